@@ -5,16 +5,26 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"log/slog"
+	"net/http"
+	"strings"
 	"time"
 
+	announcementapp "github.com/lllypuk/flowra/internal/application/announcement"
 	"github.com/lllypuk/flowra/internal/application/appcore"
+	calendarapp "github.com/lllypuk/flowra/internal/application/calendar"
 	chatapp "github.com/lllypuk/flowra/internal/application/chat"
+	"github.com/lllypuk/flowra/internal/application/inbound"
+	inboxapp "github.com/lllypuk/flowra/internal/application/inbox"
 	messageapp "github.com/lllypuk/flowra/internal/application/message"
 	"github.com/lllypuk/flowra/internal/application/notification"
+	reportapp "github.com/lllypuk/flowra/internal/application/report"
+	searchapp "github.com/lllypuk/flowra/internal/application/search"
 	taskapp "github.com/lllypuk/flowra/internal/application/task"
 	userapp "github.com/lllypuk/flowra/internal/application/user"
 	wsapp "github.com/lllypuk/flowra/internal/application/workspace"
+	"github.com/lllypuk/flowra/internal/buildinfo"
 	"github.com/lllypuk/flowra/internal/config"
 	"github.com/lllypuk/flowra/internal/domain/chat"
 	domainerrs "github.com/lllypuk/flowra/internal/domain/errs"
@@ -23,20 +33,32 @@ import (
 	notificationdomain "github.com/lllypuk/flowra/internal/domain/notification"
 	"github.com/lllypuk/flowra/internal/domain/tag"
 	taskdomain "github.com/lllypuk/flowra/internal/domain/task"
+	userdomain "github.com/lllypuk/flowra/internal/domain/user"
+	workspacedomain "github.com/lllypuk/flowra/internal/domain/workspace"
 	httphandler "github.com/lllypuk/flowra/internal/handler/http"
 	wshandler "github.com/lllypuk/flowra/internal/handler/websocket"
+	"github.com/lllypuk/flowra/internal/infrastructure/analytics"
 	"github.com/lllypuk/flowra/internal/infrastructure/auth"
+	"github.com/lllypuk/flowra/internal/infrastructure/backup"
+	"github.com/lllypuk/flowra/internal/infrastructure/chatexport"
+	"github.com/lllypuk/flowra/internal/infrastructure/errorreport"
 	"github.com/lllypuk/flowra/internal/infrastructure/eventbus"
 	"github.com/lllypuk/flowra/internal/infrastructure/eventstore"
+	"github.com/lllypuk/flowra/internal/infrastructure/featureflag"
 	"github.com/lllypuk/flowra/internal/infrastructure/filestorage"
 	"github.com/lllypuk/flowra/internal/infrastructure/healthcheck"
 	"github.com/lllypuk/flowra/internal/infrastructure/httpserver"
+	"github.com/lllypuk/flowra/internal/infrastructure/i18n"
 	"github.com/lllypuk/flowra/internal/infrastructure/keycloak"
+	"github.com/lllypuk/flowra/internal/infrastructure/mailer"
+	"github.com/lllypuk/flowra/internal/infrastructure/metrics"
 	mongodbinfra "github.com/lllypuk/flowra/internal/infrastructure/mongodb"
 	"github.com/lllypuk/flowra/internal/infrastructure/outbox"
 	"github.com/lllypuk/flowra/internal/infrastructure/projector"
 	"github.com/lllypuk/flowra/internal/infrastructure/repair"
 	"github.com/lllypuk/flowra/internal/infrastructure/repository/mongodb"
+	"github.com/lllypuk/flowra/internal/infrastructure/resilience"
+	"github.com/lllypuk/flowra/internal/infrastructure/storage"
 	"github.com/lllypuk/flowra/internal/infrastructure/websocket"
 	"github.com/lllypuk/flowra/internal/middleware"
 	"github.com/lllypuk/flowra/internal/service"
@@ -45,10 +67,12 @@ import (
 	"github.com/labstack/echo/v4"
 	"github.com/lllypuk/flowra/internal/domain/user"
 	"github.com/lllypuk/flowra/internal/domain/uuid"
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/redis/go-redis/v9"
 	"go.mongodb.org/mongo-driver/v2/bson"
 	"go.mongodb.org/mongo-driver/v2/mongo"
 	"go.mongodb.org/mongo-driver/v2/mongo/options"
+	"go.mongodb.org/mongo-driver/v2/mongo/readpref"
 )
 
 // Container initialization timeouts.
@@ -59,6 +83,12 @@ const (
 	keycloakTokenBuffer    = 30 * time.Second
 	boardProjectionTimeout = 5 * time.Second
 	repairQueueTimeout     = 5 * time.Second
+
+	// analyticsHandlerConcurrency bounds parallel deliveries to the analytics sink.
+	analyticsHandlerConcurrency = 8
+
+	// notificationEmailConcurrency bounds parallel SMTP deliveries.
+	notificationEmailConcurrency = 4
 )
 
 // Health check configuration constants.
@@ -99,18 +129,28 @@ type Container struct {
 	Broadcaster  *websocket.Broadcaster
 	NotifHandler *eventbus.NotificationHandler
 	LogHandler   *eventbus.LoggingHandler
+	// Domain counters exported to Prometheus, fed by the event bus.
+	BusinessMetrics *metrics.BusinessMetrics
+	// Duration and failure metrics for every event handler.
+	EventHandlerMetrics *metrics.EventHandlerMetrics
+	// Counts HTTP requests that exceeded their route deadline.
+	TimeoutMetrics *metrics.TimeoutMetrics
 	// Shared projector instance reused across all API wiring.
 	TaskReadModelProjector appcore.ReadModelProjector
 
 	// Reliability components
 	DeadLetterHandler *eventbus.DeadLetterHandler
 	RepairQueue       repair.Queue
+	// Sends recovered panics to the error tracker; nil when no DSN is configured.
+	ErrorReporter *errorreport.SentryReporter
 
 	// Health Checkers
 	OutboxChecker     appcore.HealthChecker
 	RepairChecker     appcore.HealthChecker
 	DeadLetterChecker appcore.HealthChecker
 	ReadModelChecker  appcore.HealthChecker
+	JWKSChecker       appcore.HealthChecker
+	ReadinessGate     appcore.HealthChecker
 
 	// Repositories
 	UserRepo         *mongodb.MongoUserRepository
@@ -120,19 +160,37 @@ type Container struct {
 	MessageRepo      *mongodb.MongoMessageRepository
 	TaskRepo         *mongodb.MongoTaskRepository
 	NotificationRepo *mongodb.MongoNotificationRepository
+	NotifPrefsRepo   *mongodb.MongoNotificationPreferencesRepository
+	StarRepo         *mongodb.MongoStarRepository
+	InboxRepo        *mongodb.MongoInboxRepository
+	SearchRepo       *mongodb.MongoMessageSearchRepository
+	ReportRepo       *mongodb.MongoReportSnapshotRepository
+	AnnouncementRepo *mongodb.MongoAnnouncementRepository
+	TaskLinkRepo     *mongodb.MongoTaskLinkRepository
+	CalendarRepo     *mongodb.MongoCalendarTokenRepository
 
 	// Use Cases
 	CreateNotificationUC *notification.CreateNotificationUseCase
 
 	// Message Use Cases
-	SendMessageUC    *messageapp.SendMessageUseCase
-	ListMessagesUC   *messageapp.ListMessagesUseCase
-	EditMessageUC    *messageapp.EditMessageUseCase
-	DeleteMessageUC  *messageapp.DeleteMessageUseCase
-	GetMessageUC     *messageapp.GetMessageUseCase
-	AddReactionUC    *messageapp.AddReactionUseCase
-	RemoveReactionUC *messageapp.RemoveReactionUseCase
-	AddAttachmentUC  *messageapp.AddAttachmentUseCase
+	SendMessageUC       *messageapp.SendMessageUseCase
+	ForwardMessageUC    *messageapp.ForwardMessageUseCase
+	ListMessagesUC      *messageapp.ListMessagesUseCase
+	EditMessageUC       *messageapp.EditMessageUseCase
+	DeleteMessageUC     *messageapp.DeleteMessageUseCase
+	UndoMessageChangeUC *messageapp.UndoMessageChangeUseCase
+	GetMessageUC        *messageapp.GetMessageUseCase
+	LocateMessageUC     *messageapp.LocateMessageUseCase
+	AddReactionUC       *messageapp.AddReactionUseCase
+	RemoveReactionUC    *messageapp.RemoveReactionUseCase
+	AddAttachmentUC     *messageapp.AddAttachmentUseCase
+	CreatePollUC        *messageapp.CreatePollUseCase
+	VotePollUC          *messageapp.VotePollUseCase
+	RetractPollVoteUC   *messageapp.RetractPollVoteUseCase
+	ClosePollUC         *messageapp.ClosePollUseCase
+
+	// Report Use Cases
+	GetReportsUC *reportapp.GetUseCase
 
 	// Services (for external access if needed)
 	WorkspaceService *service.WorkspaceService
@@ -140,27 +198,56 @@ type Container struct {
 	ChatService      *service.ChatService
 	MessageService   *service.MessageService
 	ActionService    *service.ActionService
+	StorageQuota     *service.StorageQuotaService
 
 	// HTTP Handlers
-	AuthHandler         *httphandler.AuthHandler
-	WorkspaceHandler    *httphandler.WorkspaceHandler
-	ChatHandler         *httphandler.ChatHandler
-	ChatActionHandler   *httphandler.ChatActionHandler
-	MessageHandler      *httphandler.MessageHandler
-	FileHandler         *httphandler.FileHandler
-	TaskHandler         *httphandler.TaskHandler
-	TaskActionHandler   *httphandler.TaskActionHandler
-	NotificationHandler *httphandler.NotificationHandler
-	UserHandler         *httphandler.UserHandler
-	WSHandler           *wshandler.Handler
+	AuthHandler       *httphandler.AuthHandler
+	WorkspaceHandler  *httphandler.WorkspaceHandler
+	ChatHandler       *httphandler.ChatHandler
+	ChatActionHandler *httphandler.ChatActionHandler
+	MessageHandler    *httphandler.MessageHandler
+	FileHandler       *httphandler.FileHandler
+	// WorkspaceBrandingHandler and TaskAttachmentHandler are nil when file storage is unavailable
+	WorkspaceBrandingHandler *httphandler.WorkspaceBrandingHandler
+	TaskAttachmentHandler    *httphandler.TaskAttachmentHandler
+	ChatAttachmentHandler    *httphandler.ChatAttachmentHandler // nil unless object storage is enabled
+	TaskHandler              *httphandler.TaskHandler
+	TaskActionHandler        *httphandler.TaskActionHandler
+	NotificationHandler      *httphandler.NotificationHandler
+	ReportHandler            *httphandler.ReportHandler
+	RoadmapHandler           *httphandler.RoadmapHandler
+	CommandPaletteHandler    *httphandler.CommandPaletteHandler
+	SearchHandler            *httphandler.SearchHandler
+	CalendarHandler          *httphandler.CalendarHandler
+	InboundEmailHandler      *httphandler.InboundEmailHandler // nil unless the inbound email gateway is enabled
+	AnnouncementHandler      *httphandler.AnnouncementHandler
+	InboxHandler             *httphandler.InboxHandler
+	MaintenanceHandler       *httphandler.MaintenanceHandler
+	OutboxAdminHandler       *httphandler.OutboxAdminHandler
+	AdminDirectory           *httphandler.AdminDirectoryHandler
+	UserImportHandler        *httphandler.AdminUserImportHandler // nil unless Keycloak admin access is configured
+	MessageImportHandler     *httphandler.AdminMessageImportHandler
+	ProjectionAdmin          *httphandler.ProjectionAdminHandler
+	IndexAdmin               *httphandler.IndexAdminHandler
+	BackupAdmin              *httphandler.BackupAdminHandler // nil without MongoDB
+	ChatExportHandler        *httphandler.ChatExportHandler  // nil without MongoDB
+	FeatureFlagHandler       *httphandler.FeatureFlagHandler
+	UserHandler              *httphandler.UserHandler
+	WSHandler                *wshandler.Handler
 
 	// Template Rendering
 	TemplateRenderer            *httphandler.TemplateRenderer
+	FragmentCache               *httphandler.FragmentCache
 	TemplateHandler             *httphandler.TemplateHandler
 	NotificationTemplateHandler *httphandler.NotificationTemplateHandler
 	ChatTemplateHandler         *httphandler.ChatTemplateHandler
 	BoardTemplateHandler        *httphandler.BoardTemplateHandler
 	TaskDetailTemplateHandler   *httphandler.TaskDetailTemplateHandler
+	ReportTemplateHandler       *httphandler.ReportTemplateHandler
+	RoadmapTemplateHandler      *httphandler.RoadmapTemplateHandler
+	SearchTemplateHandler       *httphandler.SearchTemplateHandler
+	AdminTemplateHandler        *httphandler.AdminTemplateHandler
+	AnnouncementTemplateHandler *httphandler.AnnouncementTemplateHandler
 
 	// Auth middleware components
 	TokenValidator middleware.TokenValidator
@@ -168,6 +255,21 @@ type Container struct {
 	AccessChecker  middleware.WorkspaceAccessChecker
 	JWTValidator   keycloak.JWTValidator // for cleanup on shutdown
 
+	// Maintenance mode switch (configuration flag plus runtime toggle)
+	Maintenance *middleware.MaintenanceSwitch
+
+	// Runtime feature flags, shared between instances through Redis when available
+	FeatureFlags featureflag.Store
+
+	// Per-workspace fair-usage limits and the log of exceeded limits shown to admins
+	WorkspaceRateLimiter *middleware.WorkspaceRateLimiter
+	WorkspaceLimitLog    workspaceLimitLog
+
+	// Bulkheads isolating calls to external dependencies from each other
+	KeycloakBulkhead *resilience.Bulkhead
+	RedisBulkhead    *resilience.Bulkhead
+	BlobBulkhead     *resilience.Bulkhead
+
 	// OAuth client (for Keycloak integration)
 	OAuthClient *keycloak.OAuthClient
 }
@@ -209,10 +311,12 @@ func NewContainer(cfg *config.Config, opts ...ContainerOption) (*Container, erro
 
 	c.setupRepositories()
 
-	// Ensure system bot user exists in database
-	if err := c.ensureSystemBot(context.Background()); err != nil {
-		c.Logger.Warn("failed to create system bot user", slog.String("error", err.Error()))
-		// Non-fatal - continue initialization
+	// Ensure system bot user exists in database; read-only regions get it replicated
+	if !c.Config.ReadOnly.Enabled {
+		if err := c.ensureSystemBot(context.Background()); err != nil {
+			c.Logger.Warn("failed to create system bot user", slog.String("error", err.Error()))
+			// Non-fatal - continue initialization
+		}
 	}
 
 	c.setupUseCases()
@@ -344,6 +448,14 @@ func (c *Container) setupInfrastructure() error {
 	ctx, cancel := context.WithTimeout(context.Background(), containerInitTimeout)
 	defer cancel()
 
+	// Setup dependency bulkheads (used by the Redis client, Keycloak clients and file storage)
+	c.setupBulkheads()
+
+	// Setup panic reporting (used by the HTTP, WebSocket and event bus recovery)
+	if err := c.setupErrorReporter(); err != nil {
+		return fmt.Errorf("error reporting: %w", err)
+	}
+
 	// Setup MongoDB
 	if err := c.setupMongoDB(ctx); err != nil {
 		return fmt.Errorf("mongodb: %w", err)
@@ -369,6 +481,12 @@ func (c *Container) setupInfrastructure() error {
 	// Setup Dead Letter Handler (for failed events)
 	c.setupDeadLetterHandler()
 
+	// Setup per-workspace rate limits
+	c.setupWorkspaceRateLimiter()
+
+	// Setup request deadline metrics
+	c.TimeoutMetrics = metrics.NewTimeoutMetrics(prometheus.DefaultRegisterer)
+
 	// Setup WebSocket Hub
 	c.setupHub()
 
@@ -382,9 +500,35 @@ func (c *Container) setupInfrastructure() error {
 
 // setupMongoDB initializes the MongoDB client.
 func (c *Container) setupMongoDB(ctx context.Context) error {
+	poolMetrics := metrics.NewMongoPoolMetrics(prometheus.DefaultRegisterer)
+
+	readOnly := c.Config.ReadOnly
+	uri := c.Config.MongoDB.URI
+	if readOnly.Enabled {
+		uri = readOnly.MongoURI(uri)
+	}
+
 	clientOpts := options.Client().
-		ApplyURI(c.Config.MongoDB.URI).
-		SetMaxPoolSize(c.Config.MongoDB.MaxPoolSize)
+		ApplyURI(uri).
+		SetMaxPoolSize(c.Config.MongoDB.MaxPoolSize).
+		SetPoolMonitor(poolMetrics.PoolMonitor())
+	if threshold := c.Config.MongoDB.SlowQueryThreshold; threshold > 0 {
+		queryMetrics := metrics.NewMongoQueryMetrics(prometheus.DefaultRegisterer)
+		clientOpts.SetMonitor(mongodbinfra.NewSlowQueryMonitor(threshold, c.Logger, queryMetrics).CommandMonitor())
+	}
+
+	if readOnly.Enabled {
+		// Queries go to the replica; writes are rejected before they reach a repository.
+		mode, modeErr := readpref.ModeFromString(readOnly.ReadPreference)
+		if modeErr != nil {
+			return fmt.Errorf("read-only read preference: %w", modeErr)
+		}
+		readPref, prefErr := readpref.New(mode)
+		if prefErr != nil {
+			return fmt.Errorf("read-only read preference: %w", prefErr)
+		}
+		clientOpts.SetReadPreference(readPref)
+	}
 
 	client, connectErr := mongo.Connect(clientOpts)
 	if connectErr != nil {
@@ -404,18 +548,31 @@ func (c *Container) setupMongoDB(ctx context.Context) error {
 
 	c.Logger.InfoContext(ctx, "connected to MongoDB",
 		slog.String("database", c.Config.MongoDB.Database),
+		slog.Bool("read_only", readOnly.Enabled),
 	)
 
-	// Create all necessary indexes
 	db := client.Database(c.Config.MongoDB.Database)
-	indexCtx, indexCancel := context.WithTimeout(ctx, c.Config.MongoDB.Timeout)
-	defer indexCancel()
 
-	if indexErr := mongodbinfra.CreateAllIndexes(indexCtx, db); indexErr != nil {
-		return fmt.Errorf("failed to create indexes: %w", indexErr)
-	}
+	// Drift is checked first: creating an index whose options changed would fail
+	c.verifyIndexes(ctx, db, readOnly.Enabled)
+
+	// Indexes are created by the primary region and replicated from there.
+	if !readOnly.Enabled {
+		indexCtx, indexCancel := context.WithTimeout(ctx, c.Config.MongoDB.Timeout)
+		defer indexCancel()
+
+		if indexErr := mongodbinfra.CreateAllIndexes(indexCtx, db); indexErr != nil {
+			return fmt.Errorf("failed to create indexes: %w", indexErr)
+		}
+		if c.Config.EventStore.Partitioned() {
+			partitions := c.Config.EventStore.Partitions
+			if indexErr := mongodbinfra.CreateEventPartitionIndexes(indexCtx, db, partitions); indexErr != nil {
+				return fmt.Errorf("failed to create event partition indexes: %w", indexErr)
+			}
+		}
 
-	c.Logger.InfoContext(ctx, "MongoDB indexes created successfully")
+		c.Logger.InfoContext(ctx, "MongoDB indexes created successfully")
+	}
 
 	legacyCtx, legacyCancel := context.WithTimeout(ctx, c.Config.MongoDB.Timeout)
 	defer legacyCancel()
@@ -429,6 +586,51 @@ func (c *Container) setupMongoDB(ctx context.Context) error {
 	return nil
 }
 
+// indexDefinitions returns every index the application expects, including the
+// event partition indexes when the event store is partitioned.
+func (c *Container) indexDefinitions() []mongodbinfra.IndexDefinition {
+	definitions := mongodbinfra.GetAllIndexDefinitions()
+	if c.Config.EventStore.Partitioned() {
+		definitions = append(definitions, mongodbinfra.GetEventPartitionIndexes(c.Config.EventStore.Partitions)...)
+	}
+	return definitions
+}
+
+// verifyIndexes logs index drift and, in repair mode, recreates missing and changed
+// indexes. A failed verification is logged and does not stop the startup.
+func (c *Container) verifyIndexes(ctx context.Context, db *mongo.Database, readOnly bool) {
+	mode := c.Config.MongoDB.IndexVerification
+	if mode == "" || mode == config.IndexVerificationOff {
+		return
+	}
+
+	verifyCtx, cancel := context.WithTimeout(ctx, c.Config.MongoDB.Timeout)
+	defer cancel()
+
+	verifier := mongodbinfra.NewIndexVerifier(db, c.indexDefinitions())
+	drifts, err := verifier.Verify(verifyCtx)
+	if err != nil {
+		c.Logger.WarnContext(verifyCtx, "failed to verify MongoDB indexes", slog.String("error", err.Error()))
+		return
+	}
+	for _, drift := range drifts {
+		c.Logger.WarnContext(verifyCtx, "MongoDB index drift",
+			slog.String("collection", drift.Collection),
+			slog.String("index", drift.Index),
+			slog.String("kind", string(drift.Kind)),
+		)
+	}
+	if len(drifts) == 0 || mode != config.IndexVerificationRepair || readOnly {
+		return
+	}
+
+	repaired, err := verifier.Repair(verifyCtx, false)
+	if err != nil {
+		c.Logger.WarnContext(verifyCtx, "failed to repair MongoDB indexes", slog.String("error", err.Error()))
+	}
+	c.Logger.InfoContext(verifyCtx, "MongoDB indexes repaired", slog.Int("repaired", len(repaired)))
+}
+
 // setupRedis initializes the Redis client.
 func (c *Container) setupRedis(ctx context.Context) error {
 	c.Redis = redis.NewClient(&redis.Options{
@@ -436,7 +638,12 @@ func (c *Container) setupRedis(ctx context.Context) error {
 		Password: c.Config.Redis.Password,
 		DB:       c.Config.Redis.DB,
 		PoolSize: c.Config.Redis.PoolSize,
+		// Lets the bulkhead timeout cut off slow replies instead of waiting for ReadTimeout.
+		ContextTimeoutEnabled: true,
 	})
+	if c.RedisBulkhead != nil {
+		c.Redis.AddHook(resilience.NewRedisHook(c.RedisBulkhead))
+	}
 
 	// Verify connection
 	pingCtx, cancel := context.WithTimeout(ctx, redisPingTimeout)
@@ -446,6 +653,8 @@ func (c *Container) setupRedis(ctx context.Context) error {
 		return fmt.Errorf("failed to ping: %w", pingErr)
 	}
 
+	metrics.NewRedisPoolCollector(prometheus.DefaultRegisterer, c.Redis)
+
 	c.Logger.InfoContext(ctx, "connected to Redis",
 		slog.String("addr", c.Config.Redis.Addr),
 	)
@@ -453,14 +662,63 @@ func (c *Container) setupRedis(ctx context.Context) error {
 	return nil
 }
 
+// setupBulkheads creates one bulkhead per external dependency, so that a slow
+// dependency exhausts only its own slots instead of every request handler.
+func (c *Container) setupBulkheads() {
+	cfg := c.Config.Resilience
+	maxWait := cfg.MaxWait
+	if maxWait == 0 {
+		maxWait = -1 // fail fast
+	}
+
+	c.KeycloakBulkhead = resilience.NewBulkhead(resilience.BulkheadConfig{
+		Name:          "keycloak",
+		MaxConcurrent: cfg.KeycloakMaxConcurrent,
+		MaxWait:       maxWait,
+		Timeout:       cfg.KeycloakTimeout,
+	})
+	c.RedisBulkhead = resilience.NewBulkhead(resilience.BulkheadConfig{
+		Name:          "redis",
+		MaxConcurrent: cfg.RedisMaxConcurrent,
+		MaxWait:       maxWait,
+		Timeout:       cfg.RedisTimeout,
+	})
+	c.BlobBulkhead = resilience.NewBulkhead(resilience.BulkheadConfig{
+		Name:          "blob",
+		MaxConcurrent: cfg.BlobMaxConcurrent,
+		MaxWait:       maxWait,
+		Timeout:       cfg.BlobTimeout,
+	})
+
+	metrics.NewBulkheadCollector(prometheus.DefaultRegisterer, c.KeycloakBulkhead, c.RedisBulkhead, c.BlobBulkhead)
+
+	c.Logger.Debug("dependency bulkheads initialized",
+		slog.Int("keycloak_max_concurrent", cfg.KeycloakMaxConcurrent),
+		slog.Int("redis_max_concurrent", cfg.RedisMaxConcurrent),
+		slog.Int("blob_max_concurrent", cfg.BlobMaxConcurrent),
+	)
+}
+
+// keycloakHTTPClient returns the HTTP client for Keycloak calls, limited by the Keycloak bulkhead.
+// It returns nil (client default) when bulkheads are not set up.
+func (c *Container) keycloakHTTPClient() *http.Client {
+	if c.KeycloakBulkhead == nil {
+		return nil
+	}
+	return resilience.HTTPClient(nil, c.KeycloakBulkhead)
+}
+
 // setupEventStore initializes the event store.
 func (c *Container) setupEventStore() {
 	c.EventStore = eventstore.NewMongoEventStore(
 		c.MongoDB,
 		c.MongoDBName,
 		eventstore.WithLogger(c.Logger),
+		eventstore.WithWorkspacePartitions(c.Config.EventStore.Partitions),
+	)
+	c.Logger.Debug("event store initialized",
+		slog.Int("partitions", c.Config.EventStore.Partitions),
 	)
-	c.Logger.Debug("event store initialized")
 }
 
 // setupEventBus initializes the event bus.
@@ -516,6 +774,50 @@ func (c *Container) setupDeadLetterHandler() {
 	c.Logger.Debug("dead letter handler initialized")
 }
 
+// setupWorkspaceRateLimiter initializes the per-workspace fair-usage limits.
+// Counters and the limit-exceeded log live in Redis so that limits hold across instances.
+func (c *Container) setupWorkspaceRateLimiter() {
+	if c.Redis != nil {
+		c.WorkspaceLimitLog = middleware.NewRedisWorkspaceLimitLog(&workspaceLimitRedisAdapter{client: c.Redis}, "", 0)
+	} else {
+		c.WorkspaceLimitLog = middleware.NewMemoryWorkspaceLimitLog(0)
+	}
+
+	if !c.Config.RateLimit.Enabled {
+		return
+	}
+
+	var store middleware.RateLimitStore = middleware.NewMemoryRateLimitStore()
+	if c.Redis != nil {
+		store = middleware.NewRedisRateLimitStore(&rateLimitRedisAdapter{client: c.Redis}, "")
+	}
+
+	c.WorkspaceRateLimiter = middleware.NewWorkspaceRateLimiter(
+		store,
+		c.Config.RateLimit.WorkspaceAPICallsPerMinute,
+		time.Minute,
+	)
+	c.WorkspaceRateLimiter.SetMessageLimit(c.Config.RateLimit.WorkspaceMessagesPerMinute)
+
+	rateLimitMetrics := metrics.NewRateLimitMetrics(prometheus.DefaultRegisterer)
+	c.WorkspaceRateLimiter.OnLimitExceeded(func(ctx context.Context, exceeded middleware.WorkspaceLimitExceeded) {
+		c.Logger.WarnContext(ctx, "workspace rate limit exceeded",
+			slog.String("workspace_id", exceeded.WorkspaceID.String()),
+			slog.String("kind", string(exceeded.Kind)),
+			slog.Int("limit", exceeded.Limit),
+			slog.String("path", exceeded.Path),
+		)
+		rateLimitMetrics.RecordWorkspaceLimitExceeded(string(exceeded.Kind))
+		if err := c.WorkspaceLimitLog.Record(ctx, exceeded); err != nil {
+			c.Logger.WarnContext(ctx, "failed to record workspace rate limit event", slog.String("error", err.Error()))
+		}
+	})
+	c.Logger.Debug("workspace rate limiter initialized",
+		slog.Int("api_calls_per_minute", c.Config.RateLimit.WorkspaceAPICallsPerMinute),
+		slog.Int("messages_per_minute", c.Config.RateLimit.WorkspaceMessagesPerMinute),
+	)
+}
+
 // setupHealthCheckers initializes all health checker components.
 func (c *Container) setupHealthCheckers() {
 	db := c.MongoDB.Database(c.MongoDBName)
@@ -547,14 +849,66 @@ func (c *Container) setupHealthCheckers() {
 		healthCheckReadModelSampleSize,
 	)
 
+	// Readiness gate on outbox backlog and projection lag
+	if c.Config.Readiness.MaxOutboxBacklog > 0 || c.Config.Readiness.MaxProjectionLag > 0 {
+		c.ReadinessGate = healthcheck.NewLagGate(
+			c.Outbox,
+			c.Config.Readiness.MaxOutboxBacklog,
+			c.Config.Readiness.MaxProjectionLag,
+			c.Config.Readiness.StartupOnly,
+		)
+	}
+
+	// Keycloak JWKS reachability checker
+	if c.Config.Keycloak.Enabled && c.Config.Keycloak.URL != "" {
+		c.JWKSChecker = healthcheck.NewJWKSChecker(
+			keycloak.JWKSURL(c.Config.Keycloak.URL, c.Config.Keycloak.Realm),
+			nil,
+		)
+	}
+
 	c.Logger.Debug("health checkers initialized")
 }
 
+// setupErrorReporter creates the error tracker client when a DSN is configured.
+func (c *Container) setupErrorReporter() error {
+	cfg := c.Config.ErrorReporting
+	if !cfg.Enabled() {
+		return nil
+	}
+
+	reporter, err := errorreport.NewSentryReporter(errorreport.SentryConfig{
+		DSN:         cfg.DSN,
+		Environment: cfg.Environment,
+		Release:     buildinfo.Get().Version,
+		Timeout:     cfg.Timeout,
+	}, c.Logger)
+	if err != nil {
+		return err
+	}
+	c.ErrorReporter = reporter
+
+	c.Logger.Info("error reporting enabled", slog.String("environment", cfg.Environment))
+	return nil
+}
+
+// recoveryConfig returns the HTTP panic recovery configuration.
+func (c *Container) recoveryConfig() middleware.RecoveryConfig {
+	config := middleware.DefaultRecoveryConfig()
+	config.Logger = c.Logger
+	if c.ErrorReporter != nil {
+		config.Reporter = c.ErrorReporter
+	}
+	return config
+}
+
 // setupHub initializes the WebSocket hub.
 func (c *Container) setupHub() {
-	c.Hub = websocket.NewHub(
-		websocket.WithHubLogger(c.Logger),
-	)
+	opts := []websocket.HubOption{websocket.WithHubLogger(c.Logger)}
+	if c.ErrorReporter != nil {
+		opts = append(opts, websocket.WithPanicReporter(c.ErrorReporter))
+	}
+	c.Hub = websocket.NewHub(opts...)
 
 	c.Logger.Debug("websocket hub initialized")
 }
@@ -584,6 +938,9 @@ func (c *Container) setupRepositories() {
 	c.UserRepo = mongodb.NewMongoUserRepository(
 		db.Collection("users"),
 		mongodb.WithUserRepoLogger(c.Logger),
+		mongodb.WithUserProfileListener(projector.NewTaskUserNamesProjector(
+			db.Collection(mongodbinfra.CollectionTaskReadModel), c.Logger,
+		)),
 	)
 
 	// Workspace repository
@@ -638,6 +995,56 @@ func (c *Container) setupRepositories() {
 		mongodb.WithNotificationRepoLogger(c.Logger),
 	)
 
+	// Notification preferences repository (do-not-disturb schedules)
+	c.NotifPrefsRepo = mongodb.NewMongoNotificationPreferencesRepository(
+		db.Collection(mongodbinfra.CollectionNotifPrefs),
+		mongodb.WithNotificationPreferencesRepoLogger(c.Logger),
+	)
+
+	// Star repository (chats and tasks starred by users)
+	c.StarRepo = mongodb.NewMongoStarRepository(
+		db.Collection(mongodbinfra.CollectionStars),
+		mongodb.WithStarRepoLogger(c.Logger),
+	)
+
+	// Inbox repository (personal quick-capture items)
+	c.InboxRepo = mongodb.NewMongoInboxRepository(
+		db.Collection(mongodbinfra.CollectionInboxItems),
+		mongodb.WithInboxRepoLogger(c.Logger),
+	)
+
+	// Search repository (text indexes of messages and chat titles)
+	c.SearchRepo = mongodb.NewMongoMessageSearchRepository(
+		db.Collection(mongodbinfra.CollectionMessages),
+		db.Collection(mongodbinfra.CollectionChatReadModel),
+		mongodb.WithMessageSearchRepoLogger(c.Logger),
+	)
+
+	// Report snapshot repository (cached burndown, flow and cycle time reports)
+	c.ReportRepo = mongodb.NewMongoReportSnapshotRepository(
+		db.Collection(mongodbinfra.CollectionReportSnapshots),
+		mongodb.WithReportSnapshotRepoLogger(c.Logger),
+	)
+
+	// Announcement repository (system-wide banners and per-user dismissals)
+	c.AnnouncementRepo = mongodb.NewMongoAnnouncementRepository(
+		db.Collection(mongodbinfra.CollectionAnnouncements),
+		db.Collection(mongodbinfra.CollectionDismissals),
+		mongodb.WithAnnouncementRepoLogger(c.Logger),
+	)
+
+	// Task link repository (messages referencing tasks, maintained by TaskLinkProjector)
+	c.TaskLinkRepo = mongodb.NewMongoTaskLinkRepository(
+		db.Collection(mongodbinfra.CollectionTaskLinks),
+		mongodb.WithTaskLinkRepoLogger(c.Logger),
+	)
+
+	// Calendar token repository (hashed secrets of per-user iCal feeds)
+	c.CalendarRepo = mongodb.NewMongoCalendarTokenRepository(
+		db.Collection(mongodbinfra.CollectionCalendarTokens),
+		mongodb.WithCalendarTokenRepoLogger(c.Logger),
+	)
+
 	c.Logger.Debug("repositories initialized")
 }
 
@@ -646,6 +1053,7 @@ func (c *Container) setupUseCases() {
 	// Notification use case is needed by event handlers
 	c.CreateNotificationUC = notification.NewCreateNotificationUseCase(
 		c.NotificationRepo,
+		notification.WithNotificationEventBus(c.domainEventBus()),
 	)
 
 	// Message use cases
@@ -673,6 +1081,14 @@ func (c *Container) setupMessageUseCases() {
 		botUserID,
 	)
 
+	// ForwardMessage use case
+	c.ForwardMessageUC = messageapp.NewForwardMessageUseCase(
+		c.MessageRepo,
+		c.ChatQueryRepo,
+		&userDisplayNameAdapter{userRepo: c.UserRepo},
+		c.EventBus,
+	)
+
 	// ListMessages use case
 	c.ListMessagesUC = messageapp.NewListMessagesUseCase(
 		c.MessageRepo,
@@ -690,11 +1106,23 @@ func (c *Container) setupMessageUseCases() {
 		c.EventBus,
 	)
 
+	// UndoMessageChange use case
+	c.UndoMessageChangeUC = messageapp.NewUndoMessageChangeUseCase(
+		c.MessageRepo,
+		c.EventBus,
+		c.Config.Messages.UndoWindow,
+	)
+
 	// GetMessage use case
 	c.GetMessageUC = messageapp.NewGetMessageUseCase(
 		c.MessageRepo,
 	)
 
+	// LocateMessage use case (message permalinks)
+	c.LocateMessageUC = messageapp.NewLocateMessageUseCase(
+		c.MessageRepo,
+	)
+
 	// AddReaction use case
 	c.AddReactionUC = messageapp.NewAddReactionUseCase(
 		c.MessageRepo,
@@ -713,6 +1141,12 @@ func (c *Container) setupMessageUseCases() {
 		c.EventBus,
 	)
 
+	// Poll use cases
+	c.CreatePollUC = messageapp.NewCreatePollUseCase(c.MessageRepo, c.ChatQueryRepo, c.EventBus)
+	c.VotePollUC = messageapp.NewVotePollUseCase(c.MessageRepo, c.ChatQueryRepo, c.EventBus)
+	c.RetractPollVoteUC = messageapp.NewRetractPollVoteUseCase(c.MessageRepo, c.ChatQueryRepo, c.EventBus)
+	c.ClosePollUC = messageapp.NewClosePollUseCase(c.MessageRepo, c.ChatQueryRepo, c.EventBus)
+
 	c.Logger.Debug("message use cases initialized")
 }
 
@@ -725,13 +1159,20 @@ func (c *Container) createChatUseCasesForTags() *tag.ChatUseCases {
 		ConvertToBug:  chatapp.NewConvertToBugUseCase(c.ChatRepo),
 		ConvertToEpic: chatapp.NewConvertToEpicUseCase(c.ChatRepo),
 
+		// Entity Removal
+		ConvertToDiscussion: chatapp.NewConvertToDiscussionUseCase(c.ChatRepo),
+
 		// Entity Management
-		ChangeStatus: chatapp.NewChangeStatusUseCase(c.ChatRepo),
-		AssignUser:   chatapp.NewAssignUserUseCase(c.ChatRepo, c.UserRepo),
-		SetPriority:  chatapp.NewSetPriorityUseCase(c.ChatRepo),
-		SetDueDate:   chatapp.NewSetDueDateUseCase(c.ChatRepo),
-		Rename:       chatapp.NewRenameChatUseCase(c.ChatRepo),
-		SetSeverity:  chatapp.NewSetSeverityUseCase(c.ChatRepo),
+		ChangeStatus:  chatapp.NewChangeStatusUseCase(c.ChatRepo),
+		AssignUser:    chatapp.NewAssignUserUseCase(c.ChatRepo, c.UserRepo),
+		SetPriority:   chatapp.NewSetPriorityUseCase(c.ChatRepo, c.valuePolicyProvider()),
+		SetDueDate:    chatapp.NewSetDueDateUseCase(c.ChatRepo),
+		SetEstimate:   chatapp.NewSetEstimateUseCase(c.ChatRepo),
+		SetSprint:     chatapp.NewSetSprintUseCase(c.ChatRepo),
+		Rename:        chatapp.NewRenameChatUseCase(c.ChatRepo),
+		SetTopic:      chatapp.NewSetTopicUseCase(c.ChatRepo),
+		SetSeverity:   chatapp.NewSetSeverityUseCase(c.ChatRepo, c.valuePolicyProvider()),
+		MarkDuplicate: chatapp.NewMarkDuplicateUseCase(c.ChatRepo),
 
 		// Participant Management (Task 007a)
 		AddParticipant:    chatapp.NewAddParticipantUseCase(c.ChatRepo),
@@ -745,15 +1186,36 @@ func (c *Container) createChatUseCasesForTags() *tag.ChatUseCases {
 
 // setupEventHandlers initializes and registers event handlers with the event bus.
 func (c *Container) setupEventHandlers() {
+	c.BusinessMetrics = metrics.NewBusinessMetrics(prometheus.DefaultRegisterer)
+	c.EventHandlerMetrics = metrics.NewEventHandlerMetrics(prometheus.DefaultRegisterer)
+	if c.Hub != nil {
+		metrics.NewHubCollector(prometheus.DefaultRegisterer, c.Hub)
+		metrics.NewWorkspaceConnectionsCollector(
+			prometheus.DefaultRegisterer,
+			c.Hub,
+			&chatWorkspaceAdapter{chatRepo: c.ChatQueryRepo},
+		)
+	}
+
 	// Create notification handler for processing domain events
 	c.NotifHandler = eventbus.NewNotificationHandler(
 		c.CreateNotificationUC,
 		eventbus.WithNotificationLogger(c.Logger),
+		eventbus.WithLocalization(i18n.MustLoad(), &userProfileLookupAdapter{userRepo: c.UserRepo}),
+		eventbus.WithNotificationObserver(c.BusinessMetrics),
+		eventbus.WithWorkspaceAdminLister(&workspaceAdminListerAdapter{workspaceRepo: c.WorkspaceRepo}),
 	)
 
 	// Create logging handler for debugging
 	c.LogHandler = eventbus.NewLoggingHandler(c.Logger)
 
+	if c.Broadcaster != nil {
+		c.Broadcaster.SetChatAudienceResolver(&chatAudienceAdapter{
+			chatRepo:      c.ChatQueryRepo,
+			workspaceRepo: c.WorkspaceRepo,
+		})
+	}
+
 	c.Logger.Debug("event handlers initialized")
 }
 
@@ -766,7 +1228,13 @@ func (c *Container) getTaskReadModelProjector() appcore.ReadModelProjector {
 	}
 
 	taskReadModelColl := c.MongoDB.Database(c.MongoDBName).Collection(mongodbinfra.CollectionTaskReadModel)
-	c.TaskReadModelProjector = projector.NewChatToTaskReadModelProjector(c.EventStore, taskReadModelColl, c.Logger)
+	var opts []projector.TaskProjectorOption
+	if c.UserRepo != nil {
+		opts = append(opts, projector.WithTaskUserDirectory(c.UserRepo))
+	}
+	c.TaskReadModelProjector = projector.NewChatToTaskReadModelProjector(
+		c.EventStore, taskReadModelColl, c.Logger, opts...,
+	)
 	return c.TaskReadModelProjector
 }
 
@@ -785,21 +1253,56 @@ func (c *Container) setupTemplateRenderer() error {
 	// Create template handler - workspace and member services will be set later during setupHTTPHandlers
 	c.TemplateHandler = httphandler.NewTemplateHandler(renderer, c.Logger, nil, nil)
 
+	// Fragment caching is production-only so template and data changes show up immediately in development.
+	if c.Config.Templates.FragmentCache && !c.Config.IsDevelopment() {
+		c.FragmentCache = httphandler.NewFragmentCache(httphandler.FragmentCacheConfig{
+			TTL:        c.Config.Templates.FragmentCacheTTL,
+			MaxEntries: c.Config.Templates.FragmentCacheMaxEntries,
+		})
+		c.TemplateHandler.SetFragmentCache(c.FragmentCache)
+	}
+
 	c.Logger.Debug("template renderer initialized",
 		slog.Bool("dev_mode", c.Config.IsDevelopment()),
+		slog.Bool("fragment_cache", c.FragmentCache != nil),
 	)
 
 	return nil
 }
 
+// eventHandlerMiddleware returns the middleware chain applied to every event handler.
+// Deduplication is shared between instances through Redis, so handlers whose effect is
+// local to each instance (e.g. cache invalidation) must be registered without it.
+func (c *Container) eventHandlerMiddleware(dedup bool) []eventbus.HandlerMiddleware {
+	config := eventbus.MiddlewareConfig{
+		Logger: c.Logger,
+		Retry:  eventbus.DefaultRetryConfig().Policy(),
+	}
+	if c.EventHandlerMetrics != nil {
+		config.Observer = c.EventHandlerMetrics
+	}
+	if dedup && c.Redis != nil {
+		config.Dedup = eventbus.NewRedisDedupStore(c.Redis)
+	}
+	if c.ErrorReporter != nil {
+		config.Reporter = c.ErrorReporter
+	}
+	return eventbus.DefaultMiddleware(config)
+}
+
 // registerEventHandlers registers all event handlers with the event bus.
 // This should be called after the event bus is ready to start.
 func (c *Container) registerEventHandlers() error {
+	middleware := c.eventHandlerMiddleware(true)
+	registry := eventbus.NewHandlerRegistry(c.EventBus, c.Logger)
+	registry.Use(middleware...)
+
 	if err := eventbus.RegisterAllHandlers(
 		c.EventBus,
 		c.NotifHandler,
 		c.LogHandler,
 		c.Logger,
+		middleware...,
 	); err != nil {
 		return err
 	}
@@ -811,10 +1314,151 @@ func (c *Container) registerEventHandlers() error {
 
 	taskProjectionHandler := eventbus.NewTaskReadModelProjectionHandler(taskProjector, c.RepairQueue, c.Logger)
 
-	if err := eventbus.RegisterTaskReadModelProjectionHandler(c.EventBus, taskProjectionHandler, c.Logger); err != nil {
+	if err := eventbus.RegisterTaskReadModelProjectionHandler(
+		c.EventBus, taskProjectionHandler, c.Logger, middleware...,
+	); err != nil {
 		return fmt.Errorf("failed to register task read model projection handler: %w", err)
 	}
 
+	activityProjector := projector.NewChatActivityProjector(
+		c.MongoDB.Database(c.MongoDBName).Collection(mongodbinfra.CollectionChatReadModel),
+		c.Logger,
+	)
+	if err := registry.RegisterWithOptions(
+		projector.ChatActivityEventTypes(),
+		activityProjector.ProcessEvent,
+		eventbus.HandlerOptions{Name: "chat_activity", Ordering: eventbus.OrderingPerAggregate},
+	); err != nil {
+		return fmt.Errorf("failed to register chat activity projector: %w", err)
+	}
+
+	db := c.MongoDB.Database(c.MongoDBName)
+	linkProjector := projector.NewTaskLinkProjector(
+		db.Collection(mongodbinfra.CollectionTaskLinks),
+		db.Collection(mongodbinfra.CollectionChatReadModel),
+		db.Collection("messages"),
+		c.Logger,
+	)
+	if err := registry.RegisterWithOptions(
+		projector.TaskLinkEventTypes(),
+		linkProjector.ProcessEvent,
+		eventbus.HandlerOptions{Name: "task_links", Ordering: eventbus.OrderingPerAggregate},
+	); err != nil {
+		return fmt.Errorf("failed to register task link projector: %w", err)
+	}
+
+	if c.FragmentCache != nil {
+		localRegistry := eventbus.NewHandlerRegistry(c.EventBus, c.Logger)
+		localRegistry.Use(c.eventHandlerMiddleware(false)...)
+		if err := localRegistry.RegisterWithOptions(
+			httphandler.FragmentCacheEventTypes(),
+			c.FragmentCache.HandleEvent,
+			eventbus.HandlerOptions{Name: "fragment_cache"},
+		); err != nil {
+			return fmt.Errorf("failed to register fragment cache invalidation: %w", err)
+		}
+	}
+
+	if c.BusinessMetrics != nil {
+		if err := registry.RegisterWithOptions(
+			metrics.BusinessEventTypes(),
+			c.BusinessMetrics.HandleEvent,
+			eventbus.HandlerOptions{Name: "business_metrics"},
+		); err != nil {
+			return fmt.Errorf("failed to register business metrics: %w", err)
+		}
+	}
+
+	if c.Config.Analytics.Enabled {
+		if err := c.registerAnalyticsHandler(registry); err != nil {
+			return err
+		}
+	}
+
+	if c.Config.Email.Enabled {
+		if err := c.registerNotificationEmailHandler(registry); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// registerNotificationEmailHandler emails created notifications to their recipients.
+func (c *Container) registerNotificationEmailHandler(registry *eventbus.HandlerRegistry) error {
+	cfg := c.Config.Email
+	sender, err := mailer.NewSMTPSender(mailer.SMTPConfig{
+		Host:     cfg.SMTPHost,
+		Port:     cfg.SMTPPort,
+		Username: cfg.SMTPUsername,
+		Password: cfg.SMTPPassword,
+		From:     cfg.From,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create smtp sender: %w", err)
+	}
+
+	opts := []mailer.NotificationOption{
+		mailer.WithNotificationLogger(c.Logger),
+		mailer.WithChatLinks(cfg.BaseURL, &chatWorkspaceAdapter{chatRepo: c.ChatQueryRepo}),
+		// Digest-worthy emails held back during quiet time are sent by the worker
+		mailer.WithQuietHours(c.NotifPrefsRepo, mailer.NewMongoDeferredEmailStore(
+			c.MongoDB.Database(c.MongoDBName).Collection(mongodbinfra.CollectionDeferredEmails), c.Logger)),
+	}
+	if c.Config.RepliesEnabled() {
+		opts = append(opts, mailer.WithReplyAddresses(
+			inbound.NewReplyAddresses(c.Config.Inbound.Domain, cfg.ReplyKey)))
+	}
+	handler := mailer.NewNotificationHandler(
+		sender,
+		c.UserRepo,
+		&messageChatAdapter{messageRepo: c.MessageRepo},
+		opts...,
+	)
+	if regErr := registry.RegisterWithOptions(mailer.EventTypes(), handler.Handle, eventbus.HandlerOptions{
+		Name:        "notification_email",
+		Ordering:    eventbus.OrderingNone,
+		Concurrency: notificationEmailConcurrency,
+	}); regErr != nil {
+		return fmt.Errorf("failed to register notification email handler: %w", regErr)
+	}
+
+	c.Logger.Info("notification emails enabled", slog.Bool("replies", c.Config.RepliesEnabled()))
+	return nil
+}
+
+// registerAnalyticsHandler subscribes the product analytics pipeline to the event bus.
+func (c *Container) registerAnalyticsHandler(registry *eventbus.HandlerRegistry) error {
+	cfg := c.Config.Analytics
+	sink, err := analytics.NewSink(analytics.SinkConfig{
+		Kind:            cfg.Sink,
+		Timeout:         cfg.Timeout,
+		SegmentWriteKey: cfg.SegmentWriteKey,
+		SegmentEndpoint: cfg.SegmentEndpoint,
+		PostHogAPIKey:   cfg.PostHogAPIKey,
+		PostHogHost:     cfg.PostHogHost,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create analytics sink: %w", err)
+	}
+
+	handler := analytics.NewHandler(
+		sink,
+		analytics.NewHasher(cfg.HashSalt),
+		&chatWorkspaceAdapter{chatRepo: c.ChatQueryRepo},
+		&workspaceAnalyticsOptOutAdapter{workspaceRepo: c.WorkspaceRepo},
+		cfg.EventList(),
+		c.Logger,
+	)
+	if regErr := registry.RegisterWithOptions(analytics.EventTypes(), handler.Handle, eventbus.HandlerOptions{
+		Name:        "analytics",
+		Ordering:    eventbus.OrderingNone,
+		Concurrency: analyticsHandlerConcurrency,
+	}); regErr != nil {
+		return fmt.Errorf("failed to register analytics handler: %w", regErr)
+	}
+
+	c.Logger.Info("product analytics enabled", slog.String("sink", cfg.Sink))
 	return nil
 }
 
@@ -828,20 +1472,42 @@ func (c *Container) setupHTTPHandlers() {
 	c.Logger.Debug("access checker initialized (real)")
 
 	// === 2. Member Service (Real) ===
-	c.MemberService = service.NewMemberService(c.WorkspaceRepo, c.WorkspaceRepo)
+	keycloakClient := c.createKeycloakGroupClient()
+	c.MemberService = service.NewMemberService(
+		c.WorkspaceRepo,
+		c.WorkspaceRepo,
+		service.WithMemberEventBus(c.domainEventBus()),
+		service.WithMemberGroupClient(keycloakClient),
+	)
 	c.Logger.Debug("member service initialized (real)")
 
 	// === 3. Workspace Service (Real) ===
-	c.WorkspaceService = c.createWorkspaceService()
+	c.WorkspaceService = c.createWorkspaceService(keycloakClient)
 	c.Logger.Debug("workspace service initialized (real)")
 
+	// === 3a. Attachment Storage Quota ===
+	c.StorageQuota = service.NewStorageQuotaService(
+		mongodb.NewMongoStorageUsageRepository(
+			c.MongoDB.Database(c.MongoDBName).Collection(mongodbinfra.CollectionStorageUsage),
+			mongodb.WithStorageUsageRepoLogger(c.Logger),
+		),
+		c.Config.Uploads.WorkspaceQuota,
+		service.WithStorageQuotaEventBus(c.domainEventBus()),
+		service.WithStorageQuotaWarnPercent(c.Config.Uploads.QuotaWarnPercent),
+	)
+
 	// === 4. Workspace Handler with Real Services ===
-	c.WorkspaceHandler = httphandler.NewWorkspaceHandler(c.WorkspaceService, c.MemberService)
+	c.WorkspaceHandler = httphandler.NewWorkspaceHandler(
+		c.WorkspaceService,
+		c.MemberService,
+		httphandler.WithWorkspaceStorageQuota(c.StorageQuota),
+	)
 
 	// Inject services into template handler
 	if c.TemplateHandler != nil {
 		c.TemplateHandler.SetServices(c.WorkspaceService, c.MemberService)
 		c.TemplateHandler.SetUserLookup(c.createUserProfileLookup())
+		c.TemplateRenderer.SetUserLookup(c.createUserProfileLookup())
 		c.TemplateHandler.SetUserSearcher(c.createUserSearcher())
 	}
 
@@ -871,10 +1537,12 @@ func (c *Container) setupHTTPHandlers() {
 		c.Hub,
 		wshandler.WithHandlerLogger(c.Logger),
 		wshandler.WithTokenValidator(c.TokenValidator),
+		wshandler.WithUserResolver(c.UserResolver),
 		wshandler.WithHandlerConfig(wshandler.HandlerConfig{
-			ReadBufferSize:  c.Config.WebSocket.ReadBufferSize,
-			WriteBufferSize: c.Config.WebSocket.WriteBufferSize,
-			Logger:          c.Logger,
+			ReadBufferSize:    c.Config.WebSocket.ReadBufferSize,
+			WriteBufferSize:   c.Config.WebSocket.WriteBufferSize,
+			Logger:            c.Logger,
+			EnableCompression: c.Config.WebSocket.Compression,
 			ClientConfig: websocket.ClientConfig{
 				ReadBufferSize:  c.Config.WebSocket.ReadBufferSize,
 				WriteBufferSize: c.Config.WebSocket.WriteBufferSize,
@@ -888,13 +1556,14 @@ func (c *Container) setupHTTPHandlers() {
 
 	// Configure page auth middleware with token validator and user resolver
 	httphandler.SetPageAuthConfig(&httphandler.PageAuthConfig{
-		TokenValidator: c.TokenValidator,
-		UserResolver:   c.UserResolver,
-		Logger:         c.Logger,
+		TokenValidator:   c.TokenValidator,
+		UserResolver:     c.UserResolver,
+		Logger:           c.Logger,
+		TimezoneResolver: c.createTimezoneResolver(),
 	})
 
-	// === 9. Notification Service and Template Handler ===
-	c.setupNotificationTemplateHandler()
+	// === 9. Notification Service, API and Template Handlers ===
+	c.setupNotificationHandlers()
 
 	// === 10. Chat Template Handler ===
 	c.setupChatTemplateHandler()
@@ -915,12 +1584,192 @@ func (c *Container) setupHTTPHandlers() {
 		service.WithTaskProjectionSync(c.getTaskReadModelProjector()),
 	)
 	c.ChatActionHandler = httphandler.NewChatActionHandler(c.ActionService)
+	if c.BoardTemplateHandler != nil {
+		c.BoardTemplateHandler.SetStatusChanger(c.ActionService)
+	}
 	c.Logger.Debug("action service and chat action handler initialized")
 
 	// Initialize TaskHandler with full service
 	c.TaskHandler = httphandler.NewTaskHandler(c.createFullTaskService(), c.ActionService)
+	c.TaskHandler.SetTaskStreamer(c.createBoardTaskService())
 	c.Logger.Debug("task handler initialized (real)")
 
+	// Initialize ReportHandler — velocity is computed from the workspace-scoped task read model,
+	// burndown, flow and cycle time from cached snapshots refreshed by the worker
+	c.GetReportsUC = reportapp.NewGetUseCase(
+		c.ReportRepo,
+		reportapp.NewGenerateUseCase(c.ChatQueryRepo, c.EventStore, c.ReportRepo),
+		reportapp.DefaultMaxSnapshotAge,
+	)
+	c.ReportHandler = httphandler.NewReportHandler(
+		taskapp.NewVelocityReportUseCase(c.createBoardTaskService()),
+		c.GetReportsUC,
+	)
+	c.ReportTemplateHandler = httphandler.NewReportTemplateHandler(
+		c.TemplateRenderer,
+		c.Logger,
+		c.GetReportsUC,
+		c.WorkspaceRepo,
+	)
+	c.Logger.Debug("report handler initialized")
+
+	// Initialize RoadmapHandler — epic rollups from the workspace-scoped task read model
+	roadmapUC := taskapp.NewRoadmapUseCase(c.createBoardTaskService())
+	c.RoadmapHandler = httphandler.NewRoadmapHandler(roadmapUC)
+
+	// Initialize CommandPaletteHandler — quick actions of the web UI command palette
+	c.CommandPaletteHandler = httphandler.NewCommandPaletteHandler(c.ChatQueryRepo, c.createBoardMemberService())
+	c.RoadmapTemplateHandler = httphandler.NewRoadmapTemplateHandler(
+		c.TemplateRenderer,
+		c.Logger,
+		roadmapUC,
+		c.WorkspaceRepo,
+	)
+	c.Logger.Debug("roadmap handler initialized")
+
+	// Initialize SearchHandler — full-text search over messages, chats and task titles
+	searchUC := searchapp.NewUseCase(c.SearchRepo)
+	c.SearchHandler = httphandler.NewSearchHandler(searchUC)
+	c.SearchTemplateHandler = httphandler.NewSearchTemplateHandler(
+		c.TemplateRenderer,
+		c.Logger,
+		searchUC,
+		c.WorkspaceRepo,
+	)
+	c.Logger.Debug("search handler initialized")
+
+	// Initialize CalendarHandler — iCal feeds of assigned due dates and sprint boundaries
+	getCalendarToken := calendarapp.NewGetTokenUseCase(c.CalendarRepo)
+	c.CalendarHandler = httphandler.NewCalendarHandler(httphandler.CalendarUseCases{
+		Issue:  calendarapp.NewIssueTokenUseCase(c.CalendarRepo),
+		Revoke: calendarapp.NewRevokeTokenUseCase(c.CalendarRepo),
+		Get:    getCalendarToken,
+		Feed: calendarapp.NewFeedUseCase(
+			c.CalendarRepo,
+			c.UserRepo,
+			c.createBoardTaskService(),
+			c.WorkspaceRepo,
+			c.GetReportsUC,
+		),
+	})
+	if c.TemplateHandler != nil {
+		c.TemplateHandler.SetCalendarTokenReader(getCalendarToken)
+	}
+	c.Logger.Debug("calendar handler initialized")
+
+	// Initialize AdminTemplateHandler — dashboard for system admins built on the health sources
+	c.AdminTemplateHandler = httphandler.NewAdminTemplateHandler(
+		c.TemplateRenderer,
+		c.Logger,
+		c.adminDashboardSources(),
+	)
+	c.Logger.Debug("admin template handler initialized")
+
+	// Initialize announcement handlers — admins publish banners, every page polls for them
+	listActiveAnnouncements := announcementapp.NewListActiveUseCase(c.AnnouncementRepo)
+	dismissAnnouncement := announcementapp.NewDismissUseCase(c.AnnouncementRepo)
+	c.AnnouncementHandler = httphandler.NewAnnouncementHandler(httphandler.AnnouncementUseCases{
+		Publish:    announcementapp.NewPublishUseCase(c.AnnouncementRepo, c.domainEventBus()),
+		End:        announcementapp.NewEndUseCase(c.AnnouncementRepo, c.domainEventBus()),
+		List:       announcementapp.NewListUseCase(c.AnnouncementRepo),
+		ListActive: listActiveAnnouncements,
+		Dismiss:    dismissAnnouncement,
+	})
+	// Initialize inbox handler — personal quick capture, promoted through the regular create flow
+	c.InboxHandler = httphandler.NewInboxHandler(httphandler.InboxUseCases{
+		Capture: inboxapp.NewCaptureUseCase(c.InboxRepo),
+		List:    inboxapp.NewListUseCase(c.InboxRepo),
+		Discard: inboxapp.NewDiscardUseCase(c.InboxRepo),
+		Promote: inboxapp.NewPromoteUseCase(c.InboxRepo, c.createInboxChatCreator()),
+	})
+
+	c.AnnouncementTemplateHandler = httphandler.NewAnnouncementTemplateHandler(
+		c.TemplateRenderer,
+		c.Logger,
+		listActiveAnnouncements,
+		dismissAnnouncement,
+	)
+	c.Logger.Debug("announcement handlers initialized")
+
+	// Initialize maintenance mode — the runtime toggle is shared between instances through Redis
+	c.Maintenance = middleware.NewMaintenanceSwitch(
+		c.Config.Maintenance.Enabled,
+		c.Config.Maintenance.Message,
+		c.maintenanceStore(),
+		c.Logger,
+	)
+	c.MaintenanceHandler = httphandler.NewMaintenanceHandler(c.Maintenance)
+	c.Logger.Debug("maintenance handler initialized")
+
+	// Initialize outbox quarantine admin API
+	if store, ok := c.Outbox.(httphandler.OutboxQuarantineStore); ok {
+		var opts []httphandler.OutboxAdminOption
+		if c.Outbox != nil {
+			opts = append(opts, httphandler.WithOutboxBacklog(c.Outbox))
+		}
+		if c.DeadLetterHandler != nil {
+			opts = append(opts, httphandler.WithDeadLetters(&adminDeadLetterAdapter{handler: c.DeadLetterHandler}))
+		}
+		c.OutboxAdminHandler = httphandler.NewOutboxAdminHandler(store, opts...)
+		c.Logger.Debug("outbox admin handler initialized")
+	}
+
+	// Initialize the admin directory, projection and feature flag APIs used by flowractl
+	c.AdminDirectory = httphandler.NewAdminDirectoryHandler(c.WorkspaceRepo, c.UserRepo)
+	if c.keycloakAdminConfigured() {
+		// Imported users are created in Keycloak first, so the import needs admin access
+		c.UserImportHandler = httphandler.NewAdminUserImportHandler(
+			userapp.NewImportUsersUseCase(c.UserRepo, &keycloakIdentityAdapter{
+				client: keycloak.NewUserClient(keycloak.UserClientConfig{
+					KeycloakURL: c.Config.Keycloak.URL,
+					Realm:       c.Config.Keycloak.Realm,
+					HTTPClient:  c.keycloakHTTPClient(),
+				}, c.newKeycloakAdminTokenManager()),
+			}),
+		)
+	}
+	// Migrated chat history is saved without events, so it raises no notifications
+	c.MessageImportHandler = httphandler.NewAdminMessageImportHandler(
+		messageapp.NewImportMessagesUseCase(c.MessageRepo, c.ChatQueryRepo, c.UserRepo),
+	)
+	if c.RepairQueue != nil {
+		c.ProjectionAdmin = httphandler.NewProjectionAdminHandler(
+			&projectionRepairAdapter{queue: c.RepairQueue},
+			&adminRepairStatsAdapter{queue: c.RepairQueue},
+		)
+	}
+	if c.MongoDB != nil {
+		c.IndexAdmin = httphandler.NewIndexAdminHandler(
+			mongodbinfra.NewIndexVerifier(c.MongoDB.Database(c.MongoDBName), c.indexDefinitions()),
+		)
+	}
+	if c.MongoDB != nil {
+		// Backup jobs are run by the worker, which shares the backup directory
+		c.BackupAdmin = httphandler.NewBackupAdminHandler(
+			backup.NewMongoJobStore(c.MongoDB.Database(c.MongoDBName).Collection(mongodbinfra.CollectionBackupJobs)),
+			c.WorkspaceRepo,
+			c.Config.Backup.Dir,
+			c.Config.Backup.MaxUploadSize,
+		)
+	}
+	if c.MongoDB != nil {
+		// Exports of large chats are written by the worker, which shares the export directory
+		c.ChatExportHandler = httphandler.NewChatExportHandler(
+			chatexport.NewMongoJobStore(
+				c.MongoDB.Database(c.MongoDBName).Collection(mongodbinfra.CollectionChatExportJobs)),
+			c.ChatQueryRepo,
+			chatexport.NewExporter(c.ChatQueryRepo, c.MessageRepo, c.UserRepo,
+				chatexport.WithBaseURL(c.Config.Email.BaseURL),
+				chatexport.WithLogger(c.Logger),
+			),
+			c.Config.ChatExport.Dir,
+			c.Config.ChatExport.SyncLimit,
+		)
+	}
+	c.FeatureFlags = c.featureFlagStore()
+	c.FeatureFlagHandler = httphandler.NewFeatureFlagHandler(c.FeatureFlags)
+	c.Logger.Debug("admin API handlers initialized")
+
 	// Initialize TaskActionHandler — routes sidebar changes through chat message system
 	c.TaskActionHandler = httphandler.NewTaskActionHandler(
 		c.createTaskActionService(),
@@ -934,68 +1783,116 @@ func (c *Container) setupHTTPHandlers() {
 	c.Logger.Info("HTTP handlers initialized with REAL implementations")
 }
 
-// createWorkspaceService creates the workspace service with all dependencies.
-func (c *Container) createWorkspaceService() *service.WorkspaceService {
-	// Create Keycloak client or NoOp if not configured/enabled
+// createKeycloakGroupClient creates the Keycloak group client, or a NoOp client
+// when Keycloak admin access is not configured.
+func (c *Container) createKeycloakGroupClient() wsapp.KeycloakClient {
 	var keycloakClient wsapp.KeycloakClient
-	if c.Config.Keycloak.Enabled && c.Config.Keycloak.URL != "" && c.Config.Keycloak.AdminUsername != "" {
-		c.Logger.Debug("using real Keycloak GroupClient for workspace service",
+	if c.keycloakAdminConfigured() {
+		c.Logger.Debug("using real Keycloak GroupClient for workspace groups",
 			slog.String("url", c.Config.Keycloak.URL),
 			slog.String("realm", c.Config.Keycloak.Realm),
 		)
 
-		// Create admin token manager for authentication
-		tokenManager := keycloak.NewAdminTokenManager(keycloak.AdminTokenConfig{
-			KeycloakURL: c.Config.Keycloak.URL,
-			Realm:       "master", // Admin operations are typically against master realm
-			ClientID:    "admin-cli",
-			Username:    c.Config.Keycloak.AdminUsername,
-			Password:    c.Config.Keycloak.AdminPassword,
-			TokenBuffer: keycloakTokenBuffer,
-		})
-
 		// Create group client for workspace management
 		keycloakClient = keycloak.NewGroupClient(keycloak.GroupClientConfig{
 			KeycloakURL: c.Config.Keycloak.URL,
 			Realm:       c.Config.Keycloak.Realm,
-		}, tokenManager)
+			HTTPClient:  c.keycloakHTTPClient(),
+		}, c.newKeycloakAdminTokenManager())
 	} else {
-		c.Logger.Debug("using NoOp Keycloak client for workspace service (admin not configured)")
+		c.Logger.Debug("using NoOp Keycloak client for workspace groups (admin not configured)")
 		keycloakClient = service.NewNoOpKeycloakClient()
 	}
+	return keycloakClient
+}
+
+// keycloakAdminConfigured reports whether the Keycloak admin API can be used.
+func (c *Container) keycloakAdminConfigured() bool {
+	return c.Config.Keycloak.Enabled && c.Config.Keycloak.URL != "" && c.Config.Keycloak.AdminUsername != ""
+}
+
+// newKeycloakAdminTokenManager creates the token manager authenticating Keycloak admin API calls.
+func (c *Container) newKeycloakAdminTokenManager() *keycloak.AdminTokenManager {
+	return keycloak.NewAdminTokenManager(keycloak.AdminTokenConfig{
+		KeycloakURL: c.Config.Keycloak.URL,
+		Realm:       "master", // Admin operations are typically against master realm
+		ClientID:    "admin-cli",
+		Username:    c.Config.Keycloak.AdminUsername,
+		Password:    c.Config.Keycloak.AdminPassword,
+		TokenBuffer: keycloakTokenBuffer,
+		HTTPClient:  c.keycloakHTTPClient(),
+	})
+}
 
+// createWorkspaceService creates the workspace service with all dependencies.
+func (c *Container) createWorkspaceService(keycloakClient wsapp.KeycloakClient) *service.WorkspaceService {
 	// Create use cases
 	createUC := wsapp.NewCreateWorkspaceUseCase(c.WorkspaceRepo, keycloakClient)
 	getUC := wsapp.NewGetWorkspaceUseCase(c.WorkspaceRepo)
 	updateUC := wsapp.NewUpdateWorkspaceUseCase(c.WorkspaceRepo)
+	policyUC := wsapp.NewUpdateValuePolicyUseCase(c.WorkspaceRepo)
+	slaUC := wsapp.NewUpdateSLAPolicyUseCase(c.WorkspaceRepo)
+	optOutUC := wsapp.NewUpdateAnalyticsOptOutUseCase(c.WorkspaceRepo)
+	joinUC := wsapp.NewUpdateJoinApprovalUseCase(c.WorkspaceRepo)
+	discoUC := wsapp.NewUpdateDiscoverabilityUseCase(c.WorkspaceRepo)
+	brandUC := wsapp.NewUpdateBrandingUseCase(c.WorkspaceRepo)
 
 	return service.NewWorkspaceService(service.WorkspaceServiceConfig{
 		CreateUC:    createUC,
 		GetUC:       getUC,
 		UpdateUC:    updateUC,
+		PolicyUC:    policyUC,
+		SLAUC:       slaUC,
+		OptOutUC:    optOutUC,
+		JoinUC:      joinUC,
+		DiscoUC:     discoUC,
+		BrandUC:     brandUC,
 		CommandRepo: c.WorkspaceRepo,
 		QueryRepo:   c.WorkspaceRepo,
+		EventBus:    c.domainEventBus(),
 	})
 }
 
+// domainEventBus returns the event bus for optional publishers, or nil when it is not configured.
+func (c *Container) domainEventBus() event.Bus {
+	if c.EventBus == nil {
+		return nil
+	}
+	return c.EventBus
+}
+
 // createChatService creates the chat service with all dependencies.
 func (c *Container) createChatService() *service.ChatService {
 	// Create use cases
 	// CreateChatUseCase uses ChatRepo which updates both event store AND read model
 	createUC := chatapp.NewCreateChatUseCase(c.ChatRepo)
-	getUC := chatapp.NewGetChatUseCase(c.EventStore)
-	listUC := chatapp.NewListChatsUseCase(c.ChatQueryRepo, c.EventStore)
+	getUC := chatapp.NewGetChatUseCase(c.EventStore, chatapp.WithGetStars(c.StarRepo))
+	listUC := chatapp.NewListChatsUseCase(c.ChatQueryRepo, c.EventStore, chatapp.WithListStars(c.StarRepo))
 	renameUC := chatapp.NewRenameChatUseCase(c.ChatRepo)
+	topicUC := chatapp.NewSetTopicUseCase(c.ChatRepo)
 	addPartUC := chatapp.NewAddParticipantUseCase(c.ChatRepo)
 	removePartUC := chatapp.NewRemoveParticipantUseCase(c.ChatRepo)
+	transferUC := chatapp.NewTransferOwnershipUseCase(c.ChatRepo)
+	listPartUC := chatapp.NewListParticipantsUseCase(c.EventStore, &userDisplayNameAdapter{userRepo: c.UserRepo})
+	markReadUC := chatapp.NewMarkChatReadUseCase(c.ChatQueryRepo, c.ChatQueryRepo)
+	unreadUC := chatapp.NewGetUnreadCountUseCase(c.ChatQueryRepo)
+	starUC := chatapp.NewStarChatUseCase(c.ChatQueryRepo, c.StarRepo)
+	unstarUC := chatapp.NewUnstarChatUseCase(c.StarRepo)
 
 	return service.NewChatService(service.ChatServiceConfig{
 		CreateUC:     createUC,
 		GetUC:        getUC,
 		ListUC:       listUC,
 		RenameUC:     renameUC,
+		TopicUC:      topicUC,
 		AddPartUC:    addPartUC,
 		RemovePartUC: removePartUC,
+		ListPartUC:   listPartUC,
+		TransferUC:   transferUC,
+		MarkReadUC:   markReadUC,
+		UnreadUC:     unreadUC,
+		StarUC:       starUC,
+		UnstarUC:     unstarUC,
 		EventStore:   c.EventStore,
 	})
 }
@@ -1016,6 +1913,7 @@ func (c *Container) createAuthService() httphandler.AuthService {
 		Realm:        c.Config.Keycloak.Realm,
 		ClientID:     c.Config.Keycloak.ClientID,
 		ClientSecret: c.Config.Keycloak.ClientSecret,
+		HTTPClient:   c.keycloakHTTPClient(),
 		Logger:       c.Logger,
 	})
 
@@ -1028,18 +1926,41 @@ func (c *Container) createAuthService() httphandler.AuthService {
 		slog.String("realm", c.Config.Keycloak.Realm),
 	)
 
+	var userRepo service.AuthServiceUserRepository = c.UserRepo
+	if c.Config.ReadOnly.Enabled {
+		userRepo = readOnlyUserStore{c.UserRepo}
+	}
+
 	return service.NewAuthService(service.AuthServiceConfig{
 		OAuthClient: c.OAuthClient,
 		TokenStore:  tokenStore,
-		UserRepo:    c.UserRepo,
+		UserRepo:    userRepo,
 		Logger:      c.Logger,
 	})
 }
 
-// setupNotificationTemplateHandler creates the notification template handler with all dependencies.
-func (c *Container) setupNotificationTemplateHandler() {
-	// Create notification service that implements NotificationTemplateService
-	notifService := c.createNotificationTemplateService()
+// errReadOnlyDeployment is returned for writes attempted in a read-only deployment.
+var errReadOnlyDeployment = errors.New("deployment is read-only")
+
+// readOnlyUserStore keeps sign-in from writing users in read-only deployments:
+// profile updates are skipped and users not replicated yet cannot sign in.
+type readOnlyUserStore struct {
+	*mongodb.MongoUserRepository
+}
+
+// Save implements service.AuthServiceUserRepository.
+func (readOnlyUserStore) Save(context.Context, *user.User) error {
+	return errReadOnlyDeployment
+}
+
+// setupNotificationHandlers creates the notification API and template handlers,
+// both backed by the same notification service.
+func (c *Container) setupNotificationHandlers() {
+	notifService := c.createNotificationService()
+
+	c.NotificationHandler = httphandler.NewNotificationHandler(notifService,
+		httphandler.WithNotificationPreferences(notifService),
+		httphandler.WithUnifiedInbox(notifService))
 
 	// Create template handler
 	c.NotificationTemplateHandler = httphandler.NewNotificationTemplateHandler(
@@ -1047,8 +1968,9 @@ func (c *Container) setupNotificationTemplateHandler() {
 		c.Logger,
 		notifService,
 	)
+	c.NotificationTemplateHandler.SetUnifiedInbox(notifService)
 
-	c.Logger.Debug("notification template handler initialized")
+	c.Logger.Debug("notification handlers initialized")
 }
 
 // setupChatTemplateHandler creates the chat template handler with all dependencies.
@@ -1072,6 +1994,10 @@ func (c *Container) setupChatTemplateHandler() {
 	c.ChatTemplateHandler.SetTaskProjector(c.getTaskReadModelProjector())
 	c.ChatTemplateHandler.SetUserLookup(c.createUserProfileLookup())
 	c.ChatTemplateHandler.SetMemberService(c.createBoardMemberService())
+	c.ChatTemplateHandler.SetTaskLinkLookup(&messageTaskLinkLookupAdapter{
+		links:      c.TaskLinkRepo,
+		collection: c.MongoDB.Database(c.MongoDBName).Collection(mongodbinfra.CollectionTaskReadModel),
+	})
 
 	c.Logger.Debug("chat template handler initialized")
 }
@@ -1079,15 +2005,17 @@ func (c *Container) setupChatTemplateHandler() {
 // createMessageTemplateService creates a service implementing MessageTemplateService.
 func (c *Container) createMessageTemplateService() httphandler.MessageTemplateService {
 	return &messageTemplateServiceAdapter{
-		listMessagesUC: c.ListMessagesUC,
-		getMessageUC:   c.GetMessageUC,
+		listMessagesUC:  c.ListMessagesUC,
+		getMessageUC:    c.GetMessageUC,
+		locateMessageUC: c.LocateMessageUC,
 	}
 }
 
 // messageTemplateServiceAdapter adapts message use cases to MessageTemplateService interface.
 type messageTemplateServiceAdapter struct {
-	listMessagesUC *messageapp.ListMessagesUseCase
-	getMessageUC   *messageapp.GetMessageUseCase
+	listMessagesUC  *messageapp.ListMessagesUseCase
+	getMessageUC    *messageapp.GetMessageUseCase
+	locateMessageUC *messageapp.LocateMessageUseCase
 }
 
 func (a *messageTemplateServiceAdapter) ListMessages(
@@ -1108,6 +2036,13 @@ func (a *messageTemplateServiceAdapter) GetMessage(ctx context.Context, messageI
 	return result.Value, nil
 }
 
+func (a *messageTemplateServiceAdapter) LocateMessage(
+	ctx context.Context,
+	query messageapp.LocateMessageQuery,
+) (messageapp.LocateResult, error) {
+	return a.locateMessageUC.Execute(ctx, query)
+}
+
 // createChatTemplateService creates a service implementing ChatTemplateService.
 func (c *Container) createChatTemplateService() httphandler.ChatTemplateService {
 	return &chatTemplateServiceAdapter{
@@ -1153,6 +2088,25 @@ func (a *chatTemplateServiceAdapter) ListChats(
 	return a.chatService.ListChats(ctx, query)
 }
 
+// MarkChatRead implements ChatTemplateService.
+func (a *chatTemplateServiceAdapter) MarkChatRead(ctx context.Context, cmd chatapp.MarkChatReadCommand) error {
+	if a.chatService == nil {
+		return nil
+	}
+	return a.chatService.MarkChatRead(ctx, cmd)
+}
+
+// GetUnreadCount implements ChatTemplateService.
+func (a *chatTemplateServiceAdapter) GetUnreadCount(
+	ctx context.Context,
+	query chatapp.GetUnreadCountQuery,
+) (*chatapp.GetUnreadCountResult, error) {
+	if a.chatService == nil {
+		return nil, service.ErrUnreadCountNotConfigured
+	}
+	return a.chatService.GetUnreadCount(ctx, query)
+}
+
 // createTaskQueryForChatService creates a service implementing TaskQueryForChatService.
 func (c *Container) createTaskQueryForChatService() httphandler.TaskQueryForChatService {
 	return &taskQueryForChatServiceAdapter{
@@ -1183,6 +2137,63 @@ func (a *taskQueryForChatServiceAdapter) GetTaskByChatID(
 	return doc.toReadModel(), nil
 }
 
+// messageTaskLinkLookupAdapter adapts the task link repository and the task read model
+// to MessageTaskLinkLookup.
+type messageTaskLinkLookupAdapter struct {
+	links      *mongodb.MongoTaskLinkRepository
+	collection *mongo.Collection
+}
+
+// LinkedTasks implements MessageTaskLinkLookup. Tasks are loaded with one query
+// for all messages; links to tasks missing from the read model are dropped.
+func (a *messageTaskLinkLookupAdapter) LinkedTasks(
+	ctx context.Context,
+	messageIDs []uuid.UUID,
+) (map[uuid.UUID][]*taskapp.ReadModel, error) {
+	linked, err := a.links.FindLinkedTaskIDs(ctx, messageIDs)
+	if err != nil {
+		return nil, err
+	}
+	result := make(map[uuid.UUID][]*taskapp.ReadModel, len(linked))
+	if len(linked) == 0 {
+		return result, nil
+	}
+
+	ids := make([]string, 0, len(linked))
+	seen := make(map[uuid.UUID]bool)
+	for _, taskIDs := range linked {
+		for _, id := range taskIDs {
+			if !seen[id] {
+				seen[id] = true
+				ids = append(ids, id.String())
+			}
+		}
+	}
+
+	cursor, err := a.collection.Find(ctx, bson.M{"task_id": bson.M{"$in": ids}})
+	if err != nil {
+		return nil, fmt.Errorf("failed to load linked tasks: %w", err)
+	}
+	var docs []taskReadModelDoc
+	if err = cursor.All(ctx, &docs); err != nil {
+		return nil, fmt.Errorf("failed to decode linked tasks: %w", err)
+	}
+	tasks := make(map[uuid.UUID]*taskapp.ReadModel, len(docs))
+	for i := range docs {
+		model := docs[i].toReadModel()
+		tasks[model.ID] = model
+	}
+
+	for messageID, taskIDs := range linked {
+		for _, id := range taskIDs {
+			if model, ok := tasks[id]; ok {
+				result[messageID] = append(result[messageID], model)
+			}
+		}
+	}
+	return result, nil
+}
+
 // setupBoardTemplateHandler creates the board template handler with all dependencies.
 func (c *Container) setupBoardTemplateHandler() {
 	// Create board task service adapter
@@ -1201,6 +2212,10 @@ func (c *Container) setupBoardTemplateHandler() {
 	// Set chat creator for creating typed chats and bootstrapping task read model.
 	c.BoardTemplateHandler.SetChatCreator(c.createBoardChatCreator())
 
+	// Workspace lookup for the branded board header.
+	c.BoardTemplateHandler.SetWorkspaceLookup(c.WorkspaceService)
+	c.BoardTemplateHandler.SetStarLookup(c.StarRepo)
+
 	c.Logger.Debug("board template handler initialized")
 }
 
@@ -1208,7 +2223,7 @@ func (c *Container) setupBoardTemplateHandler() {
 func (c *Container) createBoardChatCreator() httphandler.BoardChatCreator {
 	return &boardChatCreatorAdapter{
 		createUC:      chatapp.NewCreateChatUseCase(c.ChatRepo),
-		setPriorityUC: chatapp.NewSetPriorityUseCase(c.ChatRepo),
+		setPriorityUC: chatapp.NewSetPriorityUseCase(c.ChatRepo, c.valuePolicyProvider()),
 		assignUserUC:  chatapp.NewAssignUserUseCase(c.ChatRepo, c.UserRepo),
 		setDueDateUC:  chatapp.NewSetDueDateUseCase(c.ChatRepo),
 		taskProjector: c.getTaskReadModelProjector(),
@@ -1377,8 +2392,44 @@ func (a *boardChatCreatorAdapter) enqueueProjectionRepair(chatID uuid.UUID, proj
 	}
 }
 
-// createBoardTaskService creates a service implementing BoardTaskService.
-func (c *Container) createBoardTaskService() httphandler.BoardTaskService {
+// createInboxChatCreator creates the chat creator used to promote inbox items.
+func (c *Container) createInboxChatCreator() inboxapp.ChatCreator {
+	return &inboxChatCreatorAdapter{
+		board: &boardChatCreatorAdapter{
+			createUC:      chatapp.NewCreateChatUseCase(c.ChatRepo),
+			taskProjector: c.getTaskReadModelProjector(),
+			repairQueue:   c.RepairQueue,
+			logger:        c.Logger,
+		},
+	}
+}
+
+// inboxChatCreatorAdapter creates the chats inbox items are promoted to and,
+// like the board, projects typed chats into the task read model right away.
+type inboxChatCreatorAdapter struct {
+	board *boardChatCreatorAdapter
+}
+
+// Execute implements inboxapp.ChatCreator.
+func (a *inboxChatCreatorAdapter) Execute(ctx context.Context, cmd chatapp.CreateChatCommand) (chatapp.Result, error) {
+	result, err := a.board.createUC.Execute(ctx, cmd)
+	if err != nil || result.Value == nil || cmd.Type == chat.TypeDiscussion || a.board.taskProjector == nil {
+		return result, err
+	}
+
+	// The chat is saved; a failed projection is queued for repair instead of failing the promotion
+	if projectionErr := a.board.syncTaskProjection(result.Value.ID()); projectionErr != nil {
+		a.board.logger.WarnContext(ctx, "promoted inbox item before its task was projected",
+			slog.String("chat_id", result.Value.ID().String()),
+			slog.String("error", projectionErr.Error()),
+		)
+	}
+	return result, nil
+}
+
+// createBoardTaskService creates a service implementing BoardTaskService and
+// TaskStreamer.
+func (c *Container) createBoardTaskService() *boardTaskServiceAdapter {
 	return &boardTaskServiceAdapter{
 		collection:     c.MongoDB.Database(c.MongoDBName).Collection(mongodbinfra.CollectionTaskReadModel),
 		chatCollection: c.MongoDB.Database(c.MongoDBName).Collection(mongodbinfra.CollectionChatReadModel),
@@ -1484,6 +2535,57 @@ func (a *boardTaskServiceAdapter) queryTasks(
 	return results, nil
 }
 
+// StreamTasks implements httphandler.TaskStreamer. It ignores Limit and Offset
+// and resumes behind the task after, ordering tasks oldest first by creation time
+// and ID.
+func (a *boardTaskServiceAdapter) StreamTasks(
+	ctx context.Context,
+	filters taskapp.Filters,
+	after uuid.UUID,
+	fn func(*taskapp.ReadModel) error,
+) error {
+	if a.collection == nil {
+		return nil
+	}
+
+	filter := a.buildFilter(filters)
+	if err := a.applyWorkspaceScope(ctx, filter, filters.WorkspaceID); err != nil {
+		return err
+	}
+	if !after.IsZero() {
+		var last taskReadModelDoc
+		if err := a.collection.FindOne(ctx, bson.M{"task_id": after.String()}).Decode(&last); err != nil {
+			if errors.Is(err, mongo.ErrNoDocuments) {
+				return domainerrs.ErrNotFound
+			}
+			return err
+		}
+		filter = bson.M{"$and": bson.A{filter, bson.M{"$or": bson.A{
+			bson.M{"created_at": bson.M{"$gt": last.CreatedAt}},
+			bson.M{"created_at": last.CreatedAt, "task_id": bson.M{"$gt": last.ID}},
+		}}}}
+	}
+
+	opts := options.Find().SetSort(bson.D{{Key: "created_at", Value: 1}, {Key: "task_id", Value: 1}})
+	cursor, err := a.collection.Find(ctx, filter, opts)
+	if err != nil {
+		return err
+	}
+	defer cursor.Close(ctx)
+
+	for cursor.Next(ctx) {
+		var doc taskReadModelDoc
+		if decodeErr := cursor.Decode(&doc); decodeErr != nil {
+			continue
+		}
+		if fnErr := fn(doc.toReadModel()); fnErr != nil {
+			return fnErr
+		}
+	}
+
+	return cursor.Err()
+}
+
 // applyWorkspaceScope adds workspace filtering using chats_read_model linkage.
 func (a *boardTaskServiceAdapter) applyWorkspaceScope(
 	ctx context.Context,
@@ -1559,6 +2661,29 @@ func (a *boardTaskServiceAdapter) buildFilter(filters taskapp.Filters) map[strin
 	if filters.ChatID != nil {
 		filter["chat_id"] = filters.ChatID.String()
 	}
+	if filters.Sprint != "" {
+		filter["sprint"] = filters.Sprint
+	}
+	if filters.EpicID != nil {
+		filter["epic_id"] = filters.EpicID.String()
+	}
+	if filters.IDs != nil {
+		ids := make([]string, len(filters.IDs))
+		for i, id := range filters.IDs {
+			ids[i] = id.String()
+		}
+		filter["task_id"] = bson.M{"$in": ids}
+	}
+	if filters.AwaitingTriage {
+		// Bugs in status New are projected as To Do; nil matches both null and missing fields
+		filter["entity_type"] = string(taskdomain.TypeBug)
+		filter["status"] = string(taskdomain.StatusToDo)
+		filter["duplicate_of"] = nil
+		filter["$or"] = bson.A{
+			bson.M{"severity": bson.M{"$in": bson.A{nil, ""}}},
+			bson.M{"assigned_to": nil},
+		}
+	}
 
 	return filter
 }
@@ -1567,17 +2692,39 @@ func (a *boardTaskServiceAdapter) buildFilter(filters taskapp.Filters) map[strin
 type taskReadModelDoc struct {
 	ID          string                       `bson:"task_id"`
 	ChatID      string                       `bson:"chat_id"`
+	WorkspaceID string                       `bson:"workspace_id,omitempty"`
 	Title       string                       `bson:"title"`
 	EntityType  string                       `bson:"entity_type"`
 	Status      string                       `bson:"status"`
 	Priority    string                       `bson:"priority"`
 	Severity    string                       `bson:"severity,omitempty"`
+	Estimate    *taskEstimateReadModelDoc    `bson:"estimate,omitempty"`
+	Sprint      string                       `bson:"sprint,omitempty"`
+	DuplicateOf *string                      `bson:"duplicate_of,omitempty"`
+	EpicID      *string                      `bson:"epic_id,omitempty"`
 	AssignedTo  *string                      `bson:"assigned_to,omitempty"`
 	DueDate     *time.Time                   `bson:"due_date,omitempty"`
 	CreatedBy   string                       `bson:"created_by"`
 	CreatedAt   time.Time                    `bson:"created_at"`
 	Version     int                          `bson:"version"`
 	Attachments []taskAttachmentReadModelDoc `bson:"attachments,omitempty"`
+
+	Checklist        []taskChecklistItemReadModelDoc `bson:"checklist,omitempty"`
+	ChecklistTotal   int                             `bson:"checklist_total,omitempty"`
+	ChecklistDone    int                             `bson:"checklist_done,omitempty"`
+	ChecklistPercent int                             `bson:"checklist_percent,omitempty"`
+
+	SLA *taskSLAReadModelDoc `bson:"sla,omitempty"`
+
+	AssigneeUsername    string `bson:"assignee_username,omitempty"`
+	AssigneeDisplayName string `bson:"assignee_display_name,omitempty"`
+	CreatorUsername     string `bson:"created_by_username,omitempty"`
+	CreatorDisplayName  string `bson:"created_by_display_name,omitempty"`
+}
+
+type taskEstimateReadModelDoc struct {
+	Value float64 `bson:"value"`
+	Unit  string  `bson:"unit"`
 }
 
 type taskAttachmentReadModelDoc struct {
@@ -1587,24 +2734,49 @@ type taskAttachmentReadModelDoc struct {
 	MimeType string `bson:"mime_type"`
 }
 
+type taskSLAReadModelDoc struct {
+	OpenedAt    time.Time  `bson:"opened_at"`
+	RespondedAt *time.Time `bson:"responded_at,omitempty"`
+	ResolvedAt  *time.Time `bson:"resolved_at,omitempty"`
+}
+
+type taskChecklistItemReadModelDoc struct {
+	ItemID   string `bson:"item_id"`
+	Text     string `bson:"text"`
+	Done     bool   `bson:"done"`
+	Position int    `bson:"position"`
+}
+
 // toReadModel converts the document to a ReadModel.
 func (d *taskReadModelDoc) toReadModel() *taskapp.ReadModel {
 	id, _ := uuid.ParseUUID(d.ID)
 	chatID, _ := uuid.ParseUUID(d.ChatID)
 	createdBy, _ := uuid.ParseUUID(d.CreatedBy)
+	workspaceID, _ := uuid.ParseUUID(d.WorkspaceID)
 
 	model := &taskapp.ReadModel{
-		ID:         id,
-		ChatID:     chatID,
-		Title:      d.Title,
-		EntityType: taskdomain.EntityType(d.EntityType),
-		Status:     taskdomain.Status(d.Status),
-		Priority:   taskdomain.Priority(d.Priority),
-		Severity:   d.Severity,
-		DueDate:    d.DueDate,
-		CreatedBy:  createdBy,
-		CreatedAt:  d.CreatedAt,
-		Version:    d.Version,
+		ID:          id,
+		ChatID:      chatID,
+		WorkspaceID: workspaceID,
+		Title:       d.Title,
+		EntityType:  taskdomain.EntityType(d.EntityType),
+		Status:      taskdomain.Status(d.Status),
+		Priority:    taskdomain.Priority(d.Priority),
+		Severity:    d.Severity,
+		Sprint:      d.Sprint,
+		DueDate:     d.DueDate,
+		CreatedBy:   createdBy,
+		CreatedAt:   d.CreatedAt,
+		Version:     d.Version,
+
+		ChecklistTotal:   d.ChecklistTotal,
+		ChecklistDone:    d.ChecklistDone,
+		ChecklistPercent: d.ChecklistPercent,
+
+		AssigneeUsername:    d.AssigneeUsername,
+		AssigneeDisplayName: d.AssigneeDisplayName,
+		CreatorUsername:     d.CreatorUsername,
+		CreatorDisplayName:  d.CreatorDisplayName,
 	}
 
 	if d.AssignedTo != nil {
@@ -1612,6 +2784,30 @@ func (d *taskReadModelDoc) toReadModel() *taskapp.ReadModel {
 		model.AssignedTo = &assignedTo
 	}
 
+	if d.DuplicateOf != nil {
+		if original, err := uuid.ParseUUID(*d.DuplicateOf); err == nil {
+			model.DuplicateOf = &original
+		}
+	}
+
+	if d.EpicID != nil {
+		if epicID, err := uuid.ParseUUID(*d.EpicID); err == nil {
+			model.EpicID = &epicID
+		}
+	}
+
+	if d.Estimate != nil {
+		model.Estimate = &taskapp.EstimateReadModel{Value: d.Estimate.Value, Unit: d.Estimate.Unit}
+	}
+
+	if d.SLA != nil {
+		model.SLA = &taskapp.SLAReadModel{
+			OpenedAt:    d.SLA.OpenedAt,
+			RespondedAt: d.SLA.RespondedAt,
+			ResolvedAt:  d.SLA.ResolvedAt,
+		}
+	}
+
 	for _, att := range d.Attachments {
 		attachmentID, parseErr := uuid.ParseUUID(att.FileID)
 		if parseErr != nil {
@@ -1625,6 +2821,19 @@ func (d *taskReadModelDoc) toReadModel() *taskapp.ReadModel {
 		})
 	}
 
+	for _, item := range d.Checklist {
+		itemID, parseErr := uuid.ParseUUID(item.ItemID)
+		if parseErr != nil {
+			continue
+		}
+		model.Checklist = append(model.Checklist, taskapp.ChecklistItemReadModel{
+			ID:       itemID,
+			Text:     item.Text,
+			Done:     item.Done,
+			Position: item.Position,
+		})
+	}
+
 	return model
 }
 
@@ -1664,6 +2873,10 @@ func (a *chatBasicInfoServiceAdapter) GetChatBasicInfo(
 // createFullTaskService creates a service implementing httphandler.TaskService.
 func (c *Container) createFullTaskService() httphandler.TaskService {
 	taskReadModelColl := c.MongoDB.Database(c.MongoDBName).Collection(mongodbinfra.CollectionTaskReadModel)
+	addAttachmentUC := chatapp.NewAddAttachmentUseCase(
+		c.ChatRepo,
+		chatapp.WithAttachmentQuota(c.Config.Uploads.TaskAttachmentQuota),
+	)
 
 	return &fullTaskServiceAdapter{
 		boardTaskServiceAdapter: boardTaskServiceAdapter{
@@ -1678,10 +2891,14 @@ func (c *Container) createFullTaskService() httphandler.TaskService {
 		convertToEpicUC:    chatapp.NewConvertToEpicUseCase(c.ChatRepo),
 		changeStatusUC:     chatapp.NewChangeStatusUseCase(c.ChatRepo),
 		assignUserUC:       chatapp.NewAssignUserUseCase(c.ChatRepo, c.UserRepo),
-		setPriorityUC:      chatapp.NewSetPriorityUseCase(c.ChatRepo),
+		setPriorityUC:      chatapp.NewSetPriorityUseCase(c.ChatRepo, c.valuePolicyProvider()),
 		setDueDateUC:       chatapp.NewSetDueDateUseCase(c.ChatRepo),
-		addAttachmentUC:    chatapp.NewAddAttachmentUseCase(c.ChatRepo),
+		addAttachmentUC:    addAttachmentUC,
 		removeAttachmentUC: chatapp.NewRemoveAttachmentUseCase(c.ChatRepo),
+		addChecklistItemUC: chatapp.NewAddChecklistItemUseCase(c.ChatRepo),
+		toggleChecklistUC:  chatapp.NewToggleChecklistItemUseCase(c.ChatRepo),
+		reorderChecklistUC: chatapp.NewReorderChecklistItemUseCase(c.ChatRepo),
+		setEpicUC:          chatapp.NewSetEpicUseCase(c.ChatRepo),
 	}
 }
 
@@ -1703,6 +2920,10 @@ type fullTaskServiceAdapter struct {
 	setDueDateUC       *chatapp.SetDueDateUseCase
 	addAttachmentUC    *chatapp.AddAttachmentUseCase
 	removeAttachmentUC *chatapp.RemoveAttachmentUseCase
+	addChecklistItemUC *chatapp.AddChecklistItemUseCase
+	toggleChecklistUC  *chatapp.ToggleChecklistItemUseCase
+	reorderChecklistUC *chatapp.ReorderChecklistItemUseCase
+	setEpicUC          *chatapp.SetEpicUseCase
 }
 
 // CreateTask implements httphandler.TaskService.
@@ -1936,6 +3157,92 @@ func (a *fullTaskServiceAdapter) RemoveAttachment(
 	return taskapp.NewSuccessResult(cmd.TaskID, result.Version), nil
 }
 
+// AddChecklistItem implements httphandler.TaskService.
+func (a *fullTaskServiceAdapter) AddChecklistItem(
+	ctx context.Context,
+	cmd taskapp.AddChecklistItemCommand,
+) (taskapp.TaskResult, error) {
+	result, err := a.addChecklistItemUC.Execute(ctx, chatapp.AddChecklistItemCommand{
+		ChatID:  cmd.TaskID,
+		Text:    cmd.Text,
+		AddedBy: cmd.AddedBy,
+	})
+	if err != nil {
+		return taskapp.TaskResult{}, mapTaskWriteError(err)
+	}
+
+	if rebuildErr := a.syncTaskProjection(ctx, cmd.TaskID); rebuildErr != nil {
+		return taskapp.TaskResult{}, rebuildErr
+	}
+
+	return taskapp.NewSuccessResult(cmd.TaskID, result.Version), nil
+}
+
+// ToggleChecklistItem implements httphandler.TaskService.
+func (a *fullTaskServiceAdapter) ToggleChecklistItem(
+	ctx context.Context,
+	cmd taskapp.ToggleChecklistItemCommand,
+) (taskapp.TaskResult, error) {
+	result, err := a.toggleChecklistUC.Execute(ctx, chatapp.ToggleChecklistItemCommand{
+		ChatID:    cmd.TaskID,
+		ItemID:    cmd.ItemID,
+		Done:      cmd.Done,
+		ToggledBy: cmd.ToggledBy,
+	})
+	if err != nil {
+		return taskapp.TaskResult{}, mapTaskWriteError(err)
+	}
+
+	if rebuildErr := a.syncTaskProjection(ctx, cmd.TaskID); rebuildErr != nil {
+		return taskapp.TaskResult{}, rebuildErr
+	}
+
+	return taskapp.NewSuccessResult(cmd.TaskID, result.Version), nil
+}
+
+// ReorderChecklistItem implements httphandler.TaskService.
+func (a *fullTaskServiceAdapter) ReorderChecklistItem(
+	ctx context.Context,
+	cmd taskapp.ReorderChecklistItemCommand,
+) (taskapp.TaskResult, error) {
+	result, err := a.reorderChecklistUC.Execute(ctx, chatapp.ReorderChecklistItemCommand{
+		ChatID:      cmd.TaskID,
+		ItemID:      cmd.ItemID,
+		Position:    cmd.Position,
+		ReorderedBy: cmd.ReorderedBy,
+	})
+	if err != nil {
+		return taskapp.TaskResult{}, mapTaskWriteError(err)
+	}
+
+	if rebuildErr := a.syncTaskProjection(ctx, cmd.TaskID); rebuildErr != nil {
+		return taskapp.TaskResult{}, rebuildErr
+	}
+
+	return taskapp.NewSuccessResult(cmd.TaskID, result.Version), nil
+}
+
+// SetEpic implements httphandler.TaskService.
+func (a *fullTaskServiceAdapter) SetEpic(
+	ctx context.Context,
+	cmd taskapp.SetEpicCommand,
+) (taskapp.TaskResult, error) {
+	result, err := a.setEpicUC.Execute(ctx, chatapp.SetEpicCommand{
+		ChatID: cmd.TaskID,
+		EpicID: cmd.EpicID,
+		SetBy:  cmd.SetBy,
+	})
+	if err != nil {
+		return taskapp.TaskResult{}, mapTaskWriteError(err)
+	}
+
+	if rebuildErr := a.syncTaskProjection(ctx, cmd.TaskID); rebuildErr != nil {
+		return taskapp.TaskResult{}, rebuildErr
+	}
+
+	return taskapp.NewSuccessResult(cmd.TaskID, result.Version), nil
+}
+
 func (a *fullTaskServiceAdapter) syncTaskProjection(ctx context.Context, chatID uuid.UUID) error {
 	if a.taskProjector == nil {
 		return nil
@@ -1944,6 +3251,18 @@ func (a *fullTaskServiceAdapter) syncTaskProjection(ctx context.Context, chatID
 }
 
 func mapTaskWriteError(err error) error {
+	if errors.Is(err, chatapp.ErrAttachmentQuotaExceeded) {
+		return taskapp.ErrAttachmentQuotaExceeded
+	}
+	if errors.Is(err, chatapp.ErrChecklistItemNotFound) {
+		return taskapp.ErrChecklistItemNotFound
+	}
+	if errors.Is(err, chatapp.ErrEpicNotFound) {
+		return taskapp.ErrEpicNotFound
+	}
+	if errors.Is(err, chatapp.ErrInvalidHierarchy) {
+		return taskapp.ErrInvalidHierarchy
+	}
 	if errors.Is(err, domainerrs.ErrNotFound) {
 		return taskapp.ErrTaskNotFound
 	}
@@ -1958,6 +3277,7 @@ func (c *Container) createBoardMemberService() httphandler.BoardMemberService {
 	return &boardMemberServiceAdapter{
 		memberService: c.MemberService,
 		userRepo:      c.UserRepo,
+		logger:        c.Logger,
 	}
 }
 
@@ -1965,6 +3285,7 @@ func (c *Container) createBoardMemberService() httphandler.BoardMemberService {
 type boardMemberServiceAdapter struct {
 	memberService *service.MemberService
 	userRepo      *mongodb.MongoUserRepository
+	logger        *slog.Logger
 }
 
 // ListWorkspaceMembers implements BoardMemberService.
@@ -1981,6 +3302,7 @@ func (a *boardMemberServiceAdapter) ListWorkspaceMembers(
 		return nil, err
 	}
 
+	users := a.lookupUsers(ctx, members)
 	result := make([]httphandler.MemberViewData, 0, len(members))
 	for _, m := range members {
 		mv := httphandler.MemberViewData{
@@ -1988,11 +3310,9 @@ func (a *boardMemberServiceAdapter) ListWorkspaceMembers(
 			Role:     m.Role().String(),
 			JoinedAt: m.JoinedAt(),
 		}
-		if a.userRepo != nil {
-			if u, lookupErr := a.userRepo.FindByID(ctx, m.UserID()); lookupErr == nil && u != nil {
-				mv.Username = u.Username()
-				mv.DisplayName = u.DisplayName()
-			}
+		if u, ok := users[m.UserID()]; ok {
+			mv.Username = u.Username()
+			mv.DisplayName = u.DisplayName()
 		}
 		if mv.Username == "" {
 			mv.Username = "user" + m.UserID().String()[:8]
@@ -2003,6 +3323,34 @@ func (a *boardMemberServiceAdapter) ListWorkspaceMembers(
 	return result, nil
 }
 
+// lookupUsers loads the profiles of all members with one query.
+// Lookup failures are logged; members then fall back to generated names.
+func (a *boardMemberServiceAdapter) lookupUsers(
+	ctx context.Context,
+	members []*workspacedomain.Member,
+) map[uuid.UUID]*userdomain.User {
+	users := make(map[uuid.UUID]*userdomain.User, len(members))
+	if a.userRepo == nil || len(members) == 0 {
+		return users
+	}
+
+	ids := make([]uuid.UUID, 0, len(members))
+	for _, m := range members {
+		ids = append(ids, m.UserID())
+	}
+	found, err := a.userRepo.FindByIDs(ctx, ids)
+	if err != nil {
+		if a.logger != nil {
+			a.logger.WarnContext(ctx, "failed to look up board members", slog.String("error", err.Error()))
+		}
+		return users
+	}
+	for _, u := range found {
+		users[u.ID()] = u
+	}
+	return users
+}
+
 // setupTaskDetailTemplateHandler creates the task detail template handler with all dependencies.
 func (c *Container) setupTaskDetailTemplateHandler() {
 	// Create task detail service adapter
@@ -2021,6 +3369,8 @@ func (c *Container) setupTaskDetailTemplateHandler() {
 		chatInfoService,
 		c.createUserLookupService(),
 	)
+	c.TaskDetailTemplateHandler.SetBacklinkService(c.TaskLinkRepo)
+	c.TaskDetailTemplateHandler.SetWorkspaceLookup(c.WorkspaceService)
 
 	c.Logger.Debug("task detail template handler initialized")
 }
@@ -2032,12 +3382,19 @@ func (c *Container) setupMessageHandler() {
 		service.WithListMessagesUseCase(c.ListMessagesUC),
 		service.WithEditMessageUseCase(c.EditMessageUC),
 		service.WithDeleteMessageUseCase(c.DeleteMessageUC),
+		service.WithUndoMessageChangeUseCase(c.UndoMessageChangeUC),
 		service.WithGetMessageUseCase(c.GetMessageUC),
 		service.WithAddReactionUseCase(c.AddReactionUC),
 		service.WithRemoveReactionUseCase(c.RemoveReactionUC),
 		service.WithAddAttachmentUseCase(c.AddAttachmentUC),
+		service.WithForwardMessageUseCase(c.ForwardMessageUC),
+		service.WithPollUseCases(c.CreatePollUC, c.VotePollUC, c.RetractPollVoteUC, c.ClosePollUC),
+	)
+	c.MessageHandler = httphandler.NewMessageHandler(
+		c.MessageService,
+		httphandler.WithMessageModeration(&chatModerationAdapter{chatQueryRepo: c.ChatQueryRepo}),
+		httphandler.WithMessageStreamer(c.MessageRepo),
 	)
-	c.MessageHandler = httphandler.NewMessageHandler(c.MessageService)
 
 	uploadDir := c.Config.Uploads.Dir
 	if uploadDir == "" {
@@ -2051,16 +3408,131 @@ func (c *Container) setupMessageHandler() {
 			c.MongoDB.Database(c.MongoDBName).Collection("file_metadata"),
 			mongodb.WithFileMetadataRepoLogger(c.Logger),
 		)
+		fileOpts := []httphandler.FileHandlerOption{
+			httphandler.WithMaxFileSize(c.Config.Uploads.MaxFileSize),
+			httphandler.WithFileStorageQuota(c.StorageQuota, &chatWorkspaceAdapter{chatRepo: c.ChatQueryRepo}),
+		}
+		if c.BlobBulkhead != nil {
+			fileOpts = append(fileOpts, httphandler.WithFileStorageGuard(c.BlobBulkhead))
+		}
 		c.FileHandler = httphandler.NewFileHandler(
 			fileStorage,
 			&fileMetadataAdapter{repo: fileMetadataRepo},
 			&fileChatParticipantAdapter{chatQueryRepo: c.ChatQueryRepo},
-			httphandler.WithMaxFileSize(c.Config.Uploads.MaxFileSize),
+			fileOpts...,
+		)
+
+		var brandingOpts []httphandler.WorkspaceBrandingHandlerOption
+		if c.BlobBulkhead != nil {
+			brandingOpts = append(brandingOpts, httphandler.WithBrandingStorageGuard(c.BlobBulkhead))
+		}
+		c.WorkspaceBrandingHandler = httphandler.NewWorkspaceBrandingHandler(
+			c.WorkspaceService,
+			fileStorage,
+			brandingOpts...,
+		)
+
+		taskAttachmentOpts := []httphandler.TaskAttachmentHandlerOption{
+			httphandler.WithTaskAttachmentMaxFileSize(c.Config.Uploads.MaxFileSize),
+			httphandler.WithTaskAttachmentQuota(c.Config.Uploads.TaskAttachmentQuota),
+			httphandler.WithTaskAttachmentStorageQuota(c.StorageQuota),
+		}
+		if c.BlobBulkhead != nil {
+			taskAttachmentOpts = append(taskAttachmentOpts, httphandler.WithTaskAttachmentStorageGuard(c.BlobBulkhead))
+		}
+		c.TaskAttachmentHandler = httphandler.NewTaskAttachmentHandler(
+			c.createFullTaskService(),
+			fileStorage,
+			&fileMetadataAdapter{repo: fileMetadataRepo},
+			taskAttachmentOpts...,
 		)
+
+		if c.Config.Inbound.Enabled {
+			c.setupInboundEmailHandler(fileStorage, &fileMetadataAdapter{repo: fileMetadataRepo})
+		}
+		if c.Config.Storage.Enabled {
+			c.setupChatAttachmentHandler(&fileMetadataAdapter{repo: fileMetadataRepo})
+		}
 	}
 	c.Logger.Debug("message service and handler initialized (real)")
 }
 
+// setupChatAttachmentHandler initializes chat attachment uploads to S3-compatible
+// object storage. A broken configuration only disables the endpoints.
+func (c *Container) setupChatAttachmentHandler(metadata *fileMetadataAdapter) {
+	cfg := c.Config.Storage
+	client, err := storage.NewS3Client(storage.S3Config{
+		Endpoint:      cfg.Endpoint,
+		Region:        cfg.Region,
+		Bucket:        cfg.Bucket,
+		AccessKey:     cfg.AccessKey,
+		SecretKey:     cfg.SecretKey,
+		PathStyle:     cfg.PathStyle,
+		PresignExpiry: cfg.PresignExpiry,
+	})
+	if err != nil {
+		c.Logger.Warn("failed to initialize object storage", "error", err)
+		return
+	}
+
+	opts := []httphandler.ChatAttachmentHandlerOption{
+		httphandler.WithChatAttachmentMaxFileSize(cfg.MaxFileSize),
+		httphandler.WithChatAttachmentStorageQuota(c.StorageQuota, &chatWorkspaceAdapter{chatRepo: c.ChatQueryRepo}),
+	}
+	if c.BlobBulkhead != nil {
+		opts = append(opts, httphandler.WithChatAttachmentStorageGuard(c.BlobBulkhead))
+	}
+	c.ChatAttachmentHandler = httphandler.NewChatAttachmentHandler(
+		client,
+		chatapp.NewAddAttachmentUseCase(
+			c.ChatRepo,
+			chatapp.WithAttachmentQuota(c.Config.Uploads.TaskAttachmentQuota),
+		),
+		&fileChatParticipantAdapter{chatQueryRepo: c.ChatQueryRepo},
+		metadata,
+		opts...,
+	)
+	c.Logger.Info("chat attachment object storage enabled", "endpoint", cfg.Endpoint, "bucket", cfg.Bucket)
+}
+
+// setupInboundEmailHandler initializes the gateway that turns emails to
+// workspace addresses into task and bug chats.
+func (c *Container) setupInboundEmailHandler(
+	fileStorage *filestorage.LocalStorage,
+	metadata *fileMetadataAdapter,
+) {
+	store := &inboundAttachmentStore{
+		storage:  fileStorage,
+		metadata: metadata,
+		quota:    c.StorageQuota,
+		chats:    &chatWorkspaceAdapter{chatRepo: c.ChatQueryRepo},
+	}
+	if c.BlobBulkhead != nil {
+		store.guard = c.BlobBulkhead
+	}
+	var opts []inbound.IngestEmailOption
+	if c.Config.RepliesEnabled() {
+		opts = append(opts, inbound.WithReplyAddresses(
+			inbound.NewReplyAddresses(c.Config.Inbound.Domain, c.Config.Email.ReplyKey)))
+	}
+	ingest := inbound.NewIngestEmailUseCase(
+		c.Config.Inbound.Domain,
+		c.UserRepo,
+		c.WorkspaceRepo,
+		chatapp.NewCreateChatUseCase(c.ChatRepo),
+		c.SendMessageUC,
+		c.AddAttachmentUC,
+		store,
+		opts...,
+	)
+	c.InboundEmailHandler = httphandler.NewInboundEmailHandler(
+		ingest,
+		c.Config.Inbound.Secret,
+		c.Config.Inbound.MaxSize,
+	)
+	c.Logger.Info("inbound email gateway enabled", slog.String("domain", c.Config.Inbound.Domain))
+}
+
 // createTaskDetailService creates a service implementing TaskDetailService.
 // Reuses the boardTaskServiceAdapter since both interfaces require the same GetTask method.
 func (c *Container) createTaskDetailService() httphandler.TaskDetailService {
@@ -2121,16 +3593,428 @@ func (a *userLookupAdapter) GetDisplayName(ctx context.Context, userID string) s
 	return u.Username()
 }
 
-// userDisplayNameAdapter adapts MongoUserRepository to messageapp.UserDisplayNameResolver.
-type userDisplayNameAdapter struct {
-	userRepo *mongodb.MongoUserRepository
+// valuePolicyProvider creates the workspace value policy lookup for chat use cases.
+func (c *Container) valuePolicyProvider() chatapp.ValuePolicyProvider {
+	return &workspaceValuePolicyAdapter{workspaceRepo: c.WorkspaceRepo}
 }
 
-// GetDisplayName implements messageapp.UserDisplayNameResolver.
-func (a *userDisplayNameAdapter) GetDisplayName(ctx context.Context, userID uuid.UUID) (string, error) {
-	u, err := a.userRepo.FindByID(ctx, userID)
-	if err != nil {
-		return "", err
+// workspaceValuePolicyAdapter adapts MongoWorkspaceRepository to chatapp.ValuePolicyProvider.
+type workspaceValuePolicyAdapter struct {
+	workspaceRepo *mongodb.MongoWorkspaceRepository
+}
+
+// GetValuePolicy implements chatapp.ValuePolicyProvider.
+func (a *workspaceValuePolicyAdapter) GetValuePolicy(
+	ctx context.Context,
+	workspaceID uuid.UUID,
+) (workspacedomain.ValuePolicy, error) {
+	ws, err := a.workspaceRepo.FindByID(ctx, workspaceID)
+	if err != nil {
+		return workspacedomain.ValuePolicy{}, err
+	}
+	return ws.ValuePolicy(), nil
+}
+
+// chatWorkspaceAdapter adapts MongoChatReadModelRepository to analytics.ChatWorkspaceResolver,
+// metrics.ChatWorkspaceResolver and mailer.ChatWorkspaceResolver.
+type chatWorkspaceAdapter struct {
+	chatRepo *mongodb.MongoChatReadModelRepository
+}
+
+// ChatWorkspaceID returns the workspace of the chat.
+func (a *chatWorkspaceAdapter) ChatWorkspaceID(ctx context.Context, chatID uuid.UUID) (uuid.UUID, error) {
+	readModel, err := a.chatRepo.FindByID(ctx, chatID)
+	if err != nil {
+		return "", err
+	}
+	return readModel.WorkspaceID, nil
+}
+
+// messageChatAdapter adapts MongoMessageRepository to mailer.MessageChatResolver.
+type messageChatAdapter struct {
+	messageRepo *mongodb.MongoMessageRepository
+}
+
+// MessageChatID returns the chat the message was posted in.
+func (a *messageChatAdapter) MessageChatID(ctx context.Context, messageID uuid.UUID) (uuid.UUID, error) {
+	msg, err := a.messageRepo.FindByID(ctx, messageID)
+	if err != nil {
+		return "", err
+	}
+	return msg.ChatID(), nil
+}
+
+// chatAudienceAdapter adapts the chat read model and workspace repositories to
+// websocket.ChatAudienceResolver.
+type chatAudienceAdapter struct {
+	chatRepo      *mongodb.MongoChatReadModelRepository
+	workspaceRepo *mongodb.MongoWorkspaceRepository
+}
+
+// ChatAudience returns the workspace and participants of the chat.
+func (a *chatAudienceAdapter) ChatAudience(ctx context.Context, chatID uuid.UUID) (websocket.ChatAudience, error) {
+	readModel, err := a.chatRepo.FindByID(ctx, chatID)
+	if err != nil {
+		return websocket.ChatAudience{}, err
+	}
+
+	participants := make([]uuid.UUID, 0, len(readModel.Participants))
+	for _, participant := range readModel.Participants {
+		participants = append(participants, participant.UserID())
+	}
+	return websocket.ChatAudience{
+		WorkspaceID:  readModel.WorkspaceID,
+		IsPublic:     readModel.IsPublic,
+		Participants: participants,
+	}, nil
+}
+
+// IsWorkspaceMember reports whether the user belongs to the workspace.
+func (a *chatAudienceAdapter) IsWorkspaceMember(ctx context.Context, workspaceID, userID uuid.UUID) (bool, error) {
+	member, err := a.workspaceRepo.GetMember(ctx, workspaceID, userID)
+	if err != nil {
+		if errors.Is(err, domainerrs.ErrNotFound) {
+			return false, nil
+		}
+		return false, err
+	}
+	return !member.IsPending(), nil
+}
+
+// workspaceAdminListerPageSize is the page size used to scan workspace members for admins.
+const workspaceAdminListerPageSize = 200
+
+// workspaceAdminListerAdapter adapts the workspace repository to eventbus.WorkspaceAdminLister.
+type workspaceAdminListerAdapter struct {
+	workspaceRepo *mongodb.MongoWorkspaceRepository
+}
+
+// ListAdminIDs returns IDs of the workspace owner and admins.
+func (a *workspaceAdminListerAdapter) ListAdminIDs(ctx context.Context, workspaceID uuid.UUID) ([]uuid.UUID, error) {
+	var adminIDs []uuid.UUID
+	for offset := 0; ; offset += workspaceAdminListerPageSize {
+		members, err := a.workspaceRepo.ListMembers(ctx, workspaceID, offset, workspaceAdminListerPageSize)
+		if err != nil {
+			return nil, err
+		}
+		for _, member := range members {
+			if member.IsAdmin() {
+				adminIDs = append(adminIDs, member.UserID())
+			}
+		}
+		if len(members) < workspaceAdminListerPageSize {
+			return adminIDs, nil
+		}
+	}
+}
+
+// adminDashboardSources collects the data sources of the admin dashboard.
+func (c *Container) adminDashboardSources() httphandler.AdminDashboardSources {
+	sources := httphandler.AdminDashboardSources{
+		Workspaces:   c.WorkspaceRepo,
+		Users:        c.UserRepo,
+		HealthChecks: c.healthCheckers(),
+	}
+	if c.Outbox != nil {
+		sources.Outbox = c.Outbox
+	}
+	if c.DeadLetterHandler != nil {
+		sources.DeadLetters = &adminDeadLetterAdapter{handler: c.DeadLetterHandler}
+	}
+	if c.RepairQueue != nil {
+		sources.Repair = &adminRepairStatsAdapter{queue: c.RepairQueue}
+	}
+	if c.WorkspaceLimitLog != nil {
+		sources.RateLimits = c.WorkspaceLimitLog
+	}
+	return sources
+}
+
+// maintenanceStore returns the Redis-backed maintenance store, or nil without Redis.
+func (c *Container) maintenanceStore() middleware.MaintenanceStore {
+	if c.Redis == nil {
+		return nil
+	}
+	return middleware.NewRedisMaintenanceStore(&maintenanceRedisAdapter{client: c.Redis}, "")
+}
+
+// featureFlagStore returns the Redis-backed flag store, or an in-memory store without Redis.
+func (c *Container) featureFlagStore() featureflag.Store {
+	if c.Redis == nil {
+		return featureflag.NewMemoryStore()
+	}
+	return featureflag.NewRedisStore(&featureFlagRedisAdapter{client: c.Redis}, "")
+}
+
+// maintenanceMiddleware builds the maintenance middleware, or nil if the switch is not initialized.
+func (c *Container) maintenanceMiddleware() echo.MiddlewareFunc {
+	if c.Maintenance == nil {
+		return nil
+	}
+	config := middleware.DefaultMaintenanceConfig()
+	config.Logger = c.Logger
+	config.Checker = c.Maintenance
+	config.TokenValidator = c.TokenValidator
+	config.PageRenderer = httphandler.NewMaintenancePageRenderer(c.TemplateRenderer)
+	return middleware.Maintenance(config)
+}
+
+// readOnlyMiddleware builds the read-only middleware, or nil unless read-only mode is enabled.
+func (c *Container) readOnlyMiddleware() echo.MiddlewareFunc {
+	if !c.Config.ReadOnly.Enabled {
+		return nil
+	}
+	config := middleware.DefaultReadOnlyConfig()
+	config.Logger = c.Logger
+	if c.Config.ReadOnly.Message != "" {
+		config.Message = c.Config.ReadOnly.Message
+	}
+	return middleware.ReadOnly(config)
+}
+
+// timeoutMiddleware builds the request deadline middleware from server.request_timeouts.
+// Exports, uploads, downloads, imports and reports get the long deadline; the WebSocket
+// connection gets none.
+func (c *Container) timeoutMiddleware() echo.MiddlewareFunc {
+	timeouts := c.Config.Server.RequestTimeouts
+	long := timeouts.Long
+	config := middleware.TimeoutConfig{
+		Logger:    c.Logger,
+		Read:      timeouts.Read,
+		Write:     timeouts.Write,
+		APIPrefix: "/api/v1",
+		Rules: []middleware.TimeoutRule{
+			{Route: "/api/v1/ws"},
+			{Route: "/api/v1/files/upload", Timeout: long, ExtendConnDeadlines: true},
+			{Route: "/api/v1/files/*/*", Timeout: long, ExtendConnDeadlines: true},
+			{Route: "/api/v1/workspaces/*/tasks/*/attachments/upload", Timeout: long, ExtendConnDeadlines: true},
+			{Route: "/api/v1/chats/*/attachments", Timeout: long, ExtendConnDeadlines: true},
+			{Route: "/api/v1/workspaces/*/branding/avatar", Timeout: long, ExtendConnDeadlines: true},
+			{Route: "/api/v1/workspaces/*/reports/*", Timeout: long},
+			{Route: "/api/v1/workspaces/*/chats/*/messages/export", Timeout: long, ExtendConnDeadlines: true},
+			{Route: "/api/v1/workspaces/*/chats/*/exports/*/download", Timeout: long, ExtendConnDeadlines: true},
+			{Route: "/api/v1/workspaces/*/tasks/export", Timeout: long, ExtendConnDeadlines: true},
+			{Route: "/api/v1/calendar/*", Timeout: long},
+			{Route: "/api/v1/admin/users/import", Timeout: long, ExtendConnDeadlines: true},
+			{Route: "/api/v1/admin/chats/*/messages/import", Timeout: long, ExtendConnDeadlines: true},
+			{Route: "/api/v1/admin/backups/restore", Timeout: long, ExtendConnDeadlines: true},
+			{Route: "/api/v1/admin/backups/*/download", Timeout: long, ExtendConnDeadlines: true},
+			{Route: "/api/v1/admin/projections/rebuild", Timeout: long},
+		},
+	}
+	if c.TimeoutMetrics != nil {
+		config.Recorder = c.TimeoutMetrics
+	}
+	return middleware.Timeout(config)
+}
+
+// maintenanceRedisAdapter adapts redis.Client to middleware.MaintenanceRedisClient.
+type maintenanceRedisAdapter struct {
+	client *redis.Client
+}
+
+// Get implements middleware.MaintenanceRedisClient.
+func (a *maintenanceRedisAdapter) Get(ctx context.Context, key string) (string, error) {
+	value, err := a.client.Get(ctx, key).Result()
+	if errors.Is(err, redis.Nil) {
+		return "", nil
+	}
+	return value, err
+}
+
+// Set implements middleware.MaintenanceRedisClient.
+func (a *maintenanceRedisAdapter) Set(ctx context.Context, key, value string) error {
+	return a.client.Set(ctx, key, value, 0).Err()
+}
+
+// featureFlagRedisAdapter adapts redis.Client to featureflag.RedisClient.
+type featureFlagRedisAdapter struct {
+	client *redis.Client
+}
+
+// HGetAll implements featureflag.RedisClient.
+func (a *featureFlagRedisAdapter) HGetAll(ctx context.Context, key string) (map[string]string, error) {
+	return a.client.HGetAll(ctx, key).Result()
+}
+
+// HGet implements featureflag.RedisClient.
+func (a *featureFlagRedisAdapter) HGet(ctx context.Context, key, field string) (string, error) {
+	value, err := a.client.HGet(ctx, key, field).Result()
+	if errors.Is(err, redis.Nil) {
+		return "", nil
+	}
+	return value, err
+}
+
+// HSet implements featureflag.RedisClient.
+func (a *featureFlagRedisAdapter) HSet(ctx context.Context, key, field, value string) error {
+	return a.client.HSet(ctx, key, field, value).Err()
+}
+
+// HDel implements featureflag.RedisClient.
+func (a *featureFlagRedisAdapter) HDel(ctx context.Context, key, field string) (int64, error) {
+	return a.client.HDel(ctx, key, field).Result()
+}
+
+// workspaceLimitLog records and lists workspace limit-exceeded events.
+type workspaceLimitLog interface {
+	Record(ctx context.Context, exceeded middleware.WorkspaceLimitExceeded) error
+	Recent(ctx context.Context, limit int) ([]middleware.WorkspaceLimitExceeded, error)
+}
+
+// workspaceRateLimitMiddleware returns the workspace rate limiting middleware, or nil if disabled.
+func (c *Container) workspaceRateLimitMiddleware() echo.MiddlewareFunc {
+	if c.WorkspaceRateLimiter == nil {
+		return nil
+	}
+	return c.WorkspaceRateLimiter.Middleware()
+}
+
+// rateLimitRedisAdapter adapts redis.Client to middleware.RedisClient.
+type rateLimitRedisAdapter struct {
+	client *redis.Client
+}
+
+// Incr implements middleware.RedisClient.
+func (a *rateLimitRedisAdapter) Incr(ctx context.Context, key string) (int64, error) {
+	return a.client.Incr(ctx, key).Result()
+}
+
+// Expire implements middleware.RedisClient.
+func (a *rateLimitRedisAdapter) Expire(ctx context.Context, key string, expiration time.Duration) error {
+	return a.client.Expire(ctx, key, expiration).Err()
+}
+
+// TTL implements middleware.RedisClient.
+func (a *rateLimitRedisAdapter) TTL(ctx context.Context, key string) (time.Duration, error) {
+	return a.client.TTL(ctx, key).Result()
+}
+
+// Get implements middleware.RedisClient.
+func (a *rateLimitRedisAdapter) Get(ctx context.Context, key string) (string, error) {
+	value, err := a.client.Get(ctx, key).Result()
+	if errors.Is(err, redis.Nil) {
+		return "", nil
+	}
+	return value, err
+}
+
+// workspaceLimitRedisAdapter adapts redis.Client to middleware.WorkspaceLimitRedisClient.
+type workspaceLimitRedisAdapter struct {
+	client *redis.Client
+}
+
+// PushCapped implements middleware.WorkspaceLimitRedisClient.
+func (a *workspaceLimitRedisAdapter) PushCapped(ctx context.Context, key, value string, maxLen int64) error {
+	pipe := a.client.TxPipeline()
+	pipe.LPush(ctx, key, value)
+	pipe.LTrim(ctx, key, 0, maxLen-1)
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+// Range implements middleware.WorkspaceLimitRedisClient.
+func (a *workspaceLimitRedisAdapter) Range(ctx context.Context, key string, count int64) ([]string, error) {
+	return a.client.LRange(ctx, key, 0, count-1).Result()
+}
+
+// adminDeadLetterAdapter adapts DeadLetterHandler to httphandler.AdminDeadLetterSource.
+type adminDeadLetterAdapter struct {
+	handler *eventbus.DeadLetterHandler
+}
+
+// QueueLength implements httphandler.AdminDeadLetterSource.
+func (a *adminDeadLetterAdapter) QueueLength(ctx context.Context) (int64, error) {
+	return a.handler.QueueLength(ctx)
+}
+
+// RecentErrors implements httphandler.AdminDeadLetterSource.
+func (a *adminDeadLetterAdapter) RecentErrors(
+	ctx context.Context,
+	limit int,
+) ([]httphandler.AdminErrorViewData, error) {
+	entries, err := a.handler.GetDeadLetters(ctx, int64(limit))
+	if err != nil {
+		return nil, err
+	}
+	errs := make([]httphandler.AdminErrorViewData, 0, len(entries))
+	for _, entry := range entries {
+		errs = append(errs, httphandler.AdminErrorViewData{
+			EventType:   entry.EventType,
+			AggregateID: entry.AggregateID,
+			Error:       entry.Error,
+			FailedAt:    time.Unix(entry.Timestamp, 0),
+		})
+	}
+	return errs, nil
+}
+
+// keycloakIdentityAdapter adapts keycloak.UserClient to userapp.IdentityProvider.
+// Imported users set their password on first login.
+type keycloakIdentityAdapter struct {
+	client *keycloak.UserClient
+}
+
+func (a *keycloakIdentityAdapter) CreateUser(ctx context.Context, username, email, displayName string) (string, error) {
+	firstName, lastName, _ := strings.Cut(displayName, " ")
+	id, err := a.client.CreateUser(ctx, keycloak.NewUser{
+		Username:        username,
+		Email:           email,
+		FirstName:       firstName,
+		LastName:        strings.TrimSpace(lastName),
+		Enabled:         true,
+		RequiredActions: []string{"UPDATE_PASSWORD"},
+	})
+	if errors.Is(err, keycloak.ErrUserExists) {
+		return "", userapp.ErrIdentityAlreadyExists
+	}
+	return id, err
+}
+
+// adminRepairStatsAdapter adapts repair.Queue to httphandler.AdminRepairStats.
+type adminRepairStatsAdapter struct {
+	queue repair.Queue
+}
+
+// RepairStats implements httphandler.AdminRepairStats.
+func (a *adminRepairStatsAdapter) RepairStats(ctx context.Context) (httphandler.AdminRepairViewData, error) {
+	stats, err := a.queue.GetStats(ctx)
+	if err != nil {
+		return httphandler.AdminRepairViewData{}, err
+	}
+	return httphandler.AdminRepairViewData{
+		Pending:    stats.PendingCount,
+		Processing: stats.ProcessingCount,
+		Completed:  stats.CompletedCount,
+		Failed:     stats.FailedCount,
+		Total:      stats.TotalCount,
+	}, nil
+}
+
+// workspaceAnalyticsOptOutAdapter adapts MongoWorkspaceRepository to analytics.OptOutChecker.
+type workspaceAnalyticsOptOutAdapter struct {
+	workspaceRepo *mongodb.MongoWorkspaceRepository
+}
+
+// AnalyticsOptedOut implements analytics.OptOutChecker.
+func (a *workspaceAnalyticsOptOutAdapter) AnalyticsOptedOut(ctx context.Context, workspaceID uuid.UUID) (bool, error) {
+	ws, err := a.workspaceRepo.FindByID(ctx, workspaceID)
+	if err != nil {
+		return false, err
+	}
+	return ws.AnalyticsOptOut(), nil
+}
+
+// userDisplayNameAdapter adapts MongoUserRepository to messageapp.UserDisplayNameResolver.
+type userDisplayNameAdapter struct {
+	userRepo *mongodb.MongoUserRepository
+}
+
+// GetDisplayName implements messageapp.UserDisplayNameResolver.
+func (a *userDisplayNameAdapter) GetDisplayName(ctx context.Context, userID uuid.UUID) (string, error) {
+	u, err := a.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return "", err
 	}
 	if dn := u.DisplayName(); dn != "" {
 		return dn, nil
@@ -2143,6 +4027,14 @@ func (c *Container) createUserProfileLookup() httphandler.UserProfileLookup {
 	return &userProfileLookupAdapter{userRepo: c.UserRepo}
 }
 
+// createTimezoneResolver creates the resolver used by the timezone middleware.
+func (c *Container) createTimezoneResolver() middleware.TimezoneResolver {
+	if c.UserRepo == nil {
+		return nil
+	}
+	return &userProfileLookupAdapter{userRepo: c.UserRepo}
+}
+
 // userProfileLookupAdapter adapts MongoUserRepository to UserProfileLookup.
 type userProfileLookupAdapter struct {
 	userRepo *mongodb.MongoUserRepository
@@ -2154,17 +4046,58 @@ func (a *userProfileLookupAdapter) GetUser(ctx context.Context, userID uuid.UUID
 	if err != nil || u == nil {
 		return nil
 	}
+	return userProfileView(u)
+}
+
+// userProfileView converts a domain user to the template UserView.
+func userProfileView(u *userdomain.User) *httphandler.UserView {
 	return &httphandler.UserView{
 		ID:          u.ID().String(),
 		Username:    u.Username(),
 		DisplayName: u.DisplayName(),
 		Email:       u.Email(),
+		Locale:      u.Locale(),
+		Timezone:    u.Timezone(),
 		IsAdmin:     u.IsSystemAdmin(),
 		CreatedAt:   u.CreatedAt(),
 		UpdatedAt:   u.UpdatedAt(),
 	}
 }
 
+// GetUsers implements UserProfileLookup with a single batch query.
+func (a *userProfileLookupAdapter) GetUsers(
+	ctx context.Context,
+	userIDs []uuid.UUID,
+) map[uuid.UUID]*httphandler.UserView {
+	users, err := a.userRepo.FindByIDs(ctx, userIDs)
+	if err != nil {
+		return nil
+	}
+	views := make(map[uuid.UUID]*httphandler.UserView, len(users))
+	for _, u := range users {
+		views[u.ID()] = userProfileView(u)
+	}
+	return views
+}
+
+// UserTimezone implements middleware.TimezoneResolver.
+func (a *userProfileLookupAdapter) UserTimezone(ctx context.Context, userID uuid.UUID) string {
+	u, err := a.userRepo.FindByID(ctx, userID)
+	if err != nil || u == nil {
+		return ""
+	}
+	return u.Timezone()
+}
+
+// UserLocale implements eventbus.UserLocaleResolver.
+func (a *userProfileLookupAdapter) UserLocale(ctx context.Context, userID uuid.UUID) string {
+	u, err := a.userRepo.FindByID(ctx, userID)
+	if err != nil || u == nil {
+		return ""
+	}
+	return u.Locale()
+}
+
 // createUserSearcher creates a service implementing UserSearcher.
 func (c *Container) createUserSearcher() httphandler.UserSearcher {
 	return &userSearcherAdapter{userRepo: c.UserRepo}
@@ -2198,32 +4131,134 @@ func (a *userSearcherAdapter) Search(
 	return results, nil
 }
 
-// createNotificationTemplateService creates a service implementing NotificationTemplateService.
-func (c *Container) createNotificationTemplateService() httphandler.NotificationTemplateService {
-	// Create use cases
-	listUC := notification.NewListNotificationsUseCase(c.NotificationRepo)
-	countUC := notification.NewCountUnreadUseCase(c.NotificationRepo)
-	markAsReadUC := notification.NewMarkAsReadUseCase(c.NotificationRepo)
-	getUC := notification.NewGetNotificationUseCase(c.NotificationRepo)
+// createNotificationService creates the service behind the notification handlers.
+// Read-state changes are published so the user's other devices update live.
+func (c *Container) createNotificationService() *notificationService {
+	resolver := &notificationWorkspaceResolver{chats: c.ChatQueryRepo, messages: c.MessageRepo}
+	directory := &notificationWorkspaceDirectory{workspaces: c.WorkspaceRepo}
+	return &notificationService{
+		listUC:  notification.NewListNotificationsUseCase(c.NotificationRepo),
+		countUC: notification.NewCountUnreadUseCase(c.NotificationRepo),
+		markAsReadUC: notification.NewMarkAsReadUseCase(
+			c.NotificationRepo, notification.WithMarkAsReadEventBus(c.EventBus)),
+		markAllAsReadUC: notification.NewMarkAllAsReadUseCase(
+			c.NotificationRepo, notification.WithMarkAllAsReadEventBus(c.EventBus)),
+		deleteUC:       notification.NewDeleteNotificationUseCase(c.NotificationRepo),
+		getUC:          notification.NewGetNotificationUseCase(c.NotificationRepo),
+		syncUC:         notification.NewSyncReadStateUseCase(c.NotificationRepo),
+		getPrefsUC:     notification.NewGetPreferencesUseCase(c.NotifPrefsRepo),
+		updatePrefsUC:  notification.NewUpdatePreferencesUseCase(c.NotifPrefsRepo),
+		unifiedInboxUC: notification.NewUnifiedInboxUseCase(c.NotificationRepo, resolver, directory),
+		markManyAsReadUC: notification.NewMarkManyAsReadUseCase(c.NotificationRepo, resolver, directory,
+			notification.WithMarkManyAsReadEventBus(c.EventBus)),
+	}
+}
+
+// notificationService implements httphandler.NotificationService and
+// httphandler.NotificationTemplateService.
+type notificationService struct {
+	listUC           *notification.ListNotificationsUseCase
+	countUC          *notification.CountUnreadUseCase
+	markAsReadUC     *notification.MarkAsReadUseCase
+	markAllAsReadUC  *notification.MarkAllAsReadUseCase
+	deleteUC         *notification.DeleteNotificationUseCase
+	getUC            *notification.GetNotificationUseCase
+	syncUC           *notification.SyncReadStateUseCase
+	getPrefsUC       *notification.GetPreferencesUseCase
+	updatePrefsUC    *notification.UpdatePreferencesUseCase
+	unifiedInboxUC   *notification.UnifiedInboxUseCase
+	markManyAsReadUC *notification.MarkManyAsReadUseCase
+}
+
+// UnifiedInbox lists notifications across all workspaces of a user.
+func (s *notificationService) UnifiedInbox(
+	ctx context.Context,
+	query notification.UnifiedInboxQuery,
+) (notification.UnifiedInboxResult, error) {
+	return s.unifiedInboxUC.Execute(ctx, query)
+}
+
+// MarkManyAsRead marks several notifications, or all unread of a workspace, as read.
+func (s *notificationService) MarkManyAsRead(
+	ctx context.Context,
+	cmd notification.MarkManyAsReadCommand,
+) (notification.CountResult, error) {
+	return s.markManyAsReadUC.Execute(ctx, cmd)
+}
+
+// notificationWorkspaceResolver implements notification.WorkspaceResolver by looking up
+// the chat (task ID equals chat ID) or message a notification references.
+type notificationWorkspaceResolver struct {
+	chats    *mongodb.MongoChatReadModelRepository
+	messages *mongodb.MongoMessageRepository
+}
 
-	return &notificationTemplateService{
-		listUC:       listUC,
-		countUC:      countUC,
-		markAsReadUC: markAsReadUC,
-		getUC:        getUC,
+// ResolveWorkspace returns the workspace of the referenced resource, zero when it is gone.
+func (r *notificationWorkspaceResolver) ResolveWorkspace(
+	ctx context.Context,
+	typ notificationdomain.Type,
+	resourceID string,
+) (uuid.UUID, error) {
+	id, err := uuid.ParseUUID(resourceID)
+	if err != nil {
+		return "", nil //nolint:nilerr // notifications without a resource belong to no workspace
 	}
+
+	switch typ {
+	case notificationdomain.TypeWorkspaceInvite:
+		return id, nil
+	case notificationdomain.TypeChatMention:
+		msg, findErr := r.messages.FindByID(ctx, id)
+		if errors.Is(findErr, domainerrs.ErrNotFound) {
+			return "", nil
+		}
+		if findErr != nil {
+			return "", findErr
+		}
+		id = msg.ChatID()
+	case notificationdomain.TypeTaskStatusChanged, notificationdomain.TypeTaskAssigned,
+		notificationdomain.TypeTaskCreated, notificationdomain.TypeTaskSLABreached,
+		notificationdomain.TypeChatMessage:
+	default:
+		return "", nil
+	}
+
+	chatModel, err := r.chats.FindByID(ctx, id)
+	if errors.Is(err, domainerrs.ErrNotFound) {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	return chatModel.WorkspaceID, nil
+}
+
+// maxNotificationInboxWorkspaces caps the workspaces the unified inbox groups notifications by.
+const maxNotificationInboxWorkspaces = 500
+
+// notificationWorkspaceDirectory implements notification.WorkspaceDirectory.
+type notificationWorkspaceDirectory struct {
+	workspaces *mongodb.MongoWorkspaceRepository
 }
 
-// notificationTemplateService implements httphandler.NotificationTemplateService.
-type notificationTemplateService struct {
-	listUC       *notification.ListNotificationsUseCase
-	countUC      *notification.CountUnreadUseCase
-	markAsReadUC *notification.MarkAsReadUseCase
-	getUC        *notification.GetNotificationUseCase
+// WorkspaceNames returns the names of the workspaces the user is a member of.
+func (d *notificationWorkspaceDirectory) WorkspaceNames(
+	ctx context.Context,
+	userID uuid.UUID,
+) (map[uuid.UUID]string, error) {
+	workspaces, err := d.workspaces.ListWorkspacesByUser(ctx, userID, 0, maxNotificationInboxWorkspaces)
+	if err != nil {
+		return nil, err
+	}
+	names := make(map[uuid.UUID]string, len(workspaces))
+	for _, ws := range workspaces {
+		names[ws.ID()] = ws.Name()
+	}
+	return names, nil
 }
 
 // ListNotifications lists notifications for a user.
-func (s *notificationTemplateService) ListNotifications(
+func (s *notificationService) ListNotifications(
 	ctx context.Context,
 	query notification.ListNotificationsQuery,
 ) (notification.ListResult, error) {
@@ -2231,7 +4266,7 @@ func (s *notificationTemplateService) ListNotifications(
 }
 
 // CountUnread counts unread notifications for a user.
-func (s *notificationTemplateService) CountUnread(
+func (s *notificationService) CountUnread(
 	ctx context.Context,
 	query notification.CountUnreadQuery,
 ) (notification.CountResult, error) {
@@ -2239,15 +4274,55 @@ func (s *notificationTemplateService) CountUnread(
 }
 
 // MarkAsRead marks a notification as read.
-func (s *notificationTemplateService) MarkAsRead(
+func (s *notificationService) MarkAsRead(
 	ctx context.Context,
 	cmd notification.MarkAsReadCommand,
 ) (notification.Result, error) {
 	return s.markAsReadUC.Execute(ctx, cmd)
 }
 
+// MarkAllAsRead marks all notifications as read for a user.
+func (s *notificationService) MarkAllAsRead(
+	ctx context.Context,
+	cmd notification.MarkAllAsReadCommand,
+) (notification.CountResult, error) {
+	return s.markAllAsReadUC.Execute(ctx, cmd)
+}
+
+// DeleteNotification deletes a notification.
+func (s *notificationService) DeleteNotification(
+	ctx context.Context,
+	cmd notification.DeleteNotificationCommand,
+) error {
+	return s.deleteUC.Execute(ctx, cmd)
+}
+
+// SyncReadState returns the notifications read since a timestamp.
+func (s *notificationService) SyncReadState(
+	ctx context.Context,
+	query notification.SyncReadStateQuery,
+) (notification.SyncReadStateResult, error) {
+	return s.syncUC.Execute(ctx, query)
+}
+
+// GetPreferences gets the notification preferences of a user.
+func (s *notificationService) GetPreferences(
+	ctx context.Context,
+	query notification.GetPreferencesQuery,
+) (notificationdomain.Preferences, error) {
+	return s.getPrefsUC.Execute(ctx, query)
+}
+
+// UpdatePreferences replaces the notification preferences of a user.
+func (s *notificationService) UpdatePreferences(
+	ctx context.Context,
+	cmd notification.UpdatePreferencesCommand,
+) (notificationdomain.Preferences, error) {
+	return s.updatePrefsUC.Execute(ctx, cmd)
+}
+
 // GetNotification gets a notification by ID.
-func (s *notificationTemplateService) GetNotification(
+func (s *notificationService) GetNotification(
 	ctx context.Context,
 	notificationID uuid.UUID,
 	userID uuid.UUID,
@@ -2312,6 +4387,7 @@ func (c *Container) setupTokenValidator() {
 func (c *Container) setupUserResolver() {
 	c.UserResolver = &userResolver{
 		userRepo: c.UserRepo,
+		readOnly: c.Config.ReadOnly.Enabled,
 		logger:   c.Logger,
 	}
 	c.Logger.Debug("user resolver initialized")
@@ -2320,6 +4396,7 @@ func (c *Container) setupUserResolver() {
 // userResolver implements middleware.UserResolver.
 type userResolver struct {
 	userRepo *mongodb.MongoUserRepository
+	readOnly bool // unknown users are not created in read-only deployments
 	logger   *slog.Logger
 }
 
@@ -2331,6 +4408,10 @@ func (r *userResolver) ResolveUser(ctx context.Context, externalID, username, em
 		return existingUser.ID(), nil
 	}
 
+	if r.readOnly {
+		return uuid.UUID(""), fmt.Errorf("user %s is not replicated yet: %w", externalID, errReadOnlyDeployment)
+	}
+
 	// User not found - create new user
 	r.logger.InfoContext(ctx, "creating new user from Keycloak",
 		slog.String("external_id", externalID),
@@ -2437,11 +4518,13 @@ func (c *Container) setupUserHandler() {
 	getUserUC := userapp.NewGetUserUseCase(c.UserRepo)
 	updateProfileUC := userapp.NewUpdateProfileUseCase(c.UserRepo)
 	getUserByUsernameUC := userapp.NewGetUserByUsernameUseCase(c.UserRepo)
+	lookupUsersUC := userapp.NewLookupUsersUseCase(c.UserRepo)
 
 	adapter := &userServiceAdapter{
 		getUserUC:           getUserUC,
 		updateProfileUC:     updateProfileUC,
 		getUserByUsernameUC: getUserByUsernameUC,
+		lookupUsersUC:       lookupUsersUC,
 	}
 
 	c.UserHandler = httphandler.NewUserHandler(adapter)
@@ -2453,6 +4536,7 @@ type userServiceAdapter struct {
 	getUserUC           *userapp.GetUserUseCase
 	updateProfileUC     *userapp.UpdateProfileUseCase
 	getUserByUsernameUC *userapp.GetUserByUsernameUseCase
+	lookupUsersUC       *userapp.LookupUsersUseCase
 }
 
 func (a *userServiceAdapter) GetUser(ctx context.Context, query userapp.GetUserQuery) (userapp.Result, error) {
@@ -2473,6 +4557,13 @@ func (a *userServiceAdapter) UpdateProfile(
 	return a.updateProfileUC.Execute(ctx, cmd)
 }
 
+func (a *userServiceAdapter) LookupUsers(
+	ctx context.Context,
+	query userapp.LookupUsersQuery,
+) (userapp.UsersResult, error) {
+	return a.lookupUsersUC.Execute(ctx, query)
+}
+
 // Close gracefully closes all container resources.
 // Resources are closed in reverse order of initialization.
 func (c *Container) Close() error {
@@ -2495,6 +4586,15 @@ func (c *Container) Close() error {
 		c.Logger.Debug("websocket hub stopped")
 	}
 
+	// Flush pending error reports
+	if c.ErrorReporter != nil {
+		ctx, cancel := context.WithTimeout(context.Background(), c.Config.ErrorReporting.Timeout)
+		if err := c.ErrorReporter.Close(ctx); err != nil {
+			errs = append(errs, fmt.Errorf("error reporter flush: %w", err))
+		}
+		cancel()
+	}
+
 	// Close EventBus
 	if c.EventBus != nil {
 		if err := c.EventBus.Shutdown(); err != nil {
@@ -2536,6 +4636,12 @@ func (c *Container) Close() error {
 // StartEventBus starts the event bus and registers all handlers.
 // This should be called before the HTTP server starts accepting requests.
 func (c *Container) StartEventBus(ctx context.Context) error {
+	// Projections and notifications are written by the primary region.
+	if c.Config.ReadOnly.Enabled {
+		c.Logger.InfoContext(ctx, "event bus not started in read-only mode")
+		return nil
+	}
+
 	// Register event handlers first
 	if err := c.registerEventHandlers(); err != nil {
 		return fmt.Errorf("failed to register event handlers: %w", err)
@@ -2586,35 +4692,44 @@ func (c *Container) IsReady(ctx context.Context) bool {
 		return false
 	}
 
+	// Check event processing has caught up
+	if c.ReadinessGate != nil {
+		if status := c.ReadinessGate.Check(ctx); !status.Healthy {
+			c.Logger.WarnContext(ctx, "readiness gate closed", slog.String("reason", status.Message))
+			return false
+		}
+	}
+
 	return true
 }
 
 // GetHealthStatus implements httpserver.HealthChecker.
-// It returns detailed health status of all components.
+// It returns detailed health status of all components, including the measured
+// latency of each dependency check.
 func (c *Container) GetHealthStatus(ctx context.Context) []httpserver.ComponentStatus {
 	var statuses []httpserver.ComponentStatus
 
 	// MongoDB status
-	mongoStatus := httpserver.ComponentStatus{Name: "mongodb", Status: httpserver.StatusHealthy}
 	if c.MongoDB == nil {
-		mongoStatus.Status = httpserver.StatusUnhealthy
-		mongoStatus.Message = "client not initialized"
-	} else if err := c.MongoDB.Ping(ctx, nil); err != nil {
-		mongoStatus.Status = httpserver.StatusUnhealthy
-		mongoStatus.Message = err.Error()
+		statuses = append(statuses, httpserver.ComponentStatus{
+			Name: "mongodb", Status: httpserver.StatusUnhealthy, Message: "client not initialized",
+		})
+	} else {
+		statuses = append(statuses, probeComponent("mongodb", func() error {
+			return c.MongoDB.Ping(ctx, nil)
+		}))
 	}
-	statuses = append(statuses, mongoStatus)
 
 	// Redis status
-	redisStatus := httpserver.ComponentStatus{Name: "redis", Status: httpserver.StatusHealthy}
 	if c.Redis == nil {
-		redisStatus.Status = httpserver.StatusUnhealthy
-		redisStatus.Message = "client not initialized"
-	} else if err := c.Redis.Ping(ctx).Err(); err != nil {
-		redisStatus.Status = httpserver.StatusUnhealthy
-		redisStatus.Message = err.Error()
+		statuses = append(statuses, httpserver.ComponentStatus{
+			Name: "redis", Status: httpserver.StatusUnhealthy, Message: "client not initialized",
+		})
+	} else {
+		statuses = append(statuses, probeComponent("redis", func() error {
+			return c.Redis.Ping(ctx).Err()
+		}))
 	}
-	statuses = append(statuses, redisStatus)
 
 	// WebSocket Hub status
 	hubStatus := httpserver.ComponentStatus{Name: "websocket_hub", Status: httpserver.StatusHealthy}
@@ -2638,44 +4753,64 @@ func (c *Container) GetHealthStatus(ctx context.Context) []httpserver.ComponentS
 	}
 	statuses = append(statuses, eventBusStatus)
 
-	// Consistency health checks
-	if c.OutboxChecker != nil {
-		status := c.OutboxChecker.Check(ctx)
-		statuses = append(statuses, httpserver.ComponentStatus{
-			Name:    c.OutboxChecker.Name(),
-			Status:  mapHealthStatus(status.Healthy),
-			Message: status.Message,
-		})
+	// Consistency and dependency health checks
+	for _, checker := range c.healthCheckers() {
+		statuses = append(statuses, runHealthChecker(ctx, checker))
 	}
 
-	if c.RepairChecker != nil {
-		status := c.RepairChecker.Check(ctx)
-		statuses = append(statuses, httpserver.ComponentStatus{
-			Name:    c.RepairChecker.Name(),
-			Status:  mapHealthStatus(status.Healthy),
-			Message: status.Message,
-		})
+	return statuses
+}
+
+// healthCheckers returns the configured consistency and dependency health checkers.
+func (c *Container) healthCheckers() []appcore.HealthChecker {
+	var checkers []appcore.HealthChecker
+	for _, checker := range []appcore.HealthChecker{
+		c.OutboxChecker,
+		c.RepairChecker,
+		c.DeadLetterChecker,
+		c.ReadModelChecker,
+		c.JWKSChecker,
+		c.ReadinessGate,
+	} {
+		if checker != nil {
+			checkers = append(checkers, checker)
+		}
 	}
+	return checkers
+}
 
-	if c.DeadLetterChecker != nil {
-		status := c.DeadLetterChecker.Check(ctx)
-		statuses = append(statuses, httpserver.ComponentStatus{
-			Name:    c.DeadLetterChecker.Name(),
-			Status:  mapHealthStatus(status.Healthy),
-			Message: status.Message,
-		})
+// probeComponent runs a dependency ping and reports its status with the measured latency.
+func probeComponent(name string, ping func() error) httpserver.ComponentStatus {
+	start := time.Now()
+	err := ping()
+	status := httpserver.ComponentStatus{
+		Name:      name,
+		Status:    httpserver.StatusHealthy,
+		LatencyMS: latencyMS(time.Since(start)),
 	}
+	if err != nil {
+		status.Status = httpserver.StatusUnhealthy
+		status.Message = err.Error()
+	}
+	return status
+}
 
-	if c.ReadModelChecker != nil {
-		status := c.ReadModelChecker.Check(ctx)
-		statuses = append(statuses, httpserver.ComponentStatus{
-			Name:    c.ReadModelChecker.Name(),
-			Status:  mapHealthStatus(status.Healthy),
-			Message: status.Message,
-		})
+// runHealthChecker runs a health checker and reports its status with the measured latency.
+func runHealthChecker(ctx context.Context, checker appcore.HealthChecker) httpserver.ComponentStatus {
+	start := time.Now()
+	status := checker.Check(ctx)
+	return httpserver.ComponentStatus{
+		Name:      checker.Name(),
+		Status:    mapHealthStatus(status.Healthy),
+		Message:   status.Message,
+		LatencyMS: latencyMS(time.Since(start)),
+		Details:   status.Details,
 	}
+}
 
-	return statuses
+// latencyMS converts a duration to fractional milliseconds.
+func latencyMS(d time.Duration) float64 {
+	return float64(d.Microseconds()) / float64(time.Millisecond/time.Microsecond)
 }
 
 // mapHealthStatus converts appcore.HealthStatus.Healthy to httpserver status.
@@ -2724,6 +4859,7 @@ func (a *fileMetadataAdapter) Save(ctx context.Context, meta httphandler.FileMet
 		ChatID:     meta.ChatID,
 		UploaderID: meta.UploaderID,
 		UploadedAt: meta.UploadedAt,
+		FileName:   meta.FileName,
 	})
 }
 
@@ -2741,9 +4877,79 @@ func (a *fileMetadataAdapter) FindByFileID(
 		ChatID:     meta.ChatID,
 		UploaderID: meta.UploaderID,
 		UploadedAt: meta.UploadedAt,
+		FileName:   meta.FileName,
 	}, nil
 }
 
+// Delete implements httphandler.ChatAttachmentMetadata.
+func (a *fileMetadataAdapter) Delete(ctx context.Context, fileID uuid.UUID) error {
+	return a.repo.Delete(ctx, fileID)
+}
+
+// inboundAttachmentStore saves email attachments to the blob store and records
+// their chat so downloads are authorized like regular uploads.
+type inboundAttachmentStore struct {
+	storage  *filestorage.LocalStorage
+	guard    httphandler.FileStorageGuard
+	metadata *fileMetadataAdapter
+	quota    httphandler.WorkspaceStorageQuota
+	chats    httphandler.FileChatWorkspaceResolver
+}
+
+// Store implements inbound.AttachmentStore.
+func (s *inboundAttachmentStore) Store(
+	ctx context.Context,
+	chatID, uploaderID uuid.UUID,
+	fileName string,
+	content io.Reader,
+) (uuid.UUID, error) {
+	var fileID uuid.UUID
+	counted := &countingReader{r: content}
+	save := func(ctx context.Context) error {
+		var saveErr error
+		fileID, saveErr = s.storage.Save(resilience.NewContextReader(ctx, counted), fileName)
+		return saveErr
+	}
+	var err error
+	if s.guard != nil {
+		err = s.guard.Do(ctx, save)
+	} else {
+		err = save(ctx)
+	}
+	if err != nil {
+		return "", err
+	}
+
+	if metaErr := s.metadata.Save(ctx, httphandler.FileMetadataEntry{
+		FileID:     fileID,
+		ChatID:     chatID,
+		UploaderID: uploaderID,
+		UploadedAt: time.Now().UTC(),
+	}); metaErr != nil {
+		return "", fmt.Errorf("failed to save file metadata: %w", metaErr)
+	}
+
+	// Mailed attachments are not rejected over the quota, but they count towards it
+	if s.quota != nil {
+		if workspaceID, wsErr := s.chats.ChatWorkspaceID(ctx, chatID); wsErr == nil {
+			_ = s.quota.RecordUpload(ctx, workspaceID, counted.n)
+		}
+	}
+	return fileID, nil
+}
+
+// countingReader counts the bytes read through it.
+type countingReader struct {
+	r io.Reader
+	n int64
+}
+
+func (c *countingReader) Read(p []byte) (int, error) {
+	n, err := c.r.Read(p)
+	c.n += int64(n)
+	return n, err
+}
+
 // fileChatParticipantAdapter checks chat participation via the chat read model.
 type fileChatParticipantAdapter struct {
 	chatQueryRepo *mongodb.MongoChatReadModelRepository
@@ -2766,3 +4972,50 @@ func (a *fileChatParticipantAdapter) IsParticipant(
 	}
 	return false, nil
 }
+
+// chatModerationAdapter treats chat admins as moderators, via the chat read model.
+type chatModerationAdapter struct {
+	chatQueryRepo *mongodb.MongoChatReadModelRepository
+}
+
+// CanModerate implements httphandler.MessageModerationChecker.
+func (a *chatModerationAdapter) CanModerate(ctx context.Context, chatID, userID uuid.UUID) (bool, error) {
+	rm, err := a.chatQueryRepo.FindByID(ctx, chatID)
+	if err != nil {
+		return false, err
+	}
+	for _, p := range rm.Participants {
+		if p.UserID() == userID {
+			return p.IsAdmin(), nil
+		}
+	}
+	return false, nil
+}
+
+// projectionRepairAdapter adapts repair.Queue to httphandler.ProjectionRepairScheduler.
+type projectionRepairAdapter struct {
+	queue repair.Queue
+}
+
+// ScheduleRepair implements httphandler.ProjectionRepairScheduler.
+func (a *projectionRepairAdapter) ScheduleRepair(
+	ctx context.Context,
+	aggregateType string,
+	aggregateID uuid.UUID,
+) error {
+	return a.queue.Add(ctx, repair.Task{
+		AggregateID:   aggregateID.String(),
+		AggregateType: aggregateType,
+		TaskType:      repair.TaskTypeReadModelSync,
+		Error:         "requested by admin",
+	})
+}
+
+// ScheduleRebuildAll implements httphandler.ProjectionRepairScheduler.
+func (a *projectionRepairAdapter) ScheduleRebuildAll(ctx context.Context, aggregateType string) error {
+	return a.queue.Add(ctx, repair.Task{
+		AggregateType: aggregateType,
+		TaskType:      repair.TaskTypeRebuildAll,
+		Error:         "requested by admin",
+	})
+}
@@ -188,6 +188,34 @@ func TestContainer_GetHealthStatus_ComponentNames(t *testing.T) {
 	assert.True(t, names["eventbus"], "should have eventbus status")
 }
 
+type stubHealthChecker struct {
+	status appcore.HealthStatus
+}
+
+func (s stubHealthChecker) Check(_ context.Context) appcore.HealthStatus { return s.status }
+
+func (s stubHealthChecker) Name() string { return "outbox_backlog" }
+
+func TestContainer_GetHealthStatus_CheckerDetails(t *testing.T) {
+	c := &Container{
+		Logger: slog.Default(),
+		OutboxChecker: stubHealthChecker{status: appcore.HealthStatus{
+			Healthy: true,
+			Message: "outbox backlog: 3 events",
+			Details: map[string]any{"backlog_count": int64(3)},
+		}},
+	}
+
+	statuses := c.GetHealthStatus(context.Background())
+
+	require.Len(t, statuses, 5)
+	outbox := statuses[4]
+	assert.Equal(t, "outbox_backlog", outbox.Name)
+	assert.Equal(t, httpserver.StatusHealthy, outbox.Status)
+	assert.Equal(t, int64(3), outbox.Details["backlog_count"])
+	assert.GreaterOrEqual(t, outbox.LatencyMS, 0.0)
+}
+
 func TestHealthStatus_Structure(t *testing.T) {
 	status := httpserver.ComponentStatus{
 		Name:    "test",
@@ -238,6 +266,19 @@ func TestContainer_StartEventBus_NilEventBus(t *testing.T) {
 	_ = ctx // avoid unused variable
 }
 
+// TestContainer_ReadOnlyMode tests that read-only deployments skip the event bus
+// and reject mutating requests.
+func TestContainer_ReadOnlyMode(t *testing.T) {
+	cfg := config.DefaultConfig()
+	c := &Container{Config: cfg, Logger: slog.Default()}
+	assert.Nil(t, c.readOnlyMiddleware())
+
+	cfg.ReadOnly.Enabled = true
+	require.NoError(t, c.StartEventBus(context.Background()), "event bus must not start")
+	assert.NotNil(t, c.readOnlyMiddleware())
+	assert.ErrorIs(t, readOnlyUserStore{}.Save(context.Background(), nil), errReadOnlyDeployment)
+}
+
 // TestContainer_StartHub_NilHub tests that StartHub handles nil Hub
 func TestContainer_StartHub_NilHub(t *testing.T) {
 	c := &Container{
@@ -514,7 +555,7 @@ func TestFullTaskServiceAdapter_CreateTask_PartialFailureStillSyncsProjection(t
 		convertToEpicUC:    chatapp.NewConvertToEpicUseCase(chatRepo),
 		changeStatusUC:     chatapp.NewChangeStatusUseCase(chatRepo),
 		assignUserUC:       chatapp.NewAssignUserUseCase(chatRepo, userRepo),
-		setPriorityUC:      chatapp.NewSetPriorityUseCase(chatRepo),
+		setPriorityUC:      chatapp.NewSetPriorityUseCase(chatRepo, nil),
 		setDueDateUC:       chatapp.NewSetDueDateUseCase(chatRepo),
 		addAttachmentUC:    chatapp.NewAddAttachmentUseCase(chatRepo),
 		removeAttachmentUC: chatapp.NewRemoveAttachmentUseCase(chatRepo),
@@ -15,7 +15,11 @@ import (
 	"time"
 
 	"github.com/labstack/echo/v4"
+	"github.com/lllypuk/flowra/internal/buildinfo"
 	"github.com/lllypuk/flowra/internal/config"
+	"github.com/lllypuk/flowra/internal/infrastructure/diagnostics"
+	"github.com/lllypuk/flowra/internal/infrastructure/httpserver"
+	"github.com/lllypuk/flowra/internal/infrastructure/logging"
 	"github.com/lllypuk/flowra/internal/worker"
 )
 
@@ -37,7 +41,7 @@ func main() {
 	logger := setupLogger(cfg)
 
 	logger.Info("starting flowra API server",
-		slog.String("version", "0.1.0"),
+		slog.Any("build", buildinfo.Get()),
 		slog.String("environment", getEnvironment(cfg)),
 	)
 	config.LogDevRuntimeMode(logger, cfg, "api")
@@ -69,6 +73,8 @@ func main() {
 	// Start WebSocket Hub
 	container.StartHub(ctx)
 
+	startDiagnostics(ctx, cfg, logger)
+
 	workerDone, workerErrCh := startWorkerRuntime(
 		ctx,
 		cancel,
@@ -84,9 +90,17 @@ func main() {
 	// Get the Echo instance from the router
 	e := router.Echo()
 
-	// Configure Echo server timeouts
+	// Configure Echo server timeouts and transport
+	e.Server.Addr = cfg.Server.Address()
 	e.Server.ReadTimeout = cfg.Server.ReadTimeout
 	e.Server.WriteTimeout = cfg.Server.WriteTimeout
+	if transportErr := configureTransport(ctx, e.Server, cfg, logger); transportErr != nil {
+		logger.Error("failed to configure server transport", slog.String("error", transportErr.Error()))
+		cancel()
+		waitForWorkerShutdown(workerDone, cfg.Server.ShutdownTimeout, logger)
+		_ = container.Close()
+		os.Exit(1)
+	}
 
 	// Start graceful shutdown handler
 	shutdownDone := make(chan struct{})
@@ -97,9 +111,11 @@ func main() {
 		slog.String("address", cfg.Server.Address()),
 		slog.Duration("read_timeout", cfg.Server.ReadTimeout),
 		slog.Duration("write_timeout", cfg.Server.WriteTimeout),
+		slog.Bool("tls", cfg.Server.TLS.Enabled),
+		slog.Bool("http2", cfg.Server.HTTP2),
 	)
 
-	if serverErr := e.Start(cfg.Server.Address()); serverErr != nil && !errors.Is(serverErr, http.ErrServerClosed) {
+	if serverErr := e.StartServer(e.Server); serverErr != nil && !errors.Is(serverErr, http.ErrServerClosed) {
 		logger.Error("server error", slog.String("error", serverErr.Error()))
 		cancel()
 		waitForWorkerShutdown(workerDone, cfg.Server.ShutdownTimeout, logger)
@@ -132,7 +148,7 @@ func setupLogger(cfg *config.Config) *slog.Logger {
 		handler = slog.NewJSONHandler(os.Stdout, opts)
 	}
 
-	logger := slog.New(handler)
+	logger := slog.New(logging.NewContextHandler(handler))
 	slog.SetDefault(logger)
 
 	return logger
@@ -313,6 +329,59 @@ func workerRuntimeError(workerErrCh <-chan error) error {
 	}
 }
 
+// configureTransport applies HTTP/2 and TLS settings to srv and starts the
+// HTTPS redirect listener when configured.
+func configureTransport(ctx context.Context, srv *http.Server, cfg *config.Config, logger *slog.Logger) error {
+	tlsCfg := cfg.Server.TLS
+	httpserver.ConfigureProtocols(srv, cfg.Server.HTTP2, tlsCfg.Enabled)
+	if !tlsCfg.Enabled {
+		return nil
+	}
+
+	tlsConfig, manager, err := httpserver.NewTLSConfig(httpserver.TLSOptions{
+		CertFile:         tlsCfg.CertFile,
+		KeyFile:          tlsCfg.KeyFile,
+		AutocertHosts:    tlsCfg.AutocertHostList(),
+		AutocertCacheDir: tlsCfg.AutocertCacheDir,
+		AutocertEmail:    tlsCfg.AutocertEmail,
+		HTTP2:            cfg.Server.HTTP2,
+	})
+	if err != nil {
+		return fmt.Errorf("configure tls: %w", err)
+	}
+	srv.TLSConfig = tlsConfig
+
+	if tlsCfg.RedirectAddr != "" {
+		redirect := httpserver.NewRedirectServer(tlsCfg.RedirectAddr, srv.Addr, manager, logger)
+		go func() {
+			if runErr := redirect.Run(ctx); runErr != nil {
+				logger.Error("https redirect listener stopped with error", slog.String("error", runErr.Error()))
+			}
+		}()
+	}
+
+	return nil
+}
+
+// startDiagnostics runs the loopback-only diagnostics listener when configured.
+func startDiagnostics(ctx context.Context, cfg *config.Config, logger *slog.Logger) {
+	if !cfg.Diagnostics.Enabled || cfg.Diagnostics.ListenAddr == "" {
+		return
+	}
+
+	server, err := diagnostics.NewServer(cfg.Diagnostics.ListenAddr, logger)
+	if err != nil {
+		logger.ErrorContext(ctx, "failed to create diagnostics listener", slog.String("error", err.Error()))
+		return
+	}
+
+	go func() {
+		if runErr := server.Run(ctx); runErr != nil {
+			logger.Error("diagnostics listener stopped with error", slog.String("error", runErr.Error()))
+		}
+	}()
+}
+
 func startWorkerRuntime(
 	ctx context.Context,
 	cancel context.CancelFunc,
@@ -324,6 +393,10 @@ func startWorkerRuntime(
 	if !withWorker {
 		return nil, nil
 	}
+	if cfg.ReadOnly.Enabled {
+		logger.WarnContext(ctx, "worker runtime not started in read-only mode")
+		return nil, nil
+	}
 
 	logger.InfoContext(ctx, "starting unified API + worker mode")
 	done := make(chan struct{})
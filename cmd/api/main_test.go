@@ -4,10 +4,12 @@ import (
 	"errors"
 	"io"
 	"log/slog"
+	"net/http"
 	"testing"
 	"time"
 
 	"github.com/lllypuk/flowra/internal/config"
+	"github.com/lllypuk/flowra/internal/infrastructure/httpserver"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -295,3 +297,16 @@ func TestWaitForShutdownComplete(t *testing.T) {
 func testLogger() *slog.Logger {
 	return slog.New(slog.NewTextHandler(io.Discard, nil))
 }
+
+func TestConfigureTransport(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	cfg := config.DefaultConfig()
+	srv := &http.Server{}
+	require.NoError(t, configureTransport(t.Context(), srv, cfg, logger))
+	assert.Nil(t, srv.TLSConfig)
+	assert.True(t, srv.Protocols.UnencryptedHTTP2())
+
+	cfg.Server.TLS.Enabled = true
+	require.ErrorIs(t, configureTransport(t.Context(), &http.Server{}, cfg, logger), httpserver.ErrTLSSourceMissing)
+}
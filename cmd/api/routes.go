@@ -5,22 +5,38 @@ import (
 	"net/http"
 
 	"github.com/labstack/echo/v4"
+	"github.com/lllypuk/flowra/internal/buildinfo"
+	"github.com/lllypuk/flowra/internal/config"
 	httphandler "github.com/lllypuk/flowra/internal/handler/http"
+	"github.com/lllypuk/flowra/internal/infrastructure/diagnostics"
 	"github.com/lllypuk/flowra/internal/infrastructure/httpserver"
 	"github.com/lllypuk/flowra/internal/middleware"
 	"github.com/lllypuk/flowra/web"
 )
 
+// corsConfig maps the CORS settings onto the middleware defaults.
+func corsConfig(cfg config.CORSConfig) middleware.CORSConfig {
+	cors := middleware.DefaultCORSConfig()
+	cors.AllowOrigins = cfg.AllowedOriginList()
+	cors.AllowCredentials = cfg.AllowCredentials
+	cors.MaxAge = int(cfg.MaxAge.Seconds())
+	return cors
+}
+
 // SetupRoutes configures all API routes and middleware chains.
 func SetupRoutes(c *Container) *httpserver.Router {
 	e := echo.New()
 	e.HideBanner = true
 	e.HidePort = true
+	e.IPExtractor = httpserver.NewIPExtractor(c.Config.Server.TrustedProxyNets())
+
+	// Resolve the user's time zone right after authentication.
+	timezone := middleware.Timezone(c.createTimezoneResolver())
 
 	// Create router configuration
 	routerConfig := httpserver.RouterConfig{
 		Logger: c.Logger,
-		AuthMiddleware: middleware.Auth(middleware.AuthConfig{
+		AuthMiddleware: chainMiddleware(middleware.Auth(middleware.AuthConfig{
 			Logger:         c.Logger,
 			TokenValidator: c.TokenValidator,
 			UserResolver:   c.UserResolver,
@@ -37,17 +53,21 @@ func SetupRoutes(c *Container) *httpserver.Router {
 			},
 			// Session cookie support for HTMX frontend
 			SessionCookieName: "flowra_session",
-		}),
+		}), timezone),
 		WorkspaceMiddleware: middleware.WorkspaceAccess(middleware.WorkspaceConfig{
 			Logger:           c.Logger,
 			AccessChecker:    c.AccessChecker,
 			WorkspaceIDParam: "workspace_id",
 			AllowSystemAdmin: true,
 		}),
-		CORSConfig:     middleware.DefaultCORSConfig(),
-		LoggingConfig:  middleware.DefaultLoggingConfig(),
-		RecoveryConfig: middleware.DefaultRecoveryConfig(),
-		APIPrefix:      "/api/v1",
+		WorkspaceRateLimitMiddleware: c.workspaceRateLimitMiddleware(),
+		TimeoutMiddleware:            c.timeoutMiddleware(),
+		MaintenanceMiddleware:        c.maintenanceMiddleware(),
+		ReadOnlyMiddleware:           c.readOnlyMiddleware(),
+		CORSConfig:                   corsConfig(c.Config.CORS),
+		LoggingConfig:                middleware.DefaultLoggingConfig(),
+		RecoveryConfig:               c.recoveryConfig(),
+		APIPrefix:                    "/api/v1",
 	}
 
 	// Create router with configuration
@@ -64,6 +84,15 @@ func SetupRoutes(c *Container) *httpserver.Router {
 	// Register Prometheus metrics endpoint
 	router.RegisterMetricsEndpoint()
 
+	// Register build version endpoint
+	router.RegisterVersionEndpoint(buildinfo.Get())
+
+	// Register pprof/runtime diagnostics for system admins unless a dedicated
+	// loopback listener is configured (see startDiagnostics).
+	if c.Config.Diagnostics.Enabled && c.Config.Diagnostics.ListenAddr == "" {
+		router.RegisterDiagnosticsEndpoints(diagnostics.NewHandler())
+	}
+
 	// Register health check endpoints using the HealthChecker interface.
 	// Container implements httpserver.HealthChecker, so we pass it directly.
 	// This ensures proper context handling from the request.
@@ -80,6 +109,13 @@ func SetupRoutes(c *Container) *httpserver.Router {
 	registerFileRoutes(router, c)
 	registerTaskRoutes(router, c)
 	registerNotificationRoutes(router, c)
+	registerAnnouncementRoutes(router, c)
+	registerInboxRoutes(router, c)
+	registerCalendarRoutes(router, c)
+	registerInboundEmailRoutes(router, c)
+	registerMaintenanceRoutes(router, c)
+	registerOutboxAdminRoutes(router, c)
+	registerAdminAPIRoutes(router, c)
 	registerUserRoutes(router, c)
 	registerWebSocketRoutes(router, c)
 
@@ -107,17 +143,60 @@ func registerWorkspaceRoutes(r *httpserver.Router, c *Container) {
 	// Workspace list and create (authenticated but not workspace-scoped)
 	r.Auth().POST("/workspaces", c.WorkspaceHandler.Create)
 	r.Auth().GET("/workspaces", c.WorkspaceHandler.List)
+	r.Auth().GET("/workspaces/discover", c.WorkspaceHandler.Discover)
+
+	// Join requests come from non-members, so they skip the membership check
+	r.Auth().POST("/workspaces/:id/join", c.WorkspaceHandler.Join)
 
 	// Workspace-scoped routes
 	ws := r.Workspace()
 	ws.GET("", c.WorkspaceHandler.Get)
 	ws.PUT("", c.WorkspaceHandler.Update)
+	ws.PUT("/value-policy", c.WorkspaceHandler.UpdateValuePolicy, middleware.RequireWorkspaceAdmin())
+	ws.PUT("/sla-policy", c.WorkspaceHandler.UpdateSLAPolicy, middleware.RequireWorkspaceAdmin())
+	ws.PUT("/analytics", c.WorkspaceHandler.UpdateAnalytics, middleware.RequireWorkspaceAdmin())
+	ws.PUT("/join-approval", c.WorkspaceHandler.UpdateJoinApproval, middleware.RequireWorkspaceAdmin())
+	ws.PUT("/discoverability", c.WorkspaceHandler.UpdateDiscoverability, middleware.RequireWorkspaceAdmin())
 	ws.DELETE("", c.WorkspaceHandler.Delete, middleware.RequireWorkspaceOwner())
 
+	// Workspace branding (needs the blob store)
+	if c.WorkspaceBrandingHandler != nil {
+		ws.GET("/avatar", c.WorkspaceBrandingHandler.Avatar)
+		ws.PUT("/branding", c.WorkspaceBrandingHandler.UpdateAccentColor, middleware.RequireWorkspaceAdmin())
+		ws.POST("/branding/avatar", c.WorkspaceBrandingHandler.UploadAvatar, middleware.RequireWorkspaceAdmin())
+		ws.DELETE("/branding/avatar", c.WorkspaceBrandingHandler.DeleteAvatar, middleware.RequireWorkspaceAdmin())
+	}
+
 	// Workspace member management
 	ws.POST("/members", c.WorkspaceHandler.AddMember, middleware.RequireWorkspaceAdmin())
 	ws.DELETE("/members/:user_id", c.WorkspaceHandler.RemoveMember, middleware.RequireWorkspaceAdmin())
 	ws.PUT("/members/:user_id/role", c.WorkspaceHandler.UpdateMemberRole, middleware.RequireWorkspaceAdmin())
+	ws.GET("/members/pending", c.WorkspaceHandler.ListPendingMembers, middleware.RequireWorkspaceAdmin())
+	ws.POST("/members/:user_id/approve", c.WorkspaceHandler.ApproveMember, middleware.RequireWorkspaceAdmin())
+	ws.POST("/members/:user_id/reject", c.WorkspaceHandler.RejectMember, middleware.RequireWorkspaceAdmin())
+
+	// Workspace reports
+	if c.ReportHandler != nil {
+		ws.GET("/reports/velocity", c.ReportHandler.Velocity)
+		ws.GET("/reports/burndown", c.ReportHandler.Burndown)
+		ws.GET("/reports/cumulative-flow", c.ReportHandler.CumulativeFlow)
+		ws.GET("/reports/cycle-time", c.ReportHandler.CycleTime)
+	}
+
+	// Workspace roadmap
+	if c.RoadmapHandler != nil {
+		ws.GET("/roadmap", c.RoadmapHandler.Roadmap)
+	}
+
+	// Command palette quick actions
+	if c.CommandPaletteHandler != nil {
+		ws.GET("/commands", c.CommandPaletteHandler.List)
+	}
+
+	// Workspace full-text search
+	if c.SearchHandler != nil {
+		ws.GET("/search", c.SearchHandler.Search)
+	}
 }
 
 // registerChatRoutes registers chat-related routes.
@@ -129,12 +208,19 @@ func registerChatRoutes(r *httpserver.Router, c *Container) {
 	chats.GET("", c.ChatHandler.List)
 	chats.GET("/:id", c.ChatHandler.Get)
 	chats.PUT("/:id", c.ChatHandler.Update)
+	chats.PUT("/:id/topic", c.ChatHandler.SetTopic)
 	chats.DELETE("/:id", c.ChatHandler.Delete)
 
 	// Chat participants
+	chats.GET("/:id/participants", c.ChatHandler.ListParticipants)
 	chats.POST("/:id/participants", c.ChatHandler.AddParticipant)
 	chats.DELETE("/:id/participants/:user_id", c.ChatHandler.RemoveParticipant)
+	chats.POST("/:id/transfer-ownership", c.ChatHandler.TransferOwnership)
 	chats.GET("/:id/presence", c.ChatHandler.GetPresence)
+	chats.POST("/:id/read", c.ChatHandler.MarkRead)
+	chats.GET("/:id/unread", c.ChatHandler.Unread)
+	chats.PUT("/:id/star", c.ChatHandler.Star)
+	chats.DELETE("/:id/star", c.ChatHandler.Unstar)
 
 	// Chat actions (message-based modifications)
 	if c.ChatActionHandler != nil {
@@ -144,8 +230,17 @@ func registerChatRoutes(r *httpserver.Router, c *Container) {
 		chats.POST("/:id/actions/due-date", c.ChatActionHandler.SetDueDate)
 		chats.POST("/:id/actions/close", c.ChatActionHandler.Close)
 		chats.POST("/:id/actions/reopen", c.ChatActionHandler.Reopen)
+		chats.POST("/:id/actions/discussion", c.ChatActionHandler.ConvertToDiscussion)
 		chats.POST("/:id/actions/rename", c.ChatActionHandler.Rename)
 	}
+
+	// Chat transcripts (chat admins only)
+	if c.ChatExportHandler != nil {
+		exports := r.NewWorkspaceRouteGroup("/chats/:chat_id/exports")
+		exports.POST("", c.ChatExportHandler.Create)
+		exports.GET("/:id", c.ChatExportHandler.Get)
+		exports.GET("/:id/download", c.ChatExportHandler.Download)
+	}
 }
 
 // registerMessageRoutes registers message-related routes.
@@ -156,12 +251,19 @@ func registerMessageRoutes(r *httpserver.Router, c *Container) {
 	if c.MessageHandler != nil {
 		messages.POST("", c.MessageHandler.Send)
 		messages.GET("", c.MessageHandler.List)
+		messages.GET("/export", c.MessageHandler.Export)
+		r.NewWorkspaceRouteGroup("/chats/:chat_id/polls").POST("", c.MessageHandler.CreatePoll)
 
 		// Direct message routes (without chat_id in path) for edit/delete
 		// These are authenticated but not workspace-scoped since message ID is unique
 		r.Auth().PUT("/messages/:id", c.MessageHandler.Edit)
 		r.Auth().DELETE("/messages/:id", c.MessageHandler.Delete)
+		r.Auth().POST("/messages/:id/undo", c.MessageHandler.Undo)
 		r.Auth().POST("/messages/:id/attachments", c.MessageHandler.AddAttachment)
+		r.Auth().POST("/messages/:id/forward", c.MessageHandler.Forward)
+		r.Auth().POST("/messages/:id/poll/vote", c.MessageHandler.VotePoll)
+		r.Auth().DELETE("/messages/:id/poll/vote", c.MessageHandler.RetractPollVote)
+		r.Auth().POST("/messages/:id/poll/close", c.MessageHandler.ClosePoll)
 	} else {
 		// Placeholder endpoints when handler is not initialized
 		placeholder := createPlaceholderHandler("Message")
@@ -177,6 +279,9 @@ func registerFileRoutes(r *httpserver.Router, c *Container) {
 	if c.FileHandler != nil {
 		c.FileHandler.RegisterRoutes(r)
 	}
+	if c.ChatAttachmentHandler != nil {
+		c.ChatAttachmentHandler.RegisterRoutes(r)
+	}
 }
 
 // registerTaskRoutes registers task-related routes.
@@ -186,14 +291,29 @@ func registerTaskRoutes(r *httpserver.Router, c *Container) {
 	if c.TaskHandler != nil {
 		tasks.POST("", c.TaskHandler.Create)
 		tasks.GET("", c.TaskHandler.List)
+		tasks.GET("/triage", c.TaskHandler.Triage)
+		tasks.GET("/export", c.TaskHandler.Export)
 		tasks.GET("/:task_id", c.TaskHandler.Get)
 		tasks.PUT("/:task_id/status", c.TaskHandler.ChangeStatus)
 		tasks.PUT("/:task_id/assignee", c.TaskHandler.Assign)
 		tasks.PUT("/:task_id/priority", c.TaskHandler.ChangePriority)
 		tasks.PUT("/:task_id/due-date", c.TaskHandler.SetDueDate)
 		tasks.DELETE("/:task_id", c.TaskHandler.Delete)
+		// A task shares its ID with its chat, so task stars are chat stars
+		tasks.PUT("/:task_id/star", c.ChatHandler.Star)
+		tasks.DELETE("/:task_id/star", c.ChatHandler.Unstar)
 		tasks.POST("/:task_id/attachments", c.TaskHandler.AddAttachment)
 		tasks.DELETE("/:task_id/attachments/:file_id", c.TaskHandler.RemoveAttachment)
+		if c.TaskAttachmentHandler != nil {
+			tasks.GET("/:task_id/attachments", c.TaskAttachmentHandler.List)
+			tasks.POST("/:task_id/attachments/upload", c.TaskAttachmentHandler.Upload)
+		}
+		tasks.POST("/:task_id/checklist", c.TaskHandler.AddChecklistItem)
+		tasks.PUT("/:task_id/checklist/:item_id", c.TaskHandler.ToggleChecklistItem)
+		tasks.PUT("/:task_id/checklist/:item_id/position", c.TaskHandler.ReorderChecklistItem)
+		tasks.PUT("/:task_id/epic", c.TaskHandler.SetEpic)
+		tasks.DELETE("/:task_id/epic", c.TaskHandler.UnlinkEpic)
+		tasks.GET("/:task_id/children", c.TaskHandler.ListEpicChildren)
 	} else {
 		// Placeholder endpoints when handler is not initialized
 		placeholder := createPlaceholderHandler("Task")
@@ -215,6 +335,8 @@ func registerTaskRoutes(r *httpserver.Router, c *Container) {
 		tasks.POST("/:task_id/actions/priority", c.TaskActionHandler.ChangePriority)
 		tasks.POST("/:task_id/actions/assignee", c.TaskActionHandler.ChangeAssignee)
 		tasks.POST("/:task_id/actions/due-date", c.TaskActionHandler.SetDueDate)
+		tasks.POST("/:task_id/actions/severity", c.TaskActionHandler.SetSeverity)
+		tasks.POST("/:task_id/actions/duplicate", c.TaskActionHandler.MarkDuplicate)
 	}
 }
 
@@ -224,6 +346,9 @@ func registerNotificationRoutes(r *httpserver.Router, c *Container) {
 		// Notifications are user-scoped, not workspace-scoped
 		r.Auth().GET("/notifications", c.NotificationHandler.List)
 		r.Auth().GET("/notifications/unread/count", c.NotificationHandler.UnreadCount)
+		r.Auth().GET("/notifications/sync", c.NotificationHandler.Sync)
+		r.Auth().GET("/notifications/preferences", c.NotificationHandler.GetPreferences)
+		r.Auth().PUT("/notifications/preferences", c.NotificationHandler.UpdatePreferences)
 		r.Auth().PUT("/notifications/:id/read", c.NotificationHandler.MarkAsRead)
 		r.Auth().PUT("/notifications/mark-all-read", c.NotificationHandler.MarkAllRead)
 		r.Auth().DELETE("/notifications/:id", c.NotificationHandler.Delete)
@@ -232,23 +357,94 @@ func registerNotificationRoutes(r *httpserver.Router, c *Container) {
 		placeholder := createPlaceholderHandler("Notification")
 		r.Auth().GET("/notifications", placeholder)
 		r.Auth().GET("/notifications/unread/count", placeholder)
+		r.Auth().GET("/notifications/sync", placeholder)
 		r.Auth().PUT("/notifications/:id/read", placeholder)
 		r.Auth().PUT("/notifications/mark-all-read", placeholder)
 		r.Auth().DELETE("/notifications/:id", placeholder)
 	}
 }
 
+// registerAnnouncementRoutes registers announcement routes.
+func registerAnnouncementRoutes(r *httpserver.Router, c *Container) {
+	if c.AnnouncementHandler != nil {
+		c.AnnouncementHandler.RegisterRoutes(r)
+	}
+}
+
+// registerInboxRoutes registers the personal quick-capture inbox routes.
+func registerInboxRoutes(r *httpserver.Router, c *Container) {
+	if c.InboxHandler != nil {
+		c.InboxHandler.RegisterRoutes(r)
+	}
+}
+
+// registerCalendarRoutes registers the iCal feed and its token management.
+func registerCalendarRoutes(r *httpserver.Router, c *Container) {
+	if c.CalendarHandler != nil {
+		c.CalendarHandler.RegisterRoutes(r)
+	}
+}
+
+// registerInboundEmailRoutes registers the inbound email gateway when it is enabled.
+func registerInboundEmailRoutes(r *httpserver.Router, c *Container) {
+	if c.InboundEmailHandler != nil {
+		c.InboundEmailHandler.RegisterRoutes(r)
+	}
+}
+
+// registerMaintenanceRoutes registers the maintenance mode admin API.
+func registerMaintenanceRoutes(r *httpserver.Router, c *Container) {
+	if c.MaintenanceHandler != nil {
+		c.MaintenanceHandler.RegisterRoutes(r)
+	}
+}
+
+// registerOutboxAdminRoutes registers the outbox quarantine admin API.
+func registerOutboxAdminRoutes(r *httpserver.Router, c *Container) {
+	if c.OutboxAdminHandler != nil {
+		c.OutboxAdminHandler.RegisterRoutes(r)
+	}
+}
+
+// registerAdminAPIRoutes registers the directory, user and message import, projection, index,
+// backup and feature flag admin API.
+func registerAdminAPIRoutes(r *httpserver.Router, c *Container) {
+	if c.AdminDirectory != nil {
+		c.AdminDirectory.RegisterRoutes(r)
+	}
+	if c.UserImportHandler != nil {
+		c.UserImportHandler.RegisterRoutes(r)
+	}
+	if c.MessageImportHandler != nil {
+		c.MessageImportHandler.RegisterRoutes(r)
+	}
+	if c.ProjectionAdmin != nil {
+		c.ProjectionAdmin.RegisterRoutes(r)
+	}
+	if c.IndexAdmin != nil {
+		c.IndexAdmin.RegisterRoutes(r)
+	}
+	if c.BackupAdmin != nil {
+		c.BackupAdmin.RegisterRoutes(r)
+	}
+	if c.FeatureFlagHandler != nil {
+		c.FeatureFlagHandler.RegisterRoutes(r)
+	}
+}
+
 // registerUserRoutes registers user-related routes.
 func registerUserRoutes(r *httpserver.Router, c *Container) {
 	if c.UserHandler != nil {
 		r.Auth().GET("/users/me", c.UserHandler.GetMe)
 		r.Auth().PUT("/users/me", c.UserHandler.UpdateMe)
+		r.Auth().POST("/users/lookup", c.UserHandler.Lookup)
 		r.Auth().GET("/users/:id", c.UserHandler.Get)
 	} else {
 		// Placeholder endpoints when handler is not initialized
 		placeholder := createPlaceholderHandler("User")
 		r.Auth().GET("/users/me", placeholder)
 		r.Auth().PUT("/users/me", placeholder)
+		r.Auth().POST("/users/lookup", placeholder)
 		r.Auth().GET("/users/:id", placeholder)
 	}
 }
@@ -332,6 +528,41 @@ func registerPageRoutes(e *echo.Echo, c *Container) {
 		c.TaskDetailTemplateHandler.SetupTaskDetailRoutes(e)
 	}
 
+	// Report page routes
+	if c.ReportTemplateHandler != nil {
+		c.ReportTemplateHandler.SetupReportRoutes(e)
+	}
+
+	// Roadmap page routes
+	if c.RoadmapTemplateHandler != nil {
+		c.RoadmapTemplateHandler.SetupRoadmapRoutes(e)
+	}
+
+	// Workspace search box results
+	if c.SearchTemplateHandler != nil {
+		c.SearchTemplateHandler.SetupSearchRoutes(e)
+	}
+
+	// Admin dashboard (system admins only)
+	if c.AdminTemplateHandler != nil {
+		c.AdminTemplateHandler.SetupAdminRoutes(e)
+	}
+
+	// Announcement banner partials (rendered on every page)
+	if c.AnnouncementTemplateHandler != nil {
+		c.AnnouncementTemplateHandler.SetupAnnouncementRoutes(e)
+	}
+
 	// TODO: Add more protected pages as frontend features are implemented:
 	// - /settings (user settings)
 }
+
+// chainMiddleware composes middlewares so that the first one runs outermost.
+func chainMiddleware(mws ...echo.MiddlewareFunc) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		for i := len(mws) - 1; i >= 0; i-- {
+			next = mws[i](next)
+		}
+		return next
+	}
+}
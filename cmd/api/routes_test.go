@@ -6,6 +6,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/labstack/echo/v4"
 	"github.com/lllypuk/flowra/internal/config"
@@ -371,3 +372,36 @@ func TestCreatePlaceholderHandler(t *testing.T) {
 	assert.Contains(t, rec.Body.String(), "NOT_IMPLEMENTED")
 	assert.Contains(t, rec.Body.String(), "Test service not available")
 }
+
+func TestSetupRoutes_CORSFromConfig(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.CORS.AllowedOrigins = "https://app.flowra.example.com"
+	cfg.CORS.AllowCredentials = true
+	cfg.CORS.MaxAge = time.Hour
+
+	c := &Container{
+		Config:         cfg,
+		Logger:         slog.Default(),
+		TokenValidator: middleware.NewStaticTokenValidator(cfg.Auth.JWTSecret),
+		AccessChecker:  middleware.NewMockWorkspaceAccessChecker(),
+		Hub:            websocket.NewHub(),
+	}
+	e := SetupRoutes(c).Echo()
+
+	req := httptest.NewRequest(http.MethodOptions, "/health", nil)
+	req.Header.Set(echo.HeaderOrigin, "https://app.flowra.example.com")
+	req.Header.Set(echo.HeaderAccessControlRequestMethod, http.MethodGet)
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	assert.Equal(t, "https://app.flowra.example.com", rec.Header().Get(echo.HeaderAccessControlAllowOrigin))
+	assert.Equal(t, "true", rec.Header().Get(echo.HeaderAccessControlAllowCredentials))
+	assert.Equal(t, "3600", rec.Header().Get(echo.HeaderAccessControlMaxAge))
+
+	req = httptest.NewRequest(http.MethodGet, "/health", nil)
+	req.Header.Set(echo.HeaderOrigin, "https://other.example.com")
+	rec = httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	assert.Empty(t, rec.Header().Get(echo.HeaderAccessControlAllowOrigin))
+}
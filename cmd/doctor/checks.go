@@ -0,0 +1,372 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/redis/go-redis/v9"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+
+	"github.com/lllypuk/flowra/internal/config"
+	"github.com/lllypuk/flowra/internal/infrastructure/keycloak"
+	mongodbinfra "github.com/lllypuk/flowra/internal/infrastructure/mongodb"
+)
+
+// Status is the outcome of a check.
+type Status string
+
+// Check outcomes.
+const (
+	StatusOK   Status = "ok"
+	StatusWarn Status = "warn"
+	StatusFail Status = "fail"
+	StatusSkip Status = "skip"
+)
+
+// startupHint is the fix for schema objects the API creates when it starts.
+const startupHint = "start the API once against this database; it creates collections and indexes at startup"
+
+// Finding is one result of a check, with a hint on how to fix it.
+type Finding struct {
+	Check   string `json:"check"`
+	Status  Status `json:"status"`
+	Message string `json:"message"`
+	Hint    string `json:"hint,omitempty"`
+}
+
+func ok(check, message string) Finding {
+	return Finding{Check: check, Status: StatusOK, Message: message}
+}
+
+func warn(check, message, hint string) Finding {
+	return Finding{Check: check, Status: StatusWarn, Message: message, Hint: hint}
+}
+
+func fail(check, message, hint string) Finding {
+	return Finding{Check: check, Status: StatusFail, Message: message, Hint: hint}
+}
+
+func skip(check, message string) Finding {
+	return Finding{Check: check, Status: StatusSkip, Message: message}
+}
+
+// checkConfig reports validation errors and settings that are valid but likely wrong.
+func checkConfig(cfg *config.Config) []Finding {
+	var findings []Finding
+
+	for _, err := range validationErrors(cfg.Validate()) {
+		findings = append(findings, fail("config", err.Error(), "fix the value in the config file or environment"))
+	}
+
+	if cfg.App.IsMockMode() {
+		findings = append(findings, warn("config", "app.mode is mock: missing dependencies are wired with placeholders",
+			"set APP_MODE=real for any shared or production deployment"))
+	}
+	if !cfg.IsProduction() {
+		findings = append(findings, warn("config", "auth.jwt_secret is the development default",
+			"set AUTH_JWT_SECRET to a long random value"))
+	}
+	if cfg.Keycloak.Enabled {
+		if cfg.Keycloak.ClientSecret == "" {
+			findings = append(findings, warn("config", "keycloak.client_secret is empty: browser sign-in will fail",
+				"set KEYCLOAK_CLIENT_SECRET to the secret of the Keycloak client"))
+		}
+		if cfg.Keycloak.AdminUsername == "" || cfg.Keycloak.AdminPassword == "" {
+			findings = append(findings, warn("config",
+				"keycloak admin credentials are not set: workspace groups and user sync are disabled",
+				"set KEYCLOAK_ADMIN_USERNAME and KEYCLOAK_ADMIN_PASSWORD"))
+		}
+		if cfg.Keycloak.Realm == "" || cfg.Keycloak.ClientID == "" {
+			findings = append(findings, fail("config", "keycloak.realm and keycloak.client_id are required",
+				"set KEYCLOAK_REALM and KEYCLOAK_CLIENT_ID"))
+		}
+	}
+
+	if len(findings) == 0 {
+		findings = append(findings, ok("config", "configuration is valid"))
+	}
+	return findings
+}
+
+// validationErrors splits the error returned by Config.Validate into the individual
+// problems, dropping the ErrConfigInvalid wrapper.
+func validationErrors(err error) []error {
+	if err == nil || err == config.ErrConfigInvalid { //nolint:errorlint // drop the sentinel itself, not wrapped errors
+		return nil
+	}
+	joined, isJoined := err.(interface{ Unwrap() []error }) //nolint:errorlint // only the top-level error is split
+	if !isJoined {
+		return []error{err}
+	}
+	var errs []error
+	for _, e := range joined.Unwrap() {
+		errs = append(errs, validationErrors(e)...)
+	}
+	return errs
+}
+
+// schemaInspector lists the collections and indexes of a database.
+type schemaInspector interface {
+	CollectionNames(ctx context.Context) ([]string, error)
+	IndexNames(ctx context.Context, collection string) ([]string, error)
+}
+
+// checkMongo connects to MongoDB and checks the schema.
+func checkMongo(ctx context.Context, cfg *config.Config) []Finding {
+	client, err := mongo.Connect(options.Client().ApplyURI(cfg.MongoDB.URI).SetTimeout(cfg.MongoDB.Timeout))
+	if err != nil {
+		return []Finding{fail("mongodb", "cannot create client: "+err.Error(), "check MONGODB_URI")}
+	}
+	defer func() { _ = client.Disconnect(context.Background()) }()
+
+	if err = client.Ping(ctx, nil); err != nil {
+		return []Finding{fail("mongodb", "ping failed: "+err.Error(),
+			"check that MongoDB is running and reachable at MONGODB_URI, and the credentials")}
+	}
+
+	findings := []Finding{ok("mongodb", "connected to database "+cfg.MongoDB.Database)}
+	inspector := &mongoInspector{db: client.Database(cfg.MongoDB.Database)}
+	return append(findings, checkSchema(ctx, inspector)...)
+}
+
+// checkSchema checks that every collection and index the application defines exists.
+func checkSchema(ctx context.Context, inspector schemaInspector) []Finding {
+	collections, err := inspector.CollectionNames(ctx)
+	if err != nil {
+		return []Finding{fail("mongodb.collections", "cannot list collections: "+err.Error(),
+			"grant the user the listCollections action on the database")}
+	}
+
+	expected := make(map[string][]string)
+	for _, idx := range mongodbinfra.GetAllIndexDefinitions() {
+		expected[idx.Collection] = append(expected[idx.Collection], idx.Name())
+	}
+	names := make([]string, 0, len(expected))
+	for name := range expected {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var findings []Finding
+	var missingCollections []string
+	missingIndexes := 0
+	for _, name := range names {
+		if !slices.Contains(collections, name) {
+			missingCollections = append(missingCollections, name)
+			continue
+		}
+
+		existing, listErr := inspector.IndexNames(ctx, name)
+		if listErr != nil {
+			findings = append(findings, fail("mongodb.indexes",
+				fmt.Sprintf("cannot list indexes of %s: %v", name, listErr), "grant the user the listIndexes action"))
+			continue
+		}
+		var missing []string
+		for _, index := range expected[name] {
+			if !slices.Contains(existing, index) {
+				missing = append(missing, index)
+			}
+		}
+		if len(missing) > 0 {
+			missingIndexes += len(missing)
+			findings = append(findings, fail("mongodb.indexes",
+				fmt.Sprintf("%s is missing indexes: %s", name, strings.Join(missing, ", ")), startupHint))
+		}
+	}
+
+	if len(missingCollections) > 0 {
+		findings = append(findings, fail("mongodb.collections",
+			"missing collections: "+strings.Join(missingCollections, ", "), startupHint))
+	} else {
+		findings = append(findings, ok("mongodb.collections", fmt.Sprintf("all %d collections exist", len(names))))
+	}
+	if missingIndexes == 0 && len(missingCollections) == 0 {
+		findings = append(findings, ok("mongodb.indexes", "all indexes exist"))
+	}
+	return findings
+}
+
+// mongoInspector implements schemaInspector on a MongoDB database.
+type mongoInspector struct {
+	db *mongo.Database
+}
+
+func (m *mongoInspector) CollectionNames(ctx context.Context) ([]string, error) {
+	return m.db.ListCollectionNames(ctx, bson.D{})
+}
+
+func (m *mongoInspector) IndexNames(ctx context.Context, collection string) ([]string, error) {
+	specs, err := m.db.Collection(collection).Indexes().ListSpecifications(ctx)
+	if err != nil {
+		return nil, err
+	}
+	names := make([]string, 0, len(specs))
+	for _, spec := range specs {
+		names = append(names, spec.Name)
+	}
+	return names, nil
+}
+
+// checkRedis pings Redis.
+func checkRedis(ctx context.Context, cfg *config.Config) []Finding {
+	client := redis.NewClient(&redis.Options{
+		Addr:     cfg.Redis.Addr,
+		Password: cfg.Redis.Password,
+		DB:       cfg.Redis.DB,
+	})
+	defer client.Close()
+
+	if err := client.Ping(ctx).Err(); err != nil {
+		return []Finding{fail("redis", "ping failed: "+err.Error(),
+			"check that Redis is running and reachable at REDIS_ADDR, and REDIS_PASSWORD")}
+	}
+	return []Finding{ok("redis", "connected to "+cfg.Redis.Addr)}
+}
+
+// checkKeycloak checks the realm, its signing keys, the client credentials and the
+// admin credentials.
+func checkKeycloak(ctx context.Context, cfg *config.Config, httpClient *http.Client) []Finding {
+	kc := cfg.Keycloak
+	if !kc.Enabled {
+		return []Finding{skip("keycloak", "keycloak is disabled")}
+	}
+
+	realmURL := strings.TrimRight(kc.URL, "/") + "/realms/" + url.PathEscape(kc.Realm)
+	var discovery struct {
+		Issuer        string `json:"issuer"`
+		TokenEndpoint string `json:"token_endpoint"`
+	}
+	status, err := getJSON(ctx, httpClient, realmURL+"/.well-known/openid-configuration", &discovery)
+	switch {
+	case err != nil:
+		return []Finding{fail("keycloak.realm", "cannot reach Keycloak: "+err.Error(),
+			"check KEYCLOAK_URL; it must be reachable from this host")}
+	case status == http.StatusNotFound:
+		return []Finding{fail("keycloak.realm", fmt.Sprintf("realm %q does not exist", kc.Realm),
+			"create the realm or fix KEYCLOAK_REALM")}
+	case status != http.StatusOK:
+		return []Finding{fail("keycloak.realm", fmt.Sprintf("realm discovery returned HTTP %d", status),
+			"check the Keycloak logs")}
+	}
+	findings := []Finding{ok("keycloak.realm", "realm "+kc.Realm+" is served at "+discovery.Issuer)}
+
+	var jwks struct {
+		Keys []json.RawMessage `json:"keys"`
+	}
+	status, err = getJSON(ctx, httpClient, keycloak.JWKSURL(kc.URL, kc.Realm), &jwks)
+	if err != nil || status != http.StatusOK || len(jwks.Keys) == 0 {
+		findings = append(findings, fail("keycloak.jwks", "realm has no usable signing keys",
+			"check the realm's key providers"))
+	} else {
+		findings = append(findings, ok("keycloak.jwks", fmt.Sprintf("%d signing keys", len(jwks.Keys))))
+	}
+
+	findings = append(findings, checkKeycloakClient(ctx, httpClient, kc, discovery.TokenEndpoint))
+	return append(findings, checkKeycloakAdmin(ctx, httpClient, kc))
+}
+
+// checkKeycloakClient verifies the client ID and secret with a client credentials grant.
+// Keycloak rejects unknown clients and wrong secrets with 401, and known clients that
+// do not allow the grant with 400 unauthorized_client, which still proves the secret.
+func checkKeycloakClient(
+	ctx context.Context,
+	httpClient *http.Client,
+	kc config.KeycloakConfig,
+	tokenEndpoint string,
+) Finding {
+	if kc.ClientSecret == "" {
+		return skip("keycloak.client", "no client secret configured")
+	}
+	if tokenEndpoint == "" {
+		tokenEndpoint = strings.TrimRight(kc.URL, "/") + "/realms/" + url.PathEscape(kc.Realm) +
+			"/protocol/openid-connect/token"
+	}
+
+	form := url.Values{
+		"grant_type":    {"client_credentials"},
+		"client_id":     {kc.ClientID},
+		"client_secret": {kc.ClientSecret},
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, tokenEndpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return fail("keycloak.client", err.Error(), "check KEYCLOAK_URL")
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return fail("keycloak.client", "token endpoint unreachable: "+err.Error(), "check KEYCLOAK_URL")
+	}
+	defer resp.Body.Close()
+
+	var body struct {
+		Error string `json:"error"`
+	}
+	_ = json.NewDecoder(resp.Body).Decode(&body)
+
+	switch {
+	case resp.StatusCode == http.StatusOK:
+		return ok("keycloak.client", "client "+kc.ClientID+" authenticated")
+	case resp.StatusCode == http.StatusBadRequest && body.Error == "unauthorized_client":
+		return ok("keycloak.client", "client "+kc.ClientID+" exists and its secret is valid")
+	case resp.StatusCode == http.StatusUnauthorized:
+		return fail("keycloak.client", "client "+kc.ClientID+" rejected: unknown client or wrong secret",
+			"check KEYCLOAK_CLIENT_ID and KEYCLOAK_CLIENT_SECRET against the client's credentials tab")
+	default:
+		return fail("keycloak.client", fmt.Sprintf("token endpoint returned HTTP %d %s", resp.StatusCode, body.Error),
+			"check the client configuration in Keycloak")
+	}
+}
+
+// checkKeycloakAdmin verifies the admin credentials used for groups and user sync.
+func checkKeycloakAdmin(ctx context.Context, httpClient *http.Client, kc config.KeycloakConfig) Finding {
+	if kc.AdminUsername == "" || kc.AdminPassword == "" {
+		return skip("keycloak.admin", "no admin credentials configured")
+	}
+
+	manager := keycloak.NewAdminTokenManager(keycloak.AdminTokenConfig{
+		KeycloakURL: kc.URL,
+		Realm:       "master",
+		ClientID:    "admin-cli",
+		Username:    kc.AdminUsername,
+		Password:    kc.AdminPassword,
+		HTTPClient:  httpClient,
+	})
+	if _, err := manager.GetToken(ctx); err != nil {
+		return fail("keycloak.admin", "admin login failed: "+err.Error(),
+			"check KEYCLOAK_ADMIN_USERNAME and KEYCLOAK_ADMIN_PASSWORD (master realm)")
+	}
+	return ok("keycloak.admin", "admin credentials are valid")
+}
+
+// getJSON fetches rawURL and decodes a 200 response into out.
+func getJSON(ctx context.Context, httpClient *http.Client, rawURL string, out any) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, rawURL, nil)
+	if err != nil {
+		return 0, err
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		_, _ = io.Copy(io.Discard, resp.Body)
+		return resp.StatusCode, nil
+	}
+	if err = json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return resp.StatusCode, fmt.Errorf("invalid response from %s: %w", rawURL, err)
+	}
+	return resp.StatusCode, nil
+}
@@ -0,0 +1,129 @@
+// Command doctor checks a Flowra environment before and after deployment: the
+// configuration, MongoDB connectivity with the required collections and indexes,
+// Redis, and the Keycloak realm and client. Every problem is printed with a hint
+// on how to fix it.
+//
+//	doctor [--config path] [--json] [--timeout 30s]
+//
+// The exit code is 1 when any check fails; warnings do not fail the run.
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/lllypuk/flowra/internal/config"
+)
+
+const defaultTimeout = 30 * time.Second
+
+// errChecksFailed is returned when at least one check failed.
+var errChecksFailed = errors.New("checks failed")
+
+func main() {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	err := run(ctx, os.Args[1:], os.Stdout, os.Stderr)
+	stop()
+
+	if err != nil {
+		if !errors.Is(err, errChecksFailed) {
+			fmt.Fprintln(os.Stderr, "doctor:", err)
+		}
+		os.Exit(1)
+	}
+}
+
+// run parses the flags, runs every check and prints the findings.
+func run(ctx context.Context, args []string, stdout, stderr io.Writer) error {
+	fs := flag.NewFlagSet("doctor", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	configPath := fs.String("config", "", "path to config file (optional)")
+	jsonOutput := fs.Bool("json", false, "print findings as JSON")
+	timeout := fs.Duration("timeout", defaultTimeout, "timeout of each service check")
+	if err := fs.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return nil
+		}
+		return err
+	}
+
+	cfg, err := config.NewLoader().LoadUnvalidated(*configPath)
+	if err != nil {
+		return fmt.Errorf("failed to load config: %w", err)
+	}
+
+	findings := runChecks(ctx, cfg, *timeout)
+
+	if *jsonOutput {
+		enc := json.NewEncoder(stdout)
+		enc.SetIndent("", "  ")
+		if err = enc.Encode(findings); err != nil {
+			return err
+		}
+	} else {
+		printFindings(stdout, findings)
+	}
+
+	for _, f := range findings {
+		if f.Status == StatusFail {
+			return errChecksFailed
+		}
+	}
+	return nil
+}
+
+// runChecks runs the checks in order, each service check with its own timeout.
+func runChecks(ctx context.Context, cfg *config.Config, timeout time.Duration) []Finding {
+	findings := checkConfig(cfg)
+
+	withTimeout := func(check func(ctx context.Context) []Finding) []Finding {
+		checkCtx, cancel := context.WithTimeout(ctx, timeout)
+		defer cancel()
+		return check(checkCtx)
+	}
+
+	httpClient := &http.Client{Timeout: timeout}
+	findings = append(findings, withTimeout(func(ctx context.Context) []Finding { return checkMongo(ctx, cfg) })...)
+	findings = append(findings, withTimeout(func(ctx context.Context) []Finding { return checkRedis(ctx, cfg) })...)
+	findings = append(findings, withTimeout(func(ctx context.Context) []Finding {
+		return checkKeycloak(ctx, cfg, httpClient)
+	})...)
+	return findings
+}
+
+// printFindings prints one line per finding, the hint below it, and a summary.
+func printFindings(w io.Writer, findings []Finding) {
+	counts := make(map[Status]int)
+	for _, f := range findings {
+		counts[f.Status]++
+		fmt.Fprintf(w, "[%-4s] %-20s %s\n", statusLabel(f.Status), f.Check, f.Message)
+		if f.Hint != "" {
+			fmt.Fprintf(w, "       %-20s -> %s\n", "", f.Hint)
+		}
+	}
+	fmt.Fprintf(w, "\n%d ok, %d warnings, %d failed, %d skipped\n",
+		counts[StatusOK], counts[StatusWarn], counts[StatusFail], counts[StatusSkip])
+}
+
+func statusLabel(status Status) string {
+	switch status {
+	case StatusOK:
+		return "OK"
+	case StatusWarn:
+		return "WARN"
+	case StatusFail:
+		return "FAIL"
+	case StatusSkip:
+		return "SKIP"
+	}
+	return string(status)
+}
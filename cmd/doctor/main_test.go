@@ -0,0 +1,207 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lllypuk/flowra/internal/config"
+	mongodbinfra "github.com/lllypuk/flowra/internal/infrastructure/mongodb"
+)
+
+func findingsWith(findings []Finding, status Status) []Finding {
+	var matched []Finding
+	for _, f := range findings {
+		if f.Status == status {
+			matched = append(matched, f)
+		}
+	}
+	return matched
+}
+
+func TestCheckConfig(t *testing.T) {
+	t.Run("default config only warns", func(t *testing.T) {
+		findings := checkConfig(config.DefaultConfig())
+
+		assert.Empty(t, findingsWith(findings, StatusFail))
+		require.NotEmpty(t, findingsWith(findings, StatusWarn))
+		assert.Contains(t, findings[0].Message, "jwt_secret")
+	})
+
+	t.Run("reports every validation error", func(t *testing.T) {
+		cfg := config.DefaultConfig()
+		cfg.Server.Port = 0
+		cfg.MongoDB.URI = ""
+
+		failed := findingsWith(checkConfig(cfg), StatusFail)
+		require.Len(t, failed, 2)
+		for _, f := range failed {
+			assert.NotContains(t, f.Message, config.ErrConfigInvalid.Error())
+			assert.NotEmpty(t, f.Hint)
+		}
+	})
+
+	t.Run("keycloak without credentials", func(t *testing.T) {
+		cfg := config.DefaultConfig()
+		cfg.Keycloak.Enabled = true
+		cfg.Keycloak.ClientSecret = ""
+		cfg.Keycloak.AdminUsername = ""
+
+		warnings := findingsWith(checkConfig(cfg), StatusWarn)
+		var messages []string
+		for _, f := range warnings {
+			messages = append(messages, f.Message)
+		}
+		assert.Contains(t, messages, "keycloak.client_secret is empty: browser sign-in will fail")
+	})
+}
+
+type fakeInspector struct {
+	collections []string
+	indexes     map[string][]string
+	err         error
+}
+
+func (f *fakeInspector) CollectionNames(context.Context) ([]string, error) {
+	return f.collections, f.err
+}
+
+func (f *fakeInspector) IndexNames(_ context.Context, collection string) ([]string, error) {
+	return f.indexes[collection], nil
+}
+
+// completeSchema returns an inspector with every collection and index in place.
+func completeSchema() *fakeInspector {
+	inspector := &fakeInspector{indexes: make(map[string][]string)}
+	for _, idx := range mongodbinfra.GetAllIndexDefinitions() {
+		if _, seen := inspector.indexes[idx.Collection]; !seen {
+			inspector.collections = append(inspector.collections, idx.Collection)
+			inspector.indexes[idx.Collection] = []string{"_id_"}
+		}
+		inspector.indexes[idx.Collection] = append(inspector.indexes[idx.Collection], idx.Name())
+	}
+	return inspector
+}
+
+func TestCheckSchema(t *testing.T) {
+	t.Run("complete schema", func(t *testing.T) {
+		findings := checkSchema(context.Background(), completeSchema())
+
+		assert.Empty(t, findingsWith(findings, StatusFail))
+		assert.Len(t, findingsWith(findings, StatusOK), 2)
+	})
+
+	t.Run("missing collection and index", func(t *testing.T) {
+		inspector := completeSchema()
+		inspector.collections = inspector.collections[1:]
+		inspector.indexes[mongodbinfra.CollectionOutbox] = []string{"_id_"}
+
+		failed := findingsWith(checkSchema(context.Background(), inspector), StatusFail)
+		require.Len(t, failed, 2)
+		assert.Contains(t, failed[0].Message, mongodbinfra.CollectionOutbox+" is missing indexes")
+		assert.Contains(t, failed[1].Message, "missing collections")
+		assert.Equal(t, startupHint, failed[1].Hint)
+	})
+
+	t.Run("listing fails", func(t *testing.T) {
+		findings := checkSchema(context.Background(), &fakeInspector{err: errors.New("unauthorized")})
+
+		require.Len(t, findings, 1)
+		assert.Equal(t, StatusFail, findings[0].Status)
+	})
+}
+
+// fakeKeycloak serves the realm endpoints the doctor checks.
+func fakeKeycloak(t *testing.T, clientStatus int, clientError string) *httptest.Server {
+	t.Helper()
+	mux := http.NewServeMux()
+	var server *httptest.Server
+	mux.HandleFunc("GET /realms/flowra/.well-known/openid-configuration", func(w http.ResponseWriter, _ *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]string{
+			"issuer":         server.URL + "/realms/flowra",
+			"token_endpoint": server.URL + "/realms/flowra/protocol/openid-connect/token",
+		})
+	})
+	mux.HandleFunc("GET /realms/flowra/protocol/openid-connect/certs", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"keys":[{"kid":"k1"}]}`))
+	})
+	mux.HandleFunc("POST /realms/flowra/protocol/openid-connect/token", func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(clientStatus)
+		_ = json.NewEncoder(w).Encode(map[string]string{"error": clientError})
+	})
+	server = httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	return server
+}
+
+func keycloakConfig(url, realm string) *config.Config {
+	cfg := config.DefaultConfig()
+	cfg.Keycloak = config.KeycloakConfig{
+		Enabled:      true,
+		URL:          url,
+		Realm:        realm,
+		ClientID:     "flowra-backend",
+		ClientSecret: "secret",
+	}
+	return cfg
+}
+
+func TestCheckKeycloak(t *testing.T) {
+	t.Run("disabled", func(t *testing.T) {
+		findings := checkKeycloak(context.Background(), config.DefaultConfig(), http.DefaultClient)
+
+		require.Len(t, findings, 1)
+		assert.Equal(t, StatusSkip, findings[0].Status)
+	})
+
+	t.Run("healthy realm and client", func(t *testing.T) {
+		server := fakeKeycloak(t, http.StatusBadRequest, "unauthorized_client")
+
+		findings := checkKeycloak(context.Background(), keycloakConfig(server.URL, "flowra"), server.Client())
+
+		assert.Empty(t, findingsWith(findings, StatusFail))
+		assert.Len(t, findingsWith(findings, StatusOK), 3)
+		assert.Len(t, findingsWith(findings, StatusSkip), 1, "admin check is skipped without credentials")
+	})
+
+	t.Run("wrong client secret", func(t *testing.T) {
+		server := fakeKeycloak(t, http.StatusUnauthorized, "invalid_client")
+
+		failed := findingsWith(
+			checkKeycloak(context.Background(), keycloakConfig(server.URL, "flowra"), server.Client()),
+			StatusFail)
+
+		require.Len(t, failed, 1)
+		assert.Equal(t, "keycloak.client", failed[0].Check)
+	})
+
+	t.Run("unknown realm", func(t *testing.T) {
+		server := fakeKeycloak(t, http.StatusOK, "")
+
+		findings := checkKeycloak(context.Background(), keycloakConfig(server.URL, "missing"), server.Client())
+
+		require.Len(t, findings, 1)
+		assert.Equal(t, StatusFail, findings[0].Status)
+		assert.Contains(t, findings[0].Message, `realm "missing" does not exist`)
+	})
+}
+
+func TestPrintFindings(t *testing.T) {
+	var out bytes.Buffer
+	printFindings(&out, []Finding{
+		ok("redis", "connected to localhost:6379"),
+		fail("mongodb", "ping failed", "check MONGODB_URI"),
+	})
+
+	assert.Contains(t, out.String(), "[OK  ] redis")
+	assert.Contains(t, out.String(), "[FAIL] mongodb")
+	assert.Contains(t, out.String(), "-> check MONGODB_URI")
+	assert.Contains(t, out.String(), "1 ok, 0 warnings, 1 failed, 0 skipped")
+}
@@ -0,0 +1,566 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"text/tabwriter"
+
+	"github.com/lllypuk/flowra/pkg/flowraclient"
+)
+
+// drainBatchSize is the number of quarantined entries fetched per drain round.
+const drainBatchSize = 500
+
+// cli runs commands against the admin API.
+type cli struct {
+	client *flowraclient.Client
+	stdin  io.Reader
+	out    io.Writer
+	stderr io.Writer
+	json   bool
+}
+
+// dispatch runs the command. Unknown commands return errUsage.
+func (c *cli) dispatch(ctx context.Context, command, sub string, args []string) error {
+	switch command {
+	case "workspaces":
+		return c.workspaces(ctx, sub, args)
+	case "users":
+		return c.users(ctx, sub, args)
+	case "messages":
+		return c.messages(ctx, sub, args)
+	case "maintenance":
+		return c.maintenance(ctx, sub, args)
+	case "projections":
+		return c.projections(ctx, sub, args)
+	case "outbox":
+		return c.outbox(ctx, sub, args)
+	case "flags":
+		return c.flags(ctx, sub, args)
+	default:
+		return errUsage
+	}
+}
+
+func (c *cli) workspaces(ctx context.Context, sub string, args []string) error {
+	switch sub {
+	case "list":
+		limit, offset, err := c.parsePage(args)
+		if err != nil {
+			return err
+		}
+		list, err := c.client.AdminListWorkspaces(ctx, limit, offset)
+		if err != nil {
+			return err
+		}
+		return c.print(list, func(w *tabwriter.Writer) {
+			fmt.Fprintln(w, "ID\tNAME\tCREATED BY\tCREATED AT")
+			for _, ws := range list.Workspaces {
+				fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", ws.ID, ws.Name, ws.CreatedBy, ws.CreatedAt)
+			}
+			fmt.Fprintf(w, "\n%d of %d workspaces\n", len(list.Workspaces), list.Total)
+		})
+	case "get":
+		id, err := singleArg(args)
+		if err != nil {
+			return err
+		}
+		ws, err := c.client.AdminGetWorkspace(ctx, id)
+		if err != nil {
+			return err
+		}
+		return c.print(ws, func(w *tabwriter.Writer) {
+			fmt.Fprintf(w, "ID:\t%s\n", ws.ID)
+			fmt.Fprintf(w, "Name:\t%s\n", ws.Name)
+			fmt.Fprintf(w, "Description:\t%s\n", ws.Description)
+			fmt.Fprintf(w, "Created by:\t%s\n", ws.CreatedBy)
+			fmt.Fprintf(w, "Keycloak group:\t%s\n", ws.KeycloakGroupID)
+			fmt.Fprintf(w, "Created at:\t%s\n", ws.CreatedAt)
+			fmt.Fprintf(w, "Updated at:\t%s\n", ws.UpdatedAt)
+			if ws.MemberCount != nil {
+				fmt.Fprintf(w, "Members:\t%d\n", *ws.MemberCount)
+			}
+		})
+	default:
+		return errUsage
+	}
+}
+
+func (c *cli) users(ctx context.Context, sub string, args []string) error {
+	switch sub {
+	case "list":
+		limit, offset, err := c.parsePage(args)
+		if err != nil {
+			return err
+		}
+		list, err := c.client.AdminListUsers(ctx, limit, offset)
+		if err != nil {
+			return err
+		}
+		return c.print(list, func(w *tabwriter.Writer) {
+			fmt.Fprintln(w, "ID\tUSERNAME\tEMAIL\tACTIVE\tADMIN")
+			for _, u := range list.Users {
+				fmt.Fprintf(w, "%s\t%s\t%s\t%t\t%t\n", u.ID, u.Username, u.Email, u.IsActive, u.IsSystemAdmin)
+			}
+			fmt.Fprintf(w, "\n%d of %d users\n", len(list.Users), list.Total)
+		})
+	case "get":
+		id, err := singleArg(args)
+		if err != nil {
+			return err
+		}
+		u, err := c.client.AdminGetUser(ctx, id)
+		if err != nil {
+			return err
+		}
+		return c.print(u, func(w *tabwriter.Writer) {
+			fmt.Fprintf(w, "ID:\t%s\n", u.ID)
+			fmt.Fprintf(w, "External ID:\t%s\n", u.ExternalID)
+			fmt.Fprintf(w, "Username:\t%s\n", u.Username)
+			fmt.Fprintf(w, "Email:\t%s\n", u.Email)
+			fmt.Fprintf(w, "Display name:\t%s\n", u.DisplayName)
+			fmt.Fprintf(w, "Active:\t%t\n", u.IsActive)
+			fmt.Fprintf(w, "System admin:\t%t\n", u.IsSystemAdmin)
+			fmt.Fprintf(w, "Created at:\t%s\n", u.CreatedAt)
+		})
+	case "import":
+		return c.importUsers(ctx, args)
+	default:
+		return errUsage
+	}
+}
+
+// importUsers creates the users of a CSV file ("-" reads stdin) and prints the
+// outcome of every row. The command fails if any row did.
+func (c *cli) importUsers(ctx context.Context, args []string) error {
+	fs := c.flagSet("users import")
+	dryRun := fs.Bool("dry-run", false, "only validate the rows")
+	if err := fs.Parse(args); err != nil || fs.NArg() != 1 {
+		return errUsage
+	}
+
+	data, err := c.readInput(fs.Arg(0))
+	if err != nil {
+		return fmt.Errorf("failed to read import file: %w", err)
+	}
+
+	report, err := c.client.AdminImportUsers(ctx, string(data), *dryRun)
+	if err != nil {
+		return err
+	}
+	if printErr := c.print(report, func(w *tabwriter.Writer) {
+		fmt.Fprintln(w, "LINE\tUSERNAME\tEMAIL\tSTATUS\tDETAIL")
+		for _, row := range report.Rows {
+			detail := row.Error
+			if detail == "" {
+				detail = row.UserID
+			}
+			fmt.Fprintf(w, "%d\t%s\t%s\t%s\t%s\n", row.Line, row.Username, row.Email, row.Status, detail)
+		}
+		if report.DryRun {
+			fmt.Fprintf(w, "\nDry run: %d valid, %d failed\n", report.Valid, report.Failed)
+		} else {
+			fmt.Fprintf(w, "\n%d created, %d failed\n", report.Created, report.Failed)
+		}
+	}); printErr != nil {
+		return printErr
+	}
+	if report.Failed > 0 {
+		return fmt.Errorf("%d rows could not be imported", report.Failed)
+	}
+	return nil
+}
+
+func (c *cli) messages(ctx context.Context, sub string, args []string) error {
+	if sub != "import" {
+		return errUsage
+	}
+	return c.importMessages(ctx, args)
+}
+
+// importMessages backfills a chat with the messages of a JSON file ("-" reads
+// stdin) holding the authors mapping and the messages, and prints the outcome of
+// every message. The command fails if any message did.
+func (c *cli) importMessages(ctx context.Context, args []string) error {
+	fs := c.flagSet("messages import")
+	chatID := fs.String("chat", "", "ID of the chat to import into")
+	dryRun := fs.Bool("dry-run", false, "only validate the messages")
+	if err := fs.Parse(args); err != nil || fs.NArg() != 1 || *chatID == "" {
+		return errUsage
+	}
+
+	data, err := c.readInput(fs.Arg(0))
+	if err != nil {
+		return fmt.Errorf("failed to read import file: %w", err)
+	}
+	var batch flowraclient.MessageImport
+	if unmarshalErr := json.Unmarshal(data, &batch); unmarshalErr != nil {
+		return fmt.Errorf("failed to parse import file: %w", unmarshalErr)
+	}
+	batch.DryRun = *dryRun
+
+	report, err := c.client.AdminImportMessages(ctx, *chatID, batch)
+	if err != nil {
+		return err
+	}
+	if printErr := c.print(report, func(w *tabwriter.Writer) {
+		fmt.Fprintln(w, "INDEX\tEXTERNAL ID\tSTATUS\tDETAIL")
+		for _, row := range report.Rows {
+			detail := row.Error
+			if detail == "" {
+				detail = row.MessageID
+			}
+			fmt.Fprintf(w, "%d\t%s\t%s\t%s\n", row.Index, row.ExternalID, row.Status, detail)
+		}
+		if report.DryRun {
+			fmt.Fprintf(w, "\nDry run: %d valid, %d failed\n", report.Valid, report.Failed)
+		} else {
+			fmt.Fprintf(w, "\n%d imported, %d failed\n", report.Imported, report.Failed)
+		}
+	}); printErr != nil {
+		return printErr
+	}
+	if report.Failed > 0 {
+		return fmt.Errorf("%d messages could not be imported", report.Failed)
+	}
+	return nil
+}
+
+func (c *cli) maintenance(ctx context.Context, sub string, args []string) error {
+	var (
+		state *flowraclient.Maintenance
+		err   error
+	)
+	switch sub {
+	case "status":
+		if len(args) > 0 {
+			return errUsage
+		}
+		state, err = c.client.GetMaintenance(ctx)
+	case "on":
+		fs := c.flagSet("maintenance on")
+		message := fs.String("message", "", "message shown to users")
+		if err = parseNoArgs(fs, args); err != nil {
+			return err
+		}
+		state, err = c.client.SetMaintenance(ctx, true, *message)
+	case "off":
+		if len(args) > 0 {
+			return errUsage
+		}
+		state, err = c.client.SetMaintenance(ctx, false, "")
+	default:
+		return errUsage
+	}
+	if err != nil {
+		return err
+	}
+
+	return c.print(state, func(w *tabwriter.Writer) {
+		fmt.Fprintf(w, "Enabled:\t%t\n", state.Enabled)
+		if state.Message != "" {
+			fmt.Fprintf(w, "Message:\t%s\n", state.Message)
+		}
+		if state.FromConfig {
+			fmt.Fprintln(w, "Forced by configuration:\ttrue")
+		}
+		if state.UpdatedBy != "" {
+			fmt.Fprintf(w, "Updated by:\t%s at %s\n", state.UpdatedBy, state.UpdatedAt)
+		}
+	})
+}
+
+func (c *cli) projections(ctx context.Context, sub string, args []string) error {
+	switch sub {
+	case "stats":
+		if len(args) > 0 {
+			return errUsage
+		}
+		stats, err := c.client.RepairQueueStats(ctx)
+		if err != nil {
+			return err
+		}
+		return c.print(stats, func(w *tabwriter.Writer) {
+			fmt.Fprintf(w, "Pending:\t%d\n", stats.Pending)
+			fmt.Fprintf(w, "Processing:\t%d\n", stats.Processing)
+			fmt.Fprintf(w, "Completed:\t%d\n", stats.Completed)
+			fmt.Fprintf(w, "Failed:\t%d\n", stats.Failed)
+			fmt.Fprintf(w, "Total:\t%d\n", stats.Total)
+		})
+	case "repair":
+		if len(args) != 2 { //nolint:mnd // type and ID
+			return errUsage
+		}
+		if err := c.client.RepairProjection(ctx, args[0], args[1]); err != nil {
+			return err
+		}
+		return c.done(fmt.Sprintf("Queued repair of %s %s", args[0], args[1]))
+	case "rebuild":
+		aggregateType, err := singleArg(args)
+		if err != nil {
+			return err
+		}
+		if err = c.client.RebuildProjections(ctx, aggregateType); err != nil {
+			return err
+		}
+		return c.done(fmt.Sprintf("Queued rebuild of all %s read models", aggregateType))
+	default:
+		return errUsage
+	}
+}
+
+func (c *cli) outbox(ctx context.Context, sub string, args []string) error {
+	switch sub {
+	case "stats":
+		if len(args) > 0 {
+			return errUsage
+		}
+		return c.outboxStats(ctx)
+	case "quarantine":
+		limit, err := c.parseLimit("outbox quarantine", args)
+		if err != nil {
+			return err
+		}
+		list, err := c.client.ListQuarantined(ctx, limit)
+		if err != nil {
+			return err
+		}
+		return c.print(list, func(w *tabwriter.Writer) {
+			fmt.Fprintln(w, "ID\tEVENT TYPE\tAGGREGATE\tRETRIES\tLAST ERROR")
+			for _, e := range list.Entries {
+				fmt.Fprintf(w, "%s\t%s\t%s/%s\t%d\t%s\n",
+					e.ID, e.EventType, e.AggregateType, e.AggregateID, e.RetryCount, e.LastError)
+			}
+			fmt.Fprintf(w, "\n%d of %d quarantined entries\n", len(list.Entries), list.Total)
+		})
+	case "requeue", "discard":
+		id, err := singleArg(args)
+		if err != nil {
+			return err
+		}
+		if sub == "requeue" {
+			err = c.client.RequeueQuarantined(ctx, id)
+		} else {
+			err = c.client.DiscardQuarantined(ctx, id)
+		}
+		if err != nil {
+			return err
+		}
+		return c.done(fmt.Sprintf("Entry %s: %sd", id, sub))
+	case "drain":
+		return c.outboxDrain(ctx, args)
+	case "dead-letters":
+		limit, err := c.parseLimit("outbox dead-letters", args)
+		if err != nil {
+			return err
+		}
+		list, err := c.client.ListDeadLetters(ctx, limit)
+		if err != nil {
+			return err
+		}
+		return c.print(list, func(w *tabwriter.Writer) {
+			fmt.Fprintln(w, "FAILED AT\tEVENT TYPE\tAGGREGATE ID\tERROR")
+			for _, e := range list.Entries {
+				fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", e.FailedAt, e.EventType, e.AggregateID, e.Error)
+			}
+			fmt.Fprintf(w, "\n%d of %d dead letters\n", len(list.Entries), list.Total)
+		})
+	default:
+		return errUsage
+	}
+}
+
+func (c *cli) outboxStats(ctx context.Context) error {
+	stats, err := c.client.OutboxStats(ctx)
+	if err != nil {
+		return err
+	}
+	return c.print(stats, func(w *tabwriter.Writer) {
+		if stats.Pending != nil {
+			fmt.Fprintf(w, "Pending:\t%d\n", *stats.Pending)
+		}
+		if stats.OldestPendingAt != "" {
+			fmt.Fprintf(w, "Oldest pending:\t%s\n", stats.OldestPendingAt)
+		}
+		fmt.Fprintf(w, "Quarantined:\t%d\n", stats.Quarantined)
+		if stats.DeadLetters != nil {
+			fmt.Fprintf(w, "Dead letters:\t%d\n", *stats.DeadLetters)
+		}
+	})
+}
+
+// outboxDrain requeues or discards every quarantined entry. Entries that fail are
+// reported and skipped; the command fails if any did.
+func (c *cli) outboxDrain(ctx context.Context, args []string) error {
+	fs := c.flagSet("outbox drain")
+	requeue := fs.Bool("requeue", false, "requeue every entry")
+	discard := fs.Bool("discard", false, "discard every entry; the events are never published")
+	if err := parseNoArgs(fs, args); err != nil {
+		return err
+	}
+	if *requeue == *discard {
+		fmt.Fprintln(c.stderr, "outbox drain: exactly one of --requeue or --discard is required")
+		return errUsage
+	}
+
+	action, apply := "requeued", c.client.RequeueQuarantined
+	if *discard {
+		action, apply = "discarded", c.client.DiscardQuarantined
+	}
+
+	var drained, failed int
+	skipped := make(map[string]bool)
+	for {
+		list, err := c.client.ListQuarantined(ctx, drainBatchSize)
+		if err != nil {
+			return err
+		}
+
+		progressed := false
+		for _, entry := range list.Entries {
+			if skipped[entry.ID] {
+				continue
+			}
+			progressed = true
+			if applyErr := apply(ctx, entry.ID); applyErr != nil && !flowraclient.IsNotFound(applyErr) {
+				fmt.Fprintf(c.stderr, "entry %s: %v\n", entry.ID, applyErr)
+				skipped[entry.ID] = true
+				failed++
+				continue
+			}
+			drained++
+		}
+		if !progressed {
+			break
+		}
+	}
+
+	if c.json {
+		return c.printJSON(map[string]any{"action": action, "drained": drained, "failed": failed})
+	}
+	fmt.Fprintf(c.out, "%d entries %s, %d failed\n", drained, action, failed)
+	if failed > 0 {
+		return fmt.Errorf("%d entries could not be %s", failed, action)
+	}
+	return nil
+}
+
+func (c *cli) flags(ctx context.Context, sub string, args []string) error {
+	switch sub {
+	case "list":
+		if len(args) > 0 {
+			return errUsage
+		}
+		flags, err := c.client.ListFeatureFlags(ctx)
+		if err != nil {
+			return err
+		}
+		return c.print(flags, func(w *tabwriter.Writer) {
+			fmt.Fprintln(w, "NAME\tENABLED\tUPDATED BY\tUPDATED AT")
+			for _, f := range flags {
+				fmt.Fprintf(w, "%s\t%t\t%s\t%s\n", f.Name, f.Enabled, f.UpdatedBy, f.UpdatedAt)
+			}
+		})
+	case "on", "off":
+		name, err := singleArg(args)
+		if err != nil {
+			return err
+		}
+		updated, err := c.client.SetFeatureFlag(ctx, name, sub == "on")
+		if err != nil {
+			return err
+		}
+		return c.print(updated, func(w *tabwriter.Writer) {
+			fmt.Fprintf(w, "%s:\t%s\n", updated.Name, sub)
+		})
+	case "delete":
+		name, err := singleArg(args)
+		if err != nil {
+			return err
+		}
+		if err = c.client.DeleteFeatureFlag(ctx, name); err != nil {
+			return err
+		}
+		return c.done("Deleted feature flag " + name)
+	default:
+		return errUsage
+	}
+}
+
+// print writes v as JSON or renders it as a table.
+func (c *cli) print(v any, table func(w *tabwriter.Writer)) error {
+	if c.json {
+		return c.printJSON(v)
+	}
+	w := tabwriter.NewWriter(c.out, 0, 0, 2, ' ', 0) //nolint:mnd // column padding
+	table(w)
+	return w.Flush()
+}
+
+func (c *cli) printJSON(v any) error {
+	enc := json.NewEncoder(c.out)
+	enc.SetIndent("", "  ")
+	return enc.Encode(v)
+}
+
+// done reports the result of a command without output data.
+func (c *cli) done(message string) error {
+	if c.json {
+		return c.printJSON(map[string]string{"status": "ok", "message": message})
+	}
+	_, err := fmt.Fprintln(c.out, message)
+	return err
+}
+
+// readInput reads the file at path, or stdin for "-".
+func (c *cli) readInput(path string) ([]byte, error) {
+	if path == "-" {
+		return io.ReadAll(c.stdin)
+	}
+	return os.ReadFile(path)
+}
+
+func (c *cli) flagSet(name string) *flag.FlagSet {
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+	fs.SetOutput(c.stderr)
+	return fs
+}
+
+func (c *cli) parsePage(args []string) (int, int, error) {
+	fs := c.flagSet("list")
+	limit := fs.Int("limit", 0, "page size")
+	offset := fs.Int("offset", 0, "number of items to skip")
+	if err := parseNoArgs(fs, args); err != nil {
+		return 0, 0, err
+	}
+	return *limit, *offset, nil
+}
+
+func (c *cli) parseLimit(name string, args []string) (int, error) {
+	fs := c.flagSet(name)
+	limit := fs.Int("limit", 0, "maximum number of entries")
+	if err := parseNoArgs(fs, args); err != nil {
+		return 0, err
+	}
+	return *limit, nil
+}
+
+// parseNoArgs parses flags and rejects positional arguments.
+func parseNoArgs(fs *flag.FlagSet, args []string) error {
+	if err := fs.Parse(args); err != nil || fs.NArg() > 0 {
+		return errUsage
+	}
+	return nil
+}
+
+// singleArg returns the only positional argument.
+func singleArg(args []string) (string, error) {
+	if len(args) != 1 || args[0] == "" {
+		return "", errUsage
+	}
+	return args[0], nil
+}
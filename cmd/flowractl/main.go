@@ -0,0 +1,127 @@
+// Command flowractl is the operator CLI for Flowra. It talks to the admin API of a
+// running server and needs the access token of a system admin.
+//
+//	flowractl [flags] <command> <subcommand> [args]
+//
+// The server URL and token default to the FLOWRA_URL and FLOWRA_TOKEN environment
+// variables.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/lllypuk/flowra/pkg/flowraclient"
+)
+
+const (
+	defaultServerURL = "http://localhost:8080"
+	defaultTimeout   = 2 * time.Minute
+)
+
+// errUsage is returned for invalid command lines; the usage text has already been printed.
+var errUsage = errors.New("invalid usage")
+
+const usage = `Usage: flowractl [flags] <command> <subcommand> [args]
+
+Commands:
+  workspaces list [--limit N] [--offset N]   list all workspaces
+  workspaces get <workspace-id>              show a workspace
+  users list [--limit N] [--offset N]        list all users
+  users get <user-id>                        show a user
+  users import [--dry-run] <file.csv>        create users from CSV (username,email[,display_name])
+  messages import --chat ID [--dry-run] <file.json>
+                                             backfill a chat with migrated messages ({"authors","messages"})
+  maintenance status                         show maintenance mode
+  maintenance on [--message TEXT]            switch maintenance mode on
+  maintenance off                            switch maintenance mode off
+  projections stats                          show repair queue counters
+  projections repair <chat|task> <id>        rebuild one read model
+  projections rebuild <chat|task>            rebuild every read model of the type
+  outbox stats                               show outbox backlog and failure queues
+  outbox quarantine [--limit N]              list quarantined outbox entries
+  outbox requeue <entry-id>                  requeue a quarantined entry
+  outbox discard <entry-id>                  discard a quarantined entry
+  outbox drain --requeue|--discard           requeue or discard every quarantined entry
+  outbox dead-letters [--limit N]            list the latest dead letters
+  flags list                                 list feature flags
+  flags on|off <name>                        switch a feature flag
+  flags delete <name>                        remove a feature flag
+
+Flags:
+`
+
+func main() {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	err := run(ctx, os.Args[1:], os.Stdout, os.Stderr)
+	stop()
+
+	if err != nil {
+		if !errors.Is(err, errUsage) {
+			fmt.Fprintln(os.Stderr, "flowractl:", err)
+		}
+		os.Exit(1)
+	}
+}
+
+// run parses the global flags and dispatches the command.
+func run(ctx context.Context, args []string, stdout, stderr io.Writer) error {
+	fs := flag.NewFlagSet("flowractl", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	serverURL := fs.String("server", envOr("FLOWRA_URL", defaultServerURL), "Flowra server URL")
+	token := fs.String("token", os.Getenv("FLOWRA_TOKEN"), "access token of a system admin")
+	jsonOutput := fs.Bool("json", false, "print JSON instead of tables")
+	timeout := fs.Duration("timeout", defaultTimeout, "overall timeout of the command")
+	fs.Usage = func() {
+		fmt.Fprint(stderr, usage)
+		fs.PrintDefaults()
+	}
+
+	if err := fs.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return nil
+		}
+		return errUsage
+	}
+	if fs.NArg() < 2 { //nolint:mnd // command and subcommand
+		fs.Usage()
+		return errUsage
+	}
+	if *token == "" {
+		return errors.New("an access token is required (--token or FLOWRA_TOKEN)")
+	}
+
+	client, err := flowraclient.New(*serverURL,
+		flowraclient.WithToken(*token),
+		flowraclient.WithUserAgent("flowractl"),
+	)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, *timeout)
+	defer cancel()
+
+	cli := &cli{client: client, stdin: os.Stdin, out: stdout, stderr: stderr, json: *jsonOutput}
+	if err = cli.dispatch(ctx, fs.Arg(0), fs.Arg(1), fs.Args()[2:]); err != nil {
+		if errors.Is(err, errUsage) {
+			fs.Usage()
+		}
+		return err
+	}
+	return nil
+}
+
+func envOr(key, fallback string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+	return fallback
+}
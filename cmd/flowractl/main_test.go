@@ -0,0 +1,221 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeAdminAPI serves a small in-memory slice of the admin API.
+type fakeAdminAPI struct {
+	mu          sync.Mutex
+	quarantined []string
+	requests    []string
+	bodies      []string
+}
+
+func (f *fakeAdminAPI) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	body, _ := io.ReadAll(r.Body)
+	f.requests = append(f.requests, r.Method+" "+r.URL.Path)
+	f.bodies = append(f.bodies, string(body))
+
+	if r.Header.Get("Authorization") != "Bearer admin-token" {
+		w.WriteHeader(http.StatusUnauthorized)
+		_, _ = w.Write([]byte(`{"success":false,"error":{"code":"UNAUTHORIZED","message":"no"}}`))
+		return
+	}
+
+	switch {
+	case r.Method == http.MethodGet && r.URL.Path == "/api/v1/admin/workspaces":
+		respond(w, map[string]any{
+			"workspaces": []map[string]any{{"id": "ws-1", "name": "Acme", "created_by": "u-1"}},
+			"total":      1,
+		})
+	case r.Method == http.MethodPut && r.URL.Path == "/api/v1/admin/maintenance":
+		respond(w, map[string]any{"enabled": true, "message": "upgrade"})
+	case r.Method == http.MethodGet && r.URL.Path == "/api/v1/admin/outbox/quarantine":
+		entries := make([]map[string]any, 0, len(f.quarantined))
+		for _, id := range f.quarantined {
+			entries = append(entries, map[string]any{"id": id, "event_type": "chat.created"})
+		}
+		respond(w, map[string]any{"entries": entries, "total": len(f.quarantined)})
+	case r.Method == http.MethodDelete && strings.HasPrefix(r.URL.Path, "/api/v1/admin/outbox/quarantine/"):
+		id := strings.TrimPrefix(r.URL.Path, "/api/v1/admin/outbox/quarantine/")
+		for i, entry := range f.quarantined {
+			if entry == id {
+				f.quarantined = append(f.quarantined[:i], f.quarantined[i+1:]...)
+				w.WriteHeader(http.StatusNoContent)
+				return
+			}
+		}
+		w.WriteHeader(http.StatusNotFound)
+	case r.Method == http.MethodPost && r.URL.Path == "/api/v1/admin/users/import":
+		var req struct {
+			CSV    string `json:"csv"`
+			DryRun bool   `json:"dry_run"`
+		}
+		_ = json.Unmarshal(body, &req)
+		status := "created"
+		if req.DryRun {
+			status = "valid"
+		}
+		respond(w, map[string]any{
+			"dry_run": req.DryRun,
+			"created": 1,
+			"failed":  1,
+			"rows": []map[string]any{
+				{"line": 2, "username": "alice", "email": "alice@example.com", "status": status},
+				{"line": 3, "username": "bob", "status": "failed", "error": "email is required"},
+			},
+		})
+	case r.Method == http.MethodPost && r.URL.Path == "/api/v1/admin/chats/chat-1/messages/import":
+		respond(w, map[string]any{
+			"imported": 1,
+			"rows": []map[string]any{
+				{"index": 0, "external_id": "m1", "status": "imported", "message_id": "msg-1"},
+			},
+		})
+	case r.Method == http.MethodPost && r.URL.Path == "/api/v1/admin/projections/rebuild":
+		w.WriteHeader(http.StatusAccepted)
+		respond(w, map[string]string{"status": "queued"})
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func respond(w http.ResponseWriter, data any) {
+	_ = json.NewEncoder(w).Encode(map[string]any{"success": true, "data": data})
+}
+
+func runCLI(t *testing.T, serverURL string, args ...string) (string, string, error) {
+	t.Helper()
+	var stdout, stderr bytes.Buffer
+	args = append([]string{"--server", serverURL, "--token", "admin-token"}, args...)
+	err := run(context.Background(), args, &stdout, &stderr)
+	return stdout.String(), stderr.String(), err
+}
+
+func TestRun(t *testing.T) {
+	api := &fakeAdminAPI{}
+	server := httptest.NewServer(api)
+	t.Cleanup(server.Close)
+
+	t.Run("lists workspaces as a table", func(t *testing.T) {
+		out, _, err := runCLI(t, server.URL, "workspaces", "list")
+		require.NoError(t, err)
+		assert.Contains(t, out, "ws-1")
+		assert.Contains(t, out, "Acme")
+		assert.Contains(t, out, "1 of 1 workspaces")
+	})
+
+	t.Run("prints JSON", func(t *testing.T) {
+		out, _, err := runCLI(t, server.URL, "--json", "workspaces", "list")
+		require.NoError(t, err)
+
+		var list struct {
+			Total int `json:"total"`
+		}
+		require.NoError(t, json.Unmarshal([]byte(out), &list))
+		assert.Equal(t, 1, list.Total)
+	})
+
+	t.Run("switches maintenance on with a message", func(t *testing.T) {
+		out, _, err := runCLI(t, server.URL, "maintenance", "on", "--message", "upgrade")
+		require.NoError(t, err)
+		assert.Contains(t, out, "upgrade")
+		assert.JSONEq(t, `{"enabled":true,"message":"upgrade"}`, api.bodies[len(api.bodies)-1])
+	})
+
+	t.Run("queues projection rebuild", func(t *testing.T) {
+		out, _, err := runCLI(t, server.URL, "projections", "rebuild", "chat")
+		require.NoError(t, err)
+		assert.Contains(t, out, "Queued rebuild of all chat read models")
+		assert.JSONEq(t, `{"aggregate_type":"chat"}`, api.bodies[len(api.bodies)-1])
+	})
+
+	t.Run("drains quarantine by discarding", func(t *testing.T) {
+		api.mu.Lock()
+		api.quarantined = []string{"e-1", "e-2", "e-3"}
+		api.mu.Unlock()
+
+		out, _, err := runCLI(t, server.URL, "outbox", "drain", "--discard")
+		require.NoError(t, err)
+		assert.Contains(t, out, "3 entries discarded, 0 failed")
+		assert.Empty(t, api.quarantined)
+	})
+
+	t.Run("drain requires exactly one action", func(t *testing.T) {
+		_, stderr, err := runCLI(t, server.URL, "outbox", "drain")
+		require.ErrorIs(t, err, errUsage)
+		assert.Contains(t, stderr, "exactly one of --requeue or --discard")
+	})
+
+	t.Run("imports users from CSV and fails on rejected rows", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "users.csv")
+		csv := "username,email\nalice,alice@example.com\nbob,\n"
+		require.NoError(t, os.WriteFile(path, []byte(csv), 0o600))
+
+		out, _, err := runCLI(t, server.URL, "users", "import", "--dry-run", path)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "1 rows could not be imported")
+		assert.Contains(t, out, "email is required")
+		assert.JSONEq(t, `{"csv":"username,email\nalice,alice@example.com\nbob,\n","dry_run":true}`,
+			api.bodies[len(api.bodies)-1])
+	})
+
+	t.Run("imports messages from JSON", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "messages.json")
+		batch := `{"authors":{"U1":"user-1"},` +
+			`"messages":[{"external_id":"m1","author":"U1","content":"hi","created_at":"2020-01-02T09:00:00Z"}]}`
+		require.NoError(t, os.WriteFile(path, []byte(batch), 0o600))
+
+		out, _, err := runCLI(t, server.URL, "messages", "import", "--chat", "chat-1", path)
+		require.NoError(t, err)
+		assert.Contains(t, out, "msg-1")
+		assert.Contains(t, out, "1 imported, 0 failed")
+		assert.JSONEq(t, `{"authors":{"U1":"user-1"},"dry_run":false,`+
+			`"messages":[{"external_id":"m1","author":"U1","content":"hi","created_at":"2020-01-02T09:00:00Z"}]}`,
+			api.bodies[len(api.bodies)-1])
+	})
+
+	t.Run("message import requires a chat", func(t *testing.T) {
+		_, _, err := runCLI(t, server.URL, "messages", "import", "-")
+		require.ErrorIs(t, err, errUsage)
+	})
+
+	t.Run("unknown command prints usage", func(t *testing.T) {
+		_, stderr, err := runCLI(t, server.URL, "nope", "list")
+		require.ErrorIs(t, err, errUsage)
+		assert.Contains(t, stderr, "Usage: flowractl")
+	})
+
+	t.Run("reports API errors", func(t *testing.T) {
+		var stdout, stderr bytes.Buffer
+		err := run(context.Background(),
+			[]string{"--server", server.URL, "--token", "wrong", "users", "list"}, &stdout, &stderr)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "UNAUTHORIZED")
+	})
+
+	t.Run("requires a token", func(t *testing.T) {
+		t.Setenv("FLOWRA_TOKEN", "")
+		var stdout, stderr bytes.Buffer
+		err := run(context.Background(), []string{"--server", server.URL, "users", "list"}, &stdout, &stderr)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "access token is required")
+	})
+}
@@ -0,0 +1,272 @@
+// Command restore rebuilds everything derived from the event store after a
+// disaster, when only the events collection (plus the event routes and partitions
+// of a partitioned store) survived or was restored from a backup.
+//
+//	restore --yes [--config path]
+//	restore --verify-only [--config path]
+//
+// The rebuild runs in dependency order:
+//
+//  1. create all indexes;
+//  2. clear the chat and task read models, the outbox and the repair queue;
+//  3. rebuild the chat read models from the chat streams;
+//  4. recount message activity on the chat read models from the messages collection;
+//  5. rebuild the task read models, resolving user names from the users collection;
+//  6. verify that every read model is at the version of its aggregate.
+//
+// The outbox is left empty: the rebuilt read models already reflect every event,
+// and replaying them would notify users again. The exit code is 1 when a step
+// fails or the verification finds mismatches.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"log/slog"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+
+	"github.com/lllypuk/flowra/internal/config"
+	"github.com/lllypuk/flowra/internal/domain/uuid"
+	"github.com/lllypuk/flowra/internal/infrastructure/eventstore"
+	"github.com/lllypuk/flowra/internal/infrastructure/mongodb"
+	"github.com/lllypuk/flowra/internal/infrastructure/projector"
+	mongorepo "github.com/lllypuk/flowra/internal/infrastructure/repository/mongodb"
+)
+
+const connectTimeout = 20 * time.Second
+
+// derivedCollections are cleared before the rebuild; none of them holds data
+// that cannot be recovered from the event store.
+//
+//nolint:gochecknoglobals // fixed lookup table
+var derivedCollections = []string{
+	mongodb.CollectionChatReadModel,
+	mongodb.CollectionTaskReadModel,
+	mongodb.CollectionOutbox,
+	mongodb.CollectionRepairQueue,
+}
+
+// restoreOptions holds the parsed command line.
+type restoreOptions struct {
+	configPath string
+	confirmed  bool
+	verifyOnly bool
+}
+
+func main() {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelInfo}))
+
+	opts, err := parseFlags(os.Args[1:])
+	if err != nil {
+		logger.Error("invalid arguments", slog.String("error", err.Error()))
+		os.Exit(1)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	err = run(ctx, logger, opts)
+	stop()
+	if err != nil {
+		logger.Error("restore failed", slog.String("error", err.Error()))
+		os.Exit(1)
+	}
+}
+
+func parseFlags(args []string) (restoreOptions, error) {
+	var opts restoreOptions
+	fs := flag.NewFlagSet("restore", flag.ContinueOnError)
+	fs.StringVar(&opts.configPath, "config", "", "path to config file (optional)")
+	fs.BoolVar(&opts.confirmed, "yes", false, "confirm that the read models, outbox and repair queue may be cleared")
+	fs.BoolVar(&opts.verifyOnly, "verify-only", false, "only compare read-model versions with the event store")
+
+	if err := fs.Parse(args); err != nil {
+		return opts, err
+	}
+	if opts.confirmed && opts.verifyOnly {
+		return opts, errors.New("--yes and --verify-only are mutually exclusive")
+	}
+	if !opts.confirmed && !opts.verifyOnly {
+		return opts, errors.New("the rebuild clears derived collections; pass --yes to proceed or --verify-only to check")
+	}
+	return opts, nil
+}
+
+func run(ctx context.Context, logger *slog.Logger, opts restoreOptions) error {
+	cfg, err := loadConfig(opts.configPath)
+	if err != nil {
+		return fmt.Errorf("failed to load config: %w", err)
+	}
+	if cfg.ReadOnly.Enabled && !opts.verifyOnly {
+		return errors.New("the rebuild writes to the primary and cannot run in a read-only region")
+	}
+
+	client, err := mongo.Connect(options.Client().ApplyURI(cfg.MongoDB.URI))
+	if err != nil {
+		return fmt.Errorf("failed to connect to MongoDB: %w", err)
+	}
+	defer func() {
+		if disconnectErr := client.Disconnect(context.Background()); disconnectErr != nil {
+			logger.Warn("failed to disconnect MongoDB client", slog.String("error", disconnectErr.Error()))
+		}
+	}()
+
+	pingCtx, cancel := context.WithTimeout(ctx, connectTimeout)
+	err = client.Ping(pingCtx, nil)
+	cancel()
+	if err != nil {
+		return fmt.Errorf("failed to ping MongoDB: %w", err)
+	}
+
+	db := client.Database(cfg.MongoDB.Database)
+	store := eventstore.NewMongoEventStore(
+		client,
+		cfg.MongoDB.Database,
+		eventstore.WithLogger(logger),
+		eventstore.WithWorkspacePartitions(cfg.EventStore.Partitions),
+	)
+
+	ids, err := store.AggregateIDs(ctx, "chat", "Chat")
+	if err != nil {
+		return fmt.Errorf("failed to list chat aggregates: %w", err)
+	}
+	logger.InfoContext(ctx, "chat aggregates found", slog.Int("count", len(ids)))
+
+	if !opts.verifyOnly {
+		r := &rebuild{db: db, store: store, cfg: cfg, logger: logger}
+		if err = r.run(ctx, ids); err != nil {
+			return err
+		}
+	}
+
+	mismatches, err := verify(ctx, db, store, ids)
+	if err != nil {
+		return fmt.Errorf("verification failed: %w", err)
+	}
+	for _, m := range mismatches {
+		logger.WarnContext(ctx, "read model version mismatch",
+			slog.String("aggregate_id", m.AggregateID),
+			slog.String("read_model", m.ReadModel),
+			slog.Int("aggregate_version", m.Expected),
+			slog.Int("read_model_version", m.Actual),
+		)
+	}
+	logger.InfoContext(ctx, "verification completed",
+		slog.Int("aggregates", len(ids)),
+		slog.Int("mismatches", len(mismatches)),
+	)
+	if len(mismatches) > 0 {
+		return fmt.Errorf("%d read models do not match the event store", len(mismatches))
+	}
+	return nil
+}
+
+// rebuild recreates the derived collections from the event store.
+type rebuild struct {
+	db     *mongo.Database
+	store  *eventstore.MongoEventStore
+	cfg    *config.Config
+	logger *slog.Logger
+}
+
+func (r *rebuild) run(ctx context.Context, ids []string) error {
+	start := time.Now()
+
+	if err := mongodb.CreateAllIndexes(ctx, r.db); err != nil {
+		return fmt.Errorf("failed to create indexes: %w", err)
+	}
+	if r.cfg.EventStore.Partitioned() {
+		if err := mongodb.CreateEventPartitionIndexes(ctx, r.db, r.cfg.EventStore.Partitions); err != nil {
+			return fmt.Errorf("failed to create event partition indexes: %w", err)
+		}
+	}
+	r.logger.InfoContext(ctx, "indexes created")
+
+	for _, name := range derivedCollections {
+		if _, err := r.db.Collection(name).DeleteMany(ctx, bson.M{}); err != nil {
+			return fmt.Errorf("failed to clear %s: %w", name, err)
+		}
+		r.logger.InfoContext(ctx, "collection cleared", slog.String("collection", name))
+	}
+
+	chatsColl := r.db.Collection(mongodb.CollectionChatReadModel)
+	chats := projector.NewChatProjector(r.store, chatsColl, r.logger)
+	if err := r.project(ctx, "chats", ids, chats.RebuildOne); err != nil {
+		return err
+	}
+
+	activity := projector.NewChatActivityProjector(chatsColl, r.logger)
+	updated, err := activity.RebuildFromMessages(ctx, r.db.Collection(mongodb.CollectionMessages))
+	if err != nil {
+		return fmt.Errorf("failed to recount message activity: %w", err)
+	}
+	r.logger.InfoContext(ctx, "message activity recounted", slog.Int("chats", updated))
+
+	userRepo := mongorepo.NewMongoUserRepository(r.db.Collection(mongodb.CollectionUsers))
+	tasks := projector.NewChatToTaskReadModelProjector(
+		r.store, r.db.Collection(mongodb.CollectionTaskReadModel), r.logger,
+		projector.WithTaskUserDirectory(userRepo),
+	)
+	if err = r.project(ctx, "tasks", ids, tasks.RebuildOne); err != nil {
+		return err
+	}
+
+	r.logger.InfoContext(ctx, "rebuild completed",
+		slog.Int("aggregates", len(ids)),
+		slog.Duration("duration", time.Since(start)),
+	)
+	return nil
+}
+
+// project rebuilds one read model for every aggregate. Failures are logged per
+// aggregate and reported together, so one broken stream does not hide others.
+func (r *rebuild) project(
+	ctx context.Context,
+	readModel string,
+	ids []string,
+	rebuildOne func(context.Context, uuid.UUID) error,
+) error {
+	failed := 0
+	for _, idStr := range ids {
+		id, err := uuid.ParseUUID(idStr)
+		if err == nil {
+			err = rebuildOne(ctx, id)
+		}
+		if err != nil {
+			failed++
+			r.logger.ErrorContext(ctx, "failed to rebuild read model",
+				slog.String("read_model", readModel),
+				slog.String("aggregate_id", idStr),
+				slog.String("error", err.Error()),
+			)
+		}
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return ctxErr
+		}
+	}
+
+	r.logger.InfoContext(ctx, "read model rebuilt",
+		slog.String("read_model", readModel),
+		slog.Int("aggregates", len(ids)),
+		slog.Int("failed", failed),
+	)
+	if failed > 0 {
+		return fmt.Errorf("%d of %d %s could not be rebuilt", failed, len(ids), readModel)
+	}
+	return nil
+}
+
+func loadConfig(configPath string) (*config.Config, error) {
+	if strings.TrimSpace(configPath) == "" {
+		return config.Load()
+	}
+	return config.LoadFromPath(configPath)
+}
@@ -0,0 +1,50 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lllypuk/flowra/internal/infrastructure/mongodb"
+)
+
+func TestParseFlags(t *testing.T) {
+	t.Run("confirmed rebuild", func(t *testing.T) {
+		opts, err := parseFlags([]string{"--yes", "--config", "configs/config.yaml"})
+		require.NoError(t, err)
+		assert.True(t, opts.confirmed)
+		assert.Equal(t, "configs/config.yaml", opts.configPath)
+	})
+
+	t.Run("verify only", func(t *testing.T) {
+		opts, err := parseFlags([]string{"--verify-only"})
+		require.NoError(t, err)
+		assert.True(t, opts.verifyOnly)
+	})
+
+	t.Run("rejects invalid combinations", func(t *testing.T) {
+		for _, args := range [][]string{
+			{},
+			{"--yes", "--verify-only"},
+		} {
+			_, err := parseFlags(args)
+			assert.Error(t, err, "%v", args)
+		}
+	})
+}
+
+func TestCompareVersions(t *testing.T) {
+	aggregates := map[string]int{"a": 3, "b": 5, "c": 2}
+	chats := map[string]int{"a": 3, "b": 4, "orphan": 1}
+	tasks := map[string]int{"a": 3, "b": 5, "c": 1}
+
+	assert.Equal(t, []versionMismatch{
+		{AggregateID: "b", ReadModel: mongodb.CollectionChatReadModel, Expected: 5, Actual: 4},
+		{AggregateID: "c", ReadModel: mongodb.CollectionChatReadModel, Expected: 2, Actual: missingVersion},
+		{AggregateID: "c", ReadModel: mongodb.CollectionTaskReadModel, Expected: 2, Actual: 1},
+		{AggregateID: "orphan", ReadModel: mongodb.CollectionChatReadModel, Actual: 1},
+	}, compareVersions(aggregates, chats, tasks))
+
+	assert.Empty(t, compareVersions(map[string]int{"a": 1}, map[string]int{"a": 1}, map[string]int{}))
+}
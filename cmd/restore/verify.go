@@ -0,0 +1,133 @@
+package main
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+
+	"github.com/lllypuk/flowra/internal/infrastructure/mongodb"
+)
+
+// missingVersion marks a read model that does not exist.
+const missingVersion = -1
+
+// versionSource reports the version of an aggregate in the event store.
+type versionSource interface {
+	GetVersion(ctx context.Context, aggregateID string) (int, error)
+}
+
+// versionMismatch is a read model that is not at the version of its aggregate.
+// Expected is 0 for read models without events, Actual is missingVersion for
+// aggregates without a read model.
+type versionMismatch struct {
+	AggregateID string
+	ReadModel   string
+	Expected    int
+	Actual      int
+}
+
+// verify compares the version of every chat aggregate with the version stored in
+// its chat read model and, for tasks, bugs and epics, in its task read model.
+func verify(ctx context.Context, db *mongo.Database, store versionSource, ids []string) ([]versionMismatch, error) {
+	aggregates := make(map[string]int, len(ids))
+	for _, id := range ids {
+		version, err := store.GetVersion(ctx, id)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get version of %s: %w", id, err)
+		}
+		aggregates[id] = version
+	}
+
+	chats, err := readModelVersions(ctx, db.Collection(mongodb.CollectionChatReadModel), "chat_id")
+	if err != nil {
+		return nil, err
+	}
+	tasks, err := readModelVersions(ctx, db.Collection(mongodb.CollectionTaskReadModel), "task_id")
+	if err != nil {
+		return nil, err
+	}
+	return compareVersions(aggregates, chats, tasks), nil
+}
+
+// readModelVersions returns the version of every document of a read model by ID.
+func readModelVersions(ctx context.Context, coll *mongo.Collection, idField string) (map[string]int, error) {
+	opts := options.Find().SetProjection(bson.M{idField: 1, "version": 1})
+	cursor, err := coll.Find(ctx, bson.M{}, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query %s: %w", coll.Name(), err)
+	}
+	defer cursor.Close(ctx)
+
+	versions := make(map[string]int)
+	for cursor.Next(ctx) {
+		var doc bson.M
+		if err = cursor.Decode(&doc); err != nil {
+			return nil, fmt.Errorf("failed to decode %s document: %w", coll.Name(), err)
+		}
+		id, _ := doc[idField].(string)
+		versions[id] = versionOf(doc["version"])
+	}
+	if err = cursor.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", coll.Name(), err)
+	}
+	return versions, nil
+}
+
+// versionOf reads a version stored as any integer type; read models written
+// before versions were stored have none.
+func versionOf(value any) int {
+	switch v := value.(type) {
+	case int32:
+		return int(v)
+	case int64:
+		return int(v)
+	default:
+		return 0
+	}
+}
+
+// compareVersions returns the read models that are missing, behind or ahead of
+// their aggregate, and those left without events, sorted by aggregate ID. Task
+// read models only exist for some chat types, so a missing one is not reported.
+func compareVersions(aggregates, chats, tasks map[string]int) []versionMismatch {
+	var mismatches []versionMismatch
+	for id, expected := range aggregates {
+		actual, ok := chats[id]
+		if !ok {
+			actual = missingVersion
+		}
+		if actual != expected {
+			mismatches = append(mismatches, versionMismatch{
+				AggregateID: id, ReadModel: mongodb.CollectionChatReadModel, Expected: expected, Actual: actual,
+			})
+		}
+		if actual, ok = tasks[id]; ok && actual != expected {
+			mismatches = append(mismatches, versionMismatch{
+				AggregateID: id, ReadModel: mongodb.CollectionTaskReadModel, Expected: expected, Actual: actual,
+			})
+		}
+	}
+	for readModel, versions := range map[string]map[string]int{
+		mongodb.CollectionChatReadModel: chats,
+		mongodb.CollectionTaskReadModel: tasks,
+	} {
+		for id, actual := range versions {
+			if _, ok := aggregates[id]; !ok {
+				mismatches = append(mismatches, versionMismatch{
+					AggregateID: id, ReadModel: readModel, Actual: actual,
+				})
+			}
+		}
+	}
+
+	slices.SortFunc(mismatches, func(a, b versionMismatch) int {
+		return cmp.Or(strings.Compare(a.AggregateID, b.AggregateID), strings.Compare(a.ReadModel, b.ReadModel))
+	})
+	return mismatches
+}
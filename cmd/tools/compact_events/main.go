@@ -0,0 +1,180 @@
+// Command compact_events compacts the event streams of noisy chat aggregates: it
+// archives the original stream in events_archive and replaces superseded events
+// (repeated renames, status flapping, ...) with a snapshot. Run it while no
+// projection rebuild is in progress; concurrent saves abort the affected aggregate
+// and can be retried.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"log/slog"
+	"os"
+	"strings"
+	"time"
+
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+
+	"github.com/lllypuk/flowra/internal/config"
+	"github.com/lllypuk/flowra/internal/domain/uuid"
+	"github.com/lllypuk/flowra/internal/infrastructure/eventstore"
+	"github.com/lllypuk/flowra/internal/infrastructure/mongodb"
+)
+
+const (
+	connectTimeout = 20 * time.Second
+
+	// defaultMinEvents leaves short streams alone; compacting them saves little.
+	defaultMinEvents = 50
+)
+
+// compactOptions holds the parsed command line.
+type compactOptions struct {
+	configPath  string
+	aggregateID string
+	all         bool
+	minEvents   int
+	dryRun      bool
+}
+
+func main() {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelInfo}))
+
+	opts, err := parseFlags(os.Args[1:])
+	if err != nil {
+		logger.Error("invalid arguments", slog.String("error", err.Error()))
+		os.Exit(1)
+	}
+
+	if err = run(logger, opts); err != nil {
+		logger.Error("compaction failed", slog.String("error", err.Error()))
+		os.Exit(1)
+	}
+}
+
+func parseFlags(args []string) (compactOptions, error) {
+	var opts compactOptions
+	fs := flag.NewFlagSet("compact_events", flag.ContinueOnError)
+	fs.StringVar(&opts.configPath, "config", "", "path to config file (optional)")
+	fs.StringVar(&opts.aggregateID, "id", "", "chat ID to compact")
+	fs.BoolVar(&opts.all, "all", false, "compact every chat with at least --min-events events")
+	fs.IntVar(&opts.minEvents, "min-events", defaultMinEvents, "skip chats with fewer events")
+	fs.BoolVar(&opts.dryRun, "dry-run", false, "report what would be dropped without writing")
+
+	if err := fs.Parse(args); err != nil {
+		return opts, err
+	}
+	if opts.all == (opts.aggregateID != "") {
+		return opts, errors.New("exactly one of --id or --all must be specified")
+	}
+	if opts.aggregateID != "" {
+		if _, err := uuid.ParseUUID(opts.aggregateID); err != nil {
+			return opts, fmt.Errorf("invalid chat ID %q: %w", opts.aggregateID, err)
+		}
+		// an explicitly named chat is compacted whatever its length
+		opts.minEvents = 0
+	}
+	if opts.minEvents < 0 {
+		return opts, errors.New("--min-events must not be negative")
+	}
+	return opts, nil
+}
+
+func run(logger *slog.Logger, opts compactOptions) error {
+	cfg, err := loadConfig(opts.configPath)
+	if err != nil {
+		return fmt.Errorf("failed to load config: %w", err)
+	}
+	if cfg.ReadOnly.Enabled {
+		return errors.New("compaction writes to the primary and cannot run in a read-only region")
+	}
+
+	ctx := context.Background()
+
+	client, err := mongo.Connect(options.Client().ApplyURI(cfg.MongoDB.URI))
+	if err != nil {
+		return fmt.Errorf("failed to connect to MongoDB: %w", err)
+	}
+	defer func() {
+		if disconnectErr := client.Disconnect(context.Background()); disconnectErr != nil {
+			logger.Warn("failed to disconnect MongoDB client", slog.String("error", disconnectErr.Error()))
+		}
+	}()
+
+	pingCtx, cancel := context.WithTimeout(ctx, connectTimeout)
+	err = client.Ping(pingCtx, nil)
+	cancel()
+	if err != nil {
+		return fmt.Errorf("failed to ping MongoDB: %w", err)
+	}
+
+	db := client.Database(cfg.MongoDB.Database)
+	if err = mongodb.CreateCollectionIndexes(ctx, db, mongodb.CollectionEventArchive); err != nil {
+		return fmt.Errorf("failed to create archive indexes: %w", err)
+	}
+
+	store := eventstore.NewMongoEventStore(
+		client,
+		cfg.MongoDB.Database,
+		eventstore.WithLogger(logger),
+		eventstore.WithWorkspacePartitions(cfg.EventStore.Partitions),
+	)
+
+	ids := []string{opts.aggregateID}
+	if opts.all {
+		if ids, err = store.AggregateIDs(ctx, "chat", "Chat"); err != nil {
+			return err
+		}
+	}
+
+	var compacted, dropped, failed int
+	for _, id := range ids {
+		result, compactErr := store.CompactAggregate(ctx, id, eventstore.CompactionOptions{
+			MinEvents: opts.minEvents,
+			DryRun:    opts.dryRun,
+		})
+		if compactErr != nil {
+			failed++
+			logger.ErrorContext(ctx, "failed to compact chat",
+				slog.String("chat_id", id),
+				slog.String("error", compactErr.Error()),
+			)
+			continue
+		}
+		if result.EventsDropped == 0 {
+			continue
+		}
+
+		compacted++
+		dropped += result.EventsDropped
+		logger.InfoContext(ctx, "chat stream compacted",
+			slog.String("chat_id", id),
+			slog.Int("events_before", result.EventsBefore),
+			slog.Int("events_dropped", result.EventsDropped),
+			slog.Int("snapshot_version", result.SnapshotVersion),
+			slog.Bool("dry_run", opts.dryRun),
+		)
+	}
+
+	logger.InfoContext(ctx, "compaction completed",
+		slog.Int("chats_checked", len(ids)),
+		slog.Int("chats_compacted", compacted),
+		slog.Int("events_dropped", dropped),
+		slog.Int("failed", failed),
+		slog.Bool("dry_run", opts.dryRun),
+	)
+	if failed > 0 {
+		return fmt.Errorf("%d chats could not be compacted", failed)
+	}
+	return nil
+}
+
+func loadConfig(configPath string) (*config.Config, error) {
+	if strings.TrimSpace(configPath) == "" {
+		return config.Load()
+	}
+	return config.LoadFromPath(configPath)
+}
@@ -0,0 +1,36 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseFlags(t *testing.T) {
+	t.Run("all chats", func(t *testing.T) {
+		opts, err := parseFlags([]string{"--all", "--dry-run"})
+		require.NoError(t, err)
+		assert.True(t, opts.all)
+		assert.True(t, opts.dryRun)
+		assert.Equal(t, defaultMinEvents, opts.minEvents)
+	})
+
+	t.Run("one chat ignores the minimum", func(t *testing.T) {
+		opts, err := parseFlags([]string{"--id", "0b9c6a52-3c4f-4a8e-9f41-0d2f4f1b7a10"})
+		require.NoError(t, err)
+		assert.Equal(t, 0, opts.minEvents)
+	})
+
+	t.Run("rejects invalid combinations", func(t *testing.T) {
+		for _, args := range [][]string{
+			{},
+			{"--all", "--id", "0b9c6a52-3c4f-4a8e-9f41-0d2f4f1b7a10"},
+			{"--id", "not-a-uuid"},
+			{"--all", "--min-events", "-1"},
+		} {
+			_, err := parseFlags(args)
+			assert.Error(t, err, "%v", args)
+		}
+	})
+}
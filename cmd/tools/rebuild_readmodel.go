@@ -12,6 +12,7 @@ import (
 	"github.com/lllypuk/flowra/internal/infrastructure/eventstore"
 	"github.com/lllypuk/flowra/internal/infrastructure/mongodb"
 	"github.com/lllypuk/flowra/internal/infrastructure/projector"
+	mongorepo "github.com/lllypuk/flowra/internal/infrastructure/repository/mongodb"
 	"go.mongodb.org/mongo-driver/v2/mongo"
 	"go.mongodb.org/mongo-driver/v2/mongo/options"
 )
@@ -77,6 +78,7 @@ func main() {
 		client,
 		cfg.MongoDB.Database,
 		eventstore.WithLogger(logger),
+		eventstore.WithWorkspacePartitions(cfg.EventStore.Partitions),
 	)
 
 	// Create projector based on type
@@ -87,7 +89,10 @@ func main() {
 		proj = projector.NewChatProjector(eventStore, readModelColl, logger)
 	case "task":
 		readModelColl := db.Collection(mongodb.CollectionTaskReadModel)
-		proj = projector.NewChatToTaskReadModelProjector(eventStore, readModelColl, logger)
+		userRepo := mongorepo.NewMongoUserRepository(db.Collection(mongodb.CollectionUsers))
+		proj = projector.NewChatToTaskReadModelProjector(
+			eventStore, readModelColl, logger, projector.WithTaskUserDirectory(userRepo),
+		)
 	}
 
 	// Execute operation
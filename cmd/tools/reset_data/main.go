@@ -78,6 +78,13 @@ func run(logger *slog.Logger) error {
 
 	db := client.Database(cfg.MongoDB.Database)
 
+	if cfg.EventStore.Partitioned() {
+		resetCollections = append(resetCollections, mongodb.CollectionEventRoutes)
+		for partition := range cfg.EventStore.Partitions {
+			resetCollections = append(resetCollections, mongodb.EventPartitionCollection(partition))
+		}
+	}
+
 	for _, collectionName := range resetCollections {
 		err = db.Collection(collectionName).Drop(ctx)
 		if err != nil && !isNamespaceNotFound(err) {
@@ -90,6 +97,11 @@ func run(logger *slog.Logger) error {
 	if err = mongodb.CreateAllIndexes(ctx, db); err != nil {
 		return fmt.Errorf("failed to recreate MongoDB indexes: %w", err)
 	}
+	if cfg.EventStore.Partitioned() {
+		if err = mongodb.CreateEventPartitionIndexes(ctx, db, cfg.EventStore.Partitions); err != nil {
+			return fmt.Errorf("failed to recreate event partition indexes: %w", err)
+		}
+	}
 
 	logger.Info("data reset completed",
 		slog.String("database", cfg.MongoDB.Database),
@@ -10,11 +10,17 @@ import (
 	"syscall"
 	"time"
 
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/redis/go-redis/v9"
 	"go.mongodb.org/mongo-driver/v2/mongo"
 	"go.mongodb.org/mongo-driver/v2/mongo/options"
 
+	"github.com/lllypuk/flowra/internal/buildinfo"
 	"github.com/lllypuk/flowra/internal/config"
+	"github.com/lllypuk/flowra/internal/infrastructure/diagnostics"
+	"github.com/lllypuk/flowra/internal/infrastructure/logging"
+	"github.com/lllypuk/flowra/internal/infrastructure/metrics"
+	mongodbinfra "github.com/lllypuk/flowra/internal/infrastructure/mongodb"
 	"github.com/lllypuk/flowra/internal/worker"
 )
 
@@ -34,11 +40,17 @@ func main() {
 	logger := setupLogger(cfg)
 
 	logger.Info("starting flowra worker service",
-		slog.String("version", "0.1.0"),
+		slog.Any("build", buildinfo.Get()),
 		slog.String("environment", getEnvironment(cfg)),
 	)
 	config.LogDevRuntimeMode(logger, cfg, "worker")
 
+	// Every worker writes; read-only regions rely on the workers of the primary region.
+	if cfg.ReadOnly.Enabled {
+		logger.Error("worker service cannot run with READ_ONLY_ENABLED")
+		os.Exit(1)
+	}
+
 	// Create a context that will be cancelled on shutdown signal
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
@@ -81,8 +93,12 @@ func main() {
 	}
 	pingCancel()
 
+	metrics.NewRedisPoolCollector(prometheus.DefaultRegisterer, redisClient)
+
 	logger.InfoContext(ctx, "connected to Redis", slog.String("addr", cfg.Redis.Addr))
 
+	startDiagnostics(ctx, cfg, logger)
+
 	db := mongoClient.Database(cfg.MongoDB.Database)
 	if runErr := worker.Run(ctx, cfg, db, redisClient); runErr != nil && !errors.Is(runErr, context.Canceled) {
 		logger.Error("worker service failed", slog.String("error", runErr.Error()))
@@ -107,7 +123,7 @@ func setupLogger(cfg *config.Config) *slog.Logger {
 		handler = slog.NewJSONHandler(os.Stdout, opts)
 	}
 
-	logger := slog.New(handler)
+	logger := slog.New(logging.NewContextHandler(handler))
 	slog.SetDefault(logger)
 
 	return logger
@@ -142,9 +158,16 @@ func getEnvironment(cfg *config.Config) string {
 
 // connectMongoDB establishes a connection to MongoDB.
 func connectMongoDB(ctx context.Context, cfg *config.Config, logger *slog.Logger) (*mongo.Client, error) {
+	poolMetrics := metrics.NewMongoPoolMetrics(prometheus.DefaultRegisterer)
+
 	clientOpts := options.Client().
 		ApplyURI(cfg.MongoDB.URI).
-		SetMaxPoolSize(cfg.MongoDB.MaxPoolSize)
+		SetMaxPoolSize(cfg.MongoDB.MaxPoolSize).
+		SetPoolMonitor(poolMetrics.PoolMonitor())
+	if threshold := cfg.MongoDB.SlowQueryThreshold; threshold > 0 {
+		queryMetrics := metrics.NewMongoQueryMetrics(prometheus.DefaultRegisterer)
+		clientOpts.SetMonitor(mongodbinfra.NewSlowQueryMonitor(threshold, logger, queryMetrics).CommandMonitor())
+	}
 
 	client, err := mongo.Connect(clientOpts)
 	if err != nil {
@@ -166,6 +189,30 @@ func connectMongoDB(ctx context.Context, cfg *config.Config, logger *slog.Logger
 	return client, nil
 }
 
+// startDiagnostics runs the loopback-only diagnostics listener when configured.
+// The worker has no authenticated HTTP router, so a listen address is required.
+func startDiagnostics(ctx context.Context, cfg *config.Config, logger *slog.Logger) {
+	if !cfg.Diagnostics.Enabled {
+		return
+	}
+	if cfg.Diagnostics.ListenAddr == "" {
+		logger.WarnContext(ctx, "diagnostics enabled but diagnostics.listen_addr is empty; worker diagnostics disabled")
+		return
+	}
+
+	server, err := diagnostics.NewServer(cfg.Diagnostics.ListenAddr, logger)
+	if err != nil {
+		logger.ErrorContext(ctx, "failed to create diagnostics listener", slog.String("error", err.Error()))
+		return
+	}
+
+	go func() {
+		if runErr := server.Run(ctx); runErr != nil {
+			logger.Error("diagnostics listener stopped with error", slog.String("error", runErr.Error()))
+		}
+	}()
+}
+
 // handleShutdown listens for OS signals and cancels the context.
 func handleShutdown(cancel context.CancelFunc, logger *slog.Logger) {
 	quit := make(chan os.Signal, 1)
@@ -0,0 +1,45 @@
+package announcement
+
+import (
+	"time"
+
+	"github.com/lllypuk/flowra/internal/domain/announcement"
+	"github.com/lllypuk/flowra/internal/domain/uuid"
+)
+
+// PublishCommand - publish a system-wide announcement
+type PublishCommand struct {
+	Message  string
+	Level    announcement.Level
+	StartsAt time.Time // zero publishes immediately
+	EndsAt   time.Time
+	AdminID  uuid.UUID
+}
+
+func (c PublishCommand) CommandName() string { return "PublishAnnouncement" }
+
+// EndCommand - take an announcement down before it expires
+type EndCommand struct {
+	AnnouncementID uuid.UUID
+	AdminID        uuid.UUID
+}
+
+func (c EndCommand) CommandName() string { return "EndAnnouncement" }
+
+// DismissCommand - hide an announcement banner for one user
+type DismissCommand struct {
+	AnnouncementID uuid.UUID
+	UserID         uuid.UUID
+}
+
+func (c DismissCommand) CommandName() string { return "DismissAnnouncement" }
+
+// ListQuery - list recent announcements for system admins
+type ListQuery struct {
+	Limit int
+}
+
+// ListActiveQuery - list the banners a user should see now
+type ListActiveQuery struct {
+	UserID uuid.UUID
+}
@@ -0,0 +1,42 @@
+package announcement
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/lllypuk/flowra/internal/application/appcore"
+	"github.com/lllypuk/flowra/internal/domain/errs"
+)
+
+// DismissUseCase hides an announcement banner for one user
+type DismissUseCase struct {
+	repo Repository
+}
+
+// NewDismissUseCase creates a new DismissUseCase
+func NewDismissUseCase(repo Repository) *DismissUseCase {
+	return &DismissUseCase{repo: repo}
+}
+
+// Execute records the dismissal
+func (uc *DismissUseCase) Execute(ctx context.Context, cmd DismissCommand) error {
+	if err := appcore.ValidateUUID("announcementID", cmd.AnnouncementID); err != nil {
+		return fmt.Errorf("validation failed: %w", err)
+	}
+	if err := appcore.ValidateUUID("userID", cmd.UserID); err != nil {
+		return fmt.Errorf("validation failed: %w", err)
+	}
+
+	if _, err := uc.repo.FindByID(ctx, cmd.AnnouncementID); err != nil {
+		if errors.Is(err, errs.ErrNotFound) {
+			return ErrAnnouncementNotFound
+		}
+		return fmt.Errorf("failed to load announcement: %w", err)
+	}
+
+	if err := uc.repo.Dismiss(ctx, cmd.AnnouncementID, cmd.UserID); err != nil {
+		return fmt.Errorf("failed to dismiss announcement: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,59 @@
+package announcement
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/lllypuk/flowra/internal/application/appcore"
+	"github.com/lllypuk/flowra/internal/domain/announcement"
+	"github.com/lllypuk/flowra/internal/domain/errs"
+	"github.com/lllypuk/flowra/internal/domain/event"
+)
+
+// EndUseCase takes announcements down before they expire
+type EndUseCase struct {
+	repo     Repository
+	eventBus event.Bus
+}
+
+// NewEndUseCase creates a new EndUseCase
+func NewEndUseCase(repo Repository, eventBus event.Bus) *EndUseCase {
+	return &EndUseCase{
+		repo:     repo,
+		eventBus: eventBus,
+	}
+}
+
+// Execute ends the announcement now and notifies connected clients
+func (uc *EndUseCase) Execute(ctx context.Context, cmd EndCommand) (*announcement.Announcement, error) {
+	if err := appcore.ValidateUUID("announcementID", cmd.AnnouncementID); err != nil {
+		return nil, fmt.Errorf("validation failed: %w", err)
+	}
+	if err := appcore.ValidateUUID("adminID", cmd.AdminID); err != nil {
+		return nil, fmt.Errorf("validation failed: %w", err)
+	}
+
+	a, err := uc.repo.FindByID(ctx, cmd.AnnouncementID)
+	if err != nil {
+		if errors.Is(err, errs.ErrNotFound) {
+			return nil, ErrAnnouncementNotFound
+		}
+		return nil, fmt.Errorf("failed to load announcement: %w", err)
+	}
+
+	if endErr := a.End(time.Now()); endErr != nil {
+		return nil, ErrAnnouncementEnded
+	}
+
+	if saveErr := uc.repo.Save(ctx, a); saveErr != nil {
+		return nil, fmt.Errorf("failed to save announcement: %w", saveErr)
+	}
+
+	if uc.eventBus != nil {
+		_ = uc.eventBus.Publish(ctx, announcement.NewEnded(a.ID(), appcore.NewEventMetadata(ctx, cmd.AdminID, cmd)))
+	}
+
+	return a, nil
+}
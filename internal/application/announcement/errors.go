@@ -0,0 +1,11 @@
+package announcement
+
+import "errors"
+
+var (
+	// ErrAnnouncementNotFound is returned when the announcement does not exist
+	ErrAnnouncementNotFound = errors.New("announcement not found")
+
+	// ErrAnnouncementEnded is returned when ending an announcement that already expired
+	ErrAnnouncementEnded = errors.New("announcement already ended")
+)
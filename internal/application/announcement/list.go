@@ -0,0 +1,84 @@
+package announcement
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/lllypuk/flowra/internal/application/appcore"
+	"github.com/lllypuk/flowra/internal/domain/announcement"
+	"github.com/lllypuk/flowra/internal/domain/uuid"
+)
+
+// List limits.
+const (
+	DefaultListLimit = 50
+	MaxListLimit     = 200
+)
+
+// ListUseCase lists recent announcements for system admins
+type ListUseCase struct {
+	repo Repository
+}
+
+// NewListUseCase creates a new ListUseCase
+func NewListUseCase(repo Repository) *ListUseCase {
+	return &ListUseCase{repo: repo}
+}
+
+// Execute returns the most recently created announcements
+func (uc *ListUseCase) Execute(ctx context.Context, query ListQuery) ([]*announcement.Announcement, error) {
+	limit := query.Limit
+	if limit <= 0 {
+		limit = DefaultListLimit
+	}
+	limit = min(limit, MaxListLimit)
+
+	announcements, err := uc.repo.List(ctx, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list announcements: %w", err)
+	}
+	return announcements, nil
+}
+
+// ListActiveUseCase lists the banners a user should see now
+type ListActiveUseCase struct {
+	repo Repository
+}
+
+// NewListActiveUseCase creates a new ListActiveUseCase
+func NewListActiveUseCase(repo Repository) *ListActiveUseCase {
+	return &ListActiveUseCase{repo: repo}
+}
+
+// Execute returns the active announcements the user has not dismissed
+func (uc *ListActiveUseCase) Execute(ctx context.Context, query ListActiveQuery) ([]*announcement.Announcement, error) {
+	if err := appcore.ValidateUUID("userID", query.UserID); err != nil {
+		return nil, fmt.Errorf("validation failed: %w", err)
+	}
+
+	active, err := uc.repo.FindActive(ctx, time.Now())
+	if err != nil {
+		return nil, fmt.Errorf("failed to find active announcements: %w", err)
+	}
+	if len(active) == 0 {
+		return active, nil
+	}
+
+	ids := make([]uuid.UUID, 0, len(active))
+	for _, a := range active {
+		ids = append(ids, a.ID())
+	}
+	dismissed, err := uc.repo.DismissedIDs(ctx, query.UserID, ids)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load dismissals: %w", err)
+	}
+
+	visible := make([]*announcement.Announcement, 0, len(active))
+	for _, a := range active {
+		if !dismissed[a.ID()] {
+			visible = append(visible, a)
+		}
+	}
+	return visible, nil
+}
@@ -0,0 +1,49 @@
+package announcement_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	announcementapp "github.com/lllypuk/flowra/internal/application/announcement"
+	"github.com/lllypuk/flowra/internal/domain/announcement"
+	"github.com/lllypuk/flowra/internal/domain/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListActiveUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	adminID := uuid.NewUUID()
+	userID := uuid.NewUUID()
+	repo := newMemoryRepo()
+
+	active, _ := announcement.NewAnnouncement("Active", announcement.LevelInfo, time.Time{}, now.Add(time.Hour), adminID)
+	dismissed, _ := announcement.NewAnnouncement(
+		"Dismissed", announcement.LevelInfo, time.Time{}, now.Add(time.Hour), adminID)
+	scheduled, _ := announcement.NewAnnouncement(
+		"Scheduled", announcement.LevelMaintenance, now.Add(time.Hour), now.Add(2*time.Hour), adminID)
+	for _, a := range []*announcement.Announcement{active, dismissed, scheduled} {
+		require.NoError(t, repo.Save(ctx, a))
+	}
+
+	dismiss := announcementapp.NewDismissUseCase(repo)
+	require.NoError(t,
+		dismiss.Execute(ctx, announcementapp.DismissCommand{AnnouncementID: dismissed.ID(), UserID: userID}))
+	require.ErrorIs(t,
+		dismiss.Execute(ctx, announcementapp.DismissCommand{AnnouncementID: uuid.NewUUID(), UserID: userID}),
+		announcementapp.ErrAnnouncementNotFound,
+	)
+
+	uc := announcementapp.NewListActiveUseCase(repo)
+
+	visible, err := uc.Execute(ctx, announcementapp.ListActiveQuery{UserID: userID})
+	require.NoError(t, err)
+	require.Len(t, visible, 1)
+	assert.Equal(t, active.ID(), visible[0].ID())
+
+	visible, err = uc.Execute(ctx, announcementapp.ListActiveQuery{UserID: uuid.NewUUID()})
+	require.NoError(t, err)
+	assert.Len(t, visible, 2, "dismissals are per user")
+}
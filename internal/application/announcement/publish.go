@@ -0,0 +1,78 @@
+package announcement
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/lllypuk/flowra/internal/application/appcore"
+	"github.com/lllypuk/flowra/internal/domain/announcement"
+	"github.com/lllypuk/flowra/internal/domain/event"
+)
+
+// PublishUseCase publishes system-wide announcements
+type PublishUseCase struct {
+	repo     Repository
+	eventBus event.Bus
+}
+
+// NewPublishUseCase creates a new PublishUseCase
+func NewPublishUseCase(repo Repository, eventBus event.Bus) *PublishUseCase {
+	return &PublishUseCase{
+		repo:     repo,
+		eventBus: eventBus,
+	}
+}
+
+// Execute stores the announcement and notifies connected clients
+func (uc *PublishUseCase) Execute(ctx context.Context, cmd PublishCommand) (*announcement.Announcement, error) {
+	if err := uc.validate(cmd); err != nil {
+		return nil, fmt.Errorf("validation failed: %w", err)
+	}
+
+	a, err := announcement.NewAnnouncement(cmd.Message, cmd.Level, cmd.StartsAt, cmd.EndsAt, cmd.AdminID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create announcement: %w", err)
+	}
+
+	if saveErr := uc.repo.Save(ctx, a); saveErr != nil {
+		return nil, fmt.Errorf("failed to save announcement: %w", saveErr)
+	}
+
+	// the banner is refreshed on the next page load anyway, so delivery is best effort
+	if uc.eventBus != nil {
+		_ = uc.eventBus.Publish(ctx, announcement.NewPublished(a, appcore.NewEventMetadata(ctx, cmd.AdminID, cmd)))
+	}
+
+	return a, nil
+}
+
+func (uc *PublishUseCase) validate(cmd PublishCommand) error {
+	if err := appcore.ValidateUUID("adminID", cmd.AdminID); err != nil {
+		return err
+	}
+	if err := appcore.ValidateRequired("message", cmd.Message); err != nil {
+		return err
+	}
+	if err := appcore.ValidateMaxLength("message", cmd.Message, announcement.MaxMessageLength); err != nil {
+		return err
+	}
+	levels := make([]string, 0, len(announcement.Levels()))
+	for _, level := range announcement.Levels() {
+		levels = append(levels, string(level))
+	}
+	if err := appcore.ValidateEnum("level", string(cmd.Level), levels); err != nil {
+		return err
+	}
+	if cmd.EndsAt.IsZero() {
+		return appcore.NewValidationError("endsAt", "is required")
+	}
+	startsAt := cmd.StartsAt
+	if startsAt.IsZero() {
+		startsAt = time.Now()
+	}
+	if !cmd.EndsAt.After(startsAt) {
+		return appcore.NewValidationError("endsAt", "must be after the start time")
+	}
+	return nil
+}
@@ -0,0 +1,159 @@
+package announcement_test
+
+import (
+	"context"
+	"sort"
+	"testing"
+	"time"
+
+	announcementapp "github.com/lllypuk/flowra/internal/application/announcement"
+	"github.com/lllypuk/flowra/internal/domain/announcement"
+	"github.com/lllypuk/flowra/internal/domain/errs"
+	"github.com/lllypuk/flowra/internal/domain/event"
+	"github.com/lllypuk/flowra/internal/domain/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type memoryRepo struct {
+	items     map[uuid.UUID]*announcement.Announcement
+	dismissed map[uuid.UUID]map[uuid.UUID]bool
+}
+
+func newMemoryRepo() *memoryRepo {
+	return &memoryRepo{
+		items:     make(map[uuid.UUID]*announcement.Announcement),
+		dismissed: make(map[uuid.UUID]map[uuid.UUID]bool),
+	}
+}
+
+func (r *memoryRepo) Save(_ context.Context, a *announcement.Announcement) error {
+	r.items[a.ID()] = a
+	return nil
+}
+
+func (r *memoryRepo) FindByID(_ context.Context, id uuid.UUID) (*announcement.Announcement, error) {
+	a, ok := r.items[id]
+	if !ok {
+		return nil, errs.ErrNotFound
+	}
+	return a, nil
+}
+
+func (r *memoryRepo) List(_ context.Context, limit int) ([]*announcement.Announcement, error) {
+	all := make([]*announcement.Announcement, 0, len(r.items))
+	for _, a := range r.items {
+		all = append(all, a)
+	}
+	sort.Slice(all, func(i, j int) bool { return all[i].CreatedAt().After(all[j].CreatedAt()) })
+	if len(all) > limit {
+		all = all[:limit]
+	}
+	return all, nil
+}
+
+func (r *memoryRepo) FindActive(_ context.Context, at time.Time) ([]*announcement.Announcement, error) {
+	var active []*announcement.Announcement
+	for _, a := range r.items {
+		if a.IsActive(at) {
+			active = append(active, a)
+		}
+	}
+	return active, nil
+}
+
+func (r *memoryRepo) Dismiss(_ context.Context, announcementID, userID uuid.UUID) error {
+	if r.dismissed[userID] == nil {
+		r.dismissed[userID] = make(map[uuid.UUID]bool)
+	}
+	r.dismissed[userID][announcementID] = true
+	return nil
+}
+
+func (r *memoryRepo) DismissedIDs(
+	_ context.Context,
+	userID uuid.UUID,
+	announcementIDs []uuid.UUID,
+) (map[uuid.UUID]bool, error) {
+	result := make(map[uuid.UUID]bool)
+	for _, id := range announcementIDs {
+		if r.dismissed[userID][id] {
+			result[id] = true
+		}
+	}
+	return result, nil
+}
+
+type recordingBus struct {
+	published []event.DomainEvent
+}
+
+func (b *recordingBus) Publish(_ context.Context, evt event.DomainEvent) error {
+	b.published = append(b.published, evt)
+	return nil
+}
+
+func TestPublishUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+	adminID := uuid.NewUUID()
+
+	t.Run("saves and publishes the announcement", func(t *testing.T) {
+		repo := newMemoryRepo()
+		bus := &recordingBus{}
+		uc := announcementapp.NewPublishUseCase(repo, bus)
+
+		a, err := uc.Execute(ctx, announcementapp.PublishCommand{
+			Message: "Upgrade at 22:00 UTC",
+			Level:   announcement.LevelMaintenance,
+			EndsAt:  time.Now().Add(time.Hour),
+			AdminID: adminID,
+		})
+
+		require.NoError(t, err)
+		assert.Contains(t, repo.items, a.ID())
+		require.Len(t, bus.published, 1)
+		assert.Equal(t, announcement.EventTypeAnnouncementPublished, bus.published[0].EventType())
+		assert.Equal(t, adminID.String(), bus.published[0].Metadata().UserID)
+	})
+
+	t.Run("rejects invalid commands", func(t *testing.T) {
+		uc := announcementapp.NewPublishUseCase(newMemoryRepo(), nil)
+		now := time.Now()
+
+		for name, cmd := range map[string]announcementapp.PublishCommand{
+			"missing message": {Level: announcement.LevelInfo, EndsAt: now.Add(time.Hour), AdminID: adminID},
+			"unknown level":   {Message: "Hi", Level: "banner", EndsAt: now.Add(time.Hour), AdminID: adminID},
+			"missing end":     {Message: "Hi", Level: announcement.LevelInfo, AdminID: adminID},
+			"end in the past": {Message: "Hi", Level: announcement.LevelInfo, EndsAt: now.Add(-time.Hour), AdminID: adminID},
+		} {
+			t.Run(name, func(t *testing.T) {
+				_, err := uc.Execute(ctx, cmd)
+				require.Error(t, err)
+			})
+		}
+	})
+}
+
+func TestEndUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+	adminID := uuid.NewUUID()
+	repo := newMemoryRepo()
+	bus := &recordingBus{}
+	a, err := announcementapp.NewPublishUseCase(repo, nil).Execute(ctx, announcementapp.PublishCommand{
+		Message: "Incident", Level: announcement.LevelIncident, EndsAt: time.Now().Add(time.Hour), AdminID: adminID,
+	})
+	require.NoError(t, err)
+	uc := announcementapp.NewEndUseCase(repo, bus)
+
+	ended, err := uc.Execute(ctx, announcementapp.EndCommand{AnnouncementID: a.ID(), AdminID: adminID})
+	require.NoError(t, err)
+	assert.False(t, ended.IsActive(time.Now()))
+	require.Len(t, bus.published, 1)
+	assert.Equal(t, announcement.EventTypeAnnouncementEnded, bus.published[0].EventType())
+
+	_, err = uc.Execute(ctx, announcementapp.EndCommand{AnnouncementID: a.ID(), AdminID: adminID})
+	require.ErrorIs(t, err, announcementapp.ErrAnnouncementEnded)
+
+	_, err = uc.Execute(ctx, announcementapp.EndCommand{AnnouncementID: uuid.NewUUID(), AdminID: adminID})
+	require.ErrorIs(t, err, announcementapp.ErrAnnouncementNotFound)
+}
@@ -0,0 +1,31 @@
+package announcement
+
+import (
+	"context"
+	"time"
+
+	"github.com/lllypuk/flowra/internal/domain/announcement"
+	"github.com/lllypuk/flowra/internal/domain/uuid"
+)
+
+// Repository stores announcements and per-user dismissals
+// Interface is declared on the consumer side (application layer)
+type Repository interface {
+	// Save creates or updates an announcement
+	Save(ctx context.Context, a *announcement.Announcement) error
+
+	// FindByID returns an announcement; errs.ErrNotFound when it does not exist
+	FindByID(ctx context.Context, id uuid.UUID) (*announcement.Announcement, error)
+
+	// List returns the most recently created announcements first
+	List(ctx context.Context, limit int) ([]*announcement.Announcement, error)
+
+	// FindActive returns the announcements shown at the given time, most recent start first
+	FindActive(ctx context.Context, at time.Time) ([]*announcement.Announcement, error)
+
+	// Dismiss records that the user closed the banner; repeated calls are no-ops
+	Dismiss(ctx context.Context, announcementID, userID uuid.UUID) error
+
+	// DismissedIDs returns which of the given announcements the user dismissed
+	DismissedIDs(ctx context.Context, userID uuid.UUID, announcementIDs []uuid.UUID) (map[uuid.UUID]bool, error)
+}
@@ -3,6 +3,7 @@ package appcore
 import (
 	"context"
 	"errors"
+	"time"
 
 	"github.com/lllypuk/flowra/internal/domain/uuid"
 )
@@ -15,6 +16,9 @@ const (
 	workspaceIDKey   contextKey = "workspaceID"
 	correlationIDKey contextKey = "correlationID"
 	traceIDKey       contextKey = "traceID"
+	requestIDKey     contextKey = "requestID"
+	clientIPKey      contextKey = "clientIP"
+	locationKey      contextKey = "location"
 )
 
 var (
@@ -78,3 +82,46 @@ func GetTraceID(ctx context.Context) string {
 func WithTraceID(ctx context.Context, traceID string) context.Context {
 	return context.WithValue(ctx, traceIDKey, traceID)
 }
+
+// GetRequestID extracts the HTTP request ID from the context
+func GetRequestID(ctx context.Context) string {
+	requestID, ok := ctx.Value(requestIDKey).(string)
+	if !ok {
+		return ""
+	}
+	return requestID
+}
+
+// WithRequestID adds the HTTP request ID to the context
+func WithRequestID(ctx context.Context, requestID string) context.Context {
+	return context.WithValue(ctx, requestIDKey, requestID)
+}
+
+// GetClientIP extracts the resolved client IP address from the context
+func GetClientIP(ctx context.Context) string {
+	clientIP, ok := ctx.Value(clientIPKey).(string)
+	if !ok {
+		return ""
+	}
+	return clientIP
+}
+
+// WithClientIP adds the resolved client IP address to the context
+func WithClientIP(ctx context.Context, clientIP string) context.Context {
+	return context.WithValue(ctx, clientIPKey, clientIP)
+}
+
+// GetLocation extracts the requesting user's time zone from the context,
+// defaulting to UTC
+func GetLocation(ctx context.Context) *time.Location {
+	loc, ok := ctx.Value(locationKey).(*time.Location)
+	if !ok || loc == nil {
+		return time.UTC
+	}
+	return loc
+}
+
+// WithLocation adds the requesting user's time zone to the context
+func WithLocation(ctx context.Context, loc *time.Location) context.Context {
+	return context.WithValue(ctx, locationKey, loc)
+}
@@ -3,6 +3,7 @@ package appcore_test
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/lllypuk/flowra/internal/application/appcore"
 	"github.com/lllypuk/flowra/internal/domain/uuid"
@@ -76,6 +77,33 @@ func TestTraceIDContext(t *testing.T) {
 	})
 }
 
+func TestRequestIDContext(t *testing.T) {
+	t.Run("set and get requestID", func(t *testing.T) {
+		requestID := "test-request-id-789"
+		ctx := appcore.WithRequestID(context.Background(), requestID)
+
+		assert.Equal(t, requestID, appcore.GetRequestID(ctx))
+	})
+
+	t.Run("get requestID from empty context returns empty string", func(t *testing.T) {
+		assert.Empty(t, appcore.GetRequestID(context.Background()))
+	})
+}
+
+func TestLocationContext(t *testing.T) {
+	t.Run("set and get location", func(t *testing.T) {
+		loc, err := time.LoadLocation("Asia/Tokyo")
+		require.NoError(t, err)
+		ctx := appcore.WithLocation(context.Background(), loc)
+
+		assert.Equal(t, loc, appcore.GetLocation(ctx))
+	})
+
+	t.Run("get location from empty context returns UTC", func(t *testing.T) {
+		assert.Equal(t, time.UTC, appcore.GetLocation(context.Background()))
+	})
+}
+
 func TestMultipleContextValues(t *testing.T) {
 	t.Run("set multiple values in context", func(t *testing.T) {
 		userID := uuid.NewUUID()
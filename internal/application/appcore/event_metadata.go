@@ -0,0 +1,54 @@
+package appcore
+
+import (
+	"context"
+
+	"github.com/lllypuk/flowra/internal/domain/event"
+	"github.com/lllypuk/flowra/internal/domain/uuid"
+)
+
+// NewEventMetadata builds metadata for an event raised by userID while handling cmd.
+// The correlation ID and client IP are taken from the request context and the command name is recorded as causation.
+func NewEventMetadata(ctx context.Context, userID uuid.UUID, cmd Command) event.Metadata {
+	correlationID, _ := GetCorrelationID(ctx)
+	return event.NewMetadata(userID.String(), correlationID, causationID(cmd)).WithIPAddress(GetClientIP(ctx))
+}
+
+// StampEventMetadata attaches the request correlation ID and cmd as causation to events
+// raised by an aggregate while handling cmd, along with the client IP when the domain did not set one.
+// User IDs and timestamps set by the domain are kept.
+func StampEventMetadata(ctx context.Context, cmd Command, events []event.DomainEvent) {
+	correlationID, _ := GetCorrelationID(ctx)
+	causation := causationID(cmd)
+	clientIP := GetClientIP(ctx)
+
+	for _, evt := range events {
+		setter, ok := evt.(event.MetadataSetter)
+		if !ok {
+			continue
+		}
+
+		metadata := evt.Metadata()
+		if correlationID != "" {
+			metadata.CorrelationID = correlationID
+		}
+		if causation != "" {
+			metadata.CausationID = causation
+		}
+		if metadata.IPAddress == "" {
+			metadata.IPAddress = clientIP
+		}
+		if metadata.Timestamp.IsZero() {
+			metadata.Timestamp = evt.OccurredAt()
+		}
+		setter.SetMetadata(metadata)
+	}
+}
+
+// causationID returns the causation recorded for events triggered by cmd.
+func causationID(cmd Command) string {
+	if cmd == nil {
+		return ""
+	}
+	return cmd.CommandName()
+}
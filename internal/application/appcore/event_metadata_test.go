@@ -0,0 +1,83 @@
+package appcore_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lllypuk/flowra/internal/application/appcore"
+	"github.com/lllypuk/flowra/internal/domain/event"
+	"github.com/lllypuk/flowra/internal/domain/uuid"
+)
+
+type testCommand struct{}
+
+func (testCommand) CommandName() string { return "TestCommand" }
+
+type testEvent struct {
+	event.BaseEvent
+}
+
+func TestNewEventMetadata(t *testing.T) {
+	userID := uuid.NewUUID()
+
+	t.Run("with correlation ID", func(t *testing.T) {
+		ctx := appcore.WithCorrelationID(context.Background(), "corr-1")
+
+		metadata := appcore.NewEventMetadata(ctx, userID, testCommand{})
+
+		assert.Equal(t, userID.String(), metadata.UserID)
+		assert.Equal(t, "corr-1", metadata.CorrelationID)
+		assert.Equal(t, "TestCommand", metadata.CausationID)
+		assert.False(t, metadata.Timestamp.IsZero())
+	})
+
+	t.Run("without correlation ID", func(t *testing.T) {
+		metadata := appcore.NewEventMetadata(context.Background(), userID, nil)
+
+		assert.Empty(t, metadata.CorrelationID)
+		assert.Empty(t, metadata.CausationID)
+		assert.Empty(t, metadata.IPAddress)
+	})
+
+	t.Run("with client IP", func(t *testing.T) {
+		ctx := appcore.WithClientIP(context.Background(), "203.0.113.7")
+
+		metadata := appcore.NewEventMetadata(ctx, userID, testCommand{})
+
+		assert.Equal(t, "203.0.113.7", metadata.IPAddress)
+	})
+}
+
+func TestStampEventMetadata(t *testing.T) {
+	occurredAt := time.Now().Add(-time.Minute)
+	evt := &testEvent{BaseEvent: event.BaseEvent{
+		OccAt:         occurredAt,
+		EventMetadata: event.Metadata{UserID: "user-1"},
+	}}
+	ctx := appcore.WithCorrelationID(context.Background(), "corr-1")
+	ctx = appcore.WithClientIP(ctx, "203.0.113.7")
+
+	appcore.StampEventMetadata(ctx, testCommand{}, []event.DomainEvent{evt})
+
+	metadata := evt.Metadata()
+	require.Equal(t, "corr-1", metadata.CorrelationID)
+	assert.Equal(t, "203.0.113.7", metadata.IPAddress)
+	assert.Equal(t, "TestCommand", metadata.CausationID)
+	assert.Equal(t, "user-1", metadata.UserID)
+	assert.Equal(t, occurredAt, metadata.Timestamp)
+}
+
+func TestStampEventMetadata_KeepsExistingCorrelation(t *testing.T) {
+	evt := &testEvent{BaseEvent: event.BaseEvent{
+		EventMetadata: event.Metadata{CorrelationID: "existing"},
+	}}
+
+	appcore.StampEventMetadata(context.Background(), testCommand{}, []event.DomainEvent{evt})
+
+	assert.Equal(t, "existing", evt.Metadata().CorrelationID)
+	assert.Equal(t, "TestCommand", evt.Metadata().CausationID)
+}
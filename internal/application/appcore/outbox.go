@@ -3,11 +3,15 @@ package appcore
 
 import (
 	"context"
+	"errors"
 	"time"
 
 	"github.com/lllypuk/flowra/internal/domain/event"
 )
 
+// ErrOutboxEntryNotFound is returned when an outbox entry does not exist or is not in the expected state.
+var ErrOutboxEntryNotFound = errors.New("outbox entry not found")
+
 // OutboxEntry represents an event waiting to be published to the event bus.
 type OutboxEntry struct {
 	ID            string
@@ -20,6 +24,8 @@ type OutboxEntry struct {
 	ProcessedAt   *time.Time
 	RetryCount    int
 	LastError     string
+	// QuarantinedAt is set once the entry exhausted its retries and was taken out of polling.
+	QuarantinedAt *time.Time
 }
 
 // Outbox defines the interface for transactional outbox operations.
@@ -34,7 +40,7 @@ type Outbox interface {
 	AddBatch(ctx context.Context, events []event.DomainEvent) error
 
 	// Poll retrieves unprocessed events up to the specified batch size.
-	// Events are returned ordered by creation time (oldest first).
+	// Events are returned ordered by creation time (oldest first). Quarantined events are skipped.
 	Poll(ctx context.Context, batchSize int) ([]OutboxEntry, error)
 
 	// MarkProcessed marks an event as successfully published.
@@ -43,10 +49,17 @@ type Outbox interface {
 	// MarkFailed records a publishing failure for retry.
 	MarkFailed(ctx context.Context, entryID string, err error) error
 
+	// Quarantine takes an entry that exhausted its retries out of polling, recording the last error.
+	// Quarantined entries stay until an admin requeues or discards them.
+	Quarantine(ctx context.Context, entryID string, lastErr error) error
+
+	// CountQuarantined returns the number of quarantined entries (for monitoring).
+	CountQuarantined(ctx context.Context) (int64, error)
+
 	// Cleanup removes old processed entries older than the specified duration.
 	Cleanup(ctx context.Context, olderThan time.Duration) (int64, error)
 
-	// Count returns the number of unprocessed entries (for monitoring).
+	// Count returns the number of unprocessed, non-quarantined entries (for monitoring).
 	Count(ctx context.Context) (int64, error)
 
 	// Stats returns statistics about the outbox (count and oldest entry timestamp).
@@ -0,0 +1,27 @@
+package calendar
+
+import "github.com/lllypuk/flowra/internal/domain/uuid"
+
+// IssueTokenCommand - create or rotate the calendar feed token of a user
+type IssueTokenCommand struct {
+	UserID uuid.UUID
+}
+
+func (c IssueTokenCommand) CommandName() string { return "IssueCalendarToken" }
+
+// RevokeTokenCommand - disable the calendar feed of a user
+type RevokeTokenCommand struct {
+	UserID uuid.UUID
+}
+
+func (c RevokeTokenCommand) CommandName() string { return "RevokeCalendarToken" }
+
+// GetTokenQuery - read whether a user has an active calendar feed
+type GetTokenQuery struct {
+	UserID uuid.UUID
+}
+
+// FeedQuery - build the calendar feed identified by a secret token
+type FeedQuery struct {
+	Token string
+}
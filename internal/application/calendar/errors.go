@@ -0,0 +1,8 @@
+package calendar
+
+import "errors"
+
+var (
+	// ErrFeedNotFound is returned when the token does not belong to an active feed
+	ErrFeedNotFound = errors.New("calendar feed not found")
+)
@@ -0,0 +1,251 @@
+// Package calendar serves per-user iCal feeds with the due dates of assigned
+// tasks and the boundaries of their sprints. A feed is addressed by a secret
+// token; only its hash is stored, so rotating or revoking the token cuts off
+// every subscribed calendar.
+package calendar
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sort"
+	"strings"
+	"time"
+
+	reportapp "github.com/lllypuk/flowra/internal/application/report"
+	taskapp "github.com/lllypuk/flowra/internal/application/task"
+	"github.com/lllypuk/flowra/internal/domain/errs"
+	taskdomain "github.com/lllypuk/flowra/internal/domain/task"
+	"github.com/lllypuk/flowra/internal/domain/user"
+	"github.com/lllypuk/flowra/internal/domain/uuid"
+)
+
+// EventKind distinguishes the entries of a feed
+type EventKind string
+
+const (
+	EventKindDue         EventKind = "due"
+	EventKindSprintStart EventKind = "sprint_start"
+	EventKindSprintEnd   EventKind = "sprint_end"
+)
+
+// UserFinder loads the owner of a feed
+type UserFinder interface {
+	FindByID(ctx context.Context, id uuid.UUID) (*user.User, error)
+}
+
+// MembershipChecker tells whether the owner of a feed is still an active member
+// of a workspace; tasks of other workspaces stay out of the feed
+type MembershipChecker interface {
+	IsMember(ctx context.Context, workspaceID, userID uuid.UUID) (bool, error)
+}
+
+// ReportSource returns the cached reports of a workspace; sprint boundaries
+// come from their burndowns
+type ReportSource interface {
+	Execute(ctx context.Context, query reportapp.GetQuery) (*reportapp.Snapshot, error)
+}
+
+// Feed is the calendar of one user
+type Feed struct {
+	UserID uuid.UUID
+	Name   string
+	Events []Event // ordered by date
+}
+
+// Event is an all-day calendar entry.
+// Date is midnight UTC of the calendar day in the owner's time zone.
+type Event struct {
+	UID         string
+	Kind        EventKind
+	Summary     string
+	Date        time.Time
+	WorkspaceID uuid.UUID
+	TaskID      uuid.UUID // zero for sprint events
+	Sprint      string
+}
+
+// FeedUseCase builds the calendar feed addressed by a token: due dates of the
+// open tasks assigned to the user and the start and end of their sprints.
+// Only workspaces the user is still an active member of contribute, so a
+// removed member's token stops showing their tasks. A sprint ends on the day
+// its last item was completed, so open sprints only contribute their start.
+type FeedUseCase struct {
+	tokens  TokenRepository
+	users   UserFinder
+	tasks   taskapp.TaskLister
+	members MembershipChecker
+	reports ReportSource
+	logger  *slog.Logger
+	now     func() time.Time
+}
+
+// NewFeedUseCase creates a new FeedUseCase; reports may be nil to omit sprints
+func NewFeedUseCase(
+	tokens TokenRepository,
+	users UserFinder,
+	tasks taskapp.TaskLister,
+	members MembershipChecker,
+	reports ReportSource,
+) *FeedUseCase {
+	return &FeedUseCase{
+		tokens:  tokens,
+		users:   users,
+		tasks:   tasks,
+		members: members,
+		reports: reports,
+		logger:  slog.Default(),
+		now:     time.Now,
+	}
+}
+
+// Execute builds the feed; ErrFeedNotFound when the token is unknown or revoked
+func (uc *FeedUseCase) Execute(ctx context.Context, query FeedQuery) (*Feed, error) {
+	if strings.TrimSpace(query.Token) == "" {
+		return nil, ErrFeedNotFound
+	}
+
+	token, err := uc.tokens.FindByHash(ctx, HashToken(query.Token))
+	if err != nil {
+		if errors.Is(err, errs.ErrNotFound) {
+			return nil, ErrFeedNotFound
+		}
+		return nil, fmt.Errorf("failed to load calendar token: %w", err)
+	}
+
+	owner, err := uc.users.FindByID(ctx, token.UserID)
+	if err != nil {
+		if errors.Is(err, errs.ErrNotFound) {
+			return nil, ErrFeedNotFound
+		}
+		return nil, fmt.Errorf("failed to load user: %w", err)
+	}
+	if !owner.IsActive() {
+		return nil, ErrFeedNotFound
+	}
+
+	userID := owner.ID()
+	tasks, err := uc.tasks.ListTasks(ctx, taskapp.Filters{AssigneeID: &userID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tasks: %w", err)
+	}
+
+	feed := &Feed{UserID: userID, Name: owner.DisplayName()}
+	loc := owner.Location()
+	sprints := make(map[uuid.UUID]map[string]bool)
+	memberOf := make(map[uuid.UUID]bool)
+	for _, t := range tasks {
+		if t == nil || t.Status == taskdomain.StatusDone || t.Status == taskdomain.StatusCancelled {
+			continue
+		}
+		isMember, known := memberOf[t.WorkspaceID]
+		if !known {
+			// Calendar clients replace the whole feed, so a failed check fails the
+			// request rather than dropping the workspace from subscribed calendars
+			isMember, err = uc.isMember(ctx, t.WorkspaceID, userID)
+			if err != nil {
+				return nil, err
+			}
+			memberOf[t.WorkspaceID] = isMember
+		}
+		if !isMember {
+			continue
+		}
+		if t.DueDate != nil {
+			feed.Events = append(feed.Events, Event{
+				UID:         fmt.Sprintf("task-%s-due", t.ID),
+				Kind:        EventKindDue,
+				Summary:     t.Title,
+				Date:        calendarDay(t.DueDate.In(loc)),
+				WorkspaceID: t.WorkspaceID,
+				TaskID:      t.ID,
+				Sprint:      t.Sprint,
+			})
+		}
+		if t.Sprint != "" && !t.WorkspaceID.IsZero() {
+			if sprints[t.WorkspaceID] == nil {
+				sprints[t.WorkspaceID] = make(map[string]bool)
+			}
+			sprints[t.WorkspaceID][t.Sprint] = true
+		}
+	}
+
+	feed.Events = append(feed.Events, uc.sprintEvents(ctx, sprints)...)
+	sort.SliceStable(feed.Events, func(i, j int) bool {
+		a, b := feed.Events[i], feed.Events[j]
+		if !a.Date.Equal(b.Date) {
+			return a.Date.Before(b.Date)
+		}
+		return a.UID < b.UID
+	})
+
+	return feed, nil
+}
+
+func (uc *FeedUseCase) isMember(ctx context.Context, workspaceID, userID uuid.UUID) (bool, error) {
+	if workspaceID.IsZero() {
+		return false, nil
+	}
+	isMember, err := uc.members.IsMember(ctx, workspaceID, userID)
+	if err != nil {
+		return false, fmt.Errorf("failed to check membership of workspace %s: %w", workspaceID, err)
+	}
+	return isMember, nil
+}
+
+// sprintEvents reads the sprint boundaries from the report snapshots. A
+// workspace whose reports cannot be loaded is skipped so the rest of the feed
+// still syncs.
+func (uc *FeedUseCase) sprintEvents(ctx context.Context, sprints map[uuid.UUID]map[string]bool) []Event {
+	if uc.reports == nil {
+		return nil
+	}
+
+	today := calendarDay(uc.now().UTC())
+	var events []Event
+	for workspaceID, names := range sprints {
+		snapshot, err := uc.reports.Execute(ctx, reportapp.GetQuery{WorkspaceID: workspaceID})
+		if err != nil {
+			uc.logger.WarnContext(ctx, "calendar feed skipped sprints of workspace",
+				slog.String("workspace_id", workspaceID.String()),
+				slog.String("error", err.Error()),
+			)
+			continue
+		}
+		for _, burndown := range snapshot.Burndowns {
+			if !names[burndown.Sprint] {
+				continue
+			}
+			events = append(events, Event{
+				UID:         sprintUID(workspaceID, burndown.Sprint, "start"),
+				Kind:        EventKindSprintStart,
+				Summary:     burndown.Sprint + " starts",
+				Date:        calendarDay(burndown.StartDate),
+				WorkspaceID: workspaceID,
+				Sprint:      burndown.Sprint,
+			})
+			if end := calendarDay(burndown.EndDate); end.Before(today) {
+				events = append(events, Event{
+					UID:         sprintUID(workspaceID, burndown.Sprint, "end"),
+					Kind:        EventKindSprintEnd,
+					Summary:     burndown.Sprint + " ends",
+					Date:        end,
+					WorkspaceID: workspaceID,
+					Sprint:      burndown.Sprint,
+				})
+			}
+		}
+	}
+	return events
+}
+
+func sprintUID(workspaceID uuid.UUID, sprint, boundary string) string {
+	name := strings.ToLower(strings.Join(strings.Fields(sprint), "-"))
+	return fmt.Sprintf("sprint-%s-%s-%s", workspaceID, name, boundary)
+}
+
+// calendarDay drops the time of day, keeping the date as shown in t's location
+func calendarDay(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,261 @@
+package calendar_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	calendarapp "github.com/lllypuk/flowra/internal/application/calendar"
+	reportapp "github.com/lllypuk/flowra/internal/application/report"
+	taskapp "github.com/lllypuk/flowra/internal/application/task"
+	"github.com/lllypuk/flowra/internal/domain/errs"
+	taskdomain "github.com/lllypuk/flowra/internal/domain/task"
+	"github.com/lllypuk/flowra/internal/domain/user"
+	"github.com/lllypuk/flowra/internal/domain/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type memoryTokenRepo struct {
+	byUser map[uuid.UUID]*calendarapp.FeedToken
+}
+
+func newMemoryTokenRepo() *memoryTokenRepo {
+	return &memoryTokenRepo{byUser: make(map[uuid.UUID]*calendarapp.FeedToken)}
+}
+
+func (r *memoryTokenRepo) Save(_ context.Context, token *calendarapp.FeedToken) error {
+	r.byUser[token.UserID] = token
+	return nil
+}
+
+func (r *memoryTokenRepo) FindByUserID(_ context.Context, userID uuid.UUID) (*calendarapp.FeedToken, error) {
+	if token, ok := r.byUser[userID]; ok {
+		return token, nil
+	}
+	return nil, errs.ErrNotFound
+}
+
+func (r *memoryTokenRepo) FindByHash(_ context.Context, tokenHash string) (*calendarapp.FeedToken, error) {
+	for _, token := range r.byUser {
+		if token.TokenHash == tokenHash {
+			return token, nil
+		}
+	}
+	return nil, errs.ErrNotFound
+}
+
+func (r *memoryTokenRepo) Delete(_ context.Context, userID uuid.UUID) error {
+	delete(r.byUser, userID)
+	return nil
+}
+
+type stubUserFinder struct {
+	users map[uuid.UUID]*user.User
+}
+
+func (s *stubUserFinder) FindByID(_ context.Context, id uuid.UUID) (*user.User, error) {
+	if u, ok := s.users[id]; ok {
+		return u, nil
+	}
+	return nil, errs.ErrNotFound
+}
+
+type stubTaskLister struct {
+	tasks   []*taskapp.ReadModel
+	filters taskapp.Filters
+}
+
+func (s *stubTaskLister) ListTasks(_ context.Context, filters taskapp.Filters) ([]*taskapp.ReadModel, error) {
+	s.filters = filters
+	return s.tasks, nil
+}
+
+// stubMembership treats the owner as a member of every workspace except removed ones
+type stubMembership struct {
+	removed map[uuid.UUID]bool
+	err     error
+}
+
+func (s *stubMembership) IsMember(_ context.Context, workspaceID, _ uuid.UUID) (bool, error) {
+	if s.err != nil {
+		return false, s.err
+	}
+	return !s.removed[workspaceID], nil
+}
+
+type stubReportSource struct {
+	snapshots map[uuid.UUID]*reportapp.Snapshot
+}
+
+func (s *stubReportSource) Execute(_ context.Context, query reportapp.GetQuery) (*reportapp.Snapshot, error) {
+	if snapshot, ok := s.snapshots[query.WorkspaceID]; ok {
+		return snapshot, nil
+	}
+	return nil, errors.New("no snapshot")
+}
+
+func feedUser(timezone string, active bool) *user.User {
+	now := time.Now()
+	return user.Reconstruct(uuid.NewUUID(), "ext", "alice", "alice@example.com", "Alice", "", timezone,
+		false, active, now, now)
+}
+
+func issueToken(t *testing.T, repo *memoryTokenRepo, userID uuid.UUID) string {
+	t.Helper()
+	issued, err := calendarapp.NewIssueTokenUseCase(repo).Execute(context.Background(), calendarapp.IssueTokenCommand{
+		UserID: userID,
+	})
+	require.NoError(t, err)
+	return issued.Token
+}
+
+func TestFeedUseCase_Execute(t *testing.T) {
+	owner := feedUser("Europe/Moscow", true)
+	workspaceID := uuid.NewUUID()
+	otherWorkspace := uuid.NewUUID()
+
+	// midnight in Moscow is the previous evening in UTC
+	due := time.Date(2026, time.May, 12, 0, 0, 0, 0, owner.Location())
+	open := &taskapp.ReadModel{
+		ID: uuid.NewUUID(), WorkspaceID: workspaceID, Title: "Ship checkout",
+		Status: taskdomain.StatusInProgress, Sprint: "Sprint 4", DueDate: &due,
+	}
+	undated := &taskapp.ReadModel{
+		ID: uuid.NewUUID(), WorkspaceID: otherWorkspace, Title: "Refactor",
+		Status: taskdomain.StatusToDo, Sprint: "Sprint 9",
+	}
+	done := &taskapp.ReadModel{
+		ID: uuid.NewUUID(), WorkspaceID: workspaceID, Title: "Done already",
+		Status: taskdomain.StatusDone, Sprint: "Sprint 3", DueDate: &due,
+	}
+
+	sprintStart := time.Date(2026, time.May, 4, 0, 0, 0, 0, time.UTC)
+	sprintEnd := time.Date(2026, time.May, 15, 0, 0, 0, 0, time.UTC)
+	reports := &stubReportSource{snapshots: map[uuid.UUID]*reportapp.Snapshot{
+		workspaceID: {Burndowns: []reportapp.SprintBurndown{
+			{Sprint: "Sprint 3", StartDate: sprintStart.AddDate(0, 0, -14), EndDate: sprintStart},
+			{Sprint: "Sprint 4", StartDate: sprintStart, EndDate: sprintEnd},
+		}},
+		// the sprint is still running, so only its start is known
+		otherWorkspace: {Burndowns: []reportapp.SprintBurndown{
+			{Sprint: "Sprint 9", StartDate: sprintStart, EndDate: time.Now().UTC()},
+		}},
+	}}
+
+	tokens := newMemoryTokenRepo()
+	token := issueToken(t, tokens, owner.ID())
+	tasks := &stubTaskLister{tasks: []*taskapp.ReadModel{open, undated, done}}
+	users := &stubUserFinder{users: map[uuid.UUID]*user.User{owner.ID(): owner}}
+
+	feed, err := calendarapp.NewFeedUseCase(tokens, users, tasks, &stubMembership{}, reports).Execute(
+		context.Background(), calendarapp.FeedQuery{Token: token})
+
+	require.NoError(t, err)
+	require.NotNil(t, tasks.filters.AssigneeID)
+	assert.Equal(t, owner.ID(), *tasks.filters.AssigneeID)
+	assert.Equal(t, "Alice", feed.Name)
+
+	require.Len(t, feed.Events, 4)
+	assert.Equal(t, calendarapp.EventKindSprintStart, feed.Events[0].Kind)
+	assert.Equal(t, sprintStart, feed.Events[0].Date)
+
+	dueEvent := feed.Events[2]
+	assert.Equal(t, calendarapp.EventKindDue, dueEvent.Kind)
+	assert.Equal(t, open.ID, dueEvent.TaskID)
+	assert.Equal(t, "Ship checkout", dueEvent.Summary)
+	assert.Equal(t, time.Date(2026, time.May, 12, 0, 0, 0, 0, time.UTC), dueEvent.Date)
+
+	end := feed.Events[3]
+	assert.Equal(t, calendarapp.EventKindSprintEnd, end.Kind)
+	assert.Equal(t, "Sprint 4", end.Sprint)
+	assert.Equal(t, sprintEnd, end.Date)
+}
+
+func TestFeedUseCase_Execute_RevokedToken(t *testing.T) {
+	owner := feedUser("", true)
+	tokens := newMemoryTokenRepo()
+	token := issueToken(t, tokens, owner.ID())
+	users := &stubUserFinder{users: map[uuid.UUID]*user.User{owner.ID(): owner}}
+	uc := calendarapp.NewFeedUseCase(tokens, users, &stubTaskLister{}, &stubMembership{}, nil)
+
+	_, err := uc.Execute(context.Background(), calendarapp.FeedQuery{Token: token})
+	require.NoError(t, err)
+
+	// rotating replaces the old token
+	rotated := issueToken(t, tokens, owner.ID())
+	_, err = uc.Execute(context.Background(), calendarapp.FeedQuery{Token: token})
+	require.ErrorIs(t, err, calendarapp.ErrFeedNotFound)
+
+	err = calendarapp.NewRevokeTokenUseCase(tokens).Execute(context.Background(), calendarapp.RevokeTokenCommand{
+		UserID: owner.ID(),
+	})
+	require.NoError(t, err)
+	_, err = uc.Execute(context.Background(), calendarapp.FeedQuery{Token: rotated})
+	require.ErrorIs(t, err, calendarapp.ErrFeedNotFound)
+
+	_, err = calendarapp.NewGetTokenUseCase(tokens).Execute(context.Background(), calendarapp.GetTokenQuery{
+		UserID: owner.ID(),
+	})
+	require.ErrorIs(t, err, calendarapp.ErrFeedNotFound)
+}
+
+func TestFeedUseCase_Execute_InactiveUser(t *testing.T) {
+	owner := feedUser("", false)
+	tokens := newMemoryTokenRepo()
+	token := issueToken(t, tokens, owner.ID())
+	users := &stubUserFinder{users: map[uuid.UUID]*user.User{owner.ID(): owner}}
+
+	_, err := calendarapp.NewFeedUseCase(tokens, users, &stubTaskLister{}, &stubMembership{}, nil).Execute(
+		context.Background(), calendarapp.FeedQuery{Token: token})
+
+	require.ErrorIs(t, err, calendarapp.ErrFeedNotFound)
+}
+
+func TestFeedUseCase_Execute_RemovedMember(t *testing.T) {
+	owner := feedUser("", true)
+	current, left := uuid.NewUUID(), uuid.NewUUID()
+	due := time.Date(2026, time.May, 12, 0, 0, 0, 0, time.UTC)
+	kept := &taskapp.ReadModel{
+		ID: uuid.NewUUID(), WorkspaceID: current, Title: "Ship checkout",
+		Status: taskdomain.StatusToDo, DueDate: &due,
+	}
+	hidden := &taskapp.ReadModel{
+		ID: uuid.NewUUID(), WorkspaceID: left, Title: "Acquisition plan",
+		Status: taskdomain.StatusToDo, Sprint: "Sprint 2", DueDate: &due,
+	}
+	reports := &stubReportSource{snapshots: map[uuid.UUID]*reportapp.Snapshot{
+		left: {Burndowns: []reportapp.SprintBurndown{{Sprint: "Sprint 2", StartDate: due, EndDate: due}}},
+	}}
+
+	tokens := newMemoryTokenRepo()
+	token := issueToken(t, tokens, owner.ID())
+	users := &stubUserFinder{users: map[uuid.UUID]*user.User{owner.ID(): owner}}
+	tasks := &stubTaskLister{tasks: []*taskapp.ReadModel{kept, hidden}}
+	members := &stubMembership{removed: map[uuid.UUID]bool{left: true}}
+	uc := calendarapp.NewFeedUseCase(tokens, users, tasks, members, reports)
+
+	feed, err := uc.Execute(context.Background(), calendarapp.FeedQuery{Token: token})
+
+	require.NoError(t, err)
+	require.Len(t, feed.Events, 1, "tasks and sprints of the workspace the owner left are dropped")
+	assert.Equal(t, kept.ID, feed.Events[0].TaskID)
+
+	members.err = errors.New("database unavailable")
+	_, err = uc.Execute(context.Background(), calendarapp.FeedQuery{Token: token})
+	require.Error(t, err)
+	require.NotErrorIs(t, err, calendarapp.ErrFeedNotFound)
+}
+
+func TestIssueTokenUseCase_Execute_StoresHashOnly(t *testing.T) {
+	tokens := newMemoryTokenRepo()
+	userID := uuid.NewUUID()
+
+	token := issueToken(t, tokens, userID)
+
+	stored := tokens.byUser[userID]
+	require.NotNil(t, stored)
+	assert.NotEqual(t, token, stored.TokenHash)
+	assert.Equal(t, calendarapp.HashToken(token), stored.TokenHash)
+}
@@ -0,0 +1,36 @@
+package calendar
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/lllypuk/flowra/internal/application/appcore"
+	"github.com/lllypuk/flowra/internal/domain/errs"
+)
+
+// GetTokenUseCase reports whether a user has an active calendar feed
+type GetTokenUseCase struct {
+	tokens TokenRepository
+}
+
+// NewGetTokenUseCase creates a new GetTokenUseCase
+func NewGetTokenUseCase(tokens TokenRepository) *GetTokenUseCase {
+	return &GetTokenUseCase{tokens: tokens}
+}
+
+// Execute returns the stored token; ErrFeedNotFound when the feed is not active
+func (uc *GetTokenUseCase) Execute(ctx context.Context, query GetTokenQuery) (*FeedToken, error) {
+	if err := appcore.ValidateUUID("userID", query.UserID); err != nil {
+		return nil, fmt.Errorf("validation failed: %w", err)
+	}
+
+	token, err := uc.tokens.FindByUserID(ctx, query.UserID)
+	if err != nil {
+		if errors.Is(err, errs.ErrNotFound) {
+			return nil, ErrFeedNotFound
+		}
+		return nil, fmt.Errorf("failed to load calendar token: %w", err)
+	}
+	return token, nil
+}
@@ -0,0 +1,43 @@
+package calendar
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/lllypuk/flowra/internal/application/appcore"
+)
+
+// IssueTokenUseCase creates the calendar feed token of a user; an existing
+// token is replaced, so calendars subscribed with it stop syncing
+type IssueTokenUseCase struct {
+	tokens TokenRepository
+	now    func() time.Time
+}
+
+// NewIssueTokenUseCase creates a new IssueTokenUseCase
+func NewIssueTokenUseCase(tokens TokenRepository) *IssueTokenUseCase {
+	return &IssueTokenUseCase{tokens: tokens, now: time.Now}
+}
+
+// Execute issues the token
+func (uc *IssueTokenUseCase) Execute(ctx context.Context, cmd IssueTokenCommand) (*IssuedToken, error) {
+	if err := appcore.ValidateUUID("userID", cmd.UserID); err != nil {
+		return nil, fmt.Errorf("validation failed: %w", err)
+	}
+
+	secret, err := generateToken()
+	if err != nil {
+		return nil, err
+	}
+	token := &FeedToken{
+		UserID:    cmd.UserID,
+		TokenHash: HashToken(secret),
+		CreatedAt: uc.now().UTC(),
+	}
+	if err = uc.tokens.Save(ctx, token); err != nil {
+		return nil, fmt.Errorf("failed to save calendar token: %w", err)
+	}
+
+	return &IssuedToken{Token: secret, CreatedAt: token.CreatedAt}, nil
+}
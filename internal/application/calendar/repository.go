@@ -0,0 +1,23 @@
+package calendar
+
+import (
+	"context"
+
+	"github.com/lllypuk/flowra/internal/domain/uuid"
+)
+
+// TokenRepository stores calendar feed tokens, at most one per user
+// Interface is declared on the consumer side (application layer)
+type TokenRepository interface {
+	// Save stores the token, replacing the previous token of the user
+	Save(ctx context.Context, token *FeedToken) error
+
+	// FindByUserID returns the token of a user; errs.ErrNotFound when there is none
+	FindByUserID(ctx context.Context, userID uuid.UUID) (*FeedToken, error)
+
+	// FindByHash returns the token with the given hash; errs.ErrNotFound when there is none
+	FindByHash(ctx context.Context, tokenHash string) (*FeedToken, error)
+
+	// Delete removes the token of a user; deleting a missing token is a no-op
+	Delete(ctx context.Context, userID uuid.UUID) error
+}
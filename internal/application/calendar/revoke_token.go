@@ -0,0 +1,30 @@
+package calendar
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/lllypuk/flowra/internal/application/appcore"
+)
+
+// RevokeTokenUseCase disables the calendar feed of a user
+type RevokeTokenUseCase struct {
+	tokens TokenRepository
+}
+
+// NewRevokeTokenUseCase creates a new RevokeTokenUseCase
+func NewRevokeTokenUseCase(tokens TokenRepository) *RevokeTokenUseCase {
+	return &RevokeTokenUseCase{tokens: tokens}
+}
+
+// Execute deletes the token; revoking a feed that is not active is a no-op
+func (uc *RevokeTokenUseCase) Execute(ctx context.Context, cmd RevokeTokenCommand) error {
+	if err := appcore.ValidateUUID("userID", cmd.UserID); err != nil {
+		return fmt.Errorf("validation failed: %w", err)
+	}
+
+	if err := uc.tokens.Delete(ctx, cmd.UserID); err != nil {
+		return fmt.Errorf("failed to revoke calendar token: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,42 @@
+package calendar
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"github.com/lllypuk/flowra/internal/domain/uuid"
+)
+
+// tokenBytes is the entropy of a feed token
+const tokenBytes = 32
+
+// FeedToken is the stored form of a calendar feed token
+type FeedToken struct {
+	UserID    uuid.UUID
+	TokenHash string
+	CreatedAt time.Time
+}
+
+// IssuedToken is returned once when a token is created; the secret is not stored
+type IssuedToken struct {
+	Token     string
+	CreatedAt time.Time
+}
+
+// HashToken returns the stored form of a secret token
+func HashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+func generateToken() (string, error) {
+	buf := make([]byte, tokenBytes)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("failed to generate token: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(buf), nil
+}
@@ -11,11 +11,27 @@ import (
 // AddAttachmentUseCase handles adding attachments to typed chats.
 type AddAttachmentUseCase struct {
 	chatRepo CommandRepository
+	quota    int64 // max total size of attachments per chat in bytes; 0 = unlimited
+}
+
+// AddAttachmentOption configures AddAttachmentUseCase.
+type AddAttachmentOption func(*AddAttachmentUseCase)
+
+// WithAttachmentQuota limits the total size of files attached to one chat.
+// A quota of zero or less disables the check.
+func WithAttachmentQuota(maxBytes int64) AddAttachmentOption {
+	return func(uc *AddAttachmentUseCase) {
+		uc.quota = max(maxBytes, 0)
+	}
 }
 
 // NewAddAttachmentUseCase creates a new AddAttachmentUseCase.
-func NewAddAttachmentUseCase(chatRepo CommandRepository) *AddAttachmentUseCase {
-	return &AddAttachmentUseCase{chatRepo: chatRepo}
+func NewAddAttachmentUseCase(chatRepo CommandRepository, opts ...AddAttachmentOption) *AddAttachmentUseCase {
+	uc := &AddAttachmentUseCase{chatRepo: chatRepo}
+	for _, opt := range opts {
+		opt(uc)
+	}
+	return uc
 }
 
 // Execute adds an attachment to the chat.
@@ -29,6 +45,10 @@ func (uc *AddAttachmentUseCase) Execute(ctx context.Context, cmd AddAttachmentCo
 		return Result{}, fmt.Errorf("failed to load chat: %w", err)
 	}
 
+	if uc.exceedsQuota(chatAggregate, cmd) {
+		return Result{}, ErrAttachmentQuotaExceeded
+	}
+
 	if addErr := chatAggregate.AddAttachment(
 		cmd.FileID,
 		cmd.FileName,
@@ -39,6 +59,8 @@ func (uc *AddAttachmentUseCase) Execute(ctx context.Context, cmd AddAttachmentCo
 		return Result{}, fmt.Errorf("failed to add attachment: %w", addErr)
 	}
 
+	appcore.StampEventMetadata(ctx, cmd, chatAggregate.GetUncommittedEvents())
+
 	if err = uc.chatRepo.Save(ctx, chatAggregate); err != nil {
 		return Result{}, fmt.Errorf("failed to save chat: %w", err)
 	}
@@ -51,6 +73,20 @@ func (uc *AddAttachmentUseCase) Execute(ctx context.Context, cmd AddAttachmentCo
 	}, nil
 }
 
+// exceedsQuota checks if the new file would push the chat over its attachment quota.
+// Re-adding an already attached file is idempotent and never exceeds the quota.
+func (uc *AddAttachmentUseCase) exceedsQuota(chatAggregate *chat.Chat, cmd AddAttachmentCommand) bool {
+	if uc.quota <= 0 {
+		return false
+	}
+	for _, existing := range chatAggregate.Attachments() {
+		if existing.FileID() == cmd.FileID {
+			return false
+		}
+	}
+	return chatAggregate.AttachmentsSize()+cmd.FileSize > uc.quota
+}
+
 func (uc *AddAttachmentUseCase) validate(cmd AddAttachmentCommand) error {
 	if err := appcore.ValidateUUID("chatID", cmd.ChatID); err != nil {
 		return err
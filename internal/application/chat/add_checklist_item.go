@@ -0,0 +1,61 @@
+package chat
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/lllypuk/flowra/internal/application/appcore"
+	"github.com/lllypuk/flowra/internal/domain/chat"
+)
+
+// AddChecklistItemUseCase handles adding checklist items to typed chats.
+type AddChecklistItemUseCase struct {
+	chatRepo CommandRepository
+}
+
+// NewAddChecklistItemUseCase creates a new AddChecklistItemUseCase.
+func NewAddChecklistItemUseCase(chatRepo CommandRepository) *AddChecklistItemUseCase {
+	return &AddChecklistItemUseCase{chatRepo: chatRepo}
+}
+
+// Execute appends an item to the chat checklist.
+func (uc *AddChecklistItemUseCase) Execute(ctx context.Context, cmd AddChecklistItemCommand) (Result, error) {
+	if err := uc.validate(cmd); err != nil {
+		return Result{}, fmt.Errorf("validation failed: %w", err)
+	}
+
+	chatAggregate, err := uc.chatRepo.Load(ctx, cmd.ChatID)
+	if err != nil {
+		return Result{}, fmt.Errorf("failed to load chat: %w", err)
+	}
+
+	if _, addErr := chatAggregate.AddChecklistItem(cmd.Text, cmd.AddedBy); addErr != nil {
+		return Result{}, fmt.Errorf("failed to add checklist item: %w", addErr)
+	}
+
+	appcore.StampEventMetadata(ctx, cmd, chatAggregate.GetUncommittedEvents())
+
+	if err = uc.chatRepo.Save(ctx, chatAggregate); err != nil {
+		return Result{}, fmt.Errorf("failed to save chat: %w", err)
+	}
+
+	return Result{
+		Result: appcore.Result[*chat.Chat]{
+			Value:   chatAggregate,
+			Version: chatAggregate.Version(),
+		},
+	}, nil
+}
+
+func (uc *AddChecklistItemUseCase) validate(cmd AddChecklistItemCommand) error {
+	if err := appcore.ValidateUUID("chatID", cmd.ChatID); err != nil {
+		return err
+	}
+	if err := appcore.ValidateRequired("text", cmd.Text); err != nil {
+		return err
+	}
+	if err := appcore.ValidateUUID("addedBy", cmd.AddedBy); err != nil {
+		return err
+	}
+	return nil
+}
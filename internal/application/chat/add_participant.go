@@ -38,6 +38,7 @@ func (uc *AddParticipantUseCase) Execute(ctx context.Context, cmd AddParticipant
 
 	// Capture events before save (Save marks them as committed)
 	newEvents := chatAggregate.GetUncommittedEvents()
+	appcore.StampEventMetadata(ctx, cmd, newEvents)
 
 	// Save via repository (updates both event store and read model)
 	if saveErr := uc.chatRepo.Save(ctx, chatAggregate); saveErr != nil {
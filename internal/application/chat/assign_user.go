@@ -45,6 +45,8 @@ func (uc *AssignUserUseCase) Execute(ctx context.Context, cmd AssignUserCommand)
 		return Result{}, fmt.Errorf("failed to assign user: %w", assignErr)
 	}
 
+	appcore.StampEventMetadata(ctx, cmd, chatAggregate.GetUncommittedEvents())
+
 	// Save via repository (updates both event store and read model)
 	if err = uc.chatRepo.Save(ctx, chatAggregate); err != nil {
 		return Result{}, fmt.Errorf("failed to save chat: %w", err)
@@ -101,3 +101,38 @@ func TestRemoveAttachmentUseCase_ValidationError(t *testing.T) {
 	require.Error(t, err)
 	assert.Nil(t, result.Value)
 }
+
+func TestAddAttachmentUseCase_Error_QuotaExceeded(t *testing.T) {
+	chatRepo := newTestChatRepo()
+	creatorID := generateUUID(t)
+	workspaceID := generateUUID(t)
+
+	createdChat := createTestChatWithRepo(t, chatRepo, domainchat.TypeTask, "Task", workspaceID, creatorID)
+
+	useCase := chatapp.NewAddAttachmentUseCase(chatRepo, chatapp.WithAttachmentQuota(2048))
+	first := chatapp.AddAttachmentCommand{
+		ChatID:   createdChat.ID(),
+		FileID:   uuid.NewUUID(),
+		FileName: "report.pdf",
+		FileSize: 1536,
+		MimeType: "application/pdf",
+		AddedBy:  creatorID,
+	}
+	_, err := useCase.Execute(testContext(), first)
+	require.NoError(t, err)
+
+	// re-attaching the same file does not count twice
+	_, err = useCase.Execute(testContext(), first)
+	require.NoError(t, err)
+
+	result, err := useCase.Execute(testContext(), chatapp.AddAttachmentCommand{
+		ChatID:   createdChat.ID(),
+		FileID:   uuid.NewUUID(),
+		FileName: "appendix.pdf",
+		FileSize: 1024,
+		MimeType: "application/pdf",
+		AddedBy:  creatorID,
+	})
+	require.ErrorIs(t, err, chatapp.ErrAttachmentQuotaExceeded)
+	assert.Nil(t, result.Value)
+}
@@ -36,6 +36,8 @@ func (uc *ChangeStatusUseCase) Execute(ctx context.Context, cmd ChangeStatusComm
 		return Result{}, fmt.Errorf("failed to change status: %w", statusErr)
 	}
 
+	appcore.StampEventMetadata(ctx, cmd, chatAggregate.GetUncommittedEvents())
+
 	// Save via repository (updates both event store and read model)
 	if err = uc.chatRepo.Save(ctx, chatAggregate); err != nil {
 		return Result{}, fmt.Errorf("failed to save chat: %w", err)
@@ -0,0 +1,97 @@
+package chat_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	chatapp "github.com/lllypuk/flowra/internal/application/chat"
+	domainchat "github.com/lllypuk/flowra/internal/domain/chat"
+	"github.com/lllypuk/flowra/internal/domain/errs"
+	"github.com/lllypuk/flowra/internal/domain/uuid"
+)
+
+func TestChecklistUseCases_Success(t *testing.T) {
+	chatRepo := newTestChatRepo()
+	creatorID := generateUUID(t)
+	workspaceID := generateUUID(t)
+
+	createdChat := createTestChatWithRepo(t, chatRepo, domainchat.TypeTask, "Task", workspaceID, creatorID)
+
+	addUseCase := chatapp.NewAddChecklistItemUseCase(chatRepo)
+	for _, text := range []string{"Write spec", "Implement", "Review"} {
+		_, err := addUseCase.Execute(testContext(), chatapp.AddChecklistItemCommand{
+			ChatID:  createdChat.ID(),
+			Text:    text,
+			AddedBy: creatorID,
+		})
+		require.NoError(t, err)
+	}
+
+	loaded, err := chatRepo.Load(testContext(), createdChat.ID())
+	require.NoError(t, err)
+	items := loaded.Checklist()
+	require.Len(t, items, 3)
+
+	toggleUseCase := chatapp.NewToggleChecklistItemUseCase(chatRepo)
+	result, err := toggleUseCase.Execute(testContext(), chatapp.ToggleChecklistItemCommand{
+		ChatID:    createdChat.ID(),
+		ItemID:    items[0].ID(),
+		Done:      true,
+		ToggledBy: creatorID,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, domainchat.ChecklistProgress{Total: 3, Done: 1}, result.Value.ChecklistProgress())
+
+	reorderUseCase := chatapp.NewReorderChecklistItemUseCase(chatRepo)
+	result, err = reorderUseCase.Execute(testContext(), chatapp.ReorderChecklistItemCommand{
+		ChatID:      createdChat.ID(),
+		ItemID:      items[2].ID(),
+		Position:    0,
+		ReorderedBy: creatorID,
+	})
+	require.NoError(t, err)
+
+	reordered := result.Value.Checklist()
+	assert.Equal(t, "Review", reordered[0].Text())
+	assert.Equal(t, "Write spec", reordered[1].Text())
+	assert.True(t, reordered[1].Done())
+	assert.Equal(t, 1, reordered[1].Position())
+}
+
+func TestAddChecklistItemUseCase_Error_DiscussionChat(t *testing.T) {
+	chatRepo := newTestChatRepo()
+	creatorID := generateUUID(t)
+	workspaceID := generateUUID(t)
+
+	createdChat := createTestChatWithRepo(t, chatRepo, domainchat.TypeDiscussion, "", workspaceID, creatorID)
+
+	useCase := chatapp.NewAddChecklistItemUseCase(chatRepo)
+	result, err := useCase.Execute(testContext(), chatapp.AddChecklistItemCommand{
+		ChatID:  createdChat.ID(),
+		Text:    "Write spec",
+		AddedBy: creatorID,
+	})
+
+	require.ErrorIs(t, err, errs.ErrInvalidState)
+	assert.Nil(t, result.Value)
+}
+
+func TestToggleChecklistItemUseCase_Error_ItemNotFound(t *testing.T) {
+	chatRepo := newTestChatRepo()
+	creatorID := generateUUID(t)
+	workspaceID := generateUUID(t)
+
+	createdChat := createTestChatWithRepo(t, chatRepo, domainchat.TypeTask, "Task", workspaceID, creatorID)
+
+	useCase := chatapp.NewToggleChecklistItemUseCase(chatRepo)
+	_, err := useCase.Execute(testContext(), chatapp.ToggleChecklistItemCommand{
+		ChatID:    createdChat.ID(),
+		ItemID:    uuid.NewUUID(),
+		Done:      true,
+		ToggledBy: creatorID,
+	})
+
+	require.ErrorIs(t, err, chatapp.ErrChecklistItemNotFound)
+}
@@ -35,6 +35,8 @@ func (uc *CloseChatUseCase) Execute(ctx context.Context, cmd CloseChatCommand) (
 		return Result{}, fmt.Errorf("failed to close chat: %w", closeErr)
 	}
 
+	appcore.StampEventMetadata(ctx, cmd, chatAggregate.GetUncommittedEvents())
+
 	return saveAggregate(ctx, uc.eventStore, chatAggregate, cmd.ChatID.String())
 }
 
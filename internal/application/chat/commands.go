@@ -40,6 +40,16 @@ type RemoveParticipantCommand struct {
 // CommandName returns the command name
 func (c RemoveParticipantCommand) CommandName() string { return "RemoveParticipant" }
 
+// TransferOwnershipCommand contains data for transferring chat ownership
+type TransferOwnershipCommand struct {
+	ChatID        uuid.UUID
+	NewOwnerID    uuid.UUID
+	TransferredBy uuid.UUID
+}
+
+// CommandName returns the command name
+func (c TransferOwnershipCommand) CommandName() string { return "TransferOwnership" }
+
 // ConvertToTaskCommand contains data for converting a chat to Task
 type ConvertToTaskCommand struct {
 	ChatID      uuid.UUID
@@ -70,6 +80,15 @@ type ConvertToEpicCommand struct {
 // CommandName returns the command name
 func (c ConvertToEpicCommand) CommandName() string { return "ConvertToEpic" }
 
+// ConvertToDiscussionCommand contains data for reverting a Task/Bug/Epic to a Discussion
+type ConvertToDiscussionCommand struct {
+	ChatID      uuid.UUID
+	ConvertedBy uuid.UUID
+}
+
+// CommandName returns the command name
+func (c ConvertToDiscussionCommand) CommandName() string { return "ConvertToDiscussion" }
+
 // ChangeStatusCommand contains data for changing status
 type ChangeStatusCommand struct {
 	ChatID    uuid.UUID
@@ -110,6 +129,46 @@ type SetDueDateCommand struct {
 // CommandName returns the command name
 func (c SetDueDateCommand) CommandName() string { return "SetDueDate" }
 
+// SetEstimateCommand contains data for setting an estimate
+type SetEstimateCommand struct {
+	ChatID   uuid.UUID
+	Estimate *chat.Estimate // nil = remove estimate
+	SetBy    uuid.UUID
+}
+
+// CommandName returns the command name
+func (c SetEstimateCommand) CommandName() string { return "SetEstimate" }
+
+// SetSprintCommand contains data for assigning a typed chat to a sprint
+type SetSprintCommand struct {
+	ChatID uuid.UUID
+	Sprint string // empty = remove from sprint
+	SetBy  uuid.UUID
+}
+
+// CommandName returns the command name
+func (c SetSprintCommand) CommandName() string { return "SetSprint" }
+
+// MarkDuplicateCommand contains data for marking a typed chat as a duplicate of another
+type MarkDuplicateCommand struct {
+	ChatID     uuid.UUID
+	OriginalID *uuid.UUID // nil = not a duplicate
+	MarkedBy   uuid.UUID
+}
+
+// CommandName returns the command name
+func (c MarkDuplicateCommand) CommandName() string { return "MarkDuplicate" }
+
+// SetEpicCommand contains data for linking a task or bug to its parent epic
+type SetEpicCommand struct {
+	ChatID uuid.UUID
+	EpicID *uuid.UUID // nil = unlink from the epic
+	SetBy  uuid.UUID
+}
+
+// CommandName returns the command name
+func (c SetEpicCommand) CommandName() string { return "SetEpic" }
+
 // AddAttachmentCommand contains data for attaching a file to typed chat.
 type AddAttachmentCommand struct {
 	ChatID   uuid.UUID
@@ -133,6 +192,38 @@ type RemoveAttachmentCommand struct {
 // CommandName returns the command name.
 func (c RemoveAttachmentCommand) CommandName() string { return "RemoveAttachment" }
 
+// AddChecklistItemCommand contains data for adding an item to typed chat checklist.
+type AddChecklistItemCommand struct {
+	ChatID  uuid.UUID
+	Text    string
+	AddedBy uuid.UUID
+}
+
+// CommandName returns the command name.
+func (c AddChecklistItemCommand) CommandName() string { return "AddChecklistItem" }
+
+// ToggleChecklistItemCommand contains data for marking a checklist item as done or not done.
+type ToggleChecklistItemCommand struct {
+	ChatID    uuid.UUID
+	ItemID    uuid.UUID
+	Done      bool
+	ToggledBy uuid.UUID
+}
+
+// CommandName returns the command name.
+func (c ToggleChecklistItemCommand) CommandName() string { return "ToggleChecklistItem" }
+
+// ReorderChecklistItemCommand contains data for moving a checklist item to a new position.
+type ReorderChecklistItemCommand struct {
+	ChatID      uuid.UUID
+	ItemID      uuid.UUID
+	Position    int // 0-based
+	ReorderedBy uuid.UUID
+}
+
+// CommandName returns the command name.
+func (c ReorderChecklistItemCommand) CommandName() string { return "ReorderChecklistItem" }
+
 // RenameChatCommand contains data for renaming a chat
 type RenameChatCommand struct {
 	ChatID    uuid.UUID
@@ -143,6 +234,16 @@ type RenameChatCommand struct {
 // CommandName returns the command name
 func (c RenameChatCommand) CommandName() string { return "RenameChat" }
 
+// SetTopicCommand contains data for changing the chat topic
+type SetTopicCommand struct {
+	ChatID uuid.UUID
+	Topic  string // empty = clear topic
+	SetBy  uuid.UUID
+}
+
+// CommandName returns the command name
+func (c SetTopicCommand) CommandName() string { return "SetTopic" }
+
 // SetSeverityCommand contains data for setting severity (only for Bug)
 type SetSeverityCommand struct {
 	ChatID   uuid.UUID
@@ -172,3 +273,30 @@ type ReopenChatCommand struct {
 
 // CommandName returns the command name
 func (c ReopenChatCommand) CommandName() string { return "ReopenChat" }
+
+// MarkChatReadCommand contains data for marking all chat messages as read
+type MarkChatReadCommand struct {
+	ChatID uuid.UUID
+	UserID uuid.UUID
+}
+
+// CommandName returns the command name
+func (c MarkChatReadCommand) CommandName() string { return "MarkChatRead" }
+
+// StarChatCommand contains data for starring a chat or task
+type StarChatCommand struct {
+	ChatID uuid.UUID
+	UserID uuid.UUID
+}
+
+// CommandName returns the command name
+func (c StarChatCommand) CommandName() string { return "StarChat" }
+
+// UnstarChatCommand contains data for removing the star of a chat or task
+type UnstarChatCommand struct {
+	ChatID uuid.UUID
+	UserID uuid.UUID
+}
+
+// CommandName returns the command name
+func (c UnstarChatCommand) CommandName() string { return "UnstarChat" }
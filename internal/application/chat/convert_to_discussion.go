@@ -0,0 +1,70 @@
+package chat
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/lllypuk/flowra/internal/application/appcore"
+	"github.com/lllypuk/flowra/internal/domain/chat"
+)
+
+// ConvertToDiscussionUseCase handles reverting a Task/Bug/Epic chat back to a Discussion
+type ConvertToDiscussionUseCase struct {
+	chatRepo CommandRepository
+}
+
+// NewConvertToDiscussionUseCase creates a new ConvertToDiscussionUseCase
+func NewConvertToDiscussionUseCase(chatRepo CommandRepository) *ConvertToDiscussionUseCase {
+	return &ConvertToDiscussionUseCase{
+		chatRepo: chatRepo,
+	}
+}
+
+// Execute performs the reverse conversion.
+// Only the chat owner or a chat admin may revert; an open entity is closed
+// first, and the task read model drops the chat once it is a discussion again.
+func (uc *ConvertToDiscussionUseCase) Execute(ctx context.Context, cmd ConvertToDiscussionCommand) (Result, error) {
+	if err := uc.validate(cmd); err != nil {
+		return Result{}, fmt.Errorf("validation failed: %w", err)
+	}
+
+	chatAggregate, err := uc.chatRepo.Load(ctx, cmd.ChatID)
+	if err != nil {
+		return Result{}, fmt.Errorf("failed to load chat: %w", err)
+	}
+
+	if chatAggregate.CreatedBy() != cmd.ConvertedBy && !chatAggregate.IsParticipantAdmin(cmd.ConvertedBy) {
+		return Result{}, ErrNotAdmin
+	}
+
+	if convertErr := chatAggregate.ConvertToDiscussion(cmd.ConvertedBy); convertErr != nil {
+		return Result{}, fmt.Errorf("failed to convert to discussion: %w", convertErr)
+	}
+
+	// Capture events before save (Save marks them as committed)
+	newEvents := chatAggregate.GetUncommittedEvents()
+	appcore.StampEventMetadata(ctx, cmd, newEvents)
+
+	// Save via repository (updates both event store and read model)
+	if err = uc.chatRepo.Save(ctx, chatAggregate); err != nil {
+		return Result{}, fmt.Errorf("failed to save chat: %w", err)
+	}
+
+	return Result{
+		Result: appcore.Result[*chat.Chat]{
+			Value:   chatAggregate,
+			Version: chatAggregate.Version(),
+		},
+		Events: convertToInterfaceSlice(newEvents),
+	}, nil
+}
+
+func (uc *ConvertToDiscussionUseCase) validate(cmd ConvertToDiscussionCommand) error {
+	if err := appcore.ValidateUUID("chatID", cmd.ChatID); err != nil {
+		return err
+	}
+	if err := appcore.ValidateUUID("convertedBy", cmd.ConvertedBy); err != nil {
+		return err
+	}
+	return nil
+}
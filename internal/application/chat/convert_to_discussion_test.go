@@ -0,0 +1,77 @@
+package chat_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lllypuk/flowra/internal/application/chat"
+	domainChat "github.com/lllypuk/flowra/internal/domain/chat"
+)
+
+// TestConvertToDiscussionUseCase_Success tests reverting a Task back to a Discussion
+func TestConvertToDiscussionUseCase_Success(t *testing.T) {
+	chatRepo := newTestChatRepo()
+	creatorID := generateUUID(t)
+	createdChat := createTestChatWithRepo(t, chatRepo, domainChat.TypeTask, "Task", generateUUID(t), creatorID)
+
+	useCase := chat.NewConvertToDiscussionUseCase(chatRepo)
+	result, err := useCase.Execute(testContext(), chat.ConvertToDiscussionCommand{
+		ChatID:      createdChat.ID(),
+		ConvertedBy: creatorID,
+	})
+
+	executeAndAssertSuccess(t, err)
+	require.NotNil(t, result.Value)
+	assertChatType(t, result.Value, domainChat.TypeDiscussion)
+	assertChatTitle(t, result.Value, "Task")
+	require.NotEmpty(t, result.Events)
+	assert.IsType(t, &domainChat.TypeChanged{}, result.Events[len(result.Events)-1])
+}
+
+// TestConvertToDiscussionUseCase_Errors tests permission and state checks
+func TestConvertToDiscussionUseCase_Errors(t *testing.T) {
+	t.Run("member cannot revert", func(t *testing.T) {
+		chatRepo := newTestChatRepo()
+		creatorID := generateUUID(t)
+		createdChat := createTestChatWithRepo(t, chatRepo, domainChat.TypeBug, "Bug", generateUUID(t), creatorID)
+
+		memberID := generateUUID(t)
+		_, err := chat.NewAddParticipantUseCase(chatRepo).Execute(testContext(), chat.AddParticipantCommand{
+			ChatID:  createdChat.ID(),
+			UserID:  memberID,
+			Role:    domainChat.RoleMember,
+			AddedBy: creatorID,
+		})
+		require.NoError(t, err)
+
+		_, err = chat.NewConvertToDiscussionUseCase(chatRepo).Execute(testContext(), chat.ConvertToDiscussionCommand{
+			ChatID:      createdChat.ID(),
+			ConvertedBy: memberID,
+		})
+		require.ErrorIs(t, err, chat.ErrNotAdmin)
+	})
+
+	t.Run("already a discussion", func(t *testing.T) {
+		chatRepo := newTestChatRepo()
+		creatorID := generateUUID(t)
+		createdChat := createTestChatWithRepo(t, chatRepo, domainChat.TypeDiscussion, "", generateUUID(t), creatorID)
+
+		_, err := chat.NewConvertToDiscussionUseCase(chatRepo).Execute(testContext(), chat.ConvertToDiscussionCommand{
+			ChatID:      createdChat.ID(),
+			ConvertedBy: creatorID,
+		})
+		executeAndAssertError(t, err)
+	})
+
+	t.Run("invalid user ID", func(t *testing.T) {
+		chatRepo := newTestChatRepo()
+		createdChat := createTestChatWithRepo(t, chatRepo, domainChat.TypeTask, "Task", generateUUID(t), generateUUID(t))
+
+		_, err := chat.NewConvertToDiscussionUseCase(chatRepo).Execute(testContext(), chat.ConvertToDiscussionCommand{
+			ChatID: createdChat.ID(),
+		})
+		executeAndAssertError(t, err)
+	})
+}
@@ -37,6 +37,8 @@ func (uc *ConvertToEpicUseCase) Execute(ctx context.Context, cmd ConvertToEpicCo
 		return Result{}, fmt.Errorf("failed to convert to epic: %w", convertErr)
 	}
 
+	appcore.StampEventMetadata(ctx, cmd, chatAggregate.GetUncommittedEvents())
+
 	// Save via repository (updates both event store and read model)
 	if err = uc.chatRepo.Save(ctx, chatAggregate); err != nil {
 		return Result{}, fmt.Errorf("failed to save chat: %w", err)
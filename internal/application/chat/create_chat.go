@@ -41,6 +41,7 @@ func (uc *CreateChatUseCase) Execute(ctx context.Context, cmd CreateChatCommand)
 
 	// Capture events before saving (for response)
 	uncommittedEvents := chatAggregate.GetUncommittedEvents()
+	appcore.StampEventMetadata(ctx, cmd, uncommittedEvents)
 
 	// Save via repository (updates both event store and read model)
 	if err = uc.chatRepo.Save(ctx, chatAggregate); err != nil {
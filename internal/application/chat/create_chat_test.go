@@ -7,8 +7,10 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/lllypuk/flowra/internal/application/appcore"
 	"github.com/lllypuk/flowra/internal/application/chat"
 	domainChat "github.com/lllypuk/flowra/internal/domain/chat"
+	"github.com/lllypuk/flowra/internal/domain/event"
 	"github.com/lllypuk/flowra/internal/domain/uuid"
 )
 
@@ -126,6 +128,35 @@ func TestCreateChatUseCase_Success_Task(t *testing.T) {
 	assert.True(t, isTypeChanged, "Third event should be TypeChanged")
 }
 
+// TestCreateChatUseCase_StampsRequestMetadata tests that events carry the request correlation ID
+func TestCreateChatUseCase_StampsRequestMetadata(t *testing.T) {
+	// Arrange
+	chatRepo := newTestChatRepo()
+	useCase := chat.NewCreateChatUseCase(chatRepo)
+	ctx := appcore.WithCorrelationID(testContext(), "corr-123")
+
+	cmd := chat.CreateChatCommand{
+		WorkspaceID: generateUUID(t),
+		Type:        domainChat.TypeTask,
+		IsPublic:    true,
+		Title:       "Traced Task",
+		CreatedBy:   generateUUID(t),
+	}
+
+	// Act
+	result, err := useCase.Execute(ctx, cmd)
+
+	// Assert
+	executeAndAssertSuccess(t, err)
+	assertEventCount(t, result, 3)
+	for _, raw := range result.Events {
+		evt, ok := raw.(event.DomainEvent)
+		require.True(t, ok)
+		assert.Equal(t, "corr-123", evt.Metadata().CorrelationID)
+		assert.Equal(t, cmd.CommandName(), evt.Metadata().CausationID)
+	}
+}
+
 // TestCreateChatUseCase_Success_Bug tests creating a Bug chat
 func TestCreateChatUseCase_Success_Bug(t *testing.T) {
 	// Arrange
@@ -44,6 +44,20 @@ var (
 	ErrCannotModifyDiscussion = errors.New("cannot modify properties of discussion chat")
 	// ErrAssigneeNotFound indicates requested assignee does not exist
 	ErrAssigneeNotFound = errors.New("assignee not found")
+	// ErrAttachmentQuotaExceeded indicates attached files would exceed the per-chat size quota
+	ErrAttachmentQuotaExceeded = errors.New("attachment quota exceeded")
+	// ErrChecklistItemNotFound indicates requested checklist item does not exist
+	ErrChecklistItemNotFound = errors.New("checklist item not found")
+	// ErrPriorityNotAllowed indicates the workspace does not allow the priority for the entity type
+	ErrPriorityNotAllowed = errors.New("priority is not allowed in this workspace")
+	// ErrSeverityNotAllowed indicates the workspace does not allow the severity for the entity type
+	ErrSeverityNotAllowed = errors.New("severity is not allowed in this workspace")
+	// ErrDuplicateOriginalNotFound indicates the original of a duplicate is not a task of the same workspace
+	ErrDuplicateOriginalNotFound = errors.New("original task not found")
+	// ErrEpicNotFound indicates the parent is not an epic of the same workspace
+	ErrEpicNotFound = errors.New("epic not found")
+	// ErrInvalidHierarchy indicates the chat cannot be a child of an epic (epics and discussions)
+	ErrInvalidHierarchy = errors.New("only tasks and bugs can belong to an epic")
 )
 
 // Authorization errors
@@ -13,13 +13,28 @@ import (
 // GetChatUseCase - use case for retrieving a chat
 type GetChatUseCase struct {
 	eventStore appcore.EventStore
+	stars      StarRepository // optional; without it no chat is starred
+}
+
+// GetChatOption configures GetChatUseCase.
+type GetChatOption func(*GetChatUseCase)
+
+// WithGetStars reports whether the requesting user starred the chat.
+func WithGetStars(stars StarRepository) GetChatOption {
+	return func(uc *GetChatUseCase) {
+		uc.stars = stars
+	}
 }
 
 // NewGetChatUseCase - constructor
-func NewGetChatUseCase(eventStore appcore.EventStore) *GetChatUseCase {
-	return &GetChatUseCase{
+func NewGetChatUseCase(eventStore appcore.EventStore, opts ...GetChatOption) *GetChatUseCase {
+	uc := &GetChatUseCase{
 		eventStore: eventStore,
 	}
+	for _, opt := range opts {
+		opt(uc)
+	}
+	return uc
 }
 
 // Execute - execute the query
@@ -42,6 +57,13 @@ func (uc *GetChatUseCase) Execute(ctx context.Context, query GetChatQuery) (*Get
 
 	// 4. Build Chat DTO
 	chatDTO := mapChatToDTO(chatAggregate)
+	if uc.stars != nil {
+		starred, starErr := uc.stars.IsStarred(ctx, query.RequestedBy, query.ChatID)
+		if starErr != nil {
+			return nil, fmt.Errorf("failed to check star: %w", starErr)
+		}
+		chatDTO.IsStarred = starred
+	}
 
 	// 5. Calculate permissions
 	permissions := calculatePermissions(chatAggregate, query.RequestedBy)
@@ -69,6 +91,7 @@ func mapChatToDTO(chatAggregate *chat.Chat) *Chat {
 		WorkspaceID:  chatAggregate.WorkspaceID(),
 		Type:         chatAggregate.Type(),
 		Title:        chatAggregate.Title(),
+		Topic:        chatAggregate.Topic(),
 		IsPublic:     chatAggregate.IsPublic(),
 		CreatedBy:    chatAggregate.CreatedBy(),
 		CreatedAt:    chatAggregate.CreatedAt(),
@@ -110,6 +133,17 @@ func mapChatToDTO(chatAggregate *chat.Chat) *Chat {
 			JoinedAt: p.JoinedAt(),
 		})
 	}
+	dto.ParticipantCount = len(dto.Participants)
+
+	// Map type conversions
+	for _, tc := range chatAggregate.ConversionHistory() {
+		dto.ConversionHistory = append(dto.ConversionHistory, TypeConversion{
+			FromType:    tc.FromType(),
+			ToType:      tc.ToType(),
+			ConvertedBy: tc.ConvertedBy(),
+			ConvertedAt: tc.ConvertedAt(),
+		})
+	}
 
 	return dto
 }
@@ -319,3 +319,33 @@ func TestGetChatUseCase_ValidationError_InvalidRequestedBy(t *testing.T) {
 	require.Nil(t, result)
 	assert.Contains(t, err.Error(), "validation failed")
 }
+
+// TestGetChatUseCase_Success_ConversionHistory tests that type changes are exposed in order
+func TestGetChatUseCase_Success_ConversionHistory(t *testing.T) {
+	eventStore := newTestEventStore()
+	useCase := chat.NewGetChatUseCase(eventStore)
+
+	creatorID := generateUUID(t)
+	testChat, err := domainChat.NewChat(generateUUID(t), domainChat.TypeDiscussion, false, creatorID)
+	require.NoError(t, err)
+	require.NoError(t, testChat.ConvertToBug("Crash on save", creatorID))
+	require.NoError(t, testChat.ConvertToDiscussion(creatorID))
+	require.NoError(t, eventStore.SaveEvents(
+		context.Background(), testChat.ID().String(), testChat.GetUncommittedEvents(), 0,
+	))
+
+	result, err := useCase.Execute(testContext(), chat.GetChatQuery{
+		ChatID:      testChat.ID(),
+		RequestedBy: creatorID,
+	})
+
+	executeAndAssertSuccess(t, err)
+	history := result.Chat.ConversionHistory
+	require.Len(t, history, 2)
+	assert.Equal(t, domainChat.TypeDiscussion, history[0].FromType)
+	assert.Equal(t, domainChat.TypeBug, history[0].ToType)
+	assert.Equal(t, domainChat.TypeBug, history[1].FromType)
+	assert.Equal(t, domainChat.TypeDiscussion, history[1].ToType)
+	assert.Equal(t, creatorID, history[1].ConvertedBy)
+	assert.False(t, history[1].ConvertedAt.IsZero())
+}
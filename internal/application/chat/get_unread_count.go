@@ -0,0 +1,62 @@
+package chat
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/lllypuk/flowra/internal/application/appcore"
+)
+
+// GetUnreadCountUseCase - use case for retrieving the user's unread counter of a chat.
+// The counter is the chat message count, kept up to date by the chat activity
+// projector from message.created events, minus the user's read marker. The read
+// marker is the user's entry in the read_counts map of the chat read model, which
+// MarkChatReadUseCase moves; there is no separate read marker store.
+type GetUnreadCountUseCase struct {
+	chatRepo QueryRepository
+}
+
+// NewGetUnreadCountUseCase - constructor
+func NewGetUnreadCountUseCase(chatRepo QueryRepository) *GetUnreadCountUseCase {
+	return &GetUnreadCountUseCase{
+		chatRepo: chatRepo,
+	}
+}
+
+// Execute - execute the query
+func (uc *GetUnreadCountUseCase) Execute(
+	ctx context.Context,
+	query GetUnreadCountQuery,
+) (*GetUnreadCountResult, error) {
+	// 1. Validate input
+	if err := uc.validate(query); err != nil {
+		return nil, fmt.Errorf("validation failed: %w", err)
+	}
+
+	// 2. Load chat from read model
+	rm, err := uc.chatRepo.FindByID(ctx, query.ChatID)
+	if err != nil {
+		return nil, ErrChatNotFound
+	}
+
+	// 3. Check access: public chats or where user is participant
+	if !rm.IsPublic && !isReadModelParticipant(rm, query.RequestedBy) {
+		return nil, ErrUserNotParticipant
+	}
+
+	return &GetUnreadCountResult{
+		ChatID:       rm.ID,
+		UnreadCount:  rm.UnreadCount(query.RequestedBy),
+		MessageCount: rm.MessageCount,
+	}, nil
+}
+
+func (uc *GetUnreadCountUseCase) validate(query GetUnreadCountQuery) error {
+	if err := appcore.ValidateUUID("chatID", query.ChatID); err != nil {
+		return err
+	}
+	if err := appcore.ValidateUUID("requestedBy", query.RequestedBy); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,70 @@
+package chat_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lllypuk/flowra/internal/application/chat"
+	domainChat "github.com/lllypuk/flowra/internal/domain/chat"
+	"github.com/lllypuk/flowra/internal/domain/uuid"
+)
+
+func TestGetUnreadCountUseCase(t *testing.T) {
+	setup := func(t *testing.T) (*MockChatQueryRepository, *chat.GetUnreadCountUseCase, uuid.UUID, uuid.UUID) {
+		t.Helper()
+		queryRepo := NewMockChatQueryRepository()
+		memberID := generateUUID(t)
+		chatID := generateUUID(t)
+		queryRepo.SetupReadModel(&chat.ReadModel{
+			ID:           chatID,
+			WorkspaceID:  generateUUID(t),
+			Type:         domainChat.TypeDiscussion,
+			MessageCount: 5,
+			ReadCounts:   map[uuid.UUID]int{memberID: 2},
+			Participants: []domainChat.Participant{domainChat.NewParticipant(memberID, domainChat.RoleMember)},
+		})
+		return queryRepo, chat.NewGetUnreadCountUseCase(queryRepo), chatID, memberID
+	}
+
+	t.Run("counts messages after the read marker", func(t *testing.T) {
+		_, useCase, chatID, memberID := setup(t)
+
+		result, err := useCase.Execute(testContext(), chat.GetUnreadCountQuery{ChatID: chatID, RequestedBy: memberID})
+
+		require.NoError(t, err)
+		assert.Equal(t, chatID, result.ChatID)
+		assert.Equal(t, 3, result.UnreadCount)
+		assert.Equal(t, 5, result.MessageCount)
+	})
+
+	t.Run("marking the chat read resets the counter", func(t *testing.T) {
+		queryRepo, useCase, chatID, memberID := setup(t)
+		require.NoError(t, chat.NewMarkChatReadUseCase(queryRepo, queryRepo).Execute(
+			testContext(), chat.MarkChatReadCommand{ChatID: chatID, UserID: memberID}))
+
+		result, err := useCase.Execute(testContext(), chat.GetUnreadCountQuery{ChatID: chatID, RequestedBy: memberID})
+
+		require.NoError(t, err)
+		assert.Equal(t, 0, result.UnreadCount)
+	})
+
+	t.Run("non-participant of private chat", func(t *testing.T) {
+		_, useCase, chatID, _ := setup(t)
+
+		_, err := useCase.Execute(testContext(),
+			chat.GetUnreadCountQuery{ChatID: chatID, RequestedBy: generateUUID(t)})
+
+		require.ErrorIs(t, err, chat.ErrUserNotParticipant)
+	})
+
+	t.Run("chat not found", func(t *testing.T) {
+		_, useCase, _, memberID := setup(t)
+
+		_, err := useCase.Execute(testContext(),
+			chat.GetUnreadCountQuery{ChatID: generateUUID(t), RequestedBy: memberID})
+
+		require.ErrorIs(t, err, chat.ErrChatNotFound)
+	})
+}
@@ -80,3 +80,13 @@ func convertToInterfaceSlice(events []event.DomainEvent) []any {
 	}
 	return result
 }
+
+// isReadModelParticipant checks whether the user is a participant of the chat read model
+func isReadModelParticipant(rm *ReadModel, userID uuid.UUID) bool {
+	for _, p := range rm.Participants {
+		if p.UserID() == userID {
+			return true
+		}
+	}
+	return false
+}
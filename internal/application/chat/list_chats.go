@@ -5,20 +5,41 @@ import (
 	"fmt"
 
 	"github.com/lllypuk/flowra/internal/application/appcore"
+	"github.com/lllypuk/flowra/internal/domain/uuid"
 )
 
 // ListChatsUseCase - use case for retrieving a list of chats
 type ListChatsUseCase struct {
 	chatRepo   QueryRepository
 	eventStore appcore.EventStore
+	stars      StarRepository // optional; without it no chat is starred
+}
+
+// ListChatsOption configures ListChatsUseCase.
+type ListChatsOption func(*ListChatsUseCase)
+
+// WithListStars marks the chats the requesting user starred and enables
+// listing only those.
+func WithListStars(stars StarRepository) ListChatsOption {
+	return func(uc *ListChatsUseCase) {
+		uc.stars = stars
+	}
 }
 
 // NewListChatsUseCase - constructor
-func NewListChatsUseCase(chatRepo QueryRepository, eventStore appcore.EventStore) *ListChatsUseCase {
-	return &ListChatsUseCase{
+func NewListChatsUseCase(
+	chatRepo QueryRepository,
+	eventStore appcore.EventStore,
+	opts ...ListChatsOption,
+) *ListChatsUseCase {
+	uc := &ListChatsUseCase{
 		chatRepo:   chatRepo,
 		eventStore: eventStore,
 	}
+	for _, opt := range opts {
+		opt(uc)
+	}
+	return uc
 }
 
 // Execute - execute the query
@@ -44,57 +65,72 @@ func (uc *ListChatsUseCase) Execute(ctx context.Context, query ListChatsQuery) (
 
 	offset := max(query.Offset, 0)
 
-	// 3. Find chats from read model
+	// 3. Load the stars of the user
+	starred, err := uc.findStarred(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	// 4. Find chats from read model
 	filters := Filters{
 		Type:   query.Type,
 		Offset: offset,
 		Limit:  limit + 1,
 	}
+	if query.StarredOnly {
+		filters.IDs = make([]uuid.UUID, 0, len(starred))
+		for id := range starred {
+			filters.IDs = append(filters.IDs, id)
+		}
+	}
 	readModels, err := uc.chatRepo.FindByWorkspace(ctx, query.WorkspaceID, filters)
 	if err != nil {
 		return nil, fmt.Errorf("failed to find chats: %w", err)
 	}
 
-	// 4. Filter by access and convert to DTO
+	// 5. Filter by access and convert to DTO
 	accessibleChats := make([]Chat, 0, len(readModels))
 	for _, rm := range readModels {
 		// Check access: public chats or where user is participant
-		if !rm.IsPublic {
-			hasAccess := false
-			for _, p := range rm.Participants {
-				if p.UserID() == query.RequestedBy {
-					hasAccess = true
-					break
-				}
-			}
-			if !hasAccess {
-				continue
-			}
+		if !rm.IsPublic && !isReadModelParticipant(rm, query.RequestedBy) {
+			continue
 		}
 
 		// Convert read model to DTO
 		accessibleChats = append(accessibleChats, Chat{
-			ID:          rm.ID,
-			WorkspaceID: rm.WorkspaceID,
-			Type:        rm.Type,
-			Title:       rm.Title,
-			IsPublic:    rm.IsPublic,
-			CreatedBy:   rm.CreatedBy,
-			CreatedAt:   rm.CreatedAt,
+			ID:               rm.ID,
+			WorkspaceID:      rm.WorkspaceID,
+			Type:             rm.Type,
+			Title:            rm.Title,
+			IsPublic:         rm.IsPublic,
+			CreatedBy:        rm.CreatedBy,
+			CreatedAt:        rm.CreatedAt,
+			IsStarred:        starred[rm.ID],
+			UnreadCount:      rm.UnreadCount(query.RequestedBy),
+			ParticipantCount: len(rm.Participants),
+			LastActivityAt:   rm.LastActivityAt,
+			LastMessage:      rm.LastMessage,
 		})
 	}
 
-	// 5. Check if has more
+	// 6. Check if has more
 	hasMore := len(readModels) > limit
 	if hasMore && len(accessibleChats) > limit {
 		accessibleChats = accessibleChats[:limit]
 	}
 
-	// 6. Count total (for pagination info)
+	// 7. Count total (for pagination info)
 	total, err := uc.chatRepo.Count(ctx, query.WorkspaceID)
 	if err != nil {
 		total = len(accessibleChats) // fallback
 	}
+	if query.StarredOnly {
+		// Stars of deleted chats are not counted once the last page is reached
+		total = len(starred)
+		if !hasMore {
+			total = offset + len(accessibleChats)
+		}
+	}
 
 	return &ListChatsResult{
 		Chats:   accessibleChats,
@@ -103,6 +139,22 @@ func (uc *ListChatsUseCase) Execute(ctx context.Context, query ListChatsQuery) (
 	}, nil
 }
 
+// findStarred returns the chats the user starred in the workspace.
+func (uc *ListChatsUseCase) findStarred(ctx context.Context, query ListChatsQuery) (map[uuid.UUID]bool, error) {
+	starred := make(map[uuid.UUID]bool)
+	if uc.stars == nil {
+		return starred, nil
+	}
+	ids, err := uc.stars.FindStarred(ctx, query.RequestedBy, query.WorkspaceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find starred chats: %w", err)
+	}
+	for _, id := range ids {
+		starred[id] = true
+	}
+	return starred, nil
+}
+
 func (uc *ListChatsUseCase) validate(query ListChatsQuery) error {
 	if err := appcore.ValidateUUID("workspaceID", query.WorkspaceID); err != nil {
 		return err
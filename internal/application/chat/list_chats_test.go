@@ -2,7 +2,9 @@ package chat_test
 
 import (
 	"context"
+	"slices"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -80,6 +82,10 @@ func (m *MockChatQueryRepository) chatMatchesFilters(
 		return false
 	}
 
+	if filters.IDs != nil && !slices.Contains(filters.IDs, rm.ID) {
+		return false
+	}
+
 	return true
 }
 
@@ -165,6 +171,19 @@ func (m *MockChatQueryRepository) SetupReadModel(rm *chat.ReadModel) {
 	m.readModels[rm.ID] = rm
 }
 
+// MarkRead moves the user's read marker to the message counter
+func (m *MockChatQueryRepository) MarkRead(_ context.Context, chatID, userID uuid.UUID) error {
+	rm, ok := m.readModels[chatID]
+	if !ok {
+		return chat.ErrChatNotFound
+	}
+	if rm.ReadCounts == nil {
+		rm.ReadCounts = make(map[uuid.UUID]int)
+	}
+	rm.ReadCounts[userID] = rm.MessageCount
+	return nil
+}
+
 // TestListChatsUseCase_Success_AllChats tests listing all chats in workspace
 func TestListChatsUseCase_Success_AllChats(t *testing.T) {
 	// Arrange
@@ -452,3 +471,74 @@ func TestListChatsUseCase_ValidationError_InvalidWorkspaceID(t *testing.T) {
 	require.Nil(t, result)
 	assert.Contains(t, err.Error(), "validation failed")
 }
+
+// TestListChatsUseCase_Success_UnreadCount tests per-user unread counters from the read model
+func TestListChatsUseCase_Success_UnreadCount(t *testing.T) {
+	// Arrange
+	queryRepo := NewMockChatQueryRepository()
+	useCase := chat.NewListChatsUseCase(queryRepo, newTestEventStore())
+
+	workspaceID := generateUUID(t)
+	reader := generateUUID(t)
+	newcomer := generateUUID(t)
+
+	queryRepo.SetupReadModel(&chat.ReadModel{
+		ID:           generateUUID(t),
+		WorkspaceID:  workspaceID,
+		Type:         domainChat.TypeDiscussion,
+		IsPublic:     true,
+		CreatedBy:    reader,
+		MessageCount: 7,
+		ReadCounts:   map[uuid.UUID]int{reader: 5},
+	})
+
+	// Act & Assert
+	for userID, expected := range map[uuid.UUID]int{reader: 2, newcomer: 7} {
+		result, err := useCase.Execute(testContext(), chat.ListChatsQuery{
+			WorkspaceID: workspaceID,
+			RequestedBy: userID,
+		})
+		executeAndAssertSuccess(t, err)
+		require.Len(t, result.Chats, 1)
+		assert.Equal(t, expected, result.Chats[0].UnreadCount)
+	}
+}
+
+func TestListChatsUseCase_Success_LastMessage(t *testing.T) {
+	// Arrange
+	queryRepo := NewMockChatQueryRepository()
+	useCase := chat.NewListChatsUseCase(queryRepo, newTestEventStore())
+
+	workspaceID := generateUUID(t)
+	author := generateUUID(t)
+	activityAt := time.Now().Add(-time.Minute)
+	preview := &chat.LastMessagePreview{
+		MessageID: generateUUID(t),
+		AuthorID:  author,
+		Content:   "latest news",
+		CreatedAt: activityAt,
+	}
+
+	queryRepo.SetupReadModel(&chat.ReadModel{
+		ID:             generateUUID(t),
+		WorkspaceID:    workspaceID,
+		Type:           domainChat.TypeDiscussion,
+		IsPublic:       true,
+		CreatedBy:      author,
+		LastActivityAt: &activityAt,
+		LastMessage:    preview,
+	})
+
+	// Act
+	result, err := useCase.Execute(testContext(), chat.ListChatsQuery{
+		WorkspaceID: workspaceID,
+		RequestedBy: author,
+	})
+
+	// Assert
+	executeAndAssertSuccess(t, err)
+	require.Len(t, result.Chats, 1)
+	require.NotNil(t, result.Chats[0].LastActivityAt)
+	assert.True(t, result.Chats[0].LastActivityAt.Equal(activityAt))
+	assert.Equal(t, preview, result.Chats[0].LastMessage)
+}
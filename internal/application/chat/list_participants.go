@@ -2,22 +2,40 @@ package chat
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"sort"
+	"strings"
 
 	"github.com/lllypuk/flowra/internal/application/appcore"
+	"github.com/lllypuk/flowra/internal/domain/chat"
+	"github.com/lllypuk/flowra/internal/domain/uuid"
 )
 
-// ListParticipantsUseCase - use case for retrieving a list of participants
+// Participant list pagination bounds
+const (
+	defaultParticipantsLimit = 50
+	maxParticipantsLimit     = 200
+)
+
+// ParticipantNameResolver resolves participant display names (consumer-side interface)
+type ParticipantNameResolver interface {
+	GetDisplayName(ctx context.Context, userID uuid.UUID) (string, error)
+}
+
+// ListParticipantsUseCase - use case for retrieving a page of participants
 type ListParticipantsUseCase struct {
-	eventStore appcore.EventStore
+	eventStore   appcore.EventStore
+	nameResolver ParticipantNameResolver // optional; without it names stay empty and searches match nothing
 }
 
 // NewListParticipantsUseCase - constructor
-func NewListParticipantsUseCase(eventStore appcore.EventStore) *ListParticipantsUseCase {
+func NewListParticipantsUseCase(
+	eventStore appcore.EventStore,
+	nameResolver ParticipantNameResolver,
+) *ListParticipantsUseCase {
 	return &ListParticipantsUseCase{
-		eventStore: eventStore,
+		eventStore:   eventStore,
+		nameResolver: nameResolver,
 	}
 }
 
@@ -31,6 +49,13 @@ func (uc *ListParticipantsUseCase) Execute(
 		return nil, fmt.Errorf("validation failed: %w", err)
 	}
 
+	limit := query.Limit
+	if limit <= 0 {
+		limit = defaultParticipantsLimit
+	}
+	limit = min(limit, maxParticipantsLimit)
+	offset := max(query.Offset, 0)
+
 	// 2. Load chat
 	chatAggregate, err := loadAggregate(ctx, uc.eventStore, query.ChatID)
 	if err != nil {
@@ -39,32 +64,73 @@ func (uc *ListParticipantsUseCase) Execute(
 
 	// 3. Check access (must be participant or public chat)
 	if !chatAggregate.IsPublic() && !chatAggregate.HasParticipant(query.RequestedBy) {
-		return nil, errors.New("access denied: user is not a participant")
+		return nil, ErrUserNotParticipant
 	}
 
-	// 4. Get participants
-	participants := chatAggregate.Participants()
+	// 4. Filter by role
+	participants := make([]Participant, 0, len(chatAggregate.Participants()))
+	for _, p := range chatAggregate.Participants() {
+		if query.Role != nil && p.Role() != *query.Role {
+			continue
+		}
+		participants = append(participants, Participant{
+			UserID:   p.UserID(),
+			Role:     p.Role(),
+			JoinedAt: p.JoinedAt(),
+		})
+	}
 
-	// 5. Sort by join date (ascending)
+	// 5. Sort by join date (ascending), user ID breaks ties for stable pages
 	sort.Slice(participants, func(i, j int) bool {
-		return participants[i].JoinedAt().Before(participants[j].JoinedAt())
+		if participants[i].JoinedAt.Equal(participants[j].JoinedAt) {
+			return participants[i].UserID < participants[j].UserID
+		}
+		return participants[i].JoinedAt.Before(participants[j].JoinedAt)
 	})
 
-	// 6. Map to DTOs
-	participantDTOs := make([]Participant, len(participants))
-	for i, p := range participants {
-		participantDTOs[i] = Participant{
-			UserID:   p.UserID(),
-			Role:     p.Role(),
-			JoinedAt: p.JoinedAt(),
+	// 6. Search by name; names must be resolved for every candidate
+	search := strings.ToLower(strings.TrimSpace(query.Search))
+	if search != "" {
+		uc.resolveNames(ctx, participants)
+		matched := participants[:0]
+		for _, p := range participants {
+			if strings.Contains(strings.ToLower(p.DisplayName), search) {
+				matched = append(matched, p)
+			}
 		}
+		participants = matched
+	}
+
+	// 7. Paginate
+	total := len(participants)
+	start := min(offset, total)
+	end := min(start+limit, total)
+	page := participants[start:end]
+	if search == "" {
+		uc.resolveNames(ctx, page)
 	}
 
 	return &ListParticipantsResult{
-		Participants: participantDTOs,
+		Participants: page,
+		Total:        total,
+		HasMore:      end < total,
 	}, nil
 }
 
+// resolveNames fills DisplayName; unresolved users keep an empty name
+func (uc *ListParticipantsUseCase) resolveNames(ctx context.Context, participants []Participant) {
+	if uc.nameResolver == nil {
+		return
+	}
+	for i := range participants {
+		name, err := uc.nameResolver.GetDisplayName(ctx, participants[i].UserID)
+		if err != nil {
+			continue
+		}
+		participants[i].DisplayName = name
+	}
+}
+
 func (uc *ListParticipantsUseCase) validate(query ListParticipantsQuery) error {
 	if err := appcore.ValidateUUID("chatID", query.ChatID); err != nil {
 		return err
@@ -72,5 +138,8 @@ func (uc *ListParticipantsUseCase) validate(query ListParticipantsQuery) error {
 	if err := appcore.ValidateUUID("requestedBy", query.RequestedBy); err != nil {
 		return err
 	}
+	if query.Role != nil && *query.Role != chat.RoleMember && *query.Role != chat.RoleAdmin {
+		return ErrInvalidRole
+	}
 	return nil
 }
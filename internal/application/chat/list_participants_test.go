@@ -2,6 +2,7 @@ package chat_test
 
 import (
 	"context"
+	"errors"
 	"testing"
 	"time"
 
@@ -18,7 +19,7 @@ import (
 func TestListParticipantsUseCase_Success(t *testing.T) {
 	// Arrange
 	eventStore := newTestEventStore()
-	useCase := chat.NewListParticipantsUseCase(eventStore)
+	useCase := chat.NewListParticipantsUseCase(eventStore, nil)
 
 	workspaceID := generateUUID(t)
 	creatorID := generateUUID(t)
@@ -52,7 +53,7 @@ func TestListParticipantsUseCase_Success(t *testing.T) {
 func TestListParticipantsUseCase_Error_ChatNotFound(t *testing.T) {
 	// Arrange
 	eventStore := newTestEventStore()
-	useCase := chat.NewListParticipantsUseCase(eventStore)
+	useCase := chat.NewListParticipantsUseCase(eventStore, nil)
 
 	nonExistentChatID := generateUUID(t)
 	requestedBy := generateUUID(t)
@@ -75,7 +76,7 @@ func TestListParticipantsUseCase_Error_ChatNotFound(t *testing.T) {
 func TestListParticipantsUseCase_Error_NotParticipant(t *testing.T) {
 	// Arrange
 	eventStore := newTestEventStore()
-	useCase := chat.NewListParticipantsUseCase(eventStore)
+	useCase := chat.NewListParticipantsUseCase(eventStore, nil)
 
 	workspaceID := generateUUID(t)
 	creatorID := generateUUID(t)
@@ -113,16 +114,15 @@ func TestListParticipantsUseCase_Error_NotParticipant(t *testing.T) {
 	result, err := useCase.Execute(testContext(), query)
 
 	// Assert
-	require.Error(t, err)
+	require.ErrorIs(t, err, chat.ErrUserNotParticipant)
 	require.Nil(t, result)
-	assert.Contains(t, err.Error(), "access denied")
 }
 
 // TestListParticipantsUseCase_Success_IncludesRoles tests that roles are included in results
 func TestListParticipantsUseCase_Success_IncludesRoles(t *testing.T) {
 	// Arrange
 	eventStore := newTestEventStore()
-	useCase := chat.NewListParticipantsUseCase(eventStore)
+	useCase := chat.NewListParticipantsUseCase(eventStore, nil)
 
 	workspaceID := generateUUID(t)
 	creatorID := generateUUID(t)
@@ -170,7 +170,7 @@ func TestListParticipantsUseCase_Success_IncludesRoles(t *testing.T) {
 func TestListParticipantsUseCase_Success_SortedByJoinDate(t *testing.T) {
 	// Arrange
 	eventStore := newTestEventStore()
-	useCase := chat.NewListParticipantsUseCase(eventStore)
+	useCase := chat.NewListParticipantsUseCase(eventStore, nil)
 
 	workspaceID := generateUUID(t)
 	creatorID := generateUUID(t)
@@ -217,7 +217,7 @@ func TestListParticipantsUseCase_Success_SortedByJoinDate(t *testing.T) {
 func TestListParticipantsUseCase_Success_PublicChatNonParticipant(t *testing.T) {
 	// Arrange
 	eventStore := newTestEventStore()
-	useCase := chat.NewListParticipantsUseCase(eventStore)
+	useCase := chat.NewListParticipantsUseCase(eventStore, nil)
 
 	workspaceID := generateUUID(t)
 	creatorID := generateUUID(t)
@@ -246,11 +246,94 @@ func TestListParticipantsUseCase_Success_PublicChatNonParticipant(t *testing.T)
 	assert.Len(t, result.Participants, 2) // Creator + member visible to non-participant
 }
 
+type stubParticipantNames map[uuid.UUID]string
+
+func (s stubParticipantNames) GetDisplayName(_ context.Context, userID uuid.UUID) (string, error) {
+	name, ok := s[userID]
+	if !ok {
+		return "", errors.New("user not found")
+	}
+	return name, nil
+}
+
+// TestListParticipantsUseCase_Success_PaginationFilterSearch tests paging, role filter and name search
+func TestListParticipantsUseCase_Success_PaginationFilterSearch(t *testing.T) {
+	eventStore := newTestEventStore()
+
+	workspaceID := generateUUID(t)
+	creatorID := generateUUID(t)
+	memberIDs := []uuid.UUID{generateUUID(t), generateUUID(t), generateUUID(t), generateUUID(t)}
+	names := stubParticipantNames{
+		creatorID:    "Olga Owner",
+		memberIDs[0]: "Anna Smith",
+		memberIDs[1]: "Bob Jones",
+		memberIDs[2]: "Hannah Lee",
+	}
+	useCase := chat.NewListParticipantsUseCase(eventStore, names)
+
+	testChat, err := domainChat.NewChat(workspaceID, domainChat.TypeDiscussion, true, creatorID)
+	require.NoError(t, err)
+	for _, id := range memberIDs {
+		require.NoError(t, testChat.AddParticipant(id, domainChat.RoleMember))
+	}
+	saveTestChat(t, eventStore, testChat, creatorID)
+
+	t.Run("paginates and resolves names", func(t *testing.T) {
+		first, err := useCase.Execute(testContext(), chat.ListParticipantsQuery{
+			ChatID: testChat.ID(), Limit: 2, RequestedBy: creatorID,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 5, first.Total)
+		assert.True(t, first.HasMore)
+		require.Len(t, first.Participants, 2)
+		assert.Equal(t, creatorID, first.Participants[0].UserID)
+		assert.Equal(t, "Olga Owner", first.Participants[0].DisplayName)
+
+		last, err := useCase.Execute(testContext(), chat.ListParticipantsQuery{
+			ChatID: testChat.ID(), Limit: 2, Offset: 4, RequestedBy: creatorID,
+		})
+		require.NoError(t, err)
+		assert.False(t, last.HasMore)
+		require.Len(t, last.Participants, 1)
+		assert.Empty(t, last.Participants[0].DisplayName, "unresolved users keep an empty name")
+	})
+
+	t.Run("filters by role", func(t *testing.T) {
+		role := domainChat.RoleAdmin
+		result, err := useCase.Execute(testContext(), chat.ListParticipantsQuery{
+			ChatID: testChat.ID(), Role: &role, RequestedBy: creatorID,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 1, result.Total)
+		require.Len(t, result.Participants, 1)
+		assert.Equal(t, creatorID, result.Participants[0].UserID)
+	})
+
+	t.Run("searches names case-insensitively", func(t *testing.T) {
+		result, err := useCase.Execute(testContext(), chat.ListParticipantsQuery{
+			ChatID: testChat.ID(), Search: " ANN", RequestedBy: creatorID,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 2, result.Total)
+		require.Len(t, result.Participants, 2)
+		assert.ElementsMatch(t, []string{"Anna Smith", "Hannah Lee"},
+			[]string{result.Participants[0].DisplayName, result.Participants[1].DisplayName})
+	})
+
+	t.Run("rejects unknown role", func(t *testing.T) {
+		role := domainChat.Role("owner")
+		_, err := useCase.Execute(testContext(), chat.ListParticipantsQuery{
+			ChatID: testChat.ID(), Role: &role, RequestedBy: creatorID,
+		})
+		require.ErrorIs(t, err, chat.ErrInvalidRole)
+	})
+}
+
 // TestListParticipantsUseCase_ValidationError_InvalidChatID tests validation for invalid chat ID
 func TestListParticipantsUseCase_ValidationError_InvalidChatID(t *testing.T) {
 	// Arrange
 	eventStore := newTestEventStore()
-	useCase := chat.NewListParticipantsUseCase(eventStore)
+	useCase := chat.NewListParticipantsUseCase(eventStore, nil)
 
 	requestedBy := generateUUID(t)
 
@@ -272,7 +355,7 @@ func TestListParticipantsUseCase_ValidationError_InvalidChatID(t *testing.T) {
 func TestListParticipantsUseCase_ValidationError_InvalidRequestedBy(t *testing.T) {
 	// Arrange
 	eventStore := newTestEventStore()
-	useCase := chat.NewListParticipantsUseCase(eventStore)
+	useCase := chat.NewListParticipantsUseCase(eventStore, nil)
 
 	chatID := generateUUID(t)
 
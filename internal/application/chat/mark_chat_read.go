@@ -0,0 +1,58 @@
+package chat
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/lllypuk/flowra/internal/application/appcore"
+)
+
+// MarkChatReadUseCase - use case for resetting the user's unread counter of a chat
+type MarkChatReadUseCase struct {
+	chatRepo    QueryRepository
+	readMarkers ReadMarkerRepository
+}
+
+// NewMarkChatReadUseCase - constructor
+func NewMarkChatReadUseCase(chatRepo QueryRepository, readMarkers ReadMarkerRepository) *MarkChatReadUseCase {
+	return &MarkChatReadUseCase{
+		chatRepo:    chatRepo,
+		readMarkers: readMarkers,
+	}
+}
+
+// Execute - execute the command
+func (uc *MarkChatReadUseCase) Execute(ctx context.Context, cmd MarkChatReadCommand) error {
+	// 1. Validate input
+	if err := uc.validate(cmd); err != nil {
+		return fmt.Errorf("validation failed: %w", err)
+	}
+
+	// 2. Load chat from read model
+	rm, err := uc.chatRepo.FindByID(ctx, cmd.ChatID)
+	if err != nil {
+		return ErrChatNotFound
+	}
+
+	// 3. Check access: public chats or where user is participant
+	if !rm.IsPublic && !isReadModelParticipant(rm, cmd.UserID) {
+		return ErrUserNotParticipant
+	}
+
+	// 4. Move the read marker to the current message counter
+	if markErr := uc.readMarkers.MarkRead(ctx, cmd.ChatID, cmd.UserID); markErr != nil {
+		return fmt.Errorf("failed to mark chat as read: %w", markErr)
+	}
+
+	return nil
+}
+
+func (uc *MarkChatReadUseCase) validate(cmd MarkChatReadCommand) error {
+	if err := appcore.ValidateUUID("chatID", cmd.ChatID); err != nil {
+		return err
+	}
+	if err := appcore.ValidateUUID("userID", cmd.UserID); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,64 @@
+package chat_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lllypuk/flowra/internal/application/chat"
+	domainChat "github.com/lllypuk/flowra/internal/domain/chat"
+	"github.com/lllypuk/flowra/internal/domain/uuid"
+)
+
+func TestMarkChatReadUseCase(t *testing.T) {
+	setup := func(t *testing.T, isPublic bool) (*MockChatQueryRepository, *chat.MarkChatReadUseCase, uuid.UUID, uuid.UUID) {
+		t.Helper()
+		queryRepo := NewMockChatQueryRepository()
+		memberID := generateUUID(t)
+		chatID := generateUUID(t)
+		queryRepo.SetupReadModel(&chat.ReadModel{
+			ID:           chatID,
+			WorkspaceID:  generateUUID(t),
+			Type:         domainChat.TypeDiscussion,
+			IsPublic:     isPublic,
+			MessageCount: 4,
+			Participants: []domainChat.Participant{domainChat.NewParticipant(memberID, domainChat.RoleMember)},
+		})
+		return queryRepo, chat.NewMarkChatReadUseCase(queryRepo, queryRepo), chatID, memberID
+	}
+
+	t.Run("participant resets unread counter", func(t *testing.T) {
+		queryRepo, useCase, chatID, memberID := setup(t, false)
+
+		err := useCase.Execute(testContext(), chat.MarkChatReadCommand{ChatID: chatID, UserID: memberID})
+
+		require.NoError(t, err)
+		rm, _ := queryRepo.FindByID(testContext(), chatID)
+		assert.Equal(t, 0, rm.UnreadCount(memberID))
+	})
+
+	t.Run("any user can mark a public chat as read", func(t *testing.T) {
+		_, useCase, chatID, _ := setup(t, true)
+
+		err := useCase.Execute(testContext(), chat.MarkChatReadCommand{ChatID: chatID, UserID: generateUUID(t)})
+
+		require.NoError(t, err)
+	})
+
+	t.Run("non-participant of private chat", func(t *testing.T) {
+		_, useCase, chatID, _ := setup(t, false)
+
+		err := useCase.Execute(testContext(), chat.MarkChatReadCommand{ChatID: chatID, UserID: generateUUID(t)})
+
+		require.ErrorIs(t, err, chat.ErrUserNotParticipant)
+	})
+
+	t.Run("chat not found", func(t *testing.T) {
+		_, useCase, _, memberID := setup(t, false)
+
+		err := useCase.Execute(testContext(), chat.MarkChatReadCommand{ChatID: generateUUID(t), UserID: memberID})
+
+		require.ErrorIs(t, err, chat.ErrChatNotFound)
+	})
+}
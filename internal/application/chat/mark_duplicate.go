@@ -0,0 +1,91 @@
+package chat
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/lllypuk/flowra/internal/application/appcore"
+	"github.com/lllypuk/flowra/internal/domain/chat"
+	"github.com/lllypuk/flowra/internal/domain/errs"
+)
+
+// MarkDuplicateUseCase handles marking a typed chat as a duplicate of another task
+type MarkDuplicateUseCase struct {
+	chatRepo CommandRepository
+}
+
+// NewMarkDuplicateUseCase creates a new MarkDuplicateUseCase
+func NewMarkDuplicateUseCase(chatRepo CommandRepository) *MarkDuplicateUseCase {
+	return &MarkDuplicateUseCase{chatRepo: chatRepo}
+}
+
+// Execute marks the chat as a duplicate or clears the mark
+func (uc *MarkDuplicateUseCase) Execute(ctx context.Context, cmd MarkDuplicateCommand) (Result, error) {
+	if err := uc.validate(cmd); err != nil {
+		return Result{}, fmt.Errorf("validation failed: %w", err)
+	}
+
+	chatAggregate, err := uc.chatRepo.Load(ctx, cmd.ChatID)
+	if err != nil {
+		return Result{}, fmt.Errorf("failed to load chat: %w", err)
+	}
+
+	if cmd.OriginalID != nil && *cmd.OriginalID != cmd.ChatID {
+		if originalErr := uc.validateOriginal(ctx, chatAggregate, cmd); originalErr != nil {
+			return Result{}, originalErr
+		}
+	}
+
+	if markErr := chatAggregate.MarkDuplicate(cmd.OriginalID, cmd.MarkedBy); markErr != nil {
+		return Result{}, fmt.Errorf("failed to mark duplicate: %w", markErr)
+	}
+
+	appcore.StampEventMetadata(ctx, cmd, chatAggregate.GetUncommittedEvents())
+
+	// Save via repository (updates both event store and read model)
+	if err = uc.chatRepo.Save(ctx, chatAggregate); err != nil {
+		return Result{}, fmt.Errorf("failed to save chat: %w", err)
+	}
+
+	return Result{
+		Result: appcore.Result[*chat.Chat]{
+			Value:   chatAggregate,
+			Version: chatAggregate.Version(),
+		},
+	}, nil
+}
+
+func (uc *MarkDuplicateUseCase) validate(cmd MarkDuplicateCommand) error {
+	if err := appcore.ValidateUUID("chatID", cmd.ChatID); err != nil {
+		return err
+	}
+	if cmd.OriginalID != nil {
+		if err := appcore.ValidateUUID("originalID", *cmd.OriginalID); err != nil {
+			return err
+		}
+	}
+	if err := appcore.ValidateUUID("markedBy", cmd.MarkedBy); err != nil {
+		return err
+	}
+	return nil
+}
+
+// validateOriginal requires the original to be a live typed chat of the same workspace
+func (uc *MarkDuplicateUseCase) validateOriginal(
+	ctx context.Context,
+	duplicate *chat.Chat,
+	cmd MarkDuplicateCommand,
+) error {
+	original, err := uc.chatRepo.Load(ctx, *cmd.OriginalID)
+	if err != nil {
+		if errors.Is(err, errs.ErrNotFound) {
+			return ErrDuplicateOriginalNotFound
+		}
+		return fmt.Errorf("failed to load original: %w", err)
+	}
+	if !original.IsTyped() || original.IsDeleted() || original.WorkspaceID() != duplicate.WorkspaceID() {
+		return ErrDuplicateOriginalNotFound
+	}
+	return nil
+}
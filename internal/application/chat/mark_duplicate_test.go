@@ -0,0 +1,74 @@
+package chat_test
+
+import (
+	"testing"
+
+	"github.com/lllypuk/flowra/internal/application/chat"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	domainChat "github.com/lllypuk/flowra/internal/domain/chat"
+)
+
+// TestMarkDuplicateUseCase_Success_MarkAndClear tests marking a bug as a duplicate and clearing the mark
+func TestMarkDuplicateUseCase_Success_MarkAndClear(t *testing.T) {
+	chatRepo := newTestChatRepo()
+	workspaceID := generateUUID(t)
+	creatorID := generateUUID(t)
+
+	original := createTestChatWithRepo(t, chatRepo, domainChat.TypeBug, "Original", workspaceID, creatorID)
+	duplicate := createTestChatWithRepo(t, chatRepo, domainChat.TypeBug, "Duplicate", workspaceID, creatorID)
+	originalID := original.ID()
+
+	useCase := chat.NewMarkDuplicateUseCase(chatRepo)
+	result, err := useCase.Execute(testContext(), chat.MarkDuplicateCommand{
+		ChatID:     duplicate.ID(),
+		OriginalID: &originalID,
+		MarkedBy:   creatorID,
+	})
+	executeAndAssertSuccess(t, err)
+	require.NotNil(t, result.Value.DuplicateOf())
+	assert.Equal(t, originalID, *result.Value.DuplicateOf())
+
+	result, err = useCase.Execute(testContext(), chat.MarkDuplicateCommand{
+		ChatID:   duplicate.ID(),
+		MarkedBy: creatorID,
+	})
+	executeAndAssertSuccess(t, err)
+	assert.Nil(t, result.Value.DuplicateOf())
+}
+
+// TestMarkDuplicateUseCase_Error_OriginalInOtherWorkspace tests that the original must share the workspace
+func TestMarkDuplicateUseCase_Error_OriginalInOtherWorkspace(t *testing.T) {
+	chatRepo := newTestChatRepo()
+	creatorID := generateUUID(t)
+
+	original := createTestChatWithRepo(t, chatRepo, domainChat.TypeBug, "Original", generateUUID(t), creatorID)
+	duplicate := createTestChatWithRepo(t, chatRepo, domainChat.TypeBug, "Duplicate", generateUUID(t), creatorID)
+	originalID := original.ID()
+
+	result, err := chat.NewMarkDuplicateUseCase(chatRepo).Execute(testContext(), chat.MarkDuplicateCommand{
+		ChatID:     duplicate.ID(),
+		OriginalID: &originalID,
+		MarkedBy:   creatorID,
+	})
+	require.ErrorIs(t, err, chat.ErrDuplicateOriginalNotFound)
+	assert.Nil(t, result.Value)
+}
+
+// TestMarkDuplicateUseCase_Error_Self tests that a chat cannot duplicate itself
+func TestMarkDuplicateUseCase_Error_Self(t *testing.T) {
+	chatRepo := newTestChatRepo()
+	creatorID := generateUUID(t)
+
+	createdChat := createTestChatWithRepo(t, chatRepo, domainChat.TypeBug, "Bug", generateUUID(t), creatorID)
+	selfID := createdChat.ID()
+
+	_, err := chat.NewMarkDuplicateUseCase(chatRepo).Execute(testContext(), chat.MarkDuplicateCommand{
+		ChatID:     selfID,
+		OriginalID: &selfID,
+		MarkedBy:   creatorID,
+	})
+	executeAndAssertError(t, err)
+}
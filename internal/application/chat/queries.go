@@ -22,11 +22,22 @@ type ListChatsQuery struct {
 	Limit       int
 	Offset      int
 	RequestedBy uuid.UUID
+	StarredOnly bool // only the chats and tasks the requesting user starred
 }
 
-// ListParticipantsQuery - request to retrieve a list of participants
+// GetUnreadCountQuery - request to retrieve the number of unread messages of a chat
+type GetUnreadCountQuery struct {
+	ChatID      uuid.UUID
+	RequestedBy uuid.UUID
+}
+
+// ListParticipantsQuery - request to retrieve a page of participants
 type ListParticipantsQuery struct {
 	ChatID      uuid.UUID
+	Role        *chat.Role // optional filter
+	Search      string     // optional, matches display name or username (case-insensitive)
+	Limit       int
+	Offset      int
 	RequestedBy uuid.UUID
 }
 
@@ -45,9 +56,18 @@ type ListChatsResult struct {
 	HasMore bool   `json:"has_more"`
 }
 
+// GetUnreadCountResult - result of retrieving the unread counter of a chat
+type GetUnreadCountResult struct {
+	ChatID       uuid.UUID
+	UnreadCount  int
+	MessageCount int
+}
+
 // ListParticipantsResult - result of retrieving a list of participants
 type ListParticipantsResult struct {
 	Participants []Participant `json:"participants"`
+	Total        int           `json:"total"` // matching participants before pagination
+	HasMore      bool          `json:"has_more"`
 }
 
 // ===== DTOs =====
@@ -58,6 +78,7 @@ type Chat struct {
 	WorkspaceID uuid.UUID `json:"workspace_id"`
 	Type        chat.Type `json:"type"`
 	Title       string    `json:"title"`
+	Topic       string    `json:"topic,omitempty"`
 	IsPublic    bool      `json:"is_public"`
 	CreatedBy   uuid.UUID `json:"created_by"`
 	CreatedAt   time.Time `json:"created_at"`
@@ -73,7 +94,39 @@ type Chat struct {
 	Severity *string `json:"severity,omitempty"`
 
 	// Participants
-	Participants []Participant `json:"participants"`
+	Participants     []Participant `json:"participants"`
+	ParticipantCount int           `json:"participant_count"`
+
+	// IsStarred reports whether the requesting user starred the chat
+	IsStarred bool `json:"is_starred,omitempty"`
+
+	// UnreadCount is the number of messages the requesting user has not read (list queries only)
+	UnreadCount int `json:"unread_count,omitempty"`
+
+	// LastActivityAt is the time of the latest message or chat change (list queries only)
+	LastActivityAt *time.Time `json:"last_activity_at,omitempty"`
+	// LastMessage previews the latest message (list queries only)
+	LastMessage *LastMessagePreview `json:"last_message,omitempty"`
+
+	// ConversionHistory lists type changes, oldest first (GetChat only)
+	ConversionHistory []TypeConversion `json:"conversion_history,omitempty"`
+}
+
+// LastMessagePreview - excerpt of the latest message of a chat, kept on the read model
+type LastMessagePreview struct {
+	MessageID uuid.UUID `json:"message_id"`
+	AuthorID  uuid.UUID `json:"author_id"`
+	Content   string    `json:"content"` // empty when the message is deleted
+	CreatedAt time.Time `json:"created_at"`
+	IsDeleted bool      `json:"is_deleted"`
+}
+
+// TypeConversion - a single change of the chat type
+type TypeConversion struct {
+	FromType    chat.Type `json:"from_type"`
+	ToType      chat.Type `json:"to_type"`
+	ConvertedBy uuid.UUID `json:"converted_by"`
+	ConvertedAt time.Time `json:"converted_at"`
 }
 
 // Permissions - user permissions for a chat
@@ -85,7 +138,8 @@ type Permissions struct {
 
 // Participant - a chat participant
 type Participant struct {
-	UserID   uuid.UUID `json:"user_id"`
-	Role     chat.Role `json:"role"`
-	JoinedAt time.Time `json:"joined_at"`
+	UserID      uuid.UUID `json:"user_id"`
+	Role        chat.Role `json:"role"`
+	JoinedAt    time.Time `json:"joined_at"`
+	DisplayName string    `json:"display_name,omitempty"` // filled by ListParticipants only
 }
@@ -34,6 +34,8 @@ func (uc *RemoveAttachmentUseCase) Execute(ctx context.Context, cmd RemoveAttach
 		return Result{}, fmt.Errorf("failed to remove attachment: %w", removeErr)
 	}
 
+	appcore.StampEventMetadata(ctx, cmd, chatAggregate.GetUncommittedEvents())
+
 	if err = uc.chatRepo.Save(ctx, chatAggregate); err != nil {
 		return Result{}, fmt.Errorf("failed to save chat: %w", err)
 	}
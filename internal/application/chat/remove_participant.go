@@ -37,6 +37,7 @@ func (uc *RemoveParticipantUseCase) Execute(ctx context.Context, cmd RemoveParti
 
 	// Capture events before save (Save marks them as committed)
 	newEvents := chatAggregate.GetUncommittedEvents()
+	appcore.StampEventMetadata(ctx, cmd, newEvents)
 
 	// Save via repository (updates both event store and read model)
 	if saveErr := uc.chatRepo.Save(ctx, chatAggregate); saveErr != nil {
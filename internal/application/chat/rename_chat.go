@@ -34,6 +34,8 @@ func (uc *RenameChatUseCase) Execute(ctx context.Context, cmd RenameChatCommand)
 		return Result{}, fmt.Errorf("failed to rename: %w", renameErr)
 	}
 
+	appcore.StampEventMetadata(ctx, cmd, chatAggregate.GetUncommittedEvents())
+
 	// Save via repository (updates both event store and read model)
 	if err = uc.chatRepo.Save(ctx, chatAggregate); err != nil {
 		return Result{}, fmt.Errorf("failed to save chat: %w", err)
@@ -35,6 +35,8 @@ func (uc *ReopenChatUseCase) Execute(ctx context.Context, cmd ReopenChatCommand)
 		return Result{}, fmt.Errorf("failed to reopen chat: %w", reopenErr)
 	}
 
+	appcore.StampEventMetadata(ctx, cmd, chatAggregate.GetUncommittedEvents())
+
 	return saveAggregate(ctx, uc.eventStore, chatAggregate, cmd.ChatID.String())
 }
 
@@ -0,0 +1,69 @@
+package chat
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/lllypuk/flowra/internal/application/appcore"
+	"github.com/lllypuk/flowra/internal/domain/chat"
+	"github.com/lllypuk/flowra/internal/domain/errs"
+)
+
+// ReorderChecklistItemUseCase handles moving checklist items within the checklist.
+type ReorderChecklistItemUseCase struct {
+	chatRepo CommandRepository
+}
+
+// NewReorderChecklistItemUseCase creates a new ReorderChecklistItemUseCase.
+func NewReorderChecklistItemUseCase(chatRepo CommandRepository) *ReorderChecklistItemUseCase {
+	return &ReorderChecklistItemUseCase{chatRepo: chatRepo}
+}
+
+// Execute moves a checklist item to the requested position.
+func (uc *ReorderChecklistItemUseCase) Execute(ctx context.Context, cmd ReorderChecklistItemCommand) (Result, error) {
+	if err := uc.validate(cmd); err != nil {
+		return Result{}, fmt.Errorf("validation failed: %w", err)
+	}
+
+	chatAggregate, err := uc.chatRepo.Load(ctx, cmd.ChatID)
+	if err != nil {
+		return Result{}, fmt.Errorf("failed to load chat: %w", err)
+	}
+
+	if reorderErr := chatAggregate.ReorderChecklistItem(cmd.ItemID, cmd.Position, cmd.ReorderedBy); reorderErr != nil {
+		if errors.Is(reorderErr, errs.ErrNotFound) {
+			return Result{}, ErrChecklistItemNotFound
+		}
+		return Result{}, fmt.Errorf("failed to reorder checklist item: %w", reorderErr)
+	}
+
+	appcore.StampEventMetadata(ctx, cmd, chatAggregate.GetUncommittedEvents())
+
+	if err = uc.chatRepo.Save(ctx, chatAggregate); err != nil {
+		return Result{}, fmt.Errorf("failed to save chat: %w", err)
+	}
+
+	return Result{
+		Result: appcore.Result[*chat.Chat]{
+			Value:   chatAggregate,
+			Version: chatAggregate.Version(),
+		},
+	}, nil
+}
+
+func (uc *ReorderChecklistItemUseCase) validate(cmd ReorderChecklistItemCommand) error {
+	if err := appcore.ValidateUUID("chatID", cmd.ChatID); err != nil {
+		return err
+	}
+	if err := appcore.ValidateUUID("itemID", cmd.ItemID); err != nil {
+		return err
+	}
+	if cmd.Position < 0 {
+		return appcore.NewValidationError("position", "must not be negative")
+	}
+	if err := appcore.ValidateUUID("reorderedBy", cmd.ReorderedBy); err != nil {
+		return err
+	}
+	return nil
+}
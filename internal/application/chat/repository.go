@@ -7,31 +7,48 @@ import (
 	"github.com/lllypuk/flowra/internal/domain/chat"
 	"github.com/lllypuk/flowra/internal/domain/event"
 	"github.com/lllypuk/flowra/internal/domain/uuid"
+	"github.com/lllypuk/flowra/internal/domain/workspace"
 )
 
 // ReadModel represents the read model for chat (materialized view)
 type ReadModel struct {
-	ID            uuid.UUID
-	WorkspaceID   uuid.UUID
-	Type          chat.Type
-	Title         string
-	IsPublic      bool
-	CreatedBy     uuid.UUID
-	CreatedAt     time.Time
-	LastMessageAt *time.Time
-	MessageCount  int
-	Participants  []chat.Participant
+	ID             uuid.UUID
+	WorkspaceID    uuid.UUID
+	Type           chat.Type
+	Title          string
+	IsPublic       bool
+	CreatedBy      uuid.UUID
+	CreatedAt      time.Time
+	LastMessageAt  *time.Time
+	LastActivityAt *time.Time          // latest message or chat change, used to sort chat lists
+	LastMessage    *LastMessagePreview // nil until the first message is projected
+	MessageCount   int
+	ReadCounts     map[uuid.UUID]int // number of messages each user has read
+	Participants   []chat.Participant
+}
+
+// UnreadCount returns the number of messages the user has not read yet
+func (rm *ReadModel) UnreadCount(userID uuid.UUID) int {
+	return max(rm.MessageCount-rm.ReadCounts[userID], 0)
 }
 
 // Filters represents filters for searching chats
 type Filters struct {
 	Type     *chat.Type
 	IsPublic *bool
-	UserID   *uuid.UUID // participant
+	UserID   *uuid.UUID  // participant
+	Search   string      // case-insensitive substring of the title
+	IDs      []uuid.UUID // limits the results to these chats when not nil
 	Offset   int
 	Limit    int
 }
 
+// ValuePolicyProvider returns the allowed priorities/severities of a workspace
+// Interface is declared on the consumer side (application layer)
+type ValuePolicyProvider interface {
+	GetValuePolicy(ctx context.Context, workspaceID uuid.UUID) (workspace.ValuePolicy, error)
+}
+
 // CommandRepository defines the interface for commands (state changes) of chats
 // Interface is declared on the consumer side (application layer)
 // Uses Event Sourcing pattern
@@ -53,16 +70,39 @@ type QueryRepository interface {
 	// FindByID finds a chat by ID (from read model)
 	FindByID(ctx context.Context, chatID uuid.UUID) (*ReadModel, error)
 
-	// FindByWorkspace finds workspace chats with filters
+	// FindByWorkspace finds workspace chats with filters, most recently active first
 	FindByWorkspace(ctx context.Context, workspaceID uuid.UUID, filters Filters) ([]*ReadModel, error)
 
-	// FindByParticipant finds user chats
+	// FindByParticipant finds user chats, most recently active first
 	FindByParticipant(ctx context.Context, userID uuid.UUID, offset, limit int) ([]*ReadModel, error)
 
 	// Count returns the total number of chats in a workspace
 	Count(ctx context.Context, workspaceID uuid.UUID) (int, error)
 }
 
+// ReadMarkerRepository stores per-user read markers in the chat read model
+// Interface is declared on the consumer side (application layer)
+type ReadMarkerRepository interface {
+	// MarkRead marks all messages currently in the chat as read by the user
+	MarkRead(ctx context.Context, chatID, userID uuid.UUID) error
+}
+
+// StarRepository stores the chats each user starred; a task shares its ID with its chat
+// Interface is declared on the consumer side (application layer)
+type StarRepository interface {
+	// Star stars the chat for the user; starring a starred chat is a no-op
+	Star(ctx context.Context, userID, workspaceID, chatID uuid.UUID) error
+
+	// Unstar removes the star; unstarring a chat without one is a no-op
+	Unstar(ctx context.Context, userID, chatID uuid.UUID) error
+
+	// IsStarred reports whether the user starred the chat
+	IsStarred(ctx context.Context, userID, chatID uuid.UUID) (bool, error)
+
+	// FindStarred returns the chats the user starred in the workspace, most recently starred first
+	FindStarred(ctx context.Context, userID, workspaceID uuid.UUID) ([]uuid.UUID, error)
+}
+
 // Repository combines Command and Query interfaces for convenience
 // Used when use case needs both types of operations
 type Repository interface {
@@ -33,6 +33,8 @@ func (uc *SetDueDateUseCase) Execute(ctx context.Context, cmd SetDueDateCommand)
 		return Result{}, fmt.Errorf("failed to set due date: %w", setErr)
 	}
 
+	appcore.StampEventMetadata(ctx, cmd, chatAggregate.GetUncommittedEvents())
+
 	// Save via repository (updates both event store and read model)
 	if err = uc.chatRepo.Save(ctx, chatAggregate); err != nil {
 		return Result{}, fmt.Errorf("failed to save chat: %w", err)
@@ -0,0 +1,90 @@
+package chat
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/lllypuk/flowra/internal/application/appcore"
+	"github.com/lllypuk/flowra/internal/domain/chat"
+	"github.com/lllypuk/flowra/internal/domain/errs"
+)
+
+// SetEpicUseCase handles linking a task or bug to its parent epic
+type SetEpicUseCase struct {
+	chatRepo CommandRepository
+}
+
+// NewSetEpicUseCase creates a new SetEpicUseCase
+func NewSetEpicUseCase(chatRepo CommandRepository) *SetEpicUseCase {
+	return &SetEpicUseCase{chatRepo: chatRepo}
+}
+
+// Execute links the chat to the epic or unlinks it
+func (uc *SetEpicUseCase) Execute(ctx context.Context, cmd SetEpicCommand) (Result, error) {
+	if err := uc.validate(cmd); err != nil {
+		return Result{}, fmt.Errorf("validation failed: %w", err)
+	}
+
+	chatAggregate, err := uc.chatRepo.Load(ctx, cmd.ChatID)
+	if err != nil {
+		return Result{}, fmt.Errorf("failed to load chat: %w", err)
+	}
+
+	if cmd.EpicID != nil {
+		if chatAggregate.Type() != chat.TypeTask && chatAggregate.Type() != chat.TypeBug {
+			return Result{}, ErrInvalidHierarchy
+		}
+		if epicErr := uc.validateEpic(ctx, chatAggregate, cmd); epicErr != nil {
+			return Result{}, epicErr
+		}
+	}
+
+	if setErr := chatAggregate.SetEpic(cmd.EpicID, cmd.SetBy); setErr != nil {
+		return Result{}, fmt.Errorf("failed to set epic: %w", setErr)
+	}
+
+	appcore.StampEventMetadata(ctx, cmd, chatAggregate.GetUncommittedEvents())
+
+	// Save via repository (updates both event store and read model)
+	if err = uc.chatRepo.Save(ctx, chatAggregate); err != nil {
+		return Result{}, fmt.Errorf("failed to save chat: %w", err)
+	}
+
+	return Result{
+		Result: appcore.Result[*chat.Chat]{
+			Value:   chatAggregate,
+			Version: chatAggregate.Version(),
+		},
+	}, nil
+}
+
+func (uc *SetEpicUseCase) validate(cmd SetEpicCommand) error {
+	if err := appcore.ValidateUUID("chatID", cmd.ChatID); err != nil {
+		return err
+	}
+	if cmd.EpicID != nil {
+		if err := appcore.ValidateUUID("epicID", *cmd.EpicID); err != nil {
+			return err
+		}
+	}
+	if err := appcore.ValidateUUID("setBy", cmd.SetBy); err != nil {
+		return err
+	}
+	return nil
+}
+
+// validateEpic requires the parent to be a live epic of the same workspace
+func (uc *SetEpicUseCase) validateEpic(ctx context.Context, child *chat.Chat, cmd SetEpicCommand) error {
+	epic, err := uc.chatRepo.Load(ctx, *cmd.EpicID)
+	if err != nil {
+		if errors.Is(err, errs.ErrNotFound) {
+			return ErrEpicNotFound
+		}
+		return fmt.Errorf("failed to load epic: %w", err)
+	}
+	if epic.Type() != chat.TypeEpic || epic.IsDeleted() || epic.WorkspaceID() != child.WorkspaceID() {
+		return ErrEpicNotFound
+	}
+	return nil
+}
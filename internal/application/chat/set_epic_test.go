@@ -0,0 +1,93 @@
+package chat_test
+
+import (
+	"testing"
+
+	"github.com/lllypuk/flowra/internal/application/chat"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	domainChat "github.com/lllypuk/flowra/internal/domain/chat"
+)
+
+// TestSetEpicUseCase_Success_LinkAndUnlink tests linking a task to an epic and unlinking it
+func TestSetEpicUseCase_Success_LinkAndUnlink(t *testing.T) {
+	chatRepo := newTestChatRepo()
+	workspaceID := generateUUID(t)
+	creatorID := generateUUID(t)
+
+	epic := createTestChatWithRepo(t, chatRepo, domainChat.TypeEpic, "Epic", workspaceID, creatorID)
+	child := createTestChatWithRepo(t, chatRepo, domainChat.TypeTask, "Task", workspaceID, creatorID)
+	epicID := epic.ID()
+
+	useCase := chat.NewSetEpicUseCase(chatRepo)
+	result, err := useCase.Execute(testContext(), chat.SetEpicCommand{
+		ChatID: child.ID(),
+		EpicID: &epicID,
+		SetBy:  creatorID,
+	})
+	executeAndAssertSuccess(t, err)
+	require.NotNil(t, result.Value.EpicID())
+	assert.Equal(t, epicID, *result.Value.EpicID())
+
+	result, err = useCase.Execute(testContext(), chat.SetEpicCommand{
+		ChatID: child.ID(),
+		SetBy:  creatorID,
+	})
+	executeAndAssertSuccess(t, err)
+	assert.Nil(t, result.Value.EpicID())
+}
+
+// TestSetEpicUseCase_Error_ParentNotEpic tests that the parent must be an epic
+func TestSetEpicUseCase_Error_ParentNotEpic(t *testing.T) {
+	chatRepo := newTestChatRepo()
+	workspaceID := generateUUID(t)
+	creatorID := generateUUID(t)
+
+	parent := createTestChatWithRepo(t, chatRepo, domainChat.TypeTask, "Not an epic", workspaceID, creatorID)
+	child := createTestChatWithRepo(t, chatRepo, domainChat.TypeBug, "Bug", workspaceID, creatorID)
+	parentID := parent.ID()
+
+	_, err := chat.NewSetEpicUseCase(chatRepo).Execute(testContext(), chat.SetEpicCommand{
+		ChatID: child.ID(),
+		EpicID: &parentID,
+		SetBy:  creatorID,
+	})
+	require.ErrorIs(t, err, chat.ErrEpicNotFound)
+}
+
+// TestSetEpicUseCase_Error_EpicInOtherWorkspace tests that the epic must share the workspace
+func TestSetEpicUseCase_Error_EpicInOtherWorkspace(t *testing.T) {
+	chatRepo := newTestChatRepo()
+	creatorID := generateUUID(t)
+
+	epic := createTestChatWithRepo(t, chatRepo, domainChat.TypeEpic, "Epic", generateUUID(t), creatorID)
+	child := createTestChatWithRepo(t, chatRepo, domainChat.TypeTask, "Task", generateUUID(t), creatorID)
+	epicID := epic.ID()
+
+	_, err := chat.NewSetEpicUseCase(chatRepo).Execute(testContext(), chat.SetEpicCommand{
+		ChatID: child.ID(),
+		EpicID: &epicID,
+		SetBy:  creatorID,
+	})
+	require.ErrorIs(t, err, chat.ErrEpicNotFound)
+}
+
+// TestSetEpicUseCase_Error_EpicAsChild tests that an epic cannot be a child of another epic
+func TestSetEpicUseCase_Error_EpicAsChild(t *testing.T) {
+	chatRepo := newTestChatRepo()
+	workspaceID := generateUUID(t)
+	creatorID := generateUUID(t)
+
+	parent := createTestChatWithRepo(t, chatRepo, domainChat.TypeEpic, "Parent", workspaceID, creatorID)
+	child := createTestChatWithRepo(t, chatRepo, domainChat.TypeEpic, "Child", workspaceID, creatorID)
+	parentID := parent.ID()
+
+	_, err := chat.NewSetEpicUseCase(chatRepo).Execute(testContext(), chat.SetEpicCommand{
+		ChatID: child.ID(),
+		EpicID: &parentID,
+		SetBy:  creatorID,
+	})
+	require.ErrorIs(t, err, chat.ErrInvalidHierarchy)
+}
@@ -0,0 +1,60 @@
+//nolint:dupl // Use case pattern requires similar structure
+package chat
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/lllypuk/flowra/internal/application/appcore"
+	"github.com/lllypuk/flowra/internal/domain/chat"
+)
+
+// SetEstimateUseCase handles setting an estimate
+type SetEstimateUseCase struct {
+	chatRepo CommandRepository
+}
+
+// NewSetEstimateUseCase creates a new SetEstimateUseCase
+func NewSetEstimateUseCase(chatRepo CommandRepository) *SetEstimateUseCase {
+	return &SetEstimateUseCase{chatRepo: chatRepo}
+}
+
+// Execute performs setting or removing the estimate
+func (uc *SetEstimateUseCase) Execute(ctx context.Context, cmd SetEstimateCommand) (Result, error) {
+	if err := uc.validate(cmd); err != nil {
+		return Result{}, fmt.Errorf("validation failed: %w", err)
+	}
+
+	chatAggregate, err := uc.chatRepo.Load(ctx, cmd.ChatID)
+	if err != nil {
+		return Result{}, fmt.Errorf("failed to load chat: %w", err)
+	}
+
+	if setErr := chatAggregate.SetEstimate(cmd.Estimate, cmd.SetBy); setErr != nil {
+		return Result{}, fmt.Errorf("failed to set estimate: %w", setErr)
+	}
+
+	appcore.StampEventMetadata(ctx, cmd, chatAggregate.GetUncommittedEvents())
+
+	// Save via repository (updates both event store and read model)
+	if err = uc.chatRepo.Save(ctx, chatAggregate); err != nil {
+		return Result{}, fmt.Errorf("failed to save chat: %w", err)
+	}
+
+	return Result{
+		Result: appcore.Result[*chat.Chat]{
+			Value:   chatAggregate,
+			Version: chatAggregate.Version(),
+		},
+	}, nil
+}
+
+func (uc *SetEstimateUseCase) validate(cmd SetEstimateCommand) error {
+	if err := appcore.ValidateUUID("chatID", cmd.ChatID); err != nil {
+		return err
+	}
+	if err := appcore.ValidateUUID("setBy", cmd.SetBy); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,83 @@
+package chat_test
+
+import (
+	"testing"
+
+	"github.com/lllypuk/flowra/internal/application/chat"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	domainChat "github.com/lllypuk/flowra/internal/domain/chat"
+)
+
+// TestSetEstimateUseCase_Success_SetAndRemove tests setting and removing an estimate
+func TestSetEstimateUseCase_Success_SetAndRemove(t *testing.T) {
+	chatRepo := newTestChatRepo()
+	creatorID := generateUUID(t)
+
+	createdChat := createTestChatWithRepo(
+		t,
+		chatRepo,
+		domainChat.TypeTask,
+		"Test Task",
+		generateUUID(t),
+		creatorID,
+	)
+
+	estimate, err := domainChat.NewEstimate(5, domainChat.EstimateUnitPoints)
+	require.NoError(t, err)
+
+	useCase := chat.NewSetEstimateUseCase(chatRepo)
+	result, err := useCase.Execute(testContext(), chat.SetEstimateCommand{
+		ChatID:   createdChat.ID(),
+		Estimate: &estimate,
+		SetBy:    creatorID,
+	})
+	executeAndAssertSuccess(t, err)
+	require.NotNil(t, result.Value.Estimate())
+	assert.Equal(t, estimate, *result.Value.Estimate())
+
+	result, err = useCase.Execute(testContext(), chat.SetEstimateCommand{
+		ChatID: createdChat.ID(),
+		SetBy:  creatorID,
+	})
+	executeAndAssertSuccess(t, err)
+	assert.Nil(t, result.Value.Estimate())
+}
+
+// TestSetEstimateUseCase_Error_Discussion tests that discussions cannot be estimated
+func TestSetEstimateUseCase_Error_Discussion(t *testing.T) {
+	chatRepo := newTestChatRepo()
+	creatorID := generateUUID(t)
+
+	createdChat := createTestChatWithRepo(
+		t,
+		chatRepo,
+		domainChat.TypeDiscussion,
+		"Discussion",
+		generateUUID(t),
+		creatorID,
+	)
+
+	estimate, err := domainChat.NewEstimate(3, domainChat.EstimateUnitHours)
+	require.NoError(t, err)
+
+	result, err := chat.NewSetEstimateUseCase(chatRepo).Execute(testContext(), chat.SetEstimateCommand{
+		ChatID:   createdChat.ID(),
+		Estimate: &estimate,
+		SetBy:    creatorID,
+	})
+	executeAndAssertError(t, err)
+	assert.Nil(t, result.Value)
+}
+
+// TestSetEstimateUseCase_ValidationError_InvalidChatID tests validation error
+func TestSetEstimateUseCase_ValidationError_InvalidChatID(t *testing.T) {
+	result, err := chat.NewSetEstimateUseCase(newTestChatRepo()).Execute(testContext(), chat.SetEstimateCommand{
+		ChatID: "",
+		SetBy:  generateUUID(t),
+	})
+	executeAndAssertError(t, err)
+	assert.Nil(t, result.Value)
+}
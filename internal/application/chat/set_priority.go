@@ -12,12 +12,14 @@ import (
 // SetPriorityUseCase handles setting priority
 type SetPriorityUseCase struct {
 	chatRepo CommandRepository
+	policies ValuePolicyProvider // optional; without it every priority is allowed
 }
 
 // NewSetPriorityUseCase creates a new SetPriorityUseCase
-func NewSetPriorityUseCase(chatRepo CommandRepository) *SetPriorityUseCase {
+func NewSetPriorityUseCase(chatRepo CommandRepository, policies ValuePolicyProvider) *SetPriorityUseCase {
 	return &SetPriorityUseCase{
 		chatRepo: chatRepo,
+		policies: policies,
 	}
 }
 
@@ -32,10 +34,22 @@ func (uc *SetPriorityUseCase) Execute(ctx context.Context, cmd SetPriorityComman
 		return Result{}, fmt.Errorf("failed to load chat: %w", err)
 	}
 
+	if uc.policies != nil && chatAggregate.IsTyped() {
+		policy, policyErr := uc.policies.GetValuePolicy(ctx, chatAggregate.WorkspaceID())
+		if policyErr != nil {
+			return Result{}, fmt.Errorf("failed to load workspace value policy: %w", policyErr)
+		}
+		if !policy.IsPriorityAllowed(chatAggregate.Type(), cmd.Priority) {
+			return Result{}, ErrPriorityNotAllowed
+		}
+	}
+
 	if setErr := chatAggregate.SetPriority(cmd.Priority, cmd.SetBy); setErr != nil {
 		return Result{}, fmt.Errorf("failed to set priority: %w", setErr)
 	}
 
+	appcore.StampEventMetadata(ctx, cmd, chatAggregate.GetUncommittedEvents())
+
 	// Save via repository (updates both event store and read model)
 	if err = uc.chatRepo.Save(ctx, chatAggregate); err != nil {
 		return Result{}, fmt.Errorf("failed to save chat: %w", err)
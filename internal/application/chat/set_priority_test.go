@@ -1,6 +1,7 @@
 package chat_test
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -43,7 +44,7 @@ func testSetPrioritySuccess(t *testing.T, priority string) {
 		creatorID,
 	)
 
-	setPriorityUseCase := chat.NewSetPriorityUseCase(chatRepo)
+	setPriorityUseCase := chat.NewSetPriorityUseCase(chatRepo, nil)
 	setPriorityCmd := chat.SetPriorityCommand{
 		ChatID:   createdChat.ID(),
 		Priority: priority,
@@ -58,7 +59,7 @@ func testSetPrioritySuccess(t *testing.T, priority string) {
 // TestSetPriorityUseCase_ValidationError_InvalidPriority tests validation error
 func TestSetPriorityUseCase_ValidationError_InvalidPriority(t *testing.T) {
 	chatRepo := newTestChatRepo()
-	setPriorityUseCase := chat.NewSetPriorityUseCase(chatRepo)
+	setPriorityUseCase := chat.NewSetPriorityUseCase(chatRepo, nil)
 
 	setPriorityCmd := chat.SetPriorityCommand{
 		ChatID:   generateUUID(t),
@@ -74,7 +75,7 @@ func TestSetPriorityUseCase_ValidationError_InvalidPriority(t *testing.T) {
 // TestSetPriorityUseCase_ValidationError_InvalidChatID tests validation error
 func TestSetPriorityUseCase_ValidationError_InvalidChatID(t *testing.T) {
 	chatRepo := newTestChatRepo()
-	setPriorityUseCase := chat.NewSetPriorityUseCase(chatRepo)
+	setPriorityUseCase := chat.NewSetPriorityUseCase(chatRepo, nil)
 
 	setPriorityCmd := chat.SetPriorityCommand{
 		ChatID:   "",
@@ -86,3 +87,62 @@ func TestSetPriorityUseCase_ValidationError_InvalidChatID(t *testing.T) {
 	executeAndAssertError(t, err)
 	assert.Nil(t, result.Value)
 }
+
+// TestSetPriorityUseCase_WorkspacePolicy tests the workspace allowed priorities
+func TestSetPriorityUseCase_WorkspacePolicy(t *testing.T) {
+	chatRepo := newTestChatRepo()
+	creatorID := generateUUID(t)
+
+	createdChat := createTestChatWithRepo(
+		t,
+		chatRepo,
+		domainChat.TypeTask,
+		"Test Task",
+		generateUUID(t),
+		creatorID,
+	)
+
+	policies := stubValuePolicyProvider{policy: newTestValuePolicy(t,
+		map[domainChat.Type][]string{domainChat.TypeTask: {"High", "Critical"}}, nil)}
+	setPriorityUseCase := chat.NewSetPriorityUseCase(chatRepo, policies)
+
+	_, err := setPriorityUseCase.Execute(testContext(), chat.SetPriorityCommand{
+		ChatID:   createdChat.ID(),
+		Priority: "Low",
+		SetBy:    creatorID,
+	})
+	assert.ErrorIs(t, err, chat.ErrPriorityNotAllowed)
+
+	result, err := setPriorityUseCase.Execute(testContext(), chat.SetPriorityCommand{
+		ChatID:   createdChat.ID(),
+		Priority: "High",
+		SetBy:    creatorID,
+	})
+	executeAndAssertSuccess(t, err)
+	assert.Equal(t, "High", result.Value.Priority())
+}
+
+// TestSetPriorityUseCase_WorkspacePolicyError tests a failing policy lookup
+func TestSetPriorityUseCase_WorkspacePolicyError(t *testing.T) {
+	chatRepo := newTestChatRepo()
+	creatorID := generateUUID(t)
+
+	createdChat := createTestChatWithRepo(
+		t,
+		chatRepo,
+		domainChat.TypeTask,
+		"Test Task",
+		generateUUID(t),
+		creatorID,
+	)
+
+	lookupErr := errors.New("workspace unavailable")
+	setPriorityUseCase := chat.NewSetPriorityUseCase(chatRepo, stubValuePolicyProvider{err: lookupErr})
+
+	_, err := setPriorityUseCase.Execute(testContext(), chat.SetPriorityCommand{
+		ChatID:   createdChat.ID(),
+		Priority: "High",
+		SetBy:    creatorID,
+	})
+	assert.ErrorIs(t, err, lookupErr)
+}
@@ -12,11 +12,12 @@ import (
 // SetSeverityUseCase handles setting severity (only for Bug)
 type SetSeverityUseCase struct {
 	chatRepo CommandRepository
+	policies ValuePolicyProvider // optional; without it every severity is allowed
 }
 
 // NewSetSeverityUseCase creates a new SetSeverityUseCase
-func NewSetSeverityUseCase(chatRepo CommandRepository) *SetSeverityUseCase {
-	return &SetSeverityUseCase{chatRepo: chatRepo}
+func NewSetSeverityUseCase(chatRepo CommandRepository, policies ValuePolicyProvider) *SetSeverityUseCase {
+	return &SetSeverityUseCase{chatRepo: chatRepo, policies: policies}
 }
 
 // Execute performs setting severity
@@ -30,10 +31,22 @@ func (uc *SetSeverityUseCase) Execute(ctx context.Context, cmd SetSeverityComman
 		return Result{}, fmt.Errorf("failed to load chat: %w", err)
 	}
 
+	if uc.policies != nil && chatAggregate.Type() == chat.TypeBug {
+		policy, policyErr := uc.policies.GetValuePolicy(ctx, chatAggregate.WorkspaceID())
+		if policyErr != nil {
+			return Result{}, fmt.Errorf("failed to load workspace value policy: %w", policyErr)
+		}
+		if !policy.IsSeverityAllowed(chatAggregate.Type(), cmd.Severity) {
+			return Result{}, ErrSeverityNotAllowed
+		}
+	}
+
 	if setErr := chatAggregate.SetSeverity(cmd.Severity, cmd.SetBy); setErr != nil {
 		return Result{}, fmt.Errorf("failed to set severity: %w", setErr)
 	}
 
+	appcore.StampEventMetadata(ctx, cmd, chatAggregate.GetUncommittedEvents())
+
 	// Save via repository (updates both event store and read model)
 	if err = uc.chatRepo.Save(ctx, chatAggregate); err != nil {
 		return Result{}, fmt.Errorf("failed to save chat: %w", err)
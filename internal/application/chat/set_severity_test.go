@@ -36,7 +36,7 @@ func testSetSeveritySuccess(t *testing.T, severity string) {
 
 	createdChat := createTestChatWithRepo(t, chatRepo, domainChat.TypeBug, "Test Bug", workspaceID, creatorID)
 
-	setSeverityUseCase := chat.NewSetSeverityUseCase(chatRepo)
+	setSeverityUseCase := chat.NewSetSeverityUseCase(chatRepo, nil)
 	setSeverityCmd := chat.SetSeverityCommand{
 		ChatID:   createdChat.ID(),
 		Severity: severity,
@@ -63,7 +63,7 @@ func TestSetSeverityUseCase_Error_OnlyForBugs(t *testing.T) {
 		creatorID,
 	)
 
-	setSeverityUseCase := chat.NewSetSeverityUseCase(chatRepo)
+	setSeverityUseCase := chat.NewSetSeverityUseCase(chatRepo, nil)
 	setSeverityCmd := chat.SetSeverityCommand{
 		ChatID:   createdChat.ID(),
 		Severity: "Critical",
@@ -78,7 +78,7 @@ func TestSetSeverityUseCase_Error_OnlyForBugs(t *testing.T) {
 // TestSetSeverityUseCase_ValidationError_InvalidSeverity tests validation error
 func TestSetSeverityUseCase_ValidationError_InvalidSeverity(t *testing.T) {
 	chatRepo := newTestChatRepo()
-	setSeverityUseCase := chat.NewSetSeverityUseCase(chatRepo)
+	setSeverityUseCase := chat.NewSetSeverityUseCase(chatRepo, nil)
 
 	setSeverityCmd := chat.SetSeverityCommand{
 		ChatID:   generateUUID(t),
@@ -90,3 +90,37 @@ func TestSetSeverityUseCase_ValidationError_InvalidSeverity(t *testing.T) {
 	executeAndAssertError(t, err)
 	assert.Nil(t, result.Value)
 }
+
+// TestSetSeverityUseCase_WorkspacePolicy tests the workspace allowed severities
+func TestSetSeverityUseCase_WorkspacePolicy(t *testing.T) {
+	chatRepo := newTestChatRepo()
+	creatorID := generateUUID(t)
+
+	createdChat := createTestChatWithRepo(
+		t,
+		chatRepo,
+		domainChat.TypeBug,
+		"Test Bug",
+		generateUUID(t),
+		creatorID,
+	)
+
+	policies := stubValuePolicyProvider{policy: newTestValuePolicy(t,
+		nil, map[domainChat.Type][]string{domainChat.TypeBug: {"Blocker"}})}
+	setSeverityUseCase := chat.NewSetSeverityUseCase(chatRepo, policies)
+
+	_, err := setSeverityUseCase.Execute(testContext(), chat.SetSeverityCommand{
+		ChatID:   createdChat.ID(),
+		Severity: "Minor",
+		SetBy:    creatorID,
+	})
+	assert.ErrorIs(t, err, chat.ErrSeverityNotAllowed)
+
+	result, err := setSeverityUseCase.Execute(testContext(), chat.SetSeverityCommand{
+		ChatID:   createdChat.ID(),
+		Severity: "Blocker",
+		SetBy:    creatorID,
+	})
+	executeAndAssertSuccess(t, err)
+	assert.Equal(t, "Blocker", result.Value.Severity())
+}
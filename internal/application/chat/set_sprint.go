@@ -0,0 +1,60 @@
+//nolint:dupl // Use case pattern requires similar structure
+package chat
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/lllypuk/flowra/internal/application/appcore"
+	"github.com/lllypuk/flowra/internal/domain/chat"
+)
+
+// SetSprintUseCase handles assigning a typed chat to a sprint
+type SetSprintUseCase struct {
+	chatRepo CommandRepository
+}
+
+// NewSetSprintUseCase creates a new SetSprintUseCase
+func NewSetSprintUseCase(chatRepo CommandRepository) *SetSprintUseCase {
+	return &SetSprintUseCase{chatRepo: chatRepo}
+}
+
+// Execute performs assigning to or removing from a sprint
+func (uc *SetSprintUseCase) Execute(ctx context.Context, cmd SetSprintCommand) (Result, error) {
+	if err := uc.validate(cmd); err != nil {
+		return Result{}, fmt.Errorf("validation failed: %w", err)
+	}
+
+	chatAggregate, err := uc.chatRepo.Load(ctx, cmd.ChatID)
+	if err != nil {
+		return Result{}, fmt.Errorf("failed to load chat: %w", err)
+	}
+
+	if setErr := chatAggregate.SetSprint(cmd.Sprint, cmd.SetBy); setErr != nil {
+		return Result{}, fmt.Errorf("failed to set sprint: %w", setErr)
+	}
+
+	appcore.StampEventMetadata(ctx, cmd, chatAggregate.GetUncommittedEvents())
+
+	// Save via repository (updates both event store and read model)
+	if err = uc.chatRepo.Save(ctx, chatAggregate); err != nil {
+		return Result{}, fmt.Errorf("failed to save chat: %w", err)
+	}
+
+	return Result{
+		Result: appcore.Result[*chat.Chat]{
+			Value:   chatAggregate,
+			Version: chatAggregate.Version(),
+		},
+	}, nil
+}
+
+func (uc *SetSprintUseCase) validate(cmd SetSprintCommand) error {
+	if err := appcore.ValidateUUID("chatID", cmd.ChatID); err != nil {
+		return err
+	}
+	if err := appcore.ValidateUUID("setBy", cmd.SetBy); err != nil {
+		return err
+	}
+	return nil
+}
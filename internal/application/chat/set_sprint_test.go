@@ -0,0 +1,67 @@
+package chat_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/lllypuk/flowra/internal/application/chat"
+
+	"github.com/stretchr/testify/assert"
+
+	domainChat "github.com/lllypuk/flowra/internal/domain/chat"
+)
+
+// TestSetSprintUseCase_Success_SetAndRemove tests moving a task into a sprint and out of it
+func TestSetSprintUseCase_Success_SetAndRemove(t *testing.T) {
+	chatRepo := newTestChatRepo()
+	creatorID := generateUUID(t)
+
+	createdChat := createTestChatWithRepo(
+		t,
+		chatRepo,
+		domainChat.TypeBug,
+		"Test Bug",
+		generateUUID(t),
+		creatorID,
+	)
+
+	useCase := chat.NewSetSprintUseCase(chatRepo)
+	result, err := useCase.Execute(testContext(), chat.SetSprintCommand{
+		ChatID: createdChat.ID(),
+		Sprint: "  Sprint 12 ",
+		SetBy:  creatorID,
+	})
+	executeAndAssertSuccess(t, err)
+	assert.Equal(t, "Sprint 12", result.Value.Sprint())
+
+	result, err = useCase.Execute(testContext(), chat.SetSprintCommand{
+		ChatID: createdChat.ID(),
+		Sprint: "",
+		SetBy:  creatorID,
+	})
+	executeAndAssertSuccess(t, err)
+	assert.Empty(t, result.Value.Sprint())
+}
+
+// TestSetSprintUseCase_Error_NameTooLong tests that overly long sprint names are rejected
+func TestSetSprintUseCase_Error_NameTooLong(t *testing.T) {
+	chatRepo := newTestChatRepo()
+	creatorID := generateUUID(t)
+
+	createdChat := createTestChatWithRepo(
+		t,
+		chatRepo,
+		domainChat.TypeTask,
+		"Test Task",
+		generateUUID(t),
+		creatorID,
+	)
+
+	result, err := chat.NewSetSprintUseCase(chatRepo).Execute(testContext(), chat.SetSprintCommand{
+		ChatID: createdChat.ID(),
+		Sprint: strings.Repeat("s", domainChat.MaxSprintLength+1),
+		SetBy:  creatorID,
+	})
+	executeAndAssertError(t, err)
+	assert.Nil(t, result.Value)
+}
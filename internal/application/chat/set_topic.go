@@ -0,0 +1,60 @@
+//nolint:dupl // Use case pattern requires similar structure
+package chat
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/lllypuk/flowra/internal/application/appcore"
+	"github.com/lllypuk/flowra/internal/domain/chat"
+)
+
+// SetTopicUseCase handles changing the chat topic
+type SetTopicUseCase struct {
+	chatRepo CommandRepository
+}
+
+// NewSetTopicUseCase creates a new SetTopicUseCase
+func NewSetTopicUseCase(chatRepo CommandRepository) *SetTopicUseCase {
+	return &SetTopicUseCase{chatRepo: chatRepo}
+}
+
+// Execute performs setting or clearing the topic
+func (uc *SetTopicUseCase) Execute(ctx context.Context, cmd SetTopicCommand) (Result, error) {
+	if err := uc.validate(cmd); err != nil {
+		return Result{}, fmt.Errorf("validation failed: %w", err)
+	}
+
+	chatAggregate, err := uc.chatRepo.Load(ctx, cmd.ChatID)
+	if err != nil {
+		return Result{}, fmt.Errorf("failed to load chat: %w", err)
+	}
+
+	if setErr := chatAggregate.SetTopic(cmd.Topic, cmd.SetBy); setErr != nil {
+		return Result{}, fmt.Errorf("failed to set topic: %w", setErr)
+	}
+
+	appcore.StampEventMetadata(ctx, cmd, chatAggregate.GetUncommittedEvents())
+
+	// Save via repository (updates both event store and read model)
+	if err = uc.chatRepo.Save(ctx, chatAggregate); err != nil {
+		return Result{}, fmt.Errorf("failed to save chat: %w", err)
+	}
+
+	return Result{
+		Result: appcore.Result[*chat.Chat]{
+			Value:   chatAggregate,
+			Version: chatAggregate.Version(),
+		},
+	}, nil
+}
+
+func (uc *SetTopicUseCase) validate(cmd SetTopicCommand) error {
+	if err := appcore.ValidateUUID("chatID", cmd.ChatID); err != nil {
+		return err
+	}
+	if err := appcore.ValidateUUID("setBy", cmd.SetBy); err != nil {
+		return err
+	}
+	return nil
+}
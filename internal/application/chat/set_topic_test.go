@@ -0,0 +1,67 @@
+package chat_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/lllypuk/flowra/internal/application/chat"
+
+	"github.com/stretchr/testify/assert"
+
+	domainChat "github.com/lllypuk/flowra/internal/domain/chat"
+)
+
+// TestSetTopicUseCase_Success_SetAndClear tests setting a discussion topic and clearing it
+func TestSetTopicUseCase_Success_SetAndClear(t *testing.T) {
+	chatRepo := newTestChatRepo()
+	creatorID := generateUUID(t)
+
+	createdChat := createTestChatWithRepo(
+		t,
+		chatRepo,
+		domainChat.TypeDiscussion,
+		"",
+		generateUUID(t),
+		creatorID,
+	)
+
+	useCase := chat.NewSetTopicUseCase(chatRepo)
+	result, err := useCase.Execute(testContext(), chat.SetTopicCommand{
+		ChatID: createdChat.ID(),
+		Topic:  " Q3 launch coordination ",
+		SetBy:  creatorID,
+	})
+	executeAndAssertSuccess(t, err)
+	assert.Equal(t, "Q3 launch coordination", result.Value.Topic())
+
+	result, err = useCase.Execute(testContext(), chat.SetTopicCommand{
+		ChatID: createdChat.ID(),
+		Topic:  "",
+		SetBy:  creatorID,
+	})
+	executeAndAssertSuccess(t, err)
+	assert.Empty(t, result.Value.Topic())
+}
+
+// TestSetTopicUseCase_Error_TooLong tests that overly long topics are rejected
+func TestSetTopicUseCase_Error_TooLong(t *testing.T) {
+	chatRepo := newTestChatRepo()
+	creatorID := generateUUID(t)
+
+	createdChat := createTestChatWithRepo(
+		t,
+		chatRepo,
+		domainChat.TypeTask,
+		"Test Task",
+		generateUUID(t),
+		creatorID,
+	)
+
+	result, err := chat.NewSetTopicUseCase(chatRepo).Execute(testContext(), chat.SetTopicCommand{
+		ChatID: createdChat.ID(),
+		Topic:  strings.Repeat("t", domainChat.MaxTopicLength+1),
+		SetBy:  creatorID,
+	})
+	executeAndAssertError(t, err)
+	assert.Nil(t, result.Value)
+}
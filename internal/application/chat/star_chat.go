@@ -0,0 +1,94 @@
+package chat
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/lllypuk/flowra/internal/application/appcore"
+)
+
+// StarChatUseCase - use case for starring a chat or task of the user
+type StarChatUseCase struct {
+	chatRepo QueryRepository
+	stars    StarRepository
+}
+
+// NewStarChatUseCase - constructor
+func NewStarChatUseCase(chatRepo QueryRepository, stars StarRepository) *StarChatUseCase {
+	return &StarChatUseCase{
+		chatRepo: chatRepo,
+		stars:    stars,
+	}
+}
+
+// Execute - execute the command
+func (uc *StarChatUseCase) Execute(ctx context.Context, cmd StarChatCommand) error {
+	// 1. Validate input
+	if err := uc.validate(cmd); err != nil {
+		return fmt.Errorf("validation failed: %w", err)
+	}
+
+	// 2. Load chat from read model
+	rm, err := uc.chatRepo.FindByID(ctx, cmd.ChatID)
+	if err != nil {
+		return ErrChatNotFound
+	}
+
+	// 3. Check access: public chats or where user is participant
+	if !rm.IsPublic && !isReadModelParticipant(rm, cmd.UserID) {
+		return ErrUserNotParticipant
+	}
+
+	// 4. Star the chat in its workspace, so lists find it without loading the chat
+	if starErr := uc.stars.Star(ctx, cmd.UserID, rm.WorkspaceID, cmd.ChatID); starErr != nil {
+		return fmt.Errorf("failed to star chat: %w", starErr)
+	}
+
+	return nil
+}
+
+func (uc *StarChatUseCase) validate(cmd StarChatCommand) error {
+	if err := appcore.ValidateUUID("chatID", cmd.ChatID); err != nil {
+		return err
+	}
+	if err := appcore.ValidateUUID("userID", cmd.UserID); err != nil {
+		return err
+	}
+	return nil
+}
+
+// UnstarChatUseCase - use case for removing the star of a chat or task.
+// Access is not checked, so users can unstar chats they have since left.
+type UnstarChatUseCase struct {
+	stars StarRepository
+}
+
+// NewUnstarChatUseCase - constructor
+func NewUnstarChatUseCase(stars StarRepository) *UnstarChatUseCase {
+	return &UnstarChatUseCase{
+		stars: stars,
+	}
+}
+
+// Execute - execute the command
+func (uc *UnstarChatUseCase) Execute(ctx context.Context, cmd UnstarChatCommand) error {
+	if err := uc.validate(cmd); err != nil {
+		return fmt.Errorf("validation failed: %w", err)
+	}
+
+	if err := uc.stars.Unstar(ctx, cmd.UserID, cmd.ChatID); err != nil {
+		return fmt.Errorf("failed to unstar chat: %w", err)
+	}
+
+	return nil
+}
+
+func (uc *UnstarChatUseCase) validate(cmd UnstarChatCommand) error {
+	if err := appcore.ValidateUUID("chatID", cmd.ChatID); err != nil {
+		return err
+	}
+	if err := appcore.ValidateUUID("userID", cmd.UserID); err != nil {
+		return err
+	}
+	return nil
+}
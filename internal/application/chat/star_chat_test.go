@@ -0,0 +1,177 @@
+package chat_test
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lllypuk/flowra/internal/application/chat"
+	domainChat "github.com/lllypuk/flowra/internal/domain/chat"
+	"github.com/lllypuk/flowra/internal/domain/uuid"
+)
+
+type starKey struct {
+	userID uuid.UUID
+	chatID uuid.UUID
+}
+
+// MockStarRepository keeps stars in starring order
+type MockStarRepository struct {
+	stars      []starKey
+	workspaces map[uuid.UUID]uuid.UUID
+}
+
+func NewMockStarRepository() *MockStarRepository {
+	return &MockStarRepository{workspaces: make(map[uuid.UUID]uuid.UUID)}
+}
+
+func (m *MockStarRepository) Star(_ context.Context, userID, workspaceID, chatID uuid.UUID) error {
+	if !slices.Contains(m.stars, starKey{userID, chatID}) {
+		m.stars = append(m.stars, starKey{userID, chatID})
+	}
+	m.workspaces[chatID] = workspaceID
+	return nil
+}
+
+func (m *MockStarRepository) Unstar(_ context.Context, userID, chatID uuid.UUID) error {
+	m.stars = slices.DeleteFunc(m.stars, func(k starKey) bool { return k == starKey{userID, chatID} })
+	return nil
+}
+
+func (m *MockStarRepository) IsStarred(_ context.Context, userID, chatID uuid.UUID) (bool, error) {
+	return slices.Contains(m.stars, starKey{userID, chatID}), nil
+}
+
+func (m *MockStarRepository) FindStarred(_ context.Context, userID, workspaceID uuid.UUID) ([]uuid.UUID, error) {
+	var ids []uuid.UUID
+	for i := len(m.stars) - 1; i >= 0; i-- {
+		if k := m.stars[i]; k.userID == userID && m.workspaces[k.chatID] == workspaceID {
+			ids = append(ids, k.chatID)
+		}
+	}
+	return ids, nil
+}
+
+func TestStarChatUseCase(t *testing.T) {
+	setup := func(t *testing.T, isPublic bool) (*MockStarRepository, *chat.StarChatUseCase, *chat.ReadModel, uuid.UUID) {
+		t.Helper()
+		queryRepo := NewMockChatQueryRepository()
+		stars := NewMockStarRepository()
+		memberID := generateUUID(t)
+		rm := &chat.ReadModel{
+			ID:           generateUUID(t),
+			WorkspaceID:  generateUUID(t),
+			Type:         domainChat.TypeTask,
+			IsPublic:     isPublic,
+			Participants: []domainChat.Participant{domainChat.NewParticipant(memberID, domainChat.RoleMember)},
+		}
+		queryRepo.SetupReadModel(rm)
+		return stars, chat.NewStarChatUseCase(queryRepo, stars), rm, memberID
+	}
+
+	t.Run("participant stars a task twice", func(t *testing.T) {
+		stars, useCase, rm, memberID := setup(t, false)
+		cmd := chat.StarChatCommand{ChatID: rm.ID, UserID: memberID}
+
+		require.NoError(t, useCase.Execute(testContext(), cmd))
+		require.NoError(t, useCase.Execute(testContext(), cmd))
+
+		starred, _ := stars.FindStarred(testContext(), memberID, rm.WorkspaceID)
+		assert.Equal(t, []uuid.UUID{rm.ID}, starred)
+	})
+
+	t.Run("any user can star a public chat", func(t *testing.T) {
+		_, useCase, rm, _ := setup(t, true)
+
+		err := useCase.Execute(testContext(), chat.StarChatCommand{ChatID: rm.ID, UserID: generateUUID(t)})
+
+		require.NoError(t, err)
+	})
+
+	t.Run("non-participant of private chat", func(t *testing.T) {
+		_, useCase, rm, _ := setup(t, false)
+
+		err := useCase.Execute(testContext(), chat.StarChatCommand{ChatID: rm.ID, UserID: generateUUID(t)})
+
+		require.ErrorIs(t, err, chat.ErrUserNotParticipant)
+	})
+
+	t.Run("chat not found", func(t *testing.T) {
+		_, useCase, _, memberID := setup(t, false)
+
+		err := useCase.Execute(testContext(), chat.StarChatCommand{ChatID: generateUUID(t), UserID: memberID})
+
+		require.ErrorIs(t, err, chat.ErrChatNotFound)
+	})
+
+	t.Run("unstar removes the star", func(t *testing.T) {
+		stars, useCase, rm, memberID := setup(t, false)
+		require.NoError(t, useCase.Execute(testContext(), chat.StarChatCommand{ChatID: rm.ID, UserID: memberID}))
+
+		unstar := chat.NewUnstarChatUseCase(stars)
+		require.NoError(t, unstar.Execute(testContext(), chat.UnstarChatCommand{ChatID: rm.ID, UserID: memberID}))
+
+		starred, _ := stars.IsStarred(testContext(), memberID, rm.ID)
+		assert.False(t, starred)
+	})
+}
+
+func TestListChatsUseCase_Starred(t *testing.T) {
+	queryRepo := NewMockChatQueryRepository()
+	stars := NewMockStarRepository()
+	useCase := chat.NewListChatsUseCase(queryRepo, newTestEventStore(), chat.WithListStars(stars))
+
+	workspaceID := generateUUID(t)
+	userID := generateUUID(t)
+	var ids []uuid.UUID
+	for range 3 {
+		rm := &chat.ReadModel{
+			ID:          generateUUID(t),
+			WorkspaceID: workspaceID,
+			Type:        domainChat.TypeDiscussion,
+			IsPublic:    true,
+		}
+		queryRepo.SetupReadModel(rm)
+		ids = append(ids, rm.ID)
+	}
+	require.NoError(t, stars.Star(testContext(), userID, workspaceID, ids[1]))
+	// another user's star is not shown
+	require.NoError(t, stars.Star(testContext(), generateUUID(t), workspaceID, ids[2]))
+
+	t.Run("marks starred chats", func(t *testing.T) {
+		result, err := useCase.Execute(testContext(), chat.ListChatsQuery{WorkspaceID: workspaceID, RequestedBy: userID})
+
+		require.NoError(t, err)
+		require.Len(t, result.Chats, 3)
+		for _, c := range result.Chats {
+			assert.Equal(t, c.ID == ids[1], c.IsStarred, c.ID)
+		}
+	})
+
+	t.Run("lists only starred chats", func(t *testing.T) {
+		result, err := useCase.Execute(testContext(), chat.ListChatsQuery{
+			WorkspaceID: workspaceID,
+			RequestedBy: userID,
+			StarredOnly: true,
+		})
+
+		require.NoError(t, err)
+		require.Len(t, result.Chats, 1)
+		assert.Equal(t, ids[1], result.Chats[0].ID)
+		assert.Equal(t, 1, result.Total)
+	})
+
+	t.Run("lists nothing without stars", func(t *testing.T) {
+		result, err := useCase.Execute(testContext(), chat.ListChatsQuery{
+			WorkspaceID: workspaceID,
+			RequestedBy: generateUUID(t),
+			StarredOnly: true,
+		})
+
+		require.NoError(t, err)
+		assert.Empty(t, result.Chats)
+	})
+}
@@ -8,6 +8,7 @@ import (
 	domainChat "github.com/lllypuk/flowra/internal/domain/chat"
 	domainEvent "github.com/lllypuk/flowra/internal/domain/event"
 	"github.com/lllypuk/flowra/internal/domain/uuid"
+	"github.com/lllypuk/flowra/internal/domain/workspace"
 	"github.com/lllypuk/flowra/tests/mocks"
 	"github.com/stretchr/testify/require"
 )
@@ -150,3 +151,20 @@ func assertChatRepoCallCount(t *testing.T, repo *mocks.MockChatRepository, metho
 func generateUUID(_ *testing.T) uuid.UUID {
 	return uuid.NewUUID()
 }
+
+// stubValuePolicyProvider returns a fixed workspace value policy
+type stubValuePolicyProvider struct {
+	policy workspace.ValuePolicy
+	err    error
+}
+
+func (p stubValuePolicyProvider) GetValuePolicy(_ context.Context, _ uuid.UUID) (workspace.ValuePolicy, error) {
+	return p.policy, p.err
+}
+
+func newTestValuePolicy(t *testing.T, priorities, severities map[domainChat.Type][]string) workspace.ValuePolicy {
+	t.Helper()
+	policy, err := workspace.NewValuePolicy(priorities, severities)
+	require.NoError(t, err)
+	return policy
+}
@@ -0,0 +1,66 @@
+package chat
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/lllypuk/flowra/internal/application/appcore"
+	"github.com/lllypuk/flowra/internal/domain/chat"
+	"github.com/lllypuk/flowra/internal/domain/errs"
+)
+
+// ToggleChecklistItemUseCase handles marking checklist items as done or not done.
+type ToggleChecklistItemUseCase struct {
+	chatRepo CommandRepository
+}
+
+// NewToggleChecklistItemUseCase creates a new ToggleChecklistItemUseCase.
+func NewToggleChecklistItemUseCase(chatRepo CommandRepository) *ToggleChecklistItemUseCase {
+	return &ToggleChecklistItemUseCase{chatRepo: chatRepo}
+}
+
+// Execute sets the done state of a checklist item.
+func (uc *ToggleChecklistItemUseCase) Execute(ctx context.Context, cmd ToggleChecklistItemCommand) (Result, error) {
+	if err := uc.validate(cmd); err != nil {
+		return Result{}, fmt.Errorf("validation failed: %w", err)
+	}
+
+	chatAggregate, err := uc.chatRepo.Load(ctx, cmd.ChatID)
+	if err != nil {
+		return Result{}, fmt.Errorf("failed to load chat: %w", err)
+	}
+
+	if toggleErr := chatAggregate.ToggleChecklistItem(cmd.ItemID, cmd.Done, cmd.ToggledBy); toggleErr != nil {
+		if errors.Is(toggleErr, errs.ErrNotFound) {
+			return Result{}, ErrChecklistItemNotFound
+		}
+		return Result{}, fmt.Errorf("failed to toggle checklist item: %w", toggleErr)
+	}
+
+	appcore.StampEventMetadata(ctx, cmd, chatAggregate.GetUncommittedEvents())
+
+	if err = uc.chatRepo.Save(ctx, chatAggregate); err != nil {
+		return Result{}, fmt.Errorf("failed to save chat: %w", err)
+	}
+
+	return Result{
+		Result: appcore.Result[*chat.Chat]{
+			Value:   chatAggregate,
+			Version: chatAggregate.Version(),
+		},
+	}, nil
+}
+
+func (uc *ToggleChecklistItemUseCase) validate(cmd ToggleChecklistItemCommand) error {
+	if err := appcore.ValidateUUID("chatID", cmd.ChatID); err != nil {
+		return err
+	}
+	if err := appcore.ValidateUUID("itemID", cmd.ItemID); err != nil {
+		return err
+	}
+	if err := appcore.ValidateUUID("toggledBy", cmd.ToggledBy); err != nil {
+		return err
+	}
+	return nil
+}
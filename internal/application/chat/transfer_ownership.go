@@ -0,0 +1,76 @@
+package chat
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/lllypuk/flowra/internal/application/appcore"
+	domchat "github.com/lllypuk/flowra/internal/domain/chat"
+)
+
+// TransferOwnershipUseCase handles moving the chat owner (creator) role to another participant
+type TransferOwnershipUseCase struct {
+	chatRepo CommandRepository
+}
+
+// NewTransferOwnershipUseCase creates a new TransferOwnershipUseCase
+func NewTransferOwnershipUseCase(chatRepo CommandRepository) *TransferOwnershipUseCase {
+	return &TransferOwnershipUseCase{
+		chatRepo: chatRepo,
+	}
+}
+
+// Execute performs the ownership transfer.
+// The current owner or a chat admin may transfer ownership, so a chat can be
+// handed over after its creator has left; the new owner must already be a participant.
+func (uc *TransferOwnershipUseCase) Execute(ctx context.Context, cmd TransferOwnershipCommand) (Result, error) {
+	if err := uc.validate(cmd); err != nil {
+		return Result{}, fmt.Errorf("validation failed: %w", err)
+	}
+
+	chatAggregate, err := uc.chatRepo.Load(ctx, cmd.ChatID)
+	if err != nil {
+		return Result{}, err
+	}
+
+	if chatAggregate.CreatedBy() != cmd.TransferredBy && !chatAggregate.IsParticipantAdmin(cmd.TransferredBy) {
+		return Result{}, ErrNotAdmin
+	}
+	if !chatAggregate.HasParticipant(cmd.NewOwnerID) {
+		return Result{}, ErrUserNotParticipant
+	}
+
+	if transferErr := chatAggregate.TransferOwnership(cmd.NewOwnerID, cmd.TransferredBy); transferErr != nil {
+		return Result{}, fmt.Errorf("failed to transfer ownership: %w", transferErr)
+	}
+
+	// Capture events before save (Save marks them as committed)
+	newEvents := chatAggregate.GetUncommittedEvents()
+	appcore.StampEventMetadata(ctx, cmd, newEvents)
+
+	// Save via repository (updates both event store and read model)
+	if saveErr := uc.chatRepo.Save(ctx, chatAggregate); saveErr != nil {
+		return Result{}, fmt.Errorf("failed to save chat: %w", saveErr)
+	}
+
+	return Result{
+		Result: appcore.Result[*domchat.Chat]{
+			Value:   chatAggregate,
+			Version: chatAggregate.Version(),
+		},
+		Events: convertToInterfaceSlice(newEvents),
+	}, nil
+}
+
+func (uc *TransferOwnershipUseCase) validate(cmd TransferOwnershipCommand) error {
+	if err := appcore.ValidateUUID("chatID", cmd.ChatID); err != nil {
+		return err
+	}
+	if err := appcore.ValidateUUID("newOwnerID", cmd.NewOwnerID); err != nil {
+		return err
+	}
+	if err := appcore.ValidateUUID("transferredBy", cmd.TransferredBy); err != nil {
+		return err
+	}
+	return nil
+}
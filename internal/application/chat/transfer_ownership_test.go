@@ -0,0 +1,86 @@
+package chat_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lllypuk/flowra/internal/application/chat"
+	domainChat "github.com/lllypuk/flowra/internal/domain/chat"
+	"github.com/lllypuk/flowra/internal/domain/uuid"
+)
+
+// setupTransferOwnershipChat creates a chat with a member and returns the chat ID, owner and member.
+func setupTransferOwnershipChat(t *testing.T) (*chat.TransferOwnershipUseCase, uuid.UUID, uuid.UUID, uuid.UUID) {
+	t.Helper()
+
+	chatRepo := newTestChatRepo()
+	ownerID := generateUUID(t)
+	createdChat := createTestChatWithRepo(t, chatRepo, domainChat.TypeDiscussion, "", generateUUID(t), ownerID)
+
+	memberID := generateUUID(t)
+	_, err := chat.NewAddParticipantUseCase(chatRepo).Execute(testContext(), chat.AddParticipantCommand{
+		ChatID:  createdChat.ID(),
+		UserID:  memberID,
+		Role:    domainChat.RoleMember,
+		AddedBy: ownerID,
+	})
+	require.NoError(t, err)
+
+	return chat.NewTransferOwnershipUseCase(chatRepo), createdChat.ID(), ownerID, memberID
+}
+
+// TestTransferOwnershipUseCase_Success tests transferring ownership to a participant
+func TestTransferOwnershipUseCase_Success(t *testing.T) {
+	useCase, chatID, ownerID, memberID := setupTransferOwnershipChat(t)
+
+	result, err := useCase.Execute(testContext(), chat.TransferOwnershipCommand{
+		ChatID:        chatID,
+		NewOwnerID:    memberID,
+		TransferredBy: ownerID,
+	})
+
+	executeAndAssertSuccess(t, err)
+	require.NotNil(t, result.Value)
+	assert.Equal(t, memberID, result.Value.CreatedBy())
+	assert.True(t, result.Value.IsParticipantAdmin(memberID))
+	require.NotEmpty(t, result.Events)
+	assert.IsType(t, &domainChat.OwnershipTransferred{}, result.Events[len(result.Events)-1])
+}
+
+// TestTransferOwnershipUseCase_Errors tests permission and target validation
+func TestTransferOwnershipUseCase_Errors(t *testing.T) {
+	t.Run("target is not a participant", func(t *testing.T) {
+		useCase, chatID, ownerID, _ := setupTransferOwnershipChat(t)
+
+		_, err := useCase.Execute(testContext(), chat.TransferOwnershipCommand{
+			ChatID:        chatID,
+			NewOwnerID:    generateUUID(t),
+			TransferredBy: ownerID,
+		})
+		require.ErrorIs(t, err, chat.ErrUserNotParticipant)
+	})
+
+	t.Run("member cannot transfer", func(t *testing.T) {
+		useCase, chatID, _, memberID := setupTransferOwnershipChat(t)
+
+		_, err := useCase.Execute(testContext(), chat.TransferOwnershipCommand{
+			ChatID:        chatID,
+			NewOwnerID:    memberID,
+			TransferredBy: memberID,
+		})
+		require.ErrorIs(t, err, chat.ErrNotAdmin)
+	})
+
+	t.Run("invalid new owner ID", func(t *testing.T) {
+		useCase, chatID, ownerID, _ := setupTransferOwnershipChat(t)
+
+		_, err := useCase.Execute(testContext(), chat.TransferOwnershipCommand{
+			ChatID:        chatID,
+			NewOwnerID:    "",
+			TransferredBy: ownerID,
+		})
+		executeAndAssertError(t, err)
+	})
+}
@@ -0,0 +1,60 @@
+package inbound
+
+import (
+	"net/mail"
+	"strings"
+
+	"github.com/lllypuk/flowra/internal/domain/chat"
+	"github.com/lllypuk/flowra/internal/domain/uuid"
+)
+
+// Address subaddresses selecting the chat type; without one a task is created.
+const (
+	subaddressBug  = "bug"
+	subaddressTask = "task"
+)
+
+// WorkspaceAddress is a parsed workspace address <workspace-id>[+bug|+task]@domain
+type WorkspaceAddress struct {
+	WorkspaceID uuid.UUID
+	Type        chat.Type
+}
+
+// ParseWorkspaceAddress parses a recipient in the inbound domain.
+// It reports false for addresses of other domains or malformed local parts.
+func ParseWorkspaceAddress(domain, address string) (WorkspaceAddress, bool) {
+	parsed, err := mail.ParseAddress(address)
+	if err != nil {
+		return WorkspaceAddress{}, false
+	}
+
+	at := strings.LastIndex(parsed.Address, "@")
+	if at < 0 || !strings.EqualFold(parsed.Address[at+1:], strings.TrimSpace(domain)) {
+		return WorkspaceAddress{}, false
+	}
+
+	local, sub, _ := strings.Cut(parsed.Address[:at], "+")
+	workspaceID, err := uuid.ParseUUID(strings.ToLower(local))
+	if err != nil {
+		return WorkspaceAddress{}, false
+	}
+
+	addr := WorkspaceAddress{WorkspaceID: workspaceID, Type: chat.TypeTask}
+	switch strings.ToLower(sub) {
+	case "", subaddressTask:
+	case subaddressBug:
+		addr.Type = chat.TypeBug
+	default:
+		return WorkspaceAddress{}, false
+	}
+	return addr, true
+}
+
+// senderAddress extracts the bare address of the sender
+func senderAddress(from string) string {
+	parsed, err := mail.ParseAddress(from)
+	if err != nil {
+		return ""
+	}
+	return strings.ToLower(parsed.Address)
+}
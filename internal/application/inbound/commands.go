@@ -0,0 +1,23 @@
+package inbound
+
+import "io"
+
+// IngestEmailCommand - turn a received email into a new typed chat
+type IngestEmailCommand struct {
+	From        string   // sender address, optionally with a display name
+	To          []string // recipients; the first workspace address wins
+	Subject     string
+	Text        string // plain-text body
+	Attachments []Attachment
+}
+
+func (c IngestEmailCommand) CommandName() string { return "IngestEmail" }
+
+// Attachment is a file attached to an email.
+// FileName and MimeType are expected to be sanitized by the caller.
+type Attachment struct {
+	FileName string
+	MimeType string
+	Size     int64
+	Open     func() (io.ReadCloser, error)
+}
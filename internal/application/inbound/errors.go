@@ -0,0 +1,14 @@
+package inbound
+
+import "errors"
+
+var (
+	// ErrUnknownRecipient is returned when no recipient is a workspace address
+	ErrUnknownRecipient = errors.New("no workspace address among recipients")
+
+	// ErrUnknownSender is returned when the sender is not an active member of the workspace
+	ErrUnknownSender = errors.New("sender is not a member of the workspace")
+
+	// ErrEmptyReply is returned when a reply has no text above the quoted email
+	ErrEmptyReply = errors.New("reply is empty")
+)
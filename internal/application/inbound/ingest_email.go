@@ -0,0 +1,348 @@
+// Package inbound turns emails sent to workspace addresses into typed chats.
+// The mail provider posts each received email to the ingestion endpoint; the
+// recipient <workspace-id>[+bug|+task]@domain selects the workspace and chat
+// type, the subject becomes the title and the body the first message. Replies
+// to notification emails go to signed reply+<token>@domain addresses and are
+// appended to the chat the notification was about.
+package inbound
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/lllypuk/flowra/internal/application/appcore"
+	chatapp "github.com/lllypuk/flowra/internal/application/chat"
+	messageapp "github.com/lllypuk/flowra/internal/application/message"
+	"github.com/lllypuk/flowra/internal/domain/chat"
+	"github.com/lllypuk/flowra/internal/domain/errs"
+	"github.com/lllypuk/flowra/internal/domain/user"
+	"github.com/lllypuk/flowra/internal/domain/uuid"
+)
+
+// untitledSubject is the title of chats created from emails without a subject
+const untitledSubject = "(no subject)"
+
+// UserFinder resolves the sender of an email
+type UserFinder interface {
+	FindByEmail(ctx context.Context, email string) (*user.User, error)
+}
+
+// MembershipChecker verifies that the sender may post to the workspace
+type MembershipChecker interface {
+	IsMember(ctx context.Context, workspaceID, userID uuid.UUID) (bool, error)
+}
+
+// ChatCreator creates the chat of an email
+type ChatCreator interface {
+	Execute(ctx context.Context, cmd chatapp.CreateChatCommand) (chatapp.Result, error)
+}
+
+// MessageSender posts the email body to the chat
+type MessageSender interface {
+	Execute(ctx context.Context, cmd messageapp.SendMessageCommand) (messageapp.Result, error)
+}
+
+// AttachmentAdder attaches stored files to the first message
+type AttachmentAdder interface {
+	Execute(ctx context.Context, cmd messageapp.AddAttachmentCommand) (messageapp.Result, error)
+}
+
+// AttachmentStore writes an attachment to the blob store and records which
+// chat it belongs to, so downloads are authorized like regular uploads
+type AttachmentStore interface {
+	Store(ctx context.Context, chatID, uploaderID uuid.UUID, fileName string, content io.Reader) (uuid.UUID, error)
+}
+
+// IngestResult describes the chat created from an email, or the chat a reply was appended to
+type IngestResult struct {
+	WorkspaceID uuid.UUID // empty for replies
+	ChatID      uuid.UUID
+	MessageID   uuid.UUID
+	Type        chat.Type // empty for replies
+	Reply       bool
+	Attachments int // attachments stored; failed ones are logged and skipped
+}
+
+// IngestEmailUseCase creates a bug or task chat from an email, or appends a reply to its chat
+type IngestEmailUseCase struct {
+	domain      string
+	users       UserFinder
+	members     MembershipChecker
+	chats       ChatCreator
+	messages    MessageSender
+	attachments AttachmentAdder
+	files       AttachmentStore
+	replies     *ReplyAddresses
+	logger      *slog.Logger
+}
+
+// IngestEmailOption configures IngestEmailUseCase.
+type IngestEmailOption func(*IngestEmailUseCase)
+
+// WithReplyAddresses accepts replies to notification emails signed with replies
+func WithReplyAddresses(replies *ReplyAddresses) IngestEmailOption {
+	return func(uc *IngestEmailUseCase) {
+		uc.replies = replies
+	}
+}
+
+// NewIngestEmailUseCase creates a new IngestEmailUseCase for addresses in domain
+func NewIngestEmailUseCase(
+	domain string,
+	users UserFinder,
+	members MembershipChecker,
+	chats ChatCreator,
+	messages MessageSender,
+	attachments AttachmentAdder,
+	files AttachmentStore,
+	opts ...IngestEmailOption,
+) *IngestEmailUseCase {
+	uc := &IngestEmailUseCase{
+		domain:      domain,
+		users:       users,
+		members:     members,
+		chats:       chats,
+		messages:    messages,
+		attachments: attachments,
+		files:       files,
+		logger:      slog.Default(),
+	}
+	for _, opt := range opts {
+		opt(uc)
+	}
+	return uc
+}
+
+// Execute ingests the email
+func (uc *IngestEmailUseCase) Execute(ctx context.Context, cmd IngestEmailCommand) (*IngestResult, error) {
+	if chatID, mac, isReply := uc.replyAddress(cmd.To); isReply {
+		return uc.ingestReply(ctx, chatID, mac, cmd)
+	}
+
+	addr, ok := uc.workspaceAddress(cmd.To)
+	if !ok {
+		return nil, ErrUnknownRecipient
+	}
+
+	senderID, err := uc.resolveSender(ctx, addr.WorkspaceID, cmd.From)
+	if err != nil {
+		return nil, err
+	}
+
+	created, err := uc.chats.Execute(ctx, chatapp.CreateChatCommand{
+		WorkspaceID: addr.WorkspaceID,
+		Title:       chatTitle(cmd.Subject),
+		Type:        addr.Type,
+		IsPublic:    true,
+		CreatedBy:   senderID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create chat: %w", err)
+	}
+	chatID := created.Value.ID()
+
+	sent, err := uc.messages.Execute(ctx, messageapp.SendMessageCommand{
+		ChatID:   chatID,
+		Content:  messageContent(cmd),
+		AuthorID: senderID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to post email body: %w", err)
+	}
+
+	result := &IngestResult{
+		WorkspaceID: addr.WorkspaceID,
+		ChatID:      chatID,
+		MessageID:   sent.Value.ID(),
+		Type:        addr.Type,
+	}
+	result.Attachments = uc.attachAll(ctx, chatID, result.MessageID, senderID, cmd.Attachments)
+
+	return result, nil
+}
+
+// ingestReply appends a reply to a notification email to the chat it was about
+func (uc *IngestEmailUseCase) ingestReply(
+	ctx context.Context,
+	chatID uuid.UUID,
+	mac []byte,
+	cmd IngestEmailCommand,
+) (*IngestResult, error) {
+	sender, err := uc.activeSender(ctx, cmd.From)
+	if err != nil {
+		return nil, err
+	}
+	// the token is signed for the recipient of the notification
+	if !uc.replies.verify(chatID, sender.ID(), mac) {
+		return nil, ErrUnknownSender
+	}
+
+	content := truncateUTF8(stripQuotedReply(cmd.Text), messageapp.MaxContentLength)
+	if content == "" {
+		return nil, ErrEmptyReply
+	}
+
+	sent, err := uc.messages.Execute(ctx, messageapp.SendMessageCommand{
+		ChatID:   chatID,
+		Content:  content,
+		AuthorID: sender.ID(),
+	})
+	switch {
+	case errors.Is(err, messageapp.ErrChatNotFound):
+		return nil, ErrUnknownRecipient
+	case errors.Is(err, messageapp.ErrNotChatParticipant):
+		return nil, ErrUnknownSender
+	case err != nil:
+		return nil, fmt.Errorf("failed to post reply: %w", err)
+	}
+
+	result := &IngestResult{
+		ChatID:    chatID,
+		MessageID: sent.Value.ID(),
+		Reply:     true,
+	}
+	result.Attachments = uc.attachAll(ctx, chatID, result.MessageID, sender.ID(), cmd.Attachments)
+
+	return result, nil
+}
+
+// attachAll stores the attachments of an email and returns how many were attached
+func (uc *IngestEmailUseCase) attachAll(
+	ctx context.Context,
+	chatID, messageID, senderID uuid.UUID,
+	attachments []Attachment,
+) int {
+	attached := 0
+	for _, attachment := range attachments {
+		if err := uc.attach(ctx, chatID, messageID, senderID, attachment); err != nil {
+			uc.logger.WarnContext(ctx, "failed to store email attachment",
+				slog.String("chat_id", chatID.String()),
+				slog.String("file_name", attachment.FileName),
+				slog.String("error", err.Error()),
+			)
+			continue
+		}
+		attached++
+	}
+	return attached
+}
+
+func (uc *IngestEmailUseCase) replyAddress(recipients []string) (uuid.UUID, []byte, bool) {
+	if uc.replies == nil {
+		return "", nil, false
+	}
+	for _, recipient := range recipients {
+		if chatID, mac, ok := uc.replies.parse(recipient); ok {
+			return chatID, mac, true
+		}
+	}
+	return "", nil, false
+}
+
+func (uc *IngestEmailUseCase) workspaceAddress(recipients []string) (WorkspaceAddress, bool) {
+	for _, recipient := range recipients {
+		if addr, ok := ParseWorkspaceAddress(uc.domain, recipient); ok {
+			return addr, true
+		}
+	}
+	return WorkspaceAddress{}, false
+}
+
+// resolveSender maps the sender address to an active workspace member
+func (uc *IngestEmailUseCase) resolveSender(ctx context.Context, workspaceID uuid.UUID, from string) (uuid.UUID, error) {
+	sender, err := uc.activeSender(ctx, from)
+	if err != nil {
+		return "", err
+	}
+
+	isMember, err := uc.members.IsMember(ctx, workspaceID, sender.ID())
+	if err != nil {
+		return "", fmt.Errorf("failed to check membership: %w", err)
+	}
+	if !isMember {
+		return "", ErrUnknownSender
+	}
+	return sender.ID(), nil
+}
+
+// activeSender maps the sender address to an active user
+func (uc *IngestEmailUseCase) activeSender(ctx context.Context, from string) (*user.User, error) {
+	email := senderAddress(from)
+	if email == "" {
+		return nil, ErrUnknownSender
+	}
+
+	sender, err := uc.users.FindByEmail(ctx, email)
+	if err != nil {
+		if errors.Is(err, errs.ErrNotFound) {
+			return nil, ErrUnknownSender
+		}
+		return nil, fmt.Errorf("failed to load sender: %w", err)
+	}
+	if !sender.IsActive() {
+		return nil, ErrUnknownSender
+	}
+	return sender, nil
+}
+
+func (uc *IngestEmailUseCase) attach(
+	ctx context.Context,
+	chatID, messageID, senderID uuid.UUID,
+	attachment Attachment,
+) error {
+	content, err := attachment.Open()
+	if err != nil {
+		return fmt.Errorf("failed to open attachment: %w", err)
+	}
+	defer content.Close()
+
+	fileID, err := uc.files.Store(ctx, chatID, senderID, attachment.FileName, content)
+	if err != nil {
+		return err
+	}
+
+	_, err = uc.attachments.Execute(ctx, messageapp.AddAttachmentCommand{
+		MessageID: messageID,
+		FileID:    fileID,
+		FileName:  attachment.FileName,
+		FileSize:  attachment.Size,
+		MimeType:  attachment.MimeType,
+		UserID:    senderID,
+	})
+	return err
+}
+
+// chatTitle uses the subject as the chat title, cut to the title limit
+func chatTitle(subject string) string {
+	title := strings.Join(strings.Fields(subject), " ")
+	if title == "" {
+		return untitledSubject
+	}
+	return truncateUTF8(title, appcore.MaxTitleLength)
+}
+
+// messageContent is the email body, or the subject when the body is empty
+func messageContent(cmd IngestEmailCommand) string {
+	body := strings.TrimSpace(strings.ReplaceAll(cmd.Text, "\r\n", "\n"))
+	if body == "" {
+		body = chatTitle(cmd.Subject)
+	}
+	return truncateUTF8(body, messageapp.MaxContentLength)
+}
+
+// truncateUTF8 cuts s to at most limit bytes without splitting a character
+func truncateUTF8(s string, limit int) string {
+	if len(s) <= limit {
+		return s
+	}
+	cut := limit
+	for cut > 0 && !utf8.RuneStart(s[cut]) {
+		cut--
+	}
+	return s[:cut]
+}
@@ -0,0 +1,348 @@
+package inbound_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/lllypuk/flowra/internal/application/appcore"
+	chatapp "github.com/lllypuk/flowra/internal/application/chat"
+	"github.com/lllypuk/flowra/internal/application/inbound"
+	messageapp "github.com/lllypuk/flowra/internal/application/message"
+	"github.com/lllypuk/flowra/internal/domain/chat"
+	"github.com/lllypuk/flowra/internal/domain/errs"
+	"github.com/lllypuk/flowra/internal/domain/message"
+	"github.com/lllypuk/flowra/internal/domain/user"
+	"github.com/lllypuk/flowra/internal/domain/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testDomain = "inbox.flowra.example"
+
+type stubUsers map[string]*user.User
+
+func (s stubUsers) FindByEmail(_ context.Context, email string) (*user.User, error) {
+	u, ok := s[email]
+	if !ok {
+		return nil, errs.ErrNotFound
+	}
+	return u, nil
+}
+
+type stubMembers map[uuid.UUID]bool
+
+func (s stubMembers) IsMember(_ context.Context, _, userID uuid.UUID) (bool, error) {
+	return s[userID], nil
+}
+
+type stubChats struct {
+	last chatapp.CreateChatCommand
+}
+
+func (s *stubChats) Execute(_ context.Context, cmd chatapp.CreateChatCommand) (chatapp.Result, error) {
+	s.last = cmd
+	c, err := chat.NewChat(cmd.WorkspaceID, cmd.Type, cmd.IsPublic, cmd.CreatedBy)
+	if err != nil {
+		return chatapp.Result{}, err
+	}
+	return chatapp.Result{Result: appcore.Result[*chat.Chat]{Value: c}}, nil
+}
+
+type stubMessages struct {
+	last messageapp.SendMessageCommand
+}
+
+func (s *stubMessages) Execute(_ context.Context, cmd messageapp.SendMessageCommand) (messageapp.Result, error) {
+	s.last = cmd
+	msg, err := message.NewMessage(cmd.ChatID, cmd.AuthorID, cmd.Content, "")
+	if err != nil {
+		return messageapp.Result{}, err
+	}
+	return messageapp.Result{Value: msg}, nil
+}
+
+type stubAttachments struct {
+	added []messageapp.AddAttachmentCommand
+}
+
+func (s *stubAttachments) Execute(
+	_ context.Context,
+	cmd messageapp.AddAttachmentCommand,
+) (messageapp.Result, error) {
+	s.added = append(s.added, cmd)
+	return messageapp.Result{}, nil
+}
+
+type stubStore struct {
+	stored map[string]string
+}
+
+func (s *stubStore) Store(_ context.Context, _, _ uuid.UUID, fileName string, content io.Reader) (uuid.UUID, error) {
+	data, err := io.ReadAll(content)
+	if err != nil {
+		return "", err
+	}
+	s.stored[fileName] = string(data)
+	return uuid.NewUUID(), nil
+}
+
+func textAttachment(name, content string) inbound.Attachment {
+	return inbound.Attachment{
+		FileName: name,
+		MimeType: "text/plain",
+		Size:     int64(len(content)),
+		Open: func() (io.ReadCloser, error) {
+			return io.NopCloser(strings.NewReader(content)), nil
+		},
+	}
+}
+
+type ingestFixture struct {
+	uc          *inbound.IngestEmailUseCase
+	chats       *stubChats
+	messages    *stubMessages
+	attachments *stubAttachments
+	store       *stubStore
+	replies     *inbound.ReplyAddresses
+	sender      *user.User
+	outsider    *user.User
+	workspaceID uuid.UUID
+}
+
+func newIngestFixture(t *testing.T) *ingestFixture {
+	t.Helper()
+	sender, err := user.NewUser("ext-1", "alice", "alice@example.com", "Alice")
+	require.NoError(t, err)
+	outsider, err := user.NewUser("ext-2", "bob", "bob@example.com", "Bob")
+	require.NoError(t, err)
+
+	f := &ingestFixture{
+		chats:       &stubChats{},
+		messages:    &stubMessages{},
+		attachments: &stubAttachments{},
+		store:       &stubStore{stored: make(map[string]string)},
+		replies:     inbound.NewReplyAddresses(testDomain, "reply-key"),
+		sender:      sender,
+		outsider:    outsider,
+		workspaceID: uuid.NewUUID(),
+	}
+	f.uc = inbound.NewIngestEmailUseCase(
+		testDomain,
+		stubUsers{"alice@example.com": sender, "bob@example.com": outsider},
+		stubMembers{sender.ID(): true},
+		f.chats,
+		f.messages,
+		f.attachments,
+		f.store,
+		inbound.WithReplyAddresses(f.replies),
+	)
+	return f
+}
+
+func TestIngestEmailUseCase_CreatesBugChat(t *testing.T) {
+	f := newIngestFixture(t)
+
+	result, err := f.uc.Execute(context.Background(), inbound.IngestEmailCommand{
+		From:        `"Alice" <Alice@Example.com>`,
+		To:          []string{"team@example.com", f.workspaceID.String() + "+bug@" + testDomain},
+		Subject:     "  Login\tbroken  ",
+		Text:        "Steps:\r\n1. open the app\r\n",
+		Attachments: []inbound.Attachment{textAttachment("log.txt", "stack trace")},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, chat.TypeBug, result.Type)
+	assert.Equal(t, f.workspaceID, f.chats.last.WorkspaceID)
+	assert.Equal(t, "Login broken", f.chats.last.Title)
+	assert.Equal(t, f.sender.ID(), f.chats.last.CreatedBy)
+	assert.Equal(t, result.ChatID, f.messages.last.ChatID)
+	assert.Equal(t, "Steps:\n1. open the app", f.messages.last.Content)
+
+	assert.Equal(t, 1, result.Attachments)
+	assert.Equal(t, "stack trace", f.store.stored["log.txt"])
+	require.Len(t, f.attachments.added, 1)
+	assert.Equal(t, result.MessageID, f.attachments.added[0].MessageID)
+	assert.Equal(t, int64(len("stack trace")), f.attachments.added[0].FileSize)
+}
+
+func TestIngestEmailUseCase_DefaultsToTaskAndSubjectAsBody(t *testing.T) {
+	f := newIngestFixture(t)
+
+	result, err := f.uc.Execute(context.Background(), inbound.IngestEmailCommand{
+		From:    "alice@example.com",
+		To:      []string{f.workspaceID.String() + "@" + testDomain},
+		Subject: "Prepare the release notes",
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, chat.TypeTask, result.Type)
+	assert.Equal(t, "Prepare the release notes", f.messages.last.Content)
+}
+
+func TestIngestEmailUseCase_TruncatesLongSubject(t *testing.T) {
+	f := newIngestFixture(t)
+
+	_, err := f.uc.Execute(context.Background(), inbound.IngestEmailCommand{
+		From:    "alice@example.com",
+		To:      []string{f.workspaceID.String() + "@" + testDomain},
+		Subject: strings.Repeat("ошибка ", 50),
+		Text:    "body",
+	})
+	require.NoError(t, err)
+
+	assert.LessOrEqual(t, len(f.chats.last.Title), appcore.MaxTitleLength)
+	assert.True(t, strings.HasPrefix(f.chats.last.Title, "ошибка ошибка"))
+}
+
+func TestIngestEmailUseCase_SkipsFailedAttachments(t *testing.T) {
+	f := newIngestFixture(t)
+	broken := inbound.Attachment{
+		FileName: "broken.bin",
+		Open:     func() (io.ReadCloser, error) { return nil, errors.New("truncated part") },
+	}
+
+	result, err := f.uc.Execute(context.Background(), inbound.IngestEmailCommand{
+		From:        "alice@example.com",
+		To:          []string{f.workspaceID.String() + "@" + testDomain},
+		Subject:     "Screenshots",
+		Attachments: []inbound.Attachment{broken, textAttachment("ok.txt", "fine")},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, 1, result.Attachments)
+	require.Len(t, f.attachments.added, 1)
+	assert.Equal(t, "ok.txt", f.attachments.added[0].FileName)
+}
+
+func TestIngestEmailUseCase_Rejects(t *testing.T) {
+	tests := []struct {
+		name    string
+		from    string
+		to      func(workspaceID uuid.UUID) string
+		wantErr error
+	}{
+		{
+			name:    "no workspace recipient",
+			from:    "alice@example.com",
+			to:      func(uuid.UUID) string { return "support@example.com" },
+			wantErr: inbound.ErrUnknownRecipient,
+		},
+		{
+			name:    "unknown subaddress",
+			from:    "alice@example.com",
+			to:      func(id uuid.UUID) string { return id.String() + "+epic@" + testDomain },
+			wantErr: inbound.ErrUnknownRecipient,
+		},
+		{
+			name:    "unknown sender",
+			from:    "mallory@example.com",
+			to:      func(id uuid.UUID) string { return id.String() + "@" + testDomain },
+			wantErr: inbound.ErrUnknownSender,
+		},
+		{
+			name:    "sender outside the workspace",
+			from:    "bob@example.com",
+			to:      func(id uuid.UUID) string { return id.String() + "@" + testDomain },
+			wantErr: inbound.ErrUnknownSender,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newIngestFixture(t)
+			_, err := f.uc.Execute(context.Background(), inbound.IngestEmailCommand{
+				From:    tt.from,
+				To:      []string{tt.to(f.workspaceID)},
+				Subject: "Hello",
+			})
+			require.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestIngestEmailUseCase_RejectsInactiveSender(t *testing.T) {
+	f := newIngestFixture(t)
+	f.sender.SetActive(false)
+
+	_, err := f.uc.Execute(context.Background(), inbound.IngestEmailCommand{
+		From:    "alice@example.com",
+		To:      []string{f.workspaceID.String() + "@" + testDomain},
+		Subject: "Hello",
+	})
+	require.ErrorIs(t, err, inbound.ErrUnknownSender)
+}
+
+func TestIngestEmailUseCase_AppendsReply(t *testing.T) {
+	f := newIngestFixture(t)
+	chatID := uuid.NewUUID()
+	replyTo, err := f.replies.ReplyAddress(chatID, f.sender.ID())
+	require.NoError(t, err)
+	assert.LessOrEqual(t, len(replyTo)-len("@"+testDomain), 64, "local part must fit RFC 5321")
+
+	result, err := f.uc.Execute(context.Background(), inbound.IngestEmailCommand{
+		From:        "alice@example.com",
+		To:          []string{strings.ToUpper(replyTo)}, // some providers change the case
+		Subject:     "Re: You were assigned",
+		Text:        "Looking into it.\n\nOn Mon, 4 May 2026 at 10:00, Flowra <noreply@flowra.example> wrote:\n> You were assigned\n",
+		Attachments: []inbound.Attachment{textAttachment("trace.txt", "trace")},
+	})
+	require.NoError(t, err)
+
+	assert.True(t, result.Reply)
+	assert.Equal(t, chatID, result.ChatID)
+	assert.Equal(t, chatID, f.messages.last.ChatID)
+	assert.Equal(t, "Looking into it.", f.messages.last.Content)
+	assert.Equal(t, 1, result.Attachments)
+	assert.Empty(t, f.chats.last.Title, "replies must not create chats")
+}
+
+func TestIngestEmailUseCase_RejectsReplies(t *testing.T) {
+	f := newIngestFixture(t)
+	chatID := uuid.NewUUID()
+
+	t.Run("address signed for another user", func(t *testing.T) {
+		replyTo, err := f.replies.ReplyAddress(chatID, f.outsider.ID())
+		require.NoError(t, err)
+		_, err = f.uc.Execute(context.Background(), inbound.IngestEmailCommand{
+			From: "alice@example.com", To: []string{replyTo}, Text: "Hi",
+		})
+		require.ErrorIs(t, err, inbound.ErrUnknownSender)
+	})
+
+	t.Run("address signed with another key", func(t *testing.T) {
+		forged, err := inbound.NewReplyAddresses(testDomain, "guess").ReplyAddress(chatID, f.sender.ID())
+		require.NoError(t, err)
+		_, err = f.uc.Execute(context.Background(), inbound.IngestEmailCommand{
+			From: "alice@example.com", To: []string{forged}, Text: "Hi",
+		})
+		require.ErrorIs(t, err, inbound.ErrUnknownSender)
+	})
+
+	t.Run("only quoted text", func(t *testing.T) {
+		replyTo, err := f.replies.ReplyAddress(chatID, f.sender.ID())
+		require.NoError(t, err)
+		_, err = f.uc.Execute(context.Background(), inbound.IngestEmailCommand{
+			From: "alice@example.com", To: []string{replyTo}, Text: "> quoted\n> only\n",
+		})
+		require.ErrorIs(t, err, inbound.ErrEmptyReply)
+	})
+}
+
+func TestParseWorkspaceAddress(t *testing.T) {
+	workspaceID := uuid.NewUUID()
+
+	addr, ok := inbound.ParseWorkspaceAddress(testDomain,
+		"Flowra <"+strings.ToUpper(workspaceID.String())+"+BUG@INBOX.flowra.example>")
+	require.True(t, ok)
+	assert.Equal(t, workspaceID, addr.WorkspaceID)
+	assert.Equal(t, chat.TypeBug, addr.Type)
+
+	_, ok = inbound.ParseWorkspaceAddress(testDomain, workspaceID.String()+"@other.example")
+	assert.False(t, ok)
+
+	_, ok = inbound.ParseWorkspaceAddress(testDomain, "not-a-workspace@"+testDomain)
+	assert.False(t, ok)
+}
@@ -0,0 +1,122 @@
+package inbound
+
+import (
+	"bufio"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base32"
+	"fmt"
+	"net/mail"
+	"regexp"
+	"strings"
+
+	googleuuid "github.com/google/uuid"
+
+	"github.com/lllypuk/flowra/internal/domain/uuid"
+)
+
+const (
+	// replyLocalPart is the local part of reply addresses: reply+<token>@domain
+	replyLocalPart = "reply"
+
+	// replyMACSize is the length of the truncated signature in a reply token
+	replyMACSize = 10
+
+	// chatIDSize is the length of a chat ID in a reply token
+	chatIDSize = 16
+)
+
+// replyEncoding encodes reply tokens. Base32 survives providers that change
+// the case of local parts; tokens are written in lower case.
+//
+//nolint:gochecknoglobals // immutable encoding shared by all reply addresses
+var replyEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// replyAttribution matches the "On <date>, <name> wrote:" line mail clients put above the quoted email
+//
+//nolint:gochecknoglobals // compiled once
+var replyAttribution = regexp.MustCompile(`^(On .+ wrote|.+ пишет):\s*$`)
+
+// ReplyAddresses signs and verifies the reply-to addresses of notification emails.
+// A token names the chat and is signed for the user the email was sent to, so a
+// reply is only accepted from that user.
+type ReplyAddresses struct {
+	domain string
+	key    []byte
+}
+
+// NewReplyAddresses creates reply addresses in the inbound domain signed with key
+func NewReplyAddresses(domain, key string) *ReplyAddresses {
+	return &ReplyAddresses{domain: strings.TrimSpace(domain), key: []byte(key)}
+}
+
+// ReplyAddress returns the address replies to a notification about chatID sent to userID go to
+func (r *ReplyAddresses) ReplyAddress(chatID, userID uuid.UUID) (string, error) {
+	raw, err := chatID.ToGoogleUUID()
+	if err != nil {
+		return "", fmt.Errorf("invalid chat ID: %w", err)
+	}
+
+	token := append(raw[:], r.sign(chatID, userID)...)
+	return replyLocalPart + "+" + strings.ToLower(replyEncoding.EncodeToString(token)) + "@" + r.domain, nil
+}
+
+// parse extracts the chat ID and signature of a reply address.
+// It reports false for addresses that are not reply addresses of the domain.
+func (r *ReplyAddresses) parse(address string) (uuid.UUID, []byte, bool) {
+	parsed, err := mail.ParseAddress(address)
+	if err != nil {
+		return "", nil, false
+	}
+
+	at := strings.LastIndex(parsed.Address, "@")
+	if at < 0 || !strings.EqualFold(parsed.Address[at+1:], r.domain) {
+		return "", nil, false
+	}
+	local, token, found := strings.Cut(parsed.Address[:at], "+")
+	if !found || !strings.EqualFold(local, replyLocalPart) {
+		return "", nil, false
+	}
+
+	raw, err := replyEncoding.DecodeString(strings.ToUpper(token))
+	if err != nil || len(raw) != chatIDSize+replyMACSize {
+		return "", nil, false
+	}
+	chatID, err := googleuuid.FromBytes(raw[:chatIDSize])
+	if err != nil {
+		return "", nil, false
+	}
+	return uuid.FromGoogleUUID(chatID), raw[chatIDSize:], true
+}
+
+// verify reports whether mac signs chatID for userID
+func (r *ReplyAddresses) verify(chatID, userID uuid.UUID, mac []byte) bool {
+	return hmac.Equal(mac, r.sign(chatID, userID))
+}
+
+func (r *ReplyAddresses) sign(chatID, userID uuid.UUID) []byte {
+	h := hmac.New(sha256.New, r.key)
+	h.Write([]byte(chatID.String() + ":" + userID.String()))
+	return h.Sum(nil)[:replyMACSize]
+}
+
+// stripQuotedReply keeps the new text of a reply: everything above the quoted
+// original, its attribution line or the signature delimiter.
+func stripQuotedReply(text string) string {
+	var b strings.Builder
+	scanner := bufio.NewScanner(strings.NewReader(strings.ReplaceAll(text, "\r\n", "\n")))
+	scanner.Buffer(make([]byte, 0, bufio.MaxScanTokenSize), len(text)+1)
+	for scanner.Scan() {
+		line := scanner.Text()
+		trimmed := strings.TrimSpace(line)
+		if strings.HasPrefix(trimmed, ">") ||
+			line == "-- " ||
+			strings.HasPrefix(trimmed, "-----Original Message-----") ||
+			replyAttribution.MatchString(trimmed) {
+			break
+		}
+		b.WriteString(line)
+		b.WriteByte('\n')
+	}
+	return strings.TrimSpace(b.String())
+}
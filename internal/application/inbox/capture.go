@@ -0,0 +1,60 @@
+package inbox
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/lllypuk/flowra/internal/application/appcore"
+	"github.com/lllypuk/flowra/internal/domain/inbox"
+)
+
+// MaxItemsPerUser caps the inbox so that it stays a short list of ideas to triage
+const MaxItemsPerUser = 500
+
+// CaptureUseCase captures task ideas in the personal inbox
+type CaptureUseCase struct {
+	repo Repository
+}
+
+// NewCaptureUseCase creates a new CaptureUseCase
+func NewCaptureUseCase(repo Repository) *CaptureUseCase {
+	return &CaptureUseCase{repo: repo}
+}
+
+// Execute stores the item in the inbox of the user
+func (uc *CaptureUseCase) Execute(ctx context.Context, cmd CaptureCommand) (*inbox.Item, error) {
+	if err := uc.validate(cmd); err != nil {
+		return nil, fmt.Errorf("validation failed: %w", err)
+	}
+
+	count, err := uc.repo.CountByUser(ctx, cmd.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count inbox items: %w", err)
+	}
+	if count >= MaxItemsPerUser {
+		return nil, ErrInboxFull
+	}
+
+	item, err := inbox.NewItem(cmd.UserID, cmd.Title)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create inbox item: %w", err)
+	}
+
+	if saveErr := uc.repo.Save(ctx, item); saveErr != nil {
+		return nil, fmt.Errorf("failed to save inbox item: %w", saveErr)
+	}
+
+	return item, nil
+}
+
+func (uc *CaptureUseCase) validate(cmd CaptureCommand) error {
+	if err := appcore.ValidateUUID("userID", cmd.UserID); err != nil {
+		return err
+	}
+	title := strings.TrimSpace(cmd.Title)
+	if err := appcore.ValidateRequired("title", title); err != nil {
+		return err
+	}
+	return appcore.ValidateMaxLength("title", title, inbox.MaxTitleLength)
+}
@@ -0,0 +1,38 @@
+package inbox
+
+import (
+	"github.com/lllypuk/flowra/internal/domain/chat"
+	"github.com/lllypuk/flowra/internal/domain/uuid"
+)
+
+// CaptureCommand - capture a task idea in the personal inbox
+type CaptureCommand struct {
+	UserID uuid.UUID
+	Title  string
+}
+
+func (c CaptureCommand) CommandName() string { return "CaptureInboxItem" }
+
+// DiscardCommand - remove an item from the personal inbox
+type DiscardCommand struct {
+	ItemID uuid.UUID
+	UserID uuid.UUID
+}
+
+func (c DiscardCommand) CommandName() string { return "DiscardInboxItem" }
+
+// PromoteCommand - turn an inbox item into a chat or task of a workspace
+type PromoteCommand struct {
+	ItemID      uuid.UUID
+	UserID      uuid.UUID
+	WorkspaceID uuid.UUID
+	Type        chat.Type // Task by default
+	IsPublic    bool
+}
+
+func (c PromoteCommand) CommandName() string { return "PromoteInboxItem" }
+
+// ListQuery - list the personal inbox of a user
+type ListQuery struct {
+	UserID uuid.UUID
+}
@@ -0,0 +1,57 @@
+package inbox
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/lllypuk/flowra/internal/application/appcore"
+	"github.com/lllypuk/flowra/internal/domain/errs"
+	"github.com/lllypuk/flowra/internal/domain/inbox"
+	"github.com/lllypuk/flowra/internal/domain/uuid"
+)
+
+// DiscardUseCase removes items from the personal inbox
+type DiscardUseCase struct {
+	repo Repository
+}
+
+// NewDiscardUseCase creates a new DiscardUseCase
+func NewDiscardUseCase(repo Repository) *DiscardUseCase {
+	return &DiscardUseCase{repo: repo}
+}
+
+// Execute deletes the item of the user
+func (uc *DiscardUseCase) Execute(ctx context.Context, cmd DiscardCommand) error {
+	if err := appcore.ValidateUUID("itemID", cmd.ItemID); err != nil {
+		return fmt.Errorf("validation failed: %w", err)
+	}
+	if err := appcore.ValidateUUID("userID", cmd.UserID); err != nil {
+		return fmt.Errorf("validation failed: %w", err)
+	}
+
+	if _, err := findOwnedItem(ctx, uc.repo, cmd.ItemID, cmd.UserID); err != nil {
+		return err
+	}
+
+	if err := uc.repo.Delete(ctx, cmd.ItemID); err != nil {
+		return fmt.Errorf("failed to delete inbox item: %w", err)
+	}
+	return nil
+}
+
+// findOwnedItem loads an item of the user; items of other users are reported
+// as missing so that their IDs cannot be probed.
+func findOwnedItem(ctx context.Context, repo Repository, itemID, userID uuid.UUID) (*inbox.Item, error) {
+	item, err := repo.FindByID(ctx, itemID)
+	if err != nil {
+		if errors.Is(err, errs.ErrNotFound) {
+			return nil, ErrItemNotFound
+		}
+		return nil, fmt.Errorf("failed to load inbox item: %w", err)
+	}
+	if !item.IsOwnedBy(userID) {
+		return nil, ErrItemNotFound
+	}
+	return item, nil
+}
@@ -0,0 +1,11 @@
+package inbox
+
+import "errors"
+
+var (
+	// ErrItemNotFound is returned when the item does not exist or belongs to another user
+	ErrItemNotFound = errors.New("inbox item not found")
+
+	// ErrInboxFull is returned when the user has too many items to capture another one
+	ErrInboxFull = errors.New("inbox is full")
+)
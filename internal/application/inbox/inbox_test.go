@@ -0,0 +1,200 @@
+package inbox_test
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/lllypuk/flowra/internal/application/appcore"
+	chatapp "github.com/lllypuk/flowra/internal/application/chat"
+	inboxapp "github.com/lllypuk/flowra/internal/application/inbox"
+	"github.com/lllypuk/flowra/internal/domain/chat"
+	"github.com/lllypuk/flowra/internal/domain/errs"
+	"github.com/lllypuk/flowra/internal/domain/inbox"
+	"github.com/lllypuk/flowra/internal/domain/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// memoryRepo keeps items in capture order
+type memoryRepo struct {
+	items []*inbox.Item
+}
+
+func (r *memoryRepo) Save(_ context.Context, item *inbox.Item) error {
+	r.items = append(r.items, item)
+	return nil
+}
+
+func (r *memoryRepo) FindByID(_ context.Context, id uuid.UUID) (*inbox.Item, error) {
+	for _, item := range r.items {
+		if item.ID() == id {
+			return item, nil
+		}
+	}
+	return nil, errs.ErrNotFound
+}
+
+func (r *memoryRepo) FindByUser(_ context.Context, userID uuid.UUID) ([]*inbox.Item, error) {
+	var found []*inbox.Item
+	for i := len(r.items) - 1; i >= 0; i-- {
+		if r.items[i].IsOwnedBy(userID) {
+			found = append(found, r.items[i])
+		}
+	}
+	return found, nil
+}
+
+func (r *memoryRepo) CountByUser(ctx context.Context, userID uuid.UUID) (int, error) {
+	found, _ := r.FindByUser(ctx, userID)
+	return len(found), nil
+}
+
+func (r *memoryRepo) Delete(_ context.Context, id uuid.UUID) error {
+	r.items = slices.DeleteFunc(r.items, func(item *inbox.Item) bool { return item.ID() == id })
+	return nil
+}
+
+type recordingCreator struct {
+	commands []chatapp.CreateChatCommand
+}
+
+func (c *recordingCreator) Execute(_ context.Context, cmd chatapp.CreateChatCommand) (chatapp.Result, error) {
+	c.commands = append(c.commands, cmd)
+	created, err := chat.NewChat(cmd.WorkspaceID, cmd.Type, cmd.IsPublic, cmd.CreatedBy)
+	if err != nil {
+		return chatapp.Result{}, err
+	}
+	return chatapp.Result{Result: appcore.Result[*chat.Chat]{Value: created}}, nil
+}
+
+func TestCaptureAndList(t *testing.T) {
+	repo := &memoryRepo{}
+	capture := inboxapp.NewCaptureUseCase(repo)
+	list := inboxapp.NewListUseCase(repo)
+	userID := uuid.NewUUID()
+
+	first, err := capture.Execute(context.Background(), inboxapp.CaptureCommand{UserID: userID, Title: "Fix flaky CI"})
+	require.NoError(t, err)
+	second, err := capture.Execute(context.Background(), inboxapp.CaptureCommand{UserID: userID, Title: "Plan Q3"})
+	require.NoError(t, err)
+	_, err = capture.Execute(context.Background(), inboxapp.CaptureCommand{UserID: uuid.NewUUID(), Title: "Not mine"})
+	require.NoError(t, err)
+
+	items, err := list.Execute(context.Background(), inboxapp.ListQuery{UserID: userID})
+	require.NoError(t, err)
+	assert.Equal(t, []*inbox.Item{second, first}, items)
+
+	_, err = capture.Execute(context.Background(), inboxapp.CaptureCommand{UserID: userID, Title: " "})
+	var validationErr *appcore.ValidationError
+	require.ErrorAs(t, err, &validationErr)
+}
+
+func TestCapture_InboxFull(t *testing.T) {
+	repo := &memoryRepo{}
+	capture := inboxapp.NewCaptureUseCase(repo)
+	userID := uuid.NewUUID()
+	for range inboxapp.MaxItemsPerUser {
+		item, err := inbox.NewItem(userID, "Idea")
+		require.NoError(t, err)
+		require.NoError(t, repo.Save(context.Background(), item))
+	}
+
+	_, err := capture.Execute(context.Background(), inboxapp.CaptureCommand{UserID: userID, Title: "One more"})
+
+	require.ErrorIs(t, err, inboxapp.ErrInboxFull)
+}
+
+func TestDiscard(t *testing.T) {
+	repo := &memoryRepo{}
+	discard := inboxapp.NewDiscardUseCase(repo)
+	userID := uuid.NewUUID()
+	item, err := inbox.NewItem(userID, "Fix flaky CI")
+	require.NoError(t, err)
+	require.NoError(t, repo.Save(context.Background(), item))
+
+	err = discard.Execute(context.Background(), inboxapp.DiscardCommand{ItemID: item.ID(), UserID: uuid.NewUUID()})
+	require.ErrorIs(t, err, inboxapp.ErrItemNotFound)
+
+	require.NoError(t, discard.Execute(context.Background(), inboxapp.DiscardCommand{ItemID: item.ID(), UserID: userID}))
+	assert.Empty(t, repo.items)
+}
+
+func TestPromote(t *testing.T) {
+	setup := func(t *testing.T) (*memoryRepo, *recordingCreator, *inboxapp.PromoteUseCase, *inbox.Item) {
+		t.Helper()
+		repo := &memoryRepo{}
+		creator := &recordingCreator{}
+		item, err := inbox.NewItem(uuid.NewUUID(), "Fix flaky CI")
+		require.NoError(t, err)
+		require.NoError(t, repo.Save(context.Background(), item))
+		return repo, creator, inboxapp.NewPromoteUseCase(repo, creator), item
+	}
+
+	t.Run("creates a task and removes the item", func(t *testing.T) {
+		repo, creator, promote, item := setup(t)
+		workspaceID := uuid.NewUUID()
+
+		result, err := promote.Execute(context.Background(), inboxapp.PromoteCommand{
+			ItemID:      item.ID(),
+			UserID:      item.UserID(),
+			WorkspaceID: workspaceID,
+		})
+
+		require.NoError(t, err)
+		require.NotNil(t, result.Value)
+		assert.Equal(t, []chatapp.CreateChatCommand{{
+			WorkspaceID: workspaceID,
+			Title:       "Fix flaky CI",
+			Type:        chat.TypeTask,
+			CreatedBy:   item.UserID(),
+		}}, creator.commands)
+		assert.Empty(t, repo.items)
+	})
+
+	t.Run("promotes to the requested type", func(t *testing.T) {
+		_, creator, promote, item := setup(t)
+
+		_, err := promote.Execute(context.Background(), inboxapp.PromoteCommand{
+			ItemID:      item.ID(),
+			UserID:      item.UserID(),
+			WorkspaceID: uuid.NewUUID(),
+			Type:        chat.TypeBug,
+			IsPublic:    true,
+		})
+
+		require.NoError(t, err)
+		require.Len(t, creator.commands, 1)
+		assert.Equal(t, chat.TypeBug, creator.commands[0].Type)
+		assert.True(t, creator.commands[0].IsPublic)
+	})
+
+	t.Run("items of other users are not found", func(t *testing.T) {
+		repo, creator, promote, item := setup(t)
+
+		_, err := promote.Execute(context.Background(), inboxapp.PromoteCommand{
+			ItemID:      item.ID(),
+			UserID:      uuid.NewUUID(),
+			WorkspaceID: uuid.NewUUID(),
+		})
+
+		require.ErrorIs(t, err, inboxapp.ErrItemNotFound)
+		assert.Empty(t, creator.commands)
+		assert.Len(t, repo.items, 1)
+	})
+
+	t.Run("rejects unknown types", func(t *testing.T) {
+		_, creator, promote, item := setup(t)
+
+		_, err := promote.Execute(context.Background(), inboxapp.PromoteCommand{
+			ItemID:      item.ID(),
+			UserID:      item.UserID(),
+			WorkspaceID: uuid.NewUUID(),
+			Type:        "story",
+		})
+
+		var validationErr *appcore.ValidationError
+		require.ErrorAs(t, err, &validationErr)
+		assert.Empty(t, creator.commands)
+	})
+}
@@ -0,0 +1,32 @@
+package inbox
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/lllypuk/flowra/internal/application/appcore"
+	"github.com/lllypuk/flowra/internal/domain/inbox"
+)
+
+// ListUseCase lists the personal inbox of a user
+type ListUseCase struct {
+	repo Repository
+}
+
+// NewListUseCase creates a new ListUseCase
+func NewListUseCase(repo Repository) *ListUseCase {
+	return &ListUseCase{repo: repo}
+}
+
+// Execute returns the items of the user, most recently captured first
+func (uc *ListUseCase) Execute(ctx context.Context, query ListQuery) ([]*inbox.Item, error) {
+	if err := appcore.ValidateUUID("userID", query.UserID); err != nil {
+		return nil, fmt.Errorf("validation failed: %w", err)
+	}
+
+	items, err := uc.repo.FindByUser(ctx, query.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list inbox items: %w", err)
+	}
+	return items, nil
+}
@@ -0,0 +1,73 @@
+package inbox
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/lllypuk/flowra/internal/application/appcore"
+	chatapp "github.com/lllypuk/flowra/internal/application/chat"
+	"github.com/lllypuk/flowra/internal/domain/chat"
+)
+
+// PromoteUseCase turns inbox items into chats or tasks of a workspace
+type PromoteUseCase struct {
+	repo    Repository
+	creator ChatCreator
+}
+
+// NewPromoteUseCase creates a new PromoteUseCase
+func NewPromoteUseCase(repo Repository, creator ChatCreator) *PromoteUseCase {
+	return &PromoteUseCase{
+		repo:    repo,
+		creator: creator,
+	}
+}
+
+// Execute creates the chat through the regular create flow and removes the item from the inbox
+func (uc *PromoteUseCase) Execute(ctx context.Context, cmd PromoteCommand) (chatapp.Result, error) {
+	if cmd.Type == "" {
+		cmd.Type = chat.TypeTask
+	}
+	if err := uc.validate(cmd); err != nil {
+		return chatapp.Result{}, fmt.Errorf("validation failed: %w", err)
+	}
+
+	item, err := findOwnedItem(ctx, uc.repo, cmd.ItemID, cmd.UserID)
+	if err != nil {
+		return chatapp.Result{}, err
+	}
+
+	result, err := uc.creator.Execute(ctx, chatapp.CreateChatCommand{
+		WorkspaceID: cmd.WorkspaceID,
+		Title:       item.Title(),
+		Type:        cmd.Type,
+		IsPublic:    cmd.IsPublic,
+		CreatedBy:   cmd.UserID,
+	})
+	if err != nil {
+		return chatapp.Result{}, err
+	}
+
+	// the chat exists now, so cleanup is best effort; a leftover item can still be discarded
+	_ = uc.repo.Delete(ctx, item.ID())
+
+	return result, nil
+}
+
+func (uc *PromoteUseCase) validate(cmd PromoteCommand) error {
+	if err := appcore.ValidateUUID("itemID", cmd.ItemID); err != nil {
+		return err
+	}
+	if err := appcore.ValidateUUID("userID", cmd.UserID); err != nil {
+		return err
+	}
+	if err := appcore.ValidateUUID("workspaceID", cmd.WorkspaceID); err != nil {
+		return err
+	}
+	return appcore.ValidateEnum("type", string(cmd.Type), []string{
+		string(chat.TypeDiscussion),
+		string(chat.TypeTask),
+		string(chat.TypeBug),
+		string(chat.TypeEpic),
+	})
+}
@@ -0,0 +1,34 @@
+package inbox
+
+import (
+	"context"
+
+	chatapp "github.com/lllypuk/flowra/internal/application/chat"
+	"github.com/lllypuk/flowra/internal/domain/inbox"
+	"github.com/lllypuk/flowra/internal/domain/uuid"
+)
+
+// Repository stores the personal inbox items
+// Interface is declared on the consumer side (application layer)
+type Repository interface {
+	// Save creates or updates an item
+	Save(ctx context.Context, item *inbox.Item) error
+
+	// FindByID returns an item; errs.ErrNotFound when it does not exist
+	FindByID(ctx context.Context, id uuid.UUID) (*inbox.Item, error)
+
+	// FindByUser returns the items of the user, most recently captured first
+	FindByUser(ctx context.Context, userID uuid.UUID) ([]*inbox.Item, error)
+
+	// CountByUser returns how many items the user has
+	CountByUser(ctx context.Context, userID uuid.UUID) (int, error)
+
+	// Delete removes an item; deleting a missing item is a no-op
+	Delete(ctx context.Context, id uuid.UUID) error
+}
+
+// ChatCreator creates the chat an item is promoted to (chat.CreateChatUseCase)
+// Interface is declared on the consumer side (application layer)
+type ChatCreator interface {
+	Execute(ctx context.Context, cmd chatapp.CreateChatCommand) (chatapp.Result, error)
+}
@@ -3,6 +3,7 @@ package message
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/lllypuk/flowra/internal/application/appcore"
 	"github.com/lllypuk/flowra/internal/domain/event"
@@ -53,7 +54,13 @@ func (uc *AddAttachmentUseCase) Execute(
 	}
 
 	// Adding attachments
-	if addErr := msg.AddAttachment(cmd.FileID, cmd.FileName, cmd.FileSize, cmd.MimeType); addErr != nil {
+	var addErr error
+	if cmd.Duration > 0 {
+		addErr = msg.AddAudioAttachment(cmd.FileID, cmd.FileName, cmd.FileSize, cmd.MimeType, cmd.Duration, cmd.Waveform)
+	} else {
+		addErr = msg.AddAttachment(cmd.FileID, cmd.FileName, cmd.FileSize, cmd.MimeType)
+	}
+	if addErr != nil {
 		return Result{}, addErr
 	}
 
@@ -70,10 +77,10 @@ func (uc *AddAttachmentUseCase) Execute(
 		cmd.FileSize,
 		cmd.MimeType,
 		1,
-		event.Metadata{
-			UserID: cmd.UserID.String(),
-		},
+		appcore.NewEventMetadata(ctx, cmd.UserID, cmd),
 	)
+	evt.Duration = cmd.Duration
+	evt.Waveform = cmd.Waveform
 	_ = uc.eventBus.Publish(ctx, evt)
 
 	return Result{
@@ -103,5 +110,30 @@ func (uc *AddAttachmentUseCase) validate(cmd AddAttachmentCommand) error {
 	if err := appcore.ValidateUUID("userID", cmd.UserID); err != nil {
 		return err
 	}
+	return validateAudioMetadata(cmd)
+}
+
+// validateAudioMetadata checks the duration and waveform of audio attachments
+func validateAudioMetadata(cmd AddAttachmentCommand) error {
+	if cmd.Duration == 0 {
+		if len(cmd.Waveform) > 0 {
+			return ErrInvalidAudioMetadata
+		}
+		return nil
+	}
+	if !strings.HasPrefix(cmd.MimeType, "audio/") {
+		return ErrInvalidAudioMetadata
+	}
+	if cmd.Duration < 0 || cmd.Duration > message.MaxAudioDuration {
+		return ErrInvalidAudioMetadata
+	}
+	if len(cmd.Waveform) > message.MaxWaveformSamples {
+		return ErrInvalidAudioMetadata
+	}
+	for _, level := range cmd.Waveform {
+		if level < 0 || level > message.MaxWaveformLevel {
+			return ErrInvalidAudioMetadata
+		}
+	}
 	return nil
 }
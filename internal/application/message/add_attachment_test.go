@@ -3,6 +3,7 @@ package message_test
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/lllypuk/flowra/internal/application/message"
 	domain "github.com/lllypuk/flowra/internal/domain/message"
@@ -49,6 +50,80 @@ func TestAddAttachmentUseCase_Success(t *testing.T) {
 	assert.Len(t, eventBus.Published, 1)
 }
 
+func TestAddAttachmentUseCase_Audio(t *testing.T) {
+	messageRepo := message.NewMockMessageRepository()
+	eventBus := message.NewMockEventBus()
+
+	authorID := uuid.NewUUID()
+	msg, err := domain.NewMessage(uuid.NewUUID(), authorID, "Voice message", "")
+	require.NoError(t, err)
+	messageRepo.Messages[msg.ID()] = msg
+
+	useCase := message.NewAddAttachmentUseCase(messageRepo, eventBus)
+
+	cmd := message.AddAttachmentCommand{
+		MessageID: msg.ID(),
+		FileID:    uuid.NewUUID(),
+		FileName:  "voice.webm",
+		FileSize:  4096,
+		MimeType:  "audio/webm;codecs=opus",
+		UserID:    authorID,
+		Duration:  12 * time.Second,
+		Waveform:  []int{10, 80, 45},
+	}
+
+	result, err := useCase.Execute(context.Background(), cmd)
+
+	require.NoError(t, err)
+	attachment := result.Value.Attachments()[0]
+	assert.True(t, attachment.IsAudio())
+	assert.Equal(t, 12*time.Second, attachment.Duration())
+	assert.Equal(t, []int{10, 80, 45}, attachment.Waveform())
+
+	require.Len(t, eventBus.Published, 1)
+	evt, ok := eventBus.Published[0].(*domain.AttachmentAdded)
+	require.True(t, ok)
+	assert.Equal(t, 12*time.Second, evt.Duration)
+}
+
+func TestAddAttachmentUseCase_InvalidAudioMetadata(t *testing.T) {
+	tests := []struct {
+		name     string
+		mimeType string
+		duration time.Duration
+		waveform []int
+	}{
+		{"waveform without duration", "audio/webm", 0, []int{1}},
+		{"duration on a document", "application/pdf", time.Second, nil},
+		{"too long", "audio/webm", domain.MaxAudioDuration + time.Second, nil},
+		{"level out of range", "audio/webm", time.Second, []int{-1}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			messageRepo := message.NewMockMessageRepository()
+			authorID := uuid.NewUUID()
+			msg, err := domain.NewMessage(uuid.NewUUID(), authorID, "Voice message", "")
+			require.NoError(t, err)
+			messageRepo.Messages[msg.ID()] = msg
+
+			useCase := message.NewAddAttachmentUseCase(messageRepo, message.NewMockEventBus())
+
+			_, err = useCase.Execute(context.Background(), message.AddAttachmentCommand{
+				MessageID: msg.ID(),
+				FileID:    uuid.NewUUID(),
+				FileName:  "voice.webm",
+				FileSize:  4096,
+				MimeType:  tt.mimeType,
+				UserID:    authorID,
+				Duration:  tt.duration,
+				Waveform:  tt.waveform,
+			})
+
+			require.ErrorIs(t, err, message.ErrInvalidAudioMetadata)
+		})
+	}
+}
+
 func TestAddAttachmentUseCase_MultipleAttachments(t *testing.T) {
 	messageRepo := message.NewMockMessageRepository()
 	eventBus := message.NewMockEventBus()
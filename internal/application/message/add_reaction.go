@@ -58,9 +58,7 @@ func (uc *AddReactionUseCase) Execute(
 	}
 
 	// publish event
-	evt := message.NewReactionAdded(msg.ID(), cmd.UserID, cmd.Emoji, 1, event.Metadata{
-		UserID: cmd.UserID.String(),
-	})
+	evt := message.NewReactionAdded(msg.ID(), cmd.UserID, cmd.Emoji, 1, appcore.NewEventMetadata(ctx, cmd.UserID, cmd))
 	_ = uc.eventBus.Publish(ctx, evt)
 
 	return Result{
@@ -0,0 +1,86 @@
+package message
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/lllypuk/flowra/internal/application/appcore"
+	"github.com/lllypuk/flowra/internal/domain/errs"
+	"github.com/lllypuk/flowra/internal/domain/event"
+	messagedomain "github.com/lllypuk/flowra/internal/domain/message"
+)
+
+// ClosePollUseCase handles closing polls
+type ClosePollUseCase struct {
+	messageRepo Repository
+	chatRepo    ChatRepository
+	eventBus    event.Bus
+}
+
+// NewClosePollUseCase creates New ClosePollUseCase
+func NewClosePollUseCase(
+	messageRepo Repository,
+	chatRepo ChatRepository,
+	eventBus event.Bus,
+) *ClosePollUseCase {
+	return &ClosePollUseCase{
+		messageRepo: messageRepo,
+		chatRepo:    chatRepo,
+		eventBus:    eventBus,
+	}
+}
+
+// Execute stops the voting; only the poll author can close it
+func (uc *ClosePollUseCase) Execute(
+	ctx context.Context,
+	cmd ClosePollCommand,
+) (Result, error) {
+	// validation
+	if err := uc.validate(cmd); err != nil {
+		return Result{}, fmt.Errorf("validation failed: %w", err)
+	}
+
+	// load poll message
+	msg, err := findPollMessage(ctx, uc.messageRepo, uc.chatRepo, cmd.MessageID, cmd.UserID)
+	if err != nil {
+		return Result{}, err
+	}
+
+	// close poll
+	if closeErr := msg.ClosePoll(cmd.UserID); closeErr != nil {
+		switch {
+		case errors.Is(closeErr, errs.ErrForbidden):
+			return Result{}, ErrNotPollAuthor
+		case errors.Is(closeErr, errs.ErrInvalidState):
+			return Result{}, ErrPollClosed
+		}
+		return Result{}, closeErr
+	}
+
+	// save
+	if saveErr := uc.messageRepo.Save(ctx, msg); saveErr != nil {
+		return Result{}, fmt.Errorf("failed to save message: %w", saveErr)
+	}
+
+	// publish event with the final results
+	evt := messagedomain.NewPollClosed(
+		msg.ID(),
+		msg.ChatID(),
+		cmd.UserID,
+		msg.Poll(),
+		appcore.NewEventMetadata(ctx, cmd.UserID, cmd),
+	)
+	_ = uc.eventBus.Publish(ctx, evt)
+
+	return Result{
+		Value: msg,
+	}, nil
+}
+
+func (uc *ClosePollUseCase) validate(cmd ClosePollCommand) error {
+	if err := appcore.ValidateUUID("messageID", cmd.MessageID); err != nil {
+		return err
+	}
+	return appcore.ValidateUUID("userID", cmd.UserID)
+}
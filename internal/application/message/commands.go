@@ -1,6 +1,8 @@
 package message
 
 import (
+	"time"
+
 	messagedomain "github.com/lllypuk/flowra/internal/domain/message"
 	"github.com/lllypuk/flowra/internal/domain/uuid"
 )
@@ -11,6 +13,7 @@ type SendMessageCommand struct {
 	Content         string
 	AuthorID        uuid.UUID
 	ParentMessageID uuid.UUID          // for replies, zero UUID if not reply
+	QuotedMessageID uuid.UUID          // inline quote of a message in the same chat, zero UUID if none
 	Type            messagedomain.Type // message type (defaults to TypeUser)
 	ActorID         *uuid.UUID         // who initiated (for system messages)
 }
@@ -37,6 +40,15 @@ type DeleteMessageCommand struct {
 // CommandName returns command name
 func (c DeleteMessageCommand) CommandName() string { return "DeleteMessage" }
 
+// UndoMessageChangeCommand - undo the latest delete or edit of a message
+type UndoMessageChangeCommand struct {
+	MessageID uuid.UUID
+	UserID    uuid.UUID // must match AuthorID
+}
+
+// CommandName returns command name
+func (c UndoMessageChangeCommand) CommandName() string { return "UndoMessageChange" }
+
 // AddReactionCommand - add reactions
 type AddReactionCommand struct {
 	MessageID uuid.UUID
@@ -65,7 +77,85 @@ type AddAttachmentCommand struct {
 	FileSize  int64
 	MimeType  string
 	UserID    uuid.UUID // must match AuthorID
+
+	// Duration and Waveform describe audio attachments such as voice messages;
+	// an attachment with a duration is stored as audio
+	Duration time.Duration
+	Waveform []int
 }
 
 // CommandName returns command name
 func (c AddAttachmentCommand) CommandName() string { return "AddAttachment" }
+
+// ForwardMessageCommand - forward a message into another chat
+type ForwardMessageCommand struct {
+	MessageID    uuid.UUID
+	TargetChatID uuid.UUID
+	UserID       uuid.UUID // must be a participant of both chats
+	Comment      string    // optional note shown above the forwarded message
+}
+
+// CommandName returns command name
+func (c ForwardMessageCommand) CommandName() string { return "ForwardMessage" }
+
+// CreatePollCommand - create a poll message
+type CreatePollCommand struct {
+	ChatID    uuid.UUID
+	AuthorID  uuid.UUID
+	Question  string
+	Options   []string
+	Anonymous bool       // hide who voted for what
+	ClosesAt  *time.Time // optional automatic close time
+}
+
+// CommandName returns command name
+func (c CreatePollCommand) CommandName() string { return "CreatePoll" }
+
+// VotePollCommand - vote in a poll (replaces the previous vote of the user)
+type VotePollCommand struct {
+	MessageID uuid.UUID
+	OptionID  uuid.UUID
+	UserID    uuid.UUID
+}
+
+// CommandName returns command name
+func (c VotePollCommand) CommandName() string { return "VotePoll" }
+
+// RetractPollVoteCommand - retract the vote of the user
+type RetractPollVoteCommand struct {
+	MessageID uuid.UUID
+	UserID    uuid.UUID
+}
+
+// CommandName returns command name
+func (c RetractPollVoteCommand) CommandName() string { return "RetractPollVote" }
+
+// ClosePollCommand - stop the voting
+type ClosePollCommand struct {
+	MessageID uuid.UUID
+	UserID    uuid.UUID // must match AuthorID
+}
+
+// CommandName returns command name
+func (c ClosePollCommand) CommandName() string { return "ClosePoll" }
+
+// ImportMessagesCommand - backfill of chat history migrated from another system
+type ImportMessagesCommand struct {
+	ChatID   uuid.UUID
+	Authors  map[string]uuid.UUID // author names of the source system mapped to users
+	Messages []ImportMessageRow
+	DryRun   bool // validate the messages without saving them
+}
+
+// CommandName returns command name
+func (c ImportMessagesCommand) CommandName() string { return "ImportMessages" }
+
+// ImportMessageRow is one historical message to import
+type ImportMessageRow struct {
+	ExternalID       string // ID in the source system, optional; replies in the batch refer to it
+	Author           string // key of Authors
+	Content          string
+	CreatedAt        time.Time // original time, kept as the creation time
+	ParentExternalID string    // for replies to an earlier message of the batch
+	ParentMessageID  uuid.UUID // for replies to a message imported before
+}
@@ -0,0 +1,144 @@
+package message
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/lllypuk/flowra/internal/application/appcore"
+	"github.com/lllypuk/flowra/internal/domain/event"
+	messagedomain "github.com/lllypuk/flowra/internal/domain/message"
+	"github.com/lllypuk/flowra/internal/domain/uuid"
+)
+
+// CreatePollUseCase handles creating poll messages
+type CreatePollUseCase struct {
+	messageRepo Repository
+	chatRepo    ChatRepository
+	eventBus    event.Bus
+	logger      *slog.Logger
+}
+
+// NewCreatePollUseCase creates New CreatePollUseCase
+func NewCreatePollUseCase(
+	messageRepo Repository,
+	chatRepo ChatRepository,
+	eventBus event.Bus,
+) *CreatePollUseCase {
+	return &CreatePollUseCase{
+		messageRepo: messageRepo,
+		chatRepo:    chatRepo,
+		eventBus:    eventBus,
+		logger:      slog.Default(),
+	}
+}
+
+// Execute creates the poll as a new message in the chat.
+// The question becomes the message content; tags in it are not executed.
+func (uc *CreatePollUseCase) Execute(
+	ctx context.Context,
+	cmd CreatePollCommand,
+) (Result, error) {
+	// 1. validation
+	if err := uc.validate(cmd); err != nil {
+		return Result{}, fmt.Errorf("validation failed: %w", err)
+	}
+
+	// 2. check access to chat
+	chatReadModel, err := uc.chatRepo.FindByID(ctx, cmd.ChatID)
+	if err != nil {
+		return Result{}, ErrChatNotFound
+	}
+	if !isChatParticipant(chatReadModel, cmd.AuthorID) {
+		return Result{}, ErrNotChatParticipant
+	}
+
+	// 3. create poll message
+	poll, err := messagedomain.NewPoll(cmd.Question, cmd.Options, cmd.Anonymous, cmd.ClosesAt)
+	if err != nil {
+		return Result{}, ErrInvalidPoll
+	}
+	msg, err := messagedomain.NewPollMessage(cmd.ChatID, cmd.AuthorID, poll)
+	if err != nil {
+		return Result{}, fmt.Errorf("failed to create poll message: %w", err)
+	}
+
+	// 4. save
+	if saveErr := uc.messageRepo.Save(ctx, msg); saveErr != nil {
+		return Result{}, fmt.Errorf("failed to save message: %w", saveErr)
+	}
+
+	// 5. publish event (for WebSocket broadcast)
+	evt := messagedomain.NewCreated(
+		msg.ID(),
+		cmd.ChatID,
+		cmd.AuthorID,
+		msg.Content(),
+		msg.ParentMessageID(),
+		appcore.NewEventMetadata(ctx, cmd.AuthorID, cmd),
+	)
+	// not critical, message already saved
+	if pubErr := uc.eventBus.Publish(ctx, evt); pubErr != nil {
+		uc.logger.WarnContext(ctx, "failed to publish poll created event",
+			slog.String("message_id", msg.ID().String()),
+			slog.String("error", pubErr.Error()),
+		)
+	}
+
+	return Result{
+		Value: msg,
+	}, nil
+}
+
+func (uc *CreatePollUseCase) validate(cmd CreatePollCommand) error {
+	if err := appcore.ValidateUUID("chatID", cmd.ChatID); err != nil {
+		return err
+	}
+	if err := appcore.ValidateUUID("authorID", cmd.AuthorID); err != nil {
+		return err
+	}
+	if err := appcore.ValidateRequired("question", cmd.Question); err != nil {
+		return ErrInvalidPoll
+	}
+	return nil
+}
+
+// findPollMessage loads the poll message and checks that the user participates in its chat
+func findPollMessage(
+	ctx context.Context,
+	messageRepo Repository,
+	chatRepo ChatRepository,
+	messageID uuid.UUID,
+	userID uuid.UUID,
+) (*messagedomain.Message, error) {
+	msg, err := messageRepo.FindByID(ctx, messageID)
+	if err != nil {
+		return nil, ErrMessageNotFound
+	}
+	if msg.IsDeleted() {
+		return nil, ErrMessageDeleted
+	}
+	if !msg.HasPoll() {
+		return nil, ErrNotAPoll
+	}
+
+	chatReadModel, err := chatRepo.FindByID(ctx, msg.ChatID())
+	if err != nil {
+		return nil, ErrChatNotFound
+	}
+	if !isChatParticipant(chatReadModel, userID) {
+		return nil, ErrNotChatParticipant
+	}
+	return msg, nil
+}
+
+// mapPollError translates poll domain errors to application errors
+func mapPollError(err error) error {
+	switch {
+	case errors.Is(err, messagedomain.ErrPollClosed):
+		return ErrPollClosed
+	default:
+		return err
+	}
+}
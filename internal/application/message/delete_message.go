@@ -53,10 +53,7 @@ func (uc *DeleteMessageUseCase) Execute(
 	}
 
 	// publish event
-	evt := message.NewDeleted(msg.ID(), cmd.DeletedBy, 1, event.Metadata{
-		UserID:    cmd.DeletedBy.String(),
-		Timestamp: *msg.DeletedAt(),
-	})
+	evt := message.NewDeleted(msg.ID(), cmd.DeletedBy, 1, appcore.NewEventMetadata(ctx, cmd.DeletedBy, cmd))
 	_ = uc.eventBus.Publish(ctx, evt)
 
 	return Result{
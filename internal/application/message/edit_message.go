@@ -58,10 +58,7 @@ func (uc *EditMessageUseCase) Execute(
 	}
 
 	// publish event
-	evt := message.NewEdited(msg.ID(), cmd.Content, 1, event.Metadata{
-		UserID:    cmd.EditorID.String(),
-		Timestamp: *msg.EditedAt(),
-	})
+	evt := message.NewEdited(msg.ID(), cmd.Content, 1, appcore.NewEventMetadata(ctx, cmd.EditorID, cmd))
 	_ = uc.eventBus.Publish(ctx, evt)
 
 	return Result{
@@ -55,6 +55,13 @@ var (
 		httpCode:   "INVALID_MIME_TYPE",
 		httpMsg:    "mime type is required",
 	}
+	// ErrInvalidAudioMetadata indicates that the duration or waveform of an audio attachment is invalid
+	ErrInvalidAudioMetadata = &appError{
+		msg:        "invalid audio metadata",
+		httpStatus: http.StatusBadRequest,
+		httpCode:   "INVALID_AUDIO_METADATA",
+		httpMsg:    "audio attachments need an audio type, a duration and a valid waveform",
+	}
 
 	// ErrMessageNotFound indicates that message was not found
 	ErrMessageNotFound = &appError{
@@ -75,6 +82,12 @@ var (
 		httpCode:   "PARENT_NOT_FOUND",
 		httpMsg:    "parent message not found",
 	}
+	ErrQuotedNotFound = &appError{
+		msg:        "quoted message not found",
+		httpStatus: http.StatusBadRequest,
+		httpCode:   "QUOTED_NOT_FOUND",
+		httpMsg:    "quoted message not found",
+	}
 	ErrNotAuthor = &appError{
 		msg:        "user is not the message author",
 		httpStatus: http.StatusForbidden,
@@ -87,6 +100,20 @@ var (
 		httpCode:   "MESSAGE_DELETED",
 		httpMsg:    "message is deleted",
 	}
+	// ErrNothingToUndo indicates that the message has no delete or edit to undo
+	ErrNothingToUndo = &appError{
+		msg:        "nothing to undo",
+		httpStatus: http.StatusConflict,
+		httpCode:   "NOTHING_TO_UNDO",
+		httpMsg:    "the message has no change to undo",
+	}
+	// ErrUndoWindowExpired indicates that the change is too old to be undone
+	ErrUndoWindowExpired = &appError{
+		msg:        "undo window has expired",
+		httpStatus: http.StatusConflict,
+		httpCode:   "UNDO_WINDOW_EXPIRED",
+		httpMsg:    "the change can no longer be undone",
+	}
 	ErrReactionAlreadyExists = &appError{
 		msg:        "reaction already exists",
 		httpStatus: http.StatusConflict,
@@ -105,6 +132,55 @@ var (
 		httpCode:   "PARENT_DIFFERENT_CHAT",
 		httpMsg:    "parent message is from different chat",
 	}
+	ErrQuoteInDifferentChat = &appError{
+		msg:        "quoted message is from different chat",
+		httpStatus: http.StatusBadRequest,
+		httpCode:   "QUOTE_DIFFERENT_CHAT",
+		httpMsg:    "quoted message is from different chat",
+	}
+
+	// ErrInvalidPoll indicates that the poll question or options are invalid
+	ErrInvalidPoll = &appError{
+		msg:        "invalid poll",
+		httpStatus: http.StatusBadRequest,
+		httpCode:   "INVALID_POLL",
+		httpMsg:    "a poll needs a question and 2-10 unique options, and the close time must be in the future",
+	}
+	// ErrNotAPoll indicates that the message has no poll
+	ErrNotAPoll = &appError{
+		msg:        "message is not a poll",
+		httpStatus: http.StatusBadRequest,
+		httpCode:   "NOT_A_POLL",
+		httpMsg:    "message is not a poll",
+	}
+	// ErrPollOptionNotFound indicates that the option does not belong to the poll
+	ErrPollOptionNotFound = &appError{
+		msg:        "poll option not found",
+		httpStatus: http.StatusBadRequest,
+		httpCode:   "POLL_OPTION_NOT_FOUND",
+		httpMsg:    "poll option not found",
+	}
+	// ErrPollClosed indicates that the poll no longer accepts votes
+	ErrPollClosed = &appError{
+		msg:        "poll is closed",
+		httpStatus: http.StatusConflict,
+		httpCode:   "POLL_CLOSED",
+		httpMsg:    "poll is closed",
+	}
+	// ErrPollVoteNotFound indicates that the user has not voted
+	ErrPollVoteNotFound = &appError{
+		msg:        "poll vote not found",
+		httpStatus: http.StatusNotFound,
+		httpCode:   "POLL_VOTE_NOT_FOUND",
+		httpMsg:    "you have not voted in this poll",
+	}
+	// ErrNotPollAuthor indicates that only the poll author can close the poll
+	ErrNotPollAuthor = &appError{
+		msg:        "user is not the poll author",
+		httpStatus: http.StatusForbidden,
+		httpCode:   "NOT_AUTHOR",
+		httpMsg:    "only poll author can close the poll",
+	}
 
 	// ErrNotChatParticipant indicates that user is not a chat participant
 	ErrNotChatParticipant = &appError{
@@ -113,6 +189,13 @@ var (
 		httpCode:   "NOT_PARTICIPANT",
 		httpMsg:    "not a participant of this chat",
 	}
+	// ErrForwardAcrossWorkspaces indicates that the target chat belongs to another workspace
+	ErrForwardAcrossWorkspaces = &appError{
+		msg:        "cannot forward message to another workspace",
+		httpStatus: http.StatusBadRequest,
+		httpCode:   "FORWARD_ACROSS_WORKSPACES",
+		httpMsg:    "messages can only be forwarded within the same workspace",
+	}
 )
 
 const (
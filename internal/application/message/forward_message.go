@@ -0,0 +1,194 @@
+package message
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/lllypuk/flowra/internal/application/appcore"
+	chatapp "github.com/lllypuk/flowra/internal/application/chat"
+	"github.com/lllypuk/flowra/internal/domain/event"
+	messagedomain "github.com/lllypuk/flowra/internal/domain/message"
+	"github.com/lllypuk/flowra/internal/domain/uuid"
+)
+
+// unknownAuthorName is used in the attribution when the original author cannot be resolved
+const unknownAuthorName = "unknown user"
+
+// ForwardMessageUseCase handles forwarding a message into another chat
+type ForwardMessageUseCase struct {
+	messageRepo  Repository
+	chatRepo     ChatRepository
+	userResolver UserDisplayNameResolver // For the "Forwarded from" attribution
+	eventBus     event.Bus
+	logger       *slog.Logger
+}
+
+// NewForwardMessageUseCase creates New ForwardMessageUseCase
+func NewForwardMessageUseCase(
+	messageRepo Repository,
+	chatRepo ChatRepository,
+	userResolver UserDisplayNameResolver,
+	eventBus event.Bus,
+) *ForwardMessageUseCase {
+	return &ForwardMessageUseCase{
+		messageRepo:  messageRepo,
+		chatRepo:     chatRepo,
+		userResolver: userResolver,
+		eventBus:     eventBus,
+		logger:       slog.Default(),
+	}
+}
+
+// Execute copies the message into the target chat on behalf of the user.
+// The copy is a regular user message authored by the forwarding user; it quotes
+// the original content, names the original author and links back to the source.
+// Tags in the forwarded content are not executed.
+func (uc *ForwardMessageUseCase) Execute(
+	ctx context.Context,
+	cmd ForwardMessageCommand,
+) (Result, error) {
+	// 1. validation
+	if err := uc.validate(cmd); err != nil {
+		return Result{}, fmt.Errorf("validation failed: %w", err)
+	}
+
+	// 2. load source message
+	source, err := uc.messageRepo.FindByID(ctx, cmd.MessageID)
+	if err != nil {
+		return Result{}, ErrMessageNotFound
+	}
+	if source.IsDeleted() {
+		return Result{}, ErrMessageDeleted
+	}
+
+	// 3. the user must be able to read the source and post in the target
+	sourceChat, err := uc.chatRepo.FindByID(ctx, source.ChatID())
+	if err != nil {
+		return Result{}, ErrChatNotFound
+	}
+	if !isChatParticipant(sourceChat, cmd.UserID) {
+		return Result{}, ErrNotChatParticipant
+	}
+
+	targetChat, err := uc.chatRepo.FindByID(ctx, cmd.TargetChatID)
+	if err != nil {
+		return Result{}, ErrChatNotFound
+	}
+	if !isChatParticipant(targetChat, cmd.UserID) {
+		return Result{}, ErrNotChatParticipant
+	}
+	if sourceChat.WorkspaceID != targetChat.WorkspaceID {
+		return Result{}, ErrForwardAcrossWorkspaces
+	}
+
+	// 4. build the forwarded copy
+	content := forwardedContent(
+		cmd.Comment,
+		uc.authorName(ctx, source.AuthorID()),
+		Permalink(source.ChatID(), source.ID()),
+		source.Content(),
+	)
+	if len(content) > MaxContentLength {
+		return Result{}, ErrContentTooLong
+	}
+
+	msg, err := messagedomain.NewMessage(cmd.TargetChatID, cmd.UserID, content, uuid.UUID(""))
+	if err != nil {
+		return Result{}, fmt.Errorf("failed to create message: %w", err)
+	}
+	for _, a := range source.Attachments() {
+		var attachErr error
+		if a.Duration() > 0 {
+			attachErr = msg.AddAudioAttachment(
+				a.FileID(), a.FileName(), a.FileSize(), a.MimeType(), a.Duration(), a.Waveform())
+		} else {
+			attachErr = msg.AddAttachment(a.FileID(), a.FileName(), a.FileSize(), a.MimeType())
+		}
+		if attachErr != nil {
+			return Result{}, fmt.Errorf("failed to copy attachment: %w", attachErr)
+		}
+	}
+
+	// 5. save
+	if saveErr := uc.messageRepo.Save(ctx, msg); saveErr != nil {
+		return Result{}, fmt.Errorf("failed to save message: %w", saveErr)
+	}
+
+	// 6. publish events: Created for WebSocket broadcast, Forwarded for the audit trail
+	metadata := appcore.NewEventMetadata(ctx, cmd.UserID, cmd)
+	events := []event.DomainEvent{
+		messagedomain.NewCreated(msg.ID(), cmd.TargetChatID, cmd.UserID, content, uuid.UUID(""), metadata),
+		messagedomain.NewForwarded(msg.ID(), cmd.TargetChatID, source.ID(), source.ChatID(), cmd.UserID, metadata),
+	}
+	for _, evt := range events {
+		// not critical, message already saved
+		if pubErr := uc.eventBus.Publish(ctx, evt); pubErr != nil {
+			uc.logger.WarnContext(ctx, "failed to publish message event",
+				slog.String("event_type", evt.EventType()),
+				slog.String("message_id", msg.ID().String()),
+				slog.String("chat_id", cmd.TargetChatID.String()),
+				slog.String("error", pubErr.Error()),
+			)
+		}
+	}
+
+	return Result{
+		Value: msg,
+	}, nil
+}
+
+func (uc *ForwardMessageUseCase) validate(cmd ForwardMessageCommand) error {
+	if err := appcore.ValidateUUID("messageID", cmd.MessageID); err != nil {
+		return err
+	}
+	if err := appcore.ValidateUUID("targetChatID", cmd.TargetChatID); err != nil {
+		return err
+	}
+	if err := appcore.ValidateUUID("userID", cmd.UserID); err != nil {
+		return err
+	}
+	if len(cmd.Comment) > MaxContentLength {
+		return ErrContentTooLong
+	}
+	return nil
+}
+
+func (uc *ForwardMessageUseCase) authorName(ctx context.Context, authorID uuid.UUID) string {
+	if uc.userResolver == nil {
+		return unknownAuthorName
+	}
+	name, err := uc.userResolver.GetDisplayName(ctx, authorID)
+	if err != nil || name == "" {
+		return unknownAuthorName
+	}
+	return name
+}
+
+// isChatParticipant reports whether the user is a participant of the chat
+func isChatParticipant(chatReadModel *chatapp.ReadModel, userID uuid.UUID) bool {
+	for _, p := range chatReadModel.Participants {
+		if p.UserID() == userID {
+			return true
+		}
+	}
+	return false
+}
+
+// forwardedContent formats the forwarded copy: the optional comment, the
+// attribution line with a link to the original, and the quoted original text.
+// Quoting every line keeps tags in the original from being parsed again.
+func forwardedContent(comment, authorName, link, original string) string {
+	var b strings.Builder
+	if comment = strings.TrimSpace(comment); comment != "" {
+		b.WriteString(comment)
+		b.WriteString("\n\n")
+	}
+	fmt.Fprintf(&b, "Forwarded from %s (%s):", authorName, link)
+	for line := range strings.SplitSeq(original, "\n") {
+		b.WriteString("\n> ")
+		b.WriteString(line)
+	}
+	return b.String()
+}
@@ -0,0 +1,169 @@
+package message_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lllypuk/flowra/internal/application/message"
+	domainMessage "github.com/lllypuk/flowra/internal/domain/message"
+	"github.com/lllypuk/flowra/internal/domain/uuid"
+)
+
+type stubDisplayNameResolver map[uuid.UUID]string
+
+func (s stubDisplayNameResolver) GetDisplayName(_ context.Context, userID uuid.UUID) (string, error) {
+	return s[userID], nil
+}
+
+type forwardFixture struct {
+	messageRepo  *message.MockMessageRepository
+	chatRepo     *message.MockChatRepository
+	eventBus     *message.MockEventBus
+	useCase      *message.ForwardMessageUseCase
+	source       *domainMessage.Message
+	sourceChatID uuid.UUID
+	targetChatID uuid.UUID
+	authorID     uuid.UUID
+	userID       uuid.UUID
+}
+
+func newForwardFixture(t *testing.T) *forwardFixture {
+	t.Helper()
+
+	f := &forwardFixture{
+		messageRepo:  message.NewMockMessageRepository(),
+		chatRepo:     message.NewMockChatRepository(),
+		eventBus:     message.NewMockEventBus(),
+		sourceChatID: uuid.NewUUID(),
+		targetChatID: uuid.NewUUID(),
+		authorID:     uuid.NewUUID(),
+		userID:       uuid.NewUUID(),
+	}
+	f.chatRepo.AddChat(f.sourceChatID, []uuid.UUID{f.authorID, f.userID})
+	f.chatRepo.AddChat(f.targetChatID, []uuid.UUID{f.userID})
+
+	source, err := domainMessage.NewMessage(f.sourceChatID, f.authorID, "Deploy is done\n#status Done", "")
+	require.NoError(t, err)
+	require.NoError(t, source.AddAttachment(uuid.NewUUID(), "log.txt", 128, "text/plain"))
+	f.messageRepo.Messages[source.ID()] = source
+	f.source = source
+
+	f.useCase = message.NewForwardMessageUseCase(
+		f.messageRepo, f.chatRepo, stubDisplayNameResolver{f.authorID: "Alice"}, f.eventBus,
+	)
+	return f
+}
+
+func (f *forwardFixture) command() message.ForwardMessageCommand {
+	return message.ForwardMessageCommand{
+		MessageID:    f.source.ID(),
+		TargetChatID: f.targetChatID,
+		UserID:       f.userID,
+	}
+}
+
+func TestForwardMessageUseCase_Success(t *testing.T) {
+	f := newForwardFixture(t)
+	cmd := f.command()
+	cmd.Comment = "FYI"
+
+	result, err := f.useCase.Execute(context.Background(), cmd)
+	require.NoError(t, err)
+
+	msg := result.Value
+	assert.Equal(t, f.targetChatID, msg.ChatID())
+	assert.Equal(t, f.userID, msg.AuthorID())
+	assert.Equal(t, domainMessage.TypeUser, msg.Type())
+	assert.Len(t, msg.Attachments(), 1)
+
+	content := msg.Content()
+	assert.True(t, strings.HasPrefix(content, "FYI\n\nForwarded from Alice ("))
+	assert.Contains(t, content, "("+message.Permalink(f.sourceChatID, f.source.ID())+"):")
+	assert.Contains(t, content, "\n> Deploy is done\n> #status Done")
+
+	assert.Len(t, f.messageRepo.Messages, 2)
+	require.Len(t, f.eventBus.Published, 2)
+	assert.Equal(t, domainMessage.EventTypeMessageCreated, f.eventBus.Published[0].EventType())
+
+	forwarded, ok := f.eventBus.Published[1].(*domainMessage.Forwarded)
+	require.True(t, ok)
+	assert.Equal(t, msg.ID().String(), forwarded.AggregateID())
+	assert.Equal(t, f.source.ID(), forwarded.SourceMessageID)
+	assert.Equal(t, f.sourceChatID, forwarded.SourceChatID)
+	assert.Equal(t, f.userID, forwarded.ForwardedBy)
+}
+
+func TestForwardMessageUseCase_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		prepare func(f *forwardFixture, cmd *message.ForwardMessageCommand)
+		wantErr error
+	}{
+		{
+			name: "message not found",
+			prepare: func(_ *forwardFixture, cmd *message.ForwardMessageCommand) {
+				cmd.MessageID = uuid.NewUUID()
+			},
+			wantErr: message.ErrMessageNotFound,
+		},
+		{
+			name: "message deleted",
+			prepare: func(f *forwardFixture, _ *message.ForwardMessageCommand) {
+				_ = f.source.Delete(f.authorID)
+			},
+			wantErr: message.ErrMessageDeleted,
+		},
+		{
+			name: "not a participant of the source chat",
+			prepare: func(f *forwardFixture, _ *message.ForwardMessageCommand) {
+				f.chatRepo.AddChat(f.sourceChatID, []uuid.UUID{f.authorID})
+			},
+			wantErr: message.ErrNotChatParticipant,
+		},
+		{
+			name: "not a participant of the target chat",
+			prepare: func(f *forwardFixture, _ *message.ForwardMessageCommand) {
+				f.chatRepo.AddChat(f.targetChatID, []uuid.UUID{f.authorID})
+			},
+			wantErr: message.ErrNotChatParticipant,
+		},
+		{
+			name: "target chat not found",
+			prepare: func(_ *forwardFixture, cmd *message.ForwardMessageCommand) {
+				cmd.TargetChatID = uuid.NewUUID()
+			},
+			wantErr: message.ErrChatNotFound,
+		},
+		{
+			name: "target chat in another workspace",
+			prepare: func(f *forwardFixture, _ *message.ForwardMessageCommand) {
+				f.chatRepo.Chats[f.targetChatID.String()].WorkspaceID = uuid.NewUUID()
+			},
+			wantErr: message.ErrForwardAcrossWorkspaces,
+		},
+		{
+			name: "comment too long",
+			prepare: func(_ *forwardFixture, cmd *message.ForwardMessageCommand) {
+				cmd.Comment = strings.Repeat("a", message.MaxContentLength+1)
+			},
+			wantErr: message.ErrContentTooLong,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newForwardFixture(t)
+			cmd := f.command()
+			tt.prepare(f, &cmd)
+
+			_, err := f.useCase.Execute(context.Background(), cmd)
+			require.ErrorIs(t, err, tt.wantErr)
+			assert.Len(t, f.messageRepo.Messages, 1)
+			assert.Empty(t, f.eventBus.Published)
+		})
+	}
+}
@@ -0,0 +1,218 @@
+package message
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/lllypuk/flowra/internal/application/appcore"
+	messagedomain "github.com/lllypuk/flowra/internal/domain/message"
+	"github.com/lllypuk/flowra/internal/domain/uuid"
+)
+
+// MaxImportMessages is the maximum number of messages in one import
+const MaxImportMessages = 1000
+
+// UserChecker checks that users exist.
+// Declared on the consumer side per project guidelines.
+type UserChecker interface {
+	Exists(ctx context.Context, userID uuid.UUID) (bool, error)
+}
+
+// ImportMessagesUseCase backfills chat history migrated from another system.
+// Messages keep their original authors and times. They are saved directly,
+// without publishing events, so the import sends no notifications, no WebSocket
+// broadcasts and runs no tag commands. Messages are validated and saved one by
+// one; a failed message does not stop the import.
+type ImportMessagesUseCase struct {
+	messageRepo Repository
+	chatRepo    ChatRepository
+	users       UserChecker
+}
+
+// NewImportMessagesUseCase creates New ImportMessagesUseCase
+func NewImportMessagesUseCase(
+	messageRepo Repository,
+	chatRepo ChatRepository,
+	users UserChecker,
+) *ImportMessagesUseCase {
+	return &ImportMessagesUseCase{
+		messageRepo: messageRepo,
+		chatRepo:    chatRepo,
+		users:       users,
+	}
+}
+
+// importBatch is the state of one import: the users already checked and the
+// messages of the batch replies can refer to.
+type importBatch struct {
+	cmd         ImportMessagesCommand
+	knownUsers  map[uuid.UUID]bool
+	externalIDs map[string]uuid.UUID // external ID -> message ID, zero in a dry run
+}
+
+// Execute imports the messages and reports the outcome of each
+func (uc *ImportMessagesUseCase) Execute(
+	ctx context.Context,
+	cmd ImportMessagesCommand,
+) (ImportMessagesResult, error) {
+	if err := appcore.ValidateUUID("chatID", cmd.ChatID); err != nil {
+		return ImportMessagesResult{}, err
+	}
+	if len(cmd.Messages) == 0 {
+		return ImportMessagesResult{}, appcore.NewValidationError("messages", "no messages to import")
+	}
+	if len(cmd.Messages) > MaxImportMessages {
+		return ImportMessagesResult{}, appcore.NewValidationError(
+			"messages", fmt.Sprintf("at most %d messages can be imported at once", MaxImportMessages))
+	}
+	if _, err := uc.chatRepo.FindByID(ctx, cmd.ChatID); err != nil {
+		return ImportMessagesResult{}, ErrChatNotFound
+	}
+
+	batch := &importBatch{
+		cmd:         cmd,
+		knownUsers:  make(map[uuid.UUID]bool),
+		externalIDs: make(map[string]uuid.UUID, len(cmd.Messages)),
+	}
+	result := ImportMessagesResult{DryRun: cmd.DryRun, Rows: make([]ImportRowResult, 0, len(cmd.Messages))}
+
+	for i, row := range cmd.Messages {
+		if err := ctx.Err(); err != nil {
+			return ImportMessagesResult{}, err
+		}
+
+		row.ExternalID = strings.TrimSpace(row.ExternalID)
+		res := ImportRowResult{Index: i, ExternalID: row.ExternalID}
+
+		msg, err := uc.build(ctx, batch, row)
+		switch {
+		case err != nil:
+			res.Status, res.Error = ImportStatusFailed, err.Error()
+		case cmd.DryRun:
+			res.Status = ImportStatusValid
+		default:
+			if saveErr := uc.messageRepo.Save(ctx, msg); saveErr != nil {
+				res.Status, res.Error = ImportStatusFailed, fmt.Sprintf("failed to save message: %v", saveErr)
+			} else {
+				res.Status, res.MessageID = ImportStatusImported, msg.ID()
+			}
+		}
+
+		if row.ExternalID != "" && res.Status != ImportStatusFailed {
+			batch.externalIDs[row.ExternalID] = res.MessageID
+		}
+
+		switch res.Status {
+		case ImportStatusImported:
+			result.Imported++
+		case ImportStatusValid:
+			result.Valid++
+		case ImportStatusFailed:
+			result.Failed++
+		}
+		result.Rows = append(result.Rows, res)
+	}
+
+	return result, nil
+}
+
+// build validates the row and creates its message
+func (uc *ImportMessagesUseCase) build(
+	ctx context.Context,
+	batch *importBatch,
+	row ImportMessageRow,
+) (*messagedomain.Message, error) {
+	if row.ExternalID != "" {
+		if _, seen := batch.externalIDs[row.ExternalID]; seen {
+			return nil, errors.New("external_id repeats an earlier message")
+		}
+	}
+	if strings.TrimSpace(row.Content) == "" {
+		return nil, ErrEmptyContent
+	}
+	if len(row.Content) > MaxContentLength {
+		return nil, ErrContentTooLong
+	}
+	if row.CreatedAt.IsZero() {
+		return nil, appcore.NewValidationError("created_at", "is required")
+	}
+	if row.CreatedAt.After(time.Now()) {
+		return nil, appcore.NewValidationError("created_at", "must not be in the future")
+	}
+
+	authorID, err := uc.resolveAuthor(ctx, batch, row.Author)
+	if err != nil {
+		return nil, err
+	}
+	parentID, err := uc.resolveParent(ctx, batch, row)
+	if err != nil {
+		return nil, err
+	}
+
+	msg, err := messagedomain.NewImportedMessage(batch.cmd.ChatID, authorID, row.Content, parentID, row.CreatedAt)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create message: %w", err)
+	}
+	return msg, nil
+}
+
+// resolveAuthor maps the author name of the source system to an existing user
+func (uc *ImportMessagesUseCase) resolveAuthor(
+	ctx context.Context,
+	batch *importBatch,
+	author string,
+) (uuid.UUID, error) {
+	if author == "" {
+		return "", appcore.NewValidationError("author", "is required")
+	}
+	authorID, ok := batch.cmd.Authors[author]
+	if !ok || authorID.IsZero() {
+		return "", fmt.Errorf("author %q is not mapped to a user", author)
+	}
+
+	exists, checked := batch.knownUsers[authorID]
+	if !checked {
+		var err error
+		exists, err = uc.users.Exists(ctx, authorID)
+		if err != nil {
+			return "", fmt.Errorf("failed to check author: %w", err)
+		}
+		batch.knownUsers[authorID] = exists
+	}
+	if !exists {
+		return "", fmt.Errorf("author %q is mapped to unknown user %s", author, authorID)
+	}
+	return authorID, nil
+}
+
+// resolveParent returns the message the row replies to, zero if it is no reply.
+// In a dry run, parents from the batch have no ID yet, which does not matter as
+// nothing is saved.
+func (uc *ImportMessagesUseCase) resolveParent(
+	ctx context.Context,
+	batch *importBatch,
+	row ImportMessageRow,
+) (uuid.UUID, error) {
+	if parentExternalID := strings.TrimSpace(row.ParentExternalID); parentExternalID != "" {
+		parentID, ok := batch.externalIDs[parentExternalID]
+		if !ok {
+			return "", fmt.Errorf("parent %q is not an earlier message of the batch", parentExternalID)
+		}
+		return parentID, nil
+	}
+
+	if row.ParentMessageID.IsZero() {
+		return "", nil
+	}
+	parent, err := uc.messageRepo.FindByID(ctx, row.ParentMessageID)
+	if err != nil {
+		return "", ErrParentNotFound
+	}
+	if parent.ChatID() != batch.cmd.ChatID {
+		return "", ErrParentInDifferentChat
+	}
+	return parent.ID(), nil
+}
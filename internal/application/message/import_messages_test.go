@@ -0,0 +1,165 @@
+package message_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lllypuk/flowra/internal/application/message"
+	domainMessage "github.com/lllypuk/flowra/internal/domain/message"
+	"github.com/lllypuk/flowra/internal/domain/uuid"
+)
+
+// stubUserChecker knows a fixed set of users and counts its lookups
+type stubUserChecker struct {
+	users   map[uuid.UUID]bool
+	lookups int
+}
+
+func (s *stubUserChecker) Exists(_ context.Context, userID uuid.UUID) (bool, error) {
+	s.lookups++
+	return s.users[userID], nil
+}
+
+type importFixture struct {
+	messageRepo *message.MockMessageRepository
+	users       *stubUserChecker
+	useCase     *message.ImportMessagesUseCase
+	chatID      uuid.UUID
+	alice       uuid.UUID
+	bob         uuid.UUID
+}
+
+func newImportFixture() *importFixture {
+	f := &importFixture{
+		messageRepo: message.NewMockMessageRepository(),
+		chatID:      uuid.NewUUID(),
+		alice:       uuid.NewUUID(),
+		bob:         uuid.NewUUID(),
+	}
+	chatRepo := message.NewMockChatRepository()
+	// authors of the history need not be participants any more
+	chatRepo.AddChat(f.chatID, nil)
+	f.users = &stubUserChecker{users: map[uuid.UUID]bool{f.alice: true, f.bob: true}}
+	f.useCase = message.NewImportMessagesUseCase(f.messageRepo, chatRepo, f.users)
+	return f
+}
+
+func (f *importFixture) command(rows ...message.ImportMessageRow) message.ImportMessagesCommand {
+	return message.ImportMessagesCommand{
+		ChatID:   f.chatID,
+		Authors:  map[string]uuid.UUID{"U1": f.alice, "U2": f.bob, "U3": uuid.NewUUID()},
+		Messages: rows,
+	}
+}
+
+func TestImportMessagesUseCase(t *testing.T) {
+	first := time.Date(2020, 1, 2, 9, 0, 0, 0, time.UTC)
+
+	t.Run("saves messages with their original authors and times", func(t *testing.T) {
+		f := newImportFixture()
+
+		result, err := f.useCase.Execute(t.Context(), f.command(
+			message.ImportMessageRow{ExternalID: "m1", Author: "U1", Content: "hello", CreatedAt: first},
+			message.ImportMessageRow{
+				ExternalID: "m2", Author: "U2", Content: "hi", CreatedAt: first.Add(time.Minute),
+				ParentExternalID: "m1",
+			},
+		))
+
+		require.NoError(t, err)
+		assert.Equal(t, 2, result.Imported)
+		assert.Zero(t, result.Failed)
+		require.Len(t, result.Rows, 2)
+		assert.Equal(t, message.ImportStatusImported, result.Rows[0].Status)
+
+		root := f.messageRepo.Messages[result.Rows[0].MessageID]
+		require.NotNil(t, root)
+		assert.Equal(t, f.alice, root.AuthorID())
+		assert.True(t, root.CreatedAt().Equal(first))
+		assert.Equal(t, domainMessage.TypeUser, root.Type())
+
+		reply := f.messageRepo.Messages[result.Rows[1].MessageID]
+		require.NotNil(t, reply)
+		assert.Equal(t, f.bob, reply.AuthorID())
+		assert.Equal(t, root.ID(), reply.ParentMessageID())
+		assert.Equal(t, 2, f.users.lookups, "each author is looked up once")
+	})
+
+	t.Run("reports failed messages and imports the rest", func(t *testing.T) {
+		f := newImportFixture()
+
+		result, err := f.useCase.Execute(t.Context(), f.command(
+			message.ImportMessageRow{ExternalID: "m1", Author: "U1", Content: "ok", CreatedAt: first},
+			message.ImportMessageRow{ExternalID: "m1", Author: "U1", Content: "dup", CreatedAt: first},
+			message.ImportMessageRow{Author: "U9", Content: "unmapped", CreatedAt: first},
+			message.ImportMessageRow{Author: "U3", Content: "unknown user", CreatedAt: first},
+			message.ImportMessageRow{Author: "U1", Content: "", CreatedAt: first},
+			message.ImportMessageRow{Author: "U1", Content: "no time"},
+			message.ImportMessageRow{Author: "U1", Content: "future", CreatedAt: time.Now().Add(time.Hour)},
+			message.ImportMessageRow{Author: "U1", Content: "orphan", CreatedAt: first, ParentExternalID: "m0"},
+		))
+
+		require.NoError(t, err)
+		assert.Equal(t, 1, result.Imported)
+		assert.Equal(t, 7, result.Failed)
+		assert.Len(t, f.messageRepo.Messages, 1)
+		for _, row := range result.Rows[1:] {
+			assert.Equal(t, message.ImportStatusFailed, row.Status)
+			assert.NotEmpty(t, row.Error)
+		}
+		assert.Equal(t, 7, result.Rows[7].Index)
+	})
+
+	t.Run("replies to a message imported before", func(t *testing.T) {
+		f := newImportFixture()
+		parent, err := domainMessage.NewImportedMessage(f.chatID, f.alice, "earlier batch", "", first)
+		require.NoError(t, err)
+		require.NoError(t, f.messageRepo.Save(t.Context(), parent))
+
+		otherChat, err := domainMessage.NewMessage(uuid.NewUUID(), f.alice, "elsewhere", "")
+		require.NoError(t, err)
+		require.NoError(t, f.messageRepo.Save(t.Context(), otherChat))
+
+		result, err := f.useCase.Execute(t.Context(), f.command(
+			message.ImportMessageRow{Author: "U2", Content: "reply", CreatedAt: first, ParentMessageID: parent.ID()},
+			message.ImportMessageRow{Author: "U2", Content: "bad", CreatedAt: first, ParentMessageID: otherChat.ID()},
+		))
+
+		require.NoError(t, err)
+		assert.Equal(t, message.ImportStatusImported, result.Rows[0].Status)
+		assert.Equal(t, parent.ID(), f.messageRepo.Messages[result.Rows[0].MessageID].ParentMessageID())
+		assert.Equal(t, message.ImportStatusFailed, result.Rows[1].Status)
+	})
+
+	t.Run("dry run saves nothing", func(t *testing.T) {
+		f := newImportFixture()
+		cmd := f.command(
+			message.ImportMessageRow{ExternalID: "m1", Author: "U1", Content: "hello", CreatedAt: first},
+			message.ImportMessageRow{Author: "U2", Content: "hi", CreatedAt: first, ParentExternalID: "m1"},
+		)
+		cmd.DryRun = true
+
+		result, err := f.useCase.Execute(t.Context(), cmd)
+
+		require.NoError(t, err)
+		assert.True(t, result.DryRun)
+		assert.Equal(t, 2, result.Valid)
+		assert.Empty(t, f.messageRepo.Messages)
+	})
+
+	t.Run("rejects empty batches and unknown chats", func(t *testing.T) {
+		f := newImportFixture()
+
+		_, err := f.useCase.Execute(t.Context(), f.command())
+		require.Error(t, err)
+
+		cmd := f.command(message.ImportMessageRow{Author: "U1", Content: "hello", CreatedAt: first})
+		cmd.ChatID = uuid.NewUUID()
+		_, err = f.useCase.Execute(t.Context(), cmd)
+		require.ErrorIs(t, err, message.ErrChatNotFound)
+	})
+}
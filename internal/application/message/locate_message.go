@@ -0,0 +1,71 @@
+package message
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/lllypuk/flowra/internal/application/appcore"
+	"github.com/lllypuk/flowra/internal/domain/uuid"
+)
+
+// LocateMessageUseCase resolves on which page of the chat history a message is
+type LocateMessageUseCase struct {
+	messageRepo Repository
+}
+
+// NewLocateMessageUseCase creates New LocateMessageUseCase
+func NewLocateMessageUseCase(messageRepo Repository) *LocateMessageUseCase {
+	return &LocateMessageUseCase{
+		messageRepo: messageRepo,
+	}
+}
+
+// Execute loads the message and computes the offset of the page containing it.
+// Pages follow the ListMessages ordering (oldest first).
+func (uc *LocateMessageUseCase) Execute(
+	ctx context.Context,
+	query LocateMessageQuery,
+) (LocateResult, error) {
+	// validation
+	if err := uc.validate(&query); err != nil {
+		return LocateResult{}, fmt.Errorf("validation failed: %w", err)
+	}
+
+	// Loading message
+	msg, err := uc.messageRepo.FindByID(ctx, query.MessageID)
+	if err != nil {
+		return LocateResult{}, ErrMessageNotFound
+	}
+
+	position, err := uc.messageRepo.CountBefore(ctx, msg.ChatID(), msg.CreatedAt())
+	if err != nil {
+		return LocateResult{}, fmt.Errorf("failed to locate message: %w", err)
+	}
+
+	return LocateResult{
+		Message:  msg,
+		Offset:   position / query.PageSize * query.PageSize,
+		Position: position,
+	}, nil
+}
+
+func (uc *LocateMessageUseCase) validate(query *LocateMessageQuery) error {
+	if err := appcore.ValidateUUID("messageID", query.MessageID); err != nil {
+		return err
+	}
+
+	if query.PageSize <= 0 {
+		query.PageSize = DefaultLimit
+	}
+	if query.PageSize > MaxLimit {
+		query.PageSize = MaxLimit
+	}
+
+	return nil
+}
+
+// Permalink returns the stable web link to a message.
+// It resolves to the chat page scrolled to the message.
+func Permalink(chatID, messageID uuid.UUID) string {
+	return fmt.Sprintf("/chats/%s/messages/%s", chatID, messageID)
+}
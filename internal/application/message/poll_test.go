@@ -0,0 +1,205 @@
+package message_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lllypuk/flowra/internal/application/message"
+	domain "github.com/lllypuk/flowra/internal/domain/message"
+	"github.com/lllypuk/flowra/internal/domain/uuid"
+)
+
+type pollFixture struct {
+	messageRepo *message.MockMessageRepository
+	chatRepo    *message.MockChatRepository
+	eventBus    *message.MockEventBus
+	chatID      uuid.UUID
+	authorID    uuid.UUID
+	voterID     uuid.UUID
+}
+
+func newPollFixture() *pollFixture {
+	f := &pollFixture{
+		messageRepo: message.NewMockMessageRepository(),
+		chatRepo:    message.NewMockChatRepository(),
+		eventBus:    message.NewMockEventBus(),
+		chatID:      uuid.NewUUID(),
+		authorID:    uuid.NewUUID(),
+		voterID:     uuid.NewUUID(),
+	}
+	f.chatRepo.AddChat(f.chatID, []uuid.UUID{f.authorID, f.voterID})
+	return f
+}
+
+func (f *pollFixture) createPoll(t *testing.T, anonymous bool) *domain.Message {
+	t.Helper()
+
+	closesAt := time.Now().Add(time.Hour)
+	result, err := message.NewCreatePollUseCase(f.messageRepo, f.chatRepo, f.eventBus).Execute(
+		context.Background(),
+		message.CreatePollCommand{
+			ChatID:    f.chatID,
+			AuthorID:  f.authorID,
+			Question:  "Release on Friday?",
+			Options:   []string{"Yes", "No"},
+			Anonymous: anonymous,
+			ClosesAt:  &closesAt,
+		},
+	)
+	require.NoError(t, err)
+	return result.Value
+}
+
+func TestCreatePollUseCase(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		f := newPollFixture()
+
+		msg := f.createPoll(t, false)
+
+		assert.Equal(t, domain.TypePoll, msg.Type())
+		assert.Equal(t, "Release on Friday?", msg.Content())
+		assert.Len(t, msg.Poll().Options(), 2)
+		assert.Contains(t, f.messageRepo.Messages, msg.ID())
+		require.Len(t, f.eventBus.Published, 1)
+		assert.Equal(t, domain.EventTypeMessageCreated, f.eventBus.Published[0].EventType())
+	})
+
+	t.Run("invalid options", func(t *testing.T) {
+		f := newPollFixture()
+
+		_, err := message.NewCreatePollUseCase(f.messageRepo, f.chatRepo, f.eventBus).Execute(
+			context.Background(),
+			message.CreatePollCommand{ChatID: f.chatID, AuthorID: f.authorID, Question: "Q?", Options: []string{"Yes"}},
+		)
+
+		require.ErrorIs(t, err, message.ErrInvalidPoll)
+	})
+
+	t.Run("not a participant", func(t *testing.T) {
+		f := newPollFixture()
+
+		_, err := message.NewCreatePollUseCase(f.messageRepo, f.chatRepo, f.eventBus).Execute(
+			context.Background(),
+			message.CreatePollCommand{
+				ChatID: f.chatID, AuthorID: uuid.NewUUID(), Question: "Q?", Options: []string{"Yes", "No"},
+			},
+		)
+
+		require.ErrorIs(t, err, message.ErrNotChatParticipant)
+	})
+}
+
+func TestVotePollUseCase(t *testing.T) {
+	t.Run("vote publishes results", func(t *testing.T) {
+		f := newPollFixture()
+		msg := f.createPoll(t, false)
+		optionID := msg.Poll().Options()[1].ID()
+
+		result, err := message.NewVotePollUseCase(f.messageRepo, f.chatRepo, f.eventBus).Execute(
+			context.Background(),
+			message.VotePollCommand{MessageID: msg.ID(), OptionID: optionID, UserID: f.voterID},
+		)
+
+		require.NoError(t, err)
+		assert.Equal(t, 1, result.Value.Poll().Results()[1].Votes)
+
+		evt, ok := f.eventBus.Published[len(f.eventBus.Published)-1].(*domain.PollVoted)
+		require.True(t, ok)
+		assert.Equal(t, f.chatID, evt.ChatID)
+		assert.Equal(t, f.voterID, evt.VoterID)
+		assert.Equal(t, 1, evt.TotalVotes)
+	})
+
+	t.Run("anonymous vote hides voter", func(t *testing.T) {
+		f := newPollFixture()
+		msg := f.createPoll(t, true)
+
+		_, err := message.NewVotePollUseCase(f.messageRepo, f.chatRepo, f.eventBus).Execute(
+			context.Background(),
+			message.VotePollCommand{MessageID: msg.ID(), OptionID: msg.Poll().Options()[0].ID(), UserID: f.voterID},
+		)
+
+		require.NoError(t, err)
+		evt, ok := f.eventBus.Published[len(f.eventBus.Published)-1].(*domain.PollVoted)
+		require.True(t, ok)
+		assert.True(t, evt.VoterID.IsZero())
+		assert.Empty(t, evt.Metadata().UserID)
+	})
+
+	t.Run("unknown option", func(t *testing.T) {
+		f := newPollFixture()
+		msg := f.createPoll(t, false)
+
+		_, err := message.NewVotePollUseCase(f.messageRepo, f.chatRepo, f.eventBus).Execute(
+			context.Background(),
+			message.VotePollCommand{MessageID: msg.ID(), OptionID: uuid.NewUUID(), UserID: f.voterID},
+		)
+
+		require.ErrorIs(t, err, message.ErrPollOptionNotFound)
+	})
+
+	t.Run("not a poll", func(t *testing.T) {
+		f := newPollFixture()
+		msg, err := domain.NewMessage(f.chatID, f.authorID, "Hello", "")
+		require.NoError(t, err)
+		f.messageRepo.Messages[msg.ID()] = msg
+
+		_, err = message.NewVotePollUseCase(f.messageRepo, f.chatRepo, f.eventBus).Execute(
+			context.Background(),
+			message.VotePollCommand{MessageID: msg.ID(), OptionID: uuid.NewUUID(), UserID: f.voterID},
+		)
+
+		require.ErrorIs(t, err, message.ErrNotAPoll)
+	})
+
+	t.Run("closed poll", func(t *testing.T) {
+		f := newPollFixture()
+		msg := f.createPoll(t, false)
+		require.NoError(t, msg.ClosePoll(f.authorID))
+
+		_, err := message.NewVotePollUseCase(f.messageRepo, f.chatRepo, f.eventBus).Execute(
+			context.Background(),
+			message.VotePollCommand{MessageID: msg.ID(), OptionID: msg.Poll().Options()[0].ID(), UserID: f.voterID},
+		)
+
+		require.ErrorIs(t, err, message.ErrPollClosed)
+	})
+}
+
+func TestRetractPollVoteUseCase(t *testing.T) {
+	f := newPollFixture()
+	msg := f.createPoll(t, false)
+	require.NoError(t, msg.Vote(f.voterID, msg.Poll().Options()[0].ID()))
+	useCase := message.NewRetractPollVoteUseCase(f.messageRepo, f.chatRepo, f.eventBus)
+	cmd := message.RetractPollVoteCommand{MessageID: msg.ID(), UserID: f.voterID}
+
+	result, err := useCase.Execute(context.Background(), cmd)
+
+	require.NoError(t, err)
+	assert.Equal(t, 0, result.Value.Poll().TotalVotes())
+	assert.IsType(t, &domain.PollVoteRetracted{}, f.eventBus.Published[len(f.eventBus.Published)-1])
+
+	_, err = useCase.Execute(context.Background(), cmd)
+	require.ErrorIs(t, err, message.ErrPollVoteNotFound)
+}
+
+func TestClosePollUseCase(t *testing.T) {
+	f := newPollFixture()
+	msg := f.createPoll(t, false)
+	useCase := message.NewClosePollUseCase(f.messageRepo, f.chatRepo, f.eventBus)
+
+	_, err := useCase.Execute(context.Background(), message.ClosePollCommand{MessageID: msg.ID(), UserID: f.voterID})
+	require.ErrorIs(t, err, message.ErrNotPollAuthor)
+
+	result, err := useCase.Execute(context.Background(), message.ClosePollCommand{MessageID: msg.ID(), UserID: f.authorID})
+	require.NoError(t, err)
+	assert.True(t, result.Value.Poll().IsClosed(time.Now()))
+	assert.IsType(t, &domain.PollClosed{}, f.eventBus.Published[len(f.eventBus.Published)-1])
+
+	_, err = useCase.Execute(context.Background(), message.ClosePollCommand{MessageID: msg.ID(), UserID: f.authorID})
+	require.ErrorIs(t, err, message.ErrPollClosed)
+}
@@ -23,3 +23,9 @@ type ListMessagesQuery struct {
 type GetThreadQuery struct {
 	ParentMessageID uuid.UUID
 }
+
+// LocateMessageQuery - poisk stranitsy, na kotoroy nahoditsya message
+type LocateMessageQuery struct {
+	MessageID uuid.UUID
+	PageSize  int // default: 50, max: 100
+}
@@ -3,6 +3,7 @@ package message_test
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/lllypuk/flowra/internal/application/message"
 	domain "github.com/lllypuk/flowra/internal/domain/message"
@@ -359,3 +360,65 @@ func TestGetThreadUseCase_NestedThreads(t *testing.T) {
 	assert.NotNil(t, resultNested.Value)
 	assert.Len(t, resultNested.Value, 1) // Should only return the nested reply
 }
+
+// LocateMessage tests
+
+func TestLocateMessageUseCase_Success(t *testing.T) {
+	messageRepo := message.NewMockMessageRepository()
+
+	authorID := uuid.NewUUID()
+	chatID := uuid.NewUUID()
+	start := time.Now().Add(-time.Hour)
+
+	// Create messages with increasing timestamps
+	ids := make([]uuid.UUID, 0, 7)
+	for i := range 7 {
+		msg := domain.Reconstruct(
+			uuid.NewUUID(), chatID, authorID, "Test message", "", "",
+			start.Add(time.Duration(i)*time.Minute), nil, false, nil, nil, nil, nil, domain.TypeUser, nil, "", nil,
+		)
+		messageRepo.Messages[msg.ID()] = msg
+		ids = append(ids, msg.ID())
+	}
+
+	// Message in another chat must not affect the position
+	other, err := domain.NewMessage(uuid.NewUUID(), authorID, "Other chat", "")
+	require.NoError(t, err)
+	messageRepo.Messages[other.ID()] = other
+
+	useCase := message.NewLocateMessageUseCase(messageRepo)
+
+	result, err := useCase.Execute(context.Background(), message.LocateMessageQuery{
+		MessageID: ids[5],
+		PageSize:  3,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, ids[5], result.Message.ID())
+	assert.Equal(t, 5, result.Position)
+	assert.Equal(t, 3, result.Offset)
+
+	result, err = useCase.Execute(context.Background(), message.LocateMessageQuery{MessageID: ids[0]})
+
+	require.NoError(t, err)
+	assert.Equal(t, 0, result.Position)
+	assert.Equal(t, 0, result.Offset)
+}
+
+func TestLocateMessageUseCase_NotFound(t *testing.T) {
+	messageRepo := message.NewMockMessageRepository()
+	useCase := message.NewLocateMessageUseCase(messageRepo)
+
+	_, err := useCase.Execute(context.Background(), message.LocateMessageQuery{
+		MessageID: uuid.NewUUID(),
+	})
+
+	require.ErrorIs(t, err, message.ErrMessageNotFound)
+}
+
+func TestPermalink(t *testing.T) {
+	chatID := uuid.NewUUID()
+	messageID := uuid.NewUUID()
+
+	assert.Equal(t, "/chats/"+chatID.String()+"/messages/"+messageID.String(), message.Permalink(chatID, messageID))
+}
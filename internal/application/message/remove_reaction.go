@@ -53,9 +53,7 @@ func (uc *RemoveReactionUseCase) Execute(
 	}
 
 	// publish event
-	evt := message.NewReactionRemoved(msg.ID(), cmd.UserID, cmd.Emoji, 1, event.Metadata{
-		UserID: cmd.UserID.String(),
-	})
+	evt := message.NewReactionRemoved(msg.ID(), cmd.UserID, cmd.Emoji, 1, appcore.NewEventMetadata(ctx, cmd.UserID, cmd))
 	_ = uc.eventBus.Publish(ctx, evt)
 
 	return Result{
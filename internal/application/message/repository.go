@@ -2,6 +2,7 @@ package message
 
 import (
 	"context"
+	"time"
 
 	"github.com/lllypuk/flowra/internal/domain/message"
 	"github.com/lllypuk/flowra/internal/domain/uuid"
@@ -46,6 +47,10 @@ type QueryRepository interface {
 	// CountByChatID returns count soobscheniy in chate
 	CountByChatID(ctx context.Context, chatID uuid.UUID) (int, error)
 
+	// CountBefore returns the number of messages in the chat created before the given time
+	// uses the same ordering as FindByChatID, so the result is the message's position in the chat
+	CountBefore(ctx context.Context, chatID uuid.UUID, before time.Time) (int, error)
+
 	// CountThreadReplies returns count response in thread
 	CountThreadReplies(ctx context.Context, parentMessageID uuid.UUID) (int, error)
 
@@ -3,6 +3,7 @@ package message
 import (
 	"github.com/lllypuk/flowra/internal/application/appcore"
 	"github.com/lllypuk/flowra/internal/domain/message"
+	"github.com/lllypuk/flowra/internal/domain/uuid"
 )
 
 // Result represents result for odnogo messages
@@ -10,3 +11,40 @@ type Result = appcore.Result[*message.Message]
 
 // ListResult represents result for list soobscheniy
 type ListResult = appcore.Result[[]*message.Message]
+
+// LocateResult represents the position of a message in its chat history
+type LocateResult struct {
+	Message *message.Message
+	// Offset is the start of the page containing the message, suitable for ListMessagesQuery.Offset
+	Offset int
+	// Position is the zero-based index of the message in the chat history
+	Position int
+}
+
+// ImportStatus is the outcome of one imported message
+type ImportStatus string
+
+// Import row outcomes.
+const (
+	ImportStatusImported ImportStatus = "imported" // message saved
+	ImportStatusValid    ImportStatus = "valid"    // dry run: the message would be saved
+	ImportStatusFailed   ImportStatus = "failed"   // see Error
+)
+
+// ImportRowResult - outcome of one message of an import
+type ImportRowResult struct {
+	Index      int // position in the batch
+	ExternalID string
+	Status     ImportStatus
+	MessageID  uuid.UUID // set for imported messages
+	Error      string    // set for failed messages
+}
+
+// ImportMessagesResult - per-message report of an import
+type ImportMessagesResult struct {
+	DryRun   bool
+	Rows     []ImportRowResult
+	Imported int
+	Valid    int
+	Failed   int
+}
@@ -0,0 +1,83 @@
+package message
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/lllypuk/flowra/internal/application/appcore"
+	"github.com/lllypuk/flowra/internal/domain/errs"
+	"github.com/lllypuk/flowra/internal/domain/event"
+	messagedomain "github.com/lllypuk/flowra/internal/domain/message"
+)
+
+// RetractPollVoteUseCase handles retracting votes in polls
+type RetractPollVoteUseCase struct {
+	messageRepo Repository
+	chatRepo    ChatRepository
+	eventBus    event.Bus
+}
+
+// NewRetractPollVoteUseCase creates New RetractPollVoteUseCase
+func NewRetractPollVoteUseCase(
+	messageRepo Repository,
+	chatRepo ChatRepository,
+	eventBus event.Bus,
+) *RetractPollVoteUseCase {
+	return &RetractPollVoteUseCase{
+		messageRepo: messageRepo,
+		chatRepo:    chatRepo,
+		eventBus:    eventBus,
+	}
+}
+
+// Execute removes the vote of the user
+func (uc *RetractPollVoteUseCase) Execute(
+	ctx context.Context,
+	cmd RetractPollVoteCommand,
+) (Result, error) {
+	// validation
+	if err := uc.validate(cmd); err != nil {
+		return Result{}, fmt.Errorf("validation failed: %w", err)
+	}
+
+	// load poll message
+	msg, err := findPollMessage(ctx, uc.messageRepo, uc.chatRepo, cmd.MessageID, cmd.UserID)
+	if err != nil {
+		return Result{}, err
+	}
+
+	// retract vote
+	if retractErr := msg.RetractVote(cmd.UserID); retractErr != nil {
+		if errors.Is(retractErr, errs.ErrNotFound) {
+			return Result{}, ErrPollVoteNotFound
+		}
+		return Result{}, mapPollError(retractErr)
+	}
+
+	// save
+	if saveErr := uc.messageRepo.Save(ctx, msg); saveErr != nil {
+		return Result{}, fmt.Errorf("failed to save message: %w", saveErr)
+	}
+
+	// publish event with the new results
+	evt := messagedomain.NewPollVoteRetracted(
+		msg.ID(),
+		msg.ChatID(),
+		cmd.UserID,
+		msg.Poll(),
+		appcore.NewEventMetadata(ctx, cmd.UserID, cmd),
+	)
+	_ = uc.eventBus.Publish(ctx, evt)
+
+	return Result{
+		Value: msg,
+	}, nil
+}
+
+func (uc *RetractPollVoteUseCase) validate(cmd RetractPollVoteCommand) error {
+	if err := appcore.ValidateUUID("messageID", cmd.MessageID); err != nil {
+		return err
+	}
+	return appcore.ValidateUUID("userID", cmd.UserID)
+}
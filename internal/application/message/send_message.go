@@ -78,7 +78,7 @@ func (uc *SendMessageUseCase) Execute(
 	}
 
 	// check that user is a participant of chat
-	if !uc.isParticipant(chatReadModel, cmd.AuthorID) {
+	if !isChatParticipant(chatReadModel, cmd.AuthorID) {
 		return Result{}, ErrNotChatParticipant
 	}
 
@@ -94,6 +94,19 @@ func (uc *SendMessageUseCase) Execute(
 		}
 	}
 
+	// check quoted message (if it is inline quote)
+	var quoted *messagedomain.Message
+	if !cmd.QuotedMessageID.IsZero() {
+		var quotedErr error
+		quoted, quotedErr = uc.messageRepo.FindByID(ctx, cmd.QuotedMessageID)
+		if quotedErr != nil || quoted.IsDeleted() {
+			return Result{}, ErrQuotedNotFound
+		}
+		if quoted.ChatID() != cmd.ChatID {
+			return Result{}, ErrQuoteInDifferentChat
+		}
+	}
+
 	// 4. create message with specified type
 	msgType := cmd.Type
 	if msgType == "" {
@@ -111,6 +124,11 @@ func (uc *SendMessageUseCase) Execute(
 	if err != nil {
 		return Result{}, fmt.Errorf("failed to create message: %w", err)
 	}
+	if quoted != nil {
+		if quoteErr := msg.Quote(quoted); quoteErr != nil {
+			return Result{}, fmt.Errorf("failed to quote message: %w", quoteErr)
+		}
+	}
 
 	// 5. save
 	if saveErr := uc.messageRepo.Save(ctx, msg); saveErr != nil {
@@ -124,11 +142,9 @@ func (uc *SendMessageUseCase) Execute(
 		cmd.AuthorID,
 		cmd.Content,
 		cmd.ParentMessageID,
-		event.Metadata{
-			UserID:    cmd.AuthorID.String(),
-			Timestamp: msg.CreatedAt(),
-		},
+		appcore.NewEventMetadata(ctx, cmd.AuthorID, cmd),
 	)
+	evt.QuotedMessageID = msg.QuotedMessageID()
 	// not critical, message already saved
 	if pubErr := uc.eventBus.Publish(ctx, evt); pubErr != nil {
 		uc.logger.WarnContext(ctx, "failed to publish message created event",
@@ -140,7 +156,7 @@ func (uc *SendMessageUseCase) Execute(
 
 	// 7. tag handling
 	if uc.tagProcessor != nil && uc.tagExecutor != nil {
-		uc.processTagsDetached(msg, cmd.AuthorID, chatReadModel.Type)
+		uc.processTagsDetached(ctx, msg, cmd.AuthorID, chatReadModel.Type)
 	}
 
 	return Result{
@@ -167,23 +183,17 @@ func (uc *SendMessageUseCase) validate(cmd SendMessageCommand) error {
 	return nil
 }
 
-func (uc *SendMessageUseCase) isParticipant(chatReadModel *chatapp.ReadModel, userID uuid.UUID) bool {
-	for _, p := range chatReadModel.Participants {
-		if p.UserID() == userID {
-			return true
-		}
-	}
-	return false
-}
-
 // processTagsDetached runs tag processing outside request lifecycle.
+// Request-scoped values such as the correlation ID are kept so that events
+// raised by tag commands trace back to the originating message.
 func (uc *SendMessageUseCase) processTagsDetached(
+	parent context.Context,
 	msg *messagedomain.Message,
 	authorID uuid.UUID,
 	chatType chat.Type,
 ) {
 	go func() {
-		ctx, cancel := context.WithTimeout(context.Background(), tagProcessingTimeout)
+		ctx, cancel := context.WithTimeout(context.WithoutCancel(parent), tagProcessingTimeout)
 		defer cancel()
 
 		uc.processTags(ctx, msg, authorID, chatType)
@@ -208,8 +218,10 @@ func (uc *SendMessageUseCase) processTags(
 	// The tag processor expects "Task", "Bug", "Epic" or empty string
 	entityType := chatTypeToEntityType(chatType)
 
-	// Parse and process tags from message content
-	processingResult := uc.tagProcessor.ProcessMessage(chatIDGoogle, msg.Content(), entityType)
+	// Parse and process tags from message content; dates are read in the author's time zone
+	processingResult := uc.tagProcessor.ProcessMessageInLocation(
+		chatIDGoogle, msg.Content(), entityType, appcore.GetLocation(ctx),
+	)
 	if !processingResult.HasTags() {
 		// No tags found - exit
 		return
@@ -299,10 +311,8 @@ func (uc *SendMessageUseCase) sendBotResponse(ctx context.Context, chatID uuid.U
 		botMsg.AuthorID(),
 		botMsg.Content(),
 		uuid.UUID(""), // no parent - zero value
-		event.Metadata{
-			UserID:    uc.botUserID.String(),
-			Timestamp: botMsg.CreatedAt(),
-		},
+		// bot responses are caused by the tagged message that triggered them
+		appcore.NewEventMetadata(ctx, uc.botUserID, SendMessageCommand{}),
 	)
 
 	// Publish event for WebSocket broadcast
@@ -236,3 +236,79 @@ func TestSendMessageUseCase_ParentInDifferentChat(t *testing.T) {
 	require.ErrorIs(t, err, message.ErrParentInDifferentChat)
 	assert.Nil(t, result.Value)
 }
+
+func TestSendMessageUseCase_WithQuote(t *testing.T) {
+	messageRepo := message.NewMockMessageRepository()
+	chatRepo := message.NewMockChatRepository()
+	eventBus := message.NewMockEventBus()
+
+	chatID := uuid.NewUUID()
+	authorID := uuid.NewUUID()
+	chatRepo.AddChat(chatID, []uuid.UUID{authorID})
+
+	quotedMsg, err := domainMessage.NewMessage(chatID, authorID, "Quoted message", "")
+	require.NoError(t, err)
+	messageRepo.Messages[quotedMsg.ID()] = quotedMsg
+
+	useCase := message.NewSendMessageUseCase(messageRepo, chatRepo, nil, eventBus, nil, nil, uuid.NewUUID())
+
+	cmd := message.SendMessageCommand{
+		ChatID:          chatID,
+		Content:         "Answer",
+		AuthorID:        authorID,
+		QuotedMessageID: quotedMsg.ID(),
+	}
+
+	result, err := useCase.Execute(context.Background(), cmd)
+
+	require.NoError(t, err)
+	assert.Equal(t, quotedMsg.ID(), result.Value.QuotedMessageID())
+	assert.False(t, result.Value.IsReply())
+
+	require.Len(t, eventBus.Published, 1)
+	created, ok := eventBus.Published[0].(*domainMessage.Created)
+	require.True(t, ok)
+	assert.Equal(t, quotedMsg.ID(), created.QuotedMessageID)
+}
+
+func TestSendMessageUseCase_QuoteErrors(t *testing.T) {
+	messageRepo := message.NewMockMessageRepository()
+	chatRepo := message.NewMockChatRepository()
+	eventBus := message.NewMockEventBus()
+
+	chatID1 := uuid.NewUUID()
+	chatID2 := uuid.NewUUID()
+	authorID := uuid.NewUUID()
+	chatRepo.AddChat(chatID1, []uuid.UUID{authorID})
+	chatRepo.AddChat(chatID2, []uuid.UUID{authorID})
+
+	otherChatMsg, err := domainMessage.NewMessage(chatID1, authorID, "In chat1", "")
+	require.NoError(t, err)
+	messageRepo.Messages[otherChatMsg.ID()] = otherChatMsg
+
+	useCase := message.NewSendMessageUseCase(messageRepo, chatRepo, nil, eventBus, nil, nil, uuid.NewUUID())
+
+	tests := []struct {
+		name     string
+		quotedID uuid.UUID
+		wantErr  error
+	}{
+		{name: "quoted message not found", quotedID: uuid.NewUUID(), wantErr: message.ErrQuotedNotFound},
+		{name: "quoted message in different chat", quotedID: otherChatMsg.ID(), wantErr: message.ErrQuoteInDifferentChat},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd := message.SendMessageCommand{
+				ChatID:          chatID2,
+				Content:         "Answer",
+				AuthorID:        authorID,
+				QuotedMessageID: tt.quotedID,
+			}
+
+			_, execErr := useCase.Execute(context.Background(), cmd)
+			require.ErrorIs(t, execErr, tt.wantErr)
+		})
+	}
+	assert.Empty(t, eventBus.Published)
+}
@@ -2,6 +2,7 @@ package message
 
 import (
 	"context"
+	"time"
 
 	chatapp "github.com/lllypuk/flowra/internal/application/chat"
 	"github.com/lllypuk/flowra/internal/domain/chat"
@@ -84,6 +85,17 @@ func (m *MockMessageRepository) CountByChatID(_ context.Context, chatID uuid.UUI
 	return count, nil
 }
 
+// CountBefore podschityvaet messages in chate, created before the given time
+func (m *MockMessageRepository) CountBefore(_ context.Context, chatID uuid.UUID, before time.Time) (int, error) {
+	count := 0
+	for _, msg := range m.Messages {
+		if msg.ChatID() == chatID && msg.CreatedAt().Before(before) {
+			count++
+		}
+	}
+	return count, nil
+}
+
 // Save saves message
 func (m *MockMessageRepository) Save(_ context.Context, msg *domainMessage.Message) error {
 	if m.SaveErr != nil {
@@ -0,0 +1,104 @@
+package message
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/lllypuk/flowra/internal/application/appcore"
+	"github.com/lllypuk/flowra/internal/domain/errs"
+	"github.com/lllypuk/flowra/internal/domain/event"
+	"github.com/lllypuk/flowra/internal/domain/message"
+)
+
+// UndoMessageChangeUseCase lets the author undo the latest delete or edit of a message
+// within a short window. The change is reverted with a compensating event.
+type UndoMessageChangeUseCase struct {
+	messageRepo Repository
+	eventBus    event.Bus
+	window      time.Duration
+}
+
+// NewUndoMessageChangeUseCase creates New UndoMessageChangeUseCase.
+// A non-positive window disables undo.
+func NewUndoMessageChangeUseCase(
+	messageRepo Repository,
+	eventBus event.Bus,
+	window time.Duration,
+) *UndoMessageChangeUseCase {
+	return &UndoMessageChangeUseCase{
+		messageRepo: messageRepo,
+		eventBus:    eventBus,
+		window:      window,
+	}
+}
+
+// Execute restores a deleted message or reverts its last edit
+func (uc *UndoMessageChangeUseCase) Execute(
+	ctx context.Context,
+	cmd UndoMessageChangeCommand,
+) (Result, error) {
+	if err := uc.validate(cmd); err != nil {
+		return Result{}, fmt.Errorf("validation failed: %w", err)
+	}
+
+	if uc.window <= 0 {
+		return Result{}, ErrUndoWindowExpired
+	}
+
+	msg, err := uc.messageRepo.FindByID(ctx, cmd.MessageID)
+	if err != nil {
+		return Result{}, ErrMessageNotFound
+	}
+
+	// A deleted message can only be restored; its last edit stays as it is
+	restoring := msg.IsDeleted()
+	var undoErr error
+	if restoring {
+		undoErr = msg.Restore(cmd.UserID, uc.window)
+	} else {
+		undoErr = msg.RevertEdit(cmd.UserID, uc.window)
+	}
+	if undoErr != nil {
+		return Result{}, mapUndoError(undoErr)
+	}
+
+	if saveErr := uc.messageRepo.Save(ctx, msg); saveErr != nil {
+		return Result{}, fmt.Errorf("failed to save message: %w", saveErr)
+	}
+
+	metadata := appcore.NewEventMetadata(ctx, cmd.UserID, cmd)
+	var evt event.DomainEvent
+	if restoring {
+		evt = message.NewRestored(msg.ID(), msg.ChatID(), msg.Content(), cmd.UserID, 1, metadata)
+	} else {
+		evt = message.NewEdited(msg.ID(), msg.Content(), 1, metadata)
+	}
+	_ = uc.eventBus.Publish(ctx, evt)
+
+	return Result{
+		Value: msg,
+	}, nil
+}
+
+func (uc *UndoMessageChangeUseCase) validate(cmd UndoMessageChangeCommand) error {
+	if err := appcore.ValidateUUID("messageID", cmd.MessageID); err != nil {
+		return err
+	}
+	if err := appcore.ValidateUUID("userID", cmd.UserID); err != nil {
+		return err
+	}
+	return nil
+}
+
+func mapUndoError(err error) error {
+	switch {
+	case errors.Is(err, message.ErrUndoWindowExpired):
+		return ErrUndoWindowExpired
+	case errors.Is(err, errs.ErrInvalidState):
+		return ErrNothingToUndo
+	default:
+		return err
+	}
+}
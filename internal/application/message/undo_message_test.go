@@ -0,0 +1,139 @@
+package message_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/lllypuk/flowra/internal/application/message"
+	"github.com/lllypuk/flowra/internal/domain/errs"
+	domain "github.com/lllypuk/flowra/internal/domain/message"
+	"github.com/lllypuk/flowra/internal/domain/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUndoMessageChangeUseCase_RestoresDeletedMessage(t *testing.T) {
+	messageRepo := message.NewMockMessageRepository()
+	eventBus := message.NewMockEventBus()
+
+	authorID := uuid.NewUUID()
+	msg, err := domain.NewMessage(uuid.NewUUID(), authorID, "Test message", "")
+	require.NoError(t, err)
+	require.NoError(t, msg.Delete(authorID))
+	messageRepo.Messages[msg.ID()] = msg
+
+	useCase := message.NewUndoMessageChangeUseCase(messageRepo, eventBus, time.Minute)
+
+	result, err := useCase.Execute(context.Background(), message.UndoMessageChangeCommand{
+		MessageID: msg.ID(),
+		UserID:    authorID,
+	})
+
+	require.NoError(t, err)
+	assert.False(t, result.Value.IsDeleted())
+	require.Len(t, eventBus.Published, 1)
+	assert.Equal(t, domain.EventTypeMessageRestored, eventBus.Published[0].EventType())
+}
+
+func TestUndoMessageChangeUseCase_RevertsEdit(t *testing.T) {
+	messageRepo := message.NewMockMessageRepository()
+	eventBus := message.NewMockEventBus()
+
+	authorID := uuid.NewUUID()
+	msg, err := domain.NewMessage(uuid.NewUUID(), authorID, "Original", "")
+	require.NoError(t, err)
+	require.NoError(t, msg.EditContent("Edited", authorID))
+	messageRepo.Messages[msg.ID()] = msg
+
+	useCase := message.NewUndoMessageChangeUseCase(messageRepo, eventBus, time.Minute)
+
+	result, err := useCase.Execute(context.Background(), message.UndoMessageChangeCommand{
+		MessageID: msg.ID(),
+		UserID:    authorID,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "Original", result.Value.Content())
+	require.Len(t, eventBus.Published, 1)
+	edited, ok := eventBus.Published[0].(*domain.Edited)
+	require.True(t, ok)
+	assert.Equal(t, "Original", edited.NewContent)
+}
+
+func TestUndoMessageChangeUseCase_Errors(t *testing.T) {
+	authorID := uuid.NewUUID()
+	now := time.Now()
+	expired := now.Add(-time.Hour)
+
+	tests := []struct {
+		name    string
+		msg     *domain.Message
+		userID  uuid.UUID
+		window  time.Duration
+		wantErr error
+	}{
+		{
+			name: "nothing to undo",
+			msg: domain.Reconstruct(uuid.NewUUID(), uuid.NewUUID(), authorID, "Test", "", "",
+				now, nil, false, nil, nil, nil, nil, domain.TypeUser, nil, "", nil),
+			userID:  authorID,
+			window:  time.Minute,
+			wantErr: message.ErrNothingToUndo,
+		},
+		{
+			name: "window expired",
+			msg: domain.Reconstruct(uuid.NewUUID(), uuid.NewUUID(), authorID, "Test", "", "",
+				expired, nil, true, &expired, nil, nil, nil, domain.TypeUser, nil, "", nil),
+			userID:  authorID,
+			window:  time.Minute,
+			wantErr: message.ErrUndoWindowExpired,
+		},
+		{
+			name: "not author",
+			msg: domain.Reconstruct(uuid.NewUUID(), uuid.NewUUID(), authorID, "Edited", "Original", "",
+				now, &expired, false, nil, nil, nil, nil, domain.TypeUser, nil, "", nil),
+			userID:  uuid.NewUUID(),
+			window:  2 * time.Hour,
+			wantErr: errs.ErrForbidden,
+		},
+		{
+			name: "undo disabled",
+			msg: domain.Reconstruct(uuid.NewUUID(), uuid.NewUUID(), authorID, "Test", "", "",
+				now, nil, true, &now, nil, nil, nil, domain.TypeUser, nil, "", nil),
+			userID:  authorID,
+			window:  0,
+			wantErr: message.ErrUndoWindowExpired,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			messageRepo := message.NewMockMessageRepository()
+			eventBus := message.NewMockEventBus()
+			messageRepo.Messages[tt.msg.ID()] = tt.msg
+
+			useCase := message.NewUndoMessageChangeUseCase(messageRepo, eventBus, tt.window)
+
+			_, err := useCase.Execute(context.Background(), message.UndoMessageChangeCommand{
+				MessageID: tt.msg.ID(),
+				UserID:    tt.userID,
+			})
+
+			require.ErrorIs(t, err, tt.wantErr)
+			assert.Empty(t, eventBus.Published)
+		})
+	}
+}
+
+func TestUndoMessageChangeUseCase_MessageNotFound(t *testing.T) {
+	useCase := message.NewUndoMessageChangeUseCase(
+		message.NewMockMessageRepository(), message.NewMockEventBus(), time.Minute)
+
+	_, err := useCase.Execute(context.Background(), message.UndoMessageChangeCommand{
+		MessageID: uuid.NewUUID(),
+		UserID:    uuid.NewUUID(),
+	})
+
+	require.ErrorIs(t, err, message.ErrMessageNotFound)
+}
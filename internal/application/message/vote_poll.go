@@ -0,0 +1,87 @@
+package message
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/lllypuk/flowra/internal/application/appcore"
+	"github.com/lllypuk/flowra/internal/domain/errs"
+	"github.com/lllypuk/flowra/internal/domain/event"
+	messagedomain "github.com/lllypuk/flowra/internal/domain/message"
+)
+
+// VotePollUseCase handles voting in polls
+type VotePollUseCase struct {
+	messageRepo Repository
+	chatRepo    ChatRepository
+	eventBus    event.Bus
+}
+
+// NewVotePollUseCase creates New VotePollUseCase
+func NewVotePollUseCase(
+	messageRepo Repository,
+	chatRepo ChatRepository,
+	eventBus event.Bus,
+) *VotePollUseCase {
+	return &VotePollUseCase{
+		messageRepo: messageRepo,
+		chatRepo:    chatRepo,
+		eventBus:    eventBus,
+	}
+}
+
+// Execute records the vote of the user, replacing an earlier vote
+func (uc *VotePollUseCase) Execute(
+	ctx context.Context,
+	cmd VotePollCommand,
+) (Result, error) {
+	// validation
+	if err := uc.validate(cmd); err != nil {
+		return Result{}, fmt.Errorf("validation failed: %w", err)
+	}
+
+	// load poll message
+	msg, err := findPollMessage(ctx, uc.messageRepo, uc.chatRepo, cmd.MessageID, cmd.UserID)
+	if err != nil {
+		return Result{}, err
+	}
+
+	// vote
+	if voteErr := msg.Vote(cmd.UserID, cmd.OptionID); voteErr != nil {
+		if errors.Is(voteErr, errs.ErrNotFound) {
+			return Result{}, ErrPollOptionNotFound
+		}
+		return Result{}, mapPollError(voteErr)
+	}
+
+	// save
+	if saveErr := uc.messageRepo.Save(ctx, msg); saveErr != nil {
+		return Result{}, fmt.Errorf("failed to save message: %w", saveErr)
+	}
+
+	// publish event with the new results
+	evt := messagedomain.NewPollVoted(
+		msg.ID(),
+		msg.ChatID(),
+		cmd.UserID,
+		cmd.OptionID,
+		msg.Poll(),
+		appcore.NewEventMetadata(ctx, cmd.UserID, cmd),
+	)
+	_ = uc.eventBus.Publish(ctx, evt)
+
+	return Result{
+		Value: msg,
+	}, nil
+}
+
+func (uc *VotePollUseCase) validate(cmd VotePollCommand) error {
+	if err := appcore.ValidateUUID("messageID", cmd.MessageID); err != nil {
+		return err
+	}
+	if err := appcore.ValidateUUID("optionID", cmd.OptionID); err != nil {
+		return err
+	}
+	return appcore.ValidateUUID("userID", cmd.UserID)
+}
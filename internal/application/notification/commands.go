@@ -36,6 +36,15 @@ type MarkAllAsReadCommand struct {
 
 func (c MarkAllAsReadCommand) CommandName() string { return "MarkAllAsRead" }
 
+// MarkManyAsReadCommand - bulk pometka as prochitannye, by IDs or by workspace
+type MarkManyAsReadCommand struct {
+	UserID          uuid.UUID
+	NotificationIDs []uuid.UUID // at most MaxBulkMarkAsRead
+	WorkspaceID     uuid.UUID   // all unread of the workspace within the unified inbox window
+}
+
+func (c MarkManyAsReadCommand) CommandName() string { return "MarkManyAsRead" }
+
 // DeleteNotificationCommand - deletion notification
 type DeleteNotificationCommand struct {
 	NotificationID uuid.UUID
@@ -43,3 +52,13 @@ type DeleteNotificationCommand struct {
 }
 
 func (c DeleteNotificationCommand) CommandName() string { return "DeleteNotification" }
+
+// UpdatePreferencesCommand - replace the notification preferences of a user
+type UpdatePreferencesCommand struct {
+	UserID      uuid.UUID
+	DNDStart    string // "HH:MM" in the user's time zone, empty for no daily window
+	DNDEnd      string
+	DNDWeekends bool
+}
+
+func (c UpdatePreferencesCommand) CommandName() string { return "UpdateNotificationPreferences" }
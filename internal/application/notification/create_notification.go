@@ -5,21 +5,39 @@ import (
 	"fmt"
 
 	"github.com/lllypuk/flowra/internal/application/appcore"
+	"github.com/lllypuk/flowra/internal/domain/event"
 	"github.com/lllypuk/flowra/internal/domain/notification"
 )
 
 // CreateNotificationUseCase handles notification creation
 type CreateNotificationUseCase struct {
 	notificationRepo Repository
+	eventBus         event.Bus
+}
+
+// CreateNotificationOption configures CreateNotificationUseCase.
+type CreateNotificationOption func(*CreateNotificationUseCase)
+
+// WithNotificationEventBus publishes notification.created after each notification is saved,
+// so the recipient's open sessions can update live.
+func WithNotificationEventBus(eventBus event.Bus) CreateNotificationOption {
+	return func(uc *CreateNotificationUseCase) {
+		uc.eventBus = eventBus
+	}
 }
 
 // NewCreateNotificationUseCase creates New use case for creating notification
 func NewCreateNotificationUseCase(
 	notificationRepo Repository,
+	opts ...CreateNotificationOption,
 ) *CreateNotificationUseCase {
-	return &CreateNotificationUseCase{
+	uc := &CreateNotificationUseCase{
 		notificationRepo: notificationRepo,
 	}
+	for _, opt := range opts {
+		opt(uc)
+	}
+	return uc
 }
 
 // Execute performs notification creation
@@ -49,6 +67,19 @@ func (uc *CreateNotificationUseCase) Execute(
 		return Result{}, fmt.Errorf("failed to save notification: %w", saveErr)
 	}
 
+	// the notification list is loaded on the next page view anyway, so delivery is best effort
+	if uc.eventBus != nil {
+		_ = uc.eventBus.Publish(ctx, notification.NewNotificationCreated(
+			notif.ID(),
+			notif.UserID(),
+			notif.Type(),
+			notif.Title(),
+			notif.Message(),
+			notif.ResourceID(),
+			appcore.NewEventMetadata(ctx, notif.UserID(), cmd),
+		))
+	}
+
 	return Result{
 		Result: appcore.Result[*notification.Notification]{
 			Value: notif,
@@ -65,6 +96,7 @@ func (uc *CreateNotificationUseCase) validate(cmd CreateNotificationCommand) err
 		string(notification.TypeTaskStatusChanged),
 		string(notification.TypeTaskAssigned),
 		string(notification.TypeTaskCreated),
+		string(notification.TypeTaskSLABreached),
 		string(notification.TypeChatMention),
 		string(notification.TypeChatMessage),
 		string(notification.TypeWorkspaceInvite),
@@ -3,10 +3,12 @@ package notification_test
 import (
 	"context"
 	"errors"
+	"slices"
 	"testing"
 	"time"
 
 	"github.com/lllypuk/flowra/internal/application/notification"
+	"github.com/lllypuk/flowra/internal/domain/event"
 	domainnotification "github.com/lllypuk/flowra/internal/domain/notification"
 	"github.com/lllypuk/flowra/internal/domain/uuid"
 )
@@ -173,6 +175,30 @@ func (m *mockNotificationRepository) DeleteReadOlderThan(_ context.Context, befo
 	return count, nil
 }
 
+func (m *mockNotificationRepository) FindReadSince(
+	_ context.Context,
+	userID uuid.UUID,
+	since time.Time,
+	limit int,
+) ([]*domainnotification.Notification, error) {
+	if m.findError != nil {
+		return nil, m.findError
+	}
+	var result []*domainnotification.Notification
+	for _, notif := range m.notifications {
+		if notif.UserID() == userID && notif.IsRead() && !notif.ReadAt().Before(since) {
+			result = append(result, notif)
+		}
+	}
+	slices.SortFunc(result, func(a, b *domainnotification.Notification) int {
+		return a.ReadAt().Compare(*b.ReadAt())
+	})
+	if len(result) > limit {
+		result = result[:limit]
+	}
+	return result, nil
+}
+
 func (m *mockNotificationRepository) FindByType(
 	_ context.Context,
 	userID uuid.UUID,
@@ -276,6 +302,79 @@ func TestCreateNotificationUseCase_Execute_Success(t *testing.T) {
 	}
 }
 
+// recordingEventBus records published events
+type recordingEventBus struct {
+	published []event.DomainEvent
+}
+
+func (b *recordingEventBus) Publish(_ context.Context, evt event.DomainEvent) error {
+	b.published = append(b.published, evt)
+	return nil
+}
+
+func TestCreateNotificationUseCase_Execute_PublishesCreatedEvent(t *testing.T) {
+	// Arrange
+	repo := newMockNotificationRepository()
+	bus := &recordingEventBus{}
+	useCase := notification.NewCreateNotificationUseCase(repo, notification.WithNotificationEventBus(bus))
+	recipientID := uuid.NewUUID()
+
+	cmd := notification.CreateNotificationCommand{
+		UserID:  recipientID,
+		Type:    domainnotification.TypeChatMention,
+		Title:   "Mentioned",
+		Message: "You were mentioned",
+	}
+
+	// Act
+	result, err := useCase.Execute(context.Background(), cmd)
+
+	// Assert
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if len(bus.published) != 1 {
+		t.Fatalf("expected 1 published event, got %d", len(bus.published))
+	}
+
+	created, ok := bus.published[0].(*domainnotification.Created)
+	if !ok {
+		t.Fatalf("expected *notification.Created, got %T", bus.published[0])
+	}
+	if created.UserID != recipientID {
+		t.Errorf("expected recipient %s, got %s", recipientID, created.UserID)
+	}
+	if created.AggregateID() != result.Value.ID().String() {
+		t.Errorf("expected aggregate ID %s, got %s", result.Value.ID(), created.AggregateID())
+	}
+}
+
+func TestCreateNotificationUseCase_Execute_SaveErrorPublishesNothing(t *testing.T) {
+	// Arrange
+	repo := newMockNotificationRepository()
+	repo.saveError = errors.New("database error")
+	bus := &recordingEventBus{}
+	useCase := notification.NewCreateNotificationUseCase(repo, notification.WithNotificationEventBus(bus))
+
+	cmd := notification.CreateNotificationCommand{
+		UserID:  uuid.NewUUID(),
+		Type:    domainnotification.TypeTaskAssigned,
+		Title:   "Task Assigned",
+		Message: "You have been assigned to a task",
+	}
+
+	// Act
+	_, err := useCase.Execute(context.Background(), cmd)
+
+	// Assert
+	if err == nil {
+		t.Fatal("expected error from save operation")
+	}
+	if len(bus.published) != 0 {
+		t.Errorf("expected no published events, got %d", len(bus.published))
+	}
+}
+
 func TestCreateNotificationUseCase_Validate_MissingUserID(t *testing.T) {
 	// Arrange
 	repo := newMockNotificationRepository()
@@ -3,8 +3,11 @@ package notification
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/lllypuk/flowra/internal/application/appcore"
+	"github.com/lllypuk/flowra/internal/domain/event"
+	"github.com/lllypuk/flowra/internal/domain/notification"
 )
 
 const (
@@ -15,15 +18,32 @@ const (
 // MarkAllAsReadUseCase handles pometku all notifications user as prochitannyh
 type MarkAllAsReadUseCase struct {
 	notificationRepo Repository
+	eventBus         event.Bus
+}
+
+// MarkAllAsReadOption configures MarkAllAsReadUseCase.
+type MarkAllAsReadOption func(*MarkAllAsReadUseCase)
+
+// WithMarkAllAsReadEventBus publishes notification.all_read once the notifications are
+// saved, so the user's other open sessions can update live.
+func WithMarkAllAsReadEventBus(eventBus event.Bus) MarkAllAsReadOption {
+	return func(uc *MarkAllAsReadUseCase) {
+		uc.eventBus = eventBus
+	}
 }
 
 // NewMarkAllAsReadUseCase creates New use case for pometki all notifications as prochitannyh
 func NewMarkAllAsReadUseCase(
 	notificationRepo Repository,
+	opts ...MarkAllAsReadOption,
 ) *MarkAllAsReadUseCase {
-	return &MarkAllAsReadUseCase{
+	uc := &MarkAllAsReadUseCase{
 		notificationRepo: notificationRepo,
 	}
+	for _, opt := range opts {
+		opt(uc)
+	}
+	return uc
 }
 
 // Execute performs pometku all notifications user as prochitannyh
@@ -56,6 +76,16 @@ func (uc *MarkAllAsReadUseCase) Execute(
 		markedCount++
 	}
 
+	// one event for the whole batch; other sessions catch up through the sync endpoint
+	if uc.eventBus != nil && markedCount > 0 {
+		_ = uc.eventBus.Publish(ctx, notification.NewNotificationAllRead(
+			cmd.UserID,
+			time.Now(),
+			markedCount,
+			appcore.NewEventMetadata(ctx, cmd.UserID, cmd),
+		))
+	}
+
 	return CountResult{
 		Count: markedCount,
 	}, nil
@@ -64,6 +64,52 @@ func TestMarkAllAsReadUseCase_Execute_Success(t *testing.T) {
 	}
 }
 
+func TestMarkAllAsReadUseCase_Execute_PublishesOneEvent(t *testing.T) {
+	// Arrange
+	repo := newMockNotificationRepository()
+	bus := &recordingEventBus{}
+	userID := uuid.NewUUID()
+
+	for range 3 {
+		notif, _ := domainnotification.NewNotification(
+			userID,
+			domainnotification.TypeTaskAssigned,
+			"Task Assigned",
+			"You have been assigned to a task",
+			uuid.NewUUID().String(),
+		)
+		repo.Save(context.Background(), notif)
+	}
+
+	useCase := notification.NewMarkAllAsReadUseCase(repo, notification.WithMarkAllAsReadEventBus(bus))
+
+	// Act
+	_, err := useCase.Execute(context.Background(), notification.MarkAllAsReadCommand{UserID: userID})
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	// nothing left to mark, nothing to publish
+	_, err = useCase.Execute(context.Background(), notification.MarkAllAsReadCommand{UserID: userID})
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+
+	// Assert
+	if len(bus.published) != 1 {
+		t.Fatalf("expected 1 published event, got %d", len(bus.published))
+	}
+	allRead, ok := bus.published[0].(*domainnotification.AllRead)
+	if !ok {
+		t.Fatalf("expected *notification.AllRead, got %T", bus.published[0])
+	}
+	if allRead.UserID != userID {
+		t.Errorf("expected user %s, got %s", userID, allRead.UserID)
+	}
+	if allRead.Count != 3 {
+		t.Errorf("expected count 3, got %d", allRead.Count)
+	}
+}
+
 func TestMarkAllAsReadUseCase_Execute_NoUnreadNotifications(t *testing.T) {
 	// Arrange
 	repo := newMockNotificationRepository()
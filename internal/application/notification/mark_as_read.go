@@ -7,21 +7,39 @@ import (
 
 	"github.com/lllypuk/flowra/internal/application/appcore"
 	"github.com/lllypuk/flowra/internal/domain/errs"
+	"github.com/lllypuk/flowra/internal/domain/event"
 	"github.com/lllypuk/flowra/internal/domain/notification"
 )
 
 // MarkAsReadUseCase handles pometku notification as read
 type MarkAsReadUseCase struct {
 	notificationRepo Repository
+	eventBus         event.Bus
+}
+
+// MarkAsReadOption configures MarkAsReadUseCase.
+type MarkAsReadOption func(*MarkAsReadUseCase)
+
+// WithMarkAsReadEventBus publishes notification.read after the notification is saved,
+// so the user's other open sessions can update live.
+func WithMarkAsReadEventBus(eventBus event.Bus) MarkAsReadOption {
+	return func(uc *MarkAsReadUseCase) {
+		uc.eventBus = eventBus
+	}
 }
 
 // NewMarkAsReadUseCase creates New use case for pometki notification as read
 func NewMarkAsReadUseCase(
 	notificationRepo Repository,
+	opts ...MarkAsReadOption,
 ) *MarkAsReadUseCase {
-	return &MarkAsReadUseCase{
+	uc := &MarkAsReadUseCase{
 		notificationRepo: notificationRepo,
 	}
+	for _, opt := range opts {
+		opt(uc)
+	}
+	return uc
 }
 
 // Execute performs pometku notification as read
@@ -58,6 +76,16 @@ func (uc *MarkAsReadUseCase) Execute(
 		return Result{}, fmt.Errorf("failed to save notification: %w", saveErr)
 	}
 
+	// other sessions catch up through the sync endpoint, so delivery is best effort
+	if uc.eventBus != nil {
+		_ = uc.eventBus.Publish(ctx, notification.NewNotificationRead(
+			notif.ID(),
+			notif.UserID(),
+			*notif.ReadAt(),
+			appcore.NewEventMetadata(ctx, cmd.UserID, cmd),
+		))
+	}
+
 	return Result{
 		Result: appcore.Result[*notification.Notification]{
 			Value: notif,
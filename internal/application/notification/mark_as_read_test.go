@@ -53,6 +53,51 @@ func TestMarkAsReadUseCase_Execute_Success(t *testing.T) {
 	}
 }
 
+func TestMarkAsReadUseCase_Execute_PublishesReadEvent(t *testing.T) {
+	// Arrange
+	repo := newMockNotificationRepository()
+	bus := &recordingEventBus{}
+	userID := uuid.NewUUID()
+
+	notif, _ := domainnotification.NewNotification(
+		userID,
+		domainnotification.TypeTaskAssigned,
+		"Task Assigned",
+		"You have been assigned to a task",
+		uuid.NewUUID().String(),
+	)
+	repo.Save(context.Background(), notif)
+
+	useCase := notification.NewMarkAsReadUseCase(repo, notification.WithMarkAsReadEventBus(bus))
+
+	// Act
+	_, err := useCase.Execute(context.Background(), notification.MarkAsReadCommand{
+		NotificationID: notif.ID(),
+		UserID:         userID,
+	})
+
+	// Assert
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if len(bus.published) != 1 {
+		t.Fatalf("expected 1 published event, got %d", len(bus.published))
+	}
+	read, ok := bus.published[0].(*domainnotification.Read)
+	if !ok {
+		t.Fatalf("expected *notification.Read, got %T", bus.published[0])
+	}
+	if read.UserID != userID {
+		t.Errorf("expected user %s, got %s", userID, read.UserID)
+	}
+	if read.AggregateID() != notif.ID().String() {
+		t.Errorf("expected aggregate ID %s, got %s", notif.ID(), read.AggregateID())
+	}
+	if !read.ReadAt.Equal(*notif.ReadAt()) {
+		t.Errorf("expected read at %v, got %v", notif.ReadAt(), read.ReadAt)
+	}
+}
+
 func TestMarkAsReadUseCase_Execute_NotificationNotFound(t *testing.T) {
 	// Arrange
 	repo := newMockNotificationRepository()
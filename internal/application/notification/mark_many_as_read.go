@@ -0,0 +1,150 @@
+package notification
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/lllypuk/flowra/internal/application/appcore"
+	"github.com/lllypuk/flowra/internal/domain/errs"
+	"github.com/lllypuk/flowra/internal/domain/event"
+	"github.com/lllypuk/flowra/internal/domain/notification"
+	"github.com/lllypuk/flowra/internal/domain/uuid"
+)
+
+// MaxBulkMarkAsRead - maximum count of notification IDs in one bulk pometka
+const MaxBulkMarkAsRead = 100
+
+// MarkManyAsReadUseCase handles bulk pometku notifications as prochitannyh from the unified inbox
+type MarkManyAsReadUseCase struct {
+	notificationRepo Repository
+	grouper          workspaceGrouper
+	eventBus         event.Bus
+}
+
+// MarkManyAsReadOption configures MarkManyAsReadUseCase.
+type MarkManyAsReadOption func(*MarkManyAsReadUseCase)
+
+// WithMarkManyAsReadEventBus publishes notification.read for every marked notification,
+// so the user's other open sessions can update live.
+func WithMarkManyAsReadEventBus(eventBus event.Bus) MarkManyAsReadOption {
+	return func(uc *MarkManyAsReadUseCase) {
+		uc.eventBus = eventBus
+	}
+}
+
+// NewMarkManyAsReadUseCase creates a new use case for bulk pometki as prochitannyh
+func NewMarkManyAsReadUseCase(
+	notificationRepo Repository,
+	resolver WorkspaceResolver,
+	directory WorkspaceDirectory,
+	opts ...MarkManyAsReadOption,
+) *MarkManyAsReadUseCase {
+	uc := &MarkManyAsReadUseCase{
+		notificationRepo: notificationRepo,
+		grouper:          workspaceGrouper{resolver: resolver, directory: directory},
+	}
+	for _, opt := range opts {
+		opt(uc)
+	}
+	return uc
+}
+
+// Execute marks the given notifications, or the unread ones of a workspace, as read.
+// Notifications of other users and already read ones are skipped.
+func (uc *MarkManyAsReadUseCase) Execute(ctx context.Context, cmd MarkManyAsReadCommand) (CountResult, error) {
+	if err := uc.validate(cmd); err != nil {
+		return CountResult{}, fmt.Errorf("validation failed: %w", err)
+	}
+
+	var notifications []*notification.Notification
+	var err error
+	if cmd.WorkspaceID.IsZero() {
+		notifications, err = uc.findByIDs(ctx, cmd.NotificationIDs)
+	} else {
+		notifications, err = uc.findUnreadOfWorkspace(ctx, cmd.UserID, cmd.WorkspaceID)
+	}
+	if err != nil {
+		return CountResult{}, err
+	}
+
+	markedCount := 0
+	for _, notif := range notifications {
+		if notif.UserID() != cmd.UserID {
+			continue
+		}
+		if markErr := notif.MarkAsRead(); markErr != nil {
+			// uzhe prochitano
+			continue
+		}
+		if saveErr := uc.notificationRepo.Save(ctx, notif); saveErr != nil {
+			return CountResult{}, fmt.Errorf("failed to save notification: %w", saveErr)
+		}
+		markedCount++
+
+		if uc.eventBus != nil {
+			_ = uc.eventBus.Publish(ctx, notification.NewNotificationRead(
+				notif.ID(),
+				notif.UserID(),
+				*notif.ReadAt(),
+				appcore.NewEventMetadata(ctx, cmd.UserID, cmd),
+			))
+		}
+	}
+
+	return CountResult{Count: markedCount}, nil
+}
+
+func (uc *MarkManyAsReadUseCase) findByIDs(
+	ctx context.Context,
+	ids []uuid.UUID,
+) ([]*notification.Notification, error) {
+	notifications := make([]*notification.Notification, 0, len(ids))
+	for _, id := range ids {
+		notif, err := uc.notificationRepo.FindByID(ctx, id)
+		if errors.Is(err, errs.ErrNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to find notification: %w", err)
+		}
+		notifications = append(notifications, notif)
+	}
+	return notifications, nil
+}
+
+func (uc *MarkManyAsReadUseCase) findUnreadOfWorkspace(
+	ctx context.Context,
+	userID, workspaceID uuid.UUID,
+) ([]*notification.Notification, error) {
+	unread, err := uc.notificationRepo.FindUnreadByUserID(ctx, userID, UnifiedInboxWindow)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find unread notifications: %w", err)
+	}
+	entries, err := uc.grouper.group(ctx, userID, unread)
+	if err != nil {
+		return nil, err
+	}
+
+	notifications := make([]*notification.Notification, 0, len(entries))
+	for _, entry := range entries {
+		if entry.WorkspaceID == workspaceID {
+			notifications = append(notifications, entry.Notification)
+		}
+	}
+	return notifications, nil
+}
+
+// validate validates commands
+func (uc *MarkManyAsReadUseCase) validate(cmd MarkManyAsReadCommand) error {
+	if err := appcore.ValidateUUID("userID", cmd.UserID); err != nil {
+		return err
+	}
+	if cmd.WorkspaceID.IsZero() == (len(cmd.NotificationIDs) == 0) {
+		return appcore.NewValidationError("ids", "either notification IDs or a workspace is required")
+	}
+	if len(cmd.NotificationIDs) > MaxBulkMarkAsRead {
+		return appcore.NewValidationError("ids", fmt.Sprintf("must contain at most %d IDs", MaxBulkMarkAsRead))
+	}
+	return nil
+}
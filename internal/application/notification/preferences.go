@@ -0,0 +1,71 @@
+package notification
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/lllypuk/flowra/internal/application/appcore"
+	"github.com/lllypuk/flowra/internal/domain/errs"
+	"github.com/lllypuk/flowra/internal/domain/notification"
+)
+
+// GetPreferencesUseCase returns the notification preferences of a user; users
+// who never saved any get the defaults, which have no do-not-disturb schedule
+type GetPreferencesUseCase struct {
+	prefsRepo PreferencesRepository
+}
+
+// NewGetPreferencesUseCase creates New use case for reading preferences
+func NewGetPreferencesUseCase(prefsRepo PreferencesRepository) *GetPreferencesUseCase {
+	return &GetPreferencesUseCase{prefsRepo: prefsRepo}
+}
+
+// Execute returns the preferences of query.UserID
+func (uc *GetPreferencesUseCase) Execute(
+	ctx context.Context,
+	query GetPreferencesQuery,
+) (notification.Preferences, error) {
+	if err := appcore.ValidateUUID("userID", query.UserID); err != nil {
+		return notification.Preferences{}, fmt.Errorf("validation failed: %w", err)
+	}
+
+	prefs, err := uc.prefsRepo.FindPreferences(ctx, query.UserID)
+	if errors.Is(err, errs.ErrNotFound) {
+		return notification.Preferences{}, nil
+	}
+	if err != nil {
+		return notification.Preferences{}, fmt.Errorf("failed to find notification preferences: %w", err)
+	}
+	return prefs, nil
+}
+
+// UpdatePreferencesUseCase replaces the notification preferences of a user
+type UpdatePreferencesUseCase struct {
+	prefsRepo PreferencesRepository
+}
+
+// NewUpdatePreferencesUseCase creates New use case for saving preferences
+func NewUpdatePreferencesUseCase(prefsRepo PreferencesRepository) *UpdatePreferencesUseCase {
+	return &UpdatePreferencesUseCase{prefsRepo: prefsRepo}
+}
+
+// Execute validates and saves the preferences of cmd.UserID
+func (uc *UpdatePreferencesUseCase) Execute(
+	ctx context.Context,
+	cmd UpdatePreferencesCommand,
+) (notification.Preferences, error) {
+	if err := appcore.ValidateUUID("userID", cmd.UserID); err != nil {
+		return notification.Preferences{}, fmt.Errorf("validation failed: %w", err)
+	}
+	dnd, err := notification.NewDNDSchedule(cmd.DNDStart, cmd.DNDEnd, cmd.DNDWeekends)
+	if err != nil {
+		return notification.Preferences{}, fmt.Errorf("validation failed: %w", err)
+	}
+
+	prefs := notification.Preferences{DND: dnd}
+	if err = uc.prefsRepo.SavePreferences(ctx, cmd.UserID, prefs); err != nil {
+		return notification.Preferences{}, fmt.Errorf("failed to save notification preferences: %w", err)
+	}
+	return prefs, nil
+}
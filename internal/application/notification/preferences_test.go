@@ -0,0 +1,69 @@
+package notification_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/lllypuk/flowra/internal/application/notification"
+	"github.com/lllypuk/flowra/internal/domain/errs"
+	domainnotification "github.com/lllypuk/flowra/internal/domain/notification"
+	"github.com/lllypuk/flowra/internal/domain/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type mockPreferencesRepository struct {
+	prefs map[uuid.UUID]domainnotification.Preferences
+}
+
+func (m *mockPreferencesRepository) FindPreferences(
+	_ context.Context,
+	userID uuid.UUID,
+) (domainnotification.Preferences, error) {
+	prefs, ok := m.prefs[userID]
+	if !ok {
+		return domainnotification.Preferences{}, errs.ErrNotFound
+	}
+	return prefs, nil
+}
+
+func (m *mockPreferencesRepository) SavePreferences(
+	_ context.Context,
+	userID uuid.UUID,
+	prefs domainnotification.Preferences,
+) error {
+	m.prefs[userID] = prefs
+	return nil
+}
+
+func TestPreferencesUseCases(t *testing.T) {
+	repo := &mockPreferencesRepository{prefs: make(map[uuid.UUID]domainnotification.Preferences)}
+	get := notification.NewGetPreferencesUseCase(repo)
+	update := notification.NewUpdatePreferencesUseCase(repo)
+	userID := uuid.NewUUID()
+
+	prefs, err := get.Execute(context.Background(), notification.GetPreferencesQuery{UserID: userID})
+	require.NoError(t, err)
+	assert.False(t, prefs.DND.Enabled(), "users without saved preferences have no schedule")
+
+	_, err = update.Execute(context.Background(), notification.UpdatePreferencesCommand{
+		UserID:      userID,
+		DNDStart:    "22:00",
+		DNDEnd:      "08:00",
+		DNDWeekends: true,
+	})
+	require.NoError(t, err)
+
+	prefs, err = get.Execute(context.Background(), notification.GetPreferencesQuery{UserID: userID})
+	require.NoError(t, err)
+	assert.Equal(t, "22:00", prefs.DND.StartClock())
+	assert.Equal(t, "08:00", prefs.DND.EndClock())
+	assert.True(t, prefs.DND.Weekends)
+
+	_, err = update.Execute(context.Background(), notification.UpdatePreferencesCommand{
+		UserID:   userID,
+		DNDStart: "22:00",
+	})
+	require.ErrorIs(t, err, errs.ErrInvalidInput)
+	assert.True(t, repo.prefs[userID].DND.Weekends, "invalid updates are not saved")
+}
@@ -1,6 +1,10 @@
 package notification
 
-import "github.com/lllypuk/flowra/internal/domain/uuid"
+import (
+	"time"
+
+	"github.com/lllypuk/flowra/internal/domain/uuid"
+)
 
 // Query bazovyy interface zaprosov
 type Query interface {
@@ -25,9 +29,35 @@ type ListNotificationsQuery struct {
 
 func (q ListNotificationsQuery) QueryName() string { return "ListNotifications" }
 
+// UnifiedInboxQuery - notifications across all workspaces of the user
+type UnifiedInboxQuery struct {
+	UserID      uuid.UUID
+	WorkspaceID uuid.UUID // filter by workspace, zero for all
+	UnreadOnly  bool
+	Limit       int
+	Offset      int
+}
+
+func (q UnifiedInboxQuery) QueryName() string { return "UnifiedInbox" }
+
 // CountUnreadQuery - count unread
 type CountUnreadQuery struct {
 	UserID uuid.UUID
 }
 
 func (q CountUnreadQuery) QueryName() string { return "CountUnread" }
+
+// SyncReadStateQuery - read-state changes since a point in time
+type SyncReadStateQuery struct {
+	UserID uuid.UUID
+	Since  time.Time // SyncedAt of the previous sync
+}
+
+func (q SyncReadStateQuery) QueryName() string { return "SyncReadState" }
+
+// GetPreferencesQuery - notification preferences of a user
+type GetPreferencesQuery struct {
+	UserID uuid.UUID
+}
+
+func (q GetPreferencesQuery) QueryName() string { return "GetNotificationPreferences" }
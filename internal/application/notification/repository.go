@@ -51,6 +51,9 @@ type QueryRepository interface {
 	// FindUnreadByUserID finds neprochitannye uvedomleniya user
 	FindUnreadByUserID(ctx context.Context, userID uuid.UUID, limit int) ([]*notification.Notification, error)
 
+	// FindReadSince finds notifications of the user read at or after since, oldest read first
+	FindReadSince(ctx context.Context, userID uuid.UUID, since time.Time, limit int) ([]*notification.Notification, error)
+
 	// FindByType finds uvedomleniya specific type for user
 	FindByType(
 		ctx context.Context,
@@ -75,3 +78,13 @@ type Repository interface {
 	CommandRepository
 	QueryRepository
 }
+
+// PreferencesRepository stores the notification preferences of users
+// interface declared on the consumer side (application layer)
+type PreferencesRepository interface {
+	// FindPreferences returns the preferences of the user, errs.ErrNotFound when never saved
+	FindPreferences(ctx context.Context, userID uuid.UUID) (notification.Preferences, error)
+
+	// SavePreferences replaces the preferences of the user
+	SavePreferences(ctx context.Context, userID uuid.UUID, prefs notification.Preferences) error
+}
@@ -1,8 +1,11 @@
 package notification
 
 import (
+	"time"
+
 	"github.com/lllypuk/flowra/internal/application/appcore"
 	"github.com/lllypuk/flowra/internal/domain/notification"
+	"github.com/lllypuk/flowra/internal/domain/uuid"
 )
 
 // Result - result operatsii s notification
@@ -18,7 +21,40 @@ type ListResult struct {
 	Limit         int
 }
 
+// InboxEntry - notification with the workspace of its resource
+type InboxEntry struct {
+	Notification  *notification.Notification
+	WorkspaceID   uuid.UUID // zero when the resource belongs to none of the user's workspaces
+	WorkspaceName string
+}
+
+// WorkspaceFacet - notification counts of one workspace in the unified inbox
+type WorkspaceFacet struct {
+	WorkspaceID uuid.UUID
+	Name        string
+	Total       int
+	Unread      int
+}
+
+// UnifiedInboxResult - notifications across all workspaces of the user
+type UnifiedInboxResult struct {
+	Entries     []InboxEntry
+	Facets      []WorkspaceFacet
+	TotalCount  int // matching the filter within the window
+	UnreadCount int // all unread notifications of the user
+	Offset      int
+	Limit       int
+}
+
 // CountResult - result podscheta
 type CountResult struct {
 	Count int
 }
+
+// SyncReadStateResult - read-state changes since the requested time
+type SyncReadStateResult struct {
+	Read        []*notification.Notification // read since the requested time, oldest first
+	UnreadCount int
+	SyncedAt    time.Time // pass as Since of the next sync
+	FullResync  bool      // too many changes; Read is empty and the client reloads its notifications
+}
@@ -0,0 +1,75 @@
+package notification
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/lllypuk/flowra/internal/application/appcore"
+)
+
+// maxReadStateChanges - maximum count of read notifications returned by one sync;
+// with more the client reloads its notifications instead
+const maxReadStateChanges = 500
+
+// SyncReadStateUseCase returns the read-state changes a session missed, e.g. while
+// its WebSocket connection was down
+type SyncReadStateUseCase struct {
+	notificationRepo Repository
+}
+
+// NewSyncReadStateUseCase creates New use case for read-state sync
+func NewSyncReadStateUseCase(
+	notificationRepo Repository,
+) *SyncReadStateUseCase {
+	return &SyncReadStateUseCase{
+		notificationRepo: notificationRepo,
+	}
+}
+
+// Execute returns the notifications read since query.Since. SyncedAt is taken before
+// the lookup, so passing it as the next Since may repeat a change but never misses one.
+func (uc *SyncReadStateUseCase) Execute(
+	ctx context.Context,
+	query SyncReadStateQuery,
+) (SyncReadStateResult, error) {
+	// validation
+	if err := uc.validate(query); err != nil {
+		return SyncReadStateResult{}, fmt.Errorf("validation failed: %w", err)
+	}
+
+	syncedAt := time.Now().UTC()
+
+	// one more than the limit tells whether the changes were cut off
+	read, err := uc.notificationRepo.FindReadSince(ctx, query.UserID, query.Since, maxReadStateChanges+1)
+	if err != nil {
+		return SyncReadStateResult{}, fmt.Errorf("failed to find read notifications: %w", err)
+	}
+
+	unread, err := uc.notificationRepo.CountUnreadByUserID(ctx, query.UserID)
+	if err != nil {
+		return SyncReadStateResult{}, fmt.Errorf("failed to count unread notifications: %w", err)
+	}
+
+	result := SyncReadStateResult{
+		UnreadCount: unread,
+		SyncedAt:    syncedAt,
+	}
+	if len(read) > maxReadStateChanges {
+		result.FullResync = true
+		return result, nil
+	}
+	result.Read = read
+	return result, nil
+}
+
+// validate validates request
+func (uc *SyncReadStateUseCase) validate(query SyncReadStateQuery) error {
+	if err := appcore.ValidateUUID("userID", query.UserID); err != nil {
+		return err
+	}
+	if query.Since.IsZero() {
+		return appcore.NewValidationError("since", "is required")
+	}
+	return nil
+}
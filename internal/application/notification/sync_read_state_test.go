@@ -0,0 +1,110 @@
+package notification_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/lllypuk/flowra/internal/application/notification"
+	domainnotification "github.com/lllypuk/flowra/internal/domain/notification"
+	"github.com/lllypuk/flowra/internal/domain/uuid"
+)
+
+func newReadStateNotification(
+	t *testing.T,
+	repo *mockNotificationRepository,
+	userID uuid.UUID,
+	readAt *time.Time,
+) *domainnotification.Notification {
+	t.Helper()
+	notif := domainnotification.Reconstruct(
+		uuid.NewUUID(),
+		userID,
+		domainnotification.TypeTaskAssigned,
+		"Task Assigned",
+		"You have been assigned to a task",
+		"",
+		readAt,
+		time.Now().Add(-time.Hour),
+	)
+	repo.Save(context.Background(), notif)
+	return notif
+}
+
+func TestSyncReadStateUseCase_Execute_ReturnsReadSince(t *testing.T) {
+	// Arrange
+	repo := newMockNotificationRepository()
+	userID := uuid.NewUUID()
+	since := time.Now().Add(-10 * time.Minute)
+	before := since.Add(-time.Minute)
+	after := since.Add(time.Minute)
+
+	newReadStateNotification(t, repo, userID, &before)
+	readAfter := newReadStateNotification(t, repo, userID, &after)
+	newReadStateNotification(t, repo, userID, nil)
+	newReadStateNotification(t, repo, uuid.NewUUID(), &after)
+
+	useCase := notification.NewSyncReadStateUseCase(repo)
+
+	// Act
+	result, err := useCase.Execute(context.Background(), notification.SyncReadStateQuery{
+		UserID: userID,
+		Since:  since,
+	})
+
+	// Assert
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if len(result.Read) != 1 || result.Read[0].ID() != readAfter.ID() {
+		t.Fatalf("expected only the notification read after since, got %d", len(result.Read))
+	}
+	if result.UnreadCount != 1 {
+		t.Errorf("expected 1 unread notification, got %d", result.UnreadCount)
+	}
+	if result.FullResync {
+		t.Error("expected no full resync")
+	}
+	if result.SyncedAt.Before(after) {
+		t.Errorf("expected synced at to be now, got %v", result.SyncedAt)
+	}
+}
+
+func TestSyncReadStateUseCase_Execute_TooManyChanges(t *testing.T) {
+	// Arrange
+	repo := newMockNotificationRepository()
+	userID := uuid.NewUUID()
+	readAt := time.Now().Add(-time.Minute)
+	for range 501 {
+		newReadStateNotification(t, repo, userID, &readAt)
+	}
+
+	useCase := notification.NewSyncReadStateUseCase(repo)
+
+	// Act
+	result, err := useCase.Execute(context.Background(), notification.SyncReadStateQuery{
+		UserID: userID,
+		Since:  readAt.Add(-time.Minute),
+	})
+
+	// Assert
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if !result.FullResync {
+		t.Error("expected a full resync")
+	}
+	if len(result.Read) != 0 {
+		t.Errorf("expected no changes with a full resync, got %d", len(result.Read))
+	}
+}
+
+func TestSyncReadStateUseCase_Validate_MissingSince(t *testing.T) {
+	useCase := notification.NewSyncReadStateUseCase(newMockNotificationRepository())
+
+	_, err := useCase.Execute(context.Background(), notification.SyncReadStateQuery{UserID: uuid.NewUUID()})
+
+	if err == nil {
+		t.Fatal("expected validation error")
+	}
+}
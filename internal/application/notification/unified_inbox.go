@@ -0,0 +1,190 @@
+package notification
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/lllypuk/flowra/internal/application/appcore"
+	"github.com/lllypuk/flowra/internal/domain/notification"
+	"github.com/lllypuk/flowra/internal/domain/uuid"
+)
+
+const (
+	// UnifiedInboxWindow - the latest notifications the unified inbox groups and filters
+	UnifiedInboxWindow = 500
+
+	defaultUnifiedInboxLimit = 50
+	maxUnifiedInboxLimit     = 100
+)
+
+// WorkspaceResolver finds the workspace of the resource a notification references
+// interface declared on the consumer side (application layer)
+type WorkspaceResolver interface {
+	// ResolveWorkspace returns the workspace, zero UUID when the resource belongs to none or is gone
+	ResolveWorkspace(ctx context.Context, typ notification.Type, resourceID string) (uuid.UUID, error)
+}
+
+// WorkspaceDirectory lists the workspaces of a user
+// interface declared on the consumer side (application layer)
+type WorkspaceDirectory interface {
+	// WorkspaceNames returns the names of the workspaces the user is a member of, by ID
+	WorkspaceNames(ctx context.Context, userID uuid.UUID) (map[uuid.UUID]string, error)
+}
+
+// UnifiedInboxUseCase lists notifications across all workspaces of the user with workspace facets
+type UnifiedInboxUseCase struct {
+	notificationRepo QueryRepository
+	grouper          workspaceGrouper
+}
+
+// NewUnifiedInboxUseCase creates a new unified inbox use case
+func NewUnifiedInboxUseCase(
+	notificationRepo QueryRepository,
+	resolver WorkspaceResolver,
+	directory WorkspaceDirectory,
+) *UnifiedInboxUseCase {
+	return &UnifiedInboxUseCase{
+		notificationRepo: notificationRepo,
+		grouper:          workspaceGrouper{resolver: resolver, directory: directory},
+	}
+}
+
+// Execute lists the latest UnifiedInboxWindow notifications, filtered and paginated.
+// Facets cover the same window; UnreadCount covers all notifications of the user.
+func (uc *UnifiedInboxUseCase) Execute(ctx context.Context, query UnifiedInboxQuery) (UnifiedInboxResult, error) {
+	if err := uc.validate(query); err != nil {
+		return UnifiedInboxResult{}, fmt.Errorf("validation failed: %w", err)
+	}
+
+	limit := query.Limit
+	if limit == 0 || limit > maxUnifiedInboxLimit {
+		limit = defaultUnifiedInboxLimit
+	}
+
+	notifications, err := uc.notificationRepo.FindByUserID(ctx, query.UserID, 0, UnifiedInboxWindow)
+	if err != nil {
+		return UnifiedInboxResult{}, fmt.Errorf("failed to fetch notifications: %w", err)
+	}
+	entries, err := uc.grouper.group(ctx, query.UserID, notifications)
+	if err != nil {
+		return UnifiedInboxResult{}, err
+	}
+
+	unreadCount, err := uc.notificationRepo.CountUnreadByUserID(ctx, query.UserID)
+	if err != nil {
+		return UnifiedInboxResult{}, fmt.Errorf("failed to count notifications: %w", err)
+	}
+
+	matching := make([]InboxEntry, 0, len(entries))
+	for _, entry := range entries {
+		if query.UnreadOnly && entry.Notification.IsRead() {
+			continue
+		}
+		if !query.WorkspaceID.IsZero() && entry.WorkspaceID != query.WorkspaceID {
+			continue
+		}
+		matching = append(matching, entry)
+	}
+
+	start := min(query.Offset, len(matching))
+	end := min(start+limit, len(matching))
+
+	return UnifiedInboxResult{
+		Entries:     matching[start:end],
+		Facets:      workspaceFacets(entries),
+		TotalCount:  len(matching),
+		UnreadCount: unreadCount,
+		Offset:      query.Offset,
+		Limit:       limit,
+	}, nil
+}
+
+// validate validates request
+func (uc *UnifiedInboxUseCase) validate(query UnifiedInboxQuery) error {
+	if err := appcore.ValidateUUID("userID", query.UserID); err != nil {
+		return err
+	}
+	if query.Limit < 0 {
+		return appcore.NewValidationError("limit", "must be non-negative")
+	}
+	if query.Offset < 0 {
+		return appcore.NewValidationError("offset", "must be non-negative")
+	}
+	return nil
+}
+
+// workspaceGrouper assigns notifications to the workspaces of their user
+type workspaceGrouper struct {
+	resolver  WorkspaceResolver
+	directory WorkspaceDirectory
+}
+
+// group resolves the workspace of every notification. Resources of workspaces the user
+// is not a member of (anymore) are grouped with those that belong to no workspace.
+func (g workspaceGrouper) group(
+	ctx context.Context,
+	userID uuid.UUID,
+	notifications []*notification.Notification,
+) ([]InboxEntry, error) {
+	names, err := g.directory.WorkspaceNames(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list workspaces: %w", err)
+	}
+
+	// notifications about the same chat or task resolve once
+	resolved := make(map[string]uuid.UUID)
+	entries := make([]InboxEntry, 0, len(notifications))
+	for _, n := range notifications {
+		key := string(n.Type()) + ":" + n.ResourceID()
+		workspaceID, ok := resolved[key]
+		if !ok {
+			workspaceID, err = g.resolver.ResolveWorkspace(ctx, n.Type(), n.ResourceID())
+			if err != nil {
+				return nil, fmt.Errorf("failed to resolve workspace of notification %s: %w", n.ID(), err)
+			}
+			resolved[key] = workspaceID
+		}
+
+		name, member := names[workspaceID]
+		if !member {
+			workspaceID = ""
+		}
+		entries = append(entries, InboxEntry{Notification: n, WorkspaceID: workspaceID, WorkspaceName: name})
+	}
+	return entries, nil
+}
+
+// workspaceFacets counts entries per workspace, most unread first and the
+// notifications of no workspace last
+func workspaceFacets(entries []InboxEntry) []WorkspaceFacet {
+	index := make(map[uuid.UUID]int)
+	var facets []WorkspaceFacet
+	for _, entry := range entries {
+		i, ok := index[entry.WorkspaceID]
+		if !ok {
+			i = len(facets)
+			index[entry.WorkspaceID] = i
+			facets = append(facets, WorkspaceFacet{WorkspaceID: entry.WorkspaceID, Name: entry.WorkspaceName})
+		}
+		facets[i].Total++
+		if !entry.Notification.IsRead() {
+			facets[i].Unread++
+		}
+	}
+
+	slices.SortStableFunc(facets, func(a, b WorkspaceFacet) int {
+		if a.WorkspaceID.IsZero() != b.WorkspaceID.IsZero() {
+			if a.WorkspaceID.IsZero() {
+				return 1
+			}
+			return -1
+		}
+		if c := cmp.Compare(b.Unread, a.Unread); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.Name, b.Name)
+	})
+	return facets
+}
@@ -0,0 +1,209 @@
+package notification_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/lllypuk/flowra/internal/application/notification"
+	domainnotification "github.com/lllypuk/flowra/internal/domain/notification"
+	"github.com/lllypuk/flowra/internal/domain/uuid"
+)
+
+// mapWorkspaceResolver resolves resource IDs from a fixed map
+type mapWorkspaceResolver map[string]uuid.UUID
+
+func (r mapWorkspaceResolver) ResolveWorkspace(
+	_ context.Context,
+	_ domainnotification.Type,
+	resourceID string,
+) (uuid.UUID, error) {
+	return r[resourceID], nil
+}
+
+type mapWorkspaceDirectory map[uuid.UUID]string
+
+func (d mapWorkspaceDirectory) WorkspaceNames(context.Context, uuid.UUID) (map[uuid.UUID]string, error) {
+	return d, nil
+}
+
+type unifiedInboxFixture struct {
+	repo      *mockNotificationRepository
+	resolver  mapWorkspaceResolver
+	directory mapWorkspaceDirectory
+	userID    uuid.UUID
+	alpha     uuid.UUID
+	beta      uuid.UUID
+}
+
+// newUnifiedInboxFixture creates 2 unread notifications in alpha, 1 unread and 1 read in beta
+// and 1 unread about a workspace the user left
+func newUnifiedInboxFixture(t *testing.T) *unifiedInboxFixture {
+	t.Helper()
+	f := &unifiedInboxFixture{
+		repo:     newMockNotificationRepository(),
+		resolver: make(mapWorkspaceResolver),
+		userID:   uuid.NewUUID(),
+		alpha:    uuid.NewUUID(),
+		beta:     uuid.NewUUID(),
+	}
+	f.directory = mapWorkspaceDirectory{f.alpha: "Alpha", f.beta: "Beta"}
+
+	left := uuid.NewUUID()
+	for _, spec := range []struct {
+		workspaceID uuid.UUID
+		read        bool
+	}{
+		{f.alpha, false}, {f.alpha, false}, {f.beta, false}, {f.beta, true}, {left, false},
+	} {
+		f.add(t, spec.workspaceID, spec.read)
+	}
+	return f
+}
+
+func (f *unifiedInboxFixture) add(t *testing.T, workspaceID uuid.UUID, read bool) *domainnotification.Notification {
+	t.Helper()
+	chatID := uuid.NewUUID().String()
+	f.resolver[chatID] = workspaceID
+	notif, err := domainnotification.NewNotification(
+		f.userID, domainnotification.TypeTaskAssigned, "Task Assigned", "You have been assigned", chatID)
+	if err != nil {
+		t.Fatalf("failed to create notification: %v", err)
+	}
+	if read {
+		_ = notif.MarkAsRead()
+	}
+	f.repo.Save(context.Background(), notif)
+	return notif
+}
+
+func TestUnifiedInboxUseCase_Execute_Facets(t *testing.T) {
+	f := newUnifiedInboxFixture(t)
+	useCase := notification.NewUnifiedInboxUseCase(f.repo, f.resolver, f.directory)
+
+	result, err := useCase.Execute(context.Background(), notification.UnifiedInboxQuery{UserID: f.userID})
+
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if result.TotalCount != 5 || len(result.Entries) != 5 {
+		t.Errorf("expected 5 entries, got %d of %d", len(result.Entries), result.TotalCount)
+	}
+	if result.UnreadCount != 4 {
+		t.Errorf("expected 4 unread, got %d", result.UnreadCount)
+	}
+
+	want := []notification.WorkspaceFacet{
+		{WorkspaceID: f.alpha, Name: "Alpha", Total: 2, Unread: 2},
+		{WorkspaceID: f.beta, Name: "Beta", Total: 2, Unread: 1},
+		{Total: 1, Unread: 1},
+	}
+	if len(result.Facets) != len(want) {
+		t.Fatalf("expected %d facets, got %+v", len(want), result.Facets)
+	}
+	for i := range want {
+		if result.Facets[i] != want[i] {
+			t.Errorf("facet %d: expected %+v, got %+v", i, want[i], result.Facets[i])
+		}
+	}
+}
+
+func TestUnifiedInboxUseCase_Execute_Filters(t *testing.T) {
+	f := newUnifiedInboxFixture(t)
+	useCase := notification.NewUnifiedInboxUseCase(f.repo, f.resolver, f.directory)
+
+	result, err := useCase.Execute(context.Background(), notification.UnifiedInboxQuery{
+		UserID:      f.userID,
+		WorkspaceID: f.beta,
+		UnreadOnly:  true,
+	})
+
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if result.TotalCount != 1 || len(result.Entries) != 1 {
+		t.Fatalf("expected 1 entry, got %d of %d", len(result.Entries), result.TotalCount)
+	}
+	entry := result.Entries[0]
+	if entry.WorkspaceID != f.beta || entry.WorkspaceName != "Beta" || entry.Notification.IsRead() {
+		t.Errorf("expected the unread Beta notification, got %+v", entry)
+	}
+	if len(result.Facets) != 3 {
+		t.Errorf("facets should ignore the filter, got %+v", result.Facets)
+	}
+
+	paged, err := useCase.Execute(context.Background(), notification.UnifiedInboxQuery{
+		UserID: f.userID,
+		Limit:  2,
+		Offset: 4,
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if paged.TotalCount != 5 || len(paged.Entries) != 1 {
+		t.Errorf("expected the last of 5 entries, got %d of %d", len(paged.Entries), paged.TotalCount)
+	}
+}
+
+func TestMarkManyAsReadUseCase_Execute_ByWorkspace(t *testing.T) {
+	f := newUnifiedInboxFixture(t)
+	useCase := notification.NewMarkManyAsReadUseCase(f.repo, f.resolver, f.directory)
+
+	result, err := useCase.Execute(context.Background(), notification.MarkManyAsReadCommand{
+		UserID:      f.userID,
+		WorkspaceID: f.alpha,
+	})
+
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if result.Count != 2 {
+		t.Errorf("expected 2 notifications to be marked, got %d", result.Count)
+	}
+	unread, _ := f.repo.CountUnreadByUserID(context.Background(), f.userID)
+	if unread != 2 {
+		t.Errorf("expected 2 unread left, got %d", unread)
+	}
+}
+
+func TestMarkManyAsReadUseCase_Execute_ByIDs(t *testing.T) {
+	f := newUnifiedInboxFixture(t)
+	mine := f.add(t, f.alpha, false)
+	other, _ := domainnotification.NewNotification(
+		uuid.NewUUID(), domainnotification.TypeTaskAssigned, "Task Assigned", "Not yours", "")
+	f.repo.Save(context.Background(), other)
+	useCase := notification.NewMarkManyAsReadUseCase(f.repo, f.resolver, f.directory)
+
+	result, err := useCase.Execute(context.Background(), notification.MarkManyAsReadCommand{
+		UserID:          f.userID,
+		NotificationIDs: []uuid.UUID{mine.ID(), other.ID()},
+	})
+
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if result.Count != 1 {
+		t.Errorf("expected 1 notification to be marked, got %d", result.Count)
+	}
+	if !mine.IsRead() || other.IsRead() {
+		t.Error("expected only the caller's notification to be marked as read")
+	}
+}
+
+func TestMarkManyAsReadUseCase_Execute_Validation(t *testing.T) {
+	f := newUnifiedInboxFixture(t)
+	useCase := notification.NewMarkManyAsReadUseCase(f.repo, f.resolver, f.directory)
+
+	tooMany := make([]uuid.UUID, notification.MaxBulkMarkAsRead+1)
+	for i := range tooMany {
+		tooMany[i] = uuid.NewUUID()
+	}
+	for name, cmd := range map[string]notification.MarkManyAsReadCommand{
+		"neither":  {UserID: f.userID},
+		"both":     {UserID: f.userID, WorkspaceID: f.alpha, NotificationIDs: []uuid.UUID{uuid.NewUUID()}},
+		"too many": {UserID: f.userID, NotificationIDs: tooMany},
+	} {
+		if _, err := useCase.Execute(context.Background(), cmd); err == nil {
+			t.Errorf("%s: expected validation error", name)
+		}
+	}
+}
@@ -0,0 +1,13 @@
+package report
+
+import "github.com/lllypuk/flowra/internal/domain/uuid"
+
+// GenerateCommand - recompute and cache the reports of a workspace
+type GenerateCommand struct {
+	WorkspaceID uuid.UUID
+}
+
+// GetQuery - read the cached reports of a workspace
+type GetQuery struct {
+	WorkspaceID uuid.UUID
+}
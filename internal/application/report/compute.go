@@ -0,0 +1,235 @@
+package report
+
+import (
+	"math"
+	"sort"
+	"time"
+
+	"github.com/lllypuk/flowra/internal/domain/chat"
+	taskdomain "github.com/lllypuk/flowra/internal/domain/task"
+	"github.com/lllypuk/flowra/internal/domain/uuid"
+)
+
+// Report windows
+const (
+	burndownSprints = 5  // latest sprints that get a burndown
+	maxBurndownDays = 90 // longer sprints keep their last days only
+	flowDays        = 30
+	cycleTimeDays   = 90
+	p85             = 0.85
+	day             = 24 * time.Hour
+)
+
+// flowStatuses is the stacking order of the cumulative flow diagram;
+// cancelled items are left out
+func flowStatuses() []taskdomain.Status {
+	return []taskdomain.Status{
+		taskdomain.StatusBacklog,
+		taskdomain.StatusToDo,
+		taskdomain.StatusInProgress,
+		taskdomain.StatusInReview,
+		taskdomain.StatusDone,
+	}
+}
+
+// buildSnapshot computes all reports from the item timelines at the given moment
+func buildSnapshot(workspaceID uuid.UUID, items []*timeline, now time.Time) *Snapshot {
+	now = now.UTC()
+	return &Snapshot{
+		WorkspaceID:    workspaceID,
+		GeneratedAt:    now,
+		Burndowns:      buildBurndowns(items, now),
+		CumulativeFlow: buildCumulativeFlow(items, now),
+		CycleTime:      buildCycleTime(items, now),
+	}
+}
+
+func buildBurndowns(items []*timeline, now time.Time) []SprintBurndown {
+	bySprint := make(map[string][]*timeline)
+	for _, item := range items {
+		if item.sprint != "" {
+			bySprint[item.sprint] = append(bySprint[item.sprint], item)
+		}
+	}
+
+	sprints := make([]string, 0, len(bySprint))
+	for name := range bySprint {
+		sprints = append(sprints, name)
+	}
+	sort.Slice(sprints, func(i, j int) bool {
+		return chat.CompareSprintNames(sprints[i], sprints[j]) < 0
+	})
+	if len(sprints) > burndownSprints {
+		sprints = sprints[len(sprints)-burndownSprints:]
+	}
+
+	burndowns := make([]SprintBurndown, 0, len(sprints))
+	for _, name := range sprints {
+		burndowns = append(burndowns, buildBurndown(name, bySprint[name], now))
+	}
+	return burndowns
+}
+
+func buildBurndown(sprint string, items []*timeline, now time.Time) SprintBurndown {
+	start := now
+	end := time.Time{}
+	open := false
+	for _, item := range items {
+		if item.sprintAt.Before(start) {
+			start = item.sprintAt
+		}
+		doneAt, done := item.completedAt()
+		switch {
+		case done:
+			end = latest(end, doneAt)
+		case item.current() != taskdomain.StatusCancelled:
+			open = true
+		}
+	}
+	if open || end.IsZero() {
+		end = now
+	}
+
+	startDay, endDay := startOfDay(start), startOfDay(end)
+	if days := int(endDay.Sub(startDay)/day) + 1; days > maxBurndownDays {
+		startDay = endDay.AddDate(0, 0, -(maxBurndownDays - 1))
+	}
+
+	burndown := SprintBurndown{Sprint: sprint, StartDate: startOfDay(start), EndDate: endDay}
+	for d := startDay; !d.After(endDay); d = d.AddDate(0, 0, 1) {
+		burndown.Days = append(burndown.Days, burndownDay(items, d, endOfDay(d, now)))
+	}
+	return burndown
+}
+
+func burndownDay(items []*timeline, date, at time.Time) BurndownDay {
+	bd := BurndownDay{Date: date}
+	for _, item := range items {
+		if item.sprintAt.After(at) {
+			continue
+		}
+		status, ok := item.statusAt(at)
+		if !ok || status == taskdomain.StatusCancelled {
+			continue
+		}
+		remaining := status != taskdomain.StatusDone
+
+		bd.ScopeTasks++
+		if remaining {
+			bd.RemainingTasks++
+		}
+		if item.estimate == nil {
+			continue
+		}
+		switch item.estimate.Unit {
+		case chat.EstimateUnitPoints:
+			bd.ScopePoints += item.estimate.Value
+			if remaining {
+				bd.RemainingPoints += item.estimate.Value
+			}
+		case chat.EstimateUnitHours:
+			bd.ScopeHours += item.estimate.Value
+			if remaining {
+				bd.RemainingHours += item.estimate.Value
+			}
+		}
+	}
+	return bd
+}
+
+func buildCumulativeFlow(items []*timeline, now time.Time) CumulativeFlow {
+	statuses := flowStatuses()
+	flow := CumulativeFlow{Statuses: make([]string, 0, len(statuses))}
+	index := make(map[taskdomain.Status]int, len(statuses))
+	for i, s := range statuses {
+		flow.Statuses = append(flow.Statuses, string(s))
+		index[s] = i
+	}
+
+	today := startOfDay(now)
+	for d := today.AddDate(0, 0, -(flowDays - 1)); !d.After(today); d = d.AddDate(0, 0, 1) {
+		at := endOfDay(d, now)
+		counts := make([]int, len(statuses))
+		for _, item := range items {
+			status, ok := item.statusAt(at)
+			if !ok {
+				continue
+			}
+			if i, tracked := index[status]; tracked {
+				counts[i]++
+			}
+		}
+		flow.Days = append(flow.Days, FlowDay{Date: d, Counts: counts})
+	}
+	return flow
+}
+
+func buildCycleTime(items []*timeline, now time.Time) CycleTimeReport {
+	since := now.Add(-cycleTimeDays * day)
+	var lead, cycle []float64
+	for _, item := range items {
+		doneAt, done := item.completedAt()
+		if !done || doneAt.Before(since) {
+			continue
+		}
+		lead = append(lead, doneAt.Sub(item.typedAt).Hours())
+		if startedAt, started := item.startedAt(); started && !startedAt.After(doneAt) {
+			cycle = append(cycle, doneAt.Sub(startedAt).Hours())
+		}
+	}
+
+	return CycleTimeReport{
+		WindowDays: cycleTimeDays,
+		LeadTime:   durationStats(lead),
+		CycleTime:  durationStats(cycle),
+	}
+}
+
+// durationStats uses nearest-rank percentiles
+func durationStats(hours []float64) DurationStats {
+	if len(hours) == 0 {
+		return DurationStats{}
+	}
+	sorted := append([]float64(nil), hours...)
+	sort.Float64s(sorted)
+
+	total := 0.0
+	for _, h := range sorted {
+		total += h
+	}
+	return DurationStats{
+		Count:        len(sorted),
+		AverageHours: roundHours(total / float64(len(sorted))),
+		MedianHours:  roundHours(percentile(sorted, 0.5)),
+		P85Hours:     roundHours(percentile(sorted, p85)),
+	}
+}
+
+func percentile(sorted []float64, p float64) float64 {
+	rank := int(math.Ceil(p*float64(len(sorted)))) - 1
+	return sorted[max(rank, 0)]
+}
+
+func roundHours(h float64) float64 {
+	return math.Round(h*10) / 10
+}
+
+func startOfDay(t time.Time) time.Time {
+	return t.UTC().Truncate(day)
+}
+
+// endOfDay is the last moment of the day, or now for today
+func endOfDay(date, now time.Time) time.Time {
+	end := date.Add(day - time.Nanosecond)
+	if end.After(now) {
+		return now
+	}
+	return end
+}
+
+func latest(a, b time.Time) time.Time {
+	if b.After(a) {
+		return b
+	}
+	return a
+}
@@ -0,0 +1,193 @@
+package report //nolint:testpackage // builds timelines from events directly
+
+import (
+	"testing"
+	"time"
+
+	"github.com/lllypuk/flowra/internal/domain/chat"
+	"github.com/lllypuk/flowra/internal/domain/event"
+	taskdomain "github.com/lllypuk/flowra/internal/domain/task"
+	"github.com/lllypuk/flowra/internal/domain/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// history builds a chat event stream with explicit timestamps
+type history struct {
+	chatID uuid.UUID
+	userID uuid.UUID
+	events []event.DomainEvent
+}
+
+func newHistory(chatType chat.Type, at time.Time) *history {
+	h := &history{chatID: uuid.NewUUID(), userID: uuid.NewUUID()}
+	h.events = append(h.events,
+		chat.NewChatCreated(h.chatID, uuid.NewUUID(), chatType, true, h.userID, at, event.Metadata{}))
+	return h
+}
+
+func (h *history) add(at time.Time, e event.DomainEvent) *history {
+	switch evt := e.(type) {
+	case *chat.TypeChanged:
+		evt.OccAt = at
+	case *chat.StatusChanged:
+		evt.OccAt = at
+	case *chat.SprintSet:
+		evt.OccAt = at
+	case *chat.EstimateSet:
+		evt.OccAt = at
+	case *chat.Deleted:
+		evt.OccAt = at
+	}
+	h.events = append(h.events, e)
+	return h
+}
+
+func (h *history) toTask(at time.Time) *history {
+	return h.add(at, chat.NewChatTypeChanged(h.chatID, chat.TypeDiscussion, chat.TypeTask, "Task", 0, event.Metadata{}))
+}
+
+func (h *history) status(at time.Time, status string) *history {
+	return h.add(at, chat.NewStatusChanged(h.chatID, "", status, h.userID, 0, event.Metadata{}))
+}
+
+func (h *history) sprint(at time.Time, sprint string) *history {
+	return h.add(at, chat.NewSprintSet(h.chatID, "", sprint, h.userID, 0, event.Metadata{}))
+}
+
+func (h *history) estimate(at time.Time, value float64, unit chat.EstimateUnit) *history {
+	return h.add(at, chat.NewEstimateSet(h.chatID, nil, &chat.Estimate{Value: value, Unit: unit}, h.userID, 0, event.Metadata{}))
+}
+
+func (h *history) timeline(t *testing.T) *timeline {
+	t.Helper()
+	item, ok := buildTimeline(h.events)
+	require.True(t, ok)
+	return item
+}
+
+func at(d, hour int) time.Time {
+	return time.Date(2026, time.March, d, hour, 0, 0, 0, time.UTC)
+}
+
+func TestBuildTimeline_SkipsUntypedAndDeletedChats(t *testing.T) {
+	discussion := newHistory(chat.TypeDiscussion, at(1, 9))
+	_, ok := buildTimeline(discussion.events)
+	assert.False(t, ok)
+
+	epic := newHistory(chat.TypeEpic, at(1, 9))
+	_, ok = buildTimeline(epic.events)
+	assert.False(t, ok)
+
+	deleted := newHistory(chat.TypeTask, at(1, 9))
+	deleted.add(at(2, 9), chat.NewChatDeleted(deleted.chatID, deleted.userID, at(2, 9), 0, event.Metadata{}))
+	_, ok = buildTimeline(deleted.events)
+	assert.False(t, ok)
+}
+
+func TestBuildTimeline_StatusHistory(t *testing.T) {
+	item := newHistory(chat.TypeBug, at(1, 9)).
+		status(at(2, 9), "Investigating").
+		status(at(3, 9), "Fixed").
+		status(at(4, 9), "Verified").
+		timeline(t)
+
+	status, ok := item.statusAt(at(2, 12))
+	require.True(t, ok)
+	assert.Equal(t, taskdomain.StatusInProgress, status)
+
+	_, ok = item.statusAt(at(1, 8))
+	assert.False(t, ok)
+
+	doneAt, done := item.completedAt()
+	require.True(t, done)
+	assert.Equal(t, at(4, 9), doneAt)
+
+	startedAt, started := item.startedAt()
+	require.True(t, started)
+	assert.Equal(t, at(2, 9), startedAt)
+}
+
+func TestBuildSnapshot(t *testing.T) {
+	now := at(10, 12)
+
+	taskItem := newHistory(chat.TypeDiscussion, at(1, 9)).
+		toTask(at(1, 10)).
+		sprint(at(2, 9), "Sprint 1").
+		estimate(at(2, 9), 3, chat.EstimateUnitPoints).
+		status(at(3, 10), "In Progress").
+		status(at(5, 10), "Done").
+		timeline(t)
+	bugItem := newHistory(chat.TypeBug, at(2, 8)).
+		sprint(at(2, 10), "Sprint 1").
+		estimate(at(2, 10), 8, chat.EstimateUnitHours).
+		status(at(6, 10), "Investigating").
+		timeline(t)
+
+	snapshot := buildSnapshot(uuid.NewUUID(), []*timeline{taskItem, bugItem}, now)
+
+	t.Run("burndown", func(t *testing.T) {
+		burndown, ok := snapshot.Burndown("Sprint 1")
+		require.True(t, ok)
+		assert.Equal(t, at(2, 0), burndown.StartDate)
+		assert.Equal(t, at(10, 0), burndown.EndDate)
+		require.Len(t, burndown.Days, 9)
+
+		first := burndown.Days[0]
+		assert.Equal(t, 2, first.ScopeTasks)
+		assert.Equal(t, 2, first.RemainingTasks)
+		assert.InDelta(t, 3.0, first.RemainingPoints, 0.001)
+		assert.InDelta(t, 8.0, first.RemainingHours, 0.001)
+
+		afterDone := burndown.Days[3] // March 5
+		assert.Equal(t, 1, afterDone.RemainingTasks)
+		assert.InDelta(t, 0.0, afterDone.RemainingPoints, 0.001)
+		assert.InDelta(t, 3.0, afterDone.ScopePoints, 0.001)
+		assert.InDelta(t, 8.0, afterDone.RemainingHours, 0.001)
+	})
+
+	t.Run("cumulative flow", func(t *testing.T) {
+		flow := snapshot.CumulativeFlow
+		require.Len(t, flow.Days, flowDays)
+		assert.Equal(t, []string{"Backlog", "To Do", "In Progress", "In Review", "Done"}, flow.Statuses)
+
+		assert.Equal(t, []int{0, 0, 0, 0, 0}, flow.Days[0].Counts)
+		last := flow.Days[len(flow.Days)-1]
+		assert.Equal(t, at(10, 0), last.Date)
+		assert.Equal(t, []int{0, 0, 1, 0, 1}, last.Counts)
+	})
+
+	t.Run("cycle time", func(t *testing.T) {
+		ct := snapshot.CycleTime
+		assert.Equal(t, 1, ct.LeadTime.Count)
+		assert.InDelta(t, 96.0, ct.LeadTime.AverageHours, 0.001)
+		assert.Equal(t, 1, ct.CycleTime.Count)
+		assert.InDelta(t, 48.0, ct.CycleTime.MedianHours, 0.001)
+	})
+}
+
+func TestBuildBurndowns_KeepsLatestSprints(t *testing.T) {
+	var items []*timeline
+	for i := 1; i <= burndownSprints+2; i++ {
+		items = append(items, newHistory(chat.TypeTask, at(1, 9)).
+			sprint(at(1, 10), "Sprint "+string(rune('0'+i))).
+			timeline(t))
+	}
+
+	burndowns := buildBurndowns(items, at(3, 12))
+
+	require.Len(t, burndowns, burndownSprints)
+	assert.Equal(t, "Sprint 3", burndowns[0].Sprint)
+	assert.Equal(t, "Sprint 7", burndowns[len(burndowns)-1].Sprint)
+}
+
+func TestDurationStats(t *testing.T) {
+	stats := durationStats([]float64{10, 1, 3, 2, 4})
+
+	assert.Equal(t, 5, stats.Count)
+	assert.InDelta(t, 4.0, stats.AverageHours, 0.001)
+	assert.InDelta(t, 3.0, stats.MedianHours, 0.001)
+	assert.InDelta(t, 10.0, stats.P85Hours, 0.001)
+
+	assert.Equal(t, DurationStats{}, durationStats(nil))
+}
@@ -0,0 +1,94 @@
+package report
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/lllypuk/flowra/internal/application/appcore"
+	chatapp "github.com/lllypuk/flowra/internal/application/chat"
+	"github.com/lllypuk/flowra/internal/domain/chat"
+)
+
+// chatPageSize bounds the chat read model pages loaded per query
+const chatPageSize = 200
+
+// GenerateUseCase rebuilds the report snapshot of a workspace from the event
+// history of its tasks and bugs and stores it in the snapshot repository.
+type GenerateUseCase struct {
+	chats     ChatLister
+	events    EventLoader
+	snapshots SnapshotRepository
+	logger    *slog.Logger
+	now       func() time.Time
+}
+
+// NewGenerateUseCase creates a new GenerateUseCase
+func NewGenerateUseCase(
+	chats ChatLister,
+	events EventLoader,
+	snapshots SnapshotRepository,
+) *GenerateUseCase {
+	return &GenerateUseCase{
+		chats:     chats,
+		events:    events,
+		snapshots: snapshots,
+		logger:    slog.Default(),
+		now:       time.Now,
+	}
+}
+
+// Execute computes and caches the snapshot
+func (uc *GenerateUseCase) Execute(ctx context.Context, cmd GenerateCommand) (*Snapshot, error) {
+	if err := appcore.ValidateUUID("workspaceID", cmd.WorkspaceID); err != nil {
+		return nil, fmt.Errorf("validation failed: %w", err)
+	}
+
+	items, err := uc.loadTimelines(ctx, cmd)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshot := buildSnapshot(cmd.WorkspaceID, items, uc.now())
+	if saveErr := uc.snapshots.Save(ctx, snapshot); saveErr != nil {
+		return nil, fmt.Errorf("failed to save report snapshot: %w", saveErr)
+	}
+
+	return snapshot, nil
+}
+
+func (uc *GenerateUseCase) loadTimelines(ctx context.Context, cmd GenerateCommand) ([]*timeline, error) {
+	var items []*timeline
+	for offset := 0; ; offset += chatPageSize {
+		chats, err := uc.chats.FindByWorkspace(ctx, cmd.WorkspaceID, chatapp.Filters{
+			Offset: offset,
+			Limit:  chatPageSize,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list chats: %w", err)
+		}
+
+		for _, c := range chats {
+			if c == nil || (c.Type != chat.TypeTask && c.Type != chat.TypeBug) {
+				continue
+			}
+			events, loadErr := uc.events.LoadEvents(ctx, c.ID.String())
+			if loadErr != nil {
+				// one broken history should not hide the reports of the workspace
+				uc.logger.WarnContext(ctx, "skipping chat in reports: failed to load events",
+					slog.String("chat_id", c.ID.String()),
+					slog.String("error", loadErr.Error()),
+				)
+				continue
+			}
+			if item, ok := buildTimeline(events); ok {
+				items = append(items, item)
+			}
+		}
+
+		if len(chats) < chatPageSize {
+			return items, nil
+		}
+	}
+}
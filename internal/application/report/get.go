@@ -0,0 +1,74 @@
+package report
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/lllypuk/flowra/internal/application/appcore"
+	"github.com/lllypuk/flowra/internal/domain/errs"
+)
+
+// DefaultMaxSnapshotAge is how old a cached snapshot may get before a read
+// regenerates it; the worker normally refreshes well within this window.
+const DefaultMaxSnapshotAge = time.Hour
+
+// SnapshotGenerator rebuilds the report snapshot of a workspace
+type SnapshotGenerator interface {
+	Execute(ctx context.Context, cmd GenerateCommand) (*Snapshot, error)
+}
+
+// GetUseCase returns the cached reports of a workspace. Missing or stale
+// snapshots are generated on demand; when that fails a stale snapshot is
+// still served.
+type GetUseCase struct {
+	snapshots SnapshotRepository
+	generator SnapshotGenerator
+	maxAge    time.Duration
+	logger    *slog.Logger
+	now       func() time.Time
+}
+
+// NewGetUseCase creates a new GetUseCase; maxAge <= 0 uses DefaultMaxSnapshotAge
+func NewGetUseCase(snapshots SnapshotRepository, generator SnapshotGenerator, maxAge time.Duration) *GetUseCase {
+	if maxAge <= 0 {
+		maxAge = DefaultMaxSnapshotAge
+	}
+	return &GetUseCase{
+		snapshots: snapshots,
+		generator: generator,
+		maxAge:    maxAge,
+		logger:    slog.Default(),
+		now:       time.Now,
+	}
+}
+
+// Execute returns the snapshot
+func (uc *GetUseCase) Execute(ctx context.Context, query GetQuery) (*Snapshot, error) {
+	if err := appcore.ValidateUUID("workspaceID", query.WorkspaceID); err != nil {
+		return nil, fmt.Errorf("validation failed: %w", err)
+	}
+
+	cached, err := uc.snapshots.Get(ctx, query.WorkspaceID)
+	if err != nil && !errors.Is(err, errs.ErrNotFound) {
+		return nil, fmt.Errorf("failed to load report snapshot: %w", err)
+	}
+	if cached != nil && uc.now().Sub(cached.GeneratedAt) <= uc.maxAge {
+		return cached, nil
+	}
+
+	fresh, genErr := uc.generator.Execute(ctx, GenerateCommand(query))
+	if genErr != nil {
+		if cached != nil {
+			uc.logger.WarnContext(ctx, "serving stale report snapshot",
+				slog.String("workspace_id", query.WorkspaceID.String()),
+				slog.String("error", genErr.Error()),
+			)
+			return cached, nil
+		}
+		return nil, fmt.Errorf("failed to generate reports: %w", genErr)
+	}
+	return fresh, nil
+}
@@ -0,0 +1,103 @@
+package report_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	reportapp "github.com/lllypuk/flowra/internal/application/report"
+	"github.com/lllypuk/flowra/internal/domain/errs"
+	"github.com/lllypuk/flowra/internal/domain/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type stubSnapshotRepo struct {
+	snapshot *reportapp.Snapshot
+	err      error
+}
+
+func (s *stubSnapshotRepo) Get(_ context.Context, _ uuid.UUID) (*reportapp.Snapshot, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	if s.snapshot == nil {
+		return nil, errs.ErrNotFound
+	}
+	return s.snapshot, nil
+}
+
+func (s *stubSnapshotRepo) Save(_ context.Context, snapshot *reportapp.Snapshot) error {
+	s.snapshot = snapshot
+	return nil
+}
+
+type stubGenerator struct {
+	calls int
+	err   error
+}
+
+func (g *stubGenerator) Execute(_ context.Context, cmd reportapp.GenerateCommand) (*reportapp.Snapshot, error) {
+	g.calls++
+	if g.err != nil {
+		return nil, g.err
+	}
+	return &reportapp.Snapshot{WorkspaceID: cmd.WorkspaceID, GeneratedAt: time.Now()}, nil
+}
+
+func TestGetUseCase(t *testing.T) {
+	workspaceID := uuid.NewUUID()
+	ctx := context.Background()
+
+	t.Run("fresh snapshot is served from cache", func(t *testing.T) {
+		cached := &reportapp.Snapshot{WorkspaceID: workspaceID, GeneratedAt: time.Now().Add(-time.Minute)}
+		generator := &stubGenerator{}
+		uc := reportapp.NewGetUseCase(&stubSnapshotRepo{snapshot: cached}, generator, time.Hour)
+
+		snapshot, err := uc.Execute(ctx, reportapp.GetQuery{WorkspaceID: workspaceID})
+
+		require.NoError(t, err)
+		assert.Same(t, cached, snapshot)
+		assert.Zero(t, generator.calls)
+	})
+
+	t.Run("missing snapshot is generated", func(t *testing.T) {
+		generator := &stubGenerator{}
+		uc := reportapp.NewGetUseCase(&stubSnapshotRepo{}, generator, time.Hour)
+
+		snapshot, err := uc.Execute(ctx, reportapp.GetQuery{WorkspaceID: workspaceID})
+
+		require.NoError(t, err)
+		assert.Equal(t, workspaceID, snapshot.WorkspaceID)
+		assert.Equal(t, 1, generator.calls)
+	})
+
+	t.Run("stale snapshot is served when regeneration fails", func(t *testing.T) {
+		cached := &reportapp.Snapshot{WorkspaceID: workspaceID, GeneratedAt: time.Now().Add(-2 * time.Hour)}
+		generator := &stubGenerator{err: errors.New("event store down")}
+		uc := reportapp.NewGetUseCase(&stubSnapshotRepo{snapshot: cached}, generator, time.Hour)
+
+		snapshot, err := uc.Execute(ctx, reportapp.GetQuery{WorkspaceID: workspaceID})
+
+		require.NoError(t, err)
+		assert.Same(t, cached, snapshot)
+		assert.Equal(t, 1, generator.calls)
+	})
+
+	t.Run("generation error without cache", func(t *testing.T) {
+		uc := reportapp.NewGetUseCase(&stubSnapshotRepo{}, &stubGenerator{err: errors.New("boom")}, time.Hour)
+
+		_, err := uc.Execute(ctx, reportapp.GetQuery{WorkspaceID: workspaceID})
+
+		require.Error(t, err)
+	})
+
+	t.Run("invalid workspace ID", func(t *testing.T) {
+		uc := reportapp.NewGetUseCase(&stubSnapshotRepo{}, &stubGenerator{}, 0)
+
+		_, err := uc.Execute(ctx, reportapp.GetQuery{})
+
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,79 @@
+// Package report computes workspace delivery reports (sprint burndown,
+// cumulative flow, cycle and lead time) from the event history of typed chats.
+package report
+
+import (
+	"time"
+
+	"github.com/lllypuk/flowra/internal/domain/uuid"
+)
+
+// Snapshot holds every report of a workspace computed at GeneratedAt.
+// Snapshots are cached and refreshed by the worker; the API serves them as is.
+type Snapshot struct {
+	WorkspaceID    uuid.UUID
+	GeneratedAt    time.Time
+	Burndowns      []SprintBurndown // latest sprints, oldest first
+	CumulativeFlow CumulativeFlow
+	CycleTime      CycleTimeReport
+}
+
+// Burndown returns the burndown of the named sprint
+func (s *Snapshot) Burndown(sprint string) (SprintBurndown, bool) {
+	for _, b := range s.Burndowns {
+		if b.Sprint == sprint {
+			return b, true
+		}
+	}
+	return SprintBurndown{}, false
+}
+
+// SprintBurndown is the remaining work of a sprint per day
+type SprintBurndown struct {
+	Sprint    string
+	StartDate time.Time // day the first item joined the sprint
+	EndDate   time.Time // today, or the day the last item was completed
+	Days      []BurndownDay
+}
+
+// BurndownDay is the sprint state at the end of a day (UTC).
+// Scope counts items in the sprint that are not cancelled; remaining counts
+// the ones not done yet.
+type BurndownDay struct {
+	Date            time.Time
+	ScopeTasks      int
+	RemainingTasks  int
+	ScopePoints     float64
+	RemainingPoints float64
+	ScopeHours      float64
+	RemainingHours  float64
+}
+
+// CumulativeFlow counts items per board status at the end of each day
+type CumulativeFlow struct {
+	Statuses []string // stacking order; Counts are aligned with it
+	Days     []FlowDay
+}
+
+// FlowDay is one day of the cumulative flow diagram
+type FlowDay struct {
+	Date   time.Time
+	Counts []int
+}
+
+// CycleTimeReport summarizes items completed within the window.
+// Lead time runs from the moment the chat became a task or bug until it was
+// done; cycle time starts when work first began (In Progress or In Review).
+type CycleTimeReport struct {
+	WindowDays int
+	LeadTime   DurationStats
+	CycleTime  DurationStats
+}
+
+// DurationStats describes a distribution of durations in hours
+type DurationStats struct {
+	Count        int
+	AverageHours float64
+	MedianHours  float64
+	P85Hours     float64
+}
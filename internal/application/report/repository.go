@@ -0,0 +1,29 @@
+package report
+
+import (
+	"context"
+
+	chatapp "github.com/lllypuk/flowra/internal/application/chat"
+	"github.com/lllypuk/flowra/internal/domain/event"
+	"github.com/lllypuk/flowra/internal/domain/uuid"
+)
+
+// SnapshotRepository stores the latest report snapshot per workspace
+// Interface is declared on the consumer side (application layer)
+type SnapshotRepository interface {
+	// Get returns the cached snapshot; errs.ErrNotFound when none was generated yet
+	Get(ctx context.Context, workspaceID uuid.UUID) (*Snapshot, error)
+
+	// Save replaces the cached snapshot of the workspace
+	Save(ctx context.Context, snapshot *Snapshot) error
+}
+
+// ChatLister lists workspace chats from the read model
+type ChatLister interface {
+	FindByWorkspace(ctx context.Context, workspaceID uuid.UUID, filters chatapp.Filters) ([]*chatapp.ReadModel, error)
+}
+
+// EventLoader loads the event history of a chat
+type EventLoader interface {
+	LoadEvents(ctx context.Context, aggregateID string) ([]event.DomainEvent, error)
+}
@@ -0,0 +1,126 @@
+package report
+
+import (
+	"time"
+
+	"github.com/lllypuk/flowra/internal/domain/chat"
+	"github.com/lllypuk/flowra/internal/domain/event"
+	taskdomain "github.com/lllypuk/flowra/internal/domain/task"
+)
+
+// statusChange is a board status the item entered at the given time
+type statusChange struct {
+	at     time.Time
+	status taskdomain.Status
+}
+
+// timeline is the history of a task or bug rebuilt from its chat events
+type timeline struct {
+	typedAt  time.Time // first time the chat became a task or bug
+	changes  []statusChange
+	sprint   string
+	sprintAt time.Time // when the item joined its current sprint
+	estimate *chat.Estimate
+}
+
+// buildTimeline replays chat events into a timeline.
+// It returns false for chats that are not a task or bug at the end of their
+// history (discussions, epics, deleted chats).
+func buildTimeline(events []event.DomainEvent) (*timeline, bool) {
+	t := &timeline{}
+	var chatType chat.Type
+	deleted := false
+
+	for _, e := range events {
+		at := e.OccurredAt()
+		switch evt := e.(type) {
+		case *chat.Created:
+			chatType = evt.Type
+			if !evt.CreatedAt.IsZero() {
+				at = evt.CreatedAt
+			}
+			t.becameTyped(chatType, at)
+		case *chat.TypeChanged:
+			chatType = evt.NewType
+			t.becameTyped(chatType, at)
+		case *chat.StatusChanged:
+			t.enter(at, evt.NewStatus)
+		case *chat.Closed:
+			t.enter(at, chat.StatusClosed)
+		case *chat.Reopened:
+			t.enter(at, evt.NewStatus)
+		case *chat.SprintSet:
+			t.sprint = evt.NewSprint
+			t.sprintAt = at
+		case *chat.EstimateSet:
+			t.estimate = evt.NewEstimate
+		case *chat.Deleted:
+			deleted = true
+		}
+	}
+
+	if deleted || t.typedAt.IsZero() || (chatType != chat.TypeTask && chatType != chat.TypeBug) {
+		return nil, false
+	}
+	return t, true
+}
+
+// becameTyped records a conversion; every conversion resets the workflow
+func (t *timeline) becameTyped(chatType chat.Type, at time.Time) {
+	if chatType == chat.TypeDiscussion {
+		return
+	}
+	if t.typedAt.IsZero() {
+		t.typedAt = at
+	}
+	t.enter(at, "")
+}
+
+func (t *timeline) enter(at time.Time, chatStatus string) {
+	t.changes = append(t.changes, statusChange{at: at, status: taskdomain.StatusFromChatStatus(chatStatus)})
+}
+
+// statusAt returns the status at the given moment; false before the item existed
+func (t *timeline) statusAt(at time.Time) (taskdomain.Status, bool) {
+	var status taskdomain.Status
+	found := false
+	for _, c := range t.changes {
+		if c.at.After(at) {
+			break
+		}
+		status = c.status
+		found = true
+	}
+	return status, found
+}
+
+// current returns the latest status
+func (t *timeline) current() taskdomain.Status {
+	if len(t.changes) == 0 {
+		return taskdomain.StatusToDo
+	}
+	return t.changes[len(t.changes)-1].status
+}
+
+// completedAt returns when the item last entered Done, if it is done now
+func (t *timeline) completedAt() (time.Time, bool) {
+	if t.current() != taskdomain.StatusDone {
+		return time.Time{}, false
+	}
+	for i := len(t.changes) - 1; i >= 0; i-- {
+		if t.changes[i].status != taskdomain.StatusDone {
+			return t.changes[i+1].at, true
+		}
+	}
+	return t.changes[0].at, true
+}
+
+// startedAt returns when work on the item first began
+func (t *timeline) startedAt() (time.Time, bool) {
+	for _, c := range t.changes {
+		if c.status == taskdomain.StatusInProgress || c.status == taskdomain.StatusInReview {
+			return c.at, true
+		}
+	}
+	return time.Time{}, false
+}
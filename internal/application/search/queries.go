@@ -0,0 +1,23 @@
+package search
+
+import "github.com/lllypuk/flowra/internal/domain/uuid"
+
+// ResultType - the kind of results a search returns
+type ResultType string
+
+// Searchable result types
+const (
+	TypeMessage ResultType = "message"
+	TypeChat    ResultType = "chat"
+	TypeTask    ResultType = "task"
+)
+
+// Query - search messages, chats and task titles of a workspace
+type Query struct {
+	WorkspaceID uuid.UUID
+	UserID      uuid.UUID
+	Text        string
+	Types       []ResultType // all types when empty
+	Offset      int          // applies to each type
+	Limit       int          // applies to each type
+}
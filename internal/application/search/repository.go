@@ -0,0 +1,36 @@
+package search
+
+import (
+	"context"
+
+	"github.com/lllypuk/flowra/internal/domain/uuid"
+)
+
+// Repository runs full-text queries over messages and chat titles
+// Interface is declared on the consumer side (application layer)
+type Repository interface {
+	// ReadableChats returns the chats of the workspace the user may read: public ones
+	// and those the user participates in
+	ReadableChats(ctx context.Context, workspaceID, userID uuid.UUID) ([]ChatRef, error)
+
+	// SearchMessages returns the non-deleted messages of the given chats matching the text,
+	// best match first, and how many match in total
+	SearchMessages(
+		ctx context.Context,
+		chatIDs []uuid.UUID,
+		text string,
+		offset, limit int,
+	) ([]MessageHit, int, error)
+
+	// SearchChats returns the chats of the filter matching the text by title,
+	// best match first, and how many match in total
+	SearchChats(ctx context.Context, filter ChatFilter, offset, limit int) ([]ChatHit, int, error)
+}
+
+// ChatFilter selects the chats SearchChats looks at
+type ChatFilter struct {
+	WorkspaceID uuid.UUID
+	UserID      uuid.UUID // only public chats and those the user participates in
+	Text        string
+	Tasks       bool // typed chats (tasks, bugs, epics) instead of discussions
+}
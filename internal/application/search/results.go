@@ -0,0 +1,57 @@
+package search
+
+import (
+	"time"
+
+	"github.com/lllypuk/flowra/internal/domain/chat"
+	"github.com/lllypuk/flowra/internal/domain/uuid"
+)
+
+// ChatRef - a chat the user may read
+type ChatRef struct {
+	ID    uuid.UUID
+	Title string
+	Type  chat.Type
+}
+
+// MessageHit - a message matching the search
+type MessageHit struct {
+	MessageID uuid.UUID
+	ChatID    uuid.UUID
+	ChatTitle string
+	AuthorID  uuid.UUID
+	Content   string
+	Snippet   string // part of the content around the first matching word
+	CreatedAt time.Time
+}
+
+// ChatHit - a chat or task matching the search by title
+type ChatHit struct {
+	ChatID       uuid.UUID
+	Title        string
+	Type         chat.Type
+	Status       string // tasks only
+	LastActivity time.Time
+}
+
+// MessagePage - a page of matching messages
+type MessagePage struct {
+	Hits  []MessageHit
+	Total int
+}
+
+// ChatPage - a page of matching chats or tasks
+type ChatPage struct {
+	Hits  []ChatHit
+	Total int
+}
+
+// Result - the results of a search; sections of types not searched are nil
+type Result struct {
+	Text     string
+	Messages *MessagePage
+	Chats    *ChatPage
+	Tasks    *ChatPage
+	Offset   int
+	Limit    int
+}
@@ -0,0 +1,181 @@
+package search
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"unicode"
+
+	"github.com/lllypuk/flowra/internal/application/appcore"
+	"github.com/lllypuk/flowra/internal/domain/uuid"
+)
+
+// Search limits
+const (
+	DefaultLimit  = 20
+	MaxLimit      = 50
+	MaxTextLength = 200
+
+	// snippetRadius - runes of context kept on each side of the first matching word
+	snippetRadius = 80
+)
+
+// UseCase searches the messages, chats and task titles of a workspace the user may read
+type UseCase struct {
+	repo Repository
+}
+
+// NewUseCase creates a new search use case
+func NewUseCase(repo Repository) *UseCase {
+	return &UseCase{repo: repo}
+}
+
+// Execute runs the query for each requested result type. Offset and Limit page
+// every type separately, so the UI can show a few hits of each and page one type.
+func (uc *UseCase) Execute(ctx context.Context, query Query) (Result, error) {
+	query.Text = strings.TrimSpace(query.Text)
+	if err := uc.validate(query); err != nil {
+		return Result{}, fmt.Errorf("validation failed: %w", err)
+	}
+
+	limit := query.Limit
+	if limit == 0 {
+		limit = DefaultLimit
+	}
+	limit = min(limit, MaxLimit)
+
+	types := query.Types
+	if len(types) == 0 {
+		types = []ResultType{TypeMessage, TypeChat, TypeTask}
+	}
+
+	result := Result{Text: query.Text, Offset: query.Offset, Limit: limit}
+	if slices.Contains(types, TypeMessage) {
+		page, err := uc.searchMessages(ctx, query, limit)
+		if err != nil {
+			return Result{}, err
+		}
+		result.Messages = &page
+	}
+	if slices.Contains(types, TypeChat) {
+		page, err := uc.searchChats(ctx, query, limit, false)
+		if err != nil {
+			return Result{}, fmt.Errorf("failed to search chats: %w", err)
+		}
+		result.Chats = &page
+	}
+	if slices.Contains(types, TypeTask) {
+		page, err := uc.searchChats(ctx, query, limit, true)
+		if err != nil {
+			return Result{}, fmt.Errorf("failed to search tasks: %w", err)
+		}
+		result.Tasks = &page
+	}
+	return result, nil
+}
+
+// searchMessages searches the messages of the chats the user may read
+func (uc *UseCase) searchMessages(ctx context.Context, query Query, limit int) (MessagePage, error) {
+	chats, err := uc.repo.ReadableChats(ctx, query.WorkspaceID, query.UserID)
+	if err != nil {
+		return MessagePage{}, fmt.Errorf("failed to list readable chats: %w", err)
+	}
+	if len(chats) == 0 {
+		return MessagePage{Hits: []MessageHit{}}, nil
+	}
+
+	titles := make(map[uuid.UUID]string, len(chats))
+	chatIDs := make([]uuid.UUID, 0, len(chats))
+	for _, ref := range chats {
+		titles[ref.ID] = ref.Title
+		chatIDs = append(chatIDs, ref.ID)
+	}
+
+	hits, total, err := uc.repo.SearchMessages(ctx, chatIDs, query.Text, query.Offset, limit)
+	if err != nil {
+		return MessagePage{}, fmt.Errorf("failed to search messages: %w", err)
+	}
+	for i := range hits {
+		hits[i].ChatTitle = titles[hits[i].ChatID]
+		hits[i].Snippet = Snippet(hits[i].Content, query.Text)
+	}
+	return MessagePage{Hits: hits, Total: total}, nil
+}
+
+// searchChats searches the titles of the discussions, or of the tasks, the user may read
+func (uc *UseCase) searchChats(ctx context.Context, query Query, limit int, tasks bool) (ChatPage, error) {
+	hits, total, err := uc.repo.SearchChats(ctx, ChatFilter{
+		WorkspaceID: query.WorkspaceID,
+		UserID:      query.UserID,
+		Text:        query.Text,
+		Tasks:       tasks,
+	}, query.Offset, limit)
+	if err != nil {
+		return ChatPage{}, err
+	}
+	return ChatPage{Hits: hits, Total: total}, nil
+}
+
+// validate validates request
+func (uc *UseCase) validate(query Query) error {
+	if err := appcore.ValidateUUID("workspaceID", query.WorkspaceID); err != nil {
+		return err
+	}
+	if err := appcore.ValidateUUID("userID", query.UserID); err != nil {
+		return err
+	}
+	if err := appcore.ValidateRequired("q", query.Text); err != nil {
+		return err
+	}
+	if len([]rune(query.Text)) > MaxTextLength {
+		return appcore.NewValidationError("q", fmt.Sprintf("must be at most %d characters", MaxTextLength))
+	}
+	for _, typ := range query.Types {
+		if typ != TypeMessage && typ != TypeChat && typ != TypeTask {
+			return appcore.NewValidationError("type", fmt.Sprintf("unknown result type %q", typ))
+		}
+	}
+	if query.Limit < 0 {
+		return appcore.NewValidationError("limit", "must be non-negative")
+	}
+	if query.Offset < 0 {
+		return appcore.NewValidationError("offset", "must be non-negative")
+	}
+	return nil
+}
+
+// Snippet returns the part of content around the first word of text it contains,
+// or its beginning when it contains none (stemmed matches), shortened with ellipses.
+func Snippet(content, text string) string {
+	runes := []rune(content)
+	if len(runes) <= 2*snippetRadius {
+		return content
+	}
+
+	lowered := make([]rune, len(runes))
+	for i, r := range runes {
+		lowered[i] = unicode.ToLower(r)
+	}
+	haystack := string(lowered)
+
+	start := 0
+	for _, word := range strings.Fields(strings.ToLower(text)) {
+		if at := strings.Index(haystack, word); at >= 0 {
+			start = max(len([]rune(haystack[:at]))-snippetRadius, 0)
+			break
+		}
+	}
+	// a match near the end still gets a full-length snippet
+	start = min(start, len(runes)-2*snippetRadius)
+	end := start + 2*snippetRadius
+
+	snippet := string(runes[start:end])
+	if start > 0 {
+		snippet = "…" + snippet
+	}
+	if end < len(runes) {
+		snippet += "…"
+	}
+	return snippet
+}
@@ -0,0 +1,187 @@
+package search_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/lllypuk/flowra/internal/application/appcore"
+	"github.com/lllypuk/flowra/internal/application/search"
+	"github.com/lllypuk/flowra/internal/domain/chat"
+	"github.com/lllypuk/flowra/internal/domain/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeRepository matches by case-insensitive substring instead of a text index
+type fakeRepository struct {
+	chats    []search.ChatRef
+	messages []search.MessageHit
+	titles   []search.ChatHit
+
+	searchedChatIDs []uuid.UUID
+	chatFilters     []search.ChatFilter
+}
+
+func (r *fakeRepository) ReadableChats(context.Context, uuid.UUID, uuid.UUID) ([]search.ChatRef, error) {
+	return r.chats, nil
+}
+
+func (r *fakeRepository) SearchMessages(
+	_ context.Context,
+	chatIDs []uuid.UUID,
+	text string,
+	offset, limit int,
+) ([]search.MessageHit, int, error) {
+	r.searchedChatIDs = chatIDs
+	var hits []search.MessageHit
+	for _, hit := range r.messages {
+		for _, id := range chatIDs {
+			if hit.ChatID == id && strings.Contains(strings.ToLower(hit.Content), strings.ToLower(text)) {
+				hits = append(hits, hit)
+			}
+		}
+	}
+	return page(hits, offset, limit), len(hits), nil
+}
+
+func (r *fakeRepository) SearchChats(
+	_ context.Context,
+	filter search.ChatFilter,
+	offset, limit int,
+) ([]search.ChatHit, int, error) {
+	r.chatFilters = append(r.chatFilters, filter)
+	var hits []search.ChatHit
+	for _, hit := range r.titles {
+		if (hit.Type != chat.TypeDiscussion) == filter.Tasks &&
+			strings.Contains(strings.ToLower(hit.Title), strings.ToLower(filter.Text)) {
+			hits = append(hits, hit)
+		}
+	}
+	return page(hits, offset, limit), len(hits), nil
+}
+
+func page[T any](items []T, offset, limit int) []T {
+	start := min(offset, len(items))
+	return items[start:min(start+limit, len(items))]
+}
+
+func newSearchFixture() (*fakeRepository, search.Query) {
+	general := search.ChatRef{ID: uuid.NewUUID(), Title: "General", Type: chat.TypeDiscussion}
+	release := search.ChatRef{ID: uuid.NewUUID(), Title: "Release checklist", Type: chat.TypeTask}
+	hidden := uuid.NewUUID()
+
+	repo := &fakeRepository{
+		chats: []search.ChatRef{general, release},
+		messages: []search.MessageHit{
+			{MessageID: uuid.NewUUID(), ChatID: general.ID, Content: "When is the release?"},
+			{MessageID: uuid.NewUUID(), ChatID: release.ID, Content: "Release notes are ready"},
+			{MessageID: uuid.NewUUID(), ChatID: hidden, Content: "Secret release plan"},
+		},
+		titles: []search.ChatHit{
+			{ChatID: general.ID, Title: general.Title, Type: general.Type},
+			{ChatID: release.ID, Title: release.Title, Type: release.Type, Status: "To Do"},
+		},
+	}
+	return repo, search.Query{WorkspaceID: uuid.NewUUID(), UserID: uuid.NewUUID(), Text: " release "}
+}
+
+func TestUseCase_Execute_AllTypes(t *testing.T) {
+	repo, query := newSearchFixture()
+	useCase := search.NewUseCase(repo)
+
+	result, err := useCase.Execute(context.Background(), query)
+
+	require.NoError(t, err)
+	assert.Equal(t, "release", result.Text)
+	assert.Equal(t, search.DefaultLimit, result.Limit)
+
+	require.NotNil(t, result.Messages)
+	assert.Equal(t, 2, result.Messages.Total, "messages of unreadable chats are not searched")
+	assert.Len(t, repo.searchedChatIDs, 2)
+	assert.Equal(t, "General", result.Messages.Hits[0].ChatTitle)
+	assert.Equal(t, "When is the release?", result.Messages.Hits[0].Snippet)
+
+	require.NotNil(t, result.Chats)
+	assert.Equal(t, 0, result.Chats.Total)
+	require.NotNil(t, result.Tasks)
+	require.Len(t, result.Tasks.Hits, 1)
+	assert.Equal(t, "Release checklist", result.Tasks.Hits[0].Title)
+
+	for _, filter := range repo.chatFilters {
+		assert.Equal(t, query.UserID, filter.UserID)
+		assert.Equal(t, query.WorkspaceID, filter.WorkspaceID)
+	}
+}
+
+func TestUseCase_Execute_TypeFilterAndPagination(t *testing.T) {
+	repo, query := newSearchFixture()
+	useCase := search.NewUseCase(repo)
+
+	query.Types = []search.ResultType{search.TypeMessage}
+	query.Offset = 1
+	query.Limit = search.MaxLimit + 1
+	result, err := useCase.Execute(context.Background(), query)
+
+	require.NoError(t, err)
+	assert.Nil(t, result.Chats)
+	assert.Nil(t, result.Tasks)
+	assert.Empty(t, repo.chatFilters)
+	require.NotNil(t, result.Messages)
+	assert.Equal(t, 2, result.Messages.Total)
+	require.Len(t, result.Messages.Hits, 1)
+	assert.Equal(t, "Release notes are ready", result.Messages.Hits[0].Content)
+	assert.Equal(t, search.MaxLimit, result.Limit)
+}
+
+func TestUseCase_Execute_NoReadableChats(t *testing.T) {
+	repo, query := newSearchFixture()
+	repo.chats = nil
+	useCase := search.NewUseCase(repo)
+
+	result, err := useCase.Execute(context.Background(), query)
+
+	require.NoError(t, err)
+	assert.Equal(t, 0, result.Messages.Total)
+	assert.NotNil(t, result.Messages.Hits)
+	assert.Nil(t, repo.searchedChatIDs)
+}
+
+func TestUseCase_Execute_Validation(t *testing.T) {
+	_, valid := newSearchFixture()
+	useCase := search.NewUseCase(&fakeRepository{})
+
+	for name, mutate := range map[string]func(*search.Query){
+		"blank text":   func(q *search.Query) { q.Text = "   " },
+		"long text":    func(q *search.Query) { q.Text = strings.Repeat("a", search.MaxTextLength+1) },
+		"unknown type": func(q *search.Query) { q.Types = []search.ResultType{"file"} },
+		"no workspace": func(q *search.Query) { q.WorkspaceID = "" },
+		"negative":     func(q *search.Query) { q.Offset = -1 },
+	} {
+		query := valid
+		mutate(&query)
+		_, err := useCase.Execute(context.Background(), query)
+		var validationErr *appcore.ValidationError
+		assert.True(t, errors.As(err, &validationErr), name)
+	}
+}
+
+func TestSnippet(t *testing.T) {
+	assert.Equal(t, "short message", search.Snippet("short message", "message"))
+
+	long := strings.Repeat("a", 200) + " Deploy " + strings.Repeat("b", 200)
+	snippet := search.Snippet(long, "deploy")
+	assert.True(t, strings.HasPrefix(snippet, "…"))
+	assert.True(t, strings.HasSuffix(snippet, "…"))
+	assert.Contains(t, snippet, "Deploy")
+
+	atEnd := strings.Repeat("a", 300) + " deploy"
+	snippet = search.Snippet(atEnd, "deploy")
+	assert.True(t, strings.HasSuffix(snippet, "deploy"))
+	assert.Len(t, []rune(strings.TrimPrefix(snippet, "…")), 160)
+
+	noMatch := search.Snippet(strings.Repeat("c", 300), "deploying")
+	assert.True(t, strings.HasPrefix(noMatch, "ccc"))
+	assert.True(t, strings.HasSuffix(noMatch, "…"))
+}
@@ -53,6 +53,13 @@ type UpdateTitleCommand struct {
 	UpdatedBy uuid.UUID
 }
 
+// SetEpicCommand links a task or bug to its parent epic.
+type SetEpicCommand struct {
+	TaskID uuid.UUID
+	EpicID *uuid.UUID // nil = unlink from the epic
+	SetBy  uuid.UUID
+}
+
 // AddAttachmentCommand attaches a file to a task.
 type AddAttachmentCommand struct {
 	TaskID   uuid.UUID
@@ -69,3 +76,26 @@ type RemoveAttachmentCommand struct {
 	FileID    uuid.UUID
 	RemovedBy uuid.UUID
 }
+
+// AddChecklistItemCommand appends an item to the task checklist.
+type AddChecklistItemCommand struct {
+	TaskID  uuid.UUID
+	Text    string
+	AddedBy uuid.UUID
+}
+
+// ToggleChecklistItemCommand marks a checklist item as done or not done.
+type ToggleChecklistItemCommand struct {
+	TaskID    uuid.UUID
+	ItemID    uuid.UUID
+	Done      bool
+	ToggledBy uuid.UUID
+}
+
+// ReorderChecklistItemCommand moves a checklist item to a new position (0-based).
+type ReorderChecklistItemCommand struct {
+	TaskID      uuid.UUID
+	ItemID      uuid.UUID
+	Position    int
+	ReorderedBy uuid.UUID
+}
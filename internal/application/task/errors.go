@@ -110,6 +110,30 @@ var (
 		httpMsg:    "task not found",
 	}
 
+	// ErrAttachmentQuotaExceeded is returned when task attachments would exceed the size quota
+	ErrAttachmentQuotaExceeded = &appError{
+		msg:        "attachment quota exceeded",
+		httpStatus: http.StatusRequestEntityTooLarge,
+		httpCode:   "ATTACHMENT_QUOTA_EXCEEDED",
+		httpMsg:    "task attachments exceed the size quota",
+	}
+
+	// ErrChecklistItemNotFound is returned when checklist item is not found
+	ErrChecklistItemNotFound = &appError{
+		msg:        "checklist item not found",
+		httpStatus: http.StatusNotFound,
+		httpCode:   "CHECKLIST_ITEM_NOT_FOUND",
+		httpMsg:    "checklist item not found",
+	}
+
+	// ErrEpicNotFound is returned when the parent is not an epic of the task's workspace
+	ErrEpicNotFound = &appError{
+		msg:        "epic not found",
+		httpStatus: http.StatusNotFound,
+		httpCode:   "EPIC_NOT_FOUND",
+		httpMsg:    "epic not found",
+	}
+
 	// ErrUnauthorized is returned when user is not authorized for the operation
 	ErrUnauthorized = &appError{
 		msg:        "user not authorized for this operation",
@@ -134,6 +158,14 @@ var (
 		httpMsg:    "invalid status transition",
 	}
 
+	// ErrInvalidHierarchy is returned when an epic or discussion is linked to an epic
+	ErrInvalidHierarchy = &appError{
+		msg:        "invalid task hierarchy",
+		httpStatus: http.StatusUnprocessableEntity,
+		httpCode:   "INVALID_HIERARCHY",
+		httpMsg:    "only tasks and bugs can belong to an epic",
+	}
+
 	// ErrUserNotFound is returned when user is not found
 	ErrUserNotFound = &appError{
 		msg:        "user not found",
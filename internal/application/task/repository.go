@@ -39,26 +39,68 @@ type Filters struct {
 	Priority    *taskdomain.Priority
 	EntityType  *taskdomain.EntityType
 	CreatedBy   *uuid.UUID
+	Sprint      string
+	EpicID      *uuid.UUID // children of the epic
 	Search      string
+	IDs         []uuid.UUID // limits the results to these tasks when not nil
 	Offset      int
 	Limit       int
+
+	// AwaitingTriage limits the results to open bugs in their initial status that
+	// lack a severity or an assignee and are not marked as duplicates.
+	AwaitingTriage bool
 }
 
 // ReadModel represents denormalizovannoe view Task for zaprosov
 type ReadModel struct {
 	ID          uuid.UUID
 	ChatID      uuid.UUID
+	WorkspaceID uuid.UUID
 	Title       string
 	EntityType  taskdomain.EntityType
 	Status      taskdomain.Status
 	Priority    taskdomain.Priority
 	Severity    string
+	Estimate    *EstimateReadModel
+	Sprint      string
+	DuplicateOf *uuid.UUID // the original task when marked as a duplicate
+	EpicID      *uuid.UUID // the parent epic of a task or bug
 	AssignedTo  *uuid.UUID
 	DueDate     *time.Time
 	CreatedBy   uuid.UUID
 	CreatedAt   time.Time
 	Version     int
 	Attachments []AttachmentReadModel
+	Checklist   []ChecklistItemReadModel
+
+	// Checklist progress is denormalized so lists and boards need not load the items.
+	ChecklistTotal   int
+	ChecklistDone    int
+	ChecklistPercent int
+
+	// SLA is the SLA clock of a bug, nil for other entity types
+	SLA *SLAReadModel
+
+	// User names are denormalized from the users collection and follow renames;
+	// they are empty until the projection has resolved the user.
+	AssigneeUsername    string
+	AssigneeDisplayName string
+	CreatorUsername     string
+	CreatorDisplayName  string
+}
+
+// EstimateReadModel represents the task estimate in the read model.
+type EstimateReadModel struct {
+	Value float64
+	Unit  string // "points" or "hours"
+}
+
+// SLAReadModel represents the SLA clock of a bug in the read model.
+// Deadlines are not stored: they follow the workspace SLA policy.
+type SLAReadModel struct {
+	OpenedAt    time.Time
+	RespondedAt *time.Time
+	ResolvedAt  *time.Time
 }
 
 // AttachmentReadModel represents an attachment in the task read model.
@@ -68,3 +110,31 @@ type AttachmentReadModel struct {
 	FileSize int64
 	MimeType string
 }
+
+// ChecklistItemReadModel represents a checklist item in the task read model.
+type ChecklistItemReadModel struct {
+	ID       uuid.UUID
+	Text     string
+	Done     bool
+	Position int
+}
+
+// LinkRepository reads the links between tasks and the messages that reference them.
+// Links are maintained asynchronously from message events.
+type LinkRepository interface {
+	// FindBacklinks returns the messages mentioning the task, newest first.
+	FindBacklinks(ctx context.Context, taskID uuid.UUID, limit int) ([]Backlink, error)
+
+	// FindLinkedTaskIDs returns the tasks referenced by each of the messages.
+	// Messages without links are absent from the result.
+	FindLinkedTaskIDs(ctx context.Context, messageIDs []uuid.UUID) (map[uuid.UUID][]uuid.UUID, error)
+}
+
+// Backlink represents a message that mentions a task.
+type Backlink struct {
+	MessageID uuid.UUID
+	ChatID    uuid.UUID
+	AuthorID  uuid.UUID
+	Excerpt   string
+	CreatedAt time.Time
+}
@@ -0,0 +1,214 @@
+package task
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/lllypuk/flowra/internal/application/appcore"
+	"github.com/lllypuk/flowra/internal/domain/chat"
+	taskdomain "github.com/lllypuk/flowra/internal/domain/task"
+	"github.com/lllypuk/flowra/internal/domain/uuid"
+)
+
+// roadmapPercentScale converts the share of done children to a percentage
+const roadmapPercentScale = 100
+
+// RoadmapQuery - query for the roadmap of a workspace
+type RoadmapQuery struct {
+	WorkspaceID      uuid.UUID
+	IncludeCompleted bool // also list epics that are done or cancelled
+}
+
+// Roadmap - epics of a workspace ordered by start date
+type Roadmap struct {
+	WorkspaceID uuid.UUID
+	Epics       []RoadmapEpic
+}
+
+// RoadmapEpic rolls up an epic and its children.
+// StartDate is the earliest creation of the epic or a child; EndDate is the due
+// date of the epic or, without one, the latest due date of its children.
+type RoadmapEpic struct {
+	EpicID          uuid.UUID
+	Title           string
+	Status          taskdomain.Status
+	Priority        taskdomain.Priority
+	StartDate       time.Time
+	EndDate         *time.Time
+	Sprints         []string // sprints of the epic and its open children, in sprint order
+	ChildCount      int
+	StatusBreakdown map[taskdomain.Status]int
+	Progress        RoadmapProgress
+	Owners          []RoadmapOwner
+}
+
+// RoadmapProgress - done children out of all children except cancelled ones
+type RoadmapProgress struct {
+	Total   int
+	Done    int
+	Percent int
+}
+
+// RoadmapOwner is an assignee of the epic or its children.
+// The assignees of an epic make up the team owning it.
+type RoadmapOwner struct {
+	UserID      uuid.UUID
+	Username    string
+	DisplayName string
+	TaskCount   int  // children assigned to the user
+	Lead        bool // assignee of the epic itself
+}
+
+// RoadmapUseCase builds the roadmap from the task read model
+type RoadmapUseCase struct {
+	tasks TaskLister
+}
+
+// NewRoadmapUseCase creates a new RoadmapUseCase
+func NewRoadmapUseCase(tasks TaskLister) *RoadmapUseCase {
+	return &RoadmapUseCase{tasks: tasks}
+}
+
+// Execute builds the roadmap
+func (uc *RoadmapUseCase) Execute(ctx context.Context, query RoadmapQuery) (*Roadmap, error) {
+	if err := appcore.ValidateUUID("workspaceID", query.WorkspaceID); err != nil {
+		return nil, fmt.Errorf("validation failed: %w", err)
+	}
+
+	workspaceID := query.WorkspaceID
+	tasks, err := uc.tasks.ListTasks(ctx, Filters{WorkspaceID: &workspaceID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tasks: %w", err)
+	}
+
+	var epics []*ReadModel
+	children := make(map[uuid.UUID][]*ReadModel)
+	for _, t := range tasks {
+		if t == nil {
+			continue
+		}
+		if t.EntityType == taskdomain.TypeEpic {
+			if query.IncludeCompleted || !isClosedStatus(t.Status) {
+				epics = append(epics, t)
+			}
+			continue
+		}
+		if t.EpicID != nil {
+			children[*t.EpicID] = append(children[*t.EpicID], t)
+		}
+	}
+
+	roadmap := &Roadmap{
+		WorkspaceID: query.WorkspaceID,
+		Epics:       make([]RoadmapEpic, 0, len(epics)),
+	}
+	for _, epic := range epics {
+		roadmap.Epics = append(roadmap.Epics, buildRoadmapEpic(epic, children[epic.ID]))
+	}
+	sort.SliceStable(roadmap.Epics, func(i, j int) bool {
+		a, b := roadmap.Epics[i], roadmap.Epics[j]
+		if !a.StartDate.Equal(b.StartDate) {
+			return a.StartDate.Before(b.StartDate)
+		}
+		return strings.ToLower(a.Title) < strings.ToLower(b.Title)
+	})
+
+	return roadmap, nil
+}
+
+func buildRoadmapEpic(epic *ReadModel, children []*ReadModel) RoadmapEpic {
+	item := RoadmapEpic{
+		EpicID:          epic.ID,
+		Title:           epic.Title,
+		Status:          epic.Status,
+		Priority:        epic.Priority,
+		StartDate:       epic.CreatedAt,
+		EndDate:         epic.DueDate,
+		ChildCount:      len(children),
+		StatusBreakdown: make(map[taskdomain.Status]int),
+	}
+
+	sprints := make(map[string]struct{})
+	if epic.Sprint != "" {
+		sprints[epic.Sprint] = struct{}{}
+	}
+	owners := make(map[uuid.UUID]*RoadmapOwner)
+	if epic.AssignedTo != nil {
+		owners[*epic.AssignedTo] = &RoadmapOwner{
+			UserID:      *epic.AssignedTo,
+			Username:    epic.AssigneeUsername,
+			DisplayName: epic.AssigneeDisplayName,
+			Lead:        true,
+		}
+	}
+
+	var latestDue *time.Time
+	for _, child := range children {
+		item.StatusBreakdown[child.Status]++
+		if child.CreatedAt.Before(item.StartDate) {
+			item.StartDate = child.CreatedAt
+		}
+		if child.DueDate != nil && (latestDue == nil || child.DueDate.After(*latestDue)) {
+			latestDue = child.DueDate
+		}
+		if child.Status != taskdomain.StatusCancelled {
+			item.Progress.Total++
+			if child.Status == taskdomain.StatusDone {
+				item.Progress.Done++
+			} else if child.Sprint != "" {
+				sprints[child.Sprint] = struct{}{}
+			}
+		}
+		if child.AssignedTo != nil {
+			owner, ok := owners[*child.AssignedTo]
+			if !ok {
+				owner = &RoadmapOwner{
+					UserID:      *child.AssignedTo,
+					Username:    child.AssigneeUsername,
+					DisplayName: child.AssigneeDisplayName,
+				}
+				owners[*child.AssignedTo] = owner
+			}
+			owner.TaskCount++
+		}
+	}
+
+	if item.EndDate == nil {
+		item.EndDate = latestDue
+	}
+	if item.Progress.Total > 0 {
+		item.Progress.Percent = item.Progress.Done * roadmapPercentScale / item.Progress.Total
+	}
+
+	item.Sprints = make([]string, 0, len(sprints))
+	for sprint := range sprints {
+		item.Sprints = append(item.Sprints, sprint)
+	}
+	sort.Slice(item.Sprints, func(i, j int) bool {
+		return chat.CompareSprintNames(item.Sprints[i], item.Sprints[j]) < 0
+	})
+
+	item.Owners = make([]RoadmapOwner, 0, len(owners))
+	for _, owner := range owners {
+		item.Owners = append(item.Owners, *owner)
+	}
+	sort.Slice(item.Owners, func(i, j int) bool {
+		a, b := item.Owners[i], item.Owners[j]
+		if a.Lead != b.Lead {
+			return a.Lead
+		}
+		if a.TaskCount != b.TaskCount {
+			return a.TaskCount > b.TaskCount
+		}
+		return a.UserID.String() < b.UserID.String()
+	})
+
+	return item
+}
+
+func isClosedStatus(status taskdomain.Status) bool {
+	return status == taskdomain.StatusDone || status == taskdomain.StatusCancelled
+}
@@ -0,0 +1,114 @@
+package task_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	taskapp "github.com/lllypuk/flowra/internal/application/task"
+	taskdomain "github.com/lllypuk/flowra/internal/domain/task"
+	"github.com/lllypuk/flowra/internal/domain/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func roadmapEpic(title string, status taskdomain.Status, createdAt time.Time) *taskapp.ReadModel {
+	return &taskapp.ReadModel{
+		ID:         uuid.NewUUID(),
+		Title:      title,
+		EntityType: taskdomain.TypeEpic,
+		Status:     status,
+		CreatedAt:  createdAt,
+	}
+}
+
+func roadmapChild(epic *taskapp.ReadModel, status taskdomain.Status, sprint string) *taskapp.ReadModel {
+	epicID := epic.ID
+	return &taskapp.ReadModel{
+		ID:         uuid.NewUUID(),
+		EntityType: taskdomain.TypeTask,
+		Status:     status,
+		Sprint:     sprint,
+		EpicID:     &epicID,
+		CreatedAt:  epic.CreatedAt.Add(time.Hour),
+	}
+}
+
+func TestRoadmapUseCase_Execute(t *testing.T) {
+	start := time.Date(2026, time.March, 2, 9, 0, 0, 0, time.UTC)
+	lead := uuid.NewUUID()
+	dev := uuid.NewUUID()
+
+	checkout := roadmapEpic("Checkout", taskdomain.StatusInProgress, start.Add(48*time.Hour))
+	checkout.AssignedTo = &lead
+	checkout.AssigneeUsername = "lead"
+	search := roadmapEpic("Search", taskdomain.StatusToDo, start)
+	shipped := roadmapEpic("Shipped", taskdomain.StatusDone, start)
+
+	due := start.Add(30 * 24 * time.Hour)
+	early := roadmapChild(checkout, taskdomain.StatusDone, "Sprint 9")
+	early.CreatedAt = start.Add(24 * time.Hour)
+	early.AssignedTo = &dev
+	open := roadmapChild(checkout, taskdomain.StatusInProgress, "Sprint 11")
+	open.DueDate = &due
+	open.AssignedTo = &dev
+	planned := roadmapChild(checkout, taskdomain.StatusToDo, "Sprint 10")
+	cancelled := roadmapChild(checkout, taskdomain.StatusCancelled, "Sprint 12")
+
+	lister := &stubTaskLister{tasks: []*taskapp.ReadModel{
+		checkout, search, shipped, early, open, planned, cancelled,
+	}}
+	workspaceID := uuid.NewUUID()
+
+	roadmap, err := taskapp.NewRoadmapUseCase(lister).Execute(context.Background(), taskapp.RoadmapQuery{
+		WorkspaceID: workspaceID,
+	})
+
+	require.NoError(t, err)
+	require.NotNil(t, lister.filters.WorkspaceID)
+	assert.Equal(t, workspaceID, *lister.filters.WorkspaceID)
+
+	// done epics are hidden; epics are ordered by start date
+	require.Len(t, roadmap.Epics, 2)
+	assert.Equal(t, "Search", roadmap.Epics[0].Title)
+	assert.Zero(t, roadmap.Epics[0].ChildCount)
+	assert.Nil(t, roadmap.Epics[0].EndDate)
+
+	epic := roadmap.Epics[1]
+	assert.Equal(t, checkout.ID, epic.EpicID)
+	assert.Equal(t, early.CreatedAt, epic.StartDate)
+	require.NotNil(t, epic.EndDate)
+	assert.Equal(t, due, *epic.EndDate)
+	assert.Equal(t, []string{"Sprint 10", "Sprint 11"}, epic.Sprints)
+	assert.Equal(t, 4, epic.ChildCount)
+	assert.Equal(t, 1, epic.StatusBreakdown[taskdomain.StatusCancelled])
+	assert.Equal(t, taskapp.RoadmapProgress{Total: 3, Done: 1, Percent: 33}, epic.Progress)
+
+	require.Len(t, epic.Owners, 2)
+	assert.Equal(t, lead, epic.Owners[0].UserID)
+	assert.True(t, epic.Owners[0].Lead)
+	assert.Equal(t, "lead", epic.Owners[0].Username)
+	assert.Equal(t, dev, epic.Owners[1].UserID)
+	assert.Equal(t, 2, epic.Owners[1].TaskCount)
+}
+
+func TestRoadmapUseCase_Execute_IncludeCompleted(t *testing.T) {
+	lister := &stubTaskLister{tasks: []*taskapp.ReadModel{
+		roadmapEpic("Shipped", taskdomain.StatusDone, time.Now()),
+	}}
+
+	roadmap, err := taskapp.NewRoadmapUseCase(lister).Execute(context.Background(), taskapp.RoadmapQuery{
+		WorkspaceID:      uuid.NewUUID(),
+		IncludeCompleted: true,
+	})
+
+	require.NoError(t, err)
+	require.Len(t, roadmap.Epics, 1)
+	assert.Equal(t, "Shipped", roadmap.Epics[0].Title)
+}
+
+func TestRoadmapUseCase_Execute_InvalidWorkspace(t *testing.T) {
+	_, err := taskapp.NewRoadmapUseCase(&stubTaskLister{}).Execute(context.Background(), taskapp.RoadmapQuery{})
+
+	require.Error(t, err)
+}
@@ -0,0 +1,162 @@
+package task
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/lllypuk/flowra/internal/application/appcore"
+	"github.com/lllypuk/flowra/internal/domain/chat"
+	taskdomain "github.com/lllypuk/flowra/internal/domain/task"
+	"github.com/lllypuk/flowra/internal/domain/uuid"
+)
+
+// Velocity report bounds
+const (
+	defaultVelocitySprints = 10
+	maxVelocitySprints     = 50
+)
+
+// Estimate units as stored in the read model
+const (
+	estimateUnitPoints = "points"
+	estimateUnitHours  = "hours"
+)
+
+// TaskLister lists tasks from the read model scoped to a workspace (consumer-side interface)
+type TaskLister interface {
+	ListTasks(ctx context.Context, filters Filters) ([]*ReadModel, error)
+}
+
+// VelocityReportQuery - query for the sprint velocity of a workspace
+type VelocityReportQuery struct {
+	WorkspaceID uuid.UUID
+	Sprints     int // number of latest sprints; 0 = default (10), capped at 50
+}
+
+// SprintVelocity aggregates the estimates of one sprint
+type SprintVelocity struct {
+	Sprint           string
+	TaskCount        int
+	CompletedCount   int
+	UnestimatedCount int
+	CommittedPoints  float64
+	CompletedPoints  float64
+	CommittedHours   float64
+	CompletedHours   float64
+}
+
+// VelocityReport - velocity per sprint, oldest sprint first
+type VelocityReport struct {
+	WorkspaceID   uuid.UUID
+	Sprints       []SprintVelocity
+	AveragePoints float64 // completed points per sprint
+	AverageHours  float64 // completed hours per sprint
+}
+
+// VelocityReportUseCase computes sprint velocity from the task read model.
+// Tasks and bugs count toward their sprint; epics are left out to avoid counting
+// work twice, and cancelled items are not part of the commitment.
+type VelocityReportUseCase struct {
+	tasks TaskLister
+}
+
+// NewVelocityReportUseCase creates a new VelocityReportUseCase
+func NewVelocityReportUseCase(tasks TaskLister) *VelocityReportUseCase {
+	return &VelocityReportUseCase{tasks: tasks}
+}
+
+// Execute builds the report
+func (uc *VelocityReportUseCase) Execute(ctx context.Context, query VelocityReportQuery) (*VelocityReport, error) {
+	if err := appcore.ValidateUUID("workspaceID", query.WorkspaceID); err != nil {
+		return nil, fmt.Errorf("validation failed: %w", err)
+	}
+
+	limit := query.Sprints
+	if limit <= 0 {
+		limit = defaultVelocitySprints
+	}
+	limit = min(limit, maxVelocitySprints)
+
+	workspaceID := query.WorkspaceID
+	tasks, err := uc.tasks.ListTasks(ctx, Filters{WorkspaceID: &workspaceID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tasks: %w", err)
+	}
+
+	bySprint := make(map[string]*SprintVelocity)
+	for _, t := range tasks {
+		if t == nil || !countsTowardVelocity(t) {
+			continue
+		}
+		sprint, ok := bySprint[t.Sprint]
+		if !ok {
+			sprint = &SprintVelocity{Sprint: t.Sprint}
+			bySprint[t.Sprint] = sprint
+		}
+		addToSprint(sprint, t)
+	}
+
+	sprints := make([]SprintVelocity, 0, len(bySprint))
+	for _, s := range bySprint {
+		sprints = append(sprints, *s)
+	}
+	sort.Slice(sprints, func(i, j int) bool {
+		return chat.CompareSprintNames(sprints[i].Sprint, sprints[j].Sprint) < 0
+	})
+	if len(sprints) > limit {
+		sprints = sprints[len(sprints)-limit:]
+	}
+
+	report := &VelocityReport{
+		WorkspaceID: query.WorkspaceID,
+		Sprints:     sprints,
+	}
+	if len(sprints) > 0 {
+		for _, s := range sprints {
+			report.AveragePoints += s.CompletedPoints
+			report.AverageHours += s.CompletedHours
+		}
+		report.AveragePoints /= float64(len(sprints))
+		report.AverageHours /= float64(len(sprints))
+	}
+
+	return report, nil
+}
+
+func countsTowardVelocity(t *ReadModel) bool {
+	if strings.TrimSpace(t.Sprint) == "" || t.Status == taskdomain.StatusCancelled {
+		return false
+	}
+	return t.EntityType == taskdomain.TypeTask || t.EntityType == taskdomain.TypeBug
+}
+
+func addToSprint(sprint *SprintVelocity, t *ReadModel) {
+	done := t.Status == taskdomain.StatusDone
+
+	sprint.TaskCount++
+	if done {
+		sprint.CompletedCount++
+	}
+
+	if t.Estimate == nil {
+		sprint.UnestimatedCount++
+		return
+	}
+
+	switch t.Estimate.Unit {
+	case estimateUnitPoints:
+		sprint.CommittedPoints += t.Estimate.Value
+		if done {
+			sprint.CompletedPoints += t.Estimate.Value
+		}
+	case estimateUnitHours:
+		sprint.CommittedHours += t.Estimate.Value
+		if done {
+			sprint.CompletedHours += t.Estimate.Value
+		}
+	default:
+		sprint.UnestimatedCount++
+	}
+}
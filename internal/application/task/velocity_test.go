@@ -0,0 +1,124 @@
+package task_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	taskapp "github.com/lllypuk/flowra/internal/application/task"
+	taskdomain "github.com/lllypuk/flowra/internal/domain/task"
+	"github.com/lllypuk/flowra/internal/domain/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type stubTaskLister struct {
+	tasks   []*taskapp.ReadModel
+	err     error
+	filters taskapp.Filters
+}
+
+func (s *stubTaskLister) ListTasks(_ context.Context, filters taskapp.Filters) ([]*taskapp.ReadModel, error) {
+	s.filters = filters
+	return s.tasks, s.err
+}
+
+func velocityTask(
+	sprint string,
+	entityType taskdomain.EntityType,
+	status taskdomain.Status,
+	estimate *taskapp.EstimateReadModel,
+) *taskapp.ReadModel {
+	return &taskapp.ReadModel{
+		ID:         uuid.NewUUID(),
+		EntityType: entityType,
+		Status:     status,
+		Sprint:     sprint,
+		Estimate:   estimate,
+	}
+}
+
+func points(v float64) *taskapp.EstimateReadModel {
+	return &taskapp.EstimateReadModel{Value: v, Unit: "points"}
+}
+
+func TestVelocityReportUseCase_Execute(t *testing.T) {
+	workspaceID := uuid.NewUUID()
+	lister := &stubTaskLister{tasks: []*taskapp.ReadModel{
+		velocityTask("Sprint 10", taskdomain.TypeTask, taskdomain.StatusDone, points(5)),
+		velocityTask("Sprint 10", taskdomain.TypeTask, taskdomain.StatusInProgress, points(3)),
+		velocityTask("Sprint 9", taskdomain.TypeBug, taskdomain.StatusDone, points(2)),
+		velocityTask("Sprint 9", taskdomain.TypeTask, taskdomain.StatusDone,
+			&taskapp.EstimateReadModel{Value: 8, Unit: "hours"}),
+		velocityTask("Sprint 9", taskdomain.TypeTask, taskdomain.StatusToDo, nil),
+		velocityTask("Sprint 9", taskdomain.TypeTask, taskdomain.StatusCancelled, points(13)),
+		velocityTask("Sprint 9", taskdomain.TypeEpic, taskdomain.StatusDone, points(40)),
+		velocityTask("", taskdomain.TypeTask, taskdomain.StatusDone, points(1)),
+	}}
+
+	report, err := taskapp.NewVelocityReportUseCase(lister).Execute(context.Background(), taskapp.VelocityReportQuery{
+		WorkspaceID: workspaceID,
+	})
+
+	require.NoError(t, err)
+	require.NotNil(t, lister.filters.WorkspaceID)
+	assert.Equal(t, workspaceID, *lister.filters.WorkspaceID)
+
+	require.Len(t, report.Sprints, 2)
+	assert.Equal(t, taskapp.SprintVelocity{
+		Sprint:           "Sprint 9",
+		TaskCount:        3,
+		CompletedCount:   2,
+		UnestimatedCount: 1,
+		CommittedPoints:  2,
+		CompletedPoints:  2,
+		CommittedHours:   8,
+		CompletedHours:   8,
+	}, report.Sprints[0])
+	assert.Equal(t, taskapp.SprintVelocity{
+		Sprint:          "Sprint 10",
+		TaskCount:       2,
+		CompletedCount:  1,
+		CommittedPoints: 8,
+		CompletedPoints: 5,
+	}, report.Sprints[1])
+	assert.InDelta(t, 3.5, report.AveragePoints, 0.001)
+	assert.InDelta(t, 4.0, report.AverageHours, 0.001)
+}
+
+func TestVelocityReportUseCase_Execute_LimitsToLatestSprints(t *testing.T) {
+	lister := &stubTaskLister{tasks: []*taskapp.ReadModel{
+		velocityTask("Sprint 1", taskdomain.TypeTask, taskdomain.StatusDone, points(1)),
+		velocityTask("Sprint 2", taskdomain.TypeTask, taskdomain.StatusDone, points(2)),
+		velocityTask("Sprint 3", taskdomain.TypeTask, taskdomain.StatusDone, points(3)),
+	}}
+
+	report, err := taskapp.NewVelocityReportUseCase(lister).Execute(context.Background(), taskapp.VelocityReportQuery{
+		WorkspaceID: uuid.NewUUID(),
+		Sprints:     2,
+	})
+
+	require.NoError(t, err)
+	require.Len(t, report.Sprints, 2)
+	assert.Equal(t, "Sprint 2", report.Sprints[0].Sprint)
+	assert.Equal(t, "Sprint 3", report.Sprints[1].Sprint)
+	assert.InDelta(t, 2.5, report.AveragePoints, 0.001)
+}
+
+func TestVelocityReportUseCase_Execute_Errors(t *testing.T) {
+	t.Run("invalid workspace", func(t *testing.T) {
+		_, err := taskapp.NewVelocityReportUseCase(&stubTaskLister{}).Execute(
+			context.Background(), taskapp.VelocityReportQuery{})
+
+		require.Error(t, err)
+	})
+
+	t.Run("list failure", func(t *testing.T) {
+		listErr := errors.New("read model unavailable")
+
+		_, err := taskapp.NewVelocityReportUseCase(&stubTaskLister{err: listErr}).Execute(
+			context.Background(), taskapp.VelocityReportQuery{WorkspaceID: uuid.NewUUID()})
+
+		require.ErrorIs(t, err, listErr)
+	})
+}
@@ -22,6 +22,8 @@ type UpdateProfileCommand struct {
 	UserID      uuid.UUID
 	DisplayName *string // optsionalno
 	Email       *string // optsionalno
+	Locale      *string // optional; "" clears the preference
+	Timezone    *string // optional IANA name; "" clears the preference
 }
 
 func (c UpdateProfileCommand) CommandName() string { return "UpdateProfile" }
@@ -33,3 +35,19 @@ type PromoteToAdminCommand struct {
 }
 
 func (c PromoteToAdminCommand) CommandName() string { return "PromoteToAdmin" }
+
+// ImportUsersCommand - bulk import of users, e.g. rows of a CSV file
+type ImportUsersCommand struct {
+	Rows   []ImportUserRow
+	DryRun bool // validate the rows without creating anyone
+}
+
+func (c ImportUsersCommand) CommandName() string { return "ImportUsers" }
+
+// ImportUserRow is one user to import
+type ImportUserRow struct {
+	Line        int // line in the source file, for the report
+	Username    string
+	Email       string
+	DisplayName string // optional; defaults to the username
+}
@@ -20,4 +20,11 @@ var (
 
 	// ErrInvalidUsername is returned when username format is invalid
 	ErrInvalidUsername = errors.New("invalid username format")
+
+	// ErrInvalidImportFile is returned when a user import file cannot be parsed
+	ErrInvalidImportFile = errors.New("invalid import file")
+
+	// ErrIdentityAlreadyExists is returned when the identity provider already has an account
+	// with the username or email
+	ErrIdentityAlreadyExists = errors.New("account already exists in the identity provider")
 )
@@ -0,0 +1,227 @@
+package user
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+
+	"github.com/lllypuk/flowra/internal/application/appcore"
+	"github.com/lllypuk/flowra/internal/domain/user"
+)
+
+const (
+	// MaxImportRows is the maximum number of users in one import
+	MaxImportRows = 1000
+
+	maxImportUsernameLength    = 64
+	maxImportDisplayNameLength = 100
+)
+
+// importUsernameRegex matches usernames that can be @mentioned
+var importUsernameRegex = regexp.MustCompile(`^[a-zA-Z0-9._-]+$`)
+
+// IdentityProvider creates accounts in the external authentication system.
+// Declared on the consumer side per project guidelines.
+type IdentityProvider interface {
+	// CreateUser creates an account and returns its external ID.
+	// Returns ErrIdentityAlreadyExists when the username or email is taken.
+	CreateUser(ctx context.Context, username, email, displayName string) (string, error)
+}
+
+// ImportUsersUseCase creates users in bulk, first in the identity provider and
+// then locally. Rows are validated and imported one by one; a failed row does not
+// stop the import.
+type ImportUsersUseCase struct {
+	userRepo Repository
+	identity IdentityProvider
+}
+
+// NewImportUsersUseCase creates New ImportUsersUseCase
+func NewImportUsersUseCase(userRepo Repository, identity IdentityProvider) *ImportUsersUseCase {
+	return &ImportUsersUseCase{userRepo: userRepo, identity: identity}
+}
+
+// Execute imports the rows and reports the outcome of each
+func (uc *ImportUsersUseCase) Execute(ctx context.Context, cmd ImportUsersCommand) (ImportUsersResult, error) {
+	if len(cmd.Rows) == 0 {
+		return ImportUsersResult{}, appcore.NewValidationError("rows", "no users to import")
+	}
+	if len(cmd.Rows) > MaxImportRows {
+		return ImportUsersResult{}, appcore.NewValidationError(
+			"rows", fmt.Sprintf("at most %d users can be imported at once", MaxImportRows))
+	}
+
+	result := ImportUsersResult{DryRun: cmd.DryRun, Rows: make([]ImportRowResult, 0, len(cmd.Rows))}
+	seenUsernames := make(map[string]int, len(cmd.Rows))
+	seenEmails := make(map[string]int, len(cmd.Rows))
+
+	for _, row := range cmd.Rows {
+		if err := ctx.Err(); err != nil {
+			return ImportUsersResult{}, err
+		}
+
+		row = normalizeImportRow(row)
+		res := ImportRowResult{Line: row.Line, Username: row.Username, Email: row.Email}
+
+		err := validateImportRow(row)
+		if err == nil {
+			err = checkDuplicateInFile(seenUsernames, seenEmails, row)
+		}
+		if err == nil {
+			err = uc.checkNotRegistered(ctx, row)
+		}
+		switch {
+		case err != nil:
+			res.Status, res.Error = ImportStatusFailed, err.Error()
+		case cmd.DryRun:
+			res.Status = ImportStatusValid
+		default:
+			usr, createErr := uc.create(ctx, row)
+			if createErr != nil {
+				res.Status, res.Error = ImportStatusFailed, createErr.Error()
+			} else {
+				res.Status, res.UserID = ImportStatusCreated, usr.ID()
+			}
+		}
+
+		switch res.Status {
+		case ImportStatusCreated:
+			result.Created++
+		case ImportStatusValid:
+			result.Valid++
+		case ImportStatusFailed:
+			result.Failed++
+		}
+		result.Rows = append(result.Rows, res)
+	}
+
+	return result, nil
+}
+
+// checkNotRegistered rejects rows whose username or email already has a local user
+func (uc *ImportUsersUseCase) checkNotRegistered(ctx context.Context, row ImportUserRow) error {
+	if existing, err := uc.userRepo.FindByUsername(ctx, row.Username); err == nil && existing != nil {
+		return ErrUsernameAlreadyExists
+	}
+	if existing, err := uc.userRepo.FindByEmail(ctx, row.Email); err == nil && existing != nil {
+		return ErrEmailAlreadyExists
+	}
+	return nil
+}
+
+// create registers the account in the identity provider and saves the local user
+func (uc *ImportUsersUseCase) create(ctx context.Context, row ImportUserRow) (*user.User, error) {
+	externalID, err := uc.identity.CreateUser(ctx, row.Username, row.Email, row.DisplayName)
+	if err != nil {
+		if errors.Is(err, ErrIdentityAlreadyExists) {
+			return nil, ErrIdentityAlreadyExists
+		}
+		return nil, fmt.Errorf("failed to create account: %w", err)
+	}
+
+	usr, err := user.NewUser(externalID, row.Username, row.Email, row.DisplayName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create user: %w", err)
+	}
+	if saveErr := uc.userRepo.Save(ctx, usr); saveErr != nil {
+		// the account exists in the identity provider; the user sync links it later
+		return nil, fmt.Errorf("account created but failed to save user: %w", saveErr)
+	}
+	return usr, nil
+}
+
+func normalizeImportRow(row ImportUserRow) ImportUserRow {
+	row.Username = strings.TrimSpace(row.Username)
+	row.Email = strings.ToLower(strings.TrimSpace(row.Email))
+	row.DisplayName = strings.TrimSpace(row.DisplayName)
+	if row.DisplayName == "" {
+		row.DisplayName = row.Username
+	}
+	return row
+}
+
+func validateImportRow(row ImportUserRow) error {
+	if err := appcore.ValidateRequired("username", row.Username); err != nil {
+		return err
+	}
+	if err := appcore.ValidateMaxLength("username", row.Username, maxImportUsernameLength); err != nil {
+		return err
+	}
+	if !importUsernameRegex.MatchString(row.Username) {
+		return appcore.NewValidationError("username", "may only contain letters, digits, '.', '_' and '-'")
+	}
+	if err := appcore.ValidateEmail("email", row.Email); err != nil {
+		return err
+	}
+	return appcore.ValidateMaxLength("display_name", row.DisplayName, maxImportDisplayNameLength)
+}
+
+// checkDuplicateInFile rejects a row repeating the username or email of an earlier row
+func checkDuplicateInFile(usernames, emails map[string]int, row ImportUserRow) error {
+	username := strings.ToLower(row.Username)
+	if line, ok := usernames[username]; ok {
+		return fmt.Errorf("username repeats line %d", line)
+	}
+	if line, ok := emails[row.Email]; ok {
+		return fmt.Errorf("email repeats line %d", line)
+	}
+	usernames[username] = row.Line
+	emails[row.Email] = row.Line
+	return nil
+}
+
+// ParseImportCSV reads users from CSV with a header row. The username and email
+// columns are required, display_name is optional and other columns are ignored.
+func ParseImportCSV(r io.Reader) ([]ImportUserRow, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("%w: file is empty", ErrInvalidImportFile)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidImportFile, err)
+	}
+
+	columns := map[string]int{"username": -1, "email": -1, "display_name": -1}
+	for i, name := range header {
+		name = strings.ToLower(strings.TrimSpace(strings.TrimPrefix(name, "\ufeff")))
+		if _, known := columns[name]; known {
+			columns[name] = i
+		}
+	}
+	if columns["username"] < 0 || columns["email"] < 0 {
+		return nil, fmt.Errorf("%w: header must name the username and email columns", ErrInvalidImportFile)
+	}
+
+	field := func(record []string, column string) string {
+		if i := columns[column]; i >= 0 && i < len(record) {
+			return record[i]
+		}
+		return ""
+	}
+
+	var rows []ImportUserRow
+	for {
+		record, readErr := reader.Read()
+		if errors.Is(readErr, io.EOF) {
+			return rows, nil
+		}
+		if readErr != nil {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidImportFile, readErr)
+		}
+		line, _ := reader.FieldPos(0)
+		rows = append(rows, ImportUserRow{
+			Line:        line,
+			Username:    field(record, "username"),
+			Email:       field(record, "email"),
+			DisplayName: field(record, "display_name"),
+		})
+	}
+}
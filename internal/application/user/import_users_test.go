@@ -0,0 +1,141 @@
+package user_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/lllypuk/flowra/internal/application/user"
+	domainuser "github.com/lllypuk/flowra/internal/domain/user"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeIdentityProvider records the accounts it creates
+type fakeIdentityProvider struct {
+	created []string
+	taken   map[string]bool // usernames that already have an account
+	err     error
+}
+
+func (f *fakeIdentityProvider) CreateUser(_ context.Context, username, _, _ string) (string, error) {
+	if f.err != nil {
+		return "", f.err
+	}
+	if f.taken[username] {
+		return "", user.ErrIdentityAlreadyExists
+	}
+	f.created = append(f.created, username)
+	return "kc-" + username, nil
+}
+
+func TestParseImportCSV(t *testing.T) {
+	rows, err := user.ParseImportCSV(strings.NewReader(
+		"\ufeffEmail, Username,team\n" +
+			"alice@example.com,alice,core\n" +
+			"\n" +
+			"bob@example.com,bob\n"))
+	require.NoError(t, err)
+
+	assert.Equal(t, []user.ImportUserRow{
+		{Line: 2, Username: "alice", Email: "alice@example.com"},
+		{Line: 4, Username: "bob", Email: "bob@example.com"},
+	}, rows)
+
+	_, err = user.ParseImportCSV(strings.NewReader("name,mail\nalice,alice@example.com\n"))
+	require.ErrorIs(t, err, user.ErrInvalidImportFile)
+
+	_, err = user.ParseImportCSV(strings.NewReader(""))
+	require.ErrorIs(t, err, user.ErrInvalidImportFile)
+}
+
+func TestImportUsersUseCase_Execute(t *testing.T) {
+	newFixture := func() (*mockUserRepository, *fakeIdentityProvider, *user.ImportUsersUseCase) {
+		repo := newMockUserRepository()
+		existing, err := domainuser.NewUser("kc-carol", "carol", "carol@example.com", "Carol")
+		require.NoError(t, err)
+		require.NoError(t, repo.Save(context.Background(), existing))
+
+		identity := &fakeIdentityProvider{taken: map[string]bool{"dave": true}}
+		return repo, identity, user.NewImportUsersUseCase(repo, identity)
+	}
+	rows := []user.ImportUserRow{
+		{Line: 2, Username: "alice", Email: "Alice@Example.com", DisplayName: "Alice Smith"},
+		{Line: 3, Username: "bob", Email: "bob@example.com"},
+		{Line: 4, Username: "carol", Email: "carol2@example.com"},
+		{Line: 5, Username: "Alice", Email: "alice2@example.com"},
+		{Line: 6, Username: "eve", Email: "not-an-email"},
+		{Line: 7, Username: "bad name", Email: "bad@example.com"},
+		{Line: 8, Username: "dave", Email: "dave@example.com"},
+	}
+
+	t.Run("creates valid rows and reports the rest", func(t *testing.T) {
+		repo, identity, uc := newFixture()
+
+		result, err := uc.Execute(context.Background(), user.ImportUsersCommand{Rows: rows})
+		require.NoError(t, err)
+
+		assert.Equal(t, 2, result.Created)
+		assert.Equal(t, 5, result.Failed)
+		require.Len(t, result.Rows, len(rows))
+		assert.Equal(t, []string{"alice", "bob"}, identity.created)
+
+		alice := result.Rows[0]
+		assert.Equal(t, user.ImportStatusCreated, alice.Status)
+		assert.Equal(t, "alice@example.com", alice.Email)
+		saved := repo.usersByID[alice.UserID]
+		require.NotNil(t, saved)
+		assert.Equal(t, "kc-alice", saved.ExternalID())
+		assert.Equal(t, "Alice Smith", saved.DisplayName())
+		assert.Equal(t, "bob", repo.users["bob"].DisplayName())
+
+		assert.Equal(t, user.ErrUsernameAlreadyExists.Error(), result.Rows[2].Error)
+		assert.Equal(t, "username repeats line 2", result.Rows[3].Error)
+		assert.Contains(t, result.Rows[4].Error, "email")
+		assert.Contains(t, result.Rows[5].Error, "username")
+		assert.Equal(t, user.ErrIdentityAlreadyExists.Error(), result.Rows[6].Error)
+		for _, row := range result.Rows[2:] {
+			assert.Equal(t, user.ImportStatusFailed, row.Status, "line %d", row.Line)
+		}
+	})
+
+	t.Run("dry run creates nobody", func(t *testing.T) {
+		repo, identity, uc := newFixture()
+
+		result, err := uc.Execute(context.Background(), user.ImportUsersCommand{Rows: rows, DryRun: true})
+		require.NoError(t, err)
+
+		assert.True(t, result.DryRun)
+		assert.Equal(t, 0, result.Created)
+		// dave only fails when the identity provider is asked
+		assert.Equal(t, 3, result.Valid)
+		assert.Equal(t, 4, result.Failed)
+		assert.Empty(t, identity.created)
+		assert.Len(t, repo.users, 1)
+	})
+
+	t.Run("identity provider errors fail the row", func(t *testing.T) {
+		repo, identity, uc := newFixture()
+		identity.err = errors.New("keycloak unavailable")
+
+		result, err := uc.Execute(context.Background(), user.ImportUsersCommand{Rows: rows[:1]})
+		require.NoError(t, err)
+
+		assert.Equal(t, 1, result.Failed)
+		assert.Contains(t, result.Rows[0].Error, "keycloak unavailable")
+		assert.Len(t, repo.users, 1)
+	})
+
+	t.Run("rejects empty and oversized imports", func(t *testing.T) {
+		_, _, uc := newFixture()
+
+		_, err := uc.Execute(context.Background(), user.ImportUsersCommand{})
+		require.Error(t, err)
+
+		_, err = uc.Execute(context.Background(), user.ImportUsersCommand{
+			Rows: make([]user.ImportUserRow, user.MaxImportRows+1),
+		})
+		require.Error(t, err)
+	})
+}
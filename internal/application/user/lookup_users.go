@@ -0,0 +1,53 @@
+package user
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/lllypuk/flowra/internal/application/appcore"
+)
+
+const (
+	// MaxLookupUsers maximum count user IDs in odnom batch zaprose
+	MaxLookupUsers = 100
+)
+
+// LookupUsersUseCase handles batch retrieval users po ID
+type LookupUsersUseCase struct {
+	userRepo Repository
+}
+
+// NewLookupUsersUseCase creates New LookupUsersUseCase
+func NewLookupUsersUseCase(userRepo Repository) *LookupUsersUseCase {
+	return &LookupUsersUseCase{userRepo: userRepo}
+}
+
+// Execute performs batch retrieval users. Unknown IDs are skipped.
+func (uc *LookupUsersUseCase) Execute(
+	ctx context.Context,
+	query LookupUsersQuery,
+) (UsersResult, error) {
+	// validation
+	if err := uc.validate(query); err != nil {
+		return UsersResult{}, fmt.Errorf("validation failed: %w", err)
+	}
+
+	users, err := uc.userRepo.FindByIDs(ctx, query.UserIDs)
+	if err != nil {
+		return UsersResult{}, fmt.Errorf("failed to look up users: %w", err)
+	}
+
+	return UsersResult{Users: users}, nil
+}
+
+func (uc *LookupUsersUseCase) validate(query LookupUsersQuery) error {
+	if err := appcore.ValidateRange("userIDs", len(query.UserIDs), 1, MaxLookupUsers); err != nil {
+		return err
+	}
+	for _, id := range query.UserIDs {
+		if err := appcore.ValidateUUID("userIDs", id); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,68 @@
+package user_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/lllypuk/flowra/internal/application/user"
+	domainuser "github.com/lllypuk/flowra/internal/domain/user"
+	"github.com/lllypuk/flowra/internal/domain/uuid"
+)
+
+func TestLookupUsersUseCase_Execute_Success(t *testing.T) {
+	// Arrange
+	repo := newMockUserRepository()
+	useCase := user.NewLookupUsersUseCase(repo)
+
+	alice, _ := domainuser.NewUser("external-1", "alice", "alice@example.com", "Alice")
+	bob, _ := domainuser.NewUser("external-2", "bob", "bob@example.com", "Bob")
+	_ = repo.Save(context.Background(), alice)
+	_ = repo.Save(context.Background(), bob)
+
+	query := user.LookupUsersQuery{
+		UserIDs: []uuid.UUID{alice.ID(), uuid.NewUUID(), bob.ID()},
+	}
+
+	// Act
+	result, err := useCase.Execute(context.Background(), query)
+
+	// Assert
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+
+	if len(result.Users) != 2 {
+		t.Fatalf("expected 2 users, got %d", len(result.Users))
+	}
+}
+
+func TestLookupUsersUseCase_Validate(t *testing.T) {
+	tooMany := make([]uuid.UUID, user.MaxLookupUsers+1)
+	for i := range tooMany {
+		tooMany[i] = uuid.NewUUID()
+	}
+
+	tests := []struct {
+		name    string
+		userIDs []uuid.UUID
+	}{
+		{name: "empty", userIDs: nil},
+		{name: "too many", userIDs: tooMany},
+		{name: "invalid ID", userIDs: []uuid.UUID{uuid.NewUUID(), uuid.UUID("")}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			useCase := user.NewLookupUsersUseCase(newMockUserRepository())
+
+			// Act
+			_, err := useCase.Execute(context.Background(), user.LookupUsersQuery{UserIDs: tt.userIDs})
+
+			// Assert
+			if err == nil {
+				t.Error("expected validation error")
+			}
+		})
+	}
+}
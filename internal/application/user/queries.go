@@ -28,3 +28,10 @@ type ListUsersQuery struct {
 }
 
 func (q ListUsersQuery) QueryName() string { return "ListUsers" }
+
+// LookupUsersQuery - batch retrieval users po ID
+type LookupUsersQuery struct {
+	UserIDs []uuid.UUID
+}
+
+func (q LookupUsersQuery) QueryName() string { return "LookupUsers" }
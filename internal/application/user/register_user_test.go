@@ -37,6 +37,16 @@ func (m *mockUserRepository) FindByID(_ context.Context, id uuid.UUID) (*domainu
 	return nil, errors.New("not found")
 }
 
+func (m *mockUserRepository) FindByIDs(_ context.Context, ids []uuid.UUID) ([]*domainuser.User, error) {
+	users := make([]*domainuser.User, 0, len(ids))
+	for _, id := range ids {
+		if usr, ok := m.usersByID[id]; ok {
+			users = append(users, usr)
+		}
+	}
+	return users, nil
+}
+
 func (m *mockUserRepository) FindByExternalID(_ context.Context, keycloakID string) (*domainuser.User, error) {
 	if usr, ok := m.usersByExternalID[keycloakID]; ok {
 		return usr, nil
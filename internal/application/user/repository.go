@@ -23,6 +23,9 @@ type QueryRepository interface {
 	// FindByID finds user po ID
 	FindByID(ctx context.Context, id uuid.UUID) (*user.User, error)
 
+	// FindByIDs finds users by IDs in a single query; unknown IDs are skipped
+	FindByIDs(ctx context.Context, ids []uuid.UUID) ([]*user.User, error)
+
 	// FindByExternalID finds user po ID from vneshney sistemy autentifikatsii
 	FindByExternalID(ctx context.Context, externalID string) (*user.User, error)
 
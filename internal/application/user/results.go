@@ -3,6 +3,7 @@ package user
 import (
 	"github.com/lllypuk/flowra/internal/application/appcore"
 	"github.com/lllypuk/flowra/internal/domain/user"
+	"github.com/lllypuk/flowra/internal/domain/uuid"
 )
 
 // Result - result operatsii s odnim user
@@ -17,3 +18,37 @@ type UsersListResult struct {
 	Offset     int
 	Limit      int
 }
+
+// UsersResult - result batch operatsii; users are not in request order
+type UsersResult struct {
+	Users []*user.User
+}
+
+// ImportStatus is the outcome of one imported row
+type ImportStatus string
+
+// Import row outcomes.
+const (
+	ImportStatusCreated ImportStatus = "created" // user created
+	ImportStatusValid   ImportStatus = "valid"   // dry run: the user would be created
+	ImportStatusFailed  ImportStatus = "failed"  // see Error
+)
+
+// ImportRowResult - outcome of one row of an import
+type ImportRowResult struct {
+	Line     int
+	Username string
+	Email    string
+	Status   ImportStatus
+	UserID   uuid.UUID // set for created users
+	Error    string    // set for failed rows
+}
+
+// ImportUsersResult - per-row report of an import
+type ImportUsersResult struct {
+	DryRun  bool
+	Rows    []ImportRowResult
+	Created int
+	Valid   int
+	Failed  int
+}
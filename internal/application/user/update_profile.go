@@ -44,8 +44,20 @@ func (uc *UpdateProfileUseCase) Execute(
 	}
 
 	// update profilya
-	if updateErr := usr.UpdateProfile(cmd.DisplayName, cmd.Email); updateErr != nil {
-		return Result{}, fmt.Errorf("failed to update profile: %w", updateErr)
+	if cmd.DisplayName != nil || cmd.Email != nil {
+		if updateErr := usr.UpdateProfile(cmd.DisplayName, cmd.Email); updateErr != nil {
+			return Result{}, fmt.Errorf("failed to update profile: %w", updateErr)
+		}
+	}
+	if cmd.Locale != nil {
+		if localeErr := usr.SetLocale(*cmd.Locale); localeErr != nil {
+			return Result{}, fmt.Errorf("failed to update locale: %w", localeErr)
+		}
+	}
+	if cmd.Timezone != nil {
+		if tzErr := usr.SetTimezone(*cmd.Timezone); tzErr != nil {
+			return Result{}, fmt.Errorf("failed to update timezone: %w", tzErr)
+		}
 	}
 
 	// storage
@@ -66,8 +78,8 @@ func (uc *UpdateProfileUseCase) validate(cmd UpdateProfileCommand) error {
 	}
 
 	// Checking, that hotya by odno field for updating ukazano
-	if cmd.DisplayName == nil && cmd.Email == nil {
-		return errors.New("at least one field (displayName or email) must be provided")
+	if cmd.DisplayName == nil && cmd.Email == nil && cmd.Locale == nil && cmd.Timezone == nil {
+		return errors.New("at least one field (displayName, email, locale or timezone) must be provided")
 	}
 
 	// validation email if on predostavlen
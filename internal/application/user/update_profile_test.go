@@ -37,6 +37,77 @@ func TestUpdateProfileUseCase_Execute_Success_DisplayName(t *testing.T) {
 	}
 }
 
+func TestUpdateProfileUseCase_Execute_Success_Locale(t *testing.T) {
+	// Arrange
+	repo := newMockUserRepository()
+	useCase := user.NewUpdateProfileUseCase(repo)
+
+	existingUser, _ := domainuser.NewUser("external-123", "testuser", "test@example.com", "Name")
+	_ = repo.Save(context.Background(), existingUser)
+
+	locale := "ru"
+	cmd := user.UpdateProfileCommand{
+		UserID: existingUser.ID(),
+		Locale: &locale,
+	}
+
+	// Act
+	result, err := useCase.Execute(context.Background(), cmd)
+
+	// Assert
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if result.Value.Locale() != locale {
+		t.Errorf("expected locale %s, got %s", locale, result.Value.Locale())
+	}
+	if result.Value.DisplayName() != "Name" {
+		t.Errorf("expected displayName to be unchanged, got %s", result.Value.DisplayName())
+	}
+
+	invalid := "not a locale"
+	if _, invalidErr := useCase.Execute(context.Background(), user.UpdateProfileCommand{
+		UserID: existingUser.ID(),
+		Locale: &invalid,
+	}); invalidErr == nil {
+		t.Fatal("expected error for invalid locale")
+	}
+}
+
+func TestUpdateProfileUseCase_Execute_Success_Timezone(t *testing.T) {
+	// Arrange
+	repo := newMockUserRepository()
+	useCase := user.NewUpdateProfileUseCase(repo)
+
+	existingUser, _ := domainuser.NewUser("external-123", "testuser", "test@example.com", "Name")
+	_ = repo.Save(context.Background(), existingUser)
+
+	timezone := "Asia/Tokyo"
+	cmd := user.UpdateProfileCommand{
+		UserID:   existingUser.ID(),
+		Timezone: &timezone,
+	}
+
+	// Act
+	result, err := useCase.Execute(context.Background(), cmd)
+
+	// Assert
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if result.Value.Timezone() != timezone {
+		t.Errorf("expected timezone %s, got %s", timezone, result.Value.Timezone())
+	}
+
+	invalid := "Nowhere/City"
+	if _, invalidErr := useCase.Execute(context.Background(), user.UpdateProfileCommand{
+		UserID:   existingUser.ID(),
+		Timezone: &invalid,
+	}); invalidErr == nil {
+		t.Fatal("expected error for invalid timezone")
+	}
+}
+
 func TestUpdateProfileUseCase_Execute_Success_Email(t *testing.T) {
 	// Arrange
 	repo := newMockUserRepository()
@@ -3,7 +3,9 @@ package workspace
 import (
 	"time"
 
+	"github.com/lllypuk/flowra/internal/domain/chat"
 	"github.com/lllypuk/flowra/internal/domain/uuid"
+	"github.com/lllypuk/flowra/internal/domain/workspace"
 )
 
 // Command bazovyy interface commands
@@ -29,6 +31,63 @@ type UpdateWorkspaceCommand struct {
 
 func (c UpdateWorkspaceCommand) CommandName() string { return "UpdateWorkspace" }
 
+// UpdateValuePolicyCommand - replace the allowed priorities/severities per entity type
+type UpdateValuePolicyCommand struct {
+	WorkspaceID uuid.UUID
+	Priorities  map[chat.Type][]string // entity type -> allowed priorities; missing types are unrestricted
+	Severities  map[chat.Type][]string // only "bug" may be restricted
+	UpdatedBy   uuid.UUID
+}
+
+func (c UpdateValuePolicyCommand) CommandName() string { return "UpdateValuePolicy" }
+
+// UpdateAnalyticsOptOutCommand - opt the workspace out of (or back into) product analytics
+type UpdateAnalyticsOptOutCommand struct {
+	WorkspaceID uuid.UUID
+	OptOut      bool
+	UpdatedBy   uuid.UUID
+}
+
+func (c UpdateAnalyticsOptOutCommand) CommandName() string { return "UpdateAnalyticsOptOut" }
+
+// UpdateJoinApprovalCommand - require (or stop requiring) admin approval for new members
+type UpdateJoinApprovalCommand struct {
+	WorkspaceID     uuid.UUID
+	RequireApproval bool
+	UpdatedBy       uuid.UUID
+}
+
+func (c UpdateJoinApprovalCommand) CommandName() string { return "UpdateJoinApproval" }
+
+// UpdateDiscoverabilityCommand - list (or hide) the workspace in the discovery directory
+type UpdateDiscoverabilityCommand struct {
+	WorkspaceID  uuid.UUID
+	Discoverable bool
+	UpdatedBy    uuid.UUID
+}
+
+func (c UpdateDiscoverabilityCommand) CommandName() string { return "UpdateDiscoverability" }
+
+// UpdateSLAPolicyCommand - replace the SLA targets of bugs per severity
+type UpdateSLAPolicyCommand struct {
+	WorkspaceID uuid.UUID
+	Targets     map[string]workspace.SLATarget // severity -> targets; missing severities have no SLA
+	UpdatedBy   uuid.UUID
+}
+
+func (c UpdateSLAPolicyCommand) CommandName() string { return "UpdateSLAPolicy" }
+
+// UpdateBrandingCommand - change avatar and/or accent color of the workspace
+type UpdateBrandingCommand struct {
+	WorkspaceID    uuid.UUID
+	AccentColor    *string    // optsionalno; nil ostavlyaet tekuschiy, "" sbrasyvaet
+	AvatarFileID   *uuid.UUID // optsionalno; nil ostavlyaet tekuschiy, zero UUID udalyaet avatar
+	AvatarFileName string
+	UpdatedBy      uuid.UUID
+}
+
+func (c UpdateBrandingCommand) CommandName() string { return "UpdateBranding" }
+
 // CreateInviteCommand - creation invayta
 type CreateInviteCommand struct {
 	WorkspaceID uuid.UUID
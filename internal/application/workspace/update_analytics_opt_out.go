@@ -0,0 +1,57 @@
+package workspace
+
+import (
+	"context"
+
+	"github.com/lllypuk/flowra/internal/application/appcore"
+	"github.com/lllypuk/flowra/internal/domain/workspace"
+)
+
+// UpdateAnalyticsOptOutUseCase - use case for the per-workspace product analytics opt-out
+type UpdateAnalyticsOptOutUseCase struct {
+	appcore.BaseUseCase
+
+	workspaceRepo Repository
+}
+
+// NewUpdateAnalyticsOptOutUseCase creates New UpdateAnalyticsOptOutUseCase
+func NewUpdateAnalyticsOptOutUseCase(workspaceRepo Repository) *UpdateAnalyticsOptOutUseCase {
+	return &UpdateAnalyticsOptOutUseCase{
+		workspaceRepo: workspaceRepo,
+	}
+}
+
+// Execute sets the analytics opt-out of the workspace.
+// The flag is checked by the analytics event handler before each event is sent.
+func (uc *UpdateAnalyticsOptOutUseCase) Execute(
+	ctx context.Context,
+	cmd UpdateAnalyticsOptOutCommand,
+) (Result, error) {
+	if err := uc.ValidateContext(ctx); err != nil {
+		return Result{}, uc.WrapError("validate context", err)
+	}
+
+	if err := appcore.ValidateUUID("workspaceID", cmd.WorkspaceID); err != nil {
+		return Result{}, uc.WrapError("validation failed", err)
+	}
+	if err := appcore.ValidateUUID("updatedBy", cmd.UpdatedBy); err != nil {
+		return Result{}, uc.WrapError("validation failed", err)
+	}
+
+	ws, err := uc.workspaceRepo.FindByID(ctx, cmd.WorkspaceID)
+	if err != nil {
+		return Result{}, uc.WrapError("find workspace", ErrWorkspaceNotFound)
+	}
+
+	ws.SetAnalyticsOptOut(cmd.OptOut)
+
+	if errSave := uc.workspaceRepo.Save(ctx, ws); errSave != nil {
+		return Result{}, uc.WrapError("save workspace", errSave)
+	}
+
+	return Result{
+		Result: appcore.Result[*workspace.Workspace]{
+			Value: ws,
+		},
+	}, nil
+}
@@ -0,0 +1,50 @@
+package workspace_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/lllypuk/flowra/internal/application/workspace"
+	"github.com/lllypuk/flowra/internal/domain/uuid"
+	domainworkspace "github.com/lllypuk/flowra/internal/domain/workspace"
+)
+
+func TestUpdateAnalyticsOptOutUseCase_Execute_Success(t *testing.T) {
+	repo := newMockWorkspaceRepository()
+	useCase := workspace.NewUpdateAnalyticsOptOutUseCase(repo)
+
+	existingWs, _ := domainworkspace.NewWorkspace("Workspace", "", "keycloak-group-id", uuid.NewUUID())
+	_ = repo.Save(context.Background(), existingWs)
+
+	result, err := useCase.Execute(context.Background(), workspace.UpdateAnalyticsOptOutCommand{
+		WorkspaceID: existingWs.ID(),
+		OptOut:      true,
+		UpdatedBy:   uuid.NewUUID(),
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if !result.Value.AnalyticsOptOut() {
+		t.Error("expected workspace to be opted out")
+	}
+
+	saved, _ := repo.FindByID(context.Background(), existingWs.ID())
+	if !saved.AnalyticsOptOut() {
+		t.Error("expected opt-out to be saved")
+	}
+}
+
+func TestUpdateAnalyticsOptOutUseCase_Execute_WorkspaceNotFound(t *testing.T) {
+	repo := newMockWorkspaceRepository()
+	useCase := workspace.NewUpdateAnalyticsOptOutUseCase(repo)
+
+	_, err := useCase.Execute(context.Background(), workspace.UpdateAnalyticsOptOutCommand{
+		WorkspaceID: uuid.NewUUID(),
+		OptOut:      true,
+		UpdatedBy:   uuid.NewUUID(),
+	})
+	if !errors.Is(err, workspace.ErrWorkspaceNotFound) {
+		t.Errorf("expected ErrWorkspaceNotFound, got: %v", err)
+	}
+}
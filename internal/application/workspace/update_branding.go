@@ -0,0 +1,70 @@
+package workspace
+
+import (
+	"context"
+
+	"github.com/lllypuk/flowra/internal/application/appcore"
+	"github.com/lllypuk/flowra/internal/domain/workspace"
+)
+
+// UpdateBrandingUseCase - use case for the workspace avatar and accent color
+type UpdateBrandingUseCase struct {
+	appcore.BaseUseCase
+
+	workspaceRepo Repository
+}
+
+// NewUpdateBrandingUseCase creates New UpdateBrandingUseCase
+func NewUpdateBrandingUseCase(workspaceRepo Repository) *UpdateBrandingUseCase {
+	return &UpdateBrandingUseCase{
+		workspaceRepo: workspaceRepo,
+	}
+}
+
+// Execute applies the provided branding fields; omitted fields keep their current value.
+func (uc *UpdateBrandingUseCase) Execute(
+	ctx context.Context,
+	cmd UpdateBrandingCommand,
+) (Result, error) {
+	if err := uc.ValidateContext(ctx); err != nil {
+		return Result{}, uc.WrapError("validate context", err)
+	}
+
+	if err := appcore.ValidateUUID("workspaceID", cmd.WorkspaceID); err != nil {
+		return Result{}, uc.WrapError("validation failed", err)
+	}
+	if err := appcore.ValidateUUID("updatedBy", cmd.UpdatedBy); err != nil {
+		return Result{}, uc.WrapError("validation failed", err)
+	}
+
+	ws, err := uc.workspaceRepo.FindByID(ctx, cmd.WorkspaceID)
+	if err != nil {
+		return Result{}, uc.WrapError("find workspace", ErrWorkspaceNotFound)
+	}
+
+	current := ws.Branding()
+	avatarFileID, avatarFileName := current.AvatarFileID(), current.AvatarFileName()
+	if cmd.AvatarFileID != nil {
+		avatarFileID, avatarFileName = *cmd.AvatarFileID, cmd.AvatarFileName
+	}
+	accentColor := current.AccentColor()
+	if cmd.AccentColor != nil {
+		accentColor = *cmd.AccentColor
+	}
+
+	branding, err := workspace.NewBranding(avatarFileID, avatarFileName, accentColor)
+	if err != nil {
+		return Result{}, uc.WrapError("validation failed", err)
+	}
+	ws.SetBranding(branding)
+
+	if errSave := uc.workspaceRepo.Save(ctx, ws); errSave != nil {
+		return Result{}, uc.WrapError("save workspace", errSave)
+	}
+
+	return Result{
+		Result: appcore.Result[*workspace.Workspace]{
+			Value: ws,
+		},
+	}, nil
+}
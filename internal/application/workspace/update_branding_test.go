@@ -0,0 +1,66 @@
+package workspace_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/lllypuk/flowra/internal/application/workspace"
+	"github.com/lllypuk/flowra/internal/domain/errs"
+	"github.com/lllypuk/flowra/internal/domain/uuid"
+	domainworkspace "github.com/lllypuk/flowra/internal/domain/workspace"
+)
+
+func TestUpdateBrandingUseCase_Execute_PartialUpdates(t *testing.T) {
+	repo := newMockWorkspaceRepository()
+	useCase := workspace.NewUpdateBrandingUseCase(repo)
+
+	existingWs, _ := domainworkspace.NewWorkspace("Workspace", "", "keycloak-group-id", uuid.NewUUID())
+	_ = repo.Save(context.Background(), existingWs)
+
+	color := "#336699"
+	if _, err := useCase.Execute(context.Background(), workspace.UpdateBrandingCommand{
+		WorkspaceID: existingWs.ID(),
+		AccentColor: &color,
+		UpdatedBy:   uuid.NewUUID(),
+	}); err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+
+	fileID := uuid.NewUUID()
+	result, err := useCase.Execute(context.Background(), workspace.UpdateBrandingCommand{
+		WorkspaceID:    existingWs.ID(),
+		AvatarFileID:   &fileID,
+		AvatarFileName: "logo.png",
+		UpdatedBy:      uuid.NewUUID(),
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+
+	branding := result.Value.Branding()
+	if branding.AvatarFileID() != fileID || branding.AvatarFileName() != "logo.png" {
+		t.Errorf("expected avatar to be set, got %q/%q", branding.AvatarFileID(), branding.AvatarFileName())
+	}
+	if branding.AccentColor() != color {
+		t.Errorf("expected accent color to be kept, got %q", branding.AccentColor())
+	}
+}
+
+func TestUpdateBrandingUseCase_Execute_InvalidColor(t *testing.T) {
+	repo := newMockWorkspaceRepository()
+	useCase := workspace.NewUpdateBrandingUseCase(repo)
+
+	existingWs, _ := domainworkspace.NewWorkspace("Workspace", "", "keycloak-group-id", uuid.NewUUID())
+	_ = repo.Save(context.Background(), existingWs)
+
+	color := "purple"
+	_, err := useCase.Execute(context.Background(), workspace.UpdateBrandingCommand{
+		WorkspaceID: existingWs.ID(),
+		AccentColor: &color,
+		UpdatedBy:   uuid.NewUUID(),
+	})
+	if !errors.Is(err, errs.ErrInvalidInput) {
+		t.Errorf("expected ErrInvalidInput, got: %v", err)
+	}
+}
@@ -0,0 +1,57 @@
+package workspace
+
+import (
+	"context"
+
+	"github.com/lllypuk/flowra/internal/application/appcore"
+	"github.com/lllypuk/flowra/internal/domain/workspace"
+)
+
+// UpdateDiscoverabilityUseCase - use case for listing the workspace in the discovery directory
+type UpdateDiscoverabilityUseCase struct {
+	appcore.BaseUseCase
+
+	workspaceRepo Repository
+}
+
+// NewUpdateDiscoverabilityUseCase creates New UpdateDiscoverabilityUseCase
+func NewUpdateDiscoverabilityUseCase(workspaceRepo Repository) *UpdateDiscoverabilityUseCase {
+	return &UpdateDiscoverabilityUseCase{
+		workspaceRepo: workspaceRepo,
+	}
+}
+
+// Execute lists or hides the workspace in the discovery directory.
+// Join requests that are already pending stay pending when the workspace is hidden.
+func (uc *UpdateDiscoverabilityUseCase) Execute(
+	ctx context.Context,
+	cmd UpdateDiscoverabilityCommand,
+) (Result, error) {
+	if err := uc.ValidateContext(ctx); err != nil {
+		return Result{}, uc.WrapError("validate context", err)
+	}
+
+	if err := appcore.ValidateUUID("workspaceID", cmd.WorkspaceID); err != nil {
+		return Result{}, uc.WrapError("validation failed", err)
+	}
+	if err := appcore.ValidateUUID("updatedBy", cmd.UpdatedBy); err != nil {
+		return Result{}, uc.WrapError("validation failed", err)
+	}
+
+	ws, err := uc.workspaceRepo.FindByID(ctx, cmd.WorkspaceID)
+	if err != nil {
+		return Result{}, uc.WrapError("find workspace", ErrWorkspaceNotFound)
+	}
+
+	ws.SetDiscoverable(cmd.Discoverable)
+
+	if errSave := uc.workspaceRepo.Save(ctx, ws); errSave != nil {
+		return Result{}, uc.WrapError("save workspace", errSave)
+	}
+
+	return Result{
+		Result: appcore.Result[*workspace.Workspace]{
+			Value: ws,
+		},
+	}, nil
+}
@@ -0,0 +1,50 @@
+package workspace_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/lllypuk/flowra/internal/application/workspace"
+	"github.com/lllypuk/flowra/internal/domain/uuid"
+	domainworkspace "github.com/lllypuk/flowra/internal/domain/workspace"
+)
+
+func TestUpdateDiscoverabilityUseCase_Execute_Success(t *testing.T) {
+	repo := newMockWorkspaceRepository()
+	useCase := workspace.NewUpdateDiscoverabilityUseCase(repo)
+
+	existingWs, _ := domainworkspace.NewWorkspace("Workspace", "", "keycloak-group-id", uuid.NewUUID())
+	_ = repo.Save(context.Background(), existingWs)
+
+	result, err := useCase.Execute(context.Background(), workspace.UpdateDiscoverabilityCommand{
+		WorkspaceID:  existingWs.ID(),
+		Discoverable: true,
+		UpdatedBy:    uuid.NewUUID(),
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if !result.Value.IsDiscoverable() {
+		t.Error("expected workspace to be discoverable")
+	}
+
+	saved, _ := repo.FindByID(context.Background(), existingWs.ID())
+	if !saved.IsDiscoverable() {
+		t.Error("expected discoverability to be saved")
+	}
+}
+
+func TestUpdateDiscoverabilityUseCase_Execute_WorkspaceNotFound(t *testing.T) {
+	repo := newMockWorkspaceRepository()
+	useCase := workspace.NewUpdateDiscoverabilityUseCase(repo)
+
+	_, err := useCase.Execute(context.Background(), workspace.UpdateDiscoverabilityCommand{
+		WorkspaceID:  uuid.NewUUID(),
+		Discoverable: true,
+		UpdatedBy:    uuid.NewUUID(),
+	})
+	if !errors.Is(err, workspace.ErrWorkspaceNotFound) {
+		t.Errorf("expected ErrWorkspaceNotFound, got: %v", err)
+	}
+}
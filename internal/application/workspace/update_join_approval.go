@@ -0,0 +1,57 @@
+package workspace
+
+import (
+	"context"
+
+	"github.com/lllypuk/flowra/internal/application/appcore"
+	"github.com/lllypuk/flowra/internal/domain/workspace"
+)
+
+// UpdateJoinApprovalUseCase - use case for the restricted workspace setting
+type UpdateJoinApprovalUseCase struct {
+	appcore.BaseUseCase
+
+	workspaceRepo Repository
+}
+
+// NewUpdateJoinApprovalUseCase creates New UpdateJoinApprovalUseCase
+func NewUpdateJoinApprovalUseCase(workspaceRepo Repository) *UpdateJoinApprovalUseCase {
+	return &UpdateJoinApprovalUseCase{
+		workspaceRepo: workspaceRepo,
+	}
+}
+
+// Execute sets whether new members need admin approval.
+// Memberships that are already pending stay pending when the setting is turned off.
+func (uc *UpdateJoinApprovalUseCase) Execute(
+	ctx context.Context,
+	cmd UpdateJoinApprovalCommand,
+) (Result, error) {
+	if err := uc.ValidateContext(ctx); err != nil {
+		return Result{}, uc.WrapError("validate context", err)
+	}
+
+	if err := appcore.ValidateUUID("workspaceID", cmd.WorkspaceID); err != nil {
+		return Result{}, uc.WrapError("validation failed", err)
+	}
+	if err := appcore.ValidateUUID("updatedBy", cmd.UpdatedBy); err != nil {
+		return Result{}, uc.WrapError("validation failed", err)
+	}
+
+	ws, err := uc.workspaceRepo.FindByID(ctx, cmd.WorkspaceID)
+	if err != nil {
+		return Result{}, uc.WrapError("find workspace", ErrWorkspaceNotFound)
+	}
+
+	ws.SetRequireJoinApproval(cmd.RequireApproval)
+
+	if errSave := uc.workspaceRepo.Save(ctx, ws); errSave != nil {
+		return Result{}, uc.WrapError("save workspace", errSave)
+	}
+
+	return Result{
+		Result: appcore.Result[*workspace.Workspace]{
+			Value: ws,
+		},
+	}, nil
+}
@@ -0,0 +1,50 @@
+package workspace_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/lllypuk/flowra/internal/application/workspace"
+	"github.com/lllypuk/flowra/internal/domain/uuid"
+	domainworkspace "github.com/lllypuk/flowra/internal/domain/workspace"
+)
+
+func TestUpdateJoinApprovalUseCase_Execute_Success(t *testing.T) {
+	repo := newMockWorkspaceRepository()
+	useCase := workspace.NewUpdateJoinApprovalUseCase(repo)
+
+	existingWs, _ := domainworkspace.NewWorkspace("Workspace", "", "keycloak-group-id", uuid.NewUUID())
+	_ = repo.Save(context.Background(), existingWs)
+
+	result, err := useCase.Execute(context.Background(), workspace.UpdateJoinApprovalCommand{
+		WorkspaceID:     existingWs.ID(),
+		RequireApproval: true,
+		UpdatedBy:       uuid.NewUUID(),
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if !result.Value.RequiresJoinApproval() {
+		t.Error("expected workspace to require join approval")
+	}
+
+	saved, _ := repo.FindByID(context.Background(), existingWs.ID())
+	if !saved.RequiresJoinApproval() {
+		t.Error("expected join approval setting to be saved")
+	}
+}
+
+func TestUpdateJoinApprovalUseCase_Execute_WorkspaceNotFound(t *testing.T) {
+	repo := newMockWorkspaceRepository()
+	useCase := workspace.NewUpdateJoinApprovalUseCase(repo)
+
+	_, err := useCase.Execute(context.Background(), workspace.UpdateJoinApprovalCommand{
+		WorkspaceID:     uuid.NewUUID(),
+		RequireApproval: true,
+		UpdatedBy:       uuid.NewUUID(),
+	})
+	if !errors.Is(err, workspace.ErrWorkspaceNotFound) {
+		t.Errorf("expected ErrWorkspaceNotFound, got: %v", err)
+	}
+}
@@ -0,0 +1,62 @@
+package workspace
+
+import (
+	"context"
+
+	"github.com/lllypuk/flowra/internal/application/appcore"
+	"github.com/lllypuk/flowra/internal/domain/workspace"
+)
+
+// UpdateSLAPolicyUseCase - use case for configuring the SLA targets of bugs
+type UpdateSLAPolicyUseCase struct {
+	appcore.BaseUseCase
+
+	workspaceRepo Repository
+}
+
+// NewUpdateSLAPolicyUseCase creates New UpdateSLAPolicyUseCase
+func NewUpdateSLAPolicyUseCase(workspaceRepo Repository) *UpdateSLAPolicyUseCase {
+	return &UpdateSLAPolicyUseCase{
+		workspaceRepo: workspaceRepo,
+	}
+}
+
+// Execute replaces the SLA policy of the workspace.
+// Deadlines are derived from the policy when read, so open bugs follow the new targets.
+func (uc *UpdateSLAPolicyUseCase) Execute(
+	ctx context.Context,
+	cmd UpdateSLAPolicyCommand,
+) (Result, error) {
+	if err := uc.ValidateContext(ctx); err != nil {
+		return Result{}, uc.WrapError("validate context", err)
+	}
+
+	if err := appcore.ValidateUUID("workspaceID", cmd.WorkspaceID); err != nil {
+		return Result{}, uc.WrapError("validation failed", err)
+	}
+	if err := appcore.ValidateUUID("updatedBy", cmd.UpdatedBy); err != nil {
+		return Result{}, uc.WrapError("validation failed", err)
+	}
+
+	policy, err := workspace.NewSLAPolicy(cmd.Targets)
+	if err != nil {
+		return Result{}, uc.WrapError("validation failed", err)
+	}
+
+	ws, err := uc.workspaceRepo.FindByID(ctx, cmd.WorkspaceID)
+	if err != nil {
+		return Result{}, uc.WrapError("find workspace", ErrWorkspaceNotFound)
+	}
+
+	ws.SetSLAPolicy(policy)
+
+	if errSave := uc.workspaceRepo.Save(ctx, ws); errSave != nil {
+		return Result{}, uc.WrapError("save workspace", errSave)
+	}
+
+	return Result{
+		Result: appcore.Result[*workspace.Workspace]{
+			Value: ws,
+		},
+	}, nil
+}
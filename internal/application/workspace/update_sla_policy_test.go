@@ -0,0 +1,72 @@
+package workspace_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/lllypuk/flowra/internal/application/workspace"
+	"github.com/lllypuk/flowra/internal/domain/errs"
+	"github.com/lllypuk/flowra/internal/domain/uuid"
+	domainworkspace "github.com/lllypuk/flowra/internal/domain/workspace"
+)
+
+func TestUpdateSLAPolicyUseCase_Execute_Success(t *testing.T) {
+	repo := newMockWorkspaceRepository()
+	useCase := workspace.NewUpdateSLAPolicyUseCase(repo)
+
+	existingWs, _ := domainworkspace.NewWorkspace("Workspace", "", "keycloak-group-id", uuid.NewUUID())
+	_ = repo.Save(context.Background(), existingWs)
+
+	result, err := useCase.Execute(context.Background(), workspace.UpdateSLAPolicyCommand{
+		WorkspaceID: existingWs.ID(),
+		Targets: map[string]domainworkspace.SLATarget{
+			"Critical": {Response: time.Hour, Resolution: 8 * time.Hour},
+		},
+		UpdatedBy: uuid.NewUUID(),
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if _, ok := result.Value.SLAPolicy().Target("Critical"); !ok {
+		t.Error("expected Critical target to be set")
+	}
+
+	saved, _ := repo.FindByID(context.Background(), existingWs.ID())
+	if saved.SLAPolicy().IsZero() {
+		t.Error("expected SLA policy to be saved")
+	}
+}
+
+func TestUpdateSLAPolicyUseCase_Execute_InvalidTargets(t *testing.T) {
+	repo := newMockWorkspaceRepository()
+	useCase := workspace.NewUpdateSLAPolicyUseCase(repo)
+
+	existingWs, _ := domainworkspace.NewWorkspace("Workspace", "", "keycloak-group-id", uuid.NewUUID())
+	_ = repo.Save(context.Background(), existingWs)
+
+	_, err := useCase.Execute(context.Background(), workspace.UpdateSLAPolicyCommand{
+		WorkspaceID: existingWs.ID(),
+		Targets: map[string]domainworkspace.SLATarget{
+			"Critical": {Response: 8 * time.Hour, Resolution: time.Hour},
+		},
+		UpdatedBy: uuid.NewUUID(),
+	})
+	if !errors.Is(err, errs.ErrInvalidInput) {
+		t.Errorf("expected ErrInvalidInput, got: %v", err)
+	}
+}
+
+func TestUpdateSLAPolicyUseCase_Execute_WorkspaceNotFound(t *testing.T) {
+	repo := newMockWorkspaceRepository()
+	useCase := workspace.NewUpdateSLAPolicyUseCase(repo)
+
+	_, err := useCase.Execute(context.Background(), workspace.UpdateSLAPolicyCommand{
+		WorkspaceID: uuid.NewUUID(),
+		UpdatedBy:   uuid.NewUUID(),
+	})
+	if !errors.Is(err, workspace.ErrWorkspaceNotFound) {
+		t.Errorf("expected ErrWorkspaceNotFound, got: %v", err)
+	}
+}
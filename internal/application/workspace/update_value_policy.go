@@ -0,0 +1,62 @@
+package workspace
+
+import (
+	"context"
+
+	"github.com/lllypuk/flowra/internal/application/appcore"
+	"github.com/lllypuk/flowra/internal/domain/workspace"
+)
+
+// UpdateValuePolicyUseCase - use case for configuring allowed priorities/severities
+type UpdateValuePolicyUseCase struct {
+	appcore.BaseUseCase
+
+	workspaceRepo Repository
+}
+
+// NewUpdateValuePolicyUseCase creates New UpdateValuePolicyUseCase
+func NewUpdateValuePolicyUseCase(workspaceRepo Repository) *UpdateValuePolicyUseCase {
+	return &UpdateValuePolicyUseCase{
+		workspaceRepo: workspaceRepo,
+	}
+}
+
+// Execute replaces the value policy of the workspace.
+// The policy is enforced by the chat SetPriority/SetSeverity use cases.
+func (uc *UpdateValuePolicyUseCase) Execute(
+	ctx context.Context,
+	cmd UpdateValuePolicyCommand,
+) (Result, error) {
+	if err := uc.ValidateContext(ctx); err != nil {
+		return Result{}, uc.WrapError("validate context", err)
+	}
+
+	if err := appcore.ValidateUUID("workspaceID", cmd.WorkspaceID); err != nil {
+		return Result{}, uc.WrapError("validation failed", err)
+	}
+	if err := appcore.ValidateUUID("updatedBy", cmd.UpdatedBy); err != nil {
+		return Result{}, uc.WrapError("validation failed", err)
+	}
+
+	policy, err := workspace.NewValuePolicy(cmd.Priorities, cmd.Severities)
+	if err != nil {
+		return Result{}, uc.WrapError("validation failed", err)
+	}
+
+	ws, err := uc.workspaceRepo.FindByID(ctx, cmd.WorkspaceID)
+	if err != nil {
+		return Result{}, uc.WrapError("find workspace", ErrWorkspaceNotFound)
+	}
+
+	ws.SetValuePolicy(policy)
+
+	if errSave := uc.workspaceRepo.Save(ctx, ws); errSave != nil {
+		return Result{}, uc.WrapError("save workspace", errSave)
+	}
+
+	return Result{
+		Result: appcore.Result[*workspace.Workspace]{
+			Value: ws,
+		},
+	}, nil
+}
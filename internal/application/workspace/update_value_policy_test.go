@@ -0,0 +1,86 @@
+package workspace_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/lllypuk/flowra/internal/application/workspace"
+	"github.com/lllypuk/flowra/internal/domain/chat"
+	"github.com/lllypuk/flowra/internal/domain/errs"
+	"github.com/lllypuk/flowra/internal/domain/uuid"
+	domainworkspace "github.com/lllypuk/flowra/internal/domain/workspace"
+)
+
+func TestUpdateValuePolicyUseCase_Execute_Success(t *testing.T) {
+	repo := newMockWorkspaceRepository()
+	useCase := workspace.NewUpdateValuePolicyUseCase(repo)
+
+	existingWs, _ := domainworkspace.NewWorkspace("Workspace", "", "keycloak-group-id", uuid.NewUUID())
+	_ = repo.Save(context.Background(), existingWs)
+
+	cmd := workspace.UpdateValuePolicyCommand{
+		WorkspaceID: existingWs.ID(),
+		Priorities:  map[chat.Type][]string{chat.TypeTask: {"High", "Low"}},
+		Severities:  map[chat.Type][]string{chat.TypeBug: {"Blocker"}},
+		UpdatedBy:   uuid.NewUUID(),
+	}
+
+	result, err := useCase.Execute(context.Background(), cmd)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+
+	policy := result.Value.ValuePolicy()
+	if policy.IsPriorityAllowed(chat.TypeTask, "Medium") {
+		t.Error("expected Medium to be disallowed for tasks")
+	}
+	if !policy.IsPriorityAllowed(chat.TypeTask, "High") {
+		t.Error("expected High to be allowed for tasks")
+	}
+	if !policy.IsPriorityAllowed(chat.TypeBug, "Medium") {
+		t.Error("expected bug priorities to stay unrestricted")
+	}
+	if policy.IsSeverityAllowed(chat.TypeBug, "Minor") {
+		t.Error("expected Minor to be disallowed for bugs")
+	}
+
+	saved, _ := repo.FindByID(context.Background(), existingWs.ID())
+	if saved.ValuePolicy().IsZero() {
+		t.Error("expected policy to be saved")
+	}
+}
+
+func TestUpdateValuePolicyUseCase_Execute_InvalidPolicy(t *testing.T) {
+	repo := newMockWorkspaceRepository()
+	useCase := workspace.NewUpdateValuePolicyUseCase(repo)
+
+	existingWs, _ := domainworkspace.NewWorkspace("Workspace", "", "keycloak-group-id", uuid.NewUUID())
+	_ = repo.Save(context.Background(), existingWs)
+
+	cmd := workspace.UpdateValuePolicyCommand{
+		WorkspaceID: existingWs.ID(),
+		Severities:  map[chat.Type][]string{chat.TypeTask: {"Major"}},
+		UpdatedBy:   uuid.NewUUID(),
+	}
+
+	_, err := useCase.Execute(context.Background(), cmd)
+	if !errors.Is(err, errs.ErrInvalidInput) {
+		t.Errorf("expected ErrInvalidInput, got: %v", err)
+	}
+}
+
+func TestUpdateValuePolicyUseCase_Execute_WorkspaceNotFound(t *testing.T) {
+	repo := newMockWorkspaceRepository()
+	useCase := workspace.NewUpdateValuePolicyUseCase(repo)
+
+	cmd := workspace.UpdateValuePolicyCommand{
+		WorkspaceID: uuid.NewUUID(),
+		UpdatedBy:   uuid.NewUUID(),
+	}
+
+	_, err := useCase.Execute(context.Background(), cmd)
+	if !errors.Is(err, workspace.ErrWorkspaceNotFound) {
+		t.Errorf("expected ErrWorkspaceNotFound, got: %v", err)
+	}
+}
@@ -0,0 +1,92 @@
+// Package buildinfo exposes version metadata injected at build time.
+//
+// Values are set with -ldflags, for example:
+//
+//	go build -ldflags "-X github.com/lllypuk/flowra/internal/buildinfo.Version=v1.2.3 \
+//	  -X github.com/lllypuk/flowra/internal/buildinfo.Commit=$(git rev-parse HEAD) \
+//	  -X github.com/lllypuk/flowra/internal/buildinfo.BuildDate=$(date -u +%Y-%m-%dT%H:%M:%SZ)"
+//
+// When they are not set, Get falls back to the VCS data the Go toolchain embeds.
+package buildinfo
+
+import (
+	"log/slog"
+	"runtime"
+	"runtime/debug"
+)
+
+// Placeholder values used when nothing was injected at build time.
+const (
+	DefaultVersion = "dev"
+	unknown        = "unknown"
+	shortCommitLen = 7
+)
+
+// Build metadata populated via -ldflags -X.
+//
+//nolint:gochecknoglobals // Must be package variables to be settable by the linker.
+var (
+	Version   = DefaultVersion
+	Commit    = unknown
+	BuildDate = unknown
+)
+
+// Info describes the running binary.
+type Info struct {
+	Version   string `json:"version"`
+	Commit    string `json:"commit"`
+	BuildDate string `json:"build_date"`
+	GoVersion string `json:"go_version"`
+}
+
+// Get returns the build metadata of the running binary.
+func Get() Info {
+	info := Info{
+		Version:   Version,
+		Commit:    Commit,
+		BuildDate: BuildDate,
+		GoVersion: runtime.Version(),
+	}
+
+	if info.Commit != unknown && info.BuildDate != unknown {
+		return info
+	}
+
+	bi, ok := debug.ReadBuildInfo()
+	if !ok {
+		return info
+	}
+
+	for _, setting := range bi.Settings {
+		switch setting.Key {
+		case "vcs.revision":
+			if info.Commit == unknown {
+				info.Commit = setting.Value
+			}
+		case "vcs.time":
+			if info.BuildDate == unknown {
+				info.BuildDate = setting.Value
+			}
+		}
+	}
+
+	return info
+}
+
+// ShortCommit returns the abbreviated commit hash.
+func (i Info) ShortCommit() string {
+	if len(i.Commit) > shortCommitLen {
+		return i.Commit[:shortCommitLen]
+	}
+	return i.Commit
+}
+
+// LogValue implements slog.LogValuer so build info can be logged as a group.
+func (i Info) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.String("version", i.Version),
+		slog.String("commit", i.Commit),
+		slog.String("build_date", i.BuildDate),
+		slog.String("go_version", i.GoVersion),
+	)
+}
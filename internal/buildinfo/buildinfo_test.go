@@ -0,0 +1,34 @@
+package buildinfo_test
+
+import (
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lllypuk/flowra/internal/buildinfo"
+)
+
+func TestGet_UsesInjectedValues(t *testing.T) {
+	origVersion, origCommit, origDate := buildinfo.Version, buildinfo.Commit, buildinfo.BuildDate
+	t.Cleanup(func() {
+		buildinfo.Version, buildinfo.Commit, buildinfo.BuildDate = origVersion, origCommit, origDate
+	})
+
+	buildinfo.Version = "v1.2.3"
+	buildinfo.Commit = "0123456789abcdef"
+	buildinfo.BuildDate = "2026-01-02T03:04:05Z"
+
+	info := buildinfo.Get()
+
+	assert.Equal(t, "v1.2.3", info.Version)
+	assert.Equal(t, "0123456789abcdef", info.Commit)
+	assert.Equal(t, "2026-01-02T03:04:05Z", info.BuildDate)
+	assert.Equal(t, runtime.Version(), info.GoVersion)
+	assert.Equal(t, "0123456", info.ShortCommit())
+}
+
+func TestInfo_ShortCommit(t *testing.T) {
+	assert.Equal(t, "abc", buildinfo.Info{Commit: "abc"}.ShortCommit())
+	assert.Empty(t, buildinfo.Info{}.ShortCommit())
+}
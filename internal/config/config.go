@@ -4,8 +4,12 @@ package config
 import (
 	"errors"
 	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
 	"os"
 	"reflect"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
@@ -21,9 +25,18 @@ const (
 	DefaultWriteTimeout    = 30 * time.Second
 	DefaultShutdownTimeout = 10 * time.Second
 
+	DefaultRequestTimeoutRead  = 10 * time.Second
+	DefaultRequestTimeoutWrite = 20 * time.Second
+	DefaultRequestTimeoutLong  = 5 * time.Minute
+
+	DefaultTLSAutocertCacheDir = "autocert-cache"
+
 	DefaultMongoDBTimeout     = 10 * time.Second
 	DefaultMongoDBMaxPoolSize = 100
 
+	// DefaultMongoDBSlowQueryThreshold logs queries slower than this; 0 disables it.
+	DefaultMongoDBSlowQueryThreshold = 200 * time.Millisecond
+
 	DefaultRedisPoolSize = 10
 
 	DefaultAccessTokenTTL  = 15 * time.Minute
@@ -36,14 +49,92 @@ const (
 	DefaultJWTLeeway          = 30 * time.Second
 	DefaultJWTRefreshInterval = 1 * time.Hour
 
-	DefaultOutboxPollInterval    = 100 * time.Millisecond
-	DefaultOutboxBatchSize       = 100
-	DefaultOutboxMaxRetries      = 5
-	DefaultOutboxCleanupAge      = 7 * 24 * time.Hour // 7 days
-	DefaultOutboxCleanupInterval = 1 * time.Hour
+	DefaultLDAPUserFilter = "(objectClass=inetOrgPerson)"
+	DefaultLDAPPageSize   = 500
+	DefaultLDAPTimeout    = 30 * time.Second
+
+	DefaultReadOnlyReadPreference = "secondaryPreferred"
+
+	MaxEventStorePartitions = 256
+
+	DefaultOutboxPollInterval = 100 * time.Millisecond
+	DefaultOutboxBatchSize    = 100
+	DefaultOutboxMaxRetries   = 5
+	DefaultOutboxCleanupAge   = 7 * 24 * time.Hour // 7 days
+
+	DefaultMessageDeletedRetention = 7 * 24 * time.Hour // 7 days
+	DefaultMessageUndoWindow       = 10 * time.Second
+
+	DefaultRetentionInterval            = 1 * time.Hour
+	DefaultRetentionBatchSize           = 500
+	DefaultRetentionBatchPause          = 200 * time.Millisecond
+	DefaultRetentionReadNotificationAge = 30 * 24 * time.Hour // 30 days
+	DefaultRetentionCompletedRepairAge  = 7 * 24 * time.Hour  // 7 days
+
+	DefaultUploadDir                 = "uploads"
+	DefaultUploadMaxFileSize         = 10 << 20  // 10 MB
+	DefaultUploadTaskAttachmentQuota = 100 << 20 // 100 MB
+	DefaultUploadWorkspaceQuota      = 10 << 30  // 10 GB
+	DefaultUploadQuotaWarnPercent    = 90
+	maxPercent                       = 100
+	DefaultUploadPreviewSize         = 320
+	DefaultUploadPreviewInterval     = 30 * time.Second
+	DefaultUploadPDFRenderer         = "pdftoppm"
+	MaxUploadPreviewSize             = 2048
+
+	DefaultStorageRegion        = "us-east-1"
+	DefaultStorageMaxFileSize   = 25 << 20 // 25 MB
+	DefaultStoragePresignExpiry = 15 * time.Minute
+	MaxStoragePresignExpiry     = 7 * 24 * time.Hour // longest validity of a SigV4 presigned URL
+
+	DefaultBackupDir           = "backups"
+	DefaultBackupMaxUploadSize = 1 << 30 // 1 GB
+
+	DefaultChatExportDir       = "exports"
+	DefaultChatExportSyncLimit = 1000
 
-	DefaultUploadDir         = "uploads"
-	DefaultUploadMaxFileSize = 10 << 20 // 10 MB
+	DefaultInboundEmailMaxSize = 25 << 20 // 25 MB
+	DefaultEmailSMTPPort       = 587
+
+	DefaultReadinessMaxOutboxBacklog = 1000
+	DefaultReadinessMaxProjectionLag = 30 * time.Second
+
+	DefaultCORSAllowedOrigins = "*"
+	DefaultCORSMaxAge         = 24 * time.Hour
+
+	DefaultFragmentCacheTTL        = 5 * time.Minute
+	DefaultFragmentCacheMaxEntries = 10000
+
+	DefaultAnalyticsSink        = AnalyticsSinkStdout
+	DefaultAnalyticsPostHogHost = "https://us.i.posthog.com"
+	DefaultAnalyticsTimeout     = 5 * time.Second
+
+	DefaultRateLimitWorkspaceAPICalls = 1200
+	DefaultRateLimitWorkspaceMessages = 300
+
+	DefaultResilienceMaxWait               = 100 * time.Millisecond
+	DefaultResilienceKeycloakMaxConcurrent = 20
+	DefaultResilienceKeycloakTimeout       = 10 * time.Second
+	DefaultResilienceRedisMaxConcurrent    = 100
+	DefaultResilienceRedisTimeout          = 2 * time.Second
+	DefaultResilienceBlobMaxConcurrent     = 16
+	DefaultResilienceBlobTimeout           = 60 * time.Second
+
+	DefaultErrorReportingTimeout = 5 * time.Second
+)
+
+// MongoDB index verification modes (mongodb.index_verification).
+const (
+	IndexVerificationOff    = "off"
+	IndexVerificationReport = "report"
+	IndexVerificationRepair = "repair"
+)
+
+// Analytics sink names.
+const (
+	AnalyticsSinkStdout  = "stdout"
+	AnalyticsSinkSegment = "segment"
+	AnalyticsSinkPostHog = "posthog"
 )
 
 // AppMode defines the application wiring mode.
@@ -62,17 +153,37 @@ const (
 
 // Config holds the complete application configuration.
 type Config struct {
-	App       AppConfig       `yaml:"app"`
-	Server    ServerConfig    `yaml:"server"`
-	MongoDB   MongoDBConfig   `yaml:"mongodb"`
-	Redis     RedisConfig     `yaml:"redis"`
-	Keycloak  KeycloakConfig  `yaml:"keycloak"`
-	Auth      AuthConfig      `yaml:"auth"`
-	EventBus  EventBusConfig  `yaml:"eventbus"`
-	Log       LogConfig       `yaml:"log"`
-	WebSocket WebSocketConfig `yaml:"websocket"`
-	Outbox    OutboxConfig    `yaml:"outbox"`
-	Uploads   UploadConfig    `yaml:"uploads"`
+	App         AppConfig         `yaml:"app"`
+	Server      ServerConfig      `yaml:"server"`
+	MongoDB     MongoDBConfig     `yaml:"mongodb"`
+	EventStore  EventStoreConfig  `yaml:"event_store"`
+	Redis       RedisConfig       `yaml:"redis"`
+	Keycloak    KeycloakConfig    `yaml:"keycloak"`
+	LDAP        LDAPConfig        `yaml:"ldap"`
+	Auth        AuthConfig        `yaml:"auth"`
+	EventBus    EventBusConfig    `yaml:"eventbus"`
+	Log         LogConfig         `yaml:"log"`
+	WebSocket   WebSocketConfig   `yaml:"websocket"`
+	Outbox      OutboxConfig      `yaml:"outbox"`
+	Messages    MessagesConfig    `yaml:"messages"`
+	Retention   RetentionConfig   `yaml:"retention"`
+	Uploads     UploadConfig      `yaml:"uploads"`
+	Storage     StorageConfig     `yaml:"storage"`
+	Backup      BackupConfig      `yaml:"backup"`
+	ChatExport  ChatExportConfig  `yaml:"chat_export"`
+	Inbound     InboundConfig     `yaml:"inbound_email"`
+	Email       EmailConfig       `yaml:"email"`
+	Diagnostics DiagnosticsConfig `yaml:"diagnostics"`
+	Readiness   ReadinessConfig   `yaml:"readiness"`
+	CORS        CORSConfig        `yaml:"cors"`
+	Templates   TemplatesConfig   `yaml:"templates"`
+	Analytics   AnalyticsConfig   `yaml:"analytics"`
+	Maintenance MaintenanceConfig `yaml:"maintenance"`
+	ReadOnly    ReadOnlyConfig    `yaml:"read_only"`
+	RateLimit   RateLimitConfig   `yaml:"rate_limit"`
+	Resilience  ResilienceConfig  `yaml:"resilience"`
+
+	ErrorReporting ErrorReportingConfig `yaml:"error_reporting"`
 }
 
 // AppConfig holds application-level configuration.
@@ -104,6 +215,32 @@ type ServerConfig struct {
 	ReadTimeout     time.Duration `yaml:"read_timeout" env:"SERVER_READ_TIMEOUT"`
 	WriteTimeout    time.Duration `yaml:"write_timeout" env:"SERVER_WRITE_TIMEOUT"`
 	ShutdownTimeout time.Duration `yaml:"shutdown_timeout" env:"SERVER_SHUTDOWN_TIMEOUT"`
+
+	// HTTP2 enables HTTP/2: negotiated via ALPN with TLS, prior-knowledge h2c without it.
+	HTTP2 bool `yaml:"http2" env:"SERVER_HTTP2"`
+
+	// TrustedProxies is a comma-separated list of proxy CIDRs or IPs whose X-Forwarded-For and
+	// X-Real-IP headers are honoured when resolving the client IP. Empty trusts no forwarding headers.
+	TrustedProxies string `yaml:"trusted_proxies" env:"SERVER_TRUSTED_PROXIES"`
+
+	TLS TLSConfig `yaml:"tls"`
+
+	RequestTimeouts RequestTimeoutConfig `yaml:"request_timeouts"`
+}
+
+// RequestTimeoutConfig sets the deadlines handlers, repositories and outbound
+// clients get to serve a request. A request that runs out of time gets 504.
+// Zero disables a deadline.
+type RequestTimeoutConfig struct {
+	// Read bounds GET, HEAD and OPTIONS requests.
+	Read time.Duration `yaml:"read" env:"SERVER_REQUEST_TIMEOUT_READ"`
+
+	// Write bounds all other requests.
+	Write time.Duration `yaml:"write" env:"SERVER_REQUEST_TIMEOUT_WRITE"`
+
+	// Long bounds exports, uploads, downloads, imports and reports. These routes may
+	// outlast read_timeout and write_timeout.
+	Long time.Duration `yaml:"long" env:"SERVER_REQUEST_TIMEOUT_LONG"`
 }
 
 // Address returns the full server address (host:port).
@@ -111,6 +248,72 @@ func (c ServerConfig) Address() string {
 	return fmt.Sprintf("%s:%d", c.Host, c.Port)
 }
 
+// TrustedProxyNets returns the parsed trusted proxy networks. Bare IPs are
+// treated as single-host networks and invalid entries are skipped.
+func (c ServerConfig) TrustedProxyNets() []*net.IPNet {
+	var nets []*net.IPNet
+	for entry := range strings.SplitSeq(c.TrustedProxies, ",") {
+		if network, err := parseProxyNet(entry); err == nil && network != nil {
+			nets = append(nets, network)
+		}
+	}
+	return nets
+}
+
+// parseProxyNet parses a CIDR or bare IP. Blank entries return a nil network.
+func parseProxyNet(entry string) (*net.IPNet, error) {
+	entry = strings.TrimSpace(entry)
+	if entry == "" {
+		return nil, nil //nolint:nilnil // blank entries are skipped by callers
+	}
+	if strings.Contains(entry, "/") {
+		_, network, err := net.ParseCIDR(entry)
+		return network, err
+	}
+
+	ip := net.ParseIP(entry)
+	if ip == nil {
+		return nil, fmt.Errorf("%w: %q", ErrInvalidTrustedProxy, entry)
+	}
+	bits := 8 * net.IPv6len
+	if ip4 := ip.To4(); ip4 != nil {
+		ip, bits = ip4, 8*net.IPv4len
+	}
+	return &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)}, nil
+}
+
+// TLSConfig holds settings for serving HTTPS directly, for deployments without a terminating proxy.
+//
+//nolint:golines // Struct tags require longer lines for readability
+type TLSConfig struct {
+	Enabled bool `yaml:"enabled" env:"SERVER_TLS_ENABLED"`
+
+	// CertFile and KeyFile point to a PEM certificate chain and private key.
+	CertFile string `yaml:"cert_file" env:"SERVER_TLS_CERT_FILE"`
+	KeyFile  string `yaml:"key_file" env:"SERVER_TLS_KEY_FILE"`
+
+	// AutocertHosts is a comma-separated list of hostnames to obtain ACME certificates for.
+	// Mutually exclusive with CertFile/KeyFile.
+	AutocertHosts    string `yaml:"autocert_hosts" env:"SERVER_TLS_AUTOCERT_HOSTS"`
+	AutocertCacheDir string `yaml:"autocert_cache_dir" env:"SERVER_TLS_AUTOCERT_CACHE_DIR"`
+	AutocertEmail    string `yaml:"autocert_email" env:"SERVER_TLS_AUTOCERT_EMAIL"`
+
+	// RedirectAddr starts a separate plain HTTP listener (e.g. ":80") that redirects to HTTPS
+	// and answers ACME HTTP-01 challenges. Empty disables it.
+	RedirectAddr string `yaml:"redirect_addr" env:"SERVER_TLS_REDIRECT_ADDR"`
+}
+
+// AutocertHostList returns the parsed autocert hostnames.
+func (c TLSConfig) AutocertHostList() []string {
+	var hosts []string
+	for host := range strings.SplitSeq(c.AutocertHosts, ",") {
+		if host = strings.TrimSpace(host); host != "" {
+			hosts = append(hosts, host)
+		}
+	}
+	return hosts
+}
+
 // MongoDBConfig holds MongoDB connection configuration.
 //
 //nolint:golines // Struct tags require longer lines for readability
@@ -119,6 +322,29 @@ type MongoDBConfig struct {
 	Database    string        `yaml:"database" env:"MONGODB_DATABASE"`
 	Timeout     time.Duration `yaml:"timeout" env:"MONGODB_TIMEOUT"`
 	MaxPoolSize uint64        `yaml:"max_pool_size" env:"MONGODB_MAX_POOL_SIZE"`
+
+	// SlowQueryThreshold logs and counts commands that take longer; 0 disables it.
+	SlowQueryThreshold time.Duration `yaml:"slow_query_threshold" env:"MONGODB_SLOW_QUERY_THRESHOLD"`
+
+	// IndexVerification compares the indexes with their definitions at startup: "off",
+	// "report" (log the drift, the default) or "repair" (also recreate missing and changed
+	// indexes). Orphaned indexes are only dropped through the admin API.
+	IndexVerification string `yaml:"index_verification" env:"MONGODB_INDEX_VERIFICATION"`
+}
+
+// EventStoreConfig holds event store configuration.
+//
+//nolint:golines // Struct tags require longer lines for readability
+type EventStoreConfig struct {
+	// Partitions spreads events over this many collections by workspace. 0 or 1 keeps
+	// the single events collection. Raising it only affects aggregates created later;
+	// lowering it below a partition in use breaks loading those aggregates.
+	Partitions int `yaml:"partitions" env:"EVENT_STORE_PARTITIONS"`
+}
+
+// Partitioned reports whether events are spread over several collections.
+func (c EventStoreConfig) Partitioned() bool {
+	return c.Partitions > 1
 }
 
 // RedisConfig holds Redis connection configuration.
@@ -147,6 +373,48 @@ type KeycloakConfig struct {
 	JWT           JWTConfig `yaml:"jwt"`
 }
 
+// LDAPConfig holds the LDAP directory the worker syncs users from instead of the
+// Keycloak admin API.
+//
+//nolint:golines // Struct tags require longer lines for readability
+type LDAPConfig struct {
+	// Enabled makes the user sync worker read users from LDAP.
+	Enabled bool `yaml:"enabled" env:"LDAP_ENABLED"`
+
+	// URL is the server address, ldap://host:389 or ldaps://host:636.
+	URL      string `yaml:"url" env:"LDAP_URL"`
+	StartTLS bool   `yaml:"start_tls" env:"LDAP_START_TLS"`
+
+	// BindDN and BindPassword authenticate the search; empty binds anonymously.
+	BindDN       string `yaml:"bind_dn" env:"LDAP_BIND_DN"`
+	BindPassword string `yaml:"bind_password" env:"LDAP_BIND_PASSWORD"`
+
+	BaseDN     string `yaml:"base_dn" env:"LDAP_BASE_DN"`
+	UserFilter string `yaml:"user_filter" env:"LDAP_USER_FILTER"`
+
+	// DisabledFilter optionally selects the users synced as deactivated.
+	DisabledFilter string `yaml:"disabled_filter" env:"LDAP_DISABLED_FILTER"`
+
+	PageSize   int                 `yaml:"page_size" env:"LDAP_PAGE_SIZE"`
+	Timeout    time.Duration       `yaml:"timeout" env:"LDAP_TIMEOUT"`
+	Attributes LDAPAttributeConfig `yaml:"attributes"`
+}
+
+// LDAPAttributeConfig maps directory attributes to user fields.
+//
+//nolint:golines // Struct tags require longer lines for readability
+type LDAPAttributeConfig struct {
+	// ID must hold the value identity tokens carry in their sub claim.
+	ID        string `yaml:"id" env:"LDAP_ATTR_ID"`
+	Username  string `yaml:"username" env:"LDAP_ATTR_USERNAME"`
+	Email     string `yaml:"email" env:"LDAP_ATTR_EMAIL"`
+	FirstName string `yaml:"first_name" env:"LDAP_ATTR_FIRST_NAME"`
+	LastName  string `yaml:"last_name" env:"LDAP_ATTR_LAST_NAME"`
+
+	// DisplayName is optional; empty builds display names from first and last name.
+	DisplayName string `yaml:"display_name" env:"LDAP_ATTR_DISPLAY_NAME"`
+}
+
 // JWTConfig holds JWT validation configuration.
 //
 //nolint:golines // Struct tags require longer lines for readability
@@ -188,18 +456,56 @@ type WebSocketConfig struct {
 	WriteBufferSize int           `yaml:"write_buffer_size" env:"WS_WRITE_BUFFER_SIZE"`
 	PingInterval    time.Duration `yaml:"ping_interval" env:"WS_PING_INTERVAL"`
 	PongTimeout     time.Duration `yaml:"pong_timeout" env:"WS_PONG_TIMEOUT"`
+	Compression     bool          `yaml:"compression" env:"WS_COMPRESSION"`
 }
 
 // OutboxConfig holds transactional outbox configuration.
 //
 //nolint:golines // Struct tags require longer lines for readability
 type OutboxConfig struct {
-	Enabled         bool          `yaml:"enabled" env:"OUTBOX_ENABLED"`
-	PollInterval    time.Duration `yaml:"poll_interval" env:"OUTBOX_POLL_INTERVAL"`
-	BatchSize       int           `yaml:"batch_size" env:"OUTBOX_BATCH_SIZE"`
-	MaxRetries      int           `yaml:"max_retries" env:"OUTBOX_MAX_RETRIES"`
-	CleanupAge      time.Duration `yaml:"cleanup_age" env:"OUTBOX_CLEANUP_AGE"`
-	CleanupInterval time.Duration `yaml:"cleanup_interval" env:"OUTBOX_CLEANUP_INTERVAL"`
+	Enabled      bool          `yaml:"enabled" env:"OUTBOX_ENABLED"`
+	PollInterval time.Duration `yaml:"poll_interval" env:"OUTBOX_POLL_INTERVAL"`
+	BatchSize    int           `yaml:"batch_size" env:"OUTBOX_BATCH_SIZE"`
+	MaxRetries   int           `yaml:"max_retries" env:"OUTBOX_MAX_RETRIES"`
+
+	// CleanupAge is how long processed entries are kept before the retention worker deletes them.
+	CleanupAge time.Duration `yaml:"cleanup_age" env:"OUTBOX_CLEANUP_AGE"`
+}
+
+// MessagesConfig holds message lifecycle configuration.
+//
+//nolint:golines // Struct tags require longer lines for readability
+type MessagesConfig struct {
+	// DeletedRetention is how long a deleted message keeps its content, visible to
+	// chat moderators, before the worker purges it and only the tombstone remains.
+	DeletedRetention time.Duration `yaml:"deleted_retention" env:"MESSAGES_DELETED_RETENTION"`
+
+	// UndoWindow is how long the author can undo deleting or editing a message.
+	// Zero disables undo.
+	UndoWindow time.Duration `yaml:"undo_window" env:"MESSAGES_UNDO_WINDOW"`
+}
+
+// RetentionConfig holds configuration of the retention worker, which purges expired
+// messages, old read notifications, completed repair-queue items and processed
+// outbox entries in one pass. Message and outbox ages come from Messages.DeletedRetention
+// and Outbox.CleanupAge.
+//
+//nolint:golines // Struct tags require longer lines for readability
+type RetentionConfig struct {
+	// Interval is the time between retention passes.
+	Interval time.Duration `yaml:"interval" env:"RETENTION_INTERVAL"`
+
+	// BatchSize is the number of documents deleted per batch.
+	BatchSize int `yaml:"batch_size" env:"RETENTION_BATCH_SIZE"`
+
+	// BatchPause is the pause between batches, limiting the load on MongoDB.
+	BatchPause time.Duration `yaml:"batch_pause" env:"RETENTION_BATCH_PAUSE"`
+
+	// ReadNotificationAge is how long read notifications are kept. Zero keeps them forever.
+	ReadNotificationAge time.Duration `yaml:"read_notification_age" env:"RETENTION_READ_NOTIFICATION_AGE"`
+
+	// CompletedRepairAge is how long completed repair tasks are kept. Zero keeps them forever.
+	CompletedRepairAge time.Duration `yaml:"completed_repair_age" env:"RETENTION_COMPLETED_REPAIR_AGE"`
 }
 
 // UploadConfig holds file upload configuration.
@@ -208,6 +514,325 @@ type OutboxConfig struct {
 type UploadConfig struct {
 	Dir         string `yaml:"dir" env:"UPLOADS_DIR"`
 	MaxFileSize int64  `yaml:"max_file_size" env:"UPLOADS_MAX_FILE_SIZE"`
+
+	// TaskAttachmentQuota caps the total size of files attached to one task.
+	// Zero or less disables the quota.
+	TaskAttachmentQuota int64 `yaml:"task_attachment_quota" env:"UPLOADS_TASK_ATTACHMENT_QUOTA"`
+
+	// WorkspaceQuota caps the total size of files uploaded to one workspace.
+	// Zero or less disables the quota.
+	WorkspaceQuota int64 `yaml:"workspace_quota" env:"UPLOADS_WORKSPACE_QUOTA"`
+
+	// QuotaWarnPercent is the share of the workspace quota at which workspace
+	// admins are notified. Zero disables the notification.
+	QuotaWarnPercent int `yaml:"quota_warn_percent" env:"UPLOADS_QUOTA_WARN_PERCENT"`
+
+	// PreviewSize is the largest width or height in pixels of the previews the
+	// worker generates for image and PDF attachments.
+	PreviewSize int `yaml:"preview_size" env:"UPLOADS_PREVIEW_SIZE"`
+
+	// PreviewInterval is the time between runs of the preview worker.
+	PreviewInterval time.Duration `yaml:"preview_interval" env:"UPLOADS_PREVIEW_INTERVAL"`
+
+	// PDFRenderer is the pdftoppm (poppler-utils) binary rendering the first page
+	// of PDF attachments for their preview. Empty disables PDF previews.
+	PDFRenderer string `yaml:"pdf_renderer" env:"UPLOADS_PDF_RENDERER"`
+}
+
+// StorageConfig holds the S3-compatible object storage (AWS S3, MinIO) of chat
+// attachments uploaded to POST /api/v1/chats/:id/attachments.
+//
+//nolint:golines // Struct tags require longer lines for readability
+type StorageConfig struct {
+	// Enabled registers the chat attachment endpoints.
+	Enabled bool `yaml:"enabled" env:"STORAGE_ENABLED"`
+
+	// Endpoint is the base URL of the service, e.g. "https://s3.eu-central-1.amazonaws.com"
+	// or "http://minio:9000".
+	Endpoint string `yaml:"endpoint" env:"STORAGE_ENDPOINT"`
+
+	Region    string `yaml:"region" env:"STORAGE_REGION"`
+	Bucket    string `yaml:"bucket" env:"STORAGE_BUCKET"`
+	AccessKey string `yaml:"access_key" env:"STORAGE_ACCESS_KEY"`
+	SecretKey string `yaml:"secret_key" env:"STORAGE_SECRET_KEY"`
+
+	// PathStyle addresses the bucket as <endpoint>/<bucket>; MinIO needs it by default.
+	PathStyle bool `yaml:"path_style" env:"STORAGE_PATH_STYLE"`
+
+	// MaxFileSize caps the size of a single attachment.
+	MaxFileSize int64 `yaml:"max_file_size" env:"STORAGE_MAX_FILE_SIZE"`
+
+	// PresignExpiry is how long presigned download and upload URLs are valid.
+	PresignExpiry time.Duration `yaml:"presign_expiry" env:"STORAGE_PRESIGN_EXPIRY"`
+}
+
+// BackupConfig holds workspace backup settings. The API stores uploaded archives
+// and the worker writes exports in Dir, so both must see the same directory.
+type BackupConfig struct {
+	Dir string `yaml:"dir" env:"BACKUP_DIR"`
+
+	// MaxUploadSize caps the size of an archive uploaded for restore.
+	MaxUploadSize int64 `yaml:"max_upload_size" env:"BACKUP_MAX_UPLOAD_SIZE"`
+}
+
+// ChatExportConfig holds chat transcript export settings. The worker writes the
+// transcripts of large chats in Dir and the API serves them from there, so both
+// must see the same directory.
+type ChatExportConfig struct {
+	Dir string `yaml:"dir" env:"CHAT_EXPORT_DIR"`
+
+	// SyncLimit is the largest number of messages exported within the request;
+	// larger chats are exported by the worker.
+	SyncLimit int `yaml:"sync_limit" env:"CHAT_EXPORT_SYNC_LIMIT"`
+}
+
+// InboundConfig holds the inbound email gateway settings.
+// The mail provider posts each received email to /api/v1/inbound/email; an email
+// to <workspace-id>@domain (optionally +bug or +task) becomes a new typed chat.
+//
+//nolint:golines // Struct tags require longer lines for readability
+type InboundConfig struct {
+	// Enabled registers the ingestion endpoint.
+	Enabled bool `yaml:"enabled" env:"INBOUND_EMAIL_ENABLED"`
+
+	// Domain is the domain of workspace addresses, e.g. "in.flowra.example".
+	Domain string `yaml:"domain" env:"INBOUND_EMAIL_DOMAIN"`
+
+	// Secret must be sent by the mail provider in the X-Inbound-Secret header.
+	Secret string `yaml:"secret" env:"INBOUND_EMAIL_SECRET"`
+
+	// MaxSize caps the size of a posted email including attachments.
+	MaxSize int64 `yaml:"max_size" env:"INBOUND_EMAIL_MAX_SIZE"`
+}
+
+// EmailConfig holds the outbound notification email settings.
+// With a reply key and the inbound gateway enabled, notification emails about a
+// chat carry a signed reply-to address and replies are appended to the chat.
+//
+//nolint:golines // Struct tags require longer lines for readability
+type EmailConfig struct {
+	// Enabled emails every notification to its recipient.
+	Enabled bool `yaml:"enabled" env:"EMAIL_ENABLED"`
+
+	SMTPHost     string `yaml:"smtp_host" env:"EMAIL_SMTP_HOST"`
+	SMTPPort     int    `yaml:"smtp_port" env:"EMAIL_SMTP_PORT"`
+	SMTPUsername string `yaml:"smtp_username" env:"EMAIL_SMTP_USERNAME"`
+	SMTPPassword string `yaml:"smtp_password" env:"EMAIL_SMTP_PASSWORD"`
+
+	// From is the sender address, e.g. "Flowra <noreply@flowra.example>".
+	From string `yaml:"from" env:"EMAIL_FROM"`
+
+	// BaseURL is the public URL of the web app used for links to chats; empty omits links.
+	BaseURL string `yaml:"base_url" env:"EMAIL_BASE_URL"`
+
+	// ReplyKey signs reply-to addresses; empty disables replying by email.
+	ReplyKey string `yaml:"reply_key" env:"EMAIL_REPLY_KEY"`
+}
+
+// DiagnosticsConfig holds pprof and runtime diagnostics configuration.
+//
+//nolint:golines // Struct tags require longer lines for readability
+type DiagnosticsConfig struct {
+	// Enabled mounts /debug/pprof/* and runtime endpoints.
+	Enabled bool `yaml:"enabled" env:"DIAGNOSTICS_ENABLED"`
+
+	// ListenAddr serves diagnostics on a separate loopback-only listener (e.g. "127.0.0.1:6060").
+	// When empty, the API mounts diagnostics on its main router behind system admin auth.
+	ListenAddr string `yaml:"listen_addr" env:"DIAGNOSTICS_LISTEN_ADDR"`
+}
+
+// MaintenanceConfig holds the maintenance mode settings.
+// System admins can also switch maintenance mode at runtime through the admin API;
+// enabling it here forces it on until the next deploy.
+//
+//nolint:golines // Struct tags require longer lines for readability
+type MaintenanceConfig struct {
+	// Enabled answers every non-admin request with 503 except health checks and sign-in.
+	Enabled bool `yaml:"enabled" env:"MAINTENANCE_ENABLED"`
+
+	// Message is shown on the maintenance page and in API errors. Empty uses a generic message.
+	Message string `yaml:"message" env:"MAINTENANCE_MESSAGE"`
+}
+
+// ReadOnlyConfig holds the read-only deployment mode, used to serve dashboards and
+// reports from another region without risking writes: queries go to a replica and
+// mutating requests are answered with 503.
+//
+//nolint:golines // Struct tags require longer lines for readability
+type ReadOnlyConfig struct {
+	// Enabled rejects mutating requests and skips the event bus, workers and startup writes.
+	Enabled bool `yaml:"enabled" env:"READ_ONLY_ENABLED"`
+
+	// MongoDBURI points queries at a secondary or analytics cluster. Empty uses mongodb.uri.
+	MongoDBURI string `yaml:"mongodb_uri" env:"READ_ONLY_MONGODB_URI"`
+
+	// ReadPreference is the MongoDB read preference: primary, primaryPreferred,
+	// secondary, secondaryPreferred or nearest.
+	ReadPreference string `yaml:"read_preference" env:"READ_ONLY_READ_PREFERENCE"`
+
+	// Message is returned with rejected requests. Empty uses a generic message.
+	Message string `yaml:"message" env:"READ_ONLY_MESSAGE"`
+}
+
+// MongoURI returns the URI queries are served from in read-only mode.
+func (c ReadOnlyConfig) MongoURI(primaryURI string) string {
+	if c.MongoDBURI != "" {
+		return c.MongoDBURI
+	}
+	return primaryURI
+}
+
+// RateLimitConfig holds the per-workspace fair-usage limits.
+// Counters are shared between instances through Redis.
+//
+//nolint:golines // Struct tags require longer lines for readability
+type RateLimitConfig struct {
+	// Enabled turns on workspace-scoped rate limiting.
+	Enabled bool `yaml:"enabled" env:"RATE_LIMIT_ENABLED"`
+
+	// WorkspaceAPICallsPerMinute caps workspace-scoped API requests per workspace. 0 disables the cap.
+	WorkspaceAPICallsPerMinute int `yaml:"workspace_api_calls_per_minute" env:"RATE_LIMIT_WORKSPACE_API_CALLS_PER_MINUTE"`
+
+	// WorkspaceMessagesPerMinute caps messages sent per workspace. 0 disables the cap.
+	WorkspaceMessagesPerMinute int `yaml:"workspace_messages_per_minute" env:"RATE_LIMIT_WORKSPACE_MESSAGES_PER_MINUTE"`
+}
+
+// ResilienceConfig holds per-dependency bulkheads for calls to external services.
+// Each dependency gets its own concurrency limit and call timeout, independent of
+// the request deadline, so that one slow dependency cannot occupy every request handler.
+//
+//nolint:golines // Struct tags require longer lines for readability
+type ResilienceConfig struct {
+	// MaxWait is how long a call waits for a free slot before failing with 503.
+	MaxWait time.Duration `yaml:"max_wait" env:"RESILIENCE_MAX_WAIT"`
+
+	KeycloakMaxConcurrent int           `yaml:"keycloak_max_concurrent" env:"RESILIENCE_KEYCLOAK_MAX_CONCURRENT"`
+	KeycloakTimeout       time.Duration `yaml:"keycloak_timeout" env:"RESILIENCE_KEYCLOAK_TIMEOUT"`
+	RedisMaxConcurrent    int           `yaml:"redis_max_concurrent" env:"RESILIENCE_REDIS_MAX_CONCURRENT"`
+	RedisTimeout          time.Duration `yaml:"redis_timeout" env:"RESILIENCE_REDIS_TIMEOUT"`
+	BlobMaxConcurrent     int           `yaml:"blob_max_concurrent" env:"RESILIENCE_BLOB_MAX_CONCURRENT"`
+	BlobTimeout           time.Duration `yaml:"blob_timeout" env:"RESILIENCE_BLOB_TIMEOUT"`
+}
+
+// ErrorReportingConfig holds the external error tracker settings. Panics recovered in
+// HTTP, WebSocket and event bus handlers are sent to a Sentry-compatible service.
+//
+//nolint:golines // Struct tags require longer lines for readability
+type ErrorReportingConfig struct {
+	// DSN is the project DSN of a Sentry-compatible service. Empty disables reporting.
+	DSN string `yaml:"dsn" env:"ERROR_REPORTING_DSN"`
+
+	// Environment is attached to every report, e.g. production or staging.
+	Environment string `yaml:"environment" env:"ERROR_REPORTING_ENVIRONMENT"`
+
+	// Timeout bounds the delivery of a single report.
+	Timeout time.Duration `yaml:"timeout" env:"ERROR_REPORTING_TIMEOUT"`
+}
+
+// Enabled reports whether a DSN is configured.
+func (c ErrorReportingConfig) Enabled() bool {
+	return strings.TrimSpace(c.DSN) != ""
+}
+
+// ReadinessConfig holds thresholds that gate the readiness probe on event processing lag.
+//
+//nolint:golines // Struct tags require longer lines for readability
+type ReadinessConfig struct {
+	// MaxOutboxBacklog is the pending outbox event count above which the instance is not ready. 0 disables the check.
+	MaxOutboxBacklog int64 `yaml:"max_outbox_backlog" env:"READINESS_MAX_OUTBOX_BACKLOG"`
+
+	// MaxProjectionLag is the maximum age of the oldest unprocessed outbox event. 0 disables the check.
+	MaxProjectionLag time.Duration `yaml:"max_projection_lag" env:"READINESS_MAX_PROJECTION_LAG"`
+
+	// StartupOnly stops gating once the instance has caught up after startup,
+	// so a backlog spike later on does not pull every instance out of rotation at once.
+	StartupOnly bool `yaml:"startup_only" env:"READINESS_STARTUP_ONLY"`
+}
+
+// CORSConfig holds cross-origin resource sharing settings for browser clients on other origins.
+//
+//nolint:golines // Struct tags require longer lines for readability
+type CORSConfig struct {
+	// AllowedOrigins is a comma-separated list of origins allowed to call the API ("*" allows any).
+	// Empty disables cross-origin access entirely.
+	AllowedOrigins string `yaml:"allowed_origins" env:"CORS_ALLOWED_ORIGINS"`
+
+	// AllowCredentials lets browsers send cookies and Authorization headers cross-origin.
+	// Requires explicit origins.
+	AllowCredentials bool `yaml:"allow_credentials" env:"CORS_ALLOW_CREDENTIALS"`
+
+	// MaxAge is how long browsers may cache preflight responses.
+	MaxAge time.Duration `yaml:"max_age" env:"CORS_MAX_AGE"`
+}
+
+// AllowedOriginList returns the parsed allowed origins.
+func (c CORSConfig) AllowedOriginList() []string {
+	var origins []string
+	for origin := range strings.SplitSeq(c.AllowedOrigins, ",") {
+		if origin = strings.TrimSpace(origin); origin != "" {
+			origins = append(origins, origin)
+		}
+	}
+	return origins
+}
+
+// TemplatesConfig holds settings for server-side HTML rendering.
+//
+//nolint:golines // Struct tags require longer lines for readability
+type TemplatesConfig struct {
+	// FragmentCache caches expensive partials (workspace list, member pickers) in memory.
+	// Always off in development so template and data changes show up immediately.
+	FragmentCache bool `yaml:"fragment_cache" env:"TEMPLATES_FRAGMENT_CACHE"`
+
+	// FragmentCacheTTL bounds how long a fragment is served without an invalidating event.
+	FragmentCacheTTL time.Duration `yaml:"fragment_cache_ttl" env:"TEMPLATES_FRAGMENT_CACHE_TTL"`
+
+	// FragmentCacheMaxEntries caps the number of cached fragments per instance.
+	FragmentCacheMaxEntries int `yaml:"fragment_cache_max_entries" env:"TEMPLATES_FRAGMENT_CACHE_MAX_ENTRIES"`
+}
+
+// AnalyticsConfig holds the product analytics pipeline settings.
+// Domain events are mapped to anonymized analytics events and sent to a single sink.
+//
+//nolint:golines // Struct tags require longer lines for readability
+type AnalyticsConfig struct {
+	// Enabled registers the analytics event handler.
+	Enabled bool `yaml:"enabled" env:"ANALYTICS_ENABLED"`
+
+	// Sink selects where events are sent: stdout, segment or posthog.
+	Sink string `yaml:"sink" env:"ANALYTICS_SINK"`
+
+	// HashSalt keys the HMAC used to anonymize workspace and user IDs. Required when enabled.
+	HashSalt string `yaml:"hash_salt" env:"ANALYTICS_HASH_SALT"`
+
+	// Events is a comma-separated allow-list of domain event types. Empty sends every supported event.
+	Events string `yaml:"events" env:"ANALYTICS_EVENTS"`
+
+	// Timeout bounds a single delivery to a remote sink.
+	Timeout time.Duration `yaml:"timeout" env:"ANALYTICS_TIMEOUT"`
+
+	// SegmentWriteKey authenticates against the Segment HTTP tracking API.
+	SegmentWriteKey string `yaml:"segment_write_key" env:"ANALYTICS_SEGMENT_WRITE_KEY"`
+
+	// SegmentEndpoint overrides the Segment API base URL (e.g. for the EU region).
+	SegmentEndpoint string `yaml:"segment_endpoint" env:"ANALYTICS_SEGMENT_ENDPOINT"`
+
+	// PostHogAPIKey is the PostHog project API key.
+	PostHogAPIKey string `yaml:"posthog_api_key" env:"ANALYTICS_POSTHOG_API_KEY"`
+
+	// PostHogHost is the PostHog ingestion host.
+	PostHogHost string `yaml:"posthog_host" env:"ANALYTICS_POSTHOG_HOST"`
+}
+
+// EventList returns the parsed event allow-list.
+func (c AnalyticsConfig) EventList() []string {
+	var events []string
+	for eventType := range strings.SplitSeq(c.Events, ",") {
+		if eventType = strings.TrimSpace(eventType); eventType != "" {
+			events = append(events, eventType)
+		}
+	}
+	return events
 }
 
 // Configuration errors.
@@ -221,6 +846,27 @@ var (
 	ErrInvalidEventBusType = errors.New("invalid event bus type: must be redis or inmemory")
 	ErrInvalidAppMode      = errors.New("invalid app mode: must be real or mock")
 	ErrMockModeInProd      = errors.New("mock mode is not allowed in production")
+	ErrDiagnosticsAddr     = errors.New("diagnostics.listen_addr must be a loopback host:port")
+	ErrInvalidReadiness    = errors.New("readiness thresholds must not be negative")
+	ErrInvalidSlowQuery    = errors.New("mongodb.slow_query_threshold must not be negative")
+	ErrInvalidIndexVerify  = errors.New("mongodb.index_verification must be off, report or repair")
+	ErrInvalidTrustedProxy = errors.New("server.trusted_proxies entries must be CIDRs or IP addresses")
+	ErrInvalidCORS         = errors.New("cors.allow_credentials requires explicit allowed_origins, not \"*\"")
+	ErrInvalidTLS          = errors.New("server.tls requires either cert_file and key_file or autocert_hosts, not both")
+	ErrInvalidReqTimeout   = errors.New("server.request_timeouts must not be negative, and read and write must be shorter than server.write_timeout")
+	ErrInvalidTemplates    = errors.New("templates.fragment_cache_ttl and fragment_cache_max_entries must be positive")
+	ErrInvalidAnalytics    = errors.New("invalid analytics configuration")
+	ErrInvalidRateLimit    = errors.New("rate_limit workspace limits must not be negative")
+	ErrInvalidResilience   = errors.New("resilience limits and timeouts must be positive")
+	ErrInvalidStorage      = errors.New("invalid storage configuration")
+	ErrInvalidInbound      = errors.New("inbound_email requires domain, secret and a positive max_size when enabled")
+	ErrInvalidEmail        = errors.New("email requires smtp_host, a positive smtp_port and a valid from address when enabled")
+	ErrInvalidLDAP         = errors.New("ldap requires url, base_dn, user_filter, id/username/email attributes and a positive page_size and timeout when enabled")
+	ErrInvalidEventStore   = errors.New("event_store.partitions must be between 0 and 256")
+	ErrInvalidBackup       = errors.New("backup requires dir and a positive max_upload_size")
+	ErrInvalidChatExport   = errors.New("chat_export requires dir and a non-negative sync_limit")
+	ErrInvalidErrorReport  = errors.New("error_reporting requires an http(s) DSN with a public key and project ID, and a positive timeout")
+	ErrInvalidReadOnly     = errors.New("read_only.read_preference must be primary, primaryPreferred, secondary, secondaryPreferred or nearest")
 )
 
 // DefaultConfig returns a Config with sensible default values.
@@ -236,12 +882,23 @@ func DefaultConfig() *Config {
 			ReadTimeout:     DefaultReadTimeout,
 			WriteTimeout:    DefaultWriteTimeout,
 			ShutdownTimeout: DefaultShutdownTimeout,
+			HTTP2:           true,
+			TLS: TLSConfig{
+				AutocertCacheDir: DefaultTLSAutocertCacheDir,
+			},
+			RequestTimeouts: RequestTimeoutConfig{
+				Read:  DefaultRequestTimeoutRead,
+				Write: DefaultRequestTimeoutWrite,
+				Long:  DefaultRequestTimeoutLong,
+			},
 		},
 		MongoDB: MongoDBConfig{
-			URI:         "mongodb://localhost:27017",
-			Database:    "flowra",
-			Timeout:     DefaultMongoDBTimeout,
-			MaxPoolSize: DefaultMongoDBMaxPoolSize,
+			URI:                "mongodb://localhost:27017",
+			Database:           "flowra",
+			Timeout:            DefaultMongoDBTimeout,
+			MaxPoolSize:        DefaultMongoDBMaxPoolSize,
+			SlowQueryThreshold: DefaultMongoDBSlowQueryThreshold,
+			IndexVerification:  IndexVerificationReport,
 		},
 		Redis: RedisConfig{
 			Addr:     "localhost:6379",
@@ -279,16 +936,102 @@ func DefaultConfig() *Config {
 			PongTimeout:     DefaultWSPongTimeout,
 		},
 		Outbox: OutboxConfig{
-			Enabled:         true,
-			PollInterval:    DefaultOutboxPollInterval,
-			BatchSize:       DefaultOutboxBatchSize,
-			MaxRetries:      DefaultOutboxMaxRetries,
-			CleanupAge:      DefaultOutboxCleanupAge,
-			CleanupInterval: DefaultOutboxCleanupInterval,
+			Enabled:      true,
+			PollInterval: DefaultOutboxPollInterval,
+			BatchSize:    DefaultOutboxBatchSize,
+			MaxRetries:   DefaultOutboxMaxRetries,
+			CleanupAge:   DefaultOutboxCleanupAge,
+		},
+		Messages: MessagesConfig{
+			DeletedRetention: DefaultMessageDeletedRetention,
+			UndoWindow:       DefaultMessageUndoWindow,
+		},
+		Retention: RetentionConfig{
+			Interval:            DefaultRetentionInterval,
+			BatchSize:           DefaultRetentionBatchSize,
+			BatchPause:          DefaultRetentionBatchPause,
+			ReadNotificationAge: DefaultRetentionReadNotificationAge,
+			CompletedRepairAge:  DefaultRetentionCompletedRepairAge,
 		},
 		Uploads: UploadConfig{
-			Dir:         DefaultUploadDir,
-			MaxFileSize: DefaultUploadMaxFileSize,
+			Dir:                 DefaultUploadDir,
+			MaxFileSize:         DefaultUploadMaxFileSize,
+			TaskAttachmentQuota: DefaultUploadTaskAttachmentQuota,
+			WorkspaceQuota:      DefaultUploadWorkspaceQuota,
+			QuotaWarnPercent:    DefaultUploadQuotaWarnPercent,
+			PreviewSize:         DefaultUploadPreviewSize,
+			PreviewInterval:     DefaultUploadPreviewInterval,
+			PDFRenderer:         DefaultUploadPDFRenderer,
+		},
+		Storage: StorageConfig{
+			Region:        DefaultStorageRegion,
+			MaxFileSize:   DefaultStorageMaxFileSize,
+			PresignExpiry: DefaultStoragePresignExpiry,
+		},
+		Backup: BackupConfig{
+			Dir:           DefaultBackupDir,
+			MaxUploadSize: DefaultBackupMaxUploadSize,
+		},
+		ChatExport: ChatExportConfig{
+			Dir:       DefaultChatExportDir,
+			SyncLimit: DefaultChatExportSyncLimit,
+		},
+		LDAP: LDAPConfig{
+			UserFilter: DefaultLDAPUserFilter,
+			PageSize:   DefaultLDAPPageSize,
+			Timeout:    DefaultLDAPTimeout,
+			Attributes: LDAPAttributeConfig{
+				ID:        "entryUUID",
+				Username:  "uid",
+				Email:     "mail",
+				FirstName: "givenName",
+				LastName:  "sn",
+			},
+		},
+		Inbound: InboundConfig{
+			MaxSize: DefaultInboundEmailMaxSize,
+		},
+		Email: EmailConfig{
+			SMTPPort: DefaultEmailSMTPPort,
+		},
+		Readiness: ReadinessConfig{
+			MaxOutboxBacklog: DefaultReadinessMaxOutboxBacklog,
+			MaxProjectionLag: DefaultReadinessMaxProjectionLag,
+			StartupOnly:      true,
+		},
+		CORS: CORSConfig{
+			AllowedOrigins: DefaultCORSAllowedOrigins,
+			MaxAge:         DefaultCORSMaxAge,
+		},
+		Templates: TemplatesConfig{
+			FragmentCache:           true,
+			FragmentCacheTTL:        DefaultFragmentCacheTTL,
+			FragmentCacheMaxEntries: DefaultFragmentCacheMaxEntries,
+		},
+		Analytics: AnalyticsConfig{
+			Sink:        DefaultAnalyticsSink,
+			Timeout:     DefaultAnalyticsTimeout,
+			PostHogHost: DefaultAnalyticsPostHogHost,
+		},
+		ReadOnly: ReadOnlyConfig{
+			ReadPreference: DefaultReadOnlyReadPreference,
+		},
+		RateLimit: RateLimitConfig{
+			Enabled:                    true,
+			WorkspaceAPICallsPerMinute: DefaultRateLimitWorkspaceAPICalls,
+			WorkspaceMessagesPerMinute: DefaultRateLimitWorkspaceMessages,
+		},
+		Resilience: ResilienceConfig{
+			MaxWait:               DefaultResilienceMaxWait,
+			KeycloakMaxConcurrent: DefaultResilienceKeycloakMaxConcurrent,
+			KeycloakTimeout:       DefaultResilienceKeycloakTimeout,
+			RedisMaxConcurrent:    DefaultResilienceRedisMaxConcurrent,
+			RedisTimeout:          DefaultResilienceRedisTimeout,
+			BlobMaxConcurrent:     DefaultResilienceBlobMaxConcurrent,
+			BlobTimeout:           DefaultResilienceBlobTimeout,
+		},
+		ErrorReporting: ErrorReportingConfig{
+			Timeout: DefaultErrorReportingTimeout,
 		},
 	}
 }
@@ -300,12 +1043,31 @@ func (c *Config) Validate() error {
 	errs = c.validateApp(errs)
 	errs = c.validateServer(errs)
 	errs = c.validateMongoDB(errs)
+	errs = c.validateEventStore(errs)
 	errs = c.validateRedis(errs)
 	errs = c.validateKeycloak(errs)
+	errs = c.validateLDAP(errs)
 	errs = c.validateAuth(errs)
 	errs = c.validateLog(errs)
 	errs = c.validateEventBus(errs)
 	errs = c.validateWebSocket(errs)
+	errs = c.validateDiagnostics(errs)
+	errs = c.validateReadiness(errs)
+	errs = c.validateMessages(errs)
+	errs = c.validateRetention(errs)
+	errs = c.validateUploads(errs)
+	errs = c.validateStorage(errs)
+	errs = c.validateCORS(errs)
+	errs = c.validateTemplates(errs)
+	errs = c.validateAnalytics(errs)
+	errs = c.validateRateLimit(errs)
+	errs = c.validateResilience(errs)
+	errs = c.validateInbound(errs)
+	errs = c.validateEmail(errs)
+	errs = c.validateReadOnly(errs)
+	errs = c.validateBackup(errs)
+	errs = c.validateChatExport(errs)
+	errs = c.validateErrorReporting(errs)
 
 	if len(errs) > 0 {
 		return fmt.Errorf("%w: %w", ErrConfigInvalid, errors.Join(errs...))
@@ -336,6 +1098,47 @@ func (c *Config) validateServer(errs []error) []error {
 	if c.Server.WriteTimeout <= 0 {
 		errs = append(errs, errors.New("server.write_timeout must be positive"))
 	}
+	for entry := range strings.SplitSeq(c.Server.TrustedProxies, ",") {
+		if _, err := parseProxyNet(entry); err != nil {
+			errs = append(errs, fmt.Errorf("%w: got %q", ErrInvalidTrustedProxy, strings.TrimSpace(entry)))
+		}
+	}
+	errs = c.validateRequestTimeouts(errs)
+	return c.validateTLS(errs)
+}
+
+// validateRequestTimeouts validates the request deadlines. Read and write deadlines
+// must expire before the server write timeout, or the 504 could not be sent.
+func (c *Config) validateRequestTimeouts(errs []error) []error {
+	rt := c.Server.RequestTimeouts
+	if rt.Read < 0 || rt.Write < 0 || rt.Long < 0 ||
+		rt.Read >= c.Server.WriteTimeout || rt.Write >= c.Server.WriteTimeout {
+		errs = append(errs, ErrInvalidReqTimeout)
+	}
+	return errs
+}
+
+// validateTLS validates direct TLS serving configuration.
+func (c *Config) validateTLS(errs []error) []error {
+	tlsCfg := c.Server.TLS
+	if !tlsCfg.Enabled {
+		return errs
+	}
+
+	hasCert := tlsCfg.CertFile != "" && tlsCfg.KeyFile != ""
+	hasPartialCert := (tlsCfg.CertFile != "") != (tlsCfg.KeyFile != "")
+	hasAutocert := len(tlsCfg.AutocertHostList()) > 0
+	if hasPartialCert || hasCert == hasAutocert {
+		errs = append(errs, ErrInvalidTLS)
+	}
+	if hasAutocert && strings.TrimSpace(tlsCfg.AutocertCacheDir) == "" {
+		errs = append(errs, errors.New("server.tls.autocert_cache_dir is required when autocert is enabled"))
+	}
+	if tlsCfg.RedirectAddr != "" {
+		if _, _, err := net.SplitHostPort(tlsCfg.RedirectAddr); err != nil {
+			errs = append(errs, fmt.Errorf("server.tls.redirect_addr must be host:port, got %q", tlsCfg.RedirectAddr))
+		}
+	}
 	return errs
 }
 
@@ -347,6 +1150,14 @@ func (c *Config) validateMongoDB(errs []error) []error {
 	if c.MongoDB.Database == "" {
 		errs = append(errs, errors.New("mongodb.database is required"))
 	}
+	if c.MongoDB.SlowQueryThreshold < 0 {
+		errs = append(errs, ErrInvalidSlowQuery)
+	}
+	switch c.MongoDB.IndexVerification {
+	case "", IndexVerificationOff, IndexVerificationReport, IndexVerificationRepair:
+	default:
+		errs = append(errs, fmt.Errorf("%w: got %q", ErrInvalidIndexVerify, c.MongoDB.IndexVerification))
+	}
 	return errs
 }
 
@@ -400,6 +1211,43 @@ func (c *Config) validateLog(errs []error) []error {
 	return errs
 }
 
+// validateEventStore validates event store configuration.
+func (c *Config) validateEventStore(errs []error) []error {
+	if c.EventStore.Partitions < 0 || c.EventStore.Partitions > MaxEventStorePartitions {
+		errs = append(errs, ErrInvalidEventStore)
+	}
+	return errs
+}
+
+// validateBackup validates workspace backup configuration.
+func (c *Config) validateBackup(errs []error) []error {
+	if strings.TrimSpace(c.Backup.Dir) == "" || c.Backup.MaxUploadSize <= 0 {
+		errs = append(errs, ErrInvalidBackup)
+	}
+	return errs
+}
+
+// validateChatExport validates chat transcript export configuration.
+func (c *Config) validateChatExport(errs []error) []error {
+	if strings.TrimSpace(c.ChatExport.Dir) == "" || c.ChatExport.SyncLimit < 0 {
+		errs = append(errs, ErrInvalidChatExport)
+	}
+	return errs
+}
+
+// validateErrorReporting validates the error tracker DSN when reporting is enabled.
+func (c *Config) validateErrorReporting(errs []error) []error {
+	if !c.ErrorReporting.Enabled() {
+		return errs
+	}
+	dsn, err := url.Parse(strings.TrimSpace(c.ErrorReporting.DSN))
+	if err != nil || (dsn.Scheme != "http" && dsn.Scheme != "https") || dsn.User.Username() == "" ||
+		strings.Trim(dsn.Path, "/") == "" || c.ErrorReporting.Timeout <= 0 {
+		errs = append(errs, ErrInvalidErrorReport)
+	}
+	return errs
+}
+
 // validateEventBus validates event bus configuration.
 func (c *Config) validateEventBus(errs []error) []error {
 	validEventBusTypes := map[string]bool{"redis": true, "inmemory": true}
@@ -426,6 +1274,217 @@ func (c *Config) validateWebSocket(errs []error) []error {
 	return errs
 }
 
+// validateDiagnostics validates diagnostics configuration.
+func (c *Config) validateDiagnostics(errs []error) []error {
+	addr := strings.TrimSpace(c.Diagnostics.ListenAddr)
+	if addr == "" {
+		return errs
+	}
+
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		return append(errs, fmt.Errorf("%w: got %q", ErrDiagnosticsAddr, addr))
+	}
+	if host == "localhost" {
+		return errs
+	}
+	if ip := net.ParseIP(host); ip == nil || !ip.IsLoopback() {
+		errs = append(errs, fmt.Errorf("%w: got %q", ErrDiagnosticsAddr, addr))
+	}
+	return errs
+}
+
+// validateReadiness validates readiness gating configuration.
+func (c *Config) validateReadiness(errs []error) []error {
+	if c.Readiness.MaxOutboxBacklog < 0 || c.Readiness.MaxProjectionLag < 0 {
+		errs = append(errs, ErrInvalidReadiness)
+	}
+	return errs
+}
+
+// validateMessages validates message lifecycle configuration.
+func (c *Config) validateMessages(errs []error) []error {
+	if c.Messages.DeletedRetention <= 0 {
+		errs = append(errs, errors.New("messages.deleted_retention must be positive"))
+	}
+	if c.Messages.UndoWindow < 0 {
+		errs = append(errs, errors.New("messages.undo_window must not be negative"))
+	}
+	if c.Messages.UndoWindow >= c.Messages.DeletedRetention {
+		errs = append(errs, errors.New("messages.undo_window must be shorter than messages.deleted_retention"))
+	}
+	return errs
+}
+
+// validateRetention validates retention worker configuration.
+func (c *Config) validateRetention(errs []error) []error {
+	if c.Retention.Interval <= 0 {
+		errs = append(errs, errors.New("retention.interval must be positive"))
+	}
+	if c.Retention.BatchSize <= 0 {
+		errs = append(errs, errors.New("retention.batch_size must be positive"))
+	}
+	if c.Retention.BatchPause < 0 {
+		errs = append(errs, errors.New("retention.batch_pause must not be negative"))
+	}
+	if c.Retention.ReadNotificationAge < 0 || c.Retention.CompletedRepairAge < 0 {
+		errs = append(errs, errors.New("retention ages must not be negative"))
+	}
+	return errs
+}
+
+// validateUploads validates attachment quota and preview configuration.
+func (c *Config) validateUploads(errs []error) []error {
+	if c.Uploads.QuotaWarnPercent < 0 || c.Uploads.QuotaWarnPercent >= maxPercent {
+		errs = append(errs, fmt.Errorf("uploads.quota_warn_percent must be between 0 and %d", maxPercent-1))
+	}
+	if c.Uploads.PreviewSize <= 0 || c.Uploads.PreviewSize > MaxUploadPreviewSize {
+		errs = append(errs, fmt.Errorf("uploads.preview_size must be between 1 and %d", MaxUploadPreviewSize))
+	}
+	if c.Uploads.PreviewInterval <= 0 {
+		errs = append(errs, errors.New("uploads.preview_interval must be positive"))
+	}
+	return errs
+}
+
+// validateStorage validates the object storage of chat attachments.
+func (c *Config) validateStorage(errs []error) []error {
+	st := c.Storage
+	if !st.Enabled {
+		return errs
+	}
+	if u, err := url.Parse(st.Endpoint); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		errs = append(errs, fmt.Errorf("%w: endpoint must be an http or https URL", ErrInvalidStorage))
+	}
+	if st.Region == "" || st.Bucket == "" || st.AccessKey == "" || st.SecretKey == "" {
+		errs = append(errs, fmt.Errorf("%w: region, bucket, access_key and secret_key are required", ErrInvalidStorage))
+	}
+	if st.MaxFileSize <= 0 {
+		errs = append(errs, fmt.Errorf("%w: max_file_size must be positive", ErrInvalidStorage))
+	}
+	if st.PresignExpiry <= 0 || st.PresignExpiry > MaxStoragePresignExpiry {
+		errs = append(errs, fmt.Errorf("%w: presign_expiry must be positive and at most %s",
+			ErrInvalidStorage, MaxStoragePresignExpiry))
+	}
+	return errs
+}
+
+// validateCORS validates cross-origin configuration.
+func (c *Config) validateCORS(errs []error) []error {
+	if c.CORS.AllowCredentials && slices.Contains(c.CORS.AllowedOriginList(), "*") {
+		errs = append(errs, ErrInvalidCORS)
+	}
+	if c.CORS.MaxAge < 0 {
+		errs = append(errs, errors.New("cors.max_age must not be negative"))
+	}
+	return errs
+}
+
+// validateTemplates validates template rendering configuration.
+func (c *Config) validateTemplates(errs []error) []error {
+	if c.Templates.FragmentCache && (c.Templates.FragmentCacheTTL <= 0 || c.Templates.FragmentCacheMaxEntries <= 0) {
+		errs = append(errs, ErrInvalidTemplates)
+	}
+	return errs
+}
+
+// validateRateLimit validates the workspace fair-usage limits.
+func (c *Config) validateRateLimit(errs []error) []error {
+	if c.RateLimit.WorkspaceAPICallsPerMinute < 0 || c.RateLimit.WorkspaceMessagesPerMinute < 0 {
+		errs = append(errs, ErrInvalidRateLimit)
+	}
+	return errs
+}
+
+// validateResilience validates the dependency bulkheads.
+func (c *Config) validateResilience(errs []error) []error {
+	r := c.Resilience
+	if r.MaxWait < 0 ||
+		r.KeycloakMaxConcurrent <= 0 || r.KeycloakTimeout <= 0 ||
+		r.RedisMaxConcurrent <= 0 || r.RedisTimeout <= 0 ||
+		r.BlobMaxConcurrent <= 0 || r.BlobTimeout <= 0 {
+		errs = append(errs, ErrInvalidResilience)
+	}
+	return errs
+}
+
+// validateLDAP validates the LDAP user sync directory.
+func (c *Config) validateLDAP(errs []error) []error {
+	l := c.LDAP
+	if !l.Enabled {
+		return errs
+	}
+	if l.URL == "" || l.BaseDN == "" || l.UserFilter == "" || l.PageSize <= 0 || l.Timeout <= 0 ||
+		l.Attributes.ID == "" || l.Attributes.Username == "" || l.Attributes.Email == "" {
+		errs = append(errs, ErrInvalidLDAP)
+	}
+	return errs
+}
+
+// validateReadOnly validates the read-only deployment mode.
+func (c *Config) validateReadOnly(errs []error) []error {
+	if !c.ReadOnly.Enabled {
+		return errs
+	}
+	switch strings.ToLower(c.ReadOnly.ReadPreference) {
+	case "primary", "primarypreferred", "secondary", "secondarypreferred", "nearest":
+	default:
+		errs = append(errs, ErrInvalidReadOnly)
+	}
+	return errs
+}
+
+// validateInbound validates the inbound email gateway.
+func (c *Config) validateInbound(errs []error) []error {
+	in := c.Inbound
+	if in.Enabled && (strings.TrimSpace(in.Domain) == "" || in.Secret == "" || in.MaxSize <= 0) {
+		errs = append(errs, ErrInvalidInbound)
+	}
+	return errs
+}
+
+// validateEmail validates the outbound email settings.
+func (c *Config) validateEmail(errs []error) []error {
+	if !c.Email.Enabled {
+		return errs
+	}
+	if _, err := mail.ParseAddress(c.Email.From); err != nil ||
+		strings.TrimSpace(c.Email.SMTPHost) == "" || c.Email.SMTPPort <= 0 {
+		errs = append(errs, ErrInvalidEmail)
+	}
+	return errs
+}
+
+// validateAnalytics validates the analytics pipeline configuration.
+func (c *Config) validateAnalytics(errs []error) []error {
+	if !c.Analytics.Enabled {
+		return errs
+	}
+	if c.Analytics.HashSalt == "" {
+		errs = append(errs, fmt.Errorf("%w: hash_salt is required", ErrInvalidAnalytics))
+	}
+	if c.Analytics.Timeout <= 0 {
+		errs = append(errs, fmt.Errorf("%w: timeout must be positive", ErrInvalidAnalytics))
+	}
+
+	switch c.Analytics.Sink {
+	case AnalyticsSinkStdout:
+	case AnalyticsSinkSegment:
+		if c.Analytics.SegmentWriteKey == "" {
+			errs = append(errs, fmt.Errorf("%w: segment_write_key is required for the segment sink", ErrInvalidAnalytics))
+		}
+	case AnalyticsSinkPostHog:
+		if c.Analytics.PostHogAPIKey == "" || c.Analytics.PostHogHost == "" {
+			errs = append(errs, fmt.Errorf("%w: posthog_api_key and posthog_host are required for the posthog sink",
+				ErrInvalidAnalytics))
+		}
+	default:
+		errs = append(errs, fmt.Errorf("%w: sink must be stdout, segment or posthog, got %q",
+			ErrInvalidAnalytics, c.Analytics.Sink))
+	}
+	return errs
+}
+
 // Load loads configuration from the default config file and environment variables.
 func Load() (*Config, error) {
 	return LoadFromPath("")
@@ -462,6 +1521,22 @@ func (l *Loader) WithConfigPaths(paths []string) *Loader {
 
 // Load loads configuration from file and environment variables.
 func (l *Loader) Load(path string) (*Config, error) {
+	cfg, err := l.LoadUnvalidated(path)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate the final configuration
+	if err = cfg.Validate(); err != nil {
+		return nil, err
+	}
+
+	return cfg, nil
+}
+
+// LoadUnvalidated loads configuration like Load but skips validation, so that
+// diagnostic tools can report every problem of an invalid configuration.
+func (l *Loader) LoadUnvalidated(path string) (*Config, error) {
 	// Start with default config
 	cfg := DefaultConfig()
 
@@ -500,11 +1575,6 @@ func (l *Loader) Load(path string) (*Config, error) {
 
 	l.applyDerivedDefaults(cfg)
 
-	// Validate the final configuration
-	if err := cfg.Validate(); err != nil {
-		return nil, err
-	}
-
 	return cfg, nil
 }
 
@@ -640,3 +1710,9 @@ func (c *Config) IsProduction() bool {
 	return c.Auth.JWTSecret != "dev-secret-change-in-production" &&
 		c.Auth.JWTSecret != ""
 }
+
+// RepliesEnabled reports whether notification emails accept replies.
+// Replies arrive through the inbound email gateway.
+func (c *Config) RepliesEnabled() bool {
+	return c.Email.ReplyKey != "" && c.Inbound.Enabled
+}
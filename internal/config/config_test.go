@@ -402,6 +402,24 @@ server:
 	assert.Contains(t, err.Error(), "failed to parse config file")
 }
 
+func TestLoader_LoadUnvalidated(t *testing.T) {
+	tmpDir := t.TempDir()
+	configPath := filepath.Join(tmpDir, "config.yaml")
+	invalidConfig := `
+server:
+  port: 0
+`
+	require.NoError(t, os.WriteFile(configPath, []byte(invalidConfig), 0o644))
+
+	_, err := config.NewLoader().Load(configPath)
+	require.ErrorIs(t, err, config.ErrConfigInvalid)
+
+	cfg, err := config.NewLoader().LoadUnvalidated(configPath)
+	require.NoError(t, err)
+	assert.Equal(t, 0, cfg.Server.Port)
+	require.ErrorIs(t, cfg.Validate(), config.ErrConfigInvalid)
+}
+
 func TestLoader_LoadFromEnv(t *testing.T) {
 	// Set test environment variables using t.Setenv (auto-cleanup)
 	t.Setenv("SERVER_HOST", "env-host")
@@ -738,3 +756,436 @@ func TestConfig_Validate_ProductionRequiresKeycloakJWTAudience(t *testing.T) {
 	require.ErrorIs(t, err, config.ErrConfigInvalid)
 	assert.Contains(t, err.Error(), "keycloak.jwt_audience is required in production when keycloak is enabled")
 }
+
+func TestConfig_Validate_DiagnosticsListenAddr(t *testing.T) {
+	tests := []struct {
+		name    string
+		addr    string
+		wantErr bool
+	}{
+		{name: "empty uses main router", addr: ""},
+		{name: "loopback", addr: "127.0.0.1:6060"},
+		{name: "localhost", addr: "localhost:6060"},
+		{name: "all interfaces", addr: "0.0.0.0:6060", wantErr: true},
+		{name: "malformed", addr: "6060", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := config.DefaultConfig()
+			cfg.Diagnostics.Enabled = true
+			cfg.Diagnostics.ListenAddr = tt.addr
+
+			err := cfg.Validate()
+			if tt.wantErr {
+				require.ErrorIs(t, err, config.ErrDiagnosticsAddr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestConfig_Validate_Readiness(t *testing.T) {
+	cfg := config.DefaultConfig()
+	require.NoError(t, cfg.Validate())
+
+	cfg.Readiness.MaxOutboxBacklog = -1
+	require.ErrorIs(t, cfg.Validate(), config.ErrInvalidReadiness)
+
+	cfg = config.DefaultConfig()
+	cfg.Readiness.MaxProjectionLag = -time.Second
+	require.ErrorIs(t, cfg.Validate(), config.ErrInvalidReadiness)
+}
+
+func TestConfig_Validate_TLS(t *testing.T) {
+	cfg := config.DefaultConfig()
+	assert.True(t, cfg.Server.HTTP2)
+	cfg.Server.TLS.Enabled = true
+	require.ErrorIs(t, cfg.Validate(), config.ErrInvalidTLS)
+
+	cfg.Server.TLS.CertFile = "/etc/flowra/tls.crt"
+	require.ErrorIs(t, cfg.Validate(), config.ErrInvalidTLS)
+
+	cfg.Server.TLS.KeyFile = "/etc/flowra/tls.key"
+	require.NoError(t, cfg.Validate())
+
+	cfg.Server.TLS.AutocertHosts = "flowra.example.com"
+	require.ErrorIs(t, cfg.Validate(), config.ErrInvalidTLS)
+
+	cfg.Server.TLS.CertFile, cfg.Server.TLS.KeyFile = "", ""
+	require.NoError(t, cfg.Validate())
+
+	cfg.Server.TLS.RedirectAddr = "80"
+	require.Error(t, cfg.Validate())
+}
+
+func TestTLSConfig_AutocertHostList(t *testing.T) {
+	tlsCfg := config.TLSConfig{AutocertHosts: " flowra.example.com, ,www.flowra.example.com "}
+	assert.Equal(t, []string{"flowra.example.com", "www.flowra.example.com"}, tlsCfg.AutocertHostList())
+	assert.Empty(t, config.TLSConfig{}.AutocertHostList())
+}
+
+func TestServerConfig_TrustedProxyNets(t *testing.T) {
+	cfg := config.DefaultConfig()
+	assert.Empty(t, cfg.Server.TrustedProxyNets())
+
+	cfg.Server.TrustedProxies = "10.0.0.0/8, 192.168.1.10 ,::1"
+	require.NoError(t, cfg.Validate())
+
+	nets := cfg.Server.TrustedProxyNets()
+	require.Len(t, nets, 3)
+	assert.Equal(t, "10.0.0.0/8", nets[0].String())
+	assert.Equal(t, "192.168.1.10/32", nets[1].String())
+	assert.Equal(t, "::1/128", nets[2].String())
+
+	cfg.Server.TrustedProxies = "10.0.0.0/8,not-an-ip"
+	require.ErrorIs(t, cfg.Validate(), config.ErrInvalidTrustedProxy)
+	assert.Len(t, cfg.Server.TrustedProxyNets(), 1)
+}
+
+func TestConfig_Validate_CORS(t *testing.T) {
+	cfg := config.DefaultConfig()
+	assert.Equal(t, []string{"*"}, cfg.CORS.AllowedOriginList())
+
+	cfg.CORS.AllowCredentials = true
+	require.ErrorIs(t, cfg.Validate(), config.ErrInvalidCORS)
+
+	cfg.CORS.AllowedOrigins = "https://app.flowra.example.com, http://localhost:3000"
+	require.NoError(t, cfg.Validate())
+	assert.Equal(t,
+		[]string{"https://app.flowra.example.com", "http://localhost:3000"},
+		cfg.CORS.AllowedOriginList(),
+	)
+
+	cfg.CORS.MaxAge = -time.Second
+	require.Error(t, cfg.Validate())
+}
+
+func TestConfig_Validate_Templates(t *testing.T) {
+	cfg := config.DefaultConfig()
+	assert.True(t, cfg.Templates.FragmentCache)
+	require.NoError(t, cfg.Validate())
+
+	cfg.Templates.FragmentCacheTTL = 0
+	require.ErrorIs(t, cfg.Validate(), config.ErrInvalidTemplates)
+
+	cfg.Templates.FragmentCache = false
+	require.NoError(t, cfg.Validate())
+}
+
+func TestConfig_Validate_Analytics(t *testing.T) {
+	cfg := config.DefaultConfig()
+	assert.False(t, cfg.Analytics.Enabled)
+	require.NoError(t, cfg.Validate())
+
+	cfg.Analytics.Enabled = true
+	require.ErrorIs(t, cfg.Validate(), config.ErrInvalidAnalytics)
+
+	cfg.Analytics.HashSalt = "salt"
+	require.NoError(t, cfg.Validate())
+
+	cfg.Analytics.Sink = config.AnalyticsSinkSegment
+	require.ErrorIs(t, cfg.Validate(), config.ErrInvalidAnalytics)
+	cfg.Analytics.SegmentWriteKey = "key"
+	require.NoError(t, cfg.Validate())
+
+	cfg.Analytics.Sink = "mixpanel"
+	require.ErrorIs(t, cfg.Validate(), config.ErrInvalidAnalytics)
+}
+
+func TestConfig_Validate_RateLimit(t *testing.T) {
+	cfg := config.DefaultConfig()
+	assert.True(t, cfg.RateLimit.Enabled)
+	assert.Equal(t, config.DefaultRateLimitWorkspaceMessages, cfg.RateLimit.WorkspaceMessagesPerMinute)
+	require.NoError(t, cfg.Validate())
+
+	cfg.RateLimit.WorkspaceAPICallsPerMinute = 0
+	require.NoError(t, cfg.Validate())
+
+	cfg.RateLimit.WorkspaceMessagesPerMinute = -1
+	require.ErrorIs(t, cfg.Validate(), config.ErrInvalidRateLimit)
+}
+
+func TestConfig_Validate_Resilience(t *testing.T) {
+	cfg := config.DefaultConfig()
+	require.NoError(t, cfg.Validate())
+
+	cfg.Resilience.MaxWait = 0
+	require.NoError(t, cfg.Validate())
+
+	cfg.Resilience.RedisTimeout = 0
+	require.ErrorIs(t, cfg.Validate(), config.ErrInvalidResilience)
+
+	cfg = config.DefaultConfig()
+	cfg.Resilience.BlobMaxConcurrent = -1
+	require.ErrorIs(t, cfg.Validate(), config.ErrInvalidResilience)
+}
+
+func TestConfig_Validate_Inbound(t *testing.T) {
+	cfg := config.DefaultConfig()
+	assert.False(t, cfg.Inbound.Enabled)
+	assert.Equal(t, int64(config.DefaultInboundEmailMaxSize), cfg.Inbound.MaxSize)
+	require.NoError(t, cfg.Validate())
+
+	cfg.Inbound.Enabled = true
+	require.ErrorIs(t, cfg.Validate(), config.ErrInvalidInbound)
+
+	cfg.Inbound.Domain = "in.flowra.example"
+	cfg.Inbound.Secret = "s3cret"
+	require.NoError(t, cfg.Validate())
+}
+
+func TestConfig_Validate_Storage(t *testing.T) {
+	cfg := config.DefaultConfig()
+	assert.False(t, cfg.Storage.Enabled)
+	assert.Equal(t, config.DefaultStorageRegion, cfg.Storage.Region)
+	assert.Equal(t, int64(config.DefaultStorageMaxFileSize), cfg.Storage.MaxFileSize)
+	require.NoError(t, cfg.Validate())
+
+	cfg.Storage.Enabled = true
+	require.ErrorIs(t, cfg.Validate(), config.ErrInvalidStorage)
+
+	cfg.Storage.Endpoint = "http://minio:9000"
+	cfg.Storage.Bucket = "flowra-attachments"
+	cfg.Storage.AccessKey = "minio"
+	cfg.Storage.SecretKey = "minio-secret"
+	require.NoError(t, cfg.Validate())
+
+	cfg.Storage.PresignExpiry = config.MaxStoragePresignExpiry + time.Second
+	require.ErrorIs(t, cfg.Validate(), config.ErrInvalidStorage)
+	cfg.Storage.PresignExpiry = config.DefaultStoragePresignExpiry
+
+	cfg.Storage.MaxFileSize = 0
+	require.ErrorIs(t, cfg.Validate(), config.ErrInvalidStorage)
+}
+
+func TestConfig_Validate_Email(t *testing.T) {
+	cfg := config.DefaultConfig()
+	assert.Equal(t, config.DefaultEmailSMTPPort, cfg.Email.SMTPPort)
+	require.NoError(t, cfg.Validate())
+
+	cfg.Email.Enabled = true
+	cfg.Email.SMTPHost = "smtp.example.com"
+	cfg.Email.From = "not an address"
+	require.ErrorIs(t, cfg.Validate(), config.ErrInvalidEmail)
+
+	cfg.Email.From = "Flowra <noreply@flowra.example>"
+	require.NoError(t, cfg.Validate())
+}
+
+func TestConfig_Validate_LDAP(t *testing.T) {
+	cfg := config.DefaultConfig()
+	assert.Equal(t, "entryUUID", cfg.LDAP.Attributes.ID)
+
+	cfg.LDAP.Enabled = true
+	require.ErrorIs(t, cfg.Validate(), config.ErrInvalidLDAP)
+
+	cfg.LDAP.URL = "ldaps://ldap.example.com"
+	cfg.LDAP.BaseDN = "ou=people,dc=example,dc=com"
+	require.NoError(t, cfg.Validate())
+
+	cfg.LDAP.Attributes.Email = ""
+	require.ErrorIs(t, cfg.Validate(), config.ErrInvalidLDAP)
+}
+
+func TestConfig_Validate_MongoDBSlowQueryThreshold(t *testing.T) {
+	cfg := config.DefaultConfig()
+	assert.Equal(t, config.DefaultMongoDBSlowQueryThreshold, cfg.MongoDB.SlowQueryThreshold)
+
+	cfg.MongoDB.SlowQueryThreshold = 0
+	require.NoError(t, cfg.Validate())
+
+	cfg.MongoDB.SlowQueryThreshold = -time.Second
+	require.ErrorIs(t, cfg.Validate(), config.ErrInvalidSlowQuery)
+}
+
+func TestConfig_Validate_MongoDBIndexVerification(t *testing.T) {
+	cfg := config.DefaultConfig()
+	assert.Equal(t, config.IndexVerificationReport, cfg.MongoDB.IndexVerification)
+
+	for _, mode := range []string{"", config.IndexVerificationOff, config.IndexVerificationRepair} {
+		cfg.MongoDB.IndexVerification = mode
+		require.NoError(t, cfg.Validate())
+	}
+
+	cfg.MongoDB.IndexVerification = "fix"
+	require.ErrorIs(t, cfg.Validate(), config.ErrInvalidIndexVerify)
+}
+
+func TestConfig_Validate_ErrorReporting(t *testing.T) {
+	cfg := config.DefaultConfig()
+	assert.False(t, cfg.ErrorReporting.Enabled())
+	require.NoError(t, cfg.Validate())
+
+	cfg.ErrorReporting.DSN = "https://public@sentry.example.com/42"
+	assert.True(t, cfg.ErrorReporting.Enabled())
+	require.NoError(t, cfg.Validate())
+
+	for _, dsn := range []string{
+		"sentry.example.com/42",
+		"https://sentry.example.com/42",
+		"https://public@sentry.example.com/",
+	} {
+		cfg.ErrorReporting.DSN = dsn
+		require.ErrorIs(t, cfg.Validate(), config.ErrInvalidErrorReport, dsn)
+	}
+
+	cfg.ErrorReporting.DSN = "https://public@sentry.example.com/42"
+	cfg.ErrorReporting.Timeout = 0
+	require.ErrorIs(t, cfg.Validate(), config.ErrInvalidErrorReport)
+}
+
+func TestConfig_Validate_RequestTimeouts(t *testing.T) {
+	cfg := config.DefaultConfig()
+	require.NoError(t, cfg.Validate())
+	assert.Equal(t, config.DefaultRequestTimeoutLong, cfg.Server.RequestTimeouts.Long)
+
+	cfg.Server.RequestTimeouts = config.RequestTimeoutConfig{}
+	require.NoError(t, cfg.Validate())
+
+	cfg.Server.RequestTimeouts.Long = -time.Second
+	require.ErrorIs(t, cfg.Validate(), config.ErrInvalidReqTimeout)
+
+	cfg.Server.RequestTimeouts.Long = 0
+	cfg.Server.RequestTimeouts.Write = cfg.Server.WriteTimeout
+	require.ErrorIs(t, cfg.Validate(), config.ErrInvalidReqTimeout)
+}
+
+func TestConfig_Validate_EventStore(t *testing.T) {
+	cfg := config.DefaultConfig()
+	assert.False(t, cfg.EventStore.Partitioned())
+
+	cfg.EventStore.Partitions = 16
+	require.NoError(t, cfg.Validate())
+	assert.True(t, cfg.EventStore.Partitioned())
+
+	cfg.EventStore.Partitions = config.MaxEventStorePartitions + 1
+	require.ErrorIs(t, cfg.Validate(), config.ErrInvalidEventStore)
+}
+
+func TestConfig_Validate_Backup(t *testing.T) {
+	cfg := config.DefaultConfig()
+	require.NoError(t, cfg.Validate())
+	assert.Equal(t, config.DefaultBackupDir, cfg.Backup.Dir)
+
+	cfg.Backup.MaxUploadSize = 0
+	require.ErrorIs(t, cfg.Validate(), config.ErrInvalidBackup)
+
+	cfg = config.DefaultConfig()
+	cfg.Backup.Dir = " "
+	require.ErrorIs(t, cfg.Validate(), config.ErrInvalidBackup)
+}
+
+func TestConfig_Validate_ChatExport(t *testing.T) {
+	cfg := config.DefaultConfig()
+	require.NoError(t, cfg.Validate())
+	assert.Equal(t, config.DefaultChatExportDir, cfg.ChatExport.Dir)
+	assert.Equal(t, config.DefaultChatExportSyncLimit, cfg.ChatExport.SyncLimit)
+
+	cfg.ChatExport.SyncLimit = -1
+	require.ErrorIs(t, cfg.Validate(), config.ErrInvalidChatExport)
+
+	cfg = config.DefaultConfig()
+	cfg.ChatExport.Dir = ""
+	require.ErrorIs(t, cfg.Validate(), config.ErrInvalidChatExport)
+}
+
+func TestConfig_Validate_ReadOnly(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.ReadOnly.Enabled = true
+	require.NoError(t, cfg.Validate())
+	assert.Equal(t, cfg.MongoDB.URI, cfg.ReadOnly.MongoURI(cfg.MongoDB.URI))
+
+	cfg.ReadOnly.MongoDBURI = "mongodb://analytics:27017"
+	assert.Equal(t, "mongodb://analytics:27017", cfg.ReadOnly.MongoURI(cfg.MongoDB.URI))
+
+	cfg.ReadOnly.ReadPreference = "secondaryOnly"
+	require.ErrorIs(t, cfg.Validate(), config.ErrInvalidReadOnly)
+}
+
+func TestConfig_RepliesEnabled(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.Email.ReplyKey = "key"
+	assert.False(t, cfg.RepliesEnabled(), "replies need the inbound gateway")
+
+	cfg.Inbound.Enabled = true
+	assert.True(t, cfg.RepliesEnabled())
+}
+
+func TestAnalyticsConfig_EventList(t *testing.T) {
+	cfg := config.AnalyticsConfig{Events: " chat.created, ,message.created "}
+	assert.Equal(t, []string{"chat.created", "message.created"}, cfg.EventList())
+	assert.Empty(t, config.AnalyticsConfig{}.EventList())
+}
+
+func TestConfig_Validate_Messages(t *testing.T) {
+	cfg := config.DefaultConfig()
+	require.NoError(t, cfg.Validate())
+
+	cfg.Messages.DeletedRetention = 0
+	require.ErrorContains(t, cfg.Validate(), "messages.deleted_retention")
+
+	cfg = config.DefaultConfig()
+	cfg.Messages.UndoWindow = 0
+	require.NoError(t, cfg.Validate(), "zero disables undo")
+
+	cfg.Messages.UndoWindow = -time.Second
+	require.ErrorContains(t, cfg.Validate(), "messages.undo_window")
+
+	cfg.Messages.UndoWindow = cfg.Messages.DeletedRetention
+	require.ErrorContains(t, cfg.Validate(), "messages.undo_window")
+}
+
+func TestConfig_Validate_Retention(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.Retention.ReadNotificationAge = 0
+	cfg.Retention.CompletedRepairAge = 0
+	cfg.Retention.BatchPause = 0
+	require.NoError(t, cfg.Validate(), "zero ages disable their purge")
+
+	cfg.Retention.ReadNotificationAge = -time.Hour
+	require.ErrorContains(t, cfg.Validate(), "retention ages")
+
+	cfg = config.DefaultConfig()
+	cfg.Retention.Interval = 0
+	require.ErrorContains(t, cfg.Validate(), "retention.interval")
+
+	cfg = config.DefaultConfig()
+	cfg.Retention.BatchSize = 0
+	require.ErrorContains(t, cfg.Validate(), "retention.batch_size")
+
+	cfg = config.DefaultConfig()
+	cfg.Retention.BatchPause = -time.Millisecond
+	require.ErrorContains(t, cfg.Validate(), "retention.batch_pause")
+}
+
+func TestConfig_Validate_UploadPreviews(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.Uploads.PDFRenderer = ""
+	require.NoError(t, cfg.Validate(), "empty renderer disables PDF previews")
+
+	cfg.Uploads.PreviewSize = 0
+	require.ErrorContains(t, cfg.Validate(), "uploads.preview_size")
+
+	cfg = config.DefaultConfig()
+	cfg.Uploads.PreviewSize = config.MaxUploadPreviewSize + 1
+	require.ErrorContains(t, cfg.Validate(), "uploads.preview_size")
+
+	cfg = config.DefaultConfig()
+	cfg.Uploads.PreviewInterval = 0
+	require.ErrorContains(t, cfg.Validate(), "uploads.preview_interval")
+}
+
+func TestConfig_Validate_UploadQuotas(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.Uploads.WorkspaceQuota = 0
+	cfg.Uploads.QuotaWarnPercent = 0
+	require.NoError(t, cfg.Validate(), "zero disables the quota and its notification")
+
+	cfg.Uploads.QuotaWarnPercent = 100
+	require.ErrorContains(t, cfg.Validate(), "uploads.quota_warn_percent")
+
+	cfg.Uploads.QuotaWarnPercent = -1
+	require.ErrorContains(t, cfg.Validate(), "uploads.quota_warn_percent")
+}
@@ -0,0 +1,148 @@
+// Package announcement models system-wide announcements published by system admins.
+package announcement
+
+import (
+	"strings"
+	"time"
+
+	"github.com/lllypuk/flowra/internal/domain/errs"
+	"github.com/lllypuk/flowra/internal/domain/uuid"
+)
+
+// Level represents the severity of an announcement.
+type Level string
+
+const (
+	// LevelInfo is a general announcement such as a new feature.
+	LevelInfo Level = "info"
+	// LevelMaintenance announces a planned maintenance window.
+	LevelMaintenance Level = "maintenance"
+	// LevelIncident reports an ongoing incident.
+	LevelIncident Level = "incident"
+)
+
+// MaxMessageLength limits the banner text.
+const MaxMessageLength = 500
+
+// Levels returns all supported levels.
+func Levels() []Level {
+	return []Level{LevelInfo, LevelMaintenance, LevelIncident}
+}
+
+// IsValid reports whether the level is supported.
+func (l Level) IsValid() bool {
+	switch l {
+	case LevelInfo, LevelMaintenance, LevelIncident:
+		return true
+	default:
+		return false
+	}
+}
+
+// Announcement is a message shown to all users between its start and end time.
+type Announcement struct {
+	id        uuid.UUID
+	message   string
+	level     Level
+	startsAt  time.Time
+	endsAt    time.Time
+	createdBy uuid.UUID
+	createdAt time.Time
+}
+
+// NewAnnouncement creates a new announcement. A zero startsAt publishes it
+// immediately; endsAt is required so that banners never linger forever.
+func NewAnnouncement(
+	message string,
+	level Level,
+	startsAt, endsAt time.Time,
+	createdBy uuid.UUID,
+) (*Announcement, error) {
+	message = strings.TrimSpace(message)
+	if message == "" || len([]rune(message)) > MaxMessageLength {
+		return nil, errs.ErrInvalidInput
+	}
+	if !level.IsValid() {
+		return nil, errs.ErrInvalidInput
+	}
+	if createdBy.IsZero() {
+		return nil, errs.ErrInvalidInput
+	}
+
+	now := time.Now()
+	if startsAt.IsZero() {
+		startsAt = now
+	}
+	if endsAt.IsZero() || !endsAt.After(startsAt) {
+		return nil, errs.ErrInvalidInput
+	}
+
+	return &Announcement{
+		id:        uuid.NewUUID(),
+		message:   message,
+		level:     level,
+		startsAt:  startsAt,
+		endsAt:    endsAt,
+		createdBy: createdBy,
+		createdAt: now,
+	}, nil
+}
+
+// Reconstruct reconstructs an announcement from storage.
+// Used by repositories for hydration without validating business rules.
+func Reconstruct(
+	id uuid.UUID,
+	message string,
+	level Level,
+	startsAt, endsAt time.Time,
+	createdBy uuid.UUID,
+	createdAt time.Time,
+) *Announcement {
+	return &Announcement{
+		id:        id,
+		message:   message,
+		level:     level,
+		startsAt:  startsAt,
+		endsAt:    endsAt,
+		createdBy: createdBy,
+		createdAt: createdAt,
+	}
+}
+
+// IsActive reports whether the announcement is shown at the given time.
+func (a *Announcement) IsActive(at time.Time) bool {
+	return !at.Before(a.startsAt) && at.Before(a.endsAt)
+}
+
+// End stops showing the announcement from the given time on.
+func (a *Announcement) End(at time.Time) error {
+	if !at.Before(a.endsAt) {
+		return errs.ErrInvalidState
+	}
+	if at.Before(a.startsAt) {
+		a.startsAt = at
+	}
+	a.endsAt = at
+	return nil
+}
+
+// ID returns the announcement ID.
+func (a *Announcement) ID() uuid.UUID { return a.id }
+
+// Message returns the banner text.
+func (a *Announcement) Message() string { return a.message }
+
+// Level returns the announcement level.
+func (a *Announcement) Level() Level { return a.level }
+
+// StartsAt returns when the banner starts showing.
+func (a *Announcement) StartsAt() time.Time { return a.startsAt }
+
+// EndsAt returns when the banner stops showing.
+func (a *Announcement) EndsAt() time.Time { return a.endsAt }
+
+// CreatedBy returns the system admin who published the announcement.
+func (a *Announcement) CreatedBy() uuid.UUID { return a.createdBy }
+
+// CreatedAt returns the creation time.
+func (a *Announcement) CreatedAt() time.Time { return a.createdAt }
@@ -0,0 +1,99 @@
+package announcement_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/lllypuk/flowra/internal/domain/announcement"
+	"github.com/lllypuk/flowra/internal/domain/errs"
+	"github.com/lllypuk/flowra/internal/domain/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewAnnouncement(t *testing.T) {
+	adminID := uuid.NewUUID()
+	start := time.Now().Add(time.Hour)
+	end := start.Add(2 * time.Hour)
+
+	t.Run("successful creation", func(t *testing.T) {
+		a, err := announcement.NewAnnouncement(
+			"  Planned maintenance tonight  ", announcement.LevelMaintenance, start, end, adminID)
+
+		require.NoError(t, err)
+		assert.False(t, a.ID().IsZero())
+		assert.Equal(t, "Planned maintenance tonight", a.Message())
+		assert.Equal(t, announcement.LevelMaintenance, a.Level())
+		assert.Equal(t, start, a.StartsAt())
+		assert.Equal(t, end, a.EndsAt())
+		assert.Equal(t, adminID, a.CreatedBy())
+		assert.False(t, a.IsActive(time.Now()))
+		assert.True(t, a.IsActive(start))
+		assert.False(t, a.IsActive(end))
+	})
+
+	t.Run("zero start publishes immediately", func(t *testing.T) {
+		a, err := announcement.NewAnnouncement("Incident", announcement.LevelIncident, time.Time{}, end, adminID)
+
+		require.NoError(t, err)
+		assert.True(t, a.IsActive(time.Now()))
+	})
+
+	invalid := []struct {
+		name     string
+		message  string
+		level    announcement.Level
+		start    time.Time
+		end      time.Time
+		creator  uuid.UUID
+		expected error
+	}{
+		{"empty message", " ", announcement.LevelInfo, start, end, adminID, errs.ErrInvalidInput},
+		{
+			"message too long", strings.Repeat("a", announcement.MaxMessageLength+1),
+			announcement.LevelInfo, start, end, adminID, errs.ErrInvalidInput,
+		},
+		{"unknown level", "Hello", "banner", start, end, adminID, errs.ErrInvalidInput},
+		{"missing end", "Hello", announcement.LevelInfo, start, time.Time{}, adminID, errs.ErrInvalidInput},
+		{"end before start", "Hello", announcement.LevelInfo, end, start, adminID, errs.ErrInvalidInput},
+		{"missing creator", "Hello", announcement.LevelInfo, start, end, "", errs.ErrInvalidInput},
+	}
+	for _, tt := range invalid {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := announcement.NewAnnouncement(tt.message, tt.level, tt.start, tt.end, tt.creator)
+			require.ErrorIs(t, err, tt.expected)
+		})
+	}
+}
+
+func TestAnnouncement_End(t *testing.T) {
+	now := time.Now()
+
+	t.Run("ends an active announcement", func(t *testing.T) {
+		a, err := announcement.NewAnnouncement(
+			"Hello", announcement.LevelInfo, time.Time{}, now.Add(time.Hour), uuid.NewUUID())
+		require.NoError(t, err)
+
+		require.NoError(t, a.End(now))
+		assert.False(t, a.IsActive(now))
+		assert.Equal(t, now, a.EndsAt())
+	})
+
+	t.Run("ends a scheduled announcement", func(t *testing.T) {
+		a, err := announcement.NewAnnouncement(
+			"Hello", announcement.LevelInfo, now.Add(time.Hour), now.Add(2*time.Hour), uuid.NewUUID())
+		require.NoError(t, err)
+
+		require.NoError(t, a.End(now))
+		assert.Equal(t, now, a.StartsAt())
+		assert.Equal(t, now, a.EndsAt())
+	})
+
+	t.Run("already expired", func(t *testing.T) {
+		a := announcement.Reconstruct(uuid.NewUUID(), "Hello", announcement.LevelInfo,
+			now.Add(-2*time.Hour), now.Add(-time.Hour), uuid.NewUUID(), now.Add(-2*time.Hour))
+
+		require.ErrorIs(t, a.End(now), errs.ErrInvalidState)
+	})
+}
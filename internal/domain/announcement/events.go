@@ -0,0 +1,50 @@
+package announcement
+
+import (
+	"time"
+
+	"github.com/lllypuk/flowra/internal/domain/event"
+	"github.com/lllypuk/flowra/internal/domain/uuid"
+)
+
+// Event types
+const (
+	EventTypeAnnouncementPublished = "announcement.published"
+	EventTypeAnnouncementEnded     = "announcement.ended"
+)
+
+// aggregateType is the aggregate type of announcement events.
+const aggregateType = "Announcement"
+
+// Published is raised when a system admin publishes an announcement.
+type Published struct {
+	event.BaseEvent
+
+	Message  string
+	Level    Level
+	StartsAt time.Time
+	EndsAt   time.Time
+}
+
+// NewPublished creates a new Published event.
+func NewPublished(a *Announcement, metadata event.Metadata) *Published {
+	return &Published{
+		BaseEvent: event.NewBaseEvent(EventTypeAnnouncementPublished, a.ID().String(), aggregateType, 1, metadata),
+		Message:   a.Message(),
+		Level:     a.Level(),
+		StartsAt:  a.StartsAt(),
+		EndsAt:    a.EndsAt(),
+	}
+}
+
+// Ended is raised when a system admin takes an announcement down before it expires.
+type Ended struct {
+	event.BaseEvent
+}
+
+// NewEnded creates a new Ended event.
+func NewEnded(announcementID uuid.UUID, metadata event.Metadata) *Ended {
+	return &Ended{
+		BaseEvent: event.NewBaseEvent(EventTypeAnnouncementEnded, announcementID.String(), aggregateType, 2, metadata),
+	}
+}
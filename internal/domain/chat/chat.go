@@ -3,6 +3,7 @@ package chat
 import (
 	"errors"
 	"slices"
+	"strings"
 	"time"
 
 	"github.com/lllypuk/flowra/internal/domain/errs"
@@ -30,6 +31,19 @@ const (
 	StatusClosed = "Closed"
 )
 
+// MaxTopicLength bounds the chat topic
+const MaxTopicLength = 250
+
+// Priorities returns every priority a typed chat may have
+func Priorities() []string {
+	return []string{"Low", "Medium", "High", "Critical"}
+}
+
+// Severities returns every severity a Bug may have
+func Severities() []string {
+	return []string{"Minor", "Major", "Critical", "Blocker"}
+}
+
 // Chat represents the chat aggregate root with Event Sourcing
 type Chat struct {
 	id           uuid.UUID
@@ -39,6 +53,7 @@ type Chat struct {
 	createdBy    uuid.UUID
 	createdAt    time.Time
 	participants []Participant
+	topic        string // short description shown in the chat header, any chat type
 
 	// Fields for typed chats (Task/Bug/Epic)
 	title       string
@@ -47,7 +62,16 @@ type Chat struct {
 	assigneeID  *uuid.UUID
 	dueDate     *time.Time
 	severity    string // only for Bug
+	estimate    *Estimate
+	sprint      string
+	duplicateOf *uuid.UUID // the original this entity duplicates
+	epicID      *uuid.UUID // the parent epic, never set on an epic
 	attachments []Attachment
+	checklist   []ChecklistItem // ordered by position
+	slaClock    SLAClock        // only for Bug
+
+	// Type changes in order, including the initial one of chats created as Task/Bug/Epic
+	conversions []TypeConversion
 
 	// Soft delete
 	deleted   bool
@@ -65,6 +89,7 @@ func NewEmptyChat() *Chat {
 	return &Chat{
 		participants:      make([]Participant, 0),
 		attachments:       make([]Attachment, 0),
+		checklist:         make([]ChecklistItem, 0),
 		uncommittedEvents: make([]event.DomainEvent, 0),
 		version:           0,
 	}
@@ -93,6 +118,7 @@ func NewChat(
 	chat := &Chat{
 		participants:      make([]Participant, 0),
 		attachments:       make([]Attachment, 0),
+		checklist:         make([]ChecklistItem, 0),
 		uncommittedEvents: make([]event.DomainEvent, 0),
 		version:           0,
 	}
@@ -175,6 +201,33 @@ func (c *Chat) RemoveParticipant(userID uuid.UUID) error {
 	return nil
 }
 
+// TransferOwnership makes another participant the chat owner (creator).
+// The new owner is promoted to admin; the previous owner stays a participant.
+func (c *Chat) TransferOwnership(newOwnerID, transferredBy uuid.UUID) error {
+	if newOwnerID.IsZero() || transferredBy.IsZero() {
+		return errs.ErrInvalidInput
+	}
+	if !c.HasParticipant(newOwnerID) {
+		return errs.ErrNotFound
+	}
+	if newOwnerID == c.createdBy {
+		return nil
+	}
+
+	evt := NewOwnershipTransferred(
+		c.id,
+		c.createdBy,
+		newOwnerID,
+		transferredBy,
+		c.version+1,
+		event.Metadata{
+			UserID: transferredBy.String(),
+		},
+	)
+	c.applyEvent(evt)
+	return nil
+}
+
 // ConvertToTask converts Discussion to Task
 func (c *Chat) ConvertToTask(title string, userID uuid.UUID) error {
 	// Validation
@@ -196,9 +249,7 @@ func (c *Chat) ConvertToTask(title string, userID uuid.UUID) error {
 		title,
 		c.version+1,
 		event.Metadata{
-			CorrelationID: uuid.NewUUID().String(),
-			CausationID:   uuid.NewUUID().String(),
-			UserID:        userID.String(),
+			UserID: userID.String(),
 		},
 	)
 
@@ -226,9 +277,7 @@ func (c *Chat) ConvertToBug(title string, userID uuid.UUID) error {
 		title,
 		c.version+1,
 		event.Metadata{
-			CorrelationID: uuid.NewUUID().String(),
-			CausationID:   uuid.NewUUID().String(),
-			UserID:        userID.String(),
+			UserID: userID.String(),
 		},
 	)
 
@@ -255,9 +304,47 @@ func (c *Chat) ConvertToEpic(title string, userID uuid.UUID) error {
 		title,
 		c.version+1,
 		event.Metadata{
-			CorrelationID: uuid.NewUUID().String(),
-			CausationID:   uuid.NewUUID().String(),
-			UserID:        userID.String(),
+			UserID: userID.String(),
+		},
+	)
+
+	c.applyEvent(evt)
+	return nil
+}
+
+// ConvertToDiscussion reverts a Task/Bug/Epic back to a Discussion.
+// An open entity is closed first so the closing is recorded in its history;
+// the title is kept and the entity fields stay in the event history only.
+func (c *Chat) ConvertToDiscussion(userID uuid.UUID) error {
+	if c.chatType == TypeDiscussion {
+		return errs.ErrInvalidState
+	}
+	if userID.IsZero() {
+		return errs.ErrInvalidInput
+	}
+
+	if c.status != StatusClosed {
+		closed := NewChatClosed(
+			c.id,
+			userID,
+			c.status,
+			time.Now(),
+			c.version+1,
+			event.Metadata{
+				UserID: userID.String(),
+			},
+		)
+		c.applyEvent(closed)
+	}
+
+	evt := NewChatTypeChanged(
+		c.id,
+		c.chatType,
+		TypeDiscussion,
+		c.title,
+		c.version+1,
+		event.Metadata{
+			UserID: userID.String(),
 		},
 	)
 
@@ -293,9 +380,7 @@ func (c *Chat) ChangeStatus(newStatus string, userID uuid.UUID) error {
 		userID,
 		c.version+1,
 		event.Metadata{
-			CorrelationID: uuid.NewUUID().String(),
-			CausationID:   uuid.NewUUID().String(),
-			UserID:        userID.String(),
+			UserID: userID.String(),
 		},
 	)
 
@@ -321,9 +406,7 @@ func (c *Chat) AssignUser(assigneeID *uuid.UUID, userID uuid.UUID) error {
 			userID,
 			c.version+1,
 			event.Metadata{
-				CorrelationID: uuid.NewUUID().String(),
-				CausationID:   uuid.NewUUID().String(),
-				UserID:        userID.String(),
+				UserID: userID.String(),
 			},
 		)
 		c.applyEvent(evt)
@@ -342,9 +425,7 @@ func (c *Chat) AssignUser(assigneeID *uuid.UUID, userID uuid.UUID) error {
 		userID,
 		c.version+1,
 		event.Metadata{
-			CorrelationID: uuid.NewUUID().String(),
-			CausationID:   uuid.NewUUID().String(),
-			UserID:        userID.String(),
+			UserID: userID.String(),
 		},
 	)
 	c.applyEvent(evt)
@@ -374,9 +455,7 @@ func (c *Chat) SetPriority(priority string, userID uuid.UUID) error {
 		userID,
 		c.version+1,
 		event.Metadata{
-			CorrelationID: uuid.NewUUID().String(),
-			CausationID:   uuid.NewUUID().String(),
-			UserID:        userID.String(),
+			UserID: userID.String(),
 		},
 	)
 
@@ -402,9 +481,7 @@ func (c *Chat) SetDueDate(dueDate *time.Time, userID uuid.UUID) error {
 			userID,
 			c.version+1,
 			event.Metadata{
-				CorrelationID: uuid.NewUUID().String(),
-				CausationID:   uuid.NewUUID().String(),
-				UserID:        userID.String(),
+				UserID: userID.String(),
 			},
 		)
 		c.applyEvent(evt)
@@ -424,9 +501,7 @@ func (c *Chat) SetDueDate(dueDate *time.Time, userID uuid.UUID) error {
 		userID,
 		c.version+1,
 		event.Metadata{
-			CorrelationID: uuid.NewUUID().String(),
-			CausationID:   uuid.NewUUID().String(),
-			UserID:        userID.String(),
+			UserID: userID.String(),
 		},
 	)
 	c.applyEvent(evt)
@@ -469,9 +544,7 @@ func (c *Chat) AddAttachment(
 		addedBy,
 		c.version+1,
 		event.Metadata{
-			CorrelationID: uuid.NewUUID().String(),
-			CausationID:   uuid.NewUUID().String(),
-			UserID:        addedBy.String(),
+			UserID: addedBy.String(),
 		},
 	)
 	c.applyEvent(evt)
@@ -505,15 +578,125 @@ func (c *Chat) RemoveAttachment(fileID uuid.UUID, removedBy uuid.UUID) error {
 		removedBy,
 		c.version+1,
 		event.Metadata{
-			CorrelationID: uuid.NewUUID().String(),
-			CausationID:   uuid.NewUUID().String(),
-			UserID:        removedBy.String(),
+			UserID: removedBy.String(),
 		},
 	)
 	c.applyEvent(evt)
 	return nil
 }
 
+// AddChecklistItem appends an item to the end of the typed chat checklist.
+// Returns ID of the new item.
+func (c *Chat) AddChecklistItem(text string, addedBy uuid.UUID) (uuid.UUID, error) {
+	if c.chatType == TypeDiscussion {
+		return "", errs.ErrInvalidState
+	}
+	if addedBy.IsZero() {
+		return "", errs.ErrInvalidInput
+	}
+	if len(c.checklist) >= MaxChecklistItems {
+		return "", errs.ErrInvalidInput
+	}
+
+	item, err := NewChecklistItem(text, len(c.checklist))
+	if err != nil {
+		return "", err
+	}
+
+	evt := NewChecklistItemAdded(
+		c.id,
+		item.ID(),
+		item.Text(),
+		item.Position(),
+		addedBy,
+		c.version+1,
+		event.Metadata{
+			UserID: addedBy.String(),
+		},
+	)
+	c.applyEvent(evt)
+	return item.ID(), nil
+}
+
+// ToggleChecklistItem marks a checklist item as done or not done.
+func (c *Chat) ToggleChecklistItem(itemID uuid.UUID, done bool, toggledBy uuid.UUID) error {
+	if c.chatType == TypeDiscussion {
+		return errs.ErrInvalidState
+	}
+	if itemID.IsZero() || toggledBy.IsZero() {
+		return errs.ErrInvalidInput
+	}
+
+	index := c.checklistIndex(itemID)
+	if index < 0 {
+		return errs.ErrNotFound
+	}
+
+	// Idempotent: state is already the same.
+	if c.checklist[index].Done() == done {
+		return nil
+	}
+
+	evt := NewChecklistItemToggled(
+		c.id,
+		itemID,
+		done,
+		toggledBy,
+		c.version+1,
+		event.Metadata{
+			UserID: toggledBy.String(),
+		},
+	)
+	c.applyEvent(evt)
+	return nil
+}
+
+// ReorderChecklistItem moves a checklist item to the given position (0-based).
+// Positions of the other items are shifted accordingly.
+func (c *Chat) ReorderChecklistItem(itemID uuid.UUID, position int, reorderedBy uuid.UUID) error {
+	if c.chatType == TypeDiscussion {
+		return errs.ErrInvalidState
+	}
+	if itemID.IsZero() || reorderedBy.IsZero() {
+		return errs.ErrInvalidInput
+	}
+
+	index := c.checklistIndex(itemID)
+	if index < 0 {
+		return errs.ErrNotFound
+	}
+	if position < 0 || position >= len(c.checklist) {
+		return errs.ErrInvalidInput
+	}
+
+	// Idempotent: item is already at this position.
+	if index == position {
+		return nil
+	}
+
+	evt := NewChecklistItemReordered(
+		c.id,
+		itemID,
+		position,
+		reorderedBy,
+		c.version+1,
+		event.Metadata{
+			UserID: reorderedBy.String(),
+		},
+	)
+	c.applyEvent(evt)
+	return nil
+}
+
+func (c *Chat) checklistIndex(itemID uuid.UUID) int {
+	for i, item := range c.checklist {
+		if item.ID() == itemID {
+			return i
+		}
+	}
+	return -1
+}
+
 // Rename changes the chat title
 func (c *Chat) Rename(newTitle string, userID uuid.UUID) error {
 	if newTitle == "" {
@@ -533,9 +716,7 @@ func (c *Chat) Rename(newTitle string, userID uuid.UUID) error {
 		userID,
 		c.version+1,
 		event.Metadata{
-			CorrelationID: uuid.NewUUID().String(),
-			CausationID:   uuid.NewUUID().String(),
-			UserID:        userID.String(),
+			UserID: userID.String(),
 		},
 	)
 
@@ -630,9 +811,178 @@ func (c *Chat) SetSeverity(severity string, setBy uuid.UUID) error {
 		setBy,
 		c.version+1,
 		event.Metadata{
-			CorrelationID: uuid.NewUUID().String(),
-			CausationID:   uuid.NewUUID().String(),
-			UserID:        setBy.String(),
+			UserID: setBy.String(),
+		},
+	)
+
+	c.applyEvent(evt)
+	return nil
+}
+
+// SetEstimate sets or removes (nil) the estimate of a typed chat
+func (c *Chat) SetEstimate(estimate *Estimate, setBy uuid.UUID) error {
+	if c.chatType == TypeDiscussion {
+		return errs.ErrInvalidState
+	}
+
+	if estimate != nil {
+		if _, err := NewEstimate(estimate.Value, estimate.Unit); err != nil {
+			return err
+		}
+	}
+
+	if estimate == nil && c.estimate == nil {
+		return nil
+	}
+	if estimate != nil && c.estimate != nil && *estimate == *c.estimate {
+		return nil
+	}
+
+	var newEstimate *Estimate
+	if estimate != nil {
+		value := *estimate
+		newEstimate = &value
+	}
+
+	evt := NewEstimateSet(
+		c.id,
+		c.estimate,
+		newEstimate,
+		setBy,
+		c.version+1,
+		event.Metadata{
+			UserID: setBy.String(),
+		},
+	)
+
+	c.applyEvent(evt)
+	return nil
+}
+
+// SetSprint assigns a typed chat to a sprint; an empty name removes the assignment
+func (c *Chat) SetSprint(sprint string, setBy uuid.UUID) error {
+	if c.chatType == TypeDiscussion {
+		return errs.ErrInvalidState
+	}
+
+	sprint = strings.TrimSpace(sprint)
+	if len(sprint) > MaxSprintLength {
+		return errs.ErrInvalidInput
+	}
+
+	if c.sprint == sprint {
+		return nil
+	}
+
+	evt := NewSprintSet(
+		c.id,
+		c.sprint,
+		sprint,
+		setBy,
+		c.version+1,
+		event.Metadata{
+			UserID: setBy.String(),
+		},
+	)
+
+	c.applyEvent(evt)
+	return nil
+}
+
+// MarkDuplicate marks a typed chat as a duplicate of the original; nil clears the mark
+func (c *Chat) MarkDuplicate(originalID *uuid.UUID, markedBy uuid.UUID) error {
+	if c.chatType == TypeDiscussion {
+		return errs.ErrInvalidState
+	}
+	if originalID != nil && (originalID.IsZero() || *originalID == c.id) {
+		return errs.ErrInvalidInput
+	}
+
+	if originalID == nil && c.duplicateOf == nil {
+		return nil
+	}
+	if originalID != nil && c.duplicateOf != nil && *originalID == *c.duplicateOf {
+		return nil
+	}
+
+	var newOriginal *uuid.UUID
+	if originalID != nil {
+		id := *originalID
+		newOriginal = &id
+	}
+
+	evt := NewDuplicateMarked(
+		c.id,
+		c.duplicateOf,
+		newOriginal,
+		markedBy,
+		c.version+1,
+		event.Metadata{
+			UserID: markedBy.String(),
+		},
+	)
+
+	c.applyEvent(evt)
+	return nil
+}
+
+// SetEpic links a task or bug to its parent epic; nil unlinks it.
+// Epics and discussions cannot be children of an epic.
+func (c *Chat) SetEpic(epicID *uuid.UUID, setBy uuid.UUID) error {
+	if c.chatType == TypeDiscussion || (c.chatType == TypeEpic && epicID != nil) {
+		return errs.ErrInvalidState
+	}
+	if epicID != nil && (epicID.IsZero() || *epicID == c.id) {
+		return errs.ErrInvalidInput
+	}
+
+	if epicID == nil && c.epicID == nil {
+		return nil
+	}
+	if epicID != nil && c.epicID != nil && *epicID == *c.epicID {
+		return nil
+	}
+
+	var newEpic *uuid.UUID
+	if epicID != nil {
+		id := *epicID
+		newEpic = &id
+	}
+
+	evt := NewEpicSet(
+		c.id,
+		c.epicID,
+		newEpic,
+		setBy,
+		c.version+1,
+		event.Metadata{
+			UserID: setBy.String(),
+		},
+	)
+
+	c.applyEvent(evt)
+	return nil
+}
+
+// SetTopic changes the chat topic; an empty topic clears it
+func (c *Chat) SetTopic(topic string, setBy uuid.UUID) error {
+	topic = strings.TrimSpace(topic)
+	if len(topic) > MaxTopicLength {
+		return errs.ErrInvalidInput
+	}
+
+	if c.topic == topic {
+		return nil
+	}
+
+	evt := NewTopicSet(
+		c.id,
+		c.topic,
+		topic,
+		setBy,
+		c.version+1,
+		event.Metadata{
+			UserID: setBy.String(),
 		},
 	)
 
@@ -723,16 +1073,36 @@ func (c *Chat) Apply(e event.DomainEvent) error {
 		c.applyAttachmentAdded(evt)
 	case *AttachmentRemoved:
 		c.applyAttachmentRemoved(evt)
+	case *ChecklistItemAdded:
+		c.applyChecklistItemAdded(evt)
+	case *ChecklistItemToggled:
+		c.applyChecklistItemToggled(evt)
+	case *ChecklistItemReordered:
+		c.applyChecklistItemReordered(evt)
 	case *Renamed:
 		c.applyRenamed(evt)
 	case *SeveritySet:
 		c.applySeveritySet(evt)
+	case *EstimateSet:
+		c.applyEstimateSet(evt)
+	case *SprintSet:
+		c.applySprintSet(evt)
+	case *DuplicateMarked:
+		c.applyDuplicateMarked(evt)
+	case *EpicSet:
+		c.applyEpicSet(evt)
+	case *TopicSet:
+		c.applyTopicSet(evt)
 	case *Deleted:
 		c.applyDeleted(evt)
 	case *Closed:
 		c.applyClosed(evt)
 	case *Reopened:
 		c.applyReopened(evt)
+	case *OwnershipTransferred:
+		c.applyOwnershipTransferred(evt)
+	case *Snapshotted:
+		c.applySnapshotted(evt)
 	default:
 		// Update version for unknown events to maintain correct version tracking.
 		// This is essential for event sourcing: even if we don't understand an event,
@@ -749,6 +1119,9 @@ func (c *Chat) applyCreated(evt *Created) {
 	c.isPublic = evt.IsPublic
 	c.createdBy = evt.CreatedBy
 	c.createdAt = evt.CreatedAt
+	if evt.Type == TypeBug {
+		c.slaClock = SLAClock{openedAt: evt.CreatedAt}
+	}
 	c.version = evt.Version()
 }
 
@@ -771,20 +1144,38 @@ func (c *Chat) applyParticipantRemoved(evt *ParticipantRemoved) {
 }
 
 func (c *Chat) applyTypeChanged(evt *TypeChanged) {
+	// The converting user is only carried in the metadata
+	convertedBy, _ := uuid.ParseUUID(evt.Metadata().UserID)
+	c.conversions = append(c.conversions, TypeConversion{
+		fromType:    evt.OldType,
+		toType:      evt.NewType,
+		convertedBy: convertedBy,
+		convertedAt: evt.OccurredAt(),
+	})
 	c.chatType = evt.NewType
 	c.title = evt.Title
 	c.status = c.getDefaultStatus()
+	c.slaClock = SLAClock{}
+	if evt.NewType == TypeBug {
+		c.slaClock = SLAClock{openedAt: evt.OccurredAt()}
+	}
 	c.version = evt.Version()
 }
 
 func (c *Chat) applyStatusChanged(evt *StatusChanged) {
 	c.status = evt.NewStatus
+	if c.chatType == TypeBug {
+		c.slaClock.recordStatus(evt.NewStatus, evt.OccurredAt())
+	}
 	c.version = evt.Version()
 }
 
 func (c *Chat) applyUserAssigned(evt *UserAssigned) {
 	assigneeID := evt.AssigneeID
 	c.assigneeID = &assigneeID
+	if c.chatType == TypeBug {
+		c.slaClock.markResponded(evt.OccurredAt())
+	}
 	c.version = evt.Version()
 }
 
@@ -837,6 +1228,38 @@ func (c *Chat) applyAttachmentRemoved(evt *AttachmentRemoved) {
 	c.version = evt.Version()
 }
 
+func (c *Chat) applyChecklistItemAdded(evt *ChecklistItemAdded) {
+	if c.checklistIndex(evt.ItemID) >= 0 {
+		c.version = evt.Version()
+		return
+	}
+
+	c.checklist = append(c.checklist, ReconstructChecklistItem(evt.ItemID, evt.Text, false, len(c.checklist)))
+	c.version = evt.Version()
+}
+
+func (c *Chat) applyChecklistItemToggled(evt *ChecklistItemToggled) {
+	if index := c.checklistIndex(evt.ItemID); index >= 0 {
+		item := c.checklist[index]
+		c.checklist[index] = ReconstructChecklistItem(item.ID(), item.Text(), evt.Done, item.Position())
+	}
+	c.version = evt.Version()
+}
+
+func (c *Chat) applyChecklistItemReordered(evt *ChecklistItemReordered) {
+	index := c.checklistIndex(evt.ItemID)
+	if index >= 0 {
+		position := min(max(evt.Position, 0), len(c.checklist)-1)
+		item := c.checklist[index]
+		c.checklist = append(c.checklist[:index], c.checklist[index+1:]...)
+		c.checklist = append(c.checklist[:position], append([]ChecklistItem{item}, c.checklist[position:]...)...)
+		for i, it := range c.checklist {
+			c.checklist[i] = ReconstructChecklistItem(it.ID(), it.Text(), it.Done(), i)
+		}
+	}
+	c.version = evt.Version()
+}
+
 func (c *Chat) applyRenamed(evt *Renamed) {
 	c.title = evt.NewTitle
 	c.version = evt.Version()
@@ -847,6 +1270,43 @@ func (c *Chat) applySeveritySet(evt *SeveritySet) {
 	c.version = evt.Version()
 }
 
+func (c *Chat) applyEstimateSet(evt *EstimateSet) {
+	c.estimate = nil
+	if evt.NewEstimate != nil {
+		estimate := *evt.NewEstimate
+		c.estimate = &estimate
+	}
+	c.version = evt.Version()
+}
+
+func (c *Chat) applySprintSet(evt *SprintSet) {
+	c.sprint = evt.NewSprint
+	c.version = evt.Version()
+}
+
+func (c *Chat) applyDuplicateMarked(evt *DuplicateMarked) {
+	c.duplicateOf = nil
+	if evt.NewDuplicateOf != nil {
+		id := *evt.NewDuplicateOf
+		c.duplicateOf = &id
+	}
+	c.version = evt.Version()
+}
+
+func (c *Chat) applyEpicSet(evt *EpicSet) {
+	c.epicID = nil
+	if evt.NewEpicID != nil {
+		id := *evt.NewEpicID
+		c.epicID = &id
+	}
+	c.version = evt.Version()
+}
+
+func (c *Chat) applyTopicSet(evt *TopicSet) {
+	c.topic = evt.NewTopic
+	c.version = evt.Version()
+}
+
 func (c *Chat) applyDeleted(evt *Deleted) {
 	c.deleted = true
 	c.deletedAt = &evt.DeletedAt
@@ -857,11 +1317,27 @@ func (c *Chat) applyDeleted(evt *Deleted) {
 // Task 007a: Apply methods for Close/Reopen events
 func (c *Chat) applyClosed(evt *Closed) {
 	c.status = StatusClosed
+	if c.chatType == TypeBug {
+		c.slaClock.recordStatus(StatusClosed, evt.ClosedAt)
+	}
 	c.version = evt.Version()
 }
 
 func (c *Chat) applyReopened(evt *Reopened) {
 	c.status = evt.NewStatus
+	if c.chatType == TypeBug {
+		c.slaClock.recordStatus(evt.NewStatus, evt.ReopenedAt)
+	}
+	c.version = evt.Version()
+}
+
+func (c *Chat) applyOwnershipTransferred(evt *OwnershipTransferred) {
+	c.createdBy = evt.NewOwnerID
+	for i, p := range c.participants {
+		if p.UserID() == evt.NewOwnerID {
+			c.participants[i] = p.withRole(RoleAdmin)
+		}
+	}
 	c.version = evt.Version()
 }
 
@@ -934,6 +1410,13 @@ func (c *Chat) Participants() []Participant {
 	return participants
 }
 
+// ConversionHistory returns the type conversions of the chat, oldest first
+func (c *Chat) ConversionHistory() []TypeConversion {
+	conversions := make([]TypeConversion, len(c.conversions))
+	copy(conversions, c.conversions)
+	return conversions
+}
+
 // Version returns version aggregate for optimistic locking
 func (c *Chat) Version() int { return c.version }
 
@@ -955,6 +1438,42 @@ func (c *Chat) DueDate() *time.Time { return c.dueDate }
 // Severity returns severity for Bug
 func (c *Chat) Severity() string { return c.severity }
 
+// SLAClock returns the SLA clocks of a Bug; zero for other chat types
+func (c *Chat) SLAClock() SLAClock { return c.slaClock }
+
+// Estimate returns the estimate or nil when not estimated
+func (c *Chat) Estimate() *Estimate {
+	if c.estimate == nil {
+		return nil
+	}
+	estimate := *c.estimate
+	return &estimate
+}
+
+// Sprint returns the sprint the chat is assigned to
+func (c *Chat) Sprint() string { return c.sprint }
+
+// DuplicateOf returns the original this chat duplicates, nil when it is not a duplicate
+func (c *Chat) DuplicateOf() *uuid.UUID {
+	if c.duplicateOf == nil {
+		return nil
+	}
+	id := *c.duplicateOf
+	return &id
+}
+
+// EpicID returns the parent epic, nil when the chat is not linked to one
+func (c *Chat) EpicID() *uuid.UUID {
+	if c.epicID == nil {
+		return nil
+	}
+	id := *c.epicID
+	return &id
+}
+
+// Topic returns the chat topic
+func (c *Chat) Topic() string { return c.topic }
+
 // Attachments returns a copy of attached files.
 func (c *Chat) Attachments() []Attachment {
 	out := make([]Attachment, len(c.attachments))
@@ -962,6 +1481,33 @@ func (c *Chat) Attachments() []Attachment {
 	return out
 }
 
+// AttachmentsSize returns the total size of attached files in bytes.
+func (c *Chat) AttachmentsSize() int64 {
+	var total int64
+	for _, a := range c.attachments {
+		total += a.FileSize()
+	}
+	return total
+}
+
+// Checklist returns a copy of checklist items ordered by position.
+func (c *Chat) Checklist() []ChecklistItem {
+	out := make([]ChecklistItem, len(c.checklist))
+	copy(out, c.checklist)
+	return out
+}
+
+// ChecklistProgress returns the number of total and completed checklist items.
+func (c *Chat) ChecklistProgress() ChecklistProgress {
+	progress := ChecklistProgress{Total: len(c.checklist)}
+	for _, item := range c.checklist {
+		if item.Done() {
+			progress.Done++
+		}
+	}
+	return progress
+}
+
 // IsDeleted returns priznak removing
 func (c *Chat) IsDeleted() bool { return c.deleted }
 
@@ -999,9 +1545,7 @@ func (c *Chat) validateStatus(status string) error {
 
 // validatePriority validates priority
 func (c *Chat) validatePriority(priority string) error {
-	validPriorities := []string{"Low", "Medium", "High", "Critical"}
-
-	if slices.Contains(validPriorities, priority) {
+	if slices.Contains(Priorities(), priority) {
 		return nil
 	}
 
@@ -1010,9 +1554,7 @@ func (c *Chat) validatePriority(priority string) error {
 
 // validateSeverity validates severity for Bug
 func (c *Chat) validateSeverity(severity string) error {
-	validSeverities := []string{"Minor", "Major", "Critical", "Blocker"}
-
-	if slices.Contains(validSeverities, severity) {
+	if slices.Contains(Severities(), severity) {
 		return nil
 	}
 
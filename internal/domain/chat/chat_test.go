@@ -1,6 +1,7 @@
 package chat_test
 
 import (
+	"strings"
 	"testing"
 	"time"
 
@@ -124,6 +125,48 @@ func TestChat_RemoveParticipant(t *testing.T) {
 	})
 }
 
+func TestChat_TransferOwnership(t *testing.T) {
+	t.Run("successful transfer", func(t *testing.T) {
+		ownerID := uuid.NewUUID()
+		c, _ := chat.NewChat(uuid.NewUUID(), chat.TypeDiscussion, true, ownerID)
+		memberID := uuid.NewUUID()
+		require.NoError(t, c.AddParticipant(memberID, chat.RoleMember))
+		c.MarkEventsAsCommitted()
+
+		require.NoError(t, c.TransferOwnership(memberID, ownerID))
+
+		assert.Equal(t, memberID, c.CreatedBy())
+		assert.True(t, c.IsParticipantAdmin(memberID))
+		assert.True(t, c.HasParticipant(ownerID), "previous owner stays a participant")
+		require.NoError(t, c.RemoveParticipant(ownerID), "previous owner can now leave")
+
+		events := c.GetUncommittedEvents()
+		require.Len(t, events, 2)
+		evt, ok := events[0].(*chat.OwnershipTransferred)
+		require.True(t, ok)
+		assert.Equal(t, ownerID, evt.PreviousOwnerID)
+		assert.Equal(t, memberID, evt.NewOwnerID)
+	})
+
+	t.Run("target must be a participant", func(t *testing.T) {
+		ownerID := uuid.NewUUID()
+		c, _ := chat.NewChat(uuid.NewUUID(), chat.TypeDiscussion, true, ownerID)
+
+		err := c.TransferOwnership(uuid.NewUUID(), ownerID)
+		require.ErrorIs(t, err, errs.ErrNotFound)
+		assert.Equal(t, ownerID, c.CreatedBy())
+	})
+
+	t.Run("transfer to current owner is a no-op", func(t *testing.T) {
+		ownerID := uuid.NewUUID()
+		c, _ := chat.NewChat(uuid.NewUUID(), chat.TypeDiscussion, true, ownerID)
+		c.MarkEventsAsCommitted()
+
+		require.NoError(t, c.TransferOwnership(ownerID, ownerID))
+		assert.Empty(t, c.GetUncommittedEvents())
+	})
+}
+
 func TestChat_ConvertToTask(t *testing.T) {
 	t.Run("successful conversion to task", func(t *testing.T) {
 		c, _ := chat.NewChat(uuid.NewUUID(), chat.TypeDiscussion, true, uuid.NewUUID())
@@ -179,6 +222,79 @@ func TestChat_ConvertToTask(t *testing.T) {
 	})
 }
 
+func TestChat_ConvertToDiscussion(t *testing.T) {
+	t.Run("open task is closed and reverted", func(t *testing.T) {
+		c, _ := chat.NewChat(uuid.NewUUID(), chat.TypeDiscussion, true, uuid.NewUUID())
+		userID := uuid.NewUUID()
+		require.NoError(t, c.ConvertToTask("Implement feature", userID))
+		c.MarkEventsAsCommitted()
+
+		require.NoError(t, c.ConvertToDiscussion(userID))
+
+		assert.Equal(t, chat.TypeDiscussion, c.Type())
+		assert.Equal(t, "Implement feature", c.Title())
+		assert.Empty(t, c.Status())
+		assert.False(t, c.IsTyped())
+
+		events := c.GetUncommittedEvents()
+		require.Len(t, events, 2)
+		closed, ok := events[0].(*chat.Closed)
+		require.True(t, ok)
+		assert.Equal(t, "To Do", closed.PreviousStatus)
+		changed, ok := events[1].(*chat.TypeChanged)
+		require.True(t, ok)
+		assert.Equal(t, chat.TypeTask, changed.OldType)
+		assert.Equal(t, chat.TypeDiscussion, changed.NewType)
+	})
+
+	t.Run("closed bug is reverted without closing again", func(t *testing.T) {
+		c, _ := chat.NewChat(uuid.NewUUID(), chat.TypeDiscussion, true, uuid.NewUUID())
+		userID := uuid.NewUUID()
+		require.NoError(t, c.ConvertToBug("Fix bug", userID))
+		require.NoError(t, c.Close(userID))
+		c.MarkEventsAsCommitted()
+
+		require.NoError(t, c.ConvertToDiscussion(userID))
+
+		assert.Equal(t, chat.TypeDiscussion, c.Type())
+		require.Len(t, c.GetUncommittedEvents(), 1)
+	})
+
+	t.Run("already a discussion", func(t *testing.T) {
+		c, _ := chat.NewChat(uuid.NewUUID(), chat.TypeDiscussion, true, uuid.NewUUID())
+
+		err := c.ConvertToDiscussion(uuid.NewUUID())
+		require.ErrorIs(t, err, errs.ErrInvalidState)
+	})
+
+	t.Run("discussion can be converted again", func(t *testing.T) {
+		c, _ := chat.NewChat(uuid.NewUUID(), chat.TypeEpic, true, uuid.NewUUID())
+		userID := uuid.NewUUID()
+		require.NoError(t, c.ConvertToDiscussion(userID))
+
+		require.NoError(t, c.ConvertToTask("Again", userID))
+		assert.Equal(t, "To Do", c.Status())
+	})
+}
+
+func TestChat_ConversionHistory(t *testing.T) {
+	c, _ := chat.NewChat(uuid.NewUUID(), chat.TypeDiscussion, true, uuid.NewUUID())
+	assert.Empty(t, c.ConversionHistory())
+
+	userID, otherID := uuid.NewUUID(), uuid.NewUUID()
+	require.NoError(t, c.ConvertToTask("Task", userID))
+	require.NoError(t, c.ConvertToDiscussion(otherID))
+
+	history := c.ConversionHistory()
+	require.Len(t, history, 2)
+	assert.Equal(t, chat.TypeDiscussion, history[0].FromType())
+	assert.Equal(t, chat.TypeTask, history[0].ToType())
+	assert.Equal(t, userID, history[0].ConvertedBy())
+	assert.Equal(t, chat.TypeTask, history[1].FromType())
+	assert.Equal(t, chat.TypeDiscussion, history[1].ToType())
+	assert.Equal(t, otherID, history[1].ConvertedBy())
+}
+
 func TestChat_GetTaskEntityType(t *testing.T) {
 	t.Run("task type", func(t *testing.T) {
 		c, _ := chat.NewChat(uuid.NewUUID(), chat.TypeTask, true, uuid.NewUUID())
@@ -484,6 +600,241 @@ func TestChat_SetPriority(t *testing.T) {
 	})
 }
 
+func TestParseEstimate(t *testing.T) {
+	tests := []struct {
+		raw     string
+		want    chat.Estimate
+		wantErr bool
+	}{
+		{raw: "5", want: chat.Estimate{Value: 5, Unit: chat.EstimateUnitPoints}},
+		{raw: "3sp", want: chat.Estimate{Value: 3, Unit: chat.EstimateUnitPoints}},
+		{raw: "2.5pt", want: chat.Estimate{Value: 2.5, Unit: chat.EstimateUnitPoints}},
+		{raw: " 8H ", want: chat.Estimate{Value: 8, Unit: chat.EstimateUnitHours}},
+		{raw: "0", wantErr: true},
+		{raw: "-2h", wantErr: true},
+		{raw: "1001", wantErr: true},
+		{raw: "NaN", wantErr: true},
+		{raw: "five", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.raw, func(t *testing.T) {
+			got, err := chat.ParseEstimate(tt.raw)
+			if tt.wantErr {
+				require.ErrorIs(t, err, errs.ErrInvalidInput)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestChat_SetEstimate(t *testing.T) {
+	t.Run("set and remove estimate", func(t *testing.T) {
+		c := createTypedChat(t, chat.TypeTask, "Test")
+		userID := uuid.NewUUID()
+		estimate := chat.Estimate{Value: 5, Unit: chat.EstimateUnitPoints}
+
+		require.NoError(t, c.SetEstimate(&estimate, userID))
+		require.NotNil(t, c.Estimate())
+		assert.Equal(t, estimate, *c.Estimate())
+
+		require.NoError(t, c.SetEstimate(nil, userID))
+		assert.Nil(t, c.Estimate())
+
+		events := c.GetUncommittedEvents()
+		require.Len(t, events, 2)
+		assert.IsType(t, &chat.EstimateSet{}, events[0])
+	})
+
+	t.Run("same estimate is a no-op", func(t *testing.T) {
+		c := createTypedChat(t, chat.TypeTask, "Test")
+		userID := uuid.NewUUID()
+		estimate := chat.Estimate{Value: 8, Unit: chat.EstimateUnitHours}
+
+		require.NoError(t, c.SetEstimate(&estimate, userID))
+		c.MarkEventsAsCommitted()
+		require.NoError(t, c.SetEstimate(&estimate, userID))
+
+		assert.Empty(t, c.GetUncommittedEvents())
+	})
+
+	t.Run("cannot estimate discussion", func(t *testing.T) {
+		c, _ := chat.NewChat(uuid.NewUUID(), chat.TypeDiscussion, true, uuid.NewUUID())
+		estimate := chat.Estimate{Value: 1, Unit: chat.EstimateUnitPoints}
+
+		err := c.SetEstimate(&estimate, uuid.NewUUID())
+
+		require.ErrorIs(t, err, errs.ErrInvalidState)
+	})
+
+	t.Run("rejects invalid estimate", func(t *testing.T) {
+		c := createTypedChat(t, chat.TypeTask, "Test")
+		estimate := chat.Estimate{Value: 0, Unit: chat.EstimateUnitPoints}
+
+		err := c.SetEstimate(&estimate, uuid.NewUUID())
+
+		require.ErrorIs(t, err, errs.ErrInvalidInput)
+	})
+}
+
+func TestChat_SetSprint(t *testing.T) {
+	t.Run("set and remove sprint", func(t *testing.T) {
+		c := createTypedChat(t, chat.TypeBug, "Test")
+		userID := uuid.NewUUID()
+
+		require.NoError(t, c.SetSprint(" Sprint 3 ", userID))
+		assert.Equal(t, "Sprint 3", c.Sprint())
+
+		require.NoError(t, c.SetSprint("", userID))
+		assert.Empty(t, c.Sprint())
+
+		events := c.GetUncommittedEvents()
+		require.Len(t, events, 2)
+		assert.IsType(t, &chat.SprintSet{}, events[0])
+	})
+
+	t.Run("cannot assign discussion to sprint", func(t *testing.T) {
+		c, _ := chat.NewChat(uuid.NewUUID(), chat.TypeDiscussion, true, uuid.NewUUID())
+
+		err := c.SetSprint("Sprint 1", uuid.NewUUID())
+
+		require.ErrorIs(t, err, errs.ErrInvalidState)
+	})
+}
+
+func TestChat_MarkDuplicate(t *testing.T) {
+	t.Run("mark and clear duplicate", func(t *testing.T) {
+		c := createTypedChat(t, chat.TypeBug, "Test")
+		userID := uuid.NewUUID()
+		originalID := uuid.NewUUID()
+
+		require.NoError(t, c.MarkDuplicate(&originalID, userID))
+		require.NotNil(t, c.DuplicateOf())
+		assert.Equal(t, originalID, *c.DuplicateOf())
+
+		// same original produces no event
+		require.NoError(t, c.MarkDuplicate(&originalID, userID))
+
+		require.NoError(t, c.MarkDuplicate(nil, userID))
+		assert.Nil(t, c.DuplicateOf())
+
+		events := c.GetUncommittedEvents()
+		require.Len(t, events, 2)
+		marked, ok := events[0].(*chat.DuplicateMarked)
+		require.True(t, ok)
+		assert.Nil(t, marked.OldDuplicateOf)
+		assert.Equal(t, originalID, *marked.NewDuplicateOf)
+	})
+
+	t.Run("cannot duplicate itself", func(t *testing.T) {
+		c := createTypedChat(t, chat.TypeBug, "Test")
+		selfID := c.ID()
+
+		err := c.MarkDuplicate(&selfID, uuid.NewUUID())
+
+		require.ErrorIs(t, err, errs.ErrInvalidInput)
+		assert.Nil(t, c.DuplicateOf())
+	})
+
+	t.Run("discussion cannot be a duplicate", func(t *testing.T) {
+		c, _ := chat.NewChat(uuid.NewUUID(), chat.TypeDiscussion, true, uuid.NewUUID())
+		originalID := uuid.NewUUID()
+
+		err := c.MarkDuplicate(&originalID, uuid.NewUUID())
+
+		require.ErrorIs(t, err, errs.ErrInvalidState)
+	})
+}
+
+func TestChat_SetEpic(t *testing.T) {
+	t.Run("link and unlink epic", func(t *testing.T) {
+		c := createTypedChat(t, chat.TypeTask, "Test")
+		userID := uuid.NewUUID()
+		epicID := uuid.NewUUID()
+
+		require.NoError(t, c.SetEpic(&epicID, userID))
+		require.NotNil(t, c.EpicID())
+		assert.Equal(t, epicID, *c.EpicID())
+
+		// same epic produces no event
+		require.NoError(t, c.SetEpic(&epicID, userID))
+
+		require.NoError(t, c.SetEpic(nil, userID))
+		assert.Nil(t, c.EpicID())
+
+		events := c.GetUncommittedEvents()
+		require.Len(t, events, 2)
+		set, ok := events[0].(*chat.EpicSet)
+		require.True(t, ok)
+		assert.Nil(t, set.OldEpicID)
+		assert.Equal(t, epicID, *set.NewEpicID)
+	})
+
+	t.Run("epic cannot be a child", func(t *testing.T) {
+		c := createTypedChat(t, chat.TypeEpic, "Test")
+		epicID := uuid.NewUUID()
+
+		err := c.SetEpic(&epicID, uuid.NewUUID())
+
+		require.ErrorIs(t, err, errs.ErrInvalidState)
+		assert.Nil(t, c.EpicID())
+	})
+
+	t.Run("cannot be its own epic", func(t *testing.T) {
+		c := createTypedChat(t, chat.TypeBug, "Test")
+		selfID := c.ID()
+
+		err := c.SetEpic(&selfID, uuid.NewUUID())
+
+		require.ErrorIs(t, err, errs.ErrInvalidInput)
+	})
+
+	t.Run("discussion cannot be linked", func(t *testing.T) {
+		c, _ := chat.NewChat(uuid.NewUUID(), chat.TypeDiscussion, true, uuid.NewUUID())
+		epicID := uuid.NewUUID()
+
+		err := c.SetEpic(&epicID, uuid.NewUUID())
+
+		require.ErrorIs(t, err, errs.ErrInvalidState)
+	})
+}
+
+func TestChat_SetTopic(t *testing.T) {
+	t.Run("set and clear topic on discussion", func(t *testing.T) {
+		c, err := chat.NewChat(uuid.NewUUID(), chat.TypeDiscussion, true, uuid.NewUUID())
+		require.NoError(t, err)
+		userID := uuid.NewUUID()
+
+		require.NoError(t, c.SetTopic("  Release planning  ", userID))
+		assert.Equal(t, "Release planning", c.Topic())
+
+		// same topic produces no event
+		require.NoError(t, c.SetTopic("Release planning", userID))
+
+		require.NoError(t, c.SetTopic("", userID))
+		assert.Empty(t, c.Topic())
+
+		// Created and creator ParticipantAdded precede the two topic events
+		events := c.GetUncommittedEvents()
+		require.Len(t, events, 4)
+		topicSet, ok := events[2].(*chat.TopicSet)
+		require.True(t, ok)
+		assert.Equal(t, "Release planning", topicSet.NewTopic)
+		assert.Equal(t, userID, topicSet.ChangedBy)
+	})
+
+	t.Run("rejects too long topic", func(t *testing.T) {
+		c := createTypedChat(t, chat.TypeTask, "Test")
+
+		err := c.SetTopic(strings.Repeat("a", chat.MaxTopicLength+1), uuid.NewUUID())
+
+		require.ErrorIs(t, err, errs.ErrInvalidInput)
+		assert.Empty(t, c.Topic())
+	})
+}
+
 func TestChat_SetDueDate(t *testing.T) {
 	t.Run("set due date", func(t *testing.T) {
 		c := createTypedChat(t, chat.TypeTask, "Test")
@@ -682,6 +1033,106 @@ func TestChat_Attachments(t *testing.T) {
 	})
 }
 
+func TestChat_Checklist(t *testing.T) {
+	t.Run("add and toggle items", func(t *testing.T) {
+		c := createTypedChat(t, chat.TypeTask, "Test")
+		userID := uuid.NewUUID()
+
+		firstID, err := c.AddChecklistItem("  Write spec ", userID)
+		require.NoError(t, err)
+		_, err = c.AddChecklistItem("Implement", userID)
+		require.NoError(t, err)
+		require.NoError(t, c.ToggleChecklistItem(firstID, true, userID))
+
+		items := c.Checklist()
+		require.Len(t, items, 2)
+		assert.Equal(t, "Write spec", items[0].Text())
+		assert.True(t, items[0].Done())
+		assert.Equal(t, 1, items[1].Position())
+
+		progress := c.ChecklistProgress()
+		assert.Equal(t, chat.ChecklistProgress{Total: 2, Done: 1}, progress)
+		assert.Equal(t, 50, progress.Percent())
+
+		events := c.GetUncommittedEvents()
+		assert.IsType(t, &chat.ChecklistItemToggled{}, events[len(events)-1])
+	})
+
+	t.Run("toggle to same state is idempotent", func(t *testing.T) {
+		c := createTypedChat(t, chat.TypeTask, "Test")
+		userID := uuid.NewUUID()
+		itemID, err := c.AddChecklistItem("Write spec", userID)
+		require.NoError(t, err)
+		c.MarkEventsAsCommitted()
+
+		require.NoError(t, c.ToggleChecklistItem(itemID, false, userID))
+
+		assert.Empty(t, c.GetUncommittedEvents())
+	})
+
+	t.Run("reorder item", func(t *testing.T) {
+		c := createTypedChat(t, chat.TypeTask, "Test")
+		userID := uuid.NewUUID()
+		for _, text := range []string{"A", "B", "C"} {
+			_, err := c.AddChecklistItem(text, userID)
+			require.NoError(t, err)
+		}
+		lastID := c.Checklist()[2].ID()
+
+		require.NoError(t, c.ReorderChecklistItem(lastID, 0, userID))
+
+		items := c.Checklist()
+		assert.Equal(t, []string{"C", "A", "B"}, []string{items[0].Text(), items[1].Text(), items[2].Text()})
+		for i, item := range items {
+			assert.Equal(t, i, item.Position())
+		}
+		assert.ErrorIs(t, c.ReorderChecklistItem(lastID, 3, userID), errs.ErrInvalidInput)
+	})
+
+	t.Run("invalid input", func(t *testing.T) {
+		c := createTypedChat(t, chat.TypeTask, "Test")
+		userID := uuid.NewUUID()
+
+		_, err := c.AddChecklistItem("   ", userID)
+		require.ErrorIs(t, err, errs.ErrInvalidInput)
+		_, err = c.AddChecklistItem(strings.Repeat("a", chat.MaxChecklistItemLength+1), userID)
+		require.ErrorIs(t, err, errs.ErrInvalidInput)
+		require.ErrorIs(t, c.ToggleChecklistItem(uuid.NewUUID(), true, userID), errs.ErrNotFound)
+	})
+
+	t.Run("cannot add checklist item to discussion", func(t *testing.T) {
+		c, _ := chat.NewChat(uuid.NewUUID(), chat.TypeDiscussion, true, uuid.NewUUID())
+
+		_, err := c.AddChecklistItem("Write spec", uuid.NewUUID())
+
+		assert.ErrorIs(t, err, errs.ErrInvalidState)
+	})
+
+	t.Run("replay checklist events", func(t *testing.T) {
+		c := createTypedChat(t, chat.TypeTask, "Test")
+		userID := uuid.NewUUID()
+		firstID := uuid.NewUUID()
+		secondID := uuid.NewUUID()
+
+		events := []event.DomainEvent{
+			chat.NewChecklistItemAdded(c.ID(), firstID, "A", 0, userID, c.Version()+1, event.NewMetadata("", "", "")),
+			chat.NewChecklistItemAdded(c.ID(), secondID, "B", 1, userID, c.Version()+2, event.NewMetadata("", "", "")),
+			chat.NewChecklistItemToggled(c.ID(), firstID, true, userID, c.Version()+3, event.NewMetadata("", "", "")),
+			chat.NewChecklistItemReordered(c.ID(), secondID, 0, userID, c.Version()+4, event.NewMetadata("", "", "")),
+		}
+		for _, evt := range events {
+			require.NoError(t, c.Apply(evt))
+		}
+
+		items := c.Checklist()
+		require.Len(t, items, 2)
+		assert.Equal(t, secondID, items[0].ID())
+		assert.Equal(t, firstID, items[1].ID())
+		assert.True(t, items[1].Done())
+		assert.Equal(t, 1, items[1].Position())
+	})
+}
+
 func TestChat_EventSourcing_NewEvents(t *testing.T) {
 	t.Run("replay StatusChanged event", func(t *testing.T) {
 		c := createTypedChat(t, chat.TypeTask, "Test")
@@ -759,3 +1210,51 @@ func createTypedChat(t *testing.T, chatType chat.Type, title string) *chat.Chat
 	c.MarkEventsAsCommitted()
 	return c
 }
+
+func TestChat_SLAClock(t *testing.T) {
+	t.Run("not tracked for tasks", func(t *testing.T) {
+		c := createTypedChat(t, chat.TypeTask, "Test")
+
+		assert.True(t, c.SLAClock().IsZero())
+	})
+
+	t.Run("starts when chat becomes a bug", func(t *testing.T) {
+		c := createTypedChat(t, chat.TypeBug, "Test")
+
+		clock := c.SLAClock()
+		assert.False(t, clock.IsZero())
+		assert.Nil(t, clock.RespondedAt())
+		assert.Nil(t, clock.ResolvedAt())
+	})
+
+	t.Run("assignment stops response clock", func(t *testing.T) {
+		c := createTypedChat(t, chat.TypeBug, "Test")
+		assignee := uuid.NewUUID()
+
+		require.NoError(t, c.AssignUser(&assignee, uuid.NewUUID()))
+
+		assert.NotNil(t, c.SLAClock().RespondedAt())
+		assert.Nil(t, c.SLAClock().ResolvedAt())
+	})
+
+	t.Run("fix resolves and reopening restarts resolution", func(t *testing.T) {
+		c := createTypedChat(t, chat.TypeBug, "Test")
+		userID := uuid.NewUUID()
+
+		require.NoError(t, c.ChangeStatus("Fixed", userID))
+		assert.NotNil(t, c.SLAClock().RespondedAt())
+		require.NotNil(t, c.SLAClock().ResolvedAt())
+
+		require.NoError(t, c.ChangeStatus("Investigating", userID))
+		assert.NotNil(t, c.SLAClock().RespondedAt())
+		assert.Nil(t, c.SLAClock().ResolvedAt())
+	})
+
+	t.Run("closing resolves", func(t *testing.T) {
+		c := createTypedChat(t, chat.TypeBug, "Test")
+
+		require.NoError(t, c.Close(uuid.NewUUID()))
+
+		assert.NotNil(t, c.SLAClock().ResolvedAt())
+	})
+}
@@ -0,0 +1,71 @@
+package chat
+
+import (
+	"strings"
+
+	"github.com/lllypuk/flowra/internal/domain/errs"
+	"github.com/lllypuk/flowra/internal/domain/uuid"
+)
+
+const (
+	// MaxChecklistItemLength is the maximum length of a checklist item text.
+	MaxChecklistItemLength = 500
+	// MaxChecklistItems is the maximum number of items in one checklist.
+	MaxChecklistItems = 100
+
+	checklistPercentScale = 100
+)
+
+// ChecklistItem represents one item of a typed chat (task/bug/epic) checklist.
+type ChecklistItem struct {
+	id       uuid.UUID
+	text     string
+	done     bool
+	position int
+}
+
+// NewChecklistItem creates a validated checklist item.
+func NewChecklistItem(text string, position int) (ChecklistItem, error) {
+	text = strings.TrimSpace(text)
+	if text == "" || len([]rune(text)) > MaxChecklistItemLength {
+		return ChecklistItem{}, errs.ErrInvalidInput
+	}
+	if position < 0 {
+		return ChecklistItem{}, errs.ErrInvalidInput
+	}
+
+	return ChecklistItem{
+		id:       uuid.NewUUID(),
+		text:     text,
+		position: position,
+	}, nil
+}
+
+// ReconstructChecklistItem creates a checklist item from persisted event data.
+func ReconstructChecklistItem(id uuid.UUID, text string, done bool, position int) ChecklistItem {
+	return ChecklistItem{
+		id:       id,
+		text:     text,
+		done:     done,
+		position: position,
+	}
+}
+
+func (i ChecklistItem) ID() uuid.UUID { return i.id }
+func (i ChecklistItem) Text() string  { return i.text }
+func (i ChecklistItem) Done() bool    { return i.done }
+func (i ChecklistItem) Position() int { return i.position }
+
+// ChecklistProgress is the completion state of a checklist.
+type ChecklistProgress struct {
+	Total int
+	Done  int
+}
+
+// Percent returns completion percentage rounded down (0 for an empty checklist).
+func (p ChecklistProgress) Percent() int {
+	if p.Total == 0 {
+		return 0
+	}
+	return p.Done * checklistPercentScale / p.Total
+}
@@ -0,0 +1,95 @@
+package chat
+
+import "github.com/lllypuk/flowra/internal/domain/event"
+
+// SupersededEvents returns the versions of the events compaction may drop from a
+// chat stream:
+//   - a field change (title, priority, due date, severity, estimate, sprint, epic,
+//     duplicate, topic) followed by a later change of the same field
+//   - a checklist toggle or reorder followed by a later one of the same item
+//   - a status change that the next status change reverts (A→B→A), both of them
+//   - earlier snapshots, once there is something else to drop
+//
+// Creation, type changes, participants, ownership, assignment, attachments,
+// close/reopen and deletion are audit-relevant and always kept. The result is empty
+// if nothing but snapshots could be dropped.
+func SupersededEvents(events []event.DomainEvent) map[int]bool {
+	superseded := make(map[int]bool)
+	lastChange := make(map[string]int)
+	var snapshots []int
+	var pendingStatus *StatusChanged
+
+	for _, e := range events {
+		if key, ok := supersedingKey(e); ok {
+			if previous, seen := lastChange[key]; seen {
+				superseded[previous] = true
+			}
+			lastChange[key] = e.Version()
+			continue
+		}
+
+		switch evt := e.(type) {
+		case *StatusChanged:
+			if pendingStatus != nil &&
+				evt.OldStatus == pendingStatus.NewStatus && evt.NewStatus == pendingStatus.OldStatus {
+				superseded[pendingStatus.Version()] = true
+				superseded[evt.Version()] = true
+				pendingStatus = nil
+				continue
+			}
+			pendingStatus = evt
+		case *Closed, *Reopened, *TypeChanged:
+			pendingStatus = nil
+		case *Snapshotted:
+			snapshots = append(snapshots, evt.Version())
+		}
+	}
+
+	if len(superseded) == 0 {
+		return superseded
+	}
+
+	// the new snapshot takes the place of the last dropped event and replaces
+	// every snapshot before it
+	snapshotVersion := 0
+	for version := range superseded {
+		snapshotVersion = max(snapshotVersion, version)
+	}
+	for _, version := range snapshots {
+		if version < snapshotVersion {
+			superseded[version] = true
+		}
+	}
+	return superseded
+}
+
+// supersedingKey returns the field an event overwrites, if a later event of the
+// same key makes it irrelevant to the state.
+func supersedingKey(e event.DomainEvent) (string, bool) {
+	switch evt := e.(type) {
+	case *Renamed:
+		return "title", true
+	case *PrioritySet:
+		return "priority", true
+	case *DueDateSet, *DueDateRemoved:
+		return "due_date", true
+	case *SeveritySet:
+		return "severity", true
+	case *EstimateSet:
+		return "estimate", true
+	case *SprintSet:
+		return "sprint", true
+	case *EpicSet:
+		return "epic", true
+	case *DuplicateMarked:
+		return "duplicate_of", true
+	case *TopicSet:
+		return "topic", true
+	case *ChecklistItemToggled:
+		return "checklist_toggle:" + evt.ItemID.String(), true
+	case *ChecklistItemReordered:
+		return "checklist_order:" + evt.ItemID.String(), true
+	default:
+		return "", false
+	}
+}
@@ -0,0 +1,96 @@
+package chat_test
+
+import (
+	"testing"
+
+	"github.com/lllypuk/flowra/internal/domain/chat"
+	"github.com/lllypuk/flowra/internal/domain/event"
+	"github.com/lllypuk/flowra/internal/domain/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// noisyBug builds a bug with repeated renames, priority changes, a status flap
+// and checklist toggles.
+func noisyBug(t *testing.T) (*chat.Chat, []event.DomainEvent) {
+	t.Helper()
+	userID := uuid.NewUUID()
+
+	c, err := chat.NewChat(uuid.NewUUID(), chat.TypeDiscussion, true, userID)
+	require.NoError(t, err)
+	require.NoError(t, c.ConvertToBug("Crash", userID))
+	require.NoError(t, c.Rename("Crash on start", userID))
+	require.NoError(t, c.Rename("Crash on startup", userID))
+	require.NoError(t, c.SetPriority("High", userID))
+	require.NoError(t, c.SetPriority("Critical", userID))
+	require.NoError(t, c.ChangeStatus("Investigating", userID))
+	require.NoError(t, c.ChangeStatus("New", userID))
+	itemID, err := c.AddChecklistItem("Reproduce", userID)
+	require.NoError(t, err)
+	require.NoError(t, c.ToggleChecklistItem(itemID, true, userID))
+	require.NoError(t, c.ToggleChecklistItem(itemID, false, userID))
+	require.NoError(t, c.AssignUser(&userID, userID))
+	require.NoError(t, c.ChangeStatus("Fixed", userID))
+
+	return c, c.GetUncommittedEvents()
+}
+
+func versionsOf(events []event.DomainEvent, eventType string) []int {
+	var versions []int
+	for _, e := range events {
+		if e.EventType() == eventType {
+			versions = append(versions, e.Version())
+		}
+	}
+	return versions
+}
+
+func TestSupersededEvents(t *testing.T) {
+	_, events := noisyBug(t)
+
+	superseded := chat.SupersededEvents(events)
+
+	renames := versionsOf(events, chat.EventTypeChatRenamed)
+	priorities := versionsOf(events, chat.EventTypePrioritySet)
+	statuses := versionsOf(events, chat.EventTypeStatusChanged)
+	toggles := versionsOf(events, chat.EventTypeChecklistItemToggled)
+	require.Len(t, statuses, 3)
+
+	assert.Equal(t, map[int]bool{
+		renames[0]:    true,
+		priorities[0]: true,
+		statuses[0]:   true, // Investigating
+		statuses[1]:   true, // back to New
+		toggles[0]:    true,
+	}, superseded)
+}
+
+func TestSupersededEvents_NothingToDrop(t *testing.T) {
+	userID := uuid.NewUUID()
+	c, err := chat.NewChat(uuid.NewUUID(), chat.TypeTask, true, userID)
+	require.NoError(t, err)
+	require.NoError(t, c.Rename("Only once", userID))
+
+	assert.Empty(t, chat.SupersededEvents(c.GetUncommittedEvents()))
+}
+
+func TestSnapshotted_RestoresState(t *testing.T) {
+	c, events := noisyBug(t)
+	snapshot := chat.NewSnapshotted(c, c.Version(), event.Metadata{})
+
+	// events before a snapshot are overwritten by it
+	restored := chat.NewEmptyChat()
+	require.NoError(t, restored.Apply(events[0]))
+	require.NoError(t, restored.Apply(snapshot))
+
+	assert.Equal(t, c.SnapshotState(), restored.SnapshotState())
+	assert.Equal(t, c.Version(), restored.Version())
+	assert.Equal(t, "Crash on startup", restored.Title())
+	assert.Equal(t, "Fixed", restored.Status())
+	assert.False(t, restored.SLAClock().IsZero())
+	assert.True(t, restored.HasParticipant(c.CreatedBy()))
+
+	// later events apply on top of the snapshot
+	require.NoError(t, restored.Rename("Crash on cold startup", c.CreatedBy()))
+	assert.Equal(t, c.Version()+1, restored.Version())
+}
@@ -0,0 +1,27 @@
+package chat
+
+import (
+	"time"
+
+	"github.com/lllypuk/flowra/internal/domain/uuid"
+)
+
+// TypeConversion records a single change of the chat type (value object)
+type TypeConversion struct {
+	fromType    Type
+	toType      Type
+	convertedBy uuid.UUID
+	convertedAt time.Time
+}
+
+// FromType returns the type before the conversion
+func (tc TypeConversion) FromType() Type { return tc.fromType }
+
+// ToType returns the type after the conversion
+func (tc TypeConversion) ToType() Type { return tc.toType }
+
+// ConvertedBy returns the user who converted the chat (zero if unknown)
+func (tc TypeConversion) ConvertedBy() uuid.UUID { return tc.convertedBy }
+
+// ConvertedAt returns the time of the conversion
+func (tc TypeConversion) ConvertedAt() time.Time { return tc.convertedAt }
@@ -0,0 +1,101 @@
+package chat
+
+import (
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/lllypuk/flowra/internal/domain/errs"
+)
+
+// EstimateUnit is the unit of a task estimate
+type EstimateUnit string
+
+// Estimate units
+const (
+	EstimateUnitPoints EstimateUnit = "points"
+	EstimateUnitHours  EstimateUnit = "hours"
+)
+
+// Estimate bounds
+const (
+	maxEstimateValue = 1000
+	MaxSprintLength  = 100
+)
+
+// Estimate is the planned effort of a typed chat in story points or hours
+type Estimate struct {
+	Value float64      `json:"value" bson:"value"`
+	Unit  EstimateUnit `json:"unit"  bson:"unit"`
+}
+
+// NewEstimate creates an estimate; the value must be positive and at most 1000
+func NewEstimate(value float64, unit EstimateUnit) (Estimate, error) {
+	if unit != EstimateUnitPoints && unit != EstimateUnitHours {
+		return Estimate{}, errs.ErrInvalidInput
+	}
+	if !(value > 0 && value <= maxEstimateValue) { // also rejects NaN
+		return Estimate{}, errs.ErrInvalidInput
+	}
+	return Estimate{Value: value, Unit: unit}, nil
+}
+
+// ParseEstimate parses "5", "5pt", "5sp" (points) or "8h" (hours)
+func ParseEstimate(raw string) (Estimate, error) {
+	value := strings.ToLower(strings.TrimSpace(raw))
+	unit := EstimateUnitPoints
+
+	switch {
+	case strings.HasSuffix(value, "h"):
+		value = strings.TrimSuffix(value, "h")
+		unit = EstimateUnitHours
+	case strings.HasSuffix(value, "sp"):
+		value = strings.TrimSuffix(value, "sp")
+	case strings.HasSuffix(value, "pt"):
+		value = strings.TrimSuffix(value, "pt")
+	}
+
+	number, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+	if err != nil {
+		return Estimate{}, errs.ErrInvalidInput
+	}
+	return NewEstimate(number, unit)
+}
+
+// String formats the estimate, e.g. "5 points" or "8 hours"
+func (e Estimate) String() string {
+	return strconv.FormatFloat(e.Value, 'f', -1, 64) + " " + string(e.Unit)
+}
+
+// CompareSprintNames orders sprint names with embedded numbers numerically,
+// so "Sprint 9" comes before "Sprint 10".
+func CompareSprintNames(a, b string) int {
+	ca, cb := splitNumeric(strings.ToLower(a)), splitNumeric(strings.ToLower(b))
+	for i := 0; i < len(ca) && i < len(cb); i++ {
+		na, errA := strconv.Atoi(ca[i])
+		nb, errB := strconv.Atoi(cb[i])
+		switch {
+		case errA == nil && errB == nil && na != nb:
+			return na - nb
+		case ca[i] != cb[i]:
+			return strings.Compare(ca[i], cb[i])
+		}
+	}
+	return len(ca) - len(cb)
+}
+
+// splitNumeric splits a string into runs of digits and non-digits
+func splitNumeric(s string) []string {
+	var chunks []string
+	start := 0
+	for i, r := range s {
+		if i > start && unicode.IsDigit(r) != unicode.IsDigit(rune(s[i-1])) {
+			chunks = append(chunks, s[start:i])
+			start = i
+		}
+	}
+	if start < len(s) {
+		chunks = append(chunks, s[start:])
+	}
+	return chunks
+}
@@ -23,9 +23,26 @@ const (
 	EventTypeAttachmentRemoved  = "chat.attachment_removed"
 	EventTypeChatRenamed        = "chat.renamed"
 	EventTypeSeveritySet        = "chat.severity_set"
+	EventTypeEstimateSet        = "chat.estimate_set"
+	EventTypeSprintSet          = "chat.sprint_set"
+	EventTypeTopicSet           = "chat.topic_set"
+	EventTypeDuplicateMarked    = "chat.duplicate_marked"
+	EventTypeEpicSet            = "chat.epic_set"
 	EventTypeChatDeleted        = "chat.deleted"
 	EventTypeChatClosed         = "chat.closed"   // Task 007a
 	EventTypeChatReopened       = "chat.reopened" // Task 007a
+
+	EventTypeOwnershipTransferred = "chat.ownership_transferred"
+
+	EventTypeChecklistItemAdded     = "chat.checklist_item_added"
+	EventTypeChecklistItemToggled   = "chat.checklist_item_toggled"
+	EventTypeChecklistItemReordered = "chat.checklist_item_reordered"
+
+	// EventTypeChatSnapshotted is only written by event compaction
+	EventTypeChatSnapshotted = "chat.snapshotted"
+
+	// EventTypeSLABreached is published by the SLA monitor and not stored in the event store
+	EventTypeSLABreached = "chat.sla_breached"
 )
 
 // Created event creating chat
@@ -373,6 +390,105 @@ func NewAttachmentRemoved(
 	}
 }
 
+// ChecklistItemAdded event adding an item to the typed chat checklist.
+type ChecklistItemAdded struct {
+	event.BaseEvent `bson:",inline"`
+
+	ItemID   uuid.UUID `json:"item_id"  bson:"item_id"`
+	Text     string    `json:"text"     bson:"text"`
+	Position int       `json:"position" bson:"position"`
+	AddedBy  uuid.UUID `json:"added_by" bson:"added_by"`
+}
+
+// NewChecklistItemAdded creates event ChecklistItemAdded.
+func NewChecklistItemAdded(
+	chatID uuid.UUID,
+	itemID uuid.UUID,
+	text string,
+	position int,
+	addedBy uuid.UUID,
+	version int,
+	metadata event.Metadata,
+) *ChecklistItemAdded {
+	return &ChecklistItemAdded{
+		BaseEvent: event.NewBaseEvent(
+			EventTypeChecklistItemAdded,
+			chatID.String(),
+			"Chat",
+			version,
+			metadata,
+		),
+		ItemID:   itemID,
+		Text:     text,
+		Position: position,
+		AddedBy:  addedBy,
+	}
+}
+
+// ChecklistItemToggled event marking a checklist item as done or not done.
+type ChecklistItemToggled struct {
+	event.BaseEvent `bson:",inline"`
+
+	ItemID    uuid.UUID `json:"item_id"    bson:"item_id"`
+	Done      bool      `json:"done"       bson:"done"`
+	ToggledBy uuid.UUID `json:"toggled_by" bson:"toggled_by"`
+}
+
+// NewChecklistItemToggled creates event ChecklistItemToggled.
+func NewChecklistItemToggled(
+	chatID uuid.UUID,
+	itemID uuid.UUID,
+	done bool,
+	toggledBy uuid.UUID,
+	version int,
+	metadata event.Metadata,
+) *ChecklistItemToggled {
+	return &ChecklistItemToggled{
+		BaseEvent: event.NewBaseEvent(
+			EventTypeChecklistItemToggled,
+			chatID.String(),
+			"Chat",
+			version,
+			metadata,
+		),
+		ItemID:    itemID,
+		Done:      done,
+		ToggledBy: toggledBy,
+	}
+}
+
+// ChecklistItemReordered event moving a checklist item to a new position.
+type ChecklistItemReordered struct {
+	event.BaseEvent `bson:",inline"`
+
+	ItemID      uuid.UUID `json:"item_id"      bson:"item_id"`
+	Position    int       `json:"position"     bson:"position"`
+	ReorderedBy uuid.UUID `json:"reordered_by" bson:"reordered_by"`
+}
+
+// NewChecklistItemReordered creates event ChecklistItemReordered.
+func NewChecklistItemReordered(
+	chatID uuid.UUID,
+	itemID uuid.UUID,
+	position int,
+	reorderedBy uuid.UUID,
+	version int,
+	metadata event.Metadata,
+) *ChecklistItemReordered {
+	return &ChecklistItemReordered{
+		BaseEvent: event.NewBaseEvent(
+			EventTypeChecklistItemReordered,
+			chatID.String(),
+			"Chat",
+			version,
+			metadata,
+		),
+		ItemID:      itemID,
+		Position:    position,
+		ReorderedBy: reorderedBy,
+	}
+}
+
 // Renamed event pereimenovaniya chat
 type Renamed struct {
 	event.BaseEvent `bson:",inline"`
@@ -435,6 +551,161 @@ func NewSeveritySet(
 	}
 }
 
+// EstimateSet event setting or removing (nil NewEstimate) the estimate
+type EstimateSet struct {
+	event.BaseEvent `bson:",inline"`
+
+	OldEstimate *Estimate `json:"old_estimate,omitempty" bson:"old_estimate,omitempty"`
+	NewEstimate *Estimate `json:"new_estimate,omitempty" bson:"new_estimate,omitempty"`
+	ChangedBy   uuid.UUID `json:"changed_by"             bson:"changed_by"`
+}
+
+// NewEstimateSet creates event EstimateSet
+func NewEstimateSet(
+	chatID uuid.UUID,
+	oldEstimate, newEstimate *Estimate,
+	changedBy uuid.UUID,
+	version int,
+	metadata event.Metadata,
+) *EstimateSet {
+	return &EstimateSet{
+		BaseEvent: event.NewBaseEvent(
+			EventTypeEstimateSet,
+			chatID.String(),
+			"Chat",
+			version,
+			metadata,
+		),
+		OldEstimate: oldEstimate,
+		NewEstimate: newEstimate,
+		ChangedBy:   changedBy,
+	}
+}
+
+// SprintSet event assigning the chat to a sprint; an empty NewSprint removes it
+type SprintSet struct {
+	event.BaseEvent `bson:",inline"`
+
+	OldSprint string    `json:"old_sprint" bson:"old_sprint"`
+	NewSprint string    `json:"new_sprint" bson:"new_sprint"`
+	ChangedBy uuid.UUID `json:"changed_by" bson:"changed_by"`
+}
+
+// NewSprintSet creates event SprintSet
+func NewSprintSet(
+	chatID uuid.UUID,
+	oldSprint, newSprint string,
+	changedBy uuid.UUID,
+	version int,
+	metadata event.Metadata,
+) *SprintSet {
+	return &SprintSet{
+		BaseEvent: event.NewBaseEvent(
+			EventTypeSprintSet,
+			chatID.String(),
+			"Chat",
+			version,
+			metadata,
+		),
+		OldSprint: oldSprint,
+		NewSprint: newSprint,
+		ChangedBy: changedBy,
+	}
+}
+
+// TopicSet event changing the chat topic; an empty NewTopic clears it
+type TopicSet struct {
+	event.BaseEvent `bson:",inline"`
+
+	OldTopic  string    `json:"old_topic"  bson:"old_topic"`
+	NewTopic  string    `json:"new_topic"  bson:"new_topic"`
+	ChangedBy uuid.UUID `json:"changed_by" bson:"changed_by"`
+}
+
+// NewTopicSet creates event TopicSet
+func NewTopicSet(
+	chatID uuid.UUID,
+	oldTopic, newTopic string,
+	changedBy uuid.UUID,
+	version int,
+	metadata event.Metadata,
+) *TopicSet {
+	return &TopicSet{
+		BaseEvent: event.NewBaseEvent(
+			EventTypeTopicSet,
+			chatID.String(),
+			"Chat",
+			version,
+			metadata,
+		),
+		OldTopic:  oldTopic,
+		NewTopic:  newTopic,
+		ChangedBy: changedBy,
+	}
+}
+
+// DuplicateMarked event marking the chat as a duplicate; a nil NewDuplicateOf clears the mark
+type DuplicateMarked struct {
+	event.BaseEvent `bson:",inline"`
+
+	OldDuplicateOf *uuid.UUID `json:"old_duplicate_of,omitempty" bson:"old_duplicate_of,omitempty"`
+	NewDuplicateOf *uuid.UUID `json:"new_duplicate_of,omitempty" bson:"new_duplicate_of,omitempty"`
+	ChangedBy      uuid.UUID  `json:"changed_by"                 bson:"changed_by"`
+}
+
+// NewDuplicateMarked creates event DuplicateMarked
+func NewDuplicateMarked(
+	chatID uuid.UUID,
+	oldDuplicateOf, newDuplicateOf *uuid.UUID,
+	changedBy uuid.UUID,
+	version int,
+	metadata event.Metadata,
+) *DuplicateMarked {
+	return &DuplicateMarked{
+		BaseEvent: event.NewBaseEvent(
+			EventTypeDuplicateMarked,
+			chatID.String(),
+			"Chat",
+			version,
+			metadata,
+		),
+		OldDuplicateOf: oldDuplicateOf,
+		NewDuplicateOf: newDuplicateOf,
+		ChangedBy:      changedBy,
+	}
+}
+
+// EpicSet event linking the chat to a parent epic; a nil NewEpicID unlinks it
+type EpicSet struct {
+	event.BaseEvent `bson:",inline"`
+
+	OldEpicID *uuid.UUID `json:"old_epic_id,omitempty" bson:"old_epic_id,omitempty"`
+	NewEpicID *uuid.UUID `json:"new_epic_id,omitempty" bson:"new_epic_id,omitempty"`
+	ChangedBy uuid.UUID  `json:"changed_by"            bson:"changed_by"`
+}
+
+// NewEpicSet creates event EpicSet
+func NewEpicSet(
+	chatID uuid.UUID,
+	oldEpicID, newEpicID *uuid.UUID,
+	changedBy uuid.UUID,
+	version int,
+	metadata event.Metadata,
+) *EpicSet {
+	return &EpicSet{
+		BaseEvent: event.NewBaseEvent(
+			EventTypeEpicSet,
+			chatID.String(),
+			"Chat",
+			version,
+			metadata,
+		),
+		OldEpicID: oldEpicID,
+		NewEpicID: newEpicID,
+		ChangedBy: changedBy,
+	}
+}
+
 // Deleted event removing chat (soft delete)
 type Deleted struct {
 	event.BaseEvent `bson:",inline"`
@@ -526,3 +797,74 @@ func NewChatReopened(
 		ReopenedAt: reopenedAt,
 	}
 }
+
+// OwnershipTransferred event when the chat owner (creator) role moves to another participant
+type OwnershipTransferred struct {
+	event.BaseEvent `bson:",inline"`
+
+	PreviousOwnerID uuid.UUID `json:"previous_owner_id" bson:"previous_owner_id"`
+	NewOwnerID      uuid.UUID `json:"new_owner_id"      bson:"new_owner_id"`
+	TransferredBy   uuid.UUID `json:"transferred_by"    bson:"transferred_by"`
+}
+
+// NewOwnershipTransferred creates event OwnershipTransferred
+func NewOwnershipTransferred(
+	chatID, previousOwnerID, newOwnerID, transferredBy uuid.UUID,
+	version int,
+	metadata event.Metadata,
+) *OwnershipTransferred {
+	return &OwnershipTransferred{
+		BaseEvent: event.NewBaseEvent(
+			EventTypeOwnershipTransferred,
+			chatID.String(),
+			"Chat",
+			version,
+			metadata,
+		),
+		PreviousOwnerID: previousOwnerID,
+		NewOwnerID:      newOwnerID,
+		TransferredBy:   transferredBy,
+	}
+}
+
+// SLABreached event when an SLA clock of a bug runs past its target.
+// Published by the SLA monitor; it is not part of the chat event stream.
+type SLABreached struct {
+	event.BaseEvent `bson:",inline"`
+
+	WorkspaceID uuid.UUID  `json:"workspace_id"          bson:"workspace_id"`
+	Kind        string     `json:"kind"                  bson:"kind"` // SLAKindResponse or SLAKindResolution
+	Severity    string     `json:"severity"              bson:"severity"`
+	Title       string     `json:"title"                 bson:"title"`
+	DueAt       time.Time  `json:"due_at"                bson:"due_at"`
+	AssigneeID  *uuid.UUID `json:"assignee_id,omitempty" bson:"assignee_id,omitempty"`
+	CreatedBy   uuid.UUID  `json:"created_by"            bson:"created_by"`
+}
+
+// NewSLABreached creates event SLABreached
+func NewSLABreached(
+	chatID, workspaceID uuid.UUID,
+	kind, severity, title string,
+	dueAt time.Time,
+	assigneeID *uuid.UUID,
+	createdBy uuid.UUID,
+	version int,
+	metadata event.Metadata,
+) *SLABreached {
+	return &SLABreached{
+		BaseEvent: event.NewBaseEvent(
+			EventTypeSLABreached,
+			chatID.String(),
+			"Chat",
+			version,
+			metadata,
+		),
+		WorkspaceID: workspaceID,
+		Kind:        kind,
+		Severity:    severity,
+		Title:       title,
+		DueAt:       dueAt,
+		AssigneeID:  assigneeID,
+		CreatedBy:   createdBy,
+	}
+}
@@ -32,6 +32,12 @@ func NewParticipant(userID uuid.UUID, role Role) Participant {
 	}
 }
 
+// withRole returns a copy of the participant with another role
+func (p Participant) withRole(role Role) Participant {
+	p.role = role
+	return p
+}
+
 // UserID returns ID user
 func (p Participant) UserID() uuid.UUID { return p.userID }
 
@@ -0,0 +1,75 @@
+package chat
+
+import (
+	"time"
+)
+
+// Bug statuses that stop the resolution clock; closing the bug stops it as well
+const (
+	bugStatusNew      = "New"
+	bugStatusFixed    = "Fixed"
+	bugStatusVerified = "Verified"
+)
+
+// SLA clock kinds
+const (
+	SLAKindResponse   = "response"
+	SLAKindResolution = "resolution"
+)
+
+// SLAClock records when the SLA clocks of a bug started and stopped (value object).
+// Both clocks start when the chat becomes a bug. The response clock stops at the
+// first triage action (an assignment or a status change away from New), the
+// resolution clock when the bug is fixed, verified or closed. Reopening restarts
+// the resolution clock without resetting its start, so time spent resolved counts.
+type SLAClock struct {
+	openedAt    time.Time
+	respondedAt *time.Time
+	resolvedAt  *time.Time
+}
+
+// NewSLAClock restores a clock from its recorded times, e.g. from a read model
+func NewSLAClock(openedAt time.Time, respondedAt, resolvedAt *time.Time) SLAClock {
+	return SLAClock{
+		openedAt:    openedAt,
+		respondedAt: respondedAt,
+		resolvedAt:  resolvedAt,
+	}
+}
+
+// IsZero reports whether no clock is running, i.e. the chat is not a bug
+func (c SLAClock) IsZero() bool { return c.openedAt.IsZero() }
+
+// OpenedAt returns when the chat became a bug
+func (c SLAClock) OpenedAt() time.Time { return c.openedAt }
+
+// RespondedAt returns the first triage action, nil while awaiting a response
+func (c SLAClock) RespondedAt() *time.Time { return c.respondedAt }
+
+// ResolvedAt returns when the bug was resolved, nil while it is open
+func (c SLAClock) ResolvedAt() *time.Time { return c.resolvedAt }
+
+func (c *SLAClock) markResponded(at time.Time) {
+	if c.respondedAt == nil {
+		c.respondedAt = &at
+	}
+}
+
+func (c *SLAClock) markResolved(at time.Time) {
+	c.markResponded(at)
+	if c.resolvedAt == nil {
+		c.resolvedAt = &at
+	}
+}
+
+// recordStatus applies a bug status change to the clocks
+func (c *SLAClock) recordStatus(status string, at time.Time) {
+	if status != bugStatusNew {
+		c.markResponded(at)
+	}
+	if status == bugStatusFixed || status == bugStatusVerified || status == StatusClosed {
+		c.markResolved(at)
+		return
+	}
+	c.resolvedAt = nil
+}
@@ -0,0 +1,216 @@
+package chat
+
+import (
+	"time"
+
+	"github.com/lllypuk/flowra/internal/domain/event"
+	"github.com/lllypuk/flowra/internal/domain/uuid"
+)
+
+// Snapshotted event replacing the state rebuilt from the events before it.
+// Event compaction writes it in place of superseded events; events kept before
+// it stay in the stream for the audit trail only.
+type Snapshotted struct {
+	event.BaseEvent `bson:",inline"`
+
+	State SnapshotState `json:"state" bson:"state"`
+}
+
+// SnapshotState is the complete state of a chat aggregate
+type SnapshotState struct {
+	WorkspaceID  uuid.UUID             `json:"workspace_id"           bson:"workspace_id"`
+	Type         Type                  `json:"type"                   bson:"type"`
+	IsPublic     bool                  `json:"is_public"              bson:"is_public"`
+	CreatedBy    uuid.UUID             `json:"created_by"             bson:"created_by"`
+	CreatedAt    time.Time             `json:"created_at"             bson:"created_at"`
+	Topic        string                `json:"topic,omitempty"        bson:"topic,omitempty"`
+	Participants []SnapshotParticipant `json:"participants"           bson:"participants"`
+
+	Title       string                  `json:"title,omitempty"            bson:"title,omitempty"`
+	Status      string                  `json:"status,omitempty"           bson:"status,omitempty"`
+	Priority    string                  `json:"priority,omitempty"         bson:"priority,omitempty"`
+	AssigneeID  *uuid.UUID              `json:"assignee_id,omitempty"      bson:"assignee_id,omitempty"`
+	DueDate     *time.Time              `json:"due_date,omitempty"         bson:"due_date,omitempty"`
+	Severity    string                  `json:"severity,omitempty"         bson:"severity,omitempty"`
+	Estimate    *Estimate               `json:"estimate,omitempty"         bson:"estimate,omitempty"`
+	Sprint      string                  `json:"sprint,omitempty"           bson:"sprint,omitempty"`
+	DuplicateOf *uuid.UUID              `json:"duplicate_of,omitempty"     bson:"duplicate_of,omitempty"`
+	EpicID      *uuid.UUID              `json:"epic_id,omitempty"          bson:"epic_id,omitempty"`
+	Attachments []SnapshotAttachment    `json:"attachments"                bson:"attachments"`
+	Checklist   []SnapshotChecklistItem `json:"checklist"                  bson:"checklist"`
+	Conversions []SnapshotConversion    `json:"conversions"                bson:"conversions"`
+
+	SLAOpenedAt    *time.Time `json:"sla_opened_at,omitempty"    bson:"sla_opened_at,omitempty"`
+	SLARespondedAt *time.Time `json:"sla_responded_at,omitempty" bson:"sla_responded_at,omitempty"`
+	SLAResolvedAt  *time.Time `json:"sla_resolved_at,omitempty"  bson:"sla_resolved_at,omitempty"`
+
+	Deleted   bool       `json:"deleted,omitempty"    bson:"deleted,omitempty"`
+	DeletedAt *time.Time `json:"deleted_at,omitempty" bson:"deleted_at,omitempty"`
+	DeletedBy *uuid.UUID `json:"deleted_by,omitempty" bson:"deleted_by,omitempty"`
+}
+
+// SnapshotParticipant is a participant in a snapshot. Like a replayed
+// ParticipantAdded, it joins at the time the snapshot is applied.
+type SnapshotParticipant struct {
+	UserID uuid.UUID `json:"user_id" bson:"user_id"`
+	Role   Role      `json:"role"    bson:"role"`
+}
+
+// SnapshotAttachment is an attachment in a snapshot
+type SnapshotAttachment struct {
+	FileID   uuid.UUID `json:"file_id"   bson:"file_id"`
+	FileName string    `json:"file_name" bson:"file_name"`
+	FileSize int64     `json:"file_size" bson:"file_size"`
+	MimeType string    `json:"mime_type" bson:"mime_type"`
+}
+
+// SnapshotChecklistItem is a checklist item in a snapshot
+type SnapshotChecklistItem struct {
+	ID   uuid.UUID `json:"id"   bson:"id"`
+	Text string    `json:"text" bson:"text"`
+	Done bool      `json:"done" bson:"done"`
+}
+
+// SnapshotConversion is a type conversion in a snapshot
+type SnapshotConversion struct {
+	FromType    Type      `json:"from_type"    bson:"from_type"`
+	ToType      Type      `json:"to_type"      bson:"to_type"`
+	ConvertedBy uuid.UUID `json:"converted_by" bson:"converted_by"`
+	ConvertedAt time.Time `json:"converted_at" bson:"converted_at"`
+}
+
+// NewSnapshotted creates event Snapshotted holding the current state of the chat
+func NewSnapshotted(c *Chat, version int, metadata event.Metadata) *Snapshotted {
+	return &Snapshotted{
+		BaseEvent: event.NewBaseEvent(EventTypeChatSnapshotted, c.id.String(), "Chat", version, metadata),
+		State:     c.SnapshotState(),
+	}
+}
+
+// SnapshotState returns the complete state of the chat
+func (c *Chat) SnapshotState() SnapshotState {
+	state := SnapshotState{
+		WorkspaceID:  c.workspaceID,
+		Type:         c.chatType,
+		IsPublic:     c.isPublic,
+		CreatedBy:    c.createdBy,
+		CreatedAt:    c.createdAt,
+		Topic:        c.topic,
+		Participants: make([]SnapshotParticipant, 0, len(c.participants)),
+		Title:        c.title,
+		Status:       c.status,
+		Priority:     c.priority,
+		AssigneeID:   copyPtr(c.assigneeID),
+		DueDate:      copyPtr(c.dueDate),
+		Severity:     c.severity,
+		Estimate:     copyPtr(c.estimate),
+		Sprint:       c.sprint,
+		DuplicateOf:  copyPtr(c.duplicateOf),
+		EpicID:       copyPtr(c.epicID),
+		Attachments:  make([]SnapshotAttachment, 0, len(c.attachments)),
+		Checklist:    make([]SnapshotChecklistItem, 0, len(c.checklist)),
+		Conversions:  make([]SnapshotConversion, 0, len(c.conversions)),
+		Deleted:      c.deleted,
+		DeletedAt:    copyPtr(c.deletedAt),
+		DeletedBy:    copyPtr(c.deletedBy),
+	}
+
+	for _, p := range c.participants {
+		state.Participants = append(state.Participants, SnapshotParticipant{UserID: p.userID, Role: p.role})
+	}
+	for _, a := range c.attachments {
+		state.Attachments = append(state.Attachments, SnapshotAttachment{
+			FileID:   a.fileID,
+			FileName: a.fileName,
+			FileSize: a.fileSize,
+			MimeType: a.mimeType,
+		})
+	}
+	for _, item := range c.checklist {
+		state.Checklist = append(state.Checklist, SnapshotChecklistItem{
+			ID:   item.id,
+			Text: item.text,
+			Done: item.done,
+		})
+	}
+	for _, tc := range c.conversions {
+		state.Conversions = append(state.Conversions, SnapshotConversion{
+			FromType:    tc.fromType,
+			ToType:      tc.toType,
+			ConvertedBy: tc.convertedBy,
+			ConvertedAt: tc.convertedAt,
+		})
+	}
+
+	if !c.slaClock.IsZero() {
+		openedAt := c.slaClock.openedAt
+		state.SLAOpenedAt = &openedAt
+		state.SLARespondedAt = copyPtr(c.slaClock.respondedAt)
+		state.SLAResolvedAt = copyPtr(c.slaClock.resolvedAt)
+	}
+
+	return state
+}
+
+// applySnapshotted replaces the whole state; only the uncommitted events are kept
+func (c *Chat) applySnapshotted(evt *Snapshotted) {
+	state := evt.State
+
+	c.id = uuid.UUID(evt.AggregateID())
+	c.workspaceID = state.WorkspaceID
+	c.chatType = state.Type
+	c.isPublic = state.IsPublic
+	c.createdBy = state.CreatedBy
+	c.createdAt = state.CreatedAt
+	c.topic = state.Topic
+	c.title = state.Title
+	c.status = state.Status
+	c.priority = state.Priority
+	c.assigneeID = copyPtr(state.AssigneeID)
+	c.dueDate = copyPtr(state.DueDate)
+	c.severity = state.Severity
+	c.estimate = copyPtr(state.Estimate)
+	c.sprint = state.Sprint
+	c.duplicateOf = copyPtr(state.DuplicateOf)
+	c.epicID = copyPtr(state.EpicID)
+	c.deleted = state.Deleted
+	c.deletedAt = copyPtr(state.DeletedAt)
+	c.deletedBy = copyPtr(state.DeletedBy)
+
+	c.participants = make([]Participant, 0, len(state.Participants))
+	for _, p := range state.Participants {
+		c.addParticipantInternal(p.UserID, p.Role)
+	}
+	c.attachments = make([]Attachment, 0, len(state.Attachments))
+	for _, a := range state.Attachments {
+		c.attachments = append(c.attachments, ReconstructAttachment(a.FileID, a.FileName, a.FileSize, a.MimeType))
+	}
+	c.checklist = make([]ChecklistItem, 0, len(state.Checklist))
+	for i, item := range state.Checklist {
+		c.checklist = append(c.checklist, ReconstructChecklistItem(item.ID, item.Text, item.Done, i))
+	}
+	c.conversions = make([]TypeConversion, 0, len(state.Conversions))
+	for _, tc := range state.Conversions {
+		c.conversions = append(c.conversions, TypeConversion{
+			fromType:    tc.FromType,
+			toType:      tc.ToType,
+			convertedBy: tc.ConvertedBy,
+			convertedAt: tc.ConvertedAt,
+		})
+	}
+
+	c.slaClock = SLAClock{}
+	if state.SLAOpenedAt != nil {
+		c.slaClock = NewSLAClock(*state.SLAOpenedAt, copyPtr(state.SLARespondedAt), copyPtr(state.SLAResolvedAt))
+	}
+
+	c.version = evt.Version()
+}
+
+func copyPtr[T any](v *T) *T {
+	if v == nil {
+		return nil
+	}
+	c := *v
+	return &c
+}
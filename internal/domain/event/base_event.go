@@ -53,3 +53,9 @@ func (e BaseEvent) Version() int {
 func (e BaseEvent) Metadata() Metadata {
 	return e.EventMetadata
 }
+
+// SetMetadata replaces the event metadata.
+// Used by the application layer to attach request tracing data before events are persisted.
+func (e *BaseEvent) SetMetadata(metadata Metadata) {
+	e.EventMetadata = metadata
+}
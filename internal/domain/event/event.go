@@ -31,3 +31,9 @@ type Bus interface {
 	// Publish publishes an event
 	Publish(ctx context.Context, event DomainEvent) error
 }
+
+// MetadataSetter is implemented by events whose metadata can be enriched after creation.
+// Pointer events embedding BaseEvent satisfy it.
+type MetadataSetter interface {
+	SetMetadata(metadata Metadata)
+}
@@ -0,0 +1,68 @@
+// Package inbox models the personal quick-capture inbox where users jot down
+// task ideas before deciding which workspace they belong to.
+package inbox
+
+import (
+	"strings"
+	"time"
+
+	"github.com/lllypuk/flowra/internal/domain/errs"
+	"github.com/lllypuk/flowra/internal/domain/uuid"
+)
+
+// MaxTitleLength limits the captured title; it matches the task title limit
+// so that every item can be promoted without truncation.
+const MaxTitleLength = 200
+
+// Item is a task idea captured by a user. It belongs to the user only and
+// is not bound to a workspace until it is promoted to a chat or task.
+type Item struct {
+	id        uuid.UUID
+	userID    uuid.UUID
+	title     string
+	createdAt time.Time
+}
+
+// NewItem captures a new inbox item for the user.
+func NewItem(userID uuid.UUID, title string) (*Item, error) {
+	title = strings.TrimSpace(title)
+	if title == "" || len([]rune(title)) > MaxTitleLength {
+		return nil, errs.ErrInvalidInput
+	}
+	if userID.IsZero() {
+		return nil, errs.ErrInvalidInput
+	}
+
+	return &Item{
+		id:        uuid.NewUUID(),
+		userID:    userID,
+		title:     title,
+		createdAt: time.Now(),
+	}, nil
+}
+
+// Reconstruct reconstructs an inbox item from storage.
+// Used by repositories for hydration without validating business rules.
+func Reconstruct(id, userID uuid.UUID, title string, createdAt time.Time) *Item {
+	return &Item{
+		id:        id,
+		userID:    userID,
+		title:     title,
+		createdAt: createdAt,
+	}
+}
+
+// IsOwnedBy reports whether the item belongs to the user.
+func (i *Item) IsOwnedBy(userID uuid.UUID) bool { return i.userID == userID }
+
+// ID returns the item ID.
+func (i *Item) ID() uuid.UUID { return i.id }
+
+// UserID returns the user who captured the item.
+func (i *Item) UserID() uuid.UUID { return i.userID }
+
+// Title returns the captured title.
+func (i *Item) Title() string { return i.title }
+
+// CreatedAt returns the capture time.
+func (i *Item) CreatedAt() time.Time { return i.createdAt }
@@ -0,0 +1,45 @@
+package inbox_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/lllypuk/flowra/internal/domain/errs"
+	"github.com/lllypuk/flowra/internal/domain/inbox"
+	"github.com/lllypuk/flowra/internal/domain/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewItem(t *testing.T) {
+	userID := uuid.NewUUID()
+
+	t.Run("successful capture", func(t *testing.T) {
+		item, err := inbox.NewItem(userID, "  Draft release notes  ")
+
+		require.NoError(t, err)
+		assert.False(t, item.ID().IsZero())
+		assert.Equal(t, "Draft release notes", item.Title())
+		assert.Equal(t, userID, item.UserID())
+		assert.True(t, item.IsOwnedBy(userID))
+		assert.False(t, item.IsOwnedBy(uuid.NewUUID()))
+		assert.False(t, item.CreatedAt().IsZero())
+	})
+
+	invalid := []struct {
+		name   string
+		userID uuid.UUID
+		title  string
+	}{
+		{"empty title", userID, "  "},
+		{"title too long", userID, strings.Repeat("a", inbox.MaxTitleLength+1)},
+		{"missing user", "", "Draft release notes"},
+	}
+	for _, tt := range invalid {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := inbox.NewItem(tt.userID, tt.title)
+
+			require.ErrorIs(t, err, errs.ErrInvalidInput)
+		})
+	}
+}
@@ -1,16 +1,60 @@
 package message
 
 import (
+	"strings"
+	"time"
+
 	"github.com/lllypuk/flowra/internal/domain/errs"
 	"github.com/lllypuk/flowra/internal/domain/uuid"
 )
 
+const (
+	// MaxAudioDuration is the maximum length of an audio attachment
+	MaxAudioDuration = 15 * time.Minute
+	// MaxWaveformSamples is the maximum number of waveform samples of an audio attachment
+	MaxWaveformSamples = 256
+	// MaxWaveformLevel is the level of the loudest waveform sample
+	MaxWaveformLevel = 100
+)
+
+// PreviewStatus is the state of the preview of an attachment.
+type PreviewStatus string
+
+const (
+	// PreviewNone marks attachments that get no preview
+	PreviewNone PreviewStatus = ""
+	// PreviewPending marks attachments waiting for the worker to generate their preview
+	PreviewPending PreviewStatus = "pending"
+	// PreviewReady marks attachments whose preview is stored next to the file
+	PreviewReady PreviewStatus = "ready"
+	// PreviewFailed marks attachments whose preview could not be generated
+	PreviewFailed PreviewStatus = "failed"
+)
+
+// SupportsPreview reports whether previews are generated for files of the MIME type:
+// images, scaled down, and PDFs, from their first page.
+func SupportsPreview(mimeType string) bool {
+	switch mimeType {
+	case "image/jpeg", "image/png", "image/gif", "application/pdf":
+		return true
+	default:
+		return false
+	}
+}
+
 // Attachment represents a file attachment to a message.
+// Audio attachments, e.g. voice messages, also carry their duration and waveform.
+// Images and PDFs carry the state and size of their preview.
 type Attachment struct {
-	fileID   uuid.UUID
-	fileName string
-	fileSize int64
-	mimeType string
+	fileID        uuid.UUID
+	fileName      string
+	fileSize      int64
+	mimeType      string
+	duration      time.Duration
+	waveform      []int
+	previewStatus PreviewStatus
+	previewWidth  int
+	previewHeight int
 }
 
 // NewAttachment creates new attachment
@@ -28,12 +72,50 @@ func NewAttachment(fileID uuid.UUID, fileName string, fileSize int64, mimeType s
 		return Attachment{}, errs.ErrInvalidInput
 	}
 
-	return Attachment{
+	attachment := Attachment{
 		fileID:   fileID,
 		fileName: fileName,
 		fileSize: fileSize,
 		mimeType: mimeType,
-	}, nil
+	}
+	if SupportsPreview(mimeType) {
+		attachment.previewStatus = PreviewPending
+	}
+	return attachment, nil
+}
+
+// NewAudioAttachment creates new audio attachment with the duration of the recording
+// and its waveform, a list of levels from 0 to MaxWaveformLevel; the waveform is optional
+func NewAudioAttachment(
+	fileID uuid.UUID,
+	fileName string,
+	fileSize int64,
+	mimeType string,
+	duration time.Duration,
+	waveform []int,
+) (Attachment, error) {
+	attachment, err := NewAttachment(fileID, fileName, fileSize, mimeType)
+	if err != nil {
+		return Attachment{}, err
+	}
+	if !attachment.IsAudio() {
+		return Attachment{}, errs.ErrInvalidInput
+	}
+	if duration <= 0 || duration > MaxAudioDuration {
+		return Attachment{}, errs.ErrInvalidInput
+	}
+	if len(waveform) > MaxWaveformSamples {
+		return Attachment{}, errs.ErrInvalidInput
+	}
+	for _, level := range waveform {
+		if level < 0 || level > MaxWaveformLevel {
+			return Attachment{}, errs.ErrInvalidInput
+		}
+	}
+
+	attachment.duration = duration
+	attachment.waveform = append([]int(nil), waveform...)
+	return attachment, nil
 }
 
 // FileID returns the file ID.
@@ -56,6 +138,48 @@ func (a Attachment) MimeType() string {
 	return a.mimeType
 }
 
+// IsAudio reports whether the attachment is an audio file.
+func (a Attachment) IsAudio() bool {
+	return strings.HasPrefix(a.mimeType, "audio/")
+}
+
+// Duration returns the length of an audio attachment, zero if it is unknown.
+func (a Attachment) Duration() time.Duration {
+	return a.duration
+}
+
+// Waveform returns a copy of the waveform of an audio attachment, nil if it has none.
+func (a Attachment) Waveform() []int {
+	if a.waveform == nil {
+		return nil
+	}
+	return append([]int(nil), a.waveform...)
+}
+
+// PreviewStatus returns the state of the preview of the attachment.
+func (a Attachment) PreviewStatus() PreviewStatus {
+	return a.previewStatus
+}
+
+// HasPreview reports whether the preview of the attachment is ready.
+func (a Attachment) HasPreview() bool {
+	return a.previewStatus == PreviewReady
+}
+
+// PreviewSize returns the width and height of the preview in pixels, zero if it is not ready.
+func (a Attachment) PreviewSize() (int, int) {
+	return a.previewWidth, a.previewHeight
+}
+
+// WithPreview returns a copy of the attachment with the given preview state and size.
+// Used by repositories for object hydration.
+func (a Attachment) WithPreview(status PreviewStatus, width, height int) Attachment {
+	a.previewStatus = status
+	a.previewWidth = width
+	a.previewHeight = height
+	return a
+}
+
 // ReconstructAttachment reconstructs attachment from storage.
 // Used by repositories for object hydration without business rules validation.
 func ReconstructAttachment(fileID uuid.UUID, fileName string, fileSize int64, mimeType string) Attachment {
@@ -66,3 +190,19 @@ func ReconstructAttachment(fileID uuid.UUID, fileName string, fileSize int64, mi
 		mimeType: mimeType,
 	}
 }
+
+// ReconstructAudioAttachment reconstructs audio attachment from storage.
+// Used by repositories for object hydration without business rules validation.
+func ReconstructAudioAttachment(
+	fileID uuid.UUID,
+	fileName string,
+	fileSize int64,
+	mimeType string,
+	duration time.Duration,
+	waveform []int,
+) Attachment {
+	attachment := ReconstructAttachment(fileID, fileName, fileSize, mimeType)
+	attachment.duration = duration
+	attachment.waveform = waveform
+	return attachment
+}
@@ -15,12 +15,22 @@ const (
 	EventTypeMessageEdited = "message.edited"
 	// EventTypeMessageDeleted event removing messages
 	EventTypeMessageDeleted = "message.deleted"
+	// EventTypeMessageRestored event undoing the deletion of a message
+	EventTypeMessageRestored = "message.restored"
 	// EventTypeMessageReactionAdded event add reaktsii
 	EventTypeMessageReactionAdded = "message.reaction.added"
 	// EventTypeMessageReactionRemoved event removing reaktsii
 	EventTypeMessageReactionRemoved = "message.reaction.removed"
 	// EventTypeMessageAttachmentAdded event add vlozheniya
 	EventTypeMessageAttachmentAdded = "message.attachment.added"
+	// EventTypeMessageForwarded event forwarding a message into another chat
+	EventTypeMessageForwarded = "message.forwarded"
+	// EventTypePollVoted event golosa in poll
+	EventTypePollVoted = "message.poll.voted"
+	// EventTypePollVoteRetracted event otzyva golosa
+	EventTypePollVoteRetracted = "message.poll.vote_retracted"
+	// EventTypePollClosed event closing poll
+	EventTypePollClosed = "message.poll.closed"
 )
 
 // Created event creating messages
@@ -31,6 +41,7 @@ type Created struct {
 	AuthorID        uuid.UUID
 	Content         string
 	ParentMessageID uuid.UUID
+	QuotedMessageID uuid.UUID // set when the message quotes another message
 	CreatedAt       time.Time
 }
 
@@ -65,6 +76,9 @@ func (e *Created) Payload() json.RawMessage {
 	if !e.ParentMessageID.IsZero() {
 		payload["parent_message_id"] = e.ParentMessageID.String()
 	}
+	if !e.QuotedMessageID.IsZero() {
+		payload["quoted_message_id"] = e.QuotedMessageID.String()
+	}
 	data, _ := json.Marshal(payload)
 	return data
 }
@@ -103,6 +117,35 @@ func NewDeleted(messageID uuid.UUID, deletedBy uuid.UUID, version int, metadata
 	}
 }
 
+// Restored event undoing the deletion of a message.
+// It compensates an earlier Deleted event within the undo window.
+type Restored struct {
+	event.BaseEvent
+
+	ChatID     uuid.UUID
+	Content    string // restored content, lets projections rebuild previews
+	RestoredBy uuid.UUID
+	RestoredAt time.Time
+}
+
+// NewRestored creates event Restored
+func NewRestored(
+	messageID uuid.UUID,
+	chatID uuid.UUID,
+	content string,
+	restoredBy uuid.UUID,
+	version int,
+	metadata event.Metadata,
+) *Restored {
+	return &Restored{
+		BaseEvent:  event.NewBaseEvent(EventTypeMessageRestored, messageID.String(), "Message", version, metadata),
+		ChatID:     chatID,
+		Content:    content,
+		RestoredBy: restoredBy,
+		RestoredAt: time.Now(),
+	}
+}
+
 // ReactionAdded event add reaktsii
 type ReactionAdded struct {
 	event.BaseEvent
@@ -167,6 +210,8 @@ type AttachmentAdded struct {
 	FileName string
 	FileSize int64
 	MimeType string
+	Duration time.Duration // audio attachments only
+	Waveform []int         // audio attachments only
 	AddedAt  time.Time
 }
 
@@ -195,3 +240,152 @@ func NewAttachmentAdded(
 		AddedAt:  time.Now(),
 	}
 }
+
+// Forwarded event forwarding a message into another chat.
+// The aggregate is the new (forwarded) message.
+type Forwarded struct {
+	event.BaseEvent
+
+	ChatID          uuid.UUID
+	SourceMessageID uuid.UUID
+	SourceChatID    uuid.UUID
+	ForwardedBy     uuid.UUID
+	ForwardedAt     time.Time
+}
+
+// NewForwarded creates event Forwarded
+func NewForwarded(
+	messageID uuid.UUID,
+	chatID uuid.UUID,
+	sourceMessageID uuid.UUID,
+	sourceChatID uuid.UUID,
+	forwardedBy uuid.UUID,
+	metadata event.Metadata,
+) *Forwarded {
+	return &Forwarded{
+		BaseEvent:       event.NewBaseEvent(EventTypeMessageForwarded, messageID.String(), "Message", 1, metadata),
+		ChatID:          chatID,
+		SourceMessageID: sourceMessageID,
+		SourceChatID:    sourceChatID,
+		ForwardedBy:     forwardedBy,
+		ForwardedAt:     time.Now(),
+	}
+}
+
+// PollVoted event golosa in poll.
+// VoterID is zero for anonymous polls; Results carry the tallies after the vote.
+type PollVoted struct {
+	event.BaseEvent
+
+	ChatID     uuid.UUID
+	VoterID    uuid.UUID
+	OptionID   uuid.UUID
+	Results    []PollResult
+	TotalVotes int
+	VotedAt    time.Time
+}
+
+// NewPollVoted creates event PollVoted
+func NewPollVoted(
+	messageID uuid.UUID,
+	chatID uuid.UUID,
+	voterID uuid.UUID,
+	optionID uuid.UUID,
+	poll *Poll,
+	metadata event.Metadata,
+) *PollVoted {
+	evt := &PollVoted{
+		BaseEvent:  event.NewBaseEvent(EventTypePollVoted, messageID.String(), "Message", 1, pollMetadata(poll, metadata)),
+		ChatID:     chatID,
+		VoterID:    voterID,
+		OptionID:   optionID,
+		Results:    poll.Results(),
+		TotalVotes: poll.TotalVotes(),
+		VotedAt:    time.Now(),
+	}
+	if poll.IsAnonymous() {
+		evt.VoterID = ""
+		evt.OptionID = ""
+	}
+	return evt
+}
+
+// PollVoteRetracted event otzyva golosa.
+// VoterID is zero for anonymous polls.
+type PollVoteRetracted struct {
+	event.BaseEvent
+
+	ChatID      uuid.UUID
+	VoterID     uuid.UUID
+	Results     []PollResult
+	TotalVotes  int
+	RetractedAt time.Time
+}
+
+// NewPollVoteRetracted creates event PollVoteRetracted
+func NewPollVoteRetracted(
+	messageID uuid.UUID,
+	chatID uuid.UUID,
+	voterID uuid.UUID,
+	poll *Poll,
+	metadata event.Metadata,
+) *PollVoteRetracted {
+	evt := &PollVoteRetracted{
+		BaseEvent: event.NewBaseEvent(
+			EventTypePollVoteRetracted,
+			messageID.String(),
+			"Message",
+			1,
+			pollMetadata(poll, metadata),
+		),
+		ChatID:      chatID,
+		VoterID:     voterID,
+		Results:     poll.Results(),
+		TotalVotes:  poll.TotalVotes(),
+		RetractedAt: time.Now(),
+	}
+	if poll.IsAnonymous() {
+		evt.VoterID = ""
+	}
+	return evt
+}
+
+// PollClosed event closing poll, carries the final results
+type PollClosed struct {
+	event.BaseEvent
+
+	ChatID     uuid.UUID
+	ClosedBy   uuid.UUID
+	Results    []PollResult
+	TotalVotes int
+	ClosedAt   time.Time
+}
+
+// NewPollClosed creates event PollClosed
+func NewPollClosed(
+	messageID uuid.UUID,
+	chatID uuid.UUID,
+	closedBy uuid.UUID,
+	poll *Poll,
+	metadata event.Metadata,
+) *PollClosed {
+	return &PollClosed{
+		BaseEvent:  event.NewBaseEvent(EventTypePollClosed, messageID.String(), "Message", 1, metadata),
+		ChatID:     chatID,
+		ClosedBy:   closedBy,
+		Results:    poll.Results(),
+		TotalVotes: poll.TotalVotes(),
+		ClosedAt:   time.Now(),
+	}
+}
+
+// pollMetadata strips the voter from the metadata of anonymous polls,
+// since events are broadcast to every chat participant.
+func pollMetadata(poll *Poll, metadata event.Metadata) event.Metadata {
+	if poll.IsAnonymous() {
+		metadata.UserID = ""
+		metadata.IPAddress = ""
+		metadata.UserAgent = ""
+	}
+	return metadata
+}
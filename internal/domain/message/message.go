@@ -1,6 +1,7 @@
 package message
 
 import (
+	"errors"
 	"time"
 
 	"github.com/lllypuk/flowra/internal/domain/errs"
@@ -17,23 +18,32 @@ const (
 	TypeSystem Type = "system"
 	// TypeBot is a response from the bot (tag processing results)
 	TypeBot Type = "bot"
+	// TypePoll is a poll created by a user, the content holds the question
+	TypePoll Type = "poll"
 )
 
+// ErrUndoWindowExpired is returned when a delete or edit is undone after the undo window.
+var ErrUndoWindowExpired = errors.New("undo window has expired")
+
 // Message represents message in chate
 type Message struct {
 	id              uuid.UUID
 	chatID          uuid.UUID
 	authorID        uuid.UUID
 	content         string
+	previousContent string     // content before the last edit, kept so the edit can be reverted
 	msgType         Type       // message type
 	actorID         *uuid.UUID // who initiated (for system messages)
 	parentMessageID uuid.UUID  // for tredov
+	quotedMessageID uuid.UUID  // inline quote of another message in the same chat
 	createdAt       time.Time
 	editedAt        *time.Time
 	isDeleted       bool
 	deletedAt       *time.Time
+	purgedAt        *time.Time // content removed after the retention window, only the tombstone is left
 	attachments     []Attachment
 	reactions       []Reaction
+	poll            *Poll // set for TypePoll messages
 }
 
 // NewMessage creates new message (defaults to TypeUser)
@@ -85,6 +95,39 @@ func NewMessageWithType(
 	}, nil
 }
 
+// NewImportedMessage creates a user message migrated from another system, keeping
+// its original creation time
+func NewImportedMessage(
+	chatID uuid.UUID,
+	authorID uuid.UUID,
+	content string,
+	parentMessageID uuid.UUID,
+	createdAt time.Time,
+) (*Message, error) {
+	if createdAt.IsZero() {
+		return nil, errs.ErrInvalidInput
+	}
+	msg, err := NewMessage(chatID, authorID, content, parentMessageID)
+	if err != nil {
+		return nil, err
+	}
+	msg.createdAt = createdAt
+	return msg, nil
+}
+
+// NewPollMessage creates a poll message; the question becomes the message content
+func NewPollMessage(chatID uuid.UUID, authorID uuid.UUID, poll *Poll) (*Message, error) {
+	if poll == nil {
+		return nil, errs.ErrInvalidInput
+	}
+	msg, err := NewMessageWithType(chatID, authorID, poll.Question(), "", TypePoll, nil)
+	if err != nil {
+		return nil, err
+	}
+	msg.poll = poll
+	return msg, nil
+}
+
 // Reconstruct reconstructs message from save.
 // Used by repositories for hydration obekta without validation business rules.
 // all parameters dolzhny byt valid values from save.
@@ -93,15 +136,19 @@ func Reconstruct(
 	chatID uuid.UUID,
 	authorID uuid.UUID,
 	content string,
+	previousContent string,
 	parentMessageID uuid.UUID,
 	createdAt time.Time,
 	editedAt *time.Time,
 	isDeleted bool,
 	deletedAt *time.Time,
+	purgedAt *time.Time,
 	attachments []Attachment,
 	reactions []Reaction,
 	msgType Type,
 	actorID *uuid.UUID,
+	quotedMessageID uuid.UUID,
+	poll *Poll,
 ) *Message {
 	if attachments == nil {
 		attachments = make([]Attachment, 0)
@@ -119,15 +166,19 @@ func Reconstruct(
 		chatID:          chatID,
 		authorID:        authorID,
 		content:         content,
+		previousContent: previousContent,
 		msgType:         msgType,
 		actorID:         actorID,
 		parentMessageID: parentMessageID,
+		quotedMessageID: quotedMessageID,
 		createdAt:       createdAt,
 		editedAt:        editedAt,
 		isDeleted:       isDeleted,
 		deletedAt:       deletedAt,
+		purgedAt:        purgedAt,
 		attachments:     attachments,
 		reactions:       reactions,
+		poll:            poll,
 	}
 }
 
@@ -143,12 +194,33 @@ func (m *Message) EditContent(newContent string, editorID uuid.UUID) error {
 		return errs.ErrForbidden
 	}
 
+	m.previousContent = m.content
 	m.content = newContent
 	now := time.Now()
 	m.editedAt = &now
 	return nil
 }
 
+// RevertEdit restores the content from before the last edit.
+// Only the author can revert, and only within window after the edit.
+func (m *Message) RevertEdit(editorID uuid.UUID, window time.Duration) error {
+	if m.isDeleted || m.editedAt == nil || m.previousContent == "" {
+		return errs.ErrInvalidState
+	}
+	if !m.CanBeEditedBy(editorID) {
+		return errs.ErrForbidden
+	}
+	if time.Since(*m.editedAt) > window {
+		return ErrUndoWindowExpired
+	}
+
+	m.content = m.previousContent
+	m.previousContent = ""
+	now := time.Now()
+	m.editedAt = &now
+	return nil
+}
+
 // Delete myagko udalyaet message
 func (m *Message) Delete(deleterID uuid.UUID) error {
 	if m.isDeleted {
@@ -164,6 +236,58 @@ func (m *Message) Delete(deleterID uuid.UUID) error {
 	return nil
 }
 
+// Restore undoes the deletion of a message.
+// Only the author can restore, and only within window after the deletion.
+func (m *Message) Restore(restorerID uuid.UUID, window time.Duration) error {
+	if !m.isDeleted || m.purgedAt != nil {
+		return errs.ErrInvalidState
+	}
+	if !m.CanBeEditedBy(restorerID) {
+		return errs.ErrForbidden
+	}
+	if m.deletedAt == nil || time.Since(*m.deletedAt) > window {
+		return ErrUndoWindowExpired
+	}
+
+	m.isDeleted = false
+	m.deletedAt = nil
+	return nil
+}
+
+// Purge removes the content, attachments, reactions and poll of a deleted message,
+// leaving a tombstone. Until then moderators can still see the original content.
+func (m *Message) Purge() error {
+	if !m.isDeleted || m.purgedAt != nil {
+		return errs.ErrInvalidState
+	}
+
+	m.content = ""
+	m.previousContent = ""
+	m.attachments = make([]Attachment, 0)
+	m.reactions = make([]Reaction, 0)
+	m.poll = nil
+	now := time.Now()
+	m.purgedAt = &now
+	return nil
+}
+
+// Quote makes the message reference another message of the same chat inline.
+// Unlike a thread reply the message stays in the main chat flow.
+func (m *Message) Quote(quoted *Message) error {
+	if m.isDeleted {
+		return errs.ErrInvalidState
+	}
+	if quoted == nil || quoted.id == m.id {
+		return errs.ErrInvalidInput
+	}
+	if quoted.chatID != m.chatID {
+		return errs.ErrInvalidInput
+	}
+
+	m.quotedMessageID = quoted.id
+	return nil
+}
+
 // AddReaction adds reaction
 func (m *Message) AddReaction(userID uuid.UUID, emojiCode string) error {
 	if m.isDeleted {
@@ -198,6 +322,42 @@ func (m *Message) RemoveReaction(userID uuid.UUID, emojiCode string) error {
 	return nil
 }
 
+// Vote records the choice of the user in the poll, replacing the previous one
+func (m *Message) Vote(userID uuid.UUID, optionID uuid.UUID) error {
+	if m.isDeleted {
+		return errs.ErrInvalidState
+	}
+	if m.poll == nil {
+		return errs.ErrInvalidState
+	}
+	return m.poll.vote(userID, optionID)
+}
+
+// RetractVote removes the choice of the user from the poll
+func (m *Message) RetractVote(userID uuid.UUID) error {
+	if m.isDeleted {
+		return errs.ErrInvalidState
+	}
+	if m.poll == nil {
+		return errs.ErrInvalidState
+	}
+	return m.poll.retract(userID)
+}
+
+// ClosePoll stops the voting. Only the author can close the poll.
+func (m *Message) ClosePoll(userID uuid.UUID) error {
+	if m.isDeleted {
+		return errs.ErrInvalidState
+	}
+	if m.poll == nil {
+		return errs.ErrInvalidState
+	}
+	if !m.CanBeEditedBy(userID) {
+		return errs.ErrForbidden
+	}
+	return m.poll.close()
+}
+
 // AddAttachment adds attachment
 func (m *Message) AddAttachment(fileID uuid.UUID, fileName string, fileSize int64, mimeType string) error {
 	if m.isDeleted {
@@ -213,6 +373,28 @@ func (m *Message) AddAttachment(fileID uuid.UUID, fileName string, fileSize int6
 	return nil
 }
 
+// AddAudioAttachment adds audio attachment, e.g. a voice message
+func (m *Message) AddAudioAttachment(
+	fileID uuid.UUID,
+	fileName string,
+	fileSize int64,
+	mimeType string,
+	duration time.Duration,
+	waveform []int,
+) error {
+	if m.isDeleted {
+		return errs.ErrInvalidState
+	}
+
+	attachment, err := NewAudioAttachment(fileID, fileName, fileSize, mimeType, duration, waveform)
+	if err != nil {
+		return err
+	}
+
+	m.attachments = append(m.attachments, attachment)
+	return nil
+}
+
 // HasReaction checks presence reaktsii ot user
 func (m *Message) HasReaction(userID uuid.UUID, emojiCode string) bool {
 	for _, r := range m.reactions {
@@ -271,11 +453,26 @@ func (m *Message) Content() string {
 	return m.content
 }
 
+// PreviousContent returns the content from before the last edit (empty if none)
+func (m *Message) PreviousContent() string {
+	return m.previousContent
+}
+
 // ParentMessageID returns ID roditelskogo messages (for tredov)
 func (m *Message) ParentMessageID() uuid.UUID {
 	return m.parentMessageID
 }
 
+// QuotedMessageID returns ID of the quoted message (zero if none)
+func (m *Message) QuotedMessageID() uuid.UUID {
+	return m.quotedMessageID
+}
+
+// HasQuote checks if message quotes another message
+func (m *Message) HasQuote() bool {
+	return !m.quotedMessageID.IsZero()
+}
+
 // CreatedAt returns creation time
 func (m *Message) CreatedAt() time.Time {
 	return m.createdAt
@@ -296,6 +493,16 @@ func (m *Message) DeletedAt() *time.Time {
 	return m.deletedAt
 }
 
+// IsPurged checks if the content of the deleted message has been purged
+func (m *Message) IsPurged() bool {
+	return m.purgedAt != nil
+}
+
+// PurgedAt returns the time the content was purged
+func (m *Message) PurgedAt() *time.Time {
+	return m.purgedAt
+}
+
 // Attachments returns kopiyu list vlozheniy
 func (m *Message) Attachments() []Attachment {
 	attachments := make([]Attachment, len(m.attachments))
@@ -325,6 +532,16 @@ func (m *Message) IsSystemMessage() bool {
 	return m.msgType == TypeSystem
 }
 
+// Poll returns the poll of the message (nil if the message is not a poll)
+func (m *Message) Poll() *Poll {
+	return m.poll
+}
+
+// HasPoll checks if the message is a poll
+func (m *Message) HasPoll() bool {
+	return m.poll != nil
+}
+
 // IsBotMessage returns true if this is a bot-generated message
 func (m *Message) IsBotMessage() bool {
 	return m.msgType == TypeBot
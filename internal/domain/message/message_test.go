@@ -2,6 +2,7 @@ package message_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/lllypuk/flowra/internal/domain/errs"
 	"github.com/lllypuk/flowra/internal/domain/message"
@@ -86,6 +87,33 @@ func TestNewMessage(t *testing.T) {
 	})
 }
 
+//nolint:errorlint // Direct error comparison is acceptable in tests
+func TestNewImportedMessage(t *testing.T) {
+	t.Run("keeps the original time", func(t *testing.T) {
+		createdAt := time.Date(2021, 3, 4, 5, 6, 7, 0, time.UTC)
+
+		msg, err := message.NewImportedMessage(uuid.NewUUID(), uuid.NewUUID(), "Hello", uuid.UUID(""), createdAt)
+
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if !msg.CreatedAt().Equal(createdAt) {
+			t.Errorf("expected createdAt %v, got %v", createdAt, msg.CreatedAt())
+		}
+		if msg.Type() != message.TypeUser {
+			t.Errorf("expected type %q, got %q", message.TypeUser, msg.Type())
+		}
+	})
+
+	t.Run("requires a time", func(t *testing.T) {
+		_, err := message.NewImportedMessage(uuid.NewUUID(), uuid.NewUUID(), "Hello", uuid.UUID(""), time.Time{})
+
+		if err != errs.ErrInvalidInput {
+			t.Errorf("expected ErrInvalidInput, got %v", err)
+		}
+	})
+}
+
 //nolint:errorlint // Direct error comparison is acceptable in tests
 func TestMessage_EditContent(t *testing.T) {
 	t.Run("success", func(t *testing.T) {
@@ -198,6 +226,178 @@ func TestMessage_Delete(t *testing.T) {
 	})
 }
 
+//nolint:errorlint // Direct error comparison is acceptable in tests
+func TestMessage_Restore(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		authorID := uuid.NewUUID()
+		msg, _ := message.NewMessage(uuid.NewUUID(), authorID, "Test", uuid.UUID(""))
+		_ = msg.Delete(authorID)
+
+		err := msg.Restore(authorID, time.Minute)
+
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if msg.IsDeleted() || msg.DeletedAt() != nil {
+			t.Error("expected message to be restored")
+		}
+		if msg.Content() != "Test" {
+			t.Errorf("expected content to be kept, got %q", msg.Content())
+		}
+	})
+
+	t.Run("not deleted", func(t *testing.T) {
+		authorID := uuid.NewUUID()
+		msg, _ := message.NewMessage(uuid.NewUUID(), authorID, "Test", uuid.UUID(""))
+
+		if err := msg.Restore(authorID, time.Minute); err != errs.ErrInvalidState {
+			t.Errorf("expected ErrInvalidState, got %v", err)
+		}
+	})
+
+	t.Run("forbidden - not author", func(t *testing.T) {
+		authorID := uuid.NewUUID()
+		msg, _ := message.NewMessage(uuid.NewUUID(), authorID, "Test", uuid.UUID(""))
+		_ = msg.Delete(authorID)
+
+		if err := msg.Restore(uuid.NewUUID(), time.Minute); err != errs.ErrForbidden {
+			t.Errorf("expected ErrForbidden, got %v", err)
+		}
+	})
+
+	t.Run("window expired", func(t *testing.T) {
+		authorID := uuid.NewUUID()
+		deletedAt := time.Now().Add(-time.Hour)
+		msg := message.Reconstruct(
+			uuid.NewUUID(), uuid.NewUUID(), authorID, "Test", "", uuid.UUID(""),
+			deletedAt, nil, true, &deletedAt, nil, nil, nil, message.TypeUser, nil, uuid.UUID(""), nil,
+		)
+
+		if err := msg.Restore(authorID, time.Minute); err != message.ErrUndoWindowExpired {
+			t.Errorf("expected ErrUndoWindowExpired, got %v", err)
+		}
+		if !msg.IsDeleted() {
+			t.Error("message should stay deleted")
+		}
+	})
+}
+
+//nolint:errorlint // Direct error comparison is acceptable in tests
+func TestMessage_RevertEdit(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		authorID := uuid.NewUUID()
+		msg, _ := message.NewMessage(uuid.NewUUID(), authorID, "Original", uuid.UUID(""))
+		_ = msg.EditContent("Edited", authorID)
+
+		if msg.PreviousContent() != "Original" {
+			t.Errorf("expected previous content to be kept, got %q", msg.PreviousContent())
+		}
+
+		err := msg.RevertEdit(authorID, time.Minute)
+
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if msg.Content() != "Original" {
+			t.Errorf("expected content to be reverted, got %q", msg.Content())
+		}
+		if msg.PreviousContent() != "" {
+			t.Error("expected previous content to be cleared")
+		}
+	})
+
+	t.Run("nothing to revert", func(t *testing.T) {
+		authorID := uuid.NewUUID()
+		msg, _ := message.NewMessage(uuid.NewUUID(), authorID, "Original", uuid.UUID(""))
+
+		if err := msg.RevertEdit(authorID, time.Minute); err != errs.ErrInvalidState {
+			t.Errorf("expected ErrInvalidState, got %v", err)
+		}
+
+		_ = msg.EditContent("Edited", authorID)
+		_ = msg.RevertEdit(authorID, time.Minute)
+
+		if err := msg.RevertEdit(authorID, time.Minute); err != errs.ErrInvalidState {
+			t.Errorf("expected ErrInvalidState on second revert, got %v", err)
+		}
+	})
+
+	t.Run("forbidden - not author", func(t *testing.T) {
+		authorID := uuid.NewUUID()
+		msg, _ := message.NewMessage(uuid.NewUUID(), authorID, "Original", uuid.UUID(""))
+		_ = msg.EditContent("Edited", authorID)
+
+		if err := msg.RevertEdit(uuid.NewUUID(), time.Minute); err != errs.ErrForbidden {
+			t.Errorf("expected ErrForbidden, got %v", err)
+		}
+	})
+
+	t.Run("window expired", func(t *testing.T) {
+		authorID := uuid.NewUUID()
+		editedAt := time.Now().Add(-time.Hour)
+		msg := message.Reconstruct(
+			uuid.NewUUID(), uuid.NewUUID(), authorID, "Edited", "Original", uuid.UUID(""),
+			editedAt, &editedAt, false, nil, nil, nil, nil, message.TypeUser, nil, uuid.UUID(""), nil,
+		)
+
+		if err := msg.RevertEdit(authorID, time.Minute); err != message.ErrUndoWindowExpired {
+			t.Errorf("expected ErrUndoWindowExpired, got %v", err)
+		}
+		if msg.Content() != "Edited" {
+			t.Error("content should not change")
+		}
+	})
+}
+
+//nolint:errorlint // Direct error comparison is acceptable in tests
+func TestMessage_Purge(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		authorID := uuid.NewUUID()
+		msg, _ := message.NewMessage(uuid.NewUUID(), authorID, "Secret", uuid.UUID(""))
+		_ = msg.AddReaction(uuid.NewUUID(), "👍")
+		_ = msg.AddAttachment(uuid.NewUUID(), "a.txt", 10, "text/plain")
+		_ = msg.Delete(authorID)
+
+		if msg.Content() != "Secret" {
+			t.Error("expected content to be kept until purge")
+		}
+
+		err := msg.Purge()
+
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if !msg.IsPurged() || msg.PurgedAt() == nil {
+			t.Error("expected message to be purged")
+		}
+		if msg.Content() != "" || len(msg.Attachments()) != 0 || len(msg.Reactions()) != 0 {
+			t.Error("expected content, attachments and reactions to be removed")
+		}
+		if !msg.IsDeleted() {
+			t.Error("expected tombstone to stay deleted")
+		}
+	})
+
+	t.Run("not deleted", func(t *testing.T) {
+		msg, _ := message.NewMessage(uuid.NewUUID(), uuid.NewUUID(), "Test", uuid.UUID(""))
+
+		if err := msg.Purge(); err != errs.ErrInvalidState {
+			t.Errorf("expected ErrInvalidState, got %v", err)
+		}
+	})
+
+	t.Run("already purged", func(t *testing.T) {
+		authorID := uuid.NewUUID()
+		msg, _ := message.NewMessage(uuid.NewUUID(), authorID, "Test", uuid.UUID(""))
+		_ = msg.Delete(authorID)
+		_ = msg.Purge()
+
+		if err := msg.Purge(); err != errs.ErrInvalidState {
+			t.Errorf("expected ErrInvalidState, got %v", err)
+		}
+	})
+}
+
 //nolint:gocognit,errorlint // Test complexity is acceptable
 func TestMessage_AddReaction(t *testing.T) {
 	t.Run("success", func(t *testing.T) {
@@ -360,6 +560,105 @@ func TestMessage_RemoveReaction(t *testing.T) {
 	})
 }
 
+func TestAttachment_Preview(t *testing.T) {
+	cases := []struct {
+		mimeType string
+		want     message.PreviewStatus
+	}{
+		{"image/jpeg", message.PreviewPending},
+		{"image/png", message.PreviewPending},
+		{"image/gif", message.PreviewPending},
+		{"application/pdf", message.PreviewPending},
+		{"image/svg+xml", message.PreviewNone},
+		{"text/plain", message.PreviewNone},
+	}
+	for _, tc := range cases {
+		t.Run(tc.mimeType, func(t *testing.T) {
+			attachment, err := message.NewAttachment(uuid.NewUUID(), "file", 1024, tc.mimeType)
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			if attachment.PreviewStatus() != tc.want {
+				t.Errorf("expected preview status %q, got %q", tc.want, attachment.PreviewStatus())
+			}
+		})
+	}
+
+	t.Run("hydrated preview", func(t *testing.T) {
+		attachment := message.ReconstructAttachment(uuid.NewUUID(), "photo.jpg", 1024, "image/jpeg").
+			WithPreview(message.PreviewReady, 320, 240)
+
+		if !attachment.HasPreview() {
+			t.Error("expected a ready preview")
+		}
+		if width, height := attachment.PreviewSize(); width != 320 || height != 240 {
+			t.Errorf("expected preview size 320x240, got %dx%d", width, height)
+		}
+	})
+}
+
+//nolint:errorlint // Direct error comparison is acceptable in tests
+func TestMessage_AddAudioAttachment(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		msg, _ := message.NewMessage(uuid.NewUUID(), uuid.NewUUID(), "Voice message", uuid.UUID(""))
+		waveform := []int{0, 40, 100, 20}
+
+		err := msg.AddAudioAttachment(uuid.NewUUID(), "voice.webm", 4096, "audio/webm", 42*time.Second, waveform)
+
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		attachment := msg.Attachments()[0]
+		if !attachment.IsAudio() {
+			t.Error("expected an audio attachment")
+		}
+		if attachment.Duration() != 42*time.Second {
+			t.Errorf("expected duration 42s, got %v", attachment.Duration())
+		}
+		waveform[0] = 99
+		if attachment.Waveform()[0] != 0 {
+			t.Error("waveform must be copied")
+		}
+	})
+
+	t.Run("waveform is optional", func(t *testing.T) {
+		msg, _ := message.NewMessage(uuid.NewUUID(), uuid.NewUUID(), "Voice message", uuid.UUID(""))
+
+		err := msg.AddAudioAttachment(uuid.NewUUID(), "voice.ogg", 4096, "audio/ogg", time.Second, nil)
+
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if msg.Attachments()[0].Waveform() != nil {
+			t.Error("expected no waveform")
+		}
+	})
+
+	invalid := []struct {
+		name     string
+		mimeType string
+		duration time.Duration
+		waveform []int
+	}{
+		{"not audio", "application/pdf", time.Second, nil},
+		{"no duration", "audio/webm", 0, nil},
+		{"too long", "audio/webm", message.MaxAudioDuration + time.Second, nil},
+		{"too many samples", "audio/webm", time.Second, make([]int, message.MaxWaveformSamples+1)},
+		{"level out of range", "audio/webm", time.Second, []int{message.MaxWaveformLevel + 1}},
+	}
+	for _, tc := range invalid {
+		t.Run(tc.name, func(t *testing.T) {
+			msg, _ := message.NewMessage(uuid.NewUUID(), uuid.NewUUID(), "Voice message", uuid.UUID(""))
+
+			err := msg.AddAudioAttachment(uuid.NewUUID(), "voice", 4096, tc.mimeType, tc.duration, tc.waveform)
+
+			if err != errs.ErrInvalidInput {
+				t.Errorf("expected ErrInvalidInput, got %v", err)
+			}
+		})
+	}
+}
+
 //nolint:errorlint // Direct error comparison is acceptable in tests
 func TestMessage_AddAttachment(t *testing.T) {
 	t.Run("success", func(t *testing.T) {
@@ -526,6 +825,47 @@ func TestMessage_IsReply(t *testing.T) {
 	})
 }
 
+//nolint:errorlint // Test compares sentinel errors directly
+func TestMessage_Quote(t *testing.T) {
+	chatID := uuid.NewUUID()
+	authorID := uuid.NewUUID()
+
+	t.Run("quote message from the same chat", func(t *testing.T) {
+		quoted, _ := message.NewMessage(chatID, authorID, "Original", uuid.UUID(""))
+		msg, _ := message.NewMessage(chatID, authorID, "Answer", uuid.UUID(""))
+
+		if err := msg.Quote(quoted); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !msg.HasQuote() || msg.QuotedMessageID() != quoted.ID() {
+			t.Error("expected message to quote the original")
+		}
+		if msg.IsReply() {
+			t.Error("expected quote not to make the message a thread reply")
+		}
+	})
+
+	t.Run("quote message from another chat", func(t *testing.T) {
+		quoted, _ := message.NewMessage(uuid.NewUUID(), authorID, "Original", uuid.UUID(""))
+		msg, _ := message.NewMessage(chatID, authorID, "Answer", uuid.UUID(""))
+
+		if err := msg.Quote(quoted); err != errs.ErrInvalidInput {
+			t.Errorf("expected ErrInvalidInput, got %v", err)
+		}
+		if msg.HasQuote() {
+			t.Error("expected message not to quote anything")
+		}
+	})
+
+	t.Run("quote itself", func(t *testing.T) {
+		msg, _ := message.NewMessage(chatID, authorID, "Answer", uuid.UUID(""))
+
+		if err := msg.Quote(msg); err != errs.ErrInvalidInput {
+			t.Errorf("expected ErrInvalidInput, got %v", err)
+		}
+	})
+}
+
 func TestMessage_GetReactionCount(t *testing.T) {
 	chatID := uuid.NewUUID()
 	authorID := uuid.NewUUID()
@@ -0,0 +1,279 @@
+package message
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/lllypuk/flowra/internal/domain/errs"
+	"github.com/lllypuk/flowra/internal/domain/uuid"
+)
+
+const (
+	// MinPollOptions is the minimum number of options in a poll
+	MinPollOptions = 2
+	// MaxPollOptions is the maximum number of options in a poll
+	MaxPollOptions = 10
+	// MaxPollQuestionLength is the maximum length of a poll question
+	MaxPollQuestionLength = 300
+	// MaxPollOptionLength is the maximum length of a poll option
+	MaxPollOptionLength = 100
+)
+
+// ErrPollClosed is returned when voting in a closed poll.
+var ErrPollClosed = errors.New("poll is closed")
+
+// PollOption represents one answer of a poll
+type PollOption struct {
+	id   uuid.UUID
+	text string
+}
+
+// ID returns ID of the option
+func (o PollOption) ID() uuid.UUID {
+	return o.id
+}
+
+// Text returns text of the option
+func (o PollOption) Text() string {
+	return o.text
+}
+
+// ReconstructPollOption reconstructs poll option from save.
+func ReconstructPollOption(id uuid.UUID, text string) PollOption {
+	return PollOption{id: id, text: text}
+}
+
+// PollVote represents the choice of one user
+type PollVote struct {
+	userID   uuid.UUID
+	optionID uuid.UUID
+	votedAt  time.Time
+}
+
+// UserID returns ID of the voter
+func (v PollVote) UserID() uuid.UUID {
+	return v.userID
+}
+
+// OptionID returns ID of the chosen option
+func (v PollVote) OptionID() uuid.UUID {
+	return v.optionID
+}
+
+// VotedAt returns time of the vote
+func (v PollVote) VotedAt() time.Time {
+	return v.votedAt
+}
+
+// ReconstructPollVote reconstructs poll vote from save.
+func ReconstructPollVote(userID, optionID uuid.UUID, votedAt time.Time) PollVote {
+	return PollVote{userID: userID, optionID: optionID, votedAt: votedAt}
+}
+
+// PollResult is the number of votes for one option
+type PollResult struct {
+	OptionID uuid.UUID
+	Text     string
+	Votes    int
+}
+
+// Poll represents a single-choice poll attached to a message.
+// Anonymous polls keep the voters (to allow changing the vote) but do not reveal them.
+type Poll struct {
+	question  string
+	options   []PollOption
+	anonymous bool
+	closesAt  *time.Time // poll closes automatically at this time
+	closedAt  *time.Time // poll was closed manually by the author
+	votes     []PollVote
+}
+
+// NewPoll creates new poll.
+// Options are trimmed and must be unique; closesAt, if set, must be in the future.
+func NewPoll(question string, options []string, anonymous bool, closesAt *time.Time) (*Poll, error) {
+	question = strings.TrimSpace(question)
+	if question == "" || len([]rune(question)) > MaxPollQuestionLength {
+		return nil, errs.ErrInvalidInput
+	}
+	if len(options) < MinPollOptions || len(options) > MaxPollOptions {
+		return nil, errs.ErrInvalidInput
+	}
+	if closesAt != nil && !closesAt.After(time.Now()) {
+		return nil, errs.ErrInvalidInput
+	}
+
+	seen := make(map[string]struct{}, len(options))
+	pollOptions := make([]PollOption, 0, len(options))
+	for _, text := range options {
+		text = strings.TrimSpace(text)
+		if text == "" || len([]rune(text)) > MaxPollOptionLength {
+			return nil, errs.ErrInvalidInput
+		}
+		key := strings.ToLower(text)
+		if _, dup := seen[key]; dup {
+			return nil, errs.ErrInvalidInput
+		}
+		seen[key] = struct{}{}
+		pollOptions = append(pollOptions, PollOption{id: uuid.NewUUID(), text: text})
+	}
+
+	return &Poll{
+		question:  question,
+		options:   pollOptions,
+		anonymous: anonymous,
+		closesAt:  closesAt,
+		votes:     make([]PollVote, 0),
+	}, nil
+}
+
+// ReconstructPoll reconstructs poll from save.
+// Used by repositories for hydration obekta without validation business rules.
+func ReconstructPoll(
+	question string,
+	options []PollOption,
+	anonymous bool,
+	closesAt *time.Time,
+	closedAt *time.Time,
+	votes []PollVote,
+) *Poll {
+	if votes == nil {
+		votes = make([]PollVote, 0)
+	}
+	return &Poll{
+		question:  question,
+		options:   options,
+		anonymous: anonymous,
+		closesAt:  closesAt,
+		closedAt:  closedAt,
+		votes:     votes,
+	}
+}
+
+// vote records the choice of the user, replacing the previous one
+func (p *Poll) vote(userID, optionID uuid.UUID) error {
+	if userID.IsZero() {
+		return errs.ErrInvalidInput
+	}
+	if p.IsClosed(time.Now()) {
+		return ErrPollClosed
+	}
+	if !p.hasOption(optionID) {
+		return errs.ErrNotFound
+	}
+
+	p.removeVote(userID)
+	p.votes = append(p.votes, PollVote{userID: userID, optionID: optionID, votedAt: time.Now()})
+	return nil
+}
+
+// retract removes the choice of the user
+func (p *Poll) retract(userID uuid.UUID) error {
+	if p.IsClosed(time.Now()) {
+		return ErrPollClosed
+	}
+	if !p.removeVote(userID) {
+		return errs.ErrNotFound
+	}
+	return nil
+}
+
+// close stops the voting
+func (p *Poll) close() error {
+	if p.IsClosed(time.Now()) {
+		return errs.ErrInvalidState
+	}
+	now := time.Now()
+	p.closedAt = &now
+	return nil
+}
+
+func (p *Poll) hasOption(optionID uuid.UUID) bool {
+	for _, o := range p.options {
+		if o.id == optionID {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *Poll) removeVote(userID uuid.UUID) bool {
+	for i, v := range p.votes {
+		if v.userID == userID {
+			p.votes = append(p.votes[:i], p.votes[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+// IsClosed checks if the poll was closed manually or its close time has passed
+func (p *Poll) IsClosed(now time.Time) bool {
+	if p.closedAt != nil {
+		return true
+	}
+	return p.closesAt != nil && !now.Before(*p.closesAt)
+}
+
+// Results returns number of votes per option, in option order
+func (p *Poll) Results() []PollResult {
+	counts := make(map[uuid.UUID]int, len(p.options))
+	for _, v := range p.votes {
+		counts[v.optionID]++
+	}
+	results := make([]PollResult, 0, len(p.options))
+	for _, o := range p.options {
+		results = append(results, PollResult{OptionID: o.id, Text: o.text, Votes: counts[o.id]})
+	}
+	return results
+}
+
+// VoteOf returns the option chosen by the user (nil if the user has not voted)
+func (p *Poll) VoteOf(userID uuid.UUID) *uuid.UUID {
+	for _, v := range p.votes {
+		if v.userID == userID {
+			optionID := v.optionID
+			return &optionID
+		}
+	}
+	return nil
+}
+
+// TotalVotes returns number of users who voted
+func (p *Poll) TotalVotes() int {
+	return len(p.votes)
+}
+
+// Question returns question of the poll
+func (p *Poll) Question() string {
+	return p.question
+}
+
+// Options returns kopiyu list options
+func (p *Poll) Options() []PollOption {
+	options := make([]PollOption, len(p.options))
+	copy(options, p.options)
+	return options
+}
+
+// IsAnonymous checks if voters are hidden
+func (p *Poll) IsAnonymous() bool {
+	return p.anonymous
+}
+
+// ClosesAt returns the automatic close time (nil if the poll stays open)
+func (p *Poll) ClosesAt() *time.Time {
+	return p.closesAt
+}
+
+// ClosedAt returns the time the poll was closed manually
+func (p *Poll) ClosedAt() *time.Time {
+	return p.closedAt
+}
+
+// Votes returns kopiyu list votes
+func (p *Poll) Votes() []PollVote {
+	votes := make([]PollVote, len(p.votes))
+	copy(votes, p.votes)
+	return votes
+}
@@ -0,0 +1,195 @@
+package message_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/lllypuk/flowra/internal/domain/errs"
+	"github.com/lllypuk/flowra/internal/domain/event"
+	"github.com/lllypuk/flowra/internal/domain/message"
+	"github.com/lllypuk/flowra/internal/domain/uuid"
+)
+
+func newTestPollMessage(t *testing.T, authorID uuid.UUID, anonymous bool) *message.Message {
+	t.Helper()
+
+	poll, err := message.NewPoll("Where do we meet?", []string{"Office", "Cafe", "Online"}, anonymous, nil)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	msg, err := message.NewPollMessage(uuid.NewUUID(), authorID, poll)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	return msg
+}
+
+//nolint:errorlint // Direct error comparison is acceptable in tests
+func TestNewPoll(t *testing.T) {
+	future := time.Now().Add(time.Hour)
+	past := time.Now().Add(-time.Hour)
+
+	tests := []struct {
+		name     string
+		question string
+		options  []string
+		closesAt *time.Time
+		wantErr  bool
+	}{
+		{"valid", "Lunch?", []string{"Yes", "No"}, nil, false},
+		{"valid with close time", "Lunch?", []string{"Yes", "No"}, &future, false},
+		{"empty question", "  ", []string{"Yes", "No"}, nil, true},
+		{"single option", "Lunch?", []string{"Yes"}, nil, true},
+		{"empty option", "Lunch?", []string{"Yes", " "}, nil, true},
+		{"duplicate options", "Lunch?", []string{"Yes", "yes"}, nil, true},
+		{"close time in the past", "Lunch?", []string{"Yes", "No"}, &past, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			poll, err := message.NewPoll(tt.question, tt.options, false, tt.closesAt)
+			if tt.wantErr {
+				if err != errs.ErrInvalidInput {
+					t.Errorf("expected ErrInvalidInput, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			if len(poll.Options()) != len(tt.options) {
+				t.Errorf("expected %d options, got %d", len(tt.options), len(poll.Options()))
+			}
+		})
+	}
+}
+
+func TestNewPollMessage(t *testing.T) {
+	msg := newTestPollMessage(t, uuid.NewUUID(), false)
+
+	if msg.Type() != message.TypePoll {
+		t.Errorf("expected TypePoll, got %s", msg.Type())
+	}
+	if msg.Content() != "Where do we meet?" {
+		t.Errorf("expected question as content, got %q", msg.Content())
+	}
+	if !msg.HasPoll() {
+		t.Error("expected message to have poll")
+	}
+}
+
+//nolint:errorlint // Direct error comparison is acceptable in tests
+func TestMessage_Vote(t *testing.T) {
+	t.Run("vote and change vote", func(t *testing.T) {
+		msg := newTestPollMessage(t, uuid.NewUUID(), false)
+		options := msg.Poll().Options()
+		userID := uuid.NewUUID()
+
+		if err := msg.Vote(userID, options[0].ID()); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if err := msg.Vote(userID, options[1].ID()); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+
+		results := msg.Poll().Results()
+		if results[0].Votes != 0 || results[1].Votes != 1 {
+			t.Errorf("expected vote to move to second option, got %+v", results)
+		}
+		if msg.Poll().TotalVotes() != 1 {
+			t.Errorf("expected 1 vote, got %d", msg.Poll().TotalVotes())
+		}
+		if vote := msg.Poll().VoteOf(userID); vote == nil || *vote != options[1].ID() {
+			t.Errorf("expected vote for second option, got %v", vote)
+		}
+	})
+
+	t.Run("unknown option", func(t *testing.T) {
+		msg := newTestPollMessage(t, uuid.NewUUID(), false)
+
+		if err := msg.Vote(uuid.NewUUID(), uuid.NewUUID()); err != errs.ErrNotFound {
+			t.Errorf("expected ErrNotFound, got %v", err)
+		}
+	})
+
+	t.Run("not a poll", func(t *testing.T) {
+		msg, _ := message.NewMessage(uuid.NewUUID(), uuid.NewUUID(), "Hello", uuid.UUID(""))
+
+		if err := msg.Vote(uuid.NewUUID(), uuid.NewUUID()); err != errs.ErrInvalidState {
+			t.Errorf("expected ErrInvalidState, got %v", err)
+		}
+	})
+
+	t.Run("closed poll", func(t *testing.T) {
+		authorID := uuid.NewUUID()
+		msg := newTestPollMessage(t, authorID, false)
+		_ = msg.ClosePoll(authorID)
+
+		if err := msg.Vote(uuid.NewUUID(), msg.Poll().Options()[0].ID()); err != message.ErrPollClosed {
+			t.Errorf("expected ErrPollClosed, got %v", err)
+		}
+	})
+
+	t.Run("close time passed", func(t *testing.T) {
+		closesAt := time.Now().Add(-time.Minute)
+		option := message.ReconstructPollOption(uuid.NewUUID(), "Yes")
+		poll := message.ReconstructPoll("Lunch?", []message.PollOption{option}, false, &closesAt, nil, nil)
+
+		if !poll.IsClosed(time.Now()) {
+			t.Error("expected poll to be closed")
+		}
+	})
+}
+
+//nolint:errorlint // Direct error comparison is acceptable in tests
+func TestMessage_RetractVote(t *testing.T) {
+	msg := newTestPollMessage(t, uuid.NewUUID(), true)
+	userID := uuid.NewUUID()
+	_ = msg.Vote(userID, msg.Poll().Options()[0].ID())
+
+	if err := msg.RetractVote(userID); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if msg.Poll().TotalVotes() != 0 {
+		t.Errorf("expected no votes, got %d", msg.Poll().TotalVotes())
+	}
+	if err := msg.RetractVote(userID); err != errs.ErrNotFound {
+		t.Errorf("expected ErrNotFound, got %v", err)
+	}
+}
+
+//nolint:errorlint // Direct error comparison is acceptable in tests
+func TestMessage_ClosePoll(t *testing.T) {
+	authorID := uuid.NewUUID()
+	msg := newTestPollMessage(t, authorID, false)
+
+	if err := msg.ClosePoll(uuid.NewUUID()); err != errs.ErrForbidden {
+		t.Errorf("expected ErrForbidden, got %v", err)
+	}
+	if err := msg.ClosePoll(authorID); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if err := msg.ClosePoll(authorID); err != errs.ErrInvalidState {
+		t.Errorf("expected ErrInvalidState, got %v", err)
+	}
+}
+
+func TestNewPollVoted_Anonymous(t *testing.T) {
+	msg := newTestPollMessage(t, uuid.NewUUID(), true)
+	userID := uuid.NewUUID()
+	optionID := msg.Poll().Options()[0].ID()
+	_ = msg.Vote(userID, optionID)
+
+	evt := message.NewPollVoted(msg.ID(), msg.ChatID(), userID, optionID, msg.Poll(),
+		event.NewMetadata(userID.String(), "", ""))
+
+	if !evt.VoterID.IsZero() || !evt.OptionID.IsZero() {
+		t.Error("expected voter to be hidden in anonymous poll")
+	}
+	if evt.Metadata().UserID != "" {
+		t.Error("expected metadata user to be hidden in anonymous poll")
+	}
+	if evt.Results[0].Votes != 1 {
+		t.Errorf("expected result tally, got %+v", evt.Results)
+	}
+}
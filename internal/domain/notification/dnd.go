@@ -0,0 +1,145 @@
+package notification
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/lllypuk/flowra/internal/domain/errs"
+)
+
+const (
+	minutesPerHour = 60
+	minutesPerDay  = 24 * minutesPerHour
+
+	// maxQuietSteps bounds the walk over chained quiet periods in QuietUntil: a
+	// weekend joined to the nightly windows around it takes four steps.
+	maxQuietSteps = 8
+)
+
+// DNDSchedule is a do-not-disturb schedule in the user's time zone. While it is
+// active notifications are still recorded in-app, but not emailed.
+// The zero value is never active.
+type DNDSchedule struct {
+	// Start and End are minutes after midnight of the daily window; a window
+	// ending before it starts spans midnight, Start == End means no daily window.
+	Start int
+	End   int
+
+	// Weekends makes all of Saturday and Sunday quiet.
+	Weekends bool
+}
+
+// Preferences are the notification preferences of a user.
+type Preferences struct {
+	DND DNDSchedule
+}
+
+// NewDNDSchedule creates a schedule from "HH:MM" times; empty times mean no
+// daily window.
+func NewDNDSchedule(start, end string, weekends bool) (DNDSchedule, error) {
+	if (start == "") != (end == "") {
+		return DNDSchedule{}, fmt.Errorf("%w: dnd start and end must be set together", errs.ErrInvalidInput)
+	}
+	s := DNDSchedule{Weekends: weekends}
+	if start == "" {
+		return s, nil
+	}
+
+	var err error
+	if s.Start, err = parseClock(start); err != nil {
+		return DNDSchedule{}, err
+	}
+	if s.End, err = parseClock(end); err != nil {
+		return DNDSchedule{}, err
+	}
+	if s.Start == s.End {
+		return DNDSchedule{}, fmt.Errorf("%w: dnd start and end must differ", errs.ErrInvalidInput)
+	}
+	return s, nil
+}
+
+// Enabled reports whether the schedule has any quiet time.
+func (s DNDSchedule) Enabled() bool {
+	return s.hasWindow() || s.Weekends
+}
+
+// StartClock returns the start of the daily window as "HH:MM", or "" without one.
+func (s DNDSchedule) StartClock() string {
+	if !s.hasWindow() {
+		return ""
+	}
+	return formatClock(s.Start)
+}
+
+// EndClock returns the end of the daily window as "HH:MM", or "" without one.
+func (s DNDSchedule) EndClock() string {
+	if !s.hasWindow() {
+		return ""
+	}
+	return formatClock(s.End)
+}
+
+// Active reports whether at falls into quiet time in loc.
+func (s DNDSchedule) Active(at time.Time, loc *time.Location) bool {
+	local := at.In(loc)
+	return s.quietDay(local) || s.inWindow(local)
+}
+
+// QuietUntil returns when the quiet time around at ends, following a nightly window
+// into the weekend and the weekend into the next nightly window.
+// Returns at itself when the schedule is not active.
+func (s DNDSchedule) QuietUntil(at time.Time, loc *time.Location) time.Time {
+	t := at.In(loc)
+	for range maxQuietSteps {
+		switch {
+		case s.quietDay(t):
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, loc)
+		case s.inWindow(t):
+			t = s.windowEnd(t)
+		default:
+			return t
+		}
+	}
+	return t
+}
+
+func (s DNDSchedule) hasWindow() bool {
+	return s.Start != s.End
+}
+
+func (s DNDSchedule) quietDay(t time.Time) bool {
+	return s.Weekends && (t.Weekday() == time.Saturday || t.Weekday() == time.Sunday)
+}
+
+func (s DNDSchedule) inWindow(t time.Time) bool {
+	if !s.hasWindow() {
+		return false
+	}
+	m := t.Hour()*minutesPerHour + t.Minute()
+	if s.Start < s.End {
+		return m >= s.Start && m < s.End
+	}
+	return m >= s.Start || m < s.End
+}
+
+// windowEnd returns the end of the daily window containing t.
+func (s DNDSchedule) windowEnd(t time.Time) time.Time {
+	day := t.Day()
+	if m := t.Hour()*minutesPerHour + t.Minute(); s.Start > s.End && m >= s.Start {
+		day++
+	}
+	return time.Date(t.Year(), t.Month(), day, s.End/minutesPerHour, s.End%minutesPerHour, 0, 0, t.Location())
+}
+
+func parseClock(value string) (int, error) {
+	t, err := time.Parse("15:04", value)
+	if err != nil {
+		return 0, fmt.Errorf("%w: invalid dnd time %q, expected HH:MM", errs.ErrInvalidInput, value)
+	}
+	return t.Hour()*minutesPerHour + t.Minute(), nil
+}
+
+func formatClock(minutes int) string {
+	minutes %= minutesPerDay
+	return fmt.Sprintf("%02d:%02d", minutes/minutesPerHour, minutes%minutesPerHour)
+}
@@ -0,0 +1,74 @@
+package notification_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/lllypuk/flowra/internal/domain/errs"
+	"github.com/lllypuk/flowra/internal/domain/notification"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewDNDSchedule(t *testing.T) {
+	s, err := notification.NewDNDSchedule("22:00", "08:30", true)
+	require.NoError(t, err)
+	assert.True(t, s.Enabled())
+	assert.Equal(t, "22:00", s.StartClock())
+	assert.Equal(t, "08:30", s.EndClock())
+
+	s, err = notification.NewDNDSchedule("", "", false)
+	require.NoError(t, err)
+	assert.False(t, s.Enabled())
+	assert.Empty(t, s.StartClock())
+
+	for _, tc := range [][2]string{{"22:00", ""}, {"25:00", "08:00"}, {"9am", "10:00"}, {"08:00", "08:00"}} {
+		_, err = notification.NewDNDSchedule(tc[0], tc[1], false)
+		require.ErrorIs(t, err, errs.ErrInvalidInput, "%v", tc)
+	}
+}
+
+func TestDNDSchedule_Active(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	require.NoError(t, err)
+	overnight, err := notification.NewDNDSchedule("22:00", "08:00", false)
+	require.NoError(t, err)
+
+	// Wednesday 2026-10-14
+	at := func(hour, minute int) time.Time { return time.Date(2026, 10, 14, hour, minute, 0, 0, berlin) }
+	assert.True(t, overnight.Active(at(23, 0), berlin))
+	assert.True(t, overnight.Active(at(7, 59), berlin))
+	assert.False(t, overnight.Active(at(8, 0), berlin))
+	assert.False(t, overnight.Active(at(21, 59), berlin))
+	// 20:30 UTC is 22:30 in Berlin
+	assert.True(t, overnight.Active(time.Date(2026, 10, 14, 20, 30, 0, 0, time.UTC), berlin))
+
+	lunch, err := notification.NewDNDSchedule("12:00", "13:00", false)
+	require.NoError(t, err)
+	assert.True(t, lunch.Active(at(12, 30), berlin))
+	assert.False(t, lunch.Active(at(13, 0), berlin))
+
+	weekends := notification.DNDSchedule{Weekends: true}
+	assert.True(t, weekends.Active(time.Date(2026, 10, 17, 12, 0, 0, 0, berlin), berlin))
+	assert.False(t, weekends.Active(at(12, 0), berlin))
+
+	assert.False(t, notification.DNDSchedule{}.Active(at(3, 0), berlin))
+}
+
+func TestDNDSchedule_QuietUntil(t *testing.T) {
+	loc := time.UTC
+	s, err := notification.NewDNDSchedule("22:00", "08:00", true)
+	require.NoError(t, err)
+
+	// Wednesday night ends Thursday morning
+	end := s.QuietUntil(time.Date(2026, 10, 14, 23, 0, 0, 0, loc), loc)
+	assert.Equal(t, time.Date(2026, 10, 15, 8, 0, 0, 0, loc), end)
+
+	// Friday night runs through the weekend into Monday morning
+	end = s.QuietUntil(time.Date(2026, 10, 16, 22, 30, 0, 0, loc), loc)
+	assert.Equal(t, time.Date(2026, 10, 19, 8, 0, 0, 0, loc), end)
+
+	// outside quiet time the end is the time itself
+	noon := time.Date(2026, 10, 14, 12, 0, 0, 0, loc)
+	assert.Equal(t, noon, s.QuietUntil(noon, loc))
+}
@@ -11,6 +11,7 @@ import (
 const (
 	EventTypeNotificationCreated = "notification.created"
 	EventTypeNotificationRead    = "notification.read"
+	EventTypeNotificationAllRead = "notification.all_read"
 	EventTypeNotificationDeleted = "notification.deleted"
 )
 
@@ -69,6 +70,30 @@ func NewNotificationRead(
 	}
 }
 
+// AllRead event of marking all notifications of a user as read at once
+type AllRead struct {
+	event.BaseEvent
+
+	UserID uuid.UUID
+	ReadAt time.Time
+	Count  int // notifications marked as read
+}
+
+// NewNotificationAllRead creates new event NotificationAllRead; the aggregate is the user
+func NewNotificationAllRead(
+	userID uuid.UUID,
+	readAt time.Time,
+	count int,
+	metadata event.Metadata,
+) *AllRead {
+	return &AllRead{
+		BaseEvent: event.NewBaseEvent(EventTypeNotificationAllRead, userID.String(), "Notification", 1, metadata),
+		UserID:    userID,
+		ReadAt:    readAt,
+		Count:     count,
+	}
+}
+
 // Deleted event removing uvedomleniya
 type Deleted struct {
 	event.BaseEvent
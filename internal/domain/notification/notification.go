@@ -17,6 +17,8 @@ const (
 	TypeTaskAssigned Type = "task.assigned"
 	// TypeTaskCreated notification o sozdanii tasks
 	TypeTaskCreated Type = "task.created"
+	// TypeTaskSLABreached notification o narushenii SLA bug
+	TypeTaskSLABreached Type = "task.sla_breached"
 	// TypeChatMention notification ob upominanii in chate
 	TypeChatMention Type = "chat.mention"
 	// TypeChatMessage notification o novom soobschenii in chate
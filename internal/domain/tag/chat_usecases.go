@@ -12,13 +12,20 @@ type ChatUseCases struct {
 	ConvertToBug  *chatApp.ConvertToBugUseCase
 	ConvertToEpic *chatApp.ConvertToEpicUseCase
 
+	// Entity Removal
+	ConvertToDiscussion *chatApp.ConvertToDiscussionUseCase
+
 	// Entity Management
-	ChangeStatus *chatApp.ChangeStatusUseCase
-	AssignUser   *chatApp.AssignUserUseCase
-	SetPriority  *chatApp.SetPriorityUseCase
-	SetDueDate   *chatApp.SetDueDateUseCase
-	Rename       *chatApp.RenameChatUseCase
-	SetSeverity  *chatApp.SetSeverityUseCase
+	ChangeStatus  *chatApp.ChangeStatusUseCase
+	AssignUser    *chatApp.AssignUserUseCase
+	SetPriority   *chatApp.SetPriorityUseCase
+	SetDueDate    *chatApp.SetDueDateUseCase
+	SetEstimate   *chatApp.SetEstimateUseCase
+	SetSprint     *chatApp.SetSprintUseCase
+	Rename        *chatApp.RenameChatUseCase
+	SetTopic      *chatApp.SetTopicUseCase
+	SetSeverity   *chatApp.SetSeverityUseCase
+	MarkDuplicate *chatApp.MarkDuplicateUseCase
 
 	// Participant Management (Task 007a)
 	AddParticipant    *chatApp.AddParticipantUseCase
@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/lllypuk/flowra/internal/domain/chat"
 )
 
 // Command represents a command that should be executed
@@ -91,6 +92,28 @@ func (c SetDueDateCommand) CommandType() string {
 	return "SetDueDate"
 }
 
+// SetEstimateCommand - command for setting the estimate
+type SetEstimateCommand struct {
+	ChatID   uuid.UUID
+	Estimate *chat.Estimate // nil value removes estimate
+}
+
+// CommandType returns the command type
+func (c SetEstimateCommand) CommandType() string {
+	return "SetEstimate"
+}
+
+// SetSprintCommand - command for assigning to a sprint
+type SetSprintCommand struct {
+	ChatID uuid.UUID
+	Sprint string // empty value removes from sprint
+}
+
+// CommandType returns the command type
+func (c SetSprintCommand) CommandType() string {
+	return "SetSprint"
+}
+
 // ChangeTitleCommand - command for changing title
 type ChangeTitleCommand struct {
 	ChatID uuid.UUID
@@ -102,6 +125,28 @@ func (c ChangeTitleCommand) CommandType() string {
 	return "ChangeTitle"
 }
 
+// SetTopicCommand - command for changing the chat topic
+type SetTopicCommand struct {
+	ChatID uuid.UUID
+	Topic  string // empty value clears the topic
+}
+
+// CommandType returns the command type
+func (c SetTopicCommand) CommandType() string {
+	return "SetTopic"
+}
+
+// MarkDuplicateCommand - command marking the entity as a duplicate of another task
+type MarkDuplicateCommand struct {
+	ChatID     uuid.UUID
+	OriginalID *uuid.UUID // nil value clears the duplicate mark
+}
+
+// CommandType returns the command type
+func (c MarkDuplicateCommand) CommandType() string {
+	return "MarkDuplicate"
+}
+
 // SetSeverityCommand - command setting bug severity
 type SetSeverityCommand struct {
 	ChatID   uuid.UUID
@@ -159,6 +204,16 @@ func (c ReopenChatCommand) CommandType() string {
 	return "ReopenChat"
 }
 
+// ConvertToDiscussionCommand - command to revert a Task/Bug/Epic to a Discussion
+type ConvertToDiscussionCommand struct {
+	ChatID uuid.UUID
+}
+
+// CommandType returns command type
+func (c ConvertToDiscussionCommand) CommandType() string {
+	return "ConvertToDiscussion"
+}
+
 // DeleteChatCommand - command to delete a chat (soft delete)
 type DeleteChatCommand struct {
 	ChatID uuid.UUID
@@ -102,8 +102,16 @@ func (e *CommandExecutor) Execute(ctx context.Context, cmd Command, actorID uuid
 		return e.executeChangePriority(ctx, c, actorID)
 	case SetDueDateCommand:
 		return e.executeSetDueDate(ctx, c, actorID)
+	case SetEstimateCommand:
+		return e.executeSetEstimate(ctx, c, actorID)
+	case SetSprintCommand:
+		return e.executeSetSprint(ctx, c, actorID)
 	case ChangeTitleCommand:
 		return e.executeChangeTitle(ctx, c, actorID)
+	case SetTopicCommand:
+		return e.executeSetTopic(ctx, c, actorID)
+	case MarkDuplicateCommand:
+		return e.executeMarkDuplicate(ctx, c, actorID)
 	case SetSeverityCommand:
 		return e.executeSetSeverity(ctx, c, actorID)
 	case InviteUserCommand:
@@ -114,6 +122,8 @@ func (e *CommandExecutor) Execute(ctx context.Context, cmd Command, actorID uuid
 		return e.executeCloseChat(ctx, c, actorID)
 	case ReopenChatCommand:
 		return e.executeReopenChat(ctx, c, actorID)
+	case ConvertToDiscussionCommand:
+		return e.executeConvertToDiscussion(ctx, c, actorID)
 	case DeleteChatCommand:
 		return e.executeDeleteChat(ctx, c, actorID)
 	default:
@@ -241,6 +251,65 @@ func (e *CommandExecutor) executeSetDueDate(ctx context.Context, cmd SetDueDateC
 	}, "failed to set due date")
 }
 
+// executeSetEstimate sets estimate via UseCase
+func (e *CommandExecutor) executeSetEstimate(ctx context.Context, cmd SetEstimateCommand, actorID uuid.UUID) error {
+	usecaseCmd := chatApp.SetEstimateCommand{
+		ChatID:   domainUUID.FromGoogleUUID(cmd.ChatID),
+		Estimate: cmd.Estimate,
+		SetBy:    domainUUID.FromGoogleUUID(actorID),
+	}
+
+	return e.retryOnConcurrentModification(ctx, func(ctx context.Context) error {
+		_, err := e.chatUseCases.SetEstimate.Execute(ctx, usecaseCmd)
+		return err
+	}, "failed to set estimate")
+}
+
+// executeSetSprint sets sprint via UseCase
+func (e *CommandExecutor) executeSetSprint(ctx context.Context, cmd SetSprintCommand, actorID uuid.UUID) error {
+	usecaseCmd := chatApp.SetSprintCommand{
+		ChatID: domainUUID.FromGoogleUUID(cmd.ChatID),
+		Sprint: cmd.Sprint,
+		SetBy:  domainUUID.FromGoogleUUID(actorID),
+	}
+
+	return e.retryOnConcurrentModification(ctx, func(ctx context.Context) error {
+		_, err := e.chatUseCases.SetSprint.Execute(ctx, usecaseCmd)
+		return err
+	}, "failed to set sprint")
+}
+
+// executeSetTopic sets topic via UseCase
+func (e *CommandExecutor) executeSetTopic(ctx context.Context, cmd SetTopicCommand, actorID uuid.UUID) error {
+	usecaseCmd := chatApp.SetTopicCommand{
+		ChatID: domainUUID.FromGoogleUUID(cmd.ChatID),
+		Topic:  cmd.Topic,
+		SetBy:  domainUUID.FromGoogleUUID(actorID),
+	}
+
+	return e.retryOnConcurrentModification(ctx, func(ctx context.Context) error {
+		_, err := e.chatUseCases.SetTopic.Execute(ctx, usecaseCmd)
+		return err
+	}, "failed to set topic")
+}
+
+// executeMarkDuplicate marks or unmarks a duplicate via UseCase
+func (e *CommandExecutor) executeMarkDuplicate(ctx context.Context, cmd MarkDuplicateCommand, actorID uuid.UUID) error {
+	usecaseCmd := chatApp.MarkDuplicateCommand{
+		ChatID:   domainUUID.FromGoogleUUID(cmd.ChatID),
+		MarkedBy: domainUUID.FromGoogleUUID(actorID),
+	}
+	if cmd.OriginalID != nil {
+		originalID := domainUUID.FromGoogleUUID(*cmd.OriginalID)
+		usecaseCmd.OriginalID = &originalID
+	}
+
+	return e.retryOnConcurrentModification(ctx, func(ctx context.Context) error {
+		_, err := e.chatUseCases.MarkDuplicate.Execute(ctx, usecaseCmd)
+		return err
+	}, "failed to mark duplicate")
+}
+
 // executeChangeTitle changes title via UseCase
 func (e *CommandExecutor) executeChangeTitle(ctx context.Context, cmd ChangeTitleCommand, actorID uuid.UUID) error {
 	usecaseCmd := chatApp.RenameChatCommand{
@@ -329,6 +398,23 @@ func (e *CommandExecutor) executeCloseChat(ctx context.Context, cmd CloseChatCom
 	}, "failed to close chat")
 }
 
+// executeConvertToDiscussion performs command to revert a typed chat to a Discussion
+func (e *CommandExecutor) executeConvertToDiscussion(
+	ctx context.Context,
+	cmd ConvertToDiscussionCommand,
+	actorID uuid.UUID,
+) error {
+	convertCmd := chatApp.ConvertToDiscussionCommand{
+		ChatID:      domainUUID.FromGoogleUUID(cmd.ChatID),
+		ConvertedBy: domainUUID.FromGoogleUUID(actorID),
+	}
+
+	return e.retryOnConcurrentModification(ctx, func(ctx context.Context) error {
+		_, err := e.chatUseCases.ConvertToDiscussion.Execute(ctx, convertCmd)
+		return err
+	}, "failed to convert to discussion")
+}
+
 // executeReopenChat performs command to reopen a closed chat
 func (e *CommandExecutor) executeReopenChat(ctx context.Context, cmd ReopenChatCommand, actorID uuid.UUID) error {
 	reopenCmd := chatApp.ReopenChatCommand{
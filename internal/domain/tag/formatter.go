@@ -63,8 +63,21 @@ func formatSuccessWithActor(applied TagApplication, actor ActorInfo) string {
 		return formatWithActor(actorName, "set priority to", "Priority changed to", applied.TagValue)
 	case SetDueDateCommand:
 		return formatDueDate(actorName, applied.TagValue)
+	case SetEstimateCommand:
+		return formatOptional(actorName, applied.TagValue,
+			"set estimate to", "Estimate set to", "removed the estimate", "Estimate removed")
+	case SetSprintCommand:
+		return formatOptional(actorName, applied.TagValue,
+			"moved this to sprint", "Moved to sprint", "removed this from the sprint", "Removed from the sprint")
+	case MarkDuplicateCommand:
+		return formatOptional(actorName, applied.TagValue,
+			"marked this as a duplicate of", "Marked as a duplicate of",
+			"removed the duplicate mark", "Duplicate mark removed")
 	case ChangeTitleCommand:
 		return formatWithActor(actorName, "changed title to:", "Title changed to:", applied.TagValue)
+	case SetTopicCommand:
+		return formatOptional(actorName, applied.TagValue,
+			"changed topic to:", "Topic changed to:", "cleared the topic", "Topic cleared")
 	case SetSeverityCommand:
 		return formatWithActor(actorName, "set severity to", "Severity set to", applied.TagValue)
 	case InviteUserCommand:
@@ -77,6 +90,8 @@ func formatSuccessWithActor(applied TagApplication, actor ActorInfo) string {
 		return formatWithActor(actorName, "closed the chat", "Chat closed", "")
 	case ReopenChatCommand:
 		return formatWithActor(actorName, "reopened the chat", "Chat reopened", "")
+	case ConvertToDiscussionCommand:
+		return formatWithActor(actorName, "converted the chat to a discussion", "Chat converted to a discussion", "")
 	case DeleteChatCommand:
 		return formatWithActor(actorName, "deleted the chat", "Chat deleted", "")
 	default:
@@ -116,6 +131,14 @@ func formatDueDate(actorName, tagValue string) string {
 	return formatWithActor(actorName, "set due date to", "Due date set to", formattedDate)
 }
 
+// formatOptional formats set/remove messages of tags whose empty value removes the field
+func formatOptional(actorName, tagValue, setWithActor, setWithoutActor, removeWithActor, removeWithoutActor string) string {
+	if tagValue == "" {
+		return formatWithActor(actorName, removeWithActor, removeWithoutActor, "")
+	}
+	return formatWithActor(actorName, setWithActor, setWithoutActor, tagValue)
+}
+
 // getActorName returns the display name for the actor
 func getActorName(actor ActorInfo) string {
 	if actor.IsIntegration && actor.Integration != "" {
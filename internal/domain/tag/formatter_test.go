@@ -248,6 +248,76 @@ func TestFormatSuccess_AllCommandTypes(t *testing.T) {
 			},
 			expected: "✅ Due date removed",
 		},
+		{
+			name: "SetEstimateCommand - set",
+			applied: tag.TagApplication{
+				TagKey:   "estimate",
+				TagValue: "5 points",
+				Command:  tag.SetEstimateCommand{ChatID: chatID},
+				Success:  true,
+			},
+			expected: "✅ Estimate set to 5 points",
+		},
+		{
+			name: "SetEstimateCommand - remove",
+			applied: tag.TagApplication{
+				TagKey:   "estimate",
+				TagValue: "",
+				Command:  tag.SetEstimateCommand{ChatID: chatID},
+				Success:  true,
+			},
+			expected: "✅ Estimate removed",
+		},
+		{
+			name: "MarkDuplicateCommand - mark",
+			applied: tag.TagApplication{
+				TagKey:   "duplicate",
+				TagValue: "0b1c3e52-7a4f-4c1e-9d0a-3f6b2a1c9e77",
+				Command:  tag.MarkDuplicateCommand{ChatID: chatID},
+				Success:  true,
+			},
+			expected: "✅ Marked as a duplicate of 0b1c3e52-7a4f-4c1e-9d0a-3f6b2a1c9e77",
+		},
+		{
+			name: "SetSprintCommand - set",
+			applied: tag.TagApplication{
+				TagKey:   "sprint",
+				TagValue: "Sprint 4",
+				Command:  tag.SetSprintCommand{ChatID: chatID, Sprint: "Sprint 4"},
+				Success:  true,
+			},
+			expected: "✅ Moved to sprint Sprint 4",
+		},
+		{
+			name: "SetSprintCommand - remove",
+			applied: tag.TagApplication{
+				TagKey:   "sprint",
+				TagValue: "",
+				Command:  tag.SetSprintCommand{ChatID: chatID},
+				Success:  true,
+			},
+			expected: "✅ Removed from the sprint",
+		},
+		{
+			name: "SetTopicCommand - set",
+			applied: tag.TagApplication{
+				TagKey:   "topic",
+				TagValue: "Release planning",
+				Command:  tag.SetTopicCommand{ChatID: chatID, Topic: "Release planning"},
+				Success:  true,
+			},
+			expected: "✅ Topic changed to: Release planning",
+		},
+		{
+			name: "SetTopicCommand - clear",
+			applied: tag.TagApplication{
+				TagKey:   "topic",
+				TagValue: "",
+				Command:  tag.SetTopicCommand{ChatID: chatID},
+				Success:  true,
+			},
+			expected: "✅ Topic cleared",
+		},
 		{
 			name: "ChangeTitleCommand",
 			applied: tag.TagApplication{
@@ -268,6 +338,15 @@ func TestFormatSuccess_AllCommandTypes(t *testing.T) {
 			},
 			expected: "✅ Severity set to Critical",
 		},
+		{
+			name: "ConvertToDiscussionCommand",
+			applied: tag.TagApplication{
+				TagKey:  "discussion",
+				Command: tag.ConvertToDiscussionCommand{ChatID: chatID},
+				Success: true,
+			},
+			expected: "✅ Chat converted to a discussion",
+		},
 	}
 
 	for _, tt := range tests {
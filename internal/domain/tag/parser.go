@@ -82,6 +82,34 @@ func NewParser() *Parser {
 		Validator:     noValidation,
 	})
 
+	parser.registerTag(Definition{
+		Name:          "topic",
+		RequiresValue: false, // can be empty (clear topic)
+		ValueType:     ValueTypeString,
+		Validator:     ValidateTopic,
+	})
+
+	parser.registerTag(Definition{
+		Name:          "estimate",
+		RequiresValue: false, // can be empty (remove estimate)
+		ValueType:     ValueTypeString,
+		Validator:     func(v string) error { _, err := ValidateEstimate(v); return err },
+	})
+
+	parser.registerTag(Definition{
+		Name:          "sprint",
+		RequiresValue: false, // can be empty (remove from sprint)
+		ValueType:     ValueTypeString,
+		Validator:     ValidateSprint,
+	})
+
+	parser.registerTag(Definition{
+		Name:          "duplicate",
+		RequiresValue: false, // can be empty (not a duplicate)
+		ValueType:     ValueTypeString,
+		Validator:     func(v string) error { _, err := ValidateDuplicateOf(v); return err },
+	})
+
 	// Bug-Specific Tags
 	parser.registerTag(Definition{
 		Name:          "severity",
@@ -121,6 +149,13 @@ func NewParser() *Parser {
 		Validator:     noValidation,
 	})
 
+	parser.registerTag(Definition{
+		Name:          "discussion",
+		RequiresValue: false,
+		ValueType:     ValueTypeNone,
+		Validator:     noValidation,
+	})
+
 	parser.registerTag(Definition{
 		Name:          "delete",
 		RequiresValue: false,
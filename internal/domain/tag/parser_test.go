@@ -19,6 +19,10 @@ func TestIsKnownTag(t *testing.T) {
 	assert.True(t, parser.isKnownTag("due"))
 	assert.True(t, parser.isKnownTag("title"))
 	assert.True(t, parser.isKnownTag("severity"))
+	assert.True(t, parser.isKnownTag("estimate"))
+	assert.True(t, parser.isKnownTag("sprint"))
+	assert.True(t, parser.isKnownTag("duplicate"))
+	assert.True(t, parser.isKnownTag("topic"))
 
 	// neizvestnye tags
 	assert.False(t, parser.isKnownTag("unknown"))
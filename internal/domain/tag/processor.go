@@ -3,6 +3,7 @@ package tag
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/google/uuid"
 )
@@ -27,12 +28,24 @@ func (p *Processor) ProcessMessage(
 	chatID uuid.UUID,
 	message string,
 	currentEntityType string,
+) *ProcessingResult {
+	return p.ProcessMessageInLocation(chatID, message, currentEntityType, time.UTC)
+}
+
+// ProcessMessageInLocation is ProcessMessage with dates and times without an
+// explicit offset (e.g. "#due 2026-03-01") interpreted in loc, typically the
+// author's time zone
+func (p *Processor) ProcessMessageInLocation(
+	chatID uuid.UUID,
+	message string,
+	currentEntityType string,
+	loc *time.Location,
 ) *ProcessingResult {
 	// parse message
 	parseResult := p.parser.Parse(message)
 
 	// process tags
-	result := p.ProcessTags(chatID, parseResult.Tags, currentEntityType)
+	result := p.processTags(chatID, parseResult.Tags, currentEntityType, loc)
 	result.OriginalMessage = message
 	result.PlainText = parseResult.PlainText
 
@@ -43,12 +56,20 @@ func (p *Processor) ProcessMessage(
 // currentEntityType - type of current active entity in chat ("Task", "Bug", "Epic")
 // can be empty string if no active entity
 // if message creates a new entity, Entity Management Tags apply to it
-//
-//nolint:gocognit,funlen,cyclop // Complexity justified: sequential tag processing logic
 func (p *Processor) ProcessTags(
 	chatID uuid.UUID,
 	parsedTags []ParsedTag,
 	currentEntityType string,
+) *ProcessingResult {
+	return p.processTags(chatID, parsedTags, currentEntityType, time.UTC)
+}
+
+//nolint:gocognit,funlen,cyclop // Complexity justified: sequential tag processing logic
+func (p *Processor) processTags(
+	chatID uuid.UUID,
+	parsedTags []ParsedTag,
+	currentEntityType string,
+	loc *time.Location,
 ) *ProcessingResult {
 	result := &ProcessingResult{
 		AppliedTags: []TagApplication{},
@@ -205,7 +226,7 @@ func (p *Processor) ProcessTags(
 			})
 
 		case "due":
-			dueDate, err := ValidateDueDate(tag.Value)
+			dueDate, err := ValidateDueDateInLocation(tag.Value, loc)
 			if err != nil {
 				result.Errors = append(result.Errors, TagError{
 					TagKey:   tag.Key,
@@ -226,6 +247,102 @@ func (p *Processor) ProcessTags(
 				Success:  true,
 			})
 
+		case "estimate":
+			if entityType == "" {
+				result.Errors = append(result.Errors, TagError{
+					TagKey:   tag.Key,
+					TagValue: tag.Value,
+					Error:    ErrNoActiveEntity,
+					Severity: ErrorSeverityError,
+				})
+				continue
+			}
+			estimate, err := ValidateEstimate(tag.Value)
+			if err != nil {
+				result.Errors = append(result.Errors, TagError{
+					TagKey:   tag.Key,
+					TagValue: tag.Value,
+					Error:    err,
+					Severity: ErrorSeverityError,
+				})
+				continue
+			}
+			tagValue := ""
+			if estimate != nil {
+				tagValue = estimate.String()
+			}
+			cmd := SetEstimateCommand{
+				ChatID:   chatID,
+				Estimate: estimate,
+			}
+			result.AppliedTags = append(result.AppliedTags, TagApplication{
+				TagKey:   tag.Key,
+				TagValue: tagValue,
+				Command:  cmd,
+				Success:  true,
+			})
+
+		case "sprint":
+			if entityType == "" {
+				result.Errors = append(result.Errors, TagError{
+					TagKey:   tag.Key,
+					TagValue: tag.Value,
+					Error:    ErrNoActiveEntity,
+					Severity: ErrorSeverityError,
+				})
+				continue
+			}
+			if err := ValidateSprint(tag.Value); err != nil {
+				result.Errors = append(result.Errors, TagError{
+					TagKey:   tag.Key,
+					TagValue: tag.Value,
+					Error:    err,
+					Severity: ErrorSeverityError,
+				})
+				continue
+			}
+			cmd := SetSprintCommand{
+				ChatID: chatID,
+				Sprint: strings.TrimSpace(tag.Value),
+			}
+			result.AppliedTags = append(result.AppliedTags, TagApplication{
+				TagKey:   tag.Key,
+				TagValue: cmd.Sprint,
+				Command:  cmd,
+				Success:  true,
+			})
+
+		case "duplicate":
+			if entityType == "" {
+				result.Errors = append(result.Errors, TagError{
+					TagKey:   tag.Key,
+					TagValue: tag.Value,
+					Error:    ErrNoActiveEntity,
+					Severity: ErrorSeverityError,
+				})
+				continue
+			}
+			originalID, err := ValidateDuplicateOf(tag.Value)
+			if err != nil {
+				result.Errors = append(result.Errors, TagError{
+					TagKey:   tag.Key,
+					TagValue: tag.Value,
+					Error:    err,
+					Severity: ErrorSeverityError,
+				})
+				continue
+			}
+			cmd := MarkDuplicateCommand{
+				ChatID:     chatID,
+				OriginalID: originalID,
+			}
+			result.AppliedTags = append(result.AppliedTags, TagApplication{
+				TagKey:   tag.Key,
+				TagValue: strings.TrimSpace(tag.Value),
+				Command:  cmd,
+				Success:  true,
+			})
+
 		case "title":
 			if err := ValidateTitle(tag.Value); err != nil {
 				result.Errors = append(result.Errors, TagError{
@@ -247,6 +364,28 @@ func (p *Processor) ProcessTags(
 				Success:  true,
 			})
 
+		case "topic":
+			// topic applies to every chat type, discussions included
+			if err := ValidateTopic(tag.Value); err != nil {
+				result.Errors = append(result.Errors, TagError{
+					TagKey:   tag.Key,
+					TagValue: tag.Value,
+					Error:    err,
+					Severity: ErrorSeverityError,
+				})
+				continue
+			}
+			cmd := SetTopicCommand{
+				ChatID: chatID,
+				Topic:  strings.TrimSpace(tag.Value),
+			}
+			result.AppliedTags = append(result.AppliedTags, TagApplication{
+				TagKey:   tag.Key,
+				TagValue: cmd.Topic,
+				Command:  cmd,
+				Success:  true,
+			})
+
 		case "severity":
 			if entityType != entityTypeBug {
 				result.Errors = append(result.Errors, TagError{
@@ -347,6 +486,24 @@ func (p *Processor) ProcessTags(
 				Success: true,
 			})
 
+		case "discussion":
+			// Only a Task/Bug/Epic can be reverted
+			if entityType == "" {
+				result.Errors = append(result.Errors, TagError{
+					TagKey:   tag.Key,
+					Error:    ErrNoActiveEntity,
+					Severity: ErrorSeverityError,
+				})
+				continue
+			}
+			cmd := ConvertToDiscussionCommand{ChatID: chatID}
+			result.AppliedTags = append(result.AppliedTags, TagApplication{
+				TagKey:  tag.Key,
+				Command: cmd,
+				Success: true,
+			})
+			entityType = ""
+
 		case "delete":
 			cmd := DeleteChatCommand{ChatID: chatID}
 			result.AppliedTags = append(result.AppliedTags, TagApplication{
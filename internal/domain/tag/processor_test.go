@@ -1,7 +1,9 @@
 package tag_test
 
 import (
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/lllypuk/flowra/internal/domain/tag"
@@ -11,6 +13,20 @@ import (
 
 // ====== Task 03: TagProcessor Tests ======
 
+func TestProcessMessageInLocation_DueDate(t *testing.T) {
+	processor := tag.NewProcessor()
+	loc, err := time.LoadLocation("Asia/Tokyo")
+	require.NoError(t, err)
+
+	result := processor.ProcessMessageInLocation(uuid.New(), "#due 2025-10-20", "Task", loc)
+
+	require.Len(t, result.AppliedTags, 1)
+	dueCmd, ok := result.AppliedTags[0].Command.(tag.SetDueDateCommand)
+	require.True(t, ok)
+	require.NotNil(t, dueCmd.DueDate)
+	assert.Equal(t, time.Date(2025, 10, 19, 15, 0, 0, 0, time.UTC), dueCmd.DueDate.UTC())
+}
+
 func TestNewProcessor(t *testing.T) {
 	processor := tag.NewProcessor()
 
@@ -505,3 +521,133 @@ func TestProcessTags_EntityManagement(t *testing.T) {
 		})
 	}
 }
+
+func TestProcessTags_ConvertToDiscussion(t *testing.T) {
+	processor := tag.NewProcessor()
+	chatID := uuid.New()
+
+	t.Run("typed chat is reverted", func(t *testing.T) {
+		result := processor.ProcessTags(chatID, []tag.ParsedTag{{Key: "discussion"}}, "Bug")
+
+		require.Len(t, result.AppliedTags, 1)
+		assert.Empty(t, result.Errors)
+		assert.Equal(t, tag.ConvertToDiscussionCommand{ChatID: chatID}, result.AppliedTags[0].Command)
+	})
+
+	t.Run("discussion cannot be reverted", func(t *testing.T) {
+		result := processor.ProcessTags(chatID, []tag.ParsedTag{{Key: "discussion"}}, "")
+
+		assert.Empty(t, result.AppliedTags)
+		require.Len(t, result.Errors, 1)
+		assert.ErrorIs(t, result.Errors[0].Error, tag.ErrNoActiveEntity)
+	})
+
+	t.Run("entity tags after the revert are rejected", func(t *testing.T) {
+		tags := []tag.ParsedTag{{Key: "discussion"}, {Key: "status", Value: "Done"}}
+		result := processor.ProcessTags(chatID, tags, "Task")
+
+		assert.Len(t, result.AppliedTags, 1)
+		assert.Len(t, result.Errors, 1)
+	})
+}
+
+func TestProcessTags_EstimateAndSprint(t *testing.T) {
+	processor := tag.NewProcessor()
+	chatID := uuid.New()
+
+	t.Run("estimate in hours", func(t *testing.T) {
+		result := processor.ProcessTags(chatID, []tag.ParsedTag{{Key: "estimate", Value: "8h"}}, "Task")
+
+		require.Len(t, result.AppliedTags, 1)
+		assert.Empty(t, result.Errors)
+		assert.Equal(t, "8 hours", result.AppliedTags[0].TagValue)
+		cmd, ok := result.AppliedTags[0].Command.(tag.SetEstimateCommand)
+		require.True(t, ok)
+		require.NotNil(t, cmd.Estimate)
+		assert.InDelta(t, 8.0, cmd.Estimate.Value, 0.001)
+	})
+
+	t.Run("empty estimate removes it", func(t *testing.T) {
+		result := processor.ProcessTags(chatID, []tag.ParsedTag{{Key: "estimate"}}, "Bug")
+
+		require.Len(t, result.AppliedTags, 1)
+		assert.Equal(t, tag.SetEstimateCommand{ChatID: chatID}, result.AppliedTags[0].Command)
+	})
+
+	t.Run("invalid estimate", func(t *testing.T) {
+		result := processor.ProcessTags(chatID, []tag.ParsedTag{{Key: "estimate", Value: "lots"}}, "Task")
+
+		assert.Empty(t, result.AppliedTags)
+		require.Len(t, result.Errors, 1)
+	})
+
+	t.Run("sprint is trimmed", func(t *testing.T) {
+		result := processor.ProcessTags(chatID, []tag.ParsedTag{{Key: "sprint", Value: " Sprint 7 "}}, "Task")
+
+		require.Len(t, result.AppliedTags, 1)
+		assert.Equal(t, tag.SetSprintCommand{ChatID: chatID, Sprint: "Sprint 7"}, result.AppliedTags[0].Command)
+	})
+
+	t.Run("discussion has no sprint", func(t *testing.T) {
+		result := processor.ProcessTags(chatID, []tag.ParsedTag{{Key: "sprint", Value: "Sprint 7"}}, "")
+
+		assert.Empty(t, result.AppliedTags)
+		require.Len(t, result.Errors, 1)
+		assert.ErrorIs(t, result.Errors[0].Error, tag.ErrNoActiveEntity)
+	})
+}
+
+func TestProcessTags_Topic(t *testing.T) {
+	processor := tag.NewProcessor()
+	chatID := uuid.New()
+
+	t.Run("topic on discussion is trimmed", func(t *testing.T) {
+		result := processor.ProcessTags(chatID, []tag.ParsedTag{{Key: "topic", Value: " Weekly sync "}}, "")
+
+		require.Len(t, result.AppliedTags, 1)
+		assert.Empty(t, result.Errors)
+		assert.Equal(t, tag.SetTopicCommand{ChatID: chatID, Topic: "Weekly sync"}, result.AppliedTags[0].Command)
+	})
+
+	t.Run("empty topic clears it", func(t *testing.T) {
+		result := processor.ProcessTags(chatID, []tag.ParsedTag{{Key: "topic"}}, "Task")
+
+		require.Len(t, result.AppliedTags, 1)
+		assert.Equal(t, tag.SetTopicCommand{ChatID: chatID}, result.AppliedTags[0].Command)
+	})
+
+	t.Run("too long topic", func(t *testing.T) {
+		long := strings.Repeat("x", 251)
+		result := processor.ProcessTags(chatID, []tag.ParsedTag{{Key: "topic", Value: long}}, "")
+
+		assert.Empty(t, result.AppliedTags)
+		require.Len(t, result.Errors, 1)
+	})
+}
+
+func TestProcessTags_Duplicate(t *testing.T) {
+	processor := tag.NewProcessor()
+	chatID := uuid.New()
+	originalID := uuid.New()
+
+	t.Run("marks duplicate of a task ID", func(t *testing.T) {
+		result := processor.ProcessTags(chatID, []tag.ParsedTag{{Key: "duplicate", Value: originalID.String()}}, "Bug")
+
+		require.Len(t, result.AppliedTags, 1)
+		assert.Equal(t, tag.MarkDuplicateCommand{ChatID: chatID, OriginalID: &originalID}, result.AppliedTags[0].Command)
+	})
+
+	t.Run("empty value clears the mark", func(t *testing.T) {
+		result := processor.ProcessTags(chatID, []tag.ParsedTag{{Key: "duplicate"}}, "Bug")
+
+		require.Len(t, result.AppliedTags, 1)
+		assert.Equal(t, tag.MarkDuplicateCommand{ChatID: chatID}, result.AppliedTags[0].Command)
+	})
+
+	t.Run("invalid task ID", func(t *testing.T) {
+		result := processor.ProcessTags(chatID, []tag.ParsedTag{{Key: "duplicate", Value: "BUG-12"}}, "Bug")
+
+		assert.Empty(t, result.AppliedTags)
+		require.Len(t, result.Errors, 1)
+	})
+}
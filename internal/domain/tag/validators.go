@@ -6,6 +6,9 @@ import (
 	"regexp"
 	"strings"
 	"time"
+
+	"github.com/google/uuid"
+	"github.com/lllypuk/flowra/internal/domain/chat"
 )
 
 // ErrNoActiveEntity is returned when Entity Management Tag is used without active entity
@@ -103,6 +106,54 @@ func validateSeverity(value string) (string, error) {
 		value, strings.Join(allowedValues, ", "))
 }
 
+// ValidateEstimate parses "5", "5pt", "5sp" (story points) or "8h" (hours)
+// empty value returns nil (removes estimate)
+//
+//nolint:nilnil // Returning (nil, nil) is intentional for empty estimate (remove estimate)
+func ValidateEstimate(value string) (*chat.Estimate, error) {
+	if strings.TrimSpace(value) == "" {
+		return nil, nil
+	}
+
+	estimate, err := chat.ParseEstimate(value)
+	if err != nil {
+		return nil, fmt.Errorf("invalid estimate '%s'. Use points (5, 5pt) or hours (8h), up to 1000", value)
+	}
+	return &estimate, nil
+}
+
+// ValidateSprint checks the sprint name length; empty value removes the sprint
+func ValidateSprint(value string) error {
+	if len(strings.TrimSpace(value)) > chat.MaxSprintLength {
+		return fmt.Errorf("sprint name is too long (max %d characters)", chat.MaxSprintLength)
+	}
+	return nil
+}
+
+// ValidateTopic checks the topic length; empty value clears the topic
+func ValidateTopic(value string) error {
+	if len(strings.TrimSpace(value)) > chat.MaxTopicLength {
+		return fmt.Errorf("topic is too long (max %d characters)", chat.MaxTopicLength)
+	}
+	return nil
+}
+
+// ValidateDuplicateOf parses the task ID of the original; empty value clears the duplicate mark
+//
+//nolint:nilnil // Returning (nil, nil) is intentional for empty value (not a duplicate)
+func ValidateDuplicateOf(value string) (*uuid.UUID, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return nil, nil
+	}
+
+	originalID, err := uuid.Parse(value)
+	if err != nil {
+		return nil, fmt.Errorf("invalid task ID '%s'", value)
+	}
+	return &originalID, nil
+}
+
 // matchCaseInsensitive finds a case-insensitive match in the allowed values
 // and returns the canonical (properly-cased) value.
 func matchCaseInsensitive(value string, allowed []string) (string, bool) {
@@ -162,13 +213,23 @@ func ValidateStatus(entityType, status string) (string, error) {
 
 // ValidateDueDate parses date and returns *time.Time
 // empty value returns nil (removes due date)
+func ValidateDueDate(dateStr string) (*time.Time, error) {
+	return ValidateDueDateInLocation(dateStr, time.UTC)
+}
+
+// ValidateDueDateInLocation parses date like ValidateDueDate, interpreting
+// values without an explicit offset in loc
+// empty value returns nil (removes due date)
 //
 //nolint:nilnil // Returning (nil, nil) is intentional for empty date (remove due date)
-func ValidateDueDate(dateStr string) (*time.Time, error) {
+func ValidateDueDateInLocation(dateStr string, loc *time.Location) (*time.Time, error) {
 	// empty value is allowed (removes due date)
 	if dateStr == "" {
 		return nil, nil
 	}
+	if loc == nil {
+		loc = time.UTC
+	}
 
 	// supported formats (MVP)
 	formats := []string{
@@ -181,7 +242,7 @@ func ValidateDueDate(dateStr string) (*time.Time, error) {
 
 	// try to parse date in one of the formats
 	for _, format := range formats {
-		if t, err := time.Parse(format, dateStr); err == nil {
+		if t, err := time.ParseInLocation(format, dateStr, loc); err == nil {
 			return &t, nil
 		}
 	}
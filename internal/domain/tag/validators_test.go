@@ -2,6 +2,7 @@ package tag //nolint:testpackage // to test unexported functions
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -571,6 +572,29 @@ func TestValidateDueDate(t *testing.T) {
 	}
 }
 
+func TestValidateDueDateInLocation(t *testing.T) {
+	loc, err := time.LoadLocation("America/New_York")
+	require.NoError(t, err)
+
+	t.Run("date is midnight in location", func(t *testing.T) {
+		result, dueErr := ValidateDueDateInLocation("2025-10-20", loc)
+		require.NoError(t, dueErr)
+		assert.Equal(t, time.Date(2025, 10, 20, 4, 0, 0, 0, time.UTC), result.UTC())
+	})
+
+	t.Run("explicit offset wins", func(t *testing.T) {
+		result, dueErr := ValidateDueDateInLocation("2025-10-20T10:00:00Z", loc)
+		require.NoError(t, dueErr)
+		assert.Equal(t, time.Date(2025, 10, 20, 10, 0, 0, 0, time.UTC), result.UTC())
+	})
+
+	t.Run("nil location means UTC", func(t *testing.T) {
+		result, dueErr := ValidateDueDateInLocation("2025-10-20", nil)
+		require.NoError(t, dueErr)
+		assert.Equal(t, time.Date(2025, 10, 20, 0, 0, 0, 0, time.UTC), *result)
+	})
+}
+
 func TestValidateTitle(t *testing.T) {
 	tests := []struct {
 		name    string
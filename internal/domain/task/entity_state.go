@@ -1,6 +1,8 @@
 package task
 
 import (
+	"strings"
+
 	"github.com/lllypuk/flowra/internal/domain/errs"
 )
 
@@ -121,6 +123,28 @@ func (s *EntityState) Status() Status { return s.status }
 // Priority returns prioritet
 func (s *EntityState) Priority() Priority { return s.priority }
 
+// StatusFromChatStatus maps the status of a typed chat (task, bug or epic
+// workflow) onto the board statuses. Unknown values fall back to To Do.
+func StatusFromChatStatus(status string) Status {
+	normalized := strings.ToLower(strings.TrimSpace(status))
+	switch normalized {
+	case "", "new", "planned":
+		return StatusToDo
+	case "investigating":
+		return StatusInProgress
+	case "fixed":
+		return StatusInReview
+	case "verified", "completed", "closed":
+		return StatusDone
+	}
+	for _, s := range []Status{StatusBacklog, StatusToDo, StatusInProgress, StatusInReview, StatusDone, StatusCancelled} {
+		if normalized == strings.ToLower(string(s)) {
+			return s
+		}
+	}
+	return StatusToDo
+}
+
 // Validation helpers
 
 func isValidEntityType(t EntityType) bool {
@@ -1,12 +1,16 @@
 package user
 
 import (
+	"regexp"
 	"time"
 
 	"github.com/lllypuk/flowra/internal/domain/errs"
 	"github.com/lllypuk/flowra/internal/domain/uuid"
 )
 
+// localePattern accepts a language subtag with an optional region, e.g. "en" or "pt-BR".
+var localePattern = regexp.MustCompile(`^[a-z]{2,3}(-[A-Z]{2})?$`)
+
 // User represents user sistemy
 type User struct {
 	id            uuid.UUID
@@ -14,6 +18,8 @@ type User struct {
 	username      string
 	email         string
 	displayName   string
+	locale        string // preferred UI language; empty means negotiate from the request
+	timezone      string // IANA timezone name; empty means UTC
 	isSystemAdmin bool
 	isActive      bool // flag aktivnosti user (for soft-delete at udalenii from Keycloak)
 	createdAt     time.Time
@@ -47,7 +53,7 @@ func NewUser(externalID, username, email, displayName string) (*User, error) {
 // Reconstruct reconstructs user from save
 func Reconstruct(
 	id uuid.UUID,
-	externalID, username, email, displayName string,
+	externalID, username, email, displayName, locale, timezone string,
 	isSystemAdmin, isActive bool,
 	createdAt, updatedAt time.Time,
 ) *User {
@@ -57,6 +63,8 @@ func Reconstruct(
 		username:      username,
 		email:         email,
 		displayName:   displayName,
+		locale:        locale,
+		timezone:      timezone,
 		isSystemAdmin: isSystemAdmin,
 		isActive:      isActive,
 		createdAt:     createdAt,
@@ -91,6 +99,28 @@ func (u *User) DisplayName() string {
 	return u.displayName
 }
 
+// Locale returns the preferred UI language, or "" when not set
+func (u *User) Locale() string {
+	return u.locale
+}
+
+// Timezone returns the IANA timezone name, or "" when not set
+func (u *User) Timezone() string {
+	return u.timezone
+}
+
+// Location returns the user's time zone, falling back to UTC when unset or unknown
+func (u *User) Location() *time.Location {
+	if u.timezone == "" {
+		return time.UTC
+	}
+	loc, err := time.LoadLocation(u.timezone)
+	if err != nil {
+		return time.UTC
+	}
+	return loc
+}
+
 // IsSystemAdmin returns flag sistemnogo administrator
 func (u *User) IsSystemAdmin() bool {
 	return u.isSystemAdmin
@@ -133,6 +163,32 @@ func (u *User) UpdateProfile(displayName *string, email *string) error {
 	return nil
 }
 
+// SetLocale sets the preferred UI language as a language tag (e.g. "en", "ru-RU").
+// An empty locale clears the preference.
+func (u *User) SetLocale(locale string) error {
+	if locale != "" && !localePattern.MatchString(locale) {
+		return errs.ErrInvalidInput
+	}
+
+	u.locale = locale
+	u.updatedAt = time.Now()
+	return nil
+}
+
+// SetTimezone sets the IANA timezone (e.g. "Europe/Berlin") used to interpret and
+// display dates for the user. An empty timezone clears the preference.
+func (u *User) SetTimezone(timezone string) error {
+	if timezone != "" {
+		if _, err := time.LoadLocation(timezone); err != nil || timezone == "Local" {
+			return errs.ErrInvalidInput
+		}
+	}
+
+	u.timezone = timezone
+	u.updatedAt = time.Now()
+	return nil
+}
+
 // SetAdmin sets prava administrator
 func (u *User) SetAdmin(isAdmin bool) {
 	u.isSystemAdmin = isAdmin
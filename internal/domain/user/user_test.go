@@ -100,6 +100,8 @@ func TestReconstruct(t *testing.T) {
 		username,
 		email,
 		displayName,
+		"ru",
+		"Europe/Moscow",
 		isSystemAdmin,
 		true,
 		createdAt,
@@ -113,6 +115,8 @@ func TestReconstruct(t *testing.T) {
 	assert.Equal(t, username, user.Username())
 	assert.Equal(t, email, user.Email())
 	assert.Equal(t, displayName, user.DisplayName())
+	assert.Equal(t, "ru", user.Locale())
+	assert.Equal(t, "Europe/Moscow", user.Timezone())
 	assert.True(t, user.IsSystemAdmin())
 	assert.Equal(t, createdAt, user.CreatedAt())
 	assert.Equal(t, updatedAt, user.UpdatedAt())
@@ -152,6 +156,38 @@ func TestUser_UpdateProfile_NothingToUpdate(t *testing.T) {
 	assert.Equal(t, oldUpdatedAt, user.UpdatedAt(), "UpdatedAt should not change")
 }
 
+func TestUser_SetLocale(t *testing.T) {
+	user, _ := userDomain.NewUser("external-john", "john", "john@example.com", "John")
+	assert.Empty(t, user.Locale())
+
+	require.NoError(t, user.SetLocale("pt-BR"))
+	assert.Equal(t, "pt-BR", user.Locale())
+
+	require.ErrorIs(t, user.SetLocale("Russian"), errs.ErrInvalidInput)
+	assert.Equal(t, "pt-BR", user.Locale())
+
+	require.NoError(t, user.SetLocale(""))
+	assert.Empty(t, user.Locale())
+}
+
+func TestUser_SetTimezone(t *testing.T) {
+	user, _ := userDomain.NewUser("external-john", "john", "john@example.com", "John")
+	assert.Empty(t, user.Timezone())
+	assert.Equal(t, time.UTC, user.Location())
+
+	require.NoError(t, user.SetTimezone("America/New_York"))
+	assert.Equal(t, "America/New_York", user.Timezone())
+	assert.Equal(t, "America/New_York", user.Location().String())
+
+	require.ErrorIs(t, user.SetTimezone("Mars/Olympus"), errs.ErrInvalidInput)
+	require.ErrorIs(t, user.SetTimezone("Local"), errs.ErrInvalidInput)
+	assert.Equal(t, "America/New_York", user.Timezone())
+
+	require.NoError(t, user.SetTimezone(""))
+	assert.Empty(t, user.Timezone())
+	assert.Equal(t, time.UTC, user.Location())
+}
+
 func TestUser_SetAdmin_GrantRights(t *testing.T) {
 	// Arrange
 	user, _ := userDomain.NewUser("external-john", "john", "john@example.com", "John")
@@ -178,6 +214,8 @@ func TestUser_SetAdmin_RevokeRights(t *testing.T) {
 		"admin",
 		"admin@example.com",
 		"Admin",
+		"",   // locale
+		"",   // timezone
 		true, // isSystemAdmin
 		true, // isActive
 		time.Now(),
@@ -208,7 +246,7 @@ func TestUser_AllGetters(t *testing.T) {
 	createdAt := time.Now().Add(-48 * time.Hour)
 	updatedAt := time.Now().Add(-24 * time.Hour)
 
-	user := userDomain.Reconstruct(id, keycloakID, username, email, displayName, isAdmin, true, createdAt, updatedAt)
+	user := userDomain.Reconstruct(id, keycloakID, username, email, displayName, "", "", isAdmin, true, createdAt, updatedAt)
 
 	// Act & Assert
 	t.Run("ID", func(t *testing.T) {
@@ -254,7 +292,7 @@ func TestUser_IsActive(t *testing.T) {
 	t.Run("reconstructed user preserves active status", func(t *testing.T) {
 		id := uuid.NewUUID()
 		user := userDomain.Reconstruct(
-			id, "ext-123", "john", "john@example.com", "John", false, false,
+			id, "ext-123", "john", "john@example.com", "John", "", "", false, false,
 			time.Now(), time.Now(),
 		)
 		assert.False(t, user.IsActive())
@@ -279,7 +317,7 @@ func TestUser_SetActive(t *testing.T) {
 	t.Run("reactivates user", func(t *testing.T) {
 		id := uuid.NewUUID()
 		user := userDomain.Reconstruct(
-			id, "ext-123", "john", "john@example.com", "John", false, false,
+			id, "ext-123", "john", "john@example.com", "John", "", "", false, false,
 			time.Now(), time.Now(),
 		)
 		assert.False(t, user.IsActive())
@@ -0,0 +1,51 @@
+package workspace
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/lllypuk/flowra/internal/domain/errs"
+	"github.com/lllypuk/flowra/internal/domain/uuid"
+)
+
+// accentColorPattern matches a hex color in the #RRGGBB form.
+var accentColorPattern = regexp.MustCompile(`^#[0-9a-fA-F]{6}$`)
+
+// Branding holds the avatar and accent color of a workspace. The avatar is a
+// file in the blob store; a zero avatarFileID means no avatar is set.
+type Branding struct {
+	avatarFileID   uuid.UUID
+	avatarFileName string
+	accentColor    string
+}
+
+// NewBranding creates branding with the given avatar file and accent color.
+// The accent color must be empty or a #RRGGBB hex color; it is stored in lower case.
+func NewBranding(avatarFileID uuid.UUID, avatarFileName, accentColor string) (Branding, error) {
+	if accentColor != "" && !accentColorPattern.MatchString(accentColor) {
+		return Branding{}, errs.ErrInvalidInput
+	}
+	if !avatarFileID.IsZero() && avatarFileName == "" {
+		return Branding{}, errs.ErrInvalidInput
+	}
+	if avatarFileID.IsZero() {
+		avatarFileName = ""
+	}
+	return Branding{
+		avatarFileID:   avatarFileID,
+		avatarFileName: avatarFileName,
+		accentColor:    strings.ToLower(accentColor),
+	}, nil
+}
+
+// AvatarFileID returns the blob store ID of the avatar, zero if none
+func (b Branding) AvatarFileID() uuid.UUID { return b.avatarFileID }
+
+// AvatarFileName returns the stored file name of the avatar
+func (b Branding) AvatarFileName() string { return b.avatarFileName }
+
+// HasAvatar reports whether an avatar is set
+func (b Branding) HasAvatar() bool { return !b.avatarFileID.IsZero() }
+
+// AccentColor returns the #rrggbb accent color, empty if none
+func (b Branding) AccentColor() string { return b.accentColor }
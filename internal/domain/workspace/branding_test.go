@@ -0,0 +1,45 @@
+package workspace_test
+
+import (
+	"testing"
+
+	"github.com/lllypuk/flowra/internal/domain/errs"
+	"github.com/lllypuk/flowra/internal/domain/uuid"
+	"github.com/lllypuk/flowra/internal/domain/workspace"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewBranding(t *testing.T) {
+	t.Run("valid avatar and color", func(t *testing.T) {
+		fileID := uuid.NewUUID()
+
+		branding, err := workspace.NewBranding(fileID, "logo.png", "#1A2B3C")
+
+		require.NoError(t, err)
+		assert.True(t, branding.HasAvatar())
+		assert.Equal(t, fileID, branding.AvatarFileID())
+		assert.Equal(t, "logo.png", branding.AvatarFileName())
+		assert.Equal(t, "#1a2b3c", branding.AccentColor())
+	})
+
+	t.Run("empty branding", func(t *testing.T) {
+		branding, err := workspace.NewBranding("", "", "")
+
+		require.NoError(t, err)
+		assert.False(t, branding.HasAvatar())
+		assert.Empty(t, branding.AccentColor())
+	})
+
+	t.Run("invalid color", func(t *testing.T) {
+		for _, color := range []string{"red", "#fff", "1a2b3c", "#1a2b3g"} {
+			_, err := workspace.NewBranding("", "", color)
+			require.ErrorIs(t, err, errs.ErrInvalidInput, color)
+		}
+	})
+
+	t.Run("avatar without file name", func(t *testing.T) {
+		_, err := workspace.NewBranding(uuid.NewUUID(), "", "")
+		require.ErrorIs(t, err, errs.ErrInvalidInput)
+	})
+}
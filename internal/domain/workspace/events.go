@@ -15,6 +15,15 @@ const (
 	EventTypeInviteCreated    = "workspace.invite.created"
 	EventTypeInviteUsed       = "workspace.invite.used"
 	EventTypeInviteRevoked    = "workspace.invite.revoked"
+
+	EventTypeMemberAdded       = "workspace.member.added"
+	EventTypeMemberRemoved     = "workspace.member.removed"
+	EventTypeMemberRoleChanged = "workspace.member.role_changed"
+	EventTypeMemberPending     = "workspace.member.pending"
+	EventTypeMemberRejected    = "workspace.member.rejected"
+	EventTypeMemberApproved    = "workspace.member.approved"
+
+	EventTypeStorageQuotaWarning = "workspace.storage.quota_warning"
 )
 
 // Created event creating workspace prostranstva
@@ -133,3 +142,123 @@ func NewInviteRevoked(inviteID, workspaceID, revokedBy uuid.UUID, metadata event
 		RevokedBy:   revokedBy,
 	}
 }
+
+// MemberAdded event adding a member to the workspace
+type MemberAdded struct {
+	event.BaseEvent
+
+	UserID uuid.UUID
+	Role   Role
+}
+
+// NewMemberAdded creates new event MemberAdded
+func NewMemberAdded(workspaceID, userID uuid.UUID, role Role, metadata event.Metadata) *MemberAdded {
+	return &MemberAdded{
+		BaseEvent: event.NewBaseEvent(EventTypeMemberAdded, workspaceID.String(), "Workspace", 1, metadata),
+		UserID:    userID,
+		Role:      role,
+	}
+}
+
+// MemberRemoved event removing a member from the workspace
+type MemberRemoved struct {
+	event.BaseEvent
+
+	UserID uuid.UUID
+}
+
+// NewMemberRemoved creates new event MemberRemoved
+func NewMemberRemoved(workspaceID, userID uuid.UUID, metadata event.Metadata) *MemberRemoved {
+	return &MemberRemoved{
+		BaseEvent: event.NewBaseEvent(EventTypeMemberRemoved, workspaceID.String(), "Workspace", 1, metadata),
+		UserID:    userID,
+	}
+}
+
+// MemberRoleChanged event changing a member's role
+type MemberRoleChanged struct {
+	event.BaseEvent
+
+	UserID uuid.UUID
+	Role   Role
+}
+
+// NewMemberRoleChanged creates new event MemberRoleChanged
+func NewMemberRoleChanged(workspaceID, userID uuid.UUID, role Role, metadata event.Metadata) *MemberRoleChanged {
+	return &MemberRoleChanged{
+		BaseEvent: event.NewBaseEvent(EventTypeMemberRoleChanged, workspaceID.String(), "Workspace", 1, metadata),
+		UserID:    userID,
+		Role:      role,
+	}
+}
+
+// MemberPending event of a membership waiting for admin approval
+type MemberPending struct {
+	event.BaseEvent
+
+	UserID uuid.UUID
+	Role   Role
+}
+
+// NewMemberPending creates new event MemberPending
+func NewMemberPending(workspaceID, userID uuid.UUID, role Role, metadata event.Metadata) *MemberPending {
+	return &MemberPending{
+		BaseEvent: event.NewBaseEvent(EventTypeMemberPending, workspaceID.String(), "Workspace", 1, metadata),
+		UserID:    userID,
+		Role:      role,
+	}
+}
+
+// MemberApproved event of an admin approving a pending membership
+type MemberApproved struct {
+	event.BaseEvent
+
+	UserID uuid.UUID
+	Role   Role
+}
+
+// NewMemberApproved creates new event MemberApproved
+func NewMemberApproved(workspaceID, userID uuid.UUID, role Role, metadata event.Metadata) *MemberApproved {
+	return &MemberApproved{
+		BaseEvent: event.NewBaseEvent(EventTypeMemberApproved, workspaceID.String(), "Workspace", 1, metadata),
+		UserID:    userID,
+		Role:      role,
+	}
+}
+
+// MemberRejected event of an admin rejecting a pending membership
+type MemberRejected struct {
+	event.BaseEvent
+
+	UserID uuid.UUID
+}
+
+// NewMemberRejected creates new event MemberRejected
+func NewMemberRejected(workspaceID, userID uuid.UUID, metadata event.Metadata) *MemberRejected {
+	return &MemberRejected{
+		BaseEvent: event.NewBaseEvent(EventTypeMemberRejected, workspaceID.String(), "Workspace", 1, metadata),
+		UserID:    userID,
+	}
+}
+
+// StorageQuotaWarning event of a workspace whose attachments reached the warning
+// share of its storage quota
+type StorageQuotaWarning struct {
+	event.BaseEvent
+
+	UsedBytes  int64
+	QuotaBytes int64
+}
+
+// NewStorageQuotaWarning creates new event StorageQuotaWarning
+func NewStorageQuotaWarning(
+	workspaceID uuid.UUID,
+	usedBytes, quotaBytes int64,
+	metadata event.Metadata,
+) *StorageQuotaWarning {
+	return &StorageQuotaWarning{
+		BaseEvent:  event.NewBaseEvent(EventTypeStorageQuotaWarning, workspaceID.String(), "Workspace", 1, metadata),
+		UsedBytes:  usedBytes,
+		QuotaBytes: quotaBytes,
+	}
+}
@@ -3,6 +3,7 @@ package workspace
 import (
 	"time"
 
+	"github.com/lllypuk/flowra/internal/domain/errs"
 	"github.com/lllypuk/flowra/internal/domain/uuid"
 )
 
@@ -33,12 +34,23 @@ func (r Role) String() string {
 	return string(r)
 }
 
+// MemberStatus represents state chlenstva in workspace
+type MemberStatus string
+
+const (
+	// MemberStatusActive chlen s polnym dostupom
+	MemberStatusActive MemberStatus = "active"
+	// MemberStatusPending chlenstvo ozhidaet approval administrator
+	MemberStatusPending MemberStatus = "pending"
+)
+
 // Member represents chlena workspace prostranstva (value object)
 type Member struct {
 	userID      uuid.UUID
 	workspaceID uuid.UUID
 	role        Role
 	joinedAt    time.Time
+	status      MemberStatus
 }
 
 // NewMember creates novogo chlena workspace
@@ -48,9 +60,18 @@ func NewMember(userID, workspaceID uuid.UUID, role Role) Member {
 		workspaceID: workspaceID,
 		role:        role,
 		joinedAt:    time.Now(),
+		status:      MemberStatusActive,
 	}
 }
 
+// NewPendingMember creates chlenstvo, which ozhidaet approval administrator
+// (workspace s RequiresJoinApproval)
+func NewPendingMember(userID, workspaceID uuid.UUID, role Role) Member {
+	m := NewMember(userID, workspaceID, role)
+	m.status = MemberStatusPending
+	return m
+}
+
 // ReconstructMember reconstructs Member from save.
 // Used by repositories for hydration obekta without validation business rules.
 // all parameters dolzhny byt valid values from save.
@@ -59,12 +80,17 @@ func ReconstructMember(
 	workspaceID uuid.UUID,
 	role Role,
 	joinedAt time.Time,
+	status MemberStatus,
 ) Member {
+	if status == "" {
+		status = MemberStatusActive
+	}
 	return Member{
 		userID:      userID,
 		workspaceID: workspaceID,
 		role:        role,
 		joinedAt:    joinedAt,
+		status:      status,
 	}
 }
 
@@ -80,6 +106,12 @@ func (m Member) Role() Role { return m.role }
 // JoinedAt returns time prisoedineniya
 func (m Member) JoinedAt() time.Time { return m.joinedAt }
 
+// Status returns state chlenstva
+func (m Member) Status() MemberStatus { return m.status }
+
+// IsPending checks, ozhidaet li chlenstvo approval administrator
+func (m Member) IsPending() bool { return m.status == MemberStatusPending }
+
 // IsOwner checks, is li uchastnik vladeltsem
 func (m Member) IsOwner() bool { return m.role == RoleOwner }
 
@@ -99,5 +131,21 @@ func (m Member) WithRole(role Role) Member {
 		workspaceID: m.workspaceID,
 		role:        role,
 		joinedAt:    m.joinedAt,
+		status:      m.status,
+	}
+}
+
+// Approve returns aktivnuyu kopiyu pending Member (immutable update).
+// joinedAt is set to the moment of approval.
+func (m Member) Approve() (Member, error) {
+	if !m.IsPending() {
+		return Member{}, errs.ErrInvalidState
 	}
+	return Member{
+		userID:      m.userID,
+		workspaceID: m.workspaceID,
+		role:        m.role,
+		joinedAt:    time.Now(),
+		status:      MemberStatusActive,
+	}, nil
 }
@@ -4,9 +4,11 @@ import (
 	"testing"
 	"time"
 
+	"github.com/lllypuk/flowra/internal/domain/errs"
 	"github.com/lllypuk/flowra/internal/domain/uuid"
 	"github.com/lllypuk/flowra/internal/domain/workspace"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestNewMember(t *testing.T) {
@@ -56,12 +58,50 @@ func TestReconstructMember(t *testing.T) {
 		role := workspace.RoleAdmin
 		joinedAt := time.Now().Add(-24 * time.Hour)
 
-		member := workspace.ReconstructMember(userID, workspaceID, role, joinedAt)
+		member := workspace.ReconstructMember(userID, workspaceID, role, joinedAt, workspace.MemberStatusPending)
 
 		assert.Equal(t, userID, member.UserID())
 		assert.Equal(t, workspaceID, member.WorkspaceID())
 		assert.Equal(t, role, member.Role())
 		assert.Equal(t, joinedAt, member.JoinedAt())
+		assert.True(t, member.IsPending())
+	})
+
+	t.Run("empty status is active", func(t *testing.T) {
+		member := workspace.ReconstructMember(uuid.NewUUID(), uuid.NewUUID(), workspace.RoleMember, time.Now(), "")
+
+		assert.Equal(t, workspace.MemberStatusActive, member.Status())
+		assert.False(t, member.IsPending())
+	})
+}
+
+func TestNewPendingMember(t *testing.T) {
+	member := workspace.NewPendingMember(uuid.NewUUID(), uuid.NewUUID(), workspace.RoleMember)
+
+	assert.True(t, member.IsPending())
+	assert.Equal(t, workspace.MemberStatusPending, member.Status())
+	assert.False(t, workspace.NewMember(uuid.NewUUID(), uuid.NewUUID(), workspace.RoleMember).IsPending())
+}
+
+func TestMember_Approve(t *testing.T) {
+	t.Run("activates pending member", func(t *testing.T) {
+		pending := workspace.NewPendingMember(uuid.NewUUID(), uuid.NewUUID(), workspace.RoleAdmin)
+
+		approved, err := pending.Approve()
+
+		require.NoError(t, err)
+		assert.False(t, approved.IsPending())
+		assert.Equal(t, pending.UserID(), approved.UserID())
+		assert.Equal(t, workspace.RoleAdmin, approved.Role())
+		assert.True(t, pending.IsPending(), "original value must not change")
+	})
+
+	t.Run("active member cannot be approved", func(t *testing.T) {
+		member := workspace.NewMember(uuid.NewUUID(), uuid.NewUUID(), workspace.RoleMember)
+
+		_, err := member.Approve()
+
+		assert.ErrorIs(t, err, errs.ErrInvalidState)
 	})
 }
 
@@ -0,0 +1,125 @@
+package workspace
+
+import (
+	"maps"
+	"slices"
+	"time"
+
+	"github.com/lllypuk/flowra/internal/domain/chat"
+	"github.com/lllypuk/flowra/internal/domain/errs"
+)
+
+// SLATarget is the time allowed to respond to and to resolve a bug of one
+// severity. A zero duration leaves that clock untracked.
+type SLATarget struct {
+	Response   time.Duration
+	Resolution time.Duration
+}
+
+// IsZero reports whether the target tracks neither clock
+func (t SLATarget) IsZero() bool { return t.Response == 0 && t.Resolution == 0 }
+
+// SLAPolicy holds the SLA targets of a workspace keyed by bug severity.
+// Bugs of a severity without a target, or without a severity, have no SLA.
+type SLAPolicy struct {
+	targets map[string]SLATarget
+}
+
+// NewSLAPolicy creates a policy from targets keyed by severity.
+// Every severity must be a global one, durations must not be negative and a
+// response target must not exceed the resolution target; zero targets are dropped.
+func NewSLAPolicy(targets map[string]SLATarget) (SLAPolicy, error) {
+	policy := SLAPolicy{}
+
+	for severity, target := range targets {
+		if !slices.Contains(chat.Severities(), severity) {
+			return SLAPolicy{}, errs.ErrInvalidInput
+		}
+		if target.Response < 0 || target.Resolution < 0 {
+			return SLAPolicy{}, errs.ErrInvalidInput
+		}
+		if target.Response > 0 && target.Resolution > 0 && target.Response > target.Resolution {
+			return SLAPolicy{}, errs.ErrInvalidInput
+		}
+		if target.IsZero() {
+			continue
+		}
+		if policy.targets == nil {
+			policy.targets = make(map[string]SLATarget)
+		}
+		policy.targets[severity] = target
+	}
+
+	return policy, nil
+}
+
+// IsZero reports whether the policy tracks no severity
+func (p SLAPolicy) IsZero() bool { return len(p.targets) == 0 }
+
+// Targets returns the targets keyed by severity
+func (p SLAPolicy) Targets() map[string]SLATarget { return maps.Clone(p.targets) }
+
+// Target returns the target for bugs of the severity
+func (p SLAPolicy) Target(severity string) (SLATarget, bool) {
+	target, ok := p.targets[severity]
+	return target, ok
+}
+
+// Evaluate measures the clock of a bug with the severity against its target at now.
+// The status is zero when the severity has no target or the clock is not running.
+func (p SLAPolicy) Evaluate(severity string, clock chat.SLAClock, now time.Time) SLAStatus {
+	target, ok := p.targets[severity]
+	if !ok || clock.IsZero() {
+		return SLAStatus{}
+	}
+
+	return SLAStatus{
+		Response:   newSLADeadline(clock.OpenedAt(), target.Response, clock.RespondedAt(), now),
+		Resolution: newSLADeadline(clock.OpenedAt(), target.Resolution, clock.ResolvedAt(), now),
+	}
+}
+
+// SLAStatus is the state of the SLA clocks of a bug at a point in time
+type SLAStatus struct {
+	Response   SLADeadline
+	Resolution SLADeadline
+}
+
+// IsTracked reports whether any clock of the bug is tracked
+func (s SLAStatus) IsTracked() bool { return s.Response.IsTracked() || s.Resolution.IsTracked() }
+
+// SLADeadline is one SLA clock measured against its target; zero when untracked
+type SLADeadline struct {
+	// DueAt is when the clock breaches the target
+	DueAt time.Time
+	// StoppedAt is when the clock stopped, nil while it is running
+	StoppedAt *time.Time
+	// Breached is set when the clock stopped after DueAt or is still running past it
+	Breached bool
+}
+
+func newSLADeadline(openedAt time.Time, target time.Duration, stoppedAt *time.Time, now time.Time) SLADeadline {
+	if target <= 0 {
+		return SLADeadline{}
+	}
+
+	deadline := SLADeadline{
+		DueAt:     openedAt.Add(target),
+		StoppedAt: stoppedAt,
+	}
+	if stoppedAt != nil {
+		deadline.Breached = stoppedAt.After(deadline.DueAt)
+	} else {
+		deadline.Breached = now.After(deadline.DueAt)
+	}
+	return deadline
+}
+
+// IsTracked reports whether the clock has a target
+func (d SLADeadline) IsTracked() bool { return !d.DueAt.IsZero() }
+
+// IsRunning reports whether the clock is tracked and has not stopped
+func (d SLADeadline) IsRunning() bool { return d.IsTracked() && d.StoppedAt == nil }
+
+// Remaining returns the time left until DueAt; negative once it has passed
+func (d SLADeadline) Remaining(now time.Time) time.Duration { return d.DueAt.Sub(now) }
@@ -0,0 +1,92 @@
+package workspace_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/lllypuk/flowra/internal/domain/chat"
+	"github.com/lllypuk/flowra/internal/domain/errs"
+	"github.com/lllypuk/flowra/internal/domain/workspace"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewSLAPolicy(t *testing.T) {
+	t.Run("zero targets are dropped", func(t *testing.T) {
+		policy, err := workspace.NewSLAPolicy(map[string]workspace.SLATarget{
+			"Minor":    {},
+			"Critical": {Response: time.Hour, Resolution: 8 * time.Hour},
+		})
+
+		require.NoError(t, err)
+		assert.False(t, policy.IsZero())
+		assert.Len(t, policy.Targets(), 1)
+		_, ok := policy.Target("Minor")
+		assert.False(t, ok)
+	})
+
+	t.Run("rejects unknown severity", func(t *testing.T) {
+		_, err := workspace.NewSLAPolicy(map[string]workspace.SLATarget{"Urgent": {Response: time.Hour}})
+
+		require.ErrorIs(t, err, errs.ErrInvalidInput)
+	})
+
+	t.Run("rejects negative durations", func(t *testing.T) {
+		_, err := workspace.NewSLAPolicy(map[string]workspace.SLATarget{"Major": {Response: -time.Hour}})
+
+		require.ErrorIs(t, err, errs.ErrInvalidInput)
+	})
+
+	t.Run("rejects response longer than resolution", func(t *testing.T) {
+		_, err := workspace.NewSLAPolicy(map[string]workspace.SLATarget{
+			"Major": {Response: 2 * time.Hour, Resolution: time.Hour},
+		})
+
+		require.ErrorIs(t, err, errs.ErrInvalidInput)
+	})
+}
+
+func TestSLAPolicy_Evaluate(t *testing.T) {
+	policy, err := workspace.NewSLAPolicy(map[string]workspace.SLATarget{
+		"Critical": {Response: time.Hour, Resolution: 4 * time.Hour},
+	})
+	require.NoError(t, err)
+
+	openedAt := time.Date(2026, 1, 5, 9, 0, 0, 0, time.UTC)
+
+	t.Run("severity without target is untracked", func(t *testing.T) {
+		status := policy.Evaluate("Minor", chat.NewSLAClock(openedAt, nil, nil), openedAt)
+
+		assert.False(t, status.IsTracked())
+	})
+
+	t.Run("running clocks count down", func(t *testing.T) {
+		now := openedAt.Add(30 * time.Minute)
+		status := policy.Evaluate("Critical", chat.NewSLAClock(openedAt, nil, nil), now)
+
+		require.True(t, status.IsTracked())
+		assert.True(t, status.Response.IsRunning())
+		assert.False(t, status.Response.Breached)
+		assert.Equal(t, 30*time.Minute, status.Response.Remaining(now))
+		assert.Equal(t, openedAt.Add(4*time.Hour), status.Resolution.DueAt)
+	})
+
+	t.Run("running clock past due is breached", func(t *testing.T) {
+		status := policy.Evaluate("Critical", chat.NewSLAClock(openedAt, nil, nil), openedAt.Add(2*time.Hour))
+
+		assert.True(t, status.Response.Breached)
+		assert.False(t, status.Resolution.Breached)
+	})
+
+	t.Run("stopped clock keeps its outcome", func(t *testing.T) {
+		respondedAt := openedAt.Add(20 * time.Minute)
+		resolvedAt := openedAt.Add(5 * time.Hour)
+		status := policy.Evaluate(
+			"Critical", chat.NewSLAClock(openedAt, &respondedAt, &resolvedAt), openedAt.Add(48*time.Hour),
+		)
+
+		assert.False(t, status.Response.IsRunning())
+		assert.False(t, status.Response.Breached)
+		assert.True(t, status.Resolution.Breached)
+	})
+}
@@ -0,0 +1,103 @@
+package workspace
+
+import (
+	"slices"
+
+	"github.com/lllypuk/flowra/internal/domain/chat"
+	"github.com/lllypuk/flowra/internal/domain/errs"
+)
+
+// ValuePolicy restricts the priorities and severities allowed per entity type
+// (task, bug, epic) in a workspace. An entity type without a list allows every
+// global value; severities can only be restricted for bugs.
+type ValuePolicy struct {
+	priorities map[chat.Type][]string
+	severities map[chat.Type][]string
+}
+
+// NewValuePolicy creates a policy from allowed values keyed by entity type.
+// Every value must be one of the global priorities/severities; empty lists are dropped.
+func NewValuePolicy(priorities, severities map[chat.Type][]string) (ValuePolicy, error) {
+	policy := ValuePolicy{}
+
+	for entityType, values := range priorities {
+		if !isEntityType(entityType) || !allKnown(values, chat.Priorities()) {
+			return ValuePolicy{}, errs.ErrInvalidInput
+		}
+		policy.priorities = setAllowed(policy.priorities, entityType, values, chat.Priorities())
+	}
+
+	for entityType, values := range severities {
+		if entityType != chat.TypeBug || !allKnown(values, chat.Severities()) {
+			return ValuePolicy{}, errs.ErrInvalidInput
+		}
+		policy.severities = setAllowed(policy.severities, entityType, values, chat.Severities())
+	}
+
+	return policy, nil
+}
+
+// IsZero reports whether the policy restricts nothing
+func (p ValuePolicy) IsZero() bool {
+	return len(p.priorities) == 0 && len(p.severities) == 0
+}
+
+// Priorities returns the restricted priority lists keyed by entity type
+func (p ValuePolicy) Priorities() map[chat.Type][]string { return cloneAllowed(p.priorities) }
+
+// Severities returns the restricted severity lists keyed by entity type
+func (p ValuePolicy) Severities() map[chat.Type][]string { return cloneAllowed(p.severities) }
+
+// IsPriorityAllowed checks the priority against the list of the entity type
+func (p ValuePolicy) IsPriorityAllowed(entityType chat.Type, priority string) bool {
+	allowed, restricted := p.priorities[entityType]
+	return !restricted || slices.Contains(allowed, priority)
+}
+
+// IsSeverityAllowed checks the severity against the list of the entity type
+func (p ValuePolicy) IsSeverityAllowed(entityType chat.Type, severity string) bool {
+	allowed, restricted := p.severities[entityType]
+	return !restricted || slices.Contains(allowed, severity)
+}
+
+func isEntityType(t chat.Type) bool {
+	return t == chat.TypeTask || t == chat.TypeBug || t == chat.TypeEpic
+}
+
+func allKnown(values, known []string) bool {
+	for _, v := range values {
+		if !slices.Contains(known, v) {
+			return false
+		}
+	}
+	return true
+}
+
+// setAllowed stores the values deduplicated and in the global order; an empty list lifts the restriction
+func setAllowed(m map[chat.Type][]string, entityType chat.Type, values, known []string) map[chat.Type][]string {
+	if len(values) == 0 {
+		return m
+	}
+	if m == nil {
+		m = make(map[chat.Type][]string)
+	}
+	allowed := make([]string, 0, len(values))
+	for _, v := range known {
+		if slices.Contains(values, v) {
+			allowed = append(allowed, v)
+		}
+	}
+	m[entityType] = allowed
+	return m
+}
+
+func cloneAllowed(m map[chat.Type][]string) map[chat.Type][]string {
+	if m == nil {
+		return nil
+	}
+	out := make(map[chat.Type][]string, len(m))
+	for k, v := range m {
+		out[k] = slices.Clone(v)
+	}
+	return out
+}
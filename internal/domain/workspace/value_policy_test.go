@@ -0,0 +1,72 @@
+package workspace_test
+
+import (
+	"testing"
+
+	"github.com/lllypuk/flowra/internal/domain/chat"
+	"github.com/lllypuk/flowra/internal/domain/errs"
+	"github.com/lllypuk/flowra/internal/domain/workspace"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewValuePolicy(t *testing.T) {
+	t.Run("zero policy allows everything", func(t *testing.T) {
+		policy, err := workspace.NewValuePolicy(nil, nil)
+
+		require.NoError(t, err)
+		assert.True(t, policy.IsZero())
+		assert.True(t, policy.IsPriorityAllowed(chat.TypeTask, "Low"))
+		assert.True(t, policy.IsSeverityAllowed(chat.TypeBug, "Minor"))
+	})
+
+	t.Run("restricts listed entity types only", func(t *testing.T) {
+		policy, err := workspace.NewValuePolicy(
+			map[chat.Type][]string{chat.TypeTask: {"High", "Low"}},
+			map[chat.Type][]string{chat.TypeBug: {"Blocker", "Critical"}},
+		)
+
+		require.NoError(t, err)
+		assert.False(t, policy.IsZero())
+		assert.True(t, policy.IsPriorityAllowed(chat.TypeTask, "High"))
+		assert.False(t, policy.IsPriorityAllowed(chat.TypeTask, "Medium"))
+		assert.True(t, policy.IsPriorityAllowed(chat.TypeEpic, "Medium"))
+		assert.True(t, policy.IsSeverityAllowed(chat.TypeBug, "Blocker"))
+		assert.False(t, policy.IsSeverityAllowed(chat.TypeBug, "Minor"))
+	})
+
+	t.Run("stores values deduplicated in global order", func(t *testing.T) {
+		policy, err := workspace.NewValuePolicy(
+			map[chat.Type][]string{chat.TypeTask: {"Critical", "Low", "Critical"}},
+			nil,
+		)
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"Low", "Critical"}, policy.Priorities()[chat.TypeTask])
+	})
+
+	t.Run("empty list lifts the restriction", func(t *testing.T) {
+		policy, err := workspace.NewValuePolicy(map[chat.Type][]string{chat.TypeTask: {}}, nil)
+
+		require.NoError(t, err)
+		assert.True(t, policy.IsZero())
+	})
+
+	t.Run("unknown priority", func(t *testing.T) {
+		_, err := workspace.NewValuePolicy(map[chat.Type][]string{chat.TypeTask: {"Urgent"}}, nil)
+
+		require.ErrorIs(t, err, errs.ErrInvalidInput)
+	})
+
+	t.Run("discussion is not an entity type", func(t *testing.T) {
+		_, err := workspace.NewValuePolicy(map[chat.Type][]string{chat.TypeDiscussion: {"Low"}}, nil)
+
+		require.ErrorIs(t, err, errs.ErrInvalidInput)
+	})
+
+	t.Run("severity outside bugs", func(t *testing.T) {
+		_, err := workspace.NewValuePolicy(nil, map[chat.Type][]string{chat.TypeTask: {"Major"}})
+
+		require.ErrorIs(t, err, errs.ErrInvalidInput)
+	})
+}
@@ -17,6 +17,16 @@ type Workspace struct {
 	createdAt       time.Time
 	updatedAt       time.Time
 	invites         []*Invite
+	valuePolicy     ValuePolicy
+	analyticsOptOut bool
+	// requireJoinApproval keeps new chlenov in pending status until an admin approves them
+	requireJoinApproval bool
+	// discoverable workspaces are listed in the discovery directory and accept join requests
+	discoverable bool
+	// branding is the avatar and accent color shown in the UI
+	branding Branding
+	// slaPolicy holds the response/resolution targets of bugs by severity
+	slaPolicy SLAPolicy
 }
 
 // NewWorkspace creates new workspace space
@@ -52,6 +62,12 @@ func Reconstruct(
 	createdBy uuid.UUID,
 	createdAt, updatedAt time.Time,
 	invites []*Invite,
+	valuePolicy ValuePolicy,
+	analyticsOptOut bool,
+	requireJoinApproval bool,
+	discoverable bool,
+	branding Branding,
+	slaPolicy SLAPolicy,
 ) *Workspace {
 	if invites == nil {
 		invites = make([]*Invite, 0)
@@ -65,6 +81,13 @@ func Reconstruct(
 		createdAt:       createdAt,
 		updatedAt:       updatedAt,
 		invites:         invites,
+		valuePolicy:     valuePolicy,
+		analyticsOptOut: analyticsOptOut,
+
+		requireJoinApproval: requireJoinApproval,
+		discoverable:        discoverable,
+		branding:            branding,
+		slaPolicy:           slaPolicy,
 	}
 }
 
@@ -78,6 +101,24 @@ func (w *Workspace) UpdateName(name string) error {
 	return nil
 }
 
+// SetValuePolicy replaces the allowed priorities/severities of the workspace
+func (w *Workspace) SetValuePolicy(policy ValuePolicy) {
+	w.valuePolicy = policy
+	w.updatedAt = time.Now()
+}
+
+// SetAnalyticsOptOut excludes (or re-includes) the workspace from product analytics
+func (w *Workspace) SetAnalyticsOptOut(optOut bool) {
+	w.analyticsOptOut = optOut
+	w.updatedAt = time.Now()
+}
+
+// SetRequireJoinApproval toggles admin approval for members joining the workspace
+func (w *Workspace) SetRequireJoinApproval(required bool) {
+	w.requireJoinApproval = required
+	w.updatedAt = time.Now()
+}
+
 // CreateInvite creates new invitation in workspace space
 func (w *Workspace) CreateInvite(createdBy uuid.UUID, expiresAt time.Time, maxUses int) (*Invite, error) {
 	if createdBy.IsZero() {
@@ -134,6 +175,45 @@ func (w *Workspace) UpdatedAt() time.Time { return w.updatedAt }
 // Invites returns list priglasheniy
 func (w *Workspace) Invites() []*Invite { return w.invites }
 
+// ValuePolicy returns the allowed priorities/severities per entity type
+func (w *Workspace) ValuePolicy() ValuePolicy { return w.valuePolicy }
+
+// SetSLAPolicy replaces the SLA targets of the workspace
+func (w *Workspace) SetSLAPolicy(policy SLAPolicy) {
+	w.slaPolicy = policy
+	w.updatedAt = time.Now()
+}
+
+// SLAPolicy returns the SLA targets of bugs by severity
+func (w *Workspace) SLAPolicy() SLAPolicy { return w.slaPolicy }
+
+// AnalyticsOptOut reports whether the workspace opted out of product analytics
+func (w *Workspace) AnalyticsOptOut() bool { return w.analyticsOptOut }
+
+// SetDiscoverable toggles listing the workspace in the discovery directory
+func (w *Workspace) SetDiscoverable(discoverable bool) {
+	w.discoverable = discoverable
+	w.updatedAt = time.Now()
+}
+
+// SetBranding replaces the avatar and accent color of the workspace
+func (w *Workspace) SetBranding(branding Branding) {
+	w.branding = branding
+	w.updatedAt = time.Now()
+}
+
+// Branding returns the avatar and accent color of the workspace
+func (w *Workspace) Branding() Branding { return w.branding }
+
+// IsDiscoverable reports whether the workspace is listed in the discovery directory
+func (w *Workspace) IsDiscoverable() bool { return w.discoverable }
+
+// AcceptsJoinRequests reports whether users may ask to join without an invite
+func (w *Workspace) AcceptsJoinRequests() bool { return w.discoverable || w.requireJoinApproval }
+
+// RequiresJoinApproval reports whether new members stay pending until an admin approves them
+func (w *Workspace) RequiresJoinApproval() bool { return w.requireJoinApproval }
+
 // Invite represents priglashenie in workspace space
 type Invite struct {
 	id          uuid.UUID
@@ -66,6 +66,44 @@ func TestWorkspace_UpdateName(t *testing.T) {
 	})
 }
 
+func TestWorkspace_SetAnalyticsOptOut(t *testing.T) {
+	ws, _ := workspace.NewWorkspace("Workspace", "", "keycloak-group-123", uuid.NewUUID())
+	assert.False(t, ws.AnalyticsOptOut())
+	oldUpdatedAt := ws.UpdatedAt()
+
+	time.Sleep(1 * time.Millisecond)
+	ws.SetAnalyticsOptOut(true)
+
+	assert.True(t, ws.AnalyticsOptOut())
+	assert.True(t, ws.UpdatedAt().After(oldUpdatedAt))
+
+	ws.SetAnalyticsOptOut(false)
+	assert.False(t, ws.AnalyticsOptOut())
+}
+
+func TestWorkspace_SetRequireJoinApproval(t *testing.T) {
+	ws, _ := workspace.NewWorkspace("Workspace", "", "keycloak-group-123", uuid.NewUUID())
+	assert.False(t, ws.RequiresJoinApproval())
+	oldUpdatedAt := ws.UpdatedAt()
+
+	time.Sleep(1 * time.Millisecond)
+	ws.SetRequireJoinApproval(true)
+
+	assert.True(t, ws.RequiresJoinApproval())
+	assert.True(t, ws.UpdatedAt().After(oldUpdatedAt))
+}
+
+func TestWorkspace_SetDiscoverable(t *testing.T) {
+	ws, _ := workspace.NewWorkspace("Workspace", "", "keycloak-group-123", uuid.NewUUID())
+	assert.False(t, ws.IsDiscoverable())
+	assert.False(t, ws.AcceptsJoinRequests())
+
+	ws.SetDiscoverable(true)
+
+	assert.True(t, ws.IsDiscoverable())
+	assert.True(t, ws.AcceptsJoinRequests())
+}
+
 func TestWorkspace_CreateInvite(t *testing.T) {
 	t.Run("successful creation", func(t *testing.T) {
 		workspace, _ := workspace.NewWorkspace("Test Workspace", "", "keycloak-group-123", uuid.NewUUID())
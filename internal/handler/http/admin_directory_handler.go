@@ -0,0 +1,225 @@
+package httphandler
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/lllypuk/flowra/internal/domain/errs"
+	"github.com/lllypuk/flowra/internal/domain/user"
+	"github.com/lllypuk/flowra/internal/domain/uuid"
+	"github.com/lllypuk/flowra/internal/domain/workspace"
+	"github.com/lllypuk/flowra/internal/infrastructure/httpserver"
+)
+
+// Admin directory listing limits.
+const (
+	defaultAdminDirectoryLimit = 50
+	maxAdminDirectoryLimit     = 500
+)
+
+// AdminWorkspaceDirectory lists and looks up every workspace regardless of membership.
+// Declared on the consumer side per project guidelines.
+type AdminWorkspaceDirectory interface {
+	List(ctx context.Context, offset, limit int) ([]*workspace.Workspace, error)
+	Count(ctx context.Context) (int, error)
+	FindByID(ctx context.Context, id uuid.UUID) (*workspace.Workspace, error)
+	CountMembers(ctx context.Context, workspaceID uuid.UUID) (int, error)
+}
+
+// AdminUserDirectory lists and looks up every user.
+// Declared on the consumer side per project guidelines.
+type AdminUserDirectory interface {
+	List(ctx context.Context, offset, limit int) ([]*user.User, error)
+	Count(ctx context.Context) (int, error)
+	FindByID(ctx context.Context, id uuid.UUID) (*user.User, error)
+}
+
+// AdminWorkspaceResponse represents a workspace in the admin API.
+type AdminWorkspaceResponse struct {
+	ID              string    `json:"id"`
+	Name            string    `json:"name"`
+	Description     string    `json:"description,omitempty"`
+	CreatedBy       string    `json:"created_by"`
+	KeycloakGroupID string    `json:"keycloak_group_id,omitempty"`
+	CreatedAt       time.Time `json:"created_at"`
+	UpdatedAt       time.Time `json:"updated_at"`
+	MemberCount     *int      `json:"member_count,omitempty"`
+}
+
+// AdminWorkspaceListResponse represents a page of workspaces in the admin API.
+type AdminWorkspaceListResponse struct {
+	Workspaces []AdminWorkspaceResponse `json:"workspaces"`
+	Total      int                      `json:"total"`
+	Offset     int                      `json:"offset"`
+	Limit      int                      `json:"limit"`
+}
+
+// AdminUserResponse represents a user in the admin API.
+type AdminUserResponse struct {
+	ID            string    `json:"id"`
+	ExternalID    string    `json:"external_id,omitempty"`
+	Username      string    `json:"username"`
+	Email         string    `json:"email"`
+	DisplayName   string    `json:"display_name,omitempty"`
+	IsSystemAdmin bool      `json:"is_system_admin"`
+	IsActive      bool      `json:"is_active"`
+	CreatedAt     time.Time `json:"created_at"`
+	UpdatedAt     time.Time `json:"updated_at"`
+}
+
+// AdminUserListResponse represents a page of users in the admin API.
+type AdminUserListResponse struct {
+	Users  []AdminUserResponse `json:"users"`
+	Total  int                 `json:"total"`
+	Offset int                 `json:"offset"`
+	Limit  int                 `json:"limit"`
+}
+
+// AdminDirectoryHandler serves the workspace and user directory to system admins.
+type AdminDirectoryHandler struct {
+	workspaces AdminWorkspaceDirectory
+	users      AdminUserDirectory
+}
+
+// NewAdminDirectoryHandler creates a new AdminDirectoryHandler.
+func NewAdminDirectoryHandler(workspaces AdminWorkspaceDirectory, users AdminUserDirectory) *AdminDirectoryHandler {
+	return &AdminDirectoryHandler{workspaces: workspaces, users: users}
+}
+
+// RegisterRoutes registers the directory routes with the router. System admins only.
+func (h *AdminDirectoryHandler) RegisterRoutes(r *httpserver.Router) {
+	admin := r.NewAuthRouteGroup("/admin").RequireSystemAdmin()
+	admin.GET("/workspaces", h.ListWorkspaces)
+	admin.GET("/workspaces/:workspace_id", h.GetWorkspace)
+	admin.GET("/users", h.ListUsers)
+	admin.GET("/users/:user_id", h.GetUser)
+}
+
+// ListWorkspaces handles GET /api/v1/admin/workspaces.
+func (h *AdminDirectoryHandler) ListWorkspaces(c echo.Context) error {
+	limit, offset := parsePagination(c, defaultAdminDirectoryLimit, maxAdminDirectoryLimit)
+	ctx := c.Request().Context()
+
+	workspaces, err := h.workspaces.List(ctx, offset, limit)
+	if err != nil {
+		return httpserver.RespondErrorWithCode(
+			c, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to list workspaces")
+	}
+	total, err := h.workspaces.Count(ctx)
+	if err != nil {
+		return httpserver.RespondErrorWithCode(
+			c, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to count workspaces")
+	}
+
+	resp := AdminWorkspaceListResponse{
+		Workspaces: make([]AdminWorkspaceResponse, 0, len(workspaces)),
+		Total:      total,
+		Offset:     offset,
+		Limit:      limit,
+	}
+	for _, ws := range workspaces {
+		resp.Workspaces = append(resp.Workspaces, ToAdminWorkspaceResponse(ws))
+	}
+	return httpserver.RespondOK(c, resp)
+}
+
+// GetWorkspace handles GET /api/v1/admin/workspaces/:workspace_id.
+func (h *AdminDirectoryHandler) GetWorkspace(c echo.Context) error {
+	workspaceID, err := uuid.ParseUUID(c.Param("workspace_id"))
+	if err != nil {
+		return httpserver.RespondErrorWithCode(c, http.StatusBadRequest, "INVALID_WORKSPACE_ID", "Invalid workspace ID")
+	}
+
+	ctx := c.Request().Context()
+	ws, err := h.workspaces.FindByID(ctx, workspaceID)
+	if err != nil {
+		if errors.Is(err, errs.ErrNotFound) {
+			return httpserver.RespondErrorWithCode(c, http.StatusNotFound, "NOT_FOUND", "Workspace not found")
+		}
+		return httpserver.RespondErrorWithCode(
+			c, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to get workspace")
+	}
+	members, err := h.workspaces.CountMembers(ctx, workspaceID)
+	if err != nil {
+		return httpserver.RespondErrorWithCode(
+			c, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to count workspace members")
+	}
+
+	resp := ToAdminWorkspaceResponse(ws)
+	resp.MemberCount = &members
+	return httpserver.RespondOK(c, resp)
+}
+
+// ListUsers handles GET /api/v1/admin/users.
+func (h *AdminDirectoryHandler) ListUsers(c echo.Context) error {
+	limit, offset := parsePagination(c, defaultAdminDirectoryLimit, maxAdminDirectoryLimit)
+	ctx := c.Request().Context()
+
+	users, err := h.users.List(ctx, offset, limit)
+	if err != nil {
+		return httpserver.RespondErrorWithCode(c, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to list users")
+	}
+	total, err := h.users.Count(ctx)
+	if err != nil {
+		return httpserver.RespondErrorWithCode(c, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to count users")
+	}
+
+	resp := AdminUserListResponse{
+		Users:  make([]AdminUserResponse, 0, len(users)),
+		Total:  total,
+		Offset: offset,
+		Limit:  limit,
+	}
+	for _, u := range users {
+		resp.Users = append(resp.Users, ToAdminUserResponse(u))
+	}
+	return httpserver.RespondOK(c, resp)
+}
+
+// GetUser handles GET /api/v1/admin/users/:user_id.
+func (h *AdminDirectoryHandler) GetUser(c echo.Context) error {
+	userID, err := uuid.ParseUUID(c.Param("user_id"))
+	if err != nil {
+		return httpserver.RespondErrorWithCode(c, http.StatusBadRequest, "INVALID_USER_ID", "Invalid user ID")
+	}
+
+	u, err := h.users.FindByID(c.Request().Context(), userID)
+	if err != nil {
+		if errors.Is(err, errs.ErrNotFound) {
+			return httpserver.RespondErrorWithCode(c, http.StatusNotFound, "NOT_FOUND", "User not found")
+		}
+		return httpserver.RespondErrorWithCode(c, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to get user")
+	}
+	return httpserver.RespondOK(c, ToAdminUserResponse(u))
+}
+
+// ToAdminWorkspaceResponse converts a workspace to its admin API representation.
+func ToAdminWorkspaceResponse(ws *workspace.Workspace) AdminWorkspaceResponse {
+	return AdminWorkspaceResponse{
+		ID:              ws.ID().String(),
+		Name:            ws.Name(),
+		Description:     ws.Description(),
+		CreatedBy:       ws.CreatedBy().String(),
+		KeycloakGroupID: ws.KeycloakGroupID(),
+		CreatedAt:       ws.CreatedAt(),
+		UpdatedAt:       ws.UpdatedAt(),
+	}
+}
+
+// ToAdminUserResponse converts a user to its admin API representation.
+func ToAdminUserResponse(u *user.User) AdminUserResponse {
+	return AdminUserResponse{
+		ID:            u.ID().String(),
+		ExternalID:    u.ExternalID(),
+		Username:      u.Username(),
+		Email:         u.Email(),
+		DisplayName:   u.DisplayName(),
+		IsSystemAdmin: u.IsSystemAdmin(),
+		IsActive:      u.IsActive(),
+		CreatedAt:     u.CreatedAt(),
+		UpdatedAt:     u.UpdatedAt(),
+	}
+}
@@ -0,0 +1,151 @@
+package httphandler_test
+
+import (
+	"context"
+	"encoding/json"
+	stdhttp "net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/lllypuk/flowra/internal/domain/errs"
+	"github.com/lllypuk/flowra/internal/domain/user"
+	"github.com/lllypuk/flowra/internal/domain/uuid"
+	"github.com/lllypuk/flowra/internal/domain/workspace"
+	httphandler "github.com/lllypuk/flowra/internal/handler/http"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeWorkspaceDirectory struct {
+	workspaces []*workspace.Workspace
+	members    int
+}
+
+func (f *fakeWorkspaceDirectory) List(_ context.Context, offset, limit int) ([]*workspace.Workspace, error) {
+	return page(f.workspaces, offset, limit), nil
+}
+
+func (f *fakeWorkspaceDirectory) Count(_ context.Context) (int, error) { return len(f.workspaces), nil }
+
+func (f *fakeWorkspaceDirectory) FindByID(_ context.Context, id uuid.UUID) (*workspace.Workspace, error) {
+	for _, ws := range f.workspaces {
+		if ws.ID() == id {
+			return ws, nil
+		}
+	}
+	return nil, errs.ErrNotFound
+}
+
+func (f *fakeWorkspaceDirectory) CountMembers(_ context.Context, _ uuid.UUID) (int, error) {
+	return f.members, nil
+}
+
+type fakeUserDirectory struct {
+	users []*user.User
+}
+
+func (f *fakeUserDirectory) List(_ context.Context, offset, limit int) ([]*user.User, error) {
+	return page(f.users, offset, limit), nil
+}
+
+func (f *fakeUserDirectory) Count(_ context.Context) (int, error) { return len(f.users), nil }
+
+func (f *fakeUserDirectory) FindByID(_ context.Context, id uuid.UUID) (*user.User, error) {
+	for _, u := range f.users {
+		if u.ID() == id {
+			return u, nil
+		}
+	}
+	return nil, errs.ErrNotFound
+}
+
+func page[T any](items []T, offset, limit int) []T {
+	if offset >= len(items) {
+		return nil
+	}
+	return items[offset:min(offset+limit, len(items))]
+}
+
+func newAdminDirectoryContext(target, paramName, paramValue string) (echo.Context, *httptest.ResponseRecorder) {
+	e := echo.New()
+	req := httptest.NewRequest(stdhttp.MethodGet, target, nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	if paramName != "" {
+		c.SetParamNames(paramName)
+		c.SetParamValues(paramValue)
+	}
+	return c, rec
+}
+
+func TestAdminDirectoryHandler(t *testing.T) {
+	ownerID := uuid.NewUUID()
+	first, err := workspace.NewWorkspace("Alpha", "", "group-a", ownerID)
+	require.NoError(t, err)
+	second, err := workspace.NewWorkspace("Beta", "", "group-b", ownerID)
+	require.NoError(t, err)
+	alice, err := user.NewUser("ext-alice", "alice", "alice@example.com", "Alice")
+	require.NoError(t, err)
+
+	handler := httphandler.NewAdminDirectoryHandler(
+		&fakeWorkspaceDirectory{workspaces: []*workspace.Workspace{first, second}, members: 3},
+		&fakeUserDirectory{users: []*user.User{alice}},
+	)
+
+	t.Run("lists workspaces", func(t *testing.T) {
+		c, rec := newAdminDirectoryContext("/api/v1/admin/workspaces?limit=1&offset=1", "", "")
+		require.NoError(t, handler.ListWorkspaces(c))
+		assert.Equal(t, stdhttp.StatusOK, rec.Code)
+
+		var resp struct {
+			Data httphandler.AdminWorkspaceListResponse `json:"data"`
+		}
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+		assert.Equal(t, 2, resp.Data.Total)
+		require.Len(t, resp.Data.Workspaces, 1)
+		assert.Equal(t, "Beta", resp.Data.Workspaces[0].Name)
+	})
+
+	t.Run("gets workspace with member count", func(t *testing.T) {
+		c, rec := newAdminDirectoryContext("/", "workspace_id", first.ID().String())
+		require.NoError(t, handler.GetWorkspace(c))
+		assert.Equal(t, stdhttp.StatusOK, rec.Code)
+
+		var resp struct {
+			Data httphandler.AdminWorkspaceResponse `json:"data"`
+		}
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+		assert.Equal(t, "group-a", resp.Data.KeycloakGroupID)
+		require.NotNil(t, resp.Data.MemberCount)
+		assert.Equal(t, 3, *resp.Data.MemberCount)
+	})
+
+	t.Run("unknown workspace returns not found", func(t *testing.T) {
+		c, rec := newAdminDirectoryContext("/", "workspace_id", uuid.NewUUID().String())
+		require.NoError(t, handler.GetWorkspace(c))
+		assert.Equal(t, stdhttp.StatusNotFound, rec.Code)
+	})
+
+	t.Run("invalid workspace ID returns bad request", func(t *testing.T) {
+		c, rec := newAdminDirectoryContext("/", "workspace_id", "nope")
+		require.NoError(t, handler.GetWorkspace(c))
+		assert.Equal(t, stdhttp.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("lists and gets users", func(t *testing.T) {
+		c, rec := newAdminDirectoryContext("/api/v1/admin/users", "", "")
+		require.NoError(t, handler.ListUsers(c))
+		assert.Equal(t, stdhttp.StatusOK, rec.Code)
+		assert.Contains(t, rec.Body.String(), `"username":"alice"`)
+
+		c, rec = newAdminDirectoryContext("/", "user_id", alice.ID().String())
+		require.NoError(t, handler.GetUser(c))
+		assert.Equal(t, stdhttp.StatusOK, rec.Code)
+		assert.Contains(t, rec.Body.String(), `"external_id":"ext-alice"`)
+
+		c, rec = newAdminDirectoryContext("/", "user_id", uuid.NewUUID().String())
+		require.NoError(t, handler.GetUser(c))
+		assert.Equal(t, stdhttp.StatusNotFound, rec.Code)
+	})
+}
@@ -0,0 +1,162 @@
+package httphandler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/lllypuk/flowra/internal/application/appcore"
+	messageapp "github.com/lllypuk/flowra/internal/application/message"
+	"github.com/lllypuk/flowra/internal/domain/uuid"
+	"github.com/lllypuk/flowra/internal/infrastructure/httpserver"
+)
+
+// MessageImporter imports historical messages in bulk.
+// Declared on the consumer side per project guidelines.
+type MessageImporter interface {
+	Execute(ctx context.Context, cmd messageapp.ImportMessagesCommand) (messageapp.ImportMessagesResult, error)
+}
+
+// ImportMessagesRequest is the body of a message import.
+type ImportMessagesRequest struct {
+	// Authors maps the author names used in Messages, e.g. Slack user IDs, to user IDs.
+	Authors  map[string]string      `json:"authors"`
+	Messages []ImportMessageRequest `json:"messages" validate:"required,max=1000"`
+	DryRun   bool                   `json:"dry_run"`
+}
+
+// ImportMessageRequest is one historical message of an import.
+type ImportMessageRequest struct {
+	ExternalID       string    `json:"external_id"`
+	Author           string    `json:"author"`
+	Content          string    `json:"content"`
+	CreatedAt        time.Time `json:"created_at"`
+	ParentExternalID string    `json:"parent_external_id"`
+	ParentID         string    `json:"parent_id"`
+}
+
+// ImportMessageRowResponse is the outcome of one message of an import.
+type ImportMessageRowResponse struct {
+	Index      int    `json:"index"`
+	ExternalID string `json:"external_id,omitempty"`
+	Status     string `json:"status"`
+	MessageID  string `json:"message_id,omitempty"`
+	Error      string `json:"error,omitempty"`
+}
+
+// ImportMessagesResponse is the per-message report of an import.
+type ImportMessagesResponse struct {
+	DryRun   bool                       `json:"dry_run"`
+	Imported int                        `json:"imported"`
+	Valid    int                        `json:"valid"`
+	Failed   int                        `json:"failed"`
+	Rows     []ImportMessageRowResponse `json:"rows"`
+}
+
+// AdminMessageImportHandler backfills chat history for system admins migrating
+// from other chat systems.
+type AdminMessageImportHandler struct {
+	importer MessageImporter
+}
+
+// NewAdminMessageImportHandler creates a new AdminMessageImportHandler.
+func NewAdminMessageImportHandler(importer MessageImporter) *AdminMessageImportHandler {
+	return &AdminMessageImportHandler{importer: importer}
+}
+
+// RegisterRoutes registers the import route with the router. System admins only.
+func (h *AdminMessageImportHandler) RegisterRoutes(r *httpserver.Router) {
+	r.NewAuthRouteGroup("/admin").RequireSystemAdmin().POST("/chats/:chat_id/messages/import", h.Import)
+}
+
+// Import handles POST /api/v1/admin/chats/:chat_id/messages/import.
+// Messages are imported one by one in the order given; the response reports each
+// of them, so a partly failed import still answers 200. Imported messages raise
+// no notifications and are not broadcast to connected clients.
+func (h *AdminMessageImportHandler) Import(c echo.Context) error {
+	chatID, err := uuid.ParseUUID(c.Param("chat_id"))
+	if err != nil {
+		return httpserver.RespondErrorWithCode(c, http.StatusBadRequest, "INVALID_CHAT_ID", "Invalid chat ID format")
+	}
+
+	var req ImportMessagesRequest
+	if bindErr := c.Bind(&req); bindErr != nil {
+		return httpserver.RespondErrorWithCode(c, http.StatusBadRequest, "INVALID_REQUEST", "Invalid request body")
+	}
+	if valErr := validateRequest(&req); valErr != nil {
+		return httpserver.RespondValidationError(c, valErr)
+	}
+
+	cmd, err := req.toCommand(chatID)
+	if err != nil {
+		return httpserver.RespondErrorWithCode(c, http.StatusBadRequest, "VALIDATION_ERROR", err.Error())
+	}
+
+	result, err := h.importer.Execute(c.Request().Context(), cmd)
+	if err != nil {
+		var validationErr *appcore.ValidationError
+		if errors.As(err, &validationErr) {
+			return httpserver.RespondErrorWithCode(c, http.StatusBadRequest, "VALIDATION_ERROR", validationErr.Error())
+		}
+		return httpserver.RespondError(c, err)
+	}
+
+	resp := ImportMessagesResponse{
+		DryRun:   result.DryRun,
+		Imported: result.Imported,
+		Valid:    result.Valid,
+		Failed:   result.Failed,
+		Rows:     make([]ImportMessageRowResponse, 0, len(result.Rows)),
+	}
+	for _, row := range result.Rows {
+		rowResp := ImportMessageRowResponse{
+			Index:      row.Index,
+			ExternalID: row.ExternalID,
+			Status:     string(row.Status),
+			Error:      row.Error,
+		}
+		if !row.MessageID.IsZero() {
+			rowResp.MessageID = row.MessageID.String()
+		}
+		resp.Rows = append(resp.Rows, rowResp)
+	}
+	return httpserver.RespondOK(c, resp)
+}
+
+// toCommand parses the user IDs of the request.
+func (req ImportMessagesRequest) toCommand(chatID uuid.UUID) (messageapp.ImportMessagesCommand, error) {
+	cmd := messageapp.ImportMessagesCommand{
+		ChatID:   chatID,
+		Authors:  make(map[string]uuid.UUID, len(req.Authors)),
+		Messages: make([]messageapp.ImportMessageRow, 0, len(req.Messages)),
+		DryRun:   req.DryRun,
+	}
+	for author, rawID := range req.Authors {
+		userID, err := uuid.ParseUUID(rawID)
+		if err != nil {
+			return messageapp.ImportMessagesCommand{}, fmt.Errorf("authors: %q is not a valid user ID", rawID)
+		}
+		cmd.Authors[author] = userID
+	}
+	for i, msg := range req.Messages {
+		row := messageapp.ImportMessageRow{
+			ExternalID:       msg.ExternalID,
+			Author:           msg.Author,
+			Content:          msg.Content,
+			CreatedAt:        msg.CreatedAt,
+			ParentExternalID: msg.ParentExternalID,
+		}
+		if msg.ParentID != "" {
+			parentID, err := uuid.ParseUUID(msg.ParentID)
+			if err != nil {
+				return messageapp.ImportMessagesCommand{}, fmt.Errorf("messages[%d].parent_id is not a valid message ID", i)
+			}
+			row.ParentMessageID = parentID
+		}
+		cmd.Messages = append(cmd.Messages, row)
+	}
+	return cmd, nil
+}
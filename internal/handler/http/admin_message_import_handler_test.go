@@ -0,0 +1,123 @@
+package httphandler_test
+
+import (
+	"context"
+	"encoding/json"
+	stdhttp "net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	messageapp "github.com/lllypuk/flowra/internal/application/message"
+	"github.com/lllypuk/flowra/internal/domain/uuid"
+	httphandler "github.com/lllypuk/flowra/internal/handler/http"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type recordingMessageImporter struct {
+	cmd messageapp.ImportMessagesCommand
+}
+
+func (r *recordingMessageImporter) Execute(
+	_ context.Context,
+	cmd messageapp.ImportMessagesCommand,
+) (messageapp.ImportMessagesResult, error) {
+	r.cmd = cmd
+	result := messageapp.ImportMessagesResult{DryRun: cmd.DryRun}
+	for i, row := range cmd.Messages {
+		res := messageapp.ImportRowResult{Index: i, ExternalID: row.ExternalID}
+		if _, ok := cmd.Authors[row.Author]; !ok {
+			res.Status, res.Error = messageapp.ImportStatusFailed, "author is not mapped to a user"
+			result.Failed++
+		} else {
+			res.Status, res.MessageID = messageapp.ImportStatusImported, uuid.NewUUID()
+			result.Imported++
+		}
+		result.Rows = append(result.Rows, res)
+	}
+	return result, nil
+}
+
+func postMessageImport(
+	t *testing.T,
+	h *httphandler.AdminMessageImportHandler,
+	chatID string,
+	body string,
+) *httptest.ResponseRecorder {
+	t.Helper()
+	e := echo.New()
+	req := httptest.NewRequest(stdhttp.MethodPost, "/api/v1/admin/chats/"+chatID+"/messages/import",
+		strings.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetParamNames("chat_id")
+	c.SetParamValues(chatID)
+	require.NoError(t, h.Import(c))
+	return rec
+}
+
+func TestAdminMessageImportHandler_Import(t *testing.T) {
+	chatID := uuid.NewUUID()
+	userID := uuid.NewUUID()
+	parentID := uuid.NewUUID()
+
+	t.Run("maps authors and reports every message", func(t *testing.T) {
+		importer := &recordingMessageImporter{}
+		h := httphandler.NewAdminMessageImportHandler(importer)
+
+		rec := postMessageImport(t, h, chatID.String(), `{
+			"authors": {"U1": "`+userID.String()+`"},
+			"messages": [
+				{"external_id": "m1", "author": "U1", "content": "hello", "created_at": "2020-01-02T09:00:00Z"},
+				{"author": "U2", "content": "hi", "created_at": "2020-01-02T09:01:00Z", "parent_id": "`+parentID.String()+`"}
+			]
+		}`)
+		assert.Equal(t, stdhttp.StatusOK, rec.Code)
+
+		assert.Equal(t, chatID, importer.cmd.ChatID)
+		assert.Equal(t, userID, importer.cmd.Authors["U1"])
+		require.Len(t, importer.cmd.Messages, 2)
+		assert.Equal(t, 2020, importer.cmd.Messages[0].CreatedAt.Year())
+		assert.Equal(t, parentID, importer.cmd.Messages[1].ParentMessageID)
+
+		var resp struct {
+			Data httphandler.ImportMessagesResponse `json:"data"`
+		}
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+		assert.Equal(t, 1, resp.Data.Imported)
+		assert.Equal(t, 1, resp.Data.Failed)
+		require.Len(t, resp.Data.Rows, 2)
+		assert.Equal(t, "m1", resp.Data.Rows[0].ExternalID)
+		assert.NotEmpty(t, resp.Data.Rows[0].MessageID)
+		assert.Equal(t, 1, resp.Data.Rows[1].Index)
+		assert.Equal(t, "failed", resp.Data.Rows[1].Status)
+		assert.Empty(t, resp.Data.Rows[1].MessageID)
+	})
+
+	t.Run("rejects invalid user IDs in the author mapping", func(t *testing.T) {
+		h := httphandler.NewAdminMessageImportHandler(&recordingMessageImporter{})
+
+		rec := postMessageImport(t, h, chatID.String(),
+			`{"authors": {"U1": "nope"}, "messages": [{"author": "U1", "content": "hello"}]}`)
+		assert.Equal(t, stdhttp.StatusBadRequest, rec.Code)
+		assert.Contains(t, rec.Body.String(), "VALIDATION_ERROR")
+	})
+
+	t.Run("requires messages", func(t *testing.T) {
+		h := httphandler.NewAdminMessageImportHandler(&recordingMessageImporter{})
+
+		rec := postMessageImport(t, h, chatID.String(), `{"authors": {}}`)
+		assert.Equal(t, stdhttp.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("rejects invalid chat IDs", func(t *testing.T) {
+		h := httphandler.NewAdminMessageImportHandler(&recordingMessageImporter{})
+
+		rec := postMessageImport(t, h, "not-a-uuid", `{"messages": [{"author": "U1", "content": "hello"}]}`)
+		assert.Equal(t, stdhttp.StatusBadRequest, rec.Code)
+		assert.Contains(t, rec.Body.String(), "INVALID_CHAT_ID")
+	})
+}
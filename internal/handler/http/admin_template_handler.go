@@ -0,0 +1,241 @@
+package httphandler
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/lllypuk/flowra/internal/application/appcore"
+	"github.com/lllypuk/flowra/internal/middleware"
+)
+
+// Dashboard list sizes.
+const (
+	adminRecentErrorsLimit    = 20
+	adminRateLimitEventsLimit = 20
+)
+
+// AdminCounter counts the documents of a collection.
+// Declared on the consumer side per project guidelines.
+type AdminCounter interface {
+	Count(ctx context.Context) (int, error)
+}
+
+// AdminOutboxStats reports the outbox backlog and the creation time of its oldest entry.
+// Declared on the consumer side per project guidelines.
+type AdminOutboxStats interface {
+	Stats(ctx context.Context) (int64, time.Time, error)
+}
+
+// AdminDeadLetterSource reports the dead letter queue depth and its latest entries.
+// Declared on the consumer side per project guidelines.
+type AdminDeadLetterSource interface {
+	QueueLength(ctx context.Context) (int64, error)
+	RecentErrors(ctx context.Context, limit int) ([]AdminErrorViewData, error)
+}
+
+// AdminRepairStats reports the repair queue counters.
+// Declared on the consumer side per project guidelines.
+type AdminRepairStats interface {
+	RepairStats(ctx context.Context) (AdminRepairViewData, error)
+}
+
+// AdminRateLimitEvents lists the latest workspace limit-exceeded events.
+// Declared on the consumer side per project guidelines.
+type AdminRateLimitEvents interface {
+	Recent(ctx context.Context, limit int) ([]middleware.WorkspaceLimitExceeded, error)
+}
+
+// AdminDashboardSources groups the data sources of the admin dashboard.
+// Every source is optional; missing sources are shown as unavailable.
+type AdminDashboardSources struct {
+	Workspaces   AdminCounter
+	Users        AdminCounter
+	Outbox       AdminOutboxStats
+	DeadLetters  AdminDeadLetterSource
+	Repair       AdminRepairStats
+	RateLimits   AdminRateLimitEvents
+	HealthChecks []appcore.HealthChecker
+}
+
+// AdminDashboardViewData represents the data needed to render the admin dashboard.
+type AdminDashboardViewData struct {
+	GeneratedAt     time.Time
+	WorkspaceCount  *int
+	UserCount       *int
+	OutboxPending   *int64
+	ProjectionLag   *time.Duration
+	DeadLetterDepth *int64
+	RecentErrors    []AdminErrorViewData
+	Repair          *AdminRepairViewData
+	RateLimitEvents []middleware.WorkspaceLimitExceeded
+	Health          []AdminHealthViewData
+}
+
+// AdminErrorViewData represents a failed event from the dead letter queue.
+type AdminErrorViewData struct {
+	EventType   string
+	AggregateID string
+	Error       string
+	FailedAt    time.Time
+}
+
+// AdminRepairViewData represents the repair queue counters.
+type AdminRepairViewData struct {
+	Pending    int64
+	Processing int64
+	Completed  int64
+	Failed     int64
+	Total      int64
+}
+
+// AdminHealthViewData represents the result of one health checker.
+type AdminHealthViewData struct {
+	Name    string
+	Healthy bool
+	Message string
+}
+
+// AdminTemplateHandler renders the system admin dashboard.
+type AdminTemplateHandler struct {
+	renderer *TemplateRenderer
+	logger   *slog.Logger
+	sources  AdminDashboardSources
+}
+
+// NewAdminTemplateHandler creates a new admin template handler.
+func NewAdminTemplateHandler(
+	renderer *TemplateRenderer,
+	logger *slog.Logger,
+	sources AdminDashboardSources,
+) *AdminTemplateHandler {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &AdminTemplateHandler{
+		renderer: renderer,
+		logger:   logger,
+		sources:  sources,
+	}
+}
+
+// SetupAdminRoutes registers admin page routes.
+func (h *AdminTemplateHandler) SetupAdminRoutes(e *echo.Echo) {
+	admin := e.Group("/admin", RequireAuth)
+	admin.GET("", h.Dashboard)
+}
+
+// Dashboard renders counts, event pipeline depth, recent errors, rate limit events and health status.
+// The page is hidden from everyone but system admins.
+func (h *AdminTemplateHandler) Dashboard(c echo.Context) error {
+	if getUserView(c) == nil {
+		return c.Redirect(http.StatusFound, "/login")
+	}
+	if !middleware.IsSystemAdmin(c) {
+		return c.String(http.StatusNotFound, "Page not found")
+	}
+
+	return h.render(c, "admin/index", "Admin", h.collect(c.Request().Context()))
+}
+
+// collect gathers the dashboard data. A failing source is logged and left
+// empty so that the page still renders during partial outages.
+func (h *AdminTemplateHandler) collect(ctx context.Context) AdminDashboardViewData {
+	data := AdminDashboardViewData{GeneratedAt: time.Now()}
+
+	if h.sources.Workspaces != nil {
+		if count, err := h.sources.Workspaces.Count(ctx); err != nil {
+			h.logSourceError(ctx, "workspaces", err)
+		} else {
+			data.WorkspaceCount = &count
+		}
+	}
+
+	if h.sources.Users != nil {
+		if count, err := h.sources.Users.Count(ctx); err != nil {
+			h.logSourceError(ctx, "users", err)
+		} else {
+			data.UserCount = &count
+		}
+	}
+
+	if h.sources.Outbox != nil {
+		if pending, oldest, err := h.sources.Outbox.Stats(ctx); err != nil {
+			h.logSourceError(ctx, "outbox", err)
+		} else {
+			var lag time.Duration
+			if !oldest.IsZero() {
+				lag = time.Since(oldest).Round(time.Second)
+			}
+			data.OutboxPending = &pending
+			data.ProjectionLag = &lag
+		}
+	}
+
+	if h.sources.DeadLetters != nil {
+		if depth, err := h.sources.DeadLetters.QueueLength(ctx); err != nil {
+			h.logSourceError(ctx, "dead_letters", err)
+		} else {
+			data.DeadLetterDepth = &depth
+		}
+		if entries, err := h.sources.DeadLetters.RecentErrors(ctx, adminRecentErrorsLimit); err != nil {
+			h.logSourceError(ctx, "recent_errors", err)
+		} else {
+			data.RecentErrors = entries
+		}
+	}
+
+	if h.sources.Repair != nil {
+		if stats, err := h.sources.Repair.RepairStats(ctx); err != nil {
+			h.logSourceError(ctx, "repair_queue", err)
+		} else {
+			data.Repair = &stats
+		}
+	}
+
+	if h.sources.RateLimits != nil {
+		if events, err := h.sources.RateLimits.Recent(ctx, adminRateLimitEventsLimit); err != nil {
+			h.logSourceError(ctx, "rate_limit_events", err)
+		} else {
+			data.RateLimitEvents = events
+		}
+	}
+
+	for _, checker := range h.sources.HealthChecks {
+		status := checker.Check(ctx)
+		data.Health = append(data.Health, AdminHealthViewData{
+			Name:    checker.Name(),
+			Healthy: status.Healthy,
+			Message: status.Message,
+		})
+	}
+	sort.Slice(data.Health, func(i, j int) bool { return data.Health[i].Name < data.Health[j].Name })
+
+	return data
+}
+
+func (h *AdminTemplateHandler) logSourceError(ctx context.Context, source string, err error) {
+	h.logger.WarnContext(ctx, "admin dashboard source failed",
+		slog.String("source", source),
+		slog.String("error", err.Error()),
+	)
+}
+
+func (h *AdminTemplateHandler) render(c echo.Context, templateName, title string, data any) error {
+	if h.renderer == nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "template renderer not configured")
+	}
+
+	pageData := PageData{
+		Title:           title,
+		User:            getUserView(c),
+		Data:            data,
+		ContentTemplate: "admin-content",
+	}
+
+	c.Response().Header().Set("Content-Type", "text/html; charset=utf-8")
+	return h.renderer.Render(c.Response().Writer, templateName, pageData, c)
+}
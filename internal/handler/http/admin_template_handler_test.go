@@ -0,0 +1,154 @@
+package httphandler_test
+
+import (
+	"context"
+	"errors"
+	stdhttp "net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/lllypuk/flowra/internal/application/appcore"
+	"github.com/lllypuk/flowra/internal/domain/uuid"
+	httphandler "github.com/lllypuk/flowra/internal/handler/http"
+	"github.com/lllypuk/flowra/internal/middleware"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type stubAdminCounter struct {
+	count int
+	err   error
+}
+
+func (s *stubAdminCounter) Count(_ context.Context) (int, error) { return s.count, s.err }
+
+type stubAdminOutbox struct {
+	pending int64
+	oldest  time.Time
+}
+
+func (s *stubAdminOutbox) Stats(_ context.Context) (int64, time.Time, error) {
+	return s.pending, s.oldest, nil
+}
+
+type stubAdminDeadLetters struct {
+	entries []httphandler.AdminErrorViewData
+}
+
+func (s *stubAdminDeadLetters) QueueLength(_ context.Context) (int64, error) {
+	return int64(len(s.entries)), nil
+}
+
+func (s *stubAdminDeadLetters) RecentErrors(_ context.Context, _ int) ([]httphandler.AdminErrorViewData, error) {
+	return s.entries, nil
+}
+
+type stubAdminRepair struct{}
+
+func (stubAdminRepair) RepairStats(_ context.Context) (httphandler.AdminRepairViewData, error) {
+	return httphandler.AdminRepairViewData{Pending: 7, Failed: 2, Total: 9}, nil
+}
+
+type stubAdminRateLimits struct {
+	events []middleware.WorkspaceLimitExceeded
+}
+
+func (s *stubAdminRateLimits) Recent(_ context.Context, _ int) ([]middleware.WorkspaceLimitExceeded, error) {
+	return s.events, nil
+}
+
+type stubHealthChecker struct {
+	name   string
+	status appcore.HealthStatus
+}
+
+func (s *stubHealthChecker) Name() string { return s.name }
+
+func (s *stubHealthChecker) Check(_ context.Context) appcore.HealthStatus { return s.status }
+
+func newAdminPageContext(userID uuid.UUID, isSystemAdmin bool) (echo.Context, *httptest.ResponseRecorder) {
+	e := echo.New()
+	req := httptest.NewRequest(stdhttp.MethodGet, "/admin", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	if !userID.IsZero() {
+		setupUserAuthContext(c, userID)
+		c.Set(string(middleware.ContextKeyIsSystemAdmin), isSystemAdmin)
+	}
+	return c, rec
+}
+
+func TestAdminTemplateHandler_Dashboard(t *testing.T) {
+	sources := httphandler.AdminDashboardSources{
+		Workspaces: &stubAdminCounter{count: 12},
+		Users:      &stubAdminCounter{err: errors.New("mongo down")},
+		Outbox:     &stubAdminOutbox{pending: 34, oldest: time.Now().Add(-90 * time.Second)},
+		DeadLetters: &stubAdminDeadLetters{entries: []httphandler.AdminErrorViewData{
+			{EventType: "chat.created", AggregateID: "chat-1", Error: "projection failed", FailedAt: time.Now()},
+		}},
+		Repair: stubAdminRepair{},
+		RateLimits: &stubAdminRateLimits{events: []middleware.WorkspaceLimitExceeded{{
+			WorkspaceID: uuid.NewUUID(),
+			Kind:        middleware.WorkspaceLimitMessages,
+			Limit:       300,
+			Window:      time.Minute,
+			Method:      stdhttp.MethodPost,
+			Path:        "/api/v1/workspaces/:workspace_id/chats/:chat_id/messages",
+			OccurredAt:  time.Now(),
+		}}},
+		HealthChecks: []appcore.HealthChecker{
+			&stubHealthChecker{name: "outbox_backlog", status: appcore.HealthStatus{Healthy: true}},
+			&stubHealthChecker{
+				name:   "dead_letter_queue",
+				status: appcore.HealthStatus{Healthy: false, Message: "1 events in DLQ"},
+			},
+		},
+	}
+
+	t.Run("renders dashboard for system admins", func(t *testing.T) {
+		handler := httphandler.NewAdminTemplateHandler(newTestRenderer(t), nil, sources)
+		c, rec := newAdminPageContext(uuid.NewUUID(), true)
+
+		require.NoError(t, handler.Dashboard(c))
+		assert.Equal(t, stdhttp.StatusOK, rec.Code)
+		body := rec.Body.String()
+		assert.Contains(t, body, "<strong>12</strong>")
+		assert.Contains(t, body, "<strong>–</strong>", "failed user count renders as unavailable")
+		assert.Contains(t, body, "<strong>34</strong>")
+		assert.Contains(t, body, "<strong>1m30s</strong>")
+		assert.Contains(t, body, "projection failed")
+		assert.Contains(t, body, "<td>7</td>")
+		assert.Contains(t, body, "1 events in DLQ")
+		assert.Contains(t, body, "300 messages / 1m0s")
+		assert.Contains(t, body, `href="/admin"`)
+	})
+
+	t.Run("hides dashboard from other users", func(t *testing.T) {
+		handler := httphandler.NewAdminTemplateHandler(newTestRenderer(t), nil, sources)
+		c, rec := newAdminPageContext(uuid.NewUUID(), false)
+
+		require.NoError(t, handler.Dashboard(c))
+		assert.Equal(t, stdhttp.StatusNotFound, rec.Code)
+	})
+
+	t.Run("redirects anonymous users to login", func(t *testing.T) {
+		handler := httphandler.NewAdminTemplateHandler(newTestRenderer(t), nil, sources)
+		c, rec := newAdminPageContext("", false)
+
+		require.NoError(t, handler.Dashboard(c))
+		assert.Equal(t, stdhttp.StatusFound, rec.Code)
+		assert.Equal(t, "/login", rec.Header().Get("Location"))
+	})
+
+	t.Run("renders without sources", func(t *testing.T) {
+		handler := httphandler.NewAdminTemplateHandler(newTestRenderer(t), nil, httphandler.AdminDashboardSources{})
+		c, rec := newAdminPageContext(uuid.NewUUID(), true)
+
+		require.NoError(t, handler.Dashboard(c))
+		assert.Equal(t, stdhttp.StatusOK, rec.Code)
+		assert.Contains(t, rec.Body.String(), "Repair queue is not available.")
+		assert.Contains(t, rec.Body.String(), "No workspace has exceeded its rate limits recently.")
+	})
+}
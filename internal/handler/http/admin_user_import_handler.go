@@ -0,0 +1,109 @@
+package httphandler
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+	"github.com/lllypuk/flowra/internal/application/appcore"
+	userapp "github.com/lllypuk/flowra/internal/application/user"
+	"github.com/lllypuk/flowra/internal/infrastructure/httpserver"
+)
+
+// UserImporter imports users in bulk.
+// Declared on the consumer side per project guidelines.
+type UserImporter interface {
+	Execute(ctx context.Context, cmd userapp.ImportUsersCommand) (userapp.ImportUsersResult, error)
+}
+
+// ImportUsersRequest is the body of a user import.
+type ImportUsersRequest struct {
+	// CSV holds a header row naming the username, email and optional display_name columns.
+	CSV    string `json:"csv"`
+	DryRun bool   `json:"dry_run"`
+}
+
+// ImportUserRowResponse is the outcome of one row of an import.
+type ImportUserRowResponse struct {
+	Line     int    `json:"line"`
+	Username string `json:"username"`
+	Email    string `json:"email"`
+	Status   string `json:"status"`
+	UserID   string `json:"user_id,omitempty"`
+	Error    string `json:"error,omitempty"`
+}
+
+// ImportUsersResponse is the per-row report of an import.
+type ImportUsersResponse struct {
+	DryRun  bool                    `json:"dry_run"`
+	Created int                     `json:"created"`
+	Valid   int                     `json:"valid"`
+	Failed  int                     `json:"failed"`
+	Rows    []ImportUserRowResponse `json:"rows"`
+}
+
+// AdminUserImportHandler imports users from CSV for system admins.
+type AdminUserImportHandler struct {
+	importer UserImporter
+}
+
+// NewAdminUserImportHandler creates a new AdminUserImportHandler.
+func NewAdminUserImportHandler(importer UserImporter) *AdminUserImportHandler {
+	return &AdminUserImportHandler{importer: importer}
+}
+
+// RegisterRoutes registers the import route with the router. System admins only.
+func (h *AdminUserImportHandler) RegisterRoutes(r *httpserver.Router) {
+	r.NewAuthRouteGroup("/admin").RequireSystemAdmin().POST("/users/import", h.Import)
+}
+
+// Import handles POST /api/v1/admin/users/import.
+// Rows are imported one by one; the response reports each of them, so a partly
+// failed import still answers 200.
+func (h *AdminUserImportHandler) Import(c echo.Context) error {
+	var req ImportUsersRequest
+	if err := c.Bind(&req); err != nil {
+		return httpserver.RespondErrorWithCode(c, http.StatusBadRequest, "INVALID_REQUEST", "Invalid request body")
+	}
+
+	rows, err := userapp.ParseImportCSV(strings.NewReader(req.CSV))
+	if err != nil {
+		return httpserver.RespondErrorWithCode(c, http.StatusBadRequest, "INVALID_CSV", err.Error())
+	}
+
+	result, err := h.importer.Execute(c.Request().Context(), userapp.ImportUsersCommand{
+		Rows:   rows,
+		DryRun: req.DryRun,
+	})
+	if err != nil {
+		var validationErr *appcore.ValidationError
+		if errors.As(err, &validationErr) {
+			return httpserver.RespondErrorWithCode(c, http.StatusBadRequest, "VALIDATION_ERROR", validationErr.Error())
+		}
+		return httpserver.RespondError(c, err)
+	}
+
+	resp := ImportUsersResponse{
+		DryRun:  result.DryRun,
+		Created: result.Created,
+		Valid:   result.Valid,
+		Failed:  result.Failed,
+		Rows:    make([]ImportUserRowResponse, 0, len(result.Rows)),
+	}
+	for _, row := range result.Rows {
+		rowResp := ImportUserRowResponse{
+			Line:     row.Line,
+			Username: row.Username,
+			Email:    row.Email,
+			Status:   string(row.Status),
+			Error:    row.Error,
+		}
+		if !row.UserID.IsZero() {
+			rowResp.UserID = row.UserID.String()
+		}
+		resp.Rows = append(resp.Rows, rowResp)
+	}
+	return httpserver.RespondOK(c, resp)
+}
@@ -0,0 +1,93 @@
+package httphandler_test
+
+import (
+	"context"
+	"encoding/json"
+	stdhttp "net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	userapp "github.com/lllypuk/flowra/internal/application/user"
+	"github.com/lllypuk/flowra/internal/domain/uuid"
+	httphandler "github.com/lllypuk/flowra/internal/handler/http"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type recordingUserImporter struct {
+	cmd userapp.ImportUsersCommand
+}
+
+func (r *recordingUserImporter) Execute(
+	_ context.Context,
+	cmd userapp.ImportUsersCommand,
+) (userapp.ImportUsersResult, error) {
+	r.cmd = cmd
+	result := userapp.ImportUsersResult{DryRun: cmd.DryRun}
+	for _, row := range cmd.Rows {
+		res := userapp.ImportRowResult{Line: row.Line, Username: row.Username, Email: row.Email}
+		if row.Email == "" {
+			res.Status, res.Error = userapp.ImportStatusFailed, "email is required"
+			result.Failed++
+		} else {
+			res.Status, res.UserID = userapp.ImportStatusCreated, uuid.NewUUID()
+			result.Created++
+		}
+		result.Rows = append(result.Rows, res)
+	}
+	return result, nil
+}
+
+func postUserImport(t *testing.T, h *httphandler.AdminUserImportHandler, body string) *httptest.ResponseRecorder {
+	t.Helper()
+	e := echo.New()
+	req := httptest.NewRequest(stdhttp.MethodPost, "/api/v1/admin/users/import", strings.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	require.NoError(t, h.Import(e.NewContext(req, rec)))
+	return rec
+}
+
+func TestAdminUserImportHandler_Import(t *testing.T) {
+	t.Run("reports every row", func(t *testing.T) {
+		importer := &recordingUserImporter{}
+		h := httphandler.NewAdminUserImportHandler(importer)
+
+		rec := postUserImport(t, h,
+			`{"csv":"username,email\nalice,alice@example.com\nbob,\n","dry_run":false}`)
+		assert.Equal(t, stdhttp.StatusOK, rec.Code)
+
+		var resp struct {
+			Data httphandler.ImportUsersResponse `json:"data"`
+		}
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+		assert.Equal(t, 1, resp.Data.Created)
+		assert.Equal(t, 1, resp.Data.Failed)
+		require.Len(t, resp.Data.Rows, 2)
+		assert.Equal(t, "created", resp.Data.Rows[0].Status)
+		assert.NotEmpty(t, resp.Data.Rows[0].UserID)
+		assert.Equal(t, 3, resp.Data.Rows[1].Line)
+		assert.Equal(t, "email is required", resp.Data.Rows[1].Error)
+		assert.Empty(t, resp.Data.Rows[1].UserID)
+	})
+
+	t.Run("passes dry run through", func(t *testing.T) {
+		importer := &recordingUserImporter{}
+		h := httphandler.NewAdminUserImportHandler(importer)
+
+		rec := postUserImport(t, h, `{"csv":"email,username\nalice@example.com,alice\n","dry_run":true}`)
+		assert.Equal(t, stdhttp.StatusOK, rec.Code)
+		assert.True(t, importer.cmd.DryRun)
+		assert.Contains(t, rec.Body.String(), `"dry_run":true`)
+	})
+
+	t.Run("rejects CSV without required columns", func(t *testing.T) {
+		h := httphandler.NewAdminUserImportHandler(&recordingUserImporter{})
+
+		rec := postUserImport(t, h, `{"csv":"name\nalice\n"}`)
+		assert.Equal(t, stdhttp.StatusBadRequest, rec.Code)
+		assert.Contains(t, rec.Body.String(), "INVALID_CSV")
+	})
+}
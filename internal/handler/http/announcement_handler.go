@@ -0,0 +1,243 @@
+package httphandler
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	announcementapp "github.com/lllypuk/flowra/internal/application/announcement"
+	"github.com/lllypuk/flowra/internal/application/appcore"
+	"github.com/lllypuk/flowra/internal/domain/announcement"
+	"github.com/lllypuk/flowra/internal/domain/uuid"
+	"github.com/lllypuk/flowra/internal/infrastructure/httpserver"
+	"github.com/lllypuk/flowra/internal/middleware"
+)
+
+// AnnouncementPublisher publishes system-wide announcements.
+// Declared on the consumer side per project guidelines.
+type AnnouncementPublisher interface {
+	Execute(ctx context.Context, cmd announcementapp.PublishCommand) (*announcement.Announcement, error)
+}
+
+// AnnouncementEnder takes announcements down before they expire.
+// Declared on the consumer side per project guidelines.
+type AnnouncementEnder interface {
+	Execute(ctx context.Context, cmd announcementapp.EndCommand) (*announcement.Announcement, error)
+}
+
+// AnnouncementLister lists recent announcements for system admins.
+// Declared on the consumer side per project guidelines.
+type AnnouncementLister interface {
+	Execute(ctx context.Context, query announcementapp.ListQuery) ([]*announcement.Announcement, error)
+}
+
+// ActiveAnnouncementLister lists the banners a user should see now.
+// Declared on the consumer side per project guidelines.
+type ActiveAnnouncementLister interface {
+	Execute(ctx context.Context, query announcementapp.ListActiveQuery) ([]*announcement.Announcement, error)
+}
+
+// AnnouncementDismisser hides an announcement banner for a user.
+// Declared on the consumer side per project guidelines.
+type AnnouncementDismisser interface {
+	Execute(ctx context.Context, cmd announcementapp.DismissCommand) error
+}
+
+// AnnouncementUseCases groups the announcement use cases used by the handlers.
+type AnnouncementUseCases struct {
+	Publish    AnnouncementPublisher
+	End        AnnouncementEnder
+	List       AnnouncementLister
+	ListActive ActiveAnnouncementLister
+	Dismiss    AnnouncementDismisser
+}
+
+// PublishAnnouncementRequest represents a request to publish an announcement.
+type PublishAnnouncementRequest struct {
+	Message  string     `json:"message"`
+	Level    string     `json:"level"`
+	StartsAt *time.Time `json:"starts_at,omitempty"`
+	EndsAt   time.Time  `json:"ends_at"`
+}
+
+// AnnouncementResponse represents an announcement in API responses.
+type AnnouncementResponse struct {
+	ID        string    `json:"id"`
+	Message   string    `json:"message"`
+	Level     string    `json:"level"`
+	StartsAt  time.Time `json:"starts_at"`
+	EndsAt    time.Time `json:"ends_at"`
+	CreatedAt time.Time `json:"created_at"`
+	Active    bool      `json:"active"`
+}
+
+// AnnouncementListResponse represents a list of announcements in API responses.
+type AnnouncementListResponse struct {
+	Announcements []AnnouncementResponse `json:"announcements"`
+}
+
+// AnnouncementHandler handles announcement HTTP requests.
+type AnnouncementHandler struct {
+	useCases AnnouncementUseCases
+}
+
+// NewAnnouncementHandler creates a new AnnouncementHandler.
+func NewAnnouncementHandler(useCases AnnouncementUseCases) *AnnouncementHandler {
+	return &AnnouncementHandler{useCases: useCases}
+}
+
+// RegisterRoutes registers announcement routes with the router.
+func (h *AnnouncementHandler) RegisterRoutes(r *httpserver.Router) {
+	r.Auth().GET("/announcements", h.ListActive)
+	r.Auth().POST("/announcements/:id/dismiss", h.Dismiss)
+
+	admin := r.NewAuthRouteGroup("/admin").RequireSystemAdmin()
+	admin.GET("/announcements", h.List)
+	admin.POST("/announcements", h.Publish)
+	admin.POST("/announcements/:id/end", h.End)
+}
+
+// ListActive handles GET /api/v1/announcements.
+// Returns the active announcements the current user has not dismissed.
+func (h *AnnouncementHandler) ListActive(c echo.Context) error {
+	userID := middleware.GetUserID(c)
+	if userID.IsZero() {
+		return httpserver.RespondErrorWithCode(c, http.StatusUnauthorized, "UNAUTHORIZED", "authentication required")
+	}
+
+	announcements, err := h.useCases.ListActive.Execute(
+		c.Request().Context(), announcementapp.ListActiveQuery{UserID: userID})
+	if err != nil {
+		return handleAnnouncementError(c, err)
+	}
+
+	return httpserver.RespondOK(c, ToAnnouncementListResponse(announcements, time.Now()))
+}
+
+// Dismiss handles POST /api/v1/announcements/:id/dismiss.
+func (h *AnnouncementHandler) Dismiss(c echo.Context) error {
+	userID := middleware.GetUserID(c)
+	if userID.IsZero() {
+		return httpserver.RespondErrorWithCode(c, http.StatusUnauthorized, "UNAUTHORIZED", "authentication required")
+	}
+
+	announcementID, err := uuid.ParseUUID(c.Param("id"))
+	if err != nil {
+		return httpserver.RespondErrorWithCode(
+			c, http.StatusBadRequest, "INVALID_ANNOUNCEMENT_ID", "invalid announcement ID format")
+	}
+
+	cmd := announcementapp.DismissCommand{AnnouncementID: announcementID, UserID: userID}
+	if dismissErr := h.useCases.Dismiss.Execute(c.Request().Context(), cmd); dismissErr != nil {
+		return handleAnnouncementError(c, dismissErr)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// List handles GET /api/v1/admin/announcements.
+// Returns the most recently created announcements, including scheduled and expired ones.
+func (h *AnnouncementHandler) List(c echo.Context) error {
+	query := announcementapp.ListQuery{}
+	if raw := c.QueryParam("limit"); raw != "" {
+		limit, err := strconv.Atoi(raw)
+		if err != nil || limit <= 0 {
+			return httpserver.RespondErrorWithCode(
+				c, http.StatusBadRequest, "VALIDATION_ERROR", "limit must be a positive integer")
+		}
+		query.Limit = limit
+	}
+
+	announcements, err := h.useCases.List.Execute(c.Request().Context(), query)
+	if err != nil {
+		return handleAnnouncementError(c, err)
+	}
+
+	return httpserver.RespondOK(c, ToAnnouncementListResponse(announcements, time.Now()))
+}
+
+// Publish handles POST /api/v1/admin/announcements.
+func (h *AnnouncementHandler) Publish(c echo.Context) error {
+	adminID := middleware.GetUserID(c)
+
+	var req PublishAnnouncementRequest
+	if err := c.Bind(&req); err != nil {
+		return httpserver.RespondErrorWithCode(c, http.StatusBadRequest, "INVALID_REQUEST", "Invalid request body")
+	}
+
+	cmd := announcementapp.PublishCommand{
+		Message: req.Message,
+		Level:   announcement.Level(req.Level),
+		EndsAt:  req.EndsAt,
+		AdminID: adminID,
+	}
+	if req.StartsAt != nil {
+		cmd.StartsAt = *req.StartsAt
+	}
+
+	published, err := h.useCases.Publish.Execute(c.Request().Context(), cmd)
+	if err != nil {
+		return handleAnnouncementError(c, err)
+	}
+
+	return httpserver.RespondCreated(c, ToAnnouncementResponse(published, time.Now()))
+}
+
+// End handles POST /api/v1/admin/announcements/:id/end.
+func (h *AnnouncementHandler) End(c echo.Context) error {
+	announcementID, err := uuid.ParseUUID(c.Param("id"))
+	if err != nil {
+		return httpserver.RespondErrorWithCode(
+			c, http.StatusBadRequest, "INVALID_ANNOUNCEMENT_ID", "invalid announcement ID format")
+	}
+
+	cmd := announcementapp.EndCommand{AnnouncementID: announcementID, AdminID: middleware.GetUserID(c)}
+	ended, endErr := h.useCases.End.Execute(c.Request().Context(), cmd)
+	if endErr != nil {
+		return handleAnnouncementError(c, endErr)
+	}
+
+	return httpserver.RespondOK(c, ToAnnouncementResponse(ended, time.Now()))
+}
+
+// handleAnnouncementError maps announcement use case errors to HTTP responses.
+func handleAnnouncementError(c echo.Context, err error) error {
+	var validationErr *appcore.ValidationError
+	switch {
+	case errors.Is(err, announcementapp.ErrAnnouncementNotFound):
+		return httpserver.RespondErrorWithCode(
+			c, http.StatusNotFound, "ANNOUNCEMENT_NOT_FOUND", "announcement not found")
+	case errors.Is(err, announcementapp.ErrAnnouncementEnded):
+		return httpserver.RespondErrorWithCode(
+			c, http.StatusConflict, "ANNOUNCEMENT_ENDED", "announcement already ended")
+	case errors.As(err, &validationErr):
+		return httpserver.RespondErrorWithCode(c, http.StatusBadRequest, "VALIDATION_ERROR", validationErr.Error())
+	default:
+		return httpserver.RespondError(c, err)
+	}
+}
+
+// ToAnnouncementResponse converts an announcement to its API representation.
+func ToAnnouncementResponse(a *announcement.Announcement, now time.Time) AnnouncementResponse {
+	return AnnouncementResponse{
+		ID:        a.ID().String(),
+		Message:   a.Message(),
+		Level:     string(a.Level()),
+		StartsAt:  a.StartsAt(),
+		EndsAt:    a.EndsAt(),
+		CreatedAt: a.CreatedAt(),
+		Active:    a.IsActive(now),
+	}
+}
+
+// ToAnnouncementListResponse converts announcements to their API representation.
+func ToAnnouncementListResponse(announcements []*announcement.Announcement, now time.Time) AnnouncementListResponse {
+	items := make([]AnnouncementResponse, 0, len(announcements))
+	for _, a := range announcements {
+		items = append(items, ToAnnouncementResponse(a, now))
+	}
+	return AnnouncementListResponse{Announcements: items}
+}
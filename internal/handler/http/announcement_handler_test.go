@@ -0,0 +1,207 @@
+package httphandler_test
+
+import (
+	"context"
+	"encoding/json"
+	stdhttp "net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	announcementapp "github.com/lllypuk/flowra/internal/application/announcement"
+	"github.com/lllypuk/flowra/internal/application/appcore"
+	"github.com/lllypuk/flowra/internal/domain/announcement"
+	"github.com/lllypuk/flowra/internal/domain/uuid"
+	httphandler "github.com/lllypuk/flowra/internal/handler/http"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type stubAnnouncementPublisher struct {
+	lastCmd announcementapp.PublishCommand
+	err     error
+}
+
+func (s *stubAnnouncementPublisher) Execute(
+	_ context.Context,
+	cmd announcementapp.PublishCommand,
+) (*announcement.Announcement, error) {
+	s.lastCmd = cmd
+	if s.err != nil {
+		return nil, s.err
+	}
+	return announcement.NewAnnouncement(cmd.Message, cmd.Level, cmd.StartsAt, cmd.EndsAt, cmd.AdminID)
+}
+
+type stubAnnouncementEnder struct {
+	err error
+}
+
+func (s *stubAnnouncementEnder) Execute(
+	_ context.Context,
+	cmd announcementapp.EndCommand,
+) (*announcement.Announcement, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	now := time.Now()
+	return announcement.Reconstruct(cmd.AnnouncementID, "Done", announcement.LevelInfo,
+		now.Add(-time.Hour), now, cmd.AdminID, now.Add(-time.Hour)), nil
+}
+
+type stubActiveAnnouncements struct {
+	announcements []*announcement.Announcement
+	err           error
+}
+
+func (s *stubActiveAnnouncements) Execute(
+	_ context.Context,
+	_ announcementapp.ListActiveQuery,
+) ([]*announcement.Announcement, error) {
+	return s.announcements, s.err
+}
+
+type stubAnnouncementDismisser struct {
+	lastCmd announcementapp.DismissCommand
+	err     error
+}
+
+func (s *stubAnnouncementDismisser) Execute(_ context.Context, cmd announcementapp.DismissCommand) error {
+	s.lastCmd = cmd
+	return s.err
+}
+
+func newActiveAnnouncement(t *testing.T, level announcement.Level, message string) *announcement.Announcement {
+	t.Helper()
+	a, err := announcement.NewAnnouncement(message, level, time.Time{}, time.Now().Add(time.Hour), uuid.NewUUID())
+	require.NoError(t, err)
+	return a
+}
+
+func newAnnouncementContext(method, target, body string, userID uuid.UUID) (echo.Context, *httptest.ResponseRecorder) {
+	e := echo.New()
+	req := httptest.NewRequest(method, target, strings.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	if !userID.IsZero() {
+		setupUserAuthContext(c, userID)
+	}
+	return c, rec
+}
+
+func TestAnnouncementHandler_Publish(t *testing.T) {
+	adminID := uuid.NewUUID()
+	endsAt := time.Now().Add(2 * time.Hour).UTC().Truncate(time.Second)
+
+	t.Run("publishes announcement", func(t *testing.T) {
+		publisher := &stubAnnouncementPublisher{}
+		handler := httphandler.NewAnnouncementHandler(httphandler.AnnouncementUseCases{Publish: publisher})
+
+		body := `{"message":"Maintenance tonight","level":"maintenance","ends_at":"` +
+			endsAt.Format(time.RFC3339) + `"}`
+		c, rec := newAnnouncementContext(stdhttp.MethodPost, "/admin/announcements", body, adminID)
+		require.NoError(t, handler.Publish(c))
+		assert.Equal(t, stdhttp.StatusCreated, rec.Code)
+		assert.Equal(t, adminID, publisher.lastCmd.AdminID)
+		assert.Equal(t, announcement.LevelMaintenance, publisher.lastCmd.Level)
+		assert.True(t, publisher.lastCmd.StartsAt.IsZero())
+
+		var resp struct {
+			Data httphandler.AnnouncementResponse `json:"data"`
+		}
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+		assert.Equal(t, "Maintenance tonight", resp.Data.Message)
+		assert.True(t, resp.Data.Active)
+	})
+
+	t.Run("validation error", func(t *testing.T) {
+		publisher := &stubAnnouncementPublisher{err: appcore.NewValidationError("level", "is invalid")}
+		handler := httphandler.NewAnnouncementHandler(httphandler.AnnouncementUseCases{Publish: publisher})
+
+		c, rec := newAnnouncementContext(stdhttp.MethodPost, "/admin/announcements", `{"level":"x"}`, adminID)
+		require.NoError(t, handler.Publish(c))
+		assert.Equal(t, stdhttp.StatusBadRequest, rec.Code)
+		assert.Contains(t, rec.Body.String(), "VALIDATION_ERROR")
+	})
+
+	t.Run("invalid body", func(t *testing.T) {
+		handler := httphandler.NewAnnouncementHandler(httphandler.AnnouncementUseCases{})
+
+		c, rec := newAnnouncementContext(stdhttp.MethodPost, "/admin/announcements", `{`, adminID)
+		require.NoError(t, handler.Publish(c))
+		assert.Equal(t, stdhttp.StatusBadRequest, rec.Code)
+	})
+}
+
+func TestAnnouncementHandler_End(t *testing.T) {
+	id := uuid.NewUUID()
+
+	tests := []struct {
+		name     string
+		param    string
+		err      error
+		expected int
+	}{
+		{"ends announcement", id.String(), nil, stdhttp.StatusOK},
+		{"invalid id", "nope", nil, stdhttp.StatusBadRequest},
+		{"not found", id.String(), announcementapp.ErrAnnouncementNotFound, stdhttp.StatusNotFound},
+		{"already ended", id.String(), announcementapp.ErrAnnouncementEnded, stdhttp.StatusConflict},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler := httphandler.NewAnnouncementHandler(httphandler.AnnouncementUseCases{
+				End: &stubAnnouncementEnder{err: tt.err},
+			})
+
+			c, rec := newAnnouncementContext(stdhttp.MethodPost, "/admin/announcements/"+tt.param+"/end", "",
+				uuid.NewUUID())
+			c.SetParamNames("id")
+			c.SetParamValues(tt.param)
+			require.NoError(t, handler.End(c))
+			assert.Equal(t, tt.expected, rec.Code)
+		})
+	}
+}
+
+func TestAnnouncementHandler_ListActiveAndDismiss(t *testing.T) {
+	userID := uuid.NewUUID()
+	active := newActiveAnnouncement(t, announcement.LevelIncident, "API degraded")
+	dismisser := &stubAnnouncementDismisser{}
+	handler := httphandler.NewAnnouncementHandler(httphandler.AnnouncementUseCases{
+		ListActive: &stubActiveAnnouncements{announcements: []*announcement.Announcement{active}},
+		Dismiss:    dismisser,
+	})
+
+	t.Run("lists active announcements", func(t *testing.T) {
+		c, rec := newAnnouncementContext(stdhttp.MethodGet, "/announcements", "", userID)
+		require.NoError(t, handler.ListActive(c))
+		assert.Equal(t, stdhttp.StatusOK, rec.Code)
+
+		var resp struct {
+			Data httphandler.AnnouncementListResponse `json:"data"`
+		}
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+		require.Len(t, resp.Data.Announcements, 1)
+		assert.Equal(t, "incident", resp.Data.Announcements[0].Level)
+	})
+
+	t.Run("unauthenticated", func(t *testing.T) {
+		c, rec := newAnnouncementContext(stdhttp.MethodGet, "/announcements", "", "")
+		require.NoError(t, handler.ListActive(c))
+		assert.Equal(t, stdhttp.StatusUnauthorized, rec.Code)
+	})
+
+	t.Run("dismisses announcement", func(t *testing.T) {
+		c, rec := newAnnouncementContext(stdhttp.MethodPost, "/announcements/"+active.ID().String()+"/dismiss", "",
+			userID)
+		c.SetParamNames("id")
+		c.SetParamValues(active.ID().String())
+		require.NoError(t, handler.Dismiss(c))
+		assert.Equal(t, stdhttp.StatusNoContent, rec.Code)
+		assert.Equal(t, active.ID(), dismisser.lastCmd.AnnouncementID)
+		assert.Equal(t, userID, dismisser.lastCmd.UserID)
+	})
+}
@@ -0,0 +1,137 @@
+package httphandler
+
+import (
+	"bytes"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	announcementapp "github.com/lllypuk/flowra/internal/application/announcement"
+	"github.com/lllypuk/flowra/internal/domain/announcement"
+	"github.com/lllypuk/flowra/internal/domain/uuid"
+	"github.com/lllypuk/flowra/internal/middleware"
+)
+
+// AnnouncementViewData represents an announcement banner in templates.
+type AnnouncementViewData struct {
+	ID         string
+	Message    string
+	Level      string
+	FlashClass string
+	EndsAt     time.Time
+}
+
+// AnnouncementTemplateHandler renders the announcement banner shown on every page.
+type AnnouncementTemplateHandler struct {
+	renderer   *TemplateRenderer
+	logger     *slog.Logger
+	listActive ActiveAnnouncementLister
+	dismiss    AnnouncementDismisser
+}
+
+// NewAnnouncementTemplateHandler creates a new announcement template handler.
+func NewAnnouncementTemplateHandler(
+	renderer *TemplateRenderer,
+	logger *slog.Logger,
+	listActive ActiveAnnouncementLister,
+	dismiss AnnouncementDismisser,
+) *AnnouncementTemplateHandler {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &AnnouncementTemplateHandler{
+		renderer:   renderer,
+		logger:     logger,
+		listActive: listActive,
+		dismiss:    dismiss,
+	}
+}
+
+// SetupAnnouncementRoutes registers the announcement banner partials.
+func (h *AnnouncementTemplateHandler) SetupAnnouncementRoutes(e *echo.Echo) {
+	partials := e.Group("/partials", RequireAuth)
+	partials.GET("/announcements", h.BannerPartial)
+	partials.POST("/announcements/:id/dismiss", h.DismissPartial)
+}
+
+// BannerPartial renders the active announcements the user has not dismissed.
+func (h *AnnouncementTemplateHandler) BannerPartial(c echo.Context) error {
+	userID := middleware.GetUserID(c)
+	if userID.IsZero() {
+		return c.String(http.StatusUnauthorized, "Unauthorized")
+	}
+
+	return h.renderBanner(c, userID)
+}
+
+// DismissPartial hides an announcement for the user and re-renders the banner.
+func (h *AnnouncementTemplateHandler) DismissPartial(c echo.Context) error {
+	userID := middleware.GetUserID(c)
+	if userID.IsZero() {
+		return c.String(http.StatusUnauthorized, "Unauthorized")
+	}
+
+	announcementID, err := uuid.ParseUUID(c.Param("id"))
+	if err != nil {
+		return c.String(http.StatusBadRequest, "Invalid announcement ID")
+	}
+
+	cmd := announcementapp.DismissCommand{AnnouncementID: announcementID, UserID: userID}
+	if dismissErr := h.dismiss.Execute(c.Request().Context(), cmd); dismissErr != nil {
+		h.logger.Error("failed to dismiss announcement",
+			slog.String("announcement_id", announcementID.String()),
+			slog.String("error", dismissErr.Error()))
+	}
+
+	return h.renderBanner(c, userID)
+}
+
+// renderBanner renders the banner partial. Failures render an empty banner
+// so that a broken announcement store never breaks the page.
+func (h *AnnouncementTemplateHandler) renderBanner(c echo.Context, userID uuid.UUID) error {
+	announcements, err := h.listActive.Execute(
+		c.Request().Context(), announcementapp.ListActiveQuery{UserID: userID})
+	if err != nil {
+		h.logger.Error("failed to list active announcements", slog.String("error", err.Error()))
+		announcements = nil
+	}
+	if len(announcements) == 0 || h.renderer == nil {
+		return c.NoContent(http.StatusOK)
+	}
+
+	views := make([]AnnouncementViewData, 0, len(announcements))
+	for _, a := range announcements {
+		views = append(views, toAnnouncementViewData(a))
+	}
+
+	var buf bytes.Buffer
+	data := map[string]any{"Announcements": views}
+	if renderErr := h.renderer.Render(&buf, "announcement/banner", data, c); renderErr != nil {
+		h.logger.Error("failed to render announcement banner", slog.String("error", renderErr.Error()))
+		return c.NoContent(http.StatusOK)
+	}
+
+	c.Response().Header().Set("Content-Type", "text/html; charset=utf-8")
+	return c.HTMLBlob(http.StatusOK, buf.Bytes())
+}
+
+func toAnnouncementViewData(a *announcement.Announcement) AnnouncementViewData {
+	var flashClass string
+	switch a.Level() {
+	case announcement.LevelMaintenance:
+		flashClass = "flash-warning"
+	case announcement.LevelIncident:
+		flashClass = "flash-error"
+	case announcement.LevelInfo:
+		flashClass = "flash-info"
+	}
+
+	return AnnouncementViewData{
+		ID:         a.ID().String(),
+		Message:    a.Message(),
+		Level:      string(a.Level()),
+		FlashClass: flashClass,
+		EndsAt:     a.EndsAt(),
+	}
+}
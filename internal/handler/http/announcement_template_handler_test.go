@@ -0,0 +1,57 @@
+package httphandler_test
+
+import (
+	"errors"
+	stdhttp "net/http"
+	"testing"
+
+	"github.com/lllypuk/flowra/internal/domain/announcement"
+	"github.com/lllypuk/flowra/internal/domain/uuid"
+	httphandler "github.com/lllypuk/flowra/internal/handler/http"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAnnouncementTemplateHandler_BannerPartial(t *testing.T) {
+	userID := uuid.NewUUID()
+
+	t.Run("renders active announcements", func(t *testing.T) {
+		active := newActiveAnnouncement(t, announcement.LevelMaintenance, "Upgrade at 22:00 UTC")
+		handler := httphandler.NewAnnouncementTemplateHandler(newTestRenderer(t), nil,
+			&stubActiveAnnouncements{announcements: []*announcement.Announcement{active}},
+			&stubAnnouncementDismisser{})
+
+		c, rec := newAnnouncementContext(stdhttp.MethodGet, "/partials/announcements", "", userID)
+		require.NoError(t, handler.BannerPartial(c))
+		assert.Equal(t, stdhttp.StatusOK, rec.Code)
+		body := rec.Body.String()
+		assert.Contains(t, body, "Upgrade at 22:00 UTC")
+		assert.Contains(t, body, "flash-warning")
+		assert.Contains(t, body, `hx-post="/partials/announcements/`+active.ID().String()+`/dismiss"`)
+	})
+
+	t.Run("renders nothing when the store fails", func(t *testing.T) {
+		handler := httphandler.NewAnnouncementTemplateHandler(newTestRenderer(t), nil,
+			&stubActiveAnnouncements{err: errors.New("mongo down")}, &stubAnnouncementDismisser{})
+
+		c, rec := newAnnouncementContext(stdhttp.MethodGet, "/partials/announcements", "", userID)
+		require.NoError(t, handler.BannerPartial(c))
+		assert.Equal(t, stdhttp.StatusOK, rec.Code)
+		assert.Empty(t, rec.Body.String())
+	})
+
+	t.Run("dismiss re-renders the banner", func(t *testing.T) {
+		dismissed := newActiveAnnouncement(t, announcement.LevelInfo, "New feature")
+		dismisser := &stubAnnouncementDismisser{}
+		handler := httphandler.NewAnnouncementTemplateHandler(newTestRenderer(t), nil,
+			&stubActiveAnnouncements{}, dismisser)
+
+		c, rec := newAnnouncementContext(stdhttp.MethodPost, "/partials/announcements/x/dismiss", "", userID)
+		c.SetParamNames("id")
+		c.SetParamValues(dismissed.ID().String())
+		require.NoError(t, handler.DismissPartial(c))
+		assert.Equal(t, stdhttp.StatusOK, rec.Code)
+		assert.Equal(t, dismissed.ID(), dismisser.lastCmd.AnnouncementID)
+		assert.Empty(t, rec.Body.String())
+	})
+}
@@ -26,6 +26,11 @@ type PageAuthConfig struct {
 
 	// Logger for auth events.
 	Logger *slog.Logger
+
+	// TimezoneResolver loads the authenticated user's time zone into the request context. Optional.
+	TimezoneResolver middleware.TimezoneResolver
+
+	timezone echo.MiddlewareFunc
 }
 
 // globalPageAuthConfig holds the global configuration for page auth middleware.
@@ -35,14 +40,28 @@ type PageAuthConfig struct {
 var globalPageAuthConfig *PageAuthConfig
 
 // SetPageAuthConfig sets the global page auth configuration.
-// This must be called during application startup before any requests are processed.
+// This must be called during application startup before page routes are registered.
 func SetPageAuthConfig(config *PageAuthConfig) {
+	if config != nil && config.TimezoneResolver != nil {
+		config.timezone = middleware.Timezone(config.TimezoneResolver)
+	}
 	globalPageAuthConfig = config
 }
 
+// withUserTimezone wraps next so it sees the authenticated user's time zone.
+// It is applied once when a route or group is set up, not per request.
+func withUserTimezone(next echo.HandlerFunc) echo.HandlerFunc {
+	config := globalPageAuthConfig
+	if config == nil || config.timezone == nil {
+		return next
+	}
+	return config.timezone(next)
+}
+
 // RequireAuth is a middleware that checks if the user is authenticated.
 // For regular requests, it redirects to login. For HTMX requests, it returns 401.
 func RequireAuth(next echo.HandlerFunc) echo.HandlerFunc {
+	next = withUserTimezone(next)
 	return func(c echo.Context) error {
 		// Check for session cookie
 		token := getSessionCookie(c)
@@ -68,6 +87,7 @@ func RequireAuth(next echo.HandlerFunc) echo.HandlerFunc {
 // OptionalAuth is a middleware that checks for authentication but doesn't require it.
 // It sets user info in context if authenticated, but allows the request to proceed either way.
 func OptionalAuth(next echo.HandlerFunc) echo.HandlerFunc {
+	next = withUserTimezone(next)
 	return func(c echo.Context) error {
 		// Check for session cookie
 		token := getSessionCookie(c)
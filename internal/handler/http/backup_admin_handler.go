@@ -0,0 +1,263 @@
+package httphandler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/lllypuk/flowra/internal/domain/uuid"
+	"github.com/lllypuk/flowra/internal/domain/workspace"
+	"github.com/lllypuk/flowra/internal/infrastructure/backup"
+	"github.com/lllypuk/flowra/internal/infrastructure/httpserver"
+	"github.com/lllypuk/flowra/internal/middleware"
+)
+
+// BackupJobStore queues workspace backup jobs and reports on them.
+// Declared on the consumer side per project guidelines.
+type BackupJobStore interface {
+	Create(ctx context.Context, job *backup.Job) error
+	Get(ctx context.Context, id string) (*backup.Job, error)
+	List(ctx context.Context, limit int) ([]*backup.Job, error)
+}
+
+// BackupWorkspaceFinder looks up the workspace to export.
+// Declared on the consumer side per project guidelines.
+type BackupWorkspaceFinder interface {
+	FindByID(ctx context.Context, id uuid.UUID) (*workspace.Workspace, error)
+}
+
+// CreateBackupRequest is the body of a workspace export.
+type CreateBackupRequest struct {
+	WorkspaceID string `json:"workspace_id"`
+}
+
+// BackupJobResponse represents a backup job in API responses.
+type BackupJobResponse struct {
+	ID          string          `json:"id"`
+	Kind        string          `json:"kind"`
+	Status      string          `json:"status"`
+	WorkspaceID string          `json:"workspace_id,omitempty"`
+	Progress    backup.Progress `json:"progress"`
+	Counts      map[string]int  `json:"counts,omitempty"`
+	Error       string          `json:"error,omitempty"`
+	RequestedBy string          `json:"requested_by"`
+	CreatedAt   time.Time       `json:"created_at"`
+	StartedAt   *time.Time      `json:"started_at,omitempty"`
+	FinishedAt  *time.Time      `json:"finished_at,omitempty"`
+}
+
+// BackupAdminHandler handles the workspace backup and restore admin API.
+// Exports and restores are queued as jobs and carried out by the worker, which
+// shares the backup directory with the API.
+type BackupAdminHandler struct {
+	jobs          BackupJobStore
+	workspaces    BackupWorkspaceFinder
+	dir           string
+	maxUploadSize int64
+}
+
+// NewBackupAdminHandler creates a new BackupAdminHandler.
+func NewBackupAdminHandler(
+	jobs BackupJobStore,
+	workspaces BackupWorkspaceFinder,
+	dir string,
+	maxUploadSize int64,
+) *BackupAdminHandler {
+	return &BackupAdminHandler{
+		jobs:          jobs,
+		workspaces:    workspaces,
+		dir:           dir,
+		maxUploadSize: maxUploadSize,
+	}
+}
+
+// RegisterRoutes registers the backup routes with the router. System admins only.
+func (h *BackupAdminHandler) RegisterRoutes(r *httpserver.Router) {
+	admin := r.NewAuthRouteGroup("/admin/backups").RequireSystemAdmin()
+	admin.GET("", h.List)
+	admin.POST("", h.Create)
+	admin.POST("/restore", h.Restore)
+	admin.GET("/:id", h.Get)
+	admin.GET("/:id/download", h.Download)
+}
+
+// Create handles POST /api/v1/admin/backups.
+// It queues an export of the workspace.
+func (h *BackupAdminHandler) Create(c echo.Context) error {
+	var req CreateBackupRequest
+	if err := c.Bind(&req); err != nil {
+		return httpserver.RespondErrorWithCode(c, http.StatusBadRequest, "INVALID_REQUEST", "Invalid request body")
+	}
+	workspaceID, err := uuid.ParseUUID(req.WorkspaceID)
+	if err != nil {
+		return httpserver.RespondErrorWithCode(
+			c, http.StatusBadRequest, "VALIDATION_ERROR", "workspace_id must be a valid UUID")
+	}
+	if _, err = h.workspaces.FindByID(c.Request().Context(), workspaceID); err != nil {
+		return httpserver.RespondError(c, err)
+	}
+
+	job := backup.NewExportJob(workspaceID, middleware.GetUserID(c), h.dir)
+	if err = h.jobs.Create(c.Request().Context(), job); err != nil {
+		return httpserver.RespondErrorWithCode(
+			c, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to queue workspace export")
+	}
+	return httpserver.RespondJSON(c, http.StatusAccepted, toBackupJobResponse(job))
+}
+
+// Restore handles POST /api/v1/admin/backups/restore.
+// Accepts a multipart form with the archive in the "archive" field and an
+// optional "name" for the restored workspace, and queues the restore.
+func (h *BackupAdminHandler) Restore(c echo.Context) error {
+	c.Request().Body = http.MaxBytesReader(c.Response(), c.Request().Body, h.maxUploadSize)
+
+	file, err := c.FormFile("archive")
+	if err != nil {
+		if strings.Contains(err.Error(), "http: request body too large") {
+			return h.respondArchiveTooLarge(c)
+		}
+		return httpserver.RespondErrorWithCode(c, http.StatusBadRequest, "INVALID_FILE", "archive is required")
+	}
+	if file.Size > h.maxUploadSize {
+		return h.respondArchiveTooLarge(c)
+	}
+
+	src, err := file.Open()
+	if err != nil {
+		return httpserver.RespondErrorWithCode(
+			c, http.StatusInternalServerError, "FILE_ERROR", "failed to read uploaded archive")
+	}
+	defer src.Close()
+
+	path := backup.UploadPath(h.dir)
+	if err = saveBackupArchive(src, path); err != nil {
+		if errors.Is(err, backup.ErrInvalidArchive) {
+			return httpserver.RespondErrorWithCode(
+				c, http.StatusBadRequest, "INVALID_ARCHIVE", "file is not a workspace backup archive")
+		}
+		return httpserver.RespondErrorWithCode(
+			c, http.StatusInternalServerError, "STORAGE_ERROR", "failed to save archive")
+	}
+
+	job := backup.NewRestoreJob(path, strings.TrimSpace(c.FormValue("name")), middleware.GetUserID(c))
+	if err = h.jobs.Create(c.Request().Context(), job); err != nil {
+		_ = os.Remove(path)
+		return httpserver.RespondErrorWithCode(
+			c, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to queue workspace restore")
+	}
+	return httpserver.RespondJSON(c, http.StatusAccepted, toBackupJobResponse(job))
+}
+
+// List handles GET /api/v1/admin/backups.
+func (h *BackupAdminHandler) List(c echo.Context) error {
+	jobs, err := h.jobs.List(c.Request().Context(), 0)
+	if err != nil {
+		return httpserver.RespondErrorWithCode(
+			c, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to list backup jobs")
+	}
+	resp := make([]BackupJobResponse, 0, len(jobs))
+	for _, job := range jobs {
+		resp = append(resp, toBackupJobResponse(job))
+	}
+	return httpserver.RespondOK(c, map[string]any{"jobs": resp})
+}
+
+// Get handles GET /api/v1/admin/backups/:id.
+func (h *BackupAdminHandler) Get(c echo.Context) error {
+	job, errResp := h.loadJob(c)
+	if errResp != nil {
+		return errResp()
+	}
+	return httpserver.RespondOK(c, toBackupJobResponse(job))
+}
+
+// Download handles GET /api/v1/admin/backups/:id/download.
+// Only completed exports can be downloaded.
+func (h *BackupAdminHandler) Download(c echo.Context) error {
+	job, errResp := h.loadJob(c)
+	if errResp != nil {
+		return errResp()
+	}
+	if job.Kind != backup.JobKindExport || job.Status != backup.JobStatusCompleted {
+		return httpserver.RespondErrorWithCode(
+			c, http.StatusConflict, "BACKUP_NOT_READY", "only completed exports can be downloaded")
+	}
+	if _, err := os.Stat(job.ArchivePath); err != nil {
+		return httpserver.RespondErrorWithCode(c, http.StatusNotFound, "NOT_FOUND", "archive not found")
+	}
+
+	c.Response().Header().Set("Content-Disposition",
+		fmt.Sprintf("attachment; filename=%q", filepath.Base(job.ArchivePath)))
+	return c.File(job.ArchivePath)
+}
+
+func (h *BackupAdminHandler) loadJob(c echo.Context) (*backup.Job, func() error) {
+	job, err := h.jobs.Get(c.Request().Context(), c.Param("id"))
+	if errors.Is(err, backup.ErrJobNotFound) {
+		return nil, func() error {
+			return httpserver.RespondErrorWithCode(c, http.StatusNotFound, "NOT_FOUND", "backup job not found")
+		}
+	}
+	if err != nil {
+		return nil, func() error {
+			return httpserver.RespondErrorWithCode(
+				c, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to load backup job")
+		}
+	}
+	return job, nil
+}
+
+func (h *BackupAdminHandler) respondArchiveTooLarge(c echo.Context) error {
+	return httpserver.RespondErrorWithCode(c, http.StatusRequestEntityTooLarge, "FILE_TOO_LARGE",
+		fmt.Sprintf("archive size exceeds %d MB limit", h.maxUploadSize/bytesPerMB))
+}
+
+// saveBackupArchive stores an uploaded archive after checking its header, so
+// that a wrong file is rejected right away rather than by the worker.
+func saveBackupArchive(src io.ReadSeeker, path string) error {
+	archive, _, err := backup.NewArchiveReader(src)
+	if err != nil {
+		return err
+	}
+	_ = archive.Close()
+	if _, err = src.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+
+	if err = os.MkdirAll(filepath.Dir(path), 0o750); err != nil {
+		return err
+	}
+	dst, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if _, err = io.Copy(dst, src); err != nil {
+		_ = dst.Close()
+		_ = os.Remove(path)
+		return err
+	}
+	return dst.Close()
+}
+
+func toBackupJobResponse(job *backup.Job) BackupJobResponse {
+	return BackupJobResponse{
+		ID:          job.ID,
+		Kind:        string(job.Kind),
+		Status:      string(job.Status),
+		WorkspaceID: job.WorkspaceID,
+		Progress:    job.Progress,
+		Counts:      job.Counts,
+		Error:       job.Error,
+		RequestedBy: job.RequestedBy,
+		CreatedAt:   job.CreatedAt,
+		StartedAt:   job.StartedAt,
+		FinishedAt:  job.FinishedAt,
+	}
+}
@@ -0,0 +1,153 @@
+package httphandler_test
+
+import (
+	"bytes"
+	"context"
+	"mime/multipart"
+	stdhttp "net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/lllypuk/flowra/internal/domain/errs"
+	"github.com/lllypuk/flowra/internal/domain/uuid"
+	"github.com/lllypuk/flowra/internal/domain/workspace"
+	httphandler "github.com/lllypuk/flowra/internal/handler/http"
+	"github.com/lllypuk/flowra/internal/infrastructure/backup"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+type fakeBackupJobs struct {
+	jobs []*backup.Job
+}
+
+func (f *fakeBackupJobs) Create(_ context.Context, job *backup.Job) error {
+	f.jobs = append(f.jobs, job)
+	return nil
+}
+
+func (f *fakeBackupJobs) Get(_ context.Context, id string) (*backup.Job, error) {
+	for _, job := range f.jobs {
+		if job.ID == id {
+			return job, nil
+		}
+	}
+	return nil, backup.ErrJobNotFound
+}
+
+func (f *fakeBackupJobs) List(context.Context, int) ([]*backup.Job, error) {
+	return f.jobs, nil
+}
+
+type fakeBackupWorkspaces struct {
+	known uuid.UUID
+}
+
+func (f fakeBackupWorkspaces) FindByID(_ context.Context, id uuid.UUID) (*workspace.Workspace, error) {
+	if id != f.known {
+		return nil, errs.ErrNotFound
+	}
+	return nil, nil //nolint:nilnil // the handler only checks for existence
+}
+
+func newBackupRestoreContext(t *testing.T, archive []byte) (echo.Context, *httptest.ResponseRecorder) {
+	t.Helper()
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+	part, err := writer.CreateFormFile("archive", "backup.ndjson.gz")
+	require.NoError(t, err)
+	_, err = part.Write(archive)
+	require.NoError(t, err)
+	require.NoError(t, writer.WriteField("name", "Restored"))
+	require.NoError(t, writer.Close())
+
+	req := httptest.NewRequest(stdhttp.MethodPost, "/", body)
+	req.Header.Set(echo.HeaderContentType, writer.FormDataContentType())
+	rec := httptest.NewRecorder()
+	return echo.New().NewContext(req, rec), rec
+}
+
+func TestBackupAdminHandler_Create(t *testing.T) {
+	workspaceID := uuid.NewUUID()
+	jobs := &fakeBackupJobs{}
+	handler := httphandler.NewBackupAdminHandler(jobs, fakeBackupWorkspaces{known: workspaceID}, t.TempDir(), 1<<20)
+
+	c, rec := newProjectionAdminContext(stdhttp.MethodPost, `{"workspace_id":"`+workspaceID.String()+`"}`)
+	require.NoError(t, handler.Create(c))
+	assert.Equal(t, stdhttp.StatusAccepted, rec.Code)
+	require.Len(t, jobs.jobs, 1)
+	assert.Equal(t, backup.JobKindExport, jobs.jobs[0].Kind)
+	assert.Equal(t, workspaceID.String(), jobs.jobs[0].WorkspaceID)
+
+	c, rec = newProjectionAdminContext(stdhttp.MethodPost, `{"workspace_id":"`+uuid.NewUUID().String()+`"}`)
+	require.NoError(t, handler.Create(c))
+	assert.Equal(t, stdhttp.StatusNotFound, rec.Code)
+
+	c, rec = newProjectionAdminContext(stdhttp.MethodPost, `{"workspace_id":"nope"}`)
+	require.NoError(t, handler.Create(c))
+	assert.Equal(t, stdhttp.StatusBadRequest, rec.Code)
+	assert.Len(t, jobs.jobs, 1)
+}
+
+func TestBackupAdminHandler_Restore(t *testing.T) {
+	t.Run("stores the archive and queues the restore", func(t *testing.T) {
+		var archive bytes.Buffer
+		w, err := backup.NewArchiveWriter(&archive, backup.Header{WorkspaceID: "ws"})
+		require.NoError(t, err)
+		require.NoError(t, w.Write("workspaces", bson.D{{Key: "workspace_id", Value: "ws"}}))
+		require.NoError(t, w.Close())
+
+		jobs := &fakeBackupJobs{}
+		handler := httphandler.NewBackupAdminHandler(jobs, fakeBackupWorkspaces{}, t.TempDir(), 1<<20)
+
+		c, rec := newBackupRestoreContext(t, archive.Bytes())
+		require.NoError(t, handler.Restore(c))
+		assert.Equal(t, stdhttp.StatusAccepted, rec.Code)
+		require.Len(t, jobs.jobs, 1)
+		assert.Equal(t, backup.JobKindRestore, jobs.jobs[0].Kind)
+		assert.Equal(t, "Restored", jobs.jobs[0].WorkspaceName)
+
+		stored, err := os.ReadFile(jobs.jobs[0].ArchivePath)
+		require.NoError(t, err)
+		assert.Equal(t, archive.Bytes(), stored)
+	})
+
+	t.Run("rejects files that are not archives", func(t *testing.T) {
+		jobs := &fakeBackupJobs{}
+		handler := httphandler.NewBackupAdminHandler(jobs, fakeBackupWorkspaces{}, t.TempDir(), 1<<20)
+
+		c, rec := newBackupRestoreContext(t, []byte("not an archive"))
+		require.NoError(t, handler.Restore(c))
+		assert.Equal(t, stdhttp.StatusBadRequest, rec.Code)
+		assert.Empty(t, jobs.jobs)
+	})
+}
+
+func TestBackupAdminHandler_Download(t *testing.T) {
+	dir := t.TempDir()
+	export := backup.NewExportJob(uuid.NewUUID(), uuid.NewUUID(), dir)
+	jobs := &fakeBackupJobs{jobs: []*backup.Job{export}}
+	handler := httphandler.NewBackupAdminHandler(jobs, fakeBackupWorkspaces{}, dir, 1<<20)
+
+	download := func(id string) *httptest.ResponseRecorder {
+		c, rec := newProjectionAdminContext(stdhttp.MethodGet, "")
+		c.SetParamNames("id")
+		c.SetParamValues(id)
+		require.NoError(t, handler.Download(c))
+		return rec
+	}
+
+	assert.Equal(t, stdhttp.StatusConflict, download(export.ID).Code)
+	assert.Equal(t, stdhttp.StatusNotFound, download(uuid.NewUUID().String()).Code)
+
+	export.Status = backup.JobStatusCompleted
+	require.NoError(t, os.WriteFile(export.ArchivePath, []byte("archive"), 0o600))
+	rec := download(export.ID)
+	assert.Equal(t, stdhttp.StatusOK, rec.Code)
+	assert.Equal(t, "archive", rec.Body.String())
+	assert.True(t, strings.HasPrefix(rec.Header().Get("Content-Disposition"), "attachment;"))
+}
@@ -6,14 +6,19 @@ import (
 	"fmt"
 	"log/slog"
 	"net/http"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
 
 	"github.com/labstack/echo/v4"
+	"github.com/lllypuk/flowra/internal/application/appcore"
 	taskapp "github.com/lllypuk/flowra/internal/application/task"
+	"github.com/lllypuk/flowra/internal/domain/errs"
 	"github.com/lllypuk/flowra/internal/domain/task"
 	"github.com/lllypuk/flowra/internal/domain/uuid"
+	"github.com/lllypuk/flowra/internal/domain/workspace"
+	"github.com/lllypuk/flowra/internal/middleware"
 )
 
 // Board template handler constants.
@@ -53,6 +58,20 @@ type BoardMemberService interface {
 	ListWorkspaceMembers(ctx context.Context, workspaceID uuid.UUID, offset, limit int) ([]MemberViewData, error)
 }
 
+// BoardWorkspaceLookup defines the interface for loading the workspace shown in the board header.
+// Declared on the consumer side per project guidelines.
+type BoardWorkspaceLookup interface {
+	// GetWorkspace gets a workspace by ID.
+	GetWorkspace(ctx context.Context, id uuid.UUID) (*workspace.Workspace, error)
+}
+
+// BoardStarLookup defines the interface for loading the chats and tasks starred by a user.
+// Declared on the consumer side per project guidelines.
+type BoardStarLookup interface {
+	// FindStarred returns the IDs of the chats the user starred in the workspace.
+	FindStarred(ctx context.Context, userID, workspaceID uuid.UUID) ([]uuid.UUID, error)
+}
+
 // BoardChatCreator defines the interface for chat creation operations.
 // Declared on the consumer side per project guidelines.
 type BoardChatCreator interface {
@@ -67,6 +86,12 @@ type BoardChatCreator interface {
 	) (uuid.UUID, error)
 }
 
+// BoardStatusChanger changes a task's status through the chat action pipeline.
+// Declared on the consumer side per project guidelines.
+type BoardStatusChanger interface {
+	ChangeStatus(ctx context.Context, chatID uuid.UUID, newStatus string, actorID uuid.UUID) (*appcore.ActionResult, error)
+}
+
 // BoardViewData represents the data needed to render the board page.
 type BoardViewData struct {
 	Workspace  WorkspaceViewData
@@ -89,6 +114,7 @@ type BoardFilters struct {
 	Assignee string
 	Priority string
 	Search   string
+	Starred  bool
 }
 
 // ColumnViewData represents a single column in the board.
@@ -100,6 +126,7 @@ type ColumnViewData struct {
 	TotalCount  int
 	HasMore     bool
 	WorkspaceID string
+	SwapOOB     bool // render with hx-swap-oob so HTMX replaces the column in place
 }
 
 // TaskCardViewData represents a task card for display.
@@ -115,6 +142,14 @@ type TaskCardViewData struct {
 	DueDate     *time.Time
 	CreatedAt   time.Time
 	IsOverdue   bool
+
+	// Checklist progress badge; hidden when the task has no checklist.
+	ChecklistTotal   int
+	ChecklistDone    int
+	ChecklistPercent int
+
+	// SLA countdown of a bug; nil when no SLA clock is running.
+	SLA *SLAViewData
 }
 
 // TaskAssigneeData represents assignee information for a task card.
@@ -149,6 +184,9 @@ type BoardTemplateHandler struct {
 	taskService   BoardTaskService
 	memberService BoardMemberService
 	chatCreator   BoardChatCreator
+	statusChanger BoardStatusChanger
+	workspaces    BoardWorkspaceLookup
+	stars         BoardStarLookup
 }
 
 // NewBoardTemplateHandler creates a new board template handler.
@@ -174,6 +212,21 @@ func (h *BoardTemplateHandler) SetChatCreator(cc BoardChatCreator) {
 	h.chatCreator = cc
 }
 
+// SetStatusChanger sets the service used to move cards between columns.
+func (h *BoardTemplateHandler) SetStatusChanger(sc BoardStatusChanger) {
+	h.statusChanger = sc
+}
+
+// SetWorkspaceLookup sets the service used to brand the board header.
+func (h *BoardTemplateHandler) SetWorkspaceLookup(wl BoardWorkspaceLookup) {
+	h.workspaces = wl
+}
+
+// SetStarLookup sets the service used by the "starred" filter.
+func (h *BoardTemplateHandler) SetStarLookup(sl BoardStarLookup) {
+	h.stars = sl
+}
+
 // SetupBoardRoutes registers board-related page and partial routes.
 func (h *BoardTemplateHandler) SetupBoardRoutes(e *echo.Echo) {
 	// Board pages (protected)
@@ -184,6 +237,7 @@ func (h *BoardTemplateHandler) SetupBoardRoutes(e *echo.Echo) {
 	partials := e.Group("/partials", RequireAuth)
 	partials.GET("/workspace/:workspace_id/board", h.BoardPartial)
 	partials.GET("/workspace/:workspace_id/board/:status/more", h.BoardColumnMore)
+	partials.POST("/workspace/:workspace_id/board/move", h.BoardMoveCard)
 	partials.GET("/tasks/:task_id/card", h.TaskCardPartial)
 
 	// Task creation (protected)
@@ -240,7 +294,7 @@ func (h *BoardTemplateHandler) BoardIndex(c echo.Context) error {
 	// Count total tasks
 	var totalTasks int
 	if h.taskService != nil {
-		taskFilters := h.buildTaskFilters(workspaceID, filters, user.ID)
+		taskFilters := h.buildTaskFilters(c.Request().Context(), workspaceID, filters, user.ID)
 		var countErr error
 		totalTasks, countErr = h.taskService.CountTasks(c.Request().Context(), taskFilters)
 		if countErr != nil {
@@ -256,9 +310,7 @@ func (h *BoardTemplateHandler) BoardIndex(c echo.Context) error {
 	}
 
 	data := BoardViewData{
-		Workspace: WorkspaceViewData{
-			ID: workspaceID.String(),
-		},
+		Workspace:  h.boardWorkspace(c.Request().Context(), workspaceID),
 		TotalTasks: totalTasks,
 		Filters:    filters,
 		Members:    members,
@@ -282,6 +334,45 @@ func (h *BoardTemplateHandler) BoardIndex(c echo.Context) error {
 	return err
 }
 
+// boardWorkspace builds the header data; branding is best-effort and falls back to the bare ID.
+func (h *BoardTemplateHandler) boardWorkspace(ctx context.Context, workspaceID uuid.UUID) WorkspaceViewData {
+	data := WorkspaceViewData{ID: workspaceID.String()}
+	if h.workspaces == nil {
+		return data
+	}
+
+	ws, err := h.workspaces.GetWorkspace(ctx, workspaceID)
+	if err != nil {
+		h.logger.Warn("BoardIndex: failed to load workspace branding",
+			"workspace_id", workspaceID.String(),
+			"error", err,
+		)
+		return data
+	}
+
+	data.Name = ws.Name()
+	data.AvatarURL = WorkspaceAvatarURL(ws)
+	data.AccentColor = ws.Branding().AccentColor()
+	return data
+}
+
+// slaPolicy loads the SLA policy for bug countdowns; without it cards show none.
+func (h *BoardTemplateHandler) slaPolicy(ctx context.Context, workspaceID uuid.UUID) workspace.SLAPolicy {
+	if h.workspaces == nil || workspaceID.IsZero() {
+		return workspace.SLAPolicy{}
+	}
+
+	ws, err := h.workspaces.GetWorkspace(ctx, workspaceID)
+	if err != nil {
+		h.logger.Warn("failed to load workspace SLA policy",
+			"workspace_id", workspaceID.String(),
+			"error", err,
+		)
+		return workspace.SLAPolicy{}
+	}
+	return ws.SLAPolicy()
+}
+
 // BoardPartial returns all columns with tasks as HTML partial for HTMX.
 func (h *BoardTemplateHandler) BoardPartial(c echo.Context) error {
 	user := getUserView(c)
@@ -334,7 +425,7 @@ func (h *BoardTemplateHandler) BoardColumnMore(c echo.Context) error {
 	filters := h.parseFilters(c)
 
 	// Build task filters for this column
-	taskFilters := h.buildTaskFilters(workspaceID, filters, user.ID)
+	taskFilters := h.buildTaskFilters(c.Request().Context(), workspaceID, filters, user.ID)
 	taskFilters.Status = status
 	taskFilters.Offset = offset
 	taskFilters.Limit = defaultBoardColumnLimit
@@ -348,7 +439,9 @@ func (h *BoardTemplateHandler) BoardColumnMore(c echo.Context) error {
 	}
 
 	// Convert to view data
-	taskCards := h.convertTasksToCards(tasks, workspaceID.String())
+	taskCards := h.convertTasksToCards(
+		tasks, workspaceID.String(), h.slaPolicy(c.Request().Context(), workspaceID),
+	)
 
 	data := map[string]any{
 		"Tasks":       taskCards,
@@ -362,6 +455,146 @@ func (h *BoardTemplateHandler) BoardColumnMore(c echo.Context) error {
 	return h.renderPartial(c, "board/column_more", data)
 }
 
+// BoardMoveCard moves a card to another column and returns the affected columns
+// as out-of-band fragments, so drag-and-drop needs no full board swap.
+// Form fields: task_id, status (column key) and optional position (0-based index
+// in the target column). The board has no persisted manual order, so position
+// only places the card in the returned fragment; a full reload uses the default order.
+func (h *BoardTemplateHandler) BoardMoveCard(c echo.Context) error {
+	user := getUserView(c)
+	if user == nil {
+		return c.String(http.StatusUnauthorized, "Unauthorized")
+	}
+
+	workspaceID, err := uuid.ParseUUID(c.Param("workspace_id"))
+	if err != nil {
+		return c.String(http.StatusBadRequest, "Invalid workspace ID")
+	}
+
+	taskID, err := uuid.ParseUUID(c.FormValue("task_id"))
+	if err != nil {
+		return c.String(http.StatusBadRequest, "Invalid task ID")
+	}
+
+	target, ok := boardColumnByKey(c.FormValue("status"))
+	if !ok {
+		return c.String(http.StatusBadRequest, "Invalid status")
+	}
+
+	position := -1
+	if raw := c.FormValue("position"); raw != "" {
+		if position, err = strconv.Atoi(raw); err != nil || position < 0 {
+			return c.String(http.StatusBadRequest, "Invalid position")
+		}
+	}
+
+	if h.taskService == nil || h.statusChanger == nil {
+		return c.String(http.StatusServiceUnavailable, "Service unavailable")
+	}
+
+	ctx := c.Request().Context()
+	taskModel, err := h.taskService.GetTask(ctx, taskID)
+	if err != nil {
+		return c.String(http.StatusNotFound, "Task not found")
+	}
+
+	source, hasSource := boardColumnByStatus(taskModel.Status)
+	if taskModel.Status != target.Status {
+		userID, _ := uuid.ParseUUID(user.ID)
+		if _, changeErr := h.statusChanger.ChangeStatus(
+			ctx, taskModel.ChatID, string(target.Status), userID,
+		); changeErr != nil {
+			h.logger.Error("failed to move task card",
+				slog.String("task_id", taskID.String()),
+				slog.String("status", string(target.Status)),
+				slog.String("error", changeErr.Error()),
+			)
+			if errors.Is(changeErr, errs.ErrForbidden) {
+				return c.String(http.StatusForbidden, "Not allowed to change task status")
+			}
+			return c.String(http.StatusUnprocessableEntity, "Failed to move task")
+		}
+	}
+
+	// The read model may lag behind the status change, so the moved card is placed
+	// explicitly and counts are adjusted for whether the projection already has it.
+	projected := taskModel.Status == target.Status
+	if current, getErr := h.taskService.GetTask(ctx, taskID); getErr == nil && current.Status == target.Status {
+		projected = true
+	}
+
+	filters := h.parseFilters(c)
+	policy := h.slaPolicy(ctx, workspaceID)
+	card := h.convertTaskToCard(taskModel, workspaceID.String(), policy)
+	card.Status = string(target.Status)
+
+	var columns []ColumnViewData
+	if hasSource && source.Key != target.Key {
+		column := h.buildColumn(ctx, workspaceID, filters, user.ID, source, policy)
+		removeCard(&column, card.ID)
+		if !projected {
+			column.TotalCount--
+		}
+		column.HasMore = column.Count < column.TotalCount
+		columns = append(columns, column)
+	}
+	column := h.buildColumn(ctx, workspaceID, filters, user.ID, target, policy)
+	removeCard(&column, card.ID)
+	if !projected {
+		column.TotalCount++
+	}
+	insertCard(&column, card, position)
+	columns = append(columns, column)
+
+	for i := range columns {
+		columns[i].SwapOOB = true
+	}
+
+	return h.renderPartial(c, "board/columns", map[string]any{"Columns": columns})
+}
+
+// boardColumnByKey returns the board column for a status key.
+func boardColumnByKey(key string) (BoardColumnStatus, bool) {
+	for _, col := range GetBoardColumns() {
+		if col.Key == key {
+			return col, true
+		}
+	}
+	return BoardColumnStatus{}, false
+}
+
+// boardColumnByStatus returns the board column showing status, if any.
+func boardColumnByStatus(status task.Status) (BoardColumnStatus, bool) {
+	for _, col := range GetBoardColumns() {
+		if col.Status == status {
+			return col, true
+		}
+	}
+	return BoardColumnStatus{}, false
+}
+
+// removeCard drops the card with cardID from the listed tasks of a column.
+func removeCard(column *ColumnViewData, cardID string) {
+	for i, existing := range column.Tasks {
+		if existing.ID == cardID {
+			column.Tasks = append(column.Tasks[:i], column.Tasks[i+1:]...)
+			break
+		}
+	}
+	column.Count = len(column.Tasks)
+}
+
+// insertCard inserts card at position in the listed tasks of a column,
+// appending when position is negative or past the end.
+func insertCard(column *ColumnViewData, card TaskCardViewData, position int) {
+	if position < 0 || position > len(column.Tasks) {
+		position = len(column.Tasks)
+	}
+	column.Tasks = slices.Insert(column.Tasks, position, card)
+	column.Count = len(column.Tasks)
+	column.HasMore = column.Count < column.TotalCount
+}
+
 // TaskCardPartial returns a single task card as HTML partial.
 func (h *BoardTemplateHandler) TaskCardPartial(c echo.Context) error {
 	user := getUserView(c)
@@ -383,11 +616,15 @@ func (h *BoardTemplateHandler) TaskCardPartial(c echo.Context) error {
 		return c.String(http.StatusNotFound, "Task not found")
 	}
 
-	// We need workspace ID - get it from the task's chat
-	// For now, use an empty string as we'd need to look this up
-	workspaceID := "" // TODO: Get workspace ID from task or chat
+	// Read models projected before the workspace was recorded have none
+	workspaceID := ""
+	if !taskModel.WorkspaceID.IsZero() {
+		workspaceID = taskModel.WorkspaceID.String()
+	}
 
-	card := h.convertTaskToCard(taskModel, workspaceID)
+	card := h.convertTaskToCard(
+		taskModel, workspaceID, h.slaPolicy(c.Request().Context(), taskModel.WorkspaceID),
+	)
 
 	return h.renderPartial(c, "components/task_card", card)
 }
@@ -399,42 +636,54 @@ func (h *BoardTemplateHandler) buildColumns(
 	filters BoardFilters,
 	userID string,
 ) []ColumnViewData {
+	policy := h.slaPolicy(ctx, workspaceID)
 	columns := make([]ColumnViewData, 0, boardColumnsCount)
-	boardColumns := GetBoardColumns()
-
-	for _, col := range boardColumns {
-		// Build filters for this column
-		taskFilters := h.buildTaskFilters(workspaceID, filters, userID)
-		taskFilters.Status = &col.Status
-		taskFilters.Offset = 0
-		taskFilters.Limit = defaultBoardColumnLimit
+	for _, col := range GetBoardColumns() {
+		columns = append(columns, h.buildColumn(ctx, workspaceID, filters, userID, col, policy))
+	}
 
-		var tasks []*taskapp.ReadModel
-		var totalCount int
+	return columns
+}
 
-		if h.taskService != nil {
-			tasks, _ = h.taskService.ListTasks(ctx, taskFilters)
-			totalCount, _ = h.taskService.CountTasks(ctx, taskFilters)
-		}
+// buildColumn builds the first page of a single board column.
+func (h *BoardTemplateHandler) buildColumn(
+	ctx context.Context,
+	workspaceID uuid.UUID,
+	filters BoardFilters,
+	userID string,
+	col BoardColumnStatus,
+	policy workspace.SLAPolicy,
+) ColumnViewData {
+	// Build filters for this column
+	taskFilters := h.buildTaskFilters(ctx, workspaceID, filters, userID)
+	taskFilters.Status = &col.Status
+	taskFilters.Offset = 0
+	taskFilters.Limit = defaultBoardColumnLimit
 
-		taskCards := h.convertTasksToCards(tasks, workspaceID.String())
+	var tasks []*taskapp.ReadModel
+	var totalCount int
 
-		columns = append(columns, ColumnViewData{
-			Status:      col.Key,
-			Title:       col.Title,
-			Tasks:       taskCards,
-			Count:       len(taskCards),
-			TotalCount:  totalCount,
-			HasMore:     len(taskCards) < totalCount,
-			WorkspaceID: workspaceID.String(),
-		})
+	if h.taskService != nil {
+		tasks, _ = h.taskService.ListTasks(ctx, taskFilters)
+		totalCount, _ = h.taskService.CountTasks(ctx, taskFilters)
 	}
 
-	return columns
+	taskCards := h.convertTasksToCards(tasks, workspaceID.String(), policy)
+
+	return ColumnViewData{
+		Status:      col.Key,
+		Title:       col.Title,
+		Tasks:       taskCards,
+		Count:       len(taskCards),
+		TotalCount:  totalCount,
+		HasMore:     len(taskCards) < totalCount,
+		WorkspaceID: workspaceID.String(),
+	}
 }
 
 // buildTaskFilters builds task filters from board filters.
 func (h *BoardTemplateHandler) buildTaskFilters(
+	ctx context.Context,
 	workspaceID uuid.UUID,
 	filters BoardFilters,
 	userID string,
@@ -479,6 +728,22 @@ func (h *BoardTemplateHandler) buildTaskFilters(
 		taskFilters.Search = filters.Search
 	}
 
+	// Filter by stars; a task ID equals the ID of its chat, which is what gets starred
+	if filters.Starred {
+		taskFilters.IDs = []uuid.UUID{}
+		uid, err := uuid.ParseUUID(userID)
+		if err == nil && h.stars != nil {
+			starred, starErr := h.stars.FindStarred(ctx, uid, workspaceID)
+			if starErr != nil {
+				h.logger.Error("failed to load starred tasks",
+					"workspace_id", workspaceID.String(),
+					"error", starErr,
+				)
+			}
+			taskFilters.IDs = append(taskFilters.IDs, starred...)
+		}
+	}
+
 	return taskFilters
 }
 
@@ -486,10 +751,11 @@ func (h *BoardTemplateHandler) buildTaskFilters(
 func (h *BoardTemplateHandler) convertTasksToCards(
 	tasks []*taskapp.ReadModel,
 	workspaceID string,
+	policy workspace.SLAPolicy,
 ) []TaskCardViewData {
 	cards := make([]TaskCardViewData, 0, len(tasks))
 	for _, t := range tasks {
-		cards = append(cards, h.convertTaskToCard(t, workspaceID))
+		cards = append(cards, h.convertTaskToCard(t, workspaceID, policy))
 	}
 	return cards
 }
@@ -498,6 +764,7 @@ func (h *BoardTemplateHandler) convertTasksToCards(
 func (h *BoardTemplateHandler) convertTaskToCard(
 	t *taskapp.ReadModel,
 	workspaceID string,
+	policy workspace.SLAPolicy,
 ) TaskCardViewData {
 	card := TaskCardViewData{
 		ID:          t.ID.String(),
@@ -509,6 +776,10 @@ func (h *BoardTemplateHandler) convertTaskToCard(
 		Status:      string(t.Status),
 		DueDate:     t.DueDate,
 		CreatedAt:   t.CreatedAt,
+
+		ChecklistTotal:   t.ChecklistTotal,
+		ChecklistDone:    t.ChecklistDone,
+		ChecklistPercent: t.ChecklistPercent,
 	}
 
 	// Check if overdue
@@ -516,11 +787,20 @@ func (h *BoardTemplateHandler) convertTaskToCard(
 		card.IsOverdue = t.DueDate.Before(time.Now())
 	}
 
-	// TODO: Load assignee details from user service
+	card.SLA = buildSLAView(policy, t, time.Now())
+
+	// Assignee names are denormalized onto the read model by the task projection
 	if t.AssignedTo != nil {
 		card.Assignee = &TaskAssigneeData{
-			ID:       t.AssignedTo.String(),
-			Username: "user", // TODO: Load from user service
+			ID:          t.AssignedTo.String(),
+			Username:    t.AssigneeUsername,
+			DisplayName: t.AssigneeDisplayName,
+		}
+		if card.Assignee.Username == "" {
+			card.Assignee.Username = "user" + t.AssignedTo.String()[:8]
+		}
+		if card.Assignee.DisplayName == "" {
+			card.Assignee.DisplayName = card.Assignee.Username
 		}
 	}
 
@@ -535,6 +815,7 @@ func (h *BoardTemplateHandler) parseFilters(c echo.Context) BoardFilters {
 	filterAssignee := strings.TrimSpace(c.FormValue("filter_assignee"))
 	filterPriority := strings.TrimSpace(c.FormValue("filter_priority"))
 	filterSearch := strings.TrimSpace(c.FormValue("filter_search"))
+	filterStarred := strings.TrimSpace(c.FormValue("filter_starred"))
 
 	// Fall back to query params (for GET requests)
 	if filterType == "" {
@@ -549,12 +830,16 @@ func (h *BoardTemplateHandler) parseFilters(c echo.Context) BoardFilters {
 	if filterSearch == "" {
 		filterSearch = strings.TrimSpace(c.QueryParam("search"))
 	}
+	if filterStarred == "" {
+		filterStarred = strings.TrimSpace(c.QueryParam("starred"))
+	}
 
 	return BoardFilters{
 		Type:     filterType,
 		Assignee: filterAssignee,
 		Priority: filterPriority,
 		Search:   filterSearch,
+		Starred:  filterStarred == "true",
 	}
 }
 
@@ -762,7 +1047,7 @@ func (h *BoardTemplateHandler) parseTaskCreateForm(c echo.Context) (*taskCreateF
 		taskType:    taskType,
 		priority:    parsePriorityOrDefault(c.FormValue("priority")),
 		assigneeID:  parseOptionalUUID(c.FormValue("assignee_id")),
-		dueDate:     parseOptionalDate(c.FormValue("due_date")),
+		dueDate:     parseOptionalDate(c.FormValue("due_date"), middleware.GetLocation(c)),
 	}, nil
 }
 
@@ -785,12 +1070,12 @@ func parseOptionalUUID(raw string) *uuid.UUID {
 	return &value
 }
 
-func parseOptionalDate(raw string) *time.Time {
+func parseOptionalDate(raw string, loc *time.Location) *time.Time {
 	raw = strings.TrimSpace(raw)
 	if raw == "" {
 		return nil
 	}
-	dueDate, err := time.Parse("2006-01-02", raw)
+	dueDate, err := time.ParseInLocation("2006-01-02", raw, loc)
 	if err != nil {
 		return nil
 	}
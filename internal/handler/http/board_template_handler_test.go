@@ -2,8 +2,11 @@ package httphandler_test
 
 import (
 	"context"
+	"errors"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
+	"strings"
 	"testing"
 	"time"
 
@@ -11,6 +14,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/lllypuk/flowra/internal/application/appcore"
 	taskapp "github.com/lllypuk/flowra/internal/application/task"
 	"github.com/lllypuk/flowra/internal/domain/task"
 	"github.com/lllypuk/flowra/internal/domain/uuid"
@@ -550,6 +554,32 @@ func TestBoardTemplateHandler_TaskCardPartial(t *testing.T) {
 		err := handler.TaskCardPartial(c)
 		require.Error(t, err)
 	})
+
+	t.Run("renders assignee name from the read model", func(t *testing.T) {
+		e := echo.New()
+		userID := uuid.NewUUID()
+		assigneeID := uuid.NewUUID()
+
+		mockTaskService := NewMockBoardTaskService()
+		testTask := makeTestTaskReadModel(uuid.NewUUID(), "Named Task", task.StatusToDo, task.PriorityHigh, task.TypeTask)
+		testTask.AssignedTo = &assigneeID
+		testTask.AssigneeUsername = "alice"
+		testTask.AssigneeDisplayName = "Alice Smith"
+		mockTaskService.AddTask(testTask)
+
+		handler := httphandler.NewBoardTemplateHandler(newTestRenderer(t), nil, mockTaskService, NewMockBoardMemberService())
+
+		req := httptest.NewRequest(http.MethodGet, "/partials/tasks/"+testTask.ID.String()+"/card", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("task_id")
+		c.SetParamValues(testTask.ID.String())
+		setUserContextForBoard(c, userID)
+
+		require.NoError(t, handler.TaskCardPartial(c))
+		assert.Contains(t, rec.Body.String(), `title="Alice Smith"`)
+		assert.Contains(t, rec.Body.String(), "alice")
+	})
 }
 
 func TestBoardTemplateHandler_NilTaskService(t *testing.T) {
@@ -675,6 +705,66 @@ func TestBoardTemplateHandler_PropagatesWorkspaceFilter(t *testing.T) {
 	}
 }
 
+type stubBoardStars struct {
+	ids []uuid.UUID
+}
+
+func (s stubBoardStars) FindStarred(context.Context, uuid.UUID, uuid.UUID) ([]uuid.UUID, error) {
+	return s.ids, nil
+}
+
+func TestBoardTemplateHandler_StarredFilter(t *testing.T) {
+	starredID := uuid.NewUUID()
+
+	run := func(t *testing.T, stars httphandler.BoardStarLookup, query string) []taskapp.Filters {
+		t.Helper()
+		workspaceID := uuid.NewUUID()
+		recordingTaskService := &RecordingBoardTaskService{}
+		handler := httphandler.NewBoardTemplateHandler(nil, nil, recordingTaskService, NewMockBoardMemberService())
+		if stars != nil {
+			handler.SetStarLookup(stars)
+		}
+
+		req := httptest.NewRequest(http.MethodGet, "/partials/workspace/"+workspaceID.String()+"/board"+query, nil)
+		c := echo.New().NewContext(req, httptest.NewRecorder())
+		c.SetParamNames("workspace_id")
+		c.SetParamValues(workspaceID.String())
+		setUserContextForBoard(c, uuid.NewUUID())
+
+		// renderer is nil, but the task filters are built before rendering
+		require.Error(t, handler.BoardPartial(c))
+		return recordingTaskService.listFilters
+	}
+
+	t.Run("limits the board to starred tasks", func(t *testing.T) {
+		filters := run(t, stubBoardStars{ids: []uuid.UUID{starredID}}, "?starred=true")
+
+		require.NotEmpty(t, filters)
+		for _, filter := range filters {
+			assert.Equal(t, []uuid.UUID{starredID}, filter.IDs)
+		}
+	})
+
+	t.Run("shows nothing without stars", func(t *testing.T) {
+		filters := run(t, nil, "?starred=true")
+
+		require.NotEmpty(t, filters)
+		for _, filter := range filters {
+			assert.NotNil(t, filter.IDs)
+			assert.Empty(t, filter.IDs)
+		}
+	})
+
+	t.Run("ignores stars when the filter is off", func(t *testing.T) {
+		filters := run(t, stubBoardStars{ids: []uuid.UUID{starredID}}, "")
+
+		require.NotEmpty(t, filters)
+		for _, filter := range filters {
+			assert.Nil(t, filter.IDs)
+		}
+	})
+}
+
 func TestBoardTasksWithAssignee(t *testing.T) {
 	t.Run("tasks with assignee filter me", func(t *testing.T) {
 		e := echo.New()
@@ -851,3 +941,125 @@ func TestMockBoardMemberService(t *testing.T) {
 		assert.Empty(t, result)
 	})
 }
+
+// stubBoardStatusChanger records status changes and optionally applies them to the task read model.
+type stubBoardStatusChanger struct {
+	tasks   *MockBoardTaskService
+	apply   bool
+	err     error
+	chatID  uuid.UUID
+	status  string
+	changes int
+}
+
+func (s *stubBoardStatusChanger) ChangeStatus(
+	_ context.Context,
+	chatID uuid.UUID,
+	newStatus string,
+	_ uuid.UUID,
+) (*appcore.ActionResult, error) {
+	s.changes++
+	s.chatID = chatID
+	s.status = newStatus
+	if s.err != nil {
+		return nil, s.err
+	}
+	if s.apply {
+		for _, t := range s.tasks.tasks {
+			if t.ChatID == chatID {
+				t.Status = task.Status(newStatus)
+			}
+		}
+	}
+	return &appcore.ActionResult{}, nil
+}
+
+func TestBoardTemplateHandler_BoardMoveCard(t *testing.T) {
+	workspaceID := uuid.NewUUID()
+
+	setup := func(t *testing.T, apply bool) (*httphandler.BoardTemplateHandler, *stubBoardStatusChanger, *taskapp.ReadModel) {
+		t.Helper()
+		tasks := NewMockBoardTaskService()
+		moved := makeTestTaskReadModel(uuid.NewUUID(), "Moved", task.StatusToDo, task.PriorityHigh, task.TypeTask)
+		tasks.AddTask(moved)
+		tasks.AddTask(makeTestTaskReadModel(uuid.NewUUID(), "Other", task.StatusInProgress, task.PriorityLow, task.TypeTask))
+
+		changer := &stubBoardStatusChanger{tasks: tasks, apply: apply}
+		handler := httphandler.NewBoardTemplateHandler(newTestRenderer(t), nil, tasks, NewMockBoardMemberService())
+		handler.SetStatusChanger(changer)
+		return handler, changer, moved
+	}
+
+	move := func(handler *httphandler.BoardTemplateHandler, form url.Values, authenticated bool) *httptest.ResponseRecorder {
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(form.Encode()))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationForm)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("workspace_id")
+		c.SetParamValues(workspaceID.String())
+		if authenticated {
+			setUserContextForBoard(c, uuid.NewUUID())
+		}
+		_ = handler.BoardMoveCard(c)
+		return rec
+	}
+
+	t.Run("unauthorized returns 401", func(t *testing.T) {
+		handler, _, moved := setup(t, true)
+		rec := move(handler, url.Values{"task_id": {moved.ID.String()}, "status": {"done"}}, false)
+		assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	})
+
+	t.Run("invalid status returns 400", func(t *testing.T) {
+		handler, changer, moved := setup(t, true)
+		rec := move(handler, url.Values{"task_id": {moved.ID.String()}, "status": {"archived"}}, true)
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		assert.Zero(t, changer.changes)
+	})
+
+	t.Run("returns source and target columns out of band", func(t *testing.T) {
+		handler, changer, moved := setup(t, true)
+		rec := move(handler, url.Values{
+			"task_id":  {moved.ID.String()},
+			"status":   {"in_progress"},
+			"position": {"0"},
+		}, true)
+
+		require.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, moved.ChatID, changer.chatID)
+		assert.Equal(t, string(task.StatusInProgress), changer.status)
+
+		body := rec.Body.String()
+		assert.Equal(t, 2, strings.Count(body, `hx-swap-oob="true"`))
+		source := strings.Index(body, `id="column-todo"`)
+		target := strings.Index(body, `id="column-in_progress"`)
+		require.GreaterOrEqual(t, source, 0)
+		require.Greater(t, target, source)
+		assert.NotContains(t, body[source:target], moved.ID.String())
+
+		targetHTML := body[target:]
+		movedAt := strings.Index(targetHTML, `id="task-`+moved.ID.String()+`"`)
+		require.Positive(t, movedAt)
+		assert.Less(t, movedAt, strings.Index(targetHTML, "Other"), "card should be placed at the requested position")
+	})
+
+	t.Run("places card when the read model lags", func(t *testing.T) {
+		handler, _, moved := setup(t, false)
+		rec := move(handler, url.Values{"task_id": {moved.ID.String()}, "status": {"done"}}, true)
+
+		require.Equal(t, http.StatusOK, rec.Code)
+		body := rec.Body.String()
+		target := strings.Index(body, `id="column-done"`)
+		require.GreaterOrEqual(t, target, 0)
+		assert.Contains(t, body[target:], moved.ID.String())
+		assert.NotContains(t, body[:target], `id="task-`+moved.ID.String()+`"`)
+	})
+
+	t.Run("status change failure returns 422", func(t *testing.T) {
+		handler, changer, moved := setup(t, false)
+		changer.err = errors.New("pipeline unavailable")
+		rec := move(handler, url.Values{"task_id": {moved.ID.String()}, "status": {"done"}}, true)
+		assert.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+	})
+}
@@ -0,0 +1,253 @@
+package httphandler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/labstack/echo/v4"
+	"github.com/lllypuk/flowra/internal/application/appcore"
+	calendarapp "github.com/lllypuk/flowra/internal/application/calendar"
+	"github.com/lllypuk/flowra/internal/infrastructure/httpserver"
+	"github.com/lllypuk/flowra/internal/middleware"
+)
+
+const (
+	// calendarFeedPath is the public path of a feed under the API prefix
+	calendarFeedPath = "/api/v1/calendar/"
+
+	// calendarFeedSuffix is accepted after the token since calendar apps expect it
+	calendarFeedSuffix = ".ics"
+
+	// icalLineLimit is the maximum length of a content line in octets (RFC 5545 3.1)
+	icalLineLimit = 75
+
+	// calendarRefreshInterval is the polling interval suggested to subscribed calendars
+	calendarRefreshInterval = "PT1H"
+)
+
+// CalendarTokenIssuer creates or rotates the calendar feed token of a user.
+// Declared on the consumer side per project guidelines.
+type CalendarTokenIssuer interface {
+	Execute(ctx context.Context, cmd calendarapp.IssueTokenCommand) (*calendarapp.IssuedToken, error)
+}
+
+// CalendarTokenRevoker disables the calendar feed of a user.
+// Declared on the consumer side per project guidelines.
+type CalendarTokenRevoker interface {
+	Execute(ctx context.Context, cmd calendarapp.RevokeTokenCommand) error
+}
+
+// CalendarTokenReader reports whether a user has an active calendar feed.
+// Declared on the consumer side per project guidelines.
+type CalendarTokenReader interface {
+	Execute(ctx context.Context, query calendarapp.GetTokenQuery) (*calendarapp.FeedToken, error)
+}
+
+// CalendarFeedBuilder builds the calendar feed addressed by a token.
+// Declared on the consumer side per project guidelines.
+type CalendarFeedBuilder interface {
+	Execute(ctx context.Context, query calendarapp.FeedQuery) (*calendarapp.Feed, error)
+}
+
+// CalendarUseCases groups the calendar feed use cases used by the handler.
+type CalendarUseCases struct {
+	Issue  CalendarTokenIssuer
+	Revoke CalendarTokenRevoker
+	Get    CalendarTokenReader
+	Feed   CalendarFeedBuilder
+}
+
+// CalendarFeedResponse describes the calendar feed of the current user.
+// URL is only returned when the token is issued; afterwards just the hash is kept.
+type CalendarFeedResponse struct {
+	Active    bool       `json:"active"`
+	URL       string     `json:"url,omitempty"`
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+}
+
+// CalendarHandler handles the iCal feed and the management of feed tokens.
+type CalendarHandler struct {
+	useCases CalendarUseCases
+}
+
+// NewCalendarHandler creates a new CalendarHandler.
+func NewCalendarHandler(useCases CalendarUseCases) *CalendarHandler {
+	return &CalendarHandler{useCases: useCases}
+}
+
+// RegisterRoutes registers calendar routes with the router.
+// The feed itself is public: calendar apps authenticate with the token in the URL.
+func (h *CalendarHandler) RegisterRoutes(r *httpserver.Router) {
+	r.Auth().GET("/users/me/calendar-feed", h.GetFeedToken)
+	r.Auth().POST("/users/me/calendar-feed", h.RotateFeedToken)
+	r.Auth().DELETE("/users/me/calendar-feed", h.RevokeFeedToken)
+	r.Public().GET("/calendar/:token", h.Feed)
+}
+
+// GetFeedToken handles GET /api/v1/users/me/calendar-feed.
+func (h *CalendarHandler) GetFeedToken(c echo.Context) error {
+	userID := middleware.GetUserID(c)
+	if userID.IsZero() {
+		return httpserver.RespondErrorWithCode(c, http.StatusUnauthorized, "UNAUTHORIZED", "authentication required")
+	}
+
+	token, err := h.useCases.Get.Execute(c.Request().Context(), calendarapp.GetTokenQuery{UserID: userID})
+	if errors.Is(err, calendarapp.ErrFeedNotFound) {
+		return httpserver.RespondOK(c, CalendarFeedResponse{})
+	}
+	if err != nil {
+		return handleCalendarError(c, err)
+	}
+
+	createdAt := token.CreatedAt
+	return httpserver.RespondOK(c, CalendarFeedResponse{Active: true, CreatedAt: &createdAt})
+}
+
+// RotateFeedToken handles POST /api/v1/users/me/calendar-feed.
+// Issues a new secret URL; calendars subscribed with the previous one stop syncing.
+func (h *CalendarHandler) RotateFeedToken(c echo.Context) error {
+	userID := middleware.GetUserID(c)
+	if userID.IsZero() {
+		return httpserver.RespondErrorWithCode(c, http.StatusUnauthorized, "UNAUTHORIZED", "authentication required")
+	}
+
+	issued, err := h.useCases.Issue.Execute(c.Request().Context(), calendarapp.IssueTokenCommand{UserID: userID})
+	if err != nil {
+		return handleCalendarError(c, err)
+	}
+
+	createdAt := issued.CreatedAt
+	return httpserver.RespondCreated(c, CalendarFeedResponse{
+		Active:    true,
+		URL:       CalendarFeedURL(c, issued.Token),
+		CreatedAt: &createdAt,
+	})
+}
+
+// RevokeFeedToken handles DELETE /api/v1/users/me/calendar-feed.
+func (h *CalendarHandler) RevokeFeedToken(c echo.Context) error {
+	userID := middleware.GetUserID(c)
+	if userID.IsZero() {
+		return httpserver.RespondErrorWithCode(c, http.StatusUnauthorized, "UNAUTHORIZED", "authentication required")
+	}
+
+	if err := h.useCases.Revoke.Execute(
+		c.Request().Context(), calendarapp.RevokeTokenCommand{UserID: userID}); err != nil {
+		return handleCalendarError(c, err)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// Feed handles GET /api/v1/calendar/:token.ics.
+// Serves the due dates and sprint boundaries of the token owner as text/calendar.
+func (h *CalendarHandler) Feed(c echo.Context) error {
+	token := strings.TrimSuffix(c.Param("token"), calendarFeedSuffix)
+
+	feed, err := h.useCases.Feed.Execute(c.Request().Context(), calendarapp.FeedQuery{Token: token})
+	if err != nil {
+		return handleCalendarError(c, err)
+	}
+
+	c.Response().Header().Set(echo.HeaderCacheControl, "private, max-age=300")
+	return c.Blob(http.StatusOK, "text/calendar; charset=utf-8",
+		[]byte(RenderICalendar(feed, requestBaseURL(c), time.Now().UTC())))
+}
+
+// CalendarFeedURL returns the subscription URL of a feed token.
+func CalendarFeedURL(c echo.Context, token string) string {
+	return requestBaseURL(c) + calendarFeedPath + token + calendarFeedSuffix
+}
+
+func requestBaseURL(c echo.Context) string {
+	return c.Scheme() + "://" + c.Request().Host
+}
+
+// handleCalendarError maps calendar use case errors to HTTP responses.
+func handleCalendarError(c echo.Context, err error) error {
+	var validationErr *appcore.ValidationError
+	switch {
+	case errors.Is(err, calendarapp.ErrFeedNotFound):
+		return httpserver.RespondErrorWithCode(
+			c, http.StatusNotFound, "CALENDAR_FEED_NOT_FOUND", "calendar feed not found")
+	case errors.As(err, &validationErr):
+		return httpserver.RespondErrorWithCode(c, http.StatusBadRequest, "VALIDATION_ERROR", validationErr.Error())
+	default:
+		return httpserver.RespondError(c, err)
+	}
+}
+
+// RenderICalendar encodes a feed as an RFC 5545 calendar of all-day events.
+// Due dates link to the task chat under baseURL.
+func RenderICalendar(feed *calendarapp.Feed, baseURL string, now time.Time) string {
+	var b strings.Builder
+	writeICalLine(&b, "BEGIN:VCALENDAR")
+	writeICalLine(&b, "VERSION:2.0")
+	writeICalLine(&b, "PRODID:-//Flowra//Calendar Feed//EN")
+	writeICalLine(&b, "CALSCALE:GREGORIAN")
+	writeICalLine(&b, "METHOD:PUBLISH")
+	writeICalLine(&b, "X-WR-CALNAME:"+escapeICalText("Flowra – "+feed.Name))
+	writeICalLine(&b, "REFRESH-INTERVAL;VALUE=DURATION:"+calendarRefreshInterval)
+	writeICalLine(&b, "X-PUBLISHED-TTL:"+calendarRefreshInterval)
+
+	stamp := now.UTC().Format("20060102T150405Z")
+	for _, event := range feed.Events {
+		writeICalLine(&b, "BEGIN:VEVENT")
+		writeICalLine(&b, "UID:"+event.UID+"@flowra")
+		writeICalLine(&b, "DTSTAMP:"+stamp)
+		writeICalLine(&b, "DTSTART;VALUE=DATE:"+event.Date.Format("20060102"))
+		writeICalLine(&b, "DTEND;VALUE=DATE:"+event.Date.AddDate(0, 0, 1).Format("20060102"))
+		writeICalLine(&b, "TRANSP:TRANSPARENT")
+		switch event.Kind {
+		case calendarapp.EventKindDue:
+			writeICalLine(&b, "SUMMARY:"+escapeICalText("Due: "+event.Summary))
+			writeICalLine(&b, "CATEGORIES:TASK")
+			if event.Sprint != "" {
+				writeICalLine(&b, "DESCRIPTION:"+escapeICalText("Sprint: "+event.Sprint))
+			}
+			writeICalLine(&b, fmt.Sprintf("URL:%s/workspaces/%s/chats/%s", baseURL, event.WorkspaceID, event.TaskID))
+		case calendarapp.EventKindSprintStart, calendarapp.EventKindSprintEnd:
+			writeICalLine(&b, "SUMMARY:"+escapeICalText(event.Summary))
+			writeICalLine(&b, "CATEGORIES:SPRINT")
+		}
+		writeICalLine(&b, "END:VEVENT")
+	}
+
+	writeICalLine(&b, "END:VCALENDAR")
+	return b.String()
+}
+
+// writeICalLine writes a content line, folding it at the octet limit without
+// splitting UTF-8 sequences.
+func writeICalLine(b *strings.Builder, line string) {
+	limit := icalLineLimit
+	for len(line) > limit {
+		cut := limit
+		for cut > 0 && !utf8.RuneStart(line[cut]) {
+			cut--
+		}
+		b.WriteString(line[:cut])
+		b.WriteString("\r\n ")
+		line = line[cut:]
+		// continuation lines start with a space that counts towards the limit
+		limit = icalLineLimit - 1
+	}
+	b.WriteString(line)
+	b.WriteString("\r\n")
+}
+
+// escapeICalText escapes a TEXT value (RFC 5545 3.3.11).
+func escapeICalText(s string) string {
+	return strings.NewReplacer(
+		`\`, `\\`,
+		";", `\;`,
+		",", `\,`,
+		"\r\n", `\n`,
+		"\n", `\n`,
+	).Replace(s)
+}
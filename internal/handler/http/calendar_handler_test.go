@@ -0,0 +1,180 @@
+package httphandler_test
+
+import (
+	"context"
+	"encoding/json"
+	stdhttp "net/http"
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+
+	calendarapp "github.com/lllypuk/flowra/internal/application/calendar"
+	"github.com/lllypuk/flowra/internal/domain/uuid"
+	httphandler "github.com/lllypuk/flowra/internal/handler/http"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type stubCalendarTokens struct {
+	token    *calendarapp.FeedToken
+	revoked  uuid.UUID
+	issuedTo uuid.UUID
+}
+
+func (s *stubCalendarTokens) Execute(
+	_ context.Context,
+	query calendarapp.GetTokenQuery,
+) (*calendarapp.FeedToken, error) {
+	if s.token == nil || s.token.UserID != query.UserID {
+		return nil, calendarapp.ErrFeedNotFound
+	}
+	return s.token, nil
+}
+
+type stubCalendarIssuer struct {
+	tokens *stubCalendarTokens
+}
+
+func (s *stubCalendarIssuer) Execute(
+	_ context.Context,
+	cmd calendarapp.IssueTokenCommand,
+) (*calendarapp.IssuedToken, error) {
+	s.tokens.issuedTo = cmd.UserID
+	return &calendarapp.IssuedToken{Token: "secret", CreatedAt: time.Now()}, nil
+}
+
+type stubCalendarRevoker struct {
+	tokens *stubCalendarTokens
+}
+
+func (s *stubCalendarRevoker) Execute(_ context.Context, cmd calendarapp.RevokeTokenCommand) error {
+	s.tokens.revoked = cmd.UserID
+	return nil
+}
+
+type stubCalendarFeed struct {
+	feed      *calendarapp.Feed
+	lastToken string
+}
+
+func (s *stubCalendarFeed) Execute(_ context.Context, query calendarapp.FeedQuery) (*calendarapp.Feed, error) {
+	s.lastToken = query.Token
+	if query.Token != "secret" {
+		return nil, calendarapp.ErrFeedNotFound
+	}
+	return s.feed, nil
+}
+
+func TestCalendarHandler_FeedToken(t *testing.T) {
+	userID := uuid.NewUUID()
+	tokens := &stubCalendarTokens{}
+	handler := httphandler.NewCalendarHandler(httphandler.CalendarUseCases{
+		Issue:  &stubCalendarIssuer{tokens: tokens},
+		Revoke: &stubCalendarRevoker{tokens: tokens},
+		Get:    tokens,
+	})
+
+	t.Run("inactive feed", func(t *testing.T) {
+		c, rec := newAnnouncementContext(stdhttp.MethodGet, "/users/me/calendar-feed", "", userID)
+		require.NoError(t, handler.GetFeedToken(c))
+		assert.Equal(t, stdhttp.StatusOK, rec.Code)
+		assert.Contains(t, rec.Body.String(), `"active":false`)
+	})
+
+	t.Run("rotate returns the subscription URL", func(t *testing.T) {
+		c, rec := newAnnouncementContext(stdhttp.MethodPost, "/users/me/calendar-feed", "", userID)
+		require.NoError(t, handler.RotateFeedToken(c))
+		assert.Equal(t, stdhttp.StatusCreated, rec.Code)
+		assert.Equal(t, userID, tokens.issuedTo)
+
+		var resp struct {
+			Data httphandler.CalendarFeedResponse `json:"data"`
+		}
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+		assert.True(t, resp.Data.Active)
+		assert.Equal(t, "http://example.com/api/v1/calendar/secret.ics", resp.Data.URL)
+	})
+
+	t.Run("active feed hides the URL", func(t *testing.T) {
+		tokens.token = &calendarapp.FeedToken{UserID: userID, TokenHash: "hash", CreatedAt: time.Now()}
+		c, rec := newAnnouncementContext(stdhttp.MethodGet, "/users/me/calendar-feed", "", userID)
+		require.NoError(t, handler.GetFeedToken(c))
+		assert.Contains(t, rec.Body.String(), `"active":true`)
+		assert.NotContains(t, rec.Body.String(), "url")
+	})
+
+	t.Run("revoke", func(t *testing.T) {
+		c, rec := newAnnouncementContext(stdhttp.MethodDelete, "/users/me/calendar-feed", "", userID)
+		require.NoError(t, handler.RevokeFeedToken(c))
+		assert.Equal(t, stdhttp.StatusNoContent, rec.Code)
+		assert.Equal(t, userID, tokens.revoked)
+	})
+
+	t.Run("unauthenticated", func(t *testing.T) {
+		c, rec := newAnnouncementContext(stdhttp.MethodPost, "/users/me/calendar-feed", "", "")
+		require.NoError(t, handler.RotateFeedToken(c))
+		assert.Equal(t, stdhttp.StatusUnauthorized, rec.Code)
+	})
+}
+
+func TestCalendarHandler_Feed(t *testing.T) {
+	workspaceID := uuid.NewUUID()
+	taskID := uuid.NewUUID()
+	feeds := &stubCalendarFeed{feed: &calendarapp.Feed{
+		Name: "Alice",
+		Events: []calendarapp.Event{
+			{
+				UID: "task-1-due", Kind: calendarapp.EventKindDue, Summary: "Fix login, then deploy",
+				Date: time.Date(2026, time.May, 12, 0, 0, 0, 0, time.UTC), WorkspaceID: workspaceID, TaskID: taskID,
+			},
+			{
+				UID: "sprint-start", Kind: calendarapp.EventKindSprintStart, Summary: "Sprint 4 starts",
+				Date: time.Date(2026, time.May, 4, 0, 0, 0, 0, time.UTC), WorkspaceID: workspaceID,
+			},
+		},
+	}}
+	handler := httphandler.NewCalendarHandler(httphandler.CalendarUseCases{Feed: feeds})
+
+	t.Run("serves text/calendar", func(t *testing.T) {
+		c, rec := newAnnouncementContext(stdhttp.MethodGet, "/calendar/secret.ics", "", "")
+		c.SetParamNames("token")
+		c.SetParamValues("secret.ics")
+		require.NoError(t, handler.Feed(c))
+
+		assert.Equal(t, stdhttp.StatusOK, rec.Code)
+		assert.Equal(t, "secret", feeds.lastToken)
+		assert.Contains(t, rec.Header().Get("Content-Type"), "text/calendar")
+
+		body := rec.Body.String()
+		assert.True(t, strings.HasPrefix(body, "BEGIN:VCALENDAR\r\n"))
+		assert.Contains(t, body, "SUMMARY:Due: Fix login\\, then deploy\r\n")
+		assert.Contains(t, body, "DTSTART;VALUE=DATE:20260512\r\nDTEND;VALUE=DATE:20260513\r\n")
+		unfolded := strings.ReplaceAll(body, "\r\n ", "")
+		assert.Contains(t, unfolded, "/workspaces/"+workspaceID.String()+"/chats/"+taskID.String())
+		assert.Contains(t, body, "SUMMARY:Sprint 4 starts\r\n")
+		assert.True(t, strings.HasSuffix(body, "END:VCALENDAR\r\n"))
+	})
+
+	t.Run("unknown token", func(t *testing.T) {
+		c, rec := newAnnouncementContext(stdhttp.MethodGet, "/calendar/revoked.ics", "", "")
+		c.SetParamNames("token")
+		c.SetParamValues("revoked.ics")
+		require.NoError(t, handler.Feed(c))
+		assert.Equal(t, stdhttp.StatusNotFound, rec.Code)
+	})
+}
+
+func TestRenderICalendar_FoldsLongLines(t *testing.T) {
+	feed := &calendarapp.Feed{Name: "Alice", Events: []calendarapp.Event{{
+		UID: "long", Kind: calendarapp.EventKindSprintStart, Summary: strings.Repeat("Спринт ", 30),
+		Date: time.Date(2026, time.May, 4, 0, 0, 0, 0, time.UTC),
+	}}}
+
+	body := httphandler.RenderICalendar(feed, "https://flowra.example", time.Now())
+
+	for _, line := range strings.Split(strings.TrimSuffix(body, "\r\n"), "\r\n") {
+		assert.LessOrEqual(t, len(line), 75)
+		assert.True(t, utf8.ValidString(line), "folding must not split characters")
+	}
+}
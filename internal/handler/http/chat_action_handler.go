@@ -42,6 +42,7 @@ type ActionService interface {
 	) (*appcore.ActionResult, error)
 	Close(ctx context.Context, chatID uuid.UUID, actorID uuid.UUID) (*appcore.ActionResult, error)
 	Reopen(ctx context.Context, chatID uuid.UUID, actorID uuid.UUID) (*appcore.ActionResult, error)
+	ConvertToDiscussion(ctx context.Context, chatID uuid.UUID, actorID uuid.UUID) (*appcore.ActionResult, error)
 	Rename(ctx context.Context, chatID uuid.UUID, newTitle string, actorID uuid.UUID) (*appcore.ActionResult, error)
 }
 
@@ -198,7 +199,7 @@ func (h *ChatActionHandler) SetDueDate(c echo.Context) error {
 
 	var dueDate *time.Time
 	if req.DueDate != "" {
-		parsed, err := time.Parse("2006-01-02", req.DueDate)
+		parsed, err := time.ParseInLocation("2006-01-02", req.DueDate, middleware.GetLocation(c))
 		if err != nil {
 			return httpserver.RespondErrorWithCode(
 				c,
@@ -265,6 +266,29 @@ func (h *ChatActionHandler) Reopen(c echo.Context) error {
 	return c.NoContent(http.StatusOK)
 }
 
+// ConvertToDiscussion handles POST /api/v1/chats/:id/actions/discussion
+func (h *ChatActionHandler) ConvertToDiscussion(c echo.Context) error {
+	ctx := c.Request().Context()
+	userID := middleware.GetUserID(c)
+	if userID.IsZero() {
+		return httpserver.RespondErrorWithCode(c, http.StatusUnauthorized, "UNAUTHORIZED", "authentication required")
+	}
+
+	chatIDStr := c.Param("id")
+	chatID, parseErr := uuid.ParseUUID(chatIDStr)
+	if parseErr != nil {
+		return httpserver.RespondErrorWithCode(c, http.StatusBadRequest, "INVALID_CHAT_ID", "invalid chat ID format")
+	}
+
+	_, err := h.actionService.ConvertToDiscussion(ctx, chatID, userID)
+	if err != nil {
+		return httpserver.RespondError(c, err)
+	}
+
+	c.Response().Header().Set("Hx-Trigger", "chatUpdated")
+	return c.NoContent(http.StatusOK)
+}
+
 // Rename handles POST /api/v1/chats/:id/actions/rename
 //
 //nolint:dupl // Similar HTTP handler pattern with different validation logic
@@ -0,0 +1,556 @@
+package httphandler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/lllypuk/flowra/internal/application/appcore"
+	chatapp "github.com/lllypuk/flowra/internal/application/chat"
+	"github.com/lllypuk/flowra/internal/domain/errs"
+	"github.com/lllypuk/flowra/internal/domain/uuid"
+	"github.com/lllypuk/flowra/internal/infrastructure/httpserver"
+	"github.com/lllypuk/flowra/internal/infrastructure/resilience"
+	"github.com/lllypuk/flowra/internal/infrastructure/storage"
+	"github.com/lllypuk/flowra/internal/middleware"
+)
+
+// errQuotaLookup reports that the workspace of a chat could not be resolved for a quota check.
+var errQuotaLookup = errors.New("failed to resolve chat workspace")
+
+// ChatAttachmentResponse represents a file stored in object storage and attached to a chat.
+type ChatAttachmentResponse struct {
+	FileID      uuid.UUID `json:"file_id"`
+	FileName    string    `json:"file_name"`
+	FileSize    int64     `json:"file_size"`
+	MimeType    string    `json:"mime_type"`
+	URL         string    `json:"url"`
+	DownloadURL string    `json:"download_url,omitempty"`
+	ExpiresAt   string    `json:"expires_at,omitempty"`
+}
+
+// PresignChatAttachmentRequest announces a file the client will upload to object storage directly.
+type PresignChatAttachmentRequest struct {
+	FileName string `json:"file_name"`
+	FileSize int64  `json:"file_size"`
+}
+
+// ChatAttachmentUploadResponse carries a presigned URL the client PUTs the file to
+// before confirming the upload.
+// The upload must send UploadHeaders as they are, since they are part of the signature.
+type ChatAttachmentUploadResponse struct {
+	FileID        uuid.UUID         `json:"file_id"`
+	FileName      string            `json:"file_name"`
+	UploadURL     string            `json:"upload_url"`
+	UploadHeaders map[string]string `json:"upload_headers"`
+	ExpiresAt     string            `json:"expires_at"`
+	ConfirmURL    string            `json:"confirm_url"`
+}
+
+// uploaderMetadataKey names the object metadata that binds a presigned upload to the
+// user who requested it.
+const uploaderMetadataKey = "uploader"
+
+// ConfirmChatAttachmentRequest names the file uploaded to a presigned URL.
+type ConfirmChatAttachmentRequest struct {
+	FileName string `json:"file_name"`
+}
+
+// ChatAttachmentStore keeps attachment files in object storage.
+// Declared on the consumer side per project guidelines.
+type ChatAttachmentStore interface {
+	Put(ctx context.Context, key string, body io.Reader, size int64, contentType string) error
+	Stat(ctx context.Context, key string) (storage.ObjectInfo, error)
+	Delete(ctx context.Context, key string) error
+
+	// PresignGet returns a URL downloading the object without credentials and when it expires.
+	PresignGet(ctx context.Context, key, fileName string) (string, time.Time, error)
+
+	// PresignPut returns a URL uploading the object with the given metadata without
+	// credentials, the headers the upload must send and when the URL expires.
+	PresignPut(ctx context.Context, key string, metadata map[string]string) (string, http.Header, time.Time, error)
+}
+
+// ChatAttachmentMetadata records which chat each attached file belongs to.
+// Save must fail with errs.ErrAlreadyExists when the file ID is already recorded,
+// which makes recording an attachment an atomic claim of its file ID.
+// Declared on the consumer side per project guidelines.
+type ChatAttachmentMetadata interface {
+	FileMetadataLookup
+	Delete(ctx context.Context, fileID uuid.UUID) error
+}
+
+// ChatAttachmentAdder attaches a stored file to a task, bug or epic chat.
+// Declared on the consumer side per project guidelines.
+type ChatAttachmentAdder interface {
+	Execute(ctx context.Context, cmd chatapp.AddAttachmentCommand) (chatapp.Result, error)
+}
+
+// ChatAttachmentHandler uploads chat attachments to object storage and hands out
+// presigned upload and download URLs. Only chat participants may upload or download.
+type ChatAttachmentHandler struct {
+	store          ChatAttachmentStore
+	attachments    ChatAttachmentAdder
+	participants   FileChatParticipantChecker
+	metadataRepo   ChatAttachmentMetadata
+	maxFileSize    int64
+	storageGuard   FileStorageGuard
+	quota          WorkspaceStorageQuota
+	chatWorkspaces FileChatWorkspaceResolver
+}
+
+// ChatAttachmentHandlerOption configures a ChatAttachmentHandler.
+type ChatAttachmentHandlerOption func(*ChatAttachmentHandler)
+
+// WithChatAttachmentMaxFileSize sets the maximum allowed size of a single upload in bytes.
+func WithChatAttachmentMaxFileSize(size int64) ChatAttachmentHandlerOption {
+	return func(h *ChatAttachmentHandler) {
+		if size > 0 {
+			h.maxFileSize = size
+		}
+	}
+}
+
+// WithChatAttachmentStorageGuard runs object storage writes through the given guard.
+func WithChatAttachmentStorageGuard(guard FileStorageGuard) ChatAttachmentHandlerOption {
+	return func(h *ChatAttachmentHandler) {
+		h.storageGuard = guard
+	}
+}
+
+// WithChatAttachmentStorageQuota enforces the attachment storage quota of the workspace
+// the chat belongs to and counts uploads against it.
+func WithChatAttachmentStorageQuota(
+	quota WorkspaceStorageQuota,
+	chatWorkspaces FileChatWorkspaceResolver,
+) ChatAttachmentHandlerOption {
+	return func(h *ChatAttachmentHandler) {
+		h.quota = quota
+		h.chatWorkspaces = chatWorkspaces
+	}
+}
+
+// NewChatAttachmentHandler creates a new ChatAttachmentHandler.
+func NewChatAttachmentHandler(
+	store ChatAttachmentStore,
+	attachments ChatAttachmentAdder,
+	participants FileChatParticipantChecker,
+	metadataRepo ChatAttachmentMetadata,
+	opts ...ChatAttachmentHandlerOption,
+) *ChatAttachmentHandler {
+	h := &ChatAttachmentHandler{
+		store:        store,
+		attachments:  attachments,
+		participants: participants,
+		metadataRepo: metadataRepo,
+		maxFileSize:  defaultMaxUploadSize,
+	}
+	for _, opt := range opts {
+		opt(h)
+	}
+	return h
+}
+
+// RegisterRoutes registers chat attachment routes with the router.
+func (h *ChatAttachmentHandler) RegisterRoutes(r *httpserver.Router) {
+	r.Auth().POST("/chats/:id/attachments", h.Upload)
+	r.Auth().POST("/chats/:id/attachments/presign", h.PresignUpload)
+	r.Auth().POST("/chats/:id/attachments/:file_id/confirm", h.ConfirmUpload)
+	r.Auth().GET("/chats/:id/attachments/:file_id/:file_name", h.Download)
+}
+
+// Upload handles POST /api/v1/chats/:id/attachments.
+// Accepts a multipart form with a "file" field, stores it in object storage and
+// attaches it to the task, bug or epic chat.
+func (h *ChatAttachmentHandler) Upload(c echo.Context) error {
+	userID, chatID, ok, err := h.authorize(c)
+	if !ok {
+		return err
+	}
+	ctx := c.Request().Context()
+
+	c.Request().Body = http.MaxBytesReader(c.Response(), c.Request().Body, h.maxFileSize)
+
+	file, formErr := c.FormFile("file")
+	if formErr != nil {
+		if strings.Contains(formErr.Error(), "http: request body too large") {
+			return h.respondFileTooLarge(c)
+		}
+		return httpserver.RespondErrorWithCode(c, http.StatusBadRequest, "INVALID_FILE", "file is required")
+	}
+	if file.Size > h.maxFileSize {
+		return h.respondFileTooLarge(c)
+	}
+
+	workspaceID, usage, quotaErr := h.checkQuota(ctx, chatID, file.Size)
+	if quotaErr != nil {
+		return h.respondQuotaError(c, usage, quotaErr)
+	}
+
+	mimeType := detectUploadMIME(file.Header.Get("Content-Type"), file.Filename)
+	if !isAllowedMIME(mimeType) {
+		return httpserver.RespondErrorWithCode(c, http.StatusBadRequest, "INVALID_FILE_TYPE", "file type not allowed")
+	}
+
+	src, openErr := file.Open()
+	if openErr != nil {
+		return httpserver.RespondErrorWithCode(
+			c, http.StatusInternalServerError, "FILE_ERROR", "failed to read uploaded file")
+	}
+	defer src.Close()
+
+	safeName := sanitizeFileName(file.Filename)
+	fileID := uuid.NewUUID()
+	key := storage.ChatAttachmentKey(chatID, fileID, safeName)
+	if putErr := h.put(ctx, key, src, file.Size, mimeType); putErr != nil {
+		var depErr *resilience.DependencyError
+		if errors.As(putErr, &depErr) {
+			return httpserver.RespondError(c, depErr)
+		}
+		return httpserver.RespondErrorWithCode(c, http.StatusInternalServerError, "STORAGE_ERROR", "failed to save file")
+	}
+
+	return h.attach(c, chatAttachment{
+		chatID: chatID, workspaceID: workspaceID, fileID: fileID, uploaderID: userID,
+		key: key, fileName: safeName, size: file.Size, mimeType: mimeType,
+	})
+}
+
+// PresignUpload handles POST /api/v1/chats/:id/attachments/presign.
+// Returns a presigned URL the client uploads the file to directly; the upload is
+// attached to the chat by ConfirmUpload.
+func (h *ChatAttachmentHandler) PresignUpload(c echo.Context) error {
+	userID, chatID, ok, err := h.authorize(c)
+	if !ok {
+		return err
+	}
+	ctx := c.Request().Context()
+
+	var req PresignChatAttachmentRequest
+	if bindErr := c.Bind(&req); bindErr != nil {
+		return httpserver.RespondErrorWithCode(
+			c, http.StatusBadRequest, "INVALID_REQUEST", "invalid request body")
+	}
+	if strings.TrimSpace(req.FileName) == "" || req.FileSize <= 0 {
+		return httpserver.RespondErrorWithCode(
+			c, http.StatusBadRequest, "VALIDATION_ERROR", "file_name and a positive file_size are required")
+	}
+	if req.FileSize > h.maxFileSize {
+		return h.respondFileTooLarge(c)
+	}
+	if !isAllowedMIME(detectUploadMIME("", req.FileName)) {
+		return httpserver.RespondErrorWithCode(c, http.StatusBadRequest, "INVALID_FILE_TYPE", "file type not allowed")
+	}
+
+	// Rejects uploads that cannot fit early; ConfirmUpload checks the actual size again
+	if _, usage, quotaErr := h.checkQuota(ctx, chatID, req.FileSize); quotaErr != nil {
+		return h.respondQuotaError(c, usage, quotaErr)
+	}
+
+	safeName := sanitizeFileName(req.FileName)
+	fileID := uuid.NewUUID()
+	// The uploader is signed into the object metadata so only they can confirm the upload
+	uploadURL, headers, expiresAt, presignErr := h.store.PresignPut(
+		ctx, storage.ChatAttachmentKey(chatID, fileID, safeName),
+		map[string]string{uploaderMetadataKey: userID.String()})
+	if presignErr != nil {
+		return httpserver.RespondErrorWithCode(
+			c, http.StatusInternalServerError, "STORAGE_ERROR", "failed to create upload URL")
+	}
+	uploadHeaders := make(map[string]string, len(headers))
+	for name := range headers {
+		uploadHeaders[name] = headers.Get(name)
+	}
+
+	return httpserver.RespondCreated(c, ChatAttachmentUploadResponse{
+		FileID:        fileID,
+		FileName:      safeName,
+		UploadURL:     uploadURL,
+		UploadHeaders: uploadHeaders,
+		ExpiresAt:     expiresAt.UTC().Format(time.RFC3339),
+		ConfirmURL:    fmt.Sprintf("/api/v1/chats/%s/attachments/%s/confirm", chatID, fileID),
+	})
+}
+
+// ConfirmUpload handles POST /api/v1/chats/:id/attachments/:file_id/confirm.
+// Attaches a file uploaded to a presigned URL to the chat. Only the user who requested
+// the URL may confirm it. Uploads that break the size or type limits are deleted from
+// object storage.
+func (h *ChatAttachmentHandler) ConfirmUpload(c echo.Context) error {
+	userID, chatID, ok, err := h.authorize(c)
+	if !ok {
+		return err
+	}
+	ctx := c.Request().Context()
+
+	fileID, parseErr := uuid.ParseUUID(c.Param("file_id"))
+	if parseErr != nil {
+		return httpserver.RespondErrorWithCode(c, http.StatusBadRequest, "INVALID_FILE_ID", "invalid file ID format")
+	}
+	var req ConfirmChatAttachmentRequest
+	if bindErr := c.Bind(&req); bindErr != nil {
+		return httpserver.RespondErrorWithCode(
+			c, http.StatusBadRequest, "INVALID_REQUEST", "invalid request body")
+	}
+	if strings.TrimSpace(req.FileName) == "" {
+		return httpserver.RespondErrorWithCode(c, http.StatusBadRequest, "VALIDATION_ERROR", "file_name is required")
+	}
+	// A quick check only; attach claims the file ID atomically
+	if _, findErr := h.metadataRepo.FindByFileID(ctx, fileID); findErr == nil {
+		return respondAlreadyAttached(c)
+	} else if !errors.Is(findErr, errs.ErrNotFound) {
+		return httpserver.RespondErrorWithCode(
+			c, http.StatusInternalServerError, "METADATA_ERROR", "failed to check file metadata")
+	}
+
+	safeName := sanitizeFileName(req.FileName)
+	key := storage.ChatAttachmentKey(chatID, fileID, safeName)
+	info, statErr := h.store.Stat(ctx, key)
+	if statErr != nil {
+		if errors.Is(statErr, storage.ErrObjectNotFound) {
+			return httpserver.RespondErrorWithCode(
+				c, http.StatusNotFound, "UPLOAD_NOT_FOUND", "no file was uploaded for this attachment")
+		}
+		return httpserver.RespondErrorWithCode(
+			c, http.StatusInternalServerError, "STORAGE_ERROR", "failed to check uploaded file")
+	}
+	// The object is left in place: it belongs to whoever uploaded it
+	if info.Metadata[uploaderMetadataKey] != userID.String() {
+		return httpserver.RespondErrorWithCode(
+			c, http.StatusForbidden, "FORBIDDEN", "the file was uploaded by another user")
+	}
+
+	// Presigned URLs cannot limit what is uploaded, so the limits are enforced here
+	discard := func() { _ = h.store.Delete(context.WithoutCancel(ctx), key) }
+	if info.Size > h.maxFileSize {
+		discard()
+		return h.respondFileTooLarge(c)
+	}
+	mimeType := detectUploadMIME(info.ContentType, safeName)
+	if !isAllowedMIME(mimeType) {
+		discard()
+		return httpserver.RespondErrorWithCode(c, http.StatusBadRequest, "INVALID_FILE_TYPE", "file type not allowed")
+	}
+	workspaceID, usage, quotaErr := h.checkQuota(ctx, chatID, info.Size)
+	if quotaErr != nil {
+		discard()
+		return h.respondQuotaError(c, usage, quotaErr)
+	}
+
+	return h.attach(c, chatAttachment{
+		chatID: chatID, workspaceID: workspaceID, fileID: fileID, uploaderID: userID,
+		key: key, fileName: safeName, size: info.Size, mimeType: mimeType,
+	})
+}
+
+// Download handles GET /api/v1/chats/:id/attachments/:file_id/:file_name.
+// Redirects chat participants to a fresh presigned URL of the file.
+func (h *ChatAttachmentHandler) Download(c echo.Context) error {
+	userID := middleware.GetUserID(c)
+	if userID.IsZero() {
+		return httpserver.RespondErrorWithCode(c, http.StatusUnauthorized, "UNAUTHORIZED", "authentication required")
+	}
+
+	chatID, parseErr := uuid.ParseUUID(c.Param("id"))
+	if parseErr != nil {
+		return httpserver.RespondErrorWithCode(c, http.StatusBadRequest, "INVALID_CHAT_ID", "invalid chat ID format")
+	}
+	fileID, parseErr := uuid.ParseUUID(c.Param("file_id"))
+	if parseErr != nil {
+		return httpserver.RespondErrorWithCode(c, http.StatusBadRequest, "INVALID_FILE_ID", "invalid file ID format")
+	}
+
+	ctx := c.Request().Context()
+	isMember, memberErr := h.participants.IsParticipant(ctx, chatID, userID)
+	if memberErr != nil || !isMember {
+		return httpserver.RespondErrorWithCode(
+			c, http.StatusForbidden, "FORBIDDEN", "you do not have access to this file")
+	}
+
+	// The object key is built from the stored name, never from the request path
+	meta, metaErr := h.metadataRepo.FindByFileID(ctx, fileID)
+	if metaErr != nil || meta.ChatID != chatID || meta.FileName == "" || meta.FileName != c.Param("file_name") {
+		return httpserver.RespondErrorWithCode(c, http.StatusNotFound, "FILE_NOT_FOUND", "file not found")
+	}
+
+	downloadURL, _, presignErr := h.store.PresignGet(
+		ctx, storage.ChatAttachmentKey(chatID, fileID, meta.FileName), meta.FileName)
+	if presignErr != nil {
+		return httpserver.RespondErrorWithCode(
+			c, http.StatusInternalServerError, "STORAGE_ERROR", "failed to create download URL")
+	}
+	return c.Redirect(http.StatusFound, downloadURL)
+}
+
+// authorize resolves the current user and the chat of the request and checks the
+// user participates in it. When ok is false the error response has been written
+// and its result must be returned.
+func (h *ChatAttachmentHandler) authorize(c echo.Context) (uuid.UUID, uuid.UUID, bool, error) {
+	userID := middleware.GetUserID(c)
+	if userID.IsZero() {
+		return "", "", false, httpserver.RespondErrorWithCode(
+			c, http.StatusUnauthorized, "UNAUTHORIZED", "authentication required")
+	}
+
+	chatID, parseErr := uuid.ParseUUID(c.Param("id"))
+	if parseErr != nil {
+		return "", "", false, httpserver.RespondErrorWithCode(
+			c, http.StatusBadRequest, "INVALID_CHAT_ID", "invalid chat ID format")
+	}
+
+	isMember, memberErr := h.participants.IsParticipant(c.Request().Context(), chatID, userID)
+	if memberErr != nil || !isMember {
+		return "", "", false, httpserver.RespondErrorWithCode(
+			c, http.StatusForbidden, "FORBIDDEN", "you are not a participant of this chat")
+	}
+	return userID, chatID, true, nil
+}
+
+// checkQuota checks that size more bytes fit the storage quota of the chat's
+// workspace and returns the workspace to record the upload against.
+func (h *ChatAttachmentHandler) checkQuota(
+	ctx context.Context,
+	chatID uuid.UUID,
+	size int64,
+) (uuid.UUID, StorageUsage, error) {
+	if h.quota == nil {
+		return "", StorageUsage{}, nil
+	}
+	workspaceID, err := h.chatWorkspaces.ChatWorkspaceID(ctx, chatID)
+	if err != nil {
+		return "", StorageUsage{}, errQuotaLookup
+	}
+	usage, err := h.quota.CheckUpload(ctx, workspaceID, size)
+	return workspaceID, usage, err
+}
+
+func (h *ChatAttachmentHandler) respondQuotaError(c echo.Context, usage StorageUsage, err error) error {
+	if errors.Is(err, errQuotaLookup) {
+		return httpserver.RespondErrorWithCode(
+			c, http.StatusInternalServerError, "QUOTA_CHECK_FAILED", "failed to check storage quota")
+	}
+	return respondStorageQuotaError(c, usage, err)
+}
+
+func (h *ChatAttachmentHandler) respondFileTooLarge(c echo.Context) error {
+	return httpserver.RespondErrorWithCode(
+		c, http.StatusRequestEntityTooLarge, "FILE_TOO_LARGE",
+		fmt.Sprintf("file size exceeds %d MB limit", h.maxFileSize/bytesPerMB))
+}
+
+// chatAttachment is a file stored in object storage that is about to be attached.
+type chatAttachment struct {
+	chatID      uuid.UUID
+	workspaceID uuid.UUID
+	fileID      uuid.UUID
+	uploaderID  uuid.UUID
+	key         string
+	fileName    string
+	size        int64
+	mimeType    string
+}
+
+// attach records the stored file, adds it to the chat and responds with it. Recording
+// the metadata claims the file ID, so only one request attaches a given file. The
+// object and its record are deleted when the chat rejects the file.
+func (h *ChatAttachmentHandler) attach(c echo.Context, a chatAttachment) error {
+	ctx := c.Request().Context()
+	cleanupCtx := context.WithoutCancel(ctx)
+
+	// Downloads are authorized against the chat the file was attached to
+	if saveErr := h.metadataRepo.Save(ctx, FileMetadataEntry{
+		FileID:     a.fileID,
+		ChatID:     a.chatID,
+		UploaderID: a.uploaderID,
+		UploadedAt: time.Now().UTC(),
+		FileName:   a.fileName,
+	}); saveErr != nil {
+		if errors.Is(saveErr, errs.ErrAlreadyExists) {
+			// the object belongs to the request that claimed it first
+			return respondAlreadyAttached(c)
+		}
+		_ = h.store.Delete(cleanupCtx, a.key)
+		return httpserver.RespondErrorWithCode(
+			c, http.StatusInternalServerError, "METADATA_ERROR", "failed to record file metadata")
+	}
+
+	if _, attachErr := h.attachments.Execute(ctx, chatapp.AddAttachmentCommand{
+		ChatID:   a.chatID,
+		FileID:   a.fileID,
+		FileName: a.fileName,
+		FileSize: a.size,
+		MimeType: a.mimeType,
+		AddedBy:  a.uploaderID,
+	}); attachErr != nil {
+		// nothing references the object yet, so drop it rather than leave an orphan
+		_ = h.store.Delete(cleanupCtx, a.key)
+		_ = h.metadataRepo.Delete(cleanupCtx, a.fileID)
+		return respondChatAttachmentError(c, attachErr)
+	}
+
+	if h.quota != nil {
+		_ = h.quota.RecordUpload(ctx, a.workspaceID, a.size)
+	}
+
+	resp := ChatAttachmentResponse{
+		FileID:   a.fileID,
+		FileName: a.fileName,
+		FileSize: a.size,
+		MimeType: a.mimeType,
+		URL:      chatAttachmentURL(a.chatID, a.fileID, a.fileName),
+	}
+	// The file is attached either way; without a presigned URL clients fall back to URL
+	if downloadURL, expiresAt, err := h.store.PresignGet(ctx, a.key, a.fileName); err == nil {
+		resp.DownloadURL = downloadURL
+		resp.ExpiresAt = expiresAt.UTC().Format(time.RFC3339)
+	}
+	return httpserver.RespondCreated(c, resp)
+}
+
+// put writes the upload to object storage, through the storage guard if one is configured.
+func (h *ChatAttachmentHandler) put(
+	ctx context.Context,
+	key string,
+	src io.Reader,
+	size int64,
+	contentType string,
+) error {
+	if h.storageGuard == nil {
+		return h.store.Put(ctx, key, src, size, contentType)
+	}
+	return h.storageGuard.Do(ctx, func(ctx context.Context) error {
+		return h.store.Put(ctx, key, resilience.NewContextReader(ctx, src), size, contentType)
+	})
+}
+
+func respondAlreadyAttached(c echo.Context) error {
+	return httpserver.RespondErrorWithCode(c, http.StatusConflict, "ALREADY_ATTACHED", "file is already attached")
+}
+
+// respondChatAttachmentError maps errors of attaching a file to a chat.
+func respondChatAttachmentError(c echo.Context, err error) error {
+	var validationErr *appcore.ValidationError
+	switch {
+	case errors.Is(err, chatapp.ErrAttachmentQuotaExceeded):
+		return httpserver.RespondErrorWithCode(c, http.StatusRequestEntityTooLarge, "ATTACHMENT_QUOTA_EXCEEDED",
+			"attachments of this chat would exceed the size quota")
+	case errors.Is(err, errs.ErrInvalidState):
+		return httpserver.RespondErrorWithCode(c, http.StatusUnprocessableEntity, "INVALID_CHAT_TYPE",
+			"files can only be attached to tasks, bugs and epics")
+	case errors.As(err, &validationErr):
+		return httpserver.RespondErrorWithCode(c, http.StatusBadRequest, "VALIDATION_ERROR", validationErr.Error())
+	default:
+		return httpserver.RespondError(c, err)
+	}
+}
+
+// chatAttachmentURL returns the API path that redirects to a fresh download URL of the file.
+func chatAttachmentURL(chatID, fileID uuid.UUID, fileName string) string {
+	return fmt.Sprintf("/api/v1/chats/%s/attachments/%s/%s", chatID, fileID, url.PathEscape(fileName))
+}
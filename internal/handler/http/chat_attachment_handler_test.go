@@ -0,0 +1,440 @@
+package httphandler_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	stdhttp "net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	chatapp "github.com/lllypuk/flowra/internal/application/chat"
+	"github.com/lllypuk/flowra/internal/domain/errs"
+	"github.com/lllypuk/flowra/internal/domain/uuid"
+	httphandler "github.com/lllypuk/flowra/internal/handler/http"
+	"github.com/lllypuk/flowra/internal/infrastructure/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeAttachmentStore keeps uploaded objects in memory.
+type fakeAttachmentStore struct {
+	objects  map[string]string
+	types    map[string]string
+	metadata map[string]map[string]string
+}
+
+func newFakeAttachmentStore() *fakeAttachmentStore {
+	return &fakeAttachmentStore{
+		objects:  make(map[string]string),
+		types:    make(map[string]string),
+		metadata: make(map[string]map[string]string),
+	}
+}
+
+// upload stores an object the way S3 does for a PUT to a presigned URL with headers.
+func (s *fakeAttachmentStore) upload(key, content, contentType string, headers map[string]string) {
+	s.objects[key] = content
+	s.types[key] = contentType
+	s.metadata[key] = make(map[string]string)
+	for name, value := range headers {
+		if meta, ok := strings.CutPrefix(name, "X-Amz-Meta-"); ok {
+			s.metadata[key][strings.ToLower(meta)] = value
+		}
+	}
+}
+
+func (s *fakeAttachmentStore) Put(_ context.Context, key string, body io.Reader, _ int64, contentType string) error {
+	content, err := io.ReadAll(body)
+	if err != nil {
+		return err
+	}
+	s.objects[key] = string(content)
+	s.types[key] = contentType
+	return nil
+}
+
+func (s *fakeAttachmentStore) Delete(_ context.Context, key string) error {
+	delete(s.objects, key)
+	return nil
+}
+
+func (s *fakeAttachmentStore) Stat(_ context.Context, key string) (storage.ObjectInfo, error) {
+	content, ok := s.objects[key]
+	if !ok {
+		return storage.ObjectInfo{}, storage.ErrObjectNotFound
+	}
+	return storage.ObjectInfo{Size: int64(len(content)), ContentType: s.types[key], Metadata: s.metadata[key]}, nil
+}
+
+func (s *fakeAttachmentStore) PresignGet(_ context.Context, key, fileName string) (string, time.Time, error) {
+	expiresAt := time.Date(2026, time.March, 2, 10, 15, 0, 0, time.UTC)
+	return "https://bucket.example.com/" + key + "?name=" + fileName, expiresAt, nil
+}
+
+func (s *fakeAttachmentStore) PresignPut(
+	_ context.Context,
+	key string,
+	metadata map[string]string,
+) (string, stdhttp.Header, time.Time, error) {
+	headers := make(stdhttp.Header)
+	for name, value := range metadata {
+		headers.Set("X-Amz-Meta-"+name, value)
+	}
+	expiresAt := time.Date(2026, time.March, 2, 10, 15, 0, 0, time.UTC)
+	return "https://bucket.example.com/" + key + "?upload", headers, expiresAt, nil
+}
+
+type fakeChatAttachmentAdder struct {
+	err      error
+	commands []chatapp.AddAttachmentCommand
+}
+
+func (f *fakeChatAttachmentAdder) Execute(_ context.Context, cmd chatapp.AddAttachmentCommand) (chatapp.Result, error) {
+	f.commands = append(f.commands, cmd)
+	return chatapp.Result{}, f.err
+}
+
+func newChatAttachmentUploadContext(t *testing.T, chatID, userID uuid.UUID, fileName, content string) (
+	echo.Context,
+	*httptest.ResponseRecorder,
+) {
+	t.Helper()
+	body, contentType := createMultipartFile(t, fileName, content)
+	req := httptest.NewRequest(stdhttp.MethodPost, "/api/v1/chats/"+chatID.String()+"/attachments", body)
+	req.Header.Set(echo.HeaderContentType, contentType)
+	rec := httptest.NewRecorder()
+	c := echo.New().NewContext(req, rec)
+	c.SetParamNames("id")
+	c.SetParamValues(chatID.String())
+	setupAuthContext(c, userID)
+	return c, rec
+}
+
+func TestChatAttachmentHandler_Upload(t *testing.T) {
+	chatID := uuid.NewUUID()
+	userID := uuid.NewUUID()
+
+	t.Run("stores the file and attaches it to the chat", func(t *testing.T) {
+		store, adder, metadata := newFakeAttachmentStore(), &fakeChatAttachmentAdder{}, newMockFileMetadataRepo()
+		participants := newMockParticipantChecker()
+		participants.AddParticipant(chatID, userID)
+		handler := httphandler.NewChatAttachmentHandler(store, adder, participants, metadata)
+		c, rec := newChatAttachmentUploadContext(t, chatID, userID, "Q1 report.pdf", "%PDF-1.7")
+
+		require.NoError(t, handler.Upload(c))
+		require.Equal(t, stdhttp.StatusCreated, rec.Code)
+
+		var resp struct {
+			Data httphandler.ChatAttachmentResponse `json:"data"`
+		}
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+		fileID := resp.Data.FileID
+		key := storage.ChatAttachmentKey(chatID, fileID, "Q1 report.pdf")
+		assert.Equal(t, "%PDF-1.7", store.objects[key])
+		assert.Equal(t, "application/pdf", store.types[key])
+		assert.Equal(t, fmt.Sprintf("/api/v1/chats/%s/attachments/%s/Q1%%20report.pdf", chatID, fileID), resp.Data.URL)
+		assert.Contains(t, resp.Data.DownloadURL, key)
+		assert.Equal(t, "2026-03-02T10:15:00Z", resp.Data.ExpiresAt)
+
+		require.Len(t, adder.commands, 1)
+		assert.Equal(t, chatapp.AddAttachmentCommand{
+			ChatID: chatID, FileID: fileID, FileName: "Q1 report.pdf", FileSize: 8,
+			MimeType: "application/pdf", AddedBy: userID,
+		}, adder.commands[0])
+		require.Contains(t, metadata.entries, fileID.String())
+		assert.Equal(t, chatID, metadata.entries[fileID.String()].ChatID)
+	})
+
+	t.Run("rejects non-participants", func(t *testing.T) {
+		store, adder := newFakeAttachmentStore(), &fakeChatAttachmentAdder{}
+		handler := httphandler.NewChatAttachmentHandler(
+			store, adder, newMockParticipantChecker(), newMockFileMetadataRepo())
+		c, rec := newChatAttachmentUploadContext(t, chatID, userID, "notes.txt", "hello")
+
+		require.NoError(t, handler.Upload(c))
+		assert.Equal(t, stdhttp.StatusForbidden, rec.Code)
+		assert.Empty(t, store.objects)
+		assert.Empty(t, adder.commands)
+	})
+
+	t.Run("rejects files over the size limit", func(t *testing.T) {
+		store := newFakeAttachmentStore()
+		participants := newMockParticipantChecker()
+		participants.AddParticipant(chatID, userID)
+		handler := httphandler.NewChatAttachmentHandler(
+			store, &fakeChatAttachmentAdder{}, participants, newMockFileMetadataRepo(),
+			httphandler.WithChatAttachmentMaxFileSize(4))
+		c, rec := newChatAttachmentUploadContext(t, chatID, userID, "notes.txt", "hello world")
+
+		require.NoError(t, handler.Upload(c))
+		assert.Equal(t, stdhttp.StatusRequestEntityTooLarge, rec.Code)
+		assert.Empty(t, store.objects)
+	})
+
+	t.Run("removes the object when the chat quota is exceeded", func(t *testing.T) {
+		store, metadata := newFakeAttachmentStore(), newMockFileMetadataRepo()
+		adder := &fakeChatAttachmentAdder{err: chatapp.ErrAttachmentQuotaExceeded}
+		participants := newMockParticipantChecker()
+		participants.AddParticipant(chatID, userID)
+		handler := httphandler.NewChatAttachmentHandler(store, adder, participants, metadata)
+		c, rec := newChatAttachmentUploadContext(t, chatID, userID, "notes.txt", "hello")
+
+		require.NoError(t, handler.Upload(c))
+		assert.Equal(t, stdhttp.StatusRequestEntityTooLarge, rec.Code)
+		assert.Contains(t, rec.Body.String(), "ATTACHMENT_QUOTA_EXCEEDED")
+		assert.Empty(t, store.objects)
+		assert.Empty(t, metadata.entries)
+	})
+}
+
+func newChatAttachmentJSONContext(t *testing.T, target string, body string, userID uuid.UUID) (
+	echo.Context,
+	*httptest.ResponseRecorder,
+) {
+	t.Helper()
+	req := httptest.NewRequest(stdhttp.MethodPost, target, strings.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := echo.New().NewContext(req, rec)
+	setupAuthContext(c, userID)
+	return c, rec
+}
+
+func TestChatAttachmentHandler_PresignedUpload(t *testing.T) {
+	chatID := uuid.NewUUID()
+	userID := uuid.NewUUID()
+	base := "/api/v1/chats/" + chatID.String() + "/attachments"
+
+	setup := func() (
+		*httphandler.ChatAttachmentHandler, *fakeAttachmentStore, *fakeChatAttachmentAdder, *mockFileMetadataRepo,
+	) {
+		store, adder, metadata := newFakeAttachmentStore(), &fakeChatAttachmentAdder{}, newMockFileMetadataRepo()
+		participants := newMockParticipantChecker()
+		participants.AddParticipant(chatID, userID)
+		handler := httphandler.NewChatAttachmentHandler(store, adder, participants, metadata,
+			httphandler.WithChatAttachmentMaxFileSize(16))
+		return handler, store, adder, metadata
+	}
+	setupWithParticipants := func() (
+		*httphandler.ChatAttachmentHandler, *fakeAttachmentStore, *fakeChatAttachmentAdder, *mockParticipantChecker,
+	) {
+		store, adder := newFakeAttachmentStore(), &fakeChatAttachmentAdder{}
+		participants := newMockParticipantChecker()
+		participants.AddParticipant(chatID, userID)
+		handler := httphandler.NewChatAttachmentHandler(store, adder, participants, newMockFileMetadataRepo(),
+			httphandler.WithChatAttachmentMaxFileSize(16))
+		return handler, store, adder, participants
+	}
+	presign := func(
+		t *testing.T, handler *httphandler.ChatAttachmentHandler, body string,
+	) (httphandler.ChatAttachmentUploadResponse, int) {
+		t.Helper()
+		c, rec := newChatAttachmentJSONContext(t, base+"/presign", body, userID)
+		c.SetParamNames("id")
+		c.SetParamValues(chatID.String())
+		require.NoError(t, handler.PresignUpload(c))
+		var resp struct {
+			Data httphandler.ChatAttachmentUploadResponse `json:"data"`
+		}
+		_ = json.Unmarshal(rec.Body.Bytes(), &resp)
+		return resp.Data, rec.Code
+	}
+	confirmAs := func(
+		t *testing.T, handler *httphandler.ChatAttachmentHandler, user, fileID uuid.UUID, name string,
+	) *httptest.ResponseRecorder {
+		t.Helper()
+		c, rec := newChatAttachmentJSONContext(t, base+"/"+fileID.String()+"/confirm",
+			`{"file_name":"`+name+`"}`, user)
+		c.SetParamNames("id", "file_id")
+		c.SetParamValues(chatID.String(), fileID.String())
+		require.NoError(t, handler.ConfirmUpload(c))
+		return rec
+	}
+	confirm := func(
+		t *testing.T, handler *httphandler.ChatAttachmentHandler, fileID uuid.UUID, name string,
+	) *httptest.ResponseRecorder {
+		t.Helper()
+		return confirmAs(t, handler, userID, fileID, name)
+	}
+
+	t.Run("attaches a file uploaded to the presigned URL", func(t *testing.T) {
+		handler, store, adder, metadata := setup()
+
+		upload, code := presign(t, handler, `{"file_name":"notes.txt","file_size":5}`)
+		require.Equal(t, stdhttp.StatusCreated, code)
+		key := storage.ChatAttachmentKey(chatID, upload.FileID, "notes.txt")
+		assert.Equal(t, "https://bucket.example.com/"+key+"?upload", upload.UploadURL)
+		assert.Equal(t, base+"/"+upload.FileID.String()+"/confirm", upload.ConfirmURL)
+		assert.Equal(t, map[string]string{"X-Amz-Meta-Uploader": userID.String()}, upload.UploadHeaders)
+		assert.Empty(t, adder.commands, "nothing is attached before the upload is confirmed")
+
+		// the client uploads to the presigned URL
+		store.upload(key, "hello", "text/plain", upload.UploadHeaders)
+
+		rec := confirm(t, handler, upload.FileID, "notes.txt")
+		require.Equal(t, stdhttp.StatusCreated, rec.Code)
+		require.Len(t, adder.commands, 1)
+		assert.Equal(t, int64(5), adder.commands[0].FileSize, "the stored size is attached, not the announced one")
+		assert.Equal(t, "notes.txt", metadata.entries[upload.FileID.String()].FileName)
+
+		assert.Equal(t, stdhttp.StatusConflict, confirm(t, handler, upload.FileID, "notes.txt").Code)
+	})
+
+	t.Run("rejects announced files over the size limit", func(t *testing.T) {
+		handler, _, _, _ := setup()
+
+		_, code := presign(t, handler, `{"file_name":"big.txt","file_size":17}`)
+
+		assert.Equal(t, stdhttp.StatusRequestEntityTooLarge, code)
+	})
+
+	t.Run("deletes uploads over the size limit", func(t *testing.T) {
+		handler, store, adder, _ := setup()
+		fileID := uuid.NewUUID()
+		key := storage.ChatAttachmentKey(chatID, fileID, "big.txt")
+		store.upload(key, "more than sixteen bytes", "text/plain", map[string]string{
+			"X-Amz-Meta-Uploader": userID.String(),
+		})
+
+		rec := confirm(t, handler, fileID, "big.txt")
+
+		assert.Equal(t, stdhttp.StatusRequestEntityTooLarge, rec.Code)
+		assert.Empty(t, store.objects)
+		assert.Empty(t, adder.commands)
+	})
+
+	t.Run("confirming without an upload", func(t *testing.T) {
+		handler, _, _, _ := setup()
+
+		rec := confirm(t, handler, uuid.NewUUID(), "notes.txt")
+
+		assert.Equal(t, stdhttp.StatusNotFound, rec.Code)
+	})
+
+	t.Run("only the uploader confirms an upload", func(t *testing.T) {
+		handler, store, adder, participants := setupWithParticipants()
+		other := uuid.NewUUID()
+		participants.AddParticipant(chatID, other)
+		upload, _ := presign(t, handler, `{"file_name":"notes.txt","file_size":5}`)
+		key := storage.ChatAttachmentKey(chatID, upload.FileID, "notes.txt")
+		store.upload(key, "hello", "text/plain", upload.UploadHeaders)
+
+		rec := confirmAs(t, handler, other, upload.FileID, "notes.txt")
+
+		assert.Equal(t, stdhttp.StatusForbidden, rec.Code)
+		assert.Empty(t, adder.commands)
+		assert.Contains(t, store.objects, key, "the upload stays for its uploader")
+		assert.Equal(t, stdhttp.StatusCreated, confirm(t, handler, upload.FileID, "notes.txt").Code)
+	})
+
+	t.Run("a file ID is attached once", func(t *testing.T) {
+		handler, store, adder, metadata := setup()
+		upload, _ := presign(t, handler, `{"file_name":"notes.txt","file_size":5}`)
+		key := storage.ChatAttachmentKey(chatID, upload.FileID, "notes.txt")
+		store.upload(key, "hello", "text/plain", upload.UploadHeaders)
+		// a concurrent confirm claims the file ID between the check and the attach
+		metadata.afterFind = func() {
+			_ = metadata.Save(context.Background(), httphandler.FileMetadataEntry{FileID: upload.FileID, ChatID: chatID})
+		}
+
+		rec := confirm(t, handler, upload.FileID, "notes.txt")
+
+		assert.Equal(t, stdhttp.StatusConflict, rec.Code)
+		assert.Empty(t, adder.commands)
+		assert.Contains(t, store.objects, key, "the object belongs to the confirm that claimed it")
+	})
+
+	t.Run("metadata lookup failures", func(t *testing.T) {
+		handler, store, adder, metadata := setup()
+		upload, _ := presign(t, handler, `{"file_name":"notes.txt","file_size":5}`)
+		store.upload(storage.ChatAttachmentKey(chatID, upload.FileID, "notes.txt"), "hello", "text/plain",
+			upload.UploadHeaders)
+		metadata.findErr = errors.New("connection reset")
+
+		rec := confirm(t, handler, upload.FileID, "notes.txt")
+
+		assert.Equal(t, stdhttp.StatusInternalServerError, rec.Code)
+		assert.Empty(t, adder.commands)
+	})
+
+	t.Run("rejected attachments release the file ID", func(t *testing.T) {
+		handler, store, adder, metadata := setup()
+		adder.err = errs.ErrInvalidState
+		upload, _ := presign(t, handler, `{"file_name":"notes.txt","file_size":5}`)
+		key := storage.ChatAttachmentKey(chatID, upload.FileID, "notes.txt")
+		store.upload(key, "hello", "text/plain", upload.UploadHeaders)
+
+		rec := confirm(t, handler, upload.FileID, "notes.txt")
+
+		assert.Equal(t, stdhttp.StatusUnprocessableEntity, rec.Code)
+		assert.Empty(t, store.objects)
+		assert.Empty(t, metadata.entries)
+	})
+}
+
+func TestChatAttachmentHandler_Download(t *testing.T) {
+	chatID := uuid.NewUUID()
+	userID := uuid.NewUUID()
+	fileID := uuid.NewUUID()
+
+	newNamedContext := func(chat uuid.UUID, fileName string) (echo.Context, *httptest.ResponseRecorder) {
+		req := httptest.NewRequest(stdhttp.MethodGet,
+			fmt.Sprintf("/api/v1/chats/%s/attachments/%s/%s", chat, fileID, fileName), nil)
+		rec := httptest.NewRecorder()
+		c := echo.New().NewContext(req, rec)
+		c.SetParamNames("id", "file_id", "file_name")
+		c.SetParamValues(chat.String(), fileID.String(), fileName)
+		setupAuthContext(c, userID)
+		return c, rec
+	}
+	newContext := func(chat uuid.UUID) (echo.Context, *httptest.ResponseRecorder) {
+		return newNamedContext(chat, "notes.txt")
+	}
+
+	metadata := newMockFileMetadataRepo()
+	_ = metadata.Save(context.Background(), httphandler.FileMetadataEntry{
+		FileID: fileID, ChatID: chatID, UploaderID: userID, UploadedAt: time.Now(), FileName: "notes.txt",
+	})
+	otherChatID := uuid.NewUUID()
+	participants := newMockParticipantChecker()
+	participants.AddParticipant(chatID, userID)
+	participants.AddParticipant(otherChatID, userID)
+	handler := httphandler.NewChatAttachmentHandler(
+		newFakeAttachmentStore(), &fakeChatAttachmentAdder{}, participants, metadata)
+
+	t.Run("redirects participants to a presigned URL", func(t *testing.T) {
+		c, rec := newContext(chatID)
+
+		require.NoError(t, handler.Download(c))
+		assert.Equal(t, stdhttp.StatusFound, rec.Code)
+		assert.Equal(t, "https://bucket.example.com/"+storage.ChatAttachmentKey(chatID, fileID, "notes.txt")+
+			"?name=notes.txt", rec.Header().Get("Location"))
+	})
+
+	t.Run("rejects a name other than the stored one", func(t *testing.T) {
+		c, rec := newNamedContext(chatID, "other.html")
+
+		require.NoError(t, handler.Download(c))
+		assert.Equal(t, stdhttp.StatusNotFound, rec.Code)
+	})
+
+	t.Run("hides files of other chats", func(t *testing.T) {
+		c, rec := newContext(otherChatID)
+
+		require.NoError(t, handler.Download(c))
+		assert.Equal(t, stdhttp.StatusNotFound, rec.Code)
+	})
+
+	t.Run("rejects non-participants", func(t *testing.T) {
+		c, rec := newContext(uuid.NewUUID())
+
+		require.NoError(t, handler.Download(c))
+		assert.Equal(t, stdhttp.StatusForbidden, rec.Code)
+	})
+}
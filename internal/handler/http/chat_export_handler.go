@@ -0,0 +1,223 @@
+package httphandler
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	chatapp "github.com/lllypuk/flowra/internal/application/chat"
+	"github.com/lllypuk/flowra/internal/domain/uuid"
+	"github.com/lllypuk/flowra/internal/infrastructure/chatexport"
+	"github.com/lllypuk/flowra/internal/infrastructure/httpserver"
+	"github.com/lllypuk/flowra/internal/middleware"
+)
+
+// ChatExportJobStore queues chat exports and reports on them.
+// Declared on the consumer side per project guidelines.
+type ChatExportJobStore interface {
+	Create(ctx context.Context, job *chatexport.Job) error
+	Get(ctx context.Context, id string) (*chatexport.Job, error)
+}
+
+// ChatExportChatFinder looks up the chat to export and its participants.
+// Declared on the consumer side per project guidelines.
+type ChatExportChatFinder interface {
+	FindByID(ctx context.Context, chatID uuid.UUID) (*chatapp.ReadModel, error)
+}
+
+// ChatExportRenderer writes the transcript of a chat.
+// Declared on the consumer side per project guidelines.
+type ChatExportRenderer interface {
+	Export(ctx context.Context, chatID uuid.UUID, format chatexport.Format, w io.Writer) (int, error)
+}
+
+// ChatExportJobResponse represents a queued chat export in API responses.
+type ChatExportJobResponse struct {
+	ID           string     `json:"id"`
+	ChatID       string     `json:"chat_id"`
+	Format       string     `json:"format"`
+	Status       string     `json:"status"`
+	MessageCount int        `json:"message_count,omitempty"`
+	Error        string     `json:"error,omitempty"`
+	CreatedAt    time.Time  `json:"created_at"`
+	FinishedAt   *time.Time `json:"finished_at,omitempty"`
+}
+
+// ChatExportHandler exports chat transcripts as JSON or HTML. Chats with up to
+// syncLimit messages are exported within the request; larger chats are queued
+// as jobs and written by the worker into dir, which the API shares with it.
+// Only chat admins may export a chat.
+type ChatExportHandler struct {
+	jobs      ChatExportJobStore
+	chats     ChatExportChatFinder
+	exporter  ChatExportRenderer
+	dir       string
+	syncLimit int
+}
+
+// NewChatExportHandler creates a new ChatExportHandler.
+func NewChatExportHandler(
+	jobs ChatExportJobStore,
+	chats ChatExportChatFinder,
+	exporter ChatExportRenderer,
+	dir string,
+	syncLimit int,
+) *ChatExportHandler {
+	return &ChatExportHandler{
+		jobs:      jobs,
+		chats:     chats,
+		exporter:  exporter,
+		dir:       dir,
+		syncLimit: syncLimit,
+	}
+}
+
+// RegisterRoutes registers chat export routes with the router.
+func (h *ChatExportHandler) RegisterRoutes(r *httpserver.Router) {
+	r.Auth().POST("/chats/:chat_id/exports", h.Create)
+	r.Auth().GET("/chats/:chat_id/exports/:id", h.Get)
+	r.Auth().GET("/chats/:chat_id/exports/:id/download", h.Download)
+}
+
+// Create handles POST /api/v1/chats/:chat_id/exports?format=json|html.
+// Responds with the transcript for small chats and 202 with the queued job for
+// chats with more than syncLimit messages.
+func (h *ChatExportHandler) Create(c echo.Context) error {
+	format, err := chatexport.ParseFormat(c.QueryParam("format"))
+	if err != nil {
+		return httpserver.RespondErrorWithCode(
+			c, http.StatusBadRequest, "INVALID_FORMAT", "format must be json or html")
+	}
+	rm, errResp := h.authorizeChat(c)
+	if errResp != nil {
+		return errResp()
+	}
+	chatID := rm.ID
+
+	if rm.MessageCount > h.syncLimit {
+		job := chatexport.NewJob(chatID, rm.WorkspaceID, middleware.GetUserID(c), format, h.dir)
+		if err = h.jobs.Create(c.Request().Context(), job); err != nil {
+			return httpserver.RespondErrorWithCode(
+				c, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to queue chat export")
+		}
+		return httpserver.RespondJSON(c, http.StatusAccepted, toChatExportJobResponse(job))
+	}
+
+	// Small transcripts are buffered, so a failure halfway does not leave a truncated download
+	var buf bytes.Buffer
+	if _, err = h.exporter.Export(c.Request().Context(), chatID, format, &buf); err != nil {
+		return httpserver.RespondErrorWithCode(
+			c, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to export chat")
+	}
+	c.Response().Header().Set("Content-Disposition",
+		fmt.Sprintf("attachment; filename=%q", chatExportFileName(chatID, format)))
+	return c.Blob(http.StatusOK, format.ContentType(), buf.Bytes())
+}
+
+// Get handles GET /api/v1/chats/:chat_id/exports/:id.
+func (h *ChatExportHandler) Get(c echo.Context) error {
+	job, errResp := h.loadJob(c)
+	if errResp != nil {
+		return errResp()
+	}
+	return httpserver.RespondOK(c, toChatExportJobResponse(job))
+}
+
+// Download handles GET /api/v1/chats/:chat_id/exports/:id/download.
+// Only completed exports can be downloaded.
+func (h *ChatExportHandler) Download(c echo.Context) error {
+	job, errResp := h.loadJob(c)
+	if errResp != nil {
+		return errResp()
+	}
+	if job.Status != chatexport.JobStatusCompleted {
+		return httpserver.RespondErrorWithCode(
+			c, http.StatusConflict, "EXPORT_NOT_READY", "only completed exports can be downloaded")
+	}
+	if _, err := os.Stat(job.FilePath); err != nil {
+		return httpserver.RespondErrorWithCode(c, http.StatusNotFound, "NOT_FOUND", "transcript not found")
+	}
+
+	chatID, _ := uuid.ParseUUID(job.ChatID)
+	c.Response().Header().Set(echo.HeaderContentType, job.Format.ContentType())
+	c.Response().Header().Set("Content-Disposition",
+		fmt.Sprintf("attachment; filename=%q", chatExportFileName(chatID, job.Format)))
+	return c.File(job.FilePath)
+}
+
+// authorizeChat loads the chat of the request and verifies that the user is one
+// of its admins.
+func (h *ChatExportHandler) authorizeChat(c echo.Context) (*chatapp.ReadModel, func() error) {
+	userID := middleware.GetUserID(c)
+	if userID.IsZero() {
+		return nil, func() error {
+			return httpserver.RespondErrorWithCode(c, http.StatusUnauthorized, "UNAUTHORIZED", "authentication required")
+		}
+	}
+	chatID, err := uuid.ParseUUID(c.Param("chat_id"))
+	if err != nil {
+		return nil, func() error {
+			return httpserver.RespondErrorWithCode(c, http.StatusBadRequest, "INVALID_CHAT_ID", "invalid chat ID format")
+		}
+	}
+
+	rm, err := h.chats.FindByID(c.Request().Context(), chatID)
+	if err != nil {
+		return nil, func() error { return httpserver.RespondError(c, err) }
+	}
+	for _, p := range rm.Participants {
+		if p.UserID() == userID && p.IsAdmin() {
+			return rm, nil
+		}
+	}
+	return nil, func() error {
+		return httpserver.RespondErrorWithCode(
+			c, http.StatusForbidden, "FORBIDDEN", "only chat admins can export the chat")
+	}
+}
+
+// loadJob returns the export job of the request after checking that it belongs
+// to the chat and that the user may export that chat.
+func (h *ChatExportHandler) loadJob(c echo.Context) (*chatexport.Job, func() error) {
+	rm, errResp := h.authorizeChat(c)
+	if errResp != nil {
+		return nil, errResp
+	}
+
+	job, err := h.jobs.Get(c.Request().Context(), c.Param("id"))
+	if errors.Is(err, chatexport.ErrJobNotFound) || (err == nil && job.ChatID != rm.ID.String()) {
+		return nil, func() error {
+			return httpserver.RespondErrorWithCode(c, http.StatusNotFound, "NOT_FOUND", "chat export not found")
+		}
+	}
+	if err != nil {
+		return nil, func() error {
+			return httpserver.RespondErrorWithCode(
+				c, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to load chat export")
+		}
+	}
+	return job, nil
+}
+
+func chatExportFileName(chatID uuid.UUID, format chatexport.Format) string {
+	return "chat-" + chatID.String() + "." + format.Ext()
+}
+
+func toChatExportJobResponse(job *chatexport.Job) ChatExportJobResponse {
+	return ChatExportJobResponse{
+		ID:           job.ID,
+		ChatID:       job.ChatID,
+		Format:       string(job.Format),
+		Status:       string(job.Status),
+		MessageCount: job.MessageCount,
+		Error:        job.Error,
+		CreatedAt:    job.CreatedAt,
+		FinishedAt:   job.FinishedAt,
+	}
+}
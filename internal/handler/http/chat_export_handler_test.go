@@ -0,0 +1,192 @@
+package httphandler_test
+
+import (
+	"context"
+	"io"
+	stdhttp "net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	chatapp "github.com/lllypuk/flowra/internal/application/chat"
+	"github.com/lllypuk/flowra/internal/domain/chat"
+	"github.com/lllypuk/flowra/internal/domain/errs"
+	"github.com/lllypuk/flowra/internal/domain/uuid"
+	httphandler "github.com/lllypuk/flowra/internal/handler/http"
+	"github.com/lllypuk/flowra/internal/infrastructure/chatexport"
+	"github.com/lllypuk/flowra/internal/middleware"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeChatExportJobs struct {
+	jobs []*chatexport.Job
+}
+
+func (f *fakeChatExportJobs) Create(_ context.Context, job *chatexport.Job) error {
+	f.jobs = append(f.jobs, job)
+	return nil
+}
+
+func (f *fakeChatExportJobs) Get(_ context.Context, id string) (*chatexport.Job, error) {
+	for _, job := range f.jobs {
+		if job.ID == id {
+			return job, nil
+		}
+	}
+	return nil, chatexport.ErrJobNotFound
+}
+
+type fakeChatExportChats struct {
+	chats map[uuid.UUID]*chatapp.ReadModel
+}
+
+func (f fakeChatExportChats) FindByID(_ context.Context, chatID uuid.UUID) (*chatapp.ReadModel, error) {
+	if rm, ok := f.chats[chatID]; ok {
+		return rm, nil
+	}
+	return nil, errs.ErrNotFound
+}
+
+type fakeChatExportRenderer struct{}
+
+func (fakeChatExportRenderer) Export(
+	_ context.Context,
+	_ uuid.UUID,
+	format chatexport.Format,
+	w io.Writer,
+) (int, error) {
+	_, err := io.WriteString(w, "transcript."+format.Ext())
+	return 1, err
+}
+
+type chatExportFixture struct {
+	admin   uuid.UUID
+	member  uuid.UUID
+	chat    *chatapp.ReadModel
+	jobs    *fakeChatExportJobs
+	handler *httphandler.ChatExportHandler
+}
+
+func newChatExportFixture(t *testing.T, messageCount int) *chatExportFixture {
+	t.Helper()
+	admin, member := uuid.NewUUID(), uuid.NewUUID()
+	rm := &chatapp.ReadModel{
+		ID:           uuid.NewUUID(),
+		WorkspaceID:  uuid.NewUUID(),
+		MessageCount: messageCount,
+		Participants: []chat.Participant{
+			chat.NewParticipant(admin, chat.RoleAdmin),
+			chat.NewParticipant(member, chat.RoleMember),
+		},
+	}
+	jobs := &fakeChatExportJobs{}
+	chats := fakeChatExportChats{chats: map[uuid.UUID]*chatapp.ReadModel{rm.ID: rm}}
+	return &chatExportFixture{
+		admin:   admin,
+		member:  member,
+		chat:    rm,
+		jobs:    jobs,
+		handler: httphandler.NewChatExportHandler(jobs, chats, fakeChatExportRenderer{}, t.TempDir(), 10),
+	}
+}
+
+func newChatExportContext(
+	method, query string,
+	userID, chatID uuid.UUID,
+	jobID string,
+) (echo.Context, *httptest.ResponseRecorder) {
+	req := httptest.NewRequest(method, "/"+query, nil)
+	rec := httptest.NewRecorder()
+	c := echo.New().NewContext(req, rec)
+	names, values := []string{"chat_id"}, []string{chatID.String()}
+	if jobID != "" {
+		names, values = append(names, "id"), append(values, jobID)
+	}
+	c.SetParamNames(names...)
+	c.SetParamValues(values...)
+	c.Set(string(middleware.ContextKeyUserID), userID)
+	return c, rec
+}
+
+func TestChatExportHandler_Create(t *testing.T) {
+	t.Run("exports small chats within the request", func(t *testing.T) {
+		f := newChatExportFixture(t, 3)
+
+		c, rec := newChatExportContext(stdhttp.MethodPost, "?format=html", f.admin, f.chat.ID, "")
+		require.NoError(t, f.handler.Create(c))
+		assert.Equal(t, stdhttp.StatusOK, rec.Code)
+		assert.Equal(t, "transcript.html", rec.Body.String())
+		assert.Contains(t, rec.Header().Get("Content-Disposition"), "chat-"+f.chat.ID.String()+".html")
+		assert.Empty(t, f.jobs.jobs)
+	})
+
+	t.Run("queues large chats for the worker", func(t *testing.T) {
+		f := newChatExportFixture(t, 11)
+
+		c, rec := newChatExportContext(stdhttp.MethodPost, "", f.admin, f.chat.ID, "")
+		require.NoError(t, f.handler.Create(c))
+		assert.Equal(t, stdhttp.StatusAccepted, rec.Code)
+		require.Len(t, f.jobs.jobs, 1)
+		assert.Equal(t, chatexport.FormatJSON, f.jobs.jobs[0].Format)
+		assert.Equal(t, f.chat.ID.String(), f.jobs.jobs[0].ChatID)
+		assert.Equal(t, f.admin.String(), f.jobs.jobs[0].RequestedBy)
+	})
+
+	t.Run("rejects members who are not admins", func(t *testing.T) {
+		f := newChatExportFixture(t, 3)
+
+		c, rec := newChatExportContext(stdhttp.MethodPost, "", f.member, f.chat.ID, "")
+		require.NoError(t, f.handler.Create(c))
+		assert.Equal(t, stdhttp.StatusForbidden, rec.Code)
+	})
+
+	t.Run("rejects unknown formats", func(t *testing.T) {
+		f := newChatExportFixture(t, 3)
+
+		c, rec := newChatExportContext(stdhttp.MethodPost, "?format=pdf", f.admin, f.chat.ID, "")
+		require.NoError(t, f.handler.Create(c))
+		assert.Equal(t, stdhttp.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("returns not found for unknown chats", func(t *testing.T) {
+		f := newChatExportFixture(t, 3)
+
+		c, rec := newChatExportContext(stdhttp.MethodPost, "", f.admin, uuid.NewUUID(), "")
+		require.NoError(t, f.handler.Create(c))
+		assert.Equal(t, stdhttp.StatusNotFound, rec.Code)
+	})
+}
+
+func TestChatExportHandler_Download(t *testing.T) {
+	f := newChatExportFixture(t, 11)
+	job := chatexport.NewJob(f.chat.ID, f.chat.WorkspaceID, f.admin, chatexport.FormatJSON, t.TempDir())
+	f.jobs.jobs = append(f.jobs.jobs, job)
+
+	c, rec := newChatExportContext(stdhttp.MethodGet, "", f.admin, f.chat.ID, job.ID)
+	require.NoError(t, f.handler.Download(c))
+	assert.Equal(t, stdhttp.StatusConflict, rec.Code, "pending exports cannot be downloaded")
+
+	require.NoError(t, os.WriteFile(job.FilePath, []byte(`{"messages":[]}`), 0o600))
+	job.Status = chatexport.JobStatusCompleted
+
+	c, rec = newChatExportContext(stdhttp.MethodGet, "", f.admin, f.chat.ID, job.ID)
+	require.NoError(t, f.handler.Download(c))
+	assert.Equal(t, stdhttp.StatusOK, rec.Code)
+	assert.JSONEq(t, `{"messages":[]}`, rec.Body.String())
+
+	c, rec = newChatExportContext(stdhttp.MethodGet, "", f.member, f.chat.ID, job.ID)
+	require.NoError(t, f.handler.Download(c))
+	assert.Equal(t, stdhttp.StatusForbidden, rec.Code)
+}
+
+func TestChatExportHandler_Get_OtherChat(t *testing.T) {
+	f := newChatExportFixture(t, 11)
+	job := chatexport.NewJob(uuid.NewUUID(), f.chat.WorkspaceID, f.admin, chatexport.FormatJSON, t.TempDir())
+	f.jobs.jobs = append(f.jobs.jobs, job)
+
+	c, rec := newChatExportContext(stdhttp.MethodGet, "", f.admin, f.chat.ID, job.ID)
+	require.NoError(t, f.handler.Get(c))
+	assert.Equal(t, stdhttp.StatusNotFound, rec.Code, "jobs of other chats are hidden")
+}
@@ -6,6 +6,7 @@ import (
 	"net/http"
 	"slices"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/labstack/echo/v4"
@@ -16,14 +17,15 @@ import (
 	"github.com/lllypuk/flowra/internal/infrastructure/httpserver"
 	"github.com/lllypuk/flowra/internal/infrastructure/websocket"
 	"github.com/lllypuk/flowra/internal/middleware"
+	"github.com/lllypuk/flowra/internal/pkg/validate"
 )
 
 // Validation constants for chat handler.
 const (
-	maxChatNameLength      = 100
 	defaultChatListLimit   = 20
 	maxChatListLimit       = 100
-	maxParticipantsPerChat = 100
+	defaultParticipantPage = 50
+	maxParticipantPage     = 200
 )
 
 // Chat type string constants for request parsing.
@@ -47,26 +49,37 @@ var (
 	ErrDirectChatMaxMembers  = errors.New("direct chats cannot have more than 2 participants")
 	ErrChatNameRequired      = errors.New("chat name is required")
 	ErrChatNameTooLong       = errors.New("chat name is too long")
+	ErrChatTopicTooLong      = errors.New("chat topic is too long")
 	ErrInvalidParticipantIDs = errors.New("invalid participant IDs")
 )
 
 // CreateChatRequest represents the request to create a chat.
 type CreateChatRequest struct {
-	Name           string      `json:"name"            form:"name"`
-	Type           string      `json:"type"            form:"type"`
+	Name           string      `json:"name"            form:"name"            validate:"max=100"`
+	Type           string      `json:"type"            form:"type"            validate:"chat_type"`
 	IsPublic       bool        `json:"is_public"       form:"is_public"`
-	ParticipantIDs []uuid.UUID `json:"participant_ids" form:"participant_ids"`
+	ParticipantIDs []uuid.UUID `json:"participant_ids" form:"participant_ids" validate:"max=100,uuid"`
 }
 
 // UpdateChatRequest represents the request to update a chat.
 type UpdateChatRequest struct {
-	Name string `json:"name" form:"name"`
+	Name string `json:"name" form:"name" validate:"required,max=100"`
+}
+
+// SetChatTopicRequest represents the request to change the chat topic.
+type SetChatTopicRequest struct {
+	Topic string `json:"topic" form:"topic" validate:"max=250"`
 }
 
 // AddParticipantRequest represents the request to add a participant.
 type AddParticipantRequest struct {
-	UserID uuid.UUID `json:"user_id" form:"user_id"`
-	Role   string    `json:"role"    form:"role"`
+	UserID uuid.UUID `json:"user_id" form:"user_id" validate:"required,uuid"`
+	Role   string    `json:"role"    form:"role"    validate:"role"`
+}
+
+// TransferOwnershipRequest represents the request to transfer chat ownership.
+type TransferOwnershipRequest struct {
+	UserID uuid.UUID `json:"user_id" form:"user_id" validate:"required,uuid"`
 }
 
 // ChatResponse represents a chat in API responses.
@@ -74,11 +87,17 @@ type ChatResponse struct {
 	ID           uuid.UUID             `json:"id"`
 	WorkspaceID  uuid.UUID             `json:"workspace_id"`
 	Name         string                `json:"name"`
+	Topic        string                `json:"topic,omitempty"`
 	Type         string                `json:"type"`
 	IsPublic     bool                  `json:"is_public"`
 	CreatedBy    uuid.UUID             `json:"created_by"`
 	CreatedAt    string                `json:"created_at"`
+	UnreadCount  int                   `json:"unread_count,omitempty"`
+	IsStarred    bool                  `json:"is_starred,omitempty"`
 	Participants []ParticipantResponse `json:"participants,omitempty"`
+	// ParticipantCount is always set; the chat detail omits the participant list,
+	// which is served paginated by GET /chats/:id/participants.
+	ParticipantCount int `json:"participant_count"`
 	// Task-specific fields
 	Status     *string    `json:"status,omitempty"`
 	AssignedTo *uuid.UUID `json:"assigned_to,omitempty"`
@@ -86,13 +105,50 @@ type ChatResponse struct {
 	DueDate    *string    `json:"due_date,omitempty"`
 	// Bug-specific fields
 	Severity *string `json:"severity,omitempty"`
+	// ConversionHistory lists type changes (e.g. discussion -> task), oldest first
+	ConversionHistory []TypeConversionResponse `json:"conversion_history,omitempty"`
+	// Activity fields; chat lists are ordered by LastActivityAt
+	LastActivityAt *string              `json:"last_activity_at,omitempty"`
+	LastMessage    *LastMessageResponse `json:"last_message,omitempty"`
+}
+
+// LastMessageResponse is the preview of a chat's newest message.
+// Content is an excerpt and is empty for deleted messages.
+type LastMessageResponse struct {
+	MessageID uuid.UUID `json:"message_id"`
+	AuthorID  uuid.UUID `json:"author_id"`
+	Content   string    `json:"content"`
+	CreatedAt string    `json:"created_at"`
+	IsDeleted bool      `json:"is_deleted"`
+}
+
+// ChatUnreadResponse represents the unread counter of a chat for the current user.
+type ChatUnreadResponse struct {
+	ChatID      uuid.UUID `json:"chat_id"`
+	UnreadCount int       `json:"unread_count"`
+}
+
+// TypeConversionResponse represents a chat type change in API responses.
+type TypeConversionResponse struct {
+	FromType    string    `json:"from_type"`
+	ToType      string    `json:"to_type"`
+	ConvertedBy uuid.UUID `json:"converted_by"`
+	ConvertedAt string    `json:"converted_at"`
 }
 
 // ParticipantResponse represents a chat participant in API responses.
 type ParticipantResponse struct {
-	UserID   uuid.UUID `json:"user_id"`
-	Role     string    `json:"role"`
-	JoinedAt string    `json:"joined_at"`
+	UserID      uuid.UUID `json:"user_id"`
+	Role        string    `json:"role"`
+	JoinedAt    string    `json:"joined_at"`
+	DisplayName string    `json:"display_name,omitempty"`
+}
+
+// ParticipantListResponse represents a page of chat participants in API responses.
+type ParticipantListResponse struct {
+	Participants []ParticipantResponse `json:"participants"`
+	Total        int                   `json:"total"`
+	HasMore      bool                  `json:"has_more"`
 }
 
 // ChatListResponse represents a list of chats in API responses.
@@ -117,14 +173,35 @@ type ChatService interface {
 	// RenameChat renames a chat.
 	RenameChat(ctx context.Context, cmd chatapp.RenameChatCommand) (chatapp.Result, error)
 
+	// SetTopic changes or clears the chat topic.
+	SetTopic(ctx context.Context, cmd chatapp.SetTopicCommand) (chatapp.Result, error)
+
 	// AddParticipant adds a participant to a chat.
 	AddParticipant(ctx context.Context, cmd chatapp.AddParticipantCommand) (chatapp.Result, error)
 
 	// RemoveParticipant removes a participant from a chat.
 	RemoveParticipant(ctx context.Context, cmd chatapp.RemoveParticipantCommand) (chatapp.Result, error)
 
+	// TransferOwnership makes another participant the chat owner.
+	TransferOwnership(ctx context.Context, cmd chatapp.TransferOwnershipCommand) (chatapp.Result, error)
+
+	// ListParticipants lists a page of chat participants.
+	ListParticipants(ctx context.Context, query chatapp.ListParticipantsQuery) (*chatapp.ListParticipantsResult, error)
+
 	// DeleteChat deletes a chat (soft delete via event).
 	DeleteChat(ctx context.Context, chatID, deletedBy uuid.UUID) error
+
+	// MarkChatRead resets the user's unread counter of a chat.
+	MarkChatRead(ctx context.Context, cmd chatapp.MarkChatReadCommand) error
+
+	// GetUnreadCount returns the number of chat messages the user has not read.
+	GetUnreadCount(ctx context.Context, query chatapp.GetUnreadCountQuery) (*chatapp.GetUnreadCountResult, error)
+
+	// StarChat adds a chat to the user's starred chats.
+	StarChat(ctx context.Context, cmd chatapp.StarChatCommand) error
+
+	// UnstarChat removes a chat from the user's starred chats.
+	UnstarChat(ctx context.Context, cmd chatapp.UnstarChatCommand) error
 }
 
 // ChatHandler handles chat-related HTTP requests.
@@ -160,11 +237,21 @@ func (h *ChatHandler) RegisterRoutes(r *httpserver.Router) {
 	r.Auth().DELETE("/chats/:id", h.Delete)
 
 	// Participant management
+	r.Auth().GET("/chats/:id/participants", h.ListParticipants)
 	r.Auth().POST("/chats/:id/participants", h.AddParticipant)
 	r.Auth().DELETE("/chats/:id/participants/:user_id", h.RemoveParticipant)
+	r.Auth().POST("/chats/:id/transfer-ownership", h.TransferOwnership)
 
 	// Presence
 	r.Auth().GET("/chats/:id/presence", h.GetPresence)
+
+	// Read markers
+	r.Auth().POST("/chats/:id/read", h.MarkRead)
+	r.Auth().GET("/chats/:id/unread", h.Unread)
+
+	// Stars
+	r.Auth().PUT("/chats/:id/star", h.Star)
+	r.Auth().DELETE("/chats/:id/star", h.Unstar)
 }
 
 // Create handles POST /api/v1/workspaces/:workspace_id/chats.
@@ -188,10 +275,8 @@ func (h *ChatHandler) Create(c echo.Context) error {
 			c, http.StatusBadRequest, "INVALID_REQUEST", "invalid request body")
 	}
 
-	// Validate request
 	if valErr := validateCreateChatRequest(&req); valErr != nil {
-		return httpserver.RespondErrorWithCode(
-			c, http.StatusBadRequest, "VALIDATION_ERROR", valErr.Error())
+		return httpserver.RespondValidationError(c, valErr)
 	}
 
 	// Parse chat type
@@ -296,6 +381,7 @@ func (h *ChatHandler) List(c echo.Context) error {
 	query := chatapp.ListChatsQuery{
 		WorkspaceID: workspaceID,
 		Type:        typeFilter,
+		StarredOnly: c.QueryParam("starred") == "true",
 		Limit:       limit,
 		Offset:      offset,
 		RequestedBy: userID,
@@ -318,7 +404,7 @@ func (h *ChatHandler) List(c echo.Context) error {
 		HasMore: result.HasMore,
 	}
 
-	return httpserver.RespondOK(c, resp)
+	return httpserver.RespondPage(c, resp, httpserver.NewPagination(offset, limit, result.Total))
 }
 
 // Update handles PUT /api/v1/chats/:id.
@@ -342,14 +428,8 @@ func (h *ChatHandler) Update(c echo.Context) error {
 			c, http.StatusBadRequest, "INVALID_REQUEST", "invalid request body")
 	}
 
-	// Validate
-	if req.Name == "" {
-		return httpserver.RespondErrorWithCode(
-			c, http.StatusBadRequest, "VALIDATION_ERROR", ErrChatNameRequired.Error())
-	}
-	if len(req.Name) > maxChatNameLength {
-		return httpserver.RespondErrorWithCode(
-			c, http.StatusBadRequest, "VALIDATION_ERROR", ErrChatNameTooLong.Error())
+	if valErr := validateRequest(&req); valErr != nil {
+		return httpserver.RespondValidationError(c, valErr)
 	}
 
 	cmd := chatapp.RenameChatCommand{
@@ -367,6 +447,44 @@ func (h *ChatHandler) Update(c echo.Context) error {
 	return httpserver.RespondOK(c, resp)
 }
 
+// SetTopic handles PUT /api/v1/chats/:id/topic.
+// An empty topic clears it.
+func (h *ChatHandler) SetTopic(c echo.Context) error {
+	userID := middleware.GetUserID(c)
+	if userID.IsZero() {
+		return httpserver.RespondErrorWithCode(c, http.StatusUnauthorized, "UNAUTHORIZED", "authentication required")
+	}
+
+	chatID, parseErr := uuid.ParseUUID(c.Param("id"))
+	if parseErr != nil {
+		return httpserver.RespondErrorWithCode(
+			c, http.StatusBadRequest, "INVALID_CHAT_ID", "invalid chat ID format")
+	}
+
+	var req SetChatTopicRequest
+	if bindErr := c.Bind(&req); bindErr != nil {
+		return httpserver.RespondErrorWithCode(
+			c, http.StatusBadRequest, "INVALID_REQUEST", "invalid request body")
+	}
+
+	req.Topic = strings.TrimSpace(req.Topic)
+	if valErr := validateRequest(&req); valErr != nil {
+		return httpserver.RespondValidationError(c, valErr)
+	}
+	topic := req.Topic
+
+	result, err := h.chatService.SetTopic(c.Request().Context(), chatapp.SetTopicCommand{
+		ChatID: chatID,
+		Topic:  topic,
+		SetBy:  userID,
+	})
+	if err != nil {
+		return handleChatError(c, err)
+	}
+
+	return httpserver.RespondOK(c, ToChatResponse(result.Value))
+}
+
 // Delete handles DELETE /api/v1/chats/:id.
 // Deletes a chat.
 func (h *ChatHandler) Delete(c echo.Context) error {
@@ -390,6 +508,175 @@ func (h *ChatHandler) Delete(c echo.Context) error {
 	return httpserver.RespondNoContent(c)
 }
 
+// MarkRead handles POST /api/v1/chats/:id/read.
+// Marks all messages of the chat as read by the current user.
+func (h *ChatHandler) MarkRead(c echo.Context) error {
+	userID := middleware.GetUserID(c)
+	if userID.IsZero() {
+		return httpserver.RespondErrorWithCode(c, http.StatusUnauthorized, "UNAUTHORIZED", "authentication required")
+	}
+
+	chatIDStr := c.Param("id")
+	chatID, parseErr := uuid.ParseUUID(chatIDStr)
+	if parseErr != nil {
+		return httpserver.RespondErrorWithCode(
+			c, http.StatusBadRequest, "INVALID_CHAT_ID", "invalid chat ID format")
+	}
+
+	cmd := chatapp.MarkChatReadCommand{
+		ChatID: chatID,
+		UserID: userID,
+	}
+	if err := h.chatService.MarkChatRead(c.Request().Context(), cmd); err != nil {
+		return handleChatError(c, err)
+	}
+
+	return httpserver.RespondNoContent(c)
+}
+
+// Unread handles GET /api/v1/chats/:id/unread.
+// Returns the number of chat messages the current user has not read.
+func (h *ChatHandler) Unread(c echo.Context) error {
+	userID := middleware.GetUserID(c)
+	if userID.IsZero() {
+		return httpserver.RespondErrorWithCode(c, http.StatusUnauthorized, "UNAUTHORIZED", "authentication required")
+	}
+
+	chatIDStr := c.Param("id")
+	chatID, parseErr := uuid.ParseUUID(chatIDStr)
+	if parseErr != nil {
+		return httpserver.RespondErrorWithCode(
+			c, http.StatusBadRequest, "INVALID_CHAT_ID", "invalid chat ID format")
+	}
+
+	query := chatapp.GetUnreadCountQuery{
+		ChatID:      chatID,
+		RequestedBy: userID,
+	}
+	result, err := h.chatService.GetUnreadCount(c.Request().Context(), query)
+	if err != nil {
+		return handleChatError(c, err)
+	}
+
+	return httpserver.RespondOK(c, ChatUnreadResponse{
+		ChatID:      result.ChatID,
+		UnreadCount: result.UnreadCount,
+	})
+}
+
+// Star handles PUT /api/v1/chats/:id/star and PUT /api/v1/workspaces/:workspace_id/tasks/:task_id/star.
+// Stars the chat or task for the current user; starring twice is a no-op.
+func (h *ChatHandler) Star(c echo.Context) error {
+	userID := middleware.GetUserID(c)
+	if userID.IsZero() {
+		return httpserver.RespondErrorWithCode(c, http.StatusUnauthorized, "UNAUTHORIZED", "authentication required")
+	}
+
+	chatID, parseErr := parseStarTargetID(c)
+	if parseErr != nil {
+		return httpserver.RespondErrorWithCode(
+			c, http.StatusBadRequest, "INVALID_CHAT_ID", "invalid chat ID format")
+	}
+
+	cmd := chatapp.StarChatCommand{
+		ChatID: chatID,
+		UserID: userID,
+	}
+	if err := h.chatService.StarChat(c.Request().Context(), cmd); err != nil {
+		return handleChatError(c, err)
+	}
+
+	return httpserver.RespondNoContent(c)
+}
+
+// Unstar handles DELETE /api/v1/chats/:id/star and DELETE /api/v1/workspaces/:workspace_id/tasks/:task_id/star.
+// Removes the star of the current user from the chat or task.
+func (h *ChatHandler) Unstar(c echo.Context) error {
+	userID := middleware.GetUserID(c)
+	if userID.IsZero() {
+		return httpserver.RespondErrorWithCode(c, http.StatusUnauthorized, "UNAUTHORIZED", "authentication required")
+	}
+
+	chatID, parseErr := parseStarTargetID(c)
+	if parseErr != nil {
+		return httpserver.RespondErrorWithCode(
+			c, http.StatusBadRequest, "INVALID_CHAT_ID", "invalid chat ID format")
+	}
+
+	cmd := chatapp.UnstarChatCommand{
+		ChatID: chatID,
+		UserID: userID,
+	}
+	if err := h.chatService.UnstarChat(c.Request().Context(), cmd); err != nil {
+		return handleChatError(c, err)
+	}
+
+	return httpserver.RespondNoContent(c)
+}
+
+// parseStarTargetID reads the starred chat ID; task routes pass the task ID,
+// which equals the ID of the task's chat.
+func parseStarTargetID(c echo.Context) (uuid.UUID, error) {
+	idStr := c.Param("id")
+	if idStr == "" {
+		idStr = c.Param("task_id")
+	}
+	return uuid.ParseUUID(idStr)
+}
+
+// ListParticipants handles GET /api/v1/chats/:id/participants.
+// Lists chat participants with pagination, an optional role filter and name search (q).
+func (h *ChatHandler) ListParticipants(c echo.Context) error {
+	userID := middleware.GetUserID(c)
+	if userID.IsZero() {
+		return httpserver.RespondErrorWithCode(c, http.StatusUnauthorized, "UNAUTHORIZED", "authentication required")
+	}
+
+	chatIDStr := c.Param("id")
+	chatID, parseErr := uuid.ParseUUID(chatIDStr)
+	if parseErr != nil {
+		return httpserver.RespondErrorWithCode(
+			c, http.StatusBadRequest, "INVALID_CHAT_ID", "invalid chat ID format")
+	}
+
+	query := chatapp.ListParticipantsQuery{
+		ChatID:      chatID,
+		Search:      c.QueryParam("q"),
+		RequestedBy: userID,
+	}
+	query.Limit, query.Offset = parsePagination(c, defaultParticipantPage, maxParticipantPage)
+
+	if roleStr := c.QueryParam("role"); roleStr != "" {
+		role := chat.Role(strings.ToLower(roleStr))
+		if role != chat.RoleAdmin && role != chat.RoleMember {
+			return httpserver.RespondErrorWithCode(
+				c, http.StatusBadRequest, "INVALID_ROLE", "role must be admin or member")
+		}
+		query.Role = &role
+	}
+
+	result, err := h.chatService.ListParticipants(c.Request().Context(), query)
+	if err != nil {
+		return handleChatError(c, err)
+	}
+
+	participants := make([]ParticipantResponse, 0, len(result.Participants))
+	for _, p := range result.Participants {
+		participants = append(participants, ParticipantResponse{
+			UserID:      p.UserID,
+			Role:        string(p.Role),
+			JoinedAt:    p.JoinedAt.Format(time.RFC3339),
+			DisplayName: p.DisplayName,
+		})
+	}
+
+	return httpserver.RespondPage(c, ParticipantListResponse{
+		Participants: participants,
+		Total:        result.Total,
+		HasMore:      result.HasMore,
+	}, httpserver.NewPagination(query.Offset, query.Limit, result.Total))
+}
+
 // AddParticipant handles POST /api/v1/chats/:id/participants.
 // Adds a participant to the chat.
 func (h *ChatHandler) AddParticipant(c echo.Context) error {
@@ -411,8 +698,8 @@ func (h *ChatHandler) AddParticipant(c echo.Context) error {
 			c, http.StatusBadRequest, "INVALID_REQUEST", "invalid request body")
 	}
 
-	if req.UserID.IsZero() {
-		return httpserver.RespondErrorWithCode(c, http.StatusBadRequest, "VALIDATION_ERROR", "user_id is required")
+	if valErr := validateRequest(&req); valErr != nil {
+		return httpserver.RespondValidationError(c, valErr)
 	}
 
 	// Parse role
@@ -437,6 +724,45 @@ func (h *ChatHandler) AddParticipant(c echo.Context) error {
 	return httpserver.RespondCreated(c, resp)
 }
 
+// TransferOwnership handles POST /api/v1/chats/:id/transfer-ownership.
+// Makes another participant the chat owner.
+func (h *ChatHandler) TransferOwnership(c echo.Context) error {
+	userID := middleware.GetUserID(c)
+	if userID.IsZero() {
+		return httpserver.RespondErrorWithCode(c, http.StatusUnauthorized, "UNAUTHORIZED", "authentication required")
+	}
+
+	chatIDStr := c.Param("id")
+	chatID, parseErr := uuid.ParseUUID(chatIDStr)
+	if parseErr != nil {
+		return httpserver.RespondErrorWithCode(
+			c, http.StatusBadRequest, "INVALID_CHAT_ID", "invalid chat ID format")
+	}
+
+	var req TransferOwnershipRequest
+	if bindErr := c.Bind(&req); bindErr != nil {
+		return httpserver.RespondErrorWithCode(
+			c, http.StatusBadRequest, "INVALID_REQUEST", "invalid request body")
+	}
+
+	if valErr := validateRequest(&req); valErr != nil {
+		return httpserver.RespondValidationError(c, valErr)
+	}
+
+	cmd := chatapp.TransferOwnershipCommand{
+		ChatID:        chatID,
+		NewOwnerID:    req.UserID,
+		TransferredBy: userID,
+	}
+
+	result, err := h.chatService.TransferOwnership(c.Request().Context(), cmd)
+	if err != nil {
+		return handleChatError(c, err)
+	}
+
+	return httpserver.RespondOK(c, ToChatResponse(result.Value))
+}
+
 // RemoveParticipant handles DELETE /api/v1/chats/:id/participants/:user_id.
 // Removes a participant from the chat.
 func (h *ChatHandler) RemoveParticipant(c echo.Context) error {
@@ -476,33 +802,12 @@ func (h *ChatHandler) RemoveParticipant(c echo.Context) error {
 // Helper functions
 
 func validateCreateChatRequest(req *CreateChatRequest) error {
-	// Name validation for non-discussion types
-	if req.Type != "" && req.Type != chatTypeDiscussion {
-		if req.Name == "" {
-			return ErrChatNameRequired
-		}
+	if err := validateRequest(req); err != nil {
+		return err
 	}
-	if len(req.Name) > maxChatNameLength {
-		return ErrChatNameTooLong
-	}
-	if len(req.ParticipantIDs) > maxParticipantsPerChat {
-		return ErrTooManyParticipants
-	}
-	// Validate type if provided
-	if req.Type != "" {
-		validTypes := []string{
-			chatTypeDiscussion,
-			chatTypeTask,
-			chatTypeBug,
-			chatTypeEpic,
-			"direct",
-			"group",
-			"channel",
-		}
-		valid := slices.Contains(validTypes, req.Type)
-		if !valid {
-			return ErrInvalidChatType
-		}
+	// Only discussions may be created without a name
+	if req.Type != "" && req.Type != chatTypeDiscussion && req.Name == "" {
+		return validate.Errors{{Field: "name", Rule: validate.RuleRequired, Message: "name is required"}}
 	}
 	return nil
 }
@@ -537,12 +842,18 @@ func parseParticipantRole(roleStr string) chat.Role {
 }
 
 func parseChatPagination(c echo.Context) (int, int) {
-	limit := defaultChatListLimit
+	return parsePagination(c, defaultChatListLimit, maxChatListLimit)
+}
+
+// parsePagination parses limit and offset query parameters within the given bounds.
+// A page cursor takes precedence over offset.
+func parsePagination(c echo.Context, defaultLimit, maxLimit int) (int, int) {
+	limit := defaultLimit
 	offset := 0
 
 	if limitStr := c.QueryParam("limit"); limitStr != "" {
 		if l, err := strconv.Atoi(limitStr); err == nil && l > 0 {
-			limit = min(l, maxChatListLimit)
+			limit = min(l, maxLimit)
 		}
 	}
 
@@ -551,6 +862,9 @@ func parseChatPagination(c echo.Context) (int, int) {
 			offset = o
 		}
 	}
+	if o, ok := httpserver.CursorOffset(c); ok {
+		offset = o
+	}
 
 	return limit, offset
 }
@@ -637,6 +951,7 @@ func ToChatResponse(ch *chat.Chat) ChatResponse {
 		ID:          ch.ID(),
 		WorkspaceID: ch.WorkspaceID(),
 		Name:        ch.Title(),
+		Topic:       ch.Topic(),
 		Type:        string(ch.Type()),
 		IsPublic:    ch.IsPublic(),
 		CreatedBy:   ch.CreatedBy(),
@@ -645,6 +960,7 @@ func ToChatResponse(ch *chat.Chat) ChatResponse {
 
 	// Add participants
 	participants := ch.Participants()
+	resp.ParticipantCount = len(participants)
 	resp.Participants = make([]ParticipantResponse, 0, len(participants))
 	for _, p := range participants {
 		resp.Participants = append(resp.Participants, ParticipantResponse{
@@ -680,29 +996,32 @@ func ToChatResponse(ch *chat.Chat) ChatResponse {
 		}
 	}
 
+	for _, tc := range ch.ConversionHistory() {
+		resp.ConversionHistory = append(resp.ConversionHistory, TypeConversionResponse{
+			FromType:    string(tc.FromType()),
+			ToType:      string(tc.ToType()),
+			ConvertedBy: tc.ConvertedBy(),
+			ConvertedAt: tc.ConvertedAt().Format(time.RFC3339),
+		})
+	}
+
 	return resp
 }
 
 // ToChatResponseFromDTO converts a chatapp.Chat DTO to ChatResponse.
 func ToChatResponseFromDTO(ch *chatapp.Chat) ChatResponse {
 	resp := ChatResponse{
-		ID:          ch.ID,
-		WorkspaceID: ch.WorkspaceID,
-		Name:        ch.Title,
-		Type:        string(ch.Type),
-		IsPublic:    ch.IsPublic,
-		CreatedBy:   ch.CreatedBy,
-		CreatedAt:   ch.CreatedAt.Format(time.RFC3339),
-	}
-
-	// Add participants
-	resp.Participants = make([]ParticipantResponse, 0, len(ch.Participants))
-	for _, p := range ch.Participants {
-		resp.Participants = append(resp.Participants, ParticipantResponse{
-			UserID:   p.UserID,
-			Role:     string(p.Role),
-			JoinedAt: p.JoinedAt.Format(time.RFC3339),
-		})
+		ID:               ch.ID,
+		WorkspaceID:      ch.WorkspaceID,
+		Name:             ch.Title,
+		Topic:            ch.Topic,
+		Type:             string(ch.Type),
+		IsPublic:         ch.IsPublic,
+		CreatedBy:        ch.CreatedBy,
+		CreatedAt:        ch.CreatedAt.Format(time.RFC3339),
+		UnreadCount:      ch.UnreadCount,
+		IsStarred:        ch.IsStarred,
+		ParticipantCount: ch.ParticipantCount,
 	}
 
 	// Add task-specific fields
@@ -723,6 +1042,29 @@ func ToChatResponseFromDTO(ch *chatapp.Chat) ChatResponse {
 		resp.Severity = ch.Severity
 	}
 
+	for _, tc := range ch.ConversionHistory {
+		resp.ConversionHistory = append(resp.ConversionHistory, TypeConversionResponse{
+			FromType:    string(tc.FromType),
+			ToType:      string(tc.ToType),
+			ConvertedBy: tc.ConvertedBy,
+			ConvertedAt: tc.ConvertedAt.Format(time.RFC3339),
+		})
+	}
+
+	if ch.LastActivityAt != nil {
+		formatted := ch.LastActivityAt.Format(time.RFC3339)
+		resp.LastActivityAt = &formatted
+	}
+	if ch.LastMessage != nil {
+		resp.LastMessage = &LastMessageResponse{
+			MessageID: ch.LastMessage.MessageID,
+			AuthorID:  ch.LastMessage.AuthorID,
+			Content:   ch.LastMessage.Content,
+			CreatedAt: ch.LastMessage.CreatedAt.Format(time.RFC3339),
+			IsDeleted: ch.LastMessage.IsDeleted,
+		}
+	}
+
 	return resp
 }
 
@@ -730,6 +1072,7 @@ func ToChatResponseFromDTO(ch *chatapp.Chat) ChatResponse {
 type MockChatService struct {
 	chats        map[uuid.UUID]*chat.Chat
 	participants map[uuid.UUID][]chat.Participant
+	stars        map[uuid.UUID]map[uuid.UUID]bool
 }
 
 // NewMockChatService creates a new mock chat service.
@@ -737,6 +1080,7 @@ func NewMockChatService() *MockChatService {
 	return &MockChatService{
 		chats:        make(map[uuid.UUID]*chat.Chat),
 		participants: make(map[uuid.UUID][]chat.Participant),
+		stars:        make(map[uuid.UUID]map[uuid.UUID]bool),
 	}
 }
 
@@ -801,6 +1145,16 @@ func (m *MockChatService) GetChat(_ context.Context, query chatapp.GetChatQuery)
 			JoinedAt: p.JoinedAt(),
 		})
 	}
+	dto.ParticipantCount = len(dto.Participants)
+
+	for _, tc := range ch.ConversionHistory() {
+		dto.ConversionHistory = append(dto.ConversionHistory, chatapp.TypeConversion{
+			FromType:    tc.FromType(),
+			ToType:      tc.ToType(),
+			ConvertedBy: tc.ConvertedBy(),
+			ConvertedAt: tc.ConvertedAt(),
+		})
+	}
 
 	return &chatapp.GetChatResult{
 		Chat: dto,
@@ -826,6 +1180,10 @@ func (m *MockChatService) ListChats(_ context.Context, query chatapp.ListChatsQu
 		if !ch.IsPublic() && !ch.HasParticipant(query.RequestedBy) {
 			continue
 		}
+		starred := m.stars[query.RequestedBy][ch.ID()]
+		if query.StarredOnly && !starred {
+			continue
+		}
 
 		chats = append(chats, chatapp.Chat{
 			ID:          ch.ID(),
@@ -835,9 +1193,15 @@ func (m *MockChatService) ListChats(_ context.Context, query chatapp.ListChatsQu
 			IsPublic:    ch.IsPublic(),
 			CreatedBy:   ch.CreatedBy(),
 			CreatedAt:   ch.CreatedAt(),
+			IsStarred:   starred,
 		})
 	}
 
+	// Map iteration order is random; sort so that pages do not overlap
+	slices.SortFunc(chats, func(a, b chatapp.Chat) int {
+		return strings.Compare(a.ID.String(), b.ID.String())
+	})
+
 	// Apply pagination
 	total := len(chats)
 	start := min(query.Offset, total)
@@ -864,6 +1228,20 @@ func (m *MockChatService) RenameChat(_ context.Context, cmd chatapp.RenameChatCo
 	return chatapp.Result{Result: appcore.Result[*chat.Chat]{Value: ch}}, nil
 }
 
+// SetTopic changes the chat topic in the mock service.
+func (m *MockChatService) SetTopic(_ context.Context, cmd chatapp.SetTopicCommand) (chatapp.Result, error) {
+	ch, ok := m.chats[cmd.ChatID]
+	if !ok {
+		return chatapp.Result{}, chatapp.ErrChatNotFound
+	}
+
+	if err := ch.SetTopic(cmd.Topic, cmd.SetBy); err != nil {
+		return chatapp.Result{}, err
+	}
+
+	return chatapp.Result{Result: appcore.Result[*chat.Chat]{Value: ch}}, nil
+}
+
 // AddParticipant adds a participant to a chat in the mock service.
 func (m *MockChatService) AddParticipant(_ context.Context, cmd chatapp.AddParticipantCommand) (chatapp.Result, error) {
 	ch, ok := m.chats[cmd.ChatID]
@@ -895,6 +1273,68 @@ func (m *MockChatService) RemoveParticipant(
 	return chatapp.Result{Result: appcore.Result[*chat.Chat]{Value: ch}}, nil
 }
 
+// TransferOwnership transfers chat ownership in the mock service.
+func (m *MockChatService) TransferOwnership(
+	_ context.Context,
+	cmd chatapp.TransferOwnershipCommand,
+) (chatapp.Result, error) {
+	ch, ok := m.chats[cmd.ChatID]
+	if !ok {
+		return chatapp.Result{}, chatapp.ErrChatNotFound
+	}
+	if ch.CreatedBy() != cmd.TransferredBy && !ch.IsParticipantAdmin(cmd.TransferredBy) {
+		return chatapp.Result{}, chatapp.ErrNotAdmin
+	}
+	if !ch.HasParticipant(cmd.NewOwnerID) {
+		return chatapp.Result{}, chatapp.ErrUserNotParticipant
+	}
+	if err := ch.TransferOwnership(cmd.NewOwnerID, cmd.TransferredBy); err != nil {
+		return chatapp.Result{}, err
+	}
+
+	return chatapp.Result{Result: appcore.Result[*chat.Chat]{Value: ch}}, nil
+}
+
+// ListParticipants lists a page of chat participants from the mock service.
+// Name search is not supported by the mock.
+func (m *MockChatService) ListParticipants(
+	_ context.Context,
+	query chatapp.ListParticipantsQuery,
+) (*chatapp.ListParticipantsResult, error) {
+	ch, ok := m.chats[query.ChatID]
+	if !ok {
+		return nil, chatapp.ErrChatNotFound
+	}
+	if !ch.IsPublic() && !ch.HasParticipant(query.RequestedBy) {
+		return nil, chatapp.ErrUserNotParticipant
+	}
+
+	participants := make([]chatapp.Participant, 0)
+	for _, p := range ch.Participants() {
+		if query.Role != nil && p.Role() != *query.Role {
+			continue
+		}
+		participants = append(participants, chatapp.Participant{
+			UserID:   p.UserID(),
+			Role:     p.Role(),
+			JoinedAt: p.JoinedAt(),
+		})
+	}
+
+	total := len(participants)
+	start := min(query.Offset, total)
+	end := total
+	if query.Limit > 0 {
+		end = min(start+query.Limit, total)
+	}
+
+	return &chatapp.ListParticipantsResult{
+		Participants: participants[start:end],
+		Total:        total,
+		HasMore:      end < total,
+	}, nil
+}
+
 // DeleteChat deletes a chat from the mock service.
 func (m *MockChatService) DeleteChat(_ context.Context, chatID, _ uuid.UUID) error {
 	if _, ok := m.chats[chatID]; !ok {
@@ -903,3 +1343,53 @@ func (m *MockChatService) DeleteChat(_ context.Context, chatID, _ uuid.UUID) err
 	delete(m.chats, chatID)
 	return nil
 }
+
+// MarkChatRead marks a chat as read in the mock service.
+func (m *MockChatService) MarkChatRead(_ context.Context, cmd chatapp.MarkChatReadCommand) error {
+	ch, ok := m.chats[cmd.ChatID]
+	if !ok {
+		return chatapp.ErrChatNotFound
+	}
+	if !ch.IsPublic() && !ch.HasParticipant(cmd.UserID) {
+		return chatapp.ErrUserNotParticipant
+	}
+	return nil
+}
+
+// GetUnreadCount returns the unread counter of a chat in the mock service.
+// The mock does not track messages, so every accessible chat is fully read.
+func (m *MockChatService) GetUnreadCount(
+	_ context.Context,
+	query chatapp.GetUnreadCountQuery,
+) (*chatapp.GetUnreadCountResult, error) {
+	ch, ok := m.chats[query.ChatID]
+	if !ok {
+		return nil, chatapp.ErrChatNotFound
+	}
+	if !ch.IsPublic() && !ch.HasParticipant(query.RequestedBy) {
+		return nil, chatapp.ErrUserNotParticipant
+	}
+	return &chatapp.GetUnreadCountResult{ChatID: query.ChatID}, nil
+}
+
+// StarChat stars a chat in the mock service.
+func (m *MockChatService) StarChat(_ context.Context, cmd chatapp.StarChatCommand) error {
+	ch, ok := m.chats[cmd.ChatID]
+	if !ok {
+		return chatapp.ErrChatNotFound
+	}
+	if !ch.IsPublic() && !ch.HasParticipant(cmd.UserID) {
+		return chatapp.ErrUserNotParticipant
+	}
+	if m.stars[cmd.UserID] == nil {
+		m.stars[cmd.UserID] = make(map[uuid.UUID]bool)
+	}
+	m.stars[cmd.UserID][cmd.ChatID] = true
+	return nil
+}
+
+// UnstarChat removes the star of a chat in the mock service.
+func (m *MockChatService) UnstarChat(_ context.Context, cmd chatapp.UnstarChatCommand) error {
+	delete(m.stars[cmd.UserID], cmd.ChatID)
+	return nil
+}
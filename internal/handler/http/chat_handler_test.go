@@ -7,6 +7,7 @@ import (
 	"net/http/httptest"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/labstack/echo/v4"
 	chatapp "github.com/lllypuk/flowra/internal/application/chat"
@@ -15,6 +16,7 @@ import (
 	httphandler "github.com/lllypuk/flowra/internal/handler/http"
 	"github.com/lllypuk/flowra/internal/infrastructure/httpserver"
 	"github.com/lllypuk/flowra/internal/middleware"
+	"github.com/lllypuk/flowra/internal/service"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -251,6 +253,40 @@ func TestChatHandler_Create(t *testing.T) {
 		assert.Equal(t, stdhttp.StatusBadRequest, rec.Code)
 	})
 
+	t.Run("invalid participant ID", func(t *testing.T) {
+		e := echo.New()
+		userID := uuid.NewUUID()
+		workspaceID := uuid.NewUUID()
+
+		mockService := httphandler.NewMockChatService()
+		handler := httphandler.NewChatHandler(mockService)
+
+		reqBody := `{"name": "Chat", "type": "discussion", "participant_ids": ["` +
+			uuid.NewUUID().String() + `", "bogus"]}`
+		req := httptest.NewRequest(
+			stdhttp.MethodPost, workspaceChatsURL(workspaceID), strings.NewReader(reqBody))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("workspace_id")
+		c.SetParamValues(workspaceID.String())
+
+		setupChatAuthContext(c, userID)
+
+		err := handler.Create(c)
+		require.NoError(t, err)
+		assert.Equal(t, stdhttp.StatusBadRequest, rec.Code)
+
+		var resp httpserver.Response
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+		assert.Equal(t, "VALIDATION_ERROR", resp.Error.Code)
+		assert.Equal(t, []any{map[string]any{
+			"field":   "participant_ids[1]",
+			"rule":    "uuid",
+			"message": "participant_ids[1] must be a valid UUID",
+		}}, resp.Error.Details["fields"])
+	})
+
 	t.Run("invalid JSON", func(t *testing.T) {
 		e := echo.New()
 		userID := uuid.NewUUID()
@@ -473,6 +509,52 @@ func TestChatHandler_List(t *testing.T) {
 		assert.Equal(t, stdhttp.StatusOK, rec.Code)
 	})
 
+	t.Run("cursor round trip", func(t *testing.T) {
+		userID := uuid.NewUUID()
+		workspaceID := uuid.NewUUID()
+
+		mockService := httphandler.NewMockChatService()
+		for range 3 {
+			mockService.AddChat(createTestChat(t, workspaceID, userID))
+		}
+		handler := httphandler.NewChatHandler(mockService)
+
+		list := func(t *testing.T, query string) (httphandler.ChatListResponse, *httpserver.Pagination) {
+			t.Helper()
+			req := httptest.NewRequest(stdhttp.MethodGet, workspaceChatsURL(workspaceID)+query, nil)
+			rec := httptest.NewRecorder()
+			c := echo.New().NewContext(req, rec)
+			c.SetParamNames("workspace_id")
+			c.SetParamValues(workspaceID.String())
+			setupChatAuthContext(c, userID)
+
+			require.NoError(t, handler.List(c))
+			require.Equal(t, stdhttp.StatusOK, rec.Code)
+			return decodeListPage[httphandler.ChatListResponse](t, rec)
+		}
+
+		first, firstPage := list(t, "?limit=2")
+		require.Len(t, first.Chats, 2)
+		require.NotNil(t, firstPage.Total)
+		assert.Equal(t, 3, *firstPage.Total)
+		assert.True(t, firstPage.HasMore)
+		assert.Empty(t, firstPage.PrevCursor)
+		require.Equal(t, httpserver.EncodeOffsetCursor(2), firstPage.NextCursor)
+
+		second, secondPage := list(t, "?limit=2&cursor="+firstPage.NextCursor)
+		require.Len(t, second.Chats, 1)
+		assert.Equal(t, 2, secondPage.Offset)
+		assert.False(t, secondPage.HasMore)
+		assert.Empty(t, secondPage.NextCursor)
+		assert.Equal(t, httpserver.EncodeOffsetCursor(0), secondPage.PrevCursor)
+		for _, ch := range first.Chats {
+			assert.NotEqual(t, ch.ID, second.Chats[0].ID)
+		}
+
+		back, _ := list(t, "?limit=2&cursor="+secondPage.PrevCursor)
+		assert.Equal(t, first.Chats, back.Chats)
+	})
+
 	t.Run("invalid workspace ID", func(t *testing.T) {
 		e := echo.New()
 		userID := uuid.NewUUID()
@@ -682,6 +764,68 @@ func TestChatHandler_Update(t *testing.T) {
 	})
 }
 
+func TestChatHandler_SetTopic(t *testing.T) {
+	setTopic := func(
+		t *testing.T,
+		handler *httphandler.ChatHandler,
+		chatID, userID uuid.UUID,
+		body string,
+	) *httptest.ResponseRecorder {
+		t.Helper()
+		e := echo.New()
+		req := httptest.NewRequest(stdhttp.MethodPut, chatURL(chatID)+"/topic", strings.NewReader(body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("id")
+		c.SetParamValues(chatID.String())
+		setupChatAuthContext(c, userID)
+
+		require.NoError(t, handler.SetTopic(c))
+		return rec
+	}
+
+	t.Run("sets topic on discussion", func(t *testing.T) {
+		userID := uuid.NewUUID()
+		mockService := httphandler.NewMockChatService()
+		handler := httphandler.NewChatHandler(mockService)
+
+		testChat, err := chat.NewChat(uuid.NewUUID(), chat.TypeDiscussion, true, userID)
+		require.NoError(t, err)
+		mockService.AddChat(testChat)
+
+		rec := setTopic(t, handler, testChat.ID(), userID, `{"topic": "  Release planning "}`)
+
+		assert.Equal(t, stdhttp.StatusOK, rec.Code)
+		assert.Equal(t, "Release planning", testChat.Topic())
+		assert.Contains(t, rec.Body.String(), `"topic":"Release planning"`)
+	})
+
+	t.Run("too long topic", func(t *testing.T) {
+		userID := uuid.NewUUID()
+		mockService := httphandler.NewMockChatService()
+		handler := httphandler.NewChatHandler(mockService)
+
+		testChat, err := chat.NewChat(uuid.NewUUID(), chat.TypeDiscussion, true, userID)
+		require.NoError(t, err)
+		mockService.AddChat(testChat)
+
+		body := `{"topic": "` + strings.Repeat("x", chat.MaxTopicLength+1) + `"}`
+		rec := setTopic(t, handler, testChat.ID(), userID, body)
+
+		assert.Equal(t, stdhttp.StatusBadRequest, rec.Code)
+		assert.Empty(t, testChat.Topic())
+	})
+
+	t.Run("chat not found", func(t *testing.T) {
+		handler := httphandler.NewChatHandler(httphandler.NewMockChatService())
+
+		rec := setTopic(t, handler, uuid.NewUUID(), uuid.NewUUID(), `{"topic": "x"}`)
+
+		assert.Equal(t, stdhttp.StatusNotFound, rec.Code)
+	})
+}
+
 func TestChatHandler_Delete(t *testing.T) {
 	t.Run("successful delete", func(t *testing.T) {
 		e := echo.New()
@@ -769,6 +913,357 @@ func TestChatHandler_Delete(t *testing.T) {
 	})
 }
 
+func TestChatHandler_MarkRead(t *testing.T) {
+	userID := uuid.NewUUID()
+	workspaceID := uuid.NewUUID()
+
+	mockService := httphandler.NewMockChatService()
+	handler := httphandler.NewChatHandler(mockService)
+
+	testChat := createTestChat(t, workspaceID, userID)
+	mockService.AddChat(testChat)
+
+	tests := []struct {
+		name       string
+		chatID     string
+		wantStatus int
+	}{
+		{name: "successful mark read", chatID: testChat.ID().String(), wantStatus: stdhttp.StatusNoContent},
+		{name: "chat not found", chatID: uuid.NewUUID().String(), wantStatus: stdhttp.StatusNotFound},
+		{name: "invalid chat ID", chatID: "invalid", wantStatus: stdhttp.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			req := httptest.NewRequest(stdhttp.MethodPost, "/api/v1/chats/"+tt.chatID+"/read", nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetParamNames("id")
+			c.SetParamValues(tt.chatID)
+
+			setupChatAuthContext(c, userID)
+
+			require.NoError(t, handler.MarkRead(c))
+			assert.Equal(t, tt.wantStatus, rec.Code)
+		})
+	}
+}
+
+func TestChatHandler_Unread(t *testing.T) {
+	userID := uuid.NewUUID()
+	workspaceID := uuid.NewUUID()
+
+	mockService := httphandler.NewMockChatService()
+	handler := httphandler.NewChatHandler(mockService)
+
+	testChat := createTestChat(t, workspaceID, userID)
+	mockService.AddChat(testChat)
+
+	tests := []struct {
+		name       string
+		chatID     string
+		wantStatus int
+	}{
+		{name: "returns unread counter", chatID: testChat.ID().String(), wantStatus: stdhttp.StatusOK},
+		{name: "chat not found", chatID: uuid.NewUUID().String(), wantStatus: stdhttp.StatusNotFound},
+		{name: "invalid chat ID", chatID: "invalid", wantStatus: stdhttp.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			req := httptest.NewRequest(stdhttp.MethodGet, "/api/v1/chats/"+tt.chatID+"/unread", nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetParamNames("id")
+			c.SetParamValues(tt.chatID)
+
+			setupChatAuthContext(c, userID)
+
+			require.NoError(t, handler.Unread(c))
+			assert.Equal(t, tt.wantStatus, rec.Code)
+			if tt.wantStatus == stdhttp.StatusOK {
+				var resp struct {
+					Data httphandler.ChatUnreadResponse `json:"data"`
+				}
+				require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+				assert.Equal(t, testChat.ID(), resp.Data.ChatID)
+				assert.Zero(t, resp.Data.UnreadCount)
+			}
+		})
+	}
+}
+
+// readModelQueryRepo is a chatapp.QueryRepository over fixed read models.
+type readModelQueryRepo struct {
+	chats map[uuid.UUID]*chatapp.ReadModel
+}
+
+func (r *readModelQueryRepo) FindByID(_ context.Context, chatID uuid.UUID) (*chatapp.ReadModel, error) {
+	rm, ok := r.chats[chatID]
+	if !ok {
+		return nil, chatapp.ErrChatNotFound
+	}
+	return rm, nil
+}
+
+func (r *readModelQueryRepo) FindByWorkspace(
+	_ context.Context,
+	_ uuid.UUID,
+	_ chatapp.Filters,
+) ([]*chatapp.ReadModel, error) {
+	return nil, nil
+}
+
+func (r *readModelQueryRepo) FindByParticipant(
+	_ context.Context,
+	_ uuid.UUID,
+	_, _ int,
+) ([]*chatapp.ReadModel, error) {
+	return nil, nil
+}
+
+func (r *readModelQueryRepo) Count(_ context.Context, _ uuid.UUID) (int, error) {
+	return len(r.chats), nil
+}
+
+func TestChatHandler_Unread_CountsMessages(t *testing.T) {
+	reader := uuid.NewUUID()
+	newcomer := uuid.NewUUID()
+	outsider := uuid.NewUUID()
+
+	// Five messages were posted; the reader has read two of them, the newcomer none.
+	rm := &chatapp.ReadModel{
+		ID:           uuid.NewUUID(),
+		WorkspaceID:  uuid.NewUUID(),
+		Type:         chat.TypeDiscussion,
+		MessageCount: 5,
+		ReadCounts:   map[uuid.UUID]int{reader: 2},
+		Participants: []chat.Participant{
+			chat.NewParticipant(reader, chat.RoleMember),
+			chat.NewParticipant(newcomer, chat.RoleMember),
+		},
+	}
+	repo := &readModelQueryRepo{chats: map[uuid.UUID]*chatapp.ReadModel{rm.ID: rm}}
+	chatService := service.NewChatService(service.ChatServiceConfig{
+		UnreadUC: chatapp.NewGetUnreadCountUseCase(repo),
+	})
+	handler := httphandler.NewChatHandler(chatService)
+
+	tests := []struct {
+		name       string
+		userID     uuid.UUID
+		wantStatus int
+		wantUnread int
+	}{
+		{name: "counts messages after the read marker", userID: reader, wantStatus: stdhttp.StatusOK, wantUnread: 3},
+		{name: "counts every message without a marker", userID: newcomer, wantStatus: stdhttp.StatusOK, wantUnread: 5},
+		{name: "private chat outsider", userID: outsider, wantStatus: stdhttp.StatusForbidden},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			req := httptest.NewRequest(stdhttp.MethodGet, "/api/v1/chats/"+rm.ID.String()+"/unread", nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetParamNames("id")
+			c.SetParamValues(rm.ID.String())
+
+			setupChatAuthContext(c, tt.userID)
+
+			require.NoError(t, handler.Unread(c))
+			assert.Equal(t, tt.wantStatus, rec.Code)
+			if tt.wantStatus == stdhttp.StatusOK {
+				var resp struct {
+					Data httphandler.ChatUnreadResponse `json:"data"`
+				}
+				require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+				assert.Equal(t, rm.ID, resp.Data.ChatID)
+				assert.Equal(t, tt.wantUnread, resp.Data.UnreadCount)
+			}
+		})
+	}
+}
+
+func TestChatHandler_Star(t *testing.T) {
+	userID := uuid.NewUUID()
+	workspaceID := uuid.NewUUID()
+
+	mockService := httphandler.NewMockChatService()
+	handler := httphandler.NewChatHandler(mockService)
+
+	starred := createTestChat(t, workspaceID, userID)
+	other := createTestChat(t, workspaceID, userID)
+	mockService.AddChat(starred)
+	mockService.AddChat(other)
+
+	call := func(method, param, chatID string) int {
+		req := httptest.NewRequest(method, "/api/v1/chats/"+chatID+"/star", nil)
+		rec := httptest.NewRecorder()
+		c := echo.New().NewContext(req, rec)
+		c.SetParamNames(param)
+		c.SetParamValues(chatID)
+		setupChatAuthContext(c, userID)
+
+		if method == stdhttp.MethodDelete {
+			require.NoError(t, handler.Unstar(c))
+		} else {
+			require.NoError(t, handler.Star(c))
+		}
+		return rec.Code
+	}
+	listStarred := func() []httphandler.ChatResponse {
+		req := httptest.NewRequest(stdhttp.MethodGet, workspaceChatsURL(workspaceID)+"?starred=true", nil)
+		rec := httptest.NewRecorder()
+		c := echo.New().NewContext(req, rec)
+		c.SetParamNames("workspace_id")
+		c.SetParamValues(workspaceID.String())
+		setupChatAuthContext(c, userID)
+
+		require.NoError(t, handler.List(c))
+		var resp struct {
+			Data httphandler.ChatListResponse `json:"data"`
+		}
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+		return resp.Data.Chats
+	}
+
+	t.Run("stars a chat and lists it as starred", func(t *testing.T) {
+		assert.Equal(t, stdhttp.StatusNoContent, call(stdhttp.MethodPut, "id", starred.ID().String()))
+
+		chats := listStarred()
+		require.Len(t, chats, 1)
+		assert.Equal(t, starred.ID(), chats[0].ID)
+		assert.True(t, chats[0].IsStarred)
+	})
+
+	t.Run("unstars a task by its task ID", func(t *testing.T) {
+		assert.Equal(t, stdhttp.StatusNoContent, call(stdhttp.MethodDelete, "task_id", starred.ID().String()))
+
+		assert.Empty(t, listStarred())
+	})
+
+	t.Run("chat not found", func(t *testing.T) {
+		assert.Equal(t, stdhttp.StatusNotFound, call(stdhttp.MethodPut, "id", uuid.NewUUID().String()))
+	})
+
+	t.Run("invalid chat ID", func(t *testing.T) {
+		assert.Equal(t, stdhttp.StatusBadRequest, call(stdhttp.MethodPut, "id", "invalid"))
+	})
+}
+
+func TestChatHandler_ListParticipants(t *testing.T) {
+	userID := uuid.NewUUID()
+	workspaceID := uuid.NewUUID()
+
+	mockService := httphandler.NewMockChatService()
+	handler := httphandler.NewChatHandler(mockService)
+
+	testChat := createTestChat(t, workspaceID, userID)
+	for range 3 {
+		require.NoError(t, testChat.AddParticipant(uuid.NewUUID(), chat.RoleMember))
+	}
+	mockService.AddChat(testChat)
+
+	tests := []struct {
+		name       string
+		chatID     string
+		query      string
+		wantStatus int
+		wantCount  int
+		wantTotal  int
+		wantMore   bool
+	}{
+		{name: "first page", chatID: testChat.ID().String(), query: "?limit=2", wantStatus: stdhttp.StatusOK,
+			wantCount: 2, wantTotal: 4, wantMore: true},
+		{name: "last page", chatID: testChat.ID().String(), query: "?limit=2&offset=2", wantStatus: stdhttp.StatusOK,
+			wantCount: 2, wantTotal: 4},
+		{name: "role filter", chatID: testChat.ID().String(), query: "?role=admin", wantStatus: stdhttp.StatusOK,
+			wantCount: 1, wantTotal: 1},
+		{name: "invalid role", chatID: testChat.ID().String(), query: "?role=owner",
+			wantStatus: stdhttp.StatusBadRequest},
+		{name: "chat not found", chatID: uuid.NewUUID().String(), wantStatus: stdhttp.StatusNotFound},
+		{name: "invalid chat ID", chatID: "invalid", wantStatus: stdhttp.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			req := httptest.NewRequest(stdhttp.MethodGet, chatParticipantsURL(uuid.UUID(tt.chatID))+tt.query, nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetParamNames("id")
+			c.SetParamValues(tt.chatID)
+
+			setupChatAuthContext(c, userID)
+
+			require.NoError(t, handler.ListParticipants(c))
+			require.Equal(t, tt.wantStatus, rec.Code)
+			if tt.wantStatus != stdhttp.StatusOK {
+				return
+			}
+
+			var resp struct {
+				Data httphandler.ParticipantListResponse `json:"data"`
+			}
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+			assert.Len(t, resp.Data.Participants, tt.wantCount)
+			assert.Equal(t, tt.wantTotal, resp.Data.Total)
+			assert.Equal(t, tt.wantMore, resp.Data.HasMore)
+		})
+	}
+}
+
+func TestChatHandler_TransferOwnership(t *testing.T) {
+	ownerID := uuid.NewUUID()
+	memberID := uuid.NewUUID()
+
+	tests := []struct {
+		name       string
+		actorID    uuid.UUID
+		body       string
+		wantStatus int
+	}{
+		{name: "owner transfers to member", actorID: ownerID,
+			body: `{"user_id":"` + memberID.String() + `"}`, wantStatus: stdhttp.StatusOK},
+		{name: "target is not a participant", actorID: ownerID,
+			body: `{"user_id":"` + uuid.NewUUID().String() + `"}`, wantStatus: stdhttp.StatusForbidden},
+		{name: "member cannot transfer", actorID: memberID,
+			body: `{"user_id":"` + memberID.String() + `"}`, wantStatus: stdhttp.StatusForbidden},
+		{name: "missing user_id", actorID: ownerID, body: `{}`, wantStatus: stdhttp.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := httphandler.NewMockChatService()
+			handler := httphandler.NewChatHandler(mockService)
+
+			testChat := createTestChat(t, uuid.NewUUID(), ownerID)
+			require.NoError(t, testChat.AddParticipant(memberID, chat.RoleMember))
+			mockService.AddChat(testChat)
+
+			e := echo.New()
+			req := httptest.NewRequest(stdhttp.MethodPost,
+				chatURL(testChat.ID())+"/transfer-ownership", strings.NewReader(tt.body))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetParamNames("id")
+			c.SetParamValues(testChat.ID().String())
+
+			setupChatAuthContext(c, tt.actorID)
+
+			require.NoError(t, handler.TransferOwnership(c))
+			require.Equal(t, tt.wantStatus, rec.Code)
+			if tt.wantStatus == stdhttp.StatusOK {
+				assert.Equal(t, memberID, testChat.CreatedBy())
+			}
+		})
+	}
+}
+
 func TestChatHandler_AddParticipant(t *testing.T) {
 	t.Run("successful add participant", func(t *testing.T) {
 		e := echo.New()
@@ -1098,6 +1593,10 @@ func TestToChatResponseFromDTO(t *testing.T) {
 				Role:   chat.RoleAdmin,
 			},
 		},
+		ParticipantCount: 1,
+		ConversionHistory: []chatapp.TypeConversion{
+			{FromType: chat.TypeDiscussion, ToType: chat.TypeTask, ConvertedBy: userID},
+		},
 	}
 
 	status := "open"
@@ -1113,7 +1612,45 @@ func TestToChatResponseFromDTO(t *testing.T) {
 	assert.Equal(t, userID, resp.CreatedBy)
 	assert.NotNil(t, resp.Status)
 	assert.Equal(t, "open", *resp.Status)
-	assert.Len(t, resp.Participants, 1)
+	assert.Empty(t, resp.Participants, "chat detail does not inline participants")
+	assert.Equal(t, 1, resp.ParticipantCount)
+	require.Len(t, resp.ConversionHistory, 1)
+	assert.Equal(t, "discussion", resp.ConversionHistory[0].FromType)
+	assert.Equal(t, "task", resp.ConversionHistory[0].ToType)
+	assert.Equal(t, userID, resp.ConversionHistory[0].ConvertedBy)
+	assert.Nil(t, resp.LastActivityAt)
+	assert.Nil(t, resp.LastMessage)
+}
+
+func TestToChatResponseFromDTO_LastMessage(t *testing.T) {
+	authorID := uuid.NewUUID()
+	messageID := uuid.NewUUID()
+	activityAt := time.Date(2026, 3, 1, 10, 30, 0, 0, time.UTC)
+
+	dto := &chatapp.Chat{
+		ID:             uuid.NewUUID(),
+		WorkspaceID:    uuid.NewUUID(),
+		Type:           chat.TypeDiscussion,
+		CreatedBy:      authorID,
+		LastActivityAt: &activityAt,
+		LastMessage: &chatapp.LastMessagePreview{
+			MessageID: messageID,
+			AuthorID:  authorID,
+			Content:   "see you tomorrow",
+			CreatedAt: activityAt,
+		},
+	}
+
+	resp := httphandler.ToChatResponseFromDTO(dto)
+
+	require.NotNil(t, resp.LastActivityAt)
+	assert.Equal(t, "2026-03-01T10:30:00Z", *resp.LastActivityAt)
+	require.NotNil(t, resp.LastMessage)
+	assert.Equal(t, messageID, resp.LastMessage.MessageID)
+	assert.Equal(t, authorID, resp.LastMessage.AuthorID)
+	assert.Equal(t, "see you tomorrow", resp.LastMessage.Content)
+	assert.Equal(t, "2026-03-01T10:30:00Z", resp.LastMessage.CreatedAt)
+	assert.False(t, resp.LastMessage.IsDeleted)
 }
 
 func TestChatErrors(t *testing.T) {
@@ -6,6 +6,7 @@ import (
 	"html"
 	"log/slog"
 	"net/http"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
@@ -17,6 +18,7 @@ import (
 	chatdomain "github.com/lllypuk/flowra/internal/domain/chat"
 	"github.com/lllypuk/flowra/internal/domain/message"
 	"github.com/lllypuk/flowra/internal/domain/tag"
+	taskdomain "github.com/lllypuk/flowra/internal/domain/task"
 	"github.com/lllypuk/flowra/internal/domain/uuid"
 	"github.com/lllypuk/flowra/internal/middleware"
 )
@@ -28,6 +30,8 @@ const (
 	roleAdmin                    = "admin"
 	roleMember                   = "member"
 	roleCreator                  = "creator"
+	quoteExcerptLength           = 120
+	secondsPerMinute             = 60
 )
 
 // ChatTemplateService defines the interface for chat operations needed by templates.
@@ -41,6 +45,12 @@ type ChatTemplateService interface {
 
 	// ListChats lists chats in a workspace.
 	ListChats(ctx context.Context, query chatapp.ListChatsQuery) (*chatapp.ListChatsResult, error)
+
+	// MarkChatRead resets the user's unread counter of a chat.
+	MarkChatRead(ctx context.Context, cmd chatapp.MarkChatReadCommand) error
+
+	// GetUnreadCount returns how many messages of a chat the user has not read yet.
+	GetUnreadCount(ctx context.Context, query chatapp.GetUnreadCountQuery) (*chatapp.GetUnreadCountResult, error)
 }
 
 // MessageTemplateService defines the interface for message operations needed by templates.
@@ -51,6 +61,9 @@ type MessageTemplateService interface {
 
 	// GetMessage gets a message by ID.
 	GetMessage(ctx context.Context, messageID uuid.UUID) (*message.Message, error)
+
+	// LocateMessage resolves the page of the chat history that contains a message.
+	LocateMessage(ctx context.Context, query messageapp.LocateMessageQuery) (messageapp.LocateResult, error)
 }
 
 // TaskQueryForChatService defines the interface for querying tasks by chat ID.
@@ -60,6 +73,13 @@ type TaskQueryForChatService interface {
 	GetTaskByChatID(ctx context.Context, chatID uuid.UUID) (*taskapp.ReadModel, error)
 }
 
+// MessageTaskLinkLookup resolves the tasks referenced by messages.
+// Declared on the consumer side per project guidelines.
+type MessageTaskLinkLookup interface {
+	// LinkedTasks returns the current read models of the tasks referenced by each message.
+	LinkedTasks(ctx context.Context, messageIDs []uuid.UUID) (map[uuid.UUID][]*taskapp.ReadModel, error)
+}
+
 // ChatTaskProjectionSync defines projection synchronization required by chat template flows.
 type ChatTaskProjectionSync interface {
 	RebuildOne(ctx context.Context, chatID uuid.UUID) error
@@ -70,6 +90,7 @@ type ChatViewData struct {
 	ID               string
 	WorkspaceID      string
 	Title            string
+	Topic            string
 	Type             string
 	IsPublic         bool
 	IsTaskChat       bool
@@ -82,7 +103,9 @@ type ChatViewData struct {
 	UpdatedAt        time.Time
 	ParticipantCount int
 	UnreadCount      int
+	IsStarred        bool
 	LastMessage      *LastMessageData
+	FocusMessageID   string // message to scroll to when opened from a permalink
 }
 
 // LastMessageData represents the last message in a chat.
@@ -90,6 +113,7 @@ type LastMessageData struct {
 	Content        string
 	AuthorUsername string
 	CreatedAt      time.Time
+	IsDeleted      bool
 }
 
 // MessageViewData represents message data for templates.
@@ -100,15 +124,58 @@ type MessageViewData struct {
 	CreatedAt       time.Time
 	EditedAt        *time.Time
 	IsDeleted       bool
+	DeletedContent  string // original content of a deleted message, for moderators until purged
 	IsSystemMessage bool
 	IsBotMessage    bool
 	IsGroupStart    bool // first message in a group of consecutive system/bot messages
 	IsGroupEnd      bool // last message in a group of consecutive system/bot messages
+	IsHighlighted   bool // target of a permalink
 	CanEdit         bool
 	Author          MessageAuthorData
 	Tags            []MessageTagData
 	Reactions       []MessageReactionData
 	Attachments     []AttachmentViewData
+	Quote           *MessageQuoteData // inline quote of another message, nil if none
+	Poll            *MessagePollData  // poll of the message, nil if none
+	LinkedTasks     []MessageTaskChipData
+}
+
+// MessageTaskChipData represents a task referenced by a message, with its current status.
+type MessageTaskChipData struct {
+	ID       string
+	Title    string
+	Type     string
+	Status   string
+	IsClosed bool
+	URL      string
+}
+
+// MessagePollData represents a poll with its results for templates.
+type MessagePollData struct {
+	Options    []MessagePollOptionData
+	Anonymous  bool
+	Closed     bool
+	ClosesAt   *time.Time
+	TotalVotes int
+	HasVoted   bool
+	CanClose   bool
+}
+
+// MessagePollOptionData represents a poll option with its result for templates.
+type MessagePollOptionData struct {
+	ID      string
+	Text    string
+	Votes   int
+	Percent int
+	IsMine  bool
+}
+
+// MessageQuoteData represents the preview of a quoted message.
+type MessageQuoteData struct {
+	ID         string
+	AuthorName string
+	Excerpt    string
+	IsDeleted  bool
 }
 
 // MessageAuthorData represents message author data for templates.
@@ -141,6 +208,14 @@ type AttachmentViewData struct {
 	MimeType string
 	URL      string
 	IsImage  bool
+	// IsAudio marks audio attachments such as voice messages, shown with a player.
+	IsAudio       bool
+	DurationLabel string // e.g. "1:05", empty if the duration is unknown
+	Waveform      []int  // levels from 0 to 100, empty if unknown
+	// PreviewURL is the scaled-down JPEG of images and PDFs, empty until the worker generated it.
+	PreviewURL    string
+	PreviewWidth  int
+	PreviewHeight int
 }
 
 // ParticipantViewData represents participant data for templates.
@@ -173,6 +248,7 @@ type ChatTemplateHandler struct {
 	taskProjector  ChatTaskProjectionSync
 	userLookup     UserProfileLookup
 	memberService  BoardMemberService
+	taskLinks      MessageTaskLinkLookup
 }
 
 // NewChatTemplateHandler creates a new chat template handler.
@@ -210,6 +286,11 @@ func (h *ChatTemplateHandler) SetTaskProjector(projector ChatTaskProjectionSync)
 	h.taskProjector = projector
 }
 
+// SetTaskLinkLookup sets the lookup rendering task chips on messages that reference tasks.
+func (h *ChatTemplateHandler) SetTaskLinkLookup(lookup MessageTaskLinkLookup) {
+	h.taskLinks = lookup
+}
+
 // SetupChatRoutes registers chat-related page and partial routes.
 func (h *ChatTemplateHandler) SetupChatRoutes(e *echo.Echo) {
 	// Chat pages (protected)
@@ -217,6 +298,13 @@ func (h *ChatTemplateHandler) SetupChatRoutes(e *echo.Echo) {
 	workspaces.GET("/:workspace_id/chats", h.ChatLayout)
 	workspaces.GET("/:workspace_id/chats/:chat_id", h.ChatView)
 
+	// Message permalinks (protected)
+	e.GET("/chats/:chat_id/messages/:message_id", h.MessagePermalink, RequireAuth)
+	e.GET("/messages/:message_id", h.MessagePermalink, RequireAuth)
+
+	// Task permalinks (protected); a task shares its ID with its chat
+	e.GET("/tasks/:task_id", h.TaskPermalink, RequireAuth)
+
 	// Chat partials (protected)
 	partials := e.Group("/partials", RequireAuth)
 	partials.GET("/workspace/:workspace_id/chats", h.ChatListPartial)
@@ -225,6 +313,7 @@ func (h *ChatTemplateHandler) SetupChatRoutes(e *echo.Echo) {
 	partials.GET("/messages/:message_id", h.SingleMessagePartial)
 	partials.GET("/messages/:message_id/edit", h.MessageEditForm)
 	partials.GET("/chats/:chat_id/participants", h.ParticipantsPartial)
+	partials.POST("/chats/:chat_id/read", h.MarkChatRead)
 	partials.GET("/chat/create-form", h.ChatCreateForm)
 	partials.POST("/chat/create", h.ChatCreate)
 	partials.GET("/chats/search", h.ChatSearchPartial)
@@ -302,6 +391,9 @@ func (h *ChatTemplateHandler) ChatView(c echo.Context) error {
 		return h.renderNotFound(c)
 	}
 
+	chatData.FocusMessageID = focusMessageParam(c)
+	h.markChatRead(c.Request().Context(), chatID, userID)
+
 	workspaceData := WorkspaceViewData{
 		ID: workspaceID.String(),
 	}
@@ -344,12 +436,15 @@ func (h *ChatTemplateHandler) ChatViewPartial(c echo.Context) error {
 		return c.String(http.StatusNotFound, "Chat not found")
 	}
 
+	chatData.FocusMessageID = focusMessageParam(c)
+
 	// If this is not an HTMX request (direct page load), redirect to full page
 	if c.Request().Header.Get("Hx-Request") == "" {
-		fullURL := "/workspaces/" + chatData.WorkspaceID + "/chats/" + chatData.ID
-		return c.Redirect(http.StatusFound, fullURL)
+		return c.Redirect(http.StatusFound, chatPageURL(chatData.WorkspaceID, chatData.ID, chatData.FocusMessageID))
 	}
 
+	h.markChatRead(c.Request().Context(), chatID, userID)
+
 	// Build inner data map
 	innerData := map[string]any{
 		"Chat": chatData,
@@ -432,9 +527,46 @@ func (h *ChatTemplateHandler) ChatListPartial(c echo.Context) error {
 
 	h.logger.Info("found chats", slog.Int("count", len(result.Chats)))
 
-	// Convert to view data
-	chatViews := make([]ChatViewData, 0, len(result.Chats))
+	// Starred chats are listed in their own section at the top, so they are
+	// shown even when they fall outside the first page of the main list.
+	query.StarredOnly = true
+	starred, err := h.chatService.ListChats(c.Request().Context(), query)
+	if err != nil {
+		h.logger.Warn("failed to list starred chats",
+			slog.String("error", err.Error()),
+			slog.String("workspace_id", workspaceID.String()))
+		starred = &chatapp.ListChatsResult{}
+	}
+
+	chats := make([]chatapp.Chat, 0, len(result.Chats))
 	for _, chat := range result.Chats {
+		if !chat.IsStarred {
+			chats = append(chats, chat)
+		}
+	}
+
+	// Get active chat ID from query param
+	activeChatID := c.QueryParam("active")
+
+	data := map[string]any{
+		"StarredChats": h.chatListViews(c.Request().Context(), starred.Chats),
+		"Chats":        h.chatListViews(c.Request().Context(), chats),
+		"ActiveChatID": activeChatID,
+		"WorkspaceID":  workspaceID.String(),
+	}
+
+	h.logger.Info("rendering chat/list template",
+		slog.Int("chat_count", len(chats)),
+		slog.String("workspace_id", workspaceID.String()))
+
+	return h.renderPartial(c, "chat/list", data)
+}
+
+// chatListViews converts listed chats to view data for the chat list.
+func (h *ChatTemplateHandler) chatListViews(ctx context.Context, chats []chatapp.Chat) []ChatViewData {
+	authors := h.lastMessageAuthors(ctx, chats)
+	chatViews := make([]ChatViewData, 0, len(chats))
+	for _, chat := range chats {
 		chatViews = append(chatViews, ChatViewData{
 			ID:          chat.ID.String(),
 			WorkspaceID: chat.WorkspaceID.String(),
@@ -443,25 +575,36 @@ func (h *ChatTemplateHandler) ChatListPartial(c echo.Context) error {
 			IsPublic:    chat.IsPublic,
 			IsTaskChat:  isTaskType(string(chat.Type)),
 			CreatedAt:   chat.CreatedAt,
-			UpdatedAt:   chat.CreatedAt, // TODO: add updated_at to domain
-			UnreadCount: 0,              // TODO: implement unread count
+			UpdatedAt:   chatActivityTime(&chat),
+			UnreadCount: chat.UnreadCount,
+			IsStarred:   chat.IsStarred,
+			LastMessage: h.lastMessageView(chat.LastMessage, authors),
 		})
 	}
+	return chatViews
+}
 
-	// Get active chat ID from query param
-	activeChatID := c.QueryParam("active")
+// MarkChatRead marks the chat as read by the current user.
+// Called by the chat view when a new message arrives while the chat is open.
+func (h *ChatTemplateHandler) MarkChatRead(c echo.Context) error {
+	user := h.getUserView(c)
+	if user == nil {
+		return c.String(http.StatusUnauthorized, "Unauthorized")
+	}
 
-	data := map[string]any{
-		"Chats":        chatViews,
-		"ActiveChatID": activeChatID,
-		"WorkspaceID":  workspaceID.String(),
+	chatID, err := uuid.ParseUUID(c.Param("chat_id"))
+	if err != nil {
+		return c.String(http.StatusBadRequest, "Invalid chat ID")
 	}
 
-	h.logger.Info("rendering chat/list template",
-		slog.Int("chat_count", len(chatViews)),
-		slog.String("workspace_id", workspaceID.String()))
+	userID, err := uuid.ParseUUID(user.ID)
+	if err != nil {
+		return c.String(http.StatusUnauthorized, "Invalid user")
+	}
 
-	return h.renderPartial(c, "chat/list", data)
+	h.markChatRead(c.Request().Context(), chatID, userID)
+
+	return c.NoContent(http.StatusNoContent)
 }
 
 // MessagesPartial returns messages for a chat as HTML partial.
@@ -493,6 +636,19 @@ func (h *ChatTemplateHandler) MessagesPartial(c echo.Context) error {
 		Offset: 0,
 	}
 
+	// Start the page at the permalink target so it is rendered
+	var focusID uuid.UUID
+	if around, parseErr := uuid.ParseUUID(c.QueryParam("around")); parseErr == nil {
+		located, locateErr := h.messageService.LocateMessage(c.Request().Context(), messageapp.LocateMessageQuery{
+			MessageID: around,
+			PageSize:  query.Limit,
+		})
+		if locateErr == nil && located.Message.ChatID() == chatID {
+			focusID = around
+			query.Offset = located.Offset
+		}
+	}
+
 	h.logger.Debug("listing messages for chat",
 		slog.String("chat_id", chatID.String()),
 		slog.Int("limit", query.Limit),
@@ -515,6 +671,13 @@ func (h *ChatTemplateHandler) MessagesPartial(c echo.Context) error {
 	)
 
 	// Convert to view data
+	loaded := make(map[uuid.UUID]*message.Message, len(result.Value))
+	for _, msg := range result.Value {
+		if msg != nil {
+			loaded[msg.ID()] = msg
+		}
+	}
+	canModerate := h.canSeeDeletedContent(c.Request().Context(), chatID, userID, result.Value)
 	messageViews := make([]MessageViewData, 0, len(result.Value))
 	for _, msg := range result.Value {
 		if msg == nil {
@@ -523,9 +686,17 @@ func (h *ChatTemplateHandler) MessagesPartial(c echo.Context) error {
 		if shouldHideSystemTagCommand(msg) {
 			continue
 		}
-		messageViews = append(messageViews, h.convertMessageToView(msg, userID))
+		view := h.convertMessageToView(msg, userID)
+		view.Quote = h.quoteView(c.Request().Context(), msg, userID, loaded)
+		view.IsHighlighted = msg.ID() == focusID
+		if canModerate && msg.IsDeleted() && !msg.IsPurged() {
+			view.DeletedContent = parseMessageContent(msg.Content()).DisplayText
+		}
+		messageViews = append(messageViews, view)
 	}
 
+	h.attachTaskChips(c.Request().Context(), messageViews)
+
 	// Apply grouping for consecutive system/bot messages within 5 seconds
 	applyMessageGrouping(messageViews)
 
@@ -541,6 +712,93 @@ func (h *ChatTemplateHandler) MessagesPartial(c echo.Context) error {
 	return h.renderPartial(c, "messages-list", data)
 }
 
+// canSeeDeletedContent reports whether the user moderates the chat and may see the
+// content of its deleted messages. Participants are only loaded when the page
+// contains a deleted message that has not been purged yet.
+func (h *ChatTemplateHandler) canSeeDeletedContent(
+	ctx context.Context,
+	chatID, userID uuid.UUID,
+	messages []*message.Message,
+) bool {
+	hasDeleted := slices.ContainsFunc(messages, func(msg *message.Message) bool {
+		return msg != nil && msg.IsDeleted() && !msg.IsPurged()
+	})
+	if !hasDeleted {
+		return false
+	}
+
+	for _, p := range h.loadParticipants(ctx, chatID, userID) {
+		if p.UserID == userID.String() {
+			return p.Role == roleAdmin
+		}
+	}
+	return false
+}
+
+// MessagePermalink resolves a message permalink and redirects to its chat page
+// with the message focused. The chat access check applies as for the chat page.
+func (h *ChatTemplateHandler) MessagePermalink(c echo.Context) error {
+	user := h.getUserView(c)
+	if user == nil {
+		return c.Redirect(http.StatusFound, "/login")
+	}
+
+	messageID, err := uuid.ParseUUID(c.Param("message_id"))
+	if err != nil {
+		return h.renderNotFound(c)
+	}
+
+	userID, err := uuid.ParseUUID(user.ID)
+	if err != nil {
+		return h.renderNotFound(c)
+	}
+
+	if h.messageService == nil {
+		return h.renderNotFound(c)
+	}
+
+	msg, err := h.messageService.GetMessage(c.Request().Context(), messageID)
+	if err != nil {
+		return h.renderNotFound(c)
+	}
+	if chatParam := c.Param("chat_id"); chatParam != "" && chatParam != msg.ChatID().String() {
+		return h.renderNotFound(c)
+	}
+
+	chatData, err := h.loadChatViewData(c.Request().Context(), msg.ChatID(), userID)
+	if err != nil {
+		return h.renderNotFound(c)
+	}
+
+	return c.Redirect(http.StatusFound, chatPageURL(chatData.WorkspaceID, chatData.ID, messageID.String()))
+}
+
+// TaskPermalink resolves a task permalink (/tasks/{id}) and redirects to the task chat.
+// The chat access check applies as for the chat page.
+func (h *ChatTemplateHandler) TaskPermalink(c echo.Context) error {
+	user := h.getUserView(c)
+	if user == nil {
+		return c.Redirect(http.StatusFound, "/login")
+	}
+
+	taskID, err := uuid.ParseUUID(c.Param("task_id"))
+	if err != nil {
+		return h.renderNotFound(c)
+	}
+
+	userID, err := uuid.ParseUUID(user.ID)
+	if err != nil {
+		return h.renderNotFound(c)
+	}
+
+	chatData, err := h.loadChatViewData(c.Request().Context(), taskID, userID)
+	if err != nil || !isTaskType(chatData.Type) {
+		return h.renderNotFound(c)
+	}
+
+	return c.Redirect(http.StatusFound, chatPageURL(chatData.WorkspaceID, chatData.ID, ""))
+}
+
 // SingleMessagePartial returns a single message as HTML partial.
 func (h *ChatTemplateHandler) SingleMessagePartial(c echo.Context) error {
 	user := h.getUserView(c)
@@ -570,9 +828,11 @@ func (h *ChatTemplateHandler) SingleMessagePartial(c echo.Context) error {
 		return c.NoContent(http.StatusNoContent)
 	}
 
-	messageView := h.convertMessageToView(msg, userID)
+	messageViews := []MessageViewData{h.convertMessageToView(msg, userID)}
+	messageViews[0].Quote = h.quoteView(c.Request().Context(), msg, userID, nil)
+	h.attachTaskChips(c.Request().Context(), messageViews)
 
-	return h.renderPartial(c, "message", messageView)
+	return h.renderPartial(c, "message", messageViews[0])
 }
 
 // MessageEditForm returns the message edit form partial.
@@ -807,6 +1067,7 @@ func (h *ChatTemplateHandler) ChatSearchPartial(c echo.Context) error {
 	}
 
 	// Filter chats by search query (simple contains match)
+	authors := h.lastMessageAuthors(c.Request().Context(), result.Chats)
 	chatViews := make([]ChatViewData, 0)
 	for _, chat := range result.Chats {
 		// Simple case-insensitive contains filter
@@ -819,8 +1080,9 @@ func (h *ChatTemplateHandler) ChatSearchPartial(c echo.Context) error {
 				IsPublic:    chat.IsPublic,
 				IsTaskChat:  isTaskType(string(chat.Type)),
 				CreatedAt:   chat.CreatedAt,
-				UpdatedAt:   chat.CreatedAt,
-				UnreadCount: 0,
+				UpdatedAt:   chatActivityTime(&chat),
+				UnreadCount: chat.UnreadCount,
+				LastMessage: h.lastMessageView(chat.LastMessage, authors),
 			})
 		}
 	}
@@ -927,6 +1189,7 @@ func (h *ChatTemplateHandler) loadChatViewData(
 		ID:               chat.ID.String(),
 		WorkspaceID:      chat.WorkspaceID.String(),
 		Title:            chat.Title,
+		Topic:            chat.Topic,
 		Type:             string(chat.Type),
 		IsPublic:         chat.IsPublic,
 		IsTaskChat:       isTaskType(string(chat.Type)),
@@ -936,12 +1199,107 @@ func (h *ChatTemplateHandler) loadChatViewData(
 		DueDate:          dueDate,
 		CreatedBy:        chat.CreatedBy.String(),
 		CreatedAt:        chat.CreatedAt,
-		UpdatedAt:        chat.CreatedAt,
+		UpdatedAt:        chatActivityTime(chat),
 		ParticipantCount: len(chat.Participants),
-		UnreadCount:      0,
+		UnreadCount:      h.chatUnreadCount(ctx, chat.ID, userID),
+		IsStarred:        chat.IsStarred,
 	}, nil
 }
 
+// chatUnreadCount returns the user's unread counter of a chat as it was before the chat
+// was opened. Failures are logged only and count as read.
+func (h *ChatTemplateHandler) chatUnreadCount(ctx context.Context, chatID, userID uuid.UUID) int {
+	query := chatapp.GetUnreadCountQuery{
+		ChatID:      chatID,
+		RequestedBy: userID,
+	}
+	result, err := h.chatService.GetUnreadCount(ctx, query)
+	if err != nil {
+		h.logger.WarnContext(ctx, "failed to get chat unread count",
+			slog.String("chat_id", chatID.String()),
+			slog.String("user_id", userID.String()),
+			slog.String("error", err.Error()),
+		)
+		return 0
+	}
+	if result == nil {
+		return 0
+	}
+	return result.UnreadCount
+}
+
+// chatActivityTime returns when the chat was last active, falling back to its
+// creation time for chats projected before activity was tracked.
+func chatActivityTime(chat *chatapp.Chat) time.Time {
+	if chat.LastActivityAt != nil {
+		return *chat.LastActivityAt
+	}
+	return chat.CreatedAt
+}
+
+// lastMessageAuthors resolves the authors of all last message previews with one batch lookup.
+func (h *ChatTemplateHandler) lastMessageAuthors(ctx context.Context, chats []chatapp.Chat) map[uuid.UUID]*UserView {
+	if h.userLookup == nil {
+		return nil
+	}
+	ids := make([]uuid.UUID, 0, len(chats))
+	for _, chat := range chats {
+		if chat.LastMessage != nil && !chat.LastMessage.AuthorID.IsZero() {
+			ids = append(ids, chat.LastMessage.AuthorID)
+		}
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	return h.userLookup.GetUsers(ctx, ids)
+}
+
+// lastMessageView converts a last message preview for the chat list.
+// Authors are resolved up front by lastMessageAuthors.
+func (h *ChatTemplateHandler) lastMessageView(
+	preview *chatapp.LastMessagePreview,
+	authors map[uuid.UUID]*UserView,
+) *LastMessageData {
+	if preview == nil {
+		return nil
+	}
+
+	var username string
+	if u := authors[preview.AuthorID]; u != nil {
+		username = u.Username
+	}
+	if username == "" && !preview.AuthorID.IsZero() {
+		username = "user" + preview.AuthorID.String()[:8]
+	}
+
+	return &LastMessageData{
+		Content:        preview.Content,
+		AuthorUsername: username,
+		CreatedAt:      preview.CreatedAt,
+		IsDeleted:      preview.IsDeleted,
+	}
+}
+
+// markChatRead resets the unread counter of an opened chat.
+// Failures are logged only: an unread badge must not prevent opening the chat.
+func (h *ChatTemplateHandler) markChatRead(ctx context.Context, chatID, userID uuid.UUID) {
+	if h.chatService == nil {
+		return
+	}
+
+	cmd := chatapp.MarkChatReadCommand{
+		ChatID: chatID,
+		UserID: userID,
+	}
+	if err := h.chatService.MarkChatRead(ctx, cmd); err != nil {
+		h.logger.WarnContext(ctx, "failed to mark chat as read",
+			slog.String("chat_id", chatID.String()),
+			slog.String("user_id", userID.String()),
+			slog.String("error", err.Error()),
+		)
+	}
+}
+
 func (h *ChatTemplateHandler) loadTaskViewData(ctx context.Context, chat *ChatViewData) *TaskViewData {
 	if chat == nil || !chat.IsTaskChat {
 		return nil
@@ -1020,6 +1378,15 @@ func (h *ChatTemplateHandler) loadParticipants(
 		return []ParticipantViewData{}
 	}
 
+	var users map[uuid.UUID]*UserView
+	if h.userLookup != nil && len(result.Chat.Participants) > 0 {
+		ids := make([]uuid.UUID, 0, len(result.Chat.Participants))
+		for _, p := range result.Chat.Participants {
+			ids = append(ids, p.UserID)
+		}
+		users = h.userLookup.GetUsers(ctx, ids)
+	}
+
 	participants := make([]ParticipantViewData, 0, len(result.Chat.Participants))
 	for _, p := range result.Chat.Participants {
 		pv := ParticipantViewData{
@@ -1027,12 +1394,10 @@ func (h *ChatTemplateHandler) loadParticipants(
 			Role:     string(p.Role),
 			JoinedAt: p.JoinedAt,
 		}
-		if h.userLookup != nil {
-			if u := h.userLookup.GetUser(ctx, p.UserID); u != nil {
-				pv.Username = u.Username
-				pv.DisplayName = u.DisplayName
-				pv.AvatarURL = u.AvatarURL
-			}
+		if u := users[p.UserID]; u != nil {
+			pv.Username = u.Username
+			pv.DisplayName = u.DisplayName
+			pv.AvatarURL = u.AvatarURL
 		}
 		if pv.Username == "" {
 			pv.Username = "user" + p.UserID.String()[:8]
@@ -1115,20 +1480,33 @@ func (h *ChatTemplateHandler) convertMessageToView(msg *message.Message, current
 		displayName = "User " + username
 	}
 
-	// Parse tags and get display content
+	// Parse tags and get display content; deleted messages are tombstones
 	parsed := parseMessageContent(msg.Content())
+	if msg.IsDeleted() {
+		parsed = parsedContent{}
+	}
 
 	// Convert attachments to view data
 	attachments := make([]AttachmentViewData, 0)
 	for _, a := range msg.Attachments() {
-		attachments = append(attachments, AttachmentViewData{
+		view := AttachmentViewData{
 			FileID:   a.FileID().String(),
 			FileName: a.FileName(),
 			FileSize: a.FileSize(),
 			MimeType: a.MimeType(),
 			URL:      fmt.Sprintf("/api/v1/files/%s/%s", a.FileID().String(), a.FileName()),
 			IsImage:  strings.HasPrefix(a.MimeType(), "image/"),
-		})
+			IsAudio:  a.IsAudio(),
+			Waveform: a.Waveform(),
+		}
+		if a.Duration() > 0 {
+			view.DurationLabel = formatAudioDuration(a.Duration())
+		}
+		if a.HasPreview() {
+			view.PreviewURL = view.URL + "/preview"
+			view.PreviewWidth, view.PreviewHeight = a.PreviewSize()
+		}
+		attachments = append(attachments, view)
 	}
 
 	return MessageViewData{
@@ -1150,11 +1528,128 @@ func (h *ChatTemplateHandler) convertMessageToView(msg *message.Message, current
 		Tags:        parsed.Tags,
 		Reactions:   reactions,
 		Attachments: attachments,
+		Poll:        convertPollToView(msg, currentUserID),
+	}
+}
+
+// formatAudioDuration formats the length of an audio attachment as m:ss.
+func formatAudioDuration(d time.Duration) string {
+	seconds := int(d.Round(time.Second) / time.Second)
+	return fmt.Sprintf("%d:%02d", seconds/secondsPerMinute, seconds%secondsPerMinute)
+}
+
+// convertPollToView builds the poll results as seen by the current user.
+func convertPollToView(msg *message.Message, currentUserID uuid.UUID) *MessagePollData {
+	poll := msg.Poll()
+	if poll == nil || msg.IsDeleted() {
+		return nil
+	}
+
+	myVote := poll.VoteOf(currentUserID)
+	data := &MessagePollData{
+		Anonymous:  poll.IsAnonymous(),
+		Closed:     poll.IsClosed(time.Now()),
+		ClosesAt:   poll.ClosesAt(),
+		TotalVotes: poll.TotalVotes(),
+		HasVoted:   myVote != nil,
+	}
+	data.CanClose = !data.Closed && msg.AuthorID() == currentUserID
+
+	for _, r := range poll.Results() {
+		option := MessagePollOptionData{
+			ID:     r.OptionID.String(),
+			Text:   r.Text,
+			Votes:  r.Votes,
+			IsMine: myVote != nil && *myVote == r.OptionID,
+		}
+		if data.TotalVotes > 0 {
+			option.Percent = r.Votes * 100 / data.TotalVotes
+		}
+		data.Options = append(data.Options, option)
+	}
+	return data
+}
+
+// attachTaskChips adds chips for the tasks referenced by the messages. Statuses are
+// read from the task read model on every render, so chips follow status changes.
+func (h *ChatTemplateHandler) attachTaskChips(ctx context.Context, views []MessageViewData) {
+	if h.taskLinks == nil || len(views) == 0 {
+		return
+	}
+
+	messageIDs := make([]uuid.UUID, 0, len(views))
+	for _, view := range views {
+		if !view.IsDeleted && !view.IsSystemMessage {
+			messageIDs = append(messageIDs, uuid.UUID(view.ID))
+		}
+	}
+	if len(messageIDs) == 0 {
+		return
+	}
+
+	linked, err := h.taskLinks.LinkedTasks(ctx, messageIDs)
+	if err != nil {
+		h.logger.WarnContext(ctx, "failed to load linked tasks", slog.String("error", err.Error()))
+		return
+	}
+
+	for i := range views {
+		for _, t := range linked[uuid.UUID(views[i].ID)] {
+			views[i].LinkedTasks = append(views[i].LinkedTasks, MessageTaskChipData{
+				ID:       t.ID.String(),
+				Title:    t.Title,
+				Type:     string(t.EntityType),
+				Status:   string(t.Status),
+				IsClosed: t.Status == taskdomain.StatusDone || t.Status == taskdomain.StatusCancelled,
+				URL:      "/tasks/" + t.ID.String(),
+			})
+		}
+	}
+}
+
+// quoteView builds the preview of the message quoted by msg.
+// Messages already loaded for the page are used before asking the service.
+func (h *ChatTemplateHandler) quoteView(
+	ctx context.Context,
+	msg *message.Message,
+	currentUserID uuid.UUID,
+	loaded map[uuid.UUID]*message.Message,
+) *MessageQuoteData {
+	if msg == nil || !msg.HasQuote() {
+		return nil
+	}
+
+	quoteID := msg.QuotedMessageID()
+	quoted, ok := loaded[quoteID]
+	if !ok && h.messageService != nil {
+		var err error
+		if quoted, err = h.messageService.GetMessage(ctx, quoteID); err != nil {
+			quoted = nil
+		}
+	}
+	if quoted == nil || quoted.IsDeleted() {
+		return &MessageQuoteData{ID: quoteID.String(), IsDeleted: true}
+	}
+
+	view := h.convertMessageToView(quoted, currentUserID)
+	return &MessageQuoteData{
+		ID:         view.ID,
+		AuthorName: view.Author.DisplayName,
+		Excerpt:    excerpt(view.Content, quoteExcerptLength),
 	}
 }
 
 // Utility functions
 
+// excerpt shortens s to at most n characters on a rune boundary.
+func excerpt(s string, n int) string {
+	runes := []rune(strings.Join(strings.Fields(s), " "))
+	if len(runes) <= n {
+		return string(runes)
+	}
+	return string(runes[:n-1]) + "…"
+}
+
 // parsedContent holds both the display content and parsed tags.
 type parsedContent struct {
 	DisplayText string
@@ -1203,6 +1698,25 @@ func shouldHideSystemTagCommand(msg *message.Message) bool {
 	return strings.HasPrefix(content, "#")
 }
 
+// focusMessageParam returns the permalink target from the "message" query parameter,
+// or an empty string when it is missing or malformed.
+func focusMessageParam(c echo.Context) string {
+	id, err := uuid.ParseUUID(c.QueryParam("message"))
+	if err != nil {
+		return ""
+	}
+	return id.String()
+}
+
+// chatPageURL returns the chat page URL, focused on a message when messageID is set.
+func chatPageURL(workspaceID, chatID, messageID string) string {
+	u := "/workspaces/" + workspaceID + "/chats/" + chatID
+	if messageID != "" {
+		u += "?message=" + messageID
+	}
+	return u
+}
+
 func isTaskType(chatType string) bool {
 	return chatType == chatTypeTask || chatType == chatTypeBug || chatType == chatTypeEpic
 }
@@ -25,13 +25,18 @@ import (
 
 // MockChatTemplateService is a mock implementation of ChatTemplateService for testing.
 type MockChatTemplateService struct {
-	chats map[uuid.UUID]*chatapp.Chat
+	chats     map[uuid.UUID]*chatapp.Chat
+	unread    map[uuid.UUID]int
+	readMarks []chatapp.MarkChatReadCommand
+	// unreadSeen records the unread counts returned by GetUnreadCount, in call order.
+	unreadSeen []int
 }
 
 // NewMockChatTemplateService creates a new mock chat template service.
 func NewMockChatTemplateService() *MockChatTemplateService {
 	return &MockChatTemplateService{
-		chats: make(map[uuid.UUID]*chatapp.Chat),
+		chats:  make(map[uuid.UUID]*chatapp.Chat),
+		unread: make(map[uuid.UUID]int),
 	}
 }
 
@@ -85,6 +90,28 @@ func (m *MockChatTemplateService) GetChat(
 	}, nil
 }
 
+// MarkChatRead implements ChatTemplateService.
+func (m *MockChatTemplateService) MarkChatRead(_ context.Context, cmd chatapp.MarkChatReadCommand) error {
+	if _, ok := m.chats[cmd.ChatID]; !ok {
+		return chatapp.ErrChatNotFound
+	}
+	m.readMarks = append(m.readMarks, cmd)
+	m.unread[cmd.ChatID] = 0
+	return nil
+}
+
+// GetUnreadCount implements ChatTemplateService.
+func (m *MockChatTemplateService) GetUnreadCount(
+	_ context.Context,
+	query chatapp.GetUnreadCountQuery,
+) (*chatapp.GetUnreadCountResult, error) {
+	if _, ok := m.chats[query.ChatID]; !ok {
+		return nil, chatapp.ErrChatNotFound
+	}
+	m.unreadSeen = append(m.unreadSeen, m.unread[query.ChatID])
+	return &chatapp.GetUnreadCountResult{ChatID: query.ChatID, UnreadCount: m.unread[query.ChatID]}, nil
+}
+
 // ListChats implements ChatTemplateService.
 func (m *MockChatTemplateService) ListChats(
 	_ context.Context,
@@ -92,7 +119,7 @@ func (m *MockChatTemplateService) ListChats(
 ) (*chatapp.ListChatsResult, error) {
 	chats := make([]chatapp.Chat, 0)
 	for _, c := range m.chats {
-		if c.WorkspaceID == query.WorkspaceID {
+		if c.WorkspaceID == query.WorkspaceID && (!query.StarredOnly || c.IsStarred) {
 			chats = append(chats, *c)
 		}
 	}
@@ -149,6 +176,27 @@ func (m *MockMessageTemplateService) GetMessage(
 	return msg, nil
 }
 
+// LocateMessage implements MessageTemplateService.
+func (m *MockMessageTemplateService) LocateMessage(
+	_ context.Context,
+	query messageapp.LocateMessageQuery,
+) (messageapp.LocateResult, error) {
+	msg, ok := m.messages[query.MessageID]
+	if !ok {
+		return messageapp.LocateResult{}, messageapp.ErrMessageNotFound
+	}
+	for i, chatMsg := range m.chatMessages[msg.ChatID()] {
+		if chatMsg.ID() == msg.ID() {
+			return messageapp.LocateResult{
+				Message:  msg,
+				Offset:   i / query.PageSize * query.PageSize,
+				Position: i,
+			}, nil
+		}
+	}
+	return messageapp.LocateResult{Message: msg}, nil
+}
+
 // MockTaskQueryForChatService is a mock implementation of TaskQueryForChatService for testing.
 type MockTaskQueryForChatService struct {
 	tasks map[uuid.UUID]*taskapp.ReadModel
@@ -242,6 +290,101 @@ func TestChatTemplateHandler_ChatListPartial(t *testing.T) {
 		require.Error(t, err) // Expected because renderer is nil
 	})
 
+	t.Run("renders unread badges", func(t *testing.T) {
+		e := echo.New()
+		e.Renderer = newTestRenderer(t)
+		userID := uuid.NewUUID()
+		workspaceID := uuid.NewUUID()
+
+		mockChatService := NewMockChatTemplateService()
+		unread := makeChatDTO(workspaceID, userID, "Unread Chat", chat.TypeDiscussion)
+		unread.UnreadCount = 3
+		mockChatService.AddChat(unread)
+		mockChatService.AddChat(makeChatDTO(workspaceID, userID, "Read Chat", chat.TypeDiscussion))
+
+		handler := httphandler.NewChatTemplateHandler(nil, nil, mockChatService, NewMockMessageTemplateService(), nil)
+
+		req := httptest.NewRequest(http.MethodGet, "/partials/workspace/"+workspaceID.String()+"/chats", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("workspace_id")
+		c.SetParamValues(workspaceID.String())
+		setUserContextForTemplate(c, userID)
+
+		require.NoError(t, handler.ChatListPartial(c))
+		assert.Equal(t, 1, strings.Count(rec.Body.String(), `class="badge"`))
+		assert.Contains(t, rec.Body.String(), `<span class="badge">3</span>`)
+	})
+
+	t.Run("renders last message previews", func(t *testing.T) {
+		e := echo.New()
+		e.Renderer = newTestRenderer(t)
+		userID := uuid.NewUUID()
+		workspaceID := uuid.NewUUID()
+
+		mockChatService := NewMockChatTemplateService()
+		active := makeChatDTO(workspaceID, userID, "Active Chat", chat.TypeDiscussion)
+		active.LastMessage = &chatapp.LastMessagePreview{
+			MessageID: uuid.NewUUID(),
+			AuthorID:  userID,
+			Content:   "ship it today",
+			CreatedAt: time.Now(),
+		}
+		cleared := makeChatDTO(workspaceID, userID, "Cleared Chat", chat.TypeDiscussion)
+		cleared.LastMessage = &chatapp.LastMessagePreview{
+			MessageID: uuid.NewUUID(),
+			AuthorID:  userID,
+			CreatedAt: time.Now(),
+			IsDeleted: true,
+		}
+		mockChatService.AddChat(active)
+		mockChatService.AddChat(cleared)
+
+		handler := httphandler.NewChatTemplateHandler(nil, nil, mockChatService, NewMockMessageTemplateService(), nil)
+		handler.SetUserLookup(&stubProfileLookup{})
+
+		req := httptest.NewRequest(http.MethodGet, "/partials/workspace/"+workspaceID.String()+"/chats", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("workspace_id")
+		c.SetParamValues(workspaceID.String())
+		setUserContextForTemplate(c, userID)
+
+		require.NoError(t, handler.ChatListPartial(c))
+		body := rec.Body.String()
+		assert.Contains(t, body, "ship it today")
+		assert.Contains(t, body, "<em>message deleted</em>")
+		assert.Equal(t, 2, strings.Count(body, "user"+userID.String()[:8]+":"))
+	})
+
+	t.Run("renders starred chats in their own section first", func(t *testing.T) {
+		e := echo.New()
+		e.Renderer = newTestRenderer(t)
+		userID := uuid.NewUUID()
+		workspaceID := uuid.NewUUID()
+
+		mockChatService := NewMockChatTemplateService()
+		starred := makeChatDTO(workspaceID, userID, "Starred Chat", chat.TypeTask)
+		starred.IsStarred = true
+		mockChatService.AddChat(starred)
+		mockChatService.AddChat(makeChatDTO(workspaceID, userID, "Plain Chat", chat.TypeDiscussion))
+
+		handler := httphandler.NewChatTemplateHandler(nil, nil, mockChatService, NewMockMessageTemplateService(), nil)
+
+		req := httptest.NewRequest(http.MethodGet, "/partials/workspace/"+workspaceID.String()+"/chats", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("workspace_id")
+		c.SetParamValues(workspaceID.String())
+		setUserContextForTemplate(c, userID)
+
+		require.NoError(t, handler.ChatListPartial(c))
+		body := rec.Body.String()
+		assert.Equal(t, 1, strings.Count(body, "Starred Chat"))
+		assert.Less(t, strings.Index(body, "Starred Chat"), strings.Index(body, "Plain Chat"))
+		assert.Contains(t, body, `class="chat-list chat-list-starred"`)
+	})
+
 	t.Run("unauthorized returns 401", func(t *testing.T) {
 		e := echo.New()
 		workspaceID := uuid.NewUUID()
@@ -327,6 +470,7 @@ func TestChatTemplateHandler_ChatViewPartial(t *testing.T) {
 
 		testChat := makeChatDTO(workspaceID, userID, "Test Chat", chat.TypeDiscussion)
 		mockChatService.AddChat(testChat)
+		mockChatService.unread[testChat.ID] = 3
 
 		handler := httphandler.NewChatTemplateHandler(nil, nil, mockChatService, mockMessageService, nil)
 
@@ -342,6 +486,15 @@ func TestChatTemplateHandler_ChatViewPartial(t *testing.T) {
 
 		// Will fail due to nil renderer, but logic should work
 		require.Error(t, err)
+
+		// The view gets the unread count from before the chat was opened
+		assert.Equal(t, []int{3}, mockChatService.unreadSeen)
+
+		// Opening the chat resets the unread counter
+		require.Len(t, mockChatService.readMarks, 1)
+		assert.Equal(t, testChat.ID, mockChatService.readMarks[0].ChatID)
+		assert.Equal(t, userID, mockChatService.readMarks[0].UserID)
+		assert.Zero(t, mockChatService.unread[testChat.ID])
 	})
 
 	t.Run("unauthorized returns 401", func(t *testing.T) {
@@ -390,6 +543,29 @@ func TestChatTemplateHandler_ChatViewPartial(t *testing.T) {
 	})
 }
 
+func TestChatTemplateHandler_MarkChatRead(t *testing.T) {
+	e := echo.New()
+	userID := uuid.NewUUID()
+
+	mockChatService := NewMockChatTemplateService()
+	testChat := makeChatDTO(uuid.NewUUID(), userID, "Test Chat", chat.TypeDiscussion)
+	mockChatService.AddChat(testChat)
+
+	handler := httphandler.NewChatTemplateHandler(nil, nil, mockChatService, NewMockMessageTemplateService(), nil)
+
+	req := httptest.NewRequest(http.MethodPost, "/partials/chats/"+testChat.ID.String()+"/read", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetParamNames("chat_id")
+	c.SetParamValues(testChat.ID.String())
+	setUserContextForTemplate(c, userID)
+
+	require.NoError(t, handler.MarkChatRead(c))
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+	require.Len(t, mockChatService.readMarks, 1)
+	assert.Equal(t, testChat.ID, mockChatService.readMarks[0].ChatID)
+}
+
 func TestChatTemplateHandler_MessagesPartial(t *testing.T) {
 	t.Run("successful list messages", func(t *testing.T) {
 		e := echo.New()
@@ -494,6 +670,328 @@ func TestChatTemplateHandler_SingleMessagePartial(t *testing.T) {
 	})
 }
 
+func TestChatTemplateHandler_SingleMessagePartial_Quote(t *testing.T) {
+	render := func(t *testing.T, messages *MockMessageTemplateService, msg *message.Message) string {
+		t.Helper()
+		e := echo.New()
+		e.Renderer = newTestRenderer(t)
+		handler := httphandler.NewChatTemplateHandler(nil, nil, NewMockChatTemplateService(), messages, nil)
+
+		req := httptest.NewRequest(http.MethodGet, "/partials/messages/"+msg.ID().String(), nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("message_id")
+		c.SetParamValues(msg.ID().String())
+		setUserContextForTemplate(c, msg.AuthorID())
+
+		require.NoError(t, handler.SingleMessagePartial(c))
+		require.Equal(t, http.StatusOK, rec.Code)
+		return rec.Body.String()
+	}
+
+	chatID := uuid.NewUUID()
+	userID := uuid.NewUUID()
+
+	t.Run("renders quoted message preview", func(t *testing.T) {
+		messages := NewMockMessageTemplateService()
+		quoted := makeTestMessage(chatID, uuid.NewUUID(), "Can we ship on Friday?")
+		msg := makeTestMessage(chatID, userID, "Yes")
+		require.NoError(t, msg.Quote(quoted))
+		messages.AddMessage(quoted)
+		messages.AddMessage(msg)
+
+		body := render(t, messages, msg)
+		assert.Contains(t, body, `class="message-quote"`)
+		assert.Contains(t, body, `href="#message-`+quoted.ID().String()+`"`)
+		assert.Contains(t, body, "Can we ship on Friday?")
+	})
+
+	t.Run("renders placeholder for deleted quoted message", func(t *testing.T) {
+		messages := NewMockMessageTemplateService()
+		quoted := makeTestMessage(chatID, userID, "Secret plan")
+		msg := makeTestMessage(chatID, userID, "Yes")
+		require.NoError(t, msg.Quote(quoted))
+		require.NoError(t, quoted.Delete(userID))
+		messages.AddMessage(quoted)
+		messages.AddMessage(msg)
+
+		body := render(t, messages, msg)
+		assert.Contains(t, body, "The quoted message has been deleted.")
+		assert.NotContains(t, body, "Secret plan")
+	})
+}
+
+func TestChatTemplateHandler_SingleMessagePartial_AudioAttachment(t *testing.T) {
+	e := echo.New()
+	e.Renderer = newTestRenderer(t)
+	userID := uuid.NewUUID()
+
+	messages := NewMockMessageTemplateService()
+	msg := makeTestMessage(uuid.NewUUID(), userID, "Voice message")
+	require.NoError(t, msg.AddAudioAttachment(
+		uuid.NewUUID(), "voice.webm", 4096, "audio/webm", 65*time.Second, []int{20, 100}))
+	require.NoError(t, msg.AddAttachment(uuid.NewUUID(), "notes.pdf", 1024, "application/pdf"))
+	messages.AddMessage(msg)
+
+	handler := httphandler.NewChatTemplateHandler(nil, nil, NewMockChatTemplateService(), messages, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/partials/messages/"+msg.ID().String(), nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetParamNames("message_id")
+	c.SetParamValues(msg.ID().String())
+	setUserContextForTemplate(c, userID)
+
+	require.NoError(t, handler.SingleMessagePartial(c))
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	body := rec.Body.String()
+	assert.Contains(t, body, `class="attachment-audio"`)
+	assert.Contains(t, body, "<audio controls")
+	assert.Contains(t, body, "1:05")
+	assert.Contains(t, body, "height: 100%")
+	assert.Equal(t, 1, strings.Count(body, "<audio"), "only the audio attachment gets a player")
+	assert.Contains(t, body, "notes.pdf")
+}
+
+func TestChatTemplateHandler_SingleMessagePartial_AttachmentPreviews(t *testing.T) {
+	e := echo.New()
+	e.Renderer = newTestRenderer(t)
+	userID := uuid.NewUUID()
+	photoID, scanID, pendingID := uuid.NewUUID(), uuid.NewUUID(), uuid.NewUUID()
+
+	messages := NewMockMessageTemplateService()
+	msg := message.Reconstruct(uuid.NewUUID(), uuid.NewUUID(), userID, "Files", "", uuid.UUID(""),
+		time.Now(), nil, false, nil, nil,
+		[]message.Attachment{
+			message.ReconstructAttachment(photoID, "photo.jpg", 4096, "image/jpeg").
+				WithPreview(message.PreviewReady, 320, 240),
+			message.ReconstructAttachment(scanID, "scan.pdf", 4096, "application/pdf").
+				WithPreview(message.PreviewReady, 226, 320),
+			message.ReconstructAttachment(pendingID, "fresh.png", 4096, "image/png").
+				WithPreview(message.PreviewPending, 0, 0),
+		},
+		nil, message.TypeUser, nil, uuid.UUID(""), nil)
+	messages.AddMessage(msg)
+
+	handler := httphandler.NewChatTemplateHandler(nil, nil, NewMockChatTemplateService(), messages, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/partials/messages/"+msg.ID().String(), nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetParamNames("message_id")
+	c.SetParamValues(msg.ID().String())
+	setUserContextForTemplate(c, userID)
+
+	require.NoError(t, handler.SingleMessagePartial(c))
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	body := rec.Body.String()
+	assert.Contains(t, body, `src="/api/v1/files/`+photoID.String()+`/photo.jpg/preview"`)
+	assert.Contains(t, body, `width="320" height="240"`)
+	assert.Contains(t, body, `data-lightbox-url="/api/v1/files/`+photoID.String()+`/photo.jpg"`,
+		"the lightbox still opens the full-size image")
+	assert.Contains(t, body, `class="attachment-document"`)
+	assert.Contains(t, body, `src="/api/v1/files/`+scanID.String()+`/scan.pdf/preview"`)
+	assert.Contains(t, body, `src="/api/v1/files/`+pendingID.String()+`/fresh.png"`,
+		"images without a preview yet fall back to the file")
+}
+
+func TestChatTemplateHandler_MessagePermalink(t *testing.T) {
+	userID := uuid.NewUUID()
+	workspaceID := uuid.NewUUID()
+
+	chats := NewMockChatTemplateService()
+	testChat := makeChatDTO(workspaceID, userID, "Test Chat", chat.TypeDiscussion)
+	chats.AddChat(testChat)
+
+	messages := NewMockMessageTemplateService()
+	msg := makeTestMessage(testChat.ID, userID, "Hello")
+	messages.AddMessage(msg)
+
+	handler := httphandler.NewChatTemplateHandler(nil, nil, chats, messages, nil)
+
+	serve := func(t *testing.T, chatID, messageID string) *httptest.ResponseRecorder {
+		t.Helper()
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodGet, "/messages/"+messageID, nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		if chatID != "" {
+			c.SetParamNames("chat_id", "message_id")
+			c.SetParamValues(chatID, messageID)
+		} else {
+			c.SetParamNames("message_id")
+			c.SetParamValues(messageID)
+		}
+		setUserContextForTemplate(c, userID)
+
+		// 404 pages go through the renderer, which is nil here
+		_ = handler.MessagePermalink(c)
+		return rec
+	}
+
+	t.Run("redirects to the chat page focused on the message", func(t *testing.T) {
+		for _, chatID := range []string{testChat.ID.String(), ""} {
+			rec := serve(t, chatID, msg.ID().String())
+
+			assert.Equal(t, http.StatusFound, rec.Code)
+			assert.Equal(t,
+				"/workspaces/"+workspaceID.String()+"/chats/"+testChat.ID.String()+"?message="+msg.ID().String(),
+				rec.Header().Get("Location"),
+			)
+		}
+	})
+
+	t.Run("message from another chat is not found", func(t *testing.T) {
+		rec := serve(t, uuid.NewUUID().String(), msg.ID().String())
+		assert.NotEqual(t, http.StatusFound, rec.Code)
+	})
+
+	t.Run("unknown message is not found", func(t *testing.T) {
+		rec := serve(t, "", uuid.NewUUID().String())
+		assert.NotEqual(t, http.StatusFound, rec.Code)
+	})
+}
+
+func TestChatTemplateHandler_TaskPermalink(t *testing.T) {
+	userID := uuid.NewUUID()
+	workspaceID := uuid.NewUUID()
+
+	chats := NewMockChatTemplateService()
+	taskChat := makeChatDTO(workspaceID, userID, "Fix login", chat.TypeTask)
+	discussion := makeChatDTO(workspaceID, userID, "General", chat.TypeDiscussion)
+	chats.AddChat(taskChat)
+	chats.AddChat(discussion)
+
+	handler := httphandler.NewChatTemplateHandler(nil, nil, chats, NewMockMessageTemplateService(), nil)
+
+	serve := func(t *testing.T, taskID string) *httptest.ResponseRecorder {
+		t.Helper()
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodGet, "/tasks/"+taskID, nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("task_id")
+		c.SetParamValues(taskID)
+		setUserContextForTemplate(c, userID)
+
+		// 404 pages go through the renderer, which is nil here
+		_ = handler.TaskPermalink(c)
+		return rec
+	}
+
+	t.Run("redirects to the task chat", func(t *testing.T) {
+		rec := serve(t, taskChat.ID.String())
+
+		assert.Equal(t, http.StatusFound, rec.Code)
+		assert.Equal(t,
+			"/workspaces/"+workspaceID.String()+"/chats/"+taskChat.ID.String(),
+			rec.Header().Get("Location"),
+		)
+	})
+
+	t.Run("discussion is not a task", func(t *testing.T) {
+		rec := serve(t, discussion.ID.String())
+		assert.NotEqual(t, http.StatusFound, rec.Code)
+	})
+
+	t.Run("unknown task is not found", func(t *testing.T) {
+		rec := serve(t, uuid.NewUUID().String())
+		assert.NotEqual(t, http.StatusFound, rec.Code)
+	})
+}
+
+func TestChatTemplateHandler_MessagesPartial_Around(t *testing.T) {
+	e := echo.New()
+	e.Renderer = newTestRenderer(t)
+	userID := uuid.NewUUID()
+	chatID := uuid.NewUUID()
+
+	messages := NewMockMessageTemplateService()
+	first := makeTestMessage(chatID, userID, "First")
+	target := makeTestMessage(chatID, userID, "Target")
+	messages.AddMessage(first)
+	messages.AddMessage(target)
+
+	handler := httphandler.NewChatTemplateHandler(nil, nil, NewMockChatTemplateService(), messages, nil)
+
+	req := httptest.NewRequest(
+		http.MethodGet, "/partials/chats/"+chatID.String()+"/messages?around="+target.ID().String(), nil,
+	)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetParamNames("chat_id")
+	c.SetParamValues(chatID.String())
+	setUserContextForTemplate(c, userID)
+
+	require.NoError(t, handler.MessagesPartial(c))
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	body := rec.Body.String()
+	assert.Equal(t, 1, strings.Count(body, " highlighted\""))
+	assert.Regexp(t, `highlighted"\s+id="message-`+target.ID().String()+`"`, body)
+}
+
+func TestChatTemplateHandler_MessagesPartial_DeletedMessage(t *testing.T) {
+	authorID := uuid.NewUUID()
+	moderatorID := uuid.NewUUID()
+	chatID := uuid.NewUUID()
+
+	chats := NewMockChatTemplateService()
+	chats.AddChat(&chatapp.Chat{
+		ID: chatID,
+		Participants: []chatapp.Participant{
+			{UserID: authorID, Role: chat.RoleMember},
+			{UserID: moderatorID, Role: chat.RoleAdmin},
+		},
+	})
+	messages := NewMockMessageTemplateService()
+	deleted := makeTestMessage(chatID, authorID, "Posted in the wrong chat")
+	require.NoError(t, deleted.Delete(authorID))
+	messages.AddMessage(deleted)
+
+	handler := httphandler.NewChatTemplateHandler(nil, nil, chats, messages, nil)
+	render := func(t *testing.T, userID uuid.UUID) string {
+		t.Helper()
+		e := echo.New()
+		e.Renderer = newTestRenderer(t)
+		req := httptest.NewRequest(http.MethodGet, "/partials/chats/"+chatID.String()+"/messages", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("chat_id")
+		c.SetParamValues(chatID.String())
+		setUserContextForTemplate(c, userID)
+
+		require.NoError(t, handler.MessagesPartial(c))
+		require.Equal(t, http.StatusOK, rec.Code)
+		return rec.Body.String()
+	}
+
+	t.Run("members see the tombstone only", func(t *testing.T) {
+		body := render(t, authorID)
+
+		assert.Contains(t, body, "This message has been deleted.")
+		assert.NotContains(t, body, "Posted in the wrong chat")
+	})
+
+	t.Run("moderators see the original", func(t *testing.T) {
+		body := render(t, moderatorID)
+
+		assert.Contains(t, body, "This message has been deleted.")
+		assert.Contains(t, body, "Posted in the wrong chat")
+	})
+
+	t.Run("purged messages keep the tombstone", func(t *testing.T) {
+		require.NoError(t, deleted.Purge())
+
+		body := render(t, moderatorID)
+
+		assert.Contains(t, body, "This message has been deleted.")
+		assert.NotContains(t, body, "Show original")
+	})
+}
+
 func TestChatTemplateHandler_MessageEditForm(t *testing.T) {
 	t.Run("successful get edit form for own message", func(t *testing.T) {
 		e := echo.New()
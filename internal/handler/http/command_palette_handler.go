@@ -0,0 +1,426 @@
+package httphandler
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/labstack/echo/v4"
+	chatapp "github.com/lllypuk/flowra/internal/application/chat"
+	"github.com/lllypuk/flowra/internal/domain/chat"
+	"github.com/lllypuk/flowra/internal/domain/errs"
+	"github.com/lllypuk/flowra/internal/domain/uuid"
+	"github.com/lllypuk/flowra/internal/infrastructure/httpserver"
+	"github.com/lllypuk/flowra/internal/middleware"
+)
+
+// Command palette limits.
+const (
+	defaultPaletteLimit   = 20
+	maxPaletteLimit       = 50
+	maxPaletteQueryLength = 100
+
+	// paletteCandidateLimit bounds the chats and members ranked per request.
+	paletteCandidateLimit = 200
+)
+
+// Command palette match scores, best first. A query matches a candidate by
+// its whole text, a prefix, the prefix of one of its words or a substring.
+const (
+	paletteScoreExact      = 100
+	paletteScorePrefix     = 80
+	paletteScoreWordPrefix = 60
+	paletteScoreSubstring  = 40
+	// paletteScoreCreate ranks "create task" below every match but still offers it.
+	paletteScoreCreate = 10
+	// paletteScoreAny is the score of every candidate for an empty query.
+	paletteScoreAny = 1
+)
+
+// Command palette bonuses break ties between equally good matches: actions on
+// the current task first, then tasks, chats the user is in and people.
+const (
+	paletteBonusStatus      = 5
+	paletteBonusTask        = 4
+	paletteBonusChat        = 3
+	paletteBonusDirect      = 2
+	paletteBonusParticipant = 2
+)
+
+// PaletteCommandKind identifies what a command palette entry does.
+type PaletteCommandKind string
+
+// Command palette entry kinds.
+const (
+	PaletteCommandJumpToChat   PaletteCommandKind = "jump_to_chat"
+	PaletteCommandJumpToTask   PaletteCommandKind = "jump_to_task"
+	PaletteCommandCreateTask   PaletteCommandKind = "create_task"
+	PaletteCommandChangeStatus PaletteCommandKind = "change_status"
+	PaletteCommandStartDM      PaletteCommandKind = "start_dm"
+)
+
+// CommandPaletteChatFinder loads the chats offered by the command palette.
+// Declared on the consumer side per project guidelines.
+type CommandPaletteChatFinder interface {
+	FindByID(ctx context.Context, chatID uuid.UUID) (*chatapp.ReadModel, error)
+	FindByWorkspace(ctx context.Context, workspaceID uuid.UUID, filters chatapp.Filters) ([]*chatapp.ReadModel, error)
+}
+
+// PaletteCommandResponse is one entry of the command palette. Entries with a
+// URL navigate to a page of the web UI; entries with an action call the API.
+type PaletteCommandResponse struct {
+	ID       string                 `json:"id"`
+	Kind     PaletteCommandKind     `json:"kind"`
+	Title    string                 `json:"title"`
+	Subtitle string                 `json:"subtitle,omitempty"`
+	Score    int                    `json:"score"`
+	URL      string                 `json:"url,omitempty"`
+	Action   *PaletteActionResponse `json:"action,omitempty"`
+}
+
+// PaletteActionResponse describes the API request performing a command.
+type PaletteActionResponse struct {
+	Method string         `json:"method"`
+	Path   string         `json:"path"`
+	Body   map[string]any `json:"body,omitempty"`
+}
+
+// CommandPaletteResponse is the ranked list of commands for a query.
+type CommandPaletteResponse struct {
+	Query    string                   `json:"query"`
+	Commands []PaletteCommandResponse `json:"commands"`
+}
+
+// CommandPaletteHandler aggregates the quick actions of the keyboard-driven
+// command palette: jumping to chats and tasks, creating a task, changing the
+// status of the current task and messaging a workspace member. Only chats the
+// user may read are offered, and status changes only to participants of the
+// current task.
+type CommandPaletteHandler struct {
+	chats   CommandPaletteChatFinder
+	members BoardMemberService
+}
+
+// NewCommandPaletteHandler creates a new CommandPaletteHandler.
+func NewCommandPaletteHandler(chats CommandPaletteChatFinder, members BoardMemberService) *CommandPaletteHandler {
+	return &CommandPaletteHandler{
+		chats:   chats,
+		members: members,
+	}
+}
+
+// List handles GET /api/v1/workspaces/:workspace_id/commands?q=&chat_id=&limit=.
+// chat_id names the chat open in the UI; status commands are offered for it
+// when it is a task, bug or epic.
+func (h *CommandPaletteHandler) List(c echo.Context) error {
+	userID := middleware.GetUserID(c)
+	if userID.IsZero() {
+		return httpserver.RespondErrorWithCode(c, http.StatusUnauthorized, "UNAUTHORIZED", "authentication required")
+	}
+
+	workspaceID, parseErr := uuid.ParseUUID(c.Param("workspace_id"))
+	if parseErr != nil {
+		return httpserver.RespondErrorWithCode(
+			c, http.StatusBadRequest, "INVALID_WORKSPACE_ID", "invalid workspace ID format")
+	}
+
+	query := strings.TrimSpace(c.QueryParam("q"))
+	if len([]rune(query)) > maxPaletteQueryLength {
+		return httpserver.RespondErrorWithCode(
+			c, http.StatusBadRequest, "VALIDATION_ERROR", "q must be at most 100 characters")
+	}
+
+	limit := defaultPaletteLimit
+	if raw := c.QueryParam("limit"); raw != "" {
+		parsed, err := strconv.Atoi(raw)
+		if err != nil || parsed < 1 {
+			return httpserver.RespondErrorWithCode(
+				c, http.StatusBadRequest, "VALIDATION_ERROR", "limit must be a positive integer")
+		}
+		limit = min(parsed, maxPaletteLimit)
+	}
+
+	var currentChatID uuid.UUID
+	if raw := c.QueryParam("chat_id"); raw != "" {
+		id, err := uuid.ParseUUID(raw)
+		if err != nil {
+			return httpserver.RespondErrorWithCode(
+				c, http.StatusBadRequest, "INVALID_CHAT_ID", "invalid chat ID format")
+		}
+		currentChatID = id
+	}
+
+	ctx := c.Request().Context()
+	needle := strings.ToLower(query)
+	var commands []PaletteCommandResponse
+
+	statusCommands, err := h.statusCommands(ctx, workspaceID, userID, currentChatID, needle)
+	if err != nil {
+		return httpserver.RespondErrorWithCode(
+			c, http.StatusInternalServerError, "INTERNAL_ERROR", "failed to load chat")
+	}
+	commands = append(commands, statusCommands...)
+
+	jumpCommands, err := h.jumpCommands(ctx, workspaceID, userID, query, needle)
+	if err != nil {
+		return httpserver.RespondErrorWithCode(
+			c, http.StatusInternalServerError, "INTERNAL_ERROR", "failed to load chats")
+	}
+	commands = append(commands, jumpCommands...)
+
+	commands = append(commands, createTaskCommand(workspaceID, query))
+
+	dmCommands, err := h.directMessageCommands(ctx, workspaceID, userID, needle)
+	if err != nil {
+		return httpserver.RespondErrorWithCode(
+			c, http.StatusInternalServerError, "INTERNAL_ERROR", "failed to load members")
+	}
+	commands = append(commands, dmCommands...)
+
+	// Stable, so equally ranked chats stay in order of recent activity
+	slices.SortStableFunc(commands, func(a, b PaletteCommandResponse) int {
+		return cmp.Compare(b.Score, a.Score)
+	})
+	if len(commands) > limit {
+		commands = commands[:limit]
+	}
+
+	return httpserver.RespondOK(c, CommandPaletteResponse{Query: query, Commands: commands})
+}
+
+// statusCommands offers the statuses of the current task to its participants.
+func (h *CommandPaletteHandler) statusCommands(
+	ctx context.Context,
+	workspaceID, userID, chatID uuid.UUID,
+	needle string,
+) ([]PaletteCommandResponse, error) {
+	if chatID.IsZero() {
+		return nil, nil
+	}
+	rm, err := h.chats.FindByID(ctx, chatID)
+	if err != nil {
+		if errors.Is(err, errs.ErrNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if rm.WorkspaceID != workspaceID || rm.Type == chat.TypeDiscussion || !isPaletteParticipant(rm, userID) {
+		return nil, nil
+	}
+
+	var commands []PaletteCommandResponse
+	for _, option := range getChatStatusOptions(string(rm.Type)) {
+		title := "Change status to " + option.Label
+		score := paletteMatchScore(needle, title, option.Label)
+		if score == 0 {
+			continue
+		}
+		commands = append(commands, PaletteCommandResponse{
+			ID:       "status:" + option.Value,
+			Kind:     PaletteCommandChangeStatus,
+			Title:    title,
+			Subtitle: rm.Title,
+			Score:    score + paletteBonusStatus,
+			Action: &PaletteActionResponse{
+				Method: http.MethodPost,
+				Path:   workspaceAPIPath(workspaceID, "/chats/"+rm.ID.String()+"/actions/status"),
+				Body:   map[string]any{"status": option.Value},
+			},
+		})
+	}
+	return commands, nil
+}
+
+// jumpCommands offers the chats and tasks matching the query that the user may
+// read: public ones and those the user participates in.
+func (h *CommandPaletteHandler) jumpCommands(
+	ctx context.Context,
+	workspaceID, userID uuid.UUID,
+	query, needle string,
+) ([]PaletteCommandResponse, error) {
+	chats, err := h.chats.FindByWorkspace(ctx, workspaceID, chatapp.Filters{
+		Search: query,
+		Limit:  paletteCandidateLimit,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	commands := make([]PaletteCommandResponse, 0, len(chats))
+	for _, rm := range chats {
+		participant := isPaletteParticipant(rm, userID)
+		if !rm.IsPublic && !participant {
+			continue
+		}
+		score := paletteMatchScore(needle, rm.Title)
+		if score == 0 {
+			continue
+		}
+		if participant {
+			score += paletteBonusParticipant
+		}
+
+		cmd := PaletteCommandResponse{
+			ID:    "chat:" + rm.ID.String(),
+			Kind:  PaletteCommandJumpToChat,
+			Title: rm.Title,
+			Score: score + paletteBonusChat,
+			URL:   chatPageURL(rm.WorkspaceID.String(), rm.ID.String(), ""),
+		}
+		if rm.Type != chat.TypeDiscussion {
+			// Tasks are typed chats and share their ID
+			cmd.ID = "task:" + rm.ID.String()
+			cmd.Kind = PaletteCommandJumpToTask
+			cmd.Subtitle = chatTypeLabel(rm.Type)
+			cmd.Score = score + paletteBonusTask
+			cmd.URL = "/tasks/" + rm.ID.String()
+		}
+		commands = append(commands, cmd)
+	}
+	return commands, nil
+}
+
+// directMessageCommands offers the workspace members matching the query. A
+// private discussion of the user with just that member is reopened; otherwise
+// one is created.
+func (h *CommandPaletteHandler) directMessageCommands(
+	ctx context.Context,
+	workspaceID, userID uuid.UUID,
+	needle string,
+) ([]PaletteCommandResponse, error) {
+	members, err := h.members.ListWorkspaceMembers(ctx, workspaceID, 0, paletteCandidateLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	discussion, private := chat.TypeDiscussion, false
+	privateChats, err := h.chats.FindByWorkspace(ctx, workspaceID, chatapp.Filters{
+		Type:     &discussion,
+		IsPublic: &private,
+		UserID:   &userID,
+		Limit:    paletteCandidateLimit,
+	})
+	if err != nil {
+		return nil, err
+	}
+	direct := make(map[uuid.UUID]*chatapp.ReadModel, len(privateChats))
+	for _, rm := range privateChats {
+		if len(rm.Participants) != 2 { //nolint:mnd // the user and one other member
+			continue
+		}
+		for _, p := range rm.Participants {
+			if p.UserID() != userID {
+				if _, seen := direct[p.UserID()]; !seen {
+					direct[p.UserID()] = rm
+				}
+			}
+		}
+	}
+
+	var commands []PaletteCommandResponse
+	for _, m := range members {
+		memberID, parseErr := uuid.ParseUUID(m.UserID)
+		if parseErr != nil || memberID == userID {
+			continue
+		}
+		score := paletteMatchScore(needle, m.DisplayName, m.Username)
+		if score == 0 {
+			continue
+		}
+
+		cmd := PaletteCommandResponse{
+			ID:       "dm:" + m.UserID,
+			Kind:     PaletteCommandStartDM,
+			Title:    "Message " + m.DisplayName,
+			Subtitle: "@" + m.Username,
+			Score:    score + paletteBonusDirect,
+		}
+		if rm, ok := direct[memberID]; ok {
+			cmd.URL = chatPageURL(workspaceID.String(), rm.ID.String(), "")
+		} else {
+			cmd.Action = &PaletteActionResponse{
+				Method: http.MethodPost,
+				Path:   workspaceAPIPath(workspaceID, "/chats"),
+				Body: map[string]any{
+					"name":            m.DisplayName,
+					"type":            string(chat.TypeDiscussion),
+					"is_public":       false,
+					"participant_ids": []string{m.UserID},
+				},
+			}
+		}
+		commands = append(commands, cmd)
+	}
+	return commands, nil
+}
+
+// createTaskCommand offers to create a task titled after the query.
+func createTaskCommand(workspaceID uuid.UUID, query string) PaletteCommandResponse {
+	cmd := PaletteCommandResponse{
+		ID:    "create-task",
+		Kind:  PaletteCommandCreateTask,
+		Title: "Create task",
+		Score: paletteScoreAny,
+		Action: &PaletteActionResponse{
+			Method: http.MethodPost,
+			Path:   workspaceAPIPath(workspaceID, "/chats"),
+			Body:   map[string]any{"type": string(chat.TypeTask), "is_public": true},
+		},
+	}
+	if query != "" {
+		cmd.Title = "Create task \"" + query + "\""
+		cmd.Score = paletteScoreCreate
+		cmd.Action.Body["name"] = query
+	}
+	return cmd
+}
+
+// paletteMatchScore returns how well the lowercase needle matches the best of
+// the candidates, 0 when it matches none.
+func paletteMatchScore(needle string, candidates ...string) int {
+	if needle == "" {
+		return paletteScoreAny
+	}
+	best := 0
+	for _, candidate := range candidates {
+		text := strings.ToLower(candidate)
+		score := 0
+		switch {
+		case text == needle:
+			score = paletteScoreExact
+		case strings.HasPrefix(text, needle):
+			score = paletteScorePrefix
+		case hasWordPrefix(text, needle):
+			score = paletteScoreWordPrefix
+		case strings.Contains(text, needle):
+			score = paletteScoreSubstring
+		}
+		best = max(best, score)
+	}
+	return best
+}
+
+// hasWordPrefix reports whether one of the words of text starts with needle.
+func hasWordPrefix(text, needle string) bool {
+	words := strings.FieldsFunc(text, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	return slices.ContainsFunc(words, func(word string) bool {
+		return strings.HasPrefix(word, needle)
+	})
+}
+
+func isPaletteParticipant(rm *chatapp.ReadModel, userID uuid.UUID) bool {
+	return slices.ContainsFunc(rm.Participants, func(p chat.Participant) bool {
+		return p.UserID() == userID
+	})
+}
+
+func workspaceAPIPath(workspaceID uuid.UUID, path string) string {
+	return "/api/v1/workspaces/" + workspaceID.String() + path
+}
@@ -0,0 +1,226 @@
+package httphandler_test
+
+import (
+	"context"
+	"encoding/json"
+	stdhttp "net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	chatapp "github.com/lllypuk/flowra/internal/application/chat"
+	"github.com/lllypuk/flowra/internal/domain/chat"
+	"github.com/lllypuk/flowra/internal/domain/errs"
+	"github.com/lllypuk/flowra/internal/domain/uuid"
+	httphandler "github.com/lllypuk/flowra/internal/handler/http"
+	"github.com/lllypuk/flowra/internal/middleware"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakePaletteChats struct {
+	chats []*chatapp.ReadModel
+}
+
+func (f fakePaletteChats) FindByID(_ context.Context, chatID uuid.UUID) (*chatapp.ReadModel, error) {
+	for _, rm := range f.chats {
+		if rm.ID == chatID {
+			return rm, nil
+		}
+	}
+	return nil, errs.ErrNotFound
+}
+
+func (f fakePaletteChats) FindByWorkspace(
+	_ context.Context,
+	workspaceID uuid.UUID,
+	filters chatapp.Filters,
+) ([]*chatapp.ReadModel, error) {
+	var found []*chatapp.ReadModel
+	for _, rm := range f.chats {
+		switch {
+		case rm.WorkspaceID != workspaceID,
+			filters.Type != nil && rm.Type != *filters.Type,
+			filters.IsPublic != nil && rm.IsPublic != *filters.IsPublic,
+			filters.UserID != nil && !hasParticipant(rm, *filters.UserID),
+			!strings.Contains(strings.ToLower(rm.Title), strings.ToLower(filters.Search)):
+			continue
+		}
+		found = append(found, rm)
+	}
+	return found, nil
+}
+
+func hasParticipant(rm *chatapp.ReadModel, userID uuid.UUID) bool {
+	for _, p := range rm.Participants {
+		if p.UserID() == userID {
+			return true
+		}
+	}
+	return false
+}
+
+type paletteFixture struct {
+	workspaceID uuid.UUID
+	user        uuid.UUID
+	colleague   uuid.UUID
+	task        *chatapp.ReadModel
+	privateChat *chatapp.ReadModel
+	directChat  *chatapp.ReadModel
+	handler     *httphandler.CommandPaletteHandler
+}
+
+func newPaletteFixture() *paletteFixture {
+	workspaceID, user, colleague, stranger := uuid.NewUUID(), uuid.NewUUID(), uuid.NewUUID(), uuid.NewUUID()
+	newChat := func(typ chat.Type, title string, public bool, participants ...uuid.UUID) *chatapp.ReadModel {
+		rm := &chatapp.ReadModel{ID: uuid.NewUUID(), WorkspaceID: workspaceID, Type: typ, Title: title, IsPublic: public}
+		for _, p := range participants {
+			rm.Participants = append(rm.Participants, chat.NewParticipant(p, chat.RoleMember))
+		}
+		return rm
+	}
+
+	f := &paletteFixture{
+		workspaceID: workspaceID,
+		user:        user,
+		colleague:   colleague,
+		task:        newChat(chat.TypeBug, "Login fails on Safari", false, user),
+		privateChat: newChat(chat.TypeDiscussion, "Login redesign", false, stranger),
+		directChat:  newChat(chat.TypeDiscussion, "Grace", false, user, colleague),
+	}
+	chats := fakePaletteChats{chats: []*chatapp.ReadModel{
+		f.task,
+		newChat(chat.TypeDiscussion, "Release planning", true, stranger),
+		f.privateChat,
+		f.directChat,
+	}}
+
+	members := NewMockBoardMemberService()
+	members.AddMembers(workspaceID, []httphandler.MemberViewData{
+		{UserID: user.String(), Username: "ada", DisplayName: "Ada Lovelace"},
+		{UserID: colleague.String(), Username: "grace", DisplayName: "Grace Hopper"},
+		{UserID: stranger.String(), Username: "linus", DisplayName: "Linus Torvalds"},
+	})
+
+	f.handler = httphandler.NewCommandPaletteHandler(chats, members)
+	return f
+}
+
+func (f *paletteFixture) list(t *testing.T, query string) (int, []httphandler.PaletteCommandResponse) {
+	t.Helper()
+	req := httptest.NewRequest(stdhttp.MethodGet, "/?"+query, nil)
+	rec := httptest.NewRecorder()
+	c := echo.New().NewContext(req, rec)
+	c.SetParamNames("workspace_id")
+	c.SetParamValues(f.workspaceID.String())
+	c.Set(string(middleware.ContextKeyUserID), f.user)
+
+	require.NoError(t, f.handler.List(c))
+	var resp struct {
+		Data httphandler.CommandPaletteResponse `json:"data"`
+	}
+	if rec.Code == stdhttp.StatusOK {
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+	}
+	return rec.Code, resp.Data.Commands
+}
+
+func paletteCommandIDs(commands []httphandler.PaletteCommandResponse) []string {
+	ids := make([]string, len(commands))
+	for i, cmd := range commands {
+		ids[i] = cmd.ID
+	}
+	return ids
+}
+
+func TestCommandPaletteHandler_List(t *testing.T) {
+	t.Run("ranks matches and hides chats the user cannot read", func(t *testing.T) {
+		f := newPaletteFixture()
+
+		code, commands := f.list(t, "q=login")
+
+		require.Equal(t, stdhttp.StatusOK, code)
+		assert.Equal(t, []string{"task:" + f.task.ID.String(), "create-task"}, paletteCommandIDs(commands))
+		assert.Equal(t, httphandler.PaletteCommandJumpToTask, commands[0].Kind)
+		assert.Equal(t, "/tasks/"+f.task.ID.String(), commands[0].URL)
+		assert.Equal(t, "Bug", commands[0].Subtitle)
+		assert.Equal(t, `Create task "login"`, commands[1].Title)
+		assert.Equal(t, "login", commands[1].Action.Body["name"])
+	})
+
+	t.Run("offers status changes of the current task", func(t *testing.T) {
+		f := newPaletteFixture()
+
+		code, commands := f.list(t, "q=fix&chat_id="+f.task.ID.String())
+
+		require.Equal(t, stdhttp.StatusOK, code)
+		require.NotEmpty(t, commands)
+		assert.Equal(t, "status:Fixed", commands[0].ID)
+		assert.Equal(t, httphandler.PaletteCommandChangeStatus, commands[0].Kind)
+		require.NotNil(t, commands[0].Action)
+		assert.Equal(t, stdhttp.MethodPost, commands[0].Action.Method)
+		assert.Equal(t, "/api/v1/workspaces/"+f.workspaceID.String()+"/chats/"+f.task.ID.String()+"/actions/status",
+			commands[0].Action.Path)
+		assert.Equal(t, "Fixed", commands[0].Action.Body["status"])
+	})
+
+	t.Run("offers no status changes to non-participants", func(t *testing.T) {
+		f := newPaletteFixture()
+
+		_, commands := f.list(t, "chat_id="+f.privateChat.ID.String())
+
+		for _, cmd := range commands {
+			assert.NotEqual(t, httphandler.PaletteCommandChangeStatus, cmd.Kind)
+			assert.NotEqual(t, "chat:"+f.privateChat.ID.String(), cmd.ID)
+		}
+	})
+
+	t.Run("reopens an existing direct chat or starts one", func(t *testing.T) {
+		f := newPaletteFixture()
+
+		_, commands := f.list(t, "q=grace")
+		byID := make(map[string]httphandler.PaletteCommandResponse)
+		for _, cmd := range commands {
+			byID[cmd.ID] = cmd
+		}
+
+		direct := byID["dm:"+f.colleague.String()]
+		assert.Equal(t, "/workspaces/"+f.workspaceID.String()+"/chats/"+f.directChat.ID.String(), direct.URL)
+		assert.Nil(t, direct.Action)
+
+		_, commands = f.list(t, "q=linus")
+		require.NotEmpty(t, commands)
+		start := commands[0]
+		assert.Equal(t, httphandler.PaletteCommandStartDM, start.Kind)
+		assert.Equal(t, "Message Linus Torvalds", start.Title)
+		require.NotNil(t, start.Action)
+		assert.Equal(t, false, start.Action.Body["is_public"])
+	})
+
+	t.Run("never offers to message oneself", func(t *testing.T) {
+		f := newPaletteFixture()
+
+		_, commands := f.list(t, "q=ada")
+
+		assert.NotContains(t, paletteCommandIDs(commands), "dm:"+f.user.String())
+	})
+
+	t.Run("limits the results", func(t *testing.T) {
+		f := newPaletteFixture()
+
+		code, commands := f.list(t, "limit=2")
+
+		require.Equal(t, stdhttp.StatusOK, code)
+		assert.Len(t, commands, 2)
+	})
+
+	t.Run("rejects invalid parameters", func(t *testing.T) {
+		f := newPaletteFixture()
+
+		for _, query := range []string{"limit=0", "chat_id=nope", "q=" + strings.Repeat("a", 101)} {
+			code, _ := f.list(t, query)
+			assert.Equal(t, stdhttp.StatusBadRequest, code, query)
+		}
+	})
+}
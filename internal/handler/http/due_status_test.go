@@ -0,0 +1,44 @@
+//nolint:testpackage // Tests unexported due status helpers.
+package httphandler
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	taskapp "github.com/lllypuk/flowra/internal/application/task"
+	"github.com/lllypuk/flowra/internal/domain/task"
+)
+
+func TestCalculateDueStatus_UsesViewerTimezone(t *testing.T) {
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	require.NoError(t, err)
+
+	// Due midnight Oct 20 in Tokyo is still Oct 19 in UTC.
+	dueDate := time.Date(2025, 10, 20, 0, 0, 0, 0, tokyo)
+	model := &taskapp.ReadModel{Status: task.StatusInProgress, DueDate: &dueDate}
+	h := &TaskDetailTemplateHandler{}
+
+	t.Run("due today in the viewer's zone", func(t *testing.T) {
+		var view TaskDetailViewData
+		h.calculateDueStatus(&view, model, time.Date(2025, 10, 20, 18, 0, 0, 0, tokyo))
+		assert.True(t, view.IsDueToday)
+		assert.False(t, view.IsOverdue)
+	})
+
+	t.Run("overdue the next day", func(t *testing.T) {
+		var view TaskDetailViewData
+		h.calculateDueStatus(&view, model, time.Date(2025, 10, 21, 9, 0, 0, 0, tokyo))
+		assert.True(t, view.IsOverdue)
+		assert.Equal(t, 1, view.OverdueDays)
+	})
+
+	t.Run("due tomorrow when viewed from UTC", func(t *testing.T) {
+		var view TaskDetailViewData
+		h.calculateDueStatus(&view, model, time.Date(2025, 10, 18, 12, 0, 0, 0, time.UTC))
+		assert.True(t, view.IsDueSoon)
+		assert.Equal(t, 1, view.DaysUntilDue)
+	})
+}
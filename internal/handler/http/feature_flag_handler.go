@@ -0,0 +1,123 @@
+package httphandler
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/lllypuk/flowra/internal/infrastructure/featureflag"
+	"github.com/lllypuk/flowra/internal/infrastructure/httpserver"
+	"github.com/lllypuk/flowra/internal/middleware"
+)
+
+// FeatureFlagStore reads and switches runtime feature flags.
+// Declared on the consumer side per project guidelines.
+type FeatureFlagStore interface {
+	List(ctx context.Context) ([]featureflag.Flag, error)
+	Set(ctx context.Context, flag featureflag.Flag) error
+	Delete(ctx context.Context, name string) error
+}
+
+// UpdateFeatureFlagRequest represents a request to switch a feature flag.
+type UpdateFeatureFlagRequest struct {
+	Enabled bool `json:"enabled"`
+}
+
+// FeatureFlagResponse represents a feature flag in API responses.
+type FeatureFlagResponse struct {
+	Name      string    `json:"name"`
+	Enabled   bool      `json:"enabled"`
+	UpdatedBy string    `json:"updated_by,omitempty"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// FeatureFlagListResponse represents the list of feature flags.
+type FeatureFlagListResponse struct {
+	Flags []FeatureFlagResponse `json:"flags"`
+}
+
+// FeatureFlagHandler handles the feature flag admin API.
+type FeatureFlagHandler struct {
+	store FeatureFlagStore
+}
+
+// NewFeatureFlagHandler creates a new FeatureFlagHandler.
+func NewFeatureFlagHandler(store FeatureFlagStore) *FeatureFlagHandler {
+	return &FeatureFlagHandler{store: store}
+}
+
+// RegisterRoutes registers the feature flag routes with the router. System admins only.
+func (h *FeatureFlagHandler) RegisterRoutes(r *httpserver.Router) {
+	admin := r.NewAuthRouteGroup("/admin/feature-flags").RequireSystemAdmin()
+	admin.GET("", h.List)
+	admin.PUT("/:name", h.Update)
+	admin.DELETE("/:name", h.Delete)
+}
+
+// List handles GET /api/v1/admin/feature-flags.
+func (h *FeatureFlagHandler) List(c echo.Context) error {
+	flags, err := h.store.List(c.Request().Context())
+	if err != nil {
+		return httpserver.RespondErrorWithCode(
+			c, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to list feature flags")
+	}
+
+	resp := FeatureFlagListResponse{Flags: make([]FeatureFlagResponse, 0, len(flags))}
+	for _, flag := range flags {
+		resp.Flags = append(resp.Flags, ToFeatureFlagResponse(flag))
+	}
+	return httpserver.RespondOK(c, resp)
+}
+
+// Update handles PUT /api/v1/admin/feature-flags/:name.
+// The flag is created if it does not exist.
+func (h *FeatureFlagHandler) Update(c echo.Context) error {
+	name := c.Param("name")
+	if err := featureflag.ValidateName(name); err != nil {
+		return httpserver.RespondErrorWithCode(c, http.StatusBadRequest, "VALIDATION_ERROR", err.Error())
+	}
+
+	var req UpdateFeatureFlagRequest
+	if err := c.Bind(&req); err != nil {
+		return httpserver.RespondErrorWithCode(c, http.StatusBadRequest, "INVALID_REQUEST", "Invalid request body")
+	}
+
+	flag := featureflag.Flag{
+		Name:      name,
+		Enabled:   req.Enabled,
+		UpdatedBy: middleware.GetUserID(c),
+		UpdatedAt: time.Now().UTC(),
+	}
+	if err := h.store.Set(c.Request().Context(), flag); err != nil {
+		return httpserver.RespondErrorWithCode(
+			c, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to update feature flag")
+	}
+	return httpserver.RespondOK(c, ToFeatureFlagResponse(flag))
+}
+
+// Delete handles DELETE /api/v1/admin/feature-flags/:name.
+func (h *FeatureFlagHandler) Delete(c echo.Context) error {
+	if err := h.store.Delete(c.Request().Context(), c.Param("name")); err != nil {
+		if errors.Is(err, featureflag.ErrNotFound) {
+			return httpserver.RespondErrorWithCode(c, http.StatusNotFound, "NOT_FOUND", "Feature flag not found")
+		}
+		return httpserver.RespondErrorWithCode(
+			c, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to delete feature flag")
+	}
+	return httpserver.RespondNoContent(c)
+}
+
+// ToFeatureFlagResponse converts a feature flag to its API representation.
+func ToFeatureFlagResponse(flag featureflag.Flag) FeatureFlagResponse {
+	resp := FeatureFlagResponse{
+		Name:      flag.Name,
+		Enabled:   flag.Enabled,
+		UpdatedAt: flag.UpdatedAt,
+	}
+	if !flag.UpdatedBy.IsZero() {
+		resp.UpdatedBy = flag.UpdatedBy.String()
+	}
+	return resp
+}
@@ -0,0 +1,71 @@
+package httphandler_test
+
+import (
+	"context"
+	stdhttp "net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/lllypuk/flowra/internal/domain/uuid"
+	httphandler "github.com/lllypuk/flowra/internal/handler/http"
+	"github.com/lllypuk/flowra/internal/infrastructure/featureflag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newFeatureFlagContext(method, name, body string, adminID uuid.UUID) (echo.Context, *httptest.ResponseRecorder) {
+	e := echo.New()
+	req := httptest.NewRequest(method, "/", strings.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	if name != "" {
+		c.SetParamNames("name")
+		c.SetParamValues(name)
+	}
+	setupUserAuthContext(c, adminID)
+	return c, rec
+}
+
+func TestFeatureFlagHandler(t *testing.T) {
+	adminID := uuid.NewUUID()
+
+	t.Run("switches and lists flags", func(t *testing.T) {
+		store := featureflag.NewMemoryStore()
+		handler := httphandler.NewFeatureFlagHandler(store)
+
+		c, rec := newFeatureFlagContext(stdhttp.MethodPut, "search.v2", `{"enabled":true}`, adminID)
+		require.NoError(t, handler.Update(c))
+		assert.Equal(t, stdhttp.StatusOK, rec.Code)
+		assert.Contains(t, rec.Body.String(), adminID.String())
+		assert.True(t, featureflag.Enabled(context.Background(), store, "search.v2"))
+
+		c, rec = newFeatureFlagContext(stdhttp.MethodGet, "", "", adminID)
+		require.NoError(t, handler.List(c))
+		assert.Equal(t, stdhttp.StatusOK, rec.Code)
+		assert.Contains(t, rec.Body.String(), `"name":"search.v2","enabled":true`)
+
+		c, rec = newFeatureFlagContext(stdhttp.MethodDelete, "search.v2", "", adminID)
+		require.NoError(t, handler.Delete(c))
+		assert.Equal(t, stdhttp.StatusNoContent, rec.Code)
+		assert.False(t, featureflag.Enabled(context.Background(), store, "search.v2"))
+	})
+
+	t.Run("rejects invalid flag name", func(t *testing.T) {
+		handler := httphandler.NewFeatureFlagHandler(featureflag.NewMemoryStore())
+
+		c, rec := newFeatureFlagContext(stdhttp.MethodPut, "Not Valid", `{"enabled":true}`, adminID)
+		require.NoError(t, handler.Update(c))
+		assert.Equal(t, stdhttp.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("deleting unknown flag returns not found", func(t *testing.T) {
+		handler := httphandler.NewFeatureFlagHandler(featureflag.NewMemoryStore())
+
+		c, rec := newFeatureFlagContext(stdhttp.MethodDelete, "missing", "", adminID)
+		require.NoError(t, handler.Delete(c))
+		assert.Equal(t, stdhttp.StatusNotFound, rec.Code)
+	})
+}
@@ -2,10 +2,13 @@ package httphandler
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io"
 	"mime"
 	"net/http"
 	"net/url"
+	"os"
 	"path/filepath"
 	"strings"
 	"time"
@@ -14,6 +17,7 @@ import (
 	"github.com/lllypuk/flowra/internal/domain/uuid"
 	"github.com/lllypuk/flowra/internal/infrastructure/filestorage"
 	"github.com/lllypuk/flowra/internal/infrastructure/httpserver"
+	"github.com/lllypuk/flowra/internal/infrastructure/resilience"
 	"github.com/lllypuk/flowra/internal/middleware"
 )
 
@@ -24,6 +28,10 @@ const (
 	mimeOctetStream      = "application/octet-stream"
 )
 
+// ErrStorageQuotaExceeded is returned when an upload does not fit the attachment
+// storage quota of its workspace.
+var ErrStorageQuotaExceeded = errors.New("workspace storage quota exceeded")
+
 // FileUploadResponse represents the response after uploading a file.
 type FileUploadResponse struct {
 	FileID   uuid.UUID `json:"file_id"`
@@ -45,6 +53,7 @@ type FileMetadataEntry struct {
 	ChatID     uuid.UUID
 	UploaderID uuid.UUID
 	UploadedAt time.Time
+	FileName   string // sanitized name the file was stored under, if recorded
 }
 
 // FileChatParticipantChecker verifies user is a participant of a chat.
@@ -52,12 +61,46 @@ type FileChatParticipantChecker interface {
 	IsParticipant(ctx context.Context, chatID uuid.UUID, userID uuid.UUID) (bool, error)
 }
 
+// StorageUsage is the attachment storage used by a workspace.
+type StorageUsage struct {
+	UsedBytes  int64
+	QuotaBytes int64 // 0 = unlimited
+}
+
+// WorkspaceStorageQuota tracks and enforces the attachment storage of workspaces.
+// Declared on the consumer side per project guidelines.
+type WorkspaceStorageQuota interface {
+	// GetUsage returns the attachment storage used by the workspace.
+	GetUsage(ctx context.Context, workspaceID uuid.UUID) (StorageUsage, error)
+
+	// CheckUpload returns ErrStorageQuotaExceeded when size more bytes do not fit the quota.
+	CheckUpload(ctx context.Context, workspaceID uuid.UUID, size int64) (StorageUsage, error)
+
+	// RecordUpload adds an uploaded file to the usage of the workspace.
+	RecordUpload(ctx context.Context, workspaceID uuid.UUID, size int64) error
+}
+
+// FileChatWorkspaceResolver returns the workspace a chat belongs to.
+// Declared on the consumer side per project guidelines.
+type FileChatWorkspaceResolver interface {
+	ChatWorkspaceID(ctx context.Context, chatID uuid.UUID) (uuid.UUID, error)
+}
+
+// FileStorageGuard bounds concurrency and duration of blob store writes.
+// Declared on the consumer side per project guidelines.
+type FileStorageGuard interface {
+	Do(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
 // FileHandler handles file upload and download HTTP requests.
 type FileHandler struct {
 	storage          *filestorage.LocalStorage
+	storageGuard     FileStorageGuard
 	metadataRepo     FileMetadataLookup
 	participantCheck FileChatParticipantChecker
 	maxFileSize      int64
+	quota            WorkspaceStorageQuota
+	chatWorkspaces   FileChatWorkspaceResolver
 }
 
 // NewFileHandler creates a new FileHandler.
@@ -91,10 +134,27 @@ func WithMaxFileSize(size int64) FileHandlerOption {
 	}
 }
 
+// WithFileStorageGuard runs blob store writes through the given guard.
+func WithFileStorageGuard(guard FileStorageGuard) FileHandlerOption {
+	return func(h *FileHandler) {
+		h.storageGuard = guard
+	}
+}
+
+// WithFileStorageQuota enforces the attachment storage quota of the workspace
+// the chat belongs to and counts uploads against it.
+func WithFileStorageQuota(quota WorkspaceStorageQuota, chatWorkspaces FileChatWorkspaceResolver) FileHandlerOption {
+	return func(h *FileHandler) {
+		h.quota = quota
+		h.chatWorkspaces = chatWorkspaces
+	}
+}
+
 // RegisterRoutes registers file routes with the router.
 func (h *FileHandler) RegisterRoutes(r *httpserver.Router) {
 	r.Auth().POST("/files/upload", h.Upload)
 	r.Auth().GET("/files/:file_id/:file_name", h.Download)
+	r.Auth().GET("/files/:file_id/:file_name/preview", h.Preview)
 }
 
 // Upload handles POST /api/v1/files/upload.
@@ -145,15 +205,23 @@ func (h *FileHandler) Upload(c echo.Context) error {
 			fmt.Sprintf("file size exceeds %d MB limit", h.maxFileSize/bytesPerMB))
 	}
 
-	// Detect MIME type
-	mimeType := file.Header.Get("Content-Type")
-	if mimeType == "" || mimeType == mimeOctetStream {
-		mimeType = mime.TypeByExtension(filepath.Ext(file.Filename))
-		if mimeType == "" {
-			mimeType = mimeOctetStream
+	var workspaceID uuid.UUID
+	if h.quota != nil {
+		var resolveErr error
+		workspaceID, resolveErr = h.chatWorkspaces.ChatWorkspaceID(c.Request().Context(), chatID)
+		if resolveErr != nil {
+			return httpserver.RespondErrorWithCode(
+				c, http.StatusInternalServerError, "QUOTA_CHECK_FAILED", "failed to check storage quota")
+		}
+		usage, quotaErr := h.quota.CheckUpload(c.Request().Context(), workspaceID, file.Size)
+		if quotaErr != nil {
+			return respondStorageQuotaError(c, usage, quotaErr)
 		}
 	}
 
+	// Detect MIME type
+	mimeType := detectUploadMIME(file.Header.Get("Content-Type"), file.Filename)
+
 	// Validate MIME type
 	if !isAllowedMIME(mimeType) {
 		return httpserver.RespondErrorWithCode(
@@ -172,8 +240,12 @@ func (h *FileHandler) Upload(c echo.Context) error {
 	safeName := sanitizeFileName(file.Filename)
 
 	// Save to storage
-	fileID, saveErr := h.storage.Save(src, safeName)
+	fileID, saveErr := h.save(c.Request().Context(), src, safeName)
 	if saveErr != nil {
+		var depErr *resilience.DependencyError
+		if errors.As(saveErr, &depErr) {
+			return httpserver.RespondError(c, depErr)
+		}
 		return httpserver.RespondErrorWithCode(
 			c, http.StatusInternalServerError, "STORAGE_ERROR", "failed to save file")
 	}
@@ -186,6 +258,10 @@ func (h *FileHandler) Upload(c echo.Context) error {
 		UploadedAt: time.Now().UTC(),
 	})
 
+	if h.quota != nil {
+		_ = h.quota.RecordUpload(c.Request().Context(), workspaceID, file.Size)
+	}
+
 	resp := FileUploadResponse{
 		FileID:   fileID,
 		FileName: safeName,
@@ -200,21 +276,57 @@ func (h *FileHandler) Upload(c echo.Context) error {
 // Download handles GET /api/v1/files/:file_id/:file_name.
 // Serves the file with appropriate content type after verifying authorization.
 func (h *FileHandler) Download(c echo.Context) error {
+	fileID, fileName, ok, err := h.authorizeFile(c)
+	if !ok {
+		return err
+	}
+	return h.serveFile(c, fileID, fileName)
+}
+
+// Preview handles GET /api/v1/files/:file_id/:file_name/preview.
+// Serves the JPEG preview the worker generated for an image or PDF, with the same
+// authorization as the file itself. Responds 404 while no preview exists.
+func (h *FileHandler) Preview(c echo.Context) error {
+	fileID, _, ok, err := h.authorizeFile(c)
+	if !ok {
+		return err
+	}
+
+	previewPath, pathErr := h.storage.PreviewPath(fileID)
+	if pathErr != nil {
+		return httpserver.RespondErrorWithCode(
+			c, http.StatusBadRequest, "INVALID_PATH", "invalid file path")
+	}
+	if _, statErr := os.Stat(previewPath); statErr != nil {
+		return httpserver.RespondErrorWithCode(
+			c, http.StatusNotFound, "PREVIEW_NOT_FOUND", "preview not found")
+	}
+
+	c.Response().Header().Set("Content-Disposition", "inline")
+	c.Response().Header().Set(echo.HeaderContentType, "image/jpeg")
+	return c.File(previewPath)
+}
+
+// authorizeFile parses the file of the request and verifies that the user has access
+// to the chat it was uploaded to. When ok is false, the error response has been written
+// and err is the result of writing it.
+func (h *FileHandler) authorizeFile(c echo.Context) (uuid.UUID, string, bool, error) {
 	userID := middleware.GetUserID(c)
 	if userID.IsZero() {
-		return httpserver.RespondErrorWithCode(c, http.StatusUnauthorized, "UNAUTHORIZED", "authentication required")
+		return "", "", false, httpserver.RespondErrorWithCode(
+			c, http.StatusUnauthorized, "UNAUTHORIZED", "authentication required")
 	}
 
 	fileIDStr := c.Param("file_id")
 	fileID, parseErr := uuid.ParseUUID(fileIDStr)
 	if parseErr != nil {
-		return httpserver.RespondErrorWithCode(
+		return "", "", false, httpserver.RespondErrorWithCode(
 			c, http.StatusBadRequest, "INVALID_FILE_ID", "invalid file ID format")
 	}
 
 	fileName := filepath.Base(c.Param("file_name"))
 	if fileName == "" || fileName == "." {
-		return httpserver.RespondErrorWithCode(
+		return "", "", false, httpserver.RespondErrorWithCode(
 			c, http.StatusBadRequest, "INVALID_FILE_NAME", "file name is required")
 	}
 
@@ -222,16 +334,43 @@ func (h *FileHandler) Download(c echo.Context) error {
 	meta, metaErr := h.metadataRepo.FindByFileID(c.Request().Context(), fileID)
 	if metaErr != nil {
 		// Files without metadata (uploaded before migration) are served without auth check
-		return h.serveFile(c, fileID, fileName)
+		return fileID, fileName, true, nil
 	}
 
 	isMember, memberErr := h.participantCheck.IsParticipant(c.Request().Context(), meta.ChatID, userID)
 	if memberErr != nil || !isMember {
-		return httpserver.RespondErrorWithCode(
+		return "", "", false, httpserver.RespondErrorWithCode(
 			c, http.StatusForbidden, "FORBIDDEN", "you do not have access to this file")
 	}
 
-	return h.serveFile(c, fileID, fileName)
+	return fileID, fileName, true, nil
+}
+
+// respondStorageQuotaError responds 413 with the storage usage when an upload
+// does not fit the workspace quota and 500 when the quota could not be checked.
+func respondStorageQuotaError(c echo.Context, usage StorageUsage, err error) error {
+	if errors.Is(err, ErrStorageQuotaExceeded) {
+		return httpserver.RespondErrorWithCode(c, http.StatusRequestEntityTooLarge, "STORAGE_QUOTA_EXCEEDED",
+			fmt.Sprintf("workspace storage quota exceeded: %s of %s used",
+				formatFileSize(usage.UsedBytes), formatFileSize(usage.QuotaBytes)))
+	}
+	return httpserver.RespondErrorWithCode(
+		c, http.StatusInternalServerError, "QUOTA_CHECK_FAILED", "failed to check storage quota")
+}
+
+// save writes the upload to storage, through the storage guard if one is configured.
+func (h *FileHandler) save(ctx context.Context, src io.Reader, fileName string) (uuid.UUID, error) {
+	if h.storageGuard == nil {
+		return h.storage.Save(src, fileName)
+	}
+
+	var fileID uuid.UUID
+	err := h.storageGuard.Do(ctx, func(ctx context.Context) error {
+		var saveErr error
+		fileID, saveErr = h.storage.Save(resilience.NewContextReader(ctx, src), fileName)
+		return saveErr
+	})
+	return fileID, err
 }
 
 // serveFile serves a file from storage with appropriate headers.
@@ -254,8 +393,8 @@ func (h *FileHandler) serveFile(c echo.Context, fileID uuid.UUID, fileName strin
 		contentType = mimeOctetStream
 	}
 
-	// For images, serve inline; for other files, force download
-	if strings.HasPrefix(contentType, "image/") {
+	// For images and audio, serve inline; for other files, force download
+	if strings.HasPrefix(contentType, "image/") || strings.HasPrefix(contentType, "audio/") {
 		c.Response().Header().Set("Content-Disposition", fmt.Sprintf("inline; filename=%q", fileName))
 	} else {
 		c.Response().Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", fileName))
@@ -279,9 +418,22 @@ func sanitizeFileName(name string) string {
 	return safe
 }
 
+// detectUploadMIME returns the declared MIME type of an upload, falling back
+// to the file extension when the client sent none or a generic one.
+func detectUploadMIME(declared, fileName string) string {
+	if declared != "" && declared != mimeOctetStream {
+		return declared
+	}
+	if byExt := mime.TypeByExtension(filepath.Ext(fileName)); byExt != "" {
+		return byExt
+	}
+	return mimeOctetStream
+}
+
 func isAllowedMIME(mimeType string) bool {
 	allowed := []string{
 		"image/",
+		"audio/",
 		"application/pdf",
 		"application/msword",
 		"application/vnd.openxmlformats",
@@ -8,6 +8,7 @@ import (
 	"mime/multipart"
 	stdhttp "net/http"
 	"net/http/httptest"
+	"net/textproto"
 	"strings"
 	"testing"
 	"time"
@@ -18,13 +19,17 @@ import (
 	httphandler "github.com/lllypuk/flowra/internal/handler/http"
 	"github.com/lllypuk/flowra/internal/infrastructure/filestorage"
 	"github.com/lllypuk/flowra/internal/infrastructure/httpserver"
+	"github.com/lllypuk/flowra/internal/infrastructure/resilience"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
-// mockFileMetadataRepo implements httphandler.FileMetadataLookup for tests.
+// mockFileMetadataRepo implements httphandler.ChatAttachmentMetadata for tests.
+// Like the MongoDB repository, it keeps one entry per file ID.
 type mockFileMetadataRepo struct {
-	entries map[string]*httphandler.FileMetadataEntry
+	entries   map[string]*httphandler.FileMetadataEntry
+	findErr   error
+	afterFind func()
 }
 
 func newMockFileMetadataRepo() *mockFileMetadataRepo {
@@ -32,6 +37,9 @@ func newMockFileMetadataRepo() *mockFileMetadataRepo {
 }
 
 func (m *mockFileMetadataRepo) Save(_ context.Context, meta httphandler.FileMetadataEntry) error {
+	if _, ok := m.entries[meta.FileID.String()]; ok {
+		return errs.ErrAlreadyExists
+	}
 	m.entries[meta.FileID.String()] = &meta
 	return nil
 }
@@ -40,6 +48,12 @@ func (m *mockFileMetadataRepo) FindByFileID(
 	_ context.Context,
 	fileID uuid.UUID,
 ) (*httphandler.FileMetadataEntry, error) {
+	if m.afterFind != nil {
+		defer m.afterFind()
+	}
+	if m.findErr != nil {
+		return nil, m.findErr
+	}
 	entry, ok := m.entries[fileID.String()]
 	if !ok {
 		return nil, errs.ErrNotFound
@@ -47,6 +61,11 @@ func (m *mockFileMetadataRepo) FindByFileID(
 	return entry, nil
 }
 
+func (m *mockFileMetadataRepo) Delete(_ context.Context, fileID uuid.UUID) error {
+	delete(m.entries, fileID.String())
+	return nil
+}
+
 // mockParticipantChecker implements httphandler.FileChatParticipantChecker for tests.
 type mockParticipantChecker struct {
 	participants map[string]map[string]bool // chatID -> userID -> isMember
@@ -275,6 +294,127 @@ func TestFileHandler_Upload(t *testing.T) {
 		fileID := uuid.UUID(data["file_id"].(string))
 		assert.True(t, storage.Exists(fileID, "saved.txt"))
 	})
+
+	t.Run("returns 503 when storage bulkhead is full", func(t *testing.T) {
+		storage, err := filestorage.NewLocalStorage(t.TempDir())
+		require.NoError(t, err)
+		participantChecker := newMockParticipantChecker()
+		participantChecker.AddParticipant(chatID, userID)
+		guard := resilience.NewBulkhead(resilience.BulkheadConfig{Name: "blob", MaxConcurrent: 1, MaxWait: -1})
+		handler := httphandler.NewFileHandler(
+			storage, newMockFileMetadataRepo(), participantChecker,
+			httphandler.WithFileStorageGuard(guard),
+		)
+		e := echo.New()
+
+		body, contentType := createMultipartFileWithChatID(t, "busy.txt", "content", chatID)
+		req := httptest.NewRequest(stdhttp.MethodPost, "/api/v1/files/upload", body)
+		req.Header.Set(echo.HeaderContentType, contentType)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		setupAuthContext(c, userID)
+
+		err = guard.Do(context.Background(), func(context.Context) error {
+			return handler.Upload(c)
+		})
+		require.NoError(t, err)
+		assert.Equal(t, stdhttp.StatusServiceUnavailable, rec.Code)
+
+		var resp httpserver.Response
+		err = json.Unmarshal(rec.Body.Bytes(), &resp)
+		require.NoError(t, err)
+		assert.Equal(t, "DEPENDENCY_UNAVAILABLE", resp.Error.Code)
+	})
+}
+
+// stubStorageQuota enforces a fixed quota on in-memory usage
+type stubStorageQuota struct {
+	quota int64
+	used  map[uuid.UUID]int64
+}
+
+func newStubStorageQuota(quota int64) *stubStorageQuota {
+	return &stubStorageQuota{quota: quota, used: make(map[uuid.UUID]int64)}
+}
+
+func (q *stubStorageQuota) GetUsage(_ context.Context, workspaceID uuid.UUID) (httphandler.StorageUsage, error) {
+	return httphandler.StorageUsage{UsedBytes: q.used[workspaceID], QuotaBytes: q.quota}, nil
+}
+
+func (q *stubStorageQuota) CheckUpload(
+	ctx context.Context,
+	workspaceID uuid.UUID,
+	size int64,
+) (httphandler.StorageUsage, error) {
+	usage, _ := q.GetUsage(ctx, workspaceID)
+	if usage.UsedBytes+size > q.quota {
+		return usage, httphandler.ErrStorageQuotaExceeded
+	}
+	return usage, nil
+}
+
+func (q *stubStorageQuota) RecordUpload(_ context.Context, workspaceID uuid.UUID, size int64) error {
+	q.used[workspaceID] += size
+	return nil
+}
+
+type stubChatWorkspaces struct {
+	workspaceID uuid.UUID
+}
+
+func (s stubChatWorkspaces) ChatWorkspaceID(context.Context, uuid.UUID) (uuid.UUID, error) {
+	return s.workspaceID, nil
+}
+
+func TestFileHandler_Upload_StorageQuota(t *testing.T) {
+	chatID := uuid.NewUUID()
+	workspaceID := uuid.NewUUID()
+	userID := uuid.UUID("user-123")
+
+	upload := func(t *testing.T, handler *httphandler.FileHandler, content string) *httptest.ResponseRecorder {
+		t.Helper()
+		body, contentType := createMultipartFileWithChatID(t, "notes.txt", content, chatID)
+		req := httptest.NewRequest(stdhttp.MethodPost, "/api/v1/files/upload", body)
+		req.Header.Set(echo.HeaderContentType, contentType)
+		rec := httptest.NewRecorder()
+		c := echo.New().NewContext(req, rec)
+		setupAuthContext(c, userID)
+		require.NoError(t, handler.Upload(c))
+		return rec
+	}
+
+	newHandler := func(t *testing.T, quota *stubStorageQuota) *httphandler.FileHandler {
+		t.Helper()
+		storage, err := filestorage.NewLocalStorage(t.TempDir())
+		require.NoError(t, err)
+		participantChecker := newMockParticipantChecker()
+		participantChecker.AddParticipant(chatID, userID)
+		return httphandler.NewFileHandler(
+			storage, newMockFileMetadataRepo(), participantChecker,
+			httphandler.WithFileStorageQuota(quota, stubChatWorkspaces{workspaceID: workspaceID}),
+		)
+	}
+
+	t.Run("counts uploads against the workspace", func(t *testing.T) {
+		quota := newStubStorageQuota(1024)
+		rec := upload(t, newHandler(t, quota), "hello world")
+
+		assert.Equal(t, stdhttp.StatusCreated, rec.Code)
+		assert.Equal(t, int64(len("hello world")), quota.used[workspaceID])
+	})
+
+	t.Run("rejects uploads beyond the quota", func(t *testing.T) {
+		quota := newStubStorageQuota(1024)
+		quota.used[workspaceID] = 1020
+		rec := upload(t, newHandler(t, quota), "hello world")
+
+		assert.Equal(t, stdhttp.StatusRequestEntityTooLarge, rec.Code)
+		var resp httpserver.Response
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+		assert.Equal(t, "STORAGE_QUOTA_EXCEEDED", resp.Error.Code)
+		assert.Contains(t, resp.Error.Message, "1020 B of 1.0 KB used")
+		assert.Equal(t, int64(1020), quota.used[workspaceID])
+	})
 }
 
 func TestFileHandler_Download(t *testing.T) {
@@ -460,6 +600,70 @@ func TestFileHandler_Download(t *testing.T) {
 	})
 }
 
+func TestFileHandler_Preview(t *testing.T) {
+	chatID := uuid.NewUUID()
+	userID := uuid.UUID("user-123")
+
+	previewRequest := func(
+		t *testing.T,
+		handler *httphandler.FileHandler,
+		fileID uuid.UUID,
+	) *httptest.ResponseRecorder {
+		t.Helper()
+		req := httptest.NewRequest(stdhttp.MethodGet,
+			fmt.Sprintf("/api/v1/files/%s/photo.jpg/preview", fileID.String()), nil)
+		rec := httptest.NewRecorder()
+		c := echo.New().NewContext(req, rec)
+		c.SetParamNames("file_id", "file_name")
+		c.SetParamValues(fileID.String(), "photo.jpg")
+		setupAuthContext(c, userID)
+		require.NoError(t, handler.Preview(c))
+		return rec
+	}
+
+	t.Run("serves the preview to participants", func(t *testing.T) {
+		handler, storage, metadataRepo, participantChecker := newTestFileHandler(t)
+		participantChecker.AddParticipant(chatID, userID)
+
+		fileID, err := storage.Save(strings.NewReader("full size"), "photo.jpg")
+		require.NoError(t, err)
+		require.NoError(t, storage.SavePreview(fileID, strings.NewReader("thumbnail")))
+		_ = metadataRepo.Save(context.Background(), httphandler.FileMetadataEntry{
+			FileID: fileID, ChatID: chatID, UploaderID: userID, UploadedAt: time.Now(),
+		})
+
+		rec := previewRequest(t, handler, fileID)
+		assert.Equal(t, stdhttp.StatusOK, rec.Code)
+		assert.Equal(t, "thumbnail", rec.Body.String())
+		assert.Equal(t, "image/jpeg", rec.Header().Get(echo.HeaderContentType))
+	})
+
+	t.Run("not found before the preview is generated", func(t *testing.T) {
+		handler, storage, _, _ := newTestFileHandler(t)
+
+		fileID, err := storage.Save(strings.NewReader("full size"), "photo.jpg")
+		require.NoError(t, err)
+
+		rec := previewRequest(t, handler, fileID)
+		assert.Equal(t, stdhttp.StatusNotFound, rec.Code)
+		assert.Contains(t, rec.Body.String(), "PREVIEW_NOT_FOUND")
+	})
+
+	t.Run("rejects users outside the chat", func(t *testing.T) {
+		handler, storage, metadataRepo, _ := newTestFileHandler(t)
+
+		fileID, err := storage.Save(strings.NewReader("full size"), "photo.jpg")
+		require.NoError(t, err)
+		require.NoError(t, storage.SavePreview(fileID, strings.NewReader("thumbnail")))
+		_ = metadataRepo.Save(context.Background(), httphandler.FileMetadataEntry{
+			FileID: fileID, ChatID: chatID, UploaderID: uuid.UUID("other-user"), UploadedAt: time.Now(),
+		})
+
+		rec := previewRequest(t, handler, fileID)
+		assert.Equal(t, stdhttp.StatusForbidden, rec.Code)
+	})
+}
+
 func TestIsAllowedMIME(t *testing.T) {
 	chatID := uuid.NewUUID()
 	userID := uuid.UUID("user-123")
@@ -498,6 +702,35 @@ func TestIsAllowedMIME(t *testing.T) {
 		assert.Equal(t, stdhttp.StatusCreated, rec.Code)
 	})
 
+	t.Run("allows audio", func(t *testing.T) {
+		handler, _, _, participantChecker := newTestFileHandler(t)
+		participantChecker.AddParticipant(chatID, userID)
+		e := echo.New()
+
+		body := new(bytes.Buffer)
+		writer := multipart.NewWriter(body)
+		require.NoError(t, writer.WriteField("chat_id", chatID.String()))
+		header := textproto.MIMEHeader{}
+		header.Set("Content-Disposition", `form-data; name="file"; filename="voice.webm"`)
+		header.Set("Content-Type", "audio/webm;codecs=opus")
+		part, err := writer.CreatePart(header)
+		require.NoError(t, err)
+		_, err = part.Write([]byte("opus data"))
+		require.NoError(t, err)
+		require.NoError(t, writer.Close())
+
+		req := httptest.NewRequest(stdhttp.MethodPost, "/api/v1/files/upload", body)
+		req.Header.Set(echo.HeaderContentType, writer.FormDataContentType())
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		setupAuthContext(c, userID)
+
+		err = handler.Upload(c)
+		require.NoError(t, err)
+		assert.Equal(t, stdhttp.StatusCreated, rec.Code)
+		assert.Contains(t, rec.Body.String(), "audio/webm")
+	})
+
 	t.Run("allows text/plain", func(t *testing.T) {
 		handler, _, _, participantChecker := newTestFileHandler(t)
 		participantChecker.AddParticipant(chatID, userID)
@@ -0,0 +1,243 @@
+package httphandler
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/lllypuk/flowra/internal/domain/event"
+	"github.com/lllypuk/flowra/internal/domain/uuid"
+	"github.com/lllypuk/flowra/internal/domain/workspace"
+)
+
+// Fragment cache defaults.
+const (
+	DefaultFragmentCacheTTL        = 5 * time.Minute
+	DefaultFragmentCacheMaxEntries = 10000
+)
+
+// FragmentCacheEventTypes returns the events that invalidate cached fragments.
+func FragmentCacheEventTypes() []string {
+	return []string{
+		workspace.EventTypeWorkspaceCreated,
+		workspace.EventTypeWorkspaceUpdated,
+		workspace.EventTypeWorkspaceDeleted,
+		workspace.EventTypeMemberAdded,
+		workspace.EventTypeMemberRemoved,
+		workspace.EventTypeMemberRoleChanged,
+	}
+}
+
+// FragmentCacheConfig holds configuration for the fragment cache.
+type FragmentCacheConfig struct {
+	// TTL bounds how long a fragment is served without an invalidating event.
+	TTL time.Duration
+	// MaxEntries caps the number of cached fragments.
+	MaxEntries int
+}
+
+// FragmentCache caches rendered HTML fragments that are expensive to build and
+// change rarely (workspace list, member pickers). Entries are tagged with the
+// users and workspaces they depend on and dropped when a matching domain event
+// arrives. A nil *FragmentCache is valid and caches nothing.
+type FragmentCache struct {
+	mu         sync.Mutex
+	ttl        time.Duration
+	maxEntries int
+	entries    map[string]*fragmentEntry
+	tagIndex   map[string]map[string]struct{} // tag -> keys
+	now        func() time.Time
+}
+
+type fragmentEntry struct {
+	body      []byte
+	tags      []string
+	expiresAt time.Time
+}
+
+// NewFragmentCache creates a new fragment cache.
+func NewFragmentCache(cfg FragmentCacheConfig) *FragmentCache {
+	if cfg.TTL <= 0 {
+		cfg.TTL = DefaultFragmentCacheTTL
+	}
+	if cfg.MaxEntries <= 0 {
+		cfg.MaxEntries = DefaultFragmentCacheMaxEntries
+	}
+	return &FragmentCache{
+		ttl:        cfg.TTL,
+		maxEntries: cfg.MaxEntries,
+		entries:    make(map[string]*fragmentEntry),
+		tagIndex:   make(map[string]map[string]struct{}),
+		now:        time.Now,
+	}
+}
+
+// UserFragmentTag returns the invalidation tag for fragments that depend on a user.
+func UserFragmentTag(userID uuid.UUID) string {
+	return "user:" + userID.String()
+}
+
+// WorkspaceFragmentTag returns the invalidation tag for fragments that depend on a workspace.
+func WorkspaceFragmentTag(workspaceID uuid.UUID) string {
+	return "workspace:" + workspaceID.String()
+}
+
+// Get returns the cached fragment for key.
+func (f *FragmentCache) Get(key string) ([]byte, bool) {
+	if f == nil {
+		return nil, false
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	entry, ok := f.entries[key]
+	if !ok {
+		return nil, false
+	}
+	if f.now().After(entry.expiresAt) {
+		f.remove(key)
+		return nil, false
+	}
+	return entry.body, true
+}
+
+// Set stores a fragment under key, tagged for invalidation.
+// When the cache is full and nothing has expired the fragment is not stored.
+func (f *FragmentCache) Set(key string, body []byte, tags ...string) {
+	if f == nil {
+		return
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.remove(key)
+	if len(f.entries) >= f.maxEntries {
+		f.purgeExpired()
+		if len(f.entries) >= f.maxEntries {
+			return
+		}
+	}
+
+	f.entries[key] = &fragmentEntry{
+		body:      append([]byte(nil), body...),
+		tags:      tags,
+		expiresAt: f.now().Add(f.ttl),
+	}
+	for _, tag := range tags {
+		keys, ok := f.tagIndex[tag]
+		if !ok {
+			keys = make(map[string]struct{})
+			f.tagIndex[tag] = keys
+		}
+		keys[key] = struct{}{}
+	}
+}
+
+// Invalidate drops every fragment carrying one of tags.
+func (f *FragmentCache) Invalidate(tags ...string) {
+	if f == nil {
+		return
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for _, tag := range tags {
+		for key := range f.tagIndex[tag] {
+			f.remove(key)
+		}
+	}
+}
+
+// Len returns the number of cached fragments.
+func (f *FragmentCache) Len() int {
+	if f == nil {
+		return 0
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.entries)
+}
+
+// HandleEvent invalidates fragments affected by a workspace or membership event.
+// It matches the event bus handler signature; see FragmentCacheEventTypes.
+func (f *FragmentCache) HandleEvent(_ context.Context, evt event.DomainEvent) error {
+	if f == nil || evt == nil {
+		return nil
+	}
+
+	workspaceTag := WorkspaceFragmentTag(uuid.UUID(evt.AggregateID()))
+	userID := fragmentEventUserID(evt)
+
+	switch evt.EventType() {
+	case workspace.EventTypeWorkspaceCreated:
+		f.Invalidate(UserFragmentTag(userID))
+	case workspace.EventTypeWorkspaceUpdated, workspace.EventTypeWorkspaceDeleted:
+		f.Invalidate(workspaceTag)
+	case workspace.EventTypeMemberAdded, workspace.EventTypeMemberRemoved, workspace.EventTypeMemberRoleChanged:
+		f.Invalidate(workspaceTag, UserFragmentTag(userID))
+	}
+
+	return nil
+}
+
+// remove deletes key and its tag index entries. Callers must hold f.mu.
+func (f *FragmentCache) remove(key string) {
+	entry, ok := f.entries[key]
+	if !ok {
+		return
+	}
+	delete(f.entries, key)
+	for _, tag := range entry.tags {
+		keys := f.tagIndex[tag]
+		delete(keys, key)
+		if len(keys) == 0 {
+			delete(f.tagIndex, tag)
+		}
+	}
+}
+
+// purgeExpired drops expired fragments. Callers must hold f.mu.
+func (f *FragmentCache) purgeExpired() {
+	now := f.now()
+	for key, entry := range f.entries {
+		if now.After(entry.expiresAt) {
+			f.remove(key)
+		}
+	}
+}
+
+// fragmentEventUserID extracts the affected user from typed events or,
+// for events received from the bus, from their JSON payload.
+func fragmentEventUserID(evt event.DomainEvent) uuid.UUID {
+	switch e := evt.(type) {
+	case *workspace.Created:
+		return e.CreatedBy
+	case *workspace.MemberAdded:
+		return e.UserID
+	case *workspace.MemberRemoved:
+		return e.UserID
+	case *workspace.MemberRoleChanged:
+		return e.UserID
+	}
+
+	pe, ok := evt.(interface{ Payload() json.RawMessage })
+	if !ok {
+		return ""
+	}
+	var payload struct {
+		UserID    uuid.UUID
+		CreatedBy uuid.UUID
+	}
+	if err := json.Unmarshal(pe.Payload(), &payload); err != nil {
+		return ""
+	}
+	if payload.UserID != "" {
+		return payload.UserID
+	}
+	return payload.CreatedBy
+}
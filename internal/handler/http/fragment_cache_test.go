@@ -0,0 +1,119 @@
+package httphandler_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lllypuk/flowra/internal/domain/event"
+	"github.com/lllypuk/flowra/internal/domain/uuid"
+	"github.com/lllypuk/flowra/internal/domain/workspace"
+	httphandler "github.com/lllypuk/flowra/internal/handler/http"
+)
+
+// busEvent mimics an event received from the event bus, which only carries a JSON payload.
+type busEvent struct {
+	event.BaseEvent
+
+	payload json.RawMessage
+}
+
+func (e *busEvent) Payload() json.RawMessage { return e.payload }
+
+func TestFragmentCache_GetSetInvalidate(t *testing.T) {
+	cache := httphandler.NewFragmentCache(httphandler.FragmentCacheConfig{})
+	wsA, wsB := uuid.NewUUID(), uuid.NewUUID()
+
+	cache.Set("list", []byte("<ul/>"), httphandler.WorkspaceFragmentTag(wsA), httphandler.WorkspaceFragmentTag(wsB))
+	cache.Set("options", []byte("<option/>"), httphandler.WorkspaceFragmentTag(wsB))
+
+	body, ok := cache.Get("list")
+	require.True(t, ok)
+	assert.Equal(t, "<ul/>", string(body))
+
+	cache.Invalidate(httphandler.WorkspaceFragmentTag(wsA))
+	_, ok = cache.Get("list")
+	assert.False(t, ok)
+	_, ok = cache.Get("options")
+	assert.True(t, ok)
+	assert.Equal(t, 1, cache.Len())
+}
+
+func TestFragmentCache_TTLAndCapacity(t *testing.T) {
+	cache := httphandler.NewFragmentCache(httphandler.FragmentCacheConfig{TTL: 10 * time.Millisecond, MaxEntries: 1})
+
+	cache.Set("a", []byte("a"))
+	cache.Set("b", []byte("b"))
+	_, ok := cache.Get("b")
+	assert.False(t, ok, "full cache should not store new fragments")
+
+	time.Sleep(20 * time.Millisecond)
+	_, ok = cache.Get("a")
+	assert.False(t, ok, "expired fragment should not be served")
+
+	cache.Set("b", []byte("b"))
+	_, ok = cache.Get("b")
+	assert.True(t, ok)
+}
+
+func TestFragmentCache_NilIsDisabled(t *testing.T) {
+	var cache *httphandler.FragmentCache
+
+	cache.Set("k", []byte("v"))
+	_, ok := cache.Get("k")
+	assert.False(t, ok)
+	cache.Invalidate("user:x")
+	require.NoError(t, cache.HandleEvent(context.Background(), nil))
+}
+
+func TestFragmentCache_HandleEvent(t *testing.T) {
+	ctx := context.Background()
+	workspaceID, userID, otherID := uuid.NewUUID(), uuid.NewUUID(), uuid.NewUUID()
+
+	fill := func(cache *httphandler.FragmentCache) {
+		cache.Set("list:user", nil, httphandler.UserFragmentTag(userID))
+		cache.Set("list:other", nil, httphandler.UserFragmentTag(otherID), httphandler.WorkspaceFragmentTag(workspaceID))
+		cache.Set("options", nil, httphandler.WorkspaceFragmentTag(workspaceID))
+	}
+
+	t.Run("member added invalidates the workspace and the new member", func(t *testing.T) {
+		cache := httphandler.NewFragmentCache(httphandler.FragmentCacheConfig{})
+		fill(cache)
+
+		evt := workspace.NewMemberAdded(workspaceID, userID, workspace.RoleMember, event.Metadata{})
+		require.NoError(t, cache.HandleEvent(ctx, evt))
+		assert.Equal(t, 0, cache.Len())
+	})
+
+	t.Run("workspace created invalidates the creator only", func(t *testing.T) {
+		cache := httphandler.NewFragmentCache(httphandler.FragmentCacheConfig{})
+		fill(cache)
+
+		evt := workspace.NewWorkspaceCreated(uuid.NewUUID(), "New", "", userID, event.Metadata{})
+		require.NoError(t, cache.HandleEvent(ctx, evt))
+		assert.Equal(t, 2, cache.Len())
+	})
+
+	t.Run("events from the bus are read from the payload", func(t *testing.T) {
+		cache := httphandler.NewFragmentCache(httphandler.FragmentCacheConfig{})
+		fill(cache)
+
+		payload, err := json.Marshal(workspace.NewMemberRemoved(uuid.NewUUID(), otherID, event.Metadata{}))
+		require.NoError(t, err)
+		evt := &busEvent{
+			BaseEvent: event.NewBaseEvent(
+				workspace.EventTypeMemberRemoved, uuid.NewUUID().String(), "Workspace", 1, event.Metadata{},
+			),
+			payload: payload,
+		}
+		require.NoError(t, cache.HandleEvent(ctx, evt))
+
+		_, ok := cache.Get("list:other")
+		assert.False(t, ok)
+		assert.Equal(t, 2, cache.Len())
+	})
+}
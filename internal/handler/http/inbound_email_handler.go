@@ -0,0 +1,182 @@
+package httphandler
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/mail"
+	"sort"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+	"github.com/lllypuk/flowra/internal/application/appcore"
+	"github.com/lllypuk/flowra/internal/application/inbound"
+	"github.com/lllypuk/flowra/internal/domain/chat"
+	"github.com/lllypuk/flowra/internal/domain/uuid"
+	"github.com/lllypuk/flowra/internal/infrastructure/httpserver"
+)
+
+const (
+	// inboundSecretHeader carries the shared secret configured at the mail provider
+	inboundSecretHeader = "X-Inbound-Secret"
+
+	// inboundFormMemory is the part of a posted email kept in memory; larger
+	// attachments are spooled to temporary files
+	inboundFormMemory = 8 << 20
+)
+
+// InboundEmailIngester turns a received email into a new chat or a reply in an existing one.
+// Declared on the consumer side per project guidelines.
+type InboundEmailIngester interface {
+	Execute(ctx context.Context, cmd inbound.IngestEmailCommand) (*inbound.IngestResult, error)
+}
+
+// InboundEmailResponse describes the chat created from an email, or the chat a reply was appended to.
+type InboundEmailResponse struct {
+	WorkspaceID        uuid.UUID `json:"workspace_id,omitempty"`
+	ChatID             uuid.UUID `json:"chat_id"`
+	MessageID          uuid.UUID `json:"message_id"`
+	Type               chat.Type `json:"type,omitempty"`
+	Reply              bool      `json:"reply"`
+	Attachments        int       `json:"attachments"`
+	SkippedAttachments int       `json:"skipped_attachments"`
+}
+
+// InboundEmailHandler receives emails posted by the mail provider.
+type InboundEmailHandler struct {
+	ingest  InboundEmailIngester
+	secret  string
+	maxSize int64
+}
+
+// NewInboundEmailHandler creates a new InboundEmailHandler.
+// Requests must carry secret in the X-Inbound-Secret header and stay below maxSize bytes.
+func NewInboundEmailHandler(ingest InboundEmailIngester, secret string, maxSize int64) *InboundEmailHandler {
+	return &InboundEmailHandler{ingest: ingest, secret: secret, maxSize: maxSize}
+}
+
+// RegisterRoutes registers inbound email routes with the router.
+// The endpoint is public: the mail provider authenticates with the shared secret.
+func (h *InboundEmailHandler) RegisterRoutes(r *httpserver.Router) {
+	r.Public().POST("/inbound/email", h.Receive)
+}
+
+// Receive handles POST /api/v1/inbound/email.
+// Accepts a multipart form with from, to, subject and text fields; every file
+// part is stored as an attachment of the posted message.
+func (h *InboundEmailHandler) Receive(c echo.Context) error {
+	provided := c.Request().Header.Get(inboundSecretHeader)
+	if h.secret == "" || subtle.ConstantTimeCompare([]byte(provided), []byte(h.secret)) != 1 {
+		return httpserver.RespondErrorWithCode(c, http.StatusUnauthorized, "UNAUTHORIZED", "invalid inbound secret")
+	}
+
+	c.Request().Body = http.MaxBytesReader(c.Response(), c.Request().Body, h.maxSize)
+	if err := c.Request().ParseMultipartForm(inboundFormMemory); err != nil {
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			return httpserver.RespondErrorWithCode(
+				c, http.StatusRequestEntityTooLarge, "EMAIL_TOO_LARGE", "email exceeds the size limit")
+		}
+		return httpserver.RespondErrorWithCode(c, http.StatusBadRequest, "INVALID_EMAIL", "multipart form expected")
+	}
+	form := c.Request().MultipartForm
+	defer func() { _ = form.RemoveAll() }()
+
+	attachments, skipped := inboundAttachments(form)
+	result, err := h.ingest.Execute(c.Request().Context(), inbound.IngestEmailCommand{
+		From:        c.FormValue("from"),
+		To:          splitRecipients(c.FormValue("to")),
+		Subject:     c.FormValue("subject"),
+		Text:        c.FormValue("text"),
+		Attachments: attachments,
+	})
+	if err != nil {
+		return handleInboundEmailError(c, err)
+	}
+
+	return httpserver.RespondCreated(c, InboundEmailResponse{
+		WorkspaceID:        result.WorkspaceID,
+		ChatID:             result.ChatID,
+		MessageID:          result.MessageID,
+		Type:               result.Type,
+		Reply:              result.Reply,
+		Attachments:        result.Attachments,
+		SkippedAttachments: skipped + len(attachments) - result.Attachments,
+	})
+}
+
+// inboundAttachments collects the file parts of the form in field order.
+// Files of types that regular uploads reject are skipped and counted.
+func inboundAttachments(form *multipart.Form) ([]inbound.Attachment, int) {
+	fields := make([]string, 0, len(form.File))
+	for field := range form.File {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+
+	var (
+		attachments []inbound.Attachment
+		skipped     int
+	)
+	for _, field := range fields {
+		for _, file := range form.File[field] {
+			mimeType := detectUploadMIME(file.Header.Get("Content-Type"), file.Filename)
+			if !isAllowedMIME(mimeType) {
+				skipped++
+				continue
+			}
+			attachments = append(attachments, inbound.Attachment{
+				FileName: sanitizeFileName(file.Filename),
+				MimeType: mimeType,
+				Size:     file.Size,
+				Open: func() (io.ReadCloser, error) {
+					return file.Open()
+				},
+			})
+		}
+	}
+	return attachments, skipped
+}
+
+// splitRecipients splits the To header into single addresses.
+func splitRecipients(to string) []string {
+	if list, err := mail.ParseAddressList(to); err == nil {
+		recipients := make([]string, 0, len(list))
+		for _, addr := range list {
+			recipients = append(recipients, addr.Address)
+		}
+		return recipients
+	}
+	// fall back to a plain comma split so one malformed entry does not hide the others
+	var recipients []string
+	for _, part := range strings.Split(to, ",") {
+		if part = strings.TrimSpace(part); part != "" {
+			recipients = append(recipients, part)
+		}
+	}
+	return recipients
+}
+
+// handleInboundEmailError maps ingestion errors to HTTP responses.
+// Unknown recipients and senders are permanent failures, so providers do not retry them.
+func handleInboundEmailError(c echo.Context, err error) error {
+	var validationErr *appcore.ValidationError
+	switch {
+	case errors.Is(err, inbound.ErrUnknownRecipient):
+		return httpserver.RespondErrorWithCode(
+			c, http.StatusUnprocessableEntity, "UNKNOWN_RECIPIENT", "no workspace address among recipients")
+	case errors.Is(err, inbound.ErrUnknownSender):
+		return httpserver.RespondErrorWithCode(
+			c, http.StatusUnprocessableEntity, "UNKNOWN_SENDER", "sender is not a member of the workspace")
+	case errors.Is(err, inbound.ErrEmptyReply):
+		return httpserver.RespondErrorWithCode(
+			c, http.StatusUnprocessableEntity, "EMPTY_REPLY", "reply has no text above the quoted email")
+	case errors.As(err, &validationErr):
+		return httpserver.RespondErrorWithCode(c, http.StatusBadRequest, "VALIDATION_ERROR", validationErr.Error())
+	default:
+		return httpserver.RespondError(c, err)
+	}
+}
@@ -0,0 +1,130 @@
+package httphandler_test
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"mime/multipart"
+	stdhttp "net/http"
+	"net/http/httptest"
+	"net/textproto"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/lllypuk/flowra/internal/application/inbound"
+	"github.com/lllypuk/flowra/internal/domain/chat"
+	"github.com/lllypuk/flowra/internal/domain/uuid"
+	httphandler "github.com/lllypuk/flowra/internal/handler/http"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type stubInboundIngester struct {
+	last     inbound.IngestEmailCommand
+	contents map[string]string
+	err      error
+}
+
+func (s *stubInboundIngester) Execute(
+	_ context.Context,
+	cmd inbound.IngestEmailCommand,
+) (*inbound.IngestResult, error) {
+	s.last = cmd
+	if s.err != nil {
+		return nil, s.err
+	}
+	s.contents = make(map[string]string)
+	for _, a := range cmd.Attachments {
+		rc, err := a.Open()
+		if err != nil {
+			return nil, err
+		}
+		data, _ := io.ReadAll(rc)
+		_ = rc.Close()
+		s.contents[a.FileName] = string(data)
+	}
+	return &inbound.IngestResult{
+		WorkspaceID: uuid.NewUUID(),
+		ChatID:      uuid.NewUUID(),
+		MessageID:   uuid.NewUUID(),
+		Type:        chat.TypeBug,
+		Attachments: len(cmd.Attachments),
+	}, nil
+}
+
+func newInboundEmailRequest(t *testing.T, secret string) (echo.Context, *httptest.ResponseRecorder) {
+	t.Helper()
+	body := new(bytes.Buffer)
+	writer := multipart.NewWriter(body)
+	require.NoError(t, writer.WriteField("from", `"Alice" <alice@example.com>`))
+	require.NoError(t, writer.WriteField("to", `Team <team@example.com>, ws+bug@in.example`))
+	require.NoError(t, writer.WriteField("subject", "Crash on save"))
+	require.NoError(t, writer.WriteField("text", "It crashes."))
+
+	log, err := writer.CreateFormFile("attachment1", "../crash.txt")
+	require.NoError(t, err)
+	_, _ = log.Write([]byte("stack trace"))
+
+	header := make(textproto.MIMEHeader)
+	header.Set("Content-Disposition", `form-data; name="attachment2"; filename="setup.exe"`)
+	header.Set("Content-Type", "application/x-msdownload")
+	exe, err := writer.CreatePart(header)
+	require.NoError(t, err)
+	_, _ = exe.Write([]byte("MZ"))
+	require.NoError(t, writer.Close())
+
+	e := echo.New()
+	req := httptest.NewRequest(stdhttp.MethodPost, "/api/v1/inbound/email", body)
+	req.Header.Set(echo.HeaderContentType, writer.FormDataContentType())
+	if secret != "" {
+		req.Header.Set("X-Inbound-Secret", secret)
+	}
+	rec := httptest.NewRecorder()
+	return e.NewContext(req, rec), rec
+}
+
+func TestInboundEmailHandler_Receive(t *testing.T) {
+	t.Run("creates chat from email", func(t *testing.T) {
+		ingester := &stubInboundIngester{}
+		handler := httphandler.NewInboundEmailHandler(ingester, "s3cret", 1<<20)
+
+		c, rec := newInboundEmailRequest(t, "s3cret")
+		require.NoError(t, handler.Receive(c))
+
+		assert.Equal(t, stdhttp.StatusCreated, rec.Code)
+		assert.Equal(t, `"Alice" <alice@example.com>`, ingester.last.From)
+		assert.Equal(t, []string{"team@example.com", "ws+bug@in.example"}, ingester.last.To)
+		assert.Equal(t, "Crash on save", ingester.last.Subject)
+		assert.Equal(t, "It crashes.", ingester.last.Text)
+		assert.Equal(t, map[string]string{"crash.txt": "stack trace"}, ingester.contents)
+		assert.Contains(t, rec.Body.String(), `"skipped_attachments":1`)
+	})
+
+	t.Run("wrong secret", func(t *testing.T) {
+		ingester := &stubInboundIngester{}
+		handler := httphandler.NewInboundEmailHandler(ingester, "s3cret", 1<<20)
+
+		c, rec := newInboundEmailRequest(t, "guess")
+		require.NoError(t, handler.Receive(c))
+		assert.Equal(t, stdhttp.StatusUnauthorized, rec.Code)
+		assert.Empty(t, ingester.last.Subject)
+	})
+
+	t.Run("email too large", func(t *testing.T) {
+		handler := httphandler.NewInboundEmailHandler(&stubInboundIngester{}, "s3cret", 64)
+
+		c, rec := newInboundEmailRequest(t, "s3cret")
+		require.NoError(t, handler.Receive(c))
+		assert.Equal(t, stdhttp.StatusRequestEntityTooLarge, rec.Code)
+	})
+
+	t.Run("unknown sender", func(t *testing.T) {
+		ingester := &stubInboundIngester{err: inbound.ErrUnknownSender}
+		handler := httphandler.NewInboundEmailHandler(ingester, "s3cret", 1<<20)
+
+		c, rec := newInboundEmailRequest(t, "s3cret")
+		require.NoError(t, handler.Receive(c))
+		assert.Equal(t, stdhttp.StatusUnprocessableEntity, rec.Code)
+		assert.Contains(t, rec.Body.String(), "UNKNOWN_SENDER")
+	})
+}
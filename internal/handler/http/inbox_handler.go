@@ -0,0 +1,221 @@
+package httphandler
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/lllypuk/flowra/internal/application/appcore"
+	chatapp "github.com/lllypuk/flowra/internal/application/chat"
+	inboxapp "github.com/lllypuk/flowra/internal/application/inbox"
+	"github.com/lllypuk/flowra/internal/domain/chat"
+	"github.com/lllypuk/flowra/internal/domain/inbox"
+	"github.com/lllypuk/flowra/internal/domain/uuid"
+	"github.com/lllypuk/flowra/internal/infrastructure/httpserver"
+	"github.com/lllypuk/flowra/internal/middleware"
+)
+
+// InboxCapturer captures task ideas in the personal inbox.
+// Declared on the consumer side per project guidelines.
+type InboxCapturer interface {
+	Execute(ctx context.Context, cmd inboxapp.CaptureCommand) (*inbox.Item, error)
+}
+
+// InboxLister lists the personal inbox of a user.
+// Declared on the consumer side per project guidelines.
+type InboxLister interface {
+	Execute(ctx context.Context, query inboxapp.ListQuery) ([]*inbox.Item, error)
+}
+
+// InboxDiscarder removes items from the personal inbox.
+// Declared on the consumer side per project guidelines.
+type InboxDiscarder interface {
+	Execute(ctx context.Context, cmd inboxapp.DiscardCommand) error
+}
+
+// InboxPromoter turns inbox items into chats or tasks of a workspace.
+// Declared on the consumer side per project guidelines.
+type InboxPromoter interface {
+	Execute(ctx context.Context, cmd inboxapp.PromoteCommand) (chatapp.Result, error)
+}
+
+// InboxUseCases groups the inbox use cases used by the handler.
+type InboxUseCases struct {
+	Capture InboxCapturer
+	List    InboxLister
+	Discard InboxDiscarder
+	Promote InboxPromoter
+}
+
+// CaptureInboxItemRequest represents a request to capture a task idea.
+type CaptureInboxItemRequest struct {
+	Title string `json:"title" form:"title"`
+}
+
+// PromoteInboxItemRequest represents a request to promote an inbox item.
+type PromoteInboxItemRequest struct {
+	Type     string `json:"type"      form:"type"`
+	IsPublic bool   `json:"is_public" form:"is_public"`
+}
+
+// InboxItemResponse represents an inbox item in API responses.
+type InboxItemResponse struct {
+	ID        string    `json:"id"`
+	Title     string    `json:"title"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// InboxListResponse represents the personal inbox in API responses.
+type InboxListResponse struct {
+	Items []InboxItemResponse `json:"items"`
+}
+
+// InboxHandler handles personal quick-capture inbox HTTP requests.
+type InboxHandler struct {
+	useCases InboxUseCases
+}
+
+// NewInboxHandler creates a new InboxHandler.
+func NewInboxHandler(useCases InboxUseCases) *InboxHandler {
+	return &InboxHandler{useCases: useCases}
+}
+
+// RegisterRoutes registers inbox routes with the router.
+func (h *InboxHandler) RegisterRoutes(r *httpserver.Router) {
+	r.Auth().GET("/inbox", h.List)
+	r.Auth().POST("/inbox", h.Capture)
+	r.Auth().DELETE("/inbox/:item_id", h.Discard)
+
+	// Promoting picks the workspace, so it runs under the workspace membership check
+	r.Workspace().POST("/inbox/:item_id/promote", h.Promote)
+}
+
+// List handles GET /api/v1/inbox.
+// Returns the items of the current user, most recently captured first.
+func (h *InboxHandler) List(c echo.Context) error {
+	userID := middleware.GetUserID(c)
+	if userID.IsZero() {
+		return httpserver.RespondErrorWithCode(c, http.StatusUnauthorized, "UNAUTHORIZED", "authentication required")
+	}
+
+	items, err := h.useCases.List.Execute(c.Request().Context(), inboxapp.ListQuery{UserID: userID})
+	if err != nil {
+		return handleInboxError(c, err)
+	}
+
+	resp := InboxListResponse{Items: make([]InboxItemResponse, 0, len(items))}
+	for _, item := range items {
+		resp.Items = append(resp.Items, ToInboxItemResponse(item))
+	}
+	return httpserver.RespondOK(c, resp)
+}
+
+// Capture handles POST /api/v1/inbox.
+// Stores a task idea without choosing a workspace.
+func (h *InboxHandler) Capture(c echo.Context) error {
+	userID := middleware.GetUserID(c)
+	if userID.IsZero() {
+		return httpserver.RespondErrorWithCode(c, http.StatusUnauthorized, "UNAUTHORIZED", "authentication required")
+	}
+
+	var req CaptureInboxItemRequest
+	if err := c.Bind(&req); err != nil {
+		return httpserver.RespondErrorWithCode(c, http.StatusBadRequest, "INVALID_REQUEST", "Invalid request body")
+	}
+
+	item, err := h.useCases.Capture.Execute(c.Request().Context(), inboxapp.CaptureCommand{
+		UserID: userID,
+		Title:  req.Title,
+	})
+	if err != nil {
+		return handleInboxError(c, err)
+	}
+
+	return httpserver.RespondCreated(c, ToInboxItemResponse(item))
+}
+
+// Discard handles DELETE /api/v1/inbox/:item_id.
+func (h *InboxHandler) Discard(c echo.Context) error {
+	userID := middleware.GetUserID(c)
+	if userID.IsZero() {
+		return httpserver.RespondErrorWithCode(c, http.StatusUnauthorized, "UNAUTHORIZED", "authentication required")
+	}
+
+	itemID, err := uuid.ParseUUID(c.Param("item_id"))
+	if err != nil {
+		return httpserver.RespondErrorWithCode(
+			c, http.StatusBadRequest, "INVALID_ITEM_ID", "invalid inbox item ID format")
+	}
+
+	cmd := inboxapp.DiscardCommand{ItemID: itemID, UserID: userID}
+	if discardErr := h.useCases.Discard.Execute(c.Request().Context(), cmd); discardErr != nil {
+		return handleInboxError(c, discardErr)
+	}
+
+	return httpserver.RespondNoContent(c)
+}
+
+// Promote handles POST /api/v1/workspaces/:workspace_id/inbox/:item_id/promote.
+// Creates a chat or task (task by default) from the item and removes it from the inbox.
+func (h *InboxHandler) Promote(c echo.Context) error {
+	userID := middleware.GetUserID(c)
+	if userID.IsZero() {
+		return httpserver.RespondErrorWithCode(c, http.StatusUnauthorized, "UNAUTHORIZED", "authentication required")
+	}
+
+	workspaceID, err := uuid.ParseUUID(c.Param("workspace_id"))
+	if err != nil {
+		return httpserver.RespondErrorWithCode(
+			c, http.StatusBadRequest, "INVALID_WORKSPACE_ID", "invalid workspace ID format")
+	}
+	itemID, err := uuid.ParseUUID(c.Param("item_id"))
+	if err != nil {
+		return httpserver.RespondErrorWithCode(
+			c, http.StatusBadRequest, "INVALID_ITEM_ID", "invalid inbox item ID format")
+	}
+
+	var req PromoteInboxItemRequest
+	if bindErr := c.Bind(&req); bindErr != nil {
+		return httpserver.RespondErrorWithCode(c, http.StatusBadRequest, "INVALID_REQUEST", "Invalid request body")
+	}
+
+	result, err := h.useCases.Promote.Execute(c.Request().Context(), inboxapp.PromoteCommand{
+		ItemID:      itemID,
+		UserID:      userID,
+		WorkspaceID: workspaceID,
+		Type:        chat.Type(req.Type),
+		IsPublic:    req.IsPublic,
+	})
+	if err != nil {
+		return handleInboxError(c, err)
+	}
+
+	return httpserver.RespondCreated(c, ToChatResponse(result.Value))
+}
+
+// handleInboxError maps inbox use case errors to HTTP responses.
+func handleInboxError(c echo.Context, err error) error {
+	var validationErr *appcore.ValidationError
+	switch {
+	case errors.Is(err, inboxapp.ErrItemNotFound):
+		return httpserver.RespondErrorWithCode(c, http.StatusNotFound, "INBOX_ITEM_NOT_FOUND", "inbox item not found")
+	case errors.Is(err, inboxapp.ErrInboxFull):
+		return httpserver.RespondErrorWithCode(
+			c, http.StatusConflict, "INBOX_FULL", "inbox is full, promote or discard some items first")
+	case errors.As(err, &validationErr):
+		return httpserver.RespondErrorWithCode(c, http.StatusBadRequest, "VALIDATION_ERROR", validationErr.Error())
+	default:
+		return handleChatError(c, err)
+	}
+}
+
+// ToInboxItemResponse converts an inbox item to its API representation.
+func ToInboxItemResponse(item *inbox.Item) InboxItemResponse {
+	return InboxItemResponse{
+		ID:        item.ID().String(),
+		Title:     item.Title(),
+		CreatedAt: item.CreatedAt(),
+	}
+}
@@ -0,0 +1,192 @@
+package httphandler_test
+
+import (
+	"context"
+	"encoding/json"
+	stdhttp "net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/lllypuk/flowra/internal/application/appcore"
+	chatapp "github.com/lllypuk/flowra/internal/application/chat"
+	inboxapp "github.com/lllypuk/flowra/internal/application/inbox"
+	"github.com/lllypuk/flowra/internal/domain/chat"
+	"github.com/lllypuk/flowra/internal/domain/errs"
+	"github.com/lllypuk/flowra/internal/domain/inbox"
+	"github.com/lllypuk/flowra/internal/domain/uuid"
+	httphandler "github.com/lllypuk/flowra/internal/handler/http"
+	"github.com/lllypuk/flowra/internal/middleware"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type memoryInboxRepo struct {
+	items map[uuid.UUID]*inbox.Item
+}
+
+func (r *memoryInboxRepo) Save(_ context.Context, item *inbox.Item) error {
+	r.items[item.ID()] = item
+	return nil
+}
+
+func (r *memoryInboxRepo) FindByID(_ context.Context, id uuid.UUID) (*inbox.Item, error) {
+	item, ok := r.items[id]
+	if !ok {
+		return nil, errs.ErrNotFound
+	}
+	return item, nil
+}
+
+func (r *memoryInboxRepo) FindByUser(_ context.Context, userID uuid.UUID) ([]*inbox.Item, error) {
+	var items []*inbox.Item
+	for _, item := range r.items {
+		if item.IsOwnedBy(userID) {
+			items = append(items, item)
+		}
+	}
+	return items, nil
+}
+
+func (r *memoryInboxRepo) CountByUser(ctx context.Context, userID uuid.UUID) (int, error) {
+	items, _ := r.FindByUser(ctx, userID)
+	return len(items), nil
+}
+
+func (r *memoryInboxRepo) Delete(_ context.Context, id uuid.UUID) error {
+	delete(r.items, id)
+	return nil
+}
+
+type stubInboxChatCreator struct{}
+
+func (stubInboxChatCreator) Execute(_ context.Context, cmd chatapp.CreateChatCommand) (chatapp.Result, error) {
+	created, err := chat.NewChat(cmd.WorkspaceID, chat.TypeDiscussion, cmd.IsPublic, cmd.CreatedBy)
+	if err != nil {
+		return chatapp.Result{}, err
+	}
+	if err = created.ConvertToTask(cmd.Title, cmd.CreatedBy); err != nil {
+		return chatapp.Result{}, err
+	}
+	return chatapp.Result{Result: appcore.Result[*chat.Chat]{Value: created}}, nil
+}
+
+func newInboxTestHandler() (*httphandler.InboxHandler, *memoryInboxRepo) {
+	repo := &memoryInboxRepo{items: make(map[uuid.UUID]*inbox.Item)}
+	return httphandler.NewInboxHandler(httphandler.InboxUseCases{
+		Capture: inboxapp.NewCaptureUseCase(repo),
+		List:    inboxapp.NewListUseCase(repo),
+		Discard: inboxapp.NewDiscardUseCase(repo),
+		Promote: inboxapp.NewPromoteUseCase(repo, stubInboxChatCreator{}),
+	}), repo
+}
+
+func newInboxContext(method, body string, userID uuid.UUID, params ...string) (echo.Context, *httptest.ResponseRecorder) {
+	req := httptest.NewRequest(method, "/", strings.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := echo.New().NewContext(req, rec)
+	var names, values []string
+	for i := 0; i+1 < len(params); i += 2 {
+		names = append(names, params[i])
+		values = append(values, params[i+1])
+	}
+	c.SetParamNames(names...)
+	c.SetParamValues(values...)
+	c.Set(string(middleware.ContextKeyUserID), userID)
+	return c, rec
+}
+
+func TestInboxHandler_CaptureAndList(t *testing.T) {
+	handler, _ := newInboxTestHandler()
+	userID := uuid.NewUUID()
+
+	c, rec := newInboxContext(stdhttp.MethodPost, `{"title":"Fix flaky CI"}`, userID)
+	require.NoError(t, handler.Capture(c))
+	assert.Equal(t, stdhttp.StatusCreated, rec.Code)
+
+	c, rec = newInboxContext(stdhttp.MethodPost, `{"title":"  "}`, userID)
+	require.NoError(t, handler.Capture(c))
+	assert.Equal(t, stdhttp.StatusBadRequest, rec.Code)
+
+	c, rec = newInboxContext(stdhttp.MethodGet, "", userID)
+	require.NoError(t, handler.List(c))
+	require.Equal(t, stdhttp.StatusOK, rec.Code)
+	var resp struct {
+		Data httphandler.InboxListResponse `json:"data"`
+	}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+	require.Len(t, resp.Data.Items, 1)
+	assert.Equal(t, "Fix flaky CI", resp.Data.Items[0].Title)
+}
+
+func TestInboxHandler_Promote(t *testing.T) {
+	userID := uuid.NewUUID()
+	workspaceID := uuid.NewUUID()
+	capture := func(t *testing.T, repo *memoryInboxRepo) *inbox.Item {
+		t.Helper()
+		item, err := inbox.NewItem(userID, "Fix flaky CI")
+		require.NoError(t, err)
+		require.NoError(t, repo.Save(context.Background(), item))
+		return item
+	}
+
+	t.Run("creates a task from the item", func(t *testing.T) {
+		handler, repo := newInboxTestHandler()
+		item := capture(t, repo)
+
+		c, rec := newInboxContext(stdhttp.MethodPost, `{}`, userID,
+			"workspace_id", workspaceID.String(), "item_id", item.ID().String())
+		require.NoError(t, handler.Promote(c))
+
+		require.Equal(t, stdhttp.StatusCreated, rec.Code)
+		var resp struct {
+			Data httphandler.ChatResponse `json:"data"`
+		}
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+		assert.Equal(t, "Fix flaky CI", resp.Data.Name)
+		assert.Equal(t, workspaceID, resp.Data.WorkspaceID)
+		assert.Empty(t, repo.items)
+	})
+
+	t.Run("other users cannot promote the item", func(t *testing.T) {
+		handler, repo := newInboxTestHandler()
+		item := capture(t, repo)
+
+		c, rec := newInboxContext(stdhttp.MethodPost, `{}`, uuid.NewUUID(),
+			"workspace_id", workspaceID.String(), "item_id", item.ID().String())
+		require.NoError(t, handler.Promote(c))
+
+		assert.Equal(t, stdhttp.StatusNotFound, rec.Code)
+		assert.Len(t, repo.items, 1)
+	})
+
+	t.Run("rejects unknown types", func(t *testing.T) {
+		handler, repo := newInboxTestHandler()
+		item := capture(t, repo)
+
+		c, rec := newInboxContext(stdhttp.MethodPost, `{"type":"story"}`, userID,
+			"workspace_id", workspaceID.String(), "item_id", item.ID().String())
+		require.NoError(t, handler.Promote(c))
+
+		assert.Equal(t, stdhttp.StatusBadRequest, rec.Code)
+	})
+}
+
+func TestInboxHandler_Discard(t *testing.T) {
+	handler, repo := newInboxTestHandler()
+	userID := uuid.NewUUID()
+	item, err := inbox.NewItem(userID, "Fix flaky CI")
+	require.NoError(t, err)
+	require.NoError(t, repo.Save(context.Background(), item))
+
+	c, rec := newInboxContext(stdhttp.MethodDelete, "", userID, "item_id", "invalid")
+	require.NoError(t, handler.Discard(c))
+	assert.Equal(t, stdhttp.StatusBadRequest, rec.Code)
+
+	c, rec = newInboxContext(stdhttp.MethodDelete, "", userID, "item_id", item.ID().String())
+	require.NoError(t, handler.Discard(c))
+	assert.Equal(t, stdhttp.StatusNoContent, rec.Code)
+	assert.Empty(t, repo.items)
+}
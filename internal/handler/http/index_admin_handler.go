@@ -0,0 +1,101 @@
+package httphandler
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/lllypuk/flowra/internal/infrastructure/httpserver"
+	mongodbinfra "github.com/lllypuk/flowra/internal/infrastructure/mongodb"
+)
+
+// IndexDriftInspector compares the database indexes with their definitions and repairs them.
+// Declared on the consumer side per project guidelines.
+type IndexDriftInspector interface {
+	Verify(ctx context.Context) ([]mongodbinfra.IndexDrift, error)
+	Repair(ctx context.Context, dropOrphans bool) ([]mongodbinfra.IndexDrift, error)
+}
+
+// RepairIndexesRequest represents a request to repair index drift.
+type RepairIndexesRequest struct {
+	// DropOrphans also drops indexes that have no definition.
+	DropOrphans bool `json:"drop_orphans"`
+}
+
+// IndexDriftResponse represents the index drift report in API responses.
+type IndexDriftResponse struct {
+	Drift      []mongodbinfra.IndexDrift `json:"drift"`
+	VerifiedAt time.Time                 `json:"verified_at"`
+}
+
+// RepairIndexesResponse represents the outcome of an index repair.
+type RepairIndexesResponse struct {
+	Repaired  []mongodbinfra.IndexDrift `json:"repaired"`
+	Remaining []mongodbinfra.IndexDrift `json:"remaining"`
+}
+
+// IndexAdminHandler handles the index drift admin API.
+type IndexAdminHandler struct {
+	inspector IndexDriftInspector
+}
+
+// NewIndexAdminHandler creates a new IndexAdminHandler.
+func NewIndexAdminHandler(inspector IndexDriftInspector) *IndexAdminHandler {
+	return &IndexAdminHandler{inspector: inspector}
+}
+
+// RegisterRoutes registers the index routes with the router. System admins only.
+func (h *IndexAdminHandler) RegisterRoutes(r *httpserver.Router) {
+	admin := r.NewAuthRouteGroup("/admin/indexes").RequireSystemAdmin()
+	admin.GET("", h.Drift)
+	admin.POST("/repair", h.Repair)
+}
+
+// Drift handles GET /api/v1/admin/indexes.
+// It reports missing, changed and orphaned indexes.
+func (h *IndexAdminHandler) Drift(c echo.Context) error {
+	drifts, err := h.inspector.Verify(c.Request().Context())
+	if err != nil {
+		return httpserver.RespondErrorWithCode(
+			c, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to verify indexes")
+	}
+	return httpserver.RespondOK(c, IndexDriftResponse{
+		Drift:      nonNilDrift(drifts),
+		VerifiedAt: time.Now().UTC(),
+	})
+}
+
+// Repair handles POST /api/v1/admin/indexes/repair.
+// It recreates missing and changed indexes and, on request, drops orphaned ones.
+func (h *IndexAdminHandler) Repair(c echo.Context) error {
+	var req RepairIndexesRequest
+	if err := c.Bind(&req); err != nil {
+		return httpserver.RespondErrorWithCode(c, http.StatusBadRequest, "INVALID_REQUEST", "Invalid request body")
+	}
+
+	ctx := c.Request().Context()
+	repaired, err := h.inspector.Repair(ctx, req.DropOrphans)
+	if err != nil {
+		return httpserver.RespondErrorWithCode(
+			c, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to repair indexes")
+	}
+	remaining, err := h.inspector.Verify(ctx)
+	if err != nil {
+		return httpserver.RespondErrorWithCode(
+			c, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to verify indexes")
+	}
+
+	return httpserver.RespondOK(c, RepairIndexesResponse{
+		Repaired:  nonNilDrift(repaired),
+		Remaining: nonNilDrift(remaining),
+	})
+}
+
+// nonNilDrift keeps empty reports as [] rather than null in JSON.
+func nonNilDrift(drifts []mongodbinfra.IndexDrift) []mongodbinfra.IndexDrift {
+	if drifts == nil {
+		return []mongodbinfra.IndexDrift{}
+	}
+	return drifts
+}
@@ -0,0 +1,90 @@
+package httphandler_test
+
+import (
+	"context"
+	"encoding/json"
+	stdhttp "net/http"
+	"testing"
+
+	httphandler "github.com/lllypuk/flowra/internal/handler/http"
+	mongodbinfra "github.com/lllypuk/flowra/internal/infrastructure/mongodb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeIndexInspector struct {
+	drifts      []mongodbinfra.IndexDrift
+	dropOrphans []bool
+}
+
+func (f *fakeIndexInspector) Verify(context.Context) ([]mongodbinfra.IndexDrift, error) {
+	return f.drifts, nil
+}
+
+func (f *fakeIndexInspector) Repair(_ context.Context, dropOrphans bool) ([]mongodbinfra.IndexDrift, error) {
+	f.dropOrphans = append(f.dropOrphans, dropOrphans)
+	var repaired, remaining []mongodbinfra.IndexDrift
+	for _, drift := range f.drifts {
+		if drift.Kind == mongodbinfra.IndexOrphaned && !dropOrphans {
+			remaining = append(remaining, drift)
+			continue
+		}
+		repaired = append(repaired, drift)
+	}
+	f.drifts = remaining
+	return repaired, nil
+}
+
+func TestIndexAdminHandler(t *testing.T) {
+	drifts := func() []mongodbinfra.IndexDrift {
+		return []mongodbinfra.IndexDrift{
+			{Collection: "stars", Index: "idx_stars_user_chat", Kind: mongodbinfra.IndexMissing},
+			{Collection: "stars", Index: "idx_stars_legacy", Kind: mongodbinfra.IndexOrphaned},
+		}
+	}
+
+	t.Run("reports drift", func(t *testing.T) {
+		handler := httphandler.NewIndexAdminHandler(&fakeIndexInspector{drifts: drifts()})
+
+		c, rec := newProjectionAdminContext(stdhttp.MethodGet, "")
+		require.NoError(t, handler.Drift(c))
+
+		require.Equal(t, stdhttp.StatusOK, rec.Code)
+		var resp struct {
+			Data httphandler.IndexDriftResponse `json:"data"`
+		}
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+		assert.Equal(t, drifts(), resp.Data.Drift)
+	})
+
+	t.Run("repairs without dropping orphans by default", func(t *testing.T) {
+		inspector := &fakeIndexInspector{drifts: drifts()}
+		handler := httphandler.NewIndexAdminHandler(inspector)
+
+		c, rec := newProjectionAdminContext(stdhttp.MethodPost, `{}`)
+		require.NoError(t, handler.Repair(c))
+
+		require.Equal(t, stdhttp.StatusOK, rec.Code)
+		var resp struct {
+			Data httphandler.RepairIndexesResponse `json:"data"`
+		}
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+		assert.Equal(t, []bool{false}, inspector.dropOrphans)
+		require.Len(t, resp.Data.Repaired, 1)
+		require.Len(t, resp.Data.Remaining, 1)
+		assert.Equal(t, mongodbinfra.IndexOrphaned, resp.Data.Remaining[0].Kind)
+	})
+
+	t.Run("drops orphans on request", func(t *testing.T) {
+		inspector := &fakeIndexInspector{drifts: drifts()}
+		handler := httphandler.NewIndexAdminHandler(inspector)
+
+		c, rec := newProjectionAdminContext(stdhttp.MethodPost, `{"drop_orphans":true}`)
+		require.NoError(t, handler.Repair(c))
+
+		require.Equal(t, stdhttp.StatusOK, rec.Code)
+		assert.Equal(t, []bool{true}, inspector.dropOrphans)
+		assert.Empty(t, inspector.drifts)
+		assert.Contains(t, rec.Body.String(), `"remaining":[]`)
+	})
+}
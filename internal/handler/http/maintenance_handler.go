@@ -0,0 +1,130 @@
+package httphandler
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/lllypuk/flowra/internal/infrastructure/httpserver"
+	"github.com/lllypuk/flowra/internal/middleware"
+)
+
+// maxMaintenanceMessageLength limits the maintenance message.
+const maxMaintenanceMessageLength = 500
+
+// MaintenanceToggle reads and switches maintenance mode.
+// Declared on the consumer side per project guidelines.
+type MaintenanceToggle interface {
+	State(ctx context.Context) middleware.MaintenanceState
+	Set(ctx context.Context, state middleware.MaintenanceState) error
+}
+
+// UpdateMaintenanceRequest represents a request to switch maintenance mode.
+type UpdateMaintenanceRequest struct {
+	Enabled bool   `json:"enabled"`
+	Message string `json:"message"`
+}
+
+// MaintenanceResponse represents the maintenance mode state in API responses.
+type MaintenanceResponse struct {
+	Enabled    bool       `json:"enabled"`
+	Message    string     `json:"message,omitempty"`
+	FromConfig bool       `json:"from_config"`
+	UpdatedBy  string     `json:"updated_by,omitempty"`
+	UpdatedAt  *time.Time `json:"updated_at,omitempty"`
+}
+
+// MaintenanceHandler handles the maintenance mode admin API.
+type MaintenanceHandler struct {
+	toggle MaintenanceToggle
+}
+
+// NewMaintenanceHandler creates a new MaintenanceHandler.
+func NewMaintenanceHandler(toggle MaintenanceToggle) *MaintenanceHandler {
+	return &MaintenanceHandler{toggle: toggle}
+}
+
+// RegisterRoutes registers the maintenance routes with the router. System admins only.
+func (h *MaintenanceHandler) RegisterRoutes(r *httpserver.Router) {
+	admin := r.NewAuthRouteGroup("/admin").RequireSystemAdmin()
+	admin.GET("/maintenance", h.Get)
+	admin.PUT("/maintenance", h.Update)
+}
+
+// Get handles GET /api/v1/admin/maintenance.
+func (h *MaintenanceHandler) Get(c echo.Context) error {
+	return httpserver.RespondOK(c, ToMaintenanceResponse(h.toggle.State(c.Request().Context())))
+}
+
+// Update handles PUT /api/v1/admin/maintenance.
+// Maintenance mode forced on by configuration cannot be switched off here.
+func (h *MaintenanceHandler) Update(c echo.Context) error {
+	var req UpdateMaintenanceRequest
+	if err := c.Bind(&req); err != nil {
+		return httpserver.RespondErrorWithCode(c, http.StatusBadRequest, "INVALID_REQUEST", "Invalid request body")
+	}
+	if len([]rune(req.Message)) > maxMaintenanceMessageLength {
+		return httpserver.RespondErrorWithCode(
+			c, http.StatusBadRequest, "VALIDATION_ERROR", "Message must be at most 500 characters")
+	}
+
+	ctx := c.Request().Context()
+	if current := h.toggle.State(ctx); current.FromConfig && !req.Enabled {
+		return httpserver.RespondErrorWithCode(c, http.StatusConflict, "MAINTENANCE_FORCED_BY_CONFIG",
+			"Maintenance mode is enabled in configuration and cannot be switched off at runtime")
+	}
+
+	state := middleware.MaintenanceState{
+		Enabled:   req.Enabled,
+		Message:   req.Message,
+		UpdatedBy: middleware.GetUserID(c),
+	}
+	if err := h.toggle.Set(ctx, state); err != nil {
+		if errors.Is(err, middleware.ErrMaintenanceToggleUnavailable) {
+			return httpserver.RespondErrorWithCode(c, http.StatusServiceUnavailable, "MAINTENANCE_TOGGLE_UNAVAILABLE",
+				"Maintenance mode cannot be switched at runtime")
+		}
+		return httpserver.RespondErrorWithCode(
+			c, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to update maintenance mode")
+	}
+
+	return httpserver.RespondOK(c, ToMaintenanceResponse(h.toggle.State(ctx)))
+}
+
+// ToMaintenanceResponse converts the maintenance state to its API representation.
+func ToMaintenanceResponse(state middleware.MaintenanceState) MaintenanceResponse {
+	resp := MaintenanceResponse{
+		Enabled:    state.Enabled,
+		Message:    state.Message,
+		FromConfig: state.FromConfig,
+	}
+	if !state.UpdatedBy.IsZero() {
+		resp.UpdatedBy = state.UpdatedBy.String()
+	}
+	if !state.UpdatedAt.IsZero() {
+		updatedAt := state.UpdatedAt
+		resp.UpdatedAt = &updatedAt
+	}
+	return resp
+}
+
+// NewMaintenancePageRenderer returns a renderer for the maintenance page shown to browsers.
+func NewMaintenancePageRenderer(renderer *TemplateRenderer) func(echo.Context, middleware.MaintenanceState) error {
+	return func(c echo.Context, state middleware.MaintenanceState) error {
+		if renderer == nil {
+			return errors.New("template renderer not configured")
+		}
+
+		var buf bytes.Buffer
+		data := map[string]any{"Title": "Maintenance", "Message": state.Message}
+		if err := renderer.Render(&buf, "maintenance.html", data, c); err != nil {
+			return err
+		}
+
+		c.Response().Header().Set("Content-Type", "text/html; charset=utf-8")
+		return c.HTMLBlob(http.StatusServiceUnavailable, buf.Bytes())
+	}
+}
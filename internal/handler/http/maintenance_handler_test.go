@@ -0,0 +1,97 @@
+package httphandler_test
+
+import (
+	"context"
+	"encoding/json"
+	stdhttp "net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/lllypuk/flowra/internal/domain/uuid"
+	httphandler "github.com/lllypuk/flowra/internal/handler/http"
+	"github.com/lllypuk/flowra/internal/middleware"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newMaintenanceContext(method, body string, adminID uuid.UUID) (echo.Context, *httptest.ResponseRecorder) {
+	e := echo.New()
+	req := httptest.NewRequest(method, "/api/v1/admin/maintenance", strings.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	setupUserAuthContext(c, adminID)
+	return c, rec
+}
+
+func TestMaintenanceHandler(t *testing.T) {
+	adminID := uuid.NewUUID()
+
+	t.Run("switches maintenance mode on and off", func(t *testing.T) {
+		toggle := middleware.NewMaintenanceSwitch(false, "", middleware.NewMemoryMaintenanceStore(), nil)
+		handler := httphandler.NewMaintenanceHandler(toggle)
+
+		c, rec := newMaintenanceContext(stdhttp.MethodPut, `{"enabled":true,"message":"Database upgrade"}`, adminID)
+		require.NoError(t, handler.Update(c))
+		assert.Equal(t, stdhttp.StatusOK, rec.Code)
+
+		var resp struct {
+			Data httphandler.MaintenanceResponse `json:"data"`
+		}
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+		assert.True(t, resp.Data.Enabled)
+		assert.Equal(t, "Database upgrade", resp.Data.Message)
+		assert.Equal(t, adminID.String(), resp.Data.UpdatedBy)
+		assert.True(t, toggle.State(context.Background()).Enabled)
+
+		c, rec = newMaintenanceContext(stdhttp.MethodPut, `{"enabled":false}`, adminID)
+		require.NoError(t, handler.Update(c))
+		assert.Equal(t, stdhttp.StatusOK, rec.Code)
+		assert.False(t, toggle.State(context.Background()).Enabled)
+
+		c, rec = newMaintenanceContext(stdhttp.MethodGet, "", adminID)
+		require.NoError(t, handler.Get(c))
+		assert.Contains(t, rec.Body.String(), `"enabled":false`)
+	})
+
+	t.Run("cannot switch off maintenance forced by configuration", func(t *testing.T) {
+		toggle := middleware.NewMaintenanceSwitch(true, "", middleware.NewMemoryMaintenanceStore(), nil)
+		handler := httphandler.NewMaintenanceHandler(toggle)
+
+		c, rec := newMaintenanceContext(stdhttp.MethodPut, `{"enabled":false}`, adminID)
+		require.NoError(t, handler.Update(c))
+		assert.Equal(t, stdhttp.StatusConflict, rec.Code)
+	})
+
+	t.Run("runtime toggle unavailable", func(t *testing.T) {
+		handler := httphandler.NewMaintenanceHandler(middleware.NewMaintenanceSwitch(false, "", nil, nil))
+
+		c, rec := newMaintenanceContext(stdhttp.MethodPut, `{"enabled":true}`, adminID)
+		require.NoError(t, handler.Update(c))
+		assert.Equal(t, stdhttp.StatusServiceUnavailable, rec.Code)
+	})
+
+	t.Run("message too long", func(t *testing.T) {
+		handler := httphandler.NewMaintenanceHandler(
+			middleware.NewMaintenanceSwitch(false, "", middleware.NewMemoryMaintenanceStore(), nil))
+
+		body := `{"enabled":true,"message":"` + strings.Repeat("a", 501) + `"}`
+		c, rec := newMaintenanceContext(stdhttp.MethodPut, body, adminID)
+		require.NoError(t, handler.Update(c))
+		assert.Equal(t, stdhttp.StatusBadRequest, rec.Code)
+	})
+}
+
+func TestMaintenancePageRenderer(t *testing.T) {
+	render := httphandler.NewMaintenancePageRenderer(newTestRenderer(t))
+	e := echo.New()
+	rec := httptest.NewRecorder()
+	c := e.NewContext(httptest.NewRequest(stdhttp.MethodGet, "/workspaces", nil), rec)
+
+	require.NoError(t, render(c, middleware.MaintenanceState{Enabled: true, Message: "Back at 23:00 UTC"}))
+	assert.Equal(t, stdhttp.StatusServiceUnavailable, rec.Code)
+	assert.Contains(t, rec.Body.String(), "Back at 23:00 UTC")
+	assert.Contains(t, rec.Body.String(), "right back")
+}
@@ -4,11 +4,13 @@ import (
 	"context"
 	"errors"
 	"net/http"
+	"net/url"
 	"strconv"
 	"time"
 
 	"github.com/labstack/echo/v4"
 	messageapp "github.com/lllypuk/flowra/internal/application/message"
+	"github.com/lllypuk/flowra/internal/domain/errs"
 	"github.com/lllypuk/flowra/internal/domain/message"
 	"github.com/lllypuk/flowra/internal/domain/uuid"
 	"github.com/lllypuk/flowra/internal/infrastructure/httpserver"
@@ -17,7 +19,6 @@ import (
 
 // Validation constants for message handler.
 const (
-	maxMessageContentLength = 10000
 	defaultMessageListLimit = 50
 	maxMessageListLimit     = 100
 )
@@ -35,30 +36,53 @@ var (
 
 // SendMessageRequest represents the request to send a message.
 type SendMessageRequest struct {
-	Content   string     `json:"content"     form:"content"`
-	ReplyToID *uuid.UUID `json:"reply_to_id" form:"reply_to_id"`
+	Content   string     `json:"content"     form:"content"     validate:"required,max=10000"`
+	ReplyToID *uuid.UUID `json:"reply_to_id" form:"reply_to_id" validate:"uuid"`
+	QuoteID   *uuid.UUID `json:"quote_id"    form:"quote_id"    validate:"uuid"`
 }
 
 // EditMessageRequest represents the request to edit a message.
 type EditMessageRequest struct {
-	Content string `json:"content" form:"content"`
+	Content string `json:"content" form:"content" validate:"required,max=10000"`
+}
+
+// ForwardMessageRequest represents the request to forward a message into another chat.
+type ForwardMessageRequest struct {
+	ChatID  uuid.UUID `json:"chat_id" form:"chat_id" validate:"required,uuid"`
+	Comment string    `json:"comment" form:"comment" validate:"max=10000"`
+}
+
+// CreatePollRequest represents the request to post a poll.
+type CreatePollRequest struct {
+	Question  string     `json:"question"`
+	Options   []string   `json:"options"`
+	Anonymous bool       `json:"anonymous"`
+	ClosesAt  *time.Time `json:"closes_at,omitempty"`
+}
+
+// VotePollRequest represents the request to vote in a poll.
+type VotePollRequest struct {
+	OptionID uuid.UUID `json:"option_id" form:"option_id" validate:"required,uuid"`
 }
 
 // MessageResponse represents a message in API responses.
 type MessageResponse struct {
-	ID          uuid.UUID            `json:"id"`
-	ChatID      uuid.UUID            `json:"chat_id"`
-	SenderID    uuid.UUID            `json:"sender_id"`
-	Content     string               `json:"content"`
-	Type        string               `json:"type"`               // "user", "system", or "bot"
-	IsSystem    bool                 `json:"is_system"`          // true for system/bot messages
-	ActorID     *uuid.UUID           `json:"actor_id,omitempty"` // who initiated (for system messages)
-	ReplyToID   *uuid.UUID           `json:"reply_to_id,omitempty"`
-	CreatedAt   string               `json:"created_at"`
-	EditedAt    *string              `json:"edited_at,omitempty"`
-	IsDeleted   bool                 `json:"is_deleted"`
-	Attachments []AttachmentResponse `json:"attachments,omitempty"`
-	Reactions   []ReactionResponse   `json:"reactions,omitempty"`
+	ID             uuid.UUID            `json:"id"`
+	ChatID         uuid.UUID            `json:"chat_id"`
+	SenderID       uuid.UUID            `json:"sender_id"`
+	Content        string               `json:"content"`
+	Type           string               `json:"type"`               // "user", "system", "bot" or "poll"
+	IsSystem       bool                 `json:"is_system"`          // true for system/bot messages
+	ActorID        *uuid.UUID           `json:"actor_id,omitempty"` // who initiated (for system messages)
+	ReplyToID      *uuid.UUID           `json:"reply_to_id,omitempty"`
+	QuoteID        *uuid.UUID           `json:"quote_id,omitempty"`
+	CreatedAt      string               `json:"created_at"`
+	EditedAt       *string              `json:"edited_at,omitempty"`
+	IsDeleted      bool                 `json:"is_deleted"`
+	DeletedContent *string              `json:"deleted_content,omitempty"` // moderators only, until purged
+	Attachments    []AttachmentResponse `json:"attachments,omitempty"`
+	Reactions      []ReactionResponse   `json:"reactions,omitempty"`
+	Poll           *PollResponse        `json:"poll,omitempty"`
 }
 
 // AttachmentResponse represents a message attachment in API responses.
@@ -67,6 +91,13 @@ type AttachmentResponse struct {
 	FileName string    `json:"file_name"`
 	FileSize int64     `json:"file_size"`
 	MimeType string    `json:"mime_type"`
+	// DurationMS and Waveform are set for audio attachments such as voice messages.
+	DurationMS int64 `json:"duration_ms,omitempty"`
+	Waveform   []int `json:"waveform,omitempty"`
+	// PreviewURL and the preview size are set for images and PDFs once their preview is generated.
+	PreviewURL    string `json:"preview_url,omitempty"`
+	PreviewWidth  int    `json:"preview_width,omitempty"`
+	PreviewHeight int    `json:"preview_height,omitempty"`
 }
 
 // ReactionResponse represents a message reaction in API responses.
@@ -76,7 +107,27 @@ type ReactionResponse struct {
 	Count int         `json:"count"`
 }
 
+// PollResponse represents a poll in API responses.
+type PollResponse struct {
+	Question   string               `json:"question"`
+	Options    []PollOptionResponse `json:"options"`
+	Anonymous  bool                 `json:"anonymous"`
+	ClosesAt   *string              `json:"closes_at,omitempty"`
+	Closed     bool                 `json:"closed"`
+	TotalVotes int                  `json:"total_votes"`
+	MyVote     *uuid.UUID           `json:"my_vote,omitempty"` // option chosen by the requesting user
+}
+
+// PollOptionResponse represents a poll option with its result in API responses.
+type PollOptionResponse struct {
+	ID     uuid.UUID   `json:"id"`
+	Text   string      `json:"text"`
+	Votes  int         `json:"votes"`
+	Voters []uuid.UUID `json:"voters,omitempty"` // empty for anonymous polls
+}
+
 // MessageListResponse represents a list of messages in API responses.
+// NextCursor repeats the next_cursor of the pagination block for older clients.
 type MessageListResponse struct {
 	Messages   []MessageResponse `json:"messages"`
 	HasMore    bool              `json:"has_more"`
@@ -103,18 +154,75 @@ type MessageService interface {
 
 	// AddAttachment adds an attachment to a message.
 	AddAttachment(ctx context.Context, cmd messageapp.AddAttachmentCommand) (messageapp.Result, error)
+
+	// ForwardMessage copies a message into another chat.
+	ForwardMessage(ctx context.Context, cmd messageapp.ForwardMessageCommand) (messageapp.Result, error)
+
+	// UndoMessageChange restores a deleted message or reverts its last edit.
+	UndoMessageChange(ctx context.Context, cmd messageapp.UndoMessageChangeCommand) (messageapp.Result, error)
+
+	// CreatePoll posts a poll message.
+	CreatePoll(ctx context.Context, cmd messageapp.CreatePollCommand) (messageapp.Result, error)
+
+	// VotePoll records a vote in a poll.
+	VotePoll(ctx context.Context, cmd messageapp.VotePollCommand) (messageapp.Result, error)
+
+	// RetractPollVote removes the vote of the user from a poll.
+	RetractPollVote(ctx context.Context, cmd messageapp.RetractPollVoteCommand) (messageapp.Result, error)
+
+	// ClosePoll stops the voting in a poll.
+	ClosePoll(ctx context.Context, cmd messageapp.ClosePollCommand) (messageapp.Result, error)
+}
+
+// MessageModerationChecker tells whether a user moderates a chat.
+// Declared on the consumer side per project guidelines.
+type MessageModerationChecker interface {
+	CanModerate(ctx context.Context, chatID, userID uuid.UUID) (bool, error)
+}
+
+// MessageStreamer streams the messages of a chat for exports.
+// Declared on the consumer side per project guidelines.
+type MessageStreamer interface {
+	// StreamByChatID calls fn for every message of the chat, oldest first, starting
+	// right behind the message after unless it is zero. It returns errs.ErrNotFound
+	// if after is not a message of the chat.
+	StreamByChatID(ctx context.Context, chatID, after uuid.UUID, fn func(*message.Message) error) error
 }
 
 // MessageHandler handles message-related HTTP requests.
 type MessageHandler struct {
 	messageService MessageService
+	moderation     MessageModerationChecker
+	streamer       MessageStreamer
+}
+
+// MessageHandlerOption configures MessageHandler.
+type MessageHandlerOption func(*MessageHandler)
+
+// WithMessageModeration lets chat moderators see the content of deleted messages
+// until it is purged.
+func WithMessageModeration(checker MessageModerationChecker) MessageHandlerOption {
+	return func(h *MessageHandler) {
+		h.moderation = checker
+	}
+}
+
+// WithMessageStreamer enables the NDJSON export of the messages of a chat.
+func WithMessageStreamer(streamer MessageStreamer) MessageHandlerOption {
+	return func(h *MessageHandler) {
+		h.streamer = streamer
+	}
 }
 
 // NewMessageHandler creates a new MessageHandler.
-func NewMessageHandler(messageService MessageService) *MessageHandler {
-	return &MessageHandler{
+func NewMessageHandler(messageService MessageService, opts ...MessageHandlerOption) *MessageHandler {
+	h := &MessageHandler{
 		messageService: messageService,
 	}
+	for _, opt := range opts {
+		opt(h)
+	}
+	return h
 }
 
 // RegisterRoutes registers message routes with the router.
@@ -122,8 +230,15 @@ func (h *MessageHandler) RegisterRoutes(r *httpserver.Router) {
 	// Message operations (authenticated routes with chat/message ID)
 	r.Auth().POST("/chats/:chat_id/messages", h.Send)
 	r.Auth().GET("/chats/:chat_id/messages", h.List)
+	r.Auth().GET("/chats/:chat_id/messages/export", h.Export)
 	r.Auth().PUT("/messages/:id", h.Edit)
 	r.Auth().DELETE("/messages/:id", h.Delete)
+	r.Auth().POST("/messages/:id/undo", h.Undo)
+	r.Auth().POST("/messages/:id/forward", h.Forward)
+	r.Auth().POST("/chats/:chat_id/polls", h.CreatePoll)
+	r.Auth().POST("/messages/:id/poll/vote", h.VotePoll)
+	r.Auth().DELETE("/messages/:id/poll/vote", h.RetractPollVote)
+	r.Auth().POST("/messages/:id/poll/close", h.ClosePoll)
 }
 
 // Send handles POST /api/v1/chats/:chat_id/messages.
@@ -147,10 +262,8 @@ func (h *MessageHandler) Send(c echo.Context) error {
 			c, http.StatusBadRequest, "INVALID_REQUEST", "invalid request body")
 	}
 
-	// Validate request
-	if valErr := validateSendMessageRequest(&req); valErr != nil {
-		return httpserver.RespondErrorWithCode(
-			c, http.StatusBadRequest, "VALIDATION_ERROR", valErr.Error())
+	if valErr := validateRequest(&req); valErr != nil {
+		return httpserver.RespondValidationError(c, valErr)
 	}
 
 	// Build command
@@ -162,6 +275,9 @@ func (h *MessageHandler) Send(c echo.Context) error {
 	if req.ReplyToID != nil && !req.ReplyToID.IsZero() {
 		cmd.ParentMessageID = *req.ReplyToID
 	}
+	if req.QuoteID != nil && !req.QuoteID.IsZero() {
+		cmd.QuotedMessageID = *req.QuoteID
+	}
 
 	result, err := h.messageService.SendMessage(c.Request().Context(), cmd)
 	if err != nil {
@@ -202,29 +318,80 @@ func (h *MessageHandler) List(c echo.Context) error {
 	}
 
 	// Build response
+	canModerate := h.canSeeDeletedContent(c, chatID, userID, result.Value)
 	messages := make([]MessageResponse, 0, len(result.Value))
 	for _, msg := range result.Value {
-		messages = append(messages, ToMessageResponse(msg))
+		resp := ToMessageResponse(msg)
+		setPollViewer(&resp, msg, userID)
+		if canModerate && msg.IsDeleted() && !msg.IsPurged() {
+			content := msg.Content()
+			resp.DeletedContent = &content
+		}
+		messages = append(messages, resp)
 	}
 
 	// Determine if there are more messages
 	hasMore := len(messages) == limit
 
-	// Build cursor for next page
-	var nextCursor *string
-	if hasMore && len(messages) > 0 {
-		lastMsg := messages[len(messages)-1]
-		cursor := lastMsg.ID.String()
-		nextCursor = &cursor
+	pagination := httpserver.NewUncountedPagination(offset, limit, hasMore)
+	resp := MessageListResponse{
+		Messages: messages,
+		HasMore:  hasMore,
+	}
+	if pagination.NextCursor != "" {
+		resp.NextCursor = &pagination.NextCursor
 	}
 
-	resp := MessageListResponse{
-		Messages:   messages,
-		HasMore:    hasMore,
-		NextCursor: nextCursor,
+	return httpserver.RespondPage(c, resp, pagination)
+}
+
+// Export handles GET /api/v1/chats/:chat_id/messages/export.
+// Streams every message of the chat, oldest first, as NDJSON with one message per
+// line. A client that lost the connection resumes with ?after=<id of the last
+// message it received>.
+func (h *MessageHandler) Export(c echo.Context) error {
+	userID := middleware.GetUserID(c)
+	if userID.IsZero() {
+		return httpserver.RespondErrorWithCode(c, http.StatusUnauthorized, "UNAUTHORIZED", "authentication required")
+	}
+	if h.streamer == nil {
+		return httpserver.RespondErrorWithCode(
+			c, http.StatusServiceUnavailable, "SERVICE_UNAVAILABLE", "message export is not available")
 	}
 
-	return httpserver.RespondOK(c, resp)
+	chatID, parseErr := uuid.ParseUUID(c.Param("chat_id"))
+	if parseErr != nil {
+		return httpserver.RespondErrorWithCode(
+			c, http.StatusBadRequest, "INVALID_CHAT_ID", "invalid chat ID format")
+	}
+	after, cursorErr := parseExportCursor(c)
+	if cursorErr != nil {
+		return httpserver.RespondErrorWithCode(c, http.StatusBadRequest, "INVALID_CURSOR", "invalid cursor format")
+	}
+
+	stream := httpserver.NewNDJSONStream(c)
+	var canModerate *bool
+	err := h.streamer.StreamByChatID(c.Request().Context(), chatID, after, func(msg *message.Message) error {
+		resp := ToMessageResponse(msg)
+		setPollViewer(&resp, msg, userID)
+		if msg.IsDeleted() && !msg.IsPurged() {
+			// asked once, on the first deleted message of the stream
+			if canModerate == nil {
+				allowed := h.canSeeDeletedContent(c, chatID, userID, []*message.Message{msg})
+				canModerate = &allowed
+			}
+			if *canModerate {
+				content := msg.Content()
+				resp.DeletedContent = &content
+			}
+		}
+		return stream.Write(resp)
+	})
+	if errors.Is(err, errs.ErrNotFound) && !stream.Started() {
+		return httpserver.RespondErrorWithCode(
+			c, http.StatusBadRequest, "INVALID_CURSOR", "cursor is not a message of this chat")
+	}
+	return stream.Close(err)
 }
 
 // Edit handles PUT /api/v1/messages/:id.
@@ -248,10 +415,8 @@ func (h *MessageHandler) Edit(c echo.Context) error {
 			c, http.StatusBadRequest, "INVALID_REQUEST", "invalid request body")
 	}
 
-	// Validate request
-	if valErr := validateEditMessageRequest(&req); valErr != nil {
-		return httpserver.RespondErrorWithCode(
-			c, http.StatusBadRequest, "VALIDATION_ERROR", valErr.Error())
+	if valErr := validateRequest(&req); valErr != nil {
+		return httpserver.RespondValidationError(c, valErr)
 	}
 
 	cmd := messageapp.EditMessageCommand{
@@ -297,6 +462,205 @@ func (h *MessageHandler) Delete(c echo.Context) error {
 	return httpserver.RespondNoContent(c)
 }
 
+// Undo handles POST /api/v1/messages/:id/undo.
+// Restores a message deleted moments ago or reverts its last edit.
+func (h *MessageHandler) Undo(c echo.Context) error {
+	userID := middleware.GetUserID(c)
+	if userID.IsZero() {
+		return httpserver.RespondErrorWithCode(c, http.StatusUnauthorized, "UNAUTHORIZED", "authentication required")
+	}
+
+	messageID, parseErr := uuid.ParseUUID(c.Param("id"))
+	if parseErr != nil {
+		return httpserver.RespondErrorWithCode(
+			c, http.StatusBadRequest, "INVALID_MESSAGE_ID", "invalid message ID format")
+	}
+
+	cmd := messageapp.UndoMessageChangeCommand{
+		MessageID: messageID,
+		UserID:    userID,
+	}
+
+	result, err := h.messageService.UndoMessageChange(c.Request().Context(), cmd)
+	if err != nil {
+		return httpserver.RespondError(c, err)
+	}
+
+	return httpserver.RespondOK(c, ToMessageResponse(result.Value))
+}
+
+// Forward handles POST /api/v1/messages/:id/forward.
+// Copies the message into another chat the user can post in.
+func (h *MessageHandler) Forward(c echo.Context) error {
+	userID := middleware.GetUserID(c)
+	if userID.IsZero() {
+		return httpserver.RespondErrorWithCode(c, http.StatusUnauthorized, "UNAUTHORIZED", "authentication required")
+	}
+
+	messageIDStr := c.Param("id")
+	messageID, parseErr := uuid.ParseUUID(messageIDStr)
+	if parseErr != nil {
+		return httpserver.RespondErrorWithCode(
+			c, http.StatusBadRequest, "INVALID_MESSAGE_ID", "invalid message ID format")
+	}
+
+	var req ForwardMessageRequest
+	if bindErr := c.Bind(&req); bindErr != nil {
+		return httpserver.RespondErrorWithCode(
+			c, http.StatusBadRequest, "INVALID_REQUEST", "invalid request body")
+	}
+	if valErr := validateRequest(&req); valErr != nil {
+		return httpserver.RespondValidationError(c, valErr)
+	}
+
+	cmd := messageapp.ForwardMessageCommand{
+		MessageID:    messageID,
+		TargetChatID: req.ChatID,
+		UserID:       userID,
+		Comment:      req.Comment,
+	}
+
+	result, err := h.messageService.ForwardMessage(c.Request().Context(), cmd)
+	if err != nil {
+		return httpserver.RespondError(c, err)
+	}
+
+	resp := ToMessageResponse(result.Value)
+	return httpserver.RespondCreated(c, resp)
+}
+
+// CreatePoll handles POST /api/v1/chats/:chat_id/polls.
+// Posts a poll message to the chat.
+func (h *MessageHandler) CreatePoll(c echo.Context) error {
+	userID := middleware.GetUserID(c)
+	if userID.IsZero() {
+		return httpserver.RespondErrorWithCode(c, http.StatusUnauthorized, "UNAUTHORIZED", "authentication required")
+	}
+
+	chatID, parseErr := uuid.ParseUUID(c.Param("chat_id"))
+	if parseErr != nil {
+		return httpserver.RespondErrorWithCode(
+			c, http.StatusBadRequest, "INVALID_CHAT_ID", "invalid chat ID format")
+	}
+
+	var req CreatePollRequest
+	if bindErr := c.Bind(&req); bindErr != nil {
+		return httpserver.RespondErrorWithCode(
+			c, http.StatusBadRequest, "INVALID_REQUEST", "invalid request body")
+	}
+
+	cmd := messageapp.CreatePollCommand{
+		ChatID:    chatID,
+		AuthorID:  userID,
+		Question:  req.Question,
+		Options:   req.Options,
+		Anonymous: req.Anonymous,
+		ClosesAt:  req.ClosesAt,
+	}
+
+	result, err := h.messageService.CreatePoll(c.Request().Context(), cmd)
+	if err != nil {
+		return httpserver.RespondError(c, err)
+	}
+
+	return httpserver.RespondCreated(c, ToMessageResponse(result.Value))
+}
+
+// VotePoll handles POST /api/v1/messages/:id/poll/vote.
+// Records the vote of the user, replacing an earlier one.
+func (h *MessageHandler) VotePoll(c echo.Context) error {
+	userID := middleware.GetUserID(c)
+	if userID.IsZero() {
+		return httpserver.RespondErrorWithCode(c, http.StatusUnauthorized, "UNAUTHORIZED", "authentication required")
+	}
+
+	messageID, parseErr := uuid.ParseUUID(c.Param("id"))
+	if parseErr != nil {
+		return httpserver.RespondErrorWithCode(
+			c, http.StatusBadRequest, "INVALID_MESSAGE_ID", "invalid message ID format")
+	}
+
+	var req VotePollRequest
+	if bindErr := c.Bind(&req); bindErr != nil {
+		return httpserver.RespondErrorWithCode(
+			c, http.StatusBadRequest, "INVALID_REQUEST", "invalid request body")
+	}
+	if valErr := validateRequest(&req); valErr != nil {
+		return httpserver.RespondValidationError(c, valErr)
+	}
+
+	cmd := messageapp.VotePollCommand{
+		MessageID: messageID,
+		OptionID:  req.OptionID,
+		UserID:    userID,
+	}
+
+	result, err := h.messageService.VotePoll(c.Request().Context(), cmd)
+	if err != nil {
+		return httpserver.RespondError(c, err)
+	}
+
+	resp := ToMessageResponse(result.Value)
+	setPollViewer(&resp, result.Value, userID)
+	return httpserver.RespondOK(c, resp)
+}
+
+// RetractPollVote handles DELETE /api/v1/messages/:id/poll/vote.
+// Removes the vote of the user.
+func (h *MessageHandler) RetractPollVote(c echo.Context) error {
+	userID := middleware.GetUserID(c)
+	if userID.IsZero() {
+		return httpserver.RespondErrorWithCode(c, http.StatusUnauthorized, "UNAUTHORIZED", "authentication required")
+	}
+
+	messageID, parseErr := uuid.ParseUUID(c.Param("id"))
+	if parseErr != nil {
+		return httpserver.RespondErrorWithCode(
+			c, http.StatusBadRequest, "INVALID_MESSAGE_ID", "invalid message ID format")
+	}
+
+	cmd := messageapp.RetractPollVoteCommand{
+		MessageID: messageID,
+		UserID:    userID,
+	}
+
+	result, err := h.messageService.RetractPollVote(c.Request().Context(), cmd)
+	if err != nil {
+		return httpserver.RespondError(c, err)
+	}
+
+	return httpserver.RespondOK(c, ToMessageResponse(result.Value))
+}
+
+// ClosePoll handles POST /api/v1/messages/:id/poll/close.
+// Stops the voting; only the poll author can close it.
+func (h *MessageHandler) ClosePoll(c echo.Context) error {
+	userID := middleware.GetUserID(c)
+	if userID.IsZero() {
+		return httpserver.RespondErrorWithCode(c, http.StatusUnauthorized, "UNAUTHORIZED", "authentication required")
+	}
+
+	messageID, parseErr := uuid.ParseUUID(c.Param("id"))
+	if parseErr != nil {
+		return httpserver.RespondErrorWithCode(
+			c, http.StatusBadRequest, "INVALID_MESSAGE_ID", "invalid message ID format")
+	}
+
+	cmd := messageapp.ClosePollCommand{
+		MessageID: messageID,
+		UserID:    userID,
+	}
+
+	result, err := h.messageService.ClosePoll(c.Request().Context(), cmd)
+	if err != nil {
+		return httpserver.RespondError(c, err)
+	}
+
+	resp := ToMessageResponse(result.Value)
+	setPollViewer(&resp, result.Value, userID)
+	return httpserver.RespondOK(c, resp)
+}
+
 // AddAttachment handles POST /api/v1/messages/:id/attachments.
 func (h *MessageHandler) AddAttachment(c echo.Context) error {
 	userID := middleware.GetUserID(c)
@@ -317,6 +681,9 @@ func (h *MessageHandler) AddAttachment(c echo.Context) error {
 		FileName string `json:"file_name" form:"file_name"`
 		FileSize int64  `json:"file_size" form:"file_size"`
 		MimeType string `json:"mime_type" form:"mime_type"`
+		// audio attachments such as voice messages
+		DurationMS int64 `json:"duration_ms" form:"duration_ms"`
+		Waveform   []int `json:"waveform"`
 	}
 	if err := c.Bind(&req); err != nil {
 		return httpserver.RespondErrorWithCode(
@@ -336,6 +703,8 @@ func (h *MessageHandler) AddAttachment(c echo.Context) error {
 		FileSize:  req.FileSize,
 		MimeType:  req.MimeType,
 		UserID:    userID,
+		Duration:  time.Duration(req.DurationMS) * time.Millisecond,
+		Waveform:  req.Waveform,
 	}
 
 	_, err := h.messageService.AddAttachment(c.Request().Context(), cmd)
@@ -350,6 +719,8 @@ func (h *MessageHandler) AddAttachment(c echo.Context) error {
 		case errors.Is(err, messageapp.ErrMessageDeleted):
 			return httpserver.RespondErrorWithCode(
 				c, http.StatusBadRequest, "MESSAGE_DELETED", "cannot attach to deleted message")
+		case errors.Is(err, messageapp.ErrInvalidAudioMetadata):
+			return httpserver.RespondError(c, messageapp.ErrInvalidAudioMetadata)
 		default:
 			return httpserver.RespondErrorWithCode(
 				c, http.StatusInternalServerError, "INTERNAL_ERROR", "failed to add attachment")
@@ -361,24 +732,43 @@ func (h *MessageHandler) AddAttachment(c echo.Context) error {
 
 // Helper functions
 
-func validateSendMessageRequest(req *SendMessageRequest) error {
-	if req.Content == "" {
-		return ErrMessageEmpty
+// canSeeDeletedContent reports whether the user may see the content of the deleted
+// messages in the page: system admins and chat moderators can. The moderation check
+// only runs when the page contains a deleted message.
+func (h *MessageHandler) canSeeDeletedContent(
+	c echo.Context,
+	chatID, userID uuid.UUID,
+	messages []*message.Message,
+) bool {
+	hasDeleted := false
+	for _, msg := range messages {
+		if msg.IsDeleted() && !msg.IsPurged() {
+			hasDeleted = true
+			break
+		}
+	}
+	if !hasDeleted {
+		return false
 	}
-	if len(req.Content) > maxMessageContentLength {
-		return ErrMessageTooLong
+	if middleware.IsSystemAdmin(c) {
+		return true
 	}
-	return nil
+	if h.moderation == nil {
+		return false
+	}
+
+	canModerate, err := h.moderation.CanModerate(c.Request().Context(), chatID, userID)
+	return err == nil && canModerate
 }
 
-func validateEditMessageRequest(req *EditMessageRequest) error {
-	if req.Content == "" {
-		return ErrMessageEmpty
+// parseExportCursor returns the ID in the "after" query parameter of an export, or
+// the zero UUID to start from the beginning.
+func parseExportCursor(c echo.Context) (uuid.UUID, error) {
+	after := c.QueryParam("after")
+	if after == "" {
+		return "", nil
 	}
-	if len(req.Content) > maxMessageContentLength {
-		return ErrMessageTooLong
-	}
-	return nil
+	return uuid.ParseUUID(after)
 }
 
 func parseMessagePagination(c echo.Context) (int, int) {
@@ -396,14 +786,15 @@ func parseMessagePagination(c echo.Context) (int, int) {
 			offset = o
 		}
 	}
-
-	// Support cursor-based pagination via "before" parameter
-	// The cursor is handled in the service layer
+	if o, ok := httpserver.CursorOffset(c); ok {
+		offset = o
+	}
 
 	return limit, offset
 }
 
 // ToMessageResponse converts a domain Message to MessageResponse.
+// Deleted messages are returned as tombstones without content, attachments or reactions.
 func ToMessageResponse(msg *message.Message) MessageResponse {
 	resp := MessageResponse{
 		ID:        msg.ID(),
@@ -427,23 +818,42 @@ func ToMessageResponse(msg *message.Message) MessageResponse {
 		resp.ReplyToID = &parentID
 	}
 
+	// Set quote ID if it quotes another message
+	if msg.HasQuote() {
+		quoteID := msg.QuotedMessageID()
+		resp.QuoteID = &quoteID
+	}
+
 	// Set edited at if edited
 	if msg.IsEdited() {
 		editedAt := msg.EditedAt().Format(time.RFC3339)
 		resp.EditedAt = &editedAt
 	}
 
+	if msg.IsDeleted() {
+		resp.Content = ""
+		return resp
+	}
+
 	// Add attachments
 	attachments := msg.Attachments()
 	if len(attachments) > 0 {
 		resp.Attachments = make([]AttachmentResponse, 0, len(attachments))
 		for _, a := range attachments {
-			resp.Attachments = append(resp.Attachments, AttachmentResponse{
-				FileID:   a.FileID(),
-				FileName: a.FileName(),
-				FileSize: a.FileSize(),
-				MimeType: a.MimeType(),
-			})
+			attachment := AttachmentResponse{
+				FileID:     a.FileID(),
+				FileName:   a.FileName(),
+				FileSize:   a.FileSize(),
+				MimeType:   a.MimeType(),
+				DurationMS: a.Duration().Milliseconds(),
+				Waveform:   a.Waveform(),
+			}
+			if a.HasPreview() {
+				attachment.PreviewURL = "/api/v1/files/" + a.FileID().String() + "/" +
+					url.PathEscape(a.FileName()) + "/preview"
+				attachment.PreviewWidth, attachment.PreviewHeight = a.PreviewSize()
+			}
+			resp.Attachments = append(resp.Attachments, attachment)
 		}
 	}
 
@@ -465,9 +875,60 @@ func ToMessageResponse(msg *message.Message) MessageResponse {
 		}
 	}
 
+	resp.Poll = toPollResponse(msg.Poll())
+
 	return resp
 }
 
+// toPollResponse converts a domain Poll to PollResponse.
+// Voters are listed only for polls that are not anonymous.
+func toPollResponse(poll *message.Poll) *PollResponse {
+	if poll == nil {
+		return nil
+	}
+
+	resp := &PollResponse{
+		Question:   poll.Question(),
+		Anonymous:  poll.IsAnonymous(),
+		Closed:     poll.IsClosed(time.Now()),
+		TotalVotes: poll.TotalVotes(),
+	}
+	if poll.ClosesAt() != nil {
+		closesAt := poll.ClosesAt().Format(time.RFC3339)
+		resp.ClosesAt = &closesAt
+	}
+
+	voters := make(map[uuid.UUID][]uuid.UUID)
+	if !poll.IsAnonymous() {
+		for _, v := range poll.Votes() {
+			voters[v.OptionID()] = append(voters[v.OptionID()], v.UserID())
+		}
+	}
+
+	resp.Options = make([]PollOptionResponse, 0, len(poll.Options()))
+	for _, r := range poll.Results() {
+		resp.Options = append(resp.Options, PollOptionResponse{
+			ID:     r.OptionID,
+			Text:   r.Text,
+			Votes:  r.Votes,
+			Voters: voters[r.OptionID],
+		})
+	}
+	return resp
+}
+
+// setPollViewer fills in the option chosen by the requesting user,
+// which is the only way to see it in anonymous polls.
+func setPollViewer(resp *MessageResponse, msg *message.Message, userID uuid.UUID) {
+	if resp.Poll == nil || msg.Poll() == nil {
+		return
+	}
+	resp.Poll.MyVote = msg.Poll().VoteOf(userID)
+}
+
+// mockUndoWindow is the undo window applied by MockMessageService.
+const mockUndoWindow = time.Minute
+
 // MockMessageService is a mock implementation of MessageService for testing.
 type MockMessageService struct {
 	messages     map[uuid.UUID]*message.Message
@@ -497,6 +958,15 @@ func (m *MockMessageService) SendMessage(
 	if err != nil {
 		return messageapp.Result{}, err
 	}
+	if !cmd.QuotedMessageID.IsZero() {
+		quoted, ok := m.messages[cmd.QuotedMessageID]
+		if !ok {
+			return messageapp.Result{}, messageapp.ErrQuotedNotFound
+		}
+		if quoteErr := msg.Quote(quoted); quoteErr != nil {
+			return messageapp.Result{}, messageapp.ErrQuoteInDifferentChat
+		}
+	}
 
 	m.messages[msg.ID()] = msg
 	m.chatMessages[cmd.ChatID] = append(m.chatMessages[cmd.ChatID], msg)
@@ -574,9 +1044,133 @@ func (m *MockMessageService) AddAttachment(
 		return messageapp.Result{}, messageapp.ErrMessageNotFound
 	}
 
-	if err := msg.AddAttachment(cmd.FileID, cmd.FileName, cmd.FileSize, cmd.MimeType); err != nil {
+	var err error
+	if cmd.Duration > 0 {
+		err = msg.AddAudioAttachment(cmd.FileID, cmd.FileName, cmd.FileSize, cmd.MimeType, cmd.Duration, cmd.Waveform)
+	} else {
+		err = msg.AddAttachment(cmd.FileID, cmd.FileName, cmd.FileSize, cmd.MimeType)
+	}
+	if err != nil {
 		return messageapp.Result{}, err
 	}
 
 	return messageapp.Result{Value: msg}, nil
 }
+
+// ForwardMessage copies a message into another chat in the mock service.
+func (m *MockMessageService) ForwardMessage(
+	_ context.Context,
+	cmd messageapp.ForwardMessageCommand,
+) (messageapp.Result, error) {
+	source, ok := m.messages[cmd.MessageID]
+	if !ok {
+		return messageapp.Result{}, messageapp.ErrMessageNotFound
+	}
+
+	msg, err := message.NewMessage(cmd.TargetChatID, cmd.UserID, source.Content(), uuid.UUID(""))
+	if err != nil {
+		return messageapp.Result{}, err
+	}
+
+	m.messages[msg.ID()] = msg
+	m.chatMessages[cmd.TargetChatID] = append(m.chatMessages[cmd.TargetChatID], msg)
+
+	return messageapp.Result{Value: msg}, nil
+}
+
+// UndoMessageChange restores a deleted message or reverts its last edit in the mock service.
+func (m *MockMessageService) UndoMessageChange(
+	_ context.Context,
+	cmd messageapp.UndoMessageChangeCommand,
+) (messageapp.Result, error) {
+	msg, ok := m.messages[cmd.MessageID]
+	if !ok {
+		return messageapp.Result{}, messageapp.ErrMessageNotFound
+	}
+
+	var err error
+	if msg.IsDeleted() {
+		err = msg.Restore(cmd.UserID, mockUndoWindow)
+	} else {
+		err = msg.RevertEdit(cmd.UserID, mockUndoWindow)
+	}
+	if err != nil {
+		return messageapp.Result{}, err
+	}
+
+	return messageapp.Result{Value: msg}, nil
+}
+
+// CreatePoll posts a poll message in the mock service.
+func (m *MockMessageService) CreatePoll(
+	_ context.Context,
+	cmd messageapp.CreatePollCommand,
+) (messageapp.Result, error) {
+	poll, err := message.NewPoll(cmd.Question, cmd.Options, cmd.Anonymous, cmd.ClosesAt)
+	if err != nil {
+		return messageapp.Result{}, messageapp.ErrInvalidPoll
+	}
+	msg, err := message.NewPollMessage(cmd.ChatID, cmd.AuthorID, poll)
+	if err != nil {
+		return messageapp.Result{}, err
+	}
+
+	m.messages[msg.ID()] = msg
+	m.chatMessages[cmd.ChatID] = append(m.chatMessages[cmd.ChatID], msg)
+
+	return messageapp.Result{Value: msg}, nil
+}
+
+// VotePoll records a vote in the mock service.
+func (m *MockMessageService) VotePoll(
+	_ context.Context,
+	cmd messageapp.VotePollCommand,
+) (messageapp.Result, error) {
+	msg, ok := m.messages[cmd.MessageID]
+	if !ok {
+		return messageapp.Result{}, messageapp.ErrMessageNotFound
+	}
+	if !msg.HasPoll() {
+		return messageapp.Result{}, messageapp.ErrNotAPoll
+	}
+
+	if err := msg.Vote(cmd.UserID, cmd.OptionID); err != nil {
+		return messageapp.Result{}, messageapp.ErrPollOptionNotFound
+	}
+
+	return messageapp.Result{Value: msg}, nil
+}
+
+// RetractPollVote removes a vote in the mock service.
+func (m *MockMessageService) RetractPollVote(
+	_ context.Context,
+	cmd messageapp.RetractPollVoteCommand,
+) (messageapp.Result, error) {
+	msg, ok := m.messages[cmd.MessageID]
+	if !ok {
+		return messageapp.Result{}, messageapp.ErrMessageNotFound
+	}
+
+	if err := msg.RetractVote(cmd.UserID); err != nil {
+		return messageapp.Result{}, messageapp.ErrPollVoteNotFound
+	}
+
+	return messageapp.Result{Value: msg}, nil
+}
+
+// ClosePoll closes a poll in the mock service.
+func (m *MockMessageService) ClosePoll(
+	_ context.Context,
+	cmd messageapp.ClosePollCommand,
+) (messageapp.Result, error) {
+	msg, ok := m.messages[cmd.MessageID]
+	if !ok {
+		return messageapp.Result{}, messageapp.ErrMessageNotFound
+	}
+
+	if err := msg.ClosePoll(cmd.UserID); err != nil {
+		return messageapp.Result{}, messageapp.ErrNotPollAuthor
+	}
+
+	return messageapp.Result{Value: msg}, nil
+}
@@ -3,6 +3,7 @@ package httphandler_test
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	stdhttp "net/http"
 	"net/http/httptest"
 	"strings"
@@ -10,6 +11,7 @@ import (
 
 	"github.com/labstack/echo/v4"
 	messageapp "github.com/lllypuk/flowra/internal/application/message"
+	"github.com/lllypuk/flowra/internal/domain/errs"
 	"github.com/lllypuk/flowra/internal/domain/message"
 	"github.com/lllypuk/flowra/internal/domain/uuid"
 	httphandler "github.com/lllypuk/flowra/internal/handler/http"
@@ -97,6 +99,39 @@ func TestMessageHandler_Send(t *testing.T) {
 		assert.Equal(t, stdhttp.StatusCreated, rec.Code)
 	})
 
+	t.Run("send message quoting another message", func(t *testing.T) {
+		e := echo.New()
+		userID := uuid.NewUUID()
+		chatID := uuid.NewUUID()
+
+		mockService := httphandler.NewMockMessageService()
+		handler := httphandler.NewMessageHandler(mockService)
+		quoted := createTestMessage(t, chatID, uuid.NewUUID(), "Quoted")
+		mockService.AddMessage(quoted)
+
+		reqBody := `{"content": "Agreed", "quote_id": "` + quoted.ID().String() + `"}`
+		req := httptest.NewRequest(stdhttp.MethodPost, chatMessagesURL(chatID), strings.NewReader(reqBody))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("chat_id")
+		c.SetParamValues(chatID.String())
+
+		setupMessageAuthContext(c, userID)
+
+		err := handler.Send(c)
+		require.NoError(t, err)
+		assert.Equal(t, stdhttp.StatusCreated, rec.Code)
+
+		var resp struct {
+			Data httphandler.MessageResponse `json:"data"`
+		}
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+		require.NotNil(t, resp.Data.QuoteID)
+		assert.Equal(t, quoted.ID(), *resp.Data.QuoteID)
+		assert.Nil(t, resp.Data.ReplyToID)
+	})
+
 	t.Run("missing auth", func(t *testing.T) {
 		e := echo.New()
 		chatID := uuid.NewUUID()
@@ -244,6 +279,65 @@ func TestMessageHandler_List(t *testing.T) {
 		assert.True(t, resp.Success)
 	})
 
+	t.Run("cursor round trip", func(t *testing.T) {
+		userID := uuid.NewUUID()
+		chatID := uuid.NewUUID()
+
+		mockService := httphandler.NewMockMessageService()
+		for _, content := range []string{"Message 1", "Message 2", "Message 3"} {
+			mockService.AddMessage(createTestMessage(t, chatID, userID, content))
+		}
+		handler := httphandler.NewMessageHandler(mockService)
+
+		list := func(t *testing.T, query string) (map[string]json.RawMessage, *httpserver.Pagination) {
+			t.Helper()
+			req := httptest.NewRequest(stdhttp.MethodGet, chatMessagesURL(chatID)+query, nil)
+			rec := httptest.NewRecorder()
+			c := echo.New().NewContext(req, rec)
+			c.SetParamNames("chat_id")
+			c.SetParamValues(chatID.String())
+			setupMessageAuthContext(c, userID)
+
+			require.NoError(t, handler.List(c))
+			require.Equal(t, stdhttp.StatusOK, rec.Code)
+			return decodeListPage[map[string]json.RawMessage](t, rec)
+		}
+		contents := func(t *testing.T, data map[string]json.RawMessage) []string {
+			t.Helper()
+			var messages []httphandler.MessageResponse
+			require.NoError(t, json.Unmarshal(data["messages"], &messages))
+			result := make([]string, 0, len(messages))
+			for _, msg := range messages {
+				result = append(result, msg.Content)
+			}
+			return result
+		}
+
+		first, firstPage := list(t, "?limit=2")
+		assert.Equal(t, []string{"Message 1", "Message 2"}, contents(t, first))
+		assert.Nil(t, firstPage.Total)
+		assert.True(t, firstPage.HasMore)
+		require.Equal(t, httpserver.EncodeOffsetCursor(2), firstPage.NextCursor)
+		assert.JSONEq(t, `"`+firstPage.NextCursor+`"`, string(first["next_cursor"]),
+			"data.next_cursor is the pagination cursor")
+
+		second, secondPage := list(t, "?limit=2&cursor="+firstPage.NextCursor)
+		assert.Equal(t, []string{"Message 3"}, contents(t, second))
+		assert.Equal(t, 2, secondPage.Offset)
+		assert.False(t, secondPage.HasMore)
+		assert.NotContains(t, second, "next_cursor")
+		assert.Equal(t, httpserver.EncodeOffsetCursor(0), secondPage.PrevCursor)
+
+		back, _ := list(t, "?limit=2&cursor="+secondPage.PrevCursor)
+		assert.Equal(t, contents(t, first), contents(t, back))
+
+		// data.next_cursor is accepted by the cursor parameter as well
+		var cursor string
+		require.NoError(t, json.Unmarshal(first["next_cursor"], &cursor))
+		next, _ := list(t, "?limit=2&cursor="+cursor)
+		assert.Equal(t, contents(t, second), contents(t, next))
+	})
+
 	t.Run("list with pagination", func(t *testing.T) {
 		e := echo.New()
 		userID := uuid.NewUUID()
@@ -642,6 +736,296 @@ func TestMessageHandler_Delete(t *testing.T) {
 	})
 }
 
+func TestMessageHandler_Undo(t *testing.T) {
+	undo := func(
+		t *testing.T, service *httphandler.MockMessageService, messageID, userID uuid.UUID,
+	) *httptest.ResponseRecorder {
+		t.Helper()
+		handler := httphandler.NewMessageHandler(service)
+
+		req := httptest.NewRequest(stdhttp.MethodPost, messageURL(messageID)+"/undo", nil)
+		rec := httptest.NewRecorder()
+		c := echo.New().NewContext(req, rec)
+		c.SetParamNames("id")
+		c.SetParamValues(messageID.String())
+		setupMessageAuthContext(c, userID)
+
+		require.NoError(t, handler.Undo(c))
+		return rec
+	}
+
+	t.Run("restores deleted message", func(t *testing.T) {
+		userID := uuid.NewUUID()
+		mockService := httphandler.NewMockMessageService()
+		testMessage := createTestMessage(t, uuid.NewUUID(), userID, "Oops")
+		require.NoError(t, testMessage.Delete(userID))
+		mockService.AddMessage(testMessage)
+
+		rec := undo(t, mockService, testMessage.ID(), userID)
+		assert.Equal(t, stdhttp.StatusOK, rec.Code)
+
+		var resp struct {
+			Data httphandler.MessageResponse `json:"data"`
+		}
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+		assert.False(t, resp.Data.IsDeleted)
+		assert.Equal(t, "Oops", resp.Data.Content)
+	})
+
+	t.Run("reverts edit", func(t *testing.T) {
+		userID := uuid.NewUUID()
+		mockService := httphandler.NewMockMessageService()
+		testMessage := createTestMessage(t, uuid.NewUUID(), userID, "Original")
+		require.NoError(t, testMessage.EditContent("Edited", userID))
+		mockService.AddMessage(testMessage)
+
+		rec := undo(t, mockService, testMessage.ID(), userID)
+		assert.Equal(t, stdhttp.StatusOK, rec.Code)
+		assert.Equal(t, "Original", testMessage.Content())
+	})
+
+	t.Run("not message author", func(t *testing.T) {
+		authorID := uuid.NewUUID()
+		mockService := httphandler.NewMockMessageService()
+		testMessage := createTestMessage(t, uuid.NewUUID(), authorID, "Oops")
+		require.NoError(t, testMessage.Delete(authorID))
+		mockService.AddMessage(testMessage)
+
+		rec := undo(t, mockService, testMessage.ID(), uuid.NewUUID())
+		assert.Equal(t, stdhttp.StatusForbidden, rec.Code)
+		assert.True(t, testMessage.IsDeleted())
+	})
+
+	t.Run("message not found", func(t *testing.T) {
+		rec := undo(t, httphandler.NewMockMessageService(), uuid.NewUUID(), uuid.NewUUID())
+		assert.Equal(t, stdhttp.StatusNotFound, rec.Code)
+	})
+}
+
+func TestMessageHandler_Forward(t *testing.T) {
+	forward := func(
+		t *testing.T, service *httphandler.MockMessageService, messageID, userID uuid.UUID, body string,
+	) *httptest.ResponseRecorder {
+		t.Helper()
+		handler := httphandler.NewMessageHandler(service)
+
+		req := httptest.NewRequest(
+			stdhttp.MethodPost, messageURL(messageID)+"/forward", strings.NewReader(body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := echo.New().NewContext(req, rec)
+		c.SetParamNames("id")
+		c.SetParamValues(messageID.String())
+		setupMessageAuthContext(c, userID)
+
+		require.NoError(t, handler.Forward(c))
+		return rec
+	}
+
+	t.Run("successful forward", func(t *testing.T) {
+		userID := uuid.NewUUID()
+		targetChatID := uuid.NewUUID()
+		mockService := httphandler.NewMockMessageService()
+		testMessage := createTestMessage(t, uuid.NewUUID(), uuid.NewUUID(), "Original")
+		mockService.AddMessage(testMessage)
+
+		rec := forward(t, mockService, testMessage.ID(), userID, `{"chat_id": "`+targetChatID.String()+`"}`)
+		assert.Equal(t, stdhttp.StatusCreated, rec.Code)
+
+		var resp struct {
+			Data httphandler.MessageResponse `json:"data"`
+		}
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+		assert.Equal(t, targetChatID, resp.Data.ChatID)
+		assert.Equal(t, userID, resp.Data.SenderID)
+	})
+
+	t.Run("missing chat id", func(t *testing.T) {
+		mockService := httphandler.NewMockMessageService()
+		rec := forward(t, mockService, uuid.NewUUID(), uuid.NewUUID(), `{"comment": "hi"}`)
+		assert.Equal(t, stdhttp.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("message not found", func(t *testing.T) {
+		mockService := httphandler.NewMockMessageService()
+		rec := forward(t, mockService, uuid.NewUUID(), uuid.NewUUID(), `{"chat_id": "`+uuid.NewUUID().String()+`"}`)
+		assert.Equal(t, stdhttp.StatusNotFound, rec.Code)
+	})
+}
+
+func TestMessageHandler_Polls(t *testing.T) {
+	pollRequest := func(
+		method, target, body string, userID uuid.UUID, paramName, paramValue string,
+	) (echo.Context, *httptest.ResponseRecorder) {
+		req := httptest.NewRequest(method, target, strings.NewReader(body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := echo.New().NewContext(req, rec)
+		c.SetParamNames(paramName)
+		c.SetParamValues(paramValue)
+		setupMessageAuthContext(c, userID)
+		return c, rec
+	}
+	decode := func(t *testing.T, rec *httptest.ResponseRecorder) httphandler.MessageResponse {
+		t.Helper()
+		var resp struct {
+			Data httphandler.MessageResponse `json:"data"`
+		}
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+		return resp.Data
+	}
+
+	authorID := uuid.NewUUID()
+	voterID := uuid.NewUUID()
+	chatID := uuid.NewUUID()
+	mockService := httphandler.NewMockMessageService()
+	handler := httphandler.NewMessageHandler(mockService)
+
+	c, rec := pollRequest(stdhttp.MethodPost, "/api/v1/chats/"+chatID.String()+"/polls",
+		`{"question": "Release on Friday?", "options": ["Yes", "No"], "anonymous": true}`,
+		authorID, "chat_id", chatID.String())
+	require.NoError(t, handler.CreatePoll(c))
+	require.Equal(t, stdhttp.StatusCreated, rec.Code)
+	created := decode(t, rec)
+	assert.Equal(t, "poll", created.Type)
+	require.NotNil(t, created.Poll)
+	require.Len(t, created.Poll.Options, 2)
+	assert.True(t, created.Poll.Anonymous)
+
+	t.Run("invalid poll", func(t *testing.T) {
+		c, rec := pollRequest(stdhttp.MethodPost, "/api/v1/chats/"+chatID.String()+"/polls",
+			`{"question": "Lunch?", "options": ["Yes"]}`, authorID, "chat_id", chatID.String())
+		require.NoError(t, handler.CreatePoll(c))
+		assert.Equal(t, stdhttp.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("vote hides voters of anonymous poll", func(t *testing.T) {
+		optionID := created.Poll.Options[1].ID
+		c, rec := pollRequest(stdhttp.MethodPost, messageURL(created.ID)+"/poll/vote",
+			`{"option_id": "`+optionID.String()+`"}`, voterID, "id", created.ID.String())
+		require.NoError(t, handler.VotePoll(c))
+		require.Equal(t, stdhttp.StatusOK, rec.Code)
+
+		resp := decode(t, rec)
+		assert.Equal(t, 1, resp.Poll.Options[1].Votes)
+		assert.Empty(t, resp.Poll.Options[1].Voters)
+		require.NotNil(t, resp.Poll.MyVote)
+		assert.Equal(t, optionID, *resp.Poll.MyVote)
+	})
+
+	t.Run("vote requires option", func(t *testing.T) {
+		c, rec := pollRequest(stdhttp.MethodPost, messageURL(created.ID)+"/poll/vote",
+			`{}`, voterID, "id", created.ID.String())
+		require.NoError(t, handler.VotePoll(c))
+		assert.Equal(t, stdhttp.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("retract vote", func(t *testing.T) {
+		c, rec := pollRequest(stdhttp.MethodDelete, messageURL(created.ID)+"/poll/vote",
+			"", voterID, "id", created.ID.String())
+		require.NoError(t, handler.RetractPollVote(c))
+		require.Equal(t, stdhttp.StatusOK, rec.Code)
+		assert.Equal(t, 0, decode(t, rec).Poll.TotalVotes)
+	})
+
+	t.Run("close poll", func(t *testing.T) {
+		c, rec := pollRequest(stdhttp.MethodPost, messageURL(created.ID)+"/poll/close",
+			"", voterID, "id", created.ID.String())
+		require.NoError(t, handler.ClosePoll(c))
+		assert.Equal(t, stdhttp.StatusForbidden, rec.Code)
+
+		c, rec = pollRequest(stdhttp.MethodPost, messageURL(created.ID)+"/poll/close",
+			"", authorID, "id", created.ID.String())
+		require.NoError(t, handler.ClosePoll(c))
+		require.Equal(t, stdhttp.StatusOK, rec.Code)
+		assert.True(t, decode(t, rec).Poll.Closed)
+	})
+}
+
+type stubModerationChecker struct {
+	moderators map[uuid.UUID]bool
+	calls      int
+}
+
+func (s *stubModerationChecker) CanModerate(_ context.Context, _, userID uuid.UUID) (bool, error) {
+	s.calls++
+	return s.moderators[userID], nil
+}
+
+func TestMessageHandler_List_DeletedMessages(t *testing.T) {
+	chatID := uuid.NewUUID()
+	authorID := uuid.NewUUID()
+	moderatorID := uuid.NewUUID()
+
+	mockService := httphandler.NewMockMessageService()
+	deleted := createTestMessage(t, chatID, authorID, "Oops, wrong chat")
+	require.NoError(t, deleted.Delete(authorID))
+	mockService.AddMessage(deleted)
+
+	list := func(t *testing.T, handler *httphandler.MessageHandler, userID uuid.UUID) httphandler.MessageResponse {
+		t.Helper()
+		req := httptest.NewRequest(stdhttp.MethodGet, chatMessagesURL(chatID), nil)
+		rec := httptest.NewRecorder()
+		c := echo.New().NewContext(req, rec)
+		c.SetParamNames("chat_id")
+		c.SetParamValues(chatID.String())
+		setupMessageAuthContext(c, userID)
+
+		require.NoError(t, handler.List(c))
+		require.Equal(t, stdhttp.StatusOK, rec.Code)
+
+		var resp struct {
+			Data httphandler.MessageListResponse `json:"data"`
+		}
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+		require.Len(t, resp.Data.Messages, 1)
+		return resp.Data.Messages[0]
+	}
+
+	checker := &stubModerationChecker{moderators: map[uuid.UUID]bool{moderatorID: true}}
+	handler := httphandler.NewMessageHandler(mockService, httphandler.WithMessageModeration(checker))
+
+	t.Run("members see a tombstone", func(t *testing.T) {
+		msg := list(t, handler, uuid.NewUUID())
+
+		assert.True(t, msg.IsDeleted)
+		assert.Empty(t, msg.Content)
+		assert.Nil(t, msg.DeletedContent)
+	})
+
+	t.Run("moderators see the original content", func(t *testing.T) {
+		msg := list(t, handler, moderatorID)
+
+		assert.Empty(t, msg.Content)
+		require.NotNil(t, msg.DeletedContent)
+		assert.Equal(t, "Oops, wrong chat", *msg.DeletedContent)
+	})
+
+	t.Run("nothing is left after the purge", func(t *testing.T) {
+		require.NoError(t, deleted.Purge())
+
+		msg := list(t, handler, moderatorID)
+
+		assert.True(t, msg.IsDeleted)
+		assert.Nil(t, msg.DeletedContent)
+	})
+
+	t.Run("pages without deleted messages skip the check", func(t *testing.T) {
+		calls := checker.calls
+		other := uuid.NewUUID()
+		mockService.AddMessage(createTestMessage(t, other, authorID, "Hello"))
+
+		req := httptest.NewRequest(stdhttp.MethodGet, chatMessagesURL(other), nil)
+		c := echo.New().NewContext(req, httptest.NewRecorder())
+		c.SetParamNames("chat_id")
+		c.SetParamValues(other.String())
+		setupMessageAuthContext(c, moderatorID)
+
+		require.NoError(t, handler.List(c))
+		assert.Equal(t, calls, checker.calls)
+	})
+}
+
 func TestNewMessageHandler(t *testing.T) {
 	mockService := httphandler.NewMockMessageService()
 	handler := httphandler.NewMessageHandler(mockService)
@@ -668,6 +1052,20 @@ func TestToMessageResponse(t *testing.T) {
 		assert.Nil(t, resp.EditedAt)
 	})
 
+	t.Run("deleted message is a tombstone", func(t *testing.T) {
+		userID := uuid.NewUUID()
+		msg := createTestMessage(t, uuid.NewUUID(), userID, "Secret")
+		require.NoError(t, msg.AddReaction(uuid.NewUUID(), "👍"))
+		require.NoError(t, msg.Delete(userID))
+
+		resp := httphandler.ToMessageResponse(msg)
+
+		assert.True(t, resp.IsDeleted)
+		assert.Empty(t, resp.Content)
+		assert.Empty(t, resp.Reactions)
+		assert.Nil(t, resp.DeletedContent)
+	})
+
 	t.Run("reply message", func(t *testing.T) {
 		userID := uuid.NewUUID()
 		chatID := uuid.NewUUID()
@@ -891,6 +1289,40 @@ func TestMessageHandler_AddAttachment(t *testing.T) {
 		assert.Equal(t, stdhttp.StatusOK, rec.Code)
 	})
 
+	t.Run("voice message with duration and waveform", func(t *testing.T) {
+		e := echo.New()
+		userID := uuid.NewUUID()
+		fileID := uuid.NewUUID()
+
+		mockService := httphandler.NewMockMessageService()
+		handler := httphandler.NewMessageHandler(mockService)
+
+		testMessage := createTestMessage(t, uuid.NewUUID(), userID, "Voice message")
+		mockService.AddMessage(testMessage)
+
+		reqBody := `{"file_id":"` + fileID.String() + `","file_name":"voice.webm","file_size":4096,` +
+			`"mime_type":"audio/webm","duration_ms":2500,"waveform":[10,90]}`
+		req := httptest.NewRequest(stdhttp.MethodPost,
+			"/api/v1/messages/"+testMessage.ID().String()+"/attachments",
+			strings.NewReader(reqBody))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("id")
+		c.SetParamValues(testMessage.ID().String())
+
+		setupMessageAuthContext(c, userID)
+
+		err := handler.AddAttachment(c)
+		require.NoError(t, err)
+		assert.Equal(t, stdhttp.StatusOK, rec.Code)
+
+		resp := httphandler.ToMessageResponse(testMessage)
+		require.Len(t, resp.Attachments, 1)
+		assert.Equal(t, int64(2500), resp.Attachments[0].DurationMS)
+		assert.Equal(t, []int{10, 90}, resp.Attachments[0].Waveform)
+	})
+
 	t.Run("message not found", func(t *testing.T) {
 		e := echo.New()
 		userID := uuid.NewUUID()
@@ -981,3 +1413,128 @@ func TestMessageHandler_AddAttachment(t *testing.T) {
 		assert.Equal(t, stdhttp.StatusBadRequest, rec.Code)
 	})
 }
+
+// stubMessageStreamer streams a fixed list of messages and fails after failAfter of them.
+type stubMessageStreamer struct {
+	messages  []*message.Message
+	failAfter int
+}
+
+func (s *stubMessageStreamer) StreamByChatID(
+	_ context.Context,
+	_, after uuid.UUID,
+	fn func(*message.Message) error,
+) error {
+	start := 0
+	if !after.IsZero() {
+		start = -1
+		for i, msg := range s.messages {
+			if msg.ID() == after {
+				start = i + 1
+			}
+		}
+		if start < 0 {
+			return errs.ErrNotFound
+		}
+	}
+	for i, msg := range s.messages[start:] {
+		if s.failAfter > 0 && i == s.failAfter {
+			return errors.New("connection reset")
+		}
+		if err := fn(msg); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func TestMessageHandler_Export(t *testing.T) {
+	userID := uuid.NewUUID()
+	chatID := uuid.NewUUID()
+	messages := []*message.Message{
+		createTestMessage(t, chatID, userID, "first"),
+		createTestMessage(t, chatID, userID, "second"),
+		createTestMessage(t, chatID, userID, "third"),
+	}
+
+	export := func(t *testing.T, handler *httphandler.MessageHandler, query string) *httptest.ResponseRecorder {
+		t.Helper()
+		e := echo.New()
+		req := httptest.NewRequest(stdhttp.MethodGet, chatMessagesURL(chatID)+"/export"+query, nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("chat_id")
+		c.SetParamValues(chatID.String())
+		setupMessageAuthContext(c, userID)
+
+		require.NoError(t, handler.Export(c))
+		return rec
+	}
+	decodeLines := func(t *testing.T, rec *httptest.ResponseRecorder) []map[string]any {
+		t.Helper()
+		var lines []map[string]any
+		for line := range strings.SplitSeq(strings.TrimSuffix(rec.Body.String(), "\n"), "\n") {
+			var record map[string]any
+			require.NoError(t, json.Unmarshal([]byte(line), &record))
+			lines = append(lines, record)
+		}
+		return lines
+	}
+
+	t.Run("streams every message as a line", func(t *testing.T) {
+		handler := httphandler.NewMessageHandler(httphandler.NewMockMessageService(),
+			httphandler.WithMessageStreamer(&stubMessageStreamer{messages: messages}))
+
+		rec := export(t, handler, "")
+
+		assert.Equal(t, stdhttp.StatusOK, rec.Code)
+		assert.Equal(t, httpserver.MIMEApplicationNDJSON, rec.Header().Get(echo.HeaderContentType))
+		lines := decodeLines(t, rec)
+		require.Len(t, lines, 3)
+		assert.Equal(t, "first", lines[0]["content"])
+		assert.Equal(t, messages[2].ID().String(), lines[2]["id"])
+	})
+
+	t.Run("resumes after the cursor", func(t *testing.T) {
+		handler := httphandler.NewMessageHandler(httphandler.NewMockMessageService(),
+			httphandler.WithMessageStreamer(&stubMessageStreamer{messages: messages}))
+
+		rec := export(t, handler, "?after="+messages[0].ID().String())
+
+		lines := decodeLines(t, rec)
+		require.Len(t, lines, 2)
+		assert.Equal(t, "second", lines[0]["content"])
+	})
+
+	t.Run("unknown cursor", func(t *testing.T) {
+		handler := httphandler.NewMessageHandler(httphandler.NewMockMessageService(),
+			httphandler.WithMessageStreamer(&stubMessageStreamer{messages: messages}))
+
+		rec := export(t, handler, "?after="+uuid.NewUUID().String())
+
+		assert.Equal(t, stdhttp.StatusBadRequest, rec.Code)
+		assert.Contains(t, rec.Body.String(), "INVALID_CURSOR")
+	})
+
+	t.Run("failure mid-stream ends with an error trailer", func(t *testing.T) {
+		handler := httphandler.NewMessageHandler(httphandler.NewMockMessageService(),
+			httphandler.WithMessageStreamer(&stubMessageStreamer{messages: messages, failAfter: 1}))
+
+		rec := export(t, handler, "")
+
+		assert.Equal(t, stdhttp.StatusOK, rec.Code)
+		lines := decodeLines(t, rec)
+		require.Len(t, lines, 2)
+		assert.Equal(t, "first", lines[0]["content"])
+		assert.Equal(t, map[string]any{"code": "INTERNAL_ERROR", "message": "An internal error occurred"},
+			lines[1]["error"])
+	})
+
+	t.Run("not configured", func(t *testing.T) {
+		handler := httphandler.NewMessageHandler(httphandler.NewMockMessageService())
+
+		rec := export(t, handler, "")
+
+		assert.Equal(t, stdhttp.StatusServiceUnavailable, rec.Code)
+	})
+}
@@ -60,6 +60,49 @@ type MarkAllReadResponse struct {
 	MarkedCount int `json:"marked_count"`
 }
 
+// ReadStateResponse is the read state of one notification in a sync.
+type ReadStateResponse struct {
+	ID     string `json:"id"`
+	ReadAt string `json:"read_at"`
+}
+
+// ReadStateSyncResponse represents the read-state changes since a timestamp.
+type ReadStateSyncResponse struct {
+	Read        []ReadStateResponse `json:"read"`
+	UnreadCount int                 `json:"unread_count"`
+	// SyncedAt is the since value for the next sync.
+	SyncedAt string `json:"synced_at"`
+	// FullResync is set when there are too many changes to list; the client
+	// reloads its notifications instead.
+	FullResync bool `json:"full_resync"`
+}
+
+// DNDScheduleRequest is a do-not-disturb schedule; times are "HH:MM" in the
+// user's time zone and are both empty for no daily window.
+type DNDScheduleRequest struct {
+	Start    string `json:"start"`
+	End      string `json:"end"`
+	Weekends bool   `json:"weekends"`
+}
+
+// UpdateNotificationPreferencesRequest replaces the notification preferences of the user.
+type UpdateNotificationPreferencesRequest struct {
+	DND DNDScheduleRequest `json:"dnd"`
+}
+
+// DNDScheduleResponse represents a do-not-disturb schedule in API responses.
+type DNDScheduleResponse struct {
+	Enabled  bool   `json:"enabled"`
+	Start    string `json:"start,omitempty"`
+	End      string `json:"end,omitempty"`
+	Weekends bool   `json:"weekends"`
+}
+
+// NotificationPreferencesResponse represents the notification preferences of a user.
+type NotificationPreferencesResponse struct {
+	DND DNDScheduleResponse `json:"dnd"`
+}
+
 // NotificationService defines the interface for notification operations.
 // Declared on the consumer side per project guidelines.
 type NotificationService interface {
@@ -78,20 +121,52 @@ type NotificationService interface {
 	// DeleteNotification deletes a notification.
 	DeleteNotification(ctx context.Context, cmd notifapp.DeleteNotificationCommand) error
 
+	// SyncReadState returns the notifications read since a timestamp.
+	SyncReadState(ctx context.Context, query notifapp.SyncReadStateQuery) (notifapp.SyncReadStateResult, error)
+
 	// GetNotification gets a notification by ID.
 	GetNotification(ctx context.Context, notificationID uuid.UUID, userID uuid.UUID) (*notification.Notification, error)
 }
 
+// NotificationPreferencesService reads and replaces notification preferences.
+// Declared on the consumer side per project guidelines.
+type NotificationPreferencesService interface {
+	GetPreferences(ctx context.Context, query notifapp.GetPreferencesQuery) (notification.Preferences, error)
+	UpdatePreferences(
+		ctx context.Context,
+		cmd notifapp.UpdatePreferencesCommand,
+	) (notification.Preferences, error)
+}
+
 // NotificationHandler handles notification-related HTTP requests.
 type NotificationHandler struct {
 	notificationService NotificationService
+	preferences         NotificationPreferencesService
+	inbox               UnifiedInboxService
+}
+
+// NotificationHandlerOption configures NotificationHandler.
+type NotificationHandlerOption func(*NotificationHandler)
+
+// WithNotificationPreferences enables the notification preferences endpoints.
+func WithNotificationPreferences(preferences NotificationPreferencesService) NotificationHandlerOption {
+	return func(h *NotificationHandler) {
+		h.preferences = preferences
+	}
 }
 
 // NewNotificationHandler creates a new NotificationHandler.
-func NewNotificationHandler(notificationService NotificationService) *NotificationHandler {
-	return &NotificationHandler{
+func NewNotificationHandler(
+	notificationService NotificationService,
+	opts ...NotificationHandlerOption,
+) *NotificationHandler {
+	h := &NotificationHandler{
 		notificationService: notificationService,
 	}
+	for _, opt := range opts {
+		opt(h)
+	}
+	return h
 }
 
 // RegisterRoutes registers notification routes with the router.
@@ -99,6 +174,11 @@ func (h *NotificationHandler) RegisterRoutes(r *httpserver.Router) {
 	// All notification routes require authentication
 	r.Auth().GET("/notifications", h.List)
 	r.Auth().GET("/notifications/unread/count", h.UnreadCount)
+	r.Auth().GET("/notifications/sync", h.Sync)
+	r.Auth().GET("/notifications/inbox", h.Inbox)
+	r.Auth().POST("/notifications/inbox/read", h.MarkManyRead)
+	r.Auth().GET("/notifications/preferences", h.GetPreferences)
+	r.Auth().PUT("/notifications/preferences", h.UpdatePreferences)
 	r.Auth().PUT("/notifications/:id/read", h.MarkAsRead)
 	r.Auth().PUT("/notifications/mark-all-read", h.MarkAllRead)
 	r.Auth().DELETE("/notifications/:id", h.Delete)
@@ -142,7 +222,7 @@ func (h *NotificationHandler) List(c echo.Context) error {
 		HasMore:       hasMore,
 	}
 
-	return httpserver.RespondOK(c, resp)
+	return httpserver.RespondPage(c, resp, httpserver.NewPagination(offset, limit, result.TotalCount))
 }
 
 // UnreadCount handles GET /api/v1/notifications/unread/count.
@@ -169,6 +249,50 @@ func (h *NotificationHandler) UnreadCount(c echo.Context) error {
 	return httpserver.RespondOK(c, resp)
 }
 
+// Sync handles GET /api/v1/notifications/sync?since=<RFC3339>.
+// Returns the notifications read since the timestamp, so a device that was
+// offline catches up with what the user read on other devices.
+func (h *NotificationHandler) Sync(c echo.Context) error {
+	userID := middleware.GetUserID(c)
+	if userID.IsZero() {
+		return httpserver.RespondErrorWithCode(c, http.StatusUnauthorized, "UNAUTHORIZED", "authentication required")
+	}
+
+	since, parseErr := time.Parse(time.RFC3339Nano, c.QueryParam("since"))
+	if parseErr != nil {
+		return httpserver.RespondErrorWithCode(
+			c, http.StatusBadRequest, "INVALID_SINCE", "since must be an RFC 3339 timestamp")
+	}
+
+	query := notifapp.SyncReadStateQuery{
+		UserID: userID,
+		Since:  since,
+	}
+
+	result, err := h.notificationService.SyncReadState(c.Request().Context(), query)
+	if err != nil {
+		return handleNotificationError(c, err)
+	}
+
+	resp := ReadStateSyncResponse{
+		Read:        make([]ReadStateResponse, 0, len(result.Read)),
+		UnreadCount: result.UnreadCount,
+		SyncedAt:    result.SyncedAt.Format(time.RFC3339Nano),
+		FullResync:  result.FullResync,
+	}
+	for _, n := range result.Read {
+		if n.ReadAt() == nil {
+			continue
+		}
+		resp.Read = append(resp.Read, ReadStateResponse{
+			ID:     n.ID().String(),
+			ReadAt: n.ReadAt().Format(time.RFC3339Nano),
+		})
+	}
+
+	return httpserver.RespondOK(c, resp)
+}
+
 // MarkAsRead handles PUT /api/v1/notifications/:id/read.
 // Marks a notification as read.
 func (h *NotificationHandler) MarkAsRead(c echo.Context) error {
@@ -274,10 +398,61 @@ func parseNotificationPagination(c echo.Context) (int, int) {
 			offset = (page - 1) * limit
 		}
 	}
+	if o, ok := httpserver.CursorOffset(c); ok {
+		offset = o
+	}
 
 	return limit, offset
 }
 
+// GetPreferences handles GET /api/v1/notifications/preferences.
+func (h *NotificationHandler) GetPreferences(c echo.Context) error {
+	userID := middleware.GetUserID(c)
+	if userID.IsZero() {
+		return httpserver.RespondErrorWithCode(c, http.StatusUnauthorized, "UNAUTHORIZED", "authentication required")
+	}
+	if h.preferences == nil {
+		return httpserver.RespondErrorWithCode(
+			c, http.StatusServiceUnavailable, "SERVICE_UNAVAILABLE", "notification preferences are not available")
+	}
+
+	prefs, err := h.preferences.GetPreferences(c.Request().Context(), notifapp.GetPreferencesQuery{UserID: userID})
+	if err != nil {
+		return handleNotificationError(c, err)
+	}
+	return httpserver.RespondOK(c, ToNotificationPreferencesResponse(prefs))
+}
+
+// UpdatePreferences handles PUT /api/v1/notifications/preferences.
+// During the do-not-disturb window notifications are still listed in-app, but
+// not emailed; mentions and assignments are emailed once the window ends.
+func (h *NotificationHandler) UpdatePreferences(c echo.Context) error {
+	userID := middleware.GetUserID(c)
+	if userID.IsZero() {
+		return httpserver.RespondErrorWithCode(c, http.StatusUnauthorized, "UNAUTHORIZED", "authentication required")
+	}
+	if h.preferences == nil {
+		return httpserver.RespondErrorWithCode(
+			c, http.StatusServiceUnavailable, "SERVICE_UNAVAILABLE", "notification preferences are not available")
+	}
+
+	var req UpdateNotificationPreferencesRequest
+	if err := c.Bind(&req); err != nil {
+		return httpserver.RespondErrorWithCode(c, http.StatusBadRequest, "INVALID_REQUEST", "Invalid request body")
+	}
+
+	prefs, err := h.preferences.UpdatePreferences(c.Request().Context(), notifapp.UpdatePreferencesCommand{
+		UserID:      userID,
+		DNDStart:    req.DND.Start,
+		DNDEnd:      req.DND.End,
+		DNDWeekends: req.DND.Weekends,
+	})
+	if err != nil {
+		return handleNotificationError(c, err)
+	}
+	return httpserver.RespondOK(c, ToNotificationPreferencesResponse(prefs))
+}
+
 func handleNotificationError(c echo.Context, err error) error {
 	switch {
 	case errors.Is(err, notifapp.ErrNotificationNotFound):
@@ -294,6 +469,18 @@ func handleNotificationError(c echo.Context, err error) error {
 	}
 }
 
+// ToNotificationPreferencesResponse converts notification preferences to their response.
+func ToNotificationPreferencesResponse(prefs notification.Preferences) NotificationPreferencesResponse {
+	return NotificationPreferencesResponse{
+		DND: DNDScheduleResponse{
+			Enabled:  prefs.DND.Enabled(),
+			Start:    prefs.DND.StartClock(),
+			End:      prefs.DND.EndClock(),
+			Weekends: prefs.DND.Weekends,
+		},
+	}
+}
+
 // ToNotificationResponse converts a domain Notification to NotificationResponse.
 func ToNotificationResponse(n *notification.Notification) NotificationResponse {
 	resp := NotificationResponse{
@@ -322,9 +509,13 @@ func ToNotificationResponse(n *notification.Notification) NotificationResponse {
 // generateNotificationLink generates a link based on notification type.
 func generateNotificationLink(notifType notification.Type, resourceID string) string {
 	switch notifType {
-	case notification.TypeTaskStatusChanged, notification.TypeTaskAssigned, notification.TypeTaskCreated:
+	case notification.TypeTaskStatusChanged, notification.TypeTaskAssigned, notification.TypeTaskCreated,
+		notification.TypeTaskSLABreached:
 		return "/tasks/" + resourceID
-	case notification.TypeChatMention, notification.TypeChatMessage:
+	case notification.TypeChatMention:
+		// mentions reference the message, resolved by the message permalink
+		return "/messages/" + resourceID
+	case notification.TypeChatMessage:
 		return "/chats/" + resourceID
 	case notification.TypeWorkspaceInvite:
 		return "/workspaces/" + resourceID
@@ -489,3 +680,20 @@ func (m *MockNotificationService) GetNotification(
 
 	return n, nil
 }
+
+// SyncReadState returns the notifications read since the timestamp in the mock service.
+func (m *MockNotificationService) SyncReadState(
+	_ context.Context,
+	query notifapp.SyncReadStateQuery,
+) (notifapp.SyncReadStateResult, error) {
+	result := notifapp.SyncReadStateResult{SyncedAt: time.Now()}
+	for _, n := range m.userNotifs[query.UserID] {
+		switch {
+		case !n.IsRead():
+			result.UnreadCount++
+		case !n.ReadAt().Before(query.Since):
+			result.Read = append(result.Read, n)
+		}
+	}
+	return result, nil
+}
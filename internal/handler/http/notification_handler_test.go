@@ -5,10 +5,13 @@ import (
 	"encoding/json"
 	stdhttp "net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/labstack/echo/v4"
 	notifapp "github.com/lllypuk/flowra/internal/application/notification"
+	"github.com/lllypuk/flowra/internal/domain/errs"
 	"github.com/lllypuk/flowra/internal/domain/notification"
 	"github.com/lllypuk/flowra/internal/domain/uuid"
 	httphandler "github.com/lllypuk/flowra/internal/handler/http"
@@ -92,6 +95,48 @@ func TestNotificationHandler_List(t *testing.T) {
 		assert.Equal(t, stdhttp.StatusOK, rec.Code)
 	})
 
+	t.Run("cursor round trip", func(t *testing.T) {
+		userID := uuid.NewUUID()
+
+		mockService := httphandler.NewMockNotificationService()
+		for range 3 {
+			mockService.AddNotification(createTestNotification(t, userID))
+		}
+		handler := httphandler.NewNotificationHandler(mockService)
+
+		list := func(t *testing.T, query string) (httphandler.NotificationListResponse, *httpserver.Pagination) {
+			t.Helper()
+			req := httptest.NewRequest(stdhttp.MethodGet, "/api/v1/notifications"+query, nil)
+			rec := httptest.NewRecorder()
+			c := echo.New().NewContext(req, rec)
+			setupNotificationAuthContext(c, userID)
+
+			require.NoError(t, handler.List(c))
+			require.Equal(t, stdhttp.StatusOK, rec.Code)
+			return decodeListPage[httphandler.NotificationListResponse](t, rec)
+		}
+
+		first, firstPage := list(t, "?limit=2")
+		require.Len(t, first.Notifications, 2)
+		require.NotNil(t, firstPage.Total)
+		assert.Equal(t, 3, *firstPage.Total)
+		assert.True(t, firstPage.HasMore)
+		require.Equal(t, httpserver.EncodeOffsetCursor(2), firstPage.NextCursor)
+
+		second, secondPage := list(t, "?limit=2&cursor="+firstPage.NextCursor)
+		require.Len(t, second.Notifications, 1)
+		assert.Equal(t, 2, secondPage.Offset)
+		assert.False(t, secondPage.HasMore)
+		assert.Empty(t, secondPage.NextCursor)
+		assert.Equal(t, httpserver.EncodeOffsetCursor(0), secondPage.PrevCursor)
+		for _, n := range first.Notifications {
+			assert.NotEqual(t, n.ID, second.Notifications[0].ID)
+		}
+
+		back, _ := list(t, "?limit=2&cursor="+secondPage.PrevCursor)
+		assert.Equal(t, first.Notifications, back.Notifications)
+	})
+
 	t.Run("list with pagination", func(t *testing.T) {
 		e := echo.New()
 		userID := uuid.NewUUID()
@@ -422,6 +467,75 @@ func TestNotificationHandler_MarkAllRead(t *testing.T) {
 	})
 }
 
+func TestNotificationHandler_Sync(t *testing.T) {
+	t.Run("returns notifications read since the timestamp", func(t *testing.T) {
+		e := echo.New()
+		userID := uuid.NewUUID()
+		since := time.Now().Add(-time.Minute)
+
+		mockService := httphandler.NewMockNotificationService()
+		readNotif := createTestNotification(t, userID)
+		require.NoError(t, readNotif.MarkAsRead())
+		mockService.AddNotification(readNotif)
+		mockService.AddNotification(createTestNotification(t, userID))
+
+		handler := httphandler.NewNotificationHandler(mockService)
+
+		req := httptest.NewRequest(stdhttp.MethodGet,
+			"/api/v1/notifications/sync?since="+since.UTC().Format(time.RFC3339Nano), nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+
+		setupNotificationAuthContext(c, userID)
+
+		err := handler.Sync(c)
+		require.NoError(t, err)
+		assert.Equal(t, stdhttp.StatusOK, rec.Code)
+
+		var resp struct {
+			Data httphandler.ReadStateSyncResponse `json:"data"`
+		}
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+		require.Len(t, resp.Data.Read, 1)
+		assert.Equal(t, readNotif.ID().String(), resp.Data.Read[0].ID)
+		assert.NotEmpty(t, resp.Data.Read[0].ReadAt)
+		assert.Equal(t, 1, resp.Data.UnreadCount)
+		assert.NotEmpty(t, resp.Data.SyncedAt)
+		assert.False(t, resp.Data.FullResync)
+	})
+
+	t.Run("invalid since", func(t *testing.T) {
+		e := echo.New()
+		handler := httphandler.NewNotificationHandler(httphandler.NewMockNotificationService())
+
+		for _, target := range []string{"/api/v1/notifications/sync", "/api/v1/notifications/sync?since=yesterday"} {
+			req := httptest.NewRequest(stdhttp.MethodGet, target, nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			setupNotificationAuthContext(c, uuid.NewUUID())
+
+			err := handler.Sync(c)
+			require.NoError(t, err)
+			assert.Equal(t, stdhttp.StatusBadRequest, rec.Code)
+			assert.Contains(t, rec.Body.String(), "INVALID_SINCE")
+		}
+	})
+
+	t.Run("missing auth", func(t *testing.T) {
+		e := echo.New()
+		handler := httphandler.NewNotificationHandler(httphandler.NewMockNotificationService())
+
+		req := httptest.NewRequest(stdhttp.MethodGet, "/api/v1/notifications/sync?since=2026-01-01T00:00:00Z", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+
+		err := handler.Sync(c)
+		require.NoError(t, err)
+		assert.Equal(t, stdhttp.StatusUnauthorized, rec.Code)
+	})
+}
+
 func TestNotificationHandler_Delete(t *testing.T) {
 	t.Run("successful delete notification", func(t *testing.T) {
 		e := echo.New()
@@ -629,3 +743,80 @@ func TestMockNotificationService(t *testing.T) {
 		assert.ErrorIs(t, err, notifapp.ErrNotificationNotFound)
 	})
 }
+
+// memoryPreferences keeps notification preferences in memory.
+type memoryPreferences map[uuid.UUID]notification.Preferences
+
+func (m memoryPreferences) FindPreferences(_ context.Context, userID uuid.UUID) (notification.Preferences, error) {
+	prefs, ok := m[userID]
+	if !ok {
+		return notification.Preferences{}, errs.ErrNotFound
+	}
+	return prefs, nil
+}
+
+func (m memoryPreferences) SavePreferences(_ context.Context, userID uuid.UUID, prefs notification.Preferences) error {
+	m[userID] = prefs
+	return nil
+}
+
+type preferencesService struct {
+	*notifapp.GetPreferencesUseCase
+	update *notifapp.UpdatePreferencesUseCase
+}
+
+func (s preferencesService) GetPreferences(
+	ctx context.Context,
+	query notifapp.GetPreferencesQuery,
+) (notification.Preferences, error) {
+	return s.Execute(ctx, query)
+}
+
+func (s preferencesService) UpdatePreferences(
+	ctx context.Context,
+	cmd notifapp.UpdatePreferencesCommand,
+) (notification.Preferences, error) {
+	return s.update.Execute(ctx, cmd)
+}
+
+func TestNotificationHandler_Preferences(t *testing.T) {
+	repo := memoryPreferences{}
+	handler := httphandler.NewNotificationHandler(httphandler.NewMockNotificationService(),
+		httphandler.WithNotificationPreferences(preferencesService{
+			GetPreferencesUseCase: notifapp.NewGetPreferencesUseCase(repo),
+			update:                notifapp.NewUpdatePreferencesUseCase(repo),
+		}))
+	userID := uuid.NewUUID()
+
+	call := func(method, body string, fn func(echo.Context) error) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, "/api/v1/notifications/preferences", strings.NewReader(body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := echo.New().NewContext(req, rec)
+		setupNotificationAuthContext(c, userID)
+		require.NoError(t, fn(c))
+		return rec
+	}
+	decode := func(rec *httptest.ResponseRecorder) httphandler.NotificationPreferencesResponse {
+		var resp struct {
+			Data httphandler.NotificationPreferencesResponse `json:"data"`
+		}
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+		return resp.Data
+	}
+
+	rec := call(stdhttp.MethodGet, "", handler.GetPreferences)
+	assert.Equal(t, stdhttp.StatusOK, rec.Code)
+	assert.False(t, decode(rec).DND.Enabled)
+
+	rec = call(stdhttp.MethodPut, `{"dnd":{"start":"22:00","end":"08:00","weekends":true}}`, handler.UpdatePreferences)
+	assert.Equal(t, stdhttp.StatusOK, rec.Code)
+	assert.Equal(t, httphandler.DNDScheduleResponse{Enabled: true, Start: "22:00", End: "08:00", Weekends: true},
+		decode(rec).DND)
+
+	rec = call(stdhttp.MethodPut, `{"dnd":{"start":"22:00"}}`, handler.UpdatePreferences)
+	assert.Equal(t, stdhttp.StatusBadRequest, rec.Code)
+
+	rec = call(stdhttp.MethodGet, "", handler.GetPreferences)
+	assert.Equal(t, "22:00", decode(rec).DND.Start, "rejected updates keep the saved schedule")
+}
@@ -0,0 +1,176 @@
+package httphandler
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/lllypuk/flowra/internal/application/appcore"
+	notifapp "github.com/lllypuk/flowra/internal/application/notification"
+	"github.com/lllypuk/flowra/internal/domain/uuid"
+	"github.com/lllypuk/flowra/internal/infrastructure/httpserver"
+	"github.com/lllypuk/flowra/internal/middleware"
+)
+
+// UnifiedInboxService lists and marks notifications across all workspaces of the user.
+// Declared on the consumer side per project guidelines.
+type UnifiedInboxService interface {
+	UnifiedInbox(ctx context.Context, query notifapp.UnifiedInboxQuery) (notifapp.UnifiedInboxResult, error)
+	MarkManyAsRead(ctx context.Context, cmd notifapp.MarkManyAsReadCommand) (notifapp.CountResult, error)
+}
+
+// InboxNotificationResponse represents a notification of the unified inbox.
+type InboxNotificationResponse struct {
+	NotificationResponse
+
+	WorkspaceID   string `json:"workspace_id,omitempty"`
+	WorkspaceName string `json:"workspace_name,omitempty"`
+}
+
+// WorkspaceFacetResponse represents the notification counts of one workspace.
+// Notifications that belong to none of the user's workspaces have no workspace_id.
+type WorkspaceFacetResponse struct {
+	WorkspaceID string `json:"workspace_id,omitempty"`
+	Name        string `json:"name,omitempty"`
+	Total       int    `json:"total"`
+	Unread      int    `json:"unread"`
+}
+
+// UnifiedInboxResponse represents the unified notification inbox.
+type UnifiedInboxResponse struct {
+	Notifications []InboxNotificationResponse `json:"notifications"`
+	Facets        []WorkspaceFacetResponse    `json:"facets"`
+	UnreadCount   int                         `json:"unread_count"`
+	Total         int                         `json:"total"`
+	HasMore       bool                        `json:"has_more"`
+}
+
+// MarkManyReadRequest marks notifications as read by ID or all unread of a workspace.
+type MarkManyReadRequest struct {
+	IDs         []string `json:"ids"`
+	WorkspaceID string   `json:"workspace_id"`
+}
+
+// WithUnifiedInbox enables the unified inbox endpoints.
+func WithUnifiedInbox(inbox UnifiedInboxService) NotificationHandlerOption {
+	return func(h *NotificationHandler) {
+		h.inbox = inbox
+	}
+}
+
+// Inbox handles GET /api/v1/notifications/inbox.
+// Lists notifications across all workspaces of the user with per-workspace counts.
+func (h *NotificationHandler) Inbox(c echo.Context) error {
+	userID := middleware.GetUserID(c)
+	if userID.IsZero() {
+		return httpserver.RespondErrorWithCode(c, http.StatusUnauthorized, "UNAUTHORIZED", "authentication required")
+	}
+	if h.inbox == nil {
+		return httpserver.RespondErrorWithCode(
+			c, http.StatusServiceUnavailable, "SERVICE_UNAVAILABLE", "the notification inbox is not available")
+	}
+
+	var workspaceID uuid.UUID
+	if raw := c.QueryParam("workspace_id"); raw != "" {
+		parsed, err := uuid.ParseUUID(raw)
+		if err != nil {
+			return httpserver.RespondErrorWithCode(
+				c, http.StatusBadRequest, "INVALID_WORKSPACE_ID", "invalid workspace ID format")
+		}
+		workspaceID = parsed
+	}
+	limit, offset := parseNotificationPagination(c)
+
+	result, err := h.inbox.UnifiedInbox(c.Request().Context(), notifapp.UnifiedInboxQuery{
+		UserID:      userID,
+		WorkspaceID: workspaceID,
+		UnreadOnly:  c.QueryParam("unread_only") == queryParamTrue,
+		Limit:       limit,
+		Offset:      offset,
+	})
+	if err != nil {
+		return handleNotificationInboxError(c, err)
+	}
+
+	resp := ToUnifiedInboxResponse(result)
+	return httpserver.RespondPage(c, resp, httpserver.NewPagination(result.Offset, result.Limit, result.TotalCount))
+}
+
+// MarkManyRead handles POST /api/v1/notifications/inbox/read.
+// Marks up to 100 notifications, or all unread ones of a workspace, as read.
+func (h *NotificationHandler) MarkManyRead(c echo.Context) error {
+	userID := middleware.GetUserID(c)
+	if userID.IsZero() {
+		return httpserver.RespondErrorWithCode(c, http.StatusUnauthorized, "UNAUTHORIZED", "authentication required")
+	}
+	if h.inbox == nil {
+		return httpserver.RespondErrorWithCode(
+			c, http.StatusServiceUnavailable, "SERVICE_UNAVAILABLE", "the notification inbox is not available")
+	}
+
+	var req MarkManyReadRequest
+	if err := c.Bind(&req); err != nil {
+		return httpserver.RespondErrorWithCode(c, http.StatusBadRequest, "INVALID_REQUEST", "Invalid request body")
+	}
+
+	cmd := notifapp.MarkManyAsReadCommand{UserID: userID}
+	if req.WorkspaceID != "" {
+		workspaceID, err := uuid.ParseUUID(req.WorkspaceID)
+		if err != nil {
+			return httpserver.RespondErrorWithCode(
+				c, http.StatusBadRequest, "INVALID_WORKSPACE_ID", "invalid workspace ID format")
+		}
+		cmd.WorkspaceID = workspaceID
+	}
+	for _, raw := range req.IDs {
+		id, err := uuid.ParseUUID(raw)
+		if err != nil {
+			return httpserver.RespondErrorWithCode(
+				c, http.StatusBadRequest, "INVALID_NOTIFICATION_ID", "invalid notification ID format")
+		}
+		cmd.NotificationIDs = append(cmd.NotificationIDs, id)
+	}
+
+	result, err := h.inbox.MarkManyAsRead(c.Request().Context(), cmd)
+	if err != nil {
+		return handleNotificationInboxError(c, err)
+	}
+	return httpserver.RespondOK(c, MarkAllReadResponse{MarkedCount: result.Count})
+}
+
+// handleNotificationInboxError maps unified inbox errors to HTTP responses.
+func handleNotificationInboxError(c echo.Context, err error) error {
+	var validationErr *appcore.ValidationError
+	if errors.As(err, &validationErr) {
+		return httpserver.RespondErrorWithCode(c, http.StatusBadRequest, "VALIDATION_ERROR", validationErr.Error())
+	}
+	return handleNotificationError(c, err)
+}
+
+// ToUnifiedInboxResponse converts a unified inbox result to its API representation.
+func ToUnifiedInboxResponse(result notifapp.UnifiedInboxResult) UnifiedInboxResponse {
+	resp := UnifiedInboxResponse{
+		Notifications: make([]InboxNotificationResponse, 0, len(result.Entries)),
+		Facets:        make([]WorkspaceFacetResponse, 0, len(result.Facets)),
+		UnreadCount:   result.UnreadCount,
+		Total:         result.TotalCount,
+		HasMore:       result.Offset+len(result.Entries) < result.TotalCount,
+	}
+	for _, entry := range result.Entries {
+		resp.Notifications = append(resp.Notifications, InboxNotificationResponse{
+			NotificationResponse: ToNotificationResponse(entry.Notification),
+			WorkspaceID:          entry.WorkspaceID.String(),
+			WorkspaceName:        entry.WorkspaceName,
+		})
+	}
+	for _, facet := range result.Facets {
+		resp.Facets = append(resp.Facets, WorkspaceFacetResponse{
+			WorkspaceID: facet.WorkspaceID.String(),
+			Name:        facet.Name,
+			Total:       facet.Total,
+			Unread:      facet.Unread,
+		})
+	}
+	return resp
+}
@@ -0,0 +1,221 @@
+package httphandler_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	stdhttp "net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/lllypuk/flowra/internal/application/appcore"
+	notifapp "github.com/lllypuk/flowra/internal/application/notification"
+	"github.com/lllypuk/flowra/internal/domain/uuid"
+	httphandler "github.com/lllypuk/flowra/internal/handler/http"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeUnifiedInbox struct {
+	result   notifapp.UnifiedInboxResult
+	queries  []notifapp.UnifiedInboxQuery
+	commands []notifapp.MarkManyAsReadCommand
+}
+
+func (f *fakeUnifiedInbox) UnifiedInbox(
+	_ context.Context,
+	query notifapp.UnifiedInboxQuery,
+) (notifapp.UnifiedInboxResult, error) {
+	f.queries = append(f.queries, query)
+	return f.result, nil
+}
+
+func (f *fakeUnifiedInbox) MarkManyAsRead(
+	_ context.Context,
+	cmd notifapp.MarkManyAsReadCommand,
+) (notifapp.CountResult, error) {
+	f.commands = append(f.commands, cmd)
+	if cmd.WorkspaceID.IsZero() == (len(cmd.NotificationIDs) == 0) {
+		return notifapp.CountResult{}, fmt.Errorf("validation failed: %w",
+			appcore.NewValidationError("ids", "either notification IDs or a workspace is required"))
+	}
+	return notifapp.CountResult{Count: len(cmd.NotificationIDs) + 1}, nil
+}
+
+func newNotificationInboxContext(
+	method, target, body string,
+	userID uuid.UUID,
+) (echo.Context, *httptest.ResponseRecorder) {
+	req := httptest.NewRequest(method, target, strings.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := echo.New().NewContext(req, rec)
+	setupNotificationAuthContext(c, userID)
+	return c, rec
+}
+
+func TestNotificationHandler_Inbox(t *testing.T) {
+	t.Run("lists notifications with workspace facets", func(t *testing.T) {
+		userID := uuid.NewUUID()
+		workspaceID := uuid.NewUUID()
+		notif := createTestNotification(t, userID)
+		inbox := &fakeUnifiedInbox{result: notifapp.UnifiedInboxResult{
+			Entries: []notifapp.InboxEntry{
+				{Notification: notif, WorkspaceID: workspaceID, WorkspaceName: "Alpha"},
+			},
+			Facets: []notifapp.WorkspaceFacet{
+				{WorkspaceID: workspaceID, Name: "Alpha", Total: 3, Unread: 2},
+				{Total: 1, Unread: 1},
+			},
+			TotalCount:  3,
+			UnreadCount: 3,
+			Limit:       1,
+		}}
+		handler := httphandler.NewNotificationHandler(
+			httphandler.NewMockNotificationService(), httphandler.WithUnifiedInbox(inbox))
+
+		target := "/api/v1/notifications/inbox?unread_only=true&limit=1&workspace_id=" + workspaceID.String()
+		c, rec := newNotificationInboxContext(stdhttp.MethodGet, target, "", userID)
+		require.NoError(t, handler.Inbox(c))
+
+		require.Equal(t, stdhttp.StatusOK, rec.Code)
+		require.Len(t, inbox.queries, 1)
+		assert.Equal(t, workspaceID, inbox.queries[0].WorkspaceID)
+		assert.True(t, inbox.queries[0].UnreadOnly)
+		assert.Equal(t, 1, inbox.queries[0].Limit)
+
+		var resp struct {
+			Data httphandler.UnifiedInboxResponse `json:"data"`
+		}
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+		require.Len(t, resp.Data.Notifications, 1)
+		assert.Equal(t, notif.ID().String(), resp.Data.Notifications[0].ID)
+		assert.Equal(t, "Alpha", resp.Data.Notifications[0].WorkspaceName)
+		assert.Equal(t, []httphandler.WorkspaceFacetResponse{
+			{WorkspaceID: workspaceID.String(), Name: "Alpha", Total: 3, Unread: 2},
+			{Total: 1, Unread: 1},
+		}, resp.Data.Facets)
+		assert.Equal(t, 3, resp.Data.UnreadCount)
+		assert.True(t, resp.Data.HasMore)
+	})
+
+	t.Run("rejects an invalid workspace ID", func(t *testing.T) {
+		handler := httphandler.NewNotificationHandler(
+			httphandler.NewMockNotificationService(), httphandler.WithUnifiedInbox(&fakeUnifiedInbox{}))
+
+		c, rec := newNotificationInboxContext(
+			stdhttp.MethodGet, "/api/v1/notifications/inbox?workspace_id=nope", "", uuid.NewUUID())
+		require.NoError(t, handler.Inbox(c))
+
+		assert.Equal(t, stdhttp.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("unavailable without the inbox service", func(t *testing.T) {
+		handler := httphandler.NewNotificationHandler(httphandler.NewMockNotificationService())
+
+		c, rec := newNotificationInboxContext(stdhttp.MethodGet, "/api/v1/notifications/inbox", "", uuid.NewUUID())
+		require.NoError(t, handler.Inbox(c))
+
+		assert.Equal(t, stdhttp.StatusServiceUnavailable, rec.Code)
+	})
+}
+
+func TestNotificationHandler_MarkManyRead(t *testing.T) {
+	t.Run("marks notifications by ID", func(t *testing.T) {
+		userID := uuid.NewUUID()
+		id := uuid.NewUUID()
+		inbox := &fakeUnifiedInbox{}
+		handler := httphandler.NewNotificationHandler(
+			httphandler.NewMockNotificationService(), httphandler.WithUnifiedInbox(inbox))
+
+		c, rec := newNotificationInboxContext(
+			stdhttp.MethodPost, "/api/v1/notifications/inbox/read", `{"ids":["`+id.String()+`"]}`, userID)
+		require.NoError(t, handler.MarkManyRead(c))
+
+		require.Equal(t, stdhttp.StatusOK, rec.Code)
+		require.Len(t, inbox.commands, 1)
+		assert.Equal(t, userID, inbox.commands[0].UserID)
+		assert.Equal(t, []uuid.UUID{id}, inbox.commands[0].NotificationIDs)
+		assert.Contains(t, rec.Body.String(), `"marked_count":2`)
+	})
+
+	t.Run("marks a workspace", func(t *testing.T) {
+		workspaceID := uuid.NewUUID()
+		inbox := &fakeUnifiedInbox{}
+		handler := httphandler.NewNotificationHandler(
+			httphandler.NewMockNotificationService(), httphandler.WithUnifiedInbox(inbox))
+
+		c, rec := newNotificationInboxContext(stdhttp.MethodPost, "/api/v1/notifications/inbox/read",
+			`{"workspace_id":"`+workspaceID.String()+`"}`, uuid.NewUUID())
+		require.NoError(t, handler.MarkManyRead(c))
+
+		require.Equal(t, stdhttp.StatusOK, rec.Code)
+		require.Len(t, inbox.commands, 1)
+		assert.Equal(t, workspaceID, inbox.commands[0].WorkspaceID)
+	})
+
+	t.Run("maps validation errors to bad request", func(t *testing.T) {
+		handler := httphandler.NewNotificationHandler(
+			httphandler.NewMockNotificationService(), httphandler.WithUnifiedInbox(&fakeUnifiedInbox{}))
+
+		c, rec := newNotificationInboxContext(
+			stdhttp.MethodPost, "/api/v1/notifications/inbox/read", `{}`, uuid.NewUUID())
+		require.NoError(t, handler.MarkManyRead(c))
+
+		assert.Equal(t, stdhttp.StatusBadRequest, rec.Code)
+		assert.Contains(t, rec.Body.String(), "VALIDATION_ERROR")
+	})
+
+	t.Run("rejects an invalid notification ID", func(t *testing.T) {
+		inbox := &fakeUnifiedInbox{}
+		handler := httphandler.NewNotificationHandler(
+			httphandler.NewMockNotificationService(), httphandler.WithUnifiedInbox(inbox))
+
+		c, rec := newNotificationInboxContext(
+			stdhttp.MethodPost, "/api/v1/notifications/inbox/read", `{"ids":["nope"]}`, uuid.NewUUID())
+		require.NoError(t, handler.MarkManyRead(c))
+
+		assert.Equal(t, stdhttp.StatusBadRequest, rec.Code)
+		assert.Empty(t, inbox.commands)
+	})
+}
+
+func TestNotificationTemplateHandler_Inbox(t *testing.T) {
+	t.Run("renders entries with their workspace", func(t *testing.T) {
+		userID := uuid.NewUUID()
+		workspaceID := uuid.NewUUID()
+		inbox := &fakeUnifiedInbox{result: notifapp.UnifiedInboxResult{
+			Entries: []notifapp.InboxEntry{
+				{Notification: createTestNotification(t, userID), WorkspaceID: workspaceID, WorkspaceName: "Alpha"},
+			},
+			TotalCount: 2,
+		}}
+		handler := httphandler.NewNotificationTemplateHandler(newTestRenderer(t), nil, nil)
+		handler.SetUnifiedInbox(inbox)
+
+		c, rec := newNotificationInboxContext(stdhttp.MethodGet,
+			"/partials/notifications/inbox?filter=unread&workspace_id="+workspaceID.String(), "", userID)
+		require.NoError(t, handler.InboxListPartial(c))
+
+		require.Equal(t, stdhttp.StatusOK, rec.Code)
+		require.Len(t, inbox.queries, 1)
+		assert.Equal(t, workspaceID, inbox.queries[0].WorkspaceID)
+		assert.True(t, inbox.queries[0].UnreadOnly)
+		body := rec.Body.String()
+		assert.Contains(t, body, "Alpha")
+		assert.Contains(t, body, "Task Assigned")
+		assert.Contains(t, body, `hx-get="/partials/notifications/inbox"`)
+	})
+
+	t.Run("falls back to notifications without the inbox service", func(t *testing.T) {
+		handler := httphandler.NewNotificationTemplateHandler(nil, nil, nil)
+
+		c, rec := newNotificationInboxContext(stdhttp.MethodGet, "/notifications/inbox", "", uuid.NewUUID())
+		require.NoError(t, handler.InboxPage(c))
+
+		assert.Equal(t, stdhttp.StatusFound, rec.Code)
+		assert.Equal(t, "/notifications", rec.Header().Get("Location"))
+	})
+}
@@ -62,11 +62,40 @@ type NotificationListData struct {
 	Filter        string
 }
 
+// InboxNotificationViewData represents a notification of the unified inbox for templates.
+type InboxNotificationViewData struct {
+	NotificationViewData
+
+	WorkspaceName string
+}
+
+// InboxFacetViewData represents the notification counts of one workspace for templates.
+type InboxFacetViewData struct {
+	WorkspaceID string
+	Name        string
+	Total       int
+	Unread      int
+	Selected    bool
+}
+
+// InboxListData represents data for the unified inbox template.
+type InboxListData struct {
+	Notifications []InboxNotificationViewData
+	Facets        []InboxFacetViewData
+	TotalCount    int
+	UnreadCount   int
+	HasMore       bool
+	NextOffset    int
+	WorkspaceID   string
+	Filter        string
+}
+
 // NotificationTemplateHandler provides handlers for rendering notification HTML pages.
 type NotificationTemplateHandler struct {
 	renderer            *TemplateRenderer
 	logger              *slog.Logger
 	notificationService NotificationTemplateService
+	inbox               UnifiedInboxService
 }
 
 // NewNotificationTemplateHandler creates a new notification template handler.
@@ -85,10 +114,16 @@ func NewNotificationTemplateHandler(
 	}
 }
 
+// SetUnifiedInbox enables the cross-workspace inbox page.
+func (h *NotificationTemplateHandler) SetUnifiedInbox(inbox UnifiedInboxService) {
+	h.inbox = inbox
+}
+
 // SetupNotificationRoutes registers notification-related page and partial routes.
 func (h *NotificationTemplateHandler) SetupNotificationRoutes(e *echo.Echo) {
 	// Notification pages (protected)
 	e.GET("/notifications", h.NotificationsPage, RequireAuth)
+	e.GET("/notifications/inbox", h.InboxPage, RequireAuth)
 	e.GET("/notifications/:id/redirect", h.NotificationRedirect, RequireAuth)
 
 	// Notification partials (protected)
@@ -96,6 +131,7 @@ func (h *NotificationTemplateHandler) SetupNotificationRoutes(e *echo.Echo) {
 	partials.GET("/notifications", h.NotificationsDropdownPartial)
 	partials.GET("/notifications/count", h.NotificationCountPartial)
 	partials.GET("/notifications/list", h.NotificationsListPartial)
+	partials.GET("/notifications/inbox", h.InboxListPartial)
 }
 
 // NotificationsPage renders the full notifications page.
@@ -287,10 +323,101 @@ func (h *NotificationTemplateHandler) NotificationRedirect(c echo.Context) error
 	return c.Redirect(http.StatusFound, link)
 }
 
+// InboxPage renders the cross-workspace notification inbox with workspace facets.
+func (h *NotificationTemplateHandler) InboxPage(c echo.Context) error {
+	userID := middleware.GetUserID(c)
+	if userID.IsZero() {
+		return c.Redirect(http.StatusFound, "/login")
+	}
+	if h.inbox == nil {
+		return c.Redirect(http.StatusFound, "/notifications")
+	}
+
+	data, err := h.loadInbox(c, userID, 0)
+	if err != nil {
+		h.logger.Error("failed to load notification inbox", slog.String("error", err.Error()))
+		return c.Redirect(http.StatusFound, "/notifications")
+	}
+
+	return h.renderPage(c, "notification/inbox.html", "notification-inbox-content", "Inbox", data)
+}
+
+// InboxListPartial returns the next page of the cross-workspace inbox as HTML partial for HTMX.
+func (h *NotificationTemplateHandler) InboxListPartial(c echo.Context) error {
+	userID := middleware.GetUserID(c)
+	if userID.IsZero() {
+		return c.String(http.StatusUnauthorized, "Unauthorized")
+	}
+	if h.inbox == nil {
+		return h.renderErrorState(c, "Inbox unavailable")
+	}
+
+	_, offset := h.parseNotificationPagination(c)
+	data, err := h.loadInbox(c, userID, offset)
+	if err != nil {
+		h.logger.Error("failed to load notification inbox", slog.String("error", err.Error()))
+		return h.renderErrorState(c, "Failed to load notifications")
+	}
+
+	return h.renderPartial(c, "notification/inbox-partial", data)
+}
+
+// loadInbox loads a page of the unified inbox filtered by the workspace_id and filter query parameters.
+func (h *NotificationTemplateHandler) loadInbox(c echo.Context, userID uuid.UUID, offset int) (InboxListData, error) {
+	filter := c.QueryParam("filter")
+	data := InboxListData{Filter: filter}
+	if parsed, err := uuid.ParseUUID(c.QueryParam("workspace_id")); err == nil {
+		data.WorkspaceID = parsed.String()
+	}
+
+	result, err := h.inbox.UnifiedInbox(c.Request().Context(), notifapp.UnifiedInboxQuery{
+		UserID:      userID,
+		WorkspaceID: uuid.UUID(data.WorkspaceID),
+		UnreadOnly:  filter == "unread",
+		Limit:       defaultNotificationTemplateListLimit,
+		Offset:      offset,
+	})
+	if err != nil {
+		return InboxListData{}, err
+	}
+
+	data.Notifications = make([]InboxNotificationViewData, 0, len(result.Entries))
+	for _, entry := range result.Entries {
+		data.Notifications = append(data.Notifications, InboxNotificationViewData{
+			NotificationViewData: h.toNotificationViewData(entry.Notification),
+			WorkspaceName:        entry.WorkspaceName,
+		})
+	}
+	data.Facets = make([]InboxFacetViewData, 0, len(result.Facets))
+	for _, facet := range result.Facets {
+		data.Facets = append(data.Facets, InboxFacetViewData{
+			WorkspaceID: facet.WorkspaceID.String(),
+			Name:        facet.Name,
+			Total:       facet.Total,
+			Unread:      facet.Unread,
+			Selected:    facet.WorkspaceID.String() == data.WorkspaceID,
+		})
+	}
+	data.TotalCount = result.TotalCount
+	data.UnreadCount = result.UnreadCount
+	data.NextOffset = offset + len(result.Entries)
+	data.HasMore = data.NextOffset < result.TotalCount
+	return data, nil
+}
+
 // Helper methods
 
 // render renders a full page template with common page data.
 func (h *NotificationTemplateHandler) render(c echo.Context, templateName, title string, data any) error {
+	return h.renderPage(c, templateName, "notification-content", title, data)
+}
+
+// renderPage renders a full page template with the given content template.
+func (h *NotificationTemplateHandler) renderPage(
+	c echo.Context,
+	templateName, contentTemplate, title string,
+	data any,
+) error {
 	if h.renderer == nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, "template renderer not configured")
 	}
@@ -300,7 +427,7 @@ func (h *NotificationTemplateHandler) render(c echo.Context, templateName, title
 		User:            h.getUserView(c),
 		Flash:           nil,
 		Data:            data,
-		ContentTemplate: "notification-content",
+		ContentTemplate: contentTemplate,
 	}
 
 	c.Response().Header().Set("Content-Type", "text/html; charset=utf-8")
@@ -390,9 +517,13 @@ func (h *NotificationTemplateHandler) generateNotificationLink(notifType notific
 	}
 
 	switch notifType {
-	case notification.TypeTaskStatusChanged, notification.TypeTaskAssigned, notification.TypeTaskCreated:
+	case notification.TypeTaskStatusChanged, notification.TypeTaskAssigned, notification.TypeTaskCreated,
+		notification.TypeTaskSLABreached:
 		return "/tasks/" + resourceID
-	case notification.TypeChatMention, notification.TypeChatMessage:
+	case notification.TypeChatMention:
+		// mentions reference the message, resolved by the message permalink
+		return "/messages/" + resourceID
+	case notification.TypeChatMessage:
 		return "/chats/" + resourceID
 	case notification.TypeWorkspaceInvite:
 		return "/workspaces/" + resourceID
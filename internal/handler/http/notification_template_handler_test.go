@@ -394,7 +394,7 @@ func TestNotificationTemplateHandler_NotificationRedirect(t *testing.T) {
 
 		mockService := NewMockNotificationTemplateService()
 
-		n := makeTestNotification(userID, notification.TypeChatMention, "Mention", "@user mentioned you", "chat-123")
+		n := makeTestNotification(userID, notification.TypeChatMention, "Mention", "@user mentioned you", "msg-123")
 		mockService.AddNotification(n)
 
 		handler := httphandler.NewNotificationTemplateHandler(nil, nil, mockService)
@@ -410,7 +410,7 @@ func TestNotificationTemplateHandler_NotificationRedirect(t *testing.T) {
 
 		require.NoError(t, err)
 		assert.Equal(t, http.StatusFound, rec.Code)
-		assert.Contains(t, rec.Header().Get("Location"), "/chats/chat-123")
+		assert.Contains(t, rec.Header().Get("Location"), "/messages/msg-123")
 
 		// Verify notification was marked as read
 		assert.True(t, n.IsRead())
@@ -534,8 +534,8 @@ func TestNotificationTemplateHandler_GenerateNotificationLink(t *testing.T) {
 		{
 			name:       "chat mention",
 			notifType:  notification.TypeChatMention,
-			resourceID: "chat-123",
-			expected:   "/chats/chat-123",
+			resourceID: "msg-123",
+			expected:   "/messages/msg-123",
 		},
 		{
 			name:       "chat message",
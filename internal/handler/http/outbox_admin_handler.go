@@ -0,0 +1,273 @@
+package httphandler
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/lllypuk/flowra/internal/application/appcore"
+	"github.com/lllypuk/flowra/internal/infrastructure/httpserver"
+)
+
+// Quarantine listing limits.
+const (
+	defaultQuarantineListLimit = 50
+	maxQuarantineListLimit     = 500
+)
+
+// OutboxQuarantineStore inspects and resolves quarantined outbox entries.
+// Declared on the consumer side per project guidelines.
+type OutboxQuarantineStore interface {
+	ListQuarantined(ctx context.Context, limit int) ([]appcore.OutboxEntry, error)
+	CountQuarantined(ctx context.Context) (int64, error)
+	Requeue(ctx context.Context, entryID string) error
+	Discard(ctx context.Context, entryID string) error
+}
+
+// QuarantinedEntryResponse represents a quarantined outbox entry in API responses.
+type QuarantinedEntryResponse struct {
+	ID            string     `json:"id"`
+	EventType     string     `json:"event_type"`
+	AggregateID   string     `json:"aggregate_id"`
+	AggregateType string     `json:"aggregate_type"`
+	RetryCount    int        `json:"retry_count"`
+	LastError     string     `json:"last_error,omitempty"`
+	CreatedAt     time.Time  `json:"created_at"`
+	QuarantinedAt *time.Time `json:"quarantined_at,omitempty"`
+	Payload       string     `json:"payload"`
+}
+
+// QuarantineListResponse represents the list of quarantined outbox entries.
+type QuarantineListResponse struct {
+	Entries []QuarantinedEntryResponse `json:"entries"`
+	Total   int64                      `json:"total"`
+}
+
+// OutboxStatsResponse represents the outbox backlog and failure queues.
+// Counters whose source is not configured are omitted.
+type OutboxStatsResponse struct {
+	Pending         *int64     `json:"pending,omitempty"`
+	OldestPendingAt *time.Time `json:"oldest_pending_at,omitempty"`
+	Quarantined     int64      `json:"quarantined"`
+	DeadLetters     *int64     `json:"dead_letters,omitempty"`
+}
+
+// DeadLetterResponse represents an event from the dead letter queue.
+type DeadLetterResponse struct {
+	EventType   string    `json:"event_type"`
+	AggregateID string    `json:"aggregate_id"`
+	Error       string    `json:"error"`
+	FailedAt    time.Time `json:"failed_at"`
+}
+
+// DeadLetterListResponse represents the latest dead letter queue entries.
+type DeadLetterListResponse struct {
+	Entries []DeadLetterResponse `json:"entries"`
+	Total   int64                `json:"total"`
+}
+
+// OutboxAdminHandler handles the outbox quarantine admin API.
+type OutboxAdminHandler struct {
+	store       OutboxQuarantineStore
+	backlog     AdminOutboxStats
+	deadLetters AdminDeadLetterSource
+}
+
+// OutboxAdminOption configures an OutboxAdminHandler.
+type OutboxAdminOption func(*OutboxAdminHandler)
+
+// WithOutboxBacklog reports the pending outbox backlog in the stats endpoint.
+func WithOutboxBacklog(backlog AdminOutboxStats) OutboxAdminOption {
+	return func(h *OutboxAdminHandler) {
+		h.backlog = backlog
+	}
+}
+
+// WithDeadLetters exposes the event bus dead letter queue.
+func WithDeadLetters(deadLetters AdminDeadLetterSource) OutboxAdminOption {
+	return func(h *OutboxAdminHandler) {
+		h.deadLetters = deadLetters
+	}
+}
+
+// NewOutboxAdminHandler creates a new OutboxAdminHandler.
+func NewOutboxAdminHandler(store OutboxQuarantineStore, opts ...OutboxAdminOption) *OutboxAdminHandler {
+	h := &OutboxAdminHandler{store: store}
+	for _, opt := range opts {
+		opt(h)
+	}
+	return h
+}
+
+// RegisterRoutes registers the outbox quarantine routes with the router. System admins only.
+func (h *OutboxAdminHandler) RegisterRoutes(r *httpserver.Router) {
+	admin := r.NewAuthRouteGroup("/admin/outbox").RequireSystemAdmin()
+	admin.GET("/stats", h.Stats)
+	admin.GET("/dead-letters", h.ListDeadLetters)
+	admin.GET("/quarantine", h.ListQuarantined)
+	admin.POST("/quarantine/:entry_id/requeue", h.Requeue)
+	admin.DELETE("/quarantine/:entry_id", h.Discard)
+}
+
+// Stats handles GET /api/v1/admin/outbox/stats.
+func (h *OutboxAdminHandler) Stats(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	var resp OutboxStatsResponse
+	quarantined, err := h.store.CountQuarantined(ctx)
+	if err != nil {
+		return httpserver.RespondErrorWithCode(
+			c, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to count quarantined events")
+	}
+	resp.Quarantined = quarantined
+
+	if h.backlog != nil {
+		pending, oldest, statsErr := h.backlog.Stats(ctx)
+		if statsErr != nil {
+			return httpserver.RespondErrorWithCode(
+				c, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to load outbox backlog")
+		}
+		resp.Pending = &pending
+		if !oldest.IsZero() {
+			resp.OldestPendingAt = &oldest
+		}
+	}
+
+	if h.deadLetters != nil {
+		depth, depthErr := h.deadLetters.QueueLength(ctx)
+		if depthErr != nil {
+			return httpserver.RespondErrorWithCode(
+				c, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to load dead letter queue length")
+		}
+		resp.DeadLetters = &depth
+	}
+
+	return httpserver.RespondOK(c, resp)
+}
+
+// ListDeadLetters handles GET /api/v1/admin/outbox/dead-letters.
+// The dead letter queue holds events whose handlers kept failing; it is read-only here.
+func (h *OutboxAdminHandler) ListDeadLetters(c echo.Context) error {
+	if h.deadLetters == nil {
+		return httpserver.RespondErrorWithCode(
+			c, http.StatusServiceUnavailable, "DEAD_LETTERS_UNAVAILABLE", "Dead letter queue is not configured")
+	}
+	limit, ok := parseQuarantineLimit(c)
+	if !ok {
+		return httpserver.RespondErrorWithCode(
+			c, http.StatusBadRequest, "VALIDATION_ERROR", "limit must be a positive integer")
+	}
+
+	ctx := c.Request().Context()
+	entries, err := h.deadLetters.RecentErrors(ctx, limit)
+	if err != nil {
+		return httpserver.RespondErrorWithCode(
+			c, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to list dead letters")
+	}
+	total, err := h.deadLetters.QueueLength(ctx)
+	if err != nil {
+		return httpserver.RespondErrorWithCode(
+			c, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to load dead letter queue length")
+	}
+
+	resp := DeadLetterListResponse{
+		Entries: make([]DeadLetterResponse, 0, len(entries)),
+		Total:   total,
+	}
+	for _, entry := range entries {
+		resp.Entries = append(resp.Entries, DeadLetterResponse{
+			EventType:   entry.EventType,
+			AggregateID: entry.AggregateID,
+			Error:       entry.Error,
+			FailedAt:    entry.FailedAt,
+		})
+	}
+	return httpserver.RespondOK(c, resp)
+}
+
+// ListQuarantined handles GET /api/v1/admin/outbox/quarantine.
+func (h *OutboxAdminHandler) ListQuarantined(c echo.Context) error {
+	limit, ok := parseQuarantineLimit(c)
+	if !ok {
+		return httpserver.RespondErrorWithCode(
+			c, http.StatusBadRequest, "VALIDATION_ERROR", "limit must be a positive integer")
+	}
+
+	ctx := c.Request().Context()
+	entries, err := h.store.ListQuarantined(ctx, limit)
+	if err != nil {
+		return httpserver.RespondErrorWithCode(
+			c, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to list quarantined events")
+	}
+	total, err := h.store.CountQuarantined(ctx)
+	if err != nil {
+		return httpserver.RespondErrorWithCode(
+			c, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to count quarantined events")
+	}
+
+	resp := QuarantineListResponse{
+		Entries: make([]QuarantinedEntryResponse, 0, len(entries)),
+		Total:   total,
+	}
+	for _, entry := range entries {
+		resp.Entries = append(resp.Entries, ToQuarantinedEntryResponse(entry))
+	}
+	return httpserver.RespondOK(c, resp)
+}
+
+// Requeue handles POST /api/v1/admin/outbox/quarantine/:entry_id/requeue.
+// The entry goes back to normal polling with a fresh retry budget.
+func (h *OutboxAdminHandler) Requeue(c echo.Context) error {
+	if err := h.store.Requeue(c.Request().Context(), c.Param("entry_id")); err != nil {
+		return h.respondEntryError(c, err, "Failed to requeue event")
+	}
+	return httpserver.RespondOK(c, map[string]string{"status": "requeued"})
+}
+
+// Discard handles DELETE /api/v1/admin/outbox/quarantine/:entry_id.
+// The event is deleted and never published.
+func (h *OutboxAdminHandler) Discard(c echo.Context) error {
+	if err := h.store.Discard(c.Request().Context(), c.Param("entry_id")); err != nil {
+		return h.respondEntryError(c, err, "Failed to discard event")
+	}
+	return httpserver.RespondNoContent(c)
+}
+
+// parseQuarantineLimit parses the limit query parameter of the listing endpoints.
+func parseQuarantineLimit(c echo.Context) (int, bool) {
+	raw := c.QueryParam("limit")
+	if raw == "" {
+		return defaultQuarantineListLimit, true
+	}
+	parsed, err := strconv.Atoi(raw)
+	if err != nil || parsed <= 0 {
+		return 0, false
+	}
+	return min(parsed, maxQuarantineListLimit), true
+}
+
+func (h *OutboxAdminHandler) respondEntryError(c echo.Context, err error, message string) error {
+	if errors.Is(err, appcore.ErrOutboxEntryNotFound) {
+		return httpserver.RespondErrorWithCode(
+			c, http.StatusNotFound, "NOT_FOUND", "Quarantined event not found")
+	}
+	return httpserver.RespondErrorWithCode(c, http.StatusInternalServerError, "INTERNAL_ERROR", message)
+}
+
+// ToQuarantinedEntryResponse converts an outbox entry to its API representation.
+func ToQuarantinedEntryResponse(entry appcore.OutboxEntry) QuarantinedEntryResponse {
+	return QuarantinedEntryResponse{
+		ID:            entry.ID,
+		EventType:     entry.EventType,
+		AggregateID:   entry.AggregateID,
+		AggregateType: entry.AggregateType,
+		RetryCount:    entry.RetryCount,
+		LastError:     entry.LastError,
+		CreatedAt:     entry.CreatedAt,
+		QuarantinedAt: entry.QuarantinedAt,
+		Payload:       string(entry.Payload),
+	}
+}
@@ -0,0 +1,183 @@
+package httphandler_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	stdhttp "net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/lllypuk/flowra/internal/application/appcore"
+	httphandler "github.com/lllypuk/flowra/internal/handler/http"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeQuarantineStore struct {
+	entries map[string]appcore.OutboxEntry
+	lastMax int
+}
+
+func newFakeQuarantineStore(entries ...appcore.OutboxEntry) *fakeQuarantineStore {
+	store := &fakeQuarantineStore{entries: map[string]appcore.OutboxEntry{}}
+	for _, entry := range entries {
+		store.entries[entry.ID] = entry
+	}
+	return store
+}
+
+func (s *fakeQuarantineStore) ListQuarantined(_ context.Context, limit int) ([]appcore.OutboxEntry, error) {
+	s.lastMax = limit
+	entries := make([]appcore.OutboxEntry, 0, len(s.entries))
+	for _, entry := range s.entries {
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
+
+func (s *fakeQuarantineStore) CountQuarantined(_ context.Context) (int64, error) {
+	return int64(len(s.entries)), nil
+}
+
+func (s *fakeQuarantineStore) Requeue(_ context.Context, entryID string) error {
+	return s.remove(entryID)
+}
+
+func (s *fakeQuarantineStore) Discard(_ context.Context, entryID string) error {
+	return s.remove(entryID)
+}
+
+func (s *fakeQuarantineStore) remove(entryID string) error {
+	if _, ok := s.entries[entryID]; !ok {
+		return fmt.Errorf("%w: %s", appcore.ErrOutboxEntryNotFound, entryID)
+	}
+	delete(s.entries, entryID)
+	return nil
+}
+
+func newOutboxAdminContext(method, target, entryID string) (echo.Context, *httptest.ResponseRecorder) {
+	e := echo.New()
+	req := httptest.NewRequest(method, target, nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	if entryID != "" {
+		c.SetParamNames("entry_id")
+		c.SetParamValues(entryID)
+	}
+	return c, rec
+}
+
+func TestOutboxAdminHandler(t *testing.T) {
+	quarantinedAt := time.Now().UTC()
+	poison := appcore.OutboxEntry{
+		ID:            "entry-1",
+		EventType:     "chat.created",
+		AggregateID:   "chat-1",
+		AggregateType: "chat",
+		Payload:       []byte(`{"broken":true}`),
+		RetryCount:    5,
+		LastError:     "publish failed",
+		QuarantinedAt: &quarantinedAt,
+	}
+
+	t.Run("lists quarantined entries", func(t *testing.T) {
+		store := newFakeQuarantineStore(poison)
+		handler := httphandler.NewOutboxAdminHandler(store)
+
+		c, rec := newOutboxAdminContext(stdhttp.MethodGet, "/api/v1/admin/outbox/quarantine?limit=10000", "")
+		require.NoError(t, handler.ListQuarantined(c))
+		assert.Equal(t, stdhttp.StatusOK, rec.Code)
+
+		var resp struct {
+			Data httphandler.QuarantineListResponse `json:"data"`
+		}
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+		require.Len(t, resp.Data.Entries, 1)
+		assert.Equal(t, int64(1), resp.Data.Total)
+		assert.Equal(t, "publish failed", resp.Data.Entries[0].LastError)
+		assert.JSONEq(t, `{"broken":true}`, resp.Data.Entries[0].Payload)
+		assert.Equal(t, 500, store.lastMax)
+	})
+
+	t.Run("rejects invalid limit", func(t *testing.T) {
+		handler := httphandler.NewOutboxAdminHandler(newFakeQuarantineStore())
+
+		c, rec := newOutboxAdminContext(stdhttp.MethodGet, "/api/v1/admin/outbox/quarantine?limit=abc", "")
+		require.NoError(t, handler.ListQuarantined(c))
+		assert.Equal(t, stdhttp.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("requeues entry", func(t *testing.T) {
+		store := newFakeQuarantineStore(poison)
+		handler := httphandler.NewOutboxAdminHandler(store)
+
+		c, rec := newOutboxAdminContext(stdhttp.MethodPost, "/", "entry-1")
+		require.NoError(t, handler.Requeue(c))
+		assert.Equal(t, stdhttp.StatusOK, rec.Code)
+		assert.Empty(t, store.entries)
+	})
+
+	t.Run("discards entry", func(t *testing.T) {
+		store := newFakeQuarantineStore(poison)
+		handler := httphandler.NewOutboxAdminHandler(store)
+
+		c, rec := newOutboxAdminContext(stdhttp.MethodDelete, "/", "entry-1")
+		require.NoError(t, handler.Discard(c))
+		assert.Equal(t, stdhttp.StatusNoContent, rec.Code)
+		assert.Empty(t, store.entries)
+	})
+
+	t.Run("unknown entry returns not found", func(t *testing.T) {
+		handler := httphandler.NewOutboxAdminHandler(newFakeQuarantineStore())
+
+		c, rec := newOutboxAdminContext(stdhttp.MethodPost, "/", "missing")
+		require.NoError(t, handler.Requeue(c))
+		assert.Equal(t, stdhttp.StatusNotFound, rec.Code)
+	})
+
+	t.Run("reports backlog and dead letters", func(t *testing.T) {
+		oldest := time.Now().Add(-time.Minute).UTC()
+		handler := httphandler.NewOutboxAdminHandler(
+			newFakeQuarantineStore(poison),
+			httphandler.WithOutboxBacklog(&stubAdminOutbox{pending: 12, oldest: oldest}),
+			httphandler.WithDeadLetters(&stubAdminDeadLetters{entries: []httphandler.AdminErrorViewData{
+				{EventType: "chat.created", AggregateID: "chat-2", Error: "handler failed"},
+			}}),
+		)
+
+		c, rec := newOutboxAdminContext(stdhttp.MethodGet, "/api/v1/admin/outbox/stats", "")
+		require.NoError(t, handler.Stats(c))
+		assert.Equal(t, stdhttp.StatusOK, rec.Code)
+
+		var stats struct {
+			Data httphandler.OutboxStatsResponse `json:"data"`
+		}
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &stats))
+		require.NotNil(t, stats.Data.Pending)
+		assert.Equal(t, int64(12), *stats.Data.Pending)
+		assert.Equal(t, int64(1), stats.Data.Quarantined)
+		require.NotNil(t, stats.Data.DeadLetters)
+		assert.Equal(t, int64(1), *stats.Data.DeadLetters)
+
+		c, rec = newOutboxAdminContext(stdhttp.MethodGet, "/api/v1/admin/outbox/dead-letters", "")
+		require.NoError(t, handler.ListDeadLetters(c))
+		assert.Equal(t, stdhttp.StatusOK, rec.Code)
+		assert.Contains(t, rec.Body.String(), "handler failed")
+	})
+
+	t.Run("dead letters unavailable without source", func(t *testing.T) {
+		handler := httphandler.NewOutboxAdminHandler(newFakeQuarantineStore())
+
+		c, rec := newOutboxAdminContext(stdhttp.MethodGet, "/api/v1/admin/outbox/dead-letters", "")
+		require.NoError(t, handler.ListDeadLetters(c))
+		assert.Equal(t, stdhttp.StatusServiceUnavailable, rec.Code)
+
+		c, rec = newOutboxAdminContext(stdhttp.MethodGet, "/api/v1/admin/outbox/stats", "")
+		require.NoError(t, handler.Stats(c))
+		assert.Equal(t, stdhttp.StatusOK, rec.Code)
+		assert.NotContains(t, rec.Body.String(), "pending")
+	})
+}
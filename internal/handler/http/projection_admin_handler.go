@@ -0,0 +1,122 @@
+package httphandler
+
+import (
+	"context"
+	"net/http"
+	"slices"
+
+	"github.com/labstack/echo/v4"
+	"github.com/lllypuk/flowra/internal/domain/uuid"
+	"github.com/lllypuk/flowra/internal/infrastructure/httpserver"
+)
+
+// projectionAggregateTypes are the aggregate types whose read models can be rebuilt.
+//
+//nolint:gochecknoglobals // fixed lookup table
+var projectionAggregateTypes = []string{"chat", "task"}
+
+// ProjectionRepairScheduler queues read model rebuilds for the worker.
+// Declared on the consumer side per project guidelines.
+type ProjectionRepairScheduler interface {
+	ScheduleRepair(ctx context.Context, aggregateType string, aggregateID uuid.UUID) error
+	ScheduleRebuildAll(ctx context.Context, aggregateType string) error
+}
+
+// ProjectionRepairRequest represents a request to rebuild one aggregate's read model.
+type ProjectionRepairRequest struct {
+	AggregateType string `json:"aggregate_type"`
+	AggregateID   string `json:"aggregate_id"`
+}
+
+// ProjectionRebuildRequest represents a request to rebuild every read model of a type.
+type ProjectionRebuildRequest struct {
+	AggregateType string `json:"aggregate_type"`
+}
+
+// RepairQueueResponse represents the repair queue counters in API responses.
+type RepairQueueResponse struct {
+	Pending    int64 `json:"pending"`
+	Processing int64 `json:"processing"`
+	Completed  int64 `json:"completed"`
+	Failed     int64 `json:"failed"`
+	Total      int64 `json:"total"`
+}
+
+// ProjectionAdminHandler handles the projection rebuild and repair admin API.
+// Rebuilds are queued on the repair queue and carried out by the worker.
+type ProjectionAdminHandler struct {
+	scheduler ProjectionRepairScheduler
+	stats     AdminRepairStats
+}
+
+// NewProjectionAdminHandler creates a new ProjectionAdminHandler.
+func NewProjectionAdminHandler(scheduler ProjectionRepairScheduler, stats AdminRepairStats) *ProjectionAdminHandler {
+	return &ProjectionAdminHandler{scheduler: scheduler, stats: stats}
+}
+
+// RegisterRoutes registers the projection routes with the router. System admins only.
+func (h *ProjectionAdminHandler) RegisterRoutes(r *httpserver.Router) {
+	admin := r.NewAuthRouteGroup("/admin/projections").RequireSystemAdmin()
+	admin.GET("/repair", h.RepairStats)
+	admin.POST("/repair", h.Repair)
+	admin.POST("/rebuild", h.Rebuild)
+}
+
+// RepairStats handles GET /api/v1/admin/projections/repair.
+func (h *ProjectionAdminHandler) RepairStats(c echo.Context) error {
+	stats, err := h.stats.RepairStats(c.Request().Context())
+	if err != nil {
+		return httpserver.RespondErrorWithCode(
+			c, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to load repair queue stats")
+	}
+	return httpserver.RespondOK(c, RepairQueueResponse{
+		Pending:    stats.Pending,
+		Processing: stats.Processing,
+		Completed:  stats.Completed,
+		Failed:     stats.Failed,
+		Total:      stats.Total,
+	})
+}
+
+// Repair handles POST /api/v1/admin/projections/repair.
+// It queues a rebuild of one aggregate's read model.
+func (h *ProjectionAdminHandler) Repair(c echo.Context) error {
+	var req ProjectionRepairRequest
+	if err := c.Bind(&req); err != nil {
+		return httpserver.RespondErrorWithCode(c, http.StatusBadRequest, "INVALID_REQUEST", "Invalid request body")
+	}
+	if !slices.Contains(projectionAggregateTypes, req.AggregateType) {
+		return httpserver.RespondErrorWithCode(
+			c, http.StatusBadRequest, "VALIDATION_ERROR", "aggregate_type must be chat or task")
+	}
+	aggregateID, err := uuid.ParseUUID(req.AggregateID)
+	if err != nil {
+		return httpserver.RespondErrorWithCode(
+			c, http.StatusBadRequest, "VALIDATION_ERROR", "aggregate_id must be a valid UUID")
+	}
+
+	if err = h.scheduler.ScheduleRepair(c.Request().Context(), req.AggregateType, aggregateID); err != nil {
+		return httpserver.RespondErrorWithCode(
+			c, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to queue projection repair")
+	}
+	return httpserver.RespondJSON(c, http.StatusAccepted, map[string]string{"status": "queued"})
+}
+
+// Rebuild handles POST /api/v1/admin/projections/rebuild.
+// It queues a rebuild of every read model of the aggregate type.
+func (h *ProjectionAdminHandler) Rebuild(c echo.Context) error {
+	var req ProjectionRebuildRequest
+	if err := c.Bind(&req); err != nil {
+		return httpserver.RespondErrorWithCode(c, http.StatusBadRequest, "INVALID_REQUEST", "Invalid request body")
+	}
+	if !slices.Contains(projectionAggregateTypes, req.AggregateType) {
+		return httpserver.RespondErrorWithCode(
+			c, http.StatusBadRequest, "VALIDATION_ERROR", "aggregate_type must be chat or task")
+	}
+
+	if err := h.scheduler.ScheduleRebuildAll(c.Request().Context(), req.AggregateType); err != nil {
+		return httpserver.RespondErrorWithCode(
+			c, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to queue projection rebuild")
+	}
+	return httpserver.RespondJSON(c, http.StatusAccepted, map[string]string{"status": "queued"})
+}
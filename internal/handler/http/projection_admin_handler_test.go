@@ -0,0 +1,88 @@
+package httphandler_test
+
+import (
+	"context"
+	stdhttp "net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/lllypuk/flowra/internal/domain/uuid"
+	httphandler "github.com/lllypuk/flowra/internal/handler/http"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeRepairScheduler struct {
+	repaired []string
+	rebuilt  []string
+}
+
+func (f *fakeRepairScheduler) ScheduleRepair(_ context.Context, aggregateType string, aggregateID uuid.UUID) error {
+	f.repaired = append(f.repaired, aggregateType+":"+aggregateID.String())
+	return nil
+}
+
+func (f *fakeRepairScheduler) ScheduleRebuildAll(_ context.Context, aggregateType string) error {
+	f.rebuilt = append(f.rebuilt, aggregateType)
+	return nil
+}
+
+func newProjectionAdminContext(method, body string) (echo.Context, *httptest.ResponseRecorder) {
+	e := echo.New()
+	req := httptest.NewRequest(method, "/", strings.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	return e.NewContext(req, rec), rec
+}
+
+func TestProjectionAdminHandler(t *testing.T) {
+	t.Run("queues single aggregate repair", func(t *testing.T) {
+		scheduler := &fakeRepairScheduler{}
+		handler := httphandler.NewProjectionAdminHandler(scheduler, stubAdminRepair{})
+		chatID := uuid.NewUUID()
+
+		c, rec := newProjectionAdminContext(stdhttp.MethodPost,
+			`{"aggregate_type":"chat","aggregate_id":"`+chatID.String()+`"}`)
+		require.NoError(t, handler.Repair(c))
+		assert.Equal(t, stdhttp.StatusAccepted, rec.Code)
+		assert.Equal(t, []string{"chat:" + chatID.String()}, scheduler.repaired)
+	})
+
+	t.Run("queues full rebuild", func(t *testing.T) {
+		scheduler := &fakeRepairScheduler{}
+		handler := httphandler.NewProjectionAdminHandler(scheduler, stubAdminRepair{})
+
+		c, rec := newProjectionAdminContext(stdhttp.MethodPost, `{"aggregate_type":"task"}`)
+		require.NoError(t, handler.Rebuild(c))
+		assert.Equal(t, stdhttp.StatusAccepted, rec.Code)
+		assert.Equal(t, []string{"task"}, scheduler.rebuilt)
+	})
+
+	t.Run("rejects invalid requests", func(t *testing.T) {
+		scheduler := &fakeRepairScheduler{}
+		handler := httphandler.NewProjectionAdminHandler(scheduler, stubAdminRepair{})
+
+		c, rec := newProjectionAdminContext(stdhttp.MethodPost, `{"aggregate_type":"workspace"}`)
+		require.NoError(t, handler.Rebuild(c))
+		assert.Equal(t, stdhttp.StatusBadRequest, rec.Code)
+
+		c, rec = newProjectionAdminContext(stdhttp.MethodPost, `{"aggregate_type":"chat","aggregate_id":"nope"}`)
+		require.NoError(t, handler.Repair(c))
+		assert.Equal(t, stdhttp.StatusBadRequest, rec.Code)
+
+		assert.Empty(t, scheduler.repaired)
+		assert.Empty(t, scheduler.rebuilt)
+	})
+
+	t.Run("reports repair queue stats", func(t *testing.T) {
+		handler := httphandler.NewProjectionAdminHandler(&fakeRepairScheduler{}, stubAdminRepair{})
+
+		c, rec := newProjectionAdminContext(stdhttp.MethodGet, "")
+		require.NoError(t, handler.RepairStats(c))
+		assert.Equal(t, stdhttp.StatusOK, rec.Code)
+		assert.Contains(t, rec.Body.String(), `"pending":7`)
+		assert.Contains(t, rec.Body.String(), `"failed":2`)
+	})
+}
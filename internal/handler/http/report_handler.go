@@ -0,0 +1,312 @@
+package httphandler
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	reportapp "github.com/lllypuk/flowra/internal/application/report"
+	taskapp "github.com/lllypuk/flowra/internal/application/task"
+	"github.com/lllypuk/flowra/internal/domain/uuid"
+	"github.com/lllypuk/flowra/internal/infrastructure/httpserver"
+	"github.com/lllypuk/flowra/internal/middleware"
+)
+
+// VelocityReporter builds sprint velocity reports.
+// Declared on the consumer side per project guidelines.
+type VelocityReporter interface {
+	Execute(ctx context.Context, query taskapp.VelocityReportQuery) (*taskapp.VelocityReport, error)
+}
+
+// ReportSnapshotProvider returns the cached burndown, flow and cycle time reports.
+// Declared on the consumer side per project guidelines.
+type ReportSnapshotProvider interface {
+	Execute(ctx context.Context, query reportapp.GetQuery) (*reportapp.Snapshot, error)
+}
+
+// SprintVelocityResponse represents the velocity of a single sprint.
+type SprintVelocityResponse struct {
+	Sprint           string  `json:"sprint"`
+	TaskCount        int     `json:"task_count"`
+	CompletedCount   int     `json:"completed_count"`
+	UnestimatedCount int     `json:"unestimated_count"`
+	CommittedPoints  float64 `json:"committed_points"`
+	CompletedPoints  float64 `json:"completed_points"`
+	CommittedHours   float64 `json:"committed_hours"`
+	CompletedHours   float64 `json:"completed_hours"`
+}
+
+// VelocityReportResponse represents the velocity report in API responses.
+type VelocityReportResponse struct {
+	WorkspaceID   string                   `json:"workspace_id"`
+	Sprints       []SprintVelocityResponse `json:"sprints"`
+	AveragePoints float64                  `json:"average_points"`
+	AverageHours  float64                  `json:"average_hours"`
+}
+
+// BurndownDayResponse represents the sprint state at the end of a day.
+type BurndownDayResponse struct {
+	Date            string  `json:"date"`
+	ScopeTasks      int     `json:"scope_tasks"`
+	RemainingTasks  int     `json:"remaining_tasks"`
+	ScopePoints     float64 `json:"scope_points"`
+	RemainingPoints float64 `json:"remaining_points"`
+	ScopeHours      float64 `json:"scope_hours"`
+	RemainingHours  float64 `json:"remaining_hours"`
+}
+
+// BurndownResponse represents a sprint burndown in API responses.
+type BurndownResponse struct {
+	WorkspaceID string                `json:"workspace_id"`
+	Sprint      string                `json:"sprint"`
+	StartDate   string                `json:"start_date"`
+	EndDate     string                `json:"end_date"`
+	Days        []BurndownDayResponse `json:"days"`
+	Sprints     []string              `json:"sprints"`
+	GeneratedAt time.Time             `json:"generated_at"`
+}
+
+// FlowDayResponse represents the number of items per status at the end of a day.
+type FlowDayResponse struct {
+	Date   string `json:"date"`
+	Counts []int  `json:"counts"`
+}
+
+// CumulativeFlowResponse represents the cumulative flow report in API responses.
+// Counts of each day are in the order of Statuses.
+type CumulativeFlowResponse struct {
+	WorkspaceID string            `json:"workspace_id"`
+	Statuses    []string          `json:"statuses"`
+	Days        []FlowDayResponse `json:"days"`
+	GeneratedAt time.Time         `json:"generated_at"`
+}
+
+// DurationStatsResponse represents duration statistics in hours.
+type DurationStatsResponse struct {
+	Count        int     `json:"count"`
+	AverageHours float64 `json:"average_hours"`
+	MedianHours  float64 `json:"median_hours"`
+	P85Hours     float64 `json:"p85_hours"`
+}
+
+// CycleTimeResponse represents the cycle and lead time report in API responses.
+type CycleTimeResponse struct {
+	WorkspaceID string                `json:"workspace_id"`
+	WindowDays  int                   `json:"window_days"`
+	LeadTime    DurationStatsResponse `json:"lead_time"`
+	CycleTime   DurationStatsResponse `json:"cycle_time"`
+	GeneratedAt time.Time             `json:"generated_at"`
+}
+
+// ReportHandler handles workspace reporting HTTP requests.
+type ReportHandler struct {
+	velocity  VelocityReporter
+	snapshots ReportSnapshotProvider
+}
+
+// NewReportHandler creates a new ReportHandler.
+func NewReportHandler(velocity VelocityReporter, snapshots ReportSnapshotProvider) *ReportHandler {
+	return &ReportHandler{
+		velocity:  velocity,
+		snapshots: snapshots,
+	}
+}
+
+// Velocity handles GET /api/v1/workspaces/:workspace_id/reports/velocity.
+// Returns committed and completed estimates of the most recent sprints.
+func (h *ReportHandler) Velocity(c echo.Context) error {
+	userID := middleware.GetUserID(c)
+	if userID.IsZero() {
+		return httpserver.RespondErrorWithCode(c, http.StatusUnauthorized, "UNAUTHORIZED", "authentication required")
+	}
+
+	workspaceID, parseErr := uuid.ParseUUID(c.Param("workspace_id"))
+	if parseErr != nil {
+		return httpserver.RespondErrorWithCode(
+			c, http.StatusBadRequest, "INVALID_WORKSPACE_ID", "invalid workspace ID format")
+	}
+
+	query := taskapp.VelocityReportQuery{WorkspaceID: workspaceID}
+	if raw := c.QueryParam("sprints"); raw != "" {
+		sprints, err := strconv.Atoi(raw)
+		if err != nil || sprints <= 0 {
+			return httpserver.RespondErrorWithCode(
+				c, http.StatusBadRequest, "VALIDATION_ERROR", "sprints must be a positive integer")
+		}
+		query.Sprints = sprints
+	}
+
+	report, err := h.velocity.Execute(c.Request().Context(), query)
+	if err != nil {
+		return httpserver.RespondErrorWithCode(
+			c, http.StatusInternalServerError, "INTERNAL_ERROR", "failed to build velocity report")
+	}
+
+	return httpserver.RespondOK(c, ToVelocityReportResponse(report))
+}
+
+// Burndown handles GET /api/v1/workspaces/:workspace_id/reports/burndown.
+// Returns the burndown of the sprint given by the sprint query parameter,
+// or of the latest sprint.
+func (h *ReportHandler) Burndown(c echo.Context) error {
+	snapshot, err := h.loadSnapshot(c)
+	if err != nil {
+		return err
+	}
+	if snapshot == nil {
+		return nil
+	}
+
+	sprint := c.QueryParam("sprint")
+	if sprint == "" {
+		if len(snapshot.Burndowns) == 0 {
+			return httpserver.RespondErrorWithCode(c, http.StatusNotFound, "NOT_FOUND", "no sprints found")
+		}
+		sprint = snapshot.Burndowns[len(snapshot.Burndowns)-1].Sprint
+	}
+
+	burndown, ok := snapshot.Burndown(sprint)
+	if !ok {
+		return httpserver.RespondErrorWithCode(c, http.StatusNotFound, "NOT_FOUND", "sprint not found")
+	}
+
+	return httpserver.RespondOK(c, ToBurndownResponse(snapshot, burndown))
+}
+
+// CumulativeFlow handles GET /api/v1/workspaces/:workspace_id/reports/cumulative-flow.
+// Returns the number of tasks per status for each of the last days.
+func (h *ReportHandler) CumulativeFlow(c echo.Context) error {
+	snapshot, err := h.loadSnapshot(c)
+	if err != nil {
+		return err
+	}
+	if snapshot == nil {
+		return nil
+	}
+
+	return httpserver.RespondOK(c, ToCumulativeFlowResponse(snapshot))
+}
+
+// CycleTime handles GET /api/v1/workspaces/:workspace_id/reports/cycle-time.
+// Returns lead and cycle time statistics of recently completed tasks.
+func (h *ReportHandler) CycleTime(c echo.Context) error {
+	snapshot, err := h.loadSnapshot(c)
+	if err != nil {
+		return err
+	}
+	if snapshot == nil {
+		return nil
+	}
+
+	return httpserver.RespondOK(c, ToCycleTimeResponse(snapshot))
+}
+
+// loadSnapshot validates the request and loads the workspace report snapshot.
+// A nil snapshot means the error response has already been written.
+func (h *ReportHandler) loadSnapshot(c echo.Context) (*reportapp.Snapshot, error) {
+	userID := middleware.GetUserID(c)
+	if userID.IsZero() {
+		return nil, httpserver.RespondErrorWithCode(
+			c, http.StatusUnauthorized, "UNAUTHORIZED", "authentication required")
+	}
+
+	workspaceID, parseErr := uuid.ParseUUID(c.Param("workspace_id"))
+	if parseErr != nil {
+		return nil, httpserver.RespondErrorWithCode(
+			c, http.StatusBadRequest, "INVALID_WORKSPACE_ID", "invalid workspace ID format")
+	}
+
+	snapshot, err := h.snapshots.Execute(c.Request().Context(), reportapp.GetQuery{WorkspaceID: workspaceID})
+	if err != nil {
+		return nil, httpserver.RespondErrorWithCode(
+			c, http.StatusInternalServerError, "INTERNAL_ERROR", "failed to build reports")
+	}
+
+	return snapshot, nil
+}
+
+// ToVelocityReportResponse converts a velocity report to its API representation.
+func ToVelocityReportResponse(report *taskapp.VelocityReport) VelocityReportResponse {
+	sprints := make([]SprintVelocityResponse, 0, len(report.Sprints))
+	for _, s := range report.Sprints {
+		sprints = append(sprints, SprintVelocityResponse{
+			Sprint:           s.Sprint,
+			TaskCount:        s.TaskCount,
+			CompletedCount:   s.CompletedCount,
+			UnestimatedCount: s.UnestimatedCount,
+			CommittedPoints:  s.CommittedPoints,
+			CompletedPoints:  s.CompletedPoints,
+			CommittedHours:   s.CommittedHours,
+			CompletedHours:   s.CompletedHours,
+		})
+	}
+
+	return VelocityReportResponse{
+		WorkspaceID:   report.WorkspaceID.String(),
+		Sprints:       sprints,
+		AveragePoints: report.AveragePoints,
+		AverageHours:  report.AverageHours,
+	}
+}
+
+// ToBurndownResponse converts a sprint burndown to its API representation.
+func ToBurndownResponse(snapshot *reportapp.Snapshot, burndown reportapp.SprintBurndown) BurndownResponse {
+	days := make([]BurndownDayResponse, 0, len(burndown.Days))
+	for _, d := range burndown.Days {
+		days = append(days, BurndownDayResponse{
+			Date:            d.Date.Format(time.DateOnly),
+			ScopeTasks:      d.ScopeTasks,
+			RemainingTasks:  d.RemainingTasks,
+			ScopePoints:     d.ScopePoints,
+			RemainingPoints: d.RemainingPoints,
+			ScopeHours:      d.ScopeHours,
+			RemainingHours:  d.RemainingHours,
+		})
+	}
+
+	sprints := make([]string, 0, len(snapshot.Burndowns))
+	for _, b := range snapshot.Burndowns {
+		sprints = append(sprints, b.Sprint)
+	}
+
+	return BurndownResponse{
+		WorkspaceID: snapshot.WorkspaceID.String(),
+		Sprint:      burndown.Sprint,
+		StartDate:   burndown.StartDate.Format(time.DateOnly),
+		EndDate:     burndown.EndDate.Format(time.DateOnly),
+		Days:        days,
+		Sprints:     sprints,
+		GeneratedAt: snapshot.GeneratedAt,
+	}
+}
+
+// ToCumulativeFlowResponse converts the cumulative flow report to its API representation.
+func ToCumulativeFlowResponse(snapshot *reportapp.Snapshot) CumulativeFlowResponse {
+	days := make([]FlowDayResponse, 0, len(snapshot.CumulativeFlow.Days))
+	for _, d := range snapshot.CumulativeFlow.Days {
+		days = append(days, FlowDayResponse{
+			Date:   d.Date.Format(time.DateOnly),
+			Counts: d.Counts,
+		})
+	}
+
+	return CumulativeFlowResponse{
+		WorkspaceID: snapshot.WorkspaceID.String(),
+		Statuses:    snapshot.CumulativeFlow.Statuses,
+		Days:        days,
+		GeneratedAt: snapshot.GeneratedAt,
+	}
+}
+
+// ToCycleTimeResponse converts the cycle time report to its API representation.
+func ToCycleTimeResponse(snapshot *reportapp.Snapshot) CycleTimeResponse {
+	return CycleTimeResponse{
+		WorkspaceID: snapshot.WorkspaceID.String(),
+		WindowDays:  snapshot.CycleTime.WindowDays,
+		LeadTime:    DurationStatsResponse(snapshot.CycleTime.LeadTime),
+		CycleTime:   DurationStatsResponse(snapshot.CycleTime.CycleTime),
+		GeneratedAt: snapshot.GeneratedAt,
+	}
+}
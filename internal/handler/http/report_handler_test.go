@@ -0,0 +1,219 @@
+package httphandler_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	stdhttp "net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	reportapp "github.com/lllypuk/flowra/internal/application/report"
+	taskapp "github.com/lllypuk/flowra/internal/application/task"
+	"github.com/lllypuk/flowra/internal/domain/uuid"
+	httphandler "github.com/lllypuk/flowra/internal/handler/http"
+	"github.com/lllypuk/flowra/internal/middleware"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type stubVelocityReporter struct {
+	report    *taskapp.VelocityReport
+	err       error
+	lastQuery taskapp.VelocityReportQuery
+}
+
+func (s *stubVelocityReporter) Execute(
+	_ context.Context,
+	query taskapp.VelocityReportQuery,
+) (*taskapp.VelocityReport, error) {
+	s.lastQuery = query
+	if s.err != nil {
+		return nil, s.err
+	}
+	return s.report, nil
+}
+
+type stubSnapshotProvider struct {
+	snapshot *reportapp.Snapshot
+	err      error
+}
+
+func (s *stubSnapshotProvider) Execute(_ context.Context, _ reportapp.GetQuery) (*reportapp.Snapshot, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	return s.snapshot, nil
+}
+
+func newReportContext(target string, workspaceID string, userID uuid.UUID) (echo.Context, *httptest.ResponseRecorder) {
+	e := echo.New()
+	req := httptest.NewRequest(stdhttp.MethodGet, target, nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetParamNames("workspace_id")
+	c.SetParamValues(workspaceID)
+	if !userID.IsZero() {
+		c.Set(string(middleware.ContextKeyUserID), userID)
+	}
+	return c, rec
+}
+
+func TestReportHandler_Velocity(t *testing.T) {
+	workspaceID := uuid.NewUUID()
+	userID := uuid.NewUUID()
+
+	t.Run("returns report", func(t *testing.T) {
+		reporter := &stubVelocityReporter{report: &taskapp.VelocityReport{
+			WorkspaceID: workspaceID,
+			Sprints: []taskapp.SprintVelocity{
+				{Sprint: "Sprint 1", TaskCount: 3, CompletedCount: 2, CommittedPoints: 8, CompletedPoints: 5},
+			},
+			AveragePoints: 5,
+		}}
+		handler := httphandler.NewReportHandler(reporter, nil)
+
+		c, rec := newReportContext("/reports/velocity?sprints=3", workspaceID.String(), userID)
+		require.NoError(t, handler.Velocity(c))
+		assert.Equal(t, stdhttp.StatusOK, rec.Code)
+		assert.Equal(t, workspaceID, reporter.lastQuery.WorkspaceID)
+		assert.Equal(t, 3, reporter.lastQuery.Sprints)
+
+		var resp struct {
+			Success bool                               `json:"success"`
+			Data    httphandler.VelocityReportResponse `json:"data"`
+		}
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+		assert.True(t, resp.Success)
+		require.Len(t, resp.Data.Sprints, 1)
+		assert.Equal(t, "Sprint 1", resp.Data.Sprints[0].Sprint)
+		assert.InDelta(t, 5.0, resp.Data.AveragePoints, 0.001)
+	})
+
+	t.Run("unauthenticated", func(t *testing.T) {
+		handler := httphandler.NewReportHandler(&stubVelocityReporter{}, nil)
+		c, rec := newReportContext("/reports/velocity", workspaceID.String(), "")
+		require.NoError(t, handler.Velocity(c))
+		assert.Equal(t, stdhttp.StatusUnauthorized, rec.Code)
+	})
+
+	t.Run("invalid workspace ID", func(t *testing.T) {
+		handler := httphandler.NewReportHandler(&stubVelocityReporter{}, nil)
+		c, rec := newReportContext("/reports/velocity", "not-a-uuid", userID)
+		require.NoError(t, handler.Velocity(c))
+		assert.Equal(t, stdhttp.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("invalid sprints parameter", func(t *testing.T) {
+		handler := httphandler.NewReportHandler(&stubVelocityReporter{}, nil)
+		c, rec := newReportContext("/reports/velocity?sprints=0", workspaceID.String(), userID)
+		require.NoError(t, handler.Velocity(c))
+		assert.Equal(t, stdhttp.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("reporter error", func(t *testing.T) {
+		handler := httphandler.NewReportHandler(&stubVelocityReporter{err: errors.New("boom")}, nil)
+		c, rec := newReportContext("/reports/velocity", workspaceID.String(), userID)
+		require.NoError(t, handler.Velocity(c))
+		assert.Equal(t, stdhttp.StatusInternalServerError, rec.Code)
+	})
+}
+
+func TestReportHandler_SnapshotReports(t *testing.T) {
+	workspaceID := uuid.NewUUID()
+	userID := uuid.NewUUID()
+	day := time.Date(2026, time.March, 2, 0, 0, 0, 0, time.UTC)
+	snapshot := &reportapp.Snapshot{
+		WorkspaceID: workspaceID,
+		GeneratedAt: day.Add(12 * time.Hour),
+		Burndowns: []reportapp.SprintBurndown{
+			{Sprint: "Sprint 1", StartDate: day, EndDate: day, Days: []reportapp.BurndownDay{
+				{Date: day, ScopeTasks: 2, RemainingTasks: 1},
+			}},
+			{Sprint: "Sprint 2", StartDate: day, EndDate: day, Days: []reportapp.BurndownDay{
+				{Date: day, ScopeTasks: 4, RemainingTasks: 4},
+			}},
+		},
+		CumulativeFlow: reportapp.CumulativeFlow{
+			Statuses: []string{"To Do", "Done"},
+			Days:     []reportapp.FlowDay{{Date: day, Counts: []int{3, 1}}},
+		},
+		CycleTime: reportapp.CycleTimeReport{
+			WindowDays: 90,
+			LeadTime:   reportapp.DurationStats{Count: 1, AverageHours: 48, MedianHours: 48, P85Hours: 48},
+		},
+	}
+	handler := httphandler.NewReportHandler(nil, &stubSnapshotProvider{snapshot: snapshot})
+
+	t.Run("burndown defaults to latest sprint", func(t *testing.T) {
+		c, rec := newReportContext("/reports/burndown", workspaceID.String(), userID)
+		require.NoError(t, handler.Burndown(c))
+		assert.Equal(t, stdhttp.StatusOK, rec.Code)
+
+		var resp struct {
+			Data httphandler.BurndownResponse `json:"data"`
+		}
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+		assert.Equal(t, "Sprint 2", resp.Data.Sprint)
+		assert.Equal(t, []string{"Sprint 1", "Sprint 2"}, resp.Data.Sprints)
+		require.Len(t, resp.Data.Days, 1)
+		assert.Equal(t, "2026-03-02", resp.Data.Days[0].Date)
+		assert.Equal(t, 4, resp.Data.Days[0].RemainingTasks)
+	})
+
+	t.Run("burndown of a given sprint", func(t *testing.T) {
+		c, rec := newReportContext("/reports/burndown?sprint=Sprint+1", workspaceID.String(), userID)
+		require.NoError(t, handler.Burndown(c))
+		assert.Equal(t, stdhttp.StatusOK, rec.Code)
+		assert.Contains(t, rec.Body.String(), `"sprint":"Sprint 1"`)
+	})
+
+	t.Run("unknown sprint", func(t *testing.T) {
+		c, rec := newReportContext("/reports/burndown?sprint=Sprint+9", workspaceID.String(), userID)
+		require.NoError(t, handler.Burndown(c))
+		assert.Equal(t, stdhttp.StatusNotFound, rec.Code)
+	})
+
+	t.Run("cumulative flow", func(t *testing.T) {
+		c, rec := newReportContext("/reports/cumulative-flow", workspaceID.String(), userID)
+		require.NoError(t, handler.CumulativeFlow(c))
+		assert.Equal(t, stdhttp.StatusOK, rec.Code)
+
+		var resp struct {
+			Data httphandler.CumulativeFlowResponse `json:"data"`
+		}
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+		assert.Equal(t, []string{"To Do", "Done"}, resp.Data.Statuses)
+		require.Len(t, resp.Data.Days, 1)
+		assert.Equal(t, []int{3, 1}, resp.Data.Days[0].Counts)
+	})
+
+	t.Run("cycle time", func(t *testing.T) {
+		c, rec := newReportContext("/reports/cycle-time", workspaceID.String(), userID)
+		require.NoError(t, handler.CycleTime(c))
+		assert.Equal(t, stdhttp.StatusOK, rec.Code)
+
+		var resp struct {
+			Data httphandler.CycleTimeResponse `json:"data"`
+		}
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+		assert.Equal(t, 90, resp.Data.WindowDays)
+		assert.Equal(t, 1, resp.Data.LeadTime.Count)
+		assert.InDelta(t, 48.0, resp.Data.LeadTime.MedianHours, 0.001)
+	})
+
+	t.Run("unauthenticated", func(t *testing.T) {
+		c, rec := newReportContext("/reports/cycle-time", workspaceID.String(), "")
+		require.NoError(t, handler.CycleTime(c))
+		assert.Equal(t, stdhttp.StatusUnauthorized, rec.Code)
+	})
+
+	t.Run("provider error", func(t *testing.T) {
+		failing := httphandler.NewReportHandler(nil, &stubSnapshotProvider{err: errors.New("boom")})
+		c, rec := newReportContext("/reports/cumulative-flow", workspaceID.String(), userID)
+		require.NoError(t, failing.CumulativeFlow(c))
+		assert.Equal(t, stdhttp.StatusInternalServerError, rec.Code)
+	})
+}
@@ -0,0 +1,247 @@
+package httphandler
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	reportapp "github.com/lllypuk/flowra/internal/application/report"
+	"github.com/lllypuk/flowra/internal/domain/uuid"
+)
+
+// percentScale converts ratios to bar widths.
+const percentScale = 100
+
+// ReportMembershipChecker checks that the viewer belongs to the workspace.
+// Declared on the consumer side per project guidelines.
+type ReportMembershipChecker interface {
+	IsMember(ctx context.Context, workspaceID, userID uuid.UUID) (bool, error)
+}
+
+// ReportsViewData represents the data needed to render the reports page.
+type ReportsViewData struct {
+	Workspace   WorkspaceViewData
+	GeneratedAt time.Time
+	Sprints     []string
+	Burndown    *BurndownViewData
+	Flow        FlowViewData
+	CycleTime   CycleTimeViewData
+}
+
+// BurndownViewData represents the burndown of the selected sprint.
+type BurndownViewData struct {
+	Sprint    string
+	StartDate time.Time
+	EndDate   time.Time
+	Days      []BurndownDayViewData
+}
+
+// BurndownDayViewData represents a single burndown row with its bar width.
+type BurndownDayViewData struct {
+	Date            time.Time
+	ScopeTasks      int
+	RemainingTasks  int
+	RemainingPoints float64
+	RemainingHours  float64
+	RemainingPct    int
+}
+
+// FlowViewData represents the cumulative flow table.
+type FlowViewData struct {
+	Statuses []string
+	Days     []FlowDayViewData
+}
+
+// FlowDayViewData represents the status counts of one day.
+type FlowDayViewData struct {
+	Date     time.Time
+	Total    int
+	Segments []FlowSegmentViewData
+}
+
+// FlowSegmentViewData represents one status of a stacked flow bar.
+type FlowSegmentViewData struct {
+	Status string
+	Count  int
+	Pct    int
+}
+
+// CycleTimeViewData represents lead and cycle time statistics.
+type CycleTimeViewData struct {
+	WindowDays int
+	LeadTime   reportapp.DurationStats
+	CycleTime  reportapp.DurationStats
+}
+
+// ReportTemplateHandler renders the workspace reports page.
+type ReportTemplateHandler struct {
+	renderer  *TemplateRenderer
+	logger    *slog.Logger
+	snapshots ReportSnapshotProvider
+	members   ReportMembershipChecker
+}
+
+// NewReportTemplateHandler creates a new report template handler.
+func NewReportTemplateHandler(
+	renderer *TemplateRenderer,
+	logger *slog.Logger,
+	snapshots ReportSnapshotProvider,
+	members ReportMembershipChecker,
+) *ReportTemplateHandler {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &ReportTemplateHandler{
+		renderer:  renderer,
+		logger:    logger,
+		snapshots: snapshots,
+		members:   members,
+	}
+}
+
+// SetupReportRoutes registers report page routes.
+func (h *ReportTemplateHandler) SetupReportRoutes(e *echo.Echo) {
+	workspaces := e.Group("/workspaces", RequireAuth)
+	workspaces.GET("/:workspace_id/reports", h.ReportsIndex)
+}
+
+// ReportsIndex renders the burndown, cumulative flow and cycle time reports.
+// The sprint query parameter selects the burndown; the latest sprint is the default.
+func (h *ReportTemplateHandler) ReportsIndex(c echo.Context) error {
+	user := getUserView(c)
+	if user == nil {
+		return c.Redirect(http.StatusFound, "/login")
+	}
+
+	workspaceID, err := uuid.ParseUUID(c.Param("workspace_id"))
+	if err != nil {
+		return c.String(http.StatusNotFound, "Page not found")
+	}
+	userID, err := uuid.ParseUUID(user.ID)
+	if err != nil {
+		return c.String(http.StatusNotFound, "Page not found")
+	}
+
+	ctx := c.Request().Context()
+	if h.members != nil {
+		isMember, memberErr := h.members.IsMember(ctx, workspaceID, userID)
+		if memberErr != nil || !isMember {
+			return c.String(http.StatusNotFound, "Page not found")
+		}
+	}
+
+	if h.snapshots == nil {
+		return c.String(http.StatusServiceUnavailable, "Service unavailable")
+	}
+	snapshot, err := h.snapshots.Execute(ctx, reportapp.GetQuery{WorkspaceID: workspaceID})
+	if err != nil {
+		h.logger.ErrorContext(ctx, "failed to load reports",
+			slog.String("workspace_id", workspaceID.String()),
+			slog.String("error", err.Error()),
+		)
+		return c.String(http.StatusInternalServerError, "Failed to load reports")
+	}
+
+	return h.render(c, "report/index", "Reports", buildReportsViewData(snapshot, c.QueryParam("sprint")))
+}
+
+func (h *ReportTemplateHandler) render(c echo.Context, templateName, title string, data any) error {
+	if h.renderer == nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "template renderer not configured")
+	}
+
+	pageData := PageData{
+		Title:           title,
+		User:            getUserView(c),
+		Data:            data,
+		ContentTemplate: "report-content",
+	}
+
+	c.Response().Header().Set("Content-Type", "text/html; charset=utf-8")
+	return h.renderer.Render(c.Response().Writer, templateName, pageData, c)
+}
+
+func buildReportsViewData(snapshot *reportapp.Snapshot, sprint string) ReportsViewData {
+	data := ReportsViewData{
+		Workspace:   WorkspaceViewData{ID: snapshot.WorkspaceID.String()},
+		GeneratedAt: snapshot.GeneratedAt,
+		Sprints:     make([]string, 0, len(snapshot.Burndowns)),
+		Flow:        buildFlowViewData(snapshot.CumulativeFlow),
+		CycleTime: CycleTimeViewData{
+			WindowDays: snapshot.CycleTime.WindowDays,
+			LeadTime:   snapshot.CycleTime.LeadTime,
+			CycleTime:  snapshot.CycleTime.CycleTime,
+		},
+	}
+	for _, b := range snapshot.Burndowns {
+		data.Sprints = append(data.Sprints, b.Sprint)
+	}
+
+	if sprint == "" && len(snapshot.Burndowns) > 0 {
+		sprint = snapshot.Burndowns[len(snapshot.Burndowns)-1].Sprint
+	}
+	if burndown, ok := snapshot.Burndown(sprint); ok {
+		data.Burndown = buildBurndownViewData(burndown)
+	}
+
+	return data
+}
+
+func buildBurndownViewData(burndown reportapp.SprintBurndown) *BurndownViewData {
+	peak := 0
+	for _, d := range burndown.Days {
+		peak = max(peak, d.ScopeTasks)
+	}
+
+	view := &BurndownViewData{
+		Sprint:    burndown.Sprint,
+		StartDate: burndown.StartDate,
+		EndDate:   burndown.EndDate,
+		Days:      make([]BurndownDayViewData, 0, len(burndown.Days)),
+	}
+	for _, d := range burndown.Days {
+		view.Days = append(view.Days, BurndownDayViewData{
+			Date:            d.Date,
+			ScopeTasks:      d.ScopeTasks,
+			RemainingTasks:  d.RemainingTasks,
+			RemainingPoints: d.RemainingPoints,
+			RemainingHours:  d.RemainingHours,
+			RemainingPct:    percentOf(d.RemainingTasks, peak),
+		})
+	}
+	return view
+}
+
+func buildFlowViewData(flow reportapp.CumulativeFlow) FlowViewData {
+	view := FlowViewData{
+		Statuses: flow.Statuses,
+		Days:     make([]FlowDayViewData, 0, len(flow.Days)),
+	}
+	for _, d := range flow.Days {
+		day := FlowDayViewData{Date: d.Date}
+		for _, count := range d.Counts {
+			day.Total += count
+		}
+		for i, count := range d.Counts {
+			if i >= len(flow.Statuses) {
+				break
+			}
+			day.Segments = append(day.Segments, FlowSegmentViewData{
+				Status: flow.Statuses[i],
+				Count:  count,
+				Pct:    percentOf(count, day.Total),
+			})
+		}
+		view.Days = append(view.Days, day)
+	}
+	return view
+}
+
+func percentOf(part, total int) int {
+	if total <= 0 {
+		return 0
+	}
+	return part * percentScale / total
+}
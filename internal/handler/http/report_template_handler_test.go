@@ -0,0 +1,109 @@
+package httphandler_test
+
+import (
+	"context"
+	stdhttp "net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	reportapp "github.com/lllypuk/flowra/internal/application/report"
+	"github.com/lllypuk/flowra/internal/domain/uuid"
+	httphandler "github.com/lllypuk/flowra/internal/handler/http"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type stubReportMembership struct {
+	member bool
+}
+
+func (s *stubReportMembership) IsMember(_ context.Context, _, _ uuid.UUID) (bool, error) {
+	return s.member, nil
+}
+
+func newReportsPageContext(target, workspaceID string, userID uuid.UUID) (echo.Context, *httptest.ResponseRecorder) {
+	e := echo.New()
+	req := httptest.NewRequest(stdhttp.MethodGet, target, nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetParamNames("workspace_id")
+	c.SetParamValues(workspaceID)
+	if !userID.IsZero() {
+		setupUserAuthContext(c, userID)
+	}
+	return c, rec
+}
+
+func TestReportTemplateHandler_ReportsIndex(t *testing.T) {
+	workspaceID := uuid.NewUUID()
+	userID := uuid.NewUUID()
+	day := time.Date(2026, time.March, 2, 0, 0, 0, 0, time.UTC)
+	snapshot := &reportapp.Snapshot{
+		WorkspaceID: workspaceID,
+		GeneratedAt: time.Now(),
+		Burndowns: []reportapp.SprintBurndown{
+			{Sprint: "Sprint 1", StartDate: day, EndDate: day, Days: []reportapp.BurndownDay{
+				{Date: day, ScopeTasks: 2, RemainingTasks: 1},
+			}},
+			{Sprint: "Sprint 2", StartDate: day, EndDate: day, Days: []reportapp.BurndownDay{
+				{Date: day, ScopeTasks: 4, RemainingTasks: 3, RemainingPoints: 5},
+			}},
+		},
+		CumulativeFlow: reportapp.CumulativeFlow{
+			Statuses: []string{"To Do", "Done"},
+			Days:     []reportapp.FlowDay{{Date: day, Counts: []int{3, 1}}},
+		},
+		CycleTime: reportapp.CycleTimeReport{
+			WindowDays: 90,
+			CycleTime:  reportapp.DurationStats{Count: 2, AverageHours: 12.5, MedianHours: 10, P85Hours: 15},
+		},
+	}
+
+	t.Run("renders reports of the latest sprint", func(t *testing.T) {
+		handler := httphandler.NewReportTemplateHandler(
+			newTestRenderer(t), nil,
+			&stubSnapshotProvider{snapshot: snapshot},
+			&stubReportMembership{member: true},
+		)
+		c, rec := newReportsPageContext("/workspaces/"+workspaceID.String()+"/reports", workspaceID.String(), userID)
+
+		require.NoError(t, handler.ReportsIndex(c))
+		assert.Equal(t, stdhttp.StatusOK, rec.Code)
+		body := rec.Body.String()
+		assert.Contains(t, body, `<option value="Sprint 2" selected>`)
+		assert.Contains(t, body, "3 / 4")
+		assert.Contains(t, body, "width: 75%")
+		assert.Contains(t, body, "Cumulative flow")
+		assert.Contains(t, body, "12.5")
+	})
+
+	t.Run("selects the requested sprint", func(t *testing.T) {
+		handler := httphandler.NewReportTemplateHandler(
+			newTestRenderer(t), nil, &stubSnapshotProvider{snapshot: snapshot}, nil)
+		c, rec := newReportsPageContext("/reports?sprint=Sprint+1", workspaceID.String(), userID)
+
+		require.NoError(t, handler.ReportsIndex(c))
+		assert.Contains(t, rec.Body.String(), `<option value="Sprint 1" selected>`)
+		assert.Contains(t, rec.Body.String(), "1 / 2")
+	})
+
+	t.Run("unauthenticated redirects to login", func(t *testing.T) {
+		handler := httphandler.NewReportTemplateHandler(nil, nil, &stubSnapshotProvider{snapshot: snapshot}, nil)
+		c, rec := newReportsPageContext("/reports", workspaceID.String(), "")
+
+		require.NoError(t, handler.ReportsIndex(c))
+		assert.Equal(t, stdhttp.StatusFound, rec.Code)
+		assert.Equal(t, "/login", rec.Header().Get("Location"))
+	})
+
+	t.Run("non-member gets not found", func(t *testing.T) {
+		handler := httphandler.NewReportTemplateHandler(
+			nil, nil, &stubSnapshotProvider{snapshot: snapshot}, &stubReportMembership{})
+		c, rec := newReportsPageContext("/reports", workspaceID.String(), userID)
+
+		require.NoError(t, handler.ReportsIndex(c))
+		assert.Equal(t, stdhttp.StatusNotFound, rec.Code)
+	})
+}
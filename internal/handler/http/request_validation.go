@@ -0,0 +1,61 @@
+package httphandler
+
+import (
+	"slices"
+
+	"github.com/lllypuk/flowra/internal/domain/uuid"
+	"github.com/lllypuk/flowra/internal/pkg/validate"
+)
+
+// Custom validation rules of request DTOs, used in `validate` struct tags.
+const (
+	// ruleUUID accepts a UUID in its canonical text form.
+	ruleUUID = "uuid"
+
+	// ruleRole accepts the roles that can be granted through the API: admin and member.
+	ruleRole = "role"
+
+	// ruleChatType accepts the chat types a chat can be created with.
+	ruleChatType = "chat_type"
+)
+
+// chatTypes are the values accepted by the chat_type rule.
+//
+//nolint:gochecknoglobals // fixed lookup table
+var chatTypes = []string{
+	chatTypeDiscussion,
+	chatTypeTask,
+	chatTypeBug,
+	chatTypeEpic,
+	"direct",
+	"group",
+	"channel",
+}
+
+// requestValidator checks the request DTOs of all handlers. It is configured once
+// and only read afterwards.
+//
+//nolint:gochecknoglobals // read-only after initialization
+var requestValidator = newRequestValidator()
+
+func newRequestValidator() *validate.Validator {
+	v := validate.New()
+	v.Register(ruleUUID, "must be a valid UUID", func(s string) bool {
+		_, err := uuid.ParseUUID(s)
+		return err == nil
+	})
+	v.Register(ruleRole, "must be one of: admin, member", func(s string) bool {
+		return s == roleAdmin || s == roleMember
+	})
+	v.Register(ruleChatType, "must be one of: discussion, task, bug, epic, direct, group, channel",
+		func(s string) bool {
+			return slices.Contains(chatTypes, s)
+		})
+	return v
+}
+
+// validateRequest checks req against its `validate` struct tags and returns
+// validate.Errors describing every invalid field.
+func validateRequest(req any) error {
+	return requestValidator.Struct(req)
+}
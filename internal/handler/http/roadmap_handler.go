@@ -0,0 +1,140 @@
+package httphandler
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	taskapp "github.com/lllypuk/flowra/internal/application/task"
+	"github.com/lllypuk/flowra/internal/domain/uuid"
+	"github.com/lllypuk/flowra/internal/infrastructure/httpserver"
+	"github.com/lllypuk/flowra/internal/middleware"
+)
+
+// RoadmapBuilder builds the epic roadmap of a workspace.
+// Declared on the consumer side per project guidelines.
+type RoadmapBuilder interface {
+	Execute(ctx context.Context, query taskapp.RoadmapQuery) (*taskapp.Roadmap, error)
+}
+
+// RoadmapOwnerResponse represents an assignee of an epic or its children.
+type RoadmapOwnerResponse struct {
+	UserID      string `json:"user_id"`
+	Username    string `json:"username,omitempty"`
+	DisplayName string `json:"display_name,omitempty"`
+	TaskCount   int    `json:"task_count"`
+	Lead        bool   `json:"lead"`
+}
+
+// RoadmapEpicResponse represents an epic rollup in API responses.
+type RoadmapEpicResponse struct {
+	EpicID          string                 `json:"epic_id"`
+	Title           string                 `json:"title"`
+	Status          string                 `json:"status"`
+	Priority        string                 `json:"priority,omitempty"`
+	StartDate       string                 `json:"start_date"`
+	EndDate         *string                `json:"end_date,omitempty"`
+	Sprints         []string               `json:"sprints"`
+	ChildCount      int                    `json:"child_count"`
+	StatusBreakdown map[string]int         `json:"status_breakdown"`
+	Progress        EpicProgressResponse   `json:"progress"`
+	Owners          []RoadmapOwnerResponse `json:"owners"`
+}
+
+// RoadmapResponse represents the workspace roadmap in API responses.
+type RoadmapResponse struct {
+	WorkspaceID string                `json:"workspace_id"`
+	Epics       []RoadmapEpicResponse `json:"epics"`
+}
+
+// RoadmapHandler handles roadmap HTTP requests.
+type RoadmapHandler struct {
+	roadmap RoadmapBuilder
+}
+
+// NewRoadmapHandler creates a new RoadmapHandler.
+func NewRoadmapHandler(roadmap RoadmapBuilder) *RoadmapHandler {
+	return &RoadmapHandler{roadmap: roadmap}
+}
+
+// Roadmap handles GET /api/v1/workspaces/:workspace_id/roadmap.
+// Returns the open epics with date ranges, progress and owners; include_completed=true
+// also returns done and cancelled epics.
+func (h *RoadmapHandler) Roadmap(c echo.Context) error {
+	userID := middleware.GetUserID(c)
+	if userID.IsZero() {
+		return httpserver.RespondErrorWithCode(c, http.StatusUnauthorized, "UNAUTHORIZED", "authentication required")
+	}
+
+	workspaceID, parseErr := uuid.ParseUUID(c.Param("workspace_id"))
+	if parseErr != nil {
+		return httpserver.RespondErrorWithCode(
+			c, http.StatusBadRequest, "INVALID_WORKSPACE_ID", "invalid workspace ID format")
+	}
+
+	query := taskapp.RoadmapQuery{WorkspaceID: workspaceID}
+	if raw := c.QueryParam("include_completed"); raw != "" {
+		includeCompleted, err := strconv.ParseBool(raw)
+		if err != nil {
+			return httpserver.RespondErrorWithCode(
+				c, http.StatusBadRequest, "VALIDATION_ERROR", "include_completed must be a boolean")
+		}
+		query.IncludeCompleted = includeCompleted
+	}
+
+	roadmap, err := h.roadmap.Execute(c.Request().Context(), query)
+	if err != nil {
+		return httpserver.RespondErrorWithCode(
+			c, http.StatusInternalServerError, "INTERNAL_ERROR", "failed to build roadmap")
+	}
+
+	return httpserver.RespondOK(c, ToRoadmapResponse(roadmap))
+}
+
+// ToRoadmapResponse converts the roadmap to its API response.
+func ToRoadmapResponse(roadmap *taskapp.Roadmap) RoadmapResponse {
+	resp := RoadmapResponse{
+		WorkspaceID: roadmap.WorkspaceID.String(),
+		Epics:       make([]RoadmapEpicResponse, 0, len(roadmap.Epics)),
+	}
+
+	for _, epic := range roadmap.Epics {
+		item := RoadmapEpicResponse{
+			EpicID:          epic.EpicID.String(),
+			Title:           epic.Title,
+			Status:          string(epic.Status),
+			Priority:        string(epic.Priority),
+			StartDate:       epic.StartDate.Format(time.DateOnly),
+			Sprints:         epic.Sprints,
+			ChildCount:      epic.ChildCount,
+			StatusBreakdown: make(map[string]int, len(epic.StatusBreakdown)),
+			Progress: EpicProgressResponse{
+				Total:   epic.Progress.Total,
+				Done:    epic.Progress.Done,
+				Percent: epic.Progress.Percent,
+			},
+			Owners: make([]RoadmapOwnerResponse, 0, len(epic.Owners)),
+		}
+		if epic.EndDate != nil {
+			endDate := epic.EndDate.Format(time.DateOnly)
+			item.EndDate = &endDate
+		}
+		for status, count := range epic.StatusBreakdown {
+			item.StatusBreakdown[string(status)] = count
+		}
+		for _, owner := range epic.Owners {
+			item.Owners = append(item.Owners, RoadmapOwnerResponse{
+				UserID:      owner.UserID.String(),
+				Username:    owner.Username,
+				DisplayName: owner.DisplayName,
+				TaskCount:   owner.TaskCount,
+				Lead:        owner.Lead,
+			})
+		}
+		resp.Epics = append(resp.Epics, item)
+	}
+
+	return resp
+}
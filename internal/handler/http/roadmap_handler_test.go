@@ -0,0 +1,105 @@
+package httphandler_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	stdhttp "net/http"
+	"testing"
+	"time"
+
+	taskapp "github.com/lllypuk/flowra/internal/application/task"
+	taskdomain "github.com/lllypuk/flowra/internal/domain/task"
+	"github.com/lllypuk/flowra/internal/domain/uuid"
+	httphandler "github.com/lllypuk/flowra/internal/handler/http"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type stubRoadmapBuilder struct {
+	roadmap   *taskapp.Roadmap
+	err       error
+	lastQuery taskapp.RoadmapQuery
+}
+
+func (s *stubRoadmapBuilder) Execute(_ context.Context, query taskapp.RoadmapQuery) (*taskapp.Roadmap, error) {
+	s.lastQuery = query
+	if s.err != nil {
+		return nil, s.err
+	}
+	return s.roadmap, nil
+}
+
+func testRoadmap(workspaceID uuid.UUID) *taskapp.Roadmap {
+	start := time.Date(2026, time.March, 2, 9, 0, 0, 0, time.UTC)
+	end := start.Add(14 * 24 * time.Hour)
+	return &taskapp.Roadmap{
+		WorkspaceID: workspaceID,
+		Epics: []taskapp.RoadmapEpic{{
+			EpicID:          uuid.NewUUID(),
+			Title:           "Checkout redesign",
+			Status:          taskdomain.StatusInProgress,
+			StartDate:       start,
+			EndDate:         &end,
+			Sprints:         []string{"Sprint 10"},
+			ChildCount:      4,
+			StatusBreakdown: map[taskdomain.Status]int{taskdomain.StatusDone: 1, taskdomain.StatusToDo: 3},
+			Progress:        taskapp.RoadmapProgress{Total: 4, Done: 1, Percent: 25},
+			Owners:          []taskapp.RoadmapOwner{{UserID: uuid.NewUUID(), DisplayName: "Ada", TaskCount: 2}},
+		}},
+	}
+}
+
+func TestRoadmapHandler_Roadmap(t *testing.T) {
+	workspaceID := uuid.NewUUID()
+	userID := uuid.NewUUID()
+
+	t.Run("returns roadmap", func(t *testing.T) {
+		builder := &stubRoadmapBuilder{roadmap: testRoadmap(workspaceID)}
+		handler := httphandler.NewRoadmapHandler(builder)
+
+		c, rec := newReportContext("/roadmap?include_completed=true", workspaceID.String(), userID)
+		require.NoError(t, handler.Roadmap(c))
+		assert.Equal(t, stdhttp.StatusOK, rec.Code)
+		assert.Equal(t, workspaceID, builder.lastQuery.WorkspaceID)
+		assert.True(t, builder.lastQuery.IncludeCompleted)
+
+		var resp struct {
+			Data httphandler.RoadmapResponse `json:"data"`
+		}
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+		require.Len(t, resp.Data.Epics, 1)
+		epic := resp.Data.Epics[0]
+		assert.Equal(t, "2026-03-02", epic.StartDate)
+		require.NotNil(t, epic.EndDate)
+		assert.Equal(t, "2026-03-16", *epic.EndDate)
+		assert.Equal(t, 3, epic.StatusBreakdown["To Do"])
+		assert.Equal(t, 25, epic.Progress.Percent)
+		require.Len(t, epic.Owners, 1)
+		assert.Equal(t, "Ada", epic.Owners[0].DisplayName)
+	})
+
+	t.Run("invalid include_completed", func(t *testing.T) {
+		handler := httphandler.NewRoadmapHandler(&stubRoadmapBuilder{})
+
+		c, rec := newReportContext("/roadmap?include_completed=maybe", workspaceID.String(), userID)
+		require.NoError(t, handler.Roadmap(c))
+		assert.Equal(t, stdhttp.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("builder failure", func(t *testing.T) {
+		handler := httphandler.NewRoadmapHandler(&stubRoadmapBuilder{err: errors.New("boom")})
+
+		c, rec := newReportContext("/roadmap", workspaceID.String(), userID)
+		require.NoError(t, handler.Roadmap(c))
+		assert.Equal(t, stdhttp.StatusInternalServerError, rec.Code)
+	})
+
+	t.Run("unauthenticated", func(t *testing.T) {
+		handler := httphandler.NewRoadmapHandler(&stubRoadmapBuilder{})
+
+		c, rec := newReportContext("/roadmap", workspaceID.String(), "")
+		require.NoError(t, handler.Roadmap(c))
+		assert.Equal(t, stdhttp.StatusUnauthorized, rec.Code)
+	})
+}
@@ -0,0 +1,163 @@
+package httphandler
+
+import (
+	"log/slog"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	taskapp "github.com/lllypuk/flowra/internal/application/task"
+	"github.com/lllypuk/flowra/internal/domain/uuid"
+)
+
+// RoadmapViewData represents the data needed to render the roadmap page.
+type RoadmapViewData struct {
+	Workspace        WorkspaceViewData
+	IncludeCompleted bool
+	Epics            []RoadmapEpicViewData
+}
+
+// RoadmapEpicViewData represents an epic row of the roadmap.
+type RoadmapEpicViewData struct {
+	ID        string
+	Title     string
+	Status    string
+	StartDate time.Time
+	EndDate   time.Time // zero when neither the epic nor its children are due
+	Sprints   []string
+	Children  int
+	Done      int
+	Total     int
+	Percent   int
+	Owners    []string
+}
+
+// RoadmapTemplateHandler renders the workspace roadmap page.
+type RoadmapTemplateHandler struct {
+	renderer *TemplateRenderer
+	logger   *slog.Logger
+	roadmap  RoadmapBuilder
+	members  ReportMembershipChecker
+}
+
+// NewRoadmapTemplateHandler creates a new roadmap template handler.
+func NewRoadmapTemplateHandler(
+	renderer *TemplateRenderer,
+	logger *slog.Logger,
+	roadmap RoadmapBuilder,
+	members ReportMembershipChecker,
+) *RoadmapTemplateHandler {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &RoadmapTemplateHandler{
+		renderer: renderer,
+		logger:   logger,
+		roadmap:  roadmap,
+		members:  members,
+	}
+}
+
+// SetupRoadmapRoutes registers roadmap page routes.
+func (h *RoadmapTemplateHandler) SetupRoadmapRoutes(e *echo.Echo) {
+	workspaces := e.Group("/workspaces", RequireAuth)
+	workspaces.GET("/:workspace_id/roadmap", h.RoadmapIndex)
+}
+
+// RoadmapIndex renders the epics of the workspace with their date ranges and progress.
+// The completed query parameter also shows done and cancelled epics.
+func (h *RoadmapTemplateHandler) RoadmapIndex(c echo.Context) error {
+	user := getUserView(c)
+	if user == nil {
+		return c.Redirect(http.StatusFound, "/login")
+	}
+
+	workspaceID, err := uuid.ParseUUID(c.Param("workspace_id"))
+	if err != nil {
+		return c.String(http.StatusNotFound, "Page not found")
+	}
+	userID, err := uuid.ParseUUID(user.ID)
+	if err != nil {
+		return c.String(http.StatusNotFound, "Page not found")
+	}
+
+	ctx := c.Request().Context()
+	if h.members != nil {
+		isMember, memberErr := h.members.IsMember(ctx, workspaceID, userID)
+		if memberErr != nil || !isMember {
+			return c.String(http.StatusNotFound, "Page not found")
+		}
+	}
+
+	if h.roadmap == nil {
+		return c.String(http.StatusServiceUnavailable, "Service unavailable")
+	}
+	includeCompleted, _ := strconv.ParseBool(c.QueryParam("completed"))
+	roadmap, err := h.roadmap.Execute(ctx, taskapp.RoadmapQuery{
+		WorkspaceID:      workspaceID,
+		IncludeCompleted: includeCompleted,
+	})
+	if err != nil {
+		h.logger.ErrorContext(ctx, "failed to build roadmap",
+			slog.String("workspace_id", workspaceID.String()),
+			slog.String("error", err.Error()),
+		)
+		return c.String(http.StatusInternalServerError, "Failed to load roadmap")
+	}
+
+	return h.render(c, "report/roadmap", "Roadmap", buildRoadmapViewData(roadmap, includeCompleted))
+}
+
+func (h *RoadmapTemplateHandler) render(c echo.Context, templateName, title string, data any) error {
+	if h.renderer == nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "template renderer not configured")
+	}
+
+	pageData := PageData{
+		Title:           title,
+		User:            getUserView(c),
+		Data:            data,
+		ContentTemplate: "roadmap-content",
+	}
+
+	c.Response().Header().Set("Content-Type", "text/html; charset=utf-8")
+	return h.renderer.Render(c.Response().Writer, templateName, pageData, c)
+}
+
+func buildRoadmapViewData(roadmap *taskapp.Roadmap, includeCompleted bool) RoadmapViewData {
+	data := RoadmapViewData{
+		Workspace:        WorkspaceViewData{ID: roadmap.WorkspaceID.String()},
+		IncludeCompleted: includeCompleted,
+		Epics:            make([]RoadmapEpicViewData, 0, len(roadmap.Epics)),
+	}
+
+	for _, epic := range roadmap.Epics {
+		view := RoadmapEpicViewData{
+			ID:        epic.EpicID.String(),
+			Title:     epic.Title,
+			Status:    string(epic.Status),
+			StartDate: epic.StartDate,
+			Sprints:   epic.Sprints,
+			Children:  epic.ChildCount,
+			Done:      epic.Progress.Done,
+			Total:     epic.Progress.Total,
+			Percent:   epic.Progress.Percent,
+		}
+		if epic.EndDate != nil {
+			view.EndDate = *epic.EndDate
+		}
+		for _, owner := range epic.Owners {
+			name := owner.DisplayName
+			if name == "" {
+				name = owner.Username
+			}
+			if name != "" {
+				view.Owners = append(view.Owners, name)
+			}
+		}
+		data.Epics = append(data.Epics, view)
+	}
+
+	return data
+}
@@ -0,0 +1,47 @@
+package httphandler_test
+
+import (
+	stdhttp "net/http"
+	"testing"
+
+	"github.com/lllypuk/flowra/internal/domain/uuid"
+	httphandler "github.com/lllypuk/flowra/internal/handler/http"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRoadmapTemplateHandler_RoadmapIndex(t *testing.T) {
+	workspaceID := uuid.NewUUID()
+	userID := uuid.NewUUID()
+	target := "/workspaces/" + workspaceID.String() + "/roadmap"
+
+	t.Run("renders epics", func(t *testing.T) {
+		handler := httphandler.NewRoadmapTemplateHandler(
+			newTestRenderer(t), nil,
+			&stubRoadmapBuilder{roadmap: testRoadmap(workspaceID)},
+			&stubReportMembership{member: true},
+		)
+		c, rec := newReportsPageContext(target, workspaceID.String(), userID)
+
+		require.NoError(t, handler.RoadmapIndex(c))
+		assert.Equal(t, stdhttp.StatusOK, rec.Code)
+		body := rec.Body.String()
+		assert.Contains(t, body, "Checkout redesign")
+		assert.Contains(t, body, "Mar 16, 2026")
+		assert.Contains(t, body, "Sprint 10")
+		assert.Contains(t, body, "Ada")
+		assert.Contains(t, body, "width: 25%")
+	})
+
+	t.Run("non-member gets not found", func(t *testing.T) {
+		handler := httphandler.NewRoadmapTemplateHandler(
+			newTestRenderer(t), nil,
+			&stubRoadmapBuilder{roadmap: testRoadmap(workspaceID)},
+			&stubReportMembership{member: false},
+		)
+		c, rec := newReportsPageContext(target, workspaceID.String(), userID)
+
+		require.NoError(t, handler.RoadmapIndex(c))
+		assert.Equal(t, stdhttp.StatusNotFound, rec.Code)
+	})
+}
@@ -0,0 +1,188 @@
+package httphandler
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/lllypuk/flowra/internal/application/appcore"
+	searchapp "github.com/lllypuk/flowra/internal/application/search"
+	"github.com/lllypuk/flowra/internal/domain/uuid"
+	"github.com/lllypuk/flowra/internal/infrastructure/httpserver"
+	"github.com/lllypuk/flowra/internal/middleware"
+)
+
+// WorkspaceSearcher searches the messages, chats and task titles of a workspace.
+// Declared on the consumer side per project guidelines.
+type WorkspaceSearcher interface {
+	Execute(ctx context.Context, query searchapp.Query) (searchapp.Result, error)
+}
+
+// SearchMessageResponse represents a message matching a search.
+type SearchMessageResponse struct {
+	ID        string `json:"id"`
+	ChatID    string `json:"chat_id"`
+	ChatTitle string `json:"chat_title"`
+	AuthorID  string `json:"author_id,omitempty"`
+	Snippet   string `json:"snippet"`
+	CreatedAt string `json:"created_at"`
+	URL       string `json:"url"`
+}
+
+// SearchChatResponse represents a chat or task matching a search by title.
+type SearchChatResponse struct {
+	ID             string `json:"id"`
+	Title          string `json:"title"`
+	Type           string `json:"type"`
+	Status         string `json:"status,omitempty"`
+	LastActivityAt string `json:"last_activity_at"`
+	URL            string `json:"url"`
+}
+
+// SearchMessageSection is a page of matching messages.
+type SearchMessageSection struct {
+	Items   []SearchMessageResponse `json:"items"`
+	Total   int                     `json:"total"`
+	HasMore bool                    `json:"has_more"`
+}
+
+// SearchChatSection is a page of matching chats or tasks.
+type SearchChatSection struct {
+	Items   []SearchChatResponse `json:"items"`
+	Total   int                  `json:"total"`
+	HasMore bool                 `json:"has_more"`
+}
+
+// SearchResponse holds a section per searched result type.
+type SearchResponse struct {
+	Query    string                `json:"query"`
+	Messages *SearchMessageSection `json:"messages,omitempty"`
+	Chats    *SearchChatSection    `json:"chats,omitempty"`
+	Tasks    *SearchChatSection    `json:"tasks,omitempty"`
+}
+
+// SearchHandler handles workspace full-text search.
+type SearchHandler struct {
+	searcher WorkspaceSearcher
+}
+
+// NewSearchHandler creates a new SearchHandler.
+func NewSearchHandler(searcher WorkspaceSearcher) *SearchHandler {
+	return &SearchHandler{searcher: searcher}
+}
+
+// Search handles GET /api/v1/workspaces/:workspace_id/search?q=&type=&offset=&limit=.
+// type is a comma-separated list of message, chat and task; all are searched by
+// default. offset and limit page every type separately.
+func (h *SearchHandler) Search(c echo.Context) error {
+	userID := middleware.GetUserID(c)
+	if userID.IsZero() {
+		return httpserver.RespondErrorWithCode(c, http.StatusUnauthorized, "UNAUTHORIZED", "authentication required")
+	}
+
+	query, code, message := parseSearchQuery(c, userID)
+	if code != "" {
+		return httpserver.RespondErrorWithCode(c, http.StatusBadRequest, code, message)
+	}
+
+	result, err := h.searcher.Execute(c.Request().Context(), query)
+	if err != nil {
+		var validationErr *appcore.ValidationError
+		if errors.As(err, &validationErr) {
+			return httpserver.RespondErrorWithCode(c, http.StatusBadRequest, "VALIDATION_ERROR", validationErr.Error())
+		}
+		return httpserver.RespondErrorWithCode(c, http.StatusInternalServerError, "INTERNAL_ERROR", "search failed")
+	}
+
+	return httpserver.RespondOK(c, ToSearchResponse(query.WorkspaceID, result))
+}
+
+// parseSearchQuery reads the search query parameters. It returns an error code
+// and message for a bad request.
+func parseSearchQuery(c echo.Context, userID uuid.UUID) (searchapp.Query, string, string) {
+	workspaceID, err := uuid.ParseUUID(c.Param("workspace_id"))
+	if err != nil {
+		return searchapp.Query{}, "INVALID_WORKSPACE_ID", "invalid workspace ID format"
+	}
+
+	query := searchapp.Query{
+		WorkspaceID: workspaceID,
+		UserID:      userID,
+		Text:        c.QueryParam("q"),
+	}
+	for raw := range strings.SplitSeq(c.QueryParam("type"), ",") {
+		if raw = strings.TrimSpace(raw); raw != "" {
+			query.Types = append(query.Types, searchapp.ResultType(raw))
+		}
+	}
+	for name, target := range map[string]*int{"offset": &query.Offset, "limit": &query.Limit} {
+		raw := c.QueryParam(name)
+		if raw == "" {
+			continue
+		}
+		value, convErr := strconv.Atoi(raw)
+		if convErr != nil || value < 0 {
+			return searchapp.Query{}, "VALIDATION_ERROR", name + " must be a non-negative integer"
+		}
+		*target = value
+	}
+	return query, "", ""
+}
+
+// ToSearchResponse converts search results to their API representation.
+func ToSearchResponse(workspaceID uuid.UUID, result searchapp.Result) SearchResponse {
+	resp := SearchResponse{Query: result.Text}
+	if result.Messages != nil {
+		items := make([]SearchMessageResponse, 0, len(result.Messages.Hits))
+		for _, hit := range result.Messages.Hits {
+			items = append(items, SearchMessageResponse{
+				ID:        hit.MessageID.String(),
+				ChatID:    hit.ChatID.String(),
+				ChatTitle: hit.ChatTitle,
+				AuthorID:  hit.AuthorID.String(),
+				Snippet:   hit.Snippet,
+				CreatedAt: hit.CreatedAt.Format(time.RFC3339),
+				URL:       chatPageURL(workspaceID.String(), hit.ChatID.String(), hit.MessageID.String()),
+			})
+		}
+		resp.Messages = &SearchMessageSection{
+			Items:   items,
+			Total:   result.Messages.Total,
+			HasMore: result.Offset+len(items) < result.Messages.Total,
+		}
+	}
+	if result.Chats != nil {
+		resp.Chats = toSearchChatSection(workspaceID, result.Offset, *result.Chats)
+	}
+	if result.Tasks != nil {
+		resp.Tasks = toSearchChatSection(workspaceID, result.Offset, *result.Tasks)
+	}
+	return resp
+}
+
+func toSearchChatSection(workspaceID uuid.UUID, offset int, page searchapp.ChatPage) *SearchChatSection {
+	items := make([]SearchChatResponse, 0, len(page.Hits))
+	for _, hit := range page.Hits {
+		items = append(items, SearchChatResponse{
+			ID:             hit.ChatID.String(),
+			Title:          hit.Title,
+			Type:           string(hit.Type),
+			Status:         hit.Status,
+			LastActivityAt: hit.LastActivity.Format(time.RFC3339),
+			URL:            searchChatURL(workspaceID, hit),
+		})
+	}
+	return &SearchChatSection{Items: items, Total: page.Total, HasMore: offset+len(items) < page.Total}
+}
+
+// searchChatURL links discussions to their chat page and typed chats to their task page.
+func searchChatURL(workspaceID uuid.UUID, hit searchapp.ChatHit) string {
+	if isTaskType(string(hit.Type)) {
+		return "/tasks/" + hit.ChatID.String()
+	}
+	return chatPageURL(workspaceID.String(), hit.ChatID.String(), "")
+}
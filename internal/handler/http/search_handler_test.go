@@ -0,0 +1,175 @@
+package httphandler_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	stdhttp "net/http"
+	"testing"
+	"time"
+
+	"github.com/lllypuk/flowra/internal/application/appcore"
+	searchapp "github.com/lllypuk/flowra/internal/application/search"
+	"github.com/lllypuk/flowra/internal/domain/chat"
+	"github.com/lllypuk/flowra/internal/domain/uuid"
+	httphandler "github.com/lllypuk/flowra/internal/handler/http"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeWorkspaceSearcher struct {
+	result  searchapp.Result
+	err     error
+	queries []searchapp.Query
+}
+
+func (f *fakeWorkspaceSearcher) Execute(_ context.Context, query searchapp.Query) (searchapp.Result, error) {
+	f.queries = append(f.queries, query)
+	return f.result, f.err
+}
+
+func testSearchResult() (searchapp.Result, uuid.UUID, uuid.UUID, uuid.UUID) {
+	chatID, taskID, messageID := uuid.NewUUID(), uuid.NewUUID(), uuid.NewUUID()
+	now := time.Date(2026, time.March, 2, 10, 0, 0, 0, time.UTC)
+	return searchapp.Result{
+		Text: "release",
+		Messages: &searchapp.MessagePage{
+			Hits: []searchapp.MessageHit{{
+				MessageID: messageID, ChatID: chatID, ChatTitle: "General",
+				Snippet: "When is the release?", CreatedAt: now,
+			}},
+			Total: 3,
+		},
+		Chats: &searchapp.ChatPage{Hits: []searchapp.ChatHit{}},
+		Tasks: &searchapp.ChatPage{
+			Hits: []searchapp.ChatHit{{
+				ChatID: taskID, Title: "Release checklist", Type: chat.TypeTask, Status: "To Do", LastActivity: now,
+			}},
+			Total: 1,
+		},
+		Limit: 1,
+	}, chatID, taskID, messageID
+}
+
+func TestSearchHandler_Search(t *testing.T) {
+	workspaceID := uuid.NewUUID()
+	userID := uuid.NewUUID()
+	base := "/api/v1/workspaces/" + workspaceID.String() + "/search"
+
+	t.Run("returns a section per result type", func(t *testing.T) {
+		result, chatID, taskID, messageID := testSearchResult()
+		searcher := &fakeWorkspaceSearcher{result: result}
+		handler := httphandler.NewSearchHandler(searcher)
+		c, rec := newReportsPageContext(base+"?q=release&type=message,+task&offset=0&limit=1",
+			workspaceID.String(), userID)
+
+		require.NoError(t, handler.Search(c))
+		require.Equal(t, stdhttp.StatusOK, rec.Code)
+
+		require.Len(t, searcher.queries, 1)
+		query := searcher.queries[0]
+		assert.Equal(t, workspaceID, query.WorkspaceID)
+		assert.Equal(t, userID, query.UserID)
+		assert.Equal(t, "release", query.Text)
+		assert.Equal(t, []searchapp.ResultType{searchapp.TypeMessage, searchapp.TypeTask}, query.Types)
+		assert.Equal(t, 1, query.Limit)
+
+		var resp struct {
+			Data httphandler.SearchResponse `json:"data"`
+		}
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+		require.NotNil(t, resp.Data.Messages)
+		assert.Equal(t, 3, resp.Data.Messages.Total)
+		assert.True(t, resp.Data.Messages.HasMore)
+		assert.Equal(t, fmt.Sprintf("/workspaces/%s/chats/%s?message=%s", workspaceID, chatID, messageID),
+			resp.Data.Messages.Items[0].URL)
+		require.NotNil(t, resp.Data.Tasks)
+		assert.False(t, resp.Data.Tasks.HasMore)
+		assert.Equal(t, "/tasks/"+taskID.String(), resp.Data.Tasks.Items[0].URL)
+		require.NotNil(t, resp.Data.Chats)
+		assert.Empty(t, resp.Data.Chats.Items)
+	})
+
+	t.Run("rejects bad pagination", func(t *testing.T) {
+		searcher := &fakeWorkspaceSearcher{}
+		c, rec := newReportsPageContext(base+"?q=release&limit=-1", workspaceID.String(), userID)
+
+		require.NoError(t, httphandler.NewSearchHandler(searcher).Search(c))
+		assert.Equal(t, stdhttp.StatusBadRequest, rec.Code)
+		assert.Empty(t, searcher.queries)
+	})
+
+	t.Run("rejects invalid workspace ID", func(t *testing.T) {
+		searcher := &fakeWorkspaceSearcher{}
+		c, rec := newReportsPageContext("/api/v1/workspaces/nope/search?q=release", "nope", userID)
+
+		require.NoError(t, httphandler.NewSearchHandler(searcher).Search(c))
+		assert.Equal(t, stdhttp.StatusBadRequest, rec.Code)
+		assert.Empty(t, searcher.queries)
+	})
+
+	t.Run("maps validation errors to bad request", func(t *testing.T) {
+		searcher := &fakeWorkspaceSearcher{
+			err: fmt.Errorf("validation failed: %w", appcore.NewValidationError("q", "is required")),
+		}
+		c, rec := newReportsPageContext(base, workspaceID.String(), userID)
+
+		require.NoError(t, httphandler.NewSearchHandler(searcher).Search(c))
+		assert.Equal(t, stdhttp.StatusBadRequest, rec.Code)
+		assert.Contains(t, rec.Body.String(), "VALIDATION_ERROR")
+	})
+
+	t.Run("requires authentication", func(t *testing.T) {
+		c, rec := newReportsPageContext(base+"?q=release", workspaceID.String(), "")
+
+		require.NoError(t, httphandler.NewSearchHandler(&fakeWorkspaceSearcher{}).Search(c))
+		assert.Equal(t, stdhttp.StatusUnauthorized, rec.Code)
+	})
+}
+
+func TestSearchTemplateHandler_ResultsPartial(t *testing.T) {
+	workspaceID := uuid.NewUUID()
+	userID := uuid.NewUUID()
+	target := "/partials/workspaces/" + workspaceID.String() + "/search?q=release"
+
+	t.Run("renders hits of each type", func(t *testing.T) {
+		result, _, taskID, _ := testSearchResult()
+		searcher := &fakeWorkspaceSearcher{result: result}
+		handler := httphandler.NewSearchTemplateHandler(
+			newTestRenderer(t), nil, searcher, &stubReportMembership{member: true})
+		c, rec := newReportsPageContext(target, workspaceID.String(), userID)
+
+		require.NoError(t, handler.ResultsPartial(c))
+		assert.Equal(t, stdhttp.StatusOK, rec.Code)
+		body := rec.Body.String()
+		assert.Contains(t, body, "When is the release?")
+		assert.Contains(t, body, "Release checklist")
+		assert.Contains(t, body, "/tasks/"+taskID.String())
+		require.Len(t, searcher.queries, 1)
+		assert.Positive(t, searcher.queries[0].Limit)
+	})
+
+	t.Run("blank query renders nothing", func(t *testing.T) {
+		searcher := &fakeWorkspaceSearcher{
+			err: fmt.Errorf("validation failed: %w", appcore.NewValidationError("q", "is required")),
+		}
+		handler := httphandler.NewSearchTemplateHandler(
+			newTestRenderer(t), nil, searcher, &stubReportMembership{member: true})
+		c, rec := newReportsPageContext(target, workspaceID.String(), userID)
+
+		require.NoError(t, handler.ResultsPartial(c))
+		assert.Equal(t, stdhttp.StatusOK, rec.Code)
+		assert.Empty(t, rec.Body.String())
+	})
+
+	t.Run("non-member gets not found", func(t *testing.T) {
+		searcher := &fakeWorkspaceSearcher{}
+		handler := httphandler.NewSearchTemplateHandler(
+			newTestRenderer(t), nil, searcher, &stubReportMembership{member: false})
+		c, rec := newReportsPageContext(target, workspaceID.String(), userID)
+
+		require.NoError(t, handler.ResultsPartial(c))
+		assert.Equal(t, stdhttp.StatusNotFound, rec.Code)
+		assert.Empty(t, searcher.queries)
+	})
+}
@@ -0,0 +1,130 @@
+package httphandler
+
+import (
+	"bytes"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/lllypuk/flowra/internal/application/appcore"
+	searchapp "github.com/lllypuk/flowra/internal/application/search"
+	"github.com/lllypuk/flowra/internal/domain/uuid"
+)
+
+// searchBoxResultLimit caps the hits of each type shown under the search box.
+const searchBoxResultLimit = 5
+
+// SearchResultsViewData represents the data needed to render the search box results.
+type SearchResultsViewData struct {
+	WorkspaceID string
+	Query       string
+	Results     SearchResponse
+	Empty       bool
+}
+
+// SearchTemplateHandler renders the workspace search box results.
+type SearchTemplateHandler struct {
+	renderer *TemplateRenderer
+	logger   *slog.Logger
+	searcher WorkspaceSearcher
+	members  ReportMembershipChecker
+}
+
+// NewSearchTemplateHandler creates a new search template handler.
+func NewSearchTemplateHandler(
+	renderer *TemplateRenderer,
+	logger *slog.Logger,
+	searcher WorkspaceSearcher,
+	members ReportMembershipChecker,
+) *SearchTemplateHandler {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &SearchTemplateHandler{
+		renderer: renderer,
+		logger:   logger,
+		searcher: searcher,
+		members:  members,
+	}
+}
+
+// SetupSearchRoutes registers search partial routes.
+func (h *SearchTemplateHandler) SetupSearchRoutes(e *echo.Echo) {
+	partials := e.Group("/partials", RequireAuth)
+	partials.GET("/workspaces/:workspace_id/search", h.ResultsPartial)
+}
+
+// ResultsPartial renders the first hits of each type for the search box query q.
+// A blank query renders nothing so clearing the box clears the results.
+func (h *SearchTemplateHandler) ResultsPartial(c echo.Context) error {
+	user := getUserView(c)
+	if user == nil {
+		return c.String(http.StatusUnauthorized, "Unauthorized")
+	}
+
+	workspaceID, err := uuid.ParseUUID(c.Param("workspace_id"))
+	if err != nil {
+		return c.String(http.StatusNotFound, "Page not found")
+	}
+	userID, err := uuid.ParseUUID(user.ID)
+	if err != nil {
+		return c.String(http.StatusNotFound, "Page not found")
+	}
+
+	ctx := c.Request().Context()
+	if h.members != nil {
+		isMember, memberErr := h.members.IsMember(ctx, workspaceID, userID)
+		if memberErr != nil || !isMember {
+			return c.String(http.StatusNotFound, "Page not found")
+		}
+	}
+
+	data := SearchResultsViewData{WorkspaceID: workspaceID.String(), Query: c.QueryParam("q")}
+	result, err := h.searcher.Execute(ctx, searchapp.Query{
+		WorkspaceID: workspaceID,
+		UserID:      userID,
+		Text:        data.Query,
+		Limit:       searchBoxResultLimit,
+	})
+	if err != nil {
+		// blank and over-long queries are not worth an error under a search box
+		var validationErr *appcore.ValidationError
+		if !errors.As(err, &validationErr) {
+			h.logger.ErrorContext(ctx, "failed to search workspace",
+				slog.String("workspace_id", workspaceID.String()),
+				slog.String("error", err.Error()),
+			)
+			return c.String(http.StatusInternalServerError, "Search failed")
+		}
+		return c.HTML(http.StatusOK, "")
+	}
+
+	data.Results = ToSearchResponse(workspaceID, result)
+	data.Empty = isEmptySearchResponse(data.Results)
+	return h.renderPartial(c, "search/results", data)
+}
+
+func (h *SearchTemplateHandler) renderPartial(c echo.Context, templateName string, data any) error {
+	if h.renderer == nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "template renderer not configured")
+	}
+
+	// Buffer the template output to prevent partial writes on error
+	var buf bytes.Buffer
+	if err := h.renderer.Render(&buf, templateName, data, c); err != nil {
+		h.logger.Error("failed to render partial template",
+			slog.String("template", templateName),
+			slog.String("error", err.Error()))
+		return c.String(http.StatusInternalServerError, "Search failed")
+	}
+
+	c.Response().Header().Set("Content-Type", "text/html; charset=utf-8")
+	return c.HTMLBlob(http.StatusOK, buf.Bytes())
+}
+
+func isEmptySearchResponse(resp SearchResponse) bool {
+	return (resp.Messages == nil || len(resp.Messages.Items) == 0) &&
+		(resp.Chats == nil || len(resp.Chats.Items) == 0) &&
+		(resp.Tasks == nil || len(resp.Tasks.Items) == 0)
+}
@@ -0,0 +1,71 @@
+package httphandler
+
+import (
+	"fmt"
+	"time"
+
+	taskapp "github.com/lllypuk/flowra/internal/application/task"
+	"github.com/lllypuk/flowra/internal/domain/chat"
+	"github.com/lllypuk/flowra/internal/domain/workspace"
+)
+
+const minutesPerHour = 60
+
+// SLAViewData represents the SLA countdown of a bug on cards and the detail page.
+type SLAViewData struct {
+	Kind      string // "response" or "resolution"
+	DueAt     time.Time
+	Breached  bool
+	Remaining string // e.g. "3h 20m"; time past DueAt once breached
+}
+
+// buildSLAView picks the clock to show for a bug: the response clock while the bug
+// awaits triage, then the resolution clock. Returns nil when no clock is running.
+func buildSLAView(policy workspace.SLAPolicy, t *taskapp.ReadModel, now time.Time) *SLAViewData {
+	if t == nil || t.SLA == nil || policy.IsZero() {
+		return nil
+	}
+
+	status := policy.Evaluate(
+		t.Severity,
+		chat.NewSLAClock(t.SLA.OpenedAt, t.SLA.RespondedAt, t.SLA.ResolvedAt),
+		now,
+	)
+
+	kind, deadline := chat.SLAKindResponse, status.Response
+	if !deadline.IsRunning() {
+		kind, deadline = chat.SLAKindResolution, status.Resolution
+	}
+	if !deadline.IsRunning() {
+		return nil
+	}
+
+	remaining := deadline.Remaining(now)
+	if remaining < 0 {
+		remaining = -remaining
+	}
+
+	return &SLAViewData{
+		Kind:      kind,
+		DueAt:     deadline.DueAt,
+		Breached:  deadline.Breached,
+		Remaining: formatSLADuration(remaining),
+	}
+}
+
+// formatSLADuration renders a duration with its two largest units.
+func formatSLADuration(d time.Duration) string {
+	d = d.Round(time.Minute)
+	days := int(d.Hours()) / hoursPerDay
+	hours := int(d.Hours()) % hoursPerDay
+	minutes := int(d.Minutes()) % minutesPerHour
+
+	switch {
+	case days > 0:
+		return fmt.Sprintf("%dd %dh", days, hours)
+	case hours > 0:
+		return fmt.Sprintf("%dh %dm", hours, minutes)
+	default:
+		return fmt.Sprintf("%dm", minutes)
+	}
+}
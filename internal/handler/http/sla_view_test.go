@@ -0,0 +1,68 @@
+//nolint:testpackage // Tests unexported SLA view helpers.
+package httphandler
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	taskapp "github.com/lllypuk/flowra/internal/application/task"
+	"github.com/lllypuk/flowra/internal/domain/chat"
+	"github.com/lllypuk/flowra/internal/domain/workspace"
+)
+
+func TestBuildSLAView(t *testing.T) {
+	policy, err := workspace.NewSLAPolicy(map[string]workspace.SLATarget{
+		"Critical": {Response: time.Hour, Resolution: 24 * time.Hour},
+	})
+	require.NoError(t, err)
+
+	openedAt := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+
+	t.Run("response clock while awaiting triage", func(t *testing.T) {
+		model := &taskapp.ReadModel{Severity: "Critical", SLA: &taskapp.SLAReadModel{OpenedAt: openedAt}}
+
+		view := buildSLAView(policy, model, openedAt.Add(40*time.Minute))
+
+		require.NotNil(t, view)
+		assert.Equal(t, chat.SLAKindResponse, view.Kind)
+		assert.False(t, view.Breached)
+		assert.Equal(t, "20m", view.Remaining)
+	})
+
+	t.Run("resolution clock after triage", func(t *testing.T) {
+		respondedAt := openedAt.Add(10 * time.Minute)
+		model := &taskapp.ReadModel{
+			Severity: "Critical",
+			SLA:      &taskapp.SLAReadModel{OpenedAt: openedAt, RespondedAt: &respondedAt},
+		}
+
+		view := buildSLAView(policy, model, openedAt.Add(26*time.Hour+30*time.Minute))
+
+		require.NotNil(t, view)
+		assert.Equal(t, chat.SLAKindResolution, view.Kind)
+		assert.True(t, view.Breached)
+		assert.Equal(t, "2h 30m", view.Remaining)
+	})
+
+	t.Run("no countdown once resolved or without target", func(t *testing.T) {
+		resolvedAt := openedAt.Add(time.Hour)
+		resolved := &taskapp.ReadModel{
+			Severity: "Critical",
+			SLA:      &taskapp.SLAReadModel{OpenedAt: openedAt, RespondedAt: &resolvedAt, ResolvedAt: &resolvedAt},
+		}
+		minor := &taskapp.ReadModel{Severity: "Minor", SLA: &taskapp.SLAReadModel{OpenedAt: openedAt}}
+
+		assert.Nil(t, buildSLAView(policy, resolved, openedAt.Add(2*time.Hour)))
+		assert.Nil(t, buildSLAView(policy, minor, openedAt))
+		assert.Nil(t, buildSLAView(workspace.SLAPolicy{}, minor, openedAt))
+	})
+}
+
+func TestFormatSLADuration(t *testing.T) {
+	assert.Equal(t, "0m", formatSLADuration(20*time.Second))
+	assert.Equal(t, "1h 5m", formatSLADuration(65*time.Minute))
+	assert.Equal(t, "2d 3h", formatSLADuration(51*time.Hour+10*time.Minute))
+}
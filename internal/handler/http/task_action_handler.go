@@ -3,12 +3,15 @@ package httphandler
 import (
 	"context"
 	"net/http"
+	"strings"
 	"time"
 
 	"github.com/labstack/echo/v4"
 
 	"github.com/lllypuk/flowra/internal/application/appcore"
 	taskapp "github.com/lllypuk/flowra/internal/application/task"
+	"github.com/lllypuk/flowra/internal/domain/chat"
+	"github.com/lllypuk/flowra/internal/domain/task"
 	"github.com/lllypuk/flowra/internal/domain/uuid"
 	"github.com/lllypuk/flowra/internal/infrastructure/httpserver"
 	"github.com/lllypuk/flowra/internal/middleware"
@@ -52,6 +55,20 @@ type TaskActionService interface {
 		dueDate *time.Time,
 		actorID uuid.UUID,
 	) (*appcore.ActionResult, error)
+
+	SetSeverity(
+		ctx context.Context,
+		chatID uuid.UUID,
+		severity string,
+		actorID uuid.UUID,
+	) (*appcore.ActionResult, error)
+
+	MarkDuplicate(
+		ctx context.Context,
+		chatID uuid.UUID,
+		originalID *uuid.UUID,
+		actorID uuid.UUID,
+	) (*appcore.ActionResult, error)
 }
 
 // TaskActionHandler routes task field changes through the chat message system.
@@ -257,7 +274,7 @@ func (h *TaskActionHandler) SetDueDate(c echo.Context) error {
 
 	var dueDate *time.Time
 	if req.DueDate != "" {
-		parsed, parseErr := time.Parse("2006-01-02", req.DueDate)
+		parsed, parseErr := time.ParseInLocation("2006-01-02", req.DueDate, middleware.GetLocation(c))
 		if parseErr != nil {
 			return httpserver.RespondErrorWithCode(
 				c, http.StatusBadRequest, "INVALID_DATE", "invalid date format, use YYYY-MM-DD")
@@ -278,3 +295,123 @@ func (h *TaskActionHandler) SetDueDate(c echo.Context) error {
 	c.Response().Header().Set("Hx-Trigger", "taskUpdated")
 	return c.NoContent(http.StatusNoContent)
 }
+
+// SetSeverity handles POST /api/v1/workspaces/:workspace_id/tasks/:task_id/actions/severity.
+// Sends a #severity tag message to the bug's associated chat.
+func (h *TaskActionHandler) SetSeverity(c echo.Context) error {
+	userID, taskModel, err := h.resolveActorAndTask(c)
+	if err != nil {
+		return err
+	}
+	if taskModel == nil {
+		return nil
+	}
+
+	var req struct {
+		Severity string `json:"severity" form:"severity"`
+	}
+	if bindErr := c.Bind(&req); bindErr != nil {
+		return httpserver.RespondErrorWithCode(c, http.StatusBadRequest, "INVALID_REQUEST", "invalid request body")
+	}
+	if taskModel.EntityType != task.TypeBug {
+		return httpserver.RespondErrorWithCode(
+			c, http.StatusBadRequest, "SEVERITY_ONLY_FOR_BUGS", "severity can only be set on bugs")
+	}
+
+	severity := parseSeverityStrict(req.Severity)
+	if severity == "" {
+		return httpserver.RespondErrorWithCode(
+			c,
+			http.StatusBadRequest,
+			"INVALID_SEVERITY",
+			"severity must be "+strings.Join(chat.Severities(), ", "),
+		)
+	}
+
+	// Idempotent no-op: same severity requested.
+	if taskModel.Severity == severity {
+		c.Response().Header().Set("Hx-Trigger", "taskUpdated")
+		return c.NoContent(http.StatusNoContent)
+	}
+
+	if _, actionErr := h.actionService.SetSeverity(
+		c.Request().Context(),
+		taskModel.ChatID,
+		severity,
+		userID,
+	); actionErr != nil {
+		return httpserver.RespondError(c, actionErr)
+	}
+
+	c.Response().Header().Set("Hx-Trigger", "taskUpdated")
+	return c.NoContent(http.StatusNoContent)
+}
+
+// MarkDuplicate handles POST /api/v1/workspaces/:workspace_id/tasks/:task_id/actions/duplicate.
+// Sends a #duplicate tag message to the task's associated chat.
+// An empty original_id clears the duplicate mark.
+func (h *TaskActionHandler) MarkDuplicate(c echo.Context) error {
+	ctx := c.Request().Context()
+	userID, taskModel, err := h.resolveActorAndTask(c)
+	if err != nil {
+		return err
+	}
+	if taskModel == nil {
+		return nil
+	}
+
+	var req struct {
+		OriginalID string `json:"original_id" form:"original_id"`
+	}
+	if bindErr := c.Bind(&req); bindErr != nil {
+		return httpserver.RespondErrorWithCode(c, http.StatusBadRequest, "INVALID_REQUEST", "invalid request body")
+	}
+
+	var originalID *uuid.UUID
+	if original := strings.TrimSpace(req.OriginalID); original != "" {
+		parsed, parseErr := uuid.ParseUUID(original)
+		if parseErr != nil {
+			return httpserver.RespondErrorWithCode(
+				c, http.StatusBadRequest, "INVALID_ORIGINAL_ID", "invalid original task ID format")
+		}
+		if parsed == taskModel.ID {
+			return httpserver.RespondErrorWithCode(
+				c, http.StatusBadRequest, "INVALID_ORIGINAL_ID", "a task cannot duplicate itself")
+		}
+		originalID = &parsed
+	}
+
+	// Idempotent no-op: same original requested.
+	if sameUUIDPtr(taskModel.DuplicateOf, originalID) {
+		c.Response().Header().Set("Hx-Trigger", "taskUpdated")
+		return c.NoContent(http.StatusNoContent)
+	}
+
+	// Tag commands fail asynchronously, so the original is checked up front.
+	if originalID != nil {
+		original, getErr := h.taskService.GetTask(ctx, *originalID)
+		if getErr != nil || original == nil ||
+			(!taskModel.WorkspaceID.IsZero() && original.WorkspaceID != taskModel.WorkspaceID) {
+			return httpserver.RespondErrorWithCode(
+				c, http.StatusNotFound, "ORIGINAL_NOT_FOUND", "original task not found")
+		}
+	}
+
+	if _, actionErr := h.actionService.MarkDuplicate(ctx, taskModel.ChatID, originalID, userID); actionErr != nil {
+		return httpserver.RespondError(c, actionErr)
+	}
+
+	c.Response().Header().Set("Hx-Trigger", "taskUpdated")
+	return c.NoContent(http.StatusNoContent)
+}
+
+// parseSeverityStrict returns the canonical bug severity, or "" when unknown.
+func parseSeverityStrict(s string) string {
+	s = strings.TrimSpace(s)
+	for _, severity := range chat.Severities() {
+		if strings.EqualFold(s, severity) {
+			return severity
+		}
+	}
+	return ""
+}
@@ -15,6 +15,7 @@ import (
 
 	"github.com/lllypuk/flowra/internal/application/appcore"
 	taskapp "github.com/lllypuk/flowra/internal/application/task"
+	taskdomain "github.com/lllypuk/flowra/internal/domain/task"
 	"github.com/lllypuk/flowra/internal/domain/uuid"
 	httphandler "github.com/lllypuk/flowra/internal/handler/http"
 	"github.com/lllypuk/flowra/internal/middleware"
@@ -24,12 +25,19 @@ import (
 type mockTaskActionTaskService struct {
 	task    *taskapp.ReadModel
 	taskErr error
+	others  map[uuid.UUID]*taskapp.ReadModel // further tasks found by ID, e.g. originals
 }
 
 func (m *mockTaskActionTaskService) GetTask(
 	_ context.Context,
-	_ uuid.UUID,
+	taskID uuid.UUID,
 ) (*taskapp.ReadModel, error) {
+	if m.task != nil && m.task.ID != taskID && m.others != nil {
+		if other, ok := m.others[taskID]; ok {
+			return other, nil
+		}
+		return nil, taskapp.ErrTaskNotFound
+	}
 	return m.task, m.taskErr
 }
 
@@ -40,10 +48,14 @@ type mockTaskActionService struct {
 	lastPriority  string
 	lastAssignee  *uuid.UUID
 	lastDueDate   *time.Time
+	lastSeverity  string
+	lastOriginal  *uuid.UUID
 	statusCalls   int
 	priorityCalls int
 	assigneeCalls int
 	dueDateCalls  int
+	severityCalls int
+	dupCalls      int
 	actionErr     error
 }
 
@@ -95,6 +107,30 @@ func (m *mockTaskActionService) SetDueDate(
 	return &appcore.ActionResult{Success: true}, m.actionErr
 }
 
+func (m *mockTaskActionService) SetSeverity(
+	_ context.Context,
+	chatID uuid.UUID,
+	severity string,
+	_ uuid.UUID,
+) (*appcore.ActionResult, error) {
+	m.lastChatID = chatID
+	m.lastSeverity = severity
+	m.severityCalls++
+	return &appcore.ActionResult{Success: true}, m.actionErr
+}
+
+func (m *mockTaskActionService) MarkDuplicate(
+	_ context.Context,
+	chatID uuid.UUID,
+	originalID *uuid.UUID,
+	_ uuid.UUID,
+) (*appcore.ActionResult, error) {
+	m.lastChatID = chatID
+	m.lastOriginal = originalID
+	m.dupCalls++
+	return &appcore.ActionResult{Success: true}, m.actionErr
+}
+
 // newTestTaskActionContext creates an Echo context with a user ID and task_id param set.
 func newTestTaskActionContext(
 	t *testing.T,
@@ -274,6 +310,109 @@ func TestTaskActionHandler_ChangePriority(t *testing.T) {
 	})
 }
 
+func TestTaskActionHandler_SetSeverity(t *testing.T) {
+	taskID := uuid.NewUUID()
+	chatID := uuid.NewUUID()
+
+	bug := &taskapp.ReadModel{ID: taskID, ChatID: chatID, EntityType: taskdomain.TypeBug}
+
+	t.Run("success with canonical casing", func(t *testing.T) {
+		actionSvc := &mockTaskActionService{}
+		h := httphandler.NewTaskActionHandler(&mockTaskActionTaskService{task: bug}, actionSvc)
+
+		c, rec := newTestTaskActionContext(t, "severity=critical", taskID)
+
+		require.NoError(t, h.SetSeverity(c))
+		assert.Equal(t, http.StatusNoContent, rec.Code)
+		assert.Equal(t, chatID, actionSvc.lastChatID)
+		assert.Equal(t, "Critical", actionSvc.lastSeverity)
+	})
+
+	t.Run("invalid severity", func(t *testing.T) {
+		actionSvc := &mockTaskActionService{}
+		h := httphandler.NewTaskActionHandler(&mockTaskActionTaskService{task: bug}, actionSvc)
+
+		c, rec := newTestTaskActionContext(t, "severity=Huge", taskID)
+
+		require.NoError(t, h.SetSeverity(c))
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		assert.Equal(t, 0, actionSvc.severityCalls)
+	})
+
+	t.Run("only bugs have a severity", func(t *testing.T) {
+		actionSvc := &mockTaskActionService{}
+		plainTask := &taskapp.ReadModel{ID: taskID, ChatID: chatID, EntityType: taskdomain.TypeTask}
+		h := httphandler.NewTaskActionHandler(&mockTaskActionTaskService{task: plainTask}, actionSvc)
+
+		c, rec := newTestTaskActionContext(t, "severity=Major", taskID)
+
+		require.NoError(t, h.SetSeverity(c))
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		assert.Equal(t, 0, actionSvc.severityCalls)
+	})
+}
+
+func TestTaskActionHandler_MarkDuplicate(t *testing.T) {
+	workspaceID := uuid.NewUUID()
+	taskID := uuid.NewUUID()
+	chatID := uuid.NewUUID()
+	originalID := uuid.NewUUID()
+
+	bug := &taskapp.ReadModel{ID: taskID, ChatID: chatID, WorkspaceID: workspaceID, EntityType: taskdomain.TypeBug}
+	original := &taskapp.ReadModel{ID: originalID, ChatID: originalID, WorkspaceID: workspaceID}
+
+	t.Run("marks duplicate of a task in the workspace", func(t *testing.T) {
+		taskSvc := &mockTaskActionTaskService{task: bug, others: map[uuid.UUID]*taskapp.ReadModel{originalID: original}}
+		actionSvc := &mockTaskActionService{}
+		h := httphandler.NewTaskActionHandler(taskSvc, actionSvc)
+
+		c, rec := newTestTaskActionContext(t, "original_id="+originalID.String(), taskID)
+
+		require.NoError(t, h.MarkDuplicate(c))
+		assert.Equal(t, http.StatusNoContent, rec.Code)
+		require.NotNil(t, actionSvc.lastOriginal)
+		assert.Equal(t, originalID, *actionSvc.lastOriginal)
+	})
+
+	t.Run("original in another workspace is not found", func(t *testing.T) {
+		foreign := &taskapp.ReadModel{ID: originalID, ChatID: originalID, WorkspaceID: uuid.NewUUID()}
+		taskSvc := &mockTaskActionTaskService{task: bug, others: map[uuid.UUID]*taskapp.ReadModel{originalID: foreign}}
+		actionSvc := &mockTaskActionService{}
+		h := httphandler.NewTaskActionHandler(taskSvc, actionSvc)
+
+		c, rec := newTestTaskActionContext(t, "original_id="+originalID.String(), taskID)
+
+		require.NoError(t, h.MarkDuplicate(c))
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+		assert.Equal(t, 0, actionSvc.dupCalls)
+	})
+
+	t.Run("cannot duplicate itself", func(t *testing.T) {
+		actionSvc := &mockTaskActionService{}
+		h := httphandler.NewTaskActionHandler(&mockTaskActionTaskService{task: bug}, actionSvc)
+
+		c, rec := newTestTaskActionContext(t, "original_id="+taskID.String(), taskID)
+
+		require.NoError(t, h.MarkDuplicate(c))
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		assert.Equal(t, 0, actionSvc.dupCalls)
+	})
+
+	t.Run("empty original clears the mark", func(t *testing.T) {
+		marked := *bug
+		marked.DuplicateOf = &originalID
+		actionSvc := &mockTaskActionService{}
+		h := httphandler.NewTaskActionHandler(&mockTaskActionTaskService{task: &marked}, actionSvc)
+
+		c, rec := newTestTaskActionContext(t, "original_id=", taskID)
+
+		require.NoError(t, h.MarkDuplicate(c))
+		assert.Equal(t, http.StatusNoContent, rec.Code)
+		assert.Equal(t, 1, actionSvc.dupCalls)
+		assert.Nil(t, actionSvc.lastOriginal)
+	})
+}
+
 func TestTaskActionHandler_ChangeAssignee(t *testing.T) {
 	taskID := uuid.NewUUID()
 	chatID := uuid.NewUUID()
@@ -0,0 +1,292 @@
+package httphandler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	taskapp "github.com/lllypuk/flowra/internal/application/task"
+	"github.com/lllypuk/flowra/internal/domain/uuid"
+	"github.com/lllypuk/flowra/internal/infrastructure/filestorage"
+	"github.com/lllypuk/flowra/internal/infrastructure/httpserver"
+	"github.com/lllypuk/flowra/internal/infrastructure/resilience"
+	"github.com/lllypuk/flowra/internal/middleware"
+)
+
+// TaskAttachmentResponse represents a file attached to a task.
+type TaskAttachmentResponse struct {
+	FileID   uuid.UUID `json:"file_id"`
+	FileName string    `json:"file_name"`
+	FileSize int64     `json:"file_size"`
+	MimeType string    `json:"mime_type"`
+	URL      string    `json:"url"`
+}
+
+// TaskAttachmentListResponse represents the files attached to a task and quota usage.
+type TaskAttachmentListResponse struct {
+	Attachments []TaskAttachmentResponse `json:"attachments"`
+	TotalSize   int64                    `json:"total_size"`
+	Quota       int64                    `json:"quota,omitempty"`
+}
+
+// TaskAttachmentService defines the task operations used by the attachment handler.
+// Declared on the consumer side per project guidelines.
+type TaskAttachmentService interface {
+	// GetTask gets a task by ID.
+	GetTask(ctx context.Context, taskID uuid.UUID) (*taskapp.ReadModel, error)
+
+	// AddAttachment adds an attachment to a task.
+	AddAttachment(ctx context.Context, cmd taskapp.AddAttachmentCommand) (taskapp.TaskResult, error)
+}
+
+// TaskAttachmentHandler uploads files into the blob store and attaches them to tasks.
+// Routes are workspace-scoped: membership is enforced by middleware.
+type TaskAttachmentHandler struct {
+	taskService  TaskAttachmentService
+	storage      *filestorage.LocalStorage
+	storageGuard FileStorageGuard
+	metadataRepo FileMetadataLookup
+	maxFileSize  int64
+	quota        int64 // max total attachment size per task in bytes; 0 = unlimited
+	storageQuota WorkspaceStorageQuota
+}
+
+// TaskAttachmentHandlerOption configures a TaskAttachmentHandler.
+type TaskAttachmentHandlerOption func(*TaskAttachmentHandler)
+
+// WithTaskAttachmentMaxFileSize sets the maximum allowed size of a single upload in bytes.
+func WithTaskAttachmentMaxFileSize(size int64) TaskAttachmentHandlerOption {
+	return func(h *TaskAttachmentHandler) {
+		if size > 0 {
+			h.maxFileSize = size
+		}
+	}
+}
+
+// WithTaskAttachmentQuota sets the maximum total size of files attached to one task.
+// The quota is checked before the upload is stored; the use case enforces it again on attach.
+func WithTaskAttachmentQuota(maxBytes int64) TaskAttachmentHandlerOption {
+	return func(h *TaskAttachmentHandler) {
+		h.quota = max(maxBytes, 0)
+	}
+}
+
+// WithTaskAttachmentStorageQuota enforces the attachment storage quota of the task's
+// workspace and counts uploads against it.
+func WithTaskAttachmentStorageQuota(quota WorkspaceStorageQuota) TaskAttachmentHandlerOption {
+	return func(h *TaskAttachmentHandler) {
+		h.storageQuota = quota
+	}
+}
+
+// WithTaskAttachmentStorageGuard runs blob store writes through the given guard.
+func WithTaskAttachmentStorageGuard(guard FileStorageGuard) TaskAttachmentHandlerOption {
+	return func(h *TaskAttachmentHandler) {
+		h.storageGuard = guard
+	}
+}
+
+// NewTaskAttachmentHandler creates a new TaskAttachmentHandler.
+func NewTaskAttachmentHandler(
+	taskService TaskAttachmentService,
+	storage *filestorage.LocalStorage,
+	metadataRepo FileMetadataLookup,
+	opts ...TaskAttachmentHandlerOption,
+) *TaskAttachmentHandler {
+	h := &TaskAttachmentHandler{
+		taskService:  taskService,
+		storage:      storage,
+		metadataRepo: metadataRepo,
+		maxFileSize:  defaultMaxUploadSize,
+	}
+	for _, opt := range opts {
+		opt(h)
+	}
+	return h
+}
+
+// List handles GET /api/v1/workspaces/:workspace_id/tasks/:task_id/attachments.
+func (h *TaskAttachmentHandler) List(c echo.Context) error {
+	if middleware.GetUserID(c).IsZero() {
+		return httpserver.RespondErrorWithCode(c, http.StatusUnauthorized, "UNAUTHORIZED", "authentication required")
+	}
+
+	taskID, parseErr := uuid.ParseUUID(c.Param("task_id"))
+	if parseErr != nil {
+		return httpserver.RespondErrorWithCode(
+			c, http.StatusBadRequest, "INVALID_TASK_ID", "invalid task ID format")
+	}
+
+	task, err := h.taskService.GetTask(c.Request().Context(), taskID)
+	if err != nil {
+		return httpserver.RespondError(c, err)
+	}
+
+	return httpserver.RespondOK(c, h.toListResponse(task))
+}
+
+// Upload handles POST /api/v1/workspaces/:workspace_id/tasks/:task_id/attachments/upload.
+// Accepts a multipart form with a "file" field, stores it and attaches it to the task.
+func (h *TaskAttachmentHandler) Upload(c echo.Context) error {
+	userID := middleware.GetUserID(c)
+	if userID.IsZero() {
+		return httpserver.RespondErrorWithCode(c, http.StatusUnauthorized, "UNAUTHORIZED", "authentication required")
+	}
+
+	taskID, parseErr := uuid.ParseUUID(c.Param("task_id"))
+	if parseErr != nil {
+		return httpserver.RespondErrorWithCode(
+			c, http.StatusBadRequest, "INVALID_TASK_ID", "invalid task ID format")
+	}
+
+	task, err := h.taskService.GetTask(c.Request().Context(), taskID)
+	if err != nil {
+		return httpserver.RespondError(c, err)
+	}
+
+	c.Request().Body = http.MaxBytesReader(c.Response(), c.Request().Body, h.maxFileSize)
+
+	file, err := c.FormFile("file")
+	if err != nil {
+		if strings.Contains(err.Error(), "http: request body too large") {
+			return httpserver.RespondErrorWithCode(
+				c, http.StatusRequestEntityTooLarge, "FILE_TOO_LARGE",
+				fmt.Sprintf("file size exceeds %d MB limit", h.maxFileSize/bytesPerMB))
+		}
+		return httpserver.RespondErrorWithCode(
+			c, http.StatusBadRequest, "INVALID_FILE", "file is required")
+	}
+	if file.Size > h.maxFileSize {
+		return httpserver.RespondErrorWithCode(
+			c, http.StatusRequestEntityTooLarge, "FILE_TOO_LARGE",
+			fmt.Sprintf("file size exceeds %d MB limit", h.maxFileSize/bytesPerMB))
+	}
+	if h.quota > 0 && attachmentsSize(task)+file.Size > h.quota {
+		return httpserver.RespondError(c, taskapp.ErrAttachmentQuotaExceeded)
+	}
+	if h.storageQuota != nil {
+		usage, quotaErr := h.storageQuota.CheckUpload(c.Request().Context(), task.WorkspaceID, file.Size)
+		if quotaErr != nil {
+			return respondStorageQuotaError(c, usage, quotaErr)
+		}
+	}
+
+	mimeType := file.Header.Get("Content-Type")
+	if mimeType == "" || mimeType == mimeOctetStream {
+		mimeType = mime.TypeByExtension(filepath.Ext(file.Filename))
+		if mimeType == "" {
+			mimeType = mimeOctetStream
+		}
+	}
+	if !isAllowedMIME(mimeType) {
+		return httpserver.RespondErrorWithCode(
+			c, http.StatusBadRequest, "INVALID_FILE_TYPE", "file type not allowed")
+	}
+
+	src, openErr := file.Open()
+	if openErr != nil {
+		return httpserver.RespondErrorWithCode(
+			c, http.StatusInternalServerError, "FILE_ERROR", "failed to read uploaded file")
+	}
+	defer src.Close()
+
+	safeName := sanitizeFileName(file.Filename)
+	fileID, saveErr := h.save(c.Request().Context(), src, safeName)
+	if saveErr != nil {
+		var depErr *resilience.DependencyError
+		if errors.As(saveErr, &depErr) {
+			return httpserver.RespondError(c, depErr)
+		}
+		return httpserver.RespondErrorWithCode(
+			c, http.StatusInternalServerError, "STORAGE_ERROR", "failed to save file")
+	}
+
+	// Downloads are authorized against the task's chat participants
+	_ = h.metadataRepo.Save(c.Request().Context(), FileMetadataEntry{
+		FileID:     fileID,
+		ChatID:     task.ChatID,
+		UploaderID: userID,
+		UploadedAt: time.Now().UTC(),
+	})
+
+	_, err = h.taskService.AddAttachment(c.Request().Context(), taskapp.AddAttachmentCommand{
+		TaskID:   taskID,
+		FileID:   fileID,
+		FileName: safeName,
+		FileSize: file.Size,
+		MimeType: mimeType,
+		AddedBy:  userID,
+	})
+	if err != nil {
+		// nothing references the blob yet, so drop it rather than leave an orphan
+		_ = h.storage.Delete(fileID, safeName)
+		return httpserver.RespondError(c, err)
+	}
+
+	if h.storageQuota != nil {
+		_ = h.storageQuota.RecordUpload(c.Request().Context(), task.WorkspaceID, file.Size)
+	}
+
+	return httpserver.RespondCreated(c, TaskAttachmentResponse{
+		FileID:   fileID,
+		FileName: safeName,
+		FileSize: file.Size,
+		MimeType: mimeType,
+		URL:      fileURL(fileID, safeName),
+	})
+}
+
+func (h *TaskAttachmentHandler) toListResponse(task *taskapp.ReadModel) TaskAttachmentListResponse {
+	resp := TaskAttachmentListResponse{
+		Attachments: make([]TaskAttachmentResponse, 0, len(task.Attachments)),
+		TotalSize:   attachmentsSize(task),
+		Quota:       h.quota,
+	}
+	for _, a := range task.Attachments {
+		resp.Attachments = append(resp.Attachments, TaskAttachmentResponse{
+			FileID:   a.FileID,
+			FileName: a.FileName,
+			FileSize: a.FileSize,
+			MimeType: a.MimeType,
+			URL:      fileURL(a.FileID, a.FileName),
+		})
+	}
+	return resp
+}
+
+// save writes the upload to storage, through the storage guard if one is configured.
+func (h *TaskAttachmentHandler) save(ctx context.Context, src io.Reader, fileName string) (uuid.UUID, error) {
+	if h.storageGuard == nil {
+		return h.storage.Save(src, fileName)
+	}
+
+	var fileID uuid.UUID
+	err := h.storageGuard.Do(ctx, func(ctx context.Context) error {
+		var saveErr error
+		fileID, saveErr = h.storage.Save(resilience.NewContextReader(ctx, src), fileName)
+		return saveErr
+	})
+	return fileID, err
+}
+
+// attachmentsSize returns the total size of files attached to a task in bytes.
+func attachmentsSize(task *taskapp.ReadModel) int64 {
+	var total int64
+	for _, a := range task.Attachments {
+		total += a.FileSize
+	}
+	return total
+}
+
+// fileURL returns the download URL of a blob store file.
+func fileURL(fileID uuid.UUID, fileName string) string {
+	return fmt.Sprintf("/api/v1/files/%s/%s", fileID.String(), url.PathEscape(fileName))
+}
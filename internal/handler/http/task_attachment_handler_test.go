@@ -0,0 +1,148 @@
+package httphandler_test
+
+import (
+	"encoding/json"
+	stdhttp "net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	taskapp "github.com/lllypuk/flowra/internal/application/task"
+	"github.com/lllypuk/flowra/internal/domain/uuid"
+	httphandler "github.com/lllypuk/flowra/internal/handler/http"
+	"github.com/lllypuk/flowra/internal/infrastructure/filestorage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestTaskAttachmentHandler(
+	t *testing.T,
+	opts ...httphandler.TaskAttachmentHandlerOption,
+) (*httphandler.TaskAttachmentHandler, *httphandler.MockTaskService, *mockFileMetadataRepo, *taskapp.ReadModel) {
+	t.Helper()
+	storage, err := filestorage.NewLocalStorage(t.TempDir())
+	require.NoError(t, err)
+
+	taskService := httphandler.NewMockTaskService()
+	task := &taskapp.ReadModel{ID: uuid.NewUUID(), ChatID: uuid.NewUUID(), Title: "Ship it"}
+	taskService.AddTask(task)
+
+	metadataRepo := newMockFileMetadataRepo()
+	handler := httphandler.NewTaskAttachmentHandler(taskService, storage, metadataRepo, opts...)
+	return handler, taskService, metadataRepo, task
+}
+
+func newTaskAttachmentUploadContext(
+	t *testing.T,
+	taskID uuid.UUID,
+	fileName, content string,
+) (echo.Context, *httptest.ResponseRecorder) {
+	t.Helper()
+	body, contentType := createMultipartFile(t, fileName, content)
+	req := httptest.NewRequest(stdhttp.MethodPost, "/", body)
+	req.Header.Set(echo.HeaderContentType, contentType)
+	rec := httptest.NewRecorder()
+	c := echo.New().NewContext(req, rec)
+	c.SetParamNames("workspace_id", "task_id")
+	c.SetParamValues(uuid.NewUUID().String(), taskID.String())
+	setupAuthContext(c, uuid.NewUUID())
+	return c, rec
+}
+
+func TestTaskAttachmentHandler_Upload(t *testing.T) {
+	t.Run("stores file and attaches it to the task", func(t *testing.T) {
+		handler, _, metadataRepo, task := newTestTaskAttachmentHandler(t)
+		c, rec := newTaskAttachmentUploadContext(t, task.ID, "notes.txt", "hello")
+
+		require.NoError(t, handler.Upload(c))
+		assert.Equal(t, stdhttp.StatusCreated, rec.Code)
+
+		require.Len(t, task.Attachments, 1)
+		assert.Equal(t, "notes.txt", task.Attachments[0].FileName)
+		assert.Equal(t, int64(len("hello")), task.Attachments[0].FileSize)
+
+		meta, err := metadataRepo.FindByFileID(c.Request().Context(), task.Attachments[0].FileID)
+		require.NoError(t, err)
+		assert.Equal(t, task.ChatID, meta.ChatID)
+	})
+
+	t.Run("rejects upload over the quota", func(t *testing.T) {
+		handler, _, _, task := newTestTaskAttachmentHandler(t, httphandler.WithTaskAttachmentQuota(8))
+		task.Attachments = []taskapp.AttachmentReadModel{{FileID: uuid.NewUUID(), FileName: "a.txt", FileSize: 5}}
+		c, rec := newTaskAttachmentUploadContext(t, task.ID, "notes.txt", "hello")
+
+		require.NoError(t, handler.Upload(c))
+		assert.Equal(t, stdhttp.StatusRequestEntityTooLarge, rec.Code)
+		assert.Contains(t, rec.Body.String(), "ATTACHMENT_QUOTA_EXCEEDED")
+		assert.Len(t, task.Attachments, 1)
+	})
+
+	t.Run("rejects upload beyond the workspace storage quota", func(t *testing.T) {
+		quota := newStubStorageQuota(8)
+		handler, _, _, task := newTestTaskAttachmentHandler(t, httphandler.WithTaskAttachmentStorageQuota(quota))
+		task.WorkspaceID = uuid.NewUUID()
+		quota.used[task.WorkspaceID] = 5
+		c, rec := newTaskAttachmentUploadContext(t, task.ID, "notes.txt", "hello")
+
+		require.NoError(t, handler.Upload(c))
+		assert.Equal(t, stdhttp.StatusRequestEntityTooLarge, rec.Code)
+		assert.Contains(t, rec.Body.String(), "STORAGE_QUOTA_EXCEEDED")
+		assert.Empty(t, task.Attachments)
+	})
+
+	t.Run("counts attachments against the workspace storage", func(t *testing.T) {
+		quota := newStubStorageQuota(1024)
+		handler, _, _, task := newTestTaskAttachmentHandler(t, httphandler.WithTaskAttachmentStorageQuota(quota))
+		task.WorkspaceID = uuid.NewUUID()
+		c, rec := newTaskAttachmentUploadContext(t, task.ID, "notes.txt", "hello")
+
+		require.NoError(t, handler.Upload(c))
+		assert.Equal(t, stdhttp.StatusCreated, rec.Code)
+		assert.Equal(t, int64(len("hello")), quota.used[task.WorkspaceID])
+	})
+
+	t.Run("rejects disallowed file type", func(t *testing.T) {
+		handler, _, _, task := newTestTaskAttachmentHandler(t)
+		c, rec := newTaskAttachmentUploadContext(t, task.ID, "run.exe", "MZ")
+
+		require.NoError(t, handler.Upload(c))
+		assert.Equal(t, stdhttp.StatusBadRequest, rec.Code)
+		assert.Empty(t, task.Attachments)
+	})
+
+	t.Run("unknown task", func(t *testing.T) {
+		handler, _, _, _ := newTestTaskAttachmentHandler(t)
+		c, rec := newTaskAttachmentUploadContext(t, uuid.NewUUID(), "notes.txt", "hello")
+
+		require.NoError(t, handler.Upload(c))
+		assert.Equal(t, stdhttp.StatusNotFound, rec.Code)
+	})
+}
+
+func TestTaskAttachmentHandler_List(t *testing.T) {
+	handler, _, _, task := newTestTaskAttachmentHandler(t, httphandler.WithTaskAttachmentQuota(1024))
+	fileID := uuid.NewUUID()
+	task.Attachments = []taskapp.AttachmentReadModel{
+		{FileID: fileID, FileName: "spec v2.pdf", FileSize: 300, MimeType: "application/pdf"},
+		{FileID: uuid.NewUUID(), FileName: "logo.png", FileSize: 200, MimeType: "image/png"},
+	}
+
+	req := httptest.NewRequest(stdhttp.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	c := echo.New().NewContext(req, rec)
+	c.SetParamNames("workspace_id", "task_id")
+	c.SetParamValues(uuid.NewUUID().String(), task.ID.String())
+	setupAuthContext(c, uuid.NewUUID())
+
+	require.NoError(t, handler.List(c))
+	assert.Equal(t, stdhttp.StatusOK, rec.Code)
+
+	var resp struct {
+		Data httphandler.TaskAttachmentListResponse `json:"data"`
+	}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+	require.Len(t, resp.Data.Attachments, 2)
+	assert.Equal(t, int64(500), resp.Data.TotalSize)
+	assert.Equal(t, int64(1024), resp.Data.Quota)
+	assert.Equal(t, "/api/v1/files/"+fileID.String()+"/spec%20v2.pdf", resp.Data.Attachments[0].URL)
+}
@@ -16,6 +16,7 @@ import (
 	"github.com/lllypuk/flowra/internal/domain/event"
 	"github.com/lllypuk/flowra/internal/domain/task"
 	"github.com/lllypuk/flowra/internal/domain/uuid"
+	"github.com/lllypuk/flowra/internal/middleware"
 )
 
 // Task detail template handler constants.
@@ -23,6 +24,7 @@ const (
 	defaultActivityLimit    = 50
 	dueSoonDays             = 3
 	maxMembersListLimitTask = 100
+	maxTaskBacklinks        = 10
 	actionTextUpdatedTitle  = "updated title"
 )
 
@@ -42,6 +44,13 @@ type TaskEventService interface {
 	GetEvents(ctx context.Context, taskID uuid.UUID) ([]event.DomainEvent, error)
 }
 
+// TaskBacklinkService defines the interface for loading the messages that mention a task.
+// Declared on the consumer side per project guidelines.
+type TaskBacklinkService interface {
+	// FindBacklinks returns the messages mentioning the task, newest first.
+	FindBacklinks(ctx context.Context, taskID uuid.UUID, limit int) ([]taskapp.Backlink, error)
+}
+
 // ChatBasicInfoService defines the interface for loading basic chat information.
 // Declared on the consumer side per project guidelines.
 type ChatBasicInfoService interface {
@@ -66,6 +75,9 @@ type UserLookupService interface {
 // We use the same interface since task details need the same member operations as the board.
 type TaskDetailMemberService = BoardMemberService
 
+// TaskWorkspaceLookup is an alias for BoardWorkspaceLookup; task details load the SLA policy from it.
+type TaskWorkspaceLookup = BoardWorkspaceLookup
+
 // TaskSidebarViewData represents the data needed to render the task sidebar.
 type TaskSidebarViewData struct {
 	Task         TaskDetailViewData
@@ -95,6 +107,24 @@ type TaskDetailViewData struct {
 	DaysUntilDue int
 	CreatedAt    time.Time
 	Attachments  []TaskAttachmentViewData
+
+	// AttachmentsSize is the total size of attached files in bytes.
+	AttachmentsSize int64
+
+	Checklist        []TaskChecklistItemViewData
+	ChecklistTotal   int
+	ChecklistDone    int
+	ChecklistPercent int
+
+	// MentionedIn lists the messages that link to the task, newest first.
+	MentionedIn []TaskBacklinkViewData
+
+	// SLA countdown of a bug; nil when no SLA clock is running.
+	SLA *SLAViewData
+
+	// Assignee names come from the read model; empty until the projection resolves them.
+	AssigneeUsername    string
+	AssigneeDisplayName string
 }
 
 // TaskAttachmentViewData represents an attachment in the task detail view.
@@ -107,6 +137,25 @@ type TaskAttachmentViewData struct {
 	IsImage  bool
 }
 
+// TaskChecklistItemViewData represents a checklist item in the task detail view.
+type TaskChecklistItemViewData struct {
+	ID       string
+	Text     string
+	Done     bool
+	Position int
+	IsFirst  bool
+	IsLast   bool
+}
+
+// TaskBacklinkViewData represents a message mentioning the task.
+type TaskBacklinkViewData struct {
+	MessageID  string
+	AuthorName string
+	Excerpt    string
+	CreatedAt  time.Time
+	URL        string
+}
+
 // ActivityViewData represents a single activity item for the timeline.
 type ActivityViewData struct {
 	Actor      ActivityActorData
@@ -138,6 +187,8 @@ type TaskDetailTemplateHandler struct {
 	memberService   TaskDetailMemberService
 	chatInfoService ChatBasicInfoService
 	userLookup      UserLookupService
+	backlinks       TaskBacklinkService
+	workspaces      TaskWorkspaceLookup
 }
 
 // NewTaskDetailTemplateHandler creates a new task detail template handler.
@@ -164,6 +215,16 @@ func NewTaskDetailTemplateHandler(
 	}
 }
 
+// SetBacklinkService sets the service listing the messages that mention a task.
+func (h *TaskDetailTemplateHandler) SetBacklinkService(svc TaskBacklinkService) {
+	h.backlinks = svc
+}
+
+// SetWorkspaceLookup sets the service used to load the SLA policy of bugs.
+func (h *TaskDetailTemplateHandler) SetWorkspaceLookup(wl TaskWorkspaceLookup) {
+	h.workspaces = wl
+}
+
 // SetupTaskDetailRoutes registers task detail-related partial routes.
 func (h *TaskDetailTemplateHandler) SetupTaskDetailRoutes(e *echo.Echo) {
 	// Task detail partials (protected)
@@ -236,9 +297,13 @@ func (h *TaskDetailTemplateHandler) TaskDetailsByChatID(c echo.Context) error {
 		}
 	}
 
+	taskView := h.convertToDetailView(taskModel, middleware.GetLocation(c))
+	taskView.MentionedIn = h.loadBacklinks(c.Request().Context(), taskModel.ID)
+	taskView.SLA = h.loadSLA(c.Request().Context(), taskModel)
+
 	// Build data structure matching template expectations
 	innerData := map[string]any{
-		"Task":         h.convertToDetailView(taskModel),
+		"Task":         taskView,
 		"Chat":         chatInfo,
 		"Statuses":     getChatStatusOptions(chatInfo.Type),
 		"Priorities":   getPriorityOptions(),
@@ -291,8 +356,12 @@ func (h *TaskDetailTemplateHandler) TaskSidebarPartial(c echo.Context) error {
 		}
 	}
 
+	taskView := h.convertToDetailView(taskModel, middleware.GetLocation(c))
+	taskView.MentionedIn = h.loadBacklinks(c.Request().Context(), taskModel.ID)
+	taskView.SLA = h.loadSLA(c.Request().Context(), taskModel)
+
 	data := TaskSidebarViewData{
-		Task:         h.convertToDetailView(taskModel),
+		Task:         taskView,
 		Statuses:     getStatusOptions(),
 		Priorities:   getPriorityOptions(),
 		Participants: participants,
@@ -364,7 +433,7 @@ func (h *TaskDetailTemplateHandler) TaskEditTitleForm(c echo.Context) error {
 	}
 
 	data := map[string]any{
-		"Task": h.convertToDetailView(taskModel),
+		"Task": h.convertToDetailView(taskModel, middleware.GetLocation(c)),
 	}
 
 	return h.renderPartial(c, "task/edit-title", data)
@@ -392,7 +461,7 @@ func (h *TaskDetailTemplateHandler) TaskTitleDisplay(c echo.Context) error {
 	}
 
 	data := map[string]any{
-		"Task": h.convertToDetailView(taskModel),
+		"Task": h.convertToDetailView(taskModel, middleware.GetLocation(c)),
 	}
 
 	return h.renderPartial(c, "task/title-display", data)
@@ -420,7 +489,7 @@ func (h *TaskDetailTemplateHandler) TaskEditDescriptionForm(c echo.Context) erro
 	}
 
 	data := map[string]any{
-		"Task": h.convertToDetailView(taskModel),
+		"Task": h.convertToDetailView(taskModel, middleware.GetLocation(c)),
 	}
 
 	return h.renderPartial(c, "task/edit-description", data)
@@ -448,7 +517,7 @@ func (h *TaskDetailTemplateHandler) TaskDescriptionDisplay(c echo.Context) error
 	}
 
 	data := map[string]any{
-		"Task": h.convertToDetailView(taskModel),
+		"Task": h.convertToDetailView(taskModel, middleware.GetLocation(c)),
 	}
 
 	return h.renderPartial(c, "task/description-display", data)
@@ -479,7 +548,7 @@ func (h *TaskDetailTemplateHandler) TaskQuickEditPopover(c echo.Context) error {
 	var participants []MemberViewData
 
 	data := map[string]any{
-		"Task":         h.convertToDetailView(taskModel),
+		"Task":         h.convertToDetailView(taskModel, middleware.GetLocation(c)),
 		"Statuses":     getStatusOptions(),
 		"Priorities":   getPriorityOptions(),
 		"Participants": participants,
@@ -489,7 +558,7 @@ func (h *TaskDetailTemplateHandler) TaskQuickEditPopover(c echo.Context) error {
 }
 
 // convertToDetailView converts a task read model to detail view data.
-func (h *TaskDetailTemplateHandler) convertToDetailView(t *taskapp.ReadModel) TaskDetailViewData {
+func (h *TaskDetailTemplateHandler) convertToDetailView(t *taskapp.ReadModel, loc *time.Location) TaskDetailViewData {
 	view := TaskDetailViewData{
 		ID:        t.ID.String(),
 		ChatID:    t.ChatID.String(),
@@ -504,6 +573,8 @@ func (h *TaskDetailTemplateHandler) convertToDetailView(t *taskapp.ReadModel) Ta
 
 	if t.AssignedTo != nil {
 		view.AssigneeID = t.AssignedTo.String()
+		view.AssigneeUsername = t.AssigneeUsername
+		view.AssigneeDisplayName = t.AssigneeDisplayName
 	}
 
 	for _, a := range t.Attachments {
@@ -515,29 +586,48 @@ func (h *TaskDetailTemplateHandler) convertToDetailView(t *taskapp.ReadModel) Ta
 			URL:      fmt.Sprintf("/api/v1/files/%s/%s", a.FileID.String(), url.PathEscape(a.FileName)),
 			IsImage:  strings.HasPrefix(a.MimeType, "image/"),
 		})
+		view.AttachmentsSize += a.FileSize
+	}
+
+	view.ChecklistTotal = t.ChecklistTotal
+	view.ChecklistDone = t.ChecklistDone
+	view.ChecklistPercent = t.ChecklistPercent
+	for i, item := range t.Checklist {
+		view.Checklist = append(view.Checklist, TaskChecklistItemViewData{
+			ID:       item.ID.String(),
+			Text:     item.Text,
+			Done:     item.Done,
+			Position: item.Position,
+			IsFirst:  i == 0,
+			IsLast:   i == len(t.Checklist)-1,
+		})
 	}
 
-	h.calculateDueStatus(&view, t)
+	h.calculateDueStatus(&view, t, time.Now().In(loc))
 
 	return view
 }
 
 // calculateDueStatus sets overdue/due-soon/due-today flags on the view data.
-func (h *TaskDetailTemplateHandler) calculateDueStatus(view *TaskDetailViewData, t *taskapp.ReadModel) {
+// Days are counted in the calendar of now's location (the viewer's time zone).
+func (h *TaskDetailTemplateHandler) calculateDueStatus(
+	view *TaskDetailViewData,
+	t *taskapp.ReadModel,
+	now time.Time,
+) {
 	if t.DueDate == nil || t.Status == task.StatusDone {
 		return
 	}
 
-	now := time.Now()
-	dueDate := *t.DueDate
+	dueDate := t.DueDate.In(now.Location())
+	daysUntil := calendarDaysBetween(now, dueDate)
 
-	if dueDate.Before(now) {
+	if daysUntil < 0 {
 		view.IsOverdue = true
-		view.OverdueDays = int(now.Sub(dueDate).Hours() / hoursPerDay)
+		view.OverdueDays = -daysUntil
 		return
 	}
 
-	daysUntil := int(dueDate.Sub(now).Hours() / hoursPerDay)
 	view.DaysUntilDue = daysUntil
 
 	switch {
@@ -548,6 +638,16 @@ func (h *TaskDetailTemplateHandler) calculateDueStatus(view *TaskDetailViewData,
 	}
 }
 
+// calendarDaysBetween returns the number of calendar days from from to to,
+// both interpreted in from's location.
+func calendarDaysBetween(from, to time.Time) int {
+	loc := from.Location()
+	fromDay := time.Date(from.Year(), from.Month(), from.Day(), 0, 0, 0, 0, time.UTC)
+	to = to.In(loc)
+	toDay := time.Date(to.Year(), to.Month(), to.Day(), 0, 0, 0, 0, time.UTC)
+	return int(toDay.Sub(fromDay).Hours() / hoursPerDay)
+}
+
 // convertEventsToActivities converts domain events to activity view data.
 func (h *TaskDetailTemplateHandler) convertEventsToActivities(
 	ctx context.Context,
@@ -623,6 +723,12 @@ func extractActorID(e event.DomainEvent) string {
 		return te.AddedBy.String()
 	case *chatdomain.AttachmentRemoved:
 		return te.RemovedBy.String()
+	case *chatdomain.ChecklistItemAdded:
+		return te.AddedBy.String()
+	case *chatdomain.ChecklistItemToggled:
+		return te.ToggledBy.String()
+	case *chatdomain.ChecklistItemReordered:
+		return te.ReorderedBy.String()
 	case *chatdomain.Renamed:
 		return te.RenamedBy.String()
 	case *chatdomain.Closed:
@@ -648,6 +754,15 @@ func extractActorID(e event.DomainEvent) string {
 	return e.Metadata().UserID
 }
 
+// chatTypeLabel returns the display label of a chat type ("bug" -> "Bug").
+func chatTypeLabel(t chatdomain.Type) string {
+	s := string(t)
+	if s == "" {
+		return s
+	}
+	return strings.ToUpper(s[:1]) + s[1:]
+}
+
 // convertEventToActivity converts a single domain event to activity view data.
 //
 //nolint:cyclop,funlen // This is a domain event mapping table with intentionally broad branching.
@@ -665,7 +780,15 @@ func (h *TaskDetailTemplateHandler) convertEventToActivity(ctx context.Context,
 
 	switch te := e.(type) {
 	case *chatdomain.TypeChanged:
-		activity.ActionText = "created this task"
+		if te.OldType == chatdomain.TypeDiscussion {
+			activity.ActionText = "created this task"
+			break
+		}
+		// Reclassification, e.g. reverting a bug back to a discussion
+		activity.ActionText = "changed type"
+		activity.Details = true
+		activity.OldValue = chatTypeLabel(te.OldType)
+		activity.NewValue = chatTypeLabel(te.NewType)
 	case *chatdomain.StatusChanged:
 		activity.ActionText = "changed status"
 		activity.Details = true
@@ -702,6 +825,18 @@ func (h *TaskDetailTemplateHandler) convertEventToActivity(ctx context.Context,
 		activity.NewValue = te.FileName
 	case *chatdomain.AttachmentRemoved:
 		activity.ActionText = "removed attachment"
+	case *chatdomain.ChecklistItemAdded:
+		activity.ActionText = "added checklist item"
+		activity.Details = true
+		activity.NewValue = te.Text
+	case *chatdomain.ChecklistItemToggled:
+		if te.Done {
+			activity.ActionText = "completed checklist item"
+		} else {
+			activity.ActionText = "reopened checklist item"
+		}
+	case *chatdomain.ChecklistItemReordered:
+		activity.ActionText = "reordered checklist"
 	case *chatdomain.Renamed:
 		activity.ActionText = actionTextUpdatedTitle
 		activity.Details = true
@@ -770,6 +905,53 @@ func (h *TaskDetailTemplateHandler) convertEventToActivity(ctx context.Context,
 	return activity
 }
 
+// loadBacklinks returns the messages mentioning the task. Links are a convenience,
+// so lookup failures only leave the list empty.
+func (h *TaskDetailTemplateHandler) loadBacklinks(ctx context.Context, taskID uuid.UUID) []TaskBacklinkViewData {
+	if h.backlinks == nil {
+		return nil
+	}
+
+	backlinks, err := h.backlinks.FindBacklinks(ctx, taskID, maxTaskBacklinks)
+	if err != nil {
+		h.logger.WarnContext(ctx, "failed to load task backlinks",
+			slog.String("task_id", taskID.String()),
+			slog.String("error", err.Error()),
+		)
+		return nil
+	}
+
+	views := make([]TaskBacklinkViewData, 0, len(backlinks))
+	for _, link := range backlinks {
+		views = append(views, TaskBacklinkViewData{
+			MessageID:  link.MessageID.String(),
+			AuthorName: h.resolveUsername(ctx, link.AuthorID.String()),
+			Excerpt:    link.Excerpt,
+			CreatedAt:  link.CreatedAt,
+			URL:        fmt.Sprintf("/chats/%s/messages/%s", link.ChatID, link.MessageID),
+		})
+	}
+	return views
+}
+
+// loadSLA evaluates the SLA clock of a bug against its workspace policy; best-effort.
+func (h *TaskDetailTemplateHandler) loadSLA(ctx context.Context, t *taskapp.ReadModel) *SLAViewData {
+	if h.workspaces == nil || t.SLA == nil || t.WorkspaceID.IsZero() {
+		return nil
+	}
+
+	ws, err := h.workspaces.GetWorkspace(ctx, t.WorkspaceID)
+	if err != nil {
+		h.logger.Warn("failed to load workspace SLA policy",
+			slog.String("task_id", t.ID.String()),
+			slog.String("error", err.Error()),
+		)
+		return nil
+	}
+
+	return buildSLAView(ws.SLAPolicy(), t, time.Now())
+}
+
 // resolveUsername resolves a user ID to a display name.
 func (h *TaskDetailTemplateHandler) resolveUsername(ctx context.Context, userID string) string {
 	if h.userLookup != nil && userID != "" {
@@ -12,6 +12,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	taskapp "github.com/lllypuk/flowra/internal/application/task"
+	chatdomain "github.com/lllypuk/flowra/internal/domain/chat"
 	"github.com/lllypuk/flowra/internal/domain/event"
 	"github.com/lllypuk/flowra/internal/domain/task"
 	"github.com/lllypuk/flowra/internal/domain/uuid"
@@ -920,6 +921,37 @@ func TestTaskDetailTemplateHandler_EventTypeConversion(t *testing.T) {
 	})
 }
 
+func TestTaskDetailTemplateHandler_TaskActivityPartial_TypeConversions(t *testing.T) {
+	e := echo.New()
+	userID := uuid.NewUUID()
+	chatID := uuid.NewUUID()
+
+	mockEventService := NewMockTaskEventService()
+	metadata := event.Metadata{UserID: userID.String()}
+	mockEventService.AddEvents(chatID, []event.DomainEvent{
+		chatdomain.NewChatTypeChanged(chatID, chatdomain.TypeDiscussion, chatdomain.TypeBug, "Crash", 3, metadata),
+		chatdomain.NewChatTypeChanged(chatID, chatdomain.TypeBug, chatdomain.TypeDiscussion, "Crash", 4, metadata),
+	})
+
+	handler := httphandler.NewTaskDetailTemplateHandler(
+		newTestRenderer(t), nil, NewMockTaskDetailService(), mockEventService, nil, nil, nil,
+	)
+
+	req := httptest.NewRequest(http.MethodGet, "/partials/tasks/"+chatID.String()+"/activity", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetParamNames("task_id")
+	c.SetParamValues(chatID.String())
+	setUserContextForTaskDetail(c, userID)
+
+	require.NoError(t, handler.TaskActivityPartial(c))
+	body := rec.Body.String()
+	assert.Contains(t, body, "created this task")
+	assert.Contains(t, body, "changed type")
+	assert.Contains(t, body, `<span class="old-value">Bug</span>`)
+	assert.Contains(t, body, `<span class="new-value">Discussion</span>`)
+}
+
 func TestTaskDetailTemplateHandler_WithAssignee(t *testing.T) {
 	t.Run("task with assignee", func(t *testing.T) {
 		e := echo.New()
@@ -4,12 +4,14 @@ import (
 	"context"
 	"errors"
 	"net/http"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
 
 	"github.com/labstack/echo/v4"
 	taskapp "github.com/lllypuk/flowra/internal/application/task"
+	"github.com/lllypuk/flowra/internal/domain/errs"
 	"github.com/lllypuk/flowra/internal/domain/task"
 	"github.com/lllypuk/flowra/internal/domain/uuid"
 	"github.com/lllypuk/flowra/internal/infrastructure/httpserver"
@@ -18,10 +20,8 @@ import (
 
 // Validation constants for task handler.
 const (
-	maxTaskTitleLength       = 200
-	maxTaskDescriptionLength = 5000
-	defaultTaskListLimit     = 20
-	maxTaskListLimit         = 100
+	defaultTaskListLimit = 20
+	maxTaskListLimit     = 100
 )
 
 // Task handler errors.
@@ -39,12 +39,12 @@ var (
 
 // CreateTaskRequest represents the request to create a task.
 type CreateTaskRequest struct {
-	Title       string  `json:"title"`
-	Description string  `json:"description"`
+	Title       string  `json:"title"       validate:"required,max=200"`
+	Description string  `json:"description" validate:"max=5000"`
 	Priority    string  `json:"priority"`
-	AssigneeID  *string `json:"assignee_id"`
+	AssigneeID  *string `json:"assignee_id" validate:"uuid"`
 	DueDate     *string `json:"due_date"`
-	ChatID      *string `json:"chat_id"`
+	ChatID      *string `json:"chat_id"     validate:"required,uuid"`
 	EntityType  string  `json:"entity_type"`
 }
 
@@ -68,6 +68,26 @@ type SetDueDateRequest struct {
 	DueDate *string `json:"due_date" form:"due_date"`
 }
 
+// AddChecklistItemRequest represents the request to add a checklist item.
+type AddChecklistItemRequest struct {
+	Text string `json:"text" form:"text" validate:"required,notblank"`
+}
+
+// ToggleChecklistItemRequest represents the request to mark a checklist item as done or not done.
+type ToggleChecklistItemRequest struct {
+	Done bool `json:"done" form:"done"`
+}
+
+// ReorderChecklistItemRequest represents the request to move a checklist item.
+type ReorderChecklistItemRequest struct {
+	Position int `json:"position" form:"position" validate:"min=0"`
+}
+
+// SetEpicRequest represents the request to link a task to its parent epic.
+type SetEpicRequest struct {
+	EpicID string `json:"epic_id" form:"epic_id"`
+}
+
 // TaskResponse represents a task in API responses.
 type TaskResponse struct {
 	ID          string  `json:"id"`
@@ -83,6 +103,62 @@ type TaskResponse struct {
 	CreatedAt   string  `json:"created_at"`
 	UpdatedAt   string  `json:"updated_at,omitempty"`
 	Version     int     `json:"version"`
+
+	// User names are denormalized on the read model and omitted until resolved.
+	AssigneeUsername    string `json:"assignee_username,omitempty"`
+	AssigneeDisplayName string `json:"assignee_display_name,omitempty"`
+	ReporterUsername    string `json:"reporter_username,omitempty"`
+	ReporterDisplayName string `json:"reporter_display_name,omitempty"`
+
+	Estimate *TaskEstimateResponse `json:"estimate,omitempty"`
+	Sprint   string                `json:"sprint,omitempty"`
+
+	// Severity is set on bugs only; DuplicateOf is the original task of a duplicate.
+	Severity    string  `json:"severity,omitempty"`
+	DuplicateOf *string `json:"duplicate_of,omitempty"`
+	EpicID      *string `json:"epic_id,omitempty"`
+
+	Checklist         []TaskChecklistItemResponse    `json:"checklist,omitempty"`
+	ChecklistProgress *TaskChecklistProgressResponse `json:"checklist_progress,omitempty"`
+}
+
+// TaskChecklistItemResponse represents a checklist item in API responses.
+type TaskChecklistItemResponse struct {
+	ID       string `json:"id"`
+	Text     string `json:"text"`
+	Done     bool   `json:"done"`
+	Position int    `json:"position"`
+}
+
+// TaskChecklistProgressResponse represents checklist completion in API responses.
+type TaskChecklistProgressResponse struct {
+	Total   int `json:"total"`
+	Done    int `json:"done"`
+	Percent int `json:"percent"`
+}
+
+// EpicChildrenResponse represents a page of an epic's children with the status
+// breakdown of all of them.
+type EpicChildrenResponse struct {
+	Epic            TaskResponse         `json:"epic"`
+	Children        []TaskResponse       `json:"children"`
+	Total           int                  `json:"total"`
+	HasMore         bool                 `json:"has_more"`
+	StatusBreakdown map[string]int       `json:"status_breakdown"`
+	Progress        EpicProgressResponse `json:"progress"`
+}
+
+// EpicProgressResponse represents the completion of an epic; cancelled children are not counted.
+type EpicProgressResponse struct {
+	Total   int `json:"total"`
+	Done    int `json:"done"`
+	Percent int `json:"percent"`
+}
+
+// TaskEstimateResponse represents a task estimate in API responses.
+type TaskEstimateResponse struct {
+	Value float64 `json:"value"`
+	Unit  string  `json:"unit"`
 }
 
 // TaskListResponse represents a list of tasks in API responses.
@@ -127,12 +203,39 @@ type TaskService interface {
 
 	// RemoveAttachment removes an attachment from a task.
 	RemoveAttachment(ctx context.Context, cmd taskapp.RemoveAttachmentCommand) (taskapp.TaskResult, error)
+
+	// AddChecklistItem appends an item to the task checklist.
+	AddChecklistItem(ctx context.Context, cmd taskapp.AddChecklistItemCommand) (taskapp.TaskResult, error)
+
+	// ToggleChecklistItem marks a checklist item as done or not done.
+	ToggleChecklistItem(ctx context.Context, cmd taskapp.ToggleChecklistItemCommand) (taskapp.TaskResult, error)
+
+	// ReorderChecklistItem moves a checklist item to a new position.
+	ReorderChecklistItem(ctx context.Context, cmd taskapp.ReorderChecklistItemCommand) (taskapp.TaskResult, error)
+
+	// SetEpic links a task or bug to its parent epic or unlinks it.
+	SetEpic(ctx context.Context, cmd taskapp.SetEpicCommand) (taskapp.TaskResult, error)
+}
+
+// TaskStreamer streams tasks for exports.
+// Declared on the consumer side per project guidelines.
+type TaskStreamer interface {
+	// StreamTasks calls fn for every task matching the filters, oldest first,
+	// starting right behind the task after unless it is zero. Limit and Offset are
+	// ignored. It returns errs.ErrNotFound if after is not a task.
+	StreamTasks(
+		ctx context.Context,
+		filters taskapp.Filters,
+		after uuid.UUID,
+		fn func(*taskapp.ReadModel) error,
+	) error
 }
 
 // TaskHandler handles task-related HTTP requests.
 type TaskHandler struct {
 	taskService   TaskService
 	actionService TaskActionService
+	streamer      TaskStreamer
 }
 
 // NewTaskHandler creates a new TaskHandler.
@@ -148,6 +251,11 @@ func NewTaskHandler(taskService TaskService, actionServices ...TaskActionService
 	}
 }
 
+// SetTaskStreamer enables the NDJSON export of tasks.
+func (h *TaskHandler) SetTaskStreamer(streamer TaskStreamer) {
+	h.streamer = streamer
+}
+
 func (h *TaskHandler) ensureActionService() bool {
 	return h.actionService != nil
 }
@@ -157,6 +265,7 @@ func (h *TaskHandler) RegisterRoutes(r *httpserver.Router) {
 	// Task creation (workspace-scoped)
 	r.Workspace().POST("/tasks", h.Create)
 	r.Workspace().GET("/tasks", h.List)
+	r.Workspace().GET("/tasks/export", h.Export)
 
 	// Task operations (authenticated routes with task ID)
 	r.Auth().GET("/tasks/:id", h.Get)
@@ -181,42 +290,23 @@ func (h *TaskHandler) Create(c echo.Context) error {
 			c, http.StatusBadRequest, "INVALID_REQUEST", "invalid request body")
 	}
 
-	// Validate request
-	if valErr := validateCreateTaskRequest(&req); valErr != nil {
-		return httpserver.RespondErrorWithCode(
-			c, http.StatusBadRequest, "VALIDATION_ERROR", valErr.Error())
+	if valErr := validateRequest(&req); valErr != nil {
+		return httpserver.RespondValidationError(c, valErr)
 	}
 
-	// Parse ChatID - can come from request body or be derived from workspace
-	var chatID uuid.UUID
-	if req.ChatID != nil && *req.ChatID != "" {
-		var parseErr error
-		chatID, parseErr = uuid.ParseUUID(*req.ChatID)
-		if parseErr != nil {
-			return httpserver.RespondErrorWithCode(
-				c, http.StatusBadRequest, "INVALID_CHAT_ID", "invalid chat ID format")
-		}
-	} else {
-		// If no chat ID provided, we need to handle this based on business logic
-		// For now, return an error
-		return httpserver.RespondErrorWithCode(
-			c, http.StatusBadRequest, "CHAT_ID_REQUIRED", "chat_id is required")
-	}
+	// IDs are validated above
+	chatID := uuid.UUID(*req.ChatID)
 
 	// Parse optional fields
 	var assigneeID *uuid.UUID
 	if req.AssigneeID != nil && *req.AssigneeID != "" {
-		parsed, parseErr := uuid.ParseUUID(*req.AssigneeID)
-		if parseErr != nil {
-			return httpserver.RespondErrorWithCode(
-				c, http.StatusBadRequest, "INVALID_ASSIGNEE_ID", "invalid assignee ID format")
-		}
+		parsed := uuid.UUID(*req.AssigneeID)
 		assigneeID = &parsed
 	}
 
 	var dueDate *time.Time
 	if req.DueDate != nil && *req.DueDate != "" {
-		parsed, parseErr := time.Parse("2006-01-02", *req.DueDate)
+		parsed, parseErr := time.ParseInLocation("2006-01-02", *req.DueDate, middleware.GetLocation(c))
 		if parseErr != nil {
 			return httpserver.RespondErrorWithCode(
 				c, http.StatusBadRequest, "INVALID_DUE_DATE", "invalid due date format, expected YYYY-MM-DD")
@@ -281,9 +371,58 @@ func (h *TaskHandler) List(c echo.Context) error {
 		return httpserver.RespondErrorWithCode(c, http.StatusUnauthorized, "UNAUTHORIZED", "authentication required")
 	}
 
-	// Parse filters
-	filters := parseTaskFilters(c)
+	return h.respondTaskList(c, parseTaskFilters(c))
+}
+
+// Triage handles GET /api/v1/workspaces/:workspace_id/tasks/triage.
+// Lists new bugs that still lack a severity or an assignee and are not marked as duplicates.
+func (h *TaskHandler) Triage(c echo.Context) error {
+	userID := middleware.GetUserID(c)
+	if userID.IsZero() {
+		return httpserver.RespondErrorWithCode(c, http.StatusUnauthorized, "UNAUTHORIZED", "authentication required")
+	}
+
+	filters := taskapp.Filters{AwaitingTriage: true}
+	if workspaceID, err := uuid.ParseUUID(c.Param("workspace_id")); err == nil {
+		filters.WorkspaceID = &workspaceID
+	}
+	filters.Limit, filters.Offset = parseTaskPagination(c, defaultTaskListLimit)
+
+	return h.respondTaskList(c, filters)
+}
 
+// Export handles GET /api/v1/workspaces/:workspace_id/tasks/export.
+// Streams every task of the workspace matching the list filters, oldest first, as
+// NDJSON with one task per line. A client that lost the connection resumes with
+// ?after=<id of the last task it received>.
+func (h *TaskHandler) Export(c echo.Context) error {
+	userID := middleware.GetUserID(c)
+	if userID.IsZero() {
+		return httpserver.RespondErrorWithCode(c, http.StatusUnauthorized, "UNAUTHORIZED", "authentication required")
+	}
+	if h.streamer == nil {
+		return httpserver.RespondErrorWithCode(
+			c, http.StatusServiceUnavailable, "SERVICE_UNAVAILABLE", "task export is not available")
+	}
+
+	after, cursorErr := parseExportCursor(c)
+	if cursorErr != nil {
+		return httpserver.RespondErrorWithCode(c, http.StatusBadRequest, "INVALID_CURSOR", "invalid cursor format")
+	}
+
+	stream := httpserver.NewNDJSONStream(c)
+	err := h.streamer.StreamTasks(c.Request().Context(), parseTaskFilters(c), after,
+		func(t *taskapp.ReadModel) error {
+			return stream.Write(ToTaskResponseFromReadModel(t))
+		})
+	if errors.Is(err, errs.ErrNotFound) && !stream.Started() {
+		return httpserver.RespondErrorWithCode(c, http.StatusBadRequest, "INVALID_CURSOR", "cursor is not a task")
+	}
+	return stream.Close(err)
+}
+
+// respondTaskList responds with a page of tasks matching the filters and their total count.
+func (h *TaskHandler) respondTaskList(c echo.Context, filters taskapp.Filters) error {
 	// Get tasks
 	tasks, err := h.taskService.ListTasks(c.Request().Context(), filters)
 	if err != nil {
@@ -310,7 +449,7 @@ func (h *TaskHandler) List(c echo.Context) error {
 		HasMore: hasMore,
 	}
 
-	return httpserver.RespondOK(c, resp)
+	return httpserver.RespondPage(c, resp, httpserver.NewPagination(filters.Offset, filters.Limit, total))
 }
 
 // ChangeStatus handles PUT /api/v1/tasks/:id/status.
@@ -539,7 +678,7 @@ func (h *TaskHandler) SetDueDate(c echo.Context) error {
 
 	var dueDate *time.Time
 	if req.DueDate != nil && *req.DueDate != "" {
-		parsed, dueDateErr := time.Parse("2006-01-02", *req.DueDate)
+		parsed, dueDateErr := time.ParseInLocation("2006-01-02", *req.DueDate, middleware.GetLocation(c))
 		if dueDateErr != nil {
 			return httpserver.RespondErrorWithCode(
 				c, http.StatusBadRequest, "INVALID_DUE_DATE", "invalid due date format, expected YYYY-MM-DD")
@@ -689,17 +828,270 @@ func (h *TaskHandler) RemoveAttachment(c echo.Context) error {
 	return httpserver.RespondOK(c, map[string]string{"status": "removed"})
 }
 
-func validateCreateTaskRequest(req *CreateTaskRequest) error {
-	if req.Title == "" {
-		return ErrTaskTitleRequired
+// AddChecklistItem handles POST /api/v1/workspaces/:workspace_id/tasks/:task_id/checklist.
+func (h *TaskHandler) AddChecklistItem(c echo.Context) error {
+	userID := middleware.GetUserID(c)
+	if userID.IsZero() {
+		return httpserver.RespondErrorWithCode(c, http.StatusUnauthorized, "UNAUTHORIZED", "authentication required")
+	}
+
+	taskID, parseErr := uuid.ParseUUID(c.Param("task_id"))
+	if parseErr != nil {
+		return httpserver.RespondErrorWithCode(
+			c, http.StatusBadRequest, "INVALID_TASK_ID", "invalid task ID format")
+	}
+
+	var req AddChecklistItemRequest
+	if err := c.Bind(&req); err != nil {
+		return httpserver.RespondErrorWithCode(
+			c, http.StatusBadRequest, "INVALID_REQUEST", "invalid request body")
+	}
+	if valErr := validateRequest(&req); valErr != nil {
+		return httpserver.RespondValidationError(c, valErr)
+	}
+
+	cmd := taskapp.AddChecklistItemCommand{
+		TaskID:  taskID,
+		Text:    req.Text,
+		AddedBy: userID,
+	}
+
+	if _, err := h.taskService.AddChecklistItem(c.Request().Context(), cmd); err != nil {
+		return httpserver.RespondError(c, err)
+	}
+
+	return h.respondWithTask(c, taskID, http.StatusCreated)
+}
+
+// ToggleChecklistItem handles PUT /api/v1/workspaces/:workspace_id/tasks/:task_id/checklist/:item_id.
+func (h *TaskHandler) ToggleChecklistItem(c echo.Context) error {
+	userID := middleware.GetUserID(c)
+	if userID.IsZero() {
+		return httpserver.RespondErrorWithCode(c, http.StatusUnauthorized, "UNAUTHORIZED", "authentication required")
+	}
+
+	taskID, parseErr := uuid.ParseUUID(c.Param("task_id"))
+	if parseErr != nil {
+		return httpserver.RespondErrorWithCode(
+			c, http.StatusBadRequest, "INVALID_TASK_ID", "invalid task ID format")
+	}
+
+	itemID, itemParseErr := uuid.ParseUUID(c.Param("item_id"))
+	if itemParseErr != nil {
+		return httpserver.RespondErrorWithCode(
+			c, http.StatusBadRequest, "INVALID_ITEM_ID", "invalid checklist item ID format")
+	}
+
+	var req ToggleChecklistItemRequest
+	if err := c.Bind(&req); err != nil {
+		return httpserver.RespondErrorWithCode(
+			c, http.StatusBadRequest, "INVALID_REQUEST", "invalid request body")
 	}
-	if len(req.Title) > maxTaskTitleLength {
-		return ErrTaskTitleTooLong
+
+	cmd := taskapp.ToggleChecklistItemCommand{
+		TaskID:    taskID,
+		ItemID:    itemID,
+		Done:      req.Done,
+		ToggledBy: userID,
 	}
-	if len(req.Description) > maxTaskDescriptionLength {
-		return ErrTaskDescriptionTooLong
+
+	if _, err := h.taskService.ToggleChecklistItem(c.Request().Context(), cmd); err != nil {
+		return httpserver.RespondError(c, err)
 	}
-	return nil
+
+	return h.respondWithTask(c, taskID, http.StatusOK)
+}
+
+// ReorderChecklistItem handles PUT /api/v1/workspaces/:workspace_id/tasks/:task_id/checklist/:item_id/position.
+func (h *TaskHandler) ReorderChecklistItem(c echo.Context) error {
+	userID := middleware.GetUserID(c)
+	if userID.IsZero() {
+		return httpserver.RespondErrorWithCode(c, http.StatusUnauthorized, "UNAUTHORIZED", "authentication required")
+	}
+
+	taskID, parseErr := uuid.ParseUUID(c.Param("task_id"))
+	if parseErr != nil {
+		return httpserver.RespondErrorWithCode(
+			c, http.StatusBadRequest, "INVALID_TASK_ID", "invalid task ID format")
+	}
+
+	itemID, itemParseErr := uuid.ParseUUID(c.Param("item_id"))
+	if itemParseErr != nil {
+		return httpserver.RespondErrorWithCode(
+			c, http.StatusBadRequest, "INVALID_ITEM_ID", "invalid checklist item ID format")
+	}
+
+	var req ReorderChecklistItemRequest
+	if err := c.Bind(&req); err != nil {
+		return httpserver.RespondErrorWithCode(
+			c, http.StatusBadRequest, "INVALID_REQUEST", "invalid request body")
+	}
+	if valErr := validateRequest(&req); valErr != nil {
+		return httpserver.RespondValidationError(c, valErr)
+	}
+
+	cmd := taskapp.ReorderChecklistItemCommand{
+		TaskID:      taskID,
+		ItemID:      itemID,
+		Position:    req.Position,
+		ReorderedBy: userID,
+	}
+
+	if _, err := h.taskService.ReorderChecklistItem(c.Request().Context(), cmd); err != nil {
+		return httpserver.RespondError(c, err)
+	}
+
+	return h.respondWithTask(c, taskID, http.StatusOK)
+}
+
+// SetEpic handles PUT /api/v1/workspaces/:workspace_id/tasks/:task_id/epic.
+// Links a task or bug to an epic of the same workspace; epics cannot be children.
+func (h *TaskHandler) SetEpic(c echo.Context) error {
+	userID := middleware.GetUserID(c)
+	if userID.IsZero() {
+		return httpserver.RespondErrorWithCode(c, http.StatusUnauthorized, "UNAUTHORIZED", "authentication required")
+	}
+
+	taskID, parseErr := uuid.ParseUUID(c.Param("task_id"))
+	if parseErr != nil {
+		return httpserver.RespondErrorWithCode(
+			c, http.StatusBadRequest, "INVALID_TASK_ID", "invalid task ID format")
+	}
+
+	var req SetEpicRequest
+	if err := c.Bind(&req); err != nil {
+		return httpserver.RespondErrorWithCode(
+			c, http.StatusBadRequest, "INVALID_REQUEST", "invalid request body")
+	}
+	epicID, epicParseErr := uuid.ParseUUID(strings.TrimSpace(req.EpicID))
+	if epicParseErr != nil {
+		return httpserver.RespondErrorWithCode(
+			c, http.StatusBadRequest, "INVALID_EPIC_ID", "invalid epic ID format")
+	}
+
+	cmd := taskapp.SetEpicCommand{
+		TaskID: taskID,
+		EpicID: &epicID,
+		SetBy:  userID,
+	}
+
+	if _, err := h.taskService.SetEpic(c.Request().Context(), cmd); err != nil {
+		return httpserver.RespondError(c, err)
+	}
+
+	return h.respondWithTask(c, taskID, http.StatusOK)
+}
+
+// UnlinkEpic handles DELETE /api/v1/workspaces/:workspace_id/tasks/:task_id/epic.
+func (h *TaskHandler) UnlinkEpic(c echo.Context) error {
+	userID := middleware.GetUserID(c)
+	if userID.IsZero() {
+		return httpserver.RespondErrorWithCode(c, http.StatusUnauthorized, "UNAUTHORIZED", "authentication required")
+	}
+
+	taskID, parseErr := uuid.ParseUUID(c.Param("task_id"))
+	if parseErr != nil {
+		return httpserver.RespondErrorWithCode(
+			c, http.StatusBadRequest, "INVALID_TASK_ID", "invalid task ID format")
+	}
+
+	cmd := taskapp.SetEpicCommand{
+		TaskID: taskID,
+		SetBy:  userID,
+	}
+
+	if _, err := h.taskService.SetEpic(c.Request().Context(), cmd); err != nil {
+		return httpserver.RespondError(c, err)
+	}
+
+	return h.respondWithTask(c, taskID, http.StatusOK)
+}
+
+// ListEpicChildren handles GET /api/v1/workspaces/:workspace_id/tasks/:task_id/children.
+// Lists a page of the epic's children with the status breakdown of all children.
+func (h *TaskHandler) ListEpicChildren(c echo.Context) error {
+	userID := middleware.GetUserID(c)
+	if userID.IsZero() {
+		return httpserver.RespondErrorWithCode(c, http.StatusUnauthorized, "UNAUTHORIZED", "authentication required")
+	}
+
+	epicID, parseErr := uuid.ParseUUID(c.Param("task_id"))
+	if parseErr != nil {
+		return httpserver.RespondErrorWithCode(
+			c, http.StatusBadRequest, "INVALID_TASK_ID", "invalid task ID format")
+	}
+
+	ctx := c.Request().Context()
+	epic, err := h.taskService.GetTask(ctx, epicID)
+	if err != nil {
+		return httpserver.RespondError(c, err)
+	}
+	if epic.EntityType != task.TypeEpic {
+		return httpserver.RespondError(c, taskapp.ErrEpicNotFound)
+	}
+
+	filters := taskapp.Filters{EpicID: &epicID}
+	if workspaceID, wsErr := uuid.ParseUUID(c.Param("workspace_id")); wsErr == nil {
+		filters.WorkspaceID = &workspaceID
+	}
+	filters.Limit, filters.Offset = parseTaskPagination(c, defaultTaskListLimit)
+
+	children, err := h.taskService.ListTasks(ctx, filters)
+	if err != nil {
+		return httpserver.RespondError(c, err)
+	}
+
+	resp := EpicChildrenResponse{
+		Epic:            ToTaskResponseFromReadModel(epic),
+		Children:        make([]TaskResponse, 0, len(children)),
+		StatusBreakdown: make(map[string]int),
+	}
+	for _, child := range children {
+		resp.Children = append(resp.Children, ToTaskResponseFromReadModel(child))
+	}
+
+	// Counted per status so the breakdown covers every child, not just the page
+	for _, status := range epicChildStatuses {
+		statusFilters := taskapp.Filters{WorkspaceID: filters.WorkspaceID, EpicID: &epicID, Status: &status}
+		count, countErr := h.taskService.CountTasks(ctx, statusFilters)
+		if countErr != nil {
+			return httpserver.RespondError(c, countErr)
+		}
+		resp.StatusBreakdown[string(status)] = count
+		resp.Total += count
+	}
+
+	resp.HasMore = filters.Offset+len(children) < resp.Total
+	resp.Progress.Total = resp.Total - resp.StatusBreakdown[string(task.StatusCancelled)]
+	resp.Progress.Done = resp.StatusBreakdown[string(task.StatusDone)]
+	resp.Progress.Percent = percentOf(resp.Progress.Done, resp.Progress.Total)
+
+	return httpserver.RespondOK(c, resp)
+}
+
+// epicChildStatuses are the statuses reported in the breakdown of an epic's children.
+//
+//nolint:gochecknoglobals // read-only list of domain statuses
+var epicChildStatuses = []task.Status{
+	task.StatusBacklog,
+	task.StatusToDo,
+	task.StatusInProgress,
+	task.StatusInReview,
+	task.StatusDone,
+	task.StatusCancelled,
+}
+
+// respondWithTask reloads the task read model and responds with it.
+func (h *TaskHandler) respondWithTask(c echo.Context, taskID uuid.UUID, status int) error {
+	taskModel, err := h.taskService.GetTask(c.Request().Context(), taskID)
+	if err != nil {
+		return httpserver.RespondError(c, err)
+	}
+
+	if status == http.StatusCreated {
+		return httpserver.RespondCreated(c, ToTaskResponseFromReadModel(taskModel))
+	}
+	return httpserver.RespondOK(c, ToTaskResponseFromReadModel(taskModel))
 }
 
 func parseEntityType(s string) task.EntityType {
@@ -780,6 +1172,7 @@ func parseTaskFilters(c echo.Context) taskapp.Filters {
 	filters.AssigneeID = parseUUIDFilter(c.QueryParam("assignee_id"))
 	filters.Priority = parsePriorityFilter(c.QueryParam("priority"))
 	filters.ChatID = parseUUIDFilter(c.QueryParam("chat_id"))
+	filters.Sprint = strings.TrimSpace(c.QueryParam("sprint"))
 
 	limit, offset := parseTaskPagination(c, filters.Limit)
 	filters.Limit = limit
@@ -833,6 +1226,9 @@ func parseTaskPagination(c echo.Context, defaultLimit int) (int, int) {
 			offset = (page - 1) * limit
 		}
 	}
+	if o, ok := httpserver.CursorOffset(c); ok {
+		offset = o
+	}
 
 	return limit, offset
 }
@@ -870,11 +1266,32 @@ func ToTaskResponseFromReadModel(rm *taskapp.ReadModel) TaskResponse {
 		ReporterID: rm.CreatedBy.String(),
 		CreatedAt:  rm.CreatedAt.Format(time.RFC3339),
 		Version:    rm.Version,
+		Sprint:     rm.Sprint,
+		Severity:   rm.Severity,
+
+		ReporterUsername:    rm.CreatorUsername,
+		ReporterDisplayName: rm.CreatorDisplayName,
+	}
+
+	if rm.Estimate != nil {
+		resp.Estimate = &TaskEstimateResponse{Value: rm.Estimate.Value, Unit: rm.Estimate.Unit}
+	}
+
+	if rm.DuplicateOf != nil {
+		duplicateOf := rm.DuplicateOf.String()
+		resp.DuplicateOf = &duplicateOf
+	}
+
+	if rm.EpicID != nil {
+		epicID := rm.EpicID.String()
+		resp.EpicID = &epicID
 	}
 
 	if rm.AssignedTo != nil {
 		assigneeStr := rm.AssignedTo.String()
 		resp.AssigneeID = &assigneeStr
+		resp.AssigneeUsername = rm.AssigneeUsername
+		resp.AssigneeDisplayName = rm.AssigneeDisplayName
 	}
 
 	if rm.DueDate != nil {
@@ -882,6 +1299,22 @@ func ToTaskResponseFromReadModel(rm *taskapp.ReadModel) TaskResponse {
 		resp.DueDate = &dueDateStr
 	}
 
+	if rm.ChecklistTotal > 0 {
+		resp.ChecklistProgress = &TaskChecklistProgressResponse{
+			Total:   rm.ChecklistTotal,
+			Done:    rm.ChecklistDone,
+			Percent: rm.ChecklistPercent,
+		}
+	}
+	for _, item := range rm.Checklist {
+		resp.Checklist = append(resp.Checklist, TaskChecklistItemResponse{
+			ID:       item.ID.String(),
+			Text:     item.Text,
+			Done:     item.Done,
+			Position: item.Position,
+		})
+	}
+
 	return resp
 }
 
@@ -978,23 +1411,18 @@ func (m *MockTaskService) ListTasks(_ context.Context, filters taskapp.Filters)
 	result := make([]*taskapp.ReadModel, 0)
 
 	for _, t := range m.tasks {
-		// Apply filters
-		if filters.Status != nil && t.Status != *filters.Status {
-			continue
-		}
-		if filters.AssigneeID != nil && (t.AssignedTo == nil || *t.AssignedTo != *filters.AssigneeID) {
-			continue
-		}
-		if filters.Priority != nil && t.Priority != *filters.Priority {
-			continue
-		}
-		if filters.ChatID != nil && t.ChatID != *filters.ChatID {
+		if !mockTaskMatches(t, filters) {
 			continue
 		}
 
 		result = append(result, t)
 	}
 
+	// Map iteration order is random; sort so that pages do not overlap
+	slices.SortFunc(result, func(a, b *taskapp.ReadModel) int {
+		return strings.Compare(a.ID.String(), b.ID.String())
+	})
+
 	// Apply pagination
 	start := min(filters.Offset, len(result))
 	end := min(start+filters.Limit, len(result))
@@ -1007,17 +1435,7 @@ func (m *MockTaskService) CountTasks(_ context.Context, filters taskapp.Filters)
 	count := 0
 
 	for _, t := range m.tasks {
-		// Apply filters
-		if filters.Status != nil && t.Status != *filters.Status {
-			continue
-		}
-		if filters.AssigneeID != nil && (t.AssignedTo == nil || *t.AssignedTo != *filters.AssigneeID) {
-			continue
-		}
-		if filters.Priority != nil && t.Priority != *filters.Priority {
-			continue
-		}
-		if filters.ChatID != nil && t.ChatID != *filters.ChatID {
+		if !mockTaskMatches(t, filters) {
 			continue
 		}
 
@@ -1027,6 +1445,25 @@ func (m *MockTaskService) CountTasks(_ context.Context, filters taskapp.Filters)
 	return count, nil
 }
 
+func mockTaskMatches(t *taskapp.ReadModel, filters taskapp.Filters) bool {
+	if filters.Status != nil && t.Status != *filters.Status {
+		return false
+	}
+	if filters.AssigneeID != nil && (t.AssignedTo == nil || *t.AssignedTo != *filters.AssigneeID) {
+		return false
+	}
+	if filters.Priority != nil && t.Priority != *filters.Priority {
+		return false
+	}
+	if filters.ChatID != nil && t.ChatID != *filters.ChatID {
+		return false
+	}
+	if filters.EpicID != nil && (t.EpicID == nil || *t.EpicID != *filters.EpicID) {
+		return false
+	}
+	return true
+}
+
 // ChangeStatus changes task status in the mock service.
 func (m *MockTaskService) ChangeStatus(
 	_ context.Context,
@@ -1110,6 +1547,12 @@ func (m *MockTaskService) AddAttachment(
 	if !ok {
 		return taskapp.TaskResult{}, taskapp.ErrTaskNotFound
 	}
+	t.Attachments = append(t.Attachments, taskapp.AttachmentReadModel{
+		FileID:   cmd.FileID,
+		FileName: cmd.FileName,
+		FileSize: cmd.FileSize,
+		MimeType: cmd.MimeType,
+	})
 	t.Version++
 	return taskapp.NewSuccessResult(cmd.TaskID, t.Version), nil
 }
@@ -1126,3 +1569,107 @@ func (m *MockTaskService) RemoveAttachment(
 	t.Version++
 	return taskapp.NewSuccessResult(cmd.TaskID, t.Version), nil
 }
+
+// AddChecklistItem appends a checklist item in the mock service.
+func (m *MockTaskService) AddChecklistItem(
+	_ context.Context,
+	cmd taskapp.AddChecklistItemCommand,
+) (taskapp.TaskResult, error) {
+	t, ok := m.tasks[cmd.TaskID]
+	if !ok {
+		return taskapp.TaskResult{}, taskapp.ErrTaskNotFound
+	}
+	t.Checklist = append(t.Checklist, taskapp.ChecklistItemReadModel{
+		ID:       uuid.NewUUID(),
+		Text:     strings.TrimSpace(cmd.Text),
+		Position: len(t.Checklist),
+	})
+	m.updateChecklistProgress(t)
+	t.Version++
+	return taskapp.NewSuccessResult(cmd.TaskID, t.Version), nil
+}
+
+// ToggleChecklistItem marks a checklist item in the mock service.
+func (m *MockTaskService) ToggleChecklistItem(
+	_ context.Context,
+	cmd taskapp.ToggleChecklistItemCommand,
+) (taskapp.TaskResult, error) {
+	t, ok := m.tasks[cmd.TaskID]
+	if !ok {
+		return taskapp.TaskResult{}, taskapp.ErrTaskNotFound
+	}
+	for i := range t.Checklist {
+		if t.Checklist[i].ID == cmd.ItemID {
+			t.Checklist[i].Done = cmd.Done
+			m.updateChecklistProgress(t)
+			t.Version++
+			return taskapp.NewSuccessResult(cmd.TaskID, t.Version), nil
+		}
+	}
+	return taskapp.TaskResult{}, taskapp.ErrChecklistItemNotFound
+}
+
+// ReorderChecklistItem moves a checklist item in the mock service.
+func (m *MockTaskService) ReorderChecklistItem(
+	_ context.Context,
+	cmd taskapp.ReorderChecklistItemCommand,
+) (taskapp.TaskResult, error) {
+	t, ok := m.tasks[cmd.TaskID]
+	if !ok {
+		return taskapp.TaskResult{}, taskapp.ErrTaskNotFound
+	}
+	if cmd.Position >= len(t.Checklist) {
+		return taskapp.TaskResult{}, errs.ErrInvalidInput
+	}
+	for i, item := range t.Checklist {
+		if item.ID != cmd.ItemID {
+			continue
+		}
+		rest := append(t.Checklist[:i:i], t.Checklist[i+1:]...)
+		t.Checklist = append(rest[:cmd.Position:cmd.Position], append([]taskapp.ChecklistItemReadModel{item},
+			rest[cmd.Position:]...)...)
+		for j := range t.Checklist {
+			t.Checklist[j].Position = j
+		}
+		t.Version++
+		return taskapp.NewSuccessResult(cmd.TaskID, t.Version), nil
+	}
+	return taskapp.TaskResult{}, taskapp.ErrChecklistItemNotFound
+}
+
+// SetEpic links a task to an epic in the mock service.
+func (m *MockTaskService) SetEpic(
+	_ context.Context,
+	cmd taskapp.SetEpicCommand,
+) (taskapp.TaskResult, error) {
+	t, ok := m.tasks[cmd.TaskID]
+	if !ok {
+		return taskapp.TaskResult{}, taskapp.ErrTaskNotFound
+	}
+	if cmd.EpicID != nil {
+		if t.EntityType == task.TypeEpic {
+			return taskapp.TaskResult{}, taskapp.ErrInvalidHierarchy
+		}
+		epic, found := m.tasks[*cmd.EpicID]
+		if !found || epic.EntityType != task.TypeEpic {
+			return taskapp.TaskResult{}, taskapp.ErrEpicNotFound
+		}
+	}
+	t.EpicID = cmd.EpicID
+	t.Version++
+	return taskapp.NewSuccessResult(cmd.TaskID, t.Version), nil
+}
+
+func (m *MockTaskService) updateChecklistProgress(t *taskapp.ReadModel) {
+	t.ChecklistTotal = len(t.Checklist)
+	t.ChecklistDone = 0
+	for _, item := range t.Checklist {
+		if item.Done {
+			t.ChecklistDone++
+		}
+	}
+	t.ChecklistPercent = 0
+	if t.ChecklistTotal > 0 {
+		t.ChecklistPercent = t.ChecklistDone * 100 / t.ChecklistTotal
+	}
+}
@@ -79,6 +79,24 @@ func (s *spyTaskWriteActionService) SetDueDate(
 	return &appcore.ActionResult{Success: true}, nil
 }
 
+func (s *spyTaskWriteActionService) SetSeverity(
+	_ context.Context,
+	_ uuid.UUID,
+	_ string,
+	_ uuid.UUID,
+) (*appcore.ActionResult, error) {
+	return &appcore.ActionResult{Success: true}, nil
+}
+
+func (s *spyTaskWriteActionService) MarkDuplicate(
+	_ context.Context,
+	_ uuid.UUID,
+	_ *uuid.UUID,
+	_ uuid.UUID,
+) (*appcore.ActionResult, error) {
+	return &appcore.ActionResult{Success: true}, nil
+}
+
 func TestTaskHandler_ChangeStatus_UsesActionServiceWhenConfigured(t *testing.T) {
 	e := echo.New()
 	userID := uuid.NewUUID()
@@ -353,6 +353,52 @@ func TestTaskHandler_List(t *testing.T) {
 		assert.Equal(t, stdhttp.StatusOK, rec.Code)
 	})
 
+	t.Run("cursor round trip", func(t *testing.T) {
+		userID := uuid.NewUUID()
+		workspaceID := uuid.NewUUID()
+		chatID := uuid.NewUUID()
+
+		mockService := httphandler.NewMockTaskService()
+		for range 3 {
+			mockService.AddTask(createTestTaskReadModel(chatID, userID))
+		}
+		handler := newTaskHandlerWithAction(mockService)
+
+		list := func(t *testing.T, query string) (httphandler.TaskListResponse, *httpserver.Pagination) {
+			t.Helper()
+			req := httptest.NewRequest(stdhttp.MethodGet, workspaceTasksURL(workspaceID)+query, nil)
+			rec := httptest.NewRecorder()
+			c := echo.New().NewContext(req, rec)
+			c.SetParamNames("workspace_id")
+			c.SetParamValues(workspaceID.String())
+			setupTaskAuthContext(c, userID)
+
+			require.NoError(t, handler.List(c))
+			require.Equal(t, stdhttp.StatusOK, rec.Code)
+			return decodeListPage[httphandler.TaskListResponse](t, rec)
+		}
+
+		first, firstPage := list(t, "?per_page=2")
+		require.Len(t, first.Tasks, 2)
+		require.NotNil(t, firstPage.Total)
+		assert.Equal(t, 3, *firstPage.Total)
+		assert.True(t, firstPage.HasMore)
+		require.Equal(t, httpserver.EncodeOffsetCursor(2), firstPage.NextCursor)
+
+		second, secondPage := list(t, "?per_page=2&cursor="+firstPage.NextCursor)
+		require.Len(t, second.Tasks, 1)
+		assert.Equal(t, 2, secondPage.Offset)
+		assert.False(t, secondPage.HasMore)
+		assert.Empty(t, secondPage.NextCursor)
+		assert.Equal(t, httpserver.EncodeOffsetCursor(0), secondPage.PrevCursor)
+		for _, listed := range first.Tasks {
+			assert.NotEqual(t, listed.ID, second.Tasks[0].ID)
+		}
+
+		back, _ := list(t, "?per_page=2&cursor="+secondPage.PrevCursor)
+		assert.Equal(t, first.Tasks, back.Tasks)
+	})
+
 	t.Run("list with pagination", func(t *testing.T) {
 		e := echo.New()
 		userID := uuid.NewUUID()
@@ -821,6 +867,10 @@ func TestToTaskResponseFromReadModel(t *testing.T) {
 		CreatedBy:  userID,
 		CreatedAt:  time.Now(),
 		Version:    5,
+
+		AssigneeUsername:    "alice",
+		AssigneeDisplayName: "Alice Smith",
+		CreatorUsername:     "bob",
 	}
 
 	resp := httphandler.ToTaskResponseFromReadModel(rm)
@@ -837,6 +887,10 @@ func TestToTaskResponseFromReadModel(t *testing.T) {
 	assert.Equal(t, "2026-03-15", *resp.DueDate)
 	assert.Equal(t, userID.String(), resp.ReporterID)
 	assert.Equal(t, 5, resp.Version)
+	assert.Equal(t, "alice", resp.AssigneeUsername)
+	assert.Equal(t, "Alice Smith", resp.AssigneeDisplayName)
+	assert.Equal(t, "bob", resp.ReporterUsername)
+	assert.Empty(t, resp.ReporterDisplayName)
 }
 
 func TestMockTaskService(t *testing.T) {
@@ -1194,3 +1248,256 @@ func TestTaskHandler_RemoveAttachment(t *testing.T) {
 		assert.Equal(t, stdhttp.StatusNotFound, rec.Code)
 	})
 }
+
+func TestTaskHandler_Checklist(t *testing.T) {
+	userID := uuid.NewUUID()
+	workspaceID := uuid.NewUUID()
+
+	mockService := httphandler.NewMockTaskService()
+	testTask := createTestTaskReadModel(uuid.NewUUID(), userID)
+	mockService.AddTask(testTask)
+	handler := newTaskHandlerWithAction(mockService)
+
+	call := func(
+		t *testing.T,
+		method, path, body string,
+		itemID string,
+		fn func(echo.Context) error,
+	) (*httptest.ResponseRecorder, httphandler.TaskResponse) {
+		t.Helper()
+
+		req := httptest.NewRequest(method, taskURL(workspaceID, testTask.ID)+path, strings.NewReader(body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := echo.New().NewContext(req, rec)
+		c.SetParamNames("workspace_id", "task_id", "item_id")
+		c.SetParamValues(workspaceID.String(), testTask.ID.String(), itemID)
+		setupTaskAuthContext(c, userID)
+
+		require.NoError(t, fn(c))
+
+		var resp struct {
+			Data httphandler.TaskResponse `json:"data"`
+		}
+		if rec.Code < stdhttp.StatusBadRequest {
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+		}
+		return rec, resp.Data
+	}
+
+	t.Run("add items", func(t *testing.T) {
+		rec, _ := call(t, stdhttp.MethodPost, "/checklist", `{"text": "Write spec"}`, "", handler.AddChecklistItem)
+		assert.Equal(t, stdhttp.StatusCreated, rec.Code)
+
+		rec, resp := call(t, stdhttp.MethodPost, "/checklist", `{"text": "Review"}`, "", handler.AddChecklistItem)
+		assert.Equal(t, stdhttp.StatusCreated, rec.Code)
+		require.Len(t, resp.Checklist, 2)
+		require.NotNil(t, resp.ChecklistProgress)
+		assert.Equal(t, 2, resp.ChecklistProgress.Total)
+		assert.Equal(t, 0, resp.ChecklistProgress.Done)
+	})
+
+	t.Run("empty text", func(t *testing.T) {
+		rec, _ := call(t, stdhttp.MethodPost, "/checklist", `{"text": "  "}`, "", handler.AddChecklistItem)
+		assert.Equal(t, stdhttp.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("toggle item", func(t *testing.T) {
+		itemID := testTask.Checklist[0].ID.String()
+
+		rec, resp := call(t, stdhttp.MethodPut, "/checklist/"+itemID, `{"done": true}`, itemID,
+			handler.ToggleChecklistItem)
+
+		assert.Equal(t, stdhttp.StatusOK, rec.Code)
+		assert.True(t, resp.Checklist[0].Done)
+		assert.Equal(t, 50, resp.ChecklistProgress.Percent)
+	})
+
+	t.Run("reorder item", func(t *testing.T) {
+		itemID := testTask.Checklist[1].ID.String()
+
+		rec, resp := call(t, stdhttp.MethodPut, "/checklist/"+itemID+"/position", `{"position": 0}`, itemID,
+			handler.ReorderChecklistItem)
+
+		assert.Equal(t, stdhttp.StatusOK, rec.Code)
+		assert.Equal(t, "Review", resp.Checklist[0].Text)
+		assert.Equal(t, 1, resp.Checklist[1].Position)
+	})
+
+	t.Run("unknown item", func(t *testing.T) {
+		itemID := uuid.NewUUID().String()
+
+		rec, _ := call(t, stdhttp.MethodPut, "/checklist/"+itemID, `{"done": true}`, itemID,
+			handler.ToggleChecklistItem)
+
+		assert.Equal(t, stdhttp.StatusNotFound, rec.Code)
+	})
+
+	t.Run("invalid item ID", func(t *testing.T) {
+		rec, _ := call(t, stdhttp.MethodPut, "/checklist/invalid", `{"done": true}`, "invalid",
+			handler.ToggleChecklistItem)
+
+		assert.Equal(t, stdhttp.StatusBadRequest, rec.Code)
+	})
+}
+
+func TestTaskHandler_EpicHierarchy(t *testing.T) {
+	userID := uuid.NewUUID()
+	workspaceID := uuid.NewUUID()
+
+	mockService := httphandler.NewMockTaskService()
+	epic := createTestTaskReadModel(uuid.NewUUID(), userID)
+	epic.EntityType = task.TypeEpic
+	mockService.AddTask(epic)
+	child := createTestTaskReadModel(uuid.NewUUID(), userID)
+	mockService.AddTask(child)
+	doneChild := createTestTaskReadModel(uuid.NewUUID(), userID)
+	doneChild.Status = task.StatusDone
+	mockService.AddTask(doneChild)
+	handler := newTaskHandlerWithAction(mockService)
+
+	call := func(
+		t *testing.T,
+		method string,
+		taskID uuid.UUID,
+		body string,
+		fn func(echo.Context) error,
+	) *httptest.ResponseRecorder {
+		t.Helper()
+
+		req := httptest.NewRequest(method, taskURL(workspaceID, taskID), strings.NewReader(body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := echo.New().NewContext(req, rec)
+		c.SetParamNames("workspace_id", "task_id")
+		c.SetParamValues(workspaceID.String(), taskID.String())
+		setupTaskAuthContext(c, userID)
+
+		require.NoError(t, fn(c))
+		return rec
+	}
+
+	t.Run("link children", func(t *testing.T) {
+		body := `{"epic_id": "` + epic.ID.String() + `"}`
+
+		rec := call(t, stdhttp.MethodPut, child.ID, body, handler.SetEpic)
+		require.Equal(t, stdhttp.StatusOK, rec.Code)
+
+		var resp struct {
+			Data httphandler.TaskResponse `json:"data"`
+		}
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+		require.NotNil(t, resp.Data.EpicID)
+		assert.Equal(t, epic.ID.String(), *resp.Data.EpicID)
+
+		rec = call(t, stdhttp.MethodPut, doneChild.ID, body, handler.SetEpic)
+		require.Equal(t, stdhttp.StatusOK, rec.Code)
+	})
+
+	t.Run("list children with status breakdown", func(t *testing.T) {
+		rec := call(t, stdhttp.MethodGet, epic.ID, "", handler.ListEpicChildren)
+		require.Equal(t, stdhttp.StatusOK, rec.Code)
+
+		var resp struct {
+			Data httphandler.EpicChildrenResponse `json:"data"`
+		}
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+		assert.Len(t, resp.Data.Children, 2)
+		assert.Equal(t, 2, resp.Data.Total)
+		assert.Equal(t, 1, resp.Data.StatusBreakdown[string(task.StatusToDo)])
+		assert.Equal(t, 1, resp.Data.StatusBreakdown[string(task.StatusDone)])
+		assert.Equal(t, 50, resp.Data.Progress.Percent)
+	})
+
+	t.Run("children of a non-epic", func(t *testing.T) {
+		rec := call(t, stdhttp.MethodGet, child.ID, "", handler.ListEpicChildren)
+		assert.Equal(t, stdhttp.StatusNotFound, rec.Code)
+	})
+
+	t.Run("epic cannot be a child", func(t *testing.T) {
+		other := createTestTaskReadModel(uuid.NewUUID(), userID)
+		other.EntityType = task.TypeEpic
+		mockService.AddTask(other)
+
+		rec := call(t, stdhttp.MethodPut, other.ID, `{"epic_id": "`+epic.ID.String()+`"}`, handler.SetEpic)
+		assert.Equal(t, stdhttp.StatusUnprocessableEntity, rec.Code)
+	})
+
+	t.Run("parent must be an epic", func(t *testing.T) {
+		rec := call(t, stdhttp.MethodPut, child.ID, `{"epic_id": "`+doneChild.ID.String()+`"}`, handler.SetEpic)
+		assert.Equal(t, stdhttp.StatusNotFound, rec.Code)
+	})
+
+	t.Run("invalid epic ID", func(t *testing.T) {
+		rec := call(t, stdhttp.MethodPut, child.ID, `{"epic_id": "nope"}`, handler.SetEpic)
+		assert.Equal(t, stdhttp.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("unlink", func(t *testing.T) {
+		rec := call(t, stdhttp.MethodDelete, child.ID, "", handler.UnlinkEpic)
+		require.Equal(t, stdhttp.StatusOK, rec.Code)
+		assert.Nil(t, child.EpicID)
+	})
+}
+
+// recordingTaskStreamer streams a fixed list of tasks and records the filters and cursor.
+type recordingTaskStreamer struct {
+	tasks   []*taskapp.ReadModel
+	filters taskapp.Filters
+	after   uuid.UUID
+}
+
+func (s *recordingTaskStreamer) StreamTasks(
+	_ context.Context,
+	filters taskapp.Filters,
+	after uuid.UUID,
+	fn func(*taskapp.ReadModel) error,
+) error {
+	s.filters = filters
+	s.after = after
+	for _, t := range s.tasks {
+		if err := fn(t); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func TestTaskHandler_Export(t *testing.T) {
+	userID := uuid.NewUUID()
+	workspaceID := uuid.NewUUID()
+	after := uuid.NewUUID()
+	streamer := &recordingTaskStreamer{tasks: []*taskapp.ReadModel{
+		createTestTaskReadModel(uuid.NewUUID(), userID),
+		createTestTaskReadModel(uuid.NewUUID(), userID),
+	}}
+	handler := httphandler.NewTaskHandler(httphandler.NewMockTaskService())
+	handler.SetTaskStreamer(streamer)
+
+	e := echo.New()
+	req := httptest.NewRequest(stdhttp.MethodGet,
+		"/api/v1/workspaces/"+workspaceID.String()+"/tasks/export?status=done&after="+after.String(), nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetParamNames("workspace_id")
+	c.SetParamValues(workspaceID.String())
+	setupTaskAuthContext(c, userID)
+
+	require.NoError(t, handler.Export(c))
+
+	assert.Equal(t, stdhttp.StatusOK, rec.Code)
+	assert.Equal(t, httpserver.MIMEApplicationNDJSON, rec.Header().Get(echo.HeaderContentType))
+	dec := json.NewDecoder(rec.Body)
+	for _, want := range streamer.tasks {
+		var got httphandler.TaskResponse
+		require.NoError(t, dec.Decode(&got))
+		assert.Equal(t, want.ID.String(), got.ID)
+	}
+	assert.False(t, dec.More())
+
+	assert.Equal(t, after, streamer.after)
+	require.NotNil(t, streamer.filters.WorkspaceID)
+	assert.Equal(t, workspaceID, *streamer.filters.WorkspaceID)
+	require.NotNil(t, streamer.filters.Status)
+	assert.Equal(t, task.StatusDone, *streamer.filters.Status)
+}
@@ -5,6 +5,8 @@ import (
 	"html/template"
 	"strings"
 	"time"
+
+	"github.com/lllypuk/flowra/internal/buildinfo"
 )
 
 // TemplateFuncs returns the custom template functions for HTML templates.
@@ -16,6 +18,7 @@ func TemplateFuncs() template.FuncMap {
 		"formatDateTime":  formatDateTime,
 		"formatDateInput": formatDateInput,
 		"isoDate":         isoDate,
+		"timezone":        time.UTC.String,
 		"timeAgo":         timeAgo,
 
 		// String helpers
@@ -75,11 +78,26 @@ func TemplateFuncs() template.FuncMap {
 
 		// File helpers
 		"formatFileSize": formatFileSize,
+
+		// Build metadata
+		"buildInfo": buildinfo.Get,
 	}
 }
 
 // Time formatting functions
 
+// LocationFuncs returns the date formatting funcs bound to loc, so templates
+// render times in the viewer's time zone. isoDate stays in UTC for scripts.
+func LocationFuncs(loc *time.Location) template.FuncMap {
+	return template.FuncMap{
+		"formatTime":      func(t time.Time) string { return formatTime(t.In(loc)) },
+		"formatDate":      func(t time.Time) string { return formatDate(t.In(loc)) },
+		"formatDateTime":  func(t time.Time) string { return formatDateTime(t.In(loc)) },
+		"formatDateInput": func(t time.Time) string { return formatDateInput(t.In(loc)) },
+		"timezone":        loc.String,
+	}
+}
+
 func formatTime(t time.Time) string {
 	if t.IsZero() {
 		return ""
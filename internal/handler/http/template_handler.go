@@ -13,12 +13,15 @@ import (
 	"log/slog"
 	"net/http"
 	"path/filepath"
+	"strings"
 	"sync"
 	"time"
 
 	"github.com/labstack/echo/v4"
+	calendarapp "github.com/lllypuk/flowra/internal/application/calendar"
 	"github.com/lllypuk/flowra/internal/domain/uuid"
 	"github.com/lllypuk/flowra/internal/domain/workspace"
+	"github.com/lllypuk/flowra/internal/infrastructure/i18n"
 	"github.com/lllypuk/flowra/internal/middleware"
 )
 
@@ -28,13 +31,22 @@ const (
 	maxAdminMembersForTransfer = 100
 )
 
+// localeContextKey caches the resolved UI locale on the echo context.
+const localeContextKey = "ui_locale"
+
 // TemplateRenderer implements echo.Renderer for HTML template rendering.
+// Templates are parsed once and cloned per locale and time zone on first use,
+// so that "t" translates into the request's locale and the date funcs format
+// in the viewer's time zone.
 type TemplateRenderer struct {
-	templates *template.Template
-	mu        sync.RWMutex
-	logger    *slog.Logger
-	devMode   bool
-	fs        embed.FS
+	base       *template.Template            // parsed templates; never executed so they can be cloned
+	sets       map[string]*template.Template // executable clones keyed by locale and time zone
+	mu         sync.RWMutex
+	logger     *slog.Logger
+	devMode    bool
+	fs         embed.FS
+	catalog    *i18n.Catalog
+	userLookup UserProfileLookup
 }
 
 // TemplateRendererConfig holds configuration for the template renderer.
@@ -45,6 +57,8 @@ type TemplateRendererConfig struct {
 	Logger *slog.Logger
 	// DevMode enables template reloading on each request.
 	DevMode bool
+	// Catalog provides translations for the "t" template func. Defaults to the embedded catalogs.
+	Catalog *i18n.Catalog
 }
 
 // NewTemplateRenderer creates a new template renderer.
@@ -53,11 +67,19 @@ func NewTemplateRenderer(cfg TemplateRendererConfig) (*TemplateRenderer, error)
 		logger:  cfg.Logger,
 		devMode: cfg.DevMode,
 		fs:      cfg.FS,
+		catalog: cfg.Catalog,
 	}
 
 	if r.logger == nil {
 		r.logger = slog.Default()
 	}
+	if r.catalog == nil {
+		catalog, err := i18n.Load()
+		if err != nil {
+			return nil, fmt.Errorf("failed to load message catalogs: %w", err)
+		}
+		r.catalog = catalog
+	}
 
 	if err := r.loadTemplates(); err != nil {
 		return nil, err
@@ -71,28 +93,12 @@ func (r *TemplateRenderer) loadTemplates() error {
 	r.mu.Lock()
 	defer r.mu.Unlock()
 
-	// Create a placeholder for the template set that will be populated after parsing
-	var tmplSet *template.Template
-
-	// Create template funcs including renderContent which needs access to the template set
+	// Placeholder funcs so templates parse; each set rebinds them in templateSet.
 	funcs := TemplateFuncs()
-	funcs["renderContent"] = func(templateName string, data any) (template.HTML, error) {
-		if tmplSet == nil {
-			return "", errors.New("template set not initialized")
-		}
-		if templateName == "" {
-			return "", nil
-		}
-		contentTmpl := tmplSet.Lookup(templateName)
-		if contentTmpl == nil {
-			return "", fmt.Errorf("content template %q not found", templateName)
-		}
-		var buf bytes.Buffer
-		if err := contentTmpl.Execute(&buf, data); err != nil {
-			return "", fmt.Errorf("failed to execute content template %q: %w", templateName, err)
-		}
-		return template.HTML(buf.String()), nil //nolint:gosec // Content is from trusted templates
-	}
+	funcs["renderContent"] = func(string, any) (template.HTML, error) { return "", nil }
+	funcs["t"] = r.catalog.Translator(i18n.DefaultLocale)
+	funcs["locale"] = func() string { return i18n.DefaultLocale }
+	funcs["locales"] = r.catalog.Locales
 
 	tmpl := template.New("").Funcs(funcs)
 
@@ -132,14 +138,93 @@ func (r *TemplateRenderer) loadTemplates() error {
 		return err
 	}
 
-	// Set the template set for renderContent function to use
-	tmplSet = tmpl
-	r.templates = tmpl
+	r.base = tmpl
+	r.sets = make(map[string]*template.Template)
 	return nil
 }
 
+// templateSet returns the executable templates for locale and loc, cloning
+// them from the parsed templates on first use.
+func (r *TemplateRenderer) templateSet(locale string, loc *time.Location) (*template.Template, error) {
+	key := locale + "|" + loc.String()
+
+	r.mu.RLock()
+	set, ok := r.sets[key]
+	r.mu.RUnlock()
+	if ok {
+		return set, nil
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if set, ok = r.sets[key]; ok {
+		return set, nil
+	}
+
+	set, err := r.base.Clone()
+	if err != nil {
+		return nil, fmt.Errorf("failed to clone templates for %s: %w", key, err)
+	}
+	funcs := LocationFuncs(loc)
+	funcs["renderContent"] = renderContentFunc(set)
+	funcs["t"] = r.catalog.Translator(locale)
+	funcs["locale"] = func() string { return locale }
+	set.Funcs(funcs)
+
+	r.sets[key] = set
+	return set, nil
+}
+
+// renderContentFunc returns the renderContent template func bound to set,
+// so nested content templates render with the same locale.
+func renderContentFunc(set *template.Template) func(string, any) (template.HTML, error) {
+	return func(templateName string, data any) (template.HTML, error) {
+		if templateName == "" {
+			return "", nil
+		}
+		contentTmpl := set.Lookup(templateName)
+		if contentTmpl == nil {
+			return "", fmt.Errorf("content template %q not found", templateName)
+		}
+		var buf bytes.Buffer
+		if err := contentTmpl.Execute(&buf, data); err != nil {
+			return "", fmt.Errorf("failed to execute content template %q: %w", templateName, err)
+		}
+		return template.HTML(buf.String()), nil //nolint:gosec // Content is from trusted templates
+	}
+}
+
+// SetUserLookup sets the user lookup used to read profile locale preferences.
+func (r *TemplateRenderer) SetUserLookup(lookup UserProfileLookup) {
+	r.userLookup = lookup
+}
+
+// Locale resolves the UI locale for a request: the user's profile preference,
+// then the Accept-Language header, then the default locale. The result is
+// cached on the echo context.
+func (r *TemplateRenderer) Locale(c echo.Context) string {
+	if c == nil {
+		return i18n.DefaultLocale
+	}
+	if cached, ok := c.Get(localeContextKey).(string); ok && cached != "" {
+		return cached
+	}
+
+	var profileLocale string
+	if userID := middleware.GetUserID(c); !userID.IsZero() && r.userLookup != nil {
+		if view := r.userLookup.GetUser(c.Request().Context(), userID); view != nil {
+			profileLocale = view.Locale
+		}
+	}
+
+	locale := r.catalog.Resolve(profileLocale, c.Request().Header.Get("Accept-Language"))
+	c.Set(localeContextKey, locale)
+	return locale
+}
+
 // Render implements echo.Renderer.
-func (r *TemplateRenderer) Render(w io.Writer, name string, data any, _ echo.Context) error {
+func (r *TemplateRenderer) Render(w io.Writer, name string, data any, c echo.Context) error {
 	r.logger.Debug("TemplateRenderer.Render: starting",
 		"template_name", name,
 		"data_type", fmt.Sprintf("%T", data),
@@ -154,21 +239,28 @@ func (r *TemplateRenderer) Render(w io.Writer, name string, data any, _ echo.Con
 		}
 	}
 
-	r.mu.RLock()
-	defer r.mu.RUnlock()
+	loc := time.UTC
+	if c != nil {
+		loc = middleware.GetLocation(c)
+	}
+
+	templates, err := r.templateSet(r.Locale(c), loc)
+	if err != nil {
+		return err
+	}
 
 	// Check if template exists
-	tmpl := r.templates.Lookup(name)
+	tmpl := templates.Lookup(name)
 	if tmpl == nil {
 		r.logger.Error("TemplateRenderer.Render: template not found",
 			"template_name", name,
-			"available_templates", r.listTemplateNames(),
+			"available_templates", listTemplateNames(templates),
 		)
 		return fmt.Errorf("template %q not found", name)
 	}
 
 	r.logger.Debug("TemplateRenderer.Render: executing template", "template_name", name)
-	err := r.templates.ExecuteTemplate(w, name, data)
+	err = templates.ExecuteTemplate(w, name, data)
 	if err != nil {
 		r.logger.Error("TemplateRenderer.Render: ExecuteTemplate failed",
 			"template_name", name,
@@ -181,12 +273,9 @@ func (r *TemplateRenderer) Render(w io.Writer, name string, data any, _ echo.Con
 }
 
 // listTemplateNames returns a list of all loaded template names for debugging.
-func (r *TemplateRenderer) listTemplateNames() []string {
-	if r.templates == nil {
-		return nil
-	}
+func listTemplateNames(set *template.Template) []string {
 	var names []string
-	for _, t := range r.templates.Templates() {
+	for _, t := range set.Templates() {
 		if t.Name() != "" {
 			names = append(names, t.Name())
 		}
@@ -222,6 +311,8 @@ type UserView struct {
 	Username    string
 	DisplayName string
 	AvatarURL   string
+	Locale      string
+	Timezone    string
 	IsAdmin     bool
 	CreatedAt   time.Time
 	UpdatedAt   time.Time
@@ -247,6 +338,10 @@ type OAuthTokenResponse struct {
 type UserProfileLookup interface {
 	// GetUser returns user profile data by user ID. Returns nil if not found.
 	GetUser(ctx context.Context, userID uuid.UUID) *UserView
+
+	// GetUsers returns profiles for many user IDs with a single lookup, keyed by user ID.
+	// Unknown IDs are absent from the map.
+	GetUsers(ctx context.Context, userIDs []uuid.UUID) map[uuid.UUID]*UserView
 }
 
 // UserSearcher searches users by query string for invite functionality.
@@ -265,6 +360,8 @@ type TemplateHandler struct {
 	oauthClient      OAuthClient
 	userLookup       UserProfileLookup
 	userSearcher     UserSearcher
+	calendarTokens   CalendarTokenReader
+	fragments        *FragmentCache
 }
 
 // NewTemplateHandler creates a new template handler.
@@ -307,6 +404,16 @@ func (h *TemplateHandler) SetUserSearcher(searcher UserSearcher) {
 	h.userSearcher = searcher
 }
 
+// SetCalendarTokenReader sets the reader used to show the calendar feed state in settings.
+func (h *TemplateHandler) SetCalendarTokenReader(reader CalendarTokenReader) {
+	h.calendarTokens = reader
+}
+
+// SetFragmentCache enables caching of expensive partials. Nil disables caching.
+func (h *TemplateHandler) SetFragmentCache(cache *FragmentCache) {
+	h.fragments = cache
+}
+
 // render is a helper to render a template with common page data.
 func (h *TemplateHandler) render(c echo.Context, templateName string, title string, data any) error {
 	pageData := PageData{
@@ -323,6 +430,18 @@ func (h *TemplateHandler) render(c echo.Context, templateName string, title stri
 // RenderPartial renders a template without the base layout.
 // This is used for HTMX partial updates.
 func (h *TemplateHandler) RenderPartial(c echo.Context, templateName string, data any) error {
+	return h.renderCachedPartial(c, "", nil, templateName, data)
+}
+
+// renderCachedPartial renders a partial and, when key is set, stores the output
+// in the fragment cache under key with the given invalidation tags.
+func (h *TemplateHandler) renderCachedPartial(
+	c echo.Context,
+	key string,
+	tags []string,
+	templateName string,
+	data any,
+) error {
 	// Buffer the template output to prevent partial writes on error
 	var buf bytes.Buffer
 	if err := h.renderer.Render(&buf, templateName, data, c); err != nil {
@@ -332,8 +451,27 @@ func (h *TemplateHandler) RenderPartial(c echo.Context, templateName string, dat
 		return c.String(http.StatusInternalServerError, "Failed to render template")
 	}
 
+	if key != "" {
+		h.fragments.Set(key, buf.Bytes(), tags...)
+	}
+
+	return writeFragment(c, buf.Bytes())
+}
+
+// fragmentKey builds a fragment cache key scoped to the viewer's locale and time zone,
+// since both change the rendered output. It returns "" when caching is disabled.
+func (h *TemplateHandler) fragmentKey(c echo.Context, parts ...string) string {
+	if h.fragments == nil {
+		return ""
+	}
+	parts = append(parts, h.renderer.Locale(c), middleware.GetLocation(c).String())
+	return strings.Join(parts, "|")
+}
+
+// writeFragment writes a rendered HTML fragment.
+func writeFragment(c echo.Context, body []byte) error {
 	c.Response().Header().Set("Content-Type", "text/html; charset=utf-8")
-	return c.HTMLBlob(http.StatusOK, buf.Bytes())
+	return c.HTMLBlob(http.StatusOK, body)
 }
 
 // getUserView extracts user information from the context for templates.
@@ -345,7 +483,8 @@ func getUserView(c echo.Context) *UserView {
 
 	// Build user view from context, with fallbacks to ensure username is always populated
 	view := &UserView{
-		ID: userID.String(),
+		ID:      userID.String(),
+		IsAdmin: middleware.IsSystemAdmin(c),
 	}
 
 	// Try to get user details from the "user" context map
@@ -564,6 +703,14 @@ func (h *TemplateHandler) UserSettings(c echo.Context) error {
 		return c.Redirect(http.StatusFound, "/login")
 	}
 
+	var locale, timezone string
+	if h.userLookup != nil {
+		if profile := h.userLookup.GetUser(c.Request().Context(), middleware.GetUserID(c)); profile != nil {
+			locale = profile.Locale
+			timezone = profile.Timezone
+		}
+	}
+
 	data := map[string]any{
 		"User": map[string]any{
 			"ID":          user.ID,
@@ -571,14 +718,37 @@ func (h *TemplateHandler) UserSettings(c echo.Context) error {
 			"DisplayName": user.DisplayName,
 			"Email":       user.Email,
 			"AvatarURL":   user.AvatarURL,
+			"Locale":      locale,
+			"Timezone":    timezone,
 			"IsAdmin":     false,
 			"CreatedAt":   time.Now(),
 			"UpdatedAt":   time.Now(),
 		},
+		"CalendarFeed": h.calendarFeedState(c),
 	}
 	return h.render(c, "user/settings.html", "Settings", data)
 }
 
+// calendarFeedState describes the calendar feed of the current user for the settings page.
+func (h *TemplateHandler) calendarFeedState(c echo.Context) map[string]any {
+	state := map[string]any{"Active": false}
+	if h.calendarTokens == nil {
+		return state
+	}
+	token, err := h.calendarTokens.Execute(
+		c.Request().Context(), calendarapp.GetTokenQuery{UserID: middleware.GetUserID(c)})
+	if err != nil {
+		if !errors.Is(err, calendarapp.ErrFeedNotFound) {
+			h.logger.WarnContext(c.Request().Context(), "failed to load calendar feed state",
+				slog.String("error", err.Error()))
+		}
+		return state
+	}
+	state["Active"] = true
+	state["CreatedAt"] = token.CreatedAt
+	return state
+}
+
 // UserProfile renders the user profile page for viewing another user.
 func (h *TemplateHandler) UserProfile(c echo.Context) error {
 	currentUser := getUserView(c)
@@ -687,15 +857,22 @@ func (h *TemplateHandler) WorkspaceListPartial(c echo.Context) error {
 		return h.RenderPartial(c, "empty-workspaces", nil)
 	}
 
+	key := h.fragmentKey(c, "workspace-list", user.ID)
+	if body, ok := h.fragments.Get(key); ok {
+		return writeFragment(c, body)
+	}
+
 	workspaces, _, err := h.workspaceService.ListUserWorkspaces(c.Request().Context(), userID, 0, defaultPageLimit)
 	if err != nil {
 		h.logger.Error("failed to list workspaces", slog.String("error", err.Error()))
 		return h.RenderPartial(c, "empty-workspaces", nil)
 	}
 
-	// Convert to view models
+	// Convert to view models; the fragment depends on the user and every listed workspace
+	tags := []string{UserFragmentTag(userID)}
 	workspaceViews := make([]WorkspaceViewData, 0, len(workspaces))
 	for _, ws := range workspaces {
+		tags = append(tags, WorkspaceFragmentTag(ws.ID()))
 		memberCount, _ := h.workspaceService.GetMemberCount(c.Request().Context(), ws.ID())
 		workspaceViews = append(workspaceViews, WorkspaceViewData{
 			ID:          ws.ID().String(),
@@ -704,13 +881,15 @@ func (h *TemplateHandler) WorkspaceListPartial(c echo.Context) error {
 			MemberCount: memberCount,
 			CreatedAt:   ws.CreatedAt(),
 			UnreadCount: 0, // TODO: implement unread count
+			AvatarURL:   WorkspaceAvatarURL(ws),
+			AccentColor: ws.Branding().AccentColor(),
 		})
 	}
 
 	data := map[string]any{
 		"Workspaces": workspaceViews,
 	}
-	return h.RenderPartial(c, "workspace/list-partial", data)
+	return h.renderCachedPartial(c, key, tags, "workspace/list-partial", data)
 }
 
 // WorkspaceView renders a single workspace page.
@@ -754,6 +933,8 @@ func (h *TemplateHandler) WorkspaceView(c echo.Context) error {
 			Description: ws.Description(),
 			MemberCount: memberCount,
 			CreatedAt:   ws.CreatedAt(),
+			AvatarURL:   WorkspaceAvatarURL(ws),
+			AccentColor: ws.Branding().AccentColor(),
 		},
 		"UserRole":    member.Role().String(),
 		"ActiveTab":   c.QueryParam("tab"),
@@ -910,10 +1091,7 @@ func (h *TemplateHandler) WorkspaceMembersPartial(c echo.Context) error {
 	memberCount, _ := h.workspaceService.GetMemberCount(c.Request().Context(), workspaceID)
 
 	// Convert to view models
-	memberViews := make([]MemberViewData, 0, len(members))
-	for _, m := range members {
-		memberViews = append(memberViews, h.resolveMemberView(c.Request().Context(), m))
-	}
+	memberViews := h.resolveMemberViews(c.Request().Context(), members)
 
 	data := map[string]any{
 		"Members": memberViews,
@@ -958,6 +1136,11 @@ func (h *TemplateHandler) WorkspaceMembersOptionsPartial(c echo.Context) error {
 		return c.String(http.StatusForbidden, "Not a member of this workspace")
 	}
 
+	key := h.fragmentKey(c, "member-options", workspaceID.String(), user.ID)
+	if body, ok := h.fragments.Get(key); ok {
+		return writeFragment(c, body)
+	}
+
 	members, _, err := h.memberService.ListMembers(c.Request().Context(), workspaceID, 0, defaultPageLimit)
 	if err != nil {
 		h.logger.Error("failed to list members", slog.String("error", err.Error()))
@@ -966,13 +1149,12 @@ func (h *TemplateHandler) WorkspaceMembersOptionsPartial(c echo.Context) error {
 
 	// Build <option> elements HTML, excluding the current user
 	var options bytes.Buffer
-	for _, m := range members {
-		memberUserID := m.UserID().String()
+	for _, mv := range h.resolveMemberViews(c.Request().Context(), members) {
+		memberUserID := mv.UserID
 		// Skip current user (they shouldn't add themselves as participant)
 		if memberUserID == user.ID {
 			continue
 		}
-		mv := h.resolveMemberView(c.Request().Context(), m)
 		displayName := mv.DisplayName
 		if displayName == "" {
 			displayName = mv.Username
@@ -985,8 +1167,11 @@ func (h *TemplateHandler) WorkspaceMembersOptionsPartial(c echo.Context) error {
 		)
 	}
 
-	c.Response().Header().Set("Content-Type", "text/html; charset=utf-8")
-	return c.HTMLBlob(http.StatusOK, options.Bytes())
+	if key != "" {
+		h.fragments.Set(key, options.Bytes(), WorkspaceFragmentTag(workspaceID))
+	}
+
+	return writeFragment(c, options.Bytes())
 }
 
 // UpdateMemberRolePartial handles role update for HTMX and returns the updated member row.
@@ -1112,6 +1297,8 @@ func (h *TemplateHandler) WorkspaceSettings(c echo.Context) error {
 			Description: ws.Description(),
 			MemberCount: memberCount,
 			CreatedAt:   ws.CreatedAt(),
+			AvatarURL:   WorkspaceAvatarURL(ws),
+			AccentColor: ws.Branding().AccentColor(),
 		},
 		"UserRole":      memberRole,
 		"CurrentUserID": user.ID,
@@ -1246,12 +1433,13 @@ func (h *TemplateHandler) WorkspaceTransferForm(c echo.Context) error {
 		return c.String(http.StatusInternalServerError, "Failed to load members")
 	}
 
-	adminMembers := []MemberViewData{}
+	admins := make([]*workspace.Member, 0, len(members))
 	for _, m := range members {
 		if m.Role().String() == "admin" && m.UserID().String() != user.ID {
-			adminMembers = append(adminMembers, h.resolveMemberView(c.Request().Context(), m))
+			admins = append(admins, m)
 		}
 	}
+	adminMembers := h.resolveMemberViews(c.Request().Context(), admins)
 
 	data := map[string]any{
 		"WorkspaceID":  c.Param("id"),
@@ -1344,6 +1532,8 @@ type WorkspaceViewData struct {
 	MemberCount int
 	CreatedAt   time.Time
 	UnreadCount int
+	AvatarURL   string
+	AccentColor string
 }
 
 // MemberViewData represents member data for templates.
@@ -1356,20 +1546,37 @@ type MemberViewData struct {
 	JoinedAt    time.Time
 }
 
-// resolveMemberView converts a workspace Member to MemberViewData, resolving user details via userLookup.
-func (h *TemplateHandler) resolveMemberView(ctx context.Context, m *workspace.Member) MemberViewData {
+// resolveMemberViews converts workspace Members to MemberViewData,
+// resolving user details via one batch userLookup call.
+func (h *TemplateHandler) resolveMemberViews(ctx context.Context, members []*workspace.Member) []MemberViewData {
+	var users map[uuid.UUID]*UserView
+	if h.userLookup != nil && len(members) > 0 {
+		ids := make([]uuid.UUID, 0, len(members))
+		for _, m := range members {
+			ids = append(ids, m.UserID())
+		}
+		users = h.userLookup.GetUsers(ctx, ids)
+	}
+
+	views := make([]MemberViewData, 0, len(members))
+	for _, m := range members {
+		views = append(views, memberView(m, users[m.UserID()]))
+	}
+	return views
+}
+
+// memberView converts a workspace Member to MemberViewData using the resolved user, if any.
+func memberView(m *workspace.Member, u *UserView) MemberViewData {
 	mv := MemberViewData{
 		UserID:   m.UserID().String(),
 		Role:     m.Role().String(),
 		JoinedAt: m.JoinedAt(),
 	}
-	if h.userLookup != nil {
-		if u := h.userLookup.GetUser(ctx, m.UserID()); u != nil {
-			mv.Username = u.Username
-			mv.DisplayName = u.DisplayName
-			mv.AvatarURL = u.AvatarURL
-			return mv
-		}
+	if u != nil {
+		mv.Username = u.Username
+		mv.DisplayName = u.DisplayName
+		mv.AvatarURL = u.AvatarURL
+		return mv
 	}
 	mv.Username = "user" + m.UserID().String()[:8]
 	mv.DisplayName = "User " + m.UserID().String()[:8]
@@ -0,0 +1,173 @@
+package httphandler_test
+
+import (
+	"bytes"
+	"context"
+	stdhttp "net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/lllypuk/flowra/internal/domain/uuid"
+	httphandler "github.com/lllypuk/flowra/internal/handler/http"
+	"github.com/lllypuk/flowra/web"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// stubProfileLookup implements httphandler.UserProfileLookup for renderer tests.
+type stubProfileLookup struct {
+	locale string
+}
+
+func (s *stubProfileLookup) GetUser(_ context.Context, userID uuid.UUID) *httphandler.UserView {
+	return &httphandler.UserView{ID: userID.String(), Locale: s.locale}
+}
+
+func (s *stubProfileLookup) GetUsers(ctx context.Context, userIDs []uuid.UUID) map[uuid.UUID]*httphandler.UserView {
+	users := make(map[uuid.UUID]*httphandler.UserView, len(userIDs))
+	for _, id := range userIDs {
+		users[id] = s.GetUser(ctx, id)
+	}
+	return users
+}
+
+func newTestRenderer(t *testing.T) *httphandler.TemplateRenderer {
+	t.Helper()
+	renderer, err := httphandler.NewTemplateRenderer(httphandler.TemplateRendererConfig{FS: web.TemplatesFS})
+	require.NoError(t, err)
+	return renderer
+}
+
+func renderNavbar(t *testing.T, renderer *httphandler.TemplateRenderer, c echo.Context) string {
+	t.Helper()
+	var buf bytes.Buffer
+	require.NoError(t, renderer.Render(&buf, "navbar", httphandler.PageData{}, c))
+	return buf.String()
+}
+
+func TestTemplateRenderer_Locale(t *testing.T) {
+	e := echo.New()
+
+	t.Run("defaults to english", func(t *testing.T) {
+		renderer := newTestRenderer(t)
+		c := e.NewContext(httptest.NewRequest(stdhttp.MethodGet, "/", nil), httptest.NewRecorder())
+
+		assert.Equal(t, "en", renderer.Locale(c))
+		assert.Contains(t, renderNavbar(t, renderer, c), ">Login</a>")
+	})
+
+	t.Run("uses Accept-Language", func(t *testing.T) {
+		renderer := newTestRenderer(t)
+		req := httptest.NewRequest(stdhttp.MethodGet, "/", nil)
+		req.Header.Set("Accept-Language", "ru-RU,ru;q=0.9,en;q=0.8")
+		c := e.NewContext(req, httptest.NewRecorder())
+
+		assert.Equal(t, "ru", renderer.Locale(c))
+		assert.Contains(t, renderNavbar(t, renderer, c), ">Войти</a>")
+	})
+
+	t.Run("profile locale wins over Accept-Language", func(t *testing.T) {
+		renderer := newTestRenderer(t)
+		renderer.SetUserLookup(&stubProfileLookup{locale: "ru"})
+		req := httptest.NewRequest(stdhttp.MethodGet, "/", nil)
+		req.Header.Set("Accept-Language", "en-US")
+		c := e.NewContext(req, httptest.NewRecorder())
+		setupUserAuthContext(c, uuid.NewUUID())
+
+		assert.Equal(t, "ru", renderer.Locale(c))
+	})
+}
+
+func TestLocationFuncs(t *testing.T) {
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	require.NoError(t, err)
+
+	ts := time.Date(2025, 10, 19, 20, 30, 0, 0, time.UTC)
+	funcs := httphandler.LocationFuncs(tokyo)
+
+	formatDate, ok := funcs["formatDate"].(func(time.Time) string)
+	require.True(t, ok)
+	formatTime, ok := funcs["formatTime"].(func(time.Time) string)
+	require.True(t, ok)
+
+	assert.Equal(t, "Oct 20, 2025", formatDate(ts))
+	assert.Equal(t, "05:30", formatTime(ts))
+	assert.Empty(t, formatDate(time.Time{}))
+}
+
+func TestTemplateRenderer_PollMessage(t *testing.T) {
+	renderer := newTestRenderer(t)
+	c := echo.New().NewContext(httptest.NewRequest(stdhttp.MethodGet, "/", nil), httptest.NewRecorder())
+	closesAt := time.Now().Add(time.Hour)
+	msg := httphandler.MessageViewData{
+		ID:      uuid.NewUUID().String(),
+		Content: "Release on Friday?",
+		Poll: &httphandler.MessagePollData{
+			Options: []httphandler.MessagePollOptionData{
+				{ID: "opt-yes", Text: "Yes", Votes: 1, Percent: 100, IsMine: true},
+				{ID: "opt-no", Text: "No"},
+			},
+			ClosesAt:   &closesAt,
+			TotalVotes: 1,
+			HasVoted:   true,
+			CanClose:   true,
+		},
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, renderer.Render(&buf, "message", msg, c))
+	html := buf.String()
+
+	assert.Contains(t, html, `hx-delete="/api/v1/messages/`+msg.ID+`/poll/vote"`)
+	assert.Contains(t, html, `opt-no`)
+	assert.Contains(t, html, "/poll/close")
+	assert.Contains(t, html, "1 vote")
+}
+
+func TestTemplateRenderer_TaskChecklist(t *testing.T) {
+	renderer := newTestRenderer(t)
+	c := echo.New().NewContext(httptest.NewRequest(stdhttp.MethodGet, "/", nil), httptest.NewRecorder())
+
+	t.Run("board card badge", func(t *testing.T) {
+		card := httphandler.TaskCardViewData{
+			ID:               uuid.NewUUID().String(),
+			Title:            "Release",
+			Type:             "task",
+			Priority:         "medium",
+			ChecklistTotal:   5,
+			ChecklistDone:    2,
+			ChecklistPercent: 40,
+		}
+
+		var buf bytes.Buffer
+		require.NoError(t, renderer.Render(&buf, "components/task_card", card, c))
+
+		assert.Contains(t, buf.String(), "2/5")
+		assert.Contains(t, buf.String(), "40% done")
+	})
+
+	t.Run("detail items", func(t *testing.T) {
+		data := httphandler.TaskDetailViewData{
+			ID:    uuid.NewUUID().String(),
+			Title: "Release",
+			Checklist: []httphandler.TaskChecklistItemViewData{
+				{ID: "item-a", Text: "Write notes", Done: true, Position: 0, IsFirst: true},
+				{ID: "item-b", Text: "Tag build", Position: 1, IsLast: true},
+			},
+			ChecklistTotal:   2,
+			ChecklistDone:    1,
+			ChecklistPercent: 50,
+		}
+
+		var buf bytes.Buffer
+		require.NoError(t, renderer.Render(&buf, "task/checklist", data, c))
+		html := buf.String()
+
+		assert.Contains(t, html, `id="task-checklist-item-item-a"`)
+		assert.Contains(t, html, "Tag build")
+		assert.Contains(t, html, `value="50"`)
+		assert.Contains(t, html, "moveTaskChecklistItem('item-b',")
+	})
+}
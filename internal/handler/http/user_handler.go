@@ -3,6 +3,7 @@ package httphandler
 import (
 	"context"
 	"errors"
+	"fmt"
 	"net/http"
 	"time"
 
@@ -35,6 +36,8 @@ type UpdateProfileRequest struct {
 	DisplayName *string `json:"display_name"`
 	Email       *string `json:"email"`
 	AvatarURL   *string `json:"avatar_url"`
+	Locale      *string `json:"locale"`
+	Timezone    *string `json:"timezone"`
 }
 
 // UserResponse represents a user in API responses.
@@ -44,11 +47,31 @@ type UserResponse struct {
 	Email       string `json:"email"`
 	DisplayName string `json:"display_name"`
 	AvatarURL   string `json:"avatar_url,omitempty"`
+	Locale      string `json:"locale,omitempty"`
+	Timezone    string `json:"timezone,omitempty"`
 	IsAdmin     bool   `json:"is_admin"`
 	CreatedAt   string `json:"created_at"`
 	UpdatedAt   string `json:"updated_at"`
 }
 
+// LookupUsersRequest represents a batch user lookup.
+type LookupUsersRequest struct {
+	UserIDs []string `json:"user_ids"`
+}
+
+// UserSummaryResponse is the public part of a user profile returned by batch lookups.
+type UserSummaryResponse struct {
+	ID          string `json:"id"`
+	Username    string `json:"username"`
+	DisplayName string `json:"display_name"`
+	AvatarURL   string `json:"avatar_url,omitempty"`
+}
+
+// LookupUsersResponse lists the users found by a batch lookup; unknown IDs are omitted.
+type LookupUsersResponse struct {
+	Users []UserSummaryResponse `json:"users"`
+}
+
 // UserService defines the interface for user operations.
 // Declared on the consumer side per project guidelines.
 type UserService interface {
@@ -60,6 +83,9 @@ type UserService interface {
 
 	// UpdateProfile updates a user's profile.
 	UpdateProfile(ctx context.Context, cmd userapp.UpdateProfileCommand) (userapp.Result, error)
+
+	// LookupUsers gets many users by ID at once.
+	LookupUsers(ctx context.Context, query userapp.LookupUsersQuery) (userapp.UsersResult, error)
 }
 
 // UserHandler handles user-related HTTP requests.
@@ -81,6 +107,7 @@ func (h *UserHandler) RegisterRoutes(r *httpserver.Router) {
 	r.Auth().PUT("/users/me", h.UpdateMe)
 
 	// Get other users (authenticated)
+	r.Auth().POST("/users/lookup", h.Lookup)
 	r.Auth().GET("/users/:id", h.Get)
 }
 
@@ -129,6 +156,8 @@ func (h *UserHandler) UpdateMe(c echo.Context) error {
 		UserID:      userID,
 		DisplayName: req.DisplayName,
 		Email:       req.Email,
+		Locale:      req.Locale,
+		Timezone:    req.Timezone,
 	}
 
 	result, err := h.userService.UpdateProfile(c.Request().Context(), cmd)
@@ -168,11 +197,56 @@ func (h *UserHandler) Get(c echo.Context) error {
 	return httpserver.RespondOK(c, resp)
 }
 
+// Lookup handles POST /api/v1/users/lookup.
+// Resolves up to userapp.MaxLookupUsers user IDs with one query; unknown IDs are omitted.
+func (h *UserHandler) Lookup(c echo.Context) error {
+	if middleware.GetUserID(c).IsZero() {
+		return httpserver.RespondErrorWithCode(c, http.StatusUnauthorized, "UNAUTHORIZED", "authentication required")
+	}
+
+	var req LookupUsersRequest
+	if bindErr := c.Bind(&req); bindErr != nil {
+		return httpserver.RespondErrorWithCode(
+			c, http.StatusBadRequest, "INVALID_REQUEST", "invalid request body")
+	}
+
+	if len(req.UserIDs) == 0 || len(req.UserIDs) > userapp.MaxLookupUsers {
+		return httpserver.RespondErrorWithCode(c, http.StatusBadRequest, "VALIDATION_ERROR",
+			fmt.Sprintf("user_ids must contain between 1 and %d IDs", userapp.MaxLookupUsers))
+	}
+
+	userIDs := make([]uuid.UUID, 0, len(req.UserIDs))
+	for _, raw := range req.UserIDs {
+		id, parseErr := uuid.ParseUUID(raw)
+		if parseErr != nil {
+			return httpserver.RespondErrorWithCode(
+				c, http.StatusBadRequest, "INVALID_USER_ID", "invalid user ID format")
+		}
+		userIDs = append(userIDs, id)
+	}
+
+	result, err := h.userService.LookupUsers(c.Request().Context(), userapp.LookupUsersQuery{UserIDs: userIDs})
+	if err != nil {
+		return handleUserError(c, err)
+	}
+
+	resp := LookupUsersResponse{Users: make([]UserSummaryResponse, 0, len(result.Users))}
+	for _, u := range result.Users {
+		resp.Users = append(resp.Users, UserSummaryResponse{
+			ID:          u.ID().String(),
+			Username:    u.Username(),
+			DisplayName: u.DisplayName(),
+		})
+	}
+	return httpserver.RespondOK(c, resp)
+}
+
 // Helper functions
 
 func validateUpdateProfileRequest(req *UpdateProfileRequest) error {
 	// At least one field must be provided
-	if req.DisplayName == nil && req.Email == nil && req.AvatarURL == nil {
+	if req.DisplayName == nil && req.Email == nil && req.AvatarURL == nil && req.Locale == nil &&
+		req.Timezone == nil {
 		return errors.New("at least one field must be provided")
 	}
 
@@ -220,6 +294,8 @@ func ToUserResponse(u *user.User) UserResponse {
 		Username:    u.Username(),
 		Email:       u.Email(),
 		DisplayName: u.DisplayName(),
+		Locale:      u.Locale(),
+		Timezone:    u.Timezone(),
 		IsAdmin:     u.IsSystemAdmin(),
 		CreatedAt:   u.CreatedAt().Format(time.RFC3339),
 		UpdatedAt:   u.UpdatedAt().Format(time.RFC3339),
@@ -298,8 +374,20 @@ func (m *MockUserService) UpdateProfile(
 	}
 
 	// Update profile
-	if err := u.UpdateProfile(cmd.DisplayName, cmd.Email); err != nil {
-		return userapp.Result{}, err
+	if cmd.DisplayName != nil || cmd.Email != nil {
+		if err := u.UpdateProfile(cmd.DisplayName, cmd.Email); err != nil {
+			return userapp.Result{}, err
+		}
+	}
+	if cmd.Locale != nil {
+		if err := u.SetLocale(*cmd.Locale); err != nil {
+			return userapp.Result{}, err
+		}
+	}
+	if cmd.Timezone != nil {
+		if err := u.SetTimezone(*cmd.Timezone); err != nil {
+			return userapp.Result{}, err
+		}
 	}
 
 	// Update email index if changed
@@ -312,3 +400,17 @@ func (m *MockUserService) UpdateProfile(
 		Result: appcore.Result[*user.User]{Value: u},
 	}, nil
 }
+
+// LookupUsers gets many users from the mock service, skipping unknown IDs.
+func (m *MockUserService) LookupUsers(
+	_ context.Context,
+	query userapp.LookupUsersQuery,
+) (userapp.UsersResult, error) {
+	users := make([]*user.User, 0, len(query.UserIDs))
+	for _, id := range query.UserIDs {
+		if u, ok := m.users[id]; ok {
+			users = append(users, u)
+		}
+	}
+	return userapp.UsersResult{Users: users}, nil
+}
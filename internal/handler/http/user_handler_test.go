@@ -140,6 +140,27 @@ func TestUserHandler_UpdateMe(t *testing.T) {
 		assert.Equal(t, stdhttp.StatusOK, rec.Code)
 	})
 
+	t.Run("successful update timezone", func(t *testing.T) {
+		e := echo.New()
+
+		testUser := createTestUserForUserHandler(t)
+		mockService := NewMockUserServiceWithUser(testUser)
+		handler := httphandler.NewUserHandler(mockService)
+
+		reqBody := `{"timezone": "Europe/Berlin"}`
+		req := httptest.NewRequest(stdhttp.MethodPut, "/api/v1/users/me", strings.NewReader(reqBody))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+
+		setupUserAuthContext(c, testUser.ID())
+
+		err := handler.UpdateMe(c)
+		require.NoError(t, err)
+		assert.Equal(t, stdhttp.StatusOK, rec.Code)
+		assert.Contains(t, rec.Body.String(), `"timezone":"Europe/Berlin"`)
+	})
+
 	t.Run("missing auth", func(t *testing.T) {
 		e := echo.New()
 
@@ -305,6 +326,92 @@ func TestUserHandler_Get(t *testing.T) {
 	})
 }
 
+func TestUserHandler_Lookup(t *testing.T) {
+	newLookupContext := func(e *echo.Echo, body string) (echo.Context, *httptest.ResponseRecorder) {
+		req := httptest.NewRequest(stdhttp.MethodPost, "/api/v1/users/lookup", strings.NewReader(body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		return e.NewContext(req, rec), rec
+	}
+
+	t.Run("returns known users and skips unknown IDs", func(t *testing.T) {
+		e := echo.New()
+
+		testUser := createTestUserForUserHandler(t)
+		handler := httphandler.NewUserHandler(NewMockUserServiceWithUser(testUser))
+
+		body := `{"user_ids": ["` + testUser.ID().String() + `", "` + uuid.NewUUID().String() + `"]}`
+		c, rec := newLookupContext(e, body)
+		setupUserAuthContext(c, testUser.ID())
+
+		err := handler.Lookup(c)
+		require.NoError(t, err)
+		assert.Equal(t, stdhttp.StatusOK, rec.Code)
+
+		var resp struct {
+			Success bool                            `json:"success"`
+			Data    httphandler.LookupUsersResponse `json:"data"`
+		}
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+		require.Len(t, resp.Data.Users, 1)
+		assert.Equal(t, testUser.ID().String(), resp.Data.Users[0].ID)
+		assert.Equal(t, "testuser", resp.Data.Users[0].Username)
+		assert.Equal(t, "Test User", resp.Data.Users[0].DisplayName)
+	})
+
+	t.Run("missing auth", func(t *testing.T) {
+		e := echo.New()
+		handler := httphandler.NewUserHandler(httphandler.NewMockUserService())
+
+		c, rec := newLookupContext(e, `{"user_ids": ["`+uuid.NewUUID().String()+`"]}`)
+
+		err := handler.Lookup(c)
+		require.NoError(t, err)
+		assert.Equal(t, stdhttp.StatusUnauthorized, rec.Code)
+	})
+
+	t.Run("empty list", func(t *testing.T) {
+		e := echo.New()
+		handler := httphandler.NewUserHandler(httphandler.NewMockUserService())
+
+		c, rec := newLookupContext(e, `{"user_ids": []}`)
+		setupUserAuthContext(c, uuid.NewUUID())
+
+		err := handler.Lookup(c)
+		require.NoError(t, err)
+		assert.Equal(t, stdhttp.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("too many IDs", func(t *testing.T) {
+		e := echo.New()
+		handler := httphandler.NewUserHandler(httphandler.NewMockUserService())
+
+		ids := make([]string, userapp.MaxLookupUsers+1)
+		for i := range ids {
+			ids[i] = `"` + uuid.NewUUID().String() + `"`
+		}
+		c, rec := newLookupContext(e, `{"user_ids": [`+strings.Join(ids, ",")+`]}`)
+		setupUserAuthContext(c, uuid.NewUUID())
+
+		err := handler.Lookup(c)
+		require.NoError(t, err)
+		assert.Equal(t, stdhttp.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("invalid ID", func(t *testing.T) {
+		e := echo.New()
+		handler := httphandler.NewUserHandler(httphandler.NewMockUserService())
+
+		c, rec := newLookupContext(e, `{"user_ids": ["not-a-uuid"]}`)
+		setupUserAuthContext(c, uuid.NewUUID())
+
+		err := handler.Lookup(c)
+		require.NoError(t, err)
+		assert.Equal(t, stdhttp.StatusBadRequest, rec.Code)
+		assert.Contains(t, rec.Body.String(), "INVALID_USER_ID")
+	})
+}
+
 func TestNewUserHandler(t *testing.T) {
 	mockService := httphandler.NewMockUserService()
 	handler := httphandler.NewUserHandler(mockService)
@@ -318,6 +425,8 @@ func TestToUserResponse(t *testing.T) {
 		"testuser",
 		"test@example.com",
 		"Test User Display",
+		"",   // locale
+		"",   // timezone
 		true, // isSystemAdmin
 		true, // isActive
 		time.Now().Add(-24*time.Hour),
@@ -460,11 +569,32 @@ func (m *MockUserServiceWithUser) UpdateProfile(
 	}
 
 	// Update profile
-	if err := u.UpdateProfile(cmd.DisplayName, cmd.Email); err != nil {
-		return userapp.Result{}, err
+	if cmd.DisplayName != nil || cmd.Email != nil {
+		if err := u.UpdateProfile(cmd.DisplayName, cmd.Email); err != nil {
+			return userapp.Result{}, err
+		}
+	}
+	if cmd.Timezone != nil {
+		if err := u.SetTimezone(*cmd.Timezone); err != nil {
+			return userapp.Result{}, err
+		}
 	}
 
 	return userapp.Result{
 		Result: appcore.Result[*user.User]{Value: u},
 	}, nil
 }
+
+// LookupUsers gets many users from the mock service, skipping unknown IDs.
+func (m *MockUserServiceWithUser) LookupUsers(
+	_ context.Context,
+	query userapp.LookupUsersQuery,
+) (userapp.UsersResult, error) {
+	users := make([]*user.User, 0, len(query.UserIDs))
+	for _, id := range query.UserIDs {
+		if u, ok := m.users[id]; ok {
+			users = append(users, u)
+		}
+	}
+	return userapp.UsersResult{Users: users}, nil
+}
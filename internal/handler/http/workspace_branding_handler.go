@@ -0,0 +1,298 @@
+package httphandler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"path/filepath"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+	"github.com/lllypuk/flowra/internal/domain/errs"
+	"github.com/lllypuk/flowra/internal/domain/uuid"
+	"github.com/lllypuk/flowra/internal/domain/workspace"
+	"github.com/lllypuk/flowra/internal/infrastructure/filestorage"
+	"github.com/lllypuk/flowra/internal/infrastructure/httpserver"
+	"github.com/lllypuk/flowra/internal/infrastructure/resilience"
+	"github.com/lllypuk/flowra/internal/middleware"
+)
+
+// defaultMaxAvatarSize bounds workspace avatar uploads.
+const defaultMaxAvatarSize = 2 << 20 // 2 MB
+
+// UpdateAccentColorRequest represents the request to change the workspace accent color.
+type UpdateAccentColorRequest struct {
+	AccentColor string `json:"accent_color" form:"accent_color"`
+}
+
+// WorkspaceBrandingService defines the workspace operations used by the branding handler.
+// Declared on the consumer side per project guidelines.
+type WorkspaceBrandingService interface {
+	// GetWorkspace gets a workspace by ID.
+	GetWorkspace(ctx context.Context, id uuid.UUID) (*workspace.Workspace, error)
+
+	// UpdateAccentColor sets the accent color; an empty color resets it.
+	UpdateAccentColor(ctx context.Context, id, updatedBy uuid.UUID, color string) (*workspace.Workspace, error)
+
+	// UpdateAvatar points the avatar at a blob store file; a zero fileID removes the avatar.
+	UpdateAvatar(
+		ctx context.Context,
+		id, updatedBy uuid.UUID,
+		fileID uuid.UUID,
+		fileName string,
+	) (*workspace.Workspace, error)
+
+	// GetMemberCount returns the number of members in a workspace.
+	GetMemberCount(ctx context.Context, workspaceID uuid.UUID) (int, error)
+}
+
+// WorkspaceBrandingHandler handles the workspace avatar and accent color.
+// Routes are workspace-scoped: membership and admin role are enforced by middleware.
+type WorkspaceBrandingHandler struct {
+	workspaceService WorkspaceBrandingService
+	storage          *filestorage.LocalStorage
+	storageGuard     FileStorageGuard
+	maxAvatarSize    int64
+}
+
+// WorkspaceBrandingHandlerOption configures a WorkspaceBrandingHandler.
+type WorkspaceBrandingHandlerOption func(*WorkspaceBrandingHandler)
+
+// WithBrandingStorageGuard runs avatar writes through the given guard.
+func WithBrandingStorageGuard(guard FileStorageGuard) WorkspaceBrandingHandlerOption {
+	return func(h *WorkspaceBrandingHandler) {
+		h.storageGuard = guard
+	}
+}
+
+// NewWorkspaceBrandingHandler creates a new WorkspaceBrandingHandler.
+func NewWorkspaceBrandingHandler(
+	workspaceService WorkspaceBrandingService,
+	storage *filestorage.LocalStorage,
+	opts ...WorkspaceBrandingHandlerOption,
+) *WorkspaceBrandingHandler {
+	h := &WorkspaceBrandingHandler{
+		workspaceService: workspaceService,
+		storage:          storage,
+		maxAvatarSize:    defaultMaxAvatarSize,
+	}
+	for _, opt := range opts {
+		opt(h)
+	}
+	return h
+}
+
+// WorkspaceAvatarURL returns the URL serving the workspace avatar, or "" if none is set.
+func WorkspaceAvatarURL(ws *workspace.Workspace) string {
+	if !ws.Branding().HasAvatar() {
+		return ""
+	}
+	// the file ID busts browser caches when the avatar changes
+	return fmt.Sprintf("/api/v1/workspaces/%s/avatar?v=%s", ws.ID(), ws.Branding().AvatarFileID())
+}
+
+// UpdateAccentColor handles PUT /api/v1/workspaces/:workspace_id/branding.
+func (h *WorkspaceBrandingHandler) UpdateAccentColor(c echo.Context) error {
+	userID, workspaceID, errResp := h.parseRequest(c)
+	if errResp != nil {
+		return errResp()
+	}
+
+	var req UpdateAccentColorRequest
+	if bindErr := c.Bind(&req); bindErr != nil {
+		return httpserver.RespondErrorWithCode(
+			c, http.StatusBadRequest, "INVALID_REQUEST", "Invalid request body")
+	}
+
+	ws, err := h.workspaceService.UpdateAccentColor(
+		c.Request().Context(), workspaceID, userID, strings.TrimSpace(req.AccentColor))
+	if err != nil {
+		return h.respondUpdateError(c, err)
+	}
+
+	return h.respondWorkspace(c, ws)
+}
+
+// UploadAvatar handles POST /api/v1/workspaces/:workspace_id/branding/avatar.
+// Accepts a multipart form with an image in the "file" field.
+func (h *WorkspaceBrandingHandler) UploadAvatar(c echo.Context) error {
+	userID, workspaceID, errResp := h.parseRequest(c)
+	if errResp != nil {
+		return errResp()
+	}
+
+	c.Request().Body = http.MaxBytesReader(c.Response(), c.Request().Body, h.maxAvatarSize)
+
+	file, err := c.FormFile("file")
+	if err != nil {
+		if strings.Contains(err.Error(), "http: request body too large") {
+			return httpserver.RespondErrorWithCode(
+				c, http.StatusRequestEntityTooLarge, "FILE_TOO_LARGE",
+				fmt.Sprintf("avatar size exceeds %d MB limit", h.maxAvatarSize/bytesPerMB))
+		}
+		return httpserver.RespondErrorWithCode(
+			c, http.StatusBadRequest, "INVALID_FILE", "file is required")
+	}
+	if file.Size > h.maxAvatarSize {
+		return httpserver.RespondErrorWithCode(
+			c, http.StatusRequestEntityTooLarge, "FILE_TOO_LARGE",
+			fmt.Sprintf("avatar size exceeds %d MB limit", h.maxAvatarSize/bytesPerMB))
+	}
+
+	mimeType := file.Header.Get("Content-Type")
+	if mimeType == "" || mimeType == mimeOctetStream {
+		mimeType = mime.TypeByExtension(filepath.Ext(file.Filename))
+	}
+	if !strings.HasPrefix(mimeType, "image/") {
+		return httpserver.RespondErrorWithCode(
+			c, http.StatusBadRequest, "INVALID_FILE_TYPE", "avatar must be an image")
+	}
+
+	src, openErr := file.Open()
+	if openErr != nil {
+		return httpserver.RespondErrorWithCode(
+			c, http.StatusInternalServerError, "FILE_ERROR", "failed to read uploaded file")
+	}
+	defer src.Close()
+
+	previous := h.currentBranding(c.Request().Context(), workspaceID)
+
+	safeName := sanitizeFileName(file.Filename)
+	fileID, saveErr := h.save(c.Request().Context(), src, safeName)
+	if saveErr != nil {
+		var depErr *resilience.DependencyError
+		if errors.As(saveErr, &depErr) {
+			return httpserver.RespondError(c, depErr)
+		}
+		return httpserver.RespondErrorWithCode(
+			c, http.StatusInternalServerError, "STORAGE_ERROR", "failed to save file")
+	}
+
+	ws, err := h.workspaceService.UpdateAvatar(c.Request().Context(), workspaceID, userID, fileID, safeName)
+	if err != nil {
+		_ = h.storage.Delete(fileID, safeName)
+		return h.respondUpdateError(c, err)
+	}
+
+	h.deleteAvatarFile(previous)
+	return h.respondWorkspace(c, ws)
+}
+
+// DeleteAvatar handles DELETE /api/v1/workspaces/:workspace_id/branding/avatar.
+func (h *WorkspaceBrandingHandler) DeleteAvatar(c echo.Context) error {
+	userID, workspaceID, errResp := h.parseRequest(c)
+	if errResp != nil {
+		return errResp()
+	}
+
+	previous := h.currentBranding(c.Request().Context(), workspaceID)
+
+	ws, err := h.workspaceService.UpdateAvatar(c.Request().Context(), workspaceID, userID, "", "")
+	if err != nil {
+		return h.respondUpdateError(c, err)
+	}
+
+	h.deleteAvatarFile(previous)
+	return h.respondWorkspace(c, ws)
+}
+
+// Avatar handles GET /api/v1/workspaces/:workspace_id/avatar.
+func (h *WorkspaceBrandingHandler) Avatar(c echo.Context) error {
+	workspaceID, parseErr := uuid.ParseUUID(c.Param("workspace_id"))
+	if parseErr != nil {
+		return httpserver.RespondErrorWithCode(
+			c, http.StatusBadRequest, "INVALID_WORKSPACE_ID", "Invalid workspace ID format")
+	}
+
+	ws, err := h.workspaceService.GetWorkspace(c.Request().Context(), workspaceID)
+	if err != nil || !ws.Branding().HasAvatar() {
+		return httpserver.RespondErrorWithCode(c, http.StatusNotFound, "AVATAR_NOT_FOUND", "avatar not found")
+	}
+
+	branding := ws.Branding()
+	if !h.storage.Exists(branding.AvatarFileID(), branding.AvatarFileName()) {
+		return httpserver.RespondErrorWithCode(c, http.StatusNotFound, "AVATAR_NOT_FOUND", "avatar not found")
+	}
+	filePath, pathErr := h.storage.FilePath(branding.AvatarFileID(), branding.AvatarFileName())
+	if pathErr != nil {
+		return httpserver.RespondErrorWithCode(c, http.StatusBadRequest, "INVALID_PATH", "invalid file path")
+	}
+
+	c.Response().Header().Set("Cache-Control", "private, max-age=86400")
+	return c.File(filePath)
+}
+
+// parseRequest extracts the authenticated user and workspace ID; the returned
+// function writes the error response when parsing fails.
+func (h *WorkspaceBrandingHandler) parseRequest(c echo.Context) (uuid.UUID, uuid.UUID, func() error) {
+	userID := middleware.GetUserID(c)
+	if userID.IsZero() {
+		return "", "", func() error {
+			return httpserver.RespondErrorWithCode(
+				c, http.StatusUnauthorized, "UNAUTHORIZED", "User not authenticated")
+		}
+	}
+
+	workspaceID, parseErr := uuid.ParseUUID(c.Param("workspace_id"))
+	if parseErr != nil {
+		return "", "", func() error {
+			return httpserver.RespondErrorWithCode(
+				c, http.StatusBadRequest, "INVALID_WORKSPACE_ID", "Invalid workspace ID format")
+		}
+	}
+
+	return userID, workspaceID, nil
+}
+
+func (h *WorkspaceBrandingHandler) respondUpdateError(c echo.Context, err error) error {
+	switch {
+	case errors.Is(err, errs.ErrInvalidInput):
+		return httpserver.RespondErrorWithCode(
+			c, http.StatusBadRequest, "VALIDATION_ERROR", "accent color must be a #RRGGBB hex color")
+	case errors.Is(err, ErrWorkspaceNotFound), errors.Is(err, errs.ErrNotFound):
+		return httpserver.RespondErrorWithCode(
+			c, http.StatusNotFound, "WORKSPACE_NOT_FOUND", "Workspace not found")
+	}
+	return httpserver.RespondErrorWithCode(
+		c, http.StatusInternalServerError, "UPDATE_FAILED", "Failed to update workspace branding")
+}
+
+func (h *WorkspaceBrandingHandler) respondWorkspace(c echo.Context, ws *workspace.Workspace) error {
+	memberCount, _ := h.workspaceService.GetMemberCount(c.Request().Context(), ws.ID())
+	return httpserver.RespondOK(c, ToWorkspaceResponse(ws, memberCount))
+}
+
+// save writes the avatar to storage, through the storage guard if one is configured.
+func (h *WorkspaceBrandingHandler) save(ctx context.Context, src io.Reader, fileName string) (uuid.UUID, error) {
+	if h.storageGuard == nil {
+		return h.storage.Save(src, fileName)
+	}
+
+	var fileID uuid.UUID
+	err := h.storageGuard.Do(ctx, func(ctx context.Context) error {
+		var saveErr error
+		fileID, saveErr = h.storage.Save(resilience.NewContextReader(ctx, src), fileName)
+		return saveErr
+	})
+	return fileID, err
+}
+
+// currentBranding snapshots the branding before an update so the replaced avatar can be removed.
+func (h *WorkspaceBrandingHandler) currentBranding(ctx context.Context, workspaceID uuid.UUID) workspace.Branding {
+	ws, err := h.workspaceService.GetWorkspace(ctx, workspaceID)
+	if err != nil {
+		return workspace.Branding{}
+	}
+	return ws.Branding()
+}
+
+// deleteAvatarFile removes a replaced avatar from storage; failures only leave an orphaned file.
+func (h *WorkspaceBrandingHandler) deleteAvatarFile(previous workspace.Branding) {
+	if !previous.HasAvatar() {
+		return
+	}
+	_ = h.storage.Delete(previous.AvatarFileID(), previous.AvatarFileName())
+}
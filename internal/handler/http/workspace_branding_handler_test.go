@@ -0,0 +1,236 @@
+package httphandler_test
+
+import (
+	"context"
+	stdhttp "net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/lllypuk/flowra/internal/domain/errs"
+	"github.com/lllypuk/flowra/internal/domain/uuid"
+	"github.com/lllypuk/flowra/internal/domain/workspace"
+	httphandler "github.com/lllypuk/flowra/internal/handler/http"
+	"github.com/lllypuk/flowra/internal/infrastructure/filestorage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// mockBrandingService implements httphandler.WorkspaceBrandingService for tests.
+type mockBrandingService struct {
+	workspaces map[uuid.UUID]*workspace.Workspace
+}
+
+func newMockBrandingService(ws ...*workspace.Workspace) *mockBrandingService {
+	m := &mockBrandingService{workspaces: make(map[uuid.UUID]*workspace.Workspace)}
+	for _, w := range ws {
+		m.workspaces[w.ID()] = w
+	}
+	return m
+}
+
+func (m *mockBrandingService) GetWorkspace(_ context.Context, id uuid.UUID) (*workspace.Workspace, error) {
+	ws, ok := m.workspaces[id]
+	if !ok {
+		return nil, errs.ErrNotFound
+	}
+	return ws, nil
+}
+
+func (m *mockBrandingService) UpdateAccentColor(
+	ctx context.Context,
+	id, _ uuid.UUID,
+	color string,
+) (*workspace.Workspace, error) {
+	ws, err := m.GetWorkspace(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	branding, err := workspace.NewBranding(ws.Branding().AvatarFileID(), ws.Branding().AvatarFileName(), color)
+	if err != nil {
+		return nil, err
+	}
+	ws.SetBranding(branding)
+	return ws, nil
+}
+
+func (m *mockBrandingService) UpdateAvatar(
+	ctx context.Context,
+	id, _ uuid.UUID,
+	fileID uuid.UUID,
+	fileName string,
+) (*workspace.Workspace, error) {
+	ws, err := m.GetWorkspace(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	branding, err := workspace.NewBranding(fileID, fileName, ws.Branding().AccentColor())
+	if err != nil {
+		return nil, err
+	}
+	ws.SetBranding(branding)
+	return ws, nil
+}
+
+func (m *mockBrandingService) GetMemberCount(_ context.Context, _ uuid.UUID) (int, error) {
+	return 1, nil
+}
+
+func newTestBrandingHandler(t *testing.T) (
+	*httphandler.WorkspaceBrandingHandler,
+	*filestorage.LocalStorage,
+	*workspace.Workspace,
+) {
+	t.Helper()
+	storage, err := filestorage.NewLocalStorage(t.TempDir())
+	require.NoError(t, err)
+	ws, err := workspace.NewWorkspace("Design", "", "group-1", uuid.NewUUID())
+	require.NoError(t, err)
+	return httphandler.NewWorkspaceBrandingHandler(newMockBrandingService(ws), storage), storage, ws
+}
+
+func TestWorkspaceBrandingHandler_UpdateAccentColor(t *testing.T) {
+	userID := uuid.NewUUID()
+
+	t.Run("sets accent color", func(t *testing.T) {
+		handler, _, ws := newTestBrandingHandler(t)
+		e := echo.New()
+
+		req := httptest.NewRequest(stdhttp.MethodPut, "/", strings.NewReader(`{"accent_color":"#FF8800"}`))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("workspace_id")
+		c.SetParamValues(ws.ID().String())
+		setupAuthContext(c, userID)
+
+		require.NoError(t, handler.UpdateAccentColor(c))
+		assert.Equal(t, stdhttp.StatusOK, rec.Code)
+		assert.Equal(t, "#ff8800", ws.Branding().AccentColor())
+
+		assert.Contains(t, rec.Body.String(), `"accent_color":"#ff8800"`)
+	})
+
+	t.Run("rejects invalid color", func(t *testing.T) {
+		handler, _, ws := newTestBrandingHandler(t)
+		e := echo.New()
+
+		req := httptest.NewRequest(stdhttp.MethodPut, "/", strings.NewReader(`{"accent_color":"red"}`))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("workspace_id")
+		c.SetParamValues(ws.ID().String())
+		setupAuthContext(c, userID)
+
+		require.NoError(t, handler.UpdateAccentColor(c))
+		assert.Equal(t, stdhttp.StatusBadRequest, rec.Code)
+		assert.Contains(t, rec.Body.String(), "VALIDATION_ERROR")
+	})
+
+	t.Run("unknown workspace", func(t *testing.T) {
+		handler, _, _ := newTestBrandingHandler(t)
+		e := echo.New()
+
+		req := httptest.NewRequest(stdhttp.MethodPut, "/", strings.NewReader(`{"accent_color":"#000000"}`))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("workspace_id")
+		c.SetParamValues(uuid.NewUUID().String())
+		setupAuthContext(c, userID)
+
+		require.NoError(t, handler.UpdateAccentColor(c))
+		assert.Equal(t, stdhttp.StatusNotFound, rec.Code)
+	})
+}
+
+func TestWorkspaceBrandingHandler_UploadAvatar(t *testing.T) {
+	userID := uuid.NewUUID()
+
+	t.Run("stores avatar and replaces the previous one", func(t *testing.T) {
+		handler, storage, ws := newTestBrandingHandler(t)
+		e := echo.New()
+
+		upload := func() {
+			body, contentType := createMultipartFile(t, "logo.png", "png-bytes")
+			req := httptest.NewRequest(stdhttp.MethodPost, "/", body)
+			req.Header.Set(echo.HeaderContentType, contentType)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetParamNames("workspace_id")
+			c.SetParamValues(ws.ID().String())
+			setupAuthContext(c, userID)
+
+			require.NoError(t, handler.UploadAvatar(c))
+			require.Equal(t, stdhttp.StatusOK, rec.Code)
+		}
+
+		upload()
+		first := ws.Branding()
+		require.True(t, first.HasAvatar())
+		assert.True(t, storage.Exists(first.AvatarFileID(), first.AvatarFileName()))
+
+		upload()
+		second := ws.Branding()
+		assert.NotEqual(t, first.AvatarFileID(), second.AvatarFileID())
+		assert.False(t, storage.Exists(first.AvatarFileID(), first.AvatarFileName()))
+		assert.True(t, storage.Exists(second.AvatarFileID(), second.AvatarFileName()))
+	})
+
+	t.Run("rejects non-image files", func(t *testing.T) {
+		handler, _, ws := newTestBrandingHandler(t)
+		e := echo.New()
+
+		body, contentType := createMultipartFile(t, "notes.txt", "hello")
+		req := httptest.NewRequest(stdhttp.MethodPost, "/", body)
+		req.Header.Set(echo.HeaderContentType, contentType)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("workspace_id")
+		c.SetParamValues(ws.ID().String())
+		setupAuthContext(c, userID)
+
+		require.NoError(t, handler.UploadAvatar(c))
+		assert.Equal(t, stdhttp.StatusBadRequest, rec.Code)
+		assert.Contains(t, rec.Body.String(), "INVALID_FILE_TYPE")
+		assert.False(t, ws.Branding().HasAvatar())
+	})
+}
+
+func TestWorkspaceBrandingHandler_Avatar(t *testing.T) {
+	t.Run("serves stored avatar", func(t *testing.T) {
+		handler, storage, ws := newTestBrandingHandler(t)
+		fileID, err := storage.Save(strings.NewReader("png-bytes"), "logo.png")
+		require.NoError(t, err)
+		branding, err := workspace.NewBranding(fileID, "logo.png", "")
+		require.NoError(t, err)
+		ws.SetBranding(branding)
+
+		e := echo.New()
+		req := httptest.NewRequest(stdhttp.MethodGet, "/", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("workspace_id")
+		c.SetParamValues(ws.ID().String())
+
+		require.NoError(t, handler.Avatar(c))
+		assert.Equal(t, stdhttp.StatusOK, rec.Code)
+		assert.Equal(t, "png-bytes", rec.Body.String())
+	})
+
+	t.Run("not found without avatar", func(t *testing.T) {
+		handler, _, ws := newTestBrandingHandler(t)
+
+		e := echo.New()
+		req := httptest.NewRequest(stdhttp.MethodGet, "/", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("workspace_id")
+		c.SetParamValues(ws.ID().String())
+
+		require.NoError(t, handler.Avatar(c))
+		assert.Equal(t, stdhttp.StatusNotFound, rec.Code)
+	})
+}
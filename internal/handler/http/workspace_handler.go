@@ -3,22 +3,21 @@ package httphandler
 import (
 	"context"
 	"errors"
+	"fmt"
 	"net/http"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/labstack/echo/v4"
+	"github.com/lllypuk/flowra/internal/domain/chat"
+	"github.com/lllypuk/flowra/internal/domain/errs"
 	"github.com/lllypuk/flowra/internal/domain/uuid"
 	"github.com/lllypuk/flowra/internal/domain/workspace"
 	"github.com/lllypuk/flowra/internal/infrastructure/httpserver"
 	"github.com/lllypuk/flowra/internal/middleware"
 )
 
-// Validation constants.
-const (
-	maxWorkspaceNameLength        = 100
-	maxWorkspaceDescriptionLength = 500
-)
-
 // Workspace handler errors.
 var (
 	ErrWorkspaceNotFound     = errors.New("workspace not found")
@@ -26,30 +25,64 @@ var (
 	ErrMemberNotFound        = errors.New("member not found in workspace")
 	ErrCannotRemoveOwner     = errors.New("cannot remove workspace owner")
 	ErrInvalidRole           = errors.New("invalid role")
-	ErrInsufficientPrivilege = errors.New("insufficient privileges for this operation")
+	ErrInsufficientPrivilege = fmt.Errorf("%w: insufficient privileges for this operation", errs.ErrForbidden)
 )
 
 // CreateWorkspaceRequest represents the request to create a workspace.
 type CreateWorkspaceRequest struct {
-	Name        string `json:"name"        form:"name"`
-	Description string `json:"description" form:"description"`
+	Name        string `json:"name"        form:"name"        validate:"required,max=100"`
+	Description string `json:"description" form:"description" validate:"max=500"`
 }
 
 // UpdateWorkspaceRequest represents the request to update a workspace.
 type UpdateWorkspaceRequest struct {
-	Name        string `json:"name"        form:"name"`
-	Description string `json:"description" form:"description"`
+	Name        string `json:"name"        form:"name"        validate:"required,max=100"`
+	Description string `json:"description" form:"description" validate:"max=500"`
+}
+
+// UpdateValuePolicyRequest represents the request to configure the priorities and
+// severities allowed per entity type. Omitted or empty lists lift the restriction.
+type UpdateValuePolicyRequest struct {
+	Priorities map[string][]string `json:"priorities"`
+	Severities map[string][]string `json:"severities"`
+}
+
+// UpdateSLAPolicyRequest represents the request to configure the SLA targets of bugs
+// keyed by severity. Omitted severities and zero minutes are not tracked.
+type UpdateSLAPolicyRequest struct {
+	Targets map[string]SLATargetRequest `json:"targets"`
+}
+
+// SLATargetRequest represents the response and resolution targets of one severity.
+type SLATargetRequest struct {
+	ResponseMinutes   int64 `json:"response_minutes"`
+	ResolutionMinutes int64 `json:"resolution_minutes"`
+}
+
+// UpdateAnalyticsRequest represents the request to opt a workspace out of product analytics.
+type UpdateAnalyticsRequest struct {
+	OptOut bool `json:"opt_out"`
+}
+
+// UpdateJoinApprovalRequest represents the request to require admin approval for new members.
+type UpdateJoinApprovalRequest struct {
+	RequireApproval bool `json:"require_approval"`
+}
+
+// UpdateDiscoverabilityRequest represents the request to list or hide a workspace in the discovery directory.
+type UpdateDiscoverabilityRequest struct {
+	Discoverable bool `json:"discoverable"`
 }
 
 // AddMemberRequest represents the request to add a member to a workspace.
 type AddMemberRequest struct {
-	UserID uuid.UUID `json:"user_id"`
-	Role   string    `json:"role"`
+	UserID uuid.UUID `json:"user_id" validate:"required,uuid"`
+	Role   string    `json:"role"    validate:"required,role"`
 }
 
 // UpdateMemberRoleRequest represents the request to update a member's role.
 type UpdateMemberRoleRequest struct {
-	Role string `json:"role"`
+	Role string `json:"role" validate:"required,role"`
 }
 
 // WorkspaceResponse represents a workspace in API responses.
@@ -61,6 +94,54 @@ type WorkspaceResponse struct {
 	CreatedAt   string    `json:"created_at"`
 	UpdatedAt   string    `json:"updated_at"`
 	MemberCount int       `json:"member_count"`
+
+	ValuePolicy         *ValuePolicyResponse  `json:"value_policy,omitempty"`
+	SLAPolicy           *SLAPolicyResponse    `json:"sla_policy,omitempty"`
+	AnalyticsOptOut     bool                  `json:"analytics_opt_out"`
+	RequireJoinApproval bool                  `json:"require_join_approval"`
+	Discoverable        bool                  `json:"discoverable"`
+	Branding            *BrandingResponse     `json:"branding,omitempty"`
+	Storage             *StorageUsageResponse `json:"storage,omitempty"`
+}
+
+// StorageUsageResponse represents the attachment storage used by a workspace.
+type StorageUsageResponse struct {
+	UsedBytes   int64 `json:"used_bytes"`
+	QuotaBytes  int64 `json:"quota_bytes,omitempty"`
+	UsedPercent int   `json:"used_percent,omitempty"`
+}
+
+// ToStorageUsageResponse converts storage usage to a response; the percentage is only
+// reported for workspaces with a quota.
+func ToStorageUsageResponse(usage StorageUsage) *StorageUsageResponse {
+	resp := &StorageUsageResponse{UsedBytes: usage.UsedBytes, QuotaBytes: usage.QuotaBytes}
+	if usage.QuotaBytes > 0 {
+		resp.UsedPercent = int(usage.UsedBytes * percentScale / usage.QuotaBytes)
+	}
+	return resp
+}
+
+// ValuePolicyResponse represents the allowed priorities/severities keyed by entity type.
+type ValuePolicyResponse struct {
+	Priorities map[string][]string `json:"priorities,omitempty"`
+	Severities map[string][]string `json:"severities,omitempty"`
+}
+
+// SLAPolicyResponse represents the SLA targets of bugs keyed by severity.
+type SLAPolicyResponse struct {
+	Targets map[string]SLATargetResponse `json:"targets"`
+}
+
+// SLATargetResponse represents the response and resolution targets of one severity.
+type SLATargetResponse struct {
+	ResponseMinutes   int64 `json:"response_minutes,omitempty"`
+	ResolutionMinutes int64 `json:"resolution_minutes,omitempty"`
+}
+
+// BrandingResponse represents the workspace avatar and accent color.
+type BrandingResponse struct {
+	AvatarURL   string `json:"avatar_url,omitempty"`
+	AccentColor string `json:"accent_color,omitempty"`
 }
 
 // WorkspaceListResponse represents a list of workspaces in API responses.
@@ -78,6 +159,7 @@ type MemberResponse struct {
 	JoinedAt string    `json:"joined_at"`
 	Username string    `json:"username,omitempty"`
 	Email    string    `json:"email,omitempty"`
+	Status   string    `json:"status"`
 }
 
 // WorkspaceService defines the interface for workspace operations.
@@ -95,6 +177,32 @@ type WorkspaceService interface {
 	// UpdateWorkspace updates a workspace.
 	UpdateWorkspace(ctx context.Context, id uuid.UUID, name, description string) (*workspace.Workspace, error)
 
+	// UpdateValuePolicy replaces the allowed priorities/severities of a workspace.
+	UpdateValuePolicy(
+		ctx context.Context,
+		id, updatedBy uuid.UUID,
+		priorities, severities map[chat.Type][]string,
+	) (*workspace.Workspace, error)
+
+	// UpdateSLAPolicy replaces the SLA targets of bugs in a workspace.
+	UpdateSLAPolicy(
+		ctx context.Context,
+		id, updatedBy uuid.UUID,
+		targets map[string]workspace.SLATarget,
+	) (*workspace.Workspace, error)
+
+	// UpdateAnalyticsOptOut opts a workspace out of (or back into) product analytics.
+	UpdateAnalyticsOptOut(ctx context.Context, id, updatedBy uuid.UUID, optOut bool) (*workspace.Workspace, error)
+
+	// UpdateJoinApproval toggles admin approval for members joining a workspace.
+	UpdateJoinApproval(ctx context.Context, id, updatedBy uuid.UUID, requireApproval bool) (*workspace.Workspace, error)
+
+	// UpdateDiscoverability lists or hides the workspace in the discovery directory.
+	UpdateDiscoverability(ctx context.Context, id, updatedBy uuid.UUID, discoverable bool) (*workspace.Workspace, error)
+
+	// DiscoverWorkspaces lists discoverable workspaces whose name contains query.
+	DiscoverWorkspaces(ctx context.Context, query string, offset, limit int) ([]*workspace.Workspace, int, error)
+
 	// DeleteWorkspace deletes a workspace (soft delete).
 	DeleteWorkspace(ctx context.Context, id uuid.UUID) error
 
@@ -122,20 +230,51 @@ type MemberService interface {
 
 	// IsOwner checks if a user is the owner of a workspace.
 	IsOwner(ctx context.Context, workspaceID, userID uuid.UUID) (bool, error)
+
+	// JoinWorkspace creates a pending membership in a workspace that requires join approval.
+	JoinWorkspace(ctx context.Context, workspaceID, userID uuid.UUID) (*workspace.Member, error)
+
+	// ApproveMember activates a pending membership.
+	ApproveMember(ctx context.Context, workspaceID, userID uuid.UUID) (*workspace.Member, error)
+
+	// RejectMember deletes a pending membership.
+	RejectMember(ctx context.Context, workspaceID, userID uuid.UUID) error
+
+	// ListPendingMembers lists memberships waiting for approval.
+	ListPendingMembers(ctx context.Context, workspaceID uuid.UUID) ([]*workspace.Member, error)
 }
 
 // WorkspaceHandler handles workspace-related HTTP requests.
 type WorkspaceHandler struct {
 	workspaceService WorkspaceService
 	memberService    MemberService
+	storageQuota     WorkspaceStorageQuota
+}
+
+// WorkspaceHandlerOption configures a WorkspaceHandler.
+type WorkspaceHandlerOption func(*WorkspaceHandler)
+
+// WithWorkspaceStorageQuota reports the attachment storage usage of workspaces.
+func WithWorkspaceStorageQuota(quota WorkspaceStorageQuota) WorkspaceHandlerOption {
+	return func(h *WorkspaceHandler) {
+		h.storageQuota = quota
+	}
 }
 
 // NewWorkspaceHandler creates a new WorkspaceHandler.
-func NewWorkspaceHandler(workspaceService WorkspaceService, memberService MemberService) *WorkspaceHandler {
-	return &WorkspaceHandler{
+func NewWorkspaceHandler(
+	workspaceService WorkspaceService,
+	memberService MemberService,
+	opts ...WorkspaceHandlerOption,
+) *WorkspaceHandler {
+	h := &WorkspaceHandler{
 		workspaceService: workspaceService,
 		memberService:    memberService,
 	}
+	for _, opt := range opts {
+		opt(h)
+	}
+	return h
 }
 
 // RegisterRoutes registers workspace routes with the router.
@@ -143,14 +282,24 @@ func (h *WorkspaceHandler) RegisterRoutes(r *httpserver.Router) {
 	// Workspace CRUD (authenticated routes)
 	r.Auth().POST("/workspaces", h.Create)
 	r.Auth().GET("/workspaces", h.List)
+	r.Auth().GET("/workspaces/discover", h.Discover)
 	r.Auth().GET("/workspaces/:id", h.Get)
 	r.Auth().PUT("/workspaces/:id", h.Update)
 	r.Auth().DELETE("/workspaces/:id", h.Delete)
+	r.Auth().PUT("/workspaces/:id/value-policy", h.UpdateValuePolicy)
+	r.Auth().PUT("/workspaces/:id/sla-policy", h.UpdateSLAPolicy)
+	r.Auth().PUT("/workspaces/:id/analytics", h.UpdateAnalytics)
+	r.Auth().PUT("/workspaces/:id/join-approval", h.UpdateJoinApproval)
+	r.Auth().PUT("/workspaces/:id/discoverability", h.UpdateDiscoverability)
+	r.Auth().POST("/workspaces/:id/join", h.Join)
 
 	// Member management (workspace-scoped routes)
 	r.Auth().POST("/workspaces/:id/members", h.AddMember)
 	r.Auth().DELETE("/workspaces/:id/members/:user_id", h.RemoveMember)
 	r.Auth().PUT("/workspaces/:id/members/:user_id/role", h.UpdateMemberRole)
+	r.Auth().GET("/workspaces/:id/members/pending", h.ListPendingMembers)
+	r.Auth().POST("/workspaces/:id/members/:user_id/approve", h.ApproveMember)
+	r.Auth().POST("/workspaces/:id/members/:user_id/reject", h.RejectMember)
 }
 
 // Create handles POST /api/v1/workspaces.
@@ -177,49 +326,66 @@ func (h *WorkspaceHandler) Create(c echo.Context) error {
 	}
 
 	// Validate required fields
-	if req.Name == "" {
-		return httpserver.RespondErrorWithCode(
-			c,
-			http.StatusBadRequest,
-			"VALIDATION_ERROR",
-			"Workspace name is required",
-		)
+	if valErr := validateRequest(&req); valErr != nil {
+		return httpserver.RespondValidationError(c, valErr)
 	}
 
-	if len(req.Name) > maxWorkspaceNameLength {
+	ws, err := h.workspaceService.CreateWorkspace(c.Request().Context(), userID, req.Name, req.Description)
+	if err != nil {
 		return httpserver.RespondErrorWithCode(
 			c,
-			http.StatusBadRequest,
-			"VALIDATION_ERROR",
-			"Workspace name must be at most 100 characters",
+			http.StatusInternalServerError,
+			"CREATE_FAILED",
+			"Failed to create workspace",
 		)
 	}
 
-	if len(req.Description) > maxWorkspaceDescriptionLength {
+	return httpserver.RespondCreated(c, ToWorkspaceResponse(ws, 1)) // Owner is the first member
+}
+
+// List handles GET /api/v1/workspaces.
+// Lists all workspaces the authenticated user is a member of.
+func (h *WorkspaceHandler) List(c echo.Context) error {
+	userID := middleware.GetUserID(c)
+	if userID.IsZero() {
 		return httpserver.RespondErrorWithCode(
 			c,
-			http.StatusBadRequest,
-			"VALIDATION_ERROR",
-			"Workspace description must be at most 500 characters",
+			http.StatusUnauthorized,
+			"UNAUTHORIZED",
+			"User not authenticated",
 		)
 	}
 
-	ws, err := h.workspaceService.CreateWorkspace(c.Request().Context(), userID, req.Name, req.Description)
+	// Parse pagination parameters
+	offset, limit := ParsePagination(c)
+
+	workspaces, total, err := h.workspaceService.ListUserWorkspaces(c.Request().Context(), userID, offset, limit)
 	if err != nil {
 		return httpserver.RespondErrorWithCode(
 			c,
 			http.StatusInternalServerError,
-			"CREATE_FAILED",
-			"Failed to create workspace",
+			"LIST_FAILED",
+			"Failed to list workspaces",
 		)
 	}
 
-	return httpserver.RespondCreated(c, ToWorkspaceResponse(ws, 1)) // Owner is the first member
+	responses := make([]WorkspaceResponse, 0, len(workspaces))
+	for _, ws := range workspaces {
+		memberCount, _ := h.workspaceService.GetMemberCount(c.Request().Context(), ws.ID())
+		responses = append(responses, ToWorkspaceResponse(ws, memberCount))
+	}
+
+	return httpserver.RespondPage(c, WorkspaceListResponse{
+		Workspaces: responses,
+		Total:      total,
+		Offset:     offset,
+		Limit:      limit,
+	}, httpserver.NewPagination(offset, limit, total))
 }
 
-// List handles GET /api/v1/workspaces.
-// Lists all workspaces the authenticated user is a member of.
-func (h *WorkspaceHandler) List(c echo.Context) error {
+// Discover handles GET /api/v1/workspaces/discover.
+// Lists discoverable workspaces, optionally filtered by the "q" name query.
+func (h *WorkspaceHandler) Discover(c echo.Context) error {
 	userID := middleware.GetUserID(c)
 	if userID.IsZero() {
 		return httpserver.RespondErrorWithCode(
@@ -230,10 +396,10 @@ func (h *WorkspaceHandler) List(c echo.Context) error {
 		)
 	}
 
-	// Parse pagination parameters
 	offset, limit := ParsePagination(c)
+	query := strings.TrimSpace(c.QueryParam("q"))
 
-	workspaces, total, err := h.workspaceService.ListUserWorkspaces(c.Request().Context(), userID, offset, limit)
+	workspaces, total, err := h.workspaceService.DiscoverWorkspaces(c.Request().Context(), query, offset, limit)
 	if err != nil {
 		return httpserver.RespondErrorWithCode(
 			c,
@@ -249,12 +415,12 @@ func (h *WorkspaceHandler) List(c echo.Context) error {
 		responses = append(responses, ToWorkspaceResponse(ws, memberCount))
 	}
 
-	return httpserver.RespondOK(c, WorkspaceListResponse{
+	return httpserver.RespondPage(c, WorkspaceListResponse{
 		Workspaces: responses,
 		Total:      total,
 		Offset:     offset,
 		Limit:      limit,
-	})
+	}, httpserver.NewPagination(offset, limit, total))
 }
 
 // Get handles GET /api/v1/workspaces/:id.
@@ -310,7 +476,13 @@ func (h *WorkspaceHandler) Get(c echo.Context) error {
 	}
 
 	memberCount, _ := h.workspaceService.GetMemberCount(c.Request().Context(), ws.ID())
-	return httpserver.RespondOK(c, ToWorkspaceResponse(ws, memberCount))
+	resp := ToWorkspaceResponse(ws, memberCount)
+	if h.storageQuota != nil {
+		if usage, usageErr := h.storageQuota.GetUsage(c.Request().Context(), ws.ID()); usageErr == nil {
+			resp.Storage = ToStorageUsageResponse(usage)
+		}
+	}
+	return httpserver.RespondOK(c, resp)
 }
 
 // Update handles PUT /api/v1/workspaces/:id.
@@ -357,31 +529,8 @@ func (h *WorkspaceHandler) Update(c echo.Context) error {
 	}
 
 	// Validate fields
-	if req.Name == "" {
-		return httpserver.RespondErrorWithCode(
-			c,
-			http.StatusBadRequest,
-			"VALIDATION_ERROR",
-			"Workspace name is required",
-		)
-	}
-
-	if len(req.Name) > maxWorkspaceNameLength {
-		return httpserver.RespondErrorWithCode(
-			c,
-			http.StatusBadRequest,
-			"VALIDATION_ERROR",
-			"Workspace name must be at most 100 characters",
-		)
-	}
-
-	if len(req.Description) > maxWorkspaceDescriptionLength {
-		return httpserver.RespondErrorWithCode(
-			c,
-			http.StatusBadRequest,
-			"VALIDATION_ERROR",
-			"Workspace description must be at most 500 characters",
-		)
+	if valErr := validateRequest(&req); valErr != nil {
+		return httpserver.RespondValidationError(c, valErr)
 	}
 
 	ws, updateErr := h.workspaceService.UpdateWorkspace(c.Request().Context(), workspaceID, req.Name, req.Description)
@@ -406,9 +555,9 @@ func (h *WorkspaceHandler) Update(c echo.Context) error {
 	return httpserver.RespondOK(c, ToWorkspaceResponse(ws, memberCount))
 }
 
-// Delete handles DELETE /api/v1/workspaces/:id.
-// Deletes a workspace (soft delete).
-func (h *WorkspaceHandler) Delete(c echo.Context) error {
+// UpdateValuePolicy handles PUT /api/v1/workspaces/:id/value-policy.
+// Replaces the priorities and severities allowed per entity type.
+func (h *WorkspaceHandler) UpdateValuePolicy(c echo.Context) error {
 	userID := middleware.GetUserID(c)
 	if userID.IsZero() {
 		return httpserver.RespondErrorWithCode(
@@ -429,20 +578,43 @@ func (h *WorkspaceHandler) Delete(c echo.Context) error {
 		)
 	}
 
-	// Only owner can delete workspace
-	isOwner, _ := h.memberService.IsOwner(c.Request().Context(), workspaceID, userID)
-	if !isOwner && !middleware.IsSystemAdmin(c) {
+	if !h.hasAdminPrivileges(c, workspaceID, userID) {
 		return httpserver.RespondErrorWithCode(
 			c,
 			http.StatusForbidden,
 			"FORBIDDEN",
-			"Only the workspace owner can delete the workspace",
+			"Insufficient privileges to update workspace",
 		)
 	}
 
-	deleteErr := h.workspaceService.DeleteWorkspace(c.Request().Context(), workspaceID)
-	if deleteErr != nil {
-		if errors.Is(deleteErr, ErrWorkspaceNotFound) {
+	var req UpdateValuePolicyRequest
+	if bindErr := c.Bind(&req); bindErr != nil {
+		return httpserver.RespondErrorWithCode(
+			c,
+			http.StatusBadRequest,
+			"INVALID_REQUEST",
+			"Invalid request body",
+		)
+	}
+
+	ws, updateErr := h.workspaceService.UpdateValuePolicy(
+		c.Request().Context(),
+		workspaceID,
+		userID,
+		toTypeKeyed(req.Priorities),
+		toTypeKeyed(req.Severities),
+	)
+	if updateErr != nil {
+		switch {
+		case errors.Is(updateErr, errs.ErrInvalidInput):
+			return httpserver.RespondErrorWithCode(
+				c,
+				http.StatusBadRequest,
+				"VALIDATION_ERROR",
+				"Priorities may be restricted for task, bug and epic, severities for bug only, "+
+					"using known values",
+			)
+		case errors.Is(updateErr, ErrWorkspaceNotFound):
 			return httpserver.RespondErrorWithCode(
 				c,
 				http.StatusNotFound,
@@ -453,17 +625,18 @@ func (h *WorkspaceHandler) Delete(c echo.Context) error {
 		return httpserver.RespondErrorWithCode(
 			c,
 			http.StatusInternalServerError,
-			"DELETE_FAILED",
-			"Failed to delete workspace",
+			"UPDATE_FAILED",
+			"Failed to update workspace",
 		)
 	}
 
-	return httpserver.RespondNoContent(c)
+	memberCount, _ := h.workspaceService.GetMemberCount(c.Request().Context(), ws.ID())
+	return httpserver.RespondOK(c, ToWorkspaceResponse(ws, memberCount))
 }
 
-// AddMember handles POST /api/v1/workspaces/:id/members.
-// Adds a member to a workspace.
-func (h *WorkspaceHandler) AddMember(c echo.Context) error {
+// UpdateSLAPolicy handles PUT /api/v1/workspaces/:id/sla-policy.
+// Replaces the response and resolution targets of bugs per severity.
+func (h *WorkspaceHandler) UpdateSLAPolicy(c echo.Context) error {
 	userID := middleware.GetUserID(c)
 	if userID.IsZero() {
 		return httpserver.RespondErrorWithCode(
@@ -484,17 +657,16 @@ func (h *WorkspaceHandler) AddMember(c echo.Context) error {
 		)
 	}
 
-	// Check if user has admin privileges
 	if !h.hasAdminPrivileges(c, workspaceID, userID) {
 		return httpserver.RespondErrorWithCode(
 			c,
 			http.StatusForbidden,
 			"FORBIDDEN",
-			"Insufficient privileges to add members",
+			"Insufficient privileges to update workspace",
 		)
 	}
 
-	var req AddMemberRequest
+	var req UpdateSLAPolicyRequest
 	if bindErr := c.Bind(&req); bindErr != nil {
 		return httpserver.RespondErrorWithCode(
 			c,
@@ -504,47 +676,23 @@ func (h *WorkspaceHandler) AddMember(c echo.Context) error {
 		)
 	}
 
-	// Validate required fields
-	if req.UserID.IsZero() {
-		return httpserver.RespondErrorWithCode(
-			c,
-			http.StatusBadRequest,
-			"VALIDATION_ERROR",
-			"User ID is required",
-		)
-	}
-
-	role, err := ParseRole(req.Role)
-	if err != nil {
-		return httpserver.RespondErrorWithCode(
-			c,
-			http.StatusBadRequest,
-			"VALIDATION_ERROR",
-			"Role must be one of: admin, member",
-		)
-	}
-
-	// Cannot add owner role through this endpoint
-	if role == workspace.RoleOwner {
-		return httpserver.RespondErrorWithCode(
-			c,
-			http.StatusBadRequest,
-			"VALIDATION_ERROR",
-			"Cannot assign owner role through this endpoint",
-		)
-	}
-
-	member, err := h.memberService.AddMember(c.Request().Context(), workspaceID, req.UserID, role)
-	if err != nil {
-		if errors.Is(err, ErrMemberAlreadyExists) {
+	ws, updateErr := h.workspaceService.UpdateSLAPolicy(
+		c.Request().Context(),
+		workspaceID,
+		userID,
+		toSLATargets(req.Targets),
+	)
+	if updateErr != nil {
+		switch {
+		case errors.Is(updateErr, errs.ErrInvalidInput):
 			return httpserver.RespondErrorWithCode(
 				c,
-				http.StatusConflict,
-				"MEMBER_ALREADY_EXISTS",
-				"User is already a member of this workspace",
+				http.StatusBadRequest,
+				"VALIDATION_ERROR",
+				"Targets must use known severities, non-negative minutes "+
+					"and a response target not longer than the resolution target",
 			)
-		}
-		if errors.Is(err, ErrWorkspaceNotFound) {
+		case errors.Is(updateErr, ErrWorkspaceNotFound):
 			return httpserver.RespondErrorWithCode(
 				c,
 				http.StatusNotFound,
@@ -555,19 +703,20 @@ func (h *WorkspaceHandler) AddMember(c echo.Context) error {
 		return httpserver.RespondErrorWithCode(
 			c,
 			http.StatusInternalServerError,
-			"ADD_MEMBER_FAILED",
-			"Failed to add member",
+			"UPDATE_FAILED",
+			"Failed to update workspace",
 		)
 	}
 
-	return httpserver.RespondCreated(c, ToMemberResponse(member))
+	memberCount, _ := h.workspaceService.GetMemberCount(c.Request().Context(), ws.ID())
+	return httpserver.RespondOK(c, ToWorkspaceResponse(ws, memberCount))
 }
 
-// RemoveMember handles DELETE /api/v1/workspaces/:id/members/:user_id.
-// Removes a member from a workspace.
-func (h *WorkspaceHandler) RemoveMember(c echo.Context) error {
-	currentUserID := middleware.GetUserID(c)
-	if currentUserID.IsZero() {
+// UpdateAnalytics handles PUT /api/v1/workspaces/:id/analytics.
+// Opts the workspace out of (or back into) anonymized product analytics.
+func (h *WorkspaceHandler) UpdateAnalytics(c echo.Context) error {
+	userID := middleware.GetUserID(c)
+	if userID.IsZero() {
 		return httpserver.RespondErrorWithCode(
 			c,
 			http.StatusUnauthorized,
@@ -586,48 +735,28 @@ func (h *WorkspaceHandler) RemoveMember(c echo.Context) error {
 		)
 	}
 
-	targetUserID, parseUserErr := uuid.ParseUUID(c.Param("user_id"))
-	if parseUserErr != nil {
-		return httpserver.RespondErrorWithCode(
-			c,
-			http.StatusBadRequest,
-			"INVALID_USER_ID",
-			"Invalid user ID format",
-		)
-	}
-
-	// Users can remove themselves, admins can remove others
-	if currentUserID != targetUserID && !h.hasAdminPrivileges(c, workspaceID, currentUserID) {
+	if !h.hasAdminPrivileges(c, workspaceID, userID) {
 		return httpserver.RespondErrorWithCode(
 			c,
 			http.StatusForbidden,
 			"FORBIDDEN",
-			"Insufficient privileges to remove members",
+			"Insufficient privileges to update workspace",
 		)
 	}
 
-	// Cannot remove the owner
-	isOwner, _ := h.memberService.IsOwner(c.Request().Context(), workspaceID, targetUserID)
-	if isOwner {
+	var req UpdateAnalyticsRequest
+	if bindErr := c.Bind(&req); bindErr != nil {
 		return httpserver.RespondErrorWithCode(
 			c,
 			http.StatusBadRequest,
-			"CANNOT_REMOVE_OWNER",
-			"Cannot remove the workspace owner",
+			"INVALID_REQUEST",
+			"Invalid request body",
 		)
 	}
 
-	removeErr := h.memberService.RemoveMember(c.Request().Context(), workspaceID, targetUserID)
-	if removeErr != nil {
-		if errors.Is(removeErr, ErrMemberNotFound) {
-			return httpserver.RespondErrorWithCode(
-				c,
-				http.StatusNotFound,
-				"MEMBER_NOT_FOUND",
-				"Member not found in workspace",
-			)
-		}
-		if errors.Is(removeErr, ErrWorkspaceNotFound) {
+	ws, updateErr := h.workspaceService.UpdateAnalyticsOptOut(c.Request().Context(), workspaceID, userID, req.OptOut)
+	if updateErr != nil {
+		if errors.Is(updateErr, ErrWorkspaceNotFound) {
 			return httpserver.RespondErrorWithCode(
 				c,
 				http.StatusNotFound,
@@ -638,19 +767,20 @@ func (h *WorkspaceHandler) RemoveMember(c echo.Context) error {
 		return httpserver.RespondErrorWithCode(
 			c,
 			http.StatusInternalServerError,
-			"REMOVE_MEMBER_FAILED",
-			"Failed to remove member",
+			"UPDATE_FAILED",
+			"Failed to update workspace",
 		)
 	}
 
-	return httpserver.RespondNoContent(c)
+	memberCount, _ := h.workspaceService.GetMemberCount(c.Request().Context(), ws.ID())
+	return httpserver.RespondOK(c, ToWorkspaceResponse(ws, memberCount))
 }
 
-// UpdateMemberRole handles PUT /api/v1/workspaces/:id/members/:user_id/role.
-// Updates a member's role in a workspace.
-func (h *WorkspaceHandler) UpdateMemberRole(c echo.Context) error {
-	currentUserID := middleware.GetUserID(c)
-	if currentUserID.IsZero() {
+// UpdateJoinApproval handles PUT /api/v1/workspaces/:id/join-approval.
+// Requires (or stops requiring) admin approval for members joining the workspace.
+func (h *WorkspaceHandler) UpdateJoinApproval(c echo.Context) error {
+	userID := middleware.GetUserID(c)
+	if userID.IsZero() {
 		return httpserver.RespondErrorWithCode(
 			c,
 			http.StatusUnauthorized,
@@ -669,87 +799,620 @@ func (h *WorkspaceHandler) UpdateMemberRole(c echo.Context) error {
 		)
 	}
 
-	targetUserID, parseUserErr := uuid.ParseUUID(c.Param("user_id"))
-	if parseUserErr != nil {
+	if !h.hasAdminPrivileges(c, workspaceID, userID) {
 		return httpserver.RespondErrorWithCode(
 			c,
-			http.StatusBadRequest,
-			"INVALID_USER_ID",
-			"Invalid user ID format",
+			http.StatusForbidden,
+			"FORBIDDEN",
+			"Insufficient privileges to update workspace",
 		)
 	}
 
-	// Only owner can change roles
-	isOwner, _ := h.memberService.IsOwner(c.Request().Context(), workspaceID, currentUserID)
-	if !isOwner && !middleware.IsSystemAdmin(c) {
+	var req UpdateJoinApprovalRequest
+	if bindErr := c.Bind(&req); bindErr != nil {
 		return httpserver.RespondErrorWithCode(
 			c,
-			http.StatusForbidden,
-			"FORBIDDEN",
+			http.StatusBadRequest,
+			"INVALID_REQUEST",
+			"Invalid request body",
+		)
+	}
+
+	ws, updateErr := h.workspaceService.UpdateJoinApproval(
+		c.Request().Context(), workspaceID, userID, req.RequireApproval)
+	if updateErr != nil {
+		if errors.Is(updateErr, ErrWorkspaceNotFound) {
+			return httpserver.RespondErrorWithCode(
+				c,
+				http.StatusNotFound,
+				"WORKSPACE_NOT_FOUND",
+				"Workspace not found",
+			)
+		}
+		return httpserver.RespondErrorWithCode(
+			c,
+			http.StatusInternalServerError,
+			"UPDATE_FAILED",
+			"Failed to update workspace",
+		)
+	}
+
+	memberCount, _ := h.workspaceService.GetMemberCount(c.Request().Context(), ws.ID())
+	return httpserver.RespondOK(c, ToWorkspaceResponse(ws, memberCount))
+}
+
+// UpdateDiscoverability handles PUT /api/v1/workspaces/:id/discoverability.
+// Lists or hides the workspace in the discovery directory.
+func (h *WorkspaceHandler) UpdateDiscoverability(c echo.Context) error {
+	userID := middleware.GetUserID(c)
+	if userID.IsZero() {
+		return httpserver.RespondErrorWithCode(
+			c,
+			http.StatusUnauthorized,
+			"UNAUTHORIZED",
+			"User not authenticated",
+		)
+	}
+
+	workspaceID, parseErr := uuid.ParseUUID(c.Param("id"))
+	if parseErr != nil {
+		return httpserver.RespondErrorWithCode(
+			c,
+			http.StatusBadRequest,
+			"INVALID_WORKSPACE_ID",
+			"Invalid workspace ID format",
+		)
+	}
+
+	if !h.hasAdminPrivileges(c, workspaceID, userID) {
+		return httpserver.RespondErrorWithCode(
+			c,
+			http.StatusForbidden,
+			"FORBIDDEN",
+			"Insufficient privileges to update workspace",
+		)
+	}
+
+	var req UpdateDiscoverabilityRequest
+	if bindErr := c.Bind(&req); bindErr != nil {
+		return httpserver.RespondErrorWithCode(
+			c,
+			http.StatusBadRequest,
+			"INVALID_REQUEST",
+			"Invalid request body",
+		)
+	}
+
+	ws, updateErr := h.workspaceService.UpdateDiscoverability(
+		c.Request().Context(), workspaceID, userID, req.Discoverable)
+	if updateErr != nil {
+		if errors.Is(updateErr, ErrWorkspaceNotFound) {
+			return httpserver.RespondErrorWithCode(
+				c,
+				http.StatusNotFound,
+				"WORKSPACE_NOT_FOUND",
+				"Workspace not found",
+			)
+		}
+		return httpserver.RespondErrorWithCode(
+			c,
+			http.StatusInternalServerError,
+			"UPDATE_FAILED",
+			"Failed to update workspace",
+		)
+	}
+
+	memberCount, _ := h.workspaceService.GetMemberCount(c.Request().Context(), ws.ID())
+	return httpserver.RespondOK(c, ToWorkspaceResponse(ws, memberCount))
+}
+
+// Delete handles DELETE /api/v1/workspaces/:id.
+// Deletes a workspace (soft delete).
+func (h *WorkspaceHandler) Delete(c echo.Context) error {
+	userID := middleware.GetUserID(c)
+	if userID.IsZero() {
+		return httpserver.RespondErrorWithCode(
+			c,
+			http.StatusUnauthorized,
+			"UNAUTHORIZED",
+			"User not authenticated",
+		)
+	}
+
+	workspaceID, parseErr := uuid.ParseUUID(c.Param("id"))
+	if parseErr != nil {
+		return httpserver.RespondErrorWithCode(
+			c,
+			http.StatusBadRequest,
+			"INVALID_WORKSPACE_ID",
+			"Invalid workspace ID format",
+		)
+	}
+
+	// Only owner can delete workspace
+	isOwner, _ := h.memberService.IsOwner(c.Request().Context(), workspaceID, userID)
+	if !isOwner && !middleware.IsSystemAdmin(c) {
+		return httpserver.RespondErrorWithCode(
+			c,
+			http.StatusForbidden,
+			"FORBIDDEN",
+			"Only the workspace owner can delete the workspace",
+		)
+	}
+
+	deleteErr := h.workspaceService.DeleteWorkspace(c.Request().Context(), workspaceID)
+	if deleteErr != nil {
+		if errors.Is(deleteErr, ErrWorkspaceNotFound) {
+			return httpserver.RespondErrorWithCode(
+				c,
+				http.StatusNotFound,
+				"WORKSPACE_NOT_FOUND",
+				"Workspace not found",
+			)
+		}
+		return httpserver.RespondErrorWithCode(
+			c,
+			http.StatusInternalServerError,
+			"DELETE_FAILED",
+			"Failed to delete workspace",
+		)
+	}
+
+	return httpserver.RespondNoContent(c)
+}
+
+// AddMember handles POST /api/v1/workspaces/:id/members.
+// Adds a member to a workspace.
+func (h *WorkspaceHandler) AddMember(c echo.Context) error {
+	userID := middleware.GetUserID(c)
+	if userID.IsZero() {
+		return httpserver.RespondErrorWithCode(
+			c,
+			http.StatusUnauthorized,
+			"UNAUTHORIZED",
+			"User not authenticated",
+		)
+	}
+
+	workspaceID, parseErr := uuid.ParseUUID(c.Param("id"))
+	if parseErr != nil {
+		return httpserver.RespondErrorWithCode(
+			c,
+			http.StatusBadRequest,
+			"INVALID_WORKSPACE_ID",
+			"Invalid workspace ID format",
+		)
+	}
+
+	// Check if user has admin privileges
+	if !h.hasAdminPrivileges(c, workspaceID, userID) {
+		return httpserver.RespondErrorWithCode(
+			c,
+			http.StatusForbidden,
+			"FORBIDDEN",
+			"Insufficient privileges to add members",
+		)
+	}
+
+	var req AddMemberRequest
+	if bindErr := c.Bind(&req); bindErr != nil {
+		return httpserver.RespondErrorWithCode(
+			c,
+			http.StatusBadRequest,
+			"INVALID_REQUEST",
+			"Invalid request body",
+		)
+	}
+
+	// The role rule rejects owner, which cannot be granted through this endpoint
+	if valErr := validateRequest(&req); valErr != nil {
+		return httpserver.RespondValidationError(c, valErr)
+	}
+
+	member, err := h.memberService.AddMember(c.Request().Context(), workspaceID, req.UserID, workspace.Role(req.Role))
+	if err != nil {
+		if errors.Is(err, ErrMemberAlreadyExists) {
+			return httpserver.RespondErrorWithCode(
+				c,
+				http.StatusConflict,
+				"MEMBER_ALREADY_EXISTS",
+				"User is already a member of this workspace",
+			)
+		}
+		if errors.Is(err, ErrWorkspaceNotFound) {
+			return httpserver.RespondErrorWithCode(
+				c,
+				http.StatusNotFound,
+				"WORKSPACE_NOT_FOUND",
+				"Workspace not found",
+			)
+		}
+		return httpserver.RespondErrorWithCode(
+			c,
+			http.StatusInternalServerError,
+			"ADD_MEMBER_FAILED",
+			"Failed to add member",
+		)
+	}
+
+	return httpserver.RespondCreated(c, ToMemberResponse(member))
+}
+
+// RemoveMember handles DELETE /api/v1/workspaces/:id/members/:user_id.
+// Removes a member from a workspace.
+func (h *WorkspaceHandler) RemoveMember(c echo.Context) error {
+	currentUserID := middleware.GetUserID(c)
+	if currentUserID.IsZero() {
+		return httpserver.RespondErrorWithCode(
+			c,
+			http.StatusUnauthorized,
+			"UNAUTHORIZED",
+			"User not authenticated",
+		)
+	}
+
+	workspaceID, parseErr := uuid.ParseUUID(c.Param("id"))
+	if parseErr != nil {
+		return httpserver.RespondErrorWithCode(
+			c,
+			http.StatusBadRequest,
+			"INVALID_WORKSPACE_ID",
+			"Invalid workspace ID format",
+		)
+	}
+
+	targetUserID, parseUserErr := uuid.ParseUUID(c.Param("user_id"))
+	if parseUserErr != nil {
+		return httpserver.RespondErrorWithCode(
+			c,
+			http.StatusBadRequest,
+			"INVALID_USER_ID",
+			"Invalid user ID format",
+		)
+	}
+
+	// Users can remove themselves, admins can remove others
+	if currentUserID != targetUserID && !h.hasAdminPrivileges(c, workspaceID, currentUserID) {
+		return httpserver.RespondErrorWithCode(
+			c,
+			http.StatusForbidden,
+			"FORBIDDEN",
+			"Insufficient privileges to remove members",
+		)
+	}
+
+	// Cannot remove the owner
+	isOwner, _ := h.memberService.IsOwner(c.Request().Context(), workspaceID, targetUserID)
+	if isOwner {
+		return httpserver.RespondErrorWithCode(
+			c,
+			http.StatusBadRequest,
+			"CANNOT_REMOVE_OWNER",
+			"Cannot remove the workspace owner",
+		)
+	}
+
+	removeErr := h.memberService.RemoveMember(c.Request().Context(), workspaceID, targetUserID)
+	if removeErr != nil {
+		if errors.Is(removeErr, ErrMemberNotFound) {
+			return httpserver.RespondErrorWithCode(
+				c,
+				http.StatusNotFound,
+				"MEMBER_NOT_FOUND",
+				"Member not found in workspace",
+			)
+		}
+		if errors.Is(removeErr, ErrWorkspaceNotFound) {
+			return httpserver.RespondErrorWithCode(
+				c,
+				http.StatusNotFound,
+				"WORKSPACE_NOT_FOUND",
+				"Workspace not found",
+			)
+		}
+		return httpserver.RespondErrorWithCode(
+			c,
+			http.StatusInternalServerError,
+			"REMOVE_MEMBER_FAILED",
+			"Failed to remove member",
+		)
+	}
+
+	return httpserver.RespondNoContent(c)
+}
+
+// UpdateMemberRole handles PUT /api/v1/workspaces/:id/members/:user_id/role.
+// Updates a member's role in a workspace.
+func (h *WorkspaceHandler) UpdateMemberRole(c echo.Context) error {
+	currentUserID := middleware.GetUserID(c)
+	if currentUserID.IsZero() {
+		return httpserver.RespondErrorWithCode(
+			c,
+			http.StatusUnauthorized,
+			"UNAUTHORIZED",
+			"User not authenticated",
+		)
+	}
+
+	workspaceID, parseErr := uuid.ParseUUID(c.Param("id"))
+	if parseErr != nil {
+		return httpserver.RespondErrorWithCode(
+			c,
+			http.StatusBadRequest,
+			"INVALID_WORKSPACE_ID",
+			"Invalid workspace ID format",
+		)
+	}
+
+	targetUserID, parseUserErr := uuid.ParseUUID(c.Param("user_id"))
+	if parseUserErr != nil {
+		return httpserver.RespondErrorWithCode(
+			c,
+			http.StatusBadRequest,
+			"INVALID_USER_ID",
+			"Invalid user ID format",
+		)
+	}
+
+	// Only owner can change roles
+	isOwner, _ := h.memberService.IsOwner(c.Request().Context(), workspaceID, currentUserID)
+	if !isOwner && !middleware.IsSystemAdmin(c) {
+		return httpserver.RespondErrorWithCode(
+			c,
+			http.StatusForbidden,
+			"FORBIDDEN",
 			"Only the workspace owner can change member roles",
 		)
 	}
 
-	// Cannot change owner's role
-	isTargetOwner, _ := h.memberService.IsOwner(c.Request().Context(), workspaceID, targetUserID)
-	if isTargetOwner {
+	// Cannot change owner's role
+	isTargetOwner, _ := h.memberService.IsOwner(c.Request().Context(), workspaceID, targetUserID)
+	if isTargetOwner {
+		return httpserver.RespondErrorWithCode(
+			c,
+			http.StatusBadRequest,
+			"CANNOT_CHANGE_OWNER_ROLE",
+			"Cannot change the role of the workspace owner",
+		)
+	}
+
+	var req UpdateMemberRoleRequest
+	if bindErr := c.Bind(&req); bindErr != nil {
+		return httpserver.RespondErrorWithCode(
+			c,
+			http.StatusBadRequest,
+			"INVALID_REQUEST",
+			"Invalid request body",
+		)
+	}
+
+	// The role rule rejects owner, which cannot be assigned
+	if valErr := validateRequest(&req); valErr != nil {
+		return httpserver.RespondValidationError(c, valErr)
+	}
+
+	member, err := h.memberService.UpdateMemberRole(
+		c.Request().Context(), workspaceID, targetUserID, workspace.Role(req.Role))
+	if err != nil {
+		if errors.Is(err, ErrMemberNotFound) {
+			return httpserver.RespondErrorWithCode(
+				c,
+				http.StatusNotFound,
+				"MEMBER_NOT_FOUND",
+				"Member not found in workspace",
+			)
+		}
+		return httpserver.RespondErrorWithCode(
+			c,
+			http.StatusInternalServerError,
+			"UPDATE_ROLE_FAILED",
+			"Failed to update member role",
+		)
+	}
+
+	return httpserver.RespondOK(c, ToMemberResponse(member))
+}
+
+// Join handles POST /api/v1/workspaces/:id/join.
+// Creates a join request; the membership stays pending until an admin approves it.
+func (h *WorkspaceHandler) Join(c echo.Context) error {
+	userID := middleware.GetUserID(c)
+	if userID.IsZero() {
+		return httpserver.RespondErrorWithCode(
+			c,
+			http.StatusUnauthorized,
+			"UNAUTHORIZED",
+			"User not authenticated",
+		)
+	}
+
+	workspaceID, parseErr := uuid.ParseUUID(c.Param("id"))
+	if parseErr != nil {
 		return httpserver.RespondErrorWithCode(
 			c,
 			http.StatusBadRequest,
-			"CANNOT_CHANGE_OWNER_ROLE",
-			"Cannot change the role of the workspace owner",
+			"INVALID_WORKSPACE_ID",
+			"Invalid workspace ID format",
 		)
 	}
 
-	var req UpdateMemberRoleRequest
-	if bindErr := c.Bind(&req); bindErr != nil {
+	member, err := h.memberService.JoinWorkspace(c.Request().Context(), workspaceID, userID)
+	if err != nil {
+		switch {
+		case errors.Is(err, ErrMemberAlreadyExists), errors.Is(err, errs.ErrAlreadyExists):
+			return httpserver.RespondErrorWithCode(
+				c,
+				http.StatusConflict,
+				"MEMBER_ALREADY_EXISTS",
+				"You are already a member of this workspace or your request is pending",
+			)
+		case errors.Is(err, ErrWorkspaceNotFound), errors.Is(err, errs.ErrNotFound):
+			return httpserver.RespondErrorWithCode(
+				c,
+				http.StatusNotFound,
+				"WORKSPACE_NOT_FOUND",
+				"Workspace not found",
+			)
+		case errors.Is(err, errs.ErrForbidden):
+			return httpserver.RespondErrorWithCode(
+				c,
+				http.StatusForbidden,
+				"JOIN_NOT_ALLOWED",
+				"This workspace can only be joined by invitation",
+			)
+		}
+		return httpserver.RespondErrorWithCode(
+			c,
+			http.StatusInternalServerError,
+			"JOIN_FAILED",
+			"Failed to join workspace",
+		)
+	}
+
+	return httpserver.RespondCreated(c, ToMemberResponse(member))
+}
+
+// ListPendingMembers handles GET /api/v1/workspaces/:id/members/pending.
+// Lists memberships waiting for admin approval.
+func (h *WorkspaceHandler) ListPendingMembers(c echo.Context) error {
+	userID := middleware.GetUserID(c)
+	if userID.IsZero() {
+		return httpserver.RespondErrorWithCode(
+			c,
+			http.StatusUnauthorized,
+			"UNAUTHORIZED",
+			"User not authenticated",
+		)
+	}
+
+	workspaceID, parseErr := uuid.ParseUUID(c.Param("id"))
+	if parseErr != nil {
 		return httpserver.RespondErrorWithCode(
 			c,
 			http.StatusBadRequest,
-			"INVALID_REQUEST",
-			"Invalid request body",
+			"INVALID_WORKSPACE_ID",
+			"Invalid workspace ID format",
+		)
+	}
+
+	if !h.hasAdminPrivileges(c, workspaceID, userID) {
+		return httpserver.RespondErrorWithCode(
+			c,
+			http.StatusForbidden,
+			"FORBIDDEN",
+			"Insufficient privileges to review members",
 		)
 	}
 
-	role, err := ParseRole(req.Role)
+	members, err := h.memberService.ListPendingMembers(c.Request().Context(), workspaceID)
 	if err != nil {
+		return httpserver.RespondErrorWithCode(
+			c,
+			http.StatusInternalServerError,
+			"LIST_FAILED",
+			"Failed to list pending members",
+		)
+	}
+
+	resp := make([]MemberResponse, 0, len(members))
+	for _, m := range members {
+		resp = append(resp, ToMemberResponse(m))
+	}
+	return httpserver.RespondOK(c, map[string]any{"members": resp})
+}
+
+// ApproveMember handles POST /api/v1/workspaces/:id/members/:user_id/approve.
+// Activates a pending membership.
+func (h *WorkspaceHandler) ApproveMember(c echo.Context) error {
+	var approved *workspace.Member
+	return h.reviewPendingMember(c, func(ctx context.Context, workspaceID, userID uuid.UUID) error {
+		member, err := h.memberService.ApproveMember(ctx, workspaceID, userID)
+		approved = member
+		return err
+	}, func() error {
+		return httpserver.RespondOK(c, ToMemberResponse(approved))
+	})
+}
+
+// RejectMember handles POST /api/v1/workspaces/:id/members/:user_id/reject.
+// Deletes a pending membership.
+func (h *WorkspaceHandler) RejectMember(c echo.Context) error {
+	return h.reviewPendingMember(c, h.memberService.RejectMember, func() error {
+		return httpserver.RespondNoContent(c)
+	})
+}
+
+// reviewPendingMember runs the shared checks of approve/reject and maps review errors.
+func (h *WorkspaceHandler) reviewPendingMember(
+	c echo.Context,
+	review func(ctx context.Context, workspaceID, userID uuid.UUID) error,
+	respond func() error,
+) error {
+	currentUserID := middleware.GetUserID(c)
+	if currentUserID.IsZero() {
+		return httpserver.RespondErrorWithCode(
+			c,
+			http.StatusUnauthorized,
+			"UNAUTHORIZED",
+			"User not authenticated",
+		)
+	}
+
+	workspaceID, parseErr := uuid.ParseUUID(c.Param("id"))
+	if parseErr != nil {
 		return httpserver.RespondErrorWithCode(
 			c,
 			http.StatusBadRequest,
-			"VALIDATION_ERROR",
-			"Role must be one of: admin, member",
+			"INVALID_WORKSPACE_ID",
+			"Invalid workspace ID format",
 		)
 	}
 
-	// Cannot assign owner role
-	if role == workspace.RoleOwner {
+	targetUserID, parseUserErr := uuid.ParseUUID(c.Param("user_id"))
+	if parseUserErr != nil {
 		return httpserver.RespondErrorWithCode(
 			c,
 			http.StatusBadRequest,
-			"VALIDATION_ERROR",
-			"Cannot assign owner role",
+			"INVALID_USER_ID",
+			"Invalid user ID format",
 		)
 	}
 
-	member, err := h.memberService.UpdateMemberRole(c.Request().Context(), workspaceID, targetUserID, role)
-	if err != nil {
-		if errors.Is(err, ErrMemberNotFound) {
+	if !h.hasAdminPrivileges(c, workspaceID, currentUserID) {
+		return httpserver.RespondErrorWithCode(
+			c,
+			http.StatusForbidden,
+			"FORBIDDEN",
+			"Insufficient privileges to review members",
+		)
+	}
+
+	if err := review(c.Request().Context(), workspaceID, targetUserID); err != nil {
+		switch {
+		case errors.Is(err, ErrMemberNotFound), errors.Is(err, errs.ErrNotFound):
 			return httpserver.RespondErrorWithCode(
 				c,
 				http.StatusNotFound,
 				"MEMBER_NOT_FOUND",
 				"Member not found in workspace",
 			)
+		case errors.Is(err, errs.ErrInvalidState):
+			return httpserver.RespondErrorWithCode(
+				c,
+				http.StatusConflict,
+				"MEMBER_NOT_PENDING",
+				"Membership is not waiting for approval",
+			)
 		}
 		return httpserver.RespondErrorWithCode(
 			c,
 			http.StatusInternalServerError,
-			"UPDATE_ROLE_FAILED",
-			"Failed to update member role",
+			"REVIEW_MEMBER_FAILED",
+			"Failed to review member",
 		)
 	}
 
-	return httpserver.RespondOK(c, ToMemberResponse(member))
+	return respond()
 }
 
 // hasAdminPrivileges checks if a user has admin privileges in a workspace.
@@ -776,15 +1439,98 @@ func ToWorkspaceResponse(ws *workspace.Workspace, memberCount int) WorkspaceResp
 		CreatedAt:   ws.CreatedAt().Format("2006-01-02T15:04:05Z07:00"),
 		UpdatedAt:   ws.UpdatedAt().Format("2006-01-02T15:04:05Z07:00"),
 		MemberCount: memberCount,
+		ValuePolicy: toValuePolicyResponse(ws.ValuePolicy()),
+		SLAPolicy:   toSLAPolicyResponse(ws.SLAPolicy()),
+
+		AnalyticsOptOut:     ws.AnalyticsOptOut(),
+		RequireJoinApproval: ws.RequiresJoinApproval(),
+		Discoverable:        ws.IsDiscoverable(),
+		Branding:            toBrandingResponse(ws),
+	}
+}
+
+// toBrandingResponse converts the workspace branding; unbranded workspaces have none.
+func toBrandingResponse(ws *workspace.Workspace) *BrandingResponse {
+	branding := ws.Branding()
+	if !branding.HasAvatar() && branding.AccentColor() == "" {
+		return nil
+	}
+	return &BrandingResponse{
+		AvatarURL:   WorkspaceAvatarURL(ws),
+		AccentColor: branding.AccentColor(),
+	}
+}
+
+// toValuePolicyResponse converts the workspace value policy; unrestricted workspaces have none.
+func toValuePolicyResponse(policy workspace.ValuePolicy) *ValuePolicyResponse {
+	if policy.IsZero() {
+		return nil
+	}
+	return &ValuePolicyResponse{
+		Priorities: fromTypeKeyed(policy.Priorities()),
+		Severities: fromTypeKeyed(policy.Severities()),
 	}
 }
 
+// toSLAPolicyResponse converts the workspace SLA policy; workspaces without targets have none.
+func toSLAPolicyResponse(policy workspace.SLAPolicy) *SLAPolicyResponse {
+	if policy.IsZero() {
+		return nil
+	}
+	targets := policy.Targets()
+	resp := &SLAPolicyResponse{Targets: make(map[string]SLATargetResponse, len(targets))}
+	for severity, target := range targets {
+		resp.Targets[severity] = SLATargetResponse{
+			ResponseMinutes:   int64(target.Response / time.Minute),
+			ResolutionMinutes: int64(target.Resolution / time.Minute),
+		}
+	}
+	return resp
+}
+
+func toSLATargets(targets map[string]SLATargetRequest) map[string]workspace.SLATarget {
+	if len(targets) == 0 {
+		return nil
+	}
+	result := make(map[string]workspace.SLATarget, len(targets))
+	for severity, target := range targets {
+		result[severity] = workspace.SLATarget{
+			Response:   time.Duration(target.ResponseMinutes) * time.Minute,
+			Resolution: time.Duration(target.ResolutionMinutes) * time.Minute,
+		}
+	}
+	return result
+}
+
+func toTypeKeyed(values map[string][]string) map[chat.Type][]string {
+	if len(values) == 0 {
+		return nil
+	}
+	result := make(map[chat.Type][]string, len(values))
+	for entityType, list := range values {
+		result[chat.Type(entityType)] = list
+	}
+	return result
+}
+
+func fromTypeKeyed(values map[chat.Type][]string) map[string][]string {
+	if len(values) == 0 {
+		return nil
+	}
+	result := make(map[string][]string, len(values))
+	for entityType, list := range values {
+		result[string(entityType)] = list
+	}
+	return result
+}
+
 // ToMemberResponse converts a domain Member to a MemberResponse.
 func ToMemberResponse(m *workspace.Member) MemberResponse {
 	return MemberResponse{
 		UserID:   m.UserID(),
 		Role:     m.Role().String(),
 		JoinedAt: m.JoinedAt().Format("2006-01-02T15:04:05Z07:00"),
+		Status:   string(m.Status()),
 	}
 }
 
@@ -802,7 +1548,8 @@ func ParseRole(roleStr string) (workspace.Role, error) {
 	}
 }
 
-// ParsePagination extracts pagination parameters from the request.
+// ParsePagination extracts pagination parameters from the request. A page cursor
+// takes precedence over offset.
 func ParsePagination(c echo.Context) (int, int) {
 	const defaultLimit = 20
 	const maxLimit = 100
@@ -822,6 +1569,10 @@ func ParsePagination(c echo.Context) (int, int) {
 		}
 	}
 
+	if parsed, ok := httpserver.CursorOffset(c); ok {
+		offset = parsed
+	}
+
 	return offset, limit
 }
 
@@ -890,6 +1641,29 @@ func (m *MockWorkspaceService) ListUserWorkspaces(
 	return all[offset:end], total, nil
 }
 
+// DiscoverWorkspaces implements WorkspaceService.
+func (m *MockWorkspaceService) DiscoverWorkspaces(
+	_ context.Context,
+	query string,
+	offset, limit int,
+) ([]*workspace.Workspace, int, error) {
+	matched := make([]*workspace.Workspace, 0)
+	for _, ws := range m.workspaces {
+		if ws.IsDiscoverable() && strings.Contains(strings.ToLower(ws.Name()), strings.ToLower(query)) {
+			matched = append(matched, ws)
+		}
+	}
+
+	total := len(matched)
+	if offset >= total {
+		return []*workspace.Workspace{}, total, nil
+	}
+
+	end := min(offset+limit, total)
+
+	return matched[offset:end], total, nil
+}
+
 // UpdateWorkspace implements WorkspaceService.
 func (m *MockWorkspaceService) UpdateWorkspace(
 	_ context.Context,
@@ -906,6 +1680,84 @@ func (m *MockWorkspaceService) UpdateWorkspace(
 	return ws, nil
 }
 
+// UpdateValuePolicy implements WorkspaceService.
+func (m *MockWorkspaceService) UpdateValuePolicy(
+	_ context.Context,
+	id, _ uuid.UUID,
+	priorities, severities map[chat.Type][]string,
+) (*workspace.Workspace, error) {
+	ws, ok := m.workspaces[id]
+	if !ok {
+		return nil, ErrWorkspaceNotFound
+	}
+	policy, err := workspace.NewValuePolicy(priorities, severities)
+	if err != nil {
+		return nil, err
+	}
+	ws.SetValuePolicy(policy)
+	return ws, nil
+}
+
+// UpdateSLAPolicy implements WorkspaceService.
+func (m *MockWorkspaceService) UpdateSLAPolicy(
+	_ context.Context,
+	id, _ uuid.UUID,
+	targets map[string]workspace.SLATarget,
+) (*workspace.Workspace, error) {
+	ws, ok := m.workspaces[id]
+	if !ok {
+		return nil, ErrWorkspaceNotFound
+	}
+	policy, err := workspace.NewSLAPolicy(targets)
+	if err != nil {
+		return nil, err
+	}
+	ws.SetSLAPolicy(policy)
+	return ws, nil
+}
+
+// UpdateAnalyticsOptOut implements WorkspaceService.
+func (m *MockWorkspaceService) UpdateAnalyticsOptOut(
+	_ context.Context,
+	id, _ uuid.UUID,
+	optOut bool,
+) (*workspace.Workspace, error) {
+	ws, ok := m.workspaces[id]
+	if !ok {
+		return nil, ErrWorkspaceNotFound
+	}
+	ws.SetAnalyticsOptOut(optOut)
+	return ws, nil
+}
+
+// UpdateJoinApproval implements WorkspaceService.
+func (m *MockWorkspaceService) UpdateJoinApproval(
+	_ context.Context,
+	id, _ uuid.UUID,
+	requireApproval bool,
+) (*workspace.Workspace, error) {
+	ws, ok := m.workspaces[id]
+	if !ok {
+		return nil, ErrWorkspaceNotFound
+	}
+	ws.SetRequireJoinApproval(requireApproval)
+	return ws, nil
+}
+
+// UpdateDiscoverability implements WorkspaceService.
+func (m *MockWorkspaceService) UpdateDiscoverability(
+	_ context.Context,
+	id, _ uuid.UUID,
+	discoverable bool,
+) (*workspace.Workspace, error) {
+	ws, ok := m.workspaces[id]
+	if !ok {
+		return nil, ErrWorkspaceNotFound
+	}
+	ws.SetDiscoverable(discoverable)
+	return ws, nil
+}
+
 // DeleteWorkspace implements WorkspaceService.
 func (m *MockWorkspaceService) DeleteWorkspace(_ context.Context, id uuid.UUID) error {
 	if _, ok := m.workspaces[id]; !ok {
@@ -1034,3 +1886,65 @@ func (m *MockMemberService) IsOwner(_ context.Context, workspaceID, userID uuid.
 	}
 	return ownerID == userID, nil
 }
+
+// JoinWorkspace implements MemberService.
+func (m *MockMemberService) JoinWorkspace(
+	_ context.Context,
+	workspaceID, userID uuid.UUID,
+) (*workspace.Member, error) {
+	key := workspaceID.String() + ":" + userID.String()
+	if _, exists := m.members[key]; exists {
+		return nil, ErrMemberAlreadyExists
+	}
+
+	member := workspace.NewPendingMember(userID, workspaceID, workspace.RoleMember)
+	m.members[key] = &member
+	return &member, nil
+}
+
+// ApproveMember implements MemberService.
+func (m *MockMemberService) ApproveMember(
+	_ context.Context,
+	workspaceID, userID uuid.UUID,
+) (*workspace.Member, error) {
+	key := workspaceID.String() + ":" + userID.String()
+	member, exists := m.members[key]
+	if !exists {
+		return nil, ErrMemberNotFound
+	}
+
+	approved, err := member.Approve()
+	if err != nil {
+		return nil, err
+	}
+	m.members[key] = &approved
+	return &approved, nil
+}
+
+// RejectMember implements MemberService.
+func (m *MockMemberService) RejectMember(_ context.Context, workspaceID, userID uuid.UUID) error {
+	key := workspaceID.String() + ":" + userID.String()
+	member, exists := m.members[key]
+	if !exists {
+		return ErrMemberNotFound
+	}
+	if !member.IsPending() {
+		return errs.ErrInvalidState
+	}
+	delete(m.members, key)
+	return nil
+}
+
+// ListPendingMembers implements MemberService.
+func (m *MockMemberService) ListPendingMembers(
+	_ context.Context,
+	workspaceID uuid.UUID,
+) ([]*workspace.Member, error) {
+	members := make([]*workspace.Member, 0)
+	for _, member := range m.members {
+		if member.WorkspaceID() == workspaceID && member.IsPending() {
+			members = append(members, member)
+		}
+	}
+	return members, nil
+}
@@ -6,6 +6,7 @@ import (
 	"net/http/httptest"
 	"strings"
 	"testing"
+	"time"
 
 	httphandler "github.com/lllypuk/flowra/internal/handler/http"
 
@@ -245,6 +246,17 @@ func TestWorkspaceHandler_List(t *testing.T) {
 		err := handler.List(c)
 		require.NoError(t, err)
 		assert.Equal(t, stdhttp.StatusOK, rec.Code)
+
+		var resp httpserver.Response
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+		require.NotNil(t, resp.Pagination)
+		require.NotNil(t, resp.Pagination.Total)
+		assert.Equal(t, 5, *resp.Pagination.Total)
+		assert.Equal(t, 2, resp.Pagination.Offset)
+		assert.Equal(t, 2, resp.Pagination.Limit)
+		assert.True(t, resp.Pagination.HasMore)
+		assert.Equal(t, httpserver.EncodeOffsetCursor(4), resp.Pagination.NextCursor)
+		assert.Equal(t, httpserver.EncodeOffsetCursor(0), resp.Pagination.PrevCursor)
 	})
 
 	t.Run("unauthorized - no user in context", func(t *testing.T) {
@@ -295,6 +307,39 @@ func TestWorkspaceHandler_Get(t *testing.T) {
 		assert.Equal(t, stdhttp.StatusOK, rec.Code)
 	})
 
+	t.Run("reports storage usage", func(t *testing.T) {
+		userID := uuid.NewUUID()
+		mockWSService := httphandler.NewMockWorkspaceService()
+		mockMemberService := httphandler.NewMockMemberService()
+		ws := createTestWorkspace(t, userID, "Test Workspace")
+		mockWSService.AddWorkspace(ws, 1)
+		member := workspace.NewMember(userID, ws.ID(), workspace.RoleMember)
+		mockMemberService.AddMemberToMock(&member)
+
+		quota := newStubStorageQuota(1000)
+		quota.used[ws.ID()] = 250
+		handler := httphandler.NewWorkspaceHandler(
+			mockWSService, mockMemberService, httphandler.WithWorkspaceStorageQuota(quota))
+
+		req := httptest.NewRequest(stdhttp.MethodGet, "/api/v1/workspaces/"+ws.ID().String(), nil)
+		rec := httptest.NewRecorder()
+		c := echo.New().NewContext(req, rec)
+		c.SetParamNames("id")
+		c.SetParamValues(ws.ID().String())
+		setupWorkspaceAuthContext(c, userID, false)
+
+		require.NoError(t, handler.Get(c))
+		assert.Equal(t, stdhttp.StatusOK, rec.Code)
+
+		var resp struct {
+			Data httphandler.WorkspaceResponse `json:"data"`
+		}
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+		require.NotNil(t, resp.Data.Storage)
+		assert.Equal(t, httphandler.StorageUsageResponse{UsedBytes: 250, QuotaBytes: 1000, UsedPercent: 25},
+			*resp.Data.Storage)
+	})
+
 	t.Run("workspace not found", func(t *testing.T) {
 		e := echo.New()
 		userID := uuid.NewUUID()
@@ -526,6 +571,462 @@ func TestWorkspaceHandler_Update(t *testing.T) {
 	})
 }
 
+func TestWorkspaceHandler_UpdateValuePolicy(t *testing.T) {
+	newRequest := func(
+		t *testing.T,
+		handler *httphandler.WorkspaceHandler,
+		ws *workspace.Workspace,
+		userID uuid.UUID,
+		body string,
+	) *httptest.ResponseRecorder {
+		t.Helper()
+		e := echo.New()
+		req := httptest.NewRequest(
+			stdhttp.MethodPut,
+			"/api/v1/workspaces/"+ws.ID().String()+"/value-policy",
+			strings.NewReader(body),
+		)
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("id")
+		c.SetParamValues(ws.ID().String())
+
+		setupWorkspaceAuthContext(c, userID, false)
+
+		require.NoError(t, handler.UpdateValuePolicy(c))
+		return rec
+	}
+
+	t.Run("successful update by admin", func(t *testing.T) {
+		userID := uuid.NewUUID()
+		mockWSService := httphandler.NewMockWorkspaceService()
+		mockMemberService := httphandler.NewMockMemberService()
+
+		ws := createTestWorkspace(t, userID, "Workspace")
+		mockWSService.AddWorkspace(ws, 1)
+		member := workspace.NewMember(userID, ws.ID(), workspace.RoleAdmin)
+		mockMemberService.AddMemberToMock(&member)
+
+		handler := httphandler.NewWorkspaceHandler(mockWSService, mockMemberService)
+		rec := newRequest(t, handler, ws, userID,
+			`{"priorities": {"task": ["High", "Critical"]}, "severities": {"bug": ["Blocker"]}}`)
+
+		assert.Equal(t, stdhttp.StatusOK, rec.Code)
+
+		var resp struct {
+			Data httphandler.WorkspaceResponse `json:"data"`
+		}
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+		require.NotNil(t, resp.Data.ValuePolicy)
+		assert.Equal(t, []string{"High", "Critical"}, resp.Data.ValuePolicy.Priorities["task"])
+		assert.Equal(t, []string{"Blocker"}, resp.Data.ValuePolicy.Severities["bug"])
+	})
+
+	t.Run("invalid policy", func(t *testing.T) {
+		userID := uuid.NewUUID()
+		mockWSService := httphandler.NewMockWorkspaceService()
+		mockMemberService := httphandler.NewMockMemberService()
+
+		ws := createTestWorkspace(t, userID, "Workspace")
+		mockWSService.AddWorkspace(ws, 1)
+		member := workspace.NewMember(userID, ws.ID(), workspace.RoleAdmin)
+		mockMemberService.AddMemberToMock(&member)
+
+		handler := httphandler.NewWorkspaceHandler(mockWSService, mockMemberService)
+		rec := newRequest(t, handler, ws, userID, `{"severities": {"task": ["Major"]}}`)
+
+		assert.Equal(t, stdhttp.StatusBadRequest, rec.Code)
+		assert.Contains(t, rec.Body.String(), "VALIDATION_ERROR")
+	})
+
+	t.Run("forbidden - not admin", func(t *testing.T) {
+		userID := uuid.NewUUID()
+		mockWSService := httphandler.NewMockWorkspaceService()
+		mockMemberService := httphandler.NewMockMemberService()
+
+		ws := createTestWorkspace(t, uuid.NewUUID(), "Workspace")
+		mockWSService.AddWorkspace(ws, 1)
+		member := workspace.NewMember(userID, ws.ID(), workspace.RoleMember)
+		mockMemberService.AddMemberToMock(&member)
+
+		handler := httphandler.NewWorkspaceHandler(mockWSService, mockMemberService)
+		rec := newRequest(t, handler, ws, userID, `{"priorities": {"task": ["High"]}}`)
+
+		assert.Equal(t, stdhttp.StatusForbidden, rec.Code)
+	})
+}
+
+func TestWorkspaceHandler_UpdateSLAPolicy(t *testing.T) {
+	newRequest := func(
+		t *testing.T,
+		handler *httphandler.WorkspaceHandler,
+		ws *workspace.Workspace,
+		userID uuid.UUID,
+		body string,
+	) *httptest.ResponseRecorder {
+		t.Helper()
+		e := echo.New()
+		req := httptest.NewRequest(
+			stdhttp.MethodPut,
+			"/api/v1/workspaces/"+ws.ID().String()+"/sla-policy",
+			strings.NewReader(body),
+		)
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("id")
+		c.SetParamValues(ws.ID().String())
+
+		setupWorkspaceAuthContext(c, userID, false)
+
+		require.NoError(t, handler.UpdateSLAPolicy(c))
+		return rec
+	}
+
+	t.Run("successful update by admin", func(t *testing.T) {
+		userID := uuid.NewUUID()
+		mockWSService := httphandler.NewMockWorkspaceService()
+		mockMemberService := httphandler.NewMockMemberService()
+
+		ws := createTestWorkspace(t, userID, "Workspace")
+		mockWSService.AddWorkspace(ws, 1)
+		member := workspace.NewMember(userID, ws.ID(), workspace.RoleAdmin)
+		mockMemberService.AddMemberToMock(&member)
+
+		handler := httphandler.NewWorkspaceHandler(mockWSService, mockMemberService)
+		rec := newRequest(t, handler, ws, userID,
+			`{"targets": {"Critical": {"response_minutes": 60, "resolution_minutes": 480}}}`)
+
+		assert.Equal(t, stdhttp.StatusOK, rec.Code)
+
+		var resp struct {
+			Data httphandler.WorkspaceResponse `json:"data"`
+		}
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+		require.NotNil(t, resp.Data.SLAPolicy)
+		assert.Equal(t, httphandler.SLATargetResponse{ResponseMinutes: 60, ResolutionMinutes: 480},
+			resp.Data.SLAPolicy.Targets["Critical"])
+	})
+
+	t.Run("invalid targets", func(t *testing.T) {
+		userID := uuid.NewUUID()
+		mockWSService := httphandler.NewMockWorkspaceService()
+		mockMemberService := httphandler.NewMockMemberService()
+
+		ws := createTestWorkspace(t, userID, "Workspace")
+		mockWSService.AddWorkspace(ws, 1)
+		member := workspace.NewMember(userID, ws.ID(), workspace.RoleAdmin)
+		mockMemberService.AddMemberToMock(&member)
+
+		handler := httphandler.NewWorkspaceHandler(mockWSService, mockMemberService)
+		rec := newRequest(t, handler, ws, userID, `{"targets": {"Urgent": {"response_minutes": 60}}}`)
+
+		assert.Equal(t, stdhttp.StatusBadRequest, rec.Code)
+		assert.Contains(t, rec.Body.String(), "VALIDATION_ERROR")
+	})
+
+	t.Run("forbidden - not admin", func(t *testing.T) {
+		userID := uuid.NewUUID()
+		mockWSService := httphandler.NewMockWorkspaceService()
+		mockMemberService := httphandler.NewMockMemberService()
+
+		ws := createTestWorkspace(t, uuid.NewUUID(), "Workspace")
+		mockWSService.AddWorkspace(ws, 1)
+		member := workspace.NewMember(userID, ws.ID(), workspace.RoleMember)
+		mockMemberService.AddMemberToMock(&member)
+
+		handler := httphandler.NewWorkspaceHandler(mockWSService, mockMemberService)
+		rec := newRequest(t, handler, ws, userID, `{"targets": {}}`)
+
+		assert.Equal(t, stdhttp.StatusForbidden, rec.Code)
+	})
+}
+
+func TestWorkspaceHandler_UpdateAnalytics(t *testing.T) {
+	newRequest := func(
+		t *testing.T,
+		handler *httphandler.WorkspaceHandler,
+		ws *workspace.Workspace,
+		userID uuid.UUID,
+		body string,
+	) *httptest.ResponseRecorder {
+		t.Helper()
+		e := echo.New()
+		req := httptest.NewRequest(
+			stdhttp.MethodPut,
+			"/api/v1/workspaces/"+ws.ID().String()+"/analytics",
+			strings.NewReader(body),
+		)
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("id")
+		c.SetParamValues(ws.ID().String())
+
+		setupWorkspaceAuthContext(c, userID, false)
+
+		require.NoError(t, handler.UpdateAnalytics(c))
+		return rec
+	}
+
+	t.Run("admin opts out", func(t *testing.T) {
+		userID := uuid.NewUUID()
+		mockWSService := httphandler.NewMockWorkspaceService()
+		mockMemberService := httphandler.NewMockMemberService()
+
+		ws := createTestWorkspace(t, userID, "Workspace")
+		mockWSService.AddWorkspace(ws, 1)
+		member := workspace.NewMember(userID, ws.ID(), workspace.RoleAdmin)
+		mockMemberService.AddMemberToMock(&member)
+
+		handler := httphandler.NewWorkspaceHandler(mockWSService, mockMemberService)
+		rec := newRequest(t, handler, ws, userID, `{"opt_out": true}`)
+
+		assert.Equal(t, stdhttp.StatusOK, rec.Code)
+
+		var resp struct {
+			Data httphandler.WorkspaceResponse `json:"data"`
+		}
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+		assert.True(t, resp.Data.AnalyticsOptOut)
+		assert.True(t, ws.AnalyticsOptOut())
+	})
+
+	t.Run("forbidden - not admin", func(t *testing.T) {
+		userID := uuid.NewUUID()
+		mockWSService := httphandler.NewMockWorkspaceService()
+		mockMemberService := httphandler.NewMockMemberService()
+
+		ws := createTestWorkspace(t, uuid.NewUUID(), "Workspace")
+		mockWSService.AddWorkspace(ws, 1)
+		member := workspace.NewMember(userID, ws.ID(), workspace.RoleMember)
+		mockMemberService.AddMemberToMock(&member)
+
+		handler := httphandler.NewWorkspaceHandler(mockWSService, mockMemberService)
+		rec := newRequest(t, handler, ws, userID, `{"opt_out": true}`)
+
+		assert.Equal(t, stdhttp.StatusForbidden, rec.Code)
+		assert.False(t, ws.AnalyticsOptOut())
+	})
+}
+
+func TestWorkspaceHandler_UpdateJoinApproval(t *testing.T) {
+	userID := uuid.NewUUID()
+	mockWSService := httphandler.NewMockWorkspaceService()
+	mockMemberService := httphandler.NewMockMemberService()
+
+	ws := createTestWorkspace(t, userID, "Workspace")
+	mockWSService.AddWorkspace(ws, 1)
+	member := workspace.NewMember(userID, ws.ID(), workspace.RoleAdmin)
+	mockMemberService.AddMemberToMock(&member)
+
+	e := echo.New()
+	req := httptest.NewRequest(
+		stdhttp.MethodPut,
+		"/api/v1/workspaces/"+ws.ID().String()+"/join-approval",
+		strings.NewReader(`{"require_approval": true}`),
+	)
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetParamNames("id")
+	c.SetParamValues(ws.ID().String())
+	setupWorkspaceAuthContext(c, userID, false)
+
+	handler := httphandler.NewWorkspaceHandler(mockWSService, mockMemberService)
+	require.NoError(t, handler.UpdateJoinApproval(c))
+
+	assert.Equal(t, stdhttp.StatusOK, rec.Code)
+	var resp struct {
+		Data httphandler.WorkspaceResponse `json:"data"`
+	}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+	assert.True(t, resp.Data.RequireJoinApproval)
+	assert.True(t, ws.RequiresJoinApproval())
+}
+
+func TestWorkspaceHandler_UpdateDiscoverability(t *testing.T) {
+	userID := uuid.NewUUID()
+	mockWSService := httphandler.NewMockWorkspaceService()
+	mockMemberService := httphandler.NewMockMemberService()
+
+	ws := createTestWorkspace(t, userID, "Workspace")
+	mockWSService.AddWorkspace(ws, 1)
+	member := workspace.NewMember(userID, ws.ID(), workspace.RoleAdmin)
+	mockMemberService.AddMemberToMock(&member)
+
+	e := echo.New()
+	req := httptest.NewRequest(
+		stdhttp.MethodPut,
+		"/api/v1/workspaces/"+ws.ID().String()+"/discoverability",
+		strings.NewReader(`{"discoverable": true}`),
+	)
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetParamNames("id")
+	c.SetParamValues(ws.ID().String())
+	setupWorkspaceAuthContext(c, userID, false)
+
+	handler := httphandler.NewWorkspaceHandler(mockWSService, mockMemberService)
+	require.NoError(t, handler.UpdateDiscoverability(c))
+
+	assert.Equal(t, stdhttp.StatusOK, rec.Code)
+	var resp struct {
+		Data httphandler.WorkspaceResponse `json:"data"`
+	}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+	assert.True(t, resp.Data.Discoverable)
+	assert.True(t, ws.IsDiscoverable())
+}
+
+func TestWorkspaceHandler_Discover(t *testing.T) {
+	mockWSService := httphandler.NewMockWorkspaceService()
+	design := createTestWorkspace(t, uuid.NewUUID(), "Design Team")
+	design.SetDiscoverable(true)
+	mockWSService.AddWorkspace(design, 4)
+	hidden := createTestWorkspace(t, uuid.NewUUID(), "Design Secret")
+	mockWSService.AddWorkspace(hidden, 2)
+	other := createTestWorkspace(t, uuid.NewUUID(), "Backend")
+	other.SetDiscoverable(true)
+	mockWSService.AddWorkspace(other, 1)
+
+	e := echo.New()
+	req := httptest.NewRequest(stdhttp.MethodGet, "/api/v1/workspaces/discover?q=design", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	setupWorkspaceAuthContext(c, uuid.NewUUID(), false)
+
+	handler := httphandler.NewWorkspaceHandler(mockWSService, httphandler.NewMockMemberService())
+	require.NoError(t, handler.Discover(c))
+
+	assert.Equal(t, stdhttp.StatusOK, rec.Code)
+	var resp struct {
+		Data httphandler.WorkspaceListResponse `json:"data"`
+	}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+	require.Len(t, resp.Data.Workspaces, 1)
+	assert.Equal(t, design.ID(), resp.Data.Workspaces[0].ID)
+	assert.Equal(t, 4, resp.Data.Workspaces[0].MemberCount)
+	assert.Equal(t, 1, resp.Data.Total)
+}
+
+func TestWorkspaceHandler_Join(t *testing.T) {
+	newContext := func(wsID, userID uuid.UUID) (echo.Context, *httptest.ResponseRecorder) {
+		e := echo.New()
+		req := httptest.NewRequest(stdhttp.MethodPost, "/api/v1/workspaces/"+wsID.String()+"/join", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("id")
+		c.SetParamValues(wsID.String())
+		setupWorkspaceAuthContext(c, userID, false)
+		return c, rec
+	}
+
+	t.Run("creates pending membership", func(t *testing.T) {
+		userID := uuid.NewUUID()
+		wsID := uuid.NewUUID()
+		mockMemberService := httphandler.NewMockMemberService()
+		handler := httphandler.NewWorkspaceHandler(httphandler.NewMockWorkspaceService(), mockMemberService)
+
+		c, rec := newContext(wsID, userID)
+		require.NoError(t, handler.Join(c))
+
+		assert.Equal(t, stdhttp.StatusCreated, rec.Code)
+		var resp struct {
+			Data httphandler.MemberResponse `json:"data"`
+		}
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+		assert.Equal(t, string(workspace.MemberStatusPending), resp.Data.Status)
+	})
+
+	t.Run("conflict when already a member", func(t *testing.T) {
+		userID := uuid.NewUUID()
+		wsID := uuid.NewUUID()
+		mockMemberService := httphandler.NewMockMemberService()
+		member := workspace.NewMember(userID, wsID, workspace.RoleMember)
+		mockMemberService.AddMemberToMock(&member)
+		handler := httphandler.NewWorkspaceHandler(httphandler.NewMockWorkspaceService(), mockMemberService)
+
+		c, rec := newContext(wsID, userID)
+		require.NoError(t, handler.Join(c))
+
+		assert.Equal(t, stdhttp.StatusConflict, rec.Code)
+	})
+}
+
+func TestWorkspaceHandler_ReviewPendingMember(t *testing.T) {
+	newContext := func(wsID, adminID, userID uuid.UUID, action string) (echo.Context, *httptest.ResponseRecorder) {
+		e := echo.New()
+		req := httptest.NewRequest(
+			stdhttp.MethodPost,
+			"/api/v1/workspaces/"+wsID.String()+"/members/"+userID.String()+"/"+action,
+			nil,
+		)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("id", "user_id")
+		c.SetParamValues(wsID.String(), userID.String())
+		setupWorkspaceAuthContext(c, adminID, false)
+		return c, rec
+	}
+
+	setup := func(memberStatus workspace.MemberStatus) (*httphandler.WorkspaceHandler, uuid.UUID, uuid.UUID, uuid.UUID) {
+		adminID := uuid.NewUUID()
+		userID := uuid.NewUUID()
+		wsID := uuid.NewUUID()
+		mockMemberService := httphandler.NewMockMemberService()
+		admin := workspace.NewMember(adminID, wsID, workspace.RoleAdmin)
+		mockMemberService.AddMemberToMock(&admin)
+		member := workspace.ReconstructMember(userID, wsID, workspace.RoleMember, time.Now(), memberStatus)
+		mockMemberService.AddMemberToMock(&member)
+		return httphandler.NewWorkspaceHandler(httphandler.NewMockWorkspaceService(), mockMemberService),
+			wsID, adminID, userID
+	}
+
+	t.Run("approve activates pending member", func(t *testing.T) {
+		handler, wsID, adminID, userID := setup(workspace.MemberStatusPending)
+
+		c, rec := newContext(wsID, adminID, userID, "approve")
+		require.NoError(t, handler.ApproveMember(c))
+
+		assert.Equal(t, stdhttp.StatusOK, rec.Code)
+		var resp struct {
+			Data httphandler.MemberResponse `json:"data"`
+		}
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+		assert.Equal(t, string(workspace.MemberStatusActive), resp.Data.Status)
+	})
+
+	t.Run("approve active member conflicts", func(t *testing.T) {
+		handler, wsID, adminID, userID := setup(workspace.MemberStatusActive)
+
+		c, rec := newContext(wsID, adminID, userID, "approve")
+		require.NoError(t, handler.ApproveMember(c))
+
+		assert.Equal(t, stdhttp.StatusConflict, rec.Code)
+	})
+
+	t.Run("reject removes pending member", func(t *testing.T) {
+		handler, wsID, adminID, userID := setup(workspace.MemberStatusPending)
+
+		c, rec := newContext(wsID, adminID, userID, "reject")
+		require.NoError(t, handler.RejectMember(c))
+
+		assert.Equal(t, stdhttp.StatusNoContent, rec.Code)
+	})
+
+	t.Run("forbidden for non-admin", func(t *testing.T) {
+		handler, wsID, _, userID := setup(workspace.MemberStatusPending)
+
+		c, rec := newContext(wsID, uuid.NewUUID(), userID, "approve")
+		require.NoError(t, handler.ApproveMember(c))
+
+		assert.Equal(t, stdhttp.StatusForbidden, rec.Code)
+	})
+}
+
 func TestWorkspaceHandler_Delete(t *testing.T) {
 	t.Run("successful delete by owner", func(t *testing.T) {
 		e := echo.New()
@@ -1077,6 +1578,18 @@ func TestParseRole(t *testing.T) {
 	}
 }
 
+// decodeListPage decodes a list response into its data and pagination block.
+func decodeListPage[T any](t *testing.T, rec *httptest.ResponseRecorder) (T, *httpserver.Pagination) {
+	t.Helper()
+	var resp struct {
+		Data       T                      `json:"data"`
+		Pagination *httpserver.Pagination `json:"pagination"`
+	}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+	require.NotNil(t, resp.Pagination)
+	return resp.Data, resp.Pagination
+}
+
 func TestParsePagination(t *testing.T) {
 	e := echo.New()
 
@@ -1118,6 +1631,16 @@ func TestParsePagination(t *testing.T) {
 		assert.Equal(t, 0, offset)
 	})
 
+	t.Run("cursor takes precedence over offset", func(t *testing.T) {
+		req := httptest.NewRequest(stdhttp.MethodGet,
+			"/?offset=10&cursor="+httpserver.EncodeOffsetCursor(40), nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+
+		offset, _ := httphandler.ParsePagination(c)
+		assert.Equal(t, 40, offset)
+	})
+
 	t.Run("invalid values use defaults", func(t *testing.T) {
 		req := httptest.NewRequest(stdhttp.MethodGet, "/?offset=abc&limit=xyz", nil)
 		rec := httptest.NewRecorder()
@@ -1239,6 +1762,46 @@ func TestWorkspaceHandler_AddMember_MissingUserID(t *testing.T) {
 	assert.Equal(t, "VALIDATION_ERROR", resp.Error.Code)
 }
 
+func TestWorkspaceHandler_AddMember_FieldErrors(t *testing.T) {
+	e := echo.New()
+	adminID := uuid.NewUUID()
+
+	mockWSService := httphandler.NewMockWorkspaceService()
+	mockMemberService := httphandler.NewMockMemberService()
+
+	ws := createTestWorkspace(t, adminID, "Test Workspace")
+	mockWSService.AddWorkspace(ws, 1)
+
+	adminMember := workspace.NewMember(adminID, ws.ID(), workspace.RoleOwner)
+	mockMemberService.AddMemberToMock(&adminMember)
+
+	handler := httphandler.NewWorkspaceHandler(mockWSService, mockMemberService)
+
+	reqBody := `{"user_id": "not-a-uuid", "role": "owner"}`
+	req := httptest.NewRequest(stdhttp.MethodPost, workspaceMembersURL(ws.ID()), strings.NewReader(reqBody))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetParamNames("id")
+	c.SetParamValues(ws.ID().String())
+
+	setupWorkspaceAuthContext(c, adminID, false)
+
+	err := handler.AddMember(c)
+	require.NoError(t, err)
+	assert.Equal(t, stdhttp.StatusBadRequest, rec.Code)
+
+	var resp httpserver.Response
+	err = json.Unmarshal(rec.Body.Bytes(), &resp)
+	require.NoError(t, err)
+	assert.Equal(t, "VALIDATION_ERROR", resp.Error.Code)
+	assert.Equal(t, "user_id must be a valid UUID", resp.Error.Message)
+	assert.Equal(t, []any{
+		map[string]any{"field": "user_id", "rule": "uuid", "message": "user_id must be a valid UUID"},
+		map[string]any{"field": "role", "rule": "role", "message": "role must be one of: admin, member"},
+	}, resp.Error.Details["fields"])
+}
+
 func TestWorkspaceHandler_AddMember_InvalidJSON(t *testing.T) {
 	e := echo.New()
 	adminID := uuid.NewUUID()
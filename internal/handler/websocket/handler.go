@@ -6,10 +6,12 @@ import (
 	"log/slog"
 	"net/http"
 	"strings"
+	"time"
 
 	"github.com/gorilla/websocket"
 	"github.com/labstack/echo/v4"
 	"github.com/lllypuk/flowra/internal/domain/uuid"
+	"github.com/lllypuk/flowra/internal/infrastructure/httpserver"
 	ws "github.com/lllypuk/flowra/internal/infrastructure/websocket"
 	"github.com/lllypuk/flowra/internal/middleware"
 )
@@ -32,6 +34,7 @@ type Handler struct {
 	hub            *ws.Hub
 	upgrader       websocket.Upgrader
 	tokenValidator TokenValidator
+	userResolver   middleware.UserResolver
 	logger         *slog.Logger
 	clientConfig   ws.ClientConfig
 }
@@ -51,6 +54,10 @@ type HandlerConfig struct {
 	// Logger is the structured logger for the handler.
 	Logger *slog.Logger
 
+	// EnableCompression accepts the permessage-deflate extension during the upgrade.
+	// Clients then opt in to compressed messages with the compression feature of hello.
+	EnableCompression bool
+
 	// ClientConfig is the configuration for WebSocket clients.
 	ClientConfig ws.ClientConfig
 }
@@ -83,6 +90,14 @@ func WithTokenValidator(validator TokenValidator) HandlerOption {
 	}
 }
 
+// WithUserResolver maps the external (Keycloak) user of a query-string token to the
+// internal user ID, so connections are keyed by the same ID that events carry.
+func WithUserResolver(resolver middleware.UserResolver) HandlerOption {
+	return func(h *Handler) {
+		h.userResolver = resolver
+	}
+}
+
 // WithHandlerConfig sets the handler configuration.
 func WithHandlerConfig(config HandlerConfig) HandlerOption {
 	return func(h *Handler) {
@@ -91,6 +106,7 @@ func WithHandlerConfig(config HandlerConfig) HandlerOption {
 		if config.CheckOrigin != nil {
 			h.upgrader.CheckOrigin = config.CheckOrigin
 		}
+		h.upgrader.EnableCompression = config.EnableCompression
 		if config.Logger != nil {
 			h.logger = config.Logger
 		}
@@ -127,18 +143,13 @@ func NewHandler(hub *ws.Hub, opts ...HandlerOption) *Handler {
 // and registers the client with the hub.
 func (h *Handler) HandleWebSocket(c echo.Context) error {
 	// Get user ID from context (set by auth middleware) or validate token
-	userID := h.getUserID(c)
+	userID, expiresAt := h.authenticate(c)
 	if userID.IsZero() {
+		h.hub.RecordAuthFailure()
 		h.logger.Warn("websocket connection rejected: authentication required",
 			slog.String("remote_ip", c.RealIP()),
 		)
-		return c.JSON(http.StatusUnauthorized, map[string]any{
-			"success": false,
-			"error": map[string]string{
-				"code":    "UNAUTHORIZED",
-				"message": "Authentication required",
-			},
-		})
+		return httpserver.RespondErrorWithCode(c, http.StatusUnauthorized, "UNAUTHORIZED", "Authentication required")
 	}
 
 	// Upgrade HTTP connection to WebSocket
@@ -152,13 +163,17 @@ func (h *Handler) HandleWebSocket(c echo.Context) error {
 	}
 
 	// Create client with configuration
-	client := ws.NewClient(
-		h.hub,
-		conn,
-		userID,
+	clientOpts := []ws.ClientOption{
 		ws.WithClientConfig(h.clientConfig),
 		ws.WithClientLogger(h.logger),
-	)
+		ws.WithServerFeatures(h.serverFeatures(c.Request())...),
+		ws.WithClientRequestID(middleware.GetRequestID(c)),
+	}
+	if !expiresAt.IsZero() {
+		// Token-authenticated connections must refresh their token in-band before it expires
+		clientOpts = append(clientOpts, ws.WithTokenAuth(h, expiresAt))
+	}
+	client := ws.NewClient(h.hub, conn, userID, clientOpts...)
 
 	// Register client with hub
 	h.hub.Register(client)
@@ -175,11 +190,36 @@ func (h *Handler) HandleWebSocket(c echo.Context) error {
 	return nil
 }
 
-// getUserID extracts the user ID from the echo context or validates the token.
-func (h *Handler) getUserID(c echo.Context) uuid.UUID {
+// serverFeatures returns the protocol features offered on a connection. Compression
+// is offered only when the permessage-deflate extension was negotiated during the upgrade.
+func (h *Handler) serverFeatures(r *http.Request) []string {
+	features := ws.DefaultServerFeatures()
+	if h.upgrader.EnableCompression && offersDeflate(r) {
+		features = append(features, ws.FeatureCompression)
+	}
+	return features
+}
+
+// offersDeflate reports whether the client offered the permessage-deflate extension.
+func offersDeflate(r *http.Request) bool {
+	for _, header := range r.Header.Values("Sec-Websocket-Extensions") {
+		for extension := range strings.SplitSeq(header, ",") {
+			name, _, _ := strings.Cut(extension, ";")
+			if strings.TrimSpace(name) == "permessage-deflate" {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// authenticate returns the connection's user. Connections authenticated by the auth
+// middleware use its context; otherwise the token from the query parameter or the
+// Authorization header is validated and its expiry is returned as well.
+func (h *Handler) authenticate(c echo.Context) (uuid.UUID, time.Time) {
 	// First, try to get user ID from context (set by auth middleware)
 	if userID := middleware.GetUserID(c); !userID.IsZero() {
-		return userID
+		return userID, time.Time{}
 	}
 
 	// If not in context, try to validate token from query parameter
@@ -196,19 +236,47 @@ func (h *Handler) getUserID(c echo.Context) uuid.UUID {
 	}
 
 	if token == "" || h.tokenValidator == nil {
-		return uuid.UUID("")
+		return uuid.UUID(""), time.Time{}
 	}
 
-	// Validate token
-	claims, err := h.tokenValidator.ValidateToken(c.Request().Context(), token)
+	userID, expiresAt, err := h.AuthenticateToken(c.Request().Context(), token)
 	if err != nil {
 		h.logger.Debug("token validation failed",
 			slog.String("error", err.Error()),
 		)
-		return uuid.UUID("")
+		return uuid.UUID(""), time.Time{}
+	}
+	return userID, expiresAt
+}
+
+// AuthenticateToken validates a token and returns the internal user ID and token expiry.
+// It implements ws.TokenAuthenticator for in-band token refresh.
+func (h *Handler) AuthenticateToken(ctx context.Context, token string) (uuid.UUID, time.Time, error) {
+	if h.tokenValidator == nil {
+		return uuid.UUID(""), time.Time{}, middleware.ErrInvalidToken
+	}
+
+	claims, err := h.tokenValidator.ValidateToken(ctx, token)
+	if err != nil {
+		return uuid.UUID(""), time.Time{}, err
+	}
+
+	userID := claims.UserID
+	if userID.IsZero() && claims.ExternalUserID != "" && h.userResolver != nil {
+		userID, err = h.userResolver.ResolveUser(ctx, claims.ExternalUserID, claims.Username, claims.Email)
+		if err != nil {
+			h.logger.Warn("failed to resolve websocket user",
+				slog.String("external_id", claims.ExternalUserID),
+				slog.String("error", err.Error()),
+			)
+			return uuid.UUID(""), time.Time{}, err
+		}
+	}
+	if userID.IsZero() {
+		return uuid.UUID(""), time.Time{}, middleware.ErrUserNotFound
 	}
 
-	return claims.UserID
+	return userID, claims.ExpiresAt, nil
 }
 
 // RegisterRoutes registers the WebSocket handler with the Echo router.
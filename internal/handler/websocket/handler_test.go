@@ -27,6 +27,17 @@ func (m *mockTokenValidator) ValidateToken(_ context.Context, _ string) (*middle
 	return m.claims, m.err
 }
 
+// stubUserResolver maps every external user to a fixed internal user ID.
+type stubUserResolver struct {
+	userID     uuid.UUID
+	externalID string
+}
+
+func (r *stubUserResolver) ResolveUser(_ context.Context, externalID, _, _ string) (uuid.UUID, error) {
+	r.externalID = externalID
+	return r.userID, nil
+}
+
 func TestNewHandler(t *testing.T) {
 	t.Run("creates handler with defaults", func(t *testing.T) {
 		hub := ws.NewHub()
@@ -201,6 +212,44 @@ func TestHandler_HandleWebSocket(t *testing.T) {
 		conn.Close()
 	})
 
+	t.Run("keys token connections by the internal user ID", func(t *testing.T) {
+		hub := ws.NewHub()
+		ctx := t.Context()
+
+		go hub.Run(ctx)
+		time.Sleep(10 * time.Millisecond)
+
+		internalID := uuid.NewUUID()
+		resolver := &stubUserResolver{userID: internalID}
+		validator := &mockTokenValidator{
+			claims: &middleware.TokenClaims{
+				ExternalUserID: "keycloak-sub",
+				Username:       "testuser",
+			},
+		}
+
+		handler := wshandler.NewHandler(hub,
+			wshandler.WithTokenValidator(validator),
+			wshandler.WithUserResolver(resolver),
+		)
+
+		e := echo.New()
+		e.GET("/ws", handler.HandleWebSocket)
+
+		server := httptest.NewServer(e)
+		defer server.Close()
+
+		wsURL := "ws" + server.URL[4:] + "/ws?token=valid-token"
+		conn, resp, err := websocket.DefaultDialer.Dial(wsURL, nil)
+		require.NoError(t, err)
+		defer conn.Close()
+		assert.Equal(t, http.StatusSwitchingProtocols, resp.StatusCode)
+
+		assert.Eventually(t, func() bool { return hub.UserConnectionCount(internalID) == 1 },
+			time.Second, 10*time.Millisecond)
+		assert.Equal(t, "keycloak-sub", resolver.externalID)
+	})
+
 	t.Run("rejects request with invalid token", func(t *testing.T) {
 		hub := ws.NewHub()
 		ctx := t.Context()
@@ -230,6 +279,44 @@ func TestHandler_HandleWebSocket(t *testing.T) {
 	})
 }
 
+func TestHandler_AuthenticateToken(t *testing.T) {
+	expiresAt := time.Now().Add(time.Hour)
+
+	t.Run("returns the internal user and token expiry", func(t *testing.T) {
+		resolver := &stubUserResolver{userID: uuid.NewUUID()}
+		handler := wshandler.NewHandler(ws.NewHub(),
+			wshandler.WithTokenValidator(&mockTokenValidator{
+				claims: &middleware.TokenClaims{ExternalUserID: "kc-user", ExpiresAt: expiresAt},
+			}),
+			wshandler.WithUserResolver(resolver),
+		)
+
+		userID, gotExpiry, err := handler.AuthenticateToken(t.Context(), "token")
+
+		require.NoError(t, err)
+		assert.Equal(t, resolver.userID, userID)
+		assert.True(t, expiresAt.Equal(gotExpiry))
+	})
+
+	t.Run("rejects invalid tokens", func(t *testing.T) {
+		handler := wshandler.NewHandler(ws.NewHub(),
+			wshandler.WithTokenValidator(&mockTokenValidator{err: middleware.ErrInvalidToken}),
+		)
+
+		_, _, err := handler.AuthenticateToken(t.Context(), "token")
+
+		require.ErrorIs(t, err, middleware.ErrInvalidToken)
+	})
+
+	t.Run("rejects tokens without a validator", func(t *testing.T) {
+		handler := wshandler.NewHandler(ws.NewHub())
+
+		_, _, err := handler.AuthenticateToken(t.Context(), "token")
+
+		require.ErrorIs(t, err, middleware.ErrInvalidToken)
+	})
+}
+
 func TestHandler_RegisterRoutes(t *testing.T) {
 	t.Run("registers route on echo instance", func(t *testing.T) {
 		hub := ws.NewHub()
@@ -323,3 +410,55 @@ func TestHandler_Integration(t *testing.T) {
 		assert.Equal(t, 0, hub.ClientCount())
 	})
 }
+
+func TestHandler_CompressionFeature(t *testing.T) {
+	negotiate := func(t *testing.T, enableCompression, clientCompression bool) []any {
+		t.Helper()
+
+		hub := ws.NewHub()
+		go hub.Run(t.Context())
+
+		config := wshandler.DefaultHandlerConfig()
+		config.EnableCompression = enableCompression
+		handler := wshandler.NewHandler(hub,
+			wshandler.WithTokenValidator(&mockTokenValidator{
+				claims: &middleware.TokenClaims{UserID: uuid.NewUUID()},
+			}),
+			wshandler.WithHandlerConfig(config),
+		)
+
+		e := echo.New()
+		e.GET("/ws", handler.HandleWebSocket)
+		server := httptest.NewServer(e)
+		defer server.Close()
+
+		dialer := websocket.Dialer{EnableCompression: clientCompression}
+		conn, _, err := dialer.Dial("ws"+server.URL[4:]+"/ws?token=valid-token", nil)
+		require.NoError(t, err)
+		defer conn.Close()
+
+		require.NoError(t, conn.WriteJSON(map[string]any{
+			"type":     "hello",
+			"version":  ws.ProtocolVersion,
+			"features": []string{ws.FeatureCompression, ws.FeaturePresence},
+		}))
+
+		var response map[string]any
+		require.NoError(t, conn.ReadJSON(&response))
+		require.Equal(t, "hello", response["action"])
+		features, _ := response["features"].([]any)
+		return features
+	}
+
+	t.Run("offers compression once negotiated during the upgrade", func(t *testing.T) {
+		assert.Equal(t, []any{ws.FeatureCompression, ws.FeaturePresence}, negotiate(t, true, true))
+	})
+
+	t.Run("does not offer compression when disabled", func(t *testing.T) {
+		assert.Equal(t, []any{ws.FeaturePresence}, negotiate(t, false, true))
+	})
+
+	t.Run("does not offer compression the client did not negotiate", func(t *testing.T) {
+		assert.Equal(t, []any{ws.FeaturePresence}, negotiate(t, true, false))
+	})
+}
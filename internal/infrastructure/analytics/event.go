@@ -0,0 +1,56 @@
+// Package analytics provides the product analytics pipeline: domain events are
+// mapped to anonymized analytics events and delivered to a pluggable sink.
+package analytics
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"time"
+)
+
+// Event is an anonymized product analytics event.
+// It never carries message content, titles or raw identifiers.
+type Event struct {
+	Name          string         `json:"event"`
+	WorkspaceHash string         `json:"workspace"`
+	UserHash      string         `json:"user,omitempty"`
+	Properties    map[string]any `json:"properties,omitempty"`
+	Timestamp     time.Time      `json:"timestamp"`
+}
+
+// DistinctID returns the identity the event is attributed to:
+// the user when known, otherwise the workspace.
+func (e Event) DistinctID() string {
+	if e.UserHash != "" {
+		return e.UserHash
+	}
+	return e.WorkspaceHash
+}
+
+// Sink delivers analytics events to a destination.
+type Sink interface {
+	Send(ctx context.Context, evt Event) error
+}
+
+// Hasher pseudonymizes identifiers with a keyed HMAC so that hashes are
+// stable across events but cannot be reversed without the salt.
+type Hasher struct {
+	key []byte
+}
+
+// NewHasher creates a hasher keyed with the given salt.
+func NewHasher(salt string) *Hasher {
+	return &Hasher{key: []byte(salt)}
+}
+
+// Hash returns the hex-encoded HMAC-SHA256 of id, or an empty string for an empty id.
+func (h *Hasher) Hash(id string) string {
+	if id == "" {
+		return ""
+	}
+	mac := hmac.New(sha256.New, h.key)
+	mac.Write([]byte(id))
+	return hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,279 @@
+package analytics
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"sync"
+
+	"github.com/lllypuk/flowra/internal/domain/chat"
+	"github.com/lllypuk/flowra/internal/domain/event"
+	"github.com/lllypuk/flowra/internal/domain/message"
+	"github.com/lllypuk/flowra/internal/domain/uuid"
+	"github.com/lllypuk/flowra/internal/domain/workspace"
+	"github.com/lllypuk/flowra/internal/infrastructure/eventbus"
+)
+
+// Analytics event names, following the "Object Action" convention.
+const (
+	EventWorkspaceCreated  = "Workspace Created"
+	EventMemberAdded       = "Member Added"
+	EventChatCreated       = "Chat Created"
+	EventChatTypeChanged   = "Chat Type Changed"
+	EventTaskStatusChanged = "Task Status Changed"
+	EventChatClosed        = "Chat Closed"
+	EventMessageSent       = "Message Sent"
+)
+
+// chatWorkspaceCacheLimit bounds the chat-to-workspace cache; the mapping never changes,
+// so the cache is simply reset when full.
+const chatWorkspaceCacheLimit = 10000
+
+// eventNames maps the supported domain event types to analytics event names.
+var eventNames = map[string]string{
+	workspace.EventTypeWorkspaceCreated: EventWorkspaceCreated,
+	workspace.EventTypeMemberAdded:      EventMemberAdded,
+	chat.EventTypeChatCreated:           EventChatCreated,
+	chat.EventTypeChatTypeChanged:       EventChatTypeChanged,
+	chat.EventTypeStatusChanged:         EventTaskStatusChanged,
+	chat.EventTypeChatClosed:            EventChatClosed,
+	message.EventTypeMessageCreated:     EventMessageSent,
+}
+
+// EventTypes returns the domain event types the handler can map.
+func EventTypes() []string {
+	return []string{
+		workspace.EventTypeWorkspaceCreated,
+		workspace.EventTypeMemberAdded,
+		chat.EventTypeChatCreated,
+		chat.EventTypeChatTypeChanged,
+		chat.EventTypeStatusChanged,
+		chat.EventTypeChatClosed,
+		message.EventTypeMessageCreated,
+	}
+}
+
+// ChatWorkspaceResolver finds the workspace a chat belongs to.
+// Declared on the consumer side per project guidelines.
+type ChatWorkspaceResolver interface {
+	ChatWorkspaceID(ctx context.Context, chatID uuid.UUID) (uuid.UUID, error)
+}
+
+// OptOutChecker reports whether a workspace opted out of product analytics.
+// Declared on the consumer side per project guidelines.
+type OptOutChecker interface {
+	AnalyticsOptedOut(ctx context.Context, workspaceID uuid.UUID) (bool, error)
+}
+
+// Handler maps domain events to anonymized analytics events and sends them to a sink.
+// Delivery is best effort: failures are logged and never returned, so analytics
+// can't push events into the dead letter queue.
+type Handler struct {
+	sink    Sink
+	hasher  *Hasher
+	chats   ChatWorkspaceResolver
+	optOuts OptOutChecker
+	allowed map[string]struct{}
+	logger  *slog.Logger
+
+	mu             sync.Mutex
+	chatWorkspaces map[uuid.UUID]uuid.UUID
+}
+
+// NewHandler creates an analytics handler. events restricts the mapped domain
+// event types; an empty list allows all of EventTypes.
+func NewHandler(
+	sink Sink,
+	hasher *Hasher,
+	chats ChatWorkspaceResolver,
+	optOuts OptOutChecker,
+	events []string,
+	logger *slog.Logger,
+) *Handler {
+	if logger == nil {
+		logger = slog.Default()
+	}
+
+	var allowed map[string]struct{}
+	if len(events) > 0 {
+		allowed = make(map[string]struct{}, len(events))
+		for _, eventType := range events {
+			allowed[eventType] = struct{}{}
+		}
+	}
+
+	return &Handler{
+		sink:           sink,
+		hasher:         hasher,
+		chats:          chats,
+		optOuts:        optOuts,
+		allowed:        allowed,
+		logger:         logger,
+		chatWorkspaces: make(map[uuid.UUID]uuid.UUID),
+	}
+}
+
+// Handle maps and delivers a single domain event.
+func (h *Handler) Handle(ctx context.Context, evt event.DomainEvent) error {
+	name, ok := eventNames[evt.EventType()]
+	if !ok {
+		return nil
+	}
+	if h.allowed != nil {
+		if _, allowed := h.allowed[evt.EventType()]; !allowed {
+			return nil
+		}
+	}
+
+	payload, err := decodePayload(evt)
+	if err != nil {
+		h.logger.DebugContext(ctx, "analytics: skipping undecodable event",
+			slog.String("event_type", evt.EventType()),
+			slog.String("error", err.Error()),
+		)
+		return nil
+	}
+
+	workspaceID, err := h.workspaceOf(ctx, evt, payload)
+	if err != nil || workspaceID.IsZero() {
+		h.logger.DebugContext(ctx, "analytics: workspace not resolved",
+			slog.String("event_type", evt.EventType()),
+			slog.String("aggregate_id", evt.AggregateID()),
+		)
+		return nil
+	}
+
+	if h.optOuts != nil {
+		optedOut, optErr := h.optOuts.AnalyticsOptedOut(ctx, workspaceID)
+		if optErr != nil || optedOut {
+			return nil
+		}
+	}
+
+	analyticsEvent := Event{
+		Name:          name,
+		WorkspaceHash: h.hasher.Hash(workspaceID.String()),
+		UserHash:      h.hasher.Hash(actorOf(evt, payload)),
+		Properties:    properties(evt.EventType(), payload),
+		Timestamp:     evt.OccurredAt().UTC(),
+	}
+	if sendErr := h.sink.Send(ctx, analyticsEvent); sendErr != nil {
+		h.logger.WarnContext(ctx, "analytics: failed to send event",
+			slog.String("event", name),
+			slog.String("error", sendErr.Error()),
+		)
+	}
+	return nil
+}
+
+// workspaceOf resolves the workspace of the event from its aggregate or payload.
+func (h *Handler) workspaceOf(ctx context.Context, evt event.DomainEvent, p payload) (uuid.UUID, error) {
+	switch evt.EventType() {
+	case workspace.EventTypeWorkspaceCreated, workspace.EventTypeMemberAdded:
+		return uuid.UUID(evt.AggregateID()), nil
+	case chat.EventTypeChatCreated:
+		return uuid.UUID(p.str("workspace_id")), nil
+	case message.EventTypeMessageCreated:
+		return h.chatWorkspace(ctx, uuid.UUID(p.str("ChatID", "chat_id")))
+	default:
+		return h.chatWorkspace(ctx, uuid.UUID(evt.AggregateID()))
+	}
+}
+
+func (h *Handler) chatWorkspace(ctx context.Context, chatID uuid.UUID) (uuid.UUID, error) {
+	if chatID.IsZero() || h.chats == nil {
+		return "", nil
+	}
+
+	h.mu.Lock()
+	workspaceID, ok := h.chatWorkspaces[chatID]
+	h.mu.Unlock()
+	if ok {
+		return workspaceID, nil
+	}
+
+	workspaceID, err := h.chats.ChatWorkspaceID(ctx, chatID)
+	if err != nil {
+		return "", err
+	}
+
+	h.mu.Lock()
+	if len(h.chatWorkspaces) >= chatWorkspaceCacheLimit {
+		clear(h.chatWorkspaces)
+	}
+	h.chatWorkspaces[chatID] = workspaceID
+	h.mu.Unlock()
+
+	return workspaceID, nil
+}
+
+// actorOf returns the user who caused the event, preferring event metadata.
+func actorOf(evt event.DomainEvent, p payload) string {
+	if userID := evt.Metadata().UserID; userID != "" {
+		return userID
+	}
+	return p.str("created_by", "changed_by", "closed_by", "CreatedBy", "AuthorID", "author_id")
+}
+
+// properties extracts the non-identifying properties of an event.
+// Titles, names and message content are deliberately never copied.
+func properties(eventType string, p payload) map[string]any {
+	switch eventType {
+	case workspace.EventTypeMemberAdded:
+		return map[string]any{"role": p.str("Role")}
+	case chat.EventTypeChatCreated:
+		return map[string]any{"chat_type": p.str("type"), "is_public": p.bool("is_public")}
+	case chat.EventTypeChatTypeChanged:
+		return map[string]any{"from_type": p.str("old_type"), "to_type": p.str("new_type")}
+	case chat.EventTypeStatusChanged:
+		return map[string]any{"from_status": p.str("old_status"), "to_status": p.str("new_status")}
+	case message.EventTypeMessageCreated:
+		return map[string]any{
+			"is_reply": !uuid.UUID(p.str("ParentMessageID", "parent_message_id")).IsZero(),
+			"is_quote": !uuid.UUID(p.str("QuotedMessageID", "quoted_message_id")).IsZero(),
+		}
+	default:
+		return nil
+	}
+}
+
+// payload is the decoded JSON body of a domain event.
+type payload map[string]any
+
+// str returns the first non-empty string among keys. Some events expose a
+// snake_case Payload() in-process while the bus carries their marshaled fields.
+func (p payload) str(keys ...string) string {
+	for _, key := range keys {
+		if v, ok := p[key].(string); ok && v != "" {
+			return v
+		}
+	}
+	return ""
+}
+
+func (p payload) bool(key string) bool {
+	v, _ := p[key].(bool)
+	return v
+}
+
+// decodePayload reads the event body from the raw bus payload or, for
+// in-process events, by marshaling the event itself.
+func decodePayload(evt event.DomainEvent) (payload, error) {
+	var data json.RawMessage
+	if pe, ok := evt.(eventbus.PayloadEvent); ok {
+		data = pe.Payload()
+	} else {
+		marshaled, err := json.Marshal(evt)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal event: %w", err)
+		}
+		data = marshaled
+	}
+
+	var p payload
+	if err := json.Unmarshal(data, &p); err != nil {
+		return nil, fmt.Errorf("failed to decode event payload: %w", err)
+	}
+	return p, nil
+}
@@ -0,0 +1,132 @@
+package analytics_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/lllypuk/flowra/internal/domain/chat"
+	"github.com/lllypuk/flowra/internal/domain/event"
+	"github.com/lllypuk/flowra/internal/domain/message"
+	"github.com/lllypuk/flowra/internal/domain/uuid"
+	"github.com/lllypuk/flowra/internal/domain/workspace"
+	"github.com/lllypuk/flowra/internal/infrastructure/analytics"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type recordingSink struct {
+	events []analytics.Event
+	err    error
+}
+
+func (s *recordingSink) Send(_ context.Context, evt analytics.Event) error {
+	s.events = append(s.events, evt)
+	return s.err
+}
+
+type stubChatResolver struct {
+	workspaces map[uuid.UUID]uuid.UUID
+	calls      int
+}
+
+func (s *stubChatResolver) ChatWorkspaceID(_ context.Context, chatID uuid.UUID) (uuid.UUID, error) {
+	s.calls++
+	workspaceID, ok := s.workspaces[chatID]
+	if !ok {
+		return "", errors.New("chat not found")
+	}
+	return workspaceID, nil
+}
+
+type stubOptOuts struct {
+	optedOut map[uuid.UUID]bool
+}
+
+func (s *stubOptOuts) AnalyticsOptedOut(_ context.Context, workspaceID uuid.UUID) (bool, error) {
+	return s.optedOut[workspaceID], nil
+}
+
+func TestHandler_Handle(t *testing.T) {
+	hasher := analytics.NewHasher("salt")
+	workspaceID := uuid.NewUUID()
+	chatID := uuid.NewUUID()
+	userID := uuid.NewUUID()
+	metadata := event.NewMetadata(userID.String(), "", "")
+
+	t.Run("maps chat created without identifiers", func(t *testing.T) {
+		sink := &recordingSink{}
+		h := analytics.NewHandler(sink, hasher, nil, &stubOptOuts{}, nil, nil)
+
+		evt := chat.NewChatCreated(chatID, workspaceID, chat.TypeTask, true, userID, time.Now(), metadata)
+		require.NoError(t, h.Handle(context.Background(), evt))
+
+		require.Len(t, sink.events, 1)
+		got := sink.events[0]
+		assert.Equal(t, analytics.EventChatCreated, got.Name)
+		assert.Equal(t, hasher.Hash(workspaceID.String()), got.WorkspaceHash)
+		assert.Equal(t, hasher.Hash(userID.String()), got.UserHash)
+		assert.Equal(t, map[string]any{"chat_type": "task", "is_public": true}, got.Properties)
+	})
+
+	t.Run("resolves workspace of message through its chat", func(t *testing.T) {
+		sink := &recordingSink{}
+		chats := &stubChatResolver{workspaces: map[uuid.UUID]uuid.UUID{chatID: workspaceID}}
+		h := analytics.NewHandler(sink, hasher, chats, nil, nil, nil)
+
+		for range 2 {
+			evt := message.NewCreated(uuid.NewUUID(), chatID, userID, "secret content", uuid.NewUUID(), metadata)
+			require.NoError(t, h.Handle(context.Background(), evt))
+		}
+
+		require.Len(t, sink.events, 2)
+		assert.Equal(t, analytics.EventMessageSent, sink.events[0].Name)
+		assert.Equal(t, hasher.Hash(workspaceID.String()), sink.events[0].WorkspaceHash)
+		assert.Equal(t, map[string]any{"is_reply": true, "is_quote": false}, sink.events[0].Properties)
+		assert.Equal(t, 1, chats.calls, "chat workspace is cached")
+	})
+
+	t.Run("skips opted out workspaces", func(t *testing.T) {
+		sink := &recordingSink{}
+		optOuts := &stubOptOuts{optedOut: map[uuid.UUID]bool{workspaceID: true}}
+		h := analytics.NewHandler(sink, hasher, nil, optOuts, nil, nil)
+
+		evt := workspace.NewMemberAdded(workspaceID, userID, workspace.RoleMember, metadata)
+		require.NoError(t, h.Handle(context.Background(), evt))
+
+		assert.Empty(t, sink.events)
+	})
+
+	t.Run("respects the event allow-list", func(t *testing.T) {
+		sink := &recordingSink{}
+		h := analytics.NewHandler(sink, hasher, nil, nil, []string{workspace.EventTypeMemberAdded}, nil)
+
+		require.NoError(t, h.Handle(context.Background(),
+			chat.NewChatCreated(chatID, workspaceID, chat.TypeTask, false, userID, time.Now(), metadata)))
+		require.NoError(t, h.Handle(context.Background(),
+			workspace.NewMemberAdded(workspaceID, userID, workspace.RoleAdmin, metadata)))
+
+		require.Len(t, sink.events, 1)
+		assert.Equal(t, map[string]any{"role": "admin"}, sink.events[0].Properties)
+	})
+
+	t.Run("drops events of unknown chats", func(t *testing.T) {
+		sink := &recordingSink{}
+		h := analytics.NewHandler(sink, hasher, &stubChatResolver{}, nil, nil, nil)
+
+		evt := chat.NewStatusChanged(uuid.NewUUID(), "To Do", "Done", userID, 2, metadata)
+		require.NoError(t, h.Handle(context.Background(), evt))
+
+		assert.Empty(t, sink.events)
+	})
+
+	t.Run("sink failures are not returned", func(t *testing.T) {
+		sink := &recordingSink{err: errors.New("down")}
+		h := analytics.NewHandler(sink, hasher, nil, nil, nil, nil)
+
+		evt := workspace.NewMemberAdded(workspaceID, userID, workspace.RoleMember, metadata)
+		require.NoError(t, h.Handle(context.Background(), evt))
+		assert.Len(t, sink.events, 1)
+	})
+}
@@ -0,0 +1,208 @@
+package analytics
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Supported sink kinds.
+const (
+	SinkStdout  = "stdout"
+	SinkSegment = "segment"
+	SinkPostHog = "posthog"
+)
+
+// Default sink settings.
+const (
+	DefaultSegmentEndpoint = "https://api.segment.io"
+	DefaultTimeout         = 5 * time.Second
+)
+
+// Sink errors.
+var (
+	ErrUnknownSink = errors.New("unknown analytics sink")
+	ErrDelivery    = errors.New("analytics delivery failed")
+)
+
+// SinkConfig selects and configures a sink.
+type SinkConfig struct {
+	Kind            string
+	Timeout         time.Duration
+	SegmentWriteKey string
+	SegmentEndpoint string
+	PostHogAPIKey   string
+	PostHogHost     string
+}
+
+// NewSink builds the sink described by cfg.
+func NewSink(cfg SinkConfig) (Sink, error) {
+	timeout := cfg.Timeout
+	if timeout <= 0 {
+		timeout = DefaultTimeout
+	}
+	client := &http.Client{Timeout: timeout}
+
+	switch cfg.Kind {
+	case SinkStdout:
+		return NewStdoutSink(os.Stdout), nil
+	case SinkSegment:
+		return NewSegmentSink(client, cfg.SegmentEndpoint, cfg.SegmentWriteKey), nil
+	case SinkPostHog:
+		return NewPostHogSink(client, cfg.PostHogHost, cfg.PostHogAPIKey), nil
+	default:
+		return nil, fmt.Errorf("%w: %q", ErrUnknownSink, cfg.Kind)
+	}
+}
+
+// StdoutSink writes events as JSON lines. Useful for development and for
+// shipping events through a log collector.
+type StdoutSink struct {
+	mu  sync.Mutex
+	enc *json.Encoder
+}
+
+// NewStdoutSink creates a sink writing JSON lines to w.
+func NewStdoutSink(w io.Writer) *StdoutSink {
+	return &StdoutSink{enc: json.NewEncoder(w)}
+}
+
+// Send writes the event as a single JSON line.
+func (s *StdoutSink) Send(_ context.Context, evt Event) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.enc.Encode(evt)
+}
+
+// SegmentSink sends events to the Segment HTTP tracking API.
+type SegmentSink struct {
+	client   *http.Client
+	endpoint string
+	writeKey string
+}
+
+// NewSegmentSink creates a Segment sink. An empty endpoint uses the default Segment API.
+func NewSegmentSink(client *http.Client, endpoint, writeKey string) *SegmentSink {
+	if endpoint == "" {
+		endpoint = DefaultSegmentEndpoint
+	}
+	return &SegmentSink{
+		client:   client,
+		endpoint: strings.TrimSuffix(endpoint, "/"),
+		writeKey: writeKey,
+	}
+}
+
+type segmentTrack struct {
+	Event       string         `json:"event"`
+	UserID      string         `json:"userId,omitempty"`
+	AnonymousID string         `json:"anonymousId,omitempty"`
+	Properties  map[string]any `json:"properties,omitempty"`
+	Context     segmentContext `json:"context"`
+	Timestamp   time.Time      `json:"timestamp"`
+}
+
+type segmentContext struct {
+	GroupID string `json:"groupId"`
+}
+
+// Send posts the event to /v1/track. Events without a user are tracked anonymously per workspace.
+func (s *SegmentSink) Send(ctx context.Context, evt Event) error {
+	body := segmentTrack{
+		Event:      evt.Name,
+		UserID:     evt.UserHash,
+		Properties: evt.Properties,
+		Context:    segmentContext{GroupID: evt.WorkspaceHash},
+		Timestamp:  evt.Timestamp,
+	}
+	if body.UserID == "" {
+		body.AnonymousID = evt.WorkspaceHash
+	}
+
+	req, err := newJSONRequest(ctx, s.endpoint+"/v1/track", body)
+	if err != nil {
+		return err
+	}
+	req.SetBasicAuth(s.writeKey, "")
+	return send(s.client, req)
+}
+
+// PostHogSink sends events to the PostHog capture API.
+type PostHogSink struct {
+	client *http.Client
+	host   string
+	apiKey string
+}
+
+// NewPostHogSink creates a PostHog sink.
+func NewPostHogSink(client *http.Client, host, apiKey string) *PostHogSink {
+	return &PostHogSink{
+		client: client,
+		host:   strings.TrimSuffix(host, "/"),
+		apiKey: apiKey,
+	}
+}
+
+type postHogCapture struct {
+	APIKey     string         `json:"api_key"`
+	Event      string         `json:"event"`
+	DistinctID string         `json:"distinct_id"`
+	Properties map[string]any `json:"properties"`
+	Timestamp  time.Time      `json:"timestamp"`
+}
+
+// Send posts the event to /capture/ with the workspace as a PostHog group.
+func (s *PostHogSink) Send(ctx context.Context, evt Event) error {
+	properties := make(map[string]any, len(evt.Properties)+1)
+	for k, v := range evt.Properties {
+		properties[k] = v
+	}
+	properties["$groups"] = map[string]string{"workspace": evt.WorkspaceHash}
+
+	req, err := newJSONRequest(ctx, s.host+"/capture/", postHogCapture{
+		APIKey:     s.apiKey,
+		Event:      evt.Name,
+		DistinctID: evt.DistinctID(),
+		Properties: properties,
+		Timestamp:  evt.Timestamp,
+	})
+	if err != nil {
+		return err
+	}
+	return send(s.client, req)
+}
+
+func newJSONRequest(ctx context.Context, url string, body any) (*http.Request, error) {
+	data, err := json.Marshal(body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal analytics event: %w", err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("failed to build analytics request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	return req, nil
+}
+
+func send(client *http.Client, req *http.Request) error {
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrDelivery, err)
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("%w: %s responded %d", ErrDelivery, req.URL.Host, resp.StatusCode)
+	}
+	return nil
+}
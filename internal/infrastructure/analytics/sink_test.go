@@ -0,0 +1,113 @@
+package analytics_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/lllypuk/flowra/internal/infrastructure/analytics"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testEvent() analytics.Event {
+	return analytics.Event{
+		Name:          analytics.EventChatCreated,
+		WorkspaceHash: "ws-hash",
+		UserHash:      "user-hash",
+		Properties:    map[string]any{"chat_type": "task"},
+		Timestamp:     time.Date(2026, time.March, 2, 10, 0, 0, 0, time.UTC),
+	}
+}
+
+func TestHasher_Hash(t *testing.T) {
+	hasher := analytics.NewHasher("salt")
+
+	assert.Equal(t, hasher.Hash("id"), hasher.Hash("id"))
+	assert.Len(t, hasher.Hash("id"), 64)
+	assert.NotEqual(t, hasher.Hash("id"), analytics.NewHasher("other").Hash("id"))
+	assert.NotContains(t, hasher.Hash("id"), "id")
+	assert.Empty(t, hasher.Hash(""))
+}
+
+func TestStdoutSink_Send(t *testing.T) {
+	var buf bytes.Buffer
+	sink := analytics.NewStdoutSink(&buf)
+
+	require.NoError(t, sink.Send(context.Background(), testEvent()))
+
+	var got map[string]any
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &got))
+	assert.Equal(t, analytics.EventChatCreated, got["event"])
+	assert.Equal(t, "ws-hash", got["workspace"])
+	assert.Equal(t, "user-hash", got["user"])
+}
+
+func TestSegmentSink_Send(t *testing.T) {
+	var body map[string]any
+	var user string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/track", r.URL.Path)
+		user, _, _ = r.BasicAuth()
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	sink := analytics.NewSegmentSink(server.Client(), server.URL, "write-key")
+	evt := testEvent()
+	evt.UserHash = ""
+
+	require.NoError(t, sink.Send(context.Background(), evt))
+	assert.Equal(t, "write-key", user)
+	assert.Equal(t, analytics.EventChatCreated, body["event"])
+	assert.Equal(t, "ws-hash", body["anonymousId"])
+	assert.NotContains(t, body, "userId")
+	assert.Equal(t, map[string]any{"groupId": "ws-hash"}, body["context"])
+}
+
+func TestPostHogSink_Send(t *testing.T) {
+	var body map[string]any
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/capture/", r.URL.Path)
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	sink := analytics.NewPostHogSink(server.Client(), server.URL+"/", "api-key")
+
+	require.NoError(t, sink.Send(context.Background(), testEvent()))
+	assert.Equal(t, "api-key", body["api_key"])
+	assert.Equal(t, "user-hash", body["distinct_id"])
+	props, ok := body["properties"].(map[string]any)
+	require.True(t, ok)
+	assert.Equal(t, "task", props["chat_type"])
+	assert.Equal(t, map[string]any{"workspace": "ws-hash"}, props["$groups"])
+}
+
+func TestHTTPSink_ErrorStatus(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+	}))
+	defer server.Close()
+
+	sink := analytics.NewPostHogSink(server.Client(), server.URL, "api-key")
+
+	require.ErrorIs(t, sink.Send(context.Background(), testEvent()), analytics.ErrDelivery)
+}
+
+func TestNewSink(t *testing.T) {
+	for _, kind := range []string{analytics.SinkStdout, analytics.SinkSegment, analytics.SinkPostHog} {
+		sink, err := analytics.NewSink(analytics.SinkConfig{Kind: kind})
+		require.NoError(t, err)
+		assert.NotNil(t, sink)
+	}
+
+	_, err := analytics.NewSink(analytics.SinkConfig{Kind: "mixpanel"})
+	require.ErrorIs(t, err, analytics.ErrUnknownSink)
+}
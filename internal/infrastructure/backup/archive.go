@@ -0,0 +1,188 @@
+package backup
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+// Archive format: a gzip-compressed stream of JSON lines. The first line is the
+// Header, every following line a record holding one document in canonical
+// extended JSON, and the last line a trailer with the record count, so that a
+// truncated archive is detected.
+const (
+	ArchiveFormat  = "flowra-workspace-backup"
+	ArchiveVersion = 1
+
+	// CollectionAttachments holds the attachments manifest. File contents are not
+	// part of the archive.
+	CollectionAttachments = "attachments"
+
+	trailerCollection = "$end"
+)
+
+// Archive errors.
+var (
+	ErrInvalidArchive   = errors.New("not a workspace backup archive")
+	ErrTruncatedArchive = errors.New("workspace backup archive is truncated")
+)
+
+// Header describes an archive.
+type Header struct {
+	Format      string    `json:"format"`
+	Version     int       `json:"version"`
+	WorkspaceID string    `json:"workspace_id"`
+	ExportedAt  time.Time `json:"exported_at"`
+}
+
+// record is one line of an archive.
+type record struct {
+	Collection string          `json:"collection"`
+	Document   json.RawMessage `json:"document,omitempty"`
+	Records    int             `json:"records,omitempty"`
+}
+
+// AttachmentEntry is an entry of the attachments manifest.
+type AttachmentEntry struct {
+	FileID   string `bson:"file_id"   json:"file_id"`
+	FileName string `bson:"file_name" json:"file_name"`
+	FileSize int64  `bson:"file_size" json:"file_size"`
+	MimeType string `bson:"mime_type" json:"mime_type"`
+
+	// Present tells whether the file was found in storage at export time.
+	Present bool `bson:"present" json:"present"`
+}
+
+// ArchiveWriter writes an archive.
+type ArchiveWriter struct {
+	gz      *gzip.Writer
+	enc     *json.Encoder
+	records int
+}
+
+// NewArchiveWriter starts an archive with the header.
+func NewArchiveWriter(w io.Writer, header Header) (*ArchiveWriter, error) {
+	gz := gzip.NewWriter(w)
+	aw := &ArchiveWriter{gz: gz, enc: json.NewEncoder(gz)}
+	header.Format = ArchiveFormat
+	header.Version = ArchiveVersion
+	if err := aw.enc.Encode(header); err != nil {
+		return nil, fmt.Errorf("failed to write archive header: %w", err)
+	}
+	return aw, nil
+}
+
+// Write appends a document of the collection.
+func (w *ArchiveWriter) Write(collection string, document any) error {
+	data, err := bson.MarshalExtJSON(document, true, false)
+	if err != nil {
+		return fmt.Errorf("failed to encode %s document: %w", collection, err)
+	}
+	if err = w.enc.Encode(record{Collection: collection, Document: data}); err != nil {
+		return fmt.Errorf("failed to write archive record: %w", err)
+	}
+	w.records++
+	return nil
+}
+
+// Close writes the trailer and flushes the archive. It does not close the
+// underlying writer.
+func (w *ArchiveWriter) Close() error {
+	if err := w.enc.Encode(record{Collection: trailerCollection, Records: w.records}); err != nil {
+		return fmt.Errorf("failed to write archive trailer: %w", err)
+	}
+	return w.gz.Close()
+}
+
+// ArchiveReader reads an archive.
+type ArchiveReader struct {
+	gz      *gzip.Reader
+	lines   *bufio.Reader
+	records int
+	done    bool
+}
+
+// NewArchiveReader opens an archive and reads its header.
+func NewArchiveReader(r io.Reader) (*ArchiveReader, Header, error) {
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, Header{}, fmt.Errorf("%w: %w", ErrInvalidArchive, err)
+	}
+	ar := &ArchiveReader{gz: gz, lines: bufio.NewReader(gz)}
+
+	line, err := ar.readLine()
+	if err != nil {
+		return nil, Header{}, fmt.Errorf("%w: %w", ErrInvalidArchive, err)
+	}
+	var header Header
+	if err = json.Unmarshal(line, &header); err != nil || header.Format != ArchiveFormat {
+		return nil, Header{}, ErrInvalidArchive
+	}
+	if header.Version != ArchiveVersion {
+		return nil, Header{}, fmt.Errorf("%w: unsupported version %d", ErrInvalidArchive, header.Version)
+	}
+	return ar, header, nil
+}
+
+// Next returns the next document and its collection. It returns io.EOF after
+// the trailer and ErrTruncatedArchive if the archive ends without one.
+func (r *ArchiveReader) Next() (string, bson.D, error) {
+	if r.done {
+		return "", nil, io.EOF
+	}
+
+	line, err := r.readLine()
+	if errors.Is(err, io.EOF) {
+		return "", nil, ErrTruncatedArchive
+	}
+	if err != nil {
+		return "", nil, err
+	}
+
+	var rec record
+	if err = json.Unmarshal(line, &rec); err != nil {
+		return "", nil, fmt.Errorf("%w: %w", ErrInvalidArchive, err)
+	}
+	if rec.Collection == trailerCollection {
+		if rec.Records != r.records {
+			return "", nil, ErrTruncatedArchive
+		}
+		r.done = true
+		return "", nil, io.EOF
+	}
+
+	var doc bson.D
+	if err = bson.UnmarshalExtJSON(rec.Document, true, &doc); err != nil {
+		return "", nil, fmt.Errorf("failed to decode %s document: %w", rec.Collection, err)
+	}
+	r.records++
+	return rec.Collection, doc, nil
+}
+
+// readLine reads one line of any length. A final line without newline is
+// returned as is; an empty stream returns io.EOF.
+func (r *ArchiveReader) readLine() ([]byte, error) {
+	line, err := r.lines.ReadBytes('\n')
+	if errors.Is(err, io.EOF) && len(bytes.TrimSpace(line)) > 0 {
+		return line, nil
+	}
+	if err != nil {
+		if errors.Is(err, io.ErrUnexpectedEOF) {
+			return nil, ErrTruncatedArchive
+		}
+		return nil, err
+	}
+	return line, nil
+}
+
+// Close releases the reader. It does not close the underlying reader.
+func (r *ArchiveReader) Close() error {
+	return r.gz.Close()
+}
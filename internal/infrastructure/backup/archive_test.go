@@ -0,0 +1,63 @@
+package backup_test
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/v2/bson"
+
+	"github.com/lllypuk/flowra/internal/infrastructure/backup"
+)
+
+func TestArchive_RoundTrip(t *testing.T) {
+	var buf bytes.Buffer
+	w, err := backup.NewArchiveWriter(&buf, backup.Header{WorkspaceID: "ws-1"})
+	require.NoError(t, err)
+	require.NoError(t, w.Write("workspaces", bson.D{{Key: "workspace_id", Value: "ws-1"}, {Key: "size", Value: int64(42)}}))
+	require.NoError(t, w.Write(backup.CollectionAttachments, backup.AttachmentEntry{FileID: "f-1", Present: true}))
+	require.NoError(t, w.Close())
+
+	r, header, err := backup.NewArchiveReader(&buf)
+	require.NoError(t, err)
+	assert.Equal(t, backup.ArchiveFormat, header.Format)
+	assert.Equal(t, "ws-1", header.WorkspaceID)
+
+	collection, doc, err := r.Next()
+	require.NoError(t, err)
+	assert.Equal(t, "workspaces", collection)
+	assert.Equal(t, bson.D{{Key: "workspace_id", Value: "ws-1"}, {Key: "size", Value: int64(42)}}, doc)
+
+	collection, doc, err = r.Next()
+	require.NoError(t, err)
+	assert.Equal(t, backup.CollectionAttachments, collection)
+	assert.Contains(t, doc, bson.E{Key: "present", Value: true})
+
+	_, _, err = r.Next()
+	require.ErrorIs(t, err, io.EOF)
+}
+
+func TestArchive_Truncated(t *testing.T) {
+	var buf bytes.Buffer
+	w, err := backup.NewArchiveWriter(&buf, backup.Header{WorkspaceID: "ws-1"})
+	require.NoError(t, err)
+	require.NoError(t, w.Write("workspaces", bson.D{{Key: "workspace_id", Value: "ws-1"}}))
+	require.NoError(t, w.Close())
+
+	// drop the gzip footer and part of the trailer
+	truncated := buf.Bytes()[:buf.Len()-12]
+	r, _, err := backup.NewArchiveReader(bytes.NewReader(truncated))
+	require.NoError(t, err)
+
+	for err == nil {
+		_, _, err = r.Next()
+	}
+	require.NotErrorIs(t, err, io.EOF)
+}
+
+func TestArchive_InvalidHeader(t *testing.T) {
+	_, _, err := backup.NewArchiveReader(bytes.NewReader([]byte("plain text")))
+	require.ErrorIs(t, err, backup.ErrInvalidArchive)
+}
@@ -0,0 +1,313 @@
+package backup
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"maps"
+	"slices"
+	"time"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+
+	chatdomain "github.com/lllypuk/flowra/internal/domain/chat"
+	"github.com/lllypuk/flowra/internal/domain/event"
+	"github.com/lllypuk/flowra/internal/domain/uuid"
+	"github.com/lllypuk/flowra/internal/infrastructure/eventstore"
+	mongodbinfra "github.com/lllypuk/flowra/internal/infrastructure/mongodb"
+)
+
+// CollectionEvents holds the events of the chats of the workspace in the archive,
+// whichever event store partition they are stored in.
+const CollectionEvents = mongodbinfra.CollectionEvents
+
+// Export phases reported in Progress.
+const (
+	PhaseWorkspace   = "workspace"
+	PhaseChats       = "chats"
+	PhaseAttachments = "attachments"
+	PhaseRestore     = "restore"
+)
+
+// ErrWorkspaceNotFound is returned when exporting a workspace that does not exist.
+var ErrWorkspaceNotFound = errors.New("workspace not found")
+
+// ProgressFunc receives the progress of a running export or restore.
+type ProgressFunc func(Progress)
+
+// EventLoader loads the event stream of an aggregate.
+// Declared on the consumer side per project guidelines.
+type EventLoader interface {
+	LoadEvents(ctx context.Context, aggregateID string) ([]event.DomainEvent, error)
+}
+
+// FileChecker tells whether an uploaded file is in storage.
+// Declared on the consumer side per project guidelines.
+type FileChecker interface {
+	Exists(fileID uuid.UUID, fileName string) bool
+}
+
+// Exporter writes a workspace into an archive.
+type Exporter struct {
+	db         *mongo.Database
+	events     EventLoader
+	files      FileChecker
+	serializer *eventstore.EventSerializer
+	logger     *slog.Logger
+}
+
+// NewExporter creates a new Exporter.
+func NewExporter(db *mongo.Database, events EventLoader, files FileChecker, logger *slog.Logger) *Exporter {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &Exporter{
+		db:         db,
+		events:     events,
+		files:      files,
+		serializer: eventstore.NewEventSerializer(),
+		logger:     logger,
+	}
+}
+
+// Export writes the workspace with its members, chat events, chat and task read
+// models, messages, task links, file metadata, SLA breaches and the attachments
+// manifest. All reads run in one snapshot session, so the archive is consistent
+// even while the workspace is in use; the export must finish within the snapshot
+// history window of MongoDB (minSnapshotHistoryWindowInSeconds, 5 minutes by default).
+// It returns the number of records per collection.
+func (e *Exporter) Export(
+	ctx context.Context,
+	workspaceID uuid.UUID,
+	w io.Writer,
+	progress ProgressFunc,
+) (map[string]int, error) {
+	session, err := e.db.Client().StartSession(options.Session().SetSnapshot(true))
+	if err != nil {
+		return nil, fmt.Errorf("failed to start snapshot session: %w", err)
+	}
+	defer session.EndSession(ctx)
+	ctx = mongo.NewSessionContext(ctx, session)
+
+	run := &exportRun{
+		Exporter:    e,
+		workspaceID: workspaceID.String(),
+		counts:      make(map[string]int),
+		manifest:    make(map[string]AttachmentEntry),
+		progress:    progress,
+	}
+	if err = run.export(ctx, w); err != nil {
+		return nil, err
+	}
+
+	e.logger.InfoContext(ctx, "workspace exported",
+		slog.String("workspace_id", run.workspaceID),
+		slog.Int("events", run.counts[CollectionEvents]),
+		slog.Int("messages", run.counts[mongodbinfra.CollectionMessages]),
+		slog.Int("attachments", run.counts[CollectionAttachments]),
+	)
+	return run.counts, nil
+}
+
+// exportRun is the state of one export.
+type exportRun struct {
+	*Exporter
+
+	workspaceID string
+	archive     *ArchiveWriter
+	counts      map[string]int
+	manifest    map[string]AttachmentEntry
+	progress    ProgressFunc
+}
+
+func (r *exportRun) export(ctx context.Context, w io.Writer) error {
+	r.report(Progress{Phase: PhaseWorkspace})
+
+	var workspaceDoc bson.D
+	err := r.db.Collection(mongodbinfra.CollectionWorkspaces).
+		FindOne(ctx, bson.M{"workspace_id": r.workspaceID}).Decode(&workspaceDoc)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return ErrWorkspaceNotFound
+	}
+	if err != nil {
+		return fmt.Errorf("failed to load workspace: %w", err)
+	}
+
+	if r.archive, err = NewArchiveWriter(w, Header{
+		WorkspaceID: r.workspaceID,
+		ExportedAt:  time.Now().UTC(),
+	}); err != nil {
+		return err
+	}
+	if err = r.write(mongodbinfra.CollectionWorkspaces, workspaceDoc); err != nil {
+		return err
+	}
+	r.addBrandingAttachment(workspaceDoc)
+
+	workspaceFilter := bson.M{"workspace_id": r.workspaceID}
+	for _, collection := range []string{
+		mongodbinfra.CollectionMembers,
+		mongodbinfra.CollectionTaskReadModel,
+		mongodbinfra.CollectionSLABreaches,
+	} {
+		if err = r.copyCollection(ctx, collection, workspaceFilter, nil); err != nil {
+			return err
+		}
+	}
+
+	var chatIDs []string
+	if err = r.copyCollection(ctx, mongodbinfra.CollectionChatReadModel, workspaceFilter, func(doc bson.D) {
+		if id, ok := lookupString(doc, "chat_id"); ok {
+			chatIDs = append(chatIDs, id)
+		}
+	}); err != nil {
+		return err
+	}
+
+	for i, chatID := range chatIDs {
+		r.report(Progress{Phase: PhaseChats, Done: i, Total: len(chatIDs)})
+		if err = r.exportChat(ctx, chatID); err != nil {
+			return fmt.Errorf("chat %s: %w", chatID, err)
+		}
+	}
+	r.report(Progress{Phase: PhaseChats, Done: len(chatIDs), Total: len(chatIDs)})
+
+	r.report(Progress{Phase: PhaseAttachments, Total: len(r.manifest)})
+	for _, fileID := range slices.Sorted(maps.Keys(r.manifest)) {
+		entry := r.manifest[fileID]
+		entry.Present = r.files.Exists(uuid.UUID(entry.FileID), entry.FileName)
+		if err = r.write(CollectionAttachments, entry); err != nil {
+			return err
+		}
+	}
+
+	return r.archive.Close()
+}
+
+// exportChat writes the events of a chat and the documents belonging to it.
+func (r *exportRun) exportChat(ctx context.Context, chatID string) error {
+	events, err := r.events.LoadEvents(ctx, chatID)
+	if err != nil {
+		return fmt.Errorf("failed to load events: %w", err)
+	}
+	state := chatdomain.NewEmptyChat()
+	for _, evt := range events {
+		doc, serializeErr := r.serializer.Serialize(evt)
+		if serializeErr != nil {
+			return serializeErr
+		}
+		if err = r.write(CollectionEvents, doc); err != nil {
+			return err
+		}
+		_ = state.Apply(evt)
+	}
+	for _, a := range state.SnapshotState().Attachments {
+		r.addAttachment(a.FileID.String(), a.FileName, a.FileSize, a.MimeType)
+	}
+
+	chatFilter := bson.M{"chat_id": chatID}
+	if err = r.copyCollection(ctx, mongodbinfra.CollectionMessages, chatFilter, r.addMessageAttachments); err != nil {
+		return err
+	}
+	if err = r.copyCollection(ctx, mongodbinfra.CollectionTaskLinks, chatFilter, nil); err != nil {
+		return err
+	}
+	return r.copyCollection(ctx, mongodbinfra.CollectionFileMetadata, chatFilter, nil)
+}
+
+// copyCollection writes the documents matching filter, passing each to visit.
+func (r *exportRun) copyCollection(ctx context.Context, collection string, filter bson.M, visit func(bson.D)) error {
+	cursor, err := r.db.Collection(collection).Find(ctx, filter)
+	if err != nil {
+		return fmt.Errorf("failed to query %s: %w", collection, err)
+	}
+	defer cursor.Close(ctx)
+
+	for cursor.Next(ctx) {
+		var doc bson.D
+		if err = cursor.Decode(&doc); err != nil {
+			return fmt.Errorf("failed to decode %s document: %w", collection, err)
+		}
+		if visit != nil {
+			visit(doc)
+		}
+		if err = r.write(collection, doc); err != nil {
+			return err
+		}
+	}
+	if err = cursor.Err(); err != nil {
+		return fmt.Errorf("failed to read %s: %w", collection, err)
+	}
+	return nil
+}
+
+func (r *exportRun) write(collection string, doc any) error {
+	if err := r.archive.Write(collection, doc); err != nil {
+		return err
+	}
+	r.counts[collection]++
+	return nil
+}
+
+func (r *exportRun) addMessageAttachments(doc bson.D) {
+	attachments, ok := lookup(doc, "attachments").(bson.A)
+	if !ok {
+		return
+	}
+	for _, raw := range attachments {
+		attachment, isDoc := raw.(bson.D)
+		if !isDoc {
+			continue
+		}
+		fileID, _ := lookupString(attachment, "file_id")
+		fileName, _ := lookupString(attachment, "file_name")
+		mimeType, _ := lookupString(attachment, "mime_type")
+		fileSize, _ := lookup(attachment, "file_size").(int64)
+		r.addAttachment(fileID, fileName, fileSize, mimeType)
+	}
+}
+
+func (r *exportRun) addBrandingAttachment(workspaceDoc bson.D) {
+	branding, ok := lookup(workspaceDoc, "branding").(bson.D)
+	if !ok {
+		return
+	}
+	fileID, _ := lookupString(branding, "avatar_file_id")
+	fileName, _ := lookupString(branding, "avatar_file_name")
+	r.addAttachment(fileID, fileName, 0, "")
+}
+
+func (r *exportRun) addAttachment(fileID, fileName string, fileSize int64, mimeType string) {
+	if fileID == "" {
+		return
+	}
+	if _, seen := r.manifest[fileID]; seen {
+		return
+	}
+	r.manifest[fileID] = AttachmentEntry{FileID: fileID, FileName: fileName, FileSize: fileSize, MimeType: mimeType}
+}
+
+func (r *exportRun) report(p Progress) {
+	if r.progress != nil {
+		r.progress(p)
+	}
+}
+
+// lookup returns the value of a top-level field of doc.
+func lookup(doc bson.D, key string) any {
+	for _, elem := range doc {
+		if elem.Key == key {
+			return elem.Value
+		}
+	}
+	return nil
+}
+
+func lookupString(doc bson.D, key string) (string, bool) {
+	s, ok := lookup(doc, key).(string)
+	return s, ok && s != ""
+}
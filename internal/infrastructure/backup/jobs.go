@@ -0,0 +1,257 @@
+// Package backup exports a consistent snapshot of one workspace into an archive
+// and restores such archives under new IDs. Exports and restores run as jobs: the
+// API queues them in the backup_jobs collection and the worker carries them out.
+package backup
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"time"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+
+	"github.com/lllypuk/flowra/internal/domain/uuid"
+)
+
+// JobKind is the kind of a backup job.
+type JobKind string
+
+const (
+	// JobKindExport exports a workspace into an archive.
+	JobKindExport JobKind = "export"
+
+	// JobKindRestore restores an uploaded archive as a new workspace.
+	JobKindRestore JobKind = "restore"
+)
+
+// JobStatus is the state of a backup job.
+type JobStatus string
+
+const (
+	JobStatusPending   JobStatus = "pending"
+	JobStatusRunning   JobStatus = "running"
+	JobStatusCompleted JobStatus = "completed"
+	JobStatusFailed    JobStatus = "failed"
+)
+
+// Errors returned by the job store.
+var (
+	ErrJobNotFound   = errors.New("backup job not found")
+	ErrNoPendingJobs = errors.New("no pending backup jobs")
+)
+
+const (
+	// defaultJobListLimit caps List when no limit is given.
+	defaultJobListLimit = 50
+
+	archiveExt = ".ndjson.gz"
+)
+
+// Progress reports how far a running job is.
+type Progress struct {
+	Phase string `bson:"phase" json:"phase"`
+	Done  int    `bson:"done"  json:"done"`
+	Total int    `bson:"total" json:"total"`
+}
+
+// Job is an export or restore of one workspace.
+type Job struct {
+	ID     string    `bson:"_id"`
+	Kind   JobKind   `bson:"kind"`
+	Status JobStatus `bson:"status"`
+
+	// WorkspaceID is the exported workspace, or the workspace created by a restore
+	// once it completes.
+	WorkspaceID string `bson:"workspace_id,omitempty"`
+
+	// WorkspaceName overrides the name of the restored workspace.
+	WorkspaceName string `bson:"workspace_name,omitempty"`
+
+	// ArchivePath is the archive written by an export or read by a restore.
+	ArchivePath string `bson:"archive_path,omitempty"`
+
+	RequestedBy string         `bson:"requested_by"`
+	Progress    Progress       `bson:"progress"`
+	Counts      map[string]int `bson:"counts,omitempty"`
+	Error       string         `bson:"error,omitempty"`
+
+	CreatedAt  time.Time  `bson:"created_at"`
+	UpdatedAt  time.Time  `bson:"updated_at"`
+	StartedAt  *time.Time `bson:"started_at,omitempty"`
+	FinishedAt *time.Time `bson:"finished_at,omitempty"`
+}
+
+// NewExportJob creates a pending export of the workspace into an archive in dir.
+func NewExportJob(workspaceID, requestedBy uuid.UUID, dir string) *Job {
+	return newJob(JobKindExport, requestedBy, func(j *Job) {
+		j.WorkspaceID = workspaceID.String()
+		j.ArchivePath = filepath.Join(dir, "workspace-"+j.ID+archiveExt)
+	})
+}
+
+// UploadPath returns a new path in dir for an archive uploaded for restore.
+func UploadPath(dir string) string {
+	return filepath.Join(dir, "upload-"+uuid.NewUUID().String()+archiveExt)
+}
+
+// NewRestoreJob creates a pending restore of the archive at archivePath.
+// An empty name keeps the name stored in the archive.
+func NewRestoreJob(archivePath, name string, requestedBy uuid.UUID) *Job {
+	return newJob(JobKindRestore, requestedBy, func(j *Job) {
+		j.ArchivePath = archivePath
+		j.WorkspaceName = name
+	})
+}
+
+func newJob(kind JobKind, requestedBy uuid.UUID, init func(*Job)) *Job {
+	now := time.Now()
+	job := &Job{
+		ID:          uuid.NewUUID().String(),
+		Kind:        kind,
+		Status:      JobStatusPending,
+		RequestedBy: requestedBy.String(),
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+	init(job)
+	return job
+}
+
+// MongoJobStore keeps backup jobs in MongoDB.
+type MongoJobStore struct {
+	collection *mongo.Collection
+}
+
+// NewMongoJobStore creates a new MongoDB-based backup job store.
+func NewMongoJobStore(collection *mongo.Collection) *MongoJobStore {
+	return &MongoJobStore{collection: collection}
+}
+
+// Create stores a new job.
+func (s *MongoJobStore) Create(ctx context.Context, job *Job) error {
+	if _, err := s.collection.InsertOne(ctx, job); err != nil {
+		return fmt.Errorf("failed to insert backup job: %w", err)
+	}
+	return nil
+}
+
+// Get returns the job with the given ID.
+func (s *MongoJobStore) Get(ctx context.Context, id string) (*Job, error) {
+	var job Job
+	err := s.collection.FindOne(ctx, bson.M{"_id": id}).Decode(&job)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, ErrJobNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find backup job: %w", err)
+	}
+	return &job, nil
+}
+
+// List returns the most recent jobs, newest first.
+func (s *MongoJobStore) List(ctx context.Context, limit int) ([]*Job, error) {
+	if limit <= 0 {
+		limit = defaultJobListLimit
+	}
+	opts := options.Find().
+		SetSort(bson.D{{Key: "created_at", Value: -1}}).
+		SetLimit(int64(limit))
+
+	cursor, err := s.collection.Find(ctx, bson.M{}, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query backup jobs: %w", err)
+	}
+	jobs := make([]*Job, 0)
+	if err = cursor.All(ctx, &jobs); err != nil {
+		return nil, fmt.Errorf("failed to decode backup jobs: %w", err)
+	}
+	return jobs, nil
+}
+
+// ClaimNext marks the oldest pending job as running and returns it. Claiming is
+// atomic, so several workers never run the same job. Returns ErrNoPendingJobs
+// when the queue is empty.
+func (s *MongoJobStore) ClaimNext(ctx context.Context) (*Job, error) {
+	now := time.Now()
+	opts := options.FindOneAndUpdate().
+		SetSort(bson.D{{Key: "created_at", Value: 1}}).
+		SetReturnDocument(options.After)
+
+	var job Job
+	err := s.collection.FindOneAndUpdate(ctx,
+		bson.M{"status": JobStatusPending},
+		bson.M{"$set": bson.M{"status": JobStatusRunning, "started_at": now, "updated_at": now}},
+		opts,
+	).Decode(&job)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, ErrNoPendingJobs
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to claim backup job: %w", err)
+	}
+	return &job, nil
+}
+
+// UpdateProgress records the progress of a running job.
+func (s *MongoJobStore) UpdateProgress(ctx context.Context, id string, progress Progress) error {
+	return s.update(ctx, id, bson.M{"progress": progress, "updated_at": time.Now()})
+}
+
+// Complete marks a job as completed. workspaceID is the exported or restored
+// workspace, counts the number of records per collection.
+func (s *MongoJobStore) Complete(ctx context.Context, id, workspaceID string, counts map[string]int) error {
+	now := time.Now()
+	return s.update(ctx, id, bson.M{
+		"status":       JobStatusCompleted,
+		"workspace_id": workspaceID,
+		"counts":       counts,
+		"updated_at":   now,
+		"finished_at":  now,
+	})
+}
+
+// Fail marks a job as failed.
+func (s *MongoJobStore) Fail(ctx context.Context, id string, jobErr error) error {
+	now := time.Now()
+	return s.update(ctx, id, bson.M{
+		"status":      JobStatusFailed,
+		"error":       jobErr.Error(),
+		"updated_at":  now,
+		"finished_at": now,
+	})
+}
+
+// FailStale fails running jobs without progress since before, which were left
+// behind by a worker that stopped mid-job. Restores are not resumable, so such
+// jobs are not retried. Returns the number of failed jobs.
+func (s *MongoJobStore) FailStale(ctx context.Context, before time.Time) (int, error) {
+	now := time.Now()
+	result, err := s.collection.UpdateMany(ctx,
+		bson.M{"status": JobStatusRunning, "updated_at": bson.M{"$lt": before}},
+		bson.M{"$set": bson.M{
+			"status":      JobStatusFailed,
+			"error":       "interrupted: the worker stopped while the job was running",
+			"updated_at":  now,
+			"finished_at": now,
+		}},
+	)
+	if err != nil {
+		return 0, fmt.Errorf("failed to fail stale backup jobs: %w", err)
+	}
+	return int(result.ModifiedCount), nil
+}
+
+func (s *MongoJobStore) update(ctx context.Context, id string, set bson.M) error {
+	result, err := s.collection.UpdateOne(ctx, bson.M{"_id": id}, bson.M{"$set": set})
+	if err != nil {
+		return fmt.Errorf("failed to update backup job: %w", err)
+	}
+	if result.MatchedCount == 0 {
+		return ErrJobNotFound
+	}
+	return nil
+}
@@ -0,0 +1,446 @@
+package backup
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"strings"
+	"time"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+
+	"github.com/lllypuk/flowra/internal/domain/event"
+	"github.com/lllypuk/flowra/internal/domain/uuid"
+	"github.com/lllypuk/flowra/internal/infrastructure/eventstore"
+	mongodbinfra "github.com/lllypuk/flowra/internal/infrastructure/mongodb"
+)
+
+const (
+	// restoreBatchSize is the number of documents inserted at once.
+	restoreBatchSize = 500
+
+	// uuidLength is the length of a UUID string; shorter strings cannot contain one.
+	uuidLength = 36
+
+	// CountMissingAttachments counts manifest entries whose file is not in storage
+	// of the target deployment.
+	CountMissingAttachments = "attachments_missing"
+)
+
+// idFields are the fields holding IDs that a restore replaces by new ones.
+// User and file IDs are kept: users come from the shared identity provider and
+// files are content-addressed by their ID in storage.
+//
+//nolint:gochecknoglobals // fixed lookup table
+var idFields = map[string][]string{
+	mongodbinfra.CollectionWorkspaces:    {"workspace_id"},
+	mongodbinfra.CollectionChatReadModel: {"chat_id"},
+	mongodbinfra.CollectionTaskReadModel: {"task_id", "chat_id"},
+	mongodbinfra.CollectionMessages:      {"message_id"},
+	CollectionEvents:                     {"aggregate_id"},
+}
+
+// EventSaver appends events to an aggregate stream.
+// Declared on the consumer side per project guidelines.
+type EventSaver interface {
+	SaveEvents(ctx context.Context, aggregateID string, events []event.DomainEvent, expectedVersion int) error
+}
+
+// Restorer restores archives as new workspaces.
+type Restorer struct {
+	db         *mongo.Database
+	events     EventSaver
+	files      FileChecker
+	serializer *eventstore.EventSerializer
+	logger     *slog.Logger
+}
+
+// NewRestorer creates a new Restorer.
+func NewRestorer(db *mongo.Database, events EventSaver, files FileChecker, logger *slog.Logger) *Restorer {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &Restorer{
+		db:         db,
+		events:     events,
+		files:      files,
+		serializer: eventstore.NewEventSerializer(),
+		logger:     logger,
+	}
+}
+
+// RestoreResult describes a completed restore.
+type RestoreResult struct {
+	WorkspaceID string
+	Counts      map[string]int
+}
+
+// Restore restores the archive at path as a new workspace. The workspace, its
+// chats, tasks and messages get new IDs, and every reference to them is rewritten,
+// so an archive can be restored next to the workspace it was exported from. The
+// restored workspace is not linked to a Keycloak group and has no invites. Its
+// document is inserted last, just after the members: an interrupted restore
+// leaves only records that nothing refers to. An empty name keeps the exported name.
+func (r *Restorer) Restore(ctx context.Context, path, name string, progress ProgressFunc) (*RestoreResult, error) {
+	plan, err := r.plan(path)
+	if err != nil {
+		return nil, err
+	}
+
+	run := &restoreRun{
+		Restorer: r,
+		ids:      plan.ids,
+		counts:   make(map[string]int),
+		progress: progress,
+		total:    plan.records,
+	}
+	if err = run.restore(ctx, path); err != nil {
+		return nil, err
+	}
+
+	if len(run.members) > 0 {
+		if _, err = r.db.Collection(mongodbinfra.CollectionMembers).InsertMany(ctx, run.members); err != nil {
+			return nil, fmt.Errorf("failed to insert members: %w", err)
+		}
+		run.counts[mongodbinfra.CollectionMembers] = len(run.members)
+	}
+
+	workspaceDoc := run.ids.remapDocument(plan.workspace)
+	setField(&workspaceDoc, "invites", bson.A{})
+	setField(&workspaceDoc, "updated_at", time.Now())
+	deleteField(&workspaceDoc, "keycloak_group_id")
+	if strings.TrimSpace(name) != "" {
+		setField(&workspaceDoc, "name", strings.TrimSpace(name))
+	}
+	if _, err = r.db.Collection(mongodbinfra.CollectionWorkspaces).InsertOne(ctx, workspaceDoc); err != nil {
+		return nil, fmt.Errorf("failed to insert workspace: %w", err)
+	}
+	run.counts[mongodbinfra.CollectionWorkspaces]++
+
+	workspaceID := run.ids.ids[plan.workspaceID]
+	r.logger.InfoContext(ctx, "workspace restored",
+		slog.String("source_workspace_id", plan.workspaceID),
+		slog.String("workspace_id", workspaceID),
+		slog.Int("events", run.counts[CollectionEvents]),
+		slog.Int("attachments_missing", run.counts[CountMissingAttachments]),
+	)
+	return &RestoreResult{WorkspaceID: workspaceID, Counts: run.counts}, nil
+}
+
+// restorePlan is the result of the first pass over an archive.
+type restorePlan struct {
+	workspaceID string
+	workspace   bson.D
+	ids         *idMap
+	records     int
+}
+
+// plan reads the archive once to assign the new IDs before anything is written.
+func (r *Restorer) plan(path string) (*restorePlan, error) {
+	plan := &restorePlan{ids: newIDMap()}
+	err := readArchive(path, func(collection string, doc bson.D) error {
+		plan.records++
+		for _, field := range idFields[collection] {
+			if id, ok := lookupString(doc, field); ok {
+				plan.ids.add(id)
+			}
+		}
+		if collection == mongodbinfra.CollectionWorkspaces {
+			if plan.workspace != nil {
+				return fmt.Errorf("%w: more than one workspace", ErrInvalidArchive)
+			}
+			plan.workspace = doc
+			plan.workspaceID, _ = lookupString(doc, "workspace_id")
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if plan.workspace == nil || plan.workspaceID == "" {
+		return nil, fmt.Errorf("%w: no workspace", ErrInvalidArchive)
+	}
+	return plan, nil
+}
+
+// restoreRun is the state of the second pass of one restore.
+type restoreRun struct {
+	*Restorer
+
+	ids      *idMap
+	counts   map[string]int
+	progress ProgressFunc
+	done     int
+	total    int
+
+	// batch collects documents of one collection for a bulk insert
+	batchCollection string
+	batch           []any
+
+	// stream collects the events of one aggregate
+	streamID string
+	stream   []event.DomainEvent
+
+	// members are inserted at the end, so the workspace is not listed for its
+	// members before it is complete
+	members []any
+}
+
+func (r *restoreRun) restore(ctx context.Context, path string) error {
+	err := readArchive(path, func(collection string, doc bson.D) error {
+		r.done++
+		if r.done%restoreBatchSize == 0 {
+			r.report()
+		}
+		if collection == mongodbinfra.CollectionWorkspaces {
+			return nil
+		}
+		if collection != CollectionEvents {
+			if err := r.flushStream(ctx); err != nil {
+				return err
+			}
+		}
+		if collection != r.batchCollection {
+			if err := r.flushBatch(ctx); err != nil {
+				return err
+			}
+		}
+
+		switch collection {
+		case CollectionEvents:
+			return r.addEvent(ctx, doc)
+		case CollectionAttachments:
+			r.checkAttachment(doc)
+			return nil
+		case mongodbinfra.CollectionFileMetadata:
+			return r.insertFileMetadata(ctx, doc)
+		case mongodbinfra.CollectionMembers:
+			r.members = append(r.members, r.ids.remapDocument(doc))
+			return nil
+		case mongodbinfra.CollectionChatReadModel, mongodbinfra.CollectionTaskReadModel,
+			mongodbinfra.CollectionMessages, mongodbinfra.CollectionTaskLinks, mongodbinfra.CollectionSLABreaches:
+			return r.addDocument(ctx, collection, doc)
+		default:
+			return fmt.Errorf("%w: unexpected collection %q", ErrInvalidArchive, collection)
+		}
+	})
+	if err != nil {
+		return err
+	}
+	if err = r.flushStream(ctx); err != nil {
+		return err
+	}
+	if err = r.flushBatch(ctx); err != nil {
+		return err
+	}
+	r.report()
+	return nil
+}
+
+func (r *restoreRun) addEvent(ctx context.Context, doc bson.D) error {
+	raw, err := bson.Marshal(r.ids.remapDocument(doc))
+	if err != nil {
+		return fmt.Errorf("failed to encode event: %w", err)
+	}
+	var eventDoc eventstore.EventDocument
+	if err = bson.Unmarshal(raw, &eventDoc); err != nil {
+		return fmt.Errorf("failed to decode event: %w", err)
+	}
+	evt, err := r.serializer.Deserialize(&eventDoc)
+	if err != nil {
+		return err
+	}
+
+	if eventDoc.AggregateID != r.streamID {
+		if err = r.flushStream(ctx); err != nil {
+			return err
+		}
+		r.streamID = eventDoc.AggregateID
+	}
+	r.stream = append(r.stream, evt)
+	return nil
+}
+
+func (r *restoreRun) flushStream(ctx context.Context) error {
+	if len(r.stream) == 0 {
+		return nil
+	}
+	if err := r.events.SaveEvents(ctx, r.streamID, r.stream, 0); err != nil {
+		return fmt.Errorf("failed to save events of %s: %w", r.streamID, err)
+	}
+	r.counts[CollectionEvents] += len(r.stream)
+	r.stream = nil
+	return nil
+}
+
+func (r *restoreRun) addDocument(ctx context.Context, collection string, doc bson.D) error {
+	r.batchCollection = collection
+	r.batch = append(r.batch, r.ids.remapDocument(doc))
+	if len(r.batch) >= restoreBatchSize {
+		return r.flushBatch(ctx)
+	}
+	return nil
+}
+
+func (r *restoreRun) flushBatch(ctx context.Context) error {
+	if len(r.batch) == 0 {
+		return nil
+	}
+	if _, err := r.db.Collection(r.batchCollection).InsertMany(ctx, r.batch); err != nil {
+		return fmt.Errorf("failed to insert %s: %w", r.batchCollection, err)
+	}
+	r.counts[r.batchCollection] += len(r.batch)
+	r.batch = nil
+	return nil
+}
+
+// insertFileMetadata inserts the metadata of a file unless it is already known,
+// which is the case when restoring into the deployment the archive comes from.
+func (r *restoreRun) insertFileMetadata(ctx context.Context, doc bson.D) error {
+	_, err := r.db.Collection(mongodbinfra.CollectionFileMetadata).InsertOne(ctx, r.ids.remapDocument(doc))
+	if mongo.IsDuplicateKeyError(err) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to insert file metadata: %w", err)
+	}
+	r.counts[mongodbinfra.CollectionFileMetadata]++
+	return nil
+}
+
+func (r *restoreRun) checkAttachment(doc bson.D) {
+	fileID, _ := lookupString(doc, "file_id")
+	fileName, _ := lookupString(doc, "file_name")
+	if fileID != "" && !r.files.Exists(uuid.UUID(fileID), fileName) {
+		r.counts[CountMissingAttachments]++
+	}
+}
+
+func (r *restoreRun) report() {
+	if r.progress != nil {
+		r.progress(Progress{Phase: PhaseRestore, Done: r.done, Total: r.total})
+	}
+}
+
+// readArchive passes every document of the archive at path to fn.
+func readArchive(path string, fn func(collection string, doc bson.D) error) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("failed to open archive: %w", err)
+	}
+	defer f.Close()
+
+	archive, _, err := NewArchiveReader(f)
+	if err != nil {
+		return err
+	}
+	defer archive.Close()
+
+	for {
+		collection, doc, nextErr := archive.Next()
+		if errors.Is(nextErr, io.EOF) {
+			return nil
+		}
+		if nextErr != nil {
+			return nextErr
+		}
+		if err = fn(collection, doc); err != nil {
+			return err
+		}
+	}
+}
+
+// idMap assigns new IDs to the IDs of a restored workspace and rewrites
+// documents accordingly.
+type idMap struct {
+	ids      map[string]string
+	replacer *strings.Replacer
+}
+
+func newIDMap() *idMap {
+	return &idMap{ids: make(map[string]string)}
+}
+
+func (m *idMap) add(id string) {
+	if _, ok := m.ids[id]; !ok {
+		m.ids[id] = uuid.NewUUID().String()
+		m.replacer = nil
+	}
+}
+
+// remapDocument returns a copy of doc without its _id and with every ID replaced,
+// including IDs embedded in longer strings such as links in message content.
+func (m *idMap) remapDocument(doc bson.D) bson.D {
+	if m.replacer == nil {
+		pairs := make([]string, 0, 2*len(m.ids)) //nolint:mnd // old and new ID per entry
+		for oldID, newID := range m.ids {
+			pairs = append(pairs, oldID, newID)
+		}
+		m.replacer = strings.NewReplacer(pairs...)
+	}
+
+	remapped := make(bson.D, 0, len(doc))
+	for _, elem := range doc {
+		if elem.Key == "_id" {
+			continue
+		}
+		remapped = append(remapped, bson.E{Key: elem.Key, Value: m.remap(elem.Value)})
+	}
+	return remapped
+}
+
+func (m *idMap) remap(value any) any {
+	switch v := value.(type) {
+	case string:
+		if newID, ok := m.ids[v]; ok {
+			return newID
+		}
+		if len(v) > uuidLength {
+			return m.replacer.Replace(v)
+		}
+		return v
+	case bson.D:
+		remapped := make(bson.D, len(v))
+		for i, elem := range v {
+			remapped[i] = bson.E{Key: elem.Key, Value: m.remap(elem.Value)}
+		}
+		return remapped
+	case bson.A:
+		remapped := make(bson.A, len(v))
+		for i, item := range v {
+			remapped[i] = m.remap(item)
+		}
+		return remapped
+	case bson.M:
+		remapped := make(bson.M, len(v))
+		for key, item := range v {
+			remapped[key] = m.remap(item)
+		}
+		return remapped
+	default:
+		return value
+	}
+}
+
+func setField(doc *bson.D, key string, value any) {
+	for i := range *doc {
+		if (*doc)[i].Key == key {
+			(*doc)[i].Value = value
+			return
+		}
+	}
+	*doc = append(*doc, bson.E{Key: key, Value: value})
+}
+
+func deleteField(doc *bson.D, key string) {
+	for i := range *doc {
+		if (*doc)[i].Key == key {
+			*doc = append((*doc)[:i], (*doc)[i+1:]...)
+			return
+		}
+	}
+}
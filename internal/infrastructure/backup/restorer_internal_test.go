@@ -0,0 +1,119 @@
+package backup
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/v2/bson"
+
+	chatdomain "github.com/lllypuk/flowra/internal/domain/chat"
+	"github.com/lllypuk/flowra/internal/domain/event"
+	"github.com/lllypuk/flowra/internal/domain/uuid"
+	"github.com/lllypuk/flowra/internal/infrastructure/eventstore"
+)
+
+type recordingSaver struct {
+	streams map[string][]event.DomainEvent
+}
+
+func (s *recordingSaver) SaveEvents(_ context.Context, aggregateID string, events []event.DomainEvent, _ int) error {
+	s.streams[aggregateID] = append(s.streams[aggregateID], events...)
+	return nil
+}
+
+func TestIDMap_RemapDocument(t *testing.T) {
+	const (
+		chatID  = "0b7c5e2e-5f4a-4d8e-9a57-6a6c3c7f4b11"
+		userID  = "5d1e0c4a-1b2c-4d3e-8f90-a1b2c3d4e5f6"
+		otherID = "9f8e7d6c-5b4a-4c3d-9e2f-1a0b9c8d7e6f"
+	)
+	ids := newIDMap()
+	ids.add(chatID)
+	newChatID := ids.ids[chatID]
+
+	doc := bson.D{
+		{Key: "_id", Value: bson.NewObjectID()},
+		{Key: "chat_id", Value: chatID},
+		{Key: "sent_by", Value: userID},
+		{Key: "content", Value: "see /chats/" + chatID + " and /chats/" + otherID},
+		{Key: "attachments", Value: bson.A{bson.D{{Key: "chat_id", Value: chatID}}}},
+		{Key: "data", Value: bson.M{"chat_id": chatID, "count": int32(3)}},
+	}
+
+	assert.Equal(t, bson.D{
+		{Key: "chat_id", Value: newChatID},
+		{Key: "sent_by", Value: userID},
+		{Key: "content", Value: "see /chats/" + newChatID + " and /chats/" + otherID},
+		{Key: "attachments", Value: bson.A{bson.D{{Key: "chat_id", Value: newChatID}}}},
+		{Key: "data", Value: bson.M{"chat_id": newChatID, "count": int32(3)}},
+	}, ids.remapDocument(doc))
+
+	// the original document is left alone
+	assert.Equal(t, chatID, doc[1].Value)
+}
+
+func TestSetAndDeleteField(t *testing.T) {
+	doc := bson.D{{Key: "name", Value: "Old"}, {Key: "keycloak_group_id", Value: "g-1"}}
+
+	setField(&doc, "name", "New")
+	setField(&doc, "invites", bson.A{})
+	deleteField(&doc, "keycloak_group_id")
+
+	assert.Equal(t, bson.D{{Key: "name", Value: "New"}, {Key: "invites", Value: bson.A{}}}, doc)
+}
+
+func TestRestoreRun_EventsAreRemapped(t *testing.T) {
+	workspaceID, userID := uuid.NewUUID(), uuid.NewUUID()
+	c, err := chatdomain.NewChat(workspaceID, chatdomain.TypeTask, true, userID)
+	require.NoError(t, err)
+	require.NoError(t, c.Rename("Ship the backup API", userID))
+
+	var buf bytes.Buffer
+	w, err := NewArchiveWriter(&buf, Header{WorkspaceID: workspaceID.String()})
+	require.NoError(t, err)
+	serializer := eventstore.NewEventSerializer()
+	for _, evt := range c.GetUncommittedEvents() {
+		doc, serializeErr := serializer.Serialize(evt)
+		require.NoError(t, serializeErr)
+		require.NoError(t, w.Write(CollectionEvents, doc))
+	}
+	require.NoError(t, w.Close())
+
+	ids := newIDMap()
+	ids.add(workspaceID.String())
+	ids.add(c.ID().String())
+	saver := &recordingSaver{streams: make(map[string][]event.DomainEvent)}
+	run := &restoreRun{
+		Restorer: NewRestorer(nil, saver, nil, nil),
+		ids:      ids,
+		counts:   make(map[string]int),
+	}
+
+	r, _, err := NewArchiveReader(&buf)
+	require.NoError(t, err)
+	for {
+		collection, doc, nextErr := r.Next()
+		if nextErr != nil {
+			break
+		}
+		require.Equal(t, CollectionEvents, collection)
+		require.NoError(t, run.addEvent(context.Background(), doc))
+	}
+	require.NoError(t, run.flushStream(context.Background()))
+
+	newChatID := ids.ids[c.ID().String()]
+	require.Len(t, saver.streams[newChatID], len(c.GetUncommittedEvents()))
+	assert.Equal(t, len(c.GetUncommittedEvents()), run.counts[CollectionEvents])
+
+	restored := chatdomain.NewEmptyChat()
+	for _, evt := range saver.streams[newChatID] {
+		require.NoError(t, restored.Apply(evt))
+	}
+	assert.Equal(t, newChatID, restored.ID().String())
+	assert.Equal(t, ids.ids[workspaceID.String()], restored.WorkspaceID().String())
+	assert.Equal(t, "Ship the backup API", restored.Title())
+	assert.Equal(t, userID, restored.CreatedBy())
+}
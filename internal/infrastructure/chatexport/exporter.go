@@ -0,0 +1,406 @@
+// Package chatexport renders the transcript of one chat as a self-contained JSON
+// or HTML document. Small chats are exported within the request; larger ones run
+// as jobs: the API queues them in the chat_export_jobs collection and the worker
+// writes the transcript to the shared export directory.
+package chatexport
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"html/template"
+	"io"
+	"log/slog"
+	"net/url"
+	"strings"
+	"time"
+
+	chatapp "github.com/lllypuk/flowra/internal/application/chat"
+	"github.com/lllypuk/flowra/internal/domain/message"
+	userdomain "github.com/lllypuk/flowra/internal/domain/user"
+	"github.com/lllypuk/flowra/internal/domain/uuid"
+)
+
+// Format is the document format of a transcript.
+type Format string
+
+const (
+	FormatJSON Format = "json"
+	FormatHTML Format = "html"
+)
+
+// ErrUnsupportedFormat is returned for formats other than json and html.
+var ErrUnsupportedFormat = errors.New("unsupported export format")
+
+// ParseFormat parses a format name; empty selects JSON.
+func ParseFormat(s string) (Format, error) {
+	switch Format(strings.ToLower(strings.TrimSpace(s))) {
+	case "", FormatJSON:
+		return FormatJSON, nil
+	case FormatHTML:
+		return FormatHTML, nil
+	default:
+		return "", fmt.Errorf("%w: %q", ErrUnsupportedFormat, s)
+	}
+}
+
+// Ext returns the file extension of the format.
+func (f Format) Ext() string {
+	return string(f)
+}
+
+// ContentType returns the MIME type of the format.
+func (f Format) ContentType() string {
+	if f == FormatHTML {
+		return "text/html; charset=utf-8"
+	}
+	return "application/json"
+}
+
+// ChatFinder looks up the exported chat.
+// Declared on the consumer side per project guidelines.
+type ChatFinder interface {
+	FindByID(ctx context.Context, chatID uuid.UUID) (*chatapp.ReadModel, error)
+}
+
+// MessageStreamer streams the messages of a chat, oldest first.
+// Declared on the consumer side per project guidelines.
+type MessageStreamer interface {
+	StreamByChatID(ctx context.Context, chatID, after uuid.UUID, fn func(*message.Message) error) error
+}
+
+// UserFinder resolves the names of message authors.
+// Declared on the consumer side per project guidelines.
+type UserFinder interface {
+	FindByIDs(ctx context.Context, ids []uuid.UUID) ([]*userdomain.User, error)
+}
+
+// Chat describes the exported chat.
+type Chat struct {
+	ID          string    `json:"id"`
+	WorkspaceID string    `json:"workspace_id"`
+	Type        string    `json:"type"`
+	Title       string    `json:"title"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+// Message is one message of a transcript. The content of deleted messages is left out.
+type Message struct {
+	ID          string       `json:"id"`
+	AuthorID    string       `json:"author_id,omitempty"`
+	AuthorName  string       `json:"author_name,omitempty"`
+	ParentID    string       `json:"parent_id,omitempty"`
+	Content     string       `json:"content,omitempty"`
+	CreatedAt   time.Time    `json:"created_at"`
+	EditedAt    *time.Time   `json:"edited_at,omitempty"`
+	Deleted     bool         `json:"deleted,omitempty"`
+	Reactions   []Reaction   `json:"reactions,omitempty"`
+	Attachments []Attachment `json:"attachments,omitempty"`
+}
+
+// Reaction is an emoji reaction and the names of the users who added it.
+type Reaction struct {
+	Emoji string   `json:"emoji"`
+	Count int      `json:"count"`
+	Users []string `json:"users"`
+}
+
+// Attachment links to a file attached to a message.
+type Attachment struct {
+	FileName string `json:"file_name"`
+	FileSize int64  `json:"file_size"`
+	MimeType string `json:"mime_type"`
+	URL      string `json:"url"`
+}
+
+// Exporter writes chat transcripts.
+type Exporter struct {
+	chats    ChatFinder
+	messages MessageStreamer
+	users    UserFinder
+	baseURL  string
+	logger   *slog.Logger
+}
+
+// ExporterOption configures an Exporter.
+type ExporterOption func(*Exporter)
+
+// WithBaseURL makes attachment links absolute, so they work outside the web app.
+func WithBaseURL(baseURL string) ExporterOption {
+	return func(e *Exporter) {
+		e.baseURL = strings.TrimRight(baseURL, "/")
+	}
+}
+
+// WithLogger sets the logger of the exporter.
+func WithLogger(logger *slog.Logger) ExporterOption {
+	return func(e *Exporter) {
+		e.logger = logger
+	}
+}
+
+// NewExporter creates a new chat transcript exporter.
+func NewExporter(chats ChatFinder, messages MessageStreamer, users UserFinder, opts ...ExporterOption) *Exporter {
+	e := &Exporter{
+		chats:    chats,
+		messages: messages,
+		users:    users,
+		logger:   slog.Default(),
+	}
+	for _, opt := range opts {
+		opt(e)
+	}
+	return e
+}
+
+// Export writes the transcript of the chat to w and returns the number of exported
+// messages. Messages are streamed, so large chats are never held in memory.
+func (e *Exporter) Export(ctx context.Context, chatID uuid.UUID, format Format, w io.Writer) (int, error) {
+	rm, err := e.chats.FindByID(ctx, chatID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to find chat: %w", err)
+	}
+
+	names := newNameCache(e.users, e.logger)
+	participantIDs := make([]uuid.UUID, 0, len(rm.Participants))
+	for _, p := range rm.Participants {
+		participantIDs = append(participantIDs, p.UserID())
+	}
+	names.load(ctx, participantIDs)
+
+	buf := bufio.NewWriter(w)
+	tw := newTranscriptWriter(format, buf)
+	if err = tw.begin(Chat{
+		ID:          rm.ID.String(),
+		WorkspaceID: rm.WorkspaceID.String(),
+		Type:        string(rm.Type),
+		Title:       rm.Title,
+		CreatedAt:   rm.CreatedAt,
+	}, time.Now().UTC()); err != nil {
+		return 0, err
+	}
+
+	count := 0
+	err = e.messages.StreamByChatID(ctx, chatID, "", func(msg *message.Message) error {
+		count++
+		return tw.message(e.toMessage(ctx, msg, names))
+	})
+	if err != nil {
+		return count, fmt.Errorf("failed to stream messages: %w", err)
+	}
+
+	if err = tw.end(count); err != nil {
+		return count, err
+	}
+	return count, buf.Flush()
+}
+
+func (e *Exporter) toMessage(ctx context.Context, msg *message.Message, names *nameCache) Message {
+	m := Message{
+		ID:        msg.ID().String(),
+		CreatedAt: msg.CreatedAt(),
+		EditedAt:  msg.EditedAt(),
+		Deleted:   msg.IsDeleted(),
+	}
+	if !msg.AuthorID().IsZero() {
+		m.AuthorID = msg.AuthorID().String()
+		m.AuthorName = names.name(ctx, msg.AuthorID())
+	}
+	if !msg.ParentMessageID().IsZero() {
+		m.ParentID = msg.ParentMessageID().String()
+	}
+	if !msg.IsDeleted() {
+		m.Content = msg.Content()
+	}
+
+	byEmoji := make(map[string]int)
+	for _, r := range msg.Reactions() {
+		i, ok := byEmoji[r.EmojiCode()]
+		if !ok {
+			i = len(m.Reactions)
+			byEmoji[r.EmojiCode()] = i
+			m.Reactions = append(m.Reactions, Reaction{Emoji: r.EmojiCode()})
+		}
+		m.Reactions[i].Count++
+		m.Reactions[i].Users = append(m.Reactions[i].Users, names.name(ctx, r.UserID()))
+	}
+
+	for _, a := range msg.Attachments() {
+		m.Attachments = append(m.Attachments, Attachment{
+			FileName: a.FileName(),
+			FileSize: a.FileSize(),
+			MimeType: a.MimeType(),
+			URL:      fmt.Sprintf("%s/api/v1/files/%s/%s", e.baseURL, a.FileID(), url.PathEscape(a.FileName())),
+		})
+	}
+	return m
+}
+
+// nameCache resolves user names once per export. Users that cannot be found are
+// shown by ID.
+type nameCache struct {
+	users  UserFinder
+	logger *slog.Logger
+	names  map[uuid.UUID]string
+}
+
+func newNameCache(users UserFinder, logger *slog.Logger) *nameCache {
+	return &nameCache{users: users, logger: logger, names: make(map[uuid.UUID]string)}
+}
+
+func (c *nameCache) load(ctx context.Context, ids []uuid.UUID) {
+	var missing []uuid.UUID
+	for _, id := range ids {
+		if _, ok := c.names[id]; !ok {
+			missing = append(missing, id)
+			c.names[id] = id.String()
+		}
+	}
+	if len(missing) == 0 || c.users == nil {
+		return
+	}
+
+	users, err := c.users.FindByIDs(ctx, missing)
+	if err != nil {
+		c.logger.WarnContext(ctx, "failed to resolve user names for chat export",
+			slog.Int("users", len(missing)),
+			slog.String("error", err.Error()),
+		)
+		return
+	}
+	for _, u := range users {
+		name := u.DisplayName()
+		if name == "" {
+			name = u.Username()
+		}
+		c.names[u.ID()] = name
+	}
+}
+
+func (c *nameCache) name(ctx context.Context, id uuid.UUID) string {
+	if name, ok := c.names[id]; ok {
+		return name
+	}
+	c.load(ctx, []uuid.UUID{id})
+	return c.names[id]
+}
+
+// transcriptWriter writes a transcript one message at a time.
+type transcriptWriter interface {
+	begin(chat Chat, exportedAt time.Time) error
+	message(m Message) error
+	end(count int) error
+}
+
+func newTranscriptWriter(format Format, w io.Writer) transcriptWriter {
+	if format == FormatHTML {
+		return &htmlWriter{w: w}
+	}
+	return &jsonWriter{w: w}
+}
+
+// jsonWriter writes {"chat": ..., "exported_at": ..., "messages": [...], "message_count": n}.
+type jsonWriter struct {
+	w     io.Writer
+	first bool
+}
+
+func (j *jsonWriter) begin(chat Chat, exportedAt time.Time) error {
+	chatJSON, err := json.Marshal(chat)
+	if err != nil {
+		return err
+	}
+	j.first = true
+	_, err = fmt.Fprintf(j.w, `{"chat":%s,"exported_at":%q,"messages":[`,
+		chatJSON, exportedAt.Format(time.RFC3339))
+	return err
+}
+
+func (j *jsonWriter) message(m Message) error {
+	if !j.first {
+		if _, err := io.WriteString(j.w, ","); err != nil {
+			return err
+		}
+	}
+	j.first = false
+	data, err := json.Marshal(m)
+	if err != nil {
+		return err
+	}
+	_, err = j.w.Write(data)
+	return err
+}
+
+func (j *jsonWriter) end(count int) error {
+	_, err := fmt.Fprintf(j.w, `],"message_count":%d}`+"\n", count)
+	return err
+}
+
+// htmlWriter writes a standalone page with inline styles.
+type htmlWriter struct {
+	w io.Writer
+}
+
+func (h *htmlWriter) begin(chat Chat, exportedAt time.Time) error {
+	return transcriptTemplates.ExecuteTemplate(h.w, "header", map[string]any{
+		"Chat":       chat,
+		"ExportedAt": exportedAt,
+	})
+}
+
+func (h *htmlWriter) message(m Message) error {
+	return transcriptTemplates.ExecuteTemplate(h.w, "message", m)
+}
+
+func (h *htmlWriter) end(count int) error {
+	return transcriptTemplates.ExecuteTemplate(h.w, "footer", count)
+}
+
+//nolint:gochecknoglobals // parsed once, read-only afterwards
+var transcriptTemplates = template.Must(template.New("transcript").Funcs(template.FuncMap{
+	"timestamp": func(t time.Time) string { return t.UTC().Format("2006-01-02 15:04 MST") },
+}).Parse(`{{define "header"}}<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<title>{{.Chat.Title}}</title>
+<style>
+body{font-family:system-ui,sans-serif;max-width:48rem;margin:2rem auto;padding:0 1rem;color:#222}
+header{border-bottom:1px solid #ddd;margin-bottom:1rem}
+.meta{color:#777;font-size:.85rem}
+.message{padding:.5rem 0;border-bottom:1px solid #f0f0f0}
+.author{font-weight:600}
+.content{white-space:pre-wrap;margin:.25rem 0}
+.deleted{color:#999;font-style:italic}
+.reply{color:#777;font-size:.85rem}
+.reactions span{display:inline-block;background:#f3f3f3;border-radius:1rem;padding:0 .5rem;margin-right:.25rem}
+.attachments{margin:.25rem 0;padding-left:1.25rem}
+</style>
+</head>
+<body>
+<header>
+<h1>{{.Chat.Title}}</h1>
+<p class="meta">{{.Chat.Type}} chat · exported {{timestamp .ExportedAt}}</p>
+</header>
+<main>
+{{end}}
+{{define "message"}}<article class="message" id="m-{{.ID}}">
+<div><span class="author">{{if .AuthorName}}{{.AuthorName}}{{else}}System{{end}}</span>
+<span class="meta">{{timestamp .CreatedAt}}{{if .EditedAt}} · edited{{end}}</span></div>
+{{if .ParentID}}<div class="reply">in reply to <a href="#m-{{.ParentID}}">a message</a></div>{{end}}
+{{if .Deleted}}<p class="content deleted">This message was deleted</p>{{else}}<p class="content">{{.Content}}</p>{{end}}
+{{if .Attachments}}<ul class="attachments">{{range .Attachments}}
+<li><a href="{{.URL}}">{{.FileName}}</a> <span class="meta">{{.MimeType}}, {{.FileSize}} bytes</span></li>{{end}}
+</ul>{{end}}
+{{if .Reactions}}<div class="reactions">{{range .Reactions}}
+<span title="{{range $i, $u := .Users}}{{if $i}}, {{end}}{{$u}}{{end}}">{{.Emoji}} {{.Count}}</span>{{end}}
+</div>{{end}}
+</article>
+{{end}}
+{{define "footer"}}</main>
+<footer class="meta"><p>{{.}} messages</p></footer>
+</body>
+</html>
+{{end}}`))
@@ -0,0 +1,196 @@
+package chatexport_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	chatapp "github.com/lllypuk/flowra/internal/application/chat"
+	"github.com/lllypuk/flowra/internal/domain/chat"
+	"github.com/lllypuk/flowra/internal/domain/message"
+	userdomain "github.com/lllypuk/flowra/internal/domain/user"
+	"github.com/lllypuk/flowra/internal/domain/uuid"
+	"github.com/lllypuk/flowra/internal/infrastructure/chatexport"
+)
+
+type stubChats struct {
+	chat *chatapp.ReadModel
+}
+
+func (s stubChats) FindByID(_ context.Context, chatID uuid.UUID) (*chatapp.ReadModel, error) {
+	if s.chat == nil || s.chat.ID != chatID {
+		return nil, errors.New("not found")
+	}
+	return s.chat, nil
+}
+
+type stubMessages struct {
+	messages []*message.Message
+}
+
+func (s stubMessages) StreamByChatID(
+	_ context.Context,
+	_, _ uuid.UUID,
+	fn func(*message.Message) error,
+) error {
+	for _, msg := range s.messages {
+		if err := fn(msg); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// stubUsers counts lookups to verify that names are resolved once
+type stubUsers struct {
+	users   map[uuid.UUID]*userdomain.User
+	lookups int
+}
+
+func (s *stubUsers) FindByIDs(_ context.Context, ids []uuid.UUID) ([]*userdomain.User, error) {
+	s.lookups++
+	var found []*userdomain.User
+	for _, id := range ids {
+		if u, ok := s.users[id]; ok {
+			found = append(found, u)
+		}
+	}
+	return found, nil
+}
+
+type exportFixture struct {
+	chat     *chatapp.ReadModel
+	messages []*message.Message
+	users    *stubUsers
+	alice    *userdomain.User
+	bob      *userdomain.User
+}
+
+func newExportFixture(t *testing.T) *exportFixture {
+	t.Helper()
+	alice, err := userdomain.NewUser("ext-alice", "alice", "alice@example.com", "Alice")
+	require.NoError(t, err)
+	bob, err := userdomain.NewUser("ext-bob", "bob", "bob@example.com", "")
+	require.NoError(t, err)
+
+	rm := &chatapp.ReadModel{
+		ID:           uuid.NewUUID(),
+		WorkspaceID:  uuid.NewUUID(),
+		Type:         chat.TypeDiscussion,
+		Title:        "Release <planning>",
+		CreatedAt:    time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC),
+		Participants: []chat.Participant{chat.NewParticipant(alice.ID(), chat.RoleAdmin)},
+	}
+
+	hello, err := message.NewMessage(rm.ID, alice.ID(), "Hello <b>team</b>", "")
+	require.NoError(t, err)
+	require.NoError(t, hello.AddReaction(alice.ID(), "👍"))
+	require.NoError(t, hello.AddReaction(bob.ID(), "👍"))
+	require.NoError(t, hello.AddAttachment(uuid.NewUUID(), "plan v2.pdf", 2048, "application/pdf"))
+
+	reply, err := message.NewMessage(rm.ID, bob.ID(), "secret", hello.ID())
+	require.NoError(t, err)
+	require.NoError(t, reply.Delete(bob.ID()))
+
+	return &exportFixture{
+		chat:     rm,
+		messages: []*message.Message{hello, reply},
+		users: &stubUsers{users: map[uuid.UUID]*userdomain.User{
+			alice.ID(): alice,
+			bob.ID():   bob,
+		}},
+		alice: alice,
+		bob:   bob,
+	}
+}
+
+func (f *exportFixture) exporter() *chatexport.Exporter {
+	return chatexport.NewExporter(
+		stubChats{chat: f.chat},
+		stubMessages{messages: f.messages},
+		f.users,
+		chatexport.WithBaseURL("https://flowra.example.com/"),
+	)
+}
+
+func TestParseFormat(t *testing.T) {
+	format, err := chatexport.ParseFormat("")
+	require.NoError(t, err)
+	assert.Equal(t, chatexport.FormatJSON, format)
+
+	format, err = chatexport.ParseFormat("HTML")
+	require.NoError(t, err)
+	assert.Equal(t, chatexport.FormatHTML, format)
+
+	_, err = chatexport.ParseFormat("pdf")
+	require.ErrorIs(t, err, chatexport.ErrUnsupportedFormat)
+}
+
+func TestExporter_Export_JSON(t *testing.T) {
+	f := newExportFixture(t)
+	var buf bytes.Buffer
+
+	count, err := f.exporter().Export(context.Background(), f.chat.ID, chatexport.FormatJSON, &buf)
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+
+	var transcript struct {
+		Chat         chatexport.Chat      `json:"chat"`
+		ExportedAt   time.Time            `json:"exported_at"`
+		Messages     []chatexport.Message `json:"messages"`
+		MessageCount int                  `json:"message_count"`
+	}
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &transcript))
+	assert.Equal(t, f.chat.Title, transcript.Chat.Title)
+	assert.Equal(t, f.chat.WorkspaceID.String(), transcript.Chat.WorkspaceID)
+	assert.Equal(t, 2, transcript.MessageCount)
+	require.Len(t, transcript.Messages, 2)
+
+	hello := transcript.Messages[0]
+	assert.Equal(t, "Alice", hello.AuthorName)
+	assert.Equal(t, "Hello <b>team</b>", hello.Content)
+	require.Len(t, hello.Reactions, 1)
+	assert.Equal(t, chatexport.Reaction{Emoji: "👍", Count: 2, Users: []string{"Alice", "bob"}}, hello.Reactions[0])
+	require.Len(t, hello.Attachments, 1)
+	assert.Regexp(t, `^https://flowra\.example\.com/api/v1/files/[^/]+/plan%20v2\.pdf$`, hello.Attachments[0].URL)
+
+	reply := transcript.Messages[1]
+	assert.Equal(t, "bob", reply.AuthorName, "users without a display name are shown by username")
+	assert.Equal(t, f.messages[0].ID().String(), reply.ParentID)
+	assert.True(t, reply.Deleted)
+	assert.Empty(t, reply.Content, "deleted content is not exported")
+
+	assert.Equal(t, 2, f.users.lookups, "participants are resolved at once, other authors once each")
+}
+
+func TestExporter_Export_HTML(t *testing.T) {
+	f := newExportFixture(t)
+	var buf bytes.Buffer
+
+	count, err := f.exporter().Export(context.Background(), f.chat.ID, chatexport.FormatHTML, &buf)
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+
+	page := buf.String()
+	assert.Contains(t, page, "<!DOCTYPE html>")
+	assert.Contains(t, page, "<style>", "styles are inlined")
+	assert.Contains(t, page, "Release &lt;planning&gt;")
+	assert.Contains(t, page, "Hello &lt;b&gt;team&lt;/b&gt;", "content is escaped")
+	assert.Contains(t, page, "This message was deleted")
+	assert.NotContains(t, page, "secret")
+	assert.Contains(t, page, "plan%20v2.pdf")
+	assert.Contains(t, page, "2 messages")
+}
+
+func TestExporter_Export_UnknownChat(t *testing.T) {
+	f := newExportFixture(t)
+
+	_, err := f.exporter().Export(context.Background(), uuid.NewUUID(), chatexport.FormatJSON, &bytes.Buffer{})
+	require.Error(t, err)
+}
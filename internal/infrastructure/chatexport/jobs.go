@@ -0,0 +1,175 @@
+package chatexport
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"time"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+
+	"github.com/lllypuk/flowra/internal/domain/uuid"
+)
+
+// JobStatus is the state of an export job.
+type JobStatus string
+
+const (
+	JobStatusPending   JobStatus = "pending"
+	JobStatusRunning   JobStatus = "running"
+	JobStatusCompleted JobStatus = "completed"
+	JobStatusFailed    JobStatus = "failed"
+)
+
+// Errors returned by the job store.
+var (
+	ErrJobNotFound   = errors.New("chat export job not found")
+	ErrNoPendingJobs = errors.New("no pending chat export jobs")
+)
+
+// Job is the export of one chat, carried out by the worker.
+type Job struct {
+	ID          string    `bson:"_id"`
+	ChatID      string    `bson:"chat_id"`
+	WorkspaceID string    `bson:"workspace_id"`
+	Format      Format    `bson:"format"`
+	Status      JobStatus `bson:"status"`
+
+	// FilePath is the transcript written by the worker.
+	FilePath string `bson:"file_path"`
+
+	RequestedBy  string `bson:"requested_by"`
+	MessageCount int    `bson:"message_count"`
+	Error        string `bson:"error,omitempty"`
+
+	CreatedAt  time.Time  `bson:"created_at"`
+	UpdatedAt  time.Time  `bson:"updated_at"`
+	StartedAt  *time.Time `bson:"started_at,omitempty"`
+	FinishedAt *time.Time `bson:"finished_at,omitempty"`
+}
+
+// NewJob creates a pending export of the chat into a transcript in dir.
+func NewJob(chatID, workspaceID, requestedBy uuid.UUID, format Format, dir string) *Job {
+	now := time.Now()
+	id := uuid.NewUUID().String()
+	return &Job{
+		ID:          id,
+		ChatID:      chatID.String(),
+		WorkspaceID: workspaceID.String(),
+		Format:      format,
+		Status:      JobStatusPending,
+		FilePath:    filepath.Join(dir, "chat-"+id+"."+format.Ext()),
+		RequestedBy: requestedBy.String(),
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+}
+
+// MongoJobStore keeps chat export jobs in MongoDB.
+type MongoJobStore struct {
+	collection *mongo.Collection
+}
+
+// NewMongoJobStore creates a new MongoDB-based chat export job store.
+func NewMongoJobStore(collection *mongo.Collection) *MongoJobStore {
+	return &MongoJobStore{collection: collection}
+}
+
+// Create stores a new job.
+func (s *MongoJobStore) Create(ctx context.Context, job *Job) error {
+	if _, err := s.collection.InsertOne(ctx, job); err != nil {
+		return fmt.Errorf("failed to insert chat export job: %w", err)
+	}
+	return nil
+}
+
+// Get returns the job with the given ID.
+func (s *MongoJobStore) Get(ctx context.Context, id string) (*Job, error) {
+	var job Job
+	err := s.collection.FindOne(ctx, bson.M{"_id": id}).Decode(&job)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, ErrJobNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find chat export job: %w", err)
+	}
+	return &job, nil
+}
+
+// ClaimNext marks the oldest pending job as running and returns it. Claiming is
+// atomic, so several workers never run the same job. Returns ErrNoPendingJobs
+// when the queue is empty.
+func (s *MongoJobStore) ClaimNext(ctx context.Context) (*Job, error) {
+	now := time.Now()
+	opts := options.FindOneAndUpdate().
+		SetSort(bson.D{{Key: "created_at", Value: 1}}).
+		SetReturnDocument(options.After)
+
+	var job Job
+	err := s.collection.FindOneAndUpdate(ctx,
+		bson.M{"status": JobStatusPending},
+		bson.M{"$set": bson.M{"status": JobStatusRunning, "started_at": now, "updated_at": now}},
+		opts,
+	).Decode(&job)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, ErrNoPendingJobs
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to claim chat export job: %w", err)
+	}
+	return &job, nil
+}
+
+// Complete marks a job as completed with the number of exported messages.
+func (s *MongoJobStore) Complete(ctx context.Context, id string, messageCount int) error {
+	now := time.Now()
+	return s.update(ctx, id, bson.M{
+		"status":        JobStatusCompleted,
+		"message_count": messageCount,
+		"updated_at":    now,
+		"finished_at":   now,
+	})
+}
+
+// Fail marks a job as failed.
+func (s *MongoJobStore) Fail(ctx context.Context, id string, jobErr error) error {
+	now := time.Now()
+	return s.update(ctx, id, bson.M{
+		"status":      JobStatusFailed,
+		"error":       jobErr.Error(),
+		"updated_at":  now,
+		"finished_at": now,
+	})
+}
+
+// Requeue puts running jobs started before the given time back in the queue;
+// they were left behind by a worker that stopped mid-export. Exports are
+// idempotent, so the next worker simply starts over. Returns the number of
+// requeued jobs.
+func (s *MongoJobStore) Requeue(ctx context.Context, before time.Time) (int, error) {
+	result, err := s.collection.UpdateMany(ctx,
+		bson.M{"status": JobStatusRunning, "updated_at": bson.M{"$lt": before}},
+		bson.M{
+			"$set":   bson.M{"status": JobStatusPending, "updated_at": time.Now()},
+			"$unset": bson.M{"started_at": ""},
+		},
+	)
+	if err != nil {
+		return 0, fmt.Errorf("failed to requeue stale chat export jobs: %w", err)
+	}
+	return int(result.ModifiedCount), nil
+}
+
+func (s *MongoJobStore) update(ctx context.Context, id string, set bson.M) error {
+	result, err := s.collection.UpdateOne(ctx, bson.M{"_id": id}, bson.M{"$set": set})
+	if err != nil {
+		return fmt.Errorf("failed to update chat export job: %w", err)
+	}
+	if result.MatchedCount == 0 {
+		return ErrJobNotFound
+	}
+	return nil
+}
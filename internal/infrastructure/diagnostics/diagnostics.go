@@ -0,0 +1,205 @@
+// Package diagnostics provides pprof and runtime introspection endpoints for
+// profiling the API and worker processes.
+package diagnostics
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net"
+	"net/http"
+	"net/http/pprof"
+	"runtime"
+	"runtime/debug"
+	runtimepprof "runtime/pprof"
+	"time"
+)
+
+// Route prefixes served by the diagnostics handler.
+const (
+	PathPrefix     = "/debug"
+	PprofPrefix    = PathPrefix + "/pprof/"
+	GoroutinesPath = PathPrefix + "/runtime/goroutines"
+	GCStatsPath    = PathPrefix + "/runtime/gc"
+)
+
+// goroutineDumpDebug selects the full stack trace format for goroutine dumps.
+const goroutineDumpDebug = 2
+
+// Server timeouts for the standalone diagnostics listener.
+// WriteTimeout is intentionally generous so CPU profiles and traces can complete.
+const (
+	serverReadHeaderTimeout = 5 * time.Second
+	serverWriteTimeout      = 2 * time.Minute
+	serverShutdownTimeout   = 5 * time.Second
+)
+
+// ErrNonLoopbackAddr is returned when a standalone listener address is not loopback-only.
+var ErrNonLoopbackAddr = errors.New("diagnostics listener must bind to a loopback address")
+
+// GCStats is the JSON payload returned by the GC stats endpoint.
+type GCStats struct {
+	NumGC         int64         `json:"num_gc"`
+	LastGC        time.Time     `json:"last_gc"`
+	PauseTotal    time.Duration `json:"pause_total_ns"`
+	RecentPauses  []int64       `json:"recent_pauses_ns"`
+	HeapAlloc     uint64        `json:"heap_alloc_bytes"`
+	HeapInuse     uint64        `json:"heap_inuse_bytes"`
+	HeapObjects   uint64        `json:"heap_objects"`
+	NextGC        uint64        `json:"next_gc_bytes"`
+	TotalAlloc    uint64        `json:"total_alloc_bytes"`
+	Sys           uint64        `json:"sys_bytes"`
+	NumGoroutine  int           `json:"num_goroutine"`
+	GCCPUFraction float64       `json:"gc_cpu_fraction"`
+	GOMAXPROCS    int           `json:"gomaxprocs"`
+	MemoryLimit   int64         `json:"memory_limit_bytes"`
+}
+
+// NewHandler returns an http.Handler serving pprof profiles, goroutine dumps, and GC stats.
+// Callers are responsible for restricting access to it.
+func NewHandler() http.Handler {
+	mux := http.NewServeMux()
+
+	mux.HandleFunc(PprofPrefix, pprof.Index)
+	mux.HandleFunc(PprofPrefix+"cmdline", pprof.Cmdline)
+	mux.HandleFunc(PprofPrefix+"profile", pprof.Profile)
+	mux.HandleFunc(PprofPrefix+"symbol", pprof.Symbol)
+	mux.HandleFunc(PprofPrefix+"trace", pprof.Trace)
+	mux.HandleFunc(GoroutinesPath, handleGoroutines)
+	mux.HandleFunc(GCStatsPath, handleGCStats)
+
+	return mux
+}
+
+// handleGoroutines writes a full stack dump of all goroutines.
+func handleGoroutines(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+
+	profile := runtimepprof.Lookup("goroutine")
+	if profile == nil {
+		http.Error(w, "goroutine profile unavailable", http.StatusInternalServerError)
+		return
+	}
+
+	_ = profile.WriteTo(w, goroutineDumpDebug)
+}
+
+// handleGCStats writes garbage collector and heap statistics as JSON.
+func handleGCStats(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(CollectGCStats())
+}
+
+// CollectGCStats snapshots current garbage collector and heap statistics.
+func CollectGCStats() GCStats {
+	var gc debug.GCStats
+	debug.ReadGCStats(&gc)
+
+	var mem runtime.MemStats
+	runtime.ReadMemStats(&mem)
+
+	pauses := make([]int64, 0, len(gc.Pause))
+	for _, pause := range gc.Pause {
+		pauses = append(pauses, pause.Nanoseconds())
+	}
+
+	return GCStats{
+		NumGC:         gc.NumGC,
+		LastGC:        gc.LastGC,
+		PauseTotal:    gc.PauseTotal,
+		RecentPauses:  pauses,
+		HeapAlloc:     mem.HeapAlloc,
+		HeapInuse:     mem.HeapInuse,
+		HeapObjects:   mem.HeapObjects,
+		NextGC:        mem.NextGC,
+		TotalAlloc:    mem.TotalAlloc,
+		Sys:           mem.Sys,
+		NumGoroutine:  runtime.NumGoroutine(),
+		GCCPUFraction: mem.GCCPUFraction,
+		GOMAXPROCS:    runtime.GOMAXPROCS(0),
+		MemoryLimit:   debug.SetMemoryLimit(-1),
+	}
+}
+
+// ValidateListenAddr ensures addr binds only to a loopback interface.
+func ValidateListenAddr(addr string) error {
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		return fmt.Errorf("invalid diagnostics listen address %q: %w", addr, err)
+	}
+
+	if host == "localhost" {
+		return nil
+	}
+
+	ip := net.ParseIP(host)
+	if ip == nil || !ip.IsLoopback() {
+		return fmt.Errorf("%w: %q", ErrNonLoopbackAddr, addr)
+	}
+
+	return nil
+}
+
+// Server is a standalone diagnostics listener bound to a loopback address.
+// It requires no authentication because it is unreachable from outside the host.
+type Server struct {
+	addr   string
+	logger *slog.Logger
+	server *http.Server
+}
+
+// NewServer creates a diagnostics server listening on addr.
+func NewServer(addr string, logger *slog.Logger) (*Server, error) {
+	if err := ValidateListenAddr(addr); err != nil {
+		return nil, err
+	}
+	if logger == nil {
+		logger = slog.Default()
+	}
+
+	return &Server{
+		addr:   addr,
+		logger: logger,
+		server: &http.Server{
+			Addr:              addr,
+			Handler:           NewHandler(),
+			ReadHeaderTimeout: serverReadHeaderTimeout,
+			WriteTimeout:      serverWriteTimeout,
+		},
+	}, nil
+}
+
+// Run serves diagnostics until ctx is cancelled, then shuts the listener down.
+func (s *Server) Run(ctx context.Context) error {
+	errCh := make(chan error, 1)
+
+	go func() {
+		s.logger.InfoContext(ctx, "diagnostics listener started", slog.String("address", s.addr))
+		if err := s.server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			errCh <- err
+		}
+		close(errCh)
+	}()
+
+	select {
+	case err := <-errCh:
+		if err != nil {
+			return fmt.Errorf("diagnostics listener: %w", err)
+		}
+		return nil
+	case <-ctx.Done():
+	}
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), serverShutdownTimeout)
+	defer cancel()
+
+	if err := s.server.Shutdown(shutdownCtx); err != nil {
+		return fmt.Errorf("shutdown diagnostics listener: %w", err)
+	}
+
+	s.logger.InfoContext(shutdownCtx, "diagnostics listener stopped")
+	return nil
+}
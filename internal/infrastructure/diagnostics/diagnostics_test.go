@@ -0,0 +1,104 @@
+package diagnostics_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lllypuk/flowra/internal/infrastructure/diagnostics"
+)
+
+func TestNewHandler_Endpoints(t *testing.T) {
+	handler := diagnostics.NewHandler()
+
+	tests := []struct {
+		name        string
+		path        string
+		contentType string
+		contains    string
+	}{
+		{
+			name:        "pprof index",
+			path:        diagnostics.PprofPrefix,
+			contentType: "text/html",
+			contains:    "goroutine",
+		},
+		{
+			name:        "goroutine dump",
+			path:        diagnostics.GoroutinesPath,
+			contentType: "text/plain",
+			contains:    "goroutine ",
+		},
+		{
+			name:        "gc stats",
+			path:        diagnostics.GCStatsPath,
+			contentType: "application/json",
+			contains:    "num_goroutine",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, tt.path, nil)
+			rec := httptest.NewRecorder()
+
+			handler.ServeHTTP(rec, req)
+
+			assert.Equal(t, http.StatusOK, rec.Code)
+			assert.True(t, strings.HasPrefix(rec.Header().Get("Content-Type"), tt.contentType))
+			assert.Contains(t, rec.Body.String(), tt.contains)
+		})
+	}
+}
+
+func TestNewHandler_GCStatsPayload(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, diagnostics.GCStatsPath, nil)
+	rec := httptest.NewRecorder()
+
+	diagnostics.NewHandler().ServeHTTP(rec, req)
+
+	var stats diagnostics.GCStats
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &stats))
+	assert.Positive(t, stats.NumGoroutine)
+	assert.Positive(t, stats.GOMAXPROCS)
+	assert.Positive(t, stats.Sys)
+}
+
+func TestValidateListenAddr(t *testing.T) {
+	tests := []struct {
+		name    string
+		addr    string
+		wantErr bool
+	}{
+		{name: "ipv4 loopback", addr: "127.0.0.1:6060"},
+		{name: "ipv6 loopback", addr: "[::1]:6060"},
+		{name: "localhost", addr: "localhost:6060"},
+		{name: "all interfaces", addr: "0.0.0.0:6060", wantErr: true},
+		{name: "empty host", addr: ":6060", wantErr: true},
+		{name: "public ip", addr: "10.0.0.5:6060", wantErr: true},
+		{name: "missing port", addr: "127.0.0.1", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := diagnostics.ValidateListenAddr(tt.addr)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestNewServer_RejectsNonLoopback(t *testing.T) {
+	server, err := diagnostics.NewServer("0.0.0.0:6060", nil)
+
+	require.ErrorIs(t, err, diagnostics.ErrNonLoopbackAddr)
+	assert.Nil(t, server)
+}
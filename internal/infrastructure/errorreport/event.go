@@ -0,0 +1,128 @@
+// Package errorreport sends recovered panics to an external error tracker.
+//
+// Recovery middleware for HTTP, WebSocket and event bus handlers builds an Event
+// with the panic value, the stack and the request, user and workspace it happened
+// for, and hands it to a reporter. SentryReporter delivers events to any service
+// that accepts the Sentry store API (Sentry, GlitchTip, ...).
+package errorreport
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Sources of reported panics.
+const (
+	SourceHTTP      = "http"
+	SourceWebSocket = "websocket"
+	SourceEventBus  = "eventbus"
+)
+
+// Event is a recovered panic.
+type Event struct {
+	// Source is where the panic was recovered: http, websocket or eventbus.
+	Source string
+
+	// Panic is the value passed to panic.
+	Panic any
+
+	// Stack is the stack trace captured while recovering, as printed by runtime.Stack.
+	Stack []byte
+
+	// RequestID is the ID of the request that panicked, if any.
+	RequestID string
+
+	// UserID is the acting user, if known.
+	UserID string
+
+	// WorkspaceID is the workspace the request was scoped to, if known.
+	WorkspaceID string
+
+	// Tags are additional indexed attributes, e.g. the route or the event handler.
+	Tags map[string]string
+
+	// Timestamp is when the panic was recovered; zero means now.
+	Timestamp time.Time
+}
+
+// Message returns the panic value as text.
+func (e Event) Message() string {
+	if err, ok := e.Panic.(error); ok {
+		return err.Error()
+	}
+	return fmt.Sprint(e.Panic)
+}
+
+// Frame is one call in a parsed stack trace.
+type Frame struct {
+	Function string
+	File     string
+	Line     int
+}
+
+// ParseStack parses the first goroutine of a runtime.Stack dump into frames,
+// innermost call first. Lines it does not recognise are skipped.
+func ParseStack(stack []byte) []Frame {
+	var frames []Frame
+	var function string
+
+	scanner := bufio.NewScanner(bytes.NewReader(stack))
+	for scanner.Scan() {
+		line := scanner.Text()
+		switch {
+		case strings.HasPrefix(line, "goroutine "):
+			if frames != nil || function != "" {
+				return frames
+			}
+		case line == "":
+			if frames != nil {
+				// only the goroutine that panicked matters
+				return frames
+			}
+		case strings.HasPrefix(line, "\t"):
+			if function == "" {
+				continue
+			}
+			file, lineNo := parseLocation(line)
+			frames = append(frames, Frame{Function: function, File: file, Line: lineNo})
+			function = ""
+		default:
+			function = parseFunction(line)
+		}
+	}
+	return frames
+}
+
+// parseFunction strips the arguments from "pkg.fn(0x1, ...)" and the goroutine
+// from "created by pkg.fn in goroutine 7".
+func parseFunction(line string) string {
+	line = strings.TrimPrefix(line, "created by ")
+	if i := strings.Index(line, " in goroutine "); i >= 0 {
+		line = line[:i]
+	}
+	if i := strings.LastIndex(line, "("); i > 0 {
+		line = line[:i]
+	}
+	return line
+}
+
+// parseLocation splits "\t/path/file.go:42 +0x1d" into file and line.
+func parseLocation(line string) (string, int) {
+	line = strings.TrimSpace(line)
+	if i := strings.LastIndex(line, " +0x"); i >= 0 {
+		line = line[:i]
+	}
+	i := strings.LastIndex(line, ":")
+	if i < 0 {
+		return line, 0
+	}
+	lineNo, err := strconv.Atoi(line[i+1:])
+	if err != nil {
+		return line, 0
+	}
+	return line[:i], lineNo
+}
@@ -0,0 +1,40 @@
+package errorreport_test
+
+import (
+	"runtime/debug"
+	"strings"
+	"testing"
+
+	"github.com/lllypuk/flowra/internal/infrastructure/errorreport"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseStack(t *testing.T) {
+	frames := errorreport.ParseStack(debug.Stack())
+
+	require.NotEmpty(t, frames)
+	assert.Equal(t, "runtime/debug.Stack", frames[0].Function)
+	assert.Equal(t, "github.com/lllypuk/flowra/internal/infrastructure/errorreport_test.TestParseStack", frames[1].Function)
+	assert.True(t, strings.HasSuffix(frames[1].File, "event_test.go"))
+	assert.Positive(t, frames[1].Line)
+}
+
+func TestParseStack_FirstGoroutineOnly(t *testing.T) {
+	stack := []byte("goroutine 7 [running]:\n" +
+		"main.handler(0x1, {0x2, 0x3})\n" +
+		"\t/app/main.go:12 +0x1d\n" +
+		"created by main.serve in goroutine 1\n" +
+		"\t/app/main.go:30 +0x5e\n" +
+		"\n" +
+		"goroutine 1 [select]:\n" +
+		"main.main()\n" +
+		"\t/app/main.go:40 +0x10\n")
+
+	frames := errorreport.ParseStack(stack)
+
+	assert.Equal(t, []errorreport.Frame{
+		{Function: "main.handler", File: "/app/main.go", Line: 12},
+		{Function: "main.serve", File: "/app/main.go", Line: 30},
+	}, frames)
+}
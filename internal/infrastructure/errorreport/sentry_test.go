@@ -0,0 +1,112 @@
+package errorreport_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"runtime/debug"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/lllypuk/flowra/internal/infrastructure/errorreport"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewSentryReporter_InvalidDSN(t *testing.T) {
+	for _, dsn := range []string{
+		"",
+		"ftp://key@sentry.example.com/1",
+		"https://sentry.example.com/1",
+		"https://key@sentry.example.com/",
+	} {
+		_, err := errorreport.NewSentryReporter(errorreport.SentryConfig{DSN: dsn}, nil)
+		require.ErrorIs(t, err, errorreport.ErrInvalidDSN, dsn)
+	}
+}
+
+func TestSentryReporter_Report(t *testing.T) {
+	type request struct {
+		path string
+		auth string
+		body map[string]any
+	}
+	received := make(chan request, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]any
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		received <- request{path: r.URL.Path, auth: r.Header.Get("X-Sentry-Auth"), body: body}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	dsn := strings.Replace(server.URL, "://", "://public:secret@", 1) + "/sentry/42"
+	reporter, err := errorreport.NewSentryReporter(errorreport.SentryConfig{
+		DSN:         dsn,
+		Environment: "staging",
+		Release:     "v1.2.3",
+	}, nil)
+	require.NoError(t, err)
+
+	reporter.Report(context.Background(), errorreport.Event{
+		Source:      errorreport.SourceHTTP,
+		Panic:       errors.New("boom"),
+		Stack:       debug.Stack(),
+		RequestID:   "req-1",
+		UserID:      "user-1",
+		WorkspaceID: "ws-1",
+		Tags:        map[string]string{"path": "/api/v1/chats"},
+	})
+	require.NoError(t, reporter.Close(context.Background()))
+
+	req := <-received
+	assert.Equal(t, "/sentry/api/42/store/", req.path)
+	assert.Contains(t, req.auth, "sentry_key=public")
+	assert.Contains(t, req.auth, "sentry_secret=secret")
+	assert.Contains(t, req.auth, "sentry_client=flowra/v1.2.3")
+
+	assert.Len(t, req.body["event_id"], 32)
+	assert.Equal(t, "staging", req.body["environment"])
+	assert.Equal(t, "v1.2.3", req.body["release"])
+	assert.Equal(t, map[string]any{"id": "user-1"}, req.body["user"])
+	assert.Equal(t, map[string]any{
+		"source":       "http",
+		"request_id":   "req-1",
+		"workspace_id": "ws-1",
+		"path":         "/api/v1/chats",
+	}, req.body["tags"])
+
+	exception := req.body["exception"].(map[string]any)["values"].([]any)[0].(map[string]any)
+	assert.Equal(t, "panic", exception["type"])
+	assert.Equal(t, "boom", exception["value"])
+	frames := exception["stacktrace"].(map[string]any)["frames"].([]any)
+	innermost := frames[len(frames)-1].(map[string]any)
+	assert.Equal(t, "runtime/debug.Stack", innermost["function"])
+	caller := frames[len(frames)-2].(map[string]any)
+	assert.Equal(t, true, caller["in_app"])
+}
+
+func TestSentryReporter_DropsBeyondMaxInFlight(t *testing.T) {
+	release := make(chan struct{})
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		calls.Add(1)
+		<-release
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	dsn := strings.Replace(server.URL, "://", "://public@", 1) + "/1"
+	reporter, err := errorreport.NewSentryReporter(errorreport.SentryConfig{DSN: dsn, MaxInFlight: 1}, nil)
+	require.NoError(t, err)
+
+	reporter.Report(context.Background(), errorreport.Event{Source: errorreport.SourceEventBus, Panic: "first"})
+	reporter.Report(context.Background(), errorreport.Event{Source: errorreport.SourceEventBus, Panic: "second"})
+	close(release)
+	require.NoError(t, reporter.Close(context.Background()))
+
+	assert.Equal(t, int32(1), calls.Load())
+}
@@ -0,0 +1,169 @@
+package eventbus
+
+import (
+	"context"
+	"hash/fnv"
+	"sync"
+
+	"github.com/lllypuk/flowra/internal/domain/event"
+)
+
+// Dispatch defaults.
+const (
+	defaultAggregateLanes = 4
+	aggregateLaneQueue    = 256
+)
+
+// Ordering describes the delivery order a handler relies on.
+type Ordering int
+
+const (
+	// OrderingNone delivers events concurrently, in no particular order.
+	OrderingNone Ordering = iota
+
+	// OrderingPerAggregate delivers events of one aggregate one at a time, in the order
+	// they were received. Events of different aggregates are still handled in parallel.
+	OrderingPerAggregate
+)
+
+// String returns the ordering name used in logs.
+func (o Ordering) String() string {
+	switch o {
+	case OrderingNone:
+		return "none"
+	case OrderingPerAggregate:
+		return "per_aggregate"
+	default:
+		return "unknown"
+	}
+}
+
+// HandlerOptions configures how events are dispatched to a handler.
+type HandlerOptions struct {
+	// Name identifies the handler in logs and metrics.
+	Name string
+
+	// Ordering is the delivery order guarantee the handler needs.
+	Ordering Ordering
+
+	// Concurrency caps concurrent invocations of the handler. With OrderingNone,
+	// 0 means unlimited. With OrderingPerAggregate it is the number of aggregate
+	// lanes processed in parallel (default 4).
+	Concurrency int
+
+	// Middleware wraps the handler, outermost first. A subscription with middleware
+	// owns its error handling and the bus calls it once per event; without middleware
+	// the bus retries failed runs according to its RetryConfig.
+	Middleware []HandlerMiddleware
+}
+
+// subscription is a handler together with its dispatch state. One subscription is
+// shared by every event type it was registered for, so ordering holds across types.
+type subscription struct {
+	handler EventHandler
+	opts    HandlerOptions
+
+	// slots limits concurrent invocations for OrderingNone; nil means unlimited.
+	slots chan struct{}
+
+	// lanes serialize events per aggregate for OrderingPerAggregate.
+	lanes     []chan event.DomainEvent
+	lanesOnce sync.Once
+}
+
+func newSubscription(handler EventHandler, opts HandlerOptions) *subscription {
+	if len(opts.Middleware) > 0 {
+		handler = ChainHandler(opts.Name, handler, opts.Middleware...)
+	}
+	sub := &subscription{handler: handler, opts: opts}
+
+	switch opts.Ordering {
+	case OrderingPerAggregate:
+		lanes := opts.Concurrency
+		if lanes <= 0 {
+			lanes = defaultAggregateLanes
+		}
+		sub.lanes = make([]chan event.DomainEvent, lanes)
+		for i := range sub.lanes {
+			sub.lanes[i] = make(chan event.DomainEvent, aggregateLaneQueue)
+		}
+	case OrderingNone:
+		if opts.Concurrency > 0 {
+			sub.slots = make(chan struct{}, opts.Concurrency)
+		}
+	}
+
+	return sub
+}
+
+// lane returns the queue for an aggregate; an aggregate always maps to the same lane.
+func (s *subscription) lane(aggregateID string) chan event.DomainEvent {
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(aggregateID))
+	return s.lanes[h.Sum32()%uint32(len(s.lanes))]
+}
+
+// dispatch hands an event to a subscription according to its ordering.
+func (b *RedisEventBus) dispatch(ctx context.Context, sub *subscription, evt event.DomainEvent, handlerIndex int) {
+	if sub.opts.Ordering == OrderingPerAggregate {
+		b.enqueue(ctx, sub, evt)
+		return
+	}
+
+	b.wg.Add(1)
+	go func() {
+		defer b.wg.Done()
+
+		if sub.slots != nil {
+			select {
+			case sub.slots <- struct{}{}:
+				defer func() { <-sub.slots }()
+			case <-ctx.Done():
+				return
+			}
+		}
+
+		b.executeHandler(ctx, sub, evt, handlerIndex)
+	}()
+}
+
+// enqueue puts an event on its aggregate lane, starting the lane workers on first use.
+// A full lane blocks the caller, applying backpressure instead of reordering events.
+func (b *RedisEventBus) enqueue(ctx context.Context, sub *subscription, evt event.DomainEvent) {
+	sub.lanesOnce.Do(func() {
+		for _, lane := range sub.lanes {
+			b.wg.Add(1)
+			go b.runLane(ctx, sub, lane)
+		}
+	})
+
+	select {
+	case sub.lane(evt.AggregateID()) <- evt:
+	case <-b.shutdown:
+	case <-ctx.Done():
+	}
+}
+
+// runLane handles the events of one lane in order. On shutdown it finishes the
+// events already queued before returning.
+func (b *RedisEventBus) runLane(ctx context.Context, sub *subscription, lane chan event.DomainEvent) {
+	defer b.wg.Done()
+
+	for {
+		select {
+		case evt := <-lane:
+			b.executeHandler(ctx, sub, evt, -1)
+		case <-ctx.Done():
+			return
+		case <-b.shutdown:
+			for {
+				select {
+				case evt := <-lane:
+					b.executeHandler(ctx, sub, evt, -1)
+				default:
+					return
+				}
+			}
+		}
+	}
+}
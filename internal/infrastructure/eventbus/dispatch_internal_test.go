@@ -0,0 +1,123 @@
+package eventbus
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lllypuk/flowra/internal/domain/event"
+)
+
+func newDispatchTestBus() *RedisEventBus {
+	bus := NewRedisEventBus(nil, WithRetryConfig(RetryConfig{MaxRetries: 0}))
+	bus.running = true
+	return bus
+}
+
+func newDispatchTestEvent(aggregateID string, version int) event.DomainEvent {
+	base := event.NewBaseEvent("test.event", aggregateID, "test", version, event.Metadata{})
+	return &base
+}
+
+func TestDispatch_PerAggregateOrdering(t *testing.T) {
+	bus := newDispatchTestBus()
+
+	var mu sync.Mutex
+	seen := map[string][]int{}
+	var inFlight, maxInFlight atomic.Int32
+
+	handler := func(_ context.Context, evt event.DomainEvent) error {
+		if n := inFlight.Add(1); n > maxInFlight.Load() {
+			maxInFlight.Store(n)
+		}
+		defer inFlight.Add(-1)
+		time.Sleep(time.Millisecond)
+
+		mu.Lock()
+		seen[evt.AggregateID()] = append(seen[evt.AggregateID()], evt.Version())
+		mu.Unlock()
+		return nil
+	}
+	sub := newSubscription(handler, HandlerOptions{Name: "projection", Ordering: OrderingPerAggregate, Concurrency: 1})
+
+	ctx := context.Background()
+	for version := 1; version <= 20; version++ {
+		bus.dispatch(ctx, sub, newDispatchTestEvent("agg-a", version), 0)
+		bus.dispatch(ctx, sub, newDispatchTestEvent("agg-b", version), 0)
+	}
+	require.NoError(t, bus.Shutdown())
+
+	expected := make([]int, 0, 20)
+	for version := 1; version <= 20; version++ {
+		expected = append(expected, version)
+	}
+	assert.Equal(t, expected, seen["agg-a"])
+	assert.Equal(t, expected, seen["agg-b"])
+	assert.Equal(t, int32(1), maxInFlight.Load())
+}
+
+func TestDispatch_PerAggregateLanesRunInParallel(t *testing.T) {
+	bus := newDispatchTestBus()
+
+	release := make(chan struct{})
+	var started atomic.Int32
+	handler := func(_ context.Context, _ event.DomainEvent) error {
+		started.Add(1)
+		<-release
+		return nil
+	}
+	sub := newSubscription(handler, HandlerOptions{Ordering: OrderingPerAggregate, Concurrency: 8})
+
+	// Pick two aggregates that land on different lanes.
+	first := "agg-0"
+	second := ""
+	for i := 1; second == ""; i++ {
+		candidate := fmt.Sprintf("agg-%d", i)
+		if sub.lane(candidate) != sub.lane(first) {
+			second = candidate
+		}
+	}
+
+	ctx := context.Background()
+	bus.dispatch(ctx, sub, newDispatchTestEvent(first, 1), 0)
+	bus.dispatch(ctx, sub, newDispatchTestEvent(second, 1), 0)
+
+	assert.Eventually(t, func() bool { return started.Load() == 2 }, time.Second, time.Millisecond)
+	close(release)
+	require.NoError(t, bus.Shutdown())
+}
+
+func TestDispatch_ConcurrencyLimit(t *testing.T) {
+	bus := newDispatchTestBus()
+
+	var inFlight, maxInFlight, calls atomic.Int32
+	var mu sync.Mutex
+	handler := func(_ context.Context, _ event.DomainEvent) error {
+		n := inFlight.Add(1)
+		mu.Lock()
+		if n > maxInFlight.Load() {
+			maxInFlight.Store(n)
+		}
+		mu.Unlock()
+		time.Sleep(2 * time.Millisecond)
+		inFlight.Add(-1)
+		calls.Add(1)
+		return nil
+	}
+	sub := newSubscription(handler, HandlerOptions{Ordering: OrderingNone, Concurrency: 2})
+
+	ctx := context.Background()
+	for i := range 10 {
+		bus.dispatch(ctx, sub, newDispatchTestEvent(fmt.Sprintf("agg-%d", i), 1), 0)
+	}
+	require.NoError(t, bus.Shutdown())
+
+	assert.Equal(t, int32(10), calls.Load())
+	assert.LessOrEqual(t, maxInFlight.Load(), int32(2))
+}
@@ -4,6 +4,7 @@ package eventbus
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"log/slog"
 	"regexp"
@@ -14,6 +15,8 @@ import (
 	"github.com/lllypuk/flowra/internal/domain/message"
 	domainNotif "github.com/lllypuk/flowra/internal/domain/notification"
 	"github.com/lllypuk/flowra/internal/domain/uuid"
+	"github.com/lllypuk/flowra/internal/domain/workspace"
+	"github.com/lllypuk/flowra/internal/infrastructure/i18n"
 	"github.com/redis/go-redis/v9"
 )
 
@@ -24,6 +27,12 @@ const (
 	mentionPatternTemplate = `@([a-zA-Z0-9_-]+)`
 	minMentionMatchGroups  = 2
 	maxPayloadLogLength    = 500
+
+	// notificationHandlerConcurrency bounds parallel notification deliveries.
+	notificationHandlerConcurrency = 16
+
+	// percentScale converts the storage usage ratio to a percentage.
+	percentScale = 100
 )
 
 var mentionRegex = regexp.MustCompile(mentionPatternTemplate)
@@ -43,6 +52,36 @@ type NotificationHandler struct {
 	// userResolver is used to resolve usernames from mentions to user IDs.
 	// If nil, mention resolution will be skipped.
 	userResolver UserResolver
+	// catalog translates notification titles and messages.
+	catalog *i18n.Catalog
+	// localeResolver returns the recipient's preferred locale.
+	// If nil, notifications are created in the default locale.
+	localeResolver UserLocaleResolver
+	// observer is told about every notification created; optional.
+	observer NotificationObserver
+	// adminLister returns the admins to notify about join requests.
+	// If nil, join requests produce no notifications.
+	adminLister WorkspaceAdminLister
+}
+
+// WorkspaceAdminLister lists the users allowed to review workspace join requests.
+// This interface is declared on the consumer side (this handler).
+type WorkspaceAdminLister interface {
+	// ListAdminIDs returns IDs of the workspace owner and admins.
+	ListAdminIDs(ctx context.Context, workspaceID uuid.UUID) ([]uuid.UUID, error)
+}
+
+// NotificationObserver is notified after a notification has been created.
+// This interface is declared on the consumer side (this handler).
+type NotificationObserver interface {
+	NotificationCreated(notifType domainNotif.Type)
+}
+
+// UserLocaleResolver resolves a user's preferred UI locale.
+// This interface is declared on the consumer side (this handler).
+type UserLocaleResolver interface {
+	// UserLocale returns the user's locale, or an empty string if none is set.
+	UserLocale(ctx context.Context, userID uuid.UUID) string
 }
 
 // UserResolver resolves usernames to user IDs.
@@ -70,6 +109,31 @@ func WithUserResolver(resolver UserResolver) NotificationHandlerOption {
 	}
 }
 
+// WithLocalization sets the message catalog and recipient locale resolver
+// used to translate notification text.
+func WithLocalization(catalog *i18n.Catalog, resolver UserLocaleResolver) NotificationHandlerOption {
+	return func(h *NotificationHandler) {
+		if catalog != nil {
+			h.catalog = catalog
+		}
+		h.localeResolver = resolver
+	}
+}
+
+// WithNotificationObserver sets an observer of created notifications, e.g. business metrics.
+func WithNotificationObserver(observer NotificationObserver) NotificationHandlerOption {
+	return func(h *NotificationHandler) {
+		h.observer = observer
+	}
+}
+
+// WithWorkspaceAdminLister sets the lister of admins notified about join requests.
+func WithWorkspaceAdminLister(lister WorkspaceAdminLister) NotificationHandlerOption {
+	return func(h *NotificationHandler) {
+		h.adminLister = lister
+	}
+}
+
 // NewNotificationHandler creates a new NotificationHandler.
 func NewNotificationHandler(
 	createNotifUC *notification.CreateNotificationUseCase,
@@ -78,6 +142,7 @@ func NewNotificationHandler(
 	h := &NotificationHandler{
 		createNotifUC: createNotifUC,
 		logger:        slog.Default(),
+		catalog:       i18n.MustLoad(),
 	}
 
 	for _, opt := range opts {
@@ -96,6 +161,16 @@ func (h *NotificationHandler) Handle(ctx context.Context, evt event.DomainEvent)
 		return h.handleParticipantAdded(ctx, evt)
 	case chat.EventTypeUserAssigned:
 		return h.handleUserAssigned(ctx, evt)
+	case chat.EventTypeSLABreached:
+		return h.handleSLABreached(ctx, evt)
+	case workspace.EventTypeMemberPending:
+		return h.handleMemberPending(ctx, evt)
+	case workspace.EventTypeStorageQuotaWarning:
+		return h.handleStorageQuotaWarning(ctx, evt)
+	case workspace.EventTypeMemberApproved:
+		return h.notifyJoinRequester(ctx, evt, "notify.join_approved")
+	case workspace.EventTypeMemberRejected:
+		return h.notifyJoinRequester(ctx, evt, "notify.join_rejected")
 	case message.EventTypeMessageCreated:
 		return h.handleMessageCreated(ctx, evt)
 	default:
@@ -157,15 +232,16 @@ func (h *NotificationHandler) handleParticipantAdded(ctx context.Context, evt ev
 		return nil
 	}
 
+	t := h.translator(ctx, userID)
 	cmd := notification.CreateNotificationCommand{
 		UserID:     userID,
 		Type:       domainNotif.TypeChatMessage,
-		Title:      "Added to chat",
-		Message:    "You have been added to a new chat",
+		Title:      t("notify.chat_added.title"),
+		Message:    t("notify.chat_added.message"),
 		ResourceID: evt.AggregateID(),
 	}
 
-	if _, execErr := h.createNotifUC.Execute(ctx, cmd); execErr != nil {
+	if execErr := h.createNotification(ctx, cmd); execErr != nil {
 		return fmt.Errorf("failed to create notification for participant added: %w", execErr)
 	}
 
@@ -256,21 +332,228 @@ func (h *NotificationHandler) handleUserAssigned(ctx context.Context, evt event.
 		return nil
 	}
 
+	t := h.translator(ctx, assigneeID)
 	cmd := notification.CreateNotificationCommand{
 		UserID:     assigneeID,
 		Type:       domainNotif.TypeTaskAssigned,
-		Title:      "Task assigned",
-		Message:    "You have been assigned to a task",
+		Title:      t("notify.task_assigned.title"),
+		Message:    t("notify.task_assigned.message"),
 		ResourceID: evt.AggregateID(),
 	}
 
-	if _, execErr := h.createNotifUC.Execute(ctx, cmd); execErr != nil {
+	if execErr := h.createNotification(ctx, cmd); execErr != nil {
 		return fmt.Errorf("failed to create notification for user assigned: %w", execErr)
 	}
 
 	return nil
 }
 
+// handleSLABreached notifies the assignee of a bug, or its reporter while it is
+// unassigned, that an SLA clock ran past its target.
+func (h *NotificationHandler) handleSLABreached(ctx context.Context, evt event.DomainEvent) error {
+	payload, extractErr := h.extractPayload(evt)
+	if extractErr != nil {
+		h.logger.WarnContext(ctx, "failed to extract payload for sla_breached",
+			slog.String("error", extractErr.Error()),
+		)
+		return nil
+	}
+
+	var data struct {
+		Kind       string `json:"kind"`
+		Title      string `json:"title"`
+		AssigneeID string `json:"assignee_id"`
+		CreatedBy  string `json:"created_by"`
+	}
+	if unmarshalErr := json.Unmarshal(payload, &data); unmarshalErr != nil {
+		h.logger.WarnContext(ctx, "failed to unmarshal sla_breached payload",
+			slog.String("error", unmarshalErr.Error()),
+		)
+		return nil
+	}
+
+	recipient := data.AssigneeID
+	if recipient == "" {
+		recipient = data.CreatedBy
+	}
+	userID, parseErr := uuid.ParseUUID(recipient)
+	if parseErr != nil {
+		h.logger.WarnContext(ctx, "invalid recipient in sla_breached",
+			slog.String("user_id", recipient),
+			slog.String("error", parseErr.Error()),
+		)
+		return nil
+	}
+
+	keyPrefix := "notify.sla_resolution_breached"
+	if data.Kind == chat.SLAKindResponse {
+		keyPrefix = "notify.sla_response_breached"
+	}
+
+	t := h.translator(ctx, userID)
+	cmd := notification.CreateNotificationCommand{
+		UserID:     userID,
+		Type:       domainNotif.TypeTaskSLABreached,
+		Title:      t(keyPrefix + ".title"),
+		Message:    t(keyPrefix+".message", data.Title),
+		ResourceID: evt.AggregateID(),
+	}
+
+	if execErr := h.createNotification(ctx, cmd); execErr != nil {
+		return fmt.Errorf("failed to create notification for sla breach: %w", execErr)
+	}
+
+	return nil
+}
+
+// handleMemberPending notifies workspace admins about a new join request.
+func (h *NotificationHandler) handleMemberPending(ctx context.Context, evt event.DomainEvent) error {
+	if h.adminLister == nil {
+		return nil
+	}
+
+	workspaceID, parseErr := uuid.ParseUUID(evt.AggregateID())
+	if parseErr != nil {
+		h.logger.WarnContext(ctx, "invalid workspace ID in member.pending",
+			slog.String("workspace_id", evt.AggregateID()),
+			slog.String("error", parseErr.Error()),
+		)
+		return nil
+	}
+
+	adminIDs, err := h.adminLister.ListAdminIDs(ctx, workspaceID)
+	if err != nil {
+		return fmt.Errorf("failed to list workspace admins: %w", err)
+	}
+
+	for _, adminID := range adminIDs {
+		t := h.translator(ctx, adminID)
+		cmd := notification.CreateNotificationCommand{
+			UserID:     adminID,
+			Type:       domainNotif.TypeWorkspaceInvite,
+			Title:      t("notify.join_request.title"),
+			Message:    t("notify.join_request.message"),
+			ResourceID: workspaceID.String(),
+		}
+		if execErr := h.createNotification(ctx, cmd); execErr != nil {
+			h.logger.WarnContext(ctx, "failed to notify admin about join request",
+				slog.String("admin_id", adminID.String()),
+				slog.String("error", execErr.Error()),
+			)
+		}
+	}
+
+	return nil
+}
+
+// handleStorageQuotaWarning notifies workspace admins that attachments approach the storage quota.
+func (h *NotificationHandler) handleStorageQuotaWarning(ctx context.Context, evt event.DomainEvent) error {
+	if h.adminLister == nil {
+		return nil
+	}
+
+	workspaceID, parseErr := uuid.ParseUUID(evt.AggregateID())
+	if parseErr != nil {
+		h.logger.WarnContext(ctx, "invalid workspace ID in storage.quota_warning",
+			slog.String("workspace_id", evt.AggregateID()),
+			slog.String("error", parseErr.Error()),
+		)
+		return nil
+	}
+
+	payload, extractErr := h.extractPayload(evt)
+	if extractErr != nil {
+		h.logger.WarnContext(ctx, "failed to extract payload for storage.quota_warning",
+			slog.String("error", extractErr.Error()),
+		)
+		return nil
+	}
+
+	var data struct {
+		UsedBytes  int64
+		QuotaBytes int64
+	}
+	if unmarshalErr := json.Unmarshal(payload, &data); unmarshalErr != nil || data.QuotaBytes <= 0 {
+		h.logger.WarnContext(ctx, "invalid storage.quota_warning payload",
+			slog.String("workspace_id", workspaceID.String()),
+		)
+		return nil
+	}
+	usedPercent := data.UsedBytes * percentScale / data.QuotaBytes
+
+	adminIDs, err := h.adminLister.ListAdminIDs(ctx, workspaceID)
+	if err != nil {
+		return fmt.Errorf("failed to list workspace admins: %w", err)
+	}
+
+	for _, adminID := range adminIDs {
+		t := h.translator(ctx, adminID)
+		cmd := notification.CreateNotificationCommand{
+			UserID:     adminID,
+			Type:       domainNotif.TypeSystem,
+			Title:      t("notify.storage_quota.title"),
+			Message:    t("notify.storage_quota.message", usedPercent),
+			ResourceID: workspaceID.String(),
+		}
+		if execErr := h.createNotification(ctx, cmd); execErr != nil {
+			h.logger.WarnContext(ctx, "failed to notify admin about storage quota",
+				slog.String("admin_id", adminID.String()),
+				slog.String("error", execErr.Error()),
+			)
+		}
+	}
+
+	return nil
+}
+
+// notifyJoinRequester tells the requester that their join request was reviewed.
+// keyPrefix selects the title and message of the notification.
+func (h *NotificationHandler) notifyJoinRequester(ctx context.Context, evt event.DomainEvent, keyPrefix string) error {
+	payload, extractErr := h.extractPayload(evt)
+	if extractErr != nil {
+		h.logger.WarnContext(ctx, "failed to extract payload for join request review",
+			slog.String("event_type", evt.EventType()),
+			slog.String("error", extractErr.Error()),
+		)
+		return nil
+	}
+
+	var data struct {
+		UserID string `json:"UserID"`
+	}
+	if unmarshalErr := json.Unmarshal(payload, &data); unmarshalErr != nil {
+		h.logger.WarnContext(ctx, "failed to unmarshal join request review payload",
+			slog.String("event_type", evt.EventType()),
+			slog.String("error", unmarshalErr.Error()),
+		)
+		return nil
+	}
+
+	userID, parseErr := uuid.ParseUUID(data.UserID)
+	if parseErr != nil {
+		h.logger.WarnContext(ctx, "invalid user ID in join request review",
+			slog.String("user_id", data.UserID),
+			slog.String("error", parseErr.Error()),
+		)
+		return nil
+	}
+
+	t := h.translator(ctx, userID)
+	cmd := notification.CreateNotificationCommand{
+		UserID:     userID,
+		Type:       domainNotif.TypeWorkspaceInvite,
+		Title:      t(keyPrefix + ".title"),
+		Message:    t(keyPrefix + ".message"),
+		ResourceID: evt.AggregateID(),
+	}
+
+	if execErr := h.createNotification(ctx, cmd); execErr != nil {
+		return fmt.Errorf("failed to create notification for join request review: %w", execErr)
+	}
+
+	return nil
+}
+
 // extractMentions extracts @mentions from message content.
 func (h *NotificationHandler) extractMentions(content string) []string {
 	matches := mentionRegex.FindAllStringSubmatch(content, -1)
@@ -321,21 +604,42 @@ func (h *NotificationHandler) notifyMentionedUser(
 		return nil
 	}
 
+	t := h.translator(ctx, userID)
 	cmd := notification.CreateNotificationCommand{
 		UserID:     userID,
 		Type:       domainNotif.TypeChatMention,
-		Title:      "You were mentioned",
-		Message:    fmt.Sprintf("@%s mentioned you in a chat", username),
+		Title:      t("notify.mention.title"),
+		Message:    t("notify.mention.message", username),
 		ResourceID: messageID,
 	}
 
-	if _, execErr := h.createNotifUC.Execute(ctx, cmd); execErr != nil {
+	if execErr := h.createNotification(ctx, cmd); execErr != nil {
 		return fmt.Errorf("failed to create mention notification: %w", execErr)
 	}
 
 	return nil
 }
 
+// createNotification creates a notification and reports it to the observer.
+func (h *NotificationHandler) createNotification(ctx context.Context, cmd notification.CreateNotificationCommand) error {
+	if _, err := h.createNotifUC.Execute(ctx, cmd); err != nil {
+		return err
+	}
+	if h.observer != nil {
+		h.observer.NotificationCreated(cmd.Type)
+	}
+	return nil
+}
+
+// translator returns a translation func for the recipient's preferred locale.
+func (h *NotificationHandler) translator(ctx context.Context, userID uuid.UUID) func(string, ...any) string {
+	var locale string
+	if h.localeResolver != nil {
+		locale = h.localeResolver.UserLocale(ctx, userID)
+	}
+	return h.catalog.Translator(h.catalog.Resolve(locale, ""))
+}
+
 // extractPayload extracts raw JSON payload from an event.
 func (h *NotificationHandler) extractPayload(evt event.DomainEvent) (json.RawMessage, error) {
 	if pe, ok := evt.(PayloadEvent); ok {
@@ -388,6 +692,9 @@ func (h *LoggingHandler) Handle(ctx context.Context, evt event.DomainEvent) erro
 	if metadata.CorrelationID != "" {
 		attrs = append(attrs, slog.String("correlation_id", metadata.CorrelationID))
 	}
+	if metadata.IPAddress != "" {
+		attrs = append(attrs, slog.String("ip_address", metadata.IPAddress))
+	}
 
 	// Add payload if available
 	if pe, ok := evt.(PayloadEvent); ok {
@@ -556,6 +863,7 @@ type HandlerRegistry struct {
 	bus        *RedisEventBus
 	logger     *slog.Logger
 	dlqHandler *DeadLetterHandler
+	middleware []HandlerMiddleware
 }
 
 // NewHandlerRegistry creates a new HandlerRegistry.
@@ -571,7 +879,15 @@ func (r *HandlerRegistry) SetDeadLetterHandler(dlq *DeadLetterHandler) {
 	r.dlqHandler = dlq
 }
 
+// Use adds middleware applied to every handler registered with RegisterWithOptions
+// afterwards. The first middleware is the outermost one.
+func (r *HandlerRegistry) Use(middleware ...HandlerMiddleware) {
+	r.middleware = append(r.middleware, middleware...)
+}
+
 // Register registers an event handler for specific event types.
+// The handler is invoked concurrently with no ordering guarantee. It is not named, so
+// registry middleware is not applied; the bus retries it with its own RetryConfig.
 func (r *HandlerRegistry) Register(eventTypes []string, handler EventHandler) error {
 	for _, eventType := range eventTypes {
 		if err := r.bus.Subscribe(eventType, handler); err != nil {
@@ -584,33 +900,68 @@ func (r *HandlerRegistry) Register(eventTypes []string, handler EventHandler) er
 	return nil
 }
 
+// RegisterWithOptions registers an event handler for specific event types with its own
+// concurrency and ordering, e.g. serial per aggregate for projections and bounded
+// parallelism for notifications, so a slow handler does not hold up the others.
+// Registry middleware runs outside any middleware already set in opts.
+func (r *HandlerRegistry) RegisterWithOptions(eventTypes []string, handler EventHandler, opts HandlerOptions) error {
+	if len(r.middleware) > 0 {
+		if opts.Name == "" {
+			return errors.New("handler name is required when middleware is used")
+		}
+		opts.Middleware = append(append([]HandlerMiddleware(nil), r.middleware...), opts.Middleware...)
+	}
+	if err := r.bus.SubscribeWithOptions(eventTypes, handler, opts); err != nil {
+		return fmt.Errorf("failed to subscribe %s: %w", opts.Name, err)
+	}
+	r.logger.Debug("registered handler",
+		slog.String("handler", opts.Name),
+		slog.String("ordering", opts.Ordering.String()),
+		slog.Int("concurrency", opts.Concurrency),
+		slog.Any("event_types", eventTypes),
+	)
+	return nil
+}
+
 // RegisterNotificationHandler registers the notification handler for relevant events.
 func (r *HandlerRegistry) RegisterNotificationHandler(handler *NotificationHandler) error {
 	eventTypes := []string{
 		chat.EventTypeChatCreated,
 		chat.EventTypeParticipantAdded,
 		chat.EventTypeUserAssigned,
+		chat.EventTypeSLABreached,
 		message.EventTypeMessageCreated,
+		workspace.EventTypeMemberPending,
+		workspace.EventTypeMemberApproved,
+		workspace.EventTypeMemberRejected,
+		workspace.EventTypeStorageQuotaWarning,
 	}
 
-	return r.Register(eventTypes, handler.AsEventHandler())
+	return r.RegisterWithOptions(eventTypes, handler.AsEventHandler(), HandlerOptions{
+		Name:        "notifications",
+		Ordering:    OrderingNone,
+		Concurrency: notificationHandlerConcurrency,
+	})
 }
 
 // RegisterLoggingHandler registers the logging handler for specified event types.
 // Note: Redis Pub/Sub doesn't support wildcards natively, so you need to specify
 // all event types explicitly.
 func (r *HandlerRegistry) RegisterLoggingHandler(handler *LoggingHandler, eventTypes []string) error {
-	return r.Register(eventTypes, handler.AsEventHandler())
+	return r.RegisterWithOptions(eventTypes, handler.AsEventHandler(), HandlerOptions{Name: "logging"})
 }
 
 // RegisterAllHandlers is a convenience function that registers all standard handlers.
+// The middleware (see DefaultMiddleware) wraps every one of them.
 func RegisterAllHandlers(
 	bus *RedisEventBus,
 	notifHandler *NotificationHandler,
 	logHandler *LoggingHandler,
 	logger *slog.Logger,
+	middleware ...HandlerMiddleware,
 ) error {
 	registry := NewHandlerRegistry(bus, logger)
+	registry.Use(middleware...)
 
 	// Register notification handler
 	if notifHandler != nil {
@@ -16,7 +16,9 @@ import (
 	"github.com/lllypuk/flowra/internal/domain/message"
 	domainNotif "github.com/lllypuk/flowra/internal/domain/notification"
 	"github.com/lllypuk/flowra/internal/domain/uuid"
+	"github.com/lllypuk/flowra/internal/domain/workspace"
 	"github.com/lllypuk/flowra/internal/infrastructure/eventbus"
+	"github.com/lllypuk/flowra/internal/infrastructure/i18n"
 	"github.com/lllypuk/flowra/tests/testutil"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -144,6 +146,12 @@ func (r *mockNotificationRepository) FindByResourceID(
 	return nil, nil
 }
 
+func (r *mockNotificationRepository) FindReadSince(
+	_ context.Context, _ uuid.UUID, _ time.Time, _ int,
+) ([]*domainNotif.Notification, error) {
+	return nil, nil
+}
+
 func (r *mockNotificationRepository) CountUnreadByUserID(_ context.Context, _ uuid.UUID) (int, error) {
 	return 0, nil
 }
@@ -188,6 +196,15 @@ func (r *mockUserResolver) ResolveUsername(_ context.Context, username string) (
 	return "", nil
 }
 
+// mockLocaleResolver implements eventbus.UserLocaleResolver for testing.
+type mockLocaleResolver struct {
+	locales map[uuid.UUID]string
+}
+
+func (r *mockLocaleResolver) UserLocale(_ context.Context, userID uuid.UUID) string {
+	return r.locales[userID]
+}
+
 // testPayloadEvent wraps an event with a JSON payload for testing handlers.
 type testPayloadEvent struct {
 	event.BaseEvent
@@ -239,6 +256,14 @@ func TestNotificationHandler_NewNotificationHandler(t *testing.T) {
 	})
 }
 
+type recordingNotificationObserver struct {
+	types []domainNotif.Type
+}
+
+func (o *recordingNotificationObserver) NotificationCreated(notifType domainNotif.Type) {
+	o.types = append(o.types, notifType)
+}
+
 func TestNotificationHandler_HandleParticipantAdded(t *testing.T) {
 	t.Run("creates notification for added participant", func(t *testing.T) {
 		repo := newMockNotificationRepository()
@@ -264,6 +289,25 @@ func TestNotificationHandler_HandleParticipantAdded(t *testing.T) {
 		assert.Equal(t, domainNotif.TypeChatMessage, notifications[0].Type())
 	})
 
+	t.Run("reports created notification to observer", func(t *testing.T) {
+		repo := newMockNotificationRepository()
+		uc := notification.NewCreateNotificationUseCase(repo)
+		observer := &recordingNotificationObserver{}
+		handler := eventbus.NewNotificationHandler(uc, eventbus.WithNotificationObserver(observer))
+
+		evt := newTestPayloadEvent(
+			chat.EventTypeParticipantAdded,
+			"chat-123",
+			map[string]any{
+				"UserID": uuid.NewUUID().String(),
+				"Role":   "member",
+			},
+		)
+
+		require.NoError(t, handler.Handle(context.Background(), evt))
+		assert.Equal(t, []domainNotif.Type{domainNotif.TypeChatMessage}, observer.types)
+	})
+
 	t.Run("skips notification when user adds themselves", func(t *testing.T) {
 		repo := newMockNotificationRepository()
 		uc := notification.NewCreateNotificationUseCase(repo)
@@ -546,6 +590,50 @@ func TestNotificationHandler_HandleUserAssigned(t *testing.T) {
 	})
 }
 
+func TestNotificationHandler_HandleSLABreached(t *testing.T) {
+	t.Run("notifies assignee", func(t *testing.T) {
+		repo := newMockNotificationRepository()
+		handler := eventbus.NewNotificationHandler(notification.NewCreateNotificationUseCase(repo))
+
+		chatID, assigneeID := uuid.NewUUID(), uuid.NewUUID()
+		evt := chat.NewSLABreached(
+			chatID, uuid.NewUUID(), chat.SLAKindResponse, "Critical", "Login fails",
+			time.Now(), &assigneeID, uuid.NewUUID(), 3, event.Metadata{},
+		)
+
+		require.NoError(t, handler.Handle(context.Background(), evt))
+
+		notifications := repo.GetNotifications()
+		require.Len(t, notifications, 1)
+		assert.Equal(t, assigneeID, notifications[0].UserID())
+		assert.Equal(t, domainNotif.TypeTaskSLABreached, notifications[0].Type())
+		assert.Equal(t, chatID.String(), notifications[0].ResourceID())
+		assert.Contains(t, notifications[0].Message(), "Login fails")
+	})
+
+	t.Run("falls back to reporter while unassigned", func(t *testing.T) {
+		repo := newMockNotificationRepository()
+		handler := eventbus.NewNotificationHandler(notification.NewCreateNotificationUseCase(repo))
+
+		reporterID := uuid.NewUUID()
+		evt := newTestPayloadEvent(
+			chat.EventTypeSLABreached,
+			uuid.NewUUID().String(),
+			map[string]any{
+				"kind":       chat.SLAKindResolution,
+				"title":      "Crash on save",
+				"created_by": reporterID.String(),
+			},
+		)
+
+		require.NoError(t, handler.Handle(context.Background(), evt))
+
+		notifications := repo.GetNotifications()
+		require.Len(t, notifications, 1)
+		assert.Equal(t, reporterID, notifications[0].UserID())
+	})
+}
+
 func TestNotificationHandler_ChatAssignmentStatusRegression(t *testing.T) {
 	t.Run("assignment emits notification while status change does not", func(t *testing.T) {
 		repo := newMockNotificationRepository()
@@ -605,6 +693,118 @@ func TestNotificationHandler_ChatAssignmentStatusRegression(t *testing.T) {
 	})
 }
 
+type stubWorkspaceAdminLister struct {
+	adminIDs []uuid.UUID
+}
+
+func (s stubWorkspaceAdminLister) ListAdminIDs(context.Context, uuid.UUID) ([]uuid.UUID, error) {
+	return s.adminIDs, nil
+}
+
+func TestNotificationHandler_HandleMemberPending(t *testing.T) {
+	workspaceID := uuid.NewUUID()
+	evt := newTestPayloadEvent(
+		workspace.EventTypeMemberPending,
+		workspaceID.String(),
+		map[string]any{
+			"UserID": uuid.NewUUID().String(),
+			"Role":   "member",
+		},
+	)
+
+	t.Run("notifies every workspace admin", func(t *testing.T) {
+		repo := newMockNotificationRepository()
+		uc := notification.NewCreateNotificationUseCase(repo)
+		adminIDs := []uuid.UUID{uuid.NewUUID(), uuid.NewUUID()}
+		handler := eventbus.NewNotificationHandler(
+			uc,
+			eventbus.WithWorkspaceAdminLister(stubWorkspaceAdminLister{adminIDs: adminIDs}),
+		)
+
+		require.NoError(t, handler.Handle(context.Background(), evt))
+
+		notifications := repo.GetNotifications()
+		require.Len(t, notifications, 2)
+		for _, n := range notifications {
+			assert.Contains(t, adminIDs, n.UserID())
+			assert.Equal(t, domainNotif.TypeWorkspaceInvite, n.Type())
+			assert.Equal(t, workspaceID.String(), n.ResourceID())
+		}
+	})
+
+	t.Run("skips without admin lister", func(t *testing.T) {
+		repo := newMockNotificationRepository()
+		handler := eventbus.NewNotificationHandler(notification.NewCreateNotificationUseCase(repo))
+
+		require.NoError(t, handler.Handle(context.Background(), evt))
+		assert.Empty(t, repo.GetNotifications())
+	})
+}
+
+func TestNotificationHandler_HandleStorageQuotaWarning(t *testing.T) {
+	workspaceID := uuid.NewUUID()
+	evt := newTestPayloadEvent(
+		workspace.EventTypeStorageQuotaWarning,
+		workspaceID.String(),
+		map[string]any{
+			"UsedBytes":  int64(950),
+			"QuotaBytes": int64(1000),
+		},
+	)
+
+	t.Run("notifies every workspace admin", func(t *testing.T) {
+		repo := newMockNotificationRepository()
+		adminIDs := []uuid.UUID{uuid.NewUUID(), uuid.NewUUID()}
+		handler := eventbus.NewNotificationHandler(
+			notification.NewCreateNotificationUseCase(repo),
+			eventbus.WithWorkspaceAdminLister(stubWorkspaceAdminLister{adminIDs: adminIDs}),
+		)
+
+		require.NoError(t, handler.Handle(context.Background(), evt))
+
+		notifications := repo.GetNotifications()
+		require.Len(t, notifications, 2)
+		for _, n := range notifications {
+			assert.Contains(t, adminIDs, n.UserID())
+			assert.Equal(t, domainNotif.TypeSystem, n.Type())
+			assert.Equal(t, workspaceID.String(), n.ResourceID())
+		}
+	})
+
+	t.Run("skips without admin lister", func(t *testing.T) {
+		repo := newMockNotificationRepository()
+		handler := eventbus.NewNotificationHandler(notification.NewCreateNotificationUseCase(repo))
+
+		require.NoError(t, handler.Handle(context.Background(), evt))
+		assert.Empty(t, repo.GetNotifications())
+	})
+}
+
+func TestNotificationHandler_HandleJoinRequestReviewed(t *testing.T) {
+	for _, eventType := range []string{workspace.EventTypeMemberApproved, workspace.EventTypeMemberRejected} {
+		t.Run(eventType, func(t *testing.T) {
+			repo := newMockNotificationRepository()
+			handler := eventbus.NewNotificationHandler(notification.NewCreateNotificationUseCase(repo))
+
+			workspaceID := uuid.NewUUID()
+			requesterID := uuid.NewUUID()
+			evt := newTestPayloadEvent(
+				eventType,
+				workspaceID.String(),
+				map[string]any{"UserID": requesterID.String()},
+			)
+
+			require.NoError(t, handler.Handle(context.Background(), evt))
+
+			notifications := repo.GetNotifications()
+			require.Len(t, notifications, 1)
+			assert.Equal(t, requesterID, notifications[0].UserID())
+			assert.Equal(t, domainNotif.TypeWorkspaceInvite, notifications[0].Type())
+			assert.Equal(t, workspaceID.String(), notifications[0].ResourceID())
+		})
+	}
+}
+
 func TestNotificationHandler_HandleChatCreated(t *testing.T) {
 	t.Run("logs chat created event", func(t *testing.T) {
 		repo := newMockNotificationRepository()
@@ -635,6 +835,61 @@ func TestNotificationHandler_HandleChatCreated(t *testing.T) {
 	})
 }
 
+func TestNotificationHandler_Localization(t *testing.T) {
+	t.Run("uses recipient locale", func(t *testing.T) {
+		repo := newMockNotificationRepository()
+		uc := notification.NewCreateNotificationUseCase(repo)
+		resolver := newMockUserResolver()
+
+		mentionedUserID := uuid.NewUUID()
+		resolver.AddUser("ivan", mentionedUserID)
+		locales := &mockLocaleResolver{locales: map[uuid.UUID]string{mentionedUserID: "ru"}}
+
+		handler := eventbus.NewNotificationHandler(uc,
+			eventbus.WithUserResolver(resolver),
+			eventbus.WithLocalization(i18n.MustLoad(), locales),
+		)
+
+		evt := newTestPayloadEvent(
+			message.EventTypeMessageCreated,
+			"msg-123",
+			map[string]any{
+				"ChatID":   "chat-456",
+				"AuthorID": uuid.NewUUID().String(),
+				"Content":  "Hi @ivan",
+			},
+		)
+
+		require.NoError(t, handler.Handle(context.Background(), evt))
+
+		notifications := repo.GetNotifications()
+		require.Len(t, notifications, 1)
+		assert.Equal(t, "Вас упомянули", notifications[0].Title())
+		assert.Equal(t, "@ivan упомянул вас в чате", notifications[0].Message())
+	})
+
+	t.Run("falls back to default locale", func(t *testing.T) {
+		repo := newMockNotificationRepository()
+		uc := notification.NewCreateNotificationUseCase(repo)
+		handler := eventbus.NewNotificationHandler(uc,
+			eventbus.WithLocalization(nil, &mockLocaleResolver{}),
+		)
+
+		evt := newTestPayloadEvent(
+			chat.EventTypeUserAssigned,
+			"chat-123",
+			map[string]any{"assignee_id": uuid.NewUUID().String()},
+		)
+
+		require.NoError(t, handler.Handle(context.Background(), evt))
+
+		notifications := repo.GetNotifications()
+		require.Len(t, notifications, 1)
+		assert.Equal(t, "Task assigned", notifications[0].Title())
+		assert.Equal(t, "You have been assigned to a task", notifications[0].Message())
+	})
+}
+
 func TestNotificationHandler_HandleUnknownEvent(t *testing.T) {
 	t.Run("ignores unknown event types", func(t *testing.T) {
 		repo := newMockNotificationRepository()
@@ -1132,7 +1387,12 @@ func TestHandlerRegistry_RegisterNotificationHandler(t *testing.T) {
 		assert.Equal(t, 1, bus.HandlerCount(chat.EventTypeChatCreated))
 		assert.Equal(t, 1, bus.HandlerCount(chat.EventTypeParticipantAdded))
 		assert.Equal(t, 1, bus.HandlerCount(chat.EventTypeUserAssigned))
+		assert.Equal(t, 1, bus.HandlerCount(chat.EventTypeSLABreached))
 		assert.Equal(t, 1, bus.HandlerCount(message.EventTypeMessageCreated))
+		assert.Equal(t, 1, bus.HandlerCount(workspace.EventTypeMemberPending))
+		assert.Equal(t, 1, bus.HandlerCount(workspace.EventTypeMemberApproved))
+		assert.Equal(t, 1, bus.HandlerCount(workspace.EventTypeMemberRejected))
+		assert.Equal(t, 1, bus.HandlerCount(workspace.EventTypeStorageQuotaWarning))
 	})
 }
 
@@ -0,0 +1,304 @@
+package eventbus
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"runtime/debug"
+	"strconv"
+	"time"
+
+	"github.com/lllypuk/flowra/internal/application/appcore"
+	"github.com/lllypuk/flowra/internal/domain/event"
+	"github.com/lllypuk/flowra/internal/infrastructure/errorreport"
+	"github.com/lllypuk/flowra/internal/pkg/retry"
+	"github.com/redis/go-redis/v9"
+)
+
+// Handler middleware defaults.
+const (
+	defaultDedupTTL       = 24 * time.Hour
+	defaultDedupKeyPrefix = "events:processed:"
+)
+
+// ErrHandlerPanic is returned when an event handler panics.
+var ErrHandlerPanic = errors.New("event handler panicked")
+
+// HandlerMiddleware wraps an event handler. name is the handler name from
+// HandlerOptions and is used in logs, metric labels and deduplication keys.
+type HandlerMiddleware func(name string, next EventHandler) EventHandler
+
+// ChainHandler wraps handler with middleware. The first middleware is the outermost one.
+func ChainHandler(name string, handler EventHandler, middleware ...HandlerMiddleware) EventHandler {
+	for i := len(middleware) - 1; i >= 0; i-- {
+		handler = middleware[i](name, handler)
+	}
+	return handler
+}
+
+// PanicReporter sends recovered panics to an external error tracker.
+// Declared on the consumer side per project guidelines.
+type PanicReporter interface {
+	Report(ctx context.Context, evt errorreport.Event)
+}
+
+// RecoverMiddleware turns a handler panic into an ErrHandlerPanic error, logs the stack
+// and hands the panic to reporter. A nil reporter only logs.
+func RecoverMiddleware(logger *slog.Logger, reporter PanicReporter) HandlerMiddleware {
+	return func(name string, next EventHandler) EventHandler {
+		return func(ctx context.Context, evt event.DomainEvent) (err error) {
+			defer func() {
+				if recovered := recover(); recovered != nil {
+					stack := debug.Stack()
+					logger.ErrorContext(ctx, "event handler panicked",
+						slog.String("handler", name),
+						slog.String("event_type", evt.EventType()),
+						slog.String("aggregate_id", evt.AggregateID()),
+						slog.Any("panic", recovered),
+						slog.String("stack", string(stack)),
+					)
+					if reporter != nil {
+						reporter.Report(ctx, handlerPanicEvent(ctx, name, evt, recovered, stack))
+					}
+					err = fmt.Errorf("%w: %v", ErrHandlerPanic, recovered)
+				}
+			}()
+			return next(ctx, evt)
+		}
+	}
+}
+
+// handlerPanicEvent describes a panic of handler name while handling evt.
+func handlerPanicEvent(
+	ctx context.Context,
+	name string,
+	evt event.DomainEvent,
+	recovered any,
+	stack []byte,
+) errorreport.Event {
+	metadata := evt.Metadata()
+	report := errorreport.Event{
+		Source:    errorreport.SourceEventBus,
+		Panic:     recovered,
+		Stack:     stack,
+		RequestID: appcore.GetRequestID(ctx),
+		UserID:    metadata.UserID,
+		Tags: map[string]string{
+			"handler":        name,
+			"event_type":     evt.EventType(),
+			"aggregate_type": evt.AggregateType(),
+			"aggregate_id":   evt.AggregateID(),
+		},
+	}
+	if metadata.CorrelationID != "" {
+		report.Tags["correlation_id"] = metadata.CorrelationID
+	}
+	if workspaceID, err := appcore.GetWorkspaceID(ctx); err == nil {
+		report.WorkspaceID = workspaceID.String()
+	}
+	return report
+}
+
+// HandlerObserver records event handler outcomes.
+// Declared on the consumer side per project guidelines.
+type HandlerObserver interface {
+	// ObserveEventHandler records one handler invocation, including its retries.
+	ObserveEventHandler(handler, eventType string, duration time.Duration, err error)
+}
+
+// MetricsMiddleware reports every invocation to the observer.
+func MetricsMiddleware(observer HandlerObserver) HandlerMiddleware {
+	return func(name string, next EventHandler) EventHandler {
+		return func(ctx context.Context, evt event.DomainEvent) error {
+			start := time.Now()
+			err := next(ctx, evt)
+			observer.ObserveEventHandler(name, evt.EventType(), time.Since(start), err)
+			return err
+		}
+	}
+}
+
+// TracingMiddleware carries the event's correlation ID into the handler context, so that
+// events published by the handler stay on the same trace, and logs each handler run.
+func TracingMiddleware(logger *slog.Logger) HandlerMiddleware {
+	return func(name string, next EventHandler) EventHandler {
+		return func(ctx context.Context, evt event.DomainEvent) error {
+			metadata := evt.Metadata()
+			if metadata.CorrelationID != "" {
+				ctx = appcore.WithCorrelationID(ctx, metadata.CorrelationID)
+				if appcore.GetTraceID(ctx) == "" {
+					ctx = appcore.WithTraceID(ctx, metadata.CorrelationID)
+				}
+			}
+
+			start := time.Now()
+			err := next(ctx, evt)
+			logger.DebugContext(ctx, "event handler span",
+				slog.String("handler", name),
+				slog.String("event_type", evt.EventType()),
+				slog.String("aggregate_id", evt.AggregateID()),
+				slog.Int("version", evt.Version()),
+				slog.String("correlation_id", metadata.CorrelationID),
+				slog.Duration("duration", time.Since(start)),
+				slog.Bool("success", err == nil),
+			)
+			return err
+		}
+	}
+}
+
+// DedupStore remembers which events a handler has already processed.
+// Declared on the consumer side per project guidelines.
+type DedupStore interface {
+	// Claim records key and reports whether it was not recorded before.
+	Claim(ctx context.Context, key string, ttl time.Duration) (bool, error)
+
+	// Release forgets key so that the event can be handled again.
+	Release(ctx context.Context, key string) error
+}
+
+// DedupMiddleware skips events the handler already processed, e.g. after a Redis
+// reconnect or an outbox republish. An event is identified by handler, event type,
+// aggregate and version. A failed run releases its claim so a redelivery is handled.
+// Store errors do not block delivery: the handler runs without deduplication.
+func DedupMiddleware(store DedupStore, ttl time.Duration, logger *slog.Logger) HandlerMiddleware {
+	if ttl <= 0 {
+		ttl = defaultDedupTTL
+	}
+
+	return func(name string, next EventHandler) EventHandler {
+		return func(ctx context.Context, evt event.DomainEvent) error {
+			key := dedupKey(name, evt)
+
+			claimed, err := store.Claim(ctx, key, ttl)
+			if err != nil {
+				logger.WarnContext(ctx, "event dedup check failed, handling anyway",
+					slog.String("handler", name),
+					slog.String("event_type", evt.EventType()),
+					slog.String("error", err.Error()),
+				)
+				return next(ctx, evt)
+			}
+			if !claimed {
+				logger.DebugContext(ctx, "skipping duplicate event",
+					slog.String("handler", name),
+					slog.String("event_type", evt.EventType()),
+					slog.String("aggregate_id", evt.AggregateID()),
+					slog.Int("version", evt.Version()),
+				)
+				return nil
+			}
+
+			handlerErr := next(ctx, evt)
+			if handlerErr != nil {
+				if releaseErr := store.Release(context.WithoutCancel(ctx), key); releaseErr != nil {
+					logger.WarnContext(ctx, "failed to release event dedup claim",
+						slog.String("handler", name),
+						slog.String("error", releaseErr.Error()),
+					)
+				}
+			}
+			return handlerErr
+		}
+	}
+}
+
+// dedupKey identifies one event for one handler.
+func dedupKey(name string, evt event.DomainEvent) string {
+	return name + ":" + evt.EventType() + ":" + evt.AggregateID() + ":" + strconv.Itoa(evt.Version())
+}
+
+// RetryMiddleware retries failed handler runs with the given policy.
+func RetryMiddleware(policy retry.Policy, logger *slog.Logger) HandlerMiddleware {
+	return func(name string, next EventHandler) EventHandler {
+		return func(ctx context.Context, evt event.DomainEvent) error {
+			attemptPolicy := policy
+			attemptPolicy.OnRetry = func(attempt int, err error, delay time.Duration) {
+				logger.WarnContext(ctx, "event handler failed, retrying",
+					slog.String("handler", name),
+					slog.String("event_type", evt.EventType()),
+					slog.String("aggregate_id", evt.AggregateID()),
+					slog.Int("attempt", attempt),
+					slog.Duration("backoff", delay),
+					slog.String("error", err.Error()),
+				)
+			}
+			return retry.Do(ctx, attemptPolicy, func(ctx context.Context) error {
+				return next(ctx, evt)
+			})
+		}
+	}
+}
+
+// MiddlewareConfig selects the standard handler middleware.
+type MiddlewareConfig struct {
+	// Logger is used by every middleware.
+	Logger *slog.Logger
+
+	// Observer records handler metrics; nil disables metrics.
+	Observer HandlerObserver
+
+	// Dedup stores processed events; nil disables deduplication.
+	Dedup DedupStore
+
+	// DedupTTL is how long a processed event is remembered (default 24h).
+	DedupTTL time.Duration
+
+	// Retry is the retry policy for failed handler runs.
+	Retry retry.Policy
+
+	// Reporter receives recovered handler panics; nil only logs them.
+	Reporter PanicReporter
+}
+
+// DefaultMiddleware returns the standard handler chain, outermost first:
+// tracing, metrics, deduplication, retries and panic recovery. Recovery is innermost
+// so that a panic counts as a failed attempt and is retried like any other error.
+func DefaultMiddleware(config MiddlewareConfig) []HandlerMiddleware {
+	logger := config.Logger
+	if logger == nil {
+		logger = slog.Default()
+	}
+
+	chain := []HandlerMiddleware{TracingMiddleware(logger)}
+	if config.Observer != nil {
+		chain = append(chain, MetricsMiddleware(config.Observer))
+	}
+	if config.Dedup != nil {
+		chain = append(chain, DedupMiddleware(config.Dedup, config.DedupTTL, logger))
+	}
+	return append(chain, RetryMiddleware(config.Retry, logger), RecoverMiddleware(logger, config.Reporter))
+}
+
+// RedisDedupStore keeps processed event keys in Redis with SET NX, so duplicates are
+// detected across instances.
+type RedisDedupStore struct {
+	client *redis.Client
+	prefix string
+}
+
+// NewRedisDedupStore creates a Redis-backed dedup store.
+func NewRedisDedupStore(client *redis.Client) *RedisDedupStore {
+	return &RedisDedupStore{client: client, prefix: defaultDedupKeyPrefix}
+}
+
+// Claim implements DedupStore.
+func (s *RedisDedupStore) Claim(ctx context.Context, key string, ttl time.Duration) (bool, error) {
+	err := s.client.SetArgs(ctx, s.prefix+key, "1", redis.SetArgs{Mode: "NX", TTL: ttl}).Err()
+	if errors.Is(err, redis.Nil) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to claim event %s: %w", key, err)
+	}
+	return true, nil
+}
+
+// Release implements DedupStore.
+func (s *RedisDedupStore) Release(ctx context.Context, key string) error {
+	if err := s.client.Del(ctx, s.prefix+key).Err(); err != nil {
+		return fmt.Errorf("failed to release event %s: %w", key, err)
+	}
+	return nil
+}
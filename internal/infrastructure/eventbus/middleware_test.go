@@ -0,0 +1,282 @@
+package eventbus_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/lllypuk/flowra/internal/application/appcore"
+	"github.com/lllypuk/flowra/internal/domain/event"
+	"github.com/lllypuk/flowra/internal/domain/uuid"
+	"github.com/lllypuk/flowra/internal/infrastructure/errorreport"
+	"github.com/lllypuk/flowra/internal/infrastructure/eventbus"
+	"github.com/lllypuk/flowra/internal/pkg/retry"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var errHandlerFailed = errors.New("handler failed")
+
+func discardLogger() *slog.Logger {
+	return slog.New(slog.NewTextHandler(io.Discard, nil))
+}
+
+// memoryDedupStore is an in-memory DedupStore for tests.
+type memoryDedupStore struct {
+	mu      sync.Mutex
+	claimed map[string]bool
+	err     error
+}
+
+func newMemoryDedupStore() *memoryDedupStore {
+	return &memoryDedupStore{claimed: make(map[string]bool)}
+}
+
+func (s *memoryDedupStore) Claim(_ context.Context, key string, _ time.Duration) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.err != nil {
+		return false, s.err
+	}
+	if s.claimed[key] {
+		return false, nil
+	}
+	s.claimed[key] = true
+	return true, nil
+}
+
+func (s *memoryDedupStore) Release(_ context.Context, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.claimed, key)
+	return nil
+}
+
+// recordingObserver records handler observations.
+type recordingObserver struct {
+	handler   string
+	eventType string
+	err       error
+	calls     int
+}
+
+func (o *recordingObserver) ObserveEventHandler(handler, eventType string, _ time.Duration, err error) {
+	o.handler = handler
+	o.eventType = eventType
+	o.err = err
+	o.calls++
+}
+
+// recordingReporter records reported panics.
+type recordingReporter struct {
+	events []errorreport.Event
+}
+
+func (r *recordingReporter) Report(_ context.Context, evt errorreport.Event) {
+	r.events = append(r.events, evt)
+}
+
+func TestChainHandler_Order(t *testing.T) {
+	var order []string
+	record := func(label string) eventbus.HandlerMiddleware {
+		return func(_ string, next eventbus.EventHandler) eventbus.EventHandler {
+			return func(ctx context.Context, evt event.DomainEvent) error {
+				order = append(order, label)
+				return next(ctx, evt)
+			}
+		}
+	}
+
+	handler := eventbus.ChainHandler("test", func(_ context.Context, _ event.DomainEvent) error {
+		order = append(order, "handler")
+		return nil
+	}, record("outer"), record("inner"))
+
+	require.NoError(t, handler(context.Background(), newTestEvent("chain.test", "agg-1", "")))
+	assert.Equal(t, []string{"outer", "inner", "handler"}, order)
+}
+
+func TestRecoverMiddleware(t *testing.T) {
+	handler := eventbus.ChainHandler("panicky", func(_ context.Context, _ event.DomainEvent) error {
+		panic("boom")
+	}, eventbus.RecoverMiddleware(discardLogger(), nil))
+
+	err := handler(context.Background(), newTestEvent("panic.test", "agg-1", ""))
+
+	require.ErrorIs(t, err, eventbus.ErrHandlerPanic)
+	assert.Contains(t, err.Error(), "boom")
+}
+
+func TestRecoverMiddleware_ReportsPanic(t *testing.T) {
+	reporter := &recordingReporter{}
+	workspaceID := uuid.NewUUID()
+	handler := eventbus.ChainHandler("panicky", func(_ context.Context, _ event.DomainEvent) error {
+		panic("boom")
+	}, eventbus.RecoverMiddleware(discardLogger(), reporter))
+
+	ctx := appcore.WithWorkspaceID(context.Background(), workspaceID)
+	err := handler(ctx, newTestEvent("panic.test", "agg-1", ""))
+
+	require.ErrorIs(t, err, eventbus.ErrHandlerPanic)
+	require.Len(t, reporter.events, 1)
+	report := reporter.events[0]
+	assert.Equal(t, errorreport.SourceEventBus, report.Source)
+	assert.Equal(t, "boom", report.Message())
+	assert.Equal(t, "user-1", report.UserID)
+	assert.Equal(t, workspaceID.String(), report.WorkspaceID)
+	assert.Equal(t, "panicky", report.Tags["handler"])
+	assert.Equal(t, "panic.test", report.Tags["event_type"])
+	assert.Equal(t, "agg-1", report.Tags["aggregate_id"])
+	assert.Equal(t, "correlation-1", report.Tags["correlation_id"])
+	assert.NotEmpty(t, report.Stack)
+}
+
+func TestMetricsMiddleware(t *testing.T) {
+	observer := &recordingObserver{}
+	handler := eventbus.ChainHandler("projection", func(_ context.Context, _ event.DomainEvent) error {
+		return errHandlerFailed
+	}, eventbus.MetricsMiddleware(observer))
+
+	err := handler(context.Background(), newTestEvent("metrics.test", "agg-1", ""))
+
+	require.ErrorIs(t, err, errHandlerFailed)
+	assert.Equal(t, 1, observer.calls)
+	assert.Equal(t, "projection", observer.handler)
+	assert.Equal(t, "metrics.test", observer.eventType)
+	assert.ErrorIs(t, observer.err, errHandlerFailed)
+}
+
+func TestTracingMiddleware_PropagatesCorrelationID(t *testing.T) {
+	var correlationID string
+	handler := eventbus.ChainHandler("tracing", func(ctx context.Context, _ event.DomainEvent) error {
+		correlationID, _ = appcore.GetCorrelationID(ctx)
+		return nil
+	}, eventbus.TracingMiddleware(discardLogger()))
+
+	require.NoError(t, handler(context.Background(), newTestEvent("trace.test", "agg-1", "")))
+	assert.Equal(t, "correlation-1", correlationID)
+}
+
+func TestDedupMiddleware(t *testing.T) {
+	t.Run("skips events already handled", func(t *testing.T) {
+		store := newMemoryDedupStore()
+		calls := 0
+		handler := eventbus.ChainHandler("dedup", func(_ context.Context, _ event.DomainEvent) error {
+			calls++
+			return nil
+		}, eventbus.DedupMiddleware(store, time.Hour, discardLogger()))
+
+		evt := newTestEvent("dedup.test", "agg-1", "")
+		require.NoError(t, handler(context.Background(), evt))
+		require.NoError(t, handler(context.Background(), evt))
+
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("handlers are deduplicated independently", func(t *testing.T) {
+		store := newMemoryDedupStore()
+		calls := 0
+		count := func(_ context.Context, _ event.DomainEvent) error {
+			calls++
+			return nil
+		}
+		dedup := eventbus.DedupMiddleware(store, time.Hour, discardLogger())
+
+		evt := newTestEvent("dedup.test", "agg-1", "")
+		require.NoError(t, eventbus.ChainHandler("first", count, dedup)(context.Background(), evt))
+		require.NoError(t, eventbus.ChainHandler("second", count, dedup)(context.Background(), evt))
+
+		assert.Equal(t, 2, calls)
+	})
+
+	t.Run("failed events can be handled again", func(t *testing.T) {
+		store := newMemoryDedupStore()
+		calls := 0
+		handler := eventbus.ChainHandler("dedup", func(_ context.Context, _ event.DomainEvent) error {
+			calls++
+			if calls == 1 {
+				return errHandlerFailed
+			}
+			return nil
+		}, eventbus.DedupMiddleware(store, time.Hour, discardLogger()))
+
+		evt := newTestEvent("dedup.test", "agg-1", "")
+		require.ErrorIs(t, handler(context.Background(), evt), errHandlerFailed)
+		require.NoError(t, handler(context.Background(), evt))
+
+		assert.Equal(t, 2, calls)
+	})
+
+	t.Run("store errors do not block delivery", func(t *testing.T) {
+		store := newMemoryDedupStore()
+		store.err = errors.New("redis down")
+		calls := 0
+		handler := eventbus.ChainHandler("dedup", func(_ context.Context, _ event.DomainEvent) error {
+			calls++
+			return nil
+		}, eventbus.DedupMiddleware(store, time.Hour, discardLogger()))
+
+		require.NoError(t, handler(context.Background(), newTestEvent("dedup.test", "agg-1", "")))
+		assert.Equal(t, 1, calls)
+	})
+}
+
+func TestRetryMiddleware(t *testing.T) {
+	policy := retry.Policy{MaxAttempts: 3, InitialBackoff: time.Millisecond, MaxBackoff: time.Millisecond, Jitter: -1}
+
+	t.Run("retries until success", func(t *testing.T) {
+		calls := 0
+		handler := eventbus.ChainHandler("retry", func(_ context.Context, _ event.DomainEvent) error {
+			calls++
+			if calls < 3 {
+				return errHandlerFailed
+			}
+			return nil
+		}, eventbus.RetryMiddleware(policy, discardLogger()))
+
+		require.NoError(t, handler(context.Background(), newTestEvent("retry.test", "agg-1", "")))
+		assert.Equal(t, 3, calls)
+	})
+
+	t.Run("retries a recovered panic", func(t *testing.T) {
+		calls := 0
+		handler := eventbus.ChainHandler("retry", func(_ context.Context, _ event.DomainEvent) error {
+			calls++
+			if calls == 1 {
+				panic("first attempt")
+			}
+			return nil
+		}, eventbus.DefaultMiddleware(eventbus.MiddlewareConfig{Logger: discardLogger(), Retry: policy})...)
+
+		require.NoError(t, handler(context.Background(), newTestEvent("retry.test", "agg-1", "")))
+		assert.Equal(t, 2, calls)
+	})
+}
+
+func TestDefaultMiddleware(t *testing.T) {
+	observer := &recordingObserver{}
+	store := newMemoryDedupStore()
+	policy := retry.Policy{MaxAttempts: 2, InitialBackoff: time.Millisecond, Jitter: -1}
+
+	calls := 0
+	handler := eventbus.ChainHandler("default", func(_ context.Context, _ event.DomainEvent) error {
+		calls++
+		return errHandlerFailed
+	}, eventbus.DefaultMiddleware(eventbus.MiddlewareConfig{
+		Logger:   discardLogger(),
+		Observer: observer,
+		Dedup:    store,
+		Retry:    policy,
+	})...)
+
+	err := handler(context.Background(), newTestEvent("default.test", "agg-1", ""))
+
+	require.ErrorIs(t, err, errHandlerFailed)
+	assert.Equal(t, 2, calls, "retried within one invocation")
+	assert.Equal(t, 1, observer.calls, "observed once, including retries")
+	assert.Empty(t, store.claimed, "claim released after failure")
+}
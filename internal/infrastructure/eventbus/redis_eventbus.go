@@ -12,6 +12,7 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/lllypuk/flowra/internal/domain/event"
+	"github.com/lllypuk/flowra/internal/pkg/retry"
 	"github.com/redis/go-redis/v9"
 )
 
@@ -97,6 +98,17 @@ type RetryConfig struct {
 	BackoffFactor  float64
 }
 
+// Policy converts the configuration into a retry policy with jitter.
+func (c RetryConfig) Policy() retry.Policy {
+	return retry.Policy{
+		MaxAttempts:    c.MaxRetries + 1,
+		InitialBackoff: c.InitialBackoff,
+		MaxBackoff:     c.MaxBackoff,
+		Multiplier:     c.BackoffFactor,
+		Jitter:         retry.DefaultJitter,
+	}
+}
+
 // DefaultRetryConfig returns the default retry configuration.
 func DefaultRetryConfig() RetryConfig {
 	return RetryConfig{
@@ -112,7 +124,7 @@ type RedisEventBus struct {
 	client        *redis.Client
 	pubsub        *redis.PubSub
 	pubsubMu      sync.RWMutex
-	handlers      map[string][]EventHandler
+	handlers      map[string][]*subscription
 	handlersMu    sync.RWMutex
 	running       bool
 	runningMu     sync.RWMutex
@@ -151,7 +163,7 @@ func WithChannelPrefix(prefix string) Option {
 func NewRedisEventBus(client *redis.Client, opts ...Option) *RedisEventBus {
 	b := &RedisEventBus{
 		client:        client,
-		handlers:      make(map[string][]EventHandler),
+		handlers:      make(map[string][]*subscription),
 		shutdown:      make(chan struct{}),
 		logger:        slog.Default(),
 		retryConfig:   DefaultRetryConfig(),
@@ -209,17 +221,29 @@ func (b *RedisEventBus) Publish(ctx context.Context, evt event.DomainEvent) erro
 // Subscribe registers an event handler for a specific event type.
 // Handlers are called concurrently when events are received.
 func (b *RedisEventBus) Subscribe(eventType string, handler EventHandler) error {
-	if eventType == "" {
-		return errors.New("event type cannot be empty")
-	}
+	return b.SubscribeWithOptions([]string{eventType}, handler, HandlerOptions{})
+}
+
+// SubscribeWithOptions registers one handler for several event types with the given
+// dispatch options. Concurrency limits and ordering apply across all of the event types.
+func (b *RedisEventBus) SubscribeWithOptions(eventTypes []string, handler EventHandler, opts HandlerOptions) error {
 	if handler == nil {
 		return errors.New("handler cannot be nil")
 	}
+	for _, eventType := range eventTypes {
+		if eventType == "" {
+			return errors.New("event type cannot be empty")
+		}
+	}
+
+	sub := newSubscription(handler, opts)
 
 	b.handlersMu.Lock()
 	defer b.handlersMu.Unlock()
 
-	b.handlers[eventType] = append(b.handlers[eventType], handler)
+	for _, eventType := range eventTypes {
+		b.handlers[eventType] = append(b.handlers[eventType], sub)
+	}
 
 	return nil
 }
@@ -394,83 +418,91 @@ func (b *RedisEventBus) handleMessage(ctx context.Context, msg *redis.Message) {
 	evt := &deserializedEvent{envelope: envelope}
 
 	b.handlersMu.RLock()
-	handlers := b.handlers[envelope.EventType]
+	subs := b.handlers[envelope.EventType]
 	b.handlersMu.RUnlock()
 
 	b.logger.InfoContext(ctx, "EVENTBUS: dispatching to handlers",
 		slog.String("event_type", envelope.EventType),
-		slog.Int("handler_count", len(handlers)),
+		slog.Int("handler_count", len(subs)),
 	)
 
-	for i, handler := range handlers {
-		b.wg.Add(1)
-		go b.executeHandler(ctx, handler, evt, i)
+	for i, sub := range subs {
+		b.dispatch(ctx, sub, evt, i)
 	}
 }
 
-// executeHandler runs a single event handler with retry logic.
+// executeHandler runs a single event handler. Subscriptions without middleware are
+// retried according to the bus RetryConfig; a middleware chain handles retries itself.
+// handlerIndex is the handler position for the event type, or -1 for per-aggregate lanes.
 func (b *RedisEventBus) executeHandler(
 	ctx context.Context,
-	handler EventHandler,
+	sub *subscription,
 	evt event.DomainEvent,
 	handlerIndex int,
 ) {
-	defer b.wg.Done()
-
-	var lastErr error
-	backoff := b.retryConfig.InitialBackoff
-
-	for attempt := 0; attempt <= b.retryConfig.MaxRetries; attempt++ {
-		if attempt > 0 {
-			b.logger.DebugContext(ctx, "retrying event handler",
-				slog.String("event_type", evt.EventType()),
-				slog.Int("attempt", attempt),
-				slog.Duration("backoff", backoff),
-			)
+	var err error
+	if len(sub.opts.Middleware) > 0 {
+		err = sub.handler(ctx, evt)
+	} else {
+		err = b.executeWithRetry(ctx, sub, evt, handlerIndex)
+	}
 
-			select {
-			case <-ctx.Done():
-				b.logger.WarnContext(ctx, "handler retry cancelled",
-					slog.String("event_type", evt.EventType()),
-					slog.String("error", ctx.Err().Error()),
-				)
-				return
-			case <-time.After(backoff):
-			}
+	switch {
+	case err == nil:
+		b.logger.DebugContext(ctx, "event handler completed",
+			slog.String("event_type", evt.EventType()),
+			slog.String("aggregate_id", evt.AggregateID()),
+			slog.String("handler", sub.opts.Name),
+			slog.Int("handler_index", handlerIndex),
+		)
+	case ctx.Err() != nil:
+		b.logger.WarnContext(ctx, "handler retry cancelled",
+			slog.String("event_type", evt.EventType()),
+			slog.String("error", ctx.Err().Error()),
+		)
+	default:
+		b.logger.ErrorContext(ctx, "event handler failed after all retries",
+			slog.String("event_type", evt.EventType()),
+			slog.String("aggregate_id", evt.AggregateID()),
+			slog.String("handler", sub.opts.Name),
+			slog.Int("handler_index", handlerIndex),
+			slog.String("error", err.Error()),
+		)
+	}
+}
 
-			// Calculate next backoff with exponential growth
-			backoff = min(time.Duration(float64(backoff)*b.retryConfig.BackoffFactor), b.retryConfig.MaxBackoff)
-		}
+// executeWithRetry runs a handler with the bus retry policy.
+func (b *RedisEventBus) executeWithRetry(
+	ctx context.Context,
+	sub *subscription,
+	evt event.DomainEvent,
+	handlerIndex int,
+) error {
+	policy := b.retryConfig.Policy()
+	policy.OnRetry = func(attempt int, _ error, delay time.Duration) {
+		b.logger.DebugContext(ctx, "retrying event handler",
+			slog.String("event_type", evt.EventType()),
+			slog.Int("attempt", attempt),
+			slog.Duration("backoff", delay),
+		)
+	}
 
-		if err := handler(ctx, evt); err != nil {
-			lastErr = err
+	attempt := 0
+	return retry.Do(ctx, policy, func(ctx context.Context) error {
+		handlerErr := sub.handler(ctx, evt)
+		if handlerErr != nil {
 			b.logger.WarnContext(ctx, "event handler failed",
 				slog.String("event_type", evt.EventType()),
 				slog.String("aggregate_id", evt.AggregateID()),
+				slog.String("handler", sub.opts.Name),
 				slog.Int("handler_index", handlerIndex),
 				slog.Int("attempt", attempt),
-				slog.String("error", err.Error()),
+				slog.String("error", handlerErr.Error()),
 			)
-			continue
 		}
-
-		// Success
-		b.logger.DebugContext(ctx, "event handler completed",
-			slog.String("event_type", evt.EventType()),
-			slog.String("aggregate_id", evt.AggregateID()),
-			slog.Int("handler_index", handlerIndex),
-		)
-		return
-	}
-
-	// All retries exhausted
-	b.logger.ErrorContext(ctx, "event handler failed after all retries",
-		slog.String("event_type", evt.EventType()),
-		slog.String("aggregate_id", evt.AggregateID()),
-		slog.Int("handler_index", handlerIndex),
-		slog.Int("max_retries", b.retryConfig.MaxRetries),
-		slog.String("error", lastErr.Error()),
-	)
+		attempt++
+		return handlerErr
+	})
 }
 
 // Ensure RedisEventBus implements event.Bus
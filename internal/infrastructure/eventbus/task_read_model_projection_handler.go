@@ -116,8 +116,15 @@ func TaskReadModelProjectionEventTypes() []string {
 		chat.EventTypeDueDateSet,
 		chat.EventTypeDueDateRemoved,
 		chat.EventTypeSeveritySet,
+		chat.EventTypeEstimateSet,
+		chat.EventTypeSprintSet,
+		chat.EventTypeDuplicateMarked,
+		chat.EventTypeEpicSet,
 		chat.EventTypeAttachmentAdded,
 		chat.EventTypeAttachmentRemoved,
+		chat.EventTypeChecklistItemAdded,
+		chat.EventTypeChecklistItemToggled,
+		chat.EventTypeChecklistItemReordered,
 		chat.EventTypeChatClosed,
 		chat.EventTypeChatReopened,
 		chat.EventTypeChatRenamed,
@@ -125,15 +132,21 @@ func TaskReadModelProjectionEventTypes() []string {
 	}
 }
 
-// RegisterTaskReadModelProjectionHandler registers task projection handler subscriptions.
+// RegisterTaskReadModelProjectionHandler registers task projection handler subscriptions,
+// wrapped in the given middleware.
 func RegisterTaskReadModelProjectionHandler(
 	bus *RedisEventBus,
 	handler *TaskReadModelProjectionHandler,
 	logger *slog.Logger,
+	middleware ...HandlerMiddleware,
 ) error {
 	if handler == nil {
 		return nil
 	}
 	registry := NewHandlerRegistry(bus, logger)
-	return registry.Register(TaskReadModelProjectionEventTypes(), handler.AsEventHandler())
+	registry.Use(middleware...)
+	return registry.RegisterWithOptions(TaskReadModelProjectionEventTypes(), handler.AsEventHandler(), HandlerOptions{
+		Name:     "task_read_model",
+		Ordering: OrderingPerAggregate,
+	})
 }
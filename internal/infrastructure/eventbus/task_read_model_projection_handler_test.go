@@ -83,6 +83,7 @@ func TestTaskReadModelProjectionEventTypes(t *testing.T) {
 	assert.Contains(t, eventTypes, chat.EventTypeSeveritySet)
 	assert.Contains(t, eventTypes, chat.EventTypeAttachmentAdded)
 	assert.Contains(t, eventTypes, chat.EventTypeAttachmentRemoved)
+	assert.Contains(t, eventTypes, chat.EventTypeChecklistItemToggled)
 	assert.Contains(t, eventTypes, chat.EventTypeChatClosed)
 	assert.Contains(t, eventTypes, chat.EventTypeChatReopened)
 }
@@ -0,0 +1,220 @@
+package eventstore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"maps"
+	"reflect"
+	"slices"
+	"strings"
+	"time"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+
+	"github.com/lllypuk/flowra/internal/application/appcore"
+	chatdomain "github.com/lllypuk/flowra/internal/domain/chat"
+	"github.com/lllypuk/flowra/internal/domain/event"
+	"github.com/lllypuk/flowra/internal/domain/uuid"
+	mongodbinfra "github.com/lllypuk/flowra/internal/infrastructure/mongodb"
+)
+
+// ErrNotCompactable is returned when compacting an aggregate that is not a chat.
+var ErrNotCompactable = errors.New("only chat aggregates can be compacted")
+
+// CompactionOptions controls CompactAggregate.
+type CompactionOptions struct {
+	// MinEvents skips aggregates with fewer events.
+	MinEvents int
+
+	// DryRun plans the compaction without writing anything.
+	DryRun bool
+}
+
+// CompactionResult describes the compaction of one aggregate.
+type CompactionResult struct {
+	AggregateID  string
+	CompactionID string
+	EventsBefore int
+
+	// EventsDropped is how much shorter the stream gets, net of the snapshot.
+	EventsDropped   int
+	SnapshotVersion int
+
+	// Compacted is false for dry runs and for streams with nothing to drop.
+	Compacted bool
+}
+
+// archivedEventDocument is an event of a compacted stream, kept in events_archive.
+type archivedEventDocument struct {
+	EventDocument `bson:",inline"`
+
+	CompactionID string    `bson:"compaction_id"`
+	ArchivedAt   time.Time `bson:"archived_at"`
+}
+
+// CompactAggregate rewrites the stream of a chat aggregate into its significant
+// events plus a snapshot (see chat.SupersededEvents). The snapshot takes the
+// version of the last dropped event, so the aggregate version does not change and
+// the events after it replay as before. The original stream is copied to
+// events_archive first, and the compacted stream is verified to rebuild the same
+// state before anything is written. It runs in a transaction, so a concurrent
+// save aborts the compaction rather than being lost.
+func (s *MongoEventStore) CompactAggregate(
+	ctx context.Context,
+	aggregateID string,
+	opts CompactionOptions,
+) (*CompactionResult, error) {
+	if opts.DryRun {
+		return s.compact(ctx, aggregateID, opts)
+	}
+
+	session, err := s.client.StartSession()
+	if err != nil {
+		return nil, fmt.Errorf("failed to start session: %w", err)
+	}
+	defer session.EndSession(ctx)
+
+	var result *CompactionResult
+	_, err = session.WithTransaction(ctx, func(txCtx context.Context) (any, error) {
+		var errCompact error
+		result, errCompact = s.compact(txCtx, aggregateID, opts)
+		return nil, errCompact
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if result.Compacted {
+		s.logger.InfoContext(ctx, "aggregate compacted",
+			slog.String("aggregate_id", aggregateID),
+			slog.String("compaction_id", result.CompactionID),
+			slog.Int("events_before", result.EventsBefore),
+			slog.Int("events_dropped", result.EventsDropped),
+		)
+	}
+	return result, nil
+}
+
+func (s *MongoEventStore) compact(
+	ctx context.Context,
+	aggregateID string,
+	opts CompactionOptions,
+) (*CompactionResult, error) {
+	collection, err := s.collectionFor(ctx, aggregateID)
+	if err != nil {
+		return nil, err
+	}
+	if collection == nil {
+		return nil, appcore.ErrAggregateNotFound
+	}
+
+	cursor, err := collection.Find(ctx, bson.M{"aggregate_id": aggregateID},
+		options.Find().SetSort(bson.D{{Key: "version", Value: 1}}))
+	if err != nil {
+		return nil, fmt.Errorf("failed to find events: %w", err)
+	}
+	var docs []*EventDocument
+	if err = cursor.All(ctx, &docs); err != nil {
+		return nil, fmt.Errorf("failed to decode events: %w", err)
+	}
+	if len(docs) == 0 {
+		return nil, appcore.ErrAggregateNotFound
+	}
+	if !strings.EqualFold(docs[0].AggregateType, "chat") {
+		return nil, ErrNotCompactable
+	}
+
+	result := &CompactionResult{AggregateID: aggregateID, EventsBefore: len(docs)}
+	if len(docs) < opts.MinEvents {
+		return result, nil
+	}
+
+	events, err := s.serializer.DeserializeMany(docs)
+	if err != nil {
+		return nil, err
+	}
+	superseded := chatdomain.SupersededEvents(events)
+	if len(superseded) == 0 {
+		return result, nil
+	}
+
+	result.CompactionID = uuid.NewUUID().String()
+	result.EventsDropped = len(superseded) - 1 // the snapshot takes one slot
+	result.SnapshotVersion = slices.Max(slices.Collect(maps.Keys(superseded)))
+
+	snapshotDoc, err := s.compactedSnapshot(events, superseded, result)
+	if err != nil {
+		return nil, err
+	}
+	if opts.DryRun {
+		return result, nil
+	}
+
+	now := time.Now()
+	archived := make([]any, 0, len(docs))
+	for _, doc := range docs {
+		archivedDoc := archivedEventDocument{EventDocument: *doc, CompactionID: result.CompactionID, ArchivedAt: now}
+		archivedDoc.ID = bson.ObjectID{}
+		archived = append(archived, archivedDoc)
+	}
+	if _, err = s.database.Collection(mongodbinfra.CollectionEventArchive).InsertMany(ctx, archived); err != nil {
+		return nil, fmt.Errorf("failed to archive events: %w", err)
+	}
+
+	if _, err = collection.DeleteMany(ctx, bson.M{
+		"aggregate_id": aggregateID,
+		"version":      bson.M{"$in": slices.Collect(maps.Keys(superseded))},
+	}); err != nil {
+		return nil, fmt.Errorf("failed to delete superseded events: %w", err)
+	}
+	if _, err = collection.InsertOne(ctx, snapshotDoc); err != nil {
+		return nil, fmt.Errorf("failed to insert snapshot: %w", err)
+	}
+
+	result.Compacted = true
+	return result, nil
+}
+
+// compactedSnapshot builds the snapshot replacing the superseded events and checks
+// that the compacted stream rebuilds the same state as the original one.
+func (s *MongoEventStore) compactedSnapshot(
+	events []event.DomainEvent,
+	superseded map[int]bool,
+	result *CompactionResult,
+) (*EventDocument, error) {
+	atSnapshot := chatdomain.NewEmptyChat()
+	for _, e := range events {
+		if e.Version() <= result.SnapshotVersion {
+			_ = atSnapshot.Apply(e)
+		}
+	}
+	metadata := event.NewMetadata("", result.CompactionID, "")
+	snapshot := chatdomain.NewSnapshotted(atSnapshot, result.SnapshotVersion, metadata)
+
+	compacted := make([]event.DomainEvent, 0, len(events)-result.EventsDropped)
+	for _, e := range events {
+		switch {
+		case e.Version() == result.SnapshotVersion:
+			compacted = append(compacted, snapshot)
+		case !superseded[e.Version()]:
+			compacted = append(compacted, e)
+		}
+	}
+
+	original, rebuilt := chatdomain.NewEmptyChat(), chatdomain.NewEmptyChat()
+	for _, e := range events {
+		_ = original.Apply(e)
+	}
+	for _, e := range compacted {
+		_ = rebuilt.Apply(e)
+	}
+	if original.Version() != rebuilt.Version() ||
+		!reflect.DeepEqual(original.SnapshotState(), rebuilt.SnapshotState()) {
+		return nil, fmt.Errorf("compacted stream of %s does not rebuild the same state", result.AggregateID)
+	}
+
+	return s.serializer.Serialize(snapshot)
+}
@@ -21,6 +21,11 @@ type MongoEventStore struct {
 	collection *mongo.Collection
 	serializer *EventSerializer
 	logger     *slog.Logger
+
+	// partitions, routes and routeCache are set by WithWorkspacePartitions.
+	partitions []*mongo.Collection
+	routes     *mongo.Collection
+	routeCache *routeCache
 }
 
 // Option configures MongoEventStore.
@@ -76,9 +81,21 @@ func (s *MongoEventStore) SaveEvents(
 	defer session.EndSession(ctx)
 
 	// vypolnyaem operatsiyu in tranzaktsii
+	var newRoute int
+	var routed bool
 	_, err = session.WithTransaction(ctx, func(txCtx context.Context) (any, error) {
+		routed = false
+
 		// 1. Checking current version (optimistic locking)
-		currentVersion, errVersion := s.getCurrentVersion(txCtx, aggregateID)
+		collection, errRoute := s.collectionFor(txCtx, aggregateID)
+		if errRoute != nil {
+			s.logger.ErrorContext(ctx, "failed to route aggregate to event partition",
+				slog.String("aggregate_id", aggregateID),
+				slog.String("error", errRoute.Error()),
+			)
+			return nil, errRoute
+		}
+		currentVersion, errVersion := s.versionIn(txCtx, collection, aggregateID)
 		if errVersion != nil {
 			s.logger.ErrorContext(ctx, "failed to get current version for aggregate",
 				slog.String("aggregate_id", aggregateID),
@@ -107,6 +124,18 @@ func (s *MongoEventStore) SaveEvents(
 			return nil, errSerialize
 		}
 
+		// New aggregates of a partitioned store are pinned to their workspace's partition
+		if collection == nil {
+			partition, partitionColl, errCreate := s.createRoute(txCtx, aggregateID, documents)
+			if errCreate != nil {
+				if mongo.IsDuplicateKeyError(errCreate) {
+					return nil, appcore.ErrConcurrencyConflict
+				}
+				return nil, fmt.Errorf("failed to create event route: %w", errCreate)
+			}
+			newRoute, routed, collection = partition, true, partitionColl
+		}
+
 		// 3. Assign correct versions to documents (expectedVersion + 1, +2, ...)
 		for i, doc := range documents {
 			doc.Version = expectedVersion + i + 1
@@ -119,7 +148,7 @@ func (s *MongoEventStore) SaveEvents(
 		}
 
 		// 4. vstavlyaem event (bulk)
-		_, errInsert := s.collection.InsertMany(txCtx, docs)
+		_, errInsert := collection.InsertMany(txCtx, docs)
 		if errInsert != nil {
 			// Checking error dublirovaniya klyucha (konflikt concurrency)
 			if mongo.IsDuplicateKeyError(errInsert) {
@@ -140,6 +169,10 @@ func (s *MongoEventStore) SaveEvents(
 		return nil, nil //nolint:nilnil // Transaction success returns nil for both values
 	})
 
+	if err == nil && routed {
+		s.routeCache.put(aggregateID, newRoute)
+	}
+
 	if err != nil && !errors.Is(err, appcore.ErrConcurrencyConflict) {
 		s.logger.ErrorContext(ctx, "event store transaction failed",
 			slog.String("aggregate_id", aggregateID),
@@ -153,10 +186,18 @@ func (s *MongoEventStore) SaveEvents(
 
 // LoadEvents loads all event for aggregate
 func (s *MongoEventStore) LoadEvents(ctx context.Context, aggregateID string) ([]event.DomainEvent, error) {
+	collection, err := s.collectionFor(ctx, aggregateID)
+	if err != nil {
+		return nil, err
+	}
+	if collection == nil {
+		return nil, appcore.ErrAggregateNotFound
+	}
+
 	filter := bson.M{"aggregate_id": aggregateID}
 	opts := options.Find().SetSort(bson.D{{Key: "version", Value: 1}})
 
-	cursor, err := s.collection.Find(ctx, filter, opts)
+	cursor, err := collection.Find(ctx, filter, opts)
 	if err != nil {
 		s.logger.ErrorContext(ctx, "failed to find events in event store",
 			slog.String("aggregate_id", aggregateID),
@@ -196,11 +237,24 @@ func (s *MongoEventStore) LoadEvents(ctx context.Context, aggregateID string) ([
 
 // GetVersion returns current version aggregate
 func (s *MongoEventStore) GetVersion(ctx context.Context, aggregateID string) (int, error) {
+	collection, err := s.collectionFor(ctx, aggregateID)
+	if err != nil {
+		return 0, err
+	}
+	return s.versionIn(ctx, collection, aggregateID)
+}
+
+// versionIn returns the version of an aggregate stored in collection; a nil collection has no events.
+func (s *MongoEventStore) versionIn(ctx context.Context, collection *mongo.Collection, aggregateID string) (int, error) {
+	if collection == nil {
+		return 0, nil
+	}
+
 	filter := bson.M{"aggregate_id": aggregateID}
 	opts := options.FindOne().SetSort(bson.D{{Key: "version", Value: -1}})
 
 	var doc EventDocument
-	err := s.collection.FindOne(ctx, filter, opts).Decode(&doc)
+	err := collection.FindOne(ctx, filter, opts).Decode(&doc)
 	if err != nil {
 		if errors.Is(err, mongo.ErrNoDocuments) {
 			return 0, nil // no events esche
@@ -210,8 +264,3 @@ func (s *MongoEventStore) GetVersion(ctx context.Context, aggregateID string) (i
 
 	return doc.Version, nil
 }
-
-// getCurrentVersion receiv current version aggregate (vnutrenniy method)
-func (s *MongoEventStore) getCurrentVersion(ctx context.Context, aggregateID string) (int, error) {
-	return s.GetVersion(ctx, aggregateID)
-}
@@ -9,9 +9,12 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/v2/bson"
 
 	"github.com/lllypuk/flowra/internal/application/appcore"
+	chatdomain "github.com/lllypuk/flowra/internal/domain/chat"
 	"github.com/lllypuk/flowra/internal/domain/event"
+	"github.com/lllypuk/flowra/internal/domain/uuid"
 	"github.com/lllypuk/flowra/internal/infrastructure/eventstore"
 	"github.com/lllypuk/flowra/tests/testutil"
 )
@@ -323,3 +326,55 @@ func TestMongoEventStore_ConcurrentSaves(t *testing.T) {
 	require.NoError(t, err)
 	assert.Len(t, loadedEvents, 2)
 }
+
+func TestMongoEventStore_CompactAggregate(t *testing.T) {
+	db := testutil.SetupTestMongoDB(t)
+	store := eventstore.NewMongoEventStore(db.Client(), db.Name())
+	ctx := context.Background()
+
+	userID := uuid.NewUUID()
+	c, err := chatdomain.NewChat(uuid.NewUUID(), chatdomain.TypeTask, true, userID)
+	require.NoError(t, err)
+	for _, title := range []string{"Draft", "Draft 2", "Final"} {
+		require.NoError(t, c.Rename(title, userID))
+	}
+	require.NoError(t, c.ChangeStatus("In Progress", userID))
+	aggregateID := c.ID().String()
+	events := c.GetUncommittedEvents()
+	require.NoError(t, store.SaveEvents(ctx, aggregateID, events, 0))
+
+	// dry run writes nothing
+	result, err := store.CompactAggregate(ctx, aggregateID, eventstore.CompactionOptions{DryRun: true})
+	require.NoError(t, err)
+	assert.False(t, result.Compacted)
+	assert.Equal(t, 1, result.EventsDropped)
+
+	result, err = store.CompactAggregate(ctx, aggregateID, eventstore.CompactionOptions{})
+	require.NoError(t, err)
+	assert.True(t, result.Compacted)
+	assert.Equal(t, len(events), result.EventsBefore)
+
+	loaded, err := store.LoadEvents(ctx, aggregateID)
+	require.NoError(t, err)
+	assert.Len(t, loaded, len(events)-1)
+
+	rebuilt := chatdomain.NewEmptyChat()
+	for _, e := range loaded {
+		require.NoError(t, rebuilt.Apply(e))
+	}
+	assert.Equal(t, "Final", rebuilt.Title())
+	assert.Equal(t, c.Version(), rebuilt.Version())
+
+	version, err := store.GetVersion(ctx, aggregateID)
+	require.NoError(t, err)
+	assert.Equal(t, c.Version(), version)
+
+	archived, err := db.Collection("events_archive").CountDocuments(ctx, bson.M{"aggregate_id": aggregateID})
+	require.NoError(t, err)
+	assert.Equal(t, int64(len(events)), archived)
+
+	// nothing superseded is left
+	result, err = store.CompactAggregate(ctx, aggregateID, eventstore.CompactionOptions{})
+	require.NoError(t, err)
+	assert.False(t, result.Compacted)
+}
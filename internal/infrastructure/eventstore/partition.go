@@ -0,0 +1,204 @@
+package eventstore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"sync"
+	"time"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+
+	mongodbinfra "github.com/lllypuk/flowra/internal/infrastructure/mongodb"
+)
+
+// Partitioning limits.
+const (
+	// MaxPartitions bounds the number of event collections.
+	MaxPartitions = 256
+
+	// routeCacheSize caps the cached aggregate routes; routes never change, so
+	// a full cache simply stops caching new ones.
+	routeCacheSize = 100000
+
+	// legacyPartition routes aggregates written before partitioning was enabled
+	// to the unpartitioned events collection.
+	legacyPartition = -1
+)
+
+// WithWorkspacePartitions spreads events over partitions collections (events_0,
+// events_1, ...) by workspace, so no single collection becomes a hotspot and each
+// partition can be placed on its own shard. Every aggregate is pinned to a
+// partition on its first save and looked up through the event_routes collection,
+// so changing the count later only affects new aggregates. Aggregates saved before
+// partitioning was enabled keep their events in the events collection.
+// A count below 2 leaves the store unpartitioned.
+func WithWorkspacePartitions(partitions int) Option {
+	return func(s *MongoEventStore) {
+		if partitions < 2 {
+			return
+		}
+		partitions = min(partitions, MaxPartitions)
+		s.partitions = make([]*mongo.Collection, partitions)
+		for i := range partitions {
+			s.partitions[i] = s.database.Collection(mongodbinfra.EventPartitionCollection(i))
+		}
+		s.routes = s.database.Collection(mongodbinfra.CollectionEventRoutes)
+		s.routeCache = &routeCache{routes: make(map[string]int)}
+	}
+}
+
+// routeDocument pins an aggregate to a partition.
+type routeDocument struct {
+	AggregateID string    `bson:"_id"`
+	WorkspaceID string    `bson:"workspace_id,omitempty"`
+	Partition   int       `bson:"partition"`
+	CreatedAt   time.Time `bson:"created_at"`
+}
+
+// routeCache remembers the partition of recently used aggregates.
+type routeCache struct {
+	mu     sync.RWMutex
+	routes map[string]int
+}
+
+func (c *routeCache) get(aggregateID string) (int, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	partition, ok := c.routes[aggregateID]
+	return partition, ok
+}
+
+func (c *routeCache) put(aggregateID string, partition int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if len(c.routes) < routeCacheSize {
+		c.routes[aggregateID] = partition
+	}
+}
+
+// partitioned reports whether events are spread over partitions.
+func (s *MongoEventStore) partitioned() bool {
+	return len(s.partitions) > 0
+}
+
+// collectionFor returns the collection holding the events of an aggregate, or nil
+// if the aggregate has no events yet.
+func (s *MongoEventStore) collectionFor(ctx context.Context, aggregateID string) (*mongo.Collection, error) {
+	if !s.partitioned() {
+		return s.collection, nil
+	}
+
+	partition, ok := s.routeCache.get(aggregateID)
+	if !ok {
+		var found bool
+		var err error
+		partition, found, err = s.lookupRoute(ctx, aggregateID)
+		if err != nil {
+			return nil, err
+		}
+		if !found {
+			return nil, nil
+		}
+		s.routeCache.put(aggregateID, partition)
+	}
+	return s.partitionCollection(partition)
+}
+
+// lookupRoute finds the partition of an aggregate in the routes collection,
+// falling back to the unpartitioned events collection.
+func (s *MongoEventStore) lookupRoute(ctx context.Context, aggregateID string) (int, bool, error) {
+	var route routeDocument
+	err := s.routes.FindOne(ctx, bson.M{"_id": aggregateID}).Decode(&route)
+	if err == nil {
+		return route.Partition, true, nil
+	}
+	if !errors.Is(err, mongo.ErrNoDocuments) {
+		return 0, false, fmt.Errorf("failed to load event route: %w", err)
+	}
+
+	legacy, err := s.collection.CountDocuments(ctx, bson.M{"aggregate_id": aggregateID}, options.Count().SetLimit(1))
+	if err != nil {
+		return 0, false, fmt.Errorf("failed to check unpartitioned events: %w", err)
+	}
+	return legacyPartition, legacy > 0, nil
+}
+
+func (s *MongoEventStore) partitionCollection(partition int) (*mongo.Collection, error) {
+	if partition == legacyPartition {
+		return s.collection, nil
+	}
+	if partition < 0 || partition >= len(s.partitions) {
+		return nil, fmt.Errorf("event partition %d is not configured (%d partitions)", partition, len(s.partitions))
+	}
+	return s.partitions[partition], nil
+}
+
+// createRoute pins a new aggregate to the partition of its workspace. A concurrent
+// first save of the same aggregate fails on the route's unique _id.
+func (s *MongoEventStore) createRoute(
+	ctx context.Context,
+	aggregateID string,
+	documents []*EventDocument,
+) (int, *mongo.Collection, error) {
+	workspaceID := documentsWorkspaceID(documents)
+	key := workspaceID
+	if key == "" {
+		key = aggregateID
+	}
+	partition := partitionOf(key, len(s.partitions))
+
+	_, err := s.routes.InsertOne(ctx, routeDocument{
+		AggregateID: aggregateID,
+		WorkspaceID: workspaceID,
+		Partition:   partition,
+		CreatedAt:   time.Now(),
+	})
+	if err != nil {
+		return 0, nil, err
+	}
+	return partition, s.partitions[partition], nil
+}
+
+// AggregateIDs returns the IDs of every aggregate of the given types across all
+// collections, for rebuild and verification tooling.
+func (s *MongoEventStore) AggregateIDs(ctx context.Context, aggregateTypes ...string) ([]string, error) {
+	collections := append([]*mongo.Collection{s.collection}, s.partitions...)
+	filter := bson.M{"aggregate_type": bson.M{"$in": aggregateTypes}}
+
+	seen := make(map[string]struct{})
+	var ids []string
+	for _, coll := range collections {
+		var collIDs []string
+		if err := coll.Distinct(ctx, "aggregate_id", filter).Decode(&collIDs); err != nil {
+			return nil, fmt.Errorf("failed to list aggregates in %s: %w", coll.Name(), err)
+		}
+		for _, id := range collIDs {
+			if _, dup := seen[id]; !dup {
+				seen[id] = struct{}{}
+				ids = append(ids, id)
+			}
+		}
+	}
+	return ids, nil
+}
+
+// documentsWorkspaceID returns the workspace the events belong to, if any of them names it.
+func documentsWorkspaceID(documents []*EventDocument) string {
+	for _, doc := range documents {
+		if workspaceID, ok := doc.Data["workspace_id"].(string); ok && workspaceID != "" {
+			return workspaceID
+		}
+	}
+	return ""
+}
+
+// partitionOf maps a routing key to a partition.
+func partitionOf(key string, partitions int) int {
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(key))
+	return int(h.Sum32() % uint32(partitions)) //nolint:gosec // partitions is capped at MaxPartitions
+}
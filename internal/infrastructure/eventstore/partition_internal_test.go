@@ -0,0 +1,45 @@
+package eventstore
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPartitionOf(t *testing.T) {
+	const partitions = 8
+	for _, key := range []string{"workspace-1", "workspace-2", "", "a-much-longer-workspace-identifier"} {
+		partition := partitionOf(key, partitions)
+		assert.GreaterOrEqual(t, partition, 0)
+		assert.Less(t, partition, partitions)
+		assert.Equal(t, partition, partitionOf(key, partitions), "routing must be deterministic")
+	}
+}
+
+func TestDocumentsWorkspaceID(t *testing.T) {
+	assert.Empty(t, documentsWorkspaceID(nil))
+	assert.Empty(t, documentsWorkspaceID([]*EventDocument{{Data: map[string]any{"title": "x"}}}))
+
+	documents := []*EventDocument{
+		{Data: map[string]any{"workspace_id": ""}},
+		{Data: map[string]any{"workspace_id": "ws-1"}},
+	}
+	assert.Equal(t, "ws-1", documentsWorkspaceID(documents))
+}
+
+func TestRouteCache_Bounded(t *testing.T) {
+	cache := &routeCache{routes: make(map[string]int)}
+	cache.put("agg-1", 3)
+
+	partition, ok := cache.get("agg-1")
+	assert.True(t, ok)
+	assert.Equal(t, 3, partition)
+
+	for i := range routeCacheSize {
+		cache.routes["filler-"+strconv.Itoa(i)] = 0
+	}
+	cache.put("agg-2", 1)
+	_, ok = cache.get("agg-2")
+	assert.False(t, ok, "a full cache stops caching new routes")
+}
@@ -130,16 +130,36 @@ func createEventByType(eventType string) (event.DomainEvent, error) {
 		return &chatdomain.AttachmentAdded{}, nil
 	case chatdomain.EventTypeAttachmentRemoved:
 		return &chatdomain.AttachmentRemoved{}, nil
+	case chatdomain.EventTypeChecklistItemAdded:
+		return &chatdomain.ChecklistItemAdded{}, nil
+	case chatdomain.EventTypeChecklistItemToggled:
+		return &chatdomain.ChecklistItemToggled{}, nil
+	case chatdomain.EventTypeChecklistItemReordered:
+		return &chatdomain.ChecklistItemReordered{}, nil
 	case chatdomain.EventTypeChatRenamed:
 		return &chatdomain.Renamed{}, nil
 	case chatdomain.EventTypeSeveritySet:
 		return &chatdomain.SeveritySet{}, nil
+	case chatdomain.EventTypeEstimateSet:
+		return &chatdomain.EstimateSet{}, nil
+	case chatdomain.EventTypeSprintSet:
+		return &chatdomain.SprintSet{}, nil
+	case chatdomain.EventTypeDuplicateMarked:
+		return &chatdomain.DuplicateMarked{}, nil
+	case chatdomain.EventTypeEpicSet:
+		return &chatdomain.EpicSet{}, nil
+	case chatdomain.EventTypeTopicSet:
+		return &chatdomain.TopicSet{}, nil
 	case chatdomain.EventTypeChatDeleted:
 		return &chatdomain.Deleted{}, nil
 	case chatdomain.EventTypeChatClosed:
 		return &chatdomain.Closed{}, nil
 	case chatdomain.EventTypeChatReopened:
 		return &chatdomain.Reopened{}, nil
+	case chatdomain.EventTypeOwnershipTransferred:
+		return &chatdomain.OwnershipTransferred{}, nil
+	case chatdomain.EventTypeChatSnapshotted:
+		return &chatdomain.Snapshotted{}, nil
 	default:
 		return nil, fmt.Errorf("unknown event type: %s", eventType)
 	}
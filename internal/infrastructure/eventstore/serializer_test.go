@@ -7,7 +7,9 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	chatdomain "github.com/lllypuk/flowra/internal/domain/chat"
 	"github.com/lllypuk/flowra/internal/domain/event"
+	"github.com/lllypuk/flowra/internal/domain/uuid"
 	"github.com/lllypuk/flowra/internal/infrastructure/eventstore"
 )
 
@@ -150,3 +152,34 @@ func TestEventSerializer_PreservesOccurredAtTime(t *testing.T) {
 	// Checking that time sav
 	assert.Equal(t, now, doc.Metadata.Timestamp)
 }
+
+func TestEventSerializer_SnapshotRoundTrip(t *testing.T) {
+	serializer := eventstore.NewEventSerializer()
+
+	userID := uuid.NewUUID()
+	c, err := chatdomain.NewChat(uuid.NewUUID(), chatdomain.TypeDiscussion, true, userID)
+	require.NoError(t, err)
+	require.NoError(t, c.ConvertToBug("Crash", userID))
+	require.NoError(t, c.AssignUser(&userID, userID))
+	dueDate := time.Now().Add(24 * time.Hour)
+	require.NoError(t, c.SetDueDate(&dueDate, userID))
+	_, err = c.AddChecklistItem("Reproduce", userID)
+	require.NoError(t, err)
+
+	doc, err := serializer.Serialize(chatdomain.NewSnapshotted(c, c.Version(), event.Metadata{}))
+	require.NoError(t, err)
+
+	restored, err := serializer.Deserialize(doc)
+	require.NoError(t, err)
+	snapshot, ok := restored.(*chatdomain.Snapshotted)
+	require.True(t, ok)
+	assert.Equal(t, c.Version(), snapshot.Version())
+
+	rebuilt := chatdomain.NewEmptyChat()
+	require.NoError(t, rebuilt.Apply(snapshot))
+	assert.Equal(t, c.Title(), rebuilt.Title())
+	assert.Equal(t, userID, *rebuilt.AssigneeID())
+	assert.True(t, dueDate.Equal(*rebuilt.DueDate()))
+	assert.Len(t, rebuilt.Checklist(), 1)
+	assert.True(t, c.SLAClock().OpenedAt().Equal(rebuilt.SLAClock().OpenedAt()))
+}
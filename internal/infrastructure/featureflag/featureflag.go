@@ -0,0 +1,219 @@
+// Package featureflag stores runtime feature flags shared between instances.
+package featureflag
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/lllypuk/flowra/internal/domain/uuid"
+)
+
+// DefaultRedisKey is the Redis hash that holds the flags.
+const DefaultRedisKey = "flowra:feature_flags"
+
+// Errors returned by the flag stores.
+var (
+	ErrNotFound    = errors.New("feature flag not found")
+	ErrInvalidName = errors.New("feature flag name must be 1-64 lowercase letters, digits, '.', '-' or '_'")
+)
+
+//nolint:gochecknoglobals // compiled once
+var namePattern = regexp.MustCompile(`^[a-z0-9][a-z0-9._-]{0,63}$`)
+
+// Flag is a named on/off switch.
+type Flag struct {
+	Name      string    `json:"name"`
+	Enabled   bool      `json:"enabled"`
+	UpdatedBy uuid.UUID `json:"updated_by,omitempty"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// ValidateName checks that name is a valid flag name.
+func ValidateName(name string) error {
+	if !namePattern.MatchString(name) {
+		return ErrInvalidName
+	}
+	return nil
+}
+
+// Store persists feature flags.
+type Store interface {
+	// List returns every flag sorted by name.
+	List(ctx context.Context) ([]Flag, error)
+
+	// Get returns the flag, or ErrNotFound.
+	Get(ctx context.Context, name string) (Flag, error)
+
+	// Set creates or replaces the flag.
+	Set(ctx context.Context, flag Flag) error
+
+	// Delete removes the flag. Deleting a missing flag returns ErrNotFound.
+	Delete(ctx context.Context, name string) error
+}
+
+// Enabled reports whether the flag is on. Missing flags and store errors count as off.
+func Enabled(ctx context.Context, store Store, name string) bool {
+	flag, err := store.Get(ctx, name)
+	return err == nil && flag.Enabled
+}
+
+// MemoryStore keeps flags in memory (single instance, tests).
+type MemoryStore struct {
+	mu    sync.Mutex
+	flags map[string]Flag
+}
+
+// NewMemoryStore creates an in-memory flag store.
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{flags: make(map[string]Flag)}
+}
+
+// List returns every flag sorted by name.
+func (s *MemoryStore) List(_ context.Context) ([]Flag, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	flags := make([]Flag, 0, len(s.flags))
+	for _, flag := range s.flags {
+		flags = append(flags, flag)
+	}
+	sortFlags(flags)
+	return flags, nil
+}
+
+// Get returns the flag.
+func (s *MemoryStore) Get(_ context.Context, name string) (Flag, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	flag, ok := s.flags[name]
+	if !ok {
+		return Flag{}, ErrNotFound
+	}
+	return flag, nil
+}
+
+// Set creates or replaces the flag.
+func (s *MemoryStore) Set(_ context.Context, flag Flag) error {
+	if err := ValidateName(flag.Name); err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.flags[flag.Name] = flag
+	return nil
+}
+
+// Delete removes the flag.
+func (s *MemoryStore) Delete(_ context.Context, name string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.flags[name]; !ok {
+		return ErrNotFound
+	}
+	delete(s.flags, name)
+	return nil
+}
+
+// RedisClient defines the Redis hash operations needed by the flag store.
+type RedisClient interface {
+	HGetAll(ctx context.Context, key string) (map[string]string, error)
+	// HGet returns the value of field, or an empty string if it does not exist.
+	HGet(ctx context.Context, key, field string) (string, error)
+	HSet(ctx context.Context, key, field, value string) error
+	// HDel returns the number of fields removed.
+	HDel(ctx context.Context, key, field string) (int64, error)
+}
+
+// RedisStore shares flags between instances through a Redis hash.
+type RedisStore struct {
+	client RedisClient
+	key    string
+}
+
+// NewRedisStore creates a Redis-based flag store.
+func NewRedisStore(client RedisClient, key string) *RedisStore {
+	if key == "" {
+		key = DefaultRedisKey
+	}
+	return &RedisStore{client: client, key: key}
+}
+
+// List returns every flag sorted by name.
+func (s *RedisStore) List(ctx context.Context) ([]Flag, error) {
+	raw, err := s.client.HGetAll(ctx, s.key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load feature flags: %w", err)
+	}
+
+	flags := make([]Flag, 0, len(raw))
+	for name, value := range raw {
+		flag, decodeErr := decodeFlag(name, value)
+		if decodeErr != nil {
+			return nil, decodeErr
+		}
+		flags = append(flags, flag)
+	}
+	sortFlags(flags)
+	return flags, nil
+}
+
+// Get returns the flag.
+func (s *RedisStore) Get(ctx context.Context, name string) (Flag, error) {
+	raw, err := s.client.HGet(ctx, s.key, name)
+	if err != nil {
+		return Flag{}, fmt.Errorf("failed to load feature flag: %w", err)
+	}
+	if raw == "" {
+		return Flag{}, ErrNotFound
+	}
+	return decodeFlag(name, raw)
+}
+
+// Set creates or replaces the flag.
+func (s *RedisStore) Set(ctx context.Context, flag Flag) error {
+	if err := ValidateName(flag.Name); err != nil {
+		return err
+	}
+	raw, err := json.Marshal(flag)
+	if err != nil {
+		return fmt.Errorf("failed to encode feature flag: %w", err)
+	}
+	if err = s.client.HSet(ctx, s.key, flag.Name, string(raw)); err != nil {
+		return fmt.Errorf("failed to save feature flag: %w", err)
+	}
+	return nil
+}
+
+// Delete removes the flag.
+func (s *RedisStore) Delete(ctx context.Context, name string) error {
+	removed, err := s.client.HDel(ctx, s.key, name)
+	if err != nil {
+		return fmt.Errorf("failed to delete feature flag: %w", err)
+	}
+	if removed == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+func decodeFlag(name, raw string) (Flag, error) {
+	var flag Flag
+	if err := json.Unmarshal([]byte(raw), &flag); err != nil {
+		return Flag{}, fmt.Errorf("failed to decode feature flag %q: %w", name, err)
+	}
+	flag.Name = name
+	return flag, nil
+}
+
+func sortFlags(flags []Flag) {
+	sort.Slice(flags, func(i, j int) bool { return flags[i].Name < flags[j].Name })
+}
@@ -0,0 +1,72 @@
+package featureflag_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/lllypuk/flowra/internal/infrastructure/featureflag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeRedisHash struct {
+	fields map[string]string
+}
+
+func (f *fakeRedisHash) HGetAll(_ context.Context, _ string) (map[string]string, error) {
+	out := make(map[string]string, len(f.fields))
+	for k, v := range f.fields {
+		out[k] = v
+	}
+	return out, nil
+}
+
+func (f *fakeRedisHash) HGet(_ context.Context, _, field string) (string, error) {
+	return f.fields[field], nil
+}
+
+func (f *fakeRedisHash) HSet(_ context.Context, _, field, value string) error {
+	f.fields[field] = value
+	return nil
+}
+
+func (f *fakeRedisHash) HDel(_ context.Context, _, field string) (int64, error) {
+	if _, ok := f.fields[field]; !ok {
+		return 0, nil
+	}
+	delete(f.fields, field)
+	return 1, nil
+}
+
+func TestStores(t *testing.T) {
+	stores := map[string]featureflag.Store{
+		"memory": featureflag.NewMemoryStore(),
+		"redis":  featureflag.NewRedisStore(&fakeRedisHash{fields: map[string]string{}}, ""),
+	}
+
+	for name, store := range stores {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+
+			_, err := store.Get(ctx, "search.v2")
+			require.ErrorIs(t, err, featureflag.ErrNotFound)
+			assert.False(t, featureflag.Enabled(ctx, store, "search.v2"))
+
+			require.NoError(t, store.Set(ctx, featureflag.Flag{Name: "search.v2", Enabled: true}))
+			require.NoError(t, store.Set(ctx, featureflag.Flag{Name: "board-beta", Enabled: false}))
+			assert.True(t, featureflag.Enabled(ctx, store, "search.v2"))
+
+			flags, err := store.List(ctx)
+			require.NoError(t, err)
+			require.Len(t, flags, 2)
+			assert.Equal(t, "board-beta", flags[0].Name)
+			assert.Equal(t, "search.v2", flags[1].Name)
+
+			require.ErrorIs(t, store.Set(ctx, featureflag.Flag{Name: "Bad Name"}), featureflag.ErrInvalidName)
+
+			require.NoError(t, store.Delete(ctx, "search.v2"))
+			require.ErrorIs(t, store.Delete(ctx, "search.v2"), featureflag.ErrNotFound)
+			assert.False(t, featureflag.Enabled(ctx, store, "search.v2"))
+		})
+	}
+}
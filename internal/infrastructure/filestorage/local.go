@@ -12,6 +12,9 @@ import (
 	"github.com/lllypuk/flowra/internal/domain/uuid"
 )
 
+// previewSuffix is appended to the file ID to name the preview of a file.
+const previewSuffix = ".preview.jpg"
+
 // LocalStorage stores files on the local filesystem.
 type LocalStorage struct {
 	baseDir string
@@ -73,7 +76,50 @@ func (s *LocalStorage) FilePath(fileID uuid.UUID, fileName string) (string, erro
 	return cleanPath, nil
 }
 
-// Delete removes a stored file.
+// PreviewPath returns the full path to the preview of a stored file.
+// Previews are JPEG images stored next to the original as <fileID>.preview.jpg.
+func (s *LocalStorage) PreviewPath(fileID uuid.UUID) (string, error) {
+	if fileID.IsZero() {
+		return "", errors.New("invalid file ID")
+	}
+	cleanPath := filepath.Clean(filepath.Join(s.baseDir, fileID.String()+previewSuffix))
+	if !strings.HasPrefix(cleanPath, s.baseDir+string(filepath.Separator)) {
+		return "", errors.New("path traversal detected: resolved path is outside base directory")
+	}
+	return cleanPath, nil
+}
+
+// SavePreview stores the preview of a file, replacing an earlier one.
+// The preview is written to a temporary file first, so readers never see a partial image.
+func (s *LocalStorage) SavePreview(fileID uuid.UUID, reader io.Reader) error {
+	path, err := s.PreviewPath(fileID)
+	if err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(s.baseDir, fileID.String()+".preview-*")
+	if err != nil {
+		return fmt.Errorf("failed to create preview: %w", err)
+	}
+	tmpPath := tmp.Name()
+
+	if _, copyErr := io.Copy(tmp, reader); copyErr != nil {
+		_ = tmp.Close()
+		_ = os.Remove(tmpPath)
+		return fmt.Errorf("failed to write preview: %w", copyErr)
+	}
+	if closeErr := tmp.Close(); closeErr != nil {
+		_ = os.Remove(tmpPath)
+		return fmt.Errorf("failed to write preview: %w", closeErr)
+	}
+	if renameErr := os.Rename(tmpPath, path); renameErr != nil {
+		_ = os.Remove(tmpPath)
+		return fmt.Errorf("failed to store preview: %w", renameErr)
+	}
+	return nil
+}
+
+// Delete removes a stored file and its preview.
 func (s *LocalStorage) Delete(fileID uuid.UUID, fileName string) error {
 	path, err := s.FilePath(fileID, fileName)
 	if err != nil {
@@ -82,6 +128,11 @@ func (s *LocalStorage) Delete(fileID uuid.UUID, fileName string) error {
 	if removeErr := os.Remove(path); removeErr != nil && !os.IsNotExist(removeErr) {
 		return fmt.Errorf("failed to delete file: %w", removeErr)
 	}
+	if previewPath, previewErr := s.PreviewPath(fileID); previewErr == nil {
+		if removeErr := os.Remove(previewPath); removeErr != nil && !os.IsNotExist(removeErr) {
+			return fmt.Errorf("failed to delete preview: %w", removeErr)
+		}
+	}
 	return nil
 }
 
@@ -200,6 +200,52 @@ func TestLocalStorage_Delete(t *testing.T) {
 	})
 }
 
+func TestLocalStorage_SavePreview(t *testing.T) {
+	t.Run("stores the preview next to the original", func(t *testing.T) {
+		storage := newTestStorage(t)
+
+		fileID, err := storage.Save(strings.NewReader("original"), "photo.jpg")
+		require.NoError(t, err)
+		require.NoError(t, storage.SavePreview(fileID, strings.NewReader("first")))
+		require.NoError(t, storage.SavePreview(fileID, strings.NewReader("preview")))
+
+		previewPath, err := storage.PreviewPath(fileID)
+		require.NoError(t, err)
+		originalPath, err := storage.FilePath(fileID, "photo.jpg")
+		require.NoError(t, err)
+		assert.NotEqual(t, originalPath, previewPath)
+		assert.Equal(t, filepath.Dir(originalPath), filepath.Dir(previewPath))
+
+		data, err := os.ReadFile(previewPath)
+		require.NoError(t, err)
+		assert.Equal(t, "preview", string(data))
+		original, err := os.ReadFile(originalPath)
+		require.NoError(t, err)
+		assert.Equal(t, "original", string(original))
+	})
+
+	t.Run("deleting the file deletes its preview", func(t *testing.T) {
+		storage := newTestStorage(t)
+
+		fileID, err := storage.Save(strings.NewReader("original"), "scan.pdf")
+		require.NoError(t, err)
+		require.NoError(t, storage.SavePreview(fileID, strings.NewReader("preview")))
+
+		require.NoError(t, storage.Delete(fileID, "scan.pdf"))
+
+		previewPath, err := storage.PreviewPath(fileID)
+		require.NoError(t, err)
+		_, statErr := os.Stat(previewPath)
+		assert.True(t, os.IsNotExist(statErr))
+	})
+
+	t.Run("rejects empty file IDs", func(t *testing.T) {
+		storage := newTestStorage(t)
+
+		assert.Error(t, storage.SavePreview(uuid.UUID(""), strings.NewReader("preview")))
+	})
+}
+
 func TestLocalStorage_Exists(t *testing.T) {
 	t.Run("returns true for existing file", func(t *testing.T) {
 		storage := newTestStorage(t)
@@ -0,0 +1,70 @@
+package healthcheck
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/lllypuk/flowra/internal/application/appcore"
+)
+
+// defaultJWKSTimeout bounds a single JWKS reachability probe.
+const defaultJWKSTimeout = 3 * time.Second
+
+// JWKSChecker checks that the identity provider's JWKS endpoint is reachable.
+type JWKSChecker struct {
+	url    string
+	client *http.Client
+}
+
+// NewJWKSChecker creates a new JWKS reachability health checker.
+// A nil client uses a default client with a short timeout.
+func NewJWKSChecker(url string, client *http.Client) *JWKSChecker {
+	if client == nil {
+		client = &http.Client{Timeout: defaultJWKSTimeout}
+	}
+
+	return &JWKSChecker{
+		url:    url,
+		client: client,
+	}
+}
+
+// Name returns the name of this health checker.
+func (c *JWKSChecker) Name() string {
+	return "keycloak_jwks"
+}
+
+// Check performs the health check.
+func (c *JWKSChecker) Check(ctx context.Context) appcore.HealthStatus {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.url, nil)
+	if err != nil {
+		return appcore.HealthStatus{
+			Healthy:   false,
+			Message:   fmt.Sprintf("failed to build JWKS request: %v", err),
+			CheckedAt: time.Now(),
+		}
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return appcore.HealthStatus{
+			Healthy:   false,
+			Message:   fmt.Sprintf("JWKS endpoint unreachable: %v", err),
+			CheckedAt: time.Now(),
+		}
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	healthy := resp.StatusCode == http.StatusOK
+
+	return appcore.HealthStatus{
+		Healthy:   healthy,
+		Message:   fmt.Sprintf("JWKS endpoint responded with %d", resp.StatusCode),
+		Details:   map[string]any{"status_code": resp.StatusCode},
+		CheckedAt: time.Now(),
+	}
+}
@@ -0,0 +1,97 @@
+package healthcheck
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"github.com/lllypuk/flowra/internal/application/appcore"
+)
+
+// LagGate reports not ready while the outbox backlog or projection lag exceeds its limits.
+// Projection lag is measured as the age of the oldest unprocessed outbox event,
+// since read models are only updated once that event has been published.
+type LagGate struct {
+	outbox      appcore.Outbox
+	maxBacklog  int64
+	maxLag      time.Duration
+	startupOnly bool
+	caughtUp    atomic.Bool
+}
+
+// NewLagGate creates a readiness gate on outbox backlog and projection lag.
+// A zero threshold disables that criterion. With startupOnly the gate opens
+// permanently the first time the instance is within limits.
+func NewLagGate(outbox appcore.Outbox, maxBacklog int64, maxLag time.Duration, startupOnly bool) *LagGate {
+	return &LagGate{
+		outbox:      outbox,
+		maxBacklog:  maxBacklog,
+		maxLag:      maxLag,
+		startupOnly: startupOnly,
+	}
+}
+
+// Name returns the name of this health checker.
+func (g *LagGate) Name() string {
+	return "projection_lag"
+}
+
+// Check performs the health check.
+func (g *LagGate) Check(ctx context.Context) appcore.HealthStatus {
+	if g.startupOnly && g.caughtUp.Load() {
+		return appcore.HealthStatus{
+			Healthy:   true,
+			Message:   "caught up after startup",
+			CheckedAt: time.Now(),
+		}
+	}
+
+	count, oldest, err := g.outbox.Stats(ctx)
+	if err != nil {
+		return appcore.HealthStatus{
+			Healthy:   false,
+			Message:   fmt.Sprintf("failed to get outbox stats: %v", err),
+			CheckedAt: time.Now(),
+		}
+	}
+
+	var lag time.Duration
+	if !oldest.IsZero() {
+		lag = time.Since(oldest)
+	}
+
+	details := map[string]any{
+		"backlog_count":      count,
+		"max_backlog":        g.maxBacklog,
+		"projection_lag":     lag.String(),
+		"max_projection_lag": g.maxLag.String(),
+	}
+
+	if g.maxBacklog > 0 && count > g.maxBacklog {
+		return appcore.HealthStatus{
+			Healthy:   false,
+			Message:   fmt.Sprintf("outbox backlog %d exceeds limit %d", count, g.maxBacklog),
+			Details:   details,
+			CheckedAt: time.Now(),
+		}
+	}
+
+	if g.maxLag > 0 && lag > g.maxLag {
+		return appcore.HealthStatus{
+			Healthy:   false,
+			Message:   fmt.Sprintf("projection lag %v exceeds limit %v", lag.Round(time.Second), g.maxLag),
+			Details:   details,
+			CheckedAt: time.Now(),
+		}
+	}
+
+	g.caughtUp.Store(true)
+
+	return appcore.HealthStatus{
+		Healthy:   true,
+		Message:   fmt.Sprintf("outbox backlog: %d events, projection lag: %v", count, lag.Round(time.Second)),
+		Details:   details,
+		CheckedAt: time.Now(),
+	}
+}
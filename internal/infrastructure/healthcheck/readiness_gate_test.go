@@ -0,0 +1,74 @@
+package healthcheck_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lllypuk/flowra/internal/application/appcore"
+	"github.com/lllypuk/flowra/internal/infrastructure/healthcheck"
+)
+
+// statsOutbox is an appcore.Outbox that only answers Stats.
+type statsOutbox struct {
+	appcore.Outbox
+
+	count  int64
+	oldest time.Time
+	err    error
+}
+
+func (o *statsOutbox) Stats(_ context.Context) (int64, time.Time, error) {
+	return o.count, o.oldest, o.err
+}
+
+func TestLagGate_Check(t *testing.T) {
+	tests := []struct {
+		name    string
+		outbox  *statsOutbox
+		healthy bool
+	}{
+		{name: "empty outbox", outbox: &statsOutbox{}, healthy: true},
+		{name: "within limits", outbox: &statsOutbox{count: 5, oldest: time.Now().Add(-time.Second)}, healthy: true},
+		{name: "backlog exceeded", outbox: &statsOutbox{count: 11}, healthy: false},
+		{name: "lag exceeded", outbox: &statsOutbox{count: 1, oldest: time.Now().Add(-time.Minute)}, healthy: false},
+		{name: "stats error", outbox: &statsOutbox{err: errors.New("boom")}, healthy: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gate := healthcheck.NewLagGate(tt.outbox, 10, 30*time.Second, false)
+
+			status := gate.Check(context.Background())
+
+			assert.Equal(t, tt.healthy, status.Healthy, status.Message)
+		})
+	}
+}
+
+func TestLagGate_StartupOnlyLatches(t *testing.T) {
+	outbox := &statsOutbox{count: 11}
+	gate := healthcheck.NewLagGate(outbox, 10, 0, true)
+
+	assert.False(t, gate.Check(context.Background()).Healthy)
+
+	outbox.count = 0
+	assert.True(t, gate.Check(context.Background()).Healthy)
+
+	// A later backlog spike no longer takes the instance out of rotation
+	outbox.count = 100
+	assert.True(t, gate.Check(context.Background()).Healthy)
+}
+
+func TestLagGate_AlwaysOn(t *testing.T) {
+	outbox := &statsOutbox{}
+	gate := healthcheck.NewLagGate(outbox, 10, 0, false)
+
+	assert.True(t, gate.Check(context.Background()).Healthy)
+
+	outbox.count = 100
+	assert.False(t, gate.Check(context.Background()).Healthy)
+}
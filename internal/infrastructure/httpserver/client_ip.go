@@ -0,0 +1,40 @@
+package httpserver
+
+import (
+	"net"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+)
+
+// NewIPExtractor returns the echo.IPExtractor used by c.RealIP().
+//
+// Forwarding headers are only honoured when the direct peer is one of the
+// trusted proxies; otherwise they could be spoofed by any client. With no
+// trusted proxies the peer address is used as-is. X-Forwarded-For is walked
+// from the right, skipping trusted hops, and X-Real-IP is used when no
+// X-Forwarded-For header is present.
+func NewIPExtractor(trustedProxies []*net.IPNet) echo.IPExtractor {
+	if len(trustedProxies) == 0 {
+		return echo.ExtractIPDirect()
+	}
+
+	opts := []echo.TrustOption{
+		echo.TrustLoopback(false),
+		echo.TrustLinkLocal(false),
+		echo.TrustPrivateNet(false),
+	}
+	for _, network := range trustedProxies {
+		opts = append(opts, echo.TrustIPRange(network))
+	}
+
+	fromXFF := echo.ExtractIPFromXFFHeader(opts...)
+	fromRealIP := echo.ExtractIPFromRealIPHeader(opts...)
+
+	return func(req *http.Request) string {
+		if req.Header.Get(echo.HeaderXForwardedFor) != "" {
+			return fromXFF(req)
+		}
+		return fromRealIP(req)
+	}
+}
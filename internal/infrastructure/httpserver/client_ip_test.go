@@ -0,0 +1,73 @@
+package httpserver_test
+
+import (
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/lllypuk/flowra/internal/infrastructure/httpserver"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewIPExtractor(t *testing.T) {
+	_, proxyNet, err := net.ParseCIDR("10.0.0.0/8")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name       string
+		trusted    []*net.IPNet
+		remoteAddr string
+		headers    map[string]string
+		expected   string
+	}{
+		{
+			name:       "no trusted proxies ignores headers",
+			remoteAddr: "10.0.0.5:1234",
+			headers:    map[string]string{echo.HeaderXForwardedFor: "203.0.113.7"},
+			expected:   "10.0.0.5",
+		},
+		{
+			name:       "trusted proxy forwards client",
+			trusted:    []*net.IPNet{proxyNet},
+			remoteAddr: "10.0.0.5:1234",
+			headers:    map[string]string{echo.HeaderXForwardedFor: "203.0.113.7, 10.0.0.9"},
+			expected:   "203.0.113.7",
+		},
+		{
+			name:       "spoofed header from untrusted peer",
+			trusted:    []*net.IPNet{proxyNet},
+			remoteAddr: "198.51.100.4:1234",
+			headers:    map[string]string{echo.HeaderXForwardedFor: "203.0.113.7"},
+			expected:   "198.51.100.4",
+		},
+		{
+			name:       "rightmost untrusted hop wins",
+			trusted:    []*net.IPNet{proxyNet},
+			remoteAddr: "10.0.0.5:1234",
+			headers:    map[string]string{echo.HeaderXForwardedFor: "1.1.1.1, 203.0.113.7"},
+			expected:   "203.0.113.7",
+		},
+		{
+			name:       "x-real-ip from trusted proxy",
+			trusted:    []*net.IPNet{proxyNet},
+			remoteAddr: "10.0.0.5:1234",
+			headers:    map[string]string{echo.HeaderXRealIP: "203.0.113.7"},
+			expected:   "203.0.113.7",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			req.RemoteAddr = tt.remoteAddr
+			for key, value := range tt.headers {
+				req.Header.Set(key, value)
+			}
+
+			assert.Equal(t, tt.expected, httpserver.NewIPExtractor(tt.trusted)(req))
+		})
+	}
+}
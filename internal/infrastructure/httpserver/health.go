@@ -4,6 +4,7 @@ package httpserver
 import (
 	"context"
 	"net/http"
+	"strconv"
 
 	"github.com/labstack/echo/v4"
 )
@@ -26,11 +27,17 @@ const (
 	StatusNotReady = "not_ready"
 )
 
+// DeepQueryParam is the query parameter that switches GET /health from the
+// cheap liveness check to a full dependency check.
+const DeepQueryParam = "deep"
+
 // ComponentStatus represents the health status of a single component.
 type ComponentStatus struct {
-	Name    string `json:"name"`
-	Status  string `json:"status"`
-	Message string `json:"message,omitempty"`
+	Name      string         `json:"name"`
+	Status    string         `json:"status"`
+	Message   string         `json:"message,omitempty"`
+	LatencyMS float64        `json:"latency_ms,omitempty"`
+	Details   map[string]any `json:"details,omitempty"`
 }
 
 // HealthResponse represents the response for health endpoints.
@@ -65,7 +72,8 @@ func NewHealthEndpoints(checker HealthChecker) *HealthEndpoints {
 
 // Register registers all health endpoints on the Echo instance.
 // Endpoints registered:
-//   - GET /health - Liveness probe (always returns 200 if app is running)
+//   - GET /health - Liveness probe (always returns 200 if app is running);
+//     with ?deep=true it checks every dependency and reports latencies
 //   - GET /ready - Readiness probe (returns 200 if ready, 503 if not)
 //   - GET /health/details - Detailed health status of all components
 func (h *HealthEndpoints) Register(e *echo.Echo) {
@@ -76,8 +84,13 @@ func (h *HealthEndpoints) Register(e *echo.Echo) {
 
 // handleHealth handles the liveness probe endpoint.
 // This endpoint always returns 200 OK if the application is running.
-// Used by Kubernetes liveness probes.
+// Used by Kubernetes liveness probes. With ?deep=true it runs the full
+// dependency checks instead, like /health/details.
 func (h *HealthEndpoints) handleHealth(c echo.Context) error {
+	if deep, _ := strconv.ParseBool(c.QueryParam(DeepQueryParam)); deep {
+		return h.handleHealthDetails(c)
+	}
+
 	return c.JSON(http.StatusOK, HealthResponse{
 		Status: StatusHealthy,
 	})
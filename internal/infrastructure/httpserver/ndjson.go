@@ -0,0 +1,89 @@
+package httpserver
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+)
+
+// MIMEApplicationNDJSON is the content type of newline-delimited JSON streams.
+const MIMEApplicationNDJSON = "application/x-ndjson"
+
+// ndjsonFlushEvery is the number of records written between flushes, so clients
+// receive records as they are produced without a syscall per record.
+const ndjsonFlushEvery = 100
+
+// NDJSONStream writes records to the client as newline-delimited JSON, one record
+// per line, flushing as it goes so memory stays flat however long the stream is.
+//
+// The response starts with the first record. An error before that is sent as a
+// regular error response; an error after it can no longer change the status, so it
+// ends the stream with a trailer line {"error": {"code": ..., "message": ...}}.
+// Clients detect a cut stream by the trailer and resume from the last record they
+// received.
+type NDJSONStream struct {
+	c       echo.Context
+	enc     *json.Encoder
+	pending int
+}
+
+// NewNDJSONStream creates a stream writing to the response of c.
+func NewNDJSONStream(c echo.Context) *NDJSONStream {
+	return &NDJSONStream{c: c}
+}
+
+// Write sends one record.
+func (s *NDJSONStream) Write(record any) error {
+	s.start()
+	if err := s.enc.Encode(record); err != nil {
+		return fmt.Errorf("failed to write record: %w", err)
+	}
+	s.pending++
+	if s.pending >= ndjsonFlushEvery {
+		s.flush()
+	}
+	return nil
+}
+
+// Started reports whether the response has been committed.
+func (s *NDJSONStream) Started() bool {
+	return s.enc != nil
+}
+
+// Close ends the stream. A nil err completes it, sending an empty stream if no
+// record was written; any other err is reported as described on NDJSONStream.
+func (s *NDJSONStream) Close(err error) error {
+	if err != nil && !s.Started() {
+		return RespondError(s.c, err)
+	}
+
+	s.start()
+	if err != nil {
+		_, apiError := mapError(err)
+		if encErr := s.enc.Encode(map[string]*Error{"error": apiError}); encErr != nil {
+			return fmt.Errorf("failed to write error trailer: %w", encErr)
+		}
+	}
+	s.flush()
+	return nil
+}
+
+func (s *NDJSONStream) start() {
+	if s.enc != nil {
+		return
+	}
+	res := s.c.Response()
+	res.Header().Set(echo.HeaderContentType, MIMEApplicationNDJSON)
+	res.Header().Set("X-Content-Type-Options", "nosniff")
+	res.WriteHeader(http.StatusOK)
+	s.enc = json.NewEncoder(res)
+}
+
+// flush pushes buffered records to the client. Writers that cannot flush, like
+// test recorders, keep buffering.
+func (s *NDJSONStream) flush() {
+	s.pending = 0
+	_ = http.NewResponseController(s.c.Response().Writer).Flush()
+}
@@ -0,0 +1,69 @@
+package httpserver_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/lllypuk/flowra/internal/domain/errs"
+	"github.com/lllypuk/flowra/internal/infrastructure/httpserver"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNDJSONStream(t *testing.T) {
+	newContext := func() (echo.Context, *httptest.ResponseRecorder) {
+		rec := httptest.NewRecorder()
+		return echo.New().NewContext(httptest.NewRequest(http.MethodGet, "/export", nil), rec), rec
+	}
+
+	t.Run("records are written one per line", func(t *testing.T) {
+		c, rec := newContext()
+		stream := httpserver.NewNDJSONStream(c)
+
+		require.NoError(t, stream.Write(map[string]int{"n": 1}))
+		require.NoError(t, stream.Write(map[string]int{"n": 2}))
+		require.NoError(t, stream.Close(nil))
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, httpserver.MIMEApplicationNDJSON, rec.Header().Get(echo.HeaderContentType))
+		assert.Equal(t, "{\"n\":1}\n{\"n\":2}\n", rec.Body.String())
+		assert.True(t, rec.Flushed)
+	})
+
+	t.Run("an empty stream is still a stream", func(t *testing.T) {
+		c, rec := newContext()
+
+		require.NoError(t, httpserver.NewNDJSONStream(c).Close(nil))
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, httpserver.MIMEApplicationNDJSON, rec.Header().Get(echo.HeaderContentType))
+		assert.Empty(t, rec.Body.String())
+	})
+
+	t.Run("errors before the first record get a regular response", func(t *testing.T) {
+		c, rec := newContext()
+		stream := httpserver.NewNDJSONStream(c)
+
+		require.NoError(t, stream.Close(errs.ErrForbidden))
+
+		assert.False(t, stream.Started())
+		assert.Equal(t, http.StatusForbidden, rec.Code)
+		assert.Contains(t, rec.Body.String(), `"code":"FORBIDDEN"`)
+	})
+
+	t.Run("errors after the first record end the stream with a trailer", func(t *testing.T) {
+		c, rec := newContext()
+		stream := httpserver.NewNDJSONStream(c)
+
+		require.NoError(t, stream.Write(map[string]int{"n": 1}))
+		require.NoError(t, stream.Close(errors.New("cursor lost")))
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t,
+			"{\"n\":1}\n{\"error\":{\"code\":\"INTERNAL_ERROR\",\"message\":\"An internal error occurred\"}}\n",
+			rec.Body.String())
+	})
+}
@@ -0,0 +1,93 @@
+package httpserver
+
+import (
+	"encoding/base64"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+)
+
+// CursorParam is the query parameter carrying a page cursor.
+const CursorParam = "cursor"
+
+// offsetCursorPrefix marks cursors that encode an offset, leaving room for
+// keyset cursors later.
+const offsetCursorPrefix = "o:"
+
+// Pagination describes the page returned by a list endpoint. Clients fetch the
+// adjacent pages by passing NextCursor or PrevCursor as the cursor parameter.
+type Pagination struct {
+	// Total is the number of items across all pages, omitted by lists that do not
+	// count them.
+	Total *int `json:"total,omitempty"`
+
+	Limit   int  `json:"limit"`
+	Offset  int  `json:"offset"`
+	HasMore bool `json:"has_more"`
+
+	NextCursor string `json:"next_cursor,omitempty"`
+	PrevCursor string `json:"prev_cursor,omitempty"`
+}
+
+// NewPagination describes the page at offset of a list with total items.
+func NewPagination(offset, limit, total int) *Pagination {
+	p := newPagination(offset, limit, offset+limit < total)
+	p.Total = &total
+	return p
+}
+
+// NewUncountedPagination describes the page at offset of a list without a total;
+// hasMore tells whether another page follows.
+func NewUncountedPagination(offset, limit int, hasMore bool) *Pagination {
+	return newPagination(offset, limit, hasMore)
+}
+
+func newPagination(offset, limit int, hasMore bool) *Pagination {
+	p := &Pagination{Limit: limit, Offset: offset, HasMore: hasMore}
+	if hasMore {
+		p.NextCursor = EncodeOffsetCursor(offset + limit)
+	}
+	if offset > 0 {
+		p.PrevCursor = EncodeOffsetCursor(max(offset-limit, 0))
+	}
+	return p
+}
+
+// EncodeOffsetCursor returns the opaque cursor of the page starting at offset.
+func EncodeOffsetCursor(offset int) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(offsetCursorPrefix + strconv.Itoa(offset)))
+}
+
+// CursorOffset returns the offset encoded in the cursor query parameter. ok is
+// false when the parameter is missing or not a valid cursor, in which case the
+// offset parameters of the list apply.
+func CursorOffset(c echo.Context) (int, bool) {
+	cursor := c.QueryParam(CursorParam)
+	if cursor == "" {
+		return 0, false
+	}
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return 0, false
+	}
+	value, found := strings.CutPrefix(string(raw), offsetCursorPrefix)
+	if !found {
+		return 0, false
+	}
+	offset, err := strconv.Atoi(value)
+	if err != nil || offset < 0 {
+		return 0, false
+	}
+	return offset, true
+}
+
+// RespondPage sends a 200 OK response with one page of a list and its pagination.
+func RespondPage(c echo.Context, data any, page *Pagination) error {
+	return c.JSON(http.StatusOK, Response{
+		Success:    true,
+		Data:       data,
+		Pagination: page,
+	})
+}
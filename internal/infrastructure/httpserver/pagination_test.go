@@ -0,0 +1,128 @@
+package httpserver_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/lllypuk/flowra/internal/infrastructure/httpserver"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewPagination(t *testing.T) {
+	total := func(n int) *int { return &n }
+
+	tests := []struct {
+		name     string
+		page     *httpserver.Pagination
+		expected httpserver.Pagination
+	}{
+		{
+			name: "first page",
+			page: httpserver.NewPagination(0, 20, 45),
+			expected: httpserver.Pagination{
+				Total: total(45), Limit: 20, Offset: 0, HasMore: true,
+				NextCursor: httpserver.EncodeOffsetCursor(20),
+			},
+		},
+		{
+			name: "middle page",
+			page: httpserver.NewPagination(20, 20, 45),
+			expected: httpserver.Pagination{
+				Total: total(45), Limit: 20, Offset: 20, HasMore: true,
+				NextCursor: httpserver.EncodeOffsetCursor(40),
+				PrevCursor: httpserver.EncodeOffsetCursor(0),
+			},
+		},
+		{
+			name: "last page",
+			page: httpserver.NewPagination(40, 20, 45),
+			expected: httpserver.Pagination{
+				Total: total(45), Limit: 20, Offset: 40,
+				PrevCursor: httpserver.EncodeOffsetCursor(20),
+			},
+		},
+		{
+			name: "previous page never starts before the first item",
+			page: httpserver.NewPagination(5, 20, 45),
+			expected: httpserver.Pagination{
+				Total: total(45), Limit: 20, Offset: 5, HasMore: true,
+				NextCursor: httpserver.EncodeOffsetCursor(25),
+				PrevCursor: httpserver.EncodeOffsetCursor(0),
+			},
+		},
+		{
+			name:     "empty list",
+			page:     httpserver.NewPagination(0, 20, 0),
+			expected: httpserver.Pagination{Total: total(0), Limit: 20},
+		},
+		{
+			name: "uncounted list",
+			page: httpserver.NewUncountedPagination(50, 50, true),
+			expected: httpserver.Pagination{
+				Limit: 50, Offset: 50, HasMore: true,
+				NextCursor: httpserver.EncodeOffsetCursor(100),
+				PrevCursor: httpserver.EncodeOffsetCursor(0),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, *tt.page)
+		})
+	}
+}
+
+func TestCursorOffset(t *testing.T) {
+	tests := []struct {
+		name   string
+		cursor string
+		offset int
+		ok     bool
+	}{
+		{name: "missing", cursor: "", ok: false},
+		{name: "offset cursor", cursor: httpserver.EncodeOffsetCursor(40), offset: 40, ok: true},
+		{name: "not base64", cursor: "%%%", ok: false},
+		{name: "unknown kind", cursor: "eDo0MA", ok: false},
+		{name: "negative offset", cursor: "bzotMQ", ok: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/items", nil)
+			q := req.URL.Query()
+			q.Set(httpserver.CursorParam, tt.cursor)
+			req.URL.RawQuery = q.Encode()
+			c := echo.New().NewContext(req, httptest.NewRecorder())
+
+			offset, ok := httpserver.CursorOffset(c)
+
+			assert.Equal(t, tt.ok, ok)
+			assert.Equal(t, tt.offset, offset)
+		})
+	}
+}
+
+func TestRespondPage(t *testing.T) {
+	rec := httptest.NewRecorder()
+	c := echo.New().NewContext(httptest.NewRequest(http.MethodGet, "/items", nil), rec)
+
+	require.NoError(t, httpserver.RespondPage(c, []string{"a", "b"}, httpserver.NewPagination(0, 2, 3)))
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	var body map[string]any
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+	assert.Equal(t, true, body["success"])
+	assert.Equal(t, []any{"a", "b"}, body["data"])
+	assert.Equal(t, map[string]any{
+		"total":       float64(3),
+		"limit":       float64(2),
+		"offset":      float64(0),
+		"has_more":    true,
+		"next_cursor": httpserver.EncodeOffsetCursor(2),
+	}, body["pagination"])
+}
@@ -1,24 +1,30 @@
 package httpserver
 
 import (
+	"context"
 	"errors"
 	"net/http"
 
 	"github.com/labstack/echo/v4"
+	"github.com/lllypuk/flowra/internal/application/appcore"
 	"github.com/lllypuk/flowra/internal/domain/errs"
+	"github.com/lllypuk/flowra/internal/pkg/problem"
+	"github.com/lllypuk/flowra/internal/pkg/validate"
 )
 
 // Response represents a standard API response.
 type Response struct {
-	Success bool   `json:"success"`
-	Data    any    `json:"data,omitempty"`
-	Error   *Error `json:"error,omitempty"`
+	Success    bool        `json:"success"`
+	Data       any         `json:"data,omitempty"`
+	Pagination *Pagination `json:"pagination,omitempty"`
+	Error      *Error      `json:"error,omitempty"`
 }
 
 // Error represents an error in the API response.
 type Error struct {
-	Code    string `json:"code"`
-	Message string `json:"message"`
+	Code    string         `json:"code"`
+	Message string         `json:"message"`
+	Details map[string]any `json:"details,omitempty"`
 }
 
 // HTTPError interface allows application errors to define their HTTP representation.
@@ -56,23 +62,46 @@ func RespondNoContent(c echo.Context) error {
 // RespondError sends an error JSON response based on the error type.
 func RespondError(c echo.Context, err error) error {
 	statusCode, apiError := mapError(err)
-	return c.JSON(statusCode, Response{
-		Success: false,
-		Error:   apiError,
-	})
+	return RespondErrorWithCode(c, statusCode, apiError.Code, apiError.Message)
 }
 
 // RespondErrorWithCode sends an error JSON response with a specific HTTP status code.
+// Clients that accept application/problem+json get RFC 7807 problem details instead
+// of the envelope.
 func RespondErrorWithCode(c echo.Context, code int, errorCode, message string) error {
+	return RespondErrorWithDetails(c, code, errorCode, message, nil)
+}
+
+// RespondErrorWithDetails sends an error JSON response carrying details, e.g. the
+// invalid fields of a request. details are nested in the envelope error and become
+// top-level members of problem details.
+func RespondErrorWithDetails(c echo.Context, code int, errorCode, message string, details map[string]any) error {
+	c.Response().Header().Add(echo.HeaderVary, echo.HeaderAccept)
+	if problem.Wanted(c) {
+		return problem.Respond(c, code, errorCode, message, details)
+	}
 	return c.JSON(code, Response{
 		Success: false,
 		Error: &Error{
 			Code:    errorCode,
 			Message: message,
+			Details: details,
 		},
 	})
 }
 
+// RespondValidationError sends a 400 VALIDATION_ERROR response for a request that
+// failed validation. The message is the first problem found and every invalid field
+// is listed under "fields". Errors other than validate.Errors are sent as is.
+func RespondValidationError(c echo.Context, err error) error {
+	var fieldErrs validate.Errors
+	if !errors.As(err, &fieldErrs) || len(fieldErrs) == 0 {
+		return RespondErrorWithCode(c, http.StatusBadRequest, "VALIDATION_ERROR", err.Error())
+	}
+	return RespondErrorWithDetails(c, http.StatusBadRequest, "VALIDATION_ERROR", fieldErrs[0].Message,
+		map[string]any{"fields": []validate.FieldError(fieldErrs)})
+}
+
 // mapError maps domain errors to HTTP status codes and API errors.
 func mapError(err error) (int, *Error) {
 	// First, check if the error implements HTTPError interface
@@ -116,7 +145,13 @@ func mapError(err error) (int, *Error) {
 			Message: "Access denied",
 		}
 
-	case errors.Is(err, errs.ErrConcurrentModification):
+	case errors.Is(err, appcore.ErrChatNotFound):
+		return http.StatusNotFound, &Error{
+			Code:    "CHAT_NOT_FOUND",
+			Message: "Chat not found",
+		}
+
+	case errors.Is(err, errs.ErrConcurrentModification), errors.Is(err, appcore.ErrConcurrencyConflict):
 		return http.StatusConflict, &Error{
 			Code:    "CONCURRENT_MODIFICATION",
 			Message: "Resource was modified by another request",
@@ -134,6 +169,12 @@ func mapError(err error) (int, *Error) {
 			Message: "State transition not allowed",
 		}
 
+	case errors.Is(err, context.DeadlineExceeded):
+		return http.StatusGatewayTimeout, &Error{
+			Code:    "REQUEST_TIMEOUT",
+			Message: "The request took too long to complete",
+		}
+
 	default:
 		return http.StatusInternalServerError, &Error{
 			Code:    "INTERNAL_ERROR",
@@ -1,14 +1,20 @@
 package httpserver_test
 
 import (
+	"context"
+	"encoding/json"
 	"errors"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"testing"
 
 	"github.com/labstack/echo/v4"
+	"github.com/lllypuk/flowra/internal/application/appcore"
 	"github.com/lllypuk/flowra/internal/domain/errs"
 	"github.com/lllypuk/flowra/internal/infrastructure/httpserver"
+	"github.com/lllypuk/flowra/internal/pkg/problem"
+	"github.com/lllypuk/flowra/internal/pkg/validate"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -133,6 +139,13 @@ func TestRespondError(t *testing.T) {
 			expectedCode:   "INVALID_INPUT",
 			expectedMsg:    "Invalid input data",
 		},
+		{
+			name:           "deadline exceeded",
+			err:            fmt.Errorf("failed to find chat: %w", context.DeadlineExceeded),
+			expectedStatus: http.StatusGatewayTimeout,
+			expectedCode:   "REQUEST_TIMEOUT",
+			expectedMsg:    "The request took too long to complete",
+		},
 		{
 			name:           "unauthorized error",
 			err:            errs.ErrUnauthorized,
@@ -229,3 +242,136 @@ func TestRespondErrorWithCode(t *testing.T) {
 	}`
 	assert.JSONEq(t, expectedBody, rec.Body.String())
 }
+
+func TestRespondError_ProblemJSON(t *testing.T) {
+	tests := []struct {
+		name         string
+		err          error
+		expectedBody string
+	}{
+		{
+			name: "chat not found",
+			err:  appcore.ErrChatNotFound,
+			expectedBody: `{
+				"type": "https://flowra.dev/problems/chat-not-found",
+				"title": "Chat not found",
+				"status": 404,
+				"detail": "Chat not found",
+				"instance": "/api/v1/chats/42",
+				"code": "CHAT_NOT_FOUND",
+				"request_id": "req-1"
+			}`,
+		},
+		{
+			name: "version conflict",
+			err:  fmt.Errorf("save chat: %w", appcore.ErrConcurrencyConflict),
+			expectedBody: `{
+				"type": "https://flowra.dev/problems/version-conflict",
+				"title": "Version conflict",
+				"status": 409,
+				"detail": "Resource was modified by another request",
+				"instance": "/api/v1/chats/42",
+				"code": "CONCURRENT_MODIFICATION",
+				"request_id": "req-1"
+			}`,
+		},
+		{
+			name: "forbidden",
+			err:  errs.ErrForbidden,
+			expectedBody: `{
+				"type": "https://flowra.dev/problems/insufficient-privilege",
+				"title": "Insufficient privilege",
+				"status": 403,
+				"detail": "Access denied",
+				"instance": "/api/v1/chats/42",
+				"code": "FORBIDDEN",
+				"request_id": "req-1"
+			}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/chats/42", nil)
+			req.Header.Set(echo.HeaderAccept, "application/problem+json, application/json;q=0.5")
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.Response().Header().Set(echo.HeaderXRequestID, "req-1")
+
+			require.NoError(t, httpserver.RespondError(c, tt.err))
+
+			assert.Equal(t, problem.ContentType, rec.Header().Get(echo.HeaderContentType))
+			assert.Equal(t, echo.HeaderAccept, rec.Header().Get(echo.HeaderVary))
+			assert.JSONEq(t, tt.expectedBody, rec.Body.String())
+		})
+	}
+}
+
+func TestRespondError_EnvelopeByDefault(t *testing.T) {
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/chats/42", nil)
+	req.Header.Set(echo.HeaderAccept, "application/json")
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	require.NoError(t, httpserver.RespondError(c, appcore.ErrChatNotFound))
+
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+	assert.Contains(t, rec.Header().Get(echo.HeaderContentType), echo.MIMEApplicationJSON)
+	assert.JSONEq(t, `{"success":false,"error":{"code":"CHAT_NOT_FOUND","message":"Chat not found"}}`,
+		rec.Body.String())
+}
+
+func TestRespondValidationError(t *testing.T) {
+	fieldErrs := validate.Errors{
+		{Field: "name", Rule: "required", Message: "name is required"},
+		{Field: "role", Rule: "oneof", Param: "admin member", Message: "role must be one of: admin, member"},
+	}
+
+	t.Run("envelope", func(t *testing.T) {
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/workspaces", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+
+		require.NoError(t, httpserver.RespondValidationError(c, fieldErrs))
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		assert.JSONEq(t, `{"success":false,"error":{
+			"code":"VALIDATION_ERROR",
+			"message":"name is required",
+			"details":{"fields":[
+				{"field":"name","rule":"required","message":"name is required"},
+				{"field":"role","rule":"oneof","param":"admin member","message":"role must be one of: admin, member"}
+			]}
+		}}`, rec.Body.String())
+	})
+
+	t.Run("problem details", func(t *testing.T) {
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/workspaces", nil)
+		req.Header.Set(echo.HeaderAccept, problem.ContentType)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+
+		require.NoError(t, httpserver.RespondValidationError(c, fieldErrs))
+
+		var body map[string]any
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+		assert.Equal(t, problem.TypeBase+"validation-failed", body["type"])
+		assert.Len(t, body["fields"], 2)
+	})
+
+	t.Run("plain error", func(t *testing.T) {
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/workspaces", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+
+		require.NoError(t, httpserver.RespondValidationError(c, errors.New("bad input")))
+
+		assert.JSONEq(t, `{"success":false,"error":{"code":"VALIDATION_ERROR","message":"bad input"}}`,
+			rec.Body.String())
+	})
+}
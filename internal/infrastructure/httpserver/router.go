@@ -5,6 +5,7 @@ import (
 	"net/http"
 
 	"github.com/labstack/echo/v4"
+	"github.com/lllypuk/flowra/internal/buildinfo"
 	"github.com/lllypuk/flowra/internal/middleware"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 )
@@ -20,9 +21,22 @@ type RouterConfig struct {
 	// WorkspaceMiddleware is the workspace access middleware.
 	WorkspaceMiddleware echo.MiddlewareFunc
 
+	// WorkspaceRateLimitMiddleware enforces per-workspace limits on workspace-scoped routes.
+	// It runs after WorkspaceMiddleware, which resolves the workspace.
+	WorkspaceRateLimitMiddleware echo.MiddlewareFunc
+
 	// RateLimitMiddleware is the rate limiting middleware.
 	RateLimitMiddleware echo.MiddlewareFunc
 
+	// TimeoutMiddleware sets route-specific deadlines on request contexts.
+	TimeoutMiddleware echo.MiddlewareFunc
+
+	// MaintenanceMiddleware answers requests with 503 while maintenance mode is on.
+	MaintenanceMiddleware echo.MiddlewareFunc
+
+	// ReadOnlyMiddleware answers mutating requests with 503 in read-only deployments.
+	ReadOnlyMiddleware echo.MiddlewareFunc
+
 	// CORSConfig is the CORS configuration.
 	CORSConfig middleware.CORSConfig
 
@@ -95,6 +109,21 @@ func (r *Router) setupGlobalMiddleware() {
 	// Logging middleware
 	r.echo.Use(middleware.Logging(r.config.LoggingConfig))
 
+	// Request deadlines (if configured), inside logging so 504 responses are logged
+	if r.config.TimeoutMiddleware != nil {
+		r.echo.Use(r.config.TimeoutMiddleware)
+	}
+
+	// Maintenance mode (if configured), logged but ahead of rate limiting
+	if r.config.MaintenanceMiddleware != nil {
+		r.echo.Use(r.config.MaintenanceMiddleware)
+	}
+
+	// Read-only mode (if configured)
+	if r.config.ReadOnlyMiddleware != nil {
+		r.echo.Use(r.config.ReadOnlyMiddleware)
+	}
+
 	// Rate limiting middleware (if configured)
 	if r.config.RateLimitMiddleware != nil {
 		r.echo.Use(r.config.RateLimitMiddleware)
@@ -123,6 +152,10 @@ func (r *Router) setupRouteGroups() {
 		r.workspace = r.auth.Group("/workspaces/:workspace_id")
 		r.logger.Warn("no workspace middleware configured, workspace routes skip membership check")
 	}
+
+	if r.config.WorkspaceRateLimitMiddleware != nil {
+		r.workspace.Use(r.config.WorkspaceRateLimitMiddleware)
+	}
 }
 
 // Echo returns the underlying Echo instance.
@@ -391,3 +424,21 @@ func (r *Router) RegisterMetricsEndpoint() {
 	// We use echo.WrapHandler to convert http.Handler to echo.HandlerFunc
 	r.echo.GET("/metrics", echo.WrapHandler(promhttp.Handler()))
 }
+
+// RegisterVersionEndpoint registers GET /api/v1/version reporting the build metadata.
+func (r *Router) RegisterVersionEndpoint(info buildinfo.Info) {
+	r.public.GET("/version", func(c echo.Context) error {
+		return RespondOK(c, info)
+	})
+}
+
+// RegisterDiagnosticsEndpoints mounts the diagnostics handler under /debug.
+// Access requires authentication and the system admin flag.
+func (r *Router) RegisterDiagnosticsEndpoints(handler http.Handler) {
+	debug := r.echo.Group("/debug")
+	if r.config.AuthMiddleware != nil {
+		debug.Use(r.config.AuthMiddleware)
+	}
+	debug.Use(middleware.RequireSystemAdmin())
+	debug.Any("/*", echo.WrapHandler(handler))
+}
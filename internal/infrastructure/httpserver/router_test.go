@@ -1,12 +1,16 @@
 package httpserver_test
 
 import (
+	"context"
+	"encoding/json"
 	"log/slog"
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/labstack/echo/v4"
+	"github.com/lllypuk/flowra/internal/buildinfo"
 	"github.com/lllypuk/flowra/internal/domain/uuid"
 	"github.com/lllypuk/flowra/internal/infrastructure/httpserver"
 	"github.com/lllypuk/flowra/internal/middleware"
@@ -264,6 +268,71 @@ func TestRouter_RegisterHealthEndpoints_NilCheck(t *testing.T) {
 	assert.Equal(t, http.StatusOK, rec.Code)
 }
 
+type stubHealthChecker struct {
+	components []httpserver.ComponentStatus
+	calls      int
+}
+
+func (s *stubHealthChecker) IsReady(_ context.Context) bool {
+	return true
+}
+
+func (s *stubHealthChecker) GetHealthStatus(_ context.Context) []httpserver.ComponentStatus {
+	s.calls++
+	return s.components
+}
+
+func TestRouter_RegisterHealthEndpointsWithChecker_Deep(t *testing.T) {
+	e := echo.New()
+	router := httpserver.NewRouter(e, httpserver.DefaultRouterConfig())
+	checker := &stubHealthChecker{components: []httpserver.ComponentStatus{
+		{Name: "mongodb", Status: httpserver.StatusHealthy, LatencyMS: 1.5},
+		{Name: "redis", Status: httpserver.StatusUnhealthy, Message: "connection refused", LatencyMS: 0.2},
+	}}
+	router.RegisterHealthEndpointsWithChecker(checker)
+
+	// Cheap liveness path does not touch dependencies
+	req := httptest.NewRequest(http.MethodGet, "/health", nil)
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, 0, checker.calls)
+
+	// Deep mode runs every check and reports latencies
+	req = httptest.NewRequest(http.MethodGet, "/health?deep=true", nil)
+	rec = httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusServiceUnavailable, rec.Code)
+	assert.Equal(t, 1, checker.calls)
+
+	var resp httpserver.HealthResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+	assert.Equal(t, httpserver.StatusUnhealthy, resp.Status)
+	require.Len(t, resp.Components, 2)
+	assert.InDelta(t, 1.5, resp.Components[0].LatencyMS, 0)
+}
+
+func TestRouter_RegisterVersionEndpoint(t *testing.T) {
+	e := echo.New()
+	router := httpserver.NewRouter(e, httpserver.DefaultRouterConfig())
+	router.RegisterVersionEndpoint(buildinfo.Info{
+		Version:   "v1.2.3",
+		Commit:    "abc123",
+		BuildDate: "2026-01-02T03:04:05Z",
+		GoVersion: "go1.26",
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/version", nil)
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Body.String(), `"version":"v1.2.3"`)
+	assert.Contains(t, rec.Body.String(), `"commit":"abc123"`)
+}
+
 func TestRouter_GlobalMiddleware(t *testing.T) {
 	e := echo.New()
 	config := httpserver.DefaultRouterConfig()
@@ -290,6 +359,57 @@ func TestRouter_GlobalMiddleware(t *testing.T) {
 	assert.Equal(t, http.StatusOK, rec.Code)
 }
 
+func TestRouter_MaintenanceMiddleware(t *testing.T) {
+	e := echo.New()
+	config := httpserver.DefaultRouterConfig()
+	maintenance := middleware.DefaultMaintenanceConfig()
+	maintenance.Checker = middleware.NewMaintenanceSwitch(true, "Back soon", nil, nil)
+	config.MaintenanceMiddleware = middleware.Maintenance(maintenance)
+
+	router := httpserver.NewRouter(e, config)
+	router.Public().GET("/test", func(c echo.Context) error {
+		return c.String(http.StatusOK, "ok")
+	})
+	router.RegisterHealthEndpoints(func() bool { return true })
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/test", nil)
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusServiceUnavailable, rec.Code)
+	assert.Contains(t, rec.Body.String(), "Back soon")
+
+	req = httptest.NewRequest(http.MethodGet, "/health", nil)
+	rec = httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusOK, rec.Code)
+}
+
+func TestRouter_WorkspaceRateLimitMiddleware(t *testing.T) {
+	e := echo.New()
+	workspaceID := uuid.NewUUID()
+	config := httpserver.DefaultRouterConfig()
+	config.WorkspaceMiddleware = func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			c.Set(string(middleware.ContextKeyWorkspaceID), workspaceID)
+			return next(c)
+		}
+	}
+	limiter := middleware.NewWorkspaceRateLimiter(middleware.NewMemoryRateLimitStore(), 1, time.Minute)
+	config.WorkspaceRateLimitMiddleware = limiter.Middleware()
+
+	router := httpserver.NewRouter(e, config)
+	router.Workspace().GET("/chats", func(c echo.Context) error {
+		return c.String(http.StatusOK, "ok")
+	})
+
+	for _, want := range []int{http.StatusOK, http.StatusTooManyRequests} {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/workspaces/"+workspaceID.String()+"/chats", nil)
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+		assert.Equal(t, want, rec.Code)
+	}
+}
+
 func TestRouter_RecoveryMiddleware(t *testing.T) {
 	e := echo.New()
 	config := httpserver.DefaultRouterConfig()
@@ -862,3 +982,34 @@ func TestRouter_MiddlewareChain(t *testing.T) {
 	assert.Less(t, authIdx, workspaceIdx)
 	assert.Less(t, workspaceIdx, handlerIdx)
 }
+
+func TestRouter_RegisterDiagnosticsEndpoints(t *testing.T) {
+	e := echo.New()
+	config := httpserver.DefaultRouterConfig()
+	config.AuthMiddleware = func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			isAdmin := c.Request().Header.Get("X-System-Admin") == "true"
+			c.Set(string(middleware.ContextKeyIsSystemAdmin), isAdmin)
+			return next(c)
+		}
+	}
+
+	router := httpserver.NewRouter(e, config)
+	router.RegisterDiagnosticsEndpoints(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(r.URL.Path))
+	}))
+
+	// Without system admin - should fail
+	req := httptest.NewRequest(http.MethodGet, "/debug/pprof/heap", nil)
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+
+	// With system admin - should reach the handler with the original path
+	req = httptest.NewRequest(http.MethodGet, "/debug/pprof/heap", nil)
+	req.Header.Set("X-System-Admin", "true")
+	rec = httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "/debug/pprof/heap", rec.Body.String())
+}
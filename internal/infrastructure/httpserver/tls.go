@@ -0,0 +1,183 @@
+package httpserver
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net"
+	"net/http"
+	"time"
+
+	"golang.org/x/crypto/acme"
+	"golang.org/x/crypto/acme/autocert"
+)
+
+// ALPN protocol identifiers advertised by the TLS listener.
+const (
+	alpnHTTP2 = "h2"
+	alpnHTTP1 = "http/1.1"
+)
+
+// Timeouts for the plain HTTP redirect listener.
+const (
+	redirectReadHeaderTimeout = 5 * time.Second
+	redirectShutdownTimeout   = 5 * time.Second
+)
+
+// TLS configuration errors.
+var (
+	ErrTLSSourceMissing   = errors.New("tls requires either a certificate/key pair or autocert hosts")
+	ErrTLSSourceAmbiguous = errors.New("tls certificate files and autocert are mutually exclusive")
+)
+
+// TLSOptions configures HTTPS served directly by the API process,
+// for deployments that run without a TLS-terminating proxy.
+type TLSOptions struct {
+	// CertFile and KeyFile point to a PEM certificate chain and private key.
+	CertFile string
+	KeyFile  string
+
+	// AutocertHosts enables ACME (Let's Encrypt) certificates for the listed hosts.
+	AutocertHosts    []string
+	AutocertCacheDir string
+	AutocertEmail    string
+
+	// HTTP2 advertises h2 via ALPN.
+	HTTP2 bool
+}
+
+// NewTLSConfig builds the listener TLS configuration. When autocert is used the
+// manager is returned as well so the caller can answer HTTP-01 challenges.
+func NewTLSConfig(opts TLSOptions) (*tls.Config, *autocert.Manager, error) {
+	hasFiles := opts.CertFile != "" || opts.KeyFile != ""
+	hasAutocert := len(opts.AutocertHosts) > 0
+
+	switch {
+	case hasFiles && hasAutocert:
+		return nil, nil, ErrTLSSourceAmbiguous
+	case hasAutocert:
+		manager := &autocert.Manager{
+			Prompt:     autocert.AcceptTOS,
+			HostPolicy: autocert.HostWhitelist(opts.AutocertHosts...),
+			Cache:      autocert.DirCache(opts.AutocertCacheDir),
+			Email:      opts.AutocertEmail,
+		}
+		cfg := manager.TLSConfig()
+		cfg.MinVersion = tls.VersionTLS12
+		cfg.NextProtos = append(nextProtos(opts.HTTP2), acme.ALPNProto)
+		return cfg, manager, nil
+	case opts.CertFile != "" && opts.KeyFile != "":
+		cert, err := tls.LoadX509KeyPair(opts.CertFile, opts.KeyFile)
+		if err != nil {
+			return nil, nil, fmt.Errorf("load tls key pair: %w", err)
+		}
+		return &tls.Config{
+			MinVersion:   tls.VersionTLS12,
+			Certificates: []tls.Certificate{cert},
+			NextProtos:   nextProtos(opts.HTTP2),
+		}, nil, nil
+	default:
+		return nil, nil, ErrTLSSourceMissing
+	}
+}
+
+// nextProtos returns the ALPN protocols to advertise.
+func nextProtos(http2 bool) []string {
+	if http2 {
+		return []string{alpnHTTP2, alpnHTTP1}
+	}
+	return []string{alpnHTTP1}
+}
+
+// ConfigureProtocols sets the protocols srv accepts. HTTP/1.1 is always served.
+// With TLS, HTTP/2 is negotiated via ALPN; without TLS it is accepted as
+// prior-knowledge h2c, which proxies speaking HTTP/2 to backends rely on.
+func ConfigureProtocols(srv *http.Server, http2, useTLS bool) {
+	protocols := new(http.Protocols)
+	protocols.SetHTTP1(true)
+	protocols.SetHTTP2(http2 && useTLS)
+	protocols.SetUnencryptedHTTP2(http2 && !useTLS)
+	srv.Protocols = protocols
+}
+
+// RedirectServer is a plain HTTP listener that redirects every request to HTTPS
+// and answers ACME HTTP-01 challenges when autocert is enabled.
+type RedirectServer struct {
+	addr   string
+	logger *slog.Logger
+	server *http.Server
+}
+
+// NewRedirectServer creates a redirect listener on addr pointing at the HTTPS
+// listener address httpsAddr. manager may be nil.
+func NewRedirectServer(addr, httpsAddr string, manager *autocert.Manager, logger *slog.Logger) *RedirectServer {
+	if logger == nil {
+		logger = slog.Default()
+	}
+
+	var handler http.Handler = RedirectToHTTPS(httpsAddr)
+	if manager != nil {
+		handler = manager.HTTPHandler(handler)
+	}
+
+	return &RedirectServer{
+		addr:   addr,
+		logger: logger,
+		server: &http.Server{
+			Addr:              addr,
+			Handler:           handler,
+			ReadHeaderTimeout: redirectReadHeaderTimeout,
+		},
+	}
+}
+
+// Run serves redirects until ctx is cancelled, then shuts the listener down.
+func (s *RedirectServer) Run(ctx context.Context) error {
+	errCh := make(chan error, 1)
+
+	go func() {
+		s.logger.InfoContext(ctx, "https redirect listener started", slog.String("address", s.addr))
+		if err := s.server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			errCh <- err
+		}
+		close(errCh)
+	}()
+
+	select {
+	case err := <-errCh:
+		if err != nil {
+			return fmt.Errorf("https redirect listener: %w", err)
+		}
+		return nil
+	case <-ctx.Done():
+	}
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), redirectShutdownTimeout)
+	defer cancel()
+
+	if err := s.server.Shutdown(shutdownCtx); err != nil {
+		return fmt.Errorf("shutdown https redirect listener: %w", err)
+	}
+	return nil
+}
+
+// RedirectToHTTPS returns a handler that permanently redirects to the same
+// host and path over HTTPS. A non-default port in httpsAddr is preserved.
+func RedirectToHTTPS(httpsAddr string) http.Handler {
+	_, port, _ := net.SplitHostPort(httpsAddr)
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		host := r.Host
+		if h, _, err := net.SplitHostPort(host); err == nil {
+			host = h
+		}
+		if port != "" && port != "443" {
+			host = net.JoinHostPort(host, port)
+		}
+
+		target := "https://" + host + r.URL.RequestURI()
+		http.Redirect(w, r, target, http.StatusMovedPermanently)
+	})
+}
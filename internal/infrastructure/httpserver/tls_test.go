@@ -0,0 +1,138 @@
+package httpserver_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/lllypuk/flowra/internal/infrastructure/httpserver"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/acme"
+)
+
+func writeSelfSignedPair(t *testing.T) (string, string) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "localhost"},
+		DNSNames:     []string{"localhost"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	dir := t.TempDir()
+	certFile := filepath.Join(dir, "cert.pem")
+	keyFile := filepath.Join(dir, "key.pem")
+	require.NoError(t, os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0o600))
+	require.NoError(t, os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}), 0o600))
+
+	return certFile, keyFile
+}
+
+func TestNewTLSConfig_CertFiles(t *testing.T) {
+	certFile, keyFile := writeSelfSignedPair(t)
+
+	cfg, manager, err := httpserver.NewTLSConfig(httpserver.TLSOptions{
+		CertFile: certFile,
+		KeyFile:  keyFile,
+		HTTP2:    true,
+	})
+	require.NoError(t, err)
+	assert.Nil(t, manager)
+	assert.Len(t, cfg.Certificates, 1)
+	assert.Equal(t, []string{"h2", "http/1.1"}, cfg.NextProtos)
+
+	cfg, _, err = httpserver.NewTLSConfig(httpserver.TLSOptions{CertFile: certFile, KeyFile: keyFile})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"http/1.1"}, cfg.NextProtos)
+}
+
+func TestNewTLSConfig_Autocert(t *testing.T) {
+	cfg, manager, err := httpserver.NewTLSConfig(httpserver.TLSOptions{
+		AutocertHosts:    []string{"flowra.example.com"},
+		AutocertCacheDir: t.TempDir(),
+		HTTP2:            true,
+	})
+	require.NoError(t, err)
+	require.NotNil(t, manager)
+	assert.NotNil(t, cfg.GetCertificate)
+	assert.Contains(t, cfg.NextProtos, "h2")
+	assert.Contains(t, cfg.NextProtos, acme.ALPNProto)
+}
+
+func TestNewTLSConfig_Errors(t *testing.T) {
+	_, _, err := httpserver.NewTLSConfig(httpserver.TLSOptions{})
+	require.ErrorIs(t, err, httpserver.ErrTLSSourceMissing)
+
+	_, _, err = httpserver.NewTLSConfig(httpserver.TLSOptions{
+		CertFile:      "cert.pem",
+		KeyFile:       "key.pem",
+		AutocertHosts: []string{"flowra.example.com"},
+	})
+	require.ErrorIs(t, err, httpserver.ErrTLSSourceAmbiguous)
+
+	_, _, err = httpserver.NewTLSConfig(httpserver.TLSOptions{CertFile: "missing.pem", KeyFile: "missing.pem"})
+	require.Error(t, err)
+}
+
+func TestConfigureProtocols(t *testing.T) {
+	srv := &http.Server{}
+	httpserver.ConfigureProtocols(srv, true, true)
+	assert.True(t, srv.Protocols.HTTP1())
+	assert.True(t, srv.Protocols.HTTP2())
+	assert.False(t, srv.Protocols.UnencryptedHTTP2())
+
+	httpserver.ConfigureProtocols(srv, true, false)
+	assert.False(t, srv.Protocols.HTTP2())
+	assert.True(t, srv.Protocols.UnencryptedHTTP2())
+
+	httpserver.ConfigureProtocols(srv, false, true)
+	assert.True(t, srv.Protocols.HTTP1())
+	assert.False(t, srv.Protocols.HTTP2())
+	assert.False(t, srv.Protocols.UnencryptedHTTP2())
+}
+
+func TestRedirectToHTTPS(t *testing.T) {
+	tests := []struct {
+		name      string
+		httpsAddr string
+		host      string
+		expected  string
+	}{
+		{"default port", ":443", "flowra.example.com", "https://flowra.example.com/chats?id=1"},
+		{"strips plain port", "0.0.0.0:443", "flowra.example.com:80", "https://flowra.example.com/chats?id=1"},
+		{"keeps custom port", "0.0.0.0:8443", "flowra.example.com:8080", "https://flowra.example.com:8443/chats?id=1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/chats?id=1", nil)
+			req.Host = tt.host
+			rec := httptest.NewRecorder()
+
+			httpserver.RedirectToHTTPS(tt.httpsAddr).ServeHTTP(rec, req)
+
+			assert.Equal(t, http.StatusMovedPermanently, rec.Code)
+			assert.Equal(t, tt.expected, rec.Header().Get("Location"))
+		})
+	}
+}
@@ -0,0 +1,169 @@
+// Package i18n provides message catalogs and locale negotiation for
+// templates and user-facing notification text.
+package i18n
+
+import (
+	"embed"
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"path"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// DefaultLocale is used when neither the user profile nor the request names a supported locale.
+const DefaultLocale = "en"
+
+// maxAcceptLanguageEntries bounds how many Accept-Language entries are considered.
+const maxAcceptLanguageEntries = 16
+
+//go:embed locales/*.json
+var localesFS embed.FS
+
+// Catalog holds translated messages keyed by locale and message key.
+type Catalog struct {
+	messages map[string]map[string]string
+	locales  []string
+}
+
+// Load reads the embedded message catalogs.
+func Load() (*Catalog, error) {
+	return LoadFS(localesFS, "locales")
+}
+
+// loadDefault caches the embedded catalogs, which never change at runtime.
+//
+//nolint:gochecknoglobals // Embedded catalogs are immutable and parsed once.
+var loadDefault = sync.OnceValues(Load)
+
+// MustLoad returns the embedded message catalogs and panics if they are malformed.
+func MustLoad() *Catalog {
+	catalog, err := loadDefault()
+	if err != nil {
+		panic(fmt.Sprintf("i18n: %v", err))
+	}
+	return catalog
+}
+
+// LoadFS reads "<locale>.json" catalogs from dir in fsys. The default locale must be present.
+func LoadFS(fsys fs.FS, dir string) (*Catalog, error) {
+	entries, err := fs.ReadDir(fsys, dir)
+	if err != nil {
+		return nil, fmt.Errorf("read locales: %w", err)
+	}
+
+	catalog := &Catalog{messages: make(map[string]map[string]string)}
+	for _, entry := range entries {
+		if entry.IsDir() || path.Ext(entry.Name()) != ".json" {
+			continue
+		}
+
+		data, readErr := fs.ReadFile(fsys, path.Join(dir, entry.Name()))
+		if readErr != nil {
+			return nil, fmt.Errorf("read locale %s: %w", entry.Name(), readErr)
+		}
+
+		var messages map[string]string
+		if unmarshalErr := json.Unmarshal(data, &messages); unmarshalErr != nil {
+			return nil, fmt.Errorf("parse locale %s: %w", entry.Name(), unmarshalErr)
+		}
+
+		locale := strings.TrimSuffix(entry.Name(), ".json")
+		catalog.messages[locale] = messages
+		catalog.locales = append(catalog.locales, locale)
+	}
+
+	if _, ok := catalog.messages[DefaultLocale]; !ok {
+		return nil, fmt.Errorf("default locale %q catalog is missing", DefaultLocale)
+	}
+	slices.Sort(catalog.locales)
+
+	return catalog, nil
+}
+
+// Locales returns the supported locales in sorted order.
+func (c *Catalog) Locales() []string {
+	return slices.Clone(c.locales)
+}
+
+// Supported returns the supported locale matching locale, or "" if there is none.
+// Region subtags fall back to the base language ("ru-RU" matches "ru").
+func (c *Catalog) Supported(locale string) string {
+	locale = strings.ToLower(strings.TrimSpace(locale))
+	if locale == "" {
+		return ""
+	}
+	if _, ok := c.messages[locale]; ok {
+		return locale
+	}
+	base, _, _ := strings.Cut(strings.ReplaceAll(locale, "_", "-"), "-")
+	if _, ok := c.messages[base]; ok {
+		return base
+	}
+	return ""
+}
+
+// Resolve picks the locale for a user: the profile locale when supported,
+// then the best Accept-Language match, then DefaultLocale.
+func (c *Catalog) Resolve(profileLocale, acceptLanguage string) string {
+	if locale := c.Supported(profileLocale); locale != "" {
+		return locale
+	}
+	if locale := c.MatchAcceptLanguage(acceptLanguage); locale != "" {
+		return locale
+	}
+	return DefaultLocale
+}
+
+// MatchAcceptLanguage returns the supported locale with the highest quality in an
+// Accept-Language header value, or "" if none match.
+func (c *Catalog) MatchAcceptLanguage(header string) string {
+	best, bestQuality := "", 0.0
+	for i, part := range strings.Split(header, ",") {
+		if i >= maxAcceptLanguageEntries {
+			break
+		}
+
+		tag, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+		quality := 1.0
+		if value, ok := strings.CutPrefix(strings.TrimSpace(params), "q="); ok {
+			parsed, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				continue
+			}
+			quality = parsed
+		}
+
+		locale := c.Supported(tag)
+		if locale != "" && quality > bestQuality {
+			best, bestQuality = locale, quality
+		}
+	}
+	return best
+}
+
+// Translate returns the message for key in locale, falling back to DefaultLocale
+// and then to the key itself. Args are applied with fmt.Sprintf.
+func (c *Catalog) Translate(locale, key string, args ...any) string {
+	message, ok := c.messages[locale][key]
+	if !ok {
+		message, ok = c.messages[DefaultLocale][key]
+	}
+	if !ok {
+		return key
+	}
+	if len(args) > 0 {
+		return fmt.Sprintf(message, args...)
+	}
+	return message
+}
+
+// Translator returns a function translating keys into locale, for template func maps.
+func (c *Catalog) Translator(locale string) func(key string, args ...any) string {
+	return func(key string, args ...any) string {
+		return c.Translate(locale, key, args...)
+	}
+}
@@ -0,0 +1,89 @@
+package i18n_test
+
+import (
+	"encoding/json"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lllypuk/flowra/internal/infrastructure/i18n"
+)
+
+func TestLoad_CatalogsHaveSameKeys(t *testing.T) {
+	catalog, err := i18n.Load()
+	require.NoError(t, err)
+	require.Contains(t, catalog.Locales(), i18n.DefaultLocale)
+
+	keys := func(locale string) []string {
+		data, readErr := os.ReadFile(filepath.Join("locales", locale+".json"))
+		require.NoError(t, readErr)
+		var messages map[string]string
+		require.NoError(t, json.Unmarshal(data, &messages))
+		return slices.Sorted(maps.Keys(messages))
+	}
+
+	expected := keys(i18n.DefaultLocale)
+	for _, locale := range catalog.Locales() {
+		assert.Equal(t, expected, keys(locale), "locale %s must define the same keys as %s", locale, i18n.DefaultLocale)
+	}
+}
+
+func TestLoadFS_RequiresDefaultLocale(t *testing.T) {
+	fsys := fstest.MapFS{
+		"locales/ru.json": {Data: []byte(`{"greeting": "Привет"}`)},
+	}
+
+	_, err := i18n.LoadFS(fsys, "locales")
+	require.Error(t, err)
+}
+
+func TestCatalog_Translate(t *testing.T) {
+	catalog := testCatalog(t)
+
+	assert.Equal(t, "Привет, Ann", catalog.Translate("ru", "greeting", "Ann"))
+	assert.Equal(t, "Bye", catalog.Translate("ru", "farewell"), "falls back to default locale")
+	assert.Equal(t, "missing.key", catalog.Translate("en", "missing.key"))
+	assert.Equal(t, "Hello, Bob", catalog.Translator("fr")("greeting", "Bob"))
+}
+
+func TestCatalog_Resolve(t *testing.T) {
+	catalog := testCatalog(t)
+
+	tests := []struct {
+		name           string
+		profileLocale  string
+		acceptLanguage string
+		expected       string
+	}{
+		{"profile wins", "ru", "en-US,en;q=0.9", "ru"},
+		{"profile region falls back to base", "ru_RU", "", "ru"},
+		{"unsupported profile uses header", "fr", "ru-RU,ru;q=0.9,en;q=0.8", "ru"},
+		{"quality ordering", "", "en;q=0.4, ru;q=0.7", "ru"},
+		{"invalid quality skipped", "", "ru;q=abc, en;q=0.1", "en"},
+		{"nothing matches", "", "de,fr;q=0.5", i18n.DefaultLocale},
+		{"empty", "", "", i18n.DefaultLocale},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, catalog.Resolve(tt.profileLocale, tt.acceptLanguage))
+		})
+	}
+}
+
+func testCatalog(t *testing.T) *i18n.Catalog {
+	t.Helper()
+
+	catalog, err := i18n.LoadFS(fstest.MapFS{
+		"locales/en.json": {Data: []byte(`{"greeting": "Hello, %s", "farewell": "Bye"}`)},
+		"locales/ru.json": {Data: []byte(`{"greeting": "Привет, %s"}`)},
+	}, "locales")
+	require.NoError(t, err)
+	return catalog
+}
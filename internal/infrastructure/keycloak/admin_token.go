@@ -10,6 +10,8 @@ import (
 	"strings"
 	"sync"
 	"time"
+
+	"github.com/lllypuk/flowra/internal/pkg/retry"
 )
 
 // AdminTokenConfig contains configuration for AdminTokenManager.
@@ -37,6 +39,10 @@ type AdminTokenConfig struct {
 
 	// HTTPClient is an optional custom HTTP client.
 	HTTPClient *http.Client
+
+	// Retry configures retries of idempotent requests on transport errors and
+	// 429/502/503/504 responses. Zero fields use the retry package defaults.
+	Retry retry.Policy
 }
 
 // AdminTokenManager manages admin API tokens with automatic caching and refresh.
@@ -77,6 +83,7 @@ func NewAdminTokenManager(config AdminTokenConfig) *AdminTokenManager {
 			Username:     config.Username,
 			Password:     config.Password,
 			TokenBuffer:  tokenBuffer,
+			Retry:        config.Retry,
 		},
 		httpClient: httpClient,
 	}
@@ -118,7 +125,7 @@ func (m *AdminTokenManager) refreshToken(ctx context.Context) (string, error) {
 	}
 	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
 
-	resp, err := m.httpClient.Do(req)
+	resp, err := doWithRetry(m.httpClient, m.config.Retry, req)
 	if err != nil {
 		return "", fmt.Errorf("admin token request failed: %w", err)
 	}
@@ -10,6 +10,8 @@ import (
 	"net/http"
 	"strings"
 	"time"
+
+	"github.com/lllypuk/flowra/internal/pkg/retry"
 )
 
 // Group client errors.
@@ -30,6 +32,10 @@ type GroupClientConfig struct {
 
 	// HTTPClient is an optional custom HTTP client.
 	HTTPClient *http.Client
+
+	// Retry configures retries of idempotent requests on transport errors and
+	// 429/502/503/504 responses. Zero fields use the retry package defaults.
+	Retry retry.Policy
 }
 
 // GroupClient handles group management operations with Keycloak Admin API.
@@ -54,6 +60,7 @@ func NewGroupClient(config GroupClientConfig, tokenManager *AdminTokenManager) *
 		config: GroupClientConfig{
 			KeycloakURL: strings.TrimSuffix(config.KeycloakURL, "/"),
 			Realm:       config.Realm,
+			Retry:       config.Retry,
 		},
 		tokenManager: tokenManager,
 		httpClient:   httpClient,
@@ -86,6 +93,7 @@ func (c *GroupClient) CreateGroup(ctx context.Context, name string) (string, err
 	req.Header.Set("Authorization", "Bearer "+token)
 	req.Header.Set("Content-Type", "application/json")
 
+	// Not retried: a repeated POST could create the group twice.
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
 		return "", fmt.Errorf("create group request failed: %w", err)
@@ -136,7 +144,7 @@ func (c *GroupClient) DeleteGroup(ctx context.Context, groupID string) error {
 	}
 	req.Header.Set("Authorization", "Bearer "+token)
 
-	resp, err := c.httpClient.Do(req)
+	resp, err := doWithRetry(c.httpClient, c.config.Retry, req)
 	if err != nil {
 		return fmt.Errorf("delete group request failed: %w", err)
 	}
@@ -191,7 +199,7 @@ func (c *GroupClient) modifyUserGroupMembership(
 	}
 	req.Header.Set("Authorization", "Bearer "+token)
 
-	resp, err := c.httpClient.Do(req)
+	resp, err := doWithRetry(c.httpClient, c.config.Retry, req)
 	if err != nil {
 		return fmt.Errorf("%s request failed: %w", operation, err)
 	}
@@ -235,7 +243,7 @@ func (c *GroupClient) GetGroup(ctx context.Context, groupID string) (*Group, err
 	}
 	req.Header.Set("Authorization", "Bearer "+token)
 
-	resp, err := c.httpClient.Do(req)
+	resp, err := doWithRetry(c.httpClient, c.config.Retry, req)
 	if err != nil {
 		return nil, fmt.Errorf("get group request failed: %w", err)
 	}
@@ -277,7 +285,7 @@ func (c *GroupClient) GetUserGroups(ctx context.Context, userID string) ([]Group
 	}
 	req.Header.Set("Authorization", "Bearer "+token)
 
-	resp, err := c.httpClient.Do(req)
+	resp, err := doWithRetry(c.httpClient, c.config.Retry, req)
 	if err != nil {
 		return nil, fmt.Errorf("get user groups request failed: %w", err)
 	}
@@ -104,7 +104,7 @@ func NewJWTValidator(config JWTValidatorConfig) (JWTValidator, error) {
 	}
 
 	issuerURL := fmt.Sprintf("%s/realms/%s", issuerBaseURL, config.Realm)
-	jwksURL := fmt.Sprintf("%s/realms/%s/protocol/openid-connect/certs", keycloakURL, config.Realm)
+	jwksURL := JWKSURL(keycloakURL, config.Realm)
 
 	logger.Info("initializing JWT validator",
 		slog.String("jwks_url", jwksURL),
@@ -252,6 +252,11 @@ func (v *jwtValidator) extractClaims(claims jwt.MapClaims) (*TokenClaims, error)
 	return tc, nil
 }
 
+// JWKSURL returns the JWKS endpoint of the given Keycloak realm.
+func JWKSURL(keycloakURL, realm string) string {
+	return fmt.Sprintf("%s/realms/%s/protocol/openid-connect/certs", strings.TrimSuffix(keycloakURL, "/"), realm)
+}
+
 // Close stops background JWKS refresh.
 func (v *jwtValidator) Close() error {
 	v.logger.Info("closing JWT validator")
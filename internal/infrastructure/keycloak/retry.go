@@ -0,0 +1,74 @@
+package keycloak
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/lllypuk/flowra/internal/pkg/retry"
+)
+
+// transientStatusError reports a Keycloak response status worth retrying.
+type transientStatusError struct {
+	status int
+}
+
+func (e *transientStatusError) Error() string {
+	return fmt.Sprintf("keycloak responded with transient status %d", e.status)
+}
+
+// isTransientStatus reports whether Keycloak is overloaded or restarting rather than rejecting the request.
+func isTransientStatus(status int) bool {
+	switch status {
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	default:
+		return false
+	}
+}
+
+// doWithRetry sends an idempotent request, repeating it on transport errors and transient
+// statuses according to policy. The last response is returned whatever its status, so
+// callers handle it exactly as they would a single attempt.
+func doWithRetry(client *http.Client, policy retry.Policy, req *http.Request) (*http.Response, error) {
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		return client.Do(req)
+	}
+
+	var resp *http.Response
+	err := retry.Do(req.Context(), policy, func(ctx context.Context) error {
+		if resp != nil {
+			resp.Body.Close()
+			resp = nil
+		}
+
+		attempt := req.Clone(ctx)
+		if req.GetBody != nil {
+			body, bodyErr := req.GetBody()
+			if bodyErr != nil {
+				return retry.Permanent(bodyErr)
+			}
+			attempt.Body = body
+		}
+
+		r, doErr := client.Do(attempt) //nolint:bodyclose // Closed by the next attempt or by the caller.
+		if doErr != nil {
+			return doErr
+		}
+		resp = r
+		if isTransientStatus(r.StatusCode) {
+			return &transientStatusError{status: r.StatusCode}
+		}
+		return nil
+	})
+
+	var statusErr *transientStatusError
+	if err != nil && !errors.As(err, &statusErr) {
+		if resp != nil {
+			resp.Body.Close()
+		}
+		return nil, err
+	}
+	return resp, nil
+}
@@ -1,15 +1,22 @@
 package keycloak
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
 	"strings"
 	"time"
+
+	"github.com/lllypuk/flowra/internal/pkg/retry"
 )
 
+// ErrUserExists is returned when the username or email is already taken in the realm.
+var ErrUserExists = errors.New("user already exists")
+
 // UserClientConfig contains configuration for UserClient.
 type UserClientConfig struct {
 	// KeycloakURL is the base URL of Keycloak server.
@@ -20,6 +27,10 @@ type UserClientConfig struct {
 
 	// HTTPClient is an optional custom HTTP client.
 	HTTPClient *http.Client
+
+	// Retry configures retries of idempotent requests on transport errors and
+	// 429/502/503/504 responses. Zero fields use the retry package defaults.
+	Retry retry.Policy
 }
 
 // UserClient handles user management operations with Keycloak Admin API.
@@ -57,6 +68,7 @@ func NewUserClient(config UserClientConfig, tokenManager *AdminTokenManager) *Us
 		config: UserClientConfig{
 			KeycloakURL: strings.TrimSuffix(config.KeycloakURL, "/"),
 			Realm:       config.Realm,
+			Retry:       config.Retry,
 		},
 		tokenManager: tokenManager,
 		httpClient:   httpClient,
@@ -81,7 +93,7 @@ func (c *UserClient) ListUsers(ctx context.Context, first, limit int) ([]User, e
 	}
 	req.Header.Set("Authorization", "Bearer "+token)
 
-	resp, err := c.httpClient.Do(req)
+	resp, err := doWithRetry(c.httpClient, c.config.Retry, req)
 	if err != nil {
 		return nil, fmt.Errorf("list users request failed: %w", err)
 	}
@@ -123,7 +135,7 @@ func (c *UserClient) GetUser(ctx context.Context, userID string) (*User, error)
 	}
 	req.Header.Set("Authorization", "Bearer "+token)
 
-	resp, err := c.httpClient.Do(req)
+	resp, err := doWithRetry(c.httpClient, c.config.Retry, req)
 	if err != nil {
 		return nil, fmt.Errorf("get user request failed: %w", err)
 	}
@@ -161,7 +173,7 @@ func (c *UserClient) CountUsers(ctx context.Context) (int, error) {
 	}
 	req.Header.Set("Authorization", "Bearer "+token)
 
-	resp, err := c.httpClient.Do(req)
+	resp, err := doWithRetry(c.httpClient, c.config.Retry, req)
 	if err != nil {
 		return 0, fmt.Errorf("count users request failed: %w", err)
 	}
@@ -181,6 +193,62 @@ func (c *UserClient) CountUsers(ctx context.Context) (int, error) {
 	return count, nil
 }
 
+// NewUser describes a user to create in Keycloak.
+type NewUser struct {
+	Username  string `json:"username"`
+	Email     string `json:"email"`
+	FirstName string `json:"firstName,omitempty"`
+	LastName  string `json:"lastName,omitempty"`
+	Enabled   bool   `json:"enabled"`
+	// RequiredActions are performed by the user on first login, e.g. UPDATE_PASSWORD.
+	RequiredActions []string `json:"requiredActions,omitempty"`
+}
+
+// CreateUser creates a user in the realm and returns its Keycloak ID.
+func (c *UserClient) CreateUser(ctx context.Context, user NewUser) (string, error) {
+	token, err := c.tokenManager.GetToken(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to get admin token: %w", err)
+	}
+
+	reqURL := fmt.Sprintf("%s/admin/realms/%s/users", c.config.KeycloakURL, c.config.Realm)
+
+	jsonBody, err := json.Marshal(user)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal request body: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, reqURL, bytes.NewReader(jsonBody))
+	if err != nil {
+		return "", fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	req.Header.Set("Content-Type", "application/json")
+
+	// Not retried: a repeated POST could report the created user as a conflict.
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("create user request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusCreated:
+		// Location: http://localhost:8090/admin/realms/flowra/users/abc-123-...
+		location := resp.Header.Get("Location")
+		userID := location[strings.LastIndex(location, "/")+1:]
+		if userID == "" {
+			return "", fmt.Errorf("invalid Location header: %q", location)
+		}
+		return userID, nil
+	case http.StatusConflict:
+		return "", ErrUserExists
+	default:
+		body, _ := io.ReadAll(resp.Body)
+		return "", fmt.Errorf("create user failed with status %d: %s", resp.StatusCode, string(body))
+	}
+}
+
 // DisplayName returns the full display name for a Keycloak user.
 // It concatenates FirstName and LastName, trimming any extra spaces.
 func (u *User) DisplayName() string {
@@ -5,10 +5,12 @@ import (
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
+	"sync/atomic"
 	"testing"
 	"time"
 
 	"github.com/lllypuk/flowra/internal/infrastructure/keycloak"
+	"github.com/lllypuk/flowra/internal/pkg/retry"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -213,6 +215,44 @@ func TestUserClient_GetUser(t *testing.T) {
 		assert.True(t, result.Enabled)
 	})
 
+	t.Run("retries transient errors", func(t *testing.T) {
+		var calls atomic.Int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			if calls.Add(1) == 1 {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(keycloak.User{ID: "user-123"})
+		}))
+		defer server.Close()
+
+		client := createTestUserClient(t, server.URL)
+
+		result, err := client.GetUser(context.Background(), "user-123")
+
+		require.NoError(t, err)
+		assert.Equal(t, "user-123", result.ID)
+		assert.Equal(t, int32(2), calls.Load())
+	})
+
+	t.Run("returns last transient status after retries", func(t *testing.T) {
+		var calls atomic.Int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			calls.Add(1)
+			w.WriteHeader(http.StatusBadGateway)
+		}))
+		defer server.Close()
+
+		client := createTestUserClient(t, server.URL)
+
+		_, err := client.GetUser(context.Background(), "user-123")
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "502")
+		assert.Equal(t, int32(retry.DefaultMaxAttempts), calls.Load())
+	})
+
 	t.Run("returns error for empty user ID", func(t *testing.T) {
 		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
 			w.WriteHeader(http.StatusBadRequest)
@@ -310,6 +350,69 @@ func TestUserClient_CountUsers(t *testing.T) {
 	})
 }
 
+func TestUserClient_CreateUser(t *testing.T) {
+	t.Run("creates user and returns its ID", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/admin/realms/flowra/users", r.URL.Path)
+			assert.Equal(t, http.MethodPost, r.Method)
+
+			var body map[string]any
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			assert.Equal(t, "alice", body["username"])
+			assert.Equal(t, "alice@example.com", body["email"])
+			assert.Equal(t, "Alice", body["firstName"])
+			assert.Equal(t, true, body["enabled"])
+			assert.Equal(t, []any{"UPDATE_PASSWORD"}, body["requiredActions"])
+
+			w.Header().Set("Location", "http://keycloak/admin/realms/flowra/users/kc-42")
+			w.WriteHeader(http.StatusCreated)
+		}))
+		defer server.Close()
+
+		client := createTestUserClient(t, server.URL)
+
+		userID, err := client.CreateUser(context.Background(), keycloak.NewUser{
+			Username:        "alice",
+			Email:           "alice@example.com",
+			FirstName:       "Alice",
+			Enabled:         true,
+			RequiredActions: []string{"UPDATE_PASSWORD"},
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, "kc-42", userID)
+	})
+
+	t.Run("returns ErrUserExists on conflict", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusConflict)
+		}))
+		defer server.Close()
+
+		client := createTestUserClient(t, server.URL)
+
+		_, err := client.CreateUser(context.Background(), keycloak.NewUser{Username: "alice"})
+
+		require.ErrorIs(t, err, keycloak.ErrUserExists)
+	})
+
+	t.Run("is not retried", func(t *testing.T) {
+		var calls atomic.Int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			calls.Add(1)
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}))
+		defer server.Close()
+
+		client := createTestUserClient(t, server.URL)
+
+		_, err := client.CreateUser(context.Background(), keycloak.NewUser{Username: "alice"})
+
+		require.Error(t, err)
+		assert.Equal(t, int32(1), calls.Load())
+	})
+}
+
 func TestUserClient_ContextCancellation(t *testing.T) {
 	t.Run("respects context cancellation", func(t *testing.T) {
 		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
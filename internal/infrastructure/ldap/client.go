@@ -0,0 +1,240 @@
+// Package ldap reads users from an LDAP directory for the user sync worker.
+package ldap
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+	"time"
+
+	goldap "github.com/go-ldap/ldap/v3"
+)
+
+// ErrInvalidConfig is returned by NewClient for incomplete settings or invalid filters.
+var ErrInvalidConfig = errors.New("invalid ldap configuration")
+
+// AttributeMap names the directory attributes a user is read from.
+type AttributeMap struct {
+	// ID identifies the user; it must be the value identity tokens carry in their
+	// sub claim, e.g. entryUUID when Keycloak federates the directory.
+	ID        string
+	Username  string
+	Email     string
+	FirstName string
+	LastName  string
+	// DisplayName is optional; without it the display name is built from the first and last name.
+	DisplayName string
+}
+
+// Config contains configuration for Client.
+type Config struct {
+	// URL is the server address, ldap://host:389 or ldaps://host:636.
+	URL string
+
+	// StartTLS upgrades ldap:// connections to TLS before binding.
+	StartTLS bool
+
+	// BindDN and BindPassword authenticate the search; empty binds anonymously.
+	BindDN       string
+	BindPassword string
+
+	// BaseDN is the subtree searched for users.
+	BaseDN string
+
+	// UserFilter selects the users to sync, e.g. (objectClass=inetOrgPerson).
+	UserFilter string
+
+	// DisabledFilter optionally selects the users that are synced as deactivated,
+	// e.g. (userAccountControl:1.2.840.113556.1.4.803:=2) on Active Directory.
+	DisabledFilter string
+
+	// PageSize is the number of entries requested per page.
+	PageSize int
+
+	// Timeout bounds connecting and every request.
+	Timeout time.Duration
+
+	Attributes AttributeMap
+}
+
+// User is a user read from the directory.
+type User struct {
+	ID          string
+	Username    string
+	Email       string
+	FirstName   string
+	LastName    string
+	DisplayName string
+	Enabled     bool
+}
+
+// Client searches users in an LDAP directory. Every search opens its own
+// connection, as syncs run minutes apart.
+type Client struct {
+	config Config
+}
+
+// NewClient creates a new LDAP client, checking the settings and filters.
+func NewClient(config Config) (*Client, error) {
+	if config.URL == "" || config.BaseDN == "" || config.UserFilter == "" {
+		return nil, fmt.Errorf("%w: url, base DN and user filter are required", ErrInvalidConfig)
+	}
+	if config.Attributes.ID == "" || config.Attributes.Username == "" || config.Attributes.Email == "" {
+		return nil, fmt.Errorf("%w: id, username and email attributes are required", ErrInvalidConfig)
+	}
+	if config.PageSize <= 0 || config.Timeout <= 0 {
+		return nil, fmt.Errorf("%w: page size and timeout must be positive", ErrInvalidConfig)
+	}
+	for _, filter := range []string{config.UserFilter, config.DisabledFilter} {
+		if filter == "" {
+			continue
+		}
+		if _, err := goldap.CompileFilter(filter); err != nil {
+			return nil, fmt.Errorf("%w: filter %q: %w", ErrInvalidConfig, filter, err)
+		}
+	}
+	return &Client{config: config}, nil
+}
+
+// SearchUsers returns every user matching the user filter. Entries without an ID
+// are skipped; users matching the disabled filter are returned as not enabled.
+func (c *Client) SearchUsers(ctx context.Context) ([]User, error) {
+	conn, err := c.connect(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	// go-ldap requests are not context-aware: closing the connection aborts them
+	stop := context.AfterFunc(ctx, func() { _ = conn.Close() })
+	defer stop()
+
+	attrs := c.config.Attributes
+	entries, err := c.search(conn, c.config.UserFilter, nonEmpty(
+		attrs.ID, attrs.Username, attrs.Email, attrs.FirstName, attrs.LastName, attrs.DisplayName,
+	))
+	if err != nil {
+		return nil, searchError(ctx, err)
+	}
+
+	disabled := make(map[string]bool)
+	if c.config.DisabledFilter != "" {
+		filter := "(&" + c.config.UserFilter + c.config.DisabledFilter + ")"
+		disabledEntries, searchErr := c.search(conn, filter, []string{attrs.ID})
+		if searchErr != nil {
+			return nil, searchError(ctx, searchErr)
+		}
+		for _, entry := range disabledEntries {
+			disabled[entry.GetAttributeValue(attrs.ID)] = true
+		}
+	}
+
+	users := make([]User, 0, len(entries))
+	for _, entry := range entries {
+		u, ok := userFromEntry(entry, attrs)
+		if !ok {
+			continue
+		}
+		u.Enabled = !disabled[u.ID]
+		users = append(users, u)
+	}
+	return users, nil
+}
+
+func (c *Client) connect(ctx context.Context) (*goldap.Conn, error) {
+	serverURL, err := url.Parse(c.config.URL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid ldap url: %w", err)
+	}
+	tlsConfig := &tls.Config{ServerName: serverURL.Hostname(), MinVersion: tls.VersionTLS12}
+
+	dialer := &net.Dialer{Timeout: c.config.Timeout}
+	if deadline, ok := ctx.Deadline(); ok {
+		dialer.Deadline = deadline
+	}
+	conn, err := goldap.DialURL(c.config.URL, goldap.DialWithDialer(dialer), goldap.DialWithTLSConfig(tlsConfig))
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to ldap server: %w", err)
+	}
+	conn.SetTimeout(c.config.Timeout)
+
+	if c.config.StartTLS && serverURL.Scheme != "ldaps" {
+		if tlsErr := conn.StartTLS(tlsConfig); tlsErr != nil {
+			_ = conn.Close()
+			return nil, fmt.Errorf("failed to start tls: %w", tlsErr)
+		}
+	}
+
+	if c.config.BindDN == "" {
+		err = conn.UnauthenticatedBind("")
+	} else {
+		err = conn.Bind(c.config.BindDN, c.config.BindPassword)
+	}
+	if err != nil {
+		_ = conn.Close()
+		return nil, fmt.Errorf("ldap bind failed: %w", err)
+	}
+	return conn, nil
+}
+
+func (c *Client) search(conn *goldap.Conn, filter string, attributes []string) ([]*goldap.Entry, error) {
+	req := goldap.NewSearchRequest(
+		c.config.BaseDN,
+		goldap.ScopeWholeSubtree, goldap.NeverDerefAliases,
+		0, int(c.config.Timeout.Seconds()), false,
+		filter, attributes, nil,
+	)
+	result, err := conn.SearchWithPaging(req, uint32(c.config.PageSize)) //nolint:gosec // validated positive
+	if err != nil {
+		return nil, err
+	}
+	return result.Entries, nil
+}
+
+// searchError reports a search aborted by the context as the context error.
+func searchError(ctx context.Context, err error) error {
+	if ctxErr := ctx.Err(); ctxErr != nil {
+		return ctxErr
+	}
+	return fmt.Errorf("ldap search failed: %w", err)
+}
+
+// userFromEntry maps a directory entry to a user; entries without an ID are rejected.
+func userFromEntry(entry *goldap.Entry, attrs AttributeMap) (User, bool) {
+	u := User{ID: strings.TrimSpace(entry.GetAttributeValue(attrs.ID))}
+	if u.ID == "" {
+		return User{}, false
+	}
+	u.Username = strings.TrimSpace(entry.GetAttributeValue(attrs.Username))
+	u.Email = strings.TrimSpace(entry.GetAttributeValue(attrs.Email))
+	if attrs.FirstName != "" {
+		u.FirstName = strings.TrimSpace(entry.GetAttributeValue(attrs.FirstName))
+	}
+	if attrs.LastName != "" {
+		u.LastName = strings.TrimSpace(entry.GetAttributeValue(attrs.LastName))
+	}
+	if attrs.DisplayName != "" {
+		u.DisplayName = strings.TrimSpace(entry.GetAttributeValue(attrs.DisplayName))
+	}
+	if u.DisplayName == "" {
+		u.DisplayName = strings.TrimSpace(u.FirstName + " " + u.LastName)
+	}
+	if u.DisplayName == "" {
+		u.DisplayName = u.Username
+	}
+	return u, true
+}
+
+func nonEmpty(values ...string) []string {
+	out := make([]string, 0, len(values))
+	for _, v := range values {
+		if v != "" {
+			out = append(out, v)
+		}
+	}
+	return out
+}
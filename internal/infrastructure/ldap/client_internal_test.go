@@ -0,0 +1,56 @@
+package ldap
+
+import (
+	"testing"
+
+	goldap "github.com/go-ldap/ldap/v3"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUserFromEntry(t *testing.T) {
+	attrs := AttributeMap{
+		ID: "entryUUID", Username: "uid", Email: "mail",
+		FirstName: "givenName", LastName: "sn", DisplayName: "displayName",
+	}
+
+	t.Run("maps configured attributes", func(t *testing.T) {
+		u, ok := userFromEntry(goldap.NewEntry("uid=alice,ou=people,dc=example,dc=com", map[string][]string{
+			"entryUUID": {"8e1f-alice"},
+			"uid":       {"alice"},
+			"mail":      {" alice@example.com "},
+			"givenName": {"Alice"},
+			"sn":        {"Smith"},
+		}), attrs)
+
+		assert.True(t, ok)
+		assert.Equal(t, User{
+			ID: "8e1f-alice", Username: "alice", Email: "alice@example.com",
+			FirstName: "Alice", LastName: "Smith", DisplayName: "Alice Smith",
+		}, u)
+	})
+
+	t.Run("prefers the display name attribute", func(t *testing.T) {
+		u, _ := userFromEntry(goldap.NewEntry("uid=bob", map[string][]string{
+			"entryUUID":   {"8e1f-bob"},
+			"uid":         {"bob"},
+			"displayName": {"Bobby"},
+		}), attrs)
+
+		assert.Equal(t, "Bobby", u.DisplayName)
+	})
+
+	t.Run("falls back to the username", func(t *testing.T) {
+		u, _ := userFromEntry(goldap.NewEntry("uid=carol", map[string][]string{
+			"entryUUID": {"8e1f-carol"},
+			"uid":       {"carol"},
+		}), attrs)
+
+		assert.Equal(t, "carol", u.DisplayName)
+	})
+
+	t.Run("skips entries without an ID", func(t *testing.T) {
+		_, ok := userFromEntry(goldap.NewEntry("uid=dave", map[string][]string{"uid": {"dave"}}), attrs)
+
+		assert.False(t, ok)
+	})
+}
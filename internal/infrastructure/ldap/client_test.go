@@ -0,0 +1,47 @@
+package ldap_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/lllypuk/flowra/internal/infrastructure/ldap"
+	"github.com/stretchr/testify/require"
+)
+
+func validConfig() ldap.Config {
+	return ldap.Config{
+		URL:        "ldap://ldap.example.com:389",
+		BaseDN:     "ou=people,dc=example,dc=com",
+		UserFilter: "(objectClass=inetOrgPerson)",
+		PageSize:   500,
+		Timeout:    30 * time.Second,
+		Attributes: ldap.AttributeMap{ID: "entryUUID", Username: "uid", Email: "mail"},
+	}
+}
+
+func TestNewClient(t *testing.T) {
+	t.Run("accepts a complete configuration", func(t *testing.T) {
+		cfg := validConfig()
+		cfg.DisabledFilter = "(userAccountControl:1.2.840.113556.1.4.803:=2)"
+
+		_, err := ldap.NewClient(cfg)
+		require.NoError(t, err)
+	})
+
+	tests := map[string]func(*ldap.Config){
+		"missing base DN":         func(c *ldap.Config) { c.BaseDN = "" },
+		"missing id attribute":    func(c *ldap.Config) { c.Attributes.ID = "" },
+		"zero page size":          func(c *ldap.Config) { c.PageSize = 0 },
+		"unbalanced user filter":  func(c *ldap.Config) { c.UserFilter = "(objectClass=person" },
+		"invalid disabled filter": func(c *ldap.Config) { c.DisabledFilter = "disabled" },
+	}
+	for name, mutate := range tests {
+		t.Run("rejects "+name, func(t *testing.T) {
+			cfg := validConfig()
+			mutate(&cfg)
+
+			_, err := ldap.NewClient(cfg)
+			require.ErrorIs(t, err, ldap.ErrInvalidConfig)
+		})
+	}
+}
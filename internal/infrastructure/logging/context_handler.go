@@ -0,0 +1,75 @@
+// Package logging provides slog helpers shared by the API and worker binaries.
+package logging
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/lllypuk/flowra/internal/application/appcore"
+)
+
+// Attribute keys added from the request context.
+const (
+	RequestIDAttr     = "request_id"
+	CorrelationIDAttr = "correlation_id"
+)
+
+// ContextHandler wraps a slog.Handler and enriches every record with the
+// request and correlation IDs carried by the context passed to the *Context
+// logging methods. Attributes already present on the record are not duplicated.
+type ContextHandler struct {
+	inner slog.Handler
+}
+
+// NewContextHandler wraps inner with context enrichment.
+func NewContextHandler(inner slog.Handler) *ContextHandler {
+	return &ContextHandler{inner: inner}
+}
+
+// Enabled implements slog.Handler.
+func (h *ContextHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	return h.inner.Enabled(ctx, level)
+}
+
+// Handle implements slog.Handler.
+func (h *ContextHandler) Handle(ctx context.Context, record slog.Record) error {
+	if ctx == nil {
+		return h.inner.Handle(ctx, record)
+	}
+
+	requestID := appcore.GetRequestID(ctx)
+	correlationID, _ := appcore.GetCorrelationID(ctx)
+	if requestID == "" && correlationID == "" {
+		return h.inner.Handle(ctx, record)
+	}
+
+	hasRequestID, hasCorrelationID := false, false
+	record.Attrs(func(attr slog.Attr) bool {
+		switch attr.Key {
+		case RequestIDAttr:
+			hasRequestID = true
+		case CorrelationIDAttr:
+			hasCorrelationID = true
+		}
+		return !hasRequestID || !hasCorrelationID
+	})
+
+	if requestID != "" && !hasRequestID {
+		record.AddAttrs(slog.String(RequestIDAttr, requestID))
+	}
+	if correlationID != "" && !hasCorrelationID {
+		record.AddAttrs(slog.String(CorrelationIDAttr, correlationID))
+	}
+
+	return h.inner.Handle(ctx, record)
+}
+
+// WithAttrs implements slog.Handler.
+func (h *ContextHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return &ContextHandler{inner: h.inner.WithAttrs(attrs)}
+}
+
+// WithGroup implements slog.Handler.
+func (h *ContextHandler) WithGroup(name string) slog.Handler {
+	return &ContextHandler{inner: h.inner.WithGroup(name)}
+}
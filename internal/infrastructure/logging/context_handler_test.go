@@ -0,0 +1,72 @@
+package logging_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lllypuk/flowra/internal/application/appcore"
+	"github.com/lllypuk/flowra/internal/infrastructure/logging"
+)
+
+func decodeLines(t *testing.T, buf *bytes.Buffer) []map[string]any {
+	t.Helper()
+
+	var lines []map[string]any
+	for line := range strings.SplitSeq(strings.TrimSpace(buf.String()), "\n") {
+		var entry map[string]any
+		require.NoError(t, json.Unmarshal([]byte(line), &entry))
+		lines = append(lines, entry)
+	}
+	return lines
+}
+
+func TestContextHandler_AddsIDsFromContext(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(logging.NewContextHandler(slog.NewJSONHandler(&buf, nil)))
+
+	ctx := appcore.WithRequestID(context.Background(), "req-123")
+	ctx = appcore.WithCorrelationID(ctx, "corr-456")
+
+	logger.InfoContext(ctx, "with ids")
+	logger.InfoContext(context.Background(), "without ids")
+	logger.With(slog.String("component", "test")).InfoContext(ctx, "derived logger")
+
+	lines := decodeLines(t, &buf)
+	require.Len(t, lines, 3)
+
+	assert.Equal(t, "req-123", lines[0][logging.RequestIDAttr])
+	assert.Equal(t, "corr-456", lines[0][logging.CorrelationIDAttr])
+
+	assert.NotContains(t, lines[1], logging.RequestIDAttr)
+	assert.NotContains(t, lines[1], logging.CorrelationIDAttr)
+
+	assert.Equal(t, "req-123", lines[2][logging.RequestIDAttr])
+	assert.Equal(t, "test", lines[2]["component"])
+}
+
+func TestContextHandler_DoesNotDuplicateExplicitAttrs(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(logging.NewContextHandler(slog.NewJSONHandler(&buf, nil)))
+
+	ctx := appcore.WithRequestID(context.Background(), "req-123")
+	logger.InfoContext(ctx, "explicit", slog.String(logging.RequestIDAttr, "req-123"))
+
+	assert.Equal(t, 1, strings.Count(buf.String(), `"request_id"`))
+}
+
+func TestContextHandler_RespectsLevel(t *testing.T) {
+	var buf bytes.Buffer
+	inner := slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: slog.LevelWarn})
+	logger := slog.New(logging.NewContextHandler(inner))
+
+	logger.InfoContext(appcore.WithRequestID(context.Background(), "req-1"), "filtered")
+
+	assert.Empty(t, buf.String())
+}
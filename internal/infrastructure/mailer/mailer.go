@@ -0,0 +1,166 @@
+// Package mailer sends notification emails over SMTP.
+package mailer
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"fmt"
+	"mime"
+	"mime/quotedprintable"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/lllypuk/flowra/internal/domain/uuid"
+)
+
+// smtpDialTimeout bounds connecting to the SMTP server when the context has no deadline.
+const smtpDialTimeout = 10 * time.Second
+
+// Message is a plain-text email.
+type Message struct {
+	To      string
+	Subject string
+	Text    string
+	// ReplyTo is the address replies go to; empty keeps replies with the sender.
+	ReplyTo string
+	// ThreadID groups emails about the same conversation in mail clients.
+	ThreadID string
+}
+
+// Sender delivers emails.
+type Sender interface {
+	Send(ctx context.Context, msg Message) error
+}
+
+// SMTPConfig holds the SMTP server and sender address.
+type SMTPConfig struct {
+	Host     string
+	Port     int
+	Username string
+	Password string
+	From     string
+}
+
+// SMTPSender delivers emails through an SMTP server, upgrading to TLS when
+// the server offers STARTTLS.
+type SMTPSender struct {
+	host string
+	addr string
+	from *mail.Address
+	auth smtp.Auth
+}
+
+// NewSMTPSender creates a new SMTPSender.
+func NewSMTPSender(cfg SMTPConfig) (*SMTPSender, error) {
+	from, err := mail.ParseAddress(cfg.From)
+	if err != nil {
+		return nil, fmt.Errorf("invalid sender address: %w", err)
+	}
+
+	s := &SMTPSender{
+		host: cfg.Host,
+		addr: net.JoinHostPort(cfg.Host, strconv.Itoa(cfg.Port)),
+		from: from,
+	}
+	if cfg.Username != "" {
+		s.auth = smtp.PlainAuth("", cfg.Username, cfg.Password, cfg.Host)
+	}
+	return s, nil
+}
+
+// Send delivers the message.
+func (s *SMTPSender) Send(ctx context.Context, msg Message) error {
+	to, err := mail.ParseAddress(msg.To)
+	if err != nil {
+		return fmt.Errorf("invalid recipient: %w", err)
+	}
+
+	dialer := net.Dialer{Timeout: smtpDialTimeout}
+	conn, err := dialer.DialContext(ctx, "tcp", s.addr)
+	if err != nil {
+		return fmt.Errorf("failed to connect to smtp server: %w", err)
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = conn.SetDeadline(deadline)
+	}
+
+	client, err := smtp.NewClient(conn, s.host)
+	if err != nil {
+		_ = conn.Close()
+		return fmt.Errorf("failed to start smtp session: %w", err)
+	}
+	defer client.Close()
+
+	if ok, _ := client.Extension("STARTTLS"); ok {
+		if tlsErr := client.StartTLS(&tls.Config{ServerName: s.host, MinVersion: tls.VersionTLS12}); tlsErr != nil {
+			return fmt.Errorf("failed to start tls: %w", tlsErr)
+		}
+	}
+	if s.auth != nil {
+		if authErr := client.Auth(s.auth); authErr != nil {
+			return fmt.Errorf("smtp authentication failed: %w", authErr)
+		}
+	}
+
+	if mailErr := client.Mail(s.from.Address); mailErr != nil {
+		return fmt.Errorf("smtp MAIL FROM failed: %w", mailErr)
+	}
+	if rcptErr := client.Rcpt(to.Address); rcptErr != nil {
+		return fmt.Errorf("smtp RCPT TO failed: %w", rcptErr)
+	}
+	w, err := client.Data()
+	if err != nil {
+		return fmt.Errorf("smtp DATA failed: %w", err)
+	}
+	if _, writeErr := w.Write(Compose(s.from, msg, time.Now())); writeErr != nil {
+		_ = w.Close()
+		return fmt.Errorf("failed to write message: %w", writeErr)
+	}
+	if closeErr := w.Close(); closeErr != nil {
+		return fmt.Errorf("smtp server rejected message: %w", closeErr)
+	}
+	return client.Quit()
+}
+
+// Compose encodes the message as RFC 5322 text with a quoted-printable UTF-8 body.
+// Emails are marked as auto-generated so vacation responders don't answer them.
+func Compose(from *mail.Address, msg Message, date time.Time) []byte {
+	domain := "localhost"
+	if at := strings.LastIndex(from.Address, "@"); at >= 0 {
+		domain = from.Address[at+1:]
+	}
+
+	var b bytes.Buffer
+	header := func(name, value string) {
+		// values never span lines, so stray line breaks can't inject headers
+		b.WriteString(name + ": " + strings.NewReplacer("\r", "", "\n", "").Replace(value) + "\r\n")
+	}
+	header("From", from.String())
+	header("To", msg.To)
+	if msg.ReplyTo != "" {
+		header("Reply-To", msg.ReplyTo)
+	}
+	header("Subject", mime.QEncoding.Encode("utf-8", msg.Subject))
+	header("Date", date.Format(time.RFC1123Z))
+	header("Message-ID", "<"+uuid.NewUUID().String()+"@"+domain+">")
+	if msg.ThreadID != "" {
+		thread := "<" + msg.ThreadID + "@" + domain + ">"
+		header("In-Reply-To", thread)
+		header("References", thread)
+	}
+	header("Auto-Submitted", "auto-generated")
+	header("MIME-Version", "1.0")
+	header("Content-Type", "text/plain; charset=utf-8")
+	header("Content-Transfer-Encoding", "quoted-printable")
+	b.WriteString("\r\n")
+
+	body := quotedprintable.NewWriter(&b)
+	_, _ = body.Write([]byte(strings.ReplaceAll(msg.Text, "\n", "\r\n")))
+	_ = body.Close()
+	return b.Bytes()
+}
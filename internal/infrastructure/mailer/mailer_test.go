@@ -0,0 +1,54 @@
+package mailer_test
+
+import (
+	"io"
+	"mime"
+	"mime/quotedprintable"
+	"net/mail"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/lllypuk/flowra/internal/infrastructure/mailer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompose(t *testing.T) {
+	from := &mail.Address{Name: "Flowra", Address: "noreply@flowra.example"}
+	raw := mailer.Compose(from, mailer.Message{
+		To:       "alice@example.com",
+		Subject:  "[Flowra] Вас упомянули",
+		Text:     "Line one\nЛиния два",
+		ReplyTo:  "reply+abc@in.flowra.example",
+		ThreadID: "chat-42",
+	}, time.Date(2026, time.May, 4, 10, 0, 0, 0, time.UTC))
+
+	parsed, err := mail.ReadMessage(strings.NewReader(string(raw)))
+	require.NoError(t, err)
+
+	assert.Equal(t, `"Flowra" <noreply@flowra.example>`, parsed.Header.Get("From"))
+	assert.Equal(t, "reply+abc@in.flowra.example", parsed.Header.Get("Reply-To"))
+	assert.Equal(t, "<chat-42@flowra.example>", parsed.Header.Get("In-Reply-To"))
+	assert.Equal(t, "<chat-42@flowra.example>", parsed.Header.Get("References"))
+	assert.Equal(t, "auto-generated", parsed.Header.Get("Auto-Submitted"))
+	assert.True(t, strings.HasSuffix(parsed.Header.Get("Message-ID"), "@flowra.example>"))
+
+	subject, err := new(mime.WordDecoder).DecodeHeader(parsed.Header.Get("Subject"))
+	require.NoError(t, err)
+	assert.Equal(t, "[Flowra] Вас упомянули", subject)
+
+	body, err := io.ReadAll(quotedprintable.NewReader(parsed.Body))
+	require.NoError(t, err)
+	assert.Equal(t, "Line one\r\nЛиния два", string(body))
+}
+
+func TestCompose_StripsHeaderLineBreaks(t *testing.T) {
+	from := &mail.Address{Address: "noreply@flowra.example"}
+	raw := string(mailer.Compose(from, mailer.Message{
+		To:      "alice@example.com\r\nBcc: mallory@example.com",
+		Subject: "Hi",
+	}, time.Now()))
+
+	assert.NotContains(t, raw, "\r\nBcc:")
+}
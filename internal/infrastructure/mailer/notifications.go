@@ -0,0 +1,245 @@
+package mailer
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/lllypuk/flowra/internal/domain/errs"
+	"github.com/lllypuk/flowra/internal/domain/event"
+	"github.com/lllypuk/flowra/internal/domain/notification"
+	"github.com/lllypuk/flowra/internal/domain/user"
+	"github.com/lllypuk/flowra/internal/domain/uuid"
+	"github.com/lllypuk/flowra/internal/infrastructure/eventbus"
+	"github.com/lllypuk/flowra/internal/infrastructure/i18n"
+)
+
+// EventTypes returns the domain event types the notification handler consumes.
+func EventTypes() []string {
+	return []string{notification.EventTypeNotificationCreated}
+}
+
+// RecipientLookup loads the recipient of a notification.
+// Declared on the consumer side per project guidelines.
+type RecipientLookup interface {
+	FindByID(ctx context.Context, userID uuid.UUID) (*user.User, error)
+}
+
+// MessageChatResolver finds the chat a message was posted in.
+// Declared on the consumer side per project guidelines.
+type MessageChatResolver interface {
+	MessageChatID(ctx context.Context, messageID uuid.UUID) (uuid.UUID, error)
+}
+
+// ChatWorkspaceResolver finds the workspace a chat belongs to.
+// Declared on the consumer side per project guidelines.
+type ChatWorkspaceResolver interface {
+	ChatWorkspaceID(ctx context.Context, chatID uuid.UUID) (uuid.UUID, error)
+}
+
+// ReplyAddresser returns the signed address replies about a chat from a user go to.
+// Declared on the consumer side per project guidelines.
+type ReplyAddresser interface {
+	ReplyAddress(chatID, userID uuid.UUID) (string, error)
+}
+
+// NotificationHandler emails every created notification to its recipient.
+// Emails about a chat link to it and, with reply addresses configured, carry a
+// Reply-To that posts the reply back into the chat. With quiet hours configured
+// nothing is emailed during the do-not-disturb window of the recipient.
+type NotificationHandler struct {
+	sender   Sender
+	users    RecipientLookup
+	messages MessageChatResolver
+	chats    ChatWorkspaceResolver
+	replies  ReplyAddresser
+	prefs    PreferencesLookup
+	deferred DeferredEmailStore
+	baseURL  string
+	catalog  *i18n.Catalog
+	logger   *slog.Logger
+}
+
+// NotificationOption configures NotificationHandler.
+type NotificationOption func(*NotificationHandler)
+
+// WithReplyAddresses sets the reply-to addresses of emails about chats.
+func WithReplyAddresses(replies ReplyAddresser) NotificationOption {
+	return func(h *NotificationHandler) {
+		h.replies = replies
+	}
+}
+
+// WithChatLinks links emails about chats to the chat page under baseURL.
+func WithChatLinks(baseURL string, chats ChatWorkspaceResolver) NotificationOption {
+	return func(h *NotificationHandler) {
+		h.baseURL = strings.TrimSuffix(baseURL, "/")
+		h.chats = chats
+	}
+}
+
+// WithNotificationLogger sets the logger for NotificationHandler.
+func WithNotificationLogger(logger *slog.Logger) NotificationOption {
+	return func(h *NotificationHandler) {
+		h.logger = logger
+	}
+}
+
+// NewNotificationHandler creates a new NotificationHandler.
+func NewNotificationHandler(
+	sender Sender,
+	users RecipientLookup,
+	messages MessageChatResolver,
+	opts ...NotificationOption,
+) *NotificationHandler {
+	h := &NotificationHandler{
+		sender:   sender,
+		users:    users,
+		messages: messages,
+		catalog:  i18n.MustLoad(),
+		logger:   slog.Default(),
+	}
+	for _, opt := range opts {
+		opt(h)
+	}
+	return h
+}
+
+// createdPayload is the bus payload of notification.created.
+type createdPayload struct {
+	UserID     uuid.UUID         `json:"UserID"`
+	Type       notification.Type `json:"Type"`
+	Title      string            `json:"Title"`
+	Message    string            `json:"Message"`
+	ResourceID string            `json:"ResourceID"`
+}
+
+// Handle emails the notification of a notification.created event.
+// Send failures are returned so the bus retries them; anything else is skipped.
+func (h *NotificationHandler) Handle(ctx context.Context, evt event.DomainEvent) error {
+	if evt.EventType() != notification.EventTypeNotificationCreated {
+		return nil
+	}
+
+	p, err := decodeCreated(evt)
+	if err != nil {
+		h.logger.WarnContext(ctx, "mailer: skipping undecodable notification",
+			slog.String("notification_id", evt.AggregateID()),
+			slog.String("error", err.Error()),
+		)
+		return nil
+	}
+
+	recipient, err := h.users.FindByID(ctx, p.UserID)
+	if errors.Is(err, errs.ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to load notification recipient: %w", err)
+	}
+	if !recipient.IsActive() || recipient.Email() == "" {
+		return nil
+	}
+	if held, holdErr := h.holdBack(ctx, recipient, p); held || holdErr != nil {
+		return holdErr
+	}
+
+	msg := h.compose(ctx, recipient, p)
+	if sendErr := h.sender.Send(ctx, msg); sendErr != nil {
+		return fmt.Errorf("failed to email notification: %w", sendErr)
+	}
+	return nil
+}
+
+// compose builds the email of a notification in the recipient's locale.
+func (h *NotificationHandler) compose(ctx context.Context, recipient *user.User, p createdPayload) Message {
+	t := h.catalog.Translator(h.catalog.Resolve(recipient.Locale(), ""))
+	msg := Message{
+		To:      recipient.Email(),
+		Subject: t("email.subject", p.Title),
+	}
+
+	body := []string{p.Message}
+	chatID := h.chatOf(ctx, p)
+	if !chatID.IsZero() {
+		msg.ThreadID = "chat-" + chatID.String()
+		if link := h.chatLink(ctx, chatID); link != "" {
+			body = append(body, t("email.open_chat", link))
+		}
+		if h.replies != nil {
+			replyTo, err := h.replies.ReplyAddress(chatID, recipient.ID())
+			if err == nil {
+				msg.ReplyTo = replyTo
+				body = append(body, t("email.reply_hint"))
+			}
+		}
+	}
+	msg.Text = strings.Join(body, "\n\n") + "\n"
+	return msg
+}
+
+// chatOf returns the chat a notification is about, if any.
+// Mentions point at the message; chat and task notifications at the chat.
+func (h *NotificationHandler) chatOf(ctx context.Context, p createdPayload) uuid.UUID {
+	resourceID, err := uuid.ParseUUID(p.ResourceID)
+	if err != nil {
+		return ""
+	}
+
+	switch p.Type {
+	case notification.TypeChatMention:
+		if h.messages == nil {
+			return ""
+		}
+		chatID, chatErr := h.messages.MessageChatID(ctx, resourceID)
+		if chatErr != nil {
+			return ""
+		}
+		return chatID
+	case notification.TypeChatMessage, notification.TypeTaskAssigned, notification.TypeTaskCreated,
+		notification.TypeTaskStatusChanged, notification.TypeTaskSLABreached:
+		return resourceID
+	case notification.TypeWorkspaceInvite, notification.TypeSystem:
+		return ""
+	default:
+		return ""
+	}
+}
+
+func (h *NotificationHandler) chatLink(ctx context.Context, chatID uuid.UUID) string {
+	if h.baseURL == "" || h.chats == nil {
+		return ""
+	}
+	workspaceID, err := h.chats.ChatWorkspaceID(ctx, chatID)
+	if err != nil || workspaceID.IsZero() {
+		return ""
+	}
+	return h.baseURL + "/workspaces/" + workspaceID.String() + "/chats/" + chatID.String()
+}
+
+// decodeCreated reads the notification from the raw bus payload or, for
+// in-process events, by marshaling the event itself.
+func decodeCreated(evt event.DomainEvent) (createdPayload, error) {
+	var data json.RawMessage
+	if pe, ok := evt.(eventbus.PayloadEvent); ok {
+		data = pe.Payload()
+	} else {
+		marshaled, err := json.Marshal(evt)
+		if err != nil {
+			return createdPayload{}, fmt.Errorf("failed to marshal event: %w", err)
+		}
+		data = marshaled
+	}
+
+	var p createdPayload
+	if err := json.Unmarshal(data, &p); err != nil {
+		return createdPayload{}, fmt.Errorf("failed to decode notification: %w", err)
+	}
+	if p.UserID.IsZero() {
+		return createdPayload{}, errors.New("notification has no recipient")
+	}
+	return p, nil
+}
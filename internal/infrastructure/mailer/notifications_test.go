@@ -0,0 +1,134 @@
+package mailer_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/lllypuk/flowra/internal/domain/errs"
+	"github.com/lllypuk/flowra/internal/domain/event"
+	"github.com/lllypuk/flowra/internal/domain/notification"
+	"github.com/lllypuk/flowra/internal/domain/user"
+	"github.com/lllypuk/flowra/internal/domain/uuid"
+	"github.com/lllypuk/flowra/internal/infrastructure/mailer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type recordingSender struct {
+	sent []mailer.Message
+	err  error
+}
+
+func (s *recordingSender) Send(_ context.Context, msg mailer.Message) error {
+	s.sent = append(s.sent, msg)
+	return s.err
+}
+
+type stubRecipients map[uuid.UUID]*user.User
+
+func (s stubRecipients) FindByID(_ context.Context, userID uuid.UUID) (*user.User, error) {
+	u, ok := s[userID]
+	if !ok {
+		return nil, errs.ErrNotFound
+	}
+	return u, nil
+}
+
+type stubMessageChats map[uuid.UUID]uuid.UUID
+
+func (s stubMessageChats) MessageChatID(_ context.Context, messageID uuid.UUID) (uuid.UUID, error) {
+	chatID, ok := s[messageID]
+	if !ok {
+		return "", errs.ErrNotFound
+	}
+	return chatID, nil
+}
+
+type stubChatWorkspaces map[uuid.UUID]uuid.UUID
+
+func (s stubChatWorkspaces) ChatWorkspaceID(_ context.Context, chatID uuid.UUID) (uuid.UUID, error) {
+	return s[chatID], nil
+}
+
+type stubReplies struct{}
+
+func (stubReplies) ReplyAddress(chatID, userID uuid.UUID) (string, error) {
+	return "reply+" + chatID.String() + "." + userID.String() + "@in.example", nil
+}
+
+func notificationCreated(userID uuid.UUID, typ notification.Type, resourceID string) event.DomainEvent {
+	return notification.NewNotificationCreated(
+		uuid.NewUUID(), userID, typ, "You were mentioned", "@bob mentioned you in a chat", resourceID,
+		event.NewMetadata(userID.String(), "", ""),
+	)
+}
+
+func TestNotificationHandler_Handle(t *testing.T) {
+	alice, err := user.NewUser("ext-1", "alice", "alice@example.com", "Alice")
+	require.NoError(t, err)
+	workspaceID := uuid.NewUUID()
+	chatID := uuid.NewUUID()
+	messageID := uuid.NewUUID()
+
+	newHandler := func(sender *recordingSender, opts ...mailer.NotificationOption) *mailer.NotificationHandler {
+		return mailer.NewNotificationHandler(
+			sender,
+			stubRecipients{alice.ID(): alice},
+			stubMessageChats{messageID: chatID},
+			opts...,
+		)
+	}
+
+	t.Run("mention links the chat and accepts replies", func(t *testing.T) {
+		sender := &recordingSender{}
+		h := newHandler(sender,
+			mailer.WithChatLinks("https://flowra.example/", stubChatWorkspaces{chatID: workspaceID}),
+			mailer.WithReplyAddresses(stubReplies{}),
+		)
+
+		require.NoError(t, h.Handle(context.Background(),
+			notificationCreated(alice.ID(), notification.TypeChatMention, messageID.String())))
+
+		require.Len(t, sender.sent, 1)
+		msg := sender.sent[0]
+		assert.Equal(t, "alice@example.com", msg.To)
+		assert.Equal(t, "[Flowra] You were mentioned", msg.Subject)
+		assert.Equal(t, "reply+"+chatID.String()+"."+alice.ID().String()+"@in.example", msg.ReplyTo)
+		assert.Equal(t, "chat-"+chatID.String(), msg.ThreadID)
+		assert.Contains(t, msg.Text, "@bob mentioned you in a chat")
+		assert.Contains(t, msg.Text,
+			"https://flowra.example/workspaces/"+workspaceID.String()+"/chats/"+chatID.String())
+		assert.Contains(t, msg.Text, "Reply to this email")
+	})
+
+	t.Run("workspace notifications have no reply address", func(t *testing.T) {
+		sender := &recordingSender{}
+		h := newHandler(sender, mailer.WithReplyAddresses(stubReplies{}))
+
+		require.NoError(t, h.Handle(context.Background(),
+			notificationCreated(alice.ID(), notification.TypeWorkspaceInvite, workspaceID.String())))
+
+		require.Len(t, sender.sent, 1)
+		assert.Empty(t, sender.sent[0].ReplyTo)
+		assert.NotContains(t, sender.sent[0].Text, "Reply to this email")
+	})
+
+	t.Run("unknown recipient is skipped", func(t *testing.T) {
+		sender := &recordingSender{}
+		h := newHandler(sender)
+
+		require.NoError(t, h.Handle(context.Background(),
+			notificationCreated(uuid.NewUUID(), notification.TypeTaskAssigned, chatID.String())))
+		assert.Empty(t, sender.sent)
+	})
+
+	t.Run("send failures are retried", func(t *testing.T) {
+		sender := &recordingSender{err: errors.New("connection refused")}
+		h := newHandler(sender)
+
+		err := h.Handle(context.Background(),
+			notificationCreated(alice.ID(), notification.TypeTaskAssigned, chatID.String()))
+		require.Error(t, err)
+	})
+}
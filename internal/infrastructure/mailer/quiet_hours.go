@@ -0,0 +1,220 @@
+package mailer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+
+	"github.com/lllypuk/flowra/internal/domain/errs"
+	"github.com/lllypuk/flowra/internal/domain/notification"
+	"github.com/lllypuk/flowra/internal/domain/user"
+	"github.com/lllypuk/flowra/internal/domain/uuid"
+)
+
+// PreferencesLookup loads the notification preferences of a recipient;
+// errs.ErrNotFound means the recipient has none.
+// Declared on the consumer side per project guidelines.
+type PreferencesLookup interface {
+	FindPreferences(ctx context.Context, userID uuid.UUID) (notification.Preferences, error)
+}
+
+// DeferredEmailStore holds back notifications until the do-not-disturb window
+// of their recipient ends.
+// Declared on the consumer side per project guidelines.
+type DeferredEmailStore interface {
+	Defer(ctx context.Context, email DeferredEmail) error
+}
+
+// WithQuietHours honours the do-not-disturb schedules of recipients: during
+// their quiet time notifications are not emailed, and the digest-worthy ones
+// are deferred until it ends.
+func WithQuietHours(prefs PreferencesLookup, deferred DeferredEmailStore) NotificationOption {
+	return func(h *NotificationHandler) {
+		h.prefs = prefs
+		h.deferred = deferred
+	}
+}
+
+// digestWorthy reports whether a notification addressed to the user in person
+// is still worth an email after the do-not-disturb window; chat and task
+// activity is dropped, the in-app notification covers it.
+func digestWorthy(typ notification.Type) bool {
+	switch typ {
+	case notification.TypeChatMention, notification.TypeTaskAssigned,
+		notification.TypeTaskSLABreached, notification.TypeWorkspaceInvite:
+		return true
+	case notification.TypeChatMessage, notification.TypeTaskCreated,
+		notification.TypeTaskStatusChanged, notification.TypeSystem:
+		return false
+	default:
+		return false
+	}
+}
+
+// holdBack reports whether the recipient is in quiet time and, if so, defers
+// the notification when it is digest-worthy.
+func (h *NotificationHandler) holdBack(ctx context.Context, recipient *user.User, p createdPayload) (bool, error) {
+	if h.prefs == nil {
+		return false, nil
+	}
+	prefs, err := h.prefs.FindPreferences(ctx, recipient.ID())
+	if errors.Is(err, errs.ErrNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to load notification preferences: %w", err)
+	}
+
+	now := time.Now()
+	loc := recipient.Location()
+	if !prefs.DND.Active(now, loc) {
+		return false, nil
+	}
+	if !digestWorthy(p.Type) || h.deferred == nil {
+		return true, nil
+	}
+
+	deferErr := h.deferred.Defer(ctx, DeferredEmail{
+		ID:         uuid.NewUUID().String(),
+		UserID:     recipient.ID().String(),
+		Type:       p.Type,
+		Title:      p.Title,
+		Message:    p.Message,
+		ResourceID: p.ResourceID,
+		DeliverAt:  prefs.DND.QuietUntil(now, loc),
+		CreatedAt:  now,
+	})
+	if deferErr != nil {
+		return true, fmt.Errorf("failed to defer notification email: %w", deferErr)
+	}
+	return true, nil
+}
+
+// SendDigest emails the deferred notifications of a user as one email; a
+// single notification is emailed as usual. Inactive or unknown recipients are
+// skipped.
+func (h *NotificationHandler) SendDigest(ctx context.Context, userID uuid.UUID, emails []DeferredEmail) error {
+	if len(emails) == 0 {
+		return nil
+	}
+	recipient, err := h.users.FindByID(ctx, userID)
+	if errors.Is(err, errs.ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to load digest recipient: %w", err)
+	}
+	if !recipient.IsActive() || recipient.Email() == "" {
+		return nil
+	}
+
+	var msg Message
+	if len(emails) == 1 {
+		msg = h.compose(ctx, recipient, emails[0].payload())
+	} else {
+		msg = h.composeDigest(recipient, emails)
+	}
+	if sendErr := h.sender.Send(ctx, msg); sendErr != nil {
+		return fmt.Errorf("failed to email notification digest: %w", sendErr)
+	}
+	return nil
+}
+
+func (h *NotificationHandler) composeDigest(recipient *user.User, emails []DeferredEmail) Message {
+	t := h.catalog.Translator(h.catalog.Resolve(recipient.Locale(), ""))
+	body := make([]string, 0, len(emails)+1)
+	for _, e := range emails {
+		body = append(body, e.Title+"\n"+e.Message)
+	}
+	if h.baseURL != "" {
+		body = append(body, t("email.open_notifications", h.baseURL+"/notifications"))
+	}
+	return Message{
+		To:      recipient.Email(),
+		Subject: t("email.subject", t("email.digest_subject", len(emails))),
+		Text:    strings.Join(body, "\n\n") + "\n",
+	}
+}
+
+// DeferredEmail is a notification email held back by the do-not-disturb
+// schedule of its recipient.
+type DeferredEmail struct {
+	ID         string            `bson:"_id"`
+	UserID     string            `bson:"user_id"`
+	Type       notification.Type `bson:"type"`
+	Title      string            `bson:"title"`
+	Message    string            `bson:"message"`
+	ResourceID string            `bson:"resource_id,omitempty"`
+	DeliverAt  time.Time         `bson:"deliver_at"`
+	CreatedAt  time.Time         `bson:"created_at"`
+}
+
+func (e DeferredEmail) payload() createdPayload {
+	return createdPayload{
+		UserID:     uuid.UUID(e.UserID),
+		Type:       e.Type,
+		Title:      e.Title,
+		Message:    e.Message,
+		ResourceID: e.ResourceID,
+	}
+}
+
+// MongoDeferredEmailStore keeps deferred notification emails in MongoDB.
+type MongoDeferredEmailStore struct {
+	collection *mongo.Collection
+	logger     *slog.Logger
+}
+
+// NewMongoDeferredEmailStore creates a new MongoDB-based deferred email store.
+func NewMongoDeferredEmailStore(collection *mongo.Collection, logger *slog.Logger) *MongoDeferredEmailStore {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &MongoDeferredEmailStore{collection: collection, logger: logger}
+}
+
+// Defer stores an email until its delivery time.
+func (s *MongoDeferredEmailStore) Defer(ctx context.Context, email DeferredEmail) error {
+	if _, err := s.collection.InsertOne(ctx, email); err != nil {
+		return fmt.Errorf("failed to insert deferred email: %w", err)
+	}
+	return nil
+}
+
+// Due returns up to limit emails due before the given time, oldest first.
+func (s *MongoDeferredEmailStore) Due(ctx context.Context, before time.Time, limit int) ([]DeferredEmail, error) {
+	opts := options.Find().
+		SetSort(bson.D{{Key: "deliver_at", Value: 1}, {Key: "created_at", Value: 1}}).
+		SetLimit(int64(limit))
+	cursor, err := s.collection.Find(ctx, bson.M{"deliver_at": bson.M{"$lte": before}}, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find due deferred emails: %w", err)
+	}
+	var emails []DeferredEmail
+	if err = cursor.All(ctx, &emails); err != nil {
+		return nil, fmt.Errorf("failed to decode deferred emails: %w", err)
+	}
+	return emails, nil
+}
+
+// Delete removes delivered emails.
+func (s *MongoDeferredEmailStore) Delete(ctx context.Context, ids []string) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	if _, err := s.collection.DeleteMany(ctx, bson.M{"_id": bson.M{"$in": ids}}); err != nil {
+		s.logger.ErrorContext(ctx, "failed to delete deferred emails",
+			slog.Int("count", len(ids)),
+			slog.String("error", err.Error()),
+		)
+		return fmt.Errorf("failed to delete deferred emails: %w", err)
+	}
+	return nil
+}
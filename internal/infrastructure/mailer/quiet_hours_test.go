@@ -0,0 +1,121 @@
+package mailer_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/lllypuk/flowra/internal/domain/notification"
+	"github.com/lllypuk/flowra/internal/domain/user"
+	"github.com/lllypuk/flowra/internal/domain/uuid"
+	"github.com/lllypuk/flowra/internal/infrastructure/mailer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type stubPreferences map[uuid.UUID]notification.Preferences
+
+func (s stubPreferences) FindPreferences(_ context.Context, userID uuid.UUID) (notification.Preferences, error) {
+	return s[userID], nil
+}
+
+type recordingDeferrals struct {
+	deferred []mailer.DeferredEmail
+}
+
+func (r *recordingDeferrals) Defer(_ context.Context, email mailer.DeferredEmail) error {
+	r.deferred = append(r.deferred, email)
+	return nil
+}
+
+// quietNow returns a daily window from an hour ago to an hour from now in UTC
+func quietNow() notification.DNDSchedule {
+	const minutesPerDay = 24 * 60
+	now := time.Now().UTC()
+	m := now.Hour()*60 + now.Minute()
+	return notification.DNDSchedule{Start: (m - 60 + minutesPerDay) % minutesPerDay, End: (m + 60) % minutesPerDay}
+}
+
+func TestNotificationHandler_QuietHours(t *testing.T) {
+	alice, err := user.NewUser("ext-1", "alice", "alice@example.com", "Alice")
+	require.NoError(t, err)
+	bob, err := user.NewUser("ext-2", "bob", "bob@example.com", "Bob")
+	require.NoError(t, err)
+	chatID := uuid.NewUUID()
+
+	newHandler := func(sender *recordingSender, deferrals *recordingDeferrals) *mailer.NotificationHandler {
+		return mailer.NewNotificationHandler(
+			sender,
+			stubRecipients{alice.ID(): alice, bob.ID(): bob},
+			stubMessageChats{},
+			mailer.WithQuietHours(stubPreferences{alice.ID(): {DND: quietNow()}}, deferrals),
+		)
+	}
+
+	t.Run("digest-worthy notifications are deferred to the end of the window", func(t *testing.T) {
+		sender, deferrals := &recordingSender{}, &recordingDeferrals{}
+		h := newHandler(sender, deferrals)
+
+		require.NoError(t, h.Handle(context.Background(),
+			notificationCreated(alice.ID(), notification.TypeTaskAssigned, chatID.String())))
+
+		assert.Empty(t, sender.sent)
+		require.Len(t, deferrals.deferred, 1)
+		deferred := deferrals.deferred[0]
+		assert.Equal(t, alice.ID().String(), deferred.UserID)
+		assert.Equal(t, notification.TypeTaskAssigned, deferred.Type)
+		assert.WithinDuration(t, time.Now().Add(time.Hour), deferred.DeliverAt, time.Minute)
+	})
+
+	t.Run("other notifications are not emailed at all", func(t *testing.T) {
+		sender, deferrals := &recordingSender{}, &recordingDeferrals{}
+		h := newHandler(sender, deferrals)
+
+		require.NoError(t, h.Handle(context.Background(),
+			notificationCreated(alice.ID(), notification.TypeChatMessage, chatID.String())))
+
+		assert.Empty(t, sender.sent)
+		assert.Empty(t, deferrals.deferred)
+	})
+
+	t.Run("recipients without a schedule get emails right away", func(t *testing.T) {
+		sender, deferrals := &recordingSender{}, &recordingDeferrals{}
+		h := newHandler(sender, deferrals)
+
+		require.NoError(t, h.Handle(context.Background(),
+			notificationCreated(bob.ID(), notification.TypeTaskAssigned, chatID.String())))
+
+		assert.Len(t, sender.sent, 1)
+		assert.Empty(t, deferrals.deferred)
+	})
+}
+
+func TestNotificationHandler_SendDigest(t *testing.T) {
+	alice, err := user.NewUser("ext-1", "alice", "alice@example.com", "Alice")
+	require.NoError(t, err)
+	sender := &recordingSender{}
+	h := mailer.NewNotificationHandler(sender, stubRecipients{alice.ID(): alice}, stubMessageChats{},
+		mailer.WithChatLinks("https://flowra.example", stubChatWorkspaces{}))
+
+	emails := []mailer.DeferredEmail{
+		{UserID: alice.ID().String(), Type: notification.TypeChatMention, Title: "Mentioned", Message: "@alice look"},
+		{UserID: alice.ID().String(), Type: notification.TypeTaskAssigned, Title: "Assigned", Message: "Fix login"},
+	}
+	require.NoError(t, h.SendDigest(context.Background(), alice.ID(), emails))
+
+	require.Len(t, sender.sent, 1)
+	msg := sender.sent[0]
+	assert.Equal(t, "[Flowra] 2 notifications held back during Do Not Disturb", msg.Subject)
+	assert.Contains(t, msg.Text, "Mentioned\n@alice look")
+	assert.Contains(t, msg.Text, "Assigned\nFix login")
+	assert.Contains(t, msg.Text, "https://flowra.example/notifications")
+
+	// a single notification is emailed as usual
+	require.NoError(t, h.SendDigest(context.Background(), alice.ID(), emails[:1]))
+	require.Len(t, sender.sent, 2)
+	assert.Equal(t, "[Flowra] Mentioned", sender.sent[1].Subject)
+
+	// unknown recipients are skipped
+	require.NoError(t, h.SendDigest(context.Background(), uuid.NewUUID(), emails))
+	assert.Len(t, sender.sent, 2)
+}
@@ -0,0 +1,70 @@
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/lllypuk/flowra/internal/infrastructure/resilience"
+)
+
+// BulkheadStatsProvider exposes the counters of one dependency bulkhead.
+type BulkheadStatsProvider interface {
+	Name() string
+	Stats() resilience.BulkheadStats
+}
+
+// BulkheadCollector exports dependency bulkhead counters as Prometheus metrics on every scrape.
+type BulkheadCollector struct {
+	providers []BulkheadStatsProvider
+
+	inFlight *prometheus.Desc
+	rejected *prometheus.Desc
+	timedOut *prometheus.Desc
+}
+
+// NewBulkheadCollector creates and registers a bulkhead collector with the given registerer.
+func NewBulkheadCollector(
+	registerer prometheus.Registerer,
+	providers ...BulkheadStatsProvider,
+) *BulkheadCollector {
+	collector := &BulkheadCollector{
+		providers: providers,
+		inFlight: prometheus.NewDesc(
+			"flowra_bulkhead_in_flight",
+			"Current number of calls in flight per external dependency",
+			[]string{"dependency"}, nil,
+		),
+		rejected: prometheus.NewDesc(
+			"flowra_bulkhead_rejected_total",
+			"Total number of dependency calls rejected because the bulkhead was full",
+			[]string{"dependency"}, nil,
+		),
+		timedOut: prometheus.NewDesc(
+			"flowra_bulkhead_timeouts_total",
+			"Total number of dependency calls cut off by the bulkhead timeout",
+			[]string{"dependency"}, nil,
+		),
+	}
+
+	registerer.MustRegister(collector)
+
+	return collector
+}
+
+// Describe implements prometheus.Collector.
+func (c *BulkheadCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.inFlight
+	ch <- c.rejected
+	ch <- c.timedOut
+}
+
+// Collect implements prometheus.Collector.
+func (c *BulkheadCollector) Collect(ch chan<- prometheus.Metric) {
+	for _, provider := range c.providers {
+		stats := provider.Stats()
+		name := provider.Name()
+
+		ch <- prometheus.MustNewConstMetric(c.inFlight, prometheus.GaugeValue, float64(stats.InFlight), name)
+		ch <- prometheus.MustNewConstMetric(c.rejected, prometheus.CounterValue, float64(stats.Rejected), name)
+		ch <- prometheus.MustNewConstMetric(c.timedOut, prometheus.CounterValue, float64(stats.TimedOut), name)
+	}
+}
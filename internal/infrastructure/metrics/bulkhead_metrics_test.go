@@ -0,0 +1,55 @@
+package metrics_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lllypuk/flowra/internal/infrastructure/metrics"
+	"github.com/lllypuk/flowra/internal/infrastructure/resilience"
+)
+
+type stubBulkhead struct {
+	name  string
+	stats resilience.BulkheadStats
+}
+
+func (s *stubBulkhead) Name() string {
+	return s.name
+}
+
+func (s *stubBulkhead) Stats() resilience.BulkheadStats {
+	return s.stats
+}
+
+func TestBulkheadCollector_Collect(t *testing.T) {
+	registry := prometheus.NewRegistry()
+	metrics.NewBulkheadCollector(
+		registry,
+		&stubBulkhead{name: "keycloak", stats: resilience.BulkheadStats{InFlight: 3, Rejected: 2, TimedOut: 1}},
+		&stubBulkhead{name: "redis"},
+	)
+
+	expected := `
+# HELP flowra_bulkhead_in_flight Current number of calls in flight per external dependency
+# TYPE flowra_bulkhead_in_flight gauge
+flowra_bulkhead_in_flight{dependency="keycloak"} 3
+flowra_bulkhead_in_flight{dependency="redis"} 0
+# HELP flowra_bulkhead_rejected_total Total number of dependency calls rejected because the bulkhead was full
+# TYPE flowra_bulkhead_rejected_total counter
+flowra_bulkhead_rejected_total{dependency="keycloak"} 2
+flowra_bulkhead_rejected_total{dependency="redis"} 0
+`
+	err := testutil.GatherAndCompare(
+		registry,
+		strings.NewReader(expected),
+		"flowra_bulkhead_in_flight",
+		"flowra_bulkhead_rejected_total",
+	)
+	require.NoError(t, err)
+	assert.Equal(t, 6, testutil.CollectAndCount(registry))
+}
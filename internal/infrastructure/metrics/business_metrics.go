@@ -0,0 +1,126 @@
+package metrics
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/lllypuk/flowra/internal/domain/chat"
+	"github.com/lllypuk/flowra/internal/domain/event"
+	"github.com/lllypuk/flowra/internal/domain/message"
+	"github.com/lllypuk/flowra/internal/domain/notification"
+	"github.com/lllypuk/flowra/internal/domain/task"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// BusinessMetrics contains Prometheus counters for domain activity.
+// They are fed by an event bus handler; since every API instance receives every
+// event, aggregate across instances with max() rather than sum(). Daily figures
+// come from increase(...[1d]).
+type BusinessMetrics struct {
+	TasksCreated         *prometheus.CounterVec
+	TasksCompleted       prometheus.Counter
+	MessagesSent         prometheus.Counter
+	NotificationsCreated *prometheus.CounterVec
+}
+
+// NewBusinessMetrics creates and registers business metrics with the given registerer.
+func NewBusinessMetrics(registerer prometheus.Registerer) *BusinessMetrics {
+	metrics := &BusinessMetrics{
+		TasksCreated: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "flowra_tasks_created_total",
+				Help: "Total number of tasks, bugs and epics created, including discussions converted to a typed chat",
+			},
+			[]string{"type"}, // type: task/bug/epic
+		),
+		TasksCompleted: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "flowra_tasks_completed_total",
+			Help: "Total number of typed chats moved into a done status",
+		}),
+		MessagesSent: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "flowra_messages_sent_total",
+			Help: "Total number of chat messages sent",
+		}),
+		NotificationsCreated: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "flowra_notifications_created_total",
+				Help: "Total number of notifications created",
+			},
+			[]string{"type"},
+		),
+	}
+
+	registerer.MustRegister(
+		metrics.TasksCreated,
+		metrics.TasksCompleted,
+		metrics.MessagesSent,
+		metrics.NotificationsCreated,
+	)
+
+	return metrics
+}
+
+// BusinessEventTypes returns the domain event types HandleEvent counts.
+func BusinessEventTypes() []string {
+	return []string{
+		chat.EventTypeChatCreated,
+		chat.EventTypeChatTypeChanged,
+		chat.EventTypeStatusChanged,
+		message.EventTypeMessageCreated,
+	}
+}
+
+// HandleEvent counts a domain event. It never fails so that metrics cannot
+// cause retries or dead letters.
+func (m *BusinessMetrics) HandleEvent(_ context.Context, evt event.DomainEvent) error {
+	switch evt.EventType() {
+	case chat.EventTypeChatCreated:
+		var p struct {
+			Type chat.Type `json:"type"`
+		}
+		if decodeEventPayload(evt, &p) && p.Type != chat.TypeDiscussion {
+			m.TasksCreated.WithLabelValues(string(p.Type)).Inc()
+		}
+	case chat.EventTypeChatTypeChanged:
+		var p struct {
+			OldType chat.Type `json:"old_type"`
+			NewType chat.Type `json:"new_type"`
+		}
+		if decodeEventPayload(evt, &p) && p.OldType == chat.TypeDiscussion && p.NewType != chat.TypeDiscussion {
+			m.TasksCreated.WithLabelValues(string(p.NewType)).Inc()
+		}
+	case chat.EventTypeStatusChanged:
+		var p struct {
+			OldStatus string `json:"old_status"`
+			NewStatus string `json:"new_status"`
+		}
+		if decodeEventPayload(evt, &p) &&
+			task.StatusFromChatStatus(p.NewStatus) == task.StatusDone &&
+			task.StatusFromChatStatus(p.OldStatus) != task.StatusDone {
+			m.TasksCompleted.Inc()
+		}
+	case message.EventTypeMessageCreated:
+		m.MessagesSent.Inc()
+	}
+	return nil
+}
+
+// NotificationCreated counts a created notification.
+func (m *BusinessMetrics) NotificationCreated(notifType notification.Type) {
+	m.NotificationsCreated.WithLabelValues(string(notifType)).Inc()
+}
+
+// decodeEventPayload reads the raw bus payload when present, otherwise the marshaled event.
+func decodeEventPayload(evt event.DomainEvent, target any) bool {
+	var data []byte
+	if pe, ok := evt.(interface{ Payload() json.RawMessage }); ok {
+		data = pe.Payload()
+	} else {
+		marshaled, err := json.Marshal(evt)
+		if err != nil {
+			return false
+		}
+		data = marshaled
+	}
+	return json.Unmarshal(data, target) == nil
+}
@@ -0,0 +1,113 @@
+package metrics_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/lllypuk/flowra/internal/domain/chat"
+	"github.com/lllypuk/flowra/internal/domain/event"
+	"github.com/lllypuk/flowra/internal/domain/message"
+	"github.com/lllypuk/flowra/internal/domain/notification"
+	"github.com/lllypuk/flowra/internal/domain/uuid"
+	"github.com/lllypuk/flowra/internal/infrastructure/metrics"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+func TestBusinessMetrics_HandleEvent(t *testing.T) {
+	registry := prometheus.NewRegistry()
+	businessMetrics := metrics.NewBusinessMetrics(registry)
+	ctx := context.Background()
+	chatID := uuid.NewUUID()
+	userID := uuid.NewUUID()
+	meta := event.Metadata{}
+
+	events := []event.DomainEvent{
+		chat.NewChatCreated(chatID, uuid.NewUUID(), chat.TypeTask, false, userID, time.Now(), meta),
+		chat.NewChatCreated(uuid.NewUUID(), uuid.NewUUID(), chat.TypeDiscussion, true, userID, time.Now(), meta),
+		chat.NewChatTypeChanged(uuid.NewUUID(), chat.TypeDiscussion, chat.TypeBug, "Crash", 2, meta),
+		chat.NewStatusChanged(chatID, "In Progress", "Done", userID, 3, meta),
+		chat.NewStatusChanged(chatID, "Done", "Done", userID, 4, meta),
+		message.NewCreated(uuid.NewUUID(), chatID, userID, "hello", "", meta),
+	}
+	for _, evt := range events {
+		if err := businessMetrics.HandleEvent(ctx, evt); err != nil {
+			t.Fatalf("HandleEvent(%s) error = %v", evt.EventType(), err)
+		}
+	}
+
+	if got := testutil.ToFloat64(businessMetrics.TasksCreated.WithLabelValues("task")); got != 1 {
+		t.Errorf("TasksCreated{task} = %v, want 1", got)
+	}
+	if got := testutil.ToFloat64(businessMetrics.TasksCreated.WithLabelValues("bug")); got != 1 {
+		t.Errorf("TasksCreated{bug} = %v, want 1", got)
+	}
+	if got := testutil.ToFloat64(businessMetrics.TasksCreated.WithLabelValues("discussion")); got != 0 {
+		t.Errorf("TasksCreated{discussion} = %v, want 0", got)
+	}
+	if got := testutil.ToFloat64(businessMetrics.TasksCompleted); got != 1 {
+		t.Errorf("TasksCompleted = %v, want 1", got)
+	}
+	if got := testutil.ToFloat64(businessMetrics.MessagesSent); got != 1 {
+		t.Errorf("MessagesSent = %v, want 1", got)
+	}
+}
+
+func TestBusinessMetrics_NotificationCreated(t *testing.T) {
+	registry := prometheus.NewRegistry()
+	businessMetrics := metrics.NewBusinessMetrics(registry)
+
+	businessMetrics.NotificationCreated(notification.TypeChatMention)
+	businessMetrics.NotificationCreated(notification.TypeChatMention)
+
+	got := testutil.ToFloat64(businessMetrics.NotificationsCreated.WithLabelValues(string(notification.TypeChatMention)))
+	if got != 2 {
+		t.Errorf("NotificationsCreated = %v, want 2", got)
+	}
+}
+
+type stubSubscriptions struct {
+	chats [][]uuid.UUID
+}
+
+func (s *stubSubscriptions) SubscribedChats() [][]uuid.UUID { return s.chats }
+
+type stubChatWorkspaces struct {
+	workspaces map[uuid.UUID]uuid.UUID
+	calls      int
+}
+
+func (s *stubChatWorkspaces) ChatWorkspaceID(_ context.Context, chatID uuid.UUID) (uuid.UUID, error) {
+	s.calls++
+	if workspaceID, ok := s.workspaces[chatID]; ok {
+		return workspaceID, nil
+	}
+	return "", errors.New("not found")
+}
+
+func TestWorkspaceConnectionsCollector(t *testing.T) {
+	ws1, ws2 := uuid.NewUUID(), uuid.NewUUID()
+	chatA, chatB, chatC, unknown := uuid.NewUUID(), uuid.NewUUID(), uuid.NewUUID(), uuid.NewUUID()
+	resolver := &stubChatWorkspaces{workspaces: map[uuid.UUID]uuid.UUID{chatA: ws1, chatB: ws1, chatC: ws2}}
+	source := &stubSubscriptions{chats: [][]uuid.UUID{
+		{chatA, chatB}, // counted once for ws1
+		{chatA, chatC},
+		{unknown},
+	}}
+
+	registry := prometheus.NewRegistry()
+	collector := metrics.NewWorkspaceConnectionsCollector(registry, source, resolver)
+
+	counts := collector.Counts()
+	if counts[ws1] != 2 || counts[ws2] != 1 || len(counts) != 2 {
+		t.Errorf("Counts() = %v, want ws1=2 ws2=1", counts)
+	}
+	if n := testutil.CollectAndCount(collector, "flowra_ws_workspace_connections"); n != 2 {
+		t.Errorf("collected %d series, want 2", n)
+	}
+	if resolver.calls != 5 {
+		t.Errorf("resolver calls = %d, want 5 (3 cached chats + unknown twice)", resolver.calls)
+	}
+}
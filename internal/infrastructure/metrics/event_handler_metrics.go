@@ -0,0 +1,65 @@
+package metrics
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/lllypuk/flowra/internal/infrastructure/eventbus"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// EventHandlerMetrics contains Prometheus metrics for domain event handlers.
+type EventHandlerMetrics struct {
+	Duration *prometheus.HistogramVec
+	Failures *prometheus.CounterVec
+}
+
+// NewEventHandlerMetrics creates and registers event handler metrics with the given registerer.
+func NewEventHandlerMetrics(registerer prometheus.Registerer) *EventHandlerMetrics {
+	metrics := &EventHandlerMetrics{
+		Duration: prometheus.NewHistogramVec(
+			prometheus.HistogramOpts{
+				Name:    "flowra_event_handler_duration_seconds",
+				Help:    "Time spent handling a domain event, including retries",
+				Buckets: prometheus.DefBuckets,
+			},
+			[]string{"handler", "event_type", "outcome"}, // outcome: success/error
+		),
+		Failures: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "flowra_event_handler_failures_total",
+				Help: "Total number of domain events a handler failed to process after all retries",
+			},
+			[]string{"handler", "event_type", "reason"}, // reason: error/panic/timeout
+		),
+	}
+
+	registerer.MustRegister(metrics.Duration, metrics.Failures)
+
+	return metrics
+}
+
+// ObserveEventHandler records one handler invocation.
+func (m *EventHandlerMetrics) ObserveEventHandler(handler, eventType string, duration time.Duration, err error) {
+	if err == nil {
+		m.Duration.WithLabelValues(handler, eventType, "success").Observe(duration.Seconds())
+		return
+	}
+
+	m.Duration.WithLabelValues(handler, eventType, "error").Observe(duration.Seconds())
+	m.Failures.WithLabelValues(handler, eventType, failureReason(err)).Inc()
+}
+
+func failureReason(err error) string {
+	switch {
+	case errors.Is(err, eventbus.ErrHandlerPanic):
+		return "panic"
+	case errors.Is(err, context.DeadlineExceeded):
+		return "timeout"
+	default:
+		return "error"
+	}
+}
+
+var _ eventbus.HandlerObserver = (*EventHandlerMetrics)(nil)
@@ -0,0 +1,36 @@
+package metrics_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/lllypuk/flowra/internal/infrastructure/eventbus"
+	"github.com/lllypuk/flowra/internal/infrastructure/metrics"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+func TestEventHandlerMetrics_ObserveEventHandler(t *testing.T) {
+	registry := prometheus.NewRegistry()
+	handlerMetrics := metrics.NewEventHandlerMetrics(registry)
+
+	handlerMetrics.ObserveEventHandler("notifications", "chat.created", time.Millisecond, nil)
+	handlerMetrics.ObserveEventHandler("notifications", "chat.created", time.Millisecond, errors.New("boom"))
+	handlerMetrics.ObserveEventHandler("notifications", "chat.created", time.Millisecond,
+		fmt.Errorf("%w: nil map", eventbus.ErrHandlerPanic))
+	handlerMetrics.ObserveEventHandler("analytics", "chat.created", time.Millisecond, context.DeadlineExceeded)
+
+	if got := testutil.CollectAndCount(handlerMetrics.Duration); got != 3 {
+		t.Errorf("expected 3 duration series, got %d", got)
+	}
+	failures := map[string]string{"error": "notifications", "panic": "notifications", "timeout": "analytics"}
+	for reason, handler := range failures {
+		got := testutil.ToFloat64(handlerMetrics.Failures.WithLabelValues(handler, "chat.created", reason))
+		if got != 1 {
+			t.Errorf("expected 1 %s failure, got %v", reason, got)
+		}
+	}
+}
@@ -6,14 +6,15 @@ import (
 
 // OutboxMetrics contains Prometheus metrics for monitoring outbox performance.
 type OutboxMetrics struct {
-	EventsPending       prometheus.Gauge
-	EventsProcessed     *prometheus.CounterVec
-	ProcessingDuration  *prometheus.HistogramVec
-	PublishDuration     *prometheus.HistogramVec
-	RetryTotal          *prometheus.CounterVec
-	OldestEventAge      prometheus.Gauge
-	PollBatchSize       prometheus.Histogram
-	CleanupDeletedTotal prometheus.Counter
+	EventsPending      prometheus.Gauge
+	EventsProcessed    *prometheus.CounterVec
+	ProcessingDuration *prometheus.HistogramVec
+	PublishDuration    *prometheus.HistogramVec
+	RetryTotal         *prometheus.CounterVec
+	OldestEventAge     prometheus.Gauge
+	PollBatchSize      prometheus.Histogram
+	QuarantineDepth    prometheus.Gauge
+	QuarantinedTotal   *prometheus.CounterVec
 }
 
 // NewOutboxMetrics creates and registers outbox metrics with the given registerer.
@@ -62,10 +63,17 @@ func NewOutboxMetrics(registerer prometheus.Registerer) *OutboxMetrics {
 			Help:    "Number of events retrieved in each poll batch",
 			Buckets: []float64{1, 5, 10, 25, 50, 100, 250, 500, 1000},
 		}),
-		CleanupDeletedTotal: prometheus.NewCounter(prometheus.CounterOpts{
-			Name: "flowra_outbox_cleanup_deleted_total",
-			Help: "Total number of processed events deleted by cleanup",
+		QuarantineDepth: prometheus.NewGauge(prometheus.GaugeOpts{
+			Name: "flowra_outbox_quarantined",
+			Help: "Current number of outbox events quarantined after exhausting their retries",
 		}),
+		QuarantinedTotal: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "flowra_outbox_quarantined_total",
+				Help: "Total number of outbox events moved to quarantine",
+			},
+			[]string{"event_type"},
+		),
 	}
 
 	// Register all metrics
@@ -77,7 +85,8 @@ func NewOutboxMetrics(registerer prometheus.Registerer) *OutboxMetrics {
 		metrics.RetryTotal,
 		metrics.OldestEventAge,
 		metrics.PollBatchSize,
-		metrics.CleanupDeletedTotal,
+		metrics.QuarantineDepth,
+		metrics.QuarantinedTotal,
 	)
 
 	return metrics
@@ -37,8 +37,11 @@ func TestOutboxMetrics_Registration(t *testing.T) {
 	if outboxMetrics.PollBatchSize == nil {
 		t.Error("PollBatchSize metric not initialized")
 	}
-	if outboxMetrics.CleanupDeletedTotal == nil {
-		t.Error("CleanupDeletedTotal metric not initialized")
+	if outboxMetrics.QuarantineDepth == nil {
+		t.Error("QuarantineDepth metric not initialized")
+	}
+	if outboxMetrics.QuarantinedTotal == nil {
+		t.Error("QuarantinedTotal metric not initialized")
 	}
 
 	// Test setting a simple gauge value
@@ -0,0 +1,200 @@
+package metrics
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/redis/go-redis/v9"
+	"go.mongodb.org/mongo-driver/v2/event"
+)
+
+// MongoPoolMetrics contains Prometheus metrics fed by the MongoDB driver pool monitor.
+type MongoPoolMetrics struct {
+	ConnectionsOpen     prometheus.Gauge
+	ConnectionsInUse    prometheus.Gauge
+	CheckoutsTotal      *prometheus.CounterVec
+	CheckoutWaitSeconds prometheus.Histogram
+	CheckoutTimeouts    prometheus.Counter
+	PoolClearedTotal    prometheus.Counter
+}
+
+// NewMongoPoolMetrics creates and registers MongoDB pool metrics with the given registerer.
+func NewMongoPoolMetrics(registerer prometheus.Registerer) *MongoPoolMetrics {
+	metrics := &MongoPoolMetrics{
+		ConnectionsOpen: prometheus.NewGauge(prometheus.GaugeOpts{
+			Name: "flowra_mongo_pool_connections_open",
+			Help: "Current number of open connections in the MongoDB pool",
+		}),
+		ConnectionsInUse: prometheus.NewGauge(prometheus.GaugeOpts{
+			Name: "flowra_mongo_pool_connections_in_use",
+			Help: "Current number of MongoDB connections checked out of the pool",
+		}),
+		CheckoutsTotal: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "flowra_mongo_pool_checkouts_total",
+				Help: "Total number of MongoDB connection checkouts",
+			},
+			[]string{"status"}, // status: success/failed
+		),
+		CheckoutWaitSeconds: prometheus.NewHistogram(prometheus.HistogramOpts{
+			Name:    "flowra_mongo_pool_checkout_wait_seconds",
+			Help:    "Time spent waiting to check out a MongoDB connection",
+			Buckets: []float64{0.0005, 0.001, 0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 5},
+		}),
+		CheckoutTimeouts: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "flowra_mongo_pool_checkout_timeouts_total",
+			Help: "Total number of MongoDB connection checkouts that timed out",
+		}),
+		PoolClearedTotal: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "flowra_mongo_pool_cleared_total",
+			Help: "Total number of times the MongoDB pool was cleared",
+		}),
+	}
+
+	registerer.MustRegister(
+		metrics.ConnectionsOpen,
+		metrics.ConnectionsInUse,
+		metrics.CheckoutsTotal,
+		metrics.CheckoutWaitSeconds,
+		metrics.CheckoutTimeouts,
+		metrics.PoolClearedTotal,
+	)
+
+	return metrics
+}
+
+// PoolMonitor returns a driver pool monitor that records pool events into the metrics.
+func (m *MongoPoolMetrics) PoolMonitor() *event.PoolMonitor {
+	return &event.PoolMonitor{Event: m.HandlePoolEvent}
+}
+
+// HandlePoolEvent records a single MongoDB pool event.
+func (m *MongoPoolMetrics) HandlePoolEvent(evt *event.PoolEvent) {
+	if evt == nil {
+		return
+	}
+
+	switch evt.Type {
+	case event.ConnectionCreated:
+		m.ConnectionsOpen.Inc()
+	case event.ConnectionClosed:
+		m.ConnectionsOpen.Dec()
+	case event.ConnectionCheckedOut:
+		m.ConnectionsInUse.Inc()
+		m.CheckoutsTotal.WithLabelValues("success").Inc()
+		m.CheckoutWaitSeconds.Observe(evt.Duration.Seconds())
+	case event.ConnectionCheckedIn:
+		m.ConnectionsInUse.Dec()
+	case event.ConnectionCheckOutFailed:
+		m.CheckoutsTotal.WithLabelValues("failed").Inc()
+		m.CheckoutWaitSeconds.Observe(evt.Duration.Seconds())
+		if evt.Reason == event.ReasonTimedOut {
+			m.CheckoutTimeouts.Inc()
+		}
+	case event.ConnectionPoolCleared:
+		m.PoolClearedTotal.Inc()
+	}
+}
+
+// RedisPoolStatsProvider exposes Redis connection pool statistics.
+type RedisPoolStatsProvider interface {
+	PoolStats() *redis.PoolStats
+}
+
+// RedisPoolCollector exports Redis pool statistics as Prometheus metrics on every scrape.
+type RedisPoolCollector struct {
+	provider RedisPoolStatsProvider
+
+	hits         *prometheus.Desc
+	misses       *prometheus.Desc
+	timeouts     *prometheus.Desc
+	waits        *prometheus.Desc
+	waitDuration *prometheus.Desc
+	totalConns   *prometheus.Desc
+	idleConns    *prometheus.Desc
+	staleConns   *prometheus.Desc
+}
+
+// NewRedisPoolCollector creates and registers a Redis pool collector with the given registerer.
+func NewRedisPoolCollector(registerer prometheus.Registerer, provider RedisPoolStatsProvider) *RedisPoolCollector {
+	collector := &RedisPoolCollector{
+		provider: provider,
+		hits: prometheus.NewDesc(
+			"flowra_redis_pool_hits_total",
+			"Total number of times a free connection was found in the Redis pool",
+			nil, nil,
+		),
+		misses: prometheus.NewDesc(
+			"flowra_redis_pool_misses_total",
+			"Total number of times a free connection was not found in the Redis pool",
+			nil, nil,
+		),
+		timeouts: prometheus.NewDesc(
+			"flowra_redis_pool_timeouts_total",
+			"Total number of Redis pool wait timeouts",
+			nil, nil,
+		),
+		waits: prometheus.NewDesc(
+			"flowra_redis_pool_waits_total",
+			"Total number of times a Redis connection was waited for",
+			nil, nil,
+		),
+		waitDuration: prometheus.NewDesc(
+			"flowra_redis_pool_wait_duration_seconds_total",
+			"Total time spent waiting for Redis connections",
+			nil, nil,
+		),
+		totalConns: prometheus.NewDesc(
+			"flowra_redis_pool_connections_total",
+			"Current number of connections in the Redis pool",
+			nil, nil,
+		),
+		idleConns: prometheus.NewDesc(
+			"flowra_redis_pool_connections_idle",
+			"Current number of idle connections in the Redis pool",
+			nil, nil,
+		),
+		staleConns: prometheus.NewDesc(
+			"flowra_redis_pool_stale_connections_total",
+			"Total number of stale connections removed from the Redis pool",
+			nil, nil,
+		),
+	}
+
+	registerer.MustRegister(collector)
+
+	return collector
+}
+
+// Describe implements prometheus.Collector.
+func (c *RedisPoolCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.hits
+	ch <- c.misses
+	ch <- c.timeouts
+	ch <- c.waits
+	ch <- c.waitDuration
+	ch <- c.totalConns
+	ch <- c.idleConns
+	ch <- c.staleConns
+}
+
+// Collect implements prometheus.Collector.
+func (c *RedisPoolCollector) Collect(ch chan<- prometheus.Metric) {
+	stats := c.provider.PoolStats()
+	if stats == nil {
+		return
+	}
+
+	ch <- prometheus.MustNewConstMetric(c.hits, prometheus.CounterValue, float64(stats.Hits))
+	ch <- prometheus.MustNewConstMetric(c.misses, prometheus.CounterValue, float64(stats.Misses))
+	ch <- prometheus.MustNewConstMetric(c.timeouts, prometheus.CounterValue, float64(stats.Timeouts))
+	ch <- prometheus.MustNewConstMetric(c.waits, prometheus.CounterValue, float64(stats.WaitCount))
+	ch <- prometheus.MustNewConstMetric(
+		c.waitDuration,
+		prometheus.CounterValue,
+		time.Duration(stats.WaitDurationNs).Seconds(),
+	)
+	ch <- prometheus.MustNewConstMetric(c.totalConns, prometheus.GaugeValue, float64(stats.TotalConns))
+	ch <- prometheus.MustNewConstMetric(c.idleConns, prometheus.GaugeValue, float64(stats.IdleConns))
+	ch <- prometheus.MustNewConstMetric(c.staleConns, prometheus.CounterValue, float64(stats.StaleConns))
+}
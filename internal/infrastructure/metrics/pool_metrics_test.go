@@ -0,0 +1,101 @@
+package metrics_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/v2/event"
+
+	"github.com/lllypuk/flowra/internal/infrastructure/metrics"
+)
+
+type stubRedisPool struct {
+	stats *redis.PoolStats
+}
+
+func (s *stubRedisPool) PoolStats() *redis.PoolStats {
+	return s.stats
+}
+
+func TestMongoPoolMetrics_HandlePoolEvent(t *testing.T) {
+	registry := prometheus.NewRegistry()
+	poolMetrics := metrics.NewMongoPoolMetrics(registry)
+	monitor := poolMetrics.PoolMonitor()
+
+	monitor.Event(&event.PoolEvent{Type: event.ConnectionCreated})
+	monitor.Event(&event.PoolEvent{Type: event.ConnectionCreated})
+	monitor.Event(&event.PoolEvent{Type: event.ConnectionCheckedOut, Duration: 2 * time.Millisecond})
+	monitor.Event(&event.PoolEvent{Type: event.ConnectionCheckedOut, Duration: time.Millisecond})
+	monitor.Event(&event.PoolEvent{Type: event.ConnectionCheckedIn})
+	monitor.Event(&event.PoolEvent{
+		Type:     event.ConnectionCheckOutFailed,
+		Reason:   event.ReasonTimedOut,
+		Duration: time.Second,
+	})
+	monitor.Event(&event.PoolEvent{Type: event.ConnectionCheckOutFailed, Reason: event.ReasonPoolClosed})
+	monitor.Event(&event.PoolEvent{Type: event.ConnectionClosed})
+	monitor.Event(&event.PoolEvent{Type: event.ConnectionPoolCleared})
+	monitor.Event(nil)
+
+	assert.InDelta(t, 1, testutil.ToFloat64(poolMetrics.ConnectionsOpen), 0)
+	assert.InDelta(t, 1, testutil.ToFloat64(poolMetrics.ConnectionsInUse), 0)
+	assert.InDelta(t, 2, testutil.ToFloat64(poolMetrics.CheckoutsTotal.WithLabelValues("success")), 0)
+	assert.InDelta(t, 2, testutil.ToFloat64(poolMetrics.CheckoutsTotal.WithLabelValues("failed")), 0)
+	assert.InDelta(t, 1, testutil.ToFloat64(poolMetrics.CheckoutTimeouts), 0)
+	assert.InDelta(t, 1, testutil.ToFloat64(poolMetrics.PoolClearedTotal), 0)
+	assert.Equal(t, 1, testutil.CollectAndCount(poolMetrics.CheckoutWaitSeconds))
+}
+
+func TestRedisPoolCollector_Collect(t *testing.T) {
+	registry := prometheus.NewRegistry()
+	provider := &stubRedisPool{stats: &redis.PoolStats{
+		Hits:           10,
+		Misses:         3,
+		Timeouts:       1,
+		WaitCount:      4,
+		WaitDurationNs: int64(500 * time.Millisecond),
+		TotalConns:     8,
+		IdleConns:      5,
+		StaleConns:     2,
+	}}
+
+	metrics.NewRedisPoolCollector(registry, provider)
+
+	expected := `
+# HELP flowra_redis_pool_connections_idle Current number of idle connections in the Redis pool
+# TYPE flowra_redis_pool_connections_idle gauge
+flowra_redis_pool_connections_idle 5
+# HELP flowra_redis_pool_hits_total Total number of times a free connection was found in the Redis pool
+# TYPE flowra_redis_pool_hits_total counter
+flowra_redis_pool_hits_total 10
+# HELP flowra_redis_pool_timeouts_total Total number of Redis pool wait timeouts
+# TYPE flowra_redis_pool_timeouts_total counter
+flowra_redis_pool_timeouts_total 1
+# HELP flowra_redis_pool_wait_duration_seconds_total Total time spent waiting for Redis connections
+# TYPE flowra_redis_pool_wait_duration_seconds_total counter
+flowra_redis_pool_wait_duration_seconds_total 0.5
+`
+	err := testutil.GatherAndCompare(
+		registry,
+		strings.NewReader(expected),
+		"flowra_redis_pool_connections_idle",
+		"flowra_redis_pool_hits_total",
+		"flowra_redis_pool_timeouts_total",
+		"flowra_redis_pool_wait_duration_seconds_total",
+	)
+	require.NoError(t, err)
+	assert.Equal(t, 8, testutil.CollectAndCount(registry))
+}
+
+func TestRedisPoolCollector_NilStats(t *testing.T) {
+	registry := prometheus.NewRegistry()
+	metrics.NewRedisPoolCollector(registry, &stubRedisPool{})
+
+	assert.Equal(t, 0, testutil.CollectAndCount(registry))
+}
@@ -0,0 +1,32 @@
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// MongoQueryMetrics contains Prometheus metrics for MongoDB commands.
+type MongoQueryMetrics struct {
+	SlowQueriesTotal *prometheus.CounterVec
+}
+
+// NewMongoQueryMetrics creates and registers MongoDB query metrics with the given registerer.
+func NewMongoQueryMetrics(registerer prometheus.Registerer) *MongoQueryMetrics {
+	metrics := &MongoQueryMetrics{
+		SlowQueriesTotal: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "flowra_mongo_slow_queries_total",
+				Help: "Total number of MongoDB commands slower than the slow query threshold",
+			},
+			[]string{"collection", "command"},
+		),
+	}
+
+	registerer.MustRegister(metrics.SlowQueriesTotal)
+
+	return metrics
+}
+
+// IncSlowQuery counts a slow command on a collection.
+func (m *MongoQueryMetrics) IncSlowQuery(collection, command string) {
+	m.SlowQueriesTotal.WithLabelValues(collection, command).Inc()
+}
@@ -0,0 +1,23 @@
+package metrics_test
+
+import (
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lllypuk/flowra/internal/infrastructure/metrics"
+)
+
+func TestMongoQueryMetrics_IncSlowQuery(t *testing.T) {
+	registry := prometheus.NewRegistry()
+	queryMetrics := metrics.NewMongoQueryMetrics(registry)
+
+	queryMetrics.IncSlowQuery("messages", "find")
+	queryMetrics.IncSlowQuery("messages", "find")
+	queryMetrics.IncSlowQuery("events", "aggregate")
+
+	assert.InDelta(t, 2, testutil.ToFloat64(queryMetrics.SlowQueriesTotal.WithLabelValues("messages", "find")), 0)
+	assert.InDelta(t, 1, testutil.ToFloat64(queryMetrics.SlowQueriesTotal.WithLabelValues("events", "aggregate")), 0)
+}
@@ -0,0 +1,32 @@
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// RateLimitMetrics contains Prometheus metrics for workspace fair-usage limits.
+type RateLimitMetrics struct {
+	WorkspaceLimitExceeded *prometheus.CounterVec
+}
+
+// NewRateLimitMetrics creates and registers rate limit metrics with the given registerer.
+func NewRateLimitMetrics(registerer prometheus.Registerer) *RateLimitMetrics {
+	metrics := &RateLimitMetrics{
+		WorkspaceLimitExceeded: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "flowra_workspace_rate_limit_exceeded_total",
+				Help: "Total number of times a workspace exceeded a fair-usage limit, counted once per window",
+			},
+			[]string{"kind"}, // kind: api_calls/messages
+		),
+	}
+
+	registerer.MustRegister(metrics.WorkspaceLimitExceeded)
+
+	return metrics
+}
+
+// RecordWorkspaceLimitExceeded counts a workspace exceeding the limit of the given kind.
+func (m *RateLimitMetrics) RecordWorkspaceLimitExceeded(kind string) {
+	m.WorkspaceLimitExceeded.WithLabelValues(kind).Inc()
+}
@@ -0,0 +1,25 @@
+package metrics_test
+
+import (
+	"testing"
+
+	"github.com/lllypuk/flowra/internal/infrastructure/metrics"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+func TestRateLimitMetrics_RecordWorkspaceLimitExceeded(t *testing.T) {
+	registry := prometheus.NewRegistry()
+	rateLimitMetrics := metrics.NewRateLimitMetrics(registry)
+
+	rateLimitMetrics.RecordWorkspaceLimitExceeded("messages")
+	rateLimitMetrics.RecordWorkspaceLimitExceeded("messages")
+	rateLimitMetrics.RecordWorkspaceLimitExceeded("api_calls")
+
+	if got := testutil.ToFloat64(rateLimitMetrics.WorkspaceLimitExceeded.WithLabelValues("messages")); got != 2 {
+		t.Errorf("expected 2 message limit events, got %v", got)
+	}
+	if got := testutil.ToFloat64(rateLimitMetrics.WorkspaceLimitExceeded.WithLabelValues("api_calls")); got != 1 {
+		t.Errorf("expected 1 API call limit event, got %v", got)
+	}
+}
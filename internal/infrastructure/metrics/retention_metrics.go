@@ -0,0 +1,69 @@
+package metrics
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// RetentionMetrics contains Prometheus metrics for the retention worker.
+type RetentionMetrics struct {
+	PurgedTotal  *prometheus.CounterVec
+	ErrorsTotal  *prometheus.CounterVec
+	PassDuration prometheus.Histogram
+	LastPass     prometheus.Gauge
+}
+
+// NewRetentionMetrics creates and registers retention metrics with the given registerer.
+func NewRetentionMetrics(registerer prometheus.Registerer) *RetentionMetrics {
+	metrics := &RetentionMetrics{
+		PurgedTotal: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "flowra_retention_purged_total",
+				Help: "Total number of documents purged by retention policies",
+			},
+			[]string{"kind"}, // kind: messages/notifications/repair_queue/outbox
+		),
+		ErrorsTotal: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "flowra_retention_errors_total",
+				Help: "Total number of failed retention purges",
+			},
+			[]string{"kind"},
+		),
+		PassDuration: prometheus.NewHistogram(prometheus.HistogramOpts{
+			Name:    "flowra_retention_pass_duration_seconds",
+			Help:    "Duration of a full retention pass, including pauses between batches",
+			Buckets: []float64{0.1, 0.5, 1, 5, 15, 30, 60, 300, 900},
+		}),
+		LastPass: prometheus.NewGauge(prometheus.GaugeOpts{
+			Name: "flowra_retention_last_pass_timestamp_seconds",
+			Help: "Unix time of the last completed retention pass",
+		}),
+	}
+
+	registerer.MustRegister(
+		metrics.PurgedTotal,
+		metrics.ErrorsTotal,
+		metrics.PassDuration,
+		metrics.LastPass,
+	)
+
+	return metrics
+}
+
+// RecordPurged counts documents of kind purged in one batch.
+func (m *RetentionMetrics) RecordPurged(kind string, count int64) {
+	m.PurgedTotal.WithLabelValues(kind).Add(float64(count))
+}
+
+// RecordError counts a failed purge of kind.
+func (m *RetentionMetrics) RecordError(kind string) {
+	m.ErrorsTotal.WithLabelValues(kind).Inc()
+}
+
+// RecordPass records the duration and completion time of a retention pass.
+func (m *RetentionMetrics) RecordPass(duration time.Duration, finishedAt time.Time) {
+	m.PassDuration.Observe(duration.Seconds())
+	m.LastPass.Set(float64(finishedAt.Unix()))
+}
@@ -0,0 +1,31 @@
+package metrics_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/lllypuk/flowra/internal/infrastructure/metrics"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+func TestRetentionMetrics_Record(t *testing.T) {
+	registry := prometheus.NewRegistry()
+	retentionMetrics := metrics.NewRetentionMetrics(registry)
+
+	retentionMetrics.RecordPurged("messages", 500)
+	retentionMetrics.RecordPurged("messages", 20)
+	retentionMetrics.RecordError("outbox")
+	finishedAt := time.Unix(1_700_000_000, 0)
+	retentionMetrics.RecordPass(3*time.Second, finishedAt)
+
+	if got := testutil.ToFloat64(retentionMetrics.PurgedTotal.WithLabelValues("messages")); got != 520 {
+		t.Errorf("expected 520 purged messages, got %v", got)
+	}
+	if got := testutil.ToFloat64(retentionMetrics.ErrorsTotal.WithLabelValues("outbox")); got != 1 {
+		t.Errorf("expected 1 outbox error, got %v", got)
+	}
+	if got := testutil.ToFloat64(retentionMetrics.LastPass); got != float64(finishedAt.Unix()) {
+		t.Errorf("expected last pass at %d, got %v", finishedAt.Unix(), got)
+	}
+}
@@ -0,0 +1,32 @@
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// TimeoutMetrics contains Prometheus metrics for HTTP request deadlines.
+type TimeoutMetrics struct {
+	RequestTimeouts *prometheus.CounterVec
+}
+
+// NewTimeoutMetrics creates and registers request timeout metrics with the given registerer.
+func NewTimeoutMetrics(registerer prometheus.Registerer) *TimeoutMetrics {
+	metrics := &TimeoutMetrics{
+		RequestTimeouts: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "flowra_http_request_timeouts_total",
+				Help: "Total number of HTTP requests that exceeded their route deadline",
+			},
+			[]string{"method", "route"}, // route: registered route pattern, e.g. /api/v1/chats/:id
+		),
+	}
+
+	registerer.MustRegister(metrics.RequestTimeouts)
+
+	return metrics
+}
+
+// RecordRequestTimeout counts a request to route that exceeded its deadline.
+func (m *TimeoutMetrics) RecordRequestTimeout(method, route string) {
+	m.RequestTimeouts.WithLabelValues(method, route).Inc()
+}
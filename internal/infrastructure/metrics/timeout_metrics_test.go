@@ -0,0 +1,25 @@
+package metrics_test
+
+import (
+	"testing"
+
+	"github.com/lllypuk/flowra/internal/infrastructure/metrics"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+func TestTimeoutMetrics_RecordRequestTimeout(t *testing.T) {
+	registry := prometheus.NewRegistry()
+	timeoutMetrics := metrics.NewTimeoutMetrics(registry)
+
+	timeoutMetrics.RecordRequestTimeout("GET", "/api/v1/chats/:id")
+	timeoutMetrics.RecordRequestTimeout("GET", "/api/v1/chats/:id")
+	timeoutMetrics.RecordRequestTimeout("POST", "/api/v1/files/upload")
+
+	if got := testutil.ToFloat64(timeoutMetrics.RequestTimeouts.WithLabelValues("GET", "/api/v1/chats/:id")); got != 2 {
+		t.Errorf("expected 2 chat timeouts, got %v", got)
+	}
+	if got := testutil.ToFloat64(timeoutMetrics.RequestTimeouts.WithLabelValues("POST", "/api/v1/files/upload")); got != 1 {
+		t.Errorf("expected 1 upload timeout, got %v", got)
+	}
+}
@@ -0,0 +1,84 @@
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/lllypuk/flowra/internal/infrastructure/websocket"
+)
+
+// HubStatsProvider exposes the counters of the WebSocket hub.
+type HubStatsProvider interface {
+	Stats() websocket.HubStats
+}
+
+// HubCollector exports WebSocket hub counters as Prometheus metrics on every scrape.
+// Connections per workspace are reported by WorkspaceConnectionsCollector.
+type HubCollector struct {
+	provider HubStatsProvider
+
+	connections           *prometheus.Desc
+	broadcasts            *prometheus.Desc
+	sendBufferDrops       *prometheus.Desc
+	slowClientDisconnects *prometheus.Desc
+	authFailures          *prometheus.Desc
+}
+
+// NewHubCollector creates and registers a hub collector with the given registerer.
+func NewHubCollector(registerer prometheus.Registerer, provider HubStatsProvider) *HubCollector {
+	collector := &HubCollector{
+		provider: provider,
+		connections: prometheus.NewDesc(
+			"flowra_ws_connections",
+			"Current number of WebSocket connections",
+			nil, nil,
+		),
+		broadcasts: prometheus.NewDesc(
+			"flowra_ws_messages_broadcast_total",
+			"Total number of messages broadcast through the WebSocket hub",
+			nil, nil,
+		),
+		sendBufferDrops: prometheus.NewDesc(
+			"flowra_ws_send_buffer_drops_total",
+			"Total number of WebSocket messages dropped because a client's send buffer was full",
+			nil, nil,
+		),
+		slowClientDisconnects: prometheus.NewDesc(
+			"flowra_ws_slow_client_disconnects_total",
+			"Total number of WebSocket clients disconnected because a write timed out",
+			nil, nil,
+		),
+		authFailures: prometheus.NewDesc(
+			"flowra_ws_auth_failures_total",
+			"Total number of rejected WebSocket connection attempts and token refreshes",
+			nil, nil,
+		),
+	}
+
+	registerer.MustRegister(collector)
+
+	return collector
+}
+
+// Describe implements prometheus.Collector.
+func (c *HubCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.connections
+	ch <- c.broadcasts
+	ch <- c.sendBufferDrops
+	ch <- c.slowClientDisconnects
+	ch <- c.authFailures
+}
+
+// Collect implements prometheus.Collector.
+func (c *HubCollector) Collect(ch chan<- prometheus.Metric) {
+	stats := c.provider.Stats()
+
+	ch <- prometheus.MustNewConstMetric(c.connections, prometheus.GaugeValue, float64(stats.Connections))
+	ch <- prometheus.MustNewConstMetric(c.broadcasts, prometheus.CounterValue, float64(stats.Broadcasts))
+	ch <- prometheus.MustNewConstMetric(c.sendBufferDrops, prometheus.CounterValue, float64(stats.SendBufferDrops))
+	ch <- prometheus.MustNewConstMetric(
+		c.slowClientDisconnects,
+		prometheus.CounterValue,
+		float64(stats.SlowClientDisconnects),
+	)
+	ch <- prometheus.MustNewConstMetric(c.authFailures, prometheus.CounterValue, float64(stats.AuthFailures))
+}
@@ -0,0 +1,54 @@
+package metrics_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lllypuk/flowra/internal/infrastructure/metrics"
+	"github.com/lllypuk/flowra/internal/infrastructure/websocket"
+)
+
+type stubHub struct {
+	stats websocket.HubStats
+}
+
+func (s *stubHub) Stats() websocket.HubStats {
+	return s.stats
+}
+
+func TestHubCollector_Collect(t *testing.T) {
+	registry := prometheus.NewRegistry()
+	metrics.NewHubCollector(registry, &stubHub{stats: websocket.HubStats{
+		Connections:           4,
+		Broadcasts:            120,
+		SendBufferDrops:       3,
+		SlowClientDisconnects: 1,
+		AuthFailures:          2,
+	}})
+
+	expected := `
+# HELP flowra_ws_connections Current number of WebSocket connections
+# TYPE flowra_ws_connections gauge
+flowra_ws_connections 4
+# HELP flowra_ws_send_buffer_drops_total Total number of WebSocket messages dropped because a client's send buffer was full
+# TYPE flowra_ws_send_buffer_drops_total counter
+flowra_ws_send_buffer_drops_total 3
+# HELP flowra_ws_slow_client_disconnects_total Total number of WebSocket clients disconnected because a write timed out
+# TYPE flowra_ws_slow_client_disconnects_total counter
+flowra_ws_slow_client_disconnects_total 1
+`
+	err := testutil.GatherAndCompare(
+		registry,
+		strings.NewReader(expected),
+		"flowra_ws_connections",
+		"flowra_ws_send_buffer_drops_total",
+		"flowra_ws_slow_client_disconnects_total",
+	)
+	require.NoError(t, err)
+	assert.Equal(t, 5, testutil.CollectAndCount(registry))
+}
@@ -0,0 +1,119 @@
+package metrics
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/lllypuk/flowra/internal/domain/uuid"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Workspace connection collector limits.
+const (
+	chatWorkspaceCacheLimit = 10000
+	wsCollectTimeout        = 2 * time.Second
+)
+
+// ChatSubscriptionSource lists the chat rooms of connected WebSocket clients.
+// Declared on the consumer side per project guidelines.
+type ChatSubscriptionSource interface {
+	SubscribedChats() [][]uuid.UUID
+}
+
+// ChatWorkspaceResolver finds the workspace a chat belongs to.
+// Declared on the consumer side per project guidelines.
+type ChatWorkspaceResolver interface {
+	ChatWorkspaceID(ctx context.Context, chatID uuid.UUID) (uuid.UUID, error)
+}
+
+// WorkspaceConnectionsCollector reports active WebSocket connections per workspace.
+// A connection counts toward every workspace it has joined a chat of; the
+// chat-to-workspace mapping never changes, so resolved chats are cached.
+type WorkspaceConnectionsCollector struct {
+	source   ChatSubscriptionSource
+	resolver ChatWorkspaceResolver
+	desc     *prometheus.Desc
+
+	mu    sync.Mutex
+	cache map[uuid.UUID]uuid.UUID
+}
+
+// NewWorkspaceConnectionsCollector creates and registers the collector with the given registerer.
+func NewWorkspaceConnectionsCollector(
+	registerer prometheus.Registerer,
+	source ChatSubscriptionSource,
+	resolver ChatWorkspaceResolver,
+) *WorkspaceConnectionsCollector {
+	collector := &WorkspaceConnectionsCollector{
+		source:   source,
+		resolver: resolver,
+		desc: prometheus.NewDesc(
+			"flowra_ws_workspace_connections",
+			"Current number of WebSocket connections subscribed to chats of a workspace",
+			[]string{"workspace_id"},
+			nil,
+		),
+		cache: make(map[uuid.UUID]uuid.UUID),
+	}
+
+	registerer.MustRegister(collector)
+
+	return collector
+}
+
+// Describe implements prometheus.Collector.
+func (c *WorkspaceConnectionsCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.desc
+}
+
+// Collect implements prometheus.Collector.
+func (c *WorkspaceConnectionsCollector) Collect(ch chan<- prometheus.Metric) {
+	for workspaceID, count := range c.Counts() {
+		ch <- prometheus.MustNewConstMetric(c.desc, prometheus.GaugeValue, float64(count), workspaceID.String())
+	}
+}
+
+// Counts returns the number of connections per workspace. Chats that cannot be
+// resolved are skipped.
+func (c *WorkspaceConnectionsCollector) Counts() map[uuid.UUID]int {
+	ctx, cancel := context.WithTimeout(context.Background(), wsCollectTimeout)
+	defer cancel()
+
+	counts := make(map[uuid.UUID]int)
+	for _, chatIDs := range c.source.SubscribedChats() {
+		workspaces := make(map[uuid.UUID]struct{}, 1)
+		for _, chatID := range chatIDs {
+			if workspaceID, ok := c.workspaceOf(ctx, chatID); ok {
+				workspaces[workspaceID] = struct{}{}
+			}
+		}
+		for workspaceID := range workspaces {
+			counts[workspaceID]++
+		}
+	}
+	return counts
+}
+
+func (c *WorkspaceConnectionsCollector) workspaceOf(ctx context.Context, chatID uuid.UUID) (uuid.UUID, bool) {
+	c.mu.Lock()
+	workspaceID, ok := c.cache[chatID]
+	c.mu.Unlock()
+	if ok {
+		return workspaceID, true
+	}
+
+	workspaceID, err := c.resolver.ChatWorkspaceID(ctx, chatID)
+	if err != nil || workspaceID.IsZero() {
+		return "", false
+	}
+
+	c.mu.Lock()
+	if len(c.cache) >= chatWorkspaceCacheLimit {
+		clear(c.cache)
+	}
+	c.cache[chatID] = workspaceID
+	c.mu.Unlock()
+
+	return workspaceID, true
+}
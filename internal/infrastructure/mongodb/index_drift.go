@@ -0,0 +1,344 @@
+package mongodb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+// namespaceNotFoundCode is returned by listIndexes for collections that do not exist yet.
+const namespaceNotFoundCode = 26
+
+// idIndexName is the index MongoDB creates on every collection.
+const idIndexName = "_id_"
+
+// defaultTextLanguage is the language MongoDB stores for text indexes without one.
+const defaultTextLanguage = "english"
+
+// IndexDriftKind classifies a difference between the expected and the actual indexes.
+type IndexDriftKind string
+
+// Index drift kinds.
+const (
+	// IndexMissing is an expected index that does not exist.
+	IndexMissing IndexDriftKind = "missing"
+	// IndexChanged exists under the expected name but with other keys or options.
+	IndexChanged IndexDriftKind = "changed"
+	// IndexOrphaned exists on a managed collection but is not expected.
+	IndexOrphaned IndexDriftKind = "orphaned"
+)
+
+// IndexSpec is the comparable shape of an index: its keys and the options the
+// application sets. Keys and filters are kept in a canonical text form.
+type IndexSpec struct {
+	Keys            string `json:"keys"`
+	Unique          bool   `json:"unique,omitempty"`
+	Sparse          bool   `json:"sparse,omitempty"`
+	PartialFilter   string `json:"partial_filter,omitempty"`
+	DefaultLanguage string `json:"default_language,omitempty"`
+	Weights         string `json:"weights,omitempty"`
+}
+
+// IndexDrift describes one index that differs from its definition.
+type IndexDrift struct {
+	Collection string         `json:"collection"`
+	Index      string         `json:"index"`
+	Kind       IndexDriftKind `json:"kind"`
+	Expected   *IndexSpec     `json:"expected,omitempty"`
+	Actual     *IndexSpec     `json:"actual,omitempty"`
+}
+
+// ActualIndex is an index as listed by MongoDB.
+type ActualIndex struct {
+	Name string
+	Spec IndexSpec
+}
+
+// listedIndex is the part of a listIndexes entry the verification compares.
+type listedIndex struct {
+	Name                    string   `bson:"name"`
+	Key                     bson.Raw `bson:"key"`
+	Unique                  bool     `bson:"unique"`
+	Sparse                  bool     `bson:"sparse"`
+	PartialFilterExpression bson.Raw `bson:"partialFilterExpression"`
+	DefaultLanguage         string   `bson:"default_language"`
+	Weights                 bson.Raw `bson:"weights"`
+}
+
+// IndexVerifier compares the indexes of a database with their definitions and
+// repairs the differences.
+type IndexVerifier struct {
+	db          *mongo.Database
+	definitions []IndexDefinition
+}
+
+// NewIndexVerifier creates a verifier for the given definitions, usually
+// GetAllIndexDefinitions plus the event partition indexes when partitioned.
+func NewIndexVerifier(db *mongo.Database, definitions []IndexDefinition) *IndexVerifier {
+	return &IndexVerifier{db: db, definitions: definitions}
+}
+
+// Verify lists the indexes of every managed collection and returns the drift,
+// ordered by collection and index name.
+func (v *IndexVerifier) Verify(ctx context.Context) ([]IndexDrift, error) {
+	actual := make(map[string][]ActualIndex)
+	for _, collection := range managedCollections(v.definitions) {
+		indexes, err := v.listIndexes(ctx, collection)
+		if err != nil {
+			return nil, err
+		}
+		actual[collection] = indexes
+	}
+	return DiffIndexes(v.definitions, actual)
+}
+
+// Repair verifies the indexes, recreates changed ones, creates missing ones and,
+// when dropOrphans is set, drops orphaned ones. It returns the drift it repaired.
+func (v *IndexVerifier) Repair(ctx context.Context, dropOrphans bool) ([]IndexDrift, error) {
+	drifts, err := v.Verify(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	definitions := make(map[string]IndexDefinition, len(v.definitions))
+	for _, def := range v.definitions {
+		definitions[def.Collection+"."+def.Name()] = def
+	}
+
+	// Orphans go first: one may hold the keys of a missing index under another name
+	slices.SortStableFunc(drifts, func(a, b IndexDrift) int {
+		return compareDriftKinds(a.Kind, b.Kind)
+	})
+
+	repaired := make([]IndexDrift, 0, len(drifts))
+	for _, drift := range drifts {
+		indexes := v.db.Collection(drift.Collection).Indexes()
+		switch drift.Kind {
+		case IndexOrphaned:
+			if !dropOrphans {
+				continue
+			}
+			if err = indexes.DropOne(ctx, drift.Index); err != nil {
+				return repaired, fmt.Errorf("failed to drop index %s on collection %s: %w",
+					drift.Index, drift.Collection, err)
+			}
+		case IndexChanged:
+			if err = indexes.DropOne(ctx, drift.Index); err != nil {
+				return repaired, fmt.Errorf("failed to drop index %s on collection %s: %w",
+					drift.Index, drift.Collection, err)
+			}
+			fallthrough
+		case IndexMissing:
+			def := definitions[drift.Collection+"."+drift.Index]
+			model := mongo.IndexModel{Keys: def.Keys, Options: def.Options}
+			if _, err = indexes.CreateOne(ctx, model); err != nil {
+				return repaired, fmt.Errorf("failed to create index %s on collection %s: %w",
+					drift.Index, drift.Collection, err)
+			}
+		}
+		repaired = append(repaired, drift)
+	}
+
+	return repaired, nil
+}
+
+func (v *IndexVerifier) listIndexes(ctx context.Context, collection string) ([]ActualIndex, error) {
+	cursor, err := v.db.Collection(collection).Indexes().List(ctx)
+	if err != nil {
+		var cmdErr mongo.CommandError
+		if errors.As(err, &cmdErr) && cmdErr.Code == namespaceNotFoundCode {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to list indexes on collection %s: %w", collection, err)
+	}
+
+	var listed []listedIndex
+	if err = cursor.All(ctx, &listed); err != nil {
+		return nil, fmt.Errorf("failed to decode indexes on collection %s: %w", collection, err)
+	}
+
+	indexes := make([]ActualIndex, 0, len(listed))
+	for _, idx := range listed {
+		indexes = append(indexes, ActualIndex{Name: idx.Name, Spec: idx.spec()})
+	}
+	return indexes, nil
+}
+
+// DiffIndexes compares the definitions with the indexes listed per collection.
+// The _id index is never reported as orphaned.
+func DiffIndexes(definitions []IndexDefinition, actual map[string][]ActualIndex) ([]IndexDrift, error) {
+	var drifts []IndexDrift
+	expectedNames := make(map[string]bool, len(definitions))
+
+	for _, def := range definitions {
+		expected, err := def.Spec()
+		if err != nil {
+			return nil, err
+		}
+		name := def.Name()
+		expectedNames[def.Collection+"."+name] = true
+
+		idx := slices.IndexFunc(actual[def.Collection], func(a ActualIndex) bool { return a.Name == name })
+		switch {
+		case idx < 0:
+			drifts = append(drifts, IndexDrift{
+				Collection: def.Collection, Index: name, Kind: IndexMissing, Expected: &expected,
+			})
+		case actual[def.Collection][idx].Spec != expected:
+			found := actual[def.Collection][idx].Spec
+			drifts = append(drifts, IndexDrift{
+				Collection: def.Collection, Index: name, Kind: IndexChanged, Expected: &expected, Actual: &found,
+			})
+		}
+	}
+
+	for _, collection := range managedCollections(definitions) {
+		for _, idx := range actual[collection] {
+			if idx.Name == idIndexName || expectedNames[collection+"."+idx.Name] {
+				continue
+			}
+			found := idx.Spec
+			drifts = append(drifts, IndexDrift{
+				Collection: collection, Index: idx.Name, Kind: IndexOrphaned, Actual: &found,
+			})
+		}
+	}
+
+	slices.SortFunc(drifts, func(a, b IndexDrift) int {
+		if c := strings.Compare(a.Collection, b.Collection); c != 0 {
+			return c
+		}
+		return strings.Compare(a.Index, b.Index)
+	})
+	return drifts, nil
+}
+
+// Spec returns the comparable shape of the definition, in the form MongoDB
+// lists it (text fields are stored as _fts/_ftsx keys plus weights).
+func (d IndexDefinition) Spec() (IndexSpec, error) {
+	var opts options.IndexOptions
+	if d.Options != nil {
+		for _, apply := range d.Options.List() {
+			if err := apply(&opts); err != nil {
+				return IndexSpec{}, fmt.Errorf("index %s: %w", d.Name(), err)
+			}
+		}
+	}
+
+	keys := make(bson.D, 0, len(d.Keys))
+	var textFields []string
+	for _, key := range d.Keys {
+		if key.Value != "text" {
+			keys = append(keys, key)
+			continue
+		}
+		if len(textFields) == 0 {
+			keys = append(keys, bson.E{Key: "_fts", Value: "text"}, bson.E{Key: "_ftsx", Value: 1})
+		}
+		textFields = append(textFields, key.Key)
+	}
+
+	rawKeys, err := bson.Marshal(keys)
+	if err != nil {
+		return IndexSpec{}, fmt.Errorf("index %s keys: %w", d.Name(), err)
+	}
+	spec := IndexSpec{Keys: canonicalDocument(rawKeys, false)}
+	if opts.Unique != nil {
+		spec.Unique = *opts.Unique
+	}
+	if opts.Sparse != nil {
+		spec.Sparse = *opts.Sparse
+	}
+	if opts.PartialFilterExpression != nil {
+		rawFilter, marshalErr := bson.Marshal(opts.PartialFilterExpression)
+		if marshalErr != nil {
+			return IndexSpec{}, fmt.Errorf("index %s partial filter: %w", d.Name(), marshalErr)
+		}
+		spec.PartialFilter = canonicalDocument(rawFilter, true)
+	}
+	if len(textFields) > 0 {
+		spec.DefaultLanguage = defaultTextLanguage
+		if opts.DefaultLanguage != nil {
+			spec.DefaultLanguage = *opts.DefaultLanguage
+		}
+		slices.Sort(textFields)
+		spec.Weights = strings.Join(textFields, ",")
+	}
+	return spec, nil
+}
+
+func (idx listedIndex) spec() IndexSpec {
+	spec := IndexSpec{
+		Keys:            canonicalDocument(idx.Key, false),
+		Unique:          idx.Unique,
+		Sparse:          idx.Sparse,
+		DefaultLanguage: idx.DefaultLanguage,
+	}
+	if len(idx.PartialFilterExpression) > 0 {
+		spec.PartialFilter = canonicalDocument(idx.PartialFilterExpression, true)
+	}
+	if len(idx.Weights) > 0 {
+		// Only the weighted fields are compared; the application never sets weights
+		elements, _ := idx.Weights.Elements()
+		fields := make([]string, 0, len(elements))
+		for _, element := range elements {
+			fields = append(fields, element.Key())
+		}
+		slices.Sort(fields)
+		spec.Weights = strings.Join(fields, ",")
+	}
+	return spec
+}
+
+// canonicalDocument renders a document so that equal documents render equally
+// whatever numeric types they use. Keys are sorted when their order does not matter.
+func canonicalDocument(raw bson.Raw, sortKeys bool) string {
+	elements, err := raw.Elements()
+	if err != nil {
+		return raw.String()
+	}
+
+	parts := make([]string, 0, len(elements))
+	for _, element := range elements {
+		parts = append(parts, element.Key()+":"+canonicalValue(element.Value(), sortKeys))
+	}
+	if sortKeys {
+		slices.Sort(parts)
+	}
+	return "{" + strings.Join(parts, ",") + "}"
+}
+
+func canonicalValue(value bson.RawValue, sortKeys bool) string {
+	switch value.Type {
+	case bson.TypeInt32, bson.TypeInt64:
+		return strconv.FormatInt(value.AsInt64(), 10)
+	case bson.TypeDouble:
+		return strconv.FormatFloat(value.Double(), 'g', -1, 64)
+	case bson.TypeEmbeddedDocument:
+		return canonicalDocument(value.Document(), sortKeys)
+	default:
+		return value.String()
+	}
+}
+
+// managedCollections returns the collections the definitions cover, sorted.
+func managedCollections(definitions []IndexDefinition) []string {
+	collections := make([]string, 0, len(definitions))
+	for _, def := range definitions {
+		collections = append(collections, def.Collection)
+	}
+	slices.Sort(collections)
+	return slices.Compact(collections)
+}
+
+func compareDriftKinds(a, b IndexDriftKind) int {
+	order := []IndexDriftKind{IndexOrphaned, IndexChanged, IndexMissing}
+	return slices.Index(order, a) - slices.Index(order, b)
+}
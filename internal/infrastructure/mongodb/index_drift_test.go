@@ -0,0 +1,143 @@
+package mongodb_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+
+	"github.com/lllypuk/flowra/internal/infrastructure/mongodb"
+	"github.com/lllypuk/flowra/tests/testutil"
+)
+
+func TestIndexDefinition_Spec(t *testing.T) {
+	t.Parallel()
+
+	spec, err := mongodb.IndexDefinition{
+		Collection: mongodb.CollectionMembers,
+		Keys:       bson.D{{Key: "workspace_id", Value: 1}, {Key: "joined_at", Value: -1}},
+		Options: options.Index().
+			SetName("idx_members_pending").
+			SetUnique(true).
+			SetPartialFilterExpression(bson.M{"status": "pending"}),
+	}.Spec()
+	require.NoError(t, err)
+	assert.Equal(t, mongodb.IndexSpec{
+		Keys:          "{workspace_id:1,joined_at:-1}",
+		Unique:        true,
+		PartialFilter: `{status:"pending"}`,
+	}, spec)
+
+	text, err := mongodb.IndexDefinition{
+		Collection: mongodb.CollectionMessages,
+		Keys:       bson.D{{Key: "content", Value: "text"}},
+		Options:    options.Index().SetName("idx_messages_content_text").SetDefaultLanguage("russian"),
+	}.Spec()
+	require.NoError(t, err)
+	assert.Equal(t, mongodb.IndexSpec{
+		Keys:            `{_fts:"text",_ftsx:1}`,
+		DefaultLanguage: "russian",
+		Weights:         "content",
+	}, text)
+}
+
+func TestDiffIndexes(t *testing.T) {
+	t.Parallel()
+
+	definitions := []mongodb.IndexDefinition{
+		{
+			Collection: mongodb.CollectionStars,
+			Keys:       bson.D{{Key: "user_id", Value: 1}, {Key: "chat_id", Value: 1}},
+			Options:    options.Index().SetName("idx_stars_user_chat").SetUnique(true),
+		},
+		{
+			Collection: mongodb.CollectionStars,
+			Keys:       bson.D{{Key: "user_id", Value: 1}, {Key: "created_at", Value: -1}},
+			Options:    options.Index().SetName("idx_stars_user_created"),
+		},
+		{
+			Collection: mongodb.CollectionInboxItems,
+			Keys:       bson.D{{Key: "item_id", Value: 1}},
+			Options:    options.Index().SetUnique(true),
+		},
+	}
+
+	t.Run("no drift when indexes match", func(t *testing.T) {
+		t.Parallel()
+
+		drifts, err := mongodb.DiffIndexes(definitions, map[string][]mongodb.ActualIndex{
+			mongodb.CollectionStars: {
+				{Name: "_id_", Spec: mongodb.IndexSpec{Keys: "{_id:1}"}},
+				{Name: "idx_stars_user_chat", Spec: mongodb.IndexSpec{Keys: "{user_id:1,chat_id:1}", Unique: true}},
+				{Name: "idx_stars_user_created", Spec: mongodb.IndexSpec{Keys: "{user_id:1,created_at:-1}"}},
+			},
+			mongodb.CollectionInboxItems: {
+				{Name: "item_id_1", Spec: mongodb.IndexSpec{Keys: "{item_id:1}", Unique: true}},
+			},
+		})
+
+		require.NoError(t, err)
+		assert.Empty(t, drifts)
+	})
+
+	t.Run("reports missing, changed and orphaned indexes", func(t *testing.T) {
+		t.Parallel()
+
+		drifts, err := mongodb.DiffIndexes(definitions, map[string][]mongodb.ActualIndex{
+			mongodb.CollectionStars: {
+				{Name: "_id_", Spec: mongodb.IndexSpec{Keys: "{_id:1}"}},
+				{Name: "idx_stars_user_chat", Spec: mongodb.IndexSpec{Keys: "{user_id:1,chat_id:1}"}},
+				{Name: "idx_stars_legacy", Spec: mongodb.IndexSpec{Keys: "{chat_id:1}"}},
+			},
+		})
+
+		require.NoError(t, err)
+		require.Len(t, drifts, 4)
+		assert.Equal(t, mongodb.CollectionInboxItems, drifts[0].Collection)
+		assert.Equal(t, "item_id_1", drifts[0].Index)
+		assert.Equal(t, mongodb.IndexMissing, drifts[0].Kind)
+
+		assert.Equal(t, "idx_stars_legacy", drifts[1].Index)
+		assert.Equal(t, mongodb.IndexOrphaned, drifts[1].Kind)
+		assert.Nil(t, drifts[1].Expected)
+
+		assert.Equal(t, "idx_stars_user_chat", drifts[2].Index)
+		assert.Equal(t, mongodb.IndexChanged, drifts[2].Kind)
+		assert.True(t, drifts[2].Expected.Unique)
+		assert.False(t, drifts[2].Actual.Unique)
+
+		assert.Equal(t, "idx_stars_user_created", drifts[3].Index)
+		assert.Equal(t, mongodb.IndexMissing, drifts[3].Kind)
+	})
+}
+
+func TestIndexVerifier_RepairsDrift(t *testing.T) {
+	t.Parallel()
+
+	db := testutil.SetupTestMongoDB(t)
+	ctx := context.Background()
+	verifier := mongodb.NewIndexVerifier(db, mongodb.GetStarIndexes())
+
+	coll := db.Collection(mongodb.CollectionStars)
+	_, err := coll.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bson.D{{Key: "chat_id", Value: 1}},
+		Options: options.Index().SetName("idx_stars_legacy"),
+	})
+	require.NoError(t, err)
+
+	drifts, err := verifier.Verify(ctx)
+	require.NoError(t, err)
+	require.Len(t, drifts, len(mongodb.GetStarIndexes())+1)
+
+	repaired, err := verifier.Repair(ctx, true)
+	require.NoError(t, err)
+	assert.Len(t, repaired, len(drifts))
+
+	drifts, err = verifier.Verify(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, drifts)
+}
@@ -4,6 +4,7 @@ package mongodb
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"go.mongodb.org/mongo-driver/v2/bson"
 	"go.mongodb.org/mongo-driver/v2/mongo"
@@ -12,19 +13,40 @@ import (
 
 // Collection names as constants for consistency.
 const (
-	CollectionEvents        = "events"
-	CollectionUsers         = "users"
-	CollectionWorkspaces    = "workspaces"
-	CollectionMembers       = "workspace_members"
-	CollectionChatReadModel = "chats_read_model"
-	CollectionTaskReadModel = "tasks_read_model"
-	CollectionMessages      = "messages"
-	CollectionNotifications = "notifications"
-	CollectionOutbox        = "outbox"
-	CollectionRepairQueue   = "repair_queue"
-	CollectionFileMetadata  = "file_metadata"
+	CollectionEvents          = "events"
+	CollectionUsers           = "users"
+	CollectionWorkspaces      = "workspaces"
+	CollectionMembers         = "workspace_members"
+	CollectionChatReadModel   = "chats_read_model"
+	CollectionTaskReadModel   = "tasks_read_model"
+	CollectionMessages        = "messages"
+	CollectionNotifications   = "notifications"
+	CollectionOutbox          = "outbox"
+	CollectionRepairQueue     = "repair_queue"
+	CollectionFileMetadata    = "file_metadata"
+	CollectionReportSnapshots = "report_snapshots"
+	CollectionAnnouncements   = "announcements"
+	CollectionDismissals      = "announcement_dismissals"
+	CollectionTaskLinks       = "task_links"
+	CollectionSLABreaches     = "sla_breaches"
+	CollectionCalendarTokens  = "calendar_feed_tokens"
+	CollectionEventRoutes     = "event_routes"
+	CollectionEventArchive    = "events_archive"
+	CollectionBackupJobs      = "backup_jobs"
+	CollectionStorageUsage    = "workspace_storage_usage"
+	CollectionChatExportJobs  = "chat_export_jobs"
+	CollectionNotifPrefs      = "notification_preferences"
+	CollectionDeferredEmails  = "deferred_emails"
+	CollectionStars           = "stars"
+	CollectionInboxItems      = "inbox_items"
 )
 
+// EventPartitionCollection returns the collection holding one partition of a
+// partitioned event store.
+func EventPartitionCollection(partition int) string {
+	return fmt.Sprintf("%s_%d", CollectionEvents, partition)
+}
+
 // IndexDefinition describes a MongoDB index to be created.
 type IndexDefinition struct {
 	Collection string
@@ -47,21 +69,31 @@ func CreateAllIndexes(ctx context.Context, db *mongo.Database) error {
 		_, err := coll.Indexes().CreateOne(ctx, model)
 		if err != nil {
 			return fmt.Errorf("failed to create index %s on collection %s: %w",
-				getIndexName(idx.Options), idx.Collection, err)
+				idx.Name(), idx.Collection, err)
 		}
 	}
 
 	return nil
 }
 
-// getIndexName extracts the index name from options for error messages.
-func getIndexName(opts *options.IndexOptionsBuilder) string {
-	if opts == nil {
-		return "<unnamed>"
+// Name returns the index name: the name set in the options, or the name MongoDB
+// derives from the keys (e.g. "user_id_1_created_at_-1").
+func (d IndexDefinition) Name() string {
+	if d.Options != nil {
+		var opts options.IndexOptions
+		for _, apply := range d.Options.List() {
+			_ = apply(&opts)
+		}
+		if opts.Name != nil && *opts.Name != "" {
+			return *opts.Name
+		}
+	}
+
+	parts := make([]string, 0, len(d.Keys))
+	for _, key := range d.Keys {
+		parts = append(parts, fmt.Sprintf("%s_%v", key.Key, key.Value))
 	}
-	// Options builder doesn't expose the name directly,
-	// so we return a placeholder
-	return "<index>"
+	return strings.Join(parts, "_")
 }
 
 // GetAllIndexDefinitions returns all index definitions for all collections.
@@ -79,6 +111,19 @@ func GetAllIndexDefinitions() []IndexDefinition {
 	indexes = append(indexes, GetOutboxIndexes()...)
 	indexes = append(indexes, GetRepairQueueIndexes()...)
 	indexes = append(indexes, GetFileMetadataIndexes()...)
+	indexes = append(indexes, GetReportSnapshotIndexes()...)
+	indexes = append(indexes, GetAnnouncementIndexes()...)
+	indexes = append(indexes, GetTaskLinkIndexes()...)
+	indexes = append(indexes, GetSLABreachIndexes()...)
+	indexes = append(indexes, GetCalendarTokenIndexes()...)
+	indexes = append(indexes, GetEventArchiveIndexes()...)
+	indexes = append(indexes, GetBackupJobIndexes()...)
+	indexes = append(indexes, GetStorageUsageIndexes()...)
+	indexes = append(indexes, GetChatExportJobIndexes()...)
+	indexes = append(indexes, GetNotificationPreferenceIndexes()...)
+	indexes = append(indexes, GetDeferredEmailIndexes()...)
+	indexes = append(indexes, GetStarIndexes()...)
+	indexes = append(indexes, GetInboxItemIndexes()...)
 
 	return indexes
 }
@@ -107,6 +152,37 @@ func GetEventIndexes() []IndexDefinition {
 	}
 }
 
+// GetEventPartitionIndexes returns index definitions for a partitioned event store:
+// the events indexes on every partition plus the route lookup by workspace.
+func GetEventPartitionIndexes(partitions int) []IndexDefinition {
+	indexes := make([]IndexDefinition, 0, partitions*len(GetEventIndexes())+1)
+	for partition := range partitions {
+		for _, idx := range GetEventIndexes() {
+			idx.Collection = EventPartitionCollection(partition)
+			indexes = append(indexes, idx)
+		}
+	}
+	return append(indexes, IndexDefinition{
+		// Aggregates of a workspace, for moving or exporting a workspace
+		Collection: CollectionEventRoutes,
+		Keys:       bson.D{{Key: "workspace_id", Value: 1}},
+		Options:    options.Index().SetName("idx_event_routes_workspace"),
+	})
+}
+
+// CreateEventPartitionIndexes creates the indexes of a partitioned event store.
+// Like CreateAllIndexes it is idempotent.
+func CreateEventPartitionIndexes(ctx context.Context, db *mongo.Database, partitions int) error {
+	for _, idx := range GetEventPartitionIndexes(partitions) {
+		model := mongo.IndexModel{Keys: idx.Keys, Options: idx.Options}
+		if _, err := db.Collection(idx.Collection).Indexes().CreateOne(ctx, model); err != nil {
+			return fmt.Errorf("failed to create index %s on collection %s: %w",
+				idx.Name(), idx.Collection, err)
+		}
+	}
+	return nil
+}
+
 // GetUserIndexes returns index definitions for the users collection.
 func GetUserIndexes() []IndexDefinition {
 	return []IndexDefinition{
@@ -182,6 +258,14 @@ func GetWorkspaceIndexes() []IndexDefinition {
 			Keys:       bson.D{{Key: "invites.token", Value: 1}},
 			Options:    options.Index().SetName("idx_workspaces_invite_token"),
 		},
+		{
+			// Index for the discovery directory, sorted by name
+			Collection: CollectionWorkspaces,
+			Keys:       bson.D{{Key: "discoverable", Value: 1}, {Key: "name", Value: 1}},
+			Options: options.Index().
+				SetName("idx_workspaces_discoverable").
+				SetPartialFilterExpression(bson.M{"discoverable": true}),
+		},
 	}
 }
 
@@ -206,6 +290,14 @@ func GetMemberIndexes() []IndexDefinition {
 			Keys:       bson.D{{Key: "user_id", Value: 1}},
 			Options:    options.Index().SetName("idx_members_user"),
 		},
+		{
+			// Index for the admin list of memberships waiting for approval
+			Collection: CollectionMembers,
+			Keys:       bson.D{{Key: "workspace_id", Value: 1}, {Key: "joined_at", Value: 1}},
+			Options: options.Index().
+				SetName("idx_members_pending").
+				SetPartialFilterExpression(bson.M{"status": "pending"}),
+		},
 	}
 }
 
@@ -252,6 +344,30 @@ func GetChatReadModelIndexes() []IndexDefinition {
 			Keys:       bson.D{{Key: "participants", Value: 1}},
 			Options:    options.Index().SetName("idx_chats_participants"),
 		},
+		{
+			// Index for workspace chat lists ordered by activity
+			Collection: CollectionChatReadModel,
+			Keys:       bson.D{{Key: "workspace_id", Value: 1}, {Key: "last_activity_at", Value: -1}},
+			Options:    options.Index().SetName("idx_chats_workspace_activity"),
+		},
+		{
+			// Index for a participant's chat list ordered by activity
+			Collection: CollectionChatReadModel,
+			Keys:       bson.D{{Key: "participants", Value: 1}, {Key: "last_activity_at", Value: -1}},
+			Options:    options.Index().SetName("idx_chats_participants_activity"),
+		},
+		{
+			// Sparse index for keeping the last message preview in sync with edits
+			Collection: CollectionChatReadModel,
+			Keys:       bson.D{{Key: "last_message.message_id", Value: 1}},
+			Options:    options.Index().SetSparse(true).SetName("idx_chats_last_message"),
+		},
+		{
+			// Text index for workspace search by chat and task title
+			Collection: CollectionChatReadModel,
+			Keys:       bson.D{{Key: "title", Value: "text"}},
+			Options:    options.Index().SetName("idx_chats_title_text").SetDefaultLanguage("russian"),
+		},
 		{
 			// Index for finding chats by creator
 			Collection: CollectionChatReadModel,
@@ -341,6 +457,22 @@ func GetTaskReadModelIndexes() []IndexDefinition {
 			Keys:       bson.D{{Key: "assigned_to", Value: 1}, {Key: "status", Value: 1}, {Key: "due_date", Value: 1}},
 			Options:    options.Index().SetName("idx_tasks_dashboard"),
 		},
+		{
+			// Bugs with an SLA clock per workspace, scanned by the SLA monitor
+			Collection: CollectionTaskReadModel,
+			Keys:       bson.D{{Key: "workspace_id", Value: 1}, {Key: "sla.resolved_at", Value: 1}},
+			Options: options.Index().
+				SetPartialFilterExpression(bson.M{"sla.opened_at": bson.M{"$exists": true}}).
+				SetName("idx_tasks_workspace_sla"),
+		},
+		{
+			// Children of an epic; the projector writes null for unlinked tasks
+			Collection: CollectionTaskReadModel,
+			Keys:       bson.D{{Key: "epic_id", Value: 1}, {Key: "created_at", Value: -1}},
+			Options: options.Index().
+				SetPartialFilterExpression(bson.M{"epic_id": bson.M{"$type": "string"}}).
+				SetName("idx_tasks_epic"),
+		},
 	}
 }
 
@@ -382,6 +514,22 @@ func GetMessageIndexes() []IndexDefinition {
 			},
 			Options: options.Index().SetName("idx_messages_chat_active"),
 		},
+		{
+			// Partial index for the purge of deleted messages after the retention window
+			Collection: CollectionMessages,
+			Keys:       bson.D{{Key: "deleted_at", Value: 1}},
+			Options: options.Index().
+				SetPartialFilterExpression(bson.M{"is_deleted": true}).
+				SetName("idx_messages_deleted_purge"),
+		},
+		{
+			// Partial index for the worker generating attachment previews
+			Collection: CollectionMessages,
+			Keys:       bson.D{{Key: "attachments.preview_status", Value: 1}},
+			Options: options.Index().
+				SetPartialFilterExpression(bson.M{"attachments.preview_status": "pending"}).
+				SetName("idx_messages_preview_pending"),
+		},
 		{
 			// Text index for full-text search
 			Collection: CollectionMessages,
@@ -467,6 +615,12 @@ func GetOutboxIndexes() []IndexDefinition {
 			Keys:       bson.D{{Key: "aggregate_id", Value: 1}},
 			Options:    options.Index().SetName("idx_outbox_aggregate"),
 		},
+		{
+			// Index for listing quarantined entries (most entries never have the field)
+			Collection: CollectionOutbox,
+			Keys:       bson.D{{Key: "quarantined_at", Value: -1}},
+			Options:    options.Index().SetName("idx_outbox_quarantine").SetSparse(true),
+		},
 	}
 }
 
@@ -518,6 +672,207 @@ func GetFileMetadataIndexes() []IndexDefinition {
 	}
 }
 
+// GetReportSnapshotIndexes returns index definitions for the report_snapshots collection.
+func GetReportSnapshotIndexes() []IndexDefinition {
+	return []IndexDefinition{
+		{
+			// One cached snapshot per workspace
+			Collection: CollectionReportSnapshots,
+			Keys:       bson.D{{Key: "workspace_id", Value: 1}},
+			Options:    options.Index().SetUnique(true).SetName("idx_report_snapshots_workspace_id_unique"),
+		},
+	}
+}
+
+// GetAnnouncementIndexes returns index definitions for the announcements and
+// announcement_dismissals collections.
+func GetAnnouncementIndexes() []IndexDefinition {
+	return []IndexDefinition{
+		{
+			// Unique index for announcement ID lookup
+			Collection: CollectionAnnouncements,
+			Keys:       bson.D{{Key: "announcement_id", Value: 1}},
+			Options:    options.Index().SetUnique(true).SetName("idx_announcements_announcement_id_unique"),
+		},
+		{
+			// Active banners: starts_at <= now < ends_at
+			Collection: CollectionAnnouncements,
+			Keys:       bson.D{{Key: "ends_at", Value: 1}, {Key: "starts_at", Value: -1}},
+			Options:    options.Index().SetName("idx_announcements_active"),
+		},
+		{
+			// One dismissal per user and announcement
+			Collection: CollectionDismissals,
+			Keys:       bson.D{{Key: "user_id", Value: 1}, {Key: "announcement_id", Value: 1}},
+			Options:    options.Index().SetUnique(true).SetName("idx_announcement_dismissals_user_announcement_unique"),
+		},
+	}
+}
+
+// GetTaskLinkIndexes returns index definitions for the task_links collection.
+func GetTaskLinkIndexes() []IndexDefinition {
+	return []IndexDefinition{
+		{
+			// One link document per message
+			Collection: CollectionTaskLinks,
+			Keys:       bson.D{{Key: "message_id", Value: 1}},
+			Options:    options.Index().SetUnique(true).SetName("idx_task_links_message_id_unique"),
+		},
+		{
+			// Backlinks of a task, newest first
+			Collection: CollectionTaskLinks,
+			Keys:       bson.D{{Key: "task_ids", Value: 1}, {Key: "created_at", Value: -1}},
+			Options:    options.Index().SetName("idx_task_links_task_created"),
+		},
+	}
+}
+
+// GetSLABreachIndexes returns index definitions for the sla_breaches collection.
+func GetSLABreachIndexes() []IndexDefinition {
+	return []IndexDefinition{
+		{
+			// One breach per clock of a bug; makes breach notifications idempotent
+			Collection: CollectionSLABreaches,
+			Keys:       bson.D{{Key: "task_id", Value: 1}, {Key: "kind", Value: 1}, {Key: "opened_at", Value: 1}},
+			Options:    options.Index().SetUnique(true).SetName("idx_sla_breaches_clock_unique"),
+		},
+	}
+}
+
+// GetCalendarTokenIndexes returns index definitions for the calendar_feed_tokens collection.
+func GetCalendarTokenIndexes() []IndexDefinition {
+	return []IndexDefinition{
+		{
+			// At most one feed token per user
+			Collection: CollectionCalendarTokens,
+			Keys:       bson.D{{Key: "user_id", Value: 1}},
+			Options:    options.Index().SetUnique(true).SetName("idx_calendar_tokens_user_unique"),
+		},
+		{
+			// Feed lookup by the hash of the secret token
+			Collection: CollectionCalendarTokens,
+			Keys:       bson.D{{Key: "token_hash", Value: 1}},
+			Options:    options.Index().SetUnique(true).SetName("idx_calendar_tokens_hash_unique"),
+		},
+	}
+}
+
+// GetEventArchiveIndexes returns index definitions for the events_archive collection,
+// which keeps the original streams of compacted aggregates.
+func GetEventArchiveIndexes() []IndexDefinition {
+	return []IndexDefinition{
+		{
+			// Archived streams of an aggregate, one per compaction
+			Collection: CollectionEventArchive,
+			Keys: bson.D{
+				{Key: "aggregate_id", Value: 1},
+				{Key: "compaction_id", Value: 1},
+				{Key: "version", Value: 1},
+			},
+			Options: options.Index().SetName("idx_events_archive_aggregate"),
+		},
+	}
+}
+
+// GetBackupJobIndexes returns index definitions for the backup_jobs collection.
+func GetBackupJobIndexes() []IndexDefinition {
+	return []IndexDefinition{
+		{
+			// Workers claim the oldest pending job; admins list jobs newest first
+			Collection: CollectionBackupJobs,
+			Keys:       bson.D{{Key: "status", Value: 1}, {Key: "created_at", Value: 1}},
+			Options:    options.Index().SetName("idx_backup_jobs_status_created"),
+		},
+	}
+}
+
+// GetStorageUsageIndexes returns index definitions for the workspace_storage_usage collection.
+func GetStorageUsageIndexes() []IndexDefinition {
+	return []IndexDefinition{
+		{
+			// One usage counter per workspace
+			Collection: CollectionStorageUsage,
+			Keys:       bson.D{{Key: "workspace_id", Value: 1}},
+			Options:    options.Index().SetUnique(true).SetName("idx_storage_usage_workspace_unique"),
+		},
+	}
+}
+
+// GetChatExportJobIndexes returns index definitions for the chat_export_jobs collection.
+func GetChatExportJobIndexes() []IndexDefinition {
+	return []IndexDefinition{
+		{
+			// Workers claim the oldest pending job
+			Collection: CollectionChatExportJobs,
+			Keys:       bson.D{{Key: "status", Value: 1}, {Key: "created_at", Value: 1}},
+			Options:    options.Index().SetName("idx_chat_export_jobs_status_created"),
+		},
+	}
+}
+
+// GetNotificationPreferenceIndexes returns index definitions for the notification_preferences collection.
+func GetNotificationPreferenceIndexes() []IndexDefinition {
+	return []IndexDefinition{
+		{
+			// One preferences document per user
+			Collection: CollectionNotifPrefs,
+			Keys:       bson.D{{Key: "user_id", Value: 1}},
+			Options:    options.Index().SetUnique(true).SetName("idx_notification_preferences_user_unique"),
+		},
+	}
+}
+
+// GetDeferredEmailIndexes returns index definitions for the deferred_emails collection.
+func GetDeferredEmailIndexes() []IndexDefinition {
+	return []IndexDefinition{
+		{
+			// The digest worker picks the emails whose do-not-disturb window has ended
+			Collection: CollectionDeferredEmails,
+			Keys:       bson.D{{Key: "deliver_at", Value: 1}},
+			Options:    options.Index().SetName("idx_deferred_emails_deliver_at"),
+		},
+	}
+}
+
+// GetStarIndexes returns index definitions for the stars collection.
+func GetStarIndexes() []IndexDefinition {
+	return []IndexDefinition{
+		{
+			// A user stars a chat at most once
+			Collection: CollectionStars,
+			Keys:       bson.D{{Key: "user_id", Value: 1}, {Key: "chat_id", Value: 1}},
+			Options:    options.Index().SetUnique(true).SetName("idx_stars_user_chat_unique"),
+		},
+		{
+			// Starred section and board filter list a user's stars per workspace
+			Collection: CollectionStars,
+			Keys: bson.D{
+				{Key: "user_id", Value: 1},
+				{Key: "workspace_id", Value: 1},
+				{Key: "created_at", Value: -1},
+			},
+			Options: options.Index().SetName("idx_stars_user_workspace_created"),
+		},
+	}
+}
+
+// GetInboxItemIndexes returns index definitions for the inbox_items collection.
+func GetInboxItemIndexes() []IndexDefinition {
+	return []IndexDefinition{
+		{
+			Collection: CollectionInboxItems,
+			Keys:       bson.D{{Key: "item_id", Value: 1}},
+			Options:    options.Index().SetUnique(true).SetName("idx_inbox_items_item_id_unique"),
+		},
+		{
+			// A user's inbox is listed newest first
+			Collection: CollectionInboxItems,
+			Keys:       bson.D{{Key: "user_id", Value: 1}, {Key: "created_at", Value: -1}},
+			Options:    options.Index().SetName("idx_inbox_items_user_created"),
+		},
+	}
+}
+
 // CreateCollectionIndexes creates indexes for a specific collection only.
 // Useful for targeted index creation or testing.
 func CreateCollectionIndexes(ctx context.Context, db *mongo.Database, collectionName string) error {
@@ -546,6 +901,32 @@ func CreateCollectionIndexes(ctx context.Context, db *mongo.Database, collection
 		indexes = GetRepairQueueIndexes()
 	case CollectionFileMetadata:
 		indexes = GetFileMetadataIndexes()
+	case CollectionReportSnapshots:
+		indexes = GetReportSnapshotIndexes()
+	case CollectionAnnouncements, CollectionDismissals:
+		indexes = GetAnnouncementIndexes()
+	case CollectionTaskLinks:
+		indexes = GetTaskLinkIndexes()
+	case CollectionSLABreaches:
+		indexes = GetSLABreachIndexes()
+	case CollectionCalendarTokens:
+		indexes = GetCalendarTokenIndexes()
+	case CollectionEventArchive:
+		indexes = GetEventArchiveIndexes()
+	case CollectionBackupJobs:
+		indexes = GetBackupJobIndexes()
+	case CollectionStorageUsage:
+		indexes = GetStorageUsageIndexes()
+	case CollectionChatExportJobs:
+		indexes = GetChatExportJobIndexes()
+	case CollectionNotifPrefs:
+		indexes = GetNotificationPreferenceIndexes()
+	case CollectionDeferredEmails:
+		indexes = GetDeferredEmailIndexes()
+	case CollectionStars:
+		indexes = GetStarIndexes()
+	case CollectionInboxItems:
+		indexes = GetInboxItemIndexes()
 	default:
 		return fmt.Errorf("unknown collection: %s", collectionName)
 	}
@@ -8,6 +8,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"go.mongodb.org/mongo-driver/v2/bson"
 	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
 
 	"github.com/lllypuk/flowra/internal/infrastructure/mongodb"
 	"github.com/lllypuk/flowra/tests/testutil"
@@ -84,6 +85,26 @@ func TestGetEventIndexes(t *testing.T) {
 	require.NotNil(t, aggTypeIdx, "aggregate type+time index should exist")
 }
 
+func TestGetEventPartitionIndexes(t *testing.T) {
+	t.Parallel()
+
+	indexes := mongodb.GetEventPartitionIndexes(2)
+	assert.Len(t, indexes, 2*len(mongodb.GetEventIndexes())+1)
+
+	collections := make(map[string]int)
+	for _, idx := range indexes {
+		collections[idx.Collection]++
+	}
+	assert.Equal(t, map[string]int{
+		"events_0":                    3,
+		"events_1":                    3,
+		mongodb.CollectionEventRoutes: 1,
+	}, collections)
+
+	// the unpartitioned definitions are left untouched
+	assert.Equal(t, mongodb.CollectionEvents, mongodb.GetEventIndexes()[0].Collection)
+}
+
 func TestGetUserIndexes(t *testing.T) {
 	t.Parallel()
 
@@ -114,7 +135,7 @@ func TestGetWorkspaceIndexes(t *testing.T) {
 
 	indexes := mongodb.GetWorkspaceIndexes()
 
-	assert.Len(t, indexes, 5)
+	assert.Len(t, indexes, 6)
 
 	// Check workspace_id unique index
 	wsIDIdx := findIndexByName(indexes, "idx_workspaces_id_unique")
@@ -130,7 +151,7 @@ func TestGetMemberIndexes(t *testing.T) {
 
 	indexes := mongodb.GetMemberIndexes()
 
-	assert.Len(t, indexes, 3)
+	assert.Len(t, indexes, 4)
 
 	// Check user+workspace unique compound index
 	compoundIdx := findIndexByName(indexes, "idx_members_user_workspace_unique")
@@ -149,7 +170,7 @@ func TestGetChatReadModelIndexes(t *testing.T) {
 
 	indexes := mongodb.GetChatReadModelIndexes()
 
-	assert.Len(t, indexes, 10)
+	assert.Len(t, indexes, 14)
 
 	// Check chat_id unique index
 	chatIDIdx := findIndexByName(indexes, "idx_chats_id_unique")
@@ -162,6 +183,10 @@ func TestGetChatReadModelIndexes(t *testing.T) {
 	// Check task filter compound index
 	filterIdx := findIndexByName(indexes, "idx_chats_task_filter")
 	require.NotNil(t, filterIdx, "task filter compound index should exist")
+
+	// Check title text index for workspace search
+	titleIdx := findIndexByName(indexes, "idx_chats_title_text")
+	require.NotNil(t, titleIdx, "title text index should exist")
 }
 
 func TestGetTaskReadModelIndexes(t *testing.T) {
@@ -169,7 +194,7 @@ func TestGetTaskReadModelIndexes(t *testing.T) {
 
 	indexes := mongodb.GetTaskReadModelIndexes()
 
-	assert.Len(t, indexes, 9)
+	assert.Len(t, indexes, 11)
 
 	// Check task_id unique index
 	taskIDIdx := findIndexByName(indexes, "idx_tasks_id_unique")
@@ -182,6 +207,10 @@ func TestGetTaskReadModelIndexes(t *testing.T) {
 	// Check dashboard compound index
 	dashboardIdx := findIndexByName(indexes, "idx_tasks_dashboard")
 	require.NotNil(t, dashboardIdx, "dashboard compound index should exist")
+
+	// Check epic children index
+	epicIdx := findIndexByName(indexes, "idx_tasks_epic")
+	require.NotNil(t, epicIdx, "epic children index should exist")
 }
 
 func TestGetMessageIndexes(t *testing.T) {
@@ -189,7 +218,7 @@ func TestGetMessageIndexes(t *testing.T) {
 
 	indexes := mongodb.GetMessageIndexes()
 
-	assert.Len(t, indexes, 7)
+	assert.Len(t, indexes, 9)
 
 	// Check message_id unique index
 	msgIDIdx := findIndexByName(indexes, "idx_messages_id_unique")
@@ -248,7 +277,20 @@ func TestGetAllIndexDefinitions(t *testing.T) {
 		len(mongodb.GetNotificationIndexes()) +
 		len(mongodb.GetOutboxIndexes()) +
 		len(mongodb.GetRepairQueueIndexes()) +
-		len(mongodb.GetFileMetadataIndexes())
+		len(mongodb.GetFileMetadataIndexes()) +
+		len(mongodb.GetReportSnapshotIndexes()) +
+		len(mongodb.GetAnnouncementIndexes()) +
+		len(mongodb.GetTaskLinkIndexes()) +
+		len(mongodb.GetSLABreachIndexes()) +
+		len(mongodb.GetCalendarTokenIndexes()) +
+		len(mongodb.GetEventArchiveIndexes()) +
+		len(mongodb.GetBackupJobIndexes()) +
+		len(mongodb.GetStorageUsageIndexes()) +
+		len(mongodb.GetChatExportJobIndexes()) +
+		len(mongodb.GetNotificationPreferenceIndexes()) +
+		len(mongodb.GetDeferredEmailIndexes()) +
+		len(mongodb.GetStarIndexes()) +
+		len(mongodb.GetInboxItemIndexes())
 
 	assert.Len(t, indexes, expectedTotal)
 
@@ -260,6 +302,30 @@ func TestGetAllIndexDefinitions(t *testing.T) {
 	}
 }
 
+func TestIndexDefinition_Name(t *testing.T) {
+	t.Parallel()
+
+	named := mongodb.IndexDefinition{
+		Collection: mongodb.CollectionEvents,
+		Keys:       bson.D{{Key: "aggregate_id", Value: 1}},
+		Options:    options.Index().SetUnique(true).SetName("idx_custom"),
+	}
+	assert.Equal(t, "idx_custom", named.Name())
+
+	unnamed := mongodb.IndexDefinition{
+		Collection: mongodb.CollectionEvents,
+		Keys:       bson.D{{Key: "user_id", Value: 1}, {Key: "created_at", Value: -1}},
+	}
+	assert.Equal(t, "user_id_1_created_at_-1", unnamed.Name())
+
+	names := make(map[string]bool)
+	for _, idx := range mongodb.GetAllIndexDefinitions() {
+		key := idx.Collection + "." + idx.Name()
+		assert.False(t, names[key], "duplicate index name %s", key)
+		names[key] = true
+	}
+}
+
 func TestCreateCollectionIndexes(t *testing.T) {
 	t.Parallel()
 
@@ -414,21 +480,27 @@ func findIndexByName(indexes []mongodb.IndexDefinition, name string) *mongodb.In
 		"idx_workspaces_name":            true,
 		"idx_workspaces_created_by":      true,
 		"idx_workspaces_invite_token":    true,
+		"idx_workspaces_discoverable":    true,
 		// Members
 		"idx_members_user_workspace_unique": true,
 		"idx_members_workspace":             true,
 		"idx_members_user":                  true,
+		"idx_members_pending":               true,
 		// Chats
-		"idx_chats_id_unique":           true,
-		"idx_chats_workspace_time":      true,
-		"idx_chats_workspace_type_time": true,
-		"idx_chats_type":                true,
-		"idx_chats_workspace_public":    true,
-		"idx_chats_participants":        true,
-		"idx_chats_created_by":          true,
-		"idx_chats_assignee":            true,
-		"idx_chats_status":              true,
-		"idx_chats_task_filter":         true,
+		"idx_chats_id_unique":             true,
+		"idx_chats_workspace_time":        true,
+		"idx_chats_workspace_type_time":   true,
+		"idx_chats_type":                  true,
+		"idx_chats_workspace_public":      true,
+		"idx_chats_participants":          true,
+		"idx_chats_workspace_activity":    true,
+		"idx_chats_participants_activity": true,
+		"idx_chats_last_message":          true,
+		"idx_chats_created_by":            true,
+		"idx_chats_assignee":              true,
+		"idx_chats_status":                true,
+		"idx_chats_task_filter":           true,
+		"idx_chats_title_text":            true,
 		// Tasks
 		"idx_tasks_id_unique":       true,
 		"idx_tasks_chat_unique":     true,
@@ -439,14 +511,18 @@ func findIndexByName(indexes []mongodb.IndexDefinition, name string) *mongodb.In
 		"idx_tasks_created_at":      true,
 		"idx_tasks_due_date":        true,
 		"idx_tasks_dashboard":       true,
+		"idx_tasks_workspace_sla":   true,
+		"idx_tasks_epic":            true,
 		// Messages
-		"idx_messages_id_unique":    true,
-		"idx_messages_chat_time":    true,
-		"idx_messages_thread":       true,
-		"idx_messages_author_time":  true,
-		"idx_messages_chat_active":  true,
-		"idx_messages_content_text": true,
-		"idx_messages_chat_author":  true,
+		"idx_messages_id_unique":       true,
+		"idx_messages_chat_time":       true,
+		"idx_messages_thread":          true,
+		"idx_messages_author_time":     true,
+		"idx_messages_chat_active":     true,
+		"idx_messages_content_text":    true,
+		"idx_messages_chat_author":     true,
+		"idx_messages_deleted_purge":   true,
+		"idx_messages_preview_pending": true,
 		// Notifications
 		"idx_notifications_id_unique":   true,
 		"idx_notifications_user_time":   true,
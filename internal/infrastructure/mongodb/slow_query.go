@@ -0,0 +1,194 @@
+package mongodb
+
+import (
+	"context"
+	"log/slog"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/event"
+)
+
+// maxShapeElements bounds the array elements rendered in a query shape.
+const maxShapeElements = 10
+
+// slowQueryFilterFields maps the commands whose duration depends on indexes to the
+// field holding their filter. Handshakes, cursors and administrative commands are
+// not tracked.
+//
+//nolint:gochecknoglobals // fixed lookup table
+var slowQueryFilterFields = map[string]string{
+	"find":          "filter",
+	"count":         "query",
+	"distinct":      "query",
+	"findAndModify": "query",
+	"aggregate":     "pipeline",
+	"update":        "updates",
+	"delete":        "deletes",
+}
+
+// SlowQueryCounter counts slow commands.
+// Declared on the consumer side per project guidelines.
+type SlowQueryCounter interface {
+	IncSlowQuery(collection, command string)
+}
+
+// SlowQueryMonitor logs MongoDB commands that take longer than a threshold with
+// their collection, the shape of their filter and their duration, and counts
+// them. Shapes keep field names and operators but replace every value with "?",
+// so user data never reaches the logs.
+type SlowQueryMonitor struct {
+	threshold time.Duration
+	logger    *slog.Logger
+	counter   SlowQueryCounter
+
+	// pending holds the tracked commands in flight by request ID.
+	pending sync.Map
+}
+
+// trackedCommand is a command in flight.
+type trackedCommand struct {
+	collection string
+	filter     bson.RawValue
+}
+
+// NewSlowQueryMonitor creates a monitor for commands slower than threshold.
+// counter may be nil.
+func NewSlowQueryMonitor(threshold time.Duration, logger *slog.Logger, counter SlowQueryCounter) *SlowQueryMonitor {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &SlowQueryMonitor{
+		threshold: threshold,
+		logger:    logger,
+		counter:   counter,
+	}
+}
+
+// CommandMonitor returns a driver command monitor that feeds the slow query log.
+func (m *SlowQueryMonitor) CommandMonitor() *event.CommandMonitor {
+	return &event.CommandMonitor{
+		Started: m.started,
+		Succeeded: func(ctx context.Context, evt *event.CommandSucceededEvent) {
+			m.finished(ctx, evt.CommandFinishedEvent, nil)
+		},
+		Failed: func(ctx context.Context, evt *event.CommandFailedEvent) {
+			m.finished(ctx, evt.CommandFinishedEvent, evt.Failure)
+		},
+	}
+}
+
+func (m *SlowQueryMonitor) started(_ context.Context, evt *event.CommandStartedEvent) {
+	field, ok := slowQueryFilterFields[evt.CommandName]
+	if !ok {
+		return
+	}
+
+	cmd := trackedCommand{}
+	if collection, isString := evt.Command.Lookup(evt.CommandName).StringValueOK(); isString {
+		cmd.collection = collection
+	}
+	filter := evt.Command.Lookup(field)
+	if evt.CommandName == "update" || evt.CommandName == "delete" {
+		// the filter of the first statement stands for the batch
+		statements, isArray := filter.ArrayOK()
+		filter = bson.RawValue{}
+		if isArray {
+			if values, err := statements.Values(); err == nil && len(values) > 0 {
+				if statement, isDoc := values[0].DocumentOK(); isDoc {
+					filter = statement.Lookup("q")
+				}
+			}
+		}
+	}
+	// the driver reuses the command buffer once the command is sent
+	cmd.filter = bson.RawValue{Type: filter.Type, Value: slices.Clone(filter.Value)}
+
+	m.pending.Store(evt.RequestID, cmd)
+}
+
+func (m *SlowQueryMonitor) finished(ctx context.Context, evt event.CommandFinishedEvent, failure error) {
+	value, ok := m.pending.LoadAndDelete(evt.RequestID)
+	if !ok || evt.Duration < m.threshold {
+		return
+	}
+	cmd, _ := value.(trackedCommand)
+
+	if m.counter != nil {
+		m.counter.IncSlowQuery(cmd.collection, evt.CommandName)
+	}
+	attrs := []any{
+		slog.String("database", evt.DatabaseName),
+		slog.String("collection", cmd.collection),
+		slog.String("command", evt.CommandName),
+		slog.String("filter", QueryShape(cmd.filter)),
+		slog.Duration("duration", evt.Duration),
+		slog.Duration("threshold", m.threshold),
+	}
+	if failure != nil {
+		attrs = append(attrs, slog.String("error", failure.Error()))
+	}
+	m.logger.WarnContext(ctx, "slow MongoDB query", attrs...)
+}
+
+// QueryShape renders a filter or pipeline with its field names and operators but
+// every value replaced by "?", e.g. {chat_id: ?, created_at: {$lt: ?}}.
+func QueryShape(value bson.RawValue) string {
+	if value.Type == 0 {
+		return "{}"
+	}
+	var b strings.Builder
+	writeShape(&b, value)
+	return b.String()
+}
+
+func writeShape(b *strings.Builder, value bson.RawValue) {
+	switch value.Type {
+	case bson.TypeEmbeddedDocument:
+		elems, err := value.Document().Elements()
+		if err != nil {
+			b.WriteString("?")
+			return
+		}
+		b.WriteString("{")
+		for i, elem := range elems {
+			if i > 0 {
+				b.WriteString(", ")
+			}
+			b.WriteString(elem.Key())
+			b.WriteString(": ")
+			writeShape(b, elem.Value())
+		}
+		b.WriteString("}")
+	case bson.TypeArray:
+		values, err := value.Array().Values()
+		if err != nil || !containsStructure(values) {
+			// lists of plain values ($in, $all, ...) only matter by their presence
+			b.WriteString("[?]")
+			return
+		}
+		b.WriteString("[")
+		for i, v := range values {
+			if i == maxShapeElements {
+				b.WriteString(", …")
+				break
+			}
+			if i > 0 {
+				b.WriteString(", ")
+			}
+			writeShape(b, v)
+		}
+		b.WriteString("]")
+	default:
+		b.WriteString("?")
+	}
+}
+
+func containsStructure(values []bson.RawValue) bool {
+	return slices.ContainsFunc(values, func(v bson.RawValue) bool {
+		return v.Type == bson.TypeEmbeddedDocument || v.Type == bson.TypeArray
+	})
+}
@@ -0,0 +1,125 @@
+package mongodb_test
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/event"
+
+	"github.com/lllypuk/flowra/internal/infrastructure/mongodb"
+)
+
+type countingSlowQueries struct {
+	counts map[string]int
+}
+
+func (c *countingSlowQueries) IncSlowQuery(collection, command string) {
+	c.counts[collection+"/"+command]++
+}
+
+func rawValue(t *testing.T, value any) bson.RawValue {
+	t.Helper()
+	raw, err := bson.Marshal(bson.D{{Key: "v", Value: value}})
+	require.NoError(t, err)
+	return bson.Raw(raw).Lookup("v")
+}
+
+func TestQueryShape(t *testing.T) {
+	tests := []struct {
+		name  string
+		value any
+		want  string
+	}{
+		{
+			name: "filter values are hidden",
+			value: bson.D{
+				{Key: "chat_id", Value: "secret"},
+				{Key: "created_at", Value: bson.D{{Key: "$lt", Value: time.Now()}}},
+				{Key: "sent_by", Value: bson.D{{Key: "$in", Value: bson.A{"a", "b"}}}},
+			},
+			want: "{chat_id: ?, created_at: {$lt: ?}, sent_by: {$in: [?]}}",
+		},
+		{
+			name: "pipelines keep their stages",
+			value: bson.A{
+				bson.D{{Key: "$match", Value: bson.D{{Key: "workspace_id", Value: "w"}}}},
+				bson.D{{Key: "$sort", Value: bson.D{{Key: "created_at", Value: -1}}}},
+			},
+			want: "[{$match: {workspace_id: ?}}, {$sort: {created_at: ?}}]",
+		},
+		{
+			name: "$or branches",
+			value: bson.D{{Key: "$or", Value: bson.A{
+				bson.D{{Key: "title", Value: "x"}},
+				bson.D{{Key: "body", Value: "y"}},
+			}}},
+			want: "{$or: [{title: ?}, {body: ?}]}",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, mongodb.QueryShape(rawValue(t, tt.value)))
+		})
+	}
+
+	assert.Equal(t, "{}", mongodb.QueryShape(bson.RawValue{}))
+}
+
+func TestSlowQueryMonitor(t *testing.T) {
+	var logs bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&logs, nil))
+	counter := &countingSlowQueries{counts: map[string]int{}}
+	monitor := mongodb.NewSlowQueryMonitor(100*time.Millisecond, logger, counter).CommandMonitor()
+	ctx := context.Background()
+
+	start := func(requestID int64, name string, command bson.D) {
+		raw, err := bson.Marshal(command)
+		require.NoError(t, err)
+		monitor.Started(ctx, &event.CommandStartedEvent{
+			Command: raw, CommandName: name, DatabaseName: "flowra", RequestID: requestID,
+		})
+	}
+	finish := func(requestID int64, name string, duration time.Duration) {
+		monitor.Succeeded(ctx, &event.CommandSucceededEvent{CommandFinishedEvent: event.CommandFinishedEvent{
+			CommandName: name, DatabaseName: "flowra", RequestID: requestID, Duration: duration,
+		}})
+	}
+
+	start(1, "find", bson.D{
+		{Key: "find", Value: "messages"},
+		{Key: "filter", Value: bson.D{{Key: "content", Value: "top secret"}}},
+	})
+	finish(1, "find", 250*time.Millisecond)
+
+	start(2, "find", bson.D{{Key: "find", Value: "messages"}, {Key: "filter", Value: bson.D{}}})
+	finish(2, "find", time.Millisecond)
+
+	start(3, "update", bson.D{
+		{Key: "update", Value: "tasks_read_model"},
+		{Key: "updates", Value: bson.A{bson.D{
+			{Key: "q", Value: bson.D{{Key: "task_id", Value: "t1"}}},
+			{Key: "u", Value: bson.D{{Key: "$set", Value: bson.D{{Key: "title", Value: "x"}}}}},
+		}}},
+	})
+	monitor.Failed(ctx, &event.CommandFailedEvent{
+		CommandFinishedEvent: event.CommandFinishedEvent{CommandName: "update", RequestID: 3, Duration: time.Second},
+		Failure:              context.DeadlineExceeded,
+	})
+
+	start(4, "hello", bson.D{{Key: "hello", Value: 1}})
+	finish(4, "hello", time.Second)
+
+	assert.Equal(t, map[string]int{"messages/find": 1, "tasks_read_model/update": 1}, counter.counts)
+	out := logs.String()
+	assert.Contains(t, out, `filter="{content: ?}"`)
+	assert.Contains(t, out, `filter="{task_id: ?}"`)
+	assert.Contains(t, out, "error=\"context deadline exceeded\"")
+	assert.NotContains(t, out, "top secret")
+	assert.NotContains(t, out, "hello")
+}
@@ -30,6 +30,17 @@ type outboxDocument struct {
 	ProcessedAt   *time.Time `bson:"processed_at,omitempty"`
 	RetryCount    int        `bson:"retry_count"`
 	LastError     string     `bson:"last_error,omitempty"`
+	QuarantinedAt *time.Time `bson:"quarantined_at,omitempty"`
+}
+
+// pendingFilter matches entries waiting to be published: not processed and not quarantined.
+func pendingFilter() bson.M {
+	return bson.M{"processed_at": nil, "quarantined_at": nil}
+}
+
+// quarantinedFilter matches quarantined entries.
+func quarantinedFilter() bson.M {
+	return bson.M{"processed_at": nil, "quarantined_at": bson.M{"$ne": nil}}
 }
 
 // MongoOutbox implements appcore.Outbox using MongoDB.
@@ -134,7 +145,7 @@ func (o *MongoOutbox) Poll(ctx context.Context, batchSize int) ([]appcore.Outbox
 	}
 
 	// Find unprocessed entries, ordered by creation time
-	filter := bson.M{"processed_at": nil}
+	filter := pendingFilter()
 	opts := options.Find().
 		SetSort(bson.D{{Key: "created_at", Value: 1}}).
 		SetLimit(int64(batchSize))
@@ -184,7 +195,7 @@ func (o *MongoOutbox) MarkProcessed(ctx context.Context, entryID string) error {
 	}
 
 	if result.MatchedCount == 0 {
-		return fmt.Errorf("outbox entry not found: %s", entryID)
+		return fmt.Errorf("%w: %s", appcore.ErrOutboxEntryNotFound, entryID)
 	}
 
 	o.logger.DebugContext(ctx, "outbox entry marked as processed",
@@ -217,7 +228,7 @@ func (o *MongoOutbox) MarkFailed(ctx context.Context, entryID string, publishErr
 	}
 
 	if result.MatchedCount == 0 {
-		return fmt.Errorf("outbox entry not found: %s", entryID)
+		return fmt.Errorf("%w: %s", appcore.ErrOutboxEntryNotFound, entryID)
 	}
 
 	o.logger.DebugContext(ctx, "outbox entry marked as failed",
@@ -228,6 +239,115 @@ func (o *MongoOutbox) MarkFailed(ctx context.Context, entryID string, publishErr
 	return nil
 }
 
+// Quarantine takes an entry that exhausted its retries out of polling.
+func (o *MongoOutbox) Quarantine(ctx context.Context, entryID string, lastErr error) error {
+	set := bson.M{"quarantined_at": time.Now().UTC()}
+	if lastErr != nil {
+		set["last_error"] = lastErr.Error()
+	}
+
+	filter := bson.M{"_id": entryID, "processed_at": nil}
+	result, err := o.collection.UpdateOne(ctx, filter, bson.M{"$set": set})
+	if err != nil {
+		return fmt.Errorf("failed to quarantine outbox entry: %w", err)
+	}
+	if result.MatchedCount == 0 {
+		return fmt.Errorf("%w: %s", appcore.ErrOutboxEntryNotFound, entryID)
+	}
+
+	o.logger.WarnContext(ctx, "outbox entry quarantined",
+		slog.String("entry_id", entryID),
+	)
+
+	return nil
+}
+
+// CountQuarantined returns the number of quarantined entries.
+func (o *MongoOutbox) CountQuarantined(ctx context.Context) (int64, error) {
+	count, err := o.collection.CountDocuments(ctx, quarantinedFilter())
+	if err != nil {
+		return 0, fmt.Errorf("failed to count quarantined outbox entries: %w", err)
+	}
+	return count, nil
+}
+
+// ListQuarantined returns up to limit quarantined entries, most recently quarantined first.
+func (o *MongoOutbox) ListQuarantined(ctx context.Context, limit int) ([]appcore.OutboxEntry, error) {
+	if limit <= 0 {
+		limit = 100
+	}
+
+	opts := options.Find().
+		SetSort(bson.D{{Key: "quarantined_at", Value: -1}}).
+		SetLimit(int64(limit))
+
+	cursor, err := o.collection.Find(ctx, quarantinedFilter(), opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list quarantined outbox entries: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	entries := make([]appcore.OutboxEntry, 0)
+	for cursor.Next(ctx) {
+		var doc outboxDocument
+		if decodeErr := cursor.Decode(&doc); decodeErr != nil {
+			return nil, fmt.Errorf("failed to decode quarantined outbox entry: %w", decodeErr)
+		}
+		entries = append(entries, o.documentToEntry(&doc))
+	}
+
+	if cursorErr := cursor.Err(); cursorErr != nil {
+		return nil, fmt.Errorf("cursor error while listing quarantined entries: %w", cursorErr)
+	}
+
+	return entries, nil
+}
+
+// Requeue returns a quarantined entry to normal polling with a fresh retry budget.
+// The last error is kept for reference until the entry is published.
+func (o *MongoOutbox) Requeue(ctx context.Context, entryID string) error {
+	filter := quarantinedFilter()
+	filter["_id"] = entryID
+	update := bson.M{
+		"$unset": bson.M{"quarantined_at": ""},
+		"$set":   bson.M{"retry_count": 0},
+	}
+
+	result, err := o.collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return fmt.Errorf("failed to requeue outbox entry: %w", err)
+	}
+	if result.MatchedCount == 0 {
+		return fmt.Errorf("%w: %s", appcore.ErrOutboxEntryNotFound, entryID)
+	}
+
+	o.logger.InfoContext(ctx, "quarantined outbox entry requeued",
+		slog.String("entry_id", entryID),
+	)
+
+	return nil
+}
+
+// Discard deletes a quarantined entry; its event is never published.
+func (o *MongoOutbox) Discard(ctx context.Context, entryID string) error {
+	filter := quarantinedFilter()
+	filter["_id"] = entryID
+
+	result, err := o.collection.DeleteOne(ctx, filter)
+	if err != nil {
+		return fmt.Errorf("failed to discard outbox entry: %w", err)
+	}
+	if result.DeletedCount == 0 {
+		return fmt.Errorf("%w: %s", appcore.ErrOutboxEntryNotFound, entryID)
+	}
+
+	o.logger.WarnContext(ctx, "quarantined outbox entry discarded",
+		slog.String("entry_id", entryID),
+	)
+
+	return nil
+}
+
 // Cleanup removes old processed entries older than the specified duration.
 func (o *MongoOutbox) Cleanup(ctx context.Context, olderThan time.Duration) (int64, error) {
 	cutoff := time.Now().UTC().Add(-olderThan)
@@ -254,9 +374,47 @@ func (o *MongoOutbox) Cleanup(ctx context.Context, olderThan time.Duration) (int
 	return result.DeletedCount, nil
 }
 
-// Count returns the number of unprocessed entries.
+// PurgeProcessed deletes up to limit entries processed before the given time.
+// Unlike Cleanup it works in bounded batches, for the rate-limited retention worker.
+func (o *MongoOutbox) PurgeProcessed(ctx context.Context, processedBefore time.Time, limit int) (int64, error) {
+	filter := bson.M{
+		"processed_at": bson.M{"$ne": nil, "$lt": processedBefore},
+	}
+	opts := options.Find().
+		SetProjection(bson.M{"_id": 1}).
+		SetSort(bson.D{{Key: "processed_at", Value: 1}}).
+		SetLimit(int64(limit))
+
+	cursor, err := o.collection.Find(ctx, filter, opts)
+	if err != nil {
+		return 0, fmt.Errorf("failed to find processed outbox entries: %w", err)
+	}
+	var docs []struct {
+		ID string `bson:"_id"`
+	}
+	if err = cursor.All(ctx, &docs); err != nil {
+		return 0, fmt.Errorf("failed to decode processed outbox entries: %w", err)
+	}
+	if len(docs) == 0 {
+		return 0, nil
+	}
+
+	ids := make([]string, 0, len(docs))
+	for _, doc := range docs {
+		ids = append(ids, doc.ID)
+	}
+
+	result, err := o.collection.DeleteMany(ctx, bson.M{"_id": bson.M{"$in": ids}})
+	if err != nil {
+		return 0, fmt.Errorf("failed to purge outbox entries: %w", err)
+	}
+
+	return result.DeletedCount, nil
+}
+
+// Count returns the number of unprocessed, non-quarantined entries.
 func (o *MongoOutbox) Count(ctx context.Context) (int64, error) {
-	filter := bson.M{"processed_at": nil}
+	filter := pendingFilter()
 	count, err := o.collection.CountDocuments(ctx, filter)
 	if err != nil {
 		return 0, fmt.Errorf("failed to count outbox entries: %w", err)
@@ -266,7 +424,7 @@ func (o *MongoOutbox) Count(ctx context.Context) (int64, error) {
 
 // Stats returns statistics about the outbox (count and oldest entry timestamp).
 func (o *MongoOutbox) Stats(ctx context.Context) (int64, time.Time, error) {
-	filter := bson.M{"processed_at": nil}
+	filter := pendingFilter()
 
 	// Count unprocessed entries
 	count, err := o.collection.CountDocuments(ctx, filter)
@@ -325,6 +483,7 @@ func (o *MongoOutbox) documentToEntry(doc *outboxDocument) appcore.OutboxEntry {
 		ProcessedAt:   doc.ProcessedAt,
 		RetryCount:    doc.RetryCount,
 		LastError:     doc.LastError,
+		QuarantinedAt: doc.QuarantinedAt,
 	}
 }
 
@@ -247,6 +247,44 @@ func TestMongoOutbox_Cleanup(t *testing.T) {
 	assert.Equal(t, int64(0), count)
 }
 
+func TestMongoOutbox_PurgeProcessed(t *testing.T) {
+	collection := setupTestCollection(t)
+	if collection == nil {
+		return
+	}
+
+	ob := outbox.NewMongoOutbox(collection)
+	ctx := context.Background()
+
+	events := []event.DomainEvent{
+		newMockEvent("chat.created", "chat-1", "chat"),
+		newMockEvent("chat.updated", "chat-2", "chat"),
+		newMockEvent("task.created", "task-1", "task"),
+	}
+	require.NoError(t, ob.AddBatch(ctx, events))
+
+	entries, err := ob.Poll(ctx, 2)
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	for _, entry := range entries {
+		require.NoError(t, ob.MarkProcessed(ctx, entry.ID))
+	}
+
+	cutoff := time.Now().Add(time.Minute)
+	deleted, err := ob.PurgeProcessed(ctx, cutoff, 1)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), deleted, "a batch is capped by the limit")
+
+	deleted, err = ob.PurgeProcessed(ctx, cutoff, 10)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), deleted)
+
+	// The pending entry is kept
+	count, err := collection.CountDocuments(ctx, bson.M{})
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), count)
+}
+
 func TestMongoOutbox_Count(t *testing.T) {
 	collection := setupTestCollection(t)
 	if collection == nil {
@@ -328,5 +366,59 @@ func TestMongoOutbox_MarkProcessedNotFound(t *testing.T) {
 	assert.Error(t, err)
 }
 
+func TestMongoOutbox_Quarantine(t *testing.T) {
+	collection := setupTestCollection(t)
+	if collection == nil {
+		return
+	}
+
+	ob := outbox.NewMongoOutbox(collection)
+	ctx := context.Background()
+
+	require.NoError(t, ob.Add(ctx, newMockEvent("chat.created", "chat-1", "chat")))
+	require.NoError(t, ob.Add(ctx, newMockEvent("chat.created", "chat-2", "chat")))
+
+	entries, err := ob.Poll(ctx, 10)
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	poisonID := entries[0].ID
+
+	require.NoError(t, ob.MarkFailed(ctx, poisonID, assert.AnError))
+	require.NoError(t, ob.Quarantine(ctx, poisonID, assert.AnError))
+
+	// Quarantined entries are excluded from polling and backlog counts
+	entries, err = ob.Poll(ctx, 10)
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	assert.NotEqual(t, poisonID, entries[0].ID)
+
+	count, err := ob.Count(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), count)
+
+	quarantined, err := ob.ListQuarantined(ctx, 10)
+	require.NoError(t, err)
+	require.Len(t, quarantined, 1)
+	assert.Equal(t, poisonID, quarantined[0].ID)
+	assert.NotNil(t, quarantined[0].QuarantinedAt)
+	assert.Contains(t, quarantined[0].LastError, "assert.AnError")
+
+	// Requeue returns the entry to polling with a fresh retry budget
+	require.NoError(t, ob.Requeue(ctx, poisonID))
+	entries, err = ob.Poll(ctx, 10)
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+
+	depth, err := ob.CountQuarantined(ctx)
+	require.NoError(t, err)
+	assert.Zero(t, depth)
+
+	// Only quarantined entries can be requeued or discarded
+	require.ErrorIs(t, ob.Discard(ctx, poisonID), appcore.ErrOutboxEntryNotFound)
+	require.NoError(t, ob.Quarantine(ctx, poisonID, nil))
+	require.NoError(t, ob.Discard(ctx, poisonID))
+	require.ErrorIs(t, ob.Requeue(ctx, poisonID), appcore.ErrOutboxEntryNotFound)
+}
+
 // Ensure MongoOutbox implements appcore.Outbox.
 var _ appcore.Outbox = (*outbox.MongoOutbox)(nil)
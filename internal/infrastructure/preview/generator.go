@@ -0,0 +1,207 @@
+// Package preview generates the small JPEG previews shown for image and PDF
+// attachments in chat lists instead of the full-size files.
+package preview
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"image"
+	_ "image/gif" // register GIF decoding
+	"image/jpeg"
+	_ "image/png" // register PNG decoding
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+)
+
+// Generator constants.
+const (
+	// DefaultMaxSize is the default largest width or height of a preview in pixels.
+	DefaultMaxSize = 320
+
+	// maxSourcePixels guards against decompression bombs: larger images get no preview.
+	maxSourcePixels = 50_000_000
+
+	jpegQuality   = 80
+	maxChannel    = 0xffff
+	opaque        = 0xff
+	channelBits   = 8
+	channels      = 3 // red, green and blue
+	bytesPerPixel = 4 // RGBA
+	pdfMIMEType   = "application/pdf"
+)
+
+var (
+	// ErrUnsupported is returned for files no preview can be generated for.
+	ErrUnsupported = errors.New("preview: unsupported file type")
+
+	// ErrTooLarge is returned for images with too many pixels to decode safely.
+	ErrTooLarge = errors.New("preview: image too large")
+)
+
+// Generator renders previews. Images are scaled down to fit a square of the
+// maximum size; PDFs are rendered from their first page with pdftoppm.
+type Generator struct {
+	maxSize     int
+	pdfRenderer string
+}
+
+// Option configures a Generator.
+type Option func(*Generator)
+
+// WithMaxSize sets the largest width or height of previews in pixels.
+func WithMaxSize(size int) Option {
+	return func(g *Generator) {
+		if size > 0 {
+			g.maxSize = size
+		}
+	}
+}
+
+// WithPDFRenderer sets the pdftoppm binary rendering PDF pages. Empty disables PDF previews.
+func WithPDFRenderer(path string) Option {
+	return func(g *Generator) {
+		g.pdfRenderer = path
+	}
+}
+
+// NewGenerator creates a new preview generator. PDF previews are disabled unless
+// a renderer is configured.
+func NewGenerator(opts ...Option) *Generator {
+	g := &Generator{maxSize: DefaultMaxSize}
+	for _, opt := range opts {
+		opt(g)
+	}
+	return g
+}
+
+// PDFEnabled reports whether PDF previews are generated.
+func (g *Generator) PDFEnabled() bool {
+	return g.pdfRenderer != ""
+}
+
+// Generate writes a JPEG preview of the file at path to w and returns its width and height.
+// Returns ErrUnsupported for MIME types without previews.
+func (g *Generator) Generate(ctx context.Context, path, mimeType string, w io.Writer) (int, int, error) {
+	var (
+		src image.Image
+		err error
+	)
+	switch mimeType {
+	case "image/jpeg", "image/png", "image/gif":
+		src, err = decodeFile(path)
+	case pdfMIMEType:
+		src, err = g.renderPDF(ctx, path)
+	default:
+		return 0, 0, ErrUnsupported
+	}
+	if err != nil {
+		return 0, 0, err
+	}
+
+	dst := scaleDown(src, g.maxSize)
+	if encodeErr := jpeg.Encode(w, dst, &jpeg.Options{Quality: jpegQuality}); encodeErr != nil {
+		return 0, 0, fmt.Errorf("encode preview: %w", encodeErr)
+	}
+	return dst.Bounds().Dx(), dst.Bounds().Dy(), nil
+}
+
+// renderPDF renders the first page of a PDF to a PNG in a temporary directory.
+func (g *Generator) renderPDF(ctx context.Context, path string) (image.Image, error) {
+	if g.pdfRenderer == "" {
+		return nil, ErrUnsupported
+	}
+
+	dir, err := os.MkdirTemp("", "flowra-preview-*")
+	if err != nil {
+		return nil, fmt.Errorf("create temp dir: %w", err)
+	}
+	defer os.RemoveAll(dir)
+
+	out := filepath.Join(dir, "page")
+	//nolint:gosec // the renderer is operator configuration, the path comes from file storage
+	cmd := exec.CommandContext(ctx, g.pdfRenderer,
+		"-png", "-f", "1", "-l", "1", "-singlefile",
+		"-scale-to", strconv.Itoa(g.maxSize),
+		path, out,
+	)
+	if output, runErr := cmd.CombinedOutput(); runErr != nil {
+		return nil, fmt.Errorf("render pdf: %w: %s", runErr, bytes.TrimSpace(output))
+	}
+
+	return decodeFile(out + ".png")
+}
+
+// decodeFile decodes an image, refusing images with too many pixels.
+func decodeFile(path string) (image.Image, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("open image: %w", err)
+	}
+	defer f.Close()
+
+	cfg, _, err := image.DecodeConfig(f)
+	if err != nil {
+		return nil, fmt.Errorf("decode image: %w", err)
+	}
+	if cfg.Width <= 0 || cfg.Height <= 0 || cfg.Width*cfg.Height > maxSourcePixels {
+		return nil, ErrTooLarge
+	}
+
+	if _, seekErr := f.Seek(0, io.SeekStart); seekErr != nil {
+		return nil, fmt.Errorf("rewind image: %w", seekErr)
+	}
+	img, _, err := image.Decode(f)
+	if err != nil {
+		return nil, fmt.Errorf("decode image: %w", err)
+	}
+	return img, nil
+}
+
+// scaleDown fits the image into a square of maxSize by averaging the source pixels
+// covering each preview pixel. Transparent areas are drawn on white, as JPEG has no
+// alpha channel. Images that already fit keep their size.
+func scaleDown(src image.Image, maxSize int) *image.RGBA {
+	bounds := src.Bounds()
+	sw, sh := bounds.Dx(), bounds.Dy()
+	dw, dh := sw, sh
+	if sw > maxSize || sh > maxSize {
+		if sw >= sh {
+			dw, dh = maxSize, max(1, sh*maxSize/sw)
+		} else {
+			dw, dh = max(1, sw*maxSize/sh), maxSize
+		}
+	}
+
+	sums := make([]uint64, dw*dh*channels)
+	counts := make([]uint64, dw*dh)
+	for y := range sh {
+		row := (y * dh / sh) * dw
+		for x := range sw {
+			r, g, b, a := src.At(bounds.Min.X+x, bounds.Min.Y+y).RGBA()
+			// colors are premultiplied by alpha, so adding the missing coverage draws on white
+			background := maxChannel - a
+			i := row + x*dw/sw
+			sums[i*channels] += uint64(r + background)
+			sums[i*channels+1] += uint64(g + background)
+			sums[i*channels+2] += uint64(b + background)
+			counts[i]++
+		}
+	}
+
+	dst := image.NewRGBA(image.Rect(0, 0, dw, dh))
+	for i, n := range counts {
+		if n == 0 {
+			continue
+		}
+		for c := range channels {
+			dst.Pix[i*bytesPerPixel+c] = uint8((sums[i*channels+c] / n) >> channelBits) //nolint:gosec // at most 0xff
+		}
+		dst.Pix[i*bytesPerPixel+channels] = opaque
+	}
+	return dst
+}
@@ -0,0 +1,104 @@
+package preview_test
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/lllypuk/flowra/internal/infrastructure/preview"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// writePNG writes a PNG of the given size, left half red, right half transparent
+func writePNG(t *testing.T, width, height int) string {
+	t.Helper()
+	img := image.NewNRGBA(image.Rect(0, 0, width, height))
+	for y := range height {
+		for x := range width / 2 {
+			img.Set(x, y, color.NRGBA{R: 255, A: 255})
+		}
+	}
+
+	path := filepath.Join(t.TempDir(), "image.png")
+	f, err := os.Create(path)
+	require.NoError(t, err)
+	defer f.Close()
+	require.NoError(t, png.Encode(f, img))
+	return path
+}
+
+func TestGenerator_Generate(t *testing.T) {
+	t.Run("scales large images down keeping the aspect ratio", func(t *testing.T) {
+		g := preview.NewGenerator(preview.WithMaxSize(100))
+		var buf bytes.Buffer
+
+		width, height, err := g.Generate(t.Context(), writePNG(t, 400, 200), "image/png", &buf)
+
+		require.NoError(t, err)
+		assert.Equal(t, 100, width)
+		assert.Equal(t, 50, height)
+
+		img, err := jpeg.Decode(&buf)
+		require.NoError(t, err)
+		assert.Equal(t, image.Rect(0, 0, 100, 50), img.Bounds())
+
+		red, green, _, _ := img.At(10, 25).RGBA()
+		assert.Greater(t, red>>8, uint32(200), "red half stays red")
+		assert.Less(t, green>>8, uint32(60))
+		red, green, blue, _ := img.At(90, 25).RGBA()
+		assert.Greater(t, red>>8, uint32(200), "transparent half is drawn on white")
+		assert.Greater(t, green>>8, uint32(200))
+		assert.Greater(t, blue>>8, uint32(200))
+	})
+
+	t.Run("keeps the size of small images", func(t *testing.T) {
+		g := preview.NewGenerator(preview.WithMaxSize(100))
+		var buf bytes.Buffer
+
+		width, height, err := g.Generate(t.Context(), writePNG(t, 40, 30), "image/png", &buf)
+
+		require.NoError(t, err)
+		assert.Equal(t, 40, width)
+		assert.Equal(t, 30, height)
+	})
+
+	t.Run("rejects unsupported types", func(t *testing.T) {
+		g := preview.NewGenerator()
+
+		_, _, err := g.Generate(t.Context(), writePNG(t, 10, 10), "text/plain", &bytes.Buffer{})
+		require.ErrorIs(t, err, preview.ErrUnsupported)
+	})
+
+	t.Run("skips PDFs without a renderer", func(t *testing.T) {
+		g := preview.NewGenerator()
+		assert.False(t, g.PDFEnabled())
+
+		_, _, err := g.Generate(t.Context(), "doc.pdf", "application/pdf", &bytes.Buffer{})
+		require.ErrorIs(t, err, preview.ErrUnsupported)
+	})
+
+	t.Run("fails on broken images", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "broken.jpg")
+		require.NoError(t, os.WriteFile(path, []byte("not an image"), 0o600))
+
+		_, _, err := preview.NewGenerator().Generate(t.Context(), path, "image/jpeg", &bytes.Buffer{})
+		require.Error(t, err)
+		assert.NotErrorIs(t, err, preview.ErrUnsupported)
+	})
+
+	t.Run("reports a missing PDF renderer", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "doc.pdf")
+		require.NoError(t, os.WriteFile(path, []byte("%PDF-1.4"), 0o600))
+		g := preview.NewGenerator(preview.WithPDFRenderer(filepath.Join(t.TempDir(), "no-such-pdftoppm")))
+
+		_, _, err := g.Generate(t.Context(), path, "application/pdf", &bytes.Buffer{})
+		require.Error(t, err)
+		assert.NotErrorIs(t, err, preview.ErrUnsupported)
+	})
+}
@@ -6,6 +6,7 @@ import (
 	"log/slog"
 	"strings"
 
+	"github.com/lllypuk/flowra/internal/application/appcore"
 	"github.com/lllypuk/flowra/internal/domain/uuid"
 	"go.mongodb.org/mongo-driver/v2/bson"
 	"go.mongodb.org/mongo-driver/v2/mongo"
@@ -21,19 +22,32 @@ func isAggregateType(value, expected string) bool {
 	return strings.EqualFold(strings.TrimSpace(value), expected)
 }
 
+// aggregateIDLister is implemented by event stores that enumerate their aggregates
+// themselves, e.g. when events are partitioned over several collections.
+type aggregateIDLister interface {
+	AggregateIDs(ctx context.Context, aggregateTypes ...string) ([]string, error)
+}
+
 func getAllAggregateIDsByType(
 	ctx context.Context,
+	eventStore appcore.EventStore,
 	readModelColl *mongo.Collection,
 	aggregateTypeLower, aggregateTypeTitle string,
 	logger *slog.Logger,
 ) ([]uuid.UUID, error) {
-	eventsColl := readModelColl.Database().Collection("events")
-	filter := bson.M{"aggregate_type": bson.M{"$in": []string{aggregateTypeLower, aggregateTypeTitle}}}
-	result := eventsColl.Distinct(ctx, "aggregate_id", filter)
-
 	var stringIDs []string
-	if err := result.Decode(&stringIDs); err != nil {
-		return nil, fmt.Errorf("failed to decode aggregate IDs: %w", err)
+	if lister, ok := eventStore.(aggregateIDLister); ok {
+		ids, err := lister.AggregateIDs(ctx, aggregateTypeLower, aggregateTypeTitle)
+		if err != nil {
+			return nil, err
+		}
+		stringIDs = ids
+	} else {
+		eventsColl := readModelColl.Database().Collection("events")
+		filter := bson.M{"aggregate_type": bson.M{"$in": []string{aggregateTypeLower, aggregateTypeTitle}}}
+		if err := eventsColl.Distinct(ctx, "aggregate_id", filter).Decode(&stringIDs); err != nil {
+			return nil, fmt.Errorf("failed to decode aggregate IDs: %w", err)
+		}
 	}
 
 	aggregateIDs := make([]uuid.UUID, 0, len(stringIDs))
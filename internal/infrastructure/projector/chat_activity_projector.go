@@ -0,0 +1,353 @@
+package projector
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/lllypuk/flowra/internal/domain/event"
+	messagedomain "github.com/lllypuk/flowra/internal/domain/message"
+	"github.com/lllypuk/flowra/internal/domain/uuid"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+// recentMessageIDsLimit bounds the list of projected message IDs kept per chat
+// to make redelivered events idempotent.
+const recentMessageIDsLimit = 50
+
+// lastMessagePreviewRunes bounds the content excerpt stored in last_message.
+const lastMessagePreviewRunes = 140
+
+// ChatActivityEventTypes returns the events that update chat activity counters.
+func ChatActivityEventTypes() []string {
+	return []string{
+		messagedomain.EventTypeMessageCreated,
+		messagedomain.EventTypeMessageEdited,
+		messagedomain.EventTypeMessageDeleted,
+		messagedomain.EventTypeMessageRestored,
+	}
+}
+
+// ChatActivityProjector maintains message counters in chat read models.
+// Each message.created event increments message_count, moves last_message_at
+// and last_activity_at, and replaces the last_message preview when the message
+// is the newest one; the author's read marker (read_counts.<user_id>) follows
+// the counter, since posting into a chat implies having read it. Unread counts
+// are derived from message_count minus the user's read marker without counting
+// messages per request. Edits, deletions and restores of the previewed message
+// keep the preview in sync.
+type ChatActivityProjector struct {
+	readModelColl *mongo.Collection
+	logger        *slog.Logger
+}
+
+// NewChatActivityProjector creates a new chat activity projector.
+func NewChatActivityProjector(readModelColl *mongo.Collection, logger *slog.Logger) *ChatActivityProjector {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &ChatActivityProjector{
+		readModelColl: readModelColl,
+		logger:        logger,
+	}
+}
+
+// ProcessEvent applies a message event to the chat read model.
+// It matches the event bus handler signature; see ChatActivityEventTypes.
+func (p *ChatActivityProjector) ProcessEvent(ctx context.Context, evt event.DomainEvent) error {
+	if evt == nil {
+		return nil
+	}
+
+	switch evt.EventType() {
+	case messagedomain.EventTypeMessageCreated:
+	case messagedomain.EventTypeMessageEdited,
+		messagedomain.EventTypeMessageDeleted,
+		messagedomain.EventTypeMessageRestored:
+		return p.updatePreview(ctx, evt)
+	default:
+		return nil
+	}
+
+	activity, err := messageActivityFromEvent(evt)
+	if err != nil {
+		p.logger.WarnContext(ctx, "skipping message event without chat activity",
+			slog.String("message_id", evt.AggregateID()),
+			slog.String("error", err.Error()),
+		)
+		return nil
+	}
+
+	return p.recordMessage(ctx, activity)
+}
+
+// recordMessage increments the chat counters once per message.
+func (p *ChatActivityProjector) recordMessage(ctx context.Context, activity messageActivity) error {
+	filter := bson.M{
+		"chat_id":            activity.ChatID.String(),
+		"recent_message_ids": bson.M{"$ne": activity.MessageID},
+	}
+
+	counters := bson.M{
+		"message_count":    bson.M{"$add": bson.A{bson.M{"$ifNull": bson.A{"$message_count", 0}}, 1}},
+		"last_message_at":  bson.M{"$max": bson.A{"$last_message_at", activity.At}},
+		"last_activity_at": bson.M{"$max": bson.A{"$last_activity_at", activity.At}},
+		// Only a message newer than the current preview replaces it; $literal keeps
+		// content such as "$price" from being read as a field path.
+		"last_message": bson.M{"$cond": bson.A{
+			bson.M{"$gte": bson.A{activity.At, bson.M{"$ifNull": bson.A{"$last_message_at", time.Time{}}}}},
+			bson.M{"$literal": lastMessageDocument(activity)},
+			"$last_message",
+		}},
+		"recent_message_ids": bson.M{"$slice": bson.A{
+			bson.M{"$concatArrays": bson.A{
+				bson.M{"$ifNull": bson.A{"$recent_message_ids", bson.A{}}},
+				bson.A{activity.MessageID},
+			}},
+			-recentMessageIDsLimit,
+		}},
+	}
+	update := mongo.Pipeline{{{Key: "$set", Value: counters}}}
+	if !activity.AuthorID.IsZero() {
+		update = append(update, bson.D{{Key: "$set", Value: bson.M{
+			"read_counts." + activity.AuthorID.String(): "$message_count",
+		}}})
+	}
+
+	result, err := p.readModelColl.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return fmt.Errorf("failed to update chat activity: %w", err)
+	}
+	if result.MatchedCount == 0 {
+		p.logger.DebugContext(ctx, "chat activity not updated: already projected or chat missing",
+			slog.String("chat_id", activity.ChatID.String()),
+			slog.String("message_id", activity.MessageID),
+		)
+	}
+
+	return nil
+}
+
+// updatePreview applies an edit, deletion or restore to the last_message
+// preview of the chat whose newest message it is. Other messages are ignored.
+func (p *ChatActivityProjector) updatePreview(ctx context.Context, evt event.DomainEvent) error {
+	set, err := previewUpdateFromEvent(evt)
+	if err != nil {
+		p.logger.WarnContext(ctx, "skipping message event without preview update",
+			slog.String("message_id", evt.AggregateID()),
+			slog.String("error", err.Error()),
+		)
+		return nil
+	}
+
+	filter := bson.M{"last_message.message_id": evt.AggregateID()}
+	if _, err = p.readModelColl.UpdateOne(ctx, filter, bson.M{"$set": set}); err != nil {
+		return fmt.Errorf("failed to update last message preview: %w", err)
+	}
+	return nil
+}
+
+type messageActivity struct {
+	MessageID string
+	ChatID    uuid.UUID
+	AuthorID  uuid.UUID
+	Content   string
+	At        time.Time
+}
+
+// lastMessageDocument builds the last_message preview stored on the chat read model.
+func lastMessageDocument(activity messageActivity) bson.D {
+	authorID := ""
+	if !activity.AuthorID.IsZero() {
+		authorID = activity.AuthorID.String()
+	}
+	return bson.D{
+		{Key: "message_id", Value: activity.MessageID},
+		{Key: "author_id", Value: authorID},
+		{Key: "content", Value: previewExcerpt(activity.Content)},
+		{Key: "created_at", Value: activity.At},
+		{Key: "is_deleted", Value: false},
+	}
+}
+
+// previewUpdateFromEvent returns the last_message fields changed by an edit,
+// deletion or restore. Deleted previews drop their content.
+func previewUpdateFromEvent(evt event.DomainEvent) (bson.M, error) {
+	if evt.EventType() == messagedomain.EventTypeMessageDeleted {
+		return bson.M{"last_message.is_deleted": true, "last_message.content": ""}, nil
+	}
+
+	var content string
+	switch e := evt.(type) {
+	case *messagedomain.Edited:
+		content = e.NewContent
+	case *messagedomain.Restored:
+		content = e.Content
+	case interface{ Payload() json.RawMessage }:
+		var payload struct {
+			Content    string
+			NewContent string
+		}
+		if err := json.Unmarshal(e.Payload(), &payload); err != nil {
+			return nil, fmt.Errorf("invalid payload: %w", err)
+		}
+		content = payload.Content
+		if evt.EventType() == messagedomain.EventTypeMessageEdited {
+			content = payload.NewContent
+		}
+	}
+
+	return bson.M{"last_message.is_deleted": false, "last_message.content": previewExcerpt(content)}, nil
+}
+
+// previewExcerpt collapses whitespace and trims content to lastMessagePreviewRunes.
+func previewExcerpt(content string) string {
+	excerpt := strings.Join(strings.Fields(content), " ")
+	if utf8.RuneCountInString(excerpt) <= lastMessagePreviewRunes {
+		return excerpt
+	}
+	runes := []rune(excerpt)
+	return strings.TrimSpace(string(runes[:lastMessagePreviewRunes-1])) + "…"
+}
+
+// messageActivityFromEvent extracts the chat activity from typed events or,
+// for events received from the bus, from their JSON payload.
+func messageActivityFromEvent(evt event.DomainEvent) (messageActivity, error) {
+	activity := messageActivity{
+		MessageID: evt.AggregateID(),
+		At:        evt.OccurredAt(),
+	}
+
+	if created, ok := evt.(*messagedomain.Created); ok {
+		activity.ChatID = created.ChatID
+		activity.AuthorID = created.AuthorID
+		activity.Content = created.Content
+		if !created.CreatedAt.IsZero() {
+			activity.At = created.CreatedAt
+		}
+	} else if pe, payloadOK := evt.(interface{ Payload() json.RawMessage }); payloadOK {
+		var payload struct {
+			ChatID    uuid.UUID
+			AuthorID  uuid.UUID
+			Content   string
+			CreatedAt time.Time
+		}
+		if err := json.Unmarshal(pe.Payload(), &payload); err != nil {
+			return messageActivity{}, fmt.Errorf("invalid payload: %w", err)
+		}
+		activity.ChatID = payload.ChatID
+		activity.AuthorID = payload.AuthorID
+		activity.Content = payload.Content
+		if !payload.CreatedAt.IsZero() {
+			activity.At = payload.CreatedAt
+		}
+	}
+
+	if activity.ChatID.IsZero() || activity.MessageID == "" {
+		return messageActivity{}, errors.New("missing chat or message ID")
+	}
+	return activity, nil
+}
+
+// chatMessageTotals is the message activity of one chat, aggregated from the
+// messages collection.
+type chatMessageTotals struct {
+	ChatID    string   `bson:"_id"`
+	Count     int      `bson:"count"`
+	RecentIDs []string `bson:"recent_ids"`
+	Last      struct {
+		MessageID string    `bson:"message_id"`
+		AuthorID  string    `bson:"sent_by"`
+		Content   string    `bson:"content"`
+		CreatedAt time.Time `bson:"created_at"`
+		IsDeleted bool      `bson:"is_deleted"`
+	} `bson:"last"`
+}
+
+// RebuildFromMessages recomputes the message counters and last_message preview
+// of every chat read model from the messages collection. Chat read models rebuilt
+// from the event store have none, since message events are not event-sourced.
+// Read markers cannot be recovered, so every participant is marked as having read
+// the chat. Returns the number of chats updated.
+func (p *ChatActivityProjector) RebuildFromMessages(ctx context.Context, messagesColl *mongo.Collection) (int, error) {
+	pipeline := mongo.Pipeline{
+		{{Key: "$project", Value: bson.M{
+			"message_id": 1, "chat_id": 1, "sent_by": 1, "content": 1, "created_at": 1, "is_deleted": 1,
+		}}},
+		{{Key: "$sort", Value: bson.D{{Key: "chat_id", Value: 1}, {Key: "created_at", Value: 1}}}},
+		{{Key: "$group", Value: bson.D{
+			{Key: "_id", Value: "$chat_id"},
+			{Key: "count", Value: bson.M{"$sum": 1}},
+			{Key: "last", Value: bson.M{"$last": "$$ROOT"}},
+			{Key: "recent_ids", Value: bson.M{"$lastN": bson.M{"input": "$message_id", "n": recentMessageIDsLimit}}},
+		}}},
+	}
+	cursor, err := messagesColl.Aggregate(ctx, pipeline, options.Aggregate().SetAllowDiskUse(true))
+	if err != nil {
+		return 0, fmt.Errorf("failed to aggregate messages: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	updated := 0
+	for cursor.Next(ctx) {
+		var totals chatMessageTotals
+		if err = cursor.Decode(&totals); err != nil {
+			return updated, fmt.Errorf("failed to decode message totals: %w", err)
+		}
+		result, updateErr := p.readModelColl.UpdateOne(ctx,
+			bson.M{"chat_id": totals.ChatID}, chatActivityFromTotals(totals))
+		if updateErr != nil {
+			return updated, fmt.Errorf("failed to update chat activity: %w", updateErr)
+		}
+		if result.MatchedCount > 0 {
+			updated++
+		}
+	}
+	if err = cursor.Err(); err != nil {
+		return updated, fmt.Errorf("failed to read message totals: %w", err)
+	}
+	return updated, nil
+}
+
+// chatActivityFromTotals builds the pipeline update that replaces the activity
+// fields of a chat read model with the aggregated totals.
+func chatActivityFromTotals(totals chatMessageTotals) mongo.Pipeline {
+	activity := messageActivity{
+		MessageID: totals.Last.MessageID,
+		Content:   totals.Last.Content,
+		At:        totals.Last.CreatedAt,
+	}
+	if authorID, err := uuid.ParseUUID(totals.Last.AuthorID); err == nil {
+		activity.AuthorID = authorID
+	}
+	preview := lastMessageDocument(activity)
+	if totals.Last.IsDeleted {
+		preview = bson.D{
+			{Key: "message_id", Value: activity.MessageID},
+			{Key: "author_id", Value: totals.Last.AuthorID},
+			{Key: "content", Value: ""},
+			{Key: "created_at", Value: activity.At},
+			{Key: "is_deleted", Value: true},
+		}
+	}
+
+	return mongo.Pipeline{{{Key: "$set", Value: bson.M{
+		"message_count":      totals.Count,
+		"last_message_at":    totals.Last.CreatedAt,
+		"last_activity_at":   bson.M{"$max": bson.A{"$last_activity_at", totals.Last.CreatedAt}},
+		"last_message":       bson.M{"$literal": preview},
+		"recent_message_ids": bson.M{"$literal": totals.RecentIDs},
+		"read_counts": bson.M{"$arrayToObject": bson.M{"$map": bson.M{
+			"input": bson.M{"$ifNull": bson.A{"$participants", bson.A{}}},
+			"as":    "user_id",
+			"in":    bson.M{"k": "$$user_id", "v": totals.Count},
+		}}},
+	}}}}
+}
@@ -0,0 +1,156 @@
+//nolint:testpackage // tests validate internal event mapping used by the activity projection.
+package projector
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+
+	"github.com/lllypuk/flowra/internal/domain/event"
+	messagedomain "github.com/lllypuk/flowra/internal/domain/message"
+	"github.com/lllypuk/flowra/internal/domain/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+)
+
+type payloadEvent struct {
+	event.BaseEvent
+
+	payload json.RawMessage
+}
+
+func (e *payloadEvent) Payload() json.RawMessage { return e.payload }
+
+func TestMessageActivityFromEvent(t *testing.T) {
+	messageID, chatID, authorID := uuid.NewUUID(), uuid.NewUUID(), uuid.NewUUID()
+	created := messagedomain.NewCreated(messageID, chatID, authorID, "hello", "", event.Metadata{})
+
+	t.Run("typed event", func(t *testing.T) {
+		activity, err := messageActivityFromEvent(created)
+		require.NoError(t, err)
+		assert.Equal(t, messageID.String(), activity.MessageID)
+		assert.Equal(t, chatID, activity.ChatID)
+		assert.Equal(t, authorID, activity.AuthorID)
+		assert.Equal(t, "hello", activity.Content)
+		assert.True(t, activity.At.Equal(created.CreatedAt))
+	})
+
+	t.Run("event from the bus", func(t *testing.T) {
+		payload, err := json.Marshal(created)
+		require.NoError(t, err)
+		evt := &payloadEvent{
+			BaseEvent: event.NewBaseEvent(
+				messagedomain.EventTypeMessageCreated, messageID.String(), "Message", 1, event.Metadata{},
+			),
+			payload: payload,
+		}
+
+		activity, err := messageActivityFromEvent(evt)
+		require.NoError(t, err)
+		assert.Equal(t, chatID, activity.ChatID)
+		assert.Equal(t, authorID, activity.AuthorID)
+		assert.Equal(t, "hello", activity.Content)
+		assert.WithinDuration(t, created.CreatedAt, activity.At, time.Millisecond)
+	})
+
+	t.Run("payload without chat", func(t *testing.T) {
+		evt := &payloadEvent{
+			BaseEvent: event.NewBaseEvent(
+				messagedomain.EventTypeMessageCreated, messageID.String(), "Message", 1, event.Metadata{},
+			),
+			payload: json.RawMessage(`{}`),
+		}
+
+		_, err := messageActivityFromEvent(evt)
+		require.Error(t, err)
+	})
+}
+
+func TestChatActivityProjector_IgnoresOtherEvents(t *testing.T) {
+	p := NewChatActivityProjector(nil, nil)
+	evt := messagedomain.NewReactionAdded(uuid.NewUUID(), uuid.NewUUID(), "👍", 2, event.Metadata{})
+
+	require.NoError(t, p.ProcessEvent(context.Background(), evt))
+	require.NoError(t, p.ProcessEvent(context.Background(), nil))
+}
+
+func TestPreviewUpdateFromEvent(t *testing.T) {
+	messageID, chatID, userID := uuid.NewUUID(), uuid.NewUUID(), uuid.NewUUID()
+
+	t.Run("edited", func(t *testing.T) {
+		set, err := previewUpdateFromEvent(messagedomain.NewEdited(messageID, "updated", 2, event.Metadata{}))
+		require.NoError(t, err)
+		assert.Equal(t, "updated", set["last_message.content"])
+		assert.Equal(t, false, set["last_message.is_deleted"])
+	})
+
+	t.Run("deleted drops content", func(t *testing.T) {
+		set, err := previewUpdateFromEvent(messagedomain.NewDeleted(messageID, userID, 2, event.Metadata{}))
+		require.NoError(t, err)
+		assert.Empty(t, set["last_message.content"])
+		assert.Equal(t, true, set["last_message.is_deleted"])
+	})
+
+	t.Run("restored from the bus", func(t *testing.T) {
+		restored := messagedomain.NewRestored(messageID, chatID, "back again", userID, 3, event.Metadata{})
+		payload, err := json.Marshal(restored)
+		require.NoError(t, err)
+		evt := &payloadEvent{
+			BaseEvent: event.NewBaseEvent(
+				messagedomain.EventTypeMessageRestored, messageID.String(), "Message", 3, event.Metadata{},
+			),
+			payload: payload,
+		}
+
+		set, err := previewUpdateFromEvent(evt)
+		require.NoError(t, err)
+		assert.Equal(t, "back again", set["last_message.content"])
+		assert.Equal(t, false, set["last_message.is_deleted"])
+	})
+}
+
+func TestPreviewExcerpt(t *testing.T) {
+	assert.Equal(t, "line one line two", previewExcerpt("  line one\n\tline two "))
+
+	long := strings.Repeat("я", lastMessagePreviewRunes+10)
+	excerpt := previewExcerpt(long)
+	assert.Equal(t, lastMessagePreviewRunes, utf8.RuneCountInString(excerpt))
+	assert.True(t, strings.HasSuffix(excerpt, "…"))
+}
+
+func TestChatActivityFromTotals(t *testing.T) {
+	authorID := uuid.NewUUID()
+	totals := chatMessageTotals{ChatID: uuid.NewUUID().String(), Count: 3, RecentIDs: []string{"m1", "m2", "m3"}}
+	totals.Last.MessageID = "m3"
+	totals.Last.AuthorID = authorID.String()
+	totals.Last.Content = "latest  message"
+	totals.Last.CreatedAt = time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC)
+
+	set := func(update mongo.Pipeline) bson.M {
+		require.Len(t, update, 1)
+		return update[0][0].Value.(bson.M)
+	}
+
+	t.Run("preview of the newest message", func(t *testing.T) {
+		fields := set(chatActivityFromTotals(totals))
+		assert.Equal(t, 3, fields["message_count"])
+		assert.Equal(t, totals.Last.CreatedAt, fields["last_message_at"])
+		preview := fields["last_message"].(bson.M)["$literal"].(bson.D)
+		assert.Contains(t, preview, bson.E{Key: "content", Value: "latest message"})
+		assert.Contains(t, preview, bson.E{Key: "author_id", Value: authorID.String()})
+		assert.Contains(t, preview, bson.E{Key: "is_deleted", Value: false})
+	})
+
+	t.Run("deleted newest message hides its content", func(t *testing.T) {
+		deleted := totals
+		deleted.Last.IsDeleted = true
+		preview := set(chatActivityFromTotals(deleted))["last_message"].(bson.M)["$literal"].(bson.D)
+		assert.Contains(t, preview, bson.E{Key: "content", Value: ""})
+		assert.Contains(t, preview, bson.E{Key: "is_deleted", Value: true})
+	})
+}
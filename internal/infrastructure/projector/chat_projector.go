@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
+	"time"
 
 	"github.com/lllypuk/flowra/internal/application/appcore"
 	chatdomain "github.com/lllypuk/flowra/internal/domain/chat"
@@ -63,7 +64,7 @@ func (p *ChatProjector) RebuildOne(ctx context.Context, chatID uuid.UUID) error
 	}
 
 	// Update read model with reconstructed state
-	if updateErr := p.updateReadModel(ctx, chat); updateErr != nil {
+	if updateErr := p.updateReadModel(ctx, chat, events[len(events)-1].OccurredAt()); updateErr != nil {
 		return fmt.Errorf("failed to update read model: %w", updateErr)
 	}
 
@@ -204,8 +205,9 @@ func (p *ChatProjector) VerifyConsistency(ctx context.Context, chatID uuid.UUID)
 	return consistent, nil
 }
 
-// updateReadModel updates the read model for a chat.
-func (p *ChatProjector) updateReadModel(ctx context.Context, chat *chatdomain.Chat) error {
+// updateReadModel updates the read model for a chat. last_activity_at only moves forward,
+// so message activity projected by ChatActivityProjector is kept.
+func (p *ChatProjector) updateReadModel(ctx context.Context, chat *chatdomain.Chat, lastEventAt time.Time) error {
 	if chat.ID().IsZero() {
 		return errors.New("invalid chat ID")
 	}
@@ -213,7 +215,10 @@ func (p *ChatProjector) updateReadModel(ctx context.Context, chat *chatdomain.Ch
 
 	// Upsert the document
 	filter := bson.M{"chat_id": chat.ID().String()}
-	update := bson.M{"$set": setDoc}
+	update := bson.M{
+		"$set": setDoc,
+		"$max": bson.M{"last_activity_at": lastEventAt},
+	}
 	if len(unsetDoc) > 0 {
 		update["$unset"] = unsetDoc
 	}
@@ -242,6 +247,7 @@ func buildChatReadModelMutation(chat *chatdomain.Chat) (bson.M, bson.M) {
 		"created_by":   chat.CreatedBy().String(),
 		"created_at":   chat.CreatedAt(),
 		"participants": participantStrs,
+		"version":      chat.Version(),
 	}
 
 	unsetDoc := bson.M{}
@@ -285,5 +291,5 @@ func buildChatReadModelMutation(chat *chatdomain.Chat) (bson.M, bson.M) {
 
 // getAllAggregateIDs retrieves all unique chat IDs from the events collection.
 func (p *ChatProjector) getAllAggregateIDs(ctx context.Context) ([]uuid.UUID, error) {
-	return getAllAggregateIDsByType(ctx, p.readModelColl, aggregateTypeChat, "Chat", p.logger)
+	return getAllAggregateIDsByType(ctx, p.eventStore, p.readModelColl, aggregateTypeChat, "Chat", p.logger)
 }
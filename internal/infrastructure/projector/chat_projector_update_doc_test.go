@@ -20,6 +20,7 @@ func TestBuildChatReadModelMutation_TaskWithoutNullableFieldsUsesUnset(t *testin
 	setDoc, unsetDoc := buildChatReadModelMutation(c)
 
 	assert.Equal(t, string(chatdomain.TypeTask), setDoc["type"])
+	assert.Equal(t, c.Version(), setDoc["version"])
 	assert.Equal(t, c.Status(), setDoc["status"])
 	assert.Equal(t, c.Priority(), setDoc["priority"])
 	assert.Empty(t, unsetDoc["assigned_to"])
@@ -18,27 +18,50 @@ import (
 	"go.mongodb.org/mongo-driver/v2/mongo/options"
 )
 
+// TaskUserDirectory resolves the user names denormalized onto tasks_read_model.
+// Declared on the consumer side per project guidelines.
+type TaskUserDirectory interface {
+	GetByID(ctx context.Context, userID uuid.UUID) (*appcore.User, error)
+}
+
 // ChatToTaskReadModelProjector rebuilds tasks_read_model from chat.* event streams.
 type ChatToTaskReadModelProjector struct {
 	eventStore    appcore.EventStore
 	readModelColl *mongo.Collection
+	users         TaskUserDirectory
 	logger        *slog.Logger
 }
 
+// TaskProjectorOption configures ChatToTaskReadModelProjector.
+type TaskProjectorOption func(*ChatToTaskReadModelProjector)
+
+// WithTaskUserDirectory makes the projector store assignee and creator names
+// next to their IDs. Without it the name fields are left untouched.
+func WithTaskUserDirectory(users TaskUserDirectory) TaskProjectorOption {
+	return func(p *ChatToTaskReadModelProjector) {
+		p.users = users
+	}
+}
+
 // NewChatToTaskReadModelProjector creates a new projector that maps chat state to task read model shape.
 func NewChatToTaskReadModelProjector(
 	eventStore appcore.EventStore,
 	readModelColl *mongo.Collection,
 	logger *slog.Logger,
+	opts ...TaskProjectorOption,
 ) *ChatToTaskReadModelProjector {
 	if logger == nil {
 		logger = slog.Default()
 	}
-	return &ChatToTaskReadModelProjector{
+	p := &ChatToTaskReadModelProjector{
 		eventStore:    eventStore,
 		readModelColl: readModelColl,
 		logger:        logger,
 	}
+	for _, opt := range opts {
+		opt(p)
+	}
+	return p
 }
 
 // RebuildOne rebuilds a single tasks_read_model document from chat.* events only.
@@ -169,7 +192,11 @@ func (p *ChatToTaskReadModelProjector) syncReadModel(ctx context.Context, aggreg
 		return nil
 	}
 
+	p.resolveUserNames(ctx, doc)
 	update := bson.M{"$set": doc}
+	if doc.AssignedTo == nil {
+		update["$unset"] = bson.M{"assignee_username": "", "assignee_display_name": ""}
+	}
 	opts := options.UpdateOne().SetUpsert(true)
 	if _, updateErr := p.readModelColl.UpdateOne(ctx, filter, update, opts); updateErr != nil {
 		return fmt.Errorf("failed to upsert task read model: %w", updateErr)
@@ -178,6 +205,38 @@ func (p *ChatToTaskReadModelProjector) syncReadModel(ctx context.Context, aggreg
 	return nil
 }
 
+// resolveUserNames fills the assignee and creator names of doc. Users that
+// cannot be resolved keep the names already stored in the read model.
+func (p *ChatToTaskReadModelProjector) resolveUserNames(ctx context.Context, doc *taskProjectionDocument) {
+	if p.users == nil {
+		return
+	}
+	if doc.AssignedTo != nil {
+		if u := p.lookupUser(ctx, *doc.AssignedTo); u != nil {
+			doc.AssigneeUsername, doc.AssigneeDisplayName = u.Username, u.FullName
+		}
+	}
+	if u := p.lookupUser(ctx, doc.CreatedBy); u != nil {
+		doc.CreatorUsername, doc.CreatorDisplayName = u.Username, u.FullName
+	}
+}
+
+func (p *ChatToTaskReadModelProjector) lookupUser(ctx context.Context, userID string) *appcore.User {
+	id, err := uuid.ParseUUID(userID)
+	if err != nil {
+		return nil
+	}
+	u, err := p.users.GetByID(ctx, id)
+	if err != nil {
+		p.logger.DebugContext(ctx, "task projection user not resolved",
+			slog.String("user_id", userID),
+			slog.String("error", err.Error()),
+		)
+		return nil
+	}
+	return u
+}
+
 func (p *ChatToTaskReadModelProjector) readModelAbsent(ctx context.Context, chatID uuid.UUID) (bool, error) {
 	count, err := p.readModelColl.CountDocuments(ctx, bson.M{"task_id": chatID.String()})
 	if err != nil {
@@ -187,7 +246,7 @@ func (p *ChatToTaskReadModelProjector) readModelAbsent(ctx context.Context, chat
 }
 
 func (p *ChatToTaskReadModelProjector) getAllAggregateIDs(ctx context.Context) ([]uuid.UUID, error) {
-	return getAllAggregateIDsByType(ctx, p.readModelColl, aggregateTypeChat, "Chat", p.logger)
+	return getAllAggregateIDsByType(ctx, p.eventStore, p.readModelColl, aggregateTypeChat, "Chat", p.logger)
 }
 
 func replayChatEvents(events []event.DomainEvent) (*chatdomain.Chat, error) {
@@ -218,17 +277,40 @@ func filterChatEvents(events []event.DomainEvent) []event.DomainEvent {
 type taskProjectionDocument struct {
 	TaskID      string                     `bson:"task_id"`
 	ChatID      string                     `bson:"chat_id"`
+	WorkspaceID string                     `bson:"workspace_id"`
 	Title       string                     `bson:"title"`
 	EntityType  string                     `bson:"entity_type"`
 	Status      string                     `bson:"status"`
 	Priority    string                     `bson:"priority"`
 	Severity    *string                    `bson:"severity"`
+	Estimate    *taskProjectionEstimate    `bson:"estimate"`
+	Sprint      *string                    `bson:"sprint"`
+	DuplicateOf *string                    `bson:"duplicate_of"`
+	EpicID      *string                    `bson:"epic_id"`
 	AssignedTo  *string                    `bson:"assigned_to"`
 	DueDate     *time.Time                 `bson:"due_date"`
 	CreatedBy   string                     `bson:"created_by"`
 	CreatedAt   time.Time                  `bson:"created_at"`
 	Version     int                        `bson:"version"`
 	Attachments []taskProjectionAttachment `bson:"attachments"`
+
+	Checklist        []taskProjectionChecklistItem `bson:"checklist"`
+	ChecklistTotal   int                           `bson:"checklist_total"`
+	ChecklistDone    int                           `bson:"checklist_done"`
+	ChecklistPercent int                           `bson:"checklist_percent"`
+
+	SLA *taskProjectionSLA `bson:"sla"`
+
+	// Denormalized user names; see TaskUserNamesProjector for renames.
+	AssigneeUsername    string `bson:"assignee_username,omitempty"`
+	AssigneeDisplayName string `bson:"assignee_display_name,omitempty"`
+	CreatorUsername     string `bson:"created_by_username,omitempty"`
+	CreatorDisplayName  string `bson:"created_by_display_name,omitempty"`
+}
+
+type taskProjectionEstimate struct {
+	Value float64 `bson:"value"`
+	Unit  string  `bson:"unit"`
 }
 
 type taskProjectionAttachment struct {
@@ -238,6 +320,21 @@ type taskProjectionAttachment struct {
 	MimeType string `bson:"mime_type"`
 }
 
+// taskProjectionSLA holds the SLA clock of a bug; deadlines depend on the
+// workspace policy and are computed when read.
+type taskProjectionSLA struct {
+	OpenedAt    time.Time  `bson:"opened_at"`
+	RespondedAt *time.Time `bson:"responded_at"`
+	ResolvedAt  *time.Time `bson:"resolved_at"`
+}
+
+type taskProjectionChecklistItem struct {
+	ItemID   string `bson:"item_id"`
+	Text     string `bson:"text"`
+	Done     bool   `bson:"done"`
+	Position int    `bson:"position"`
+}
+
 func buildTaskProjectionDocument(aggregate *chatdomain.Chat) (*taskProjectionDocument, bool, error) {
 	if aggregate == nil || aggregate.ID().IsZero() {
 		return nil, false, appcore.ErrAggregateNotFound
@@ -258,6 +355,7 @@ func buildTaskProjectionDocument(aggregate *chatdomain.Chat) (*taskProjectionDoc
 	doc := &taskProjectionDocument{
 		TaskID:      aggregate.ID().String(),
 		ChatID:      aggregate.ID().String(),
+		WorkspaceID: aggregate.WorkspaceID().String(),
 		Title:       aggregate.Title(),
 		EntityType:  string(entityType),
 		Status:      string(status),
@@ -266,12 +364,27 @@ func buildTaskProjectionDocument(aggregate *chatdomain.Chat) (*taskProjectionDoc
 		CreatedAt:   aggregate.CreatedAt(),
 		Version:     aggregate.Version(),
 		Attachments: make([]taskProjectionAttachment, 0, len(aggregate.Attachments())),
+		Checklist:   make([]taskProjectionChecklistItem, 0, len(aggregate.Checklist())),
 	}
 
 	if aggregate.Type() == chatdomain.TypeBug && strings.TrimSpace(aggregate.Severity()) != "" {
 		severity := aggregate.Severity()
 		doc.Severity = &severity
 	}
+	if estimate := aggregate.Estimate(); estimate != nil {
+		doc.Estimate = &taskProjectionEstimate{Value: estimate.Value, Unit: string(estimate.Unit)}
+	}
+	if sprint := aggregate.Sprint(); sprint != "" {
+		doc.Sprint = &sprint
+	}
+	if original := aggregate.DuplicateOf(); original != nil {
+		duplicateOf := original.String()
+		doc.DuplicateOf = &duplicateOf
+	}
+	if epic := aggregate.EpicID(); epic != nil {
+		epicID := epic.String()
+		doc.EpicID = &epicID
+	}
 	if aggregate.AssigneeID() != nil {
 		assigneeID := aggregate.AssigneeID().String()
 		doc.AssignedTo = &assigneeID
@@ -288,6 +401,25 @@ func buildTaskProjectionDocument(aggregate *chatdomain.Chat) (*taskProjectionDoc
 			MimeType: attachment.MimeType(),
 		})
 	}
+	for _, item := range aggregate.Checklist() {
+		doc.Checklist = append(doc.Checklist, taskProjectionChecklistItem{
+			ItemID:   item.ID().String(),
+			Text:     item.Text(),
+			Done:     item.Done(),
+			Position: item.Position(),
+		})
+	}
+	if clock := aggregate.SLAClock(); !clock.IsZero() {
+		doc.SLA = &taskProjectionSLA{
+			OpenedAt:    clock.OpenedAt(),
+			RespondedAt: clock.RespondedAt(),
+			ResolvedAt:  clock.ResolvedAt(),
+		}
+	}
+	progress := aggregate.ChecklistProgress()
+	doc.ChecklistTotal = progress.Total
+	doc.ChecklistDone = progress.Done
+	doc.ChecklistPercent = progress.Percent()
 
 	return doc, true, nil
 }
@@ -299,6 +431,7 @@ func compareTaskProjectionDocuments(expected, actual *taskProjectionDocument) bo
 
 	if expected.TaskID != actual.TaskID ||
 		expected.ChatID != actual.ChatID ||
+		expected.WorkspaceID != actual.WorkspaceID ||
 		expected.Title != actual.Title ||
 		expected.EntityType != actual.EntityType ||
 		expected.Status != actual.Status ||
@@ -313,6 +446,14 @@ func compareTaskProjectionDocuments(expected, actual *taskProjectionDocument) bo
 		return false
 	}
 
+	if !equalEstimatePtr(expected.Estimate, actual.Estimate) || !equalStringPtr(expected.Sprint, actual.Sprint) {
+		return false
+	}
+
+	if !equalStringPtr(expected.DuplicateOf, actual.DuplicateOf) || !equalStringPtr(expected.EpicID, actual.EpicID) {
+		return false
+	}
+
 	if !equalStringPtr(expected.AssignedTo, actual.AssignedTo) {
 		return false
 	}
@@ -321,9 +462,36 @@ func compareTaskProjectionDocuments(expected, actual *taskProjectionDocument) bo
 		return false
 	}
 
+	if expected.ChecklistTotal != actual.ChecklistTotal ||
+		expected.ChecklistDone != actual.ChecklistDone ||
+		expected.ChecklistPercent != actual.ChecklistPercent ||
+		!equalTaskProjectionChecklist(expected.Checklist, actual.Checklist) {
+		return false
+	}
+
+	if !equalTaskProjectionSLA(expected.SLA, actual.SLA) {
+		return false
+	}
+
 	return equalTaskProjectionAttachments(expected.Attachments, actual.Attachments)
 }
 
+func equalTaskProjectionSLA(a, b *taskProjectionSLA) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.OpenedAt.Equal(b.OpenedAt) &&
+		equalTimePtr(a.RespondedAt, b.RespondedAt) &&
+		equalTimePtr(a.ResolvedAt, b.ResolvedAt)
+}
+
+func equalEstimatePtr(a, b *taskProjectionEstimate) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
 func equalStringPtr(a, b *string) bool {
 	if a == nil || b == nil {
 		return a == b
@@ -350,6 +518,18 @@ func equalTaskProjectionAttachments(a, b []taskProjectionAttachment) bool {
 	return true
 }
 
+func equalTaskProjectionChecklist(a, b []taskProjectionChecklistItem) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
 func mapChatTypeToTaskEntityType(chatType chatdomain.Type) (taskdomain.EntityType, error) {
 	switch chatType {
 	case chatdomain.TypeTask:
@@ -366,31 +546,7 @@ func mapChatTypeToTaskEntityType(chatType chatdomain.Type) (taskdomain.EntityTyp
 }
 
 func normalizeTaskStatus(status string) taskdomain.Status {
-	normalized := strings.ToLower(strings.TrimSpace(status))
-	switch normalized {
-	case "", "new", "planned":
-		return taskdomain.StatusToDo
-	case "investigating":
-		return taskdomain.StatusInProgress
-	case "fixed":
-		return taskdomain.StatusInReview
-	case "verified", "completed", "closed":
-		return taskdomain.StatusDone
-	case strings.ToLower(string(taskdomain.StatusBacklog)):
-		return taskdomain.StatusBacklog
-	case strings.ToLower(string(taskdomain.StatusToDo)):
-		return taskdomain.StatusToDo
-	case strings.ToLower(string(taskdomain.StatusInProgress)):
-		return taskdomain.StatusInProgress
-	case strings.ToLower(string(taskdomain.StatusInReview)):
-		return taskdomain.StatusInReview
-	case strings.ToLower(string(taskdomain.StatusDone)):
-		return taskdomain.StatusDone
-	case strings.ToLower(string(taskdomain.StatusCancelled)):
-		return taskdomain.StatusCancelled
-	default:
-		return taskdomain.StatusToDo
-	}
+	return taskdomain.StatusFromChatStatus(status)
 }
 
 func normalizeTaskPriority(priority string) taskdomain.Priority {
@@ -2,9 +2,11 @@
 package projector
 
 import (
+	"context"
 	"testing"
 	"time"
 
+	"github.com/lllypuk/flowra/internal/application/appcore"
 	chatdomain "github.com/lllypuk/flowra/internal/domain/chat"
 	"github.com/lllypuk/flowra/internal/domain/event"
 	taskdomain "github.com/lllypuk/flowra/internal/domain/task"
@@ -86,6 +88,45 @@ func TestBuildTaskProjectionDocument_DiscussionChat(t *testing.T) {
 	assert.Nil(t, doc)
 }
 
+func TestChatToTaskReadModelProjector_ResolveUserNames(t *testing.T) {
+	creatorID := uuid.NewUUID()
+	assigneeID := uuid.NewUUID()
+	users := stubTaskUserDirectory{
+		creatorID: {ID: creatorID, Username: "bob", FullName: "Bob Jones"},
+	}
+
+	t.Run("stores resolved names and keeps unresolved ones untouched", func(t *testing.T) {
+		p := NewChatToTaskReadModelProjector(nil, nil, nil, WithTaskUserDirectory(users))
+		assignee := assigneeID.String()
+		doc := &taskProjectionDocument{CreatedBy: creatorID.String(), AssignedTo: &assignee}
+
+		p.resolveUserNames(context.Background(), doc)
+
+		assert.Equal(t, "bob", doc.CreatorUsername)
+		assert.Equal(t, "Bob Jones", doc.CreatorDisplayName)
+		assert.Empty(t, doc.AssigneeUsername)
+		assert.Empty(t, doc.AssigneeDisplayName)
+	})
+
+	t.Run("without a directory", func(t *testing.T) {
+		p := NewChatToTaskReadModelProjector(nil, nil, nil)
+		doc := &taskProjectionDocument{CreatedBy: creatorID.String()}
+
+		p.resolveUserNames(context.Background(), doc)
+
+		assert.Empty(t, doc.CreatorUsername)
+	})
+}
+
+type stubTaskUserDirectory map[uuid.UUID]*appcore.User
+
+func (d stubTaskUserDirectory) GetByID(_ context.Context, userID uuid.UUID) (*appcore.User, error) {
+	if u, ok := d[userID]; ok {
+		return u, nil
+	}
+	return nil, appcore.ErrAggregateNotFound
+}
+
 func TestFilterChatEvents(t *testing.T) {
 	events := []event.DomainEvent{
 		&stubEvent{eventType: "chat.created"},
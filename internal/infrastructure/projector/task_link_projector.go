@@ -0,0 +1,320 @@
+package projector
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"regexp"
+	"strings"
+	"time"
+
+	chatdomain "github.com/lllypuk/flowra/internal/domain/chat"
+	"github.com/lllypuk/flowra/internal/domain/event"
+	messagedomain "github.com/lllypuk/flowra/internal/domain/message"
+	"github.com/lllypuk/flowra/internal/domain/uuid"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+// maxTaskReferencesPerMessage bounds the tasks linked from one message.
+const maxTaskReferencesPerMessage = 20
+
+// taskReferencePattern matches task URLs: API paths (/tasks/{id}) and task chat
+// pages (/chats/{id}), since a task shares its ID with its chat.
+var taskReferencePattern = regexp.MustCompile(
+	`(?i)/(?:tasks|chats)/([0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12})\b`,
+)
+
+// TaskLinkEventTypes returns the events that update message-to-task links.
+func TaskLinkEventTypes() []string {
+	return []string{
+		messagedomain.EventTypeMessageCreated,
+		messagedomain.EventTypeMessageEdited,
+		messagedomain.EventTypeMessageDeleted,
+		messagedomain.EventTypeMessageRestored,
+	}
+}
+
+// taskLinkDocument records the tasks referenced by one message. Messages without
+// references to tasks of their workspace have no document.
+type taskLinkDocument struct {
+	MessageID   string    `bson:"message_id"`
+	ChatID      string    `bson:"chat_id"`
+	WorkspaceID string    `bson:"workspace_id"`
+	AuthorID    string    `bson:"author_id"`
+	TaskIDs     []string  `bson:"task_ids"`
+	Excerpt     string    `bson:"excerpt"`
+	CreatedAt   time.Time `bson:"created_at"`
+	IsDeleted   bool      `bson:"is_deleted"`
+}
+
+// TaskLinkProjector maintains the task_links collection: for every message that
+// references tasks (see taskReferencePattern) it stores the referenced task IDs,
+// which serve both directions — the tasks shown on a message and the messages a
+// task is mentioned in. Only tasks, bugs and epics of the message's workspace are
+// linked. Edits re-resolve the references; deletions hide the links until the
+// message is restored.
+type TaskLinkProjector struct {
+	linksColl    *mongo.Collection
+	chatsColl    *mongo.Collection
+	messagesColl *mongo.Collection
+	logger       *slog.Logger
+}
+
+// NewTaskLinkProjector creates a new task link projector. Chats are resolved from
+// the chat read model; messages are read only for edits of messages without links.
+func NewTaskLinkProjector(
+	linksColl, chatsColl, messagesColl *mongo.Collection,
+	logger *slog.Logger,
+) *TaskLinkProjector {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &TaskLinkProjector{
+		linksColl:    linksColl,
+		chatsColl:    chatsColl,
+		messagesColl: messagesColl,
+		logger:       logger,
+	}
+}
+
+// ProcessEvent applies a message event to the task links.
+// It matches the event bus handler signature; see TaskLinkEventTypes.
+func (p *TaskLinkProjector) ProcessEvent(ctx context.Context, evt event.DomainEvent) error {
+	if evt == nil {
+		return nil
+	}
+
+	switch evt.EventType() {
+	case messagedomain.EventTypeMessageCreated:
+		activity, err := messageActivityFromEvent(evt)
+		if err != nil {
+			p.logger.WarnContext(ctx, "skipping message event without task links",
+				slog.String("message_id", evt.AggregateID()),
+				slog.String("error", err.Error()),
+			)
+			return nil
+		}
+		return p.link(ctx, activity)
+	case messagedomain.EventTypeMessageEdited, messagedomain.EventTypeMessageRestored:
+		return p.relink(ctx, evt)
+	case messagedomain.EventTypeMessageDeleted:
+		_, err := p.linksColl.UpdateOne(ctx,
+			bson.M{"message_id": evt.AggregateID()},
+			bson.M{"$set": bson.M{"is_deleted": true}},
+		)
+		if err != nil {
+			return fmt.Errorf("failed to hide task links: %w", err)
+		}
+		return nil
+	default:
+		return nil
+	}
+}
+
+// relink re-resolves the links of an edited or restored message. The message's
+// chat and author come from its existing links or, failing that, the message itself.
+func (p *TaskLinkProjector) relink(ctx context.Context, evt event.DomainEvent) error {
+	content, chatID, err := messageContentFromEvent(evt)
+	if err != nil {
+		p.logger.WarnContext(ctx, "skipping message event without task links",
+			slog.String("message_id", evt.AggregateID()),
+			slog.String("error", err.Error()),
+		)
+		return nil
+	}
+
+	activity, found, err := p.findMessage(ctx, evt.AggregateID())
+	if err != nil {
+		return err
+	}
+	if !found {
+		p.logger.DebugContext(ctx, "task links not updated: message not found",
+			slog.String("message_id", evt.AggregateID()),
+		)
+		return nil
+	}
+	if !chatID.IsZero() {
+		activity.ChatID = chatID
+	}
+	activity.Content = content
+
+	return p.link(ctx, activity)
+}
+
+// link stores the tasks referenced by the message, or removes its links when
+// it no longer references any task of its workspace.
+func (p *TaskLinkProjector) link(ctx context.Context, activity messageActivity) error {
+	filter := bson.M{"message_id": activity.MessageID}
+
+	refs := extractTaskReferences(activity.Content, activity.ChatID)
+	var (
+		workspaceID string
+		taskIDs     []string
+		err         error
+	)
+	if len(refs) > 0 {
+		workspaceID, taskIDs, err = p.resolveTasks(ctx, activity.ChatID, refs)
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(taskIDs) == 0 {
+		if _, err = p.linksColl.DeleteOne(ctx, filter); err != nil {
+			return fmt.Errorf("failed to remove task links: %w", err)
+		}
+		return nil
+	}
+
+	authorID := ""
+	if !activity.AuthorID.IsZero() {
+		authorID = activity.AuthorID.String()
+	}
+	doc := taskLinkDocument{
+		MessageID:   activity.MessageID,
+		ChatID:      activity.ChatID.String(),
+		WorkspaceID: workspaceID,
+		AuthorID:    authorID,
+		TaskIDs:     taskIDs,
+		Excerpt:     previewExcerpt(activity.Content),
+		CreatedAt:   activity.At,
+	}
+	if _, err = p.linksColl.ReplaceOne(ctx, filter, doc, options.Replace().SetUpsert(true)); err != nil {
+		return fmt.Errorf("failed to save task links: %w", err)
+	}
+	return nil
+}
+
+// resolveTasks returns the workspace of the message's chat and, in reference
+// order, the referenced IDs that are tasks of that workspace.
+func (p *TaskLinkProjector) resolveTasks(
+	ctx context.Context,
+	chatID uuid.UUID,
+	refs []string,
+) (string, []string, error) {
+	var source struct {
+		WorkspaceID string `bson:"workspace_id"`
+	}
+	err := p.chatsColl.FindOne(ctx, bson.M{"chat_id": chatID.String()},
+		options.FindOne().SetProjection(bson.M{"workspace_id": 1}),
+	).Decode(&source)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return "", nil, nil
+	}
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to load chat: %w", err)
+	}
+
+	cursor, err := p.chatsColl.Find(ctx, bson.M{
+		"chat_id":      bson.M{"$in": refs},
+		"workspace_id": source.WorkspaceID,
+		"type":         bson.M{"$ne": string(chatdomain.TypeDiscussion)},
+	}, options.Find().SetProjection(bson.M{"chat_id": 1}))
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to resolve referenced tasks: %w", err)
+	}
+	var docs []struct {
+		ChatID string `bson:"chat_id"`
+	}
+	if err = cursor.All(ctx, &docs); err != nil {
+		return "", nil, fmt.Errorf("failed to decode referenced tasks: %w", err)
+	}
+
+	found := make(map[string]bool, len(docs))
+	for _, doc := range docs {
+		found[doc.ChatID] = true
+	}
+	taskIDs := make([]string, 0, len(docs))
+	for _, ref := range refs {
+		if found[ref] {
+			taskIDs = append(taskIDs, ref)
+		}
+	}
+	return source.WorkspaceID, taskIDs, nil
+}
+
+// findMessage loads the chat, author and creation time of a message from its
+// task links or, for messages without links, from the messages collection.
+func (p *TaskLinkProjector) findMessage(ctx context.Context, messageID string) (messageActivity, bool, error) {
+	var doc struct {
+		ChatID    string    `bson:"chat_id"`
+		AuthorID  string    `bson:"author_id"`
+		SentBy    string    `bson:"sent_by"`
+		CreatedAt time.Time `bson:"created_at"`
+	}
+
+	err := p.linksColl.FindOne(ctx, bson.M{"message_id": messageID}).Decode(&doc)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		err = p.messagesColl.FindOne(ctx, bson.M{"message_id": messageID},
+			options.FindOne().SetProjection(bson.M{"chat_id": 1, "sent_by": 1, "created_at": 1}),
+		).Decode(&doc)
+		doc.AuthorID = doc.SentBy
+	}
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return messageActivity{}, false, nil
+	}
+	if err != nil {
+		return messageActivity{}, false, fmt.Errorf("failed to load message: %w", err)
+	}
+
+	return messageActivity{
+		MessageID: messageID,
+		ChatID:    uuid.UUID(doc.ChatID),
+		AuthorID:  uuid.UUID(doc.AuthorID),
+		At:        doc.CreatedAt,
+	}, true, nil
+}
+
+// extractTaskReferences returns the distinct task IDs referenced in content, in
+// order of appearance. References to the message's own chat are skipped.
+func extractTaskReferences(content string, selfChatID uuid.UUID) []string {
+	matches := taskReferencePattern.FindAllStringSubmatch(content, -1)
+	if len(matches) == 0 {
+		return nil
+	}
+
+	self := strings.ToLower(selfChatID.String())
+	seen := make(map[string]bool, len(matches))
+	refs := make([]string, 0, len(matches))
+	for _, match := range matches {
+		id := strings.ToLower(match[1])
+		if id == self || seen[id] {
+			continue
+		}
+		seen[id] = true
+		refs = append(refs, id)
+		if len(refs) == maxTaskReferencesPerMessage {
+			break
+		}
+	}
+	return refs
+}
+
+// messageContentFromEvent extracts the new content of an edited or restored
+// message and, for restores, its chat, from typed events or the JSON payload.
+func messageContentFromEvent(evt event.DomainEvent) (string, uuid.UUID, error) {
+	switch e := evt.(type) {
+	case *messagedomain.Edited:
+		return e.NewContent, "", nil
+	case *messagedomain.Restored:
+		return e.Content, e.ChatID, nil
+	case interface{ Payload() json.RawMessage }:
+		var payload struct {
+			ChatID     uuid.UUID
+			Content    string
+			NewContent string
+		}
+		if err := json.Unmarshal(e.Payload(), &payload); err != nil {
+			return "", "", fmt.Errorf("invalid payload: %w", err)
+		}
+		if evt.EventType() == messagedomain.EventTypeMessageEdited {
+			return payload.NewContent, payload.ChatID, nil
+		}
+		return payload.Content, payload.ChatID, nil
+	}
+	return "", "", errors.New("unsupported event")
+}
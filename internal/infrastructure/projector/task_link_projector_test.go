@@ -0,0 +1,105 @@
+//nolint:testpackage // tests validate internal reference parsing used by the task link projection.
+package projector
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/lllypuk/flowra/internal/domain/event"
+	messagedomain "github.com/lllypuk/flowra/internal/domain/message"
+	"github.com/lllypuk/flowra/internal/domain/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExtractTaskReferences(t *testing.T) {
+	self := uuid.NewUUID()
+	first, second := uuid.NewUUID(), uuid.NewUUID()
+
+	t.Run("task and chat URLs in order of appearance", func(t *testing.T) {
+		content := "see https://flowra.example/chats/" + second.String() +
+			" and /tasks/" + first.String() + "?tab=details"
+
+		assert.Equal(t, []string{second.String(), first.String()}, extractTaskReferences(content, self))
+	})
+
+	t.Run("duplicates and case are folded", func(t *testing.T) {
+		content := "/tasks/" + strings.ToUpper(first.String()) + " /chats/" + first.String()
+
+		assert.Equal(t, []string{first.String()}, extractTaskReferences(content, self))
+	})
+
+	t.Run("own chat is skipped", func(t *testing.T) {
+		assert.Empty(t, extractTaskReferences("/chats/"+self.String(), self))
+	})
+
+	t.Run("bare IDs and other paths are ignored", func(t *testing.T) {
+		content := first.String() + " /messages/" + second.String()
+
+		assert.Empty(t, extractTaskReferences(content, self))
+	})
+
+	t.Run("references are bounded", func(t *testing.T) {
+		var b strings.Builder
+		for range maxTaskReferencesPerMessage + 5 {
+			b.WriteString(" /tasks/" + uuid.NewUUID().String())
+		}
+
+		assert.Len(t, extractTaskReferences(b.String(), self), maxTaskReferencesPerMessage)
+	})
+}
+
+func TestMessageContentFromEvent(t *testing.T) {
+	messageID, chatID := uuid.NewUUID(), uuid.NewUUID()
+
+	t.Run("typed edit", func(t *testing.T) {
+		edited := messagedomain.NewEdited(messageID, "new text", 2, event.Metadata{})
+
+		content, gotChatID, err := messageContentFromEvent(edited)
+		require.NoError(t, err)
+		assert.Equal(t, "new text", content)
+		assert.True(t, gotChatID.IsZero())
+	})
+
+	t.Run("edit from the bus", func(t *testing.T) {
+		payload, err := json.Marshal(map[string]any{"NewContent": "from bus", "ChatID": chatID})
+		require.NoError(t, err)
+		evt := &payloadEvent{
+			BaseEvent: event.NewBaseEvent(
+				messagedomain.EventTypeMessageEdited, messageID.String(), "Message", 2, event.Metadata{},
+			),
+			payload: payload,
+		}
+
+		content, gotChatID, err := messageContentFromEvent(evt)
+		require.NoError(t, err)
+		assert.Equal(t, "from bus", content)
+		assert.Equal(t, chatID, gotChatID)
+	})
+
+	t.Run("restore from the bus", func(t *testing.T) {
+		payload, err := json.Marshal(map[string]any{"Content": "restored", "ChatID": chatID})
+		require.NoError(t, err)
+		evt := &payloadEvent{
+			BaseEvent: event.NewBaseEvent(
+				messagedomain.EventTypeMessageRestored, messageID.String(), "Message", 3, event.Metadata{},
+			),
+			payload: payload,
+		}
+
+		content, gotChatID, err := messageContentFromEvent(evt)
+		require.NoError(t, err)
+		assert.Equal(t, "restored", content)
+		assert.Equal(t, chatID, gotChatID)
+	})
+}
+
+func TestTaskLinkProjector_IgnoresUnrelatedEvents(t *testing.T) {
+	p := NewTaskLinkProjector(nil, nil, nil, nil)
+	evt := event.NewBaseEvent("chat.created", uuid.NewUUID().String(), "Chat", 1, event.Metadata{})
+
+	require.NoError(t, p.ProcessEvent(context.Background(), &evt))
+	require.NoError(t, p.ProcessEvent(context.Background(), nil))
+}
@@ -0,0 +1,82 @@
+package projector
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/lllypuk/flowra/internal/domain/uuid"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+)
+
+// taskUserNameField maps a user reference in tasks_read_model to its denormalized name fields.
+type taskUserNameField struct {
+	idField          string
+	usernameField    string
+	displayNameField string
+}
+
+var taskUserNameFields = []taskUserNameField{
+	{idField: "assigned_to", usernameField: "assignee_username", displayNameField: "assignee_display_name"},
+	{idField: "created_by", usernameField: "created_by_username", displayNameField: "created_by_display_name"},
+}
+
+// TaskUserNamesProjector keeps the user names denormalized on tasks_read_model
+// in step with profile changes, so board cards never show a stale name.
+type TaskUserNamesProjector struct {
+	readModelColl *mongo.Collection
+	logger        *slog.Logger
+}
+
+// NewTaskUserNamesProjector creates a new task user names projector.
+func NewTaskUserNamesProjector(readModelColl *mongo.Collection, logger *slog.Logger) *TaskUserNamesProjector {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &TaskUserNamesProjector{
+		readModelColl: readModelColl,
+		logger:        logger,
+	}
+}
+
+// UserProfileChanged rewrites the user's names on the tasks assigned to or created by them.
+// Tasks that already carry the current names are not touched.
+func (p *TaskUserNamesProjector) UserProfileChanged(
+	ctx context.Context,
+	userID uuid.UUID,
+	username, displayName string,
+) error {
+	for _, fields := range taskUserNameFields {
+		filter, update := taskUserNamesUpdate(fields, userID, username, displayName)
+		result, err := p.readModelColl.UpdateMany(ctx, filter, update)
+		if err != nil {
+			return fmt.Errorf("failed to update task %s names: %w", fields.idField, err)
+		}
+		if result.ModifiedCount > 0 {
+			p.logger.DebugContext(ctx, "updated user names on tasks",
+				slog.String("user_id", userID.String()),
+				slog.String("field", fields.idField),
+				slog.Int64("tasks", result.ModifiedCount),
+			)
+		}
+	}
+	return nil
+}
+
+// taskUserNamesUpdate builds the filter and update that stamp the user's names on matching tasks.
+func taskUserNamesUpdate(
+	fields taskUserNameField,
+	userID uuid.UUID,
+	username, displayName string,
+) (bson.M, bson.M) {
+	filter := bson.M{
+		fields.idField: userID.String(),
+		"$or": bson.A{
+			bson.M{fields.usernameField: bson.M{"$ne": username}},
+			bson.M{fields.displayNameField: bson.M{"$ne": displayName}},
+		},
+	}
+	update := bson.M{"$set": bson.M{fields.usernameField: username, fields.displayNameField: displayName}}
+	return filter, update
+}
@@ -0,0 +1,36 @@
+//nolint:testpackage // tests validate internal update builders used by the task names projection.
+package projector
+
+import (
+	"testing"
+
+	"github.com/lllypuk/flowra/internal/domain/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+func TestTaskUserNamesUpdate(t *testing.T) {
+	userID := uuid.NewUUID()
+
+	filter, update := taskUserNamesUpdate(taskUserNameFields[0], userID, "alice", "Alice Smith")
+
+	assert.Equal(t, bson.M{
+		"assigned_to": userID.String(),
+		"$or": bson.A{
+			bson.M{"assignee_username": bson.M{"$ne": "alice"}},
+			bson.M{"assignee_display_name": bson.M{"$ne": "Alice Smith"}},
+		},
+	}, filter)
+	assert.Equal(t, bson.M{"$set": bson.M{
+		"assignee_username":     "alice",
+		"assignee_display_name": "Alice Smith",
+	}}, update)
+}
+
+func TestTaskUserNameFields(t *testing.T) {
+	idFields := make([]string, 0, len(taskUserNameFields))
+	for _, fields := range taskUserNameFields {
+		idFields = append(idFields, fields.idField)
+	}
+	assert.Equal(t, []string{"assigned_to", "created_by"}, idFields)
+}
@@ -17,6 +17,10 @@ type TaskType string
 const (
 	// TaskTypeReadModelSync indicates a read model synchronization task.
 	TaskTypeReadModelSync TaskType = "readmodel_sync"
+
+	// TaskTypeRebuildAll indicates a rebuild of every read model of the aggregate type.
+	// AggregateID is empty for these tasks.
+	TaskTypeRebuildAll TaskType = "rebuild_all"
 )
 
 // Task represents a repair task that needs to be processed.
@@ -208,6 +212,45 @@ func (q *MongoQueue) MarkFailed(ctx context.Context, taskID string, taskErr erro
 	return nil
 }
 
+// PurgeCompleted deletes up to limit tasks completed before the given time.
+// Returns the number of deleted tasks.
+func (q *MongoQueue) PurgeCompleted(ctx context.Context, completedBefore time.Time, limit int) (int64, error) {
+	filter := bson.M{
+		"status":       "completed",
+		"completed_at": bson.M{"$lt": completedBefore},
+	}
+	opts := options.Find().
+		SetProjection(bson.M{"_id": 1}).
+		SetSort(bson.D{{Key: "completed_at", Value: 1}}).
+		SetLimit(int64(limit))
+
+	cursor, err := q.collection.Find(ctx, filter, opts)
+	if err != nil {
+		return 0, fmt.Errorf("failed to find completed repair tasks: %w", err)
+	}
+	var docs []struct {
+		ID any `bson:"_id"`
+	}
+	if err = cursor.All(ctx, &docs); err != nil {
+		return 0, fmt.Errorf("failed to decode completed repair tasks: %w", err)
+	}
+	if len(docs) == 0 {
+		return 0, nil
+	}
+
+	ids := make([]any, 0, len(docs))
+	for _, doc := range docs {
+		ids = append(ids, doc.ID)
+	}
+
+	result, err := q.collection.DeleteMany(ctx, bson.M{"_id": bson.M{"$in": ids}})
+	if err != nil {
+		return 0, fmt.Errorf("failed to purge completed repair tasks: %w", err)
+	}
+
+	return result.DeletedCount, nil
+}
+
 // GetStats returns queue statistics.
 func (q *MongoQueue) GetStats(ctx context.Context) (*QueueStats, error) {
 	stats := &QueueStats{}
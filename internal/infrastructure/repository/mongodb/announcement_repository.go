@@ -0,0 +1,236 @@
+package mongodb
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+
+	"github.com/lllypuk/flowra/internal/domain/announcement"
+	"github.com/lllypuk/flowra/internal/domain/errs"
+	"github.com/lllypuk/flowra/internal/domain/uuid"
+)
+
+// announcementDocument is the MongoDB representation of an announcement.
+type announcementDocument struct {
+	AnnouncementID string    `bson:"announcement_id"`
+	Message        string    `bson:"message"`
+	Level          string    `bson:"level"`
+	StartsAt       time.Time `bson:"starts_at"`
+	EndsAt         time.Time `bson:"ends_at"`
+	CreatedBy      string    `bson:"created_by"`
+	CreatedAt      time.Time `bson:"created_at"`
+}
+
+// announcementDismissalDocument records that a user closed an announcement banner.
+type announcementDismissalDocument struct {
+	AnnouncementID string    `bson:"announcement_id"`
+	UserID         string    `bson:"user_id"`
+	DismissedAt    time.Time `bson:"dismissed_at"`
+}
+
+// MongoAnnouncementRepository stores announcements and per-user dismissals in MongoDB.
+type MongoAnnouncementRepository struct {
+	collection *mongo.Collection
+	dismissals *mongo.Collection
+	logger     *slog.Logger
+}
+
+// AnnouncementRepoOption configures MongoAnnouncementRepository.
+type AnnouncementRepoOption func(*MongoAnnouncementRepository)
+
+// WithAnnouncementRepoLogger sets the logger for the announcement repository.
+func WithAnnouncementRepoLogger(logger *slog.Logger) AnnouncementRepoOption {
+	return func(r *MongoAnnouncementRepository) {
+		r.logger = logger
+	}
+}
+
+// NewMongoAnnouncementRepository creates a new announcement repository.
+func NewMongoAnnouncementRepository(
+	collection *mongo.Collection,
+	dismissals *mongo.Collection,
+	opts ...AnnouncementRepoOption,
+) *MongoAnnouncementRepository {
+	r := &MongoAnnouncementRepository{
+		collection: collection,
+		dismissals: dismissals,
+		logger:     slog.Default(),
+	}
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
+// Save creates or updates an announcement.
+func (r *MongoAnnouncementRepository) Save(ctx context.Context, a *announcement.Announcement) error {
+	if a == nil || a.ID().IsZero() {
+		return errs.ErrInvalidInput
+	}
+
+	doc := announcementDocument{
+		AnnouncementID: a.ID().String(),
+		Message:        a.Message(),
+		Level:          string(a.Level()),
+		StartsAt:       a.StartsAt(),
+		EndsAt:         a.EndsAt(),
+		CreatedBy:      a.CreatedBy().String(),
+		CreatedAt:      a.CreatedAt(),
+	}
+	filter := bson.M{"announcement_id": doc.AnnouncementID}
+	_, err := r.collection.ReplaceOne(ctx, filter, doc, options.Replace().SetUpsert(true))
+	if err != nil {
+		r.logger.ErrorContext(ctx, "failed to save announcement",
+			slog.String("announcement_id", doc.AnnouncementID),
+			slog.String("error", err.Error()),
+		)
+		return HandleMongoError(err, "announcement")
+	}
+
+	return nil
+}
+
+// FindByID returns an announcement by ID.
+func (r *MongoAnnouncementRepository) FindByID(ctx context.Context, id uuid.UUID) (*announcement.Announcement, error) {
+	if id.IsZero() {
+		return nil, errs.ErrInvalidInput
+	}
+
+	var doc announcementDocument
+	err := r.collection.FindOne(ctx, bson.M{"announcement_id": id.String()}).Decode(&doc)
+	if err != nil {
+		return nil, HandleMongoError(err, "announcement")
+	}
+
+	return documentToAnnouncement(&doc), nil
+}
+
+// List returns the most recently created announcements first.
+func (r *MongoAnnouncementRepository) List(ctx context.Context, limit int) ([]*announcement.Announcement, error) {
+	limit = DefaultLimit(limit, DefaultPaginationLimit)
+	opts := options.Find().
+		SetSort(bson.D{{Key: "created_at", Value: -1}}).
+		SetLimit(int64(limit))
+
+	return r.find(ctx, bson.M{}, opts)
+}
+
+// FindActive returns the announcements shown at the given time, most recent start first.
+func (r *MongoAnnouncementRepository) FindActive(
+	ctx context.Context,
+	at time.Time,
+) ([]*announcement.Announcement, error) {
+	filter := bson.M{
+		"starts_at": bson.M{"$lte": at},
+		"ends_at":   bson.M{"$gt": at},
+	}
+	opts := options.Find().SetSort(bson.D{{Key: "starts_at", Value: -1}})
+
+	return r.find(ctx, filter, opts)
+}
+
+// Dismiss records that the user closed the banner. Repeated calls keep the first dismissal time.
+func (r *MongoAnnouncementRepository) Dismiss(ctx context.Context, announcementID, userID uuid.UUID) error {
+	if announcementID.IsZero() || userID.IsZero() {
+		return errs.ErrInvalidInput
+	}
+
+	filter := bson.M{
+		"announcement_id": announcementID.String(),
+		"user_id":         userID.String(),
+	}
+	update := bson.M{"$setOnInsert": announcementDismissalDocument{
+		AnnouncementID: announcementID.String(),
+		UserID:         userID.String(),
+		DismissedAt:    time.Now(),
+	}}
+	_, err := r.dismissals.UpdateOne(ctx, filter, update, options.UpdateOne().SetUpsert(true))
+	if err != nil {
+		return HandleMongoError(err, "announcement_dismissal")
+	}
+
+	return nil
+}
+
+// DismissedIDs returns which of the given announcements the user dismissed.
+func (r *MongoAnnouncementRepository) DismissedIDs(
+	ctx context.Context,
+	userID uuid.UUID,
+	announcementIDs []uuid.UUID,
+) (map[uuid.UUID]bool, error) {
+	dismissed := make(map[uuid.UUID]bool)
+	if userID.IsZero() || len(announcementIDs) == 0 {
+		return dismissed, nil
+	}
+
+	ids := make([]string, 0, len(announcementIDs))
+	for _, id := range announcementIDs {
+		ids = append(ids, id.String())
+	}
+	filter := bson.M{
+		"user_id":         userID.String(),
+		"announcement_id": bson.M{"$in": ids},
+	}
+
+	cursor, err := r.dismissals.Find(ctx, filter)
+	if err != nil {
+		return nil, HandleMongoError(err, "announcement_dismissal")
+	}
+	defer cursor.Close(ctx)
+
+	for cursor.Next(ctx) {
+		var doc announcementDismissalDocument
+		if decodeErr := cursor.Decode(&doc); decodeErr != nil {
+			continue
+		}
+		dismissed[uuid.UUID(doc.AnnouncementID)] = true
+	}
+	if err = cursor.Err(); err != nil {
+		return nil, fmt.Errorf("cursor error: %w", err)
+	}
+
+	return dismissed, nil
+}
+
+func (r *MongoAnnouncementRepository) find(
+	ctx context.Context,
+	filter bson.M,
+	opts *options.FindOptionsBuilder,
+) ([]*announcement.Announcement, error) {
+	cursor, err := r.collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, HandleMongoError(err, "announcements")
+	}
+	defer cursor.Close(ctx)
+
+	announcements := make([]*announcement.Announcement, 0)
+	for cursor.Next(ctx) {
+		var doc announcementDocument
+		if decodeErr := cursor.Decode(&doc); decodeErr != nil {
+			continue
+		}
+		announcements = append(announcements, documentToAnnouncement(&doc))
+	}
+	if err = cursor.Err(); err != nil {
+		return nil, fmt.Errorf("cursor error: %w", err)
+	}
+
+	return announcements, nil
+}
+
+func documentToAnnouncement(doc *announcementDocument) *announcement.Announcement {
+	return announcement.Reconstruct(
+		uuid.UUID(doc.AnnouncementID),
+		doc.Message,
+		announcement.Level(doc.Level),
+		doc.StartsAt,
+		doc.EndsAt,
+		uuid.UUID(doc.CreatedBy),
+		doc.CreatedAt,
+	)
+}
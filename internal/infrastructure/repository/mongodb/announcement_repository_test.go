@@ -0,0 +1,82 @@
+package mongodb_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/lllypuk/flowra/internal/domain/announcement"
+	"github.com/lllypuk/flowra/internal/domain/errs"
+	"github.com/lllypuk/flowra/internal/domain/uuid"
+	"github.com/lllypuk/flowra/internal/infrastructure/repository/mongodb"
+	"github.com/lllypuk/flowra/tests/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setupTestAnnouncementRepository(t *testing.T) *mongodb.MongoAnnouncementRepository {
+	t.Helper()
+
+	db := testutil.SetupTestMongoDB(t)
+	return mongodb.NewMongoAnnouncementRepository(
+		db.Collection("announcements"),
+		db.Collection("announcement_dismissals"),
+	)
+}
+
+func TestMongoAnnouncementRepository_SaveAndFind(t *testing.T) {
+	repo := setupTestAnnouncementRepository(t)
+	ctx := context.Background()
+	now := time.Now().UTC().Truncate(time.Millisecond)
+
+	active, err := announcement.NewAnnouncement(
+		"Incident", announcement.LevelIncident, now.Add(-time.Minute), now.Add(time.Hour), uuid.NewUUID())
+	require.NoError(t, err)
+	scheduled, err := announcement.NewAnnouncement(
+		"Maintenance", announcement.LevelMaintenance, now.Add(time.Hour), now.Add(2*time.Hour), uuid.NewUUID())
+	require.NoError(t, err)
+	require.NoError(t, repo.Save(ctx, active))
+	require.NoError(t, repo.Save(ctx, scheduled))
+
+	found, err := repo.FindByID(ctx, active.ID())
+	require.NoError(t, err)
+	assert.Equal(t, "Incident", found.Message())
+	assert.Equal(t, announcement.LevelIncident, found.Level())
+	assert.True(t, found.EndsAt().Equal(active.EndsAt()))
+
+	_, err = repo.FindByID(ctx, uuid.NewUUID())
+	require.ErrorIs(t, err, errs.ErrNotFound)
+
+	activeNow, err := repo.FindActive(ctx, now)
+	require.NoError(t, err)
+	require.Len(t, activeNow, 1)
+	assert.Equal(t, active.ID(), activeNow[0].ID())
+
+	all, err := repo.List(ctx, 10)
+	require.NoError(t, err)
+	assert.Len(t, all, 2)
+
+	require.NoError(t, active.End(now))
+	require.NoError(t, repo.Save(ctx, active))
+	activeNow, err = repo.FindActive(ctx, now)
+	require.NoError(t, err)
+	assert.Empty(t, activeNow)
+}
+
+func TestMongoAnnouncementRepository_Dismissals(t *testing.T) {
+	repo := setupTestAnnouncementRepository(t)
+	ctx := context.Background()
+	userID := uuid.NewUUID()
+	first, second := uuid.NewUUID(), uuid.NewUUID()
+
+	require.NoError(t, repo.Dismiss(ctx, first, userID))
+	require.NoError(t, repo.Dismiss(ctx, first, userID), "dismissing twice is a no-op")
+
+	dismissed, err := repo.DismissedIDs(ctx, userID, []uuid.UUID{first, second})
+	require.NoError(t, err)
+	assert.Equal(t, map[uuid.UUID]bool{first: true}, dismissed)
+
+	dismissed, err = repo.DismissedIDs(ctx, uuid.NewUUID(), []uuid.UUID{first, second})
+	require.NoError(t, err)
+	assert.Empty(t, dismissed)
+}
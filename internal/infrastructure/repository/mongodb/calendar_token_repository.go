@@ -0,0 +1,125 @@
+package mongodb
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+
+	calendarapp "github.com/lllypuk/flowra/internal/application/calendar"
+	"github.com/lllypuk/flowra/internal/domain/errs"
+	"github.com/lllypuk/flowra/internal/domain/uuid"
+)
+
+// calendarTokenDocument is the MongoDB representation of a calendar feed token.
+// Only the hash of the secret token is stored.
+type calendarTokenDocument struct {
+	UserID    string    `bson:"user_id"`
+	TokenHash string    `bson:"token_hash"`
+	CreatedAt time.Time `bson:"created_at"`
+}
+
+// MongoCalendarTokenRepository stores calendar feed tokens in MongoDB.
+type MongoCalendarTokenRepository struct {
+	collection *mongo.Collection
+	logger     *slog.Logger
+}
+
+// CalendarTokenRepoOption configures MongoCalendarTokenRepository.
+type CalendarTokenRepoOption func(*MongoCalendarTokenRepository)
+
+// WithCalendarTokenRepoLogger sets the logger for the calendar token repository.
+func WithCalendarTokenRepoLogger(logger *slog.Logger) CalendarTokenRepoOption {
+	return func(r *MongoCalendarTokenRepository) {
+		r.logger = logger
+	}
+}
+
+// NewMongoCalendarTokenRepository creates a new calendar token repository.
+func NewMongoCalendarTokenRepository(
+	collection *mongo.Collection,
+	opts ...CalendarTokenRepoOption,
+) *MongoCalendarTokenRepository {
+	r := &MongoCalendarTokenRepository{
+		collection: collection,
+		logger:     slog.Default(),
+	}
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
+// Save stores the token, replacing the previous token of the user.
+func (r *MongoCalendarTokenRepository) Save(ctx context.Context, token *calendarapp.FeedToken) error {
+	if token == nil || token.UserID.IsZero() || token.TokenHash == "" {
+		return errs.ErrInvalidInput
+	}
+
+	doc := calendarTokenDocument{
+		UserID:    token.UserID.String(),
+		TokenHash: token.TokenHash,
+		CreatedAt: token.CreatedAt,
+	}
+	filter := bson.M{"user_id": doc.UserID}
+	_, err := r.collection.ReplaceOne(ctx, filter, doc, options.Replace().SetUpsert(true))
+	if err != nil {
+		r.logger.ErrorContext(ctx, "failed to save calendar token",
+			slog.String("user_id", doc.UserID),
+			slog.String("error", err.Error()),
+		)
+		return HandleMongoError(err, "calendar_token")
+	}
+
+	return nil
+}
+
+// FindByUserID returns the token of a user.
+func (r *MongoCalendarTokenRepository) FindByUserID(
+	ctx context.Context,
+	userID uuid.UUID,
+) (*calendarapp.FeedToken, error) {
+	if userID.IsZero() {
+		return nil, errs.ErrInvalidInput
+	}
+	return r.findOne(ctx, bson.M{"user_id": userID.String()})
+}
+
+// FindByHash returns the token with the given hash.
+func (r *MongoCalendarTokenRepository) FindByHash(
+	ctx context.Context,
+	tokenHash string,
+) (*calendarapp.FeedToken, error) {
+	if tokenHash == "" {
+		return nil, errs.ErrInvalidInput
+	}
+	return r.findOne(ctx, bson.M{"token_hash": tokenHash})
+}
+
+// Delete removes the token of a user. Deleting a missing token is a no-op.
+func (r *MongoCalendarTokenRepository) Delete(ctx context.Context, userID uuid.UUID) error {
+	if userID.IsZero() {
+		return errs.ErrInvalidInput
+	}
+
+	if _, err := r.collection.DeleteOne(ctx, bson.M{"user_id": userID.String()}); err != nil {
+		return HandleMongoError(err, "calendar_token")
+	}
+	return nil
+}
+
+func (r *MongoCalendarTokenRepository) findOne(ctx context.Context, filter bson.M) (*calendarapp.FeedToken, error) {
+	var doc calendarTokenDocument
+	if err := r.collection.FindOne(ctx, filter).Decode(&doc); err != nil {
+		return nil, HandleMongoError(err, "calendar_token")
+	}
+
+	return &calendarapp.FeedToken{
+		UserID:    uuid.UUID(doc.UserID),
+		TokenHash: doc.TokenHash,
+		CreatedAt: doc.CreatedAt,
+	}, nil
+}
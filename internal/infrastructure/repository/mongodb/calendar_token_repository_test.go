@@ -0,0 +1,44 @@
+package mongodb_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	calendarapp "github.com/lllypuk/flowra/internal/application/calendar"
+	"github.com/lllypuk/flowra/internal/domain/errs"
+	"github.com/lllypuk/flowra/internal/domain/uuid"
+	"github.com/lllypuk/flowra/internal/infrastructure/repository/mongodb"
+	"github.com/lllypuk/flowra/tests/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMongoCalendarTokenRepository_SaveFindDelete(t *testing.T) {
+	db := testutil.SetupTestMongoDB(t)
+	repo := mongodb.NewMongoCalendarTokenRepository(db.Collection("calendar_feed_tokens"))
+	ctx := context.Background()
+	userID := uuid.NewUUID()
+	now := time.Now().UTC().Truncate(time.Millisecond)
+
+	require.NoError(t, repo.Save(ctx, &calendarapp.FeedToken{UserID: userID, TokenHash: "first", CreatedAt: now}))
+	// saving again replaces the token of the user
+	require.NoError(t, repo.Save(ctx, &calendarapp.FeedToken{UserID: userID, TokenHash: "second", CreatedAt: now}))
+
+	found, err := repo.FindByUserID(ctx, userID)
+	require.NoError(t, err)
+	assert.Equal(t, "second", found.TokenHash)
+	assert.True(t, found.CreatedAt.Equal(now))
+
+	byHash, err := repo.FindByHash(ctx, "second")
+	require.NoError(t, err)
+	assert.Equal(t, userID, byHash.UserID)
+
+	_, err = repo.FindByHash(ctx, "first")
+	require.ErrorIs(t, err, errs.ErrNotFound)
+
+	require.NoError(t, repo.Delete(ctx, userID))
+	require.NoError(t, repo.Delete(ctx, userID))
+	_, err = repo.FindByUserID(ctx, userID)
+	require.ErrorIs(t, err, errs.ErrNotFound)
+}
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
+	"regexp"
 	"time"
 
 	"go.mongodb.org/mongo-driver/v2/bson"
@@ -158,7 +159,7 @@ func (r *MongoChatRepository) Save(ctx context.Context, chat *chatdomain.Chat) e
 	}
 
 	// 2. Update read model (denormalized representation)
-	err = r.updateReadModel(ctx, chat)
+	err = r.updateReadModel(ctx, chat, uncommittedEvents[len(uncommittedEvents)-1].OccurredAt())
 	if err != nil {
 		r.logger.ErrorContext(ctx, "failed to update chat read model",
 			slog.String("chat_id", chat.ID().String()),
@@ -233,7 +234,12 @@ func (r *MongoChatRepository) GetEvents(ctx context.Context, chatID uuid.UUID) (
 }
 
 // updateReadModel obnovlyaet denormalizovannoe view in read model kollektsii
-func (r *MongoChatRepository) updateReadModel(ctx context.Context, chat *chatdomain.Chat) error {
+// activityAt moves last_activity_at forward; message activity is projected separately.
+func (r *MongoChatRepository) updateReadModel(
+	ctx context.Context,
+	chat *chatdomain.Chat,
+	activityAt time.Time,
+) error {
 	// Checking, that u nas est bazovaya information for read model
 	if chat.ID().IsZero() {
 		return errs.ErrInvalidInput
@@ -242,7 +248,10 @@ func (r *MongoChatRepository) updateReadModel(ctx context.Context, chat *chatdom
 
 	// ispolzuem upsert for creating or updating dokumenta
 	filter := bson.M{"chat_id": chat.ID().String()}
-	update := bson.M{"$set": setDoc}
+	update := bson.M{
+		"$set": setDoc,
+		"$max": bson.M{"last_activity_at": activityAt},
+	}
 	if len(unsetDoc) > 0 {
 		update["$unset"] = unsetDoc
 	}
@@ -267,6 +276,7 @@ func buildChatReadModelMutation(chat *chatdomain.Chat) (bson.M, bson.M) {
 		"created_by":   chat.CreatedBy().String(),
 		"created_at":   chat.CreatedAt(),
 		"participants": participantStrs,
+		"version":      chat.Version(),
 	}
 
 	unsetDoc := bson.M{}
@@ -392,9 +402,17 @@ func (r *MongoChatReadModelRepository) FindByWorkspace(
 		filter["participants"] = filters.UserID.String()
 	}
 
+	if filters.Search != "" {
+		filter["title"] = bson.M{"$regex": regexp.QuoteMeta(filters.Search), "$options": "i"}
+	}
+
+	if filters.IDs != nil {
+		filter["chat_id"] = bson.M{"$in": uuidStrings(filters.IDs)}
+	}
+
 	// formiruem optsii (paginatsiya, sort)
 	opts := options.Find().
-		SetSort(bson.D{{Key: "created_at", Value: -1}}).
+		SetSort(chatActivitySort).
 		SetLimit(int64(filters.Limit)).
 		SetSkip(int64(filters.Offset))
 
@@ -458,7 +476,7 @@ func (r *MongoChatReadModelRepository) FindByParticipant(
 
 	filter := bson.M{"participants": userID.String()}
 	opts := options.Find().
-		SetSort(bson.D{{Key: "created_at", Value: -1}}).
+		SetSort(chatActivitySort).
 		SetLimit(int64(limit)).
 		SetSkip(int64(offset))
 
@@ -521,6 +539,66 @@ func (r *MongoChatReadModelRepository) Count(ctx context.Context, workspaceID uu
 	return int(count), nil
 }
 
+// MarkRead sets the user's read marker to the chat message counter,
+// so all messages projected so far count as read.
+func (r *MongoChatReadModelRepository) MarkRead(ctx context.Context, chatID, userID uuid.UUID) error {
+	if chatID.IsZero() || userID.IsZero() {
+		return errs.ErrInvalidInput
+	}
+
+	filter := bson.M{"chat_id": chatID.String()}
+	update := mongo.Pipeline{
+		{{Key: "$set", Value: bson.M{
+			"read_counts." + userID.String(): bson.M{"$ifNull": bson.A{"$message_count", 0}},
+		}}},
+	}
+
+	result, err := r.collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		r.logger.ErrorContext(ctx, "failed to mark chat as read",
+			slog.String("chat_id", chatID.String()),
+			slog.String("user_id", userID.String()),
+			slog.String("error", err.Error()),
+		)
+		return HandleMongoError(err, "chat")
+	}
+	if result.MatchedCount == 0 {
+		return errs.ErrNotFound
+	}
+
+	return nil
+}
+
+// chatActivitySort lists the most recently active chats first.
+// Chats projected before last_activity_at existed fall back to their creation time.
+var chatActivitySort = bson.D{{Key: "last_activity_at", Value: -1}, {Key: "created_at", Value: -1}}
+
+// bsonTime converts a BSON datetime to time.Time; documents decoded into bson.M hold bson.DateTime
+func bsonTime(v any) (time.Time, bool) {
+	switch t := v.(type) {
+	case bson.DateTime:
+		return t.Time(), true
+	case time.Time:
+		return t, true
+	default:
+		return time.Time{}, false
+	}
+}
+
+// bsonInt converts a numeric BSON value to int, returning 0 for missing values
+func bsonInt(v any) int {
+	switch n := v.(type) {
+	case int32:
+		return int(n)
+	case int64:
+		return int(n)
+	case float64:
+		return int(n)
+	default:
+		return 0
+	}
+}
+
 // documentToReadModel preobrazuet BSON dokument in ReadModel
 func (r *MongoChatReadModelRepository) documentToReadModel(doc bson.M) (*chatapp.ReadModel, error) {
 	chatIDStr, ok := doc["chat_id"].(string)
@@ -548,10 +626,7 @@ func (r *MongoChatReadModelRepository) documentToReadModel(doc bson.M) (*chatapp
 		isPublic = false
 	}
 
-	var createdAt time.Time
-	if createdAtVal, createdOk := doc["created_at"].(time.Time); createdOk {
-		createdAt = createdAtVal
-	}
+	createdAt, _ := bsonTime(doc["created_at"])
 
 	// Read title (may be empty for discussions)
 	var title string
@@ -573,16 +648,68 @@ func (r *MongoChatReadModelRepository) documentToReadModel(doc bson.M) (*chatapp
 		}
 	}
 
+	// Activity counters are maintained by the chat activity projector
+	var lastMessageAt *time.Time
+	if lastMessageVal, lastOk := bsonTime(doc["last_message_at"]); lastOk {
+		lastMessageAt = &lastMessageVal
+	}
+
+	var lastActivityAt *time.Time
+	if lastActivityVal, activityOk := bsonTime(doc["last_activity_at"]); activityOk {
+		lastActivityAt = &lastActivityVal
+	}
+
+	readCounts := make(map[uuid.UUID]int)
+	if readCountsVal, readOk := doc["read_counts"].(bson.D); readOk {
+		for _, elem := range readCountsVal {
+			readCounts[uuid.UUID(elem.Key)] = bsonInt(elem.Value)
+		}
+	}
+
 	rm := &chatapp.ReadModel{
-		ID:           uuid.UUID(chatIDStr),
-		WorkspaceID:  uuid.UUID(workspaceIDStr),
-		Type:         chatdomain.Type(chatType),
-		Title:        title,
-		IsPublic:     isPublic,
-		CreatedBy:    uuid.UUID(createdByStr),
-		CreatedAt:    createdAt,
-		Participants: participants,
+		ID:             uuid.UUID(chatIDStr),
+		WorkspaceID:    uuid.UUID(workspaceIDStr),
+		Type:           chatdomain.Type(chatType),
+		Title:          title,
+		IsPublic:       isPublic,
+		CreatedBy:      uuid.UUID(createdByStr),
+		CreatedAt:      createdAt,
+		LastMessageAt:  lastMessageAt,
+		LastActivityAt: lastActivityAt,
+		LastMessage:    documentToLastMessage(doc["last_message"]),
+		MessageCount:   bsonInt(doc["message_count"]),
+		ReadCounts:     readCounts,
+		Participants:   participants,
 	}
 
 	return rm, nil
 }
+
+// documentToLastMessage reads the last message preview maintained by the chat activity projector
+func documentToLastMessage(v any) *chatapp.LastMessagePreview {
+	doc, ok := v.(bson.D)
+	if !ok {
+		return nil
+	}
+
+	var preview chatapp.LastMessagePreview
+	for _, elem := range doc {
+		str, _ := elem.Value.(string)
+		switch elem.Key {
+		case "message_id":
+			preview.MessageID = uuid.UUID(str)
+		case "author_id":
+			preview.AuthorID = uuid.UUID(str)
+		case "content":
+			preview.Content = str
+		case "created_at":
+			preview.CreatedAt, _ = bsonTime(elem.Value)
+		case "is_deleted":
+			preview.IsDeleted, _ = elem.Value.(bool)
+		}
+	}
+	if preview.MessageID.IsZero() {
+		return nil
+	}
+	return &preview
+}
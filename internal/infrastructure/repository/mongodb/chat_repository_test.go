@@ -8,8 +8,11 @@ import (
 	chatapp "github.com/lllypuk/flowra/internal/application/chat"
 	"github.com/lllypuk/flowra/internal/domain/chat"
 	"github.com/lllypuk/flowra/internal/domain/errs"
+	"github.com/lllypuk/flowra/internal/domain/event"
+	"github.com/lllypuk/flowra/internal/domain/message"
 	"github.com/lllypuk/flowra/internal/domain/uuid"
 	mongodbinfra "github.com/lllypuk/flowra/internal/infrastructure/mongodb"
+	"github.com/lllypuk/flowra/internal/infrastructure/projector"
 	"github.com/lllypuk/flowra/internal/infrastructure/repository/mongodb"
 	"github.com/lllypuk/flowra/tests/mocks"
 	"github.com/lllypuk/flowra/tests/testutil"
@@ -473,6 +476,36 @@ func TestMongoChatReadModelRepository_FindByWorkspace_WithTypeFilter(t *testing.
 	assert.Equal(t, chat.TypeTask, chats[0].Type)
 }
 
+// TestMongoChatReadModelRepository_FindByWorkspace_WithSearch checks the title search
+func TestMongoChatReadModelRepository_FindByWorkspace_WithSearch(t *testing.T) {
+	_, queryRepo, _, readModelColl := setupTestRepository(t)
+	if queryRepo == nil {
+		return
+	}
+
+	ctx := context.Background()
+
+	workspaceID := uuid.NewUUID()
+	userID := uuid.NewUUID()
+
+	for _, title := range []string{"Release v1.2 (beta)", "Release notes", "Standup"} {
+		c, err := chat.NewChat(workspaceID, chat.TypeDiscussion, true, userID)
+		require.NoError(t, err)
+		require.NoError(t, c.Rename(title, userID))
+		addChatToReadModel(ctx, t, readModelColl, c)
+	}
+
+	chats, err := queryRepo.FindByWorkspace(ctx, workspaceID, chatapp.Filters{Search: "release", Limit: 10})
+	require.NoError(t, err)
+	assert.Len(t, chats, 2)
+
+	// regex metacharacters are matched literally
+	chats, err = queryRepo.FindByWorkspace(ctx, workspaceID, chatapp.Filters{Search: "v1.2 (", Limit: 10})
+	require.NoError(t, err)
+	require.Len(t, chats, 1)
+	assert.Equal(t, "Release v1.2 (beta)", chats[0].Title)
+}
+
 // TestMongoChatReadModelRepository_FindByparticipant checks search chats po uchastniku
 func TestMongoChatReadModelRepository_FindByParticipant(t *testing.T) {
 	_, queryRepo, _, readModelColl := setupTestRepository(t)
@@ -544,6 +577,57 @@ func TestMongoChatReadModelRepository_Count(t *testing.T) {
 	assert.GreaterOrEqual(t, count, 5)
 }
 
+// TestMongoChatReadModelRepository_UnreadCounters checks the activity projection and read markers
+func TestMongoChatReadModelRepository_UnreadCounters(t *testing.T) {
+	_, queryRepo, _, readModelColl := setupTestRepository(t)
+	if queryRepo == nil {
+		return
+	}
+
+	ctx := context.Background()
+	authorID := uuid.NewUUID()
+	readerID := uuid.NewUUID()
+
+	c, err := chat.NewChat(uuid.NewUUID(), chat.TypeDiscussion, true, authorID)
+	require.NoError(t, err)
+	addChatToReadModel(ctx, t, readModelColl, c)
+
+	activity := projector.NewChatActivityProjector(readModelColl, nil)
+	post := func(author uuid.UUID) *message.Created {
+		evt := message.NewCreated(uuid.NewUUID(), c.ID(), author, "hello", "", event.Metadata{})
+		require.NoError(t, activity.ProcessEvent(ctx, evt))
+		return evt
+	}
+
+	post(authorID)
+	duplicate := post(authorID)
+	require.NoError(t, activity.ProcessEvent(ctx, duplicate), "redelivered event must not be counted twice")
+
+	rm, err := queryRepo.FindByID(ctx, c.ID())
+	require.NoError(t, err)
+	assert.Equal(t, 2, rm.MessageCount)
+	require.NotNil(t, rm.LastMessageAt)
+	assert.Equal(t, 0, rm.UnreadCount(authorID))
+	assert.Equal(t, 2, rm.UnreadCount(readerID))
+
+	require.NoError(t, queryRepo.MarkRead(ctx, c.ID(), readerID))
+	post(authorID)
+
+	rm, err = queryRepo.FindByID(ctx, c.ID())
+	require.NoError(t, err)
+	assert.Equal(t, 1, rm.UnreadCount(readerID))
+
+	// posting marks the chat as read for the author
+	post(readerID)
+
+	rm, err = queryRepo.FindByID(ctx, c.ID())
+	require.NoError(t, err)
+	assert.Equal(t, 0, rm.UnreadCount(readerID))
+	assert.Equal(t, 1, rm.UnreadCount(authorID))
+
+	require.ErrorIs(t, queryRepo.MarkRead(ctx, uuid.NewUUID(), readerID), errs.ErrNotFound)
+}
+
 // TestMongoChatRepository_InputValidation checks valid vhodnyh dannyh
 func TestMongoChatRepository_InputValidation(t *testing.T) {
 	commandRepo, queryRepo, _, _ := setupTestRepository(t)
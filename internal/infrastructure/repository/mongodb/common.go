@@ -122,6 +122,31 @@ func CountAll(ctx context.Context, coll *mongo.Collection) (int, error) {
 	return CountFilter(ctx, coll, bson.M{})
 }
 
+// FindIDs returns the _id of up to limit documents matching the filter.
+// Retention purges delete by these ids so every batch touches a bounded number of documents.
+func FindIDs(ctx context.Context, coll *mongo.Collection, filter bson.M, limit int) ([]any, error) {
+	opts := options.Find().
+		SetProjection(bson.M{"_id": 1}).
+		SetLimit(int64(limit))
+
+	cursor, err := coll.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	var docs []struct {
+		ID any `bson:"_id"`
+	}
+	if err = cursor.All(ctx, &docs); err != nil {
+		return nil, err
+	}
+
+	ids := make([]any, 0, len(docs))
+	for _, doc := range docs {
+		ids = append(ids, doc.ID)
+	}
+	return ids, nil
+}
+
 // DefaultLimit returns limit s applying defoltnogo values.
 // if limit <= 0, returns defaultLimit.
 func DefaultLimit(limit, defaultLimit int) int {
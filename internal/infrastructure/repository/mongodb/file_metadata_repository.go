@@ -18,6 +18,7 @@ type FileMetadata struct {
 	ChatID     uuid.UUID
 	UploaderID uuid.UUID
 	UploadedAt time.Time
+	FileName   string // empty for files uploaded before names were recorded
 }
 
 // fileMetadataDocument is the MongoDB representation of file metadata.
@@ -26,6 +27,7 @@ type fileMetadataDocument struct {
 	ChatID     string    `bson:"chat_id"`
 	UploaderID string    `bson:"uploader_id"`
 	UploadedAt time.Time `bson:"uploaded_at"`
+	FileName   string    `bson:"file_name,omitempty"`
 }
 
 // MongoFileMetadataRepository implements file metadata storage using MongoDB.
@@ -59,7 +61,8 @@ func NewMongoFileMetadataRepository(
 	return r
 }
 
-// Save stores file metadata.
+// Save stores file metadata. It returns errs.ErrAlreadyExists when metadata for the
+// file ID is already stored.
 func (r *MongoFileMetadataRepository) Save(ctx context.Context, meta FileMetadata) error {
 	if meta.FileID.IsZero() {
 		return errs.ErrInvalidInput
@@ -70,6 +73,7 @@ func (r *MongoFileMetadataRepository) Save(ctx context.Context, meta FileMetadat
 		ChatID:     meta.ChatID.String(),
 		UploaderID: meta.UploaderID.String(),
 		UploadedAt: meta.UploadedAt,
+		FileName:   meta.FileName,
 	}
 
 	_, err := r.collection.InsertOne(ctx, doc)
@@ -102,5 +106,18 @@ func (r *MongoFileMetadataRepository) FindByFileID(ctx context.Context, fileID u
 		ChatID:     uuid.UUID(doc.ChatID),
 		UploaderID: uuid.UUID(doc.UploaderID),
 		UploadedAt: doc.UploadedAt,
+		FileName:   doc.FileName,
 	}, nil
 }
+
+// Delete removes the metadata of a file. Deleting missing metadata succeeds.
+func (r *MongoFileMetadataRepository) Delete(ctx context.Context, fileID uuid.UUID) error {
+	if fileID.IsZero() {
+		return errs.ErrInvalidInput
+	}
+
+	if _, err := r.collection.DeleteOne(ctx, bson.M{"file_id": fileID.String()}); err != nil {
+		return HandleMongoError(err, "file_metadata")
+	}
+	return nil
+}
@@ -0,0 +1,151 @@
+package mongodb
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+
+	"github.com/lllypuk/flowra/internal/domain/errs"
+	"github.com/lllypuk/flowra/internal/domain/inbox"
+	"github.com/lllypuk/flowra/internal/domain/uuid"
+)
+
+// inboxItemDocument is the MongoDB representation of a personal inbox item.
+type inboxItemDocument struct {
+	ItemID    string    `bson:"item_id"`
+	UserID    string    `bson:"user_id"`
+	Title     string    `bson:"title"`
+	CreatedAt time.Time `bson:"created_at"`
+}
+
+// MongoInboxRepository stores personal inbox items in MongoDB.
+type MongoInboxRepository struct {
+	collection *mongo.Collection
+	logger     *slog.Logger
+}
+
+// InboxRepoOption configures MongoInboxRepository.
+type InboxRepoOption func(*MongoInboxRepository)
+
+// WithInboxRepoLogger sets the logger for the inbox repository.
+func WithInboxRepoLogger(logger *slog.Logger) InboxRepoOption {
+	return func(r *MongoInboxRepository) {
+		r.logger = logger
+	}
+}
+
+// NewMongoInboxRepository creates a new inbox repository.
+func NewMongoInboxRepository(collection *mongo.Collection, opts ...InboxRepoOption) *MongoInboxRepository {
+	r := &MongoInboxRepository{
+		collection: collection,
+		logger:     slog.Default(),
+	}
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
+// Save creates or updates an inbox item.
+func (r *MongoInboxRepository) Save(ctx context.Context, item *inbox.Item) error {
+	if item == nil || item.ID().IsZero() {
+		return errs.ErrInvalidInput
+	}
+
+	doc := inboxItemDocument{
+		ItemID:    item.ID().String(),
+		UserID:    item.UserID().String(),
+		Title:     item.Title(),
+		CreatedAt: item.CreatedAt(),
+	}
+	filter := bson.M{"item_id": doc.ItemID}
+	if _, err := r.collection.ReplaceOne(ctx, filter, doc, options.Replace().SetUpsert(true)); err != nil {
+		r.logger.ErrorContext(ctx, "failed to save inbox item",
+			slog.String("item_id", doc.ItemID),
+			slog.String("error", err.Error()),
+		)
+		return HandleMongoError(err, "inbox_item")
+	}
+
+	return nil
+}
+
+// FindByID returns an inbox item by ID.
+func (r *MongoInboxRepository) FindByID(ctx context.Context, id uuid.UUID) (*inbox.Item, error) {
+	if id.IsZero() {
+		return nil, errs.ErrInvalidInput
+	}
+
+	var doc inboxItemDocument
+	if err := r.collection.FindOne(ctx, bson.M{"item_id": id.String()}).Decode(&doc); err != nil {
+		return nil, HandleMongoError(err, "inbox_item")
+	}
+
+	return documentToInboxItem(&doc), nil
+}
+
+// FindByUser returns the items of the user, most recently captured first.
+func (r *MongoInboxRepository) FindByUser(ctx context.Context, userID uuid.UUID) ([]*inbox.Item, error) {
+	if userID.IsZero() {
+		return nil, errs.ErrInvalidInput
+	}
+
+	opts := options.Find().SetSort(bson.D{{Key: "created_at", Value: -1}})
+	cursor, err := r.collection.Find(ctx, bson.M{"user_id": userID.String()}, opts)
+	if err != nil {
+		return nil, HandleMongoError(err, "inbox_items")
+	}
+	defer cursor.Close(ctx)
+
+	items := make([]*inbox.Item, 0)
+	for cursor.Next(ctx) {
+		var doc inboxItemDocument
+		if decodeErr := cursor.Decode(&doc); decodeErr != nil {
+			continue
+		}
+		items = append(items, documentToInboxItem(&doc))
+	}
+	if err = cursor.Err(); err != nil {
+		return nil, fmt.Errorf("cursor error: %w", err)
+	}
+
+	return items, nil
+}
+
+// CountByUser returns how many items the user has.
+func (r *MongoInboxRepository) CountByUser(ctx context.Context, userID uuid.UUID) (int, error) {
+	if userID.IsZero() {
+		return 0, errs.ErrInvalidInput
+	}
+
+	count, err := r.collection.CountDocuments(ctx, bson.M{"user_id": userID.String()})
+	if err != nil {
+		return 0, HandleMongoError(err, "inbox_items")
+	}
+	return int(count), nil
+}
+
+// Delete removes an inbox item; deleting a missing item is a no-op.
+func (r *MongoInboxRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	if id.IsZero() {
+		return errs.ErrInvalidInput
+	}
+
+	if _, err := r.collection.DeleteOne(ctx, bson.M{"item_id": id.String()}); err != nil {
+		r.logger.ErrorContext(ctx, "failed to delete inbox item",
+			slog.String("item_id", id.String()),
+			slog.String("error", err.Error()),
+		)
+		return HandleMongoError(err, "inbox_item")
+	}
+	return nil
+}
+
+func documentToInboxItem(doc *inboxItemDocument) *inbox.Item {
+	return inbox.Reconstruct(uuid.UUID(doc.ItemID), uuid.UUID(doc.UserID), doc.Title, doc.CreatedAt)
+}
@@ -0,0 +1,49 @@
+package mongodb_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/lllypuk/flowra/internal/domain/errs"
+	"github.com/lllypuk/flowra/internal/domain/inbox"
+	"github.com/lllypuk/flowra/internal/domain/uuid"
+	"github.com/lllypuk/flowra/internal/infrastructure/repository/mongodb"
+	"github.com/lllypuk/flowra/tests/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMongoInboxRepository_SaveFindDelete(t *testing.T) {
+	db := testutil.SetupTestMongoDB(t)
+	repo := mongodb.NewMongoInboxRepository(db.Collection("inbox_items"))
+	ctx := context.Background()
+	userID := uuid.NewUUID()
+
+	first, err := inbox.NewItem(userID, "Fix flaky CI")
+	require.NoError(t, err)
+	second, err := inbox.NewItem(userID, "Plan Q3")
+	require.NoError(t, err)
+	other, err := inbox.NewItem(uuid.NewUUID(), "Not mine")
+	require.NoError(t, err)
+	for _, item := range []*inbox.Item{first, second, other} {
+		require.NoError(t, repo.Save(ctx, item))
+	}
+
+	found, err := repo.FindByID(ctx, first.ID())
+	require.NoError(t, err)
+	assert.Equal(t, "Fix flaky CI", found.Title())
+	assert.Equal(t, userID, found.UserID())
+
+	items, err := repo.FindByUser(ctx, userID)
+	require.NoError(t, err)
+	require.Len(t, items, 2)
+	assert.Equal(t, second.ID(), items[0].ID())
+
+	count, err := repo.CountByUser(ctx, userID)
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+
+	require.NoError(t, repo.Delete(ctx, first.ID()))
+	_, err = repo.FindByID(ctx, first.ID())
+	require.ErrorIs(t, err, errs.ErrNotFound)
+}
@@ -133,6 +133,78 @@ func (r *MongoMessageRepository) FindByChatID(
 	return messages, nil
 }
 
+// StreamByChatID calls fn for every message of the chat, oldest first, reading
+// them from a cursor instead of loading the chat into memory. A non-zero after
+// resumes right behind that message; errs.ErrNotFound means it is not a message
+// of the chat. Messages with the same creation time are ordered by ID, so
+// resuming neither skips nor repeats any. An error from fn stops the stream and
+// is returned as is.
+func (r *MongoMessageRepository) StreamByChatID(
+	ctx context.Context,
+	chatID uuid.UUID,
+	after uuid.UUID,
+	fn func(*messagedomain.Message) error,
+) error {
+	if chatID.IsZero() {
+		return errs.ErrInvalidInput
+	}
+
+	filter := bson.M{"chat_id": chatID.String()}
+	if !after.IsZero() {
+		var last messageDocument
+		err := r.collection.FindOne(ctx, bson.M{"message_id": after.String(), "chat_id": chatID.String()}).
+			Decode(&last)
+		if err != nil {
+			return HandleMongoError(err, "message")
+		}
+		filter["$or"] = bson.A{
+			bson.M{"created_at": bson.M{"$gt": last.CreatedAt}},
+			bson.M{"created_at": last.CreatedAt, "message_id": bson.M{"$gt": last.MessageID}},
+		}
+	}
+
+	opts := options.Find().SetSort(bson.D{{Key: "created_at", Value: 1}, {Key: "message_id", Value: 1}})
+	cursor, err := r.collection.Find(ctx, filter, opts)
+	if err != nil {
+		r.logger.ErrorContext(ctx, "failed to stream messages by chat ID",
+			slog.String("chat_id", chatID.String()),
+			slog.String("error", err.Error()),
+		)
+		return HandleMongoError(err, "messages")
+	}
+	defer cursor.Close(ctx)
+
+	for cursor.Next(ctx) {
+		var doc messageDocument
+		if decodeErr := cursor.Decode(&doc); decodeErr != nil {
+			r.logger.WarnContext(ctx, "failed to decode message document",
+				slog.String("chat_id", chatID.String()),
+				slog.String("error", decodeErr.Error()),
+			)
+			continue
+		}
+
+		msg, docErr := r.documentToMessage(&doc)
+		if docErr != nil {
+			r.logger.WarnContext(ctx, "skipping invalid message document",
+				slog.String("chat_id", chatID.String()),
+				slog.String("error", docErr.Error()),
+			)
+			continue
+		}
+
+		if fnErr := fn(msg); fnErr != nil {
+			return fnErr
+		}
+	}
+
+	if err = cursor.Err(); err != nil {
+		return fmt.Errorf("cursor error: %w", err)
+	}
+
+	return nil
+}
+
 // FindThread finds all responses in thread
 func (r *MongoMessageRepository) FindThread(
 	ctx context.Context,
@@ -191,6 +263,25 @@ func (r *MongoMessageRepository) CountByChatID(ctx context.Context, chatID uuid.
 	return int(count), nil
 }
 
+// CountBefore returns the number of messages in the chat created before the given time.
+// It matches the FindByChatID filter, so the count is the message's offset in the chat history.
+func (r *MongoMessageRepository) CountBefore(ctx context.Context, chatID uuid.UUID, before time.Time) (int, error) {
+	if chatID.IsZero() {
+		return 0, errs.ErrInvalidInput
+	}
+
+	filter := bson.M{
+		"chat_id":    chatID.String(),
+		"created_at": bson.M{"$lt": before},
+	}
+	count, err := r.collection.CountDocuments(ctx, filter)
+	if err != nil {
+		return 0, HandleMongoError(err, "messages")
+	}
+
+	return int(count), nil
+}
+
 // Save saves message (creation or update)
 func (r *MongoMessageRepository) Save(ctx context.Context, message *messagedomain.Message) error {
 	if message == nil {
@@ -239,6 +330,124 @@ func (r *MongoMessageRepository) Delete(ctx context.Context, id uuid.UUID) error
 	return nil
 }
 
+// PurgeDeleted removes the content, attachments and reactions of up to limit messages
+// deleted before the given time, leaving their tombstones. Returns the number of purged messages.
+func (r *MongoMessageRepository) PurgeDeleted(ctx context.Context, deletedBefore time.Time, limit int) (int64, error) {
+	filter := bson.M{
+		"is_deleted": true,
+		"deleted_at": bson.M{"$lt": deletedBefore},
+		"purged_at":  bson.M{"$exists": false},
+	}
+
+	ids, err := FindIDs(ctx, r.collection, filter, limit)
+	if err != nil {
+		return 0, HandleMongoError(err, "messages")
+	}
+	if len(ids) == 0 {
+		return 0, nil
+	}
+
+	filter["_id"] = bson.M{"$in": ids}
+	update := bson.M{"$set": bson.M{
+		"content":          "",
+		"previous_content": "",
+		"attachments":      bson.A{},
+		"reactions":        bson.A{},
+		"purged_at":        time.Now().UTC(),
+	}}
+
+	result, err := r.collection.UpdateMany(ctx, filter, update)
+	if err != nil {
+		r.logger.ErrorContext(ctx, "failed to purge deleted messages",
+			slog.Time("deleted_before", deletedBefore),
+			slog.String("error", err.Error()),
+		)
+		return 0, HandleMongoError(err, "messages")
+	}
+
+	return result.ModifiedCount, nil
+}
+
+// FindPendingPreviews returns up to limit messages with attachments waiting for
+// their preview, oldest first. Deleted messages are skipped.
+func (r *MongoMessageRepository) FindPendingPreviews(
+	ctx context.Context,
+	limit int,
+) ([]*messagedomain.Message, error) {
+	if limit <= 0 {
+		return nil, errs.ErrInvalidInput
+	}
+
+	filter := bson.M{
+		"attachments.preview_status": string(messagedomain.PreviewPending),
+		"is_deleted":                 false,
+	}
+	opts := options.Find().
+		SetSort(bson.D{{Key: "created_at", Value: 1}}).
+		SetLimit(int64(limit))
+
+	cursor, err := r.collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, HandleMongoError(err, "messages")
+	}
+	defer cursor.Close(ctx)
+
+	messages := make([]*messagedomain.Message, 0, limit)
+	for cursor.Next(ctx) {
+		var doc messageDocument
+		if decodeErr := cursor.Decode(&doc); decodeErr != nil {
+			continue
+		}
+
+		msg, docErr := r.documentToMessage(&doc)
+		if docErr != nil {
+			continue
+		}
+
+		messages = append(messages, msg)
+	}
+
+	return messages, cursor.Err()
+}
+
+// UpdateAttachmentPreview sets the preview state and size of one attachment.
+// Only the attachment is updated, so concurrent changes to the message are kept.
+func (r *MongoMessageRepository) UpdateAttachmentPreview(
+	ctx context.Context,
+	messageID uuid.UUID,
+	fileID uuid.UUID,
+	status messagedomain.PreviewStatus,
+	width, height int,
+) error {
+	if messageID.IsZero() || fileID.IsZero() {
+		return errs.ErrInvalidInput
+	}
+
+	filter := bson.M{"message_id": messageID.String()}
+	update := bson.M{"$set": bson.M{
+		"attachments.$[a].preview_status": string(status),
+		"attachments.$[a].preview_width":  width,
+		"attachments.$[a].preview_height": height,
+	}}
+	opts := options.UpdateOne().SetArrayFilters([]any{bson.M{"a.file_id": fileID.String()}})
+
+	result, err := r.collection.UpdateOne(ctx, filter, update, opts)
+	if err != nil {
+		r.logger.ErrorContext(ctx, "failed to update attachment preview",
+			slog.String("message_id", messageID.String()),
+			slog.String("file_id", fileID.String()),
+			slog.String("error", err.Error()),
+		)
+		return HandleMongoError(err, "message")
+	}
+
+	if result.MatchedCount == 0 {
+		return errs.ErrNotFound
+	}
+
+	return nil
+}
+
 // CountThreadReplies returns count response in thread
 func (r *MongoMessageRepository) CountThreadReplies(
 	ctx context.Context,
@@ -482,19 +691,23 @@ func (r *MongoMessageRepository) FindByAuthor(
 
 // messageDocument represents strukturu dokumenta in MongoDB
 type messageDocument struct {
-	MessageID   string               `bson:"message_id"`
-	ChatID      string               `bson:"chat_id"`
-	AuthorID    string               `bson:"sent_by"`
-	Content     string               `bson:"content"`
-	Type        string               `bson:"type"`               // message type
-	ActorID     *string              `bson:"actor_id,omitempty"` // who initiated (for system messages)
-	ParentID    *string              `bson:"parent_id,omitempty"`
-	CreatedAt   time.Time            `bson:"created_at"`
-	EditedAt    *time.Time           `bson:"edited_at,omitempty"`
-	IsDeleted   bool                 `bson:"is_deleted"`
-	DeletedAt   *time.Time           `bson:"deleted_at,omitempty"`
-	Attachments []attachmentDocument `bson:"attachments"`
-	Reactions   []reactionDocument   `bson:"reactions"`
+	MessageID       string               `bson:"message_id"`
+	ChatID          string               `bson:"chat_id"`
+	AuthorID        string               `bson:"sent_by"`
+	Content         string               `bson:"content"`
+	PreviousContent string               `bson:"previous_content"`   // content before the last edit, for undo
+	Type            string               `bson:"type"`               // message type
+	ActorID         *string              `bson:"actor_id,omitempty"` // who initiated (for system messages)
+	ParentID        *string              `bson:"parent_id,omitempty"`
+	QuotedID        *string              `bson:"quoted_id,omitempty"`
+	CreatedAt       time.Time            `bson:"created_at"`
+	EditedAt        *time.Time           `bson:"edited_at,omitempty"`
+	IsDeleted       bool                 `bson:"is_deleted"`
+	DeletedAt       *time.Time           `bson:"deleted_at"` // cleared when a deletion is undone
+	PurgedAt        *time.Time           `bson:"purged_at,omitempty"`
+	Attachments     []attachmentDocument `bson:"attachments"`
+	Reactions       []reactionDocument   `bson:"reactions"`
+	Poll            *pollDocument        `bson:"poll"` // cleared when the message is purged
 }
 
 // attachmentDocument represents attachment in dokumente
@@ -503,6 +716,13 @@ type attachmentDocument struct {
 	FileName string `bson:"file_name"`
 	FileSize int64  `bson:"file_size"`
 	MimeType string `bson:"mime_type"`
+	// audio attachments only
+	DurationMS int64 `bson:"duration_ms,omitempty"`
+	Waveform   []int `bson:"waveform,omitempty"`
+	// images and PDFs only
+	PreviewStatus string `bson:"preview_status,omitempty"`
+	PreviewWidth  int    `bson:"preview_width,omitempty"`
+	PreviewHeight int    `bson:"preview_height,omitempty"`
 }
 
 // reactionDocument represents reaction in dokumente
@@ -512,16 +732,106 @@ type reactionDocument struct {
 	AddedAt   time.Time `bson:"added_at"`
 }
 
+// pollDocument represents poll in dokumente
+type pollDocument struct {
+	Question  string               `bson:"question"`
+	Options   []pollOptionDocument `bson:"options"`
+	Anonymous bool                 `bson:"anonymous"`
+	ClosesAt  *time.Time           `bson:"closes_at,omitempty"`
+	ClosedAt  *time.Time           `bson:"closed_at,omitempty"`
+	Votes     []pollVoteDocument   `bson:"votes"`
+}
+
+// pollOptionDocument represents poll option in dokumente
+type pollOptionDocument struct {
+	OptionID string `bson:"option_id"`
+	Text     string `bson:"text"`
+}
+
+// pollVoteDocument represents poll vote in dokumente
+type pollVoteDocument struct {
+	UserID   string    `bson:"user_id"`
+	OptionID string    `bson:"option_id"`
+	VotedAt  time.Time `bson:"voted_at"`
+}
+
+// pollToDocument preobrazuet Poll in Document
+func pollToDocument(poll *messagedomain.Poll) *pollDocument {
+	if poll == nil {
+		return nil
+	}
+
+	options := make([]pollOptionDocument, 0, len(poll.Options()))
+	for _, o := range poll.Options() {
+		options = append(options, pollOptionDocument{
+			OptionID: o.ID().String(),
+			Text:     o.Text(),
+		})
+	}
+
+	votes := make([]pollVoteDocument, 0, len(poll.Votes()))
+	for _, v := range poll.Votes() {
+		votes = append(votes, pollVoteDocument{
+			UserID:   v.UserID().String(),
+			OptionID: v.OptionID().String(),
+			VotedAt:  v.VotedAt(),
+		})
+	}
+
+	return &pollDocument{
+		Question:  poll.Question(),
+		Options:   options,
+		Anonymous: poll.IsAnonymous(),
+		ClosesAt:  poll.ClosesAt(),
+		ClosedAt:  poll.ClosedAt(),
+		Votes:     votes,
+	}
+}
+
+// documentToPoll preobrazuet Document in Poll
+func documentToPoll(doc *pollDocument) *messagedomain.Poll {
+	if doc == nil {
+		return nil
+	}
+
+	options := make([]messagedomain.PollOption, 0, len(doc.Options))
+	for _, o := range doc.Options {
+		optionID, err := uuid.ParseUUID(o.OptionID)
+		if err != nil {
+			continue // propuskaem nekorrektnye varianty
+		}
+		options = append(options, messagedomain.ReconstructPollOption(optionID, o.Text))
+	}
+
+	votes := make([]messagedomain.PollVote, 0, len(doc.Votes))
+	for _, v := range doc.Votes {
+		userID, userErr := uuid.ParseUUID(v.UserID)
+		optionID, optionErr := uuid.ParseUUID(v.OptionID)
+		if userErr != nil || optionErr != nil {
+			continue // propuskaem nekorrektnye golosa
+		}
+		votes = append(votes, messagedomain.ReconstructPollVote(userID, optionID, v.VotedAt))
+	}
+
+	return messagedomain.ReconstructPoll(doc.Question, options, doc.Anonymous, doc.ClosesAt, doc.ClosedAt, votes)
+}
+
 // messageToDocument preobrazuet Message in Document
 func (r *MongoMessageRepository) messageToDocument(msg *messagedomain.Message) messageDocument {
 	// preobrazuem vlozheniya
 	attachments := make([]attachmentDocument, 0, len(msg.Attachments()))
 	for _, a := range msg.Attachments() {
+		previewWidth, previewHeight := a.PreviewSize()
 		attachments = append(attachments, attachmentDocument{
-			FileID:   a.FileID().String(),
-			FileName: a.FileName(),
-			FileSize: a.FileSize(),
-			MimeType: a.MimeType(),
+			FileID:        a.FileID().String(),
+			FileName:      a.FileName(),
+			FileSize:      a.FileSize(),
+			MimeType:      a.MimeType(),
+			DurationMS:    a.Duration().Milliseconds(),
+			Waveform:      a.Waveform(),
+			PreviewStatus: string(a.PreviewStatus()),
+			PreviewWidth:  previewWidth,
+			PreviewHeight: previewHeight,
 		})
 	}
 
@@ -542,6 +852,13 @@ func (r *MongoMessageRepository) messageToDocument(msg *messagedomain.Message) m
 		parentID = &parentIDStr
 	}
 
+	// obrabatyvaem quoted message ID
+	var quotedID *string
+	if msg.HasQuote() {
+		quotedIDStr := msg.QuotedMessageID().String()
+		quotedID = &quotedIDStr
+	}
+
 	// obrabatyvaem actor ID
 	var actorID *string
 	if msg.ActorID() != nil && !msg.ActorID().IsZero() {
@@ -556,19 +873,23 @@ func (r *MongoMessageRepository) messageToDocument(msg *messagedomain.Message) m
 	}
 
 	return messageDocument{
-		MessageID:   msg.ID().String(),
-		ChatID:      msg.ChatID().String(),
-		AuthorID:    msg.AuthorID().String(),
-		Content:     msg.Content(),
-		Type:        msgType,
-		ActorID:     actorID,
-		ParentID:    parentID,
-		CreatedAt:   msg.CreatedAt(),
-		EditedAt:    msg.EditedAt(),
-		IsDeleted:   msg.IsDeleted(),
-		DeletedAt:   msg.DeletedAt(),
-		Attachments: attachments,
-		Reactions:   reactions,
+		MessageID:       msg.ID().String(),
+		ChatID:          msg.ChatID().String(),
+		AuthorID:        msg.AuthorID().String(),
+		Content:         msg.Content(),
+		PreviousContent: msg.PreviousContent(),
+		Type:            msgType,
+		ActorID:         actorID,
+		ParentID:        parentID,
+		QuotedID:        quotedID,
+		CreatedAt:       msg.CreatedAt(),
+		EditedAt:        msg.EditedAt(),
+		IsDeleted:       msg.IsDeleted(),
+		DeletedAt:       msg.DeletedAt(),
+		PurgedAt:        msg.PurgedAt(),
+		Attachments:     attachments,
+		Reactions:       reactions,
+		Poll:            pollToDocument(msg.Poll()),
 	}
 }
 
@@ -601,6 +922,14 @@ func (r *MongoMessageRepository) documentToMessage(doc *messageDocument) (*messa
 		}
 	}
 
+	var quotedMessageID uuid.UUID
+	if doc.QuotedID != nil {
+		quotedMessageID, err = uuid.ParseUUID(*doc.QuotedID)
+		if err != nil {
+			return nil, errs.ErrInvalidInput
+		}
+	}
+
 	// vosstanavlivaem vlozheniya
 	attachments := make([]messagedomain.Attachment, 0, len(doc.Attachments))
 	for _, a := range doc.Attachments {
@@ -608,12 +937,23 @@ func (r *MongoMessageRepository) documentToMessage(doc *messageDocument) (*messa
 		if parseErr != nil {
 			continue // propuskaem nekorrektnye vlozheniya
 		}
+		if a.DurationMS > 0 {
+			attachments = append(attachments, messagedomain.ReconstructAudioAttachment(
+				fileID,
+				a.FileName,
+				a.FileSize,
+				a.MimeType,
+				time.Duration(a.DurationMS)*time.Millisecond,
+				a.Waveform,
+			))
+			continue
+		}
 		attachments = append(attachments, messagedomain.ReconstructAttachment(
 			fileID,
 			a.FileName,
 			a.FileSize,
 			a.MimeType,
-		))
+		).WithPreview(messagedomain.PreviewStatus(a.PreviewStatus), a.PreviewWidth, a.PreviewHeight))
 	}
 
 	// vosstanavlivaem reaktsii
@@ -650,14 +990,18 @@ func (r *MongoMessageRepository) documentToMessage(doc *messageDocument) (*messa
 		chatID,
 		authorID,
 		doc.Content,
+		doc.PreviousContent,
 		parentMessageID,
 		doc.CreatedAt,
 		doc.EditedAt,
 		doc.IsDeleted,
 		doc.DeletedAt,
+		doc.PurgedAt,
 		attachments,
 		reactions,
 		msgType,
 		actorID,
+		quotedMessageID,
+		documentToPoll(doc.Poll),
 	), nil
 }
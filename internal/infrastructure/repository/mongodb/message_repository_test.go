@@ -2,6 +2,7 @@ package mongodb_test
 
 import (
 	"context"
+	"errors"
 	"testing"
 	"time"
 
@@ -125,6 +126,48 @@ func TestMongoMessageRepository_FindByChatID(t *testing.T) {
 	assert.Empty(t, messages)
 }
 
+// TestMongoMessageRepository_StreamByChatID checks streaming a chat and resuming after a message
+func TestMongoMessageRepository_StreamByChatID(t *testing.T) {
+	repo := setupTestMessageRepository(t)
+	ctx := context.Background()
+
+	chatID := uuid.NewUUID()
+	authorID := uuid.NewUUID()
+
+	var saved []uuid.UUID
+	for i := range 5 {
+		msg := createTestMessage(t, chatID, authorID, "Message "+string(rune('A'+i)))
+		require.NoError(t, repo.Save(ctx, msg))
+		saved = append(saved, msg.ID())
+		time.Sleep(10 * time.Millisecond)
+	}
+	require.NoError(t, repo.Save(ctx, createTestMessage(t, uuid.NewUUID(), authorID, "Other chat")))
+
+	collect := func(after uuid.UUID) ([]uuid.UUID, error) {
+		var ids []uuid.UUID
+		err := repo.StreamByChatID(ctx, chatID, after, func(msg *messagedomain.Message) error {
+			ids = append(ids, msg.ID())
+			return nil
+		})
+		return ids, err
+	}
+
+	ids, err := collect("")
+	require.NoError(t, err)
+	assert.Equal(t, saved, ids)
+
+	ids, err = collect(saved[2])
+	require.NoError(t, err)
+	assert.Equal(t, saved[3:], ids)
+
+	_, err = collect(uuid.NewUUID())
+	require.ErrorIs(t, err, errs.ErrNotFound)
+
+	stop := errors.New("stop")
+	err = repo.StreamByChatID(ctx, chatID, "", func(*messagedomain.Message) error { return stop })
+	require.ErrorIs(t, err, stop)
+}
+
 // TestMongoMessageRepository_FindThread checks search treda
 func TestMongoMessageRepository_FindThread(t *testing.T) {
 	repo := setupTestMessageRepository(t)
@@ -660,6 +703,51 @@ func TestMongoMessageRepository_SoftDelete(t *testing.T) {
 	assert.NotNil(t, loaded.DeletedAt())
 }
 
+// TestMongoMessageRepository_PurgeDeleted checks that only messages deleted before the cutoff are purged
+func TestMongoMessageRepository_PurgeDeleted(t *testing.T) {
+	repo := setupTestMessageRepository(t)
+	ctx := context.Background()
+
+	chatID := uuid.NewUUID()
+	authorID := uuid.NewUUID()
+
+	old := createTestMessage(t, chatID, authorID, "Deleted long ago")
+	require.NoError(t, old.AddReaction(uuid.NewUUID(), "👍"))
+	require.NoError(t, old.Delete(authorID))
+	require.NoError(t, repo.Save(ctx, old))
+
+	older := createTestMessage(t, chatID, authorID, "Deleted even earlier")
+	require.NoError(t, older.Delete(authorID))
+	require.NoError(t, repo.Save(ctx, older))
+
+	kept := createTestMessage(t, chatID, authorID, "Still visible")
+	require.NoError(t, repo.Save(ctx, kept))
+
+	purged, err := repo.PurgeDeleted(ctx, time.Now().Add(time.Minute), 1)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), purged, "a batch is capped by the limit")
+
+	purged, err = repo.PurgeDeleted(ctx, time.Now().Add(time.Minute), 10)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), purged)
+
+	loaded, err := repo.FindByID(ctx, old.ID())
+	require.NoError(t, err)
+	assert.True(t, loaded.IsDeleted())
+	assert.True(t, loaded.IsPurged())
+	assert.Empty(t, loaded.Content())
+	assert.Empty(t, loaded.Reactions())
+
+	loaded, err = repo.FindByID(ctx, kept.ID())
+	require.NoError(t, err)
+	assert.Equal(t, "Still visible", loaded.Content())
+
+	// A second run finds nothing left to purge
+	purged, err = repo.PurgeDeleted(ctx, time.Now().Add(time.Minute), 10)
+	require.NoError(t, err)
+	assert.Zero(t, purged)
+}
+
 // TestMongoMessageRepository_WithAttachments checks save messages s vlozheniyami
 func TestMongoMessageRepository_WithAttachments(t *testing.T) {
 	repo := setupTestMessageRepository(t)
@@ -678,6 +766,9 @@ func TestMongoMessageRepository_WithAttachments(t *testing.T) {
 	err = msg.AddAttachment(uuid.NewUUID(), "image.png", 2048, "image/png")
 	require.NoError(t, err)
 
+	err = msg.AddAudioAttachment(uuid.NewUUID(), "voice.webm", 4096, "audio/webm", 1500*time.Millisecond, []int{5, 90})
+	require.NoError(t, err)
+
 	// Save message
 	err = repo.Save(ctx, msg)
 	require.NoError(t, err)
@@ -685,13 +776,80 @@ func TestMongoMessageRepository_WithAttachments(t *testing.T) {
 	// Load and verify attachments
 	loaded, err := repo.FindByID(ctx, msg.ID())
 	require.NoError(t, err)
-	assert.Len(t, loaded.Attachments(), 2)
+	assert.Len(t, loaded.Attachments(), 3)
 
 	// Verify attachment fields
 	attachments := loaded.Attachments()
 	assert.Equal(t, "document.pdf", attachments[0].FileName())
 	assert.Equal(t, int64(1024), attachments[0].FileSize())
 	assert.Equal(t, "application/pdf", attachments[0].MimeType())
+	assert.Zero(t, attachments[0].Duration())
+	assert.Equal(t, 1500*time.Millisecond, attachments[2].Duration())
+	assert.Equal(t, []int{5, 90}, attachments[2].Waveform())
+	assert.Equal(t, messagedomain.PreviewPending, attachments[1].PreviewStatus())
+	assert.Equal(t, messagedomain.PreviewNone, attachments[2].PreviewStatus())
+}
+
+// TestMongoMessageRepository_AttachmentPreviews checks the queue of pending previews
+func TestMongoMessageRepository_AttachmentPreviews(t *testing.T) {
+	repo := setupTestMessageRepository(t)
+	ctx := context.Background()
+
+	chatID := uuid.NewUUID()
+	authorID := uuid.NewUUID()
+
+	withImage := createTestMessage(t, chatID, authorID, "Photo")
+	imageID := uuid.NewUUID()
+	require.NoError(t, withImage.AddAttachment(imageID, "photo.jpg", 2048, "image/jpeg"))
+	require.NoError(t, withImage.AddAttachment(uuid.NewUUID(), "notes.txt", 10, "text/plain"))
+	require.NoError(t, repo.Save(ctx, withImage))
+
+	withText := createTestMessage(t, chatID, authorID, "Notes")
+	require.NoError(t, withText.AddAttachment(uuid.NewUUID(), "notes.txt", 10, "text/plain"))
+	require.NoError(t, repo.Save(ctx, withText))
+
+	pending, err := repo.FindPendingPreviews(ctx, 10)
+	require.NoError(t, err)
+	require.Len(t, pending, 1)
+	assert.Equal(t, withImage.ID(), pending[0].ID())
+
+	require.NoError(t, repo.UpdateAttachmentPreview(ctx, withImage.ID(), imageID, messagedomain.PreviewReady, 320, 240))
+
+	loaded, err := repo.FindByID(ctx, withImage.ID())
+	require.NoError(t, err)
+	assert.True(t, loaded.Attachments()[0].HasPreview())
+	width, height := loaded.Attachments()[0].PreviewSize()
+	assert.Equal(t, 320, width)
+	assert.Equal(t, 240, height)
+	assert.Equal(t, messagedomain.PreviewNone, loaded.Attachments()[1].PreviewStatus())
+
+	pending, err = repo.FindPendingPreviews(ctx, 10)
+	require.NoError(t, err)
+	assert.Empty(t, pending)
+
+	err = repo.UpdateAttachmentPreview(ctx, uuid.NewUUID(), imageID, messagedomain.PreviewFailed, 0, 0)
+	require.ErrorIs(t, err, errs.ErrNotFound)
+}
+
+// TestMongoMessageRepository_WithQuote checks save messages s quoted message
+func TestMongoMessageRepository_WithQuote(t *testing.T) {
+	repo := setupTestMessageRepository(t)
+	ctx := context.Background()
+
+	chatID := uuid.NewUUID()
+	authorID := uuid.NewUUID()
+
+	quoted := createTestMessage(t, chatID, authorID, "Original")
+	msg := createTestMessage(t, chatID, authorID, "Answer")
+	require.NoError(t, msg.Quote(quoted))
+
+	require.NoError(t, repo.Save(ctx, msg))
+
+	loaded, err := repo.FindByID(ctx, msg.ID())
+	require.NoError(t, err)
+	assert.True(t, loaded.HasQuote())
+	assert.Equal(t, quoted.ID(), loaded.QuotedMessageID())
+	assert.False(t, loaded.IsReply())
 }
 
 // TestMongoMessageRepository_WithReactions checks save messages s reactionsami via domain
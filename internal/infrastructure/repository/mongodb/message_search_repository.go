@@ -0,0 +1,244 @@
+package mongodb
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+
+	searchapp "github.com/lllypuk/flowra/internal/application/search"
+	chatdomain "github.com/lllypuk/flowra/internal/domain/chat"
+	"github.com/lllypuk/flowra/internal/domain/errs"
+	"github.com/lllypuk/flowra/internal/domain/uuid"
+	mongodbinfra "github.com/lllypuk/flowra/internal/infrastructure/mongodb"
+)
+
+// textScoreSort ranks full-text matches best first, newest first among equals.
+var textScoreSort = bson.D{ //nolint:gochecknoglobals // read-only sort specification
+	{Key: "score", Value: bson.M{"$meta": "textScore"}},
+	{Key: "created_at", Value: -1},
+}
+
+// searchChatDocument is the part of a chat read model document search reads.
+type searchChatDocument struct {
+	ChatID         string     `bson:"chat_id"`
+	Title          string     `bson:"title"`
+	Type           string     `bson:"type"`
+	Status         string     `bson:"status"`
+	CreatedAt      time.Time  `bson:"created_at"`
+	LastActivityAt *time.Time `bson:"last_activity_at"`
+}
+
+// MongoMessageSearchRepository implements searchapp.Repository with the text
+// indexes of the messages and chats_read_model collections.
+type MongoMessageSearchRepository struct {
+	messages *mongo.Collection
+	chats    *mongo.Collection
+	logger   *slog.Logger
+}
+
+// MessageSearchRepoOption configures MongoMessageSearchRepository.
+type MessageSearchRepoOption func(*MongoMessageSearchRepository)
+
+// WithMessageSearchRepoLogger sets the logger for the message search repository.
+func WithMessageSearchRepoLogger(logger *slog.Logger) MessageSearchRepoOption {
+	return func(r *MongoMessageSearchRepository) {
+		r.logger = logger
+	}
+}
+
+// NewMongoMessageSearchRepository creates a new message search repository.
+func NewMongoMessageSearchRepository(
+	messages, chats *mongo.Collection,
+	opts ...MessageSearchRepoOption,
+) *MongoMessageSearchRepository {
+	r := &MongoMessageSearchRepository{
+		messages: messages,
+		chats:    chats,
+		logger:   slog.Default(),
+	}
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
+// ReadableChats returns the public chats of the workspace and those the user participates in.
+func (r *MongoMessageSearchRepository) ReadableChats(
+	ctx context.Context,
+	workspaceID, userID uuid.UUID,
+) ([]searchapp.ChatRef, error) {
+	if workspaceID.IsZero() || userID.IsZero() {
+		return nil, errs.ErrInvalidInput
+	}
+
+	opts := options.Find().SetProjection(bson.M{"chat_id": 1, "title": 1, "type": 1})
+	cursor, err := r.chats.Find(ctx, readableChatsFilter(workspaceID, userID), opts)
+	if err != nil {
+		return nil, HandleMongoError(err, mongodbinfra.CollectionChatReadModel)
+	}
+	defer cursor.Close(ctx)
+
+	refs := make([]searchapp.ChatRef, 0)
+	for cursor.Next(ctx) {
+		var doc searchChatDocument
+		if decodeErr := cursor.Decode(&doc); decodeErr != nil {
+			return nil, fmt.Errorf("failed to decode chat read model: %w", decodeErr)
+		}
+		chatID, parseErr := uuid.ParseUUID(doc.ChatID)
+		if parseErr != nil {
+			continue
+		}
+		refs = append(refs, searchapp.ChatRef{ID: chatID, Title: doc.Title, Type: chatdomain.Type(doc.Type)})
+	}
+	if err = cursor.Err(); err != nil {
+		return nil, fmt.Errorf("cursor error: %w", err)
+	}
+	return refs, nil
+}
+
+// SearchMessages returns the non-deleted messages of the chats matching the text, best match first.
+func (r *MongoMessageSearchRepository) SearchMessages(
+	ctx context.Context,
+	chatIDs []uuid.UUID,
+	text string,
+	offset, limit int,
+) ([]searchapp.MessageHit, int, error) {
+	if text == "" {
+		return nil, 0, errs.ErrInvalidInput
+	}
+	if len(chatIDs) == 0 {
+		return []searchapp.MessageHit{}, 0, nil
+	}
+
+	filter := bson.M{
+		"$text":      bson.M{"$search": text},
+		"chat_id":    bson.M{"$in": uuidStrings(chatIDs)},
+		"is_deleted": false,
+	}
+	total, err := r.messages.CountDocuments(ctx, filter)
+	if err != nil {
+		return nil, 0, HandleMongoError(err, mongodbinfra.CollectionMessages)
+	}
+
+	opts := options.Find().
+		SetProjection(bson.M{
+			"message_id": 1, "chat_id": 1, "sent_by": 1, "content": 1, "created_at": 1,
+			"score": bson.M{"$meta": "textScore"},
+		}).
+		SetSort(textScoreSort).
+		SetSkip(int64(offset)).
+		SetLimit(int64(limit))
+	cursor, err := r.messages.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, 0, HandleMongoError(err, mongodbinfra.CollectionMessages)
+	}
+	defer cursor.Close(ctx)
+
+	hits := make([]searchapp.MessageHit, 0, limit)
+	for cursor.Next(ctx) {
+		var doc messageDocument
+		if decodeErr := cursor.Decode(&doc); decodeErr != nil {
+			r.logger.WarnContext(ctx, "skipping undecodable message in search results",
+				slog.String("error", decodeErr.Error()))
+			continue
+		}
+		messageID, idErr := uuid.ParseUUID(doc.MessageID)
+		chatID, chatErr := uuid.ParseUUID(doc.ChatID)
+		if idErr != nil || chatErr != nil {
+			continue
+		}
+		authorID, _ := uuid.ParseUUID(doc.AuthorID) // system messages have no author
+		hits = append(hits, searchapp.MessageHit{
+			MessageID: messageID,
+			ChatID:    chatID,
+			AuthorID:  authorID,
+			Content:   doc.Content,
+			CreatedAt: doc.CreatedAt,
+		})
+	}
+	if err = cursor.Err(); err != nil {
+		return nil, 0, fmt.Errorf("cursor error: %w", err)
+	}
+	return hits, int(total), nil
+}
+
+// SearchChats returns the readable discussions, or tasks, of the workspace matching the text by title.
+func (r *MongoMessageSearchRepository) SearchChats(
+	ctx context.Context,
+	filter searchapp.ChatFilter,
+	offset, limit int,
+) ([]searchapp.ChatHit, int, error) {
+	if filter.WorkspaceID.IsZero() || filter.UserID.IsZero() || filter.Text == "" {
+		return nil, 0, errs.ErrInvalidInput
+	}
+
+	query := readableChatsFilter(filter.WorkspaceID, filter.UserID)
+	query["$text"] = bson.M{"$search": filter.Text}
+	if filter.Tasks {
+		query["type"] = bson.M{"$ne": string(chatdomain.TypeDiscussion)}
+	} else {
+		query["type"] = string(chatdomain.TypeDiscussion)
+	}
+
+	total, err := r.chats.CountDocuments(ctx, query)
+	if err != nil {
+		return nil, 0, HandleMongoError(err, mongodbinfra.CollectionChatReadModel)
+	}
+
+	opts := options.Find().
+		SetProjection(bson.M{
+			"chat_id": 1, "title": 1, "type": 1, "status": 1, "created_at": 1, "last_activity_at": 1,
+			"score": bson.M{"$meta": "textScore"},
+		}).
+		SetSort(textScoreSort).
+		SetSkip(int64(offset)).
+		SetLimit(int64(limit))
+	cursor, err := r.chats.Find(ctx, query, opts)
+	if err != nil {
+		return nil, 0, HandleMongoError(err, mongodbinfra.CollectionChatReadModel)
+	}
+	defer cursor.Close(ctx)
+
+	hits := make([]searchapp.ChatHit, 0, limit)
+	for cursor.Next(ctx) {
+		var doc searchChatDocument
+		if decodeErr := cursor.Decode(&doc); decodeErr != nil {
+			return nil, 0, fmt.Errorf("failed to decode chat read model: %w", decodeErr)
+		}
+		chatID, parseErr := uuid.ParseUUID(doc.ChatID)
+		if parseErr != nil {
+			continue
+		}
+		lastActivity := doc.CreatedAt
+		if doc.LastActivityAt != nil {
+			lastActivity = *doc.LastActivityAt
+		}
+		hits = append(hits, searchapp.ChatHit{
+			ChatID:       chatID,
+			Title:        doc.Title,
+			Type:         chatdomain.Type(doc.Type),
+			Status:       doc.Status,
+			LastActivity: lastActivity,
+		})
+	}
+	if err = cursor.Err(); err != nil {
+		return nil, 0, fmt.Errorf("cursor error: %w", err)
+	}
+	return hits, int(total), nil
+}
+
+// readableChatsFilter matches the public chats of the workspace and those the user participates in.
+func readableChatsFilter(workspaceID, userID uuid.UUID) bson.M {
+	return bson.M{
+		"workspace_id": workspaceID.String(),
+		"$or": bson.A{
+			bson.M{"is_public": true},
+			bson.M{"participants": userID.String()},
+		},
+	}
+}
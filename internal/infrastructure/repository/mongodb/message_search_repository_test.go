@@ -0,0 +1,94 @@
+package mongodb_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/v2/bson"
+
+	searchapp "github.com/lllypuk/flowra/internal/application/search"
+	"github.com/lllypuk/flowra/internal/domain/uuid"
+	mongodbinfra "github.com/lllypuk/flowra/internal/infrastructure/mongodb"
+	"github.com/lllypuk/flowra/internal/infrastructure/repository/mongodb"
+	"github.com/lllypuk/flowra/tests/testutil"
+)
+
+func TestMongoMessageSearchRepository_Search(t *testing.T) {
+	db := testutil.SetupTestMongoDB(t)
+	ctx := context.Background()
+	for _, collection := range []string{mongodbinfra.CollectionMessages, mongodbinfra.CollectionChatReadModel} {
+		require.NoError(t, mongodbinfra.CreateCollectionIndexes(ctx, db, collection))
+	}
+	repo := mongodb.NewMongoMessageSearchRepository(
+		db.Collection(mongodbinfra.CollectionMessages),
+		db.Collection(mongodbinfra.CollectionChatReadModel),
+	)
+
+	workspaceID, userID := uuid.NewUUID(), uuid.NewUUID()
+	public, private, mine, otherWorkspace := uuid.NewUUID(), uuid.NewUUID(), uuid.NewUUID(), uuid.NewUUID()
+	now := time.Now().UTC()
+	_, err := db.Collection(mongodbinfra.CollectionChatReadModel).InsertMany(ctx, []any{
+		bson.M{"chat_id": public.String(), "workspace_id": workspaceID.String(), "type": "discussion",
+			"title": "Deployment talk", "is_public": true, "participants": bson.A{}, "created_at": now},
+		bson.M{"chat_id": private.String(), "workspace_id": workspaceID.String(), "type": "discussion",
+			"title": "Deployment secrets", "is_public": false, "participants": bson.A{}, "created_at": now},
+		bson.M{"chat_id": mine.String(), "workspace_id": workspaceID.String(), "type": "task",
+			"title": "Deployment pipeline", "is_public": false, "participants": bson.A{userID.String()},
+			"status": "In Progress", "created_at": now},
+		bson.M{"chat_id": otherWorkspace.String(), "workspace_id": uuid.NewUUID().String(), "type": "discussion",
+			"title": "Deployment elsewhere", "is_public": true, "participants": bson.A{}, "created_at": now},
+	})
+	require.NoError(t, err)
+
+	chats, err := repo.ReadableChats(ctx, workspaceID, userID)
+	require.NoError(t, err)
+	require.Len(t, chats, 2)
+	assert.ElementsMatch(t, []uuid.UUID{public, mine}, []uuid.UUID{chats[0].ID, chats[1].ID})
+
+	message := func(chatID uuid.UUID, content string, deleted bool) bson.M {
+		return bson.M{"message_id": uuid.NewUUID().String(), "chat_id": chatID.String(),
+			"sent_by": userID.String(), "content": content, "created_at": now, "is_deleted": deleted}
+	}
+	_, err = db.Collection(mongodbinfra.CollectionMessages).InsertMany(ctx, []any{
+		message(public, "the release is on friday", false),
+		message(mine, "release checklist is done", false),
+		message(mine, "deleted release note", true),
+		message(private, "secret release", false),
+		message(public, "unrelated chatter", false),
+	})
+	require.NoError(t, err)
+
+	hits, total, err := repo.SearchMessages(ctx, []uuid.UUID{public, mine}, "release", 0, 10)
+	require.NoError(t, err)
+	assert.Equal(t, 2, total)
+	require.Len(t, hits, 2)
+	for _, hit := range hits {
+		assert.Contains(t, hit.Content, "release")
+		assert.Equal(t, userID, hit.AuthorID)
+	}
+
+	paged, total, err := repo.SearchMessages(ctx, []uuid.UUID{public, mine}, "release", 1, 10)
+	require.NoError(t, err)
+	assert.Equal(t, 2, total)
+	assert.Len(t, paged, 1)
+
+	tasks, total, err := repo.SearchChats(ctx, searchapp.ChatFilter{
+		WorkspaceID: workspaceID, UserID: userID, Text: "deployment", Tasks: true,
+	}, 0, 10)
+	require.NoError(t, err)
+	assert.Equal(t, 1, total)
+	require.Len(t, tasks, 1)
+	assert.Equal(t, mine, tasks[0].ChatID)
+	assert.Equal(t, "In Progress", tasks[0].Status)
+
+	discussions, total, err := repo.SearchChats(ctx, searchapp.ChatFilter{
+		WorkspaceID: workspaceID, UserID: userID, Text: "deployment",
+	}, 0, 10)
+	require.NoError(t, err)
+	assert.Equal(t, 1, total)
+	require.Len(t, discussions, 1)
+	assert.Equal(t, public, discussions[0].ChatID)
+}
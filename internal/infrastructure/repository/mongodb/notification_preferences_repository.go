@@ -0,0 +1,113 @@
+package mongodb
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+
+	"github.com/lllypuk/flowra/internal/domain/errs"
+	"github.com/lllypuk/flowra/internal/domain/notification"
+	"github.com/lllypuk/flowra/internal/domain/uuid"
+)
+
+// notificationPreferencesDocument is the MongoDB representation of the
+// notification preferences of a user.
+type notificationPreferencesDocument struct {
+	UserID    string      `bson:"user_id"`
+	DND       dndDocument `bson:"dnd"`
+	UpdatedAt time.Time   `bson:"updated_at"`
+}
+
+// dndDocument stores the daily window in minutes after midnight.
+type dndDocument struct {
+	Start    int  `bson:"start"`
+	End      int  `bson:"end"`
+	Weekends bool `bson:"weekends"`
+}
+
+// MongoNotificationPreferencesRepository stores notification preferences in MongoDB.
+type MongoNotificationPreferencesRepository struct {
+	collection *mongo.Collection
+	logger     *slog.Logger
+}
+
+// NotificationPreferencesRepoOption configures MongoNotificationPreferencesRepository.
+type NotificationPreferencesRepoOption func(*MongoNotificationPreferencesRepository)
+
+// WithNotificationPreferencesRepoLogger sets the logger for the preferences repository.
+func WithNotificationPreferencesRepoLogger(logger *slog.Logger) NotificationPreferencesRepoOption {
+	return func(r *MongoNotificationPreferencesRepository) {
+		r.logger = logger
+	}
+}
+
+// NewMongoNotificationPreferencesRepository creates a new notification preferences repository.
+func NewMongoNotificationPreferencesRepository(
+	collection *mongo.Collection,
+	opts ...NotificationPreferencesRepoOption,
+) *MongoNotificationPreferencesRepository {
+	r := &MongoNotificationPreferencesRepository{
+		collection: collection,
+		logger:     slog.Default(),
+	}
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
+// FindPreferences returns the preferences of a user.
+func (r *MongoNotificationPreferencesRepository) FindPreferences(
+	ctx context.Context,
+	userID uuid.UUID,
+) (notification.Preferences, error) {
+	if userID.IsZero() {
+		return notification.Preferences{}, errs.ErrInvalidInput
+	}
+
+	var doc notificationPreferencesDocument
+	if err := r.collection.FindOne(ctx, bson.M{"user_id": userID.String()}).Decode(&doc); err != nil {
+		return notification.Preferences{}, HandleMongoError(err, "notification_preferences")
+	}
+	return notification.Preferences{
+		DND: notification.DNDSchedule{
+			Start:    doc.DND.Start,
+			End:      doc.DND.End,
+			Weekends: doc.DND.Weekends,
+		},
+	}, nil
+}
+
+// SavePreferences stores the preferences, replacing the previous ones of the user.
+func (r *MongoNotificationPreferencesRepository) SavePreferences(
+	ctx context.Context,
+	userID uuid.UUID,
+	prefs notification.Preferences,
+) error {
+	if userID.IsZero() {
+		return errs.ErrInvalidInput
+	}
+
+	doc := notificationPreferencesDocument{
+		UserID: userID.String(),
+		DND: dndDocument{
+			Start:    prefs.DND.Start,
+			End:      prefs.DND.End,
+			Weekends: prefs.DND.Weekends,
+		},
+		UpdatedAt: time.Now().UTC(),
+	}
+	filter := bson.M{"user_id": doc.UserID}
+	if _, err := r.collection.ReplaceOne(ctx, filter, doc, options.Replace().SetUpsert(true)); err != nil {
+		r.logger.ErrorContext(ctx, "failed to save notification preferences",
+			slog.String("user_id", doc.UserID),
+			slog.String("error", err.Error()),
+		)
+		return HandleMongoError(err, "notification_preferences")
+	}
+	return nil
+}
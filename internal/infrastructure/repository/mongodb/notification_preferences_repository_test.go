@@ -0,0 +1,36 @@
+package mongodb_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/lllypuk/flowra/internal/domain/errs"
+	"github.com/lllypuk/flowra/internal/domain/notification"
+	"github.com/lllypuk/flowra/internal/domain/uuid"
+	"github.com/lllypuk/flowra/internal/infrastructure/repository/mongodb"
+	"github.com/lllypuk/flowra/tests/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMongoNotificationPreferencesRepository_SaveAndFind(t *testing.T) {
+	db := testutil.SetupTestMongoDB(t)
+	repo := mongodb.NewMongoNotificationPreferencesRepository(db.Collection("notification_preferences"))
+	ctx := context.Background()
+	userID := uuid.NewUUID()
+
+	_, err := repo.FindPreferences(ctx, userID)
+	require.ErrorIs(t, err, errs.ErrNotFound)
+
+	first, err := notification.NewDNDSchedule("22:00", "08:00", false)
+	require.NoError(t, err)
+	require.NoError(t, repo.SavePreferences(ctx, userID, notification.Preferences{DND: first}))
+	// saving again replaces the preferences of the user
+	second, err := notification.NewDNDSchedule("", "", true)
+	require.NoError(t, err)
+	require.NoError(t, repo.SavePreferences(ctx, userID, notification.Preferences{DND: second}))
+
+	found, err := repo.FindPreferences(ctx, userID)
+	require.NoError(t, err)
+	assert.Equal(t, second, found.DND)
+}
@@ -225,6 +225,51 @@ func (r *MongoNotificationRepository) FindUnreadByUserID(
 	return notifications, nil
 }
 
+// FindReadSince finds notifications of the user read at or after since, oldest read first
+func (r *MongoNotificationRepository) FindReadSince(
+	ctx context.Context,
+	userID uuid.UUID,
+	since time.Time,
+	limit int,
+) ([]*notificationdomain.Notification, error) {
+	if userID.IsZero() {
+		return nil, errs.ErrInvalidInput
+	}
+
+	limit = DefaultLimit(limit, DefaultPaginationLimit)
+
+	filter := bson.M{
+		"user_id": userID.String(),
+		"read_at": bson.M{"$gte": since},
+	}
+	opts := options.Find().
+		SetSort(bson.D{{Key: "read_at", Value: 1}, {Key: "notification_id", Value: 1}}).
+		SetLimit(int64(limit))
+
+	cursor, err := r.collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, HandleMongoError(err, "notifications")
+	}
+	defer cursor.Close(ctx)
+
+	notifications := make([]*notificationdomain.Notification, 0)
+	for cursor.Next(ctx) {
+		var doc notificationDocument
+		if decodeErr := cursor.Decode(&doc); decodeErr != nil {
+			continue
+		}
+
+		notif, docErr := r.documentToNotification(&doc)
+		if docErr != nil {
+			continue
+		}
+
+		notifications = append(notifications, notif)
+	}
+
+	return notifications, nil
+}
+
 // FindByType finds uvedomleniya specific type for user
 func (r *MongoNotificationRepository) FindByType(
 	ctx context.Context,
@@ -494,6 +539,30 @@ func (r *MongoNotificationRepository) DeleteReadOlderThan(ctx context.Context, b
 	return int(result.DeletedCount), nil
 }
 
+// PurgeReadOlderThan deletes up to limit read notifications created before the given time.
+// Returns the number of deleted notifications.
+func (r *MongoNotificationRepository) PurgeReadOlderThan(ctx context.Context, before time.Time, limit int) (int64, error) {
+	filter := bson.M{
+		"read_at":    bson.M{"$ne": nil},
+		"created_at": bson.M{"$lt": before},
+	}
+
+	ids, err := FindIDs(ctx, r.collection, filter, limit)
+	if err != nil {
+		return 0, HandleMongoError(err, "notifications")
+	}
+	if len(ids) == 0 {
+		return 0, nil
+	}
+
+	result, err := r.collection.DeleteMany(ctx, bson.M{"_id": bson.M{"$in": ids}})
+	if err != nil {
+		return 0, HandleMongoError(err, "notifications")
+	}
+
+	return result.DeletedCount, nil
+}
+
 // MarkAsRead otmechaet notification as prochitannoe
 func (r *MongoNotificationRepository) MarkAsRead(ctx context.Context, id uuid.UUID) error {
 	if id.IsZero() {
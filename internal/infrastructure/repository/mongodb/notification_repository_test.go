@@ -2,6 +2,7 @@ package mongodb_test
 
 import (
 	"context"
+	"fmt"
 	"testing"
 	"time"
 
@@ -561,6 +562,46 @@ func TestMongoNotificationRepository_DeleteReadOlderThan(t *testing.T) {
 	assert.Equal(t, "Old unread notification", notifications[0].Title())
 }
 
+// TestMongoNotificationRepository_PurgeReadOlderThan checks that read notifications are purged in batches
+func TestMongoNotificationRepository_PurgeReadOlderThan(t *testing.T) {
+	db := testutil.SetupTestMongoDB(t)
+	coll := db.Collection("notifications")
+	repo := mongodb.NewMongoNotificationRepository(coll)
+	ctx := context.Background()
+
+	userID := uuid.NewUUID()
+	now := time.Now()
+	oldDate := now.Add(-30 * 24 * time.Hour)
+
+	for i, readAt := range []any{oldDate, oldDate, nil} {
+		doc := bson.M{
+			"notification_id": uuid.NewUUID().String(),
+			"user_id":         userID.String(),
+			"type":            string(notificationdomain.TypeSystem),
+			"title":           fmt.Sprintf("Notification %d", i),
+			"message":         "Body",
+			"read_at":         readAt,
+			"created_at":      oldDate,
+		}
+		_, err := coll.InsertOne(ctx, doc)
+		require.NoError(t, err)
+	}
+
+	cutoff := now.Add(-7 * 24 * time.Hour)
+	purged, err := repo.PurgeReadOlderThan(ctx, cutoff, 1)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), purged, "a batch is capped by the limit")
+
+	purged, err = repo.PurgeReadOlderThan(ctx, cutoff, 10)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), purged)
+
+	notifications, err := repo.FindByUserID(ctx, userID, 0, 10)
+	require.NoError(t, err)
+	require.Len(t, notifications, 1)
+	assert.Equal(t, "Notification 2", notifications[0].Title(), "unread notifications are kept")
+}
+
 // TestMongoNotificationRepository_SaveBatch checks paketnoe save uvedomleniy
 func TestMongoNotificationRepository_SaveBatch(t *testing.T) {
 	repo := setupTestNotificationRepository(t)
@@ -0,0 +1,201 @@
+package mongodb
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+
+	reportapp "github.com/lllypuk/flowra/internal/application/report"
+	"github.com/lllypuk/flowra/internal/domain/errs"
+	"github.com/lllypuk/flowra/internal/domain/uuid"
+)
+
+// reportSnapshotDocument is the MongoDB representation of a report snapshot.
+// There is one document per workspace; every refresh replaces it.
+type reportSnapshotDocument struct {
+	WorkspaceID    string                   `bson:"workspace_id"`
+	GeneratedAt    time.Time                `bson:"generated_at"`
+	Burndowns      []sprintBurndownDocument `bson:"burndowns"`
+	CumulativeFlow cumulativeFlowDocument   `bson:"cumulative_flow"`
+	CycleTime      cycleTimeReportDocument  `bson:"cycle_time"`
+}
+
+type sprintBurndownDocument struct {
+	Sprint    string                `bson:"sprint"`
+	StartDate time.Time             `bson:"start_date"`
+	EndDate   time.Time             `bson:"end_date"`
+	Days      []burndownDayDocument `bson:"days"`
+}
+
+type burndownDayDocument struct {
+	Date            time.Time `bson:"date"`
+	ScopeTasks      int       `bson:"scope_tasks"`
+	RemainingTasks  int       `bson:"remaining_tasks"`
+	ScopePoints     float64   `bson:"scope_points"`
+	RemainingPoints float64   `bson:"remaining_points"`
+	ScopeHours      float64   `bson:"scope_hours"`
+	RemainingHours  float64   `bson:"remaining_hours"`
+}
+
+type cumulativeFlowDocument struct {
+	Statuses []string          `bson:"statuses"`
+	Days     []flowDayDocument `bson:"days"`
+}
+
+type flowDayDocument struct {
+	Date   time.Time `bson:"date"`
+	Counts []int     `bson:"counts"`
+}
+
+type cycleTimeReportDocument struct {
+	WindowDays int                   `bson:"window_days"`
+	LeadTime   durationStatsDocument `bson:"lead_time"`
+	CycleTime  durationStatsDocument `bson:"cycle_time"`
+}
+
+type durationStatsDocument struct {
+	Count        int     `bson:"count"`
+	AverageHours float64 `bson:"average_hours"`
+	MedianHours  float64 `bson:"median_hours"`
+	P85Hours     float64 `bson:"p85_hours"`
+}
+
+// MongoReportSnapshotRepository stores cached report snapshots in MongoDB.
+type MongoReportSnapshotRepository struct {
+	collection *mongo.Collection
+	logger     *slog.Logger
+}
+
+// ReportSnapshotRepoOption configures MongoReportSnapshotRepository.
+type ReportSnapshotRepoOption func(*MongoReportSnapshotRepository)
+
+// WithReportSnapshotRepoLogger sets the logger for the report snapshot repository.
+func WithReportSnapshotRepoLogger(logger *slog.Logger) ReportSnapshotRepoOption {
+	return func(r *MongoReportSnapshotRepository) {
+		r.logger = logger
+	}
+}
+
+// NewMongoReportSnapshotRepository creates a new report snapshot repository.
+func NewMongoReportSnapshotRepository(
+	collection *mongo.Collection,
+	opts ...ReportSnapshotRepoOption,
+) *MongoReportSnapshotRepository {
+	r := &MongoReportSnapshotRepository{
+		collection: collection,
+		logger:     slog.Default(),
+	}
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
+// Get returns the cached snapshot of a workspace.
+func (r *MongoReportSnapshotRepository) Get(ctx context.Context, workspaceID uuid.UUID) (*reportapp.Snapshot, error) {
+	if workspaceID.IsZero() {
+		return nil, errs.ErrInvalidInput
+	}
+
+	var doc reportSnapshotDocument
+	err := r.collection.FindOne(ctx, bson.M{"workspace_id": workspaceID.String()}).Decode(&doc)
+	if err != nil {
+		return nil, HandleMongoError(err, "report_snapshot")
+	}
+
+	return documentToReportSnapshot(&doc), nil
+}
+
+// Save replaces the cached snapshot of a workspace.
+func (r *MongoReportSnapshotRepository) Save(ctx context.Context, snapshot *reportapp.Snapshot) error {
+	if snapshot == nil || snapshot.WorkspaceID.IsZero() {
+		return errs.ErrInvalidInput
+	}
+
+	doc := reportSnapshotToDocument(snapshot)
+	filter := bson.M{"workspace_id": doc.WorkspaceID}
+	_, err := r.collection.ReplaceOne(ctx, filter, doc, options.Replace().SetUpsert(true))
+	if err != nil {
+		r.logger.ErrorContext(ctx, "failed to save report snapshot",
+			slog.String("workspace_id", doc.WorkspaceID),
+			slog.String("error", err.Error()),
+		)
+		return HandleMongoError(err, "report_snapshot")
+	}
+
+	return nil
+}
+
+func reportSnapshotToDocument(s *reportapp.Snapshot) reportSnapshotDocument {
+	doc := reportSnapshotDocument{
+		WorkspaceID: s.WorkspaceID.String(),
+		GeneratedAt: s.GeneratedAt,
+		Burndowns:   make([]sprintBurndownDocument, 0, len(s.Burndowns)),
+		CumulativeFlow: cumulativeFlowDocument{
+			Statuses: s.CumulativeFlow.Statuses,
+			Days:     make([]flowDayDocument, 0, len(s.CumulativeFlow.Days)),
+		},
+		CycleTime: cycleTimeReportDocument{
+			WindowDays: s.CycleTime.WindowDays,
+			LeadTime:   durationStatsDocument(s.CycleTime.LeadTime),
+			CycleTime:  durationStatsDocument(s.CycleTime.CycleTime),
+		},
+	}
+
+	for _, b := range s.Burndowns {
+		bd := sprintBurndownDocument{
+			Sprint:    b.Sprint,
+			StartDate: b.StartDate,
+			EndDate:   b.EndDate,
+			Days:      make([]burndownDayDocument, 0, len(b.Days)),
+		}
+		for _, d := range b.Days {
+			bd.Days = append(bd.Days, burndownDayDocument(d))
+		}
+		doc.Burndowns = append(doc.Burndowns, bd)
+	}
+	for _, d := range s.CumulativeFlow.Days {
+		doc.CumulativeFlow.Days = append(doc.CumulativeFlow.Days, flowDayDocument(d))
+	}
+
+	return doc
+}
+
+func documentToReportSnapshot(doc *reportSnapshotDocument) *reportapp.Snapshot {
+	s := &reportapp.Snapshot{
+		WorkspaceID: uuid.UUID(doc.WorkspaceID),
+		GeneratedAt: doc.GeneratedAt,
+		Burndowns:   make([]reportapp.SprintBurndown, 0, len(doc.Burndowns)),
+		CumulativeFlow: reportapp.CumulativeFlow{
+			Statuses: doc.CumulativeFlow.Statuses,
+			Days:     make([]reportapp.FlowDay, 0, len(doc.CumulativeFlow.Days)),
+		},
+		CycleTime: reportapp.CycleTimeReport{
+			WindowDays: doc.CycleTime.WindowDays,
+			LeadTime:   reportapp.DurationStats(doc.CycleTime.LeadTime),
+			CycleTime:  reportapp.DurationStats(doc.CycleTime.CycleTime),
+		},
+	}
+
+	for _, bd := range doc.Burndowns {
+		b := reportapp.SprintBurndown{
+			Sprint:    bd.Sprint,
+			StartDate: bd.StartDate,
+			EndDate:   bd.EndDate,
+			Days:      make([]reportapp.BurndownDay, 0, len(bd.Days)),
+		}
+		for _, d := range bd.Days {
+			b.Days = append(b.Days, reportapp.BurndownDay(d))
+		}
+		s.Burndowns = append(s.Burndowns, b)
+	}
+	for _, d := range doc.CumulativeFlow.Days {
+		s.CumulativeFlow.Days = append(s.CumulativeFlow.Days, reportapp.FlowDay(d))
+	}
+
+	return s
+}
@@ -0,0 +1,87 @@
+package mongodb
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"go.mongodb.org/mongo-driver/v2/mongo"
+
+	"github.com/lllypuk/flowra/internal/domain/errs"
+	"github.com/lllypuk/flowra/internal/domain/uuid"
+)
+
+// slaBreachDocument records that an SLA clock of a bug ran past its target.
+// The clock is identified by task, kind and the time it started.
+type slaBreachDocument struct {
+	TaskID      string    `bson:"task_id"`
+	WorkspaceID string    `bson:"workspace_id"`
+	Kind        string    `bson:"kind"`
+	OpenedAt    time.Time `bson:"opened_at"`
+	DueAt       time.Time `bson:"due_at"`
+	DetectedAt  time.Time `bson:"detected_at"`
+}
+
+// MongoSLABreachRepository stores detected SLA breaches in MongoDB.
+type MongoSLABreachRepository struct {
+	collection *mongo.Collection
+	logger     *slog.Logger
+}
+
+// SLABreachRepoOption configures MongoSLABreachRepository.
+type SLABreachRepoOption func(*MongoSLABreachRepository)
+
+// WithSLABreachRepoLogger sets the logger for the SLA breach repository.
+func WithSLABreachRepoLogger(logger *slog.Logger) SLABreachRepoOption {
+	return func(r *MongoSLABreachRepository) {
+		r.logger = logger
+	}
+}
+
+// NewMongoSLABreachRepository creates a new SLA breach repository.
+func NewMongoSLABreachRepository(collection *mongo.Collection, opts ...SLABreachRepoOption) *MongoSLABreachRepository {
+	r := &MongoSLABreachRepository{
+		collection: collection,
+		logger:     slog.Default(),
+	}
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
+// RecordBreach stores a breach of the clock started at openedAt.
+// It reports false when the breach was already recorded, relying on the
+// unique index over task, kind and opened_at.
+func (r *MongoSLABreachRepository) RecordBreach(
+	ctx context.Context,
+	taskID, workspaceID uuid.UUID,
+	kind string,
+	openedAt, dueAt time.Time,
+) (bool, error) {
+	if taskID.IsZero() || kind == "" || openedAt.IsZero() {
+		return false, errs.ErrInvalidInput
+	}
+
+	doc := slaBreachDocument{
+		TaskID:      taskID.String(),
+		WorkspaceID: workspaceID.String(),
+		Kind:        kind,
+		OpenedAt:    openedAt,
+		DueAt:       dueAt,
+		DetectedAt:  time.Now().UTC(),
+	}
+	if _, err := r.collection.InsertOne(ctx, doc); err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return false, nil
+		}
+		r.logger.ErrorContext(ctx, "failed to record SLA breach",
+			slog.String("task_id", taskID.String()),
+			slog.String("kind", kind),
+			slog.String("error", err.Error()),
+		)
+		return false, HandleMongoError(err, "sla_breach")
+	}
+
+	return true, nil
+}
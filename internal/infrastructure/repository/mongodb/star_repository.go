@@ -0,0 +1,136 @@
+package mongodb
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+
+	"github.com/lllypuk/flowra/internal/domain/errs"
+	"github.com/lllypuk/flowra/internal/domain/uuid"
+)
+
+// starDocument is the MongoDB representation of a chat or task starred by a user.
+type starDocument struct {
+	UserID      string    `bson:"user_id"`
+	WorkspaceID string    `bson:"workspace_id"`
+	ChatID      string    `bson:"chat_id"`
+	CreatedAt   time.Time `bson:"created_at"`
+}
+
+// MongoStarRepository stores starred chats and tasks in MongoDB.
+type MongoStarRepository struct {
+	collection *mongo.Collection
+	logger     *slog.Logger
+}
+
+// StarRepoOption configures MongoStarRepository.
+type StarRepoOption func(*MongoStarRepository)
+
+// WithStarRepoLogger sets the logger for the star repository.
+func WithStarRepoLogger(logger *slog.Logger) StarRepoOption {
+	return func(r *MongoStarRepository) {
+		r.logger = logger
+	}
+}
+
+// NewMongoStarRepository creates a new star repository.
+func NewMongoStarRepository(collection *mongo.Collection, opts ...StarRepoOption) *MongoStarRepository {
+	r := &MongoStarRepository{
+		collection: collection,
+		logger:     slog.Default(),
+	}
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
+// Star stars the chat for the user; starring a starred chat keeps its original time.
+func (r *MongoStarRepository) Star(ctx context.Context, userID, workspaceID, chatID uuid.UUID) error {
+	if userID.IsZero() || workspaceID.IsZero() || chatID.IsZero() {
+		return errs.ErrInvalidInput
+	}
+
+	filter := bson.M{"user_id": userID.String(), "chat_id": chatID.String()}
+	update := bson.M{"$setOnInsert": starDocument{
+		UserID:      userID.String(),
+		WorkspaceID: workspaceID.String(),
+		ChatID:      chatID.String(),
+		CreatedAt:   time.Now().UTC(),
+	}}
+	if _, err := r.collection.UpdateOne(ctx, filter, update, options.UpdateOne().SetUpsert(true)); err != nil {
+		r.logger.ErrorContext(ctx, "failed to star chat",
+			slog.String("user_id", userID.String()),
+			slog.String("chat_id", chatID.String()),
+			slog.String("error", err.Error()),
+		)
+		return HandleMongoError(err, "star")
+	}
+	return nil
+}
+
+// Unstar removes the star of the user from the chat.
+func (r *MongoStarRepository) Unstar(ctx context.Context, userID, chatID uuid.UUID) error {
+	if userID.IsZero() || chatID.IsZero() {
+		return errs.ErrInvalidInput
+	}
+
+	filter := bson.M{"user_id": userID.String(), "chat_id": chatID.String()}
+	if _, err := r.collection.DeleteOne(ctx, filter); err != nil {
+		r.logger.ErrorContext(ctx, "failed to unstar chat",
+			slog.String("user_id", userID.String()),
+			slog.String("chat_id", chatID.String()),
+			slog.String("error", err.Error()),
+		)
+		return HandleMongoError(err, "star")
+	}
+	return nil
+}
+
+// IsStarred reports whether the user starred the chat.
+func (r *MongoStarRepository) IsStarred(ctx context.Context, userID, chatID uuid.UUID) (bool, error) {
+	if userID.IsZero() || chatID.IsZero() {
+		return false, errs.ErrInvalidInput
+	}
+
+	filter := bson.M{"user_id": userID.String(), "chat_id": chatID.String()}
+	err := r.collection.FindOne(ctx, filter).Err()
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return false, nil
+	}
+	if err != nil {
+		return false, HandleMongoError(err, "star")
+	}
+	return true, nil
+}
+
+// FindStarred returns the chats the user starred in the workspace, most recently starred first.
+func (r *MongoStarRepository) FindStarred(ctx context.Context, userID, workspaceID uuid.UUID) ([]uuid.UUID, error) {
+	if userID.IsZero() || workspaceID.IsZero() {
+		return nil, errs.ErrInvalidInput
+	}
+
+	filter := bson.M{"user_id": userID.String(), "workspace_id": workspaceID.String()}
+	opts := options.Find().
+		SetSort(bson.D{{Key: "created_at", Value: -1}}).
+		SetProjection(bson.M{"chat_id": 1})
+	cursor, err := r.collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, HandleMongoError(err, "stars")
+	}
+
+	var docs []starDocument
+	if err = cursor.All(ctx, &docs); err != nil {
+		return nil, HandleMongoError(err, "stars")
+	}
+	ids := make([]uuid.UUID, 0, len(docs))
+	for _, doc := range docs {
+		ids = append(ids, uuid.UUID(doc.ChatID))
+	}
+	return ids, nil
+}
@@ -0,0 +1,45 @@
+package mongodb_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/lllypuk/flowra/internal/domain/uuid"
+	"github.com/lllypuk/flowra/internal/infrastructure/repository/mongodb"
+	"github.com/lllypuk/flowra/tests/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMongoStarRepository_StarAndUnstar(t *testing.T) {
+	db := testutil.SetupTestMongoDB(t)
+	repo := mongodb.NewMongoStarRepository(db.Collection("stars"))
+	ctx := context.Background()
+	userID, workspaceID := uuid.NewUUID(), uuid.NewUUID()
+	first, second := uuid.NewUUID(), uuid.NewUUID()
+
+	require.NoError(t, repo.Star(ctx, userID, workspaceID, first))
+	require.NoError(t, repo.Star(ctx, userID, workspaceID, second))
+	// starring again is a no-op
+	require.NoError(t, repo.Star(ctx, userID, workspaceID, first))
+	// stars of other users and workspaces are not listed
+	require.NoError(t, repo.Star(ctx, uuid.NewUUID(), workspaceID, uuid.NewUUID()))
+	require.NoError(t, repo.Star(ctx, userID, uuid.NewUUID(), uuid.NewUUID()))
+
+	starred, err := repo.FindStarred(ctx, userID, workspaceID)
+	require.NoError(t, err)
+	assert.Equal(t, []uuid.UUID{second, first}, starred)
+
+	isStarred, err := repo.IsStarred(ctx, userID, first)
+	require.NoError(t, err)
+	assert.True(t, isStarred)
+
+	require.NoError(t, repo.Unstar(ctx, userID, first))
+	isStarred, err = repo.IsStarred(ctx, userID, first)
+	require.NoError(t, err)
+	assert.False(t, isStarred)
+
+	starred, err = repo.FindStarred(ctx, userID, workspaceID)
+	require.NoError(t, err)
+	assert.Equal(t, []uuid.UUID{second}, starred)
+}
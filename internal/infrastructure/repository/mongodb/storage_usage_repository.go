@@ -0,0 +1,97 @@
+package mongodb
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+
+	"github.com/lllypuk/flowra/internal/domain/errs"
+	"github.com/lllypuk/flowra/internal/domain/uuid"
+)
+
+// storageUsageDocument is the MongoDB representation of the attachment bytes of a workspace.
+type storageUsageDocument struct {
+	WorkspaceID string    `bson:"workspace_id"`
+	UsedBytes   int64     `bson:"used_bytes"`
+	UpdatedAt   time.Time `bson:"updated_at"`
+}
+
+// MongoStorageUsageRepository counts the attachment bytes stored per workspace.
+type MongoStorageUsageRepository struct {
+	collection *mongo.Collection
+	logger     *slog.Logger
+}
+
+// StorageUsageRepoOption configures MongoStorageUsageRepository.
+type StorageUsageRepoOption func(*MongoStorageUsageRepository)
+
+// WithStorageUsageRepoLogger sets the logger for the storage usage repository.
+func WithStorageUsageRepoLogger(logger *slog.Logger) StorageUsageRepoOption {
+	return func(r *MongoStorageUsageRepository) {
+		r.logger = logger
+	}
+}
+
+// NewMongoStorageUsageRepository creates a new storage usage repository.
+func NewMongoStorageUsageRepository(
+	collection *mongo.Collection,
+	opts ...StorageUsageRepoOption,
+) *MongoStorageUsageRepository {
+	r := &MongoStorageUsageRepository{
+		collection: collection,
+		logger:     slog.Default(),
+	}
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
+// GetUsage returns the attachment bytes stored by the workspace; zero when nothing was uploaded.
+func (r *MongoStorageUsageRepository) GetUsage(ctx context.Context, workspaceID uuid.UUID) (int64, error) {
+	if workspaceID.IsZero() {
+		return 0, errs.ErrInvalidInput
+	}
+
+	var doc storageUsageDocument
+	err := r.collection.FindOne(ctx, bson.M{"workspace_id": workspaceID.String()}).Decode(&doc)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, HandleMongoError(err, "storage_usage")
+	}
+
+	return doc.UsedBytes, nil
+}
+
+// AddUsage atomically adds delta bytes to the usage of the workspace and returns the new total.
+func (r *MongoStorageUsageRepository) AddUsage(ctx context.Context, workspaceID uuid.UUID, delta int64) (int64, error) {
+	if workspaceID.IsZero() {
+		return 0, errs.ErrInvalidInput
+	}
+
+	filter := bson.M{"workspace_id": workspaceID.String()}
+	update := bson.M{
+		"$inc": bson.M{"used_bytes": delta},
+		"$set": bson.M{"updated_at": time.Now().UTC()},
+	}
+	opts := options.FindOneAndUpdate().SetUpsert(true).SetReturnDocument(options.After)
+
+	var doc storageUsageDocument
+	if err := r.collection.FindOneAndUpdate(ctx, filter, update, opts).Decode(&doc); err != nil {
+		r.logger.ErrorContext(ctx, "failed to add storage usage",
+			slog.String("workspace_id", workspaceID.String()),
+			slog.Int64("delta", delta),
+			slog.String("error", err.Error()),
+		)
+		return 0, HandleMongoError(err, "storage_usage")
+	}
+
+	return doc.UsedBytes, nil
+}
@@ -0,0 +1,43 @@
+package mongodb_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/lllypuk/flowra/internal/domain/errs"
+	"github.com/lllypuk/flowra/internal/domain/uuid"
+	"github.com/lllypuk/flowra/internal/infrastructure/repository/mongodb"
+	"github.com/lllypuk/flowra/tests/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMongoStorageUsageRepository_AddAndGet(t *testing.T) {
+	db := testutil.SetupTestMongoDB(t)
+	repo := mongodb.NewMongoStorageUsageRepository(db.Collection("workspace_storage_usage"))
+	ctx := context.Background()
+	workspaceID := uuid.NewUUID()
+
+	used, err := repo.GetUsage(ctx, workspaceID)
+	require.NoError(t, err)
+	assert.Zero(t, used, "workspaces without uploads use nothing")
+
+	used, err = repo.AddUsage(ctx, workspaceID, 1024)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1024), used)
+
+	used, err = repo.AddUsage(ctx, workspaceID, 512)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1536), used)
+
+	used, err = repo.GetUsage(ctx, workspaceID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1536), used)
+
+	other, err := repo.GetUsage(ctx, uuid.NewUUID())
+	require.NoError(t, err)
+	assert.Zero(t, other)
+
+	_, err = repo.AddUsage(ctx, "", 1)
+	require.ErrorIs(t, err, errs.ErrInvalidInput)
+}
@@ -0,0 +1,136 @@
+package mongodb
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+
+	taskapp "github.com/lllypuk/flowra/internal/application/task"
+	"github.com/lllypuk/flowra/internal/domain/errs"
+	"github.com/lllypuk/flowra/internal/domain/uuid"
+)
+
+// defaultBacklinksLimit bounds FindBacklinks when no limit is given.
+const defaultBacklinksLimit = 20
+
+// taskLinkDocument is the MongoDB representation of the tasks referenced by a message.
+// Documents are written by projector.TaskLinkProjector.
+type taskLinkDocument struct {
+	MessageID string    `bson:"message_id"`
+	ChatID    string    `bson:"chat_id"`
+	AuthorID  string    `bson:"author_id"`
+	TaskIDs   []string  `bson:"task_ids"`
+	Excerpt   string    `bson:"excerpt"`
+	CreatedAt time.Time `bson:"created_at"`
+}
+
+// MongoTaskLinkRepository reads links between tasks and messages from MongoDB.
+type MongoTaskLinkRepository struct {
+	collection *mongo.Collection
+	logger     *slog.Logger
+}
+
+// TaskLinkRepoOption configures MongoTaskLinkRepository.
+type TaskLinkRepoOption func(*MongoTaskLinkRepository)
+
+// WithTaskLinkRepoLogger sets the logger for the task link repository.
+func WithTaskLinkRepoLogger(logger *slog.Logger) TaskLinkRepoOption {
+	return func(r *MongoTaskLinkRepository) {
+		r.logger = logger
+	}
+}
+
+// NewMongoTaskLinkRepository creates a new task link repository.
+func NewMongoTaskLinkRepository(collection *mongo.Collection, opts ...TaskLinkRepoOption) *MongoTaskLinkRepository {
+	r := &MongoTaskLinkRepository{
+		collection: collection,
+		logger:     slog.Default(),
+	}
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
+// FindBacklinks returns the messages mentioning the task, newest first.
+// Links of deleted messages are skipped.
+func (r *MongoTaskLinkRepository) FindBacklinks(
+	ctx context.Context,
+	taskID uuid.UUID,
+	limit int,
+) ([]taskapp.Backlink, error) {
+	if taskID.IsZero() {
+		return nil, errs.ErrInvalidInput
+	}
+	if limit <= 0 {
+		limit = defaultBacklinksLimit
+	}
+
+	filter := bson.M{"task_ids": taskID.String(), "is_deleted": bson.M{"$ne": true}}
+	opts := options.Find().SetSort(bson.D{{Key: "created_at", Value: -1}}).SetLimit(int64(limit))
+	cursor, err := r.collection.Find(ctx, filter, opts)
+	if err != nil {
+		r.logger.ErrorContext(ctx, "failed to find task backlinks",
+			slog.String("task_id", taskID.String()),
+			slog.String("error", err.Error()),
+		)
+		return nil, HandleMongoError(err, "task_link")
+	}
+
+	var docs []taskLinkDocument
+	if err = cursor.All(ctx, &docs); err != nil {
+		return nil, HandleMongoError(err, "task_link")
+	}
+
+	backlinks := make([]taskapp.Backlink, 0, len(docs))
+	for _, doc := range docs {
+		backlinks = append(backlinks, taskapp.Backlink{
+			MessageID: uuid.UUID(doc.MessageID),
+			ChatID:    uuid.UUID(doc.ChatID),
+			AuthorID:  uuid.UUID(doc.AuthorID),
+			Excerpt:   doc.Excerpt,
+			CreatedAt: doc.CreatedAt,
+		})
+	}
+	return backlinks, nil
+}
+
+// FindLinkedTaskIDs returns the tasks referenced by each of the messages.
+func (r *MongoTaskLinkRepository) FindLinkedTaskIDs(
+	ctx context.Context,
+	messageIDs []uuid.UUID,
+) (map[uuid.UUID][]uuid.UUID, error) {
+	result := make(map[uuid.UUID][]uuid.UUID)
+	if len(messageIDs) == 0 {
+		return result, nil
+	}
+
+	ids := make([]string, 0, len(messageIDs))
+	for _, id := range messageIDs {
+		ids = append(ids, id.String())
+	}
+
+	opts := options.Find().SetProjection(bson.M{"message_id": 1, "task_ids": 1})
+	cursor, err := r.collection.Find(ctx, bson.M{"message_id": bson.M{"$in": ids}}, opts)
+	if err != nil {
+		return nil, HandleMongoError(err, "task_link")
+	}
+
+	var docs []taskLinkDocument
+	if err = cursor.All(ctx, &docs); err != nil {
+		return nil, HandleMongoError(err, "task_link")
+	}
+
+	for _, doc := range docs {
+		taskIDs := make([]uuid.UUID, 0, len(doc.TaskIDs))
+		for _, id := range doc.TaskIDs {
+			taskIDs = append(taskIDs, uuid.UUID(id))
+		}
+		result[uuid.UUID(doc.MessageID)] = taskIDs
+	}
+	return result, nil
+}
@@ -134,6 +134,48 @@ func (r *MongoTaskRepository) Count(ctx context.Context, filters taskapp.Filters
 	return int(count), nil
 }
 
+// FindOpenSLABugs returns the bugs of the workspace whose SLA clock has not been resolved.
+func (r *MongoTaskRepository) FindOpenSLABugs(
+	ctx context.Context,
+	workspaceID uuid.UUID,
+) ([]*taskapp.ReadModel, error) {
+	if workspaceID.IsZero() {
+		return nil, errs.ErrInvalidInput
+	}
+
+	filter := bson.M{
+		"workspace_id":    workspaceID.String(),
+		"sla.opened_at":   bson.M{"$exists": true},
+		"sla.resolved_at": nil,
+	}
+	cursor, err := r.collection.Find(ctx, filter)
+	if err != nil {
+		return nil, HandleMongoError(err, "tasks")
+	}
+	defer cursor.Close(ctx)
+
+	results := make([]*taskapp.ReadModel, 0)
+	for cursor.Next(ctx) {
+		var doc taskReadModelDocument
+		if decodeErr := cursor.Decode(&doc); decodeErr != nil {
+			continue
+		}
+
+		rm, docErr := r.documentToReadModel(&doc)
+		if docErr != nil {
+			continue
+		}
+
+		results = append(results, rm)
+	}
+
+	if err = cursor.Err(); err != nil {
+		return nil, fmt.Errorf("cursor error: %w", err)
+	}
+
+	return results, nil
+}
+
 // applyFilters applies filters to MongoDB query.
 func (r *MongoTaskRepository) applyFilters(filter bson.M, filters taskapp.Filters) {
 	if filters.ChatID != nil {
@@ -154,9 +196,33 @@ func (r *MongoTaskRepository) applyFilters(filter bson.M, filters taskapp.Filter
 	if filters.CreatedBy != nil {
 		filter["created_by"] = filters.CreatedBy.String()
 	}
+	if filters.Sprint != "" {
+		filter["sprint"] = filters.Sprint
+	}
+	if filters.EpicID != nil {
+		filter["epic_id"] = filters.EpicID.String()
+	}
 	if filters.Search != "" {
 		filter["title"] = bson.M{"$regex": filters.Search, "$options": "i"}
 	}
+	if filters.IDs != nil {
+		filter["task_id"] = bson.M{"$in": uuidStrings(filters.IDs)}
+	}
+	if filters.AwaitingTriage {
+		applyAwaitingTriageFilter(filter)
+	}
+}
+
+// applyAwaitingTriageFilter limits the filter to new bugs missing a severity or an assignee.
+// Bugs in status New are projected as To Do; nil matches both null and missing fields.
+func applyAwaitingTriageFilter(filter bson.M) {
+	filter["entity_type"] = string(taskdomain.TypeBug)
+	filter["status"] = string(taskdomain.StatusToDo)
+	filter["duplicate_of"] = nil
+	filter["$or"] = bson.A{
+		bson.M{"severity": bson.M{"$in": bson.A{nil, ""}}},
+		bson.M{"assigned_to": nil},
+	}
 }
 
 // findMany performs search with pagination.
@@ -208,17 +274,40 @@ func (r *MongoTaskRepository) findMany(
 type taskReadModelDocument struct {
 	TaskID      string                   `bson:"task_id"`
 	ChatID      string                   `bson:"chat_id"`
+	WorkspaceID string                   `bson:"workspace_id,omitempty"`
 	Title       string                   `bson:"title"`
 	EntityType  string                   `bson:"entity_type"`
 	Status      string                   `bson:"status"`
 	Priority    string                   `bson:"priority"`
 	Severity    string                   `bson:"severity,omitempty"`
+	Estimate    *taskEstimateDocument    `bson:"estimate,omitempty"`
+	Sprint      string                   `bson:"sprint,omitempty"`
+	DuplicateOf *string                  `bson:"duplicate_of,omitempty"`
+	EpicID      *string                  `bson:"epic_id,omitempty"`
 	AssignedTo  *string                  `bson:"assigned_to,omitempty"`
 	DueDate     *time.Time               `bson:"due_date,omitempty"`
 	CreatedBy   string                   `bson:"created_by"`
 	CreatedAt   time.Time                `bson:"created_at"`
 	Version     int                      `bson:"version"`
 	Attachments []taskAttachmentDocument `bson:"attachments,omitempty"`
+
+	Checklist        []taskChecklistItemDocument `bson:"checklist,omitempty"`
+	ChecklistTotal   int                         `bson:"checklist_total,omitempty"`
+	ChecklistDone    int                         `bson:"checklist_done,omitempty"`
+	ChecklistPercent int                         `bson:"checklist_percent,omitempty"`
+
+	SLA *taskSLADocument `bson:"sla,omitempty"`
+
+	AssigneeUsername    string `bson:"assignee_username,omitempty"`
+	AssigneeDisplayName string `bson:"assignee_display_name,omitempty"`
+	CreatorUsername     string `bson:"created_by_username,omitempty"`
+	CreatorDisplayName  string `bson:"created_by_display_name,omitempty"`
+}
+
+// taskEstimateDocument represents the estimate in the read model document.
+type taskEstimateDocument struct {
+	Value float64 `bson:"value"`
+	Unit  string  `bson:"unit"`
 }
 
 // taskAttachmentDocument represents an attachment in the read model document.
@@ -229,6 +318,21 @@ type taskAttachmentDocument struct {
 	MimeType string `bson:"mime_type"`
 }
 
+// taskSLADocument represents the SLA clock of a bug in the read model document.
+type taskSLADocument struct {
+	OpenedAt    time.Time  `bson:"opened_at"`
+	RespondedAt *time.Time `bson:"responded_at,omitempty"`
+	ResolvedAt  *time.Time `bson:"resolved_at,omitempty"`
+}
+
+// taskChecklistItemDocument represents a checklist item in the read model document.
+type taskChecklistItemDocument struct {
+	ItemID   string `bson:"item_id"`
+	Text     string `bson:"text"`
+	Done     bool   `bson:"done"`
+	Position int    `bson:"position"`
+}
+
 // documentToReadModel converts BSON document to task read model.
 func (r *MongoTaskRepository) documentToReadModel(doc *taskReadModelDocument) (*taskapp.ReadModel, error) {
 	if doc == nil {
@@ -243,9 +347,19 @@ func (r *MongoTaskRepository) documentToReadModel(doc *taskReadModelDocument) (*
 		Status:     taskdomain.Status(doc.Status),
 		Priority:   taskdomain.Priority(doc.Priority),
 		Severity:   doc.Severity,
+		Sprint:     doc.Sprint,
 		CreatedBy:  uuid.UUID(doc.CreatedBy),
 		CreatedAt:  doc.CreatedAt,
 		Version:    doc.Version,
+
+		ChecklistTotal:   doc.ChecklistTotal,
+		ChecklistDone:    doc.ChecklistDone,
+		ChecklistPercent: doc.ChecklistPercent,
+
+		AssigneeUsername:    doc.AssigneeUsername,
+		AssigneeDisplayName: doc.AssigneeDisplayName,
+		CreatorUsername:     doc.CreatorUsername,
+		CreatorDisplayName:  doc.CreatorDisplayName,
 	}
 
 	if doc.AssignedTo != nil {
@@ -257,6 +371,32 @@ func (r *MongoTaskRepository) documentToReadModel(doc *taskReadModelDocument) (*
 		rm.DueDate = doc.DueDate
 	}
 
+	if doc.DuplicateOf != nil {
+		original := uuid.UUID(*doc.DuplicateOf)
+		rm.DuplicateOf = &original
+	}
+
+	if doc.EpicID != nil {
+		epicID := uuid.UUID(*doc.EpicID)
+		rm.EpicID = &epicID
+	}
+
+	if doc.WorkspaceID != "" {
+		rm.WorkspaceID = uuid.UUID(doc.WorkspaceID)
+	}
+
+	if doc.SLA != nil {
+		rm.SLA = &taskapp.SLAReadModel{
+			OpenedAt:    doc.SLA.OpenedAt,
+			RespondedAt: doc.SLA.RespondedAt,
+			ResolvedAt:  doc.SLA.ResolvedAt,
+		}
+	}
+
+	if doc.Estimate != nil {
+		rm.Estimate = &taskapp.EstimateReadModel{Value: doc.Estimate.Value, Unit: doc.Estimate.Unit}
+	}
+
 	for _, a := range doc.Attachments {
 		rm.Attachments = append(rm.Attachments, taskapp.AttachmentReadModel{
 			FileID:   uuid.UUID(a.FileID),
@@ -266,8 +406,26 @@ func (r *MongoTaskRepository) documentToReadModel(doc *taskReadModelDocument) (*
 		})
 	}
 
+	for _, item := range doc.Checklist {
+		rm.Checklist = append(rm.Checklist, taskapp.ChecklistItemReadModel{
+			ID:       uuid.UUID(item.ItemID),
+			Text:     item.Text,
+			Done:     item.Done,
+			Position: item.Position,
+		})
+	}
+
 	return rm, nil
 }
 
 // Compile-time interface checks.
 var _ taskapp.QueryRepository = (*MongoTaskRepository)(nil)
+
+// uuidStrings converts IDs to their string form for $in queries.
+func uuidStrings(ids []uuid.UUID) []string {
+	strs := make([]string, len(ids))
+	for i, id := range ids {
+		strs[i] = id.String()
+	}
+	return strs
+}
@@ -18,8 +18,16 @@ import (
 
 // MongoUserRepository realizuet userapp.Repository (application layer interface)
 type MongoUserRepository struct {
-	collection *mongo.Collection
-	logger     *slog.Logger
+	collection      *mongo.Collection
+	profileListener UserProfileListener
+	logger          *slog.Logger
+}
+
+// UserProfileListener is notified after a user is saved, so that read models
+// denormalizing user names can follow renames.
+// Declared on the consumer side per project guidelines.
+type UserProfileListener interface {
+	UserProfileChanged(ctx context.Context, userID uuid.UUID, username, displayName string) error
 }
 
 // UserRepoOption configures MongoUserRepository.
@@ -32,6 +40,13 @@ func WithUserRepoLogger(logger *slog.Logger) UserRepoOption {
 	}
 }
 
+// WithUserProfileListener sets the listener notified after every successful Save.
+func WithUserProfileListener(listener UserProfileListener) UserRepoOption {
+	return func(r *MongoUserRepository) {
+		r.profileListener = listener
+	}
+}
+
 // NewMongoUserRepository creates New MongoDB User Repository
 func NewMongoUserRepository(collection *mongo.Collection, opts ...UserRepoOption) *MongoUserRepository {
 	r := &MongoUserRepository{
@@ -68,6 +83,50 @@ func (r *MongoUserRepository) FindByID(ctx context.Context, id uuid.UUID) (*user
 	return r.documentToUser(&doc)
 }
 
+// FindByIDs finds users by IDs with a single query. Unknown IDs are skipped,
+// so the result may be shorter than ids and is not in ids order.
+func (r *MongoUserRepository) FindByIDs(ctx context.Context, ids []uuid.UUID) ([]*userdomain.User, error) {
+	idStrings := make([]string, 0, len(ids))
+	seen := make(map[uuid.UUID]struct{}, len(ids))
+	for _, id := range ids {
+		if id.IsZero() {
+			continue
+		}
+		if _, dup := seen[id]; dup {
+			continue
+		}
+		seen[id] = struct{}{}
+		idStrings = append(idStrings, id.String())
+	}
+	if len(idStrings) == 0 {
+		return []*userdomain.User{}, nil
+	}
+
+	cursor, err := r.collection.Find(ctx, bson.M{"user_id": bson.M{"$in": idStrings}})
+	if err != nil {
+		return nil, HandleMongoError(err, "users")
+	}
+	defer cursor.Close(ctx)
+
+	users := make([]*userdomain.User, 0, len(idStrings))
+	for cursor.Next(ctx) {
+		var doc userDocument
+		if decodeErr := cursor.Decode(&doc); decodeErr != nil {
+			continue
+		}
+		u, docErr := r.documentToUser(&doc)
+		if docErr != nil {
+			continue
+		}
+		users = append(users, u)
+	}
+	if cursorErr := cursor.Err(); cursorErr != nil {
+		return nil, HandleMongoError(cursorErr, "users")
+	}
+
+	return users, nil
+}
+
 // FindByExternalID finds user po ID from vneshney sistemy autentifikatsii
 func (r *MongoUserRepository) FindByExternalID(ctx context.Context, externalID string) (*userdomain.User, error) {
 	if externalID == "" {
@@ -216,8 +275,22 @@ func (r *MongoUserRepository) Save(ctx context.Context, user *userdomain.User) e
 			slog.String("email", user.Email()),
 			slog.String("error", err.Error()),
 		)
+		return HandleMongoError(err, "user")
 	}
-	return HandleMongoError(err, "user")
+
+	// Denormalized names are eventually consistent: a failed update is logged
+	// and corrected by the next save or a read model rebuild.
+	if r.profileListener != nil {
+		if listenerErr := r.profileListener.UserProfileChanged(
+			ctx, user.ID(), user.Username(), user.DisplayName(),
+		); listenerErr != nil {
+			r.logger.WarnContext(ctx, "failed to propagate user profile",
+				slog.String("user_id", user.ID().String()),
+				slog.String("error", listenerErr.Error()),
+			)
+		}
+	}
+	return nil
 }
 
 // Delete udalyaet user
@@ -264,6 +337,8 @@ type userDocument struct {
 	Username      string    `bson:"username"`
 	Email         string    `bson:"email"`
 	DisplayName   string    `bson:"display_name"`
+	Locale        string    `bson:"locale,omitempty"`
+	Timezone      string    `bson:"timezone,omitempty"`
 	IsSystemAdmin bool      `bson:"is_system_admin"`
 	IsActive      bool      `bson:"is_active"`
 	CreatedAt     time.Time `bson:"created_at"`
@@ -277,6 +352,8 @@ func (r *MongoUserRepository) userToDocument(user *userdomain.User) userDocument
 		Username:      user.Username(),
 		Email:         user.Email(),
 		DisplayName:   user.DisplayName(),
+		Locale:        user.Locale(),
+		Timezone:      user.Timezone(),
 		IsSystemAdmin: user.IsSystemAdmin(),
 		IsActive:      user.IsActive(),
 		CreatedAt:     user.CreatedAt(),
@@ -307,6 +384,8 @@ func (r *MongoUserRepository) documentToUser(doc *userDocument) (*userdomain.Use
 		doc.Username,
 		doc.Email,
 		doc.DisplayName,
+		doc.Locale,
+		doc.Timezone,
 		doc.IsSystemAdmin,
 		doc.IsActive,
 		doc.CreatedAt,
@@ -8,10 +8,12 @@ import (
 	"github.com/lllypuk/flowra/internal/domain/errs"
 	userdomain "github.com/lllypuk/flowra/internal/domain/user"
 	"github.com/lllypuk/flowra/internal/domain/uuid"
+	"github.com/lllypuk/flowra/internal/infrastructure/projector"
 	"github.com/lllypuk/flowra/internal/infrastructure/repository/mongodb"
 	"github.com/lllypuk/flowra/tests/testutil"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/v2/bson"
 )
 
 // setupTestUserRepository creates test repozitoriy users
@@ -62,6 +64,36 @@ func TestMongoUserRepository_Save_And_FindByID(t *testing.T) {
 	assert.Equal(t, user.IsSystemAdmin(), loaded.IsSystemAdmin())
 }
 
+// TestMongoUserRepository_Save_PropagatesNamesToTasks checks that renames reach tasks_read_model
+func TestMongoUserRepository_Save_PropagatesNamesToTasks(t *testing.T) {
+	db := testutil.SetupTestMongoDB(t)
+	tasks := db.Collection("tasks_read_model")
+	repo := mongodb.NewMongoUserRepository(
+		db.Collection("users"),
+		mongodb.WithUserProfileListener(projector.NewTaskUserNamesProjector(tasks, nil)),
+	)
+	ctx := context.Background()
+
+	user := createTestUser(t, "rename")
+	_, err := tasks.InsertOne(ctx, bson.M{
+		"task_id":     uuid.NewUUID().String(),
+		"assigned_to": user.ID().String(),
+		"created_by":  user.ID().String(),
+	})
+	require.NoError(t, err)
+
+	newName := "Renamed User"
+	require.NoError(t, user.UpdateProfile(&newName, nil))
+	require.NoError(t, repo.Save(ctx, user))
+
+	var doc bson.M
+	require.NoError(t, tasks.FindOne(ctx, bson.M{"assigned_to": user.ID().String()}).Decode(&doc))
+	assert.Equal(t, user.Username(), doc["assignee_username"])
+	assert.Equal(t, newName, doc["assignee_display_name"])
+	assert.Equal(t, user.Username(), doc["created_by_username"])
+	assert.Equal(t, newName, doc["created_by_display_name"])
+}
+
 // TestMongoUserRepository_FindByID_NotFound checks search existing user
 func TestMongoUserRepository_FindByID_NotFound(t *testing.T) {
 	repo := setupTestUserRepository(t)
@@ -72,6 +104,28 @@ func TestMongoUserRepository_FindByID_NotFound(t *testing.T) {
 	assert.ErrorIs(t, err, errs.ErrNotFound)
 }
 
+// TestMongoUserRepository_FindByIDs checks batch search users po ID
+func TestMongoUserRepository_FindByIDs(t *testing.T) {
+	repo := setupTestUserRepository(t)
+	ctx := context.Background()
+
+	first := createTestUser(t, "batch1")
+	second := createTestUser(t, "batch2")
+	require.NoError(t, repo.Save(ctx, first))
+	require.NoError(t, repo.Save(ctx, second))
+
+	users, err := repo.FindByIDs(ctx, []uuid.UUID{first.ID(), second.ID(), first.ID(), uuid.NewUUID(), ""})
+	require.NoError(t, err)
+	require.Len(t, users, 2)
+
+	usernames := []string{users[0].Username(), users[1].Username()}
+	assert.ElementsMatch(t, []string{first.Username(), second.Username()}, usernames)
+
+	empty, err := repo.FindByIDs(ctx, nil)
+	require.NoError(t, err)
+	assert.Empty(t, empty)
+}
+
 // TestMongoUserRepository_FindByEmail checks search user po email
 func TestMongoUserRepository_FindByEmail(t *testing.T) {
 	repo := setupTestUserRepository(t)
@@ -5,12 +5,14 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
+	"regexp"
 	"time"
 
 	"go.mongodb.org/mongo-driver/v2/bson"
 	"go.mongodb.org/mongo-driver/v2/mongo"
 	"go.mongodb.org/mongo-driver/v2/mongo/options"
 
+	chatdomain "github.com/lllypuk/flowra/internal/domain/chat"
 	"github.com/lllypuk/flowra/internal/domain/errs"
 	"github.com/lllypuk/flowra/internal/domain/uuid"
 	workspacedomain "github.com/lllypuk/flowra/internal/domain/workspace"
@@ -170,6 +172,63 @@ func (r *MongoWorkspaceRepository) Count(ctx context.Context) (int, error) {
 	return count, nil
 }
 
+// ListDiscoverable returns discoverable workspaces, optionally filtered po podstroke imeni
+func (r *MongoWorkspaceRepository) ListDiscoverable(
+	ctx context.Context,
+	query string,
+	offset, limit int,
+) ([]*workspacedomain.Workspace, error) {
+	limit = DefaultLimitWithMax(limit, DefaultPaginationLimit, MaxPaginationLimit)
+
+	opts := options.Find().
+		SetSort(bson.D{{Key: "name", Value: 1}}).
+		SetLimit(int64(limit)).
+		SetSkip(int64(offset))
+
+	cursor, err := r.collection.Find(ctx, discoverableFilter(query), opts)
+	if err != nil {
+		return nil, HandleMongoError(err, "workspaces")
+	}
+	defer cursor.Close(ctx)
+
+	workspaces := make([]*workspacedomain.Workspace, 0)
+	for cursor.Next(ctx) {
+		var doc workspaceDocument
+		if decodeErr := cursor.Decode(&doc); decodeErr != nil {
+			continue
+		}
+		ws, docErr := r.documentToWorkspace(&doc)
+		if docErr != nil {
+			continue
+		}
+		workspaces = append(workspaces, ws)
+	}
+
+	if cursorErr := cursor.Err(); cursorErr != nil {
+		return nil, HandleMongoError(cursorErr, "workspaces")
+	}
+
+	return workspaces, nil
+}
+
+// CountDiscoverable returns count discoverable workspaces, sovpadayuschih s query
+func (r *MongoWorkspaceRepository) CountDiscoverable(ctx context.Context, query string) (int, error) {
+	count, err := r.collection.CountDocuments(ctx, discoverableFilter(query))
+	if err != nil {
+		return 0, HandleMongoError(err, "workspaces")
+	}
+	return int(count), nil
+}
+
+// discoverableFilter selects discoverable workspaces whose name contains query (case-insensitive)
+func discoverableFilter(query string) bson.M {
+	filter := bson.M{"discoverable": true}
+	if query != "" {
+		filter["name"] = bson.M{"$regex": regexp.QuoteMeta(query), "$options": "i"}
+	}
+	return filter
+}
+
 // FindInviteByToken finds priglashenie po tokenu
 func (r *MongoWorkspaceRepository) FindInviteByToken(
 	ctx context.Context,
@@ -207,6 +266,33 @@ type workspaceDocument struct {
 	CreatedAt       time.Time        `bson:"created_at"`
 	UpdatedAt       time.Time        `bson:"updated_at"`
 	Invites         []inviteDocument `bson:"invites"`
+	// Always written so that $set clears a lifted policy
+	ValuePolicy         valuePolicyDocument `bson:"value_policy"`
+	AnalyticsOptOut     bool                `bson:"analytics_opt_out"`
+	RequireJoinApproval bool                `bson:"require_join_approval"`
+	Discoverable        bool                `bson:"discoverable"`
+	Branding            brandingDocument    `bson:"branding"`
+	// Keyed by severity; always written so that $set clears a lifted policy
+	SLAPolicy map[string]slaTargetDocument `bson:"sla_policy"`
+}
+
+// slaTargetDocument stores the SLA targets of one severity in minutes, 0 = untracked
+type slaTargetDocument struct {
+	ResponseMinutes   int64 `bson:"response_minutes"`
+	ResolutionMinutes int64 `bson:"resolution_minutes"`
+}
+
+// brandingDocument stores the workspace avatar reference and accent color
+type brandingDocument struct {
+	AvatarFileID   string `bson:"avatar_file_id,omitempty"`
+	AvatarFileName string `bson:"avatar_file_name,omitempty"`
+	AccentColor    string `bson:"accent_color,omitempty"`
+}
+
+// valuePolicyDocument stores the allowed values keyed by entity type
+type valuePolicyDocument struct {
+	Priorities map[string][]string `bson:"priorities"`
+	Severities map[string][]string `bson:"severities"`
 }
 
 // inviteDocument represents priglashenie in dokumente
@@ -249,6 +335,19 @@ func (r *MongoWorkspaceRepository) workspaceToDocument(ws *workspacedomain.Works
 		CreatedAt:       ws.CreatedAt(),
 		UpdatedAt:       ws.UpdatedAt(),
 		Invites:         invites,
+		ValuePolicy: valuePolicyDocument{
+			Priorities: typeKeyedToDocument(ws.ValuePolicy().Priorities()),
+			Severities: typeKeyedToDocument(ws.ValuePolicy().Severities()),
+		},
+		AnalyticsOptOut:     ws.AnalyticsOptOut(),
+		RequireJoinApproval: ws.RequiresJoinApproval(),
+		Discoverable:        ws.IsDiscoverable(),
+		Branding: brandingDocument{
+			AvatarFileID:   ws.Branding().AvatarFileID().String(),
+			AvatarFileName: ws.Branding().AvatarFileName(),
+			AccentColor:    ws.Branding().AccentColor(),
+		},
+		SLAPolicy: slaPolicyToDocument(ws.SLAPolicy()),
 	}
 }
 
@@ -278,6 +377,37 @@ func (r *MongoWorkspaceRepository) documentToWorkspace(doc *workspaceDocument) (
 		invites = append(invites, invite)
 	}
 
+	// a policy that no longer validates is dropped rather than failing the load
+	valuePolicy, policyErr := workspacedomain.NewValuePolicy(
+		documentToTypeKeyed(doc.ValuePolicy.Priorities),
+		documentToTypeKeyed(doc.ValuePolicy.Severities),
+	)
+	if policyErr != nil {
+		r.logger.Warn("ignoring invalid workspace value policy",
+			slog.String("workspace_id", doc.WorkspaceID),
+		)
+	}
+
+	// invalid branding is dropped rather than failing the load, like the value policy
+	branding, brandingErr := workspacedomain.NewBranding(
+		uuid.UUID(doc.Branding.AvatarFileID),
+		doc.Branding.AvatarFileName,
+		doc.Branding.AccentColor,
+	)
+	if brandingErr != nil {
+		r.logger.Warn("ignoring invalid workspace branding",
+			slog.String("workspace_id", doc.WorkspaceID),
+		)
+	}
+
+	// an invalid SLA policy is dropped as well; bugs then have no SLA
+	slaPolicy, slaErr := documentToSLAPolicy(doc.SLAPolicy)
+	if slaErr != nil {
+		r.logger.Warn("ignoring invalid workspace SLA policy",
+			slog.String("workspace_id", doc.WorkspaceID),
+		)
+	}
+
 	return workspacedomain.Reconstruct(
 		id,
 		doc.Name,
@@ -287,9 +417,60 @@ func (r *MongoWorkspaceRepository) documentToWorkspace(doc *workspaceDocument) (
 		doc.CreatedAt,
 		doc.UpdatedAt,
 		invites,
+		valuePolicy,
+		doc.AnalyticsOptOut,
+		doc.RequireJoinApproval,
+		doc.Discoverable,
+		branding,
+		slaPolicy,
 	), nil
 }
 
+func slaPolicyToDocument(policy workspacedomain.SLAPolicy) map[string]slaTargetDocument {
+	targets := policy.Targets()
+	if len(targets) == 0 {
+		return nil
+	}
+	out := make(map[string]slaTargetDocument, len(targets))
+	for severity, target := range targets {
+		out[severity] = slaTargetDocument{
+			ResponseMinutes:   int64(target.Response / time.Minute),
+			ResolutionMinutes: int64(target.Resolution / time.Minute),
+		}
+	}
+	return out
+}
+
+func documentToSLAPolicy(m map[string]slaTargetDocument) (workspacedomain.SLAPolicy, error) {
+	targets := make(map[string]workspacedomain.SLATarget, len(m))
+	for severity, target := range m {
+		targets[severity] = workspacedomain.SLATarget{
+			Response:   time.Duration(target.ResponseMinutes) * time.Minute,
+			Resolution: time.Duration(target.ResolutionMinutes) * time.Minute,
+		}
+	}
+	return workspacedomain.NewSLAPolicy(targets)
+}
+
+func typeKeyedToDocument(m map[chatdomain.Type][]string) map[string][]string {
+	if len(m) == 0 {
+		return nil
+	}
+	out := make(map[string][]string, len(m))
+	for k, v := range m {
+		out[string(k)] = v
+	}
+	return out
+}
+
+func documentToTypeKeyed(m map[string][]string) map[chatdomain.Type][]string {
+	out := make(map[chatdomain.Type][]string, len(m))
+	for k, v := range m {
+		out[chatdomain.Type(k)] = v
+	}
+	return out
+}
+
 // documentToInvite preobrazuet inviteDocument in Invite
 func (r *MongoWorkspaceRepository) documentToInvite(doc *inviteDocument) (*workspacedomain.Invite, error) {
 	if doc == nil {
@@ -330,6 +511,15 @@ type memberDocument struct {
 	WorkspaceID string    `bson:"workspace_id"`
 	Role        string    `bson:"role"`
 	JoinedAt    time.Time `bson:"joined_at"`
+	// Status is empty for memberships saved before join approval existed; they are active
+	Status string `bson:"status,omitempty"`
+}
+
+// activeMembersFilter excludes memberships waiting for admin approval.
+// Pending members only show up through GetMember and ListPendingMembers.
+func activeMembersFilter(filter bson.M) bson.M {
+	filter["status"] = bson.M{"$ne": string(workspacedomain.MemberStatusPending)}
+	return filter
 }
 
 // GetMember returns chlena workspace po userID
@@ -358,6 +548,7 @@ func (r *MongoWorkspaceRepository) GetMember(
 		workspaceID,
 		workspacedomain.Role(doc.Role),
 		doc.JoinedAt,
+		workspacedomain.MemberStatus(doc.Status),
 	)
 	return &member, nil
 }
@@ -372,10 +563,10 @@ func (r *MongoWorkspaceRepository) IsMember(
 		return false, errs.ErrInvalidInput
 	}
 
-	filter := bson.M{
+	filter := activeMembersFilter(bson.M{
 		"workspace_id": workspaceID.String(),
 		"user_id":      userID.String(),
-	}
+	})
 
 	count, err := r.membersCollection.CountDocuments(ctx, filter, options.Count().SetLimit(1))
 	if err != nil {
@@ -396,7 +587,7 @@ func (r *MongoWorkspaceRepository) ListWorkspacesByUser(
 	}
 
 	// nahodim all workspace_id, gde user is chlenom
-	filter := bson.M{"user_id": userID.String()}
+	filter := activeMembersFilter(bson.M{"user_id": userID.String()})
 	opts := options.Find().
 		SetSort(bson.D{{Key: "joined_at", Value: -1}}).
 		SetLimit(int64(limit)).
@@ -462,7 +653,7 @@ func (r *MongoWorkspaceRepository) CountWorkspacesByUser(ctx context.Context, us
 		return 0, errs.ErrInvalidInput
 	}
 
-	filter := bson.M{"user_id": userID.String()}
+	filter := activeMembersFilter(bson.M{"user_id": userID.String()})
 	count, err := r.membersCollection.CountDocuments(ctx, filter)
 	if err != nil {
 		return 0, HandleMongoError(err, "members")
@@ -486,6 +677,7 @@ func (r *MongoWorkspaceRepository) AddMember(ctx context.Context, member *worksp
 		WorkspaceID: member.WorkspaceID().String(),
 		Role:        member.Role().String(),
 		JoinedAt:    member.JoinedAt(),
+		Status:      string(member.Status()),
 	}
 
 	filter := bson.M{
@@ -515,7 +707,9 @@ func (r *MongoWorkspaceRepository) UpdateMember(ctx context.Context, member *wor
 
 	update := bson.M{
 		"$set": bson.M{
-			"role": member.Role().String(),
+			"role":      member.Role().String(),
+			"status":    string(member.Status()),
+			"joined_at": member.JoinedAt(),
 		},
 	}
 
@@ -568,12 +762,39 @@ func (r *MongoWorkspaceRepository) ListMembers(
 		return nil, errs.ErrInvalidInput
 	}
 
-	filter := bson.M{"workspace_id": workspaceID.String()}
+	filter := activeMembersFilter(bson.M{"workspace_id": workspaceID.String()})
 	opts := options.Find().
 		SetSort(bson.D{{Key: "joined_at", Value: 1}}).
 		SetLimit(int64(limit)).
 		SetSkip(int64(offset))
 
+	return r.findMembers(ctx, filter, opts)
+}
+
+// ListPendingMembers returns chlenstva, ozhidayuschie approval administrator, oldest first
+func (r *MongoWorkspaceRepository) ListPendingMembers(
+	ctx context.Context,
+	workspaceID uuid.UUID,
+) ([]*workspacedomain.Member, error) {
+	if workspaceID.IsZero() {
+		return nil, errs.ErrInvalidInput
+	}
+
+	filter := bson.M{
+		"workspace_id": workspaceID.String(),
+		"status":       string(workspacedomain.MemberStatusPending),
+	}
+	opts := options.Find().SetSort(bson.D{{Key: "joined_at", Value: 1}})
+
+	return r.findMembers(ctx, filter, opts)
+}
+
+// findMembers loads and reconstructs the members matching filter, skipping invalid documents
+func (r *MongoWorkspaceRepository) findMembers(
+	ctx context.Context,
+	filter bson.M,
+	opts *options.FindOptionsBuilder,
+) ([]*workspacedomain.Member, error) {
 	cursor, err := r.membersCollection.Find(ctx, filter, opts)
 	if err != nil {
 		return nil, HandleMongoError(err, "members")
@@ -602,6 +823,7 @@ func (r *MongoWorkspaceRepository) ListMembers(
 			wsID,
 			workspacedomain.Role(doc.Role),
 			doc.JoinedAt,
+			workspacedomain.MemberStatus(doc.Status),
 		)
 		members = append(members, &member)
 	}
@@ -619,7 +841,7 @@ func (r *MongoWorkspaceRepository) CountMembers(ctx context.Context, workspaceID
 		return 0, errs.ErrInvalidInput
 	}
 
-	filter := bson.M{"workspace_id": workspaceID.String()}
+	filter := activeMembersFilter(bson.M{"workspace_id": workspaceID.String()})
 	count, err := r.membersCollection.CountDocuments(ctx, filter)
 	if err != nil {
 		return 0, HandleMongoError(err, "members")
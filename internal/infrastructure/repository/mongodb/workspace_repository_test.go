@@ -128,6 +128,34 @@ func TestMongoWorkspaceRepository_List(t *testing.T) {
 	assert.Empty(t, workspaces)
 }
 
+// TestMongoWorkspaceRepository_ListDiscoverable checks the discovery directory query
+func TestMongoWorkspaceRepository_ListDiscoverable(t *testing.T) {
+	repo := setupTestWorkspaceRepository(t)
+	ctx := context.Background()
+
+	for _, name := range []string{"Design", "Design Ops", "Backend"} {
+		ws := createTestWorkspace(t, name)
+		ws.SetDiscoverable(true)
+		require.NoError(t, repo.Save(ctx, ws))
+	}
+	hidden := createTestWorkspace(t, "Design Private")
+	require.NoError(t, repo.Save(ctx, hidden))
+
+	workspaces, err := repo.ListDiscoverable(ctx, "", 0, 10)
+	require.NoError(t, err)
+	assert.Len(t, workspaces, 3)
+
+	workspaces, err = repo.ListDiscoverable(ctx, "design", 0, 10)
+	require.NoError(t, err)
+	require.Len(t, workspaces, 2)
+	assert.Equal(t, "Design", workspaces[0].Name())
+	assert.True(t, workspaces[0].IsDiscoverable())
+
+	count, err := repo.CountDiscoverable(ctx, "design")
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+}
+
 // TestMongoWorkspaceRepository_Count checks podschet workspaces
 func TestMongoWorkspaceRepository_Count(t *testing.T) {
 	repo := setupTestWorkspaceRepository(t)
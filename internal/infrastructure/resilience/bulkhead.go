@@ -0,0 +1,217 @@
+// Package resilience isolates calls to external dependencies (Keycloak, Redis,
+// blob storage) so that one slow dependency cannot tie up every request handler.
+package resilience
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sync/atomic"
+	"time"
+)
+
+// Bulkhead defaults.
+const (
+	DefaultMaxConcurrent = 32
+	DefaultMaxWait       = 100 * time.Millisecond
+	DefaultTimeout       = 5 * time.Second
+)
+
+// Bulkhead rejection reasons.
+var (
+	// ErrBulkheadFull is returned when no call slot frees up within MaxWait.
+	ErrBulkheadFull = errors.New("bulkhead full")
+
+	// ErrCallTimeout is returned when a call does not finish within the bulkhead timeout.
+	ErrCallTimeout = errors.New("dependency call timed out")
+)
+
+// DependencyError reports a call rejected or cut short by a bulkhead.
+// It maps to 503 Service Unavailable in API responses.
+type DependencyError struct {
+	Dependency string
+	Err        error
+}
+
+// Error implements error.
+func (e *DependencyError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Dependency, e.Err.Error())
+}
+
+// Unwrap returns ErrBulkheadFull or ErrCallTimeout.
+func (e *DependencyError) Unwrap() error {
+	return e.Err
+}
+
+// Retryable reports false: retrying into a saturated or slow dependency only adds load.
+func (e *DependencyError) Retryable() bool {
+	return false
+}
+
+// HTTPStatus implements httpserver.HTTPError.
+func (e *DependencyError) HTTPStatus() int {
+	return http.StatusServiceUnavailable
+}
+
+// HTTPCode implements httpserver.HTTPError.
+func (e *DependencyError) HTTPCode() string {
+	return "DEPENDENCY_UNAVAILABLE"
+}
+
+// HTTPMessage implements httpserver.HTTPError.
+func (e *DependencyError) HTTPMessage() string {
+	return "A backing service is busy. Please try again shortly."
+}
+
+// BulkheadConfig configures a bulkhead.
+type BulkheadConfig struct {
+	// Name identifies the dependency in errors and metrics.
+	Name string
+
+	// MaxConcurrent is the maximum number of calls in flight.
+	MaxConcurrent int
+
+	// MaxWait is how long a call waits for a free slot before it is rejected.
+	MaxWait time.Duration
+
+	// Timeout bounds each call. It applies on top of, and independently from,
+	// the deadline of the incoming request.
+	Timeout time.Duration
+}
+
+// BulkheadStats is a snapshot of bulkhead counters.
+type BulkheadStats struct {
+	InFlight int64
+	Rejected int64
+	TimedOut int64
+}
+
+// Bulkhead limits concurrent calls to one dependency and bounds each call with a timeout.
+type Bulkhead struct {
+	name    string
+	slots   chan struct{}
+	maxWait time.Duration
+	timeout time.Duration
+
+	inFlight atomic.Int64
+	rejected atomic.Int64
+	timedOut atomic.Int64
+}
+
+// NewBulkhead creates a bulkhead. Zero values in config fall back to the defaults.
+func NewBulkhead(config BulkheadConfig) *Bulkhead {
+	if config.MaxConcurrent <= 0 {
+		config.MaxConcurrent = DefaultMaxConcurrent
+	}
+	if config.MaxWait < 0 {
+		config.MaxWait = 0
+	} else if config.MaxWait == 0 {
+		config.MaxWait = DefaultMaxWait
+	}
+	if config.Timeout <= 0 {
+		config.Timeout = DefaultTimeout
+	}
+
+	return &Bulkhead{
+		name:    config.Name,
+		slots:   make(chan struct{}, config.MaxConcurrent),
+		maxWait: config.MaxWait,
+		timeout: config.Timeout,
+	}
+}
+
+// Name returns the dependency name.
+func (b *Bulkhead) Name() string {
+	return b.name
+}
+
+// Timeout returns the per-call timeout.
+func (b *Bulkhead) Timeout() time.Duration {
+	return b.timeout
+}
+
+// Stats returns the current counters.
+func (b *Bulkhead) Stats() BulkheadStats {
+	return BulkheadStats{
+		InFlight: b.inFlight.Load(),
+		Rejected: b.rejected.Load(),
+		TimedOut: b.timedOut.Load(),
+	}
+}
+
+// Do runs fn once a slot is free, with a context bounded by the bulkhead timeout.
+// It returns a *DependencyError if no slot frees up within MaxWait or if fn
+// runs past the timeout; cancellation of ctx itself is returned unchanged.
+func (b *Bulkhead) Do(ctx context.Context, fn func(ctx context.Context) error) error {
+	release, err := b.acquire(ctx)
+	if err != nil {
+		return err
+	}
+	defer release()
+
+	callCtx, cancel := context.WithTimeout(ctx, b.timeout)
+	defer cancel()
+
+	err = fn(callCtx)
+	if err != nil && ctx.Err() == nil && errors.Is(callCtx.Err(), context.DeadlineExceeded) {
+		b.timedOut.Add(1)
+		return &DependencyError{Dependency: b.name, Err: fmt.Errorf("%w after %s: %w", ErrCallTimeout, b.timeout, err)}
+	}
+	return err
+}
+
+// acquire takes a slot and returns the function that frees it.
+func (b *Bulkhead) acquire(ctx context.Context) (func(), error) {
+	select {
+	case b.slots <- struct{}{}:
+		return b.enter(), nil
+	default:
+	}
+
+	if b.maxWait == 0 {
+		b.rejected.Add(1)
+		return nil, &DependencyError{Dependency: b.name, Err: ErrBulkheadFull}
+	}
+
+	timer := time.NewTimer(b.maxWait)
+	defer timer.Stop()
+
+	select {
+	case b.slots <- struct{}{}:
+		return b.enter(), nil
+	case <-timer.C:
+		b.rejected.Add(1)
+		return nil, &DependencyError{Dependency: b.name, Err: ErrBulkheadFull}
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+func (b *Bulkhead) enter() func() {
+	b.inFlight.Add(1)
+	return func() {
+		b.inFlight.Add(-1)
+		<-b.slots
+	}
+}
+
+// contextReader fails reads once its context is done.
+type contextReader struct {
+	ctx context.Context //nolint:containedctx // The reader is bound to one bulkhead call.
+	r   io.Reader
+}
+
+// NewContextReader returns a reader that stops with the context error once ctx is done,
+// so that copying a slow stream inside Bulkhead.Do honours the call timeout.
+func NewContextReader(ctx context.Context, r io.Reader) io.Reader {
+	return &contextReader{ctx: ctx, r: r}
+}
+
+func (r *contextReader) Read(p []byte) (int, error) {
+	if err := r.ctx.Err(); err != nil {
+		return 0, err
+	}
+	return r.r.Read(p)
+}
@@ -0,0 +1,112 @@
+package resilience_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lllypuk/flowra/internal/infrastructure/resilience"
+)
+
+func TestBulkhead_Do_RunsCall(t *testing.T) {
+	b := resilience.NewBulkhead(resilience.BulkheadConfig{Name: "redis", MaxConcurrent: 1})
+
+	called := false
+	err := b.Do(context.Background(), func(ctx context.Context) error {
+		called = true
+		_, hasDeadline := ctx.Deadline()
+		assert.True(t, hasDeadline)
+		return nil
+	})
+
+	require.NoError(t, err)
+	assert.True(t, called)
+	assert.Equal(t, resilience.BulkheadStats{}, b.Stats())
+}
+
+func TestBulkhead_Do_RejectsWhenFull(t *testing.T) {
+	b := resilience.NewBulkhead(resilience.BulkheadConfig{
+		Name:          "keycloak",
+		MaxConcurrent: 1,
+		MaxWait:       10 * time.Millisecond,
+	})
+
+	started := make(chan struct{})
+	unblock := make(chan struct{})
+	done := make(chan error, 1)
+	go func() {
+		done <- b.Do(context.Background(), func(context.Context) error {
+			close(started)
+			<-unblock
+			return nil
+		})
+	}()
+	<-started
+
+	err := b.Do(context.Background(), func(context.Context) error {
+		t.Fatal("call must not run while the bulkhead is full")
+		return nil
+	})
+
+	var depErr *resilience.DependencyError
+	require.ErrorAs(t, err, &depErr)
+	require.ErrorIs(t, err, resilience.ErrBulkheadFull)
+	assert.Equal(t, "keycloak", depErr.Dependency)
+	assert.Equal(t, http.StatusServiceUnavailable, depErr.HTTPStatus())
+	assert.Equal(t, int64(1), b.Stats().InFlight)
+	assert.Equal(t, int64(1), b.Stats().Rejected)
+
+	close(unblock)
+	require.NoError(t, <-done)
+	assert.Equal(t, int64(0), b.Stats().InFlight)
+}
+
+func TestBulkhead_Do_TimesOut(t *testing.T) {
+	b := resilience.NewBulkhead(resilience.BulkheadConfig{Name: "blob", Timeout: 10 * time.Millisecond})
+
+	err := b.Do(context.Background(), func(ctx context.Context) error {
+		<-ctx.Done()
+		return ctx.Err()
+	})
+
+	require.ErrorIs(t, err, resilience.ErrCallTimeout)
+	var depErr *resilience.DependencyError
+	require.ErrorAs(t, err, &depErr)
+	assert.Equal(t, int64(1), b.Stats().TimedOut)
+}
+
+func TestBulkhead_Do_KeepsCallerCancellation(t *testing.T) {
+	b := resilience.NewBulkhead(resilience.BulkheadConfig{Name: "blob"})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	err := b.Do(ctx, func(ctx context.Context) error {
+		return ctx.Err()
+	})
+
+	require.ErrorIs(t, err, context.Canceled)
+	var depErr *resilience.DependencyError
+	assert.False(t, errors.As(err, &depErr))
+}
+
+func TestNewContextReader_StopsAfterCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	r := resilience.NewContextReader(ctx, strings.NewReader("payload"))
+
+	buf := make([]byte, 3)
+	n, err := r.Read(buf)
+	require.NoError(t, err)
+	assert.Equal(t, 3, n)
+
+	cancel()
+	_, err = io.ReadAll(r)
+	require.ErrorIs(t, err, context.Canceled)
+}
@@ -0,0 +1,87 @@
+package resilience
+
+import (
+	"context"
+	"io"
+	"net/http"
+)
+
+// Transport is an http.RoundTripper that sends every request through a bulkhead.
+// The slot is held until the response body is closed or fully read.
+type Transport struct {
+	base     http.RoundTripper
+	bulkhead *Bulkhead
+}
+
+// NewTransport wraps base, or http.DefaultTransport if base is nil.
+func NewTransport(base http.RoundTripper, bulkhead *Bulkhead) *Transport {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return &Transport{base: base, bulkhead: bulkhead}
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	release, err := t.bulkhead.acquire(req.Context())
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(req.Context(), t.bulkhead.timeout)
+	resp, err := t.base.RoundTrip(req.WithContext(ctx))
+	if err != nil {
+		timedOut := req.Context().Err() == nil && ctx.Err() != nil
+		cancel()
+		release()
+		if timedOut {
+			t.bulkhead.timedOut.Add(1)
+			return nil, &DependencyError{Dependency: t.bulkhead.name, Err: ErrCallTimeout}
+		}
+		return nil, err
+	}
+
+	resp.Body = &releasingBody{ReadCloser: resp.Body, release: func() {
+		cancel()
+		release()
+	}}
+	return resp, nil
+}
+
+// HTTPClient returns a copy of client (or a new client) whose transport goes through the bulkhead.
+func HTTPClient(client *http.Client, bulkhead *Bulkhead) *http.Client {
+	wrapped := &http.Client{}
+	if client != nil {
+		*wrapped = *client
+	}
+	wrapped.Transport = NewTransport(wrapped.Transport, bulkhead)
+	return wrapped
+}
+
+// releasingBody frees the bulkhead slot once the body is closed or drained.
+type releasingBody struct {
+	io.ReadCloser
+	release  func()
+	released bool
+}
+
+func (b *releasingBody) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	if err == io.EOF {
+		b.done()
+	}
+	return n, err
+}
+
+func (b *releasingBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.done()
+	return err
+}
+
+func (b *releasingBody) done() {
+	if !b.released {
+		b.released = true
+		b.release()
+	}
+}
@@ -0,0 +1,64 @@
+package resilience_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lllypuk/flowra/internal/infrastructure/resilience"
+)
+
+func TestTransport_ReleasesSlotOnBodyClose(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte("ok"))
+	}))
+	defer server.Close()
+
+	b := resilience.NewBulkhead(resilience.BulkheadConfig{Name: "keycloak", MaxConcurrent: 1, MaxWait: -1})
+	client := resilience.HTTPClient(nil, b)
+
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, server.URL, nil)
+	require.NoError(t, err)
+	resp, err := client.Do(req)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), b.Stats().InFlight)
+
+	_, err = client.Do(req.Clone(context.Background()))
+	require.ErrorIs(t, err, resilience.ErrBulkheadFull)
+
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+	assert.Equal(t, "ok", string(body))
+	assert.Equal(t, int64(0), b.Stats().InFlight)
+
+	resp, err = client.Do(req.Clone(context.Background()))
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+}
+
+func TestTransport_TimesOut(t *testing.T) {
+	unblock := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {
+		<-unblock
+	}))
+	defer server.Close()
+	defer close(unblock)
+
+	b := resilience.NewBulkhead(resilience.BulkheadConfig{Name: "keycloak", Timeout: 20 * time.Millisecond})
+	client := resilience.HTTPClient(&http.Client{}, b)
+
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, server.URL, nil)
+	require.NoError(t, err)
+	_, err = client.Do(req) //nolint:bodyclose // The request fails before a response is returned.
+
+	require.ErrorIs(t, err, resilience.ErrCallTimeout)
+	assert.Equal(t, int64(1), b.Stats().TimedOut)
+	assert.Equal(t, int64(0), b.Stats().InFlight)
+}
@@ -0,0 +1,59 @@
+package resilience
+
+import (
+	"context"
+	"net"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// RedisHook sends every Redis command and pipeline through a bulkhead.
+// Pub/Sub receive loops do not pass through command hooks and are not limited.
+// The client needs ContextTimeoutEnabled for the bulkhead timeout to cut off slow replies.
+type RedisHook struct {
+	bulkhead *Bulkhead
+}
+
+// NewRedisHook creates a Redis hook for the bulkhead.
+func NewRedisHook(bulkhead *Bulkhead) *RedisHook {
+	return &RedisHook{bulkhead: bulkhead}
+}
+
+var _ redis.Hook = (*RedisHook)(nil)
+
+// DialHook implements redis.Hook. Dialing is bounded by the client's DialTimeout.
+func (h *RedisHook) DialHook(next redis.DialHook) redis.DialHook {
+	return func(ctx context.Context, network, addr string) (net.Conn, error) {
+		return next(ctx, network, addr)
+	}
+}
+
+// ProcessHook implements redis.Hook.
+func (h *RedisHook) ProcessHook(next redis.ProcessHook) redis.ProcessHook {
+	return func(ctx context.Context, cmd redis.Cmder) error {
+		err := h.bulkhead.Do(ctx, func(ctx context.Context) error {
+			return next(ctx, cmd)
+		})
+		if err != nil && cmd.Err() == nil {
+			cmd.SetErr(err)
+		}
+		return err
+	}
+}
+
+// ProcessPipelineHook implements redis.Hook.
+func (h *RedisHook) ProcessPipelineHook(next redis.ProcessPipelineHook) redis.ProcessPipelineHook {
+	return func(ctx context.Context, cmds []redis.Cmder) error {
+		err := h.bulkhead.Do(ctx, func(ctx context.Context) error {
+			return next(ctx, cmds)
+		})
+		if err != nil {
+			for _, cmd := range cmds {
+				if cmd.Err() == nil {
+					cmd.SetErr(err)
+				}
+			}
+		}
+		return err
+	}
+}
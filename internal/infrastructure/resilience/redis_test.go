@@ -0,0 +1,60 @@
+package resilience_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lllypuk/flowra/internal/infrastructure/resilience"
+)
+
+func TestRedisHook_RejectsWhenFull(t *testing.T) {
+	b := resilience.NewBulkhead(resilience.BulkheadConfig{Name: "redis", MaxConcurrent: 1, MaxWait: -1})
+	hook := resilience.NewRedisHook(b)
+
+	var nested error
+	process := hook.ProcessHook(func(ctx context.Context, _ redis.Cmder) error {
+		inner := redis.NewStringCmd(ctx, "get", "other")
+		nested = hook.ProcessHook(func(context.Context, redis.Cmder) error { return nil })(ctx, inner)
+		return nil
+	})
+
+	cmd := redis.NewStringCmd(context.Background(), "get", "key")
+	require.NoError(t, process(context.Background(), cmd))
+	require.ErrorIs(t, nested, resilience.ErrBulkheadFull)
+	assert.Equal(t, int64(1), b.Stats().Rejected)
+}
+
+func TestRedisHook_PipelineSetsCommandErrors(t *testing.T) {
+	b := resilience.NewBulkhead(resilience.BulkheadConfig{Name: "redis", MaxConcurrent: 1, MaxWait: -1})
+	hook := resilience.NewRedisHook(b)
+
+	blocked := make(chan struct{})
+	unblock := make(chan struct{})
+	go func() {
+		_ = hook.ProcessHook(func(context.Context, redis.Cmder) error {
+			close(blocked)
+			<-unblock
+			return nil
+		})(context.Background(), redis.NewStatusCmd(context.Background(), "ping"))
+	}()
+	<-blocked
+	defer close(unblock)
+
+	cmds := []redis.Cmder{
+		redis.NewIntCmd(context.Background(), "incr", "a"),
+		redis.NewIntCmd(context.Background(), "incr", "b"),
+	}
+	err := hook.ProcessPipelineHook(func(context.Context, []redis.Cmder) error {
+		t.Fatal("pipeline must not run while the bulkhead is full")
+		return nil
+	})(context.Background(), cmds)
+
+	require.ErrorIs(t, err, resilience.ErrBulkheadFull)
+	for _, cmd := range cmds {
+		require.ErrorIs(t, cmd.Err(), resilience.ErrBulkheadFull)
+	}
+}
@@ -0,0 +1,212 @@
+// Package storage provides object storage for attachments on S3-compatible
+// services such as AWS S3 and MinIO.
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+
+	"github.com/lllypuk/flowra/internal/domain/uuid"
+)
+
+// Client defaults and limits.
+const (
+	DefaultPresignExpiry = 15 * time.Minute
+	// MaxPresignExpiry is the longest validity S3 allows for a presigned URL.
+	MaxPresignExpiry = 7 * 24 * time.Hour
+)
+
+// Object storage errors.
+var (
+	ErrInvalidConfig  = errors.New("invalid object storage configuration")
+	ErrObjectNotFound = errors.New("object not found")
+)
+
+// S3Config contains the configuration of an S3Client.
+type S3Config struct {
+	// Endpoint is the base URL of the service, e.g. "https://s3.eu-central-1.amazonaws.com"
+	// or "http://minio:9000".
+	Endpoint string
+
+	// Region is the region requests are signed for; MinIO accepts "us-east-1".
+	Region string
+
+	// Bucket holds the objects; it must exist.
+	Bucket string
+
+	AccessKey string
+	SecretKey string
+
+	// PathStyle addresses the bucket as <endpoint>/<bucket> instead of
+	// <bucket>.<endpoint host>. MinIO needs it unless configured with a domain.
+	PathStyle bool
+
+	// PresignExpiry is how long presigned URLs are valid. Zero uses DefaultPresignExpiry.
+	PresignExpiry time.Duration
+
+	// Transport is an optional custom HTTP transport.
+	Transport http.RoundTripper
+}
+
+// ObjectInfo describes a stored object.
+type ObjectInfo struct {
+	Size        int64
+	ContentType string
+
+	// Metadata holds the user metadata of the object with lower-case keys.
+	Metadata map[string]string
+}
+
+// amzMetaPrefix prefixes the headers carrying user metadata of an object.
+const amzMetaPrefix = "X-Amz-Meta-"
+
+// S3Client stores objects in one bucket of an S3-compatible service.
+type S3Client struct {
+	client        *minio.Client
+	bucket        string
+	presignExpiry time.Duration
+	now           func() time.Time
+}
+
+// NewS3Client creates a new S3 client. It does not contact the service.
+func NewS3Client(cfg S3Config) (*S3Client, error) {
+	endpoint, err := url.Parse(strings.TrimSuffix(cfg.Endpoint, "/"))
+	if err != nil || (endpoint.Scheme != "http" && endpoint.Scheme != "https") || endpoint.Host == "" ||
+		endpoint.Path != "" {
+		return nil, fmt.Errorf("%w: endpoint must be an http or https URL without a path", ErrInvalidConfig)
+	}
+	if cfg.Region == "" || cfg.Bucket == "" || cfg.AccessKey == "" || cfg.SecretKey == "" {
+		return nil, fmt.Errorf("%w: region, bucket, access key and secret key are required", ErrInvalidConfig)
+	}
+	if cfg.PresignExpiry < 0 || cfg.PresignExpiry > MaxPresignExpiry {
+		return nil, fmt.Errorf("%w: presign expiry must be at most %s", ErrInvalidConfig, MaxPresignExpiry)
+	}
+
+	presignExpiry := cfg.PresignExpiry
+	if presignExpiry == 0 {
+		presignExpiry = DefaultPresignExpiry
+	}
+	lookup := minio.BucketLookupDNS
+	if cfg.PathStyle {
+		lookup = minio.BucketLookupPath
+	}
+
+	// The region is always set, so the client never queries the bucket location
+	client, err := minio.New(endpoint.Host, &minio.Options{
+		Creds:        credentials.NewStaticV4(cfg.AccessKey, cfg.SecretKey, ""),
+		Secure:       endpoint.Scheme == "https",
+		Transport:    cfg.Transport,
+		Region:       cfg.Region,
+		BucketLookup: lookup,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidConfig, err)
+	}
+	// Keep AWS endpoints as configured instead of switching to dual-stack hosts
+	client.SetS3EnableDualstack(false)
+
+	return &S3Client{
+		client:        client,
+		bucket:        cfg.Bucket,
+		presignExpiry: presignExpiry,
+		now:           time.Now,
+	}, nil
+}
+
+// Put uploads size bytes of body as the object key.
+func (c *S3Client) Put(ctx context.Context, key string, body io.Reader, size int64, contentType string) error {
+	_, err := c.client.PutObject(ctx, c.bucket, key, body, size, minio.PutObjectOptions{ContentType: contentType})
+	if err != nil {
+		return wrapError(err, key)
+	}
+	return nil
+}
+
+// Stat returns the size, content type and user metadata of the object key.
+func (c *S3Client) Stat(ctx context.Context, key string) (ObjectInfo, error) {
+	info, err := c.client.StatObject(ctx, c.bucket, key, minio.StatObjectOptions{})
+	if err != nil {
+		return ObjectInfo{}, wrapError(err, key)
+	}
+	result := ObjectInfo{Size: info.Size, ContentType: info.ContentType}
+	if len(info.UserMetadata) > 0 {
+		result.Metadata = make(map[string]string, len(info.UserMetadata))
+		for name, value := range info.UserMetadata {
+			result.Metadata[strings.ToLower(name)] = value
+		}
+	}
+	return result, nil
+}
+
+// Delete removes the object key. Deleting a missing object succeeds.
+func (c *S3Client) Delete(ctx context.Context, key string) error {
+	if err := c.client.RemoveObject(ctx, c.bucket, key, minio.RemoveObjectOptions{}); err != nil {
+		if wrapped := wrapError(err, key); !errors.Is(wrapped, ErrObjectNotFound) {
+			return wrapped
+		}
+	}
+	return nil
+}
+
+// PresignGet returns a URL that downloads the object key without credentials
+// until it expires. With a file name, browsers save the download under that name.
+func (c *S3Client) PresignGet(ctx context.Context, key, fileName string) (string, time.Time, error) {
+	params := url.Values{}
+	if fileName != "" {
+		params.Set("response-content-disposition",
+			mime.FormatMediaType("attachment", map[string]string{"filename": fileName}))
+	}
+	expiresAt := c.now().Add(c.presignExpiry)
+	u, err := c.client.PresignedGetObject(ctx, c.bucket, key, c.presignExpiry, params)
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("failed to presign download of %s: %w", key, err)
+	}
+	return u.String(), expiresAt, nil
+}
+
+// PresignPut returns a URL that uploads the object key without credentials until
+// it expires, so clients can send large files to the bucket directly. The metadata
+// is part of the signature: the upload must send the returned headers unchanged,
+// and Stat reports the metadata of the uploaded object.
+func (c *S3Client) PresignPut(
+	ctx context.Context,
+	key string,
+	metadata map[string]string,
+) (string, http.Header, time.Time, error) {
+	headers := make(http.Header, len(metadata))
+	for name, value := range metadata {
+		headers.Set(amzMetaPrefix+name, value)
+	}
+	expiresAt := c.now().Add(c.presignExpiry)
+	u, err := c.client.PresignHeader(ctx, http.MethodPut, c.bucket, key, c.presignExpiry, nil, headers)
+	if err != nil {
+		return "", nil, time.Time{}, fmt.Errorf("failed to presign upload of %s: %w", key, err)
+	}
+	return u.String(), headers, expiresAt, nil
+}
+
+// wrapError maps missing objects to ErrObjectNotFound.
+func wrapError(err error, key string) error {
+	resp := minio.ToErrorResponse(err)
+	if resp.StatusCode == http.StatusNotFound || resp.Code == "NoSuchKey" {
+		return fmt.Errorf("%w: %s", ErrObjectNotFound, key)
+	}
+	return fmt.Errorf("object storage request for %s failed: %w", key, err)
+}
+
+// ChatAttachmentKey returns the object key of a file attached to a chat. The
+// extension of the file name is kept so the object has a recognisable type.
+func ChatAttachmentKey(chatID, fileID uuid.UUID, fileName string) string {
+	return "chats/" + chatID.String() + "/" + fileID.String() + strings.ToLower(path.Ext(fileName))
+}
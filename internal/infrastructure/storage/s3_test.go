@@ -0,0 +1,212 @@
+package storage_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/lllypuk/flowra/internal/domain/uuid"
+	"github.com/lllypuk/flowra/internal/infrastructure/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeS3 keeps objects in memory and records the requests it served.
+type fakeS3 struct {
+	mu       sync.Mutex
+	objects  map[string][]byte
+	types    map[string]string
+	meta     map[string]http.Header
+	requests []*http.Request
+}
+
+// newFakeS3 serves over TLS so the client sends plain, unsigned payloads.
+func newFakeS3(t *testing.T) (*fakeS3, *httptest.Server) {
+	t.Helper()
+	fake := &fakeS3{
+		objects: make(map[string][]byte),
+		types:   make(map[string]string),
+		meta:    make(map[string]http.Header),
+	}
+	server := httptest.NewTLSServer(http.HandlerFunc(fake.serve))
+	t.Cleanup(server.Close)
+	return fake, server
+}
+
+func (f *fakeS3) serve(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.requests = append(f.requests, r)
+
+	presigned := strings.HasPrefix(r.URL.Query().Get("X-Amz-Credential"), "minio/")
+	if !presigned && !strings.HasPrefix(r.Header.Get("Authorization"), "AWS4-HMAC-SHA256 Credential=minio/") {
+		w.WriteHeader(http.StatusForbidden)
+		_, _ = io.WriteString(w, "<Error><Code>AccessDenied</Code><Message>Access Denied.</Message></Error>")
+		return
+	}
+
+	switch r.Method {
+	case http.MethodPut:
+		body, _ := io.ReadAll(r.Body)
+		f.objects[r.URL.Path] = body
+		f.types[r.URL.Path] = r.Header.Get("Content-Type")
+		f.meta[r.URL.Path] = make(http.Header)
+		for name, values := range r.Header {
+			if strings.HasPrefix(name, "X-Amz-Meta-") {
+				f.meta[r.URL.Path][name] = values
+			}
+		}
+		w.Header().Set("ETag", `"etag"`)
+	case http.MethodHead:
+		body, ok := f.objects[r.URL.Path]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Length", strconv.Itoa(len(body)))
+		w.Header().Set("Content-Type", f.types[r.URL.Path])
+		for name, values := range f.meta[r.URL.Path] {
+			w.Header()[name] = values
+		}
+		w.Header().Set("Last-Modified", time.Now().UTC().Format(http.TimeFormat))
+		w.Header().Set("ETag", `"etag"`)
+	case http.MethodDelete:
+		delete(f.objects, r.URL.Path)
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+func newTestClient(t *testing.T, server *httptest.Server, accessKey string) *storage.S3Client {
+	t.Helper()
+	client, err := storage.NewS3Client(storage.S3Config{
+		Endpoint:  server.URL,
+		Region:    "us-east-1",
+		Bucket:    "attachments",
+		AccessKey: accessKey,
+		SecretKey: "minio-secret",
+		PathStyle: true,
+		Transport: server.Client().Transport,
+	})
+	require.NoError(t, err)
+	return client
+}
+
+func TestS3Client_PutStatDelete(t *testing.T) {
+	fake, server := newFakeS3(t)
+	client := newTestClient(t, server, "minio")
+	ctx := context.Background()
+	key := storage.ChatAttachmentKey(uuid.NewUUID(), uuid.NewUUID(), "Report Q1.PDF")
+	require.True(t, strings.HasSuffix(key, ".pdf"))
+
+	require.NoError(t, client.Put(ctx, key, strings.NewReader("%PDF-1.7"), 8, "application/pdf"))
+	assert.Equal(t, "%PDF-1.7", string(fake.objects["/attachments/"+key]))
+
+	info, err := client.Stat(ctx, key)
+	require.NoError(t, err)
+	assert.Equal(t, storage.ObjectInfo{Size: 8, ContentType: "application/pdf"}, info)
+
+	require.NoError(t, client.Delete(ctx, key))
+	assert.Empty(t, fake.objects)
+	require.NoError(t, client.Delete(ctx, key), "deleting a missing object succeeds")
+
+	_, err = client.Stat(ctx, key)
+	require.ErrorIs(t, err, storage.ErrObjectNotFound)
+}
+
+func TestS3Client_ErrorResponse(t *testing.T) {
+	_, server := newFakeS3(t)
+	client := newTestClient(t, server, "intruder")
+
+	err := client.Put(context.Background(), "chats/x", strings.NewReader("x"), 1, "text/plain")
+
+	require.Error(t, err)
+	require.NotErrorIs(t, err, storage.ErrObjectNotFound)
+	assert.Contains(t, err.Error(), "Access Denied")
+}
+
+func TestS3Client_Presign(t *testing.T) {
+	client, err := storage.NewS3Client(storage.S3Config{
+		Endpoint:      "https://s3.eu-central-1.amazonaws.com",
+		Region:        "eu-central-1",
+		Bucket:        "flowra",
+		AccessKey:     "AKIA",
+		SecretKey:     "secret",
+		PresignExpiry: time.Hour,
+	})
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	before := time.Now()
+	download, expiresAt, err := client.PresignGet(ctx, "chats/a/b.pdf", `Q1 "final".pdf`)
+	require.NoError(t, err)
+	u, err := url.Parse(download)
+	require.NoError(t, err)
+	assert.Equal(t, "flowra.s3.eu-central-1.amazonaws.com", u.Host, "virtual-hosted-style by default")
+	assert.Equal(t, "/chats/a/b.pdf", u.Path)
+	assert.Equal(t, "3600", u.Query().Get("X-Amz-Expires"))
+	assert.Equal(t, `attachment; filename="Q1 \"final\".pdf"`, u.Query().Get("response-content-disposition"))
+	assert.NotEmpty(t, u.Query().Get("X-Amz-Signature"))
+	assert.WithinDuration(t, before.Add(time.Hour), expiresAt, time.Minute)
+
+	upload, headers, _, err := client.PresignPut(ctx, "chats/a/b.pdf", map[string]string{"uploader": "u-1"})
+	require.NoError(t, err)
+	assert.Contains(t, upload, "X-Amz-Signature=")
+	assert.NotEqual(t, download, upload)
+	assert.Equal(t, "u-1", headers.Get("X-Amz-Meta-Uploader"))
+	u, err = url.Parse(upload)
+	require.NoError(t, err)
+	assert.Contains(t, u.Query().Get("X-Amz-SignedHeaders"), "x-amz-meta-uploader", "metadata is signed")
+}
+
+func TestS3Client_PresignPutMetadata(t *testing.T) {
+	fake, server := newFakeS3(t)
+	client := newTestClient(t, server, "minio")
+	ctx := context.Background()
+	key := storage.ChatAttachmentKey(uuid.NewUUID(), uuid.NewUUID(), "notes.txt")
+
+	upload, headers, _, err := client.PresignPut(ctx, key, map[string]string{"uploader": "u-1"})
+	require.NoError(t, err)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, upload, strings.NewReader("hello"))
+	require.NoError(t, err)
+	for name, values := range headers {
+		req.Header[name] = values
+	}
+	req.Header.Set("Content-Type", "text/plain")
+	resp, err := server.Client().Do(req)
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Contains(t, fake.objects, "/attachments/"+key)
+
+	info, err := client.Stat(ctx, key)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"uploader": "u-1"}, info.Metadata)
+}
+
+func TestNewS3Client_Validation(t *testing.T) {
+	valid := storage.S3Config{
+		Endpoint: "http://minio:9000", Region: "us-east-1", Bucket: "b", AccessKey: "a", SecretKey: "s",
+	}
+	_, err := storage.NewS3Client(valid)
+	require.NoError(t, err)
+
+	for name, mutate := range map[string]func(*storage.S3Config){
+		"no scheme":     func(c *storage.S3Config) { c.Endpoint = "minio:9000" },
+		"with path":     func(c *storage.S3Config) { c.Endpoint = "http://minio:9000/s3" },
+		"no bucket":     func(c *storage.S3Config) { c.Bucket = "" },
+		"no secret":     func(c *storage.S3Config) { c.SecretKey = "" },
+		"long presigns": func(c *storage.S3Config) { c.PresignExpiry = storage.MaxPresignExpiry + time.Second },
+	} {
+		cfg := valid
+		mutate(&cfg)
+		_, err = storage.NewS3Client(cfg)
+		require.ErrorIs(t, err, storage.ErrInvalidConfig, name)
+	}
+}
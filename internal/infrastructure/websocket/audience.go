@@ -0,0 +1,169 @@
+package websocket
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/lllypuk/flowra/internal/domain/uuid"
+)
+
+// audienceCacheTTL bounds how long public chat audiences and workspace memberships are
+// reused. Workspace membership changes therefore take effect on live connections within
+// this window. Private chat audiences are never cached: a removed participant must stop
+// receiving events at once on every node, and invalidation events only reach this one.
+const audienceCacheTTL = 10 * time.Second
+
+// audienceCacheSweepSize is the cache size above which expired entries are swept on insert.
+const audienceCacheSweepSize = 10000
+
+// ChatAudience describes who may receive the events of a chat.
+type ChatAudience struct {
+	WorkspaceID  uuid.UUID
+	IsPublic     bool
+	Participants []uuid.UUID
+}
+
+// ChatAudienceResolver looks up chat audiences and workspace memberships.
+// Declared on the consumer side per project guidelines.
+type ChatAudienceResolver interface {
+	// ChatAudience returns the workspace and participants of a chat.
+	ChatAudience(ctx context.Context, chatID uuid.UUID) (ChatAudience, error)
+
+	// IsWorkspaceMember reports whether the user belongs to the workspace.
+	IsWorkspaceMember(ctx context.Context, workspaceID, userID uuid.UUID) (bool, error)
+}
+
+// audienceFilter decides which subscribers of a chat room may receive its events:
+// members of the chat's workspace, and for private chats only its participants.
+type audienceFilter struct {
+	resolver ChatAudienceResolver
+	ttl      time.Duration
+	now      func() time.Time
+
+	mu        sync.Mutex
+	audiences map[uuid.UUID]cachedAudience
+	members   map[membershipKey]cachedMembership
+}
+
+type cachedAudience struct {
+	audience  ChatAudience
+	expiresAt time.Time
+}
+
+type membershipKey struct {
+	workspaceID uuid.UUID
+	userID      uuid.UUID
+}
+
+type cachedMembership struct {
+	member    bool
+	expiresAt time.Time
+}
+
+func newAudienceFilter(resolver ChatAudienceResolver) *audienceFilter {
+	return &audienceFilter{
+		resolver:  resolver,
+		ttl:       audienceCacheTTL,
+		now:       time.Now,
+		audiences: make(map[uuid.UUID]cachedAudience),
+		members:   make(map[membershipKey]cachedMembership),
+	}
+}
+
+// allowed returns the users among candidates who may receive events of the chat.
+func (f *audienceFilter) allowed(ctx context.Context, chatID uuid.UUID, candidates []uuid.UUID) ([]uuid.UUID, error) {
+	audience, err := f.audience(ctx, chatID)
+	if err != nil {
+		return nil, err
+	}
+
+	allowed := make([]uuid.UUID, 0, len(candidates))
+	for _, userID := range candidates {
+		if !audience.IsPublic && !slices.Contains(audience.Participants, userID) {
+			continue
+		}
+		member, memberErr := f.isMember(ctx, audience.WorkspaceID, userID)
+		if memberErr != nil {
+			return nil, memberErr
+		}
+		if member {
+			allowed = append(allowed, userID)
+		}
+	}
+	return allowed, nil
+}
+
+// forget drops the cached audience of a chat, e.g. after its participants changed.
+func (f *audienceFilter) forget(chatID uuid.UUID) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	delete(f.audiences, chatID)
+}
+
+func (f *audienceFilter) audience(ctx context.Context, chatID uuid.UUID) (ChatAudience, error) {
+	now := f.now()
+
+	f.mu.Lock()
+	cached, ok := f.audiences[chatID]
+	f.mu.Unlock()
+	if ok && now.Before(cached.expiresAt) {
+		return cached.audience, nil
+	}
+
+	audience, err := f.resolver.ChatAudience(ctx, chatID)
+	if err != nil {
+		return ChatAudience{}, err
+	}
+	// Visibility is fixed when a chat is created, so only public audiences are safe to reuse
+	if !audience.IsPublic {
+		return audience, nil
+	}
+
+	f.mu.Lock()
+	f.audiences[chatID] = cachedAudience{audience: audience, expiresAt: now.Add(f.ttl)}
+	f.sweepLocked(now)
+	f.mu.Unlock()
+	return audience, nil
+}
+
+func (f *audienceFilter) isMember(ctx context.Context, workspaceID, userID uuid.UUID) (bool, error) {
+	now := f.now()
+	key := membershipKey{workspaceID: workspaceID, userID: userID}
+
+	f.mu.Lock()
+	cached, ok := f.members[key]
+	f.mu.Unlock()
+	if ok && now.Before(cached.expiresAt) {
+		return cached.member, nil
+	}
+
+	member, err := f.resolver.IsWorkspaceMember(ctx, workspaceID, userID)
+	if err != nil {
+		return false, err
+	}
+
+	f.mu.Lock()
+	f.members[key] = cachedMembership{member: member, expiresAt: now.Add(f.ttl)}
+	f.sweepLocked(now)
+	f.mu.Unlock()
+	return member, nil
+}
+
+// sweepLocked removes expired entries once the caches grow large. f.mu must be held.
+func (f *audienceFilter) sweepLocked(now time.Time) {
+	if len(f.audiences)+len(f.members) < audienceCacheSweepSize {
+		return
+	}
+	for chatID, cached := range f.audiences {
+		if !now.Before(cached.expiresAt) {
+			delete(f.audiences, chatID)
+		}
+	}
+	for key, cached := range f.members {
+		if !now.Before(cached.expiresAt) {
+			delete(f.members, key)
+		}
+	}
+}
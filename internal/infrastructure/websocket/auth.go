@@ -0,0 +1,138 @@
+package websocket
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/gorilla/websocket"
+
+	"github.com/lllypuk/flowra/internal/domain/uuid"
+)
+
+// Token lifetime constants.
+const (
+	// authExpiryWarning is how long before token expiry the client is asked to refresh.
+	authExpiryWarning = 60 * time.Second
+
+	// authRefreshTimeout bounds validation of a refreshed token.
+	authRefreshTimeout = 10 * time.Second
+)
+
+// CloseTokenExpired is the close code sent when a connection's token expires without being refreshed.
+const CloseTokenExpired = 4001
+
+// TokenExpiredCloseReason is the close frame reason sent with CloseTokenExpired.
+const TokenExpiredCloseReason = "token expired"
+
+// errTokenUserMismatch is returned when a refreshed token belongs to another user.
+var errTokenUserMismatch = errors.New("token belongs to a different user")
+
+// TokenAuthenticator validates an access token presented on an open connection.
+// Declared on the consumer side per project guidelines.
+type TokenAuthenticator interface {
+	// AuthenticateToken returns the internal user ID and expiry of a valid token.
+	AuthenticateToken(ctx context.Context, token string) (uuid.UUID, time.Time, error)
+}
+
+// WithTokenAuth enables in-band token refresh for a connection authenticated with a
+// token that expires at expiresAt. The connection is closed with CloseTokenExpired
+// once the token expires unless the client sends an auth.refresh message first.
+func WithTokenAuth(authenticator TokenAuthenticator, expiresAt time.Time) ClientOption {
+	return func(c *Client) {
+		c.authenticator = authenticator
+		c.authExpiresAt = expiresAt
+	}
+}
+
+// AuthExpiresAt returns when the connection's token expires (zero if it does not expire).
+func (c *Client) AuthExpiresAt() time.Time {
+	c.authMu.RLock()
+	defer c.authMu.RUnlock()
+	return c.authExpiresAt
+}
+
+// handleAuthRefresh validates a refreshed token and extends the connection's expiry.
+// The token must belong to the connection's user; a failed refresh leaves the current
+// expiry in place.
+func (c *Client) handleAuthRefresh(token string) {
+	if c.authenticator == nil {
+		c.sendError(ErrorCodeProtocol, "token refresh is not supported on this connection")
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), authRefreshTimeout)
+	defer cancel()
+
+	userID, expiresAt, err := c.authenticator.AuthenticateToken(ctx, token)
+	if err == nil && userID != c.userID {
+		err = errTokenUserMismatch
+	}
+	if err != nil {
+		c.logger.Warn("websocket token refresh rejected",
+			slog.String("user_id", c.userID.String()),
+			slog.String("error", err.Error()),
+		)
+		if c.hub != nil {
+			c.hub.RecordAuthFailure()
+		}
+		c.sendError(ErrorCodeAuthFailed, "token refresh failed")
+		return
+	}
+
+	c.authMu.Lock()
+	c.authExpiresAt = expiresAt
+	c.expiryWarned = false
+	c.authMu.Unlock()
+
+	c.sendAuthMessage("ack", "auth.refreshed", expiresAt)
+}
+
+// checkAuthExpiry reports whether the connection's token has expired. Shortly before
+// expiry it asks the client, once, to refresh the token.
+func (c *Client) checkAuthExpiry(now time.Time) bool {
+	c.authMu.Lock()
+	expiresAt := c.authExpiresAt
+	if c.authenticator == nil || expiresAt.IsZero() {
+		c.authMu.Unlock()
+		return false
+	}
+	if !now.Before(expiresAt) {
+		c.authMu.Unlock()
+		return true
+	}
+	warn := !c.expiryWarned && expiresAt.Sub(now) <= authExpiryWarning
+	if warn {
+		c.expiryWarned = true
+	}
+	c.authMu.Unlock()
+
+	if warn {
+		c.sendAuthMessage("auth.expiring", "", expiresAt)
+	}
+	return false
+}
+
+// expireAuth closes the connection because its token expired.
+func (c *Client) expireAuth() {
+	c.logger.Info("closing websocket connection with expired token",
+		slog.String("user_id", c.userID.String()),
+	)
+	_ = c.conn.WriteMessage(websocket.CloseMessage,
+		websocket.FormatCloseMessage(CloseTokenExpired, TokenExpiredCloseReason))
+}
+
+// sendAuthMessage sends an auth control message with the token expiry.
+func (c *Client) sendAuthMessage(messageType, action string, expiresAt time.Time) {
+	response := map[string]any{
+		"type":       messageType,
+		"expires_at": expiresAt.UTC().Format(time.RFC3339),
+	}
+	if action != "" {
+		response["action"] = action
+	}
+	data, _ := json.Marshal(response)
+	c.Send(data)
+}
@@ -0,0 +1,154 @@
+package websocket_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/lllypuk/flowra/internal/domain/uuid"
+	ws "github.com/lllypuk/flowra/internal/infrastructure/websocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// stubTokenAuthenticator accepts known tokens.
+type stubTokenAuthenticator struct {
+	tokens map[string]stubToken
+}
+
+type stubToken struct {
+	userID    uuid.UUID
+	expiresAt time.Time
+}
+
+func (a *stubTokenAuthenticator) AuthenticateToken(_ context.Context, token string) (uuid.UUID, time.Time, error) {
+	if t, ok := a.tokens[token]; ok {
+		return t.userID, t.expiresAt, nil
+	}
+	return "", time.Time{}, errors.New("invalid token")
+}
+
+func startPumpedClient(
+	t *testing.T,
+	userID uuid.UUID,
+	config ws.ClientConfig,
+	opts ...ws.ClientOption,
+) (*ws.Client, *websocket.Conn) {
+	t.Helper()
+
+	hub := ws.NewHub()
+	go hub.Run(t.Context())
+
+	serverConn, clientConn, cleanup := createWSConnPair(t)
+	t.Cleanup(cleanup)
+
+	opts = append(opts, ws.WithClientConfig(config))
+	client := ws.NewClient(hub, serverConn, userID, opts...)
+	hub.Register(client)
+
+	go client.WritePump()
+	go client.ReadPump()
+
+	return client, clientConn
+}
+
+func readJSON(t *testing.T, conn *websocket.Conn) map[string]any {
+	t.Helper()
+
+	require.NoError(t, conn.SetReadDeadline(time.Now().Add(time.Second)))
+	_, data, err := conn.ReadMessage()
+	require.NoError(t, err)
+
+	var msg map[string]any
+	require.NoError(t, json.Unmarshal(data, &msg))
+	return msg
+}
+
+func sendRefresh(t *testing.T, conn *websocket.Conn, token string) {
+	t.Helper()
+
+	data, err := json.Marshal(map[string]any{"type": "auth.refresh", "token": token})
+	require.NoError(t, err)
+	require.NoError(t, conn.WriteMessage(websocket.TextMessage, data))
+}
+
+func TestClient_AuthRefresh(t *testing.T) {
+	userID := uuid.NewUUID()
+	expiresAt := time.Now().Add(time.Hour).Truncate(time.Second)
+	refreshedAt := expiresAt.Add(time.Hour)
+	authenticator := &stubTokenAuthenticator{tokens: map[string]stubToken{
+		"fresh": {userID: userID, expiresAt: refreshedAt},
+		"other": {userID: uuid.NewUUID(), expiresAt: refreshedAt},
+	}}
+
+	t.Run("extends expiry with a valid token", func(t *testing.T) {
+		client, conn := startPumpedClient(t, userID, ws.DefaultClientConfig(), ws.WithTokenAuth(authenticator, expiresAt))
+
+		sendRefresh(t, conn, "fresh")
+		msg := readJSON(t, conn)
+
+		assert.Equal(t, "ack", msg["type"])
+		assert.Equal(t, "auth.refreshed", msg["action"])
+		assert.Equal(t, refreshedAt.UTC().Format(time.RFC3339), msg["expires_at"])
+		assert.True(t, refreshedAt.Equal(client.AuthExpiresAt()))
+	})
+
+	t.Run("rejects a token of another user", func(t *testing.T) {
+		client, conn := startPumpedClient(t, userID, ws.DefaultClientConfig(), ws.WithTokenAuth(authenticator, expiresAt))
+
+		sendRefresh(t, conn, "other")
+		msg := readJSON(t, conn)
+
+		assert.Equal(t, "error", msg["type"])
+		assert.True(t, expiresAt.Equal(client.AuthExpiresAt()))
+	})
+
+	t.Run("rejects an invalid token", func(t *testing.T) {
+		_, conn := startPumpedClient(t, userID, ws.DefaultClientConfig(), ws.WithTokenAuth(authenticator, expiresAt))
+
+		sendRefresh(t, conn, "bogus")
+
+		assert.Equal(t, "error", readJSON(t, conn)["type"])
+	})
+
+	t.Run("is unsupported without token auth", func(t *testing.T) {
+		_, conn := startPumpedClient(t, userID, ws.DefaultClientConfig())
+
+		sendRefresh(t, conn, "fresh")
+
+		assert.Equal(t, "error", readJSON(t, conn)["type"])
+	})
+}
+
+func TestClient_AuthExpiry(t *testing.T) {
+	userID := uuid.NewUUID()
+	authenticator := &stubTokenAuthenticator{}
+	config := ws.DefaultClientConfig()
+	config.PingInterval = 20 * time.Millisecond
+
+	t.Run("warns before the token expires", func(t *testing.T) {
+		expiresAt := time.Now().Add(30 * time.Second)
+		_, conn := startPumpedClient(t, userID, config, ws.WithTokenAuth(authenticator, expiresAt))
+		conn.SetPingHandler(func(string) error { return nil })
+
+		msg := readJSON(t, conn)
+
+		assert.Equal(t, "auth.expiring", msg["type"])
+		assert.Equal(t, expiresAt.UTC().Format(time.RFC3339), msg["expires_at"])
+	})
+
+	t.Run("closes the connection once the token expired", func(t *testing.T) {
+		_, conn := startPumpedClient(t, userID, config, ws.WithTokenAuth(authenticator, time.Now().Add(-time.Second)))
+
+		require.NoError(t, conn.SetReadDeadline(time.Now().Add(time.Second)))
+		_, _, err := conn.ReadMessage()
+
+		var closeErr *websocket.CloseError
+		require.ErrorAs(t, err, &closeErr)
+		assert.Equal(t, ws.CloseTokenExpired, closeErr.Code)
+		assert.Equal(t, ws.TokenExpiredCloseReason, closeErr.Text)
+	})
+}
@@ -7,8 +7,10 @@ import (
 	"log/slog"
 	"sync"
 
+	chatdomain "github.com/lllypuk/flowra/internal/domain/chat"
 	"github.com/lllypuk/flowra/internal/domain/event"
 	messagedomain "github.com/lllypuk/flowra/internal/domain/message"
+	notificationdomain "github.com/lllypuk/flowra/internal/domain/notification"
 	"github.com/lllypuk/flowra/internal/domain/uuid"
 	"github.com/lllypuk/flowra/internal/infrastructure/eventbus"
 )
@@ -41,6 +43,10 @@ type Broadcaster struct {
 
 	// runningMu protects the running flag.
 	runningMu sync.RWMutex
+
+	// audience restricts chat events to workspace members; nil broadcasts to every subscriber.
+	audience   *audienceFilter
+	audienceMu sync.RWMutex
 }
 
 // BroadcasterOption configures a Broadcaster.
@@ -60,22 +66,48 @@ func WithEventTypes(eventTypes []string) BroadcasterOption {
 	}
 }
 
+// WithChatAudienceResolver restricts chat events to subscribers who may see the chat.
+func WithChatAudienceResolver(resolver ChatAudienceResolver) BroadcasterOption {
+	return func(b *Broadcaster) {
+		b.audience = newAudienceFilter(resolver)
+	}
+}
+
+// SetChatAudienceResolver restricts chat events to subscribers who may see the chat.
+// It is used when the resolver's repositories are created after the broadcaster.
+func (b *Broadcaster) SetChatAudienceResolver(resolver ChatAudienceResolver) {
+	b.audienceMu.Lock()
+	defer b.audienceMu.Unlock()
+	b.audience = newAudienceFilter(resolver)
+}
+
 // DefaultEventTypes returns the default event types to broadcast.
 func DefaultEventTypes() []string {
 	return []string{
 		"message.created",
 		"message.edited",
 		"message.deleted",
+		"message.restored",
+		"message.poll.voted",
+		"message.poll.vote_retracted",
+		"message.poll.closed",
 		"chat.created",
 		"chat.updated",
 		"chat.deleted",
 		"chat.member_added",
 		"chat.member_removed",
+		chatdomain.EventTypeParticipantAdded,
+		chatdomain.EventTypeParticipantRemoved,
 		"chat.type_changed",
 		"chat.status_changed",
 		"chat.renamed",
 		"chat.priority_set",
 		"chat.severity_set",
+		"chat.estimate_set",
+		"chat.sprint_set",
+		"chat.duplicate_marked",
+		"chat.epic_set",
+		"chat.topic_set",
 		"chat.user_assigned",
 		"chat.assignee_removed",
 		"chat.due_date_set",
@@ -87,6 +119,10 @@ func DefaultEventTypes() []string {
 		"task.status_changed",
 		"task.assigned",
 		"notification.created",
+		"notification.read",
+		"notification.all_read",
+		"announcement.published",
+		"announcement.ended",
 	}
 }
 
@@ -195,6 +231,10 @@ func (b *Broadcaster) handleEvent(ctx context.Context, evt event.DomainEvent) er
 			)
 		}
 
+	case b.isSystemEvent(evt.EventType()):
+		// Send to every connected client
+		b.hub.BroadcastToAll(messageBytes)
+
 	case b.isChatEvent(evt.EventType()):
 		// Broadcast to chat room
 		chatID := b.extractChatID(evt)
@@ -204,7 +244,7 @@ func (b *Broadcaster) handleEvent(ctx context.Context, evt event.DomainEvent) er
 			slog.Bool("is_zero", chatID.IsZero()),
 		)
 		if !chatID.IsZero() {
-			b.hub.BroadcastToChat(chatID, messageBytes)
+			b.broadcastToChat(ctx, evt.EventType(), chatID, messageBytes)
 			b.logger.InfoContext(ctx, "BROADCASTER: broadcast message to chat",
 				slog.String("event_type", evt.EventType()),
 				slog.String("chat_id", chatID.String()),
@@ -224,6 +264,64 @@ func (b *Broadcaster) handleEvent(ctx context.Context, evt event.DomainEvent) er
 	return nil
 }
 
+// broadcastToChat sends a chat event to the chat room. With an audience resolver only
+// subscribers who belong to the chat's workspace (and, for private chats, to the chat)
+// receive it; if the audience cannot be resolved the event is dropped rather than leaked.
+func (b *Broadcaster) broadcastToChat(ctx context.Context, eventType string, chatID uuid.UUID, message []byte) {
+	b.audienceMu.RLock()
+	audience := b.audience
+	b.audienceMu.RUnlock()
+
+	if audience == nil {
+		b.hub.BroadcastToChat(chatID, message)
+		return
+	}
+
+	if isAudienceChangeEvent(eventType) {
+		audience.forget(chatID)
+	}
+
+	subscribers := b.hub.ChatSubscribers(chatID)
+	if len(subscribers) == 0 {
+		return
+	}
+
+	allowed, err := audience.allowed(ctx, chatID, subscribers)
+	if err != nil {
+		b.logger.WarnContext(ctx, "failed to resolve chat audience, dropping websocket event",
+			slog.String("event_type", eventType),
+			slog.String("chat_id", chatID.String()),
+			slog.String("error", err.Error()),
+		)
+		return
+	}
+	if len(allowed) < len(subscribers) {
+		b.logger.DebugContext(ctx, "filtered chat subscribers outside the chat audience",
+			slog.String("chat_id", chatID.String()),
+			slog.Int("subscribers", len(subscribers)),
+			slog.Int("allowed", len(allowed)),
+		)
+	}
+	if len(allowed) > 0 {
+		b.hub.BroadcastToChatUsers(chatID, allowed, message)
+	}
+}
+
+// isAudienceChangeEvent returns true if the event changes who may see a chat: its
+// participants, its existence, its type or its visibility ("chat.updated").
+func isAudienceChangeEvent(eventType string) bool {
+	switch eventType {
+	case chatdomain.EventTypeParticipantAdded,
+		chatdomain.EventTypeParticipantRemoved,
+		chatdomain.EventTypeChatDeleted,
+		chatdomain.EventTypeChatTypeChanged,
+		"chat.updated":
+		return true
+	default:
+		return false
+	}
+}
+
 // transformEvent converts a domain event to a WebSocket message.
 func (b *Broadcaster) transformEvent(evt event.DomainEvent) *OutboundMessage {
 	wsType := b.mapEventTypeToWSType(evt.EventType())
@@ -270,30 +368,45 @@ type PayloadProvider interface {
 // mapEventTypeToWSType maps domain event types to WebSocket message types.
 func (b *Broadcaster) mapEventTypeToWSType(eventType string) string {
 	mapping := map[string]string{
-		"message.created":       "chat.message.posted",
-		"message.edited":        "chat.message.edited",
-		"message.deleted":       "chat.message.deleted",
-		"chat.created":          "chat.created",
-		"chat.updated":          "chat.updated",
-		"chat.deleted":          "chat.deleted",
-		"chat.member_added":     "chat.member_added",
-		"chat.member_removed":   "chat.member_removed",
-		"chat.type_changed":     "chat.type_changed",
-		"chat.status_changed":   "chat.status_changed",
-		"chat.renamed":          "chat.renamed",
-		"chat.priority_set":     "chat.priority_set",
-		"chat.severity_set":     "chat.severity_set",
-		"chat.user_assigned":    "chat.user_assigned",
-		"chat.assignee_removed": "chat.assignee_removed",
-		"chat.due_date_set":     "chat.due_date_set",
-		"chat.due_date_removed": "chat.due_date_removed",
-		"chat.closed":           "chat.closed",
-		"chat.reopened":         "chat.reopened",
-		"task.created":          "task.created",
-		"task.updated":          "task.updated",
-		"task.status_changed":   "task.updated",
-		"task.assigned":         "task.updated",
-		"notification.created":  "notification.new",
+		"message.created":             "chat.message.posted",
+		"message.edited":              "chat.message.edited",
+		"message.deleted":             "chat.message.deleted",
+		"message.restored":            "chat.message.restored",
+		"message.poll.voted":          "chat.message.poll_updated",
+		"message.poll.vote_retracted": "chat.message.poll_updated",
+		"message.poll.closed":         "chat.message.poll_updated",
+		"chat.created":                "chat.created",
+		"chat.updated":                "chat.updated",
+		"chat.deleted":                "chat.deleted",
+		"chat.member_added":           "chat.member_added",
+		"chat.member_removed":         "chat.member_removed",
+		"chat.participant_added":      "chat.member_added",
+		"chat.participant_removed":    "chat.member_removed",
+		"chat.type_changed":           "chat.type_changed",
+		"chat.status_changed":         "chat.status_changed",
+		"chat.renamed":                "chat.renamed",
+		"chat.priority_set":           "chat.priority_set",
+		"chat.severity_set":           "chat.severity_set",
+		"chat.estimate_set":           "chat.estimate_set",
+		"chat.sprint_set":             "chat.sprint_set",
+		"chat.duplicate_marked":       "chat.duplicate_marked",
+		"chat.epic_set":               "chat.epic_set",
+		"chat.topic_set":              "chat.topic_set",
+		"chat.user_assigned":          "chat.user_assigned",
+		"chat.assignee_removed":       "chat.assignee_removed",
+		"chat.due_date_set":           "chat.due_date_set",
+		"chat.due_date_removed":       "chat.due_date_removed",
+		"chat.closed":                 "chat.closed",
+		"chat.reopened":               "chat.reopened",
+		"task.created":                "task.created",
+		"task.updated":                "task.updated",
+		"task.status_changed":         "task.updated",
+		"task.assigned":               "task.updated",
+		"notification.created":        "notification.new",
+		"notification.read":           "notification.read",
+		"notification.all_read":       "notification.all_read",
+		"announcement.published":      "announcement.updated",
+		"announcement.ended":          "announcement.updated",
 	}
 
 	if wsType, ok := mapping[eventType]; ok {
@@ -305,37 +418,59 @@ func (b *Broadcaster) mapEventTypeToWSType(eventType string) string {
 // isUserSpecificEvent returns true if the event should be sent to a specific user.
 func (b *Broadcaster) isUserSpecificEvent(eventType string) bool {
 	userEvents := map[string]bool{
-		"notification.created": true,
+		"notification.created":  true,
+		"notification.read":     true,
+		"notification.all_read": true,
 	}
 	return userEvents[eventType]
 }
 
+// isSystemEvent returns true if the event should be sent to every connected client.
+func (b *Broadcaster) isSystemEvent(eventType string) bool {
+	systemEvents := map[string]bool{
+		"announcement.published": true,
+		"announcement.ended":     true,
+	}
+	return systemEvents[eventType]
+}
+
 // isChatEvent returns true if the event should be broadcast to a chat room.
 func (b *Broadcaster) isChatEvent(eventType string) bool {
 	chatEvents := map[string]bool{
-		"message.created":       true,
-		"message.edited":        true,
-		"message.deleted":       true,
-		"chat.created":          true,
-		"chat.updated":          true,
-		"chat.deleted":          true,
-		"chat.member_added":     true,
-		"chat.member_removed":   true,
-		"chat.type_changed":     true,
-		"chat.status_changed":   true,
-		"chat.renamed":          true,
-		"chat.priority_set":     true,
-		"chat.severity_set":     true,
-		"chat.user_assigned":    true,
-		"chat.assignee_removed": true,
-		"chat.due_date_set":     true,
-		"chat.due_date_removed": true,
-		"chat.closed":           true,
-		"chat.reopened":         true,
-		"task.created":          true,
-		"task.updated":          true,
-		"task.status_changed":   true,
-		"task.assigned":         true,
+		"message.created":             true,
+		"message.edited":              true,
+		"message.deleted":             true,
+		"message.restored":            true,
+		"message.poll.voted":          true,
+		"message.poll.vote_retracted": true,
+		"message.poll.closed":         true,
+		"chat.created":                true,
+		"chat.updated":                true,
+		"chat.deleted":                true,
+		"chat.member_added":           true,
+		"chat.member_removed":         true,
+		"chat.participant_added":      true,
+		"chat.participant_removed":    true,
+		"chat.type_changed":           true,
+		"chat.status_changed":         true,
+		"chat.renamed":                true,
+		"chat.priority_set":           true,
+		"chat.severity_set":           true,
+		"chat.estimate_set":           true,
+		"chat.sprint_set":             true,
+		"chat.duplicate_marked":       true,
+		"chat.epic_set":               true,
+		"chat.topic_set":              true,
+		"chat.user_assigned":          true,
+		"chat.assignee_removed":       true,
+		"chat.due_date_set":           true,
+		"chat.due_date_removed":       true,
+		"chat.closed":                 true,
+		"chat.reopened":               true,
+		"task.created":                true,
+		"task.updated":                true,
+		"task.status_changed":         true,
+		"task.assigned":               true,
 	}
 	return chatEvents[eventType]
 }
@@ -379,26 +514,30 @@ func (b *Broadcaster) extractChatID(evt event.DomainEvent) uuid.UUID {
 	return uuid.UUID("")
 }
 
-// extractUserID extracts the target user ID from an event.
+// extractUserID extracts the recipient's internal user ID from a user-specific event.
+// The metadata user is the actor who caused the event, not the recipient, so it is never used:
+// falling back to it would deliver a notification to the wrong person.
 func (b *Broadcaster) extractUserID(evt event.DomainEvent) uuid.UUID {
-	// For notification events, try to get user ID from metadata or payload
-	metadata := evt.Metadata()
-	if metadata.UserID != "" {
-		id, err := uuid.ParseUUID(metadata.UserID)
-		if err == nil {
-			return id
-		}
+	switch e := evt.(type) {
+	case *notificationdomain.Created:
+		return e.UserID
+	case *notificationdomain.Read:
+		return e.UserID
+	case *notificationdomain.AllRead:
+		return e.UserID
 	}
 
-	// Try to get from payload
+	// Events received from Redis carry the serialized event as payload
 	if payloadEvent, ok := evt.(PayloadProvider); ok {
-		payload := payloadEvent.Payload()
 		var data struct {
-			UserID string `json:"user_id"`
+			UserID      string `json:"UserID"`
+			SnakeUserID string `json:"user_id"`
 		}
-		if unmarshalErr := json.Unmarshal(payload, &data); unmarshalErr == nil && data.UserID != "" {
-			if parsedID, parseErr := uuid.ParseUUID(data.UserID); parseErr == nil {
-				return parsedID
+		if unmarshalErr := json.Unmarshal(payloadEvent.Payload(), &data); unmarshalErr == nil {
+			for _, raw := range []string{data.UserID, data.SnakeUserID} {
+				if parsedID, parseErr := uuid.ParseUUID(raw); raw != "" && parseErr == nil {
+					return parsedID
+				}
 			}
 		}
 	}
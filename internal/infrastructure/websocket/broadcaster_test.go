@@ -3,11 +3,14 @@ package websocket_test
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"sync"
 	"testing"
 	"time"
 
+	chatdomain "github.com/lllypuk/flowra/internal/domain/chat"
 	"github.com/lllypuk/flowra/internal/domain/event"
+	notificationdomain "github.com/lllypuk/flowra/internal/domain/notification"
 	"github.com/lllypuk/flowra/internal/domain/uuid"
 	"github.com/lllypuk/flowra/internal/infrastructure/eventbus"
 	ws "github.com/lllypuk/flowra/internal/infrastructure/websocket"
@@ -135,16 +138,27 @@ func TestDefaultEventTypes(t *testing.T) {
 		"message.created",
 		"message.edited",
 		"message.deleted",
+		"message.restored",
+		"message.poll.voted",
+		"message.poll.vote_retracted",
+		"message.poll.closed",
 		"chat.created",
 		"chat.updated",
 		"chat.deleted",
 		"chat.member_added",
 		"chat.member_removed",
+		"chat.participant_added",
+		"chat.participant_removed",
 		"chat.type_changed",
 		"chat.status_changed",
 		"chat.renamed",
 		"chat.priority_set",
 		"chat.severity_set",
+		"chat.estimate_set",
+		"chat.sprint_set",
+		"chat.duplicate_marked",
+		"chat.epic_set",
+		"chat.topic_set",
 		"chat.user_assigned",
 		"chat.assignee_removed",
 		"chat.due_date_set",
@@ -156,6 +170,10 @@ func TestDefaultEventTypes(t *testing.T) {
 		"task.status_changed",
 		"task.assigned",
 		"notification.created",
+		"notification.read",
+		"notification.all_read",
+		"announcement.published",
+		"announcement.ended",
 	}
 
 	assert.Equal(t, expectedTypes, eventTypes)
@@ -307,6 +325,43 @@ func TestBroadcaster_HandleEvent(t *testing.T) {
 		}
 	})
 
+	t.Run("broadcasts announcements to every client", func(t *testing.T) {
+		hub := ws.NewHub()
+		ctx := t.Context()
+
+		go hub.Run(ctx)
+		time.Sleep(10 * time.Millisecond)
+
+		eventBus := newMockEventBus()
+		broadcaster := ws.NewBroadcaster(hub, eventBus)
+
+		err := broadcaster.Start(ctx)
+		require.NoError(t, err)
+
+		client1, receiveChan1 := createTestBroadcasterClient(t, hub, uuid.NewUUID())
+		client2, receiveChan2 := createTestBroadcasterClient(t, hub, uuid.NewUUID())
+		hub.Register(client1)
+		hub.Register(client2)
+		time.Sleep(20 * time.Millisecond)
+
+		evt := newTestDomainEvent("announcement.published", uuid.NewUUID().String(), "Announcement")
+		err = eventBus.Publish(ctx, evt)
+		require.NoError(t, err)
+
+		time.Sleep(50 * time.Millisecond)
+
+		for _, receiveChan := range []chan []byte{receiveChan1, receiveChan2} {
+			select {
+			case msg := <-receiveChan:
+				var wsMsg map[string]any
+				require.NoError(t, json.Unmarshal(msg, &wsMsg))
+				assert.Equal(t, "announcement.updated", wsMsg["type"])
+			case <-time.After(100 * time.Millisecond):
+				t.Fatal("expected announcement but did not receive")
+			}
+		}
+	})
+
 	t.Run("does not broadcast unregistered event types", func(t *testing.T) {
 		hub := ws.NewHub()
 		ctx := t.Context()
@@ -481,3 +536,257 @@ func createTestBroadcasterClient(t *testing.T, hub *ws.Hub, userID uuid.UUID) (*
 
 	return client, receiveChan
 }
+
+// stubAudienceResolver is a ChatAudienceResolver with fixed answers.
+type stubAudienceResolver struct {
+	mu       sync.Mutex
+	audience ws.ChatAudience
+	members  map[uuid.UUID]bool
+	err      error
+}
+
+func (r *stubAudienceResolver) ChatAudience(_ context.Context, _ uuid.UUID) (ws.ChatAudience, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.audience, r.err
+}
+
+func (r *stubAudienceResolver) setParticipants(participants ...uuid.UUID) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.audience.Participants = participants
+}
+
+func (r *stubAudienceResolver) IsWorkspaceMember(_ context.Context, _, userID uuid.UUID) (bool, error) {
+	return r.members[userID], nil
+}
+
+func TestBroadcaster_ChatAudience(t *testing.T) {
+	setup := func(t *testing.T, resolver ws.ChatAudienceResolver, userIDs ...uuid.UUID) (
+		*mockEventBus, uuid.UUID, []chan []byte,
+	) {
+		t.Helper()
+		hub := ws.NewHub()
+		ctx := t.Context()
+		go hub.Run(ctx)
+		time.Sleep(10 * time.Millisecond)
+
+		eventBus := newMockEventBus()
+		broadcaster := ws.NewBroadcaster(hub, eventBus, ws.WithChatAudienceResolver(resolver))
+		require.NoError(t, broadcaster.Start(ctx))
+
+		chatID := uuid.NewUUID()
+		receivers := make([]chan []byte, 0, len(userIDs))
+		for _, userID := range userIDs {
+			client, receiveChan := createTestBroadcasterClient(t, hub, userID)
+			hub.Register(client)
+			time.Sleep(20 * time.Millisecond)
+			hub.JoinChat(client, chatID)
+			receivers = append(receivers, receiveChan)
+		}
+		time.Sleep(20 * time.Millisecond)
+		return eventBus, chatID, receivers
+	}
+
+	expectMessage := func(t *testing.T, receiveChan chan []byte) {
+		t.Helper()
+		select {
+		case <-receiveChan:
+		case <-time.After(100 * time.Millisecond):
+			t.Fatal("expected message but did not receive")
+		}
+	}
+	expectNoMessage := func(t *testing.T, receiveChan chan []byte) {
+		t.Helper()
+		select {
+		case <-receiveChan:
+			t.Fatal("subscriber outside the chat audience received the event")
+		case <-time.After(50 * time.Millisecond):
+		}
+	}
+
+	t.Run("delivers public chat events only to workspace members", func(t *testing.T) {
+		member := uuid.NewUUID()
+		outsider := uuid.NewUUID()
+		resolver := &stubAudienceResolver{
+			audience: ws.ChatAudience{WorkspaceID: uuid.NewUUID(), IsPublic: true},
+			members:  map[uuid.UUID]bool{member: true},
+		}
+		eventBus, chatID, receivers := setup(t, resolver, member, outsider)
+
+		require.NoError(t, eventBus.Publish(t.Context(), newTestDomainEvent("chat.renamed", chatID.String(), "chat")))
+
+		expectMessage(t, receivers[0])
+		expectNoMessage(t, receivers[1])
+	})
+
+	t.Run("delivers private chat events only to participants", func(t *testing.T) {
+		participant := uuid.NewUUID()
+		colleague := uuid.NewUUID()
+		resolver := &stubAudienceResolver{
+			audience: ws.ChatAudience{WorkspaceID: uuid.NewUUID(), Participants: []uuid.UUID{participant}},
+			members:  map[uuid.UUID]bool{participant: true, colleague: true},
+		}
+		eventBus, chatID, receivers := setup(t, resolver, participant, colleague)
+
+		require.NoError(t, eventBus.Publish(t.Context(), newTestDomainEvent("chat.renamed", chatID.String(), "chat")))
+
+		expectMessage(t, receivers[0])
+		expectNoMessage(t, receivers[1])
+	})
+
+	t.Run("re-resolves the audience after an audience change event", func(t *testing.T) {
+		for _, eventType := range []string{
+			chatdomain.EventTypeParticipantAdded,
+			chatdomain.EventTypeChatTypeChanged,
+		} {
+			t.Run(eventType, func(t *testing.T) {
+				participant := uuid.NewUUID()
+				colleague := uuid.NewUUID()
+				resolver := &stubAudienceResolver{
+					audience: ws.ChatAudience{WorkspaceID: uuid.NewUUID(), Participants: []uuid.UUID{participant}},
+					members:  map[uuid.UUID]bool{participant: true, colleague: true},
+				}
+				eventBus, chatID, receivers := setup(t, resolver, participant, colleague)
+				renamed := newTestDomainEvent("chat.renamed", chatID.String(), "chat")
+
+				require.NoError(t, eventBus.Publish(t.Context(), renamed))
+				expectMessage(t, receivers[0])
+				expectNoMessage(t, receivers[1])
+
+				resolver.setParticipants(participant, colleague)
+				require.NoError(t, eventBus.Publish(t.Context(), newTestDomainEvent(eventType, chatID.String(), "chat")))
+				expectMessage(t, receivers[0])
+				expectMessage(t, receivers[1])
+
+				require.NoError(t, eventBus.Publish(t.Context(), renamed))
+				expectMessage(t, receivers[1])
+			})
+		}
+	})
+
+	t.Run("stops private chat events to a removed participant without an event", func(t *testing.T) {
+		participant := uuid.NewUUID()
+		removed := uuid.NewUUID()
+		resolver := &stubAudienceResolver{
+			audience: ws.ChatAudience{WorkspaceID: uuid.NewUUID(), Participants: []uuid.UUID{participant, removed}},
+			members:  map[uuid.UUID]bool{participant: true, removed: true},
+		}
+		eventBus, chatID, receivers := setup(t, resolver, participant, removed)
+		renamed := newTestDomainEvent("chat.renamed", chatID.String(), "chat")
+
+		require.NoError(t, eventBus.Publish(t.Context(), renamed))
+		expectMessage(t, receivers[0])
+		expectMessage(t, receivers[1])
+
+		// the removal was handled on another node, so no event invalidates this one
+		resolver.setParticipants(participant)
+		require.NoError(t, eventBus.Publish(t.Context(), renamed))
+		expectMessage(t, receivers[0])
+		expectNoMessage(t, receivers[1])
+	})
+
+	t.Run("drops the event when the audience cannot be resolved", func(t *testing.T) {
+		resolver := &stubAudienceResolver{err: errors.New("mongo unavailable")}
+		eventBus, chatID, receivers := setup(t, resolver, uuid.NewUUID())
+
+		require.NoError(t, eventBus.Publish(t.Context(), newTestDomainEvent("chat.renamed", chatID.String(), "chat")))
+
+		expectNoMessage(t, receivers[0])
+	})
+}
+
+func TestBroadcaster_NotificationRecipient(t *testing.T) {
+	hub := ws.NewHub()
+	ctx := t.Context()
+	go hub.Run(ctx)
+	time.Sleep(10 * time.Millisecond)
+
+	eventBus := newMockEventBus()
+	broadcaster := ws.NewBroadcaster(hub, eventBus)
+	require.NoError(t, broadcaster.Start(ctx))
+
+	recipientID := uuid.NewUUID()
+	actorID := uuid.NewUUID()
+	recipient, recipientChan := createTestBroadcasterClient(t, hub, recipientID)
+	actor, actorChan := createTestBroadcasterClient(t, hub, actorID)
+	hub.Register(recipient)
+	hub.Register(actor)
+	time.Sleep(20 * time.Millisecond)
+
+	// The metadata user is the actor whose action triggered the notification.
+	evt := notificationdomain.NewNotificationCreated(
+		uuid.NewUUID(),
+		recipientID,
+		notificationdomain.TypeChatMention,
+		"Mentioned",
+		"You were mentioned",
+		"",
+		event.NewMetadata(actorID.String(), "correlation-1", "causation-1"),
+	)
+	require.NoError(t, eventBus.Publish(ctx, evt))
+
+	select {
+	case msg := <-recipientChan:
+		var wsMsg map[string]any
+		require.NoError(t, json.Unmarshal(msg, &wsMsg))
+		assert.Equal(t, "notification.new", wsMsg["type"])
+	case <-time.After(100 * time.Millisecond):
+		t.Fatal("expected notification for the recipient")
+	}
+
+	select {
+	case <-actorChan:
+		t.Fatal("the actor must not receive the recipient's notification")
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+func TestBroadcaster_NotificationReadState(t *testing.T) {
+	hub := ws.NewHub()
+	ctx := t.Context()
+	go hub.Run(ctx)
+	time.Sleep(10 * time.Millisecond)
+
+	eventBus := newMockEventBus()
+	broadcaster := ws.NewBroadcaster(hub, eventBus)
+	require.NoError(t, broadcaster.Start(ctx))
+
+	userID := uuid.NewUUID()
+	laptop, laptopChan := createTestBroadcasterClient(t, hub, userID)
+	phone, phoneChan := createTestBroadcasterClient(t, hub, userID)
+	other, otherChan := createTestBroadcasterClient(t, hub, uuid.NewUUID())
+	hub.Register(laptop)
+	hub.Register(phone)
+	hub.Register(other)
+	time.Sleep(20 * time.Millisecond)
+
+	expectType := func(t *testing.T, ch <-chan []byte, wsType string) {
+		t.Helper()
+		select {
+		case msg := <-ch:
+			var wsMsg map[string]any
+			require.NoError(t, json.Unmarshal(msg, &wsMsg))
+			assert.Equal(t, wsType, wsMsg["type"])
+		case <-time.After(100 * time.Millisecond):
+			t.Fatalf("expected %s message", wsType)
+		}
+	}
+
+	metadata := event.NewMetadata(userID.String(), "correlation-1", "causation-1")
+	require.NoError(t, eventBus.Publish(ctx,
+		notificationdomain.NewNotificationRead(uuid.NewUUID(), userID, time.Now(), metadata)))
+	expectType(t, laptopChan, "notification.read")
+	expectType(t, phoneChan, "notification.read")
+
+	require.NoError(t, eventBus.Publish(ctx,
+		notificationdomain.NewNotificationAllRead(userID, time.Now(), 3, metadata)))
+	expectType(t, laptopChan, "notification.all_read")
+	expectType(t, phoneChan, "notification.all_read")
+
+	select {
+	case <-otherChan:
+		t.Fatal("other users must not receive the read state")
+	case <-time.After(50 * time.Millisecond):
+	}
+}
@@ -2,13 +2,18 @@
 package websocket
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"log/slog"
+	"net"
+	"runtime/debug"
 	"sync"
 	"time"
 
 	"github.com/gorilla/websocket"
 	"github.com/lllypuk/flowra/internal/domain/uuid"
+	"github.com/lllypuk/flowra/internal/infrastructure/errorreport"
 )
 
 // Default client configuration constants.
@@ -59,6 +64,11 @@ func DefaultClientConfig() ClientConfig {
 type ClientMessage struct {
 	Type   string    `json:"type"`
 	ChatID uuid.UUID `json:"chat_id,omitempty"`
+	Token  string    `json:"token,omitempty"`
+
+	// Version and Features are sent with hello.
+	Version  int      `json:"version,omitempty"`
+	Features []string `json:"features,omitempty"`
 }
 
 // Client represents a single WebSocket connection.
@@ -75,6 +85,9 @@ type Client struct {
 	// userID is the authenticated user ID.
 	userID uuid.UUID
 
+	// requestID is the ID of the upgrade request, used to correlate error reports.
+	requestID string
+
 	// chatIDs are the chat rooms this client has subscribed to.
 	chatIDs map[uuid.UUID]bool
 
@@ -90,8 +103,44 @@ type Client struct {
 	// closed indicates if the client connection has been closed.
 	closed bool
 
-	// closedMu protects the closed flag.
+	// writing indicates that WritePump is running and will flush the send buffer.
+	writing bool
+
+	// closeMessage is the close frame payload sent once the send buffer is drained.
+	closeMessage []byte
+
+	// closedMu protects the closed, writing and closeMessage fields.
 	closedMu sync.RWMutex
+
+	// connOnce guards closing the underlying connection.
+	connOnce sync.Once
+
+	// connClosed is closed once the underlying connection has been closed.
+	connClosed chan struct{}
+
+	// authenticator validates refreshed tokens; nil disables token expiry and refresh.
+	authenticator TokenAuthenticator
+
+	// authExpiresAt is when the connection's current token expires.
+	authExpiresAt time.Time
+
+	// expiryWarned records that auth.expiring was sent for the current token.
+	expiryWarned bool
+
+	// authMu protects authExpiresAt and expiryWarned.
+	authMu sync.RWMutex
+
+	// serverFeatures are the protocol features the server offers on this connection.
+	serverFeatures []string
+
+	// protocolVersion is the version negotiated by hello (zero before the handshake).
+	protocolVersion int
+
+	// features are the features negotiated by hello (nil before the handshake).
+	features map[string]bool
+
+	// protocolMu protects protocolVersion and features.
+	protocolMu sync.RWMutex
 }
 
 // ClientOption configures a Client.
@@ -111,16 +160,26 @@ func WithClientLogger(logger *slog.Logger) ClientOption {
 	}
 }
 
+// WithClientRequestID sets the ID of the request that opened the connection.
+func WithClientRequestID(requestID string) ClientOption {
+	return func(c *Client) {
+		c.requestID = requestID
+	}
+}
+
 // NewClient creates a new WebSocket client.
 func NewClient(hub *Hub, conn *websocket.Conn, userID uuid.UUID, opts ...ClientOption) *Client {
 	c := &Client{
-		hub:     hub,
-		conn:    conn,
-		send:    make(chan []byte, defaultSendBufferSize),
-		userID:  userID,
-		chatIDs: make(map[uuid.UUID]bool),
-		config:  DefaultClientConfig(),
-		logger:  slog.Default(),
+		hub:        hub,
+		conn:       conn,
+		send:       make(chan []byte, defaultSendBufferSize),
+		userID:     userID,
+		chatIDs:    make(map[uuid.UUID]bool),
+		config:     DefaultClientConfig(),
+		logger:     slog.Default(),
+		connClosed: make(chan struct{}),
+
+		serverFeatures: DefaultServerFeatures(),
 	}
 
 	for _, opt := range opts {
@@ -196,7 +255,12 @@ func (c *Client) ReadPump() {
 	for {
 		_, message, err := c.conn.ReadMessage()
 		if err != nil {
-			if websocket.IsUnexpectedCloseError(err, websocket.CloseGoingAway, websocket.CloseAbnormalClosure) {
+			if websocket.IsUnexpectedCloseError(
+				err,
+				websocket.CloseGoingAway,
+				websocket.CloseAbnormalClosure,
+				websocket.CloseServiceRestart,
+			) {
 				c.logger.Warn("websocket read error",
 					slog.String("user_id", c.userID.String()),
 					slog.String("error", err.Error()),
@@ -205,13 +269,49 @@ func (c *Client) ReadPump() {
 			return
 		}
 
-		c.handleClientMessage(message)
+		c.dispatchClientMessage(message)
+	}
+}
+
+// dispatchClientMessage handles one client message. A panic is logged, reported and
+// answered with an INTERNAL_ERROR frame, so one bad message neither closes the
+// connection nor crashes the server.
+func (c *Client) dispatchClientMessage(message []byte) {
+	defer func() {
+		if recovered := recover(); recovered != nil {
+			c.recoverPanic(recovered)
+		}
+	}()
+	c.handleClientMessage(message)
+}
+
+func (c *Client) recoverPanic(recovered any) {
+	stack := debug.Stack()
+	c.logger.Error("websocket message handler panicked",
+		slog.String("user_id", c.userID.String()),
+		slog.String("request_id", c.requestID),
+		slog.Any("panic", recovered),
+		slog.String("stack", string(stack)),
+	)
+	if c.hub != nil && c.hub.reporter != nil {
+		c.hub.reporter.Report(context.Background(), errorreport.Event{
+			Source:    errorreport.SourceWebSocket,
+			Panic:     recovered,
+			Stack:     stack,
+			RequestID: c.requestID,
+			UserID:    c.userID.String(),
+		})
 	}
+	c.sendError(ErrorCodeInternal, "an internal error occurred")
 }
 
 // WritePump writes messages to the WebSocket connection.
 // It should be run as a goroutine.
 func (c *Client) WritePump() {
+	c.closedMu.Lock()
+	c.writing = true
+	c.closedMu.Unlock()
+
 	ticker := time.NewTicker(c.config.PingInterval)
 	defer func() {
 		ticker.Stop()
@@ -227,87 +327,100 @@ func (c *Client) WritePump() {
 			}
 
 			if !ok {
-				// Hub closed the channel
-				_ = c.conn.WriteMessage(websocket.CloseMessage, []byte{})
+				// Hub closed the channel; pending messages have been flushed
+				_ = c.conn.WriteMessage(websocket.CloseMessage, c.closeFrame())
 				return
 			}
 
+			if compress, negotiated := c.writeCompression(); negotiated {
+				c.conn.EnableWriteCompression(compress)
+			}
+
 			if err := c.conn.WriteMessage(websocket.TextMessage, message); err != nil {
 				c.logger.Warn("websocket write error",
 					slog.String("user_id", c.userID.String()),
 					slog.String("error", err.Error()),
 				)
+				c.recordWriteFailure(err)
 				return
 			}
 
 		case <-ticker.C:
+			if c.checkAuthExpiry(time.Now()) {
+				if err := c.conn.SetWriteDeadline(time.Now().Add(c.config.WriteWait)); err == nil {
+					c.expireAuth()
+				}
+				return
+			}
+
 			if err := c.conn.SetWriteDeadline(time.Now().Add(c.config.WriteWait)); err != nil {
 				c.logger.Error("failed to set write deadline", slog.String("error", err.Error()))
 				return
 			}
 
 			if err := c.conn.WriteMessage(websocket.PingMessage, nil); err != nil {
+				c.recordWriteFailure(err)
 				return
 			}
 		}
 	}
 }
 
-// handleClientMessage processes a message received from the client.
+// recordWriteFailure counts a write that timed out as a slow-client disconnect:
+// the client did not read fast enough for the write to complete in WriteWait.
+func (c *Client) recordWriteFailure(err error) {
+	var netErr net.Error
+	if c.hub == nil || !errors.As(err, &netErr) || !netErr.Timeout() {
+		return
+	}
+	c.hub.counters.slowClientDisconnects.Add(1)
+}
+
+// handleClientMessage processes a message received from the client. Messages that do
+// not match the definition of their type are answered with an error frame.
 func (c *Client) handleClientMessage(message []byte) {
-	var msg ClientMessage
-	if err := json.Unmarshal(message, &msg); err != nil {
-		c.logger.Warn("invalid client message",
+	msg, protoErr := parseClientMessage(message)
+	if protoErr != nil {
+		c.logger.Debug("invalid client message",
 			slog.String("user_id", c.userID.String()),
-			slog.String("error", err.Error()),
+			slog.String("code", protoErr.code),
+			slog.String("error", protoErr.message),
 		)
-		c.sendError("invalid message format")
+		c.sendFrame(protoErr.frame())
 		return
 	}
 
 	switch msg.Type {
 	case "subscribe":
-		if msg.ChatID.IsZero() {
-			c.sendError("chat_id is required for subscribe")
-			return
-		}
 		c.hub.JoinChat(c, msg.ChatID)
 		c.sendAck("subscribed", msg.ChatID)
 
 	case "unsubscribe":
-		if msg.ChatID.IsZero() {
-			c.sendError("chat_id is required for unsubscribe")
-			return
-		}
 		c.hub.LeaveChat(c, msg.ChatID)
 		c.sendAck("unsubscribed", msg.ChatID)
 
 	case "chat.typing":
-		if msg.ChatID.IsZero() {
-			c.sendError("chat_id is required for chat.typing")
-			return
-		}
 		c.hub.BroadcastTyping(msg.ChatID, c.userID)
 
 	case "ping":
 		c.sendPong()
 
-	default:
-		c.logger.Debug("unknown message type",
-			slog.String("user_id", c.userID.String()),
-			slog.String("type", msg.Type),
-		)
-		c.sendError("unknown message type: " + msg.Type)
+	case "auth.refresh":
+		c.handleAuthRefresh(msg.Token)
+
+	case "hello":
+		c.handleHello(msg.Version, msg.Features)
 	}
 }
 
-// sendError sends an error message to the client.
-func (c *Client) sendError(message string) {
-	response := map[string]any{
-		"type":    "error",
-		"message": message,
-	}
-	data, _ := json.Marshal(response)
+// sendError sends an error frame with the given code to the client.
+func (c *Client) sendError(code, message string) {
+	c.sendFrame(ErrorMessage{Type: "error", Code: code, Message: message})
+}
+
+// sendFrame sends a JSON-encoded frame to the client.
+func (c *Client) sendFrame(frame any) {
+	data, _ := json.Marshal(frame)
 	c.Send(data)
 }
 
@@ -349,17 +462,59 @@ func (c *Client) Send(message []byte) {
 // Close closes the client connection.
 func (c *Client) Close() {
 	c.closedMu.Lock()
-	defer c.closedMu.Unlock()
+	if !c.closed {
+		c.closed = true
+		close(c.send)
+	}
+	c.closedMu.Unlock()
+
+	c.closeConn()
+}
 
+// Drain stops accepting new messages and lets WritePump flush the send buffer,
+// then send a close frame with the given code and reason and close the connection.
+// Without a running WritePump the connection is closed immediately.
+func (c *Client) Drain(code int, reason string) {
+	c.closedMu.Lock()
 	if c.closed {
+		c.closedMu.Unlock()
 		return
 	}
 	c.closed = true
-
+	c.closeMessage = websocket.FormatCloseMessage(code, reason)
 	close(c.send)
-	_ = c.conn.Close()
+	writing := c.writing
+	c.closedMu.Unlock()
 
-	c.logger.Debug("client connection closed",
-		slog.String("user_id", c.userID.String()),
-	)
+	if !writing {
+		c.closeConn()
+	}
+}
+
+// Done returns a channel that is closed once the underlying connection has been closed.
+func (c *Client) Done() <-chan struct{} {
+	return c.connClosed
+}
+
+// closeFrame returns the close frame payload to send when the send buffer is closed.
+func (c *Client) closeFrame() []byte {
+	c.closedMu.RLock()
+	defer c.closedMu.RUnlock()
+
+	if c.closeMessage == nil {
+		return []byte{}
+	}
+	return c.closeMessage
+}
+
+// closeConn closes the underlying connection exactly once.
+func (c *Client) closeConn() {
+	c.connOnce.Do(func() {
+		_ = c.conn.Close()
+		close(c.connClosed)
+
+		c.logger.Debug("client connection closed",
+			slog.String("user_id", c.userID.String()),
+		)
+	})
 }
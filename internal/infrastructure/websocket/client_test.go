@@ -1,6 +1,7 @@
 package websocket_test
 
 import (
+	"context"
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
@@ -9,6 +10,7 @@ import (
 
 	"github.com/gorilla/websocket"
 	"github.com/lllypuk/flowra/internal/domain/uuid"
+	"github.com/lllypuk/flowra/internal/infrastructure/errorreport"
 	ws "github.com/lllypuk/flowra/internal/infrastructure/websocket"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -446,3 +448,57 @@ func createWSConnPair(t *testing.T) (*websocket.Conn, *websocket.Conn, func()) {
 		return nil, nil, nil
 	}
 }
+
+// panickingAuthenticator panics on every token.
+type panickingAuthenticator struct{}
+
+func (panickingAuthenticator) AuthenticateToken(context.Context, string) (uuid.UUID, time.Time, error) {
+	panic("authenticator exploded")
+}
+
+// channelReporter forwards reported panics.
+type channelReporter chan errorreport.Event
+
+func (r channelReporter) Report(_ context.Context, evt errorreport.Event) {
+	r <- evt
+}
+
+func TestClient_RecoversMessageHandlerPanic(t *testing.T) {
+	reporter := make(channelReporter, 1)
+	hub := ws.NewHub(ws.WithPanicReporter(reporter))
+	go hub.Run(t.Context())
+
+	serverConn, clientConn, cleanup := createWSConnPair(t)
+	defer cleanup()
+
+	userID := uuid.NewUUID()
+	client := ws.NewClient(hub, serverConn, userID,
+		ws.WithClientRequestID("req-7"),
+		ws.WithTokenAuth(panickingAuthenticator{}, time.Now().Add(time.Hour)),
+	)
+	hub.Register(client)
+	go client.WritePump()
+	go client.ReadPump()
+
+	sendRefresh(t, clientConn, "token")
+
+	msg := readJSON(t, clientConn)
+	assert.Equal(t, "error", msg["type"])
+	assert.Equal(t, ws.ErrorCodeInternal, msg["code"])
+
+	select {
+	case evt := <-reporter:
+		assert.Equal(t, errorreport.SourceWebSocket, evt.Source)
+		assert.Equal(t, "authenticator exploded", evt.Message())
+		assert.Equal(t, "req-7", evt.RequestID)
+		assert.Equal(t, userID.String(), evt.UserID)
+		assert.NotEmpty(t, evt.Stack)
+	case <-time.After(time.Second):
+		t.Fatal("panic was not reported")
+	}
+
+	// the connection survives the panic
+	require.NoError(t, clientConn.WriteMessage(websocket.TextMessage, []byte(`{"type":"ping"}`)))
+	assert.Equal(t, "pong", readJSON(t, clientConn)["type"])
+	assert.False(t, client.IsClosed())
+}
@@ -6,15 +6,24 @@ import (
 	"encoding/json"
 	"log/slog"
 	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/gorilla/websocket"
 
 	"github.com/lllypuk/flowra/internal/domain/uuid"
+	"github.com/lllypuk/flowra/internal/infrastructure/errorreport"
 )
 
 // Hub configuration constants.
 const (
 	defaultBroadcastBufferSize = 256
+	defaultDrainTimeout        = 2 * time.Second
 )
 
+// DrainCloseReason is the close frame reason sent to clients when the hub shuts down.
+const DrainCloseReason = "server restarting"
+
 // Message represents a WebSocket message.
 type Message struct {
 	Type   string          `json:"type"`
@@ -42,6 +51,34 @@ type TypingMessage struct {
 	UserID uuid.UUID `json:"user_id"`
 }
 
+// HubStats are the counters of a hub, exported as metrics.
+type HubStats struct {
+	// Connections is the number of connected clients.
+	Connections int
+
+	// Broadcasts is the number of messages broadcast through the hub.
+	Broadcasts uint64
+
+	// SendBufferDrops is the number of messages dropped because a client's send
+	// buffer was full.
+	SendBufferDrops uint64
+
+	// SlowClientDisconnects is the number of clients disconnected because a write
+	// did not complete within the write timeout.
+	SlowClientDisconnects uint64
+
+	// AuthFailures is the number of rejected connection attempts and token refreshes.
+	AuthFailures uint64
+}
+
+// hubCounters holds the cumulative counters of HubStats.
+type hubCounters struct {
+	broadcasts            atomic.Uint64
+	sendBufferDrops       atomic.Uint64
+	slowClientDisconnects atomic.Uint64
+	authFailures          atomic.Uint64
+}
+
 // Hub manages all WebSocket connections and chat room subscriptions.
 type Hub struct {
 	// clients holds all connected clients.
@@ -71,11 +108,29 @@ type Hub struct {
 	// done signals when the hub should stop.
 	done chan struct{}
 
+	// stopOnce guards closing done.
+	stopOnce sync.Once
+
+	// stopped is closed once shutdown has finished draining clients.
+	stopped chan struct{}
+
+	// drainTimeout bounds how long shutdown waits for clients to flush and close.
+	drainTimeout time.Duration
+
 	// running indicates if the hub is currently running.
 	running bool
 
-	// runningMu protects the running flag.
+	// started indicates that Run has been called at least once.
+	started bool
+
+	// runningMu protects the running and started flags.
 	runningMu sync.RWMutex
+
+	// counters feed Stats.
+	counters hubCounters
+
+	// reporter receives panics recovered while handling client messages; may be nil.
+	reporter PanicReporter
 }
 
 // broadcastMessage represents a message to be broadcast to a specific target.
@@ -86,6 +141,15 @@ type broadcastMessage struct {
 	// userID is the target user (nil for chat-wide messages).
 	userID *uuid.UUID
 
+	// all sends the message to every connected client.
+	all bool
+
+	// recipients restricts a chat broadcast to these users (nil means every subscriber).
+	recipients map[uuid.UUID]bool
+
+	// feature restricts a chat broadcast to clients with this protocol feature enabled.
+	feature string
+
 	// message is the raw message bytes.
 	message []byte
 }
@@ -100,17 +164,40 @@ func WithHubLogger(logger *slog.Logger) HubOption {
 	}
 }
 
+// WithDrainTimeout sets how long Stop waits for clients to flush pending
+// messages and acknowledge the close frame before connections are dropped.
+func WithDrainTimeout(timeout time.Duration) HubOption {
+	return func(h *Hub) {
+		h.drainTimeout = timeout
+	}
+}
+
+// PanicReporter sends recovered panics to an external error tracker.
+// Declared on the consumer side per project guidelines.
+type PanicReporter interface {
+	Report(ctx context.Context, evt errorreport.Event)
+}
+
+// WithPanicReporter reports panics recovered while handling client messages.
+func WithPanicReporter(reporter PanicReporter) HubOption {
+	return func(h *Hub) {
+		h.reporter = reporter
+	}
+}
+
 // NewHub creates a new Hub with the given options.
 func NewHub(opts ...HubOption) *Hub {
 	h := &Hub{
-		clients:     make(map[*Client]bool),
-		chatRooms:   make(map[uuid.UUID]map[*Client]bool),
-		userClients: make(map[uuid.UUID]map[*Client]bool),
-		register:    make(chan *Client),
-		unregister:  make(chan *Client),
-		broadcast:   make(chan *broadcastMessage, defaultBroadcastBufferSize),
-		logger:      slog.Default(),
-		done:        make(chan struct{}),
+		clients:      make(map[*Client]bool),
+		chatRooms:    make(map[uuid.UUID]map[*Client]bool),
+		userClients:  make(map[uuid.UUID]map[*Client]bool),
+		register:     make(chan *Client),
+		unregister:   make(chan *Client),
+		broadcast:    make(chan *broadcastMessage, defaultBroadcastBufferSize),
+		logger:       slog.Default(),
+		done:         make(chan struct{}),
+		stopped:      make(chan struct{}),
+		drainTimeout: defaultDrainTimeout,
 	}
 
 	for _, opt := range opts {
@@ -129,6 +216,7 @@ func (h *Hub) Run(ctx context.Context) {
 		return
 	}
 	h.running = true
+	h.started = true
 	h.runningMu.Unlock()
 
 	h.logger.InfoContext(ctx, "websocket hub started")
@@ -155,48 +243,86 @@ func (h *Hub) Run(ctx context.Context) {
 	}
 }
 
-// Stop signals the hub to stop.
+// Stop signals the hub to stop and waits until connected clients have been drained.
 func (h *Hub) Stop() {
 	h.runningMu.Lock()
-	defer h.runningMu.Unlock()
-
-	if !h.running {
-		return
+	started := h.started
+	if h.running {
+		h.stopOnce.Do(func() { close(h.done) })
 	}
+	h.runningMu.Unlock()
 
-	close(h.done)
+	if started {
+		<-h.stopped
+	}
 }
 
-// shutdown performs graceful shutdown of all connections.
+// shutdown drains all connections so clients can reconnect cleanly to another replica.
+// Each client flushes its pending writes and receives a close frame with the
+// "service restart" code; connections still open after the drain timeout are dropped.
 func (h *Hub) shutdown() {
+	defer close(h.stopped)
+
 	h.runningMu.Lock()
 	h.running = false
 	h.runningMu.Unlock()
 
 	h.mu.Lock()
-	defer h.mu.Unlock()
-
-	// Close all client connections
+	clients := make([]*Client, 0, len(h.clients))
 	for client := range h.clients {
-		client.Close()
+		clients = append(clients, client)
 	}
 
 	// Clear all maps
 	h.clients = make(map[*Client]bool)
 	h.chatRooms = make(map[uuid.UUID]map[*Client]bool)
 	h.userClients = make(map[uuid.UUID]map[*Client]bool)
+	h.mu.Unlock()
 
-	h.logger.Info("websocket hub stopped")
+	for _, client := range clients {
+		client.Drain(websocket.CloseServiceRestart, DrainCloseReason)
+	}
+
+	timer := time.NewTimer(h.drainTimeout)
+	defer timer.Stop()
+
+	drained := 0
+wait:
+	for _, client := range clients {
+		select {
+		case <-client.Done():
+			drained++
+		case <-timer.C:
+			break wait
+		}
+	}
+
+	// Drop any connection that did not finish draining in time
+	for _, client := range clients {
+		client.Close()
+	}
+
+	h.logger.Info("websocket hub stopped",
+		slog.Int("clients", len(clients)),
+		slog.Int("drained", drained),
+	)
 }
 
 // Register registers a new client with the hub.
 func (h *Hub) Register(client *Client) {
-	h.register <- client
+	select {
+	case h.register <- client:
+	case <-h.stopped:
+		client.Close()
+	}
 }
 
 // Unregister unregisters a client from the hub.
 func (h *Hub) Unregister(client *Client) {
-	h.unregister <- client
+	select {
+	case h.unregister <- client:
+	case <-h.stopped:
+	}
 }
 
 // registerClient adds a client to the hub.
@@ -336,6 +462,20 @@ func (h *Hub) BroadcastToChat(chatID uuid.UUID, message []byte) {
 	}
 }
 
+// BroadcastToChatUsers sends a message to the clients in a chat room that belong to
+// one of the given users. Subscribers who are not listed do not receive it.
+func (h *Hub) BroadcastToChatUsers(chatID uuid.UUID, userIDs []uuid.UUID, message []byte) {
+	recipients := make(map[uuid.UUID]bool, len(userIDs))
+	for _, userID := range userIDs {
+		recipients[userID] = true
+	}
+	h.broadcast <- &broadcastMessage{
+		chatID:     &chatID,
+		recipients: recipients,
+		message:    message,
+	}
+}
+
 // SendToUser sends a message to all connections of a specific user.
 func (h *Hub) SendToUser(userID uuid.UUID, message []byte) {
 	h.broadcast <- &broadcastMessage{
@@ -344,19 +484,47 @@ func (h *Hub) SendToUser(userID uuid.UUID, message []byte) {
 	}
 }
 
+// BroadcastToAll sends a message to every connected client.
+func (h *Hub) BroadcastToAll(message []byte) {
+	h.broadcast <- &broadcastMessage{
+		all:     true,
+		message: message,
+	}
+}
+
 // handleBroadcast processes a broadcast message.
 func (h *Hub) handleBroadcast(msg *broadcastMessage) {
 	h.mu.RLock()
 	defer h.mu.RUnlock()
 
-	if msg.chatID != nil {
+	h.counters.broadcasts.Add(1)
+
+	if msg.all {
+		for client := range h.clients {
+			select {
+			case client.send <- msg.message:
+			default:
+				h.counters.sendBufferDrops.Add(1)
+				h.logger.Warn("client send buffer full, dropping message",
+					slog.String("user_id", client.userID.String()),
+				)
+			}
+		}
+	} else if msg.chatID != nil {
 		// Broadcast to chat room
 		if room, ok := h.chatRooms[*msg.chatID]; ok {
 			for client := range room {
+				if msg.recipients != nil && !msg.recipients[client.userID] {
+					continue
+				}
+				if msg.feature != "" && !client.HasFeature(msg.feature) {
+					continue
+				}
 				select {
 				case client.send <- msg.message:
 				default:
 					// Client's send buffer is full, skip this message
+					h.counters.sendBufferDrops.Add(1)
 					h.logger.Warn("client send buffer full, dropping message",
 						slog.String("user_id", client.userID.String()),
 						slog.String("chat_id", msg.chatID.String()),
@@ -371,6 +539,7 @@ func (h *Hub) handleBroadcast(msg *broadcastMessage) {
 				select {
 				case client.send <- msg.message:
 				default:
+					h.counters.sendBufferDrops.Add(1)
 					h.logger.Warn("client send buffer full, dropping message",
 						slog.String("user_id", msg.userID.String()),
 					)
@@ -387,6 +556,23 @@ func (h *Hub) ClientCount() int {
 	return len(h.clients)
 }
 
+// Stats returns the current connection count and the cumulative counters of the hub.
+func (h *Hub) Stats() HubStats {
+	return HubStats{
+		Connections:           h.ClientCount(),
+		Broadcasts:            h.counters.broadcasts.Load(),
+		SendBufferDrops:       h.counters.sendBufferDrops.Load(),
+		SlowClientDisconnects: h.counters.slowClientDisconnects.Load(),
+		AuthFailures:          h.counters.authFailures.Load(),
+	}
+}
+
+// RecordAuthFailure counts a connection attempt rejected for missing or invalid
+// credentials.
+func (h *Hub) RecordAuthFailure() {
+	h.counters.authFailures.Add(1)
+}
+
 // ChatRoomCount returns the number of active chat rooms.
 func (h *Hub) ChatRoomCount() int {
 	h.mu.RLock()
@@ -404,6 +590,42 @@ func (h *Hub) ClientsInChat(chatID uuid.UUID) int {
 	return 0
 }
 
+// ChatSubscribers returns the distinct users with a client subscribed to the chat room.
+func (h *Hub) ChatSubscribers(chatID uuid.UUID) []uuid.UUID {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	seen := make(map[uuid.UUID]bool)
+	users := make([]uuid.UUID, 0, len(h.chatRooms[chatID]))
+	for client := range h.chatRooms[chatID] {
+		if !seen[client.userID] {
+			seen[client.userID] = true
+			users = append(users, client.userID)
+		}
+	}
+	return users
+}
+
+// SubscribedChats returns the chat rooms of every client subscribed to at least
+// one chat, one slice per client.
+func (h *Hub) SubscribedChats() [][]uuid.UUID {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	byClient := make(map[*Client][]uuid.UUID)
+	for chatID, room := range h.chatRooms {
+		for client := range room {
+			byClient[client] = append(byClient[client], chatID)
+		}
+	}
+
+	subscriptions := make([][]uuid.UUID, 0, len(byClient))
+	for _, chatIDs := range byClient {
+		subscriptions = append(subscriptions, chatIDs)
+	}
+	return subscriptions
+}
+
 // IsRunning returns whether the hub is currently running.
 func (h *Hub) IsRunning() bool {
 	h.runningMu.RLock()
@@ -453,7 +675,11 @@ func (h *Hub) BroadcastPresenceChange(userID uuid.UUID, chatIDs []uuid.UUID, isO
 	}
 
 	for _, chatID := range chatIDs {
-		h.BroadcastToChat(chatID, msgBytes)
+		h.broadcast <- &broadcastMessage{
+			chatID:  &chatID,
+			feature: FeaturePresence,
+			message: msgBytes,
+		}
 	}
 }
 
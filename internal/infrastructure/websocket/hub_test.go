@@ -115,6 +115,53 @@ func TestHub_Run(t *testing.T) {
 	})
 }
 
+func TestHub_StopDrainsClients(t *testing.T) {
+	hub := ws.NewHub(ws.WithDrainTimeout(time.Second))
+	go hub.Run(t.Context())
+	time.Sleep(10 * time.Millisecond)
+
+	serverConn, clientConn, cleanup := createWSConnPair(t)
+	defer cleanup()
+
+	client := ws.NewClient(hub, serverConn, uuid.NewUUID())
+	hub.Register(client)
+	go client.WritePump()
+
+	client.Send([]byte(`{"type":"pending"}`))
+	hub.Stop()
+
+	assert.False(t, hub.IsRunning())
+	assert.True(t, client.IsClosed())
+
+	// Pending messages are flushed before the close frame
+	require.NoError(t, clientConn.SetReadDeadline(time.Now().Add(time.Second)))
+	_, msg, err := clientConn.ReadMessage()
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"type":"pending"}`, string(msg))
+
+	_, _, err = clientConn.ReadMessage()
+	var closeErr *websocket.CloseError
+	require.ErrorAs(t, err, &closeErr)
+	assert.Equal(t, websocket.CloseServiceRestart, closeErr.Code)
+	assert.Equal(t, ws.DrainCloseReason, closeErr.Text)
+}
+
+func TestHub_StopWithoutRun(t *testing.T) {
+	hub := ws.NewHub()
+
+	done := make(chan struct{})
+	go func() {
+		hub.Stop()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("Stop blocked on a hub that never ran")
+	}
+}
+
 func TestHub_RegisterUnregister(t *testing.T) {
 	t.Run("registers and counts client", func(t *testing.T) {
 		hub := ws.NewHub()
@@ -245,6 +292,32 @@ func TestHub_ChatRooms(t *testing.T) {
 		assert.Equal(t, 2, hub.ClientsInChat(chatID))
 	})
 
+	t.Run("lists subscribed chats per client", func(t *testing.T) {
+		hub := ws.NewHub()
+		ctx := t.Context()
+
+		go hub.Run(ctx)
+		time.Sleep(10 * time.Millisecond)
+
+		chat1, chat2 := uuid.NewUUID(), uuid.NewUUID()
+		client1 := createMockClient(t, hub, uuid.NewUUID())
+		client2 := createMockClient(t, hub, uuid.NewUUID())
+		idle := createMockClient(t, hub, uuid.NewUUID())
+
+		hub.Register(client1)
+		hub.Register(client2)
+		hub.Register(idle)
+		time.Sleep(10 * time.Millisecond)
+		hub.JoinChat(client1, chat1)
+		hub.JoinChat(client1, chat2)
+		hub.JoinChat(client2, chat2)
+
+		subscriptions := hub.SubscribedChats()
+		require.Len(t, subscriptions, 2)
+		sizes := []int{len(subscriptions[0]), len(subscriptions[1])}
+		assert.ElementsMatch(t, []int{1, 2}, sizes)
+	})
+
 	t.Run("removes chat room when empty", func(t *testing.T) {
 		hub := ws.NewHub()
 		ctx := t.Context()
@@ -325,6 +398,62 @@ func TestHub_BroadcastToChat(t *testing.T) {
 	})
 }
 
+func TestHub_BroadcastToAll(t *testing.T) {
+	hub := ws.NewHub()
+	ctx := t.Context()
+
+	go hub.Run(ctx)
+	time.Sleep(10 * time.Millisecond)
+
+	client1, sendChan1 := createTestClientWithChannel(t, hub, uuid.NewUUID())
+	client2, sendChan2 := createTestClientWithChannel(t, hub, uuid.NewUUID())
+
+	hub.Register(client1)
+	hub.Register(client2)
+	time.Sleep(10 * time.Millisecond)
+
+	hub.JoinChat(client1, uuid.NewUUID())
+	time.Sleep(10 * time.Millisecond)
+
+	message := []byte(`{"type":"announcement.updated"}`)
+	hub.BroadcastToAll(message)
+	time.Sleep(10 * time.Millisecond)
+
+	// Clients receive the message regardless of chat membership
+	assertReceived(t, sendChan1, message)
+	assertReceived(t, sendChan2, message)
+}
+
+func TestHub_Stats(t *testing.T) {
+	hub := ws.NewHub()
+	ctx := t.Context()
+
+	go hub.Run(ctx)
+	time.Sleep(10 * time.Millisecond)
+
+	// Without a write pump nothing drains the send buffer
+	server, _, err := createWebSocketPair(t)
+	require.NoError(t, err)
+	userID := uuid.NewUUID()
+	hub.Register(ws.NewClient(hub, server, userID))
+	time.Sleep(10 * time.Millisecond)
+
+	// one message more than the default client send buffer holds
+	const messages = 257
+	for range messages {
+		hub.SendToUser(userID, []byte(`{"type":"test"}`))
+	}
+	hub.RecordAuthFailure()
+	time.Sleep(20 * time.Millisecond)
+
+	stats := hub.Stats()
+	assert.Equal(t, 1, stats.Connections)
+	assert.Equal(t, uint64(messages), stats.Broadcasts)
+	assert.Equal(t, uint64(1), stats.SendBufferDrops)
+	assert.Equal(t, uint64(1), stats.AuthFailures)
+	assert.Zero(t, stats.SlowClientDisconnects)
+}
+
 func TestHub_SendToUser(t *testing.T) {
 	t.Run("sends message to specific user", func(t *testing.T) {
 		hub := ws.NewHub()
@@ -0,0 +1,113 @@
+package websocket
+
+import (
+	"encoding/json"
+	"slices"
+)
+
+// Protocol versions understood by the server.
+const (
+	// ProtocolVersion is the newest protocol version the server speaks.
+	ProtocolVersion = 1
+
+	// MinProtocolVersion is the oldest protocol version the server still accepts.
+	MinProtocolVersion = 1
+)
+
+// Protocol features a client can declare in its hello message.
+const (
+	// FeatureCompression enables permessage-deflate compression of server messages.
+	// It is only offered when the extension was negotiated during the HTTP upgrade.
+	FeatureCompression = "compression"
+
+	// FeatureReplay requests replay of missed events after a reconnect.
+	FeatureReplay = "replay"
+
+	// FeaturePresence delivers presence.changed messages.
+	FeaturePresence = "presence"
+)
+
+// DefaultServerFeatures are the features offered when none are configured for a client.
+func DefaultServerFeatures() []string {
+	return []string{FeaturePresence}
+}
+
+// WithServerFeatures sets the features the server offers on this connection.
+func WithServerFeatures(features ...string) ClientOption {
+	return func(c *Client) {
+		c.serverFeatures = features
+	}
+}
+
+// ProtocolVersion returns the negotiated protocol version. Clients that never sent
+// hello speak the original protocol, version 1.
+func (c *Client) ProtocolVersion() int {
+	c.protocolMu.RLock()
+	defer c.protocolMu.RUnlock()
+	if c.protocolVersion == 0 {
+		return MinProtocolVersion
+	}
+	return c.protocolVersion
+}
+
+// HasFeature reports whether a feature is enabled on the connection. Before a hello
+// handshake every offered feature is enabled, which matches the behaviour that
+// clients predating the handshake rely on.
+func (c *Client) HasFeature(feature string) bool {
+	c.protocolMu.RLock()
+	defer c.protocolMu.RUnlock()
+	if c.features == nil {
+		return slices.Contains(c.serverFeatures, feature)
+	}
+	return c.features[feature]
+}
+
+// writeCompression reports whether outgoing messages should be compressed, and
+// whether the handshake decided it at all.
+func (c *Client) writeCompression() (bool, bool) {
+	c.protocolMu.RLock()
+	defer c.protocolMu.RUnlock()
+	if c.features == nil {
+		return false, false
+	}
+	return c.features[FeatureCompression], true
+}
+
+// handleHello negotiates the protocol version and features with the client. The
+// negotiated version is the lower of the two sides' versions; the negotiated features
+// are those declared by the client that the server offers. The handshake happens once
+// per connection.
+func (c *Client) handleHello(version int, features []string) {
+	if version < MinProtocolVersion {
+		c.sendError(ErrorCodeUnsupportedVersion, "unsupported protocol version")
+		return
+	}
+
+	c.protocolMu.Lock()
+	if c.features != nil {
+		c.protocolMu.Unlock()
+		c.sendError(ErrorCodeProtocol, "hello already received")
+		return
+	}
+	c.protocolVersion = min(version, ProtocolVersion)
+	c.features = make(map[string]bool, len(features))
+	negotiated := make([]string, 0, len(features))
+	for _, feature := range features {
+		if slices.Contains(c.serverFeatures, feature) && !c.features[feature] {
+			c.features[feature] = true
+			negotiated = append(negotiated, feature)
+		}
+	}
+	negotiatedVersion := c.protocolVersion
+	c.protocolMu.Unlock()
+
+	slices.Sort(negotiated)
+	response := map[string]any{
+		"type":     "ack",
+		"action":   "hello",
+		"version":  negotiatedVersion,
+		"features": negotiated,
+	}
+	data, _ := json.Marshal(response)
+	c.Send(data)
+}
@@ -0,0 +1,115 @@
+package websocket_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/lllypuk/flowra/internal/domain/uuid"
+	ws "github.com/lllypuk/flowra/internal/infrastructure/websocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func sendHello(t *testing.T, conn *websocket.Conn, version int, features ...string) {
+	t.Helper()
+
+	data, err := json.Marshal(map[string]any{"type": "hello", "version": version, "features": features})
+	require.NoError(t, err)
+	require.NoError(t, conn.WriteMessage(websocket.TextMessage, data))
+}
+
+func TestClient_Hello(t *testing.T) {
+	t.Run("negotiates version and offered features", func(t *testing.T) {
+		client, conn := startPumpedClient(t, uuid.NewUUID(), ws.DefaultClientConfig())
+
+		sendHello(t, conn, ws.ProtocolVersion+1, ws.FeaturePresence, ws.FeatureReplay, ws.FeatureCompression)
+		msg := readJSON(t, conn)
+
+		assert.Equal(t, "ack", msg["type"])
+		assert.Equal(t, "hello", msg["action"])
+		assert.InDelta(t, ws.ProtocolVersion, msg["version"], 0)
+		assert.Equal(t, []any{ws.FeaturePresence}, msg["features"])
+		assert.Equal(t, ws.ProtocolVersion, client.ProtocolVersion())
+		assert.True(t, client.HasFeature(ws.FeaturePresence))
+		assert.False(t, client.HasFeature(ws.FeatureReplay))
+	})
+
+	t.Run("disables features the client did not declare", func(t *testing.T) {
+		client, conn := startPumpedClient(t, uuid.NewUUID(), ws.DefaultClientConfig(),
+			ws.WithServerFeatures(ws.FeaturePresence, ws.FeatureCompression))
+
+		sendHello(t, conn, ws.ProtocolVersion, ws.FeatureCompression)
+		msg := readJSON(t, conn)
+
+		assert.Equal(t, []any{ws.FeatureCompression}, msg["features"])
+		assert.True(t, client.HasFeature(ws.FeatureCompression))
+		assert.False(t, client.HasFeature(ws.FeaturePresence))
+	})
+
+	t.Run("keeps offered features for clients without hello", func(t *testing.T) {
+		client, _ := startPumpedClient(t, uuid.NewUUID(), ws.DefaultClientConfig())
+
+		assert.Equal(t, ws.MinProtocolVersion, client.ProtocolVersion())
+		assert.True(t, client.HasFeature(ws.FeaturePresence))
+		assert.False(t, client.HasFeature(ws.FeatureCompression))
+	})
+
+	t.Run("rejects unsupported versions", func(t *testing.T) {
+		_, conn := startPumpedClient(t, uuid.NewUUID(), ws.DefaultClientConfig())
+
+		sendHello(t, conn, ws.MinProtocolVersion-1)
+		msg := readJSON(t, conn)
+
+		assert.Equal(t, "error", msg["type"])
+		assert.Equal(t, "unsupported protocol version", msg["message"])
+	})
+
+	t.Run("rejects a second hello", func(t *testing.T) {
+		_, conn := startPumpedClient(t, uuid.NewUUID(), ws.DefaultClientConfig())
+
+		sendHello(t, conn, ws.ProtocolVersion)
+		assert.Equal(t, "ack", readJSON(t, conn)["type"])
+
+		sendHello(t, conn, ws.ProtocolVersion, ws.FeaturePresence)
+		assert.Equal(t, "error", readJSON(t, conn)["type"])
+	})
+}
+
+func TestHub_PresenceRequiresFeature(t *testing.T) {
+	hub := ws.NewHub()
+	go hub.Run(t.Context())
+
+	chatID := uuid.NewUUID()
+	subscribe := func(t *testing.T, hello bool, features ...string) *websocket.Conn {
+		t.Helper()
+
+		serverConn, clientConn, cleanup := createWSConnPair(t)
+		t.Cleanup(cleanup)
+		client := ws.NewClient(hub, serverConn, uuid.NewUUID())
+		hub.Register(client)
+		time.Sleep(10 * time.Millisecond)
+		go client.WritePump()
+		go client.ReadPump()
+
+		if hello {
+			sendHello(t, clientConn, ws.ProtocolVersion, features...)
+			require.Equal(t, "ack", readJSON(t, clientConn)["type"])
+		}
+		hub.JoinChat(client, chatID)
+		return clientConn
+	}
+
+	legacy := subscribe(t, false)
+	withPresence := subscribe(t, true, ws.FeaturePresence)
+	withoutPresence := subscribe(t, true)
+	time.Sleep(20 * time.Millisecond)
+
+	hub.BroadcastPresenceChange(uuid.NewUUID(), []uuid.UUID{chatID}, true)
+	hub.BroadcastToChat(chatID, []byte(`{"type":"chat.closed"}`))
+
+	assert.Equal(t, "presence.changed", readJSON(t, legacy)["type"])
+	assert.Equal(t, "presence.changed", readJSON(t, withPresence)["type"])
+	assert.Equal(t, "chat.closed", readJSON(t, withoutPresence)["type"])
+}
@@ -0,0 +1,189 @@
+package websocket
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"slices"
+
+	"github.com/lllypuk/flowra/internal/domain/uuid"
+)
+
+// Error codes sent in error frames.
+const (
+	// ErrorCodeInvalidRequest means the frame is not a JSON object with a string type.
+	ErrorCodeInvalidRequest = "INVALID_REQUEST"
+
+	// ErrorCodeValidation means a field of a known message type is missing or malformed.
+	ErrorCodeValidation = "VALIDATION_ERROR"
+
+	// ErrorCodeUnknownMessageType is the protocol error for message types the server does not define.
+	ErrorCodeUnknownMessageType = "UNKNOWN_MESSAGE_TYPE"
+
+	// ErrorCodeProtocol means the message is valid but not allowed in the connection's state.
+	ErrorCodeProtocol = "PROTOCOL_ERROR"
+
+	// ErrorCodeUnsupportedVersion means the client's protocol version is too old.
+	ErrorCodeUnsupportedVersion = "UNSUPPORTED_VERSION"
+
+	// ErrorCodeAuthFailed means an in-band token refresh was rejected.
+	ErrorCodeAuthFailed = "AUTH_FAILED"
+
+	// ErrorCodeInternal means handling the message failed on the server.
+	ErrorCodeInternal = "INTERNAL_ERROR"
+)
+
+// ErrorMessage is the error frame sent to a client.
+type ErrorMessage struct {
+	Type        string `json:"type"`
+	Code        string `json:"code"`
+	Message     string `json:"message"`
+	Field       string `json:"field,omitempty"`
+	RequestType string `json:"request_type,omitempty"`
+}
+
+// fieldKind is the JSON shape a message field must have.
+type fieldKind int
+
+const (
+	fieldUUID fieldKind = iota
+	fieldString
+	fieldInteger
+	fieldStringList
+)
+
+// fieldSpec defines one field of an inbound message.
+type fieldSpec struct {
+	name     string
+	kind     fieldKind
+	required bool
+}
+
+// inboundMessages defines the fields of every message type a client may send.
+// Fields that are not listed are rejected.
+//
+//nolint:gochecknoglobals // immutable protocol definition
+var inboundMessages = map[string][]fieldSpec{
+	"subscribe":    {{name: "chat_id", kind: fieldUUID, required: true}},
+	"unsubscribe":  {{name: "chat_id", kind: fieldUUID, required: true}},
+	"chat.typing":  {{name: "chat_id", kind: fieldUUID, required: true}},
+	"ping":         nil,
+	"auth.refresh": {{name: "token", kind: fieldString, required: true}},
+	"hello": {
+		{name: "version", kind: fieldInteger, required: true},
+		{name: "features", kind: fieldStringList},
+	},
+}
+
+// protocolError describes why an inbound message was rejected.
+type protocolError struct {
+	code        string
+	message     string
+	field       string
+	requestType string
+}
+
+// frame returns the error frame for the client.
+func (e *protocolError) frame() ErrorMessage {
+	return ErrorMessage{
+		Type:        "error",
+		Code:        e.code,
+		Message:     e.message,
+		Field:       e.field,
+		RequestType: e.requestType,
+	}
+}
+
+// parseClientMessage validates a frame against the definition of its message type
+// and decodes it.
+func parseClientMessage(data []byte) (ClientMessage, *protocolError) {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil || raw == nil {
+		return ClientMessage{}, &protocolError{code: ErrorCodeInvalidRequest, message: "invalid message format"}
+	}
+
+	var msgType string
+	if err := json.Unmarshal(raw["type"], &msgType); err != nil || msgType == "" {
+		return ClientMessage{}, &protocolError{
+			code:    ErrorCodeInvalidRequest,
+			message: "type is required",
+			field:   "type",
+		}
+	}
+
+	fields, ok := inboundMessages[msgType]
+	if !ok {
+		return ClientMessage{}, &protocolError{
+			code:        ErrorCodeUnknownMessageType,
+			message:     "unknown message type: " + msgType,
+			requestType: msgType,
+		}
+	}
+
+	for name := range raw {
+		if name != "type" && !slices.ContainsFunc(fields, func(f fieldSpec) bool { return f.name == name }) {
+			return ClientMessage{}, validationError(msgType, name, fmt.Sprintf("%s does not accept %s", msgType, name))
+		}
+	}
+	for _, field := range fields {
+		value, present := raw[field.name]
+		if !present || bytes.Equal(value, []byte("null")) {
+			if field.required {
+				return ClientMessage{}, validationError(msgType, field.name,
+					fmt.Sprintf("%s is required for %s", field.name, msgType))
+			}
+			continue
+		}
+		if !validField(field.kind, value) {
+			return ClientMessage{}, validationError(msgType, field.name,
+				fmt.Sprintf("%s must be %s", field.name, field.kind.description()))
+		}
+	}
+
+	var msg ClientMessage
+	if err := json.Unmarshal(data, &msg); err != nil {
+		return ClientMessage{}, &protocolError{code: ErrorCodeInvalidRequest, message: "invalid message format"}
+	}
+	return msg, nil
+}
+
+func validationError(msgType, field, message string) *protocolError {
+	return &protocolError{code: ErrorCodeValidation, message: message, field: field, requestType: msgType}
+}
+
+// validField reports whether a raw JSON value has the given shape.
+func validField(kind fieldKind, value json.RawMessage) bool {
+	switch kind {
+	case fieldUUID:
+		var s string
+		if json.Unmarshal(value, &s) != nil {
+			return false
+		}
+		id, err := uuid.ParseUUID(s)
+		return err == nil && !id.IsZero()
+	case fieldString:
+		var s string
+		return json.Unmarshal(value, &s) == nil && s != ""
+	case fieldInteger:
+		var n int
+		return json.Unmarshal(value, &n) == nil
+	case fieldStringList:
+		var list []string
+		return json.Unmarshal(value, &list) == nil
+	}
+	return false
+}
+
+func (k fieldKind) description() string {
+	switch k {
+	case fieldUUID:
+		return "a UUID"
+	case fieldString:
+		return "a non-empty string"
+	case fieldInteger:
+		return "an integer"
+	case fieldStringList:
+		return "an array of strings"
+	}
+	return "valid"
+}
@@ -0,0 +1,105 @@
+package websocket_test
+
+import (
+	"testing"
+
+	"github.com/gorilla/websocket"
+	"github.com/lllypuk/flowra/internal/domain/uuid"
+	ws "github.com/lllypuk/flowra/internal/infrastructure/websocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_InboundValidation(t *testing.T) {
+	chatID := uuid.NewUUID().String()
+
+	tests := []struct {
+		name        string
+		frame       string
+		code        string
+		field       string
+		requestType string
+	}{
+		{name: "malformed JSON", frame: `{"type":`, code: ws.ErrorCodeInvalidRequest},
+		{name: "not an object", frame: `["subscribe"]`, code: ws.ErrorCodeInvalidRequest},
+		{name: "missing type", frame: `{"chat_id":"` + chatID + `"}`, code: ws.ErrorCodeInvalidRequest, field: "type"},
+		{name: "non-string type", frame: `{"type":42}`, code: ws.ErrorCodeInvalidRequest, field: "type"},
+		{
+			name:        "unknown type",
+			frame:       `{"type":"chat.delete"}`,
+			code:        ws.ErrorCodeUnknownMessageType,
+			requestType: "chat.delete",
+		},
+		{
+			name:        "missing required field",
+			frame:       `{"type":"subscribe"}`,
+			code:        ws.ErrorCodeValidation,
+			field:       "chat_id",
+			requestType: "subscribe",
+		},
+		{
+			name:        "null required field",
+			frame:       `{"type":"chat.typing","chat_id":null}`,
+			code:        ws.ErrorCodeValidation,
+			field:       "chat_id",
+			requestType: "chat.typing",
+		},
+		{
+			name:        "malformed UUID",
+			frame:       `{"type":"unsubscribe","chat_id":"not-a-uuid"}`,
+			code:        ws.ErrorCodeValidation,
+			field:       "chat_id",
+			requestType: "unsubscribe",
+		},
+		{
+			name:        "wrong field type",
+			frame:       `{"type":"hello","version":"1"}`,
+			code:        ws.ErrorCodeValidation,
+			field:       "version",
+			requestType: "hello",
+		},
+		{
+			name:        "empty token",
+			frame:       `{"type":"auth.refresh","token":""}`,
+			code:        ws.ErrorCodeValidation,
+			field:       "token",
+			requestType: "auth.refresh",
+		},
+		{
+			name:        "unexpected field",
+			frame:       `{"type":"ping","chat_id":"` + chatID + `"}`,
+			code:        ws.ErrorCodeValidation,
+			field:       "chat_id",
+			requestType: "ping",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, conn := startPumpedClient(t, uuid.NewUUID(), ws.DefaultClientConfig())
+
+			require.NoError(t, conn.WriteMessage(websocket.TextMessage, []byte(tt.frame)))
+			msg := readJSON(t, conn)
+
+			assert.Equal(t, "error", msg["type"])
+			assert.Equal(t, tt.code, msg["code"])
+			assert.NotEmpty(t, msg["message"])
+			if tt.field != "" {
+				assert.Equal(t, tt.field, msg["field"])
+			} else {
+				assert.NotContains(t, msg, "field")
+			}
+			if tt.requestType != "" {
+				assert.Equal(t, tt.requestType, msg["request_type"])
+			}
+		})
+	}
+
+	t.Run("accepts valid messages", func(t *testing.T) {
+		_, conn := startPumpedClient(t, uuid.NewUUID(), ws.DefaultClientConfig())
+
+		require.NoError(t, conn.WriteMessage(websocket.TextMessage, []byte(`{"type":"ping"}`)))
+
+		assert.Equal(t, "pong", readJSON(t, conn)["type"])
+	})
+}
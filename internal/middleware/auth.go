@@ -328,13 +328,7 @@ func respondAuthError(c echo.Context, err error) error {
 		status = http.StatusForbidden
 	}
 
-	return c.JSON(status, map[string]any{
-		"success": false,
-		"error": map[string]string{
-			"code":    code,
-			"message": message,
-		},
-	})
+	return respondError(c, status, code, message, nil)
 }
 
 // GetUserID extracts the user ID from the echo context.
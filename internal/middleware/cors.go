@@ -11,6 +11,17 @@ const (
 	DefaultCORSMaxAge = 86400
 )
 
+// HTMX headers that cross-origin HTMX clients send and read.
+const (
+	HeaderHXRequest    = "HX-Request"
+	HeaderHXTarget     = "HX-Target"
+	HeaderHXTrigger    = "HX-Trigger"
+	HeaderHXCurrentURL = "HX-Current-URL"
+	HeaderHXRedirect   = "HX-Redirect"
+	HeaderHXRetarget   = "HX-Retarget"
+	HeaderHXReswap     = "HX-Reswap"
+)
+
 // CORSConfig holds CORS middleware configuration.
 type CORSConfig struct {
 	// AllowOrigins defines a list of origins that may access the resource.
@@ -54,15 +65,39 @@ func DefaultCORSConfig() CORSConfig {
 			echo.HeaderAccept,
 			echo.HeaderAuthorization,
 			echo.HeaderXRequestID,
+			CorrelationIDHeader,
+			HeaderHXRequest,
+			HeaderHXTarget,
+			HeaderHXTrigger,
+			HeaderHXCurrentURL,
 		},
 		AllowCredentials: false,
-		ExposeHeaders:    []string{},
-		MaxAge:           DefaultCORSMaxAge,
+		ExposeHeaders: []string{
+			RequestIDHeader,
+			CorrelationIDHeader,
+			HeaderHXRedirect,
+			HeaderHXRetarget,
+			HeaderHXReswap,
+			HeaderHXTrigger,
+			HeaderRateLimitLimit,
+			HeaderRateLimitRemaining,
+			HeaderRateLimitReset,
+			echo.HeaderRetryAfter,
+		},
+		MaxAge: DefaultCORSMaxAge,
 	}
 }
 
 // CORS returns a CORS middleware with the given configuration.
+// With no allowed origins no CORS headers are sent, so only same-origin
+// browser requests succeed.
 func CORS(config CORSConfig) echo.MiddlewareFunc {
+	if len(config.AllowOrigins) == 0 {
+		return func(next echo.HandlerFunc) echo.HandlerFunc {
+			return next
+		}
+	}
+
 	return middleware.CORSWithConfig(middleware.CORSConfig{
 		AllowOrigins:     config.AllowOrigins,
 		AllowMethods:     config.AllowMethods,
@@ -26,11 +26,36 @@ func TestDefaultCORSConfig(t *testing.T) {
 	assert.Contains(t, config.AllowHeaders, echo.HeaderAccept)
 	assert.Contains(t, config.AllowHeaders, echo.HeaderAuthorization)
 	assert.Contains(t, config.AllowHeaders, echo.HeaderXRequestID)
+	assert.Contains(t, config.AllowHeaders, middleware.HeaderHXRequest)
 	assert.False(t, config.AllowCredentials)
-	assert.Empty(t, config.ExposeHeaders)
+	assert.Contains(t, config.ExposeHeaders, middleware.RequestIDHeader)
+	assert.Contains(t, config.ExposeHeaders, middleware.CorrelationIDHeader)
+	assert.Contains(t, config.ExposeHeaders, middleware.HeaderHXRedirect)
+	assert.Contains(t, config.ExposeHeaders, middleware.HeaderRateLimitRemaining)
+	assert.Contains(t, config.ExposeHeaders, echo.HeaderRetryAfter)
 	assert.Equal(t, middleware.DefaultCORSMaxAge, config.MaxAge)
 }
 
+func TestCORSNoAllowedOrigins(t *testing.T) {
+	config := middleware.DefaultCORSConfig()
+	config.AllowOrigins = nil
+
+	e := echo.New()
+	e.Use(middleware.CORS(config))
+	e.GET("/test", func(c echo.Context) error {
+		return c.String(http.StatusOK, "ok")
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/test", nil)
+	req.Header.Set(echo.HeaderOrigin, "http://evil.example.com")
+	rec := httptest.NewRecorder()
+
+	e.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Empty(t, rec.Header().Get(echo.HeaderAccessControlAllowOrigin))
+}
+
 func TestCORS(t *testing.T) {
 	tests := []struct {
 		name                   string
@@ -7,6 +7,8 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
+
+	"github.com/lllypuk/flowra/internal/application/appcore"
 )
 
 // HTTP status code thresholds for log levels.
@@ -19,8 +21,17 @@ const (
 	// RequestIDHeader is the header name for request ID.
 	RequestIDHeader = "X-Request-ID"
 
+	// CorrelationIDHeader is the header name for the correlation ID shared across requests.
+	CorrelationIDHeader = "X-Correlation-ID"
+
 	// RequestIDKey is the context key for request ID.
 	RequestIDKey = "request_id"
+
+	// CorrelationIDKey is the context key for correlation ID.
+	CorrelationIDKey = "correlation_id"
+
+	// maxInboundIDLength caps client-supplied request/correlation IDs.
+	maxInboundIDLength = 128
 )
 
 // LoggingConfig holds configuration for the logging middleware.
@@ -60,20 +71,35 @@ func Logging(config LoggingConfig) echo.MiddlewareFunc {
 			res := c.Response()
 			path := req.URL.Path
 
-			// Skip logging for configured paths
-			if _, ok := skipPaths[path]; ok {
-				return next(c)
-			}
-
-			// Get or generate request ID
-			requestID := req.Header.Get(RequestIDHeader)
+			// Honor a well-formed inbound request ID, otherwise generate one.
+			requestID := sanitizeInboundID(req.Header.Get(RequestIDHeader))
 			if requestID == "" {
 				requestID = uuid.New().String()
 			}
 
-			// Set request ID in response header and context
+			// Correlation ID spans a chain of requests; default to this request's ID.
+			correlationID := sanitizeInboundID(req.Header.Get(CorrelationIDHeader))
+			if correlationID == "" {
+				correlationID = requestID
+			}
+
+			// Set IDs in response headers, echo context and request context so that
+			// every *Context slog call downstream carries them.
 			res.Header().Set(RequestIDHeader, requestID)
+			res.Header().Set(CorrelationIDHeader, correlationID)
 			c.Set(RequestIDKey, requestID)
+			c.Set(CorrelationIDKey, correlationID)
+
+			ctx := appcore.WithRequestID(req.Context(), requestID)
+			ctx = appcore.WithCorrelationID(ctx, correlationID)
+			ctx = appcore.WithClientIP(ctx, c.RealIP())
+			req = req.WithContext(ctx)
+			c.SetRequest(req)
+
+			// Skip logging for configured paths
+			if _, ok := skipPaths[path]; ok {
+				return next(c)
+			}
 
 			// Record start time
 			start := time.Now()
@@ -96,6 +122,7 @@ func Logging(config LoggingConfig) echo.MiddlewareFunc {
 			// Build log attributes
 			attrs := []slog.Attr{
 				slog.String("request_id", requestID),
+				slog.String("correlation_id", correlationID),
 				slog.String("method", req.Method),
 				slog.String("path", path),
 				slog.Int("status", status),
@@ -134,7 +161,11 @@ func Logging(config LoggingConfig) echo.MiddlewareFunc {
 				}
 			}
 
-			config.Logger.LogAttrs(c.Request().Context(), level, msg, attrs...)
+			if userID := GetUserID(c); !userID.IsZero() {
+				attrs = append(attrs, slog.String("user_id", userID.String()))
+			}
+
+			config.Logger.LogAttrs(req.Context(), level, msg, attrs...)
 
 			return err
 		}
@@ -153,3 +184,27 @@ func GetRequestID(c echo.Context) string {
 	}
 	return ""
 }
+
+// GetCorrelationID retrieves the correlation ID from the echo context.
+func GetCorrelationID(c echo.Context) string {
+	if id, ok := c.Get(CorrelationIDKey).(string); ok {
+		return id
+	}
+	return ""
+}
+
+// sanitizeInboundID returns id if it is a safe, bounded token, otherwise "".
+// Client-supplied IDs end up in logs and response headers, so only a
+// conservative character set is accepted.
+func sanitizeInboundID(id string) string {
+	if id == "" || len(id) > maxInboundIDLength {
+		return ""
+	}
+	for _, r := range id {
+		isAlnum := (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9')
+		if !isAlnum && r != '-' && r != '_' && r != '.' && r != ':' {
+			return ""
+		}
+	}
+	return id
+}
@@ -3,6 +3,7 @@ package middleware_test
 import (
 	"bytes"
 	"encoding/json"
+	"io"
 	"log/slog"
 	"net/http"
 	"net/http/httptest"
@@ -10,6 +11,7 @@ import (
 	"testing"
 
 	"github.com/labstack/echo/v4"
+	"github.com/lllypuk/flowra/internal/application/appcore"
 	"github.com/lllypuk/flowra/internal/middleware"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -441,6 +443,29 @@ func TestLoggingRemoteIP(t *testing.T) {
 	assert.Contains(t, logBuffer.String(), "remote_ip")
 }
 
+func TestLoggingStoresClientIPInContext(t *testing.T) {
+	e := echo.New()
+	e.IPExtractor = echo.ExtractIPDirect()
+	e.Use(middleware.Logging(middleware.LoggingConfig{
+		Logger: slog.New(slog.NewJSONHandler(io.Discard, nil)),
+	}))
+
+	var clientIP string
+	e.GET("/test", func(c echo.Context) error {
+		clientIP = appcore.GetClientIP(c.Request().Context())
+		return c.String(http.StatusOK, "ok")
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/test", nil)
+	req.RemoteAddr = "198.51.100.4:5555"
+	req.Header.Set("X-Forwarded-For", "203.0.113.7")
+	rec := httptest.NewRecorder()
+
+	e.ServeHTTP(rec, req)
+
+	assert.Equal(t, "198.51.100.4", clientIP)
+}
+
 func TestLoggingNilLogger(t *testing.T) {
 	e := echo.New()
 	e.Use(middleware.Logging(middleware.LoggingConfig{
@@ -495,3 +520,105 @@ func TestLoggingLogFormat(t *testing.T) {
 	assert.Contains(t, logEntry, "latency")
 	assert.Contains(t, logEntry, "remote_ip")
 }
+
+func TestLoggingCorrelationID(t *testing.T) {
+	tests := []struct {
+		name                      string
+		requestID                 string
+		correlationID             string
+		wantRequestID             string
+		wantCorrelationID         string
+		correlationFollowsRequest bool
+	}{
+		{
+			name:                      "correlation defaults to request ID",
+			requestID:                 "req-1",
+			wantRequestID:             "req-1",
+			correlationFollowsRequest: true,
+		},
+		{
+			name:              "honors inbound correlation ID",
+			requestID:         "req-2",
+			correlationID:     "corr-2",
+			wantRequestID:     "req-2",
+			wantCorrelationID: "corr-2",
+		},
+		{
+			name:                      "rejects unsafe inbound IDs",
+			requestID:                 "bad id\nwith newline",
+			correlationID:             strings.Repeat("x", 200),
+			correlationFollowsRequest: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var logBuffer bytes.Buffer
+			logger := slog.New(slog.NewJSONHandler(&logBuffer, nil))
+
+			e := echo.New()
+			e.Use(middleware.Logging(middleware.LoggingConfig{Logger: logger}))
+
+			var ctxRequestID, ctxCorrelationID string
+			e.GET("/test", func(c echo.Context) error {
+				ctxRequestID = appcore.GetRequestID(c.Request().Context())
+				ctxCorrelationID, _ = appcore.GetCorrelationID(c.Request().Context())
+				return c.String(http.StatusOK, "ok")
+			})
+
+			req := httptest.NewRequest(http.MethodGet, "/test", nil)
+			req.Header.Set(middleware.RequestIDHeader, tt.requestID)
+			if tt.correlationID != "" {
+				req.Header.Set(middleware.CorrelationIDHeader, tt.correlationID)
+			}
+			rec := httptest.NewRecorder()
+
+			e.ServeHTTP(rec, req)
+
+			requestID := rec.Header().Get(middleware.RequestIDHeader)
+			correlationID := rec.Header().Get(middleware.CorrelationIDHeader)
+			require.NotEmpty(t, requestID)
+			require.NotEmpty(t, correlationID)
+
+			if tt.wantRequestID != "" {
+				assert.Equal(t, tt.wantRequestID, requestID)
+			} else {
+				assert.NotEqual(t, tt.requestID, requestID)
+			}
+			if tt.correlationFollowsRequest {
+				assert.Equal(t, requestID, correlationID)
+			} else {
+				assert.Equal(t, tt.wantCorrelationID, correlationID)
+			}
+
+			assert.Equal(t, requestID, ctxRequestID)
+			assert.Equal(t, correlationID, ctxCorrelationID)
+			assert.Contains(t, logBuffer.String(), `"correlation_id":"`+correlationID+`"`)
+		})
+	}
+}
+
+func TestLoggingSkipPathStillAssignsRequestID(t *testing.T) {
+	var logBuffer bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&logBuffer, nil))
+
+	e := echo.New()
+	e.Use(middleware.Logging(middleware.LoggingConfig{
+		Logger:    logger,
+		SkipPaths: []string{"/health"},
+	}))
+
+	var ctxRequestID string
+	e.GET("/health", func(c echo.Context) error {
+		ctxRequestID = appcore.GetRequestID(c.Request().Context())
+		return c.String(http.StatusOK, "healthy")
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/health", nil)
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	assert.Empty(t, logBuffer.String())
+	assert.NotEmpty(t, rec.Header().Get(middleware.RequestIDHeader))
+	assert.Equal(t, rec.Header().Get(middleware.RequestIDHeader), ctxRequestID)
+}
@@ -0,0 +1,353 @@
+package middleware
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"html"
+	"log/slog"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/lllypuk/flowra/internal/domain/uuid"
+)
+
+// Maintenance defaults.
+const (
+	DefaultMaintenanceMessage  = "Flowra is down for maintenance. Please try again in a few minutes."
+	DefaultMaintenanceCacheTTL = 5 * time.Second
+	DefaultMaintenanceRedisKey = "flowra:maintenance"
+
+	maintenanceRetryAfter = "300"
+)
+
+// ErrMaintenanceToggleUnavailable is returned when maintenance mode cannot be switched at runtime.
+var ErrMaintenanceToggleUnavailable = errors.New("maintenance toggle is not configured")
+
+// MaintenanceState describes whether maintenance mode is on.
+type MaintenanceState struct {
+	Enabled   bool      `json:"enabled"`
+	Message   string    `json:"message,omitempty"`
+	UpdatedBy uuid.UUID `json:"updated_by,omitempty"`
+	UpdatedAt time.Time `json:"updated_at"`
+
+	// FromConfig is set when maintenance mode is forced by configuration
+	// and cannot be switched off at runtime.
+	FromConfig bool `json:"-"`
+}
+
+// MaintenanceStore persists the runtime maintenance toggle so that every instance sees it.
+type MaintenanceStore interface {
+	// Load returns the stored state. A missing state is returned as the zero value.
+	Load(ctx context.Context) (MaintenanceState, error)
+
+	// Save stores the state.
+	Save(ctx context.Context, state MaintenanceState) error
+}
+
+// MaintenanceSwitch combines the configured maintenance flag with the runtime toggle.
+// Maintenance mode enabled in configuration always wins; otherwise the runtime toggle
+// decides. Runtime state is cached briefly per instance.
+type MaintenanceSwitch struct {
+	store    MaintenanceStore
+	static   MaintenanceState
+	cacheTTL time.Duration
+	logger   *slog.Logger
+
+	mu       sync.Mutex
+	cached   MaintenanceState
+	cachedAt time.Time
+}
+
+// NewMaintenanceSwitch creates a maintenance switch.
+// enabled and message come from configuration; store may be nil to disable the runtime toggle.
+func NewMaintenanceSwitch(
+	enabled bool,
+	message string,
+	store MaintenanceStore,
+	logger *slog.Logger,
+) *MaintenanceSwitch {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &MaintenanceSwitch{
+		store:    store,
+		static:   MaintenanceState{Enabled: enabled, Message: message, FromConfig: enabled},
+		cacheTTL: DefaultMaintenanceCacheTTL,
+		logger:   logger,
+	}
+}
+
+// State returns the effective maintenance state.
+// If the store is unavailable the last known runtime state is used.
+func (s *MaintenanceSwitch) State(ctx context.Context) MaintenanceState {
+	if s.static.Enabled {
+		return s.withDefaultMessage(s.static)
+	}
+	if s.store == nil {
+		return s.static
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.cachedAt.IsZero() && time.Since(s.cachedAt) < s.cacheTTL {
+		return s.withDefaultMessage(s.cached)
+	}
+
+	state, err := s.store.Load(ctx)
+	if err != nil {
+		s.logger.WarnContext(ctx, "failed to load maintenance state", slog.String("error", err.Error()))
+	} else {
+		s.cached = state
+	}
+	s.cachedAt = time.Now()
+
+	return s.withDefaultMessage(s.cached)
+}
+
+// Set updates the runtime maintenance toggle.
+func (s *MaintenanceSwitch) Set(ctx context.Context, state MaintenanceState) error {
+	if s.store == nil {
+		return ErrMaintenanceToggleUnavailable
+	}
+
+	state.FromConfig = false
+	state.UpdatedAt = time.Now()
+	if err := s.store.Save(ctx, state); err != nil {
+		return fmt.Errorf("failed to save maintenance state: %w", err)
+	}
+
+	s.mu.Lock()
+	s.cached = state
+	s.cachedAt = time.Now()
+	s.mu.Unlock()
+
+	s.logger.InfoContext(ctx, "maintenance mode toggled",
+		slog.Bool("enabled", state.Enabled),
+		slog.String("updated_by", state.UpdatedBy.String()),
+	)
+	return nil
+}
+
+func (s *MaintenanceSwitch) withDefaultMessage(state MaintenanceState) MaintenanceState {
+	if state.Enabled && state.Message == "" {
+		state.Message = s.static.Message
+		if state.Message == "" {
+			state.Message = DefaultMaintenanceMessage
+		}
+	}
+	return state
+}
+
+// MaintenanceChecker reports the effective maintenance state.
+type MaintenanceChecker interface {
+	State(ctx context.Context) MaintenanceState
+}
+
+// MaintenanceConfig holds configuration for the maintenance middleware.
+type MaintenanceConfig struct {
+	// Logger is the structured logger for maintenance events.
+	Logger *slog.Logger
+
+	// Checker reports whether maintenance mode is on.
+	Checker MaintenanceChecker
+
+	// TokenValidator validates bearer tokens and session cookies so that
+	// system admins keep access during maintenance. Optional.
+	TokenValidator TokenValidator
+
+	// SessionCookieName is the name of the session cookie used by browser routes.
+	SessionCookieName string
+
+	// APIPrefix identifies API routes, which get a JSON envelope instead of an HTML page.
+	APIPrefix string
+
+	// AllowPaths stay available during maintenance. Each entry matches the path
+	// itself and everything below it.
+	AllowPaths []string
+
+	// PageRenderer renders the maintenance page for browser routes.
+	// If nil, a minimal built-in page is returned.
+	PageRenderer func(c echo.Context, state MaintenanceState) error
+}
+
+// DefaultMaintenanceConfig returns a MaintenanceConfig that keeps health checks,
+// metrics, static assets and the sign-in flow available.
+func DefaultMaintenanceConfig() MaintenanceConfig {
+	return MaintenanceConfig{
+		Logger:            slog.Default(),
+		SessionCookieName: "flowra_session",
+		APIPrefix:         "/api/v1",
+		AllowPaths: []string{
+			"/health",
+			"/ready",
+			"/metrics",
+			"/static",
+			"/login",
+			"/logout",
+			"/auth",
+			"/api/v1/auth",
+		},
+	}
+}
+
+// Maintenance returns a middleware that answers 503 while maintenance mode is on.
+// Allowed paths and system admins pass through.
+func Maintenance(config MaintenanceConfig) echo.MiddlewareFunc {
+	if config.Logger == nil {
+		config.Logger = slog.Default()
+	}
+
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			if config.Checker == nil {
+				return next(c)
+			}
+
+			state := config.Checker.State(c.Request().Context())
+			if !state.Enabled || isMaintenanceAllowedPath(c.Request().URL.Path, config.AllowPaths) {
+				return next(c)
+			}
+
+			if isMaintenanceAdmin(c, config) {
+				return next(c)
+			}
+
+			c.Response().Header().Set("Retry-After", maintenanceRetryAfter)
+			if isAPIRequest(c, config.APIPrefix) {
+				return respondError(c, http.StatusServiceUnavailable, "MAINTENANCE", state.Message, nil)
+			}
+
+			if config.PageRenderer != nil {
+				err := config.PageRenderer(c, state)
+				if err == nil || c.Response().Committed {
+					return err
+				}
+				config.Logger.Error("failed to render maintenance page", slog.String("error", err.Error()))
+			}
+			return c.HTML(http.StatusServiceUnavailable, defaultMaintenancePage(state.Message))
+		}
+	}
+}
+
+// isMaintenanceAllowedPath reports whether the path is one of allowed or below it.
+func isMaintenanceAllowedPath(path string, allowed []string) bool {
+	for _, prefix := range allowed {
+		prefix = strings.TrimSuffix(prefix, "/")
+		if path == prefix || strings.HasPrefix(path, prefix+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+// isMaintenanceAdmin reports whether the request carries a valid system admin token.
+func isMaintenanceAdmin(c echo.Context, config MaintenanceConfig) bool {
+	if config.TokenValidator == nil {
+		return false
+	}
+
+	token, err := extractBearerToken(c.Request().Header.Get(echo.HeaderAuthorization))
+	if err != nil && config.SessionCookieName != "" {
+		if cookie, cookieErr := c.Cookie(config.SessionCookieName); cookieErr == nil {
+			token = cookie.Value
+		}
+	}
+	if token == "" {
+		return false
+	}
+
+	claims, err := config.TokenValidator.ValidateToken(c.Request().Context(), token)
+	return err == nil && claims != nil && claims.IsSystemAdmin
+}
+
+// isAPIRequest reports whether the request targets the JSON API.
+func isAPIRequest(c echo.Context, apiPrefix string) bool {
+	if apiPrefix != "" && isMaintenanceAllowedPath(c.Request().URL.Path, []string{apiPrefix}) {
+		return true
+	}
+	return strings.Contains(c.Request().Header.Get(echo.HeaderAccept), echo.MIMEApplicationJSON)
+}
+
+func defaultMaintenancePage(message string) string {
+	return `<!doctype html><html><head><meta charset="UTF-8"><title>Maintenance - Flowra</title></head>` +
+		`<body><main><h1>We'll be right back</h1><p>` + html.EscapeString(message) + `</p></main></body></html>`
+}
+
+// MemoryMaintenanceStore keeps the runtime toggle in memory (single instance, tests).
+type MemoryMaintenanceStore struct {
+	mu    sync.Mutex
+	state MaintenanceState
+}
+
+// NewMemoryMaintenanceStore creates an in-memory maintenance store.
+func NewMemoryMaintenanceStore() *MemoryMaintenanceStore {
+	return &MemoryMaintenanceStore{}
+}
+
+// Load returns the stored state.
+func (s *MemoryMaintenanceStore) Load(_ context.Context) (MaintenanceState, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.state, nil
+}
+
+// Save stores the state.
+func (s *MemoryMaintenanceStore) Save(_ context.Context, state MaintenanceState) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.state = state
+	return nil
+}
+
+// MaintenanceRedisClient defines the Redis operations needed by the maintenance store.
+type MaintenanceRedisClient interface {
+	// Get returns the value of key, or an empty string if the key does not exist.
+	Get(ctx context.Context, key string) (string, error)
+	Set(ctx context.Context, key, value string) error
+}
+
+// RedisMaintenanceStore shares the runtime toggle between instances through Redis.
+type RedisMaintenanceStore struct {
+	client MaintenanceRedisClient
+	key    string
+}
+
+// NewRedisMaintenanceStore creates a Redis-based maintenance store.
+func NewRedisMaintenanceStore(client MaintenanceRedisClient, key string) *RedisMaintenanceStore {
+	if key == "" {
+		key = DefaultMaintenanceRedisKey
+	}
+	return &RedisMaintenanceStore{client: client, key: key}
+}
+
+// Load returns the stored state.
+func (s *RedisMaintenanceStore) Load(ctx context.Context) (MaintenanceState, error) {
+	raw, err := s.client.Get(ctx, s.key)
+	if err != nil {
+		return MaintenanceState{}, fmt.Errorf("failed to load maintenance state: %w", err)
+	}
+	if raw == "" {
+		return MaintenanceState{}, nil
+	}
+
+	var state MaintenanceState
+	if unmarshalErr := json.Unmarshal([]byte(raw), &state); unmarshalErr != nil {
+		return MaintenanceState{}, fmt.Errorf("failed to decode maintenance state: %w", unmarshalErr)
+	}
+	return state, nil
+}
+
+// Save stores the state.
+func (s *RedisMaintenanceStore) Save(ctx context.Context, state MaintenanceState) error {
+	raw, err := json.Marshal(state)
+	if err != nil {
+		return fmt.Errorf("failed to encode maintenance state: %w", err)
+	}
+	return s.client.Set(ctx, s.key, string(raw))
+}
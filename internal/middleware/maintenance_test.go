@@ -0,0 +1,184 @@
+package middleware_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/lllypuk/flowra/internal/domain/uuid"
+	"github.com/lllypuk/flowra/internal/middleware"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type failingMaintenanceStore struct{}
+
+func (failingMaintenanceStore) Load(_ context.Context) (middleware.MaintenanceState, error) {
+	return middleware.MaintenanceState{}, errors.New("redis down")
+}
+
+func (failingMaintenanceStore) Save(_ context.Context, _ middleware.MaintenanceState) error {
+	return errors.New("redis down")
+}
+
+type fakeMaintenanceRedis struct {
+	values map[string]string
+}
+
+func (f *fakeMaintenanceRedis) Get(_ context.Context, key string) (string, error) {
+	return f.values[key], nil
+}
+
+func (f *fakeMaintenanceRedis) Set(_ context.Context, key, value string) error {
+	f.values[key] = value
+	return nil
+}
+
+func newMaintenanceEcho(config middleware.MaintenanceConfig) *echo.Echo {
+	e := echo.New()
+	e.Use(middleware.Maintenance(config))
+	ok := func(c echo.Context) error { return c.String(http.StatusOK, "ok") }
+	e.GET("/health", ok)
+	e.GET("/static/css/custom.css", ok)
+	e.GET("/workspaces", ok)
+	e.GET("/api/v1/workspaces", ok)
+	return e
+}
+
+func serveMaintenance(e *echo.Echo, path string, prepare func(*http.Request)) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodGet, path, nil)
+	if prepare != nil {
+		prepare(req)
+	}
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestMaintenance(t *testing.T) {
+	switcher := middleware.NewMaintenanceSwitch(false, "", middleware.NewMemoryMaintenanceStore(), nil)
+	config := middleware.DefaultMaintenanceConfig()
+	config.Checker = switcher
+	config.TokenValidator = &mockTokenValidator{claims: &middleware.TokenClaims{IsSystemAdmin: true}}
+	e := newMaintenanceEcho(config)
+
+	t.Run("passes through when disabled", func(t *testing.T) {
+		rec := serveMaintenance(e, "/workspaces", nil)
+		assert.Equal(t, http.StatusOK, rec.Code)
+	})
+
+	require.NoError(t, switcher.Set(context.Background(), middleware.MaintenanceState{
+		Enabled:   true,
+		Message:   "Upgrading <db>",
+		UpdatedBy: uuid.NewUUID(),
+	}))
+
+	t.Run("browser routes get an HTML page", func(t *testing.T) {
+		rec := serveMaintenance(e, "/workspaces", nil)
+		assert.Equal(t, http.StatusServiceUnavailable, rec.Code)
+		assert.Contains(t, rec.Header().Get(echo.HeaderContentType), echo.MIMETextHTML)
+		assert.Contains(t, rec.Body.String(), "Upgrading &lt;db&gt;")
+		assert.NotEmpty(t, rec.Header().Get("Retry-After"))
+	})
+
+	t.Run("API routes get a JSON envelope", func(t *testing.T) {
+		rec := serveMaintenance(e, "/api/v1/workspaces", nil)
+		assert.Equal(t, http.StatusServiceUnavailable, rec.Code)
+		assert.Contains(t, rec.Body.String(), `"code":"MAINTENANCE"`)
+	})
+
+	t.Run("health checks and static assets stay available", func(t *testing.T) {
+		assert.Equal(t, http.StatusOK, serveMaintenance(e, "/health", nil).Code)
+		assert.Equal(t, http.StatusOK, serveMaintenance(e, "/static/css/custom.css", nil).Code)
+	})
+
+	t.Run("system admins keep access", func(t *testing.T) {
+		rec := serveMaintenance(e, "/api/v1/workspaces", func(req *http.Request) {
+			req.Header.Set(echo.HeaderAuthorization, "Bearer admin-token")
+		})
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		rec = serveMaintenance(e, "/workspaces", func(req *http.Request) {
+			req.AddCookie(&http.Cookie{Name: "flowra_session", Value: "admin-token"})
+		})
+		assert.Equal(t, http.StatusOK, rec.Code)
+	})
+
+	t.Run("other users are blocked", func(t *testing.T) {
+		userConfig := config
+		userConfig.TokenValidator = &mockTokenValidator{claims: &middleware.TokenClaims{UserID: uuid.NewUUID()}}
+		rec := serveMaintenance(newMaintenanceEcho(userConfig), "/api/v1/workspaces", func(req *http.Request) {
+			req.Header.Set(echo.HeaderAuthorization, "Bearer user-token")
+		})
+		assert.Equal(t, http.StatusServiceUnavailable, rec.Code)
+	})
+
+	t.Run("custom page renderer", func(t *testing.T) {
+		pageConfig := config
+		pageConfig.PageRenderer = func(c echo.Context, state middleware.MaintenanceState) error {
+			return c.String(http.StatusServiceUnavailable, "custom: "+state.Message)
+		}
+		rec := serveMaintenance(newMaintenanceEcho(pageConfig), "/workspaces", nil)
+		assert.Equal(t, "custom: Upgrading <db>", rec.Body.String())
+	})
+}
+
+func TestMaintenanceSwitch(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("configuration forces maintenance on", func(t *testing.T) {
+		store := middleware.NewMemoryMaintenanceStore()
+		switcher := middleware.NewMaintenanceSwitch(true, "", store, nil)
+		require.NoError(t, switcher.Set(ctx, middleware.MaintenanceState{Enabled: false}))
+
+		state := switcher.State(ctx)
+		assert.True(t, state.Enabled)
+		assert.True(t, state.FromConfig)
+		assert.Equal(t, middleware.DefaultMaintenanceMessage, state.Message)
+	})
+
+	t.Run("runtime message falls back to the configured one", func(t *testing.T) {
+		switcher := middleware.NewMaintenanceSwitch(false, "Planned upgrade", middleware.NewMemoryMaintenanceStore(), nil)
+		require.NoError(t, switcher.Set(ctx, middleware.MaintenanceState{Enabled: true}))
+
+		state := switcher.State(ctx)
+		assert.True(t, state.Enabled)
+		assert.False(t, state.FromConfig)
+		assert.Equal(t, "Planned upgrade", state.Message)
+	})
+
+	t.Run("store failures keep maintenance off", func(t *testing.T) {
+		switcher := middleware.NewMaintenanceSwitch(false, "", failingMaintenanceStore{}, nil)
+
+		assert.False(t, switcher.State(ctx).Enabled)
+		require.Error(t, switcher.Set(ctx, middleware.MaintenanceState{Enabled: true}))
+	})
+
+	t.Run("runtime toggle requires a store", func(t *testing.T) {
+		switcher := middleware.NewMaintenanceSwitch(false, "", nil, nil)
+
+		require.ErrorIs(t, switcher.Set(ctx, middleware.MaintenanceState{Enabled: true}),
+			middleware.ErrMaintenanceToggleUnavailable)
+	})
+
+	t.Run("redis store round trip", func(t *testing.T) {
+		client := &fakeMaintenanceRedis{values: map[string]string{}}
+		store := middleware.NewRedisMaintenanceStore(client, "")
+
+		state, err := store.Load(ctx)
+		require.NoError(t, err)
+		assert.False(t, state.Enabled)
+
+		adminID := uuid.NewUUID()
+		require.NoError(t, store.Save(ctx, middleware.MaintenanceState{Enabled: true, UpdatedBy: adminID}))
+		assert.Contains(t, client.values, middleware.DefaultMaintenanceRedisKey)
+
+		state, err = store.Load(ctx)
+		require.NoError(t, err)
+		assert.True(t, state.Enabled)
+		assert.Equal(t, adminID, state.UpdatedBy)
+	})
+}
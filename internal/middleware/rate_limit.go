@@ -7,6 +7,8 @@ import (
 	"log/slog"
 	"net/http"
 	"strconv"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/labstack/echo/v4"
@@ -140,19 +142,16 @@ func RateLimit(config RateLimitConfig) echo.MiddlewareFunc {
 			// Calculate limit with burst
 			totalLimit := int64(config.Limit + config.BurstSize)
 
-			// Set rate limit headers
-			remaining := max(totalLimit-count, 0)
-
-			c.Response().Header().Set("X-Ratelimit-Limit", strconv.FormatInt(totalLimit, 10))
-			c.Response().Header().Set("X-Ratelimit-Remaining", strconv.FormatInt(remaining, 10))
-
 			// Get TTL for reset header
 			ttl, err := config.Store.GetTTL(c.Request().Context(), key)
-			if err == nil && ttl > 0 {
-				resetTime := time.Now().Add(ttl).Unix()
-				c.Response().Header().Set("X-Ratelimit-Reset", strconv.FormatInt(resetTime, 10))
+			if err != nil {
+				ttl = 0
 			}
 
+			// Set rate limit headers
+			info := RateLimitInfo{Limit: totalLimit, Remaining: max(totalLimit-count, 0), Reset: ttl}
+			SetRateLimitHeaders(c, info)
+
 			// Check if rate limit exceeded
 			if count > totalLimit {
 				config.Logger.Warn("rate limit exceeded",
@@ -168,7 +167,7 @@ func RateLimit(config RateLimitConfig) echo.MiddlewareFunc {
 					return config.ExceedHandler(c, ttl)
 				}
 
-				return respondRateLimitError(c, config.Message, ttl)
+				return respondRateLimitError(c, config.Message, info)
 			}
 
 			return next(c)
@@ -193,19 +192,77 @@ func generateRateLimitKey(c echo.Context, keyFunc func(c echo.Context) string) s
 	return fmt.Sprintf("ratelimit:ip:%s", c.RealIP())
 }
 
-// respondRateLimitError sends a rate limit exceeded error response.
-func respondRateLimitError(c echo.Context, message string, retryAfter time.Duration) error {
+// Rate limit response headers. The RateLimit-* headers follow the IETF
+// "RateLimit header fields for HTTP" draft; the X-Ratelimit-* headers are kept
+// for existing clients.
+const (
+	HeaderRateLimitLimit     = "RateLimit-Limit"
+	HeaderRateLimitRemaining = "RateLimit-Remaining"
+	HeaderRateLimitReset     = "RateLimit-Reset"
+
+	headerLegacyRateLimitLimit     = "X-Ratelimit-Limit"
+	headerLegacyRateLimitRemaining = "X-Ratelimit-Remaining"
+	headerLegacyRateLimitReset     = "X-Ratelimit-Reset"
+)
+
+// RateLimitInfo describes the state of one rate limit quota for the current request.
+type RateLimitInfo struct {
+	// Limit is the number of requests allowed per window.
+	Limit int64
+
+	// Remaining is the number of requests left in the current window.
+	Remaining int64
+
+	// Reset is the time until the current window ends.
+	Reset time.Duration
+}
+
+// SetRateLimitHeaders sets the rate limit response headers.
+// When several limits apply to a request, the one with the fewest remaining
+// requests wins, so clients always see the quota they will hit first.
+func SetRateLimitHeaders(c echo.Context, info RateLimitInfo) {
+	header := c.Response().Header()
+	if current := header.Get(HeaderRateLimitRemaining); current != "" {
+		if remaining, err := strconv.ParseInt(current, 10, 64); err == nil && remaining < info.Remaining {
+			return
+		}
+	}
+
+	reset := resetSeconds(info.Reset)
+	header.Set(HeaderRateLimitLimit, strconv.FormatInt(info.Limit, 10))
+	header.Set(HeaderRateLimitRemaining, strconv.FormatInt(info.Remaining, 10))
+	header.Set(HeaderRateLimitReset, strconv.FormatInt(reset, 10))
+
+	header.Set(headerLegacyRateLimitLimit, strconv.FormatInt(info.Limit, 10))
+	header.Set(headerLegacyRateLimitRemaining, strconv.FormatInt(info.Remaining, 10))
+	if info.Reset > 0 {
+		header.Set(headerLegacyRateLimitReset, strconv.FormatInt(time.Now().Add(info.Reset).Unix(), 10))
+	} else {
+		header.Del(headerLegacyRateLimitReset)
+	}
+}
+
+// resetSeconds rounds a reset duration up to whole seconds.
+func resetSeconds(reset time.Duration) int64 {
+	if reset <= 0 {
+		return 0
+	}
+	return int64((reset + time.Second - 1) / time.Second)
+}
+
+// respondRateLimitError sends a rate limit exceeded error in the standard response envelope.
+// The error details repeat the rate limit headers for clients that only read the body.
+func respondRateLimitError(c echo.Context, message string, info RateLimitInfo) error {
+	retryAfter := resetSeconds(info.Reset)
 	if retryAfter > 0 {
-		c.Response().Header().Set("Retry-After", strconv.FormatInt(int64(retryAfter.Seconds()), 10))
+		c.Response().Header().Set("Retry-After", strconv.FormatInt(retryAfter, 10))
 	}
 
-	return c.JSON(http.StatusTooManyRequests, map[string]any{
-		"success": false,
-		"error": map[string]any{
-			"code":        "RATE_LIMIT_EXCEEDED",
-			"message":     message,
-			"retry_after": int64(retryAfter.Seconds()),
-		},
+	return respondError(c, http.StatusTooManyRequests, "RATE_LIMIT_EXCEEDED", message, map[string]any{
+		"limit":       info.Limit,
+		"remaining":   info.Remaining,
+		"reset":       retryAfter,
+		"retry_after": retryAfter,
 	})
 }
 
@@ -273,8 +330,9 @@ func RateLimitByWorkspace(config RateLimitConfig) echo.MiddlewareFunc {
 	return RateLimit(config)
 }
 
-// MemoryRateLimitStore is an in-memory rate limit store for testing.
+// MemoryRateLimitStore is an in-memory rate limit store for testing and single-instance setups.
 type MemoryRateLimitStore struct {
+	mu     sync.Mutex
 	counts map[string]*rateLimitEntry
 }
 
@@ -292,6 +350,9 @@ func NewMemoryRateLimitStore() *MemoryRateLimitStore {
 
 // Increment increments the counter for the given key.
 func (s *MemoryRateLimitStore) Increment(_ context.Context, key string, window time.Duration) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
 	entry, exists := s.counts[key]
 
 	// Check if entry exists and is still valid
@@ -311,6 +372,9 @@ func (s *MemoryRateLimitStore) Increment(_ context.Context, key string, window t
 
 // GetCount returns the current count for the given key.
 func (s *MemoryRateLimitStore) GetCount(_ context.Context, key string) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
 	entry, exists := s.counts[key]
 	if !exists || time.Now().After(entry.expiresAt) {
 		return 0, nil
@@ -320,6 +384,9 @@ func (s *MemoryRateLimitStore) GetCount(_ context.Context, key string) (int64, e
 
 // GetTTL returns the remaining TTL for the given key.
 func (s *MemoryRateLimitStore) GetTTL(_ context.Context, key string) (time.Duration, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
 	entry, exists := s.counts[key]
 	if !exists {
 		return 0, nil
@@ -335,6 +402,8 @@ func (s *MemoryRateLimitStore) GetTTL(_ context.Context, key string) (time.Durat
 
 // Reset clears all rate limit entries (for testing).
 func (s *MemoryRateLimitStore) Reset() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
 	s.counts = make(map[string]*rateLimitEntry)
 }
 
@@ -462,15 +531,43 @@ func matchPattern(pattern, key string) bool {
 	return false
 }
 
-// WorkspaceRateLimiter provides workspace-specific rate limiting.
+// WorkspaceLimitKind identifies which workspace quota was exceeded.
+type WorkspaceLimitKind string
+
+// Workspace quota kinds.
+const (
+	WorkspaceLimitAPICalls WorkspaceLimitKind = "api_calls"
+	WorkspaceLimitMessages WorkspaceLimitKind = "messages"
+)
+
+// WorkspaceLimitExceeded describes a workspace crossing one of its quotas.
+// It is reported once per window, on the first rejected request.
+type WorkspaceLimitExceeded struct {
+	WorkspaceID uuid.UUID          `json:"workspace_id"`
+	Kind        WorkspaceLimitKind `json:"kind"`
+	Limit       int                `json:"limit"`
+	Window      time.Duration      `json:"window"`
+	UserID      uuid.UUID          `json:"user_id,omitempty"`
+	Method      string             `json:"method"`
+	Path        string             `json:"path"`
+	OccurredAt  time.Time          `json:"occurred_at"`
+}
+
+// WorkspaceRateLimiter provides workspace-specific fair-usage limits.
+// Every workspace-scoped request counts toward the API call quota; sending
+// a chat message additionally counts toward the message quota. A limit of
+// zero or less disables the quota.
 type WorkspaceRateLimiter struct {
-	store     RateLimitStore
-	limits    map[uuid.UUID]int
-	defLimit  int
-	defWindow time.Duration
+	store        RateLimitStore
+	limits       map[uuid.UUID]int
+	defLimit     int
+	defWindow    time.Duration
+	messageLimit int
+	onExceeded   func(ctx context.Context, exceeded WorkspaceLimitExceeded)
 }
 
 // NewWorkspaceRateLimiter creates a new workspace-aware rate limiter.
+// defaultLimit is the API call quota per defaultWindow.
 func NewWorkspaceRateLimiter(
 	store RateLimitStore,
 	defaultLimit int,
@@ -484,12 +581,23 @@ func NewWorkspaceRateLimiter(
 	}
 }
 
-// SetWorkspaceLimit sets a custom limit for a specific workspace.
+// SetWorkspaceLimit sets a custom API call limit for a specific workspace.
 func (w *WorkspaceRateLimiter) SetWorkspaceLimit(workspaceID uuid.UUID, limit int) {
 	w.limits[workspaceID] = limit
 }
 
-// GetLimit returns the rate limit for a workspace.
+// SetMessageLimit sets the number of messages a workspace may send per window.
+func (w *WorkspaceRateLimiter) SetMessageLimit(limit int) {
+	w.messageLimit = limit
+}
+
+// OnLimitExceeded registers a callback invoked when a workspace first exceeds
+// a quota within a window. The callback runs synchronously and must be fast.
+func (w *WorkspaceRateLimiter) OnLimitExceeded(fn func(ctx context.Context, exceeded WorkspaceLimitExceeded)) {
+	w.onExceeded = fn
+}
+
+// GetLimit returns the API call limit for a workspace.
 func (w *WorkspaceRateLimiter) GetLimit(workspaceID uuid.UUID) int {
 	if limit, ok := w.limits[workspaceID]; ok {
 		return limit
@@ -497,7 +605,13 @@ func (w *WorkspaceRateLimiter) GetLimit(workspaceID uuid.UUID) int {
 	return w.defLimit
 }
 
+// MessageLimit returns the message limit shared by all workspaces.
+func (w *WorkspaceRateLimiter) MessageLimit() int {
+	return w.messageLimit
+}
+
 // Middleware returns the rate limiting middleware for workspaces.
+// Store failures allow the request.
 func (w *WorkspaceRateLimiter) Middleware() echo.MiddlewareFunc {
 	return func(next echo.HandlerFunc) echo.HandlerFunc {
 		return func(c echo.Context) error {
@@ -508,25 +622,75 @@ func (w *WorkspaceRateLimiter) Middleware() echo.MiddlewareFunc {
 
 			limit := w.GetLimit(workspaceID)
 			key := fmt.Sprintf("workspace:%s", workspaceID.String())
-
-			count, err := w.store.Increment(c.Request().Context(), key, w.defWindow)
-			if err != nil {
-				// On error, allow the request
-				return next(c)
+			if exceeded, err := w.check(c, workspaceID, WorkspaceLimitAPICalls, key, limit); exceeded {
+				return err
 			}
 
-			// Set headers
-			remaining := max(int64(limit)-count, 0)
-
-			c.Response().Header().Set("X-Ratelimit-Limit", strconv.Itoa(limit))
-			c.Response().Header().Set("X-Ratelimit-Remaining", strconv.FormatInt(remaining, 10))
-
-			if count > int64(limit) {
-				ttl, _ := w.store.GetTTL(c.Request().Context(), key)
-				return respondRateLimitError(c, "Workspace rate limit exceeded", ttl)
+			if w.messageLimit > 0 && isMessageSend(c) {
+				messageKey := key + ":messages"
+				exceeded, err := w.check(c, workspaceID, WorkspaceLimitMessages, messageKey, w.messageLimit)
+				if exceeded {
+					return err
+				}
 			}
 
 			return next(c)
 		}
 	}
 }
+
+// check counts the request against one quota. It reports whether the request
+// was rejected, in which case the returned error is the response result.
+func (w *WorkspaceRateLimiter) check(
+	c echo.Context,
+	workspaceID uuid.UUID,
+	kind WorkspaceLimitKind,
+	key string,
+	limit int,
+) (bool, error) {
+	if limit <= 0 {
+		return false, nil
+	}
+
+	ctx := c.Request().Context()
+	count, err := w.store.Increment(ctx, key, w.defWindow)
+	if err != nil {
+		// On error, allow the request
+		return false, nil
+	}
+
+	ttl, err := w.store.GetTTL(ctx, key)
+	if err != nil {
+		ttl = 0
+	}
+	info := RateLimitInfo{Limit: int64(limit), Remaining: max(int64(limit)-count, 0), Reset: ttl}
+	SetRateLimitHeaders(c, info)
+
+	if count <= int64(limit) {
+		return false, nil
+	}
+
+	if count == int64(limit)+1 && w.onExceeded != nil {
+		w.onExceeded(ctx, WorkspaceLimitExceeded{
+			WorkspaceID: workspaceID,
+			Kind:        kind,
+			Limit:       limit,
+			Window:      w.defWindow,
+			UserID:      GetUserID(c),
+			Method:      c.Request().Method,
+			Path:        c.Path(),
+			OccurredAt:  time.Now(),
+		})
+	}
+
+	message := "Workspace rate limit exceeded"
+	if kind == WorkspaceLimitMessages {
+		message = "Workspace message rate limit exceeded"
+	}
+	return true, respondRateLimitError(c, message, info)
+}
+
+// isMessageSend reports whether the request posts a chat message.
+func isMessageSend(c echo.Context) bool {
+	return c.Request().Method == http.MethodPost && strings.HasSuffix(c.Path(), "/chats/:chat_id/messages")
+}
@@ -0,0 +1,114 @@
+package middleware
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+)
+
+// Workspace limit event log defaults.
+const (
+	DefaultWorkspaceLimitLogKey  = "flowra:ratelimit:workspace_events"
+	DefaultWorkspaceLimitLogSize = 100
+)
+
+// MemoryWorkspaceLimitLog keeps the latest limit-exceeded events in memory (single instance, tests).
+type MemoryWorkspaceLimitLog struct {
+	mu     sync.Mutex
+	size   int
+	events []WorkspaceLimitExceeded
+}
+
+// NewMemoryWorkspaceLimitLog creates an in-memory log holding up to size events.
+func NewMemoryWorkspaceLimitLog(size int) *MemoryWorkspaceLimitLog {
+	if size <= 0 {
+		size = DefaultWorkspaceLimitLogSize
+	}
+	return &MemoryWorkspaceLimitLog{size: size}
+}
+
+// Record stores an event, evicting the oldest one when the log is full.
+func (l *MemoryWorkspaceLimitLog) Record(_ context.Context, exceeded WorkspaceLimitExceeded) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.events = append([]WorkspaceLimitExceeded{exceeded}, l.events...)
+	if len(l.events) > l.size {
+		l.events = l.events[:l.size]
+	}
+	return nil
+}
+
+// Recent returns up to limit events, newest first.
+func (l *MemoryWorkspaceLimitLog) Recent(_ context.Context, limit int) ([]WorkspaceLimitExceeded, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	n := len(l.events)
+	if limit > 0 && limit < n {
+		n = limit
+	}
+	return append([]WorkspaceLimitExceeded(nil), l.events[:n]...), nil
+}
+
+// WorkspaceLimitRedisClient defines the Redis list operations needed by the workspace limit log.
+type WorkspaceLimitRedisClient interface {
+	// PushCapped prepends value to the list at key and trims it to maxLen entries.
+	PushCapped(ctx context.Context, key, value string, maxLen int64) error
+
+	// Range returns the first count entries of the list at key.
+	Range(ctx context.Context, key string, count int64) ([]string, error)
+}
+
+// RedisWorkspaceLimitLog shares the latest limit-exceeded events between instances through Redis.
+type RedisWorkspaceLimitLog struct {
+	client WorkspaceLimitRedisClient
+	key    string
+	size   int64
+}
+
+// NewRedisWorkspaceLimitLog creates a Redis-based workspace limit log.
+func NewRedisWorkspaceLimitLog(client WorkspaceLimitRedisClient, key string, size int) *RedisWorkspaceLimitLog {
+	if key == "" {
+		key = DefaultWorkspaceLimitLogKey
+	}
+	if size <= 0 {
+		size = DefaultWorkspaceLimitLogSize
+	}
+	return &RedisWorkspaceLimitLog{client: client, key: key, size: int64(size)}
+}
+
+// Record stores an event.
+func (l *RedisWorkspaceLimitLog) Record(ctx context.Context, exceeded WorkspaceLimitExceeded) error {
+	raw, err := json.Marshal(exceeded)
+	if err != nil {
+		return fmt.Errorf("failed to encode workspace limit event: %w", err)
+	}
+	if pushErr := l.client.PushCapped(ctx, l.key, string(raw), l.size); pushErr != nil {
+		return fmt.Errorf("failed to record workspace limit event: %w", pushErr)
+	}
+	return nil
+}
+
+// Recent returns up to limit events, newest first. Undecodable entries are skipped.
+func (l *RedisWorkspaceLimitLog) Recent(ctx context.Context, limit int) ([]WorkspaceLimitExceeded, error) {
+	count := l.size
+	if limit > 0 && int64(limit) < count {
+		count = int64(limit)
+	}
+
+	entries, err := l.client.Range(ctx, l.key, count)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load workspace limit events: %w", err)
+	}
+
+	events := make([]WorkspaceLimitExceeded, 0, len(entries))
+	for _, raw := range entries {
+		var exceeded WorkspaceLimitExceeded
+		if json.Unmarshal([]byte(raw), &exceeded) == nil {
+			events = append(events, exceeded)
+		}
+	}
+	return events, nil
+}
@@ -0,0 +1,90 @@
+package middleware_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/lllypuk/flowra/internal/domain/uuid"
+	"github.com/lllypuk/flowra/internal/middleware"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeWorkspaceLimitRedis struct {
+	lists map[string][]string
+}
+
+func (f *fakeWorkspaceLimitRedis) PushCapped(_ context.Context, key, value string, maxLen int64) error {
+	list := append([]string{value}, f.lists[key]...)
+	if int64(len(list)) > maxLen {
+		list = list[:maxLen]
+	}
+	f.lists[key] = list
+	return nil
+}
+
+func (f *fakeWorkspaceLimitRedis) Range(_ context.Context, key string, count int64) ([]string, error) {
+	list := f.lists[key]
+	if int64(len(list)) > count {
+		list = list[:count]
+	}
+	return list, nil
+}
+
+func newLimitEvent(kind middleware.WorkspaceLimitKind) middleware.WorkspaceLimitExceeded {
+	return middleware.WorkspaceLimitExceeded{
+		WorkspaceID: uuid.NewUUID(),
+		Kind:        kind,
+		Limit:       10,
+		Window:      time.Minute,
+		Method:      "POST",
+		Path:        "/api/v1/workspaces/:workspace_id/chats/:chat_id/messages",
+		OccurredAt:  time.Now().UTC().Truncate(time.Second),
+	}
+}
+
+func TestMemoryWorkspaceLimitLog(t *testing.T) {
+	ctx := context.Background()
+	log := middleware.NewMemoryWorkspaceLimitLog(2)
+
+	first := newLimitEvent(middleware.WorkspaceLimitAPICalls)
+	second := newLimitEvent(middleware.WorkspaceLimitMessages)
+	third := newLimitEvent(middleware.WorkspaceLimitMessages)
+	require.NoError(t, log.Record(ctx, first))
+	require.NoError(t, log.Record(ctx, second))
+	require.NoError(t, log.Record(ctx, third))
+
+	events, err := log.Recent(ctx, 10)
+	require.NoError(t, err)
+	require.Len(t, events, 2)
+	assert.Equal(t, third.WorkspaceID, events[0].WorkspaceID)
+	assert.Equal(t, second.WorkspaceID, events[1].WorkspaceID)
+
+	events, err = log.Recent(ctx, 1)
+	require.NoError(t, err)
+	assert.Len(t, events, 1)
+}
+
+func TestRedisWorkspaceLimitLog(t *testing.T) {
+	ctx := context.Background()
+	client := &fakeWorkspaceLimitRedis{lists: map[string][]string{}}
+	log := middleware.NewRedisWorkspaceLimitLog(client, "", 2)
+
+	for range 3 {
+		require.NoError(t, log.Record(ctx, newLimitEvent(middleware.WorkspaceLimitAPICalls)))
+	}
+	last := newLimitEvent(middleware.WorkspaceLimitMessages)
+	require.NoError(t, log.Record(ctx, last))
+	assert.Len(t, client.lists[middleware.DefaultWorkspaceLimitLogKey], 2)
+
+	events, err := log.Recent(ctx, 0)
+	require.NoError(t, err)
+	require.Len(t, events, 2)
+	assert.Equal(t, last, events[0])
+
+	client.lists[middleware.DefaultWorkspaceLimitLogKey][1] = "not json"
+	events, err = log.Recent(ctx, 0)
+	require.NoError(t, err)
+	assert.Len(t, events, 1)
+}
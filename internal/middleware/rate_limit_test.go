@@ -2,6 +2,7 @@ package middleware_test
 
 import (
 	"context"
+	"encoding/json"
 	"net/http"
 	"net/http/httptest"
 	"strconv"
@@ -107,6 +108,37 @@ func TestRateLimit_ExceedsLimit(t *testing.T) {
 	assert.Contains(t, rec.Body.String(), "RATE_LIMIT_EXCEEDED")
 }
 
+func TestRateLimit_ProblemJSON(t *testing.T) {
+	e := echo.New()
+	e.Use(middleware.RateLimit(middleware.RateLimitConfig{
+		Store:  middleware.NewMemoryRateLimitStore(),
+		Limit:  1,
+		Window: time.Minute,
+	}))
+	e.GET("/test", func(c echo.Context) error {
+		return c.String(http.StatusOK, "ok")
+	})
+
+	var rec *httptest.ResponseRecorder
+	for range 2 {
+		req := httptest.NewRequest(http.MethodGet, "/test", nil)
+		req.Header.Set(echo.HeaderAccept, "application/problem+json")
+		rec = httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+	}
+
+	assert.Equal(t, http.StatusTooManyRequests, rec.Code)
+	assert.Equal(t, "application/problem+json", rec.Header().Get(echo.HeaderContentType))
+
+	var body map[string]any
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+	assert.Equal(t, "https://flowra.dev/problems/rate-limited", body["type"])
+	assert.Equal(t, "RATE_LIMIT_EXCEEDED", body["code"])
+	assert.Equal(t, "/test", body["instance"])
+	assert.InDelta(t, 1, body["limit"], 0)
+	assert.Contains(t, body, "retry_after")
+}
+
 func TestRateLimit_WithBurst(t *testing.T) {
 	e := echo.New()
 
@@ -171,6 +203,66 @@ func TestRateLimit_Headers(t *testing.T) {
 
 	resetHeader := rec.Header().Get("X-Ratelimit-Reset")
 	assert.NotEmpty(t, resetHeader)
+
+	// Standard RateLimit headers
+	assert.Equal(t, "15", rec.Header().Get(middleware.HeaderRateLimitLimit))
+	assert.Equal(t, "14", rec.Header().Get(middleware.HeaderRateLimitRemaining))
+	reset, err := strconv.Atoi(rec.Header().Get(middleware.HeaderRateLimitReset))
+	require.NoError(t, err)
+	assert.Positive(t, reset)
+	assert.LessOrEqual(t, reset, 60)
+}
+
+func TestRateLimit_ExceededBody(t *testing.T) {
+	e := echo.New()
+
+	store := middleware.NewMemoryRateLimitStore()
+	e.Use(middleware.RateLimit(middleware.RateLimitConfig{Store: store, Limit: 1, Window: time.Minute}))
+	e.GET("/test", func(c echo.Context) error {
+		return c.String(http.StatusOK, "ok")
+	})
+
+	for range 2 {
+		e.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/test", nil))
+	}
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/test", nil))
+	require.Equal(t, http.StatusTooManyRequests, rec.Code)
+	assert.Equal(t, "0", rec.Header().Get(middleware.HeaderRateLimitRemaining))
+
+	var body struct {
+		Success bool `json:"success"`
+		Error   struct {
+			Code    string           `json:"code"`
+			Message string           `json:"message"`
+			Details map[string]int64 `json:"details"`
+		} `json:"error"`
+	}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+	assert.False(t, body.Success)
+	assert.Equal(t, "RATE_LIMIT_EXCEEDED", body.Error.Code)
+	assert.Equal(t, int64(1), body.Error.Details["limit"])
+	assert.Equal(t, int64(0), body.Error.Details["remaining"])
+	assert.Positive(t, body.Error.Details["retry_after"])
+	assert.Equal(t, body.Error.Details["retry_after"], body.Error.Details["reset"])
+	assert.Equal(t, strconv.FormatInt(body.Error.Details["retry_after"], 10), rec.Header().Get("Retry-After"))
+}
+
+func TestSetRateLimitHeaders_MostRestrictiveWins(t *testing.T) {
+	e := echo.New()
+	rec := httptest.NewRecorder()
+	c := e.NewContext(httptest.NewRequest(http.MethodGet, "/test", nil), rec)
+
+	middleware.SetRateLimitHeaders(c, middleware.RateLimitInfo{Limit: 100, Remaining: 40, Reset: 30 * time.Second})
+	middleware.SetRateLimitHeaders(c, middleware.RateLimitInfo{Limit: 1000, Remaining: 900, Reset: time.Minute})
+	assert.Equal(t, "100", rec.Header().Get(middleware.HeaderRateLimitLimit))
+	assert.Equal(t, "40", rec.Header().Get(middleware.HeaderRateLimitRemaining))
+	assert.Equal(t, "30", rec.Header().Get(middleware.HeaderRateLimitReset))
+
+	middleware.SetRateLimitHeaders(c, middleware.RateLimitInfo{Limit: 10, Remaining: 2, Reset: 1500 * time.Millisecond})
+	assert.Equal(t, "10", rec.Header().Get(middleware.HeaderRateLimitLimit))
+	assert.Equal(t, "2", rec.Header().Get(middleware.HeaderRateLimitRemaining))
+	assert.Equal(t, "2", rec.Header().Get(middleware.HeaderRateLimitReset))
 }
 
 func TestRateLimit_RemainingDecrements(t *testing.T) {
@@ -798,3 +890,115 @@ func TestWorkspaceRateLimiter_NoWorkspaceID(t *testing.T) {
 		assert.Equal(t, http.StatusOK, rec.Code)
 	}
 }
+
+func TestWorkspaceRateLimiter_MessageLimit(t *testing.T) {
+	e := echo.New()
+
+	store := middleware.NewMemoryRateLimitStore()
+	limiter := middleware.NewWorkspaceRateLimiter(store, 10, time.Minute)
+	limiter.SetMessageLimit(2)
+
+	var events []middleware.WorkspaceLimitExceeded
+	limiter.OnLimitExceeded(func(_ context.Context, exceeded middleware.WorkspaceLimitExceeded) {
+		events = append(events, exceeded)
+	})
+
+	workspaceID := uuid.NewUUID()
+	e.Use(func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			c.Set(string(middleware.ContextKeyWorkspaceID), workspaceID)
+			return next(c)
+		}
+	})
+	e.Use(limiter.Middleware())
+	ok := func(c echo.Context) error { return c.String(http.StatusOK, "ok") }
+	e.POST("/workspaces/:workspace_id/chats/:chat_id/messages", ok)
+	e.GET("/workspaces/:workspace_id/chats/:chat_id/messages", ok)
+
+	send := func() *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/workspaces/w/chats/c/messages", nil)
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+		return rec
+	}
+
+	assert.Equal(t, http.StatusOK, send().Code)
+	assert.Equal(t, http.StatusOK, send().Code)
+
+	rec := send()
+	assert.Equal(t, http.StatusTooManyRequests, rec.Code)
+	assert.Contains(t, rec.Body.String(), "Workspace message rate limit exceeded")
+	assert.Equal(t, "2", rec.Header().Get(middleware.HeaderRateLimitLimit), "the message quota is the tighter one")
+	assert.Equal(t, http.StatusTooManyRequests, send().Code)
+
+	// Reading messages only counts toward the API call quota
+	req := httptest.NewRequest(http.MethodGet, "/workspaces/w/chats/c/messages", nil)
+	rec = httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	// Reported once per window
+	require.Len(t, events, 1)
+	assert.Equal(t, workspaceID, events[0].WorkspaceID)
+	assert.Equal(t, middleware.WorkspaceLimitMessages, events[0].Kind)
+	assert.Equal(t, 2, events[0].Limit)
+	assert.Equal(t, "/workspaces/:workspace_id/chats/:chat_id/messages", events[0].Path)
+}
+
+func TestWorkspaceRateLimiter_APICallLimitExceededEvent(t *testing.T) {
+	e := echo.New()
+
+	store := middleware.NewMemoryRateLimitStore()
+	limiter := middleware.NewWorkspaceRateLimiter(store, 1, time.Minute)
+
+	var events []middleware.WorkspaceLimitExceeded
+	limiter.OnLimitExceeded(func(_ context.Context, exceeded middleware.WorkspaceLimitExceeded) {
+		events = append(events, exceeded)
+	})
+
+	workspaceID := uuid.NewUUID()
+	e.Use(func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			c.Set(string(middleware.ContextKeyWorkspaceID), workspaceID)
+			return next(c)
+		}
+	})
+	e.Use(limiter.Middleware())
+	e.GET("/test", func(c echo.Context) error {
+		return c.String(http.StatusOK, "ok")
+	})
+
+	for range 3 {
+		e.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/test", nil))
+	}
+
+	require.Len(t, events, 1)
+	assert.Equal(t, middleware.WorkspaceLimitAPICalls, events[0].Kind)
+	assert.Equal(t, time.Minute, events[0].Window)
+}
+
+func TestWorkspaceRateLimiter_DisabledLimits(t *testing.T) {
+	e := echo.New()
+
+	store := middleware.NewMemoryRateLimitStore()
+	limiter := middleware.NewWorkspaceRateLimiter(store, 0, time.Minute)
+
+	workspaceID := uuid.NewUUID()
+	e.Use(func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			c.Set(string(middleware.ContextKeyWorkspaceID), workspaceID)
+			return next(c)
+		}
+	})
+	e.Use(limiter.Middleware())
+	e.POST("/workspaces/:workspace_id/chats/:chat_id/messages", func(c echo.Context) error {
+		return c.String(http.StatusOK, "ok")
+	})
+
+	for range 5 {
+		req := httptest.NewRequest(http.MethodPost, "/workspaces/w/chats/c/messages", nil)
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+		assert.Equal(t, http.StatusOK, rec.Code)
+	}
+}
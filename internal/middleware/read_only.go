@@ -0,0 +1,80 @@
+package middleware
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+)
+
+// DefaultReadOnlyMessage is returned with rejected requests when no message is configured.
+const DefaultReadOnlyMessage = "This Flowra region is read-only. Changes can only be made in the primary region."
+
+// ReadOnlyHeader marks responses rejected by read-only mode, so the frontend can show the message.
+const ReadOnlyHeader = "X-Flowra-Read-Only"
+
+// ReadOnlyConfig holds configuration for the read-only middleware.
+type ReadOnlyConfig struct {
+	// Logger is the structured logger for rejected requests.
+	Logger *slog.Logger
+
+	// Message is returned with rejected requests. Empty uses DefaultReadOnlyMessage.
+	Message string
+
+	// APIPrefix identifies API routes, which get a JSON envelope instead of plain text.
+	APIPrefix string
+
+	// AllowPaths accept mutating requests anyway. Each entry matches the path
+	// itself and everything below it.
+	AllowPaths []string
+}
+
+// DefaultReadOnlyConfig returns a ReadOnlyConfig that keeps the sign-in flow working.
+// Sessions live in Redis, so signing in and out does not write to MongoDB.
+func DefaultReadOnlyConfig() ReadOnlyConfig {
+	return ReadOnlyConfig{
+		Logger:    slog.Default(),
+		Message:   DefaultReadOnlyMessage,
+		APIPrefix: "/api/v1",
+		AllowPaths: []string{
+			"/auth",
+			"/logout",
+			"/api/v1/auth",
+		},
+	}
+}
+
+// ReadOnly returns a middleware that answers every mutating request with 503.
+// GET, HEAD and OPTIONS requests and allowed paths pass through.
+func ReadOnly(config ReadOnlyConfig) echo.MiddlewareFunc {
+	if config.Logger == nil {
+		config.Logger = slog.Default()
+	}
+	if config.Message == "" {
+		config.Message = DefaultReadOnlyMessage
+	}
+
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			req := c.Request()
+			switch req.Method {
+			case http.MethodGet, http.MethodHead, http.MethodOptions:
+				return next(c)
+			}
+			if isMaintenanceAllowedPath(req.URL.Path, config.AllowPaths) {
+				return next(c)
+			}
+
+			config.Logger.DebugContext(req.Context(), "mutating request rejected in read-only mode",
+				slog.String("method", req.Method),
+				slog.String("path", req.URL.Path),
+			)
+
+			c.Response().Header().Set(ReadOnlyHeader, "true")
+			if isAPIRequest(c, config.APIPrefix) {
+				return respondError(c, http.StatusServiceUnavailable, "READ_ONLY", config.Message, nil)
+			}
+			return c.String(http.StatusServiceUnavailable, config.Message)
+		}
+	}
+}
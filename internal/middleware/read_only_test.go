@@ -0,0 +1,51 @@
+package middleware_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/lllypuk/flowra/internal/middleware"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReadOnly(t *testing.T) {
+	e := echo.New()
+	e.Use(middleware.ReadOnly(middleware.DefaultReadOnlyConfig()))
+	ok := func(c echo.Context) error { return c.String(http.StatusOK, "ok") }
+	e.GET("/api/v1/workspaces", ok)
+	e.POST("/api/v1/workspaces", ok)
+	e.POST("/api/v1/auth/logout", ok)
+	e.DELETE("/workspaces/:id", ok)
+
+	serve := func(method, path string) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, httptest.NewRequest(method, path, nil))
+		return rec
+	}
+
+	t.Run("reads pass through", func(t *testing.T) {
+		rec := serve(http.MethodGet, "/api/v1/workspaces")
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Empty(t, rec.Header().Get(middleware.ReadOnlyHeader))
+	})
+
+	t.Run("API writes get a JSON error", func(t *testing.T) {
+		rec := serve(http.MethodPost, "/api/v1/workspaces")
+		assert.Equal(t, http.StatusServiceUnavailable, rec.Code)
+		assert.Equal(t, "true", rec.Header().Get(middleware.ReadOnlyHeader))
+		assert.Contains(t, rec.Body.String(), `"code":"READ_ONLY"`)
+	})
+
+	t.Run("browser writes get the message", func(t *testing.T) {
+		rec := serve(http.MethodDelete, "/workspaces/1")
+		assert.Equal(t, http.StatusServiceUnavailable, rec.Code)
+		assert.Equal(t, middleware.DefaultReadOnlyMessage, rec.Body.String())
+	})
+
+	t.Run("sign-in flow stays available", func(t *testing.T) {
+		rec := serve(http.MethodPost, "/api/v1/auth/logout")
+		assert.Equal(t, http.StatusOK, rec.Code)
+	})
+}
@@ -1,12 +1,14 @@
 package middleware
 
 import (
+	"context"
 	"fmt"
 	"log/slog"
 	"net/http"
 	"runtime"
 
 	"github.com/labstack/echo/v4"
+	"github.com/lllypuk/flowra/internal/infrastructure/errorreport"
 )
 
 // Recovery configuration constants.
@@ -15,6 +17,12 @@ const (
 	DefaultStackSize = 4 << 10
 )
 
+// PanicReporter sends recovered panics to an external error tracker.
+// Declared on the consumer side per project guidelines.
+type PanicReporter interface {
+	Report(ctx context.Context, evt errorreport.Event)
+}
+
 // RecoveryConfig holds configuration for the recovery middleware.
 type RecoveryConfig struct {
 	// Logger is the structured logger to use for panic logging.
@@ -30,6 +38,10 @@ type RecoveryConfig struct {
 
 	// DisablePrintStack disables printing the stack trace to the logger.
 	DisablePrintStack bool
+
+	// Reporter receives every recovered panic with its stack, request, user and
+	// workspace. Nil disables external reporting.
+	Reporter PanicReporter
 }
 
 // DefaultRecoveryConfig returns a RecoveryConfig with sensible defaults.
@@ -99,15 +111,14 @@ func RecoveryWithConfig(config RecoveryConfig) echo.MiddlewareFunc {
 
 					config.Logger.Error("panic recovered", logAttrs...)
 
+					if config.Reporter != nil {
+						config.Reporter.Report(req.Context(), panicEvent(c, err, stack, requestID))
+					}
+
 					// Send error response
 					if !c.Response().Committed {
-						_ = c.JSON(http.StatusInternalServerError, map[string]any{
-							"success": false,
-							"error": map[string]string{
-								"code":    "INTERNAL_ERROR",
-								"message": "An internal error occurred",
-							},
-						})
+						_ = respondError(c, http.StatusInternalServerError,
+							"INTERNAL_ERROR", "An internal error occurred", nil)
 					}
 				}
 			}()
@@ -116,3 +127,24 @@ func RecoveryWithConfig(config RecoveryConfig) echo.MiddlewareFunc {
 		}
 	}
 }
+
+// panicEvent describes a panic recovered while serving c.
+func panicEvent(c echo.Context, err error, stack []byte, requestID string) errorreport.Event {
+	evt := errorreport.Event{
+		Source:    errorreport.SourceHTTP,
+		Panic:     err,
+		Stack:     stack,
+		RequestID: requestID,
+		Tags: map[string]string{
+			"method": c.Request().Method,
+			"route":  c.Path(),
+		},
+	}
+	if userID := GetUserID(c); !userID.IsZero() {
+		evt.UserID = userID.String()
+	}
+	if workspaceID := GetWorkspaceID(c); !workspaceID.IsZero() {
+		evt.WorkspaceID = workspaceID.String()
+	}
+	return evt
+}
@@ -2,6 +2,7 @@ package middleware_test
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"log/slog"
 	"net/http"
@@ -9,6 +10,8 @@ import (
 	"testing"
 
 	"github.com/labstack/echo/v4"
+	"github.com/lllypuk/flowra/internal/domain/uuid"
+	"github.com/lllypuk/flowra/internal/infrastructure/errorreport"
 	"github.com/lllypuk/flowra/internal/middleware"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -407,3 +410,46 @@ func TestRecoveryWithNilPanic(t *testing.T) {
 	// Should handle gracefully
 	assert.Equal(t, http.StatusInternalServerError, rec.Code)
 }
+
+type recordingReporter struct {
+	events []errorreport.Event
+}
+
+func (r *recordingReporter) Report(_ context.Context, evt errorreport.Event) {
+	r.events = append(r.events, evt)
+}
+
+func TestRecoveryReportsPanic(t *testing.T) {
+	reporter := &recordingReporter{}
+	userID := uuid.NewUUID()
+	workspaceID := uuid.NewUUID()
+
+	config := middleware.DefaultRecoveryConfig()
+	config.Logger = slog.New(slog.DiscardHandler)
+	config.Reporter = reporter
+
+	e := echo.New()
+	e.Use(middleware.RecoveryWithConfig(config))
+	e.GET("/workspaces/:workspace_id/panic", func(c echo.Context) error {
+		c.Set(string(middleware.ContextKeyUserID), userID)
+		c.Set(string(middleware.ContextKeyWorkspaceID), workspaceID)
+		panic("reported panic")
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/workspaces/"+workspaceID.String()+"/panic", nil)
+	req.Header.Set(echo.HeaderXRequestID, "req-42")
+	rec := httptest.NewRecorder()
+
+	e.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusInternalServerError, rec.Code)
+	require.Len(t, reporter.events, 1)
+	evt := reporter.events[0]
+	assert.Equal(t, errorreport.SourceHTTP, evt.Source)
+	assert.Equal(t, "reported panic", evt.Message())
+	assert.Equal(t, "req-42", evt.RequestID)
+	assert.Equal(t, userID.String(), evt.UserID)
+	assert.Equal(t, workspaceID.String(), evt.WorkspaceID)
+	assert.Equal(t, "/workspaces/:workspace_id/panic", evt.Tags["route"])
+	assert.NotEmpty(t, errorreport.ParseStack(evt.Stack))
+}
@@ -0,0 +1,29 @@
+package middleware
+
+import (
+	"github.com/labstack/echo/v4"
+
+	"github.com/lllypuk/flowra/internal/pkg/problem"
+)
+
+// respondError sends an error in the standard response envelope, or as RFC 7807
+// problem details to clients that accept application/problem+json. details are
+// nested in the envelope error and become top-level problem members.
+func respondError(c echo.Context, status int, code, message string, details map[string]any) error {
+	c.Response().Header().Add(echo.HeaderVary, echo.HeaderAccept)
+	if problem.Wanted(c) {
+		return problem.Respond(c, status, code, message, details)
+	}
+
+	apiErr := map[string]any{
+		"code":    code,
+		"message": message,
+	}
+	if details != nil {
+		apiErr["details"] = details
+	}
+	return c.JSON(status, map[string]any{
+		"success": false,
+		"error":   apiErr,
+	})
+}
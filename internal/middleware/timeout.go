@@ -0,0 +1,148 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"path"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+// DefaultTimeoutMessage is returned with requests that ran out of time.
+const DefaultTimeoutMessage = "The request took too long to complete. Please try again."
+
+// timeoutWriteGrace keeps the connection writable after the deadline of a route
+// with extended connection deadlines, so the 504 response can still be sent.
+const timeoutWriteGrace = 5 * time.Second
+
+// TimeoutRecorder records requests that exceeded their deadline.
+// Declared on the consumer side per project guidelines.
+type TimeoutRecorder interface {
+	RecordRequestTimeout(method, route string)
+}
+
+// TimeoutRule sets the deadline of the routes it matches.
+type TimeoutRule struct {
+	// Method restricts the rule to one HTTP method. Empty matches every method.
+	Method string
+
+	// Route is matched against the registered route pattern with path.Match, so
+	// "*" stands for one segment: "/api/v1/workspaces/*/export" matches
+	// "/api/v1/workspaces/:workspace_id/export".
+	Route string
+
+	// Timeout is the deadline of matched requests. Zero sets no deadline.
+	Timeout time.Duration
+
+	// ExtendConnDeadlines moves the read and write deadlines of the connection to
+	// the request deadline, for uploads and downloads that outlast the server's
+	// read and write timeouts.
+	ExtendConnDeadlines bool
+}
+
+// TimeoutConfig holds configuration for the timeout middleware.
+type TimeoutConfig struct {
+	// Logger is the structured logger for timed out requests.
+	Logger *slog.Logger
+
+	// Recorder, if set, counts timed out requests.
+	Recorder TimeoutRecorder
+
+	// Read is the deadline of GET, HEAD and OPTIONS requests. Zero sets no deadline.
+	Read time.Duration
+
+	// Write is the deadline of all other requests. Zero sets no deadline.
+	Write time.Duration
+
+	// Rules override Read and Write for specific routes. The first match wins.
+	Rules []TimeoutRule
+
+	// APIPrefix identifies API routes, which get a JSON error instead of plain text.
+	APIPrefix string
+}
+
+// Timeout returns a middleware that bounds every request with a route-specific
+// deadline on its context. Repositories and outbound clients that honor the
+// context give up once it expires; a request that ran out of time without
+// writing a response gets 504.
+func Timeout(config TimeoutConfig) echo.MiddlewareFunc {
+	if config.Logger == nil {
+		config.Logger = slog.Default()
+	}
+
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			rule := config.ruleFor(c)
+			if rule.Timeout <= 0 {
+				return next(c)
+			}
+
+			req := c.Request()
+			ctx, cancel := context.WithTimeout(req.Context(), rule.Timeout)
+			defer cancel()
+			c.SetRequest(req.WithContext(ctx))
+
+			if rule.ExtendConnDeadlines {
+				extendConnDeadlines(c, rule.Timeout)
+			}
+
+			err := next(c)
+			if !errors.Is(ctx.Err(), context.DeadlineExceeded) {
+				return err
+			}
+
+			config.Logger.WarnContext(ctx, "request deadline exceeded",
+				slog.String("method", req.Method),
+				slog.String("route", c.Path()),
+				slog.Duration("timeout", rule.Timeout),
+				slog.Bool("committed", c.Response().Committed),
+			)
+			if config.Recorder != nil {
+				config.Recorder.RecordRequestTimeout(req.Method, c.Path())
+			}
+
+			// a handler that already answered, e.g. with a mapped error, keeps its response
+			if c.Response().Committed {
+				return err
+			}
+			if isAPIRequest(c, config.APIPrefix) {
+				return respondError(c, http.StatusGatewayTimeout, "REQUEST_TIMEOUT", DefaultTimeoutMessage, nil)
+			}
+			return c.String(http.StatusGatewayTimeout, DefaultTimeoutMessage)
+		}
+	}
+}
+
+// ruleFor returns the rule of the matched route, or the method default.
+func (config TimeoutConfig) ruleFor(c echo.Context) TimeoutRule {
+	method := c.Request().Method
+	route := c.Path()
+	for _, rule := range config.Rules {
+		if rule.Method != "" && rule.Method != method {
+			continue
+		}
+		if ok, _ := path.Match(rule.Route, route); ok {
+			return rule
+		}
+	}
+
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return TimeoutRule{Timeout: config.Read}
+	default:
+		return TimeoutRule{Timeout: config.Write}
+	}
+}
+
+// extendConnDeadlines lets the connection outlive the server's read and write
+// timeouts until the request deadline. Writers that do not support deadlines,
+// like test recorders, are left alone.
+func extendConnDeadlines(c echo.Context, timeout time.Duration) {
+	rc := http.NewResponseController(c.Response())
+	deadline := time.Now().Add(timeout)
+	_ = rc.SetReadDeadline(deadline)
+	_ = rc.SetWriteDeadline(deadline.Add(timeoutWriteGrace))
+}
@@ -0,0 +1,131 @@
+package middleware_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/lllypuk/flowra/internal/infrastructure/httpserver"
+	"github.com/lllypuk/flowra/internal/middleware"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type recordingTimeoutRecorder struct {
+	mu       sync.Mutex
+	timeouts []string
+}
+
+func (r *recordingTimeoutRecorder) RecordRequestTimeout(method, route string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.timeouts = append(r.timeouts, method+" "+route)
+}
+
+func TestTimeout(t *testing.T) {
+	recorder := &recordingTimeoutRecorder{}
+	e := echo.New()
+	e.Use(middleware.Timeout(middleware.TimeoutConfig{
+		Recorder:  recorder,
+		Read:      20 * time.Millisecond,
+		Write:     time.Second,
+		APIPrefix: "/api/v1",
+		Rules: []middleware.TimeoutRule{
+			{Route: "/api/v1/workspaces/*/export", Timeout: time.Second},
+			{Method: http.MethodGet, Route: "/api/v1/ws"},
+		},
+	}))
+
+	remaining := make(chan time.Duration, 1)
+	waitForDeadline := func(c echo.Context) error {
+		deadline, ok := c.Request().Context().Deadline()
+		if ok {
+			remaining <- time.Until(deadline)
+		} else {
+			remaining <- 0
+		}
+		<-c.Request().Context().Done()
+		return nil
+	}
+	reportDeadline := func(c echo.Context) error {
+		deadline, ok := c.Request().Context().Deadline()
+		if ok {
+			remaining <- time.Until(deadline)
+		} else {
+			remaining <- 0
+		}
+		return c.NoContent(http.StatusOK)
+	}
+	e.GET("/api/v1/chats", waitForDeadline)
+	e.GET("/chats", waitForDeadline)
+	e.GET("/api/v1/workspaces/:workspace_id/export", reportDeadline)
+	e.POST("/api/v1/chats", reportDeadline)
+	e.GET("/api/v1/ws", reportDeadline)
+	e.GET("/api/v1/slow-error", func(c echo.Context) error {
+		<-c.Request().Context().Done()
+		return httpserver.RespondError(c, c.Request().Context().Err())
+	})
+
+	serve := func(method, path string) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, httptest.NewRequest(method, path, nil))
+		return rec
+	}
+
+	t.Run("reads that run out of time get 504", func(t *testing.T) {
+		rec := serve(http.MethodGet, "/api/v1/chats")
+
+		assert.LessOrEqual(t, <-remaining, 20*time.Millisecond)
+		assert.Equal(t, http.StatusGatewayTimeout, rec.Code)
+		assert.Contains(t, rec.Body.String(), `"code":"REQUEST_TIMEOUT"`)
+	})
+
+	t.Run("pages get plain text", func(t *testing.T) {
+		rec := serve(http.MethodGet, "/chats")
+
+		<-remaining
+		assert.Equal(t, http.StatusGatewayTimeout, rec.Code)
+		assert.Equal(t, middleware.DefaultTimeoutMessage, rec.Body.String())
+	})
+
+	t.Run("writes get the write deadline", func(t *testing.T) {
+		rec := serve(http.MethodPost, "/api/v1/chats")
+
+		d := <-remaining
+		assert.Greater(t, d, 500*time.Millisecond)
+		assert.LessOrEqual(t, d, time.Second)
+		assert.Equal(t, http.StatusOK, rec.Code)
+	})
+
+	t.Run("rules override the method default", func(t *testing.T) {
+		rec := serve(http.MethodGet, "/api/v1/workspaces/42/export")
+
+		assert.Greater(t, <-remaining, 500*time.Millisecond)
+		assert.Equal(t, http.StatusOK, rec.Code)
+	})
+
+	t.Run("rules without a timeout set no deadline", func(t *testing.T) {
+		rec := serve(http.MethodGet, "/api/v1/ws")
+
+		assert.Zero(t, <-remaining)
+		assert.Equal(t, http.StatusOK, rec.Code)
+	})
+
+	t.Run("handlers mapping the deadline error keep their response", func(t *testing.T) {
+		rec := serve(http.MethodGet, "/api/v1/slow-error")
+
+		assert.Equal(t, http.StatusGatewayTimeout, rec.Code)
+		assert.Contains(t, rec.Body.String(), `"code":"REQUEST_TIMEOUT"`)
+	})
+
+	recorder.mu.Lock()
+	defer recorder.mu.Unlock()
+	require.Equal(t, []string{
+		"GET /api/v1/chats",
+		"GET /chats",
+		"GET /api/v1/slow-error",
+	}, recorder.timeouts)
+}
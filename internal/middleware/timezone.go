@@ -0,0 +1,60 @@
+package middleware
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/lllypuk/flowra/internal/application/appcore"
+	"github.com/lllypuk/flowra/internal/domain/uuid"
+)
+
+// TimezoneResolver resolves a user's preferred time zone.
+type TimezoneResolver interface {
+	// UserTimezone returns the user's IANA timezone name, or "" if none is set.
+	UserTimezone(ctx context.Context, userID uuid.UUID) string
+}
+
+// Timezone returns a middleware that loads the authenticated user's time zone
+// into the request context (see appcore.GetLocation). It must run after
+// authentication. Anonymous requests and unknown zones fall back to UTC.
+func Timezone(resolver TimezoneResolver) echo.MiddlewareFunc {
+	var locations sync.Map // IANA name -> *time.Location
+
+	load := func(name string) *time.Location {
+		if cached, ok := locations.Load(name); ok {
+			loc, _ := cached.(*time.Location)
+			return loc
+		}
+		loc, err := time.LoadLocation(name)
+		if err != nil {
+			loc = time.UTC
+		}
+		locations.Store(name, loc)
+		return loc
+	}
+
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			userID := GetUserID(c)
+			if resolver == nil || userID.IsZero() {
+				return next(c)
+			}
+
+			req := c.Request()
+			name := resolver.UserTimezone(req.Context(), userID)
+			if name == "" {
+				return next(c)
+			}
+
+			c.SetRequest(req.WithContext(appcore.WithLocation(req.Context(), load(name))))
+			return next(c)
+		}
+	}
+}
+
+// GetLocation returns the requesting user's time zone, defaulting to UTC.
+func GetLocation(c echo.Context) *time.Location {
+	return appcore.GetLocation(c.Request().Context())
+}
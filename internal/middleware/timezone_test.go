@@ -0,0 +1,60 @@
+package middleware_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/lllypuk/flowra/internal/domain/uuid"
+	"github.com/lllypuk/flowra/internal/middleware"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type stubTimezoneResolver map[uuid.UUID]string
+
+func (s stubTimezoneResolver) UserTimezone(_ context.Context, userID uuid.UUID) string {
+	return s[userID]
+}
+
+func runTimezone(t *testing.T, resolver middleware.TimezoneResolver, userID uuid.UUID) *time.Location {
+	t.Helper()
+
+	e := echo.New()
+	c := e.NewContext(httptest.NewRequest(http.MethodGet, "/", nil), httptest.NewRecorder())
+	if !userID.IsZero() {
+		c.Set(string(middleware.ContextKeyUserID), userID)
+	}
+
+	var got *time.Location
+	handler := middleware.Timezone(resolver)(func(c echo.Context) error {
+		got = middleware.GetLocation(c)
+		return nil
+	})
+	require.NoError(t, handler(c))
+	return got
+}
+
+func TestTimezone(t *testing.T) {
+	userID := uuid.NewUUID()
+
+	t.Run("sets the user's location", func(t *testing.T) {
+		loc := runTimezone(t, stubTimezoneResolver{userID: "America/Chicago"}, userID)
+		assert.Equal(t, "America/Chicago", loc.String())
+	})
+
+	t.Run("defaults to UTC without preference", func(t *testing.T) {
+		assert.Equal(t, time.UTC, runTimezone(t, stubTimezoneResolver{}, userID))
+	})
+
+	t.Run("defaults to UTC for unknown zone", func(t *testing.T) {
+		assert.Equal(t, time.UTC, runTimezone(t, stubTimezoneResolver{userID: "Nowhere/City"}, userID))
+	})
+
+	t.Run("skips anonymous requests", func(t *testing.T) {
+		assert.Equal(t, time.UTC, runTimezone(t, stubTimezoneResolver{userID: "Asia/Tokyo"}, ""))
+	})
+}
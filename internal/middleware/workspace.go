@@ -242,13 +242,7 @@ func respondWorkspaceError(c echo.Context, err error) error {
 		status = http.StatusBadRequest
 	}
 
-	return c.JSON(status, map[string]any{
-		"success": false,
-		"error": map[string]string{
-			"code":    code,
-			"message": message,
-		},
-	})
+	return respondError(c, status, code, message, nil)
 }
 
 // GetWorkspaceID extracts the workspace ID from the echo context.
@@ -0,0 +1,173 @@
+// Package problem renders API errors as RFC 7807 problem details.
+//
+// The API answers errors with the {"success": false, "error": {...}} envelope by
+// default. Clients that list application/problem+json in their Accept header get
+// a problem details document instead. Its type URI is stable: it is chosen from
+// the error code, or from the status for codes without a type of their own, and
+// the legacy code is kept in the "code" member.
+package problem
+
+import (
+	"encoding/json"
+	"maps"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+)
+
+// ContentType is the media type of problem details documents.
+const ContentType = "application/problem+json"
+
+// TypeBase prefixes every problem type URI.
+const TypeBase = "https://flowra.dev/problems/"
+
+// blankType is the RFC 7807 type of problems that need no type of their own.
+const blankType = "about:blank"
+
+// Type is an entry of the error taxonomy.
+type Type struct {
+	// Slug is the last segment of the type URI.
+	Slug string
+
+	// Title is the short, human-readable summary of the problem type.
+	Title string
+}
+
+// URI returns the type URI.
+func (t Type) URI() string {
+	return TypeBase + t.Slug
+}
+
+// codeTypes are the error codes with a problem type of their own.
+//
+//nolint:gochecknoglobals // fixed lookup table
+var codeTypes = map[string]Type{
+	"CHAT_NOT_FOUND":          {Slug: "chat-not-found", Title: "Chat not found"},
+	"WORKSPACE_NOT_FOUND":     {Slug: "workspace-not-found", Title: "Workspace not found"},
+	"CONCURRENT_MODIFICATION": {Slug: "version-conflict", Title: "Version conflict"},
+	"INVALID_STATE":           {Slug: "invalid-state", Title: "Operation not allowed in the current state"},
+	"INVALID_TRANSITION":      {Slug: "invalid-transition", Title: "State transition not allowed"},
+	"READ_ONLY":               {Slug: "read-only", Title: "Instance is read-only"},
+	"MAINTENANCE":             {Slug: "maintenance", Title: "Maintenance in progress"},
+}
+
+// statusTypes are the problem types of codes without one of their own.
+//
+//nolint:gochecknoglobals // fixed lookup table
+var statusTypes = map[int]Type{
+	http.StatusBadRequest:            {Slug: "validation-failed", Title: "Request validation failed"},
+	http.StatusUnauthorized:          {Slug: "authentication-required", Title: "Authentication required"},
+	http.StatusForbidden:             {Slug: "insufficient-privilege", Title: "Insufficient privilege"},
+	http.StatusNotFound:              {Slug: "not-found", Title: "Resource not found"},
+	http.StatusConflict:              {Slug: "conflict", Title: "Resource conflict"},
+	http.StatusRequestEntityTooLarge: {Slug: "payload-too-large", Title: "Payload too large"},
+	http.StatusUnprocessableEntity:   {Slug: "unprocessable", Title: "Request cannot be processed"},
+	http.StatusTooManyRequests:       {Slug: "rate-limited", Title: "Rate limit exceeded"},
+	http.StatusInternalServerError:   {Slug: "internal-error", Title: "Internal error"},
+	http.StatusServiceUnavailable:    {Slug: "service-unavailable", Title: "Service unavailable"},
+	http.StatusGatewayTimeout:        {Slug: "request-timeout", Title: "Request timed out"},
+}
+
+// Lookup returns the problem type of an error code answered with status. The
+// boolean is false when neither the code nor the status has a type.
+func Lookup(status int, code string) (Type, bool) {
+	if t, ok := codeTypes[code]; ok {
+		return t, true
+	}
+	t, ok := statusTypes[status]
+	return t, ok
+}
+
+// Details is an RFC 7807 problem details document.
+type Details struct {
+	Type     string
+	Title    string
+	Status   int
+	Detail   string
+	Instance string
+
+	// Code is the error code of the legacy envelope.
+	Code string
+
+	// RequestID correlates the problem with the server logs.
+	RequestID string
+
+	// Extensions are additional members, e.g. rate limit details.
+	Extensions map[string]any
+}
+
+// New returns the problem details for an error code answered with status.
+func New(status int, code, detail string) Details {
+	d := Details{
+		Type:   blankType,
+		Title:  http.StatusText(status),
+		Status: status,
+		Detail: detail,
+		Code:   code,
+	}
+	if t, ok := Lookup(status, code); ok {
+		d.Type = t.URI()
+		d.Title = t.Title
+	}
+	return d
+}
+
+// MarshalJSON writes the standard members and the extensions side by side at the
+// top level, as RFC 7807 requires. Extensions never replace standard members.
+func (d Details) MarshalJSON() ([]byte, error) {
+	members := make(map[string]any, len(d.Extensions))
+	maps.Copy(members, d.Extensions)
+	members["type"] = d.Type
+	members["title"] = d.Title
+	members["status"] = d.Status
+	members["code"] = d.Code
+	if d.Detail != "" {
+		members["detail"] = d.Detail
+	}
+	if d.Instance != "" {
+		members["instance"] = d.Instance
+	}
+	if d.RequestID != "" {
+		members["request_id"] = d.RequestID
+	}
+	return json.Marshal(members)
+}
+
+// Wanted reports whether the client of c asked for problem details.
+func Wanted(c echo.Context) bool {
+	return Accepted(c.Request().Header.Get(echo.HeaderAccept))
+}
+
+// Respond writes the problem details for an error code answered with status.
+// The request path becomes the instance and the request ID is included when set.
+func Respond(c echo.Context, status int, code, detail string, extensions map[string]any) error {
+	d := New(status, code, detail)
+	d.Instance = c.Request().URL.Path
+	d.RequestID = c.Response().Header().Get(echo.HeaderXRequestID)
+	d.Extensions = extensions
+
+	c.Response().Header().Set(echo.HeaderContentType, ContentType)
+	return c.JSON(status, d)
+}
+
+// Accepted reports whether an Accept header asks for problem details. The
+// envelope stays the default, so only an explicit, non-zero q value for
+// application/problem+json switches the format.
+func Accepted(accept string) bool {
+	for part := range strings.SplitSeq(accept, ",") {
+		mediaType, params, err := mime.ParseMediaType(strings.TrimSpace(part))
+		if err != nil || mediaType != ContentType {
+			continue
+		}
+		if q, hasQ := params["q"]; hasQ {
+			if weight, parseErr := strconv.ParseFloat(q, 64); parseErr != nil || weight <= 0 {
+				continue
+			}
+		}
+		return true
+	}
+	return false
+}
@@ -0,0 +1,84 @@
+package problem_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/lllypuk/flowra/internal/pkg/problem"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew(t *testing.T) {
+	tests := []struct {
+		name   string
+		status int
+		code   string
+		typ    string
+		title  string
+	}{
+		{"code type", http.StatusNotFound, "CHAT_NOT_FOUND", problem.TypeBase + "chat-not-found", "Chat not found"},
+		{"version conflict", http.StatusConflict, "CONCURRENT_MODIFICATION",
+			problem.TypeBase + "version-conflict", "Version conflict"},
+		{"status type", http.StatusForbidden, "NOT_ADMIN",
+			problem.TypeBase + "insufficient-privilege", "Insufficient privilege"},
+		{"validation", http.StatusBadRequest, "INVALID_CHAT_ID",
+			problem.TypeBase + "validation-failed", "Request validation failed"},
+		{"untyped status", http.StatusTeapot, "TEAPOT", "about:blank", "I'm a teapot"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := problem.New(tt.status, tt.code, "detail")
+
+			assert.Equal(t, tt.typ, d.Type)
+			assert.Equal(t, tt.title, d.Title)
+			assert.Equal(t, tt.status, d.Status)
+			assert.Equal(t, tt.code, d.Code)
+			assert.Equal(t, "detail", d.Detail)
+		})
+	}
+}
+
+func TestDetails_MarshalJSON(t *testing.T) {
+	d := problem.New(http.StatusTooManyRequests, "RATE_LIMIT_EXCEEDED", "slow down")
+	d.Instance = "/api/v1/chats"
+	d.RequestID = "req-1"
+	d.Extensions = map[string]any{"retry_after": 30, "status": "ignored"}
+
+	data, err := json.Marshal(d)
+	require.NoError(t, err)
+
+	var body map[string]any
+	require.NoError(t, json.Unmarshal(data, &body))
+	assert.Equal(t, map[string]any{
+		"type":        problem.TypeBase + "rate-limited",
+		"title":       "Rate limit exceeded",
+		"status":      float64(http.StatusTooManyRequests),
+		"detail":      "slow down",
+		"instance":    "/api/v1/chats",
+		"code":        "RATE_LIMIT_EXCEEDED",
+		"request_id":  "req-1",
+		"retry_after": float64(30),
+	}, body)
+}
+
+func TestAccepted(t *testing.T) {
+	tests := []struct {
+		accept string
+		want   bool
+	}{
+		{"", false},
+		{"application/json", false},
+		{"*/*", false},
+		{"application/problem+json", true},
+		{"application/json, application/problem+json;q=0.9", true},
+		{"application/problem+json; q=0", false},
+		{"text/html, application/problem+json ; charset=utf-8", true},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, problem.Accepted(tt.accept), tt.accept)
+	}
+}
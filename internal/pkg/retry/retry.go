@@ -0,0 +1,186 @@
+// Package retry runs operations again after transient failures, with
+// exponential backoff, jitter and context awareness.
+package retry
+
+import (
+	"context"
+	"errors"
+	"math/rand/v2"
+	"time"
+)
+
+// Policy defaults.
+const (
+	DefaultMaxAttempts    = 3
+	DefaultInitialBackoff = 100 * time.Millisecond
+	DefaultMaxBackoff     = 5 * time.Second
+	DefaultMultiplier     = 2.0
+	DefaultJitter         = 0.2
+)
+
+// Policy describes how often and how fast an operation is retried.
+// Zero fields fall back to the package defaults.
+type Policy struct {
+	// MaxAttempts is the total number of attempts, including the first one.
+	MaxAttempts int
+
+	// InitialBackoff is the delay before the first retry.
+	InitialBackoff time.Duration
+
+	// MaxBackoff caps the delay between attempts.
+	MaxBackoff time.Duration
+
+	// Multiplier grows the delay after every retry.
+	Multiplier float64
+
+	// Jitter is the fraction (0..1) of each delay that is randomized, so that
+	// clients failing together do not retry in lockstep. Negative disables jitter.
+	Jitter float64
+
+	// Retryable decides whether an error is worth another attempt. Defaults to IsRetryable.
+	Retryable func(err error) bool
+
+	// OnRetry, if set, is called after a failed attempt that will be retried.
+	// attempt is the number of the failed attempt, starting at 1.
+	OnRetry func(attempt int, err error, delay time.Duration)
+}
+
+// DefaultPolicy returns the default retry policy.
+func DefaultPolicy() Policy {
+	return Policy{
+		MaxAttempts:    DefaultMaxAttempts,
+		InitialBackoff: DefaultInitialBackoff,
+		MaxBackoff:     DefaultMaxBackoff,
+		Multiplier:     DefaultMultiplier,
+		Jitter:         DefaultJitter,
+	}
+}
+
+func (p Policy) withDefaults() Policy {
+	if p.MaxAttempts <= 0 {
+		p.MaxAttempts = DefaultMaxAttempts
+	}
+	if p.InitialBackoff <= 0 {
+		p.InitialBackoff = DefaultInitialBackoff
+	}
+	if p.MaxBackoff <= 0 {
+		p.MaxBackoff = DefaultMaxBackoff
+	}
+	if p.Multiplier < 1 {
+		p.Multiplier = DefaultMultiplier
+	}
+	if p.Jitter == 0 {
+		p.Jitter = DefaultJitter
+	}
+	p.Jitter = min(p.Jitter, 1)
+	if p.Retryable == nil {
+		p.Retryable = IsRetryable
+	}
+	return p
+}
+
+// Backoff returns the delay before the given retry (1 for the first retry), jitter included.
+func (p Policy) Backoff(retry int) time.Duration {
+	p = p.withDefaults()
+
+	delay := float64(p.InitialBackoff)
+	for i := 1; i < retry && delay < float64(p.MaxBackoff); i++ {
+		delay *= p.Multiplier
+	}
+	delay = min(delay, float64(p.MaxBackoff))
+
+	if p.Jitter > 0 {
+		delay -= delay * p.Jitter * rand.Float64() //nolint:gosec // Jitter does not need a secure source.
+	}
+	return time.Duration(delay)
+}
+
+// Do calls fn until it succeeds, returns a non-retryable error, or the policy runs
+// out of attempts. It returns nil on success and the last error otherwise. If ctx
+// is cancelled while waiting between attempts, the context error is returned; when
+// the ctx deadline would pass before the next attempt, the last error is returned
+// right away.
+func Do(ctx context.Context, policy Policy, fn func(ctx context.Context) error) error {
+	policy = policy.withDefaults()
+
+	var err error
+	for attempt := 1; ; attempt++ {
+		err = fn(ctx)
+		if err == nil {
+			return nil
+		}
+
+		var permanent *permanentError
+		if errors.As(err, &permanent) {
+			return permanent.err
+		}
+		if attempt >= policy.MaxAttempts || !policy.Retryable(err) || ctx.Err() != nil {
+			return err
+		}
+
+		delay := policy.Backoff(attempt)
+		if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < delay {
+			// the next attempt could not start before the caller gives up
+			return err
+		}
+		if policy.OnRetry != nil {
+			policy.OnRetry(attempt, err, delay)
+		}
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// DoValue is Do for operations that return a value.
+func DoValue[T any](ctx context.Context, policy Policy, fn func(ctx context.Context) (T, error)) (T, error) {
+	var result T
+	err := Do(ctx, policy, func(ctx context.Context) error {
+		value, err := fn(ctx)
+		if err != nil {
+			return err
+		}
+		result = value
+		return nil
+	})
+	return result, err
+}
+
+// permanentError marks an error that must not be retried.
+type permanentError struct {
+	err error
+}
+
+func (e *permanentError) Error() string { return e.err.Error() }
+func (e *permanentError) Unwrap() error { return e.err }
+
+// Permanent wraps err so that Do stops retrying and returns err as is.
+func Permanent(err error) error {
+	if err == nil {
+		return nil
+	}
+	return &permanentError{err: err}
+}
+
+// IsRetryable reports whether err is worth another attempt. Context cancellation
+// and deadline errors are not; neither are errors that implement
+// `Retryable() bool` and return false. Everything else is.
+func IsRetryable(err error) bool {
+	if err == nil {
+		return false
+	}
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+
+	var classified interface{ Retryable() bool }
+	if errors.As(err, &classified) {
+		return classified.Retryable()
+	}
+	return true
+}
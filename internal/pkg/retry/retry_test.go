@@ -0,0 +1,164 @@
+package retry_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lllypuk/flowra/internal/pkg/retry"
+)
+
+var errTransient = errors.New("transient")
+
+type classifiedError struct{ retryable bool }
+
+func (e classifiedError) Error() string   { return "classified" }
+func (e classifiedError) Retryable() bool { return e.retryable }
+
+func fastPolicy() retry.Policy {
+	return retry.Policy{
+		MaxAttempts:    3,
+		InitialBackoff: time.Millisecond,
+		MaxBackoff:     5 * time.Millisecond,
+		Jitter:         -1,
+	}
+}
+
+func TestDo_RetriesUntilSuccess(t *testing.T) {
+	policy := fastPolicy()
+	var retries []int
+	policy.OnRetry = func(attempt int, err error, _ time.Duration) {
+		retries = append(retries, attempt)
+		assert.ErrorIs(t, err, errTransient)
+	}
+
+	calls := 0
+	err := retry.Do(context.Background(), policy, func(context.Context) error {
+		calls++
+		if calls < 3 {
+			return errTransient
+		}
+		return nil
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, 3, calls)
+	assert.Equal(t, []int{1, 2}, retries)
+}
+
+func TestDo_ReturnsLastErrorWhenExhausted(t *testing.T) {
+	calls := 0
+	err := retry.Do(context.Background(), fastPolicy(), func(context.Context) error {
+		calls++
+		return fmt.Errorf("attempt %d: %w", calls, errTransient)
+	})
+
+	require.ErrorIs(t, err, errTransient)
+	assert.EqualError(t, err, "attempt 3: transient")
+	assert.Equal(t, 3, calls)
+}
+
+func TestDo_StopsOnNonRetryableErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want error
+	}{
+		{name: "permanent", err: retry.Permanent(errTransient), want: errTransient},
+		{name: "classified", err: classifiedError{retryable: false}, want: classifiedError{}},
+		{name: "context canceled", err: context.Canceled, want: context.Canceled},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calls := 0
+			err := retry.Do(context.Background(), fastPolicy(), func(context.Context) error {
+				calls++
+				return tt.err
+			})
+
+			require.ErrorIs(t, err, tt.want)
+			assert.Equal(t, 1, calls)
+		})
+	}
+}
+
+func TestDo_StopsWhenContextIsCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	policy := fastPolicy()
+	policy.InitialBackoff = time.Hour
+	policy.MaxBackoff = time.Hour
+	policy.OnRetry = func(int, error, time.Duration) { cancel() }
+
+	err := retry.Do(ctx, policy, func(context.Context) error {
+		return errTransient
+	})
+
+	require.ErrorIs(t, err, context.Canceled)
+}
+
+func TestDo_GivesUpWhenDeadlineWouldPassBeforeNextAttempt(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	policy := fastPolicy()
+	policy.InitialBackoff = time.Hour
+	policy.MaxBackoff = time.Hour
+	policy.OnRetry = func(int, error, time.Duration) { t.Fatal("unexpected retry") }
+
+	calls := 0
+	err := retry.Do(ctx, policy, func(context.Context) error {
+		calls++
+		return errTransient
+	})
+
+	require.ErrorIs(t, err, errTransient)
+	assert.Equal(t, 1, calls)
+}
+
+func TestDoValue(t *testing.T) {
+	calls := 0
+	value, err := retry.DoValue(context.Background(), fastPolicy(), func(context.Context) (string, error) {
+		calls++
+		if calls == 1 {
+			return "", errTransient
+		}
+		return "ok", nil
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "ok", value)
+}
+
+func TestPolicy_Backoff(t *testing.T) {
+	policy := retry.Policy{
+		InitialBackoff: 100 * time.Millisecond,
+		MaxBackoff:     time.Second,
+		Multiplier:     2,
+		Jitter:         -1,
+	}
+
+	assert.Equal(t, 100*time.Millisecond, policy.Backoff(1))
+	assert.Equal(t, 200*time.Millisecond, policy.Backoff(2))
+	assert.Equal(t, 800*time.Millisecond, policy.Backoff(4))
+	assert.Equal(t, time.Second, policy.Backoff(10))
+
+	policy.Jitter = 0.5
+	for range 50 {
+		delay := policy.Backoff(2)
+		assert.GreaterOrEqual(t, delay, 100*time.Millisecond)
+		assert.LessOrEqual(t, delay, 200*time.Millisecond)
+	}
+}
+
+func TestIsRetryable(t *testing.T) {
+	assert.False(t, retry.IsRetryable(nil))
+	assert.True(t, retry.IsRetryable(errTransient))
+	assert.False(t, retry.IsRetryable(fmt.Errorf("wrapped: %w", context.DeadlineExceeded)))
+	assert.True(t, retry.IsRetryable(classifiedError{retryable: true}))
+	assert.False(t, retry.IsRetryable(classifiedError{retryable: false}))
+}
@@ -0,0 +1,245 @@
+// Package validate checks request DTOs against declarative struct tags.
+//
+// Rules are listed in a `validate` tag, separated by commas:
+//
+//	Name string `json:"name" validate:"required,max=100"`
+//
+// Built-in rules are required, notblank, min, max and oneof. Custom rules for
+// string values (IDs, roles, enum-like types) are added with Validator.Register.
+// Every rule except required skips zero values, so optional fields are only
+// checked when set. Rules other than min, max and required are applied to every
+// element of a slice. Fields are reported by their JSON name.
+package validate
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// TagName is the struct tag holding the rules of a field.
+const TagName = "validate"
+
+// Built-in rule names.
+const (
+	RuleRequired = "required"
+	RuleNotBlank = "notblank"
+	RuleMin      = "min"
+	RuleMax      = "max"
+	RuleOneOf    = "oneof"
+)
+
+// FieldError describes a field that broke a rule.
+type FieldError struct {
+	// Field is the JSON name of the field; slice elements carry their index, e.g. participant_ids[1].
+	Field string `json:"field"`
+
+	// Rule is the name of the broken rule.
+	Rule string `json:"rule"`
+
+	// Param is the rule parameter, e.g. the limit of max.
+	Param string `json:"param,omitempty"`
+
+	// Message is the human-readable description of the problem.
+	Message string `json:"message"`
+}
+
+// Errors lists every broken rule of a value, in field order.
+type Errors []FieldError
+
+// Error joins the messages of all field errors.
+func (e Errors) Error() string {
+	messages := make([]string, 0, len(e))
+	for _, fe := range e {
+		messages = append(messages, fe.Message)
+	}
+	return strings.Join(messages, "; ")
+}
+
+// StringFunc reports whether a string value satisfies a custom rule.
+type StringFunc func(value string) bool
+
+type customRule struct {
+	message string
+	fn      StringFunc
+}
+
+// Validator checks structs against their validate tags. Register custom rules
+// before the validator is shared; Struct is safe for concurrent use.
+type Validator struct {
+	rules map[string]customRule
+}
+
+// New returns a validator with the built-in rules only.
+func New() *Validator {
+	return &Validator{rules: make(map[string]customRule)}
+}
+
+// Register adds a custom rule for string fields. message completes the field
+// name in error messages, e.g. "must be a valid UUID".
+func (v *Validator) Register(name, message string, fn StringFunc) {
+	v.rules[name] = customRule{message: message, fn: fn}
+}
+
+// Struct validates s, a struct or a pointer to one. It returns Errors when any
+// rule is broken. A tag naming an unknown rule is a programming error and panics.
+func (v *Validator) Struct(s any) error {
+	rv := reflect.Indirect(reflect.ValueOf(s))
+	if rv.Kind() != reflect.Struct {
+		panic(fmt.Sprintf("validate: %T is not a struct", s))
+	}
+
+	var errs Errors
+	rt := rv.Type()
+	for i := range rt.NumField() {
+		field := rt.Field(i)
+		tag := field.Tag.Get(TagName)
+		if tag == "" || !field.IsExported() {
+			continue
+		}
+		name := fieldName(field)
+		for rule := range strings.SplitSeq(tag, ",") {
+			ruleName, param, _ := strings.Cut(strings.TrimSpace(rule), "=")
+			if fe, ok := v.check(name, rv.Field(i), ruleName, param); !ok {
+				errs = append(errs, fe...)
+				// later rules of the field would repeat the same problem
+				break
+			}
+		}
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+// check applies one rule to a field value.
+func (v *Validator) check(name string, value reflect.Value, rule, param string) ([]FieldError, bool) {
+	if rule == RuleRequired {
+		if isZero(value) {
+			return []FieldError{{Field: name, Rule: rule, Message: name + " is required"}}, false
+		}
+		return nil, true
+	}
+
+	if value.Kind() == reflect.Pointer {
+		if value.IsNil() {
+			return nil, true
+		}
+		value = value.Elem()
+	}
+
+	if rule == RuleMin || rule == RuleMax {
+		return checkBound(name, value, rule, param)
+	}
+
+	if value.Kind() == reflect.Slice {
+		var errs []FieldError
+		for i := range value.Len() {
+			elemName := fmt.Sprintf("%s[%d]", name, i)
+			if fe, ok := v.check(elemName, value.Index(i), rule, param); !ok {
+				errs = append(errs, fe...)
+			}
+		}
+		return errs, len(errs) == 0
+	}
+
+	if value.IsZero() {
+		return nil, true
+	}
+	return v.checkString(name, value, rule, param)
+}
+
+// checkString applies notblank, oneof and custom rules, which need a string.
+func (v *Validator) checkString(name string, value reflect.Value, rule, param string) ([]FieldError, bool) {
+	if value.Kind() != reflect.String {
+		panic(fmt.Sprintf("validate: rule %q on non-string field %s", rule, name))
+	}
+	s := value.String()
+
+	switch rule {
+	case RuleNotBlank:
+		if strings.TrimSpace(s) == "" {
+			return []FieldError{{Field: name, Rule: rule, Message: name + " must not be blank"}}, false
+		}
+		return nil, true
+	case RuleOneOf:
+		allowed := strings.Fields(param)
+		for _, a := range allowed {
+			if s == a {
+				return nil, true
+			}
+		}
+		return []FieldError{{
+			Field:   name,
+			Rule:    rule,
+			Param:   param,
+			Message: name + " must be one of: " + strings.Join(allowed, ", "),
+		}}, false
+	}
+
+	custom, ok := v.rules[rule]
+	if !ok {
+		panic(fmt.Sprintf("validate: unknown rule %q on field %s", rule, name))
+	}
+	if custom.fn(s) {
+		return nil, true
+	}
+	return []FieldError{{Field: name, Rule: rule, Message: name + " " + custom.message}}, false
+}
+
+// checkBound applies min and max: characters of strings, elements of slices and
+// maps, the value of numbers.
+func checkBound(name string, value reflect.Value, rule, param string) ([]FieldError, bool) {
+	limit, err := strconv.ParseInt(param, 10, 64)
+	if err != nil {
+		panic(fmt.Sprintf("validate: rule %q on field %s needs an integer parameter", rule, name))
+	}
+
+	var size int64
+	var unit string
+	switch value.Kind() {
+	case reflect.String:
+		size, unit = int64(utf8.RuneCountInString(value.String())), " characters"
+	case reflect.Slice, reflect.Map:
+		size, unit = int64(value.Len()), " items"
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		size = value.Int()
+	default:
+		panic(fmt.Sprintf("validate: rule %q on unsupported field %s", rule, name))
+	}
+
+	if rule == RuleMin && size < limit {
+		return []FieldError{{
+			Field: name, Rule: rule, Param: param,
+			Message: name + " must be at least " + param + unit,
+		}}, false
+	}
+	if rule == RuleMax && size > limit {
+		return []FieldError{{
+			Field: name, Rule: rule, Param: param,
+			Message: name + " must be at most " + param + unit,
+		}}, false
+	}
+	return nil, true
+}
+
+// isZero reports whether a required field is missing: nil, zero or, for
+// pointers, pointing to a zero value.
+func isZero(value reflect.Value) bool {
+	if value.Kind() == reflect.Pointer {
+		return value.IsNil() || value.Elem().IsZero()
+	}
+	return value.IsZero()
+}
+
+// fieldName returns the JSON name of a field, falling back to the Go name.
+func fieldName(field reflect.StructField) string {
+	name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+	if name == "" || name == "-" {
+		return field.Name
+	}
+	return name
+}
@@ -0,0 +1,89 @@
+package validate_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/lllypuk/flowra/internal/pkg/validate"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type request struct {
+	Name     string   `json:"name"      validate:"required,max=5"`
+	Text     string   `json:"text"      validate:"notblank"`
+	Role     string   `json:"role"      validate:"oneof=admin member"`
+	OwnerID  *string  `json:"owner_id"  validate:"code"`
+	Tags     []string `json:"tags"      validate:"max=2,code"`
+	Position int      `json:"position"  validate:"min=0"`
+	Internal string   `json:"-"         validate:"required"`
+}
+
+func newValidator() *validate.Validator {
+	v := validate.New()
+	v.Register("code", "must be upper case", func(s string) bool {
+		return s == strings.ToUpper(s)
+	})
+	return v
+}
+
+func TestValidator_Struct_Valid(t *testing.T) {
+	owner := "OWNER"
+	req := request{
+		Name:     "héllo",
+		Role:     "admin",
+		OwnerID:  &owner,
+		Tags:     []string{"A", "B"},
+		Internal: "set",
+	}
+
+	require.NoError(t, newValidator().Struct(&req))
+}
+
+func TestValidator_Struct_OptionalFieldsSkipped(t *testing.T) {
+	require.NoError(t, newValidator().Struct(request{Name: "x", Internal: "set"}))
+}
+
+func TestValidator_Struct_Errors(t *testing.T) {
+	owner := "owner"
+	req := request{
+		Name:     "too long",
+		Text:     "   ",
+		Role:     "owner",
+		OwnerID:  &owner,
+		Tags:     []string{"A", "b"},
+		Position: -1,
+	}
+
+	err := newValidator().Struct(req)
+
+	var errs validate.Errors
+	require.ErrorAs(t, err, &errs)
+	assert.Equal(t, validate.Errors{
+		{Field: "name", Rule: "max", Param: "5", Message: "name must be at most 5 characters"},
+		{Field: "text", Rule: "notblank", Message: "text must not be blank"},
+		{Field: "role", Rule: "oneof", Param: "admin member", Message: "role must be one of: admin, member"},
+		{Field: "owner_id", Rule: "code", Message: "owner_id must be upper case"},
+		{Field: "tags[1]", Rule: "code", Message: "tags[1] must be upper case"},
+		{Field: "position", Rule: "min", Param: "0", Message: "position must be at least 0"},
+		{Field: "Internal", Rule: "required", Message: "Internal is required"},
+	}, errs)
+	assert.Contains(t, err.Error(), "name must be at most 5 characters; text must not be blank")
+}
+
+func TestValidator_Struct_StopsAtFirstBrokenRuleOfField(t *testing.T) {
+	err := newValidator().Struct(request{Internal: "set"})
+
+	var errs validate.Errors
+	require.ErrorAs(t, err, &errs)
+	require.Len(t, errs, 1)
+	assert.Equal(t, "name is required", errs[0].Message)
+}
+
+func TestValidator_Struct_UnknownRulePanics(t *testing.T) {
+	type bad struct {
+		Value string `validate:"missing"`
+	}
+
+	assert.Panics(t, func() { _ = validate.New().Struct(bad{Value: "x"}) })
+}
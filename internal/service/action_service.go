@@ -163,6 +163,50 @@ func (s *ActionService) SetPriority(
 	)
 }
 
+// SetSeverity executes bug severity change via tag command and batches the human-readable message
+func (s *ActionService) SetSeverity(
+	ctx context.Context,
+	chatID uuid.UUID,
+	severity string,
+	actorID uuid.UUID,
+) (*appcore.ActionResult, error) {
+	return s.executeTaskTagAction(
+		ctx,
+		chatID,
+		actorID,
+		fmt.Sprintf("#severity %s", severity),
+		ChangeTypeSeverity,
+		severity,
+		"failed to batch severity change message",
+	)
+}
+
+// MarkDuplicate executes duplicate marking via tag command and batches the human-readable message.
+// A nil originalID clears the mark.
+func (s *ActionService) MarkDuplicate(
+	ctx context.Context,
+	chatID uuid.UUID,
+	originalID *uuid.UUID,
+	actorID uuid.UUID,
+) (*appcore.ActionResult, error) {
+	tagContent := "#duplicate"
+	original := ""
+	if originalID != nil {
+		original = originalID.String()
+		tagContent = fmt.Sprintf("#duplicate %s", original)
+	}
+
+	return s.executeTaskTagAction(
+		ctx,
+		chatID,
+		actorID,
+		tagContent,
+		ChangeTypeDuplicate,
+		original,
+		"failed to batch duplicate mark message",
+	)
+}
+
 // SetDueDate executes due date change via tag command and batches the human-readable message
 func (s *ActionService) SetDueDate(
 	ctx context.Context,
@@ -319,6 +363,30 @@ func (s *ActionService) Reopen(
 	return s.executeAction(ctx, chatID, content, actorID)
 }
 
+// ConvertToDiscussion reverts a Task/Bug/Epic chat back to a Discussion;
+// the task read model drops the chat on the projection sync
+func (s *ActionService) ConvertToDiscussion(
+	ctx context.Context,
+	chatID uuid.UUID,
+	actorID uuid.UUID,
+) (*appcore.ActionResult, error) {
+	if err := s.executeTagCommand(ctx, chatID, actorID, "#discussion"); err != nil {
+		return &appcore.ActionResult{Success: false, Error: err.Error()}, err
+	}
+	if err := s.syncTaskProjection(ctx, chatID); err != nil {
+		return &appcore.ActionResult{Success: false, Error: err.Error()}, err
+	}
+
+	actorName := s.getActorDisplayName(ctx, actorID)
+	var content string
+	if actorName != "" {
+		content = fmt.Sprintf("✅ %s converted the chat to a discussion", actorName)
+	} else {
+		content = "✅ Chat converted to a discussion"
+	}
+	return s.executeAction(ctx, chatID, content, actorID)
+}
+
 // Delete creates a system message to delete the chat
 func (s *ActionService) Delete(
 	ctx context.Context,
@@ -190,6 +190,15 @@ func TestActionService_CloseAndReopenSyncProjection(t *testing.T) {
 				return err
 			},
 		},
+		{
+			name:        "convert to discussion",
+			wantTag:     "#discussion",
+			wantMessage: "✅ Chat converted to a discussion",
+			executeFn: func(svc *service.ActionService) error {
+				_, err := svc.ConvertToDiscussion(context.Background(), chatID, actorID)
+				return err
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -15,11 +15,13 @@ import (
 type ChangeType string
 
 const (
-	ChangeTypeStatus   ChangeType = "status"
-	ChangeTypePriority ChangeType = "priority"
-	ChangeTypeAssignee ChangeType = "assignee"
-	ChangeTypeDueDate  ChangeType = "due_date"
-	ChangeTypeTitle    ChangeType = "title"
+	ChangeTypeStatus    ChangeType = "status"
+	ChangeTypePriority  ChangeType = "priority"
+	ChangeTypeAssignee  ChangeType = "assignee"
+	ChangeTypeDueDate   ChangeType = "due_date"
+	ChangeTypeTitle     ChangeType = "title"
+	ChangeTypeSeverity  ChangeType = "severity"
+	ChangeTypeDuplicate ChangeType = "duplicate"
 
 	// defaultBatchWindow is the default time window for batching changes
 	defaultBatchWindow = 2 * time.Second
@@ -229,6 +231,13 @@ func (cb *ChangeBatcher) formatSingleChange(actorName string, change Change) str
 		return fmt.Sprintf("✅ %sset due date to %s", actorPrefix, change.Value)
 	case ChangeTypeTitle:
 		return fmt.Sprintf("✅ %schanged title to: %s", actorPrefix, change.Value)
+	case ChangeTypeSeverity:
+		return fmt.Sprintf("✅ %sset severity to %s", actorPrefix, change.Value)
+	case ChangeTypeDuplicate:
+		if change.Value == "" {
+			return fmt.Sprintf("✅ %sremoved the duplicate mark", actorPrefix)
+		}
+		return fmt.Sprintf("✅ %smarked this as a duplicate of %s", actorPrefix, change.Value)
 	default:
 		return fmt.Sprintf("✅ %smade a change", actorPrefix)
 	}
@@ -253,6 +262,13 @@ func (cb *ChangeBatcher) formatChangeAction(change Change) string {
 		return fmt.Sprintf("set due date to %s", change.Value)
 	case ChangeTypeTitle:
 		return fmt.Sprintf("changed title to: %s", change.Value)
+	case ChangeTypeSeverity:
+		return fmt.Sprintf("set severity to %s", change.Value)
+	case ChangeTypeDuplicate:
+		if change.Value == "" {
+			return "removed the duplicate mark"
+		}
+		return fmt.Sprintf("marked this as a duplicate of %s", change.Value)
 	default:
 		return "made a change"
 	}
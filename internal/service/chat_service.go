@@ -8,6 +8,7 @@ import (
 	"github.com/lllypuk/flowra/internal/application/appcore"
 	chatapp "github.com/lllypuk/flowra/internal/application/chat"
 	"github.com/lllypuk/flowra/internal/domain/chat"
+	"github.com/lllypuk/flowra/internal/domain/errs"
 	"github.com/lllypuk/flowra/internal/domain/uuid"
 	httphandler "github.com/lllypuk/flowra/internal/handler/http"
 )
@@ -15,6 +16,9 @@ import (
 // Compile-time assertion that ChatService implements httphandler.ChatService.
 var _ httphandler.ChatService = (*ChatService)(nil)
 
+// ErrUnreadCountNotConfigured reports that ChatService was built without an unread count use case.
+var ErrUnreadCountNotConfigured = errors.New("unread count use case is not configured")
+
 // CreateChatUseCase defines interface for use case creating chat.
 type CreateChatUseCase interface {
 	Execute(ctx context.Context, cmd chatapp.CreateChatCommand) (chatapp.Result, error)
@@ -35,6 +39,11 @@ type RenameChatUseCase interface {
 	Execute(ctx context.Context, cmd chatapp.RenameChatCommand) (chatapp.Result, error)
 }
 
+// SetTopicUseCase defines interface for use case changing chat topic.
+type SetTopicUseCase interface {
+	Execute(ctx context.Context, cmd chatapp.SetTopicCommand) (chatapp.Result, error)
+}
+
 // AddParticipantUseCase defines interface for use case adding participant.
 type AddParticipantUseCase interface {
 	Execute(ctx context.Context, cmd chatapp.AddParticipantCommand) (chatapp.Result, error)
@@ -45,6 +54,36 @@ type RemoveParticipantUseCase interface {
 	Execute(ctx context.Context, cmd chatapp.RemoveParticipantCommand) (chatapp.Result, error)
 }
 
+// TransferOwnershipUseCase defines interface for use case transferring chat ownership.
+type TransferOwnershipUseCase interface {
+	Execute(ctx context.Context, cmd chatapp.TransferOwnershipCommand) (chatapp.Result, error)
+}
+
+// ListParticipantsUseCase defines interface for use case listing participants.
+type ListParticipantsUseCase interface {
+	Execute(ctx context.Context, query chatapp.ListParticipantsQuery) (*chatapp.ListParticipantsResult, error)
+}
+
+// MarkChatReadUseCase defines interface for use case marking chat as read.
+type MarkChatReadUseCase interface {
+	Execute(ctx context.Context, cmd chatapp.MarkChatReadCommand) error
+}
+
+// GetUnreadCountUseCase defines interface for use case counting unread messages of a chat.
+type GetUnreadCountUseCase interface {
+	Execute(ctx context.Context, query chatapp.GetUnreadCountQuery) (*chatapp.GetUnreadCountResult, error)
+}
+
+// StarChatUseCase defines interface for use case starring a chat.
+type StarChatUseCase interface {
+	Execute(ctx context.Context, cmd chatapp.StarChatCommand) error
+}
+
+// UnstarChatUseCase defines interface for use case removing the star of a chat.
+type UnstarChatUseCase interface {
+	Execute(ctx context.Context, cmd chatapp.UnstarChatCommand) error
+}
+
 // ChatService realizuet httphandler.ChatService.
 // obedinyaet existing use cases for work s chatami.
 type ChatService struct {
@@ -52,8 +91,15 @@ type ChatService struct {
 	getUC        GetChatUseCase
 	listUC       ListChatsUseCase
 	renameUC     RenameChatUseCase
+	topicUC      SetTopicUseCase
 	addPartUC    AddParticipantUseCase
 	removePartUC RemoveParticipantUseCase
+	listPartUC   ListParticipantsUseCase
+	transferUC   TransferOwnershipUseCase
+	markReadUC   MarkChatReadUseCase
+	unreadUC     GetUnreadCountUseCase
+	starUC       StarChatUseCase
+	unstarUC     UnstarChatUseCase
 	eventStore   appcore.EventStore
 }
 
@@ -63,8 +109,15 @@ type ChatServiceConfig struct {
 	GetUC        GetChatUseCase
 	ListUC       ListChatsUseCase
 	RenameUC     RenameChatUseCase
+	TopicUC      SetTopicUseCase
 	AddPartUC    AddParticipantUseCase
 	RemovePartUC RemoveParticipantUseCase
+	ListPartUC   ListParticipantsUseCase
+	TransferUC   TransferOwnershipUseCase
+	MarkReadUC   MarkChatReadUseCase
+	UnreadUC     GetUnreadCountUseCase
+	StarUC       StarChatUseCase
+	UnstarUC     UnstarChatUseCase
 	EventStore   appcore.EventStore
 }
 
@@ -75,8 +128,15 @@ func NewChatService(cfg ChatServiceConfig) *ChatService {
 		getUC:        cfg.GetUC,
 		listUC:       cfg.ListUC,
 		renameUC:     cfg.RenameUC,
+		topicUC:      cfg.TopicUC,
 		addPartUC:    cfg.AddPartUC,
 		removePartUC: cfg.RemovePartUC,
+		listPartUC:   cfg.ListPartUC,
+		transferUC:   cfg.TransferUC,
+		markReadUC:   cfg.MarkReadUC,
+		unreadUC:     cfg.UnreadUC,
+		starUC:       cfg.StarUC,
+		unstarUC:     cfg.UnstarUC,
 		eventStore:   cfg.EventStore,
 	}
 }
@@ -105,6 +165,42 @@ func (s *ChatService) ListChats(
 	return s.listUC.Execute(ctx, query)
 }
 
+// MarkChatRead resets the user's unread counter of the chat.
+func (s *ChatService) MarkChatRead(ctx context.Context, cmd chatapp.MarkChatReadCommand) error {
+	if s.markReadUC == nil {
+		return nil
+	}
+	return s.markReadUC.Execute(ctx, cmd)
+}
+
+// GetUnreadCount returns the number of chat messages the user has not read.
+// A missing use case is an error rather than a zero count, so it cannot pass for a read chat.
+func (s *ChatService) GetUnreadCount(
+	ctx context.Context,
+	query chatapp.GetUnreadCountQuery,
+) (*chatapp.GetUnreadCountResult, error) {
+	if s.unreadUC == nil {
+		return nil, ErrUnreadCountNotConfigured
+	}
+	return s.unreadUC.Execute(ctx, query)
+}
+
+// StarChat adds the chat to the user's starred chats.
+func (s *ChatService) StarChat(ctx context.Context, cmd chatapp.StarChatCommand) error {
+	if s.starUC == nil {
+		return errs.ErrNotFound
+	}
+	return s.starUC.Execute(ctx, cmd)
+}
+
+// UnstarChat removes the chat from the user's starred chats.
+func (s *ChatService) UnstarChat(ctx context.Context, cmd chatapp.UnstarChatCommand) error {
+	if s.unstarUC == nil {
+		return nil
+	}
+	return s.unstarUC.Execute(ctx, cmd)
+}
+
 // RenameChat pereimenovyvaet chat.
 func (s *ChatService) RenameChat(
 	ctx context.Context,
@@ -113,6 +209,14 @@ func (s *ChatService) RenameChat(
 	return s.renameUC.Execute(ctx, cmd)
 }
 
+// SetTopic changes or clears the chat topic.
+func (s *ChatService) SetTopic(
+	ctx context.Context,
+	cmd chatapp.SetTopicCommand,
+) (chatapp.Result, error) {
+	return s.topicUC.Execute(ctx, cmd)
+}
+
 // AddParticipant adds participant in chat.
 func (s *ChatService) AddParticipant(
 	ctx context.Context,
@@ -121,6 +225,22 @@ func (s *ChatService) AddParticipant(
 	return s.addPartUC.Execute(ctx, cmd)
 }
 
+// TransferOwnership makes another participant the chat owner.
+func (s *ChatService) TransferOwnership(
+	ctx context.Context,
+	cmd chatapp.TransferOwnershipCommand,
+) (chatapp.Result, error) {
+	return s.transferUC.Execute(ctx, cmd)
+}
+
+// ListParticipants returns a page of chat participants.
+func (s *ChatService) ListParticipants(
+	ctx context.Context,
+	query chatapp.ListParticipantsQuery,
+) (*chatapp.ListParticipantsResult, error) {
+	return s.listPartUC.Execute(ctx, query)
+}
+
 // RemoveParticipant udalyaet participant from chat.
 func (s *ChatService) RemoveParticipant(
 	ctx context.Context,
@@ -97,6 +97,34 @@ func (m *mockRemoveParticipantUseCase) Execute(
 	return chatapp.Result{}, nil
 }
 
+type mockTransferOwnershipUseCase struct {
+	executeFunc func(ctx context.Context, cmd chatapp.TransferOwnershipCommand) (chatapp.Result, error)
+}
+
+func (m *mockTransferOwnershipUseCase) Execute(
+	ctx context.Context,
+	cmd chatapp.TransferOwnershipCommand,
+) (chatapp.Result, error) {
+	if m.executeFunc != nil {
+		return m.executeFunc(ctx, cmd)
+	}
+	return chatapp.Result{}, nil
+}
+
+type mockListParticipantsUseCase struct {
+	executeFunc func(ctx context.Context, query chatapp.ListParticipantsQuery) (*chatapp.ListParticipantsResult, error)
+}
+
+func (m *mockListParticipantsUseCase) Execute(
+	ctx context.Context,
+	query chatapp.ListParticipantsQuery,
+) (*chatapp.ListParticipantsResult, error) {
+	if m.executeFunc != nil {
+		return m.executeFunc(ctx, query)
+	}
+	return &chatapp.ListParticipantsResult{}, nil
+}
+
 // Mock event store
 
 type mockEventStore struct {
@@ -161,6 +189,8 @@ func createDefaultServiceConfig() service.ChatServiceConfig {
 		RenameUC:     &mockRenameChatUseCase{},
 		AddPartUC:    &mockAddParticipantUseCase{},
 		RemovePartUC: &mockRemoveParticipantUseCase{},
+		ListPartUC:   &mockListParticipantsUseCase{},
+		TransferUC:   &mockTransferOwnershipUseCase{},
 		EventStore:   newMockEventStore(),
 	}
 }
@@ -627,6 +657,100 @@ func TestChatService_RemoveParticipant(t *testing.T) {
 	})
 }
 
+func TestChatService_TransferOwnership(t *testing.T) {
+	chatID := uuid.NewUUID()
+	newOwnerID := uuid.NewUUID()
+
+	cfg := createDefaultServiceConfig()
+	cfg.TransferUC = &mockTransferOwnershipUseCase{
+		executeFunc: func(_ context.Context, cmd chatapp.TransferOwnershipCommand) (chatapp.Result, error) {
+			assert.Equal(t, chatID, cmd.ChatID)
+			assert.Equal(t, newOwnerID, cmd.NewOwnerID)
+			return chatapp.Result{}, chatapp.ErrNotAdmin
+		},
+	}
+	svc := service.NewChatService(cfg)
+
+	_, err := svc.TransferOwnership(context.Background(), chatapp.TransferOwnershipCommand{
+		ChatID:        chatID,
+		NewOwnerID:    newOwnerID,
+		TransferredBy: uuid.NewUUID(),
+	})
+
+	require.ErrorIs(t, err, chatapp.ErrNotAdmin)
+}
+
+func TestChatService_ListParticipants(t *testing.T) {
+	chatID := uuid.NewUUID()
+	userID := uuid.NewUUID()
+
+	listPartUC := &mockListParticipantsUseCase{
+		executeFunc: func(_ context.Context, query chatapp.ListParticipantsQuery) (*chatapp.ListParticipantsResult, error) {
+			assert.Equal(t, chatID, query.ChatID)
+			assert.Equal(t, "ann", query.Search)
+			assert.Equal(t, 10, query.Limit)
+			return &chatapp.ListParticipantsResult{
+				Participants: []chatapp.Participant{{UserID: userID, Role: chat.RoleMember}},
+				Total:        11,
+				HasMore:      true,
+			}, nil
+		},
+	}
+
+	cfg := createDefaultServiceConfig()
+	cfg.ListPartUC = listPartUC
+	svc := service.NewChatService(cfg)
+
+	result, err := svc.ListParticipants(context.Background(), chatapp.ListParticipantsQuery{
+		ChatID:      chatID,
+		Search:      "ann",
+		Limit:       10,
+		RequestedBy: userID,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, 11, result.Total)
+	assert.True(t, result.HasMore)
+	require.Len(t, result.Participants, 1)
+}
+
+type mockGetUnreadCountUseCase struct {
+	result *chatapp.GetUnreadCountResult
+}
+
+func (m *mockGetUnreadCountUseCase) Execute(
+	_ context.Context,
+	_ chatapp.GetUnreadCountQuery,
+) (*chatapp.GetUnreadCountResult, error) {
+	return m.result, nil
+}
+
+func TestChatService_GetUnreadCount(t *testing.T) {
+	query := chatapp.GetUnreadCountQuery{ChatID: uuid.NewUUID(), RequestedBy: uuid.NewUUID()}
+
+	t.Run("returns the counter of the use case", func(t *testing.T) {
+		cfg := createDefaultServiceConfig()
+		cfg.UnreadUC = &mockGetUnreadCountUseCase{
+			result: &chatapp.GetUnreadCountResult{ChatID: query.ChatID, UnreadCount: 3, MessageCount: 5},
+		}
+		svc := service.NewChatService(cfg)
+
+		result, err := svc.GetUnreadCount(context.Background(), query)
+
+		require.NoError(t, err)
+		assert.Equal(t, 3, result.UnreadCount)
+	})
+
+	t.Run("fails without the use case", func(t *testing.T) {
+		svc := service.NewChatService(createDefaultServiceConfig())
+
+		result, err := svc.GetUnreadCount(context.Background(), query)
+
+		require.ErrorIs(t, err, service.ErrUnreadCountNotConfigured)
+		assert.Nil(t, result)
+	})
+}
+
 func TestChatService_DeleteChat(t *testing.T) {
 	t.Run("successfully delete chat", func(t *testing.T) {
 		chatID := uuid.NewUUID()
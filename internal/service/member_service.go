@@ -3,8 +3,11 @@ package service
 import (
 	"context"
 	"errors"
+	"fmt"
 
+	"github.com/lllypuk/flowra/internal/application/appcore"
 	"github.com/lllypuk/flowra/internal/domain/errs"
+	"github.com/lllypuk/flowra/internal/domain/event"
 	"github.com/lllypuk/flowra/internal/domain/uuid"
 	"github.com/lllypuk/flowra/internal/domain/workspace"
 )
@@ -36,23 +39,58 @@ type MemberQueryRepository interface {
 
 	// CountMembers returns count chlenov workspace
 	CountMembers(ctx context.Context, workspaceID uuid.UUID) (int, error)
+
+	// ListPendingMembers returns chlenstva, ozhidayuschie approval administrator
+	ListPendingMembers(ctx context.Context, workspaceID uuid.UUID) ([]*workspace.Member, error)
+}
+
+// MemberGroupClient adds approved members to the workspace group of the identity provider.
+// interface declared on the consumer side according to principles Go interface design.
+type MemberGroupClient interface {
+	// AddUserToGroup adds user in groups Keycloak
+	AddUserToGroup(ctx context.Context, userID, groupID string) error
+}
+
+// MemberServiceOption customizes MemberService behavior.
+type MemberServiceOption func(*MemberService)
+
+// WithMemberEventBus publishes membership changes so that derived state
+// (e.g. cached UI fragments) can be refreshed.
+func WithMemberEventBus(bus event.Bus) MemberServiceOption {
+	return func(s *MemberService) {
+		s.eventBus = bus
+	}
+}
+
+// WithMemberGroupClient adds approved join requests to the workspace Keycloak group.
+func WithMemberGroupClient(client MemberGroupClient) MemberServiceOption {
+	return func(s *MemberService) {
+		s.groupClient = client
+	}
 }
 
 // MemberService realizuet httphandler.MemberService
 type MemberService struct {
 	commandRepo MemberCommandRepository
 	queryRepo   MemberQueryRepository
+	eventBus    event.Bus
+	groupClient MemberGroupClient
 }
 
 // NewMemberService sozdayot New MemberService.
 func NewMemberService(
 	commandRepo MemberCommandRepository,
 	queryRepo MemberQueryRepository,
+	opts ...MemberServiceOption,
 ) *MemberService {
-	return &MemberService{
+	s := &MemberService{
 		commandRepo: commandRepo,
 		queryRepo:   queryRepo,
 	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
 }
 
 // AddMember adds user in workspace.
@@ -86,9 +124,137 @@ func (s *MemberService) AddMember(
 		return nil, addErr
 	}
 
+	publishEvent(ctx, s.eventBus, workspace.NewMemberAdded(workspaceID, userID, role, serviceEventMetadata(ctx)))
+
+	return &member, nil
+}
+
+// JoinWorkspace creates a join request of the user.
+// Only discoverable workspaces and workspaces with RequiresJoinApproval accept joins;
+// the membership stays pending (and the access checker denies access) until an admin approves it.
+func (s *MemberService) JoinWorkspace(
+	ctx context.Context,
+	workspaceID, userID uuid.UUID,
+) (*workspace.Member, error) {
+	ws, err := s.queryRepo.FindByID(ctx, workspaceID)
+	if err != nil {
+		return nil, err
+	}
+	if ws == nil {
+		return nil, errs.ErrNotFound
+	}
+	if !ws.AcceptsJoinRequests() {
+		// bez approval vstupit mozhno only po priglasheniyu administrator
+		return nil, errs.ErrForbidden
+	}
+
+	existing, err := s.queryRepo.GetMember(ctx, workspaceID, userID)
+	if err != nil && !errors.Is(err, errs.ErrNotFound) {
+		return nil, err
+	}
+	if existing != nil {
+		return nil, errs.ErrAlreadyExists
+	}
+
+	member := workspace.NewPendingMember(userID, workspaceID, workspace.RoleMember)
+
+	if addErr := s.commandRepo.AddMember(ctx, &member); addErr != nil {
+		return nil, addErr
+	}
+
+	publishEvent(ctx, s.eventBus, workspace.NewMemberPending(
+		workspaceID, userID, member.Role(), memberEventMetadata(ctx, userID)))
+
 	return &member, nil
 }
 
+// ApproveMember activates a pending membership.
+func (s *MemberService) ApproveMember(
+	ctx context.Context,
+	workspaceID, userID uuid.UUID,
+) (*workspace.Member, error) {
+	member, err := s.queryRepo.GetMember(ctx, workspaceID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if member == nil {
+		return nil, errs.ErrNotFound
+	}
+
+	approved, err := member.Approve()
+	if err != nil {
+		return nil, err
+	}
+
+	// gruppa Keycloak obnovlyaetsya do aktivatsii, chtoby pri oshibke zayavka ostalas' pending
+	if groupErr := s.addToWorkspaceGroup(ctx, workspaceID, userID); groupErr != nil {
+		return nil, groupErr
+	}
+
+	if updateErr := s.commandRepo.UpdateMember(ctx, &approved); updateErr != nil {
+		return nil, updateErr
+	}
+
+	metadata := serviceEventMetadata(ctx)
+	publishEvent(ctx, s.eventBus, workspace.NewMemberAdded(workspaceID, userID, approved.Role(), metadata))
+	publishEvent(ctx, s.eventBus, workspace.NewMemberApproved(workspaceID, userID, approved.Role(), metadata))
+
+	return &approved, nil
+}
+
+// RejectMember deletes a pending membership.
+func (s *MemberService) RejectMember(
+	ctx context.Context,
+	workspaceID, userID uuid.UUID,
+) error {
+	member, err := s.queryRepo.GetMember(ctx, workspaceID, userID)
+	if err != nil {
+		return err
+	}
+	if member == nil {
+		return errs.ErrNotFound
+	}
+	if !member.IsPending() {
+		return errs.ErrInvalidState
+	}
+
+	if removeErr := s.commandRepo.RemoveMember(ctx, workspaceID, userID); removeErr != nil {
+		return removeErr
+	}
+
+	publishEvent(ctx, s.eventBus, workspace.NewMemberRejected(workspaceID, userID, serviceEventMetadata(ctx)))
+
+	return nil
+}
+
+// addToWorkspaceGroup adds user in groups Keycloak workspace, if gruppa nastroena.
+func (s *MemberService) addToWorkspaceGroup(ctx context.Context, workspaceID, userID uuid.UUID) error {
+	if s.groupClient == nil {
+		return nil
+	}
+
+	ws, err := s.queryRepo.FindByID(ctx, workspaceID)
+	if err != nil {
+		return err
+	}
+	if ws.KeycloakGroupID() == "" {
+		return nil
+	}
+
+	if addErr := s.groupClient.AddUserToGroup(ctx, userID.String(), ws.KeycloakGroupID()); addErr != nil {
+		return fmt.Errorf("add user to Keycloak group: %w", addErr)
+	}
+	return nil
+}
+
+// ListPendingMembers returns chlenstva, ozhidayuschie approval administrator.
+func (s *MemberService) ListPendingMembers(
+	ctx context.Context,
+	workspaceID uuid.UUID,
+) ([]*workspace.Member, error) {
+	return s.queryRepo.ListPendingMembers(ctx, workspaceID)
+}
+
 // RemoveMember udalyaet user from workspace.
 func (s *MemberService) RemoveMember(
 	ctx context.Context,
@@ -108,7 +274,13 @@ func (s *MemberService) RemoveMember(
 		return errs.ErrForbidden
 	}
 
-	return s.commandRepo.RemoveMember(ctx, workspaceID, userID)
+	if removeErr := s.commandRepo.RemoveMember(ctx, workspaceID, userID); removeErr != nil {
+		return removeErr
+	}
+
+	publishEvent(ctx, s.eventBus, workspace.NewMemberRemoved(workspaceID, userID, serviceEventMetadata(ctx)))
+
+	return nil
 }
 
 // UpdateMemberRole obnovlyaet role participant.
@@ -142,15 +314,25 @@ func (s *MemberService) UpdateMemberRole(
 		return nil, updateErr
 	}
 
+	publishEvent(ctx, s.eventBus, workspace.NewMemberRoleChanged(workspaceID, userID, role, serviceEventMetadata(ctx)))
+
 	return &updatedMember, nil
 }
 
 // GetMember returns informatsiyu ob uchastnike.
+// Pending chlenstvo is reported as not found: handlers use GetMember as an access check.
 func (s *MemberService) GetMember(
 	ctx context.Context,
 	workspaceID, userID uuid.UUID,
 ) (*workspace.Member, error) {
-	return s.queryRepo.GetMember(ctx, workspaceID, userID)
+	member, err := s.queryRepo.GetMember(ctx, workspaceID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if member != nil && member.IsPending() {
+		return nil, errs.ErrNotFound
+	}
+	return member, nil
 }
 
 // ListMembers returns list participants workspace.
@@ -190,3 +372,28 @@ func (s *MemberService) IsOwner(
 
 	return member.Role() == workspace.RoleOwner, nil
 }
+
+// publishEvent publishes evt when an event bus is configured. Failures are ignored:
+// the change is already persisted and subscribers only refresh derived state.
+func publishEvent(ctx context.Context, bus event.Bus, evt event.DomainEvent) {
+	if bus == nil {
+		return
+	}
+	_ = bus.Publish(ctx, evt)
+}
+
+// memberEventMetadata builds event metadata for an action the user performs on their own membership.
+func memberEventMetadata(ctx context.Context, userID uuid.UUID) event.Metadata {
+	correlationID, _ := appcore.GetCorrelationID(ctx)
+	return event.NewMetadata(userID.String(), correlationID, "").WithIPAddress(appcore.GetClientIP(ctx))
+}
+
+// serviceEventMetadata builds event metadata from the request context.
+func serviceEventMetadata(ctx context.Context) event.Metadata {
+	var actor string
+	if userID, err := appcore.GetUserID(ctx); err == nil {
+		actor = userID.String()
+	}
+	correlationID, _ := appcore.GetCorrelationID(ctx)
+	return event.NewMetadata(actor, correlationID, "").WithIPAddress(appcore.GetClientIP(ctx))
+}
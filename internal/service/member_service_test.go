@@ -2,12 +2,14 @@ package service_test
 
 import (
 	"context"
+	"errors"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	"github.com/lllypuk/flowra/internal/domain/errs"
+	"github.com/lllypuk/flowra/internal/domain/event"
 	"github.com/lllypuk/flowra/internal/domain/uuid"
 	"github.com/lllypuk/flowra/internal/domain/workspace"
 	"github.com/lllypuk/flowra/internal/service"
@@ -50,6 +52,7 @@ type mockMemberQueryRepository struct {
 	getMemberFunc    func(ctx context.Context, workspaceID, userID uuid.UUID) (*workspace.Member, error)
 	listMembersFunc  func(ctx context.Context, workspaceID uuid.UUID, offset, limit int) ([]*workspace.Member, error)
 	countMembersFunc func(ctx context.Context, workspaceID uuid.UUID) (int, error)
+	listPendingFunc  func(ctx context.Context, workspaceID uuid.UUID) ([]*workspace.Member, error)
 }
 
 func (m *mockMemberQueryRepository) FindByID(ctx context.Context, id uuid.UUID) (*workspace.Workspace, error) {
@@ -87,6 +90,16 @@ func (m *mockMemberQueryRepository) CountMembers(ctx context.Context, workspaceI
 	return 0, nil
 }
 
+func (m *mockMemberQueryRepository) ListPendingMembers(
+	ctx context.Context,
+	workspaceID uuid.UUID,
+) ([]*workspace.Member, error) {
+	if m.listPendingFunc != nil {
+		return m.listPendingFunc(ctx, workspaceID)
+	}
+	return []*workspace.Member{}, nil
+}
+
 // createMemberTestWorkspace creates a test workspace for member service testing
 func createMemberTestWorkspace(ownerID uuid.UUID, name string) *workspace.Workspace {
 	ws, _ := workspace.NewWorkspace(name, "", "keycloak-group-id", ownerID)
@@ -535,6 +548,21 @@ func TestMemberService_GetMember(t *testing.T) {
 		assert.Equal(t, workspace.RoleMember, member.Role())
 	})
 
+	t.Run("pending member is not found", func(t *testing.T) {
+		pending := workspace.NewPendingMember(uuid.NewUUID(), uuid.NewUUID(), workspace.RoleMember)
+		queryRepo := &mockMemberQueryRepository{
+			getMemberFunc: func(_ context.Context, _, _ uuid.UUID) (*workspace.Member, error) {
+				return &pending, nil
+			},
+		}
+		svc := service.NewMemberService(&mockMemberCommandRepository{}, queryRepo)
+
+		member, err := svc.GetMember(context.Background(), pending.WorkspaceID(), pending.UserID())
+
+		require.ErrorIs(t, err, errs.ErrNotFound)
+		assert.Nil(t, member)
+	})
+
 	t.Run("member not found", func(t *testing.T) {
 		workspaceID := uuid.NewUUID()
 		userID := uuid.NewUUID()
@@ -800,3 +828,307 @@ func TestMemberService_IsOwner(t *testing.T) {
 		assert.False(t, isOwner)
 	})
 }
+
+// recordingEventBus captures published events.
+type recordingEventBus struct {
+	events []event.DomainEvent
+}
+
+func (b *recordingEventBus) Publish(_ context.Context, evt event.DomainEvent) error {
+	b.events = append(b.events, evt)
+	return nil
+}
+
+func TestMemberService_PublishesEvents(t *testing.T) {
+	workspaceID := uuid.NewUUID()
+	userID := uuid.NewUUID()
+	ws := createMemberTestWorkspace(uuid.NewUUID(), "Test Workspace")
+	member := workspace.NewMember(userID, workspaceID, workspace.RoleMember)
+
+	var stored *workspace.Member
+	queryRepo := &mockMemberQueryRepository{
+		findByIDFunc: func(_ context.Context, _ uuid.UUID) (*workspace.Workspace, error) {
+			return ws, nil
+		},
+		getMemberFunc: func(_ context.Context, _, _ uuid.UUID) (*workspace.Member, error) {
+			if stored == nil {
+				return nil, errs.ErrNotFound
+			}
+			return stored, nil
+		},
+	}
+
+	bus := &recordingEventBus{}
+	svc := service.NewMemberService(&mockMemberCommandRepository{}, queryRepo, service.WithMemberEventBus(bus))
+	ctx := context.Background()
+
+	_, err := svc.AddMember(ctx, workspaceID, userID, workspace.RoleMember)
+	require.NoError(t, err)
+
+	stored = &member
+	_, err = svc.UpdateMemberRole(ctx, workspaceID, userID, workspace.RoleAdmin)
+	require.NoError(t, err)
+	require.NoError(t, svc.RemoveMember(ctx, workspaceID, userID))
+
+	require.Len(t, bus.events, 3)
+	assert.Equal(t, workspace.EventTypeMemberAdded, bus.events[0].EventType())
+	assert.Equal(t, workspace.EventTypeMemberRoleChanged, bus.events[1].EventType())
+	assert.Equal(t, workspace.EventTypeMemberRemoved, bus.events[2].EventType())
+
+	removed, ok := bus.events[2].(*workspace.MemberRemoved)
+	require.True(t, ok)
+	assert.Equal(t, workspaceID.String(), removed.AggregateID())
+	assert.Equal(t, userID, removed.UserID)
+}
+
+func TestMemberService_JoinWorkspace(t *testing.T) {
+	t.Run("restricted workspace creates pending membership", func(t *testing.T) {
+		workspaceID := uuid.NewUUID()
+		userID := uuid.NewUUID()
+		ws := createMemberTestWorkspace(uuid.NewUUID(), "Restricted")
+		ws.SetRequireJoinApproval(true)
+
+		queryRepo := &mockMemberQueryRepository{
+			findByIDFunc: func(_ context.Context, _ uuid.UUID) (*workspace.Workspace, error) {
+				return ws, nil
+			},
+		}
+		var saved *workspace.Member
+		commandRepo := &mockMemberCommandRepository{
+			addMemberFunc: func(_ context.Context, member *workspace.Member) error {
+				saved = member
+				return nil
+			},
+		}
+		bus := &recordingEventBus{}
+		svc := service.NewMemberService(commandRepo, queryRepo, service.WithMemberEventBus(bus))
+
+		member, err := svc.JoinWorkspace(context.Background(), workspaceID, userID)
+
+		require.NoError(t, err)
+		assert.True(t, member.IsPending())
+		assert.Equal(t, workspace.RoleMember, member.Role())
+		require.NotNil(t, saved)
+		assert.True(t, saved.IsPending())
+		require.Len(t, bus.events, 1)
+		pending, ok := bus.events[0].(*workspace.MemberPending)
+		require.True(t, ok)
+		assert.Equal(t, userID, pending.UserID)
+		assert.Equal(t, userID.String(), pending.Metadata().UserID)
+	})
+
+	t.Run("discoverable workspace creates pending membership", func(t *testing.T) {
+		ws := createMemberTestWorkspace(uuid.NewUUID(), "Discoverable")
+		ws.SetDiscoverable(true)
+		queryRepo := &mockMemberQueryRepository{
+			findByIDFunc: func(_ context.Context, _ uuid.UUID) (*workspace.Workspace, error) {
+				return ws, nil
+			},
+			getMemberFunc: func(_ context.Context, _, _ uuid.UUID) (*workspace.Member, error) {
+				return nil, errs.ErrNotFound
+			},
+		}
+		svc := service.NewMemberService(&mockMemberCommandRepository{}, queryRepo)
+
+		member, err := svc.JoinWorkspace(context.Background(), ws.ID(), uuid.NewUUID())
+
+		require.NoError(t, err)
+		assert.True(t, member.IsPending())
+	})
+
+	t.Run("invite-only workspace rejects joins", func(t *testing.T) {
+		ws := createMemberTestWorkspace(uuid.NewUUID(), "Invite only")
+		queryRepo := &mockMemberQueryRepository{
+			findByIDFunc: func(_ context.Context, _ uuid.UUID) (*workspace.Workspace, error) {
+				return ws, nil
+			},
+		}
+		svc := service.NewMemberService(&mockMemberCommandRepository{}, queryRepo)
+
+		_, err := svc.JoinWorkspace(context.Background(), uuid.NewUUID(), uuid.NewUUID())
+
+		require.ErrorIs(t, err, errs.ErrForbidden)
+	})
+
+	t.Run("existing pending membership", func(t *testing.T) {
+		workspaceID := uuid.NewUUID()
+		userID := uuid.NewUUID()
+		ws := createMemberTestWorkspace(uuid.NewUUID(), "Restricted")
+		ws.SetRequireJoinApproval(true)
+		existing := workspace.NewPendingMember(userID, workspaceID, workspace.RoleMember)
+
+		queryRepo := &mockMemberQueryRepository{
+			findByIDFunc: func(_ context.Context, _ uuid.UUID) (*workspace.Workspace, error) {
+				return ws, nil
+			},
+			getMemberFunc: func(_ context.Context, _, _ uuid.UUID) (*workspace.Member, error) {
+				return &existing, nil
+			},
+		}
+		svc := service.NewMemberService(&mockMemberCommandRepository{}, queryRepo)
+
+		_, err := svc.JoinWorkspace(context.Background(), workspaceID, userID)
+
+		require.ErrorIs(t, err, errs.ErrAlreadyExists)
+	})
+}
+
+type recordingGroupClient struct {
+	added []string
+	err   error
+}
+
+func (c *recordingGroupClient) AddUserToGroup(_ context.Context, userID, groupID string) error {
+	if c.err != nil {
+		return c.err
+	}
+	c.added = append(c.added, userID+":"+groupID)
+	return nil
+}
+
+func TestMemberService_ApproveMember(t *testing.T) {
+	t.Run("activates pending member and publishes member added", func(t *testing.T) {
+		workspaceID := uuid.NewUUID()
+		userID := uuid.NewUUID()
+		pending := workspace.NewPendingMember(userID, workspaceID, workspace.RoleMember)
+
+		queryRepo := &mockMemberQueryRepository{
+			getMemberFunc: func(_ context.Context, _, _ uuid.UUID) (*workspace.Member, error) {
+				return &pending, nil
+			},
+		}
+		var updated *workspace.Member
+		commandRepo := &mockMemberCommandRepository{
+			updateMemberFunc: func(_ context.Context, member *workspace.Member) error {
+				updated = member
+				return nil
+			},
+		}
+		bus := &recordingEventBus{}
+		svc := service.NewMemberService(commandRepo, queryRepo, service.WithMemberEventBus(bus))
+
+		member, err := svc.ApproveMember(context.Background(), workspaceID, userID)
+
+		require.NoError(t, err)
+		assert.False(t, member.IsPending())
+		require.NotNil(t, updated)
+		assert.False(t, updated.IsPending())
+		require.Len(t, bus.events, 2)
+		assert.Equal(t, workspace.EventTypeMemberAdded, bus.events[0].EventType())
+		assert.Equal(t, workspace.EventTypeMemberApproved, bus.events[1].EventType())
+	})
+
+	t.Run("adds approved member to Keycloak group", func(t *testing.T) {
+		userID := uuid.NewUUID()
+		ws, err := workspace.NewWorkspace("Workspace", "", "group-1", uuid.NewUUID())
+		require.NoError(t, err)
+		pending := workspace.NewPendingMember(userID, ws.ID(), workspace.RoleMember)
+
+		queryRepo := &mockMemberQueryRepository{
+			findByIDFunc: func(_ context.Context, _ uuid.UUID) (*workspace.Workspace, error) {
+				return ws, nil
+			},
+			getMemberFunc: func(_ context.Context, _, _ uuid.UUID) (*workspace.Member, error) {
+				return &pending, nil
+			},
+		}
+		groups := &recordingGroupClient{}
+		svc := service.NewMemberService(
+			&mockMemberCommandRepository{},
+			queryRepo,
+			service.WithMemberGroupClient(groups),
+		)
+
+		_, err = svc.ApproveMember(context.Background(), ws.ID(), userID)
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{userID.String() + ":group-1"}, groups.added)
+	})
+
+	t.Run("Keycloak failure keeps member pending", func(t *testing.T) {
+		userID := uuid.NewUUID()
+		ws, err := workspace.NewWorkspace("Workspace", "", "group-1", uuid.NewUUID())
+		require.NoError(t, err)
+		pending := workspace.NewPendingMember(userID, ws.ID(), workspace.RoleMember)
+
+		queryRepo := &mockMemberQueryRepository{
+			findByIDFunc: func(_ context.Context, _ uuid.UUID) (*workspace.Workspace, error) {
+				return ws, nil
+			},
+			getMemberFunc: func(_ context.Context, _, _ uuid.UUID) (*workspace.Member, error) {
+				return &pending, nil
+			},
+		}
+		updated := false
+		commandRepo := &mockMemberCommandRepository{
+			updateMemberFunc: func(_ context.Context, _ *workspace.Member) error {
+				updated = true
+				return nil
+			},
+		}
+		groups := &recordingGroupClient{err: errors.New("keycloak unavailable")}
+		svc := service.NewMemberService(commandRepo, queryRepo, service.WithMemberGroupClient(groups))
+
+		_, err = svc.ApproveMember(context.Background(), ws.ID(), userID)
+
+		require.Error(t, err)
+		assert.False(t, updated)
+	})
+
+	t.Run("active member cannot be approved", func(t *testing.T) {
+		member := workspace.NewMember(uuid.NewUUID(), uuid.NewUUID(), workspace.RoleMember)
+		queryRepo := &mockMemberQueryRepository{
+			getMemberFunc: func(_ context.Context, _, _ uuid.UUID) (*workspace.Member, error) {
+				return &member, nil
+			},
+		}
+		svc := service.NewMemberService(&mockMemberCommandRepository{}, queryRepo)
+
+		_, err := svc.ApproveMember(context.Background(), member.WorkspaceID(), member.UserID())
+
+		require.ErrorIs(t, err, errs.ErrInvalidState)
+	})
+}
+
+func TestMemberService_RejectMember(t *testing.T) {
+	t.Run("removes pending member and publishes rejection", func(t *testing.T) {
+		workspaceID := uuid.NewUUID()
+		userID := uuid.NewUUID()
+		pending := workspace.NewPendingMember(userID, workspaceID, workspace.RoleMember)
+
+		queryRepo := &mockMemberQueryRepository{
+			getMemberFunc: func(_ context.Context, _, _ uuid.UUID) (*workspace.Member, error) {
+				return &pending, nil
+			},
+		}
+		removed := false
+		commandRepo := &mockMemberCommandRepository{
+			removeMemberFunc: func(_ context.Context, _, _ uuid.UUID) error {
+				removed = true
+				return nil
+			},
+		}
+		bus := &recordingEventBus{}
+		svc := service.NewMemberService(commandRepo, queryRepo, service.WithMemberEventBus(bus))
+
+		require.NoError(t, svc.RejectMember(context.Background(), workspaceID, userID))
+
+		assert.True(t, removed)
+		require.Len(t, bus.events, 1)
+		assert.Equal(t, workspace.EventTypeMemberRejected, bus.events[0].EventType())
+	})
+
+	t.Run("active member cannot be rejected", func(t *testing.T) {
+		member := workspace.NewMember(uuid.NewUUID(), uuid.NewUUID(), workspace.RoleMember)
+		queryRepo := &mockMemberQueryRepository{
+			getMemberFunc: func(_ context.Context, _, _ uuid.UUID) (*workspace.Member, error) {
+				return &member, nil
+			},
+		}
+		svc := service.NewMemberService(&mockMemberCommandRepository{}, queryRepo)
+
+		err := svc.RejectMember(context.Background(), member.WorkspaceID(), member.UserID())
+
+		require.ErrorIs(t, err, errs.ErrInvalidState)
+	})
+}
@@ -19,6 +19,12 @@ type MessageService struct {
 	addReactionUC    *messageapp.AddReactionUseCase
 	removeReactionUC *messageapp.RemoveReactionUseCase
 	addAttachmentUC  *messageapp.AddAttachmentUseCase
+	forwardMessageUC *messageapp.ForwardMessageUseCase
+	undoChangeUC     *messageapp.UndoMessageChangeUseCase
+	createPollUC     *messageapp.CreatePollUseCase
+	votePollUC       *messageapp.VotePollUseCase
+	retractVoteUC    *messageapp.RetractPollVoteUseCase
+	closePollUC      *messageapp.ClosePollUseCase
 }
 
 // MessageServiceOption configures the MessageService.
@@ -80,6 +86,35 @@ func WithAddAttachmentUseCase(uc *messageapp.AddAttachmentUseCase) MessageServic
 	}
 }
 
+// WithForwardMessageUseCase sets the forward message use case.
+func WithForwardMessageUseCase(uc *messageapp.ForwardMessageUseCase) MessageServiceOption {
+	return func(s *MessageService) {
+		s.forwardMessageUC = uc
+	}
+}
+
+// WithUndoMessageChangeUseCase sets the undo message change use case.
+func WithUndoMessageChangeUseCase(uc *messageapp.UndoMessageChangeUseCase) MessageServiceOption {
+	return func(s *MessageService) {
+		s.undoChangeUC = uc
+	}
+}
+
+// WithPollUseCases sets the poll use cases.
+func WithPollUseCases(
+	create *messageapp.CreatePollUseCase,
+	vote *messageapp.VotePollUseCase,
+	retract *messageapp.RetractPollVoteUseCase,
+	closePoll *messageapp.ClosePollUseCase,
+) MessageServiceOption {
+	return func(s *MessageService) {
+		s.createPollUC = create
+		s.votePollUC = vote
+		s.retractVoteUC = retract
+		s.closePollUC = closePoll
+	}
+}
+
 // NewMessageService creates a new MessageService.
 func NewMessageService(opts ...MessageServiceOption) *MessageService {
 	s := &MessageService{}
@@ -180,3 +215,69 @@ func (s *MessageService) AddAttachment(
 	}
 	return s.addAttachmentUC.Execute(ctx, cmd)
 }
+
+// ForwardMessage copies a message into another chat.
+func (s *MessageService) ForwardMessage(
+	ctx context.Context,
+	cmd messageapp.ForwardMessageCommand,
+) (messageapp.Result, error) {
+	if s.forwardMessageUC == nil {
+		return messageapp.Result{}, messageapp.ErrMessageNotFound
+	}
+	return s.forwardMessageUC.Execute(ctx, cmd)
+}
+
+// UndoMessageChange undoes the latest delete or edit of a message.
+func (s *MessageService) UndoMessageChange(
+	ctx context.Context,
+	cmd messageapp.UndoMessageChangeCommand,
+) (messageapp.Result, error) {
+	if s.undoChangeUC == nil {
+		return messageapp.Result{}, messageapp.ErrMessageNotFound
+	}
+	return s.undoChangeUC.Execute(ctx, cmd)
+}
+
+// CreatePoll posts a poll message.
+func (s *MessageService) CreatePoll(
+	ctx context.Context,
+	cmd messageapp.CreatePollCommand,
+) (messageapp.Result, error) {
+	if s.createPollUC == nil {
+		return messageapp.Result{}, messageapp.ErrChatNotFound
+	}
+	return s.createPollUC.Execute(ctx, cmd)
+}
+
+// VotePoll records a vote in a poll.
+func (s *MessageService) VotePoll(
+	ctx context.Context,
+	cmd messageapp.VotePollCommand,
+) (messageapp.Result, error) {
+	if s.votePollUC == nil {
+		return messageapp.Result{}, messageapp.ErrMessageNotFound
+	}
+	return s.votePollUC.Execute(ctx, cmd)
+}
+
+// RetractPollVote removes the vote of the user from a poll.
+func (s *MessageService) RetractPollVote(
+	ctx context.Context,
+	cmd messageapp.RetractPollVoteCommand,
+) (messageapp.Result, error) {
+	if s.retractVoteUC == nil {
+		return messageapp.Result{}, messageapp.ErrMessageNotFound
+	}
+	return s.retractVoteUC.Execute(ctx, cmd)
+}
+
+// ClosePoll stops the voting in a poll.
+func (s *MessageService) ClosePoll(
+	ctx context.Context,
+	cmd messageapp.ClosePollCommand,
+) (messageapp.Result, error) {
+	if s.closePollUC == nil {
+		return messageapp.Result{}, messageapp.ErrMessageNotFound
+	}
+	return s.closePollUC.Execute(ctx, cmd)
+}
@@ -0,0 +1,112 @@
+package service
+
+import (
+	"context"
+
+	"github.com/lllypuk/flowra/internal/domain/event"
+	"github.com/lllypuk/flowra/internal/domain/uuid"
+	"github.com/lllypuk/flowra/internal/domain/workspace"
+	httphandler "github.com/lllypuk/flowra/internal/handler/http"
+)
+
+// storageQuotaPercentScale converts the warning percentage to a ratio.
+const storageQuotaPercentScale = 100
+
+// WorkspaceStorageRepository counts the attachment bytes stored per workspace.
+// interface declared on the consumer side according to principles Go interface design.
+type WorkspaceStorageRepository interface {
+	// GetUsage returns the attachment bytes stored by the workspace.
+	GetUsage(ctx context.Context, workspaceID uuid.UUID) (int64, error)
+
+	// AddUsage adds delta bytes to the usage of the workspace and returns the new total.
+	AddUsage(ctx context.Context, workspaceID uuid.UUID, delta int64) (int64, error)
+}
+
+// StorageQuotaServiceOption customizes StorageQuotaService behavior.
+type StorageQuotaServiceOption func(*StorageQuotaService)
+
+// WithStorageQuotaEventBus publishes a warning when a workspace nears its quota,
+// so that its admins can be notified.
+func WithStorageQuotaEventBus(bus event.Bus) StorageQuotaServiceOption {
+	return func(s *StorageQuotaService) {
+		s.eventBus = bus
+	}
+}
+
+// WithStorageQuotaWarnPercent sets the share of the quota at which the warning is
+// published. Zero disables the warning.
+func WithStorageQuotaWarnPercent(percent int) StorageQuotaServiceOption {
+	return func(s *StorageQuotaService) {
+		s.warnPercent = max(percent, 0)
+	}
+}
+
+// StorageQuotaService realizuet httphandler.WorkspaceStorageQuota
+type StorageQuotaService struct {
+	repo        WorkspaceStorageRepository
+	quota       int64
+	warnPercent int
+	eventBus    event.Bus
+}
+
+// NewStorageQuotaService creates a storage quota service. A quota of zero or less
+// only tracks usage without limiting uploads.
+func NewStorageQuotaService(
+	repo WorkspaceStorageRepository,
+	quota int64,
+	opts ...StorageQuotaServiceOption,
+) *StorageQuotaService {
+	s := &StorageQuotaService{
+		repo:  repo,
+		quota: max(quota, 0),
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// GetUsage returns the attachment storage used by the workspace.
+func (s *StorageQuotaService) GetUsage(ctx context.Context, workspaceID uuid.UUID) (httphandler.StorageUsage, error) {
+	used, err := s.repo.GetUsage(ctx, workspaceID)
+	if err != nil {
+		return httphandler.StorageUsage{}, err
+	}
+	return httphandler.StorageUsage{UsedBytes: used, QuotaBytes: s.quota}, nil
+}
+
+// CheckUpload returns httphandler.ErrStorageQuotaExceeded together with the current
+// usage when size more bytes do not fit the quota of the workspace.
+func (s *StorageQuotaService) CheckUpload(
+	ctx context.Context,
+	workspaceID uuid.UUID,
+	size int64,
+) (httphandler.StorageUsage, error) {
+	usage, err := s.GetUsage(ctx, workspaceID)
+	if err != nil {
+		return usage, err
+	}
+	if s.quota > 0 && usage.UsedBytes+size > s.quota {
+		return usage, httphandler.ErrStorageQuotaExceeded
+	}
+	return usage, nil
+}
+
+// RecordUpload adds an uploaded file to the usage of the workspace and publishes
+// workspace.StorageQuotaWarning when the upload crosses the warning threshold.
+func (s *StorageQuotaService) RecordUpload(ctx context.Context, workspaceID uuid.UUID, size int64) error {
+	used, err := s.repo.AddUsage(ctx, workspaceID, size)
+	if err != nil {
+		return err
+	}
+
+	if s.quota <= 0 || s.warnPercent <= 0 {
+		return nil
+	}
+	threshold := s.quota * int64(s.warnPercent) / storageQuotaPercentScale
+	if used-size < threshold && used >= threshold {
+		publishEvent(ctx, s.eventBus, workspace.NewStorageQuotaWarning(
+			workspaceID, used, s.quota, serviceEventMetadata(ctx)))
+	}
+	return nil
+}
@@ -0,0 +1,126 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lllypuk/flowra/internal/domain/uuid"
+	"github.com/lllypuk/flowra/internal/domain/workspace"
+	httphandler "github.com/lllypuk/flowra/internal/handler/http"
+	"github.com/lllypuk/flowra/internal/service"
+)
+
+// memoryStorageRepository keeps storage usage in memory
+type memoryStorageRepository struct {
+	used map[uuid.UUID]int64
+	err  error
+}
+
+func newMemoryStorageRepository() *memoryStorageRepository {
+	return &memoryStorageRepository{used: make(map[uuid.UUID]int64)}
+}
+
+func (r *memoryStorageRepository) GetUsage(_ context.Context, workspaceID uuid.UUID) (int64, error) {
+	return r.used[workspaceID], r.err
+}
+
+func (r *memoryStorageRepository) AddUsage(_ context.Context, workspaceID uuid.UUID, delta int64) (int64, error) {
+	if r.err != nil {
+		return 0, r.err
+	}
+	r.used[workspaceID] += delta
+	return r.used[workspaceID], nil
+}
+
+func TestStorageQuotaService_CheckUpload(t *testing.T) {
+	ctx := context.Background()
+	workspaceID := uuid.NewUUID()
+
+	t.Run("accepts uploads that fit the quota", func(t *testing.T) {
+		repo := newMemoryStorageRepository()
+		repo.used[workspaceID] = 600
+		svc := service.NewStorageQuotaService(repo, 1000)
+
+		usage, err := svc.CheckUpload(ctx, workspaceID, 400)
+		require.NoError(t, err)
+		assert.Equal(t, httphandler.StorageUsage{UsedBytes: 600, QuotaBytes: 1000}, usage)
+	})
+
+	t.Run("rejects uploads beyond the quota", func(t *testing.T) {
+		repo := newMemoryStorageRepository()
+		repo.used[workspaceID] = 600
+		svc := service.NewStorageQuotaService(repo, 1000)
+
+		usage, err := svc.CheckUpload(ctx, workspaceID, 401)
+		require.ErrorIs(t, err, httphandler.ErrStorageQuotaExceeded)
+		assert.Equal(t, int64(600), usage.UsedBytes)
+	})
+
+	t.Run("zero quota is unlimited", func(t *testing.T) {
+		repo := newMemoryStorageRepository()
+		repo.used[workspaceID] = 1 << 40
+		svc := service.NewStorageQuotaService(repo, 0)
+
+		_, err := svc.CheckUpload(ctx, workspaceID, 1<<30)
+		require.NoError(t, err)
+	})
+
+	t.Run("propagates repository errors", func(t *testing.T) {
+		repo := newMemoryStorageRepository()
+		repo.err = errors.New("mongo down")
+		svc := service.NewStorageQuotaService(repo, 1000)
+
+		_, err := svc.CheckUpload(ctx, workspaceID, 1)
+		require.Error(t, err)
+		assert.NotErrorIs(t, err, httphandler.ErrStorageQuotaExceeded)
+	})
+}
+
+func TestStorageQuotaService_RecordUpload(t *testing.T) {
+	ctx := context.Background()
+	workspaceID := uuid.NewUUID()
+
+	t.Run("warns once when crossing the threshold", func(t *testing.T) {
+		repo := newMemoryStorageRepository()
+		bus := &recordingEventBus{}
+		svc := service.NewStorageQuotaService(repo, 1000,
+			service.WithStorageQuotaEventBus(bus), service.WithStorageQuotaWarnPercent(90))
+
+		require.NoError(t, svc.RecordUpload(ctx, workspaceID, 800))
+		assert.Empty(t, bus.events)
+
+		require.NoError(t, svc.RecordUpload(ctx, workspaceID, 150))
+		require.Len(t, bus.events, 1)
+		warning, ok := bus.events[0].(*workspace.StorageQuotaWarning)
+		require.True(t, ok)
+		assert.Equal(t, workspaceID.String(), warning.AggregateID())
+		assert.Equal(t, int64(950), warning.UsedBytes)
+		assert.Equal(t, int64(1000), warning.QuotaBytes)
+
+		require.NoError(t, svc.RecordUpload(ctx, workspaceID, 10))
+		assert.Len(t, bus.events, 1, "uploads past the threshold do not warn again")
+	})
+
+	t.Run("does not warn without a quota", func(t *testing.T) {
+		bus := &recordingEventBus{}
+		svc := service.NewStorageQuotaService(newMemoryStorageRepository(), 0,
+			service.WithStorageQuotaEventBus(bus), service.WithStorageQuotaWarnPercent(90))
+
+		require.NoError(t, svc.RecordUpload(ctx, workspaceID, 1<<30))
+		assert.Empty(t, bus.events)
+	})
+
+	t.Run("tracks usage", func(t *testing.T) {
+		repo := newMemoryStorageRepository()
+		svc := service.NewStorageQuotaService(repo, 1000)
+
+		require.NoError(t, svc.RecordUpload(ctx, workspaceID, 300))
+		usage, err := svc.GetUsage(ctx, workspaceID)
+		require.NoError(t, err)
+		assert.Equal(t, int64(300), usage.UsedBytes)
+	})
+}
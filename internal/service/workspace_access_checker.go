@@ -59,6 +59,11 @@ func (c *RealWorkspaceAccessChecker) GetMembership(
 		return nil, err
 	}
 
+	// pending chlenstvo not daet dostupa until an admin approves it
+	if member.IsPending() {
+		return nil, nil
+	}
+
 	return &middleware.WorkspaceMembership{
 		WorkspaceID:   workspaceID,
 		WorkspaceName: ws.Name(),
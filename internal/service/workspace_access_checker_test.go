@@ -80,7 +80,7 @@ func createTestWorkspace(t *testing.T, name string, createdBy uuid.UUID) *worksp
 
 // Helper to create test member
 func createTestMember(workspaceID, userID uuid.UUID, role workspace.Role) *workspace.Member {
-	member := workspace.ReconstructMember(userID, workspaceID, role, time.Now())
+	member := workspace.ReconstructMember(userID, workspaceID, role, time.Now(), workspace.MemberStatusActive)
 	return &member
 }
 
@@ -107,6 +107,23 @@ func TestRealWorkspaceAccessChecker_GetMembership(t *testing.T) {
 		assert.Equal(t, "Test Workspace", membership.WorkspaceName)
 	})
 
+	t.Run("pending member - returns nil until approved", func(t *testing.T) {
+		repo := newMockWorkspaceQueryRepository()
+		checker := service.NewRealWorkspaceAccessChecker(repo)
+
+		userID := uuid.NewUUID()
+		ws := createTestWorkspace(t, "Restricted Workspace", uuid.NewUUID())
+		pending := workspace.NewPendingMember(userID, ws.ID(), workspace.RoleMember)
+
+		repo.addWorkspace(ws)
+		repo.addMember(&pending)
+
+		membership, err := checker.GetMembership(context.Background(), ws.ID(), userID)
+
+		require.NoError(t, err)
+		assert.Nil(t, membership)
+	})
+
 	t.Run("user is owner - returns membership with owner role", func(t *testing.T) {
 		repo := newMockWorkspaceQueryRepository()
 		checker := service.NewRealWorkspaceAccessChecker(repo)
@@ -4,6 +4,8 @@ import (
 	"context"
 
 	wsapp "github.com/lllypuk/flowra/internal/application/workspace"
+	"github.com/lllypuk/flowra/internal/domain/chat"
+	"github.com/lllypuk/flowra/internal/domain/event"
 	"github.com/lllypuk/flowra/internal/domain/uuid"
 	"github.com/lllypuk/flowra/internal/domain/workspace"
 	httphandler "github.com/lllypuk/flowra/internal/handler/http"
@@ -39,6 +41,12 @@ type WorkspaceServiceQueryRepository interface {
 
 	// CountMembers returns count chlenov workspace
 	CountMembers(ctx context.Context, workspaceID uuid.UUID) (int, error)
+
+	// ListDiscoverable returns discoverable workspaces, optionally filtered po imeni
+	ListDiscoverable(ctx context.Context, query string, offset, limit int) ([]*workspace.Workspace, error)
+
+	// CountDiscoverable returns count discoverable workspaces, sovpadayuschih s query
+	CountDiscoverable(ctx context.Context, query string) (int, error)
 }
 
 // CreateWorkspaceUseCase defines interface for use case creating workspace.
@@ -56,16 +64,55 @@ type UpdateWorkspaceUseCase interface {
 	Execute(ctx context.Context, cmd wsapp.UpdateWorkspaceCommand) (wsapp.Result, error)
 }
 
+// UpdateValuePolicyUseCase defines interface for use case updating allowed priorities/severities.
+type UpdateValuePolicyUseCase interface {
+	Execute(ctx context.Context, cmd wsapp.UpdateValuePolicyCommand) (wsapp.Result, error)
+}
+
+// UpdateSLAPolicyUseCase defines interface for use case updating the SLA targets of bugs.
+type UpdateSLAPolicyUseCase interface {
+	Execute(ctx context.Context, cmd wsapp.UpdateSLAPolicyCommand) (wsapp.Result, error)
+}
+
+// UpdateAnalyticsOptOutUseCase defines interface for use case toggling the product analytics opt-out.
+type UpdateAnalyticsOptOutUseCase interface {
+	Execute(ctx context.Context, cmd wsapp.UpdateAnalyticsOptOutCommand) (wsapp.Result, error)
+}
+
+// UpdateJoinApprovalUseCase defines interface for use case toggling admin approval for new members.
+type UpdateJoinApprovalUseCase interface {
+	Execute(ctx context.Context, cmd wsapp.UpdateJoinApprovalCommand) (wsapp.Result, error)
+}
+
+// UpdateDiscoverabilityUseCase defines interface for use case listing the workspace in the discovery directory.
+type UpdateDiscoverabilityUseCase interface {
+	Execute(ctx context.Context, cmd wsapp.UpdateDiscoverabilityCommand) (wsapp.Result, error)
+}
+
+// UpdateBrandingUseCase defines interface for use case updating the workspace avatar and accent color.
+type UpdateBrandingUseCase interface {
+	Execute(ctx context.Context, cmd wsapp.UpdateBrandingCommand) (wsapp.Result, error)
+}
+
 // WorkspaceService realizuet httphandler.WorkspaceService
 type WorkspaceService struct {
 	// Use cases
 	createUC CreateWorkspaceUseCase
 	getUC    GetWorkspaceUseCase
 	updateUC UpdateWorkspaceUseCase
+	policyUC UpdateValuePolicyUseCase
+	slaUC    UpdateSLAPolicyUseCase
+	optOutUC UpdateAnalyticsOptOutUseCase
+	joinUC   UpdateJoinApprovalUseCase
+	discoUC  UpdateDiscoverabilityUseCase
+	brandUC  UpdateBrandingUseCase
 
 	// Repositories (for operatsiy bez use case)
 	commandRepo WorkspaceServiceCommandRepository
 	queryRepo   WorkspaceServiceQueryRepository
+
+	// eventBus publishes workspace lifecycle events; optional
+	eventBus event.Bus
 }
 
 // WorkspaceServiceConfig contains zavisimosti for WorkspaceService.
@@ -73,8 +120,15 @@ type WorkspaceServiceConfig struct {
 	CreateUC    CreateWorkspaceUseCase
 	GetUC       GetWorkspaceUseCase
 	UpdateUC    UpdateWorkspaceUseCase
+	PolicyUC    UpdateValuePolicyUseCase
+	SLAUC       UpdateSLAPolicyUseCase
+	OptOutUC    UpdateAnalyticsOptOutUseCase
+	JoinUC      UpdateJoinApprovalUseCase
+	DiscoUC     UpdateDiscoverabilityUseCase
+	BrandUC     UpdateBrandingUseCase
 	CommandRepo WorkspaceServiceCommandRepository
 	QueryRepo   WorkspaceServiceQueryRepository
+	EventBus    event.Bus
 }
 
 // NewWorkspaceService sozdayot New WorkspaceService.
@@ -83,8 +137,15 @@ func NewWorkspaceService(cfg WorkspaceServiceConfig) *WorkspaceService {
 		createUC:    cfg.CreateUC,
 		getUC:       cfg.GetUC,
 		updateUC:    cfg.UpdateUC,
+		policyUC:    cfg.PolicyUC,
+		slaUC:       cfg.SLAUC,
+		optOutUC:    cfg.OptOutUC,
+		joinUC:      cfg.JoinUC,
+		discoUC:     cfg.DiscoUC,
+		brandUC:     cfg.BrandUC,
 		commandRepo: cfg.CommandRepo,
 		queryRepo:   cfg.QueryRepo,
+		eventBus:    cfg.EventBus,
 	}
 }
 
@@ -103,7 +164,12 @@ func (s *WorkspaceService) CreateWorkspace(
 		return nil, err
 	}
 
-	return result.Value, nil
+	ws := result.Value
+	publishEvent(ctx, s.eventBus, workspace.NewWorkspaceCreated(
+		ws.ID(), ws.Name(), ws.KeycloakGroupID(), ownerID, serviceEventMetadata(ctx),
+	))
+
+	return ws, nil
 }
 
 // GetWorkspace returns workspace po ID.
@@ -157,16 +223,187 @@ func (s *WorkspaceService) UpdateWorkspace(
 		return nil, err
 	}
 
+	publishEvent(ctx, s.eventBus, workspace.NewWorkspaceUpdated(id, result.Value.Name(), serviceEventMetadata(ctx)))
+
+	return result.Value, nil
+}
+
+// UpdateValuePolicy replaces the allowed priorities/severities of the workspace.
+func (s *WorkspaceService) UpdateValuePolicy(
+	ctx context.Context,
+	id, updatedBy uuid.UUID,
+	priorities, severities map[chat.Type][]string,
+) (*workspace.Workspace, error) {
+	result, err := s.policyUC.Execute(ctx, wsapp.UpdateValuePolicyCommand{
+		WorkspaceID: id,
+		Priorities:  priorities,
+		Severities:  severities,
+		UpdatedBy:   updatedBy,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	publishEvent(ctx, s.eventBus, workspace.NewWorkspaceUpdated(id, result.Value.Name(), serviceEventMetadata(ctx)))
+
+	return result.Value, nil
+}
+
+// UpdateSLAPolicy replaces the SLA targets of bugs in the workspace.
+func (s *WorkspaceService) UpdateSLAPolicy(
+	ctx context.Context,
+	id, updatedBy uuid.UUID,
+	targets map[string]workspace.SLATarget,
+) (*workspace.Workspace, error) {
+	result, err := s.slaUC.Execute(ctx, wsapp.UpdateSLAPolicyCommand{
+		WorkspaceID: id,
+		Targets:     targets,
+		UpdatedBy:   updatedBy,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	publishEvent(ctx, s.eventBus, workspace.NewWorkspaceUpdated(id, result.Value.Name(), serviceEventMetadata(ctx)))
+
+	return result.Value, nil
+}
+
+// UpdateAnalyticsOptOut opts the workspace out of (or back into) product analytics.
+func (s *WorkspaceService) UpdateAnalyticsOptOut(
+	ctx context.Context,
+	id, updatedBy uuid.UUID,
+	optOut bool,
+) (*workspace.Workspace, error) {
+	result, err := s.optOutUC.Execute(ctx, wsapp.UpdateAnalyticsOptOutCommand{
+		WorkspaceID: id,
+		OptOut:      optOut,
+		UpdatedBy:   updatedBy,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	publishEvent(ctx, s.eventBus, workspace.NewWorkspaceUpdated(id, result.Value.Name(), serviceEventMetadata(ctx)))
+
+	return result.Value, nil
+}
+
+// UpdateJoinApproval toggles admin approval for members joining the workspace.
+func (s *WorkspaceService) UpdateJoinApproval(
+	ctx context.Context,
+	id, updatedBy uuid.UUID,
+	requireApproval bool,
+) (*workspace.Workspace, error) {
+	result, err := s.joinUC.Execute(ctx, wsapp.UpdateJoinApprovalCommand{
+		WorkspaceID:     id,
+		RequireApproval: requireApproval,
+		UpdatedBy:       updatedBy,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	publishEvent(ctx, s.eventBus, workspace.NewWorkspaceUpdated(id, result.Value.Name(), serviceEventMetadata(ctx)))
+
 	return result.Value, nil
 }
 
+// UpdateDiscoverability lists or hides the workspace in the discovery directory.
+func (s *WorkspaceService) UpdateDiscoverability(
+	ctx context.Context,
+	id, updatedBy uuid.UUID,
+	discoverable bool,
+) (*workspace.Workspace, error) {
+	result, err := s.discoUC.Execute(ctx, wsapp.UpdateDiscoverabilityCommand{
+		WorkspaceID:  id,
+		Discoverable: discoverable,
+		UpdatedBy:    updatedBy,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	publishEvent(ctx, s.eventBus, workspace.NewWorkspaceUpdated(id, result.Value.Name(), serviceEventMetadata(ctx)))
+
+	return result.Value, nil
+}
+
+// UpdateAccentColor sets the accent color of the workspace; an empty color resets it.
+func (s *WorkspaceService) UpdateAccentColor(
+	ctx context.Context,
+	id, updatedBy uuid.UUID,
+	color string,
+) (*workspace.Workspace, error) {
+	return s.updateBranding(ctx, wsapp.UpdateBrandingCommand{
+		WorkspaceID: id,
+		AccentColor: &color,
+		UpdatedBy:   updatedBy,
+	})
+}
+
+// UpdateAvatar points the workspace avatar at a blob store file; a zero fileID removes the avatar.
+func (s *WorkspaceService) UpdateAvatar(
+	ctx context.Context,
+	id, updatedBy uuid.UUID,
+	fileID uuid.UUID,
+	fileName string,
+) (*workspace.Workspace, error) {
+	return s.updateBranding(ctx, wsapp.UpdateBrandingCommand{
+		WorkspaceID:    id,
+		AvatarFileID:   &fileID,
+		AvatarFileName: fileName,
+		UpdatedBy:      updatedBy,
+	})
+}
+
+func (s *WorkspaceService) updateBranding(
+	ctx context.Context,
+	cmd wsapp.UpdateBrandingCommand,
+) (*workspace.Workspace, error) {
+	result, err := s.brandUC.Execute(ctx, cmd)
+	if err != nil {
+		return nil, err
+	}
+
+	publishEvent(ctx, s.eventBus, workspace.NewWorkspaceUpdated(
+		cmd.WorkspaceID, result.Value.Name(), serviceEventMetadata(ctx)))
+
+	return result.Value, nil
+}
+
+// DiscoverWorkspaces returns discoverable workspaces, chyo imya soderzhit query.
+func (s *WorkspaceService) DiscoverWorkspaces(
+	ctx context.Context,
+	query string,
+	offset, limit int,
+) ([]*workspace.Workspace, int, error) {
+	workspaces, err := s.queryRepo.ListDiscoverable(ctx, query, offset, limit)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	total, err := s.queryRepo.CountDiscoverable(ctx, query)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return workspaces, total, nil
+}
+
 // DeleteWorkspace udalyaet workspace.
 // Use case for delete poka not realizovan, ispolzuem repository napryamuyu.
 func (s *WorkspaceService) DeleteWorkspace(
 	ctx context.Context,
 	id uuid.UUID,
 ) error {
-	return s.commandRepo.Delete(ctx, id)
+	if err := s.commandRepo.Delete(ctx, id); err != nil {
+		return err
+	}
+
+	publishEvent(ctx, s.eventBus, workspace.NewWorkspaceDeleted(id, serviceEventMetadata(ctx)))
+
+	return nil
 }
 
 // GetMemberCount returns count participants workspace.
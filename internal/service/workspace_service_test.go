@@ -10,6 +10,7 @@ import (
 
 	"github.com/lllypuk/flowra/internal/application/appcore"
 	wsapp "github.com/lllypuk/flowra/internal/application/workspace"
+	"github.com/lllypuk/flowra/internal/domain/chat"
 	"github.com/lllypuk/flowra/internal/domain/uuid"
 	"github.com/lllypuk/flowra/internal/domain/workspace"
 	"github.com/lllypuk/flowra/internal/service"
@@ -51,6 +52,75 @@ func (m *mockWSUpdateUseCase) Execute(ctx context.Context, cmd wsapp.UpdateWorks
 	return wsapp.Result{}, nil
 }
 
+// mockWSPolicyUseCase is a mock implementation of UpdateValuePolicyUseCase
+type mockWSPolicyUseCase struct {
+	executeFunc func(ctx context.Context, cmd wsapp.UpdateValuePolicyCommand) (wsapp.Result, error)
+}
+
+func (m *mockWSPolicyUseCase) Execute(ctx context.Context, cmd wsapp.UpdateValuePolicyCommand) (wsapp.Result, error) {
+	if m.executeFunc != nil {
+		return m.executeFunc(ctx, cmd)
+	}
+	return wsapp.Result{}, nil
+}
+
+// mockWSOptOutUseCase is a mock implementation of UpdateAnalyticsOptOutUseCase
+type mockWSOptOutUseCase struct {
+	executeFunc func(ctx context.Context, cmd wsapp.UpdateAnalyticsOptOutCommand) (wsapp.Result, error)
+}
+
+func (m *mockWSOptOutUseCase) Execute(
+	ctx context.Context,
+	cmd wsapp.UpdateAnalyticsOptOutCommand,
+) (wsapp.Result, error) {
+	if m.executeFunc != nil {
+		return m.executeFunc(ctx, cmd)
+	}
+	return wsapp.Result{}, nil
+}
+
+type mockWSJoinApprovalUseCase struct {
+	executeFunc func(ctx context.Context, cmd wsapp.UpdateJoinApprovalCommand) (wsapp.Result, error)
+}
+
+func (m *mockWSJoinApprovalUseCase) Execute(
+	ctx context.Context,
+	cmd wsapp.UpdateJoinApprovalCommand,
+) (wsapp.Result, error) {
+	if m.executeFunc != nil {
+		return m.executeFunc(ctx, cmd)
+	}
+	return wsapp.Result{}, nil
+}
+
+type mockWSDiscoverabilityUseCase struct {
+	executeFunc func(ctx context.Context, cmd wsapp.UpdateDiscoverabilityCommand) (wsapp.Result, error)
+}
+
+func (m *mockWSDiscoverabilityUseCase) Execute(
+	ctx context.Context,
+	cmd wsapp.UpdateDiscoverabilityCommand,
+) (wsapp.Result, error) {
+	if m.executeFunc != nil {
+		return m.executeFunc(ctx, cmd)
+	}
+	return wsapp.Result{}, nil
+}
+
+type mockWSBrandingUseCase struct {
+	executeFunc func(ctx context.Context, cmd wsapp.UpdateBrandingCommand) (wsapp.Result, error)
+}
+
+func (m *mockWSBrandingUseCase) Execute(
+	ctx context.Context,
+	cmd wsapp.UpdateBrandingCommand,
+) (wsapp.Result, error) {
+	if m.executeFunc != nil {
+		return m.executeFunc(ctx, cmd)
+	}
+	return wsapp.Result{}, nil
+}
+
 // mockWSServiceCommandRepo is a mock implementation of WorkspaceServiceCommandRepository
 type mockWSServiceCommandRepo struct {
 	saveFunc      func(ctx context.Context, ws *workspace.Workspace) error
@@ -85,6 +155,8 @@ type mockWSServiceQueryRepo struct {
 	listWorkspacesByUserFunc  func(ctx context.Context, userID uuid.UUID, offset, limit int) ([]*workspace.Workspace, error)
 	countWorkspacesByUserFunc func(ctx context.Context, userID uuid.UUID) (int, error)
 	countMembersFunc          func(ctx context.Context, workspaceID uuid.UUID) (int, error)
+	listDiscoverableFunc      func(ctx context.Context, query string, offset, limit int) ([]*workspace.Workspace, error)
+	countDiscoverableFunc     func(ctx context.Context, query string) (int, error)
 }
 
 func (m *mockWSServiceQueryRepo) FindByID(ctx context.Context, id uuid.UUID) (*workspace.Workspace, error) {
@@ -119,6 +191,24 @@ func (m *mockWSServiceQueryRepo) CountMembers(ctx context.Context, workspaceID u
 	return 0, nil
 }
 
+func (m *mockWSServiceQueryRepo) ListDiscoverable(
+	ctx context.Context,
+	query string,
+	offset, limit int,
+) ([]*workspace.Workspace, error) {
+	if m.listDiscoverableFunc != nil {
+		return m.listDiscoverableFunc(ctx, query, offset, limit)
+	}
+	return []*workspace.Workspace{}, nil
+}
+
+func (m *mockWSServiceQueryRepo) CountDiscoverable(ctx context.Context, query string) (int, error) {
+	if m.countDiscoverableFunc != nil {
+		return m.countDiscoverableFunc(ctx, query)
+	}
+	return 0, nil
+}
+
 // createWSServiceTestWorkspace creates a test workspace for service testing
 func createWSServiceTestWorkspace(ownerID uuid.UUID, name string) *workspace.Workspace {
 	ws, _ := workspace.NewWorkspace(name, "", "keycloak-group-id", ownerID)
@@ -467,6 +557,228 @@ func TestWorkspaceService_UpdateWorkspace(t *testing.T) {
 	})
 }
 
+func TestWorkspaceService_UpdateValuePolicy(t *testing.T) {
+	t.Run("successfully update value policy", func(t *testing.T) {
+		workspaceID := uuid.NewUUID()
+		updatedBy := uuid.NewUUID()
+		expectedWS := createWSServiceTestWorkspace(uuid.NewUUID(), "Workspace")
+		priorities := map[chat.Type][]string{chat.TypeTask: {"High"}}
+
+		policyUC := &mockWSPolicyUseCase{
+			executeFunc: func(_ context.Context, cmd wsapp.UpdateValuePolicyCommand) (wsapp.Result, error) {
+				assert.Equal(t, workspaceID, cmd.WorkspaceID)
+				assert.Equal(t, updatedBy, cmd.UpdatedBy)
+				assert.Equal(t, priorities, cmd.Priorities)
+				return wsapp.Result{
+					Result: appcore.Result[*workspace.Workspace]{Value: expectedWS},
+				}, nil
+			},
+		}
+
+		svc := service.NewWorkspaceService(service.WorkspaceServiceConfig{
+			CreateUC:    &mockWSCreateUseCase{},
+			GetUC:       &mockWSGetUseCase{},
+			UpdateUC:    &mockWSUpdateUseCase{},
+			PolicyUC:    policyUC,
+			CommandRepo: &mockWSServiceCommandRepo{},
+			QueryRepo:   &mockWSServiceQueryRepo{},
+		})
+
+		ws, err := svc.UpdateValuePolicy(context.Background(), workspaceID, updatedBy, priorities, nil)
+
+		require.NoError(t, err)
+		assert.Equal(t, expectedWS, ws)
+	})
+
+	t.Run("use case error", func(t *testing.T) {
+		expectedErr := wsapp.ErrWorkspaceNotFound
+
+		policyUC := &mockWSPolicyUseCase{
+			executeFunc: func(_ context.Context, _ wsapp.UpdateValuePolicyCommand) (wsapp.Result, error) {
+				return wsapp.Result{}, expectedErr
+			},
+		}
+
+		svc := service.NewWorkspaceService(service.WorkspaceServiceConfig{
+			CreateUC:    &mockWSCreateUseCase{},
+			GetUC:       &mockWSGetUseCase{},
+			UpdateUC:    &mockWSUpdateUseCase{},
+			PolicyUC:    policyUC,
+			CommandRepo: &mockWSServiceCommandRepo{},
+			QueryRepo:   &mockWSServiceQueryRepo{},
+		})
+
+		ws, err := svc.UpdateValuePolicy(context.Background(), uuid.NewUUID(), uuid.NewUUID(), nil, nil)
+
+		require.ErrorIs(t, err, expectedErr)
+		assert.Nil(t, ws)
+	})
+}
+
+func TestWorkspaceService_UpdateAnalyticsOptOut(t *testing.T) {
+	workspaceID := uuid.NewUUID()
+	updatedBy := uuid.NewUUID()
+	expectedWS := createWSServiceTestWorkspace(uuid.NewUUID(), "Workspace")
+
+	optOutUC := &mockWSOptOutUseCase{
+		executeFunc: func(_ context.Context, cmd wsapp.UpdateAnalyticsOptOutCommand) (wsapp.Result, error) {
+			assert.Equal(t, workspaceID, cmd.WorkspaceID)
+			assert.Equal(t, updatedBy, cmd.UpdatedBy)
+			assert.True(t, cmd.OptOut)
+			return wsapp.Result{
+				Result: appcore.Result[*workspace.Workspace]{Value: expectedWS},
+			}, nil
+		},
+	}
+
+	svc := service.NewWorkspaceService(service.WorkspaceServiceConfig{
+		CreateUC:    &mockWSCreateUseCase{},
+		GetUC:       &mockWSGetUseCase{},
+		UpdateUC:    &mockWSUpdateUseCase{},
+		OptOutUC:    optOutUC,
+		CommandRepo: &mockWSServiceCommandRepo{},
+		QueryRepo:   &mockWSServiceQueryRepo{},
+	})
+
+	ws, err := svc.UpdateAnalyticsOptOut(context.Background(), workspaceID, updatedBy, true)
+
+	require.NoError(t, err)
+	assert.Equal(t, expectedWS, ws)
+}
+
+func TestWorkspaceService_UpdateJoinApproval(t *testing.T) {
+	workspaceID := uuid.NewUUID()
+	updatedBy := uuid.NewUUID()
+	expectedWS := createWSServiceTestWorkspace(uuid.NewUUID(), "Workspace")
+
+	joinUC := &mockWSJoinApprovalUseCase{
+		executeFunc: func(_ context.Context, cmd wsapp.UpdateJoinApprovalCommand) (wsapp.Result, error) {
+			assert.Equal(t, workspaceID, cmd.WorkspaceID)
+			assert.Equal(t, updatedBy, cmd.UpdatedBy)
+			assert.True(t, cmd.RequireApproval)
+			return wsapp.Result{
+				Result: appcore.Result[*workspace.Workspace]{Value: expectedWS},
+			}, nil
+		},
+	}
+
+	svc := service.NewWorkspaceService(service.WorkspaceServiceConfig{
+		CreateUC:    &mockWSCreateUseCase{},
+		GetUC:       &mockWSGetUseCase{},
+		UpdateUC:    &mockWSUpdateUseCase{},
+		JoinUC:      joinUC,
+		CommandRepo: &mockWSServiceCommandRepo{},
+		QueryRepo:   &mockWSServiceQueryRepo{},
+	})
+
+	ws, err := svc.UpdateJoinApproval(context.Background(), workspaceID, updatedBy, true)
+
+	require.NoError(t, err)
+	assert.Equal(t, expectedWS, ws)
+}
+
+func TestWorkspaceService_UpdateDiscoverability(t *testing.T) {
+	workspaceID := uuid.NewUUID()
+	updatedBy := uuid.NewUUID()
+	expectedWS := createWSServiceTestWorkspace(uuid.NewUUID(), "Workspace")
+
+	discoUC := &mockWSDiscoverabilityUseCase{
+		executeFunc: func(_ context.Context, cmd wsapp.UpdateDiscoverabilityCommand) (wsapp.Result, error) {
+			assert.Equal(t, workspaceID, cmd.WorkspaceID)
+			assert.Equal(t, updatedBy, cmd.UpdatedBy)
+			assert.True(t, cmd.Discoverable)
+			return wsapp.Result{
+				Result: appcore.Result[*workspace.Workspace]{Value: expectedWS},
+			}, nil
+		},
+	}
+
+	svc := service.NewWorkspaceService(service.WorkspaceServiceConfig{
+		CreateUC:    &mockWSCreateUseCase{},
+		GetUC:       &mockWSGetUseCase{},
+		UpdateUC:    &mockWSUpdateUseCase{},
+		DiscoUC:     discoUC,
+		CommandRepo: &mockWSServiceCommandRepo{},
+		QueryRepo:   &mockWSServiceQueryRepo{},
+	})
+
+	ws, err := svc.UpdateDiscoverability(context.Background(), workspaceID, updatedBy, true)
+
+	require.NoError(t, err)
+	assert.Equal(t, expectedWS, ws)
+}
+
+func TestWorkspaceService_UpdateBranding(t *testing.T) {
+	workspaceID := uuid.NewUUID()
+	updatedBy := uuid.NewUUID()
+	expectedWS := createWSServiceTestWorkspace(uuid.NewUUID(), "Workspace")
+
+	var commands []wsapp.UpdateBrandingCommand
+	brandUC := &mockWSBrandingUseCase{
+		executeFunc: func(_ context.Context, cmd wsapp.UpdateBrandingCommand) (wsapp.Result, error) {
+			commands = append(commands, cmd)
+			return wsapp.Result{
+				Result: appcore.Result[*workspace.Workspace]{Value: expectedWS},
+			}, nil
+		},
+	}
+
+	svc := service.NewWorkspaceService(service.WorkspaceServiceConfig{
+		CreateUC:    &mockWSCreateUseCase{},
+		GetUC:       &mockWSGetUseCase{},
+		UpdateUC:    &mockWSUpdateUseCase{},
+		BrandUC:     brandUC,
+		CommandRepo: &mockWSServiceCommandRepo{},
+		QueryRepo:   &mockWSServiceQueryRepo{},
+	})
+
+	_, err := svc.UpdateAccentColor(context.Background(), workspaceID, updatedBy, "#112233")
+	require.NoError(t, err)
+	fileID := uuid.NewUUID()
+	_, err = svc.UpdateAvatar(context.Background(), workspaceID, updatedBy, fileID, "logo.png")
+	require.NoError(t, err)
+
+	require.Len(t, commands, 2)
+	require.NotNil(t, commands[0].AccentColor)
+	assert.Equal(t, "#112233", *commands[0].AccentColor)
+	assert.Nil(t, commands[0].AvatarFileID)
+	assert.Nil(t, commands[1].AccentColor)
+	require.NotNil(t, commands[1].AvatarFileID)
+	assert.Equal(t, fileID, *commands[1].AvatarFileID)
+	assert.Equal(t, "logo.png", commands[1].AvatarFileName)
+}
+
+func TestWorkspaceService_DiscoverWorkspaces(t *testing.T) {
+	expectedWS := createWSServiceTestWorkspace(uuid.NewUUID(), "Design")
+
+	queryRepo := &mockWSServiceQueryRepo{
+		listDiscoverableFunc: func(_ context.Context, query string, offset, limit int) ([]*workspace.Workspace, error) {
+			assert.Equal(t, "des", query)
+			assert.Equal(t, 0, offset)
+			assert.Equal(t, 20, limit)
+			return []*workspace.Workspace{expectedWS}, nil
+		},
+		countDiscoverableFunc: func(_ context.Context, query string) (int, error) {
+			assert.Equal(t, "des", query)
+			return 1, nil
+		},
+	}
+
+	svc := service.NewWorkspaceService(service.WorkspaceServiceConfig{
+		CreateUC:    &mockWSCreateUseCase{},
+		GetUC:       &mockWSGetUseCase{},
+		UpdateUC:    &mockWSUpdateUseCase{},
+		CommandRepo: &mockWSServiceCommandRepo{},
+		QueryRepo:   queryRepo,
+	})
+
+	workspaces, total, err := svc.DiscoverWorkspaces(context.Background(), "des", 0, 20)
+
+	require.NoError(t, err)
+	assert.Equal(t, []*workspace.Workspace{expectedWS}, workspaces)
+	assert.Equal(t, 1, total)
+}
+
 func TestWorkspaceService_DeleteWorkspace(t *testing.T) {
 	t.Run("successfully delete workspace", func(t *testing.T) {
 		workspaceID := uuid.NewUUID()
@@ -0,0 +1,208 @@
+package worker
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"time"
+
+	"github.com/lllypuk/flowra/internal/config"
+	messagedomain "github.com/lllypuk/flowra/internal/domain/message"
+	"github.com/lllypuk/flowra/internal/domain/uuid"
+	"github.com/lllypuk/flowra/internal/infrastructure/preview"
+)
+
+// defaultAttachmentPreviewBatchSize is the number of messages loaded per batch.
+const defaultAttachmentPreviewBatchSize = 50
+
+// AttachmentPreviewConfig contains configuration for the attachment preview worker.
+type AttachmentPreviewConfig struct {
+	// Interval is the time between runs.
+	Interval time.Duration
+
+	// BatchSize is the number of messages loaded per batch.
+	BatchSize int
+
+	// Enabled determines if the worker should run.
+	Enabled bool
+}
+
+// DefaultAttachmentPreviewConfig returns sensible default configuration.
+func DefaultAttachmentPreviewConfig() AttachmentPreviewConfig {
+	return AttachmentPreviewConfig{
+		Interval:  config.DefaultUploadPreviewInterval,
+		BatchSize: defaultAttachmentPreviewBatchSize,
+		Enabled:   true,
+	}
+}
+
+// AttachmentPreviewRepository finds attachments waiting for their preview and records the result.
+// Declared on the consumer side per project guidelines.
+type AttachmentPreviewRepository interface {
+	FindPendingPreviews(ctx context.Context, limit int) ([]*messagedomain.Message, error)
+	UpdateAttachmentPreview(
+		ctx context.Context,
+		messageID, fileID uuid.UUID,
+		status messagedomain.PreviewStatus,
+		width, height int,
+	) error
+}
+
+// AttachmentPreviewStorage reads stored files and stores their previews.
+// Declared on the consumer side per project guidelines.
+type AttachmentPreviewStorage interface {
+	FilePath(fileID uuid.UUID, fileName string) (string, error)
+	SavePreview(fileID uuid.UUID, reader io.Reader) error
+}
+
+// PreviewGenerator renders the preview of a file.
+// Declared on the consumer side per project guidelines.
+type PreviewGenerator interface {
+	Generate(ctx context.Context, path, mimeType string, w io.Writer) (int, int, error)
+}
+
+// AttachmentPreviewWorker generates previews for image and PDF attachments, stores
+// them next to the original files and records their size on the message, so chat
+// lists show the preview instead of loading the full-size file.
+type AttachmentPreviewWorker struct {
+	repo      AttachmentPreviewRepository
+	storage   AttachmentPreviewStorage
+	generator PreviewGenerator
+	logger    *slog.Logger
+	config    AttachmentPreviewConfig
+}
+
+// NewAttachmentPreviewWorker creates a new attachment preview worker.
+func NewAttachmentPreviewWorker(
+	repo AttachmentPreviewRepository,
+	storage AttachmentPreviewStorage,
+	generator PreviewGenerator,
+	logger *slog.Logger,
+	config AttachmentPreviewConfig,
+) *AttachmentPreviewWorker {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	if config.BatchSize <= 0 {
+		config.BatchSize = defaultAttachmentPreviewBatchSize
+	}
+
+	return &AttachmentPreviewWorker{
+		repo:      repo,
+		storage:   storage,
+		generator: generator,
+		logger:    logger,
+		config:    config,
+	}
+}
+
+// Run starts the preview loop and blocks until the context is cancelled.
+func (w *AttachmentPreviewWorker) Run(ctx context.Context) error {
+	if !w.config.Enabled {
+		w.logger.InfoContext(ctx, "attachment preview worker disabled")
+		return nil
+	}
+
+	w.logger.InfoContext(ctx, "starting attachment preview worker",
+		slog.Duration("interval", w.config.Interval),
+		slog.Int("batch_size", w.config.BatchSize),
+	)
+
+	ticker := time.NewTicker(w.config.Interval)
+	defer ticker.Stop()
+
+	// Generate immediately on start
+	w.GenerateOnce(ctx)
+
+	for {
+		select {
+		case <-ctx.Done():
+			w.logger.InfoContext(ctx, "attachment preview worker stopped")
+			return ctx.Err()
+		case <-ticker.C:
+			w.GenerateOnce(ctx)
+		}
+	}
+}
+
+// GenerateOnce generates the previews of all pending attachments, batch by batch.
+// It stops early when an attachment stays pending, so a failing store is retried
+// on the next run instead of in a tight loop.
+func (w *AttachmentPreviewWorker) GenerateOnce(ctx context.Context) {
+	generated := 0
+	for ctx.Err() == nil {
+		messages, err := w.repo.FindPendingPreviews(ctx, w.config.BatchSize)
+		if err != nil {
+			w.logger.ErrorContext(ctx, "failed to find pending attachment previews",
+				slog.String("error", err.Error()),
+			)
+			return
+		}
+
+		complete := true
+		for _, msg := range messages {
+			for _, attachment := range msg.Attachments() {
+				if attachment.PreviewStatus() != messagedomain.PreviewPending {
+					continue
+				}
+				if procErr := w.process(ctx, msg.ID(), attachment); procErr != nil {
+					complete = false
+					w.logger.ErrorContext(ctx, "failed to process attachment preview",
+						slog.String("message_id", msg.ID().String()),
+						slog.String("file_id", attachment.FileID().String()),
+						slog.String("error", procErr.Error()),
+					)
+					continue
+				}
+				generated++
+			}
+		}
+
+		if !complete || len(messages) < w.config.BatchSize {
+			break
+		}
+	}
+
+	if generated > 0 {
+		w.logger.InfoContext(ctx, "processed attachment previews", slog.Int("count", generated))
+	}
+}
+
+// process generates and stores the preview of one attachment and records the outcome.
+// Files without a preview, e.g. PDFs when no renderer is configured, and files that
+// cannot be decoded leave the queue; storage and database errors keep them pending.
+func (w *AttachmentPreviewWorker) process(
+	ctx context.Context,
+	messageID uuid.UUID,
+	attachment messagedomain.Attachment,
+) error {
+	path, err := w.storage.FilePath(attachment.FileID(), attachment.FileName())
+	if err != nil {
+		return w.repo.UpdateAttachmentPreview(ctx, messageID, attachment.FileID(), messagedomain.PreviewFailed, 0, 0)
+	}
+
+	var buf bytes.Buffer
+	width, height, genErr := w.generator.Generate(ctx, path, attachment.MimeType(), &buf)
+	switch {
+	case ctx.Err() != nil:
+		return ctx.Err()
+	case errors.Is(genErr, preview.ErrUnsupported):
+		return w.repo.UpdateAttachmentPreview(ctx, messageID, attachment.FileID(), messagedomain.PreviewNone, 0, 0)
+	case genErr != nil:
+		w.logger.WarnContext(ctx, "failed to generate attachment preview",
+			slog.String("message_id", messageID.String()),
+			slog.String("file_id", attachment.FileID().String()),
+			slog.String("mime_type", attachment.MimeType()),
+			slog.String("error", genErr.Error()),
+		)
+		return w.repo.UpdateAttachmentPreview(ctx, messageID, attachment.FileID(), messagedomain.PreviewFailed, 0, 0)
+	}
+
+	if saveErr := w.storage.SavePreview(attachment.FileID(), &buf); saveErr != nil {
+		return saveErr
+	}
+	return w.repo.UpdateAttachmentPreview(
+		ctx, messageID, attachment.FileID(), messagedomain.PreviewReady, width, height)
+}
@@ -0,0 +1,207 @@
+package worker_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"sync"
+	"testing"
+	"time"
+
+	messagedomain "github.com/lllypuk/flowra/internal/domain/message"
+	"github.com/lllypuk/flowra/internal/domain/uuid"
+	"github.com/lllypuk/flowra/internal/infrastructure/preview"
+	"github.com/lllypuk/flowra/internal/worker"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type previewUpdate struct {
+	status messagedomain.PreviewStatus
+	width  int
+	height int
+}
+
+// stubPreviewRepository serves messages until their attachments leave the pending state
+type stubPreviewRepository struct {
+	messages []*messagedomain.Message
+	updates  map[uuid.UUID]previewUpdate
+	findErr  error
+	finds    int
+}
+
+func (r *stubPreviewRepository) FindPendingPreviews(_ context.Context, limit int) ([]*messagedomain.Message, error) {
+	r.finds++
+	if r.findErr != nil {
+		return nil, r.findErr
+	}
+	var pending []*messagedomain.Message
+	for _, msg := range r.messages {
+		for _, a := range msg.Attachments() {
+			if _, done := r.updates[a.FileID()]; !done && a.PreviewStatus() == messagedomain.PreviewPending {
+				pending = append(pending, msg)
+				break
+			}
+		}
+		if len(pending) == limit {
+			break
+		}
+	}
+	return pending, nil
+}
+
+func (r *stubPreviewRepository) UpdateAttachmentPreview(
+	_ context.Context,
+	_, fileID uuid.UUID,
+	status messagedomain.PreviewStatus,
+	width, height int,
+) error {
+	r.updates[fileID] = previewUpdate{status: status, width: width, height: height}
+	return nil
+}
+
+type stubPreviewStorage struct {
+	mu      sync.Mutex
+	saved   map[uuid.UUID]string
+	saveErr error
+}
+
+func (s *stubPreviewStorage) preview(fileID uuid.UUID) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.saved[fileID]
+}
+
+func (s *stubPreviewStorage) FilePath(fileID uuid.UUID, fileName string) (string, error) {
+	return "/uploads/" + fileID.String() + "/" + fileName, nil
+}
+
+func (s *stubPreviewStorage) SavePreview(fileID uuid.UUID, reader io.Reader) error {
+	if s.saveErr != nil {
+		return s.saveErr
+	}
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.saved[fileID] = string(data)
+	return nil
+}
+
+// stubPreviewGenerator renders images, has no PDF renderer and fails on GIFs
+type stubPreviewGenerator struct{}
+
+func (stubPreviewGenerator) Generate(_ context.Context, _, mimeType string, w io.Writer) (int, int, error) {
+	switch mimeType {
+	case "image/png":
+		_, err := io.WriteString(w, "jpeg")
+		return 320, 200, err
+	case "image/gif":
+		return 0, 0, errors.New("broken gif")
+	default:
+		return 0, 0, preview.ErrUnsupported
+	}
+}
+
+func newPreviewFixture(t *testing.T) (*stubPreviewRepository, *stubPreviewStorage, map[string]uuid.UUID) {
+	t.Helper()
+	files := map[string]uuid.UUID{
+		"photo.png": uuid.NewUUID(),
+		"anim.gif":  uuid.NewUUID(),
+		"doc.pdf":   uuid.NewUUID(),
+		"notes.txt": uuid.NewUUID(),
+	}
+	msg, err := messagedomain.NewMessage(uuid.NewUUID(), uuid.NewUUID(), "Files", "")
+	require.NoError(t, err)
+	require.NoError(t, msg.AddAttachment(files["photo.png"], "photo.png", 100, "image/png"))
+	require.NoError(t, msg.AddAttachment(files["anim.gif"], "anim.gif", 100, "image/gif"))
+	require.NoError(t, msg.AddAttachment(files["doc.pdf"], "doc.pdf", 100, "application/pdf"))
+	require.NoError(t, msg.AddAttachment(files["notes.txt"], "notes.txt", 100, "text/plain"))
+
+	repo := &stubPreviewRepository{
+		messages: []*messagedomain.Message{msg},
+		updates:  make(map[uuid.UUID]previewUpdate),
+	}
+	return repo, &stubPreviewStorage{saved: make(map[uuid.UUID]string)}, files
+}
+
+func TestDefaultAttachmentPreviewConfig(t *testing.T) {
+	config := worker.DefaultAttachmentPreviewConfig()
+
+	assert.Equal(t, 30*time.Second, config.Interval)
+	assert.Positive(t, config.BatchSize)
+	assert.True(t, config.Enabled)
+}
+
+func TestAttachmentPreviewWorker_GenerateOnce(t *testing.T) {
+	t.Run("stores previews and records the outcome of each attachment", func(t *testing.T) {
+		repo, storage, files := newPreviewFixture(t)
+		w := worker.NewAttachmentPreviewWorker(
+			repo, storage, stubPreviewGenerator{}, slog.Default(), worker.DefaultAttachmentPreviewConfig())
+
+		w.GenerateOnce(context.Background())
+
+		assert.Equal(t, previewUpdate{status: messagedomain.PreviewReady, width: 320, height: 200},
+			repo.updates[files["photo.png"]])
+		assert.Equal(t, "jpeg", storage.saved[files["photo.png"]])
+		assert.Equal(t, messagedomain.PreviewFailed, repo.updates[files["anim.gif"]].status)
+		assert.Equal(t, messagedomain.PreviewNone, repo.updates[files["doc.pdf"]].status)
+		assert.NotContains(t, repo.updates, files["notes.txt"], "files without previews are not touched")
+		assert.Len(t, storage.saved, 1)
+	})
+
+	t.Run("keeps attachments pending when the preview cannot be stored", func(t *testing.T) {
+		repo, storage, files := newPreviewFixture(t)
+		storage.saveErr = errors.New("disk full")
+		config := worker.DefaultAttachmentPreviewConfig()
+		config.BatchSize = 1
+		w := worker.NewAttachmentPreviewWorker(repo, storage, stubPreviewGenerator{}, slog.Default(), config)
+
+		w.GenerateOnce(context.Background())
+
+		assert.NotContains(t, repo.updates, files["photo.png"])
+		assert.Equal(t, 1, repo.finds, "a full batch with failures is retried on the next run")
+	})
+
+	t.Run("survives repository errors", func(t *testing.T) {
+		repo, storage, _ := newPreviewFixture(t)
+		repo.findErr = errors.New("mongo down")
+		w := worker.NewAttachmentPreviewWorker(
+			repo, storage, stubPreviewGenerator{}, slog.Default(), worker.DefaultAttachmentPreviewConfig())
+
+		w.GenerateOnce(context.Background())
+
+		assert.Empty(t, repo.updates)
+	})
+}
+
+func TestAttachmentPreviewWorker_Run(t *testing.T) {
+	t.Run("disabled worker returns immediately", func(t *testing.T) {
+		repo, storage, _ := newPreviewFixture(t)
+		config := worker.DefaultAttachmentPreviewConfig()
+		config.Enabled = false
+		w := worker.NewAttachmentPreviewWorker(repo, storage, stubPreviewGenerator{}, slog.Default(), config)
+
+		require.NoError(t, w.Run(context.Background()))
+		assert.Zero(t, repo.finds)
+	})
+
+	t.Run("generates on start and stops with the context", func(t *testing.T) {
+		repo, storage, files := newPreviewFixture(t)
+		config := worker.DefaultAttachmentPreviewConfig()
+		config.Interval = time.Hour
+		w := worker.NewAttachmentPreviewWorker(repo, storage, stubPreviewGenerator{}, slog.Default(), config)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		done := make(chan error, 1)
+		go func() { done <- w.Run(ctx) }()
+
+		require.Eventually(t, func() bool { return storage.preview(files["photo.png"]) != "" },
+			time.Second, 10*time.Millisecond)
+		cancel()
+		require.ErrorIs(t, <-done, context.Canceled)
+	})
+}
@@ -0,0 +1,302 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/lllypuk/flowra/internal/domain/uuid"
+	"github.com/lllypuk/flowra/internal/infrastructure/backup"
+)
+
+// Default backup worker configuration values.
+const (
+	defaultBackupPollInterval     = 5 * time.Second
+	defaultBackupProgressInterval = 2 * time.Second
+	defaultBackupStaleAfter       = 10 * time.Minute
+)
+
+// BackupWorkerConfig contains configuration for the backup worker.
+type BackupWorkerConfig struct {
+	// PollInterval is the time between checks for pending jobs.
+	PollInterval time.Duration
+
+	// ProgressInterval is how often the progress of a running job is saved. It
+	// doubles as the heartbeat that keeps the job from being considered stale.
+	ProgressInterval time.Duration
+
+	// StaleAfter fails running jobs whose progress has not been saved for this
+	// long, which happens when a worker stops mid-job.
+	StaleAfter time.Duration
+
+	// Enabled determines if the worker should run.
+	Enabled bool
+}
+
+// DefaultBackupWorkerConfig returns sensible default configuration.
+func DefaultBackupWorkerConfig() BackupWorkerConfig {
+	return BackupWorkerConfig{
+		PollInterval:     defaultBackupPollInterval,
+		ProgressInterval: defaultBackupProgressInterval,
+		StaleAfter:       defaultBackupStaleAfter,
+		Enabled:          true,
+	}
+}
+
+// BackupJobQueue hands out backup jobs and records their outcome.
+// Declared on the consumer side per project guidelines.
+type BackupJobQueue interface {
+	ClaimNext(ctx context.Context) (*backup.Job, error)
+	UpdateProgress(ctx context.Context, id string, progress backup.Progress) error
+	Complete(ctx context.Context, id, workspaceID string, counts map[string]int) error
+	Fail(ctx context.Context, id string, jobErr error) error
+	FailStale(ctx context.Context, before time.Time) (int, error)
+}
+
+// WorkspaceExporter writes a workspace into an archive.
+// Declared on the consumer side per project guidelines.
+type WorkspaceExporter interface {
+	Export(ctx context.Context, workspaceID uuid.UUID, w io.Writer, progress backup.ProgressFunc) (map[string]int, error)
+}
+
+// WorkspaceRestorer restores an archive as a new workspace.
+// Declared on the consumer side per project guidelines.
+type WorkspaceRestorer interface {
+	Restore(ctx context.Context, path, name string, progress backup.ProgressFunc) (*backup.RestoreResult, error)
+}
+
+// BackupWorker runs the workspace export and restore jobs queued by the admin API,
+// one at a time.
+type BackupWorker struct {
+	jobs     BackupJobQueue
+	exporter WorkspaceExporter
+	restorer WorkspaceRestorer
+	logger   *slog.Logger
+	config   BackupWorkerConfig
+}
+
+// NewBackupWorker creates a new backup worker.
+func NewBackupWorker(
+	jobs BackupJobQueue,
+	exporter WorkspaceExporter,
+	restorer WorkspaceRestorer,
+	logger *slog.Logger,
+	config BackupWorkerConfig,
+) *BackupWorker {
+	if logger == nil {
+		logger = slog.Default()
+	}
+
+	return &BackupWorker{
+		jobs:     jobs,
+		exporter: exporter,
+		restorer: restorer,
+		logger:   logger,
+		config:   config,
+	}
+}
+
+// Run starts the polling loop and blocks until the context is cancelled.
+func (w *BackupWorker) Run(ctx context.Context) error {
+	if !w.config.Enabled {
+		w.logger.InfoContext(ctx, "backup worker disabled")
+		return nil
+	}
+
+	w.logger.InfoContext(ctx, "starting backup worker",
+		slog.Duration("poll_interval", w.config.PollInterval),
+	)
+
+	ticker := time.NewTicker(w.config.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			w.logger.InfoContext(ctx, "backup worker stopped")
+			return ctx.Err()
+		case <-ticker.C:
+			w.ProcessPending(ctx)
+		}
+	}
+}
+
+// ProcessPending fails stale jobs and runs pending jobs until none are left.
+func (w *BackupWorker) ProcessPending(ctx context.Context) {
+	if failed, err := w.jobs.FailStale(ctx, time.Now().Add(-w.config.StaleAfter)); err != nil {
+		w.logger.ErrorContext(ctx, "failed to check for stale backup jobs", slog.String("error", err.Error()))
+	} else if failed > 0 {
+		w.logger.WarnContext(ctx, "failed interrupted backup jobs", slog.Int("count", failed))
+	}
+
+	for ctx.Err() == nil {
+		job, err := w.jobs.ClaimNext(ctx)
+		if errors.Is(err, backup.ErrNoPendingJobs) {
+			return
+		}
+		if err != nil {
+			w.logger.ErrorContext(ctx, "failed to claim backup job", slog.String("error", err.Error()))
+			return
+		}
+		w.runJob(ctx, job)
+	}
+}
+
+func (w *BackupWorker) runJob(ctx context.Context, job *backup.Job) {
+	start := time.Now()
+	w.logger.InfoContext(ctx, "backup job started",
+		slog.String("job_id", job.ID),
+		slog.String("kind", string(job.Kind)),
+	)
+
+	reporter := newProgressReporter(w.jobs, job.ID, w.logger)
+	stop := reporter.start(ctx, w.config.ProgressInterval)
+	workspaceID, counts, err := w.execute(ctx, job, reporter.set)
+	stop()
+
+	if err != nil {
+		w.logger.ErrorContext(ctx, "backup job failed",
+			slog.String("job_id", job.ID),
+			slog.String("kind", string(job.Kind)),
+			slog.String("error", err.Error()),
+		)
+		if failErr := w.jobs.Fail(ctx, job.ID, err); failErr != nil {
+			w.logger.ErrorContext(ctx, "failed to mark backup job as failed",
+				slog.String("job_id", job.ID),
+				slog.String("error", failErr.Error()),
+			)
+		}
+		return
+	}
+
+	if completeErr := w.jobs.Complete(ctx, job.ID, workspaceID, counts); completeErr != nil {
+		w.logger.ErrorContext(ctx, "failed to mark backup job as completed",
+			slog.String("job_id", job.ID),
+			slog.String("error", completeErr.Error()),
+		)
+		return
+	}
+	w.logger.InfoContext(ctx, "backup job completed",
+		slog.String("job_id", job.ID),
+		slog.String("kind", string(job.Kind)),
+		slog.String("workspace_id", workspaceID),
+		slog.Duration("duration", time.Since(start)),
+	)
+}
+
+func (w *BackupWorker) execute(
+	ctx context.Context,
+	job *backup.Job,
+	progress backup.ProgressFunc,
+) (string, map[string]int, error) {
+	switch job.Kind {
+	case backup.JobKindExport:
+		counts, err := w.export(ctx, job, progress)
+		return job.WorkspaceID, counts, err
+	case backup.JobKindRestore:
+		result, err := w.restorer.Restore(ctx, job.ArchivePath, job.WorkspaceName, progress)
+		if err != nil {
+			return "", nil, err
+		}
+		return result.WorkspaceID, result.Counts, nil
+	default:
+		return "", nil, fmt.Errorf("unknown backup job kind %q", job.Kind)
+	}
+}
+
+// export writes the archive next to its final path and moves it into place once
+// complete, so a download never sees a partial archive.
+func (w *BackupWorker) export(ctx context.Context, job *backup.Job, progress backup.ProgressFunc) (map[string]int, error) {
+	workspaceID, err := uuid.ParseUUID(job.WorkspaceID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid workspace ID: %w", err)
+	}
+	if err = os.MkdirAll(filepath.Dir(job.ArchivePath), 0o750); err != nil {
+		return nil, fmt.Errorf("failed to create backup directory: %w", err)
+	}
+
+	tmpPath := job.ArchivePath + ".tmp"
+	f, err := os.Create(tmpPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create archive: %w", err)
+	}
+	counts, err := w.exporter.Export(ctx, workspaceID, f, progress)
+	if closeErr := f.Close(); err == nil && closeErr != nil {
+		err = fmt.Errorf("failed to write archive: %w", closeErr)
+	}
+	if err == nil {
+		err = os.Rename(tmpPath, job.ArchivePath)
+	}
+	if err != nil {
+		_ = os.Remove(tmpPath)
+		return nil, err
+	}
+	return counts, nil
+}
+
+// progressReporter saves the latest progress of a job at a fixed interval, so
+// frequent updates from the exporter do not each cost a write.
+type progressReporter struct {
+	jobs   BackupJobQueue
+	jobID  string
+	logger *slog.Logger
+
+	mu       sync.Mutex
+	progress backup.Progress
+}
+
+func newProgressReporter(jobs BackupJobQueue, jobID string, logger *slog.Logger) *progressReporter {
+	return &progressReporter{jobs: jobs, jobID: jobID, logger: logger}
+}
+
+func (r *progressReporter) set(p backup.Progress) {
+	r.mu.Lock()
+	r.progress = p
+	r.mu.Unlock()
+}
+
+// start saves the progress every interval until the returned stop function is
+// called; stop saves it one last time.
+func (r *progressReporter) start(ctx context.Context, interval time.Duration) func() {
+	done := make(chan struct{})
+	var wg sync.WaitGroup
+	wg.Go(func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-done:
+				return
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				r.save(ctx)
+			}
+		}
+	})
+
+	return func() {
+		close(done)
+		wg.Wait()
+		r.save(ctx)
+	}
+}
+
+func (r *progressReporter) save(ctx context.Context) {
+	r.mu.Lock()
+	p := r.progress
+	r.mu.Unlock()
+
+	if err := r.jobs.UpdateProgress(ctx, r.jobID, p); err != nil {
+		r.logger.WarnContext(ctx, "failed to save backup job progress",
+			slog.String("job_id", r.jobID),
+			slog.String("error", err.Error()),
+		)
+	}
+}
@@ -0,0 +1,129 @@
+package worker_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/lllypuk/flowra/internal/domain/uuid"
+	"github.com/lllypuk/flowra/internal/infrastructure/backup"
+	"github.com/lllypuk/flowra/internal/worker"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type stubBackupQueue struct {
+	pending   []*backup.Job
+	progress  map[string]backup.Progress
+	completed map[string]string
+	failed    map[string]string
+}
+
+func newStubBackupQueue(jobs ...*backup.Job) *stubBackupQueue {
+	return &stubBackupQueue{
+		pending:   jobs,
+		progress:  make(map[string]backup.Progress),
+		completed: make(map[string]string),
+		failed:    make(map[string]string),
+	}
+}
+
+func (q *stubBackupQueue) ClaimNext(context.Context) (*backup.Job, error) {
+	if len(q.pending) == 0 {
+		return nil, backup.ErrNoPendingJobs
+	}
+	job := q.pending[0]
+	q.pending = q.pending[1:]
+	return job, nil
+}
+
+func (q *stubBackupQueue) UpdateProgress(_ context.Context, id string, progress backup.Progress) error {
+	q.progress[id] = progress
+	return nil
+}
+
+func (q *stubBackupQueue) Complete(_ context.Context, id, workspaceID string, _ map[string]int) error {
+	q.completed[id] = workspaceID
+	return nil
+}
+
+func (q *stubBackupQueue) Fail(_ context.Context, id string, jobErr error) error {
+	q.failed[id] = jobErr.Error()
+	return nil
+}
+
+func (q *stubBackupQueue) FailStale(context.Context, time.Time) (int, error) {
+	return 0, nil
+}
+
+type stubExporter struct {
+	err error
+}
+
+func (e *stubExporter) Export(
+	_ context.Context,
+	_ uuid.UUID,
+	w io.Writer,
+	progress backup.ProgressFunc,
+) (map[string]int, error) {
+	progress(backup.Progress{Phase: backup.PhaseChats, Done: 3, Total: 3})
+	if e.err != nil {
+		return nil, e.err
+	}
+	_, err := w.Write([]byte("archive"))
+	return map[string]int{"events": 3}, err
+}
+
+type stubRestorer struct {
+	workspaceID string
+}
+
+func (r *stubRestorer) Restore(
+	_ context.Context,
+	_, _ string,
+	progress backup.ProgressFunc,
+) (*backup.RestoreResult, error) {
+	progress(backup.Progress{Phase: backup.PhaseRestore, Done: 10, Total: 10})
+	return &backup.RestoreResult{WorkspaceID: r.workspaceID}, nil
+}
+
+func TestBackupWorker_ProcessPending(t *testing.T) {
+	dir := t.TempDir()
+	export := backup.NewExportJob(uuid.NewUUID(), uuid.NewUUID(), dir)
+	restore := backup.NewRestoreJob(backup.UploadPath(dir), "Copy", uuid.NewUUID())
+	queue := newStubBackupQueue(export, restore)
+
+	w := worker.NewBackupWorker(queue, &stubExporter{}, &stubRestorer{workspaceID: "restored"},
+		slog.Default(), worker.DefaultBackupWorkerConfig())
+	w.ProcessPending(context.Background())
+
+	assert.Equal(t, export.WorkspaceID, queue.completed[export.ID])
+	assert.Equal(t, "restored", queue.completed[restore.ID])
+	assert.Equal(t, backup.Progress{Phase: backup.PhaseChats, Done: 3, Total: 3}, queue.progress[export.ID])
+	assert.Equal(t, 10, queue.progress[restore.ID].Done)
+
+	data, err := os.ReadFile(export.ArchivePath)
+	require.NoError(t, err)
+	assert.Equal(t, "archive", string(data))
+}
+
+func TestBackupWorker_FailedExportLeavesNoArchive(t *testing.T) {
+	dir := t.TempDir()
+	export := backup.NewExportJob(uuid.NewUUID(), uuid.NewUUID(), dir)
+	queue := newStubBackupQueue(export)
+
+	w := worker.NewBackupWorker(queue, &stubExporter{err: errors.New("snapshot too old")}, &stubRestorer{},
+		slog.Default(), worker.DefaultBackupWorkerConfig())
+	w.ProcessPending(context.Background())
+
+	assert.Equal(t, "snapshot too old", queue.failed[export.ID])
+	assert.Empty(t, queue.completed)
+	entries, err := os.ReadDir(filepath.Dir(export.ArchivePath))
+	require.NoError(t, err)
+	assert.Empty(t, entries)
+}
@@ -0,0 +1,195 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/lllypuk/flowra/internal/domain/uuid"
+	"github.com/lllypuk/flowra/internal/infrastructure/chatexport"
+)
+
+// Default chat export worker configuration values.
+const (
+	defaultChatExportPollInterval = 5 * time.Second
+	defaultChatExportStaleAfter   = 30 * time.Minute
+)
+
+// ChatExportWorkerConfig contains configuration for the chat export worker.
+type ChatExportWorkerConfig struct {
+	// PollInterval is the time between checks for pending jobs.
+	PollInterval time.Duration
+
+	// StaleAfter requeues running jobs started this long ago, which happens when
+	// a worker stops mid-export.
+	StaleAfter time.Duration
+
+	// Enabled determines if the worker should run.
+	Enabled bool
+}
+
+// DefaultChatExportWorkerConfig returns sensible default configuration.
+func DefaultChatExportWorkerConfig() ChatExportWorkerConfig {
+	return ChatExportWorkerConfig{
+		PollInterval: defaultChatExportPollInterval,
+		StaleAfter:   defaultChatExportStaleAfter,
+		Enabled:      true,
+	}
+}
+
+// ChatExportJobQueue hands out chat export jobs and records their outcome.
+// Declared on the consumer side per project guidelines.
+type ChatExportJobQueue interface {
+	ClaimNext(ctx context.Context) (*chatexport.Job, error)
+	Complete(ctx context.Context, id string, messageCount int) error
+	Fail(ctx context.Context, id string, jobErr error) error
+	Requeue(ctx context.Context, before time.Time) (int, error)
+}
+
+// ChatTranscriptExporter writes the transcript of a chat.
+// Declared on the consumer side per project guidelines.
+type ChatTranscriptExporter interface {
+	Export(ctx context.Context, chatID uuid.UUID, format chatexport.Format, w io.Writer) (int, error)
+}
+
+// ChatExportWorker writes the transcripts of chats too large to export within
+// the request, one at a time.
+type ChatExportWorker struct {
+	jobs     ChatExportJobQueue
+	exporter ChatTranscriptExporter
+	logger   *slog.Logger
+	config   ChatExportWorkerConfig
+}
+
+// NewChatExportWorker creates a new chat export worker.
+func NewChatExportWorker(
+	jobs ChatExportJobQueue,
+	exporter ChatTranscriptExporter,
+	logger *slog.Logger,
+	config ChatExportWorkerConfig,
+) *ChatExportWorker {
+	if logger == nil {
+		logger = slog.Default()
+	}
+
+	return &ChatExportWorker{
+		jobs:     jobs,
+		exporter: exporter,
+		logger:   logger,
+		config:   config,
+	}
+}
+
+// Run starts the polling loop and blocks until the context is cancelled.
+func (w *ChatExportWorker) Run(ctx context.Context) error {
+	if !w.config.Enabled {
+		w.logger.InfoContext(ctx, "chat export worker disabled")
+		return nil
+	}
+
+	w.logger.InfoContext(ctx, "starting chat export worker",
+		slog.Duration("poll_interval", w.config.PollInterval),
+	)
+
+	ticker := time.NewTicker(w.config.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			w.logger.InfoContext(ctx, "chat export worker stopped")
+			return ctx.Err()
+		case <-ticker.C:
+			w.ProcessPending(ctx)
+		}
+	}
+}
+
+// ProcessPending requeues stale jobs and runs pending jobs until none are left.
+func (w *ChatExportWorker) ProcessPending(ctx context.Context) {
+	if requeued, err := w.jobs.Requeue(ctx, time.Now().Add(-w.config.StaleAfter)); err != nil {
+		w.logger.ErrorContext(ctx, "failed to check for stale chat export jobs", slog.String("error", err.Error()))
+	} else if requeued > 0 {
+		w.logger.WarnContext(ctx, "requeued interrupted chat export jobs", slog.Int("count", requeued))
+	}
+
+	for ctx.Err() == nil {
+		job, err := w.jobs.ClaimNext(ctx)
+		if errors.Is(err, chatexport.ErrNoPendingJobs) {
+			return
+		}
+		if err != nil {
+			w.logger.ErrorContext(ctx, "failed to claim chat export job", slog.String("error", err.Error()))
+			return
+		}
+		w.runJob(ctx, job)
+	}
+}
+
+func (w *ChatExportWorker) runJob(ctx context.Context, job *chatexport.Job) {
+	start := time.Now()
+	count, err := w.export(ctx, job)
+	if err != nil {
+		w.logger.ErrorContext(ctx, "chat export job failed",
+			slog.String("job_id", job.ID),
+			slog.String("chat_id", job.ChatID),
+			slog.String("error", err.Error()),
+		)
+		if failErr := w.jobs.Fail(ctx, job.ID, err); failErr != nil {
+			w.logger.ErrorContext(ctx, "failed to mark chat export job as failed",
+				slog.String("job_id", job.ID),
+				slog.String("error", failErr.Error()),
+			)
+		}
+		return
+	}
+
+	if completeErr := w.jobs.Complete(ctx, job.ID, count); completeErr != nil {
+		w.logger.ErrorContext(ctx, "failed to mark chat export job as completed",
+			slog.String("job_id", job.ID),
+			slog.String("error", completeErr.Error()),
+		)
+		return
+	}
+	w.logger.InfoContext(ctx, "chat export job completed",
+		slog.String("job_id", job.ID),
+		slog.String("chat_id", job.ChatID),
+		slog.Int("messages", count),
+		slog.Duration("duration", time.Since(start)),
+	)
+}
+
+// export writes the transcript next to its final path and moves it into place
+// once complete, so a download never sees a partial transcript.
+func (w *ChatExportWorker) export(ctx context.Context, job *chatexport.Job) (int, error) {
+	chatID, err := uuid.ParseUUID(job.ChatID)
+	if err != nil {
+		return 0, fmt.Errorf("invalid chat ID: %w", err)
+	}
+	if err = os.MkdirAll(filepath.Dir(job.FilePath), 0o750); err != nil {
+		return 0, fmt.Errorf("failed to create export directory: %w", err)
+	}
+
+	tmpPath := job.FilePath + ".tmp"
+	f, err := os.Create(tmpPath)
+	if err != nil {
+		return 0, fmt.Errorf("failed to create transcript: %w", err)
+	}
+	count, err := w.exporter.Export(ctx, chatID, job.Format, f)
+	if closeErr := f.Close(); err == nil && closeErr != nil {
+		err = fmt.Errorf("failed to write transcript: %w", closeErr)
+	}
+	if err == nil {
+		err = os.Rename(tmpPath, job.FilePath)
+	}
+	if err != nil {
+		_ = os.Remove(tmpPath)
+		return 0, err
+	}
+	return count, nil
+}
@@ -0,0 +1,130 @@
+package worker_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/lllypuk/flowra/internal/domain/uuid"
+	"github.com/lllypuk/flowra/internal/infrastructure/chatexport"
+	"github.com/lllypuk/flowra/internal/worker"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type stubChatExportQueue struct {
+	pending   []*chatexport.Job
+	completed map[string]int
+	failed    map[string]string
+	requeues  int
+}
+
+func newStubChatExportQueue(jobs ...*chatexport.Job) *stubChatExportQueue {
+	return &stubChatExportQueue{
+		pending:   jobs,
+		completed: make(map[string]int),
+		failed:    make(map[string]string),
+	}
+}
+
+func (q *stubChatExportQueue) ClaimNext(context.Context) (*chatexport.Job, error) {
+	if len(q.pending) == 0 {
+		return nil, chatexport.ErrNoPendingJobs
+	}
+	job := q.pending[0]
+	q.pending = q.pending[1:]
+	return job, nil
+}
+
+func (q *stubChatExportQueue) Complete(_ context.Context, id string, messageCount int) error {
+	q.completed[id] = messageCount
+	return nil
+}
+
+func (q *stubChatExportQueue) Fail(_ context.Context, id string, jobErr error) error {
+	q.failed[id] = jobErr.Error()
+	return nil
+}
+
+func (q *stubChatExportQueue) Requeue(context.Context, time.Time) (int, error) {
+	q.requeues++
+	return 0, nil
+}
+
+type stubTranscriptExporter struct {
+	err error
+}
+
+func (e *stubTranscriptExporter) Export(
+	_ context.Context,
+	_ uuid.UUID,
+	format chatexport.Format,
+	w io.Writer,
+) (int, error) {
+	if e.err != nil {
+		return 0, e.err
+	}
+	_, err := io.WriteString(w, "transcript."+format.Ext())
+	return 7, err
+}
+
+func TestChatExportWorker_ProcessPending(t *testing.T) {
+	t.Run("writes the transcript and completes the job", func(t *testing.T) {
+		dir := t.TempDir()
+		job := chatexport.NewJob(uuid.NewUUID(), uuid.NewUUID(), uuid.NewUUID(), chatexport.FormatHTML, dir)
+		queue := newStubChatExportQueue(job)
+		w := worker.NewChatExportWorker(
+			queue, &stubTranscriptExporter{}, slog.Default(), worker.DefaultChatExportWorkerConfig())
+
+		w.ProcessPending(context.Background())
+
+		assert.Equal(t, 7, queue.completed[job.ID])
+		assert.Equal(t, 1, queue.requeues)
+		data, err := os.ReadFile(job.FilePath)
+		require.NoError(t, err)
+		assert.Equal(t, "transcript.html", string(data))
+		assert.Equal(t, ".html", filepath.Ext(job.FilePath))
+	})
+
+	t.Run("fails the job and leaves no partial transcript", func(t *testing.T) {
+		dir := t.TempDir()
+		job := chatexport.NewJob(uuid.NewUUID(), uuid.NewUUID(), uuid.NewUUID(), chatexport.FormatJSON, dir)
+		queue := newStubChatExportQueue(job)
+		w := worker.NewChatExportWorker(queue, &stubTranscriptExporter{err: errors.New("mongo down")},
+			slog.Default(), worker.DefaultChatExportWorkerConfig())
+
+		w.ProcessPending(context.Background())
+
+		assert.Equal(t, "mongo down", queue.failed[job.ID])
+		entries, err := os.ReadDir(dir)
+		require.NoError(t, err)
+		assert.Empty(t, entries)
+	})
+
+	t.Run("fails jobs with an invalid chat ID", func(t *testing.T) {
+		job := chatexport.NewJob(uuid.NewUUID(), uuid.NewUUID(), uuid.NewUUID(), chatexport.FormatJSON, t.TempDir())
+		job.ChatID = "not-a-uuid"
+		queue := newStubChatExportQueue(job)
+		w := worker.NewChatExportWorker(
+			queue, &stubTranscriptExporter{}, slog.Default(), worker.DefaultChatExportWorkerConfig())
+
+		w.ProcessPending(context.Background())
+
+		assert.Contains(t, queue.failed[job.ID], "invalid chat ID")
+	})
+}
+
+func TestChatExportWorker_Run_Disabled(t *testing.T) {
+	queue := newStubChatExportQueue()
+	config := worker.DefaultChatExportWorkerConfig()
+	config.Enabled = false
+	w := worker.NewChatExportWorker(queue, &stubTranscriptExporter{}, slog.Default(), config)
+
+	require.NoError(t, w.Run(context.Background()))
+	assert.Zero(t, queue.requeues)
+}
@@ -0,0 +1,58 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+
+	"github.com/lllypuk/flowra/internal/infrastructure/ldap"
+)
+
+// LDAPUserDirectory searches the users to sync in an LDAP directory.
+type LDAPUserDirectory interface {
+	SearchUsers(ctx context.Context) ([]ldap.User, error)
+}
+
+// NewLDAPUserSyncWorker creates a user sync worker that reads users from an LDAP
+// directory instead of the Keycloak admin API. Users are linked by the directory
+// ID attribute, so it must match the sub claim of the identity tokens.
+func NewLDAPUserSyncWorker(
+	directory LDAPUserDirectory,
+	userRepo SyncUserRepository,
+	logger *slog.Logger,
+	config UserSyncConfig,
+) *UserSyncWorker {
+	return newUserSyncWorker(ldapUserSource{directory: directory}, userRepo, logger, config)
+}
+
+// ldapUserSource searches the directory once per sync run and passes the result
+// on in batches.
+type ldapUserSource struct {
+	directory LDAPUserDirectory
+}
+
+func (s ldapUserSource) ListUsers(ctx context.Context, batchSize int, visit func([]SyncedUser) error) error {
+	found, err := s.directory.SearchUsers(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to search ldap users: %w", err)
+	}
+
+	users := make([]SyncedUser, 0, len(found))
+	for _, u := range found {
+		users = append(users, SyncedUser{
+			ExternalID:  u.ID,
+			Username:    u.Username,
+			Email:       u.Email,
+			DisplayName: u.DisplayName,
+			Enabled:     u.Enabled,
+		})
+	}
+
+	for batch := range slices.Chunk(users, batchSize) {
+		if visitErr := visit(batch); visitErr != nil {
+			return visitErr
+		}
+	}
+	return nil
+}
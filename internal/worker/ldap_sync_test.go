@@ -0,0 +1,83 @@
+package worker_test
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/lllypuk/flowra/internal/domain/user"
+	"github.com/lllypuk/flowra/internal/infrastructure/ldap"
+	"github.com/lllypuk/flowra/internal/worker"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeLDAPDirectory returns a fixed set of directory users.
+type fakeLDAPDirectory struct {
+	users    []ldap.User
+	err      error
+	searches int
+}
+
+func (f *fakeLDAPDirectory) SearchUsers(_ context.Context) ([]ldap.User, error) {
+	f.searches++
+	return f.users, f.err
+}
+
+func TestLDAPUserSyncWorker_Sync(t *testing.T) {
+	localUser, err := user.NewUser("uuid-gone", "gone", "gone@example.com", "Gone")
+	require.NoError(t, err)
+
+	directory := &fakeLDAPDirectory{users: []ldap.User{
+		{
+			ID: "uuid-alice", Username: "alice", Email: "alice@example.com",
+			FirstName: "Alice", LastName: "Smith", DisplayName: "Alice Smith (Ops)", Enabled: true,
+		},
+		{ID: "uuid-bob", Username: "bob", Email: "bob@example.com", DisplayName: "Bob", Enabled: true},
+		{ID: "uuid-carol", Username: "carol", Email: "carol@example.com", DisplayName: "Carol", Enabled: false},
+	}}
+	repo := NewMockSyncUserRepository()
+	repo.AddUser(localUser)
+
+	// a batch smaller than the directory pages through the snapshot
+	w := worker.NewLDAPUserSyncWorker(directory, repo, slog.Default(), worker.UserSyncConfig{
+		Interval:  time.Hour,
+		BatchSize: 2,
+		Enabled:   true,
+	})
+
+	require.NoError(t, w.Sync(context.Background()))
+	assert.Equal(t, 1, directory.searches)
+	assert.Equal(t, 4, repo.UserCount())
+
+	alice := repo.GetUser("uuid-alice")
+	require.NotNil(t, alice)
+	assert.Equal(t, "Alice Smith (Ops)", alice.DisplayName(), "the directory display name is kept as is")
+	assert.True(t, alice.IsActive())
+
+	carol := repo.GetUser("uuid-carol")
+	require.NotNil(t, carol)
+	assert.False(t, carol.IsActive())
+
+	gone := repo.GetUser("uuid-gone")
+	require.NotNil(t, gone)
+	assert.False(t, gone.IsActive())
+
+	// every run searches the directory again
+	require.NoError(t, w.Sync(context.Background()))
+	assert.Equal(t, 2, directory.searches)
+}
+
+func TestLDAPUserSyncWorker_Sync_SearchError(t *testing.T) {
+	directory := &fakeLDAPDirectory{err: errors.New("ldap server unavailable")}
+	repo := NewMockSyncUserRepository()
+
+	w := worker.NewLDAPUserSyncWorker(directory, repo, slog.Default(), worker.DefaultUserSyncConfig())
+
+	err := w.Sync(context.Background())
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "ldap server unavailable")
+	assert.Equal(t, 0, repo.UserCount())
+}
@@ -0,0 +1,145 @@
+package worker
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/lllypuk/flowra/internal/domain/uuid"
+	"github.com/lllypuk/flowra/internal/infrastructure/mailer"
+)
+
+// Default notification digest worker configuration values.
+const (
+	defaultNotificationDigestInterval  = time.Minute
+	defaultNotificationDigestBatchSize = 500
+)
+
+// NotificationDigestWorkerConfig contains configuration for the notification digest worker.
+type NotificationDigestWorkerConfig struct {
+	// Interval is the time between checks for deferred emails that are due.
+	Interval time.Duration
+
+	// BatchSize is the maximum number of deferred emails delivered per run.
+	BatchSize int
+
+	// Enabled determines if the worker should run.
+	Enabled bool
+}
+
+// DefaultNotificationDigestWorkerConfig returns sensible default configuration.
+func DefaultNotificationDigestWorkerConfig() NotificationDigestWorkerConfig {
+	return NotificationDigestWorkerConfig{
+		Interval:  defaultNotificationDigestInterval,
+		BatchSize: defaultNotificationDigestBatchSize,
+		Enabled:   true,
+	}
+}
+
+// DeferredEmailQueue hands out the notification emails whose do-not-disturb
+// window has ended.
+// Declared on the consumer side per project guidelines.
+type DeferredEmailQueue interface {
+	Due(ctx context.Context, before time.Time, limit int) ([]mailer.DeferredEmail, error)
+	Delete(ctx context.Context, ids []string) error
+}
+
+// DigestSender emails the deferred notifications of one user.
+// Declared on the consumer side per project guidelines.
+type DigestSender interface {
+	SendDigest(ctx context.Context, userID uuid.UUID, emails []mailer.DeferredEmail) error
+}
+
+// NotificationDigestWorker emails the notifications held back by do-not-disturb
+// schedules once the window of their recipient has ended, one digest per user.
+type NotificationDigestWorker struct {
+	queue  DeferredEmailQueue
+	sender DigestSender
+	logger *slog.Logger
+	config NotificationDigestWorkerConfig
+}
+
+// NewNotificationDigestWorker creates a new notification digest worker.
+func NewNotificationDigestWorker(
+	queue DeferredEmailQueue,
+	sender DigestSender,
+	logger *slog.Logger,
+	config NotificationDigestWorkerConfig,
+) *NotificationDigestWorker {
+	if logger == nil {
+		logger = slog.Default()
+	}
+
+	return &NotificationDigestWorker{
+		queue:  queue,
+		sender: sender,
+		logger: logger,
+		config: config,
+	}
+}
+
+// Run starts the polling loop and blocks until the context is cancelled.
+func (w *NotificationDigestWorker) Run(ctx context.Context) error {
+	if !w.config.Enabled {
+		w.logger.InfoContext(ctx, "notification digest worker disabled")
+		return nil
+	}
+
+	w.logger.InfoContext(ctx, "starting notification digest worker",
+		slog.Duration("interval", w.config.Interval),
+	)
+
+	ticker := time.NewTicker(w.config.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			w.logger.InfoContext(ctx, "notification digest worker stopped")
+			return ctx.Err()
+		case <-ticker.C:
+			w.DeliverDue(ctx)
+		}
+	}
+}
+
+// DeliverDue emails the due deferred notifications, grouped per user. Emails of
+// a user whose digest fails stay queued for the next run.
+func (w *NotificationDigestWorker) DeliverDue(ctx context.Context) {
+	due, err := w.queue.Due(ctx, time.Now(), w.config.BatchSize)
+	if err != nil {
+		w.logger.ErrorContext(ctx, "failed to load due deferred emails", slog.String("error", err.Error()))
+		return
+	}
+
+	var users []string
+	byUser := make(map[string][]mailer.DeferredEmail)
+	for _, email := range due {
+		if _, seen := byUser[email.UserID]; !seen {
+			users = append(users, email.UserID)
+		}
+		byUser[email.UserID] = append(byUser[email.UserID], email)
+	}
+
+	for _, userID := range users {
+		emails := byUser[userID]
+		if sendErr := w.sender.SendDigest(ctx, uuid.UUID(userID), emails); sendErr != nil {
+			w.logger.ErrorContext(ctx, "failed to email notification digest",
+				slog.String("user_id", userID),
+				slog.String("error", sendErr.Error()),
+			)
+			continue
+		}
+
+		ids := make([]string, len(emails))
+		for i, email := range emails {
+			ids[i] = email.ID
+		}
+		if delErr := w.queue.Delete(ctx, ids); delErr != nil {
+			w.logger.ErrorContext(ctx, "failed to remove delivered deferred emails",
+				slog.String("user_id", userID),
+				slog.String("error", delErr.Error()),
+			)
+		}
+	}
+}
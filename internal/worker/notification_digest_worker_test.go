@@ -0,0 +1,66 @@
+package worker_test
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/lllypuk/flowra/internal/domain/uuid"
+	"github.com/lllypuk/flowra/internal/infrastructure/mailer"
+	"github.com/lllypuk/flowra/internal/worker"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type stubDeferredQueue struct {
+	due     []mailer.DeferredEmail
+	deleted []string
+}
+
+func (q *stubDeferredQueue) Due(context.Context, time.Time, int) ([]mailer.DeferredEmail, error) {
+	return q.due, nil
+}
+
+func (q *stubDeferredQueue) Delete(_ context.Context, ids []string) error {
+	q.deleted = append(q.deleted, ids...)
+	return nil
+}
+
+type recordingDigestSender struct {
+	digests map[uuid.UUID]int
+	failFor uuid.UUID
+}
+
+func (s *recordingDigestSender) SendDigest(_ context.Context, userID uuid.UUID, emails []mailer.DeferredEmail) error {
+	if userID == s.failFor {
+		return errors.New("smtp down")
+	}
+	s.digests[userID] = len(emails)
+	return nil
+}
+
+func TestNotificationDigestWorker_DeliverDue(t *testing.T) {
+	alice, bob := uuid.NewUUID(), uuid.NewUUID()
+	queue := &stubDeferredQueue{due: []mailer.DeferredEmail{
+		{ID: "1", UserID: alice.String()},
+		{ID: "2", UserID: bob.String()},
+		{ID: "3", UserID: alice.String()},
+	}}
+	sender := &recordingDigestSender{digests: make(map[uuid.UUID]int), failFor: bob}
+	w := worker.NewNotificationDigestWorker(queue, sender, slog.Default(), worker.DefaultNotificationDigestWorkerConfig())
+
+	w.DeliverDue(context.Background())
+
+	assert.Equal(t, map[uuid.UUID]int{alice: 2}, sender.digests, "one digest per user")
+	assert.ElementsMatch(t, []string{"1", "3"}, queue.deleted, "failed digests stay queued")
+}
+
+func TestNotificationDigestWorker_Run_Disabled(t *testing.T) {
+	config := worker.DefaultNotificationDigestWorkerConfig()
+	config.Enabled = false
+	w := worker.NewNotificationDigestWorker(&stubDeferredQueue{}, &recordingDigestSender{}, slog.Default(), config)
+
+	require.NoError(t, w.Run(context.Background()))
+}
@@ -3,6 +3,7 @@ package worker
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"log/slog"
 	"time"
@@ -10,6 +11,7 @@ import (
 	"github.com/lllypuk/flowra/internal/application/appcore"
 	"github.com/lllypuk/flowra/internal/domain/event"
 	"github.com/lllypuk/flowra/internal/infrastructure/metrics"
+	"github.com/lllypuk/flowra/internal/pkg/retry"
 )
 
 // Default outbox worker configuration values.
@@ -17,7 +19,10 @@ const (
 	defaultOutboxPollInterval = 100 * time.Millisecond
 	defaultOutboxBatchSize    = 100
 	defaultOutboxMaxRetries   = 5
-	defaultOutboxCleanupAge   = 7 * 24 * time.Hour // 7 days
+
+	defaultOutboxPublishAttempts = 3
+	defaultOutboxPublishBackoff  = 50 * time.Millisecond
+	defaultOutboxPublishMaxDelay = 500 * time.Millisecond
 )
 
 // OutboxWorkerConfig contains configuration for the outbox worker.
@@ -31,11 +36,9 @@ type OutboxWorkerConfig struct {
 	// MaxRetries is the maximum number of retry attempts for failed publishes.
 	MaxRetries int
 
-	// CleanupAge is the age after which processed entries are cleaned up.
-	CleanupAge time.Duration
-
-	// CleanupInterval is how often to run the cleanup process.
-	CleanupInterval time.Duration
+	// PublishRetry retries a publish in place before the failure counts against MaxRetries,
+	// so short Redis blips do not burn through an entry's retries.
+	PublishRetry retry.Policy
 
 	// Enabled determines if the worker should run.
 	Enabled bool
@@ -44,12 +47,22 @@ type OutboxWorkerConfig struct {
 // DefaultOutboxWorkerConfig returns sensible default configuration.
 func DefaultOutboxWorkerConfig() OutboxWorkerConfig {
 	return OutboxWorkerConfig{
-		PollInterval:    defaultOutboxPollInterval,
-		BatchSize:       defaultOutboxBatchSize,
-		MaxRetries:      defaultOutboxMaxRetries,
-		CleanupAge:      defaultOutboxCleanupAge,
-		CleanupInterval: 1 * time.Hour,
-		Enabled:         true,
+		PollInterval: defaultOutboxPollInterval,
+		BatchSize:    defaultOutboxBatchSize,
+		MaxRetries:   defaultOutboxMaxRetries,
+		PublishRetry: DefaultOutboxPublishRetry(),
+		Enabled:      true,
+	}
+}
+
+// DefaultOutboxPublishRetry returns the in-place retry policy for outbox publishes.
+func DefaultOutboxPublishRetry() retry.Policy {
+	return retry.Policy{
+		MaxAttempts:    defaultOutboxPublishAttempts,
+		InitialBackoff: defaultOutboxPublishBackoff,
+		MaxBackoff:     defaultOutboxPublishMaxDelay,
+		Multiplier:     retry.DefaultMultiplier,
+		Jitter:         retry.DefaultJitter,
 	}
 }
 
@@ -99,9 +112,6 @@ func (w *OutboxWorker) Run(ctx context.Context) error {
 	pollTicker := time.NewTicker(w.config.PollInterval)
 	defer pollTicker.Stop()
 
-	cleanupTicker := time.NewTicker(w.config.CleanupInterval)
-	defer cleanupTicker.Stop()
-
 	for {
 		select {
 		case <-ctx.Done():
@@ -117,16 +127,6 @@ func (w *OutboxWorker) Run(ctx context.Context) error {
 					slog.String("error", err.Error()),
 				)
 			}
-
-		case <-cleanupTicker.C:
-			deleted, err := w.outbox.Cleanup(ctx, w.config.CleanupAge)
-			if err != nil {
-				w.logger.ErrorContext(ctx, "failed to cleanup outbox",
-					slog.String("error", err.Error()),
-				)
-			} else if w.metrics != nil && deleted > 0 {
-				w.metrics.CleanupDeletedTotal.Add(float64(deleted))
-			}
 		}
 	}
 }
@@ -185,21 +185,24 @@ func (w *OutboxWorker) processEntry(ctx context.Context, entry appcore.OutboxEnt
 		}
 	}()
 
-	// Check if max retries exceeded
+	// Quarantine entries that exhausted their retries so they stop blocking the queue
 	if entry.RetryCount >= w.config.MaxRetries {
-		w.logger.ErrorContext(ctx, "outbox entry exceeded max retries, marking as processed",
+		w.logger.ErrorContext(ctx, "outbox entry exceeded max retries, quarantining",
 			slog.String("entry_id", entry.ID),
 			slog.String("event_type", entry.EventType),
 			slog.Int("retry_count", entry.RetryCount),
 			slog.String("last_error", entry.LastError),
 		)
-		// Mark as processed to prevent infinite retries
-		if err := w.outbox.MarkProcessed(ctx, entry.ID); err != nil {
+		var lastErr error
+		if entry.LastError != "" {
+			lastErr = errors.New(entry.LastError)
+		}
+		if err := w.outbox.Quarantine(ctx, entry.ID, lastErr); err != nil {
 			return err
 		}
-		// Record as failed
 		if w.metrics != nil {
 			w.metrics.EventsProcessed.WithLabelValues(entry.EventType, "failed").Inc()
+			w.metrics.QuarantinedTotal.WithLabelValues(entry.EventType).Inc()
 		}
 		return nil
 	}
@@ -214,8 +217,20 @@ func (w *OutboxWorker) processEntry(ctx context.Context, entry appcore.OutboxEnt
 	}
 
 	// Publish to event bus with timing
+	policy := w.config.PublishRetry
+	policy.OnRetry = func(attempt int, err error, delay time.Duration) {
+		w.logger.DebugContext(ctx, "retrying outbox publish",
+			slog.String("entry_id", entry.ID),
+			slog.String("event_type", entry.EventType),
+			slog.Int("attempt", attempt),
+			slog.Duration("backoff", delay),
+			slog.String("error", err.Error()),
+		)
+	}
 	publishStart := time.Now()
-	if err := w.eventBus.Publish(ctx, evt); err != nil {
+	if err := retry.Do(ctx, policy, func(ctx context.Context) error {
+		return w.eventBus.Publish(ctx, evt)
+	}); err != nil {
 		// Record retry metric
 		if w.metrics != nil {
 			w.metrics.RetryTotal.WithLabelValues(entry.EventType).Inc()
@@ -279,6 +294,15 @@ func (w *OutboxWorker) updateGaugeMetrics(ctx context.Context) {
 	// Update pending count
 	w.metrics.EventsPending.Set(float64(count))
 
+	// Update quarantine depth
+	if quarantined, quarantineErr := w.outbox.CountQuarantined(ctx); quarantineErr != nil {
+		w.logger.WarnContext(ctx, "failed to count quarantined outbox entries for metrics",
+			slog.String("error", quarantineErr.Error()),
+		)
+	} else {
+		w.metrics.QuarantineDepth.Set(float64(quarantined))
+	}
+
 	// Update oldest event age (0 if no events)
 	if !oldest.IsZero() && count > 0 {
 		age := time.Since(oldest).Seconds()
@@ -177,6 +177,8 @@ func (w *RepairWorker) processTask(ctx context.Context, task repair.Task) error
 	switch task.TaskType {
 	case repair.TaskTypeReadModelSync:
 		return w.processReadModelSync(ctx, task)
+	case repair.TaskTypeRebuildAll:
+		return w.processRebuildAll(ctx, task)
 	default:
 		return fmt.Errorf("unknown task type: %s", task.TaskType)
 	}
@@ -189,14 +191,9 @@ func (w *RepairWorker) processReadModelSync(ctx context.Context, task repair.Tas
 		return fmt.Errorf("invalid aggregate ID: %w", err)
 	}
 
-	var projector appcore.ReadModelProjector
-	switch strings.ToLower(strings.TrimSpace(task.AggregateType)) {
-	case "chat":
-		projector = w.chatProjector
-	case "task":
-		projector = w.taskProjector
-	default:
-		return fmt.Errorf("unsupported aggregate type: %s", task.AggregateType)
+	projector, err := w.projectorFor(task.AggregateType)
+	if err != nil {
+		return err
 	}
 
 	// Rebuild the read model
@@ -212,6 +209,36 @@ func (w *RepairWorker) processReadModelSync(ctx context.Context, task repair.Tas
 	return nil
 }
 
+// processRebuildAll rebuilds every read model of the task's aggregate type.
+func (w *RepairWorker) processRebuildAll(ctx context.Context, task repair.Task) error {
+	projector, err := w.projectorFor(task.AggregateType)
+	if err != nil {
+		return err
+	}
+
+	if rebuildErr := projector.RebuildAll(ctx); rebuildErr != nil {
+		return fmt.Errorf("failed to rebuild read models: %w", rebuildErr)
+	}
+
+	w.logger.InfoContext(ctx, "successfully rebuilt all read models",
+		slog.String("aggregate_type", task.AggregateType),
+	)
+
+	return nil
+}
+
+// projectorFor returns the projector of the aggregate type.
+func (w *RepairWorker) projectorFor(aggregateType string) (appcore.ReadModelProjector, error) {
+	switch strings.ToLower(strings.TrimSpace(aggregateType)) {
+	case "chat":
+		return w.chatProjector, nil
+	case "task":
+		return w.taskProjector, nil
+	default:
+		return nil, fmt.Errorf("unsupported aggregate type: %s", aggregateType)
+	}
+}
+
 // GetStats returns repair queue statistics.
 func (w *RepairWorker) GetStats(ctx context.Context) (*repair.QueueStats, error) {
 	return w.repairQueue.GetStats(ctx)
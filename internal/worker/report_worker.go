@@ -0,0 +1,143 @@
+package worker
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	reportapp "github.com/lllypuk/flowra/internal/application/report"
+	"github.com/lllypuk/flowra/internal/domain/workspace"
+)
+
+// Default report refresh configuration values.
+const (
+	defaultReportRefreshInterval = 15 * time.Minute
+	reportWorkspacePageSize      = 100
+)
+
+// ReportRefreshConfig contains configuration for the report refresh worker.
+type ReportRefreshConfig struct {
+	// Interval is the time between refreshes of all workspace reports.
+	Interval time.Duration
+
+	// Enabled determines if the worker should run.
+	Enabled bool
+}
+
+// DefaultReportRefreshConfig returns sensible default configuration.
+func DefaultReportRefreshConfig() ReportRefreshConfig {
+	return ReportRefreshConfig{
+		Interval: defaultReportRefreshInterval,
+		Enabled:  true,
+	}
+}
+
+// ReportWorkspaceLister lists workspaces page by page.
+// Declared on the consumer side per project guidelines.
+type ReportWorkspaceLister interface {
+	List(ctx context.Context, offset, limit int) ([]*workspace.Workspace, error)
+}
+
+// ReportGenerator regenerates the report snapshot of a workspace.
+// Declared on the consumer side per project guidelines.
+type ReportGenerator interface {
+	Execute(ctx context.Context, cmd reportapp.GenerateCommand) (*reportapp.Snapshot, error)
+}
+
+// ReportRefreshWorker periodically regenerates the cached report snapshots
+// of all workspaces so that report pages stay fast.
+type ReportRefreshWorker struct {
+	workspaces ReportWorkspaceLister
+	generator  ReportGenerator
+	logger     *slog.Logger
+	config     ReportRefreshConfig
+}
+
+// NewReportRefreshWorker creates a new report refresh worker.
+func NewReportRefreshWorker(
+	workspaces ReportWorkspaceLister,
+	generator ReportGenerator,
+	logger *slog.Logger,
+	config ReportRefreshConfig,
+) *ReportRefreshWorker {
+	if logger == nil {
+		logger = slog.Default()
+	}
+
+	return &ReportRefreshWorker{
+		workspaces: workspaces,
+		generator:  generator,
+		logger:     logger,
+		config:     config,
+	}
+}
+
+// Run starts the refresh loop and blocks until the context is cancelled.
+func (w *ReportRefreshWorker) Run(ctx context.Context) error {
+	if !w.config.Enabled {
+		w.logger.InfoContext(ctx, "report refresh worker disabled")
+		return nil
+	}
+
+	w.logger.InfoContext(ctx, "starting report refresh worker",
+		slog.Duration("interval", w.config.Interval),
+	)
+
+	ticker := time.NewTicker(w.config.Interval)
+	defer ticker.Stop()
+
+	// Refresh immediately on start
+	w.RefreshAll(ctx)
+
+	for {
+		select {
+		case <-ctx.Done():
+			w.logger.InfoContext(ctx, "report refresh worker stopped")
+			return ctx.Err()
+		case <-ticker.C:
+			w.RefreshAll(ctx)
+		}
+	}
+}
+
+// RefreshAll regenerates the snapshots of all workspaces.
+// A failing workspace is logged and does not stop the others.
+func (w *ReportRefreshWorker) RefreshAll(ctx context.Context) {
+	start := time.Now()
+	refreshed, failed := 0, 0
+
+	for offset := 0; ; offset += reportWorkspacePageSize {
+		workspaces, err := w.workspaces.List(ctx, offset, reportWorkspacePageSize)
+		if err != nil {
+			w.logger.ErrorContext(ctx, "failed to list workspaces for report refresh",
+				slog.String("error", err.Error()),
+			)
+			return
+		}
+
+		for _, ws := range workspaces {
+			if ctx.Err() != nil {
+				return
+			}
+			if _, genErr := w.generator.Execute(ctx, reportapp.GenerateCommand{WorkspaceID: ws.ID()}); genErr != nil {
+				failed++
+				w.logger.ErrorContext(ctx, "failed to refresh workspace reports",
+					slog.String("workspace_id", ws.ID().String()),
+					slog.String("error", genErr.Error()),
+				)
+				continue
+			}
+			refreshed++
+		}
+
+		if len(workspaces) < reportWorkspacePageSize {
+			break
+		}
+	}
+
+	w.logger.InfoContext(ctx, "report refresh completed",
+		slog.Int("refreshed", refreshed),
+		slog.Int("failed", failed),
+		slog.Duration("duration", time.Since(start)),
+	)
+}
@@ -0,0 +1,120 @@
+package worker_test
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"testing"
+	"time"
+
+	reportapp "github.com/lllypuk/flowra/internal/application/report"
+	"github.com/lllypuk/flowra/internal/domain/uuid"
+	"github.com/lllypuk/flowra/internal/domain/workspace"
+	"github.com/lllypuk/flowra/internal/worker"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type stubWorkspaceLister struct {
+	workspaces []*workspace.Workspace
+	err        error
+}
+
+func (s *stubWorkspaceLister) List(_ context.Context, offset, limit int) ([]*workspace.Workspace, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	if offset >= len(s.workspaces) {
+		return nil, nil
+	}
+	end := min(offset+limit, len(s.workspaces))
+	return s.workspaces[offset:end], nil
+}
+
+type stubReportGenerator struct {
+	failFor   uuid.UUID
+	generated []uuid.UUID
+}
+
+func (g *stubReportGenerator) Execute(
+	_ context.Context,
+	cmd reportapp.GenerateCommand,
+) (*reportapp.Snapshot, error) {
+	if cmd.WorkspaceID == g.failFor {
+		return nil, errors.New("generation failed")
+	}
+	g.generated = append(g.generated, cmd.WorkspaceID)
+	return &reportapp.Snapshot{WorkspaceID: cmd.WorkspaceID}, nil
+}
+
+func newTestWorkspaces(t *testing.T, n int) []*workspace.Workspace {
+	t.Helper()
+	workspaces := make([]*workspace.Workspace, 0, n)
+	for range n {
+		ws, err := workspace.NewWorkspace("Workspace", "", "group", uuid.NewUUID())
+		require.NoError(t, err)
+		workspaces = append(workspaces, ws)
+	}
+	return workspaces
+}
+
+func TestDefaultReportRefreshConfig(t *testing.T) {
+	config := worker.DefaultReportRefreshConfig()
+
+	assert.Equal(t, 15*time.Minute, config.Interval)
+	assert.True(t, config.Enabled)
+}
+
+func TestReportRefreshWorker_RefreshAll(t *testing.T) {
+	t.Run("refreshes every workspace across pages", func(t *testing.T) {
+		workspaces := newTestWorkspaces(t, 130)
+		generator := &stubReportGenerator{}
+		w := worker.NewReportRefreshWorker(
+			&stubWorkspaceLister{workspaces: workspaces},
+			generator,
+			slog.Default(),
+			worker.DefaultReportRefreshConfig(),
+		)
+
+		w.RefreshAll(context.Background())
+
+		assert.Len(t, generator.generated, len(workspaces))
+	})
+
+	t.Run("failing workspace does not stop the others", func(t *testing.T) {
+		workspaces := newTestWorkspaces(t, 3)
+		generator := &stubReportGenerator{failFor: workspaces[1].ID()}
+		w := worker.NewReportRefreshWorker(
+			&stubWorkspaceLister{workspaces: workspaces},
+			generator,
+			nil,
+			worker.DefaultReportRefreshConfig(),
+		)
+
+		w.RefreshAll(context.Background())
+
+		assert.Equal(t, []uuid.UUID{workspaces[0].ID(), workspaces[2].ID()}, generator.generated)
+	})
+
+	t.Run("list error stops the refresh", func(t *testing.T) {
+		generator := &stubReportGenerator{}
+		w := worker.NewReportRefreshWorker(
+			&stubWorkspaceLister{err: errors.New("mongo down")},
+			generator,
+			nil,
+			worker.DefaultReportRefreshConfig(),
+		)
+
+		w.RefreshAll(context.Background())
+
+		assert.Empty(t, generator.generated)
+	})
+}
+
+func TestReportRefreshWorker_RunDisabled(t *testing.T) {
+	config := worker.DefaultReportRefreshConfig()
+	config.Enabled = false
+	w := worker.NewReportRefreshWorker(&stubWorkspaceLister{}, &stubReportGenerator{}, nil, config)
+
+	require.NoError(t, w.Run(context.Background()))
+}
@@ -0,0 +1,193 @@
+package worker
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/lllypuk/flowra/internal/config"
+	"github.com/lllypuk/flowra/internal/infrastructure/metrics"
+)
+
+// Retention policy kinds, also used as the metric label.
+const (
+	RetentionKindMessages      = "messages"
+	RetentionKindNotifications = "notifications"
+	RetentionKindRepairQueue   = "repair_queue"
+	RetentionKindOutbox        = "outbox"
+)
+
+// RetentionConfig contains configuration for the retention worker.
+type RetentionConfig struct {
+	// Interval is the time between retention passes.
+	Interval time.Duration
+
+	// BatchSize is the number of documents purged per batch.
+	BatchSize int
+
+	// BatchPause is the pause between batches, limiting the load on the database.
+	BatchPause time.Duration
+
+	// Enabled determines if the worker should run.
+	Enabled bool
+}
+
+// DefaultRetentionConfig returns sensible default configuration.
+func DefaultRetentionConfig() RetentionConfig {
+	return RetentionConfig{
+		Interval:   config.DefaultRetentionInterval,
+		BatchSize:  config.DefaultRetentionBatchSize,
+		BatchPause: config.DefaultRetentionBatchPause,
+		Enabled:    true,
+	}
+}
+
+// RetentionPurgeFunc purges up to limit documents that expired before the given time
+// and returns how many it purged.
+type RetentionPurgeFunc func(ctx context.Context, before time.Time, limit int) (int64, error)
+
+// RetentionPolicy purges one kind of document once it is older than MaxAge.
+type RetentionPolicy struct {
+	// Kind names the purged documents in logs and metrics.
+	Kind string
+
+	// MaxAge is how long documents are kept. Zero disables the policy.
+	MaxAge time.Duration
+
+	// Purge purges one batch of expired documents.
+	Purge RetentionPurgeFunc
+}
+
+// RetentionWorker enforces the retention policies in one coordinated pass: each
+// policy purges in batches with a pause in between until no expired documents are
+// left, so a large backlog is worked off without monopolising the database.
+type RetentionWorker struct {
+	policies []RetentionPolicy
+	logger   *slog.Logger
+	config   RetentionConfig
+	metrics  *metrics.RetentionMetrics
+}
+
+// NewRetentionWorker creates a new retention worker. Metrics are optional.
+func NewRetentionWorker(
+	policies []RetentionPolicy,
+	logger *slog.Logger,
+	config RetentionConfig,
+	metrics *metrics.RetentionMetrics,
+) *RetentionWorker {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	if config.BatchSize <= 0 {
+		config.BatchSize = DefaultRetentionConfig().BatchSize
+	}
+
+	return &RetentionWorker{
+		policies: policies,
+		logger:   logger,
+		config:   config,
+		metrics:  metrics,
+	}
+}
+
+// Run starts the retention loop and blocks until the context is cancelled.
+func (w *RetentionWorker) Run(ctx context.Context) error {
+	if !w.config.Enabled {
+		w.logger.InfoContext(ctx, "retention worker disabled")
+		return nil
+	}
+
+	w.logger.InfoContext(ctx, "starting retention worker",
+		slog.Duration("interval", w.config.Interval),
+		slog.Int("batch_size", w.config.BatchSize),
+		slog.Duration("batch_pause", w.config.BatchPause),
+	)
+
+	ticker := time.NewTicker(w.config.Interval)
+	defer ticker.Stop()
+
+	// Purge immediately on start
+	w.PurgeOnce(ctx)
+
+	for {
+		select {
+		case <-ctx.Done():
+			w.logger.InfoContext(ctx, "retention worker stopped")
+			return ctx.Err()
+		case <-ticker.C:
+			w.PurgeOnce(ctx)
+		}
+	}
+}
+
+// PurgeOnce runs every enabled policy in turn. A failing policy is logged and
+// skipped until the next pass, so it does not hold back the others.
+func (w *RetentionWorker) PurgeOnce(ctx context.Context) {
+	start := time.Now()
+
+	for _, policy := range w.policies {
+		if ctx.Err() != nil {
+			return
+		}
+		if policy.MaxAge <= 0 {
+			continue
+		}
+		w.purge(ctx, policy, start.Add(-policy.MaxAge))
+	}
+
+	if w.metrics != nil && ctx.Err() == nil {
+		finished := time.Now()
+		w.metrics.RecordPass(finished.Sub(start), finished)
+	}
+}
+
+// purge purges the documents of one policy batch by batch until a batch comes back short.
+func (w *RetentionWorker) purge(ctx context.Context, policy RetentionPolicy, cutoff time.Time) {
+	var total int64
+	for {
+		purged, err := policy.Purge(ctx, cutoff, w.config.BatchSize)
+		if err != nil {
+			w.logger.ErrorContext(ctx, "failed to enforce retention policy",
+				slog.String("kind", policy.Kind),
+				slog.String("error", err.Error()),
+			)
+			if w.metrics != nil {
+				w.metrics.RecordError(policy.Kind)
+			}
+			break
+		}
+
+		total += purged
+		if w.metrics != nil && purged > 0 {
+			w.metrics.RecordPurged(policy.Kind, purged)
+		}
+		if purged < int64(w.config.BatchSize) || !w.pause(ctx) {
+			break
+		}
+	}
+
+	if total > 0 {
+		w.logger.InfoContext(ctx, "enforced retention policy",
+			slog.String("kind", policy.Kind),
+			slog.Int64("purged", total),
+			slog.Time("before", cutoff),
+		)
+	}
+}
+
+// pause waits between batches. Returns false when the context is cancelled.
+func (w *RetentionWorker) pause(ctx context.Context) bool {
+	if w.config.BatchPause <= 0 {
+		return ctx.Err() == nil
+	}
+
+	timer := time.NewTimer(w.config.BatchPause)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return false
+	case <-timer.C:
+		return true
+	}
+}
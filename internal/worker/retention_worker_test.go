@@ -0,0 +1,163 @@
+package worker_test
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/lllypuk/flowra/internal/infrastructure/metrics"
+	"github.com/lllypuk/flowra/internal/worker"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// stubPurger purges from a fixed backlog of expired documents
+type stubPurger struct {
+	mu      sync.Mutex
+	backlog int64
+	cutoffs []time.Time
+	limits  []int
+	err     error
+}
+
+func (p *stubPurger) Purge(_ context.Context, before time.Time, limit int) (int64, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.cutoffs = append(p.cutoffs, before)
+	p.limits = append(p.limits, limit)
+	if p.err != nil {
+		return 0, p.err
+	}
+	purged := min(p.backlog, int64(limit))
+	p.backlog -= purged
+	return purged, nil
+}
+
+func (p *stubPurger) calls() int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return len(p.cutoffs)
+}
+
+func testRetentionConfig() worker.RetentionConfig {
+	config := worker.DefaultRetentionConfig()
+	config.BatchSize = 10
+	config.BatchPause = 0
+	return config
+}
+
+func TestDefaultRetentionConfig(t *testing.T) {
+	config := worker.DefaultRetentionConfig()
+
+	assert.Equal(t, time.Hour, config.Interval)
+	assert.Positive(t, config.BatchSize)
+	assert.Positive(t, config.BatchPause)
+	assert.True(t, config.Enabled)
+}
+
+func TestRetentionWorker_PurgeOnce(t *testing.T) {
+	t.Run("purges each policy in batches until nothing expired is left", func(t *testing.T) {
+		messages := &stubPurger{backlog: 25}
+		outbox := &stubPurger{backlog: 3}
+		registry := prometheus.NewRegistry()
+		retentionMetrics := metrics.NewRetentionMetrics(registry)
+		w := worker.NewRetentionWorker([]worker.RetentionPolicy{
+			{Kind: worker.RetentionKindMessages, MaxAge: 48 * time.Hour, Purge: messages.Purge},
+			{Kind: worker.RetentionKindOutbox, MaxAge: time.Hour, Purge: outbox.Purge},
+		}, slog.Default(), testRetentionConfig(), retentionMetrics)
+
+		w.PurgeOnce(context.Background())
+
+		assert.Equal(t, []int{10, 10, 10}, messages.limits)
+		assert.WithinDuration(t, time.Now().Add(-48*time.Hour), messages.cutoffs[0], time.Minute)
+		assert.Equal(t, 1, outbox.calls())
+		assert.WithinDuration(t, time.Now().Add(-time.Hour), outbox.cutoffs[0], time.Minute)
+
+		assert.InDelta(t, 25, testutil.ToFloat64(retentionMetrics.PurgedTotal.WithLabelValues("messages")), 0)
+		assert.InDelta(t, 3, testutil.ToFloat64(retentionMetrics.PurgedTotal.WithLabelValues("outbox")), 0)
+		assert.Positive(t, testutil.ToFloat64(retentionMetrics.LastPass))
+	})
+
+	t.Run("skips policies without a maximum age", func(t *testing.T) {
+		notifications := &stubPurger{backlog: 5}
+		w := worker.NewRetentionWorker([]worker.RetentionPolicy{
+			{Kind: worker.RetentionKindNotifications, Purge: notifications.Purge},
+		}, slog.Default(), testRetentionConfig(), nil)
+
+		w.PurgeOnce(context.Background())
+
+		assert.Zero(t, notifications.calls())
+	})
+
+	t.Run("a failing policy does not hold back the others", func(t *testing.T) {
+		repairs := &stubPurger{err: errors.New("mongo down")}
+		outbox := &stubPurger{backlog: 5}
+		registry := prometheus.NewRegistry()
+		retentionMetrics := metrics.NewRetentionMetrics(registry)
+		w := worker.NewRetentionWorker([]worker.RetentionPolicy{
+			{Kind: worker.RetentionKindRepairQueue, MaxAge: time.Hour, Purge: repairs.Purge},
+			{Kind: worker.RetentionKindOutbox, MaxAge: time.Hour, Purge: outbox.Purge},
+		}, slog.Default(), testRetentionConfig(), retentionMetrics)
+
+		w.PurgeOnce(context.Background())
+
+		assert.Equal(t, 1, repairs.calls())
+		assert.Zero(t, outbox.backlog)
+		assert.InDelta(t, 1, testutil.ToFloat64(retentionMetrics.ErrorsTotal.WithLabelValues("repair_queue")), 0)
+	})
+
+	t.Run("stops between batches when the context is cancelled", func(t *testing.T) {
+		messages := &stubPurger{backlog: 100}
+		config := testRetentionConfig()
+		config.BatchPause = time.Hour
+		w := worker.NewRetentionWorker([]worker.RetentionPolicy{
+			{Kind: worker.RetentionKindMessages, MaxAge: time.Hour, Purge: messages.Purge},
+		}, slog.Default(), config, nil)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		done := make(chan struct{})
+		go func() {
+			w.PurgeOnce(ctx)
+			close(done)
+		}()
+
+		require.Eventually(t, func() bool { return messages.calls() == 1 }, time.Second, 10*time.Millisecond)
+		cancel()
+		<-done
+		assert.Equal(t, 1, messages.calls(), "the pause between batches rate-limits the purge")
+	})
+}
+
+func TestRetentionWorker_Run(t *testing.T) {
+	t.Run("disabled worker returns immediately", func(t *testing.T) {
+		messages := &stubPurger{backlog: 5}
+		config := testRetentionConfig()
+		config.Enabled = false
+		w := worker.NewRetentionWorker([]worker.RetentionPolicy{
+			{Kind: worker.RetentionKindMessages, MaxAge: time.Hour, Purge: messages.Purge},
+		}, slog.Default(), config, nil)
+
+		require.NoError(t, w.Run(context.Background()))
+		assert.Zero(t, messages.calls())
+	})
+
+	t.Run("purges on start and stops on cancel", func(t *testing.T) {
+		messages := &stubPurger{backlog: 5}
+		w := worker.NewRetentionWorker([]worker.RetentionPolicy{
+			{Kind: worker.RetentionKindMessages, MaxAge: time.Hour, Purge: messages.Purge},
+		}, slog.Default(), testRetentionConfig(), nil)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		done := make(chan error, 1)
+		go func() { done <- w.Run(ctx) }()
+
+		require.Eventually(t, func() bool { return messages.calls() == 1 }, time.Second, 10*time.Millisecond)
+		cancel()
+		require.ErrorIs(t, <-done, context.Canceled)
+	})
+}
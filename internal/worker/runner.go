@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"log/slog"
 	"os"
+	"os/exec"
 	"strconv"
 	"sync"
 	"time"
@@ -14,13 +15,21 @@ import (
 	"github.com/redis/go-redis/v9"
 	"go.mongodb.org/mongo-driver/v2/mongo"
 
+	reportapp "github.com/lllypuk/flowra/internal/application/report"
 	"github.com/lllypuk/flowra/internal/config"
+	"github.com/lllypuk/flowra/internal/domain/event"
+	"github.com/lllypuk/flowra/internal/infrastructure/backup"
+	"github.com/lllypuk/flowra/internal/infrastructure/chatexport"
 	"github.com/lllypuk/flowra/internal/infrastructure/eventbus"
 	"github.com/lllypuk/flowra/internal/infrastructure/eventstore"
+	"github.com/lllypuk/flowra/internal/infrastructure/filestorage"
 	"github.com/lllypuk/flowra/internal/infrastructure/keycloak"
+	"github.com/lllypuk/flowra/internal/infrastructure/ldap"
+	"github.com/lllypuk/flowra/internal/infrastructure/mailer"
 	"github.com/lllypuk/flowra/internal/infrastructure/metrics"
 	mongodbinfra "github.com/lllypuk/flowra/internal/infrastructure/mongodb"
 	"github.com/lllypuk/flowra/internal/infrastructure/outbox"
+	"github.com/lllypuk/flowra/internal/infrastructure/preview"
 	"github.com/lllypuk/flowra/internal/infrastructure/projector"
 	"github.com/lllypuk/flowra/internal/infrastructure/repair"
 	mongorepo "github.com/lllypuk/flowra/internal/infrastructure/repository/mongodb"
@@ -50,7 +59,13 @@ func Run(ctx context.Context, cfg *config.Config, mongoDB *mongo.Database, redis
 	}
 	legacyCancel()
 
-	userRepo := mongorepo.NewMongoUserRepository(mongoDB.Collection("users"))
+	userRepo := mongorepo.NewMongoUserRepository(
+		mongoDB.Collection("users"),
+		mongorepo.WithUserRepoLogger(logger),
+		mongorepo.WithUserProfileListener(projector.NewTaskUserNamesProjector(
+			mongoDB.Collection(mongodbinfra.CollectionTaskReadModel), logger,
+		)),
+	)
 
 	eventBusInstance := eventbus.NewRedisEventBus(
 		redisCli,
@@ -68,12 +83,11 @@ func Run(ctx context.Context, cfg *config.Config, mongoDB *mongo.Database, redis
 	}
 
 	outboxConfig := OutboxWorkerConfig{
-		PollInterval:    cfg.Outbox.PollInterval,
-		BatchSize:       cfg.Outbox.BatchSize,
-		MaxRetries:      cfg.Outbox.MaxRetries,
-		CleanupAge:      cfg.Outbox.CleanupAge,
-		CleanupInterval: cfg.Outbox.CleanupInterval,
-		Enabled:         cfg.Outbox.Enabled,
+		PollInterval: cfg.Outbox.PollInterval,
+		BatchSize:    cfg.Outbox.BatchSize,
+		MaxRetries:   cfg.Outbox.MaxRetries,
+		PublishRetry: DefaultOutboxPublishRetry(),
+		Enabled:      cfg.Outbox.Enabled,
 	}
 
 	outboxWorker := NewOutboxWorker(
@@ -83,7 +97,29 @@ func Run(ctx context.Context, cfg *config.Config, mongoDB *mongo.Database, redis
 		outboxConfig,
 		outboxMetrics,
 	)
-	repairWorker := setupRepairWorker(mongoDB, logger)
+	eventStore := eventstore.NewMongoEventStore(
+		mongoDB.Client(),
+		mongoDB.Name(),
+		eventstore.WithLogger(logger),
+		eventstore.WithWorkspacePartitions(cfg.EventStore.Partitions),
+	)
+	repairWorker := setupRepairWorker(mongoDB, eventStore, logger)
+	reportWorker := setupReportWorker(mongoDB, eventStore, logger)
+	retentionWorker := setupRetentionWorker(cfg, mongoDB, mongoOutbox, logger)
+	previewWorker, err := setupAttachmentPreviewWorker(cfg, mongoDB, logger)
+	if err != nil {
+		return fmt.Errorf("setup attachment preview worker: %w", err)
+	}
+	slaWorker := setupSLAMonitorWorker(mongoDB, eventBusInstance, logger)
+	backupWorker, err := setupBackupWorker(cfg, mongoDB, eventStore, logger)
+	if err != nil {
+		return fmt.Errorf("setup backup worker: %w", err)
+	}
+	chatExportWorker := setupChatExportWorker(cfg, mongoDB, eventStore, userRepo, logger)
+	digestWorker, err := setupNotificationDigestWorker(cfg, mongoDB, userRepo, logger)
+	if err != nil {
+		return fmt.Errorf("setup notification digest worker: %w", err)
+	}
 
 	logger.InfoContext(ctx, "starting workers",
 		slog.Bool("user_sync_enabled", syncConfig.Enabled),
@@ -91,6 +127,15 @@ func Run(ctx context.Context, cfg *config.Config, mongoDB *mongo.Database, redis
 		slog.Bool("outbox_enabled", outboxConfig.Enabled),
 		slog.Duration("outbox_poll_interval", outboxConfig.PollInterval),
 		slog.Bool("repair_enabled", repairWorker.config.Enabled),
+		slog.Bool("report_refresh_enabled", reportWorker.config.Enabled),
+		slog.Duration("report_refresh_interval", reportWorker.config.Interval),
+		slog.Bool("retention_enabled", retentionWorker.config.Enabled),
+		slog.Duration("retention_interval", retentionWorker.config.Interval),
+		slog.Bool("attachment_preview_enabled", previewWorker.config.Enabled),
+		slog.Bool("sla_monitor_enabled", slaWorker.config.Enabled),
+		slog.Bool("backup_enabled", backupWorker.config.Enabled),
+		slog.Bool("chat_export_enabled", chatExportWorker.config.Enabled),
+		slog.Bool("notification_digest_enabled", digestWorker.config.Enabled),
 	)
 
 	var wg sync.WaitGroup
@@ -113,6 +158,48 @@ func Run(ctx context.Context, cfg *config.Config, mongoDB *mongo.Database, redis
 		}
 	})
 
+	wg.Go(func() {
+		if runErr := reportWorker.Run(ctx); runErr != nil && !errors.Is(runErr, context.Canceled) {
+			logger.Error("report refresh worker error", slog.String("error", runErr.Error()))
+		}
+	})
+
+	wg.Go(func() {
+		if runErr := retentionWorker.Run(ctx); runErr != nil && !errors.Is(runErr, context.Canceled) {
+			logger.Error("retention worker error", slog.String("error", runErr.Error()))
+		}
+	})
+
+	wg.Go(func() {
+		if runErr := previewWorker.Run(ctx); runErr != nil && !errors.Is(runErr, context.Canceled) {
+			logger.Error("attachment preview worker error", slog.String("error", runErr.Error()))
+		}
+	})
+
+	wg.Go(func() {
+		if runErr := slaWorker.Run(ctx); runErr != nil && !errors.Is(runErr, context.Canceled) {
+			logger.Error("SLA monitor worker error", slog.String("error", runErr.Error()))
+		}
+	})
+
+	wg.Go(func() {
+		if runErr := backupWorker.Run(ctx); runErr != nil && !errors.Is(runErr, context.Canceled) {
+			logger.Error("backup worker error", slog.String("error", runErr.Error()))
+		}
+	})
+
+	wg.Go(func() {
+		if runErr := chatExportWorker.Run(ctx); runErr != nil && !errors.Is(runErr, context.Canceled) {
+			logger.Error("chat export worker error", slog.String("error", runErr.Error()))
+		}
+	})
+
+	wg.Go(func() {
+		if runErr := digestWorker.Run(ctx); runErr != nil && !errors.Is(runErr, context.Canceled) {
+			logger.Error("notification digest worker error", slog.String("error", runErr.Error()))
+		}
+	})
+
 	wg.Wait()
 
 	logger.InfoContext(ctx, "worker service shutdown complete")
@@ -152,6 +239,36 @@ func setupUserSyncWorker(
 		return workerInstance, syncConfig, nil
 	}
 
+	if cfg.LDAP.Enabled {
+		directory, err := ldap.NewClient(ldap.Config{
+			URL:            cfg.LDAP.URL,
+			StartTLS:       cfg.LDAP.StartTLS,
+			BindDN:         cfg.LDAP.BindDN,
+			BindPassword:   cfg.LDAP.BindPassword,
+			BaseDN:         cfg.LDAP.BaseDN,
+			UserFilter:     cfg.LDAP.UserFilter,
+			DisabledFilter: cfg.LDAP.DisabledFilter,
+			PageSize:       cfg.LDAP.PageSize,
+			Timeout:        cfg.LDAP.Timeout,
+			Attributes: ldap.AttributeMap{
+				ID:          cfg.LDAP.Attributes.ID,
+				Username:    cfg.LDAP.Attributes.Username,
+				Email:       cfg.LDAP.Attributes.Email,
+				FirstName:   cfg.LDAP.Attributes.FirstName,
+				LastName:    cfg.LDAP.Attributes.LastName,
+				DisplayName: cfg.LDAP.Attributes.DisplayName,
+			},
+		})
+		if err != nil {
+			return nil, UserSyncConfig{}, fmt.Errorf("ldap user sync: %w", err)
+		}
+		logger.Info("user sync reads users from LDAP",
+			slog.String("url", cfg.LDAP.URL),
+			slog.String("base_dn", cfg.LDAP.BaseDN),
+		)
+		return NewLDAPUserSyncWorker(directory, userRepo, logger, syncConfig), syncConfig, nil
+	}
+
 	if cfg.Keycloak.URL == "" || cfg.Keycloak.AdminUsername == "" || cfg.Keycloak.AdminPassword == "" {
 		return nil, UserSyncConfig{}, errors.New("keycloak configuration is required for user sync worker")
 	}
@@ -179,7 +296,11 @@ func setupUserSyncWorker(
 	return workerInstance, syncConfig, nil
 }
 
-func setupRepairWorker(mongoDB *mongo.Database, logger *slog.Logger) *RepairWorker {
+func setupRepairWorker(
+	mongoDB *mongo.Database,
+	eventStore *eventstore.MongoEventStore,
+	logger *slog.Logger,
+) *RepairWorker {
 	repairConfig := DefaultRepairWorkerConfig()
 	if isEnvBoolTrue("REPAIR_WORKER_DISABLED") {
 		repairConfig.Enabled = false
@@ -188,17 +309,14 @@ func setupRepairWorker(mongoDB *mongo.Database, logger *slog.Logger) *RepairWork
 	repairQueueColl := mongoDB.Collection(mongodbinfra.CollectionRepairQueue)
 	repairQueue := repair.NewMongoQueue(repairQueueColl, logger)
 
-	eventStore := eventstore.NewMongoEventStore(
-		mongoDB.Client(),
-		mongoDB.Name(),
-		eventstore.WithLogger(logger),
-	)
-
 	chatReadModelColl := mongoDB.Collection(mongodbinfra.CollectionChatReadModel)
 	chatProjector := projector.NewChatProjector(eventStore, chatReadModelColl, logger)
 
 	taskReadModelColl := mongoDB.Collection(mongodbinfra.CollectionTaskReadModel)
-	taskProjector := projector.NewChatToTaskReadModelProjector(eventStore, taskReadModelColl, logger)
+	taskProjector := projector.NewChatToTaskReadModelProjector(
+		eventStore, taskReadModelColl, logger,
+		projector.WithTaskUserDirectory(mongorepo.NewMongoUserRepository(mongoDB.Collection("users"))),
+	)
 
 	return NewRepairWorker(
 		repairQueue,
@@ -209,6 +327,244 @@ func setupRepairWorker(mongoDB *mongo.Database, logger *slog.Logger) *RepairWork
 	)
 }
 
+func setupReportWorker(
+	mongoDB *mongo.Database,
+	eventStore *eventstore.MongoEventStore,
+	logger *slog.Logger,
+) *ReportRefreshWorker {
+	reportConfig := DefaultReportRefreshConfig()
+	if interval := os.Getenv("REPORT_REFRESH_INTERVAL"); interval != "" {
+		parsed, parseErr := time.ParseDuration(interval)
+		if parseErr != nil || parsed <= 0 {
+			logger.Warn("invalid REPORT_REFRESH_INTERVAL, using default interval",
+				slog.String("value", interval),
+			)
+		} else {
+			reportConfig.Interval = parsed
+		}
+	}
+	if isEnvBoolTrue("REPORT_REFRESH_DISABLED") {
+		reportConfig.Enabled = false
+	}
+
+	chatRepo := mongorepo.NewMongoChatReadModelRepository(
+		mongoDB.Collection(mongodbinfra.CollectionChatReadModel),
+		eventStore,
+	)
+	snapshotRepo := mongorepo.NewMongoReportSnapshotRepository(
+		mongoDB.Collection(mongodbinfra.CollectionReportSnapshots),
+		mongorepo.WithReportSnapshotRepoLogger(logger),
+	)
+	workspaceRepo := mongorepo.NewMongoWorkspaceRepository(
+		mongoDB.Collection(mongodbinfra.CollectionWorkspaces),
+		mongoDB.Collection(mongodbinfra.CollectionMembers),
+	)
+
+	return NewReportRefreshWorker(
+		workspaceRepo,
+		reportapp.NewGenerateUseCase(chatRepo, eventStore, snapshotRepo),
+		logger,
+		reportConfig,
+	)
+}
+
+func setupRetentionWorker(
+	cfg *config.Config,
+	mongoDB *mongo.Database,
+	mongoOutbox *outbox.MongoOutbox,
+	logger *slog.Logger,
+) *RetentionWorker {
+	retentionConfig := RetentionConfig{
+		Interval:   cfg.Retention.Interval,
+		BatchSize:  cfg.Retention.BatchSize,
+		BatchPause: cfg.Retention.BatchPause,
+		Enabled:    !isEnvBoolTrue("RETENTION_WORKER_DISABLED"),
+	}
+
+	messageRepo := mongorepo.NewMongoMessageRepository(
+		mongoDB.Collection(mongodbinfra.CollectionMessages),
+		mongorepo.WithMessageRepoLogger(logger),
+	)
+	notificationRepo := mongorepo.NewMongoNotificationRepository(
+		mongoDB.Collection(mongodbinfra.CollectionNotifications),
+	)
+	repairQueue := repair.NewMongoQueue(mongoDB.Collection(mongodbinfra.CollectionRepairQueue), logger)
+
+	messageRetention := cfg.Messages.DeletedRetention
+	if isEnvBoolTrue("MESSAGE_PURGE_DISABLED") {
+		messageRetention = 0
+	}
+
+	policies := []RetentionPolicy{
+		{Kind: RetentionKindMessages, MaxAge: messageRetention, Purge: messageRepo.PurgeDeleted},
+		{
+			Kind:   RetentionKindNotifications,
+			MaxAge: cfg.Retention.ReadNotificationAge,
+			Purge:  notificationRepo.PurgeReadOlderThan,
+		},
+		{Kind: RetentionKindRepairQueue, MaxAge: cfg.Retention.CompletedRepairAge, Purge: repairQueue.PurgeCompleted},
+		{Kind: RetentionKindOutbox, MaxAge: cfg.Outbox.CleanupAge, Purge: mongoOutbox.PurgeProcessed},
+	}
+
+	return NewRetentionWorker(
+		policies,
+		logger,
+		retentionConfig,
+		metrics.NewRetentionMetrics(prometheus.DefaultRegisterer),
+	)
+}
+
+func setupAttachmentPreviewWorker(
+	cfg *config.Config,
+	mongoDB *mongo.Database,
+	logger *slog.Logger,
+) (*AttachmentPreviewWorker, error) {
+	previewConfig := DefaultAttachmentPreviewConfig()
+	previewConfig.Interval = cfg.Uploads.PreviewInterval
+	if isEnvBoolTrue("ATTACHMENT_PREVIEW_DISABLED") {
+		previewConfig.Enabled = false
+	}
+
+	files, err := filestorage.NewLocalStorage(cfg.Uploads.Dir)
+	if err != nil {
+		return nil, err
+	}
+
+	// Without the renderer every PDF would fail, so PDFs get no preview instead
+	pdfRenderer := cfg.Uploads.PDFRenderer
+	if pdfRenderer != "" {
+		if _, lookErr := exec.LookPath(pdfRenderer); lookErr != nil {
+			logger.Warn("PDF renderer not found, PDF previews disabled",
+				slog.String("pdf_renderer", pdfRenderer),
+			)
+			pdfRenderer = ""
+		}
+	}
+
+	messageRepo := mongorepo.NewMongoMessageRepository(
+		mongoDB.Collection(mongodbinfra.CollectionMessages),
+		mongorepo.WithMessageRepoLogger(logger),
+	)
+	generator := preview.NewGenerator(
+		preview.WithMaxSize(cfg.Uploads.PreviewSize),
+		preview.WithPDFRenderer(pdfRenderer),
+	)
+
+	return NewAttachmentPreviewWorker(messageRepo, files, generator, logger, previewConfig), nil
+}
+
+func setupSLAMonitorWorker(mongoDB *mongo.Database, bus event.Bus, logger *slog.Logger) *SLAMonitorWorker {
+	slaConfig := DefaultSLAMonitorConfig()
+	if isEnvBoolTrue("SLA_MONITOR_DISABLED") {
+		slaConfig.Enabled = false
+	}
+
+	workspaceRepo := mongorepo.NewMongoWorkspaceRepository(
+		mongoDB.Collection(mongodbinfra.CollectionWorkspaces),
+		mongoDB.Collection(mongodbinfra.CollectionMembers),
+	)
+	taskRepo := mongorepo.NewMongoTaskRepository(
+		nil,
+		mongoDB.Collection(mongodbinfra.CollectionTaskReadModel),
+		mongorepo.WithTaskRepoLogger(logger),
+	)
+	breachRepo := mongorepo.NewMongoSLABreachRepository(
+		mongoDB.Collection(mongodbinfra.CollectionSLABreaches),
+		mongorepo.WithSLABreachRepoLogger(logger),
+	)
+
+	return NewSLAMonitorWorker(workspaceRepo, taskRepo, breachRepo, bus, logger, slaConfig)
+}
+
+func setupBackupWorker(
+	cfg *config.Config,
+	mongoDB *mongo.Database,
+	eventStore *eventstore.MongoEventStore,
+	logger *slog.Logger,
+) (*BackupWorker, error) {
+	backupConfig := DefaultBackupWorkerConfig()
+	if isEnvBoolTrue("BACKUP_WORKER_DISABLED") {
+		backupConfig.Enabled = false
+	}
+
+	// The attachments manifest records which files are present in storage
+	files, err := filestorage.NewLocalStorage(cfg.Uploads.Dir)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewBackupWorker(
+		backup.NewMongoJobStore(mongoDB.Collection(mongodbinfra.CollectionBackupJobs)),
+		backup.NewExporter(mongoDB, eventStore, files, logger),
+		backup.NewRestorer(mongoDB, eventStore, files, logger),
+		logger,
+		backupConfig,
+	), nil
+}
+
+func setupChatExportWorker(
+	cfg *config.Config,
+	mongoDB *mongo.Database,
+	eventStore *eventstore.MongoEventStore,
+	userRepo *mongorepo.MongoUserRepository,
+	logger *slog.Logger,
+) *ChatExportWorker {
+	exportConfig := DefaultChatExportWorkerConfig()
+	if isEnvBoolTrue("CHAT_EXPORT_WORKER_DISABLED") {
+		exportConfig.Enabled = false
+	}
+
+	exporter := chatexport.NewExporter(
+		mongorepo.NewMongoChatReadModelRepository(mongoDB.Collection(mongodbinfra.CollectionChatReadModel), eventStore),
+		mongorepo.NewMongoMessageRepository(
+			mongoDB.Collection(mongodbinfra.CollectionMessages),
+			mongorepo.WithMessageRepoLogger(logger),
+		),
+		userRepo,
+		chatexport.WithBaseURL(cfg.Email.BaseURL),
+		chatexport.WithLogger(logger),
+	)
+
+	return NewChatExportWorker(
+		chatexport.NewMongoJobStore(mongoDB.Collection(mongodbinfra.CollectionChatExportJobs)),
+		exporter,
+		logger,
+		exportConfig,
+	)
+}
+
+// setupNotificationDigestWorker emails the notifications deferred by do-not-disturb
+// schedules; it only runs with notification emails enabled.
+func setupNotificationDigestWorker(
+	cfg *config.Config,
+	mongoDB *mongo.Database,
+	userRepo *mongorepo.MongoUserRepository,
+	logger *slog.Logger,
+) (*NotificationDigestWorker, error) {
+	digestConfig := DefaultNotificationDigestWorkerConfig()
+	queue := mailer.NewMongoDeferredEmailStore(mongoDB.Collection(mongodbinfra.CollectionDeferredEmails), logger)
+	if !cfg.Email.Enabled || isEnvBoolTrue("NOTIFICATION_DIGEST_DISABLED") {
+		digestConfig.Enabled = false
+		return NewNotificationDigestWorker(queue, nil, logger, digestConfig), nil
+	}
+
+	sender, err := mailer.NewSMTPSender(mailer.SMTPConfig{
+		Host:     cfg.Email.SMTPHost,
+		Port:     cfg.Email.SMTPPort,
+		Username: cfg.Email.SMTPUsername,
+		Password: cfg.Email.SMTPPassword,
+		From:     cfg.Email.From,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create smtp sender: %w", err)
+	}
+	digests := mailer.NewNotificationHandler(sender, userRepo, nil,
+		mailer.WithChatLinks(cfg.Email.BaseURL, nil),
+		mailer.WithNotificationLogger(logger),
+	)
+	return NewNotificationDigestWorker(queue, digests, logger, digestConfig), nil
+}
+
 func isEnvBoolTrue(key string) bool {
 	value := os.Getenv(key)
 	enabled, err := strconv.ParseBool(value)
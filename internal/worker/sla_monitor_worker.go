@@ -0,0 +1,236 @@
+package worker
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	taskapp "github.com/lllypuk/flowra/internal/application/task"
+	"github.com/lllypuk/flowra/internal/domain/chat"
+	"github.com/lllypuk/flowra/internal/domain/event"
+	"github.com/lllypuk/flowra/internal/domain/uuid"
+	"github.com/lllypuk/flowra/internal/domain/workspace"
+)
+
+// Default SLA monitor configuration values.
+const (
+	defaultSLAMonitorInterval = time.Minute
+	slaWorkspacePageSize      = 100
+)
+
+// SLAMonitorConfig contains configuration for the SLA monitor worker.
+type SLAMonitorConfig struct {
+	// Interval is the time between scans of the open bugs.
+	Interval time.Duration
+
+	// Enabled determines if the worker should run.
+	Enabled bool
+}
+
+// DefaultSLAMonitorConfig returns sensible default configuration.
+func DefaultSLAMonitorConfig() SLAMonitorConfig {
+	return SLAMonitorConfig{
+		Interval: defaultSLAMonitorInterval,
+		Enabled:  true,
+	}
+}
+
+// SLAWorkspaceLister lists workspaces page by page.
+// Declared on the consumer side per project guidelines.
+type SLAWorkspaceLister interface {
+	List(ctx context.Context, offset, limit int) ([]*workspace.Workspace, error)
+}
+
+// SLABugFinder finds the bugs of a workspace whose SLA clock is still open.
+// Declared on the consumer side per project guidelines.
+type SLABugFinder interface {
+	FindOpenSLABugs(ctx context.Context, workspaceID uuid.UUID) ([]*taskapp.ReadModel, error)
+}
+
+// SLABreachRecorder records a breach once per clock and reports whether it is new.
+// Declared on the consumer side per project guidelines.
+type SLABreachRecorder interface {
+	RecordBreach(
+		ctx context.Context,
+		taskID, workspaceID uuid.UUID,
+		kind string,
+		openedAt, dueAt time.Time,
+	) (bool, error)
+}
+
+// SLAMonitorWorker periodically measures the open bugs of every workspace with an
+// SLA policy and publishes chat.sla_breached once per clock that runs past its target.
+// Clocks are identified by their start, so a reopened bug that breaches its
+// resolution target again is not reported twice.
+type SLAMonitorWorker struct {
+	workspaces SLAWorkspaceLister
+	bugs       SLABugFinder
+	breaches   SLABreachRecorder
+	eventBus   event.Bus
+	logger     *slog.Logger
+	config     SLAMonitorConfig
+}
+
+// NewSLAMonitorWorker creates a new SLA monitor worker.
+func NewSLAMonitorWorker(
+	workspaces SLAWorkspaceLister,
+	bugs SLABugFinder,
+	breaches SLABreachRecorder,
+	eventBus event.Bus,
+	logger *slog.Logger,
+	config SLAMonitorConfig,
+) *SLAMonitorWorker {
+	if logger == nil {
+		logger = slog.Default()
+	}
+
+	return &SLAMonitorWorker{
+		workspaces: workspaces,
+		bugs:       bugs,
+		breaches:   breaches,
+		eventBus:   eventBus,
+		logger:     logger,
+		config:     config,
+	}
+}
+
+// Run starts the monitor loop and blocks until the context is cancelled.
+func (w *SLAMonitorWorker) Run(ctx context.Context) error {
+	if !w.config.Enabled {
+		w.logger.InfoContext(ctx, "SLA monitor worker disabled")
+		return nil
+	}
+
+	w.logger.InfoContext(ctx, "starting SLA monitor worker",
+		slog.Duration("interval", w.config.Interval),
+	)
+
+	ticker := time.NewTicker(w.config.Interval)
+	defer ticker.Stop()
+
+	// Check immediately on start
+	w.CheckOnce(ctx)
+
+	for {
+		select {
+		case <-ctx.Done():
+			w.logger.InfoContext(ctx, "SLA monitor worker stopped")
+			return ctx.Err()
+		case <-ticker.C:
+			w.CheckOnce(ctx)
+		}
+	}
+}
+
+// CheckOnce scans all workspaces with an SLA policy and reports new breaches.
+// A failing workspace is logged and does not stop the others.
+func (w *SLAMonitorWorker) CheckOnce(ctx context.Context) {
+	now := time.Now()
+	reported := 0
+
+	for offset := 0; ; offset += slaWorkspacePageSize {
+		workspaces, err := w.workspaces.List(ctx, offset, slaWorkspacePageSize)
+		if err != nil {
+			w.logger.ErrorContext(ctx, "failed to list workspaces for SLA monitor",
+				slog.String("error", err.Error()),
+			)
+			return
+		}
+
+		for _, ws := range workspaces {
+			if ctx.Err() != nil {
+				return
+			}
+			if ws.SLAPolicy().IsZero() {
+				continue
+			}
+			reported += w.checkWorkspace(ctx, ws, now)
+		}
+
+		if len(workspaces) < slaWorkspacePageSize {
+			break
+		}
+	}
+
+	if reported > 0 {
+		w.logger.InfoContext(ctx, "reported SLA breaches", slog.Int("breaches", reported))
+	}
+}
+
+// checkWorkspace reports the new breaches of one workspace and returns their count.
+func (w *SLAMonitorWorker) checkWorkspace(ctx context.Context, ws *workspace.Workspace, now time.Time) int {
+	bugs, err := w.bugs.FindOpenSLABugs(ctx, ws.ID())
+	if err != nil {
+		w.logger.ErrorContext(ctx, "failed to load bugs for SLA monitor",
+			slog.String("workspace_id", ws.ID().String()),
+			slog.String("error", err.Error()),
+		)
+		return 0
+	}
+
+	reported := 0
+	for _, bug := range bugs {
+		if bug.SLA == nil {
+			continue
+		}
+		status := ws.SLAPolicy().Evaluate(
+			bug.Severity,
+			chat.NewSLAClock(bug.SLA.OpenedAt, bug.SLA.RespondedAt, bug.SLA.ResolvedAt),
+			now,
+		)
+		if w.reportBreach(ctx, ws.ID(), bug, chat.SLAKindResponse, status.Response) {
+			reported++
+		}
+		if w.reportBreach(ctx, ws.ID(), bug, chat.SLAKindResolution, status.Resolution) {
+			reported++
+		}
+	}
+	return reported
+}
+
+// reportBreach records a breached running clock and publishes the event the first time.
+func (w *SLAMonitorWorker) reportBreach(
+	ctx context.Context,
+	workspaceID uuid.UUID,
+	bug *taskapp.ReadModel,
+	kind string,
+	deadline workspace.SLADeadline,
+) bool {
+	if !deadline.IsRunning() || !deadline.Breached {
+		return false
+	}
+
+	recorded, err := w.breaches.RecordBreach(ctx, bug.ID, workspaceID, kind, bug.SLA.OpenedAt, deadline.DueAt)
+	if err != nil {
+		w.logger.ErrorContext(ctx, "failed to record SLA breach",
+			slog.String("task_id", bug.ID.String()),
+			slog.String("kind", kind),
+			slog.String("error", err.Error()),
+		)
+		return false
+	}
+	if !recorded || w.eventBus == nil {
+		return recorded
+	}
+
+	evt := chat.NewSLABreached(
+		bug.ChatID,
+		workspaceID,
+		kind,
+		bug.Severity,
+		bug.Title,
+		deadline.DueAt,
+		bug.AssignedTo,
+		bug.CreatedBy,
+		bug.Version,
+		event.Metadata{Timestamp: time.Now()},
+	)
+	if pubErr := w.eventBus.Publish(ctx, evt); pubErr != nil {
+		w.logger.ErrorContext(ctx, "failed to publish SLA breach",
+			slog.String("task_id", bug.ID.String()),
+			slog.String("kind", kind),
+			slog.String("error", pubErr.Error()),
+		)
+	}
+	return true
+}
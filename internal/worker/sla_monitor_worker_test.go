@@ -0,0 +1,167 @@
+package worker_test
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"testing"
+	"time"
+
+	taskapp "github.com/lllypuk/flowra/internal/application/task"
+	"github.com/lllypuk/flowra/internal/domain/chat"
+	"github.com/lllypuk/flowra/internal/domain/event"
+	"github.com/lllypuk/flowra/internal/domain/uuid"
+	"github.com/lllypuk/flowra/internal/domain/workspace"
+	"github.com/lllypuk/flowra/internal/worker"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const slaTestSeverity = "Critical"
+
+type stubSLABugFinder struct {
+	bugs map[uuid.UUID][]*taskapp.ReadModel
+	err  error
+}
+
+func (f *stubSLABugFinder) FindOpenSLABugs(_ context.Context, workspaceID uuid.UUID) ([]*taskapp.ReadModel, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return f.bugs[workspaceID], nil
+}
+
+type stubSLABreachRecorder struct {
+	seen map[string]bool
+}
+
+func (r *stubSLABreachRecorder) RecordBreach(
+	_ context.Context,
+	taskID, _ uuid.UUID,
+	kind string,
+	openedAt, _ time.Time,
+) (bool, error) {
+	if r.seen == nil {
+		r.seen = make(map[string]bool)
+	}
+	key := taskID.String() + kind + openedAt.String()
+	if r.seen[key] {
+		return false, nil
+	}
+	r.seen[key] = true
+	return true, nil
+}
+
+type recordingBus struct {
+	events []event.DomainEvent
+}
+
+func (b *recordingBus) Publish(_ context.Context, evt event.DomainEvent) error {
+	b.events = append(b.events, evt)
+	return nil
+}
+
+func newSLAWorkspace(t *testing.T, target workspace.SLATarget) *workspace.Workspace {
+	t.Helper()
+	ws := newTestWorkspaces(t, 1)[0]
+	policy, err := workspace.NewSLAPolicy(map[string]workspace.SLATarget{slaTestSeverity: target})
+	require.NoError(t, err)
+	ws.SetSLAPolicy(policy)
+	return ws
+}
+
+func newSLABug(workspaceID uuid.UUID, openedAt time.Time, respondedAt *time.Time) *taskapp.ReadModel {
+	id := uuid.NewUUID()
+	return &taskapp.ReadModel{
+		ID:          id,
+		ChatID:      id,
+		WorkspaceID: workspaceID,
+		Title:       "Checkout fails",
+		Severity:    slaTestSeverity,
+		CreatedBy:   uuid.NewUUID(),
+		SLA:         &taskapp.SLAReadModel{OpenedAt: openedAt, RespondedAt: respondedAt},
+	}
+}
+
+func TestDefaultSLAMonitorConfig(t *testing.T) {
+	config := worker.DefaultSLAMonitorConfig()
+
+	assert.Equal(t, time.Minute, config.Interval)
+	assert.True(t, config.Enabled)
+}
+
+func TestSLAMonitorWorker_CheckOnce(t *testing.T) {
+	target := workspace.SLATarget{Response: time.Hour, Resolution: 24 * time.Hour}
+
+	t.Run("publishes each breached clock once", func(t *testing.T) {
+		ws := newSLAWorkspace(t, target)
+		bug := newSLABug(ws.ID(), time.Now().Add(-2*time.Hour), nil)
+		bus := &recordingBus{}
+		w := worker.NewSLAMonitorWorker(
+			&stubWorkspaceLister{workspaces: []*workspace.Workspace{ws}},
+			&stubSLABugFinder{bugs: map[uuid.UUID][]*taskapp.ReadModel{ws.ID(): {bug}}},
+			&stubSLABreachRecorder{},
+			bus,
+			slog.Default(),
+			worker.DefaultSLAMonitorConfig(),
+		)
+
+		w.CheckOnce(context.Background())
+		w.CheckOnce(context.Background())
+
+		require.Len(t, bus.events, 1)
+		breached, ok := bus.events[0].(*chat.SLABreached)
+		require.True(t, ok)
+		assert.Equal(t, chat.EventTypeSLABreached, breached.EventType())
+		assert.Equal(t, chat.SLAKindResponse, breached.Kind)
+		assert.Equal(t, ws.ID(), breached.WorkspaceID)
+		assert.Equal(t, bug.ChatID.String(), breached.AggregateID())
+	})
+
+	t.Run("ignores clocks within target and stopped clocks", func(t *testing.T) {
+		ws := newSLAWorkspace(t, target)
+		respondedAt := time.Now().Add(-90 * time.Minute)
+		bugs := []*taskapp.ReadModel{
+			newSLABug(ws.ID(), time.Now().Add(-10*time.Minute), nil),
+			newSLABug(ws.ID(), time.Now().Add(-2*time.Hour), &respondedAt),
+		}
+		bus := &recordingBus{}
+		w := worker.NewSLAMonitorWorker(
+			&stubWorkspaceLister{workspaces: []*workspace.Workspace{ws}},
+			&stubSLABugFinder{bugs: map[uuid.UUID][]*taskapp.ReadModel{ws.ID(): bugs}},
+			&stubSLABreachRecorder{},
+			bus,
+			slog.Default(),
+			worker.DefaultSLAMonitorConfig(),
+		)
+
+		w.CheckOnce(context.Background())
+
+		assert.Empty(t, bus.events)
+	})
+
+	t.Run("skips workspaces without a policy", func(t *testing.T) {
+		ws := newTestWorkspaces(t, 1)[0]
+		bus := &recordingBus{}
+		w := worker.NewSLAMonitorWorker(
+			&stubWorkspaceLister{workspaces: []*workspace.Workspace{ws}},
+			&stubSLABugFinder{err: errors.New("must not be called")},
+			&stubSLABreachRecorder{},
+			bus,
+			slog.Default(),
+			worker.DefaultSLAMonitorConfig(),
+		)
+
+		w.CheckOnce(context.Background())
+
+		assert.Empty(t, bus.events)
+	})
+}
+
+func TestSLAMonitorWorker_RunDisabled(t *testing.T) {
+	config := worker.DefaultSLAMonitorConfig()
+	config.Enabled = false
+	w := worker.NewSLAMonitorWorker(nil, nil, nil, nil, slog.Default(), config)
+
+	require.NoError(t, w.Run(context.Background()))
+}
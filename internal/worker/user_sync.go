@@ -46,6 +46,65 @@ type KeycloakUserClient interface {
 	CountUsers(ctx context.Context) (int, error)
 }
 
+// SyncedUser is a user as the identity source reports it, whether the source is
+// Keycloak or an LDAP directory.
+type SyncedUser struct {
+	ExternalID  string
+	Username    string
+	Email       string
+	DisplayName string
+	Enabled     bool
+}
+
+// userSource lists the users of an identity source.
+type userSource interface {
+	// ListUsers reads the source and passes its users to visit in batches of at
+	// most batchSize. Every call reads the source anew.
+	ListUsers(ctx context.Context, batchSize int, visit func([]SyncedUser) error) error
+}
+
+// keycloakUserSource pages through the users of the Keycloak admin API.
+type keycloakUserSource struct {
+	client KeycloakUserClient
+}
+
+func (s keycloakUserSource) ListUsers(ctx context.Context, batchSize int, visit func([]SyncedUser) error) error {
+	totalCount, err := s.client.CountUsers(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to count keycloak users: %w", err)
+	}
+
+	for offset := 0; offset < totalCount; offset += batchSize {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return ctxErr
+		}
+
+		kcUsers, listErr := s.client.ListUsers(ctx, offset, batchSize)
+		if listErr != nil {
+			return fmt.Errorf("failed to list keycloak users at offset %d: %w", offset, listErr)
+		}
+
+		users := make([]SyncedUser, 0, len(kcUsers))
+		for _, kcUser := range kcUsers {
+			users = append(users, keycloakSyncedUser(kcUser))
+		}
+		if visitErr := visit(users); visitErr != nil {
+			return visitErr
+		}
+	}
+	return nil
+}
+
+func keycloakSyncedUser(kcUser keycloak.User) SyncedUser {
+	return SyncedUser{
+		ExternalID:  kcUser.ID,
+		Username:    kcUser.Username,
+		Email:       kcUser.Email,
+		DisplayName: buildDisplayName(kcUser),
+		Enabled:     kcUser.Enabled,
+	}
+}
+
 // SyncUserRepository is the interface for user persistence operations needed by sync.
 type SyncUserRepository interface {
 	FindByExternalID(ctx context.Context, externalID string) (*user.User, error)
@@ -53,30 +112,40 @@ type SyncUserRepository interface {
 	ListExternalIDs(ctx context.Context) ([]string, error)
 }
 
-// UserSyncWorker handles periodic synchronization of users from Keycloak to MongoDB.
+// UserSyncWorker handles periodic synchronization of users from the identity
+// source (Keycloak or an LDAP directory) to MongoDB.
 type UserSyncWorker struct {
-	keycloakClient KeycloakUserClient
-	userRepo       SyncUserRepository
-	logger         *slog.Logger
-	config         UserSyncConfig
+	source   userSource
+	userRepo SyncUserRepository
+	logger   *slog.Logger
+	config   UserSyncConfig
 }
 
-// NewUserSyncWorker creates a new user sync worker.
+// NewUserSyncWorker creates a new user sync worker reading users from Keycloak.
 func NewUserSyncWorker(
 	keycloakClient KeycloakUserClient,
 	userRepo SyncUserRepository,
 	logger *slog.Logger,
 	config UserSyncConfig,
+) *UserSyncWorker {
+	return newUserSyncWorker(keycloakUserSource{client: keycloakClient}, userRepo, logger, config)
+}
+
+func newUserSyncWorker(
+	source userSource,
+	userRepo SyncUserRepository,
+	logger *slog.Logger,
+	config UserSyncConfig,
 ) *UserSyncWorker {
 	if logger == nil {
 		logger = slog.Default()
 	}
 
 	return &UserSyncWorker{
-		keycloakClient: keycloakClient,
-		userRepo:       userRepo,
-		logger:         logger,
-		config:         config,
+		source:   source,
+		userRepo: userRepo,
+		logger:   logger,
+		config:   config,
 	}
 }
 
@@ -123,45 +192,26 @@ type SyncResult struct {
 	Duration    time.Duration
 }
 
-// Sync performs a single synchronization of all users from Keycloak.
+// Sync performs a single synchronization of all users from the identity source.
 func (w *UserSyncWorker) Sync(ctx context.Context) error {
 	start := time.Now()
 	w.logger.InfoContext(ctx, "starting user sync")
 
-	// Get total count from Keycloak
-	totalCount, err := w.keycloakClient.CountUsers(ctx)
-	if err != nil {
-		return fmt.Errorf("failed to count keycloak users: %w", err)
-	}
-
-	w.logger.DebugContext(ctx, "keycloak user count", slog.Int("count", totalCount))
-
 	// Track seen external IDs to detect deleted users
-	seenExternalIDs := make(map[string]bool, totalCount)
+	seenExternalIDs := make(map[string]bool)
 
 	result := SyncResult{}
 
 	// Fetch and sync in batches
-	for offset := 0; offset < totalCount; offset += w.config.BatchSize {
-		select {
-		case <-ctx.Done():
-			return ctx.Err()
-		default:
-		}
-
-		kcUsers, listErr := w.keycloakClient.ListUsers(ctx, offset, w.config.BatchSize)
-		if listErr != nil {
-			return fmt.Errorf("failed to list keycloak users at offset %d: %w", offset, listErr)
-		}
+	err := w.source.ListUsers(ctx, w.config.BatchSize, func(users []SyncedUser) error {
+		for _, synced := range users {
+			seenExternalIDs[synced.ExternalID] = true
 
-		for _, kcUser := range kcUsers {
-			seenExternalIDs[kcUser.ID] = true
-
-			syncResult, syncErr := w.syncUser(ctx, kcUser)
+			syncResult, syncErr := w.syncUser(ctx, synced)
 			if syncErr != nil {
 				w.logger.WarnContext(ctx, "failed to sync user",
-					slog.String("keycloak_id", kcUser.ID),
-					slog.String("username", kcUser.Username),
+					slog.String("external_id", synced.ExternalID),
+					slog.String("username", synced.Username),
 					slog.String("error", syncErr.Error()),
 				)
 				result.Errors++
@@ -179,13 +229,14 @@ func (w *UserSyncWorker) Sync(ctx context.Context) error {
 			}
 		}
 
-		w.logger.DebugContext(ctx, "processed batch",
-			slog.Int("offset", offset),
-			slog.Int("batch_size", len(kcUsers)),
-		)
+		w.logger.DebugContext(ctx, "processed batch", slog.Int("batch_size", len(users)))
+		return nil
+	})
+	if err != nil {
+		return err
 	}
 
-	// Deactivate users not found in Keycloak
+	// Deactivate users not found in the identity source
 	deactivated, deactErr := w.deactivateMissingUsers(ctx, seenExternalIDs)
 	if deactErr != nil {
 		w.logger.WarnContext(ctx, "failed to deactivate missing users", slog.String("error", deactErr.Error()))
@@ -213,53 +264,51 @@ const (
 	syncResultUpdated
 )
 
-func (w *UserSyncWorker) syncUser(ctx context.Context, kcUser keycloak.User) (syncResultType, error) {
+func (w *UserSyncWorker) syncUser(ctx context.Context, synced SyncedUser) (syncResultType, error) {
 	// Try to find existing user by external ID
-	existing, err := w.userRepo.FindByExternalID(ctx, kcUser.ID)
+	existing, err := w.userRepo.FindByExternalID(ctx, synced.ExternalID)
 	if err != nil && !errors.Is(err, errs.ErrNotFound) {
 		return syncResultNoChange, fmt.Errorf("failed to find user by external ID: %w", err)
 	}
 
-	displayName := buildDisplayName(kcUser)
-
 	if existing == nil {
 		// Create new user
 		newUser, createErr := user.NewUser(
-			kcUser.ID,
-			kcUser.Username,
-			kcUser.Email,
-			displayName,
+			synced.ExternalID,
+			synced.Username,
+			synced.Email,
+			synced.DisplayName,
 		)
 		if createErr != nil {
 			return syncResultNoChange, fmt.Errorf("failed to create user: %w", createErr)
 		}
 
-		// Set active status based on Keycloak enabled flag
-		newUser.SetActive(kcUser.Enabled)
+		// Set active status based on the enabled flag of the identity source
+		newUser.SetActive(synced.Enabled)
 
 		if saveErr := w.userRepo.Save(ctx, newUser); saveErr != nil {
 			return syncResultNoChange, fmt.Errorf("failed to save new user: %w", saveErr)
 		}
 
-		w.logger.DebugContext(ctx, "created user from keycloak",
+		w.logger.DebugContext(ctx, "created user from identity source",
 			slog.String("user_id", newUser.ID().String()),
-			slog.String("keycloak_id", kcUser.ID),
-			slog.String("username", kcUser.Username),
+			slog.String("external_id", synced.ExternalID),
+			slog.String("username", synced.Username),
 		)
 
 		return syncResultCreated, nil
 	}
 
 	// Update existing user if needed
-	if existing.UpdateFromSync(kcUser.Username, kcUser.Email, displayName, kcUser.Enabled) {
+	if existing.UpdateFromSync(synced.Username, synced.Email, synced.DisplayName, synced.Enabled) {
 		if saveErr := w.userRepo.Save(ctx, existing); saveErr != nil {
 			return syncResultNoChange, fmt.Errorf("failed to update user: %w", saveErr)
 		}
 
-		w.logger.DebugContext(ctx, "updated user from keycloak",
+		w.logger.DebugContext(ctx, "updated user from identity source",
 			slog.String("user_id", existing.ID().String()),
-			slog.String("keycloak_id", kcUser.ID),
-			slog.String("username", kcUser.Username),
+			slog.String("external_id", synced.ExternalID),
+			slog.String("username", synced.Username),
 		)
 
 		return syncResultUpdated, nil
@@ -278,10 +327,10 @@ func (w *UserSyncWorker) deactivateMissingUsers(ctx context.Context, seenExterna
 	var deactivated int
 	for _, externalID := range localExternalIDs {
 		if seenExternalIDs[externalID] {
-			continue // User exists in Keycloak
+			continue // User exists in the identity source
 		}
 
-		// User not found in Keycloak, deactivate them
+		// User not found in the identity source, deactivate them
 		localUser, findErr := w.userRepo.FindByExternalID(ctx, externalID)
 		if findErr != nil {
 			w.logger.WarnContext(ctx, "failed to find user for deactivation",
@@ -305,7 +354,7 @@ func (w *UserSyncWorker) deactivateMissingUsers(ctx context.Context, seenExterna
 			continue
 		}
 
-		w.logger.InfoContext(ctx, "deactivated user not found in keycloak",
+		w.logger.InfoContext(ctx, "deactivated user not found in identity source",
 			slog.String("user_id", localUser.ID().String()),
 			slog.String("external_id", externalID),
 			slog.String("username", localUser.Username()),
@@ -329,7 +378,7 @@ func buildDisplayName(kcUser keycloak.User) string {
 // SyncSingleUser synchronizes a single user from Keycloak by their external ID.
 // This is useful for on-demand sync after login or profile updates.
 func (w *UserSyncWorker) SyncSingleUser(ctx context.Context, kcUser keycloak.User) error {
-	result, err := w.syncUser(ctx, kcUser)
+	result, err := w.syncUser(ctx, keycloakSyncedUser(kcUser))
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,352 @@
+package flowraclient
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// The admin endpoints require a token of a system admin.
+
+// AdminWorkspace is a workspace as seen by system admins.
+type AdminWorkspace struct {
+	ID              string `json:"id"`
+	Name            string `json:"name"`
+	Description     string `json:"description,omitempty"`
+	CreatedBy       string `json:"created_by"`
+	KeycloakGroupID string `json:"keycloak_group_id,omitempty"`
+	CreatedAt       string `json:"created_at"`
+	UpdatedAt       string `json:"updated_at"`
+	MemberCount     *int   `json:"member_count,omitempty"`
+}
+
+// AdminWorkspaceList is a page of workspaces.
+type AdminWorkspaceList struct {
+	Workspaces []AdminWorkspace `json:"workspaces"`
+	Total      int              `json:"total"`
+	Offset     int              `json:"offset"`
+	Limit      int              `json:"limit"`
+}
+
+// AdminUser is a user as seen by system admins.
+type AdminUser struct {
+	ID            string `json:"id"`
+	ExternalID    string `json:"external_id,omitempty"`
+	Username      string `json:"username"`
+	Email         string `json:"email"`
+	DisplayName   string `json:"display_name,omitempty"`
+	IsSystemAdmin bool   `json:"is_system_admin"`
+	IsActive      bool   `json:"is_active"`
+	CreatedAt     string `json:"created_at"`
+	UpdatedAt     string `json:"updated_at"`
+}
+
+// AdminUserList is a page of users.
+type AdminUserList struct {
+	Users  []AdminUser `json:"users"`
+	Total  int         `json:"total"`
+	Offset int         `json:"offset"`
+	Limit  int         `json:"limit"`
+}
+
+// UserImportRow is the outcome of one row of a user import. Status is "created",
+// "valid" (dry run) or "failed".
+type UserImportRow struct {
+	Line     int    `json:"line"`
+	Username string `json:"username"`
+	Email    string `json:"email"`
+	Status   string `json:"status"`
+	UserID   string `json:"user_id,omitempty"`
+	Error    string `json:"error,omitempty"`
+}
+
+// UserImportReport is the per-row report of a user import.
+type UserImportReport struct {
+	DryRun  bool            `json:"dry_run"`
+	Created int             `json:"created"`
+	Valid   int             `json:"valid"`
+	Failed  int             `json:"failed"`
+	Rows    []UserImportRow `json:"rows"`
+}
+
+// MessageImport is a batch of historical messages for AdminImportMessages.
+// Authors maps the author names used in Messages, e.g. Slack user IDs, to user IDs.
+type MessageImport struct {
+	Authors  map[string]string `json:"authors"`
+	Messages []ImportMessage   `json:"messages"`
+	DryRun   bool              `json:"dry_run"`
+}
+
+// ImportMessage is one historical message. CreatedAt is the original time in RFC
+// 3339 format. A reply names its parent by ParentExternalID, for an earlier message
+// of the batch, or by ParentID, for a message imported before.
+type ImportMessage struct {
+	ExternalID       string `json:"external_id,omitempty"`
+	Author           string `json:"author"`
+	Content          string `json:"content"`
+	CreatedAt        string `json:"created_at"`
+	ParentExternalID string `json:"parent_external_id,omitempty"`
+	ParentID         string `json:"parent_id,omitempty"`
+}
+
+// MessageImportRow is the outcome of one message of an import. Status is
+// "imported", "valid" (dry run) or "failed".
+type MessageImportRow struct {
+	Index      int    `json:"index"`
+	ExternalID string `json:"external_id,omitempty"`
+	Status     string `json:"status"`
+	MessageID  string `json:"message_id,omitempty"`
+	Error      string `json:"error,omitempty"`
+}
+
+// MessageImportReport is the per-message report of a message import.
+type MessageImportReport struct {
+	DryRun   bool               `json:"dry_run"`
+	Imported int                `json:"imported"`
+	Valid    int                `json:"valid"`
+	Failed   int                `json:"failed"`
+	Rows     []MessageImportRow `json:"rows"`
+}
+
+// Maintenance is the maintenance mode state.
+type Maintenance struct {
+	Enabled    bool   `json:"enabled"`
+	Message    string `json:"message,omitempty"`
+	FromConfig bool   `json:"from_config"`
+	UpdatedBy  string `json:"updated_by,omitempty"`
+	UpdatedAt  string `json:"updated_at,omitempty"`
+}
+
+// OutboxStats is the outbox backlog and the size of its failure queues. Counters
+// the server cannot report are nil.
+type OutboxStats struct {
+	Pending         *int64 `json:"pending,omitempty"`
+	OldestPendingAt string `json:"oldest_pending_at,omitempty"`
+	Quarantined     int64  `json:"quarantined"`
+	DeadLetters     *int64 `json:"dead_letters,omitempty"`
+}
+
+// QuarantinedEntry is an outbox entry that exhausted its retries.
+type QuarantinedEntry struct {
+	ID            string `json:"id"`
+	EventType     string `json:"event_type"`
+	AggregateID   string `json:"aggregate_id"`
+	AggregateType string `json:"aggregate_type"`
+	RetryCount    int    `json:"retry_count"`
+	LastError     string `json:"last_error,omitempty"`
+	CreatedAt     string `json:"created_at"`
+	QuarantinedAt string `json:"quarantined_at,omitempty"`
+	Payload       string `json:"payload"`
+}
+
+// QuarantineList is a list of quarantined entries with the total count.
+type QuarantineList struct {
+	Entries []QuarantinedEntry `json:"entries"`
+	Total   int64              `json:"total"`
+}
+
+// DeadLetter is an event whose handlers kept failing.
+type DeadLetter struct {
+	EventType   string `json:"event_type"`
+	AggregateID string `json:"aggregate_id"`
+	Error       string `json:"error"`
+	FailedAt    string `json:"failed_at"`
+}
+
+// DeadLetterList is a list of dead letters with the queue length.
+type DeadLetterList struct {
+	Entries []DeadLetter `json:"entries"`
+	Total   int64        `json:"total"`
+}
+
+// RepairQueueStats are the counters of the read model repair queue.
+type RepairQueueStats struct {
+	Pending    int64 `json:"pending"`
+	Processing int64 `json:"processing"`
+	Completed  int64 `json:"completed"`
+	Failed     int64 `json:"failed"`
+	Total      int64 `json:"total"`
+}
+
+// FeatureFlag is a runtime feature flag.
+type FeatureFlag struct {
+	Name      string `json:"name"`
+	Enabled   bool   `json:"enabled"`
+	UpdatedBy string `json:"updated_by,omitempty"`
+	UpdatedAt string `json:"updated_at"`
+}
+
+// AdminListWorkspaces lists every workspace.
+func (c *Client) AdminListWorkspaces(ctx context.Context, limit, offset int) (*AdminWorkspaceList, error) {
+	var list AdminWorkspaceList
+	err := c.do(ctx, http.MethodGet, "/admin/workspaces", paginationQuery(limit, offset), nil, &list)
+	if err != nil {
+		return nil, err
+	}
+	return &list, nil
+}
+
+// AdminGetWorkspace returns a workspace with its member count.
+func (c *Client) AdminGetWorkspace(ctx context.Context, workspaceID string) (*AdminWorkspace, error) {
+	var ws AdminWorkspace
+	if err := c.do(ctx, http.MethodGet, "/admin/workspaces/"+url.PathEscape(workspaceID), nil, nil, &ws); err != nil {
+		return nil, err
+	}
+	return &ws, nil
+}
+
+// AdminListUsers lists every user.
+func (c *Client) AdminListUsers(ctx context.Context, limit, offset int) (*AdminUserList, error) {
+	var list AdminUserList
+	if err := c.do(ctx, http.MethodGet, "/admin/users", paginationQuery(limit, offset), nil, &list); err != nil {
+		return nil, err
+	}
+	return &list, nil
+}
+
+// AdminGetUser returns a user.
+func (c *Client) AdminGetUser(ctx context.Context, userID string) (*AdminUser, error) {
+	var u AdminUser
+	if err := c.do(ctx, http.MethodGet, "/admin/users/"+url.PathEscape(userID), nil, nil, &u); err != nil {
+		return nil, err
+	}
+	return &u, nil
+}
+
+// AdminImportUsers creates the users listed in csv, which has a header row naming
+// the username, email and optional display_name columns. With dryRun the rows are
+// only validated.
+func (c *Client) AdminImportUsers(ctx context.Context, csv string, dryRun bool) (*UserImportReport, error) {
+	body := map[string]any{"csv": csv, "dry_run": dryRun}
+	var report UserImportReport
+	if err := c.do(ctx, http.MethodPost, "/admin/users/import", nil, body, &report); err != nil {
+		return nil, err
+	}
+	return &report, nil
+}
+
+// AdminImportMessages backfills the history of a chat with messages migrated from
+// another system. Imported messages keep their authors and times and raise no
+// notifications.
+func (c *Client) AdminImportMessages(ctx context.Context, chatID string, batch MessageImport) (*MessageImportReport, error) {
+	var report MessageImportReport
+	path := "/admin/chats/" + url.PathEscape(chatID) + "/messages/import"
+	if err := c.do(ctx, http.MethodPost, path, nil, batch, &report); err != nil {
+		return nil, err
+	}
+	return &report, nil
+}
+
+// GetMaintenance returns the maintenance mode state.
+func (c *Client) GetMaintenance(ctx context.Context) (*Maintenance, error) {
+	var state Maintenance
+	if err := c.do(ctx, http.MethodGet, "/admin/maintenance", nil, nil, &state); err != nil {
+		return nil, err
+	}
+	return &state, nil
+}
+
+// SetMaintenance switches maintenance mode. message is shown to users while it is on.
+func (c *Client) SetMaintenance(ctx context.Context, enabled bool, message string) (*Maintenance, error) {
+	body := map[string]any{"enabled": enabled, "message": message}
+	var state Maintenance
+	if err := c.do(ctx, http.MethodPut, "/admin/maintenance", nil, body, &state); err != nil {
+		return nil, err
+	}
+	return &state, nil
+}
+
+// OutboxStats returns the outbox backlog and failure queue sizes.
+func (c *Client) OutboxStats(ctx context.Context) (*OutboxStats, error) {
+	var stats OutboxStats
+	if err := c.do(ctx, http.MethodGet, "/admin/outbox/stats", nil, nil, &stats); err != nil {
+		return nil, err
+	}
+	return &stats, nil
+}
+
+// ListQuarantined lists up to limit quarantined outbox entries (0 for the server default).
+func (c *Client) ListQuarantined(ctx context.Context, limit int) (*QuarantineList, error) {
+	var list QuarantineList
+	if err := c.do(ctx, http.MethodGet, "/admin/outbox/quarantine", limitQuery(limit), nil, &list); err != nil {
+		return nil, err
+	}
+	return &list, nil
+}
+
+// RequeueQuarantined returns a quarantined entry to the outbox with a fresh retry budget.
+func (c *Client) RequeueQuarantined(ctx context.Context, entryID string) error {
+	return c.do(ctx, http.MethodPost, "/admin/outbox/quarantine/"+url.PathEscape(entryID)+"/requeue", nil, nil, nil)
+}
+
+// DiscardQuarantined deletes a quarantined entry without publishing it.
+func (c *Client) DiscardQuarantined(ctx context.Context, entryID string) error {
+	return c.do(ctx, http.MethodDelete, "/admin/outbox/quarantine/"+url.PathEscape(entryID), nil, nil, nil)
+}
+
+// ListDeadLetters lists up to limit of the latest dead letters (0 for the server default).
+func (c *Client) ListDeadLetters(ctx context.Context, limit int) (*DeadLetterList, error) {
+	var list DeadLetterList
+	if err := c.do(ctx, http.MethodGet, "/admin/outbox/dead-letters", limitQuery(limit), nil, &list); err != nil {
+		return nil, err
+	}
+	return &list, nil
+}
+
+// RepairQueueStats returns the read model repair queue counters.
+func (c *Client) RepairQueueStats(ctx context.Context) (*RepairQueueStats, error) {
+	var stats RepairQueueStats
+	if err := c.do(ctx, http.MethodGet, "/admin/projections/repair", nil, nil, &stats); err != nil {
+		return nil, err
+	}
+	return &stats, nil
+}
+
+// RepairProjection queues a rebuild of one aggregate's read model. aggregateType is
+// "chat" or "task".
+func (c *Client) RepairProjection(ctx context.Context, aggregateType, aggregateID string) error {
+	body := map[string]string{"aggregate_type": aggregateType, "aggregate_id": aggregateID}
+	return c.do(ctx, http.MethodPost, "/admin/projections/repair", nil, body, nil)
+}
+
+// RebuildProjections queues a rebuild of every read model of the aggregate type.
+func (c *Client) RebuildProjections(ctx context.Context, aggregateType string) error {
+	body := map[string]string{"aggregate_type": aggregateType}
+	return c.do(ctx, http.MethodPost, "/admin/projections/rebuild", nil, body, nil)
+}
+
+// ListFeatureFlags lists the runtime feature flags.
+func (c *Client) ListFeatureFlags(ctx context.Context) ([]FeatureFlag, error) {
+	var resp struct {
+		Flags []FeatureFlag `json:"flags"`
+	}
+	if err := c.do(ctx, http.MethodGet, "/admin/feature-flags", nil, nil, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Flags, nil
+}
+
+// SetFeatureFlag switches a feature flag, creating it if needed.
+func (c *Client) SetFeatureFlag(ctx context.Context, name string, enabled bool) (*FeatureFlag, error) {
+	var flag FeatureFlag
+	body := map[string]bool{"enabled": enabled}
+	if err := c.do(ctx, http.MethodPut, "/admin/feature-flags/"+url.PathEscape(name), nil, body, &flag); err != nil {
+		return nil, err
+	}
+	return &flag, nil
+}
+
+// DeleteFeatureFlag removes a feature flag.
+func (c *Client) DeleteFeatureFlag(ctx context.Context, name string) error {
+	return c.do(ctx, http.MethodDelete, "/admin/feature-flags/"+url.PathEscape(name), nil, nil, nil)
+}
+
+// limitQuery returns the limit query parameter, leaving a zero value out.
+func limitQuery(limit int) url.Values {
+	query := url.Values{}
+	if limit > 0 {
+		query.Set("limit", strconv.Itoa(limit))
+	}
+	return query
+}
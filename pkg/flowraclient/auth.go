@@ -0,0 +1,48 @@
+package flowraclient
+
+import (
+	"context"
+	"net/http"
+)
+
+// Login exchanges an OAuth authorization code for tokens. The returned access token
+// becomes the client's token.
+func (c *Client) Login(ctx context.Context, code, redirectURI string) (*LoginResult, error) {
+	var result LoginResult
+	body := map[string]string{"code": code, "redirect_uri": redirectURI}
+	if err := c.do(ctx, http.MethodPost, "/auth/login", nil, body, &result); err != nil {
+		return nil, err
+	}
+	c.SetToken(result.AccessToken)
+	return &result, nil
+}
+
+// Refresh exchanges a refresh token for new tokens. The returned access token
+// becomes the client's token.
+func (c *Client) Refresh(ctx context.Context, refreshToken string) (*TokenPair, error) {
+	var result TokenPair
+	body := map[string]string{"refresh_token": refreshToken}
+	if err := c.do(ctx, http.MethodPost, "/auth/refresh", nil, body, &result); err != nil {
+		return nil, err
+	}
+	c.SetToken(result.AccessToken)
+	return &result, nil
+}
+
+// Logout ends the current session and clears the client's token.
+func (c *Client) Logout(ctx context.Context) error {
+	if err := c.do(ctx, http.MethodPost, "/auth/logout", nil, nil, nil); err != nil {
+		return err
+	}
+	c.SetToken("")
+	return nil
+}
+
+// Me returns the authenticated user.
+func (c *Client) Me(ctx context.Context) (*User, error) {
+	var user User
+	if err := c.do(ctx, http.MethodGet, "/auth/me", nil, nil, &user); err != nil {
+		return nil, err
+	}
+	return &user, nil
+}
@@ -0,0 +1,112 @@
+package flowraclient
+
+import (
+	"context"
+	"net/http"
+)
+
+// CreateChatRequest describes a new chat.
+type CreateChatRequest struct {
+	Name           string   `json:"name"`
+	Type           string   `json:"type"`
+	IsPublic       bool     `json:"is_public"`
+	ParticipantIDs []string `json:"participant_ids,omitempty"`
+}
+
+// ListChatsOptions filters and pages ListChats. Zero values use the server defaults.
+type ListChatsOptions struct {
+	Type   string
+	Limit  int
+	Offset int
+	Cursor string // takes precedence over Offset
+}
+
+// CreateChat creates a chat in a workspace.
+func (c *Client) CreateChat(ctx context.Context, workspaceID string, req CreateChatRequest) (*Chat, error) {
+	var chat Chat
+	if err := c.do(ctx, http.MethodPost, workspacePath(workspaceID, "chats"), nil, req, &chat); err != nil {
+		return nil, err
+	}
+	return &chat, nil
+}
+
+// ListChats lists the chats of a workspace visible to the authenticated user.
+func (c *Client) ListChats(ctx context.Context, workspaceID string, opts ListChatsOptions) (*ChatList, error) {
+	query := paginationQuery(opts.Limit, opts.Offset)
+	if opts.Type != "" {
+		query.Set("type", opts.Type)
+	}
+	if opts.Cursor != "" {
+		query.Set("cursor", opts.Cursor)
+	}
+
+	var list ChatList
+	page, err := c.doPage(ctx, http.MethodGet, workspacePath(workspaceID, "chats"), query, nil, &list)
+	if err != nil {
+		return nil, err
+	}
+	list.Pagination = page
+	return &list, nil
+}
+
+// GetChat returns a chat.
+func (c *Client) GetChat(ctx context.Context, workspaceID, chatID string) (*Chat, error) {
+	var chat Chat
+	if err := c.do(ctx, http.MethodGet, workspacePath(workspaceID, "chats", chatID), nil, nil, &chat); err != nil {
+		return nil, err
+	}
+	return &chat, nil
+}
+
+// RenameChat changes the name of a chat.
+func (c *Client) RenameChat(ctx context.Context, workspaceID, chatID, name string) (*Chat, error) {
+	var chat Chat
+	body := map[string]string{"name": name}
+	if err := c.do(ctx, http.MethodPut, workspacePath(workspaceID, "chats", chatID), nil, body, &chat); err != nil {
+		return nil, err
+	}
+	return &chat, nil
+}
+
+// DeleteChat deletes a chat.
+func (c *Client) DeleteChat(ctx context.Context, workspaceID, chatID string) error {
+	return c.do(ctx, http.MethodDelete, workspacePath(workspaceID, "chats", chatID), nil, nil, nil)
+}
+
+// ListParticipants lists the participants of a chat.
+func (c *Client) ListParticipants(
+	ctx context.Context,
+	workspaceID, chatID string,
+	limit, offset int,
+) (*ParticipantList, error) {
+	var list ParticipantList
+	path := workspacePath(workspaceID, "chats", chatID, "participants")
+	page, err := c.doPage(ctx, http.MethodGet, path, paginationQuery(limit, offset), nil, &list)
+	if err != nil {
+		return nil, err
+	}
+	list.Pagination = page
+	return &list, nil
+}
+
+// AddParticipant adds a user to a chat with the given role ("member", "admin", ...).
+func (c *Client) AddParticipant(ctx context.Context, workspaceID, chatID, userID, role string) (*Chat, error) {
+	var chat Chat
+	body := map[string]string{"user_id": userID, "role": role}
+	path := workspacePath(workspaceID, "chats", chatID, "participants")
+	if err := c.do(ctx, http.MethodPost, path, nil, body, &chat); err != nil {
+		return nil, err
+	}
+	return &chat, nil
+}
+
+// RemoveParticipant removes a user from a chat.
+func (c *Client) RemoveParticipant(ctx context.Context, workspaceID, chatID, userID string) error {
+	path := workspacePath(workspaceID, "chats", chatID, "participants", userID)
+	return c.do(ctx, http.MethodDelete, path, nil, nil, nil)
+}
+
+// MarkChatRead marks every message of a chat as read by the authenticated user.
+func (c *Client) MarkChatRead(ctx context.Context, workspaceID, chatID string) error {
+	return c.do(ctx, http.MethodPost, workspacePath(workspaceID, "chats", chatID, "read"), nil, nil, nil)
+}
@@ -0,0 +1,199 @@
+// Package flowraclient is the Go client for the Flowra REST and WebSocket APIs.
+//
+// It covers authentication, workspaces, chats, messages and tasks, and provides a
+// WebSocket Subscriber that reconnects with backoff. Internal services and the
+// end-to-end tests use it instead of hand-rolled HTTP calls; keep it in step with
+// the handlers in internal/handler.
+package flowraclient
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// APIPrefix is the path prefix of the versioned REST API.
+const APIPrefix = "/api/v1"
+
+// defaultTimeout bounds requests made with the default HTTP client.
+const defaultTimeout = 30 * time.Second
+
+// ErrBaseURLRequired is returned by New when no base URL is given.
+var ErrBaseURLRequired = errors.New("flowraclient: base URL is required")
+
+// Client calls the Flowra API. It is safe for concurrent use.
+type Client struct {
+	baseURL    *url.URL
+	httpClient *http.Client
+	userAgent  string
+
+	mu    sync.RWMutex
+	token string
+}
+
+// Option configures a Client.
+type Option func(*Client)
+
+// WithHTTPClient sets the HTTP client used for requests.
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(c *Client) {
+		c.httpClient = httpClient
+	}
+}
+
+// WithToken sets the access token sent as a bearer token.
+func WithToken(token string) Option {
+	return func(c *Client) {
+		c.token = token
+	}
+}
+
+// WithUserAgent sets the User-Agent header of every request.
+func WithUserAgent(userAgent string) Option {
+	return func(c *Client) {
+		c.userAgent = userAgent
+	}
+}
+
+// New creates a client for the Flowra server at baseURL, e.g. "https://flowra.example.com".
+func New(baseURL string, opts ...Option) (*Client, error) {
+	if baseURL == "" {
+		return nil, ErrBaseURLRequired
+	}
+	parsed, err := url.Parse(strings.TrimRight(baseURL, "/"))
+	if err != nil {
+		return nil, fmt.Errorf("flowraclient: invalid base URL: %w", err)
+	}
+
+	c := &Client{
+		baseURL:    parsed,
+		httpClient: &http.Client{Timeout: defaultTimeout},
+		userAgent:  "flowraclient",
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c, nil
+}
+
+// Token returns the current access token.
+func (c *Client) Token() string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.token
+}
+
+// SetToken replaces the access token, e.g. after a refresh. Open subscribers use the
+// new token for their next reconnect or in-band refresh.
+func (c *Client) SetToken(token string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.token = token
+}
+
+// envelope is the response wrapper of every API endpoint.
+type envelope struct {
+	Success    bool            `json:"success"`
+	Data       json.RawMessage `json:"data,omitempty"`
+	Pagination *Pagination     `json:"pagination,omitempty"`
+	Error      *struct {
+		Code    string `json:"code"`
+		Message string `json:"message"`
+	} `json:"error,omitempty"`
+}
+
+// do sends a request to the API and decodes the data of the response into out
+// (which may be nil). Non-2xx responses are returned as *APIError.
+func (c *Client) do(ctx context.Context, method, path string, query url.Values, body, out any) error {
+	_, err := c.doPage(ctx, method, path, query, body, out)
+	return err
+}
+
+// doPage is do for list endpoints: it also returns the pagination of the page,
+// which is nil if the server did not send one.
+func (c *Client) doPage(
+	ctx context.Context,
+	method, path string,
+	query url.Values,
+	body, out any,
+) (*Pagination, error) {
+	endpoint := c.baseURL.JoinPath(APIPrefix, path)
+	if len(query) > 0 {
+		endpoint.RawQuery = query.Encode()
+	}
+
+	var reader io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return nil, fmt.Errorf("flowraclient: failed to encode request: %w", err)
+		}
+		reader = bytes.NewReader(data)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, endpoint.String(), reader)
+	if err != nil {
+		return nil, fmt.Errorf("flowraclient: failed to build request: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if c.userAgent != "" {
+		req.Header.Set("User-Agent", c.userAgent)
+	}
+	if token := c.Token(); token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("flowraclient: %s %s: %w", method, path, err)
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("flowraclient: failed to read response: %w", err)
+	}
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return nil, newAPIError(resp.StatusCode, data)
+	}
+	if out == nil || resp.StatusCode == http.StatusNoContent || len(data) == 0 {
+		return nil, nil
+	}
+
+	var env envelope
+	if err = json.Unmarshal(data, &env); err != nil {
+		return nil, fmt.Errorf("flowraclient: failed to decode response: %w", err)
+	}
+	if len(env.Data) == 0 {
+		return env.Pagination, nil
+	}
+	if err = json.Unmarshal(env.Data, out); err != nil {
+		return nil, fmt.Errorf("flowraclient: failed to decode response data: %w", err)
+	}
+	return env.Pagination, nil
+}
+
+// paginationQuery returns limit/offset query parameters, leaving zero values out.
+func paginationQuery(limit, offset int) url.Values {
+	query := url.Values{}
+	if limit > 0 {
+		query.Set("limit", strconv.Itoa(limit))
+	}
+	if offset > 0 {
+		query.Set("offset", strconv.Itoa(offset))
+	}
+	return query
+}
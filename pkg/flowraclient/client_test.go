@@ -0,0 +1,164 @@
+package flowraclient_test
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/lllypuk/flowra/pkg/flowraclient"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// recordedRequest is a request received by the test server.
+type recordedRequest struct {
+	Method string
+	Path   string
+	Query  string
+	Auth   string
+	Body   map[string]any
+}
+
+// newTestServer serves one canned response and records the request.
+func newTestServer(t *testing.T, status int, response string) (*flowraclient.Client, *recordedRequest) {
+	t.Helper()
+
+	recorded := &recordedRequest{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		recorded.Method = r.Method
+		recorded.Path = r.URL.Path
+		recorded.Query = r.URL.RawQuery
+		recorded.Auth = r.Header.Get("Authorization")
+		if data, _ := io.ReadAll(r.Body); len(data) > 0 {
+			_ = json.Unmarshal(data, &recorded.Body)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(response))
+	}))
+	t.Cleanup(server.Close)
+
+	client, err := flowraclient.New(server.URL, flowraclient.WithToken("token-1"))
+	require.NoError(t, err)
+	return client, recorded
+}
+
+func TestNew(t *testing.T) {
+	_, err := flowraclient.New("")
+	require.ErrorIs(t, err, flowraclient.ErrBaseURLRequired)
+
+	client, err := flowraclient.New("https://flowra.example.com/", flowraclient.WithToken("abc"))
+	require.NoError(t, err)
+	assert.Equal(t, "abc", client.Token())
+}
+
+func TestClient_Login(t *testing.T) {
+	client, recorded := newTestServer(t, http.StatusOK, `{"success":true,"data":{
+		"access_token":"access-2","refresh_token":"refresh-2","expires_in":300,
+		"user":{"id":"u-1","email":"a@example.com","username":"alice"}}}`)
+
+	result, err := client.Login(t.Context(), "code-1", "https://app/callback")
+
+	require.NoError(t, err)
+	assert.Equal(t, http.MethodPost, recorded.Method)
+	assert.Equal(t, "/api/v1/auth/login", recorded.Path)
+	assert.Equal(t, map[string]any{"code": "code-1", "redirect_uri": "https://app/callback"}, recorded.Body)
+	assert.Equal(t, "alice", result.User.Username)
+	assert.Equal(t, "access-2", client.Token(), "login stores the access token")
+}
+
+func TestClient_CreateWorkspace(t *testing.T) {
+	client, recorded := newTestServer(t, http.StatusCreated,
+		`{"success":true,"data":{"id":"ws-1","name":"Team","owner_id":"u-1","member_count":1}}`)
+
+	ws, err := client.CreateWorkspace(t.Context(), "Team", "Our team")
+
+	require.NoError(t, err)
+	assert.Equal(t, "/api/v1/workspaces", recorded.Path)
+	assert.Equal(t, "Bearer token-1", recorded.Auth)
+	assert.Equal(t, "Our team", recorded.Body["description"])
+	assert.Equal(t, "ws-1", ws.ID)
+	assert.Equal(t, 1, ws.MemberCount)
+}
+
+func TestClient_ListChats(t *testing.T) {
+	client, recorded := newTestServer(t, http.StatusOK,
+		`{"success":true,"data":{"chats":[{"id":"c-1","type":"task"}],"total":1,"has_more":false}}`)
+
+	list, err := client.ListChats(t.Context(), "ws-1", flowraclient.ListChatsOptions{Type: "task", Limit: 10})
+
+	require.NoError(t, err)
+	assert.Equal(t, http.MethodGet, recorded.Method)
+	assert.Equal(t, "/api/v1/workspaces/ws-1/chats", recorded.Path)
+	assert.Equal(t, "limit=10&type=task", recorded.Query)
+	require.Len(t, list.Chats, 1)
+	assert.Equal(t, "c-1", list.Chats[0].ID)
+	assert.Nil(t, list.Pagination, "servers without pagination metadata leave it nil")
+}
+
+func TestClient_ListChats_Pagination(t *testing.T) {
+	client, recorded := newTestServer(t, http.StatusOK, `{"success":true,
+		"data":{"chats":[{"id":"c-3"}],"total":5,"has_more":true},
+		"pagination":{"total":5,"limit":1,"offset":2,"has_more":true,"next_cursor":"next","prev_cursor":"prev"}}`)
+
+	list, err := client.ListChats(t.Context(), "ws-1", flowraclient.ListChatsOptions{Limit: 1, Cursor: "cur"})
+
+	require.NoError(t, err)
+	assert.Equal(t, "cursor=cur&limit=1", recorded.Query)
+	require.NotNil(t, list.Pagination)
+	require.NotNil(t, list.Pagination.Total)
+	assert.Equal(t, 5, *list.Pagination.Total)
+	assert.Equal(t, 2, list.Pagination.Offset)
+	assert.Equal(t, "next", list.Pagination.NextCursor)
+	assert.Equal(t, "prev", list.Pagination.PrevCursor)
+}
+
+func TestClient_SendMessage(t *testing.T) {
+	client, recorded := newTestServer(t, http.StatusCreated,
+		`{"success":true,"data":{"id":"m-1","chat_id":"c-1","content":"hi","type":"user"}}`)
+
+	msg, err := client.SendMessage(t.Context(), "ws-1", "c-1", flowraclient.SendMessageRequest{Content: "hi"})
+
+	require.NoError(t, err)
+	assert.Equal(t, "/api/v1/workspaces/ws-1/chats/c-1/messages", recorded.Path)
+	assert.Equal(t, map[string]any{"content": "hi"}, recorded.Body)
+	assert.Equal(t, "m-1", msg.ID)
+}
+
+func TestClient_ChangeTaskStatus(t *testing.T) {
+	client, recorded := newTestServer(t, http.StatusOK,
+		`{"success":true,"data":{"id":"t-1","status":"in_progress","version":2}}`)
+
+	task, err := client.ChangeTaskStatus(t.Context(), "ws-1", "t-1", "in_progress")
+
+	require.NoError(t, err)
+	assert.Equal(t, http.MethodPut, recorded.Method)
+	assert.Equal(t, "/api/v1/workspaces/ws-1/tasks/t-1/status", recorded.Path)
+	assert.Equal(t, "in_progress", task.Status)
+}
+
+func TestClient_DeleteTask(t *testing.T) {
+	client, recorded := newTestServer(t, http.StatusNoContent, "")
+
+	require.NoError(t, client.DeleteTask(t.Context(), "ws-1", "t-1"))
+	assert.Equal(t, http.MethodDelete, recorded.Method)
+	assert.Equal(t, "/api/v1/workspaces/ws-1/tasks/t-1", recorded.Path)
+}
+
+func TestClient_APIError(t *testing.T) {
+	client, _ := newTestServer(t, http.StatusNotFound,
+		`{"success":false,"error":{"code":"CHAT_NOT_FOUND","message":"chat not found"}}`)
+
+	_, err := client.GetChat(t.Context(), "ws-1", "missing")
+
+	var apiErr *flowraclient.APIError
+	require.ErrorAs(t, err, &apiErr)
+	assert.Equal(t, http.StatusNotFound, apiErr.StatusCode)
+	assert.Equal(t, "CHAT_NOT_FOUND", apiErr.Code)
+	assert.Equal(t, "chat not found", apiErr.Message)
+	assert.True(t, flowraclient.IsNotFound(err))
+	assert.False(t, flowraclient.IsUnauthorized(err))
+}
@@ -0,0 +1,63 @@
+package flowraclient
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+)
+
+// SendMessageRequest describes a new message.
+type SendMessageRequest struct {
+	Content   string  `json:"content"`
+	ReplyToID *string `json:"reply_to_id,omitempty"`
+	QuoteID   *string `json:"quote_id,omitempty"`
+}
+
+// SendMessage posts a message to a chat.
+func (c *Client) SendMessage(
+	ctx context.Context,
+	workspaceID, chatID string,
+	req SendMessageRequest,
+) (*Message, error) {
+	var msg Message
+	path := workspacePath(workspaceID, "chats", chatID, "messages")
+	if err := c.do(ctx, http.MethodPost, path, nil, req, &msg); err != nil {
+		return nil, err
+	}
+	return &msg, nil
+}
+
+// ListMessages lists the messages of a chat. Zero limit and offset use the server defaults.
+func (c *Client) ListMessages(
+	ctx context.Context,
+	workspaceID, chatID string,
+	limit, offset int,
+) (*MessageList, error) {
+	var list MessageList
+	path := workspacePath(workspaceID, "chats", chatID, "messages")
+	page, err := c.doPage(ctx, http.MethodGet, path, paginationQuery(limit, offset), nil, &list)
+	if err != nil {
+		return nil, err
+	}
+	list.Pagination = page
+	return &list, nil
+}
+
+// EditMessage replaces the content of a message.
+func (c *Client) EditMessage(ctx context.Context, messageID, content string) (*Message, error) {
+	var msg Message
+	body := map[string]string{"content": content}
+	if err := c.do(ctx, http.MethodPut, messagePath(messageID), nil, body, &msg); err != nil {
+		return nil, err
+	}
+	return &msg, nil
+}
+
+// DeleteMessage deletes a message.
+func (c *Client) DeleteMessage(ctx context.Context, messageID string) error {
+	return c.do(ctx, http.MethodDelete, messagePath(messageID), nil, nil, nil)
+}
+
+func messagePath(messageID string) string {
+	return "/messages/" + url.PathEscape(messageID)
+}
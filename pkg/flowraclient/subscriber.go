@@ -0,0 +1,324 @@
+package flowraclient
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+
+	"github.com/lllypuk/flowra/internal/pkg/retry"
+)
+
+// WebSocket protocol constants, see docs/api/websocket-protocol.md.
+const (
+	// ProtocolVersion is the WebSocket protocol version the subscriber speaks.
+	ProtocolVersion = 1
+
+	// FeaturePresence requests presence.changed messages.
+	FeaturePresence = "presence"
+
+	// FeatureCompression requests compressed server messages.
+	FeatureCompression = "compression"
+)
+
+// Subscriber defaults.
+const (
+	defaultReconnectInitialBackoff = 500 * time.Millisecond
+	defaultReconnectMaxBackoff     = 30 * time.Second
+	defaultEventBuffer             = 256
+	subscriberWriteWait            = 10 * time.Second
+)
+
+// ErrSubscriberRunning is returned when Run is called on a subscriber that is already running.
+var ErrSubscriberRunning = errors.New("flowraclient: subscriber is already running")
+
+// Event is a message received from the server: a domain event broadcast such as
+// chat.message.posted, or a control message such as ack, error or presence.changed.
+type Event struct {
+	// Type is the message type.
+	Type string `json:"type"`
+
+	// ChatID is the chat the message belongs to, if any.
+	ChatID string `json:"chat_id,omitempty"`
+
+	// Data is the event payload, if any.
+	Data json.RawMessage `json:"data,omitempty"`
+
+	// Raw is the complete message.
+	Raw json.RawMessage `json:"-"`
+}
+
+// Subscriber receives real-time events over the WebSocket API. It reconnects with
+// exponential backoff when the connection drops and re-subscribes to its chats.
+type Subscriber struct {
+	client   *Client
+	backoff  retry.Policy
+	features []string
+	dialer   *websocket.Dialer
+	events   chan Event
+	onError  func(error)
+
+	mu      sync.Mutex
+	chats   map[string]bool
+	conn    *websocket.Conn
+	running bool
+
+	// writeMu serializes writes to conn.
+	writeMu sync.Mutex
+}
+
+// SubscriberOption configures a Subscriber.
+type SubscriberOption func(*Subscriber)
+
+// WithReconnectBackoff sets the delay before the first reconnect and its upper bound.
+func WithReconnectBackoff(initial, maxBackoff time.Duration) SubscriberOption {
+	return func(s *Subscriber) {
+		s.backoff.InitialBackoff = initial
+		s.backoff.MaxBackoff = maxBackoff
+	}
+}
+
+// WithFeatures sets the protocol features requested in the hello handshake.
+func WithFeatures(features ...string) SubscriberOption {
+	return func(s *Subscriber) {
+		s.features = features
+	}
+}
+
+// WithEventBuffer sets the capacity of the Events channel.
+func WithEventBuffer(size int) SubscriberOption {
+	return func(s *Subscriber) {
+		s.events = make(chan Event, size)
+	}
+}
+
+// WithConnectionErrorHandler sets a function called with every connection failure
+// before the subscriber reconnects.
+func WithConnectionErrorHandler(onError func(error)) SubscriberOption {
+	return func(s *Subscriber) {
+		s.onError = onError
+	}
+}
+
+// WithDialer sets the WebSocket dialer.
+func WithDialer(dialer *websocket.Dialer) SubscriberOption {
+	return func(s *Subscriber) {
+		s.dialer = dialer
+	}
+}
+
+// NewSubscriber creates a subscriber that authenticates with the client's token.
+func (c *Client) NewSubscriber(opts ...SubscriberOption) *Subscriber {
+	s := &Subscriber{
+		client: c,
+		backoff: retry.Policy{
+			InitialBackoff: defaultReconnectInitialBackoff,
+			MaxBackoff:     defaultReconnectMaxBackoff,
+		},
+		features: []string{FeaturePresence},
+		dialer:   websocket.DefaultDialer,
+		events:   make(chan Event, defaultEventBuffer),
+		chats:    make(map[string]bool),
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// Events returns the channel on which received messages are delivered. It is closed
+// when Run returns.
+func (s *Subscriber) Events() <-chan Event {
+	return s.events
+}
+
+// Subscribe adds chats to the subscription. The subscription survives reconnects.
+func (s *Subscriber) Subscribe(chatIDs ...string) error {
+	return s.updateChats("subscribe", true, chatIDs)
+}
+
+// Unsubscribe removes chats from the subscription.
+func (s *Subscriber) Unsubscribe(chatIDs ...string) error {
+	return s.updateChats("unsubscribe", false, chatIDs)
+}
+
+func (s *Subscriber) updateChats(msgType string, subscribed bool, chatIDs []string) error {
+	s.mu.Lock()
+	for _, chatID := range chatIDs {
+		if subscribed {
+			s.chats[chatID] = true
+		} else {
+			delete(s.chats, chatID)
+		}
+	}
+	conn := s.conn
+	s.mu.Unlock()
+
+	if conn == nil {
+		return nil
+	}
+	for _, chatID := range chatIDs {
+		if err := s.write(conn, map[string]any{"type": msgType, "chat_id": chatID}); err != nil {
+			// The read loop notices the broken connection and reconnects.
+			return err
+		}
+	}
+	return nil
+}
+
+// Run connects and delivers events until ctx is cancelled, reconnecting with backoff
+// whenever the connection fails. It returns the context error.
+func (s *Subscriber) Run(ctx context.Context) error {
+	s.mu.Lock()
+	if s.running {
+		s.mu.Unlock()
+		return ErrSubscriberRunning
+	}
+	s.running = true
+	s.mu.Unlock()
+	defer close(s.events)
+
+	failures := 0
+	for {
+		connected, err := s.session(ctx)
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if s.onError != nil && err != nil {
+			s.onError(err)
+		}
+		if connected {
+			failures = 0
+		}
+		failures++
+
+		timer := time.NewTimer(s.backoff.Backoff(failures))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// session runs one connection until it fails. connected reports whether the
+// connection was established.
+func (s *Subscriber) session(ctx context.Context) (bool, error) {
+	conn, err := s.dial(ctx)
+	if err != nil {
+		return false, err
+	}
+	connToken := s.client.Token()
+
+	s.mu.Lock()
+	s.conn = conn
+	chatIDs := make([]string, 0, len(s.chats))
+	for chatID := range s.chats {
+		chatIDs = append(chatIDs, chatID)
+	}
+	s.mu.Unlock()
+
+	defer func() {
+		s.mu.Lock()
+		s.conn = nil
+		s.mu.Unlock()
+		_ = conn.Close()
+	}()
+
+	// Unblock ReadMessage when ctx is cancelled.
+	stop := context.AfterFunc(ctx, func() { _ = conn.Close() })
+	defer stop()
+
+	if err = s.write(conn, map[string]any{
+		"type":     "hello",
+		"version":  ProtocolVersion,
+		"features": s.features,
+	}); err != nil {
+		return true, err
+	}
+	for _, chatID := range chatIDs {
+		if err = s.write(conn, map[string]any{"type": "subscribe", "chat_id": chatID}); err != nil {
+			return true, err
+		}
+	}
+
+	for {
+		_, data, readErr := conn.ReadMessage()
+		if readErr != nil {
+			return true, readErr
+		}
+
+		var evt Event
+		if json.Unmarshal(data, &evt) != nil {
+			continue
+		}
+		evt.Raw = data
+
+		if evt.Type == "auth.expiring" {
+			// Hand the server a newer token if the client has been given one.
+			if token := s.client.Token(); token != "" && token != connToken {
+				if err = s.write(conn, map[string]any{"type": "auth.refresh", "token": token}); err != nil {
+					return true, err
+				}
+				connToken = token
+			}
+		}
+
+		select {
+		case s.events <- evt:
+		case <-ctx.Done():
+			return true, ctx.Err()
+		}
+	}
+}
+
+// dial opens a WebSocket connection authenticated with the client's token.
+func (s *Subscriber) dial(ctx context.Context) (*websocket.Conn, error) {
+	endpoint := *s.client.baseURL.JoinPath(APIPrefix, "ws")
+	switch endpoint.Scheme {
+	case "https":
+		endpoint.Scheme = "wss"
+	default:
+		endpoint.Scheme = "ws"
+	}
+
+	header := http.Header{}
+	if token := s.client.Token(); token != "" {
+		header.Set("Authorization", "Bearer "+token)
+	}
+	if s.client.userAgent != "" {
+		header.Set("User-Agent", s.client.userAgent)
+	}
+
+	conn, resp, err := s.dialer.DialContext(ctx, endpoint.String(), header)
+	if resp != nil && resp.Body != nil {
+		_ = resp.Body.Close()
+	}
+	if err != nil {
+		if resp != nil {
+			return nil, fmt.Errorf("flowraclient: websocket dial: HTTP %d: %w", resp.StatusCode, err)
+		}
+		return nil, fmt.Errorf("flowraclient: websocket dial: %w", err)
+	}
+	return conn, nil
+}
+
+// write sends a JSON message on conn.
+func (s *Subscriber) write(conn *websocket.Conn, msg any) error {
+	s.writeMu.Lock()
+	defer s.writeMu.Unlock()
+
+	if err := conn.SetWriteDeadline(time.Now().Add(subscriberWriteWait)); err != nil {
+		return fmt.Errorf("flowraclient: websocket write: %w", err)
+	}
+	if err := conn.WriteJSON(msg); err != nil {
+		return fmt.Errorf("flowraclient: websocket write: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,156 @@
+package flowraclient_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/labstack/echo/v4"
+	"github.com/lllypuk/flowra/internal/domain/uuid"
+	wshandler "github.com/lllypuk/flowra/internal/handler/websocket"
+	ws "github.com/lllypuk/flowra/internal/infrastructure/websocket"
+	"github.com/lllypuk/flowra/internal/middleware"
+	"github.com/lllypuk/flowra/pkg/flowraclient"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// staticTokenValidator accepts a single token.
+type staticTokenValidator struct {
+	token  string
+	userID uuid.UUID
+}
+
+func (v *staticTokenValidator) ValidateToken(_ context.Context, token string) (*middleware.TokenClaims, error) {
+	if token != v.token {
+		return nil, middleware.ErrInvalidToken
+	}
+	return &middleware.TokenClaims{UserID: v.userID, ExpiresAt: time.Now().Add(time.Hour)}, nil
+}
+
+func runSubscriber(t *testing.T, sub *flowraclient.Subscriber) {
+	t.Helper()
+
+	ctx, cancel := context.WithCancel(t.Context())
+	done := make(chan error, 1)
+	go func() { done <- sub.Run(ctx) }()
+	t.Cleanup(func() {
+		cancel()
+		<-done
+	})
+}
+
+func nextEvent(t *testing.T, sub *flowraclient.Subscriber, eventType string) flowraclient.Event {
+	t.Helper()
+
+	timeout := time.After(2 * time.Second)
+	for {
+		select {
+		case evt := <-sub.Events():
+			if evt.Type == eventType {
+				return evt
+			}
+		case <-timeout:
+			t.Fatalf("no %s event received", eventType)
+		}
+	}
+}
+
+func TestSubscriber_ReceivesChatEvents(t *testing.T) {
+	hub := ws.NewHub()
+	go hub.Run(t.Context())
+
+	handler := wshandler.NewHandler(hub,
+		wshandler.WithTokenValidator(&staticTokenValidator{token: "token-1", userID: uuid.NewUUID()}))
+	e := echo.New()
+	e.GET("/api/v1/ws", handler.HandleWebSocket)
+	server := httptest.NewServer(e)
+	t.Cleanup(server.Close)
+
+	client, err := flowraclient.New(server.URL, flowraclient.WithToken("token-1"))
+	require.NoError(t, err)
+
+	chatID := uuid.NewUUID()
+	sub := client.NewSubscriber()
+	require.NoError(t, sub.Subscribe(chatID.String()))
+	runSubscriber(t, sub)
+
+	hello := nextEvent(t, sub, "ack")
+	assert.JSONEq(t, `{"type":"ack","action":"hello","version":1,"features":["presence"]}`, string(hello.Raw))
+	nextEvent(t, sub, "ack") // subscribed
+
+	posted := `{"type":"chat.message.posted","chat_id":"` + chatID.String() + `","data":{"id":"m-1"}}`
+	hub.BroadcastToChat(chatID, []byte(posted))
+
+	evt := nextEvent(t, sub, "chat.message.posted")
+	assert.Equal(t, chatID.String(), evt.ChatID)
+	assert.JSONEq(t, `{"id":"m-1"}`, string(evt.Data))
+}
+
+func TestSubscriber_ReconnectsAndResubscribes(t *testing.T) {
+	var (
+		mu          sync.Mutex
+		connections int
+		received    [][]string
+	)
+	upgrader := websocket.Upgrader{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		mu.Lock()
+		connections++
+		attempt := connections
+		received = append(received, nil)
+		mu.Unlock()
+
+		for {
+			var msg map[string]any
+			if conn.ReadJSON(&msg) != nil {
+				return
+			}
+			msgType, _ := msg["type"].(string)
+			mu.Lock()
+			received[attempt-1] = append(received[attempt-1], msgType)
+			mu.Unlock()
+
+			if msgType == "subscribe" {
+				if attempt == 1 {
+					return // drop the first connection
+				}
+				_ = conn.WriteJSON(map[string]any{"type": "chat.closed", "chat_id": msg["chat_id"]})
+			}
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	var connErrors int
+	client, err := flowraclient.New(server.URL)
+	require.NoError(t, err)
+	sub := client.NewSubscriber(
+		flowraclient.WithReconnectBackoff(10*time.Millisecond, 50*time.Millisecond),
+		flowraclient.WithConnectionErrorHandler(func(error) {
+			mu.Lock()
+			connErrors++
+			mu.Unlock()
+		}),
+	)
+	require.NoError(t, sub.Subscribe("chat-1"))
+	runSubscriber(t, sub)
+
+	evt := nextEvent(t, sub, "chat.closed")
+
+	assert.Equal(t, "chat-1", evt.ChatID)
+	mu.Lock()
+	defer mu.Unlock()
+	assert.Equal(t, 2, connections)
+	assert.Equal(t, []string{"hello", "subscribe"}, received[1], "re-subscribed after reconnect")
+	assert.Positive(t, connErrors)
+}
@@ -0,0 +1,115 @@
+package flowraclient
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// CreateTaskRequest describes a new task. DueDate is a date in YYYY-MM-DD format.
+type CreateTaskRequest struct {
+	Title       string  `json:"title"`
+	Description string  `json:"description,omitempty"`
+	Priority    string  `json:"priority,omitempty"`
+	AssigneeID  *string `json:"assignee_id,omitempty"`
+	DueDate     *string `json:"due_date,omitempty"`
+	ChatID      *string `json:"chat_id,omitempty"`
+	EntityType  string  `json:"entity_type,omitempty"`
+}
+
+// ListTasksOptions filters and pages ListTasks. Zero values are not sent.
+type ListTasksOptions struct {
+	Status     string
+	Priority   string
+	AssigneeID string
+	ChatID     string
+	Sprint     string
+	Page       int
+	PerPage    int
+	Cursor     string // takes precedence over Page
+}
+
+// CreateTask creates a task in a workspace.
+func (c *Client) CreateTask(ctx context.Context, workspaceID string, req CreateTaskRequest) (*Task, error) {
+	var task Task
+	if err := c.do(ctx, http.MethodPost, workspacePath(workspaceID, "tasks"), nil, req, &task); err != nil {
+		return nil, err
+	}
+	return &task, nil
+}
+
+// ListTasks lists the tasks of a workspace.
+func (c *Client) ListTasks(ctx context.Context, workspaceID string, opts ListTasksOptions) (*TaskList, error) {
+	query := url.Values{}
+	for key, value := range map[string]string{
+		"status":      opts.Status,
+		"priority":    opts.Priority,
+		"assignee_id": opts.AssigneeID,
+		"chat_id":     opts.ChatID,
+		"sprint":      opts.Sprint,
+	} {
+		if value != "" {
+			query.Set(key, value)
+		}
+	}
+	if opts.Page > 0 {
+		query.Set("page", strconv.Itoa(opts.Page))
+	}
+	if opts.PerPage > 0 {
+		query.Set("per_page", strconv.Itoa(opts.PerPage))
+	}
+	if opts.Cursor != "" {
+		query.Set("cursor", opts.Cursor)
+	}
+
+	var list TaskList
+	page, err := c.doPage(ctx, http.MethodGet, workspacePath(workspaceID, "tasks"), query, nil, &list)
+	if err != nil {
+		return nil, err
+	}
+	list.Pagination = page
+	return &list, nil
+}
+
+// GetTask returns a task.
+func (c *Client) GetTask(ctx context.Context, workspaceID, taskID string) (*Task, error) {
+	var task Task
+	if err := c.do(ctx, http.MethodGet, workspacePath(workspaceID, "tasks", taskID), nil, nil, &task); err != nil {
+		return nil, err
+	}
+	return &task, nil
+}
+
+// ChangeTaskStatus moves a task to another status.
+func (c *Client) ChangeTaskStatus(ctx context.Context, workspaceID, taskID, status string) (*Task, error) {
+	return c.updateTask(ctx, workspaceID, taskID, "status", map[string]any{"status": status})
+}
+
+// AssignTask assigns a task; a nil assigneeID unassigns it.
+func (c *Client) AssignTask(ctx context.Context, workspaceID, taskID string, assigneeID *string) (*Task, error) {
+	return c.updateTask(ctx, workspaceID, taskID, "assignee", map[string]any{"assignee_id": assigneeID})
+}
+
+// ChangeTaskPriority changes the priority of a task.
+func (c *Client) ChangeTaskPriority(ctx context.Context, workspaceID, taskID, priority string) (*Task, error) {
+	return c.updateTask(ctx, workspaceID, taskID, "priority", map[string]any{"priority": priority})
+}
+
+// SetTaskDueDate sets the due date of a task (YYYY-MM-DD); a nil dueDate clears it.
+func (c *Client) SetTaskDueDate(ctx context.Context, workspaceID, taskID string, dueDate *string) (*Task, error) {
+	return c.updateTask(ctx, workspaceID, taskID, "due-date", map[string]any{"due_date": dueDate})
+}
+
+// DeleteTask deletes a task.
+func (c *Client) DeleteTask(ctx context.Context, workspaceID, taskID string) error {
+	return c.do(ctx, http.MethodDelete, workspacePath(workspaceID, "tasks", taskID), nil, nil, nil)
+}
+
+func (c *Client) updateTask(ctx context.Context, workspaceID, taskID, field string, body any) (*Task, error) {
+	var task Task
+	if err := c.do(ctx, http.MethodPut, workspacePath(workspaceID, "tasks", taskID, field), nil, body, &task); err != nil {
+		return nil, err
+	}
+	return &task, nil
+}
@@ -0,0 +1,204 @@
+package flowraclient
+
+// IDs and timestamps are kept as the strings the API returns, so that the package
+// does not depend on the server's domain types.
+
+// User is a user as returned by the auth endpoints.
+type User struct {
+	ID          string `json:"id"`
+	Email       string `json:"email"`
+	Username    string `json:"username"`
+	DisplayName string `json:"display_name,omitempty"`
+	AvatarURL   string `json:"avatar_url,omitempty"`
+}
+
+// LoginResult is returned by Login.
+type LoginResult struct {
+	AccessToken  string `json:"access_token"`
+	RefreshToken string `json:"refresh_token"`
+	ExpiresIn    int    `json:"expires_in"`
+	User         User   `json:"user"`
+}
+
+// TokenPair is returned by Refresh.
+type TokenPair struct {
+	AccessToken  string `json:"access_token"`
+	RefreshToken string `json:"refresh_token"`
+	ExpiresIn    int    `json:"expires_in"`
+}
+
+// Workspace is a workspace.
+type Workspace struct {
+	ID              string `json:"id"`
+	Name            string `json:"name"`
+	Description     string `json:"description,omitempty"`
+	OwnerID         string `json:"owner_id"`
+	CreatedAt       string `json:"created_at"`
+	UpdatedAt       string `json:"updated_at"`
+	MemberCount     int    `json:"member_count"`
+	AnalyticsOptOut bool   `json:"analytics_opt_out"`
+}
+
+// Pagination describes a page of a list. Lists with options take NextCursor or
+// PrevCursor as their Cursor to fetch the adjacent pages; the others page by offset.
+type Pagination struct {
+	Total      *int   `json:"total,omitempty"` // nil for lists that are not counted
+	Limit      int    `json:"limit"`
+	Offset     int    `json:"offset"`
+	HasMore    bool   `json:"has_more"`
+	NextCursor string `json:"next_cursor,omitempty"`
+	PrevCursor string `json:"prev_cursor,omitempty"`
+}
+
+// WorkspaceList is a page of workspaces.
+type WorkspaceList struct {
+	Workspaces []Workspace `json:"workspaces"`
+	Total      int         `json:"total"`
+	Offset     int         `json:"offset"`
+	Limit      int         `json:"limit"`
+
+	// Pagination is nil if the server did not send one.
+	Pagination *Pagination `json:"-"`
+}
+
+// Member is a workspace member.
+type Member struct {
+	UserID   string `json:"user_id"`
+	Role     string `json:"role"`
+	JoinedAt string `json:"joined_at"`
+	Username string `json:"username,omitempty"`
+	Email    string `json:"email,omitempty"`
+}
+
+// Chat is a chat.
+type Chat struct {
+	ID               string        `json:"id"`
+	WorkspaceID      string        `json:"workspace_id"`
+	Name             string        `json:"name"`
+	Type             string        `json:"type"`
+	IsPublic         bool          `json:"is_public"`
+	CreatedBy        string        `json:"created_by"`
+	CreatedAt        string        `json:"created_at"`
+	UnreadCount      int           `json:"unread_count,omitempty"`
+	Participants     []Participant `json:"participants,omitempty"`
+	ParticipantCount int           `json:"participant_count"`
+	Status           *string       `json:"status,omitempty"`
+	AssignedTo       *string       `json:"assigned_to,omitempty"`
+	Priority         *string       `json:"priority,omitempty"`
+}
+
+// ChatList is a page of chats.
+type ChatList struct {
+	Chats   []Chat `json:"chats"`
+	Total   int    `json:"total"`
+	HasMore bool   `json:"has_more"`
+
+	// Pagination is nil if the server did not send one.
+	Pagination *Pagination `json:"-"`
+}
+
+// Participant is a chat participant.
+type Participant struct {
+	UserID      string `json:"user_id"`
+	Role        string `json:"role"`
+	JoinedAt    string `json:"joined_at"`
+	DisplayName string `json:"display_name,omitempty"`
+}
+
+// ParticipantList is a page of chat participants.
+type ParticipantList struct {
+	Participants []Participant `json:"participants"`
+	Total        int           `json:"total"`
+	HasMore      bool          `json:"has_more"`
+
+	// Pagination is nil if the server did not send one.
+	Pagination *Pagination `json:"-"`
+}
+
+// Message is a chat message.
+type Message struct {
+	ID          string       `json:"id"`
+	ChatID      string       `json:"chat_id"`
+	SenderID    string       `json:"sender_id"`
+	Content     string       `json:"content"`
+	Type        string       `json:"type"`
+	IsSystem    bool         `json:"is_system"`
+	ActorID     *string      `json:"actor_id,omitempty"`
+	ReplyToID   *string      `json:"reply_to_id,omitempty"`
+	QuoteID     *string      `json:"quote_id,omitempty"`
+	CreatedAt   string       `json:"created_at"`
+	EditedAt    *string      `json:"edited_at,omitempty"`
+	IsDeleted   bool         `json:"is_deleted"`
+	Attachments []Attachment `json:"attachments,omitempty"`
+	Reactions   []Reaction   `json:"reactions,omitempty"`
+}
+
+// Attachment is a file attached to a message.
+type Attachment struct {
+	FileID   string `json:"file_id"`
+	FileName string `json:"file_name"`
+	FileSize int64  `json:"file_size"`
+	MimeType string `json:"mime_type"`
+	// DurationMS and Waveform are set for audio attachments such as voice messages.
+	DurationMS int64 `json:"duration_ms,omitempty"`
+	Waveform   []int `json:"waveform,omitempty"`
+	// PreviewURL and the preview size are set for images and PDFs once their preview is generated.
+	PreviewURL    string `json:"preview_url,omitempty"`
+	PreviewWidth  int    `json:"preview_width,omitempty"`
+	PreviewHeight int    `json:"preview_height,omitempty"`
+}
+
+// Reaction is an emoji reaction on a message.
+type Reaction struct {
+	Emoji string   `json:"emoji"`
+	Users []string `json:"users"`
+	Count int      `json:"count"`
+}
+
+// MessageList is a page of messages.
+type MessageList struct {
+	Messages []Message `json:"messages"`
+	HasMore  bool      `json:"has_more"`
+
+	// NextCursor is the cursor of the next page, the same as Pagination.NextCursor.
+	NextCursor *string `json:"next_cursor,omitempty"`
+
+	// Pagination is nil if the server did not send one.
+	Pagination *Pagination `json:"-"`
+}
+
+// Task is a task. Mutations whose result is not yet projected return a Task with
+// only the ID set.
+type Task struct {
+	ID          string        `json:"id"`
+	ChatID      string        `json:"chat_id"`
+	Title       string        `json:"title"`
+	Description string        `json:"description,omitempty"`
+	Status      string        `json:"status"`
+	Priority    string        `json:"priority"`
+	EntityType  string        `json:"entity_type"`
+	AssigneeID  *string       `json:"assignee_id,omitempty"`
+	ReporterID  string        `json:"reporter_id"`
+	DueDate     *string       `json:"due_date,omitempty"`
+	CreatedAt   string        `json:"created_at"`
+	UpdatedAt   string        `json:"updated_at,omitempty"`
+	Version     int           `json:"version"`
+	Estimate    *TaskEstimate `json:"estimate,omitempty"`
+	Sprint      string        `json:"sprint,omitempty"`
+}
+
+// TaskEstimate is a task estimate.
+type TaskEstimate struct {
+	Value float64 `json:"value"`
+	Unit  string  `json:"unit"`
+}
+
+// TaskList is a page of tasks.
+type TaskList struct {
+	Tasks   []Task `json:"tasks"`
+	Total   int    `json:"total"`
+	HasMore bool   `json:"has_more"`
+
+	// Pagination is nil if the server did not send one.
+	Pagination *Pagination `json:"-"`
+}
@@ -0,0 +1,88 @@
+package flowraclient
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+)
+
+// CreateWorkspace creates a workspace owned by the authenticated user.
+func (c *Client) CreateWorkspace(ctx context.Context, name, description string) (*Workspace, error) {
+	var ws Workspace
+	body := map[string]string{"name": name, "description": description}
+	if err := c.do(ctx, http.MethodPost, "/workspaces", nil, body, &ws); err != nil {
+		return nil, err
+	}
+	return &ws, nil
+}
+
+// ListWorkspaces lists the workspaces of the authenticated user. Zero limit and offset
+// use the server defaults.
+func (c *Client) ListWorkspaces(ctx context.Context, limit, offset int) (*WorkspaceList, error) {
+	var list WorkspaceList
+	page, err := c.doPage(ctx, http.MethodGet, "/workspaces", paginationQuery(limit, offset), nil, &list)
+	if err != nil {
+		return nil, err
+	}
+	list.Pagination = page
+	return &list, nil
+}
+
+// GetWorkspace returns a workspace.
+func (c *Client) GetWorkspace(ctx context.Context, workspaceID string) (*Workspace, error) {
+	var ws Workspace
+	if err := c.do(ctx, http.MethodGet, workspacePath(workspaceID), nil, nil, &ws); err != nil {
+		return nil, err
+	}
+	return &ws, nil
+}
+
+// UpdateWorkspace renames a workspace and replaces its description.
+func (c *Client) UpdateWorkspace(ctx context.Context, workspaceID, name, description string) (*Workspace, error) {
+	var ws Workspace
+	body := map[string]string{"name": name, "description": description}
+	if err := c.do(ctx, http.MethodPut, workspacePath(workspaceID), nil, body, &ws); err != nil {
+		return nil, err
+	}
+	return &ws, nil
+}
+
+// DeleteWorkspace deletes a workspace.
+func (c *Client) DeleteWorkspace(ctx context.Context, workspaceID string) error {
+	return c.do(ctx, http.MethodDelete, workspacePath(workspaceID), nil, nil, nil)
+}
+
+// AddMember adds a user to a workspace with the given role ("member", "admin", ...).
+func (c *Client) AddMember(ctx context.Context, workspaceID, userID, role string) (*Member, error) {
+	var member Member
+	body := map[string]string{"user_id": userID, "role": role}
+	if err := c.do(ctx, http.MethodPost, workspacePath(workspaceID, "members"), nil, body, &member); err != nil {
+		return nil, err
+	}
+	return &member, nil
+}
+
+// RemoveMember removes a user from a workspace.
+func (c *Client) RemoveMember(ctx context.Context, workspaceID, userID string) error {
+	return c.do(ctx, http.MethodDelete, workspacePath(workspaceID, "members", userID), nil, nil, nil)
+}
+
+// UpdateMemberRole changes the role of a workspace member.
+func (c *Client) UpdateMemberRole(ctx context.Context, workspaceID, userID, role string) (*Member, error) {
+	var member Member
+	body := map[string]string{"role": role}
+	path := workspacePath(workspaceID, "members", userID, "role")
+	if err := c.do(ctx, http.MethodPut, path, nil, body, &member); err != nil {
+		return nil, err
+	}
+	return &member, nil
+}
+
+// workspacePath returns the path of a workspace-scoped resource.
+func workspacePath(workspaceID string, elems ...string) string {
+	path := "/workspaces/" + url.PathEscape(workspaceID)
+	for _, elem := range elems {
+		path += "/" + url.PathEscape(elem)
+	}
+	return path
+}
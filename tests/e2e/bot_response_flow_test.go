@@ -10,6 +10,7 @@ import (
 
 	chatapp "github.com/lllypuk/flowra/internal/application/chat"
 	messageapp "github.com/lllypuk/flowra/internal/application/message"
+	"github.com/lllypuk/flowra/internal/config"
 	chatdomain "github.com/lllypuk/flowra/internal/domain/chat"
 	messagedomain "github.com/lllypuk/flowra/internal/domain/message"
 	"github.com/lllypuk/flowra/internal/domain/tag"
@@ -30,6 +31,12 @@ type realE2EMessageService struct {
 	del           *messageapp.DeleteMessageUseCase
 	get           *messageapp.GetMessageUseCase
 	addAttachment *messageapp.AddAttachmentUseCase
+	forward       *messageapp.ForwardMessageUseCase
+	undo          *messageapp.UndoMessageChangeUseCase
+	createPoll    *messageapp.CreatePollUseCase
+	votePoll      *messageapp.VotePollUseCase
+	retractVote   *messageapp.RetractPollVoteUseCase
+	closePoll     *messageapp.ClosePollUseCase
 }
 
 func newRealE2EMessageService(t *testing.T, suite *E2ETestSuite) httphandler.MessageService {
@@ -45,7 +52,7 @@ func newRealE2EMessageService(t *testing.T, suite *E2ETestSuite) httphandler.Mes
 
 	tagUseCases := &tag.ChatUseCases{
 		ChangeStatus: chatapp.NewChangeStatusUseCase(suite.ChatRepo),
-		SetPriority:  chatapp.NewSetPriorityUseCase(suite.ChatRepo),
+		SetPriority:  chatapp.NewSetPriorityUseCase(suite.ChatRepo, nil),
 	}
 
 	tagExecutor := tag.NewCommandExecutor(tagUseCases, suite.UserRepo)
@@ -65,6 +72,12 @@ func newRealE2EMessageService(t *testing.T, suite *E2ETestSuite) httphandler.Mes
 		del:           messageapp.NewDeleteMessageUseCase(suite.MessageRepo, suite.EventBus),
 		get:           messageapp.NewGetMessageUseCase(suite.MessageRepo),
 		addAttachment: messageapp.NewAddAttachmentUseCase(suite.MessageRepo, suite.EventBus),
+		forward:       messageapp.NewForwardMessageUseCase(suite.MessageRepo, chatReadRepo, nil, suite.EventBus),
+		undo:          messageapp.NewUndoMessageChangeUseCase(suite.MessageRepo, suite.EventBus, config.DefaultMessageUndoWindow),
+		createPoll:    messageapp.NewCreatePollUseCase(suite.MessageRepo, chatReadRepo, suite.EventBus),
+		votePoll:      messageapp.NewVotePollUseCase(suite.MessageRepo, chatReadRepo, suite.EventBus),
+		retractVote:   messageapp.NewRetractPollVoteUseCase(suite.MessageRepo, chatReadRepo, suite.EventBus),
+		closePoll:     messageapp.NewClosePollUseCase(suite.MessageRepo, chatReadRepo, suite.EventBus),
 	}
 }
 
@@ -96,6 +109,30 @@ func (s *realE2EMessageService) AddAttachment(ctx context.Context, cmd messageap
 	return s.addAttachment.Execute(ctx, cmd)
 }
 
+func (s *realE2EMessageService) ForwardMessage(ctx context.Context, cmd messageapp.ForwardMessageCommand) (messageapp.Result, error) {
+	return s.forward.Execute(ctx, cmd)
+}
+
+func (s *realE2EMessageService) UndoMessageChange(ctx context.Context, cmd messageapp.UndoMessageChangeCommand) (messageapp.Result, error) {
+	return s.undo.Execute(ctx, cmd)
+}
+
+func (s *realE2EMessageService) CreatePoll(ctx context.Context, cmd messageapp.CreatePollCommand) (messageapp.Result, error) {
+	return s.createPoll.Execute(ctx, cmd)
+}
+
+func (s *realE2EMessageService) VotePoll(ctx context.Context, cmd messageapp.VotePollCommand) (messageapp.Result, error) {
+	return s.votePoll.Execute(ctx, cmd)
+}
+
+func (s *realE2EMessageService) RetractPollVote(ctx context.Context, cmd messageapp.RetractPollVoteCommand) (messageapp.Result, error) {
+	return s.retractVote.Execute(ctx, cmd)
+}
+
+func (s *realE2EMessageService) ClosePoll(ctx context.Context, cmd messageapp.ClosePollCommand) (messageapp.Result, error) {
+	return s.closePoll.Execute(ctx, cmd)
+}
+
 func NewRealMessageE2ETestSuite(t *testing.T) *E2ETestSuite {
 	t.Helper()
 	return newE2ETestSuite(t, func(suite *E2ETestSuite) {
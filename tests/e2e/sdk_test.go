@@ -0,0 +1,115 @@
+//go:build e2e
+
+package e2e
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/lllypuk/flowra/internal/domain/uuid"
+	"github.com/lllypuk/flowra/pkg/flowraclient"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSDK_WorkspaceAndChat(t *testing.T) {
+	suite := NewE2ETestSuite(t)
+
+	testUser := suite.CreateTestUser("sdkowner")
+	client := suite.NewSDKClient(testUser.Token)
+	ctx := t.Context()
+
+	ws, err := client.CreateWorkspace(ctx, "SDK Workspace", "Created through flowraclient")
+	require.NoError(t, err)
+	assert.NotEmpty(t, ws.ID)
+	assert.Equal(t, testUser.ID.String(), ws.OwnerID)
+
+	chat, err := client.CreateChat(ctx, ws.ID, flowraclient.CreateChatRequest{
+		Name:           "General",
+		Type:           "discussion",
+		IsPublic:       true,
+		ParticipantIDs: []string{testUser.ID.String()},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, ws.ID, chat.WorkspaceID)
+	assert.Equal(t, "discussion", chat.Type)
+}
+
+func TestSDK_SendMessage(t *testing.T) {
+	suite := NewE2ETestSuite(t)
+
+	testUser := suite.CreateTestUser("sdkmessenger")
+	ws := suite.CreateTestWorkspace("SDK Message Workspace", testUser)
+	client := suite.NewSDKClient(testUser.Token)
+
+	// The mock message service does not validate chat existence
+	chatID := uuid.NewUUID().String()
+	msg, err := client.SendMessage(t.Context(), ws.ID().String(), chatID, flowraclient.SendMessageRequest{
+		Content: "Hello from the SDK",
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, chatID, msg.ChatID)
+	assert.Equal(t, "Hello from the SDK", msg.Content)
+}
+
+func TestSDK_Errors(t *testing.T) {
+	suite := NewE2ETestSuite(t)
+
+	t.Run("unauthenticated", func(t *testing.T) {
+		_, err := suite.NewSDKClient("invalid-token").ListWorkspaces(t.Context(), 0, 0)
+
+		assert.True(t, flowraclient.IsUnauthorized(err))
+	})
+
+	t.Run("validation", func(t *testing.T) {
+		testUser := suite.CreateTestUser("sdkvalidation")
+
+		_, err := suite.NewSDKClient(testUser.Token).CreateWorkspace(t.Context(), "", "")
+
+		var apiErr *flowraclient.APIError
+		require.ErrorAs(t, err, &apiErr)
+		assert.Equal(t, "VALIDATION_ERROR", apiErr.Code)
+	})
+}
+
+func TestSDK_Subscriber(t *testing.T) {
+	suite := NewE2ETestSuite(t)
+
+	testUser := suite.CreateTestUser("sdksubscriber")
+	chatID := uuid.NewUUID()
+
+	sub := suite.NewSDKClient(testUser.Token).NewSubscriber()
+	require.NoError(t, sub.Subscribe(chatID.String()))
+
+	ctx, cancel := context.WithCancel(t.Context())
+	done := make(chan error, 1)
+	go func() { done <- sub.Run(ctx) }()
+	defer func() {
+		cancel()
+		<-done
+	}()
+
+	waitFor := func(eventType string) flowraclient.Event {
+		t.Helper()
+		timeout := time.After(wsReadTimeout)
+		for {
+			select {
+			case evt := <-sub.Events():
+				if evt.Type == eventType {
+					return evt
+				}
+			case <-timeout:
+				t.Fatalf("no %s event received", eventType)
+			}
+		}
+	}
+
+	waitFor("ack")
+	time.Sleep(eventPropagationWait)
+	suite.WSHub.BroadcastToChat(chatID, []byte(`{"type":"chat.closed","chat_id":"`+chatID.String()+`"}`))
+
+	evt := waitFor("chat.closed")
+	assert.Equal(t, chatID.String(), evt.ChatID)
+}
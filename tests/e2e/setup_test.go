@@ -29,6 +29,7 @@ import (
 	"github.com/lllypuk/flowra/internal/infrastructure/repository/mongodb"
 	wsinfra "github.com/lllypuk/flowra/internal/infrastructure/websocket"
 	"github.com/lllypuk/flowra/internal/middleware"
+	"github.com/lllypuk/flowra/pkg/flowraclient"
 	"github.com/lllypuk/flowra/tests/testutil"
 	"github.com/redis/go-redis/v9"
 	"github.com/stretchr/testify/require"
@@ -511,6 +512,15 @@ func (s *E2ETestSuite) NewHTTPClient(token string) *HTTPClient {
 	}
 }
 
+// NewSDKClient creates a flowraclient authenticated with the given token.
+func (s *E2ETestSuite) NewSDKClient(token string) *flowraclient.Client {
+	s.t.Helper()
+
+	client, err := flowraclient.New(s.BaseURL(), flowraclient.WithToken(token))
+	require.NoError(s.t, err)
+	return client
+}
+
 // DoRequest performs an HTTP request and returns the response.
 func (c *HTTPClient) DoRequest(method, path string, body interface{}) *http.Response {
 	c.t.Helper()
@@ -75,7 +75,7 @@ func TestChatSoT_TypedLifecycleEmitsOnlyChatEventsAndKeepsReadModelsConsistent(t
 	})
 	require.NoError(t, err)
 
-	_, err = chatapp.NewSetPriorityUseCase(env.chatRepo).Execute(ctx, chatapp.SetPriorityCommand{
+	_, err = chatapp.NewSetPriorityUseCase(env.chatRepo, nil).Execute(ctx, chatapp.SetPriorityCommand{
 		ChatID:   chatID,
 		Priority: string(taskdomain.PriorityHigh),
 		SetBy:    actorID,
@@ -236,7 +236,7 @@ func TestChatSoT_SidebarBoardRegressionQueriesStayConsistentAfterReload(t *testi
 	require.NoError(t, err)
 	require.NoError(t, env.syncReadModels(ctx, chatID))
 
-	_, err = chatapp.NewSetPriorityUseCase(env.chatRepo).Execute(ctx, chatapp.SetPriorityCommand{
+	_, err = chatapp.NewSetPriorityUseCase(env.chatRepo, nil).Execute(ctx, chatapp.SetPriorityCommand{
 		ChatID:   chatID,
 		Priority: string(taskdomain.PriorityHigh),
 		SetBy:    actorID,
@@ -311,7 +311,7 @@ func TestChatSoT_ConcurrentTypedMutationsSingleAggregateRemainDeterministic(t *t
 
 	changeStatusUC := chatapp.NewChangeStatusUseCase(concurrentRepo)
 	assignUserUC := chatapp.NewAssignUserUseCase(concurrentRepo, userRepo)
-	setPriorityUC := chatapp.NewSetPriorityUseCase(concurrentRepo)
+	setPriorityUC := chatapp.NewSetPriorityUseCase(concurrentRepo, nil)
 
 	mutations := []func(context.Context) error{
 		func(runCtx context.Context) error {
@@ -392,7 +392,7 @@ func TestChatSoT_BoardStyleCreatePartialSetupFailureCanBeRecoveredByProjectionRe
 	require.NoError(t, err)
 	chatID := createResult.Value.ID()
 
-	_, err = chatapp.NewSetPriorityUseCase(env.chatRepo).Execute(ctx, chatapp.SetPriorityCommand{
+	_, err = chatapp.NewSetPriorityUseCase(env.chatRepo, nil).Execute(ctx, chatapp.SetPriorityCommand{
 		ChatID:   chatID,
 		Priority: string(taskdomain.PriorityHigh),
 		SetBy:    actorID,
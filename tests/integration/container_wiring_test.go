@@ -113,7 +113,13 @@ func TestContainerWiring_MemberService_OwnerProtection(t *testing.T) {
 	require.NoError(t, err)
 	require.NoError(t, workspaceRepo.Save(ctx, ws))
 
-	ownerMember := workspace.ReconstructMember(creatorID, ws.ID(), workspace.RoleOwner, time.Now())
+	ownerMember := workspace.ReconstructMember(
+		creatorID,
+		ws.ID(),
+		workspace.RoleOwner,
+		time.Now(),
+		workspace.MemberStatusActive,
+	)
 	require.NoError(t, workspaceRepo.AddMember(ctx, &ownerMember))
 
 	// Try to remove owner - should fail
@@ -37,7 +37,13 @@ func TestRealWorkspaceAccessChecker_Integration_GetMembership(t *testing.T) {
 
 		// Add member
 		memberID := uuid.NewUUID()
-		member := workspace.ReconstructMember(memberID, ws.ID(), workspace.RoleMember, time.Now())
+		member := workspace.ReconstructMember(
+			memberID,
+			ws.ID(),
+			workspace.RoleMember,
+			time.Now(),
+			workspace.MemberStatusActive,
+		)
 		err = repo.AddMember(ctx, &member)
 		require.NoError(t, err)
 
@@ -70,7 +76,13 @@ func TestRealWorkspaceAccessChecker_Integration_GetMembership(t *testing.T) {
 		require.NoError(t, err)
 
 		// Add owner as member
-		member := workspace.ReconstructMember(ownerID, ws.ID(), workspace.RoleOwner, time.Now())
+		member := workspace.ReconstructMember(
+			ownerID,
+			ws.ID(),
+			workspace.RoleOwner,
+			time.Now(),
+			workspace.MemberStatusActive,
+		)
 		err = repo.AddMember(ctx, &member)
 		require.NoError(t, err)
 
@@ -101,7 +113,13 @@ func TestRealWorkspaceAccessChecker_Integration_GetMembership(t *testing.T) {
 
 		// Add admin as member
 		adminID := uuid.NewUUID()
-		member := workspace.ReconstructMember(adminID, ws.ID(), workspace.RoleAdmin, time.Now())
+		member := workspace.ReconstructMember(
+			adminID,
+			ws.ID(),
+			workspace.RoleAdmin,
+			time.Now(),
+			workspace.MemberStatusActive,
+		)
 		err = repo.AddMember(ctx, &member)
 		require.NoError(t, err)
 
@@ -224,9 +242,27 @@ func TestRealWorkspaceAccessChecker_Integration_MultipleMembers(t *testing.T) {
 		adminID := uuid.NewUUID()
 		memberID := uuid.NewUUID()
 
-		ownerMember := workspace.ReconstructMember(ownerID, ws.ID(), workspace.RoleOwner, time.Now())
-		adminMember := workspace.ReconstructMember(adminID, ws.ID(), workspace.RoleAdmin, time.Now())
-		regularMember := workspace.ReconstructMember(memberID, ws.ID(), workspace.RoleMember, time.Now())
+		ownerMember := workspace.ReconstructMember(
+			ownerID,
+			ws.ID(),
+			workspace.RoleOwner,
+			time.Now(),
+			workspace.MemberStatusActive,
+		)
+		adminMember := workspace.ReconstructMember(
+			adminID,
+			ws.ID(),
+			workspace.RoleAdmin,
+			time.Now(),
+			workspace.MemberStatusActive,
+		)
+		regularMember := workspace.ReconstructMember(
+			memberID,
+			ws.ID(),
+			workspace.RoleMember,
+			time.Now(),
+			workspace.MemberStatusActive,
+		)
 
 		require.NoError(t, repo.AddMember(ctx, &ownerMember))
 		require.NoError(t, repo.AddMember(ctx, &adminMember))
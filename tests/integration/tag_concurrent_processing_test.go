@@ -208,7 +208,7 @@ func newTagExecutorTestEnv(t *testing.T, repoOpts countingRepoOptions) *tagExecu
 	chatUseCases := &tag.ChatUseCases{
 		ChangeStatus: chatapp.NewChangeStatusUseCase(chatRepo),
 		AssignUser:   chatapp.NewAssignUserUseCase(chatRepo, userRepo),
-		SetPriority:  chatapp.NewSetPriorityUseCase(chatRepo),
+		SetPriority:  chatapp.NewSetPriorityUseCase(chatRepo, nil),
 		Rename:       chatapp.NewRenameChatUseCase(chatRepo),
 	}
 
@@ -149,6 +149,17 @@
         if (xhr.status === 404) {
             return 'Resource not found.';
         }
+        if (xhr.status === 429) {
+            var retryAfter = parseInt(xhr.getResponseHeader('Retry-After') ||
+                xhr.getResponseHeader('RateLimit-Reset'), 10);
+            if (retryAfter > 0) {
+                return 'Too many requests. Please try again in ' + retryAfter + ' s.';
+            }
+            return 'Too many requests. Please try again shortly.';
+        }
+        if (xhr.status === 503 && xhr.getResponseHeader('X-Flowra-Read-Only')) {
+            return xhr.responseText || 'This Flowra region is read-only.';
+        }
         if (xhr.status >= 500) {
             return 'Server error. Please try again later.';
         }
@@ -906,17 +917,7 @@
             }
             showToast(message, 'info');
             
-            // If dropdown is open, reload it to show new notification
-            var dropdown = document.querySelector('.notification-dropdown[open]');
-            if (dropdown) {
-                htmx.trigger(document.body, 'reload-notifications');
-            }
-            
-            // If on notifications page, reload the list
-            var notificationsList = document.getElementById('notifications-list');
-            if (notificationsList) {
-                htmx.trigger(notificationsList, 'reload-notifications');
-            }
+            reloadNotificationViews();
             
             // Animate badge
             var badge = document.getElementById('notification-badge');
@@ -927,6 +928,35 @@
                 }, 600);
             }
         });
+
+        // Read state changed on another device (or tab) of the same user
+        ['notification.read', 'notification.all_read'].forEach(function(type) {
+            document.body.addEventListener(type, function() {
+                htmx.trigger(document.body, 'notification-update');
+                reloadNotificationViews();
+            });
+        });
+    }
+
+    // reloadNotificationViews refreshes the open dropdown and the notifications page
+    function reloadNotificationViews() {
+        var dropdown = document.querySelector('.notification-dropdown[open]');
+        if (dropdown) {
+            htmx.trigger(document.body, 'reload-notifications');
+        }
+
+        var notificationsList = document.getElementById('notifications-list');
+        if (notificationsList) {
+            htmx.trigger(notificationsList, 'reload-notifications');
+        }
+    }
+
+    // ===== Announcement Handlers =====
+    function setupAnnouncementHandlers() {
+        // Reload the banner when an admin publishes or ends an announcement
+        document.body.addEventListener('announcement.updated', function() {
+            htmx.trigger(document.body, 'announcement-update');
+        });
     }
 
     // ===== Task Detail Helpers =====
@@ -1076,6 +1106,7 @@
         setupFormStatePreservation();
         setupWebSocketReconnection();
         setupNotificationHandlers();
+        setupAnnouncementHandlers();
         wsStatus.init();
     }
 
@@ -166,10 +166,12 @@
   }
 
   /**
-   * Update task status via HTMX AJAX call
+   * Move a task card to another column on the server.
+   * The response carries the affected columns as out-of-band fragments.
    * @param {string} taskId - Task ID
-   * @param {string} newStatus - New status value
+   * @param {string} newStatus - Target column status key
    * @param {HTMLElement} taskCard - Task card element
+   * @param {string} oldStatus - Source column status key
    */
   function updateTaskStatus(taskId, newStatus, taskCard, oldStatus) {
     // Show loading state
@@ -187,33 +189,56 @@
       return;
     }
 
-    // Update via fetch (no swap needed - card already moved by drag)
-    fetch(
-      "/api/v1/workspaces/" + workspaceId + "/tasks/" + taskId + "/status",
-      {
-        method: "PUT",
-        headers: {
-          "Content-Type": "application/x-www-form-urlencoded",
-        },
-        body: "status=" + encodeURIComponent(newStatus),
+    var column = taskCard.closest(".column-cards");
+    var position = column
+      ? Array.prototype.indexOf.call(
+          column.querySelectorAll(".task-card"),
+          taskCard,
+        )
+      : -1;
+
+    var body = new URLSearchParams();
+    body.set("task_id", taskId);
+    body.set("status", newStatus);
+    if (position >= 0) {
+      body.set("position", String(position));
+    }
+
+    // Keep the returned columns consistent with the active filters
+    var filters = document.getElementById("board-filters");
+    ["type", "assignee", "priority", "search", "starred"].forEach(function (name) {
+      var el = filters && filters.querySelector('[name="' + name + '"]');
+      if (el && el.value) {
+        body.set("filter_" + name, el.value);
+      }
+    });
+
+    fetch("/partials/workspace/" + workspaceId + "/board/move", {
+      method: "POST",
+      headers: {
+        "Content-Type": "application/x-www-form-urlencoded",
+        "HX-Request": "true",
       },
-    )
+      body: body.toString(),
+    })
       .then(function (response) {
         if (!response.ok) {
           throw new Error(
-            "Status update failed: " +
-              response.status +
-              " " +
-              response.statusText,
+            "Move failed: " + response.status + " " + response.statusText,
           );
         }
-        // Restore card visibility and update counts
-        taskCard.style.opacity = "1";
-        taskCard.style.pointerEvents = "";
+        return response.text();
+      })
+      .then(function (html) {
+        // Columns are marked hx-swap-oob, so nothing is swapped into a target
+        htmx.swap(document.body, html, { swapStyle: "none" });
+        document
+          .querySelectorAll(".board-column")
+          .forEach(setupCardAccessibility);
         updateColumnCounts();
       })
       .catch(function (err) {
-        console.error("Failed to update task status:", err);
+        console.error("Failed to move task:", err);
         // Revert visual state on error
         taskCard.style.opacity = "1";
         taskCard.style.pointerEvents = "";
@@ -292,7 +317,7 @@
     if (!filters) return;
 
     var params = new URLSearchParams(window.location.search);
-    var names = ["type", "assignee", "priority", "search"];
+    var names = ["type", "assignee", "priority", "search", "starred"];
     var activeCount = 0;
 
     names.forEach(function (name) {
@@ -335,7 +360,8 @@
       if (
         sel.name === "type" ||
         sel.name === "assignee" ||
-        sel.name === "priority"
+        sel.name === "priority" ||
+        sel.name === "starred"
       ) {
         sel.value = "";
       }
@@ -57,6 +57,67 @@ window.scrollToBottomInstant = function scrollToBottomInstant(elementId) {
     }
 };
 
+/**
+ * Scroll to the highlighted (permalinked) message inside a container
+ * @param {string} elementId - ID of the messages container
+ * @returns {boolean} true if a highlighted message was found
+ */
+window.scrollToHighlightedMessage = function scrollToHighlightedMessage(elementId) {
+    var element = document.getElementById(elementId);
+    var target = element ? element.querySelector('.message.highlighted') : null;
+    if (!target) {
+        return false;
+    }
+    target.scrollIntoView({ block: 'center' });
+    return true;
+};
+
+// ============================================================
+// Message undo
+// ============================================================
+
+/**
+ * Offer an "Undo" toast after the user deleted or edited a message.
+ * The server accepts the undo for a few seconds (messages.undo_window).
+ * @param {Event} event - htmx:afterRequest event of the delete/edit request
+ * @param {string} messageId - The message ID
+ * @param {string} label - Toast text, e.g. "Message deleted."
+ */
+window.offerMessageUndo = function offerMessageUndo(event, messageId, label) {
+    if (!event.detail.successful || typeof window.showUndoToast !== 'function') {
+        return;
+    }
+
+    window.showUndoToast(label, function() {
+        htmx.ajax('POST', '/api/v1/messages/' + messageId + '/undo', { swap: 'none' }).then(function() {
+            // The WebSocket event refreshes other clients; refresh ours right away
+            if (document.getElementById('message-' + messageId)) {
+                htmx.ajax('GET', '/partials/messages/' + messageId, {
+                    target: '#message-' + messageId,
+                    swap: 'outerHTML'
+                });
+            }
+        });
+    });
+};
+
+/**
+ * Swap the star/unstar buttons of the chat header after the user starred or
+ * unstarred the chat, and refresh the chat list so its "Starred" section follows.
+ * @param {Event} event - htmx:afterRequest event of the star/unstar request
+ * @param {HTMLElement} button - The button that sent the request
+ */
+window.toggleChatStar = function toggleChatStar(event, button) {
+    if (!event.detail.successful) {
+        return;
+    }
+
+    button.parentElement.querySelectorAll('.star-toggle').forEach(function(toggle) {
+        toggle.hidden = !toggle.hidden;
+    });
+    htmx.trigger(document.body, 'refreshChatList');
+};
+
 // ============================================================
 // Typing indicator
 // ============================================================
@@ -699,7 +760,9 @@ document.body.addEventListener('htmx:afterSwap', function(evt) {
 document.body.addEventListener('htmx:afterSettle', function(evt) {
     var target = evt.detail.target;
     if (target && target.id && target.id.startsWith('messages-')) {
-        window.scrollToBottomInstant(target.id);
+        if (!window.scrollToHighlightedMessage(target.id)) {
+            window.scrollToBottomInstant(target.id);
+        }
     }
 });
 